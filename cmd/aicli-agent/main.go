@@ -0,0 +1,67 @@
+// aicli-agent API 서버에 접속해 배정받은 태스크를 로컬에서 실행하는 원격 에이전트
+//
+// 환경 변수:
+//
+//	AICLI_AGENT_SERVER    서버 에이전트 엔드포인트 (예: wss://aicli.example.com/ws/agents)
+//	AICLI_AGENT_TOKEN     에이전트 접속 토큰 (서버의 remote.token)
+//	AICLI_AGENT_ID        에이전트 ID (기본값: 호스트 이름)
+//	AICLI_AGENT_NAME      표시 이름
+//	AICLI_AGENT_LABELS    쉼표로 구분한 능력 레이블 (예: "gpu=true,region=eu")
+//	AICLI_AGENT_CAPACITY  동시에 실행할 태스크 수 (기본값 1)
+//	AICLI_AGENT_WORKDIR   워크스페이스별 작업 디렉토리 루트
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/aicli/aicli-web/internal/remote"
+)
+
+func main() {
+	logger := log.New(os.Stderr, "aicli-agent: ", log.LstdFlags)
+
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv("AICLI_AGENT_LABELS"), ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), "=")
+		if key != "" {
+			labels[key] = value
+		}
+	}
+
+	capacity := 1
+	if value := os.Getenv("AICLI_AGENT_CAPACITY"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			logger.Fatalf("AICLI_AGENT_CAPACITY가 올바르지 않습니다: %q", value)
+		}
+		capacity = n
+	}
+
+	agent, err := remote.NewAgent(remote.AgentConfig{
+		ServerURL: os.Getenv("AICLI_AGENT_SERVER"),
+		Token:     os.Getenv("AICLI_AGENT_TOKEN"),
+		AgentID:   os.Getenv("AICLI_AGENT_ID"),
+		Name:      os.Getenv("AICLI_AGENT_NAME"),
+		Labels:    labels,
+		Capacity:  capacity,
+		WorkDir:   os.Getenv("AICLI_AGENT_WORKDIR"),
+		Logger:    logger,
+	})
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger.Printf("%s에 접속합니다 (레이블 %v, 동시 실행 %d)", os.Getenv("AICLI_AGENT_SERVER"), labels, capacity)
+	if err := agent.Run(ctx); err != nil && err != context.Canceled {
+		logger.Fatal(err)
+	}
+}
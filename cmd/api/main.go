@@ -2,15 +2,19 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/spf13/viper"
+	"github.com/aicli/aicli-web/internal/config"
 	"github.com/aicli/aicli-web/internal/server"
 	
 	// Swagger docs 자동 생성을 위한 임포트 (docs 패키지 생성 필요)
@@ -38,27 +42,85 @@ import (
 // @description JWT 인증 토큰. "Bearer {token}" 형식으로 입력하세요.
 
 func main() {
+	configPath := flag.String("config", "", "설정 파일 경로 (기본: $AICLI_CONFIG_PATH, ~/.aicli/config.yaml, /etc/aicli/config.yaml)")
+	flag.Parse()
+
 	// 설정 초기화
-	initConfig()
+	path := config.ResolveServerConfigPath(*configPath)
+	cfg, err := initConfig(path)
+	if err != nil {
+		log.Fatalf("설정 로드 실패: %v", err)
+	}
 
 	// 서버 생성
-	srv := server.New()
+	srv, err := server.NewWithConfig(cfg)
+	if err != nil {
+		log.Fatalf("서버 생성 실패: %v", err)
+	}
+
+	// 설정 파일 변경 감시 (로그 레벨, 요청 제한, 워커 수를 재시작 없이 적용)
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+	if cfg.Server.HotReload && path != "" {
+		reloader := config.NewReloader(path, cfg, nil)
+		reloader.Subscribe(srv.ApplyConfig)
+		if err := reloader.Watch(watchCtx); err != nil {
+			log.Printf("설정 파일 감시를 시작하지 못했습니다: %v", err)
+		}
+	}
 
 	// 서버 설정
-	port := viper.GetString("port")
-	if port == "" {
-		port = "8080"
+	port := strconv.Itoa(cfg.Server.Port)
+
+	// HTTPS 설정 (정적 인증서 또는 ACME 자동 발급)
+	tlsManager, err := server.NewTLSManagerFromConfig(cfg.API, srv.Logger())
+	if err != nil {
+		log.Fatalf("TLS 설정 실패: %v", err)
+	}
+	var tlsConfig *tls.Config
+	if tlsManager != nil {
+		tlsConfig = tlsManager.TLSConfig()
 	}
 
-	httpServer := &http.Server{
-		Addr:    ":" + port,
-		Handler: srv.Router(),
+	// 시간 제한, 헤더 크기, keep-alive, HTTP/2 설정
+	httpServer, conns, err := server.NewHTTPServer(cfg.Server, srv.Handler(), tlsConfig)
+	if err != nil {
+		log.Fatalf("HTTP 서버 설정 실패: %v", err)
+	}
+	httpServer.Addr = ":" + port
+	httpServer.RegisterOnShutdown(srv.CloseWebSockets)
+
+	var challengeServer *http.Server
+	if tlsManager != nil {
+		go tlsManager.Run(watchCtx)
+
+		// ACME HTTP-01 챌린지 응답과 HTTPS 리다이렉트
+		if challengePort := tlsManager.ChallengePort(); challengePort != 0 {
+			challengeServer = &http.Server{
+				Addr:              ":" + strconv.Itoa(challengePort),
+				Handler:           tlsManager.ChallengeHandler(cfg.Server.Port),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+			go func() {
+				log.Printf("ACME 챌린지 서버가 포트 %d에서 시작됩니다", challengePort)
+				if err := challengeServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					log.Fatalf("ACME 챌린지 서버 시작 실패: %v", err)
+				}
+			}()
+		}
 	}
 
 	// 고루틴에서 서버 시작
 	go func() {
-		log.Printf("🚀 AICode Manager API 서버가 포트 %s에서 시작됩니다", port)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if tlsManager != nil {
+			log.Printf("🚀 AICode Manager API 서버가 포트 %s에서 HTTPS로 시작됩니다", port)
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			log.Printf("🚀 AICode Manager API 서버가 포트 %s에서 시작됩니다", port)
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			log.Fatalf("서버 시작 실패: %v", err)
 		}
 	}()
@@ -69,33 +131,39 @@ func main() {
 	<-quit
 	log.Println("서버를 종료합니다...")
 
-	// 30초 타임아웃으로 서버 종료
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	// 설정된 타임아웃으로 서버 종료
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
 
-	if err := httpServer.Shutdown(ctx); err != nil {
+	if challengeServer != nil {
+		if err := challengeServer.Shutdown(ctx); err != nil {
+			log.Printf("ACME 챌린지 서버 종료 실패: %v", err)
+		}
+	}
+
+	// 유휴 연결은 바로 닫고 처리 중인 요청은 기한까지 기다린 뒤 남은 연결을 강제로 닫음
+	stats := conns.Stats()
+	log.Printf("연결을 정리합니다 (처리 중 %d개, 유휴 %d개)", stats.Active, stats.Idle)
+	if err := server.ShutdownHTTPServer(ctx, httpServer); err != nil {
 		log.Fatal("서버 강제 종료:", err)
 	}
 
 	log.Println("서버가 정상적으로 종료되었습니다")
 }
 
-// initConfig는 환경 변수 및 설정을 초기화합니다.
-func initConfig() {
-	// 환경 변수 자동 읽기
-	viper.AutomaticEnv()
-	viper.SetEnvPrefix("AICLI")
-
-	// 기본값 설정
-	viper.SetDefault("port", "8080")
-	viper.SetDefault("env", "development")
-	viper.SetDefault("log_level", "info")
+// initConfig는 설정 파일과 환경 변수에서 서버 설정을 읽고 검증합니다.
+func initConfig(path string) (*config.Config, error) {
+	cfg, err := config.LoadServerConfig(path)
+	if err != nil {
+		return nil, err
+	}
 
-	// 환경별 설정
-	env := viper.GetString("env")
-	fmt.Printf("환경: %s\n", env)
+	// 라우터의 Gin 모드 선택에서 사용
+	viper.Set("env", cfg.Server.Env)
 
-	if env == "development" {
-		viper.SetDefault("log_level", "debug")
+	if path != "" {
+		fmt.Printf("설정 파일: %s\n", path)
 	}
-}
\ No newline at end of file
+	fmt.Printf("환경: %s\n", cfg.Server.Env)
+	return cfg, nil
+}
@@ -0,0 +1,43 @@
+// egress-proxy 워크스페이스 네트워크 정책의 allowlist 모드에서 사이드카 컨테이너로 실행되는 이그레스 프록시
+//
+// 환경 변수:
+//
+//	AICLI_EGRESS_ALLOWED_DOMAINS  쉼표로 구분한 허용 도메인 ("*.example.com"은 하위 도메인)
+//	AICLI_EGRESS_LISTEN           수신 주소 (기본값 ":3128")
+package main
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/docker/egress"
+)
+
+func main() {
+	listen := os.Getenv("AICLI_EGRESS_LISTEN")
+	if listen == "" {
+		listen = ":3128"
+	}
+
+	var domains []string
+	for _, domain := range strings.Split(os.Getenv("AICLI_EGRESS_ALLOWED_DOMAINS"), ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+
+	logger := log.New(os.Stderr, "egress-proxy: ", log.LstdFlags)
+	logger.Printf("%s에서 시작합니다 (허용 도메인 %d개)", listen, len(domains))
+
+	server := &http.Server{
+		Addr:              listen,
+		Handler:           egress.NewProxy(egress.NewAllowlist(domains), logger),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	if err := server.ListenAndServe(); err != nil {
+		logger.Fatal(err)
+	}
+}
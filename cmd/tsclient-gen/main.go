@@ -0,0 +1,71 @@
+// tsclient-gen API 서버의 OpenAPI 스펙으로부터 웹 대시보드용 TypeScript 클라이언트를 생성하는 도구
+//
+// 서버 라우터를 프로세스 안에서 구성해 /api/v1/openapi.json을 읽으므로 실행 중인 서버가 필요 없습니다.
+// WebSocket 메시지 타입은 스펙의 x-websocket-messages 확장(internal/websocket의 구조체)에서 생성됩니다.
+//
+//	go run ./cmd/tsclient-gen -out web/src/api/generated/client.ts -spec docs/api/openapi.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/docs"
+	"github.com/aicli/aicli-web/internal/server"
+	"github.com/aicli/aicli-web/pkg/version"
+)
+
+func main() {
+	out := flag.String("out", "web/src/api/generated/client.ts", "생성할 TypeScript 파일 경로")
+	specOut := flag.String("spec", "", "OpenAPI 스펙(JSON)을 함께 저장할 경로 (비우면 저장하지 않음)")
+	clientVersion := flag.String("version", version.Version, "클라이언트에 기록할 빌드 버전")
+	flag.Parse()
+
+	if err := run(*out, *specOut, *clientVersion); err != nil {
+		fmt.Fprintf(os.Stderr, "tsclient-gen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(out, specOut, clientVersion string) error {
+	// 라우트 등록 로그가 출력을 어지럽히지 않도록 숨김
+	gin.DefaultWriter = io.Discard
+
+	srv := server.New()
+	w := httptest.NewRecorder()
+	srv.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, docs.GetSwaggerInfo().BasePath+"/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		return fmt.Errorf("OpenAPI 스펙 조회 실패 (HTTP %d): %s", w.Code, w.Body.String())
+	}
+	spec := w.Body.Bytes()
+
+	client, err := docs.GenerateTypeScriptClient(spec, docs.TSClientOptions{ClientVersion: clientVersion})
+	if err != nil {
+		return err
+	}
+
+	if specOut != "" {
+		if err := writeFile(specOut, append(spec, '\n')); err != nil {
+			return err
+		}
+	}
+	if err := writeFile(out, client); err != nil {
+		return err
+	}
+	fmt.Printf("%s 생성 완료 (버전 %s)\n", out, clientVersion)
+	return nil
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 func main() {
@@ -20,7 +21,7 @@ func main() {
 	logger.SetLevel(logrus.InfoLevel)
 
 	// 프로세스 관리자 생성
-	processManager := claude.NewProcessManager(logger)
+	processManager := claude.NewProcessManager(logging.FromLogrus(logger))
 
 	// 복구 정책 설정
 	policy := &claude.RecoveryPolicy{
@@ -39,7 +40,7 @@ func main() {
 	}
 
 	// 에러 복구 관리자 생성
-	// errorRecovery := claude.NewErrorRecoveryManager(policy, processManager, logger)
+	// errorRecovery := claude.NewErrorRecoveryManager(policy, processManager, logging.FromLogrus(logger))
 	_ = policy
 	_ = processManager
 
@@ -166,9 +167,9 @@ func printStats(errorRecovery claude.ErrorRecovery) {
 // 고급 사용 예시: 커스텀 에러 분류 규칙 추가
 func advancedUsage() {
 	logger := logrus.New()
-	processManager := claude.NewProcessManager(logger)
+	processManager := claude.NewProcessManager(logging.FromLogrus(logger))
 	policy := claude.DefaultRecoveryPolicy()
-	// errorRecovery := claude.NewErrorRecoveryManager(policy, processManager, logger)
+	// errorRecovery := claude.NewErrorRecoveryManager(policy, processManager, logging.FromLogrus(logger))
 	_ = policy
 	_ = processManager
 
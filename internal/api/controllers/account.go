@@ -0,0 +1,194 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// AccountController는 로컬 계정 본인 관리(프로필, 비밀번호 변경과 재설정) API를 처리합니다.
+type AccountController struct {
+	accounts *services.AccountService
+}
+
+// NewAccountController는 새로운 로컬 계정 컨트롤러를 생성합니다.
+func NewAccountController(accounts *services.AccountService) *AccountController {
+	return &AccountController{
+		accounts: accounts,
+	}
+}
+
+// GetProfile은 로그인한 사용자의 계정 정보를 조회합니다.
+// @Summary 내 계정 조회
+// @Tags account
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.Account "계정 정보"
+// @Failure 404 {object} models.ErrorResponse "로컬 계정이 아님"
+// @Router /account/me [get]
+func (ac *AccountController) GetProfile(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	account, err := ac.accounts.Get(c.Request.Context(), userID)
+	if err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+// UpdateProfile은 로그인한 사용자의 표시 이름과 이메일을 수정합니다.
+// @Summary 내 프로필 수정
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.AccountProfileUpdateRequest true "프로필 수정 요청"
+// @Success 200 {object} models.Account "수정된 계정 정보"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 409 {object} models.ErrorResponse "이미 사용 중인 이메일"
+// @Router /account/me [put]
+func (ac *AccountController) UpdateProfile(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	var req models.AccountProfileUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	account, err := ac.accounts.UpdateProfile(c.Request.Context(), userID, &req)
+	if err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+// ChangePassword는 현재 비밀번호를 확인한 뒤 비밀번호를 변경합니다.
+// @Summary 내 비밀번호 변경
+// @Description 새 비밀번호는 서버의 비밀번호 정책(길이, 대소문자, 숫자, 특수문자)을 만족해야 합니다
+// @Tags account
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.ChangePasswordRequest true "비밀번호 변경 요청"
+// @Success 204 "변경 완료"
+// @Failure 400 {object} models.ErrorResponse "비밀번호 정책 위반 또는 확인 불일치"
+// @Failure 401 {object} models.ErrorResponse "현재 비밀번호 불일치"
+// @Router /account/me/password [put]
+func (ac *AccountController) ChangePassword(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	var req models.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	if err := ac.accounts.ChangePassword(c.Request.Context(), userID, &req); err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RequestPasswordReset은 등록된 이메일로 비밀번호 재설정 토큰을 보냅니다.
+// @Summary 비밀번호 재설정 메일 요청
+// @Description 계정 존재 여부와 관계없이 같은 응답을 반환합니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.ResetPasswordRequest true "재설정 메일 요청"
+// @Success 202 "요청 접수"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /auth/password/forgot [post]
+func (ac *AccountController) RequestPasswordReset(c *gin.Context) {
+	var req models.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Email == "" {
+		middleware.ValidationError(c, "이메일이 필요합니다", nil)
+		return
+	}
+
+	if err := ac.accounts.RequestPasswordReset(c.Request.Context(), req.Email); err != nil {
+		middleware.InternalError(c, "비밀번호 재설정 요청 처리 실패", err.Error())
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// ResetPassword는 재설정 토큰으로 비밀번호를 변경하고 계정 잠금을 해제합니다.
+// @Summary 비밀번호 재설정
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body models.ConfirmResetPasswordRequest true "비밀번호 재설정 요청"
+// @Success 204 "재설정 완료"
+// @Failure 400 {object} models.ErrorResponse "토큰이 유효하지 않거나 비밀번호 정책 위반"
+// @Router /auth/password/reset [post]
+func (ac *AccountController) ResetPassword(c *gin.Context) {
+	var req models.ConfirmResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Token == "" {
+		middleware.ValidationError(c, "재설정 토큰이 필요합니다", nil)
+		return
+	}
+
+	if err := ac.accounts.ResetPassword(c.Request.Context(), &req); err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UnlockAccount는 로그인 실패로 잠긴 계정의 잠금을 해제합니다.
+// @Summary 계정 잠금 해제
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "계정 ID"
+// @Success 200 {object} models.Account "잠금 해제된 계정"
+// @Failure 404 {object} models.ErrorResponse "계정 없음"
+// @Router /admin/accounts/{id}/unlock [post]
+func (ac *AccountController) UnlockAccount(c *gin.Context) {
+	account, err := ac.accounts.Unlock(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		ac.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
+
+// handleError는 계정 서비스 에러를 HTTP 응답으로 변환합니다.
+func (ac *AccountController) handleError(c *gin.Context, err error) {
+	var policyErr validation.ValidationErrors
+	switch {
+	case errors.As(err, &policyErr):
+		middleware.ValidationError(c, "비밀번호 정책을 만족하지 않습니다", policyErr.Errors)
+	case errors.Is(err, services.ErrInvalidCredentials):
+		middleware.UnauthorizedError(c, "현재 비밀번호가 올바르지 않습니다")
+	case errors.Is(err, services.ErrPasswordMismatch):
+		middleware.ValidationError(c, "새 비밀번호와 확인 비밀번호가 일치하지 않습니다", nil)
+	case errors.Is(err, services.ErrPasswordReused):
+		middleware.ValidationError(c, "새 비밀번호는 현재 비밀번호와 달라야 합니다", nil)
+	case errors.Is(err, services.ErrInvalidResetToken):
+		middleware.AbortWithError(c, http.StatusBadRequest, "INVALID_RESET_TOKEN", "재설정 토큰이 유효하지 않거나 만료되었습니다", nil)
+	case storage.IsNotFoundError(err):
+		middleware.NotFoundError(c, "로컬 계정을 찾을 수 없습니다")
+	case storage.IsAlreadyExistsError(err):
+		middleware.ConflictError(c, "이미 사용 중인 이메일입니다")
+	default:
+		middleware.InternalError(c, "계정 처리 실패", err.Error())
+	}
+}
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// ActivityController는 워크스페이스/사용자 활동 피드 API를 처리합니다.
+type ActivityController struct {
+	activityService *services.ActivityService
+}
+
+// NewActivityController는 새로운 활동 피드 컨트롤러를 생성합니다.
+func NewActivityController(activityService *services.ActivityService) *ActivityController {
+	return &ActivityController{
+		activityService: activityService,
+	}
+}
+
+// ListWorkspaceActivity는 워크스페이스 활동 피드를 조회합니다.
+// @Summary 워크스페이스 활동 피드
+// @Description 태스크 종료, 검토 요청과 결정, 공유 변경을 최신순으로 반환하며, 각 항목의 읽음 여부와 안 읽은 항목 수를 함께 반환합니다 (read 권한 필요)
+// @Tags activity
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param kind query string false "활동 종류 (task, approval, membership, security)"
+// @Param unread query bool false "안 읽은 항목만"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Success 200 {object} models.ActivityFeedResponse "활동 피드"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Router /workspaces/{id}/activity [get]
+func (ac *ActivityController) ListWorkspaceActivity(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.ActivityListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "활동 조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	response, err := ac.activityService.WorkspaceFeed(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkWorkspaceActivityRead는 워크스페이스 활동 피드를 읽음으로 표시합니다.
+// @Summary 워크스페이스 활동 읽음 표시
+// @Description until까지(생략하면 지금까지) 생긴 항목을 읽음으로 표시합니다. 읽음 표시 시각은 앞으로만 움직입니다 (read 권한 필요)
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.ActivityReadRequest false "읽음 표시 시각"
+// @Success 200 {object} models.ActivityReadState "읽음 상태"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Router /workspaces/{id}/activity/read [post]
+func (ac *ActivityController) MarkWorkspaceActivityRead(c *gin.Context) {
+	ac.markRead(c, c.Param("id"))
+}
+
+// ListMyActivity는 로그인한 사용자의 개인 활동 피드를 조회합니다.
+// @Summary 개인 활동 피드
+// @Description 모든 워크스페이스에서 나에게 온 검토 요청, 공유/역할/그룹 변경, 계정 보안 알림을 최신순으로 반환합니다
+// @Tags activity
+// @Produce json
+// @Security BearerAuth
+// @Param kind query string false "활동 종류 (task, approval, membership, security)"
+// @Param unread query bool false "안 읽은 항목만"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Success 200 {object} models.ActivityFeedResponse "활동 피드"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Router /activity [get]
+func (ac *ActivityController) ListMyActivity(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.ActivityListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "활동 조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	response, err := ac.activityService.UserFeed(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkMyActivityRead는 개인 활동 피드를 읽음으로 표시합니다.
+// @Summary 개인 활동 읽음 표시
+// @Description until까지(생략하면 지금까지) 생긴 항목을 읽음으로 표시합니다
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.ActivityReadRequest false "읽음 표시 시각"
+// @Success 200 {object} models.ActivityReadState "읽음 상태"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /activity/read [post]
+func (ac *ActivityController) MarkMyActivityRead(c *gin.Context) {
+	ac.markRead(c, models.ActivityPersonalFeed)
+}
+
+// markRead 요청 본문의 시각까지 피드를 읽음으로 표시 (본문은 생략 가능)
+func (ac *ActivityController) markRead(c *gin.Context, feed string) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.ActivityReadRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.ValidationError(c, "읽음 표시 요청이 올바르지 않습니다", err.Error())
+			return
+		}
+	}
+
+	state, err := ac.activityService.MarkRead(c.Request.Context(), userClaims.UserID, feed, req.Until)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, state)
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// AdmissionController는 관리자용 호스트 자원 상태 API를 처리합니다.
+type AdmissionController struct {
+	admission *services.HostAdmissionService
+}
+
+// NewAdmissionController는 새로운 호스트 자원 상태 컨트롤러를 생성합니다.
+func NewAdmissionController(admission *services.HostAdmissionService) *AdmissionController {
+	return &AdmissionController{
+		admission: admission,
+	}
+}
+
+// GetAdmission은 호스트 자원 사용률과 실행을 기다리는 태스크를 조회합니다.
+// @Summary 호스트 자원 상태 조회
+// @Description 최근 CPU, 메모리, 디스크 사용률과 기준, 자원 부족으로 실행을 기다리는 태스크 목록을 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.HostAdmissionStatus "호스트 자원 상태"
+// @Router /admin/admission [get]
+func (ac *AdmissionController) GetAdmission(c *gin.Context) {
+	c.JSON(http.StatusOK, ac.admission.Status())
+}
+
+// handleHostOverloadedError는 호스트 자원 부족으로 거부된 요청이면 503과 Retry-After 헤더로 응답합니다.
+func handleHostOverloadedError(c *gin.Context, err error) bool {
+	var overloadedErr *services.HostOverloadedError
+	if !errors.As(err, &overloadedErr) {
+		return false
+	}
+
+	retryAfter := int(overloadedErr.RetryAfter.Seconds())
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	middleware.AbortWithError(c, http.StatusServiceUnavailable, "HOST_OVERLOADED", overloadedErr.Error(), gin.H{
+		"reasons":             overloadedErr.Reasons,
+		"retry_after_seconds": retryAfter,
+	})
+	return true
+}
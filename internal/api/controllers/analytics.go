@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// AnalyticsController는 관리자용 사용량 분석 대시보드 API를 처리합니다.
+type AnalyticsController struct {
+	analyticsService *services.AnalyticsService
+}
+
+// NewAnalyticsController는 새로운 사용량 분석 컨트롤러를 생성합니다.
+func NewAnalyticsController(analyticsService *services.AnalyticsService) *AnalyticsController {
+	return &AnalyticsController{
+		analyticsService: analyticsService,
+	}
+}
+
+// GetSummary는 기간 전체의 사용량 요약을 조회합니다.
+// @Summary 사용량 요약
+// @Description 기간 동안 끝난 태스크 수, 성공률, 평균 실행 시간, 비용, 활성 사용자 수, 최대 동시 실행 수를 반환합니다. 값은 analytics-rollup 작업이 갱신하는 일별 집계에서 읽으며 updated_at 이후에 끝난 태스크는 아직 반영되지 않았습니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "시작 날짜 (UTC, YYYY-MM-DD, 기본값: to를 포함한 30일 전)"
+// @Param to query string false "마지막 날짜 (UTC, YYYY-MM-DD, 포함, 기본값: 오늘)"
+// @Success 200 {object} models.AnalyticsSummary "사용량 요약"
+// @Failure 400 {object} models.ErrorResponse "잘못된 기간"
+// @Router /admin/analytics/summary [get]
+func (ac *AnalyticsController) GetSummary(c *gin.Context) {
+	req, ok := ac.bindRange(c)
+	if !ok {
+		return
+	}
+
+	summary, err := ac.analyticsService.Summary(c.Request.Context(), req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// GetDaily는 일별 사용량 추이를 조회합니다.
+// @Summary 일별 사용량 추이
+// @Description 날짜마다 끝난 태스크 수, 성공률, 평균 실행 시간, 비용, 활성 사용자 수, 최대 동시 실행 수를 반환합니다 (태스크가 없는 날도 0으로 포함)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "시작 날짜 (UTC, YYYY-MM-DD, 기본값: to를 포함한 30일 전)"
+// @Param to query string false "마지막 날짜 (UTC, YYYY-MM-DD, 포함, 기본값: 오늘)"
+// @Success 200 {object} models.AnalyticsDailyResponse "일별 사용량"
+// @Failure 400 {object} models.ErrorResponse "잘못된 기간"
+// @Router /admin/analytics/daily [get]
+func (ac *AnalyticsController) GetDaily(c *gin.Context) {
+	req, ok := ac.bindRange(c)
+	if !ok {
+		return
+	}
+
+	daily, err := ac.analyticsService.Daily(c.Request.Context(), req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, daily)
+}
+
+// GetTopWorkspaces는 비용이 많이 든 워크스페이스를 조회합니다.
+// @Summary 비용 상위 워크스페이스
+// @Description 기간 동안 claude 명령 비용이 많이 든 순서로 워크스페이스를 반환합니다 (비용이 같으면 태스크 수가 많은 순서)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "시작 날짜 (UTC, YYYY-MM-DD, 기본값: to를 포함한 30일 전)"
+// @Param to query string false "마지막 날짜 (UTC, YYYY-MM-DD, 포함, 기본값: 오늘)"
+// @Param limit query int false "최대 개수 (1~100)" default(10)
+// @Success 200 {object} models.AnalyticsTopWorkspacesResponse "비용 상위 워크스페이스"
+// @Failure 400 {object} models.ErrorResponse "잘못된 기간"
+// @Router /admin/analytics/workspaces [get]
+func (ac *AnalyticsController) GetTopWorkspaces(c *gin.Context) {
+	req, ok := ac.bindRange(c)
+	if !ok {
+		return
+	}
+
+	top, err := ac.analyticsService.TopWorkspaces(c.Request.Context(), req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, top)
+}
+
+// bindRange 조회 기간 파라미터 바인딩 (실패하면 응답을 쓰고 false 반환)
+func (ac *AnalyticsController) bindRange(c *gin.Context) (*models.AnalyticsRangeRequest, bool) {
+	var req models.AnalyticsRangeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "사용량 분석 조회 조건이 올바르지 않습니다", err.Error())
+		return nil, false
+	}
+	return &req, true
+}
@@ -0,0 +1,194 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/artifact"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// ArtifactController는 아티팩트 업로드/다운로드 API를 처리합니다.
+type ArtifactController struct {
+	artifactService *services.ArtifactService
+	maxUploadSize   int64
+}
+
+// NewArtifactController는 새로운 아티팩트 컨트롤러를 생성합니다.
+func NewArtifactController(artifactService *services.ArtifactService, maxUploadSize int64) *ArtifactController {
+	return &ArtifactController{
+		artifactService: artifactService,
+		maxUploadSize:   maxUploadSize,
+	}
+}
+
+// Upload는 파일을 아티팩트 저장소에 업로드합니다.
+// @Summary 파일 업로드
+// @Description 파일을 아티팩트 저장소에 저장하고 미리 서명된 다운로드 URL을 반환합니다
+// @Tags artifacts
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "업로드할 파일"
+// @Security BearerAuth
+// @Success 201 {object} services.ArtifactInfo "저장된 아티팩트"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 413 {object} models.ErrorResponse "파일 크기 초과"
+// @Router /artifacts [post]
+func (ac *ArtifactController) Upload(c *gin.Context) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		middleware.UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+		return
+	}
+	userClaims := claims.(*auth.Claims)
+
+	if ac.maxUploadSize > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, ac.maxUploadSize)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			middleware.AbortWithError(c, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE",
+				fmt.Sprintf("파일 크기는 %d 바이트를 초과할 수 없습니다", ac.maxUploadSize), nil)
+			return
+		}
+		middleware.ValidationError(c, "file 필드가 필요합니다", err.Error())
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		middleware.ValidationError(c, "파일을 열 수 없습니다", err.Error())
+		return
+	}
+	defer file.Close()
+
+	contentType := fileHeader.Header.Get("Content-Type")
+	info, err := ac.artifactService.Upload(c.Request.Context(), userClaims.UserID, fileHeader.Filename, file, fileHeader.Size, contentType)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, info)
+}
+
+// GetDownloadURL은 아티팩트의 미리 서명된 다운로드 URL을 발급합니다.
+// @Summary 다운로드 URL 발급
+// @Tags artifacts
+// @Produce json
+// @Param key query string true "아티팩트 키"
+// @Param redirect query bool false "true이면 다운로드 URL로 리다이렉트"
+// @Security BearerAuth
+// @Success 200 {object} services.ArtifactInfo "다운로드 URL"
+// @Success 302 "다운로드 URL로 리다이렉트"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /artifacts/url [get]
+func (ac *ArtifactController) GetDownloadURL(c *gin.Context) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		middleware.UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+		return
+	}
+	userClaims := claims.(*auth.Claims)
+
+	key := c.Query("key")
+	if key == "" {
+		middleware.ValidationError(c, "key 파라미터가 필요합니다", nil)
+		return
+	}
+
+	info, err := ac.artifactService.PresignURL(c.Request.Context(), userClaims.UserID, key, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	if c.Query("redirect") == "true" {
+		c.Redirect(http.StatusFound, info.DownloadURL)
+		return
+	}
+	c.JSON(http.StatusOK, info)
+}
+
+// ArchiveTaskOutput은 태스크 출력을 아카이브로 저장합니다.
+// @Summary 태스크 출력 아카이브
+// @Description 태스크 메타데이터와 출력을 tar.gz로 묶어 아티팩트 저장소에 저장합니다
+// @Tags tasks
+// @Produce json
+// @Param id path string true "태스크 ID"
+// @Security BearerAuth
+// @Success 201 {object} services.ArtifactInfo "저장된 아카이브"
+// @Failure 404 {object} models.ErrorResponse "태스크를 찾을 수 없음"
+// @Router /tasks/{id}/archive [post]
+func (ac *ArtifactController) ArchiveTaskOutput(c *gin.Context) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		middleware.UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+		return
+	}
+	userClaims := claims.(*auth.Claims)
+
+	info, err := ac.artifactService.ArchiveTaskOutput(c.Request.Context(), userClaims.UserID, c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, info)
+}
+
+// Download는 로컬 저장소의 아티팩트를 서명 검증 후 전송합니다.
+// 인증 대신 미리 서명된 URL의 서명과 만료 시간으로 접근을 제어합니다.
+// @Summary 아티팩트 다운로드 (로컬 저장소)
+// @Tags artifacts
+// @Produce application/octet-stream
+// @Param key path string true "아티팩트 키"
+// @Param expires query int true "만료 시각 (Unix)"
+// @Param signature query string true "서명"
+// @Success 200 {file} file "아티팩트"
+// @Failure 403 {object} models.ErrorResponse "잘못되었거나 만료된 서명"
+// @Router /artifacts/download/{key} [get]
+func (ac *ArtifactController) Download(c *gin.Context) {
+	local, ok := ac.artifactService.Store().(*artifact.LocalStore)
+	if !ok {
+		middleware.NotFoundError(c, "로컬 저장소를 사용하지 않습니다")
+		return
+	}
+
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	if err := local.Verify(key, c.Query("expires"), c.Query("signature")); err != nil {
+		middleware.ForbiddenError(c, "다운로드 URL이 올바르지 않거나 만료되었습니다")
+		return
+	}
+
+	r, err := local.Get(c.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, artifact.ErrNotFound) {
+			middleware.NotFoundError(c, "아티팩트를 찾을 수 없습니다")
+			return
+		}
+		middleware.InternalError(c, "아티팩트를 읽을 수 없습니다", err.Error())
+		return
+	}
+	defer r.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, path.Base(key)))
+	c.Header("Content-Type", contentType)
+	c.Status(http.StatusOK)
+	io.Copy(c.Writer, r)
+}
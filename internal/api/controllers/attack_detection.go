@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/security"
+)
+
+// AttackDetectionController는 관리자용 공격 탐지 임계값 API를 처리합니다.
+type AttackDetectionController struct {
+	detector   *security.AttackDetector
+	inspection bool
+}
+
+// NewAttackDetectionController는 새로운 공격 탐지 임계값 컨트롤러를 생성합니다.
+// inspection은 모든 요청을 공격 탐지기로 검사하는지 여부입니다.
+func NewAttackDetectionController(detector *security.AttackDetector, inspection bool) *AttackDetectionController {
+	return &AttackDetectionController{
+		detector:   detector,
+		inspection: inspection,
+	}
+}
+
+// GetAttackDetection는 공격 탐지 임계값을 조회합니다.
+// @Summary 공격 탐지 임계값 조회
+// @Description 기본 임계값, 라우트 그룹별 임계값, 탐지 기록 모드, 요청 검사 여부를 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.AttackDetectionStatus "공격 탐지 임계값"
+// @Router /admin/attack-detection [get]
+func (ac *AttackDetectionController) GetAttackDetection(c *gin.Context) {
+	c.JSON(http.StatusOK, ac.status())
+}
+
+// UpdateAttackDetection는 공격 탐지 임계값을 바꿉니다.
+// @Summary 공격 탐지 임계값 변경
+// @Description 다음 요청부터 적용하며 groups는 요청 값으로 교체됩니다. dry_run이면 탐지한 공격을 로그와 보안 이벤트로만 남기고 IP 차단, 요청 거부, 알림은 하지 않습니다. 설정을 다시 읽으면 설정 파일 값으로 돌아갑니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.AttackDetectionSettings true "공격 탐지 임계값"
+// @Success 200 {object} models.AttackDetectionStatus "변경된 공격 탐지 임계값"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/attack-detection [put]
+func (ac *AttackDetectionController) UpdateAttackDetection(c *gin.Context) {
+	var req models.AttackDetectionSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	settings := security.AttackDetectionSettings{
+		Defaults: security.AttackThresholds{
+			MinConfidence:       req.Defaults.MinConfidence,
+			BruteForceThreshold: req.Defaults.BruteForceThreshold,
+			BruteForceWindow:    time.Duration(req.Defaults.BruteForceWindowMS) * time.Millisecond,
+			AutoBlockEnabled:    req.Defaults.AutoBlock,
+			BlockDuration:       time.Duration(req.Defaults.BlockDurationMS) * time.Millisecond,
+			DryRun:              req.Defaults.DryRun,
+		},
+		Groups: make([]security.AttackRouteGroup, 0, len(req.Groups)),
+	}
+	for _, group := range req.Groups {
+		settings.Groups = append(settings.Groups, security.AttackRouteGroup{
+			Name:                group.Name,
+			PathPrefix:          group.PathPrefix,
+			MinConfidence:       group.MinConfidence,
+			BruteForceThreshold: group.BruteForceThreshold,
+			BruteForceWindow:    time.Duration(group.BruteForceWindowMS) * time.Millisecond,
+			BlockDuration:       time.Duration(group.BlockDurationMS) * time.Millisecond,
+			DisableAutoBlock:    group.DisableAutoBlock,
+			DryRun:              group.DryRun,
+		})
+	}
+	if err := ac.detector.SetSettings(settings); err != nil {
+		middleware.ValidationError(c, "공격 탐지 임계값이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, ac.status())
+}
+
+// status는 공격 탐지기 임계값을 API 응답으로 변환합니다.
+func (ac *AttackDetectionController) status() models.AttackDetectionStatus {
+	settings := ac.detector.Settings()
+	groups := make([]models.AttackRouteGroupSettings, 0, len(settings.Groups))
+	for _, group := range settings.Groups {
+		groups = append(groups, models.AttackRouteGroupSettings{
+			Name:                group.Name,
+			PathPrefix:          group.PathPrefix,
+			MinConfidence:       group.MinConfidence,
+			BruteForceThreshold: group.BruteForceThreshold,
+			BruteForceWindowMS:  group.BruteForceWindow.Milliseconds(),
+			BlockDurationMS:     group.BlockDuration.Milliseconds(),
+			DisableAutoBlock:    group.DisableAutoBlock,
+			DryRun:              group.DryRun,
+		})
+	}
+	return models.AttackDetectionStatus{
+		RequestInspection: ac.inspection,
+		Settings: models.AttackDetectionSettings{
+			Defaults: models.AttackThresholdSettings{
+				MinConfidence:       settings.Defaults.MinConfidence,
+				BruteForceThreshold: settings.Defaults.BruteForceThreshold,
+				BruteForceWindowMS:  settings.Defaults.BruteForceWindow.Milliseconds(),
+				AutoBlock:           settings.Defaults.AutoBlockEnabled,
+				BlockDurationMS:     settings.Defaults.BlockDuration.Milliseconds(),
+				DryRun:              settings.Defaults.DryRun,
+			},
+			Groups: groups,
+		},
+	}
+}
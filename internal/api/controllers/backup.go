@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/backup"
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+// BackupController는 관리자용 백업 API를 처리합니다.
+type BackupController struct {
+	manager *backup.Manager
+}
+
+// NewBackupController는 새로운 백업 컨트롤러를 생성합니다.
+func NewBackupController(manager *backup.Manager) *BackupController {
+	return &BackupController{
+		manager: manager,
+	}
+}
+
+// CreateBackup은 즉시 백업을 생성합니다.
+// @Summary 백업 생성
+// @Description 스토리지, 시크릿, 워크스페이스 메타데이터의 스냅샷을 즉시 생성합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 201 {object} backup.Snapshot "생성된 스냅샷"
+// @Failure 409 {object} models.ErrorResponse "다른 백업이 진행 중"
+// @Failure 500 {object} models.ErrorResponse "백업 실패"
+// @Router /admin/backups [post]
+func (bc *BackupController) CreateBackup(c *gin.Context) {
+	snapshot, err := bc.manager.Create(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, backup.ErrBackupInProgress) {
+			middleware.AbortWithError(c, http.StatusConflict, "BACKUP_IN_PROGRESS", "다른 백업이 진행 중입니다", nil)
+			return
+		}
+		middleware.InternalError(c, "백업 생성에 실패했습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusCreated, snapshot)
+}
+
+// ListBackups는 저장된 백업 목록을 조회합니다.
+// @Summary 백업 목록 조회
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} backup.Snapshot "스냅샷 목록 (최신순)"
+// @Router /admin/backups [get]
+func (bc *BackupController) ListBackups(c *gin.Context) {
+	snapshots, err := bc.manager.List(c.Request.Context())
+	if err != nil {
+		middleware.InternalError(c, "백업 목록 조회에 실패했습니다", err.Error())
+		return
+	}
+	if snapshots == nil {
+		snapshots = []backup.Snapshot{}
+	}
+
+	c.JSON(http.StatusOK, snapshots)
+}
+
+// VerifyBackup은 백업의 무결성을 검증합니다.
+// @Summary 백업 무결성 검증
+// @Tags admin
+// @Produce json
+// @Param id path string true "스냅샷 ID"
+// @Security BearerAuth
+// @Success 200 {object} backup.Manifest "검증된 매니페스트"
+// @Failure 404 {object} models.ErrorResponse "백업을 찾을 수 없음"
+// @Failure 422 {object} models.ErrorResponse "체크섬 불일치"
+// @Router /admin/backups/{id}/verify [post]
+func (bc *BackupController) VerifyBackup(c *gin.Context) {
+	manifest, err := bc.manager.Verify(c.Request.Context(), c.Param("id"))
+	switch {
+	case err == nil:
+		c.JSON(http.StatusOK, manifest)
+	case errors.Is(err, backup.ErrInvalidSnapshotID), errors.Is(err, backup.ErrObjectNotFound):
+		middleware.NotFoundError(c, "백업을 찾을 수 없습니다")
+	case errors.Is(err, backup.ErrChecksumMismatch):
+		middleware.AbortWithError(c, http.StatusUnprocessableEntity, "BACKUP_CORRUPTED", "백업 무결성 검증에 실패했습니다", err.Error())
+	default:
+		middleware.InternalError(c, "백업 검증에 실패했습니다", err.Error())
+	}
+}
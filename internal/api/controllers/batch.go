@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// BatchController는 일괄 처리 API를 처리합니다.
+type BatchController struct {
+	batchService *services.BatchService
+	authorizer   middleware.WorkspaceAuthorizer
+}
+
+// NewBatchController는 새로운 일괄 처리 컨트롤러를 생성합니다.
+func NewBatchController(batchService *services.BatchService, authorizer middleware.WorkspaceAuthorizer) *BatchController {
+	return &BatchController{
+		batchService: batchService,
+		authorizer:   authorizer,
+	}
+}
+
+// BatchDeleteWorkspaces는 여러 워크스페이스를 한 번에 삭제합니다.
+// @Summary 워크스페이스 일괄 삭제
+// @Description 여러 워크스페이스를 삭제합니다. 항목별 결과를 반환하며 atomic=true이면 전체 성공 또는 전체 롤백합니다
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Param body body models.BatchDeleteRequest true "일괄 삭제 요청"
+// @Security BearerAuth
+// @Success 200 {object} models.BatchResult "모든 항목 성공"
+// @Success 207 {object} models.BatchResult "일부 항목 실패"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 401 {object} models.ErrorResponse "인증 실패"
+// @Router /workspaces:batchDelete [post]
+func (bc *BatchController) BatchDeleteWorkspaces(c *gin.Context) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		middleware.UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+		return
+	}
+	userClaims := claims.(*auth.Claims)
+
+	var req models.BatchDeleteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	result, err := bc.batchService.BatchDeleteWorkspaces(c.Request.Context(), &req, userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(batchStatusCode(result), result)
+}
+
+// BatchCreateTasks는 여러 태스크를 한 번에 생성합니다.
+// @Summary 태스크 일괄 생성
+// @Description 여러 태스크를 생성합니다. 항목별 결과를 반환하며 atomic=true이면 전체 성공 또는 전체 롤백합니다
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param body body models.BatchCreateTasksRequest true "일괄 생성 요청"
+// @Security BearerAuth
+// @Success 201 {object} models.BatchResult "모든 항목 성공"
+// @Success 207 {object} models.BatchResult "일부 항목 실패"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 401 {object} models.ErrorResponse "인증 실패"
+// @Failure 403 {object} models.ErrorResponse "세션의 워크스페이스에 execute 권한 없음"
+// @Failure 503 {object} models.ErrorResponse "유지보수 모드 (Retry-After 헤더 포함)"
+// @Router /tasks:batchCreate [post]
+func (bc *BatchController) BatchCreateTasks(c *gin.Context) {
+	var req models.BatchCreateTasksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	// 모든 세션의 워크스페이스에 execute 권한이 있어야 함 (시스템 admin 제외)
+	if role, _ := middleware.GetUserRole(c); role != "admin" && bc.authorizer != nil {
+		userID, _ := middleware.GetUserID(c)
+		checked := make(map[string]bool)
+		for _, task := range req.Tasks {
+			if checked[task.SessionID] {
+				continue
+			}
+			checked[task.SessionID] = true
+			if _, err := bc.authorizer.AuthorizeSession(c.Request.Context(), task.SessionID, userID, models.WorkspacePermissionExecute); err != nil {
+				middleware.HandleServiceError(c, err)
+				return
+			}
+		}
+	}
+
+	// 동시 실행 세션 한도는 요청한 사용자에게 셈
+	userID, _ := middleware.GetUserID(c)
+	for i := range req.Tasks {
+		req.Tasks[i].UserID = userID
+	}
+
+	result, err := bc.batchService.BatchCreateTasks(c.Request.Context(), &req)
+	if err != nil {
+		if handleMaintenanceError(c, err) || handleDiskQuotaError(c, err) || handleHostOverloadedError(c, err) || handleSessionLimitError(c, err) {
+			return
+		}
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	status := batchStatusCode(result)
+	if status == http.StatusOK {
+		status = http.StatusCreated
+	}
+	c.JSON(status, result)
+}
+
+// batchStatusCode는 일괄 처리 결과에 맞는 HTTP 상태 코드를 결정합니다.
+func batchStatusCode(result *models.BatchResult) int {
+	switch {
+	case !result.HasFailures():
+		return http.StatusOK
+	case result.Succeeded == 0 && result.RolledBack:
+		return http.StatusConflict
+	default:
+		return http.StatusMultiStatus
+	}
+}
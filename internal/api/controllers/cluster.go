@@ -0,0 +1,38 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/cluster"
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+// ClusterController는 관리자용 다중 레플리카 상태 API를 처리합니다.
+type ClusterController struct {
+	cluster *cluster.Cluster
+}
+
+// NewClusterController는 새로운 클러스터 컨트롤러를 생성합니다.
+func NewClusterController(c *cluster.Cluster) *ClusterController {
+	return &ClusterController{
+		cluster: c,
+	}
+}
+
+// GetStatus는 현재 인스턴스와 역할별 리더를 조회합니다.
+// @Summary 클러스터 상태 조회
+// @Description 요청을 처리한 인스턴스의 식별자와 백그라운드 작업 예약, 에러 추세 분석 등 역할별 리더 인스턴스를 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} cluster.Status "클러스터 상태"
+// @Router /admin/cluster [get]
+func (cc *ClusterController) GetStatus(c *gin.Context) {
+	status, err := cc.cluster.Status(c.Request.Context())
+	if err != nil {
+		middleware.InternalError(c, "클러스터 상태 조회에 실패했습니다", err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/security"
+)
+
+// maxCSPReportBodyBytes는 위반 보고 요청 본문의 최대 크기입니다.
+const maxCSPReportBodyBytes = 64 * 1024
+
+// defaultCSPReportLimit는 위반 보고 조회의 기본 최대 개수입니다.
+const defaultCSPReportLimit = 100
+
+// CSPReportController는 Content-Security-Policy 위반 보고 수집과 조회를 처리합니다.
+type CSPReportController struct {
+	store *security.CSPReportStore
+}
+
+// NewCSPReportController는 새로운 CSP 위반 보고 컨트롤러를 생성합니다.
+func NewCSPReportController(store *security.CSPReportStore) *CSPReportController {
+	return &CSPReportController{store: store}
+}
+
+// CollectReport는 브라우저가 보낸 CSP 위반 보고를 보관합니다.
+// @Summary CSP 위반 보고 수집
+// @Description 브라우저가 report-uri(application/csp-report)나 Reporting API(application/reports+json)로 보낸 위반 보고를 보안 대시보드용으로 보관합니다. 인증이 필요 없으며 본문은 64KB까지 받습니다
+// @Tags security
+// @Accept json
+// @Success 204 "보관함"
+// @Failure 400 {object} models.ErrorResponse "잘못된 보고"
+// @Failure 413 {object} models.ErrorResponse "본문이 너무 큼"
+// @Router /csp-report [post]
+func (cc *CSPReportController) CollectReport(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxCSPReportBodyBytes+1))
+	if err != nil {
+		middleware.BadRequestError(c, "위반 보고를 읽을 수 없습니다")
+		return
+	}
+	if len(body) > maxCSPReportBodyBytes {
+		middleware.AbortWithError(c, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", "위반 보고가 너무 큽니다", nil)
+		return
+	}
+
+	reports, err := security.ParseCSPReports(c.GetHeader("Content-Type"), body)
+	if err != nil {
+		middleware.ValidationError(c, "위반 보고가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	for _, report := range reports {
+		report.IPAddress = c.ClientIP()
+		if report.UserAgent == "" {
+			report.UserAgent = c.Request.UserAgent()
+		}
+		cc.store.Add(report)
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListReports는 보관 중인 CSP 위반 보고와 집계를 조회합니다.
+// @Summary CSP 위반 보고 조회
+// @Description 최근 위반 보고를 최신순으로, 지시문과 차단된 주소별 집계를 많은 것부터 반환합니다. 보고는 메모리에 보관하므로 재시작하면 사라집니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param directive query string false "위반한 지시문 (예: script-src-elem)"
+// @Param disposition query string false "정책 적용 방식 (enforce, report)"
+// @Param since query string false "이후에 받은 보고만 (RFC3339)"
+// @Param limit query int false "최대 개수 (기본 100)"
+// @Success 200 {object} models.CSPReportList "위반 보고 목록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Router /admin/csp-reports [get]
+func (cc *CSPReportController) ListReports(c *gin.Context) {
+	var filter models.CSPReportFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultCSPReportLimit
+	}
+
+	reports := cc.store.List(security.CSPReportFilter{
+		Directive:   filter.Directive,
+		Disposition: filter.Disposition,
+		Since:       filter.Since,
+		Limit:       filter.Limit,
+	})
+	received, dropped, _ := cc.store.Stats()
+
+	list := models.CSPReportList{
+		Received:   received,
+		Dropped:    dropped,
+		MaxReports: cc.store.MaxReports(),
+		Summary:    make([]models.CSPViolationSummary, 0),
+		Reports:    make([]models.CSPReport, 0, len(reports)),
+	}
+	for _, summary := range cc.store.Summary() {
+		list.Summary = append(list.Summary, models.CSPViolationSummary{
+			EffectiveDirective: summary.EffectiveDirective,
+			BlockedURI:         summary.BlockedURI,
+			Count:              summary.Count,
+			LastSeen:           summary.LastSeen,
+		})
+	}
+	for _, report := range reports {
+		list.Reports = append(list.Reports, models.CSPReport{
+			ID:                 report.ID,
+			DocumentURI:        report.DocumentURI,
+			Referrer:           report.Referrer,
+			BlockedURI:         report.BlockedURI,
+			EffectiveDirective: report.EffectiveDirective,
+			ViolatedDirective:  report.ViolatedDirective,
+			OriginalPolicy:     report.OriginalPolicy,
+			Disposition:        report.Disposition,
+			SourceFile:         report.SourceFile,
+			LineNumber:         report.LineNumber,
+			ColumnNumber:       report.ColumnNumber,
+			StatusCode:         report.StatusCode,
+			ScriptSample:       report.ScriptSample,
+			IPAddress:          report.IPAddress,
+			UserAgent:          report.UserAgent,
+			ReceivedAt:         report.ReceivedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+// CacheController는 워크스페이스 의존성 캐시 조회/삭제 API를 처리합니다.
+type CacheController struct {
+	cacheManager *docker.CacheManager
+}
+
+// NewCacheController는 새로운 의존성 캐시 컨트롤러를 생성합니다.
+func NewCacheController(cacheManager *docker.CacheManager) *CacheController {
+	return &CacheController{
+		cacheManager: cacheManager,
+	}
+}
+
+// ListWorkspaceCaches는 워크스페이스의 의존성 캐시 목록을 조회합니다.
+// @Summary 워크스페이스 의존성 캐시 목록 조회
+// @Tags caches
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {array} docker.CacheEntry "캐시 목록"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/caches [get]
+func (cc *CacheController) ListWorkspaceCaches(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	entries, err := cc.cacheManager.List(c.Request.Context(), workspaceID)
+	if err != nil {
+		middleware.InternalError(c, "캐시 목록 조회에 실패했습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// PurgeWorkspaceCaches는 워크스페이스의 모든 의존성 캐시를 삭제합니다.
+// @Summary 워크스페이스 의존성 캐시 전체 삭제
+// @Description 컨테이너가 실행 중이면 force=true일 때만 삭제합니다
+// @Tags caches
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param force query bool false "사용 중인 캐시도 삭제"
+// @Security BearerAuth
+// @Success 200 {array} docker.CacheEntry "삭제된 캐시"
+// @Failure 409 {object} models.ErrorResponse "캐시 사용 중"
+// @Router /workspaces/{id}/caches [delete]
+func (cc *CacheController) PurgeWorkspaceCaches(c *gin.Context) {
+	cc.purge(c, "")
+}
+
+// PurgeWorkspaceCache는 워크스페이스의 특정 의존성 캐시를 삭제합니다.
+// @Summary 워크스페이스 의존성 캐시 삭제
+// @Tags caches
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param name path string true "캐시 이름 (node_modules, venv, gomod 등)"
+// @Param force query bool false "사용 중인 캐시도 삭제"
+// @Security BearerAuth
+// @Success 200 {array} docker.CacheEntry "삭제된 캐시"
+// @Failure 404 {object} models.ErrorResponse "캐시를 찾을 수 없음"
+// @Failure 409 {object} models.ErrorResponse "캐시 사용 중"
+// @Router /workspaces/{id}/caches/{name} [delete]
+func (cc *CacheController) PurgeWorkspaceCache(c *gin.Context) {
+	cc.purge(c, c.Param("name"))
+}
+
+func (cc *CacheController) purge(c *gin.Context, name string) {
+	workspaceID := c.Param("id")
+
+	removed, err := cc.cacheManager.Purge(c.Request.Context(), workspaceID, name, c.Query("force") == "true")
+	if err != nil {
+		switch {
+		case errors.Is(err, docker.ErrCacheNotFound):
+			middleware.NotFoundError(c, "캐시를 찾을 수 없습니다")
+		case errors.Is(err, docker.ErrCacheInUse):
+			middleware.ConflictError(c, "컨테이너가 사용 중인 캐시입니다. force=true로 다시 요청하세요")
+		default:
+			middleware.InternalError(c, "캐시 삭제에 실패했습니다", err.Error())
+		}
+		return
+	}
+
+	if removed == nil {
+		removed = []docker.CacheEntry{}
+	}
+	c.JSON(http.StatusOK, removed)
+}
+
+// GetCacheUsage는 전체 의존성 캐시 사용량을 조회합니다.
+// @Summary 의존성 캐시 사용량 조회
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} docker.CacheUsage "캐시 사용량"
+// @Router /admin/caches [get]
+func (cc *CacheController) GetCacheUsage(c *gin.Context) {
+	usage, err := cc.cacheManager.Usage(c.Request.Context())
+	if err != nil {
+		middleware.InternalError(c, "캐시 사용량 조회에 실패했습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
+
+// EnforceCacheQuota는 크기 상한을 넘은 유휴 캐시를 LRU 순서로 정리합니다.
+// @Summary 의존성 캐시 용량 정리
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} docker.CacheEntry "제거된 캐시"
+// @Router /admin/caches/evict [post]
+func (cc *CacheController) EnforceCacheQuota(c *gin.Context) {
+	evicted, err := cc.cacheManager.EnforceQuota(c.Request.Context())
+	if err != nil {
+		middleware.InternalError(c, "캐시 정리에 실패했습니다", err.Error())
+		return
+	}
+
+	if evicted == nil {
+		evicted = []docker.CacheEntry{}
+	}
+	c.JSON(http.StatusOK, evicted)
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/email"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// EmailController는 관리자용 메일 발송 기록과 재시도 API를 처리합니다.
+type EmailController struct {
+	mailer *email.Mailer
+}
+
+// NewEmailController는 새로운 메일 발송 컨트롤러를 생성합니다.
+func NewEmailController(mailer *email.Mailer) *EmailController {
+	return &EmailController{
+		mailer: mailer,
+	}
+}
+
+// ListDeliveries는 메일 발송 기록을 조회합니다.
+// @Summary 메일 발송 기록 조회
+// @Description 초대, 비밀번호 재설정, 예산 알림, 보안 알림 메일의 발송 상태와 마지막 에러를 최신순으로 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "발송 상태 (pending, sent, failed)"
+// @Param template query string false "템플릿 이름"
+// @Param to query string false "받는 사람"
+// @Param limit query int false "최대 개수 (기본 100)"
+// @Success 200 {array} models.EmailDelivery "발송 기록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Router /admin/email/deliveries [get]
+func (ec *EmailController) ListDeliveries(c *gin.Context) {
+	var filter models.EmailDeliveryFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	deliveries, err := ec.mailer.List(c.Request.Context(), &filter)
+	if err != nil {
+		middleware.InternalError(c, "메일 발송 기록 조회 실패", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// RetryDelivery는 발송에 실패한 메일을 다시 대기열에 넣습니다.
+// @Summary 메일 다시 보내기
+// @Description 시도 횟수를 새로 세어 다시 보냅니다. 이미 발송한 메일은 다시 보낼 수 없습니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "발송 기록 ID"
+// @Success 202 {object} models.EmailDelivery "대기열에 넣은 발송 기록"
+// @Failure 404 {object} models.ErrorResponse "발송 기록 없음"
+// @Failure 409 {object} models.ErrorResponse "이미 발송한 메일"
+// @Router /admin/email/deliveries/{id}/retry [post]
+func (ec *EmailController) RetryDelivery(c *gin.Context) {
+	delivery, err := ec.mailer.Retry(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		switch {
+		case storage.IsNotFoundError(err):
+			middleware.NotFoundError(c, "메일 발송 기록을 찾을 수 없습니다")
+		case errors.Is(err, email.ErrAlreadySent):
+			middleware.ConflictError(c, "이미 발송한 메일입니다")
+		default:
+			middleware.InternalError(c, "메일 재발송 실패", err.Error())
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+// SendTestEmail은 메일 발송 설정을 확인할 테스트 메일을 보냅니다.
+// @Summary 테스트 메일 보내기
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.EmailTestRequest true "테스트 메일 요청"
+// @Success 202 {object} models.EmailDelivery "대기열에 넣은 발송 기록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/email/test [post]
+func (ec *EmailController) SendTestEmail(c *gin.Context) {
+	var req models.EmailTestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	username, _ := middleware.GetUsername(c)
+	delivery, err := ec.mailer.Send(c.Request.Context(), req.To, email.TemplateSecurityAlert, &email.SecurityAlertData{
+		Title:    "메일 발송 설정 확인",
+		Message:  "관리자가 메일 발송 설정을 확인하려고 보낸 테스트 메일입니다.",
+		Username: username,
+	})
+	if err != nil {
+		middleware.InternalError(c, "테스트 메일 발송 실패", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
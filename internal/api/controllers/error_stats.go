@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// ErrorStatsController는 관리자용 에러 통계 API를 처리합니다.
+type ErrorStatsController struct {
+	collector *claude.ErrorStatisticsCollector
+	trend     *claude.ErrorTrendMonitor
+}
+
+// NewErrorStatsController는 새로운 에러 통계 컨트롤러를 생성합니다.
+func NewErrorStatsController(collector *claude.ErrorStatisticsCollector) *ErrorStatsController {
+	return &ErrorStatsController{
+		collector: collector,
+	}
+}
+
+// SetTrendMonitor는 에러 추세 분석 모니터를 설정합니다.
+func (ec *ErrorStatsController) SetTrendMonitor(monitor *claude.ErrorTrendMonitor) {
+	ec.trend = monitor
+}
+
+// ErrorStatsResponse는 에러 통계 시계열 응답입니다.
+type ErrorStatsResponse struct {
+	Granularity models.ErrorStatGranularity `json:"granularity"`
+	From        time.Time                   `json:"from"`
+	To          time.Time                   `json:"to"`
+	Series      []models.ErrorStatSeries    `json:"series"`
+	Recoveries  []claude.RecoveryStat       `json:"recoveries"`
+}
+
+// GetErrorStats는 에러 유형별 발생 횟수 시계열을 조회합니다.
+// @Summary 에러 통계 조회
+// @Description 시간별 또는 일별 에러 발생 횟수를 유형, 심각도, 분류별 시계열로 반환하고 서버 시작 후 복구 시도 결과를 함께 반환합니다
+// @Tags admin
+// @Produce json
+// @Param granularity query string false "집계 단위 (hour, day)" default(hour)
+// @Param from query string false "시작 시각 (RFC3339, 기본값: hour는 24시간 전, day는 30일 전)"
+// @Param to query string false "종료 시각 (RFC3339, 기본값: 현재)"
+// @Security BearerAuth
+// @Success 200 {object} ErrorStatsResponse "에러 통계 시계열"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/errors/stats [get]
+func (ec *ErrorStatsController) GetErrorStats(c *gin.Context) {
+	granularity := models.ErrorStatGranularity(c.DefaultQuery("granularity", string(models.ErrorStatHourly)))
+	if !granularity.IsValid() {
+		middleware.ValidationError(c, "집계 단위는 hour 또는 day여야 합니다", nil)
+		return
+	}
+
+	to := time.Now().UTC()
+	if value := c.Query("to"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			middleware.ValidationError(c, "to는 RFC3339 형식이어야 합니다", err.Error())
+			return
+		}
+		to = parsed.UTC()
+	}
+
+	from := to.Add(-24 * time.Hour)
+	if granularity == models.ErrorStatDaily {
+		from = to.AddDate(0, 0, -30)
+	}
+	if value := c.Query("from"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			middleware.ValidationError(c, "from은 RFC3339 형식이어야 합니다", err.Error())
+			return
+		}
+		from = parsed.UTC()
+	}
+	if !from.Before(to) {
+		middleware.ValidationError(c, "from은 to보다 이전이어야 합니다", nil)
+		return
+	}
+
+	series, err := ec.collector.Series(c.Request.Context(), granularity, from, to)
+	if err != nil {
+		middleware.InternalError(c, "에러 통계 조회에 실패했습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, ErrorStatsResponse{
+		Granularity: granularity,
+		From:        from,
+		To:          to,
+		Series:      series,
+		Recoveries:  ec.collector.RecoveryStats(),
+	})
+}
+
+// GetErrorTrend는 에러 발생 추세 분석 결과를 조회합니다.
+// @Summary 에러 추세 조회
+// @Description 시간별 에러 수로 다음 한 시간의 에러 수를 예측하고 이상 여부와 발생한 알림을 반환합니다
+// @Tags admin
+// @Produce json
+// @Param refresh query bool false "마지막 분석 결과 대신 지금 다시 분석" default(false)
+// @Security BearerAuth
+// @Success 200 {object} claude.ErrorTrendReport "에러 추세 분석 결과"
+// @Failure 404 {object} models.ErrorResponse "추세 분석 비활성"
+// @Router /admin/errors/trend [get]
+func (ec *ErrorStatsController) GetErrorTrend(c *gin.Context) {
+	if ec.trend == nil {
+		middleware.NotFoundError(c, "에러 추세 분석이 비활성화되어 있습니다")
+		return
+	}
+
+	report := ec.trend.LastReport()
+	if report == nil || c.Query("refresh") == "true" {
+		var err error
+		report, err = ec.trend.Evaluate(c.Request.Context(), time.Now().UTC())
+		if err != nil {
+			middleware.InternalError(c, "에러 추세 분석에 실패했습니다", err.Error())
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// FanOutController는 여러 워크스페이스에 같은 프롬프트를 실행하는 팬아웃 API를 처리합니다.
+type FanOutController struct {
+	fanOuts *services.FanOutService
+}
+
+// NewFanOutController는 새로운 팬아웃 컨트롤러를 생성합니다.
+func NewFanOutController(fanOuts *services.FanOutService) *FanOutController {
+	return &FanOutController{
+		fanOuts: fanOuts,
+	}
+}
+
+// ListFanOuts는 사용자의 팬아웃 목록을 조회합니다.
+// @Summary 팬아웃 목록 조회
+// @Tags fanouts
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.FanOut "팬아웃 목록 (최근 50개)"
+// @Router /fanouts [get]
+func (fc *FanOutController) ListFanOuts(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	fanOuts, err := fc.fanOuts.List(c.Request.Context(), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fanOuts)
+}
+
+// StartFanOut은 여러 워크스페이스에서 같은 프롬프트를 병렬로 실행합니다.
+// @Summary 팬아웃 시작
+// @Description 워크스페이스마다 지정한 세션(없으면 가장 최근 활성 세션)에 태스크를 제출하며, 동시에 concurrency개까지 실행합니다
+// @Tags fanouts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.FanOutRequest true "팬아웃 요청"
+// @Success 201 {object} models.FanOut "시작된 팬아웃"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "워크스페이스 실행 권한 없음"
+// @Router /fanouts [post]
+func (fc *FanOutController) StartFanOut(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.FanOutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	fanOut, err := fc.fanOuts.Start(c.Request.Context(), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, fanOut)
+}
+
+// GetFanOut은 팬아웃 진행 상황과 워크스페이스별 결과를 조회합니다.
+// @Summary 팬아웃 조회
+// @Tags fanouts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "팬아웃 ID"
+// @Success 200 {object} models.FanOut "팬아웃"
+// @Failure 404 {object} models.ErrorResponse "팬아웃을 찾을 수 없음"
+// @Router /fanouts/{id} [get]
+func (fc *FanOutController) GetFanOut(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	fanOut, err := fc.fanOuts.Get(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fanOut)
+}
+
+// CancelFanOut은 실행 중인 팬아웃을 취소합니다.
+// @Summary 팬아웃 취소
+// @Description 대기 중인 워크스페이스는 실행하지 않고, 실행 중인 태스크는 취소합니다
+// @Tags fanouts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "팬아웃 ID"
+// @Success 200 {object} models.FanOut "취소된 팬아웃"
+// @Failure 400 {object} models.ErrorResponse "실행 중이 아님"
+// @Failure 404 {object} models.ErrorResponse "팬아웃을 찾을 수 없음"
+// @Router /fanouts/{id}/cancel [post]
+func (fc *FanOutController) CancelFanOut(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	fanOut, err := fc.fanOuts.Cancel(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, fanOut)
+}
+
+// GetReport는 워크스페이스별 결과를 모은 마크다운 보고서를 반환합니다.
+// @Summary 팬아웃 통합 보고서
+// @Description 실행 중이면 현재까지의 결과를 반환합니다. 완료된 보고서는 report_key의 아티팩트로도 저장됩니다
+// @Tags fanouts
+// @Produce text/markdown
+// @Security BearerAuth
+// @Param id path string true "팬아웃 ID"
+// @Success 200 {string} string "마크다운 보고서"
+// @Failure 404 {object} models.ErrorResponse "팬아웃을 찾을 수 없음"
+// @Router /fanouts/{id}/report [get]
+func (fc *FanOutController) GetReport(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	report, err := fc.fanOuts.Report(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(report))
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/chaos"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// FaultInjectionController는 관리자용 장애 주입 테스트 모드 API를 처리합니다.
+// 장애 주입은 production이 아닌 환경에서 chaos.enabled로 켰을 때만 라우트가 등록됩니다.
+type FaultInjectionController struct {
+	injector *chaos.Injector
+}
+
+// NewFaultInjectionController는 새로운 장애 주입 컨트롤러를 생성합니다.
+func NewFaultInjectionController(injector *chaos.Injector) *FaultInjectionController {
+	return &FaultInjectionController{
+		injector: injector,
+	}
+}
+
+// GetFaultInjection는 장애 주입 상태를 조회합니다.
+// @Summary 장애 주입 상태 조회
+// @Description 현재 주입하는 장애의 확률과 시간, 시작 후 실제로 주입한 장애 수를 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.FaultInjectionStatus "장애 주입 상태"
+// @Router /admin/fault-injection [get]
+func (fc *FaultInjectionController) GetFaultInjection(c *gin.Context) {
+	c.JSON(http.StatusOK, fc.status())
+}
+
+// UpdateFaultInjection는 주입할 장애를 바꿉니다.
+// @Summary 장애 주입 변경
+// @Description 스토리지 호출 지연, Claude 프로세스 강제 종료, WebSocket 프레임 누락 확률을 바꿉니다. 확률을 0으로 두면 해당 장애를 주입하지 않습니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.FaultInjectionFaults true "주입할 장애"
+// @Success 200 {object} models.FaultInjectionStatus "변경된 장애 주입 상태"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/fault-injection [put]
+func (fc *FaultInjectionController) UpdateFaultInjection(c *gin.Context) {
+	var req models.FaultInjectionFaults
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	faults := chaos.Faults{
+		StorageDelay: chaos.StorageDelay{
+			Probability: req.StorageDelay.Probability,
+			Min:         time.Duration(req.StorageDelay.MinDelayMS) * time.Millisecond,
+			Max:         time.Duration(req.StorageDelay.MaxDelayMS) * time.Millisecond,
+		},
+		ProcessKill: chaos.ProcessKill{
+			Probability: req.ProcessKill.Probability,
+			After:       time.Duration(req.ProcessKill.AfterMS) * time.Millisecond,
+		},
+		FrameDrop: chaos.FrameDrop{Probability: req.FrameDrop.Probability},
+	}
+	if err := fc.injector.SetFaults(faults); err != nil {
+		middleware.ValidationError(c, "장애 주입 설정이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, fc.status())
+}
+
+// status는 장애 주입기 상태를 API 응답으로 변환합니다.
+func (fc *FaultInjectionController) status() models.FaultInjectionStatus {
+	faults := fc.injector.Faults()
+	counts := fc.injector.Counts()
+	return models.FaultInjectionStatus{
+		Faults: models.FaultInjectionFaults{
+			StorageDelay: models.StorageDelayFault{
+				Probability: faults.StorageDelay.Probability,
+				MinDelayMS:  faults.StorageDelay.Min.Milliseconds(),
+				MaxDelayMS:  faults.StorageDelay.Max.Milliseconds(),
+			},
+			ProcessKill: models.ProcessKillFault{
+				Probability: faults.ProcessKill.Probability,
+				AfterMS:     faults.ProcessKill.After.Milliseconds(),
+			},
+			FrameDrop: models.FrameDropFault{Probability: faults.FrameDrop.Probability},
+		},
+		Injected: models.FaultInjectionCounts{
+			StorageDelays: counts.StorageDelays,
+			ProcessKills:  counts.ProcessKills,
+			FramesDropped: counts.FramesDropped,
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/graphql"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// GraphQLController는 대시보드용 GraphQL 엔드포인트를 처리합니다.
+// 필드 단위 권한은 서비스에서 워크스페이스 ACL로 확인합니다.
+type GraphQLController struct {
+	graphql *services.GraphQLService
+}
+
+// NewGraphQLController는 새로운 GraphQL 컨트롤러를 생성합니다.
+func NewGraphQLController(graphql *services.GraphQLService) *GraphQLController {
+	return &GraphQLController{
+		graphql: graphql,
+	}
+}
+
+// Handle은 GraphQL 쿼리와 구독을 실행합니다.
+// @Summary GraphQL 쿼리/구독
+// @Description 워크스페이스, 프로젝트, 세션, 태스크, 사용량을 한 번의 쿼리로 중첩 조회합니다.
+// @Description POST 본문 또는 GET 쿼리 파라미터(query, operationName, variables)로 요청합니다.
+// @Description Accept: text/event-stream이면 구독(taskEvents)을 실행해 결과마다 next 이벤트를, 스트림이 끝나면 complete 이벤트를 보냅니다.
+// @Description 권한이 없는 필드는 null과 FORBIDDEN 코드의 에러로 응답합니다.
+// @Tags graphql
+// @Accept json
+// @Produce json,text/event-stream
+// @Security BearerAuth
+// @Param request body graphql.Request false "GraphQL 요청"
+// @Success 200 {object} graphql.Result "실행 결과"
+// @Failure 400 {object} graphql.Result "잘못된 쿼리"
+// @Router /graphql [post]
+func (gc *GraphQLController) Handle(c *gin.Context) {
+	claims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	var req graphql.Request
+	if c.Request.Method == http.MethodGet {
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				middleware.ValidationError(c, "variables는 JSON 객체여야 합니다", err.Error())
+				return
+			}
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 GraphQL 요청", err.Error())
+		return
+	}
+
+	viewer := services.GraphQLViewer{UserID: claims.UserID, Admin: claims.Role == "admin"}
+	if !strings.Contains(c.GetHeader("Accept"), "text/event-stream") {
+		result := gc.graphql.Execute(c.Request.Context(), viewer, &req)
+		if result.Data == nil {
+			c.JSON(http.StatusBadRequest, result)
+			return
+		}
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	results, errResult := gc.graphql.Subscribe(c.Request.Context(), viewer, &req)
+	if errResult != nil {
+		c.JSON(http.StatusBadRequest, errResult)
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Stream(func(w io.Writer) bool {
+		result, ok := <-results
+		if !ok {
+			c.SSEvent("complete", "")
+			return false
+		}
+		c.SSEvent("next", result)
+		return true
+	})
+}
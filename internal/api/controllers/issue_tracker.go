@@ -0,0 +1,257 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// IssueTrackerController는 이슈 트래커 설정, 태스크/세션 이슈 연결, 이슈로 태스크 생성을 처리합니다.
+// 워크스페이스/세션/태스크 권한은 라우터의 권한 미들웨어에서 확인합니다.
+type IssueTrackerController struct {
+	issues *services.IssueTrackerService
+}
+
+// NewIssueTrackerController는 새로운 이슈 트래커 컨트롤러를 생성합니다.
+func NewIssueTrackerController(issues *services.IssueTrackerService) *IssueTrackerController {
+	return &IssueTrackerController{
+		issues: issues,
+	}
+}
+
+// GetConfig는 워크스페이스의 이슈 트래커 설정을 조회합니다.
+// @Summary 이슈 트래커 설정 조회
+// @Description API 토큰은 설정 여부(has_token)만 반환합니다
+// @Tags issue-tracker
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {object} models.IssueTrackerConfig "이슈 트래커 설정"
+// @Failure 404 {object} models.ErrorResponse "설정 없음"
+// @Router /workspaces/{id}/issue-tracker [get]
+func (ic *IssueTrackerController) GetConfig(c *gin.Context) {
+	config, err := ic.issues.GetConfig(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, config)
+}
+
+// PutConfig는 워크스페이스의 이슈 트래커 설정을 저장합니다.
+// @Summary 이슈 트래커 설정 저장
+// @Description token을 비워 두면 기존 토큰을 유지합니다
+// @Tags issue-tracker
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.IssueTrackerConfigRequest true "이슈 트래커 설정"
+// @Success 200 {object} models.IssueTrackerConfig "저장된 설정"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "워크스페이스 관리 권한 없음"
+// @Router /workspaces/{id}/issue-tracker [put]
+func (ic *IssueTrackerController) PutConfig(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.IssueTrackerConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	config, err := ic.issues.SaveConfig(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, config)
+}
+
+// DeleteConfig는 워크스페이스의 이슈 트래커 설정을 삭제합니다.
+// @Summary 이슈 트래커 설정 삭제
+// @Tags issue-tracker
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 204 "삭제됨"
+// @Failure 404 {object} models.ErrorResponse "설정 없음"
+// @Router /workspaces/{id}/issue-tracker [delete]
+func (ic *IssueTrackerController) DeleteConfig(c *gin.Context) {
+	if err := ic.issues.DeleteConfig(c.Request.Context(), c.Param("id")); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListTaskLinks는 태스크에 적용되는 이슈 연결을 조회합니다.
+// @Summary 태스크 이슈 연결 조회
+// @Description 태스크에 직접 연결한 이슈와 태스크 세션 전체에 연결한 이슈를 반환합니다
+// @Tags issue-tracker
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Success 200 {array} models.IssueLink "이슈 연결 목록"
+// @Router /tasks/{id}/issue-links [get]
+func (ic *IssueTrackerController) ListTaskLinks(c *gin.Context) {
+	links, err := ic.issues.ListTaskLinks(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, links)
+}
+
+// LinkTask는 태스크를 이슈에 연결합니다.
+// @Summary 태스크를 이슈에 연결
+// @Description 태스크가 끝나면 결과 댓글을 남기고, 검토에서 승인되면 이슈 상태를 전환합니다
+// @Tags issue-tracker
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Param request body models.IssueLinkRequest true "이슈 참조"
+// @Success 201 {object} models.IssueLink "생성된 연결"
+// @Failure 400 {object} models.ErrorResponse "잘못된 이슈 참조 또는 설정 없음"
+// @Failure 409 {object} models.ErrorResponse "이미 연결됨"
+// @Router /tasks/{id}/issue-links [post]
+func (ic *IssueTrackerController) LinkTask(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.IssueLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	link, err := ic.issues.LinkTask(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, link)
+}
+
+// UnlinkTask는 태스크의 이슈 연결을 해제합니다.
+// @Summary 태스크 이슈 연결 해제
+// @Tags issue-tracker
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Param linkId path string true "연결 ID"
+// @Success 204 "해제됨"
+// @Failure 404 {object} models.ErrorResponse "연결을 찾을 수 없음"
+// @Router /tasks/{id}/issue-links/{linkId} [delete]
+func (ic *IssueTrackerController) UnlinkTask(c *gin.Context) {
+	if err := ic.issues.UnlinkTask(c.Request.Context(), c.Param("id"), c.Param("linkId")); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListSessionLinks는 세션의 이슈 연결을 조회합니다.
+// @Summary 세션 이슈 연결 조회
+// @Tags issue-tracker
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "세션 ID"
+// @Success 200 {array} models.IssueLink "이슈 연결 목록"
+// @Router /sessions/{id}/issue-links [get]
+func (ic *IssueTrackerController) ListSessionLinks(c *gin.Context) {
+	links, err := ic.issues.ListSessionLinks(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, links)
+}
+
+// LinkSession은 세션을 이슈에 연결합니다.
+// @Summary 세션을 이슈에 연결
+// @Description 세션에서 끝나는 모든 태스크의 결과를 이슈에 댓글로 남깁니다 (상태 전환은 태스크 연결에만 적용)
+// @Tags issue-tracker
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "세션 ID"
+// @Param request body models.IssueLinkRequest true "이슈 참조"
+// @Success 201 {object} models.IssueLink "생성된 연결"
+// @Failure 400 {object} models.ErrorResponse "잘못된 이슈 참조 또는 설정 없음"
+// @Failure 409 {object} models.ErrorResponse "이미 연결됨"
+// @Router /sessions/{id}/issue-links [post]
+func (ic *IssueTrackerController) LinkSession(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.IssueLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	link, err := ic.issues.LinkSession(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, link)
+}
+
+// UnlinkSession은 세션의 이슈 연결을 해제합니다.
+// @Summary 세션 이슈 연결 해제
+// @Tags issue-tracker
+// @Security BearerAuth
+// @Param id path string true "세션 ID"
+// @Param linkId path string true "연결 ID"
+// @Success 204 "해제됨"
+// @Failure 404 {object} models.ErrorResponse "연결을 찾을 수 없음"
+// @Router /sessions/{id}/issue-links/{linkId} [delete]
+func (ic *IssueTrackerController) UnlinkSession(c *gin.Context) {
+	if err := ic.issues.UnlinkSession(c.Request.Context(), c.Param("id"), c.Param("linkId")); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateTaskFromIssue는 이슈 내용을 컨텍스트로 태스크를 생성합니다.
+// @Summary 이슈로 태스크 생성
+// @Description 이슈 제목과 본문을 명령에 넣어 태스크를 시작하고 태스크를 이슈에 연결합니다
+// @Tags issue-tracker
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "세션 ID"
+// @Param request body models.IssueTaskCreateRequest true "이슈 참조와 추가 지시"
+// @Success 201 {object} models.IssueTaskResponse "생성된 태스크와 연결"
+// @Failure 400 {object} models.ErrorResponse "잘못된 이슈 참조 또는 설정 없음"
+// @Router /sessions/{id}/issue-tasks [post]
+func (ic *IssueTrackerController) CreateTaskFromIssue(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.IssueTaskCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	result, err := ic.issues.CreateTask(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/aicli/aicli-web/internal/jobs"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// JobController는 관리자용 백그라운드 작업 조회와 수동 실행 API를 처리합니다.
+type JobController struct {
+	runner *jobs.Runner
+}
+
+// NewJobController는 새로운 백그라운드 작업 컨트롤러를 생성합니다.
+func NewJobController(runner *jobs.Runner) *JobController {
+	return &JobController{
+		runner: runner,
+	}
+}
+
+// JobListResponse 백그라운드 작업 목록 응답
+type JobListResponse struct {
+	// Scheduling 이 인스턴스가 예약 실행을 맡고 있는지 여부 (다중 레플리카에서는 리더만 예약 실행)
+	Scheduling bool          `json:"scheduling"`
+	Jobs       []jobs.Status `json:"jobs"`
+}
+
+// ListJobs는 등록된 백그라운드 작업과 마지막 실행 결과를 조회합니다.
+// @Summary 백그라운드 작업 목록 조회
+// @Description 정리, 백업, 퍼지 등 등록된 작업의 실행 주기, 실행 중 여부, 다음 실행 시각, 마지막 실행 결과를 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} JobListResponse "작업 목록"
+// @Router /admin/jobs [get]
+func (jc *JobController) ListJobs(c *gin.Context) {
+	c.JSON(http.StatusOK, JobListResponse{
+		Scheduling: jc.runner.Scheduling(),
+		Jobs:       jc.runner.List(),
+	})
+}
+
+// GetJob은 백그라운드 작업 하나의 상태를 조회합니다.
+// @Summary 백그라운드 작업 조회
+// @Tags admin
+// @Produce json
+// @Param name path string true "작업 이름"
+// @Security BearerAuth
+// @Success 200 {object} jobs.Status "작업 상태"
+// @Failure 404 {object} models.ErrorResponse "등록되지 않은 작업"
+// @Router /admin/jobs/{name} [get]
+func (jc *JobController) GetJob(c *gin.Context) {
+	status, err := jc.runner.Get(c.Param("name"))
+	if err != nil {
+		middleware.NotFoundError(c, "등록되지 않은 작업입니다")
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
+
+// RunJob은 백그라운드 작업을 즉시 실행합니다.
+// @Summary 백그라운드 작업 수동 실행
+// @Description 작업을 백그라운드에서 바로 실행합니다. 결과는 작업 조회로 확인합니다
+// @Tags admin
+// @Produce json
+// @Param name path string true "작업 이름"
+// @Security BearerAuth
+// @Success 202 {object} jobs.Status "실행을 시작한 작업 상태"
+// @Failure 404 {object} models.ErrorResponse "등록되지 않은 작업"
+// @Failure 409 {object} models.ErrorResponse "이미 실행 중인 작업"
+// @Router /admin/jobs/{name}/run [post]
+func (jc *JobController) RunJob(c *gin.Context) {
+	name := c.Param("name")
+	if err := jc.runner.Trigger(c.Request.Context(), name); err != nil {
+		switch {
+		case errors.Is(err, jobs.ErrJobNotFound):
+			middleware.NotFoundError(c, "등록되지 않은 작업입니다")
+		case errors.Is(err, jobs.ErrJobRunning):
+			middleware.AbortWithError(c, http.StatusConflict, "JOB_RUNNING", "이미 실행 중인 작업입니다", nil)
+		default:
+			middleware.InternalError(c, "작업 실행에 실패했습니다", err.Error())
+		}
+		return
+	}
+
+	status, _ := jc.runner.Get(name)
+	c.JSON(http.StatusAccepted, status)
+}
@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// LogLevelController는 관리자용 모듈별 로그 레벨 API를 처리합니다.
+type LogLevelController struct {
+	logs *logging.Registry
+}
+
+// NewLogLevelController는 새로운 로그 레벨 컨트롤러를 생성합니다.
+func NewLogLevelController(logs *logging.Registry) *LogLevelController {
+	return &LogLevelController{
+		logs: logs,
+	}
+}
+
+// GetLogLevels는 기본 로그 레벨과 모듈별 로그 레벨을 조회합니다.
+// @Summary 로그 레벨 조회
+// @Description 기본 로그 레벨과 claude, api, security, storage 모듈의 현재 로그 레벨을 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.LogLevelStatus "로그 레벨"
+// @Router /admin/log-levels [get]
+func (lc *LogLevelController) GetLogLevels(c *gin.Context) {
+	c.JSON(http.StatusOK, lc.logs.Status())
+}
+
+// UpdateLogLevels는 기본 로그 레벨이나 모듈별 로그 레벨을 실행 중에 변경합니다.
+// @Summary 로그 레벨 변경
+// @Description 재시작 없이 바로 적용됩니다. 모듈 레벨을 빈 값으로 보내면 기본 레벨을 따르며, 설정 파일을 다시 읽으면 설정 값으로 돌아갑니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.LogLevelUpdateRequest true "로그 레벨 변경 요청"
+// @Success 200 {object} models.LogLevelStatus "변경된 로그 레벨"
+// @Failure 400 {object} models.ErrorResponse "알 수 없는 모듈 또는 레벨"
+// @Router /admin/log-levels [put]
+func (lc *LogLevelController) UpdateLogLevels(c *gin.Context) {
+	var req models.LogLevelUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	status, err := lc.logs.Update(&req)
+	if err != nil {
+		middleware.ValidationError(c, "로그 레벨을 변경할 수 없습니다", err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, status)
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// MaintenanceController는 관리자용 유지보수 모드 API를 처리합니다.
+type MaintenanceController struct {
+	maintenance *services.MaintenanceService
+}
+
+// NewMaintenanceController는 새로운 유지보수 모드 컨트롤러를 생성합니다.
+func NewMaintenanceController(maintenance *services.MaintenanceService) *MaintenanceController {
+	return &MaintenanceController{
+		maintenance: maintenance,
+	}
+}
+
+// GetMaintenance는 유지보수 모드 상태를 조회합니다.
+// @Summary 유지보수 모드 조회
+// @Description 유지보수 모드 여부, 안내 문구, 보관 중인 태스크 수를 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.MaintenanceStatus "유지보수 모드 상태"
+// @Router /admin/maintenance [get]
+func (mc *MaintenanceController) GetMaintenance(c *gin.Context) {
+	c.JSON(http.StatusOK, mc.maintenance.Status())
+}
+
+// UpdateMaintenance는 유지보수 모드를 켜거나 끕니다.
+// @Summary 유지보수 모드 변경
+// @Description 켜면 새 태스크를 503으로 거부하거나(queue_submissions=false) 보관하고, 끄면 보관한 태스크를 실행합니다. 실행 중인 태스크는 계속 진행됩니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.MaintenanceUpdateRequest true "유지보수 모드 변경 요청"
+// @Success 200 {object} models.MaintenanceStatus "변경된 유지보수 모드 상태"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/maintenance [put]
+func (mc *MaintenanceController) UpdateMaintenance(c *gin.Context) {
+	var req models.MaintenanceUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, mc.maintenance.Update(&req))
+}
+
+// handleMaintenanceError는 유지보수 모드로 거부된 요청이면 503과 Retry-After 헤더로 응답합니다.
+func handleMaintenanceError(c *gin.Context, err error) bool {
+	var maintenanceErr *services.MaintenanceError
+	if !errors.As(err, &maintenanceErr) {
+		return false
+	}
+
+	retryAfter := int(maintenanceErr.RetryAfter.Seconds())
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	middleware.AbortWithError(c, http.StatusServiceUnavailable, "MAINTENANCE_MODE", maintenanceErr.Message, gin.H{
+		"retry_after_seconds": retryAfter,
+	})
+	return true
+}
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// MCPController는 워크스페이스별 MCP 서버 관리 API를 처리합니다.
+type MCPController struct {
+	mcpService *services.MCPService
+}
+
+// NewMCPController는 새로운 MCP 컨트롤러를 생성합니다.
+func NewMCPController(mcpService *services.MCPService) *MCPController {
+	return &MCPController{
+		mcpService: mcpService,
+	}
+}
+
+// ListServers는 워크스페이스에 선언된 MCP 서버 목록을 조회합니다.
+// @Summary MCP 서버 목록 조회
+// @Description 환경 변수와 헤더 값은 마스킹되어 반환됩니다
+// @Tags mcp
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {array} services.MCPServer "MCP 서버 목록"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/mcp/servers [get]
+func (mc *MCPController) ListServers(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	servers, err := mc.mcpService.List(c.Request.Context(), workspaceID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, servers)
+}
+
+// PutServer는 MCP 서버를 추가하거나 갱신합니다.
+// @Summary MCP 서버 등록/갱신
+// @Tags mcp
+// @Accept json
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param name path string true "MCP 서버 이름"
+// @Param request body claude.MCPServerConfig true "MCP 서버 설정"
+// @Security BearerAuth
+// @Success 200 {object} services.MCPServer "저장된 MCP 서버"
+// @Failure 400 {object} models.ErrorResponse "잘못된 설정"
+// @Router /workspaces/{id}/mcp/servers/{name} [put]
+func (mc *MCPController) PutServer(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	var req claude.MCPServerConfig
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 형식입니다", err.Error())
+		return
+	}
+
+	server, err := mc.mcpService.Put(c.Request.Context(), workspaceID, c.Param("name"), req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, server)
+}
+
+// DeleteServer는 MCP 서버 선언을 삭제합니다.
+// @Summary MCP 서버 삭제
+// @Tags mcp
+// @Param id path string true "워크스페이스 ID"
+// @Param name path string true "MCP 서버 이름"
+// @Security BearerAuth
+// @Success 204 "삭제됨"
+// @Failure 404 {object} models.ErrorResponse "MCP 서버를 찾을 수 없음"
+// @Router /workspaces/{id}/mcp/servers/{name} [delete]
+func (mc *MCPController) DeleteServer(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	if err := mc.mcpService.Delete(c.Request.Context(), workspaceID, c.Param("name")); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetStatus는 MCP 서버 상태를 즉시 확인합니다.
+// @Summary MCP 서버 상태 확인
+// @Description stdio 서버는 initialize 핸드셰이크로, http/sse 서버는 엔드포인트 응답으로 상태를 확인합니다
+// @Tags mcp
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {array} claude.MCPServerStatus "MCP 서버 상태"
+// @Router /workspaces/{id}/mcp/status [get]
+func (mc *MCPController) GetStatus(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	statuses, err := mc.mcpService.Check(c.Request.Context(), workspaceID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, statuses)
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// MessageController는 세션 대화 기록 조회와 검색 API를 처리합니다.
+type MessageController struct {
+	messageService *services.MessageService
+}
+
+// NewMessageController는 새로운 대화 기록 컨트롤러를 생성합니다.
+func NewMessageController(messageService *services.MessageService) *MessageController {
+	return &MessageController{
+		messageService: messageService,
+	}
+}
+
+// ListSessionMessages는 세션의 메시지를 순서대로 조회합니다.
+// @Summary 세션 대화 기록 조회
+// @Tags sessions
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Security BearerAuth
+// @Success 200 {object} models.PaginationResponse "세션 메시지 목록"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /sessions/{id}/messages [get]
+func (mc *MessageController) ListSessionMessages(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	var req models.PaginationRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	response, err := mc.messageService.ListBySession(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SearchMessages는 사용자가 접근할 수 있는 세션 대화 기록을 검색합니다.
+// @Summary 대화 기록 검색
+// @Description 공백으로 구분된 모든 단어를 포함하는 메시지를 최신순으로 반환합니다
+// @Tags sessions
+// @Produce json
+// @Param q query string true "검색어"
+// @Param workspace_id query string false "워크스페이스 ID"
+// @Param session_id query string false "세션 ID"
+// @Param role query string false "메시지 역할 (user, assistant, system)"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Security BearerAuth
+// @Success 200 {object} models.PaginationResponse "검색 결과"
+// @Failure 400 {object} models.ErrorResponse "잘못된 검색어"
+// @Router /messages/search [get]
+func (mc *MessageController) SearchMessages(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	var req models.PaginationRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	query := &models.MessageSearchQuery{
+		Query:     c.Query("q"),
+		SessionID: c.Query("session_id"),
+		Role:      models.MessageRole(c.Query("role")),
+	}
+	if workspaceID := c.Query("workspace_id"); workspaceID != "" {
+		query.WorkspaceIDs = []string{workspaceID}
+	}
+
+	response, err := mc.messageService.Search(c.Request.Context(), userClaims.UserID, userClaims.Role == "admin", query, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// messageClaims 요청의 인증 정보 조회
+func messageClaims(c *gin.Context) (*auth.Claims, bool) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		middleware.UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+		return nil, false
+	}
+	return claims.(*auth.Claims), true
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// NetworkPolicyController는 워크스페이스 네트워크 이그레스 정책 API를 처리합니다.
+type NetworkPolicyController struct {
+	policyService *services.NetworkPolicyService
+}
+
+// NewNetworkPolicyController는 새로운 네트워크 정책 컨트롤러를 생성합니다.
+func NewNetworkPolicyController(policyService *services.NetworkPolicyService) *NetworkPolicyController {
+	return &NetworkPolicyController{
+		policyService: policyService,
+	}
+}
+
+// GetPolicy는 워크스페이스에 적용되는 네트워크 정책을 조회합니다.
+// @Summary 워크스페이스 네트워크 정책 조회
+// @Description 지정하지 않은 워크스페이스는 서버 기본 정책을 반환합니다
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {object} models.NetworkPolicy "적용 중인 정책"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/network-policy [get]
+func (nc *NetworkPolicyController) GetPolicy(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	policy, err := nc.policyService.Effective(c.Request.Context(), workspaceID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// PutPolicy는 워크스페이스 네트워크 정책을 지정합니다.
+// @Summary 워크스페이스 네트워크 정책 지정
+// @Description full(전체 허용), offline(네트워크 없음), allowlist(허용 도메인만 이그레스 프록시로 접근) 중 하나를 지정합니다.
+// @Description 바뀐 정책은 컨테이너를 다시 만들 때 적용됩니다.
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.NetworkPolicy true "네트워크 정책"
+// @Security BearerAuth
+// @Success 200 {object} models.NetworkPolicy "저장된 정책"
+// @Failure 400 {object} models.ErrorResponse "잘못된 정책"
+// @Router /workspaces/{id}/network-policy [put]
+func (nc *NetworkPolicyController) PutPolicy(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	var policy models.NetworkPolicy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 형식입니다", err.Error())
+		return
+	}
+
+	saved, err := nc.policyService.Put(c.Request.Context(), workspaceID, policy)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, saved)
+}
+
+// DeletePolicy는 워크스페이스 네트워크 정책을 삭제하고 기본 정책으로 되돌립니다.
+// @Summary 워크스페이스 네트워크 정책 초기화
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {object} models.NetworkPolicy "기본 정책"
+// @Router /workspaces/{id}/network-policy [delete]
+func (nc *NetworkPolicyController) DeletePolicy(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	if err := nc.policyService.Delete(c.Request.Context(), workspaceID); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, nc.policyService.DefaultPolicy())
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// NotificationController는 알림 센터 API를 처리합니다.
+type NotificationController struct {
+	notificationCenter *services.NotificationCenter
+}
+
+// NewNotificationController는 새로운 알림 센터 컨트롤러를 생성합니다.
+func NewNotificationController(notificationCenter *services.NotificationCenter) *NotificationController {
+	return &NotificationController{
+		notificationCenter: notificationCenter,
+	}
+}
+
+// ListNotifications는 로그인한 사용자의 알림을 조회합니다.
+// @Summary 알림 목록
+// @Description 예산 경고, 검토 요청과 결정, 워크스페이스 초대, 보안 알림을 최신순으로 반환합니다. 웹소켓을 쓸 수 없으면 마지막으로 받은 알림의 created_at을 since로 넘겨 폴링합니다
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "알림 종류 (budget, approval, invite, security, export)"
+// @Param unread query bool false "안 읽은 알림만"
+// @Param since query string false "이 시각보다 나중에 생긴 알림만 (RFC 3339)"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Success 200 {object} models.NotificationListResponse "알림 목록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Router /notifications [get]
+func (nc *NotificationController) ListNotifications(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.NotificationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "알림 조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	response, err := nc.notificationCenter.List(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkNotificationRead는 알림 하나를 읽음으로 표시합니다.
+// @Summary 알림 읽음 표시
+// @Description 본인 알림만 표시하며, 이미 읽었거나 없는 알림이면 marked가 0입니다
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "알림 ID"
+// @Success 200 {object} models.NotificationReadState "읽음 상태"
+// @Router /notifications/{id}/read [post]
+func (nc *NotificationController) MarkNotificationRead(c *gin.Context) {
+	nc.markRead(c, []string{c.Param("id")})
+}
+
+// MarkAllNotificationsRead는 안 읽은 알림을 모두 읽음으로 표시합니다.
+// @Summary 모든 알림 읽음 표시
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.NotificationReadState "읽음 상태"
+// @Router /notifications/read-all [post]
+func (nc *NotificationController) MarkAllNotificationsRead(c *gin.Context) {
+	nc.markRead(c, nil)
+}
+
+// markRead 로그인한 사용자의 알림을 읽음으로 표시 (ids가 비어 있으면 전체)
+func (nc *NotificationController) markRead(c *gin.Context, ids []string) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	state, err := nc.notificationCenter.MarkRead(c.Request.Context(), userClaims.UserID, ids)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, state)
+}
+
+// GetPreferences는 알림 종류별 설정을 조회합니다.
+// @Summary 알림 설정 조회
+// @Description 종류마다 알림 센터 저장 여부와 메일 발송 방법을 반환합니다 (저장하지 않은 종류는 기본값: 알림 센터와 즉시 메일)
+// @Tags notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.NotificationPreference "알림 설정"
+// @Router /notifications/preferences [get]
+func (nc *NotificationController) GetPreferences(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	preferences, err := nc.notificationCenter.Preferences(c.Request.Context(), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// UpdatePreferences는 알림 종류별 설정을 변경합니다.
+// @Summary 알림 설정 변경
+// @Description 요청에 있는 종류의 값만 바꿉니다. email이 digest인 알림은 요약 메일 주기마다 모아서 보냅니다
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.NotificationPreferencesRequest true "변경할 알림 설정"
+// @Success 200 {array} models.NotificationPreference "변경된 알림 설정"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /notifications/preferences [put]
+func (nc *NotificationController) UpdatePreferences(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.NotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "알림 설정 요청이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	preferences, err := nc.notificationCenter.UpdatePreferences(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
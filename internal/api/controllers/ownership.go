@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// OwnershipController는 소유권 이전과 사용자 오프보딩 API를 처리합니다.
+type OwnershipController struct {
+	ownership *services.OwnershipService
+}
+
+// NewOwnershipController는 새로운 소유권 컨트롤러를 생성합니다.
+func NewOwnershipController(ownership *services.OwnershipService) *OwnershipController {
+	return &OwnershipController{
+		ownership: ownership,
+	}
+}
+
+// TransferWorkspace는 워크스페이스 소유권을 다른 사용자에게 이전합니다.
+// @Summary 워크스페이스 소유권 이전
+// @Description 소유자 또는 관리자가 워크스페이스를 다른 사용자에게 넘깁니다. keep_access로 이전 소유자에게 ACL 권한을 남길 수 있습니다
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.WorkspaceTransferRequest true "이전 요청"
+// @Success 200 {object} models.Workspace "이전된 워크스페이스"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청 또는 새 소유자의 워크스페이스 수 초과"
+// @Failure 403 {object} models.ErrorResponse "소유자 권한 필요"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Failure 409 {object} models.ErrorResponse "새 소유자에게 같은 이름의 워크스페이스가 있음"
+// @Router /workspaces/{id}/transfer [post]
+func (oc *OwnershipController) TransferWorkspace(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.WorkspaceTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	workspace, err := oc.ownership.TransferWorkspace(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, workspace)
+}
+
+// TransferProject는 프로젝트를 다른 워크스페이스로 옮깁니다.
+// @Summary 프로젝트 이전
+// @Description 원래 워크스페이스와 대상 워크스페이스 모두에 admin 권한이 있어야 합니다. 활성 세션이 있는 프로젝트는 옮길 수 없습니다
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "프로젝트 ID"
+// @Param request body models.ProjectTransferRequest true "이전 요청"
+// @Success 200 {object} models.Project "이전된 프로젝트"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "프로젝트 또는 워크스페이스를 찾을 수 없음"
+// @Failure 409 {object} models.ErrorResponse "이름 충돌 또는 활성 세션 있음"
+// @Router /projects/{id}/transfer [post]
+func (oc *OwnershipController) TransferProject(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.ProjectTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	project, err := oc.ownership.TransferProject(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, project)
+}
+
+// BulkTransfer는 한 사용자의 워크스페이스와 프로젝트를 다른 사용자에게 일괄 이전합니다.
+// @Summary 소유권 일괄 이전 (관리자)
+// @Description workspace_ids와 project_ids가 모두 비어 있으면 from_user_id의 모든 워크스페이스를 이전합니다. dry_run이면 변경 없이 결과만 반환합니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.BulkTransferRequest true "일괄 이전 요청"
+// @Success 200 {object} models.OwnershipResult "항목별 결과"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/ownership/transfer [post]
+func (oc *OwnershipController) BulkTransfer(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.BulkTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	result, err := oc.ownership.BulkTransfer(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// OffboardUser는 사용자를 오프보딩합니다.
+// @Summary 사용자 오프보딩 (관리자)
+// @Description 로컬 계정을 비활성화하고, 소유한 워크스페이스를 다른 사용자에게 넘기거나(reassign) 아카이브하며(archive), 공유받은 접근 권한을 회수합니다. dry_run이면 변경 없이 결과만 반환합니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Param request body models.OffboardRequest true "오프보딩 요청"
+// @Success 200 {object} models.OwnershipResult "항목별 결과"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/users/{id}/offboard [post]
+func (oc *OwnershipController) OffboardUser(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.OffboardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	result, err := oc.ownership.Offboard(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
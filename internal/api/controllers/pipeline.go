@@ -0,0 +1,272 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// PipelineController는 파이프라인 정의와 실행 API를 처리합니다.
+type PipelineController struct {
+	pipelines *services.PipelineService
+}
+
+// NewPipelineController는 새로운 파이프라인 컨트롤러를 생성합니다.
+func NewPipelineController(pipelines *services.PipelineService) *PipelineController {
+	return &PipelineController{
+		pipelines: pipelines,
+	}
+}
+
+// ListPipelines는 사용자의 파이프라인 목록을 조회합니다.
+// @Summary 파이프라인 목록 조회
+// @Tags pipelines
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Pipeline "파이프라인 목록"
+// @Router /pipelines [get]
+func (pc *PipelineController) ListPipelines(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	pipelines, err := pc.pipelines.List(c.Request.Context(), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pipelines)
+}
+
+// CreatePipeline은 새 파이프라인을 저장합니다.
+// @Summary 파이프라인 생성
+// @Description 단계는 순서대로 실행되며, {{steps.<이름>.output}}으로 앞 단계의 출력을 사용할 수 있습니다
+// @Tags pipelines
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.PipelineCreateRequest true "파이프라인"
+// @Success 201 {object} models.Pipeline "생성된 파이프라인"
+// @Failure 400 {object} models.ErrorResponse "잘못된 단계 정의"
+// @Failure 409 {object} models.ErrorResponse "같은 이름의 파이프라인이 있음"
+// @Router /pipelines [post]
+func (pc *PipelineController) CreatePipeline(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PipelineCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	pipeline, err := pc.pipelines.Create(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, pipeline)
+}
+
+// GetPipeline은 파이프라인을 조회합니다.
+// @Summary 파이프라인 조회
+// @Tags pipelines
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "파이프라인 ID"
+// @Success 200 {object} models.Pipeline "파이프라인"
+// @Failure 404 {object} models.ErrorResponse "파이프라인을 찾을 수 없음"
+// @Router /pipelines/{id} [get]
+func (pc *PipelineController) GetPipeline(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	pipeline, err := pc.pipelines.Get(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pipeline)
+}
+
+// UpdatePipeline은 파이프라인을 수정합니다.
+// @Summary 파이프라인 수정
+// @Description 진행 중인 실행은 시작 시점의 단계 정의를 계속 사용합니다
+// @Tags pipelines
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "파이프라인 ID"
+// @Param request body models.PipelineUpdateRequest true "변경할 필드"
+// @Success 200 {object} models.Pipeline "수정된 파이프라인"
+// @Failure 400 {object} models.ErrorResponse "잘못된 단계 정의"
+// @Failure 404 {object} models.ErrorResponse "파이프라인을 찾을 수 없음"
+// @Router /pipelines/{id} [put]
+func (pc *PipelineController) UpdatePipeline(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PipelineUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	pipeline, err := pc.pipelines.Update(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pipeline)
+}
+
+// DeletePipeline은 파이프라인을 삭제합니다.
+// @Summary 파이프라인 삭제
+// @Description 실행 기록은 삭제되지 않습니다
+// @Tags pipelines
+// @Security BearerAuth
+// @Param id path string true "파이프라인 ID"
+// @Success 204 "삭제 완료"
+// @Failure 404 {object} models.ErrorResponse "파이프라인을 찾을 수 없음"
+// @Router /pipelines/{id} [delete]
+func (pc *PipelineController) DeletePipeline(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	if err := pc.pipelines.Delete(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin"); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RunPipeline은 파이프라인을 세션에서 실행합니다.
+// @Summary 파이프라인 실행
+// @Description 단계를 태스크 큐로 순서대로 실행합니다. 진행 상황은 세션 채널의 pipeline_run 상태 메시지로도 전달됩니다
+// @Tags pipelines
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "파이프라인 ID"
+// @Param request body models.PipelineRunRequest true "실행 요청"
+// @Success 201 {object} models.PipelineRun "파이프라인 실행"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "파이프라인 또는 세션을 찾을 수 없음"
+// @Router /pipelines/{id}/runs [post]
+func (pc *PipelineController) RunPipeline(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PipelineRunRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	run, err := pc.pipelines.Run(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, run)
+}
+
+// ListRuns는 사용자의 파이프라인 실행 목록을 조회합니다.
+// @Summary 파이프라인 실행 목록 조회
+// @Tags pipelines
+// @Produce json
+// @Security BearerAuth
+// @Param pipeline_id query string false "파이프라인 ID"
+// @Param status query string false "실행 상태 (running, succeeded, failed, cancelled)"
+// @Param limit query int false "최대 개수 (기본 50)"
+// @Success 200 {array} models.PipelineRun "파이프라인 실행 목록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Router /pipeline-runs [get]
+func (pc *PipelineController) ListRuns(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var filter models.PipelineRunFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	runs, err := pc.pipelines.ListRuns(c.Request.Context(), userClaims.UserID, &filter)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, runs)
+}
+
+// GetRun은 파이프라인 실행 상태를 조회합니다.
+// @Summary 파이프라인 실행 조회
+// @Tags pipelines
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "파이프라인 실행 ID"
+// @Success 200 {object} models.PipelineRun "파이프라인 실행"
+// @Failure 404 {object} models.ErrorResponse "파이프라인 실행을 찾을 수 없음"
+// @Router /pipeline-runs/{id} [get]
+func (pc *PipelineController) GetRun(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	run, err := pc.pipelines.GetRun(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
+
+// CancelRun은 실행 중인 파이프라인을 취소합니다.
+// @Summary 파이프라인 실행 취소
+// @Description 현재 단계의 태스크도 함께 취소합니다
+// @Tags pipelines
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "파이프라인 실행 ID"
+// @Success 200 {object} models.PipelineRun "취소된 실행"
+// @Failure 400 {object} models.ErrorResponse "실행 중이 아님"
+// @Failure 404 {object} models.ErrorResponse "파이프라인 실행을 찾을 수 없음"
+// @Router /pipeline-runs/{id}/cancel [post]
+func (pc *PipelineController) CancelRun(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	run, err := pc.pipelines.CancelRun(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
+
+// ResumeRun은 실패하거나 취소된 파이프라인을 멈춘 단계부터 다시 실행합니다.
+// @Summary 파이프라인 실행 재개
+// @Description 앞서 통과한 단계의 출력은 그대로 사용합니다
+// @Tags pipelines
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "파이프라인 실행 ID"
+// @Success 200 {object} models.PipelineRun "재개된 실행"
+// @Failure 400 {object} models.ErrorResponse "재개할 수 없는 상태"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "파이프라인 실행을 찾을 수 없음"
+// @Router /pipeline-runs/{id}/resume [post]
+func (pc *PipelineController) ResumeRun(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	run, err := pc.pipelines.ResumeRun(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, run)
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/plugin"
+)
+
+// PluginController는 태스크 훅 플러그인 API를 처리합니다.
+type PluginController struct {
+	manager *plugin.Manager
+}
+
+// NewPluginController는 새로운 플러그인 컨트롤러를 생성합니다.
+func NewPluginController(manager *plugin.Manager) *PluginController {
+	return &PluginController{
+		manager: manager,
+	}
+}
+
+// UpdateWorkspacePluginRequest 워크스페이스 플러그인 활성화 변경 요청
+type UpdateWorkspacePluginRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// ListPlugins는 등록된 플러그인 목록을 조회합니다.
+// @Summary 플러그인 목록 조회
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} plugin.Info "등록된 플러그인"
+// @Router /admin/plugins [get]
+func (pc *PluginController) ListPlugins(c *gin.Context) {
+	c.JSON(http.StatusOK, pc.manager.List())
+}
+
+// ListWorkspacePlugins는 워크스페이스의 플러그인 활성화 상태를 조회합니다.
+// @Summary 워크스페이스 플러그인 조회
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {array} plugin.WorkspacePlugin "플러그인 활성화 상태"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/plugins [get]
+func (pc *PluginController) ListWorkspacePlugins(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	c.JSON(http.StatusOK, pc.manager.WorkspacePlugins(workspaceID))
+}
+
+// UpdateWorkspacePlugin은 워크스페이스에서 플러그인을 활성화하거나 비활성화합니다.
+// @Summary 워크스페이스 플러그인 활성화 변경
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param name path string true "플러그인 이름"
+// @Param request body UpdateWorkspacePluginRequest true "활성화 여부"
+// @Security BearerAuth
+// @Success 200 {object} plugin.WorkspacePlugin "변경된 상태"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스 또는 플러그인을 찾을 수 없음"
+// @Router /workspaces/{id}/plugins/{name} [put]
+func (pc *PluginController) UpdateWorkspacePlugin(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	var req UpdateWorkspacePluginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 형식입니다", err.Error())
+		return
+	}
+
+	name := c.Param("name")
+	if err := pc.manager.SetEnabled(workspaceID, name, *req.Enabled); err != nil {
+		if errors.Is(err, plugin.ErrPluginNotFound) {
+			middleware.NotFoundError(c, "플러그인을 찾을 수 없습니다")
+			return
+		}
+		middleware.InternalError(c, "플러그인 설정 저장에 실패했습니다", err.Error())
+		return
+	}
+
+	for _, p := range pc.manager.WorkspacePlugins(workspaceID) {
+		if p.Name == name {
+			c.JSON(http.StatusOK, p)
+			return
+		}
+	}
+	middleware.NotFoundError(c, "플러그인을 찾을 수 없습니다")
+}
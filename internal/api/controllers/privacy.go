@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// PrivacyController는 개인정보 내보내기와 삭제 API를 처리합니다.
+type PrivacyController struct {
+	privacy *services.PrivacyService
+}
+
+// NewPrivacyController는 새로운 개인정보 컨트롤러를 생성합니다.
+func NewPrivacyController(privacy *services.PrivacyService) *PrivacyController {
+	return &PrivacyController{
+		privacy: privacy,
+	}
+}
+
+// ExportMyData는 본인의 개인정보를 내보냅니다.
+// @Summary 본인 개인정보 내보내기
+// @Description 프로필, 소유한 워크스페이스/프로젝트/세션, 공유받은 권한, 작성한 프롬프트와 본인에 대한 감사 기록을 JSON으로 반환합니다
+// @Tags privacy
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.UserDataExport "개인정보 묶음"
+// @Router /privacy/export [get]
+func (pc *PrivacyController) ExportMyData(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	export, err := pc.privacy.Export(c.Request.Context(), userClaims.UserID, userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, export)
+}
+
+// ExportUserData는 사용자의 개인정보를 내보냅니다.
+// @Summary 사용자 개인정보 내보내기 (관리자)
+// @Description 사용자의 프로필, 소유한 워크스페이스/프로젝트/세션, 공유받은 권한, 작성한 프롬프트와 사용자에 대한 감사 기록을 JSON으로 반환합니다. 처리 기록이 남습니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Success 200 {object} models.UserDataExport "개인정보 묶음"
+// @Router /admin/users/{id}/export [get]
+func (pc *PrivacyController) ExportUserData(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	export, err := pc.privacy.Export(c.Request.Context(), c.Param("id"), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, export)
+}
+
+// EraseUserData는 사용자의 개인정보를 삭제합니다.
+// @Summary 사용자 개인정보 삭제 (관리자)
+// @Description 계정을 비활성화하고 소유한 워크스페이스를 넘기거나(reassign) 아카이브한 뒤(archive), 계정의 사용자명/이메일/표시 이름과 감사 기록의 접속 정보를 지우고 사용자에게 남는 워크스페이스의 대화 기록을 삭제합니다. 사용자 ID는 다른 기록이 참조하므로 유지합니다. confirm에 사용자 ID를 다시 입력해야 하며, dry_run이면 변경 없이 결과만 반환합니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "사용자 ID"
+// @Param request body models.ErasureRequest true "삭제 요청"
+// @Success 200 {object} models.ErasureResult "삭제 결과"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청 또는 확인 값 불일치"
+// @Router /admin/users/{id}/erase [post]
+func (pc *PrivacyController) EraseUserData(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.ErasureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	result, err := pc.privacy.Erase(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ListPrivacyRequests는 개인정보 처리 기록을 조회합니다.
+// @Summary 개인정보 처리 기록 조회 (관리자)
+// @Description 개인정보 내보내기와 삭제 처리 기록을 최신순으로 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param subject_id query string false "정보 주체 사용자 ID"
+// @Param type query string false "요청 종류 (export, erasure)"
+// @Param limit query int false "최대 개수"
+// @Success 200 {array} models.PrivacyRequest "처리 기록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/privacy/requests [get]
+func (pc *PrivacyController) ListPrivacyRequests(c *gin.Context) {
+	var filter models.PrivacyRequestFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	requests, err := pc.privacy.ListRequests(c.Request.Context(), &filter)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, requests)
+}
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+// ProcessReaperController는 관리자용 고아 프로세스 점검 API를 처리합니다.
+type ProcessReaperController struct {
+	reaper *claude.ProcessReaper
+}
+
+// NewProcessReaperController는 새로운 고아 프로세스 컨트롤러를 생성합니다.
+func NewProcessReaperController(reaper *claude.ProcessReaper) *ProcessReaperController {
+	return &ProcessReaperController{
+		reaper: reaper,
+	}
+}
+
+// ListOrphans는 고아 프로세스를 처리하지 않고 점검 보고서만 반환합니다.
+// @Summary 고아 프로세스 점검 (dry-run)
+// @Description 이전 서버 실행에서 남은 CLI 프로세스를 찾아 보고합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} claude.ReapReport "점검 보고서"
+// @Router /admin/processes/orphans [get]
+func (pc *ProcessReaperController) ListOrphans(c *gin.Context) {
+	report, err := pc.reaper.Reap(c.Request.Context(), "", true)
+	if err != nil {
+		middleware.InternalError(c, "고아 프로세스 점검에 실패했습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ReapOrphans는 고아 프로세스를 종료하거나 다시 관리합니다.
+// @Summary 고아 프로세스 정리
+// @Tags admin
+// @Produce json
+// @Param action query string false "처리 방식 (kill, adopt), 생략하면 설정값"
+// @Param dry_run query bool false "보고만 함"
+// @Security BearerAuth
+// @Success 200 {object} claude.ReapReport "처리 결과"
+// @Failure 400 {object} models.ErrorResponse "잘못된 처리 방식"
+// @Router /admin/processes/orphans/reap [post]
+func (pc *ProcessReaperController) ReapOrphans(c *gin.Context) {
+	action := claude.OrphanAction(c.Query("action"))
+	if action != "" && !action.IsValid() {
+		middleware.ValidationError(c, "처리 방식은 kill 또는 adopt여야 합니다", nil)
+		return
+	}
+
+	report, err := pc.reaper.Reap(c.Request.Context(), action, c.Query("dry_run") == "true")
+	if err != nil {
+		middleware.InternalError(c, "고아 프로세스 정리에 실패했습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
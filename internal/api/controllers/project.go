@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/aicli/aicli-web/internal/auth"
@@ -15,6 +16,7 @@ import (
 // ProjectController는 프로젝트 관련 API를 처리합니다.
 type ProjectController struct {
 	projectService *services.ProjectService
+	access         *services.WorkspaceAccessService
 	storage        storage.Storage
 }
 
@@ -22,6 +24,7 @@ type ProjectController struct {
 func NewProjectController(storage storage.Storage) *ProjectController {
 	return &ProjectController{
 		projectService: services.NewProjectService(storage),
+		access:         services.NewWorkspaceAccessService(storage),
 		storage:        storage,
 	}
 }
@@ -57,8 +60,8 @@ func (pc *ProjectController) CreateProject(c *gin.Context) {
 		return
 	}
 	
-	// 워크스페이스 소유권 확인
-	workspace, err := pc.storage.Workspace().GetByID(c, workspaceID)
+	// 워크스페이스 권한 확인 (소유자 또는 ACL 공유)
+	workspace, err := pc.storage.Workspace().GetByID(c.Request.Context(), workspaceID)
 	if err != nil {
 		if err == storage.ErrNotFound {
 			middleware.NotFoundError(c, "워크스페이스를 찾을 수 없습니다")
@@ -68,7 +71,7 @@ func (pc *ProjectController) CreateProject(c *gin.Context) {
 		return
 	}
 	
-	if workspace.OwnerID != userClaims.UserID {
+	if !pc.hasPermission(c, workspace, userClaims.UserID, models.WorkspacePermissionAdmin) {
 		middleware.ForbiddenError(c, "워크스페이스에 프로젝트를 생성할 권한이 없습니다")
 		return
 	}
@@ -99,7 +102,7 @@ func (pc *ProjectController) CreateProject(c *gin.Context) {
 	}
 	
 	// 프로젝트 생성
-	if err := pc.projectService.CreateProject(c, &project); err != nil {
+	if err := pc.projectService.CreateProject(c.Request.Context(), &project); err != nil {
 		if err.Error() == "project name already exists in workspace" {
 			middleware.ConflictError(c, "이미 존재하는 프로젝트 이름입니다")
 			return
@@ -154,8 +157,8 @@ func (pc *ProjectController) ListProjects(c *gin.Context) {
 		return
 	}
 	
-	// 워크스페이스 소유권 확인
-	workspace, err := pc.storage.Workspace().GetByID(c, workspaceID)
+	// 워크스페이스 권한 확인 (소유자 또는 ACL 공유)
+	workspace, err := pc.storage.Workspace().GetByID(c.Request.Context(), workspaceID)
 	if err != nil {
 		if err == storage.ErrNotFound {
 			middleware.NotFoundError(c, "워크스페이스를 찾을 수 없습니다")
@@ -165,7 +168,7 @@ func (pc *ProjectController) ListProjects(c *gin.Context) {
 		return
 	}
 	
-	if workspace.OwnerID != userClaims.UserID {
+	if !pc.hasPermission(c, workspace, userClaims.UserID, models.WorkspacePermissionRead) {
 		middleware.ForbiddenError(c, "워크스페이스의 프로젝트를 조회할 권한이 없습니다")
 		return
 	}
@@ -192,7 +195,11 @@ func (pc *ProjectController) ListProjects(c *gin.Context) {
 	}
 	
 	// 프로젝트 목록 조회
-	projects, total, err := pc.projectService.GetProjectsByWorkspace(c, workspaceID, &req)
+	projects, total, err := pc.projectService.GetProjectsByWorkspace(c.Request.Context(), workspaceID, &req)
+	if errors.Is(err, models.ErrInvalidCursor) || errors.Is(err, models.ErrInvalidSortField) {
+		middleware.ValidationError(c, "잘못된 정렬 또는 커서 파라미터입니다", err.Error())
+		return
+	}
 	if err != nil {
 		middleware.InternalError(c, "프로젝트 목록 조회 실패", err)
 		return
@@ -243,7 +250,7 @@ func (pc *ProjectController) GetProject(c *gin.Context) {
 	}
 	
 	// 프로젝트 조회
-	project, err := pc.projectService.GetProject(c, id)
+	project, err := pc.projectService.GetProject(c.Request.Context(), id)
 	if err != nil {
 		if err == storage.ErrNotFound {
 			middleware.NotFoundError(c, "프로젝트를 찾을 수 없습니다")
@@ -253,14 +260,14 @@ func (pc *ProjectController) GetProject(c *gin.Context) {
 		return
 	}
 	
-	// 워크스페이스 소유권 확인
-	workspace, err := pc.storage.Workspace().GetByID(c, project.WorkspaceID)
+	// 워크스페이스 권한 확인 (소유자 또는 ACL 공유)
+	workspace, err := pc.storage.Workspace().GetByID(c.Request.Context(), project.WorkspaceID)
 	if err != nil {
 		middleware.InternalError(c, "워크스페이스 조회 실패", err)
 		return
 	}
 	
-	if workspace.OwnerID != userClaims.UserID {
+	if !pc.hasPermission(c, workspace, userClaims.UserID, models.WorkspacePermissionRead) {
 		middleware.ForbiddenError(c, "프로젝트에 접근할 권한이 없습니다")
 		return
 	}
@@ -305,7 +312,7 @@ func (pc *ProjectController) UpdateProject(c *gin.Context) {
 	}
 	
 	// 프로젝트 존재 및 권한 확인
-	project, err := pc.storage.Project().GetByID(c, id)
+	project, err := pc.storage.Project().GetByID(c.Request.Context(), id)
 	if err != nil {
 		if err == storage.ErrNotFound {
 			middleware.NotFoundError(c, "프로젝트를 찾을 수 없습니다")
@@ -315,14 +322,14 @@ func (pc *ProjectController) UpdateProject(c *gin.Context) {
 		return
 	}
 	
-	// 워크스페이스 소유권 확인
-	workspace, err := pc.storage.Workspace().GetByID(c, project.WorkspaceID)
+	// 워크스페이스 권한 확인 (소유자 또는 ACL 공유)
+	workspace, err := pc.storage.Workspace().GetByID(c.Request.Context(), project.WorkspaceID)
 	if err != nil {
 		middleware.InternalError(c, "워크스페이스 조회 실패", err)
 		return
 	}
 	
-	if workspace.OwnerID != userClaims.UserID {
+	if !pc.hasPermission(c, workspace, userClaims.UserID, models.WorkspacePermissionAdmin) {
 		middleware.ForbiddenError(c, "프로젝트를 수정할 권한이 없습니다")
 		return
 	}
@@ -364,7 +371,7 @@ func (pc *ProjectController) UpdateProject(c *gin.Context) {
 	}
 	
 	// 프로젝트 업데이트
-	if err := pc.projectService.UpdateProject(c, id, updates); err != nil {
+	if err := pc.projectService.UpdateProject(c.Request.Context(), id, updates); err != nil {
 		if err.Error() == "project name already exists in workspace" {
 			middleware.ConflictError(c, "이미 존재하는 프로젝트 이름입니다")
 			return
@@ -378,7 +385,7 @@ func (pc *ProjectController) UpdateProject(c *gin.Context) {
 	}
 	
 	// 수정된 프로젝트 조회
-	updatedProject, err := pc.projectService.GetProject(c, id)
+	updatedProject, err := pc.projectService.GetProject(c.Request.Context(), id)
 	if err != nil {
 		middleware.InternalError(c, "수정된 프로젝트 조회 실패", err)
 		return
@@ -423,7 +430,7 @@ func (pc *ProjectController) DeleteProject(c *gin.Context) {
 	}
 	
 	// 프로젝트 존재 및 권한 확인
-	project, err := pc.storage.Project().GetByID(c, id)
+	project, err := pc.storage.Project().GetByID(c.Request.Context(), id)
 	if err != nil {
 		if err == storage.ErrNotFound {
 			middleware.NotFoundError(c, "프로젝트를 찾을 수 없습니다")
@@ -433,20 +440,20 @@ func (pc *ProjectController) DeleteProject(c *gin.Context) {
 		return
 	}
 	
-	// 워크스페이스 소유권 확인
-	workspace, err := pc.storage.Workspace().GetByID(c, project.WorkspaceID)
+	// 워크스페이스 권한 확인 (소유자 또는 ACL 공유)
+	workspace, err := pc.storage.Workspace().GetByID(c.Request.Context(), project.WorkspaceID)
 	if err != nil {
 		middleware.InternalError(c, "워크스페이스 조회 실패", err)
 		return
 	}
 	
-	if workspace.OwnerID != userClaims.UserID {
+	if !pc.hasPermission(c, workspace, userClaims.UserID, models.WorkspacePermissionAdmin) {
 		middleware.ForbiddenError(c, "프로젝트를 삭제할 권한이 없습니다")
 		return
 	}
 	
 	// 프로젝트 삭제
-	if err := pc.projectService.DeleteProject(c, id); err != nil {
+	if err := pc.projectService.DeleteProject(c.Request.Context(), id); err != nil {
 		if err == storage.ErrNotFound {
 			middleware.NotFoundError(c, "프로젝트를 찾을 수 없습니다")
 			return
@@ -465,4 +472,10 @@ func (pc *ProjectController) DeleteProject(c *gin.Context) {
 	}
 	
 	c.JSON(http.StatusOK, response)
-}
\ No newline at end of file
+}
+
+// hasPermission 워크스페이스 소유자이거나 ACL로 required 이상의 권한을 공유받았는지 확인
+func (pc *ProjectController) hasPermission(c *gin.Context, workspace *models.Workspace, userID string, required models.WorkspacePermission) bool {
+	permission, err := pc.access.Permission(c.Request.Context(), workspace, userID)
+	return err == nil && permission.Includes(required)
+}
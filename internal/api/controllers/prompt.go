@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// PromptController는 프롬프트 라이브러리 API를 처리합니다.
+type PromptController struct {
+	prompts *services.PromptService
+}
+
+// NewPromptController는 새로운 프롬프트 컨트롤러를 생성합니다.
+func NewPromptController(prompts *services.PromptService) *PromptController {
+	return &PromptController{
+		prompts: prompts,
+	}
+}
+
+// ListPrompts는 사용할 수 있는 프롬프트 목록을 조회합니다.
+// @Summary 프롬프트 목록 조회
+// @Description 본인 프롬프트와 조직에 공유된 프롬프트를 이름순으로 반환합니다
+// @Tags prompts
+// @Produce json
+// @Security BearerAuth
+// @Param scope query string false "mine: 본인 프롬프트만, shared: 공유 프롬프트만"
+// @Param tag query string false "태그"
+// @Param q query string false "이름 또는 설명 검색어"
+// @Param limit query int false "최대 개수"
+// @Success 200 {array} models.Prompt "프롬프트 목록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Router /prompts [get]
+func (pc *PromptController) ListPrompts(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var filter models.PromptFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	prompts, err := pc.prompts.List(c.Request.Context(), userClaims.UserID, &filter)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, prompts)
+}
+
+// CreatePrompt는 새 프롬프트를 저장합니다.
+// @Summary 프롬프트 생성
+// @Description 본문의 {{변수}}는 모두 variables에 선언해야 합니다
+// @Tags prompts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.PromptCreateRequest true "프롬프트"
+// @Success 201 {object} models.Prompt "생성된 프롬프트"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 409 {object} models.ErrorResponse "같은 이름의 프롬프트가 있음"
+// @Router /prompts [post]
+func (pc *PromptController) CreatePrompt(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PromptCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	prompt, err := pc.prompts.Create(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, prompt)
+}
+
+// GetPrompt는 프롬프트를 조회합니다.
+// @Summary 프롬프트 조회
+// @Tags prompts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "프롬프트 ID"
+// @Success 200 {object} models.Prompt "프롬프트"
+// @Failure 404 {object} models.ErrorResponse "프롬프트를 찾을 수 없음"
+// @Router /prompts/{id} [get]
+func (pc *PromptController) GetPrompt(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	prompt, err := pc.prompts.Get(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, prompt)
+}
+
+// UpdatePrompt는 프롬프트를 수정합니다.
+// @Summary 프롬프트 수정
+// @Description 작성자만 수정할 수 있습니다
+// @Tags prompts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "프롬프트 ID"
+// @Param request body models.PromptUpdateRequest true "변경할 필드"
+// @Success 200 {object} models.Prompt "수정된 프롬프트"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "작성자가 아님"
+// @Failure 404 {object} models.ErrorResponse "프롬프트를 찾을 수 없음"
+// @Router /prompts/{id} [put]
+func (pc *PromptController) UpdatePrompt(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PromptUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	prompt, err := pc.prompts.Update(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, prompt)
+}
+
+// DeletePrompt는 프롬프트를 삭제합니다.
+// @Summary 프롬프트 삭제
+// @Description 작성자만 삭제할 수 있습니다
+// @Tags prompts
+// @Security BearerAuth
+// @Param id path string true "프롬프트 ID"
+// @Success 204 "삭제 완료"
+// @Failure 403 {object} models.ErrorResponse "작성자가 아님"
+// @Failure 404 {object} models.ErrorResponse "프롬프트를 찾을 수 없음"
+// @Router /prompts/{id} [delete]
+func (pc *PromptController) DeletePrompt(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	if err := pc.prompts.Delete(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin"); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RenderPrompt는 변수를 치환한 프롬프트 본문을 미리 봅니다.
+// @Summary 프롬프트 미리보기
+// @Description 실행과 같은 규칙으로 변수를 검증하고 치환합니다
+// @Tags prompts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "프롬프트 ID"
+// @Param request body models.PromptRenderRequest true "변수 값"
+// @Success 200 {object} models.PromptRenderResponse "치환된 본문"
+// @Failure 400 {object} models.ErrorResponse "변수 검증 실패"
+// @Failure 404 {object} models.ErrorResponse "프롬프트를 찾을 수 없음"
+// @Router /prompts/{id}/render [post]
+func (pc *PromptController) RenderPrompt(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PromptRenderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	content, err := pc.prompts.Render(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", req.Variables)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.PromptRenderResponse{Content: content})
+}
+
+// LaunchPrompt는 프롬프트로 세션에서 태스크를 실행합니다.
+// @Summary 프롬프트 실행
+// @Description 변수를 치환한 본문으로 태스크를 생성합니다. 세션이 속한 워크스페이스에 execute 권한이 필요합니다
+// @Tags prompts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "프롬프트 ID"
+// @Param request body models.PromptLaunchRequest true "실행 요청"
+// @Success 201 {object} models.Task "생성된 태스크"
+// @Failure 400 {object} models.ErrorResponse "변수 검증 실패"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "프롬프트 또는 세션을 찾을 수 없음"
+// @Failure 429 {object} models.ErrorResponse "동시 실행 세션 한도 초과 (SESSION_LIMIT_EXCEEDED)"
+// @Failure 503 {object} models.ErrorResponse "유지보수 모드"
+// @Router /prompts/{id}/launch [post]
+func (pc *PromptController) LaunchPrompt(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PromptLaunchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	task, err := pc.prompts.Launch(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		if handleMaintenanceError(c, err) || handleDiskQuotaError(c, err) || handleHostOverloadedError(c, err) || handleSessionLimitError(c, err) {
+			return
+		}
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, task)
+}
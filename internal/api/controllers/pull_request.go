@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// PullRequestController는 워크스페이스 PR 설정과 태스크 결과 PR/MR 생성을 처리합니다.
+// 워크스페이스/태스크 권한은 라우터의 권한 미들웨어에서 확인합니다.
+type PullRequestController struct {
+	pullRequests *services.PullRequestService
+}
+
+// NewPullRequestController는 새로운 PR 컨트롤러를 생성합니다.
+func NewPullRequestController(pullRequests *services.PullRequestService) *PullRequestController {
+	return &PullRequestController{
+		pullRequests: pullRequests,
+	}
+}
+
+// GetConfig는 워크스페이스의 PR 설정을 조회합니다.
+// @Summary 워크스페이스 PR 설정 조회
+// @Description 액세스 토큰은 설정 여부(has_token)만 반환합니다
+// @Tags pull-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {object} models.PullRequestConfig "PR 설정"
+// @Failure 404 {object} models.ErrorResponse "설정 없음"
+// @Router /workspaces/{id}/pull-request-config [get]
+func (pc *PullRequestController) GetConfig(c *gin.Context) {
+	config, err := pc.pullRequests.GetConfig(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, config)
+}
+
+// PutConfig는 워크스페이스의 PR 설정을 저장합니다.
+// @Summary 워크스페이스 PR 설정 저장
+// @Description token을 비워 두면 기존 토큰을 유지합니다
+// @Tags pull-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.PullRequestConfigRequest true "PR 설정"
+// @Success 200 {object} models.PullRequestConfig "저장된 설정"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "워크스페이스 관리 권한 없음"
+// @Router /workspaces/{id}/pull-request-config [put]
+func (pc *PullRequestController) PutConfig(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PullRequestConfigRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	config, err := pc.pullRequests.SaveConfig(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, config)
+}
+
+// DeleteConfig는 워크스페이스의 PR 설정을 삭제합니다.
+// @Summary 워크스페이스 PR 설정 삭제
+// @Tags pull-requests
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 204 "삭제됨"
+// @Failure 404 {object} models.ErrorResponse "설정 없음"
+// @Router /workspaces/{id}/pull-request-config [delete]
+func (pc *PullRequestController) DeleteConfig(c *gin.Context) {
+	if err := pc.pullRequests.DeleteConfig(c.Request.Context(), c.Param("id")); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListPullRequests는 워크스페이스에서 만든 PR 목록을 조회합니다.
+// @Summary 워크스페이스 PR 목록 조회
+// @Tags pull-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {array} models.PullRequest "PR 목록 (최신순)"
+// @Router /workspaces/{id}/pull-requests [get]
+func (pc *PullRequestController) ListPullRequests(c *gin.Context) {
+	prs, err := pc.pullRequests.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, prs)
+}
+
+// CreatePullRequest는 완료된 태스크의 변경 사항으로 PR/MR을 생성합니다.
+// @Summary 태스크 결과 PR 생성
+// @Description 변경 사항을 태스크 브랜치에 커밋해 푸시하고, 세션 요약과 세션 기록 링크를 담은 PR/MR을 엽니다
+// @Tags pull-requests
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Param request body models.PullRequestCreateRequest false "PR 옵션"
+// @Success 201 {object} models.PullRequest "생성된 PR"
+// @Failure 400 {object} models.ErrorResponse "완료되지 않았거나 변경 사항이 없는 태스크, PR 설정 없음"
+// @Failure 409 {object} models.ErrorResponse "이미 PR이 있음"
+// @Router /tasks/{id}/pull-request [post]
+func (pc *PullRequestController) CreatePullRequest(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.PullRequestCreateRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+			return
+		}
+	}
+
+	pr, err := pc.pullRequests.Create(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, pr)
+}
+
+// GetTaskPullRequest는 태스크로 만든 PR을 조회합니다.
+// @Summary 태스크 PR 조회
+// @Tags pull-requests
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Success 200 {object} models.PullRequest "PR (실패한 경우 error 포함)"
+// @Failure 404 {object} models.ErrorResponse "PR 없음"
+// @Router /tasks/{id}/pull-request [get]
+func (pc *PullRequestController) GetTaskPullRequest(c *gin.Context) {
+	pr, err := pc.pullRequests.GetByTask(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pr)
+}
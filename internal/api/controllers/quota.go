@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+// QuotaController는 관리자용 Claude 자격 증명 풀 API를 처리합니다.
+type QuotaController struct {
+	quota *claude.QuotaGovernor
+}
+
+// NewQuotaController는 새로운 쿼터 컨트롤러를 생성합니다.
+func NewQuotaController(quota *claude.QuotaGovernor) *QuotaController {
+	return &QuotaController{
+		quota: quota,
+	}
+}
+
+// GetQuota는 자격 증명별 상태와 사용 현황을 조회합니다.
+// @Summary Claude 자격 증명 풀 현황 조회
+// @Description 자격 증명별 상태(healthy, quarantined, budget_exhausted), 최근 1분 요청 수, 실행 중인 요청 수, 오늘 사용한 토큰과 비용, 격리 사유와 해제 시각을 반환합니다 (키 값은 포함하지 않음)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} claude.QuotaCredentialStatus "자격 증명별 현황"
+// @Router /admin/quota [get]
+func (qc *QuotaController) GetQuota(c *gin.Context) {
+	c.JSON(http.StatusOK, qc.quota.Status())
+}
+
+// ReinstateCredential은 격리된 자격 증명을 바로 다시 사용하도록 해제합니다.
+// @Summary Claude 자격 증명 격리 해제
+// @Description 인증 실패나 한도 초과로 격리된 자격 증명을 격리 시간이 끝나기 전에 다시 사용합니다 (키를 교체한 뒤 등)
+// @Tags admin
+// @Produce json
+// @Param name path string true "자격 증명 이름"
+// @Security BearerAuth
+// @Success 200 {array} claude.QuotaCredentialStatus "자격 증명별 현황"
+// @Failure 404 {object} models.ErrorResponse "등록되지 않은 자격 증명"
+// @Router /admin/quota/{name}/reinstate [post]
+func (qc *QuotaController) ReinstateCredential(c *gin.Context) {
+	if err := qc.quota.Reinstate(c.Param("name")); err != nil {
+		if errors.Is(err, claude.ErrUnknownCredential) {
+			middleware.NotFoundError(c, "등록되지 않은 자격 증명입니다")
+			return
+		}
+		middleware.InternalError(c, "자격 증명 격리 해제에 실패했습니다", err.Error())
+		return
+	}
+	c.JSON(http.StatusOK, qc.quota.Status())
+}
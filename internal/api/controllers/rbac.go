@@ -1,7 +1,7 @@
 package controllers
 
 import (
-	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -16,6 +16,7 @@ import (
 type RBACController struct {
 	rbacManager *auth.RBACManager
 	storage     storage.Storage
+	events      *auth.RBACEventManager // 변경 이벤트 발행 (없으면 발행하지 않음)
 }
 
 // NewRBACController RBAC 컨트롤러 생성자
@@ -26,6 +27,18 @@ func NewRBACController(rbacManager *auth.RBACManager, storage storage.Storage) *
 	}
 }
 
+// SetEventManager 역할/권한 변경을 RBAC 이벤트 버스에 알릴 이벤트 관리자 설정
+func (rc *RBACController) SetEventManager(events *auth.RBACEventManager) {
+	rc.events = events
+}
+
+// emitRoleEvent 역할 변경 이벤트 발행 (구독자 실패는 요청 결과에 영향을 주지 않음)
+func (rc *RBACController) emitRoleEvent(c *gin.Context, eventType auth.RBACEventType, roleID string, metadata map[string]interface{}) {
+	if rc.events != nil {
+		rc.events.EmitRoleEvent(eventType, roleID, c.GetString("user_id"), metadata)
+	}
+}
+
 // 역할 관리 API
 
 // CreateRole godoc
@@ -67,7 +80,7 @@ func (rc *RBACController) CreateRole(c *gin.Context) {
 
 	// 부모 역할이 있는 경우 레벨 계산
 	if req.ParentID != nil {
-		ctx := context.Background()
+		ctx := c.Request.Context()
 		parentRole, err := rc.storage.RBAC().GetRoleByID(ctx, *req.ParentID)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
@@ -83,7 +96,7 @@ func (rc *RBACController) CreateRole(c *gin.Context) {
 		role.Level = parentRole.Level + 1
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	if err := rc.storage.RBAC().CreateRole(ctx, role); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -127,7 +140,7 @@ func (rc *RBACController) GetRole(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	role, err := rc.storage.RBAC().GetRoleByID(ctx, roleID)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{
@@ -182,7 +195,7 @@ func (rc *RBACController) ListRoles(c *gin.Context) {
 		active = &activeBool
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	roles, total, err := rc.storage.RBAC().ListRoles(ctx, models.ListRolesRequest{
 		Page:   page,
 		Limit:  limit,
@@ -226,6 +239,7 @@ func (rc *RBACController) ListRoles(c *gin.Context) {
 // @Success 200 {object} models.Role
 // @Failure 400 {object} gin.H
 // @Failure 404 {object} gin.H
+// @Failure 409 {object} gin.H "버전 충돌 (다른 요청이 먼저 수정함)"
 // @Failure 500 {object} gin.H
 // @Security BearerAuth
 // @Router /api/v1/rbac/roles/{id} [put]
@@ -255,7 +269,7 @@ func (rc *RBACController) UpdateRole(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	
 	// 기존 역할 조회
 	role, err := rc.storage.RBAC().GetRoleByID(ctx, roleID)
@@ -314,8 +328,27 @@ func (rc *RBACController) UpdateRole(c *gin.Context) {
 	if req.IsActive != nil {
 		role.IsActive = *req.IsActive
 	}
+	// 요청이 기준으로 삼은 버전이 있으면 그 뒤에 다른 수정이 없었을 때만 저장
+	if req.Version > 0 {
+		role.Version = req.Version
+	}
 
 	if err := rc.storage.RBAC().UpdateRole(ctx, role); err != nil {
+		var conflict *storage.ConflictError
+		if errors.As(err, &conflict) {
+			c.JSON(http.StatusConflict, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "VERSION_CONFLICT",
+					"message": "Role was modified by another request",
+					"details": gin.H{
+						"expected_version": conflict.ExpectedVersion,
+						"current_version":  conflict.CurrentVersion,
+					},
+				},
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"error": gin.H{
@@ -329,6 +362,7 @@ func (rc *RBACController) UpdateRole(c *gin.Context) {
 
 	// 역할 변경 시 캐시 무효화
 	rc.rbacManager.InvalidateRolePermissions(roleID)
+	rc.emitRoleEvent(c, auth.EventRoleUpdated, roleID, nil)
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -362,7 +396,7 @@ func (rc *RBACController) DeleteRole(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	
 	// 기존 역할 조회
 	role, err := rc.storage.RBAC().GetRoleByID(ctx, roleID)
@@ -432,6 +466,7 @@ func (rc *RBACController) DeleteRole(c *gin.Context) {
 
 	// 역할 삭제 시 캐시 무효화
 	rc.rbacManager.InvalidateRolePermissions(roleID)
+	rc.emitRoleEvent(c, auth.EventRoleDeleted, roleID, nil)
 
 	c.Status(http.StatusNoContent)
 }
@@ -476,7 +511,7 @@ func (rc *RBACController) CreatePermission(c *gin.Context) {
 		IsActive:     true,
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	if err := rc.storage.RBAC().CreatePermission(ctx, permission); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -527,7 +562,7 @@ func (rc *RBACController) ListPermissions(c *gin.Context) {
 	action := c.Query("action")
 	effect := c.Query("effect")
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	permissions, total, err := rc.storage.RBAC().ListPermissions(ctx, models.ListPermissionsRequest{
 		Page:         page,
 		Limit:        limit,
@@ -611,7 +646,7 @@ func (rc *RBACController) AssignRoleToUser(c *gin.Context) {
 		IsActive:   true,
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	if err := rc.storage.RBAC().AssignRoleToUser(ctx, userRole); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -626,6 +661,7 @@ func (rc *RBACController) AssignRoleToUser(c *gin.Context) {
 
 	// 사용자 권한 캐시 무효화
 	rc.rbacManager.InvalidateUserPermissions(req.UserID)
+	rc.emitRoleEvent(c, auth.EventRoleAssigned, req.RoleID, map[string]interface{}{"user_id": req.UserID})
 
 	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
@@ -661,7 +697,7 @@ func (rc *RBACController) CheckPermission(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	response, err := rc.rbacManager.CheckPermission(ctx, &req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -707,7 +743,7 @@ func (rc *RBACController) GetUserPermissions(c *gin.Context) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := c.Request.Context()
 	matrix, err := rc.rbacManager.ComputeUserPermissionMatrix(ctx, userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -824,6 +860,9 @@ func (rc *RBACController) InvalidateCache(c *gin.Context) {
 		})
 		return
 	}
+	if rc.events != nil {
+		rc.events.EmitCacheEvent(cacheType, targetID, c.GetString("user_id"))
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/remote"
+)
+
+// RemoteAgentController는 관리자용 원격 에이전트 조회 API를 처리합니다.
+type RemoteAgentController struct {
+	registry *remote.Registry
+}
+
+// NewRemoteAgentController는 새로운 원격 에이전트 컨트롤러를 생성합니다.
+func NewRemoteAgentController(registry *remote.Registry) *RemoteAgentController {
+	return &RemoteAgentController{
+		registry: registry,
+	}
+}
+
+// ListAgents는 접속 중인 원격 에이전트와 실행 중인 태스크를 조회합니다.
+// @Summary 원격 에이전트 목록 조회
+// @Description 접속 중인 원격 에이전트의 레이블, 동시 실행 한도, 실행 중인 태스크와 마지막 하트비트 시각을 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} remote.AgentInfo "원격 에이전트 목록"
+// @Router /admin/remote-agents [get]
+func (rc *RemoteAgentController) ListAgents(c *gin.Context) {
+	c.JSON(http.StatusOK, rc.registry.List())
+}
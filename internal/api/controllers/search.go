@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// SearchController는 통합 검색 API를 처리합니다.
+type SearchController struct {
+	searchService *services.SearchService
+}
+
+// NewSearchController는 새로운 통합 검색 컨트롤러를 생성합니다.
+func NewSearchController(searchService *services.SearchService) *SearchController {
+	return &SearchController{
+		searchService: searchService,
+	}
+}
+
+// Search는 워크스페이스, 세션, 프롬프트, 셸 명령 감사 기록을 한 번에 검색합니다.
+// @Summary 통합 검색
+// @Description 접근할 수 있는 문서만 관련도순으로 반환하며, 같은 조건의 종류/소유자/생성 월별 개수(facets)를 함께 반환합니다
+// @Tags search
+// @Produce json
+// @Security BearerAuth
+// @Param q query string false "검색어 (공백으로 구분한 모든 단어 포함, 단어 앞부분 일치)"
+// @Param type query []string false "문서 종류 (workspace, session, prompt, audit)" collectionFormat(multi)
+// @Param owner_id query string false "소유자 ID"
+// @Param from query string false "생성 시각 시작 (RFC3339 또는 YYYY-MM-DD)"
+// @Param to query string false "생성 시각 끝 (RFC3339 또는 YYYY-MM-DD, 날짜는 그날 끝까지 포함)"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Success 200 {object} models.SearchResponse "검색 결과와 패싯"
+// @Failure 400 {object} models.ErrorResponse "잘못된 검색 조건"
+// @Router /search [get]
+func (sc *SearchController) Search(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.SearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "검색 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	response, err := sc.searchService.Search(c.Request.Context(), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -98,6 +98,7 @@ type AttackDetectionRequest struct {
 	Query     string            `json:"query,omitempty"`
 	Headers   map[string]string `json:"headers,omitempty"`
 	Body      string            `json:"body,omitempty"`
+	ContentType string          `json:"content_type,omitempty"`
 }
 
 // NewSecurityController는 새로운 보안 컨트롤러를 생성합니다.
@@ -361,6 +362,7 @@ func (sc *SecurityController) DetectAttack(c *gin.Context) {
 		Query:     req.Query,
 		Headers:   req.Headers,
 		Body:      req.Body,
+		ContentType: req.ContentType,
 		Timestamp: time.Now(),
 	}
 
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/security"
+)
+
+// 보안 대시보드 조회 기본값과 한도
+const (
+	defaultSecurityDashboardPeriod   = 24 * time.Hour
+	maxSecurityDashboardPeriod       = 30 * 24 * time.Hour // 이벤트 기본 보관 기간
+	defaultSecurityDashboardInterval = time.Hour
+	defaultSecurityDashboardLimit    = 50
+)
+
+// SecurityDashboardController는 보안 관리자용 보안 대시보드 집계 API를 처리합니다.
+type SecurityDashboardController struct {
+	dashboard *security.SecurityDashboard
+}
+
+// NewSecurityDashboardController는 새로운 보안 대시보드 컨트롤러를 생성합니다.
+func NewSecurityDashboardController(dashboard *security.SecurityDashboard) *SecurityDashboardController {
+	return &SecurityDashboardController{dashboard: dashboard}
+}
+
+// GetOverview는 기간 동안의 보안 이벤트 요약을 조회합니다.
+// @Summary 보안 대시보드 요약
+// @Description 타입별 이벤트 수, 공격 유형과 출처, 로그인 실패와 무차별 대입 탐지, 이상 이벤트, 차단 중인 IP 수를 반환합니다. 공격 유형과 출처는 최근 이벤트 1000개로 집계합니다
+// @Tags security
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "지금부터 거슬러 올라갈 기간 (기본 24h, 최대 720h)"
+// @Success 200 {object} security.DashboardOverview "보안 이벤트 요약"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Failure 403 {object} models.ErrorResponse "보안 관리자 권한 없음"
+// @Router /security/dashboard [get]
+func (sc *SecurityDashboardController) GetOverview(c *gin.Context) {
+	_, since, until, ok := bindSecurityDashboardQuery(c)
+	if !ok {
+		return
+	}
+
+	overview, err := sc.dashboard.Overview(c.Request.Context(), since, until)
+	if err != nil {
+		middleware.InternalError(c, "보안 대시보드 집계 실패", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, overview)
+}
+
+// ListEvents는 분류별 최근 보안 이벤트를 조회합니다.
+// @Summary 최근 보안 이벤트
+// @Description 공격(attack), 무차별 대입(brute_force), 이상(anomaly) 분류의 이벤트를 최근 것부터 반환합니다 (분류를 비우면 모든 분류)
+// @Tags security
+// @Produce json
+// @Security BearerAuth
+// @Param category query string false "이벤트 분류 (attack, brute_force, anomaly)"
+// @Param period query string false "지금부터 거슬러 올라갈 기간 (기본 24h, 최대 720h)"
+// @Param limit query int false "최대 개수 (기본 50, 최대 500)"
+// @Success 200 {array} security.SecurityEvent "보안 이벤트"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Failure 403 {object} models.ErrorResponse "보안 관리자 권한 없음"
+// @Router /security/dashboard/events [get]
+func (sc *SecurityDashboardController) ListEvents(c *gin.Context) {
+	query, since, _, ok := bindSecurityDashboardQuery(c)
+	if !ok {
+		return
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultSecurityDashboardLimit
+	}
+
+	events, err := sc.dashboard.RecentEvents(c.Request.Context(), query.Category, since, query.Limit)
+	if err != nil {
+		middleware.InternalError(c, "보안 이벤트 조회 실패", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// ListBlockedIPs는 자동 차단 중인 IP를 조회합니다.
+// @Summary 차단 중인 IP
+// @Description 공격이나 무차별 대입으로 자동 차단한 IP를 차단 해제가 늦은 것부터 반환합니다
+// @Tags security
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} security.BlockedIP "차단 중인 IP"
+// @Failure 403 {object} models.ErrorResponse "보안 관리자 권한 없음"
+// @Router /security/dashboard/blocked-ips [get]
+func (sc *SecurityDashboardController) ListBlockedIPs(c *gin.Context) {
+	blocked, err := sc.dashboard.BlockedIPs(c.Request.Context())
+	if err != nil {
+		middleware.InternalError(c, "차단 IP 조회 실패", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, blocked)
+}
+
+// GetTrend는 구간별 보안 이벤트 수를 조회합니다.
+// @Summary 보안 이벤트 추세
+// @Description 기간을 interval 간격으로 나눠 구간별, 타입별 이벤트 수를 반환합니다 (구간은 최대 500개)
+// @Tags security
+// @Produce json
+// @Security BearerAuth
+// @Param category query string false "이벤트 분류 (attack, brute_force, anomaly)"
+// @Param period query string false "지금부터 거슬러 올라갈 기간 (기본 24h, 최대 720h)"
+// @Param interval query string false "구간 간격 (기본 1h, 최소 1m)"
+// @Success 200 {object} security.DashboardTrend "구간별 이벤트 수"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Failure 403 {object} models.ErrorResponse "보안 관리자 권한 없음"
+// @Router /security/dashboard/trend [get]
+func (sc *SecurityDashboardController) GetTrend(c *gin.Context) {
+	query, since, until, ok := bindSecurityDashboardQuery(c)
+	if !ok {
+		return
+	}
+
+	interval := defaultSecurityDashboardInterval
+	if query.Interval != "" {
+		parsed, err := time.ParseDuration(query.Interval)
+		if err != nil || parsed < time.Minute {
+			middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", "interval은 1m 이상의 기간이어야 합니다")
+			return
+		}
+		interval = parsed
+	}
+	if buckets := int((until.Sub(since) + interval - 1) / interval); buckets > security.MaxEventTrendBuckets {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다",
+			fmt.Sprintf("구간이 너무 많습니다: %d (최대 %d)", buckets, security.MaxEventTrendBuckets))
+		return
+	}
+
+	trend, err := sc.dashboard.Trend(c.Request.Context(), query.Category, since, until, interval)
+	if err != nil {
+		middleware.InternalError(c, "보안 이벤트 추세 조회 실패", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, trend)
+}
+
+// bindSecurityDashboardQuery는 조회 조건을 읽고 조회 기간을 계산합니다.
+// 잘못된 조건이면 응답을 보내고 false를 반환합니다.
+func bindSecurityDashboardQuery(c *gin.Context) (models.SecurityDashboardQuery, time.Time, time.Time, bool) {
+	var query models.SecurityDashboardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", err.Error())
+		return query, time.Time{}, time.Time{}, false
+	}
+
+	period := defaultSecurityDashboardPeriod
+	if query.Period != "" {
+		parsed, err := time.ParseDuration(query.Period)
+		if err != nil || parsed < time.Minute || parsed > maxSecurityDashboardPeriod {
+			middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", "period는 1m 이상 720h 이하의 기간이어야 합니다")
+			return query, time.Time{}, time.Time{}, false
+		}
+		period = parsed
+	}
+
+	until := time.Now()
+	return query, until.Add(-period), until, true
+}
@@ -1,9 +1,11 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/aicli/aicli-web/internal/logging"
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/services"
 	"github.com/gin-gonic/gin"
@@ -13,7 +15,7 @@ import (
 // SessionController 세션 관리 컨트롤러
 type SessionController struct {
 	sessionService *services.SessionService
-	logger         *zap.Logger
+	logger         logging.Logger
 }
 
 // NewSessionController 새 세션 컨트롤러 생성
@@ -21,10 +23,15 @@ func NewSessionController(sessionService *services.SessionService) *SessionContr
 	logger, _ := zap.NewProduction()
 	return &SessionController{
 		sessionService: sessionService,
-		logger:         logger,
+		logger:         logging.FromZap(logger),
 	}
 }
 
+// SetLogger 컨트롤러 로거 설정 (기본값은 zap 프로덕션 로거)
+func (c *SessionController) SetLogger(logger logging.Logger) {
+	c.logger = logger
+}
+
 // Create 새 세션 생성
 // @Summary 새 Claude 세션 생성
 // @Description 프로젝트에 대한 새로운 Claude CLI 세션을 생성합니다
@@ -65,12 +72,9 @@ func (c *SessionController) Create(ctx *gin.Context) {
 	// 요청에 프로젝트 ID 설정
 	req.ProjectID = projectID
 
-	session, err := c.sessionService.Create(ctx, &req)
+	session, err := c.sessionService.Create(ctx.Request.Context(), &req)
 	if err != nil {
-		c.logger.Error("세션 생성 실패",
-			zap.String("project_id", projectID),
-			zap.Error(err),
-		)
+		c.logger.WithContext(ctx.Request.Context()).WithField("project_id", projectID).WithError(err).Error("세션 생성 실패")
 		
 		statusCode := http.StatusInternalServerError
 		if err.Error() == "프로젝트를 찾을 수 없습니다" {
@@ -102,6 +106,10 @@ func (c *SessionController) Create(ctx *gin.Context) {
 // @Param active query boolean false "활성 세션만 조회"
 // @Param page query int false "페이지 번호" default(1)
 // @Param limit query int false "페이지 크기" default(20)
+// @Param sort query string false "정렬 기준 (created_at, updated_at, last_active)" default("created_at")
+// @Param order query string false "정렬 순서 (asc, desc)" default("desc")
+// @Param cursor query string false "다음 페이지 커서 (meta.next_cursor)"
+// @Param fields query string false "응답에 포함할 필드 (쉼표 구분)"
 // @Success 200 {object} models.PagingResponse[models.SessionResponse]
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
@@ -123,8 +131,12 @@ func (c *SessionController) List(ctx *gin.Context) {
 	}
 	
 	paging := &models.PagingRequest{
-		Page:  1,
-		Limit: 20,
+		Page:   1,
+		Limit:  20,
+		Sort:   ctx.Query("sort"),
+		Order:  ctx.Query("order"),
+		Cursor: ctx.Query("cursor"),
+		Fields: ctx.Query("fields"),
 	}
 	
 	if page, err := strconv.Atoi(ctx.Query("page")); err == nil && page > 0 {
@@ -135,9 +147,19 @@ func (c *SessionController) List(ctx *gin.Context) {
 		paging.Limit = limit
 	}
 	
-	result, err := c.sessionService.List(ctx, filter, paging)
+	result, err := c.sessionService.List(ctx.Request.Context(), filter, paging)
+	if errors.Is(err, models.ErrInvalidCursor) || errors.Is(err, models.ErrInvalidSortField) {
+		ctx.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: models.ErrorDetail{
+				Code:    "INVALID_PAGINATION",
+				Message: "잘못된 정렬 또는 커서 파라미터입니다",
+				Details: err.Error(),
+			},
+		})
+		return
+	}
 	if err != nil {
-		c.logger.Error("세션 목록 조회 실패", zap.Error(err))
+		c.logger.WithContext(ctx.Request.Context()).WithError(err).Error("세션 목록 조회 실패")
 		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: models.ErrorDetail{
 				Code:    "SESSION_LIST_FAILED",
@@ -155,8 +177,9 @@ func (c *SessionController) List(ctx *gin.Context) {
 		responses[i] = session.ToResponse()
 	}
 	
+	// 요청된 필드만 응답 (sparse fieldset)
 	ctx.JSON(http.StatusOK, models.PaginationResponse{
-		Data: responses,
+		Data: models.SelectFields(responses, paging.FieldList()),
 		Meta: result.Meta,
 	})
 }
@@ -184,7 +207,7 @@ func (c *SessionController) GetByID(ctx *gin.Context) {
 		return
 	}
 	
-	session, err := c.sessionService.GetByID(ctx, id)
+	session, err := c.sessionService.GetByID(ctx.Request.Context(), id)
 	if err != nil {
 		if err.Error() == "not found" {
 			ctx.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -196,10 +219,7 @@ func (c *SessionController) GetByID(ctx *gin.Context) {
 			return
 		}
 		
-		c.logger.Error("세션 조회 실패",
-			zap.String("session_id", id),
-			zap.Error(err),
-		)
+		c.logger.WithContext(ctx.Request.Context()).WithField("session_id", id).WithError(err).Error("세션 조회 실패")
 		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: models.ErrorDetail{
 				Code:    "SESSION_GET_FAILED",
@@ -236,7 +256,7 @@ func (c *SessionController) Terminate(ctx *gin.Context) {
 		return
 	}
 	
-	err := c.sessionService.Terminate(ctx, id)
+	err := c.sessionService.Terminate(ctx.Request.Context(), id)
 	if err != nil {
 		if err.Error() == "not found" {
 			ctx.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -248,10 +268,7 @@ func (c *SessionController) Terminate(ctx *gin.Context) {
 			return
 		}
 		
-		c.logger.Error("세션 종료 실패",
-			zap.String("session_id", id),
-			zap.Error(err),
-		)
+		c.logger.WithContext(ctx.Request.Context()).WithField("session_id", id).WithError(err).Error("세션 종료 실패")
 		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: models.ErrorDetail{
 				Code:    "SESSION_TERMINATE_FAILED",
@@ -288,7 +305,7 @@ func (c *SessionController) UpdateActivity(ctx *gin.Context) {
 		return
 	}
 	
-	err := c.sessionService.UpdateActivity(ctx, id)
+	err := c.sessionService.UpdateActivity(ctx.Request.Context(), id)
 	if err != nil {
 		if err.Error() == "세션을 찾을 수 없습니다" {
 			ctx.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -300,10 +317,7 @@ func (c *SessionController) UpdateActivity(ctx *gin.Context) {
 			return
 		}
 		
-		c.logger.Error("세션 활동 업데이트 실패",
-			zap.String("session_id", id),
-			zap.Error(err),
-		)
+		c.logger.WithContext(ctx.Request.Context()).WithField("session_id", id).WithError(err).Error("세션 활동 업데이트 실패")
 		ctx.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: models.ErrorDetail{
 				Code:    "SESSION_UPDATE_ACTIVITY_FAILED",
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// maxReplayDelay 재생 시 메시지 사이 최대 대기 시간 (긴 공백 구간 단축)
+const maxReplayDelay = 10 * time.Second
+
+// SessionBranchController는 세션 분기와 대화 기록 재생 API를 처리합니다.
+type SessionBranchController struct {
+	branchService *services.SessionBranchService
+}
+
+// NewSessionBranchController는 새로운 세션 분기 컨트롤러를 생성합니다.
+func NewSessionBranchController(branchService *services.SessionBranchService) *SessionBranchController {
+	return &SessionBranchController{
+		branchService: branchService,
+	}
+}
+
+// Fork는 세션을 지정한 메시지 지점에서 새 세션으로 분기합니다.
+// @Summary 세션 분기
+// @Description 지정한 메시지까지의 대화 기록을 이어받는 새 Claude 세션을 생성합니다
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Param request body models.SessionForkRequest true "분기 요청"
+// @Security BearerAuth
+// @Success 201 {object} models.SessionForkResult "분기된 세션"
+// @Failure 400 {object} models.ErrorResponse "잘못된 분기 지점"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /sessions/{id}/fork [post]
+func (bc *SessionBranchController) Fork(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	var req models.SessionForkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 형식", err.Error())
+		return
+	}
+
+	result, err := bc.branchService.Fork(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// Replay는 세션 대화 기록을 Server-Sent Events로 재생합니다.
+// 각 message 이벤트는 원래 시간 간격(offset_ms, delay_ms)을 포함하며 마지막에 done 이벤트를 전송합니다.
+// @Summary 세션 재생
+// @Tags sessions
+// @Produce text/event-stream
+// @Param id path string true "세션 ID"
+// @Param speed query number false "재생 속도 배율 (0이면 대기 없이 전송)" default(1)
+// @Security BearerAuth
+// @Success 200 {object} models.SessionReplayEvent "재생 이벤트 스트림"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /sessions/{id}/replay [get]
+func (bc *SessionBranchController) Replay(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	speed := 1.0
+	if value := c.Query("speed"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed < 0 || parsed > 100 {
+			middleware.ValidationError(c, "잘못된 재생 속도", "speed는 0에서 100 사이의 숫자여야 합니다")
+			return
+		}
+		speed = parsed
+	}
+
+	events, err := bc.branchService.Replay(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	next := 0
+	c.Stream(func(w io.Writer) bool {
+		if next >= len(events) {
+			c.SSEvent("done", gin.H{"session_id": c.Param("id"), "total": len(events)})
+			return false
+		}
+
+		event := events[next]
+		if wait := replayDelay(event.DelayMS, speed); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-c.Request.Context().Done():
+				timer.Stop()
+				return false
+			case <-timer.C:
+			}
+		}
+
+		c.SSEvent("message", event)
+		next++
+		return true
+	})
+}
+
+// replayDelay 재생 속도를 반영한 대기 시간
+func replayDelay(delayMS int64, speed float64) time.Duration {
+	if speed == 0 || delayMS <= 0 {
+		return 0
+	}
+	wait := time.Duration(float64(delayMS) / speed * float64(time.Millisecond))
+	if wait > maxReplayDelay {
+		return maxReplayDelay
+	}
+	return wait
+}
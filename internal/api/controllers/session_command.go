@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// SessionCommandController는 세션 셸 명령 실행 기록 조회와 내보내기 API를 처리합니다.
+type SessionCommandController struct {
+	commandService *services.SessionCommandService
+}
+
+// NewSessionCommandController는 새로운 명령 실행 기록 컨트롤러를 생성합니다.
+func NewSessionCommandController(commandService *services.SessionCommandService) *SessionCommandController {
+	return &SessionCommandController{
+		commandService: commandService,
+	}
+}
+
+// ListSessionCommands는 세션에서 실행된 셸 명령을 실행 순서대로 조회합니다.
+// @Summary 세션 명령 실행 기록 조회
+// @Description Bash 도구로 실행된 명령, 작업 디렉토리, 종료 코드, 잘린 출력을 반환합니다
+// @Tags sessions
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Security BearerAuth
+// @Success 200 {object} models.PaginationResponse "명령 실행 기록 목록"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /sessions/{id}/commands [get]
+func (cc *SessionCommandController) ListSessionCommands(c *gin.Context) {
+	var req models.PaginationRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	response, err := cc.commandService.ListBySession(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ExportSessionCommands는 세션의 명령 실행 기록 전체를 감사용 파일로 내려받습니다.
+// @Summary 세션 명령 실행 기록 내보내기
+// @Description 컴플라이언스 검토용으로 전체 기록을 CSV 또는 JSON Lines 파일로 반환합니다
+// @Tags sessions
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param id path string true "세션 ID"
+// @Param format query string false "내보내기 형식" Enums(csv, jsonl) default(csv)
+// @Security BearerAuth
+// @Success 200 {file} file "명령 실행 기록"
+// @Failure 400 {object} models.ErrorResponse "지원하지 않는 형식"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /sessions/{id}/commands/export [get]
+func (cc *SessionCommandController) ExportSessionCommands(c *gin.Context) {
+	sessionID := c.Param("id")
+	format := models.SessionCommandExportFormat(c.DefaultQuery("format", string(models.SessionCommandExportCSV)))
+
+	// 에러 발생 시 JSON 응답을 보낼 수 있도록 버퍼에 먼저 작성
+	var buf bytes.Buffer
+	if err := cc.commandService.Export(c.Request.Context(), &buf, sessionID, format); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == models.SessionCommandExportJSONL {
+		contentType = "application/x-ndjson"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="session-%s-commands.%s"`, sessionID, format))
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
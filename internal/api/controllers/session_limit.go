@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// SessionLimitController는 관리자용 동시 실행 세션 한도 API를 처리합니다.
+type SessionLimitController struct {
+	limits *services.SessionLimitService
+}
+
+// NewSessionLimitController는 새로운 동시 실행 세션 한도 컨트롤러를 생성합니다.
+func NewSessionLimitController(limits *services.SessionLimitService) *SessionLimitController {
+	return &SessionLimitController{
+		limits: limits,
+	}
+}
+
+// GetSessionLimits는 동시 실행 세션 한도와 사용 현황을 조회합니다.
+// @Summary 동시 실행 세션 한도 조회
+// @Description 사용자별, 서버 전체 동시 실행 Claude 세션 한도와 현재 실행 중인 세션 수를 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.SessionLimitStatus "동시 실행 세션 한도와 사용 현황"
+// @Router /admin/session-limits [get]
+func (lc *SessionLimitController) GetSessionLimits(c *gin.Context) {
+	c.JSON(http.StatusOK, lc.limits.Status())
+}
+
+// UpdateSessionLimits는 동시 실행 세션 한도를 바꿉니다.
+// @Summary 동시 실행 세션 한도 변경
+// @Description 한도에 도달하면 새 세션의 태스크를 429 SESSION_LIMIT_EXCEEDED로 거부합니다. 0은 무제한이며, users는 요청 값으로 교체됩니다. 한도를 낮춰도 이미 실행 중인 세션은 계속 진행됩니다
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.SessionLimitSettings true "동시 실행 세션 한도"
+// @Success 200 {object} models.SessionLimitStatus "변경된 한도와 사용 현황"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Router /admin/session-limits [put]
+func (lc *SessionLimitController) UpdateSessionLimits(c *gin.Context) {
+	var req models.SessionLimitSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, lc.limits.Update(req))
+}
+
+// handleSessionLimitError는 동시 실행 세션 한도로 거부된 요청이면 429와 사용량 정보로 응답합니다.
+func handleSessionLimitError(c *gin.Context, err error) bool {
+	var limitErr *services.SessionLimitError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+
+	middleware.AbortWithError(c, http.StatusTooManyRequests, services.SessionLimitErrorCode, limitErr.Error(), limitErr.Usage)
+	return true
+}
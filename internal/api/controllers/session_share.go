@@ -0,0 +1,216 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// ShareLinkDisconnector는 폐기된 공유 링크로 참여한 실시간 연결을 종료합니다.
+type ShareLinkDisconnector interface {
+	DisconnectShareLink(linkID string) int
+}
+
+// SessionShareController는 세션 공유 링크 관리와 공유 링크 접근 API를 처리합니다.
+type SessionShareController struct {
+	shareService *services.SessionShareService
+	disconnector ShareLinkDisconnector
+}
+
+// NewSessionShareController는 새로운 세션 공유 컨트롤러를 생성합니다.
+// disconnector가 nil이면 링크 폐기 시 기존 연결을 종료하지 않습니다.
+func NewSessionShareController(shareService *services.SessionShareService, disconnector ShareLinkDisconnector) *SessionShareController {
+	return &SessionShareController{
+		shareService: shareService,
+		disconnector: disconnector,
+	}
+}
+
+// Create는 세션 공유 링크를 생성합니다. 토큰 원문은 이 응답에서만 확인할 수 있습니다.
+// @Summary 세션 공유 링크 생성
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Param request body models.CreateShareLinkRequest true "공유 링크 설정"
+// @Security BearerAuth
+// @Success 201 {object} models.CreateShareLinkResponse "생성된 공유 링크"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /sessions/{id}/share-links [post]
+func (sc *SessionShareController) Create(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	var req models.CreateShareLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 형식", err.Error())
+		return
+	}
+
+	response, err := sc.shareService.Create(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// List는 세션의 공유 링크 목록을 조회합니다.
+// @Summary 세션 공유 링크 목록
+// @Tags sessions
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Security BearerAuth
+// @Success 200 {array} models.SessionShareLink "공유 링크 목록"
+// @Router /sessions/{id}/share-links [get]
+func (sc *SessionShareController) List(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	links, err := sc.shareService.List(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": links})
+}
+
+// Revoke는 공유 링크를 폐기하고 링크로 참여한 연결을 종료합니다.
+// @Summary 세션 공유 링크 폐기
+// @Tags sessions
+// @Param id path string true "세션 ID"
+// @Param linkId path string true "공유 링크 ID"
+// @Security BearerAuth
+// @Success 204 "폐기됨"
+// @Failure 404 {object} models.ErrorResponse "공유 링크 없음"
+// @Router /sessions/{id}/share-links/{linkId} [delete]
+func (sc *SessionShareController) Revoke(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	linkID := c.Param("linkId")
+	if err := sc.shareService.Revoke(c.Request.Context(), c.Param("id"), linkID, userClaims.UserID, userClaims.Role == "admin"); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	if sc.disconnector != nil {
+		sc.disconnector.DisconnectShareLink(linkID)
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListJoins는 공유 링크를 통한 세션 참여 기록을 조회합니다.
+// @Summary 공유 링크 참여 기록
+// @Tags sessions
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Security BearerAuth
+// @Success 200 {object} models.PaginationResponse "참여 기록"
+// @Router /sessions/{id}/share-joins [get]
+func (sc *SessionShareController) ListJoins(c *gin.Context) {
+	userClaims, ok := messageClaims(c)
+	if !ok {
+		return
+	}
+
+	var req models.PaginationRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	response, err := sc.shareService.ListJoins(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetShared는 공유 링크로 세션에 참여하고 링크 정보를 반환합니다.
+// @Summary 공유 세션 참여
+// @Tags shared
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Param X-Share-Token header string true "공유 토큰"
+// @Success 200 {object} models.SessionShareLink "공유 링크 정보"
+// @Failure 401 {object} models.ErrorResponse "유효하지 않은 공유 링크"
+// @Router /shared/sessions/{id} [get]
+func (sc *SessionShareController) GetShared(c *gin.Context) {
+	link, ok := middleware.GetShareLink(c)
+	if !ok {
+		middleware.UnauthorizedError(c, "공유 링크 정보를 찾을 수 없습니다")
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	userName, _ := middleware.GetUsername(c)
+	err := sc.shareService.RecordShareJoin(c.Request.Context(), &models.SessionShareJoin{
+		LinkID:    link.ID,
+		SessionID: link.SessionID,
+		UserID:    userID,
+		UserName:  userName,
+		Channel:   "rest",
+		ClientIP:  c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	})
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"session_id": link.SessionID,
+		"scope":      link.Scope,
+		"expires_at": link.ExpiresAt,
+		"websocket":  "/ws/session/" + link.SessionID,
+	})
+}
+
+// SharedMessages는 공유 링크로 세션 대화 기록을 조회합니다.
+// @Summary 공유 세션 대화 기록
+// @Tags shared
+// @Produce json
+// @Param id path string true "세션 ID"
+// @Param X-Share-Token header string true "공유 토큰"
+// @Param page query int false "페이지 번호" default(1)
+// @Param limit query int false "페이지당 항목 수" default(20)
+// @Success 200 {object} models.PaginationResponse "세션 메시지 목록"
+// @Router /shared/sessions/{id}/messages [get]
+func (sc *SessionShareController) SharedMessages(c *gin.Context) {
+	link, ok := middleware.GetShareLink(c)
+	if !ok {
+		middleware.UnauthorizedError(c, "공유 링크 정보를 찾을 수 없습니다")
+		return
+	}
+
+	var req models.PaginationRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 파라미터", err.Error())
+		return
+	}
+
+	response, err := sc.shareService.SharedTranscript(c.Request.Context(), link, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
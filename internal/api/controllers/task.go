@@ -1,9 +1,11 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/aicli/aicli-web/internal/middleware"
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/services"
 	"github.com/gin-gonic/gin"
@@ -27,16 +29,21 @@ func NewTaskController(taskService *services.TaskService) *TaskController {
 // @Tags tasks
 // @Accept json
 // @Produce json
-// @Param sessionId path string true "세션 ID"
+// @Param id path string true "세션 ID"
 // @Param task body models.TaskCreateRequest true "태스크 생성 요청"
 // @Success 201 {object} models.TaskResponse
+// @Success 202 {object} models.TaskResponse "호스트 자원 부족으로 실행 대기 (admission에 대기 순서)"
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse "require_review/dry_run: 프로젝트에 결정되지 않은 검토가 있음"
+// @Failure 429 {object} models.ErrorResponse "동시 실행 세션 한도 초과 (SESSION_LIMIT_EXCEEDED, details에 현재 사용량과 한도)"
 // @Failure 500 {object} models.ErrorResponse
-// @Router /sessions/{sessionId}/tasks [post]
+// @Failure 503 {object} models.ErrorResponse "유지보수 모드 또는 호스트 자원 부족으로 대기열이 가득 참 (Retry-After 헤더 포함)"
+// @Failure 507 {object} models.ErrorResponse "워크스페이스 디스크 한도 초과 (DISK_QUOTA_EXCEEDED)"
+// @Router /sessions/{id}/tasks [post]
 // @Security BearerAuth
 func (tc *TaskController) Create(c *gin.Context) {
-	sessionID := c.Param("sessionId")
+	sessionID := c.Param("id")
 	if sessionID == "" {
 		c.JSON(http.StatusBadRequest, models.ErrorResponse{
 			Error: models.ErrorDetail{
@@ -59,11 +66,21 @@ func (tc *TaskController) Create(c *gin.Context) {
 		return
 	}
 
-	// 세션 ID 설정
+	// 세션 ID와 요청한 사용자 설정
 	req.SessionID = sessionID
+	req.UserID, _ = middleware.GetUserID(c)
 
 	task, err := tc.taskService.Create(c.Request.Context(), &req)
 	if err != nil {
+		if handleMaintenanceError(c, err) || handleDiskQuotaError(c, err) || handleHostOverloadedError(c, err) || handleSessionLimitError(c, err) {
+			return
+		}
+		// 검토 워크플로 조건 위반 (Git 리포지토리 아님, 작업 트리 변경, 결정되지 않은 검토)
+		var workspaceErr *services.WorkspaceError
+		if errors.As(err, &workspaceErr) {
+			middleware.HandleServiceError(c, err)
+			return
+		}
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: models.ErrorDetail{
 				Code:    "TASK_CREATE_FAILED",
@@ -74,7 +91,12 @@ func (tc *TaskController) Create(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, task.ToResponse())
+	// 호스트 자원 부족으로 실행이 미뤄졌으면 대기 순서와 함께 202로 응답
+	status := http.StatusCreated
+	if task.Admission != nil {
+		status = http.StatusAccepted
+	}
+	c.JSON(status, task.ToResponse())
 }
 
 // List 태스크 목록 조회
@@ -88,6 +110,10 @@ func (tc *TaskController) Create(c *gin.Context) {
 // @Param active query boolean false "활성 태스크만 조회"
 // @Param page query int false "페이지 번호" default(1)
 // @Param limit query int false "페이지 크기" default(10)
+// @Param sort query string false "정렬 기준 (created_at, updated_at, status)" default("created_at")
+// @Param order query string false "정렬 순서 (asc, desc)" default("desc")
+// @Param cursor query string false "다음 페이지 커서 (meta.next_cursor)"
+// @Param fields query string false "응답에 포함할 필드 (쉼표 구분)"
 // @Success 200 {object} models.PagingResponse[models.TaskResponse]
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
@@ -113,11 +139,25 @@ func (tc *TaskController) List(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	paging := &models.PagingRequest{
-		Page:  page,
-		Limit: limit,
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort"),
+		Order:  c.Query("order"),
+		Cursor: c.Query("cursor"),
+		Fields: c.Query("fields"),
 	}
 
 	result, err := tc.taskService.List(c.Request.Context(), &filter, paging)
+	if errors.Is(err, models.ErrInvalidCursor) || errors.Is(err, models.ErrInvalidSortField) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error: models.ErrorDetail{
+				Code:    "INVALID_PAGINATION",
+				Message: "잘못된 정렬 또는 커서 파라미터입니다",
+				Details: err.Error(),
+			},
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error: models.ErrorDetail{
@@ -129,6 +169,9 @@ func (tc *TaskController) List(c *gin.Context) {
 		return
 	}
 
+	// 요청된 필드만 응답 (sparse fieldset)
+	result.Data = models.SelectFields(result.Data, paging.FieldList())
+
 	c.JSON(http.StatusOK, result)
 }
 
@@ -183,7 +226,7 @@ func (tc *TaskController) GetByID(c *gin.Context) {
 
 // Cancel 태스크 취소
 // @Summary 태스크 취소
-// @Description 실행 중인 태스크를 취소합니다
+// @Description 실행 중인 태스크를 취소합니다. 실행 중인 프로세스에는 인터럽트 신호를 보내고 유예 시간 뒤 강제 종료합니다
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// TaskArtifactController는 태스크 대화 기록에서 추출한 결과물 조회를 처리합니다.
+// 태스크 권한은 라우터의 권한 미들웨어에서 확인합니다.
+type TaskArtifactController struct {
+	artifacts *services.TaskArtifactService
+}
+
+// NewTaskArtifactController는 새로운 태스크 결과물 컨트롤러를 생성합니다.
+func NewTaskArtifactController(artifacts *services.TaskArtifactService) *TaskArtifactController {
+	return &TaskArtifactController{
+		artifacts: artifacts,
+	}
+}
+
+// List는 태스크의 결과물을 조회합니다.
+// @Summary 태스크 결과물 조회
+// @Description 태스크가 끝나면 출력과 실행 중 기록된 대화에서 코드 블록, 생성/수정한 파일 경로,
+// @Description 테스트 결과 요약을 추출해 저장합니다. 추출 순서대로 반환합니다.
+// @Tags tasks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Param type query string false "결과물 종류 (code_block, file, test_report)"
+// @Success 200 {array} models.TaskArtifact "태스크 결과물"
+// @Failure 400 {object} models.ErrorResponse "알 수 없는 결과물 종류"
+// @Failure 404 {object} models.ErrorResponse "태스크를 찾을 수 없음"
+// @Router /tasks/{id}/artifacts [get]
+func (ac *TaskArtifactController) List(c *gin.Context) {
+	artifactType := models.TaskArtifactType(c.Query("type"))
+	artifacts, err := ac.artifacts.List(c.Request.Context(), c.Param("id"), artifactType)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, artifacts)
+}
@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// TaskEventController는 WebSocket/SSE를 쓸 수 없는 클라이언트를 위한 태스크 이벤트 롱 폴링을 처리합니다.
+// 태스크 권한은 라우터의 권한 미들웨어에서 확인합니다.
+type TaskEventController struct {
+	events *services.TaskEventService
+}
+
+// NewTaskEventController는 새로운 태스크 이벤트 컨트롤러를 생성합니다.
+func NewTaskEventController(events *services.TaskEventService) *TaskEventController {
+	return &TaskEventController{
+		events: events,
+	}
+}
+
+// Poll은 since 커서 이후의 태스크 이벤트를 롱 폴링으로 조회합니다.
+// @Summary 태스크 이벤트 롱 폴링
+// @Description WebSocket 태스크 채널과 같은 이벤트 스트림을 사용합니다. since 이후 이벤트가 있으면 바로 묶어서 반환하고,
+// @Description 없으면 wait 동안 기다렸다가 빈 events로 응답합니다. 응답의 cursor를 다음 요청의 since로 넘기고,
+// @Description done이 true이면 태스크가 종료되어 더 이상 이벤트가 없습니다.
+// @Tags tasks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Param since query int false "마지막으로 받은 이벤트 커서 (기본 0: 처음부터)"
+// @Param wait query string false "최대 대기 시간 (예: 30s, 초 단위 숫자도 허용; 기본 30s, 최대 60s)"
+// @Success 200 {object} models.TaskEventPollResponse "이벤트 묶음 (시간 초과 시 빈 배열)"
+// @Failure 400 {object} models.ErrorResponse "잘못된 since/wait"
+// @Failure 404 {object} models.ErrorResponse "태스크를 찾을 수 없음"
+// @Router /tasks/{id}/events [get]
+func (tc *TaskEventController) Poll(c *gin.Context) {
+	var since int64
+	if value := c.Query("since"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			middleware.ValidationError(c, "since는 정수 커서여야 합니다", err.Error())
+			return
+		}
+		since = parsed
+	}
+
+	wait := services.DefaultTaskEventWait
+	if value := c.Query("wait"); value != "" {
+		parsed, err := parseWaitDuration(value)
+		if err != nil {
+			middleware.ValidationError(c, "wait 형식이 올바르지 않습니다", err.Error())
+			return
+		}
+		wait = parsed
+	}
+
+	resp, err := tc.events.Poll(c.Request.Context(), c.Param("id"), since, wait)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, resp)
+}
+
+// parseWaitDuration "30s" 같은 기간 또는 초 단위 숫자 해석
+func parseWaitDuration(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// TaskReviewController는 태스크 결과 검토와 승인 API를 처리합니다.
+type TaskReviewController struct {
+	reviews *services.TaskReviewService
+}
+
+// NewTaskReviewController는 새로운 태스크 검토 컨트롤러를 생성합니다.
+func NewTaskReviewController(reviews *services.TaskReviewService) *TaskReviewController {
+	return &TaskReviewController{
+		reviews: reviews,
+	}
+}
+
+// ListReviews는 접근할 수 있는 워크스페이스의 검토 목록을 조회합니다.
+// @Summary 태스크 검토 목록 조회
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param workspace_id query string false "워크스페이스 ID"
+// @Param status query string false "검토 상태 (awaiting_task, pending, approved, rejected)"
+// @Param limit query int false "최대 개수 (기본 50)"
+// @Success 200 {array} models.TaskReview "검토 목록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 조회 조건"
+// @Router /reviews [get]
+func (rc *TaskReviewController) ListReviews(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var filter models.TaskReviewFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		middleware.ValidationError(c, "조회 조건이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	reviews, err := rc.reviews.List(c.Request.Context(), userClaims.UserID, userClaims.Role == "admin", &filter)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, reviews)
+}
+
+// GetReview는 검토와 태스크가 만든 diff를 조회합니다.
+// @Summary 태스크 검토 조회
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Success 200 {object} models.TaskReview "검토"
+// @Failure 404 {object} models.ErrorResponse "검토를 찾을 수 없음"
+// @Router /reviews/{id} [get]
+func (rc *TaskReviewController) GetReview(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	review, err := rc.reviews.Get(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// GetReviewDiff는 검토의 제안 변경 사항을 패치 파일로 내려받습니다.
+// @Summary 태스크 검토 diff 다운로드
+// @Description 드라이런 검토는 섀도 복사본에서 잘리지 않은 diff를 만들어 반환합니다
+// @Tags reviews
+// @Produce text/x-diff
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Success 200 {file} file "git diff 형식 패치"
+// @Failure 404 {object} models.ErrorResponse "검토를 찾을 수 없음"
+// @Router /reviews/{id}/diff [get]
+func (rc *TaskReviewController) GetReviewDiff(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	reviewID := c.Param("id")
+	diff, err := rc.reviews.Diff(c.Request.Context(), reviewID, userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\"review-"+reviewID+".diff\"")
+	c.Data(http.StatusOK, "text/x-diff; charset=utf-8", []byte(diff))
+}
+
+// GetTaskReview는 태스크의 검토를 조회합니다.
+// @Summary 태스크의 검토 조회
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "태스크 ID"
+// @Success 200 {object} models.TaskReview "검토"
+// @Failure 404 {object} models.ErrorResponse "검토가 필요한 태스크가 아님"
+// @Router /tasks/{id}/review [get]
+func (rc *TaskReviewController) GetTaskReview(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	review, err := rc.reviews.GetByTask(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
+
+// ApproveReview는 검토를 승인하고 태스크의 변경 사항을 커밋합니다.
+// @Summary 태스크 검토 승인
+// @Description 워크스페이스 admin 권한이 필요합니다. 변경 사항이 없으면 커밋하지 않으며, 드라이런 검토는 제안된 diff를 작업 트리에 적용한 뒤 커밋합니다
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Param request body models.TaskReviewDecisionRequest false "검토 의견과 커밋 메시지"
+// @Success 200 {object} models.TaskReview "승인된 검토"
+// @Failure 400 {object} models.ErrorResponse "검토 대기 상태가 아님"
+// @Failure 403 {object} models.ErrorResponse "검토 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "검토를 찾을 수 없음"
+// @Router /reviews/{id}/approve [post]
+func (rc *TaskReviewController) ApproveReview(c *gin.Context) {
+	rc.decide(c, rc.reviews.Approve)
+}
+
+// RejectReview는 검토를 거부하고 작업 트리를 태스크 실행 전 커밋으로 되돌립니다.
+// @Summary 태스크 검토 거부
+// @Description 워크스페이스 admin 권한이 필요합니다
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Param request body models.TaskReviewDecisionRequest false "검토 의견"
+// @Success 200 {object} models.TaskReview "거부된 검토"
+// @Failure 400 {object} models.ErrorResponse "검토 대기 상태가 아님"
+// @Failure 403 {object} models.ErrorResponse "검토 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "검토를 찾을 수 없음"
+// @Router /reviews/{id}/reject [post]
+func (rc *TaskReviewController) RejectReview(c *gin.Context) {
+	rc.decide(c, rc.reviews.Reject)
+}
+
+// decide 승인/거부 요청 처리 (본문은 생략 가능)
+func (rc *TaskReviewController) decide(c *gin.Context, decide func(ctx context.Context, id, userID string, admin bool, req *models.TaskReviewDecisionRequest) (*models.TaskReview, error)) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.TaskReviewDecisionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+			return
+		}
+	}
+
+	review, err := decide(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, review)
+}
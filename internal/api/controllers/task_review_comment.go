@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// ListReviewComments는 검토 diff에 남긴 의견을 조회합니다.
+// @Summary 태스크 검토 의견 목록
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Success 200 {array} models.TaskReviewComment "파일, 줄, 작성 순 의견 목록"
+// @Failure 404 {object} models.ErrorResponse "검토를 찾을 수 없음"
+// @Router /reviews/{id}/comments [get]
+func (rc *TaskReviewController) ListReviewComments(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	comments, err := rc.reviews.ListComments(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, comments)
+}
+
+// AddReviewComment는 검토 diff의 한 줄에 의견을 남깁니다.
+// @Summary 태스크 검토 의견 작성
+// @Description 워크스페이스 execute 권한이 필요합니다. side가 new면 변경 후, old면 변경 전 파일의 줄 번호이며 diff에 있는 줄에만 남길 수 있습니다
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Param request body models.TaskReviewCommentRequest true "파일, 줄, 의견"
+// @Success 201 {object} models.TaskReviewComment "작성한 의견"
+// @Failure 400 {object} models.ErrorResponse "diff에 없는 줄이거나 태스크 실행 중"
+// @Failure 403 {object} models.ErrorResponse "의견 작성 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "검토를 찾을 수 없음"
+// @Router /reviews/{id}/comments [post]
+func (rc *TaskReviewController) AddReviewComment(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.TaskReviewCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	comment, err := rc.reviews.AddComment(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, comment)
+}
+
+// UpdateReviewComment는 검토 의견 내용을 고치거나 해결 여부를 바꿉니다.
+// @Summary 태스크 검토 의견 수정
+// @Description 내용은 작성자만, 해결 여부는 작성자나 워크스페이스 admin이 바꿀 수 있습니다
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Param commentId path string true "의견 ID"
+// @Param request body models.TaskReviewCommentUpdateRequest true "바꿀 필드"
+// @Success 200 {object} models.TaskReviewComment "수정한 의견"
+// @Failure 403 {object} models.ErrorResponse "수정 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "의견을 찾을 수 없음"
+// @Router /reviews/{id}/comments/{commentId} [put]
+func (rc *TaskReviewController) UpdateReviewComment(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.TaskReviewCommentUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	comment, err := rc.reviews.UpdateComment(c.Request.Context(), c.Param("id"), c.Param("commentId"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, comment)
+}
+
+// DeleteReviewComment는 검토 의견을 삭제합니다.
+// @Summary 태스크 검토 의견 삭제
+// @Description 작성자나 워크스페이스 admin이 삭제할 수 있습니다
+// @Tags reviews
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Param commentId path string true "의견 ID"
+// @Success 204 "삭제됨"
+// @Failure 403 {object} models.ErrorResponse "삭제 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "의견을 찾을 수 없음"
+// @Router /reviews/{id}/comments/{commentId} [delete]
+func (rc *TaskReviewController) DeleteReviewComment(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	if err := rc.reviews.DeleteComment(c.Request.Context(), c.Param("id"), c.Param("commentId"), userClaims.UserID, userClaims.Role == "admin"); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReviseReview는 해결하지 않은 의견을 수정 지시로 정리해 새 세션에서 태스크를 실행합니다.
+// @Summary 검토 의견으로 수정 태스크 실행
+// @Description 승인하거나 거부한 검토에서 워크스페이스 execute 권한이 필요합니다. 검토한 프로젝트에 새 세션을 만들고 메타데이터(revision_of_review, parent_session_id)로 원래 세션과 연결하며, 전달한 의견에 수정 세션과 태스크를 기록합니다
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "검토 ID"
+// @Param request body models.TaskReviewRevisionRequest false "전달할 의견과 태스크 옵션"
+// @Success 201 {object} models.TaskReviewRevision "수정 세션과 태스크"
+// @Failure 400 {object} models.ErrorResponse "결정되지 않은 검토이거나 전달할 의견이 없음"
+// @Failure 403 {object} models.ErrorResponse "실행 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "검토를 찾을 수 없음"
+// @Router /reviews/{id}/revise [post]
+func (rc *TaskReviewController) ReviseReview(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.TaskReviewRevisionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+			return
+		}
+	}
+
+	revision, err := rc.reviews.Revise(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, revision)
+}
@@ -61,7 +61,7 @@ func setupTaskControllerTest() (*gin.Engine, *services.TaskService, *models.Sess
 	router := gin.New()
 	
 	// 라우트 설정
-	router.POST("/sessions/:sessionId/tasks", taskController.Create)
+	router.POST("/sessions/:id/tasks", taskController.Create)
 	router.GET("/tasks", taskController.List)
 	router.GET("/tasks/active", taskController.GetActiveTasks)
 	router.GET("/tasks/stats", taskController.GetStats)
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	sessionws "github.com/aicli/aicli-web/internal/api/websocket"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// TerminalController는 워크스페이스 터미널 접속과 접속 기록/녹화 조회를 처리합니다.
+// 워크스페이스 권한은 라우터의 권한 미들웨어에서 확인합니다.
+type TerminalController struct {
+	terminals      *services.TerminalService
+	allowedOrigins []string
+}
+
+// NewTerminalController는 새로운 터미널 컨트롤러를 생성합니다.
+func NewTerminalController(terminals *services.TerminalService) *TerminalController {
+	return &TerminalController{
+		terminals: terminals,
+	}
+}
+
+// SetAllowedOrigins는 터미널 WebSocket을 허용할 브라우저 오리진(CORS 허용 목록)을 설정합니다.
+// 같은 호스트의 오리진과 Origin 헤더가 없는 비브라우저 클라이언트는 항상 허용됩니다.
+func (tc *TerminalController) SetAllowedOrigins(origins []string) {
+	tc.allowedOrigins = origins
+}
+
+// Connect는 워크스페이스에서 셸을 열고 WebSocket으로 연결합니다.
+// @Summary 워크스페이스 터미널 접속
+// @Description WebSocket으로 워크스페이스 디렉토리 또는 컨테이너의 대화형 셸에 접속합니다.
+// @Description 클라이언트는 {"type":"input","data":...}, {"type":"resize","cols":..,"rows":..}를 보내고
+// @Description 서버는 output, exit 메시지를 보냅니다. 입출력은 모두 녹화됩니다.
+// @Description 브라우저는 Authorization 헤더 대신 token 쿼리 파라미터로 인증할 수 있습니다.
+// @Tags terminal
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param mode query string false "host 또는 container (기본: 실행 중인 컨테이너가 있으면 container)"
+// @Param shell query string false "셸 절대 경로"
+// @Param cols query int false "열 수"
+// @Param rows query int false "행 수"
+// @Success 101 "WebSocket 연결"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청 또는 실행 중인 컨테이너 없음"
+// @Failure 403 {object} models.ErrorResponse "워크스페이스 실행 권한, terminal:execute 권한 없음, 허용되지 않은 오리진 또는 호스트 모드 비활성화"
+// @Failure 409 {object} models.ErrorResponse "동시 터미널 수 초과"
+// @Router /ws/workspaces/{id}/terminal [get]
+func (tc *TerminalController) Connect(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	// 다른 사이트에서 연 WebSocket(CSWSH)은 셸을 시작하기 전에 거부
+	if !sessionws.CheckTerminalOrigin(c.Request, tc.allowedOrigins) {
+		middleware.ForbiddenError(c, "허용되지 않은 오리진입니다")
+		return
+	}
+
+	var req models.TerminalOpenRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+	req.ClientIP = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
+	// 업그레이드 전에 셸을 시작해 실패를 일반 HTTP 오류로 응답
+	handle, err := tc.terminals.Open(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	conn, err := sessionws.UpgradeTerminal(c.Writer, c.Request, tc.allowedOrigins)
+	if err != nil {
+		tc.terminals.Abort(handle)
+		return
+	}
+	tc.terminals.Serve(c.Request.Context(), handle, conn)
+}
+
+// ListSessions는 워크스페이스의 터미널 접속 기록을 조회합니다.
+// @Summary 터미널 접속 기록 조회
+// @Tags terminal
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {array} models.TerminalSession "최신순 접속 기록"
+// @Failure 403 {object} models.ErrorResponse "워크스페이스 관리 권한 없음"
+// @Router /workspaces/{id}/terminal-sessions [get]
+func (tc *TerminalController) ListSessions(c *gin.Context) {
+	sessions, err := tc.terminals.ListSessions(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// GetSession은 터미널 접속 기록을 조회합니다.
+// @Summary 터미널 접속 기록 상세
+// @Tags terminal
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param terminalId path string true "터미널 세션 ID"
+// @Success 200 {object} models.TerminalSession "접속 기록"
+// @Failure 404 {object} models.ErrorResponse "기록을 찾을 수 없음"
+// @Router /workspaces/{id}/terminal-sessions/{terminalId} [get]
+func (tc *TerminalController) GetSession(c *gin.Context) {
+	session, err := tc.terminals.GetSession(c.Request.Context(), c.Param("id"), c.Param("terminalId"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// GetRecording은 터미널 녹화를 asciicast v2 형식으로 내려받습니다.
+// @Summary 터미널 녹화 다운로드
+// @Description asciinema 플레이어로 재생할 수 있는 asciicast v2 파일을 반환합니다
+// @Tags terminal
+// @Produce application/x-asciicast
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param terminalId path string true "터미널 세션 ID"
+// @Success 200 {file} file "asciicast v2 녹화"
+// @Failure 404 {object} models.ErrorResponse "녹화를 찾을 수 없음"
+// @Failure 409 {object} models.ErrorResponse "접속 중인 터미널"
+// @Router /workspaces/{id}/terminal-sessions/{terminalId}/recording [get]
+func (tc *TerminalController) GetRecording(c *gin.Context) {
+	terminalID := c.Param("terminalId")
+	data, err := tc.terminals.GetRecording(c.Request.Context(), c.Param("id"), terminalID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", "attachment; filename=\"terminal-"+terminalID+".cast\"")
+	c.Data(http.StatusOK, "application/x-asciicast", data)
+}
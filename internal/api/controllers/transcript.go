@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// TranscriptController는 CLI 오프라인 모드 대화 기록 동기화 API를 처리합니다.
+type TranscriptController struct {
+	syncService *services.TranscriptSyncService
+}
+
+// NewTranscriptController는 새로운 대화 기록 동기화 컨트롤러를 생성합니다.
+func NewTranscriptController(syncService *services.TranscriptSyncService) *TranscriptController {
+	return &TranscriptController{
+		syncService: syncService,
+	}
+}
+
+// Import는 오프라인 모드에서 실행한 대화 기록을 프로젝트의 세션으로 가져옵니다.
+// @Summary 오프라인 대화 기록 동기화
+// @Description aicli offline sync가 로컬에서 실행한 작업의 대화 기록을 종료된 세션으로 저장합니다. 메시지는 offset부터 나눠 보낼 수 있고, 이미 받은 메시지는 건너뜁니다. complete 요청에서 실행 결과와 사용량을 태스크로 기록합니다
+// @Tags sessions
+// @Accept json
+// @Produce json
+// @Param id path string true "프로젝트 ID"
+// @Param body body models.TranscriptImportRequest true "대화 기록"
+// @Security BearerAuth
+// @Success 200 {object} models.TranscriptImportResult "저장 결과"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 404 {object} models.ErrorResponse "프로젝트 없음"
+// @Failure 409 {object} models.ErrorResponse "앞선 메시지 누락 (details.received부터 다시 보냄)"
+// @Router /projects/{id}/transcripts [post]
+func (tc *TranscriptController) Import(c *gin.Context) {
+	var req models.TranscriptImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 형식", err.Error())
+		return
+	}
+
+	result, err := tc.syncService.Import(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
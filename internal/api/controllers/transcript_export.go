@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// TranscriptExportController는 세션 대화 기록 내보내기 API를 처리합니다.
+type TranscriptExportController struct {
+	exports *services.TranscriptExportService
+}
+
+// NewTranscriptExportController는 새로운 대화 기록 내보내기 컨트롤러를 생성합니다.
+func NewTranscriptExportController(exports *services.TranscriptExportService) *TranscriptExportController {
+	return &TranscriptExportController{
+		exports: exports,
+	}
+}
+
+// ListExports는 사용자가 시작한 내보내기 작업 목록을 조회합니다.
+// @Summary 대화 기록 내보내기 목록 조회
+// @Tags exports
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.TranscriptExport "내보내기 작업 목록 (최신순)"
+// @Router /exports/transcripts [get]
+func (ec *TranscriptExportController) ListExports(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	exports, err := ec.exports.List(c.Request.Context(), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, exports)
+}
+
+// StartExport는 세션 대화 기록을 문서 하나로 내보내는 작업을 시작합니다.
+// @Summary 대화 기록 내보내기 시작
+// @Description 세션마다 한 장씩 이어 붙인 Markdown, 단독 HTML, PDF 문서를 백그라운드에서 만들어 아티팩트로 저장하고, 끝나면 알림 센터와 WebSocket으로 알립니다.
+// @Description PDF는 서버에 exports.pdf_command가 설정되어 있어야 합니다.
+// @Tags exports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.TranscriptExportRequest true "내보내기 요청"
+// @Success 202 {object} models.TranscriptExport "시작된 내보내기 작업"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청 또는 설정되지 않은 형식"
+// @Failure 403 {object} models.ErrorResponse "세션 조회 권한 없음"
+// @Router /exports/transcripts [post]
+func (ec *TranscriptExportController) StartExport(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.TranscriptExportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	export, err := ec.exports.Start(c.Request.Context(), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, export)
+}
+
+// GetExport는 내보내기 작업 상태와 다운로드 URL을 조회합니다.
+// @Summary 대화 기록 내보내기 조회
+// @Description 다운로드 URL이 만료되었으면 artifact_key로 /artifacts/url을 호출해 다시 발급받습니다.
+// @Tags exports
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "내보내기 작업 ID"
+// @Success 200 {object} models.TranscriptExport "내보내기 작업"
+// @Failure 404 {object} models.ErrorResponse "작업을 찾을 수 없음"
+// @Router /exports/transcripts/{id} [get]
+func (ec *TranscriptExportController) GetExport(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	export, err := ec.exports.Get(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, export)
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// UserPreferenceController는 사용자 언어 설정 API를 처리합니다.
+type UserPreferenceController struct {
+	preferences *services.UserPreferenceService
+}
+
+// NewUserPreferenceController는 새로운 사용자 설정 컨트롤러를 생성합니다.
+func NewUserPreferenceController(preferences *services.UserPreferenceService) *UserPreferenceController {
+	return &UserPreferenceController{
+		preferences: preferences,
+	}
+}
+
+// GetPreferences는 사용자 언어 설정을 조회합니다.
+// @Summary 언어 설정 조회
+// @Description 저장한 적이 없으면 빈 설정을 반환합니다 (응답 언어 지시 없음, 메시지 언어는 Accept-Language)
+// @Tags preferences
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.UserPreference "언어 설정"
+// @Router /preferences [get]
+func (pc *UserPreferenceController) GetPreferences(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	preference, err := pc.preferences.Get(c.Request.Context(), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preference)
+}
+
+// UpdatePreferences는 사용자 언어 설정을 변경합니다.
+// @Summary 언어 설정 변경
+// @Description response_language(ko, en, ja, zh, es, fr, de, pt, vi)는 이후 시작하는 세션과 태스크의 claude 명령에 응답 언어 지시문으로 들어갑니다.
+// @Description locale(ko, en)은 API 에러와 알림 메시지 언어입니다. 요청에 없는 항목은 유지하고 빈 문자열은 설정을 해제합니다.
+// @Tags preferences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.UserPreferenceRequest true "변경할 언어 설정"
+// @Success 200 {object} models.UserPreference "변경된 언어 설정"
+// @Failure 400 {object} models.ErrorResponse "지원하지 않는 언어"
+// @Router /preferences [put]
+func (pc *UserPreferenceController) UpdatePreferences(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.UserPreferenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	preference, err := pc.preferences.Update(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, preference)
+}
@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/docker"
+)
+
+// WarmPoolController는 관리자용 웜 컨테이너 풀 조회 API를 처리합니다.
+type WarmPoolController struct {
+	warmPool *docker.WarmPool
+}
+
+// NewWarmPoolController는 새로운 웜 컨테이너 풀 컨트롤러를 생성합니다.
+func NewWarmPoolController(warmPool *docker.WarmPool) *WarmPoolController {
+	return &WarmPoolController{
+		warmPool: warmPool,
+	}
+}
+
+// GetStats는 웜 풀 현황과 콜드/웜 스타트 통계를 조회합니다.
+// @Summary 웜 컨테이너 풀 현황 조회
+// @Description 대기 중인 웜 컨테이너 수, 콜드/웜 스타트 횟수와 평균 시작 시간을 반환합니다
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} docker.WarmPoolStats "웜 풀 현황"
+// @Router /admin/warm-pool [get]
+func (wc *WarmPoolController) GetStats(c *gin.Context) {
+	c.JSON(http.StatusOK, wc.warmPool.Stats())
+}
@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// webhookPayloadMaxBytes 수신할 웹훅 본문의 최대 크기
+const webhookPayloadMaxBytes = 5 << 20
+
+// WebhookController는 웹훅 매핑 설정과 GitHub/GitLab 웹훅 수신을 처리합니다.
+type WebhookController struct {
+	webhooks *services.WebhookService
+}
+
+// NewWebhookController는 새로운 웹훅 컨트롤러를 생성합니다.
+func NewWebhookController(webhooks *services.WebhookService) *WebhookController {
+	return &WebhookController{
+		webhooks: webhooks,
+	}
+}
+
+// ListMappings는 사용자의 웹훅 매핑 목록을 조회합니다.
+// @Summary 웹훅 매핑 목록 조회
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.WebhookMapping "웹훅 매핑 목록"
+// @Router /webhooks [get]
+func (wc *WebhookController) ListMappings(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	mappings, err := wc.webhooks.ListMappings(c.Request.Context(), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mappings)
+}
+
+// CreateMapping은 리포지토리 이벤트를 워크스페이스 태스크로 연결하는 매핑을 생성합니다.
+// @Summary 웹훅 매핑 생성
+// @Description 응답의 secret과 url을 GitHub/GitLab 웹훅 설정에 입력합니다. secret은 다시 조회할 수 없습니다
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.WebhookMappingCreateRequest true "웹훅 매핑"
+// @Success 201 {object} models.WebhookMappingSecretResponse "생성된 매핑과 비밀 값"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "워크스페이스 실행 권한 없음"
+// @Router /webhooks [post]
+func (wc *WebhookController) CreateMapping(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.WebhookMappingCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	mapping, err := wc.webhooks.CreateMapping(c.Request.Context(), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, mapping)
+}
+
+// GetMapping은 웹훅 매핑과 마지막 수신 결과를 조회합니다.
+// @Summary 웹훅 매핑 조회
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "매핑 ID"
+// @Success 200 {object} models.WebhookMapping "웹훅 매핑"
+// @Failure 404 {object} models.ErrorResponse "매핑을 찾을 수 없음"
+// @Router /webhooks/{id} [get]
+func (wc *WebhookController) GetMapping(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	mapping, err := wc.webhooks.GetMapping(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping)
+}
+
+// UpdateMapping은 웹훅 매핑을 수정합니다.
+// @Summary 웹훅 매핑 수정
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "매핑 ID"
+// @Param request body models.WebhookMappingUpdateRequest true "변경할 필드"
+// @Success 200 {object} models.WebhookMapping "수정된 매핑"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 404 {object} models.ErrorResponse "매핑을 찾을 수 없음"
+// @Router /webhooks/{id} [put]
+func (wc *WebhookController) UpdateMapping(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.WebhookMappingUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	mapping, err := wc.webhooks.UpdateMapping(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin", &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping)
+}
+
+// DeleteMapping은 웹훅 매핑을 삭제합니다.
+// @Summary 웹훅 매핑 삭제
+// @Tags webhooks
+// @Security BearerAuth
+// @Param id path string true "매핑 ID"
+// @Success 204 "삭제됨"
+// @Failure 404 {object} models.ErrorResponse "매핑을 찾을 수 없음"
+// @Router /webhooks/{id} [delete]
+func (wc *WebhookController) DeleteMapping(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	if err := wc.webhooks.DeleteMapping(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin"); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RotateSecret은 웹훅 비밀 값을 재발급합니다.
+// @Summary 웹훅 비밀 값 재발급
+// @Description 이전 비밀 값으로 서명한 요청은 바로 거부됩니다
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "매핑 ID"
+// @Success 200 {object} models.WebhookMappingSecretResponse "매핑과 새 비밀 값"
+// @Failure 404 {object} models.ErrorResponse "매핑을 찾을 수 없음"
+// @Router /webhooks/{id}/rotate-secret [post]
+func (wc *WebhookController) RotateSecret(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	mapping, err := wc.webhooks.RotateSecret(c.Request.Context(), c.Param("id"), userClaims.UserID, userClaims.Role == "admin")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping)
+}
+
+// ReceiveGitHub는 GitHub 웹훅을 수신합니다.
+// @Summary GitHub 웹훅 수신
+// @Description X-Hub-Signature-256 서명을 검증합니다. issues(labeled), issue_comment(PR 댓글), push 이벤트를 처리합니다
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "매핑 ID"
+// @Success 200 {object} models.WebhookDeliveryResult "무시되었거나 태스크를 시작하지 못함"
+// @Success 202 {object} models.WebhookDeliveryResult "태스크 시작"
+// @Failure 401 {object} models.ErrorResponse "서명 검증 실패"
+// @Failure 404 {object} models.ErrorResponse "매핑을 찾을 수 없음"
+// @Router /hooks/github/{id} [post]
+func (wc *WebhookController) ReceiveGitHub(c *gin.Context) {
+	wc.receive(c, models.WebhookProviderGitHub, &models.WebhookDelivery{
+		Event:      c.GetHeader("X-GitHub-Event"),
+		DeliveryID: c.GetHeader("X-GitHub-Delivery"),
+		Signature:  c.GetHeader("X-Hub-Signature-256"),
+	})
+}
+
+// ReceiveGitLab은 GitLab 웹훅을 수신합니다.
+// @Summary GitLab 웹훅 수신
+// @Description X-Gitlab-Token을 검증합니다. Issue Hook(라벨 추가), Note Hook(MR 댓글), Push Hook 이벤트를 처리합니다
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "매핑 ID"
+// @Success 200 {object} models.WebhookDeliveryResult "무시되었거나 태스크를 시작하지 못함"
+// @Success 202 {object} models.WebhookDeliveryResult "태스크 시작"
+// @Failure 401 {object} models.ErrorResponse "토큰 검증 실패"
+// @Failure 404 {object} models.ErrorResponse "매핑을 찾을 수 없음"
+// @Router /hooks/gitlab/{id} [post]
+func (wc *WebhookController) ReceiveGitLab(c *gin.Context) {
+	wc.receive(c, models.WebhookProviderGitLab, &models.WebhookDelivery{
+		Event:      c.GetHeader("X-Gitlab-Event"),
+		DeliveryID: c.GetHeader("X-Gitlab-Event-UUID"),
+		Token:      c.GetHeader("X-Gitlab-Token"),
+	})
+}
+
+// receive 본문을 읽어 웹훅 처리 (서명 검증에 원문 그대로 사용)
+func (wc *WebhookController) receive(c *gin.Context, provider models.WebhookProvider, delivery *models.WebhookDelivery) {
+	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, webhookPayloadMaxBytes+1))
+	if err != nil {
+		middleware.BadRequestError(c, "요청 본문을 읽을 수 없습니다")
+		return
+	}
+	if len(payload) > webhookPayloadMaxBytes {
+		middleware.AbortWithError(c, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", "웹훅 본문이 너무 큽니다", nil)
+		return
+	}
+	delivery.Payload = payload
+
+	result, err := wc.webhooks.Handle(c.Request.Context(), provider, c.Param("id"), delivery)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	status := http.StatusOK
+	if result.Status == models.WebhookDeliveryTriggered {
+		status = http.StatusAccepted
+	}
+	c.JSON(status, result)
+}
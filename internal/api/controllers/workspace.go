@@ -47,6 +47,8 @@ func NewWorkspaceControllerWithStorage(service services.WorkspaceService, docker
 // @Param limit query int false "페이지당 항목 수" default(10)
 // @Param sort query string false "정렬 기준 (name, created_at, updated_at)" default("created_at")
 // @Param order query string false "정렬 순서 (asc, desc)" default("desc")
+// @Param cursor query string false "다음 페이지 커서 (meta.next_cursor)"
+// @Param fields query string false "응답에 포함할 필드 (쉼표 구분)"
 // @Security BearerAuth
 // @Success 200 {object} models.WorkspaceListResponse "워크스페이스 목록"
 // @Failure 401 {object} models.ErrorResponse "인증 실패"
@@ -68,7 +70,7 @@ func (wc *WorkspaceController) ListWorkspaces(c *gin.Context) {
 	}
 	
 	// 워크스페이스 목록 조회 (서비스 계층 사용)
-	response, err := wc.service.ListWorkspaces(c, userClaims.UserID, &req)
+	response, err := wc.service.ListWorkspaces(c.Request.Context(), userClaims.UserID, &req)
 	if err != nil {
 		middleware.HandleServiceError(c, err)
 		return
@@ -92,7 +94,7 @@ func (wc *WorkspaceController) ListWorkspaces(c *gin.Context) {
 		Success: true,
 		Message: "워크스페이스 목록을 조회했습니다",
 		Data: gin.H{
-			"workspaces": response.Data,
+			"workspaces": models.SelectFields(response.Data, req.FieldList()),
 			"meta": response.Meta,
 		},
 	})
@@ -136,7 +138,7 @@ func (wc *WorkspaceController) CreateWorkspace(c *gin.Context) {
 		workspace, err = wc.dockerService.CreateWorkspace(c, &req, userClaims.UserID)
 	} else {
 		// 기본 서비스 사용 (Docker 비활성화된 경우)
-		workspace, err = wc.service.CreateWorkspace(c, &req, userClaims.UserID)
+		workspace, err = wc.service.CreateWorkspace(c.Request.Context(), &req, userClaims.UserID)
 	}
 	
 	if err != nil {
@@ -186,12 +188,15 @@ func (wc *WorkspaceController) GetWorkspace(c *gin.Context) {
 	}
 	
 	// 워크스페이스 조회 (서비스 계층 사용)
-	workspace, err := wc.service.GetWorkspace(c, id, userClaims.UserID)
+	workspace, err := wc.service.GetWorkspace(c.Request.Context(), id, userClaims.UserID)
 	if err != nil {
 		middleware.HandleServiceError(c, err)
 		return
 	}
 	
+	// 적용 중인 네트워크 이그레스 정책 (Docker 백엔드)
+	wc.attachNetworkPolicy(c, workspace)
+	
 	// 성공 응답
 	c.JSON(http.StatusOK, models.SuccessResponse{
 		Success: true,
@@ -213,7 +218,7 @@ func (wc *WorkspaceController) GetWorkspace(c *gin.Context) {
 // @Failure 400 {object} models.ErrorResponse "잘못된 요청"
 // @Failure 401 {object} models.ErrorResponse "인증 실패"
 // @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
-// @Failure 409 {object} models.ErrorResponse "이미 존재하는 워크스페이스 이름"
+// @Failure 409 {object} models.ErrorResponse "이미 존재하는 워크스페이스 이름 또는 버전 충돌"
 // @Router /workspaces/{id} [put]
 func (wc *WorkspaceController) UpdateWorkspace(c *gin.Context) {
 	// 사용자 정보 가져오기
@@ -238,7 +243,7 @@ func (wc *WorkspaceController) UpdateWorkspace(c *gin.Context) {
 	}
 	
 	// 워크스페이스 업데이트 (서비스 계층 사용)
-	updatedWorkspace, err := wc.service.UpdateWorkspace(c, id, &req, userClaims.UserID)
+	updatedWorkspace, err := wc.service.UpdateWorkspace(c.Request.Context(), id, &req, userClaims.UserID)
 	if err != nil {
 		middleware.HandleServiceError(c, err)
 		return
@@ -281,7 +286,7 @@ func (wc *WorkspaceController) DeleteWorkspace(c *gin.Context) {
 	}
 	
 	// 워크스페이스 삭제 (서비스 계층 사용)
-	err := wc.service.DeleteWorkspace(c, id, userClaims.UserID)
+	err := wc.service.DeleteWorkspace(c.Request.Context(), id, userClaims.UserID)
 	if err != nil {
 		middleware.HandleServiceError(c, err)
 		return
@@ -320,16 +325,18 @@ func (wc *WorkspaceController) GetWorkspaceStatus(c *gin.Context) {
 	}
 	
 	// 기본 워크스페이스 정보 조회
-	workspace, err := wc.service.GetWorkspace(c, workspaceID, claims.UserID)
+	workspace, err := wc.service.GetWorkspace(c.Request.Context(), workspaceID, claims.UserID)
 	if err != nil {
 		middleware.HandleServiceError(c, err)
 		return
 	}
 	
+	wc.attachNetworkPolicy(c, workspace)
+	
 	// Docker 컨테이너 상태 조회 (선택적)
 	var containerStatus *services.WorkspaceStatus
 	if wc.dockerService != nil {
-		status, err := wc.dockerService.GetWorkspaceStatus(c, workspaceID)
+		status, err := wc.dockerService.GetWorkspaceStatus(c.Request.Context(), workspaceID)
 		if err != nil {
 			// Docker 상태 조회 실패는 경고만 출력
 			containerStatus = &services.WorkspaceStatus{
@@ -354,6 +361,20 @@ func (wc *WorkspaceController) GetWorkspaceStatus(c *gin.Context) {
 	})
 }
 
+// attachNetworkPolicy는 Docker 백엔드에서 워크스페이스에 적용되는 네트워크 정책을 응답에 포함합니다.
+func (wc *WorkspaceController) attachNetworkPolicy(c *gin.Context, workspace *models.Workspace) {
+	if wc.dockerService == nil {
+		return
+	}
+	policyService := wc.dockerService.NetworkPolicyService()
+	if policyService == nil {
+		return
+	}
+	if policy, err := policyService.Effective(c.Request.Context(), workspace.ID); err == nil {
+		workspace.NetworkPolicy = policy
+	}
+}
+
 // BatchWorkspaceOperation은 대량 워크스페이스 작업을 처리합니다.
 // @Summary 배치 워크스페이스 작업
 // @Description 여러 워크스페이스에 대해 일괄 작업을 수행합니다
@@ -426,7 +447,7 @@ func (wc *WorkspaceController) GetBatchOperationStatus(c *gin.Context) {
 		return
 	}
 	
-	status, err := wc.dockerService.GetBatchOperationStatus(c, batchID)
+	status, err := wc.dockerService.GetBatchOperationStatus(c.Request.Context(), batchID)
 	if err != nil {
 		middleware.HandleServiceError(c, err)
 		return
@@ -465,7 +486,7 @@ func (wc *WorkspaceController) CancelBatchOperation(c *gin.Context) {
 		return
 	}
 	
-	err := wc.dockerService.CancelBatchOperation(c, batchID)
+	err := wc.dockerService.CancelBatchOperation(c.Request.Context(), batchID)
 	if err != nil {
 		middleware.HandleServiceError(c, err)
 		return
@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// WorkspaceACLController는 워크스페이스 공유 ACL API를 처리합니다.
+type WorkspaceACLController struct {
+	access *services.WorkspaceAccessService
+}
+
+// NewWorkspaceACLController는 새로운 워크스페이스 ACL 컨트롤러를 생성합니다.
+func NewWorkspaceACLController(access *services.WorkspaceAccessService) *WorkspaceACLController {
+	return &WorkspaceACLController{
+		access: access,
+	}
+}
+
+// ListACL은 워크스페이스의 ACL 항목을 조회합니다.
+// @Summary 워크스페이스 ACL 조회
+// @Description 워크스페이스에 사용자 또는 그룹별로 부여된 read/execute/admin 권한을 조회합니다 (admin 권한 필요)
+// @Tags workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {array} models.WorkspaceACLEntry "ACL 항목"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/acl [get]
+func (ac *WorkspaceACLController) ListACL(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	entries, err := ac.access.ListEntries(c.Request.Context(), c.Param("id"), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+// PutACL은 사용자 또는 그룹에 워크스페이스 권한을 부여합니다.
+// @Summary 워크스페이스 권한 부여
+// @Description 같은 대상의 항목이 이미 있으면 권한을 바꿉니다 (admin 권한 필요)
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.WorkspaceACLRequest true "ACL 항목"
+// @Success 200 {object} models.WorkspaceACLEntry "저장된 ACL 항목"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/acl [put]
+func (ac *WorkspaceACLController) PutACL(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.WorkspaceACLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	entry, err := ac.access.Grant(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+// DeleteACL은 사용자 또는 그룹의 워크스페이스 권한을 회수합니다.
+// @Summary 워크스페이스 권한 회수
+// @Tags workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param type path string true "대상 종류 (user, group)"
+// @Param principal path string true "사용자 ID 또는 그룹 ID"
+// @Success 204 "회수 완료"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "ACL 항목을 찾을 수 없음"
+// @Router /workspaces/{id}/acl/{type}/{principal} [delete]
+func (ac *WorkspaceACLController) DeleteACL(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	principalType := models.ACLPrincipalType(c.Param("type"))
+	if principalType != models.ACLPrincipalUser && principalType != models.ACLPrincipalGroup {
+		middleware.ValidationError(c, "대상 종류는 user, group 중 하나여야 합니다", nil)
+		return
+	}
+
+	err := ac.access.Revoke(c.Request.Context(), c.Param("id"), userClaims.UserID, principalType, c.Param("principal"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// WhoHasAccess는 워크스페이스에 접근할 수 있는 사용자와 그룹을 조회합니다.
+// @Summary 워크스페이스 접근 권한 보유자 조회
+// @Description 소유자와 ACL 항목을 권한과 함께 반환합니다. 그룹 항목에는 그룹 이름과 구성원이 포함됩니다 (read 권한 필요)
+// @Tags workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {array} models.WorkspaceAccessEntry "접근 권한 보유자"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/access [get]
+func (ac *WorkspaceACLController) WhoHasAccess(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	access, err := ac.access.WhoHasAccess(c.Request.Context(), c.Param("id"), userClaims.UserID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, access)
+}
+
+// ListShared는 다른 사용자에게서 공유받은 워크스페이스를 조회합니다.
+// @Summary 공유받은 워크스페이스 목록
+// @Description 본인 또는 소속 그룹에 ACL로 공유된 워크스페이스와 가장 높은 권한을 반환합니다
+// @Tags workspaces
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.SharedWorkspace "공유받은 워크스페이스"
+// @Router /workspaces/shared [get]
+func (ac *WorkspaceACLController) ListShared(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	shared, err := ac.access.ListShared(c.Request.Context(), userClaims.UserID)
+	if err != nil {
+		middleware.InternalError(c, "공유받은 워크스페이스 조회 실패", err.Error())
+		return
+	}
+
+	c.JSON(http.StatusOK, shared)
+}
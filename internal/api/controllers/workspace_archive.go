@@ -0,0 +1,195 @@
+package controllers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+const (
+	// maxArchiveUploadSize 가져오기 아카이브 최대 크기
+	maxArchiveUploadSize = 512 << 20
+	// maxArchiveFilesSize 내보내기 시 포함할 파일 최대 총 크기
+	maxArchiveFilesSize = 256 << 20
+)
+
+// WorkspaceArchiveController는 워크스페이스 내보내기/가져오기 API를 처리합니다.
+type WorkspaceArchiveController struct {
+	archiveService  *services.WorkspaceArchiveService
+	artifactService *services.ArtifactService
+}
+
+// NewWorkspaceArchiveController는 새로운 아카이브 컨트롤러를 생성합니다.
+// artifactService가 nil이면 아티팩트 저장소에 보관하는 옵션(store=true)은 사용할 수 없습니다.
+func NewWorkspaceArchiveController(archiveService *services.WorkspaceArchiveService, artifactService *services.ArtifactService) *WorkspaceArchiveController {
+	return &WorkspaceArchiveController{
+		archiveService:  archiveService,
+		artifactService: artifactService,
+	}
+}
+
+// ExportWorkspace는 워크스페이스를 tar.gz 아카이브로 내보냅니다.
+// @Summary 워크스페이스 내보내기
+// @Description 워크스페이스 메타데이터, 세션/태스크 기록, 설정과 선택적으로 프로젝트 파일을 tar.gz로 내보냅니다
+// @Tags workspaces
+// @Produce application/gzip
+// @Param id path string true "워크스페이스 ID"
+// @Param include_files query bool false "프로젝트 파일 포함 여부"
+// @Param store query bool false "true이면 아티팩트 저장소에 보관하고 다운로드 URL을 반환"
+// @Security BearerAuth
+// @Success 200 {file} file "워크스페이스 아카이브"
+// @Success 201 {object} services.ArtifactInfo "저장된 아카이브 (store=true)"
+// @Failure 401 {object} models.ErrorResponse "인증 실패"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/export [get]
+func (ac *WorkspaceArchiveController) ExportWorkspace(c *gin.Context) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		middleware.UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+		return
+	}
+	userClaims := claims.(*auth.Claims)
+
+	workspaceID := c.Param("id")
+	includeFiles, _ := strconv.ParseBool(c.Query("include_files"))
+
+	opts := services.ExportOptions{IncludeFiles: includeFiles, MaxFilesSize: maxArchiveFilesSize}
+
+	// 대용량 아카이브는 저장소에 보관하고 미리 서명된 URL로 내려받도록 함 (메모리 대신 임시 파일에 작성)
+	if store, _ := strconv.ParseBool(c.Query("store")); store {
+		if ac.artifactService == nil {
+			middleware.ValidationError(c, "아티팩트 저장소가 설정되지 않았습니다", nil)
+			return
+		}
+		info, err := ac.storeExport(c, workspaceID, userClaims.UserID, opts)
+		if err != nil {
+			middleware.HandleServiceError(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, info)
+		return
+	}
+
+	// 아카이브를 응답으로 바로 스트리밍 (첫 바이트를 쓰기 전의 에러는 JSON으로 응답)
+	w := &archiveResponseWriter{
+		c:        c,
+		filename: fmt.Sprintf("workspace-%s-%s.tar.gz", workspaceID, time.Now().Format("20060102-150405")),
+	}
+	if err := ac.archiveService.Export(c.Request.Context(), w, workspaceID, userClaims.UserID, opts); err != nil {
+		if !w.started {
+			middleware.HandleServiceError(c, err)
+			return
+		}
+		// 이미 전송을 시작했으면 gzip 끝부분이 빠진 채로 끊겨 클라이언트가 잘린 아카이브로 인식합니다
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
+
+// storeExport는 아카이브를 임시 파일에 작성한 뒤 아티팩트 저장소에 보관합니다.
+func (ac *WorkspaceArchiveController) storeExport(c *gin.Context, workspaceID, userID string, opts services.ExportOptions) (*services.ArtifactInfo, error) {
+	tmp, err := os.CreateTemp("", "aicli-export-*.tar.gz")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err := ac.archiveService.Export(c.Request.Context(), tmp, workspaceID, userID, opts); err != nil {
+		return nil, err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return ac.artifactService.StoreWorkspaceExport(c.Request.Context(), userID, workspaceID, tmp, size)
+}
+
+// archiveResponseWriter는 첫 바이트를 쓸 때 다운로드 헤더와 상태 코드를 보냅니다.
+type archiveResponseWriter struct {
+	c        *gin.Context
+	filename string
+	started  bool
+}
+
+func (w *archiveResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.filename))
+		w.c.Header("Content-Type", "application/gzip")
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
+
+// ImportWorkspace는 아카이브에서 워크스페이스를 가져옵니다.
+// @Summary 워크스페이스 가져오기
+// @Description 내보낸 tar.gz 아카이브를 가져옵니다. 모든 ID는 새로 발급되며 응답에 원본 ID와의 매핑이 포함됩니다
+// @Tags workspaces
+// @Accept application/gzip
+// @Accept multipart/form-data
+// @Produce json
+// @Param archive formData file false "워크스페이스 아카이브 (multipart 업로드 시)"
+// @Param on_conflict query string false "이름 충돌 처리 방식" Enums(fail, rename)
+// @Param target_path query string false "프로젝트 파일을 복원할 디렉토리 (workspace.import_root 아래, 상대 경로는 루트 기준)"
+// @Security BearerAuth
+// @Success 201 {object} services.ImportResult "가져오기 결과"
+// @Failure 400 {object} models.ErrorResponse "잘못된 아카이브"
+// @Failure 401 {object} models.ErrorResponse "인증 실패"
+// @Failure 409 {object} models.ErrorResponse "이름 충돌"
+// @Router /workspaces/import [post]
+func (ac *WorkspaceArchiveController) ImportWorkspace(c *gin.Context) {
+	claims, exists := c.Get("claims")
+	if !exists {
+		middleware.UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+		return
+	}
+	userClaims := claims.(*auth.Claims)
+
+	strategy := services.ConflictStrategy(c.DefaultQuery("on_conflict", string(services.ConflictFail)))
+	if strategy != services.ConflictFail && strategy != services.ConflictRename {
+		middleware.ValidationError(c, "on_conflict는 fail 또는 rename이어야 합니다", nil)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxArchiveUploadSize)
+
+	var archive io.Reader = c.Request.Body
+	if c.ContentType() == "multipart/form-data" {
+		fileHeader, err := c.FormFile("archive")
+		if err != nil {
+			middleware.ValidationError(c, "archive 파일이 필요합니다", err.Error())
+			return
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			middleware.ValidationError(c, "archive 파일을 열 수 없습니다", err.Error())
+			return
+		}
+		defer file.Close()
+		archive = file
+	}
+
+	opts := services.ImportOptions{
+		OnConflict: strategy,
+		TargetPath: c.Query("target_path"),
+	}
+	result, err := ac.archiveService.Import(c.Request.Context(), archive, userClaims.UserID, opts)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// WorkspaceDiskQuotaController는 워크스페이스 디스크 사용량 조회 API를 처리합니다.
+type WorkspaceDiskQuotaController struct {
+	quotaService *services.WorkspaceDiskQuotaService
+}
+
+// NewWorkspaceDiskQuotaController는 새로운 워크스페이스 디스크 사용량 컨트롤러를 생성합니다.
+func NewWorkspaceDiskQuotaController(quotaService *services.WorkspaceDiskQuotaService) *WorkspaceDiskQuotaController {
+	return &WorkspaceDiskQuotaController{
+		quotaService: quotaService,
+	}
+}
+
+// GetDiskUsage는 워크스페이스 디스크 사용량과 한도를 조회합니다.
+// @Summary 워크스페이스 디스크 사용량 조회
+// @Description 사용량은 주기적으로 측정한 값을 반환하며, refresh=true이면 바로 다시 측정합니다.
+// @Description 한도에 도달한 워크스페이스의 새 태스크는 507 DISK_QUOTA_EXCEEDED로 거부됩니다.
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param refresh query bool false "다시 측정 여부"
+// @Security BearerAuth
+// @Success 200 {object} models.WorkspaceDiskUsage "디스크 사용량"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /workspaces/{id}/disk-usage [get]
+func (qc *WorkspaceDiskQuotaController) GetDiskUsage(c *gin.Context) {
+	usage, err := qc.quotaService.Usage(c.Request.Context(), c.Param("id"), c.Query("refresh") == "true")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
+
+// handleDiskQuotaError는 디스크 한도 초과로 거부된 요청이면 507과 사용량 정보로 응답합니다.
+func handleDiskQuotaError(c *gin.Context, err error) bool {
+	var quotaErr *services.DiskQuotaError
+	if !errors.As(err, &quotaErr) {
+		return false
+	}
+
+	middleware.AbortWithError(c, http.StatusInsufficientStorage, services.DiskQuotaErrorCode, quotaErr.Error(), quotaErr.Usage)
+	return true
+}
@@ -0,0 +1,42 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// WorkspaceDoctorController는 워크스페이스 사전 점검 API를 처리합니다.
+type WorkspaceDoctorController struct {
+	doctor *services.WorkspaceDoctorService
+}
+
+// NewWorkspaceDoctorController는 새로운 워크스페이스 점검 컨트롤러를 생성합니다.
+func NewWorkspaceDoctorController(doctor *services.WorkspaceDoctorService) *WorkspaceDoctorController {
+	return &WorkspaceDoctorController{
+		doctor: doctor,
+	}
+}
+
+// RunDoctor는 워크스페이스 환경을 점검합니다.
+// @Summary 워크스페이스 사전 점검
+// @Description 경로 쓰기 권한, git 저장소, Claude CLI, 컨테이너 이미지, 디스크 한도, MCP 서버를 점검하고 문제별 해결 방법을 반환합니다. 이미지가 없으면 받아오므로 시간이 걸릴 수 있습니다 (execute 권한 필요)
+// @Tags workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {object} models.WorkspaceDoctorReport "점검 결과"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "워크스페이스를 찾을 수 없음"
+// @Router /workspaces/{id}/doctor [post]
+func (dc *WorkspaceDoctorController) RunDoctor(c *gin.Context) {
+	report, err := dc.doctor.Run(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// WorkspaceEnvController는 워크스페이스 환경 변수 API를 처리합니다.
+// 워크스페이스 권한은 라우터의 권한 미들웨어에서 확인합니다.
+type WorkspaceEnvController struct {
+	env *services.WorkspaceEnvService
+}
+
+// NewWorkspaceEnvController는 새로운 워크스페이스 환경 변수 컨트롤러를 생성합니다.
+func NewWorkspaceEnvController(env *services.WorkspaceEnvService) *WorkspaceEnvController {
+	return &WorkspaceEnvController{
+		env: env,
+	}
+}
+
+// ListEnv는 워크스페이스의 환경 변수를 조회합니다.
+// @Summary 워크스페이스 환경 변수 조회
+// @Description 비밀 변수 값은 마스킹되어 반환됩니다
+// @Tags workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 200 {array} models.WorkspaceEnvVar "환경 변수"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Router /workspaces/{id}/env [get]
+func (ec *WorkspaceEnvController) ListEnv(c *gin.Context) {
+	variables, err := ec.env.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, variables)
+}
+
+// CreateEnv는 워크스페이스에 환경 변수를 추가합니다.
+// @Summary 워크스페이스 환경 변수 추가
+// @Description secret이면 값을 암호화해 저장하고 응답에서 가립니다 (admin 권한 필요)
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.WorkspaceEnvVarCreateRequest true "환경 변수"
+// @Success 201 {object} models.WorkspaceEnvVar "추가된 환경 변수"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 409 {object} models.ErrorResponse "같은 이름의 변수가 있음"
+// @Router /workspaces/{id}/env [post]
+func (ec *WorkspaceEnvController) CreateEnv(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.WorkspaceEnvVarCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	variable, err := ec.env.Create(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, variable)
+}
+
+// UpdateEnv는 환경 변수의 값 또는 비밀 여부를 바꿉니다.
+// @Summary 워크스페이스 환경 변수 변경
+// @Description 비밀 변수를 일반 변수로 바꿀 때는 새 값이 필요합니다 (admin 권한 필요)
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param key path string true "변수 이름"
+// @Param request body models.WorkspaceEnvVarUpdateRequest true "변경 내용"
+// @Success 200 {object} models.WorkspaceEnvVar "변경된 환경 변수"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "변수를 찾을 수 없음"
+// @Router /workspaces/{id}/env/{key} [put]
+func (ec *WorkspaceEnvController) UpdateEnv(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.WorkspaceEnvVarUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	variable, err := ec.env.Update(c.Request.Context(), c.Param("id"), c.Param("key"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, variable)
+}
+
+// DeleteEnv는 환경 변수를 삭제합니다.
+// @Summary 워크스페이스 환경 변수 삭제
+// @Tags workspaces
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param key path string true "변수 이름"
+// @Success 204 "삭제됨"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Failure 404 {object} models.ErrorResponse "변수를 찾을 수 없음"
+// @Router /workspaces/{id}/env/{key} [delete]
+func (ec *WorkspaceEnvController) DeleteEnv(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	if err := ec.env.Delete(c.Request.Context(), c.Param("id"), c.Param("key"), userClaims.UserID); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListEnvHistory는 환경 변수 변경 기록을 조회합니다.
+// @Summary 워크스페이스 환경 변수 변경 기록
+// @Description 누가 언제 어떤 변수를 추가, 변경, 삭제했는지 최신순으로 반환합니다 (값은 기록하지 않음, admin 권한 필요)
+// @Tags workspaces
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Param key query string false "변수 이름"
+// @Param limit query int false "최대 개수 (기본값: 50)"
+// @Success 200 {array} models.WorkspaceEnvChange "변경 기록"
+// @Failure 400 {object} models.ErrorResponse "잘못된 요청"
+// @Failure 403 {object} models.ErrorResponse "권한 없음"
+// @Router /workspaces/{id}/env/history [get]
+func (ec *WorkspaceEnvController) ListEnvHistory(c *gin.Context) {
+	var req models.WorkspaceEnvHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	changes, err := ec.env.History(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, changes)
+}
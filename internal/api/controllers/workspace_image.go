@@ -0,0 +1,154 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// WorkspaceImageController는 워크스페이스별 컨테이너 이미지 지정과 빌드 API를 처리합니다.
+type WorkspaceImageController struct {
+	imageService *services.WorkspaceImageService
+	storage      storage.Storage
+}
+
+// NewWorkspaceImageController는 새로운 워크스페이스 이미지 컨트롤러를 생성합니다.
+func NewWorkspaceImageController(imageService *services.WorkspaceImageService, storage storage.Storage) *WorkspaceImageController {
+	return &WorkspaceImageController{
+		imageService: imageService,
+		storage:      storage,
+	}
+}
+
+// GetImage는 워크스페이스 이미지 설정과 마지막 빌드 상태를 조회합니다.
+// @Summary 워크스페이스 이미지 조회
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {object} services.WorkspaceImage "이미지 설정"
+// @Failure 404 {object} models.ErrorResponse "이미지 설정이 없음"
+// @Router /workspaces/{id}/image [get]
+func (ic *WorkspaceImageController) GetImage(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	image, err := ic.imageService.Get(c.Request.Context(), workspaceID)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, image)
+}
+
+// PutImage는 워크스페이스 컨테이너 이미지를 지정합니다.
+// @Summary 워크스페이스 이미지 지정
+// @Description 허용 목록의 이미지 또는 Dockerfile을 지정합니다. Dockerfile은 백그라운드에서 빌드되며 빌드 로그는 WebSocket으로 전달됩니다.
+// @Description 새 이미지는 컨테이너를 다시 만들 때 적용됩니다.
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param request body docker.WorkspaceImageSpec true "이미지 설정"
+// @Security BearerAuth
+// @Success 200 {object} services.WorkspaceImage "저장된 이미지 설정"
+// @Failure 400 {object} models.ErrorResponse "정책 위반"
+// @Failure 409 {object} models.ErrorResponse "빌드 진행 중"
+// @Router /workspaces/{id}/image [put]
+func (ic *WorkspaceImageController) PutImage(c *gin.Context) {
+	workspace, ok := ic.loadWorkspace(c)
+	if !ok {
+		return
+	}
+
+	var spec docker.WorkspaceImageSpec
+	if err := c.ShouldBindJSON(&spec); err != nil {
+		middleware.ValidationError(c, "잘못된 요청 형식입니다", err.Error())
+		return
+	}
+
+	image, err := ic.imageService.Put(c.Request.Context(), workspace, spec)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, image)
+}
+
+// RebuildImage는 워크스페이스 Dockerfile을 다시 빌드합니다.
+// @Summary 워크스페이스 이미지 다시 빌드
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param no_cache query bool false "빌드 캐시를 사용하지 않음"
+// @Security BearerAuth
+// @Success 202 {object} services.ImageBuild "시작된 빌드"
+// @Failure 400 {object} models.ErrorResponse "Dockerfile이 지정되지 않음"
+// @Failure 409 {object} models.ErrorResponse "빌드 진행 중"
+// @Router /workspaces/{id}/image/build [post]
+func (ic *WorkspaceImageController) RebuildImage(c *gin.Context) {
+	workspace, ok := ic.loadWorkspace(c)
+	if !ok {
+		return
+	}
+
+	build, err := ic.imageService.Rebuild(c.Request.Context(), workspace, c.Query("no_cache") == "true")
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, build)
+}
+
+// GetBuild는 워크스페이스 이미지의 마지막 빌드 상태와 로그를 조회합니다.
+// @Summary 워크스페이스 이미지 빌드 조회
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {object} services.ImageBuild "빌드 상태와 로그"
+// @Failure 404 {object} models.ErrorResponse "빌드 기록이 없음"
+// @Router /workspaces/{id}/image/build [get]
+func (ic *WorkspaceImageController) GetBuild(c *gin.Context) {
+	workspaceID := c.Param("id")
+
+	build := ic.imageService.LatestBuild(workspaceID)
+	if build == nil {
+		middleware.NotFoundError(c, "빌드 기록이 없습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, build)
+}
+
+// GetPolicy는 워크스페이스 이미지 정책을 조회합니다.
+// @Summary 워크스페이스 이미지 정책 조회
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} docker.ImagePolicy "이미지 정책"
+// @Router /admin/image-policy [get]
+func (ic *WorkspaceImageController) GetPolicy(c *gin.Context) {
+	c.JSON(http.StatusOK, ic.imageService.Policy())
+}
+
+// loadWorkspace 경로의 :id 워크스페이스 조회 (권한은 라우터의 워크스페이스 권한 미들웨어에서 확인)
+func (ic *WorkspaceImageController) loadWorkspace(c *gin.Context) (*models.Workspace, bool) {
+	workspace, err := ic.storage.Workspace().GetByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		if err == storage.ErrNotFound {
+			middleware.NotFoundError(c, "워크스페이스를 찾을 수 없습니다")
+			return nil, false
+		}
+		middleware.InternalError(c, "워크스페이스 조회 실패", err)
+		return nil, false
+	}
+	return workspace, true
+}
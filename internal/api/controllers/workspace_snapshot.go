@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// WorkspaceSnapshotController는 워크스페이스 파일 시스템 스냅샷 생성, 조회, 복원 API를 처리합니다.
+type WorkspaceSnapshotController struct {
+	snapshotService *services.WorkspaceSnapshotService
+}
+
+// NewWorkspaceSnapshotController는 새로운 워크스페이스 스냅샷 컨트롤러를 생성합니다.
+func NewWorkspaceSnapshotController(snapshotService *services.WorkspaceSnapshotService) *WorkspaceSnapshotController {
+	return &WorkspaceSnapshotController{
+		snapshotService: snapshotService,
+	}
+}
+
+// ListSnapshots는 워크스페이스 스냅샷을 최신순으로 조회합니다.
+// @Summary 워크스페이스 스냅샷 목록
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {array} models.WorkspaceSnapshot "스냅샷 목록"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /workspaces/{id}/snapshots [get]
+func (sc *WorkspaceSnapshotController) ListSnapshots(c *gin.Context) {
+	snapshots, err := sc.snapshotService.List(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, snapshots)
+}
+
+// CreateSnapshot는 워크스페이스 디렉토리의 현재 상태로 스냅샷을 만듭니다.
+// @Summary 워크스페이스 스냅샷 생성
+// @Description Git과 무관하게 워크스페이스 디렉토리 전체를 복사합니다. 바뀌지 않은 파일은 이전 스냅샷과 하드 링크로 공유합니다.
+// @Description 디스크 사용량 상한을 넘으면 오래된 스냅샷부터 삭제됩니다.
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.WorkspaceSnapshotCreateRequest false "스냅샷 설명"
+// @Security BearerAuth
+// @Success 201 {object} models.WorkspaceSnapshot "생성된 스냅샷"
+// @Failure 400 {object} models.ErrorResponse "워크스페이스 디렉토리가 없음"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /workspaces/{id}/snapshots [post]
+func (sc *WorkspaceSnapshotController) CreateSnapshot(c *gin.Context) {
+	var req models.WorkspaceSnapshotCreateRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			middleware.ValidationError(c, "잘못된 요청 형식입니다", err.Error())
+			return
+		}
+	}
+
+	snapshot, err := sc.snapshotService.Create(c.Request.Context(), c.Param("id"), req.Label)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, snapshot)
+}
+
+// RestoreSnapshot는 워크스페이스 디렉토리를 스냅샷 상태로 되돌립니다.
+// @Summary 워크스페이스 스냅샷 복원
+// @Description 스냅샷에 없는 파일은 삭제되고 바뀐 파일은 스냅샷 내용으로 덮어씁니다.
+// @Description 복원 직전 상태는 자동 스냅샷으로 남습니다.
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param snapshotId path string true "스냅샷 ID"
+// @Security BearerAuth
+// @Success 200 {object} models.WorkspaceSnapshotRestoreResult "복원 결과"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "스냅샷 없음"
+// @Router /workspaces/{id}/snapshots/{snapshotId}/restore [post]
+func (sc *WorkspaceSnapshotController) RestoreSnapshot(c *gin.Context) {
+	result, err := sc.snapshotService.Restore(c.Request.Context(), c.Param("id"), c.Param("snapshotId"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteSnapshot는 워크스페이스 스냅샷을 삭제합니다.
+// @Summary 워크스페이스 스냅샷 삭제
+// @Tags workspaces
+// @Param id path string true "워크스페이스 ID"
+// @Param snapshotId path string true "스냅샷 ID"
+// @Security BearerAuth
+// @Success 204 "삭제됨"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Failure 404 {object} models.ErrorResponse "스냅샷 없음"
+// @Router /workspaces/{id}/snapshots/{snapshotId} [delete]
+func (sc *WorkspaceSnapshotController) DeleteSnapshot(c *gin.Context) {
+	if err := sc.snapshotService.Delete(c.Request.Context(), c.Param("id"), c.Param("snapshotId")); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
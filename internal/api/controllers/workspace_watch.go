@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// WorkspaceWatchController는 워크스페이스 파일 감시 자동 실행 API를 처리합니다.
+type WorkspaceWatchController struct {
+	watchService *services.WorkspaceWatchService
+}
+
+// NewWorkspaceWatchController는 새로운 워크스페이스 파일 감시 컨트롤러를 생성합니다.
+func NewWorkspaceWatchController(watchService *services.WorkspaceWatchService) *WorkspaceWatchController {
+	return &WorkspaceWatchController{
+		watchService: watchService,
+	}
+}
+
+// GetWatch는 워크스페이스 파일 감시 설정과 현재 상태를 조회합니다.
+// @Summary 워크스페이스 파일 감시 조회
+// @Description 설정이 없으면 state가 disabled입니다. 실행 횟수 한도를 넘어 멈춘 감시는 paused와 멈춘 이유를 반환합니다.
+// @Tags workspaces
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Security BearerAuth
+// @Success 200 {object} models.WorkspaceWatchStatus "감시 설정과 상태"
+// @Failure 403 {object} models.ErrorResponse "접근 권한 없음"
+// @Router /workspaces/{id}/watch [get]
+func (wc *WorkspaceWatchController) GetWatch(c *gin.Context) {
+	status, err := wc.watchService.Get(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// PutWatch는 워크스페이스 파일 감시 설정을 저장합니다.
+// @Summary 워크스페이스 파일 감시 설정
+// @Description 세션 프로젝트 디렉토리에서 patterns와 일치하는 파일이 바뀌면 debounce_ms 동안 변경을 모아 prompt 태스크나 pipeline_id 파이프라인을 요청한 사용자로 실행합니다.
+// @Description 자동 실행이 끝날 때까지와 cooldown_ms 동안의 변경은 무시하며, 한 시간에 max_triggers_per_hour번을 넘으면 감시를 멈춥니다. 다시 저장하면 재개합니다.
+// @Tags workspaces
+// @Accept json
+// @Produce json
+// @Param id path string true "워크스페이스 ID"
+// @Param request body models.WorkspaceWatchRequest true "감시 설정"
+// @Security BearerAuth
+// @Success 200 {object} models.WorkspaceWatchStatus "저장된 설정과 상태"
+// @Failure 400 {object} models.ErrorResponse "잘못된 설정"
+// @Failure 403 {object} models.ErrorResponse "세션 실행 권한 없음"
+// @Router /workspaces/{id}/watch [put]
+func (wc *WorkspaceWatchController) PutWatch(c *gin.Context) {
+	userClaims := c.MustGet("claims").(*auth.Claims)
+
+	var req models.WorkspaceWatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ValidationError(c, "요청 데이터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	status, err := wc.watchService.Put(c.Request.Context(), c.Param("id"), userClaims.UserID, &req)
+	if err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// DeleteWatch는 워크스페이스 파일 감시 설정을 삭제하고 감시를 멈춥니다.
+// @Summary 워크스페이스 파일 감시 삭제
+// @Tags workspaces
+// @Security BearerAuth
+// @Param id path string true "워크스페이스 ID"
+// @Success 204 "삭제됨"
+// @Router /workspaces/{id}/watch [delete]
+func (wc *WorkspaceWatchController) DeleteWatch(c *gin.Context) {
+	if err := wc.watchService.Delete(c.Request.Context(), c.Param("id")); err != nil {
+		middleware.HandleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -3,14 +3,24 @@ package handlers
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/breaker"
 	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
 )
 
+// oauthUserIDPrefix OAuth 로그인 사용자 ID 접두사 (로컬 계정이 없는 사용자)
+const oauthUserIDPrefix = "oauth_"
+
 // LoginRequest 로그인 요청 구조체
 type LoginRequest struct {
 	Username string `json:"username" binding:"required"`
@@ -41,6 +51,8 @@ type RefreshResponse struct {
 type AuthHandler struct {
 	jwtManager *auth.JWTManager
 	blacklist  *auth.Blacklist
+	accounts   *services.AccountService
+	devices    *auth.DeviceAuthorizer
 }
 
 // NewAuthHandler 새로운 인증 핸들러 생성
@@ -51,6 +63,11 @@ func NewAuthHandler(jwtManager *auth.JWTManager, blacklist *auth.Blacklist) *Aut
 	}
 }
 
+// SetAccountService 로컬 계정 저장소로 로그인하도록 설정 (설정하지 않으면 개발용 고정 계정 사용)
+func (h *AuthHandler) SetAccountService(accounts *services.AccountService) {
+	h.accounts = accounts
+}
+
 // Login 로그인 처리
 // @Summary 사용자 로그인
 // @Description 사용자 자격증명으로 로그인하여 JWT 토큰을 받습니다
@@ -61,6 +78,7 @@ func NewAuthHandler(jwtManager *auth.JWTManager, blacklist *auth.Blacklist) *Aut
 // @Success 200 {object} map[string]interface{} "로그인 성공"
 // @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Failure 401 {object} map[string]interface{} "인증 실패"
+// @Failure 423 {object} map[string]interface{} "로그인 실패가 반복되어 계정 잠김"
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req LoginRequest
@@ -76,29 +94,13 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	// TODO: 실제 환경에서는 데이터베이스에서 사용자 검증 필요
-	// 임시로 하드코딩된 사용자 정보 사용
-	if !h.validateUser(req.Username, req.Password) {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"success": false,
-			"error": gin.H{
-				"code":    "INVALID_CREDENTIALS",
-				"message": "Invalid username or password",
-			},
-		})
+	userID, username, email, role, ok := h.authenticate(c, &req)
+	if !ok {
 		return
 	}
 
-	// 사용자 정보 설정 (임시)
-	userID := "user-" + req.Username
-	role := "user"
-	if req.Username == "admin" {
-		role = "admin"
-	}
-
 	// 액세스 토큰 생성
-	email := req.Username + "@example.com" // 임시 이메일
-	accessToken, err := h.jwtManager.GenerateToken(userID, req.Username, email, role, auth.AccessToken)
+	accessToken, err := h.jwtManager.GenerateToken(userID, username, email, role, auth.AccessToken)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -111,7 +113,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// 리프레시 토큰 생성
-	refreshToken, err := h.jwtManager.GenerateToken(userID, req.Username, email, role, auth.RefreshToken)
+	refreshToken, err := h.jwtManager.GenerateToken(userID, username, email, role, auth.RefreshToken)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -135,6 +137,68 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	})
 }
 
+// authenticate 자격 증명을 확인하고 토큰에 넣을 사용자 정보를 반환 (실패하면 응답 후 false)
+func (h *AuthHandler) authenticate(c *gin.Context, req *LoginRequest) (userID, username, email, role string, ok bool) {
+	if h.accounts == nil {
+		// 로컬 계정을 사용하지 않으면 개발용 고정 계정으로 확인
+		if !h.validateUser(req.Username, req.Password) {
+			h.invalidCredentials(c)
+			return "", "", "", "", false
+		}
+		role = "user"
+		if req.Username == "admin" {
+			role = "admin"
+		}
+		return "user-" + req.Username, req.Username, req.Username + "@example.com", role, true
+	}
+
+	account, err := h.accounts.Authenticate(c.Request.Context(), &services.LoginAttempt{
+		Username:  req.Username,
+		Password:  req.Password,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		Path:      c.Request.URL.Path,
+	})
+	var lockedErr *services.AccountLockedError
+	switch {
+	case err == nil:
+		return account.ID, account.Username, account.Email, account.Role, true
+	case errors.As(err, &lockedErr):
+		retryAfter := int(time.Until(lockedErr.Until).Seconds()) + 1
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		c.JSON(http.StatusLocked, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "ACCOUNT_LOCKED",
+				"message": "Too many failed login attempts; account is temporarily locked",
+				"details": gin.H{"locked_until": lockedErr.Until},
+			},
+		})
+	case errors.Is(err, services.ErrInvalidCredentials):
+		h.invalidCredentials(c)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "AUTHENTICATION_ERROR",
+				"message": "Failed to verify credentials",
+			},
+		})
+	}
+	return "", "", "", "", false
+}
+
+// invalidCredentials 자격 증명 오류 응답
+func (h *AuthHandler) invalidCredentials(c *gin.Context) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INVALID_CREDENTIALS",
+			"message": "Invalid username or password",
+		},
+	})
+}
+
 // Refresh 토큰 갱신
 // @Summary 액세스 토큰 갱신
 // @Description 리프레시 토큰을 사용하여 새 액세스 토큰을 받습니다
@@ -144,7 +208,8 @@ func (h *AuthHandler) Login(c *gin.Context) {
 // @Param body body RefreshRequest true "토큰 갱신 요청"
 // @Success 200 {object} map[string]interface{} "토큰 갱신 성공"
 // @Failure 400 {object} map[string]interface{} "잘못된 요청"
-// @Failure 401 {object} map[string]interface{} "인증 실패"
+// @Failure 401 {object} map[string]interface{} "인증 실패 (차단된 토큰, 비활성 계정, 비밀번호 변경 전 발급)"
+// @Failure 423 {object} map[string]interface{} "계정 잠김"
 // @Router /auth/refresh [post]
 func (h *AuthHandler) Refresh(c *gin.Context) {
 	var req RefreshRequest
@@ -185,6 +250,20 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		})
 		return
 	}
+	claims, _ := h.jwtManager.VerifyToken(req.RefreshToken)
+	if h.blacklist.IsRevoked(claims) {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "TOKEN_BLACKLISTED",
+				"message": "Refresh token has been revoked",
+			},
+		})
+		return
+	}
+	if !h.checkRefreshAccount(c, claims) {
+		return
+	}
 
 	// 응답
 	c.JSON(http.StatusOK, gin.H{
@@ -197,6 +276,61 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 	})
 }
 
+// checkRefreshAccount 토큰을 갱신할 로컬 계정이 아직 사용 가능한지 확인 (실패하면 응답 후 false)
+// 삭제, 비활성화되거나 잠긴 계정, 마지막 비밀번호 변경 전에 발급한 토큰은 거부합니다.
+// 로컬 계정을 사용하지 않거나 OAuth 사용자의 토큰이면 확인하지 않습니다.
+func (h *AuthHandler) checkRefreshAccount(c *gin.Context, claims *auth.Claims) bool {
+	if h.accounts == nil || strings.HasPrefix(claims.UserID, oauthUserIDPrefix) {
+		return true
+	}
+
+	account, err := h.accounts.Get(c.Request.Context(), claims.UserID)
+	if err != nil && !storage.IsNotFoundError(err) {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "AUTHENTICATION_ERROR",
+				"message": "Failed to verify account",
+			},
+		})
+		return false
+	}
+
+	now := time.Now()
+	switch {
+	case err != nil || !account.IsActive:
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "ACCOUNT_DISABLED",
+				"message": "Account is no longer active",
+			},
+		})
+	case account.IsLocked(now):
+		c.Header("Retry-After", strconv.Itoa(int(account.LockedUntil.Sub(now).Seconds())+1))
+		c.JSON(http.StatusLocked, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "ACCOUNT_LOCKED",
+				"message": "Account is temporarily locked",
+				"details": gin.H{"locked_until": account.LockedUntil},
+			},
+		})
+	case claims.IssuedAt == nil || claims.IssuedAt.Time.Before(account.PasswordChangedAt.Truncate(time.Second)):
+		// JWT 발급 시각은 초 단위이므로 비밀번호를 바꾼 초에 발급한 토큰은 허용
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "TOKEN_BLACKLISTED",
+				"message": "Refresh token was issued before the last password change",
+			},
+		})
+	default:
+		return true
+	}
+	return false
+}
+
 // Logout 로그아웃 처리
 // @Summary 사용자 로그아웃
 // @Description 현재 액세스 토큰을 무효화합니다
@@ -341,6 +475,7 @@ func (h *AuthHandler) OAuthLogin(c *gin.Context, oauthManager auth.OAuthManager)
 // @Success 200 {object} map[string]interface{} "로그인 성공"
 // @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Failure 401 {object} map[string]interface{} "인증 실패"
+// @Failure 503 {object} map[string]interface{} "OAuth 제공자 일시 사용 불가"
 // @Router /auth/oauth/{provider}/callback [get]
 func (h *AuthHandler) OAuthCallback(c *gin.Context, oauthManager auth.OAuthManager) {
 	var req OAuthCallbackRequest
@@ -371,6 +506,9 @@ func (h *AuthHandler) OAuthCallback(c *gin.Context, oauthManager auth.OAuthManag
 	// 인증 코드를 액세스 토큰으로 교환
 	token, err := oauthManager.ExchangeCode(req.Provider, req.Code, req.State)
 	if err != nil {
+		if oauthProviderUnavailable(c, err) {
+			return
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
@@ -378,13 +516,16 @@ func (h *AuthHandler) OAuthCallback(c *gin.Context, oauthManager auth.OAuthManag
 	// 사용자 정보 가져오기
 	userInfo, err := oauthManager.GetUserInfo(req.Provider, token)
 	if err != nil {
+		if oauthProviderUnavailable(c, err) {
+			return
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
 
 	// TODO: 실제 환경에서는 DB에서 사용자 매핑 또는 생성 필요
 	// 현재는 OAuth 정보로 내부 사용자 생성
-	userID := fmt.Sprintf("oauth_%s_%s", userInfo.Provider, userInfo.ID)
+	userID := fmt.Sprintf("%s%s_%s", oauthUserIDPrefix, userInfo.Provider, userInfo.ID)
 	userName := userInfo.Name
 	if userName == "" {
 		userName = userInfo.Email
@@ -435,6 +576,27 @@ func (h *AuthHandler) OAuthCallback(c *gin.Context, oauthManager auth.OAuthManag
 	})
 }
 
+// oauthProviderUnavailable OAuth 제공자 회로가 열려 있으면 503과 Retry-After로 응답합니다
+func oauthProviderUnavailable(c *gin.Context, err error) bool {
+	var openErr *breaker.OpenError
+	if !errors.As(err, &openErr) {
+		return false
+	}
+	retryAfter := int(openErr.RetryAfter.Round(time.Second) / time.Second)
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusServiceUnavailable, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "OAUTH_PROVIDER_UNAVAILABLE",
+			"message": "OAuth provider is temporarily unavailable",
+		},
+	})
+	return true
+}
+
 // generateSecureState 보안 state 파라미터 생성
 func (h *AuthHandler) generateSecureState() (string, error) {
 	b := make([]byte, 32)
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -9,10 +10,15 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/aicli/aicli-web/internal/auth"
 	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+	"github.com/aicli/aicli-web/internal/validation"
 )
 
 func setupTestRouter() (*gin.Engine, *AuthHandler) {
@@ -380,4 +386,110 @@ func TestUserRoles(t *testing.T) {
 		
 		assert.Equal(t, "user", claims.Role)
 	})
-}
\ No newline at end of file
+}
+
+func TestLoginWithLocalAccounts(t *testing.T) {
+	router, authHandler := setupTestRouter()
+
+	accounts := services.NewAccountService(memory.New().Account(), auth.NewPasswordHasher(auth.Argon2Params{Memory: 1024, Iterations: 1}), services.AccountConfig{
+		Policy:          validation.DefaultPasswordPolicy(),
+		MaxFailures:     2,
+		LockoutDuration: time.Minute,
+	})
+	account, err := accounts.Create(context.Background(), "alice", "alice@example.com", "Initial-Pass-1", "admin")
+	require.NoError(t, err)
+	authHandler.SetAccountService(accounts)
+
+	login := func(username, password string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(LoginRequest{Username: username, Password: password})
+		req := httptest.NewRequest("POST", "/api/v1/auth/login", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("개발용 고정 계정 사용 안 함", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, login("admin", "admin123").Code)
+	})
+
+	t.Run("로컬 계정 로그인", func(t *testing.T) {
+		w := login("alice", "Initial-Pass-1")
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		accessToken := response["data"].(map[string]interface{})["access_token"].(string)
+		claims, err := auth.NewJWTManager("test-secret-key-for-testing-only", 15*time.Minute, 7*24*time.Hour).VerifyToken(accessToken)
+		require.NoError(t, err)
+		assert.Equal(t, account.ID, claims.UserID)
+		assert.Equal(t, "admin", claims.Role)
+	})
+
+	t.Run("연속 실패 시 계정 잠금", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, login("alice", "wrong").Code)
+
+		w := login("alice", "wrong")
+		assert.Equal(t, http.StatusLocked, w.Code)
+		assert.NotEmpty(t, w.Header().Get("Retry-After"))
+		assert.Contains(t, w.Body.String(), "ACCOUNT_LOCKED")
+
+		assert.Equal(t, http.StatusLocked, login("alice", "Initial-Pass-1").Code)
+	})
+}
+
+func TestRefreshWithLocalAccounts(t *testing.T) {
+	router, authHandler := setupTestRouter()
+
+	accountStorage := memory.New().Account()
+	accounts := services.NewAccountService(accountStorage, auth.NewPasswordHasher(auth.Argon2Params{Memory: 1024, Iterations: 1}), services.AccountConfig{
+		Policy: validation.DefaultPasswordPolicy(),
+	})
+	account, err := accounts.Create(context.Background(), "alice", "alice@example.com", "Initial-Pass-1", "user")
+	require.NoError(t, err)
+	authHandler.SetAccountService(accounts)
+
+	// 계정을 만든 뒤 발급한 리프레시 토큰
+	claims := auth.NewClaims(account.ID, "alice", "alice@example.com", "user", time.Now().Add(time.Hour))
+	claims.IssuedAt = jwt.NewNumericDate(account.PasswordChangedAt.Add(time.Minute))
+	refreshToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-secret-key-for-testing-only"))
+	require.NoError(t, err)
+
+	refresh := func() *httptest.ResponseRecorder {
+		body, _ := json.Marshal(RefreshRequest{RefreshToken: refreshToken})
+		req := httptest.NewRequest("POST", "/api/v1/auth/refresh", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	update := func(fn func(*models.Account)) {
+		stored, err := accountStorage.GetByID(context.Background(), account.ID)
+		require.NoError(t, err)
+		fn(stored)
+		require.NoError(t, accountStorage.Update(context.Background(), stored))
+	}
+
+	assert.Equal(t, http.StatusOK, refresh().Code)
+
+	// 잠긴 계정
+	update(func(a *models.Account) {
+		until := time.Now().Add(time.Minute)
+		a.LockedUntil = &until
+	})
+	assert.Equal(t, http.StatusLocked, refresh().Code)
+	update(func(a *models.Account) { a.LockedUntil = nil })
+
+	// 비활성화된 계정
+	update(func(a *models.Account) { a.IsActive = false })
+	w := refresh()
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "ACCOUNT_DISABLED")
+	update(func(a *models.Account) { a.IsActive = true })
+
+	// 토큰 발급 후 비밀번호를 바꾼 계정
+	update(func(a *models.Account) { a.PasswordChangedAt = time.Now().Add(time.Hour) })
+	w = refresh()
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "TOKEN_BLACKLISTED")
+}
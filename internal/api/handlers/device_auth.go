@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+// DeviceVerificationPath 사용자가 디바이스 코드를 승인하는 웹 UI 경로
+const DeviceVerificationPath = "/device"
+
+// DeviceCodeRequest 디바이스 코드 발급 요청 구조체
+type DeviceCodeRequest struct {
+	ClientName string `json:"client_name"`
+	Scope      string `json:"scope"`
+}
+
+// DeviceCodeResponse 디바이스 코드 발급 응답 구조체
+type DeviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// DeviceTokenRequest 디바이스 토큰 요청 구조체
+type DeviceTokenRequest struct {
+	DeviceCode string `json:"device_code" binding:"required"`
+}
+
+// DeviceTokenResponse 디바이스 토큰 응답 구조체
+type DeviceTokenResponse struct {
+	LoginResponse
+	Scope string `json:"scope"`
+}
+
+// DeviceApproveRequest 디바이스 승인 요청 구조체
+type DeviceApproveRequest struct {
+	UserCode string `json:"user_code" binding:"required"`
+	Approve  bool   `json:"approve"`
+}
+
+// SetDeviceAuthorizer 디바이스 코드 로그인 흐름을 사용하도록 설정
+func (h *AuthHandler) SetDeviceAuthorizer(devices *auth.DeviceAuthorizer) {
+	h.devices = devices
+}
+
+// DeviceCode 디바이스 코드 발급
+// @Summary 디바이스 코드 발급
+// @Description CLI처럼 브라우저가 없는 클라이언트가 로그인을 시작합니다. 사용자가 verification_uri에서 user_code를 승인하면 토큰을 받을 수 있습니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body DeviceCodeRequest false "디바이스 코드 요청"
+// @Success 200 {object} map[string]interface{} "디바이스 코드"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Router /auth/device/code [post]
+func (h *AuthHandler) DeviceCode(c *gin.Context) {
+	var req DeviceCodeRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			deviceError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", err.Error())
+			return
+		}
+	}
+
+	authz, err := h.devices.Start(req.ClientName, req.Scope)
+	if err != nil {
+		deviceError(c, http.StatusBadRequest, "INVALID_SCOPE", "Invalid scope", err.Error())
+		return
+	}
+
+	verificationURI := requestBaseURL(c) + DeviceVerificationPath
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": DeviceCodeResponse{
+			DeviceCode:              authz.DeviceCode,
+			UserCode:                authz.UserCode,
+			VerificationURI:         verificationURI,
+			VerificationURIComplete: verificationURI + "?user_code=" + authz.UserCode,
+			ExpiresIn:               int(time.Until(authz.ExpiresAt).Seconds()),
+			Interval:                int(authz.Interval.Seconds()),
+		},
+	})
+}
+
+// DeviceToken 승인된 디바이스 코드로 토큰 발급
+// @Summary 디바이스 토큰 발급
+// @Description 클라이언트가 interval 간격으로 호출합니다. 승인 전에는 AUTHORIZATION_PENDING, 너무 자주 호출하면 SLOW_DOWN 오류를 반환합니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param body body DeviceTokenRequest true "디바이스 토큰 요청"
+// @Success 200 {object} map[string]interface{} "토큰 발급 성공"
+// @Failure 400 {object} map[string]interface{} "승인 대기, 거절, 만료"
+// @Router /auth/device/token [post]
+func (h *AuthHandler) DeviceToken(c *gin.Context) {
+	var req DeviceTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		deviceError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", err.Error())
+		return
+	}
+
+	authz, err := h.devices.Poll(req.DeviceCode)
+	switch {
+	case errors.Is(err, auth.ErrAuthorizationPending):
+		deviceError(c, http.StatusBadRequest, "AUTHORIZATION_PENDING", "User has not approved the request yet", "")
+		return
+	case errors.Is(err, auth.ErrSlowDown):
+		deviceError(c, http.StatusBadRequest, "SLOW_DOWN", "Polling too frequently", "")
+		return
+	case errors.Is(err, auth.ErrAccessDenied):
+		deviceError(c, http.StatusBadRequest, "ACCESS_DENIED", "User denied the request", "")
+		return
+	case errors.Is(err, auth.ErrExpiredToken), errors.Is(err, auth.ErrDeviceCodeNotFound):
+		deviceError(c, http.StatusBadRequest, "EXPIRED_TOKEN", "Device code is invalid or expired", "")
+		return
+	case err != nil:
+		deviceError(c, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to check device code", err.Error())
+		return
+	}
+
+	accessToken, err := h.jwtManager.GenerateScopedToken(authz.UserID, authz.UserName, authz.Email, authz.Role, authz.Scope, auth.AccessToken)
+	if err != nil {
+		deviceError(c, http.StatusInternalServerError, "TOKEN_GENERATION_ERROR", "Failed to generate access token", "")
+		return
+	}
+	refreshToken, err := h.jwtManager.GenerateScopedToken(authz.UserID, authz.UserName, authz.Email, authz.Role, authz.Scope, auth.RefreshToken)
+	if err != nil {
+		deviceError(c, http.StatusInternalServerError, "TOKEN_GENERATION_ERROR", "Failed to generate refresh token", "")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": DeviceTokenResponse{
+			LoginResponse: LoginResponse{
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
+				TokenType:    "Bearer",
+				ExpiresIn:    int(config.DefaultAccessTokenExpiry.Seconds()),
+			},
+			Scope: authz.Scope,
+		},
+	})
+}
+
+// GetDeviceAuthorization 승인 대기 중인 디바이스 요청 조회
+// @Summary 디바이스 승인 요청 조회
+// @Description 승인 화면에 보여줄 클라이언트 이름과 권한 범위를 조회합니다
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param user_code query string true "사용자 코드"
+// @Success 200 {object} map[string]interface{} "승인 요청"
+// @Failure 404 {object} map[string]interface{} "코드 없음 또는 만료"
+// @Router /auth/device [get]
+func (h *AuthHandler) GetDeviceAuthorization(c *gin.Context) {
+	authz, err := h.devices.Lookup(c.Query("user_code"))
+	if err != nil {
+		deviceError(c, http.StatusNotFound, "DEVICE_CODE_NOT_FOUND", "Code is invalid or expired", "")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    authz,
+	})
+}
+
+// ApproveDevice 디바이스 요청 승인 또는 거절
+// @Summary 디바이스 승인
+// @Description 로그인한 사용자가 CLI에 표시된 코드를 승인하면 클라이언트가 이 사용자의 토큰을 받습니다
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body DeviceApproveRequest true "디바이스 승인 요청"
+// @Success 200 {object} map[string]interface{} "처리 성공"
+// @Failure 404 {object} map[string]interface{} "코드 없음 또는 만료"
+// @Router /auth/device/approve [post]
+func (h *AuthHandler) ApproveDevice(c *gin.Context) {
+	var req DeviceApproveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		deviceError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body", err.Error())
+		return
+	}
+
+	var err error
+	if req.Approve {
+		value, _ := c.Get("claims")
+		claims, ok := value.(*auth.Claims)
+		if !ok {
+			deviceError(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required", "")
+			return
+		}
+		err = h.devices.Approve(req.UserCode, claims.UserID, claims.UserName, claims.Email, claims.Role)
+	} else {
+		err = h.devices.Deny(req.UserCode)
+	}
+	if err != nil {
+		deviceError(c, http.StatusNotFound, "DEVICE_CODE_NOT_FOUND", "Code is invalid or expired", "")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"user_code": auth.NormalizeUserCode(req.UserCode),
+			"approved":  req.Approve,
+		},
+	})
+}
+
+// deviceError 디바이스 흐름 오류 응답
+func deviceError(c *gin.Context, status int, code, message, details string) {
+	body := gin.H{
+		"code":    code,
+		"message": message,
+	}
+	if details != "" {
+		body["details"] = details
+	}
+	c.JSON(status, gin.H{
+		"success": false,
+		"error":   body,
+	})
+}
+
+// requestBaseURL 요청이 들어온 서버 주소 (리버스 프록시의 X-Forwarded-Proto 반영)
+func requestBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto == "https" || proto == "http" {
+		scheme = proto
+	}
+	return scheme + "://" + c.Request.Host
+}
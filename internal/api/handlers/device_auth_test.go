@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupDeviceRouter() (*gin.Engine, *auth.JWTManager) {
+	gin.SetMode(gin.TestMode)
+
+	jwtManager := auth.NewJWTManager("test-secret-key-for-testing-only", 15*time.Minute, 7*24*time.Hour)
+	blacklist := auth.NewBlacklist()
+	authHandler := NewAuthHandler(jwtManager, blacklist)
+	// 폴링 간격 검사에 걸리지 않도록 짧게 설정
+	authHandler.SetDeviceAuthorizer(auth.NewDeviceAuthorizer(time.Minute, time.Nanosecond))
+
+	router := gin.New()
+	device := router.Group("/api/v1/auth/device")
+	device.POST("/code", authHandler.DeviceCode)
+	device.POST("/token", authHandler.DeviceToken)
+	device.GET("", middleware.RequireAuth(jwtManager, blacklist), authHandler.GetDeviceAuthorization)
+	device.POST("/approve", middleware.RequireAuth(jwtManager, blacklist), authHandler.ApproveDevice)
+	return router, jwtManager
+}
+
+func deviceRequest(router *gin.Engine, method, path, token string, body interface{}) (*httptest.ResponseRecorder, map[string]interface{}) {
+	var buf bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&buf).Encode(body)
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Host = "aicli.example.com"
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var resp map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	return w, resp
+}
+
+func errorCode(resp map[string]interface{}) string {
+	errBody, _ := resp["error"].(map[string]interface{})
+	code, _ := errBody["code"].(string)
+	return code
+}
+
+func TestDeviceCodeFlow(t *testing.T) {
+	router, jwtManager := setupDeviceRouter()
+	userToken, err := jwtManager.GenerateToken("user-1", "alice", "alice@example.com", "admin", auth.AccessToken)
+	require.NoError(t, err)
+
+	// 코드 발급
+	w, resp := deviceRequest(router, http.MethodPost, "/api/v1/auth/device/code", "", DeviceCodeRequest{ClientName: "aicli", Scope: "read"})
+	require.Equal(t, http.StatusOK, w.Code)
+	data := resp["data"].(map[string]interface{})
+	deviceCode := data["device_code"].(string)
+	userCode := data["user_code"].(string)
+	assert.Equal(t, "http://aicli.example.com/device", data["verification_uri"])
+	assert.Equal(t, "http://aicli.example.com/device?user_code="+userCode, data["verification_uri_complete"])
+
+	// 승인 전
+	w, resp = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/token", "", DeviceTokenRequest{DeviceCode: deviceCode})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "AUTHORIZATION_PENDING", errorCode(resp))
+
+	// 승인 화면 조회와 승인 (로그인 필요)
+	w, _ = deviceRequest(router, http.MethodGet, "/api/v1/auth/device?user_code="+userCode, "", nil)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	w, resp = deviceRequest(router, http.MethodGet, "/api/v1/auth/device?user_code="+userCode, userToken, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "aicli", resp["data"].(map[string]interface{})["client_name"])
+
+	w, _ = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/approve", userToken, DeviceApproveRequest{UserCode: userCode, Approve: true})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// 권한 범위가 제한된 토큰 발급
+	w, resp = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/token", "", DeviceTokenRequest{DeviceCode: deviceCode})
+	require.Equal(t, http.StatusOK, w.Code)
+	data = resp["data"].(map[string]interface{})
+	assert.Equal(t, "read", data["scope"])
+	claims, err := jwtManager.VerifyToken(data["access_token"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+	assert.Equal(t, "read", claims.Scope)
+
+	// 리프레시해도 범위 유지
+	refreshed, err := jwtManager.RefreshAccessToken(data["refresh_token"].(string))
+	require.NoError(t, err)
+	claims, err = jwtManager.VerifyToken(refreshed)
+	require.NoError(t, err)
+	assert.Equal(t, "read", claims.Scope)
+
+	// 읽기 전용 토큰으로는 변경 요청 불가
+	w, resp = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/approve", data["access_token"].(string), DeviceApproveRequest{UserCode: userCode, Approve: true})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "INSUFFICIENT_SCOPE", errorCode(resp))
+
+	// 토큰은 한 번만 발급
+	w, resp = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/token", "", DeviceTokenRequest{DeviceCode: deviceCode})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "EXPIRED_TOKEN", errorCode(resp))
+}
+
+func TestDeviceCodeFlow_Denied(t *testing.T) {
+	router, jwtManager := setupDeviceRouter()
+	userToken, err := jwtManager.GenerateToken("user-1", "alice", "", "user", auth.AccessToken)
+	require.NoError(t, err)
+
+	w, resp := deviceRequest(router, http.MethodPost, "/api/v1/auth/device/code", "", DeviceCodeRequest{Scope: "admin"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "INVALID_SCOPE", errorCode(resp))
+
+	w, resp = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/code", "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	data := resp["data"].(map[string]interface{})
+
+	w, _ = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/approve", userToken, DeviceApproveRequest{UserCode: data["user_code"].(string)})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w, resp = deviceRequest(router, http.MethodPost, "/api/v1/auth/device/token", "", DeviceTokenRequest{DeviceCode: data["device_code"].(string)})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "ACCESS_DENIED", errorCode(resp))
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/pkg/version"
 )
 
@@ -120,4 +121,32 @@ func GetSystemStatus(c *gin.Context) {
 			"uptime":    time.Since(startTime).String(),
 		},
 	})
-}
\ No newline at end of file
+}
+
+// SystemStatusWithMaintenance는 유지보수 모드 배너 정보를 포함한 시스템 상태 핸들러를 반환합니다.
+// @Summary 시스템 상태 확인
+// @Description API 서버 상태와 유지보수 모드 여부(프론트엔드 배너 표시용)를 조회합니다
+// @Tags system
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{} "시스템 상태"
+// @Router /system/status [get]
+func SystemStatusWithMaintenance(maintenance func() models.MaintenanceStatus) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		status := "running"
+		banner := maintenance()
+		if banner.Enabled {
+			status = "maintenance"
+		}
+		
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data": gin.H{
+				"status":      status,
+				"timestamp":   time.Now().Format(time.RFC3339),
+				"uptime":      time.Since(startTime).String(),
+				"maintenance": banner,
+			},
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package websocket
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// SlowConsumerPolicy는 전송 큐가 가득 찬 연결(느린 소비자)을 처리하는 방식입니다
+type SlowConsumerPolicy string
+
+const (
+	// SlowConsumerDrop 메시지를 버리고 연결은 유지 (유실이 MaxDroppedMessages에 도달하면 종료)
+	SlowConsumerDrop SlowConsumerPolicy = "drop"
+	// SlowConsumerDisconnect 연결을 종료
+	SlowConsumerDisconnect SlowConsumerPolicy = "disconnect"
+)
+
+// closeSlowConsumer 느린 소비자 종료 코드 (internal/websocket의 CloseSlowConsumer와 동일)
+const closeSlowConsumer = 4003
+
+// broadcastJob은 한 샤드가 전달할 메시지와 대상 연결입니다
+type broadcastJob struct {
+	message []byte
+	targets []*ManagedConnection
+}
+
+// shardFor는 연결 ID로 브로드캐스트 샤드를 결정합니다
+func (cm *ConnectionManager) shardFor(connectionID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(connectionID))
+	return int(h.Sum32() % uint32(len(cm.shards)))
+}
+
+// broadcast는 메시지를 한 번만 직렬화해 대상 연결을 샤드별로 나눠 워커에 넘깁니다.
+// 샤드 큐가 가득 차면 호출자가 대기하므로 생산자에게 배압이 전달됩니다.
+func (cm *ConnectionManager) broadcast(targets []*ManagedConnection, message WebSocketMessage) {
+	if len(targets) == 0 {
+		return
+	}
+
+	messageBytes, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+
+	buckets := make([][]*ManagedConnection, len(cm.shards))
+	for _, managed := range targets {
+		buckets[managed.shard] = append(buckets[managed.shard], managed)
+	}
+
+	for i, bucket := range buckets {
+		if len(bucket) == 0 {
+			continue
+		}
+		select {
+		case cm.shards[i] <- broadcastJob{message: messageBytes, targets: bucket}:
+		case <-cm.ctx.Done():
+			return
+		}
+	}
+}
+
+// broadcastWorker는 한 샤드의 작업을 순서대로 전달합니다
+func (cm *ConnectionManager) broadcastWorker(jobs <-chan broadcastJob) {
+	defer cm.wg.Done()
+
+	for {
+		select {
+		case <-cm.ctx.Done():
+			return
+		case job := <-jobs:
+			for _, managed := range job.targets {
+				cm.deliver(managed, job.message)
+			}
+		}
+	}
+}
+
+// deliver는 연결의 전송 큐에 메시지를 넣습니다.
+// 큐가 가득 차면 SlowConsumerTimeout 동안만 기다리며, 이미 지연 중인 연결은 기다리지 않아
+// 느린 연결 하나가 같은 샤드의 다른 연결을 붙잡지 않습니다.
+func (cm *ConnectionManager) deliver(managed *ManagedConnection, message []byte) {
+	if managed.ctx.Err() != nil || managed.slowClosing.Load() {
+		return
+	}
+
+	select {
+	case managed.sendChan <- message:
+		managed.lagging.Store(false)
+		return
+	default:
+	}
+
+	if !managed.lagging.Load() && cm.config.SlowConsumerTimeout > 0 {
+		timer := time.NewTimer(cm.config.SlowConsumerTimeout)
+		select {
+		case managed.sendChan <- message:
+			timer.Stop()
+			return
+		case <-managed.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	managed.lagging.Store(true)
+	cm.handleSlowConsumer(managed)
+}
+
+// handleSlowConsumer는 전달하지 못한 메시지를 기록하고 정책에 따라 연결을 종료합니다
+func (cm *ConnectionManager) handleSlowConsumer(managed *ManagedConnection) {
+	dropped := atomic.AddInt64(&managed.metrics.DroppedMessages, 1)
+	atomic.AddInt64(&cm.stats.DroppedMessages, 1)
+
+	if cm.config.SlowConsumerPolicy == SlowConsumerDrop &&
+		(cm.config.MaxDroppedMessages <= 0 || dropped < cm.config.MaxDroppedMessages) {
+		return
+	}
+
+	if managed.slowClosing.CompareAndSwap(false, true) {
+		atomic.AddInt64(&cm.stats.SlowConsumerDisconnects, 1)
+		// 종료 프레임 전송이 샤드 워커를 막지 않도록 별도 고루틴에서 처리
+		go cm.disconnectSlowConsumer(managed)
+	}
+}
+
+// disconnectSlowConsumer는 종료 코드를 보낸 뒤 연결을 해제합니다
+func (cm *ConnectionManager) disconnectSlowConsumer(managed *ManagedConnection) {
+	deadline := time.Now().Add(time.Second)
+	closeMsg := websocket.FormatCloseMessage(closeSlowConsumer, "slow consumer")
+	managed.Conn.WriteControl(websocket.CloseMessage, closeMsg, deadline)
+
+	cm.UnregisterConnection(managed.ID)
+	managed.forceClose()
+}
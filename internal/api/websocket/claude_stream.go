@@ -21,6 +21,7 @@ type ClaudeStreamHandler struct {
 	connectionsMutex sync.RWMutex
 	messageRouter    *MessageRouter
 	authValidator    *auth.Validator
+	shareLinks       ShareLinkService
 	upgrader         websocket.Upgrader
 	
 	// 설정
@@ -32,6 +33,8 @@ type ConnectionGroup struct {
 	SessionID     string                    `json:"session_id"`
 	Connections   map[string]*ClientConnection `json:"connections"`
 	Permissions   map[string]Permission     `json:"permissions"`
+	DriverID      string                    `json:"driver_id"`
+	Cursors       map[string]CursorPosition `json:"cursors"`
 	CreatedAt     time.Time                 `json:"created_at"`
 	LastActivity  time.Time                 `json:"last_activity"`
 	mutex         sync.RWMutex
@@ -47,6 +50,7 @@ type ClientConnection struct {
 	ConnectedAt time.Time       `json:"connected_at"`
 	LastSeen    time.Time       `json:"last_seen"`
 	IsActive    bool            `json:"is_active"`
+	ShareLinkID string          `json:"share_link_id,omitempty"` // 공유 링크로 참여한 경우 링크 ID
 	
 	// 채널들
 	sendChan    chan []byte
@@ -178,7 +182,6 @@ func (h *ClaudeStreamHandler) HandleWebSocket(w http.ResponseWriter, r *http.Req
 // ConnectSession은 세션을 WebSocket 연결 그룹에 연결합니다
 func (h *ClaudeStreamHandler) ConnectSession(sessionID string, clientConn *ClientConnection) error {
 	h.connectionsMutex.Lock()
-	defer h.connectionsMutex.Unlock()
 
 	// 연결 그룹 찾기 또는 생성
 	group, exists := h.connections[sessionID]
@@ -187,18 +190,24 @@ func (h *ClaudeStreamHandler) ConnectSession(sessionID string, clientConn *Clien
 			SessionID:    sessionID,
 			Connections:  make(map[string]*ClientConnection),
 			Permissions:  make(map[string]Permission),
+			Cursors:      make(map[string]CursorPosition),
 			CreatedAt:    time.Now(),
 			LastActivity: time.Now(),
 		}
 		h.connections[sessionID] = group
 	}
 
-	// 연결 추가
+	// 연결 추가 (드라이버가 없으면 쓰기 권한이 있는 첫 참여자가 드라이버)
 	group.mutex.Lock()
 	group.Connections[clientConn.ID] = clientConn
 	group.Permissions[clientConn.UserID] = clientConn.Permission
+	if group.DriverID == "" && clientConn.Permission >= PermissionWrite {
+		group.DriverID = clientConn.UserID
+	}
+	role := group.roleOf(clientConn.UserID)
 	group.LastActivity = time.Now()
 	group.mutex.Unlock()
+	h.connectionsMutex.Unlock()
 
 	// 세션 참여 이벤트 전송
 	h.broadcastSessionEvent(sessionID, SessionEvent{
@@ -207,7 +216,11 @@ func (h *ClaudeStreamHandler) ConnectSession(sessionID string, clientConn *Clien
 		UserID:    clientConn.UserID,
 		UserName:  clientConn.UserName,
 		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"role": role,
+		},
 	})
+	h.broadcastPresence(sessionID)
 
 	return nil
 }
@@ -224,6 +237,7 @@ func (h *ClaudeStreamHandler) StreamToWebSocket(sessionID string, messages <-cha
 					"content":      message.Content,
 					"message_id":   message.ID,
 					"meta":         message.Meta,
+					"rendered":     claude.RenderedLines(message.Type, message.Content, message.Meta),
 				},
 				Timestamp: time.Now(),
 			})
@@ -240,6 +254,11 @@ func (h *ClaudeStreamHandler) ForwardToSession(sessionID string, userID string,
 		return fmt.Errorf("insufficient permissions")
 	}
 
+	// 입력 중재: 드라이버만 세션에 입력 가능
+	if !h.isDriver(sessionID, userID) {
+		return ErrNotDriver
+	}
+
 	// Claude 세션에 메시지 전송 (실제 구현 필요)
 	// 여기서는 시뮬레이션
 	h.broadcastToSession(sessionID, WebSocketMessage{
@@ -267,11 +286,16 @@ func (h *ClaudeStreamHandler) GetActiveConnections() map[string]*ConnectionGroup
 			SessionID:    group.SessionID,
 			Connections:  make(map[string]*ClientConnection),
 			Permissions:  make(map[string]Permission),
+			Cursors:      make(map[string]CursorPosition),
 			CreatedAt:    group.CreatedAt,
 			LastActivity: group.LastActivity,
 		}
 
 		group.mutex.RLock()
+		groupCopy.DriverID = group.DriverID
+		for userID, cursor := range group.Cursors {
+			groupCopy.Cursors[userID] = cursor
+		}
 		for connID, conn := range group.Connections {
 			if conn.IsActive {
 				groupCopy.Connections[connID] = conn
@@ -300,16 +324,15 @@ func (h *ClaudeStreamHandler) CloseConnection(sessionID string, connectionID str
 		return fmt.Errorf("session not found: %s", sessionID)
 	}
 
-	group.mutex.Lock()
-	defer group.mutex.Unlock()
-
+	group.mutex.RLock()
 	conn, exists := group.Connections[connectionID]
+	group.mutex.RUnlock()
 	if !exists {
 		return fmt.Errorf("connection not found: %s", connectionID)
 	}
 
+	// 연결 종료 시 모든 세션에서 제거되고 드라이버 역할이 정리됨
 	h.closeClientConnection(conn)
-	delete(group.Connections, connectionID)
 
 	return nil
 }
@@ -422,19 +445,23 @@ func (h *ClaudeStreamHandler) broadcastToSession(sessionID string, message WebSo
 		return
 	}
 
+	var stalled []*ClientConnection
 	group.mutex.RLock()
-	defer group.mutex.RUnlock()
-
 	for _, conn := range group.Connections {
 		if conn.IsActive {
 			select {
 			case conn.sendChan <- messageBytes:
 			default:
-				// 버퍼가 가득 찬 경우 연결 종료
-				h.closeClientConnection(conn)
+				stalled = append(stalled, conn)
 			}
 		}
 	}
+	group.mutex.RUnlock()
+
+	// 버퍼가 가득 찬 연결은 잠금 해제 후 종료
+	for _, conn := range stalled {
+		h.closeClientConnection(conn)
+	}
 }
 
 func (h *ClaudeStreamHandler) broadcastSessionEvent(sessionID string, event SessionEvent) {
@@ -458,29 +485,41 @@ func (h *ClaudeStreamHandler) closeClientConnection(clientConn *ClientConnection
 	clientConn.IsActive = false
 	clientConn.cancel()
 	close(clientConn.closeChan)
-	clientConn.Conn.Close()
+	if clientConn.Conn != nil {
+		clientConn.Conn.Close()
+	}
 
 	// 세션에서 연결 제거
 	h.removeConnectionFromSessions(clientConn)
 }
 
 func (h *ClaudeStreamHandler) removeConnectionFromSessions(clientConn *ClientConnection) {
-	h.connectionsMutex.Lock()
-	defer h.connectionsMutex.Unlock()
+	// 드라이버 변경 정보 (세션 ID -> 새 드라이버)
+	type driverChange struct {
+		sessionID string
+		driverID  string
+	}
+	var (
+		left    []string
+		changes []driverChange
+	)
 
+	h.connectionsMutex.Lock()
 	for sessionID, group := range h.connections {
 		group.mutex.Lock()
 		if _, exists := group.Connections[clientConn.ID]; exists {
 			delete(group.Connections, clientConn.ID)
-			
-			// 세션에서 사용자 퇴장 이벤트 전송
-			h.broadcastSessionEvent(sessionID, SessionEvent{
-				Type:      "user_left",
-				SessionID: sessionID,
-				UserID:    clientConn.UserID,
-				UserName:  clientConn.UserName,
-				Timestamp: time.Now(),
-			})
+			left = append(left, sessionID)
+
+			// 같은 사용자의 다른 연결이 없으면 역할과 커서 정리
+			if !group.hasActiveUser(clientConn.UserID) {
+				delete(group.Permissions, clientConn.UserID)
+				delete(group.Cursors, clientConn.UserID)
+				if group.DriverID == clientConn.UserID {
+					group.DriverID = group.nextDriver()
+					changes = append(changes, driverChange{sessionID: sessionID, driverID: group.DriverID})
+				}
+			}
 
 			// 연결이 없으면 그룹 제거
 			if len(group.Connections) == 0 {
@@ -489,6 +528,21 @@ func (h *ClaudeStreamHandler) removeConnectionFromSessions(clientConn *ClientCon
 		}
 		group.mutex.Unlock()
 	}
+	h.connectionsMutex.Unlock()
+
+	// 세션에서 사용자 퇴장 이벤트 전송 (브로드캐스트는 잠금 해제 후)
+	for _, sessionID := range left {
+		h.broadcastSessionEvent(sessionID, SessionEvent{
+			Type:      "user_left",
+			SessionID: sessionID,
+			UserID:    clientConn.UserID,
+			UserName:  clientConn.UserName,
+			Timestamp: time.Now(),
+		})
+	}
+	for _, change := range changes {
+		h.broadcastDriverChanged(change.sessionID, clientConn.UserID, change.driverID, clientConn.UserID)
+	}
 }
 
 func (h *ClaudeStreamHandler) determinePermission(userInfo *auth.UserInfo) Permission {
@@ -541,14 +595,19 @@ func (h *ClaudeStreamHandler) GetSessionUsers(sessionID string) []SessionUser {
 	userMap := make(map[string]SessionUser)
 	for _, conn := range group.Connections {
 		if conn.IsActive {
-			userMap[conn.UserID] = SessionUser{
+			user := SessionUser{
 				UserID:      conn.UserID,
 				UserName:    conn.UserName,
 				Permission:  conn.Permission,
+				Role:        group.roleOf(conn.UserID),
 				ConnectedAt: conn.ConnectedAt,
 				LastSeen:    conn.LastSeen,
 				IsActive:    conn.IsActive,
 			}
+			if cursor, ok := group.Cursors[conn.UserID]; ok {
+				user.Cursor = &cursor
+			}
+			userMap[conn.UserID] = user
 		}
 	}
 
@@ -562,10 +621,12 @@ func (h *ClaudeStreamHandler) GetSessionUsers(sessionID string) []SessionUser {
 
 // SessionUser는 세션 참여 사용자 정보입니다
 type SessionUser struct {
-	UserID      string     `json:"user_id"`
-	UserName    string     `json:"user_name"`
-	Permission  Permission `json:"permission"`
-	ConnectedAt time.Time  `json:"connected_at"`
-	LastSeen    time.Time  `json:"last_seen"`
-	IsActive    bool       `json:"is_active"`
+	UserID      string            `json:"user_id"`
+	UserName    string            `json:"user_name"`
+	Permission  Permission        `json:"permission"`
+	Role        CollaborationRole `json:"role"`
+	Cursor      *CursorPosition   `json:"cursor,omitempty"`
+	ConnectedAt time.Time         `json:"connected_at"`
+	LastSeen    time.Time         `json:"last_seen"`
+	IsActive    bool              `json:"is_active"`
 }
\ No newline at end of file
@@ -0,0 +1,68 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	claudetest "github.com/aicli/aicli-web/internal/claude/testing"
+)
+
+func TestClaudeStreamHandler_ReplayRecordedSession(t *testing.T) {
+	lines := claudetest.NewTranscript().
+		Text("m1", "hello").
+		Text("m2", "world").
+		Result("claude-3-sonnet", 3, 4).
+		Lines()
+
+	h := NewClaudeStreamHandler(nil, nil, DefaultClaudeStreamConfig())
+	client := newTestClient("c1", "alice", PermissionWrite, time.Now())
+	require.NoError(t, h.ConnectSession("s1", client))
+	drainClient(client)
+
+	// 녹화된 CLI 출력을 파싱해 WebSocket으로 전달
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	parser := claude.NewClaudeRunner().NewStreamParser(claude.NewReplayReader(lines, false), logrus.New())
+	responses, _ := parser.ParseStream(ctx)
+
+	messages := make(chan claude.Message)
+	require.NoError(t, h.StreamToWebSocket("s1", messages))
+	go func() {
+		defer close(messages)
+		for resp := range responses {
+			messages <- claude.Message{ID: resp.MessageID, Type: resp.Type, Content: resp.Content, Meta: resp.Metadata}
+		}
+	}()
+
+	var got []string
+	for len(got) < len(lines) {
+		select {
+		case raw := <-client.sendChan:
+			var msg WebSocketMessage
+			require.NoError(t, json.Unmarshal(raw, &msg))
+			require.Equal(t, "claude_message", msg.Type)
+			got = append(got, msg.Data["message_type"].(string)+":"+msg.Data["content"].(string))
+		case <-ctx.Done():
+			t.Fatalf("timed out after %d messages", len(got))
+		}
+	}
+	assert.Equal(t, []string{"text:hello", "text:world", "result:"}, got)
+}
+
+// drainClient 클라이언트 버퍼에 쌓인 메시지를 비웁니다
+func drainClient(client *ClientConnection) {
+	for {
+		select {
+		case <-client.sendChan:
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CollaborationRole은 공유 세션에서의 참여 역할입니다
+type CollaborationRole string
+
+const (
+	// RoleDriver는 세션에 입력을 보낼 수 있는 참여자입니다 (세션당 한 명)
+	RoleDriver CollaborationRole = "driver"
+	// RoleObserver는 세션 출력과 다른 참여자의 활동만 볼 수 있는 참여자입니다
+	RoleObserver CollaborationRole = "observer"
+)
+
+var (
+	// ErrNotDriver는 드라이버가 아닌 참여자가 입력을 보낼 때 반환됩니다
+	ErrNotDriver = errors.New("only the session driver can send input")
+	// ErrNotParticipant는 세션에 연결되지 않은 사용자를 대상으로 할 때 반환됩니다
+	ErrNotParticipant = errors.New("user is not connected to the session")
+)
+
+// CursorPosition은 참여자의 편집기 커서 위치입니다
+type CursorPosition struct {
+	File      string    `json:"file,omitempty"`
+	Line      int       `json:"line"`
+	Column    int       `json:"column"`
+	EndLine   int       `json:"end_line,omitempty"` // 선택 영역 끝 (선택이 없으면 0)
+	EndColumn int       `json:"end_column,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetDriver는 세션의 현재 드라이버 사용자 ID를 반환합니다 (없으면 빈 문자열)
+func (h *ClaudeStreamHandler) GetDriver(sessionID string) string {
+	group := h.getGroup(sessionID)
+	if group == nil {
+		return ""
+	}
+
+	group.mutex.RLock()
+	defer group.mutex.RUnlock()
+	return group.DriverID
+}
+
+// HandoffDriver는 드라이버 역할을 다른 참여자에게 넘깁니다
+// 현재 드라이버 또는 세션 관리자 권한을 가진 사용자만 넘길 수 있으며, 대상은 쓰기 권한으로 연결되어 있어야 합니다.
+func (h *ClaudeStreamHandler) HandoffDriver(sessionID, requesterID, targetUserID string) error {
+	group := h.getGroup(sessionID)
+	if group == nil {
+		return fmt.Errorf("session not found: %s", sessionID)
+	}
+
+	group.mutex.Lock()
+	if group.DriverID != requesterID && group.Permissions[requesterID] < PermissionAdmin {
+		group.mutex.Unlock()
+		return ErrNotDriver
+	}
+	if !group.hasActiveUser(targetUserID) {
+		group.mutex.Unlock()
+		return ErrNotParticipant
+	}
+	if group.Permissions[targetUserID] < PermissionWrite {
+		group.mutex.Unlock()
+		return fmt.Errorf("user %s does not have write permission", targetUserID)
+	}
+	previous := group.DriverID
+	group.DriverID = targetUserID
+	group.LastActivity = time.Now()
+	group.mutex.Unlock()
+
+	if previous != targetUserID {
+		h.broadcastDriverChanged(sessionID, previous, targetUserID, requesterID)
+	}
+	return nil
+}
+
+// RequestControl은 드라이버 역할을 요청합니다
+// 드라이버가 없으면 바로 드라이버가 되고, 있으면 현재 드라이버에게 요청 이벤트를 전달합니다.
+func (h *ClaudeStreamHandler) RequestControl(sessionID, userID string) (bool, error) {
+	group := h.getGroup(sessionID)
+	if group == nil {
+		return false, fmt.Errorf("session not found: %s", sessionID)
+	}
+
+	group.mutex.Lock()
+	if !group.hasActiveUser(userID) {
+		group.mutex.Unlock()
+		return false, ErrNotParticipant
+	}
+	if group.Permissions[userID] < PermissionWrite {
+		group.mutex.Unlock()
+		return false, fmt.Errorf("write permission required")
+	}
+	if group.DriverID == userID {
+		group.mutex.Unlock()
+		return true, nil
+	}
+	granted := group.DriverID == ""
+	if granted {
+		group.DriverID = userID
+	}
+	driverID := group.DriverID
+	group.mutex.Unlock()
+
+	if granted {
+		h.broadcastDriverChanged(sessionID, "", userID, userID)
+		return true, nil
+	}
+
+	h.broadcastSessionEvent(sessionID, SessionEvent{
+		Type:      "control_requested",
+		SessionID: sessionID,
+		UserID:    userID,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"driver_id": driverID,
+		},
+	})
+	return false, nil
+}
+
+// UpdateCursor는 참여자의 커서 위치를 갱신하고 세션에 알립니다
+func (h *ClaudeStreamHandler) UpdateCursor(sessionID, userID string, cursor CursorPosition) error {
+	group := h.getGroup(sessionID)
+	if group == nil {
+		return fmt.Errorf("session not found: %s", sessionID)
+	}
+
+	cursor.UpdatedAt = time.Now()
+
+	group.mutex.Lock()
+	if !group.hasActiveUser(userID) {
+		group.mutex.Unlock()
+		return ErrNotParticipant
+	}
+	group.Cursors[userID] = cursor
+	group.mutex.Unlock()
+
+	h.broadcastSessionEvent(sessionID, SessionEvent{
+		Type:      "cursor_moved",
+		SessionID: sessionID,
+		UserID:    userID,
+		Timestamp: cursor.UpdatedAt,
+		Data:      cursor,
+	})
+	return nil
+}
+
+// isDriver는 사용자가 세션의 드라이버인지 확인합니다
+func (h *ClaudeStreamHandler) isDriver(sessionID, userID string) bool {
+	return userID != "" && h.GetDriver(sessionID) == userID
+}
+
+// getGroup은 세션의 연결 그룹을 반환합니다
+func (h *ClaudeStreamHandler) getGroup(sessionID string) *ConnectionGroup {
+	h.connectionsMutex.RLock()
+	defer h.connectionsMutex.RUnlock()
+	return h.connections[sessionID]
+}
+
+// broadcastDriverChanged는 드라이버 변경과 갱신된 참여자 목록을 알립니다
+func (h *ClaudeStreamHandler) broadcastDriverChanged(sessionID, previous, driver, changedBy string) {
+	h.broadcastSessionEvent(sessionID, SessionEvent{
+		Type:      "driver_changed",
+		SessionID: sessionID,
+		UserID:    changedBy,
+		Timestamp: time.Now(),
+		Data: map[string]interface{}{
+			"previous_driver_id": previous,
+			"driver_id":          driver,
+		},
+	})
+	h.broadcastPresence(sessionID)
+}
+
+// broadcastPresence는 세션 참여자 목록(역할, 커서 포함)을 알립니다
+func (h *ClaudeStreamHandler) broadcastPresence(sessionID string) {
+	h.broadcastSessionEvent(sessionID, SessionEvent{
+		Type:      "presence",
+		SessionID: sessionID,
+		Timestamp: time.Now(),
+		Data:      h.GetSessionUsers(sessionID),
+	})
+}
+
+// hasActiveUser는 사용자의 활성 연결이 있는지 확인합니다 (호출자가 잠금 보유)
+func (g *ConnectionGroup) hasActiveUser(userID string) bool {
+	for _, conn := range g.Connections {
+		if conn.UserID == userID && conn.IsActive {
+			return true
+		}
+	}
+	return false
+}
+
+// nextDriver는 가장 먼저 연결한 쓰기 권한 참여자를 반환합니다 (호출자가 잠금 보유)
+func (g *ConnectionGroup) nextDriver() string {
+	var (
+		next      string
+		connected time.Time
+	)
+	for _, conn := range g.Connections {
+		if !conn.IsActive || g.Permissions[conn.UserID] < PermissionWrite {
+			continue
+		}
+		if next == "" || conn.ConnectedAt.Before(connected) {
+			next = conn.UserID
+			connected = conn.ConnectedAt
+		}
+	}
+	return next
+}
+
+// roleOf는 사용자의 역할을 반환합니다 (호출자가 잠금 보유)
+func (g *ConnectionGroup) roleOf(userID string) CollaborationRole {
+	if g.DriverID == userID {
+		return RoleDriver
+	}
+	return RoleObserver
+}
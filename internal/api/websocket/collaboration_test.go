@@ -0,0 +1,124 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient 실제 소켓 없이 메시지를 버퍼에 쌓는 클라이언트 연결
+func newTestClient(id, userID string, permission Permission, connectedAt time.Time) *ClientConnection {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ClientConnection{
+		ID:          id,
+		UserID:      userID,
+		UserName:    userID,
+		Permission:  permission,
+		ConnectedAt: connectedAt,
+		IsActive:    true,
+		sendChan:    make(chan []byte, 64),
+		closeChan:   make(chan struct{}),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+// sessionEventTypes 클라이언트가 받은 세션 이벤트 타입 목록
+func sessionEventTypes(t *testing.T, client *ClientConnection) []string {
+	var types []string
+	for {
+		select {
+		case raw := <-client.sendChan:
+			var msg struct {
+				Data struct {
+					Event SessionEvent `json:"event"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(raw, &msg))
+			types = append(types, msg.Data.Event.Type)
+		default:
+			return types
+		}
+	}
+}
+
+func TestClaudeStreamHandler_DriverArbitration(t *testing.T) {
+	h := NewClaudeStreamHandler(nil, nil, DefaultClaudeStreamConfig())
+	now := time.Now()
+
+	viewer := newTestClient("c1", "viewer", PermissionRead, now)
+	alice := newTestClient("c2", "alice", PermissionWrite, now.Add(time.Second))
+	bob := newTestClient("c3", "bob", PermissionWrite, now.Add(2*time.Second))
+
+	// 읽기 권한만 있는 사용자는 드라이버가 되지 않음
+	require.NoError(t, h.ConnectSession("s1", viewer))
+	assert.Empty(t, h.GetDriver("s1"))
+
+	require.NoError(t, h.ConnectSession("s1", alice))
+	require.NoError(t, h.ConnectSession("s1", bob))
+	assert.Equal(t, "alice", h.GetDriver("s1"))
+
+	// 드라이버만 입력 가능
+	assert.NoError(t, h.ForwardToSession("s1", "alice", "hello"))
+	assert.ErrorIs(t, h.ForwardToSession("s1", "bob", "hello"), ErrNotDriver)
+
+	// 관전자의 제어 요청은 드라이버에게 전달만 됨
+	sessionEventTypes(t, alice)
+	granted, err := h.RequestControl("s1", "bob")
+	require.NoError(t, err)
+	assert.False(t, granted)
+	assert.Contains(t, sessionEventTypes(t, alice), "control_requested")
+
+	// 드라이버가 아닌 사용자는 역할을 넘길 수 없음
+	assert.ErrorIs(t, h.HandoffDriver("s1", "bob", "bob"), ErrNotDriver)
+	assert.Error(t, h.HandoffDriver("s1", "alice", "viewer"))
+	assert.ErrorIs(t, h.HandoffDriver("s1", "alice", "nobody"), ErrNotParticipant)
+
+	require.NoError(t, h.HandoffDriver("s1", "alice", "bob"))
+	assert.Equal(t, "bob", h.GetDriver("s1"))
+	assert.Contains(t, sessionEventTypes(t, viewer), "driver_changed")
+
+	roles := map[string]CollaborationRole{}
+	for _, user := range h.GetSessionUsers("s1") {
+		roles[user.UserID] = user.Role
+	}
+	assert.Equal(t, RoleDriver, roles["bob"])
+	assert.Equal(t, RoleObserver, roles["alice"])
+	assert.Equal(t, RoleObserver, roles["viewer"])
+}
+
+func TestClaudeStreamHandler_DriverLeavesAndCursors(t *testing.T) {
+	h := NewClaudeStreamHandler(nil, nil, DefaultClaudeStreamConfig())
+	now := time.Now()
+
+	alice := newTestClient("c1", "alice", PermissionWrite, now)
+	viewer := newTestClient("c2", "viewer", PermissionRead, now.Add(time.Second))
+	bob := newTestClient("c3", "bob", PermissionWrite, now.Add(2*time.Second))
+	for _, client := range []*ClientConnection{alice, viewer, bob} {
+		require.NoError(t, h.ConnectSession("s1", client))
+	}
+
+	require.NoError(t, h.UpdateCursor("s1", "viewer", CursorPosition{File: "main.go", Line: 10, Column: 4}))
+	assert.ErrorIs(t, h.UpdateCursor("s1", "nobody", CursorPosition{Line: 1}), ErrNotParticipant)
+	assert.Contains(t, sessionEventTypes(t, bob), "cursor_moved")
+
+	for _, user := range h.GetSessionUsers("s1") {
+		if user.UserID == "viewer" {
+			require.NotNil(t, user.Cursor)
+			assert.Equal(t, "main.go", user.Cursor.File)
+		}
+	}
+
+	// 드라이버가 나가면 쓰기 권한이 있는 다음 참여자가 드라이버
+	require.NoError(t, h.CloseConnection("s1", "c1"))
+	assert.Equal(t, "bob", h.GetDriver("s1"))
+	assert.Contains(t, sessionEventTypes(t, viewer), "driver_changed")
+
+	// 쓰기 권한 참여자가 없으면 드라이버 없음
+	require.NoError(t, h.CloseConnection("s1", "c3"))
+	assert.Empty(t, h.GetDriver("s1"))
+}
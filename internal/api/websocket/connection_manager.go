@@ -36,6 +36,9 @@ type ConnectionManager struct {
 	// 이벤트 채널
 	eventChan chan ConnectionEvent
 	
+	// 샤드별 브로드캐스트 작업 큐 (연결 ID 해시로 샤드 결정)
+	shards []chan broadcastJob
+	
 	// 생명주기
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -64,6 +67,11 @@ type ManagedConnection struct {
 	// 구독
 	subscriptions map[string]bool
 	subMutex      sync.RWMutex
+	
+	// 브로드캐스트 배압
+	shard       int         // 전달을 담당하는 브로드캐스트 샤드
+	lagging     atomic.Bool // 전송 큐가 가득 차 있는 상태 (대기 없이 바로 느린 소비자로 처리)
+	slowClosing atomic.Bool // 느린 소비자로 종료 중
 }
 
 // ConnectionHealthCheck는 연결 상태 검사입니다
@@ -85,6 +93,7 @@ type ConnectionMetrics struct {
 	BytesReceived    int64         `json:"bytes_received"`
 	BytesSent        int64         `json:"bytes_sent"`
 	ErrorCount       int64         `json:"error_count"`
+	DroppedMessages  int64         `json:"dropped_messages"`
 	ReconnectCount   int64         `json:"reconnect_count"`
 	AverageLatency   time.Duration `json:"average_latency"`
 	LastMessage      time.Time     `json:"last_message"`
@@ -118,6 +127,8 @@ type ConnectionStats struct {
 	AverageConnTime     time.Duration `json:"average_connection_time"`
 	PeakConnections     int64 `json:"peak_connections"`
 	PeakConnectionsTime time.Time `json:"peak_connections_time"`
+	DroppedMessages     int64 `json:"dropped_messages"`
+	SlowConsumerDisconnects int64 `json:"slow_consumer_disconnects"`
 }
 
 // ConnectionManagerConfig는 연결 매니저 설정입니다
@@ -133,6 +144,14 @@ type ConnectionManagerConfig struct {
 	ReconnectDelay       time.Duration `json:"reconnect_delay"`
 	StatsUpdateInterval  time.Duration `json:"stats_update_interval"`
 	CleanupInterval      time.Duration `json:"cleanup_interval"`
+	
+	// 브로드캐스트와 배압
+	SendQueueSize        int                `json:"send_queue_size"`        // 연결별 전송 큐 크기
+	BroadcastShards      int                `json:"broadcast_shards"`       // 브로드캐스트 워커 수
+	BroadcastQueueSize   int                `json:"broadcast_queue_size"`   // 샤드별 작업 큐 크기 (가득 차면 호출자가 대기)
+	SlowConsumerPolicy   SlowConsumerPolicy `json:"slow_consumer_policy"`   // 전송 큐가 가득 찬 연결의 처리 방식
+	SlowConsumerTimeout  time.Duration      `json:"slow_consumer_timeout"`  // 큐에 자리가 나기를 기다리는 시간
+	MaxDroppedMessages   int64              `json:"max_dropped_messages"`   // drop 정책에서 연결을 끊는 누적 유실 수 (0이면 끊지 않음)
 }
 
 // ConnectionEvent는 연결 이벤트입니다
@@ -183,11 +202,31 @@ func DefaultConnectionManagerConfig() ConnectionManagerConfig {
 		ReconnectDelay:       time.Second,
 		StatsUpdateInterval:  10 * time.Second,
 		CleanupInterval:      time.Minute,
+		SendQueueSize:        256,
+		BroadcastShards:      8,
+		BroadcastQueueSize:   1024,
+		SlowConsumerPolicy:   SlowConsumerDisconnect,
+		SlowConsumerTimeout:  100 * time.Millisecond,
+		MaxDroppedMessages:   0,
 	}
 }
 
 // NewConnectionManager는 새로운 연결 매니저를 생성합니다
 func NewConnectionManager(config ConnectionManagerConfig) *ConnectionManager {
+	defaults := DefaultConnectionManagerConfig()
+	if config.SendQueueSize <= 0 {
+		config.SendQueueSize = defaults.SendQueueSize
+	}
+	if config.BroadcastShards <= 0 {
+		config.BroadcastShards = defaults.BroadcastShards
+	}
+	if config.BroadcastQueueSize <= 0 {
+		config.BroadcastQueueSize = defaults.BroadcastQueueSize
+	}
+	if config.SlowConsumerPolicy == "" {
+		config.SlowConsumerPolicy = defaults.SlowConsumerPolicy
+	}
+	
 	ctx, cancel := context.WithCancel(context.Background())
 	
 	cm := &ConnectionManager{
@@ -207,6 +246,14 @@ func NewConnectionManager(config ConnectionManagerConfig) *ConnectionManager {
 	go cm.cleanupWorker()
 	go cm.eventProcessor()
 	
+	// 브로드캐스트 샤드 워커 시작
+	cm.shards = make([]chan broadcastJob, config.BroadcastShards)
+	for i := range cm.shards {
+		cm.shards[i] = make(chan broadcastJob, config.BroadcastQueueSize)
+		cm.wg.Add(1)
+		go cm.broadcastWorker(cm.shards[i])
+	}
+	
 	return cm
 }
 
@@ -231,25 +278,9 @@ func (cm *ConnectionManager) RegisterConnection(conn *websocket.Conn, userInfo *
 		return nil, fmt.Errorf("maximum connections per user exceeded")
 	}
 	
-	// 관리 연결 생성
+	// 관리 연결 생성 및 등록
 	managed := cm.createManagedConnection(conn, userInfo)
-	
-	// 연결 등록
-	cm.connectionsMutex.Lock()
-	cm.connections[managed.ID] = managed
-	cm.connectionsMutex.Unlock()
-	
-	// 사용자별 연결 등록
-	cm.userMutex.Lock()
-	if cm.userConnections[userInfo.ID] == nil {
-		cm.userConnections[userInfo.ID] = make(map[string]*ManagedConnection)
-	}
-	cm.userConnections[userInfo.ID][managed.ID] = managed
-	cm.userMutex.Unlock()
-	
-	// 통계 업데이트
-	atomic.AddInt64(&cm.stats.TotalConnections, 1)
-	atomic.AddInt64(&cm.stats.ActiveConnections, 1)
+	cm.addConnection(managed)
 	
 	// 이벤트 발생
 	cm.publishEvent(ConnectionEvent{
@@ -268,6 +299,24 @@ func (cm *ConnectionManager) RegisterConnection(conn *websocket.Conn, userInfo *
 	return managed, nil
 }
 
+// addConnection은 연결을 전체/사용자별 목록에 추가합니다
+func (cm *ConnectionManager) addConnection(managed *ManagedConnection) {
+	cm.connectionsMutex.Lock()
+	cm.connections[managed.ID] = managed
+	cm.connectionsMutex.Unlock()
+	
+	cm.userMutex.Lock()
+	if cm.userConnections[managed.UserID] == nil {
+		cm.userConnections[managed.UserID] = make(map[string]*ManagedConnection)
+	}
+	cm.userConnections[managed.UserID][managed.ID] = managed
+	cm.userMutex.Unlock()
+	
+	// 통계 업데이트
+	atomic.AddInt64(&cm.stats.TotalConnections, 1)
+	atomic.AddInt64(&cm.stats.ActiveConnections, 1)
+}
+
 // UnregisterConnection은 연결을 해제합니다
 func (cm *ConnectionManager) UnregisterConnection(connectionID string) error {
 	cm.connectionsMutex.Lock()
@@ -441,65 +490,23 @@ func (cm *ConnectionManager) GetStats() ConnectionStats {
 // BroadcastToAll은 모든 연결에 메시지를 브로드캐스트합니다
 func (cm *ConnectionManager) BroadcastToAll(message WebSocketMessage) {
 	cm.connectionsMutex.RLock()
-	defer cm.connectionsMutex.RUnlock()
-	
-	messageBytes, err := json.Marshal(message)
-	if err != nil {
-		return
-	}
-	
+	connections := make([]*ManagedConnection, 0, len(cm.connections))
 	for _, managed := range cm.connections {
-		if managed.IsActive {
-			select {
-			case managed.sendChan <- messageBytes:
-			default:
-				// 버퍼가 가득 찬 경우 연결 종료
-				managed.forceClose()
-			}
-		}
+		connections = append(connections, managed)
 	}
+	cm.connectionsMutex.RUnlock()
+	
+	cm.broadcast(connections, message)
 }
 
 // BroadcastToSession은 세션의 모든 연결에 메시지를 브로드캐스트합니다
 func (cm *ConnectionManager) BroadcastToSession(sessionID string, message WebSocketMessage) {
-	connections := cm.GetSessionConnections(sessionID)
-	
-	messageBytes, err := json.Marshal(message)
-	if err != nil {
-		return
-	}
-	
-	for _, managed := range connections {
-		if managed.IsActive {
-			select {
-			case managed.sendChan <- messageBytes:
-			default:
-				// 버퍼가 가득 찬 경우 연결 종료
-				managed.forceClose()
-			}
-		}
-	}
+	cm.broadcast(cm.GetSessionConnections(sessionID), message)
 }
 
 // BroadcastToUser는 사용자의 모든 연결에 메시지를 브로드캐스트합니다
 func (cm *ConnectionManager) BroadcastToUser(userID string, message WebSocketMessage) {
-	connections := cm.GetUserConnections(userID)
-	
-	messageBytes, err := json.Marshal(message)
-	if err != nil {
-		return
-	}
-	
-	for _, managed := range connections {
-		if managed.IsActive {
-			select {
-			case managed.sendChan <- messageBytes:
-			default:
-				// 버퍼가 가득 찬 경우 연결 종료
-				managed.forceClose()
-			}
-		}
-	}
+	cm.broadcast(cm.GetUserConnections(userID), message)
 }
 
 // Shutdown은 연결 매니저를 종료합니다
@@ -534,7 +541,7 @@ func (cm *ConnectionManager) createManagedConnection(conn *websocket.Conn, userI
 		ConnectedAt: time.Now(),
 		LastSeen:    time.Now(),
 		IsActive:    true,
-		sendChan:    make(chan []byte, 256),
+		sendChan:    make(chan []byte, cm.config.SendQueueSize),
 		closeChan:   make(chan struct{}),
 	}
 	
@@ -552,6 +559,7 @@ func (cm *ConnectionManager) createManagedConnection(conn *websocket.Conn, userI
 		state:         StateConnected,
 		subscriptions: make(map[string]bool),
 		heartbeatTicker: time.NewTicker(cm.config.HeartbeatInterval),
+		shard:         cm.shardFor(clientConn.ID),
 	}
 	
 	return managed
@@ -0,0 +1,154 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dialTestConn 서버 측 연결과 클라이언트 측 연결 쌍
+func dialTestConn(t *testing.T) (*websocket.Conn, *websocket.Conn) {
+	t.Helper()
+
+	serverConns := make(chan *websocket.Conn, 1)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		serverConns <- conn
+	}))
+	t.Cleanup(server.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	return <-serverConns, client
+}
+
+// addTestConnection 쓰기 루프 없이 등록한 관리 연결 (전송 큐를 테스트가 직접 비움)
+func addTestConnection(t *testing.T, cm *ConnectionManager, id, sessionID string) (*ManagedConnection, *websocket.Conn) {
+	t.Helper()
+
+	serverConn, clientConn := dialTestConn(t)
+	managed := cm.createManagedConnection(serverConn, &auth.UserInfo{ID: "user-" + id, Username: id})
+	managed.ID = id
+	managed.shard = cm.shardFor(id)
+	cm.addConnection(managed)
+	require.NoError(t, cm.AssignToSession(id, sessionID))
+
+	return managed, clientConn
+}
+
+func newTestConnectionManager(t *testing.T, policy SlowConsumerPolicy) *ConnectionManager {
+	config := DefaultConnectionManagerConfig()
+	config.SendQueueSize = 2
+	config.BroadcastShards = 4
+	config.SlowConsumerPolicy = policy
+	config.SlowConsumerTimeout = 20 * time.Millisecond
+
+	cm := NewConnectionManager(config)
+	t.Cleanup(cm.Shutdown)
+	return cm
+}
+
+func TestConnectionManager_SlowConsumerDisconnect(t *testing.T) {
+	cm := newTestConnectionManager(t, SlowConsumerDisconnect)
+
+	healthy, _ := addTestConnection(t, cm, "healthy", "s1")
+	stuck, stuckClient := addTestConnection(t, cm, "stuck", "s1")
+
+	var received atomic.Int64
+	go func() {
+		for {
+			select {
+			case <-healthy.sendChan:
+				received.Add(1)
+			case <-healthy.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < 10; i++ {
+		cm.BroadcastToSession("s1", WebSocketMessage{Type: "output"})
+	}
+
+	// 정상 연결은 느린 연결과 무관하게 모든 메시지를 받음
+	assert.Eventually(t, func() bool { return received.Load() == 10 }, 2*time.Second, 10*time.Millisecond)
+
+	// 느린 연결은 4003으로 종료되고 매니저에서 제거됨
+	stuckClient.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, _, err := stuckClient.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, closeSlowConsumer), "unexpected error: %v", err)
+
+	assert.Eventually(t, func() bool {
+		_, err := cm.GetConnection(stuck.ID)
+		return err != nil
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&cm.stats.SlowConsumerDisconnects))
+	assert.Len(t, cm.GetSessionConnections("s1"), 1)
+}
+
+func TestConnectionManager_SlowConsumerDrop(t *testing.T) {
+	cm := newTestConnectionManager(t, SlowConsumerDrop)
+
+	stuck, _ := addTestConnection(t, cm, "stuck", "s1")
+
+	for i := 0; i < 5; i++ {
+		cm.BroadcastToSession("s1", WebSocketMessage{Type: "output"})
+	}
+
+	// 큐 크기(2)를 넘는 메시지는 버려지고 연결은 유지됨
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt64(&cm.stats.DroppedMessages) == 3
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(3), atomic.LoadInt64(&stuck.metrics.DroppedMessages))
+	assert.Zero(t, atomic.LoadInt64(&cm.stats.SlowConsumerDisconnects))
+
+	_, err := cm.GetConnection(stuck.ID)
+	assert.NoError(t, err)
+}
+
+func TestConnectionManager_DropLimitDisconnects(t *testing.T) {
+	config := DefaultConnectionManagerConfig()
+	config.SendQueueSize = 1
+	config.SlowConsumerPolicy = SlowConsumerDrop
+	config.SlowConsumerTimeout = 0
+	config.MaxDroppedMessages = 2
+	cm := NewConnectionManager(config)
+	t.Cleanup(cm.Shutdown)
+
+	stuck, _ := addTestConnection(t, cm, "stuck", "s1")
+
+	for i := 0; i < 5; i++ {
+		cm.BroadcastToSession("s1", WebSocketMessage{Type: "output"})
+	}
+
+	assert.Eventually(t, func() bool {
+		_, err := cm.GetConnection(stuck.ID)
+		return err != nil
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&cm.stats.SlowConsumerDisconnects))
+}
+
+func TestNewConnectionManager_BroadcastDefaults(t *testing.T) {
+	cm := NewConnectionManager(ConnectionManagerConfig{
+		HeartbeatInterval:   time.Minute,
+		StatsUpdateInterval: time.Minute,
+		CleanupInterval:     time.Minute,
+	})
+	defer cm.Shutdown()
+
+	assert.Len(t, cm.shards, DefaultConnectionManagerConfig().BroadcastShards)
+	assert.Equal(t, SlowConsumerDisconnect, cm.config.SlowConsumerPolicy)
+	assert.Equal(t, cm.shardFor("conn-1"), cm.shardFor("conn-1"))
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
 )
 
 // MessageRouter는 WebSocket 메시지를 라우팅합니다
@@ -46,6 +48,9 @@ const (
 	MessageTypeUsers          = "session.users"
 	MessageTypeShare          = "session.share"
 	MessageTypeJoin           = "session.join"
+	MessageTypeHandoff        = "session.handoff"
+	MessageTypeRequestControl = "session.request_control"
+	MessageTypeCursor         = "session.cursor"
 	MessageTypeFileUpload     = "file.upload"
 	MessageTypeFileDownload   = "file.download"
 	MessageTypeFileList       = "file.list"
@@ -126,6 +131,11 @@ func (r *MessageRouter) setupDefaultRoutes() {
 	r.AddRoute(MessageTypeShare, r.handleSessionShare)
 	r.AddRoute(MessageTypeJoin, r.handleSessionJoin)
 
+	// 공동 작업 라우트
+	r.AddRoute(MessageTypeHandoff, r.handleSessionHandoff)
+	r.AddRoute(MessageTypeRequestControl, r.handleRequestControl)
+	r.AddRoute(MessageTypeCursor, r.handleCursor)
+
 	// 파일 관련 라우트
 	r.AddRoute(MessageTypeFileUpload, r.handleFileUpload)
 	r.AddRoute(MessageTypeFileDownload, r.handleFileDownload)
@@ -242,6 +252,10 @@ func (r *MessageRouter) handleSessionExecute(ctx *MessageContext) error {
 		return fmt.Errorf("write permission required")
 	}
 
+	if !r.handler.isDriver(ctx.SessionID, ctx.UserID) {
+		return ErrNotDriver
+	}
+
 	command, ok := ctx.Data["command"].(string)
 	if !ok {
 		return fmt.Errorf("command is required")
@@ -302,15 +316,34 @@ func (r *MessageRouter) handleSessionShare(ctx *MessageContext) error {
 		return fmt.Errorf("session_id is required")
 	}
 
-	// 공유 링크 생성 (실제 구현 필요)
-	shareToken := fmt.Sprintf("share_%s_%d", sessionID, time.Now().Unix())
-	shareURL := fmt.Sprintf("/session/join?token=%s", shareToken)
+	if r.handler.shareLinks == nil {
+		return fmt.Errorf("share links are not enabled")
+	}
+
+	scope := models.ShareScopeRead
+	if value, ok := ctx.Data["scope"].(string); ok && value != "" {
+		scope = models.ShareScope(value)
+	}
+	if !scope.IsValid() {
+		return fmt.Errorf("invalid share scope: %s", scope)
+	}
+	ttl := defaultShareLinkTTL
+	if seconds := intFromData(ctx.Data, "expires_in"); seconds > 0 {
+		ttl = time.Duration(seconds) * time.Second
+	}
+
+	link, shareToken, err := r.handler.shareLinks.IssueShareLink(ctx.Client.ctx, sessionID, "", ctx.UserID, scope, ttl)
+	if err != nil {
+		return fmt.Errorf("failed to create share link: %w", err)
+	}
 
 	ctx.SendSuccess(map[string]interface{}{
 		"session_id":  sessionID,
+		"link_id":     link.ID,
+		"scope":       link.Scope,
 		"share_token": shareToken,
-		"share_url":   shareURL,
-		"expires_at":  time.Now().Add(24 * time.Hour),
+		"share_url":   fmt.Sprintf("/ws/session/%s?share_token=%s", sessionID, shareToken),
+		"expires_at":  link.ExpiresAt,
 	})
 
 	return nil
@@ -322,16 +355,13 @@ func (r *MessageRouter) handleSessionJoin(ctx *MessageContext) error {
 		return fmt.Errorf("share_token is required")
 	}
 
-	// 토큰 검증 및 세션 ID 추출 (실제 구현 필요)
-	// 여기서는 시뮬레이션
 	sessionID := ctx.SessionID
 	if sessionID == "" || shareToken == "" {
-		return fmt.Errorf("invalid share token: %s", shareToken)
+		return fmt.Errorf("session_id and share_token are required")
 	}
 
-	// 세션 참여
-	err := r.handler.ConnectSession(sessionID, ctx.Client)
-	if err != nil {
+	// 토큰 검증 후 링크 권한으로 세션 참여
+	if err := r.handler.joinWithShareToken(ctx.Client.ctx, ctx.Client, sessionID, shareToken); err != nil {
 		return fmt.Errorf("failed to join session: %w", err)
 	}
 
@@ -344,6 +374,69 @@ func (r *MessageRouter) handleSessionJoin(ctx *MessageContext) error {
 	return nil
 }
 
+func (r *MessageRouter) handleSessionHandoff(ctx *MessageContext) error {
+	sessionID := ctx.SessionID
+	if sessionID == "" {
+		return fmt.Errorf("session_id is required")
+	}
+
+	targetUserID, ok := ctx.Data["user_id"].(string)
+	if !ok || targetUserID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+
+	if err := r.handler.HandoffDriver(sessionID, ctx.UserID, targetUserID); err != nil {
+		return fmt.Errorf("failed to hand off driver: %w", err)
+	}
+
+	ctx.SendSuccess(map[string]interface{}{
+		"session_id": sessionID,
+		"driver_id":  targetUserID,
+	})
+
+	return nil
+}
+
+func (r *MessageRouter) handleRequestControl(ctx *MessageContext) error {
+	sessionID := ctx.SessionID
+	if sessionID == "" {
+		return fmt.Errorf("session_id is required")
+	}
+
+	granted, err := r.handler.RequestControl(sessionID, ctx.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to request control: %w", err)
+	}
+
+	ctx.SendSuccess(map[string]interface{}{
+		"session_id": sessionID,
+		"granted":    granted,
+		"driver_id":  r.handler.GetDriver(sessionID),
+	})
+
+	return nil
+}
+
+func (r *MessageRouter) handleCursor(ctx *MessageContext) error {
+	sessionID := ctx.SessionID
+	if sessionID == "" {
+		return fmt.Errorf("session_id is required")
+	}
+
+	cursor := CursorPosition{
+		Line:      intFromData(ctx.Data, "line"),
+		Column:    intFromData(ctx.Data, "column"),
+		EndLine:   intFromData(ctx.Data, "end_line"),
+		EndColumn: intFromData(ctx.Data, "end_column"),
+	}
+	if file, ok := ctx.Data["file"].(string); ok {
+		cursor.File = file
+	}
+
+	// 커서 이동은 빈번하므로 별도 응답 없이 브로드캐스트만 수행
+	return r.handler.UpdateCursor(sessionID, ctx.UserID, cursor)
+}
+
 func (r *MessageRouter) handleFileUpload(ctx *MessageContext) error {
 	if ctx.Permission < PermissionWrite {
 		return fmt.Errorf("write permission required")
@@ -436,6 +529,14 @@ func (r *MessageRouter) handlePing(ctx *MessageContext) error {
 	return nil
 }
 
+// intFromData는 JSON 숫자 필드를 정수로 변환합니다
+func intFromData(data map[string]interface{}, key string) int {
+	if value, ok := data[key].(float64); ok {
+		return int(value)
+	}
+	return 0
+}
+
 // 빌더 메서드들
 
 func (r *MessageRouter) buildHandlerChain(messageType string) RouteHandler {
@@ -0,0 +1,138 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// defaultShareLinkTTL WebSocket으로 발급하는 공유 링크의 기본 유효 기간
+const defaultShareLinkTTL = 24 * time.Hour
+
+// ShareLinkService는 세션 공유 링크 발급, 검증과 참여 기록을 제공합니다 (services.SessionShareService가 구현)
+type ShareLinkService interface {
+	IssueShareLink(ctx context.Context, sessionID, workspaceID, createdBy string, scope models.ShareScope, ttl time.Duration) (*models.SessionShareLink, string, error)
+	ValidateShareToken(ctx context.Context, token, sessionID string) (*models.SessionShareLink, error)
+	RecordShareJoin(ctx context.Context, join *models.SessionShareJoin) error
+}
+
+// SharedParticipant는 공유 링크로 참여하는 사용자 정보입니다
+// 인증하지 않은 게스트는 UserID가 비어 있습니다.
+type SharedParticipant struct {
+	UserID    string
+	UserName  string
+	ClientIP  string
+	UserAgent string
+}
+
+// SetShareLinkService는 공유 링크 서비스를 설정합니다
+func (h *ClaudeStreamHandler) SetShareLinkService(service ShareLinkService) {
+	h.shareLinks = service
+}
+
+// HandleSharedConnection은 검증된 공유 링크로 세션에 WebSocket 참여를 처리합니다
+// 링크 검증은 호출 전에 middleware.RequireShareToken에서 수행합니다.
+func (h *ClaudeStreamHandler) HandleSharedConnection(w http.ResponseWriter, r *http.Request, link *models.SessionShareLink, participant SharedParticipant) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("WebSocket upgrade failed: %v", err)
+		return
+	}
+
+	userID := participant.UserID
+	userName := participant.UserName
+	if userID == "" {
+		userID = fmt.Sprintf("guest_%d", time.Now().UnixNano())
+		userName = "guest"
+	}
+
+	clientConn := h.createClientConnection(conn, &auth.UserInfo{ID: userID, Username: userName})
+	clientConn.Permission = scopePermission(link.Scope)
+	clientConn.ShareLinkID = link.ID
+	defer h.closeClientConnection(clientConn)
+
+	h.recordShareJoin(r.Context(), link, clientConn, participant)
+
+	if err := h.ConnectSession(link.SessionID, clientConn); err != nil {
+		log.Printf("Failed to join shared session %s: %v", link.SessionID, err)
+		return
+	}
+
+	h.handleConnection(clientConn)
+}
+
+// joinWithShareToken은 연결된 클라이언트를 공유 토큰으로 세션에 참여시킵니다
+// 링크 권한 범위가 클라이언트의 기존 권한보다 높으면 링크 권한을 적용합니다.
+func (h *ClaudeStreamHandler) joinWithShareToken(ctx context.Context, clientConn *ClientConnection, sessionID, token string) error {
+	if h.shareLinks == nil {
+		return fmt.Errorf("share links are not enabled")
+	}
+
+	link, err := h.shareLinks.ValidateShareToken(ctx, token, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if permission := scopePermission(link.Scope); permission > clientConn.Permission {
+		clientConn.Permission = permission
+		clientConn.ShareLinkID = link.ID
+	}
+	h.recordShareJoin(ctx, link, clientConn, SharedParticipant{UserID: clientConn.UserID, UserName: clientConn.UserName})
+
+	return h.ConnectSession(sessionID, clientConn)
+}
+
+// DisconnectShareLink는 폐기된 공유 링크로 참여한 연결을 모두 종료하고 종료한 연결 수를 반환합니다
+func (h *ClaudeStreamHandler) DisconnectShareLink(linkID string) int {
+	targets := make(map[string]*ClientConnection)
+
+	h.connectionsMutex.RLock()
+	for _, group := range h.connections {
+		group.mutex.RLock()
+		for _, conn := range group.Connections {
+			if conn.ShareLinkID == linkID && conn.IsActive {
+				targets[conn.ID] = conn
+			}
+		}
+		group.mutex.RUnlock()
+	}
+	h.connectionsMutex.RUnlock()
+
+	for _, conn := range targets {
+		h.closeClientConnection(conn)
+	}
+	return len(targets)
+}
+
+// recordShareJoin은 공유 링크 참여를 감사 기록으로 남깁니다. 실패해도 참여는 계속됩니다.
+func (h *ClaudeStreamHandler) recordShareJoin(ctx context.Context, link *models.SessionShareLink, clientConn *ClientConnection, participant SharedParticipant) {
+	if h.shareLinks == nil {
+		return
+	}
+
+	err := h.shareLinks.RecordShareJoin(ctx, &models.SessionShareJoin{
+		LinkID:    link.ID,
+		SessionID: link.SessionID,
+		UserID:    participant.UserID,
+		UserName:  clientConn.UserName,
+		Channel:   "websocket",
+		ClientIP:  participant.ClientIP,
+		UserAgent: participant.UserAgent,
+	})
+	if err != nil {
+		log.Printf("Failed to record share join (link: %s): %v", link.ID, err)
+	}
+}
+
+// scopePermission은 공유 링크 권한 범위를 연결 권한으로 변환합니다
+func scopePermission(scope models.ShareScope) Permission {
+	if scope == models.ShareScopeInteractive {
+		return PermissionWrite
+	}
+	return PermissionRead
+}
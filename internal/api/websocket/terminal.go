@@ -0,0 +1,136 @@
+package websocket
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+const (
+	// terminalPongWait 클라이언트 응답 대기 시간 (넘으면 연결이 끊긴 것으로 봄)
+	terminalPongWait = 60 * time.Second
+	// terminalPingPeriod 핑 전송 주기
+	terminalPingPeriod = 30 * time.Second
+	// terminalWriteWait 메시지 쓰기 제한 시간
+	terminalWriteWait = 10 * time.Second
+	// terminalMaxMessageSize 클라이언트 메시지 최대 크기 (붙여넣기 포함)
+	terminalMaxMessageSize = 1 << 20
+)
+
+// CheckTerminalOrigin 터미널 WebSocket 요청의 Origin 확인
+// Origin 헤더가 없는 비브라우저 클라이언트(CLI), 같은 호스트의 오리진, 허용 목록에 있는 오리진만 허용합니다.
+// 셸 접속이므로 허용 목록의 "*"는 무시합니다.
+func CheckTerminalOrigin(r *http.Request, allowedOrigins []string) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, allowed := range allowedOrigins {
+		if allowed != "*" && strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// TerminalConn 터미널 메시지(models.TerminalMessage)를 주고받는 WebSocket 연결
+// services.TerminalConn을 구현하며, 쓰기는 직렬화되고 주기적으로 핑을 보내 끊긴 연결을 감지합니다.
+type TerminalConn struct {
+	conn      *websocket.Conn
+	writeMu   sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// UpgradeTerminal HTTP 요청을 터미널 WebSocket 연결로 업그레이드 (오리진은 CheckTerminalOrigin으로 확인)
+// 실패하면 업그레이더가 이미 HTTP 오류 응답을 보냈습니다.
+func UpgradeTerminal(w http.ResponseWriter, r *http.Request, allowedOrigins []string) (*TerminalConn, error) {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 32 * 1024,
+		CheckOrigin: func(r *http.Request) bool {
+			return CheckTerminalOrigin(r, allowedOrigins)
+		},
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conn.SetReadLimit(terminalMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(terminalPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(terminalPongWait))
+	})
+
+	tc := &TerminalConn{conn: conn, done: make(chan struct{})}
+	go tc.keepAlive()
+	return tc, nil
+}
+
+// ReadMessage 클라이언트 메시지 읽기
+func (tc *TerminalConn) ReadMessage() (*models.TerminalMessage, error) {
+	var msg models.TerminalMessage
+	if err := tc.conn.ReadJSON(&msg); err != nil {
+		return nil, err
+	}
+	tc.conn.SetReadDeadline(time.Now().Add(terminalPongWait))
+	return &msg, nil
+}
+
+// WriteMessage 클라이언트로 메시지 전송
+func (tc *TerminalConn) WriteMessage(msg *models.TerminalMessage) error {
+	tc.writeMu.Lock()
+	defer tc.writeMu.Unlock()
+
+	tc.conn.SetWriteDeadline(time.Now().Add(terminalWriteWait))
+	return tc.conn.WriteJSON(msg)
+}
+
+// Close 정상 종료 프레임을 보내고 연결 종료
+func (tc *TerminalConn) Close() error {
+	var err error
+	tc.closeOnce.Do(func() {
+		close(tc.done)
+		tc.writeMu.Lock()
+		tc.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(terminalWriteWait))
+		tc.writeMu.Unlock()
+		err = tc.conn.Close()
+	})
+	return err
+}
+
+// keepAlive 연결이 닫힐 때까지 주기적으로 핑 전송
+func (tc *TerminalConn) keepAlive() {
+	ticker := time.NewTicker(terminalPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-tc.done:
+			return
+		case <-ticker.C:
+			tc.writeMu.Lock()
+			err := tc.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(terminalWriteWait))
+			tc.writeMu.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package websocket
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTerminalOrigin(t *testing.T) {
+	allowed := []string{"https://app.example.com", "*"}
+
+	tests := []struct {
+		name   string
+		origin string
+		want   bool
+	}{
+		{"비브라우저 클라이언트", "", true},
+		{"같은 호스트", "https://api.example.com", true},
+		{"허용 목록", "https://app.example.com", true},
+		{"다른 사이트", "https://evil.example.net", false},
+		{"잘못된 오리진", "null", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "https://api.example.com/ws/workspaces/ws-1/terminal", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			assert.Equal(t, tt.want, CheckTerminalOrigin(req, allowed))
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package websocket
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/aicli/aicli-web/internal/auth"
 	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/storage"
 	"github.com/gin-gonic/gin"
 )
@@ -87,6 +89,11 @@ type MessageSendRequest struct {
 	Data    map[string]interface{} `json:"data,omitempty"`
 }
 
+// HandoffRequest는 드라이버 역할 넘기기 요청입니다
+type HandoffRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+}
+
 // InviteUserRequest는 사용자 초대 요청입니다
 type InviteUserRequest struct {
 	UserID     string     `json:"user_id,omitempty"`
@@ -194,9 +201,13 @@ func (c *WebSessionController) CreateSession(ctx *gin.Context) {
 		},
 	}
 
-	// 비공개가 아닌 경우 공유 토큰 생성
-	if !req.IsPrivate {
-		shareToken := generateShareToken(session.ID)
+	// 비공개가 아닌 경우 읽기 전용 공유 토큰 발급
+	if !req.IsPrivate && c.streamHandler.shareLinks != nil {
+		_, shareToken, err := c.streamHandler.shareLinks.IssueShareLink(ctx.Request.Context(), session.ID, req.WorkspaceID, userInfo.ID, models.ShareScopeRead, defaultShareLinkTTL)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "공유 링크 발급에 실패했습니다"})
+			return
+		}
 		response.ShareToken = shareToken
 	}
 
@@ -497,6 +508,10 @@ func (c *WebSessionController) SendMessage(ctx *gin.Context) {
 
 	// Claude 세션에 메시지 전달
 	if err := c.streamHandler.ForwardToSession(sessionID, userInfo.ID, req.Message); err != nil {
+		if errors.Is(err, ErrNotDriver) {
+			ctx.JSON(http.StatusConflict, gin.H{"error": "드라이버만 메시지를 전송할 수 있습니다", "driver_id": c.streamHandler.GetDriver(sessionID)})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "메시지 전송에 실패했습니다"})
 		return
 	}
@@ -504,6 +519,46 @@ func (c *WebSessionController) SendMessage(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"message": "메시지가 전송되었습니다"})
 }
 
+// HandoffDriver는 세션의 드라이버 역할을 다른 참여자에게 넘깁니다
+func (c *WebSessionController) HandoffDriver(ctx *gin.Context) {
+	sessionID := ctx.Param("id")
+	if sessionID == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "session_id가 필요합니다"})
+		return
+	}
+
+	var req HandoffRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// 사용자 인증 확인
+	token := ctx.GetHeader("Authorization"); if token == "" { ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Missing Authorization header"}); return }; tokenStr, err := auth.ExtractTokenFromHeader(token); if err != nil { ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid Authorization header"}); return }; userInfo, err := (*c.authValidator).ValidateToken(ctx.Request.Context(), tokenStr)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "인증이 필요합니다"})
+		return
+	}
+
+	if err := c.streamHandler.HandoffDriver(sessionID, userInfo.ID, req.UserID); err != nil {
+		switch {
+		case errors.Is(err, ErrNotDriver):
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "현재 드라이버 또는 세션 관리자만 역할을 넘길 수 있습니다"})
+		case errors.Is(err, ErrNotParticipant):
+			ctx.JSON(http.StatusNotFound, gin.H{"error": "대상 사용자가 세션에 연결되어 있지 않습니다"})
+		default:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"session_id":   sessionID,
+		"driver_id":    req.UserID,
+		"participants": c.streamHandler.GetSessionUsers(sessionID),
+	})
+}
+
 // InviteUser는 사용자를 세션에 초대합니다
 func (c *WebSessionController) InviteUser(ctx *gin.Context) {
 	sessionID := ctx.Param("id")
@@ -737,11 +792,6 @@ func analyzeMessages(messages []claude.Message) map[string]interface{} {
 	return stats
 }
 
-func generateShareToken(sessionID string) string {
-	// 실제 구현에서는 암호화된 토큰 생성
-	return fmt.Sprintf("share_%s_%d", sessionID, time.Now().Unix())
-}
-
 func generateInviteToken(sessionID, userID string, permission Permission) string {
 	// 실제 구현에서는 암호화된 토큰 생성
 	return fmt.Sprintf("invite_%s_%s_%d_%d", sessionID, userID, int(permission), time.Now().Unix())
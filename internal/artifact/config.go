@@ -0,0 +1,41 @@
+package artifact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// DownloadPath 로컬 드라이버 다운로드 엔드포인트 경로
+const DownloadPath = "/api/v1/artifacts/download"
+
+// NewStoreFromConfig 설정에 맞는 아티팩트 저장소를 생성합니다.
+// secret은 로컬 드라이버의 다운로드 URL 서명에 사용됩니다.
+func NewStoreFromConfig(cfg config.ArtifactConfig, secret []byte) (Store, error) {
+	switch cfg.Driver {
+	case "", "local":
+		dir := cfg.LocalDir
+		if dir == "" {
+			dir = config.GetDefaultConfig().Artifacts.LocalDir
+		}
+		return NewLocalStore(dir, strings.TrimSuffix(cfg.PublicURL, "/")+DownloadPath, secret), nil
+	case "s3":
+		return NewS3Store(S3ConfigFrom(cfg.S3))
+	default:
+		return nil, fmt.Errorf("지원하지 않는 아티팩트 드라이버: %s", cfg.Driver)
+	}
+}
+
+// S3ConfigFrom 애플리케이션 S3 설정을 드라이버 설정으로 변환합니다
+func S3ConfigFrom(cfg config.S3Config) S3Config {
+	return S3Config{
+		Bucket:          cfg.Bucket,
+		Prefix:          cfg.Prefix,
+		Region:          cfg.Region,
+		Endpoint:        cfg.Endpoint,
+		AccessKeyID:     cfg.AccessKeyID,
+		SecretAccessKey: cfg.SecretAccessKey,
+		PathStyle:       cfg.PathStyle,
+	}
+}
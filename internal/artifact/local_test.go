@@ -0,0 +1,68 @@
+package artifact
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore_PutGetList(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocalStore(t.TempDir(), "http://localhost:8080"+DownloadPath, []byte("secret"))
+
+	require.NoError(t, store.Put(ctx, "tasks/t1/output.tar.gz", strings.NewReader("data"), 4, "application/gzip"))
+	require.NoError(t, store.Put(ctx, "uploads/u1/a.txt", strings.NewReader("a"), 1, "text/plain"))
+
+	r, err := store.Get(ctx, "tasks/t1/output.tar.gz")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, "data", string(data))
+
+	objects, err := store.List(ctx, "tasks/")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "tasks/t1/output.tar.gz", objects[0].Key)
+
+	require.NoError(t, store.Delete(ctx, "tasks/t1/output.tar.gz"))
+	_, err = store.Get(ctx, "tasks/t1/output.tar.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocalStore_PresignVerify(t *testing.T) {
+	store := NewLocalStore(t.TempDir(), "http://localhost:8080"+DownloadPath, []byte("secret"))
+	now := time.Unix(1700000000, 0)
+	store.now = func() time.Time { return now }
+
+	raw, err := store.PresignGet(context.Background(), "uploads/u1/a b.txt", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(raw, "http://localhost:8080/api/v1/artifacts/download/uploads/u1/a%20b.txt?"))
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	assert.NoError(t, store.Verify("uploads/u1/a b.txt", expires, signature))
+	assert.ErrorIs(t, store.Verify("uploads/u1/other.txt", expires, signature), ErrInvalidSignature)
+
+	now = now.Add(2 * time.Minute)
+	assert.ErrorIs(t, store.Verify("uploads/u1/a b.txt", expires, signature), ErrInvalidSignature)
+}
+
+func TestValidateKey(t *testing.T) {
+	valid := []string{"a", "a/b.txt", "tasks/1/out.tar.gz"}
+	invalid := []string{"", "/abs", "../x", "a/../b", "a//b", "a/./b", "a\\b", "a/"}
+
+	for _, key := range valid {
+		assert.NoError(t, ValidateKey(key), key)
+	}
+	for _, key := range invalid {
+		assert.ErrorIs(t, ValidateKey(key), ErrInvalidKey, key)
+	}
+}
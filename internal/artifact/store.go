@@ -0,0 +1,67 @@
+// Package artifact는 태스크 출력 아카이브, 내보낸 워크스페이스, 백업, 업로드 파일 등
+// 대용량 바이너리를 저장하는 아티팩트 저장소 추상화를 제공합니다.
+//
+// 로컬 파일시스템과 S3 호환 저장소(AWS S3, MinIO) 드라이버를 지원하며,
+// 다운로드는 미리 서명된 URL로 제공하여 API 서버를 거치지 않도록 합니다.
+package artifact
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound 객체를 찾을 수 없음
+	ErrNotFound = errors.New("artifact not found")
+
+	// ErrInvalidKey 잘못된 객체 키
+	ErrInvalidKey = errors.New("invalid artifact key")
+
+	// ErrInvalidSignature 잘못되었거나 만료된 서명
+	ErrInvalidSignature = errors.New("invalid or expired artifact signature")
+)
+
+// Object 저장된 객체 정보
+type Object struct {
+	Key     string    `json:"key"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// Store 아티팩트 저장소
+type Store interface {
+	// Put 객체 저장
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+
+	// Get 객체 읽기
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// List 접두사로 시작하는 객체 목록 (키 순)
+	List(ctx context.Context, prefix string) ([]Object, error)
+
+	// Delete 객체 삭제 (없으면 무시)
+	Delete(ctx context.Context, key string) error
+
+	// PresignGet 만료 시간이 있는 다운로드 URL 생성
+	PresignGet(ctx context.Context, key string, expires time.Duration) (string, error)
+}
+
+// ValidateKey 객체 키를 검증합니다. 키는 슬래시로 구분된 상대 경로여야 합니다.
+func ValidateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	if path.Clean(key) != key {
+		return ErrInvalidKey
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == ".." || segment == "." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
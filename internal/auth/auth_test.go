@@ -178,6 +178,22 @@ func TestBlacklist(t *testing.T) {
 		blacklist.Remove(token)
 		assert.False(t, blacklist.IsBlacklisted(token))
 	})
+	
+	t.Run("사용자 토큰 차단", func(t *testing.T) {
+		issued := NewClaims("user-1", "alice", "", "user", time.Now().Add(time.Hour))
+		issued.IssuedAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		other := NewClaims("user-2", "bob", "", "user", time.Now().Add(time.Hour))
+		other.IssuedAt = issued.IssuedAt
+		
+		blacklist.RevokeUser("user-1", time.Now(), time.Now().Add(time.Hour))
+		assert.True(t, blacklist.IsRevoked(issued))
+		assert.False(t, blacklist.IsRevoked(other))
+		
+		// 차단 이후 발급한 토큰은 사용 가능
+		reissued := NewClaims("user-1", "alice", "", "user", time.Now().Add(time.Hour))
+		reissued.IssuedAt = jwt.NewNumericDate(time.Now().Add(time.Second))
+		assert.False(t, blacklist.IsRevoked(reissued))
+	})
 }
 
 func TestJWTSigningMethod(t *testing.T) {
@@ -12,15 +12,24 @@ type BlacklistEntry struct {
 }
 
 // Blacklist 토큰 블랙리스트 관리자
+// 개별 토큰 외에 사용자 단위로 특정 시각 이전에 발급한 토큰을 모두 차단할 수 있습니다 (비밀번호 변경, 계정 삭제).
 type Blacklist struct {
 	mu      sync.RWMutex
 	entries map[string]BlacklistEntry
+	users   map[string]userRevocation
+}
+
+// userRevocation 사용자 토큰 차단 (Before 이전에 발급한 토큰, ExpiresAt 이후에는 그 토큰들도 모두 만료됨)
+type userRevocation struct {
+	Before    time.Time
+	ExpiresAt time.Time
 }
 
 // NewBlacklist 새로운 블랙리스트 생성
 func NewBlacklist() *Blacklist {
 	bl := &Blacklist{
 		entries: make(map[string]BlacklistEntry),
+		users:   make(map[string]userRevocation),
 	}
 	
 	// 만료된 항목 정리를 위한 고루틴 시작
@@ -58,6 +67,31 @@ func (bl *Blacklist) IsBlacklisted(token string) bool {
 	return true
 }
 
+// RevokeUser 사용자에게 before 이전에 발급한 토큰을 모두 차단 (expiresAt까지 유지)
+// JWT 발급 시각은 초 단위이므로 before와 같은 초에 발급한 토큰은 차단하지 않습니다.
+func (bl *Blacklist) RevokeUser(userID string, before, expiresAt time.Time) {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	
+	before = before.Truncate(time.Second)
+	if current, exists := bl.users[userID]; exists && current.Before.After(before) {
+		before = current.Before
+	}
+	bl.users[userID] = userRevocation{Before: before, ExpiresAt: expiresAt}
+}
+
+// IsRevoked 클레임의 토큰이 사용자 단위로 차단되었는지 확인
+func (bl *Blacklist) IsRevoked(claims *Claims) bool {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+	
+	revocation, exists := bl.users[claims.UserID]
+	if !exists || time.Now().After(revocation.ExpiresAt) {
+		return false
+	}
+	return claims.IssuedAt == nil || claims.IssuedAt.Time.Before(revocation.Before)
+}
+
 // Remove 토큰을 블랙리스트에서 제거
 func (bl *Blacklist) Remove(token string) {
 	bl.mu.Lock()
@@ -80,6 +114,11 @@ func (bl *Blacklist) cleanupExpiredEntries() {
 				delete(bl.entries, token)
 			}
 		}
+		for userID, revocation := range bl.users {
+			if now.After(revocation.ExpiresAt) {
+				delete(bl.users, userID)
+			}
+		}
 		
 		bl.mu.Unlock()
 	}
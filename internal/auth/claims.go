@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -13,6 +15,52 @@ type Claims struct {
 	UserName string `json:"username"`
 	Email    string `json:"email,omitempty"`
 	Role     string `json:"role"`
+	// Scope 공백으로 구분한 권한 범위 (비어 있으면 모든 요청 허용)
+	Scope string `json:"scope,omitempty"`
+}
+
+// 토큰 권한 범위
+const (
+	// ScopeRead 조회 요청(GET, HEAD, OPTIONS)만 허용
+	ScopeRead = "read"
+	// ScopeWrite 변경 요청 허용
+	ScopeWrite = "write"
+)
+
+// HasScope 토큰이 scope 권한을 가졌는지 확인 (범위가 없는 토큰은 모든 권한을 가짐)
+func (c *Claims) HasScope(scope string) bool {
+	if c.Scope == "" {
+		return true
+	}
+	for _, s := range strings.Fields(c.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeScope 요청한 권한 범위를 검증하고 정해진 순서로 정리합니다
+// 비어 있으면 read write를 반환하고, write는 read를 포함합니다.
+func NormalizeScope(scope string) (string, error) {
+	fields := strings.Fields(scope)
+	if len(fields) == 0 {
+		return ScopeRead + " " + ScopeWrite, nil
+	}
+	var write bool
+	for _, s := range fields {
+		switch s {
+		case ScopeRead:
+		case ScopeWrite:
+			write = true
+		default:
+			return "", fmt.Errorf("unknown scope: %s", s)
+		}
+	}
+	if write {
+		return ScopeRead + " " + ScopeWrite, nil
+	}
+	return ScopeRead, nil
 }
 
 // NewClaims 새로운 JWT 클레임 생성
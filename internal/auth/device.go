@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 디바이스 코드 흐름 기본값 (RFC 8628)
+const (
+	// DefaultDeviceCodeTTL 사용자가 승인할 수 있는 시간
+	DefaultDeviceCodeTTL = 10 * time.Minute
+	// DefaultDevicePollInterval 클라이언트의 최소 폴링 간격
+	DefaultDevicePollInterval = 5 * time.Second
+	// deviceSlowDownStep 너무 자주 폴링하면 늘리는 간격
+	deviceSlowDownStep = 5 * time.Second
+)
+
+// userCodeAlphabet 사용자 코드 문자 (헷갈리기 쉬운 모음과 숫자 제외)
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+// userCodeLength 구분자를 뺀 사용자 코드 길이
+const userCodeLength = 8
+
+// 디바이스 코드 흐름 오류
+var (
+	ErrDeviceCodeNotFound   = errors.New("device code not found")
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("slow down")
+	ErrAccessDenied         = errors.New("access denied")
+	ErrExpiredToken         = errors.New("device code expired")
+)
+
+// DeviceAuthStatus 디바이스 승인 상태
+type DeviceAuthStatus string
+
+const (
+	DeviceAuthPending  DeviceAuthStatus = "pending"
+	DeviceAuthApproved DeviceAuthStatus = "approved"
+	DeviceAuthDenied   DeviceAuthStatus = "denied"
+)
+
+// DeviceAuthorization 진행 중인 디바이스 승인 요청
+type DeviceAuthorization struct {
+	DeviceCode string           `json:"-"`
+	UserCode   string           `json:"user_code"`
+	ClientName string           `json:"client_name,omitempty"`
+	Scope      string           `json:"scope"`
+	Status     DeviceAuthStatus `json:"status"`
+	ExpiresAt  time.Time        `json:"expires_at"`
+	Interval   time.Duration    `json:"-"`
+
+	// 승인한 사용자 (승인 후 설정)
+	UserID   string `json:"-"`
+	UserName string `json:"-"`
+	Email    string `json:"-"`
+	Role     string `json:"-"`
+
+	lastPoll time.Time
+}
+
+// DeviceAuthorizer 디바이스 코드 흐름의 승인 요청 저장소
+// 요청은 메모리에만 두므로 서버를 재시작하면 진행 중인 로그인을 다시 시작해야 합니다.
+type DeviceAuthorizer struct {
+	mu       sync.Mutex
+	byDevice map[string]*DeviceAuthorization
+	byUser   map[string]*DeviceAuthorization
+	ttl      time.Duration
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewDeviceAuthorizer 새로운 디바이스 승인 저장소 생성
+func NewDeviceAuthorizer(ttl, interval time.Duration) *DeviceAuthorizer {
+	if ttl <= 0 {
+		ttl = DefaultDeviceCodeTTL
+	}
+	if interval <= 0 {
+		interval = DefaultDevicePollInterval
+	}
+	return &DeviceAuthorizer{
+		byDevice: make(map[string]*DeviceAuthorization),
+		byUser:   make(map[string]*DeviceAuthorization),
+		ttl:      ttl,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start 새 승인 요청을 만들고 디바이스 코드와 사용자 코드를 발급
+func (a *DeviceAuthorizer) Start(clientName, scope string) (*DeviceAuthorization, error) {
+	scope, err := NormalizeScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceCode, err := randomDeviceCode()
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	a.removeExpiredLocked(now)
+
+	var userCode string
+	for {
+		userCode, err = randomUserCode()
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := a.byUser[userCode]; !exists {
+			break
+		}
+	}
+
+	authz := &DeviceAuthorization{
+		DeviceCode: deviceCode,
+		UserCode:   userCode,
+		ClientName: clientName,
+		Scope:      scope,
+		Status:     DeviceAuthPending,
+		ExpiresAt:  now.Add(a.ttl),
+		Interval:   a.interval,
+	}
+	a.byDevice[deviceCode] = authz
+	a.byUser[userCode] = authz
+
+	result := *authz
+	return &result, nil
+}
+
+// Lookup 사용자 코드로 대기 중인 승인 요청 조회
+func (a *DeviceAuthorizer) Lookup(userCode string) (*DeviceAuthorization, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	authz, err := a.pendingLocked(userCode)
+	if err != nil {
+		return nil, err
+	}
+	result := *authz
+	return &result, nil
+}
+
+// Approve 로그인한 사용자가 요청을 승인 (클라이언트는 이 사용자로 토큰을 받음)
+func (a *DeviceAuthorizer) Approve(userCode, userID, userName, email, role string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	authz, err := a.pendingLocked(userCode)
+	if err != nil {
+		return err
+	}
+	authz.Status = DeviceAuthApproved
+	authz.UserID = userID
+	authz.UserName = userName
+	authz.Email = email
+	authz.Role = role
+	return nil
+}
+
+// Deny 요청을 거절
+func (a *DeviceAuthorizer) Deny(userCode string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	authz, err := a.pendingLocked(userCode)
+	if err != nil {
+		return err
+	}
+	authz.Status = DeviceAuthDenied
+	return nil
+}
+
+// Poll 클라이언트의 토큰 요청을 처리
+// 승인되었으면 요청을 저장소에서 지우고 반환하므로 토큰은 한 번만 발급됩니다.
+// 아직 승인 전이면 ErrAuthorizationPending을, 폴링 간격보다 자주 요청하면 간격을 늘리고 ErrSlowDown을 반환합니다.
+func (a *DeviceAuthorizer) Poll(deviceCode string) (*DeviceAuthorization, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	authz, exists := a.byDevice[deviceCode]
+	if !exists {
+		return nil, ErrDeviceCodeNotFound
+	}
+
+	now := a.now()
+	if now.After(authz.ExpiresAt) {
+		a.removeLocked(authz)
+		return nil, ErrExpiredToken
+	}
+
+	switch authz.Status {
+	case DeviceAuthApproved:
+		a.removeLocked(authz)
+		result := *authz
+		return &result, nil
+	case DeviceAuthDenied:
+		a.removeLocked(authz)
+		return nil, ErrAccessDenied
+	}
+
+	if !authz.lastPoll.IsZero() && now.Sub(authz.lastPoll) < authz.Interval {
+		authz.Interval += deviceSlowDownStep
+		authz.lastPoll = now
+		return nil, ErrSlowDown
+	}
+	authz.lastPoll = now
+	return nil, ErrAuthorizationPending
+}
+
+// pendingLocked 사용자 코드로 승인 대기 중인 요청을 찾음 (호출자가 잠금을 가져야 함)
+func (a *DeviceAuthorizer) pendingLocked(userCode string) (*DeviceAuthorization, error) {
+	authz, exists := a.byUser[NormalizeUserCode(userCode)]
+	if !exists {
+		return nil, ErrDeviceCodeNotFound
+	}
+	if a.now().After(authz.ExpiresAt) {
+		a.removeLocked(authz)
+		return nil, ErrExpiredToken
+	}
+	if authz.Status != DeviceAuthPending {
+		return nil, ErrDeviceCodeNotFound
+	}
+	return authz, nil
+}
+
+// removeExpiredLocked 만료된 요청 정리 (호출자가 잠금을 가져야 함)
+func (a *DeviceAuthorizer) removeExpiredLocked(now time.Time) {
+	for _, authz := range a.byDevice {
+		if now.After(authz.ExpiresAt) {
+			a.removeLocked(authz)
+		}
+	}
+}
+
+// removeLocked 요청 삭제 (호출자가 잠금을 가져야 함)
+func (a *DeviceAuthorizer) removeLocked(authz *DeviceAuthorization) {
+	delete(a.byDevice, authz.DeviceCode)
+	delete(a.byUser, authz.UserCode)
+}
+
+// NormalizeUserCode 사용자가 입력한 코드를 저장 형식(XXXX-XXXX)으로 변환
+// 대소문자, 공백, 구분자 위치는 구분하지 않습니다.
+func NormalizeUserCode(userCode string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(userCode) {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteRune(r)
+		}
+	}
+	code := b.String()
+	if len(code) != userCodeLength {
+		return code
+	}
+	return code[:userCodeLength/2] + "-" + code[userCodeLength/2:]
+}
+
+// randomUserCode 무작위 사용자 코드 생성
+func randomUserCode() (string, error) {
+	max := big.NewInt(int64(len(userCodeAlphabet)))
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate user code: %w", err)
+		}
+		code[i] = userCodeAlphabet[n.Int64()]
+	}
+	return NormalizeUserCode(string(code)), nil
+}
+
+// randomDeviceCode 클라이언트만 아는 무작위 디바이스 코드 생성
+func randomDeviceCode() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate device code: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
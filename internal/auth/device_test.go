@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeScope(t *testing.T) {
+	scope, err := NormalizeScope("")
+	require.NoError(t, err)
+	assert.Equal(t, "read write", scope)
+
+	scope, err = NormalizeScope("write")
+	require.NoError(t, err)
+	assert.Equal(t, "read write", scope)
+
+	scope, err = NormalizeScope(" read ")
+	require.NoError(t, err)
+	assert.Equal(t, "read", scope)
+
+	_, err = NormalizeScope("read admin")
+	assert.Error(t, err)
+
+	assert.True(t, (&Claims{}).HasScope(ScopeWrite))
+	assert.False(t, (&Claims{Scope: "read"}).HasScope(ScopeWrite))
+	assert.True(t, (&Claims{Scope: "read write"}).HasScope(ScopeWrite))
+}
+
+func TestNormalizeUserCode(t *testing.T) {
+	assert.Equal(t, "BCDF-GHJK", NormalizeUserCode("bcdf ghjk"))
+	assert.Equal(t, "BCDF-GHJK", NormalizeUserCode("BCDFGHJK"))
+	assert.Equal(t, "BCD", NormalizeUserCode("b-c-d"))
+}
+
+func TestDeviceAuthorizer_Approve(t *testing.T) {
+	now := time.Now()
+	a := NewDeviceAuthorizer(time.Minute, 5*time.Second)
+	a.now = func() time.Time { return now }
+
+	authz, err := a.Start("aicli on laptop", "read")
+	require.NoError(t, err)
+	assert.Regexp(t, `^[A-Z]{4}-[A-Z]{4}$`, authz.UserCode)
+	assert.NotEmpty(t, authz.DeviceCode)
+	assert.Equal(t, "read", authz.Scope)
+
+	_, err = a.Poll(authz.DeviceCode)
+	assert.ErrorIs(t, err, ErrAuthorizationPending)
+
+	// 간격보다 자주 폴링하면 간격을 늘림
+	_, err = a.Poll(authz.DeviceCode)
+	assert.ErrorIs(t, err, ErrSlowDown)
+
+	found, err := a.Lookup(authz.UserCode)
+	require.NoError(t, err)
+	assert.Equal(t, "aicli on laptop", found.ClientName)
+	assert.Equal(t, 10*time.Second, found.Interval)
+
+	require.NoError(t, a.Approve(authz.UserCode, "user-1", "alice", "alice@example.com", "admin"))
+	assert.ErrorIs(t, a.Approve(authz.UserCode, "user-2", "bob", "", "user"), ErrDeviceCodeNotFound)
+
+	approved, err := a.Poll(authz.DeviceCode)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", approved.UserID)
+	assert.Equal(t, "read", approved.Scope)
+
+	// 토큰은 한 번만 발급
+	_, err = a.Poll(authz.DeviceCode)
+	assert.ErrorIs(t, err, ErrDeviceCodeNotFound)
+}
+
+func TestDeviceAuthorizer_DenyAndExpire(t *testing.T) {
+	now := time.Now()
+	a := NewDeviceAuthorizer(time.Minute, time.Second)
+	a.now = func() time.Time { return now }
+
+	denied, err := a.Start("", "")
+	require.NoError(t, err)
+	require.NoError(t, a.Deny(denied.UserCode))
+	_, err = a.Poll(denied.DeviceCode)
+	assert.ErrorIs(t, err, ErrAccessDenied)
+
+	expired, err := a.Start("", "")
+	require.NoError(t, err)
+	now = now.Add(2 * time.Minute)
+	_, err = a.Lookup(expired.UserCode)
+	assert.ErrorIs(t, err, ErrExpiredToken)
+	_, err = a.Poll(expired.DeviceCode)
+	assert.ErrorIs(t, err, ErrDeviceCodeNotFound)
+
+	_, err = a.Start("", "admin")
+	assert.Error(t, err)
+}
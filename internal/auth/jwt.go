@@ -35,6 +35,11 @@ func NewJWTManager(secretKey string, accessExpiry, refreshExpiry time.Duration)
 
 // GenerateToken 토큰 생성
 func (m *JWTManager) GenerateToken(userID, userName, email, role string, tokenType TokenType) (string, error) {
+	return m.GenerateScopedToken(userID, userName, email, role, "", tokenType)
+}
+
+// GenerateScopedToken 권한 범위를 제한한 토큰 생성 (scope가 비어 있으면 GenerateToken과 같음)
+func (m *JWTManager) GenerateScopedToken(userID, userName, email, role, scope string, tokenType TokenType) (string, error) {
 	var expirationTime time.Time
 	
 	switch tokenType {
@@ -48,6 +53,7 @@ func (m *JWTManager) GenerateToken(userID, userName, email, role string, tokenTy
 
 	// 클레임 생성
 	claims := NewClaims(userID, userName, email, role, expirationTime)
+	claims.Scope = scope
 	
 	// 토큰 생성
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -93,8 +99,8 @@ func (m *JWTManager) RefreshAccessToken(refreshTokenString string) (string, erro
 		return "", fmt.Errorf("invalid refresh token: %w", err)
 	}
 	
-	// 새로운 액세스 토큰 생성
-	newAccessToken, err := m.GenerateToken(claims.UserID, claims.UserName, claims.Email, claims.Role, AccessToken)
+	// 새로운 액세스 토큰 생성 (리프레시 토큰의 권한 범위 유지)
+	newAccessToken, err := m.GenerateScopedToken(claims.UserID, claims.UserName, claims.Email, claims.Role, claims.Scope, AccessToken)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate new access token: %w", err)
 	}
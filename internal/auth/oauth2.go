@@ -3,12 +3,16 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/github"
 	"golang.org/x/oauth2/google"
+
+	"github.com/aicli/aicli-web/internal/breaker"
 )
 
 // OAuthProvider OAuth 제공자 타입
@@ -66,6 +70,7 @@ type OAuthManagerImpl struct {
 	configs    map[OAuthProvider]*OAuthConfig
 	jwtManager *JWTManager
 	stateStore map[string]time.Time // 실제 환경에서는 Redis나 DB 사용
+	breakers   *breaker.Registry    // 제공자별 회로 차단기 (nil이면 사용 안 함)
 }
 
 // NewOAuthManager 새로운 OAuth 매니저 생성
@@ -77,6 +82,12 @@ func NewOAuthManager(configs map[OAuthProvider]*OAuthConfig, jwtManager *JWTMana
 	}
 }
 
+// SetBreakers 제공자별 회로 차단기 설정
+// 회로가 열린 제공자의 토큰 교환, 사용자 정보 조회, 토큰 갱신은 요청하지 않고 breaker.ErrOpen으로 바로 실패합니다.
+func (m *OAuthManagerImpl) SetBreakers(breakers *breaker.Registry) {
+	m.breakers = breakers
+}
+
 // GetAuthURL 인증 URL 생성
 func (m *OAuthManagerImpl) GetAuthURL(provider OAuthProvider, state string) (string, error) {
 	config, err := m.getOAuthConfig(provider)
@@ -107,7 +118,12 @@ func (m *OAuthManagerImpl) ExchangeCode(provider OAuthProvider, code, state stri
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	
+	done, err := m.providerBreaker(provider).Allow(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code for token: %w", err)
+	}
 	token, err := config.Exchange(ctx, code)
+	done(err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange code for token: %w", err)
 	}
@@ -140,15 +156,27 @@ func (m *OAuthManagerImpl) GetUserInfo(provider OAuthProvider, token *oauth2.Tok
 		return nil, fmt.Errorf("unsupported provider: %s", provider)
 	}
 	
+	done, err := m.providerBreaker(provider).Allow(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
 	resp, err := client.Get(userInfoURL)
 	if err != nil {
+		done(err)
 		return nil, fmt.Errorf("failed to get user info: %w", err)
 	}
 	defer resp.Body.Close()
 	
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get user info: status %d", resp.StatusCode)
+		err := fmt.Errorf("failed to get user info: status %d", resp.StatusCode)
+		if resp.StatusCode >= http.StatusInternalServerError {
+			done(err)
+		} else {
+			done(nil)
+		}
+		return nil, err
 	}
+	done(nil)
 	
 	var rawUserInfo map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&rawUserInfo); err != nil {
@@ -171,7 +199,12 @@ func (m *OAuthManagerImpl) RefreshToken(provider OAuthProvider, refreshToken str
 	token := &oauth2.Token{RefreshToken: refreshToken}
 	tokenSource := config.TokenSource(ctx, token)
 	
+	done, err := m.providerBreaker(provider).Allow(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
 	newToken, err := tokenSource.Token()
+	done(err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh token: %w", err)
 	}
@@ -179,6 +212,21 @@ func (m *OAuthManagerImpl) RefreshToken(provider OAuthProvider, refreshToken str
 	return newToken, nil
 }
 
+// providerBreaker 제공자의 회로 차단기 (회로 차단기를 설정하지 않았으면 nil)
+func (m *OAuthManagerImpl) providerBreaker(provider OAuthProvider) *breaker.Breaker {
+	return m.breakers.GetWith("oauth:"+string(provider), isOAuthProviderFailure)
+}
+
+// isOAuthProviderFailure 제공자 장애로 볼 에러인지 판단
+// 토큰 엔드포인트의 4xx 응답(잘못된 코드, 만료된 갱신 토큰)은 사용자 요청 문제이므로 제외합니다.
+func isOAuthProviderFailure(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.Response != nil {
+		return retrieveErr.Response.StatusCode >= http.StatusInternalServerError
+	}
+	return err != nil
+}
+
 // ValidateState state 파라미터 검증
 func (m *OAuthManagerImpl) ValidateState(state string) bool {
 	expiry, exists := m.stateStore[state]
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPasswordHasher_HashAndVerify(t *testing.T) {
+	hasher := NewPasswordHasher(Argon2Params{Memory: 1024, Iterations: 1})
+
+	hash, err := hasher.Hash("Correct-Horse-1")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	other, err := hasher.Hash("Correct-Horse-1")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other, "솔트가 달라야 함")
+
+	match, needsRehash, err := hasher.Verify("Correct-Horse-1", hash)
+	require.NoError(t, err)
+	assert.True(t, match)
+	assert.False(t, needsRehash)
+
+	match, _, err = hasher.Verify("wrong", hash)
+	require.NoError(t, err)
+	assert.False(t, match)
+
+	_, _, err = hasher.Verify("Correct-Horse-1", "$argon2id$broken")
+	assert.ErrorIs(t, err, ErrInvalidPasswordHash)
+}
+
+func TestPasswordHasher_NeedsRehash(t *testing.T) {
+	old := NewPasswordHasher(Argon2Params{Memory: 1024, Iterations: 1})
+	hash, err := old.Hash("Correct-Horse-1")
+	require.NoError(t, err)
+
+	// 파라미터를 올리면 기존 해시도 검증되지만 다시 해시해야 함
+	current := NewPasswordHasher(Argon2Params{Memory: 2048, Iterations: 1})
+	match, needsRehash, err := current.Verify("Correct-Horse-1", hash)
+	require.NoError(t, err)
+	assert.True(t, match)
+	assert.True(t, needsRehash)
+
+	// 이전 bcrypt 해시도 검증하고 argon2id로 옮기도록 표시
+	legacy, err := bcrypt.GenerateFromPassword([]byte("Correct-Horse-1"), bcrypt.MinCost)
+	require.NoError(t, err)
+	match, needsRehash, err = current.Verify("Correct-Horse-1", string(legacy))
+	require.NoError(t, err)
+	assert.True(t, match)
+	assert.True(t, needsRehash)
+
+	match, _, err = current.Verify("wrong", string(legacy))
+	require.NoError(t, err)
+	assert.False(t, match)
+}
@@ -0,0 +1,67 @@
+package backup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/artifact"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// NewManagerFromConfig 애플리케이션 설정으로 백업 관리자를 구성합니다.
+// store가 nil이면 메타데이터 소스는 제외됩니다 (CLI 복원 등).
+func NewManagerFromConfig(cfg *config.Config, store storage.Storage) (*Manager, error) {
+	target, err := NewTargetFromConfig(cfg.Backup)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []Source
+
+	// 스토리지 백엔드 파일
+	dataSource := cfg.Storage.DataSource
+	if i := strings.Index(dataSource, "?"); i >= 0 {
+		dataSource = dataSource[:i]
+	}
+	if dataSource != "" && dataSource != ":memory:" {
+		switch cfg.Storage.Type {
+		case "sqlite":
+			sources = append(sources, NewSQLiteSource(dataSource))
+		case "boltdb":
+			sources = append(sources, NewBoltSource(dataSource))
+		}
+	}
+
+	// 설정 디렉토리의 시크릿 파일 (API 키, OAuth 비밀 등)
+	sources = append(sources, NewFilesSource("secrets.tar", config.GetConfigDir(), "config.yaml", "*.key", "*.pem"))
+
+	if store != nil {
+		sources = append(sources, NewMetadataSource(store))
+	}
+
+	return NewManager(Config{
+		Interval:  cfg.Backup.Interval,
+		Retention: cfg.Backup.Retention,
+	}, target, sources...), nil
+}
+
+// NewTargetFromConfig 백업 설정에 맞는 저장소를 생성합니다
+func NewTargetFromConfig(cfg config.BackupConfig) (Target, error) {
+	switch cfg.Destination {
+	case "", "local":
+		dir := cfg.LocalDir
+		if dir == "" {
+			dir = config.GetDefaultConfig().Backup.LocalDir
+		}
+		return NewLocalTarget(dir), nil
+	case "s3":
+		store, err := artifact.NewS3Store(artifact.S3ConfigFrom(cfg.S3))
+		if err != nil {
+			return nil, err
+		}
+		return NewStoreTarget(store), nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 백업 저장소: %s", cfg.Destination)
+	}
+}
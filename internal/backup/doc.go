@@ -0,0 +1,7 @@
+// Package backup은 스토리지 백엔드, 시크릿 파일, 워크스페이스 메타데이터의
+// 스냅샷을 생성하고 검증/복원하는 백업 관리 기능을 제공합니다.
+//
+// 스냅샷은 tar.gz 아카이브이며 각 항목의 SHA-256 체크섬이 기록된 manifest.json을
+// 포함합니다. 아카이브 전체의 체크섬은 "<id>.sha256" 파일로 함께 저장되어
+// 복원 전에 무결성을 검증합니다.
+package backup
@@ -0,0 +1,588 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/cluster"
+)
+
+// FormatVersion 백업 아카이브 포맷 버전
+const FormatVersion = 1
+
+const (
+	snapshotPrefix   = "aicli-backup-"
+	snapshotSuffix   = ".tar.gz"
+	checksumSuffix   = ".sha256"
+	manifestName     = "manifest.json"
+	snapshotIDLayout = "20060102T150405.000Z"
+	snapshotIDSuffix = 4 // 같은 시각에 생성된 스냅샷을 구분하는 임의 바이트 수
+)
+
+var (
+	// ErrBackupInProgress 다른 백업이 진행 중
+	ErrBackupInProgress = errors.New("backup already in progress")
+
+	// ErrChecksumMismatch 체크섬 불일치
+	ErrChecksumMismatch = errors.New("backup checksum mismatch")
+
+	// ErrInvalidSnapshotID 잘못된 스냅샷 ID
+	ErrInvalidSnapshotID = errors.New("invalid snapshot id")
+
+	// ErrSourceInUse 복원 대상이 실행 중인 서버에서 사용 중
+	ErrSourceInUse = errors.New("backup source in use")
+)
+
+// Config 백업 관리자 설정
+type Config struct {
+	// Interval 주기적 백업 간격 (0이면 스케줄 비활성화)
+	Interval time.Duration
+
+	// Retention 보관할 스냅샷 수 (0이면 모두 보관)
+	Retention int
+}
+
+// DefaultConfig 기본 백업 설정
+func DefaultConfig() Config {
+	return Config{
+		Interval:  24 * time.Hour,
+		Retention: 7,
+	}
+}
+
+// ManifestEntry 아카이브 항목 정보
+type ManifestEntry struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest 스냅샷 매니페스트
+type Manifest struct {
+	FormatVersion int             `json:"format_version"`
+	ID            string          `json:"id"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Entries       []ManifestEntry `json:"entries"`
+}
+
+// Snapshot 저장된 스냅샷 정보
+type Snapshot struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Size      int64     `json:"size"`
+	SHA256    string    `json:"sha256,omitempty"`
+	Entries   []string  `json:"entries,omitempty"`
+}
+
+// RestoreOptions 복원 옵션
+type RestoreOptions struct {
+	// Only 복원할 항목 이름 (비어 있으면 전체)
+	Only []string
+}
+
+// Manager 백업 관리자
+type Manager struct {
+	config  Config
+	target  Target
+	sources []Source
+
+	mu      sync.Mutex
+	running bool
+	guard   cluster.Guard
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewManager 새 백업 관리자 생성
+func NewManager(config Config, target Target, sources ...Source) *Manager {
+	return &Manager{
+		config:  config,
+		target:  target,
+		sources: sources,
+	}
+}
+
+// SetGuard 여러 인스턴스가 저장소를 공유할 때 백업 생성을 분산 잠금으로 감쌉니다
+func (m *Manager) SetGuard(guard cluster.Guard) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.guard = guard
+}
+
+// Create 모든 소스의 스냅샷을 생성하여 저장소에 업로드합니다
+// 다른 인스턴스가 백업 중이면 ErrBackupInProgress를 반환합니다.
+func (m *Manager) Create(ctx context.Context) (*Snapshot, error) {
+	m.mu.Lock()
+	if m.running {
+		m.mu.Unlock()
+		return nil, ErrBackupInProgress
+	}
+	m.running = true
+	guard := m.guard
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.running = false
+		m.mu.Unlock()
+	}()
+
+	if guard == nil {
+		return m.create(ctx)
+	}
+
+	var snapshot *Snapshot
+	err := guard.WithLock(ctx, "backup", func(ctx context.Context) error {
+		var err error
+		snapshot, err = m.create(ctx)
+		return err
+	})
+	if errors.Is(err, cluster.ErrLocked) {
+		return nil, ErrBackupInProgress
+	}
+	return snapshot, err
+}
+
+// create 스냅샷 생성
+func (m *Manager) create(ctx context.Context) (*Snapshot, error) {
+	createdAt := time.Now().UTC()
+	id, err := newSnapshotID(createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := os.CreateTemp("", snapshotPrefix+"*"+snapshotSuffix)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	archiveHash := sha256.New()
+	manifest, err := m.writeArchive(ctx, io.MultiWriter(archive, archiveHash), id, createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := archive.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	checksum := hex.EncodeToString(archiveHash.Sum(nil))
+	if err := m.target.Put(ctx, archiveName(id), archive, size); err != nil {
+		return nil, fmt.Errorf("백업 업로드 실패: %w", err)
+	}
+	if err := m.target.Put(ctx, archiveName(id)+checksumSuffix, strings.NewReader(checksum), int64(len(checksum))); err != nil {
+		return nil, fmt.Errorf("체크섬 업로드 실패: %w", err)
+	}
+
+	snapshot := &Snapshot{ID: id, CreatedAt: createdAt, Size: size, SHA256: checksum}
+	for _, entry := range manifest.Entries {
+		snapshot.Entries = append(snapshot.Entries, entry.Name)
+	}
+
+	if err := m.prune(ctx); err != nil {
+		log.Printf("오래된 백업 정리 실패: %v", err)
+	}
+	return snapshot, nil
+}
+
+// writeArchive 소스별 스냅샷을 tar.gz로 기록하고 매니페스트를 마지막에 추가
+func (m *Manager) writeArchive(ctx context.Context, w io.Writer, id string, createdAt time.Time) (*Manifest, error) {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	manifest := &Manifest{FormatVersion: FormatVersion, ID: id, CreatedAt: createdAt}
+	for _, source := range m.sources {
+		entry, err := writeSourceEntry(ctx, tw, source)
+		if err != nil {
+			return nil, fmt.Errorf("%s 스냅샷 실패: %w", source.Name(), err)
+		}
+		manifest.Entries = append(manifest.Entries, *entry)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeTarEntry(tw, manifestName, data); err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, gz.Close()
+}
+
+// writeSourceEntry 소스를 임시 파일로 스냅샷한 뒤 크기와 체크섬을 계산하여 tar에 추가
+func writeSourceEntry(ctx context.Context, tw *tar.Writer, source Source) (*ManifestEntry, error) {
+	tmp, err := os.CreateTemp("", "aicli-backup-entry-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	if err := source.Snapshot(ctx, io.MultiWriter(tmp, hash)); err != nil {
+		return nil, err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	header := &tar.Header{Name: source.Name(), Mode: 0600, Size: size, ModTime: time.Now()}
+	if err := tw.WriteHeader(header); err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(tw, tmp); err != nil {
+		return nil, err
+	}
+
+	return &ManifestEntry{Name: source.Name(), Size: size, SHA256: hex.EncodeToString(hash.Sum(nil))}, nil
+}
+
+// List 저장된 스냅샷 목록 (최신순)
+func (m *Manager) List(ctx context.Context) ([]Snapshot, error) {
+	objects, err := m.target.List(ctx, snapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	for i := len(objects) - 1; i >= 0; i-- {
+		obj := objects[i]
+		if !strings.HasSuffix(obj.Name, snapshotSuffix) {
+			continue
+		}
+		id := strings.TrimSuffix(strings.TrimPrefix(obj.Name, snapshotPrefix), snapshotSuffix)
+		createdAt, err := parseSnapshotID(id)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, Snapshot{ID: id, CreatedAt: createdAt, Size: obj.Size})
+	}
+	return snapshots, nil
+}
+
+// Verify 스냅샷의 아카이브 체크섬과 항목별 체크섬을 검증합니다
+func (m *Manager) Verify(ctx context.Context, id string) (*Manifest, error) {
+	path, manifest, err := m.fetch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	os.Remove(path)
+	return manifest, nil
+}
+
+// Restore 스냅샷을 검증한 뒤 각 소스에 복원합니다
+// 실행 중인 서버가 사용 중인 소스가 있으면 아무것도 덮어쓰지 않고 ErrSourceInUse를 반환합니다.
+func (m *Manager) Restore(ctx context.Context, id string, opts RestoreOptions) ([]string, error) {
+	path, manifest, err := m.fetch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(path)
+
+	sources := make(map[string]Source, len(m.sources))
+	for _, source := range m.sources {
+		if len(opts.Only) == 0 || contains(opts.Only, source.Name()) {
+			sources[source.Name()] = source
+		}
+	}
+
+	for name, source := range sources {
+		checker, ok := source.(restoreChecker)
+		if !ok {
+			continue
+		}
+		if err := checker.CheckRestore(ctx); err != nil {
+			return nil, fmt.Errorf("%s 복원 불가: %w", name, err)
+		}
+	}
+
+	var restored []string
+	err = readArchive(path, func(name string, r io.Reader) error {
+		source, ok := sources[name]
+		if !ok {
+			return nil
+		}
+		if err := source.Restore(ctx, r); err != nil {
+			return fmt.Errorf("%s 복원 실패: %w", name, err)
+		}
+		restored = append(restored, name)
+		return nil
+	})
+	if err != nil {
+		return restored, err
+	}
+
+	log.Printf("백업 %s 복원 완료: %v (항목 %d개 중)", manifest.ID, restored, len(manifest.Entries))
+	return restored, nil
+}
+
+// fetch 스냅샷을 임시 파일로 내려받고 무결성을 검증합니다
+func (m *Manager) fetch(ctx context.Context, id string) (string, *Manifest, error) {
+	if _, err := parseSnapshotID(id); err != nil {
+		return "", nil, err
+	}
+
+	expected, err := m.readChecksum(ctx, id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	r, err := m.target.Get(ctx, archiveName(id))
+	if err != nil {
+		return "", nil, err
+	}
+	defer r.Close()
+
+	tmp, err := os.CreateTemp("", snapshotPrefix+"restore-")
+	if err != nil {
+		return "", nil, err
+	}
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, hash), r)
+	tmp.Close()
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", nil, err
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != expected {
+		os.Remove(tmp.Name())
+		return "", nil, fmt.Errorf("%w: 아카이브", ErrChecksumMismatch)
+	}
+
+	manifest, err := verifyEntries(tmp.Name())
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", nil, err
+	}
+	return tmp.Name(), manifest, nil
+}
+
+// readChecksum 저장된 아카이브 체크섬 읽기
+func (m *Manager) readChecksum(ctx context.Context, id string) (string, error) {
+	r, err := m.target.Get(ctx, archiveName(id)+checksumSuffix)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(io.LimitReader(r, 128))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// verifyEntries 매니페스트와 각 항목의 체크섬을 비교합니다
+func verifyEntries(path string) (*Manifest, error) {
+	sums := make(map[string]string)
+	var manifest *Manifest
+
+	err := readArchive(path, func(name string, r io.Reader) error {
+		if name == manifestName {
+			manifest = &Manifest{}
+			return json.NewDecoder(r).Decode(manifest)
+		}
+		hash := sha256.New()
+		if _, err := io.Copy(hash, r); err != nil {
+			return err
+		}
+		sums[name] = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("매니페스트가 없는 백업입니다")
+	}
+	if manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("지원하지 않는 백업 버전입니다: %d", manifest.FormatVersion)
+	}
+
+	for _, entry := range manifest.Entries {
+		if sums[entry.Name] != entry.SHA256 {
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, entry.Name)
+		}
+	}
+	return manifest, nil
+}
+
+// readArchive tar.gz 항목을 순서대로 읽습니다
+func readArchive(path string, fn func(name string, r io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(header.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// prune 보관 개수를 초과한 오래된 스냅샷 삭제
+func (m *Manager) prune(ctx context.Context) error {
+	if m.config.Retention <= 0 {
+		return nil
+	}
+
+	snapshots, err := m.List(ctx)
+	if err != nil {
+		return err
+	}
+	for i := m.config.Retention; i < len(snapshots); i++ {
+		name := archiveName(snapshots[i].ID)
+		if err := m.target.Delete(ctx, name); err != nil {
+			return err
+		}
+		if err := m.target.Delete(ctx, name+checksumSuffix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start 주기적 백업 스케줄러 시작
+func (m *Manager) Start(ctx context.Context) {
+	if m.config.Interval <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	if m.stop != nil {
+		m.mu.Unlock()
+		return
+	}
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+	stop, done := m.stop, m.done
+	m.mu.Unlock()
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if snapshot, err := m.Create(ctx); err != nil {
+					log.Printf("예약 백업 실패: %v", err)
+				} else {
+					log.Printf("예약 백업 완료: %s (%d bytes)", snapshot.ID, snapshot.Size)
+				}
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop 스케줄러 중지
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	stop, done := m.stop, m.done
+	m.stop, m.done = nil, nil
+	m.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+func writeTarEntry(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), ModTime: time.Now()}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// newSnapshotID 생성 시각과 임의 접미사로 스냅샷 ID 생성
+// 여러 인스턴스가 같은 저장소에 동시에 백업해도 서로 덮어쓰지 않습니다.
+func newSnapshotID(createdAt time.Time) (string, error) {
+	suffix := make([]byte, snapshotIDSuffix)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("스냅샷 ID 생성 실패: %w", err)
+	}
+	return createdAt.Format(snapshotIDLayout) + "-" + hex.EncodeToString(suffix), nil
+}
+
+// parseSnapshotID 스냅샷 ID에서 생성 시각 추출
+// 접미사가 없는 이전 형식의 ID도 허용합니다.
+func parseSnapshotID(id string) (time.Time, error) {
+	stamp, suffix, found := strings.Cut(id, "-")
+	if found {
+		if len(suffix) != hex.EncodedLen(snapshotIDSuffix) {
+			return time.Time{}, ErrInvalidSnapshotID
+		}
+		if _, err := hex.DecodeString(suffix); err != nil {
+			return time.Time{}, ErrInvalidSnapshotID
+		}
+	}
+	createdAt, err := time.Parse(snapshotIDLayout, stamp)
+	if err != nil {
+		return time.Time{}, ErrInvalidSnapshotID
+	}
+	return createdAt, nil
+}
+
+func archiveName(id string) string {
+	return snapshotPrefix + id + snapshotSuffix
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/cluster"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestManager_CreateVerifyRestore(t *testing.T) {
+	ctx := context.Background()
+	secretsDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(secretsDir, "config.yaml"), []byte("api:\n  jwt_secret: s3cr3t\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(secretsDir, "ignored.txt"), []byte("x"), 0600))
+
+	store := memory.New()
+	workspace := &models.Workspace{Name: "ws", ProjectPath: "/tmp/ws", OwnerID: "user-1", Status: models.WorkspaceStatusActive}
+	require.NoError(t, store.Workspace().Create(ctx, workspace))
+
+	target := NewLocalTarget(t.TempDir())
+	manager := NewManager(Config{}, target,
+		NewFilesSource("secrets.tar", secretsDir, "config.yaml"),
+		NewMetadataSource(store),
+	)
+
+	snapshot, err := manager.Create(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"secrets.tar", "metadata.json"}, snapshot.Entries)
+	assert.NotEmpty(t, snapshot.SHA256)
+
+	manifest, err := manager.Verify(ctx, snapshot.ID)
+	require.NoError(t, err)
+	assert.Len(t, manifest.Entries, 2)
+
+	// 원본을 지운 뒤 새 스토리지로 복원
+	require.NoError(t, os.Remove(filepath.Join(secretsDir, "config.yaml")))
+	restoreStore := memory.New()
+	restoreManager := NewManager(Config{}, target,
+		NewFilesSource("secrets.tar", secretsDir, "config.yaml"),
+		NewMetadataSource(restoreStore),
+	)
+
+	restored, err := restoreManager.Restore(ctx, snapshot.ID, RestoreOptions{})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"secrets.tar", "metadata.json"}, restored)
+
+	data, err := os.ReadFile(filepath.Join(secretsDir, "config.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "s3cr3t")
+
+	got, err := restoreStore.Workspace().GetByID(ctx, workspace.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "ws", got.Name)
+}
+
+func TestManager_VerifyDetectsCorruption(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	secretsDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(secretsDir, "config.yaml"), []byte("a: b\n"), 0600))
+
+	manager := NewManager(Config{}, NewLocalTarget(dir), NewFilesSource("secrets.tar", secretsDir, "config.yaml"))
+	snapshot, err := manager.Create(ctx)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, archiveName(snapshot.ID))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	_, err = manager.Verify(ctx, snapshot.ID)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+
+	_, err = manager.Restore(ctx, snapshot.ID, RestoreOptions{})
+	assert.Error(t, err)
+}
+
+func TestManager_Retention(t *testing.T) {
+	ctx := context.Background()
+	manager := NewManager(Config{Retention: 2}, NewLocalTarget(t.TempDir()), NewFilesSource("secrets.tar", t.TempDir()))
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		snapshot, err := manager.Create(ctx)
+		require.NoError(t, err)
+		ids = append(ids, snapshot.ID)
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	snapshots, err := manager.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assert.Equal(t, ids[2], snapshots[0].ID)
+	assert.Equal(t, ids[1], snapshots[1].ID)
+}
+
+func TestManager_InvalidSnapshotID(t *testing.T) {
+	manager := NewManager(Config{}, NewLocalTarget(t.TempDir()))
+
+	_, err := manager.Verify(context.Background(), "../../etc/passwd")
+	assert.ErrorIs(t, err, ErrInvalidSnapshotID)
+}
+
+func TestSnapshotID(t *testing.T) {
+	createdAt := time.Date(2026, 10, 15, 3, 0, 0, 0, time.UTC)
+
+	// 같은 시각에 생성해도 ID가 겹치지 않음
+	a, err := newSnapshotID(createdAt)
+	require.NoError(t, err)
+	b, err := newSnapshotID(createdAt)
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b)
+
+	parsed, err := parseSnapshotID(a)
+	require.NoError(t, err)
+	assert.True(t, createdAt.Equal(parsed))
+
+	// 접미사가 없는 이전 형식
+	parsed, err = parseSnapshotID("20261015T030000.000Z")
+	require.NoError(t, err)
+	assert.True(t, createdAt.Equal(parsed))
+
+	for _, id := range []string{"20261015T030000.000Z-", "20261015T030000.000Z-zzzzzzzz", "20261015T030000.000Z-1a2b/../x", "latest"} {
+		_, err := parseSnapshotID(id)
+		assert.ErrorIs(t, err, ErrInvalidSnapshotID, id)
+	}
+}
+
+func TestSQLiteSource_RestoreRequiresStoppedServer(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "aicli.db")
+
+	live, err := sql.Open("sqlite3", path+"?_journal_mode=WAL")
+	require.NoError(t, err)
+	_, err = live.Exec("CREATE TABLE items (name TEXT); INSERT INTO items VALUES ('live')")
+	require.NoError(t, err)
+
+	source := NewSQLiteSource(path)
+	var snapshot bytes.Buffer
+	require.NoError(t, source.Snapshot(ctx, &snapshot))
+
+	// 서버 연결이 열려 있으면 파일을 교체하지 않음
+	err = source.Restore(ctx, bytes.NewReader(snapshot.Bytes()))
+	assert.ErrorIs(t, err, ErrSourceInUse)
+
+	_, err = live.Exec("INSERT INTO items VALUES ('after')")
+	require.NoError(t, err)
+	require.NoError(t, live.Close())
+
+	require.NoError(t, source.Restore(ctx, bytes.NewReader(snapshot.Bytes())))
+	assert.NoFileExists(t, path+"-wal")
+
+	restored, err := sql.Open("sqlite3", path)
+	require.NoError(t, err)
+	defer restored.Close()
+	var count int
+	require.NoError(t, restored.QueryRow("SELECT COUNT(*) FROM items").Scan(&count))
+	assert.Equal(t, 1, count)
+}
+
+func TestManager_GuardedCreate(t *testing.T) {
+	ctx := context.Background()
+	target := NewLocalTarget(t.TempDir())
+	locker := cluster.NewMemoryLocker()
+
+	newGuard := func(instanceID string) *cluster.Cluster {
+		c, err := cluster.New(locker, cluster.Config{InstanceID: instanceID}, nil)
+		require.NoError(t, err)
+		return c
+	}
+	a := NewManager(Config{}, target, NewMetadataSource(memory.New()))
+	a.SetGuard(newGuard("node-a"))
+	b := NewManager(Config{}, target, NewMetadataSource(memory.New()))
+	b.SetGuard(newGuard("node-b"))
+
+	// 다른 인스턴스가 백업 중이면 거부
+	require.NoError(t, newGuard("node-a").WithLock(ctx, "backup", func(ctx context.Context) error {
+		_, err := b.Create(ctx)
+		assert.ErrorIs(t, err, ErrBackupInProgress)
+		return nil
+	}))
+
+	_, err := a.Create(ctx)
+	require.NoError(t, err)
+	_, err = b.Create(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,361 @@
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"go.etcd.io/bbolt"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// Source 백업 대상 데이터 소스
+type Source interface {
+	// Name 아카이브 항목 이름
+	Name() string
+
+	// Snapshot 현재 상태를 w에 기록합니다
+	Snapshot(ctx context.Context, w io.Writer) error
+
+	// Restore r의 데이터로 상태를 복원합니다
+	Restore(ctx context.Context, r io.Reader) error
+}
+
+// restoreChecker 복원 전에 대상을 안전하게 교체할 수 있는지 확인하는 소스
+type restoreChecker interface {
+	CheckRestore(ctx context.Context) error
+}
+
+// SQLiteSource SQLite 데이터베이스 파일 백업 소스
+// VACUUM INTO를 사용하므로 서버가 실행 중이어도 일관된 스냅샷을 얻을 수 있습니다.
+type SQLiteSource struct {
+	path string
+}
+
+// NewSQLiteSource 새 SQLite 백업 소스 생성
+func NewSQLiteSource(path string) *SQLiteSource {
+	return &SQLiteSource{path: path}
+}
+
+// Name 항목 이름
+func (s *SQLiteSource) Name() string { return "storage.sqlite" }
+
+// Snapshot 데이터베이스 스냅샷 생성
+func (s *SQLiteSource) Snapshot(ctx context.Context, w io.Writer) error {
+	db, err := sql.Open("sqlite3", s.path+"?mode=ro")
+	if err != nil {
+		return fmt.Errorf("SQLite 열기 실패: %w", err)
+	}
+	defer db.Close()
+
+	tmp, err := os.MkdirTemp("", "aicli-sqlite-backup-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	snapshot := filepath.Join(tmp, "snapshot.db")
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", snapshot); err != nil {
+		return fmt.Errorf("SQLite 스냅샷 실패: %w", err)
+	}
+	return copyFileTo(w, snapshot)
+}
+
+// CheckRestore 다른 프로세스가 데이터베이스를 열고 있지 않은지 확인
+// 배타 잠금 모드로 연결하면 실행 중인 서버의 연결이 남아 있는 동안 잠금에 실패합니다.
+// 확인하면서 WAL을 본 파일에 체크포인트하므로 교체 후 이전 WAL이 새 파일에 재생되지 않습니다.
+func (s *SQLiteSource) CheckRestore(ctx context.Context) error {
+	if _, err := os.Stat(s.path); os.IsNotExist(err) {
+		return nil
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+s.path+"?_locking_mode=EXCLUSIVE&_busy_timeout=0")
+	if err != nil {
+		return fmt.Errorf("SQLite 열기 실패: %w", err)
+	}
+	defer db.Close()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return sqliteLockError(err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "BEGIN EXCLUSIVE"); err != nil {
+		return sqliteLockError(err)
+	}
+	if _, err := conn.ExecContext(ctx, "COMMIT"); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+		return fmt.Errorf("SQLite 체크포인트 실패: %w", err)
+	}
+	return nil
+}
+
+// Restore 데이터베이스 파일 교체
+// 서버를 중지한 상태에서 호출해야 하며, 남아 있는 WAL/SHM 파일은 함께 제거합니다.
+func (s *SQLiteSource) Restore(ctx context.Context, r io.Reader) error {
+	if err := s.CheckRestore(ctx); err != nil {
+		return err
+	}
+	if err := replaceFile(s.path, r, 0644); err != nil {
+		return err
+	}
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(s.path + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqliteLockError 잠금 실패를 ErrSourceInUse로 변환
+func sqliteLockError(err error) error {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && (sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked) {
+		return fmt.Errorf("%w: API 서버를 중지한 뒤 복원하세요", ErrSourceInUse)
+	}
+	return fmt.Errorf("SQLite 잠금 실패: %w", err)
+}
+
+// BoltSource BoltDB 파일 백업 소스
+type BoltSource struct {
+	path string
+}
+
+// NewBoltSource 새 BoltDB 백업 소스 생성
+func NewBoltSource(path string) *BoltSource {
+	return &BoltSource{path: path}
+}
+
+// Name 항목 이름
+func (s *BoltSource) Name() string { return "storage.bolt" }
+
+// Snapshot 읽기 트랜잭션으로 데이터베이스 스냅샷 생성
+func (s *BoltSource) Snapshot(ctx context.Context, w io.Writer) error {
+	db, err := bbolt.Open(s.path, 0600, &bbolt.Options{ReadOnly: true, Timeout: 5 * time.Second})
+	if err != nil {
+		return fmt.Errorf("BoltDB 열기 실패: %w", err)
+	}
+	defer db.Close()
+
+	return db.View(func(tx *bbolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// CheckRestore 다른 프로세스가 데이터베이스를 열고 있지 않은지 확인
+// BoltDB는 연 프로세스가 파일 잠금을 유지하므로 잠금을 얻지 못하면 사용 중입니다.
+func (s *BoltSource) CheckRestore(ctx context.Context) error {
+	if _, err := os.Stat(s.path); os.IsNotExist(err) {
+		return nil
+	}
+
+	db, err := bbolt.Open(s.path, 0600, &bbolt.Options{Timeout: time.Second})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return fmt.Errorf("%w: API 서버를 중지한 뒤 복원하세요", ErrSourceInUse)
+	}
+	if err != nil {
+		return fmt.Errorf("BoltDB 열기 실패: %w", err)
+	}
+	return db.Close()
+}
+
+// Restore 데이터베이스 파일 교체
+func (s *BoltSource) Restore(ctx context.Context, r io.Reader) error {
+	if err := s.CheckRestore(ctx); err != nil {
+		return err
+	}
+	return replaceFile(s.path, r, 0600)
+}
+
+// FilesSource 시크릿/설정 파일 백업 소스
+// 지정된 디렉토리의 파일 중 패턴에 맞는 파일을 tar로 묶습니다.
+type FilesSource struct {
+	name     string
+	dir      string
+	patterns []string
+}
+
+// NewFilesSource 새 파일 백업 소스 생성
+func NewFilesSource(name, dir string, patterns ...string) *FilesSource {
+	return &FilesSource{name: name, dir: dir, patterns: patterns}
+}
+
+// Name 항목 이름
+func (s *FilesSource) Name() string { return s.name }
+
+// Snapshot 파일들을 tar로 기록
+func (s *FilesSource) Snapshot(ctx context.Context, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	for _, pattern := range s.patterns {
+		matches, err := filepath.Glob(filepath.Join(s.dir, pattern))
+		if err != nil {
+			return err
+		}
+		for _, match := range matches {
+			info, err := os.Stat(match)
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+			header, err := tar.FileInfoHeader(info, "")
+			if err != nil {
+				return err
+			}
+			header.Name = filepath.Base(match)
+			if err := tw.WriteHeader(header); err != nil {
+				return err
+			}
+			if err := copyFileTo(tw, match); err != nil {
+				return err
+			}
+		}
+	}
+	return tw.Close()
+}
+
+// Restore tar의 파일들을 디렉토리에 기록 (권한 0600)
+func (s *FilesSource) Restore(ctx context.Context, r io.Reader) error {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// 디렉토리 탈출 방지
+		name := filepath.Base(header.Name)
+		if name != header.Name || name == "." || name == ".." {
+			continue
+		}
+		if err := replaceFile(filepath.Join(s.dir, name), tr, 0600); err != nil {
+			return err
+		}
+	}
+}
+
+// metadataSnapshot 워크스페이스 메타데이터 스냅샷
+type metadataSnapshot struct {
+	Workspaces []*models.Workspace `json:"workspaces"`
+	Projects   []*models.Project   `json:"projects"`
+}
+
+// MetadataSource 스토리지 인터페이스를 통한 워크스페이스 메타데이터 백업 소스
+// 파일 기반이 아닌 스토리지(메모리 등)에서도 워크스페이스 구성을 보존합니다.
+type MetadataSource struct {
+	storage storage.Storage
+}
+
+// NewMetadataSource 새 메타데이터 백업 소스 생성
+func NewMetadataSource(storage storage.Storage) *MetadataSource {
+	return &MetadataSource{storage: storage}
+}
+
+// Name 항목 이름
+func (s *MetadataSource) Name() string { return "metadata.json" }
+
+// Snapshot 워크스페이스와 프로젝트를 JSON으로 기록
+func (s *MetadataSource) Snapshot(ctx context.Context, w io.Writer) error {
+	var snapshot metadataSnapshot
+
+	for page := 1; ; page++ {
+		workspaces, total, err := s.storage.Workspace().List(ctx, &models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return fmt.Errorf("워크스페이스 조회 실패: %w", err)
+		}
+		snapshot.Workspaces = append(snapshot.Workspaces, workspaces...)
+		if len(workspaces) == 0 || len(snapshot.Workspaces) >= total {
+			break
+		}
+	}
+
+	for _, workspace := range snapshot.Workspaces {
+		for page := 1; ; page++ {
+			projects, total, err := s.storage.Project().GetByWorkspaceID(ctx, workspace.ID, &models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+			if err != nil {
+				return fmt.Errorf("프로젝트 조회 실패: %w", err)
+			}
+			snapshot.Projects = append(snapshot.Projects, projects...)
+			if len(projects) == 0 || page*100 >= total {
+				break
+			}
+		}
+	}
+
+	return json.NewEncoder(w).Encode(snapshot)
+}
+
+// Restore 존재하지 않는 워크스페이스와 프로젝트를 원래 ID로 다시 생성
+func (s *MetadataSource) Restore(ctx context.Context, r io.Reader) error {
+	var snapshot metadataSnapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return fmt.Errorf("메타데이터 파싱 실패: %w", err)
+	}
+
+	for _, workspace := range snapshot.Workspaces {
+		if err := s.storage.Workspace().Create(ctx, workspace); err != nil && !storage.IsAlreadyExistsError(err) {
+			return fmt.Errorf("워크스페이스 복원 실패 (%s): %w", workspace.ID, err)
+		}
+	}
+	for _, project := range snapshot.Projects {
+		if err := s.storage.Project().Create(ctx, project); err != nil && !storage.IsAlreadyExistsError(err) {
+			return fmt.Errorf("프로젝트 복원 실패 (%s): %w", project.ID, err)
+		}
+	}
+	return nil
+}
+
+// copyFileTo 파일 내용을 w에 복사
+func copyFileTo(w io.Writer, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// replaceFile 임시 파일에 기록한 뒤 원자적으로 교체
+func replaceFile(path string, r io.Reader, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".restore-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,142 @@
+package backup
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/artifact"
+)
+
+// ErrObjectNotFound 저장소에 객체가 없음
+var ErrObjectNotFound = artifact.ErrNotFound
+
+// ObjectInfo 저장된 객체 정보
+type ObjectInfo struct {
+	Name    string    `json:"name"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// Target 백업 저장소
+type Target interface {
+	// Put 객체 저장
+	Put(ctx context.Context, name string, r io.Reader, size int64) error
+
+	// Get 객체 읽기
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+
+	// List 접두사로 시작하는 객체 목록
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+
+	// Delete 객체 삭제
+	Delete(ctx context.Context, name string) error
+}
+
+// LocalTarget 로컬 디렉토리 백업 저장소
+type LocalTarget struct {
+	dir string
+}
+
+// NewLocalTarget 새 로컬 저장소 생성
+func NewLocalTarget(dir string) *LocalTarget {
+	return &LocalTarget{dir: dir}
+}
+
+// Put 임시 파일에 쓴 뒤 이름을 바꿔 부분 파일이 노출되지 않도록 저장
+func (t *LocalTarget) Put(ctx context.Context, name string, r io.Reader, size int64) error {
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return err
+	}
+	return replaceFile(t.path(name), r, 0600)
+}
+
+// Get 객체 읽기
+func (t *LocalTarget) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	f, err := os.Open(t.path(name))
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotFound
+	}
+	return f, err
+}
+
+// List 객체 목록 (이름순)
+func (t *LocalTarget) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	entries, err := os.ReadDir(t.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []ObjectInfo
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		objects = append(objects, ObjectInfo{Name: entry.Name(), Size: info.Size(), ModTime: info.ModTime()})
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+	return objects, nil
+}
+
+// Delete 객체 삭제
+func (t *LocalTarget) Delete(ctx context.Context, name string) error {
+	err := os.Remove(t.path(name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (t *LocalTarget) path(name string) string {
+	return filepath.Join(t.dir, filepath.Base(name))
+}
+
+// StoreTarget 아티팩트 저장소 기반 백업 저장소 (S3/MinIO 등)
+type StoreTarget struct {
+	store artifact.Store
+}
+
+// NewStoreTarget 아티팩트 저장소를 백업 저장소로 사용합니다
+func NewStoreTarget(store artifact.Store) *StoreTarget {
+	return &StoreTarget{store: store}
+}
+
+// Put 객체 저장
+func (t *StoreTarget) Put(ctx context.Context, name string, r io.Reader, size int64) error {
+	return t.store.Put(ctx, name, r, size, "application/octet-stream")
+}
+
+// Get 객체 읽기
+func (t *StoreTarget) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	return t.store.Get(ctx, name)
+}
+
+// List 객체 목록 (이름순)
+func (t *StoreTarget) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	objects, err := t.store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]ObjectInfo, 0, len(objects))
+	for _, obj := range objects {
+		infos = append(infos, ObjectInfo{Name: obj.Key, Size: obj.Size, ModTime: obj.ModTime})
+	}
+	return infos, nil
+}
+
+// Delete 객체 삭제
+func (t *StoreTarget) Delete(ctx context.Context, name string) error {
+	return t.store.Delete(ctx, name)
+}
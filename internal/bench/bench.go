@@ -0,0 +1,260 @@
+// Package bench는 API 서버의 부하/내구성 테스트 하네스를 제공합니다.
+//
+// 워커마다 로그인, 워크스페이스/프로젝트/세션 생성, 작은 태스크 실행과 출력 스트리밍(롱 폴링),
+// 워크스페이스 삭제를 한 반복으로 실행하고 단계별 지연 시간 백분위수와 에러율을 집계합니다.
+// 실제 Claude API를 쓰지 않도록 Claude CLI 대신 실행할 스텁(RunStub, InstallStub)도 제공합니다.
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// 측정 단계
+const (
+	StepLogin           = "login"
+	StepCreateWorkspace = "create_workspace"
+	StepCreateProject   = "create_project"
+	StepCreateSession   = "create_session"
+	StepCreateTask      = "create_task"
+	StepFirstOutput     = "first_output"  // 태스크 생성부터 첫 출력 이벤트까지
+	StepTaskComplete    = "task_complete" // 태스크 생성부터 종료 이벤트까지
+	StepDeleteWorkspace = "delete_workspace"
+	StepIteration       = "iteration" // 반복 하나 전체
+)
+
+// steps 보고서에 표시할 단계 순서
+var steps = []string{
+	StepLogin,
+	StepCreateWorkspace,
+	StepCreateProject,
+	StepCreateSession,
+	StepCreateTask,
+	StepFirstOutput,
+	StepTaskComplete,
+	StepDeleteWorkspace,
+	StepIteration,
+}
+
+// 기본 설정 값
+const (
+	DefaultConcurrency = 4
+	DefaultIterations  = 20
+	DefaultCommand     = "claude -p bench"
+	DefaultPollWait    = 30 * time.Second
+	DefaultTimeout     = 2 * time.Minute
+)
+
+// Config 부하 테스트 설정
+type Config struct {
+	// BaseURL API 서버 주소 (예: http://localhost:8080)
+	BaseURL string
+	// Username, Password 로그인 자격 증명
+	Username string
+	Password string
+	// Concurrency 동시에 반복을 실행할 워커 수
+	Concurrency int
+	// Iterations 전체 반복 수 (Duration과 함께 지정하면 먼저 도달한 쪽에서 멈춤, 0이면 Duration까지)
+	Iterations int
+	// Duration 새 반복을 시작하는 시간 (0이면 Iterations만큼)
+	Duration time.Duration
+	// Command 태스크로 실행할 명령 (서버 PATH의 claude가 스텁이면 실제 API를 쓰지 않음)
+	Command string
+	// WorkDir 프로젝트 디렉토리를 만들 경로 (서버와 같은 파일 시스템이어야 함)
+	WorkDir string
+	// PollWait 태스크 이벤트 롱 폴링 대기 시간
+	PollWait time.Duration
+	// Timeout 반복 하나의 제한 시간
+	Timeout time.Duration
+	// HTTPClient 요청에 사용할 클라이언트 (nil이면 기본 클라이언트)
+	HTTPClient *http.Client
+}
+
+// withDefaults 비어 있는 값을 기본값으로 채운 설정
+func (c Config) withDefaults() Config {
+	if c.Concurrency <= 0 {
+		c.Concurrency = DefaultConcurrency
+	}
+	if c.Iterations <= 0 && c.Duration <= 0 {
+		c.Iterations = DefaultIterations
+	}
+	if c.Command == "" {
+		c.Command = DefaultCommand
+	}
+	if c.WorkDir == "" {
+		c.WorkDir = filepath.Join(os.TempDir(), "aicli-bench")
+	}
+	if c.PollWait <= 0 {
+		c.PollWait = DefaultPollWait
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: c.PollWait + 30*time.Second}
+	}
+	return c
+}
+
+// Run 설정대로 반복을 실행하고 보고서를 반환합니다
+// ctx가 취소되면 새 반복을 시작하지 않고, 중단된 반복은 집계하지 않습니다.
+func Run(ctx context.Context, config Config) (*Report, error) {
+	config = config.withDefaults()
+	if config.BaseURL == "" {
+		return nil, errors.New("API 서버 주소가 필요합니다")
+	}
+
+	runID := time.Now().UTC().Format("20060102T150405")
+	runDir := filepath.Join(config.WorkDir, runID)
+	if err := os.MkdirAll(runDir, 0755); err != nil {
+		return nil, fmt.Errorf("작업 디렉토리 생성 실패: %w", err)
+	}
+	defer os.RemoveAll(runDir)
+
+	r := &runner{config: config, runID: runID, runDir: runDir, recorder: newRecorder()}
+
+	var deadline time.Time
+	if config.Duration > 0 {
+		deadline = time.Now().Add(config.Duration)
+	}
+
+	start := time.Now()
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < config.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				n := next.Add(1)
+				if config.Iterations > 0 && n > int64(config.Iterations) {
+					return
+				}
+				if ctx.Err() != nil || (!deadline.IsZero() && time.Now().After(deadline)) {
+					return
+				}
+				r.iteration(ctx, n)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return r.recorder.report(time.Since(start)), nil
+}
+
+// runner 반복 실행기
+type runner struct {
+	config   Config
+	runID    string
+	runDir   string
+	recorder *recorder
+}
+
+// iteration 반복 하나를 실행하고 결과를 기록합니다
+func (r *runner) iteration(ctx context.Context, n int64) {
+	iterCtx, cancel := context.WithTimeout(ctx, r.config.Timeout)
+	defer cancel()
+
+	s := &samples{}
+	start := time.Now()
+	err := r.scenario(iterCtx, n, s)
+	if ctx.Err() != nil {
+		// 사용자가 중단한 반복은 집계하지 않음
+		return
+	}
+	s.add(StepIteration, time.Since(start), err)
+	r.recorder.commit(s)
+}
+
+// scenario 로그인부터 워크스페이스 삭제까지 실행 (처음 실패한 단계의 에러 반환)
+func (r *runner) scenario(ctx context.Context, n int64, s *samples) (err error) {
+	c := newClient(r.config.BaseURL, r.config.HTTPClient)
+
+	if err := s.measure(StepLogin, func() error {
+		return c.login(ctx, r.config.Username, r.config.Password)
+	}); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("bench-%s-%d", r.runID, n)
+	dir := filepath.Join(r.runDir, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("프로젝트 디렉토리 생성 실패: %w", err)
+	}
+
+	var workspaceID string
+	if err := s.measure(StepCreateWorkspace, func() (err error) {
+		workspaceID, err = c.createWorkspace(ctx, name, dir)
+		return err
+	}); err != nil {
+		return err
+	}
+	defer func() {
+		// 실행 결과와 관계없이 정리 (반복 제한 시간이 지났어도 삭제 시도)
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+		if deleteErr := s.measure(StepDeleteWorkspace, func() error {
+			return c.deleteWorkspace(cleanupCtx, workspaceID)
+		}); err == nil {
+			err = deleteErr
+		}
+	}()
+
+	var projectID string
+	if err := s.measure(StepCreateProject, func() (err error) {
+		projectID, err = c.createProject(ctx, workspaceID, name, dir)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var sessionID string
+	if err := s.measure(StepCreateSession, func() (err error) {
+		sessionID, err = c.createSession(ctx, projectID)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var taskID string
+	if err := s.measure(StepCreateTask, func() (err error) {
+		taskID, err = c.createTask(ctx, sessionID, r.config.Command)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	taskStart := time.Now()
+	firstOutput := false
+	status, err := c.streamTask(ctx, taskID, r.config.PollWait, func() {
+		if !firstOutput {
+			firstOutput = true
+			s.add(StepFirstOutput, time.Since(taskStart), nil)
+		}
+	})
+	if err == nil && status != models.TaskCompleted {
+		err = &TaskStatusError{TaskID: taskID, Status: status}
+	}
+	s.add(StepTaskComplete, time.Since(taskStart), err)
+	return err
+}
+
+// TaskStatusError 태스크가 완료가 아닌 상태로 끝났음
+type TaskStatusError struct {
+	TaskID string
+	Status models.TaskStatus
+}
+
+// Error 에러 메시지
+func (e *TaskStatusError) Error() string {
+	return fmt.Sprintf("태스크 %s가 %s 상태로 끝났습니다", e.TaskID, e.Status)
+}
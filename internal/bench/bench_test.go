@@ -0,0 +1,221 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// fakeAPI 부하 테스트 흐름에 필요한 엔드포인트만 흉내 내는 API 서버
+type fakeAPI struct {
+	tasks      atomic.Int64
+	deleted    atomic.Int64
+	taskStatus models.TaskStatus
+}
+
+func (f *fakeAPI) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	authorized := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				json.NewEncoder(w).Encode(models.ErrorResponse{Error: models.ErrorDetail{Code: "UNAUTHORIZED"}})
+				return
+			}
+			next(w, r)
+		}
+	}
+	created := func(body interface{}) http.HandlerFunc {
+		return authorized(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(body)
+		})
+	}
+
+	mux.HandleFunc("POST /api/v1/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req["password"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(models.ErrorResponse{Error: models.ErrorDetail{Code: "INVALID_CREDENTIALS"}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "data": map[string]string{"access_token": "token"}})
+	})
+	mux.HandleFunc("POST /api/v1/workspaces", created(models.SuccessResponse{Success: true, Data: map[string]string{"id": "ws-1"}}))
+	mux.HandleFunc("DELETE /api/v1/workspaces/{id}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		f.deleted.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	mux.HandleFunc("POST /api/v1/workspaces/{id}/projects", created(models.SuccessResponse{Success: true, Data: map[string]string{"id": "project-1"}}))
+	mux.HandleFunc("POST /api/v1/projects/{id}/sessions", created(map[string]string{"id": "session-1"}))
+	mux.HandleFunc("POST /api/v1/sessions/{id}/tasks", authorized(func(w http.ResponseWriter, r *http.Request) {
+		var req models.TaskCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "session-1", req.SessionID)
+		f.tasks.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"id": "task-1"})
+	}))
+	mux.HandleFunc("GET /api/v1/tasks/{id}/events", authorized(func(w http.ResponseWriter, r *http.Request) {
+		// 첫 요청에는 출력, 다음 요청에는 종료 이벤트
+		if r.URL.Query().Get("since") == "0" {
+			json.NewEncoder(w).Encode(models.TaskEventPollResponse{
+				Status: models.TaskRunning,
+				Events: []*models.TaskEvent{{Cursor: 1, Output: "bench output"}},
+				Cursor: 1,
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(models.TaskEventPollResponse{Status: f.taskStatus, Cursor: 2, Done: true})
+	}))
+	return mux
+}
+
+func startFakeAPI(t *testing.T, status models.TaskStatus) (*fakeAPI, string) {
+	api := &fakeAPI{taskStatus: status}
+	server := httptest.NewServer(api.handler(t))
+	t.Cleanup(server.Close)
+	return api, server.URL
+}
+
+func TestRun(t *testing.T) {
+	api, url := startFakeAPI(t, models.TaskCompleted)
+	workDir := t.TempDir()
+
+	report, err := Run(context.Background(), Config{
+		BaseURL:     url,
+		Username:    "admin",
+		Password:    "secret",
+		Concurrency: 2,
+		Iterations:  5,
+		WorkDir:     workDir,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, report.Iterations)
+	assert.Zero(t, report.Failed)
+	assert.Equal(t, int64(5), api.tasks.Load())
+	assert.Equal(t, int64(5), api.deleted.Load())
+	for _, step := range steps {
+		stats, ok := report.Step(step)
+		require.True(t, ok, step)
+		assert.Equal(t, 5, stats.Count, step)
+		assert.LessOrEqual(t, stats.P50Ms, stats.P99Ms)
+		assert.LessOrEqual(t, stats.P99Ms, stats.MaxMs)
+	}
+	assert.NoError(t, report.Check(Thresholds{MaxErrorRate: 0}))
+
+	// 프로젝트 디렉토리는 실행이 끝나면 정리
+	entries, err := os.ReadDir(workDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestRun_Failures(t *testing.T) {
+	_, url := startFakeAPI(t, models.TaskFailed)
+
+	report, err := Run(context.Background(), Config{BaseURL: url, Password: "secret", Concurrency: 1, Iterations: 2, WorkDir: t.TempDir()})
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Failed)
+	assert.Equal(t, 1.0, report.ErrorRate)
+
+	stats, _ := report.Step(StepTaskComplete)
+	assert.Equal(t, map[string]int{"task_failed": 2}, stats.ErrorKinds)
+	// 태스크가 실패해도 워크스페이스는 삭제
+	deleted, _ := report.Step(StepDeleteWorkspace)
+	assert.Equal(t, 2, deleted.Count)
+	assert.Zero(t, deleted.Errors)
+
+	assert.Error(t, report.Check(Thresholds{MaxErrorRate: 0.5}))
+
+	// 로그인에 실패하면 이후 단계는 실행하지 않음
+	report, err = Run(context.Background(), Config{BaseURL: url, Password: "wrong", Concurrency: 1, Iterations: 1, WorkDir: t.TempDir()})
+	require.NoError(t, err)
+	login, _ := report.Step(StepLogin)
+	assert.Equal(t, map[string]int{"HTTP 401 INVALID_CREDENTIALS": 1}, login.ErrorKinds)
+	_, ok := report.Step(StepCreateWorkspace)
+	assert.False(t, ok)
+}
+
+func TestRun_Duration(t *testing.T) {
+	_, url := startFakeAPI(t, models.TaskCompleted)
+
+	report, err := Run(context.Background(), Config{BaseURL: url, Password: "secret", Concurrency: 2, Duration: 50 * time.Millisecond, WorkDir: t.TempDir()})
+	require.NoError(t, err)
+	assert.Positive(t, report.Iterations)
+	assert.Zero(t, report.Failed)
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+	assert.Equal(t, 50*time.Millisecond, percentile(sorted, 50))
+	assert.Equal(t, 95*time.Millisecond, percentile(sorted, 95))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 100))
+	assert.Equal(t, time.Millisecond, percentile(sorted[:1], 99))
+}
+
+func TestReport_CheckP95(t *testing.T) {
+	report := &Report{Iterations: 1, Steps: []StepStats{{Step: StepIteration, Count: 1, P95Ms: 1500}}}
+	assert.NoError(t, report.Check(Thresholds{MaxErrorRate: 1, MaxP95: 2 * time.Second}))
+	assert.Error(t, report.Check(Thresholds{MaxErrorRate: 1, MaxP95: time.Second}))
+	assert.Error(t, (&Report{}).Check(Thresholds{MaxErrorRate: 1}))
+}
+
+func TestRunStub(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, RunStub(context.Background(), &out, StubConfig{Lines: 3}))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 5)
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[4]), &result))
+	assert.Equal(t, "result", result["type"])
+	assert.Equal(t, false, result["is_error"])
+
+	out.Reset()
+	assert.ErrorIs(t, RunStub(context.Background(), &out, StubConfig{Lines: 1, FailRate: 1}), ErrStubFailure)
+	assert.Contains(t, out.String(), "error_during_execution")
+}
+
+func TestStubConfigFromEnv(t *testing.T) {
+	t.Setenv(EnvStubLines, "2")
+	t.Setenv(EnvStubDelay, "5ms")
+	t.Setenv(EnvStubFailRate, "0.5")
+
+	config, err := StubConfigFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, StubConfig{Lines: 2, Delay: 5 * time.Millisecond, FailRate: 0.5}, config)
+
+	t.Setenv(EnvStubFailRate, "2")
+	_, err = StubConfigFromEnv()
+	assert.Error(t, err)
+}
+
+func TestInstallStub(t *testing.T) {
+	path, err := InstallStub(t.TempDir(), "/opt/aicli's/aicli")
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `exec '/opt/aicli'\''s/aicli' bench stub-claude "$@"`)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.NotZero(t, info.Mode()&0100)
+}
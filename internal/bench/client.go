@@ -0,0 +1,177 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// APIError API가 2xx가 아닌 상태로 응답했음
+type APIError struct {
+	Method  string
+	Path    string
+	Status  int
+	Code    string
+	Message string
+}
+
+// Error 에러 메시지
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("%s %s: HTTP %d", e.Method, e.Path, e.Status)
+	}
+	return fmt.Sprintf("%s %s: HTTP %d %s: %s", e.Method, e.Path, e.Status, e.Code, e.Message)
+}
+
+// client 부하 테스트용 API 클라이언트 (반복마다 새로 만들어 토큰을 보관)
+type client struct {
+	baseURL string
+	http    *http.Client
+	token   string
+}
+
+func newClient(baseURL string, httpClient *http.Client) *client {
+	return &client{baseURL: strings.TrimRight(baseURL, "/"), http: httpClient}
+}
+
+// idResponse 생성된 리소스 ID만 읽는 응답
+type idResponse struct {
+	ID   string `json:"id"`
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+// id 응답 형식(SuccessResponse 또는 리소스 자체)에 관계없이 ID 반환
+func (r *idResponse) id() string {
+	if r.Data.ID != "" {
+		return r.Data.ID
+	}
+	return r.ID
+}
+
+func (c *client) login(ctx context.Context, username, password string) error {
+	var resp struct {
+		Data struct {
+			AccessToken string `json:"access_token"`
+		} `json:"data"`
+	}
+	body := map[string]string{"username": username, "password": password}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/auth/login", body, &resp); err != nil {
+		return err
+	}
+	if resp.Data.AccessToken == "" {
+		return fmt.Errorf("로그인 응답에 액세스 토큰이 없습니다")
+	}
+	c.token = resp.Data.AccessToken
+	return nil
+}
+
+func (c *client) createWorkspace(ctx context.Context, name, path string) (string, error) {
+	return c.create(ctx, "/api/v1/workspaces", models.CreateWorkspaceRequest{Name: name, ProjectPath: path})
+}
+
+func (c *client) deleteWorkspace(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/workspaces/"+url.PathEscape(id), nil, nil)
+}
+
+func (c *client) createProject(ctx context.Context, workspaceID, name, path string) (string, error) {
+	return c.create(ctx, "/api/v1/workspaces/"+url.PathEscape(workspaceID)+"/projects", models.CreateProjectRequest{Name: name, Path: path})
+}
+
+func (c *client) createSession(ctx context.Context, projectID string) (string, error) {
+	return c.create(ctx, "/api/v1/projects/"+url.PathEscape(projectID)+"/sessions", models.SessionCreateRequest{ProjectID: projectID})
+}
+
+func (c *client) createTask(ctx context.Context, sessionID, command string) (string, error) {
+	return c.create(ctx, "/api/v1/sessions/"+url.PathEscape(sessionID)+"/tasks", models.TaskCreateRequest{SessionID: sessionID, Command: command})
+}
+
+// streamTask 태스크가 끝날 때까지 이벤트를 롱 폴링하고 최종 상태를 반환합니다 (출력 이벤트마다 onOutput 호출)
+func (c *client) streamTask(ctx context.Context, taskID string, wait time.Duration, onOutput func()) (models.TaskStatus, error) {
+	var cursor int64
+	for {
+		query := url.Values{}
+		query.Set("since", strconv.FormatInt(cursor, 10))
+		query.Set("wait", wait.String())
+
+		var resp models.TaskEventPollResponse
+		path := "/api/v1/tasks/" + url.PathEscape(taskID) + "/events?" + query.Encode()
+		if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return "", err
+		}
+		for _, event := range resp.Events {
+			if event.Output != "" {
+				onOutput()
+			}
+		}
+		cursor = resp.Cursor
+		if resp.Done {
+			return resp.Status, nil
+		}
+	}
+}
+
+func (c *client) create(ctx context.Context, path string, body interface{}) (string, error) {
+	var resp idResponse
+	if err := c.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return "", err
+	}
+	if resp.id() == "" {
+		return "", fmt.Errorf("POST %s: 응답에 ID가 없습니다", path)
+	}
+	return resp.id(), nil
+}
+
+// do JSON 요청을 보내고 2xx 응답을 out에 읽습니다
+func (c *client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &APIError{Method: method, Path: strings.SplitN(path, "?", 2)[0], Status: resp.StatusCode}
+		var errResp models.ErrorResponse
+		if json.NewDecoder(resp.Body).Decode(&errResp) == nil {
+			apiErr.Code = errResp.Error.Code
+			apiErr.Message = errResp.Error.Message
+		}
+		return apiErr
+	}
+	if out == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
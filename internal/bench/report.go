@@ -0,0 +1,204 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+// sample 단계 하나의 측정 결과
+type sample struct {
+	step     string
+	duration time.Duration
+	err      error
+}
+
+// samples 반복 하나에서 측정한 결과 (반복이 끝나면 한 번에 집계)
+type samples struct {
+	items []sample
+}
+
+func (s *samples) add(step string, duration time.Duration, err error) {
+	s.items = append(s.items, sample{step: step, duration: duration, err: err})
+}
+
+// measure fn 실행 시간과 결과 기록
+func (s *samples) measure(step string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	s.add(step, time.Since(start), err)
+	return err
+}
+
+// recorder 단계별 측정 결과 집계기
+type recorder struct {
+	mu        sync.Mutex
+	durations map[string][]time.Duration
+	errors    map[string]map[string]int // 단계 -> 에러 종류 -> 수
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		durations: make(map[string][]time.Duration),
+		errors:    make(map[string]map[string]int),
+	}
+}
+
+func (r *recorder) commit(s *samples) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, item := range s.items {
+		r.durations[item.step] = append(r.durations[item.step], item.duration)
+		if item.err != nil {
+			if r.errors[item.step] == nil {
+				r.errors[item.step] = make(map[string]int)
+			}
+			r.errors[item.step][errorKind(item.err)]++
+		}
+	}
+}
+
+// StepStats 단계별 지연 시간(밀리초)과 에러 통계
+type StepStats struct {
+	Step       string         `json:"step"`
+	Count      int            `json:"count"`
+	Errors     int            `json:"errors"`
+	ErrorRate  float64        `json:"error_rate"`
+	MeanMs     float64        `json:"mean_ms"`
+	P50Ms      float64        `json:"p50_ms"`
+	P90Ms      float64        `json:"p90_ms"`
+	P95Ms      float64        `json:"p95_ms"`
+	P99Ms      float64        `json:"p99_ms"`
+	MaxMs      float64        `json:"max_ms"`
+	ErrorKinds map[string]int `json:"error_kinds,omitempty"`
+}
+
+// Report 부하 테스트 결과
+type Report struct {
+	Iterations      int         `json:"iterations"`
+	Failed          int         `json:"failed"`
+	ErrorRate       float64     `json:"error_rate"`
+	DurationSeconds float64     `json:"duration_seconds"`
+	Throughput      float64     `json:"iterations_per_second"`
+	Steps           []StepStats `json:"steps"`
+}
+
+// Step 단계 통계 (측정하지 않은 단계면 false)
+func (r *Report) Step(step string) (StepStats, bool) {
+	for _, stats := range r.Steps {
+		if stats.Step == step {
+			return stats, true
+		}
+	}
+	return StepStats{}, false
+}
+
+func (r *recorder) report(elapsed time.Duration) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := &Report{DurationSeconds: elapsed.Seconds()}
+	for _, step := range steps {
+		durations := r.durations[step]
+		if len(durations) == 0 {
+			continue
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+		var total time.Duration
+		for _, d := range durations {
+			total += d
+		}
+		stats := StepStats{
+			Step:       step,
+			Count:      len(durations),
+			MeanMs:     milliseconds(total / time.Duration(len(durations))),
+			P50Ms:      milliseconds(percentile(durations, 50)),
+			P90Ms:      milliseconds(percentile(durations, 90)),
+			P95Ms:      milliseconds(percentile(durations, 95)),
+			P99Ms:      milliseconds(percentile(durations, 99)),
+			MaxMs:      milliseconds(durations[len(durations)-1]),
+			ErrorKinds: r.errors[step],
+		}
+		for _, n := range r.errors[step] {
+			stats.Errors += n
+		}
+		stats.ErrorRate = float64(stats.Errors) / float64(stats.Count)
+		report.Steps = append(report.Steps, stats)
+
+		if step == StepIteration {
+			report.Iterations = stats.Count
+			report.Failed = stats.Errors
+			report.ErrorRate = stats.ErrorRate
+		}
+	}
+	if elapsed > 0 {
+		report.Throughput = float64(report.Iterations) / elapsed.Seconds()
+	}
+	return report
+}
+
+// percentile 정렬된 값의 p 백분위수 (nearest-rank)
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// errorKind 에러 종류별 집계에 쓸 짧은 이름
+func errorKind(err error) string {
+	var apiErr *APIError
+	var statusErr *TaskStatusError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &apiErr):
+		if apiErr.Code != "" {
+			return fmt.Sprintf("HTTP %d %s", apiErr.Status, apiErr.Code)
+		}
+		return fmt.Sprintf("HTTP %d", apiErr.Status)
+	case errors.As(err, &statusErr):
+		return "task_" + string(statusErr.Status)
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.As(err, &netErr):
+		return "network"
+	default:
+		return "error"
+	}
+}
+
+// Thresholds CI에서 성능 회귀로 볼 기준
+type Thresholds struct {
+	// MaxErrorRate 허용하는 반복 에러율 (0~1)
+	MaxErrorRate float64
+	// MaxP95 허용하는 반복 p95 지연 시간 (0이면 확인하지 않음)
+	MaxP95 time.Duration
+}
+
+// Check 보고서가 기준을 넘었으면 에러 반환
+func (r *Report) Check(t Thresholds) error {
+	if r.Iterations == 0 {
+		return errors.New("완료한 반복이 없습니다")
+	}
+	if r.ErrorRate > t.MaxErrorRate {
+		return fmt.Errorf("에러율 %.2f%%가 기준 %.2f%%를 넘었습니다", r.ErrorRate*100, t.MaxErrorRate*100)
+	}
+	if t.MaxP95 > 0 {
+		stats, _ := r.Step(StepIteration)
+		if p95 := time.Duration(stats.P95Ms * float64(time.Millisecond)); p95 > t.MaxP95 {
+			return fmt.Errorf("반복 p95 %s가 기준 %s를 넘었습니다", p95.Round(time.Millisecond), t.MaxP95)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package bench
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 스텁 설정 환경 변수 (스텁은 Claude CLI 인자를 그대로 받으므로 플래그 대신 환경 변수로 설정)
+const (
+	EnvStubLines    = "AICLI_BENCH_STUB_LINES"
+	EnvStubDelay    = "AICLI_BENCH_STUB_DELAY"
+	EnvStubFailRate = "AICLI_BENCH_STUB_FAIL_RATE"
+)
+
+// 스텁 기본값
+const (
+	DefaultStubLines = 5
+	DefaultStubDelay = 20 * time.Millisecond
+)
+
+// ErrStubFailure 설정한 확률로 스텁이 실패했음
+var ErrStubFailure = errors.New("bench stub: simulated failure")
+
+// StubConfig 스텁 Claude CLI 동작
+type StubConfig struct {
+	// Lines 출력할 assistant 메시지 수
+	Lines int
+	// Delay 메시지 사이 지연 (모델 응답 시간 흉내)
+	Delay time.Duration
+	// FailRate 에러 결과로 끝낼 확률 (0~1)
+	FailRate float64
+}
+
+// StubConfigFromEnv 환경 변수로 스텁 설정 구성 (없으면 기본값)
+func StubConfigFromEnv() (StubConfig, error) {
+	config := StubConfig{Lines: DefaultStubLines, Delay: DefaultStubDelay}
+	if v := os.Getenv(EnvStubLines); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return config, fmt.Errorf("%s 값이 올바르지 않습니다: %q", EnvStubLines, v)
+		}
+		config.Lines = n
+	}
+	if v := os.Getenv(EnvStubDelay); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return config, fmt.Errorf("%s 값이 올바르지 않습니다: %q", EnvStubDelay, v)
+		}
+		config.Delay = d
+	}
+	if v := os.Getenv(EnvStubFailRate); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil || rate < 0 || rate > 1 {
+			return config, fmt.Errorf("%s 값이 올바르지 않습니다: %q", EnvStubFailRate, v)
+		}
+		config.FailRate = rate
+	}
+	return config, nil
+}
+
+// RunStub Claude CLI의 stream-json 출력 형식을 흉내 내 w에 씁니다
+// init 메시지, Lines개의 assistant 메시지, result 메시지 순으로 출력하며 실패하면 ErrStubFailure를 반환합니다.
+func RunStub(ctx context.Context, w io.Writer, config StubConfig) error {
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(map[string]interface{}{
+		"type":       "system",
+		"subtype":    "init",
+		"session_id": "bench-stub",
+		"model":      "bench-stub",
+	}); err != nil {
+		return err
+	}
+
+	for i := 1; i <= config.Lines; i++ {
+		if config.Delay > 0 {
+			select {
+			case <-time.After(config.Delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := encoder.Encode(map[string]interface{}{
+			"type": "assistant",
+			"message": map[string]interface{}{
+				"role":    "assistant",
+				"content": []map[string]string{{"type": "text", "text": fmt.Sprintf("bench output %d/%d", i, config.Lines)}},
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	failed := config.FailRate > 0 && rand.Float64() < config.FailRate
+	result := map[string]interface{}{
+		"type":     "result",
+		"subtype":  "success",
+		"is_error": failed,
+		"result":   "bench complete",
+		"usage":    map[string]int{"input_tokens": 10, "output_tokens": 10 * config.Lines},
+	}
+	if failed {
+		result["subtype"] = "error_during_execution"
+		result["result"] = ErrStubFailure.Error()
+	}
+	if err := encoder.Encode(result); err != nil {
+		return err
+	}
+	if failed {
+		return ErrStubFailure
+	}
+	return nil
+}
+
+// InstallStub dir에 executable의 스텁 모드를 실행하는 claude 스크립트를 만들고 경로를 반환합니다
+// 서버를 PATH=dir:$PATH로 실행하면 태스크의 claude 명령이 실제 API 대신 스텁을 실행합니다.
+func InstallStub(dir, executable string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("스텁 디렉토리 생성 실패: %w", err)
+	}
+	path := filepath.Join(dir, "claude")
+	script := fmt.Sprintf("#!/bin/sh\nexec %s bench stub-claude \"$@\"\n", shellQuote(executable))
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		return "", fmt.Errorf("스텁 생성 실패: %w", err)
+	}
+	return path, nil
+}
+
+// shellQuote 작은따옴표로 감싼 셸 인자
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,314 @@
+// Package breaker는 Redis, 스토리지, Git 호스트, OAuth 프로바이더 같은 외부 의존성 호출을 보호하는 회로 차단기를 제공합니다.
+//
+// 연속 실패가 기준을 넘으면 회로를 열어 정해진 시간 동안 호출을 바로 거부하고(ErrOpen),
+// 시간이 지나면 반열림 상태에서 시험 호출을 허용해 모두 성공하면 다시 닫습니다.
+// 호출한 쪽의 context가 끝나 실패한 호출은 의존성 문제로 보지 않습니다.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// State 회로 차단기 상태
+type State int
+
+const (
+	// StateClosed 정상 (모든 호출 허용)
+	StateClosed State = iota
+	// StateHalfOpen 시험 호출만 허용
+	StateHalfOpen
+	// StateOpen 모든 호출 거부
+	StateOpen
+)
+
+// String 상태 이름
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// 기본 설정 값
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 30 * time.Second
+	DefaultHalfOpenProbes   = 1
+)
+
+// ErrOpen 회로가 열려 호출을 거부했음 (errors.Is로 OpenError와 비교)
+var ErrOpen = errors.New("circuit breaker is open")
+
+// OpenError 회로가 열려 호출을 거부했을 때의 에러
+type OpenError struct {
+	// Name 의존성 이름
+	Name string
+	// RetryAfter 다시 시도할 수 있을 때까지 남은 시간
+	RetryAfter time.Duration
+}
+
+// Error 에러 메시지
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("%s: circuit breaker is open (retry after %s)", e.Name, e.RetryAfter.Round(time.Second))
+}
+
+// Is ErrOpen과 비교
+func (e *OpenError) Is(target error) bool {
+	return target == ErrOpen
+}
+
+// Config 회로 차단기 설정
+type Config struct {
+	// FailureThreshold 회로를 여는 연속 실패 횟수
+	FailureThreshold int
+	// OpenTimeout 회로를 연 뒤 시험 호출을 허용할 때까지 대기 시간
+	OpenTimeout time.Duration
+	// HalfOpenProbes 반열림 상태에서 동시에 허용하는 시험 호출 수 (모두 성공하면 닫힘)
+	HalfOpenProbes int
+	// IsFailure 의존성 실패로 볼 에러인지 판단 (nil이면 nil이 아닌 모든 에러)
+	IsFailure func(error) bool
+}
+
+// DefaultConfig 기본 회로 차단기 설정
+func DefaultConfig() Config {
+	return Config{
+		FailureThreshold: DefaultFailureThreshold,
+		OpenTimeout:      DefaultOpenTimeout,
+		HalfOpenProbes:   DefaultHalfOpenProbes,
+	}
+}
+
+// withDefaults 0 이하인 값을 기본값으로 채운 설정
+func (c Config) withDefaults() Config {
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = DefaultFailureThreshold
+	}
+	if c.OpenTimeout <= 0 {
+		c.OpenTimeout = DefaultOpenTimeout
+	}
+	if c.HalfOpenProbes <= 0 {
+		c.HalfOpenProbes = DefaultHalfOpenProbes
+	}
+	return c
+}
+
+// Snapshot 회로 차단기 현재 상태
+type Snapshot struct {
+	Name     string    `json:"name"`
+	State    string    `json:"state"`
+	Failures int       `json:"failures"`
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+}
+
+// Breaker 의존성 하나의 회로 차단기 (nil Breaker는 모든 호출을 허용)
+type Breaker struct {
+	name    string
+	config  Config
+	metrics *metrics
+	now     func() time.Time
+
+	mu         sync.Mutex
+	state      State
+	generation uint64 // 상태가 바뀔 때마다 증가 (이전 상태에서 시작한 호출 결과는 무시)
+	failures   int    // 닫힌 상태의 연속 실패 수
+	probes     int    // 반열림 상태에서 진행 중인 시험 호출 수
+	successes  int    // 반열림 상태에서 성공한 시험 호출 수
+	openedAt   time.Time
+}
+
+// New 회로 차단기 생성 (메트릭은 Registry로 만든 차단기만 기록)
+func New(name string, config Config) *Breaker {
+	return newBreaker(name, config, nil)
+}
+
+func newBreaker(name string, config Config, m *metrics) *Breaker {
+	b := &Breaker{
+		name:    name,
+		config:  config.withDefaults(),
+		metrics: m,
+		now:     time.Now,
+	}
+	b.metrics.setState(name, StateClosed)
+	return b
+}
+
+// Name 의존성 이름
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// Allow 호출을 시작합니다
+// 허용되면 호출이 끝난 뒤 결과 에러로 한 번 호출할 함수를, 회로가 열려 있으면 *OpenError를 반환합니다.
+// ctx가 끝나 실패한 호출은 실패로 집계하지 않습니다.
+func (b *Breaker) Allow(ctx context.Context) (func(error), error) {
+	if b == nil {
+		return func(error) {}, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	probe := false
+	switch b.state {
+	case StateOpen:
+		if remaining := b.openedAt.Add(b.config.OpenTimeout).Sub(b.now()); remaining > 0 {
+			b.metrics.observe(b.name, resultRejected)
+			return nil, &OpenError{Name: b.name, RetryAfter: remaining}
+		}
+		b.setStateLocked(StateHalfOpen)
+		fallthrough
+	case StateHalfOpen:
+		if b.probes >= b.config.HalfOpenProbes {
+			b.metrics.observe(b.name, resultRejected)
+			return nil, &OpenError{Name: b.name, RetryAfter: b.config.OpenTimeout}
+		}
+		b.probes++
+		probe = true
+	}
+
+	generation := b.generation
+	return func(err error) {
+		b.record(ctx, generation, probe, err)
+	}, nil
+}
+
+// Do 회로가 닫혀 있거나 시험 호출이 가능하면 fn을 실행하고 결과를 기록합니다
+func (b *Breaker) Do(ctx context.Context, fn func(context.Context) error) error {
+	done, err := b.Allow(ctx)
+	if err != nil {
+		return err
+	}
+	err = fn(ctx)
+	done(err)
+	return err
+}
+
+// Ready 지금 호출하면 허용되는지 확인 (시험 호출 자리를 차지하지 않음)
+func (b *Breaker) Ready() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		return !b.now().Before(b.openedAt.Add(b.config.OpenTimeout))
+	case StateHalfOpen:
+		return b.probes < b.config.HalfOpenProbes
+	default:
+		return true
+	}
+}
+
+// State 현재 상태
+func (b *Breaker) State() State {
+	if b == nil {
+		return StateClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Snapshot 현재 상태 요약
+func (b *Breaker) Snapshot() Snapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	snapshot := Snapshot{Name: b.name, State: b.state.String(), Failures: b.failures}
+	if b.state != StateClosed {
+		snapshot.OpenedAt = b.openedAt
+	}
+	return snapshot
+}
+
+// Reset 회로를 닫고 실패 기록을 지웁니다
+func (b *Breaker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.setStateLocked(StateClosed)
+}
+
+// record 호출 결과 기록
+func (b *Breaker) record(ctx context.Context, generation uint64, probe bool, err error) {
+	failure := b.isFailure(err)
+	if failure && ctx.Err() != nil {
+		// 호출한 쪽이 취소했거나 기한이 지나 실패한 호출은 의존성 문제로 보지 않음
+		failure = false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if failure {
+		b.metrics.observe(b.name, resultFailure)
+	} else {
+		b.metrics.observe(b.name, resultSuccess)
+	}
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case StateClosed:
+		if !failure {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.config.FailureThreshold {
+			b.setStateLocked(StateOpen)
+		}
+	case StateHalfOpen:
+		if probe {
+			b.probes--
+		}
+		if failure {
+			b.setStateLocked(StateOpen)
+			return
+		}
+		b.successes++
+		if b.successes >= b.config.HalfOpenProbes {
+			b.setStateLocked(StateClosed)
+		}
+	}
+}
+
+// isFailure 설정 기준으로 의존성 실패인지 판단
+func (b *Breaker) isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if b.config.IsFailure != nil {
+		return b.config.IsFailure(err)
+	}
+	return true
+}
+
+// setStateLocked 상태 변경 (mu를 잡은 상태에서 호출)
+func (b *Breaker) setStateLocked(state State) {
+	if state == StateOpen {
+		b.openedAt = b.now()
+	}
+	if state == StateClosed {
+		b.failures = 0
+	}
+	b.probes = 0
+	b.successes = 0
+	b.generation++
+
+	if b.state != state {
+		b.state = state
+		b.metrics.transition(b.name, state)
+	}
+}
@@ -0,0 +1,194 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDependency = errors.New("connection refused")
+
+// newTestBreaker 시간을 직접 움직일 수 있는 차단기
+func newTestBreaker(config Config) (*Breaker, *time.Time) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := New("test", config)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func fail(b *Breaker, times int) {
+	for i := 0; i < times; i++ {
+		_ = b.Do(context.Background(), func(context.Context) error { return errDependency })
+	}
+}
+
+func TestBreaker_OpensAfterThreshold(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 3, OpenTimeout: time.Minute})
+
+	fail(b, 2)
+	assert.Equal(t, StateClosed, b.State())
+
+	// 성공하면 연속 실패 수가 초기화됨
+	require.NoError(t, b.Do(context.Background(), func(context.Context) error { return nil }))
+	fail(b, 2)
+	assert.Equal(t, StateClosed, b.State())
+
+	fail(b, 1)
+	assert.Equal(t, StateOpen, b.State())
+	assert.False(t, b.Ready())
+
+	called := false
+	err := b.Do(context.Background(), func(context.Context) error {
+		called = true
+		return nil
+	})
+	assert.False(t, called)
+	assert.ErrorIs(t, err, ErrOpen)
+
+	var openErr *OpenError
+	require.True(t, errors.As(err, &openErr))
+	assert.Equal(t, "test", openErr.Name)
+	assert.Equal(t, time.Minute, openErr.RetryAfter)
+}
+
+func TestBreaker_HalfOpenProbing(t *testing.T) {
+	b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenProbes: 1})
+
+	fail(b, 1)
+	require.Equal(t, StateOpen, b.State())
+
+	*now = now.Add(time.Minute)
+	assert.True(t, b.Ready())
+
+	// 시험 호출 하나만 허용
+	done, err := b.Allow(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, StateHalfOpen, b.State())
+	assert.False(t, b.Ready())
+
+	_, err = b.Allow(context.Background())
+	assert.ErrorIs(t, err, ErrOpen)
+
+	// 시험 호출이 실패하면 다시 열림
+	done(errDependency)
+	assert.Equal(t, StateOpen, b.State())
+
+	*now = now.Add(time.Minute)
+	require.NoError(t, b.Do(context.Background(), func(context.Context) error { return nil }))
+	assert.Equal(t, StateClosed, b.State())
+	assert.True(t, b.Ready())
+}
+
+func TestBreaker_IgnoresCallerCancellation(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := b.Do(ctx, func(ctx context.Context) error { return ctx.Err() })
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, StateClosed, b.State())
+}
+
+func TestBreaker_IsFailure(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 1, IsFailure: func(err error) bool {
+		return errors.Is(err, errDependency)
+	}})
+
+	_ = b.Do(context.Background(), func(context.Context) error { return errors.New("not found") })
+	assert.Equal(t, StateClosed, b.State())
+
+	fail(b, 1)
+	assert.Equal(t, StateOpen, b.State())
+
+	b.Reset()
+	assert.Equal(t, StateClosed, b.State())
+	assert.Equal(t, 0, b.Snapshot().Failures)
+}
+
+func TestBreaker_IgnoresResultsFromPreviousState(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 1})
+
+	done, err := b.Allow(context.Background())
+	require.NoError(t, err)
+
+	fail(b, 1)
+	b.Reset()
+
+	// 회로가 열리기 전에 시작한 호출의 실패는 새 상태에 영향 없음
+	done(errDependency)
+	assert.Equal(t, StateClosed, b.State())
+}
+
+func TestRegistry_Metrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	registry := NewRegistry(Config{FailureThreshold: 1, OpenTimeout: time.Minute}, reg)
+
+	b := registry.Get("redis:test")
+	assert.Same(t, b, registry.Get("redis:test"))
+
+	require.NoError(t, b.Do(context.Background(), func(context.Context) error { return nil }))
+	fail(b, 1)
+	fail(b, 1)
+
+	assert.Equal(t, float64(StateOpen), testutil.ToFloat64(registry.metrics.state.WithLabelValues("redis:test")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(registry.metrics.calls.WithLabelValues("redis:test", resultSuccess)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(registry.metrics.calls.WithLabelValues("redis:test", resultFailure)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(registry.metrics.calls.WithLabelValues("redis:test", resultRejected)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(registry.metrics.transitions.WithLabelValues("redis:test", "open")))
+
+	snapshots := registry.Snapshots()
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, "open", snapshots[0].State)
+
+	// 같은 registerer로 다시 만들어도 패닉 없이 기존 메트릭 사용
+	assert.NotPanics(t, func() { NewRegistry(DefaultConfig(), reg) })
+
+	var nilRegistry *Registry
+	assert.Nil(t, nilRegistry.Get("redis:test"))
+}
+
+func TestIsRedisFailure(t *testing.T) {
+	assert.False(t, IsRedisFailure(nil))
+	assert.False(t, IsRedisFailure(redis.Nil))
+	assert.True(t, IsRedisFailure(errDependency))
+}
+
+func TestRedisHook_FailsFastWhenOpen(t *testing.T) {
+	b := New("redis:test", Config{FailureThreshold: 1, OpenTimeout: time.Minute, IsFailure: IsRedisFailure})
+	client := redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		MaxRetries:  -1,
+		DialTimeout: 100 * time.Millisecond,
+	})
+	defer client.Close()
+	client.AddHook(NewRedisHook(b))
+
+	ctx := context.Background()
+	err := client.Ping(ctx).Err()
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrOpen)
+	assert.Equal(t, StateOpen, b.State())
+
+	err = client.Ping(ctx).Err()
+	assert.ErrorIs(t, err, ErrOpen)
+
+	_, err = client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Get(ctx, "key")
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrOpen)
+}
+
+func TestBreaker_Nil(t *testing.T) {
+	var b *Breaker
+	assert.True(t, b.Ready())
+	assert.Equal(t, StateClosed, b.State())
+	assert.Equal(t, errDependency, b.Do(context.Background(), func(context.Context) error { return errDependency }))
+}
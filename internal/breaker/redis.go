@@ -0,0 +1,74 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// IsRedisFailure Redis 연결 문제로 볼 에러인지 판단 (redis.Nil과 서버 응답 에러는 제외)
+func IsRedisFailure(err error) bool {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return false
+	}
+	var reply redis.Error
+	return !errors.As(err, &reply)
+}
+
+// redisDoneKey BeforeProcess에서 시작한 호출의 완료 함수를 담는 context 키
+type redisDoneKey struct{}
+
+// RedisHook Redis 명령과 파이프라인에 회로 차단기를 적용하는 go-redis 훅
+// 회로가 열려 있으면 명령을 보내지 않고 *OpenError로 바로 실패합니다.
+type RedisHook struct {
+	breaker *Breaker
+}
+
+// NewRedisHook 차단기를 적용하는 Redis 훅 생성 (client.AddHook으로 등록)
+func NewRedisHook(b *Breaker) *RedisHook {
+	return &RedisHook{breaker: b}
+}
+
+// BeforeProcess 명령 실행 전 회로 확인
+func (h *RedisHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return h.before(ctx)
+}
+
+// AfterProcess 명령 결과 기록
+func (h *RedisHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	if done, ok := ctx.Value(redisDoneKey{}).(func(error)); ok {
+		done(cmd.Err())
+	}
+	return nil
+}
+
+// BeforeProcessPipeline 파이프라인 실행 전 회로 확인
+func (h *RedisHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	return h.before(ctx)
+}
+
+// AfterProcessPipeline 파이프라인 결과 기록 (연결 문제로 실패한 첫 명령 기준)
+func (h *RedisHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	done, ok := ctx.Value(redisDoneKey{}).(func(error))
+	if !ok {
+		return nil
+	}
+	var failed error
+	for _, cmd := range cmds {
+		if err := cmd.Err(); IsRedisFailure(err) {
+			failed = err
+			break
+		}
+	}
+	done(failed)
+	return nil
+}
+
+func (h *RedisHook) before(ctx context.Context) (context.Context, error) {
+	done, err := h.breaker.Allow(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, redisDoneKey{}, done), nil
+}
@@ -0,0 +1,141 @@
+package breaker
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// 호출 결과 (메트릭 레이블)
+const (
+	resultSuccess  = "success"
+	resultFailure  = "failure"
+	resultRejected = "rejected"
+)
+
+// metrics 의존성별 회로 차단기 메트릭
+type metrics struct {
+	state       *prometheus.GaugeVec
+	calls       *prometheus.CounterVec
+	transitions *prometheus.CounterVec
+}
+
+func newMetrics(reg prometheus.Registerer) *metrics {
+	return &metrics{
+		state: register(reg, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "aicli_circuit_breaker_state",
+			Help: "의존성별 회로 차단기 상태 (0: closed, 1: half-open, 2: open)",
+		}, []string{"dependency"})),
+		calls: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "aicli_circuit_breaker_calls_total",
+			Help: "회로 차단기를 거친 의존성 호출 수 (의존성, 결과별)",
+		}, []string{"dependency", "result"})),
+		transitions: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "aicli_circuit_breaker_transitions_total",
+			Help: "회로 차단기 상태 변경 수 (의존성, 바뀐 상태별)",
+		}, []string{"dependency", "state"})),
+	}
+}
+
+func (m *metrics) setState(name string, state State) {
+	if m != nil {
+		m.state.WithLabelValues(name).Set(float64(state))
+	}
+}
+
+func (m *metrics) observe(name, result string) {
+	if m != nil {
+		m.calls.WithLabelValues(name, result).Inc()
+	}
+}
+
+func (m *metrics) transition(name string, state State) {
+	if m != nil {
+		m.setState(name, state)
+		m.transitions.WithLabelValues(name, state.String()).Inc()
+	}
+}
+
+// register 메트릭 등록 (이미 등록되어 있으면 기존 메트릭 사용)
+func register[C prometheus.Collector](reg prometheus.Registerer, collector C) C {
+	if reg == nil {
+		return collector
+	}
+	if err := reg.Register(collector); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if errors.As(err, &registered) {
+			if existing, ok := registered.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+	}
+	return collector
+}
+
+// Registry 의존성 이름별 회로 차단기 모음
+// 같은 이름으로 요청하면 같은 차단기를 돌려주므로 여러 클라이언트가 한 의존성의 상태를 공유합니다.
+// nil Registry는 차단기 없이 호출을 그대로 허용합니다.
+type Registry struct {
+	config  Config
+	metrics *metrics
+
+	mu       sync.Mutex
+	breakers map[string]*Breaker
+}
+
+// NewRegistry 공통 설정으로 차단기를 만드는 레지스트리 생성 (registerer가 nil이면 메트릭을 등록하지 않음)
+func NewRegistry(config Config, registerer prometheus.Registerer) *Registry {
+	return &Registry{
+		config:   config,
+		metrics:  newMetrics(registerer),
+		breakers: make(map[string]*Breaker),
+	}
+}
+
+// Get 이름의 차단기 (없으면 공통 설정으로 생성, nil Registry면 nil)
+func (r *Registry) Get(name string) *Breaker {
+	return r.GetWith(name, nil)
+}
+
+// GetWith 이름의 차단기 (없으면 공통 설정에 의존성별 실패 판단을 더해 생성, nil Registry면 nil)
+// 이미 만들어진 차단기는 isFailure를 바꾸지 않습니다.
+func (r *Registry) GetWith(name string, isFailure func(error) bool) *Breaker {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if b, ok := r.breakers[name]; ok {
+		return b
+	}
+	config := r.config
+	if isFailure != nil {
+		config.IsFailure = isFailure
+	}
+	b := newBreaker(name, config, r.metrics)
+	r.breakers[name] = b
+	return b
+}
+
+// Snapshots 모든 차단기의 현재 상태 (이름순)
+func (r *Registry) Snapshots() []Snapshot {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	breakers := make([]*Breaker, 0, len(r.breakers))
+	for _, b := range r.breakers {
+		breakers = append(breakers, b)
+	}
+	r.mu.Unlock()
+
+	snapshots := make([]Snapshot, 0, len(breakers))
+	for _, b := range breakers {
+		snapshots = append(snapshots, b.Snapshot())
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Name < snapshots[j].Name })
+	return snapshots
+}
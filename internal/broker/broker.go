@@ -0,0 +1,37 @@
+// Package broker는 내부 이벤트 버스의 이벤트를 외부 메시지 브로커(NATS JetStream, Kafka)로
+// 전달하여 다른 시스템이 aicli 이벤트를 구독할 수 있도록 하는 커넥터를 제공합니다.
+package broker
+
+import (
+	"context"
+	"time"
+)
+
+// Event 외부로 전달되는 이벤트
+type Event struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	Source    string      `json:"source"`
+	Subject   string      `json:"subject,omitempty"`
+	Timestamp time.Time   `json:"timestamp"`
+	Data      interface{} `json:"data,omitempty"`
+}
+
+// Message 브로커로 전송되는 메시지
+// Key는 Kafka 파티션 키로, ID는 NATS JetStream 중복 제거에 사용됩니다.
+type Message struct {
+	ID      string
+	Topic   string
+	Key     string
+	Value   []byte
+	Headers map[string]string
+}
+
+// Publisher 외부 브로커 발행자
+type Publisher interface {
+	// Publish 메시지를 발행하고 브로커의 확인을 기다립니다
+	Publish(ctx context.Context, msg *Message) error
+
+	// Close 연결 종료
+	Close() error
+}
@@ -0,0 +1,65 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewConnectorFromConfig 설정에 맞는 브로커에 연결하고 커넥터를 생성합니다.
+// 브로커가 설정되지 않았으면(none) nil을 반환합니다.
+func NewConnectorFromConfig(ctx context.Context, cfg config.EventsConfig, reg prometheus.Registerer, logger *logrus.Logger) (*Connector, error) {
+	if cfg.Broker == "" || cfg.Broker == "none" {
+		return nil, nil
+	}
+
+	serializer, err := NewSerializer(Serialization(cfg.Serialization))
+	if err != nil {
+		return nil, err
+	}
+
+	var publisher Publisher
+	switch cfg.Broker {
+	case "nats":
+		publisher, err = NewNATSPublisher(ctx, NATSConfig{
+			URL:      cfg.NATS.URL,
+			Name:     "aicli-web",
+			Username: cfg.NATS.Username,
+			Password: cfg.NATS.Password,
+			Token:    cfg.NATS.Token,
+			Stream:   cfg.NATS.Stream,
+			Subjects: cfg.NATS.Subjects,
+			MaxAge:   cfg.NATS.MaxAge,
+		})
+	case "kafka":
+		publisher, err = NewKafkaPublisher(KafkaConfig{
+			Brokers:                cfg.Kafka.Brokers,
+			ClientID:               cfg.Kafka.ClientID,
+			RequiredAcks:           cfg.Kafka.RequiredAcks,
+			Compression:            cfg.Kafka.Compression,
+			AllowAutoTopicCreation: cfg.Kafka.AllowAutoTopicCreation,
+		})
+	default:
+		return nil, fmt.Errorf("지원하지 않는 이벤트 브로커: %s", cfg.Broker)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewConnector(publisher, serializer, ConnectorConfig{
+		Broker: cfg.Broker,
+		Topics: TopicConfig{
+			Default:   cfg.Topics.Default,
+			Prefix:    cfg.Topics.Prefix,
+			Overrides: cfg.Topics.Overrides,
+		},
+		BufferSize: cfg.BufferSize,
+		MaxRetries: cfg.MaxRetries,
+		Registerer: reg,
+		Logger:     logger,
+	}), nil
+}
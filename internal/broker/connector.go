@@ -0,0 +1,318 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrConnectorClosed 종료된 커넥터에 이벤트를 보낸 경우
+var ErrConnectorClosed = errors.New("이벤트 커넥터가 종료되었습니다")
+
+// TopicConfig 이벤트 타입별 토픽(NATS subject) 결정 규칙
+type TopicConfig struct {
+	// Default 지정되면 오버라이드가 없는 모든 이벤트를 이 토픽으로 보냅니다
+	Default string
+	// Prefix Default가 없을 때 "<Prefix>.<이벤트 타입>" 형태로 토픽을 만듭니다
+	Prefix string
+	// Overrides 이벤트 타입별 토픽
+	Overrides map[string]string
+}
+
+// Topic 이벤트 타입에 해당하는 토픽
+func (t TopicConfig) Topic(eventType string) string {
+	if topic, ok := t.Overrides[eventType]; ok && topic != "" {
+		return topic
+	}
+	if t.Default != "" {
+		return t.Default
+	}
+	if t.Prefix == "" {
+		return eventType
+	}
+	return strings.TrimSuffix(t.Prefix, ".") + "." + eventType
+}
+
+// ConnectorConfig 커넥터 설정
+type ConnectorConfig struct {
+	// Broker 메트릭 레이블로 사용할 브로커 이름 (nats, kafka)
+	Broker string
+	// Source 이벤트 출처 (기본값: aicli)
+	Source string
+	Topics TopicConfig
+
+	BufferSize     int
+	MaxRetries     int
+	RetryBackoff   time.Duration
+	PublishTimeout time.Duration
+
+	// Registerer 메트릭을 등록할 Prometheus 레지스트리 (nil이면 등록하지 않음)
+	Registerer prometheus.Registerer
+	Logger     *logrus.Logger
+}
+
+// 기본 커넥터 설정 값
+const (
+	DefaultBufferSize     = 1024
+	DefaultMaxRetries     = 3
+	DefaultRetryBackoff   = 200 * time.Millisecond
+	DefaultPublishTimeout = 5 * time.Second
+)
+
+// connectorMetrics Prometheus 전달 메트릭
+type connectorMetrics struct {
+	messages   *prometheus.CounterVec
+	duration   *prometheus.HistogramVec
+	queueDepth prometheus.Gauge
+}
+
+func newConnectorMetrics(reg prometheus.Registerer, broker string) *connectorMetrics {
+	factory := promauto.With(reg)
+	labels := prometheus.Labels{"broker": broker}
+	return &connectorMetrics{
+		messages: factory.NewCounterVec(prometheus.CounterOpts{
+			Name:        "aicli_event_broker_messages_total",
+			Help:        "외부 브로커로 전달한 이벤트 수 (결과별)",
+			ConstLabels: labels,
+		}, []string{"topic", "result"}),
+		duration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "aicli_event_broker_publish_duration_seconds",
+			Help:        "외부 브로커 발행 소요 시간",
+			ConstLabels: labels,
+			Buckets:     prometheus.DefBuckets,
+		}, []string{"topic"}),
+		queueDepth: factory.NewGauge(prometheus.GaugeOpts{
+			Name:        "aicli_event_broker_queue_depth",
+			Help:        "발행 대기 중인 이벤트 수",
+			ConstLabels: labels,
+		}),
+	}
+}
+
+// Connector 이벤트를 비동기로 외부 브로커에 전달하는 커넥터
+// 이벤트는 버퍼링된 큐를 통해 단일 워커가 순서대로 발행하며,
+// 큐가 가득 차면 이벤트 버스를 막지 않도록 이벤트를 버립니다.
+type Connector struct {
+	publisher  Publisher
+	serializer Serializer
+	config     ConnectorConfig
+	logger     *logrus.Logger
+	metrics    *connectorMetrics
+
+	queue  chan *Event
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+
+	published int64
+	failed    int64
+	dropped   int64
+	retries   int64
+
+	statsMu         sync.RWMutex
+	lastError       string
+	lastErrorAt     time.Time
+	lastPublishedAt time.Time
+}
+
+// NewConnector 새 커넥터 생성 후 발행 워커 시작
+func NewConnector(publisher Publisher, serializer Serializer, config ConnectorConfig) *Connector {
+	if config.Source == "" {
+		config.Source = "aicli"
+	}
+	if config.BufferSize <= 0 {
+		config.BufferSize = DefaultBufferSize
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = DefaultRetryBackoff
+	}
+	if config.PublishTimeout <= 0 {
+		config.PublishTimeout = DefaultPublishTimeout
+	}
+	logger := config.Logger
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+
+	c := &Connector{
+		publisher:  publisher,
+		serializer: serializer,
+		config:     config,
+		logger:     logger,
+		metrics:    newConnectorMetrics(config.Registerer, config.Broker),
+		queue:      make(chan *Event, config.BufferSize),
+	}
+
+	c.wg.Add(1)
+	go c.run()
+
+	return c
+}
+
+// Publish 이벤트를 발행 큐에 추가합니다 (논블로킹)
+func (c *Connector) Publish(event *Event) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return ErrConnectorClosed
+	}
+	if event.Source == "" {
+		event.Source = c.config.Source
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	select {
+	case c.queue <- event:
+		c.metrics.queueDepth.Set(float64(len(c.queue)))
+		return nil
+	default:
+		atomic.AddInt64(&c.dropped, 1)
+		c.metrics.messages.WithLabelValues(c.config.Topics.Topic(event.Type), "dropped").Inc()
+		return errors.New("이벤트 큐가 가득 찼습니다")
+	}
+}
+
+// Close 큐에 남은 이벤트를 발행한 뒤 브로커 연결을 종료합니다
+// ctx가 먼저 만료되면 남은 이벤트를 버립니다.
+func (c *Connector) Close(ctx context.Context) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	close(c.queue)
+	c.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if closeErr := c.publisher.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// GetMetrics 전달 통계
+func (c *Connector) GetMetrics() map[string]interface{} {
+	c.statsMu.RLock()
+	defer c.statsMu.RUnlock()
+
+	metrics := map[string]interface{}{
+		"broker":         c.config.Broker,
+		"published":      atomic.LoadInt64(&c.published),
+		"failed":         atomic.LoadInt64(&c.failed),
+		"dropped":        atomic.LoadInt64(&c.dropped),
+		"retries":        atomic.LoadInt64(&c.retries),
+		"queue_length":   len(c.queue),
+		"queue_capacity": cap(c.queue),
+	}
+	if !c.lastPublishedAt.IsZero() {
+		metrics["last_published_at"] = c.lastPublishedAt
+	}
+	if c.lastError != "" {
+		metrics["last_error"] = c.lastError
+		metrics["last_error_at"] = c.lastErrorAt
+	}
+	return metrics
+}
+
+// run 큐에서 이벤트를 꺼내 발행하는 워커
+func (c *Connector) run() {
+	defer c.wg.Done()
+
+	for event := range c.queue {
+		c.metrics.queueDepth.Set(float64(len(c.queue)))
+		c.deliver(event)
+	}
+}
+
+// deliver 이벤트를 직렬화하여 재시도와 함께 발행
+func (c *Connector) deliver(event *Event) {
+	topic := c.config.Topics.Topic(event.Type)
+
+	payload, err := c.serializer.Marshal(event)
+	if err != nil {
+		c.recordFailure(topic, err)
+		return
+	}
+
+	msg := &Message{
+		ID:    event.ID,
+		Topic: topic,
+		Key:   event.Subject,
+		Value: payload,
+		Headers: map[string]string{
+			"content-type": c.serializer.ContentType(),
+			"event-type":   event.Type,
+			"event-source": event.Source,
+		},
+	}
+
+	backoff := c.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), c.config.PublishTimeout)
+		err = c.publisher.Publish(ctx, msg)
+		cancel()
+		c.metrics.duration.WithLabelValues(topic).Observe(time.Since(start).Seconds())
+
+		if err == nil {
+			atomic.AddInt64(&c.published, 1)
+			c.metrics.messages.WithLabelValues(topic, "published").Inc()
+			c.statsMu.Lock()
+			c.lastPublishedAt = time.Now()
+			c.statsMu.Unlock()
+			return
+		}
+
+		if attempt >= c.config.MaxRetries {
+			break
+		}
+		atomic.AddInt64(&c.retries, 1)
+		c.metrics.messages.WithLabelValues(topic, "retried").Inc()
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+
+	c.recordFailure(topic, err)
+}
+
+// recordFailure 발행 실패 기록
+func (c *Connector) recordFailure(topic string, err error) {
+	atomic.AddInt64(&c.failed, 1)
+	c.metrics.messages.WithLabelValues(topic, "failed").Inc()
+
+	c.statsMu.Lock()
+	c.lastError = err.Error()
+	c.lastErrorAt = time.Now()
+	c.statsMu.Unlock()
+
+	c.logger.WithFields(logrus.Fields{
+		"broker": c.config.Broker,
+		"topic":  topic,
+	}).WithError(err).Warn("이벤트 발행 실패")
+}
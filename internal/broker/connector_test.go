@@ -0,0 +1,142 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePublisher 발행된 메시지를 기록하는 테스트용 발행자
+type fakePublisher struct {
+	mu       sync.Mutex
+	messages []*Message
+	failures int
+	closed   bool
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, msg *Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("broker unavailable")
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *fakePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func TestTopicConfig_Topic(t *testing.T) {
+	topics := TopicConfig{
+		Prefix:    "aicli.events.",
+		Overrides: map[string]string{"session.error": "aicli.alerts"},
+	}
+	assert.Equal(t, "aicli.events.session.created", topics.Topic("session.created"))
+	assert.Equal(t, "aicli.alerts", topics.Topic("session.error"))
+
+	topics.Default = "aicli"
+	assert.Equal(t, "aicli", topics.Topic("session.created"))
+	assert.Equal(t, "aicli.alerts", topics.Topic("session.error"))
+}
+
+func TestConnector_PublishesInOrder(t *testing.T) {
+	pub := &fakePublisher{}
+	reg := prometheus.NewRegistry()
+	c := NewConnector(pub, JSONSerializer{}, ConnectorConfig{
+		Broker:     "test",
+		Topics:     TopicConfig{Prefix: "aicli.events"},
+		Registerer: reg,
+	})
+
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, c.Publish(&Event{ID: id, Type: "session.created", Subject: "session-1"}))
+	}
+	require.NoError(t, c.Close(context.Background()))
+
+	require.Len(t, pub.messages, 3)
+	for i, msg := range pub.messages {
+		assert.Equal(t, []string{"1", "2", "3"}[i], msg.ID)
+		assert.Equal(t, "aicli.events.session.created", msg.Topic)
+		assert.Equal(t, "session-1", msg.Key)
+		assert.Equal(t, "application/json", msg.Headers["content-type"])
+		assert.Equal(t, "aicli", msg.Headers["event-source"])
+	}
+	assert.True(t, pub.closed)
+
+	metrics := c.GetMetrics()
+	assert.Equal(t, int64(3), metrics["published"])
+	assert.Equal(t, float64(3), testutil.ToFloat64(c.metrics.messages.WithLabelValues("aicli.events.session.created", "published")))
+
+	assert.ErrorIs(t, c.Publish(&Event{ID: "4"}), ErrConnectorClosed)
+}
+
+func TestConnector_RetriesAndFailures(t *testing.T) {
+	pub := &fakePublisher{failures: 1}
+	c := NewConnector(pub, JSONSerializer{}, ConnectorConfig{
+		MaxRetries:   1,
+		RetryBackoff: time.Millisecond,
+	})
+
+	require.NoError(t, c.Publish(&Event{ID: "1", Type: "session.created"}))
+	require.NoError(t, c.Close(context.Background()))
+
+	metrics := c.GetMetrics()
+	assert.Equal(t, int64(1), metrics["published"])
+	assert.Equal(t, int64(1), metrics["retries"])
+	assert.Equal(t, int64(0), metrics["failed"])
+
+	pub = &fakePublisher{failures: 5}
+	c = NewConnector(pub, JSONSerializer{}, ConnectorConfig{
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	})
+	require.NoError(t, c.Publish(&Event{ID: "1", Type: "session.created"}))
+	require.NoError(t, c.Close(context.Background()))
+
+	metrics = c.GetMetrics()
+	assert.Equal(t, int64(0), metrics["published"])
+	assert.Equal(t, int64(1), metrics["failed"])
+	assert.Equal(t, "broker unavailable", metrics["last_error"])
+}
+
+// blockingPublisher release가 닫힐 때까지 발행을 막는 테스트용 발행자
+type blockingPublisher struct {
+	fakePublisher
+	release chan struct{}
+}
+
+func (p *blockingPublisher) Publish(ctx context.Context, msg *Message) error {
+	<-p.release
+	return p.fakePublisher.Publish(ctx, msg)
+}
+
+func TestConnector_DropsWhenQueueFull(t *testing.T) {
+	pub := &blockingPublisher{release: make(chan struct{})}
+	c := NewConnector(pub, JSONSerializer{}, ConnectorConfig{BufferSize: 1})
+
+	// 첫 이벤트는 워커가 가져가 대기하고, 두 번째는 큐에, 이후는 버려짐
+	require.NoError(t, c.Publish(&Event{ID: "1"}))
+	require.Eventually(t, func() bool { return len(c.queue) == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, c.Publish(&Event{ID: "2"}))
+	assert.Error(t, c.Publish(&Event{ID: "3"}))
+
+	close(pub.release)
+	require.NoError(t, c.Close(context.Background()))
+
+	metrics := c.GetMetrics()
+	assert.Equal(t, int64(2), metrics["published"])
+	assert.Equal(t, int64(1), metrics["dropped"])
+}
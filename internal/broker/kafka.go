@@ -0,0 +1,90 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaConfig Kafka 프로듀서 설정
+type KafkaConfig struct {
+	Brokers  []string
+	ClientID string
+
+	// RequiredAcks none, one, all 중 하나 (기본값: all)
+	RequiredAcks string
+	// Compression none, gzip, snappy, lz4, zstd 중 하나 (기본값: none)
+	Compression string
+
+	BatchTimeout time.Duration
+	// AllowAutoTopicCreation 존재하지 않는 토픽을 자동 생성
+	AllowAutoTopicCreation bool
+}
+
+// KafkaPublisher Kafka 프로듀서
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaPublisher Kafka 프로듀서 생성
+// 토픽은 메시지마다 지정되므로 Writer에는 토픽을 설정하지 않습니다.
+func NewKafkaPublisher(cfg KafkaConfig) (*KafkaPublisher, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, errors.New("Kafka 브로커 주소가 필요합니다")
+	}
+
+	acks := kafka.RequireAll
+	if cfg.RequiredAcks != "" {
+		if err := acks.UnmarshalText([]byte(cfg.RequiredAcks)); err != nil {
+			return nil, fmt.Errorf("잘못된 required_acks 설정: %w", err)
+		}
+	}
+
+	var compression kafka.Compression
+	if cfg.Compression != "" {
+		if err := compression.UnmarshalText([]byte(cfg.Compression)); err != nil {
+			return nil, fmt.Errorf("잘못된 compression 설정: %w", err)
+		}
+	}
+
+	batchTimeout := cfg.BatchTimeout
+	if batchTimeout <= 0 {
+		batchTimeout = 10 * time.Millisecond
+	}
+
+	writer := &kafka.Writer{
+		Addr:                   kafka.TCP(cfg.Brokers...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           acks,
+		Compression:            compression,
+		BatchTimeout:           batchTimeout,
+		AllowAutoTopicCreation: cfg.AllowAutoTopicCreation,
+		Transport:              &kafka.Transport{ClientID: cfg.ClientID},
+	}
+
+	return &KafkaPublisher{writer: writer}, nil
+}
+
+// Publish 메시지를 Kafka에 전송합니다
+// 같은 키(세션 ID)를 가진 메시지는 같은 파티션으로 전달되어 순서가 보장됩니다.
+func (p *KafkaPublisher) Publish(ctx context.Context, msg *Message) error {
+	headers := make([]kafka.Header, 0, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
+
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Topic:   msg.Topic,
+		Key:     []byte(msg.Key),
+		Value:   msg.Value,
+		Headers: headers,
+	})
+}
+
+// Close 보류 중인 메시지를 전송하고 프로듀서 종료
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,100 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSConfig NATS JetStream 발행자 설정
+type NATSConfig struct {
+	URL      string
+	Name     string
+	Username string
+	Password string
+	Token    string
+
+	// Stream이 지정되면 시작 시 스트림을 생성하거나 갱신합니다
+	Stream   string
+	Subjects []string
+	MaxAge   time.Duration
+}
+
+// NATSPublisher NATS JetStream 발행자
+type NATSPublisher struct {
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+// NewNATSPublisher NATS에 연결하고 JetStream 발행자 생성
+func NewNATSPublisher(ctx context.Context, cfg NATSConfig) (*NATSPublisher, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("NATS URL이 필요합니다")
+	}
+
+	opts := []nats.Option{nats.MaxReconnects(-1)}
+	if cfg.Name != "" {
+		opts = append(opts, nats.Name(cfg.Name))
+	}
+	if cfg.Username != "" {
+		opts = append(opts, nats.UserInfo(cfg.Username, cfg.Password))
+	}
+	if cfg.Token != "" {
+		opts = append(opts, nats.Token(cfg.Token))
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("NATS 연결 실패: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("JetStream 초기화 실패: %w", err)
+	}
+
+	if cfg.Stream != "" {
+		streamCfg := jetstream.StreamConfig{
+			Name:     cfg.Stream,
+			Subjects: cfg.Subjects,
+			MaxAge:   cfg.MaxAge,
+		}
+		if _, err := js.CreateOrUpdateStream(ctx, streamCfg); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("JetStream 스트림 생성 실패: %w", err)
+		}
+	}
+
+	return &NATSPublisher{conn: conn, js: js}, nil
+}
+
+// Publish 메시지를 JetStream에 발행하고 확인(ack)을 기다립니다
+func (p *NATSPublisher) Publish(ctx context.Context, msg *Message) error {
+	m := nats.NewMsg(msg.Topic)
+	m.Data = msg.Value
+	for k, v := range msg.Headers {
+		m.Header.Set(k, v)
+	}
+
+	var opts []jetstream.PublishOpt
+	if msg.ID != "" {
+		opts = append(opts, jetstream.WithMsgID(msg.ID))
+	}
+
+	_, err := p.js.PublishMsg(ctx, m, opts...)
+	return err
+}
+
+// Close 연결 종료
+func (p *NATSPublisher) Close() error {
+	if err := p.conn.Drain(); err != nil {
+		p.conn.Close()
+		return err
+	}
+	return nil
+}
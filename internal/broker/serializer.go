@@ -0,0 +1,178 @@
+package broker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Serialization 직렬화 형식
+type Serialization string
+
+const (
+	// SerializationJSON JSON 직렬화
+	SerializationJSON Serialization = "json"
+	// SerializationProtobuf Protocol Buffers 직렬화
+	SerializationProtobuf Serialization = "protobuf"
+)
+
+// Serializer 이벤트 직렬화기
+type Serializer interface {
+	// Marshal 이벤트를 바이트로 변환
+	Marshal(event *Event) ([]byte, error)
+
+	// Unmarshal 바이트를 이벤트로 변환
+	Unmarshal(data []byte) (*Event, error)
+
+	// ContentType 메시지 헤더에 기록할 콘텐츠 타입
+	ContentType() string
+}
+
+// NewSerializer 형식에 맞는 직렬화기 생성
+func NewSerializer(format Serialization) (Serializer, error) {
+	switch format {
+	case "", SerializationJSON:
+		return JSONSerializer{}, nil
+	case SerializationProtobuf:
+		return ProtobufSerializer{}, nil
+	default:
+		return nil, fmt.Errorf("지원하지 않는 직렬화 형식: %s", format)
+	}
+}
+
+// JSONSerializer JSON 직렬화기
+type JSONSerializer struct{}
+
+// Marshal 이벤트를 JSON으로 변환
+func (JSONSerializer) Marshal(event *Event) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+// Unmarshal JSON을 이벤트로 변환
+func (JSONSerializer) Unmarshal(data []byte) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// ContentType JSON 콘텐츠 타입
+func (JSONSerializer) ContentType() string {
+	return "application/json"
+}
+
+// ProtobufSerializer Protocol Buffers 직렬화기
+//
+// 다음 스키마와 호환되는 바이트를 생성합니다. Data는 임의 구조이므로 JSON으로 인코딩해 담습니다.
+//
+//	message Event {
+//	  string id = 1;
+//	  string type = 2;
+//	  string source = 3;
+//	  string subject = 4;
+//	  int64 timestamp_unix_nano = 5;
+//	  bytes data_json = 6;
+//	}
+type ProtobufSerializer struct{}
+
+const (
+	pbFieldID        protowire.Number = 1
+	pbFieldType      protowire.Number = 2
+	pbFieldSource    protowire.Number = 3
+	pbFieldSubject   protowire.Number = 4
+	pbFieldTimestamp protowire.Number = 5
+	pbFieldData      protowire.Number = 6
+)
+
+// Marshal 이벤트를 protobuf로 변환
+func (ProtobufSerializer) Marshal(event *Event) ([]byte, error) {
+	var b []byte
+	appendString := func(num protowire.Number, v string) {
+		if v == "" {
+			return
+		}
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	}
+
+	appendString(pbFieldID, event.ID)
+	appendString(pbFieldType, event.Type)
+	appendString(pbFieldSource, event.Source)
+	appendString(pbFieldSubject, event.Subject)
+	if !event.Timestamp.IsZero() {
+		b = protowire.AppendTag(b, pbFieldTimestamp, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(event.Timestamp.UnixNano()))
+	}
+	if event.Data != nil {
+		data, err := json.Marshal(event.Data)
+		if err != nil {
+			return nil, fmt.Errorf("이벤트 데이터 인코딩 실패: %w", err)
+		}
+		b = protowire.AppendTag(b, pbFieldData, protowire.BytesType)
+		b = protowire.AppendBytes(b, data)
+	}
+	return b, nil
+}
+
+// Unmarshal protobuf를 이벤트로 변환
+// 알 수 없는 필드는 무시합니다.
+func (ProtobufSerializer) Unmarshal(data []byte) (*Event, error) {
+	event := &Event{}
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		switch {
+		case typ == protowire.BytesType && num != pbFieldTimestamp:
+			v, n := protowire.ConsumeBytes(data)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			data = data[n:]
+			switch num {
+			case pbFieldID:
+				event.ID = string(v)
+			case pbFieldType:
+				event.Type = string(v)
+			case pbFieldSource:
+				event.Source = string(v)
+			case pbFieldSubject:
+				event.Subject = string(v)
+			case pbFieldData:
+				var decoded interface{}
+				if err := json.Unmarshal(v, &decoded); err != nil {
+					return nil, fmt.Errorf("이벤트 데이터 디코딩 실패: %w", err)
+				}
+				event.Data = decoded
+			}
+		case typ == protowire.VarintType && num == pbFieldTimestamp:
+			v, n := protowire.ConsumeVarint(data)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			data = data[n:]
+			event.Timestamp = time.Unix(0, int64(v)).UTC()
+		case num == pbFieldTimestamp:
+			return nil, errors.New("timestamp 필드의 와이어 타입이 올바르지 않습니다")
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			data = data[n:]
+		}
+	}
+	return event, nil
+}
+
+// ContentType protobuf 콘텐츠 타입
+func (ProtobufSerializer) ContentType() string {
+	return "application/x-protobuf"
+}
@@ -0,0 +1,58 @@
+package broker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerializers_RoundTrip(t *testing.T) {
+	event := &Event{
+		ID:        "evt-1",
+		Type:      "session.created",
+		Source:    "aicli",
+		Subject:   "session-1",
+		Timestamp: time.Date(2024, 5, 1, 12, 0, 0, 123, time.UTC),
+		Data:      map[string]interface{}{"workspace_id": "ws-1", "count": float64(2)},
+	}
+
+	for _, format := range []Serialization{SerializationJSON, SerializationProtobuf} {
+		t.Run(string(format), func(t *testing.T) {
+			s, err := NewSerializer(format)
+			require.NoError(t, err)
+
+			data, err := s.Marshal(event)
+			require.NoError(t, err)
+
+			decoded, err := s.Unmarshal(data)
+			require.NoError(t, err)
+			assert.Equal(t, event.ID, decoded.ID)
+			assert.Equal(t, event.Type, decoded.Type)
+			assert.Equal(t, event.Source, decoded.Source)
+			assert.Equal(t, event.Subject, decoded.Subject)
+			assert.True(t, event.Timestamp.Equal(decoded.Timestamp))
+			assert.Equal(t, event.Data, decoded.Data)
+		})
+	}
+}
+
+func TestProtobufSerializer_SkipsUnknownFields(t *testing.T) {
+	s := ProtobufSerializer{}
+	data, err := s.Marshal(&Event{ID: "evt-1", Type: "session.closed"})
+	require.NoError(t, err)
+
+	// field 15, varint 1 - 이후 스키마 확장으로 추가된 필드
+	data = append(data, 15<<3, 1)
+
+	decoded, err := s.Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, "evt-1", decoded.ID)
+	assert.Equal(t, "session.closed", decoded.Type)
+}
+
+func TestNewSerializer_Unsupported(t *testing.T) {
+	_, err := NewSerializer("avro")
+	assert.Error(t, err)
+}
@@ -0,0 +1,186 @@
+// Package chaos는 복구와 재시도 동작을 통합 테스트에서 확인할 수 있도록 장애를 주입합니다.
+//
+// 스토리지 호출 지연(WrapStorage), Claude 프로세스 강제 종료(ProcessTracker),
+// WebSocket 프레임 누락(DropFrame)을 확률에 따라 주입하며, 실행 중에 확률을 바꿀 수 있습니다.
+// production 환경에서는 사용하지 않도록 설정 단계에서 막습니다.
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// StorageDelay 스토리지 호출 지연 주입
+type StorageDelay struct {
+	// Probability 호출마다 지연을 주입할 확률 (0~1)
+	Probability float64
+	// Min 최소 지연 시간
+	Min time.Duration
+	// Max 최대 지연 시간 (Min보다 작으면 Min만큼 지연)
+	Max time.Duration
+}
+
+// ProcessKill Claude 프로세스 강제 종료 주입
+type ProcessKill struct {
+	// Probability 실행한 프로세스마다 강제 종료할 확률 (0~1)
+	Probability float64
+	// After 프로세스 시작 후 강제 종료까지 시간
+	After time.Duration
+}
+
+// FrameDrop WebSocket 프레임 누락 주입
+type FrameDrop struct {
+	// Probability 보내는 프레임마다 버릴 확률 (0~1)
+	Probability float64
+}
+
+// Faults 주입할 장애 (확률이 0인 장애는 주입하지 않음)
+type Faults struct {
+	StorageDelay StorageDelay
+	ProcessKill  ProcessKill
+	FrameDrop    FrameDrop
+}
+
+// Validate 확률과 시간 범위 확인
+func (f Faults) Validate() error {
+	probabilities := []struct {
+		name  string
+		value float64
+	}{
+		{"storage_delay", f.StorageDelay.Probability},
+		{"process_kill", f.ProcessKill.Probability},
+		{"frame_drop", f.FrameDrop.Probability},
+	}
+	for _, p := range probabilities {
+		if p.value < 0 || p.value > 1 {
+			return fmt.Errorf("%s 확률은 0과 1 사이여야 합니다: %v", p.name, p.value)
+		}
+	}
+	if f.StorageDelay.Min < 0 || f.StorageDelay.Max < 0 || f.ProcessKill.After < 0 {
+		return fmt.Errorf("장애 주입 시간은 0 이상이어야 합니다")
+	}
+	return nil
+}
+
+// Counts 종류별 주입한 장애 수
+type Counts struct {
+	StorageDelays int64
+	ProcessKills  int64
+	FramesDropped int64
+}
+
+// Injector 설정한 확률에 따라 장애를 주입합니다 (nil Injector는 장애를 주입하지 않음)
+type Injector struct {
+	mu     sync.Mutex
+	faults Faults
+	rand   *rand.Rand
+
+	storageDelays atomic.Int64
+	processKills  atomic.Int64
+	framesDropped atomic.Int64
+}
+
+// New 장애 주입기 생성 (seed가 0이면 현재 시각을 시드로 사용)
+func New(faults Faults, seed int64) (*Injector, error) {
+	if err := faults.Validate(); err != nil {
+		return nil, err
+	}
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Injector{
+		faults: faults,
+		rand:   rand.New(rand.NewSource(seed)),
+	}, nil
+}
+
+// Faults 현재 주입하는 장애
+func (i *Injector) Faults() Faults {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.faults
+}
+
+// SetFaults 주입할 장애를 바꿉니다 (이미 예약한 프로세스 종료는 그대로 진행)
+func (i *Injector) SetFaults(faults Faults) error {
+	if err := faults.Validate(); err != nil {
+		return err
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults = faults
+	return nil
+}
+
+// Counts 시작 후 주입한 장애 수
+func (i *Injector) Counts() Counts {
+	return Counts{
+		StorageDelays: i.storageDelays.Load(),
+		ProcessKills:  i.processKills.Load(),
+		FramesDropped: i.framesDropped.Load(),
+	}
+}
+
+// DelayStorage 확률에 따라 스토리지 호출을 지연합니다
+// 지연 중 ctx가 끝나면 ctx 에러를 반환하므로 호출한 쪽의 기한 처리도 함께 확인할 수 있습니다.
+func (i *Injector) DelayStorage(ctx context.Context) error {
+	if i == nil {
+		return nil
+	}
+	i.mu.Lock()
+	delay := i.faults.StorageDelay
+	inject := i.rollLocked(delay.Probability)
+	if inject && delay.Max > delay.Min {
+		delay.Min += time.Duration(i.rand.Int63n(int64(delay.Max - delay.Min + 1)))
+	}
+	i.mu.Unlock()
+
+	if !inject {
+		return nil
+	}
+	i.storageDelays.Add(1)
+
+	timer := time.NewTimer(delay.Min)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DropFrame 확률에 따라 WebSocket 프레임을 버릴지 결정합니다
+func (i *Injector) DropFrame() bool {
+	if i == nil {
+		return false
+	}
+	i.mu.Lock()
+	drop := i.rollLocked(i.faults.FrameDrop.Probability)
+	i.mu.Unlock()
+
+	if drop {
+		i.framesDropped.Add(1)
+	}
+	return drop
+}
+
+// killAfter 확률에 따라 프로세스를 강제 종료할지와 종료까지 시간을 결정합니다
+func (i *Injector) killAfter() (time.Duration, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	kill := i.faults.ProcessKill
+	return kill.After, i.rollLocked(kill.Probability)
+}
+
+// rollLocked 확률 p로 true (mu를 잡은 상태에서 호출)
+func (i *Injector) rollLocked(p float64) bool {
+	if p <= 0 {
+		return false
+	}
+	return p >= 1 || i.rand.Float64() < p
+}
@@ -0,0 +1,136 @@
+package chaos
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestFaults_Validate(t *testing.T) {
+	assert.NoError(t, Faults{}.Validate())
+	assert.Error(t, Faults{FrameDrop: FrameDrop{Probability: 1.5}}.Validate())
+	assert.Error(t, Faults{StorageDelay: StorageDelay{Probability: -0.1}}.Validate())
+	assert.Error(t, Faults{ProcessKill: ProcessKill{After: -time.Second}}.Validate())
+
+	_, err := New(Faults{ProcessKill: ProcessKill{Probability: 2}}, 1)
+	assert.Error(t, err)
+}
+
+func TestInjector_DropFrame(t *testing.T) {
+	injector, err := New(Faults{}, 1)
+	require.NoError(t, err)
+	assert.False(t, injector.DropFrame())
+
+	require.NoError(t, injector.SetFaults(Faults{FrameDrop: FrameDrop{Probability: 1}}))
+	assert.True(t, injector.DropFrame())
+	assert.Equal(t, int64(1), injector.Counts().FramesDropped)
+
+	// 같은 시드면 같은 순서로 주입
+	first, _ := New(Faults{FrameDrop: FrameDrop{Probability: 0.5}}, 42)
+	second, _ := New(Faults{FrameDrop: FrameDrop{Probability: 0.5}}, 42)
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, first.DropFrame(), second.DropFrame())
+	}
+
+	var nilInjector *Injector
+	assert.False(t, nilInjector.DropFrame())
+	assert.NoError(t, nilInjector.DelayStorage(context.Background()))
+}
+
+func TestInjector_DelayStorage(t *testing.T) {
+	injector, err := New(Faults{StorageDelay: StorageDelay{Probability: 1, Min: 20 * time.Millisecond, Max: 30 * time.Millisecond}}, 1)
+	require.NoError(t, err)
+
+	start := time.Now()
+	require.NoError(t, injector.DelayStorage(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, int64(1), injector.Counts().StorageDelays)
+
+	// 지연 중 기한이 지나면 ctx 에러 반환
+	require.NoError(t, injector.SetFaults(Faults{StorageDelay: StorageDelay{Probability: 1, Min: time.Minute}}))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, injector.DelayStorage(ctx), context.DeadlineExceeded)
+}
+
+func TestWrapStorage(t *testing.T) {
+	injector, err := New(Faults{StorageDelay: StorageDelay{Probability: 1, Min: time.Minute}}, 1)
+	require.NoError(t, err)
+	store := WrapStorage(memory.New(), injector)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = store.Workspace().Create(ctx, &models.Workspace{ID: "ws-1", Name: "chaos", OwnerID: "user-1"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// 장애를 끄면 그대로 전달
+	require.NoError(t, injector.SetFaults(Faults{}))
+	require.NoError(t, store.Workspace().Create(context.Background(), &models.Workspace{ID: "ws-1", Name: "chaos", OwnerID: "user-1", ProjectPath: "/tmp"}))
+	workspace, err := store.Workspace().GetByID(context.Background(), "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, "chaos", workspace.Name)
+}
+
+// recordingTracker 기록된 PID를 보관하는 추적기
+type recordingTracker struct {
+	tracked   []int
+	untracked []int
+}
+
+func (r *recordingTracker) TrackProcess(info claude.ProcessInfo) {
+	r.tracked = append(r.tracked, info.PID)
+}
+func (r *recordingTracker) UntrackProcess(pid int) { r.untracked = append(r.untracked, pid) }
+
+func TestProcessTracker_KillsProcess(t *testing.T) {
+	injector, err := New(Faults{ProcessKill: ProcessKill{Probability: 1, After: 10 * time.Millisecond}}, 1)
+	require.NoError(t, err)
+	inner := &recordingTracker{}
+	tracker := injector.ProcessTracker(inner)
+
+	cmd := exec.Command("sleep", "30")
+	require.NoError(t, cmd.Start())
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	tracker.TrackProcess(claude.ProcessInfo{PID: cmd.Process.Pid})
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		_ = cmd.Process.Kill()
+		t.Fatal("프로세스가 강제 종료되지 않음")
+	}
+	// Wait는 시그널이 도착하자마자 반환되므로 카운터 증가는 조금 늦을 수 있음
+	assert.Eventually(t, func() bool {
+		return injector.Counts().ProcessKills == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []int{cmd.Process.Pid}, inner.tracked)
+}
+
+func TestProcessTracker_UntrackCancelsKill(t *testing.T) {
+	injector, err := New(Faults{ProcessKill: ProcessKill{Probability: 1, After: 50 * time.Millisecond}}, 1)
+	require.NoError(t, err)
+	tracker := injector.ProcessTracker(nil)
+
+	cmd := exec.Command("sleep", "30")
+	require.NoError(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	tracker.TrackProcess(claude.ProcessInfo{PID: cmd.Process.Pid})
+	tracker.UntrackProcess(cmd.Process.Pid)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Zero(t, injector.Counts().ProcessKills)
+}
@@ -0,0 +1,80 @@
+package chaos
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+// processKiller 실행한 프로세스를 확률에 따라 정해진 시간 뒤 강제 종료하는 프로세스 추적기
+// 종료된 프로세스는 프로세스 관리자가 예기치 않은 종료로 감지해 재시작 정책을 적용합니다.
+type processKiller struct {
+	inner    claude.ProcessTracker
+	injector *Injector
+
+	mu     sync.Mutex
+	timers map[int]*time.Timer
+}
+
+// ProcessTracker inner에 기록을 전달하면서 프로세스 강제 종료를 주입하는 추적기 (inner는 nil 가능)
+func (i *Injector) ProcessTracker(inner claude.ProcessTracker) claude.ProcessTracker {
+	return &processKiller{
+		inner:    inner,
+		injector: i,
+		timers:   make(map[int]*time.Timer),
+	}
+}
+
+// TrackProcess 프로세스 기록 후 확률에 따라 강제 종료 예약
+func (k *processKiller) TrackProcess(info claude.ProcessInfo) {
+	if k.inner != nil {
+		k.inner.TrackProcess(info)
+	}
+
+	after, kill := k.injector.killAfter()
+	if !kill {
+		return
+	}
+
+	pid := info.PID
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if timer, ok := k.timers[pid]; ok {
+		timer.Stop()
+	}
+	k.timers[pid] = time.AfterFunc(after, func() { k.kill(pid) })
+}
+
+// UntrackProcess 프로세스 기록 삭제 (예약한 강제 종료 취소)
+func (k *processKiller) UntrackProcess(pid int) {
+	k.mu.Lock()
+	if timer, ok := k.timers[pid]; ok {
+		timer.Stop()
+		delete(k.timers, pid)
+	}
+	k.mu.Unlock()
+
+	if k.inner != nil {
+		k.inner.UntrackProcess(pid)
+	}
+}
+
+func (k *processKiller) kill(pid int) {
+	k.mu.Lock()
+	_, scheduled := k.timers[pid]
+	delete(k.timers, pid)
+	k.mu.Unlock()
+	if !scheduled {
+		return
+	}
+
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return
+	}
+	if process.Kill() == nil {
+		k.injector.processKills.Add(1)
+	}
+}
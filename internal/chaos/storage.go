@@ -0,0 +1,213 @@
+package chaos
+
+import (
+	"context"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// Storage 워크스페이스, 세션, 태스크 호출 전에 지연을 주입하는 스토리지
+// 그 외 스토리지는 그대로 전달합니다.
+type Storage struct {
+	storage.Storage
+
+	injector *Injector
+}
+
+// WrapStorage 스토리지에 호출 지연 주입을 씌웁니다
+func WrapStorage(inner storage.Storage, injector *Injector) *Storage {
+	return &Storage{Storage: inner, injector: injector}
+}
+
+// Workspace 지연을 주입하는 워크스페이스 스토리지
+func (s *Storage) Workspace() storage.WorkspaceStorage {
+	return &workspaceStorage{WorkspaceStorage: s.Storage.Workspace(), injector: s.injector}
+}
+
+// Session 지연을 주입하는 세션 스토리지
+func (s *Storage) Session() storage.SessionStorage {
+	return &sessionStorage{SessionStorage: s.Storage.Session(), injector: s.injector}
+}
+
+// Task 지연을 주입하는 태스크 스토리지
+func (s *Storage) Task() storage.TaskStorage {
+	return &taskStorage{TaskStorage: s.Storage.Task(), injector: s.injector}
+}
+
+type workspaceStorage struct {
+	storage.WorkspaceStorage
+	injector *Injector
+}
+
+func (s *workspaceStorage) Create(ctx context.Context, workspace *models.Workspace) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.WorkspaceStorage.Create(ctx, workspace)
+}
+
+func (s *workspaceStorage) GetByID(ctx context.Context, id string) (*models.Workspace, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, err
+	}
+	return s.WorkspaceStorage.GetByID(ctx, id)
+}
+
+func (s *workspaceStorage) GetByName(ctx context.Context, ownerID, name string) (*models.Workspace, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, err
+	}
+	return s.WorkspaceStorage.GetByName(ctx, ownerID, name)
+}
+
+func (s *workspaceStorage) GetByOwnerID(ctx context.Context, ownerID string, pagination *models.PaginationRequest) ([]*models.Workspace, int, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, 0, err
+	}
+	return s.WorkspaceStorage.GetByOwnerID(ctx, ownerID, pagination)
+}
+
+func (s *workspaceStorage) CountByOwner(ctx context.Context, ownerID string) (int, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return 0, err
+	}
+	return s.WorkspaceStorage.CountByOwner(ctx, ownerID)
+}
+
+func (s *workspaceStorage) Update(ctx context.Context, id string, updates map[string]interface{}) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.WorkspaceStorage.Update(ctx, id, updates)
+}
+
+func (s *workspaceStorage) Delete(ctx context.Context, id string) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.WorkspaceStorage.Delete(ctx, id)
+}
+
+func (s *workspaceStorage) List(ctx context.Context, pagination *models.PaginationRequest) ([]*models.Workspace, int, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, 0, err
+	}
+	return s.WorkspaceStorage.List(ctx, pagination)
+}
+
+func (s *workspaceStorage) ExistsByName(ctx context.Context, ownerID, name string) (bool, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return false, err
+	}
+	return s.WorkspaceStorage.ExistsByName(ctx, ownerID, name)
+}
+
+type sessionStorage struct {
+	storage.SessionStorage
+	injector *Injector
+}
+
+func (s *sessionStorage) Create(ctx context.Context, session *models.Session) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.SessionStorage.Create(ctx, session)
+}
+
+func (s *sessionStorage) GetByID(ctx context.Context, id string) (*models.Session, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, err
+	}
+	return s.SessionStorage.GetByID(ctx, id)
+}
+
+func (s *sessionStorage) List(ctx context.Context, filter *models.SessionFilter, paging *models.PaginationRequest) (*models.PaginationResponse, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, err
+	}
+	return s.SessionStorage.List(ctx, filter, paging)
+}
+
+func (s *sessionStorage) Update(ctx context.Context, session *models.Session) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.SessionStorage.Update(ctx, session)
+}
+
+func (s *sessionStorage) Delete(ctx context.Context, id string) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.SessionStorage.Delete(ctx, id)
+}
+
+func (s *sessionStorage) GetActiveCount(ctx context.Context, projectID string) (int64, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return 0, err
+	}
+	return s.SessionStorage.GetActiveCount(ctx, projectID)
+}
+
+type taskStorage struct {
+	storage.TaskStorage
+	injector *Injector
+}
+
+func (s *taskStorage) Create(ctx context.Context, task *models.Task) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.TaskStorage.Create(ctx, task)
+}
+
+func (s *taskStorage) GetByID(ctx context.Context, id string) (*models.Task, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, err
+	}
+	return s.TaskStorage.GetByID(ctx, id)
+}
+
+func (s *taskStorage) List(ctx context.Context, filter *models.TaskFilter, paging *models.PaginationRequest) ([]*models.Task, int, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, 0, err
+	}
+	return s.TaskStorage.List(ctx, filter, paging)
+}
+
+func (s *taskStorage) Update(ctx context.Context, task *models.Task) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.TaskStorage.Update(ctx, task)
+}
+
+func (s *taskStorage) Delete(ctx context.Context, id string) error {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return err
+	}
+	return s.TaskStorage.Delete(ctx, id)
+}
+
+func (s *taskStorage) GetBySessionID(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.Task, int, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, 0, err
+	}
+	return s.TaskStorage.GetBySessionID(ctx, sessionID, paging)
+}
+
+func (s *taskStorage) GetActiveCount(ctx context.Context, sessionID string) (int64, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return 0, err
+	}
+	return s.TaskStorage.GetActiveCount(ctx, sessionID)
+}
+
+func (s *taskStorage) ListExecutedBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	if err := s.injector.DelayStorage(ctx); err != nil {
+		return nil, err
+	}
+	return s.TaskStorage.ListExecutedBetween(ctx, from, to)
+}
@@ -8,6 +8,8 @@ import (
 	
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // TestAdvancedSessionPool은 고급 세션 풀의 기본 기능을 테스트합니다
@@ -218,7 +220,7 @@ func TestHealthChecker(t *testing.T) {
 	// 헬스체커는 logger만 받아야 함
 	logger := logrus.New()
 	logger.SetLevel(logrus.ErrorLevel)
-	hc := NewHealthChecker(logger)
+	hc := NewHealthChecker(logging.FromLogrus(logger))
 	defer hc.Stop()
 	
 	t.Run("HealthChecker Initialization", func(t *testing.T) {
@@ -0,0 +1,136 @@
+package claude
+
+import (
+	"io"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// claudeModelPricing 모델 계열별 단가 (USD / 100만 토큰)
+// 모델 이름에 계열 이름이 포함되어 있는지로 매칭합니다.
+var claudeModelPricing = []struct {
+	family  string
+	pricing AgentPricing
+}{
+	{"opus", AgentPricing{InputPerMTok: 15, OutputPerMTok: 75, CacheWritePerMTok: 18.75, CacheReadPerMTok: 1.5}},
+	{"sonnet", AgentPricing{InputPerMTok: 3, OutputPerMTok: 15, CacheWritePerMTok: 3.75, CacheReadPerMTok: 0.3}},
+	{"haiku", AgentPricing{InputPerMTok: 0.8, OutputPerMTok: 4, CacheWritePerMTok: 1, CacheReadPerMTok: 0.08}},
+}
+
+// ClaudeRunner Claude CLI용 AgentRunner 구현 (기본 프로바이더)
+type ClaudeRunner struct {
+	// DefaultPricing 모델을 알 수 없을 때 사용할 단가
+	DefaultPricing AgentPricing
+}
+
+// NewClaudeRunner 새 Claude 러너 생성
+func NewClaudeRunner() *ClaudeRunner {
+	return &ClaudeRunner{DefaultPricing: claudeModelPricing[1].pricing}
+}
+
+// Provider 프로바이더 이름
+func (r *ClaudeRunner) Provider() string {
+	return DefaultAgentProvider
+}
+
+// ProcessConfig Claude CLI 프로세스 설정 생성
+func (r *ClaudeRunner) ProcessConfig(config SessionConfig) (*ProcessConfig, error) {
+	return &ProcessConfig{
+		Command:       "claude",
+		Args:          claudeArgs(config),
+		WorkingDir:    config.WorkingDir,
+		Environment:   config.Environment,
+		OAuthToken:    config.OAuthToken,
+		MCPServers:    config.MCPServers,
+		RestartPolicy: config.RestartPolicy,
+		HangPolicy:    config.HangPolicy,
+		ResourceLimits: &ResourceLimits{
+			MaxMemory: config.MaxMemory,
+			MaxCPU:    config.MaxCPU,
+			Timeout:   config.MaxDuration,
+		},
+	}, nil
+}
+
+// claudeArgs 세션 설정을 CLI 인자로 변환
+// 시스템 프롬프트는 CLI 기본 프롬프트를 유지하도록 --append-system-prompt로 전달합니다.
+func claudeArgs(config SessionConfig) []string {
+	args := claudeResumeArgs(config)
+	if config.SystemPrompt != "" {
+		args = append(args, "--append-system-prompt", config.SystemPrompt)
+	}
+	return args
+}
+
+// claudeResumeArgs 대화 이어가기 설정을 CLI 인자로 변환
+func claudeResumeArgs(config SessionConfig) []string {
+	args := []string{}
+	switch {
+	case config.ResumeSessionID != "":
+		args = append(args, "--resume", config.ResumeSessionID)
+	case config.Continue:
+		args = append(args, "--continue")
+	}
+	if config.ForkSession && len(args) > 0 {
+		args = append(args, "--fork-session")
+	}
+	return args
+}
+
+// NewStreamParser stream-json 출력 파서 생성
+func (r *ClaudeRunner) NewStreamParser(reader io.Reader, logger *logrus.Logger) AgentStreamParser {
+	return NewJSONStreamParser(reader, logger)
+}
+
+// Usage 응답 메타데이터의 usage 필드에서 토큰 사용량 추출
+func (r *ClaudeRunner) Usage(resp *Response) (*AgentUsage, bool) {
+	if resp == nil || resp.Metadata == nil {
+		return nil, false
+	}
+	raw, ok := resp.Metadata["usage"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	usage := &AgentUsage{
+		InputTokens:         metadataInt(raw, "input_tokens"),
+		OutputTokens:        metadataInt(raw, "output_tokens"),
+		CacheCreationTokens: metadataInt(raw, "cache_creation_input_tokens"),
+		CacheReadTokens:     metadataInt(raw, "cache_read_input_tokens"),
+	}
+	if model, ok := resp.Metadata["model"].(string); ok {
+		usage.Model = model
+	}
+	return usage, true
+}
+
+// Cost 모델별 단가로 비용 계산
+func (r *ClaudeRunner) Cost(usage AgentUsage) float64 {
+	return r.pricingFor(usage.Model).Cost(usage)
+}
+
+// pricingFor 모델 이름에 해당하는 단가
+func (r *ClaudeRunner) pricingFor(model string) AgentPricing {
+	model = strings.ToLower(model)
+	for _, entry := range claudeModelPricing {
+		if strings.Contains(model, entry.family) {
+			return entry.pricing
+		}
+	}
+	return r.DefaultPricing
+}
+
+// metadataInt JSON 디코딩된 숫자 값을 int로 변환
+func metadataInt(values map[string]interface{}, key string) int {
+	switch v := values[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case int64:
+		return int(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,162 @@
+package claude
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// CLI 에이전트 출력 형식
+const (
+	// AgentOutputText 한 줄이 텍스트 메시지 하나
+	AgentOutputText = "text"
+	// AgentOutputJSONL 한 줄이 Response 형식의 JSON 객체 하나
+	AgentOutputJSONL = "jsonl"
+)
+
+// CLIRunnerConfig 범용 CLI 에이전트 설정
+type CLIRunnerConfig struct {
+	// Provider 프로바이더 이름
+	Provider string
+	// Command 실행 파일
+	Command string
+	// Args 실행 인자
+	Args []string
+	// Env 에이전트에 추가로 전달할 환경 변수
+	Env map[string]string
+	// OutputFormat 표준 출력 형식 (text, jsonl)
+	OutputFormat string
+	// Pricing 토큰 단가 (로컬 모델이면 0)
+	Pricing AgentPricing
+}
+
+// CLIRunner Claude 이외의 로컬 CLI 에이전트용 AgentRunner 구현
+type CLIRunner struct {
+	config CLIRunnerConfig
+}
+
+// NewCLIRunner 새 CLI 러너 생성
+func NewCLIRunner(config CLIRunnerConfig) (*CLIRunner, error) {
+	if config.Provider == "" {
+		return nil, fmt.Errorf("agent provider name is required")
+	}
+	if config.Command == "" {
+		return nil, fmt.Errorf("agent %s: command is required", config.Provider)
+	}
+	switch config.OutputFormat {
+	case "":
+		config.OutputFormat = AgentOutputText
+	case AgentOutputText, AgentOutputJSONL:
+	default:
+		return nil, fmt.Errorf("agent %s: unsupported output format %q", config.Provider, config.OutputFormat)
+	}
+	return &CLIRunner{config: config}, nil
+}
+
+// Provider 프로바이더 이름
+func (r *CLIRunner) Provider() string {
+	return r.config.Provider
+}
+
+// ProcessConfig 에이전트 프로세스 설정 생성
+// MCP 서버와 OAuth 토큰은 Claude CLI 전용이므로 전달하지 않습니다.
+func (r *CLIRunner) ProcessConfig(config SessionConfig) (*ProcessConfig, error) {
+	env := make(map[string]string, len(config.Environment)+len(r.config.Env))
+	for k, v := range config.Environment {
+		env[k] = v
+	}
+	for k, v := range r.config.Env {
+		env[k] = v
+	}
+
+	return &ProcessConfig{
+		Command:       r.config.Command,
+		Args:          append([]string{}, r.config.Args...),
+		WorkingDir:    config.WorkingDir,
+		Environment:   env,
+		RestartPolicy: config.RestartPolicy,
+		HangPolicy:    config.HangPolicy,
+		ResourceLimits: &ResourceLimits{
+			MaxMemory: config.MaxMemory,
+			MaxCPU:    config.MaxCPU,
+			Timeout:   config.MaxDuration,
+		},
+	}, nil
+}
+
+// NewStreamParser 출력 형식에 맞는 파서 생성
+func (r *CLIRunner) NewStreamParser(reader io.Reader, logger *logrus.Logger) AgentStreamParser {
+	if r.config.OutputFormat == AgentOutputJSONL {
+		return NewJSONStreamParser(reader, logger)
+	}
+	return newTextStreamParser(reader)
+}
+
+// Usage jsonl 출력의 usage 메타데이터에서 토큰 사용량 추출 (text 출력은 사용량 없음)
+func (r *CLIRunner) Usage(resp *Response) (*AgentUsage, bool) {
+	if resp == nil || resp.Metadata == nil {
+		return nil, false
+	}
+	raw, ok := resp.Metadata["usage"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	usage := &AgentUsage{
+		InputTokens:  metadataInt(raw, "input_tokens"),
+		OutputTokens: metadataInt(raw, "output_tokens"),
+	}
+	if model, ok := resp.Metadata["model"].(string); ok {
+		usage.Model = model
+	}
+	return usage, true
+}
+
+// Cost 설정된 단가로 비용 계산
+func (r *CLIRunner) Cost(usage AgentUsage) float64 {
+	return r.config.Pricing.Cost(usage)
+}
+
+// textStreamParser 한 줄을 text 응답 하나로 변환하는 파서
+type textStreamParser struct {
+	scanner *bufio.Scanner
+}
+
+func newTextStreamParser(reader io.Reader) *textStreamParser {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	return &textStreamParser{scanner: scanner}
+}
+
+// ParseStream 빈 줄을 제외한 각 줄을 text 응답으로 전송
+func (p *textStreamParser) ParseStream(ctx context.Context) (<-chan *Response, <-chan error) {
+	responseChan := make(chan *Response, 10)
+	errorChan := make(chan error, 1)
+
+	go func() {
+		defer close(responseChan)
+		defer close(errorChan)
+
+		for p.scanner.Scan() {
+			line := strings.TrimRight(p.scanner.Text(), "\r")
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+
+			select {
+			case responseChan <- &Response{Type: "text", Content: line}:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := p.scanner.Err(); err != nil {
+			errorChan <- fmt.Errorf("scanner error: %w", err)
+		}
+	}()
+
+	return responseChan, errorChan
+}
@@ -0,0 +1,173 @@
+package claude
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultAgentProvider 워크스페이스에 프로바이더가 지정되지 않았을 때 사용하는 기본 에이전트
+const DefaultAgentProvider = "claude"
+
+// AgentUsage 에이전트 응답 하나에서 추출한 토큰 사용량
+type AgentUsage struct {
+	Model               string `json:"model,omitempty"`
+	InputTokens         int    `json:"input_tokens"`
+	OutputTokens        int    `json:"output_tokens"`
+	CacheCreationTokens int    `json:"cache_creation_tokens,omitempty"`
+	CacheReadTokens     int    `json:"cache_read_tokens,omitempty"`
+}
+
+// AgentPricing 100만 토큰당 USD 단가
+type AgentPricing struct {
+	InputPerMTok      float64 `json:"input_per_mtok"`
+	OutputPerMTok     float64 `json:"output_per_mtok"`
+	CacheWritePerMTok float64 `json:"cache_write_per_mtok,omitempty"`
+	CacheReadPerMTok  float64 `json:"cache_read_per_mtok,omitempty"`
+}
+
+// Cost 사용량에 단가를 적용한 비용 (USD)
+func (p AgentPricing) Cost(usage AgentUsage) float64 {
+	const perToken = 1.0 / 1_000_000
+	return float64(usage.InputTokens)*p.InputPerMTok*perToken +
+		float64(usage.OutputTokens)*p.OutputPerMTok*perToken +
+		float64(usage.CacheCreationTokens)*p.CacheWritePerMTok*perToken +
+		float64(usage.CacheReadTokens)*p.CacheReadPerMTok*perToken
+}
+
+// AgentStreamParser 에이전트 출력 스트림을 Response로 변환하는 파서
+// JSONStreamParser가 Claude CLI의 stream-json 출력에 대한 구현입니다.
+type AgentStreamParser interface {
+	ParseStream(ctx context.Context) (<-chan *Response, <-chan error)
+}
+
+// AgentRunner 로컬 CLI 에이전트 하나를 프로세스 계층에 연결하는 인터페이스
+// 프로세스 설정, 출력 파싱, 비용 계산을 프로바이더별로 분리합니다.
+type AgentRunner interface {
+	// Provider 프로바이더 이름 (예: "claude")
+	Provider() string
+
+	// ProcessConfig 세션 설정으로부터 실행할 프로세스 설정 생성
+	ProcessConfig(config SessionConfig) (*ProcessConfig, error)
+
+	// NewStreamParser 프로세스 표준 출력 파서 생성
+	NewStreamParser(r io.Reader, logger *logrus.Logger) AgentStreamParser
+
+	// Usage 응답에 토큰 사용량이 포함되어 있으면 추출
+	Usage(resp *Response) (*AgentUsage, bool)
+
+	// Cost 사용량에 대한 비용 (USD)
+	Cost(usage AgentUsage) float64
+}
+
+// RecordUsage 사용량과 비용을 실행 요약에 누적합니다.
+func (s *ExecutionSummary) RecordUsage(runner AgentRunner, usage AgentUsage) {
+	if s.Provider == "" {
+		s.Provider = runner.Provider()
+	}
+	s.InputTokens += usage.InputTokens
+	s.OutputTokens += usage.OutputTokens
+	s.CostUSD += runner.Cost(usage)
+}
+
+// AgentRegistry 프로바이더별 AgentRunner와 워크스페이스별 프로바이더 지정을 관리
+type AgentRegistry struct {
+	mu              sync.RWMutex
+	runners         map[string]AgentRunner
+	defaultProvider string
+	workspaces      map[string]string
+}
+
+// NewAgentRegistry Claude 러너가 기본으로 등록된 레지스트리 생성
+func NewAgentRegistry() *AgentRegistry {
+	r := &AgentRegistry{
+		runners:         make(map[string]AgentRunner),
+		defaultProvider: DefaultAgentProvider,
+		workspaces:      make(map[string]string),
+	}
+	r.runners[DefaultAgentProvider] = NewClaudeRunner()
+	return r
+}
+
+// Register 러너 등록 (같은 이름이 있으면 교체)
+func (r *AgentRegistry) Register(runner AgentRunner) error {
+	if runner == nil || runner.Provider() == "" {
+		return fmt.Errorf("agent runner must have a provider name")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.runners[runner.Provider()] = runner
+	return nil
+}
+
+// SetDefault 기본 프로바이더 변경
+func (r *AgentRegistry) SetDefault(provider string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.runners[provider]; !ok {
+		return fmt.Errorf("unknown agent provider: %s", provider)
+	}
+	r.defaultProvider = provider
+	return nil
+}
+
+// Get 프로바이더에 해당하는 러너 조회 (빈 문자열이면 기본 프로바이더)
+func (r *AgentRegistry) Get(provider string) (AgentRunner, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if provider == "" {
+		provider = r.defaultProvider
+	}
+	runner, ok := r.runners[provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown agent provider: %s", provider)
+	}
+	return runner, nil
+}
+
+// SetWorkspaceProvider 워크스페이스에서 사용할 프로바이더 지정 (빈 문자열이면 지정 해제)
+func (r *AgentRegistry) SetWorkspaceProvider(workspaceID, provider string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if provider == "" {
+		delete(r.workspaces, workspaceID)
+		return nil
+	}
+	if _, ok := r.runners[provider]; !ok {
+		return fmt.Errorf("unknown agent provider: %s", provider)
+	}
+	r.workspaces[workspaceID] = provider
+	return nil
+}
+
+// ProviderFor 워크스페이스에 지정된 프로바이더 (없으면 기본 프로바이더)
+func (r *AgentRegistry) ProviderFor(workspaceID string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if provider, ok := r.workspaces[workspaceID]; ok {
+		return provider
+	}
+	return r.defaultProvider
+}
+
+// Providers 등록된 프로바이더 이름 목록
+func (r *AgentRegistry) Providers() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.runners))
+	for name := range r.runners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
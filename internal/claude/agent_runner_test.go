@@ -0,0 +1,154 @@
+package claude
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentRegistry(t *testing.T) {
+	registry := NewAgentRegistry()
+
+	runner, err := registry.Get("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAgentProvider, runner.Provider())
+
+	local, err := NewCLIRunner(CLIRunnerConfig{Provider: "local", Command: "llm"})
+	require.NoError(t, err)
+	require.NoError(t, registry.Register(local))
+	assert.Equal(t, []string{"claude", "local"}, registry.Providers())
+
+	// 워크스페이스별 지정
+	require.NoError(t, registry.SetWorkspaceProvider("ws-1", "local"))
+	assert.Equal(t, "local", registry.ProviderFor("ws-1"))
+	assert.Equal(t, DefaultAgentProvider, registry.ProviderFor("ws-2"))
+	assert.Error(t, registry.SetWorkspaceProvider("ws-1", "unknown"))
+
+	require.NoError(t, registry.SetWorkspaceProvider("ws-1", ""))
+	assert.Equal(t, DefaultAgentProvider, registry.ProviderFor("ws-1"))
+
+	require.NoError(t, registry.SetDefault("local"))
+	assert.Equal(t, "local", registry.ProviderFor("ws-2"))
+	assert.Error(t, registry.SetDefault("unknown"))
+
+	_, err = registry.Get("unknown")
+	assert.Error(t, err)
+}
+
+func TestClaudeRunner_ProcessConfig(t *testing.T) {
+	runner := NewClaudeRunner()
+	config := SessionConfig{
+		WorkingDir: "/tmp",
+		OAuthToken: "token",
+		MCPServers: map[string]MCPServerConfig{"github": {Command: "npx"}},
+		MaxMemory:  1024,
+	}
+
+	pc, err := runner.ProcessConfig(config)
+	require.NoError(t, err)
+	assert.Equal(t, "claude", pc.Command)
+	assert.Equal(t, "token", pc.OAuthToken)
+	assert.Len(t, pc.MCPServers, 1)
+	assert.Equal(t, int64(1024), pc.ResourceLimits.MaxMemory)
+	assert.Empty(t, pc.Args)
+
+	config.ResumeSessionID = "cli-session"
+	config.ForkSession = true
+	pc, err = runner.ProcessConfig(config)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--resume", "cli-session", "--fork-session"}, pc.Args)
+
+	pc, err = runner.ProcessConfig(SessionConfig{WorkingDir: "/tmp", Continue: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--continue"}, pc.Args)
+
+	pc, err = runner.ProcessConfig(SessionConfig{WorkingDir: "/tmp", Continue: true, SystemPrompt: "Be brief."})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--continue", "--append-system-prompt", "Be brief."}, pc.Args)
+}
+
+func TestClaudeRunner_UsageAndCost(t *testing.T) {
+	runner := NewClaudeRunner()
+
+	_, ok := runner.Usage(&Response{Type: "text", Content: "hello"})
+	assert.False(t, ok)
+
+	usage, ok := runner.Usage(&Response{
+		Type: "result",
+		Metadata: map[string]interface{}{
+			"model": "claude-3-opus",
+			"usage": map[string]interface{}{
+				"input_tokens":            float64(1_000_000),
+				"output_tokens":           float64(100_000),
+				"cache_read_input_tokens": float64(1_000_000),
+			},
+		},
+	})
+	require.True(t, ok)
+	assert.Equal(t, 1_000_000, usage.InputTokens)
+	assert.Equal(t, 100_000, usage.OutputTokens)
+	assert.InDelta(t, 15+7.5+1.5, runner.Cost(*usage), 1e-9)
+
+	// 알 수 없는 모델은 기본 단가 사용
+	usage.Model = "unknown"
+	usage.CacheReadTokens = 0
+	assert.InDelta(t, 3+1.5, runner.Cost(*usage), 1e-9)
+
+	summary := &ExecutionSummary{}
+	summary.RecordUsage(runner, *usage)
+	summary.RecordUsage(runner, *usage)
+	assert.Equal(t, DefaultAgentProvider, summary.Provider)
+	assert.Equal(t, 2_000_000, summary.InputTokens)
+	assert.InDelta(t, 9, summary.CostUSD, 1e-9)
+}
+
+func TestCLIRunner(t *testing.T) {
+	_, err := NewCLIRunner(CLIRunnerConfig{Provider: "local"})
+	assert.Error(t, err)
+	_, err = NewCLIRunner(CLIRunnerConfig{Provider: "local", Command: "llm", OutputFormat: "xml"})
+	assert.Error(t, err)
+
+	runner, err := NewCLIRunner(CLIRunnerConfig{
+		Provider: "local",
+		Command:  "llm",
+		Args:     []string{"chat"},
+		Env:      map[string]string{"LLM_MODEL": "llama3"},
+		Pricing:  AgentPricing{InputPerMTok: 1, OutputPerMTok: 2},
+	})
+	require.NoError(t, err)
+
+	pc, err := runner.ProcessConfig(SessionConfig{
+		WorkingDir:  "/tmp",
+		OAuthToken:  "token",
+		Environment: map[string]string{"FOO": "bar"},
+		MCPServers:  map[string]MCPServerConfig{"github": {Command: "npx"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "llm", pc.Command)
+	assert.Equal(t, []string{"chat"}, pc.Args)
+	assert.Equal(t, map[string]string{"FOO": "bar", "LLM_MODEL": "llama3"}, pc.Environment)
+	assert.Empty(t, pc.OAuthToken)
+	assert.Nil(t, pc.MCPServers)
+
+	assert.InDelta(t, 3.0, runner.Cost(AgentUsage{InputTokens: 1_000_000, OutputTokens: 1_000_000}), 1e-9)
+}
+
+func TestCLIRunner_TextStreamParser(t *testing.T) {
+	runner, err := NewCLIRunner(CLIRunnerConfig{Provider: "local", Command: "llm"})
+	require.NoError(t, err)
+
+	parser := runner.NewStreamParser(strings.NewReader("first line\n\n  \nsecond line\r\n"), logrus.New())
+	responses, errs := parser.ParseStream(context.Background())
+
+	var contents []string
+	for resp := range responses {
+		assert.Equal(t, "text", resp.Type)
+		contents = append(contents, resp.Content)
+	}
+	assert.NoError(t, <-errs)
+	assert.Equal(t, []string{"first line", "second line"}, contents)
+}
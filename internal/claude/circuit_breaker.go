@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // CircuitBreakerState 회로 차단기 상태
@@ -100,7 +102,7 @@ type circuitBreaker struct {
 	totalRequests int64
 	stateChanges int64
 	mutex        sync.RWMutex
-	logger       *logrus.Logger
+	logger       logging.Logger
 	
 	// 슬라이딩 윈도우를 위한 필드
 	window       *slidingWindow
@@ -108,7 +110,7 @@ type circuitBreaker struct {
 }
 
 // NewCircuitBreaker 새로운 회로 차단기를 생성합니다
-func NewCircuitBreaker(config *CircuitBreakerConfig, logger *logrus.Logger) CircuitBreaker {
+func NewCircuitBreaker(config *CircuitBreakerConfig, logger logging.Logger) CircuitBreaker {
 	if config == nil {
 		config = &CircuitBreakerConfig{
 			FailureThreshold:         5,
@@ -120,7 +122,7 @@ func NewCircuitBreaker(config *CircuitBreakerConfig, logger *logrus.Logger) Circ
 	}
 	
 	if logger == nil {
-		logger = logrus.StandardLogger()
+		logger = logging.FromLogrus(logrus.StandardLogger())
 	}
 	
 	windowSize := 1 * time.Minute // 1분 슬라이딩 윈도우
@@ -179,7 +181,7 @@ func (cb *circuitBreaker) RecordSuccess() {
 		}
 	}
 	
-	cb.logger.WithFields(logrus.Fields{
+	cb.logger.WithFields(logging.Fields{
 		"state":         cb.state,
 		"success_count": cb.successCount,
 	}).Debug("Recorded success in circuit breaker")
@@ -194,7 +196,7 @@ func (cb *circuitBreaker) RecordError() {
 	cb.lastFailure = time.Now()
 	cb.window.RecordFailure()
 	
-	cb.logger.WithFields(logrus.Fields{
+	cb.logger.WithFields(logging.Fields{
 		"state":         cb.state,
 		"failure_count": cb.failureCount,
 	}).Debug("Recorded error in circuit breaker")
@@ -249,7 +251,7 @@ func (cb *circuitBreaker) transitionToOpen() {
 	cb.nextAttempt = time.Now().Add(cb.config.RecoveryTimeout)
 	cb.stateChanges++
 	
-	cb.logger.WithFields(logrus.Fields{
+	cb.logger.WithFields(logging.Fields{
 		"state":        cb.state,
 		"next_attempt": cb.nextAttempt,
 	}).Warn("Circuit breaker transitioned to OPEN")
@@ -0,0 +1,201 @@
+package claude
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ShellToolName 셸 명령을 실행하는 Claude 도구 이름
+const ShellToolName = "Bash"
+
+var (
+	// exitCodePattern 실패한 Bash 도구 결과 첫 줄의 종료 코드 ("Exit code 2")
+	exitCodePattern = regexp.MustCompile(`^Exit code (-?\d+)\s*\n?`)
+	// permissionDeniedPattern 허용 도구 정책에 막혀 실행되지 않은 도구 호출 결과
+	permissionDeniedPattern = regexp.MustCompile(`(?i)(requested permissions? to use|permission to use \S+ (?:has been|was) denied|haven't granted it)`)
+)
+
+// ExecutedCommand 도구 호출로 실제 실행된 셸 명령 하나
+type ExecutedCommand struct {
+	// ToolUseID 도구 호출 ID (없으면 빈 문자열)
+	ToolUseID string `json:"tool_use_id,omitempty"`
+	// Command 실행한 명령
+	Command string `json:"command"`
+	// Description 도구 호출에 붙은 설명
+	Description string `json:"description,omitempty"`
+	// WorkingDir 세션 초기화 메시지의 작업 디렉토리 (알 수 없으면 빈 문자열)
+	WorkingDir string `json:"working_dir,omitempty"`
+	// ExitCode 종료 코드 (결과에서 알 수 없으면 nil)
+	ExitCode *int `json:"exit_code,omitempty"`
+	// Output 명령 출력
+	Output string `json:"output"`
+	// IsError 도구가 실패로 보고했는지 여부
+	IsError bool `json:"is_error"`
+}
+
+// streamLine stream-json 출력 한 줄 (CLI 원본 형식과 Response 형식 모두 처리)
+type streamLine struct {
+	Type     string                 `json:"type"`
+	Subtype  string                 `json:"subtype"`
+	Cwd      string                 `json:"cwd"`
+	Content  json.RawMessage        `json:"content"`
+	Metadata map[string]interface{} `json:"metadata"`
+	Message  *struct {
+		Content json.RawMessage `json:"content"`
+	} `json:"message"`
+}
+
+// streamContentBlock assistant/user 메시지의 내용 블록
+type streamContentBlock struct {
+	Type      string          `json:"type"`
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Input     json.RawMessage `json:"input"`
+	ToolUseID string          `json:"tool_use_id"`
+	Content   json.RawMessage `json:"content"`
+	IsError   bool            `json:"is_error"`
+}
+
+// shellToolInput Bash 도구 입력
+type shellToolInput struct {
+	Command     string `json:"command"`
+	Description string `json:"description"`
+}
+
+// ExtractExecutedCommands stream-json 출력에서 실행된 셸 명령을 순서대로 추출합니다
+// 결과가 돌아온 Bash 도구 호출만 실행된 것으로 보며, 정책에 막힌 호출과 결과가 없는 호출은 제외합니다.
+// JSON이 아닌 줄은 무시합니다.
+func ExtractExecutedCommands(output string) []ExecutedCommand {
+	var (
+		commands []ExecutedCommand
+		pending  []ExecutedCommand
+		cwd      string
+	)
+
+	call := func(id string, input json.RawMessage) {
+		var in shellToolInput
+		if err := json.Unmarshal(input, &in); err != nil || strings.TrimSpace(in.Command) == "" {
+			return
+		}
+		pending = append(pending, ExecutedCommand{ToolUseID: id, Command: in.Command, Description: in.Description, WorkingDir: cwd})
+	}
+	result := func(id, content string, isError bool) {
+		if len(pending) == 0 {
+			return
+		}
+		// ID가 같은 호출, 없으면 가장 오래된 호출과 짝지음
+		index := 0
+		if id != "" {
+			index = -1
+			for i, p := range pending {
+				if p.ToolUseID == id {
+					index = i
+					break
+				}
+			}
+			if index < 0 {
+				return
+			}
+		}
+		command := pending[index]
+		pending = append(pending[:index], pending[index+1:]...)
+		if isError && permissionDeniedPattern.MatchString(content) {
+			return
+		}
+		command.IsError = isError
+		command.Output = content
+		if match := exitCodePattern.FindStringSubmatch(content); match != nil {
+			if code, err := strconv.Atoi(match[1]); err == nil {
+				command.ExitCode = &code
+				command.Output = content[len(match[0]):]
+			}
+		} else if !isError {
+			code := 0
+			command.ExitCode = &code
+		}
+		commands = append(commands, command)
+	}
+
+	for _, raw := range strings.Split(output, "\n") {
+		raw = strings.TrimSpace(raw)
+		if !strings.HasPrefix(raw, "{") {
+			continue
+		}
+		var line streamLine
+		if err := json.Unmarshal([]byte(raw), &line); err != nil {
+			continue
+		}
+
+		switch line.Type {
+		case "system":
+			if line.Subtype == "init" && line.Cwd != "" {
+				cwd = line.Cwd
+			}
+		case "assistant", "user":
+			if line.Message == nil {
+				continue
+			}
+			var blocks []streamContentBlock
+			if err := json.Unmarshal(line.Message.Content, &blocks); err != nil {
+				continue
+			}
+			for _, block := range blocks {
+				switch block.Type {
+				case "tool_use":
+					if block.Name == ShellToolName {
+						call(block.ID, block.Input)
+					}
+				case "tool_result":
+					result(block.ToolUseID, toolResultText(block.Content), block.IsError)
+				}
+			}
+		case string(MessageTypeToolUse):
+			// Response 형식: content가 {"name":"Bash","input":{...}} JSON 문자열
+			var text string
+			if err := json.Unmarshal(line.Content, &text); err != nil {
+				continue
+			}
+			var block streamContentBlock
+			if err := json.Unmarshal([]byte(text), &block); err != nil || block.Name != ShellToolName {
+				continue
+			}
+			id, _ := line.Metadata["tool_use_id"].(string)
+			if id == "" {
+				id = block.ID
+			}
+			call(id, block.Input)
+		case "tool_result", "tool_output":
+			id, _ := line.Metadata["tool_use_id"].(string)
+			isError, _ := line.Metadata["is_error"].(bool)
+			result(id, toolResultText(line.Content), isError)
+		}
+	}
+	return commands
+}
+
+// toolResultText 도구 결과 내용을 텍스트로 변환 (문자열 또는 text 블록 배열)
+func toolResultText(content json.RawMessage) string {
+	if len(content) == 0 {
+		return ""
+	}
+	var text string
+	if err := json.Unmarshal(content, &text); err == nil {
+		return text
+	}
+	var blocks []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(content, &blocks); err != nil {
+		return ""
+	}
+	parts := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Type == "text" {
+			parts = append(parts, block.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
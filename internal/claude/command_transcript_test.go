@@ -0,0 +1,60 @@
+package claude
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractExecutedCommands_CLIStream(t *testing.T) {
+	output := `{"type":"system","subtype":"init","cwd":"/work/app","tools":["Bash","Edit"]}
+{"type":"assistant","message":{"content":[{"type":"text","text":"Running tests"},{"type":"tool_use","id":"toolu_1","name":"Bash","input":{"command":"go test ./...","description":"Run tests"}}]}}
+{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"toolu_1","content":"ok  \tapp\t0.01s"}]}}
+{"type":"assistant","message":{"content":[{"type":"tool_use","id":"toolu_2","name":"Bash","input":{"command":"make lint"}},{"type":"tool_use","id":"toolu_3","name":"Edit","input":{"file_path":"main.go"}}]}}
+{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"toolu_2","content":[{"type":"text","text":"Exit code 2\nlint failed"}],"is_error":true}]}}
+{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"toolu_3","content":"edited"}]}}
+{"type":"assistant","message":{"content":[{"type":"tool_use","id":"toolu_4","name":"Bash","input":{"command":"rm -rf /tmp/x"}}]}}
+{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"toolu_4","content":"Claude requested permissions to use Bash, but you haven't granted it yet.","is_error":true}]}}
+{"type":"assistant","message":{"content":[{"type":"tool_use","id":"toolu_5","name":"Bash","input":{"command":"sleep 100"}}]}}
+not json
+{"type":"result","subtype":"success","total_cost_usd":0.01}`
+
+	commands := ExtractExecutedCommands(output)
+	require.Len(t, commands, 2)
+
+	assert.Equal(t, "toolu_1", commands[0].ToolUseID)
+	assert.Equal(t, "go test ./...", commands[0].Command)
+	assert.Equal(t, "Run tests", commands[0].Description)
+	assert.Equal(t, "/work/app", commands[0].WorkingDir)
+	require.NotNil(t, commands[0].ExitCode)
+	assert.Equal(t, 0, *commands[0].ExitCode)
+	assert.Equal(t, "ok  \tapp\t0.01s", commands[0].Output)
+	assert.False(t, commands[0].IsError)
+
+	// 실패한 명령은 종료 코드를 분리하고 정책에 막힌 명령과 결과 없는 명령은 제외
+	assert.Equal(t, "make lint", commands[1].Command)
+	require.NotNil(t, commands[1].ExitCode)
+	assert.Equal(t, 2, *commands[1].ExitCode)
+	assert.Equal(t, "lint failed", commands[1].Output)
+	assert.True(t, commands[1].IsError)
+}
+
+func TestExtractExecutedCommands_ResponseStream(t *testing.T) {
+	output := `{"type":"tool_use","content":"{\"name\":\"Bash\",\"input\":{\"command\":\"ls\"}}"}
+{"type":"tool_result","content":"README.md"}
+{"type":"tool_use","content":"{\"name\":\"Bash\",\"input\":{\"command\":\"cat missing\"}}","metadata":{"tool_use_id":"t2"}}
+{"type":"tool_output","content":"cat: missing: No such file","metadata":{"tool_use_id":"t2","is_error":true}}`
+
+	commands := ExtractExecutedCommands(output)
+	require.Len(t, commands, 2)
+	assert.Equal(t, "ls", commands[0].Command)
+	assert.Equal(t, "README.md", commands[0].Output)
+	assert.Equal(t, 0, *commands[0].ExitCode)
+
+	assert.Equal(t, "t2", commands[1].ToolUseID)
+	assert.True(t, commands[1].IsError)
+	assert.Nil(t, commands[1].ExitCode)
+
+	assert.Empty(t, ExtractExecutedCommands("plain text output"))
+}
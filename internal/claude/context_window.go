@@ -0,0 +1,517 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// DefaultContextWindowLimit 모델 한도를 모를 때 사용하는 컨텍스트 한도 (토큰)
+const DefaultContextWindowLimit = 200000
+
+// 스트림 핸들러가 응답의 토큰 사용량을 알릴 때 발행하는 스트림 이벤트 타입
+const StreamEventUsageUpdated = "usage_updated"
+
+// 압축 후 새 프로세스의 시스템 프롬프트에 붙이는 요약 머리말
+const contextSummaryHeader = "## 이전 대화 요약\n컨텍스트 한도에 가까워 이전 대화를 요약하고 새로 시작했습니다. 아래 요약을 이어서 작업하세요.\n\n"
+
+// ErrEmptyContextSummary 압축할 대화 기록이 없음
+var ErrEmptyContextSummary = errors.New("no conversation to summarize")
+
+// ContextLevel 컨텍스트 사용량 단계
+type ContextLevel string
+
+const (
+	ContextLevelNormal   ContextLevel = "normal"
+	ContextLevelWarning  ContextLevel = "warning"  // 경고 비율 이상
+	ContextLevelCritical ContextLevel = "critical" // 압축 비율 이상 (곧 한도에 도달)
+)
+
+func (l ContextLevel) rank() int {
+	switch l {
+	case ContextLevelWarning:
+		return 1
+	case ContextLevelCritical:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// ContextWindowPolicy 세션 컨텍스트 사용량 경고와 자동 압축 기준
+type ContextWindowPolicy struct {
+	// Limit 모델 한도를 모를 때 사용하는 컨텍스트 한도 (토큰)
+	Limit int `json:"limit"`
+
+	// ModelLimits 모델 이름에 포함된 문자열별 컨텍스트 한도 (예: "haiku": 200000)
+	ModelLimits map[string]int `json:"model_limits,omitempty"`
+
+	// WarnRatio 한도 대비 이 비율 이상이면 경고
+	WarnRatio float64 `json:"warn_ratio"`
+
+	// CompactRatio 한도 대비 이 비율 이상이면 자동 압축 (AutoCompact가 꺼져 있으면 위험 경고)
+	CompactRatio float64 `json:"compact_ratio"`
+
+	// AutoCompact 압축 비율에 도달하면 대화를 요약하고 요약을 이어받아 프로세스를 재시작
+	AutoCompact bool `json:"auto_compact"`
+}
+
+// DefaultContextWindowPolicy 기본 컨텍스트 정책
+func DefaultContextWindowPolicy() ContextWindowPolicy {
+	return ContextWindowPolicy{
+		Limit:        DefaultContextWindowLimit,
+		WarnRatio:    0.8,
+		CompactRatio: 0.9,
+		AutoCompact:  true,
+	}
+}
+
+// Validate 정책 유효성 검사
+func (p ContextWindowPolicy) Validate() error {
+	if p.Limit <= 0 {
+		return fmt.Errorf("limit must be positive, got %d", p.Limit)
+	}
+	for model, limit := range p.ModelLimits {
+		if limit <= 0 {
+			return fmt.Errorf("model limit for %q must be positive, got %d", model, limit)
+		}
+	}
+	if p.WarnRatio <= 0 || p.WarnRatio > 1 {
+		return fmt.Errorf("warn_ratio must be in (0, 1], got %v", p.WarnRatio)
+	}
+	if p.CompactRatio <= 0 || p.CompactRatio > 1 {
+		return fmt.Errorf("compact_ratio must be in (0, 1], got %v", p.CompactRatio)
+	}
+	if p.WarnRatio > p.CompactRatio {
+		return fmt.Errorf("warn_ratio (%v) must not exceed compact_ratio (%v)", p.WarnRatio, p.CompactRatio)
+	}
+	return nil
+}
+
+// LimitFor 모델의 컨텍스트 한도 (가장 긴 일치 항목 우선)
+func (p ContextWindowPolicy) LimitFor(model string) int {
+	model = strings.ToLower(model)
+	limit, matched := p.Limit, ""
+	for name, value := range p.ModelLimits {
+		name = strings.ToLower(name)
+		if name != "" && strings.Contains(model, name) && len(name) > len(matched) {
+			limit, matched = value, name
+		}
+	}
+	return limit
+}
+
+// levelFor 사용 비율에 해당하는 단계
+func (p ContextWindowPolicy) levelFor(ratio float64) ContextLevel {
+	switch {
+	case ratio >= p.CompactRatio:
+		return ContextLevelCritical
+	case ratio >= p.WarnRatio:
+		return ContextLevelWarning
+	default:
+		return ContextLevelNormal
+	}
+}
+
+// ContextUsage 세션 하나의 추정 컨텍스트 사용량
+// 마지막 응답의 입력(캐시 포함)과 출력 토큰 합으로 다음 요청에 실릴 컨텍스트 크기를 추정합니다.
+type ContextUsage struct {
+	SessionID   string       `json:"session_id"`
+	Model       string       `json:"model,omitempty"`
+	Tokens      int          `json:"tokens"`
+	Limit       int          `json:"limit"`
+	Ratio       float64      `json:"ratio"`
+	Level       ContextLevel `json:"level"`
+	Compacting  bool         `json:"compacting"`  // 자동 압축 진행 중
+	Compactions int          `json:"compactions"` // 지금까지 압축한 횟수
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// ContextCompaction 자동 압축 결과 (SessionEventCompacted 데이터)
+type ContextCompaction struct {
+	SessionID     string `json:"session_id"`
+	TokensBefore  int    `json:"tokens_before"`
+	Limit         int    `json:"limit"`
+	SummaryLength int    `json:"summary_length"` // 이어받은 요약 길이 (문자)
+	Compactions   int    `json:"compactions"`
+}
+
+// ContextCompactor 세션 대화를 요약하고 요약을 이어받아 다시 시작합니다
+type ContextCompactor interface {
+	// Compact 압축 후 새 프로세스에 넘긴 요약을 반환
+	Compact(ctx context.Context, sessionID string) (string, error)
+}
+
+// ContextTrackerConfig 컨텍스트 추적기 구성
+type ContextTrackerConfig struct {
+	Policy ContextWindowPolicy
+	// Events 경고와 압축 이벤트를 발행할 세션 이벤트 버스 (nil이면 발행하지 않음)
+	Events *SessionEventBus
+	// Compactor 자동 압축기 (nil이면 압축 비율에서도 경고만 발행)
+	Compactor ContextCompactor
+	// Timeout 압축 한 번의 최대 시간
+	Timeout time.Duration
+}
+
+type contextState struct {
+	usage    ContextUsage
+	notified ContextLevel // 마지막으로 경고를 보낸 단계
+}
+
+// ContextTracker 스트림의 토큰 사용량으로 세션별 컨텍스트 사용량을 추적합니다
+// 경고 비율을 넘으면 SessionEventContextWarning을 한 번 발행하고, 압축 비율을 넘으면
+// 자동 압축을 시작하거나(압축기가 있고 AutoCompact일 때) 위험 단계 경고를 발행합니다.
+type ContextTracker struct {
+	config ContextTrackerConfig
+	logger *logrus.Logger
+
+	mu       sync.Mutex
+	sessions map[string]*contextState
+}
+
+// NewContextTracker 새 컨텍스트 추적기 생성
+func NewContextTracker(config ContextTrackerConfig, logger *logrus.Logger) (*ContextTracker, error) {
+	if err := config.Policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid context window policy: %w", err)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Minute
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+
+	return &ContextTracker{
+		config:   config,
+		logger:   logger,
+		sessions: make(map[string]*contextState),
+	}, nil
+}
+
+// Observe 스트림 핸들러의 사용량 이벤트를 세션 컨텍스트 사용량으로 기록합니다
+func (t *ContextTracker) Observe(sessionID string, handler StreamHandler) (*EventSubscription, error) {
+	return handler.Subscribe(StreamEventUsageUpdated, func(event *StreamEvent) error {
+		data, ok := event.Data.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		// result 메시지의 사용량은 실행 전체의 누적값이라 컨텍스트 크기로 쓰지 않음
+		if responseType, _ := data["response_type"].(string); responseType == "result" {
+			return nil
+		}
+		if usage, ok := data["usage"].(AgentUsage); ok {
+			t.Record(sessionID, usage)
+		}
+		return nil
+	})
+}
+
+// Record 응답 하나의 토큰 사용량을 기록하고 갱신된 컨텍스트 사용량을 반환합니다
+func (t *ContextTracker) Record(sessionID string, usage AgentUsage) ContextUsage {
+	tokens := usage.InputTokens + usage.CacheReadTokens + usage.CacheCreationTokens + usage.OutputTokens
+
+	t.mu.Lock()
+	state, exists := t.sessions[sessionID]
+	if !exists {
+		state = &contextState{notified: ContextLevelNormal}
+		state.usage.SessionID = sessionID
+		t.sessions[sessionID] = state
+	}
+	if usage.Model != "" {
+		state.usage.Model = usage.Model
+	}
+	state.usage.Tokens = tokens
+	state.usage.Limit = t.config.Policy.LimitFor(state.usage.Model)
+	state.usage.Ratio = float64(tokens) / float64(state.usage.Limit)
+	state.usage.Level = t.config.Policy.levelFor(state.usage.Ratio)
+	state.usage.UpdatedAt = time.Now()
+
+	compact := false
+	notify := false
+	if state.usage.Level == ContextLevelCritical && t.canCompact() {
+		// 압축 중에 들어오는 응답은 기록만 하고 다시 시작하지 않음
+		if !state.usage.Compacting {
+			state.usage.Compacting = true
+			compact = true
+			notify = true
+		}
+	} else if state.usage.Level.rank() > state.notified.rank() {
+		notify = true
+	}
+	if notify {
+		state.notified = state.usage.Level
+	}
+	snapshot := state.usage
+	t.mu.Unlock()
+
+	if notify {
+		t.publish(SessionEvent{
+			SessionID: sessionID,
+			Type:      SessionEventContextWarning,
+			Data:      snapshot,
+		})
+	}
+	if compact {
+		go t.compact(sessionID, snapshot)
+	}
+	return snapshot
+}
+
+func (t *ContextTracker) canCompact() bool {
+	return t.config.Policy.AutoCompact && t.config.Compactor != nil
+}
+
+// compact 대화를 요약해 이어받고 결과를 이벤트로 알립니다
+func (t *ContextTracker) compact(sessionID string, before ContextUsage) {
+	ctx, cancel := context.WithTimeout(context.Background(), t.config.Timeout)
+	defer cancel()
+
+	summary, err := t.config.Compactor.Compact(ctx, sessionID)
+
+	t.mu.Lock()
+	state, exists := t.sessions[sessionID]
+	if !exists {
+		// 압축 중에 세션이 종료됨
+		t.mu.Unlock()
+		return
+	}
+	state.usage.Compacting = false
+	if err == nil {
+		state.usage.Compactions++
+		state.usage.Tokens = 0
+		state.usage.Ratio = 0
+		state.usage.Level = ContextLevelNormal
+		state.usage.UpdatedAt = time.Now()
+		state.notified = ContextLevelNormal
+	}
+	snapshot := state.usage
+	t.mu.Unlock()
+
+	logger := t.logger.WithFields(logrus.Fields{
+		"session_id": sessionID,
+		"tokens":     before.Tokens,
+		"limit":      before.Limit,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("세션 컨텍스트 자동 압축 실패")
+		// 압축하지 못했으므로 사용자가 직접 정리할 수 있게 위험 경고를 다시 보냄
+		t.publish(SessionEvent{
+			SessionID: sessionID,
+			Type:      SessionEventContextWarning,
+			Data:      snapshot,
+			Error:     err,
+		})
+		return
+	}
+
+	logger.WithField("compactions", snapshot.Compactions).Info("세션 컨텍스트 자동 압축 완료")
+	t.publish(SessionEvent{
+		SessionID: sessionID,
+		Type:      SessionEventCompacted,
+		Data: ContextCompaction{
+			SessionID:     sessionID,
+			TokensBefore:  before.Tokens,
+			Limit:         before.Limit,
+			SummaryLength: len([]rune(summary)),
+			Compactions:   snapshot.Compactions,
+		},
+	})
+}
+
+func (t *ContextTracker) publish(event SessionEvent) {
+	if t.config.Events != nil {
+		t.config.Events.Publish(event)
+	}
+}
+
+// Usage 세션의 현재 컨텍스트 사용량 (기록이 없으면 false)
+func (t *ContextTracker) Usage(sessionID string) (ContextUsage, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, exists := t.sessions[sessionID]
+	if !exists {
+		return ContextUsage{}, false
+	}
+	return state.usage, true
+}
+
+// Forget 세션 기록 삭제
+func (t *ContextTracker) Forget(sessionID string) {
+	t.mu.Lock()
+	delete(t.sessions, sessionID)
+	t.mu.Unlock()
+}
+
+// OnSessionEvent 종료된 세션의 기록을 정리합니다
+func (t *ContextTracker) OnSessionEvent(event SessionEvent) {
+	if event.Type == SessionEventClosed {
+		t.Forget(event.SessionID)
+	}
+}
+
+// SummarizeFunc 세션 대화를 새 프로세스에 넘길 요약으로 만듭니다
+type SummarizeFunc func(ctx context.Context, sessionID string) (string, error)
+
+// SessionCompactor 대화를 요약하고, 요약을 시스템 프롬프트에 붙여 세션 프로세스를 새 대화로 재시작합니다
+// 압축 전 원래 시스템 프롬프트는 세션 메타데이터에 보관하여 여러 번 압축해도 요약이 겹치지 않게 합니다.
+type SessionCompactor struct {
+	sessions  SessionManager
+	summarize SummarizeFunc
+}
+
+// NewSessionCompactor 새 세션 압축기 생성
+func NewSessionCompactor(sessions SessionManager, summarize SummarizeFunc) *SessionCompactor {
+	return &SessionCompactor{
+		sessions:  sessions,
+		summarize: summarize,
+	}
+}
+
+// Compact 세션 대화를 요약해 이어받도록 재시작
+func (c *SessionCompactor) Compact(ctx context.Context, sessionID string) (string, error) {
+	restarter, ok := c.sessions.(SessionRestarter)
+	if !ok {
+		return "", fmt.Errorf("session manager does not support restart")
+	}
+
+	session, err := c.sessions.GetSession(sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	summary, err := c.summarize(ctx, sessionID)
+	if err != nil {
+		return "", fmt.Errorf("summarize session: %w", err)
+	}
+	if strings.TrimSpace(summary) == "" {
+		return "", ErrEmptyContextSummary
+	}
+
+	session.mu.RLock()
+	config := session.Config
+	basePrompt, hasBase := session.Metadata[metadataBaseSystemPrompt].(string)
+	compactions, _ := session.Metadata[metadataCompactions].(int)
+	session.mu.RUnlock()
+	if !hasBase {
+		basePrompt = config.SystemPrompt
+	}
+
+	// 요약을 이어받은 새 대화로 시작 (이전 CLI 대화는 이어가지 않음)
+	config.SystemPrompt = strings.TrimSpace(basePrompt + "\n\n" + contextSummaryHeader + summary)
+	config.ResumeSessionID = ""
+	config.Continue = false
+	config.ForkSession = false
+
+	if err := restarter.RestartSession(ctx, sessionID, config); err != nil {
+		return "", fmt.Errorf("restart session: %w", err)
+	}
+
+	err = c.sessions.UpdateSession(sessionID, SessionUpdate{
+		Metadata: map[string]interface{}{
+			metadataBaseSystemPrompt: basePrompt,
+			metadataCompactions:      compactions + 1,
+			metadataCompactedAt:      time.Now(),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("update session metadata: %w", err)
+	}
+	return summary, nil
+}
+
+// 압축 상태를 보관하는 세션 메타데이터 키
+const (
+	metadataBaseSystemPrompt = "base_system_prompt"
+	metadataCompactions      = "compactions"
+	metadataCompactedAt      = "compacted_at"
+)
+
+// NewTranscriptSummarizer 저장된 대화 기록으로 요약을 만드는 SummarizeFunc
+// 첫 사용자 요청(작업 목표)과 최근 메시지를 maxChars 안에서 최신순으로 채우고,
+// 메시지 하나는 maxMessageChars까지만 포함합니다.
+func NewTranscriptSummarizer(store storage.Storage, maxChars, maxMessageChars int) SummarizeFunc {
+	return func(ctx context.Context, sessionID string) (string, error) {
+		messages, err := listSessionMessages(ctx, store, sessionID)
+		if err != nil {
+			return "", err
+		}
+		if len(messages) == 0 {
+			return "", ErrEmptyContextSummary
+		}
+
+		var goal *models.SessionMessage
+		for _, message := range messages {
+			if message.Role == models.MessageRoleUser {
+				goal = message
+				break
+			}
+		}
+
+		budget := maxChars
+		var header string
+		if goal != nil {
+			header = "작업 목표: " + truncateRunes(goal.Content, maxMessageChars) + "\n\n"
+			budget -= len([]rune(header))
+		}
+
+		// 최신 메시지부터 예산 안에서 거꾸로 채움
+		var recent []string
+		for i := len(messages) - 1; i >= 0 && budget > 0; i-- {
+			message := messages[i]
+			if message == goal || strings.TrimSpace(message.Content) == "" {
+				continue
+			}
+			line := fmt.Sprintf("[%s] %s", message.Role, truncateRunes(message.Content, maxMessageChars))
+			size := len([]rune(line)) + 1
+			if size > budget {
+				break
+			}
+			budget -= size
+			recent = append(recent, line)
+		}
+		for i, j := 0, len(recent)-1; i < j; i, j = i+1, j-1 {
+			recent[i], recent[j] = recent[j], recent[i]
+		}
+
+		summary := header
+		if len(recent) > 0 {
+			summary += "최근 대화:\n" + strings.Join(recent, "\n")
+		}
+		return strings.TrimSpace(summary), nil
+	}
+}
+
+// listSessionMessages 세션의 메시지를 순서대로 모두 조회
+func listSessionMessages(ctx context.Context, store storage.Storage, sessionID string) ([]*models.SessionMessage, error) {
+	const pageSize = 100
+	var all []*models.SessionMessage
+	for page := 1; ; page++ {
+		messages, total, err := store.Message().ListBySession(ctx, sessionID, &models.PaginationRequest{Page: page, Limit: pageSize})
+		if err != nil {
+			return nil, fmt.Errorf("list session messages: %w", err)
+		}
+		all = append(all, messages...)
+		if len(messages) < pageSize || len(all) >= total {
+			return all, nil
+		}
+	}
+}
+
+// truncateRunes 문자 수 기준으로 자르고 잘렸으면 말줄임표를 붙임 (0 이하면 자르지 않음)
+func truncateRunes(s string, max int) string {
+	s = strings.TrimSpace(s)
+	runes := []rune(s)
+	if max <= 0 || len(runes) <= max {
+		return s
+	}
+	return string(runes[:max]) + "…"
+}
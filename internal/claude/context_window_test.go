@@ -0,0 +1,219 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+type fakeCompactor struct {
+	mu    sync.Mutex
+	calls []string
+	err   error
+}
+
+func (c *fakeCompactor) Compact(ctx context.Context, sessionID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, sessionID)
+	if c.err != nil {
+		return "", c.err
+	}
+	return "요약", nil
+}
+
+func (c *fakeCompactor) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.calls)
+}
+
+func testContextPolicy() ContextWindowPolicy {
+	return ContextWindowPolicy{
+		Limit:        1000,
+		ModelLimits:  map[string]int{"haiku": 500},
+		WarnRatio:    0.8,
+		CompactRatio: 0.9,
+		AutoCompact:  true,
+	}
+}
+
+func TestContextWindowPolicy(t *testing.T) {
+	policy := testContextPolicy()
+	require.NoError(t, policy.Validate())
+	assert.Equal(t, 500, policy.LimitFor("claude-3-haiku-20240307"))
+	assert.Equal(t, 1000, policy.LimitFor("claude-sonnet"))
+
+	invalid := policy
+	invalid.WarnRatio = 0.95
+	assert.Error(t, invalid.Validate())
+
+	invalid = policy
+	invalid.Limit = 0
+	assert.Error(t, invalid.Validate())
+}
+
+func TestContextTracker_WarnsOncePerLevel(t *testing.T) {
+	bus := NewSessionEventBus(10)
+	defer bus.Shutdown()
+	recorder := NewSessionEventRecorder()
+	bus.Subscribe("s1", recorder)
+
+	policy := testContextPolicy()
+	policy.AutoCompact = false
+	tracker, err := NewContextTracker(ContextTrackerConfig{Policy: policy, Events: bus}, nil)
+	require.NoError(t, err)
+
+	usage := tracker.Record("s1", AgentUsage{InputTokens: 300, CacheReadTokens: 200})
+	assert.Equal(t, 500, usage.Tokens)
+	assert.Equal(t, ContextLevelNormal, usage.Level)
+
+	usage = tracker.Record("s1", AgentUsage{InputTokens: 700, CacheReadTokens: 100, OutputTokens: 20})
+	assert.Equal(t, ContextLevelWarning, usage.Level)
+	tracker.Record("s1", AgentUsage{InputTokens: 850})
+	usage = tracker.Record("s1", AgentUsage{InputTokens: 950})
+	assert.Equal(t, ContextLevelCritical, usage.Level)
+	assert.False(t, usage.Compacting)
+
+	require.Eventually(t, func() bool {
+		return len(recorder.GetEventsByType(SessionEventContextWarning)) == 2
+	}, time.Second, 10*time.Millisecond)
+	events := recorder.GetEventsByType(SessionEventContextWarning)
+	assert.Equal(t, ContextLevelWarning, events[0].Data.(ContextUsage).Level)
+	assert.Equal(t, ContextLevelCritical, events[1].Data.(ContextUsage).Level)
+}
+
+func TestContextTracker_AutoCompact(t *testing.T) {
+	bus := NewSessionEventBus(10)
+	defer bus.Shutdown()
+	recorder := NewSessionEventRecorder()
+	bus.Subscribe("s1", recorder)
+
+	compactor := &fakeCompactor{}
+	tracker, err := NewContextTracker(ContextTrackerConfig{Policy: testContextPolicy(), Events: bus, Compactor: compactor}, nil)
+	require.NoError(t, err)
+
+	usage := tracker.Record("s1", AgentUsage{Model: "claude-3-haiku", InputTokens: 460})
+	assert.Equal(t, 500, usage.Limit)
+	assert.Equal(t, ContextLevelCritical, usage.Level)
+	assert.True(t, usage.Compacting)
+
+	require.Eventually(t, func() bool {
+		return len(recorder.GetEventsByType(SessionEventCompacted)) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, compactor.callCount())
+
+	compaction := recorder.GetEventsByType(SessionEventCompacted)[0].Data.(ContextCompaction)
+	assert.Equal(t, 460, compaction.TokensBefore)
+	assert.Equal(t, 1, compaction.Compactions)
+
+	current, ok := tracker.Usage("s1")
+	require.True(t, ok)
+	assert.Equal(t, 0, current.Tokens)
+	assert.Equal(t, ContextLevelNormal, current.Level)
+	assert.False(t, current.Compacting)
+
+	tracker.OnSessionEvent(SessionEvent{SessionID: "s1", Type: SessionEventClosed})
+	_, ok = tracker.Usage("s1")
+	assert.False(t, ok)
+}
+
+func TestContextTracker_CompactFailureWarns(t *testing.T) {
+	bus := NewSessionEventBus(10)
+	defer bus.Shutdown()
+	recorder := NewSessionEventRecorder()
+	bus.Subscribe("s1", recorder)
+
+	compactor := &fakeCompactor{err: errors.New("boom")}
+	tracker, err := NewContextTracker(ContextTrackerConfig{Policy: testContextPolicy(), Events: bus, Compactor: compactor}, nil)
+	require.NoError(t, err)
+
+	tracker.Record("s1", AgentUsage{InputTokens: 950})
+	require.Eventually(t, func() bool {
+		for _, event := range recorder.GetEventsByType(SessionEventContextWarning) {
+			if event.Error != nil {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+	assert.Empty(t, recorder.GetEventsByType(SessionEventCompacted))
+
+	current, ok := tracker.Usage("s1")
+	require.True(t, ok)
+	assert.Equal(t, ContextLevelCritical, current.Level)
+	assert.False(t, current.Compacting)
+}
+
+func TestTranscriptSummarizer(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	for _, message := range []*models.SessionMessage{
+		{Role: models.MessageRoleUser, Content: "로그인 버그를 고쳐 주세요"},
+		{Role: models.MessageRoleAssistant, Content: strings.Repeat("a", 50)},
+		{Role: models.MessageRoleUser, Content: "테스트도 추가해 주세요"},
+		{Role: models.MessageRoleAssistant, Content: "테스트를 추가했습니다"},
+	} {
+		message.SessionID = "s1"
+		message.WorkspaceID = "w1"
+		require.NoError(t, store.Message().Append(ctx, message))
+	}
+
+	summary, err := NewTranscriptSummarizer(store, 80, 20)(ctx, "s1")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(summary, "작업 목표: 로그인 버그를 고쳐 주세요"))
+	assert.Contains(t, summary, "[user] 테스트도 추가해 주세요\n[assistant] 테스트를 추가했습니다")
+	assert.NotContains(t, summary, "aaaa")
+
+	_, err = NewTranscriptSummarizer(store, 80, 20)(ctx, "missing")
+	assert.ErrorIs(t, err, ErrEmptyContextSummary)
+}
+
+func TestSessionCompactor_CarriesSummary(t *testing.T) {
+	ctx := context.Background()
+	process := &MockProcessManager{}
+	process.On("Start", mock.Anything, mock.Anything).Return(nil)
+	process.On("IsRunning").Return(true)
+	process.On("Stop", mock.Anything).Return(nil)
+
+	sm := NewSessionManager(process, nil)
+	session, err := sm.CreateSession(ctx, SessionConfig{
+		WorkingDir:      "/tmp",
+		MaxTurns:        10,
+		SystemPrompt:    "기본 프롬프트",
+		ResumeSessionID: "cli-session",
+	})
+	require.NoError(t, err)
+
+	summaries := []string{"첫 번째 요약", "두 번째 요약"}
+	compactor := NewSessionCompactor(sm, func(ctx context.Context, sessionID string) (string, error) {
+		summary := summaries[0]
+		summaries = summaries[1:]
+		return summary, nil
+	})
+
+	for range 2 {
+		_, err = compactor.Compact(ctx, session.ID)
+		require.NoError(t, err)
+	}
+
+	updated, err := sm.GetSession(session.ID)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(updated.Config.SystemPrompt, "기본 프롬프트"))
+	assert.Contains(t, updated.Config.SystemPrompt, "두 번째 요약")
+	assert.NotContains(t, updated.Config.SystemPrompt, "첫 번째 요약")
+	assert.Empty(t, updated.Config.ResumeSessionID)
+	assert.Equal(t, 2, updated.Metadata[metadataCompactions])
+	process.AssertNumberOfCalls(t, "Start", 3)
+	process.AssertNumberOfCalls(t, "Stop", 2)
+}
@@ -10,6 +10,14 @@
 //   - 상태 추적 및 헬스체크
 //   - 시그널 처리 및 우아한 종료
 //   - 입출력 리다이렉션
+//   - RestartPolicy에 따른 비정상 종료 시 자동 재시작 (지수 백오프, 회로 차단기)
+//   - 상태 전환마다 ProcessEvent 발행 (ProcessConfig.EventListener)
+//   - HangPolicy에 따른 멈춘 프로세스 감지와 /proc 상태, 고루틴 스택 수집
+//
+// ProcessReaper - 서버 비정상 종료 후 남은 CLI 프로세스 정리:
+//   - 실행한 PID를 시작 시각 지문과 함께 스토리지에 기록
+//   - 재시작 시 이전 실행의 고아 프로세스를 종료하거나 다시 관리
+//   - PID 재사용 감지 및 dry-run 보고서
 //
 // ProcessManagerV2 - 향상된 프로세스 관리자:
 //   - 상태 머신 기반 상태 관리
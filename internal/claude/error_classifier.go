@@ -74,6 +74,43 @@ const (
 	SeverityFatal
 )
 
+// String은 ErrorType의 문자열 표현을 반환합니다
+func (t ErrorType) String() string {
+	types := []string{
+		"none",
+		"network",
+		"process",
+		"auth",
+		"resource",
+		"timeout",
+		"validation",
+		"internal",
+		"config",
+		"dependency",
+		"quota",
+		"unknown",
+	}
+	if t >= 0 && int(t) < len(types) {
+		return types[t]
+	}
+	return "unknown"
+}
+
+// String은 ErrorSeverity의 문자열 표현을 반환합니다
+func (s ErrorSeverity) String() string {
+	severities := []string{
+		"low",
+		"medium",
+		"high",
+		"critical",
+		"fatal",
+	}
+	if s >= 0 && int(s) < len(severities) {
+		return severities[s]
+	}
+	return "unknown"
+}
+
 // ErrorPriority는 에러 처리 우선순위입니다
 type ErrorPriority int
 
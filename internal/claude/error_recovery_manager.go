@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // errorRecoveryManager 에러 복구 관리자 구현
@@ -17,7 +19,7 @@ type errorRecoveryManager struct {
 	circuitBreaker  CircuitBreaker
 	backoff         BackoffStrategy
 	processManager  ProcessManager
-	logger          *logrus.Logger
+	logger          logging.Logger
 	mutex           sync.RWMutex
 	ctx             context.Context
 	cancel          context.CancelFunc
@@ -51,14 +53,14 @@ type RecoveryMetric struct {
 func NewErrorRecoveryManager(
 	policy *RecoveryPolicy,
 	processManager ProcessManager,
-	logger *logrus.Logger,
+	logger logging.Logger,
 ) ErrorRecovery {
 	if policy == nil {
 		policy = DefaultRecoveryPolicy()
 	}
 	
 	if logger == nil {
-		logger = logrus.StandardLogger()
+		logger = logging.FromLogrus(logrus.StandardLogger())
 	}
 	
 	ctx, cancel := context.WithCancel(context.Background())
@@ -109,7 +111,7 @@ func (erm *errorRecoveryManager) HandleError(err error) RecoveryAction {
 	errorType, action := erm.classifier.ClassifyError(err)
 	erm.stats.IncrementError(errorType)
 	
-	erm.logger.WithFields(logrus.Fields{
+	erm.logger.WithFields(logging.Fields{
 		"error":      err.Error(),
 		"error_type": errorType,
 		"action":     action,
@@ -179,7 +181,7 @@ func (erm *errorRecoveryManager) processAction(action RecoveryAction, errorType
 func (erm *errorRecoveryManager) shouldAllowRestart() bool {
 	// 최대 재시작 횟수 확인
 	if erm.stats.RestartCount >= int64(erm.policy.MaxRestarts) {
-		erm.logger.WithFields(logrus.Fields{
+		erm.logger.WithFields(logging.Fields{
 			"restart_count": erm.stats.RestartCount,
 			"max_restarts":  erm.policy.MaxRestarts,
 		}).Error("최대 재시작 횟수에 도달했습니다")
@@ -192,7 +194,7 @@ func (erm *errorRecoveryManager) shouldAllowRestart() bool {
 		minInterval := erm.backoff.NextBackoff()
 		
 		if timeSinceLastRestart < minInterval {
-			erm.logger.WithFields(logrus.Fields{
+			erm.logger.WithFields(logging.Fields{
 				"time_since_last": timeSinceLastRestart,
 				"min_interval":    minInterval,
 			}).Warn("재시작이 너무 빨리 시도되었습니다")
@@ -216,7 +218,7 @@ func (erm *errorRecoveryManager) shouldAllowRestart() bool {
 	// 윈도우 내 재시작 횟수가 제한을 초과하는지 확인
 	windowRestartLimit := erm.policy.MaxRestarts * 2 // 윈도우 내에서는 좀 더 관대하게
 	if len(erm.restartCounts) >= windowRestartLimit {
-		erm.logger.WithFields(logrus.Fields{
+		erm.logger.WithFields(logging.Fields{
 			"window_restarts": len(erm.restartCounts),
 			"window_limit":    windowRestartLimit,
 			"window_duration": erm.restartWindow,
@@ -290,7 +292,7 @@ func (erm *errorRecoveryManager) Restart(ctx context.Context) error {
 		erm.circuitBreaker.RecordError()
 		duration := time.Since(startTime)
 		
-		erm.logger.WithFields(logrus.Fields{
+		erm.logger.WithFields(logging.Fields{
 			"error":    err,
 			"duration": duration,
 		}).Error("프로세스 재시작 실패")
@@ -310,7 +312,7 @@ func (erm *errorRecoveryManager) Restart(ctx context.Context) error {
 	
 	duration := time.Since(startTime)
 	
-	erm.logger.WithFields(logrus.Fields{
+	erm.logger.WithFields(logging.Fields{
 		"restart_count": erm.stats.RestartCount,
 		"duration":      duration,
 	}).Info("프로세스가 성공적으로 재시작되었습니다")
@@ -458,7 +460,7 @@ func (erm *errorRecoveryManager) metricsCollector() {
 		select {
 		case metric := <-erm.metricsChannel:
 			// 메트릭 처리 (예: 외부 모니터링 시스템으로 전송)
-			erm.logger.WithFields(logrus.Fields{
+			erm.logger.WithFields(logging.Fields{
 				"timestamp":   metric.Timestamp,
 				"error_type":  metric.ErrorType,
 				"action":      metric.Action,
@@ -484,7 +486,7 @@ func (erm *errorRecoveryManager) statsLogger() {
 			stats := erm.GetRecoveryStats()
 			cbStats := erm.circuitBreaker.Stats()
 			
-			erm.logger.WithFields(logrus.Fields{
+			erm.logger.WithFields(logging.Fields{
 				"total_errors":     stats.TotalErrors,
 				"restart_count":    stats.RestartCount,
 				"successful_runs":  stats.SuccessfulRuns,
@@ -9,6 +9,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 
@@ -120,7 +122,7 @@ func TestCircuitBreaker(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.ErrorLevel) // 테스트 중 로그 출력 최소화
 
-	cb := NewCircuitBreaker(config, logger)
+	cb := NewCircuitBreaker(config, logging.FromLogrus(logger))
 
 	// 초기 상태는 Closed
 	assert.True(t, cb.IsClosed())
@@ -179,7 +181,7 @@ func TestErrorRecoveryManager_HandleError(t *testing.T) {
 		Enabled: true,
 	}
 
-	erm := NewErrorRecoveryManager(policy, mockPM, logger)
+	erm := NewErrorRecoveryManager(policy, mockPM, logging.FromLogrus(logger))
 	defer erm.Stop()
 
 	tests := []struct {
@@ -225,7 +227,7 @@ func TestErrorRecoveryManager_Restart(t *testing.T) {
 	policy := DefaultRecoveryPolicy()
 	policy.RestartInterval = 10 * time.Millisecond // 테스트를 위해 짧게 설정
 
-	erm := NewErrorRecoveryManager(policy, mockPM, logger)
+	erm := NewErrorRecoveryManager(policy, mockPM, logging.FromLogrus(logger))
 	defer erm.Stop()
 
 	ctx := context.Background()
@@ -256,7 +258,7 @@ func TestErrorRecoveryManager_RestartLimits(t *testing.T) {
 	policy.MaxRestarts = 2
 	policy.RestartInterval = 10 * time.Millisecond
 
-	erm := NewErrorRecoveryManager(policy, mockPM, logger)
+	erm := NewErrorRecoveryManager(policy, mockPM, logging.FromLogrus(logger))
 	defer erm.Stop()
 
 	ctx := context.Background()
@@ -338,7 +340,7 @@ func TestErrorRecoveryManager_EnableDisable(t *testing.T) {
 	policy := DefaultRecoveryPolicy()
 	policy.Enabled = false // 비활성화로 시작
 
-	erm := NewErrorRecoveryManager(policy, mockPM, logger)
+	erm := NewErrorRecoveryManager(policy, mockPM, logging.FromLogrus(logger))
 	defer erm.Stop()
 
 	// 비활성화 상태에서는 모든 에러를 무시
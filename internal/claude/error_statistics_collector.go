@@ -0,0 +1,373 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/cluster"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// ErrorStatisticsCollectorConfig 에러 통계 수집 설정
+type ErrorStatisticsCollectorConfig struct {
+	// FlushInterval 메모리에 모은 집계를 스토리지에 저장하는 주기
+	FlushInterval time.Duration
+	// CompactInterval 보존 기간이 지난 집계를 삭제하는 주기
+	CompactInterval time.Duration
+	// HourlyRetention 시간별 집계 보존 기간
+	HourlyRetention time.Duration
+	// DailyRetention 일별 집계 보존 기간
+	DailyRetention time.Duration
+	// Registerer Prometheus 메트릭 등록 대상 (nil이면 등록하지 않음)
+	Registerer prometheus.Registerer
+	// Guard 레플리카가 스토리지를 공유할 때 보존 기간 정리를 감쌀 분산 잠금 (nil이면 잠금 없이 실행)
+	Guard cluster.Guard
+}
+
+// errorStatKey 시간별 집계 구간과 에러 유형
+type errorStatKey struct {
+	hour      time.Time
+	errorType string
+	severity  string
+	category  string
+}
+
+// recoveryStatKey 복구 시도 집계 단위
+type recoveryStatKey struct {
+	errorType string
+	action    string
+}
+
+// RecoveryStat 에러 유형과 복구 동작별 복구 시도 결과
+type RecoveryStat struct {
+	ErrorType   string    `json:"error_type"`
+	Action      string    `json:"action"`
+	Succeeded   int64     `json:"succeeded"`
+	Failed      int64     `json:"failed"`
+	LastError   string    `json:"last_error,omitempty"`
+	LastAttempt time.Time `json:"last_attempt"`
+}
+
+// ErrorStatisticsCollector 분류된 에러를 시간별/일별로 집계하여 스토리지에 저장합니다
+// 집계는 메모리에 모았다가 주기적으로 저장하므로 서버를 재시작해도 이력이 남고,
+// 같은 값을 Prometheus 카운터로도 내보냅니다.
+type ErrorStatisticsCollector struct {
+	store      storage.ErrorStatsStorage
+	classifier ErrorClassifier
+	config     ErrorStatisticsCollectorConfig
+	logger     *logrus.Logger
+
+	mu         sync.Mutex
+	pending    map[errorStatKey]int64
+	recoveries map[recoveryStatKey]*RecoveryStat
+
+	errorsTotal     *prometheus.CounterVec
+	recoveriesTotal *prometheus.CounterVec
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewErrorStatisticsCollector 새 에러 통계 수집기 생성 (classifier가 nil이면 기본 분류 엔진 사용)
+func NewErrorStatisticsCollector(store storage.ErrorStatsStorage, classifier ErrorClassifier, config ErrorStatisticsCollectorConfig, logger *logrus.Logger) *ErrorStatisticsCollector {
+	if classifier == nil {
+		classifier = NewErrorClassificationEngine()
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = time.Minute
+	}
+	if config.CompactInterval <= 0 {
+		config.CompactInterval = time.Hour
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+
+	return &ErrorStatisticsCollector{
+		store:      store,
+		classifier: classifier,
+		config:     config,
+		logger:     logger,
+		pending:    make(map[errorStatKey]int64),
+		recoveries: make(map[recoveryStatKey]*RecoveryStat),
+		errorsTotal: registerCounterVec(config.Registerer, prometheus.CounterOpts{
+			Name: "aicli_errors_total",
+			Help: "분류된 에러 발생 수 (유형, 심각도, 분류별)",
+		}, []string{"type", "severity", "category"}),
+		recoveriesTotal: registerCounterVec(config.Registerer, prometheus.CounterOpts{
+			Name: "aicli_recoveries_total",
+			Help: "에러 복구 시도 수 (에러 유형, 복구 동작, 결과별)",
+		}, []string{"type", "action", "result"}),
+	}
+}
+
+// registerCounterVec 카운터 등록 (이미 등록되어 있으면 기존 카운터 사용)
+func registerCounterVec(reg prometheus.Registerer, opts prometheus.CounterOpts, labels []string) *prometheus.CounterVec {
+	counter := prometheus.NewCounterVec(opts, labels)
+	if reg == nil {
+		return counter
+	}
+
+	if err := reg.Register(counter); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if errors.As(err, &registered) {
+			if existing, ok := registered.ExistingCollector.(*prometheus.CounterVec); ok {
+				return existing
+			}
+		}
+	}
+	return counter
+}
+
+// Record 에러를 분류하여 집계에 추가하고 분류 결과를 반환
+func (c *ErrorStatisticsCollector) Record(err error) ErrorClass {
+	class := c.classifier.ClassifyError(err)
+	c.RecordClass(class, time.Now())
+	return class
+}
+
+// RecordClass 이미 분류된 에러를 발생 시각의 집계에 추가
+func (c *ErrorStatisticsCollector) RecordClass(class ErrorClass, at time.Time) {
+	key := errorStatKey{
+		hour:      models.ErrorStatHourly.Truncate(at),
+		errorType: class.Type.String(),
+		severity:  class.Severity.String(),
+		category:  class.Category,
+	}
+
+	c.mu.Lock()
+	c.pending[key]++
+	c.mu.Unlock()
+
+	c.errorsTotal.WithLabelValues(key.errorType, key.severity, key.category).Inc()
+}
+
+// RecordRecovery 복구 시도 결과를 에러 유형과 복구 동작별로 집계 (err가 nil이면 성공)
+func (c *ErrorStatisticsCollector) RecordRecovery(class ErrorClass, action string, err error) {
+	key := recoveryStatKey{errorType: class.Type.String(), action: action}
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+
+	c.mu.Lock()
+	stat, ok := c.recoveries[key]
+	if !ok {
+		stat = &RecoveryStat{ErrorType: key.errorType, Action: action}
+		c.recoveries[key] = stat
+	}
+	if err != nil {
+		stat.Failed++
+		stat.LastError = err.Error()
+	} else {
+		stat.Succeeded++
+	}
+	stat.LastAttempt = time.Now()
+	c.mu.Unlock()
+
+	c.recoveriesTotal.WithLabelValues(key.errorType, action, result).Inc()
+}
+
+// RecoveryStats 서버 시작 후 복구 시도 결과 (시도가 많은 순)
+func (c *ErrorStatisticsCollector) RecoveryStats() []RecoveryStat {
+	c.mu.Lock()
+	stats := make([]RecoveryStat, 0, len(c.recoveries))
+	for _, stat := range c.recoveries {
+		stats = append(stats, *stat)
+	}
+	c.mu.Unlock()
+
+	sort.Slice(stats, func(i, j int) bool {
+		ti, tj := stats[i].Succeeded+stats[i].Failed, stats[j].Succeeded+stats[j].Failed
+		if ti != tj {
+			return ti > tj
+		}
+		if stats[i].ErrorType != stats[j].ErrorType {
+			return stats[i].ErrorType < stats[j].ErrorType
+		}
+		return stats[i].Action < stats[j].Action
+	})
+	return stats
+}
+
+// OnSessionEvent 세션 에러와 프로세스 비정상 종료 이벤트를 집계
+func (c *ErrorStatisticsCollector) OnSessionEvent(event SessionEvent) {
+	switch event.Type {
+	case SessionEventError:
+		if event.Error != nil {
+			c.Record(event.Error)
+		}
+	case SessionEventProcess:
+		processEvent, ok := event.Data.(ProcessEvent)
+		if !ok || processEvent.Error == "" {
+			return
+		}
+		switch processEvent.Type {
+		case ProcessEventCrashed, ProcessEventStartFailed, ProcessEventHung:
+			c.Record(errors.New(processEvent.Error))
+		}
+	}
+}
+
+// Flush 메모리에 모은 집계를 시간별, 일별 구간으로 스토리지에 누적
+// 저장에 실패하면 다음 저장 때 다시 시도하도록 집계를 되돌려 놓습니다.
+func (c *ErrorStatisticsCollector) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = make(map[errorStatKey]int64)
+	c.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	buckets := make([]*models.ErrorStatBucket, 0, len(pending)*2)
+	for key, count := range pending {
+		for _, granularity := range []models.ErrorStatGranularity{models.ErrorStatHourly, models.ErrorStatDaily} {
+			buckets = append(buckets, &models.ErrorStatBucket{
+				Granularity: granularity,
+				BucketStart: granularity.Truncate(key.hour),
+				ErrorType:   key.errorType,
+				Severity:    key.severity,
+				Category:    key.category,
+				Count:       count,
+			})
+		}
+	}
+
+	if err := c.store.Increment(ctx, buckets); err != nil {
+		c.mu.Lock()
+		for key, count := range pending {
+			c.pending[key] += count
+		}
+		c.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Compact 보존 기간이 지난 시간별, 일별 집계 삭제 후 삭제한 수 반환
+// 시간별 집계는 일별 집계에 이미 합산되어 있으므로 삭제해도 장기 추이는 남습니다.
+func (c *ErrorStatisticsCollector) Compact(ctx context.Context, now time.Time) (int64, error) {
+	var deleted int64
+	if c.config.HourlyRetention > 0 {
+		n, err := c.store.DeleteBefore(ctx, models.ErrorStatHourly, models.ErrorStatHourly.Truncate(now.Add(-c.config.HourlyRetention)))
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+	if c.config.DailyRetention > 0 {
+		n, err := c.store.DeleteBefore(ctx, models.ErrorStatDaily, models.ErrorStatDaily.Truncate(now.Add(-c.config.DailyRetention)))
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
+
+// Series 기간 안의 집계를 에러 유형별 시계열로 반환 (아직 저장하지 않은 집계 포함)
+func (c *ErrorStatisticsCollector) Series(ctx context.Context, granularity models.ErrorStatGranularity, from, to time.Time) ([]models.ErrorStatSeries, error) {
+	if err := c.Flush(ctx); err != nil {
+		return nil, err
+	}
+
+	buckets, err := c.store.List(ctx, granularity, granularity.Truncate(from), to)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[[3]string]int)
+	series := []models.ErrorStatSeries{}
+	for _, bucket := range buckets {
+		key := [3]string{bucket.ErrorType, bucket.Severity, bucket.Category}
+		i, ok := index[key]
+		if !ok {
+			i = len(series)
+			index[key] = i
+			series = append(series, models.ErrorStatSeries{
+				ErrorType: bucket.ErrorType,
+				Severity:  bucket.Severity,
+				Category:  bucket.Category,
+				Points:    []models.ErrorStatPoint{},
+			})
+		}
+		series[i].Total += bucket.Count
+		series[i].Points = append(series[i].Points, models.ErrorStatPoint{Time: bucket.BucketStart, Count: bucket.Count})
+	}
+
+	sort.SliceStable(series, func(i, j int) bool {
+		return series[i].Total > series[j].Total
+	})
+	return series, nil
+}
+
+// Start 주기적인 저장과 보존 기간 정리 시작
+func (c *ErrorStatisticsCollector) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(ctx)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		flushTicker := time.NewTicker(c.config.FlushInterval)
+		defer flushTicker.Stop()
+		compactTicker := time.NewTicker(c.config.CompactInterval)
+		defer compactTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-flushTicker.C:
+				if err := c.Flush(ctx); err != nil {
+					c.logger.WithError(err).Warn("에러 통계 저장 실패")
+				}
+			case now := <-compactTicker.C:
+				c.compactGuarded(ctx, now)
+			}
+		}
+	}()
+}
+
+// compactGuarded 보존 기간 정리 (다른 인스턴스가 정리 중이면 건너뜀)
+func (c *ErrorStatisticsCollector) compactGuarded(ctx context.Context, now time.Time) {
+	var deleted int64
+	compact := func(ctx context.Context) error {
+		var err error
+		deleted, err = c.Compact(ctx, now)
+		return err
+	}
+
+	var err error
+	if c.config.Guard != nil {
+		err = c.config.Guard.WithLock(ctx, "error-stats-compact", compact)
+	} else {
+		err = compact(ctx)
+	}
+	switch {
+	case errors.Is(err, cluster.ErrLocked):
+		c.logger.Debug("다른 인스턴스가 에러 통계를 정리 중이어서 건너뜁니다")
+	case err != nil:
+		c.logger.WithError(err).Warn("에러 통계 정리 실패")
+	case deleted > 0:
+		c.logger.WithField("deleted", deleted).Info("보존 기간이 지난 에러 통계를 삭제했습니다")
+	}
+}
+
+// Stop 주기 작업을 멈추고 남은 집계 저장
+func (c *ErrorStatisticsCollector) Stop(ctx context.Context) error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+	return c.Flush(ctx)
+}
@@ -0,0 +1,102 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestErrorStatisticsCollector_FlushAndSeries(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	registry := prometheus.NewRegistry()
+	collector := NewErrorStatisticsCollector(store.ErrorStats(), nil, ErrorStatisticsCollectorConfig{Registerer: registry}, logrus.New())
+
+	base := time.Date(2026, 3, 1, 10, 15, 0, 0, time.UTC)
+	network := ErrorClass{Type: NetworkError, Severity: SeverityMedium, Category: "network"}
+	process := ErrorClass{Type: ProcessError, Severity: SeverityHigh, Category: "process"}
+	collector.RecordClass(network, base)
+	collector.RecordClass(network, base.Add(10*time.Minute))
+	collector.RecordClass(network, base.Add(time.Hour))
+	collector.RecordClass(process, base)
+
+	require.NoError(t, collector.Flush(ctx))
+
+	// 저장된 집계는 새 수집기에서도 조회됨 (재시작 후 이력 유지)
+	restarted := NewErrorStatisticsCollector(store.ErrorStats(), nil, ErrorStatisticsCollectorConfig{}, logrus.New())
+	series, err := restarted.Series(ctx, models.ErrorStatHourly, base.Add(-time.Hour), base.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+	assert.Equal(t, "network", series[0].ErrorType)
+	assert.Equal(t, "medium", series[0].Severity)
+	assert.Equal(t, int64(3), series[0].Total)
+	require.Len(t, series[0].Points, 2)
+	assert.Equal(t, base.Truncate(time.Hour), series[0].Points[0].Time)
+	assert.Equal(t, int64(2), series[0].Points[0].Count)
+
+	daily, err := restarted.Series(ctx, models.ErrorStatDaily, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, daily, 2)
+	require.Len(t, daily[0].Points, 1)
+	assert.Equal(t, int64(3), daily[0].Points[0].Count)
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(collector.errorsTotal.WithLabelValues("network", "medium", "network")))
+}
+
+func TestErrorStatisticsCollector_Compact(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	collector := NewErrorStatisticsCollector(store.ErrorStats(), nil, ErrorStatisticsCollectorConfig{
+		HourlyRetention: 24 * time.Hour,
+		DailyRetention:  7 * 24 * time.Hour,
+	}, logrus.New())
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	class := ErrorClass{Type: TimeoutError, Severity: SeverityMedium, Category: "timeout"}
+	collector.RecordClass(class, now.Add(-30*24*time.Hour))
+	collector.RecordClass(class, now.Add(-3*24*time.Hour))
+	collector.RecordClass(class, now.Add(-time.Hour))
+	require.NoError(t, collector.Flush(ctx))
+
+	deleted, err := collector.Compact(ctx, now)
+	require.NoError(t, err)
+	// 시간별 2개, 일별 1개 삭제
+	assert.Equal(t, int64(3), deleted)
+
+	hourly, err := store.ErrorStats().List(ctx, models.ErrorStatHourly, time.Time{}, now)
+	require.NoError(t, err)
+	assert.Len(t, hourly, 1)
+
+	daily, err := store.ErrorStats().List(ctx, models.ErrorStatDaily, time.Time{}, now)
+	require.NoError(t, err)
+	assert.Len(t, daily, 2)
+}
+
+func TestErrorStatisticsCollector_OnSessionEvent(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	collector := NewErrorStatisticsCollector(store.ErrorStats(), nil, ErrorStatisticsCollectorConfig{}, logrus.New())
+
+	collector.OnSessionEvent(SessionEvent{Type: SessionEventProcess, Data: ProcessEvent{Type: ProcessEventCrashed, Error: "exit status 1"}})
+	collector.OnSessionEvent(SessionEvent{Type: SessionEventProcess, Data: ProcessEvent{Type: ProcessEventStarted}})
+	collector.OnSessionEvent(SessionEvent{Type: SessionEventError, Error: errors.New("connection refused")})
+
+	series, err := collector.Series(ctx, models.ErrorStatHourly, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	var total int64
+	for _, s := range series {
+		total += s.Total
+	}
+	assert.Equal(t, int64(2), total)
+}
@@ -0,0 +1,425 @@
+package claude
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// 추세 분석기 이름
+const (
+	TrendAnalyzerEWMA        = "ewma"
+	TrendAnalyzerHoltWinters = "holt_winters"
+)
+
+// minTrendDeviation 이상 탐지에 쓰는 최소 표준편차 (에러가 드문 구간에서 한 건만 늘어도 이상으로 보지 않도록)
+const minTrendDeviation = 1.0
+
+// TrendAnalyzer 시간 순서의 값(오래된 것부터)으로 다음 구간 값을 예측하고 마지막 값의 이상 여부를 판단합니다
+type TrendAnalyzer interface {
+	Name() string
+	Analyze(values []float64) TrendForecast
+}
+
+// TrendForecast 추세 분석 결과
+type TrendForecast struct {
+	Analyzer  string  `json:"analyzer"`
+	Last      float64 `json:"last"`      // 마지막 구간 값
+	Expected  float64 `json:"expected"`  // 마지막 구간의 예측 값
+	Predicted float64 `json:"predicted"` // 다음 구간 예측 값
+	Slope     float64 `json:"slope"`     // 구간당 증가량
+	Deviation float64 `json:"deviation"` // 예측 오차의 표준편차
+	Score     float64 `json:"score"`     // 마지막 값의 예측 오차 / 표준편차
+	Anomaly   bool    `json:"anomaly"`   // Score가 민감도를 넘음
+}
+
+// EWMAAnalyzer 지수 가중 이동 평균으로 수준과 오차 분산을 추적합니다
+type EWMAAnalyzer struct {
+	// Alpha 평활 계수 (0~1, 클수록 최근 값에 민감)
+	Alpha float64
+	// Sensitivity 이상으로 판단할 표준편차 배수
+	Sensitivity float64
+}
+
+// Name 분석기 이름
+func (a *EWMAAnalyzer) Name() string {
+	return TrendAnalyzerEWMA
+}
+
+// Analyze 다음 구간 값 예측
+func (a *EWMAAnalyzer) Analyze(values []float64) TrendForecast {
+	forecast := TrendForecast{Analyzer: a.Name()}
+	if len(values) == 0 {
+		return forecast
+	}
+
+	level := values[0]
+	variance := 0.0
+	prevLevel := level
+	for i := 1; i < len(values); i++ {
+		residual := values[i] - level
+		if i == len(values)-1 {
+			forecast.Expected = level
+			forecast.Score, forecast.Deviation = trendScore(residual, variance)
+		}
+		variance = (1 - a.Alpha) * (variance + a.Alpha*residual*residual)
+		prevLevel = level
+		level += a.Alpha * residual
+	}
+
+	forecast.Last = values[len(values)-1]
+	forecast.Predicted = math.Max(level, 0)
+	forecast.Slope = level - prevLevel
+	forecast.Anomaly = forecast.Score > a.Sensitivity
+	return forecast
+}
+
+// HoltWintersAnalyzer 수준, 추세, 계절성(가법)을 지수 평활로 추적합니다
+// 계절 길이의 두 배보다 데이터가 적으면 계절성 없이 추세만 사용합니다.
+type HoltWintersAnalyzer struct {
+	// Alpha 수준 평활 계수
+	Alpha float64
+	// Beta 추세 평활 계수
+	Beta float64
+	// Gamma 계절성 평활 계수
+	Gamma float64
+	// SeasonLength 계절 주기 (시간별 집계라면 24)
+	SeasonLength int
+	// Sensitivity 이상으로 판단할 표준편차 배수
+	Sensitivity float64
+}
+
+// Name 분석기 이름
+func (a *HoltWintersAnalyzer) Name() string {
+	return TrendAnalyzerHoltWinters
+}
+
+// Analyze 다음 구간 값 예측
+func (a *HoltWintersAnalyzer) Analyze(values []float64) TrendForecast {
+	forecast := TrendForecast{Analyzer: a.Name()}
+	n := len(values)
+	if n == 0 {
+		return forecast
+	}
+	forecast.Last = values[n-1]
+	if n == 1 {
+		forecast.Expected = values[0]
+		forecast.Predicted = math.Max(values[0], 0)
+		return forecast
+	}
+
+	m := a.SeasonLength
+	seasonal := false
+	if m > 1 && n >= 2*m {
+		seasonal = true
+	} else {
+		m = 1
+	}
+
+	// 초기값: 계절성이 있으면 첫 두 주기의 평균 차이, 없으면 첫 두 값의 차이
+	var level, trend float64
+	season := make([]float64, m)
+	start := 1
+	if seasonal {
+		first, second := mean(values[:m]), mean(values[m:2*m])
+		level = first
+		trend = (second - first) / float64(m)
+		for i := 0; i < m; i++ {
+			season[i] = values[i] - first
+		}
+		start = m
+	} else {
+		level = values[0]
+		trend = values[1] - values[0]
+	}
+
+	variance := 0.0
+	for t := start; t < n; t++ {
+		s := season[t%m]
+		expected := level + trend + s
+		residual := values[t] - expected
+		if t == n-1 {
+			forecast.Expected = expected
+			forecast.Score, forecast.Deviation = trendScore(residual, variance)
+		}
+		variance = (1 - a.Alpha) * (variance + a.Alpha*residual*residual)
+
+		prevLevel := level
+		level = a.Alpha*(values[t]-s) + (1-a.Alpha)*(level+trend)
+		trend = a.Beta*(level-prevLevel) + (1-a.Beta)*trend
+		if seasonal {
+			season[t%m] = a.Gamma*(values[t]-level) + (1-a.Gamma)*s
+		}
+	}
+
+	forecast.Predicted = math.Max(level+trend+season[n%m], 0)
+	forecast.Slope = trend
+	forecast.Anomaly = forecast.Score > a.Sensitivity
+	return forecast
+}
+
+// trendScore 예측 오차를 표준편차 단위로 환산 (증가 방향만 이상으로 봄)
+func trendScore(residual, variance float64) (score, deviation float64) {
+	deviation = math.Max(math.Sqrt(variance), minTrendDeviation)
+	if residual <= 0 {
+		return 0, deviation
+	}
+	return residual / deviation, deviation
+}
+
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// ErrorTrendConfig 에러 추세 분석 설정
+type ErrorTrendConfig struct {
+	// Analyzer 사용할 분석기 (ewma, holt_winters)
+	Analyzer string
+	// Window 분석할 시간별 집계 구간 수
+	Window int
+	// Interval 분석 주기
+	Interval time.Duration
+	// Threshold 다음 한 시간의 예측 에러 수가 이 값을 넘으면 알림 (0이면 사용하지 않음)
+	Threshold float64
+	// Sensitivity 이상으로 판단할 표준편차 배수
+	Sensitivity float64
+	// Alpha, Beta, Gamma 평활 계수
+	Alpha float64
+	Beta  float64
+	Gamma float64
+	// SeasonLength Holt-Winters 계절 주기 (시간 단위)
+	SeasonLength int
+}
+
+// DefaultErrorTrendConfig 기본 에러 추세 분석 설정
+func DefaultErrorTrendConfig() ErrorTrendConfig {
+	return ErrorTrendConfig{
+		Analyzer:     TrendAnalyzerEWMA,
+		Window:       48,
+		Interval:     5 * time.Minute,
+		Sensitivity:  3,
+		Alpha:        0.3,
+		Beta:         0.1,
+		Gamma:        0.1,
+		SeasonLength: 24,
+	}
+}
+
+// NewTrendAnalyzer 설정으로 추세 분석기 생성
+func NewTrendAnalyzer(config ErrorTrendConfig) (TrendAnalyzer, error) {
+	if config.Alpha <= 0 || config.Alpha > 1 || config.Beta < 0 || config.Beta > 1 || config.Gamma < 0 || config.Gamma > 1 {
+		return nil, fmt.Errorf("smoothing factors must be in (0, 1]: alpha=%v beta=%v gamma=%v", config.Alpha, config.Beta, config.Gamma)
+	}
+	if config.Sensitivity <= 0 {
+		return nil, fmt.Errorf("sensitivity must be positive, got %v", config.Sensitivity)
+	}
+
+	switch config.Analyzer {
+	case "", TrendAnalyzerEWMA:
+		return &EWMAAnalyzer{Alpha: config.Alpha, Sensitivity: config.Sensitivity}, nil
+	case TrendAnalyzerHoltWinters:
+		return &HoltWintersAnalyzer{
+			Alpha:        config.Alpha,
+			Beta:         config.Beta,
+			Gamma:        config.Gamma,
+			SeasonLength: config.SeasonLength,
+			Sensitivity:  config.Sensitivity,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown trend analyzer: %s", config.Analyzer)
+	}
+}
+
+// ErrorTypeTrend 에러 유형별 추세
+type ErrorTypeTrend struct {
+	ErrorType string        `json:"error_type"`
+	Forecast  TrendForecast `json:"forecast"`
+}
+
+// ErrorTrendReport 에러 추세 분석 보고서
+type ErrorTrendReport struct {
+	GeneratedAt time.Time        `json:"generated_at"`
+	Window      int              `json:"window"`
+	Threshold   float64          `json:"threshold"`
+	Total       TrendForecast    `json:"total"`
+	ByType      []ErrorTypeTrend `json:"by_type"`
+	Alerts      []string         `json:"alerts"`
+}
+
+// ErrorTrendMonitor 저장된 시간별 에러 통계로 추세를 분석하고 임계값이나 이상을 알립니다
+type ErrorTrendMonitor struct {
+	collector *ErrorStatisticsCollector
+	analyzer  TrendAnalyzer
+	alerts    AlertManager
+	config    ErrorTrendConfig
+	logger    *logrus.Logger
+
+	mu     sync.RWMutex
+	last   *ErrorTrendReport
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewErrorTrendMonitor 새 에러 추세 모니터 생성 (alerts가 nil이면 로그로만 남김)
+func NewErrorTrendMonitor(collector *ErrorStatisticsCollector, config ErrorTrendConfig, alerts AlertManager, logger *logrus.Logger) (*ErrorTrendMonitor, error) {
+	analyzer, err := NewTrendAnalyzer(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.Window < 2 {
+		return nil, fmt.Errorf("trend window must be at least 2, got %d", config.Window)
+	}
+	if config.Interval <= 0 {
+		config.Interval = 5 * time.Minute
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+
+	return &ErrorTrendMonitor{
+		collector: collector,
+		analyzer:  analyzer,
+		alerts:    alerts,
+		config:    config,
+		logger:    logger,
+	}, nil
+}
+
+// Evaluate now까지의 시간별 집계로 추세를 분석하고 필요하면 알림을 보냄
+// 마지막(현재 진행 중인) 구간은 아직 채워지는 중이므로 분석에서 제외합니다.
+func (m *ErrorTrendMonitor) Evaluate(ctx context.Context, now time.Time) (*ErrorTrendReport, error) {
+	end := models.ErrorStatHourly.Truncate(now)
+	start := end.Add(-time.Duration(m.config.Window) * time.Hour)
+
+	series, err := m.collector.Series(ctx, models.ErrorStatHourly, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	total := make([]float64, m.config.Window)
+	byType := make(map[string][]float64)
+	for _, s := range series {
+		values, ok := byType[s.ErrorType]
+		if !ok {
+			values = make([]float64, m.config.Window)
+			byType[s.ErrorType] = values
+		}
+		for _, point := range s.Points {
+			i := int(point.Time.Sub(start) / time.Hour)
+			if i < 0 || i >= m.config.Window {
+				continue
+			}
+			values[i] += float64(point.Count)
+			total[i] += float64(point.Count)
+		}
+	}
+
+	report := &ErrorTrendReport{
+		GeneratedAt: now,
+		Window:      m.config.Window,
+		Threshold:   m.config.Threshold,
+		Total:       m.analyzer.Analyze(total),
+		ByType:      []ErrorTypeTrend{},
+		Alerts:      []string{},
+	}
+	for errorType, values := range byType {
+		report.ByType = append(report.ByType, ErrorTypeTrend{ErrorType: errorType, Forecast: m.analyzer.Analyze(values)})
+	}
+	sort.Slice(report.ByType, func(i, j int) bool {
+		return report.ByType[i].Forecast.Predicted > report.ByType[j].Forecast.Predicted
+	})
+
+	if m.config.Threshold > 0 && report.Total.Predicted > m.config.Threshold {
+		report.Alerts = append(report.Alerts, fmt.Sprintf("예측 에러율 %.1f/h가 임계값 %.1f/h를 넘습니다", report.Total.Predicted, m.config.Threshold))
+		m.sendAlert(AlertLevelWarning, report.Alerts[len(report.Alerts)-1], report.Total, "")
+	}
+	if report.Total.Anomaly {
+		report.Alerts = append(report.Alerts, fmt.Sprintf("에러 수가 예측보다 %.1f 표준편차 높습니다", report.Total.Score))
+		m.sendAlert(AlertLevelError, report.Alerts[len(report.Alerts)-1], report.Total, "")
+	}
+	for _, trend := range report.ByType {
+		if trend.Forecast.Anomaly {
+			message := fmt.Sprintf("%s 에러 수가 예측보다 %.1f 표준편차 높습니다", trend.ErrorType, trend.Forecast.Score)
+			report.Alerts = append(report.Alerts, message)
+			m.sendAlert(AlertLevelWarning, message, trend.Forecast, trend.ErrorType)
+		}
+	}
+
+	m.mu.Lock()
+	m.last = report
+	m.mu.Unlock()
+	return report, nil
+}
+
+// LastReport 마지막 분석 보고서 (분석 전이면 nil)
+func (m *ErrorTrendMonitor) LastReport() *ErrorTrendReport {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.last
+}
+
+// sendAlert 알림 전송 (알림 관리자가 없으면 로그로 남김)
+func (m *ErrorTrendMonitor) sendAlert(level AlertLevel, message string, forecast TrendForecast, errorType string) {
+	context := map[string]interface{}{
+		"analyzer":  forecast.Analyzer,
+		"last":      forecast.Last,
+		"expected":  forecast.Expected,
+		"predicted": forecast.Predicted,
+		"score":     forecast.Score,
+		"threshold": m.config.Threshold,
+	}
+	if errorType != "" {
+		context["error_type"] = errorType
+	}
+
+	if m.alerts == nil {
+		m.logger.WithFields(logrus.Fields(context)).Warn(message)
+		return
+	}
+	if err := m.alerts.SendAlert(level, message, context); err != nil {
+		m.logger.WithError(err).Warn("에러 추세 알림 전송 실패")
+	}
+}
+
+// Start 주기적인 추세 분석 시작
+func (m *ErrorTrendMonitor) Start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(ctx)
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		ticker := time.NewTicker(m.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if _, err := m.Evaluate(ctx, now); err != nil {
+					m.logger.WithError(err).Warn("에러 추세 분석 실패")
+				}
+			}
+		}
+	}()
+}
+
+// Stop 주기적인 추세 분석 중지
+func (m *ErrorTrendMonitor) Stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+}
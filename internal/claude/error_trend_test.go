@@ -0,0 +1,198 @@
+package claude
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+type recordedAlert struct {
+	level   AlertLevel
+	message string
+	context map[string]interface{}
+}
+
+type alertRecorder struct {
+	mu     sync.Mutex
+	alerts []recordedAlert
+}
+
+func (r *alertRecorder) SendAlert(level AlertLevel, message string, context map[string]interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.alerts = append(r.alerts, recordedAlert{level: level, message: message, context: context})
+	return nil
+}
+
+func TestEWMAAnalyzer(t *testing.T) {
+	analyzer := &EWMAAnalyzer{Alpha: 0.3, Sensitivity: 3}
+
+	steady := []float64{5, 6, 5, 4, 5, 6, 5, 5, 4, 5}
+	forecast := analyzer.Analyze(steady)
+	assert.False(t, forecast.Anomaly)
+	assert.InDelta(t, 5, forecast.Predicted, 1)
+
+	spike := append(append([]float64{}, steady...), 40)
+	forecast = analyzer.Analyze(spike)
+	assert.True(t, forecast.Anomaly)
+	assert.Greater(t, forecast.Score, 3.0)
+	assert.Greater(t, forecast.Predicted, 5.0)
+
+	// 에러가 거의 없는 구간에서 한 건 늘어난 것은 이상이 아님
+	forecast = analyzer.Analyze([]float64{0, 0, 0, 0, 0, 1})
+	assert.False(t, forecast.Anomaly)
+
+	// 감소는 이상으로 보지 않음
+	forecast = analyzer.Analyze([]float64{40, 40, 40, 40, 0})
+	assert.False(t, forecast.Anomaly)
+
+	assert.Equal(t, TrendForecast{Analyzer: TrendAnalyzerEWMA}, analyzer.Analyze(nil))
+}
+
+func TestHoltWintersAnalyzer_Seasonal(t *testing.T) {
+	analyzer := &HoltWintersAnalyzer{Alpha: 0.3, Beta: 0.05, Gamma: 0.3, SeasonLength: 6, Sensitivity: 3}
+
+	season := []float64{2, 4, 10, 10, 4, 2}
+	var values []float64
+	for i := 0; i < 4; i++ {
+		values = append(values, season...)
+	}
+
+	// 다음 값은 주기의 첫 값
+	forecast := analyzer.Analyze(values)
+	assert.False(t, forecast.Anomaly)
+	assert.InDelta(t, 2, forecast.Predicted, 1)
+
+	// 주기의 최고점 직전에는 높은 값을 예측
+	forecast = analyzer.Analyze(values[:len(values)-4])
+	assert.InDelta(t, 10, forecast.Predicted, 1)
+
+	// 평소 낮은 시간대의 급증은 이상
+	spiked := append(append([]float64{}, values...), 20)
+	forecast = analyzer.Analyze(spiked)
+	assert.True(t, forecast.Anomaly)
+}
+
+func TestHoltWintersAnalyzer_TrendFallback(t *testing.T) {
+	analyzer := &HoltWintersAnalyzer{Alpha: 0.5, Beta: 0.5, Gamma: 0.1, SeasonLength: 24, Sensitivity: 3}
+
+	// 주기 두 번보다 짧으면 추세만 사용
+	forecast := analyzer.Analyze([]float64{1, 2, 3, 4, 5, 6})
+	assert.InDelta(t, 7, forecast.Predicted, 0.5)
+	assert.InDelta(t, 1, forecast.Slope, 0.1)
+	assert.False(t, forecast.Anomaly)
+
+	forecast = analyzer.Analyze([]float64{6, 4, 2, 0, 0})
+	assert.GreaterOrEqual(t, forecast.Predicted, 0.0)
+	assert.False(t, math.IsNaN(forecast.Predicted))
+}
+
+func TestNewTrendAnalyzer(t *testing.T) {
+	config := DefaultErrorTrendConfig()
+
+	analyzer, err := NewTrendAnalyzer(config)
+	require.NoError(t, err)
+	assert.Equal(t, TrendAnalyzerEWMA, analyzer.Name())
+
+	config.Analyzer = TrendAnalyzerHoltWinters
+	analyzer, err = NewTrendAnalyzer(config)
+	require.NoError(t, err)
+	assert.Equal(t, TrendAnalyzerHoltWinters, analyzer.Name())
+
+	config.Analyzer = "arima"
+	_, err = NewTrendAnalyzer(config)
+	assert.Error(t, err)
+
+	config = DefaultErrorTrendConfig()
+	config.Alpha = 0
+	_, err = NewTrendAnalyzer(config)
+	assert.Error(t, err)
+
+	config = DefaultErrorTrendConfig()
+	config.Sensitivity = 0
+	_, err = NewTrendAnalyzer(config)
+	assert.Error(t, err)
+}
+
+func TestErrorTrendMonitor_Evaluate(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	collector := NewErrorStatisticsCollector(store.ErrorStats(), nil, ErrorStatisticsCollectorConfig{}, logrus.New())
+
+	now := time.Date(2026, 3, 2, 12, 30, 0, 0, time.UTC)
+	network := ErrorClass{Type: NetworkError, Severity: SeverityMedium, Category: "network"}
+	timeout := ErrorClass{Type: TimeoutError, Severity: SeverityMedium, Category: "timeout"}
+	for h := 24; h >= 1; h-- {
+		hour := now.Add(-time.Duration(h) * time.Hour)
+		for i := 0; i < 2; i++ {
+			collector.RecordClass(network, hour)
+		}
+		collector.RecordClass(timeout, hour)
+	}
+	// 직전 한 시간 동안 네트워크 에러 급증
+	for i := 0; i < 30; i++ {
+		collector.RecordClass(network, now.Add(-time.Hour))
+	}
+	// 진행 중인 구간은 분석에서 제외
+	for i := 0; i < 100; i++ {
+		collector.RecordClass(timeout, now)
+	}
+
+	config := DefaultErrorTrendConfig()
+	config.Window = 24
+	config.Threshold = 5
+	alerts := &alertRecorder{}
+	monitor, err := NewErrorTrendMonitor(collector, config, alerts, logrus.New())
+	require.NoError(t, err)
+	assert.Nil(t, monitor.LastReport())
+
+	report, err := monitor.Evaluate(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, float64(33), report.Total.Last)
+	assert.True(t, report.Total.Anomaly)
+	assert.Greater(t, report.Total.Predicted, config.Threshold)
+	require.Len(t, report.ByType, 2)
+	assert.Equal(t, "network", report.ByType[0].ErrorType)
+	assert.True(t, report.ByType[0].Forecast.Anomaly)
+	assert.False(t, report.ByType[1].Forecast.Anomaly)
+	assert.Same(t, report, monitor.LastReport())
+
+	// 임계값 초과, 전체 이상, 네트워크 에러 이상
+	alerts.mu.Lock()
+	defer alerts.mu.Unlock()
+	require.Len(t, alerts.alerts, 3)
+	assert.Equal(t, AlertLevelWarning, alerts.alerts[0].level)
+	assert.Equal(t, config.Threshold, alerts.alerts[0].context["threshold"])
+	assert.Equal(t, AlertLevelError, alerts.alerts[1].level)
+	assert.Equal(t, "network", alerts.alerts[2].context["error_type"])
+	assert.Len(t, report.Alerts, 3)
+}
+
+func TestErrorTrendMonitor_NoAlertWhenQuiet(t *testing.T) {
+	store := memory.New()
+	collector := NewErrorStatisticsCollector(store.ErrorStats(), nil, ErrorStatisticsCollectorConfig{}, logrus.New())
+
+	alerts := &alertRecorder{}
+	monitor, err := NewErrorTrendMonitor(collector, DefaultErrorTrendConfig(), alerts, logrus.New())
+	require.NoError(t, err)
+
+	report, err := monitor.Evaluate(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, report.Total.Predicted)
+	assert.Empty(t, report.ByType)
+	assert.Empty(t, report.Alerts)
+	assert.Empty(t, alerts.alerts)
+
+	config := DefaultErrorTrendConfig()
+	config.Window = 1
+	_, err = NewErrorTrendMonitor(collector, config, alerts, logrus.New())
+	assert.Error(t, err)
+}
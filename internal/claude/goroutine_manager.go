@@ -213,6 +213,17 @@ type WorkerPoolManager struct {
 	taskQueue    chan TaskWrapper
 	priorityQueue chan TaskWrapper
 	
+	// 우선순위 스케줄링 (대기 태스크와 선점 이력)
+	pending         taskHeap
+	pendingMutex    sync.Mutex
+	nextSeq         uint64
+	reclaiming      map[uint64]bool // 재큐잉 선점으로 워커를 확보 중인 태스크
+	wakeCh          chan struct{}
+	preemptions     []PreemptionEvent
+	preemptionMutex sync.RWMutex
+	maxPreemptions  int
+	fairShare       *fairShareScheduler
+	
 	// 설정
 	config WorkerPoolConfig
 	
@@ -247,6 +258,13 @@ type TaskWrapper struct {
 	ResultCh  chan TaskResult
 	Ctx       context.Context
 	Cancel    context.CancelFunc
+	
+	seq          uint64  // 같은 우선순위 안에서의 제출 순서
+	preemptions  int     // 선점되어 재큐잉된 횟수
+	afterExecute func()  // 실행이 끝난 뒤 호출 (일시 중지한 태스크 재개)
+	tenant       string  // 공정 분배 테넌트 키
+	startTag     float64 // 공정 분배 가상 시작 태그
+	starved      bool    // 기아 임계값을 넘겨 먼저 배정됨
 }
 
 // TaskResult는 태스크 실행 결과입니다
@@ -284,10 +302,15 @@ type Worker struct {
 	StartTime      time.Time `json:"start_time"`
 	IdleTime       time.Duration `json:"idle_time"`
 	
-	// 현재 태스크
+	// 현재 태스크 (State와 선점 상태도 currentMutex로 보호)
 	CurrentTask    *TaskWrapper `json:"current_task,omitempty"`
 	currentMutex   sync.RWMutex
 	
+	// 선점 상태
+	preempted bool // 현재 태스크가 재큐잉 선점됨
+	paused    bool // 현재 태스크가 일시 중지 선점됨
+	borrowed  bool // 일시 중지 선점으로 최대 워커 수를 넘어 만든 임시 워커
+	
 	// 생명주기
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -320,6 +343,11 @@ type GoroutineStats struct {
 	// 큐 상태
 	QueuedTasks      int `json:"queued_tasks"`
 	PriorityTasks    int `json:"priority_tasks"`
+	PendingTasks     int `json:"pending_tasks"`
+	Preemptions      int64 `json:"preemptions"`
+	
+	// 테넌트별 현황 (공정 분배 스케줄링)
+	Tenants          map[string]TenantStats `json:"tenants,omitempty"`
 	
 	// 리소스 사용량
 	MemoryUsage      int64   `json:"memory_usage"`
@@ -352,6 +380,12 @@ type WorkerPoolConfig struct {
 	// 성능 최적화
 	EnableProfiling    bool `json:"enable_profiling"`
 	GCThreshold        int  `json:"gc_threshold"`
+	
+	// 선점 정책
+	Preemption         PreemptionConfig `json:"preemption"`
+	
+	// 테넌트 간 공정 분배
+	FairShare          FairShareConfig `json:"fair_share"`
 }
 
 // WorkerScaler는 워커 자동 스케일링을 담당합니다
@@ -451,6 +485,8 @@ func DefaultWorkerPoolConfig() WorkerPoolConfig {
 		HealthCheckInterval: 30 * time.Second,
 		EnableProfiling:     false,
 		GCThreshold:         1000,
+		Preemption:          DefaultPreemptionConfig(),
+		FairShare:           DefaultFairShareConfig(),
 	}
 }
 
@@ -882,6 +918,10 @@ func NewWorkerPoolManager(config WorkerPoolConfig) *WorkerPoolManager {
 		workers:       make(map[int]*Worker),
 		taskQueue:     make(chan TaskWrapper, config.QueueSize),
 		priorityQueue: make(chan TaskWrapper, config.PriorityQueueSize),
+		reclaiming:    make(map[uint64]bool),
+		wakeCh:        make(chan struct{}, 1),
+		maxPreemptions: 100,
+		fairShare:     newFairShareScheduler(config.FairShare),
 		config:        config,
 		ctx:           ctx,
 		cancel:        cancel,
@@ -1025,7 +1065,7 @@ func (wpm *WorkerPoolManager) GetActiveGoroutines() int {
 	
 	active := 0
 	for _, worker := range wpm.workers {
-		if worker.State == WorkerStateBusy {
+		if worker.getState() == WorkerStateBusy {
 			active++
 		}
 	}
@@ -1040,6 +1080,7 @@ func (wpm *WorkerPoolManager) GetGoroutineStats() GoroutineStats {
 	
 	stats := wpm.stats
 	stats.LastUpdate = time.Now()
+	stats.Tenants = wpm.fairShare.snapshot()
 	
 	return stats
 }
@@ -1053,7 +1094,7 @@ func (wpm *WorkerPoolManager) CleanupIdleWorkers() error {
 	var toRemove []int
 	
 	for id, worker := range wpm.workers {
-		if worker.State == WorkerStateIdle {
+		if worker.getState() == WorkerStateIdle {
 			idleTime := now.Sub(worker.LastTaskTime)
 			if idleTime > wpm.config.IdleTimeout {
 				toRemove = append(toRemove, id)
@@ -1116,21 +1157,7 @@ func (wpm *WorkerPoolManager) createWorker() error {
 		return fmt.Errorf("maximum worker limit reached")
 	}
 	
-	workerID := int(atomic.AddInt32(&wpm.nextWorkerID, 1))
-	ctx, cancel := context.WithCancel(wpm.ctx)
-	
-	worker := &Worker{
-		ID:        workerID,
-		State:     WorkerStateIdle,
-		TaskQueue: make(chan TaskWrapper, 1),
-		QuitChan:  make(chan bool, 1),
-		Pool:      wpm,
-		StartTime: time.Now(),
-		ctx:       ctx,
-		cancel:    cancel,
-	}
-	
-	wpm.workers[workerID] = worker
+	worker := wpm.newWorkerLocked()
 	
 	// 워커 고루틴 시작
 	go worker.Run()
@@ -1144,7 +1171,7 @@ func (wpm *WorkerPoolManager) removeWorker() error {
 	
 	// 유휴 상태인 워커 찾기
 	for id, worker := range wpm.workers {
-		if worker.State == WorkerStateIdle {
+		if worker.getState() == WorkerStateIdle {
 			worker.Stop()
 			delete(wpm.workers, id)
 			return nil
@@ -1162,63 +1189,14 @@ func (wpm *WorkerPoolManager) taskDispatcher() {
 		case <-wpm.ctx.Done():
 			return
 		case task := <-wpm.priorityQueue:
-			wpm.assignTask(task)
+			wpm.enqueuePending(task)
 		case task := <-wpm.taskQueue:
-			wpm.assignTask(task)
+			wpm.enqueuePending(task)
+		case <-wpm.wakeCh:
 		}
-	}
-}
-
-func (wpm *WorkerPoolManager) assignTask(task TaskWrapper) {
-	wpm.workersMutex.RLock()
-	
-	// 유휴 워커 찾기
-	var idleWorker *Worker
-	for _, worker := range wpm.workers {
-		if worker.State == WorkerStateIdle {
-			idleWorker = worker
-			break
-		}
-	}
-	wpm.workersMutex.RUnlock()
-	
-	if idleWorker == nil {
-		// 유휴 워커가 없으면 새 워커 생성 시도
-		if err := wpm.createWorker(); err == nil {
-			wpm.workersMutex.RLock()
-			for _, worker := range wpm.workers {
-				if worker.State == WorkerStateIdle {
-					idleWorker = worker
-					break
-				}
-			}
-			wpm.workersMutex.RUnlock()
-		}
-	}
-	
-	if idleWorker != nil {
-		select {
-		case idleWorker.TaskQueue <- task:
-			// 태스크 할당 성공
-		default:
-			// 워커가 바쁘면 다시 큐에 추가
-			go func() {
-				if task.Task.GetPriority() >= TaskPriorityHigh {
-					wpm.priorityQueue <- task
-				} else {
-					wpm.taskQueue <- task
-				}
-			}()
-		}
-	} else {
-		// 모든 워커가 바쁘면 다시 큐에 추가
-		go func() {
-			if task.Task.GetPriority() >= TaskPriorityHigh {
-				wpm.priorityQueue <- task
-			} else {
-				wpm.taskQueue <- task
-			}
-		}()
+		
+		// 대기 태스크를 우선순위 순서로 배정하고, 풀이 포화되면 선점
+		wpm.dispatchPending()
 	}
 }
 
@@ -1247,7 +1225,7 @@ func (wpm *WorkerPoolManager) updateStatistics() {
 	var totalLifetime time.Duration
 	
 	for _, worker := range wpm.workers {
-		switch worker.State {
+		switch worker.getState() {
 		case WorkerStateBusy:
 			activeWorkers++
 		case WorkerStateIdle:
@@ -1269,6 +1247,7 @@ func (wpm *WorkerPoolManager) updateStatistics() {
 	wpm.stats.Failed = totalFailed
 	wpm.stats.QueuedTasks = len(wpm.taskQueue)
 	wpm.stats.PriorityTasks = len(wpm.priorityQueue)
+	wpm.stats.PendingTasks = wpm.pendingCount()
 	wpm.stats.GoroutineCount = runtime.NumGoroutine()
 	wpm.stats.LastUpdate = time.Now()
 	
@@ -1317,7 +1296,7 @@ func (wpm *WorkerPoolManager) performHealthCheck() {
 // Run은 워커를 실행합니다
 func (w *Worker) Run() {
 	defer func() {
-		w.State = WorkerStateStopped
+		w.setState(WorkerStateStopped)
 		if r := recover(); r != nil {
 			// 패닉 복구
 			fmt.Printf("Worker %d panicked: %v\n", w.ID, r)
@@ -1325,8 +1304,9 @@ func (w *Worker) Run() {
 	}()
 	
 	for {
-		w.State = WorkerStateIdle
+		w.setState(WorkerStateIdle)
 		w.LastTaskTime = time.Now()
+		w.Pool.wake()
 		
 		select {
 		case <-w.ctx.Done():
@@ -1335,15 +1315,21 @@ func (w *Worker) Run() {
 			return
 		case task := <-w.TaskQueue:
 			w.executeTask(task)
+			
+			// 임시 워커는 선점한 태스크 하나만 실행하고 종료
+			if w.borrowed {
+				w.Pool.retireWorker(w)
+				return
+			}
 		}
 	}
 }
 
 func (w *Worker) executeTask(wrapper TaskWrapper) {
-	w.State = WorkerStateBusy
 	w.TasksProcessed++
 	
 	w.currentMutex.Lock()
+	w.State = WorkerStateBusy
 	w.CurrentTask = &wrapper
 	w.currentMutex.Unlock()
 	
@@ -1352,6 +1338,24 @@ func (w *Worker) executeTask(wrapper TaskWrapper) {
 	endTime := time.Now()
 	duration := endTime.Sub(startTime)
 	
+	if wrapper.afterExecute != nil {
+		wrapper.afterExecute()
+	}
+	
+	// 재큐잉 선점으로 중단된 태스크는 실패로 집계하지 않고 다시 대기열에 넣음
+	w.currentMutex.Lock()
+	preempted := w.preempted
+	w.preempted = false
+	w.CurrentTask = nil
+	w.currentMutex.Unlock()
+	if preempted && err != nil {
+		wrapper.Cancel()
+		w.Pool.requeuePreempted(wrapper)
+		w.LastTaskTime = time.Now()
+		return
+	}
+	w.Pool.fairShare.finished(wrapper, err)
+	
 	result := TaskResult{
 		Success:   err == nil,
 		Error:     err,
@@ -1376,28 +1380,40 @@ func (w *Worker) executeTask(wrapper TaskWrapper) {
 	// 컨텍스트 정리
 	wrapper.Cancel()
 	
-	w.currentMutex.Lock()
-	w.CurrentTask = nil
-	w.currentMutex.Unlock()
-	
 	w.LastTaskTime = time.Now()
 }
 
 // Stop은 워커를 중지합니다
 func (w *Worker) Stop() {
-	if w.State != WorkerStateStopped {
-		w.State = WorkerStateStopping
-		
-		// 현재 실행 중인 태스크 취소
-		w.currentMutex.RLock()
-		if w.CurrentTask != nil {
-			w.CurrentTask.Cancel()
-		}
-		w.currentMutex.RUnlock()
-		
-		w.cancel()
-		close(w.QuitChan)
+	w.currentMutex.Lock()
+	if w.State == WorkerStateStopped {
+		w.currentMutex.Unlock()
+		return
+	}
+	w.State = WorkerStateStopping
+	
+	// 현재 실행 중인 태스크 취소
+	if w.CurrentTask != nil {
+		w.CurrentTask.Cancel()
 	}
+	w.currentMutex.Unlock()
+	
+	w.cancel()
+	close(w.QuitChan)
+}
+
+// getState는 워커 상태를 반환합니다
+func (w *Worker) getState() WorkerState {
+	w.currentMutex.RLock()
+	defer w.currentMutex.RUnlock()
+	return w.State
+}
+
+// setState는 워커 상태를 변경합니다
+func (w *Worker) setState(state WorkerState) {
+	w.currentMutex.Lock()
+	w.State = state
+	w.currentMutex.Unlock()
 }
 
 // NewWorkerScaler는 새로운 워커 스케일러를 생성합니다
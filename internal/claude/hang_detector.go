@@ -0,0 +1,166 @@
+package claude
+
+import (
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// ErrTypeHangDetected 출력과 하트비트가 없는 멈춘 프로세스
+const ErrTypeHangDetected = "HANG_DETECTED"
+
+// maxGoroutineStackSize 진단 정보에 담을 고루틴 스택 최대 크기
+const maxGoroutineStackSize = 1 << 20
+
+// HangPolicy 멈춘 프로세스 감지 정책
+// 출력이 없고 하트비트(CPU 사용 시간 증가 또는 RecordActivity 호출)도 없는 상태가
+// Timeout 이상 이어지면 멈춘 것으로 판단합니다.
+type HangPolicy struct {
+	// Timeout 멈춤으로 판단할 무활동 시간 (0이면 감지하지 않음)
+	Timeout time.Duration `yaml:"timeout" json:"timeout"`
+	// CheckInterval 활동 확인 주기 (0이면 Timeout의 1/4)
+	CheckInterval time.Duration `yaml:"check_interval" json:"check_interval"`
+	// AutoKill 멈춤 감지 시 프로세스 강제 종료 (재시작 정책이 있으면 재시작)
+	AutoKill bool `yaml:"auto_kill" json:"auto_kill"`
+}
+
+// Validate 정책 값 검증
+func (p *HangPolicy) Validate() error {
+	if p.Timeout < 0 || p.CheckInterval < 0 {
+		return errors.New("hang durations must not be negative")
+	}
+	if p.CheckInterval > 0 && p.Timeout > 0 && p.CheckInterval > p.Timeout {
+		return errors.New("hang check_interval must not exceed timeout")
+	}
+	return nil
+}
+
+// interval 활동 확인 주기
+func (p *HangPolicy) interval() time.Duration {
+	if p.CheckInterval > 0 {
+		return p.CheckInterval
+	}
+	return p.Timeout / 4
+}
+
+// ActivityRecorder 프로세스 밖에서 관찰한 활동을 하트비트로 기록합니다
+// 프로세스 입출력을 직접 읽는 호출자가 진행 상황을 알릴 때 사용합니다.
+type ActivityRecorder interface {
+	RecordActivity()
+}
+
+// ActivityRecorder 인터페이스 구현 확인
+var _ ActivityRecorder = (*claudeProcessManager)(nil)
+
+// HangDiagnostics 멈춘 프로세스 진단 정보
+type HangDiagnostics struct {
+	PID           int               `json:"pid"`
+	IdleFor       time.Duration     `json:"idle_for"`
+	LastOutput    time.Time         `json:"last_output"`
+	LastHeartbeat time.Time         `json:"last_heartbeat"`
+	CPUTicks      uint64            `json:"cpu_ticks"`
+	ProcStatus    map[string]string `json:"proc_status,omitempty"`  // /proc/<pid>/status
+	WaitChannel   string            `json:"wait_channel,omitempty"` // /proc/<pid>/wchan
+	KernelStack   string            `json:"kernel_stack,omitempty"` // /proc/<pid>/stack (권한이 있을 때만)
+	ProcError     string            `json:"proc_error,omitempty"`
+	Goroutines    string            `json:"goroutines"` // 서버 고루틴 스택
+	CapturedAt    time.Time         `json:"captured_at"`
+}
+
+// activityWriter 프로세스 출력을 next로 넘기면서 마지막 출력 시각을 기록 (next가 nil이면 버림)
+type activityWriter struct {
+	monitor *activityMonitor
+	next    io.Writer
+}
+
+func (w activityWriter) Write(p []byte) (int, error) {
+	w.monitor.output()
+	if w.next == nil {
+		return len(p), nil
+	}
+	return w.next.Write(p)
+}
+
+// withActivity next에 쓰는 출력의 시각을 monitor에 기록하는 Writer
+func withActivity(next io.Writer, monitor *activityMonitor) io.Writer {
+	return activityWriter{monitor: monitor, next: next}
+}
+
+// activityMonitor 실행 하나의 출력과 하트비트 시각
+type activityMonitor struct {
+	mu            sync.Mutex
+	lastOutput    time.Time
+	lastHeartbeat time.Time
+	cpuTicks      uint64
+	reported      bool
+}
+
+func newActivityMonitor(now time.Time) *activityMonitor {
+	return &activityMonitor{lastOutput: now, lastHeartbeat: now}
+}
+
+func (m *activityMonitor) output() {
+	m.mu.Lock()
+	m.lastOutput = time.Now()
+	m.reported = false
+	m.mu.Unlock()
+}
+
+func (m *activityMonitor) heartbeat() {
+	m.mu.Lock()
+	m.lastHeartbeat = time.Now()
+	m.reported = false
+	m.mu.Unlock()
+}
+
+// check CPU 사용 시간을 반영한 뒤 멈춤 여부 확인
+// 한 번 보고한 멈춤은 활동이 다시 생길 때까지 다시 보고하지 않습니다.
+func (m *activityMonitor) check(ticks uint64, ticksOK bool, timeout time.Duration, now time.Time) (*HangDiagnostics, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ticksOK && ticks != m.cpuTicks {
+		m.cpuTicks = ticks
+		m.lastHeartbeat = now
+		m.reported = false
+	}
+
+	last := m.lastOutput
+	if m.lastHeartbeat.After(last) {
+		last = m.lastHeartbeat
+	}
+	idle := now.Sub(last)
+	if m.reported || idle < timeout {
+		return nil, false
+	}
+	m.reported = true
+
+	return &HangDiagnostics{
+		IdleFor:       idle,
+		LastOutput:    m.lastOutput,
+		LastHeartbeat: m.lastHeartbeat,
+		CPUTicks:      m.cpuTicks,
+	}, true
+}
+
+// captureHangDiagnostics 자식 프로세스의 /proc 상태와 서버 고루틴 스택 수집
+func captureHangDiagnostics(pid int, diag *HangDiagnostics) *HangDiagnostics {
+	diag.PID = pid
+	diag.CapturedAt = time.Now()
+	if err := captureProcState(pid, diag); err != nil {
+		diag.ProcError = err.Error()
+	}
+
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) || len(buf) >= maxGoroutineStackSize {
+			diag.Goroutines = string(buf[:n])
+			break
+		}
+		buf = make([]byte, len(buf)*2)
+	}
+	return diag
+}
@@ -0,0 +1,106 @@
+package claude
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/logging"
+)
+
+func TestActivityMonitor_Check(t *testing.T) {
+	start := time.Now()
+	monitor := newActivityMonitor(start)
+
+	_, hung := monitor.check(0, false, time.Minute, start.Add(30*time.Second))
+	assert.False(t, hung)
+
+	// CPU 사용 시간이 늘면 하트비트로 봄
+	_, hung = monitor.check(5, true, time.Minute, start.Add(50*time.Second))
+	assert.False(t, hung)
+	_, hung = monitor.check(5, true, time.Minute, start.Add(100*time.Second))
+	assert.False(t, hung)
+
+	diag, hung := monitor.check(5, true, time.Minute, start.Add(111*time.Second))
+	require.True(t, hung)
+	assert.Equal(t, 61*time.Second, diag.IdleFor)
+	assert.Equal(t, uint64(5), diag.CPUTicks)
+
+	// 같은 멈춤은 한 번만 보고
+	_, hung = monitor.check(5, true, time.Minute, start.Add(200*time.Second))
+	assert.False(t, hung)
+
+	// 출력이 생기면 다시 감시
+	monitor.output()
+	_, hung = monitor.check(5, true, time.Minute, time.Now().Add(2*time.Minute))
+	assert.True(t, hung)
+}
+
+func TestHangPolicy_Validate(t *testing.T) {
+	assert.NoError(t, (&HangPolicy{Timeout: time.Minute}).Validate())
+	assert.Error(t, (&HangPolicy{Timeout: -time.Second}).Validate())
+	assert.Error(t, (&HangPolicy{Timeout: time.Second, CheckInterval: time.Minute}).Validate())
+	assert.Equal(t, 15*time.Second, (&HangPolicy{Timeout: time.Minute}).interval())
+}
+
+func TestProcessManager_HangDetection(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sleep이 필요합니다")
+	}
+
+	recorder := &processEventRecorder{}
+	pm := NewProcessManager(logging.FromLogrus(logrus.New()))
+	err := pm.Start(context.Background(), &ProcessConfig{
+		Command:       "sleep",
+		Args:          []string{"30"},
+		HangPolicy:    &HangPolicy{Timeout: 200 * time.Millisecond, CheckInterval: 20 * time.Millisecond, AutoKill: true},
+		EventListener: recorder.record,
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return recorder.count(ProcessEventCrashed) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, recorder.count(ProcessEventHung))
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for _, event := range recorder.events {
+		if event.Type != ProcessEventHung {
+			continue
+		}
+		require.NotNil(t, event.Diagnostics)
+		assert.Contains(t, event.Error, ErrTypeHangDetected)
+		assert.NotEmpty(t, event.Diagnostics.Goroutines)
+		assert.GreaterOrEqual(t, event.Diagnostics.IdleFor, 200*time.Millisecond)
+		if runtime.GOOS == "linux" {
+			assert.Equal(t, "sleep", event.Diagnostics.ProcStatus["Name"])
+		}
+	}
+}
+
+func TestProcessManager_HangDetectionIgnoresActiveOutput(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sh가 필요합니다")
+	}
+
+	recorder := &processEventRecorder{}
+	pm := NewProcessManager(logging.FromLogrus(logrus.New()))
+	err := pm.Start(context.Background(), &ProcessConfig{
+		Command:       "sh",
+		Args:          []string{"-c", "while true; do echo tick; sleep 0.05; done"},
+		HangPolicy:    &HangPolicy{Timeout: 300 * time.Millisecond, CheckInterval: 20 * time.Millisecond, AutoKill: true},
+		EventListener: recorder.record,
+	})
+	require.NoError(t, err)
+	defer pm.Kill()
+
+	time.Sleep(time.Second)
+	assert.Equal(t, 0, recorder.count(ProcessEventHung))
+	assert.True(t, pm.IsRunning())
+}
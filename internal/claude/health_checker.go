@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // HealthStatus 헬스체크 상태
@@ -59,7 +61,7 @@ type healthChecker struct {
 	handlers    []HealthHandler
 	stopCh      chan struct{}
 	stopped     bool
-	logger      *logrus.Logger
+	logger      logging.Logger
 	checkFunc   HealthCheckFunc
 }
 
@@ -67,9 +69,9 @@ type healthChecker struct {
 type HealthCheckFunc func(ctx context.Context, process ProcessManager) error
 
 // NewHealthChecker 새로운 헬스체커를 생성합니다
-func NewHealthChecker(logger *logrus.Logger) HealthChecker {
+func NewHealthChecker(logger logging.Logger) HealthChecker {
 	if logger == nil {
-		logger = logrus.StandardLogger()
+		logger = logging.FromLogrus(logrus.StandardLogger())
 	}
 	
 	return &healthChecker{
@@ -7,13 +7,15 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 
 func TestNewHealthChecker(t *testing.T) {
 	t.Run("with logger", func(t *testing.T) {
 		logger := logrus.New()
-		hc := NewHealthChecker(logger)
+		hc := NewHealthChecker(logging.FromLogrus(logger))
 		assert.NotNil(t, hc)
 		
 		status := hc.GetHealthStatus()
@@ -32,7 +34,7 @@ func TestHealthChecker_CheckHealth(t *testing.T) {
 	logger.SetLevel(logrus.DebugLevel)
 
 	t.Run("healthy process", func(t *testing.T) {
-		hc := NewHealthChecker(logger)
+		hc := NewHealthChecker(logging.FromLogrus(logger))
 		mockPM := new(MockProcessManager)
 		
 		mockPM.On("IsRunning").Return(true)
@@ -50,7 +52,7 @@ func TestHealthChecker_CheckHealth(t *testing.T) {
 	})
 
 	t.Run("process not running", func(t *testing.T) {
-		hc := NewHealthChecker(logger)
+		hc := NewHealthChecker(logging.FromLogrus(logger))
 		mockPM := new(MockProcessManager)
 		
 		mockPM.On("IsRunning").Return(false)
@@ -67,7 +69,7 @@ func TestHealthChecker_CheckHealth(t *testing.T) {
 	})
 
 	t.Run("health check failed", func(t *testing.T) {
-		hc := NewHealthChecker(logger)
+		hc := NewHealthChecker(logging.FromLogrus(logger))
 		mockPM := new(MockProcessManager)
 		
 		mockPM.On("IsRunning").Return(true)
@@ -88,7 +90,7 @@ func TestHealthChecker_RegisterHandler(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
 	
-	hc := NewHealthChecker(logger)
+	hc := NewHealthChecker(logging.FromLogrus(logger))
 	mockPM := new(MockProcessManager)
 	
 	handlerCalled := false
@@ -118,7 +120,7 @@ func TestHealthChecker_StartStop(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
 	
-	hc := NewHealthChecker(logger)
+	hc := NewHealthChecker(logging.FromLogrus(logger))
 	mockPM := new(MockProcessManager)
 	
 	// 헬스체크가 여러 번 호출될 것을 예상
@@ -145,7 +147,7 @@ func TestHealthChecker_MultipleHandlers(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
 	
-	hc := NewHealthChecker(logger)
+	hc := NewHealthChecker(logging.FromLogrus(logger))
 	mockPM := new(MockProcessManager)
 	
 	handler1Called := false
@@ -178,7 +180,7 @@ func TestHealthStatus_Transitions(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
 	
-	hc := NewHealthChecker(logger)
+	hc := NewHealthChecker(logging.FromLogrus(logger))
 	mockPM := new(MockProcessManager)
 	
 	var statusHistory []bool
@@ -0,0 +1,409 @@
+package claude
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RenderMetadataKey는 렌더링 결과를 담는 메시지 메타데이터 키입니다.
+const RenderMetadataKey = "render"
+
+// LineKind는 렌더링된 줄의 출처 분류입니다.
+type LineKind string
+
+const (
+	// LineKindAssistant 어시스턴트 응답 텍스트
+	LineKindAssistant LineKind = "assistant"
+	// LineKindToolUse 도구 호출
+	LineKindToolUse LineKind = "tool_use"
+	// LineKindToolOutput 도구 실행 출력
+	LineKindToolOutput LineKind = "tool_output"
+	// LineKindError 에러 메시지 또는 에러로 보이는 출력 줄
+	LineKindError LineKind = "error"
+	// LineKindSystem 시스템/상태 메시지
+	LineKindSystem LineKind = "system"
+)
+
+// RenderedSpan은 같은 스타일이 적용된 텍스트 조각입니다.
+// 색상은 16색이면 이름(red, bright_blue 등), 그 외에는 #rrggbb 형식입니다.
+type RenderedSpan struct {
+	Text      string `json:"text"`
+	FG        string `json:"fg,omitempty"`
+	BG        string `json:"bg,omitempty"`
+	Bold      bool   `json:"bold,omitempty"`
+	Dim       bool   `json:"dim,omitempty"`
+	Italic    bool   `json:"italic,omitempty"`
+	Underline bool   `json:"underline,omitempty"`
+	Inverse   bool   `json:"inverse,omitempty"`
+}
+
+// RenderedLine은 분류와 스타일 조각으로 구성된 한 줄입니다.
+type RenderedLine struct {
+	Kind  LineKind       `json:"kind"`
+	Spans []RenderedSpan `json:"spans"`
+}
+
+// ansiStyle은 SGR 시퀀스로 누적되는 현재 스타일입니다.
+type ansiStyle struct {
+	fg, bg                                string
+	bold, dim, italic, underline, inverse bool
+}
+
+// ansiColorNames는 SGR 30-37/90-97 색상 이름입니다.
+var ansiColorNames = [8]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// errorLinePrefixes는 도구 출력에서 에러 줄로 분류할 접두어입니다 (소문자 비교).
+var errorLinePrefixes = []string{"error:", "error[", "error ", "fatal:", "panic:", "traceback (most recent call last)", "exception:"}
+
+// RenderMessage는 메시지 타입과 내용을 받아 ANSI 시퀀스를 해석한 줄 목록을 만듭니다.
+// 도구 출력 중 에러로 보이는 줄은 error로 분류합니다. 내용이 비어 있으면 nil을 반환합니다.
+func RenderMessage(messageType, content string) []RenderedLine {
+	if content == "" {
+		return nil
+	}
+
+	kind := classifyMessage(messageType)
+	spans := ParseANSI(content)
+	lines := make([]RenderedLine, len(spans))
+	for i, lineSpans := range spans {
+		lineKind := kind
+		if kind == LineKindToolOutput && isErrorLine(lineSpans) {
+			lineKind = LineKindError
+		}
+		lines[i] = RenderedLine{Kind: lineKind, Spans: lineSpans}
+	}
+	return lines
+}
+
+// RenderStderr는 프로세스 stderr 출력을 에러 줄로 렌더링합니다.
+func RenderStderr(content string) []RenderedLine {
+	return RenderMessage(string(MessageTypeError), content)
+}
+
+// StripANSI는 ANSI 이스케이프 시퀀스를 제거한 텍스트를 반환합니다.
+func StripANSI(content string) string {
+	var b strings.Builder
+	for i, line := range ParseANSI(content) {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		for _, span := range line {
+			b.WriteString(span.Text)
+		}
+	}
+	return b.String()
+}
+
+// ParseANSI는 텍스트를 줄 단위로 나누고 각 줄을 스타일 조각으로 변환합니다.
+// SGR(색상/굵게 등)만 해석하고 커서 이동 등 나머지 CSI/OSC 시퀀스는 버립니다.
+// 스타일은 줄바꿈을 넘어 유지되며, 단독 \r은 터미널처럼 해당 줄을 다시 씁니다.
+func ParseANSI(content string) [][]RenderedSpan {
+	var (
+		lines   [][]RenderedSpan
+		current []RenderedSpan
+		text    strings.Builder
+		style   ansiStyle
+	)
+
+	flush := func() {
+		if text.Len() == 0 {
+			return
+		}
+		current = appendSpan(current, style.span(text.String()))
+		text.Reset()
+	}
+
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == '\x1b':
+			next, params, final := scanEscape(content, i)
+			if final == 'm' {
+				flush()
+				style.apply(params)
+			}
+			i = next
+			continue
+		case c == '\n':
+			flush()
+			lines = append(lines, current)
+			current = nil
+		case c == '\r':
+			if i+1 < len(content) && content[i+1] == '\n' {
+				break
+			}
+			text.Reset()
+			current = nil
+		case c == '\t':
+			text.WriteByte(c)
+		case c < 0x20 || c == 0x7f:
+			// 그 외 제어 문자는 표시하지 않음
+		default:
+			text.WriteByte(c)
+		}
+		i++
+	}
+
+	flush()
+	if len(current) > 0 || len(lines) == 0 {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// scanEscape는 i 위치의 ESC 시퀀스를 읽어 다음 위치, CSI 파라미터, 종료 문자를 반환합니다.
+// CSI가 아니면 종료 문자는 0입니다.
+func scanEscape(content string, i int) (int, string, byte) {
+	if i+1 >= len(content) {
+		return len(content), "", 0
+	}
+
+	switch content[i+1] {
+	case '[':
+		// CSI: 파라미터(0x30-0x3f), 중간 문자(0x20-0x2f), 종료 문자(0x40-0x7e)
+		j := i + 2
+		for j < len(content) && content[j] >= 0x20 && content[j] <= 0x3f {
+			j++
+		}
+		if j >= len(content) {
+			return len(content), "", 0
+		}
+		return j + 1, content[i+2 : j], content[j]
+	case ']', 'P', '_', '^':
+		// OSC/DCS 등: BEL 또는 ST(ESC \)까지 무시
+		for j := i + 2; j < len(content); j++ {
+			if content[j] == '\a' {
+				return j + 1, "", 0
+			}
+			if content[j] == '\x1b' && j+1 < len(content) && content[j+1] == '\\' {
+				return j + 2, "", 0
+			}
+		}
+		return len(content), "", 0
+	default:
+		return i + 2, "", 0
+	}
+}
+
+// apply는 SGR 파라미터를 현재 스타일에 반영합니다.
+func (s *ansiStyle) apply(params string) {
+	if params == "" {
+		*s = ansiStyle{}
+		return
+	}
+
+	codes := strings.Split(strings.ReplaceAll(params, ":", ";"), ";")
+	for i := 0; i < len(codes); i++ {
+		code, err := strconv.Atoi(codes[i])
+		if err != nil {
+			if codes[i] != "" {
+				continue
+			}
+			code = 0
+		}
+
+		switch {
+		case code == 0:
+			*s = ansiStyle{}
+		case code == 1:
+			s.bold = true
+		case code == 2:
+			s.dim = true
+		case code == 3:
+			s.italic = true
+		case code == 4:
+			s.underline = true
+		case code == 7:
+			s.inverse = true
+		case code == 22:
+			s.bold, s.dim = false, false
+		case code == 23:
+			s.italic = false
+		case code == 24:
+			s.underline = false
+		case code == 27:
+			s.inverse = false
+		case code >= 30 && code <= 37:
+			s.fg = ansiColorNames[code-30]
+		case code == 38:
+			var color string
+			color, i = extendedColor(codes, i)
+			s.fg = color
+		case code == 39:
+			s.fg = ""
+		case code >= 40 && code <= 47:
+			s.bg = ansiColorNames[code-40]
+		case code == 48:
+			var color string
+			color, i = extendedColor(codes, i)
+			s.bg = color
+		case code == 49:
+			s.bg = ""
+		case code >= 90 && code <= 97:
+			s.fg = "bright_" + ansiColorNames[code-90]
+		case code >= 100 && code <= 107:
+			s.bg = "bright_" + ansiColorNames[code-100]
+		}
+	}
+}
+
+// extendedColor는 38/48 뒤의 5;n 또는 2;r;g;b 색상을 읽고 마지막으로 소비한 인덱스를 반환합니다.
+func extendedColor(codes []string, i int) (string, int) {
+	if i+1 >= len(codes) {
+		return "", i
+	}
+
+	switch codes[i+1] {
+	case "5":
+		if i+2 >= len(codes) {
+			return "", len(codes)
+		}
+		n, err := strconv.Atoi(codes[i+2])
+		if err != nil || n < 0 || n > 255 {
+			return "", i + 2
+		}
+		return paletteColor(n), i + 2
+	case "2":
+		if i+4 >= len(codes) {
+			return "", len(codes)
+		}
+		rgb := [3]int{}
+		for k := range rgb {
+			v, err := strconv.Atoi(codes[i+2+k])
+			if err != nil || v < 0 || v > 255 {
+				return "", i + 4
+			}
+			rgb[k] = v
+		}
+		return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2]), i + 4
+	default:
+		return "", i + 1
+	}
+}
+
+// paletteColor는 256색 팔레트 번호를 색상 문자열로 변환합니다.
+// 0-15는 테마를 따르도록 이름으로, 나머지는 xterm 기본값의 #rrggbb로 반환합니다.
+func paletteColor(n int) string {
+	switch {
+	case n < 8:
+		return ansiColorNames[n]
+	case n < 16:
+		return "bright_" + ansiColorNames[n-8]
+	case n < 232:
+		n -= 16
+		level := func(v int) int {
+			if v == 0 {
+				return 0
+			}
+			return 55 + v*40
+		}
+		return fmt.Sprintf("#%02x%02x%02x", level(n/36), level(n/6%6), level(n%6))
+	default:
+		gray := 8 + (n-232)*10
+		return fmt.Sprintf("#%02x%02x%02x", gray, gray, gray)
+	}
+}
+
+// span은 현재 스타일로 텍스트 조각을 만듭니다.
+func (s ansiStyle) span(text string) RenderedSpan {
+	return RenderedSpan{
+		Text:      text,
+		FG:        s.fg,
+		BG:        s.bg,
+		Bold:      s.bold,
+		Dim:       s.dim,
+		Italic:    s.italic,
+		Underline: s.underline,
+		Inverse:   s.inverse,
+	}
+}
+
+// appendSpan은 직전 조각과 스타일이 같으면 합쳐서 추가합니다.
+func appendSpan(spans []RenderedSpan, span RenderedSpan) []RenderedSpan {
+	if n := len(spans); n > 0 {
+		last := spans[n-1]
+		last.Text = span.Text
+		if last == span {
+			spans[n-1].Text += span.Text
+			return spans
+		}
+	}
+	return append(spans, span)
+}
+
+// MessageKind는 메시지 타입의 기본 줄 분류를 반환합니다 (줄 단위 에러 분류는 하지 않음).
+func MessageKind(messageType string) LineKind {
+	return classifyMessage(messageType)
+}
+
+// classifyMessage는 메시지 타입으로 기본 줄 분류를 정합니다.
+func classifyMessage(messageType string) LineKind {
+	switch messageType {
+	case "", string(MessageTypeText), "assistant", "thinking":
+		return LineKindAssistant
+	case string(MessageTypeToolUse):
+		return LineKindToolUse
+	case "tool_result", "tool_output", "stdout", "output":
+		return LineKindToolOutput
+	case string(MessageTypeError), "stderr":
+		return LineKindError
+	default:
+		return LineKindSystem
+	}
+}
+
+// isErrorLine은 도구 출력 줄이 에러 메시지처럼 보이는지 판단합니다.
+// 에러 접두어로 시작하거나 보이는 글자가 모두 빨간색이면 에러 줄로 봅니다.
+func isErrorLine(spans []RenderedSpan) bool {
+	var b strings.Builder
+	red := false
+	for _, span := range spans {
+		b.WriteString(span.Text)
+		if strings.TrimSpace(span.Text) == "" {
+			continue
+		}
+		if span.FG != "red" && span.FG != "bright_red" {
+			return hasErrorPrefix(b.String())
+		}
+		red = true
+	}
+	return red || hasErrorPrefix(b.String())
+}
+
+// hasErrorPrefix는 줄이 에러 접두어로 시작하는지 확인합니다.
+func hasErrorPrefix(text string) bool {
+	line := strings.ToLower(strings.TrimSpace(text))
+	for _, prefix := range errorLinePrefixes {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderResponse는 응답 메타데이터에 렌더링 결과를 추가합니다.
+// 파서가 에러를 채운 응답은 내용과 관계없이 에러 줄로 분류합니다.
+func renderResponse(response *Response) {
+	messageType := response.Type
+	if response.Error != nil {
+		messageType = string(MessageTypeError)
+	}
+	lines := RenderMessage(messageType, response.Content)
+	if lines == nil {
+		return
+	}
+	if response.Metadata == nil {
+		response.Metadata = make(map[string]interface{})
+	}
+	response.Metadata[RenderMetadataKey] = lines
+}
+
+// RenderedLines는 메타데이터에 스트림 핸들러가 남긴 렌더링 결과가 있으면 재사용하고,
+// 없으면 메시지 타입과 내용으로 새로 렌더링합니다.
+func RenderedLines(messageType, content string, meta map[string]interface{}) []RenderedLine {
+	if lines, ok := meta[RenderMetadataKey].([]RenderedLine); ok {
+		return lines
+	}
+	return RenderMessage(messageType, content)
+}
@@ -0,0 +1,76 @@
+package claude
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseANSI_Styles(t *testing.T) {
+	lines := ParseANSI("\x1b[1;31mFAIL\x1b[0m pkg\n\x1b[38;5;208morange\x1b[39m \x1b[48;2;16;32;48mbg\x1b[m")
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, []RenderedSpan{
+		{Text: "FAIL", FG: "red", Bold: true},
+		{Text: " pkg"},
+	}, lines[0])
+	assert.Equal(t, []RenderedSpan{
+		{Text: "orange", FG: "#ff8700"},
+		{Text: " "},
+		{Text: "bg", BG: "#102030"},
+	}, lines[1])
+}
+
+func TestParseANSI_StyleSpansLines(t *testing.T) {
+	lines := ParseANSI("\x1b[32mok\nstill green\x1b[0m\r\ndone")
+	require.Len(t, lines, 3)
+	assert.Equal(t, []RenderedSpan{{Text: "ok", FG: "green"}}, lines[0])
+	assert.Equal(t, []RenderedSpan{{Text: "still green", FG: "green"}}, lines[1])
+	assert.Equal(t, []RenderedSpan{{Text: "done"}}, lines[2])
+}
+
+func TestParseANSI_DropsControlSequences(t *testing.T) {
+	// 커서 이동, 화면 지우기, OSC 하이퍼링크, 진행률 표시의 \r 덮어쓰기
+	content := "\x1b[2K\x1b[1Gstep 1/3\rstep 3/3\n\x1b]8;;http://example.com\x07link\x1b]8;;\x1b\\ text\x07"
+	assert.Equal(t, "step 3/3\nlink text", StripANSI(content))
+
+	// 잘린 시퀀스는 버림
+	assert.Equal(t, "abc", StripANSI("abc\x1b[31"))
+	assert.Equal(t, [][]RenderedSpan{nil}, ParseANSI("\x1b[0m"))
+}
+
+func TestRenderMessage_Classification(t *testing.T) {
+	assert.Nil(t, RenderMessage("text", ""))
+
+	lines := RenderMessage("text", "hello\nworld")
+	require.Len(t, lines, 2)
+	assert.Equal(t, LineKindAssistant, lines[0].Kind)
+	assert.Equal(t, LineKindAssistant, lines[1].Kind)
+
+	assert.Equal(t, LineKindToolUse, RenderMessage("tool_use", "Bash")[0].Kind)
+	assert.Equal(t, LineKindSystem, RenderMessage("system", "init")[0].Kind)
+	assert.Equal(t, LineKindError, RenderStderr("boom")[0].Kind)
+
+	// 도구 출력 안의 에러 줄만 error로 분류
+	lines = RenderMessage("tool_result", "ok 1\nError: missing file\n\x1b[31mFAILED\x1b[0m\n  panic: nil map\nerrors found: 0")
+	kinds := make([]LineKind, len(lines))
+	for i, line := range lines {
+		kinds[i] = line.Kind
+	}
+	assert.Equal(t, []LineKind{LineKindToolOutput, LineKindError, LineKindError, LineKindError, LineKindToolOutput}, kinds)
+}
+
+func TestRenderResponse(t *testing.T) {
+	response := &Response{Type: "tool_result", Content: "fatal: not a git repository", Error: &StreamError{Type: "tool"}}
+	renderResponse(response)
+
+	lines, ok := response.Metadata[RenderMetadataKey].([]RenderedLine)
+	require.True(t, ok)
+	assert.Equal(t, LineKindError, lines[0].Kind)
+	assert.Equal(t, lines, RenderedLines("text", "other", response.Metadata))
+
+	empty := &Response{Type: "complete"}
+	renderResponse(empty)
+	assert.Nil(t, empty.Metadata)
+}
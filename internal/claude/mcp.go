@@ -0,0 +1,373 @@
+package claude
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MCP 서버 전송 방식
+const (
+	MCPTransportStdio = "stdio"
+	MCPTransportHTTP  = "http"
+	MCPTransportSSE   = "sse"
+)
+
+// MCPProtocolVersion 헬스체크 initialize 요청에 사용하는 MCP 프로토콜 버전
+const MCPProtocolVersion = "2024-11-05"
+
+// mcpConfigFileName Claude CLI에 전달할 MCP 설정 파일 이름
+const mcpConfigFileName = "mcp.json"
+
+var mcpServerNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// MCPServerConfig MCP(Model Context Protocol) 서버 설정
+type MCPServerConfig struct {
+	// Type 전송 방식 (stdio, http, sse; 기본값 stdio)
+	Type string `json:"type,omitempty"`
+
+	// stdio 서버
+	Command string            `json:"command,omitempty"`
+	Args    []string          `json:"args,omitempty"`
+	Env     map[string]string `json:"env,omitempty"`
+
+	// http/sse 서버
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// Transport 전송 방식 (기본값 stdio)
+func (c MCPServerConfig) Transport() string {
+	if c.Type == "" {
+		return MCPTransportStdio
+	}
+	return c.Type
+}
+
+// Validate MCP 서버 설정 검증
+func (c MCPServerConfig) Validate() error {
+	switch c.Transport() {
+	case MCPTransportStdio:
+		if c.Command == "" {
+			return errors.New("stdio MCP 서버에는 command가 필요합니다")
+		}
+	case MCPTransportHTTP, MCPTransportSSE:
+		if !strings.HasPrefix(c.URL, "http://") && !strings.HasPrefix(c.URL, "https://") {
+			return fmt.Errorf("%s MCP 서버에는 http(s) url이 필요합니다", c.Type)
+		}
+	default:
+		return fmt.Errorf("지원하지 않는 MCP 전송 방식: %s", c.Type)
+	}
+	return nil
+}
+
+// ValidateMCPServerName MCP 서버 이름 검증
+func ValidateMCPServerName(name string) error {
+	if !mcpServerNamePattern.MatchString(name) {
+		return fmt.Errorf("MCP 서버 이름은 영문, 숫자, '-', '_'로 이루어진 1~64자여야 합니다: %q", name)
+	}
+	return nil
+}
+
+// MCPServerProvider 워크스페이스에 선언된 MCP 서버 목록을 제공합니다
+type MCPServerProvider interface {
+	MCPServers(workspaceID string) map[string]MCPServerConfig
+}
+
+// mcpConfigFile Claude CLI --mcp-config 파일 형식
+type mcpConfigFile struct {
+	MCPServers map[string]MCPServerConfig `json:"mcpServers"`
+}
+
+// WriteMCPConfigFile Claude CLI의 --mcp-config 옵션에 전달할 설정 파일을 dir에 생성합니다.
+// 환경 변수와 헤더에 시크릿이 포함될 수 있으므로 소유자만 읽을 수 있게 저장합니다.
+func WriteMCPConfigFile(dir string, servers map[string]MCPServerConfig) (string, error) {
+	data, err := MarshalMCPConfig(servers)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, mcpConfigFileName)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("MCP 설정 파일 생성 실패: %w", err)
+	}
+	return path, nil
+}
+
+// MarshalMCPConfig 서버 설정을 검증하고 Claude CLI --mcp-config 파일 내용으로 직렬화합니다.
+func MarshalMCPConfig(servers map[string]MCPServerConfig) ([]byte, error) {
+	for name, server := range servers {
+		if err := ValidateMCPServerName(name); err != nil {
+			return nil, err
+		}
+		if err := server.Validate(); err != nil {
+			return nil, fmt.Errorf("MCP 서버 %s: %w", name, err)
+		}
+	}
+	return json.MarshalIndent(mcpConfigFile{MCPServers: servers}, "", "  ")
+}
+
+// MCPServerStatus MCP 서버 상태
+type MCPServerStatus struct {
+	Name          string    `json:"name"`
+	Type          string    `json:"type"`
+	Healthy       bool      `json:"healthy"`
+	Error         string    `json:"error,omitempty"`
+	Latency       int64     `json:"latency_ms"`
+	ServerName    string    `json:"server_name,omitempty"`
+	ServerVersion string    `json:"server_version,omitempty"`
+	CheckedAt     time.Time `json:"checked_at"`
+}
+
+// MCPHealthChecker MCP 서버 헬스체커
+// stdio 서버는 프로세스를 띄워 initialize 핸드셰이크를 수행하고,
+// http/sse 서버는 엔드포인트 응답 여부를 확인합니다.
+type MCPHealthChecker struct {
+	timeout    time.Duration
+	httpClient *http.Client
+}
+
+// NewMCPHealthChecker 새 MCP 헬스체커 생성
+func NewMCPHealthChecker(timeout time.Duration) *MCPHealthChecker {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &MCPHealthChecker{
+		timeout:    timeout,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// mcpInitializeResult initialize 응답 중 필요한 부분
+type mcpInitializeResult struct {
+	ServerInfo struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"serverInfo"`
+}
+
+// mcpResponse JSON-RPC 응답
+type mcpResponse struct {
+	ID     json.RawMessage      `json:"id"`
+	Result *mcpInitializeResult `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Check MCP 서버 상태 확인
+func (hc *MCPHealthChecker) Check(ctx context.Context, name string, config MCPServerConfig) MCPServerStatus {
+	status := MCPServerStatus{
+		Name: name,
+		Type: config.Transport(),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, hc.timeout)
+	defer cancel()
+
+	start := time.Now()
+	var result *mcpInitializeResult
+	err := config.Validate()
+	if err == nil {
+		switch config.Transport() {
+		case MCPTransportStdio:
+			result, err = hc.checkStdio(ctx, config)
+		case MCPTransportHTTP:
+			result, err = hc.checkHTTP(ctx, config)
+		case MCPTransportSSE:
+			err = hc.checkSSE(ctx, config)
+		}
+	}
+	status.Latency = time.Since(start).Milliseconds()
+	status.CheckedAt = time.Now()
+
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.Healthy = true
+	if result != nil {
+		status.ServerName = result.ServerInfo.Name
+		status.ServerVersion = result.ServerInfo.Version
+	}
+	return status
+}
+
+// initializeRequest MCP initialize 요청
+func initializeRequest() []byte {
+	req := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "initialize",
+		"params": map[string]interface{}{
+			"protocolVersion": MCPProtocolVersion,
+			"capabilities":    map[string]interface{}{},
+			"clientInfo": map[string]string{
+				"name":    "aicli-web",
+				"version": "1.0.0",
+			},
+		},
+	}
+	data, _ := json.Marshal(req)
+	return data
+}
+
+// parseInitializeResponse initialize 응답 파싱
+func parseInitializeResponse(data []byte) (*mcpInitializeResult, error) {
+	var resp mcpResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("initialize 응답 파싱 실패: %w", err)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("initialize 실패 (%d): %s", resp.Error.Code, resp.Error.Message)
+	}
+	if resp.Result == nil {
+		return nil, errors.New("initialize 응답에 result가 없습니다")
+	}
+	return resp.Result, nil
+}
+
+// checkStdio 서버 프로세스를 실행하여 initialize 핸드셰이크 수행
+func (hc *MCPHealthChecker) checkStdio(ctx context.Context, config MCPServerConfig) (*mcpInitializeResult, error) {
+	cmd := exec.CommandContext(ctx, config.Command, config.Args...)
+	cmd.Env = os.Environ()
+	for k, v := range config.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.WaitDelay = time.Second
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("MCP 서버 실행 실패: %w", err)
+	}
+	exited := false
+	defer func() {
+		stdin.Close()
+		if !exited {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+		}
+	}()
+
+	if _, err := stdin.Write(append(initializeRequest(), '\n')); err != nil {
+		return nil, fmt.Errorf("initialize 요청 전송 실패: %w", err)
+	}
+
+	type lineResult struct {
+		line []byte
+		err  error
+	}
+	lines := make(chan lineResult, 1)
+	go func() {
+		reader := bufio.NewReader(stdout)
+		for {
+			line, err := reader.ReadBytes('\n')
+			// 서버가 보내는 알림이나 로그 줄은 건너뛰고 응답만 찾음
+			if err == nil && !bytes.Contains(line, []byte(`"id"`)) {
+				continue
+			}
+			lines <- lineResult{line: line, err: err}
+			return
+		}
+	}()
+
+	select {
+	case r := <-lines:
+		if r.err != nil && len(bytes.TrimSpace(r.line)) == 0 {
+			// stderr 복사가 끝난 뒤에 읽도록 프로세스 종료를 기다림
+			_ = cmd.Wait()
+			exited = true
+			msg := strings.TrimSpace(stderr.String())
+			if msg == "" {
+				msg = r.err.Error()
+			}
+			return nil, fmt.Errorf("MCP 서버가 응답 없이 종료되었습니다: %s", msg)
+		}
+		return parseInitializeResponse(r.line)
+	case <-ctx.Done():
+		return nil, fmt.Errorf("MCP 서버 응답 시간 초과: %w", ctx.Err())
+	}
+}
+
+// checkHTTP Streamable HTTP 엔드포인트에 initialize 요청
+func (hc *MCPHealthChecker) checkHTTP(ctx context.Context, config MCPServerConfig) (*mcpInitializeResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.URL, bytes.NewReader(initializeRequest()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	for k, v := range config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := hc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("MCP 서버 응답 상태: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	// SSE로 응답하는 경우 첫 data 줄을 사용
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		for _, line := range strings.Split(string(body), "\n") {
+			if strings.HasPrefix(line, "data:") {
+				body = []byte(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+				break
+			}
+		}
+	}
+	return parseInitializeResponse(body)
+}
+
+// checkSSE SSE 엔드포인트 연결 확인
+func (hc *MCPHealthChecker) checkSSE(ctx context.Context, config MCPServerConfig) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	for k, v := range config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := hc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("MCP 서버 응답 상태: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package claude
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMCPHelperServer MCP 서버 역할을 하는 헬퍼 프로세스 (직접 실행되지 않음)
+func TestMCPHelperServer(t *testing.T) {
+	if os.Getenv("GO_WANT_MCP_HELPER") != "1" {
+		return
+	}
+	defer os.Exit(0)
+
+	reader := bufio.NewReader(os.Stdin)
+	line, _ := reader.ReadBytes('\n')
+	var req struct {
+		ID     int    `json:"id"`
+		Method string `json:"method"`
+	}
+	_ = json.Unmarshal(line, &req)
+
+	switch os.Getenv("MCP_HELPER_MODE") {
+	case "exit":
+		fmt.Fprintln(os.Stderr, "missing API token")
+		os.Exit(1)
+	case "hang":
+		time.Sleep(10 * time.Second)
+	default:
+		// 응답 전에 알림을 보내는 서버도 처리해야 함
+		fmt.Println(`{"jsonrpc":"2.0","method":"notifications/message","params":{}}`)
+		fmt.Printf(`{"jsonrpc":"2.0","id":%d,"result":{"protocolVersion":"2024-11-05","serverInfo":{"name":"helper","version":"0.1.0"}}}`+"\n", req.ID)
+	}
+}
+
+func helperMCPServer(mode string) MCPServerConfig {
+	return MCPServerConfig{
+		Command: os.Args[0],
+		Args:    []string{"-test.run=^TestMCPHelperServer$"},
+		Env: map[string]string{
+			"GO_WANT_MCP_HELPER": "1",
+			"MCP_HELPER_MODE":    mode,
+		},
+	}
+}
+
+func TestMCPServerConfig_Validate(t *testing.T) {
+	assert.NoError(t, MCPServerConfig{Command: "npx", Args: []string{"-y", "@modelcontextprotocol/server-github"}}.Validate())
+	assert.NoError(t, MCPServerConfig{Type: MCPTransportHTTP, URL: "https://mcp.example.com/mcp"}.Validate())
+	assert.Error(t, MCPServerConfig{}.Validate())
+	assert.Error(t, MCPServerConfig{Type: MCPTransportSSE, URL: "ftp://example.com"}.Validate())
+	assert.Error(t, MCPServerConfig{Type: "websocket", URL: "https://example.com"}.Validate())
+
+	assert.NoError(t, ValidateMCPServerName("github_tools-1"))
+	assert.Error(t, ValidateMCPServerName("../etc"))
+	assert.Error(t, ValidateMCPServerName(""))
+}
+
+func TestWriteMCPConfigFile(t *testing.T) {
+	dir := t.TempDir()
+	servers := map[string]MCPServerConfig{
+		"github": {Command: "npx", Args: []string{"-y", "server-github"}, Env: map[string]string{"GITHUB_TOKEN": "secret"}},
+		"docs":   {Type: MCPTransportHTTP, URL: "https://docs.example.com/mcp"},
+	}
+
+	path, err := WriteMCPConfigFile(dir, servers)
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var decoded map[string]map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "npx", decoded["mcpServers"]["github"]["command"])
+	assert.Equal(t, "secret", decoded["mcpServers"]["github"]["env"].(map[string]interface{})["GITHUB_TOKEN"])
+	assert.Equal(t, "http", decoded["mcpServers"]["docs"]["type"])
+
+	_, err = WriteMCPConfigFile(dir, map[string]MCPServerConfig{"bad": {}})
+	assert.Error(t, err)
+}
+
+func TestMCPHealthChecker_Stdio(t *testing.T) {
+	checker := NewMCPHealthChecker(5 * time.Second)
+
+	status := checker.Check(context.Background(), "helper", helperMCPServer("ok"))
+	assert.True(t, status.Healthy, status.Error)
+	assert.Equal(t, "helper", status.ServerName)
+	assert.Equal(t, "0.1.0", status.ServerVersion)
+	assert.Equal(t, MCPTransportStdio, status.Type)
+
+	status = checker.Check(context.Background(), "helper", helperMCPServer("exit"))
+	assert.False(t, status.Healthy)
+	assert.Contains(t, status.Error, "missing API token")
+
+	status = NewMCPHealthChecker(300*time.Millisecond).Check(context.Background(), "helper", helperMCPServer("hang"))
+	assert.False(t, status.Healthy)
+	assert.Contains(t, status.Error, "시간 초과")
+}
+
+func TestMCPHealthChecker_HTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: message\ndata: {\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{\"serverInfo\":{\"name\":\"remote\",\"version\":\"2.0\"}}}\n\n")
+	}))
+	defer server.Close()
+
+	checker := NewMCPHealthChecker(5 * time.Second)
+	config := MCPServerConfig{
+		Type:    MCPTransportHTTP,
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	}
+
+	status := checker.Check(context.Background(), "remote", config)
+	assert.True(t, status.Healthy, status.Error)
+	assert.Equal(t, "remote", status.ServerName)
+
+	config.Headers = nil
+	status = checker.Check(context.Background(), "remote", config)
+	assert.False(t, status.Healthy)
+	assert.Contains(t, status.Error, "401")
+}
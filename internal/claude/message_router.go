@@ -345,6 +345,13 @@ func (r *MessageRouter) GetMetrics() map[string]interface{} {
 	return metrics
 }
 
+// Drain은 비동기 모드에서 제출된 메시지가 모두 처리될 때까지 기다립니다.
+func (r *MessageRouter) Drain() {
+	if r.workerPool != nil {
+		r.workerPool.Wait()
+	}
+}
+
 // Stop은 라우터를 정지합니다.
 func (r *MessageRouter) Stop() {
 	if r.workerPool != nil {
@@ -393,6 +400,11 @@ type WorkerPool struct {
 	stopCh     chan struct{}
 	logger     *logrus.Logger
 	stats      *WorkerPoolStats
+
+	// 아직 끝나지 않은 태스크 수 (Wait에서 사용)
+	pendingMu  sync.Mutex
+	pendingCnd *sync.Cond
+	pending    int
 }
 
 // MessageRouterTask는 워커 풀에서 실행할 태스크 인터페이스입니다.
@@ -410,13 +422,15 @@ type WorkerPoolStats struct {
 
 // NewWorkerPool은 새로운 워커 풀을 생성합니다.
 func NewWorkerPool(workers int, logger *logrus.Logger) *WorkerPool {
-	return &WorkerPool{
+	wp := &WorkerPool{
 		workers:   workers,
 		taskQueue: make(chan MessageRouterTask, workers*10),
 		stopCh:    make(chan struct{}),
 		logger:    logger,
 		stats:     &WorkerPoolStats{},
 	}
+	wp.pendingCnd = sync.NewCond(&wp.pendingMu)
+	return wp
 }
 
 // Start는 워커 풀을 시작합니다.
@@ -442,6 +456,7 @@ func (wp *WorkerPool) worker(id int) {
 					atomic.AddInt64(&wp.stats.TasksCompleted, 1)
 				}
 				atomic.AddInt64(&wp.stats.QueueSize, -1)
+				wp.donePending()
 			}
 		case <-wp.stopCh:
 			return
@@ -451,20 +466,48 @@ func (wp *WorkerPool) worker(id int) {
 
 // Submit은 태스크를 워커 풀에 제출합니다.
 func (wp *WorkerPool) Submit(task MessageRouterTask) error {
+	wp.pendingMu.Lock()
+	wp.pending++
+	wp.pendingMu.Unlock()
+
 	select {
 	case wp.taskQueue <- task:
 		atomic.AddInt64(&wp.stats.TasksSubmitted, 1)
 		atomic.AddInt64(&wp.stats.QueueSize, 1)
 		return nil
 	default:
+		wp.donePending()
 		return fmt.Errorf("worker pool queue is full")
 	}
 }
 
+// Wait은 제출된 태스크가 모두 끝날 때까지 기다립니다.
+func (wp *WorkerPool) Wait() {
+	wp.pendingMu.Lock()
+	defer wp.pendingMu.Unlock()
+	for wp.pending > 0 {
+		wp.pendingCnd.Wait()
+	}
+}
+
+func (wp *WorkerPool) donePending() {
+	wp.pendingMu.Lock()
+	wp.pending--
+	if wp.pending == 0 {
+		wp.pendingCnd.Broadcast()
+	}
+	wp.pendingMu.Unlock()
+}
+
 // Stop은 워커 풀을 정지합니다.
 func (wp *WorkerPool) Stop() {
 	close(wp.stopCh)
 	wp.wg.Wait()
+	// 큐에 남아 실행되지 않을 태스크를 기다리는 Wait 호출을 풀어줌
+	wp.pendingMu.Lock()
+	wp.pending = 0
+	wp.pendingCnd.Broadcast()
+	wp.pendingMu.Unlock()
 	close(wp.taskQueue)
 	wp.logger.Info("Worker pool stopped")
 }
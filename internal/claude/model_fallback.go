@@ -0,0 +1,18 @@
+package claude
+
+import (
+	"regexp"
+)
+
+// modelUnavailablePattern CLI 출력에서 모델 과부하나 요청 한도 초과를 나타내는 패턴
+// (API 에러 타입, HTTP 상태 코드를 포함한 "API Error: 529" 형식, 사람이 읽는 메시지)
+var modelUnavailablePattern = regexp.MustCompile(`(?i)overloaded_error|rate_limit_error|api error:?\s*(429|529)\b|\boverloaded\b|rate[ _-]?limit(ed)?\b|too many requests`)
+
+// IsModelUnavailable CLI 에러 출력이 모델 과부하 또는 요청 한도 초과로 인한 실패인지 판단합니다.
+// 이 경우 같은 요청을 다른 모델로 다시 시도할 수 있습니다.
+func IsModelUnavailable(output string) bool {
+	if output == "" {
+		return false
+	}
+	return modelUnavailablePattern.MatchString(output)
+}
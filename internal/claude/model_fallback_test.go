@@ -0,0 +1,28 @@
+package claude
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsModelUnavailable(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   bool
+	}{
+		{"과부하 API 에러", `API Error: 529 {"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`, true},
+		{"요청 한도 API 에러", `API Error: 429 {"type":"error","error":{"type":"rate_limit_error"}}`, true},
+		{"사람이 읽는 메시지", "Claude is currently overloaded, please try again", true},
+		{"Too Many Requests", "HTTP 429 Too Many Requests", true},
+		{"일반 에러", "Error: invalid API key", false},
+		{"빈 출력", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsModelUnavailable(tt.output))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+//go:build linux
+
+package claude
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// processCPUTicks /proc/<pid>/stat의 utime과 stime 합계 (클록 틱)
+func processCPUTicks(pid int) (uint64, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+
+	content := string(stat)
+	end := strings.LastIndexByte(content, ')')
+	if end < 0 {
+		return 0, fmt.Errorf("unexpected /proc/%d/stat format", pid)
+	}
+	// fields[0]은 세 번째 필드(state), utime과 stime은 14, 15번째 필드
+	fields := strings.Fields(content[end+1:])
+	if len(fields) < 13 {
+		return 0, fmt.Errorf("unexpected /proc/%d/stat format", pid)
+	}
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return utime + stime, nil
+}
+
+// captureProcState /proc/<pid>의 status, wchan, stack을 진단 정보에 기록
+// stack은 보통 root 권한이 필요하므로 읽지 못해도 에러로 보지 않습니다.
+func captureProcState(pid int, diag *HangDiagnostics) error {
+	status, err := os.ReadFile(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		return err
+	}
+
+	diag.ProcStatus = make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(status))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if ok {
+			diag.ProcStatus[key] = strings.TrimSpace(value)
+		}
+	}
+
+	if wchan, err := os.ReadFile(fmt.Sprintf("/proc/%d/wchan", pid)); err == nil {
+		diag.WaitChannel = strings.TrimSpace(string(wchan))
+	}
+	if stack, err := os.ReadFile(fmt.Sprintf("/proc/%d/stack", pid)); err == nil {
+		diag.KernelStack = string(stack)
+	}
+	return nil
+}
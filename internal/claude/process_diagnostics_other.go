@@ -0,0 +1,19 @@
+//go:build !linux
+
+package claude
+
+import "errors"
+
+// errProcUnsupported 이 플랫폼에는 /proc이 없음
+var errProcUnsupported = errors.New("/proc is not available on this platform")
+
+// processCPUTicks 이 플랫폼에서는 CPU 사용 시간을 하트비트로 쓰지 않음
+// 출력과 RecordActivity 호출만으로 멈춤을 판단합니다.
+func processCPUTicks(pid int) (uint64, error) {
+	return 0, errProcUnsupported
+}
+
+// captureProcState 이 플랫폼에서는 프로세스 상태를 수집하지 않음
+func captureProcState(pid int, diag *HangDiagnostics) error {
+	return errProcUnsupported
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // 이 파일은 프로세스 관리자 사용 예제입니다.
@@ -25,7 +26,7 @@ func main() {
 	})
 
 	// 프로세스 관리자 생성
-	pm := claude.NewProcessManager(logger)
+	pm := claude.NewProcessManager(logging.FromLogrus(logger))
 
 	// 예제 1: 간단한 명령어 실행
 	fmt.Println("=== 예제 1: 간단한 명령어 실행 ===")
@@ -132,7 +133,7 @@ func runWithErrorHandling(pm claude.ProcessManager) {
 
 	// 정상 종료 테스트
 	fmt.Println("\n정상 종료 테스트:")
-	pm2 := claude.NewProcessManager(logrus.StandardLogger())
+	pm2 := claude.NewProcessManager(logging.FromLogrus(logrus.StandardLogger()))
 
 	config2 := &claude.ProcessConfig{
 		Command: "sleep",
@@ -0,0 +1,39 @@
+//go:build linux
+
+package claude
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// processFingerprint 부팅 ID와 /proc/<pid>/stat의 시작 시각(starttime)으로 프로세스 지문 생성
+// 같은 PID가 재사용되거나 호스트가 재부팅되면 지문이 달라집니다.
+func processFingerprint(pid int) (string, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return "", err
+	}
+
+	// comm 필드에 공백이나 괄호가 있을 수 있으므로 마지막 ')' 이후부터 파싱
+	content := string(stat)
+	end := strings.LastIndexByte(content, ')')
+	if end < 0 {
+		return "", fmt.Errorf("unexpected /proc/%d/stat format", pid)
+	}
+	fields := strings.Fields(content[end+1:])
+	// fields[0]은 세 번째 필드(state), starttime은 22번째 필드
+	if len(fields) < 20 {
+		return "", fmt.Errorf("unexpected /proc/%d/stat format", pid)
+	}
+	if fields[0] == "Z" {
+		return "", fmt.Errorf("process %d is a zombie", pid)
+	}
+
+	bootID, err := os.ReadFile("/proc/sys/kernel/random/boot_id")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(bootID)) + ":" + fields[19], nil
+}
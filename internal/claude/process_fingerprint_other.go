@@ -0,0 +1,9 @@
+//go:build !linux
+
+package claude
+
+// processFingerprint 이 플랫폼에서는 프로세스 시작 시각을 확인할 수 없음
+// 지문이 없는 기록은 PID 재사용 여부를 알 수 없으므로 정리 대상에서 제외됩니다.
+func processFingerprint(pid int) (string, error) {
+	return "", ErrFingerprintUnsupported
+}
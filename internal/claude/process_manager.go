@@ -3,6 +3,7 @@ package claude
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"runtime"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // ProcessStatus 프로세스 상태
@@ -81,7 +84,7 @@ type ProcessConfig struct {
 	WorkingDir string
 	// Environment 환경 변수
 	Environment map[string]string
-	// Timeout 실행 타임아웃
+	// Timeout 실행 타임아웃 (Start부터 잰 전체 실행 시간, 재시작해도 늘어나지 않음, 0이면 무제한)
 	Timeout time.Duration
 	// OAuthToken OAuth 인증 토큰
 	OAuthToken string
@@ -91,6 +94,17 @@ type ProcessConfig struct {
 	ResourceLimits *ResourceLimits
 	// HealthCheckInterval 헬스체크 주기
 	HealthCheckInterval time.Duration
+	// MCPServers 프로세스에 연결할 MCP 서버 (시작 시 --mcp-config 파일로 전달)
+	MCPServers map[string]MCPServerConfig
+	// RestartPolicy 예기치 않게 종료되었을 때의 자동 재시작 정책 (nil이면 재시작하지 않음)
+	RestartPolicy *RestartPolicy
+	// EventListener 상태 전환과 재시작 이벤트 수신 함수
+	EventListener ProcessEventListener
+	// HangPolicy 멈춘 프로세스 감지 정책 (nil이면 감지하지 않음)
+	HangPolicy *HangPolicy
+	// Stdout, Stderr 프로세스 출력을 받을 곳 (nil이면 버림, 재시작해도 같은 곳에 이어서 씀)
+	Stdout io.Writer
+	Stderr io.Writer
 }
 
 // ResourceLimits 프로세스 리소스 제한 설정
@@ -113,33 +127,57 @@ type claudeProcessManager struct {
 	pid           int
 	startTime     time.Time
 	mutex         sync.RWMutex
+	parentCtx     context.Context
+	deadline      time.Time // config.Timeout으로 정한 실행 종료 시각 (없으면 zero)
 	ctx           context.Context
 	cancel        context.CancelFunc
 	done          chan error
-	logger        *logrus.Logger
+	baseLogger    logging.Logger
+	logger        logging.Logger // baseLogger에 Start ctx의 요청, 세션, 워크스페이스 ID를 붙인 로거
 	tokenManager  TokenManager
 	healthChecker HealthChecker
 	healthCancel  context.CancelFunc
+	mcpConfigDir  string
+	tracker       ProcessTracker
+	activity      *activityMonitor
+
+	// 자동 재시작 상태
+	stopRequested bool
+	restarts      *restartTracker
+	restartTimer  *time.Timer
+	restartSeq    uint64
+
+	// 잠금을 푼 뒤 리스너에게 전달할 이벤트
+	pendingEvents []ProcessEvent
+	eventMu       sync.Mutex
 }
 
 // NewProcessManager 새로운 프로세스 관리자를 생성합니다
-func NewProcessManager(logger *logrus.Logger) ProcessManager {
+func NewProcessManager(logger logging.Logger) ProcessManager {
+	return NewProcessManagerWithTracker(logger, nil)
+}
+
+// NewProcessManagerWithTracker 실행한 프로세스를 tracker에 기록하는 프로세스 관리자를 생성합니다
+func NewProcessManagerWithTracker(logger logging.Logger, tracker ProcessTracker) ProcessManager {
 	if logger == nil {
-		logger = logrus.StandardLogger()
+		logger = logging.FromLogrus(logrus.StandardLogger())
 	}
 	return &claudeProcessManager{
-		status: StatusStopped,
-		logger: logger,
-		done:   make(chan error, 1),
+		status:     StatusStopped,
+		baseLogger: logger,
+		logger:     logger,
+		done:       make(chan error, 1),
+		tracker:    tracker,
 	}
 }
 
 // Start 프로세스를 시작합니다
 func (pm *claudeProcessManager) Start(ctx context.Context, config *ProcessConfig) error {
 	pm.mutex.Lock()
+	defer pm.dispatchEvents()
 	defer pm.mutex.Unlock()
 
-	if pm.status != StatusStopped {
+	if pm.status != StatusStopped && pm.status != StatusError {
 		return fmt.Errorf("프로세스가 이미 실행 중이거나 시작 중입니다 (현재 상태: %s)", pm.status)
 	}
 
@@ -151,14 +189,58 @@ func (pm *claudeProcessManager) Start(ctx context.Context, config *ProcessConfig
 		return fmt.Errorf("실행할 명령어가 지정되지 않았습니다")
 	}
 
+	if config.RestartPolicy != nil {
+		if err := config.RestartPolicy.Validate(); err != nil {
+			return fmt.Errorf("잘못된 재시작 정책: %w", err)
+		}
+	}
+
+	if config.HangPolicy != nil {
+		if err := config.HangPolicy.Validate(); err != nil {
+			return fmt.Errorf("잘못된 멈춤 감지 정책: %w", err)
+		}
+	}
+
+	pm.cancelRestart()
 	pm.config = config
-	pm.status = StatusStarting
+	pm.parentCtx = ctx
+	pm.deadline = time.Time{}
+	if config.Timeout > 0 {
+		pm.deadline = time.Now().Add(config.Timeout)
+	}
+	pm.logger = pm.baseLogger.WithContext(ctx)
+	pm.stopRequested = false
+	pm.restarts = newRestartTracker(config.RestartPolicy, pm.logger)
+
+	return pm.launch(ProcessEventStarting, 0)
+}
+
+// launch 현재 설정으로 프로세스를 실행합니다 (mutex를 잡은 상태에서 호출)
+func (pm *claudeProcessManager) launch(eventType ProcessEventType, attempt int) error {
+	config := pm.config
+	pm.transition(StatusStarting, ProcessEvent{Type: eventType, Attempt: attempt})
 
-	// 컨텍스트 설정
-	pm.ctx, pm.cancel = context.WithCancel(ctx)
+	// 컨텍스트 설정 (Start ctx가 취소되거나 실행 시간 제한이 지나면 프로세스 종료)
+	if pm.deadline.IsZero() {
+		pm.ctx, pm.cancel = context.WithCancel(pm.parentCtx)
+	} else {
+		pm.ctx, pm.cancel = context.WithDeadline(pm.parentCtx, pm.deadline)
+	}
+
+	// MCP 설정 파일 생성
+	args := config.Args
+	if len(config.MCPServers) > 0 {
+		mcpArgs, err := pm.prepareMCPConfig(config.MCPServers)
+		if err != nil {
+			pm.cancel()
+			pm.transition(StatusStopped, ProcessEvent{Type: ProcessEventStartFailed, Attempt: attempt, Error: err.Error()})
+			return err
+		}
+		args = append(append([]string{}, args...), mcpArgs...)
+	}
 
 	// 명령어 준비
-	pm.cmd = exec.CommandContext(pm.ctx, config.Command, config.Args...)
+	pm.cmd = exec.CommandContext(pm.ctx, config.Command, args...)
 	
 	// 작업 디렉토리 설정
 	if config.WorkingDir != "" {
@@ -196,107 +278,370 @@ func (pm *claudeProcessManager) Start(ctx context.Context, config *ProcessConfig
 		}
 	}
 
+	// 출력 연결 (설정하지 않으면 버림)
+	pm.cmd.Stdout = config.Stdout
+	pm.cmd.Stderr = config.Stderr
+
+	// 멈춤 감지를 위해 출력 관찰
+	pm.activity = nil
+	hangPolicy := config.HangPolicy
+	if hangPolicy != nil && hangPolicy.Timeout > 0 {
+		pm.activity = newActivityMonitor(time.Now())
+		pm.cmd.Stdout = withActivity(config.Stdout, pm.activity)
+		pm.cmd.Stderr = withActivity(config.Stderr, pm.activity)
+	}
+	if pm.cmd.Stdout != nil || pm.cmd.Stderr != nil {
+		// 손자 프로세스가 출력 파이프를 잡고 있어도 Wait가 끝나도록
+		pm.cmd.WaitDelay = time.Second
+	}
+
 	// 프로세스 시작
 	if err := pm.cmd.Start(); err != nil {
-		pm.status = StatusError
+		pm.cancel()
+		pm.cleanupMCPConfig()
+		pm.transition(StatusError, ProcessEvent{Type: ProcessEventStartFailed, Attempt: attempt, Error: err.Error()})
 		return fmt.Errorf("프로세스 시작 실패: %w", err)
 	}
 
 	pm.pid = pm.cmd.Process.Pid
 	pm.startTime = time.Now()
-	pm.status = StatusRunning
+	pm.done = make(chan error, 1)
+	if pm.tracker != nil {
+		pm.tracker.TrackProcess(ProcessInfo{
+			PID:        pm.pid,
+			Command:    config.Command,
+			Args:       args,
+			WorkingDir: config.WorkingDir,
+			StartedAt:  pm.startTime,
+		})
+	}
+	pm.transition(StatusRunning, ProcessEvent{Type: ProcessEventStarted, Attempt: attempt})
 
-	pm.logger.WithFields(logrus.Fields{
+	pm.logger.WithFields(logging.Fields{
 		"pid":        pm.pid,
 		"command":    config.Command,
 		"args":       config.Args,
 		"workingDir": config.WorkingDir,
+		"attempt":    attempt,
 	}).Info("프로세스가 성공적으로 시작되었습니다")
 
 	// 헬스체커 초기화 및 시작
 	if config.HealthCheckInterval > 0 {
 		pm.healthChecker = NewHealthChecker(pm.logger)
-		healthCtx, cancel := context.WithCancel(ctx)
+		healthCtx, cancel := context.WithCancel(pm.parentCtx)
 		pm.healthCancel = cancel
 		go pm.healthChecker.Start(healthCtx, pm, config.HealthCheckInterval)
 	}
 
+	// 멈춤 감지 시작
+	if pm.activity != nil {
+		go pm.watchHang(pm.ctx, pm.cmd, pm.activity, *hangPolicy)
+	}
+
 	// 비동기 프로세스 모니터링
-	go pm.monitor()
+	go pm.monitor(pm.cmd, pm.done)
 
 	return nil
 }
 
 // monitor 프로세스를 모니터링합니다
-func (pm *claudeProcessManager) monitor() {
-	defer close(pm.done)
-
-	err := pm.cmd.Wait()
+func (pm *claudeProcessManager) monitor(cmd *exec.Cmd, done chan error) {
+	err := cmd.Wait()
+	if pm.tracker != nil {
+		pm.tracker.UntrackProcess(cmd.Process.Pid)
+	}
 
 	pm.mutex.Lock()
+	defer pm.dispatchEvents()
 	defer pm.mutex.Unlock()
+	defer func() {
+		done <- err
+		close(done)
+	}()
+
+	// 이미 다른 실행으로 교체된 경우 (강제 종료 후 재시작 등)
+	if pm.cmd != cmd {
+		return
+	}
+
+	pm.cleanupMCPConfig()
+
+	// 강제 종료로 이미 중지 상태가 된 경우
+	if pm.status == StatusStopped {
+		return
+	}
+	runFor := time.Since(pm.startTime)
 
-	if pm.status == StatusStopping {
-		pm.status = StatusStopped
-		pm.logger.WithFields(logrus.Fields{
+	if pm.stopRequested || pm.status == StatusStopping {
+		pm.transition(StatusStopped, ProcessEvent{Type: ProcessEventStopped})
+		pm.logger.WithFields(logging.Fields{
 			"pid":      pm.pid,
-			"duration": time.Since(pm.startTime),
+			"duration": runFor,
 		}).Info("프로세스가 정상적으로 중지되었습니다")
+	} else if ctxErr := pm.ctx.Err(); ctxErr != nil {
+		// 호출자가 취소했거나 실행 시간 제한을 넘긴 경우는 재시작하지 않음
+		pm.stopHealthCheck()
+		pm.transition(StatusStopped, ProcessEvent{Type: ProcessEventStopped, Error: ctxErr.Error()})
+		pm.logger.WithFields(logging.Fields{
+			"pid":      pm.pid,
+			"duration": runFor,
+			"reason":   ctxErr,
+		}).Info("컨텍스트 취소 또는 시간 제한으로 프로세스가 종료되었습니다")
 	} else if err != nil {
-		pm.status = StatusError
-		pm.logger.WithFields(logrus.Fields{
+		pm.stopHealthCheck()
+		pm.transition(StatusError, ProcessEvent{Type: ProcessEventCrashed, Error: err.Error()})
+		pm.logger.WithFields(logging.Fields{
 			"pid":      pm.pid,
-			"duration": time.Since(pm.startTime),
+			"duration": runFor,
 			"error":    err,
 		}).Error("프로세스가 예기치 않게 종료되었습니다")
+		pm.scheduleRestart(runFor)
 	} else {
-		pm.status = StatusStopped
-		pm.logger.WithFields(logrus.Fields{
+		pm.stopHealthCheck()
+		pm.transition(StatusStopped, ProcessEvent{Type: ProcessEventExited})
+		pm.logger.WithFields(logging.Fields{
 			"pid":      pm.pid,
-			"duration": time.Since(pm.startTime),
+			"duration": runFor,
 		}).Info("프로세스가 종료되었습니다")
 	}
+}
 
-	pm.done <- err
+// watchHang 출력과 하트비트가 없는 상태가 정책의 제한 시간을 넘으면 진단 정보와 함께 알립니다
+func (pm *claudeProcessManager) watchHang(ctx context.Context, cmd *exec.Cmd, activity *activityMonitor, policy HangPolicy) {
+	ticker := time.NewTicker(policy.interval())
+	defer ticker.Stop()
+
+	pid := cmd.Process.Pid
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		pm.mutex.RLock()
+		current := pm.cmd == cmd && pm.status == StatusRunning
+		pm.mutex.RUnlock()
+		if !current {
+			return
+		}
+
+		ticks, err := processCPUTicks(pid)
+		diag, hung := activity.check(ticks, err == nil, policy.Timeout, time.Now())
+		if !hung {
+			continue
+		}
+		diag = captureHangDiagnostics(pid, diag)
+		pm.reportHang(cmd, diag, policy)
+	}
+}
+
+// reportHang 멈춤 이벤트를 발행하고 정책에 따라 프로세스를 강제 종료합니다
+// 강제 종료는 예기치 않은 종료로 처리되므로 재시작 정책이 있으면 다시 시작됩니다.
+func (pm *claudeProcessManager) reportHang(cmd *exec.Cmd, diag *HangDiagnostics, policy HangPolicy) {
+	pm.mutex.Lock()
+	defer pm.dispatchEvents()
+	defer pm.mutex.Unlock()
+
+	if pm.cmd != cmd || pm.status != StatusRunning {
+		return
+	}
+
+	hangErr := NewClaudeProcessError(ErrTypeHangDetected,
+		fmt.Sprintf("%v 동안 출력과 하트비트가 없습니다", diag.IdleFor.Round(time.Second)), nil, pm.pid, pm.status)
+	pm.emit(ProcessEvent{Type: ProcessEventHung, From: pm.status, To: pm.status, Error: hangErr.Error(), Diagnostics: diag})
+	pm.logger.WithFields(logging.Fields{
+		"pid":          pm.pid,
+		"idle_for":     diag.IdleFor,
+		"wait_channel": diag.WaitChannel,
+		"auto_kill":    policy.AutoKill,
+	}).Warn("멈춘 프로세스를 감지했습니다")
+
+	if policy.AutoKill {
+		if err := cmd.Process.Kill(); err != nil {
+			pm.logger.WithError(err).WithField("pid", pm.pid).Error("멈춘 프로세스 강제 종료 실패")
+		}
+	}
+}
+
+// RecordActivity 프로세스 밖에서 관찰한 진행 상황을 하트비트로 기록합니다
+func (pm *claudeProcessManager) RecordActivity() {
+	pm.mutex.RLock()
+	activity := pm.activity
+	pm.mutex.RUnlock()
+
+	if activity != nil {
+		activity.heartbeat()
+	}
+}
+
+// scheduleRestart 재시작 정책에 따라 백오프 후 재시작을 예약합니다 (mutex를 잡은 상태에서 호출)
+func (pm *claudeProcessManager) scheduleRestart(runFor time.Duration) {
+	if pm.restarts == nil {
+		return
+	}
+
+	decision := pm.restarts.next(runFor)
+	if decision.circuitOpen {
+		pm.emit(ProcessEvent{Type: ProcessEventCircuitOpen, From: pm.status, To: pm.status, Attempt: decision.attempt, Delay: decision.delay})
+	}
+	if !decision.restart {
+		pm.emit(ProcessEvent{Type: ProcessEventRestartExhausted, From: pm.status, To: pm.status})
+		pm.logger.WithField("pid", pm.pid).Warn("재시작 정책에 따라 자동 재시작을 중단합니다")
+		return
+	}
+
+	pm.emit(ProcessEvent{Type: ProcessEventRestartScheduled, From: pm.status, To: pm.status, Attempt: decision.attempt, Delay: decision.delay})
+	pm.logger.WithFields(logging.Fields{
+		"attempt": decision.attempt,
+		"delay":   decision.delay,
+	}).Info("프로세스 자동 재시작을 예약합니다")
+
+	pm.restartSeq++
+	seq := pm.restartSeq
+	pm.restartTimer = time.AfterFunc(decision.delay, func() {
+		pm.restartAfterCrash(seq, decision.attempt)
+	})
+}
+
+// restartAfterCrash 예약된 자동 재시작을 실행합니다
+func (pm *claudeProcessManager) restartAfterCrash(seq uint64, attempt int) {
+	pm.mutex.Lock()
+	defer pm.dispatchEvents()
+	defer pm.mutex.Unlock()
+
+	// 그 사이 중지, 수동 재시작 또는 다른 예약으로 대체된 경우
+	if pm.restartTimer == nil || pm.restartSeq != seq || pm.stopRequested || pm.status != StatusError {
+		return
+	}
+	pm.restartTimer = nil
+
+	if pm.parentCtx.Err() != nil {
+		pm.emit(ProcessEvent{Type: ProcessEventRestartExhausted, From: pm.status, To: pm.status, Error: pm.parentCtx.Err().Error()})
+		return
+	}
+
+	if err := pm.launch(ProcessEventRestarting, attempt); err != nil {
+		pm.logger.WithError(err).Error("프로세스 자동 재시작 실패")
+		if pm.status != StatusError {
+			pm.transition(StatusError, ProcessEvent{Type: ProcessEventStartFailed, Attempt: attempt, Error: err.Error()})
+		}
+		pm.scheduleRestart(0)
+	}
+}
+
+// cancelRestart 예약된 자동 재시작을 취소합니다 (mutex를 잡은 상태에서 호출)
+func (pm *claudeProcessManager) cancelRestart() {
+	if pm.restartTimer != nil {
+		pm.restartTimer.Stop()
+		pm.restartTimer = nil
+	}
+}
+
+// stopHealthCheck 실행 중인 헬스체커를 중지합니다 (mutex를 잡은 상태에서 호출)
+func (pm *claudeProcessManager) stopHealthCheck() {
+	if pm.healthCancel != nil {
+		pm.healthCancel()
+		pm.healthCancel = nil
+	}
+}
+
+// transition 상태를 바꾸고 전환 이벤트를 기록합니다 (mutex를 잡은 상태에서 호출)
+func (pm *claudeProcessManager) transition(to ProcessStatus, event ProcessEvent) {
+	event.From = pm.status
+	event.To = to
+	pm.status = to
+	pm.emit(event)
+}
+
+// emit 이벤트를 전달 대기열에 추가합니다 (mutex를 잡은 상태에서 호출)
+func (pm *claudeProcessManager) emit(event ProcessEvent) {
+	if pm.config == nil || pm.config.EventListener == nil {
+		return
+	}
+	if event.PID == 0 {
+		event.PID = pm.pid
+	}
+	event.Timestamp = time.Now()
+	pm.pendingEvents = append(pm.pendingEvents, event)
+}
+
+// dispatchEvents 대기 중인 이벤트를 발생 순서대로 리스너에게 전달합니다
+// 리스너는 잠금 밖에서 호출되므로 관리자 메서드를 다시 호출해도 됩니다.
+func (pm *claudeProcessManager) dispatchEvents() {
+	pm.eventMu.Lock()
+	defer pm.eventMu.Unlock()
+
+	pm.mutex.Lock()
+	events := pm.pendingEvents
+	pm.pendingEvents = nil
+	var listener ProcessEventListener
+	if pm.config != nil {
+		listener = pm.config.EventListener
+	}
+	pm.mutex.Unlock()
+
+	if listener == nil {
+		return
+	}
+	for _, event := range events {
+		listener(event)
+	}
 }
 
 // Stop 프로세스를 정상적으로 중지합니다
+// 자동 재시작을 기다리는 중이면 예약을 취소하고 중지 상태로 전환합니다.
 func (pm *claudeProcessManager) Stop(timeout time.Duration) error {
 	pm.mutex.Lock()
-	
+
+	if pm.status == StatusError && pm.restartTimer != nil {
+		pm.cancelRestart()
+		pm.stopRequested = true
+		pm.transition(StatusStopped, ProcessEvent{Type: ProcessEventStopped})
+		pm.mutex.Unlock()
+		pm.dispatchEvents()
+		return nil
+	}
+
 	if pm.status != StatusRunning {
 		pm.mutex.Unlock()
 		return fmt.Errorf("프로세스가 실행 중이 아닙니다 (현재 상태: %s)", pm.status)
 	}
 
-	pm.status = StatusStopping
+	pm.stopRequested = true
+	pm.transition(StatusStopping, ProcessEvent{Type: ProcessEventStopping})
+	pm.stopHealthCheck()
+	cmd := pm.cmd
+	done := pm.done
+	pid := pm.pid
+	checker := pm.healthChecker
 	pm.mutex.Unlock()
+	pm.dispatchEvents()
 
 	// 헬스체커 중지
-	if pm.healthChecker != nil && pm.healthCancel != nil {
-		pm.healthCancel()
-		pm.healthChecker.Stop()
+	if checker != nil {
+		checker.Stop()
 	}
 
-	pm.logger.WithFields(logrus.Fields{
-		"pid":     pm.pid,
+	pm.logger.WithFields(logging.Fields{
+		"pid":     pid,
 		"timeout": timeout,
 	}).Info("프로세스 정상 종료를 시작합니다")
 
 	// SIGTERM 전송
-	if err := pm.cmd.Process.Signal(syscall.SIGTERM); err != nil {
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
 		return fmt.Errorf("인터럽트 시그널 전송 실패: %w", err)
 	}
 
 	// 타임아웃 기다리기
 	select {
-	case err := <-pm.done:
+	case err := <-done:
 		if err != nil && err.Error() != "signal: terminated" {
 			return fmt.Errorf("프로세스 종료 중 오류: %w", err)
 		}
 		return nil
 	case <-time.After(timeout):
-		pm.logger.WithField("pid", pm.pid).Warn("정상 종료 타임아웃, 강제 종료를 시도합니다")
+		pm.logger.WithField("pid", pid).Warn("정상 종료 타임아웃, 강제 종료를 시도합니다")
 		return pm.Kill()
 	}
 }
@@ -304,13 +649,17 @@ func (pm *claudeProcessManager) Stop(timeout time.Duration) error {
 // Kill 프로세스를 강제로 종료합니다
 func (pm *claudeProcessManager) Kill() error {
 	pm.mutex.Lock()
+	defer pm.dispatchEvents()
 	defer pm.mutex.Unlock()
 
+	pm.stopRequested = true
+	pm.cancelRestart()
+
 	if pm.status == StatusStopped {
 		return nil
 	}
 
-	if pm.cmd != nil && pm.cmd.Process != nil {
+	if pm.cmd != nil && pm.cmd.Process != nil && pm.status != StatusError {
 		if err := pm.cmd.Process.Kill(); err != nil {
 			return fmt.Errorf("프로세스 강제 종료 실패: %w", err)
 		}
@@ -319,8 +668,9 @@ func (pm *claudeProcessManager) Kill() error {
 	if pm.cancel != nil {
 		pm.cancel()
 	}
+	pm.stopHealthCheck()
 
-	pm.status = StatusStopped
+	pm.transition(StatusStopped, ProcessEvent{Type: ProcessEventKilled})
 	pm.logger.WithField("pid", pm.pid).Info("프로세스가 강제 종료되었습니다")
 
 	return nil
@@ -345,13 +695,21 @@ func (pm *claudeProcessManager) GetPID() int {
 	return pm.pid
 }
 
-// Wait 프로세스가 종료될 때까지 대기합니다
+// Wait 현재 실행 중인 프로세스가 종료될 때까지 대기합니다
 func (pm *claudeProcessManager) Wait() error {
+	pm.mutex.RLock()
+	done := pm.done
+	ctx := pm.ctx
+	pm.mutex.RUnlock()
+
+	if ctx == nil {
+		return <-done
+	}
 	select {
-	case err := <-pm.done:
+	case err := <-done:
 		return err
-	case <-pm.ctx.Done():
-		return pm.ctx.Err()
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
 
@@ -376,6 +734,25 @@ func (pm *claudeProcessManager) HealthCheck() error {
 	return nil
 }
 
+// UpdateCredentials 다음 시작부터 사용할 OAuth 토큰을 교체합니다 (실행 중인 프로세스에는 재시작 후 적용)
+func (pm *claudeProcessManager) UpdateCredentials(oauthToken string, expiresAt time.Time) error {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	if pm.config == nil {
+		return fmt.Errorf("프로세스 설정이 없습니다")
+	}
+
+	config := *pm.config
+	config.OAuthToken = oauthToken
+	pm.config = &config
+
+	if pm.tokenManager != nil {
+		pm.tokenManager.SetToken(oauthToken, expiresAt)
+	}
+	return nil
+}
+
 // RestartProcess 프로세스를 재시작합니다
 func (pm *claudeProcessManager) RestartProcess(identifier string) error {
 	pm.logger.WithField("identifier", identifier).Info("프로세스 재시작을 시작합니다")
@@ -383,7 +760,7 @@ func (pm *claudeProcessManager) RestartProcess(identifier string) error {
 	// 현재 설정 저장
 	pm.mutex.RLock()
 	config := pm.config
-	ctx := pm.ctx
+	ctx := pm.parentCtx
 	pm.mutex.RUnlock()
 	
 	if config == nil {
@@ -412,6 +789,34 @@ func (pm *claudeProcessManager) RestartProcess(identifier string) error {
 	return nil
 }
 
+// prepareMCPConfig MCP 설정 파일을 임시 디렉토리에 생성하고 CLI 인자를 반환합니다
+func (pm *claudeProcessManager) prepareMCPConfig(servers map[string]MCPServerConfig) ([]string, error) {
+	dir, err := os.MkdirTemp("", "aicli-mcp-")
+	if err != nil {
+		return nil, fmt.Errorf("MCP 설정 디렉토리 생성 실패: %w", err)
+	}
+
+	path, err := WriteMCPConfigFile(dir, servers)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	pm.mcpConfigDir = dir
+	return []string{"--mcp-config", path}, nil
+}
+
+// cleanupMCPConfig 프로세스 종료 후 MCP 설정 파일 삭제 (mutex를 잡은 상태에서 호출)
+func (pm *claudeProcessManager) cleanupMCPConfig() {
+	if pm.mcpConfigDir == "" {
+		return
+	}
+	if err := os.RemoveAll(pm.mcpConfigDir); err != nil {
+		pm.logger.WithError(err).Warn("MCP 설정 파일 삭제 실패")
+	}
+	pm.mcpConfigDir = ""
+}
+
 // applyResourceLimits 프로세스에 리소스 제한을 적용합니다
 func (pm *claudeProcessManager) applyResourceLimits(limits *ResourceLimits) error {
 	if limits == nil {
@@ -430,7 +835,7 @@ func (pm *claudeProcessManager) applyResourceLimits(limits *ResourceLimits) erro
 		// - 메모리 제한: cgroup memory.limit_in_bytes 또는 rlimit RLIMIT_AS
 		// - 디스크 I/O 제한: cgroup blkio 컨트롤러
 		
-		pm.logger.WithFields(logrus.Fields{
+		pm.logger.WithFields(logging.Fields{
 			"maxCPU":    limits.MaxCPU,
 			"maxMemory": limits.MaxMemory,
 			"maxDiskIO": limits.MaxDiskIO,
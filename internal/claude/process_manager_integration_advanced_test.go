@@ -12,6 +12,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // TestProcessManagerIntegration_TokenAndHealthCheck 통합 테스트: 토큰 관리와 헬스체크
@@ -24,12 +26,12 @@ func TestProcessManagerIntegration_TokenAndHealthCheck(t *testing.T) {
 	logger.SetLevel(logrus.DebugLevel)
 
 	t.Run("OAuth token with health monitoring", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		// 헬스 상태 변경 추적
 		var healthStatuses []bool
-		healthChecker := NewHealthChecker(logger)
+		healthChecker := NewHealthChecker(logging.FromLogrus(logger))
 		healthChecker.RegisterHealthHandler(func(status HealthStatus) {
 			healthStatuses = append(healthStatuses, status.Healthy)
 			logger.WithFields(logrus.Fields{
@@ -65,7 +67,7 @@ func TestProcessManagerIntegration_TokenAndHealthCheck(t *testing.T) {
 	})
 
 	t.Run("API key fallback with resource limits", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		config := &ProcessConfig{
@@ -117,7 +119,7 @@ func TestProcessManagerIntegration_TokenRefresh(t *testing.T) {
 	// 초기 토큰 설정 (곧 만료될 토큰)
 	tokenManager.SetToken("initial-token", time.Now().Add(1*time.Second))
 
-	pm := NewProcessManager(logger)
+	pm := NewProcessManager(logging.FromLogrus(logger))
 	ctx := context.Background()
 
 	// 환경 변수 설정을 확인하는 스크립트
@@ -157,7 +159,7 @@ func TestProcessManagerIntegration_HealthCheckRecovery(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
 
-	pm := NewProcessManager(logger)
+	pm := NewProcessManager(logging.FromLogrus(logger))
 	ctx := context.Background()
 
 	// 헬스체크 실패/복구를 시뮬레이션하는 스크립트
@@ -223,7 +225,7 @@ func TestProcessManagerIntegration_ConcurrentProcesses(t *testing.T) {
 	// 여러 프로세스 동시 실행
 	for i := 0; i < numProcesses; i++ {
 		idx := i
-		managers[i] = NewProcessManager(logger)
+		managers[i] = NewProcessManager(logging.FromLogrus(logger))
 		
 		go func(pm ProcessManager, id int) {
 			config := &ProcessConfig{
@@ -272,8 +274,8 @@ func TestProcessManagerIntegration_EnvironmentIsolation(t *testing.T) {
 	logger.SetLevel(logrus.DebugLevel)
 
 	// 두 개의 다른 환경 설정
-	pm1 := NewProcessManager(logger)
-	pm2 := NewProcessManager(logger)
+	pm1 := NewProcessManager(logging.FromLogrus(logger))
+	pm2 := NewProcessManager(logging.FromLogrus(logger))
 	
 	ctx := context.Background()
 
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // 통합 테스트는 실제 프로세스를 실행하므로 더 긴 시간이 걸릴 수 있습니다.
@@ -23,7 +24,7 @@ import (
 
 func TestProcessManagerIntegration_ClaudeCLISimulation(t *testing.T) {
 	logger := createTestLogger()
-	pm := claude.NewProcessManager(logger)
+	pm := claude.NewProcessManager(logging.FromLogrus(logger))
 	
 	// Claude CLI를 시뮬레이션하는 스크립트 생성
 	scriptPath := createClaudeSimScript(t)
@@ -62,7 +63,7 @@ func TestProcessManagerIntegration_MultipleProcesses(t *testing.T) {
 	// 여러 프로세스 동시 실행
 	processes := make([]claude.ProcessManager, 3)
 	for i := range processes {
-		processes[i] = claude.NewProcessManager(logger)
+		processes[i] = claude.NewProcessManager(logging.FromLogrus(logger))
 	}
 	
 	ctx := context.Background()
@@ -99,7 +100,7 @@ func TestProcessManagerIntegration_MultipleProcesses(t *testing.T) {
 
 func TestProcessManagerIntegration_ErrorHandling(t *testing.T) {
 	logger := createTestLogger()
-	pm := claude.NewProcessManager(logger)
+	pm := claude.NewProcessManager(logging.FromLogrus(logger))
 	
 	ctx := context.Background()
 	
@@ -119,7 +120,7 @@ func TestProcessManagerIntegration_ErrorHandling(t *testing.T) {
 	})
 	
 	t.Run("exit with error code", func(t *testing.T) {
-		pm2 := claude.NewProcessManager(logger)
+		pm2 := claude.NewProcessManager(logging.FromLogrus(logger))
 		
 		config := &claude.ProcessConfig{
 			Command: getShellCommand(),
@@ -139,7 +140,7 @@ func TestProcessManagerIntegration_ErrorHandling(t *testing.T) {
 
 func TestProcessManagerIntegration_Timeout(t *testing.T) {
 	logger := createTestLogger()
-	pm := claude.NewProcessManager(logger)
+	pm := claude.NewProcessManager(logging.FromLogrus(logger))
 	
 	ctx := context.Background()
 	config := &claude.ProcessConfig{
@@ -161,7 +162,7 @@ func TestProcessManagerIntegration_Timeout(t *testing.T) {
 
 func TestProcessManagerIntegration_HealthCheck(t *testing.T) {
 	logger := createTestLogger()
-	pm := claude.NewProcessManager(logger)
+	pm := claude.NewProcessManager(logging.FromLogrus(logger))
 	
 	ctx := context.Background()
 	config := &claude.ProcessConfig{
@@ -1,6 +1,7 @@
 package claude
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"runtime"
@@ -10,6 +11,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 func TestProcessStatus_String(t *testing.T) {
@@ -36,7 +39,7 @@ func TestProcessStatus_String(t *testing.T) {
 func TestNewProcessManager(t *testing.T) {
 	t.Run("with logger", func(t *testing.T) {
 		logger := logrus.New()
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		assert.NotNil(t, pm)
 		assert.Equal(t, StatusStopped, pm.GetStatus())
 		assert.Equal(t, 0, pm.GetPID())
@@ -54,7 +57,7 @@ func TestProcessManager_Start(t *testing.T) {
 	logger.SetLevel(logrus.DebugLevel)
 
 	t.Run("simple command", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		// 플랫폼별 명령어 선택
@@ -85,7 +88,7 @@ func TestProcessManager_Start(t *testing.T) {
 	})
 
 	t.Run("with working directory", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		tempDir, err := os.MkdirTemp("", "process_test")
@@ -115,8 +118,29 @@ func TestProcessManager_Start(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("captures output", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("sh가 필요합니다")
+		}
+		pm := NewProcessManager(logging.FromLogrus(logger))
+
+		var stdout, stderr bytes.Buffer
+		config := &ProcessConfig{
+			Command:    "sh",
+			Args:       []string{"-c", "echo out; echo err >&2"},
+			Stdout:     &stdout,
+			Stderr:     &stderr,
+			HangPolicy: &HangPolicy{Timeout: time.Minute},
+		}
+
+		require.NoError(t, pm.Start(context.Background(), config))
+		require.NoError(t, pm.Wait())
+		assert.Equal(t, "out\n", stdout.String())
+		assert.Equal(t, "err\n", stderr.String())
+	})
+
 	t.Run("with environment variables", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -145,7 +169,7 @@ func TestProcessManager_Start(t *testing.T) {
 	})
 
 	t.Run("with OAuth token", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -172,7 +196,7 @@ func TestProcessManager_Start(t *testing.T) {
 	})
 
 	t.Run("with API key", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -199,7 +223,7 @@ func TestProcessManager_Start(t *testing.T) {
 	})
 
 	t.Run("with resource limits", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -231,7 +255,7 @@ func TestProcessManager_Start(t *testing.T) {
 	})
 
 	t.Run("with health check", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -264,7 +288,7 @@ func TestProcessManager_Start(t *testing.T) {
 	})
 
 	t.Run("invalid config", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		// nil config
@@ -280,7 +304,7 @@ func TestProcessManager_Start(t *testing.T) {
 	})
 
 	t.Run("already running", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -320,7 +344,7 @@ func TestProcessManager_Stop(t *testing.T) {
 			t.Skip("Windows에서는 SIGTERM을 지원하지 않습니다")
 		}
 
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		// 시그널을 받을 수 있는 프로세스 실행
@@ -342,7 +366,7 @@ func TestProcessManager_Stop(t *testing.T) {
 	})
 
 	t.Run("stop with timeout", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -370,7 +394,7 @@ func TestProcessManager_Stop(t *testing.T) {
 	})
 
 	t.Run("stop not running", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		err := pm.Stop(1 * time.Second)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "프로세스가 실행 중이 아닙니다")
@@ -382,7 +406,7 @@ func TestProcessManager_Kill(t *testing.T) {
 	logger.SetLevel(logrus.DebugLevel)
 
 	t.Run("kill running process", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -410,18 +434,55 @@ func TestProcessManager_Kill(t *testing.T) {
 	})
 
 	t.Run("kill already stopped", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		err := pm.Kill()
 		assert.NoError(t, err) // 이미 중지된 상태에서는 에러 없음
 	})
 }
 
+func TestProcessManager_ContextEndsProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sleep 명령이 필요함")
+	}
+	policy := &RestartPolicy{Enabled: true, MaxRestarts: 3, InitialBackoff: 10 * time.Millisecond, MaxBackoff: 10 * time.Millisecond, BackoffMultiplier: 1}
+
+	t.Run("timeout", func(t *testing.T) {
+		pm := NewProcessManager(nil)
+		require.NoError(t, pm.Start(context.Background(), &ProcessConfig{
+			Command:       "sleep",
+			Args:          []string{"30"},
+			Timeout:       100 * time.Millisecond,
+			RestartPolicy: policy,
+		}))
+
+		// 시간 제한으로 끝난 프로세스는 크래시로 보고 재시작하지 않음
+		assert.Eventually(t, func() bool { return pm.GetStatus() == StatusStopped }, 5*time.Second, 10*time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, StatusStopped, pm.GetStatus())
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		pm := NewProcessManager(nil)
+		require.NoError(t, pm.Start(ctx, &ProcessConfig{
+			Command:       "sleep",
+			Args:          []string{"30"},
+			RestartPolicy: policy,
+		}))
+
+		cancel()
+		assert.Eventually(t, func() bool { return pm.GetStatus() == StatusStopped }, 5*time.Second, 10*time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, StatusStopped, pm.GetStatus())
+	})
+}
+
 func TestProcessManager_HealthCheck(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
 
 	t.Run("healthy process", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		ctx := context.Background()
 
 		var cmd string
@@ -451,7 +512,7 @@ func TestProcessManager_HealthCheck(t *testing.T) {
 	})
 
 	t.Run("not running", func(t *testing.T) {
-		pm := NewProcessManager(logger)
+		pm := NewProcessManager(logging.FromLogrus(logger))
 		err := pm.HealthCheck()
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "프로세스가 실행 중이 아닙니다")
@@ -462,7 +523,7 @@ func TestProcessManager_ConcurrentOperations(t *testing.T) {
 	logger := logrus.New()
 	logger.SetLevel(logrus.DebugLevel)
 
-	pm := NewProcessManager(logger)
+	pm := NewProcessManager(logging.FromLogrus(logger))
 	ctx := context.Background()
 
 	var cmd string
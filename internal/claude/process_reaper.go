@@ -0,0 +1,397 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// ErrFingerprintUnsupported 현재 플랫폼에서 프로세스 지문을 만들 수 없음
+var ErrFingerprintUnsupported = errors.New("process fingerprint is not supported on this platform")
+
+// ProcessInfo 실행한 프로세스 정보
+type ProcessInfo struct {
+	PID        int
+	Command    string
+	Args       []string
+	WorkingDir string
+	StartedAt  time.Time
+}
+
+// ProcessTracker 프로세스 관리자가 실행하고 종료한 프로세스를 기록합니다
+// 프로세스 관리자 잠금을 잡은 상태에서 호출되므로 관리자 메서드를 다시 호출하면 안 됩니다.
+type ProcessTracker interface {
+	TrackProcess(info ProcessInfo)
+	UntrackProcess(pid int)
+}
+
+// OrphanAction 고아 프로세스 처리 방식
+type OrphanAction string
+
+const (
+	OrphanActionKill  OrphanAction = "kill"  // 종료
+	OrphanActionAdopt OrphanAction = "adopt" // 계속 실행하며 다시 관리
+)
+
+// IsValid 유효한 처리 방식인지 확인
+func (a OrphanAction) IsValid() bool {
+	return a == OrphanActionKill || a == OrphanActionAdopt
+}
+
+// ReapResult 기록 하나의 처리 결과
+type ReapResult string
+
+const (
+	ReapResultOrphan  ReapResult = "orphan"  // 고아 프로세스 (dry-run에서 처리하지 않음)
+	ReapResultKilled  ReapResult = "killed"  // 고아 프로세스 종료
+	ReapResultAdopted ReapResult = "adopted" // 고아 프로세스 재관리
+	ReapResultStale   ReapResult = "stale"   // 이미 종료되었거나 PID가 재사용됨 (기록만 삭제)
+	ReapResultSkipped ReapResult = "skipped" // 지문이 없어 고아 여부를 확인할 수 없음
+	ReapResultFailed  ReapResult = "failed"  // 처리 실패
+)
+
+// ReapEntry 프로세스 기록 하나의 점검 결과
+type ReapEntry struct {
+	PID       int        `json:"pid"`
+	Command   string     `json:"command"`
+	Args      []string   `json:"args,omitempty"`
+	StartedAt time.Time  `json:"started_at"`
+	Result    ReapResult `json:"result"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// ReapReport 고아 프로세스 점검 보고서
+type ReapReport struct {
+	InstanceID  string       `json:"instance_id"`
+	Action      OrphanAction `json:"action"`
+	DryRun      bool         `json:"dry_run"`
+	Orphans     int          `json:"orphans"`
+	Entries     []ReapEntry  `json:"entries"`
+	GeneratedAt time.Time    `json:"generated_at"`
+}
+
+// ProcessReaperConfig 고아 프로세스 정리 설정
+type ProcessReaperConfig struct {
+	// InstanceID 서버 인스턴스 식별자 (재시작해도 같아야 함)
+	InstanceID string
+	// Action 고아 프로세스 기본 처리 방식
+	Action OrphanAction
+	// KillTimeout SIGTERM 후 SIGKILL까지 대기 시간
+	KillTimeout time.Duration
+	// PollInterval 종료 대기와 재관리 프로세스 감시 주기
+	PollInterval time.Duration
+}
+
+// ProcessReaper 실행한 CLI 프로세스를 스토리지에 기록하고,
+// 서버가 비정상 종료된 뒤 재시작할 때 남은 고아 프로세스를 종료하거나 다시 관리합니다.
+type ProcessReaper struct {
+	store       storage.ProcessRecordStorage
+	config      ProcessReaperConfig
+	logger      *logrus.Logger
+	fingerprint func(pid int) (string, error)
+
+	mu     sync.Mutex
+	live   map[int]string // 현재 인스턴스가 관리 중인 PID -> 지문
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// ProcessTracker 인터페이스 구현 확인
+var _ ProcessTracker = (*ProcessReaper)(nil)
+
+// NewProcessReaper 새 고아 프로세스 정리기 생성
+func NewProcessReaper(store storage.ProcessRecordStorage, config ProcessReaperConfig, logger *logrus.Logger) (*ProcessReaper, error) {
+	if config.InstanceID == "" {
+		return nil, errors.New("instance id is required")
+	}
+	if config.Action == "" {
+		config.Action = OrphanActionKill
+	}
+	if !config.Action.IsValid() {
+		return nil, fmt.Errorf("invalid orphan action: %s", config.Action)
+	}
+	if config.KillTimeout <= 0 {
+		config.KillTimeout = 10 * time.Second
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Second
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ProcessReaper{
+		store:       store,
+		config:      config,
+		logger:      logger,
+		fingerprint: processFingerprint,
+		live:        make(map[int]string),
+		ctx:         ctx,
+		cancel:      cancel,
+	}, nil
+}
+
+// TrackProcess 실행한 프로세스를 지문과 함께 기록
+func (r *ProcessReaper) TrackProcess(info ProcessInfo) {
+	fingerprint, err := r.fingerprint(info.PID)
+	if err != nil && !errors.Is(err, ErrFingerprintUnsupported) {
+		r.logger.WithError(err).WithField("pid", info.PID).Warn("프로세스 지문 생성 실패")
+	}
+
+	r.mu.Lock()
+	r.live[info.PID] = fingerprint
+	r.mu.Unlock()
+
+	err = r.store.Save(context.Background(), &models.ProcessRecord{
+		InstanceID:  r.config.InstanceID,
+		PID:         info.PID,
+		Fingerprint: fingerprint,
+		Command:     info.Command,
+		Args:        info.Args,
+		WorkingDir:  info.WorkingDir,
+		StartedAt:   info.StartedAt,
+	})
+	if err != nil {
+		r.logger.WithError(err).WithField("pid", info.PID).Warn("프로세스 기록 저장 실패")
+	}
+}
+
+// UntrackProcess 종료된 프로세스 기록 삭제
+func (r *ProcessReaper) UntrackProcess(pid int) {
+	r.mu.Lock()
+	delete(r.live, pid)
+	r.mu.Unlock()
+
+	if err := r.store.Delete(context.Background(), r.config.InstanceID, pid); err != nil && !storage.IsNotFoundError(err) {
+		r.logger.WithError(err).WithField("pid", pid).Warn("프로세스 기록 삭제 실패")
+	}
+}
+
+// Reap 이전 실행에서 남은 고아 프로세스를 찾아 처리
+// action이 비어 있으면 설정의 기본 처리 방식을 사용하고, dryRun이면 보고서만 만듭니다.
+// 현재 인스턴스가 관리 중인 프로세스는 점검하지 않습니다.
+func (r *ProcessReaper) Reap(ctx context.Context, action OrphanAction, dryRun bool) (*ReapReport, error) {
+	if action == "" {
+		action = r.config.Action
+	}
+	if !action.IsValid() {
+		return nil, fmt.Errorf("invalid orphan action: %s", action)
+	}
+
+	records, err := r.store.ListByInstance(ctx, r.config.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReapReport{
+		InstanceID:  r.config.InstanceID,
+		Action:      action,
+		DryRun:      dryRun,
+		Entries:     []ReapEntry{},
+		GeneratedAt: time.Now(),
+	}
+	for _, record := range records {
+		if r.isLive(record.PID) {
+			continue
+		}
+
+		entry := ReapEntry{
+			PID:       record.PID,
+			Command:   record.Command,
+			Args:      record.Args,
+			StartedAt: record.StartedAt,
+		}
+		entry.Result, err = r.reapOne(ctx, record, action, dryRun)
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		if entry.Result != ReapResultStale && entry.Result != ReapResultSkipped {
+			report.Orphans++
+		}
+		report.Entries = append(report.Entries, entry)
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"instance": r.config.InstanceID,
+		"action":   action,
+		"dry_run":  dryRun,
+		"orphans":  report.Orphans,
+		"records":  len(report.Entries),
+	}).Info("고아 프로세스 점검 완료")
+
+	return report, nil
+}
+
+// PurgeInstances 더 이상 실행 중이 아닌 다른 인스턴스의 프로세스 기록을 삭제
+// 레플리카 여러 대가 스토리지를 공유할 때 리더 인스턴스가 주기적으로 호출합니다.
+// 다른 호스트의 프로세스에는 신호를 보낼 수 없으므로 기록만 정리하며, 삭제한 기록 수를 반환합니다.
+func (r *ProcessReaper) PurgeInstances(ctx context.Context, alive func(ctx context.Context, instanceID string) (bool, error)) (int, error) {
+	instances, err := r.store.ListInstances(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, instanceID := range instances {
+		if instanceID == r.config.InstanceID {
+			continue
+		}
+		ok, err := alive(ctx, instanceID)
+		if err != nil {
+			return purged, err
+		}
+		if ok {
+			continue
+		}
+
+		records, err := r.store.ListByInstance(ctx, instanceID)
+		if err != nil {
+			return purged, err
+		}
+		for _, record := range records {
+			if err := r.store.Delete(ctx, instanceID, record.PID); err != nil && !storage.IsNotFoundError(err) {
+				return purged, err
+			}
+			purged++
+		}
+		r.logger.WithFields(logrus.Fields{
+			"instance": instanceID,
+			"records":  len(records),
+		}).Info("종료된 인스턴스의 프로세스 기록을 정리했습니다")
+	}
+	return purged, nil
+}
+
+// reapOne 프로세스 기록 하나를 점검하고 처리
+func (r *ProcessReaper) reapOne(ctx context.Context, record *models.ProcessRecord, action OrphanAction, dryRun bool) (ReapResult, error) {
+	if record.Fingerprint == "" {
+		return ReapResultSkipped, errors.New("fingerprint not recorded")
+	}
+
+	current, err := r.fingerprint(record.PID)
+	if errors.Is(err, ErrFingerprintUnsupported) {
+		return ReapResultSkipped, err
+	}
+	if err != nil || current != record.Fingerprint {
+		// 이미 종료되었거나 다른 프로세스가 PID를 재사용 중
+		if !dryRun {
+			if err := r.store.Delete(ctx, record.InstanceID, record.PID); err != nil && !storage.IsNotFoundError(err) {
+				return ReapResultStale, err
+			}
+		}
+		return ReapResultStale, nil
+	}
+
+	if dryRun {
+		return ReapResultOrphan, nil
+	}
+
+	switch action {
+	case OrphanActionAdopt:
+		if err := r.adopt(record); err != nil {
+			return ReapResultFailed, err
+		}
+		return ReapResultAdopted, nil
+	default:
+		if err := r.kill(ctx, record.PID, record.Fingerprint); err != nil {
+			return ReapResultFailed, err
+		}
+		if err := r.store.Delete(ctx, record.InstanceID, record.PID); err != nil && !storage.IsNotFoundError(err) {
+			return ReapResultKilled, err
+		}
+		return ReapResultKilled, nil
+	}
+}
+
+// kill SIGTERM을 보내고 제한 시간 안에 종료되지 않으면 SIGKILL
+func (r *ProcessReaper) kill(ctx context.Context, pid int, fingerprint string) error {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	if err := process.Signal(syscall.SIGTERM); err != nil {
+		return process.Kill()
+	}
+
+	deadline := time.Now().Add(r.config.KillTimeout)
+	for time.Now().Before(deadline) {
+		if !r.running(pid, fingerprint) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.config.PollInterval):
+		}
+	}
+
+	r.logger.WithField("pid", pid).Warn("고아 프로세스가 SIGTERM에 응답하지 않아 강제 종료합니다")
+	return process.Kill()
+}
+
+// adopt 고아 프로세스를 현재 인스턴스가 관리하도록 기록하고 종료될 때까지 감시
+// 표준 입출력은 다시 연결할 수 없으므로 종료 감시와 기록 정리만 담당합니다.
+func (r *ProcessReaper) adopt(record *models.ProcessRecord) error {
+	now := time.Now()
+	adopted := *record
+	adopted.AdoptedAt = &now
+	if err := r.store.Save(context.Background(), &adopted); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.live[record.PID] = record.Fingerprint
+	r.mu.Unlock()
+
+	go r.watch(record.PID, record.Fingerprint)
+	return nil
+}
+
+// watch 재관리 중인 프로세스가 종료되면 기록 삭제
+func (r *ProcessReaper) watch(pid int, fingerprint string) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.running(pid, fingerprint) {
+				r.logger.WithField("pid", pid).Info("재관리 중인 프로세스가 종료되었습니다")
+				r.UntrackProcess(pid)
+				return
+			}
+		}
+	}
+}
+
+// running 지문이 같은 프로세스가 아직 실행 중인지 확인
+func (r *ProcessReaper) running(pid int, fingerprint string) bool {
+	current, err := r.fingerprint(pid)
+	return err == nil && current == fingerprint
+}
+
+// isLive 현재 인스턴스가 관리 중인 PID인지 확인
+func (r *ProcessReaper) isLive(pid int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.live[pid]
+	return ok
+}
+
+// Stop 재관리 프로세스 감시 중지 (프로세스와 기록은 그대로 둠)
+func (r *ProcessReaper) Stop() {
+	r.cancel()
+}
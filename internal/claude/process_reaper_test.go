@@ -0,0 +1,185 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// startOrphan 이전 서버 인스턴스가 남긴 것처럼 기록된 프로세스를 실행
+func startOrphan(t *testing.T, previous *ProcessReaper) *exec.Cmd {
+	cmd := exec.Command("sleep", "30")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	previous.TrackProcess(ProcessInfo{PID: cmd.Process.Pid, Command: "sleep", Args: []string{"30"}, StartedAt: time.Now()})
+	return cmd
+}
+
+func newTestReaper(t *testing.T, store *memory.Storage, action OrphanAction) *ProcessReaper {
+	reaper, err := NewProcessReaper(store.Process(), ProcessReaperConfig{
+		InstanceID:   "node-1",
+		Action:       action,
+		KillTimeout:  2 * time.Second,
+		PollInterval: 10 * time.Millisecond,
+	}, logrus.New())
+	require.NoError(t, err)
+	t.Cleanup(reaper.Stop)
+	return reaper
+}
+
+func TestProcessReaper_KillOrphans(t *testing.T) {
+	if _, err := processFingerprint(1); errors.Is(err, ErrFingerprintUnsupported) {
+		t.Skip("프로세스 지문을 지원하지 않는 플랫폼")
+	}
+
+	ctx := context.Background()
+	store := memory.New()
+	orphan := startOrphan(t, newTestReaper(t, store, OrphanActionKill))
+
+	// 서버 재시작 후 새 정리기
+	reaper := newTestReaper(t, store, OrphanActionKill)
+
+	// dry-run은 보고만 함
+	report, err := reaper.Reap(ctx, "", true)
+	require.NoError(t, err)
+	require.Len(t, report.Entries, 1)
+	assert.Equal(t, 1, report.Orphans)
+	assert.Equal(t, ReapResultOrphan, report.Entries[0].Result)
+	assert.True(t, reaper.running(orphan.Process.Pid, mustFingerprint(t, orphan.Process.Pid)))
+
+	report, err = reaper.Reap(ctx, "", false)
+	require.NoError(t, err)
+	require.Len(t, report.Entries, 1)
+	assert.Equal(t, ReapResultKilled, report.Entries[0].Result)
+
+	records, err := store.Process().ListByInstance(ctx, "node-1")
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestProcessReaper_StaleAndReusedPIDs(t *testing.T) {
+	if _, err := processFingerprint(1); errors.Is(err, ErrFingerprintUnsupported) {
+		t.Skip("프로세스 지문을 지원하지 않는 플랫폼")
+	}
+
+	ctx := context.Background()
+	store := memory.New()
+	previous := newTestReaper(t, store, OrphanActionKill)
+	orphan := startOrphan(t, previous)
+
+	// PID가 다른 프로세스에 재사용된 것처럼 지문을 바꿈
+	records, err := store.Process().ListByInstance(ctx, "node-1")
+	require.NoError(t, err)
+	records[0].Fingerprint = "other-boot:1"
+	require.NoError(t, store.Process().Save(ctx, records[0]))
+
+	reaper := newTestReaper(t, store, OrphanActionKill)
+	report, err := reaper.Reap(ctx, "", false)
+	require.NoError(t, err)
+	require.Len(t, report.Entries, 1)
+	assert.Equal(t, ReapResultStale, report.Entries[0].Result)
+	assert.Equal(t, 0, report.Orphans)
+
+	// 지문이 다른 프로세스는 종료하지 않음
+	assert.True(t, reaper.running(orphan.Process.Pid, mustFingerprint(t, orphan.Process.Pid)))
+}
+
+func TestProcessReaper_AdoptAndSkipLive(t *testing.T) {
+	if _, err := processFingerprint(1); errors.Is(err, ErrFingerprintUnsupported) {
+		t.Skip("프로세스 지문을 지원하지 않는 플랫폼")
+	}
+
+	ctx := context.Background()
+	store := memory.New()
+	orphan := startOrphan(t, newTestReaper(t, store, OrphanActionKill))
+
+	reaper := newTestReaper(t, store, OrphanActionAdopt)
+	report, err := reaper.Reap(ctx, "", false)
+	require.NoError(t, err)
+	require.Len(t, report.Entries, 1)
+	assert.Equal(t, ReapResultAdopted, report.Entries[0].Result)
+
+	records, err := store.Process().ListByInstance(ctx, "node-1")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.NotNil(t, records[0].AdoptedAt)
+
+	// 재관리 중인 프로세스는 다시 점검하지 않음
+	report, err = reaper.Reap(ctx, OrphanActionKill, false)
+	require.NoError(t, err)
+	assert.Empty(t, report.Entries)
+
+	// 종료되면 기록 정리
+	require.NoError(t, orphan.Process.Kill())
+	_ = orphan.Wait()
+	require.Eventually(t, func() bool {
+		records, _ := store.Process().ListByInstance(ctx, "node-1")
+		return len(records) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestProcessManager_TracksLaunchedProcesses(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	reaper := newTestReaper(t, store, OrphanActionKill)
+
+	pm := NewProcessManagerWithTracker(logging.FromLogrus(logrus.New()), reaper)
+	require.NoError(t, pm.Start(ctx, &ProcessConfig{Command: "sleep", Args: []string{"30"}}))
+
+	records, err := store.Process().ListByInstance(ctx, "node-1")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, pm.GetPID(), records[0].PID)
+
+	require.NoError(t, pm.Kill())
+	require.Eventually(t, func() bool {
+		records, _ := store.Process().ListByInstance(ctx, "node-1")
+		return len(records) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestProcessReaper_PurgeInstances(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	reaper := newTestReaper(t, store, OrphanActionKill)
+
+	for _, record := range []*models.ProcessRecord{
+		{InstanceID: "node-1", PID: 100, StartedAt: time.Now()},
+		{InstanceID: "node-2", PID: 200, StartedAt: time.Now()},
+		{InstanceID: "node-3", PID: 300, StartedAt: time.Now()},
+		{InstanceID: "node-3", PID: 301, StartedAt: time.Now()},
+	} {
+		require.NoError(t, store.Process().Save(ctx, record))
+	}
+
+	// node-2만 실행 중, 현재 인스턴스(node-1)의 기록은 건드리지 않음
+	purged, err := reaper.PurgeInstances(ctx, func(ctx context.Context, instanceID string) (bool, error) {
+		return instanceID == "node-2", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, purged)
+
+	instances, err := store.Process().ListInstances(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"node-1", "node-2"}, instances)
+}
+
+func mustFingerprint(t *testing.T, pid int) string {
+	fingerprint, err := processFingerprint(pid)
+	require.NoError(t, err)
+	return fingerprint
+}
@@ -0,0 +1,756 @@
+package claude
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// 쿼터 거버너 기본값
+const (
+	DefaultQuotaNearRatio      = 0.9
+	DefaultQuotaCooldown       = time.Minute
+	DefaultQuotaAuthQuarantine = 30 * time.Minute
+	DefaultQuotaMaxDelay       = 30 * time.Second
+
+	// quotaWindow 분당 요청 수를 세는 구간
+	quotaWindow = time.Minute
+	// quotaPollInterval 동시 실행 한도에 걸렸을 때 다시 확인하는 주기
+	quotaPollInterval = time.Second
+)
+
+// quotaBudgetAlertLevels 예산 알림을 보내는 오늘 사용량 비율 (%)
+var quotaBudgetAlertLevels = []int{80, 100}
+
+// QuotaBudgetAlert 자격 증명의 오늘 사용량이 일별 예산의 알림 비율에 도달함
+type QuotaBudgetAlert struct {
+	Credential       string    `json:"credential"`
+	Percent          int       `json:"percent"` // 도달한 알림 비율 (80, 100)
+	Day              time.Time `json:"day"`
+	TokensToday      int64     `json:"tokens_today"`
+	DailyTokenBudget int64     `json:"daily_token_budget,omitempty"`
+	CostTodayUSD     float64   `json:"cost_today_usd"`
+	DailyBudgetUSD   float64   `json:"daily_budget_usd,omitempty"`
+}
+
+// QuotaBudgetAlertHandler 예산 알림을 받는 함수 (요청 처리 경로에서 호출되므로 오래 걸리는 작업은 비동기로)
+type QuotaBudgetAlertHandler func(alert QuotaBudgetAlert)
+
+// QuotaSignalKind 프로바이더 한도 신호 종류
+type QuotaSignalKind string
+
+const (
+	QuotaSignalRateLimit  QuotaSignalKind = "rate_limit" // 키별 요청 한도 초과 (429)
+	QuotaSignalOverloaded QuotaSignalKind = "overloaded" // 프로바이더 과부하 (529)
+)
+
+// QuotaSignal CLI 출력에서 읽은 한도 초과 정보
+type QuotaSignal struct {
+	Kind QuotaSignalKind `json:"kind"`
+	// RetryAfter 프로바이더가 알려 준 재시도 대기 시간 (알 수 없으면 0)
+	RetryAfter time.Duration `json:"retry_after,omitempty"`
+}
+
+var (
+	quotaOverloadedPattern = regexp.MustCompile(`(?i)overloaded|api error:?\s*529\b`)
+	quotaRetryAfterPattern = regexp.MustCompile(`(?i)retry[-_ ]after["':\s]*(\d+(?:\.\d+)?)`)
+	quotaTryAgainPattern   = regexp.MustCompile(`(?i)try again in\s*(\d+(?:\.\d+)?)\s*(ms|milliseconds?|s|secs?|seconds?|m|mins?|minutes?)\b`)
+)
+
+// ParseQuotaSignal CLI 에러 출력에서 요청 한도 초과나 과부하 신호를 찾습니다
+func ParseQuotaSignal(output string) (QuotaSignal, bool) {
+	if !IsModelUnavailable(output) {
+		return QuotaSignal{}, false
+	}
+
+	signal := QuotaSignal{Kind: QuotaSignalRateLimit}
+	if quotaOverloadedPattern.MatchString(output) {
+		signal.Kind = QuotaSignalOverloaded
+	}
+	if m := quotaRetryAfterPattern.FindStringSubmatch(output); m != nil {
+		signal.RetryAfter = parseQuotaDuration(m[1], "s")
+	} else if m := quotaTryAgainPattern.FindStringSubmatch(output); m != nil {
+		signal.RetryAfter = parseQuotaDuration(m[1], m[2])
+	}
+	return signal, true
+}
+
+// parseQuotaDuration 숫자와 단위로 대기 시간 계산
+func parseQuotaDuration(value, unit string) time.Duration {
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	unit = strings.ToLower(unit)
+	switch {
+	case strings.HasPrefix(unit, "ms"), strings.HasPrefix(unit, "milli"):
+		return time.Duration(n * float64(time.Millisecond))
+	case strings.HasPrefix(unit, "m"):
+		return time.Duration(n * float64(time.Minute))
+	default:
+		return time.Duration(n * float64(time.Second))
+	}
+}
+
+// authFailurePattern CLI 출력에서 자격 증명 자체가 거부되었음을 나타내는 패턴
+var authFailurePattern = regexp.MustCompile(`(?i)authentication_error|permission_error|api error:?\s*(401|403)\b|invalid (x-)?api[ -]?key|oauth token (has )?expired|invalid bearer token`)
+
+// IsAuthFailure CLI 에러 출력이 인증 실패(잘못되었거나 만료된 키, 권한 없음)인지 판단합니다
+func IsAuthFailure(output string) bool {
+	return output != "" && authFailurePattern.MatchString(output)
+}
+
+// IsCredentialFailure 다른 자격 증명으로 다시 시도할 만한 실패인지 판단합니다 (인증 실패, 한도 초과, 과부하)
+func IsCredentialFailure(output string) bool {
+	return IsAuthFailure(output) || IsModelUnavailable(output)
+}
+
+// CLIUsage CLI 실행 결과 메시지에서 읽은 토큰 사용량과 비용
+type CLIUsage struct {
+	AgentUsage
+	CostUSD float64 `json:"cost_usd"`
+}
+
+// Tokens 입력, 출력, 캐시 토큰 합계
+func (u CLIUsage) Tokens() int64 {
+	return int64(u.InputTokens + u.OutputTokens + u.CacheCreationTokens + u.CacheReadTokens)
+}
+
+// cliResultMessage --output-format json/stream-json 출력의 최종 결과 메시지
+type cliResultMessage struct {
+	Type         string  `json:"type"`
+	TotalCostUSD float64 `json:"total_cost_usd"`
+	Usage        *struct {
+		InputTokens              int `json:"input_tokens"`
+		OutputTokens             int `json:"output_tokens"`
+		CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+		CacheReadInputTokens     int `json:"cache_read_input_tokens"`
+	} `json:"usage"`
+}
+
+// ExtractCLIUsage CLI 출력의 마지막 결과 메시지에서 사용량을 추출합니다
+// JSON 출력 형식이 아니면 사용량을 알 수 없으므로 false를 반환합니다.
+func ExtractCLIUsage(output string) (CLIUsage, bool) {
+	var (
+		usage CLIUsage
+		found bool
+	)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "{") || !strings.Contains(line, `"result"`) {
+			continue
+		}
+		var msg cliResultMessage
+		if err := json.Unmarshal([]byte(line), &msg); err != nil || msg.Type != "result" {
+			continue
+		}
+		usage = CLIUsage{CostUSD: msg.TotalCostUSD}
+		if msg.Usage != nil {
+			usage.InputTokens = msg.Usage.InputTokens
+			usage.OutputTokens = msg.Usage.OutputTokens
+			usage.CacheCreationTokens = msg.Usage.CacheCreationInputTokens
+			usage.CacheReadTokens = msg.Usage.CacheReadInputTokens
+		}
+		found = true
+	}
+	return usage, found
+}
+
+// QuotaCredential 부하를 나눠 쓸 Claude 자격 증명 하나
+type QuotaCredential struct {
+	// Name 로그와 상태 조회에 쓰는 이름 (키 값은 노출하지 않음)
+	Name string `json:"name"`
+	// OAuthToken OAuth 토큰 (있으면 APIKey보다 우선)
+	OAuthToken string `json:"-"`
+	// APIKey Claude API 키
+	APIKey string `json:"-"`
+	// RequestsPerMinute 프로바이더의 분당 요청 한도 (0이면 요청 수로 제한하지 않음)
+	RequestsPerMinute int `json:"requests_per_minute"`
+	// MaxConcurrent 동시에 실행할 수 있는 요청 수 (0이면 제한 없음)
+	MaxConcurrent int `json:"max_concurrent"`
+	// DailyTokenBudget 하루(UTC)에 쓸 수 있는 토큰 수 (0이면 제한 없음)
+	DailyTokenBudget int64 `json:"daily_token_budget,omitempty"`
+	// DailyBudgetUSD 하루(UTC)에 쓸 수 있는 비용 (0이면 제한 없음)
+	DailyBudgetUSD float64 `json:"daily_budget_usd,omitempty"`
+}
+
+// Environment 프로세스에 넘길 인증 환경 변수
+func (c QuotaCredential) Environment() map[string]string {
+	if c.OAuthToken != "" {
+		return map[string]string{"CLAUDE_CODE_OAUTH_TOKEN": c.OAuthToken}
+	}
+	if c.APIKey != "" {
+		return map[string]string{"CLAUDE_API_KEY": c.APIKey}
+	}
+	return nil
+}
+
+// QuotaGovernorConfig 쿼터 거버너 설정
+type QuotaGovernorConfig struct {
+	// Credentials 부하를 나눠 쓸 자격 증명 목록
+	Credentials []QuotaCredential
+	// NearRatio 분당 한도 대비 이 비율에 도달하면 한도에 가까운 것으로 보고 다른 자격 증명을 사용
+	NearRatio float64
+	// Cooldown 한도 초과나 과부하 응답에 재시도 시간이 없을 때 자격 증명을 격리하는 시간
+	Cooldown time.Duration
+	// AuthQuarantine 인증 실패 응답을 받은 자격 증명을 격리하는 시간 (Reinstate로 먼저 해제 가능)
+	AuthQuarantine time.Duration
+	// MaxDelay 사용 가능한 자격 증명을 기다리는 최대 시간 (더 오래 기다려야 하면 QuotaWaitError)
+	MaxDelay time.Duration
+	// Registerer Prometheus 메트릭 등록 대상 (nil이면 등록하지 않음)
+	Registerer prometheus.Registerer
+}
+
+// QuotaWaitError 모든 자격 증명이 한도에 가까워 MaxDelay보다 오래 기다려야 함
+type QuotaWaitError struct {
+	Wait time.Duration
+}
+
+func (e *QuotaWaitError) Error() string {
+	return fmt.Sprintf("all Claude credentials are near their rate limits, retry in %s", e.Wait.Round(time.Second))
+}
+
+// ErrUnknownCredential 이름에 해당하는 자격 증명이 없음
+var ErrUnknownCredential = errors.New("unknown credential")
+
+// CredentialHealth 자격 증명 상태
+type CredentialHealth string
+
+const (
+	CredentialHealthy         CredentialHealth = "healthy"
+	CredentialQuarantined     CredentialHealth = "quarantined"      // 인증 실패, 한도 초과, 과부하로 격리 중
+	CredentialBudgetExhausted CredentialHealth = "budget_exhausted" // 오늘 예산 소진
+)
+
+// 격리 사유 (QuotaSignalKind 외에 인증 실패)
+const quarantineAuth = "auth"
+
+// QuotaCredentialStatus 자격 증명별 쿼터 상태
+type QuotaCredentialStatus struct {
+	Name               string           `json:"name"`
+	Health             CredentialHealth `json:"health"`
+	RequestsPerMinute  int              `json:"requests_per_minute"`
+	RequestsLastMinute int              `json:"requests_last_minute"`
+	MaxConcurrent      int              `json:"max_concurrent"`
+	InFlight           int              `json:"in_flight"`
+	BlockedUntil       *time.Time       `json:"blocked_until,omitempty"`
+	BlockReason        string           `json:"block_reason,omitempty"`
+	LastSignal         *QuotaSignal     `json:"last_signal,omitempty"`
+	RateLimited        int64            `json:"rate_limited"`
+	AuthFailures       int64            `json:"auth_failures"`
+	Requests           int64            `json:"requests"`
+	Failures           int64            `json:"failures"`
+	DailyTokenBudget   int64            `json:"daily_token_budget,omitempty"`
+	DailyBudgetUSD     float64          `json:"daily_budget_usd,omitempty"`
+	TokensToday        int64            `json:"tokens_today"`
+	CostTodayUSD       float64          `json:"cost_today_usd"`
+	TotalTokens        int64            `json:"total_tokens"`
+	TotalCostUSD       float64          `json:"total_cost_usd"`
+}
+
+// quotaState 자격 증명 하나의 사용 기록
+type quotaState struct {
+	credential   QuotaCredential
+	requests     []time.Time
+	inFlight     int
+	blockedUntil time.Time
+	blockReason  string
+	lastSignal   *QuotaSignal
+	rateLimited  int64
+	authFailures int64
+	total        int64
+	failures     int64
+
+	// 일별 예산 집계 (budgetDay가 바뀌면 초기화)
+	budgetDay   time.Time
+	tokensToday int64
+	costToday   float64
+	tokensTotal int64
+	costTotal   float64
+
+	// budgetAlerted 오늘 알린 가장 높은 예산 알림 비율
+	budgetAlerted int
+}
+
+// prune 집계 구간이 지난 요청 기록과 지난 날의 예산 집계 정리
+func (s *quotaState) prune(now time.Time) {
+	cut := 0
+	for cut < len(s.requests) && now.Sub(s.requests[cut]) >= quotaWindow {
+		cut++
+	}
+	s.requests = s.requests[cut:]
+
+	if day := quotaDay(now); !day.Equal(s.budgetDay) {
+		s.budgetDay = day
+		s.tokensToday = 0
+		s.costToday = 0
+		s.budgetAlerted = 0
+	}
+}
+
+// quotaDay 예산 집계 기준일 (UTC 자정)
+func quotaDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// budgetExhausted 오늘 예산을 모두 썼는지 확인
+func (s *quotaState) budgetExhausted() bool {
+	if budget := s.credential.DailyTokenBudget; budget > 0 && s.tokensToday >= budget {
+		return true
+	}
+	if budget := s.credential.DailyBudgetUSD; budget > 0 && s.costToday >= budget {
+		return true
+	}
+	return false
+}
+
+// budgetAlertLevel 오늘 사용량이 도달한 가장 높은 예산 알림 비율 (없으면 0)
+func (s *quotaState) budgetAlertLevel() int {
+	percent := 0.0
+	if budget := s.credential.DailyTokenBudget; budget > 0 {
+		percent = float64(s.tokensToday) / float64(budget) * 100
+	}
+	if budget := s.credential.DailyBudgetUSD; budget > 0 {
+		percent = math.Max(percent, s.costToday/budget*100)
+	}
+
+	level := 0
+	for _, threshold := range quotaBudgetAlertLevels {
+		if percent >= float64(threshold) {
+			level = threshold
+		}
+	}
+	return level
+}
+
+// wait 지금 사용할 수 없으면 다시 사용할 수 있을 때까지의 시간 (사용 가능하면 0)
+func (s *quotaState) wait(now time.Time, nearRatio float64) time.Duration {
+	if now.Before(s.blockedUntil) {
+		return s.blockedUntil.Sub(now)
+	}
+	if s.budgetExhausted() {
+		return s.budgetDay.Add(24 * time.Hour).Sub(now)
+	}
+	if rpm := s.credential.RequestsPerMinute; rpm > 0 {
+		near := int(float64(rpm) * nearRatio)
+		if near < 1 {
+			near = 1
+		}
+		if len(s.requests) >= near {
+			return s.requests[len(s.requests)-near].Add(quotaWindow).Sub(now)
+		}
+	}
+	if max := s.credential.MaxConcurrent; max > 0 && s.inFlight >= max {
+		return quotaPollInterval
+	}
+	return 0
+}
+
+// load 자격 증명 사용률 (요청 한도 대비 최근 요청 수, 한도가 없으면 동시 실행 수 기준)
+func (s *quotaState) load() float64 {
+	if rpm := s.credential.RequestsPerMinute; rpm > 0 {
+		return float64(len(s.requests)+s.inFlight) / float64(rpm)
+	}
+	return float64(s.inFlight)
+}
+
+// health 현재 상태
+func (s *quotaState) health(now time.Time) CredentialHealth {
+	switch {
+	case now.Before(s.blockedUntil):
+		return CredentialQuarantined
+	case s.budgetExhausted():
+		return CredentialBudgetExhausted
+	default:
+		return CredentialHealthy
+	}
+}
+
+// quotaMetrics 자격 증명별 사용량 메트릭
+type quotaMetrics struct {
+	requests    *prometheus.CounterVec
+	tokens      *prometheus.CounterVec
+	cost        *prometheus.CounterVec
+	inFlight    *prometheus.GaugeVec
+	quarantined *prometheus.GaugeVec
+}
+
+func newQuotaMetrics(reg prometheus.Registerer) *quotaMetrics {
+	return &quotaMetrics{
+		requests: registerCounterVec(reg, prometheus.CounterOpts{
+			Name: "aicli_claude_credential_requests_total",
+			Help: "Claude 자격 증명별 요청 수 (결과별: success, error, rate_limit, overloaded, auth)",
+		}, []string{"credential", "result"}),
+		tokens: registerCounterVec(reg, prometheus.CounterOpts{
+			Name: "aicli_claude_credential_tokens_total",
+			Help: "Claude 자격 증명별 사용 토큰 수 (종류별: input, output, cache_creation, cache_read)",
+		}, []string{"credential", "type"}),
+		cost: registerCounterVec(reg, prometheus.CounterOpts{
+			Name: "aicli_claude_credential_cost_usd_total",
+			Help: "Claude 자격 증명별 CLI가 보고한 누적 비용 (USD)",
+		}, []string{"credential"}),
+		inFlight: registerGaugeVec(reg, prometheus.GaugeOpts{
+			Name: "aicli_claude_credential_in_flight",
+			Help: "Claude 자격 증명별 실행 중인 요청 수",
+		}, []string{"credential"}),
+		quarantined: registerGaugeVec(reg, prometheus.GaugeOpts{
+			Name: "aicli_claude_credential_quarantined",
+			Help: "Claude 자격 증명 격리 여부 (1이면 격리 중)",
+		}, []string{"credential"}),
+	}
+}
+
+// registerGaugeVec 게이지 등록 (이미 등록되어 있으면 기존 게이지 사용)
+func registerGaugeVec(reg prometheus.Registerer, opts prometheus.GaugeOpts, labels []string) *prometheus.GaugeVec {
+	gauge := prometheus.NewGaugeVec(opts, labels)
+	if reg == nil {
+		return gauge
+	}
+
+	if err := reg.Register(gauge); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if errors.As(err, &registered) {
+			if existing, ok := registered.ExistingCollector.(*prometheus.GaugeVec); ok {
+				return existing
+			}
+		}
+	}
+	return gauge
+}
+
+// QuotaGovernor 여러 Claude 자격 증명을 풀로 관리하며 요청을 나눕니다
+// 자격 증명별 분당 요청 한도, 동시 실행 수, 일별 예산을 추적해 여유가 있는 자격 증명을 고르고,
+// 인증 실패나 한도 초과, 과부하 응답을 받은 자격 증명은 일정 시간 격리합니다. 모든 자격 증명을
+// 쓸 수 없으면 요청을 지연시키거나 QuotaWaitError로 나중에 다시 시도하도록 알립니다.
+type QuotaGovernor struct {
+	config  QuotaGovernorConfig
+	logger  *logrus.Logger
+	metrics *quotaMetrics
+	now     func() time.Time
+
+	mu          sync.Mutex
+	states      []*quotaState
+	next        int
+	budgetAlert QuotaBudgetAlertHandler
+}
+
+// NewQuotaGovernor 새 쿼터 거버너 생성
+func NewQuotaGovernor(config QuotaGovernorConfig, logger *logrus.Logger) (*QuotaGovernor, error) {
+	if len(config.Credentials) == 0 {
+		return nil, fmt.Errorf("at least one credential is required")
+	}
+	if config.NearRatio <= 0 || config.NearRatio > 1 {
+		config.NearRatio = DefaultQuotaNearRatio
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = DefaultQuotaCooldown
+	}
+	if config.AuthQuarantine <= 0 {
+		config.AuthQuarantine = DefaultQuotaAuthQuarantine
+	}
+	if config.MaxDelay < 0 {
+		config.MaxDelay = DefaultQuotaMaxDelay
+	}
+	if logger == nil {
+		logger = logrus.New()
+	}
+
+	g := &QuotaGovernor{config: config, logger: logger, metrics: newQuotaMetrics(config.Registerer), now: time.Now}
+	seen := make(map[string]bool, len(config.Credentials))
+	for i, credential := range config.Credentials {
+		if credential.OAuthToken == "" && credential.APIKey == "" {
+			return nil, fmt.Errorf("credential %d has neither an OAuth token nor an API key", i)
+		}
+		if credential.Name == "" {
+			credential.Name = fmt.Sprintf("credential-%d", i+1)
+		}
+		if seen[credential.Name] {
+			return nil, fmt.Errorf("duplicate credential name: %s", credential.Name)
+		}
+		if credential.DailyTokenBudget < 0 || credential.DailyBudgetUSD < 0 {
+			return nil, fmt.Errorf("credential %s has a negative budget", credential.Name)
+		}
+		seen[credential.Name] = true
+		g.states = append(g.states, &quotaState{credential: credential})
+		g.metrics.inFlight.WithLabelValues(credential.Name).Set(0)
+		g.metrics.quarantined.WithLabelValues(credential.Name).Set(0)
+	}
+	return g, nil
+}
+
+// SetBudgetAlertHandler 자격 증명의 오늘 사용량이 일별 예산의 80%, 100%에 도달할 때 호출할 함수 설정
+// 비율마다 하루(UTC)에 한 번씩 호출합니다.
+func (g *QuotaGovernor) SetBudgetAlertHandler(handler QuotaBudgetAlertHandler) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.budgetAlert = handler
+}
+
+// Size 풀에 있는 자격 증명 수
+func (g *QuotaGovernor) Size() int {
+	return len(g.states)
+}
+
+// Acquire 여유가 있는 자격 증명을 골라 요청 하나를 예약합니다
+// 모든 자격 증명이 한도에 가까우면 MaxDelay까지 기다리고, 그보다 오래 기다려야 하면
+// *QuotaWaitError를 반환합니다. 사용이 끝나면 반드시 QuotaLease.Release를 호출해야 합니다.
+func (g *QuotaGovernor) Acquire(ctx context.Context) (*QuotaLease, error) {
+	deadline := g.now().Add(g.config.MaxDelay)
+	for {
+		lease, wait := g.tryAcquire()
+		if lease != nil {
+			return lease, nil
+		}
+		if g.now().Add(wait).After(deadline) {
+			return nil, &QuotaWaitError{Wait: wait}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// tryAcquire 사용 가능한 자격 증명 중 사용률이 가장 낮은 것을 예약 (없으면 가장 짧은 대기 시간)
+func (g *QuotaGovernor) tryAcquire() (*QuotaLease, time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	var (
+		best    *quotaState
+		minWait time.Duration
+	)
+	// 사용률이 같으면 돌아가며 고르도록 마지막으로 고른 다음 자격 증명부터 확인
+	for i := range g.states {
+		state := g.states[(g.next+i)%len(g.states)]
+		g.refresh(state, now)
+		if wait := state.wait(now, g.config.NearRatio); wait > 0 {
+			if minWait == 0 || wait < minWait {
+				minWait = wait
+			}
+			continue
+		}
+		if best == nil || state.load() < best.load() {
+			best = state
+		}
+	}
+	if best == nil {
+		return nil, minWait
+	}
+
+	for i, state := range g.states {
+		if state == best {
+			g.next = i + 1
+		}
+	}
+	best.requests = append(best.requests, now)
+	best.inFlight++
+	best.total++
+	g.metrics.inFlight.WithLabelValues(best.credential.Name).Inc()
+	return &QuotaLease{governor: g, state: best}, 0
+}
+
+// refresh 지난 기록을 정리하고 격리가 끝났으면 메트릭 갱신 (mu를 잡은 상태에서 호출)
+func (g *QuotaGovernor) refresh(state *quotaState, now time.Time) {
+	state.prune(now)
+	if state.blockReason != "" && !now.Before(state.blockedUntil) {
+		state.blockReason = ""
+		g.metrics.quarantined.WithLabelValues(state.credential.Name).Set(0)
+	}
+}
+
+// release 요청 종료 기록
+// 사용량을 예산에 반영하고, 인증 실패나 한도 초과 신호가 있으면 자격 증명을 격리합니다.
+func (g *QuotaGovernor) release(state *quotaState, output string, err error) {
+	name := state.credential.Name
+	usage, hasUsage := ExtractCLIUsage(output)
+
+	result := "success"
+	var (
+		signal    QuotaSignal
+		limited   bool
+		authError bool
+	)
+	if err != nil {
+		result = "error"
+		combined := output + "\n" + err.Error()
+		if authError = IsAuthFailure(combined); authError {
+			result = quarantineAuth
+		} else if signal, limited = ParseQuotaSignal(combined); limited {
+			result = string(signal.Kind)
+		}
+	}
+
+	g.mu.Lock()
+	now := g.now()
+	g.refresh(state, now)
+	state.inFlight--
+	if err != nil {
+		state.failures++
+	}
+	var budgetAlert *QuotaBudgetAlert
+	if hasUsage {
+		state.tokensToday += usage.Tokens()
+		state.costToday += usage.CostUSD
+		state.tokensTotal += usage.Tokens()
+		state.costTotal += usage.CostUSD
+		if level := state.budgetAlertLevel(); level > state.budgetAlerted {
+			state.budgetAlerted = level
+			budgetAlert = &QuotaBudgetAlert{
+				Credential:       name,
+				Percent:          level,
+				Day:              state.budgetDay,
+				TokensToday:      state.tokensToday,
+				DailyTokenBudget: state.credential.DailyTokenBudget,
+				CostTodayUSD:     state.costToday,
+				DailyBudgetUSD:   state.credential.DailyBudgetUSD,
+			}
+		}
+	}
+	alertHandler := g.budgetAlert
+
+	var quarantine time.Duration
+	switch {
+	case authError:
+		quarantine = g.config.AuthQuarantine
+		state.authFailures++
+		g.block(state, now, quarantine, quarantineAuth)
+	case limited:
+		quarantine = signal.RetryAfter
+		if quarantine <= 0 {
+			quarantine = g.config.Cooldown
+		}
+		state.lastSignal = &signal
+		state.rateLimited++
+		g.block(state, now, quarantine, string(signal.Kind))
+	}
+	g.mu.Unlock()
+
+	g.metrics.requests.WithLabelValues(name, result).Inc()
+	g.metrics.inFlight.WithLabelValues(name).Dec()
+	if hasUsage {
+		g.metrics.tokens.WithLabelValues(name, "input").Add(float64(usage.InputTokens))
+		g.metrics.tokens.WithLabelValues(name, "output").Add(float64(usage.OutputTokens))
+		g.metrics.tokens.WithLabelValues(name, "cache_creation").Add(float64(usage.CacheCreationTokens))
+		g.metrics.tokens.WithLabelValues(name, "cache_read").Add(float64(usage.CacheReadTokens))
+		g.metrics.cost.WithLabelValues(name).Add(usage.CostUSD)
+	}
+	if quarantine > 0 {
+		g.logger.WithFields(logrus.Fields{
+			"credential": name,
+			"reason":     result,
+			"quarantine": quarantine,
+		}).Warn("Claude 자격 증명을 격리합니다")
+	}
+	if budgetAlert != nil {
+		g.logger.WithFields(logrus.Fields{
+			"credential": name,
+			"percent":    budgetAlert.Percent,
+		}).Warn("Claude 자격 증명의 일별 예산 알림 비율에 도달했습니다")
+		if alertHandler != nil {
+			alertHandler(*budgetAlert)
+		}
+	}
+}
+
+// block 자격 증명 격리 (이미 더 길게 격리되어 있으면 유지, mu를 잡은 상태에서 호출)
+func (g *QuotaGovernor) block(state *quotaState, now time.Time, duration time.Duration, reason string) {
+	if until := now.Add(duration); until.After(state.blockedUntil) {
+		state.blockedUntil = until
+		state.blockReason = reason
+	}
+	g.metrics.quarantined.WithLabelValues(state.credential.Name).Set(1)
+}
+
+// Reinstate 격리된 자격 증명을 바로 다시 사용하도록 해제합니다 (키를 교체한 뒤 등)
+func (g *QuotaGovernor) Reinstate(name string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, state := range g.states {
+		if state.credential.Name != name {
+			continue
+		}
+		state.blockedUntil = time.Time{}
+		state.blockReason = ""
+		g.metrics.quarantined.WithLabelValues(name).Set(0)
+		g.logger.WithField("credential", name).Info("Claude 자격 증명 격리 해제")
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownCredential, name)
+}
+
+// Status 자격 증명별 쿼터 상태 (이름순)
+func (g *QuotaGovernor) Status() []QuotaCredentialStatus {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	statuses := make([]QuotaCredentialStatus, 0, len(g.states))
+	for _, state := range g.states {
+		g.refresh(state, now)
+		status := QuotaCredentialStatus{
+			Name:               state.credential.Name,
+			Health:             state.health(now),
+			RequestsPerMinute:  state.credential.RequestsPerMinute,
+			RequestsLastMinute: len(state.requests),
+			MaxConcurrent:      state.credential.MaxConcurrent,
+			InFlight:           state.inFlight,
+			LastSignal:         state.lastSignal,
+			RateLimited:        state.rateLimited,
+			AuthFailures:       state.authFailures,
+			Requests:           state.total,
+			Failures:           state.failures,
+			DailyTokenBudget:   state.credential.DailyTokenBudget,
+			DailyBudgetUSD:     state.credential.DailyBudgetUSD,
+			TokensToday:        state.tokensToday,
+			CostTodayUSD:       state.costToday,
+			TotalTokens:        state.tokensTotal,
+			TotalCostUSD:       state.costTotal,
+		}
+		if now.Before(state.blockedUntil) {
+			until := state.blockedUntil
+			status.BlockedUntil = &until
+			status.BlockReason = state.blockReason
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// QuotaLease 예약한 요청 하나
+type QuotaLease struct {
+	governor *QuotaGovernor
+	state    *quotaState
+	once     sync.Once
+}
+
+// Credential 요청에 사용할 자격 증명
+func (l *QuotaLease) Credential() QuotaCredential {
+	return l.state.credential
+}
+
+// Release 요청 결과 기록 (여러 번 호출해도 한 번만 반영)
+// 출력에 CLI 결과 메시지가 있으면 토큰 사용량과 비용을 예산에 반영합니다.
+func (l *QuotaLease) Release(output string, err error) {
+	l.once.Do(func() {
+		l.governor.release(l.state, output, err)
+	})
+}
@@ -0,0 +1,266 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuotaSignal(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		ok     bool
+		kind   QuotaSignalKind
+		retry  time.Duration
+	}{
+		{"요청 한도와 retry-after", `API Error: 429 {"type":"rate_limit_error"} retry-after: 30`, true, QuotaSignalRateLimit, 30 * time.Second},
+		{"과부하", `API Error: 529 {"type":"overloaded_error","message":"Overloaded"}`, true, QuotaSignalOverloaded, 0},
+		{"분 단위 재시도 안내", "Rate limited. Please try again in 2 minutes.", true, QuotaSignalRateLimit, 2 * time.Minute},
+		{"일반 에러", "Error: invalid API key", false, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal, ok := ParseQuotaSignal(tt.output)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.kind, signal.Kind)
+			assert.Equal(t, tt.retry, signal.RetryAfter)
+		})
+	}
+}
+
+func newTestQuotaGovernor(t *testing.T, config QuotaGovernorConfig) (*QuotaGovernor, *time.Time) {
+	t.Helper()
+	g, err := NewQuotaGovernor(config, nil)
+	require.NoError(t, err)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+	return g, &now
+}
+
+func TestQuotaGovernor_SpreadsLoad(t *testing.T) {
+	g, _ := newTestQuotaGovernor(t, QuotaGovernorConfig{
+		Credentials: []QuotaCredential{
+			{Name: "a", APIKey: "key-a", RequestsPerMinute: 10},
+			{Name: "b", OAuthToken: "token-b", RequestsPerMinute: 10},
+		},
+	})
+
+	first, err := g.Acquire(context.Background())
+	require.NoError(t, err)
+	second, err := g.Acquire(context.Background())
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Credential().Name, second.Credential().Name)
+	assert.Equal(t, map[string]string{"CLAUDE_CODE_OAUTH_TOKEN": "token-b"}, QuotaCredential{OAuthToken: "token-b", APIKey: "key"}.Environment())
+
+	first.Release("", nil)
+	second.Release("", nil)
+	for _, status := range g.Status() {
+		assert.Equal(t, 1, status.RequestsLastMinute)
+		assert.Zero(t, status.InFlight)
+	}
+}
+
+func TestQuotaGovernor_NearLimit(t *testing.T) {
+	g, now := newTestQuotaGovernor(t, QuotaGovernorConfig{
+		Credentials: []QuotaCredential{{Name: "a", APIKey: "key-a", RequestsPerMinute: 2}},
+		NearRatio:   1,
+	})
+
+	for i := 0; i < 2; i++ {
+		lease, err := g.Acquire(context.Background())
+		require.NoError(t, err)
+		lease.Release("", nil)
+		*now = now.Add(10 * time.Second)
+	}
+
+	// 한도에 도달하면 가장 오래된 요청이 구간을 벗어날 때까지 기다려야 함
+	_, err := g.Acquire(context.Background())
+	var wait *QuotaWaitError
+	require.ErrorAs(t, err, &wait)
+	assert.Equal(t, 40*time.Second, wait.Wait)
+
+	*now = now.Add(40 * time.Second)
+	lease, err := g.Acquire(context.Background())
+	require.NoError(t, err)
+	lease.Release("", nil)
+}
+
+func TestQuotaGovernor_RateLimitCooldown(t *testing.T) {
+	g, now := newTestQuotaGovernor(t, QuotaGovernorConfig{
+		Credentials: []QuotaCredential{
+			{Name: "a", APIKey: "key-a"},
+			{Name: "b", APIKey: "key-b"},
+		},
+		Cooldown: time.Minute,
+	})
+
+	lease, err := g.Acquire(context.Background())
+	require.NoError(t, err)
+	limited := lease.Credential().Name
+	lease.Release("API Error: 429 rate_limit_error retry-after: 20", errors.New("exit status 1"))
+
+	// 한도에 걸린 자격 증명은 재시도 시간 동안 건너뜀
+	for i := 0; i < 3; i++ {
+		other, err := g.Acquire(context.Background())
+		require.NoError(t, err)
+		assert.NotEqual(t, limited, other.Credential().Name)
+		other.Release("", nil)
+	}
+
+	var blocked QuotaCredentialStatus
+	for _, status := range g.Status() {
+		if status.Name == limited {
+			blocked = status
+		}
+	}
+	require.NotNil(t, blocked.BlockedUntil)
+	assert.Equal(t, now.Add(20*time.Second), *blocked.BlockedUntil)
+	assert.Equal(t, int64(1), blocked.RateLimited)
+	require.NotNil(t, blocked.LastSignal)
+	assert.Equal(t, QuotaSignalRateLimit, blocked.LastSignal.Kind)
+}
+
+func TestNewQuotaGovernor_Validation(t *testing.T) {
+	_, err := NewQuotaGovernor(QuotaGovernorConfig{}, nil)
+	assert.Error(t, err)
+
+	_, err = NewQuotaGovernor(QuotaGovernorConfig{Credentials: []QuotaCredential{{Name: "a"}}}, nil)
+	assert.Error(t, err)
+
+	_, err = NewQuotaGovernor(QuotaGovernorConfig{Credentials: []QuotaCredential{
+		{Name: "a", APIKey: "key-a"},
+		{Name: "a", APIKey: "key-b"},
+	}}, nil)
+	assert.Error(t, err)
+}
+
+func TestExtractCLIUsage(t *testing.T) {
+	output := `{"type":"system","subtype":"init"}
+{"type":"assistant","message":{"content":[{"type":"text","text":"done"}]}}
+{"type":"result","subtype":"success","result":"done","total_cost_usd":0.25,"usage":{"input_tokens":1000,"output_tokens":200,"cache_creation_input_tokens":50,"cache_read_input_tokens":300}}`
+
+	usage, ok := ExtractCLIUsage(output)
+	require.True(t, ok)
+	assert.Equal(t, 1000, usage.InputTokens)
+	assert.Equal(t, 200, usage.OutputTokens)
+	assert.Equal(t, 50, usage.CacheCreationTokens)
+	assert.Equal(t, 300, usage.CacheReadTokens)
+	assert.Equal(t, int64(1550), usage.Tokens())
+	assert.InDelta(t, 0.25, usage.CostUSD, 1e-9)
+
+	_, ok = ExtractCLIUsage("plain text output")
+	assert.False(t, ok)
+}
+
+func TestQuotaGovernor_AuthQuarantine(t *testing.T) {
+	g, now := newTestQuotaGovernor(t, QuotaGovernorConfig{
+		Credentials: []QuotaCredential{
+			{Name: "a", APIKey: "key-a"},
+			{Name: "b", APIKey: "key-b"},
+		},
+		AuthQuarantine: time.Hour,
+	})
+
+	lease, err := g.Acquire(context.Background())
+	require.NoError(t, err)
+	bad := lease.Credential().Name
+	assert.True(t, IsCredentialFailure(`API Error: 401 {"type":"authentication_error","message":"invalid x-api-key"}`))
+	lease.Release(`API Error: 401 {"type":"authentication_error","message":"invalid x-api-key"}`, errors.New("exit status 1"))
+
+	var status QuotaCredentialStatus
+	for _, s := range g.Status() {
+		if s.Name == bad {
+			status = s
+		}
+	}
+	assert.Equal(t, CredentialQuarantined, status.Health)
+	assert.Equal(t, "auth", status.BlockReason)
+	assert.Equal(t, int64(1), status.AuthFailures)
+	assert.Equal(t, int64(1), status.Failures)
+	require.NotNil(t, status.BlockedUntil)
+	assert.Equal(t, now.Add(time.Hour), *status.BlockedUntil)
+
+	// 격리 해제 후 다시 사용 가능
+	require.NoError(t, g.Reinstate(bad))
+	for _, s := range g.Status() {
+		assert.Equal(t, CredentialHealthy, s.Health)
+	}
+	assert.ErrorIs(t, g.Reinstate("missing"), ErrUnknownCredential)
+}
+
+func TestQuotaGovernor_DailyBudget(t *testing.T) {
+	g, now := newTestQuotaGovernor(t, QuotaGovernorConfig{
+		Credentials: []QuotaCredential{{Name: "a", APIKey: "key-a", DailyBudgetUSD: 1}},
+	})
+
+	lease, err := g.Acquire(context.Background())
+	require.NoError(t, err)
+	lease.Release(`{"type":"result","total_cost_usd":1.5,"usage":{"input_tokens":10,"output_tokens":5}}`, nil)
+
+	status := g.Status()[0]
+	assert.Equal(t, CredentialBudgetExhausted, status.Health)
+	assert.Equal(t, int64(15), status.TokensToday)
+	assert.InDelta(t, 1.5, status.CostTodayUSD, 1e-9)
+
+	// 예산을 다 쓰면 다음 날(UTC 자정)까지 기다려야 함
+	*now = now.Add(6 * time.Hour)
+	_, err = g.Acquire(context.Background())
+	var wait *QuotaWaitError
+	require.ErrorAs(t, err, &wait)
+	assert.Equal(t, 18*time.Hour, wait.Wait)
+
+	// 날짜가 바뀌면 예산이 초기화되고 누적 사용량은 유지
+	*now = now.Add(18 * time.Hour)
+	lease, err = g.Acquire(context.Background())
+	require.NoError(t, err)
+	lease.Release("", nil)
+
+	status = g.Status()[0]
+	assert.Equal(t, CredentialHealthy, status.Health)
+	assert.Zero(t, status.TokensToday)
+	assert.Equal(t, int64(15), status.TotalTokens)
+	assert.Equal(t, int64(2), status.Requests)
+}
+
+func TestQuotaGovernor_BudgetAlerts(t *testing.T) {
+	g, now := newTestQuotaGovernor(t, QuotaGovernorConfig{
+		Credentials: []QuotaCredential{{Name: "a", APIKey: "key-a", DailyTokenBudget: 100}},
+	})
+	var alerts []QuotaBudgetAlert
+	g.SetBudgetAlertHandler(func(alert QuotaBudgetAlert) { alerts = append(alerts, alert) })
+
+	use := func(tokens int) {
+		lease, err := g.Acquire(context.Background())
+		require.NoError(t, err)
+		lease.Release(fmt.Sprintf(`{"type":"result","usage":{"input_tokens":%d,"output_tokens":0}}`, tokens), nil)
+	}
+
+	use(50)
+	assert.Empty(t, alerts)
+
+	// 80%와 100%는 하루에 한 번씩만 알림
+	use(35)
+	use(5)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, 80, alerts[0].Percent)
+	assert.Equal(t, int64(85), alerts[0].TokensToday)
+
+	use(10)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, 100, alerts[1].Percent)
+	assert.Equal(t, "a", alerts[1].Credential)
+
+	// 다음 날에는 다시 알림
+	*now = now.Add(24 * time.Hour)
+	use(120)
+	require.Len(t, alerts, 3)
+	assert.Equal(t, 100, alerts[2].Percent)
+	assert.True(t, alerts[2].Day.After(alerts[1].Day))
+}
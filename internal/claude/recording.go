@@ -0,0 +1,176 @@
+package claude
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RecordedLine 녹화된 에이전트 표준 출력 한 줄
+// 녹화 파일은 RecordedLine을 한 줄에 하나씩 담은 JSONL 형식입니다.
+type RecordedLine struct {
+	// OffsetMS 녹화 시작부터 이 줄을 읽을 때까지 걸린 시간 (밀리초)
+	OffsetMS int64 `json:"offset_ms"`
+	// Line 개행을 뺀 출력 한 줄
+	Line string `json:"line"`
+}
+
+// recordingReader 읽은 출력을 줄 단위로 녹화 파일에 기록하는 io.Reader
+type recordingReader struct {
+	reader  io.Reader
+	encoder *json.Encoder
+	closer  io.Closer
+	started time.Time
+	pending bytes.Buffer
+	done    bool
+}
+
+// NewRecordingReader 읽은 내용을 그대로 돌려주면서 완성된 줄마다 w에 RecordedLine으로 기록하는 Reader 생성
+// 스트림이 끝나면 마지막 미완성 줄까지 기록하고, w가 io.Closer이면 닫습니다.
+func NewRecordingReader(r io.Reader, w io.Writer) io.Reader {
+	rr := &recordingReader{
+		reader:  r,
+		encoder: json.NewEncoder(w),
+		started: time.Now(),
+	}
+	if closer, ok := w.(io.Closer); ok {
+		rr.closer = closer
+	}
+	return rr
+}
+
+func (r *recordingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 && !r.done {
+		r.pending.Write(p[:n])
+		for {
+			idx := bytes.IndexByte(r.pending.Bytes(), '\n')
+			if idx < 0 {
+				break
+			}
+			line := r.pending.Next(idx + 1)
+			r.record(string(bytes.TrimRight(line, "\r\n")))
+		}
+	}
+	if err != nil && !r.done {
+		r.done = true
+		if r.pending.Len() > 0 {
+			r.record(r.pending.String())
+			r.pending.Reset()
+		}
+		if r.closer != nil {
+			_ = r.closer.Close()
+		}
+	}
+	return n, err
+}
+
+// record 녹화 실패는 무시 (녹화 때문에 실제 스트림 처리가 실패하면 안 됨)
+func (r *recordingReader) record(line string) {
+	_ = r.encoder.Encode(RecordedLine{
+		OffsetMS: time.Since(r.started).Milliseconds(),
+		Line:     line,
+	})
+}
+
+// ReadRecording 녹화 파일 내용을 읽습니다
+func ReadRecording(r io.Reader) ([]RecordedLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	var lines []RecordedLine
+	for n := 1; scanner.Scan(); n++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var line RecordedLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			return nil, fmt.Errorf("recording line %d: %w", n, err)
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	return lines, nil
+}
+
+// LoadRecording 녹화 파일을 경로로 읽습니다
+func LoadRecording(path string) ([]RecordedLine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer f.Close()
+	return ReadRecording(f)
+}
+
+// NewReplayReader 녹화된 출력을 다시 내보내는 Reader 생성
+// realtime이 false이면 기다리지 않고 모든 줄을 바로 돌려주므로 테스트에서 결과가 항상 같습니다.
+// true이면 녹화된 시간 간격에 맞춰 줄을 내보냅니다.
+func NewReplayReader(lines []RecordedLine, realtime bool) io.Reader {
+	if !realtime {
+		var buf bytes.Buffer
+		for _, line := range lines {
+			buf.WriteString(line.Line)
+			buf.WriteByte('\n')
+		}
+		return &buf
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		started := time.Now()
+		for _, line := range lines {
+			if wait := time.Duration(line.OffsetMS)*time.Millisecond - time.Since(started); wait > 0 {
+				time.Sleep(wait)
+			}
+			if _, err := io.WriteString(pw, line.Line+"\n"); err != nil {
+				return
+			}
+		}
+		pw.Close()
+	}()
+	return pr
+}
+
+// RecordingRunner 다른 러너의 표준 출력을 녹화하는 AgentRunner
+// 스트림 파서를 만들 때마다 dir 아래에 <프로바이더>-<시각>-<번호>.jsonl 녹화 파일을 하나 만듭니다.
+type RecordingRunner struct {
+	AgentRunner
+
+	dir string
+	seq atomic.Uint64
+}
+
+// NewRecordingRunner runner의 출력을 dir에 녹화하는 러너 생성
+func NewRecordingRunner(runner AgentRunner, dir string) (*RecordingRunner, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory: %w", err)
+	}
+	return &RecordingRunner{AgentRunner: runner, dir: dir}, nil
+}
+
+// NewStreamParser 녹화 파일을 만들고 원래 러너의 파서에 녹화 Reader를 연결합니다
+// 녹화 파일을 만들 수 없으면 경고만 남기고 녹화 없이 파싱합니다.
+func (r *RecordingRunner) NewStreamParser(reader io.Reader, logger *logrus.Logger) AgentStreamParser {
+	name := fmt.Sprintf("%s-%s-%d.jsonl", r.Provider(), time.Now().UTC().Format("20060102T150405"), r.seq.Add(1))
+	path := filepath.Join(r.dir, name)
+
+	f, err := os.Create(path)
+	if err != nil {
+		logger.WithError(err).WithField("path", path).Warn("Failed to create agent recording")
+		return r.AgentRunner.NewStreamParser(reader, logger)
+	}
+
+	logger.WithField("path", path).Debug("Recording agent output")
+	return r.AgentRunner.NewStreamParser(NewRecordingReader(reader, f), logger)
+}
@@ -0,0 +1,105 @@
+package claude
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const recordedSession = `{"type":"text","content":"hello","message_id":"m1"}
+{"type":"text","content":"world","message_id":"m2"}
+{"type":"result","metadata":{"model":"claude-3-sonnet","usage":{"input_tokens":10,"output_tokens":20}}}`
+
+func collectResponses(t *testing.T, parser AgentStreamParser) []*Response {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	responses, errs := parser.ParseStream(ctx)
+	var got []*Response
+	for resp := range responses {
+		got = append(got, resp)
+	}
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	return got
+}
+
+func TestRecordingReader_RoundTrip(t *testing.T) {
+	var recording bytes.Buffer
+	reader := NewRecordingReader(strings.NewReader(recordedSession), &recording)
+
+	// 녹화하면서도 파서는 원래 출력을 그대로 읽음
+	original := collectResponses(t, NewJSONStreamParser(reader, logrus.New()))
+	require.Len(t, original, 3)
+
+	lines, err := ReadRecording(&recording)
+	require.NoError(t, err)
+	require.Len(t, lines, 3)
+	assert.Equal(t, `{"type":"text","content":"hello","message_id":"m1"}`, lines[0].Line)
+
+	// 재생 결과는 원래 파싱 결과와 같음
+	replayed := collectResponses(t, NewJSONStreamParser(NewReplayReader(lines, false), logrus.New()))
+	assert.Equal(t, original, replayed)
+}
+
+func TestReplayReader_Realtime(t *testing.T) {
+	lines := []RecordedLine{
+		{OffsetMS: 0, Line: "first"},
+		{OffsetMS: 50, Line: "second"},
+	}
+
+	started := time.Now()
+	data, err := io.ReadAll(NewReplayReader(lines, true))
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\n", string(data))
+	assert.GreaterOrEqual(t, time.Since(started), 50*time.Millisecond)
+}
+
+func TestReadRecording_InvalidLine(t *testing.T) {
+	_, err := ReadRecording(strings.NewReader("{\"offset_ms\":0,\"line\":\"ok\"}\nnot json\n"))
+	assert.ErrorContains(t, err, "recording line 2")
+}
+
+func TestRecordingRunner_WritesRecording(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "recordings")
+	runner, err := NewRecordingRunner(NewClaudeRunner(), dir)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultAgentProvider, runner.Provider())
+
+	responses := collectResponses(t, runner.NewStreamParser(strings.NewReader(recordedSession), logrus.New()))
+	require.Len(t, responses, 3)
+	usage, ok := runner.Usage(responses[2])
+	require.True(t, ok)
+	assert.Equal(t, 20, usage.OutputTokens)
+
+	files, err := filepath.Glob(filepath.Join(dir, DefaultAgentProvider+"-*.jsonl"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	lines, err := LoadRecording(files[0])
+	require.NoError(t, err)
+	require.Len(t, lines, 3)
+
+	data, err := io.ReadAll(NewReplayReader(lines, false))
+	require.NoError(t, err)
+	assert.Equal(t, recordedSession+"\n", string(data))
+}
+
+func TestRecordingRunner_UnwritableDirectory(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o644))
+
+	_, err := NewRecordingRunner(NewClaudeRunner(), filepath.Join(file, "recordings"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,394 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// 복구 동작 이름
+const (
+	RecoveryActionRestartProcess    = "restart_process"
+	RecoveryActionRecreateWorkspace = "recreate_workspace"
+	RecoveryActionReauthenticate    = "reauthenticate"
+)
+
+// ErrNoRecoveryHandler 에러 유형에 맞는 복구 동작이 없음
+var ErrNoRecoveryHandler = errors.New("no recovery handler for error")
+
+// ErrRecoveryInProgress 같은 세션의 복구가 이미 진행 중
+var ErrRecoveryInProgress = errors.New("recovery already in progress")
+
+// RecoveryRequest 복구 요청
+type RecoveryRequest struct {
+	SessionID   string
+	WorkspaceID string
+	// Process 복구할 프로세스 (비어 있으면 세션 또는 기본 프로세스 관리자에서 찾음)
+	Process ProcessManager
+	Err     error
+	// Class 에러 분류 (Type이 비어 있으면 Err를 분류)
+	Class ErrorClass
+}
+
+// RecoveryHandler 에러 유형에 연결되는 복구 동작
+type RecoveryHandler interface {
+	// Name 복구 동작 이름 (통계의 action 레이블)
+	Name() string
+	// CanHandle 요청에 필요한 대상이 있는지 확인
+	CanHandle(req *RecoveryRequest) bool
+	// Recover 복구 실행
+	Recover(ctx context.Context, req *RecoveryRequest) error
+}
+
+// RecoveryAttempt 복구 동작 하나의 실행 결과
+type RecoveryAttempt struct {
+	Action   string        `json:"action"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// RecoveryOutcome 복구 요청 처리 결과
+type RecoveryOutcome struct {
+	SessionID   string            `json:"session_id,omitempty"`
+	WorkspaceID string            `json:"workspace_id,omitempty"`
+	ErrorType   string            `json:"error_type"`
+	Recovered   bool              `json:"recovered"`
+	Action      string            `json:"action,omitempty"` // 성공한 복구 동작
+	Attempts    []RecoveryAttempt `json:"attempts"`
+}
+
+// RecoveryManagerConfig 복구 관리자 구성
+type RecoveryManagerConfig struct {
+	// Sessions 세션 ID로 워크스페이스와 프로세스를 찾을 때 사용
+	Sessions SessionManager
+	// Processes 세션에 프로세스가 없을 때 사용할 프로세스 관리자
+	Processes ProcessManager
+	// Classifier 에러 분류기 (nil이면 기본 분류 엔진)
+	Classifier ErrorClassifier
+	// Stats 복구 결과를 기록할 에러 통계 수집기 (nil이면 기록하지 않음)
+	Stats *ErrorStatisticsCollector
+	// Timeout 복구 요청 하나의 최대 시간
+	Timeout time.Duration
+}
+
+// RecoveryManager 에러 분류 결과에 따라 등록된 복구 동작을 차례로 실행합니다
+// 한 에러 유형에 여러 동작을 등록하면 등록 순서대로 시도하여 처음 성공한 동작에서 멈추고,
+// 각 시도 결과는 에러 통계 수집기에 기록합니다.
+type RecoveryManager struct {
+	config RecoveryManagerConfig
+	logger *logrus.Logger
+
+	mu       sync.RWMutex
+	handlers map[ErrorType][]RecoveryHandler
+
+	inflightMu sync.Mutex
+	inflight   map[string]struct{}
+}
+
+// NewRecoveryManager 새 복구 관리자 생성
+func NewRecoveryManager(config RecoveryManagerConfig, logger *logrus.Logger) *RecoveryManager {
+	if config.Classifier == nil {
+		config.Classifier = NewErrorClassificationEngine()
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 5 * time.Minute
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+
+	return &RecoveryManager{
+		config:   config,
+		logger:   logger,
+		handlers: make(map[ErrorType][]RecoveryHandler),
+		inflight: make(map[string]struct{}),
+	}
+}
+
+// Register 에러 유형에 복구 동작 추가
+func (m *RecoveryManager) Register(errorType ErrorType, handler RecoveryHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[errorType] = append(m.handlers[errorType], handler)
+}
+
+// RegisterDefaults 기본 복구 동작 등록 (workspaces나 refresh가 nil이면 해당 동작은 건너뜀)
+//   - 인증 에러: 토큰을 다시 받아 재인증
+//   - 프로세스 에러: 프로세스 재시작, 실패하면 워크스페이스 컨테이너 재생성
+//   - 리소스, 의존성 에러: 워크스페이스 컨테이너 재생성
+func (m *RecoveryManager) RegisterDefaults(workspaces WorkspaceRecreator, refresh TokenRefreshFunc) {
+	if refresh != nil {
+		m.Register(AuthError, &ReauthHandler{Refresh: refresh})
+	}
+	m.Register(ProcessError, &ProcessRestartHandler{})
+	if workspaces != nil {
+		recreate := &WorkspaceRecreateHandler{Workspaces: workspaces}
+		m.Register(ProcessError, recreate)
+		m.Register(ResourceError, recreate)
+		m.Register(DependencyError, recreate)
+	}
+}
+
+// Handlers 에러 유형에 등록된 복구 동작 이름
+func (m *RecoveryManager) Handlers(errorType ErrorType) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	names := make([]string, 0, len(m.handlers[errorType]))
+	for _, handler := range m.handlers[errorType] {
+		names = append(names, handler.Name())
+	}
+	return names
+}
+
+// Recover 에러를 분류하고 해당 유형의 복구 동작을 실행
+func (m *RecoveryManager) Recover(ctx context.Context, req RecoveryRequest) (*RecoveryOutcome, error) {
+	if req.Class.Type == ErrorTypeNone {
+		if req.Err == nil {
+			return nil, fmt.Errorf("error cannot be nil")
+		}
+		req.Class = m.config.Classifier.ClassifyError(req.Err)
+	}
+
+	if req.SessionID != "" {
+		if !m.acquire(req.SessionID) {
+			return nil, ErrRecoveryInProgress
+		}
+		defer m.release(req.SessionID)
+	}
+	m.resolve(&req)
+
+	m.mu.RLock()
+	handlers := append([]RecoveryHandler{}, m.handlers[req.Class.Type]...)
+	m.mu.RUnlock()
+
+	outcome := &RecoveryOutcome{
+		SessionID:   req.SessionID,
+		WorkspaceID: req.WorkspaceID,
+		ErrorType:   req.Class.Type.String(),
+		Attempts:    []RecoveryAttempt{},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.config.Timeout)
+	defer cancel()
+
+	var lastErr error
+	for _, handler := range handlers {
+		if !handler.CanHandle(&req) {
+			continue
+		}
+
+		start := time.Now()
+		err := handler.Recover(ctx, &req)
+		attempt := RecoveryAttempt{Action: handler.Name(), Duration: time.Since(start)}
+		if err != nil {
+			attempt.Error = err.Error()
+		}
+		outcome.Attempts = append(outcome.Attempts, attempt)
+
+		if m.config.Stats != nil {
+			m.config.Stats.RecordRecovery(req.Class, handler.Name(), err)
+		}
+
+		entry := m.logger.WithFields(logrus.Fields{
+			"session_id": req.SessionID,
+			"error_type": outcome.ErrorType,
+			"action":     handler.Name(),
+		})
+		if err == nil {
+			entry.Info("에러 복구에 성공했습니다")
+			outcome.Recovered = true
+			outcome.Action = handler.Name()
+			return outcome, nil
+		}
+		entry.WithError(err).Warn("에러 복구 동작 실패")
+		lastErr = err
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	if len(outcome.Attempts) == 0 {
+		return outcome, fmt.Errorf("%w: %s", ErrNoRecoveryHandler, outcome.ErrorType)
+	}
+	return outcome, fmt.Errorf("recovery failed for %s: %w", outcome.ErrorType, lastErr)
+}
+
+// OnSessionEvent 세션 에러와 자동 재시작으로 회복되지 않은 프로세스 이벤트에 대해 복구 실행
+// 일반적인 비정상 종료는 재시작 정책이 처리하므로 여기서는 다루지 않습니다.
+func (m *RecoveryManager) OnSessionEvent(event SessionEvent) {
+	var err error
+	switch event.Type {
+	case SessionEventError:
+		err = event.Error
+	case SessionEventProcess:
+		processEvent, ok := event.Data.(ProcessEvent)
+		if !ok || processEvent.Error == "" {
+			return
+		}
+		switch processEvent.Type {
+		case ProcessEventStartFailed, ProcessEventRestartExhausted:
+			err = errors.New(processEvent.Error)
+		}
+	}
+	if err == nil {
+		return
+	}
+
+	go func() {
+		_, recoverErr := m.Recover(context.Background(), RecoveryRequest{SessionID: event.SessionID, Err: err})
+		if recoverErr != nil && !errors.Is(recoverErr, ErrNoRecoveryHandler) && !errors.Is(recoverErr, ErrRecoveryInProgress) {
+			m.logger.WithError(recoverErr).WithField("session_id", event.SessionID).Warn("세션 에러 복구 실패")
+		}
+	}()
+}
+
+// resolve 세션 정보로 비어 있는 워크스페이스와 프로세스를 채움
+func (m *RecoveryManager) resolve(req *RecoveryRequest) {
+	if req.SessionID != "" && m.config.Sessions != nil && (req.WorkspaceID == "" || req.Process == nil) {
+		if session, err := m.config.Sessions.GetSession(req.SessionID); err == nil {
+			if req.WorkspaceID == "" {
+				req.WorkspaceID = session.WorkspaceID
+			}
+			if req.Process == nil {
+				req.Process = session.Process
+			}
+		}
+	}
+	if req.Process == nil {
+		req.Process = m.config.Processes
+	}
+}
+
+func (m *RecoveryManager) acquire(sessionID string) bool {
+	m.inflightMu.Lock()
+	defer m.inflightMu.Unlock()
+	if _, ok := m.inflight[sessionID]; ok {
+		return false
+	}
+	m.inflight[sessionID] = struct{}{}
+	return true
+}
+
+func (m *RecoveryManager) release(sessionID string) {
+	m.inflightMu.Lock()
+	delete(m.inflight, sessionID)
+	m.inflightMu.Unlock()
+}
+
+// ProcessRestartHandler Claude CLI 프로세스를 같은 설정으로 재시작합니다
+type ProcessRestartHandler struct{}
+
+// Name 복구 동작 이름
+func (h *ProcessRestartHandler) Name() string {
+	return RecoveryActionRestartProcess
+}
+
+// CanHandle 재시작할 프로세스가 있는지 확인
+func (h *ProcessRestartHandler) CanHandle(req *RecoveryRequest) bool {
+	return req.Process != nil
+}
+
+// Recover 프로세스 재시작
+func (h *ProcessRestartHandler) Recover(ctx context.Context, req *RecoveryRequest) error {
+	return req.Process.RestartProcess(req.SessionID)
+}
+
+// WorkspaceRecreator 워크스페이스 컨테이너를 지우고 다시 만드는 기능
+type WorkspaceRecreator interface {
+	RecreateWorkspace(ctx context.Context, workspaceID string) error
+}
+
+// WorkspaceRecreateHandler 워크스페이스 컨테이너를 다시 만든 뒤 프로세스를 재시작합니다
+type WorkspaceRecreateHandler struct {
+	Workspaces WorkspaceRecreator
+}
+
+// Name 복구 동작 이름
+func (h *WorkspaceRecreateHandler) Name() string {
+	return RecoveryActionRecreateWorkspace
+}
+
+// CanHandle 컨테이너를 다시 만들 워크스페이스가 있는지 확인
+func (h *WorkspaceRecreateHandler) CanHandle(req *RecoveryRequest) bool {
+	return h.Workspaces != nil && req.WorkspaceID != ""
+}
+
+// Recover 컨테이너 재생성 후 프로세스 재시작
+func (h *WorkspaceRecreateHandler) Recover(ctx context.Context, req *RecoveryRequest) error {
+	if err := h.Workspaces.RecreateWorkspace(ctx, req.WorkspaceID); err != nil {
+		return fmt.Errorf("recreate workspace %s: %w", req.WorkspaceID, err)
+	}
+	if req.Process == nil {
+		return nil
+	}
+	return req.Process.RestartProcess(req.SessionID)
+}
+
+// CredentialUpdater 다음 실행부터 사용할 인증 토큰을 교체할 수 있는 프로세스 관리자
+type CredentialUpdater interface {
+	UpdateCredentials(oauthToken string, expiresAt time.Time) error
+}
+
+// ReauthHandler 새 OAuth 토큰을 받아 Claude CLI 프로세스를 다시 인증합니다
+type ReauthHandler struct {
+	Refresh TokenRefreshFunc
+}
+
+// Name 복구 동작 이름
+func (h *ReauthHandler) Name() string {
+	return RecoveryActionReauthenticate
+}
+
+// CanHandle 토큰을 교체할 수 있는 프로세스인지 확인
+func (h *ReauthHandler) CanHandle(req *RecoveryRequest) bool {
+	if h.Refresh == nil || req.Process == nil {
+		return false
+	}
+	_, ok := req.Process.(CredentialUpdater)
+	return ok
+}
+
+// Recover 토큰 갱신 후 프로세스 재시작
+func (h *ReauthHandler) Recover(ctx context.Context, req *RecoveryRequest) error {
+	token, expiresAt, err := h.Refresh(ctx)
+	if err != nil {
+		return fmt.Errorf("refresh token: %w", err)
+	}
+	if token == "" {
+		return fmt.Errorf("refresh token: empty token")
+	}
+	if err := req.Process.(CredentialUpdater).UpdateCredentials(token, expiresAt); err != nil {
+		return err
+	}
+	return req.Process.RestartProcess(req.SessionID)
+}
+
+// NewFileTokenRefresher 파일에서 OAuth 토큰을 다시 읽는 갱신 함수 (비밀 관리 도구가 파일을 교체하는 경우)
+func NewFileTokenRefresher(path string, ttl time.Duration) TokenRefreshFunc {
+	return func(ctx context.Context) (string, time.Time, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		return strings.TrimSpace(string(data)), time.Now().Add(ttl), nil
+	}
+}
+
+// NewEnvTokenRefresher 환경 변수에서 OAuth 토큰을 다시 읽는 갱신 함수
+func NewEnvTokenRefresher(name string, ttl time.Duration) TokenRefreshFunc {
+	return func(ctx context.Context) (string, time.Time, error) {
+		token := os.Getenv(name)
+		if token == "" {
+			return "", time.Time{}, fmt.Errorf("environment variable %s is empty", name)
+		}
+		return token, time.Now().Add(ttl), nil
+	}
+}
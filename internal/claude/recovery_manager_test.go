@@ -0,0 +1,155 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// recoveryProcessStub 재시작과 토큰 교체만 기록하는 프로세스 관리자
+type recoveryProcessStub struct {
+	ProcessManager
+
+	mu         sync.Mutex
+	restarts   int
+	restartErr error
+	token      string
+}
+
+func (p *recoveryProcessStub) RestartProcess(identifier string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.restarts++
+	return p.restartErr
+}
+
+func (p *recoveryProcessStub) UpdateCredentials(oauthToken string, expiresAt time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.token = oauthToken
+	return nil
+}
+
+type workspaceRecreatorStub struct {
+	recreated []string
+	err       error
+}
+
+func (w *workspaceRecreatorStub) RecreateWorkspace(ctx context.Context, workspaceID string) error {
+	w.recreated = append(w.recreated, workspaceID)
+	return w.err
+}
+
+func newRecoveryTestManager(t *testing.T, process ProcessManager) (*RecoveryManager, *ErrorStatisticsCollector) {
+	t.Helper()
+	stats := NewErrorStatisticsCollector(memory.New().ErrorStats(), nil, ErrorStatisticsCollectorConfig{Registerer: prometheus.NewRegistry()}, logrus.New())
+	manager := NewRecoveryManager(RecoveryManagerConfig{Processes: process, Stats: stats}, logrus.New())
+	return manager, stats
+}
+
+func TestRecoveryManager_ProcessErrorFallsBackToRecreate(t *testing.T) {
+	process := &recoveryProcessStub{restartErr: errors.New("start failed")}
+	workspaces := &workspaceRecreatorStub{}
+	manager, stats := newRecoveryTestManager(t, process)
+	manager.RegisterDefaults(workspaces, nil)
+
+	assert.Equal(t, []string{RecoveryActionRestartProcess, RecoveryActionRecreateWorkspace}, manager.Handlers(ProcessError))
+	assert.Empty(t, manager.Handlers(AuthError))
+
+	// 재시작이 실패하면 컨테이너를 다시 만들고 재시작
+	outcome, err := manager.Recover(context.Background(), RecoveryRequest{
+		SessionID:   "session-1",
+		WorkspaceID: "ws-1",
+		Class:       ErrorClass{Type: ProcessError},
+	})
+	assert.Error(t, err)
+	require.NotNil(t, outcome)
+	assert.False(t, outcome.Recovered)
+	require.Len(t, outcome.Attempts, 2)
+	assert.Equal(t, []string{"ws-1"}, workspaces.recreated)
+	assert.Equal(t, 2, process.restarts)
+
+	process.restartErr = nil
+	outcome, err = manager.Recover(context.Background(), RecoveryRequest{
+		SessionID:   "session-1",
+		WorkspaceID: "ws-1",
+		Err:         errors.New("process exited with exit code 1"),
+	})
+	require.NoError(t, err)
+	assert.True(t, outcome.Recovered)
+	assert.Equal(t, RecoveryActionRestartProcess, outcome.Action)
+	assert.Equal(t, "process", outcome.ErrorType)
+
+	recoveries := stats.RecoveryStats()
+	require.Len(t, recoveries, 2)
+	assert.Equal(t, RecoveryStat{ErrorType: "process", Action: RecoveryActionRestartProcess, Succeeded: 1, Failed: 1, LastError: "start failed"}, withoutTime(recoveries[0]))
+	assert.Equal(t, int64(1), recoveries[1].Failed)
+	assert.Equal(t, float64(1), testutil.ToFloat64(stats.recoveriesTotal.WithLabelValues("process", RecoveryActionRestartProcess, "success")))
+}
+
+func TestRecoveryManager_Reauthenticate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("new-token\n"), 0600))
+
+	process := &recoveryProcessStub{}
+	manager, _ := newRecoveryTestManager(t, process)
+	manager.RegisterDefaults(nil, NewFileTokenRefresher(path, time.Hour))
+
+	outcome, err := manager.Recover(context.Background(), RecoveryRequest{
+		SessionID: "session-1",
+		Err:       errors.New("authentication failed: token expired"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, RecoveryActionReauthenticate, outcome.Action)
+	assert.Equal(t, "new-token", process.token)
+	assert.Equal(t, 1, process.restarts)
+}
+
+func TestRecoveryManager_NoHandler(t *testing.T) {
+	manager, _ := newRecoveryTestManager(t, nil)
+	manager.RegisterDefaults(nil, nil)
+
+	// 프로세스가 없으면 재시작할 수 없음
+	_, err := manager.Recover(context.Background(), RecoveryRequest{Class: ErrorClass{Type: ProcessError}})
+	assert.ErrorIs(t, err, ErrNoRecoveryHandler)
+
+	_, err = manager.Recover(context.Background(), RecoveryRequest{Class: ErrorClass{Type: QuotaError}})
+	assert.ErrorIs(t, err, ErrNoRecoveryHandler)
+
+	_, err = manager.Recover(context.Background(), RecoveryRequest{})
+	assert.Error(t, err)
+}
+
+func TestRecoveryManager_OnSessionEvent(t *testing.T) {
+	process := &recoveryProcessStub{}
+	manager, stats := newRecoveryTestManager(t, process)
+	manager.RegisterDefaults(nil, nil)
+
+	// 재시작 정책이 처리하는 비정상 종료는 무시
+	manager.OnSessionEvent(SessionEvent{SessionID: "s", Type: SessionEventProcess, Data: ProcessEvent{Type: ProcessEventCrashed, Error: "process killed"}})
+	manager.OnSessionEvent(SessionEvent{SessionID: "s", Type: SessionEventProcess, Data: ProcessEvent{Type: ProcessEventRestartExhausted, Error: "process exited with exit code 1"}})
+
+	require.Eventually(t, func() bool {
+		return len(stats.RecoveryStats()) == 1
+	}, time.Second, 10*time.Millisecond)
+	process.mu.Lock()
+	defer process.mu.Unlock()
+	assert.Equal(t, 1, process.restarts)
+}
+
+func withoutTime(stat RecoveryStat) RecoveryStat {
+	stat.LastAttempt = time.Time{}
+	return stat
+}
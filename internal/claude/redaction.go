@@ -0,0 +1,440 @@
+package claude
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// 마스킹 규칙 분류
+const (
+	RedactionCategorySecret = "secret"
+	RedactionCategoryPII    = "pii"
+)
+
+// 기본 엔트로피 판정 기준
+const (
+	DefaultRedactionEntropyThreshold = 4.5
+	DefaultRedactionEntropyMinLength = 32
+)
+
+// redactingWriterMaxLine 줄바꿈 없이 이 크기를 넘으면 모아 둔 출력을 그대로 마스킹해 내보냄
+const redactingWriterMaxLine = 64 * 1024
+
+// redactionRule 출력에서 찾아 가릴 패턴 하나
+type redactionRule struct {
+	name     string
+	category string
+	pattern  *regexp.Regexp
+	// group 0이 아니면 해당 캡처 그룹만 가림 (키 이름은 남김)
+	group int
+	// valid 추가 검증 (nil이면 패턴만으로 판단)
+	valid func(value string) bool
+}
+
+// redactionRules 적용 순서대로 정렬한 마스킹 규칙
+// 구체적인 토큰 형식을 먼저 가리고, 키=값 형식과 엔트로피 판정은 마지막에 적용합니다.
+var redactionRules = []redactionRule{
+	{name: "private_key", category: RedactionCategorySecret, pattern: regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----[\s\S]*?(?:-----END [A-Z0-9 ]*PRIVATE KEY-----|\z)`)},
+	{name: "aws_access_key", category: RedactionCategorySecret, pattern: regexp.MustCompile(`\b(?:AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA|AIPA)[A-Z0-9]{16}\b`)},
+	{name: "aws_secret_key", category: RedactionCategorySecret, pattern: regexp.MustCompile(`(?i)\b(?:aws_?secret_?access_?key|aws_?secret_?key|secret_?access_?key)\b["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})\b`), group: 1},
+	{name: "github_token", category: RedactionCategorySecret, pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{22,255})\b`)},
+	{name: "anthropic_key", category: RedactionCategorySecret, pattern: regexp.MustCompile(`\bsk-ant-[A-Za-z0-9_-]{20,}`)},
+	{name: "openai_key", category: RedactionCategorySecret, pattern: regexp.MustCompile(`\bsk-(?:proj-)?[A-Za-z0-9_-]{20,}`)},
+	{name: "slack_token", category: RedactionCategorySecret, pattern: regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{name: "jwt", category: RedactionCategorySecret, pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+	{name: "bearer_token", category: RedactionCategorySecret, pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9._~+/-]{16,}=*)`), group: 1},
+	{name: "credential_assignment", category: RedactionCategorySecret, pattern: regexp.MustCompile(`(?i)\b(?:password|passwd|pwd|secret|token|api[_-]?key|access[_-]?key|client[_-]?secret|private[_-]?key)\b["']?\s*[:=]\s*["']?([^\s"',;]{8,})`), group: 1},
+	{name: "email", category: RedactionCategoryPII, pattern: regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)},
+	{name: "credit_card", category: RedactionCategoryPII, pattern: regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`), valid: luhnValid},
+	{name: "us_ssn", category: RedactionCategoryPII, pattern: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`)},
+	{name: "kr_rrn", category: RedactionCategoryPII, pattern: regexp.MustCompile(`\b\d{6}-[1-4]\d{6}\b`)},
+	{name: "phone", category: RedactionCategoryPII, pattern: regexp.MustCompile(`(?:\+\d{1,3}[ .-]?\(?\d{1,4}\)?[ .-]?\d{3,4}[ .-]?\d{4}|\b0\d{1,3}[ .-]\d{3,4}[ .-]\d{4}|\(\d{3}\)\s?\d{3}-\d{4})\b`)},
+}
+
+// highEntropyRule 규칙에 없는 토큰을 엔트로피로 판정할 때 사용하는 규칙 이름과 후보 패턴
+const highEntropyRule = "high_entropy"
+
+var highEntropyCandidate = regexp.MustCompile(`[A-Za-z0-9+/=_-]+`)
+
+// privateKeyEndPattern 여러 줄로 나뉜 개인 키 블록의 끝
+var privateKeyEndPattern = regexp.MustCompile(`-----END [A-Z0-9 ]*PRIVATE KEY-----`)
+
+// RedactionRuleNames 사용할 수 있는 마스킹 규칙 이름 목록
+func RedactionRuleNames() []string {
+	names := make([]string, 0, len(redactionRules)+1)
+	for _, rule := range redactionRules {
+		names = append(names, rule.name)
+	}
+	return append(names, highEntropyRule)
+}
+
+// RedactionPolicy 워크스페이스 하나에 적용할 마스킹 정책
+type RedactionPolicy struct {
+	// Secrets 키, 토큰, 개인 키 등 비밀 값 마스킹
+	Secrets bool `json:"secrets"`
+	// PII 이메일, 전화번호, 카드 번호 등 개인 정보 마스킹
+	PII bool `json:"pii"`
+	// Entropy 규칙에 없는 무작위 문자열을 엔트로피로 판정해 마스킹 (Secrets가 켜져 있어야 함)
+	Entropy bool `json:"entropy"`
+	// EntropyThreshold 마스킹할 최소 섀넌 엔트로피 (비트/문자)
+	EntropyThreshold float64 `json:"entropy_threshold"`
+	// EntropyMinLength 엔트로피를 판정할 최소 토큰 길이
+	EntropyMinLength int `json:"entropy_min_length"`
+	// DisabledRules 적용하지 않을 규칙 이름
+	DisabledRules []string `json:"disabled_rules,omitempty"`
+	// Allowlist 규칙에 걸려도 가리지 않을 값
+	Allowlist []string `json:"allowlist,omitempty"`
+}
+
+// DefaultRedactionPolicy 비밀 값, 개인 정보, 엔트로피 판정을 모두 켠 기본 정책
+func DefaultRedactionPolicy() RedactionPolicy {
+	return RedactionPolicy{
+		Secrets:          true,
+		PII:              true,
+		Entropy:          true,
+		EntropyThreshold: DefaultRedactionEntropyThreshold,
+		EntropyMinLength: DefaultRedactionEntropyMinLength,
+	}
+}
+
+// enabled 정책에서 규칙을 적용하는지 여부
+func (p RedactionPolicy) enabled(name, category string) bool {
+	switch category {
+	case RedactionCategorySecret:
+		if !p.Secrets {
+			return false
+		}
+	case RedactionCategoryPII:
+		if !p.PII {
+			return false
+		}
+	}
+	for _, disabled := range p.DisabledRules {
+		if disabled == name {
+			return false
+		}
+	}
+	return true
+}
+
+// allowed 허용 목록에 있는 값인지 여부
+func (p RedactionPolicy) allowed(value string) bool {
+	for _, allowed := range p.Allowlist {
+		if allowed == value {
+			return true
+		}
+	}
+	return false
+}
+
+// OutputRedactorConfig 출력 마스킹 설정
+type OutputRedactorConfig struct {
+	// Default 워크스페이스별 정책이 없을 때 사용할 정책
+	Default RedactionPolicy
+	// Workspaces 워크스페이스 ID별 정책 (기본 정책을 대체)
+	Workspaces map[string]RedactionPolicy
+	// Registerer Prometheus 메트릭 등록 대상 (nil이면 등록하지 않음)
+	Registerer prometheus.Registerer
+}
+
+// OutputRedactor Claude 출력에서 비밀 값과 개인 정보를 찾아 가리는 필터
+// 저장소, WebSocket, 로그로 나가기 전에 적용하며, 가린 건수를 규칙별 카운터로 집계합니다.
+// nil 필터는 출력을 그대로 돌려줍니다.
+type OutputRedactor struct {
+	config     OutputRedactorConfig
+	redactions *prometheus.CounterVec
+}
+
+// NewOutputRedactor 새 출력 마스킹 필터 생성 (알 수 없는 규칙 이름은 에러)
+func NewOutputRedactor(config OutputRedactorConfig) (*OutputRedactor, error) {
+	known := make(map[string]bool)
+	for _, name := range RedactionRuleNames() {
+		known[name] = true
+	}
+	policies := map[string]RedactionPolicy{"": config.Default}
+	for workspaceID, policy := range config.Workspaces {
+		policies[workspaceID] = policy
+	}
+	for workspaceID, policy := range policies {
+		for _, name := range policy.DisabledRules {
+			if !known[name] {
+				return nil, fmt.Errorf("redaction policy %q: unknown rule %q", workspaceID, name)
+			}
+		}
+		if policy.Entropy && (policy.EntropyThreshold <= 0 || policy.EntropyMinLength <= 0) {
+			return nil, fmt.Errorf("redaction policy %q: entropy threshold and min length must be positive", workspaceID)
+		}
+	}
+
+	return &OutputRedactor{
+		config: config,
+		redactions: registerCounterVec(config.Registerer, prometheus.CounterOpts{
+			Name: "aicli_output_redactions_total",
+			Help: "Claude 출력에서 가린 비밀 값과 개인 정보 건수 (분류, 규칙별)",
+		}, []string{"category", "rule"}),
+	}, nil
+}
+
+// Policy 워크스페이스에 적용할 정책 (지정된 정책이 없으면 기본 정책)
+func (r *OutputRedactor) Policy(workspaceID string) RedactionPolicy {
+	if policy, ok := r.config.Workspaces[workspaceID]; ok && workspaceID != "" {
+		return policy
+	}
+	return r.config.Default
+}
+
+// Redact 워크스페이스 정책으로 출력을 마스킹합니다
+func (r *OutputRedactor) Redact(workspaceID, text string) string {
+	if r == nil || text == "" {
+		return text
+	}
+	return r.redact(r.Policy(workspaceID), text)
+}
+
+// redact 정책의 규칙을 순서대로 적용하고 가린 건수를 집계
+func (r *OutputRedactor) redact(policy RedactionPolicy, text string) string {
+	for _, rule := range redactionRules {
+		if !policy.enabled(rule.name, rule.category) {
+			continue
+		}
+		var count int
+		text, count = applyRedactionRule(rule, policy, text)
+		if count > 0 {
+			r.redactions.WithLabelValues(rule.category, rule.name).Add(float64(count))
+		}
+	}
+
+	if policy.Entropy && policy.enabled(highEntropyRule, RedactionCategorySecret) {
+		count := 0
+		text = highEntropyCandidate.ReplaceAllStringFunc(text, func(token string) string {
+			if len(token) < policy.EntropyMinLength || policy.allowed(token) || !hasLettersAndDigits(token) {
+				return token
+			}
+			if shannonEntropy(token) < policy.EntropyThreshold {
+				return token
+			}
+			count++
+			return redactionMask(highEntropyRule)
+		})
+		if count > 0 {
+			r.redactions.WithLabelValues(RedactionCategorySecret, highEntropyRule).Add(float64(count))
+		}
+	}
+	return text
+}
+
+// applyRedactionRule 규칙에 맞는 값을 마스크로 바꾸고 바꾼 건수를 반환
+// 이미 가린 값과 허용 목록의 값은 그대로 둡니다.
+func applyRedactionRule(rule redactionRule, policy RedactionPolicy, text string) (string, int) {
+	matches := rule.pattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text, 0
+	}
+
+	var b strings.Builder
+	last, count := 0, 0
+	for _, match := range matches {
+		start, end := match[2*rule.group], match[2*rule.group+1]
+		if start < 0 {
+			continue
+		}
+		value := text[start:end]
+		if strings.HasPrefix(value, "[REDACTED") || policy.allowed(value) || (rule.valid != nil && !rule.valid(value)) {
+			continue
+		}
+		b.WriteString(text[last:start])
+		b.WriteString(redactionMask(rule.name))
+		last = end
+		count++
+	}
+	if count == 0 {
+		return text, 0
+	}
+	b.WriteString(text[last:])
+	return b.String(), count
+}
+
+// redactionMask 가린 값 자리에 넣을 문자열
+func redactionMask(rule string) string {
+	return "[REDACTED:" + rule + "]"
+}
+
+// shannonEntropy 문자 단위 섀넌 엔트로피 (비트/문자)
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	for _, c := range s {
+		counts[c]++
+	}
+	entropy := 0.0
+	length := float64(len(s))
+	for _, n := range counts {
+		p := float64(n) / length
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// hasLettersAndDigits 영문자와 숫자를 모두 포함하는지 여부 (일반 단어와 긴 숫자 제외)
+func hasLettersAndDigits(s string) bool {
+	var letter, digit bool
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digit = true
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			letter = true
+		}
+	}
+	return letter && digit
+}
+
+// luhnValid 카드 번호 체크섬 검증 (구분자 제외)
+func luhnValid(value string) bool {
+	sum, double, digits := 0, false, 0
+	for i := len(value) - 1; i >= 0; i-- {
+		c := value[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+		digits++
+	}
+	return digits >= 13 && digits <= 19 && sum%10 == 0
+}
+
+// RedactingWriter 출력을 줄 단위로 모아 마스킹한 뒤 내보내는 스트리밍 필터
+// 청크 경계에 걸친 값도 가릴 수 있도록 줄바꿈이 올 때까지 모으며,
+// 여러 줄에 걸친 개인 키 블록은 끝 표시가 나올 때까지 버립니다. 마지막에 Close로 남은 출력을 내보내야 합니다.
+type RedactingWriter struct {
+	dst          io.Writer
+	redactor     *OutputRedactor
+	policy       RedactionPolicy
+	buf          []byte
+	inPrivateKey bool
+	mu           sync.Mutex
+}
+
+// NewRedactingWriter 워크스페이스 정책으로 마스킹해 dst로 내보내는 필터 생성
+func NewRedactingWriter(dst io.Writer, redactor *OutputRedactor, workspaceID string) *RedactingWriter {
+	w := &RedactingWriter{dst: dst, redactor: redactor}
+	if redactor != nil {
+		w.policy = redactor.Policy(workspaceID)
+	}
+	return w
+}
+
+// Write 완성된 줄만 마스킹해 내보내고 나머지는 다음 쓰기까지 모아 둡니다
+func (w *RedactingWriter) Write(p []byte) (int, error) {
+	if w.redactor == nil {
+		return w.dst.Write(p)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := string(w.buf[:i+1])
+		w.buf = w.buf[i+1:]
+		if err := w.writeLine(line); err != nil {
+			return len(p), err
+		}
+	}
+	if len(w.buf) > redactingWriterMaxLine {
+		line := string(w.buf)
+		w.buf = w.buf[:0]
+		if err := w.writeLine(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// Close 모아 둔 나머지 출력을 마스킹해 내보냅니다 (dst는 닫지 않음)
+func (w *RedactingWriter) Close() error {
+	if w.redactor == nil {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.buf) == 0 {
+		return nil
+	}
+	line := string(w.buf)
+	w.buf = nil
+	return w.writeLine(line)
+}
+
+// writeLine 한 줄을 마스킹해 내보냄 (개인 키 블록 안의 줄은 버림)
+func (w *RedactingWriter) writeLine(line string) error {
+	if w.inPrivateKey {
+		loc := privateKeyEndPattern.FindStringIndex(line)
+		if loc == nil {
+			return nil
+		}
+		w.inPrivateKey = false
+		line = line[loc[1]:]
+	}
+
+	redacted := w.redactor.redact(w.policy, line)
+	if w.policy.enabled("private_key", RedactionCategorySecret) {
+		if start := redactionRules[0].pattern.FindStringIndex(line); start != nil && !privateKeyEndPattern.MatchString(line[start[0]:]) {
+			w.inPrivateKey = true
+			// 블록 끝이 다음 줄에 있으므로 줄바꿈은 남김
+			if strings.HasSuffix(line, "\n") && !strings.HasSuffix(redacted, "\n") {
+				redacted += "\n"
+			}
+		}
+	}
+	if redacted == "" {
+		return nil
+	}
+	_, err := io.WriteString(w.dst, redacted)
+	return err
+}
+
+// redactionLogHook 로그 메시지와 문자열 필드를 기본 정책으로 마스킹하는 logrus 훅
+type redactionLogHook struct {
+	redactor *OutputRedactor
+}
+
+// NewRedactionLogHook 로그로 나가는 출력을 마스킹하는 훅 생성
+func NewRedactionLogHook(redactor *OutputRedactor) logrus.Hook {
+	return &redactionLogHook{redactor: redactor}
+}
+
+func (h *redactionLogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *redactionLogHook) Fire(entry *logrus.Entry) error {
+	entry.Message = h.redactor.Redact("", entry.Message)
+	for key, value := range entry.Data {
+		if s, ok := value.(string); ok {
+			entry.Data[key] = h.redactor.Redact("", s)
+		}
+	}
+	return nil
+}
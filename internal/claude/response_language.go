@@ -0,0 +1,38 @@
+package claude
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// ResponseLanguageProvider 사용자가 설정한 Claude 응답 언어 코드를 제공합니다 (설정하지 않았으면 빈 문자열)
+type ResponseLanguageProvider interface {
+	ResponseLanguage(ctx context.Context, userID string) string
+}
+
+// ResponseLanguageInstruction 응답 언어 코드로 시스템 프롬프트에 넣을 지시문 생성
+// 지원하지 않는 코드나 빈 문자열이면 빈 문자열을 반환합니다.
+func ResponseLanguageInstruction(code string) string {
+	language, ok := models.ResponseLanguages[code]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("Always write your responses in %s (%s), regardless of the language used in the prompt, "+
+		"unless the user explicitly asks for another language. "+
+		"Keep code, identifiers, file paths, commands and tool output unchanged.", language.Name, language.Native)
+}
+
+// withResponseLanguage 시스템 프롬프트 끝에 응답 언어 지시문 추가
+func withResponseLanguage(systemPrompt, code string) string {
+	instruction := ResponseLanguageInstruction(code)
+	if instruction == "" {
+		return systemPrompt
+	}
+	if strings.TrimSpace(systemPrompt) == "" {
+		return instruction
+	}
+	return systemPrompt + "\n\n" + instruction
+}
@@ -0,0 +1,238 @@
+package claude
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/logging"
+)
+
+// RestartPolicy 프로세스가 예기치 않게 종료되었을 때의 자동 재시작 정책
+type RestartPolicy struct {
+	// Enabled 자동 재시작 활성화 여부
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// MaxRestarts 안정적으로 실행되기 전까지 허용할 연속 재시작 횟수 (0이면 무제한)
+	MaxRestarts int `yaml:"max_restarts" json:"max_restarts"`
+	// InitialBackoff 첫 재시작 전 대기 시간
+	InitialBackoff time.Duration `yaml:"initial_backoff" json:"initial_backoff"`
+	// MaxBackoff 재시작 대기 시간 상한
+	MaxBackoff time.Duration `yaml:"max_backoff" json:"max_backoff"`
+	// BackoffMultiplier 재시작할 때마다 대기 시간에 곱할 배수
+	BackoffMultiplier float64 `yaml:"backoff_multiplier" json:"backoff_multiplier"`
+	// CircuitBreakerThreshold 회로 차단기가 열리는 연속 실패 횟수 (0이면 사용하지 않음)
+	CircuitBreakerThreshold int `yaml:"circuit_breaker_threshold" json:"circuit_breaker_threshold"`
+	// CircuitBreakerCooldown 회로가 열린 뒤 다음 재시작까지 대기 시간 (0이면 재시작 중단)
+	CircuitBreakerCooldown time.Duration `yaml:"circuit_breaker_cooldown" json:"circuit_breaker_cooldown"`
+	// StableAfter 이 시간 이상 실행된 뒤 종료되면 재시작 횟수, 백오프, 회로 차단기를 초기화
+	StableAfter time.Duration `yaml:"stable_after" json:"stable_after"`
+}
+
+// DefaultRestartPolicy 기본 자동 재시작 정책
+func DefaultRestartPolicy() *RestartPolicy {
+	return &RestartPolicy{
+		Enabled:                 true,
+		MaxRestarts:             5,
+		InitialBackoff:          time.Second,
+		MaxBackoff:              time.Minute,
+		BackoffMultiplier:       2.0,
+		CircuitBreakerThreshold: 3,
+		CircuitBreakerCooldown:  5 * time.Minute,
+		StableAfter:             time.Minute,
+	}
+}
+
+// Validate 정책 값 검증
+func (p *RestartPolicy) Validate() error {
+	if p.MaxRestarts < 0 {
+		return errors.New("max_restarts must not be negative")
+	}
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 || p.CircuitBreakerCooldown < 0 || p.StableAfter < 0 {
+		return errors.New("restart durations must not be negative")
+	}
+	if p.MaxBackoff > 0 && p.MaxBackoff < p.InitialBackoff {
+		return fmt.Errorf("max_backoff (%v) must be greater than initial_backoff (%v)", p.MaxBackoff, p.InitialBackoff)
+	}
+	if p.BackoffMultiplier != 0 && p.BackoffMultiplier < 1 {
+		return fmt.Errorf("backoff_multiplier must be at least 1, got %f", p.BackoffMultiplier)
+	}
+	if p.CircuitBreakerThreshold < 0 {
+		return errors.New("circuit_breaker_threshold must not be negative")
+	}
+	return nil
+}
+
+// RestartPolicyProvider 워크스페이스에 적용할 자동 재시작 정책을 제공합니다
+type RestartPolicyProvider interface {
+	RestartPolicy(workspaceID string) *RestartPolicy
+}
+
+// RestartPolicyRegistry 기본 정책과 워크스페이스별 정책을 관리합니다
+type RestartPolicyRegistry struct {
+	mu            sync.RWMutex
+	defaultPolicy *RestartPolicy
+	workspaces    map[string]*RestartPolicy
+}
+
+// NewRestartPolicyRegistry 새 재시작 정책 레지스트리 생성 (defaultPolicy가 nil이면 자동 재시작하지 않음)
+func NewRestartPolicyRegistry(defaultPolicy *RestartPolicy) *RestartPolicyRegistry {
+	return &RestartPolicyRegistry{
+		defaultPolicy: defaultPolicy,
+		workspaces:    make(map[string]*RestartPolicy),
+	}
+}
+
+// SetWorkspacePolicy 워크스페이스 정책 지정 (nil이면 지정 해제)
+func (r *RestartPolicyRegistry) SetWorkspacePolicy(workspaceID string, policy *RestartPolicy) error {
+	if policy != nil {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if policy == nil {
+		delete(r.workspaces, workspaceID)
+		return nil
+	}
+	r.workspaces[workspaceID] = policy
+	return nil
+}
+
+// RestartPolicy 워크스페이스에 적용할 정책 사본 (지정이 없으면 기본 정책)
+func (r *RestartPolicyRegistry) RestartPolicy(workspaceID string) *RestartPolicy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, ok := r.workspaces[workspaceID]
+	if !ok {
+		policy = r.defaultPolicy
+	}
+	if policy == nil {
+		return nil
+	}
+	copied := *policy
+	return &copied
+}
+
+// ProcessEventType 프로세스 이벤트 타입
+type ProcessEventType string
+
+const (
+	ProcessEventStarting         ProcessEventType = "starting"          // 프로세스 시작
+	ProcessEventStarted          ProcessEventType = "started"           // 프로세스 실행 중
+	ProcessEventStartFailed      ProcessEventType = "start_failed"      // 프로세스 시작 실패
+	ProcessEventStopping         ProcessEventType = "stopping"          // 정상 종료 요청
+	ProcessEventStopped          ProcessEventType = "stopped"           // 요청에 의한 종료
+	ProcessEventKilled           ProcessEventType = "killed"            // 강제 종료
+	ProcessEventExited           ProcessEventType = "exited"            // 스스로 정상 종료
+	ProcessEventCrashed          ProcessEventType = "crashed"           // 예기치 않은 종료
+	ProcessEventRestartScheduled ProcessEventType = "restart_scheduled" // 백오프 후 재시작 예약
+	ProcessEventCircuitOpen      ProcessEventType = "circuit_open"      // 회로 차단기 열림
+	ProcessEventRestarting       ProcessEventType = "restarting"        // 자동 재시작
+	ProcessEventRestartExhausted ProcessEventType = "restart_exhausted" // 재시작 중단
+	ProcessEventHung             ProcessEventType = "hung"              // 출력과 하트비트 없이 멈춤
+)
+
+// ProcessEvent 프로세스 상태 전환 이벤트
+// 재시작 예약처럼 상태가 바뀌지 않는 이벤트는 From과 To가 같습니다.
+type ProcessEvent struct {
+	Type      ProcessEventType `json:"type"`
+	From      ProcessStatus    `json:"from"`
+	To        ProcessStatus    `json:"to"`
+	PID       int              `json:"pid,omitempty"`
+	Attempt   int              `json:"attempt,omitempty"` // 자동 재시작 시도 번호
+	Delay     time.Duration    `json:"delay,omitempty"`   // 재시작까지 대기 시간
+	Error     string           `json:"error,omitempty"`
+	Timestamp time.Time        `json:"timestamp"`
+
+	// Diagnostics 멈춤 감지 시 수집한 진단 정보
+	Diagnostics *HangDiagnostics `json:"diagnostics,omitempty"`
+}
+
+// ProcessEventListener 프로세스 이벤트 수신 함수
+type ProcessEventListener func(event ProcessEvent)
+
+// restartDecision 예기치 않은 종료 후 재시작 여부 판단 결과
+type restartDecision struct {
+	restart     bool
+	attempt     int
+	delay       time.Duration
+	circuitOpen bool
+}
+
+// restartTracker 프로세스 하나의 재시작 횟수, 백오프, 회로 차단기 상태
+type restartTracker struct {
+	policy   RestartPolicy
+	backoff  BackoffStrategy
+	breaker  CircuitBreaker
+	restarts int
+}
+
+// newRestartTracker 정책으로 재시작 추적기 생성 (비활성 정책이면 nil)
+func newRestartTracker(policy *RestartPolicy, logger logging.Logger) *restartTracker {
+	if policy == nil || !policy.Enabled {
+		return nil
+	}
+
+	t := &restartTracker{policy: *policy}
+	multiplier := policy.BackoffMultiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+	maxBackoff := policy.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = policy.InitialBackoff
+	}
+	t.backoff = NewExponentialBackoff(&RecoveryPolicy{
+		RestartInterval:   policy.InitialBackoff,
+		BackoffMultiplier: multiplier,
+		MaxBackoff:        maxBackoff,
+	})
+	if policy.CircuitBreakerThreshold > 0 {
+		t.breaker = NewCircuitBreaker(&CircuitBreakerConfig{
+			FailureThreshold:         policy.CircuitBreakerThreshold,
+			RecoveryTimeout:          policy.CircuitBreakerCooldown,
+			SuccessThreshold:         1,
+			ErrorPercentageThreshold: 101, // 연속 실패 횟수만으로 판단
+		}, logger)
+	}
+	return t
+}
+
+// next 실행 시간이 runFor인 프로세스가 비정상 종료되었을 때 재시작 여부 결정
+func (t *restartTracker) next(runFor time.Duration) restartDecision {
+	if t.policy.StableAfter > 0 && runFor >= t.policy.StableAfter {
+		t.restarts = 0
+		t.backoff.Reset()
+		if t.breaker != nil {
+			t.breaker.Reset()
+		}
+	}
+
+	if t.policy.MaxRestarts > 0 && t.restarts >= t.policy.MaxRestarts {
+		return restartDecision{}
+	}
+
+	decision := restartDecision{restart: true}
+	if t.breaker != nil {
+		t.breaker.RecordError()
+		if t.breaker.IsOpen() {
+			if t.policy.CircuitBreakerCooldown <= 0 {
+				return restartDecision{circuitOpen: true}
+			}
+			decision.circuitOpen = true
+			decision.delay = t.policy.CircuitBreakerCooldown
+		}
+	}
+	if !decision.circuitOpen {
+		decision.delay = t.backoff.NextBackoff()
+	}
+
+	t.restarts++
+	decision.attempt = t.restarts
+	return decision
+}
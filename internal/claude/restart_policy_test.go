@@ -0,0 +1,180 @@
+package claude
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/logging"
+)
+
+// processEventRecorder 프로세스 이벤트를 기록하는 테스트용 리스너
+type processEventRecorder struct {
+	mu     sync.Mutex
+	events []ProcessEvent
+}
+
+func (r *processEventRecorder) record(event ProcessEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *processEventRecorder) count(eventType ProcessEventType) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, event := range r.events {
+		if event.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
+
+func TestRestartTracker_BackoffAndLimits(t *testing.T) {
+	tracker := newRestartTracker(&RestartPolicy{
+		Enabled:           true,
+		MaxRestarts:       3,
+		InitialBackoff:    time.Second,
+		MaxBackoff:        3 * time.Second,
+		BackoffMultiplier: 2,
+		StableAfter:       time.Minute,
+	}, logging.FromLogrus(logrus.New()))
+
+	delays := []time.Duration{}
+	for i := 0; i < 3; i++ {
+		decision := tracker.next(0)
+		require.True(t, decision.restart)
+		assert.Equal(t, i+1, decision.attempt)
+		delays = append(delays, decision.delay)
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}, delays)
+
+	// 최대 재시작 횟수 초과
+	assert.False(t, tracker.next(0).restart)
+
+	// 안정적으로 실행된 뒤의 종료는 카운트 초기화
+	decision := tracker.next(2 * time.Minute)
+	require.True(t, decision.restart)
+	assert.Equal(t, 1, decision.attempt)
+	assert.Equal(t, time.Second, decision.delay)
+
+	assert.Nil(t, newRestartTracker(&RestartPolicy{}, nil))
+	assert.Nil(t, newRestartTracker(nil, nil))
+}
+
+func TestRestartTracker_CircuitBreaker(t *testing.T) {
+	policy := &RestartPolicy{
+		Enabled:                 true,
+		InitialBackoff:          time.Millisecond,
+		BackoffMultiplier:       2,
+		MaxBackoff:              time.Second,
+		CircuitBreakerThreshold: 2,
+		CircuitBreakerCooldown:  time.Minute,
+	}
+	tracker := newRestartTracker(policy, logging.FromLogrus(logrus.New()))
+
+	assert.False(t, tracker.next(0).circuitOpen)
+	decision := tracker.next(0)
+	assert.True(t, decision.restart)
+	assert.True(t, decision.circuitOpen)
+	assert.Equal(t, time.Minute, decision.delay)
+
+	// 쿨다운이 없으면 회로가 열릴 때 재시작 중단
+	policy.CircuitBreakerCooldown = 0
+	tracker = newRestartTracker(policy, logging.FromLogrus(logrus.New()))
+	tracker.next(0)
+	decision = tracker.next(0)
+	assert.False(t, decision.restart)
+	assert.True(t, decision.circuitOpen)
+}
+
+func TestRestartPolicyRegistry(t *testing.T) {
+	registry := NewRestartPolicyRegistry(DefaultRestartPolicy())
+
+	require.NoError(t, registry.SetWorkspacePolicy("ws-1", &RestartPolicy{Enabled: false}))
+	assert.Error(t, registry.SetWorkspacePolicy("ws-2", &RestartPolicy{Enabled: true, BackoffMultiplier: 0.5}))
+
+	assert.False(t, registry.RestartPolicy("ws-1").Enabled)
+	assert.True(t, registry.RestartPolicy("ws-2").Enabled)
+
+	// 반환된 정책을 수정해도 레지스트리에는 영향 없음
+	registry.RestartPolicy("ws-2").MaxRestarts = 100
+	assert.Equal(t, DefaultRestartPolicy().MaxRestarts, registry.RestartPolicy("ws-2").MaxRestarts)
+
+	require.NoError(t, registry.SetWorkspacePolicy("ws-1", nil))
+	assert.True(t, registry.RestartPolicy("ws-1").Enabled)
+
+	assert.Nil(t, NewRestartPolicyRegistry(nil).RestartPolicy("ws-1"))
+}
+
+func TestProcessManager_AutoRestart(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sh가 필요합니다")
+	}
+
+	recorder := &processEventRecorder{}
+	pm := NewProcessManager(logging.FromLogrus(logrus.New()))
+	err := pm.Start(context.Background(), &ProcessConfig{
+		Command: "sh",
+		Args:    []string{"-c", "exit 3"},
+		RestartPolicy: &RestartPolicy{
+			Enabled:           true,
+			MaxRestarts:       2,
+			InitialBackoff:    10 * time.Millisecond,
+			MaxBackoff:        20 * time.Millisecond,
+			BackoffMultiplier: 2,
+		},
+		EventListener: recorder.record,
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return recorder.count(ProcessEventRestartExhausted) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, StatusError, pm.GetStatus())
+	assert.Equal(t, 3, recorder.count(ProcessEventCrashed))
+	assert.Equal(t, 2, recorder.count(ProcessEventRestarting))
+	assert.Equal(t, 2, recorder.count(ProcessEventRestartScheduled))
+
+	// 전환 이벤트는 이전 이벤트의 상태에서 이어짐
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	status := StatusStopped
+	for _, event := range recorder.events {
+		assert.Equal(t, status, event.From, "event %s", event.Type)
+		status = event.To
+	}
+}
+
+func TestProcessManager_StopCancelsPendingRestart(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sh가 필요합니다")
+	}
+
+	recorder := &processEventRecorder{}
+	pm := NewProcessManager(logging.FromLogrus(logrus.New()))
+	err := pm.Start(context.Background(), &ProcessConfig{
+		Command:       "sh",
+		Args:          []string{"-c", "exit 1"},
+		RestartPolicy: &RestartPolicy{Enabled: true, InitialBackoff: time.Hour},
+		EventListener: recorder.record,
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return recorder.count(ProcessEventRestartScheduled) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, pm.Stop(time.Second))
+	assert.Equal(t, StatusStopped, pm.GetStatus())
+	assert.Equal(t, 0, recorder.count(ProcessEventRestarting))
+}
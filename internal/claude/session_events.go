@@ -20,6 +20,9 @@ const (
 	SessionEventStateChanged
 	SessionEventConfigUpdated
 	SessionEventMetadataUpdated
+	SessionEventProcess
+	SessionEventContextWarning // 컨텍스트 사용량이 경고/위험 단계에 도달 (데이터: ContextUsage)
+	SessionEventCompacted      // 대화를 요약해 이어받고 재시작 (데이터: ContextCompaction)
 )
 
 // String은 SessionEventType의 문자열 표현을 반환합니다
@@ -34,6 +37,9 @@ func (t SessionEventType) String() string {
 		"state_changed",
 		"config_updated",
 		"metadata_updated",
+		"process",
+		"context_warning",
+		"compacted",
 	}
 	if int(t) < len(types) {
 		return types[t]
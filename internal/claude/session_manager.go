@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/aicli/aicli-web/internal/logging"
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/storage"
 )
@@ -38,25 +39,47 @@ type Session struct {
 // SessionConfig는 세션 설정을 정의합니다
 type SessionConfig struct {
 	// 기본 설정
+	Provider     string  `json:"provider,omitempty"` // 에이전트 프로바이더 (비어 있으면 기본 프로바이더)
 	WorkingDir   string  `json:"working_dir" validate:"required,dir"`
 	SystemPrompt string  `json:"system_prompt"`
+	// 응답 언어 코드 (프로세스 시작 시 시스템 프롬프트에 지시문 추가, 비어 있으면 지시하지 않음)
+	ResponseLanguage string `json:"response_language,omitempty"`
 	MaxTurns     int     `json:"max_turns" validate:"min=1,max=1000"`
 	Temperature  float64 `json:"temperature" validate:"min=0,max=2"`
 
+	// 대화 이어가기 설정
+	ResumeSessionID string `json:"resume_session_id,omitempty"` // 이어갈 Claude CLI 세션 ID (--resume)
+	ForkSession     bool   `json:"fork_session,omitempty"`      // 이어갈 세션을 새 세션 ID로 분기 (--fork-session)
+	Continue        bool   `json:"continue,omitempty"`          // 작업 디렉토리의 마지막 대화 이어가기 (--continue)
+
 	// 도구 설정
 	AllowedTools []string      `json:"allowed_tools"`
 	ToolTimeout  time.Duration `json:"tool_timeout" validate:"min=1s,max=5m"`
 
-	// 환경 설정
-	Environment map[string]string `json:"environment"`
+	// 환경 설정 (워크스페이스 비밀 변수가 포함될 수 있어 직렬화하지 않음)
+	Environment map[string]string `json:"-"`
 	OAuthToken  string            `json:"-"` // 보안상 직렬화하지 않음
 
+	// MCP 서버 설정 (환경 변수에 시크릿이 포함될 수 있어 직렬화하지 않음)
+	MCPServers map[string]MCPServerConfig `json:"-"`
+
+	// 프로세스 자동 재시작 정책 (nil이면 재시작하지 않음)
+	RestartPolicy *RestartPolicy `json:"restart_policy,omitempty"`
+
+	// 멈춘 프로세스 감지 정책 (nil이면 감지하지 않음)
+	HangPolicy *HangPolicy `json:"hang_policy,omitempty"`
+
 	// 리소스 제한
 	MaxMemory   int64         `json:"max_memory" validate:"min=0"`   // bytes
 	MaxCPU      float64       `json:"max_cpu" validate:"min=0,max=1"` // 0-1 범위
 	MaxDuration time.Duration `json:"max_duration" validate:"min=1m,max=24h"`
 }
 
+// EnvironmentProvider 워크스페이스에서 프로세스에 전달할 환경 변수를 제공합니다
+type EnvironmentProvider interface {
+	WorkspaceEnvironment(workspaceID string) map[string]string
+}
+
 // Validate는 설정의 유효성을 검증합니다
 func (c SessionConfig) Validate() error {
 	// 필수 필드 검증
@@ -85,6 +108,25 @@ func (c SessionConfig) Validate() error {
 		return fmt.Errorf("max_duration must be between 1m and 24h, got %v", c.MaxDuration)
 	}
 
+	if c.ResumeSessionID != "" && c.Continue {
+		return errors.New("resume_session_id and continue are mutually exclusive")
+	}
+	if c.ForkSession && c.ResumeSessionID == "" && !c.Continue {
+		return errors.New("fork_session requires resume_session_id or continue")
+	}
+
+	if c.RestartPolicy != nil {
+		if err := c.RestartPolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid restart_policy: %w", err)
+		}
+	}
+
+	if c.HangPolicy != nil {
+		if err := c.HangPolicy.Validate(); err != nil {
+			return fmt.Errorf("invalid hang_policy: %w", err)
+		}
+	}
+
 	// 도구 권한 검증
 	validTools := map[string]bool{
 		"code_interpreter": true,
@@ -158,17 +200,29 @@ type sessionManager struct {
 	stateMachine   *SessionStateMachine
 	store          storage.Storage
 	eventBus       *SessionEventBus
+	runners        *AgentRegistry
+	guardrails     *PromptGuardrails
 	mu             sync.RWMutex
 }
 
 // NewSessionManager는 새로운 SessionManager를 생성합니다
 func NewSessionManager(processManager ProcessManager, store storage.Storage) SessionManager {
+	return NewSessionManagerWithAgents(processManager, store, nil)
+}
+
+// NewSessionManagerWithAgents는 프로바이더별 에이전트 러너를 사용하는 SessionManager를 생성합니다
+// registry가 nil이면 Claude 러너만 등록된 레지스트리를 사용합니다
+func NewSessionManagerWithAgents(processManager ProcessManager, store storage.Storage, registry *AgentRegistry) SessionManager {
+	if registry == nil {
+		registry = NewAgentRegistry()
+	}
 	sm := &sessionManager{
 		sessions:       make(map[string]*Session),
 		processManager: processManager,
 		stateMachine:   NewSessionStateMachine(),
 		store:          store,
 		eventBus:       NewSessionEventBus(1000),
+		runners:        registry,
 	}
 	
 	// 기본 이벤트 로거 추가
@@ -177,6 +231,34 @@ func NewSessionManager(processManager ProcessManager, store storage.Storage) Ses
 	return sm
 }
 
+// SessionEventSource는 세션 이벤트 버스를 외부에 노출하는 세션 매니저가 구현합니다
+type SessionEventSource interface {
+	Events() *SessionEventBus
+}
+
+// Events는 세션 이벤트 버스를 반환합니다
+func (sm *sessionManager) Events() *SessionEventBus {
+	return sm.eventBus
+}
+
+// SetGuardrails는 세션 프로세스를 시작할 때마다 적용할 시스템 프롬프트 가드레일을 설정합니다
+func (sm *sessionManager) SetGuardrails(guardrails *PromptGuardrails) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.guardrails = guardrails
+}
+
+// processConfig는 응답 언어 지시문과 가드레일을 적용한 설정 사본으로 프로세스 설정을 생성합니다
+// 세션에는 사용자가 지정한 설정이 그대로 남으므로 설정을 바꿔도 가드레일은 빠지지 않습니다.
+func (sm *sessionManager) processConfig(runner AgentRunner, config SessionConfig) (*ProcessConfig, error) {
+	sm.mu.RLock()
+	guardrails := sm.guardrails
+	sm.mu.RUnlock()
+
+	config.SystemPrompt = guardrails.Apply(withResponseLanguage(config.SystemPrompt, config.ResponseLanguage))
+	return runner.ProcessConfig(config)
+}
+
 // CreateSession은 새로운 세션을 생성합니다
 func (sm *sessionManager) CreateSession(ctx context.Context, config SessionConfig) (*Session, error) {
 	// 설정 검증
@@ -184,6 +266,13 @@ func (sm *sessionManager) CreateSession(ctx context.Context, config SessionConfi
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
+	// 에이전트 러너 선택
+	runner, err := sm.runners.Get(config.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	config.Provider = runner.Provider()
+
 	// 세션 ID 생성
 	sessionID, err := uuid.NewV4()
 	if err != nil {
@@ -197,7 +286,7 @@ func (sm *sessionManager) CreateSession(ctx context.Context, config SessionConfi
 		State:      SessionStateCreated,
 		Created:    time.Now(),
 		LastActive: time.Now(),
-		Metadata:   make(map[string]interface{}),
+		Metadata:   map[string]interface{}{"provider": config.Provider},
 	}
 
 	// 메모리에 저장
@@ -212,7 +301,7 @@ func (sm *sessionManager) CreateSession(ctx context.Context, config SessionConfi
 			Status:     models.SessionStatus(session.State.String()),
 			StartedAt:  &session.Created,
 			LastActive: session.LastActive,
-			Metadata:   make(map[string]string),
+			Metadata:   map[string]string{"provider": config.Provider},
 		}
 		sessionModel.ID = session.ID
 		
@@ -238,21 +327,25 @@ func (sm *sessionManager) CreateSession(ctx context.Context, config SessionConfi
 	}
 
 	// 프로세스 생성
-	processConfig := ProcessConfig{
-		Command:      "claude",
-		Args:         []string{},
-		WorkingDir:   config.WorkingDir,
-		Environment:  config.Environment,
-		OAuthToken:   config.OAuthToken,
-		ResourceLimits: &ResourceLimits{
-			MaxMemory: config.MaxMemory,
-			MaxCPU:    config.MaxCPU,
-			Timeout:   config.MaxDuration,
-		},
-	}
-
-	// ProcessManager를 직접 생성하고 시작
-	if err := sm.processManager.Start(ctx, &processConfig); err != nil {
+	processConfig, err := sm.processConfig(runner, config)
+	if err != nil {
+		sm.updateSessionState(session.ID, SessionStateError)
+		return nil, fmt.Errorf("failed to build process config: %w", err)
+	}
+
+	// 프로세스 상태 전환과 자동 재시작 이벤트를 세션 이벤트로 전달
+	processConfig.EventListener = func(event ProcessEvent) {
+		sm.eventBus.Publish(SessionEvent{
+			SessionID: session.ID,
+			Type:      SessionEventProcess,
+			Timestamp: event.Timestamp,
+			Data:      event,
+		})
+	}
+
+	// ProcessManager를 직접 생성하고 시작 (프로세스 로그에 세션, 워크스페이스 ID를 남김)
+	ctx = logging.WithSessionID(logging.WithWorkspaceID(ctx, session.WorkspaceID), session.ID)
+	if err := sm.processManager.Start(ctx, processConfig); err != nil {
 		sm.updateSessionState(session.ID, SessionStateError)
 		return nil, fmt.Errorf("failed to start process: %w", err)
 	}
@@ -393,6 +486,63 @@ func (sm *sessionManager) UpdateSession(sessionID string, updates SessionUpdate)
 	return nil
 }
 
+// SessionRestarter는 바뀐 설정으로 세션 프로세스를 다시 시작할 수 있는 세션 매니저가 구현합니다
+type SessionRestarter interface {
+	RestartSession(ctx context.Context, sessionID string, config SessionConfig) error
+}
+
+// RestartSession은 세션 ID를 유지한 채 새 설정으로 프로세스를 다시 시작합니다
+// 컨텍스트 압축처럼 시스템 프롬프트를 바꿔 새 대화로 이어갈 때 사용합니다.
+func (sm *sessionManager) RestartSession(ctx context.Context, sessionID string, config SessionConfig) error {
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	session, err := sm.GetSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	runner, err := sm.runners.Get(config.Provider)
+	if err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	config.Provider = runner.Provider()
+
+	processConfig, err := sm.processConfig(runner, config)
+	if err != nil {
+		return fmt.Errorf("failed to build process config: %w", err)
+	}
+	processConfig.EventListener = func(event ProcessEvent) {
+		sm.eventBus.Publish(SessionEvent{
+			SessionID: sessionID,
+			Type:      SessionEventProcess,
+			Timestamp: event.Timestamp,
+			Data:      event,
+		})
+	}
+
+	process := session.Process
+	if process == nil {
+		process = sm.processManager
+	}
+	if process == nil {
+		return fmt.Errorf("no process manager for session %s", sessionID)
+	}
+	if process.IsRunning() {
+		if err := process.Stop(30 * time.Second); err != nil {
+			return fmt.Errorf("failed to stop process: %w", err)
+		}
+	}
+	ctx = logging.WithSessionID(logging.WithWorkspaceID(ctx, session.WorkspaceID), sessionID)
+	if err := process.Start(ctx, processConfig); err != nil {
+		sm.updateSessionState(sessionID, SessionStateError)
+		return fmt.Errorf("failed to start process: %w", err)
+	}
+
+	return sm.UpdateSession(sessionID, SessionUpdate{Config: &config})
+}
+
 // CloseSession은 세션을 종료합니다
 func (sm *sessionManager) CloseSession(sessionID string) error {
 	session, err := sm.GetSession(sessionID)
@@ -48,7 +48,8 @@ type claudeStreamHandler struct {
 	stdin        io.WriteCloser
 	stdout       io.ReadCloser
 	stderr       io.ReadCloser
-	parser       *JSONStreamParser
+	parser       AgentStreamParser
+	runner       AgentRunner
 	eventBus     *EventBus
 	buffer       *StreamBuffer
 	isRunning    bool
@@ -69,6 +70,9 @@ type claudeStreamHandler struct {
 	bytesProcessed   int64
 	parseErrors      int64
 	
+	// 프로바이더별 토큰 사용량과 비용
+	usage ExecutionSummary
+	
 	// 백프레셔 및 라우팅
 	backpressure    *BackpressureHandler
 	messageRouter   *MessageRouter
@@ -77,7 +81,13 @@ type claudeStreamHandler struct {
 }
 
 // NewStreamHandler는 새로운 스트림 핸들러를 생성합니다.
+// 출력은 Claude CLI의 stream-json 형식으로 파싱합니다.
 func NewStreamHandler(logger *logrus.Logger) StreamHandler {
+	return NewStreamHandlerForAgent(logger, NewClaudeRunner())
+}
+
+// NewStreamHandlerForAgent는 에이전트 러너의 출력 형식으로 파싱하는 스트림 핸들러를 생성합니다.
+func NewStreamHandlerForAgent(logger *logrus.Logger, runner AgentRunner) StreamHandler {
 	// 백프레셔 설정
 	backpressureConfig := BackpressureConfig{
 		MaxBufferSize:     1000,
@@ -98,6 +108,7 @@ func NewStreamHandler(logger *logrus.Logger) StreamHandler {
 		eventBus:       NewEventBus(logger),
 		buffer:         NewStreamBuffer(1024 * 1024), // 1MB 버퍼
 		logger:         logger,
+		runner:         runner,
 		responseChan:   make(chan *Response, 100),
 		errorChan:      make(chan error, 10),
 		backpressure:   NewBackpressureHandler(backpressureConfig, logger),
@@ -124,7 +135,7 @@ func (sh *claudeStreamHandler) Start(stdin io.WriteCloser, stdout, stderr io.Rea
 	sh.stdin = stdin
 	sh.stdout = stdout
 	sh.stderr = stderr
-	sh.parser = NewJSONStreamParser(stdout, sh.logger)
+	sh.parser = sh.runner.NewStreamParser(stdout, sh.logger)
 	sh.ctx, sh.cancel = context.WithCancel(context.Background())
 	sh.isRunning = true
 	sh.startTime = time.Now()
@@ -194,8 +205,9 @@ func (sh *claudeStreamHandler) processErrorStream() {
 				sh.eventBus.Publish(&StreamEvent{
 					Type: "stderr_data",
 					Data: map[string]interface{}{
-						"data": string(errorData),
-						"size": n,
+						"data":            string(errorData),
+						"size":            n,
+						RenderMetadataKey: RenderStderr(string(errorData)),
 					},
 					Timestamp: time.Now(),
 					Source:    "stream_handler",
@@ -209,6 +221,28 @@ func (sh *claudeStreamHandler) processErrorStream() {
 func (sh *claudeStreamHandler) handleResponse(response *Response) {
 	sh.messagesSent++
 
+	// 토큰 사용량 누적
+	if usage, ok := sh.runner.Usage(response); ok {
+		sh.mutex.Lock()
+		sh.usage.RecordUsage(sh.runner, *usage)
+		sh.mutex.Unlock()
+
+		// 컨텍스트 사용량 추적용 이벤트 발행 (응답 순서대로 기록되도록 동기 발행)
+		sh.eventBus.PublishSync(&StreamEvent{
+			Type: StreamEventUsageUpdated,
+			Data: map[string]interface{}{
+				"response_type": response.Type,
+				"usage":         *usage,
+			},
+			Timestamp: time.Now(),
+			Source:    "stream_handler",
+			ID:        response.MessageID,
+		})
+	}
+
+	// ANSI 시퀀스를 스타일 조각으로 변환해 메타데이터에 추가
+	renderResponse(response)
+
 	// 응답을 채널로 전송
 	select {
 	case sh.responseChan <- response:
@@ -388,6 +422,10 @@ func (sh *claudeStreamHandler) GetStats() map[string]interface{} {
 		"messages_received":  sh.messagesReceived,
 		"errors":            sh.errors,
 		"uptime_seconds":    0,
+		"provider":          sh.runner.Provider(),
+		"input_tokens":      sh.usage.InputTokens,
+		"output_tokens":     sh.usage.OutputTokens,
+		"cost_usd":          sh.usage.CostUSD,
 	}
 
 	if !sh.startTime.IsZero() {
@@ -446,7 +484,7 @@ func (sh *claudeStreamHandler) Stream(ctx context.Context, reader io.Reader) (<-
 	go func() {
 		defer close(messageChan)
 		
-		parser := NewJSONStreamParser(reader, sh.logger)
+		parser := sh.runner.NewStreamParser(reader, sh.logger)
 		responseChan, errorChan := parser.ParseStream(ctx)
 		
 		for {
@@ -459,6 +497,7 @@ func (sh *claudeStreamHandler) Stream(ctx context.Context, reader io.Reader) (<-
 				}
 				
 				// Response를 StreamMessage로 변환
+				renderResponse(response)
 				msg := StreamMessage{
 					Type:    response.Type,
 					Content: response.Content,
@@ -489,7 +528,9 @@ func (sh *claudeStreamHandler) Stream(ctx context.Context, reader io.Reader) (<-
 					atomic.AddInt64(&sh.parseErrors, 1)
 					sh.logger.WithError(err).Error("Parse error in stream")
 				}
-				return
+				// 파서가 EOF로 끝나면 errorChan이 먼저 닫힐 수 있으므로
+				// 버퍼에 남은 응답은 responseChan이 닫힐 때까지 마저 전달
+				errorChan = nil
 			}
 		}
 	}()
@@ -529,6 +570,9 @@ func (sh *claudeStreamHandler) StreamWithCallback(ctx context.Context, reader io
 		sh.backpressure.DecrementBuffer()
 	}
 	
+	// 비동기 라우팅된 핸들러까지 끝난 뒤 반환해야 호출자가 결과를 온전히 볼 수 있음
+	sh.messageRouter.Drain()
+	
 	return nil
 }
 
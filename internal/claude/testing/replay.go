@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+// Transcript는 스트림 파서가 읽는 형식의 Claude CLI 출력을 만드는 빌더입니다.
+// 실제 CLI 녹화 대신 테스트에서 원하는 출력을 직접 구성할 때 사용합니다.
+type Transcript struct {
+	offset time.Duration
+	lines  []claude.RecordedLine
+}
+
+// NewTranscript는 빈 출력 빌더를 생성합니다.
+func NewTranscript() *Transcript {
+	return &Transcript{}
+}
+
+// After는 다음 줄이 나오기 전까지의 간격을 추가합니다.
+func (t *Transcript) After(d time.Duration) *Transcript {
+	t.offset += d
+	return t
+}
+
+// Response는 응답 하나를 출력 한 줄로 추가합니다.
+func (t *Transcript) Response(resp claude.Response) *Transcript {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal response: %v", err))
+	}
+	return t.Raw(string(data))
+}
+
+// Raw는 출력 한 줄을 그대로 추가합니다 (잘못된 JSON 처리 테스트용).
+func (t *Transcript) Raw(line string) *Transcript {
+	t.lines = append(t.lines, claude.RecordedLine{
+		OffsetMS: t.offset.Milliseconds(),
+		Line:     line,
+	})
+	return t
+}
+
+// Text는 텍스트 응답을 추가합니다.
+func (t *Transcript) Text(messageID, content string) *Transcript {
+	return t.Response(claude.Response{Type: "text", Content: content, MessageID: messageID})
+}
+
+// Result는 토큰 사용량이 담긴 완료 응답을 추가합니다.
+func (t *Transcript) Result(model string, inputTokens, outputTokens int) *Transcript {
+	return t.Response(claude.Response{
+		Type: "result",
+		Metadata: map[string]interface{}{
+			"model": model,
+			"usage": map[string]interface{}{
+				"input_tokens":  inputTokens,
+				"output_tokens": outputTokens,
+			},
+		},
+	})
+}
+
+// Lines는 지금까지 추가한 출력을 반환합니다.
+func (t *Transcript) Lines() []claude.RecordedLine {
+	return append([]claude.RecordedLine(nil), t.lines...)
+}
+
+// WriteStubCLI는 녹화된 출력을 표준 출력으로 내보내는 스텁 CLI 스크립트를 dir/name에 생성합니다.
+// realtime이면 녹화된 간격만큼 기다리며 출력합니다. 인자와 표준 입력은 무시하며,
+// dir을 PATH 앞에 두면 프로세스 관리자가 실제 Claude CLI 대신 스텁을 실행합니다.
+func WriteStubCLI(dir, name string, lines []claude.RecordedLine, realtime bool) (string, error) {
+	var script strings.Builder
+	script.WriteString("#!/bin/sh\n")
+
+	var last int64
+	for _, line := range lines {
+		if realtime && line.OffsetMS > last {
+			fmt.Fprintf(&script, "sleep %.3f\n", float64(line.OffsetMS-last)/1000)
+			last = line.OffsetMS
+		}
+		fmt.Fprintf(&script, "printf '%%s\\n' %s\n", shellQuote(line.Line))
+	}
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(script.String()), 0o755); err != nil {
+		return "", fmt.Errorf("failed to write stub CLI: %w", err)
+	}
+	return path, nil
+}
+
+// shellQuote는 문자열을 sh 작은따옴표 인자로 감쌉니다.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
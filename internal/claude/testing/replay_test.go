@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+func TestWriteStubCLI_RecordAndReplay(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+
+	transcript := NewTranscript().
+		Text("m1", "it's done").
+		After(20*time.Millisecond).
+		Text("m2", `quotes "and" $vars`).
+		Result("claude-3-sonnet", 5, 7).
+		Lines()
+
+	dir := t.TempDir()
+	stub, err := WriteStubCLI(dir, "claude", transcript, true)
+	require.NoError(t, err)
+
+	// 스텁 CLI 출력을 실제 세션처럼 녹화
+	cmd := exec.Command(stub, "-p", "ignored")
+	stdout, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+
+	recordings := filepath.Join(dir, "recordings")
+	runner, err := claude.NewRecordingRunner(claude.NewClaudeRunner(), recordings)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	responses, errs := runner.NewStreamParser(stdout, logrus.New()).ParseStream(ctx)
+	var contents []string
+	for resp := range responses {
+		contents = append(contents, resp.Content)
+	}
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.NoError(t, cmd.Wait())
+	assert.Equal(t, []string{"it's done", `quotes "and" $vars`, ""}, contents)
+
+	// 녹화 파일에는 스텁이 낸 출력과 대기 간격이 그대로 남음
+	files, err := filepath.Glob(filepath.Join(recordings, "*.jsonl"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	recorded, err := claude.LoadRecording(files[0])
+	require.NoError(t, err)
+	require.Len(t, recorded, len(transcript))
+	for i := range transcript {
+		assert.Equal(t, transcript[i].Line, recorded[i].Line)
+	}
+	assert.GreaterOrEqual(t, recorded[1].OffsetMS, int64(20))
+}
@@ -20,6 +20,8 @@ type ExecutionSummary struct {
 	Duration     int64         `json:"duration_ms"`     // 밀리초
 	InputTokens  int           `json:"input_tokens"`
 	OutputTokens int           `json:"output_tokens"`
+	Provider     string        `json:"provider,omitempty"`
+	CostUSD      float64       `json:"cost_usd,omitempty"`
 	ErrorMessage string        `json:"error_message,omitempty"`
 	SessionID    string        `json:"session_id,omitempty"`
 	StartedAt    time.Time     `json:"started_at"`
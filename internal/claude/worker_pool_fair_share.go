@@ -0,0 +1,234 @@
+package claude
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultTenantKey 사용자/조직 정보가 없는 태스크가 속하는 테넌트
+const DefaultTenantKey = "default"
+
+// TaskTenant 태스크를 제출한 사용자와 조직
+type TaskTenant struct {
+	OrgID  string `json:"org_id,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+// Key 공정 분배 스케줄링에 쓰이는 테넌트 키 ("조직/사용자")
+func (t TaskTenant) Key() string {
+	if t.OrgID == "" && t.UserID == "" {
+		return DefaultTenantKey
+	}
+	return t.OrgID + "/" + t.UserID
+}
+
+// TenantTask 사용자/조직 정보를 제공하는 태스크 (구현하지 않으면 기본 테넌트로 취급)
+type TenantTask interface {
+	Task
+	GetTenant() TaskTenant
+}
+
+// FairShareConfig 테넌트 간 공정 분배 스케줄링 설정
+type FairShareConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// 가중 공정 큐잉: 가중치가 클수록 같은 시간에 더 많은 작업을 배정받음
+	DefaultWeight float64            `json:"default_weight"`
+	Weights       map[string]float64 `json:"weights,omitempty"` // 테넌트 키별 가중치
+
+	// 테넌트별 동시 실행 상한 (0이면 무제한)
+	MaxConcurrentPerTenant int            `json:"max_concurrent_per_tenant"`
+	TenantConcurrency      map[string]int `json:"tenant_concurrency,omitempty"` // 테넌트 키별 상한
+
+	// 이 시간 이상 대기한 태스크는 우선순위와 공정 분배 순서보다 먼저 배정 (0이면 비활성)
+	StarvationThreshold time.Duration `json:"starvation_threshold"`
+}
+
+// DefaultFairShareConfig 기본 공정 분배 설정
+func DefaultFairShareConfig() FairShareConfig {
+	return FairShareConfig{
+		Enabled:             true,
+		DefaultWeight:       1,
+		StarvationThreshold: 5 * time.Minute,
+	}
+}
+
+// TenantStats 테넌트별 워커 풀 사용 현황
+type TenantStats struct {
+	Weight        float64 `json:"weight"`
+	MaxConcurrent int     `json:"max_concurrent,omitempty"`
+	Running       int     `json:"running"`
+	Pending       int     `json:"pending"`
+	Completed     int64   `json:"completed"`
+	Failed        int64   `json:"failed"`
+	Starved       int64   `json:"starved"` // 기아 방지로 먼저 배정된 횟수
+}
+
+// fairShareScheduler 시작 시각 기반 가중 공정 큐잉(SFQ)으로 태스크에 가상 시작 태그를 부여합니다
+type fairShareScheduler struct {
+	config FairShareConfig
+
+	mu          sync.Mutex
+	virtualTime float64
+	tenants     map[string]*tenantState
+}
+
+type tenantState struct {
+	lastFinish float64
+	stats      TenantStats
+}
+
+func newFairShareScheduler(config FairShareConfig) *fairShareScheduler {
+	if config.DefaultWeight <= 0 {
+		config.DefaultWeight = 1
+	}
+	return &fairShareScheduler{
+		config:  config,
+		tenants: make(map[string]*tenantState),
+	}
+}
+
+func (fs *fairShareScheduler) weight(key string) float64 {
+	if weight, ok := fs.config.Weights[key]; ok && weight > 0 {
+		return weight
+	}
+	return fs.config.DefaultWeight
+}
+
+func (fs *fairShareScheduler) maxConcurrent(key string) int {
+	if limit, ok := fs.config.TenantConcurrency[key]; ok {
+		return limit
+	}
+	return fs.config.MaxConcurrentPerTenant
+}
+
+func (fs *fairShareScheduler) tenant(key string) *tenantState {
+	state, ok := fs.tenants[key]
+	if !ok {
+		state = &tenantState{}
+		fs.tenants[key] = state
+	}
+	return state
+}
+
+// enqueue 새로 제출된 태스크에 테넌트와 가상 시작 태그를 부여합니다
+func (fs *fairShareScheduler) enqueue(task *TaskWrapper) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	task.tenant = DefaultTenantKey
+	if tenantTask, ok := task.Task.(TenantTask); ok {
+		task.tenant = tenantTask.GetTenant().Key()
+	}
+	state := fs.tenant(task.tenant)
+	state.stats.Pending++
+
+	if !fs.config.Enabled {
+		return
+	}
+
+	// 예상 실행 시간을 비용으로, 가중치로 나눈 만큼 테넌트의 가상 시간이 진행됨
+	cost := task.Task.GetEstimatedDuration().Seconds()
+	if cost <= 0 {
+		cost = 1
+	}
+	start := fs.virtualTime
+	if state.lastFinish > start {
+		start = state.lastFinish
+	}
+	task.startTag = start
+	state.lastFinish = start + cost/fs.weight(task.tenant)
+}
+
+// requeue 선점된 태스크를 기존 태그 그대로 다시 대기 상태로 돌립니다
+func (fs *fairShareScheduler) requeue(task *TaskWrapper) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	state := fs.tenant(task.tenant)
+	state.stats.Running--
+	state.stats.Pending++
+}
+
+// canRun 테넌트가 동시 실행 상한에 도달하지 않았는지 확인합니다
+func (fs *fairShareScheduler) canRun(task TaskWrapper) bool {
+	if !fs.config.Enabled {
+		return true
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	limit := fs.maxConcurrent(task.tenant)
+	return limit <= 0 || fs.tenant(task.tenant).stats.Running < limit
+}
+
+// started 태스크가 워커에 배정되어 가상 시간을 진행합니다
+func (fs *fairShareScheduler) started(task TaskWrapper) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	state := fs.tenant(task.tenant)
+	state.stats.Pending--
+	state.stats.Running++
+	if task.startTag > fs.virtualTime {
+		fs.virtualTime = task.startTag
+	}
+	if task.starved {
+		state.stats.Starved++
+	}
+}
+
+// finished 태스크 실행 결과를 테넌트 통계에 반영합니다
+func (fs *fairShareScheduler) finished(task TaskWrapper, err error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	state := fs.tenant(task.tenant)
+	state.stats.Running--
+	if err == nil {
+		state.stats.Completed++
+	} else {
+		state.stats.Failed++
+	}
+}
+
+// dropped 대기 중에 취소된 태스크를 대기 수에서 뺍니다
+func (fs *fairShareScheduler) dropped(task TaskWrapper) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.tenant(task.tenant).stats.Pending--
+}
+
+// promoteStarved 기아 임계값을 넘긴 대기 태스크를 표시하고, 변경이 있으면 true를 반환합니다
+func (fs *fairShareScheduler) promoteStarved(pending taskHeap, now time.Time) bool {
+	threshold := fs.config.StarvationThreshold
+	if !fs.config.Enabled || threshold <= 0 {
+		return false
+	}
+
+	promoted := false
+	for i := range pending {
+		if !pending[i].starved && now.Sub(pending[i].StartTime) >= threshold {
+			pending[i].starved = true
+			promoted = true
+		}
+	}
+	return promoted
+}
+
+// snapshot 테넌트별 통계 복사본
+func (fs *fairShareScheduler) snapshot() map[string]TenantStats {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	result := make(map[string]TenantStats, len(fs.tenants))
+	for key, state := range fs.tenants {
+		stats := state.stats
+		stats.Weight = fs.weight(key)
+		stats.MaxConcurrent = fs.maxConcurrent(key)
+		result[key] = stats
+	}
+	return result
+}
@@ -0,0 +1,125 @@
+package claude
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tenantTestTask 테넌트 정보를 가진 테스트 태스크
+type tenantTestTask struct {
+	*schedulerTestTask
+	tenant TaskTenant
+}
+
+func (t *tenantTestTask) GetTenant() TaskTenant { return t.tenant }
+
+func newFairShareTestPool(t *testing.T, maxWorkers int, fairShare FairShareConfig) *WorkerPoolManager {
+	t.Helper()
+	config := DefaultWorkerPoolConfig()
+	config.MinWorkers = 1
+	config.MaxWorkers = maxWorkers
+	config.TaskTimeout = 5 * time.Second
+	config.Preemption.Policy = PreemptionPolicyNone
+	config.FairShare = fairShare
+
+	pool := NewWorkerPoolManager(config)
+	require.NoError(t, pool.Start())
+	t.Cleanup(func() { pool.Stop() })
+	return pool
+}
+
+// blockPool 하나뿐인 워커를 점유하고, 해제 함수를 반환합니다
+func blockPool(t *testing.T, pool *WorkerPoolManager) func() {
+	t.Helper()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, pool.SpawnWorker(&schedulerTestTask{name: "blocker", priority: TaskPriorityNormal, run: func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}}))
+	<-started
+	return func() { close(release) }
+}
+
+func TestWorkerPool_WeightedFairQueuing(t *testing.T) {
+	config := DefaultFairShareConfig()
+	config.Weights = map[string]float64{"acme/bob": 2}
+	pool := newFairShareTestPool(t, 1, config)
+	log := &executionLog{}
+	release := blockPool(t, pool)
+
+	alice := TaskTenant{OrgID: "acme", UserID: "alice"}
+	bob := TaskTenant{OrgID: "acme", UserID: "bob"}
+	for _, name := range []string{"a1", "a2", "a3"} {
+		require.NoError(t, pool.SpawnWorker(&tenantTestTask{log.task(name, TaskPriorityNormal), alice}))
+	}
+	for _, name := range []string{"b1", "b2", "b3", "b4"} {
+		require.NoError(t, pool.SpawnWorker(&tenantTestTask{log.task(name, TaskPriorityNormal), bob}))
+	}
+	require.Eventually(t, func() bool { return pool.pendingCount() == 7 }, time.Second, 5*time.Millisecond)
+
+	// 가중치가 두 배인 bob은 alice가 한 번 실행될 때 두 번 실행됨
+	release()
+	require.Eventually(t, func() bool { return len(log.get()) == 7 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"a1", "b1", "b2", "a2", "b3", "b4", "a3"}, log.get())
+
+	tenants := pool.GetGoroutineStats().Tenants
+	assert.Equal(t, TenantStats{Weight: 1, Completed: 3}, tenants["acme/alice"])
+	assert.Equal(t, TenantStats{Weight: 2, Completed: 4}, tenants["acme/bob"])
+	assert.Equal(t, int64(1), tenants[DefaultTenantKey].Completed)
+}
+
+func TestWorkerPool_TenantConcurrencyCap(t *testing.T) {
+	config := DefaultFairShareConfig()
+	config.TenantConcurrency = map[string]int{"acme/alice": 1}
+	pool := newFairShareTestPool(t, 3, config)
+
+	release := make(chan struct{})
+	defer close(release)
+	blocking := func(name string, tenant TaskTenant) *tenantTestTask {
+		return &tenantTestTask{&schedulerTestTask{name: name, priority: TaskPriorityNormal, run: func(ctx context.Context) error {
+			<-release
+			return nil
+		}}, tenant}
+	}
+
+	alice := TaskTenant{OrgID: "acme", UserID: "alice"}
+	bob := TaskTenant{OrgID: "acme", UserID: "bob"}
+	require.NoError(t, pool.SpawnWorker(blocking("a1", alice)))
+	require.NoError(t, pool.SpawnWorker(blocking("a2", alice)))
+	require.NoError(t, pool.SpawnWorker(blocking("b1", bob)))
+
+	// alice는 상한 때문에 하나만 실행되고, 남은 워커는 bob이 사용
+	require.Eventually(t, func() bool {
+		tenants := pool.GetGoroutineStats().Tenants
+		return tenants["acme/alice"].Running == 1 && tenants["acme/alice"].Pending == 1 && tenants["acme/bob"].Running == 1
+	}, time.Second, 5*time.Millisecond)
+
+	tenants := pool.GetGoroutineStats().Tenants
+	assert.Equal(t, 1, tenants["acme/alice"].MaxConcurrent)
+	assert.Zero(t, tenants["acme/bob"].MaxConcurrent)
+}
+
+func TestWorkerPool_StarvationProtection(t *testing.T) {
+	config := DefaultFairShareConfig()
+	config.StarvationThreshold = 50 * time.Millisecond
+	pool := newFairShareTestPool(t, 1, config)
+	log := &executionLog{}
+	release := blockPool(t, pool)
+
+	require.NoError(t, pool.SpawnWorker(log.task("low", TaskPriorityLow)))
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, pool.SpawnWorker(log.task("high", TaskPriorityHigh)))
+	require.Eventually(t, func() bool { return pool.pendingCount() == 2 }, time.Second, 5*time.Millisecond)
+
+	// 오래 기다린 낮은 우선순위 태스크가 먼저 실행됨
+	release()
+	require.Eventually(t, func() bool { return len(log.get()) == 2 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"low", "high"}, log.get())
+	assert.Equal(t, int64(1), pool.GetGoroutineStats().Tenants[DefaultTenantKey].Starved)
+}
@@ -0,0 +1,394 @@
+package claude
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// PreemptionPolicy 워커 풀이 포화되었을 때 낮은 우선순위 태스크를 선점하는 방식
+type PreemptionPolicy string
+
+const (
+	// PreemptionPolicyNone 선점하지 않고 워커가 빌 때까지 대기
+	PreemptionPolicyNone PreemptionPolicy = "none"
+	// PreemptionPolicyRequeue 실행 중인 태스크를 취소하고 다시 대기열에 넣음
+	PreemptionPolicyRequeue PreemptionPolicy = "requeue"
+	// PreemptionPolicyPause 실행 중인 태스크를 일시 중지하고 임시 워커에서 실행
+	// (PausableTask가 아니면 재큐잉으로 대체)
+	PreemptionPolicyPause PreemptionPolicy = "pause"
+)
+
+// PreemptionConfig 선점 정책 설정
+type PreemptionConfig struct {
+	Policy                 PreemptionPolicy `json:"policy"`
+	MinPreemptorPriority   TaskPriority     `json:"min_preemptor_priority"`   // 선점할 수 있는 최소 우선순위
+	MaxPreemptiblePriority TaskPriority     `json:"max_preemptible_priority"` // 선점될 수 있는 최대 우선순위
+	MaxPreemptionsPerTask  int              `json:"max_preemptions_per_task"` // 태스크당 최대 선점 횟수 (0이면 무제한)
+}
+
+// DefaultPreemptionConfig 기본 선점 정책 (긴급 태스크가 보통 이하 태스크를 재큐잉)
+func DefaultPreemptionConfig() PreemptionConfig {
+	return PreemptionConfig{
+		Policy:                 PreemptionPolicyRequeue,
+		MinPreemptorPriority:   TaskPriorityCritical,
+		MaxPreemptiblePriority: TaskPriorityNormal,
+		MaxPreemptionsPerTask:  3,
+	}
+}
+
+// taskPriorityNames 설정 파일에서 쓰는 우선순위 이름
+var taskPriorityNames = map[string]TaskPriority{
+	"low":      TaskPriorityLow,
+	"normal":   TaskPriorityNormal,
+	"high":     TaskPriorityHigh,
+	"critical": TaskPriorityCritical,
+}
+
+// ParseTaskPriority 우선순위 이름(low, normal, high, critical)을 TaskPriority로 변환합니다
+func ParseTaskPriority(name string) (TaskPriority, error) {
+	priority, ok := taskPriorityNames[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown task priority: %q", name)
+	}
+	return priority, nil
+}
+
+// PausableTask 선점 시 일시 중지할 수 있는 태스크
+type PausableTask interface {
+	Task
+	Pause() error
+	Resume() error
+}
+
+// PreemptionEvent 선점 이벤트 기록
+type PreemptionEvent struct {
+	Timestamp         time.Time        `json:"timestamp"`
+	Policy            PreemptionPolicy `json:"policy"`
+	WorkerID          int              `json:"worker_id"`
+	PreemptedTask     string           `json:"preempted_task"`
+	PreemptedPriority TaskPriority     `json:"preempted_priority"`
+	PreemptorTask     string           `json:"preemptor_task"`
+	PreemptorPriority TaskPriority     `json:"preemptor_priority"`
+	Preemptions       int              `json:"preemptions"` // 선점된 태스크의 누적 선점 횟수
+}
+
+// GetPreemptionEvents 최근 선점 이벤트를 반환합니다
+func (wpm *WorkerPoolManager) GetPreemptionEvents() []PreemptionEvent {
+	wpm.preemptionMutex.RLock()
+	defer wpm.preemptionMutex.RUnlock()
+
+	events := make([]PreemptionEvent, len(wpm.preemptions))
+	copy(events, wpm.preemptions)
+	return events
+}
+
+// taskHeap 기아 상태인 태스크, 우선순위가 높은 순, 공정 분배 태그가 작은 순,
+// 먼저 제출된 순으로 정렬되는 대기열
+type taskHeap []TaskWrapper
+
+func (h taskHeap) Len() int { return len(h) }
+
+func (h taskHeap) Less(i, j int) bool {
+	if h[i].starved != h[j].starved {
+		return h[i].starved
+	}
+	pi, pj := h[i].Task.GetPriority(), h[j].Task.GetPriority()
+	if pi != pj {
+		return pi > pj
+	}
+	if h[i].startTag != h[j].startTag {
+		return h[i].startTag < h[j].startTag
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h taskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *taskHeap) Push(x interface{}) { *h = append(*h, x.(TaskWrapper)) }
+
+func (h *taskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// wake 디스패처가 대기 태스크를 다시 배정하도록 깨웁니다
+func (wpm *WorkerPoolManager) wake() {
+	select {
+	case wpm.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (wpm *WorkerPoolManager) enqueuePending(task TaskWrapper) {
+	wpm.pendingMutex.Lock()
+	defer wpm.pendingMutex.Unlock()
+
+	if task.seq == 0 {
+		wpm.nextSeq++
+		task.seq = wpm.nextSeq
+		wpm.fairShare.enqueue(&task)
+	}
+	heap.Push(&wpm.pending, task)
+}
+
+func (wpm *WorkerPoolManager) pendingCount() int {
+	wpm.pendingMutex.Lock()
+	defer wpm.pendingMutex.Unlock()
+	return wpm.pending.Len()
+}
+
+// dispatchPending 대기 태스크를 우선순위와 공정 분배 순서로 유휴 워커에 배정합니다
+func (wpm *WorkerPoolManager) dispatchPending() {
+	wpm.pendingMutex.Lock()
+	defer wpm.pendingMutex.Unlock()
+
+	if wpm.fairShare.promoteStarved(wpm.pending, time.Now()) {
+		heap.Init(&wpm.pending)
+	}
+
+	// 동시 실행 상한에 걸린 테넌트의 태스크는 건너뛰었다가 다시 넣음
+	var deferred []TaskWrapper
+	defer func() {
+		for _, task := range deferred {
+			heap.Push(&wpm.pending, task)
+		}
+	}()
+
+	for wpm.pending.Len() > 0 {
+		next := heap.Pop(&wpm.pending).(TaskWrapper)
+
+		// 대기 중에 취소되거나 시간이 초과된 태스크는 버림
+		if next.Ctx.Err() != nil {
+			delete(wpm.reclaiming, next.seq)
+			wpm.fairShare.dropped(next)
+			next.Cancel()
+			continue
+		}
+
+		if !wpm.fairShare.canRun(next) {
+			deferred = append(deferred, next)
+			continue
+		}
+
+		if wpm.assignToIdleWorker(next) {
+			delete(wpm.reclaiming, next.seq)
+			wpm.fairShare.started(next)
+			continue
+		}
+
+		// 풀이 포화됨: 선점할 태스크가 없으면 워커가 빌 때까지 대기
+		if wpm.reclaiming[next.seq] || !wpm.preempt(next) {
+			heap.Push(&wpm.pending, next)
+			return
+		}
+		if wpm.reclaiming[next.seq] {
+			// 재큐잉 선점: 선점된 태스크가 중단되어 워커가 빌 때까지 대기
+			heap.Push(&wpm.pending, next)
+			return
+		}
+		// 일시 중지 선점: 임시 워커에 배정됨
+		wpm.fairShare.started(next)
+	}
+}
+
+// assignToIdleWorker 유휴 워커에 태스크를 넘깁니다 (필요하면 워커를 새로 생성)
+func (wpm *WorkerPoolManager) assignToIdleWorker(task TaskWrapper) bool {
+	if wpm.sendToIdleWorker(task) {
+		return true
+	}
+	if err := wpm.createWorker(); err != nil {
+		return false
+	}
+	return wpm.sendToIdleWorker(task)
+}
+
+func (wpm *WorkerPoolManager) sendToIdleWorker(task TaskWrapper) bool {
+	wpm.workersMutex.Lock()
+	defer wpm.workersMutex.Unlock()
+
+	for _, worker := range wpm.workers {
+		if worker.getState() != WorkerStateIdle || worker.borrowed {
+			continue
+		}
+		select {
+		case worker.TaskQueue <- task:
+			worker.setState(WorkerStateBusy)
+			return true
+		default:
+		}
+	}
+	return false
+}
+
+// preempt 설정된 정책에 따라 낮은 우선순위 태스크를 선점합니다
+func (wpm *WorkerPoolManager) preempt(preemptor TaskWrapper) bool {
+	config := wpm.config.Preemption
+	priority := preemptor.Task.GetPriority()
+	if config.Policy != PreemptionPolicyRequeue && config.Policy != PreemptionPolicyPause {
+		return false
+	}
+	if priority < config.MinPreemptorPriority {
+		return false
+	}
+
+	victim := wpm.selectVictim(priority)
+	if victim == nil {
+		return false
+	}
+
+	victim.currentMutex.Lock()
+	current := victim.CurrentTask
+	if current == nil || victim.preempted || victim.paused {
+		victim.currentMutex.Unlock()
+		return false
+	}
+
+	policy := config.Policy
+	var pausable PausableTask
+	if policy == PreemptionPolicyPause {
+		if p, ok := current.Task.(PausableTask); ok && p.Pause() == nil {
+			pausable = p
+			victim.paused = true
+		} else {
+			policy = PreemptionPolicyRequeue
+		}
+	}
+	if policy == PreemptionPolicyRequeue {
+		victim.preempted = true
+		current.Cancel()
+	}
+	victim.currentMutex.Unlock()
+
+	if pausable != nil {
+		wpm.runOnBorrowedWorker(preemptor, victim, pausable)
+	} else {
+		wpm.reclaiming[preemptor.seq] = true
+	}
+
+	wpm.recordPreemption(PreemptionEvent{
+		Timestamp:         time.Now(),
+		Policy:            policy,
+		WorkerID:          victim.ID,
+		PreemptedTask:     current.Task.GetDescription(),
+		PreemptedPriority: current.Task.GetPriority(),
+		PreemptorTask:     preemptor.Task.GetDescription(),
+		PreemptorPriority: priority,
+		Preemptions:       current.preemptions + 1,
+	})
+	return true
+}
+
+// selectVictim 선점할 워커를 고릅니다 (가장 낮은 우선순위, 같으면 가장 늦게 제출된 태스크)
+func (wpm *WorkerPoolManager) selectVictim(priority TaskPriority) *Worker {
+	config := wpm.config.Preemption
+
+	wpm.workersMutex.RLock()
+	defer wpm.workersMutex.RUnlock()
+
+	var victim *Worker
+	var victimTask *TaskWrapper
+	for _, worker := range wpm.workers {
+		worker.currentMutex.RLock()
+		current := worker.CurrentTask
+		eligible := current != nil && !worker.preempted && !worker.paused && !worker.borrowed
+		worker.currentMutex.RUnlock()
+		if !eligible {
+			continue
+		}
+
+		p := current.Task.GetPriority()
+		if p >= priority || p > config.MaxPreemptiblePriority {
+			continue
+		}
+		if config.MaxPreemptionsPerTask > 0 && current.preemptions >= config.MaxPreemptionsPerTask {
+			continue
+		}
+		if victimTask == nil || p < victimTask.Task.GetPriority() ||
+			(p == victimTask.Task.GetPriority() && current.seq > victimTask.seq) {
+			victim, victimTask = worker, current
+		}
+	}
+	return victim
+}
+
+// runOnBorrowedWorker 일시 중지한 태스크의 자리를 대신할 임시 워커에서 실행합니다
+func (wpm *WorkerPoolManager) runOnBorrowedWorker(preemptor TaskWrapper, victim *Worker, paused PausableTask) {
+	preemptor.afterExecute = func() {
+		victim.currentMutex.Lock()
+		victim.paused = false
+		victim.currentMutex.Unlock()
+		paused.Resume()
+	}
+
+	wpm.workersMutex.Lock()
+	defer wpm.workersMutex.Unlock()
+
+	worker := wpm.newWorkerLocked()
+	worker.borrowed = true
+	worker.setState(WorkerStateBusy)
+	worker.TaskQueue <- preemptor
+	go worker.Run()
+}
+
+// requeuePreempted 재큐잉 선점된 태스크를 새 컨텍스트로 다시 대기열에 넣습니다
+func (wpm *WorkerPoolManager) requeuePreempted(wrapper TaskWrapper) {
+	if !wpm.running.Load() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(wpm.ctx, wrapper.Timeout)
+	wrapper.Ctx = ctx
+	wrapper.Cancel = cancel
+	wrapper.preemptions++
+
+	wpm.fairShare.requeue(&wrapper)
+	wpm.enqueuePending(wrapper)
+	wpm.wake()
+}
+
+// retireWorker 임시 워커를 풀에서 제거합니다
+func (wpm *WorkerPoolManager) retireWorker(worker *Worker) {
+	wpm.workersMutex.Lock()
+	delete(wpm.workers, worker.ID)
+	wpm.workersMutex.Unlock()
+	worker.cancel()
+}
+
+func (wpm *WorkerPoolManager) recordPreemption(event PreemptionEvent) {
+	wpm.preemptionMutex.Lock()
+	wpm.preemptions = append(wpm.preemptions, event)
+	if len(wpm.preemptions) > wpm.maxPreemptions {
+		wpm.preemptions = wpm.preemptions[len(wpm.preemptions)-wpm.maxPreemptions:]
+	}
+	wpm.preemptionMutex.Unlock()
+
+	wpm.statsMutex.Lock()
+	wpm.stats.Preemptions++
+	wpm.statsMutex.Unlock()
+}
+
+// newWorkerLocked 워커를 만들어 등록합니다 (workersMutex를 잡은 상태에서 호출)
+func (wpm *WorkerPoolManager) newWorkerLocked() *Worker {
+	workerID := int(atomic.AddInt32(&wpm.nextWorkerID, 1))
+	ctx, cancel := context.WithCancel(wpm.ctx)
+
+	worker := &Worker{
+		ID:        workerID,
+		State:     WorkerStateIdle,
+		TaskQueue: make(chan TaskWrapper, 1),
+		QuitChan:  make(chan bool, 1),
+		Pool:      wpm,
+		StartTime: time.Now(),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+	wpm.workers[workerID] = worker
+	return worker
+}
@@ -0,0 +1,173 @@
+package claude
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// schedulerTestTask 실행 동작을 주입할 수 있는 테스트 태스크
+type schedulerTestTask struct {
+	name     string
+	priority TaskPriority
+	run      func(ctx context.Context) error
+}
+
+func (t *schedulerTestTask) Execute(ctx context.Context) error { return t.run(ctx) }
+func (t *schedulerTestTask) GetPriority() TaskPriority         { return t.priority }
+func (t *schedulerTestTask) GetEstimatedDuration() time.Duration {
+	return time.Second
+}
+func (t *schedulerTestTask) GetDescription() string { return t.name }
+
+// pausableTestTask 일시 중지/재개 호출을 기록하는 테스트 태스크
+type pausableTestTask struct {
+	schedulerTestTask
+	paused  atomic.Int32
+	resumed atomic.Int32
+}
+
+func (t *pausableTestTask) Pause() error  { t.paused.Add(1); return nil }
+func (t *pausableTestTask) Resume() error { t.resumed.Add(1); return nil }
+
+func newSchedulerTestPool(t *testing.T, policy PreemptionPolicy) *WorkerPoolManager {
+	t.Helper()
+	config := DefaultWorkerPoolConfig()
+	config.MinWorkers = 1
+	config.MaxWorkers = 1
+	config.TaskTimeout = 5 * time.Second
+	config.Preemption.Policy = policy
+
+	pool := NewWorkerPoolManager(config)
+	require.NoError(t, pool.Start())
+	t.Cleanup(func() { pool.Stop() })
+	return pool
+}
+
+// executionLog 실행 순서 기록
+type executionLog struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (l *executionLog) task(name string, priority TaskPriority) *schedulerTestTask {
+	return &schedulerTestTask{name: name, priority: priority, run: func(ctx context.Context) error {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.names = append(l.names, name)
+		return nil
+	}}
+}
+
+func (l *executionLog) get() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.names...)
+}
+
+func TestWorkerPool_PriorityOrder(t *testing.T) {
+	pool := newSchedulerTestPool(t, PreemptionPolicyNone)
+	log := &executionLog{}
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.NoError(t, pool.SpawnWorker(&schedulerTestTask{name: "blocker", priority: TaskPriorityLow, run: func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}}))
+	<-started
+
+	require.NoError(t, pool.SpawnWorker(log.task("normal-1", TaskPriorityNormal)))
+	require.NoError(t, pool.SpawnWorker(log.task("low", TaskPriorityLow)))
+	require.NoError(t, pool.SpawnWorker(log.task("critical", TaskPriorityCritical)))
+	require.NoError(t, pool.SpawnWorker(log.task("normal-2", TaskPriorityNormal)))
+	require.NoError(t, pool.SpawnWorker(log.task("high", TaskPriorityHigh)))
+	require.Eventually(t, func() bool { return pool.pendingCount() == 5 }, time.Second, 5*time.Millisecond)
+
+	close(release)
+	require.Eventually(t, func() bool { return len(log.get()) == 5 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"critical", "high", "normal-1", "normal-2", "low"}, log.get())
+	assert.Empty(t, pool.GetPreemptionEvents())
+}
+
+func TestWorkerPool_PreemptRequeue(t *testing.T) {
+	pool := newSchedulerTestPool(t, PreemptionPolicyRequeue)
+	log := &executionLog{}
+
+	var runs atomic.Int32
+	started := make(chan struct{}, 2)
+	require.NoError(t, pool.SpawnWorker(&schedulerTestTask{name: "batch", priority: TaskPriorityLow, run: func(ctx context.Context) error {
+		// 첫 실행은 선점될 때까지 대기, 재큐잉된 실행은 바로 완료
+		if runs.Add(1) == 1 {
+			started <- struct{}{}
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		log.task("batch", TaskPriorityLow).run(ctx)
+		return nil
+	}}))
+	<-started
+
+	require.NoError(t, pool.SpawnWorker(log.task("critical", TaskPriorityCritical)))
+	require.Eventually(t, func() bool { return len(log.get()) == 2 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"critical", "batch"}, log.get())
+	assert.Equal(t, int32(2), runs.Load())
+
+	events := pool.GetPreemptionEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, PreemptionPolicyRequeue, events[0].Policy)
+	assert.Equal(t, "batch", events[0].PreemptedTask)
+	assert.Equal(t, "critical", events[0].PreemptorTask)
+	assert.Equal(t, 1, events[0].Preemptions)
+
+	pool.updateStatistics()
+	stats := pool.GetGoroutineStats()
+	assert.Equal(t, int64(1), stats.Preemptions)
+	assert.Equal(t, int64(2), stats.Completed)
+	assert.Zero(t, stats.Failed)
+}
+
+func TestWorkerPool_PreemptPause(t *testing.T) {
+	pool := newSchedulerTestPool(t, PreemptionPolicyPause)
+	log := &executionLog{}
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	victim := &pausableTestTask{schedulerTestTask: schedulerTestTask{name: "batch", priority: TaskPriorityNormal}}
+	victim.run = func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}
+	require.NoError(t, pool.SpawnWorker(victim))
+	<-started
+
+	// 긴급하지 않은 태스크는 선점하지 않음
+	require.NoError(t, pool.SpawnWorker(log.task("high", TaskPriorityHigh)))
+	require.NoError(t, pool.SpawnWorker(log.task("critical", TaskPriorityCritical)))
+
+	require.Eventually(t, func() bool { return victim.resumed.Load() == 1 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"critical"}, log.get())
+	assert.Equal(t, int32(1), victim.paused.Load())
+
+	events := pool.GetPreemptionEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, PreemptionPolicyPause, events[0].Policy)
+
+	// 임시 워커가 정리되어 최대 워커 수로 돌아옴
+	require.Eventually(t, func() bool {
+		pool.workersMutex.RLock()
+		defer pool.workersMutex.RUnlock()
+		return len(pool.workers) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	close(release)
+	require.Eventually(t, func() bool { return len(log.get()) == 2 }, 2*time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"critical", "high"}, log.get())
+}
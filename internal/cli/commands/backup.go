@@ -0,0 +1,181 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aicli/aicli-web/internal/backup"
+)
+
+// NewBackupCmd 백업 명령어 생성
+func NewBackupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "백업 및 복원 명령어",
+		Long:  "스토리지, 시크릿 파일의 스냅샷을 생성하고 검증/복원합니다.",
+	}
+
+	cmd.AddCommand(
+		newBackupCreateCommand(),
+		newBackupListCommand(),
+		newBackupVerifyCommand(),
+		newBackupRestoreCommand(),
+	)
+
+	return cmd
+}
+
+// newBackupCreateCommand 백업 생성 명령어
+func newBackupCreateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create",
+		Short: "즉시 백업 생성",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createBackupManager()
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := manager.Create(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("백업 생성 실패: %w", err)
+			}
+
+			fmt.Printf("✅ 백업이 생성되었습니다: %s (%d bytes)\n", snapshot.ID, snapshot.Size)
+			fmt.Printf("   항목: %s\n", strings.Join(snapshot.Entries, ", "))
+			return nil
+		},
+	}
+}
+
+// newBackupListCommand 백업 목록 명령어
+func newBackupListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "백업 목록 조회",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createBackupManager()
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := manager.List(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("백업 목록 조회 실패: %w", err)
+			}
+			if len(snapshots) == 0 {
+				fmt.Println("저장된 백업이 없습니다.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tCREATED\tSIZE")
+			for _, s := range snapshots {
+				fmt.Fprintf(w, "%s\t%s\t%d\n", s.ID, s.CreatedAt.Local().Format(time.RFC3339), s.Size)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// newBackupVerifyCommand 백업 검증 명령어
+func newBackupVerifyCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify <snapshot-id>",
+		Short: "백업 무결성 검증",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createBackupManager()
+			if err != nil {
+				return err
+			}
+
+			manifest, err := manager.Verify(cmd.Context(), args[0])
+			if err != nil {
+				return fmt.Errorf("백업 검증 실패: %w", err)
+			}
+
+			fmt.Printf("✅ 백업 %s 검증 완료\n", manifest.ID)
+			for _, entry := range manifest.Entries {
+				fmt.Printf("   %s (%d bytes, sha256 %s)\n", entry.Name, entry.Size, entry.SHA256[:12])
+			}
+			return nil
+		},
+	}
+}
+
+// newBackupRestoreCommand 백업 복원 명령어
+func newBackupRestoreCommand() *cobra.Command {
+	var (
+		only []string
+		yes  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore <snapshot-id>",
+		Short: "백업 복원",
+		Long: `백업을 검증한 뒤 스토리지 파일과 시크릿 파일을 복원합니다.
+복원 전에 API 서버를 중지해야 합니다.
+
+예시:
+  aicli backup restore 20261015T030000.000Z-1a2b3c4d
+  aicli backup restore 20261015T030000.000Z-1a2b3c4d --only secrets.tar`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !yes && !confirmRestore(args[0]) {
+				fmt.Println("복원이 취소되었습니다.")
+				return nil
+			}
+
+			manager, err := createBackupManager()
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Minute)
+			defer cancel()
+
+			restored, err := manager.Restore(ctx, args[0], backup.RestoreOptions{Only: only})
+			if err != nil {
+				return fmt.Errorf("복원 실패: %w", err)
+			}
+
+			fmt.Printf("✅ 복원이 완료되었습니다: %s\n", strings.Join(restored, ", "))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&only, "only", nil, "복원할 항목 (예: storage.sqlite, secrets.tar)")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "확인 없이 복원")
+
+	return cmd
+}
+
+// createBackupManager 설정으로 백업 관리자 생성
+func createBackupManager() (*backup.Manager, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	manager, err := backup.NewManagerFromConfig(cfg, nil)
+	if err != nil {
+		return nil, fmt.Errorf("백업 관리자 생성 실패: %w", err)
+	}
+	return manager, nil
+}
+
+// confirmRestore 사용자 확인
+func confirmRestore(id string) bool {
+	fmt.Printf("백업 %s로 현재 데이터를 덮어씁니다. 계속하시겠습니까? [y/N]: ", id)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,193 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/aicli/aicli-web/internal/bench"
+)
+
+// EnvBenchPassword 부하 테스트 로그인 비밀번호 환경 변수 (--password가 없을 때 사용)
+const EnvBenchPassword = "AICLI_BENCH_PASSWORD"
+
+// NewBenchCmd 부하 테스트 명령어 생성
+func NewBenchCmd() *cobra.Command {
+	var (
+		config     bench.Config
+		thresholds bench.Thresholds
+	)
+
+	cmd := &cobra.Command{
+		Use:   "bench",
+		Short: "API 부하/내구성 테스트",
+		Long: `실행 중인 API 서버에 동시 요청을 보내 단계별 지연 시간 백분위수와 에러율을 보고합니다.
+
+워커마다 다음 흐름을 한 반복으로 실행합니다:
+  로그인 → 워크스페이스/프로젝트/세션 생성 → 태스크 실행 → 출력 스트리밍 → 워크스페이스 삭제
+
+프로젝트 디렉토리는 --work-dir 아래에 만들므로 서버와 같은 파일 시스템에서 실행해야 합니다.
+실제 Claude API를 쓰지 않으려면 'aicli bench install-stub'으로 만든 스텁을 서버 PATH 앞에 두고 실행하세요.
+--max-error-rate, --max-p95 기준을 넘으면 0이 아닌 코드로 종료하므로 CI에서 성능 회귀를 확인할 수 있습니다.`,
+		Example: `  # 스텁 설치 후 서버 실행
+  aicli bench install-stub --dir /tmp/aicli-stub
+  PATH=/tmp/aicli-stub:$PATH aicli-api &
+
+  # 동시 8개 워커로 100회 실행
+  aicli bench --url http://localhost:8080 --password admin123 -c 8 -n 100
+
+  # 5분 동안 실행하고 기준을 넘으면 실패
+  aicli bench --duration 5m --max-error-rate 0.01 --max-p95 3s -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Password == "" {
+				config.Password = os.Getenv(EnvBenchPassword)
+			}
+			if config.Password == "" {
+				return fmt.Errorf("로그인 비밀번호가 필요합니다 (--password 또는 %s)", EnvBenchPassword)
+			}
+			if thresholds.MaxErrorRate < 0 || thresholds.MaxErrorRate > 1 {
+				return errors.New("--max-error-rate는 0과 1 사이여야 합니다")
+			}
+
+			// Ctrl+C로 중단하면 새 반복을 시작하지 않고 지금까지의 결과를 보고
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			report, err := bench.Run(ctx, config)
+			if err != nil {
+				return fmt.Errorf("부하 테스트 실패: %w", err)
+			}
+			if err := printBenchReport(report); err != nil {
+				return err
+			}
+			return report.Check(thresholds)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&config.BaseURL, "url", "http://localhost:8080", "API 서버 주소")
+	flags.StringVar(&config.Username, "username", "admin", "로그인 사용자 이름")
+	flags.StringVar(&config.Password, "password", "", "로그인 비밀번호 (기본값: $"+EnvBenchPassword+")")
+	flags.IntVarP(&config.Concurrency, "concurrency", "c", bench.DefaultConcurrency, "동시에 실행할 워커 수")
+	flags.IntVarP(&config.Iterations, "iterations", "n", bench.DefaultIterations, "전체 반복 수 (--duration과 함께 쓰면 먼저 도달한 쪽에서 멈춤, 0이면 --duration까지)")
+	flags.DurationVar(&config.Duration, "duration", 0, "새 반복을 시작하는 시간 (내구성 테스트)")
+	flags.StringVar(&config.Command, "command", bench.DefaultCommand, "태스크로 실행할 명령")
+	flags.StringVar(&config.WorkDir, "work-dir", "", "프로젝트 디렉토리를 만들 경로 (기본값: 임시 디렉토리)")
+	flags.DurationVar(&config.PollWait, "poll-wait", bench.DefaultPollWait, "태스크 이벤트 롱 폴링 대기 시간")
+	flags.DurationVar(&config.Timeout, "timeout", bench.DefaultTimeout, "반복 하나의 제한 시간")
+	flags.Float64Var(&thresholds.MaxErrorRate, "max-error-rate", 1, "허용하는 반복 에러율 (0~1, 넘으면 실패)")
+	flags.DurationVar(&thresholds.MaxP95, "max-p95", 0, "허용하는 반복 p95 지연 시간 (0이면 확인하지 않음)")
+
+	cmd.AddCommand(
+		newBenchInstallStubCommand(),
+		newBenchStubClaudeCommand(),
+	)
+
+	return cmd
+}
+
+// newBenchInstallStubCommand 스텁 Claude CLI 설치 명령어
+func newBenchInstallStubCommand() *cobra.Command {
+	var dir string
+
+	cmd := &cobra.Command{
+		Use:   "install-stub",
+		Short: "스텁 Claude CLI 설치",
+		Long: `실제 Claude API 대신 정해진 stream-json 출력을 내는 claude 스크립트를 만듭니다.
+서버를 PATH=<dir>:$PATH로 실행하면 태스크의 claude 명령이 스텁을 실행합니다.
+
+스텁 동작은 서버 환경 변수로 조절합니다:
+  ` + bench.EnvStubLines + `      출력 메시지 수 (기본값: 5)
+  ` + bench.EnvStubDelay + `      메시지 사이 지연 (기본값: 20ms)
+  ` + bench.EnvStubFailRate + `  실패로 끝낼 확률 (0~1, 기본값: 0)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			executable, err := os.Executable()
+			if err != nil {
+				return fmt.Errorf("실행 파일 경로 확인 실패: %w", err)
+			}
+			path, err := bench.InstallStub(dir, executable)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("✅ 스텁이 설치되었습니다: %s\n", path)
+			fmt.Printf("   서버를 PATH=%s:$PATH로 실행하세요.\n", dir)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", "", "스텁을 만들 디렉토리")
+	cmd.MarkFlagRequired("dir")
+
+	return cmd
+}
+
+// newBenchStubClaudeCommand 스텁 Claude CLI 실행 명령어 (install-stub 스크립트가 호출)
+func newBenchStubClaudeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:    "stub-claude",
+		Short:  "스텁 Claude CLI 실행",
+		Hidden: true,
+		// Claude CLI 인자(-p, --output-format 등)를 그대로 받으므로 플래그를 해석하지 않음
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := bench.StubConfigFromEnv()
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return bench.RunStub(cmd.Context(), os.Stdout, config)
+		},
+	}
+}
+
+// printBenchReport 출력 형식에 맞게 보고서 출력
+func printBenchReport(report *bench.Report) error {
+	if viper.GetString("output") == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	fmt.Printf("반복 %d회 (실패 %d, 에러율 %.2f%%), %.1f초, %.2f회/초\n\n",
+		report.Iterations, report.Failed, report.ErrorRate*100, report.DurationSeconds, report.Throughput)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tCOUNT\tERRORS\tMEAN\tP50\tP90\tP95\tP99\tMAX")
+	for _, s := range report.Steps {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			s.Step, s.Count, s.Errors, formatMs(s.MeanMs), formatMs(s.P50Ms), formatMs(s.P90Ms),
+			formatMs(s.P95Ms), formatMs(s.P99Ms), formatMs(s.MaxMs))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, s := range report.Steps {
+		if len(s.ErrorKinds) == 0 {
+			continue
+		}
+		kinds := make([]string, 0, len(s.ErrorKinds))
+		for kind, n := range s.ErrorKinds {
+			kinds = append(kinds, fmt.Sprintf("%s ×%d", kind, n))
+		}
+		sort.Strings(kinds)
+		fmt.Printf("\n%s 에러: %s\n", s.Step, strings.Join(kinds, ", "))
+	}
+	return nil
+}
+
+// formatMs 밀리초 값을 읽기 쉬운 시간으로 표시
+func formatMs(ms float64) string {
+	return time.Duration(ms * float64(time.Millisecond)).Round(100 * time.Microsecond).String()
+}
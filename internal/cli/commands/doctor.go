@@ -0,0 +1,300 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/aicli/aicli-web/internal/cli/output"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/docker"
+	cliErrors "github.com/aicli/aicli-web/internal/errors"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/pkg/version"
+)
+
+// 시스템 점검 항목
+const (
+	doctorCheckConfig     = "config"
+	doctorCheckStorage    = "storage"
+	doctorCheckRedis      = "redis"
+	doctorCheckDocker     = "docker"
+	doctorCheckClaude     = "claude_binary"
+	doctorCheckClaudeAuth = "claude_auth"
+)
+
+// doctorOptions 시스템 점검 옵션
+type doctorOptions struct {
+	serverConfig string
+	bundle       string
+	timeout      time.Duration
+	claudePath   string
+}
+
+// NewDoctorCmd 시스템 진단 명령어 생성
+func NewDoctorCmd() *cobra.Command {
+	opts := &doctorOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "시스템 진단",
+		Long: `실행 환경 정보를 수집하고 API 서버가 의존하는 구성 요소를 점검합니다.
+
+점검 항목:
+  • API 서버 설정 파일 로드와 검증
+  • 스토리지 연결
+  • Redis 연결 (클러스터, 로그인 공격 탐지에 설정된 경우)
+  • Docker 데몬 연결
+  • Claude CLI 실행 파일과 인증 정보
+
+--bundle로 진단 정보와 점검 결과를 JSON 파일로 저장해 버그 리포트에 첨부할 수 있습니다.
+비밀 값은 번들에 포함하지 않습니다. 실패한 항목이 있으면 0이 아닌 종료 코드로 끝납니다.`,
+		Example: `  # 시스템 진단
+  aicli doctor
+
+  # 버그 리포트용 진단 번들 저장
+  aicli doctor --bundle aicli-doctor.json
+
+  # 특정 서버 설정 파일로 점검
+  aicli doctor --server-config /etc/aicli/config.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDoctor(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.serverConfig, "server-config", "", "API 서버 설정 파일 경로 (기본값: AICLI_CONFIG_PATH, ~/.aicli/config.yaml, /etc/aicli/config.yaml)")
+	cmd.Flags().StringVar(&opts.bundle, "bundle", "", "진단 번들(JSON)을 저장할 파일 경로")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 5*time.Second, "항목별 점검 제한 시간")
+	cmd.Flags().StringVar(&opts.claudePath, "claude-path", "claude", "Claude CLI 실행 파일 (이름이면 PATH에서 찾음)")
+
+	return cmd
+}
+
+// runDoctor 진단 정보를 수집하고 의존성을 점검해 보고서를 출력
+func runDoctor(ctx context.Context, opts *doctorOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	path := config.ResolveServerConfigPath(opts.serverConfig)
+	info := cliErrors.NewDiagnosticCollector(path, version.Version).Collect()
+
+	var cfg *config.Config
+	runDoctorCheck(info, doctorCheckConfig, func() cliErrors.DiagnosticCheck {
+		var check cliErrors.DiagnosticCheck
+		cfg, check = checkServerConfig(path)
+		return check
+	})
+	runDoctorCheck(info, doctorCheckStorage, func() cliErrors.DiagnosticCheck {
+		return checkStorage(cfg.Storage)
+	})
+	runDoctorCheck(info, doctorCheckRedis, func() cliErrors.DiagnosticCheck {
+		return checkRedis(ctx, cfg, opts.timeout)
+	})
+	runDoctorCheck(info, doctorCheckDocker, func() cliErrors.DiagnosticCheck {
+		return checkDockerDaemon(ctx, opts.timeout)
+	})
+	runDoctorCheck(info, doctorCheckClaude, func() cliErrors.DiagnosticCheck {
+		return checkClaudeBinary(ctx, opts.claudePath, opts.timeout)
+	})
+	runDoctorCheck(info, doctorCheckClaudeAuth, func() cliErrors.DiagnosticCheck {
+		return checkClaudeAuth(cfg)
+	})
+
+	switch output.Format(viper.GetString("output")) {
+	case output.FormatJSON, output.FormatYAML:
+		if err := output.DefaultFormatterManager().Print(info); err != nil {
+			return err
+		}
+	default:
+		fmt.Print(cliErrors.FormatDiagnosticReport(info))
+	}
+
+	if opts.bundle != "" {
+		if err := writeDoctorBundle(opts.bundle, info); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "\n진단 번들을 저장했습니다: %s\n", opts.bundle)
+	}
+
+	failed := 0
+	for _, check := range info.Checks {
+		if check.Status == cliErrors.CheckFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("시스템 진단에서 %d개 항목이 실패했습니다", failed)
+	}
+	return nil
+}
+
+// runDoctorCheck 점검 하나를 실행해 진단 정보에 추가
+func runDoctorCheck(info *cliErrors.DiagnosticInfo, name string, fn func() cliErrors.DiagnosticCheck) {
+	start := time.Now()
+	check := fn()
+	check.Name = name
+	check.DurationMs = time.Since(start).Milliseconds()
+	info.Checks = append(info.Checks, check)
+}
+
+// checkServerConfig 서버 설정을 읽고 검증 (실패해도 기본값과 환경 변수로 나머지 점검을 계속)
+func checkServerConfig(path string) (*config.Config, cliErrors.DiagnosticCheck) {
+	cfg, err := config.LoadServerConfig(path)
+	if err != nil {
+		fallback := config.GetDefaultConfig()
+		config.LoadFromEnv(fallback)
+		return fallback, cliErrors.NewDiagnosticCheck("", cliErrors.CheckFail, cliErrors.NewConfigError(err, path))
+	}
+	if path == "" {
+		return cfg, doctorCheck(cliErrors.CheckOK, "설정 파일 없이 기본값과 환경 변수를 사용합니다")
+	}
+	return cfg, doctorCheck(cliErrors.CheckOK, fmt.Sprintf("설정 파일을 읽었습니다: %s", path))
+}
+
+// checkStorage 설정한 스토리지를 API 서버가 사용할 수 있는지 확인
+func checkStorage(cfg config.StorageConfig) cliErrors.DiagnosticCheck {
+	switch storage.StorageType(cfg.Type) {
+	case "", storage.StorageTypeMemory:
+		return cliErrors.NewDiagnosticCheck("", cliErrors.CheckWarn, cliErrors.NewValidationError(
+			"메모리 스토리지를 사용합니다 (서버를 재시작하면 데이터가 사라짐)",
+			"API 서버는 아직 영구 스토리지를 지원하지 않으므로 중요한 데이터는 별도로 백업하세요"))
+	default:
+		// postgres는 워크스페이스/프로젝트/세션/태스크만 저장하므로 API 서버에서 사용할 수 없음
+		return cliErrors.NewDiagnosticCheck("", cliErrors.CheckFail, cliErrors.NewInvalidValueError("storage.type", cfg.Type, []string{"memory"}).
+			AddSuggestion("API 서버는 지원하지 않는 스토리지 타입이면 시작하지 않습니다"))
+	}
+}
+
+// checkRedis 설정된 Redis 주소에 연결할 수 있는지 확인
+func checkRedis(ctx context.Context, cfg *config.Config, timeout time.Duration) cliErrors.DiagnosticCheck {
+	targets := map[string]*redis.Options{}
+	if cfg.Cluster.Enabled && (cfg.Cluster.Backend == "" || cfg.Cluster.Backend == "redis") && cfg.Cluster.Redis.Addr != "" {
+		targets["cluster.redis"] = &redis.Options{Addr: cfg.Cluster.Redis.Addr, Password: cfg.Cluster.Redis.Password, DB: cfg.Cluster.Redis.DB}
+	}
+	if cfg.Accounts.Lockout.RedisAddr != "" {
+		targets["accounts.lockout"] = &redis.Options{Addr: cfg.Accounts.Lockout.RedisAddr}
+	}
+	if len(targets) == 0 {
+		return doctorCheck(cliErrors.CheckSkipped, "Redis를 사용하도록 설정되지 않았습니다")
+	}
+
+	var reached []string
+	for name, options := range targets {
+		options.DialTimeout = timeout
+		client := redis.NewClient(options)
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := client.Ping(pingCtx).Err()
+		cancel()
+		client.Close()
+		if err != nil {
+			return cliErrors.NewDiagnosticCheck("", cliErrors.CheckFail, cliErrors.NewNetworkError("Redis", err).
+				AddContext("setting", name).
+				AddSuggestion(fmt.Sprintf("%s 설정의 주소(%s)와 비밀번호를 확인하세요", name, options.Addr)))
+		}
+		reached = append(reached, fmt.Sprintf("%s(%s)", name, options.Addr))
+	}
+	return doctorCheck(cliErrors.CheckOK, "Redis에 연결했습니다: "+strings.Join(reached, ", "))
+}
+
+// checkDockerDaemon Docker 데몬에 연결할 수 있는지 확인
+func checkDockerDaemon(ctx context.Context, timeout time.Duration) cliErrors.DiagnosticCheck {
+	manager, err := docker.NewManagerWithDefaults()
+	if err != nil {
+		return cliErrors.NewDiagnosticCheck("", cliErrors.CheckWarn, cliErrors.NewNetworkError("Docker 데몬", err).
+			AddSuggestion("Docker 없이 실행하면 워크스페이스 컨테이너 격리를 사용할 수 없습니다"))
+	}
+	defer manager.Shutdown()
+
+	pingCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := manager.Client().Ping(pingCtx); err != nil {
+		return cliErrors.NewDiagnosticCheck("", cliErrors.CheckWarn, cliErrors.NewNetworkError("Docker 데몬", err).
+			AddSuggestion("DOCKER_HOST 설정과 Docker 소켓 권한을 확인하세요").
+			AddSuggestion("Docker 없이 실행하면 워크스페이스 컨테이너 격리를 사용할 수 없습니다"))
+	}
+	return doctorCheck(cliErrors.CheckOK, "Docker 데몬에 연결했습니다")
+}
+
+// checkClaudeBinary Claude CLI를 찾아 버전을 확인
+func checkClaudeBinary(ctx context.Context, command string, timeout time.Duration) cliErrors.DiagnosticCheck {
+	binary, err := exec.LookPath(command)
+	if err != nil {
+		return cliErrors.NewDiagnosticCheck("", cliErrors.CheckFail, cliErrors.NewCLIError(cliErrors.ErrorTypeNotFound, "Claude CLI를 찾을 수 없습니다").
+			WithCause(err).
+			AddSuggestion("'npm install -g @anthropic-ai/claude-code'로 Claude CLI를 설치하세요").
+			AddSuggestion("서버 프로세스의 PATH에 Claude CLI 설치 경로가 포함되어 있는지 확인하세요"))
+	}
+
+	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	out, err := exec.CommandContext(cmdCtx, binary, "--version").Output()
+	if err != nil {
+		exitCode := -1
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			exitCode = exitErr.ExitCode()
+		}
+		return cliErrors.NewDiagnosticCheck("", cliErrors.CheckFail, cliErrors.NewProcessError(command+" --version", exitCode, err).
+			AddSuggestion("Claude CLI를 다시 설치하거나 최신 버전으로 업데이트하세요"))
+	}
+	return doctorCheck(cliErrors.CheckOK, fmt.Sprintf("Claude CLI %s (%s)", strings.TrimSpace(string(out)), binary))
+}
+
+// checkClaudeAuth Claude 인증 정보가 어디에든 설정되어 있는지 확인 (값은 보고하지 않음)
+func checkClaudeAuth(cfg *config.Config) cliErrors.DiagnosticCheck {
+	var sources []string
+	for _, env := range []string{"CLAUDE_CODE_OAUTH_TOKEN", "CLAUDE_API_KEY", "ANTHROPIC_API_KEY"} {
+		if os.Getenv(env) != "" {
+			sources = append(sources, "환경 변수 "+env)
+		}
+	}
+	if cfg.Claude.APIKey != "" {
+		sources = append(sources, "claude.api_key")
+	}
+	credentials := 0
+	for _, credential := range cfg.Quota.Credentials {
+		if credential.OAuthToken != "" || credential.APIKey != "" {
+			credentials++
+		}
+	}
+	if credentials > 0 {
+		sources = append(sources, fmt.Sprintf("quota.credentials %d개", credentials))
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		if _, err := os.Stat(filepath.Join(home, ".claude", ".credentials.json")); err == nil {
+			sources = append(sources, "Claude CLI 로그인")
+		}
+	}
+
+	if len(sources) == 0 {
+		return cliErrors.NewDiagnosticCheck("", cliErrors.CheckFail, cliErrors.NewAuthenticationError("Claude", nil).
+			AddSuggestion("'claude setup-token'으로 OAuth 토큰을 발급해 CLAUDE_CODE_OAUTH_TOKEN에 설정하세요"))
+	}
+	return doctorCheck(cliErrors.CheckOK, "인증 정보: "+strings.Join(sources, ", "))
+}
+
+// writeDoctorBundle 진단 정보와 점검 결과를 JSON 파일로 저장 (소유자만 읽을 수 있음)
+func writeDoctorBundle(path string, info *cliErrors.DiagnosticInfo) error {
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return fmt.Errorf("진단 번들 생성 실패: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("진단 번들 저장 실패: %w", err)
+	}
+	return nil
+}
+
+func doctorCheck(status cliErrors.DiagnosticCheckStatus, message string) cliErrors.DiagnosticCheck {
+	return cliErrors.DiagnosticCheck{Status: status, Message: message}
+}
@@ -0,0 +1,314 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aicli/aicli-web/internal/credentials"
+)
+
+// serverURLEnv 원격 API 서버 주소 환경 변수 (--server 기본값)
+const serverURLEnv = "AICLI_SERVER_URL"
+
+// 디바이스 토큰 요청 오류 코드 (서버의 handlers.DeviceToken 참고)
+const (
+	deviceErrPending  = "AUTHORIZATION_PENDING"
+	deviceErrSlowDown = "SLOW_DOWN"
+)
+
+// loginOptions 로그인 옵션
+type loginOptions struct {
+	server    string
+	scope     string
+	noBrowser bool
+	fileStore bool
+}
+
+// deviceCodeResponse 디바이스 코드 발급 응답
+type deviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// deviceTokenResponse 디바이스 토큰 응답
+type deviceTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	Scope        string `json:"scope"`
+}
+
+// apiError API 오류 응답
+type apiError struct {
+	Status  int
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s (%s, HTTP %d)", e.Message, e.Code, e.Status)
+}
+
+// NewLoginCmd 원격 서버 로그인 명령어 생성
+func NewLoginCmd() *cobra.Command {
+	opts := &loginOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "원격 API 서버에 로그인",
+		Long: `디바이스 코드 방식으로 원격 API 서버에 로그인합니다.
+
+표시되는 주소를 브라우저에서 열고 웹 UI에 로그인한 상태로 코드를 승인하면
+CLI가 토큰을 받아 운영체제 키체인(macOS Keychain, Linux Secret Service)에 저장합니다.
+키체인 도구가 없으면 ~/.aicli/credentials.json(권한 0600)에 저장합니다.
+
+--scope read로 로그인하면 조회 요청만 할 수 있는 토큰을 받습니다.`,
+		Example: `  # 원격 서버에 로그인
+  aicli login --server https://aicli.example.com
+
+  # 읽기 전용 토큰으로 로그인
+  aicli login --server https://aicli.example.com --scope read
+
+  # 브라우저를 열 수 없는 환경 (SSH 접속 등)
+  aicli login --server https://aicli.example.com --no-browser`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogin(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.server, "server", os.Getenv(serverURLEnv), "API 서버 주소 (기본값: "+serverURLEnv+")")
+	cmd.Flags().StringVar(&opts.scope, "scope", "read write", "요청할 권한 범위 (read, read write)")
+	cmd.Flags().BoolVar(&opts.noBrowser, "no-browser", false, "승인 페이지를 브라우저로 자동으로 열지 않음")
+	cmd.Flags().BoolVar(&opts.fileStore, "no-keychain", false, "키체인 대신 ~/.aicli/credentials.json에 저장")
+
+	return cmd
+}
+
+// NewLogoutCmd 원격 서버 로그아웃 명령어 생성
+func NewLogoutCmd() *cobra.Command {
+	opts := &loginOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Short: "원격 API 서버에서 로그아웃",
+		Long:  "서버에 토큰 폐기를 요청하고 저장된 로그인 정보를 삭제합니다.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogout(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.server, "server", os.Getenv(serverURLEnv), "API 서버 주소 (기본값: "+serverURLEnv+")")
+	cmd.Flags().BoolVar(&opts.fileStore, "no-keychain", false, "~/.aicli/credentials.json에 저장한 로그인 정보 삭제")
+
+	return cmd
+}
+
+// runLogin 디바이스 코드를 발급받고 사용자가 승인할 때까지 토큰을 요청
+func runLogin(ctx context.Context, opts *loginOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	server := credentials.NormalizeServer(opts.server)
+	if server == "" {
+		return fmt.Errorf("--server 또는 %s로 API 서버 주소를 지정하세요", serverURLEnv)
+	}
+	store, err := credentialStore(opts.fileStore)
+	if err != nil {
+		return err
+	}
+
+	hostname, _ := os.Hostname()
+	var code deviceCodeResponse
+	err = postAPI(ctx, server, "/api/v1/auth/device/code", map[string]string{
+		"client_name": fmt.Sprintf("aicli (%s, %s/%s)", hostname, runtime.GOOS, runtime.GOARCH),
+		"scope":       opts.scope,
+	}, &code)
+	if err != nil {
+		return fmt.Errorf("로그인을 시작할 수 없습니다: %w", err)
+	}
+
+	fmt.Printf("브라우저에서 다음 주소를 열고 코드를 승인하세요.\n\n")
+	fmt.Printf("   주소: %s\n", code.VerificationURI)
+	fmt.Printf("   코드: %s\n\n", code.UserCode)
+	if !opts.noBrowser {
+		if err := openBrowser(code.VerificationURIComplete); err == nil {
+			fmt.Println("브라우저에서 승인 페이지를 열었습니다.")
+		}
+	}
+	fmt.Println("승인을 기다리는 중...")
+
+	token, err := pollDeviceToken(ctx, server, &code)
+	if err != nil {
+		return err
+	}
+
+	creds := &credentials.Credentials{
+		Server:       server,
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		Scope:        token.Scope,
+		ExpiresAt:    time.Now().Add(time.Duration(token.ExpiresIn) * time.Second),
+	}
+	if err := store.Set(creds); err != nil {
+		return fmt.Errorf("%w (--no-keychain으로 파일에 저장할 수 있습니다)", err)
+	}
+
+	fmt.Printf("✅ %s에 로그인했습니다 (권한: %s, 저장 위치: %s)\n", server, token.Scope, store.Name())
+	return nil
+}
+
+// pollDeviceToken 승인, 거절, 만료 중 하나가 될 때까지 interval 간격으로 토큰을 요청
+func pollDeviceToken(ctx context.Context, server string, code *deviceCodeResponse) (*deviceTokenResponse, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(code.ExpiresIn)*time.Second)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("승인 시간이 지났습니다. 다시 로그인하세요")
+		case <-time.After(interval):
+		}
+
+		var token deviceTokenResponse
+		err := postAPI(ctx, server, "/api/v1/auth/device/token", map[string]string{"device_code": code.DeviceCode}, &token)
+		if err == nil {
+			return &token, nil
+		}
+
+		var apiErr *apiError
+		if !errors.As(err, &apiErr) {
+			if ctx.Err() != nil {
+				continue
+			}
+			return nil, fmt.Errorf("토큰을 받을 수 없습니다: %w", err)
+		}
+		switch apiErr.Code {
+		case deviceErrPending:
+		case deviceErrSlowDown:
+			interval += 5 * time.Second
+		default:
+			return nil, fmt.Errorf("로그인이 승인되지 않았습니다: %w", err)
+		}
+	}
+}
+
+// runLogout 서버에 토큰 폐기를 요청하고 저장된 로그인 정보를 삭제
+func runLogout(ctx context.Context, opts *loginOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	server := credentials.NormalizeServer(opts.server)
+	if server == "" {
+		return fmt.Errorf("--server 또는 %s로 API 서버 주소를 지정하세요", serverURLEnv)
+	}
+	store, err := credentialStore(opts.fileStore)
+	if err != nil {
+		return err
+	}
+
+	creds, err := store.Get(server)
+	if errors.Is(err, credentials.ErrNotFound) {
+		fmt.Printf("%s에 로그인한 정보가 없습니다.\n", server)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// 서버에 연결할 수 없어도 로컬 정보는 삭제
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server+"/api/v1/auth/logout", nil)
+	if err == nil {
+		req.Header.Set("Authorization", "Bearer "+creds.AccessToken)
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			resp.Body.Close()
+		} else {
+			fmt.Printf("⚠️  서버에 토큰 폐기를 요청하지 못했습니다: %v\n", err)
+		}
+	}
+
+	if err := store.Delete(server); err != nil {
+		return err
+	}
+	fmt.Printf("✅ %s에서 로그아웃했습니다.\n", server)
+	return nil
+}
+
+// credentialStore 로그인 정보 저장소 선택
+func credentialStore(fileStore bool) (credentials.Store, error) {
+	if fileStore {
+		return credentials.NewDefaultFileStore()
+	}
+	return credentials.NewStore()
+}
+
+// postAPI JSON 요청을 보내고 성공 응답의 data를 out에 디코딩 (실패 응답은 *apiError)
+func postAPI(ctx context.Context, server, path string, body interface{}, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var envelope struct {
+		Success bool            `json:"success"`
+		Data    json.RawMessage `json:"data"`
+		Error   *apiError       `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("서버 응답을 해석할 수 없습니다 (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if !envelope.Success {
+		if envelope.Error == nil {
+			envelope.Error = &apiError{Message: "요청이 실패했습니다"}
+		}
+		envelope.Error.Status = resp.StatusCode
+		return envelope.Error
+	}
+	return json.Unmarshal(envelope.Data, out)
+}
+
+// openBrowser 기본 브라우저로 주소를 엶
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
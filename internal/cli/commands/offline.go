@@ -0,0 +1,339 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/credentials"
+	"github.com/aicli/aicli-web/internal/offline"
+)
+
+// offlineRunOptions 오프라인 실행 옵션
+type offlineRunOptions struct {
+	dir          string
+	model        string
+	command      string
+	timeout      time.Duration
+	serverConfig string
+}
+
+// offlineSyncOptions 오프라인 기록 동기화 옵션
+type offlineSyncOptions struct {
+	server    string
+	projectID string
+	fileStore bool
+	batchSize int
+	watch     bool
+	interval  time.Duration
+}
+
+// NewOfflineCmd 오프라인 모드 명령어 생성
+func NewOfflineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offline",
+		Short: "API 서버 없이 로컬 Claude CLI로 작업 실행",
+		Long: `API 서버와 웹 UI 없이 로컬에 설치된 Claude CLI로 작업을 직접 실행합니다.
+
+Claude API 키와 모델은 API 서버와 같은 설정 파일(~/.aicli/config.yaml, AICLI_CONFIG_PATH)과
+환경 변수(AICLI_CLAUDE_API_KEY, AICLI_CLAUDE_MODEL)에서 읽습니다.
+실행 기록은 ~/.aicli/offline/transcripts에 저장되며, 나중에 aicli offline sync로
+서버 프로젝트의 세션으로 올릴 수 있습니다.`,
+	}
+
+	cmd.AddCommand(newOfflineRunCmd())
+	cmd.AddCommand(newOfflineListCmd())
+	cmd.AddCommand(newOfflineSyncCmd())
+
+	return cmd
+}
+
+// newOfflineRunCmd 오프라인 실행 명령어
+func newOfflineRunCmd() *cobra.Command {
+	opts := &offlineRunOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run <prompt>",
+		Short: "로컬 Claude CLI로 프롬프트 실행",
+		Example: `  # 현재 디렉토리에서 실행
+  aicli offline run "테스트가 실패하는 원인을 찾아줘"
+
+  # 다른 디렉토리에서 30분 제한으로 실행
+  aicli offline run --dir ~/src/app --timeout 30m "마이그레이션 정리"`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOffline(cmd.Context(), opts, strings.Join(args, " "))
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.dir, "dir", "d", "", "작업 디렉토리 (기본값: 현재 디렉토리)")
+	cmd.Flags().StringVar(&opts.model, "model", "", "사용할 모델 (기본값: 설정 파일, 없으면 Claude CLI 기본값)")
+	cmd.Flags().StringVar(&opts.command, "claude", offline.DefaultCommand, "Claude CLI 실행 파일")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 0, "최대 실행 시간 (0이면 제한 없음)")
+	cmd.Flags().StringVar(&opts.serverConfig, "server-config", "", "Claude 설정을 읽을 API 서버 설정 파일 경로 (기본값: AICLI_CONFIG_PATH, ~/.aicli/config.yaml, /etc/aicli/config.yaml)")
+
+	return cmd
+}
+
+// newOfflineListCmd 오프라인 기록 목록 명령어
+func newOfflineListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "오프라인 실행 기록 목록",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			transcripts, err := offline.NewDefaultStore().List()
+			if err != nil {
+				return err
+			}
+			if len(transcripts) == 0 {
+				fmt.Println("오프라인 실행 기록이 없습니다.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tSTARTED\tSTATUS\tSYNCED\tPROMPT")
+			for _, t := range transcripts {
+				synced := "-"
+				if t.Synced() {
+					synced = t.RemoteSessionID
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.ID, t.StartedAt.Local().Format(time.RFC3339), transcriptStatus(t), synced, truncatePrompt(t.Prompt, 50))
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// newOfflineSyncCmd 오프라인 기록 동기화 명령어
+func newOfflineSyncCmd() *cobra.Command {
+	opts := &offlineSyncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync [transcript-id...]",
+		Short: "오프라인 실행 기록을 서버 프로젝트의 세션으로 업로드",
+		Long: `끝난 오프라인 실행 기록을 서버 프로젝트에 종료된 세션으로 올립니다.
+ID를 지정하지 않으면 아직 동기화하지 않은 기록을 모두 올립니다.
+aicli login으로 저장한 로그인 정보를 사용하며, 쓰기 권한이 필요합니다.
+
+메시지는 나눠서 보내고 보낸 위치를 기록에 저장하므로, 연결이 끊기면 다음 동기화에서 이어서 보냅니다.
+같은 기록을 다시 보내도 서버에는 한 번만 저장되며, 비용과 토큰 사용량은 서버의 사용량 분석에 반영됩니다.
+--watch로 실행하면 연결이 돌아올 때까지 기다렸다가 올리고, 이후 끝나는 기록도 계속 올립니다.`,
+		Example: `  # 동기화하지 않은 기록을 모두 올리기
+  aicli offline sync --server https://aicli.example.com --project <project-id>
+
+  # 연결이 돌아오면 자동으로 올리기 (Ctrl+C로 종료)
+  aicli offline sync --server https://aicli.example.com --project <project-id> --watch`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runOfflineSync(cmd.Context(), opts, args)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.server, "server", os.Getenv(serverURLEnv), "API 서버 주소 (기본값: "+serverURLEnv+")")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "기록을 올릴 프로젝트 ID")
+	cmd.Flags().BoolVar(&opts.fileStore, "no-keychain", false, "~/.aicli/credentials.json에 저장한 로그인 정보 사용")
+	cmd.Flags().IntVar(&opts.batchSize, "batch-size", offline.DefaultSyncBatchSize, "요청 하나에 보내는 메시지 수")
+	cmd.Flags().BoolVarP(&opts.watch, "watch", "w", false, "연결이 끊겨도 종료하지 않고 주기적으로 다시 동기화")
+	cmd.Flags().DurationVar(&opts.interval, "interval", 30*time.Second, "--watch의 동기화 주기")
+	cmd.MarkFlagRequired("project")
+
+	return cmd
+}
+
+// runOffline 로컬 Claude CLI로 프롬프트를 실행하고 기록을 저장
+func runOffline(ctx context.Context, opts *offlineRunOptions, prompt string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	dir := opts.dir
+	if dir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		dir = wd
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+
+	// 서버와 같은 설정 파일의 Claude 설정 사용
+	cfg, err := config.LoadServerConfig(config.ResolveServerConfigPath(opts.serverConfig))
+	if err != nil {
+		return err
+	}
+	model := opts.model
+	if model == "" && cfg.Claude.Model != config.DefaultClaudeModel {
+		model = cfg.Claude.Model
+	}
+
+	runner := &offline.Runner{
+		Store:   offline.NewDefaultStore(),
+		Command: opts.command,
+		APIKey:  cfg.Claude.APIKey,
+		Model:   model,
+		Timeout: opts.timeout,
+		Output:  os.Stdout,
+	}
+	transcript, err := runner.Run(ctx, prompt, dir)
+	if transcript != nil {
+		fmt.Printf("\n기록: %s (aicli offline sync로 서버에 올릴 수 있습니다)\n", transcript.ID)
+	}
+	return err
+}
+
+// runOfflineSync 동기화할 기록을 골라 서버로 업로드
+// --watch면 연결이 끊겨도 끝내지 않고 interval마다 다시 시도하며, 새로 끝난 기록도 계속 올립니다.
+func runOfflineSync(ctx context.Context, opts *offlineSyncOptions, ids []string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	server := credentials.NormalizeServer(opts.server)
+	if server == "" {
+		return fmt.Errorf("--server 또는 %s로 API 서버 주소를 지정하세요", serverURLEnv)
+	}
+	credStore, err := credentialStore(opts.fileStore)
+	if err != nil {
+		return err
+	}
+	creds, err := credStore.Get(server)
+	if errors.Is(err, credentials.ErrNotFound) {
+		return fmt.Errorf("%s에 로그인하지 않았습니다. aicli login --server %s로 먼저 로그인하세요", server, server)
+	}
+	if err != nil {
+		return err
+	}
+
+	store := offline.NewDefaultStore()
+	syncer := &offline.Syncer{
+		Store:     store,
+		Server:    server,
+		ProjectID: opts.projectID,
+		Token:     creds.AccessToken,
+		Refresh: func(ctx context.Context) (string, error) {
+			return refreshCredentials(ctx, credStore, creds)
+		},
+		BatchSize: opts.batchSize,
+	}
+
+	for {
+		transcripts, err := syncTargets(syncer, store, ids)
+		if err != nil {
+			return err
+		}
+		retry, err := syncTranscripts(ctx, syncer, transcripts)
+		if !opts.watch {
+			if len(transcripts) == 0 {
+				fmt.Println("동기화할 기록이 없습니다.")
+			}
+			return err
+		}
+		// 기록 하나의 실패로 감시를 끝내지 않음 (다음 주기에 다시 시도)
+		if retry {
+			fmt.Printf("서버에 연결할 수 없습니다 (%v). %s 후 다시 시도합니다.\n", err, opts.interval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.interval):
+		}
+	}
+}
+
+// syncTargets 아직 동기화하지 않은 기록 (ID를 지정하지 않았으면 끝난 기록 전체)
+func syncTargets(syncer *offline.Syncer, store *offline.Store, ids []string) ([]*offline.Transcript, error) {
+	if len(ids) == 0 {
+		return syncer.Pending()
+	}
+	var transcripts []*offline.Transcript
+	for _, id := range ids {
+		transcript, err := store.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		if !syncer.Synced(transcript) {
+			transcripts = append(transcripts, transcript)
+		}
+	}
+	return transcripts, nil
+}
+
+// syncTranscripts 기록을 차례로 업로드
+// 연결 문제로 실패하면 나머지도 실패할 것이므로 멈추고 retry로 알립니다 (보낸 위치는 기록에 저장됨).
+func syncTranscripts(ctx context.Context, syncer *offline.Syncer, transcripts []*offline.Transcript) (retry bool, err error) {
+	var failed int
+	for _, transcript := range transcripts {
+		if err := syncer.Sync(ctx, transcript); err != nil {
+			if offline.IsRetryable(err) {
+				return true, err
+			}
+			failed++
+			fmt.Printf("❌ %s: %v\n", transcript.ID, err)
+			continue
+		}
+		fmt.Printf("✅ %s → 세션 %s\n", transcript.ID, transcript.RemoteSessionID)
+	}
+	if failed > 0 {
+		return false, fmt.Errorf("%d개 기록을 동기화하지 못했습니다", failed)
+	}
+	return false, nil
+}
+
+// refreshCredentials 리프레시 토큰으로 액세스 토큰을 갱신하고 저장
+func refreshCredentials(ctx context.Context, store credentials.Store, creds *credentials.Credentials) (string, error) {
+	if creds.RefreshToken == "" {
+		return "", errors.New("리프레시 토큰이 없습니다. aicli login으로 다시 로그인하세요")
+	}
+	var token deviceTokenResponse
+	err := postAPI(ctx, creds.Server, "/api/v1/auth/refresh", map[string]string{"refresh_token": creds.RefreshToken}, &token)
+	if err != nil {
+		return "", err
+	}
+	creds.AccessToken = token.AccessToken
+	if token.ExpiresIn > 0 {
+		creds.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	if err := store.Set(creds); err != nil {
+		return "", err
+	}
+	return creds.AccessToken, nil
+}
+
+// transcriptStatus 기록 상태 표시
+func transcriptStatus(t *offline.Transcript) string {
+	switch {
+	case !t.Finished():
+		return "running"
+	case t.Error != "":
+		return "failed"
+	default:
+		return "done"
+	}
+}
+
+// truncatePrompt 목록에 표시할 만큼 프롬프트를 자름
+func truncatePrompt(prompt string, max int) string {
+	prompt = strings.Join(strings.Fields(prompt), " ")
+	runes := []rune(prompt)
+	if len(runes) <= max {
+		return prompt
+	}
+	return string(runes[:max-1]) + "…"
+}
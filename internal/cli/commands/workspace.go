@@ -2,11 +2,18 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/aicli/aicli-web/internal/claude"
 	"github.com/aicli/aicli-web/internal/cli/errors"
 	"github.com/aicli/aicli-web/internal/cli/output"
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
 )
 
 // NewWorkspaceCmd는 workspace 관련 명령어를 생성합니다.
@@ -36,7 +43,10 @@ func NewWorkspaceCmd() *cobra.Command {
   aicli workspace info myproject
   
   # 워크스페이스 삭제
-  aicli workspace delete myproject`,
+  aicli workspace delete myproject
+  
+  # 현재 디렉토리 사전 점검
+  aicli workspace doctor`,
 		Run: func(cmd *cobra.Command, args []string) {
 			cmd.Help()
 		},
@@ -47,6 +57,7 @@ func NewWorkspaceCmd() *cobra.Command {
 	cmd.AddCommand(newWorkspaceCreateCmd())
 	cmd.AddCommand(newWorkspaceDeleteCmd())
 	cmd.AddCommand(newWorkspaceInfoCmd())
+	cmd.AddCommand(newWorkspaceDoctorCmd())
 
 	return cmd
 }
@@ -275,4 +286,128 @@ func newWorkspaceInfoCmd() *cobra.Command {
 			return formatter.Print(info)
 		},
 	}
-}
\ No newline at end of file
+}
+
+// newWorkspaceDoctorCmd는 워크스페이스 환경을 사전 점검하는 명령어입니다.
+func newWorkspaceDoctorCmd() *cobra.Command {
+	var (
+		workspaceID string
+		skipImage   bool
+		claudePath  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "doctor [path]",
+		Short: "워크스페이스 사전 점검",
+		Long: `태스크를 실행하기 전에 워크스페이스 환경을 점검하고 문제별 해결 방법을 안내합니다.
+
+점검 항목:
+  • 경로가 존재하고 쓸 수 있는지
+  • git 저장소 상태
+  • Claude CLI 실행 가능 여부
+  • 컨테이너 이미지를 받을 수 있는지 (Docker 사용 시)
+  • 디스크 한도까지 남은 여유 (디스크 한도 사용 시)
+  • MCP 서버 시작 여부 (--id 지정 시)
+
+실패한 항목이 있으면 0이 아닌 종료 코드로 끝납니다.`,
+		Example: `  # 현재 디렉토리 점검
+  aicli workspace doctor
+  
+  # 워크스페이스 MCP 서버까지 점검
+  aicli workspace doctor ~/projects/myapp --id ws-12345
+  
+  # 이미지 받기를 건너뛰고 JSON으로 출력
+  aicli ws doctor --skip-image --output json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "."
+			if len(args) > 0 {
+				path = args[0]
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("경로 확인 실패: %w", err)
+			}
+
+			cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			doctor := services.NewWorkspaceDoctorService(nil)
+			doctor.SetClaudeCommand(claudePath)
+			if !skipImage {
+				if manager, err := docker.NewManagerWithDefaults(); err == nil {
+					doctor.SetImages(services.NewDockerDoctorImages(manager), nil)
+				}
+			}
+			if quota := cfg.Workspace.DiskQuota; quota.Enabled {
+				limits := make(map[string]int64, len(quota.Workspaces))
+				for id, limitMB := range quota.Workspaces {
+					limits[id] = limitMB * 1024 * 1024
+				}
+				doctor.SetDiskQuota(services.NewWorkspaceDiskQuotaService(nil, services.WorkspaceDiskQuotaConfig{
+					DefaultLimit: quota.DefaultLimitMB * 1024 * 1024,
+					Limits:       limits,
+					WarnRatio:    quota.WarnRatio,
+				}))
+			}
+			if workspaceID != "" && cfg.MCP.Dir != "" {
+				doctor.SetMCP(services.NewMCPService(cfg.MCP.Dir, claude.NewMCPHealthChecker(cfg.MCP.HealthCheckTimeout), 0))
+			}
+
+			report := doctor.Diagnose(cmd.Context(), &models.Workspace{ID: workspaceID, ProjectPath: absPath})
+
+			switch output.Format(viper.GetString("output")) {
+			case output.FormatJSON, output.FormatYAML:
+				if err := output.DefaultFormatterManager().Print(report); err != nil {
+					return err
+				}
+			default:
+				printDoctorReport(report)
+			}
+
+			failed := 0
+			for _, finding := range report.Findings {
+				if finding.Status == models.WorkspaceDoctorFail {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("워크스페이스 점검에서 %d개 항목이 실패했습니다", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&workspaceID, "id", "", "워크스페이스 ID (MCP 서버와 워크스페이스별 디스크 한도 점검)")
+	cmd.Flags().BoolVar(&skipImage, "skip-image", false, "컨테이너 이미지 점검 건너뛰기")
+	cmd.Flags().StringVar(&claudePath, "claude-path", "", "Claude CLI 실행 파일 경로 (기본값: PATH의 claude)")
+
+	return cmd
+}
+
+// printDoctorReport는 점검 결과를 항목별로 출력합니다.
+func printDoctorReport(report *models.WorkspaceDoctorReport) {
+	icons := map[models.WorkspaceDoctorStatus]string{
+		models.WorkspaceDoctorOK:      "✅",
+		models.WorkspaceDoctorWarn:    "⚠️ ",
+		models.WorkspaceDoctorFail:    "❌",
+		models.WorkspaceDoctorSkipped: "⏭️ ",
+	}
+
+	fmt.Fprintf(os.Stdout, "워크스페이스 점검: %s\n\n", report.Path)
+	for _, finding := range report.Findings {
+		fmt.Fprintf(os.Stdout, "%s %-16s %s\n", icons[finding.Status], finding.Check, finding.Message)
+		if finding.Status == models.WorkspaceDoctorOK || finding.Status == models.WorkspaceDoctorSkipped {
+			continue
+		}
+		for _, suggestion := range finding.Suggestions {
+			fmt.Fprintf(os.Stdout, "   → %s\n", suggestion)
+		}
+	}
+	fmt.Fprintln(os.Stdout)
+	if report.Healthy {
+		fmt.Fprintln(os.Stdout, "태스크를 실행할 준비가 되었습니다.")
+	}
+}
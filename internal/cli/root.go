@@ -87,6 +87,12 @@ func init() {
 	rootCmd.AddCommand(commands.NewConfigCmd())
 	rootCmd.AddCommand(commands.NewVersionCmd())
 	rootCmd.AddCommand(commands.NewDBCmd())
+	rootCmd.AddCommand(commands.NewBackupCmd())
+	rootCmd.AddCommand(commands.NewBenchCmd())
+	rootCmd.AddCommand(commands.NewDoctorCmd())
+	rootCmd.AddCommand(commands.NewLoginCmd())
+	rootCmd.AddCommand(commands.NewLogoutCmd())
+	rootCmd.AddCommand(commands.NewOfflineCmd())
 	// rootCmd.AddCommand(commands.NewClaudeCommand()) // claude 패키지 중복 오류로 임시 비활성화
 	
 	// 자동 완성 명령어 추가
@@ -0,0 +1,239 @@
+// Package cluster API 서버를 여러 레플리카로 운영할 때 필요한 조정 기능을 제공합니다.
+//
+// 백업 스케줄러나 고아 프로세스 정리처럼 인스턴스 하나에서만 실행해야 하는 작업은
+// 분산 잠금(WithLock)이나 리더 선출(Elector)로 감싸고, 각 인스턴스는 멤버십 임대로
+// 살아 있음을 알립니다. 잠금 저장소는 Redis(여러 레플리카)와 메모리(단일 인스턴스)를 지원합니다.
+package cluster
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// 클러스터 기본값
+const (
+	DefaultKeyPrefix     = "aicli:cluster:"
+	DefaultLeaseTTL      = 15 * time.Second
+	DefaultRetryInterval = 5 * time.Second
+)
+
+// ErrLocked 다른 인스턴스가 잠금을 잡고 있음
+var ErrLocked = errors.New("locked by another instance")
+
+// Guard 인스턴스 하나에서만 실행해야 하는 작업을 분산 잠금으로 감쌉니다
+// 다른 인스턴스가 같은 이름의 작업을 실행 중이면 ErrLocked를 반환합니다.
+type Guard interface {
+	WithLock(ctx context.Context, name string, fn func(ctx context.Context) error) error
+}
+
+// Config 클러스터 설정
+type Config struct {
+	// InstanceID 현재 인스턴스 식별자
+	InstanceID string
+	// KeyPrefix 잠금 키 접두사
+	KeyPrefix string
+	// LeaseTTL 잠금과 멤버십 임대 만료 시간 (TTL의 1/3마다 연장)
+	LeaseTTL time.Duration
+	// RetryInterval 리더가 아닐 때 다시 선출을 시도하는 주기
+	RetryInterval time.Duration
+}
+
+// Cluster 현재 인스턴스의 멤버십, 잠금, 리더 선출
+type Cluster struct {
+	locker Locker
+	config Config
+	logger *logrus.Logger
+
+	mu       sync.Mutex
+	electors map[string]*Elector
+}
+
+// Guard 인터페이스 구현 확인
+var _ Guard = (*Cluster)(nil)
+
+// New 새 클러스터 생성
+func New(locker Locker, config Config, logger *logrus.Logger) (*Cluster, error) {
+	if locker == nil {
+		return nil, errors.New("locker is required")
+	}
+	if config.InstanceID == "" {
+		return nil, errors.New("instance id is required")
+	}
+	if config.KeyPrefix == "" {
+		config.KeyPrefix = DefaultKeyPrefix
+	}
+	if config.LeaseTTL <= 0 {
+		config.LeaseTTL = DefaultLeaseTTL
+	}
+	if config.RetryInterval <= 0 {
+		config.RetryInterval = DefaultRetryInterval
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return &Cluster{
+		locker:   locker,
+		config:   config,
+		logger:   logger,
+		electors: make(map[string]*Elector),
+	}, nil
+}
+
+// InstanceID 현재 인스턴스 식별자
+func (c *Cluster) InstanceID() string {
+	return c.config.InstanceID
+}
+
+// Run 컨텍스트가 끝날 때까지 멤버십 임대를 유지합니다 (종료 시 해제)
+func (c *Cluster) Run(ctx context.Context) {
+	key := c.memberKey(c.config.InstanceID)
+	ticker := time.NewTicker(c.refreshInterval())
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.locker.Acquire(ctx, key, c.config.InstanceID, c.config.LeaseTTL); err != nil && ctx.Err() == nil {
+			c.logger.WithError(err).Warn("클러스터 멤버십 갱신 실패")
+		}
+
+		select {
+		case <-ctx.Done():
+			c.release(key)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Alive 인스턴스의 멤버십 임대가 유지되고 있는지 확인
+func (c *Cluster) Alive(ctx context.Context, instanceID string) (bool, error) {
+	if instanceID == c.config.InstanceID {
+		return true, nil
+	}
+	owner, err := c.locker.Owner(ctx, c.memberKey(instanceID))
+	if err != nil {
+		return false, err
+	}
+	return owner != "", nil
+}
+
+// WithLock 이름 있는 분산 잠금을 잡고 작업을 실행합니다
+// 실행 중에는 잠금을 연장하고, 잠금을 잃으면 작업 컨텍스트를 취소합니다.
+func (c *Cluster) WithLock(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	key := c.config.KeyPrefix + "lock:" + name
+	ok, err := c.locker.Acquire(ctx, key, c.config.InstanceID, c.config.LeaseTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrLocked
+	}
+	defer c.release(key)
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := c.keepAlive(jobCtx, key, cancel)
+	defer stop()
+
+	return fn(jobCtx)
+}
+
+// Elector 역할별 리더 선출기 (같은 역할이면 같은 선출기)
+func (c *Cluster) Elector(role string) *Elector {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.electors[role]; ok {
+		return e
+	}
+	e := &Elector{cluster: c, role: role, key: c.config.KeyPrefix + "leader:" + role}
+	c.electors[role] = e
+	return e
+}
+
+// RoleStatus 역할 하나의 리더 정보
+type RoleStatus struct {
+	Role     string `json:"role"`
+	Leader   string `json:"leader,omitempty"`
+	IsLeader bool   `json:"is_leader"`
+}
+
+// Status 클러스터 상태
+type Status struct {
+	InstanceID string       `json:"instance_id"`
+	Roles      []RoleStatus `json:"roles"`
+}
+
+// Status 현재 인스턴스와 선출 중인 역할의 리더를 조회
+func (c *Cluster) Status(ctx context.Context) (*Status, error) {
+	c.mu.Lock()
+	electors := make([]*Elector, 0, len(c.electors))
+	for _, e := range c.electors {
+		electors = append(electors, e)
+	}
+	c.mu.Unlock()
+	sort.Slice(electors, func(i, j int) bool { return electors[i].role < electors[j].role })
+
+	status := &Status{InstanceID: c.config.InstanceID, Roles: []RoleStatus{}}
+	for _, e := range electors {
+		leader, err := c.locker.Owner(ctx, e.key)
+		if err != nil {
+			return nil, err
+		}
+		status.Roles = append(status.Roles, RoleStatus{Role: e.role, Leader: leader, IsLeader: e.IsLeader()})
+	}
+	return status, nil
+}
+
+// keepAlive 잠금을 주기적으로 연장하고, 잃으면 lost를 호출합니다 (반환 함수로 중지)
+func (c *Cluster) keepAlive(ctx context.Context, key string, lost func()) (stop func()) {
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		ticker := time.NewTicker(c.refreshInterval())
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				ok, err := c.locker.Refresh(ctx, key, c.config.InstanceID, c.config.LeaseTTL)
+				if err != nil || !ok {
+					// 잠금 상태를 확인할 수 없으면 다른 인스턴스와 겹치지 않도록 잃은 것으로 처리
+					c.logger.WithError(err).WithField("key", key).Warn("클러스터 잠금을 잃었습니다")
+					lost()
+					return
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-finished
+	}
+}
+
+// release 잠금 해제 (호출한 컨텍스트가 이미 끝났을 수 있어 별도 제한 시간 사용)
+func (c *Cluster) release(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.locker.Release(ctx, key, c.config.InstanceID); err != nil {
+		c.logger.WithError(err).WithField("key", key).Warn("클러스터 잠금 해제 실패")
+	}
+}
+
+func (c *Cluster) memberKey(instanceID string) string {
+	return c.config.KeyPrefix + "instance:" + instanceID
+}
+
+func (c *Cluster) refreshInterval() time.Duration {
+	return c.config.LeaseTTL / 3
+}
@@ -0,0 +1,198 @@
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCluster(t *testing.T, locker Locker, instanceID string) *Cluster {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	c, err := New(locker, Config{
+		InstanceID:    instanceID,
+		LeaseTTL:      150 * time.Millisecond,
+		RetryInterval: 20 * time.Millisecond,
+	}, logger)
+	require.NoError(t, err)
+	return c
+}
+
+func TestMemoryLocker(t *testing.T) {
+	ctx := context.Background()
+	locker := NewMemoryLocker()
+	now := time.Now()
+	locker.now = func() time.Time { return now }
+
+	ok, err := locker.Acquire(ctx, "job", "a", time.Second)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, _ = locker.Acquire(ctx, "job", "b", time.Second)
+	assert.False(t, ok, "다른 소유자는 획득 불가")
+	ok, _ = locker.Refresh(ctx, "job", "b", time.Second)
+	assert.False(t, ok)
+	require.NoError(t, locker.Release(ctx, "job", "b"))
+	owner, _ := locker.Owner(ctx, "job")
+	assert.Equal(t, "a", owner, "다른 소유자는 해제 불가")
+
+	// 만료되면 다른 소유자가 획득
+	now = now.Add(2 * time.Second)
+	owner, _ = locker.Owner(ctx, "job")
+	assert.Empty(t, owner)
+	ok, _ = locker.Refresh(ctx, "job", "a", time.Second)
+	assert.False(t, ok)
+	ok, _ = locker.Acquire(ctx, "job", "b", time.Second)
+	assert.True(t, ok)
+}
+
+func TestCluster_WithLock(t *testing.T) {
+	ctx := context.Background()
+	locker := NewMemoryLocker()
+	a := newTestCluster(t, locker, "a")
+	b := newTestCluster(t, locker, "b")
+
+	err := a.WithLock(ctx, "backup", func(ctx context.Context) error {
+		assert.ErrorIs(t, b.WithLock(ctx, "backup", func(context.Context) error { return nil }), ErrLocked)
+		// 임대 시간보다 오래 실행해도 연장됨
+		time.Sleep(300 * time.Millisecond)
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	ran := false
+	require.NoError(t, b.WithLock(ctx, "backup", func(context.Context) error {
+		ran = true
+		return nil
+	}))
+	assert.True(t, ran, "해제 후 다른 인스턴스가 실행")
+}
+
+func TestCluster_Membership(t *testing.T) {
+	locker := NewMemoryLocker()
+	a := newTestCluster(t, locker, "a")
+	b := newTestCluster(t, locker, "b")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		b.Run(ctx)
+	}()
+
+	require.Eventually(t, func() bool {
+		alive, _ := a.Alive(context.Background(), "b")
+		return alive
+	}, time.Second, 10*time.Millisecond)
+
+	alive, _ := a.Alive(context.Background(), "c")
+	assert.False(t, alive)
+
+	cancel()
+	<-done
+	alive, _ = a.Alive(context.Background(), "b")
+	assert.False(t, alive, "종료 시 멤버십 해제")
+}
+
+func TestElector_Failover(t *testing.T) {
+	locker := NewMemoryLocker()
+	a := newTestCluster(t, locker, "a")
+	b := newTestCluster(t, locker, "b")
+
+	var leaders atomic.Int32
+	var overlap atomic.Bool
+	work := func(ctx context.Context) {
+		if leaders.Add(1) > 1 {
+			overlap.Store(true)
+		}
+		<-ctx.Done()
+		leaders.Add(-1)
+	}
+
+	ctxA, cancelA := context.WithCancel(context.Background())
+	doneA := make(chan struct{})
+	go func() {
+		defer close(doneA)
+		a.Elector("reaper").Run(ctxA, work)
+	}()
+	require.Eventually(t, a.Elector("reaper").IsLeader, time.Second, 5*time.Millisecond)
+
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+	go b.Elector("reaper").Run(ctxB, work)
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, b.Elector("reaper").IsLeader())
+
+	status, err := b.Status(context.Background())
+	require.NoError(t, err)
+	require.Len(t, status.Roles, 1)
+	assert.Equal(t, RoleStatus{Role: "reaper", Leader: "a"}, status.Roles[0])
+
+	// 리더가 종료되면 다른 인스턴스가 넘겨받음
+	cancelA()
+	<-doneA
+	require.Eventually(t, b.Elector("reaper").IsLeader, time.Second, 5*time.Millisecond)
+	assert.False(t, overlap.Load(), "리더 작업이 동시에 실행되지 않음")
+}
+
+func TestElector_LostLease(t *testing.T) {
+	locker := NewMemoryLocker()
+	a := newTestCluster(t, locker, "a")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan struct{}, 1)
+	go a.Elector("backup").Run(ctx, func(ctx context.Context) {
+		<-ctx.Done()
+		stopped <- struct{}{}
+	})
+	require.Eventually(t, a.Elector("backup").IsLeader, time.Second, 5*time.Millisecond)
+
+	// 다른 인스턴스가 만료된 잠금을 가져간 경우
+	locker.mu.Lock()
+	locker.leases[DefaultKeyPrefix+"leader:backup"] = memoryLease{owner: "b", expiresAt: time.Now().Add(time.Hour)}
+	locker.mu.Unlock()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("잠금을 잃으면 리더 작업이 중지되어야 함")
+	}
+	assert.Eventually(t, func() bool { return !a.Elector("backup").IsLeader() }, time.Second, 5*time.Millisecond)
+}
+
+func TestInstanceIdentity(t *testing.T) {
+	id, err := ResolveInstanceID("", "node-1")
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", id)
+
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(NewInstanceHook("node-1"))
+	logger.Info("hello")
+	assert.Contains(t, buf.String(), `"instance_id":"node-1"`)
+
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_total", Help: "test"}, []string{"kind"})
+	registry.MustRegister(counter)
+	counter.WithLabelValues("x").Inc()
+
+	families, err := InstanceGatherer(registry, "node-1").Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	labels := families[0].Metric[0].Label
+	require.Len(t, labels, 2)
+	assert.Equal(t, "instance_id", labels[0].GetName())
+	assert.Equal(t, "node-1", labels[0].GetValue())
+	assert.Equal(t, "kind", labels[1].GetName())
+}
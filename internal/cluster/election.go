@@ -0,0 +1,91 @@
+package cluster
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Elector 역할 하나의 리더 선출
+// 리더 잠금을 잡은 인스턴스만 역할 작업을 실행하고, 잠금을 잃거나 리더가 종료되면
+// 다른 인스턴스가 RetryInterval 안에 역할을 넘겨받습니다.
+type Elector struct {
+	cluster *Cluster
+	role    string
+	key     string
+	leader  atomic.Bool
+}
+
+// Role 선출 중인 역할 이름
+func (e *Elector) Role() string {
+	return e.role
+}
+
+// IsLeader 현재 인스턴스가 리더인지 확인
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run 컨텍스트가 끝날 때까지 선출에 참여합니다
+// 리더가 되면 fn을 실행하며, fn은 전달받은 컨텍스트가 끝날 때까지(리더 자격을 잃을 때까지)
+// 역할 작업을 수행해야 합니다. 자격을 잃으면 fn이 반환된 뒤 다시 선출에 참여합니다.
+func (e *Elector) Run(ctx context.Context, fn func(ctx context.Context)) {
+	c := e.cluster
+	log := c.logger.WithField("role", e.role)
+
+	for {
+		ok, err := c.locker.Acquire(ctx, e.key, c.config.InstanceID, c.config.LeaseTTL)
+		if err != nil && ctx.Err() == nil {
+			log.WithError(err).Warn("리더 선출 실패")
+		}
+		if ok {
+			log.Info("리더로 선출되었습니다")
+			e.lead(ctx, fn)
+			if ctx.Err() != nil {
+				c.release(e.key)
+				return
+			}
+			log.Warn("리더 자격을 잃었습니다")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.config.RetryInterval):
+		}
+	}
+}
+
+// lead 리더 잠금을 연장하면서 역할 작업을 실행합니다 (잠금을 잃거나 ctx가 끝나면 반환)
+func (e *Elector) lead(ctx context.Context, fn func(ctx context.Context)) {
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	e.leader.Store(true)
+	defer e.leader.Store(false)
+
+	stop := e.cluster.keepAlive(leaderCtx, e.key, cancel)
+	defer stop()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				e.cluster.logger.WithFields(logrus.Fields{"role": e.role, "panic": r}).Error("리더 작업 패닉")
+				cancel()
+			}
+		}()
+		fn(leaderCtx)
+	}()
+
+	select {
+	case <-leaderCtx.Done():
+		<-done
+	case <-done:
+		// 작업이 먼저 끝나도 다른 인스턴스가 중복 실행하지 않도록 자격 유지
+		<-leaderCtx.Done()
+	}
+}
@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"os"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sirupsen/logrus"
+)
+
+// InstanceLabel 로그 필드와 메트릭 레이블에 쓰는 인스턴스 식별자 이름
+// Prometheus가 스크레이프 대상에 붙이는 instance 레이블과 겹치지 않도록 별도 이름을 사용합니다.
+const InstanceLabel = "instance_id"
+
+// ResolveInstanceID 처음으로 비어 있지 않은 후보를 인스턴스 식별자로 사용합니다 (모두 비어 있으면 호스트 이름)
+func ResolveInstanceID(candidates ...string) (string, error) {
+	for _, candidate := range candidates {
+		if candidate != "" {
+			return candidate, nil
+		}
+	}
+	return os.Hostname()
+}
+
+// instanceHook 모든 로그 항목에 인스턴스 식별자를 붙이는 logrus 훅
+type instanceHook struct {
+	instanceID string
+}
+
+// NewInstanceHook 로그 항목에 instance_id 필드를 추가하는 훅 생성
+func NewInstanceHook(instanceID string) logrus.Hook {
+	return &instanceHook{instanceID: instanceID}
+}
+
+func (h *instanceHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *instanceHook) Fire(entry *logrus.Entry) error {
+	if _, ok := entry.Data[InstanceLabel]; !ok {
+		entry.Data[InstanceLabel] = h.instanceID
+	}
+	return nil
+}
+
+// InstanceGatherer 수집한 모든 메트릭에 instance_id 레이블을 추가합니다
+// 이미 같은 레이블이 있는 메트릭은 그대로 둡니다.
+func InstanceGatherer(gatherer prometheus.Gatherer, instanceID string) prometheus.Gatherer {
+	name := InstanceLabel
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := gatherer.Gather()
+		for _, family := range families {
+			for _, metric := range family.Metric {
+				if hasLabel(metric, name) {
+					continue
+				}
+				value := instanceID
+				metric.Label = append(metric.Label, &dto.LabelPair{Name: &name, Value: &value})
+				sort.Slice(metric.Label, func(i, j int) bool {
+					return metric.Label[i].GetName() < metric.Label[j].GetName()
+				})
+			}
+		}
+		return families, err
+	})
+}
+
+func hasLabel(metric *dto.Metric, name string) bool {
+	for _, label := range metric.Label {
+		if label.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
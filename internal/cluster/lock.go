@@ -0,0 +1,153 @@
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Locker 소유자와 만료 시간이 있는 분산 잠금 저장소
+// 잠금 하나는 키 하나이며, 소유자만 연장하거나 해제할 수 있습니다.
+type Locker interface {
+	// Acquire 잠금을 얻습니다. 이미 같은 소유자가 잡고 있으면 만료 시간을 연장합니다.
+	Acquire(ctx context.Context, key, owner string, ttl time.Duration) (bool, error)
+	// Refresh 소유한 잠금의 만료 시간을 연장합니다 (잠금을 잃었으면 false)
+	Refresh(ctx context.Context, key, owner string, ttl time.Duration) (bool, error)
+	// Release 소유한 잠금을 해제합니다 (다른 소유자의 잠금은 그대로 둠)
+	Release(ctx context.Context, key, owner string) error
+	// Owner 현재 잠금 소유자 (잡혀 있지 않으면 빈 문자열)
+	Owner(ctx context.Context, key string) (string, error)
+}
+
+// memoryLease 메모리 잠금 하나
+type memoryLease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+// MemoryLocker 프로세스 안에서만 유효한 잠금 (단일 인스턴스, 테스트용)
+type MemoryLocker struct {
+	mu     sync.Mutex
+	leases map[string]memoryLease
+	now    func() time.Time
+}
+
+// NewMemoryLocker 새 메모리 잠금 저장소 생성
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{leases: make(map[string]memoryLease), now: time.Now}
+}
+
+// Acquire 잠금 획득 또는 연장
+func (l *MemoryLocker) Acquire(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if lease, ok := l.leases[key]; ok && lease.owner != owner && now.Before(lease.expiresAt) {
+		return false, nil
+	}
+	l.leases[key] = memoryLease{owner: owner, expiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+// Refresh 잠금 연장
+func (l *MemoryLocker) Refresh(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	lease, ok := l.leases[key]
+	if !ok || lease.owner != owner || !now.Before(lease.expiresAt) {
+		return false, nil
+	}
+	l.leases[key] = memoryLease{owner: owner, expiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+// Release 잠금 해제
+func (l *MemoryLocker) Release(ctx context.Context, key, owner string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lease, ok := l.leases[key]; ok && lease.owner == owner {
+		delete(l.leases, key)
+	}
+	return nil
+}
+
+// Owner 현재 소유자 조회
+func (l *MemoryLocker) Owner(ctx context.Context, key string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lease, ok := l.leases[key]
+	if !ok || !l.now().Before(lease.expiresAt) {
+		return "", nil
+	}
+	return lease.owner, nil
+}
+
+// 소유자를 확인하고 변경하는 Redis 스크립트 (확인과 변경을 원자적으로 처리)
+var (
+	acquireScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current == false then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+if current == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+return 0`)
+
+	refreshScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// RedisLocker Redis 기반 분산 잠금 (레플리카가 같은 Redis를 공유)
+type RedisLocker struct {
+	client redis.UniversalClient
+}
+
+// NewRedisLocker 새 Redis 잠금 저장소 생성
+func NewRedisLocker(client redis.UniversalClient) *RedisLocker {
+	return &RedisLocker{client: client}
+}
+
+// Acquire 잠금 획득 또는 연장
+func (l *RedisLocker) Acquire(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	n, err := acquireScript.Run(ctx, l.client, []string{key}, owner, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+// Refresh 잠금 연장
+func (l *RedisLocker) Refresh(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	n, err := refreshScript.Run(ctx, l.client, []string{key}, owner, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+// Release 잠금 해제
+func (l *RedisLocker) Release(ctx context.Context, key, owner string) error {
+	return releaseScript.Run(ctx, l.client, []string{key}, owner).Err()
+}
+
+// Owner 현재 소유자 조회
+func (l *RedisLocker) Owner(ctx context.Context, key string) (string, error) {
+	owner, err := l.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return owner, err
+}
@@ -20,6 +20,19 @@ const (
 	DefaultMaxProjects    = 10
 	DefaultIsolationMode  = "docker"
 
+	// 워크스페이스 스냅샷 기본값
+	DefaultSnapshotMaxTotalMB      = 10240 // 10GB
+	DefaultSnapshotMaxPerWorkspace = 20
+	DefaultSnapshotMaxAge          = 7 * 24 * time.Hour
+
+	// 워크스페이스 파일 감시 기본값
+	DefaultWatchMaxDirectories = 4096
+
+	// 워크스페이스 디스크 한도 기본값
+	DefaultDiskQuotaWarnRatio    = 0.8
+	DefaultDiskQuotaCacheTTL     = 5 * time.Minute
+	DefaultDiskQuotaScanInterval = 15 * time.Minute
+
 	// 출력 기본값
 	DefaultOutputFormat   = "table"
 	DefaultColorMode      = "auto"
@@ -39,18 +52,270 @@ const (
 	DefaultDockerCPULimit      = 2.0
 	DefaultDockerNetworkMode   = "bridge"
 	DefaultContainerPrefix     = "aicli"
+	DefaultDependencyCacheBackend        = "volume"
+	DefaultDependencyCacheMaxTotalMB     = 20480 // 20GB
+	DefaultDependencyCacheMaxWorkspaceMB = 4096  // 4GB
+	DefaultWarmPoolSize                  = 2
+	DefaultWarmPoolHealthCheckInterval   = 30 * time.Second
+	DefaultWarmPoolMaxIdle               = time.Hour
+	DefaultImageBuildTimeout             = 30 * time.Minute
+	DefaultNetworkPolicyMode             = "full"
+	DefaultEgressProxyImage              = "aicli/egress-proxy:latest"
 
 	// API 기본값
 	DefaultAPIAddress     = "localhost:8080"
 	DefaultRateLimit      = 100 // requests per minute
 	DefaultJWTExpiration  = 24 * time.Hour
+	DefaultRequestTimeout          = 60 * time.Second
+	DefaultRequestTimeoutMaxClient = 5 * time.Minute
 	
 	// JWT 기본값
 	DefaultAccessTokenExpiry  = 15 * time.Minute
 	DefaultRefreshTokenExpiry = 7 * 24 * time.Hour
 	DefaultJWTSecretKey      = "default-secret-key-change-in-production"
+	DefaultOAuthStateExpiry   = 10 // minutes
+
+	// 백업 기본값
+	DefaultBackupInterval  = 24 * time.Hour
+	DefaultBackupRetention = 7
+
+	// 아티팩트 기본값
+	DefaultArtifactPresignExpiry = 15 * time.Minute
+	DefaultArtifactMaxUploadSize = 100 << 20 // 100MB
+
+	// 이벤트 브로커 기본값
+	DefaultEventsTopicPrefix = "aicli.events"
+	DefaultEventsBufferSize  = 1024
+	DefaultEventsMaxRetries  = 3
+
+	// 플러그인 기본값
+	DefaultPluginTimeout = 30 * time.Second
+
+	// 에이전트 기본값
+	DefaultAgentProvider = "claude"
+
+	// MCP 기본값
+	DefaultMCPHealthCheckInterval = 5 * time.Minute
+	DefaultMCPHealthCheckTimeout  = 10 * time.Second
+
+	// 프로세스 자동 재시작 기본값
+	DefaultRestartMaxRestarts             = 5
+	DefaultRestartInitialBackoff          = time.Second
+	DefaultRestartMaxBackoff              = time.Minute
+	DefaultRestartBackoffMultiplier       = 2.0
+	DefaultRestartCircuitBreakerThreshold = 3
+	DefaultRestartCircuitBreakerCooldown  = 5 * time.Minute
+	DefaultRestartStableAfter             = time.Minute
+
+	// 고아 프로세스 정리 기본값
+	DefaultReaperEnabled     = true
+	DefaultReaperAction      = "kill"
+	DefaultReaperKillTimeout = 10 * time.Second
+
+	// 멈춘 프로세스 감지 기본값
+	DefaultHangTimeout = 10 * time.Minute
+
+	// 세션 컨텍스트 관리 기본값
+	DefaultContextWindowLimit         = 200000
+	DefaultContextWindowWarnRatio     = 0.8
+	DefaultContextWindowCompactRatio  = 0.9
+	DefaultContextSummaryMaxChars     = 8000
+	DefaultContextSummaryMessageChars = 1000
+	DefaultContextCompactTimeout      = 2 * time.Minute
+
+	// Claude 쿼터 거버너 기본값
+	DefaultQuotaNearRatio      = 0.9
+	DefaultQuotaCooldown       = time.Minute
+	DefaultQuotaAuthQuarantine = 30 * time.Minute
+	DefaultQuotaMaxDelay       = 30 * time.Second
+
+	// 워커 풀 선점 기본값 (긴급 태스크가 보통 이하 태스크를 재큐잉)
+	DefaultPreemptionPolicy                 = "requeue"
+	DefaultPreemptionMinPreemptorPriority   = "critical"
+	DefaultPreemptionMaxPreemptiblePriority = "normal"
+	DefaultPreemptionMaxPreemptionsPerTask  = 3
+
+	// 출력 마스킹 기본값
+	DefaultRedactionEntropyThreshold = 4.5
+	DefaultRedactionEntropyMinLength = 32
+
+	// 에러 통계 기본값
+	DefaultErrorStatsFlushInterval   = time.Minute
+	DefaultErrorStatsCompactInterval = time.Hour
+	DefaultErrorStatsHourlyRetention = 7 * 24 * time.Hour
+	DefaultErrorStatsDailyRetention  = 90 * 24 * time.Hour
+
+	// 태스크 실행 시간 제한 기본값
+	DefaultTaskQuickTimeout      = 30 * time.Second
+	DefaultTaskStandardTimeout   = 5 * time.Minute
+	DefaultTaskLongTimeout       = 30 * time.Minute
+	DefaultTaskCancelGracePeriod = 5 * time.Second
+
+	// 호스트 자원 기반 태스크 실행 허가 기본값
+	DefaultAdmissionSampleInterval = 5 * time.Second
+	DefaultAdmissionCPUPercent     = 90
+	DefaultAdmissionMemoryPercent  = 90
+	DefaultAdmissionDiskPercent    = 95
+	DefaultAdmissionMaxDeferred    = 100
+	DefaultAdmissionReleaseBatch   = 5
+
+	// Kubernetes 태스크 실행 기본값
+	DefaultKubernetesImage            = "aicli/workspace:latest"
+	DefaultKubernetesClaimNameFormat  = "aicli-workspace-%s"
+	DefaultKubernetesMountPath        = "/workspace"
+	DefaultKubernetesStartTimeout     = 5 * time.Minute
+	DefaultKubernetesTTLAfterFinished = 10 * time.Minute
+
+	// 원격 에이전트 기본값
+	DefaultRemoteHeartbeatInterval = 10 * time.Second
+	DefaultRemoteHeartbeatTimeout  = 30 * time.Second
+	DefaultRemoteAssignTimeout     = 30 * time.Second
+
+	// API 서버 실행 기본값
+	DefaultServerEnv               = "development"
+	DefaultServerPort              = 8080
+	DefaultServerShutdownTimeout   = 30 * time.Second
+	DefaultServerReadHeaderTimeout = 10 * time.Second
+	DefaultServerIdleTimeout       = 2 * time.Minute
+
+	// HTTP/2 기본값
+	DefaultHTTP2MaxConcurrentStreams = 250
+
+	// 요청 제한과 워커 수 기본값
+	DefaultRateLimitBurst         = 10
+	DefaultAuthenticatedRateLimit = 300
+	DefaultAuthenticatedBurst     = 50
+	DefaultTaskWorkers            = 5
+	DefaultTaskQueueSize          = 100
+
+	// 다중 레플리카 기본값
+	DefaultClusterBackend       = "redis"
+	DefaultClusterKeyPrefix     = "aicli:cluster:"
+	DefaultClusterLeaseTTL      = 15 * time.Second
+	DefaultClusterRetryInterval = 5 * time.Second
+	DefaultClusterReapInterval  = 5 * time.Minute
+
+	// 유지보수 모드 기본값
+	DefaultMaintenanceMessage    = "시스템 점검 중입니다. 실행 중인 태스크는 계속 진행되며 새 태스크는 점검 후 실행할 수 있습니다."
+	DefaultMaintenanceRetryAfter = 10 * time.Minute
+	DefaultMaintenanceMaxQueued  = 100
+
+	// 로컬 계정 기본값
+	DefaultBootstrapAdmin      = "admin"
+	DefaultArgon2Memory        = 19 * 1024
+	DefaultArgon2Iterations    = 2
+	DefaultArgon2Parallelism   = 1
+	DefaultPasswordMinLength   = 10
+	DefaultPasswordMaxLength   = 128
+	DefaultLockoutMaxFailures  = 5
+	DefaultLockoutDuration     = 15 * time.Minute
+	DefaultPasswordResetExpiry = 24 * time.Hour
+
+	// 메일 발송 기본값
+	DefaultEmailFromName     = "AICode Manager"
+	DefaultEmailBaseURL      = "http://localhost:3000"
+	DefaultEmailMaxAttempts  = 5
+	DefaultEmailRetryBackoff = time.Minute
+	DefaultEmailPollInterval = 15 * time.Second
+	DefaultSMTPPort          = 587
+
+	// 스토리지 읽기 캐시 기본값
+	DefaultStorageCacheAccountTTL   = time.Minute
+	DefaultStorageCacheWorkspaceTTL = time.Minute
+	DefaultStorageCacheRBACTTL      = 5 * time.Minute
+	DefaultStorageCacheMaxEntries   = 10000
+	
+	// WebSocket 이벤트 로그 기본값
+	DefaultWSEventRingSize  = 512
+	DefaultWSEventMaxEvents = 10000
+	DefaultWSEventRetention = time.Hour
+
+	// 에러 자동 복구 기본값
+	DefaultRecoveryTimeout  = 5 * time.Minute
+	DefaultRecoveryTokenEnv = "CLAUDE_CODE_OAUTH_TOKEN"
+	DefaultRecoveryTokenTTL = 24 * time.Hour
+
+	// 에러 추세 분석 기본값
+	DefaultErrorTrendAnalyzer     = "ewma"
+	DefaultErrorTrendWindow       = 48
+	DefaultErrorTrendInterval     = 5 * time.Minute
+	DefaultErrorTrendSensitivity  = 3.0
+	DefaultErrorTrendAlpha        = 0.3
+	DefaultErrorTrendBeta         = 0.1
+	DefaultErrorTrendGamma        = 0.1
+	DefaultErrorTrendSeasonLength = 24
+
+	// 회로 차단기 기본값
+	DefaultCircuitBreakerFailureThreshold = 5
+	DefaultCircuitBreakerOpenTimeout      = 30 * time.Second
+	DefaultCircuitBreakerHalfOpenProbes   = 1
+
+	// 통합 검색 기본값
+	DefaultSearchBackend            = "memory"
+	DefaultSearchReindexInterval    = 5 * time.Minute
+	DefaultSearchElasticsearchIndex = "aicli-search"
+
+	// 알림 센터 기본값
+	DefaultNotificationsDigestInterval = time.Hour
+
+	// 사용량 분석 기본값
+	DefaultAnalyticsRollupInterval = 5 * time.Minute
+
+	// 대화 기록 내보내기 기본값
+	DefaultExportsPDFTimeout = time.Minute
+	DefaultExportsRetention  = 24 * time.Hour
+
+	// 공격 탐지 기본값
+	DefaultAttackMinConfidence       = 0.7
+	DefaultAttackBruteForceThreshold = 10
+	DefaultAttackBruteForceWindow    = 5 * time.Minute
+	DefaultAttackBlockDuration       = 30 * time.Minute
+	DefaultAttackScanMaxURLBytes     = 8 * 1024
+	DefaultAttackScanMaxHeaderBytes  = 4 * 1024
+	DefaultAttackScanMaxBodyBytes    = 16 * 1024
+
+	// CSP 기본값
+	DefaultCSPReportURI  = "/api/v1/csp-report"
+	DefaultCSPMaxReports = 1000
+
+	// 웹 UI 기본값
+	DefaultWebUIAssetMaxAge = 365 * 24 * time.Hour
+
+	// ACME 기본값
+	DefaultACMEHTTPPort    = 80
+	DefaultACMERenewBefore = 30 * 24 * time.Hour
 )
 
+// DefaultAttackScanSkipContentTypes는 본문을 공격 패턴으로 검사하지 않을 Content-Type 접두사를 반환합니다
+// diff와 소스 코드, 파일 업로드는 정상 요청도 패턴에 걸리기 쉽고 크기가 커서, CSP 위반 보고는 차단된 스크립트를 그대로 담고 있어 검사하지 않습니다.
+func DefaultAttackScanSkipContentTypes() []string {
+	return []string{
+		"multipart/form-data",
+		"application/octet-stream",
+		"application/zip",
+		"application/gzip",
+		"application/x-tar",
+		"text/x-",
+		"application/csp-report",
+		"application/reports+json",
+	}
+}
+
+// DefaultRequestTimeoutRoutes는 기본 예산과 다른 처리 시간이 필요한 경로의 예산을 반환합니다
+// 롱 폴링은 최대 대기 시간보다 길게, 내려받기는 크기에 따라 달라지므로 기한 없이 둡니다.
+// 설정 파일의 키는 소문자로 읽히므로 같은 경로를 덮어쓸 수 있도록 기본값도 소문자로 둡니다.
+func DefaultRequestTimeoutRoutes() map[string]time.Duration {
+	return map[string]time.Duration{
+		"get /api/v1/tasks/:id/events":      90 * time.Second,
+		"get /api/v1/workspaces/:id/export": 10 * time.Minute,
+		"post /api/v1/workspaces/import":    10 * time.Minute,
+		"post /api/v1/artifacts":            10 * time.Minute,
+		"/api/v1/artifacts/download/*":      0,
+		"get /api/v1/sessions/:id/replay":   0,
+		"post /api/v1/admin/backups":        10 * time.Minute,
+	}
+}
+
 // GetDefaultConfig는 기본 설정을 반환합니다
 func GetDefaultConfig() *Config {
 	homeDir, _ := os.UserHomeDir()
@@ -58,6 +323,27 @@ func GetDefaultConfig() *Config {
 	defaultLogPath := filepath.Join(homeDir, ".aicli", "logs", "aicli.log")
 
 	return &Config{
+		Server: ServerConfig{
+			Env:               DefaultServerEnv,
+			Port:              DefaultServerPort,
+			ShutdownTimeout:   DefaultServerShutdownTimeout,
+			ReadHeaderTimeout: DefaultServerReadHeaderTimeout,
+			IdleTimeout:       DefaultServerIdleTimeout,
+			KeepAlive:         true,
+			HTTP2: HTTP2Config{
+				Enabled:              true,
+				MaxConcurrentStreams: DefaultHTTP2MaxConcurrentStreams,
+			},
+		},
+		
+		Limits: LimitsConfig{
+			RateLimitBurst:         DefaultRateLimitBurst,
+			AuthenticatedRateLimit: DefaultAuthenticatedRateLimit,
+			AuthenticatedBurst:     DefaultAuthenticatedBurst,
+			TaskWorkers:            DefaultTaskWorkers,
+			TaskQueueSize:          DefaultTaskQueueSize,
+		},
+		
 		Claude: ClaudeConfig{
 			Model:       DefaultClaudeModel,
 			Temperature: DefaultClaudeTemperature,
@@ -82,6 +368,23 @@ func GetDefaultConfig() *Config {
 				".DS_Store",
 				"vendor/**",
 			},
+			Watch: WorkspaceWatchConfig{
+				Dir:            filepath.Join(homeDir, ".aicli", "watch"),
+				MaxDirectories: DefaultWatchMaxDirectories,
+			},
+			Snapshots: WorkspaceSnapshotConfig{
+				Enabled:         true,
+				Dir:             filepath.Join(homeDir, ".aicli", "snapshots"),
+				MaxTotalSizeMB:  DefaultSnapshotMaxTotalMB,
+				MaxPerWorkspace: DefaultSnapshotMaxPerWorkspace,
+				MaxAge:          DefaultSnapshotMaxAge,
+			},
+			DiskQuota: WorkspaceDiskQuotaConfig{
+				Enabled:      false,
+				WarnRatio:    DefaultDiskQuotaWarnRatio,
+				CacheTTL:     DefaultDiskQuotaCacheTTL,
+				ScanInterval: DefaultDiskQuotaScanInterval,
+			},
 		},
 		Output: OutputConfig{
 			Format:        DefaultOutputFormat,
@@ -108,16 +411,55 @@ func GetDefaultConfig() *Config {
 			NetworkMode:     DefaultDockerNetworkMode,
 			AutoCleanup:     true,
 			ContainerPrefix: DefaultContainerPrefix,
+			Cache: DependencyCacheConfig{
+				Enabled:            true,
+				Backend:            DefaultDependencyCacheBackend,
+				HostDir:            filepath.Join(homeDir, ".aicli", "cache"),
+				MaxTotalSizeMB:     DefaultDependencyCacheMaxTotalMB,
+				MaxWorkspaceSizeMB: DefaultDependencyCacheMaxWorkspaceMB,
+			},
+			WarmPool: WarmPoolConfig{
+				Enabled:             false,
+				Size:                DefaultWarmPoolSize,
+				HealthCheckInterval: DefaultWarmPoolHealthCheckInterval,
+				MaxIdle:             DefaultWarmPoolMaxIdle,
+			},
+			ImagePolicy: ImagePolicyConfig{
+				AllowedImages:   []string{"aicli/workspace:*", "aicli-workspace:*"},
+				AllowDockerfile: true,
+				AllowPrivileged: false,
+				Dir:             filepath.Join(homeDir, ".aicli", "images"),
+				BuildTimeout:    DefaultImageBuildTimeout,
+			},
+			NetworkPolicy: NetworkPolicyConfig{
+				DefaultMode: DefaultNetworkPolicyMode,
+				ProxyImage:  DefaultEgressProxyImage,
+				Dir:         filepath.Join(homeDir, ".aicli", "network"),
+			},
 		},
 		API: APIConfig{
 			Address:            DefaultAPIAddress,
 			TLSEnabled:         false,
+			OCSPStapling:       true,
+			ACME: ACMEConfig{
+				CacheDir:    filepath.Join(homeDir, ".aicli", "acme"),
+				HTTPPort:    DefaultACMEHTTPPort,
+				RenewBefore: DefaultACMERenewBefore,
+			},
 			CORSOrigins:        []string{"http://localhost:3000"},
 			RateLimit:          DefaultRateLimit,
 			JWTExpiration:      DefaultJWTExpiration,
 			JWTSecret:          DefaultJWTSecretKey,
 			AccessTokenExpiry:  DefaultAccessTokenExpiry,
 			RefreshTokenExpiry: DefaultRefreshTokenExpiry,
+			OAuth: OAuthConfig{
+				StateExpiry: DefaultOAuthStateExpiry,
+			},
+			RequestTimeout: RequestTimeoutConfig{
+				Default:   DefaultRequestTimeout,
+				MaxClient: DefaultRequestTimeoutMaxClient,
+				Routes:    DefaultRequestTimeoutRoutes(),
+			},
 		},
 		
 		Storage: StorageConfig{
@@ -129,6 +471,284 @@ func GetDefaultConfig() *Config {
 			Timeout:         time.Second * 30,
 			RetryCount:      3,
 			RetryInterval:   time.Second,
+			Cache: StorageCacheConfig{
+				Enabled:      true,
+				AccountTTL:   DefaultStorageCacheAccountTTL,
+				WorkspaceTTL: DefaultStorageCacheWorkspaceTTL,
+				RBACTTL:      DefaultStorageCacheRBACTTL,
+				MaxEntries:   DefaultStorageCacheMaxEntries,
+			},
+		},
+		
+		Backup: BackupConfig{
+			Enabled:     false,
+			Interval:    DefaultBackupInterval,
+			Retention:   DefaultBackupRetention,
+			Destination: "local",
+			LocalDir:    filepath.Join(homeDir, ".aicli", "backups"),
+		},
+		
+		Artifacts: ArtifactConfig{
+			Driver:        "local",
+			LocalDir:      filepath.Join(homeDir, ".aicli", "artifacts"),
+			PublicURL:     "http://" + DefaultAPIAddress,
+			PresignExpiry: DefaultArtifactPresignExpiry,
+			MaxUploadSize: DefaultArtifactMaxUploadSize,
+		},
+		
+		Events: EventsConfig{
+			Broker:        "none",
+			Serialization: "json",
+			Topics: EventTopicsConfig{
+				Prefix: DefaultEventsTopicPrefix,
+			},
+			BufferSize: DefaultEventsBufferSize,
+			MaxRetries: DefaultEventsMaxRetries,
+			NATS: NATSConfig{
+				URL:      "nats://localhost:4222",
+				Stream:   "AICLI_EVENTS",
+				Subjects: []string{DefaultEventsTopicPrefix + ".>"},
+			},
+			Kafka: KafkaConfig{
+				Brokers:      []string{"localhost:9092"},
+				ClientID:     "aicli",
+				RequiredAcks: "all",
+			},
+		},
+		
+		Plugins: PluginsConfig{
+			StatePath:      filepath.Join(homeDir, ".aicli", "plugins", "state.json"),
+			DefaultTimeout: DefaultPluginTimeout,
+		},
+		
+		MCP: MCPConfig{
+			Dir:                 filepath.Join(homeDir, ".aicli", "mcp"),
+			HealthCheckInterval: DefaultMCPHealthCheckInterval,
+			HealthCheckTimeout:  DefaultMCPHealthCheckTimeout,
+		},
+		
+		Agents: AgentsConfig{
+			Default: DefaultAgentProvider,
+		},
+		
+		Restart: RestartConfig{
+			RestartPolicyConfig: RestartPolicyConfig{
+				MaxRestarts:             DefaultRestartMaxRestarts,
+				InitialBackoff:          DefaultRestartInitialBackoff,
+				MaxBackoff:              DefaultRestartMaxBackoff,
+				BackoffMultiplier:       DefaultRestartBackoffMultiplier,
+				CircuitBreakerThreshold: DefaultRestartCircuitBreakerThreshold,
+				CircuitBreakerCooldown:  DefaultRestartCircuitBreakerCooldown,
+				StableAfter:             DefaultRestartStableAfter,
+			},
+		},
+		
+		Reaper: ReaperConfig{
+			Enabled:     DefaultReaperEnabled,
+			Action:      DefaultReaperAction,
+			KillTimeout: DefaultReaperKillTimeout,
+		},
+		
+		Hang: HangConfig{
+			Timeout: DefaultHangTimeout,
+		},
+		
+		ContextWindow: ContextWindowConfig{
+			Enabled:             true,
+			Limit:               DefaultContextWindowLimit,
+			WarnRatio:           DefaultContextWindowWarnRatio,
+			CompactRatio:        DefaultContextWindowCompactRatio,
+			AutoCompact:         true,
+			SummaryMaxChars:     DefaultContextSummaryMaxChars,
+			SummaryMessageChars: DefaultContextSummaryMessageChars,
+			CompactTimeout:      DefaultContextCompactTimeout,
+		},
+		
+		Quota: QuotaConfig{
+			NearRatio:      DefaultQuotaNearRatio,
+			Cooldown:       DefaultQuotaCooldown,
+			AuthQuarantine: DefaultQuotaAuthQuarantine,
+			MaxDelay:       DefaultQuotaMaxDelay,
+		},
+		
+		Preemption: PreemptionConfig{
+			Policy:                 DefaultPreemptionPolicy,
+			MinPreemptorPriority:   DefaultPreemptionMinPreemptorPriority,
+			MaxPreemptiblePriority: DefaultPreemptionMaxPreemptiblePriority,
+			MaxPreemptionsPerTask:  DefaultPreemptionMaxPreemptionsPerTask,
+		},
+		
+		Redaction: RedactionConfig{
+			Default: RedactionPolicyConfig{
+				Secrets:          true,
+				PII:              true,
+				Entropy:          true,
+				EntropyThreshold: DefaultRedactionEntropyThreshold,
+				EntropyMinLength: DefaultRedactionEntropyMinLength,
+			},
+		},
+		
+		ErrorStats: ErrorStatsConfig{
+			Enabled:         true,
+			FlushInterval:   DefaultErrorStatsFlushInterval,
+			CompactInterval: DefaultErrorStatsCompactInterval,
+			HourlyRetention: DefaultErrorStatsHourlyRetention,
+			DailyRetention:  DefaultErrorStatsDailyRetention,
+			Trend: ErrorTrendConfig{
+				Enabled:      true,
+				Analyzer:     DefaultErrorTrendAnalyzer,
+				Window:       DefaultErrorTrendWindow,
+				Interval:     DefaultErrorTrendInterval,
+				Sensitivity:  DefaultErrorTrendSensitivity,
+				Alpha:        DefaultErrorTrendAlpha,
+				Beta:         DefaultErrorTrendBeta,
+				Gamma:        DefaultErrorTrendGamma,
+				SeasonLength: DefaultErrorTrendSeasonLength,
+			},
+		},
+		
+		Recovery: RecoveryConfig{
+			Enabled:  true,
+			Timeout:  DefaultRecoveryTimeout,
+			TokenEnv: DefaultRecoveryTokenEnv,
+			TokenTTL: DefaultRecoveryTokenTTL,
+		},
+		
+		Tasks: TasksConfig{
+			QuickTimeout:      DefaultTaskQuickTimeout,
+			StandardTimeout:   DefaultTaskStandardTimeout,
+			LongTimeout:       DefaultTaskLongTimeout,
+			CancelGracePeriod: DefaultTaskCancelGracePeriod,
+			Admission: TaskAdmissionConfig{
+				SampleInterval: DefaultAdmissionSampleInterval,
+				CPUPercent:     DefaultAdmissionCPUPercent,
+				MemoryPercent:  DefaultAdmissionMemoryPercent,
+				DiskPercent:    DefaultAdmissionDiskPercent,
+				MaxDeferred:    DefaultAdmissionMaxDeferred,
+				ReleaseBatch:   DefaultAdmissionReleaseBatch,
+			},
+		},
+		
+		Kubernetes: KubernetesConfig{
+			Image:            DefaultKubernetesImage,
+			ImagePullPolicy:  "IfNotPresent",
+			ClaimNameFormat:  DefaultKubernetesClaimNameFormat,
+			MountPath:        DefaultKubernetesMountPath,
+			StartTimeout:     DefaultKubernetesStartTimeout,
+			TTLAfterFinished: DefaultKubernetesTTLAfterFinished,
+		},
+		
+		Remote: RemoteConfig{
+			HeartbeatInterval: DefaultRemoteHeartbeatInterval,
+			HeartbeatTimeout:  DefaultRemoteHeartbeatTimeout,
+			AssignTimeout:     DefaultRemoteAssignTimeout,
+		},
+		
+		Cluster: ClusterConfig{
+			Backend:       DefaultClusterBackend,
+			Redis:         ClusterRedisConfig{Addr: "localhost:6379"},
+			KeyPrefix:     DefaultClusterKeyPrefix,
+			LeaseTTL:      DefaultClusterLeaseTTL,
+			RetryInterval: DefaultClusterRetryInterval,
+			ReapInterval:  DefaultClusterReapInterval,
+		},
+		
+		Maintenance: MaintenanceConfig{
+			Message:    DefaultMaintenanceMessage,
+			RetryAfter: DefaultMaintenanceRetryAfter,
+			MaxQueued:  DefaultMaintenanceMaxQueued,
+		},
+		
+		Accounts: AccountsConfig{
+			BootstrapAdmin: DefaultBootstrapAdmin,
+			Argon2: Argon2Config{
+				Memory:      DefaultArgon2Memory,
+				Iterations:  DefaultArgon2Iterations,
+				Parallelism: DefaultArgon2Parallelism,
+			},
+			PasswordPolicy: PasswordPolicyConfig{
+				MinLength:        DefaultPasswordMinLength,
+				MaxLength:        DefaultPasswordMaxLength,
+				RequireUpper:     true,
+				RequireLower:     true,
+				RequireDigit:     true,
+				DisallowUsername: true,
+			},
+			Lockout: AccountLockoutConfig{
+				MaxFailures: DefaultLockoutMaxFailures,
+				Duration:    DefaultLockoutDuration,
+			},
+			ResetTokenExpiry: DefaultPasswordResetExpiry,
+		},
+		
+		Email: EmailConfig{
+			FromName:     DefaultEmailFromName,
+			BaseURL:      DefaultEmailBaseURL,
+			MaxAttempts:  DefaultEmailMaxAttempts,
+			RetryBackoff: DefaultEmailRetryBackoff,
+			PollInterval: DefaultEmailPollInterval,
+			SMTP: SMTPConfig{
+				Port: DefaultSMTPPort,
+			},
+		},
+		
+		WebSocket: WebSocketConfig{
+			EventLog: WebSocketEventLogConfig{
+				Enabled:   true,
+				Dir:       filepath.Join(homeDir, ".aicli", "ws-events"),
+				RingSize:  DefaultWSEventRingSize,
+				MaxEvents: DefaultWSEventMaxEvents,
+				Retention: DefaultWSEventRetention,
+			},
+		},
+		
+		CircuitBreaker: CircuitBreakerConfig{
+			Enabled:          true,
+			FailureThreshold: DefaultCircuitBreakerFailureThreshold,
+			OpenTimeout:      DefaultCircuitBreakerOpenTimeout,
+			HalfOpenProbes:   DefaultCircuitBreakerHalfOpenProbes,
+		},
+		
+		Search: SearchConfig{
+			Backend:         DefaultSearchBackend,
+			ReindexInterval: DefaultSearchReindexInterval,
+			Elasticsearch: SearchElasticsearchConfig{
+				Index: DefaultSearchElasticsearchIndex,
+			},
+		},
+		
+		Notifications: NotificationsConfig{
+			DigestInterval: DefaultNotificationsDigestInterval,
+		},
+		
+		Analytics: AnalyticsConfig{
+			RollupInterval: DefaultAnalyticsRollupInterval,
+		},
+		
+		Exports: ExportsConfig{
+			PDFTimeout: DefaultExportsPDFTimeout,
+			Retention:  DefaultExportsRetention,
+		},
+		
+		AttackDetection: AttackDetectionConfig{
+			MinConfidence:       DefaultAttackMinConfidence,
+			BruteForceThreshold: DefaultAttackBruteForceThreshold,
+			BruteForceWindow:    DefaultAttackBruteForceWindow,
+			BlockDuration:       DefaultAttackBlockDuration,
+			Scan: AttackScanConfig{
+				MaxURLBytes:      DefaultAttackScanMaxURLBytes,
+				MaxHeaderBytes:   DefaultAttackScanMaxHeaderBytes,
+				MaxBodyBytes:     DefaultAttackScanMaxBodyBytes,
+				SkipContentTypes: DefaultAttackScanSkipContentTypes(),
+			},
+		},
+		
+		CSP: CSPConfig{
+			ReportURI:  DefaultCSPReportURI,
+			MaxReports: DefaultCSPMaxReports,
+		},
+		WebUI: WebUIConfig{
+			AssetMaxAge: DefaultWebUIAssetMaxAge,
 		},
 	}
 }
@@ -42,6 +42,8 @@ const (
 	EnvLogMaxAge     = "AICLI_LOG_MAX_AGE"
 	EnvLogCompress   = "AICLI_LOG_COMPRESS"
 	EnvLogJSONFormat = "AICLI_LOG_JSON_FORMAT"
+	// 모듈별 로그 레벨 접두사 (예: AICLI_LOG_LEVEL_CLAUDE=debug)
+	EnvLogModuleLevelPrefix = "AICLI_LOG_LEVEL_"
 
 	// Docker 환경 변수
 	EnvDockerSocketPath     = "AICLI_DOCKER_SOCKET_PATH"
@@ -57,10 +59,93 @@ const (
 	EnvAPITLSEnabled   = "AICLI_API_TLS_ENABLED"
 	EnvAPITLSCertPath  = "AICLI_API_TLS_CERT_PATH"
 	EnvAPITLSKeyPath   = "AICLI_API_TLS_KEY_PATH"
+	EnvAPIOCSPStapling = "AICLI_API_OCSP_STAPLING"
+	EnvAPIACMEEnabled  = "AICLI_API_ACME_ENABLED"
+	EnvAPIACMEDomains  = "AICLI_API_ACME_DOMAINS"
+	EnvAPIACMEEmail    = "AICLI_API_ACME_EMAIL"
+	EnvAPIACMECacheDir = "AICLI_API_ACME_CACHE_DIR"
 	EnvAPICORSOrigins  = "AICLI_API_CORS_ORIGINS"
 	EnvAPIRateLimit    = "AICLI_API_RATE_LIMIT"
 	EnvAPIJWTSecret    = "AICLI_API_JWT_SECRET"
 	EnvAPIJWTExpiration = "AICLI_API_JWT_EXPIRATION"
+	EnvAPIMetricsToken  = "AICLI_API_METRICS_TOKEN"
+	EnvAPIRequestTimeout          = "AICLI_API_REQUEST_TIMEOUT"
+	EnvAPIRequestTimeoutMaxClient = "AICLI_API_REQUEST_TIMEOUT_MAX_CLIENT"
+
+	// API 서버 실행 환경 변수
+	EnvServerEnv  = "AICLI_ENV"
+	EnvServerPort = "AICLI_PORT"
+	EnvServerReadTimeout  = "AICLI_SERVER_READ_TIMEOUT"
+	EnvServerWriteTimeout = "AICLI_SERVER_WRITE_TIMEOUT"
+	EnvServerIdleTimeout  = "AICLI_SERVER_IDLE_TIMEOUT"
+	EnvServerKeepAlive    = "AICLI_SERVER_KEEP_ALIVE"
+	EnvServerHTTP2Enabled = "AICLI_SERVER_HTTP2_ENABLED"
+	EnvServerHTTP2H2C     = "AICLI_SERVER_HTTP2_H2C"
+
+	// 요청 제한과 워커 수 환경 변수
+	EnvLimitsAuthenticatedRateLimit = "AICLI_LIMITS_AUTHENTICATED_RATE_LIMIT"
+	EnvLimitsTaskWorkers            = "AICLI_LIMITS_TASK_WORKERS"
+	EnvLimitsSessionsPerUser        = "AICLI_LIMITS_SESSIONS_PER_USER"
+	EnvLimitsSessionsPerOrg         = "AICLI_LIMITS_SESSIONS_PER_ORG"
+
+	// 로컬 계정 환경 변수
+	EnvAccountsEnabled       = "AICLI_ACCOUNTS_ENABLED"
+	EnvAccountsAdminPassword = "AICLI_ADMIN_PASSWORD"
+
+	// 메일 발송 환경 변수 (SES 키는 AWS 표준 환경 변수도 사용)
+	EnvEmailDriver         = "AICLI_EMAIL_DRIVER"
+	EnvEmailSMTPPassword   = "AICLI_SMTP_PASSWORD"
+	EnvEmailSESAccessKeyID = "AWS_ACCESS_KEY_ID"
+	EnvEmailSESSecretKey   = "AWS_SECRET_ACCESS_KEY"
+
+	// 회로 차단기 환경 변수
+	EnvCircuitBreakerEnabled     = "AICLI_CIRCUIT_BREAKER_ENABLED"
+	EnvCircuitBreakerOpenTimeout = "AICLI_CIRCUIT_BREAKER_OPEN_TIMEOUT"
+	
+	// 장애 주입 테스트 모드 설정
+	EnvChaosEnabled = "AICLI_CHAOS_ENABLED"
+	EnvChaosSeed    = "AICLI_CHAOS_SEED"
+	
+	// 에이전트 출력 녹화 디렉토리
+	EnvAgentsRecordDir = "AICLI_AGENTS_RECORD_DIR"
+	
+	// 스토리지 백엔드 설정
+	EnvStorageType       = "AICLI_STORAGE_TYPE"
+	EnvStorageDataSource = "AICLI_STORAGE_DATA_SOURCE"
+	
+	// 통합 검색 인덱스 설정
+	EnvSearchBackend          = "AICLI_SEARCH_BACKEND"
+	EnvSearchReindexInterval  = "AICLI_SEARCH_REINDEX_INTERVAL"
+	EnvSearchElasticsearchURL = "AICLI_SEARCH_ELASTICSEARCH_URL"
+	
+	// 알림 센터 설정
+	EnvNotificationsDigestInterval = "AICLI_NOTIFICATIONS_DIGEST_INTERVAL"
+	
+	// 사용량 분석 설정
+	EnvAnalyticsRollupInterval = "AICLI_ANALYTICS_ROLLUP_INTERVAL"
+	
+	// 대화 기록 내보내기 설정
+	EnvExportsPDFCommand = "AICLI_EXPORTS_PDF_COMMAND"
+	EnvExportsPDFTimeout = "AICLI_EXPORTS_PDF_TIMEOUT"
+	
+	// 비밀 값 암호화 설정
+	EnvSecretsKey = "AICLI_SECRETS_KEY"
+	
+	// 공격 탐지 설정
+	EnvAttackDetectionEnabled             = "AICLI_ATTACK_DETECTION_ENABLED"
+	EnvAttackDetectionDryRun              = "AICLI_ATTACK_DETECTION_DRY_RUN"
+	EnvAttackDetectionMinConfidence       = "AICLI_ATTACK_DETECTION_MIN_CONFIDENCE"
+	EnvAttackDetectionBruteForceThreshold = "AICLI_ATTACK_DETECTION_BRUTE_FORCE_THRESHOLD"
+	EnvAttackDetectionMaxBodyBytes        = "AICLI_ATTACK_DETECTION_MAX_BODY_BYTES"
+	
+	// CSP 설정
+	EnvCSPEnabled    = "AICLI_CSP_ENABLED"
+	EnvCSPNonce      = "AICLI_CSP_NONCE"
+	EnvCSPReportOnly = "AICLI_CSP_REPORT_ONLY"
+	
+	// 웹 UI 설정
+	EnvWebUIEnabled = "AICLI_WEB_UI_ENABLED"
+	EnvWebUIDir     = "AICLI_WEB_UI_DIR"
 )
 
 // LoadFromEnv는 환경 변수에서 설정을 읽어 기존 설정에 적용합니다
@@ -148,6 +233,16 @@ func LoadFromEnv(cfg *Config) error {
 	if level := os.Getenv(EnvLogLevel); level != "" {
 		cfg.Logging.Level = level
 	}
+	for _, env := range os.Environ() {
+		name, level, ok := strings.Cut(env, "=")
+		if !ok || level == "" || !strings.HasPrefix(name, EnvLogModuleLevelPrefix) {
+			continue
+		}
+		if cfg.Logging.Modules == nil {
+			cfg.Logging.Modules = make(map[string]string)
+		}
+		cfg.Logging.Modules[strings.ToLower(strings.TrimPrefix(name, EnvLogModuleLevelPrefix))] = level
+	}
 	if filePath := os.Getenv(EnvLogFilePath); filePath != "" {
 		cfg.Logging.FilePath = filePath
 	}
@@ -213,6 +308,21 @@ func LoadFromEnv(cfg *Config) error {
 	if tlsKeyPath := os.Getenv(EnvAPITLSKeyPath); tlsKeyPath != "" {
 		cfg.API.TLSKeyPath = tlsKeyPath
 	}
+	if ocspStapling := os.Getenv(EnvAPIOCSPStapling); ocspStapling != "" {
+		cfg.API.OCSPStapling = parseBool(ocspStapling)
+	}
+	if acmeEnabled := os.Getenv(EnvAPIACMEEnabled); acmeEnabled != "" {
+		cfg.API.ACME.Enabled = parseBool(acmeEnabled)
+	}
+	if acmeDomains := os.Getenv(EnvAPIACMEDomains); acmeDomains != "" {
+		cfg.API.ACME.Domains = strings.Split(acmeDomains, ",")
+	}
+	if acmeEmail := os.Getenv(EnvAPIACMEEmail); acmeEmail != "" {
+		cfg.API.ACME.Email = acmeEmail
+	}
+	if acmeCacheDir := os.Getenv(EnvAPIACMECacheDir); acmeCacheDir != "" {
+		cfg.API.ACME.CacheDir = acmeCacheDir
+	}
 	if corsOrigins := os.Getenv(EnvAPICORSOrigins); corsOrigins != "" {
 		cfg.API.CORSOrigins = strings.Split(corsOrigins, ",")
 	}
@@ -224,11 +334,219 @@ func LoadFromEnv(cfg *Config) error {
 	if jwtSecret := os.Getenv(EnvAPIJWTSecret); jwtSecret != "" {
 		cfg.API.JWTSecret = jwtSecret
 	}
+	if metricsToken := os.Getenv(EnvAPIMetricsToken); metricsToken != "" {
+		cfg.API.MetricsToken = metricsToken
+	}
 	if jwtExpiration := os.Getenv(EnvAPIJWTExpiration); jwtExpiration != "" {
 		if d, err := time.ParseDuration(jwtExpiration); err == nil {
 			cfg.API.JWTExpiration = d
 		}
 	}
+	if requestTimeout := os.Getenv(EnvAPIRequestTimeout); requestTimeout != "" {
+		if d, err := time.ParseDuration(requestTimeout); err == nil {
+			cfg.API.RequestTimeout.Default = d
+		}
+	}
+	if maxClient := os.Getenv(EnvAPIRequestTimeoutMaxClient); maxClient != "" {
+		if d, err := time.ParseDuration(maxClient); err == nil {
+			cfg.API.RequestTimeout.MaxClient = d
+		}
+	}
+
+	// API 서버 실행 설정
+	if env := os.Getenv(EnvServerEnv); env != "" {
+		cfg.Server.Env = env
+	}
+	if port := os.Getenv(EnvServerPort); port != "" {
+		if i, err := strconv.Atoi(port); err == nil {
+			cfg.Server.Port = i
+		}
+	}
+	if readTimeout := os.Getenv(EnvServerReadTimeout); readTimeout != "" {
+		if d, err := time.ParseDuration(readTimeout); err == nil {
+			cfg.Server.ReadTimeout = d
+		}
+	}
+	if writeTimeout := os.Getenv(EnvServerWriteTimeout); writeTimeout != "" {
+		if d, err := time.ParseDuration(writeTimeout); err == nil {
+			cfg.Server.WriteTimeout = d
+		}
+	}
+	if idleTimeout := os.Getenv(EnvServerIdleTimeout); idleTimeout != "" {
+		if d, err := time.ParseDuration(idleTimeout); err == nil {
+			cfg.Server.IdleTimeout = d
+		}
+	}
+	if keepAlive := os.Getenv(EnvServerKeepAlive); keepAlive != "" {
+		cfg.Server.KeepAlive = parseBool(keepAlive)
+	}
+	if http2Enabled := os.Getenv(EnvServerHTTP2Enabled); http2Enabled != "" {
+		cfg.Server.HTTP2.Enabled = parseBool(http2Enabled)
+	}
+	if h2c := os.Getenv(EnvServerHTTP2H2C); h2c != "" {
+		cfg.Server.HTTP2.H2C = parseBool(h2c)
+	}
+
+	// 요청 제한과 워커 수
+	if rateLimit := os.Getenv(EnvLimitsAuthenticatedRateLimit); rateLimit != "" {
+		if i, err := strconv.Atoi(rateLimit); err == nil {
+			cfg.Limits.AuthenticatedRateLimit = i
+		}
+	}
+	if workers := os.Getenv(EnvLimitsTaskWorkers); workers != "" {
+		if i, err := strconv.Atoi(workers); err == nil {
+			cfg.Limits.TaskWorkers = i
+		}
+	}
+	if sessions := os.Getenv(EnvLimitsSessionsPerUser); sessions != "" {
+		if i, err := strconv.Atoi(sessions); err == nil {
+			cfg.Limits.SessionsPerUser = i
+		}
+	}
+	if sessions := os.Getenv(EnvLimitsSessionsPerOrg); sessions != "" {
+		if i, err := strconv.Atoi(sessions); err == nil {
+			cfg.Limits.SessionsPerOrg = i
+		}
+	}
+
+	// 로컬 계정 (비밀번호는 설정 파일보다 환경 변수 사용 권장)
+	if enabled := os.Getenv(EnvAccountsEnabled); enabled != "" {
+		cfg.Accounts.Enabled = parseBool(enabled)
+	}
+	if password := os.Getenv(EnvAccountsAdminPassword); password != "" {
+		cfg.Accounts.BootstrapPassword = password
+	}
+
+	// 메일 발송 (SES 키는 설정 파일에 없을 때만 AWS 환경 변수 사용)
+	if driver := os.Getenv(EnvEmailDriver); driver != "" {
+		cfg.Email.Driver = driver
+	}
+	if password := os.Getenv(EnvEmailSMTPPassword); password != "" {
+		cfg.Email.SMTP.Password = password
+	}
+	if cfg.Email.SES.AccessKeyID == "" {
+		cfg.Email.SES.AccessKeyID = os.Getenv(EnvEmailSESAccessKeyID)
+	}
+	if cfg.Email.SES.SecretAccessKey == "" {
+		cfg.Email.SES.SecretAccessKey = os.Getenv(EnvEmailSESSecretKey)
+	}
+
+	// 회로 차단기
+	if enabled := os.Getenv(EnvCircuitBreakerEnabled); enabled != "" {
+		cfg.CircuitBreaker.Enabled = parseBool(enabled)
+	}
+	if openTimeout := os.Getenv(EnvCircuitBreakerOpenTimeout); openTimeout != "" {
+		if d, err := time.ParseDuration(openTimeout); err == nil {
+			cfg.CircuitBreaker.OpenTimeout = d
+		}
+	}
+	
+	// 장애 주입 테스트 모드 설정
+	if enabled := os.Getenv(EnvChaosEnabled); enabled != "" {
+		cfg.Chaos.Enabled = parseBool(enabled)
+	}
+	if seed := os.Getenv(EnvChaosSeed); seed != "" {
+		if n, err := strconv.ParseInt(seed, 10, 64); err == nil {
+			cfg.Chaos.Seed = n
+		}
+	}
+	
+	// 에이전트 출력 녹화 디렉토리
+	if dir := os.Getenv(EnvAgentsRecordDir); dir != "" {
+		cfg.Agents.RecordDir = dir
+	}
+	
+	// 스토리지 백엔드 설정
+	if storageType := os.Getenv(EnvStorageType); storageType != "" {
+		cfg.Storage.Type = storageType
+	}
+	if dataSource := os.Getenv(EnvStorageDataSource); dataSource != "" {
+		cfg.Storage.DataSource = dataSource
+	}
+	
+	// 통합 검색 인덱스 설정
+	if backend := os.Getenv(EnvSearchBackend); backend != "" {
+		cfg.Search.Backend = backend
+	}
+	if interval := os.Getenv(EnvSearchReindexInterval); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			cfg.Search.ReindexInterval = d
+		}
+	}
+	if url := os.Getenv(EnvSearchElasticsearchURL); url != "" {
+		cfg.Search.Elasticsearch.URL = url
+	}
+	
+	// 알림 센터 설정
+	if interval := os.Getenv(EnvNotificationsDigestInterval); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			cfg.Notifications.DigestInterval = d
+		}
+	}
+	
+	// 사용량 분석 설정
+	if interval := os.Getenv(EnvAnalyticsRollupInterval); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			cfg.Analytics.RollupInterval = d
+		}
+	}
+	
+	// 대화 기록 내보내기 설정
+	if command := os.Getenv(EnvExportsPDFCommand); command != "" {
+		cfg.Exports.PDFCommand = command
+	}
+	if timeout := os.Getenv(EnvExportsPDFTimeout); timeout != "" {
+		if d, err := time.ParseDuration(timeout); err == nil {
+			cfg.Exports.PDFTimeout = d
+		}
+	}
+	
+	// 비밀 값 암호화 키 (설정 파일보다 환경 변수 사용 권장)
+	if key := os.Getenv(EnvSecretsKey); key != "" {
+		cfg.Secrets.Key = key
+	}
+	
+	// 공격 탐지 설정
+	if enabled := os.Getenv(EnvAttackDetectionEnabled); enabled != "" {
+		cfg.AttackDetection.Enabled = parseBool(enabled)
+	}
+	if dryRun := os.Getenv(EnvAttackDetectionDryRun); dryRun != "" {
+		cfg.AttackDetection.DryRun = parseBool(dryRun)
+	}
+	if confidence := os.Getenv(EnvAttackDetectionMinConfidence); confidence != "" {
+		if f, err := strconv.ParseFloat(confidence, 64); err == nil {
+			cfg.AttackDetection.MinConfidence = f
+		}
+	}
+	if threshold := os.Getenv(EnvAttackDetectionBruteForceThreshold); threshold != "" {
+		if i, err := strconv.Atoi(threshold); err == nil {
+			cfg.AttackDetection.BruteForceThreshold = i
+		}
+	}
+	if maxBytes := os.Getenv(EnvAttackDetectionMaxBodyBytes); maxBytes != "" {
+		if i, err := strconv.Atoi(maxBytes); err == nil {
+			cfg.AttackDetection.Scan.MaxBodyBytes = i
+		}
+	}
+	
+	// CSP 설정
+	if enabled := os.Getenv(EnvCSPEnabled); enabled != "" {
+		cfg.CSP.Enabled = parseBool(enabled)
+	}
+	if nonce := os.Getenv(EnvCSPNonce); nonce != "" {
+		cfg.CSP.Nonce = parseBool(nonce)
+	}
+	if reportOnly := os.Getenv(EnvCSPReportOnly); reportOnly != "" {
+		cfg.CSP.ReportOnly = parseBool(reportOnly)
+	}
+	
+	// 웹 UI 설정
+	if enabled := os.Getenv(EnvWebUIEnabled); enabled != "" {
+		cfg.WebUI.Enabled = parseBool(enabled)
+	}
+	if dir := os.Getenv(EnvWebUIDir); dir != "" {
+		cfg.WebUI.Dir = dir
+	}
 
 	return nil
 }
@@ -0,0 +1,124 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// EnvConfigPath API 서버 설정 파일 경로 환경 변수
+const EnvConfigPath = "AICLI_CONFIG_PATH"
+
+// SystemConfigPath 시스템 전역 설정 파일 경로
+const SystemConfigPath = "/etc/aicli/config.yaml"
+
+// ResolveServerConfigPath API 서버 설정 파일 경로를 결정합니다
+// --config 플래그, AICLI_CONFIG_PATH, ~/.aicli/config.yaml, /etc/aicli/config.yaml 순서로 찾으며,
+// 플래그나 환경 변수로 지정한 경로는 존재 여부와 관계없이 그대로 사용합니다 (없으면 로드 시 에러).
+// 기본 위치에 파일이 없으면 빈 문자열을 반환합니다 (기본값과 환경 변수만 사용).
+func ResolveServerConfigPath(flagPath string) string {
+	if flagPath != "" {
+		return flagPath
+	}
+	if envPath := os.Getenv(EnvConfigPath); envPath != "" {
+		return envPath
+	}
+	for _, candidate := range []string{filepath.Join(GetConfigDir(), ConfigFileName), SystemConfigPath} {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// LoadServerConfig 기본값, 설정 파일, 환경 변수 순서로 API 서버 설정을 읽고 검증합니다
+// path가 비어 있으면 설정 파일 없이 기본값과 환경 변수만 사용합니다.
+func LoadServerConfig(path string) (*Config, error) {
+	cfg := GetDefaultConfig()
+
+	if path != "" {
+		v := viper.New()
+		v.SetConfigFile(path)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("설정 파일 읽기 실패 (%s): %w", path, err)
+		}
+		if err := v.Unmarshal(cfg); err != nil {
+			return nil, fmt.Errorf("설정 파일 해석 실패 (%s): %w", path, err)
+		}
+	}
+
+	if err := LoadFromEnv(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateServerConfig(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// ValidateServerConfig API 서버가 사용하는 설정 섹션(서버, API 보안, 스토리지, 로깅, 요청 제한)을 검증합니다
+func ValidateServerConfig(cfg *Config) error {
+	sections := []struct {
+		name  string
+		value interface{}
+	}{
+		{"server", cfg.Server},
+		{"api", cfg.API},
+		{"storage", cfg.Storage},
+		{"logging", cfg.Logging},
+		{"limits", cfg.Limits},
+		{"accounts", cfg.Accounts},
+		{"email", cfg.Email},
+		{"chaos", cfg.Chaos},
+		{"search", cfg.Search},
+		{"notifications", cfg.Notifications},
+		{"analytics", cfg.Analytics},
+		{"exports", cfg.Exports},
+		{"secrets", cfg.Secrets},
+		{"attack_detection", cfg.AttackDetection},
+		{"csp", cfg.CSP},
+		{"web_ui", cfg.WebUI},
+	}
+	for _, section := range sections {
+		if err := validation.Validate(section.value); err != nil {
+			return fmt.Errorf("설정 검증 실패 (%s): %w", section.name, err)
+		}
+	}
+
+	if cfg.Server.Env == "production" && cfg.API.JWTSecret == DefaultJWTSecretKey {
+		return errors.New("설정 검증 실패 (api): production 환경에서는 api.jwt_secret을 설정해야 합니다")
+	}
+	if cfg.Server.Env == "production" && cfg.Chaos.Enabled {
+		return errors.New("설정 검증 실패 (chaos): production 환경에서는 장애 주입을 사용할 수 없습니다")
+	}
+	if cfg.API.TLSEnabled && !cfg.API.ACME.Enabled && (cfg.API.TLSCertPath == "" || cfg.API.TLSKeyPath == "") {
+		return errors.New("설정 검증 실패 (api): TLS를 사용하려면 인증서와 키 경로가 필요합니다")
+	}
+	if cfg.API.ACME.Enabled && (len(cfg.API.ACME.Domains) == 0 || cfg.API.ACME.CacheDir == "") {
+		return errors.New("설정 검증 실패 (api): ACME를 사용하려면 api.acme.domains와 api.acme.cache_dir이 필요합니다")
+	}
+	if cfg.API.ACME.Enabled && cfg.API.ACME.HTTPPort == cfg.Server.Port {
+		return errors.New("설정 검증 실패 (api): api.acme.http_port는 server.port와 달라야 합니다")
+	}
+	if cfg.Storage.Type != "memory" && cfg.Storage.DataSource == "" {
+		return fmt.Errorf("설정 검증 실패 (storage): %s 스토리지는 data_source가 필요합니다", cfg.Storage.Type)
+	}
+	if cfg.Search.Backend == "elasticsearch" && cfg.Search.Elasticsearch.URL == "" {
+		return errors.New("설정 검증 실패 (search): elasticsearch 백엔드는 search.elasticsearch.url이 필요합니다")
+	}
+	groups := make(map[string]bool, len(cfg.AttackDetection.RouteGroups))
+	for _, group := range cfg.AttackDetection.RouteGroups {
+		if groups["name:"+group.Name] || groups["prefix:"+group.PathPrefix] {
+			return fmt.Errorf("설정 검증 실패 (attack_detection): 라우트 그룹 이름이나 경로 접두사가 중복되었습니다: %s", group.Name)
+		}
+		groups["name:"+group.Name] = true
+		groups["prefix:"+group.PathPrefix] = true
+	}
+	return nil
+}
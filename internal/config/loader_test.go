@@ -0,0 +1,166 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeServerConfig(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func TestLoadServerConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	writeServerConfig(t, path, `
+server:
+  port: 9090
+limits:
+  task_workers: 8
+logging:
+  level: debug
+`)
+
+	t.Run("파일과 환경 변수", func(t *testing.T) {
+		t.Setenv(EnvServerEnv, "staging")
+
+		cfg, err := LoadServerConfig(path)
+		require.NoError(t, err)
+		assert.Equal(t, 9090, cfg.Server.Port)
+		assert.Equal(t, "staging", cfg.Server.Env)
+		assert.Equal(t, 8, cfg.Limits.TaskWorkers)
+		assert.Equal(t, "debug", cfg.Logging.Level)
+		// 파일에 없는 항목은 기본값
+		assert.Equal(t, DefaultAuthenticatedRateLimit, cfg.Limits.AuthenticatedRateLimit)
+	})
+
+	t.Run("환경 변수가 파일보다 우선", func(t *testing.T) {
+		t.Setenv(EnvServerPort, "7070")
+
+		cfg, err := LoadServerConfig(path)
+		require.NoError(t, err)
+		assert.Equal(t, 7070, cfg.Server.Port)
+	})
+
+	t.Run("파일 없음", func(t *testing.T) {
+		_, err := LoadServerConfig(filepath.Join(dir, "missing.yaml"))
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateServerConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+	}{
+		{"잘못된 포트", func(cfg *Config) { cfg.Server.Port = 70000 }},
+		{"잘못된 환경", func(cfg *Config) { cfg.Server.Env = "qa" }},
+		{"음수 유휴 연결 시간", func(cfg *Config) { cfg.Server.IdleTimeout = -time.Second }},
+		{"HTTP/2 프레임 크기 범위 미만", func(cfg *Config) { cfg.Server.HTTP2.MaxReadFrameSize = 1024 }},
+		{"워커 수 0", func(cfg *Config) { cfg.Limits.TaskWorkers = 0 }},
+		{"음수 사용자별 세션 한도", func(cfg *Config) { cfg.Limits.SessionsPerUser = -1 }},
+		{"production 기본 JWT 키", func(cfg *Config) { cfg.Server.Env = "production" }},
+		{"TLS 인증서 없음", func(cfg *Config) { cfg.API.TLSEnabled = true }},
+		{"스토리지 경로 없음", func(cfg *Config) {
+			cfg.Storage.Type = "sqlite"
+			cfg.Storage.DataSource = ""
+		}},
+		{"PostgreSQL 연결 문자열 없음", func(cfg *Config) { cfg.Storage.Type = "postgres" }},
+		{"지원하지 않는 스토리지", func(cfg *Config) {
+			cfg.Storage.Type = "mysql"
+			cfg.Storage.DataSource = "mysql://localhost/aicli"
+		}},
+		{"production 장애 주입", func(cfg *Config) {
+			cfg.Server.Env = "production"
+			cfg.API.JWTSecret = "production-secret"
+			cfg.Chaos.Enabled = true
+		}},
+		{"장애 주입 확률 범위 초과", func(cfg *Config) { cfg.Chaos.FrameDrop.Probability = 1.5 }},
+		{"Elasticsearch 주소 없음", func(cfg *Config) { cfg.Search.Backend = "elasticsearch" }},
+		{"지원하지 않는 검색 백엔드", func(cfg *Config) { cfg.Search.Backend = "solr" }},
+		{"음수 요약 메일 주기", func(cfg *Config) { cfg.Notifications.DigestInterval = -time.Minute }},
+		{"음수 사용량 집계 주기", func(cfg *Config) { cfg.Analytics.RollupInterval = -time.Minute }},
+		{"짧은 비밀 값 암호화 키", func(cfg *Config) { cfg.Secrets.Key = "short" }},
+		{"공격 탐지 신뢰도 범위 초과", func(cfg *Config) { cfg.AttackDetection.MinConfidence = 1.5 }},
+		{"공격 탐지 경로 접두사 형식", func(cfg *Config) {
+			cfg.AttackDetection.RouteGroups = []AttackRouteGroupConfig{{Name: "auth", PathPrefix: "api/v1/auth"}}
+		}},
+		{"공격 탐지 검사 대상 이름", func(cfg *Config) {
+			cfg.AttackDetection.Scan.PatternTargets = map[string][]string{"sqli_001": {"cookie"}}
+		}},
+		{"음수 본문 검사 예산", func(cfg *Config) { cfg.AttackDetection.Scan.MaxBodyBytes = -1 }},
+		{"공격 탐지 라우트 그룹 중복", func(cfg *Config) {
+			cfg.AttackDetection.RouteGroups = []AttackRouteGroupConfig{
+				{Name: "auth", PathPrefix: "/api/v1/auth"},
+				{Name: "login", PathPrefix: "/api/v1/auth"},
+			}
+		}},
+		{"CSP 위반 보고 보관 수 0", func(cfg *Config) { cfg.CSP.MaxReports = 0 }},
+		{"음수 웹 UI 캐시 시간", func(cfg *Config) { cfg.WebUI.AssetMaxAge = -time.Hour }},
+		{"ACME 도메인 없음", func(cfg *Config) { cfg.API.ACME.Enabled = true }},
+		{"ACME 도메인 형식", func(cfg *Config) {
+			cfg.API.ACME.Enabled = true
+			cfg.API.ACME.Domains = []string{"aicli example.com"}
+		}},
+		{"ACME 챌린지 포트 충돌", func(cfg *Config) {
+			cfg.API.ACME.Enabled = true
+			cfg.API.ACME.Domains = []string{"aicli.example.com"}
+			cfg.API.ACME.HTTPPort = cfg.Server.Port
+		}},
+	}
+
+	require.NoError(t, ValidateServerConfig(GetDefaultConfig()))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := GetDefaultConfig()
+			tt.modify(cfg)
+			assert.Error(t, ValidateServerConfig(cfg))
+		})
+	}
+}
+
+func TestResolveServerConfigPath(t *testing.T) {
+	t.Setenv(EnvConfigPath, "/tmp/from-env.yaml")
+	assert.Equal(t, "/tmp/from-flag.yaml", ResolveServerConfigPath("/tmp/from-flag.yaml"))
+	assert.Equal(t, "/tmp/from-env.yaml", ResolveServerConfigPath(""))
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	writeServerConfig(t, path, "limits:\n  task_workers: 2\n")
+
+	initial, err := LoadServerConfig(path)
+	require.NoError(t, err)
+
+	reloader := NewReloader(path, initial, nil)
+	reloader.debounce = 10 * time.Millisecond
+
+	var workers atomic.Int32
+	reloader.Subscribe(func(old, new *Config) {
+		assert.Equal(t, 2, old.Limits.TaskWorkers)
+		workers.Store(int32(new.Limits.TaskWorkers))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, reloader.Watch(ctx))
+
+	// 검증에 실패하는 설정은 무시하고 이전 설정 유지
+	writeServerConfig(t, path, "limits:\n  task_workers: 0\n")
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(0), workers.Load())
+	assert.Equal(t, 2, reloader.Current().Limits.TaskWorkers)
+
+	writeServerConfig(t, path, "limits:\n  task_workers: 6\n")
+	require.Eventually(t, func() bool { return workers.Load() == 6 }, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 6, reloader.Current().Limits.TaskWorkers)
+}
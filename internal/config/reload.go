@@ -0,0 +1,148 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultReloadDebounce 설정 파일 변경 이벤트를 모으는 시간 (편집기는 저장 한 번에 이벤트를 여러 개 보냄)
+const DefaultReloadDebounce = 200 * time.Millisecond
+
+// ReloadFunc 다시 읽은 설정이 검증을 통과하면 이전 설정과 함께 호출됩니다
+type ReloadFunc func(old, new *Config)
+
+// Reloader 설정 파일을 감시하다가 바뀌면 다시 읽어 구독자에게 알립니다
+// 새 설정이 검증에 실패하면 이전 설정을 유지하고 에러만 기록합니다.
+type Reloader struct {
+	path     string
+	debounce time.Duration
+	logger   *log.Logger
+
+	mu          sync.Mutex
+	current     *Config
+	subscribers []ReloadFunc
+}
+
+// NewReloader 새 설정 리로더 생성 (initial은 처음 읽은 설정)
+func NewReloader(path string, initial *Config, logger *log.Logger) *Reloader {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &Reloader{
+		path:     path,
+		debounce: DefaultReloadDebounce,
+		logger:   logger,
+		current:  initial,
+	}
+}
+
+// Current 현재 적용된 설정
+func (r *Reloader) Current() *Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// Subscribe 설정 변경 구독
+func (r *Reloader) Subscribe(fn ReloadFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscribers = append(r.subscribers, fn)
+}
+
+// Reload 설정 파일을 다시 읽고 검증에 통과하면 구독자에게 알립니다
+func (r *Reloader) Reload() error {
+	cfg, err := LoadServerConfig(r.path)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	old := r.current
+	r.current = cfg
+	subscribers := append([]ReloadFunc(nil), r.subscribers...)
+	r.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(old, cfg)
+	}
+	return nil
+}
+
+// Watch 컨텍스트가 끝날 때까지 설정 파일 변경을 감시합니다
+// 파일을 교체하는 방식의 저장(편집기, Kubernetes ConfigMap)도 감지하도록 디렉토리를 감시합니다.
+func (r *Reloader) Watch(ctx context.Context) error {
+	if r.path == "" {
+		return fmt.Errorf("설정 파일 경로가 없어 변경을 감시할 수 없습니다")
+	}
+	path, err := filepath.Abs(r.path)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		var timer *time.Timer
+		var fire <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !r.relevant(path, event) {
+					continue
+				}
+				if timer == nil {
+					timer = time.NewTimer(r.debounce)
+				} else {
+					timer.Reset(r.debounce)
+				}
+				fire = timer.C
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				r.logger.Printf("설정 파일 감시 에러: %v", err)
+			case <-fire:
+				fire = nil
+				if err := r.Reload(); err != nil {
+					r.logger.Printf("설정을 다시 읽지 못해 이전 설정을 유지합니다: %v", err)
+				} else {
+					r.logger.Printf("설정을 다시 읽었습니다: %s", path)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// relevant 설정 파일 자체나 ConfigMap 심볼릭 링크(..data) 교체 이벤트인지 확인
+func (r *Reloader) relevant(path string, event fsnotify.Event) bool {
+	if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+		return false
+	}
+	name := filepath.Clean(event.Name)
+	return name == path || filepath.Base(name) == "..data"
+}
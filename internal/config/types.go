@@ -4,6 +4,12 @@ import "time"
 
 // Config는 AICode Manager의 전체 설정을 나타냅니다
 type Config struct {
+	// API 서버 실행 설정
+	Server ServerConfig `yaml:"server" mapstructure:"server" json:"server"`
+	
+	// 요청 제한과 워커 수 (실행 중 변경 가능)
+	Limits LimitsConfig `yaml:"limits" mapstructure:"limits" json:"limits"`
+	
 	// Claude 관련 설정
 	Claude ClaudeConfig `yaml:"claude" mapstructure:"claude" json:"claude"`
 	
@@ -24,6 +30,202 @@ type Config struct {
 	
 	// 스토리지 관련 설정
 	Storage StorageConfig `yaml:"storage" mapstructure:"storage" json:"storage"`
+	
+	// 백업 관련 설정
+	Backup BackupConfig `yaml:"backup" mapstructure:"backup" json:"backup"`
+	
+	// 아티팩트 저장소 설정
+	Artifacts ArtifactConfig `yaml:"artifacts" mapstructure:"artifacts" json:"artifacts"`
+	
+	// 외부 이벤트 브로커 설정
+	Events EventsConfig `yaml:"events" mapstructure:"events" json:"events"`
+	
+	// 태스크 훅 플러그인 설정
+	Plugins PluginsConfig `yaml:"plugins" mapstructure:"plugins" json:"plugins"`
+	
+	// 워크스페이스별 MCP 서버 설정
+	MCP MCPConfig `yaml:"mcp" mapstructure:"mcp" json:"mcp"`
+	
+	// CLI 에이전트 프로바이더 설정
+	Agents AgentsConfig `yaml:"agents" mapstructure:"agents" json:"agents"`
+	
+	// 프로세스 자동 재시작 설정
+	Restart RestartConfig `yaml:"restart" mapstructure:"restart" json:"restart"`
+	
+	// 고아 프로세스 정리 설정
+	Reaper ReaperConfig `yaml:"reaper" mapstructure:"reaper" json:"reaper"`
+	
+	// 멈춘 프로세스 감지 설정
+	Hang HangConfig `yaml:"hang" mapstructure:"hang" json:"hang"`
+	
+	// 세션 컨텍스트 사용량 경고와 자동 압축 설정
+	ContextWindow ContextWindowConfig `yaml:"context_window" mapstructure:"context_window" json:"context_window"`
+	
+	// Claude 자격 증명별 요청 한도 관리 설정
+	Quota QuotaConfig `yaml:"quota" mapstructure:"quota" json:"quota"`
+	
+	// 워커 풀 포화 시 우선순위 선점 설정
+	Preemption PreemptionConfig `yaml:"preemption" mapstructure:"preemption" json:"preemption"`
+	
+	// 모든 세션의 시스템 프롬프트 앞에 붙일 조직 지정 가드레일 설정
+	Guardrails GuardrailsConfig `yaml:"guardrails" mapstructure:"guardrails" json:"guardrails"`
+	
+	// Claude 출력의 비밀 값과 개인 정보 마스킹 설정
+	Redaction RedactionConfig `yaml:"redaction" mapstructure:"redaction" json:"redaction"`
+	
+	// 에러 통계 저장 설정
+	ErrorStats ErrorStatsConfig `yaml:"error_stats" mapstructure:"error_stats" json:"error_stats"`
+	
+	// 에러 자동 복구 설정
+	Recovery RecoveryConfig `yaml:"recovery" mapstructure:"recovery" json:"recovery"`
+	
+	// 태스크 실행 설정
+	Tasks TasksConfig `yaml:"tasks" mapstructure:"tasks" json:"tasks"`
+	
+	// Kubernetes 태스크 실행 설정
+	Kubernetes KubernetesConfig `yaml:"kubernetes" mapstructure:"kubernetes" json:"kubernetes"`
+	
+	// 원격 에이전트 분산 실행 설정
+	Remote RemoteConfig `yaml:"remote" mapstructure:"remote" json:"remote"`
+	
+	// 다중 레플리카(수평 확장) 설정
+	Cluster ClusterConfig `yaml:"cluster" mapstructure:"cluster" json:"cluster"`
+	
+	// 유지보수 모드 설정
+	Maintenance MaintenanceConfig `yaml:"maintenance" mapstructure:"maintenance" json:"maintenance"`
+	
+	// 로컬 계정 관리 설정
+	Accounts AccountsConfig `yaml:"accounts" mapstructure:"accounts" json:"accounts"`
+	
+	// 메일 발송 설정
+	Email EmailConfig `yaml:"email" mapstructure:"email" json:"email"`
+	
+	// WebSocket(/ws) 설정
+	WebSocket WebSocketConfig `yaml:"websocket" mapstructure:"websocket" json:"websocket"`
+	
+	// 백그라운드 작업 실행 설정
+	Jobs JobsConfig `yaml:"jobs" mapstructure:"jobs" json:"jobs"`
+	
+	// 외부 의존성(Redis, 스토리지, Git 호스트, OAuth) 회로 차단기 설정
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker" mapstructure:"circuit_breaker" json:"circuit_breaker"`
+	
+	// 장애 주입 테스트 모드 설정 (production에서는 사용할 수 없음)
+	Chaos ChaosConfig `yaml:"chaos" mapstructure:"chaos" json:"chaos"`
+	
+	// 통합 검색 인덱스 설정
+	Search SearchConfig `yaml:"search" mapstructure:"search" json:"search"`
+	
+	// 알림 센터 설정
+	Notifications NotificationsConfig `yaml:"notifications" mapstructure:"notifications" json:"notifications"`
+	
+	// 사용량 분석 설정
+	Analytics AnalyticsConfig `yaml:"analytics" mapstructure:"analytics" json:"analytics"`
+	
+	// 대화 기록 내보내기 설정
+	Exports ExportsConfig `yaml:"exports" mapstructure:"exports" json:"exports"`
+	
+	// 비밀 값 암호화 설정
+	Secrets SecretsConfig `yaml:"secrets" mapstructure:"secrets" json:"secrets"`	
+	// 요청 공격 탐지 임계값 설정 (실행 중 변경 가능)
+	AttackDetection AttackDetectionConfig `yaml:"attack_detection" mapstructure:"attack_detection" json:"attack_detection"`
+	// Content-Security-Policy 헤더와 위반 보고 수집 설정
+	CSP CSPConfig `yaml:"csp" mapstructure:"csp" json:"csp"`
+	
+	// 웹 UI 제공 설정
+	WebUI WebUIConfig `yaml:"web_ui" mapstructure:"web_ui" json:"web_ui"`
+	
+	// 워크스페이스 터미널 설정
+	Terminal TerminalConfig `yaml:"terminal" mapstructure:"terminal" json:"terminal"`
+}
+
+// TerminalConfig는 워크스페이스 대화형 터미널 설정을 정의합니다
+type TerminalConfig struct {
+	// 실행 중인 컨테이너가 없을 때 API 서버 호스트의 워크스페이스 디렉토리에서 셸을 여는 것을 허용
+	// 서버 호스트 셸을 내주는 것이므로 신뢰할 수 있는 단일 사용자 환경에서만 켜세요 (기본 꺼짐)
+	AllowHost bool `yaml:"allow_host" mapstructure:"allow_host" json:"allow_host"`
+}
+
+// ServerConfig는 API 서버 프로세스 실행 설정을 정의합니다
+type ServerConfig struct {
+	// Env 실행 환경 (production이면 기본 JWT 비밀 키를 허용하지 않음)
+	Env string `yaml:"env" mapstructure:"env" json:"env" validate:"oneof=development staging production test"`
+	
+	// Port 리스닝 포트 (모든 인터페이스)
+	Port int `yaml:"port" mapstructure:"port" json:"port" validate:"min=1,max=65535"`
+	
+	// ShutdownTimeout 종료 신호 후 처리 중인 요청을 기다리는 최대 시간
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" mapstructure:"shutdown_timeout" json:"shutdown_timeout" validate:"min=0"`
+	
+	// HotReload 설정 파일이 바뀌면 다시 읽어 로그 레벨, 요청 제한, 워커 수를 즉시 적용
+	HotReload bool `yaml:"hot_reload" mapstructure:"hot_reload" json:"hot_reload"`
+	
+	// ReadTimeout 요청 헤더와 본문을 모두 읽는 최대 시간 (0이면 무제한)
+	ReadTimeout time.Duration `yaml:"read_timeout" mapstructure:"read_timeout" json:"read_timeout" validate:"min=0"`
+	
+	// ReadHeaderTimeout 요청 헤더를 읽는 최대 시간 (0이면 read_timeout)
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" mapstructure:"read_header_timeout" json:"read_header_timeout" validate:"min=0"`
+	
+	// WriteTimeout 응답을 쓰는 최대 시간 (0이면 무제한, 스트리밍 응답도 이 시간에 끊김)
+	WriteTimeout time.Duration `yaml:"write_timeout" mapstructure:"write_timeout" json:"write_timeout" validate:"min=0"`
+	
+	// IdleTimeout keep-alive 연결이 다음 요청을 기다리는 최대 시간 (0이면 read_timeout)
+	IdleTimeout time.Duration `yaml:"idle_timeout" mapstructure:"idle_timeout" json:"idle_timeout" validate:"min=0"`
+	
+	// MaxHeaderBytes 요청 헤더 최대 크기 (0이면 1MB)
+	MaxHeaderBytes int `yaml:"max_header_bytes" mapstructure:"max_header_bytes" json:"max_header_bytes" validate:"min=0"`
+	
+	// KeepAlive HTTP/1.1 keep-alive 연결 재사용 (false면 응답마다 연결을 닫음)
+	KeepAlive bool `yaml:"keep_alive" mapstructure:"keep_alive" json:"keep_alive"`
+	
+	// HTTP2 HTTP/2 설정
+	HTTP2 HTTP2Config `yaml:"http2" mapstructure:"http2" json:"http2"`
+}
+
+// HTTP2Config는 API 서버의 HTTP/2 설정을 정의합니다
+// TLS를 사용하면 ALPN으로 협상하고, h2c이면 TLS 없이도 HTTP/2 요청을 받습니다.
+type HTTP2Config struct {
+	// Enabled HTTP/2 사용 (false면 HTTP/1.1만 제공)
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// H2C TLS 없이 HTTP/2 요청 받기 (TLS를 종료하는 프록시 뒤에서 사용)
+	H2C bool `yaml:"h2c" mapstructure:"h2c" json:"h2c"`
+	
+	// MaxConcurrentStreams 연결당 동시 스트림 수 (0이면 250)
+	MaxConcurrentStreams uint32 `yaml:"max_concurrent_streams" mapstructure:"max_concurrent_streams" json:"max_concurrent_streams"`
+	
+	// MaxReadFrameSize 받을 최대 프레임 크기 (0이면 1MB)
+	MaxReadFrameSize uint32 `yaml:"max_read_frame_size" mapstructure:"max_read_frame_size" json:"max_read_frame_size" validate:"omitempty,min=16384,max=16777215"`
+	
+	// ReadIdleTimeout 이 시간 동안 받은 프레임이 없으면 PING으로 연결 확인 (0이면 확인 안 함)
+	ReadIdleTimeout time.Duration `yaml:"read_idle_timeout" mapstructure:"read_idle_timeout" json:"read_idle_timeout" validate:"min=0"`
+	
+	// PingTimeout PING 응답을 기다리는 시간 (넘기면 연결을 닫음, 0이면 15초)
+	PingTimeout time.Duration `yaml:"ping_timeout" mapstructure:"ping_timeout" json:"ping_timeout" validate:"min=0"`
+}
+
+// LimitsConfig는 실행 중 다시 읽어 적용할 수 있는 요청 제한과 워커 수를 정의합니다
+// 미인증 요청의 분당 허용 수는 api.rate_limit을 사용합니다.
+type LimitsConfig struct {
+	// RateLimitBurst 미인증 요청의 순간 허용량
+	RateLimitBurst int `yaml:"rate_limit_burst" mapstructure:"rate_limit_burst" json:"rate_limit_burst" validate:"min=1,max=10000"`
+	
+	// AuthenticatedRateLimit 인증된 요청의 분당 허용 수
+	AuthenticatedRateLimit int `yaml:"authenticated_rate_limit" mapstructure:"authenticated_rate_limit" json:"authenticated_rate_limit" validate:"min=1,max=100000"`
+	
+	// AuthenticatedBurst 인증된 요청의 순간 허용량
+	AuthenticatedBurst int `yaml:"authenticated_burst" mapstructure:"authenticated_burst" json:"authenticated_burst" validate:"min=1,max=10000"`
+	
+	// TaskWorkers 태스크를 동시에 실행하는 워커 수
+	TaskWorkers int `yaml:"task_workers" mapstructure:"task_workers" json:"task_workers" validate:"min=1,max=256"`
+	
+	// TaskQueueSize 대기 중인 태스크 최대 수 (재시작해야 적용)
+	TaskQueueSize int `yaml:"task_queue_size" mapstructure:"task_queue_size" json:"task_queue_size" validate:"min=1,max=100000"`
+	
+	// SessionsPerUser 사용자별 동시에 실행할 수 있는 Claude 세션 수 (0이면 무제한)
+	SessionsPerUser int `yaml:"sessions_per_user" mapstructure:"sessions_per_user" json:"sessions_per_user" validate:"min=0"`
+	
+	// SessionsPerOrg 서버 전체에서 동시에 실행할 수 있는 Claude 세션 수 (0이면 무제한)
+	SessionsPerOrg int `yaml:"sessions_per_org" mapstructure:"sessions_per_org" json:"sessions_per_org" validate:"min=0"`
 }
 
 // ClaudeConfig는 Claude CLI 관련 설정을 정의합니다
@@ -64,11 +266,75 @@ type WorkspaceConfig struct {
 	// 워크스페이스 격리 모드
 	IsolationMode string `yaml:"isolation_mode" mapstructure:"isolation_mode" json:"isolation_mode" validate:"oneof=docker process none"`
 	
-	// 파일 감시 활성화
+	// 파일 감시 활성화 (워크스페이스 파일 변경 시 자동 태스크 실행)
 	WatchFiles bool `yaml:"watch_files" mapstructure:"watch_files" json:"watch_files"`
 	
-	// 제외 패턴 (glob)
+	// 제외 패턴 (glob, 파일 감시에서 모든 워크스페이스에 적용)
 	ExcludePatterns []string `yaml:"exclude_patterns" mapstructure:"exclude_patterns" json:"exclude_patterns"`
+	
+	// 파일 감시 설정 (watch_files가 켜져 있을 때)
+	Watch WorkspaceWatchConfig `yaml:"watch" mapstructure:"watch" json:"watch"`
+	
+	// 워크스페이스 파일 시스템 스냅샷 설정
+	Snapshots WorkspaceSnapshotConfig `yaml:"snapshots" mapstructure:"snapshots" json:"snapshots"`
+	
+	// 워크스페이스 디스크 한도 설정
+	DiskQuota WorkspaceDiskQuotaConfig `yaml:"disk_quota" mapstructure:"disk_quota" json:"disk_quota"`
+	
+	// 워크스페이스 아카이브 가져오기 시 프로젝트 파일을 풀 수 있는 루트 디렉토리
+	// 비어 있으면 target_path를 지정한 가져오기(파일 복원)는 거부됩니다.
+	ImportRoot string `yaml:"import_root" mapstructure:"import_root" json:"import_root"`
+}
+
+// WorkspaceWatchConfig는 워크스페이스 파일 변경 시 자동으로 태스크나 파이프라인을 실행하는 감시 설정을 정의합니다
+// 감시할 패턴과 실행할 작업은 워크스페이스마다 API로 지정합니다.
+type WorkspaceWatchConfig struct {
+	// 워크스페이스별 감시 설정 저장 디렉토리
+	Dir string `yaml:"dir" mapstructure:"dir" json:"dir"`
+	
+	// 워크스페이스 하나에서 감시할 최대 디렉토리 수 (inotify 감시 한도 보호, 0이면 4096)
+	MaxDirectories int `yaml:"max_directories" mapstructure:"max_directories" json:"max_directories" validate:"min=0"`
+}
+
+// WorkspaceDiskQuotaConfig는 워크스페이스 디렉토리 디스크 사용량 추적과 한도를 정의합니다
+// 사용률이 경고 비율을 넘으면 소유자에게 알리고, 한도에 도달하면 새 태스크를 거부합니다.
+type WorkspaceDiskQuotaConfig struct {
+	// 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// 워크스페이스별 기본 한도 (MB, 0이면 무제한)
+	DefaultLimitMB int64 `yaml:"default_limit_mb" mapstructure:"default_limit_mb" json:"default_limit_mb" validate:"min=0"`
+	
+	// 워크스페이스 ID별 한도 (MB, 기본 한도를 대체, 0이면 무제한)
+	Workspaces map[string]int64 `yaml:"workspaces" mapstructure:"workspaces" json:"workspaces"`
+	
+	// 경고를 보낼 사용률 (0~1)
+	WarnRatio float64 `yaml:"warn_ratio" mapstructure:"warn_ratio" json:"warn_ratio" validate:"min=0,max=1"`
+	
+	// 측정한 사용량을 다시 쓰는 시간
+	CacheTTL time.Duration `yaml:"cache_ttl" mapstructure:"cache_ttl" json:"cache_ttl"`
+	
+	// 모든 워크스페이스 사용량을 다시 측정하는 주기 (0이면 태스크 요청 때만 측정)
+	ScanInterval time.Duration `yaml:"scan_interval" mapstructure:"scan_interval" json:"scan_interval"`
+}
+
+// WorkspaceSnapshotConfig는 Git과 무관한 워크스페이스 디렉토리 스냅샷과 복원을 정의합니다
+// 바뀌지 않은 파일은 이전 스냅샷과 하드 링크로 공유하고, 지원하는 파일 시스템에서는 reflink로 복사합니다.
+type WorkspaceSnapshotConfig struct {
+	// 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// 스냅샷 저장 디렉토리 (워크스페이스와 같은 파일 시스템이어야 reflink를 사용할 수 있음)
+	Dir string `yaml:"dir" mapstructure:"dir" json:"dir"`
+	
+	// 전체 스냅샷 디스크 사용량 상한 (MB, 0이면 무제한, 넘으면 오래된 스냅샷부터 삭제)
+	MaxTotalSizeMB int64 `yaml:"max_total_size_mb" mapstructure:"max_total_size_mb" json:"max_total_size_mb" validate:"min=0"`
+	
+	// 워크스페이스별 최대 스냅샷 수 (0이면 무제한)
+	MaxPerWorkspace int `yaml:"max_per_workspace" mapstructure:"max_per_workspace" json:"max_per_workspace" validate:"min=0"`
+	
+	// 스냅샷 보관 기간 (0이면 무제한)
+	MaxAge time.Duration `yaml:"max_age" mapstructure:"max_age" json:"max_age"`
 }
 
 // OutputConfig는 출력 형식 관련 설정을 정의합니다
@@ -97,6 +363,9 @@ type LoggingConfig struct {
 	// 로그 레벨
 	Level string `yaml:"level" mapstructure:"level" json:"level" validate:"oneof=debug info warn error fatal"`
 	
+	// 모듈별 로그 레벨 (claude, api, security, storage; 지정하지 않은 모듈은 level을 따름)
+	Modules map[string]string `yaml:"modules" mapstructure:"modules" json:"modules" validate:"omitempty,dive,keys,oneof=claude api security storage,endkeys,oneof=trace debug info warn error fatal"`
+	
 	// 로그 파일 경로
 	FilePath string `yaml:"file_path" mapstructure:"file_path" json:"file_path"`
 	
@@ -138,6 +407,91 @@ type DockerConfig struct {
 	
 	// 컨테이너 접두사
 	ContainerPrefix string `yaml:"container_prefix" mapstructure:"container_prefix" json:"container_prefix" validate:"required"`
+	
+	// 의존성 캐시
+	Cache DependencyCacheConfig `yaml:"cache" mapstructure:"cache" json:"cache"`
+	
+	// 웜 컨테이너 풀
+	WarmPool WarmPoolConfig `yaml:"warm_pool" mapstructure:"warm_pool" json:"warm_pool"`
+	
+	// 워크스페이스별 이미지 정책
+	ImagePolicy ImagePolicyConfig `yaml:"image_policy" mapstructure:"image_policy" json:"image_policy"`
+	
+	// 워크스페이스별 네트워크 이그레스 정책
+	NetworkPolicy NetworkPolicyConfig `yaml:"network_policy" mapstructure:"network_policy" json:"network_policy"`
+}
+
+// NetworkPolicyConfig는 워크스페이스 컨테이너의 외부 네트워크 접근 정책 설정을 정의합니다
+type NetworkPolicyConfig struct {
+	// 정책을 지정하지 않은 워크스페이스의 모드 (full, offline, allowlist)
+	DefaultMode string `yaml:"default_mode" mapstructure:"default_mode" json:"default_mode" validate:"omitempty,oneof=full offline allowlist"`
+	
+	// 기본 모드가 allowlist일 때 허용할 도메인
+	DefaultAllowedDomains []string `yaml:"default_allowed_domains" mapstructure:"default_allowed_domains" json:"default_allowed_domains"`
+	
+	// allowlist 모드에서 사이드카로 실행할 이그레스 프록시 이미지 (cmd/egress-proxy)
+	ProxyImage string `yaml:"proxy_image" mapstructure:"proxy_image" json:"proxy_image"`
+	
+	// 워크스페이스별 정책 저장 디렉토리
+	Dir string `yaml:"dir" mapstructure:"dir" json:"dir"`
+}
+
+// ImagePolicyConfig는 워크스페이스가 지정할 수 있는 컨테이너 이미지와 Dockerfile 빌드 정책을 정의합니다
+type ImagePolicyConfig struct {
+	// 허용된 이미지 패턴 (예: "aicli/workspace:*", "node:20*")
+	AllowedImages []string `yaml:"allowed_images" mapstructure:"allowed_images" json:"allowed_images"`
+	
+	// 워크스페이스 Dockerfile 빌드 허용 여부 (기본 이미지도 허용 목록에 있어야 함)
+	AllowDockerfile bool `yaml:"allow_dockerfile" mapstructure:"allow_dockerfile" json:"allow_dockerfile"`
+	
+	// 특권 컨테이너 허용 여부
+	AllowPrivileged bool `yaml:"allow_privileged" mapstructure:"allow_privileged" json:"allow_privileged"`
+	
+	// 워크스페이스 이미지 설정 저장 디렉토리
+	Dir string `yaml:"dir" mapstructure:"dir" json:"dir"`
+	
+	// 빌드 제한 시간
+	BuildTimeout time.Duration `yaml:"build_timeout" mapstructure:"build_timeout" json:"build_timeout"`
+}
+
+// WarmPoolConfig는 새 워크스페이스 시작을 빠르게 하기 위해 미리 만들어 둘 컨테이너 풀 설정을 정의합니다
+type WarmPoolConfig struct {
+	// 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// 유지할 웜 컨테이너 수
+	Size int `yaml:"size" mapstructure:"size" json:"size" validate:"min=0,max=50"`
+	
+	// 웜 컨테이너 이미지 (비어 있으면 기본 이미지)
+	Image string `yaml:"image" mapstructure:"image" json:"image"`
+	
+	// 웜 컨테이너에 마운트할 워크스페이스 루트 (이 아래에 있는 프로젝트만 웜 스타트)
+	// 웜 컨테이너는 루트 전체를 볼 수 있으므로 신뢰할 수 있는 단일 사용자 환경에서만 사용하세요
+	WorkspaceRoot string `yaml:"workspace_root" mapstructure:"workspace_root" json:"workspace_root"`
+	
+	// 상태 점검 주기
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" mapstructure:"health_check_interval" json:"health_check_interval"`
+	
+	// 이 시간 이상 할당되지 않은 컨테이너는 새로 만듦 (0이면 무제한)
+	MaxIdle time.Duration `yaml:"max_idle" mapstructure:"max_idle" json:"max_idle"`
+}
+
+// DependencyCacheConfig는 컨테이너 재생성 시에도 유지할 의존성 캐시 설정을 정의합니다
+type DependencyCacheConfig struct {
+	// 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// 저장 방식 (volume: Docker named volume, host: 호스트 디렉토리)
+	Backend string `yaml:"backend" mapstructure:"backend" json:"backend" validate:"omitempty,oneof=volume host"`
+	
+	// host 방식의 캐시 루트 디렉토리
+	HostDir string `yaml:"host_dir" mapstructure:"host_dir" json:"host_dir"`
+	
+	// 전체 캐시 크기 상한 (MB, 0이면 무제한)
+	MaxTotalSizeMB int64 `yaml:"max_total_size_mb" mapstructure:"max_total_size_mb" json:"max_total_size_mb" validate:"min=0"`
+	
+	// 워크스페이스별 캐시 크기 상한 (MB, 0이면 무제한)
+	MaxWorkspaceSizeMB int64 `yaml:"max_workspace_size_mb" mapstructure:"max_workspace_size_mb" json:"max_workspace_size_mb" validate:"min=0"`
 }
 
 // APIConfig는 API 서버 관련 설정을 정의합니다
@@ -154,10 +508,16 @@ type APIConfig struct {
 	// TLS 키 경로
 	TLSKeyPath string `yaml:"tls_key_path" mapstructure:"tls_key_path" json:"tls_key_path"`
 	
+	// OCSP 스테이플링 (인증서에 OCSP 서버가 없으면 보내지 않음)
+	OCSPStapling bool `yaml:"ocsp_stapling" mapstructure:"ocsp_stapling" json:"ocsp_stapling"`
+	
+	// ACME(Let's Encrypt) 인증서 자동 발급
+	ACME ACMEConfig `yaml:"acme" mapstructure:"acme" json:"acme"`
+	
 	// CORS 허용 오리진
 	CORSOrigins []string `yaml:"cors_origins" mapstructure:"cors_origins" json:"cors_origins"`
 	
-	// 요청 제한 (분당)
+	// 요청 제한 (미인증 요청의 분당 허용 수, 0이면 요청 제한 비활성화)
 	RateLimit int `yaml:"rate_limit" mapstructure:"rate_limit" json:"rate_limit" validate:"min=0,max=10000"`
 	
 	// JWT 비밀 키
@@ -166,6 +526,9 @@ type APIConfig struct {
 	// JWT 만료 시간 (시간)
 	JWTExpiration time.Duration `yaml:"jwt_expiration" mapstructure:"jwt_expiration" json:"jwt_expiration"`
 	
+	// MetricsToken /metrics 수집용 Bearer 토큰 (비어 있으면 관리자 JWT로만 조회 가능)
+	MetricsToken string `yaml:"metrics_token" mapstructure:"metrics_token" json:"metrics_token"`
+	
 	// 액세스 토큰 만료 시간
 	AccessTokenExpiry time.Duration `yaml:"access_token_expiry" mapstructure:"access_token_expiry" json:"access_token_expiry"`
 	
@@ -174,12 +537,29 @@ type APIConfig struct {
 	
 	// OAuth 설정
 	OAuth OAuthConfig `yaml:"oauth" mapstructure:"oauth" json:"oauth"`
+	
+	// 요청 처리 시간 예산
+	RequestTimeout RequestTimeoutConfig `yaml:"request_timeout" mapstructure:"request_timeout" json:"request_timeout"`
+}
+
+// RequestTimeoutConfig는 /api/v1 요청의 처리 시간 예산을 정의합니다
+// 예산이 지나면 요청 context가 취소되어 스토리지와 Claude 호출이 중단되고 504 응답을 반환합니다.
+type RequestTimeoutConfig struct {
+	// Default 경로별 예산이 없는 요청의 기본 예산 (0이면 기한 없음)
+	Default time.Duration `yaml:"default" mapstructure:"default" json:"default" validate:"min=0"`
+	
+	// MaxClient X-Request-Timeout 헤더로 요청할 수 있는 최대 예산 (0이면 헤더 무시)
+	MaxClient time.Duration `yaml:"max_client" mapstructure:"max_client" json:"max_client" validate:"min=0"`
+	
+	// Routes 경로별 예산 ("[메서드 ]경로 템플릿", 대소문자 구분 없음, 끝의 *는 접두사 일치, 0이면 기한 없음)
+	// 예: "get /api/v1/tasks/:id/events": 90s, "/api/v1/artifacts/download/*": 0
+	Routes map[string]time.Duration `yaml:"routes" mapstructure:"routes" json:"routes" validate:"dive,min=0"`
 }
 
 // StorageConfig는 스토리지 관련 설정을 정의합니다
 type StorageConfig struct {
-	// Type 스토리지 타입 (memory, sqlite, boltdb)
-	Type string `yaml:"type" mapstructure:"type" json:"type" validate:"oneof=memory sqlite boltdb"`
+	// Type 스토리지 타입 (memory, sqlite, boltdb, postgres)
+	Type string `yaml:"type" mapstructure:"type" json:"type" validate:"oneof=memory sqlite boltdb postgres"`
 	
 	// DataSource 데이터 소스 (파일 경로 또는 연결 문자열)
 	DataSource string `yaml:"data_source" mapstructure:"data_source" json:"data_source"`
@@ -201,6 +581,94 @@ type StorageConfig struct {
 	
 	// RetryInterval 재시도 간격
 	RetryInterval time.Duration `yaml:"retry_interval" mapstructure:"retry_interval" json:"retry_interval"`
+	
+	// Cache 자주 읽는 조회(계정, 워크스페이스, 역할/권한)의 읽기 캐시 설정
+	Cache StorageCacheConfig `yaml:"cache" mapstructure:"cache" json:"cache"`
+}
+
+// StorageCacheConfig는 스토리지 읽기 캐시 설정을 정의합니다
+// 캐시를 거친 쓰기와 RBAC 이벤트는 즉시 무효화하며, 다른 인스턴스의 변경은 TTL이 지나야 반영됩니다.
+type StorageCacheConfig struct {
+	// Enabled 읽기 캐시 사용 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// AccountTTL 계정 조회 캐시 유지 시간 (0이면 캐시하지 않음)
+	AccountTTL time.Duration `yaml:"account_ttl" mapstructure:"account_ttl" json:"account_ttl"`
+	
+	// WorkspaceTTL 워크스페이스 조회 캐시 유지 시간 (0이면 캐시하지 않음)
+	WorkspaceTTL time.Duration `yaml:"workspace_ttl" mapstructure:"workspace_ttl" json:"workspace_ttl"`
+	
+	// RBACTTL 역할/권한 조회 캐시 유지 시간 (0이면 캐시하지 않음)
+	RBACTTL time.Duration `yaml:"rbac_ttl" mapstructure:"rbac_ttl" json:"rbac_ttl"`
+	
+	// MaxEntries 컬렉션별 최대 항목 수 (0이면 제한 없음)
+	MaxEntries int `yaml:"max_entries" mapstructure:"max_entries" json:"max_entries" validate:"min=0"`
+}
+
+// BackupConfig는 백업 관련 설정을 정의합니다
+type BackupConfig struct {
+	// Enabled 주기적 백업 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Interval 백업 주기
+	Interval time.Duration `yaml:"interval" mapstructure:"interval" json:"interval"`
+	
+	// Retention 보관할 백업 수 (0이면 모두 보관)
+	Retention int `yaml:"retention" mapstructure:"retention" json:"retention" validate:"min=0"`
+	
+	// Destination 백업 저장 위치 (local, s3)
+	Destination string `yaml:"destination" mapstructure:"destination" json:"destination" validate:"oneof=local s3"`
+	
+	// LocalDir 로컬 백업 디렉토리
+	LocalDir string `yaml:"local_dir" mapstructure:"local_dir" json:"local_dir"`
+	
+	// S3 설정
+	S3 S3Config `yaml:"s3" mapstructure:"s3" json:"s3"`
+}
+
+// ArtifactConfig는 아티팩트 저장소 관련 설정을 정의합니다
+type ArtifactConfig struct {
+	// Driver 저장소 드라이버 (local, s3)
+	Driver string `yaml:"driver" mapstructure:"driver" json:"driver" validate:"oneof=local s3"`
+	
+	// LocalDir 로컬 저장 디렉토리
+	LocalDir string `yaml:"local_dir" mapstructure:"local_dir" json:"local_dir"`
+	
+	// PublicURL 로컬 드라이버의 다운로드 URL 기준 주소 (예: https://aicli.example.com)
+	PublicURL string `yaml:"public_url" mapstructure:"public_url" json:"public_url"`
+	
+	// PresignExpiry 미리 서명된 다운로드 URL 유효 기간
+	PresignExpiry time.Duration `yaml:"presign_expiry" mapstructure:"presign_expiry" json:"presign_expiry"`
+	
+	// MaxUploadSize 업로드 최대 크기 (바이트)
+	MaxUploadSize int64 `yaml:"max_upload_size" mapstructure:"max_upload_size" json:"max_upload_size" validate:"min=0"`
+	
+	// S3 설정
+	S3 S3Config `yaml:"s3" mapstructure:"s3" json:"s3"`
+}
+
+// S3Config는 S3 호환 저장소 설정을 정의합니다
+type S3Config struct {
+	// Bucket 버킷 이름
+	Bucket string `yaml:"bucket" mapstructure:"bucket" json:"bucket"`
+	
+	// Prefix 객체 키 접두사
+	Prefix string `yaml:"prefix" mapstructure:"prefix" json:"prefix"`
+	
+	// Region 리전
+	Region string `yaml:"region" mapstructure:"region" json:"region"`
+	
+	// Endpoint S3 호환 엔드포인트 (비어 있으면 AWS 기본 엔드포인트)
+	Endpoint string `yaml:"endpoint" mapstructure:"endpoint" json:"endpoint"`
+	
+	// AccessKeyID 액세스 키 ID
+	AccessKeyID string `yaml:"access_key_id" mapstructure:"access_key_id" json:"access_key_id"`
+	
+	// SecretAccessKey 시크릿 액세스 키
+	SecretAccessKey string `yaml:"secret_access_key" mapstructure:"secret_access_key" json:"-"`
+	
+	// PathStyle 경로 방식 요청 사용 (MinIO 등)
+	PathStyle bool `yaml:"path_style" mapstructure:"path_style" json:"path_style"`
 }
 
 // OAuthConfig는 OAuth 인증 관련 설정을 정의합니다
@@ -247,4 +715,1083 @@ type MicrosoftOAuthConfig struct {
 	ClientSecret string   `yaml:"client_secret" mapstructure:"client_secret" json:"client_secret"`
 	TenantID     string   `yaml:"tenant_id" mapstructure:"tenant_id" json:"tenant_id"`
 	Scopes       []string `yaml:"scopes" mapstructure:"scopes" json:"scopes"`
-}
\ No newline at end of file
+}
+
+// EventsConfig는 이벤트 버스를 외부 브로커로 내보내는 설정을 정의합니다
+type EventsConfig struct {
+	// Broker 외부 브로커 (none, nats, kafka)
+	Broker string `yaml:"broker" mapstructure:"broker" json:"broker" validate:"oneof=none nats kafka"`
+	
+	// Serialization 메시지 직렬화 형식 (json, protobuf)
+	Serialization string `yaml:"serialization" mapstructure:"serialization" json:"serialization" validate:"oneof=json protobuf"`
+	
+	// Topics 토픽(NATS subject) 설정
+	Topics EventTopicsConfig `yaml:"topics" mapstructure:"topics" json:"topics"`
+	
+	// BufferSize 발행 대기 큐 크기
+	BufferSize int `yaml:"buffer_size" mapstructure:"buffer_size" json:"buffer_size" validate:"min=0"`
+	
+	// MaxRetries 발행 실패 시 재시도 횟수
+	MaxRetries int `yaml:"max_retries" mapstructure:"max_retries" json:"max_retries" validate:"min=0,max=10"`
+	
+	// NATS JetStream 설정
+	NATS NATSConfig `yaml:"nats" mapstructure:"nats" json:"nats"`
+	
+	// Kafka 설정
+	Kafka KafkaConfig `yaml:"kafka" mapstructure:"kafka" json:"kafka"`
+}
+
+// EventTopicsConfig는 이벤트 타입별 토픽 설정을 정의합니다
+type EventTopicsConfig struct {
+	// Default 모든 이벤트를 보낼 단일 토픽 (비어 있으면 Prefix 사용)
+	Default string `yaml:"default" mapstructure:"default" json:"default"`
+	
+	// Prefix "<prefix>.<이벤트 타입>" 형태의 토픽 접두사
+	Prefix string `yaml:"prefix" mapstructure:"prefix" json:"prefix"`
+	
+	// Overrides 이벤트 타입별 토픽 (예: session.error: aicli.alerts)
+	Overrides map[string]string `yaml:"overrides" mapstructure:"overrides" json:"overrides"`
+}
+
+// NATSConfig는 NATS JetStream 연결 설정을 정의합니다
+type NATSConfig struct {
+	// URL 서버 주소 (예: nats://localhost:4222)
+	URL string `yaml:"url" mapstructure:"url" json:"url"`
+	
+	// Token 인증 토큰
+	Token string `yaml:"token" mapstructure:"token" json:"-"`
+	
+	// Username 사용자 이름
+	Username string `yaml:"username" mapstructure:"username" json:"username"`
+	
+	// Password 비밀번호
+	Password string `yaml:"password" mapstructure:"password" json:"-"`
+	
+	// Stream 생성할 JetStream 스트림 이름 (비어 있으면 기존 스트림 사용)
+	Stream string `yaml:"stream" mapstructure:"stream" json:"stream"`
+	
+	// Subjects 스트림이 수신할 subject 목록
+	Subjects []string `yaml:"subjects" mapstructure:"subjects" json:"subjects"`
+	
+	// MaxAge 스트림 메시지 보관 기간
+	MaxAge time.Duration `yaml:"max_age" mapstructure:"max_age" json:"max_age"`
+}
+
+// KafkaConfig는 Kafka 프로듀서 설정을 정의합니다
+type KafkaConfig struct {
+	// Brokers 브로커 주소 목록
+	Brokers []string `yaml:"brokers" mapstructure:"brokers" json:"brokers"`
+	
+	// ClientID 클라이언트 ID
+	ClientID string `yaml:"client_id" mapstructure:"client_id" json:"client_id"`
+	
+	// RequiredAcks 확인 수준 (none, one, all)
+	RequiredAcks string `yaml:"required_acks" mapstructure:"required_acks" json:"required_acks" validate:"omitempty,oneof=none one all"`
+	
+	// Compression 압축 방식 (none, gzip, snappy, lz4, zstd)
+	Compression string `yaml:"compression" mapstructure:"compression" json:"compression"`
+	
+	// AllowAutoTopicCreation 존재하지 않는 토픽 자동 생성
+	AllowAutoTopicCreation bool `yaml:"allow_auto_topic_creation" mapstructure:"allow_auto_topic_creation" json:"allow_auto_topic_creation"`
+}
+
+// PluginsConfig는 태스크 전후 훅 플러그인 설정을 정의합니다
+type PluginsConfig struct {
+	// StatePath 워크스페이스별 활성화 상태 저장 파일
+	StatePath string `yaml:"state_path" mapstructure:"state_path" json:"state_path"`
+	
+	// DefaultTimeout 플러그인별 제한 시간이 없을 때 사용할 기본값
+	DefaultTimeout time.Duration `yaml:"default_timeout" mapstructure:"default_timeout" json:"default_timeout"`
+	
+	// Hooks 등록할 플러그인 목록
+	Hooks []PluginConfig `yaml:"hooks" mapstructure:"hooks" json:"hooks"`
+}
+
+// PluginConfig는 하위 프로세스 플러그인 하나의 설정을 정의합니다
+type PluginConfig struct {
+	// Name 플러그인 이름
+	Name string `yaml:"name" mapstructure:"name" json:"name" validate:"required"`
+	
+	// Command 실행 파일 경로
+	Command string `yaml:"command" mapstructure:"command" json:"command" validate:"required"`
+	
+	// Args 실행 인자
+	Args []string `yaml:"args" mapstructure:"args" json:"args"`
+	
+	// Env 플러그인에 전달할 환경 변수 (서버 환경 변수는 상속되지 않음)
+	Env map[string]string `yaml:"env" mapstructure:"env" json:"-"`
+	
+	// Hooks 처리할 훅 (pre_task, post_task; 비어 있으면 모두)
+	Hooks []string `yaml:"hooks" mapstructure:"hooks" json:"hooks"`
+	
+	// Timeout 훅 실행 제한 시간
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout" json:"timeout"`
+	
+	// FailurePolicy 플러그인 실패 시 처리 (ignore, fail)
+	FailurePolicy string `yaml:"failure_policy" mapstructure:"failure_policy" json:"failure_policy" validate:"omitempty,oneof=ignore fail"`
+	
+	// Workspaces 기본 활성화할 워크스페이스 ID 목록 ("*"이면 전체)
+	Workspaces []string `yaml:"workspaces" mapstructure:"workspaces" json:"workspaces"`
+}
+
+// MCPConfig는 워크스페이스별 MCP 서버 관리 설정을 정의합니다
+type MCPConfig struct {
+	// Dir 워크스페이스별 MCP 서버 선언 저장 디렉토리
+	Dir string `yaml:"dir" mapstructure:"dir" json:"dir"`
+	
+	// HealthCheckInterval MCP 서버 상태 확인 주기 (0이면 요청 시에만 확인)
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" mapstructure:"health_check_interval" json:"health_check_interval"`
+	
+	// HealthCheckTimeout MCP 서버 상태 확인 제한 시간
+	HealthCheckTimeout time.Duration `yaml:"health_check_timeout" mapstructure:"health_check_timeout" json:"health_check_timeout"`
+}
+
+// AgentsConfig는 세션에서 실행할 CLI 에이전트 프로바이더 설정을 정의합니다
+type AgentsConfig struct {
+	// Default 기본 프로바이더 (claude 또는 Providers에 정의된 이름)
+	Default string `yaml:"default" mapstructure:"default" json:"default"`
+	
+	// Providers Claude 외에 추가로 등록할 CLI 에이전트 목록
+	Providers []AgentProviderConfig `yaml:"providers" mapstructure:"providers" json:"providers"`
+	
+	// Workspaces 워크스페이스 ID별 프로바이더 지정
+	Workspaces map[string]string `yaml:"workspaces" mapstructure:"workspaces" json:"workspaces"`
+	
+	// RecordDir 에이전트 표준 출력 녹화 디렉토리 (비어 있으면 녹화하지 않음, 재생 테스트용)
+	RecordDir string `yaml:"record_dir" mapstructure:"record_dir" json:"record_dir"`
+}
+
+// AgentProviderConfig는 CLI 에이전트 프로바이더 하나의 설정을 정의합니다
+type AgentProviderConfig struct {
+	// Name 프로바이더 이름
+	Name string `yaml:"name" mapstructure:"name" json:"name" validate:"required"`
+	
+	// Command 실행 파일 경로
+	Command string `yaml:"command" mapstructure:"command" json:"command" validate:"required"`
+	
+	// Args 실행 인자
+	Args []string `yaml:"args" mapstructure:"args" json:"args"`
+	
+	// Env 에이전트에 전달할 환경 변수
+	Env map[string]string `yaml:"env" mapstructure:"env" json:"-"`
+	
+	// OutputFormat 표준 출력 형식 (text, jsonl)
+	OutputFormat string `yaml:"output_format" mapstructure:"output_format" json:"output_format" validate:"omitempty,oneof=text jsonl"`
+	
+	// InputPricePerMTok 입력 토큰 100만 개당 비용 (USD)
+	InputPricePerMTok float64 `yaml:"input_price_per_mtok" mapstructure:"input_price_per_mtok" json:"input_price_per_mtok"`
+	
+	// OutputPricePerMTok 출력 토큰 100만 개당 비용 (USD)
+	OutputPricePerMTok float64 `yaml:"output_price_per_mtok" mapstructure:"output_price_per_mtok" json:"output_price_per_mtok"`
+}
+
+// RestartConfig는 CLI 프로세스가 예기치 않게 종료되었을 때의 자동 재시작 설정을 정의합니다
+type RestartConfig struct {
+	// 기본 재시작 정책
+	RestartPolicyConfig `yaml:",inline" mapstructure:",squash"`
+	
+	// Workspaces 워크스페이스 ID별 재시작 정책 (지정하지 않은 값은 기본 정책을 따름)
+	Workspaces map[string]RestartPolicyConfig `yaml:"workspaces" mapstructure:"workspaces" json:"workspaces"`
+}
+
+// ReaperConfig는 서버 비정상 종료 후 남은 CLI 프로세스 정리 설정을 정의합니다
+type ReaperConfig struct {
+	// Enabled 실행한 프로세스 기록과 시작 시 고아 프로세스 점검 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// InstanceID 서버 인스턴스 식별자 (cluster.instance_id가 우선, 둘 다 비어 있으면 호스트 이름, 재시작해도 같아야 함)
+	InstanceID string `yaml:"instance_id" mapstructure:"instance_id" json:"instance_id"`
+	
+	// Action 고아 프로세스 처리 방식 (kill: 종료, adopt: 다시 관리)
+	Action string `yaml:"action" mapstructure:"action" json:"action" validate:"omitempty,oneof=kill adopt"`
+	
+	// DryRun 시작 시 고아 프로세스를 처리하지 않고 보고만 함
+	DryRun bool `yaml:"dry_run" mapstructure:"dry_run" json:"dry_run"`
+	
+	// KillTimeout SIGTERM 후 SIGKILL까지 대기 시간
+	KillTimeout time.Duration `yaml:"kill_timeout" mapstructure:"kill_timeout" json:"kill_timeout"`
+}
+
+// HangConfig는 출력과 하트비트가 없는 멈춘 CLI 프로세스 감지 설정을 정의합니다
+type HangConfig struct {
+	// Timeout 멈춤으로 판단할 무활동 시간 (0이면 감지하지 않음)
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout" json:"timeout"`
+	
+	// CheckInterval 활동 확인 주기 (0이면 Timeout의 1/4)
+	CheckInterval time.Duration `yaml:"check_interval" mapstructure:"check_interval" json:"check_interval"`
+	
+	// AutoKill 멈춤 감지 시 프로세스 강제 종료 (재시작 정책이 있으면 재시작)
+	AutoKill bool `yaml:"auto_kill" mapstructure:"auto_kill" json:"auto_kill"`
+}
+
+// ContextWindowConfig는 세션별 컨텍스트 사용량 추적과 자동 압축을 정의합니다
+// 스트림의 토큰 사용량으로 컨텍스트 크기를 추정하여, CLI가 작업 도중 한도에 걸리기 전에
+// WebSocket으로 경고하거나 대화를 요약해 이어받은 새 프로세스로 재시작합니다.
+type ContextWindowConfig struct {
+	// Enabled 컨텍스트 사용량 추적 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Limit 모델 한도를 모를 때 사용하는 컨텍스트 한도 (토큰)
+	Limit int `yaml:"limit" mapstructure:"limit" json:"limit" validate:"min=0"`
+	
+	// ModelLimits 모델 이름에 포함된 문자열별 컨텍스트 한도 (예: sonnet: 200000)
+	ModelLimits map[string]int `yaml:"model_limits" mapstructure:"model_limits" json:"model_limits"`
+	
+	// WarnRatio 한도 대비 이 비율 이상이면 경고 이벤트 전송
+	WarnRatio float64 `yaml:"warn_ratio" mapstructure:"warn_ratio" json:"warn_ratio" validate:"min=0,max=1"`
+	
+	// CompactRatio 한도 대비 이 비율 이상이면 자동 압축 (AutoCompact가 꺼져 있으면 위험 경고)
+	CompactRatio float64 `yaml:"compact_ratio" mapstructure:"compact_ratio" json:"compact_ratio" validate:"min=0,max=1"`
+	
+	// AutoCompact 압축 비율에 도달하면 대화를 요약하고 요약을 이어받아 프로세스 재시작
+	AutoCompact bool `yaml:"auto_compact" mapstructure:"auto_compact" json:"auto_compact"`
+	
+	// SummaryMaxChars 이어받을 요약의 최대 길이 (문자)
+	SummaryMaxChars int `yaml:"summary_max_chars" mapstructure:"summary_max_chars" json:"summary_max_chars" validate:"min=0"`
+	
+	// SummaryMessageChars 요약에 넣는 메시지 하나의 최대 길이 (문자)
+	SummaryMessageChars int `yaml:"summary_message_chars" mapstructure:"summary_message_chars" json:"summary_message_chars" validate:"min=0"`
+	
+	// CompactTimeout 압축 한 번의 최대 시간
+	CompactTimeout time.Duration `yaml:"compact_timeout" mapstructure:"compact_timeout" json:"compact_timeout"`
+}
+
+// QuotaConfig는 Claude 자격 증명별 요청 한도 추적과 부하 분산을 정의합니다
+type QuotaConfig struct {
+	// Enabled 쿼터 거버너 활성화 여부 (자격 증명이 하나 이상 있어야 함)
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Credentials claude 태스크에 나눠 쓸 자격 증명 목록
+	Credentials []QuotaCredentialConfig `yaml:"credentials" mapstructure:"credentials" json:"credentials"`
+	
+	// NearRatio 분당 한도 대비 이 비율에 도달하면 다른 자격 증명을 사용하거나 태스크를 지연
+	NearRatio float64 `yaml:"near_ratio" mapstructure:"near_ratio" json:"near_ratio" validate:"min=0,max=1"`
+	
+	// Cooldown 한도 초과나 과부하 응답에 재시도 시간이 없을 때 자격 증명을 격리하는 시간
+	Cooldown time.Duration `yaml:"cooldown" mapstructure:"cooldown" json:"cooldown"`
+	
+	// AuthQuarantine 인증 실패 응답을 받은 자격 증명을 격리하는 시간 (관리자 API로 먼저 해제 가능)
+	AuthQuarantine time.Duration `yaml:"auth_quarantine" mapstructure:"auth_quarantine" json:"auth_quarantine"`
+	
+	// MaxDelay 워커에서 자격 증명을 기다리는 최대 시간 (더 오래 기다려야 하면 태스크를 다시 큐에 넣음)
+	MaxDelay time.Duration `yaml:"max_delay" mapstructure:"max_delay" json:"max_delay"`
+}
+
+// PreemptionConfig는 워커 풀이 포화되었을 때 높은 우선순위 태스크가 낮은 우선순위 태스크를 선점하는 정책을 정의합니다
+// 우선순위는 low, normal, high, critical 중 하나입니다.
+type PreemptionConfig struct {
+	// Policy 선점 방식 (none: 선점 안 함, requeue: 취소 후 재큐잉, pause: 일시 중지 후 임시 워커에서 실행)
+	Policy string `yaml:"policy" mapstructure:"policy" json:"policy" validate:"omitempty,oneof=none requeue pause"`
+	
+	// MinPreemptorPriority 다른 태스크를 선점할 수 있는 최소 우선순위
+	MinPreemptorPriority string `yaml:"min_preemptor_priority" mapstructure:"min_preemptor_priority" json:"min_preemptor_priority" validate:"omitempty,oneof=low normal high critical"`
+	
+	// MaxPreemptiblePriority 선점될 수 있는 최대 우선순위
+	MaxPreemptiblePriority string `yaml:"max_preemptible_priority" mapstructure:"max_preemptible_priority" json:"max_preemptible_priority" validate:"omitempty,oneof=low normal high critical"`
+	
+	// MaxPreemptionsPerTask 태스크 하나가 선점될 수 있는 최대 횟수 (0이면 무제한)
+	MaxPreemptionsPerTask int `yaml:"max_preemptions_per_task" mapstructure:"max_preemptions_per_task" json:"max_preemptions_per_task" validate:"min=0"`
+}
+
+// QuotaCredentialConfig는 Claude 자격 증명 하나를 정의합니다
+type QuotaCredentialConfig struct {
+	// Name 로그와 상태 조회에 쓰는 이름
+	Name string `yaml:"name" mapstructure:"name" json:"name"`
+	
+	// OAuthToken OAuth 토큰 (있으면 APIKey보다 우선)
+	OAuthToken string `yaml:"oauth_token" mapstructure:"oauth_token" json:"-"`
+	
+	// APIKey Claude API 키
+	APIKey string `yaml:"api_key" mapstructure:"api_key" json:"-"`
+	
+	// RequestsPerMinute 프로바이더의 분당 요청 한도 (0이면 요청 수로 제한하지 않음)
+	RequestsPerMinute int `yaml:"requests_per_minute" mapstructure:"requests_per_minute" json:"requests_per_minute" validate:"min=0"`
+	
+	// MaxConcurrent 동시에 실행할 수 있는 요청 수 (0이면 제한 없음)
+	MaxConcurrent int `yaml:"max_concurrent" mapstructure:"max_concurrent" json:"max_concurrent" validate:"min=0"`
+	
+	// DailyTokenBudget 하루(UTC)에 쓸 수 있는 토큰 수 (0이면 제한 없음)
+	DailyTokenBudget int64 `yaml:"daily_token_budget" mapstructure:"daily_token_budget" json:"daily_token_budget" validate:"min=0"`
+	
+	// DailyBudgetUSD 하루(UTC)에 쓸 수 있는 비용 (0이면 제한 없음, CLI가 보고한 비용 기준)
+	DailyBudgetUSD float64 `yaml:"daily_budget_usd" mapstructure:"daily_budget_usd" json:"daily_budget_usd" validate:"min=0"`
+}
+
+// GuardrailsConfig는 서버가 강제하는 시스템 프롬프트 가드레일을 정의합니다
+// 가드레일은 Order 순서대로 사용자 시스템 프롬프트 앞에 붙으며 사용자가 빼거나 순서를 바꿀 수 없습니다.
+// 시스템 프롬프트를 지원하는 Claude 세션에만 적용됩니다.
+type GuardrailsConfig struct {
+	// Enabled 가드레일 적용 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Fragments 시스템 프롬프트에 넣을 지침 목록
+	Fragments []GuardrailFragmentConfig `yaml:"fragments" mapstructure:"fragments" json:"fragments"`
+}
+
+// GuardrailFragmentConfig는 가드레일 지침 하나를 정의합니다
+type GuardrailFragmentConfig struct {
+	// Name 지침 이름 (중복 불가)
+	Name string `yaml:"name" mapstructure:"name" json:"name"`
+	
+	// Text 지침 내용 (예: "/workspace 밖의 파일을 수정하지 말 것")
+	Text string `yaml:"text" mapstructure:"text" json:"text"`
+	
+	// Order 적용 순서 (작을수록 앞, 같으면 선언 순서)
+	Order int `yaml:"order" mapstructure:"order" json:"order"`
+}
+
+// RedactionConfig는 Claude 출력이 저장소, WebSocket, 로그로 나가기 전에 적용할 마스킹을 정의합니다
+type RedactionConfig struct {
+	// Enabled 출력 마스킹 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Default 워크스페이스별 정책이 없을 때 사용할 정책 (로그에도 적용)
+	Default RedactionPolicyConfig `yaml:"default" mapstructure:"default" json:"default"`
+	
+	// Workspaces 워크스페이스 ID별 정책 (기본 정책을 대체)
+	Workspaces map[string]RedactionPolicyConfig `yaml:"workspaces" mapstructure:"workspaces" json:"workspaces"`
+}
+
+// RedactionPolicyConfig는 마스킹 정책 하나를 정의합니다
+type RedactionPolicyConfig struct {
+	// Secrets 키, 토큰, 개인 키 등 비밀 값 마스킹
+	Secrets bool `yaml:"secrets" mapstructure:"secrets" json:"secrets"`
+	
+	// PII 이메일, 전화번호, 카드 번호 등 개인 정보 마스킹
+	PII bool `yaml:"pii" mapstructure:"pii" json:"pii"`
+	
+	// Entropy 규칙에 없는 무작위 문자열을 엔트로피로 판정해 마스킹
+	Entropy bool `yaml:"entropy" mapstructure:"entropy" json:"entropy"`
+	
+	// EntropyThreshold 마스킹할 최소 섀넌 엔트로피 (0이면 기본값)
+	EntropyThreshold float64 `yaml:"entropy_threshold" mapstructure:"entropy_threshold" json:"entropy_threshold" validate:"min=0"`
+	
+	// EntropyMinLength 엔트로피를 판정할 최소 토큰 길이 (0이면 기본값)
+	EntropyMinLength int `yaml:"entropy_min_length" mapstructure:"entropy_min_length" json:"entropy_min_length" validate:"min=0"`
+	
+	// DisabledRules 적용하지 않을 규칙 이름 (예: email, phone, high_entropy)
+	DisabledRules []string `yaml:"disabled_rules" mapstructure:"disabled_rules" json:"disabled_rules"`
+	
+	// Allowlist 규칙에 걸려도 가리지 않을 값
+	Allowlist []string `yaml:"allowlist" mapstructure:"allowlist" json:"allowlist"`
+}
+
+// ErrorStatsConfig는 에러 통계 집계 저장과 보존 기간을 정의합니다
+type ErrorStatsConfig struct {
+	// Enabled 에러 통계 저장 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// FlushInterval 메모리에 모은 집계를 스토리지에 저장하는 주기
+	FlushInterval time.Duration `yaml:"flush_interval" mapstructure:"flush_interval" json:"flush_interval"`
+	
+	// CompactInterval 보존 기간이 지난 집계를 삭제하는 주기
+	CompactInterval time.Duration `yaml:"compact_interval" mapstructure:"compact_interval" json:"compact_interval"`
+	
+	// HourlyRetention 시간별 집계 보존 기간 (0이면 삭제하지 않음)
+	HourlyRetention time.Duration `yaml:"hourly_retention" mapstructure:"hourly_retention" json:"hourly_retention"`
+	
+	// DailyRetention 일별 집계 보존 기간 (0이면 삭제하지 않음)
+	DailyRetention time.Duration `yaml:"daily_retention" mapstructure:"daily_retention" json:"daily_retention"`
+	
+	// Trend 에러 추세 분석과 알림 설정
+	Trend ErrorTrendConfig `yaml:"trend" mapstructure:"trend" json:"trend"`
+}
+
+// ErrorTrendConfig는 에러 추세 분석과 이상 탐지 알림을 정의합니다
+type ErrorTrendConfig struct {
+	// Enabled 추세 분석 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Analyzer 추세 분석기 (ewma, holt_winters)
+	Analyzer string `yaml:"analyzer" mapstructure:"analyzer" json:"analyzer"`
+	
+	// Window 분석할 시간별 집계 구간 수
+	Window int `yaml:"window" mapstructure:"window" json:"window"`
+	
+	// Interval 분석 주기
+	Interval time.Duration `yaml:"interval" mapstructure:"interval" json:"interval"`
+	
+	// Threshold 다음 한 시간의 예측 에러 수 알림 임계값 (0이면 이상 탐지만 사용)
+	Threshold float64 `yaml:"threshold" mapstructure:"threshold" json:"threshold"`
+	
+	// Sensitivity 이상으로 판단할 표준편차 배수 (작을수록 민감)
+	Sensitivity float64 `yaml:"sensitivity" mapstructure:"sensitivity" json:"sensitivity"`
+	
+	// Alpha, Beta, Gamma 수준, 추세, 계절성 평활 계수
+	Alpha float64 `yaml:"alpha" mapstructure:"alpha" json:"alpha"`
+	Beta  float64 `yaml:"beta" mapstructure:"beta" json:"beta"`
+	Gamma float64 `yaml:"gamma" mapstructure:"gamma" json:"gamma"`
+	
+	// SeasonLength Holt-Winters 계절 주기 (시간 단위)
+	SeasonLength int `yaml:"season_length" mapstructure:"season_length" json:"season_length"`
+}
+
+// TasksConfig는 태스크 실행 시간 제한 단계와 취소 동작을 정의합니다
+type TasksConfig struct {
+	// QuickTimeout quick 단계 실행 시간 제한
+	QuickTimeout time.Duration `yaml:"quick_timeout" mapstructure:"quick_timeout" json:"quick_timeout"`
+	
+	// StandardTimeout standard 단계 실행 시간 제한 (단계를 지정하지 않은 태스크에 적용)
+	StandardTimeout time.Duration `yaml:"standard_timeout" mapstructure:"standard_timeout" json:"standard_timeout"`
+	
+	// LongTimeout long 단계 실행 시간 제한
+	LongTimeout time.Duration `yaml:"long_timeout" mapstructure:"long_timeout" json:"long_timeout"`
+	
+	// CancelGracePeriod 취소 신호 후 강제 종료까지 대기 시간
+	CancelGracePeriod time.Duration `yaml:"cancel_grace_period" mapstructure:"cancel_grace_period" json:"cancel_grace_period"`
+	
+	// Admission 호스트 자원 사용률에 따른 claude 태스크 실행 허가
+	Admission TaskAdmissionConfig `yaml:"admission" mapstructure:"admission" json:"admission"`
+}
+
+// TaskAdmissionConfig는 호스트 자원이 부족할 때 새 claude 태스크 실행을 미루는 설정을 정의합니다
+// 사용률이 기준을 넘으면 새 태스크를 대기열에 보관하고 대기 순서를 응답하며, 기준 아래로 내려오면 순서대로 실행합니다.
+type TaskAdmissionConfig struct {
+	// Enabled 호스트 자원 측정과 실행 허가 사용 (Linux만 지원)
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// SampleInterval 호스트 자원 측정 주기
+	SampleInterval time.Duration `yaml:"sample_interval" mapstructure:"sample_interval" json:"sample_interval"`
+	
+	// CPUPercent 실행을 미루는 CPU 사용률 (%, 0이면 검사하지 않음)
+	CPUPercent float64 `yaml:"cpu_percent" mapstructure:"cpu_percent" json:"cpu_percent" validate:"min=0,max=100"`
+	
+	// MemoryPercent 실행을 미루는 메모리 사용률 (%, 0이면 검사하지 않음)
+	MemoryPercent float64 `yaml:"memory_percent" mapstructure:"memory_percent" json:"memory_percent" validate:"min=0,max=100"`
+	
+	// DiskPercent 실행을 미루는 디스크 사용률 (%, 0이면 검사하지 않음)
+	DiskPercent float64 `yaml:"disk_percent" mapstructure:"disk_percent" json:"disk_percent" validate:"min=0,max=100"`
+	
+	// DiskPath 디스크 사용률을 측정할 경로 (비어 있으면 workspace.default_path)
+	DiskPath string `yaml:"disk_path" mapstructure:"disk_path" json:"disk_path"`
+	
+	// MaxDeferred 실행을 기다릴 수 있는 최대 태스크 수 (넘으면 503으로 거부, 0이면 무제한)
+	MaxDeferred int `yaml:"max_deferred" mapstructure:"max_deferred" json:"max_deferred" validate:"min=0"`
+	
+	// ReleaseBatch 자원이 회복되었을 때 측정 한 번에 실행하는 태스크 수
+	ReleaseBatch int `yaml:"release_batch" mapstructure:"release_batch" json:"release_batch" validate:"min=0"`
+}
+
+// KubernetesConfig는 태스크를 Kubernetes Job으로 실행하는 설정을 정의합니다
+type KubernetesConfig struct {
+	// Enabled 활성화하면 태스크를 로컬 프로세스 대신 Kubernetes Job으로 실행
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// APIServer API 서버 주소 (비어 있으면 클러스터 내부 서비스 어카운트 사용)
+	APIServer string `yaml:"api_server" mapstructure:"api_server" json:"api_server"`
+	
+	// TokenFile API 서버 베어러 토큰 파일
+	TokenFile string `yaml:"token_file" mapstructure:"token_file" json:"token_file"`
+	
+	// CAFile API 서버 CA 인증서 파일
+	CAFile string `yaml:"ca_file" mapstructure:"ca_file" json:"ca_file"`
+	
+	// Insecure TLS 인증서 검증 생략 (개발용)
+	Insecure bool `yaml:"insecure" mapstructure:"insecure" json:"insecure"`
+	
+	// Namespace 태스크 Job 네임스페이스 (비어 있으면 서버가 실행 중인 네임스페이스)
+	Namespace string `yaml:"namespace" mapstructure:"namespace" json:"namespace"`
+	
+	// Image 태스크 컨테이너 이미지
+	Image string `yaml:"image" mapstructure:"image" json:"image"`
+	
+	// ImagePullPolicy 이미지 풀 정책
+	ImagePullPolicy string `yaml:"image_pull_policy" mapstructure:"image_pull_policy" json:"image_pull_policy" validate:"omitempty,oneof=Always IfNotPresent Never"`
+	
+	// ServiceAccount 태스크 파드 서비스 어카운트
+	ServiceAccount string `yaml:"service_account" mapstructure:"service_account" json:"service_account"`
+	
+	// ClaimNameFormat 워크스페이스 PVC 이름 형식 (%s는 워크스페이스 ID)
+	ClaimNameFormat string `yaml:"claim_name_format" mapstructure:"claim_name_format" json:"claim_name_format"`
+	
+	// MountPath 워크스페이스 볼륨 마운트 경로
+	MountPath string `yaml:"mount_path" mapstructure:"mount_path" json:"mount_path"`
+	
+	// DefaultLimits 태스크 컨테이너 기본 리소스 제한 (cpu, memory)
+	DefaultLimits map[string]string `yaml:"default_limits" mapstructure:"default_limits" json:"default_limits"`
+	
+	// NodeSelector 태스크 파드 노드 셀렉터
+	NodeSelector map[string]string `yaml:"node_selector" mapstructure:"node_selector" json:"node_selector"`
+	
+	// StartTimeout 파드 시작 대기 최대 시간
+	StartTimeout time.Duration `yaml:"start_timeout" mapstructure:"start_timeout" json:"start_timeout"`
+	
+	// TTLAfterFinished 정리되지 못한 Job의 자동 삭제 시간
+	TTLAfterFinished time.Duration `yaml:"ttl_after_finished" mapstructure:"ttl_after_finished" json:"ttl_after_finished"`
+	
+	// Quota 태스크 네임스페이스 리소스 쿼터 (비어 있으면 관리하지 않음)
+	Quota KubernetesQuotaConfig `yaml:"quota" mapstructure:"quota" json:"quota"`
+}
+
+// KubernetesQuotaConfig는 태스크 네임스페이스에 적용할 리소스 쿼터를 정의합니다
+type KubernetesQuotaConfig struct {
+	// Name 리소스 쿼터 이름
+	Name string `yaml:"name" mapstructure:"name" json:"name"`
+	
+	// Hard 리소스별 상한 (예: limits.cpu: "16", limits.memory: "64Gi", pods: "20")
+	Hard map[string]string `yaml:"hard" mapstructure:"hard" json:"hard"`
+}
+
+// RemoteConfig는 원격 에이전트로 태스크를 분산 실행하는 설정을 정의합니다
+type RemoteConfig struct {
+	// Enabled 활성화하면 태스크를 접속한 원격 에이전트에 배정
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Token 에이전트 접속 토큰 (비어 있으면 에이전트 접속을 받지 않음)
+	Token string `yaml:"token" mapstructure:"token" json:"-"`
+	
+	// HeartbeatInterval 에이전트 하트비트 주기
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval" mapstructure:"heartbeat_interval" json:"heartbeat_interval"`
+	
+	// HeartbeatTimeout 이 시간 동안 하트비트가 없으면 에이전트 연결을 끊음
+	HeartbeatTimeout time.Duration `yaml:"heartbeat_timeout" mapstructure:"heartbeat_timeout" json:"heartbeat_timeout"`
+	
+	// AssignTimeout 사용 가능한 에이전트를 기다릴 최대 시간
+	AssignTimeout time.Duration `yaml:"assign_timeout" mapstructure:"assign_timeout" json:"assign_timeout"`
+	
+	// Affinity 워크스페이스별 에이전트 어피니티 규칙
+	Affinity []RemoteAffinityConfig `yaml:"affinity" mapstructure:"affinity" json:"affinity"`
+}
+
+// RemoteAffinityConfig는 워크스페이스 하나의 에이전트 어피니티 규칙을 정의합니다
+type RemoteAffinityConfig struct {
+	// Workspace 워크스페이스 ID ("*"는 규칙이 없는 워크스페이스)
+	Workspace string `yaml:"workspace" mapstructure:"workspace" json:"workspace" validate:"required"`
+	
+	// Required 에이전트 레이블이 모두 일치해야 배정
+	Required map[string]string `yaml:"required" mapstructure:"required" json:"required"`
+	
+	// Preferred 일치하는 레이블이 많은 에이전트를 우선
+	Preferred map[string]string `yaml:"preferred" mapstructure:"preferred" json:"preferred"`
+}
+
+// ClusterConfig는 API 서버를 여러 레플리카로 운영할 때의 잠금과 리더 선출 설정을 정의합니다
+type ClusterConfig struct {
+	// Enabled 활성화하면 백업, 고아 프로세스 정리 등 단일 실행 작업을 리더 인스턴스에서만 실행
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// InstanceID 인스턴스 식별자 (비어 있으면 reaper.instance_id, 그다음 호스트 이름)
+	InstanceID string `yaml:"instance_id" mapstructure:"instance_id" json:"instance_id"`
+	
+	// Backend 잠금 저장소 (redis: 레플리카 간 공유, memory: 단일 인스턴스)
+	Backend string `yaml:"backend" mapstructure:"backend" json:"backend" validate:"omitempty,oneof=redis memory"`
+	
+	// Redis 잠금 저장소 Redis 연결 설정
+	Redis ClusterRedisConfig `yaml:"redis" mapstructure:"redis" json:"redis"`
+	
+	// KeyPrefix 잠금 키 접두사
+	KeyPrefix string `yaml:"key_prefix" mapstructure:"key_prefix" json:"key_prefix"`
+	
+	// LeaseTTL 잠금과 멤버십 임대 만료 시간 (리더가 죽으면 이 시간 안에 넘겨받음)
+	LeaseTTL time.Duration `yaml:"lease_ttl" mapstructure:"lease_ttl" json:"lease_ttl"`
+	
+	// RetryInterval 리더가 아닌 인스턴스가 선출을 다시 시도하는 주기
+	RetryInterval time.Duration `yaml:"retry_interval" mapstructure:"retry_interval" json:"retry_interval"`
+	
+	// ReapInterval 리더가 종료된 인스턴스의 프로세스 기록을 정리하는 주기
+	ReapInterval time.Duration `yaml:"reap_interval" mapstructure:"reap_interval" json:"reap_interval"`
+}
+
+// ClusterRedisConfig는 클러스터 잠금 저장소의 Redis 연결 설정을 정의합니다
+type ClusterRedisConfig struct {
+	// Addr Redis 주소 (host:port)
+	Addr string `yaml:"addr" mapstructure:"addr" json:"addr"`
+	
+	// Password Redis 비밀번호
+	Password string `yaml:"password" mapstructure:"password" json:"-"`
+	
+	// DB Redis 데이터베이스 번호
+	DB int `yaml:"db" mapstructure:"db" json:"db"`
+}
+
+// MaintenanceConfig는 유지보수 모드의 기본 동작을 정의합니다
+// 유지보수 모드는 관리자 API로 켜고 끄며, 여기서는 켤 때 따로 지정하지 않은 값의 기본값을 정합니다.
+type MaintenanceConfig struct {
+	// Enabled 서버 시작 시 유지보수 모드로 시작
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Message 프론트엔드 배너에 표시할 기본 안내 문구
+	Message string `yaml:"message" mapstructure:"message" json:"message"`
+	
+	// RetryAfter 새 태스크 거부 시 Retry-After로 안내할 시간
+	RetryAfter time.Duration `yaml:"retry_after" mapstructure:"retry_after" json:"retry_after"`
+	
+	// QueueSubmissions 새 태스크를 거부하지 않고 보관했다가 유지보수가 끝나면 실행
+	QueueSubmissions bool `yaml:"queue_submissions" mapstructure:"queue_submissions" json:"queue_submissions"`
+	
+	// MaxQueued 유지보수 중 보관할 최대 태스크 수 (넘으면 거부)
+	MaxQueued int `yaml:"max_queued" mapstructure:"max_queued" json:"max_queued" validate:"min=0"`
+}
+
+// CircuitBreakerConfig는 외부 의존성 호출을 보호하는 회로 차단기 설정을 정의합니다
+// 연속 실패가 기준을 넘은 의존성은 열린 동안 호출하지 않고 바로 실패하거나 대체 동작(예: 메모리 요청 제한)으로 처리합니다.
+type CircuitBreakerConfig struct {
+	// Enabled 회로 차단기 사용 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// FailureThreshold 회로를 여는 연속 실패 횟수
+	FailureThreshold int `yaml:"failure_threshold" mapstructure:"failure_threshold" json:"failure_threshold" validate:"min=0"`
+	
+	// OpenTimeout 회로를 연 뒤 시험 호출을 허용할 때까지 대기 시간
+	OpenTimeout time.Duration `yaml:"open_timeout" mapstructure:"open_timeout" json:"open_timeout" validate:"min=0"`
+	
+	// HalfOpenProbes 반열림 상태에서 동시에 허용하는 시험 호출 수 (모두 성공하면 회로를 닫음)
+	HalfOpenProbes int `yaml:"half_open_probes" mapstructure:"half_open_probes" json:"half_open_probes" validate:"min=0"`
+}
+
+// ChaosConfig는 복구와 재시도 동작을 통합 테스트에서 확인하기 위한 장애 주입 설정을 정의합니다
+// 켜져 있으면 스토리지 호출 지연, Claude 프로세스 강제 종료, WebSocket 프레임 누락을 확률에 따라 주입하며,
+// 실행 중에는 관리자 API(/admin/fault-injection)로 확률을 바꿀 수 있습니다. production 환경에서는 켤 수 없습니다.
+type ChaosConfig struct {
+	// Enabled 장애 주입 사용 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Seed 난수 시드 (0이면 시작 시각 사용, 같은 시드면 같은 순서로 장애 주입)
+	Seed int64 `yaml:"seed" mapstructure:"seed" json:"seed"`
+	
+	// StorageDelay 스토리지 호출 지연
+	StorageDelay ChaosStorageDelayConfig `yaml:"storage_delay" mapstructure:"storage_delay" json:"storage_delay"`
+	
+	// ProcessKill Claude 프로세스 강제 종료
+	ProcessKill ChaosProcessKillConfig `yaml:"process_kill" mapstructure:"process_kill" json:"process_kill"`
+	
+	// FrameDrop WebSocket 프레임 누락
+	FrameDrop ChaosFrameDropConfig `yaml:"frame_drop" mapstructure:"frame_drop" json:"frame_drop"`
+}
+
+// ChaosStorageDelayConfig는 스토리지 호출 지연 주입 설정을 정의합니다
+type ChaosStorageDelayConfig struct {
+	// Probability 호출마다 지연을 주입할 확률 (0~1)
+	Probability float64 `yaml:"probability" mapstructure:"probability" json:"probability" validate:"min=0,max=1"`
+	
+	// MinDelay 최소 지연 시간
+	MinDelay time.Duration `yaml:"min_delay" mapstructure:"min_delay" json:"min_delay" validate:"min=0"`
+	
+	// MaxDelay 최대 지연 시간 (MinDelay보다 작으면 MinDelay만큼 지연)
+	MaxDelay time.Duration `yaml:"max_delay" mapstructure:"max_delay" json:"max_delay" validate:"min=0"`
+}
+
+// ChaosProcessKillConfig는 Claude 프로세스 강제 종료 주입 설정을 정의합니다
+type ChaosProcessKillConfig struct {
+	// Probability 실행한 프로세스마다 강제 종료할 확률 (0~1)
+	Probability float64 `yaml:"probability" mapstructure:"probability" json:"probability" validate:"min=0,max=1"`
+	
+	// After 프로세스 시작 후 강제 종료까지 시간
+	After time.Duration `yaml:"after" mapstructure:"after" json:"after" validate:"min=0"`
+}
+
+// ChaosFrameDropConfig는 WebSocket 프레임 누락 주입 설정을 정의합니다
+type ChaosFrameDropConfig struct {
+	// Probability 보내는 프레임마다 버릴 확률 (0~1)
+	Probability float64 `yaml:"probability" mapstructure:"probability" json:"probability" validate:"min=0,max=1"`
+}
+
+// JobsConfig는 백그라운드 작업(백업, 정리, 퍼지) 실행 설정을 정의합니다
+// 다중 레플리카에서는 리더만 예약 실행하고, 수동 실행은 작업별 분산 잠금으로 겹치지 않게 합니다.
+type JobsConfig struct {
+	// Schedules 작업별 실행 주기 재정의 (cron 식, "@every 30m", "@daily", "off"면 수동으로만 실행)
+	Schedules map[string]string `yaml:"schedules" mapstructure:"schedules" json:"schedules"`
+	
+	// Timeout 작업 한 번의 제한 시간 (0이면 제한 없음)
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout" json:"timeout"`
+}
+
+// WebSocketConfig는 /ws 엔드포인트 설정을 정의합니다
+type WebSocketConfig struct {
+	// EventLog 세션/태스크 채널 이벤트 보관 설정 (재연결 후 재개용)
+	EventLog WebSocketEventLogConfig `yaml:"event_log" mapstructure:"event_log" json:"event_log"`
+}
+
+// WebSocketEventLogConfig는 채널 이벤트 로그 설정을 정의합니다
+// 최근 이벤트는 메모리 링 버퍼에, 밀려난 이벤트는 디스크에 보관해 재연결한 클라이언트가 이어 받을 수 있게 합니다.
+type WebSocketEventLogConfig struct {
+	// Enabled 이벤트 로그 사용 여부 (끄면 resume 요청을 거부)
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Dir 링 버퍼에서 밀려난 이벤트를 저장할 디렉토리 (비어 있으면 메모리만 사용)
+	Dir string `yaml:"dir" mapstructure:"dir" json:"dir"`
+	
+	// RingSize 채널별로 메모리에 보관할 최근 이벤트 수
+	RingSize int `yaml:"ring_size" mapstructure:"ring_size" json:"ring_size" validate:"min=0"`
+	
+	// MaxEvents 채널별 최대 보관 이벤트 수 (넘으면 오래된 것부터 버리고 재개 시 truncated로 알림)
+	MaxEvents int `yaml:"max_events" mapstructure:"max_events" json:"max_events" validate:"min=0"`
+	
+	// Retention 마지막 이벤트 이후 채널 로그를 보관할 시간
+	Retention time.Duration `yaml:"retention" mapstructure:"retention" json:"retention"`
+}
+
+// AccountsConfig는 비밀번호로 로그인하는 로컬 계정 관리 설정을 정의합니다
+// 비활성화하면 개발용 고정 계정으로 로그인합니다.
+type AccountsConfig struct {
+	// Enabled 로컬 계정 저장소로 로그인 처리
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// BootstrapAdmin 계정이 하나도 없을 때 만들 관리자 사용자명
+	BootstrapAdmin string `yaml:"bootstrap_admin" mapstructure:"bootstrap_admin" json:"bootstrap_admin"`
+	
+	// BootstrapPassword 최초 관리자 비밀번호 (비어 있으면 최초 관리자를 만들지 않음)
+	BootstrapPassword string `yaml:"bootstrap_password" mapstructure:"bootstrap_password" json:"-"`
+	
+	// Argon2 비밀번호 해시 파라미터
+	Argon2 Argon2Config `yaml:"argon2" mapstructure:"argon2" json:"argon2"`
+	
+	// PasswordPolicy 비밀번호 복잡도 규칙
+	PasswordPolicy PasswordPolicyConfig `yaml:"password_policy" mapstructure:"password_policy" json:"password_policy"`
+	
+	// Lockout 로그인 실패 잠금 설정
+	Lockout AccountLockoutConfig `yaml:"lockout" mapstructure:"lockout" json:"lockout"`
+	
+	// ResetTokenExpiry 비밀번호 재설정 토큰 유효 시간 (재설정 메일은 email 설정으로 발송)
+	ResetTokenExpiry time.Duration `yaml:"reset_token_expiry" mapstructure:"reset_token_expiry" json:"reset_token_expiry"`
+}
+
+// Argon2Config는 argon2id 비밀번호 해시 파라미터를 정의합니다
+type Argon2Config struct {
+	// Memory 메모리 사용량 (KiB)
+	Memory uint32 `yaml:"memory" mapstructure:"memory" json:"memory"`
+	
+	// Iterations 반복 횟수
+	Iterations uint32 `yaml:"iterations" mapstructure:"iterations" json:"iterations"`
+	
+	// Parallelism 병렬 스레드 수
+	Parallelism uint8 `yaml:"parallelism" mapstructure:"parallelism" json:"parallelism"`
+}
+
+// PasswordPolicyConfig는 로컬 계정 비밀번호 복잡도 규칙을 정의합니다
+type PasswordPolicyConfig struct {
+	// MinLength 최소 길이
+	MinLength int `yaml:"min_length" mapstructure:"min_length" json:"min_length" validate:"min=1"`
+	
+	// MaxLength 최대 길이 (0이면 제한 없음)
+	MaxLength int `yaml:"max_length" mapstructure:"max_length" json:"max_length" validate:"min=0"`
+	
+	// RequireUpper 대문자 필수
+	RequireUpper bool `yaml:"require_upper" mapstructure:"require_upper" json:"require_upper"`
+	
+	// RequireLower 소문자 필수
+	RequireLower bool `yaml:"require_lower" mapstructure:"require_lower" json:"require_lower"`
+	
+	// RequireDigit 숫자 필수
+	RequireDigit bool `yaml:"require_digit" mapstructure:"require_digit" json:"require_digit"`
+	
+	// RequireSymbol 특수문자 필수
+	RequireSymbol bool `yaml:"require_symbol" mapstructure:"require_symbol" json:"require_symbol"`
+	
+	// DisallowUsername 사용자명을 포함한 비밀번호 거부
+	DisallowUsername bool `yaml:"disallow_username" mapstructure:"disallow_username" json:"disallow_username"`
+}
+
+// AccountLockoutConfig는 로그인 실패 시 계정 잠금과 공격 탐지 연동을 정의합니다
+type AccountLockoutConfig struct {
+	// MaxFailures 잠그기 전까지 허용하는 연속 실패 횟수 (0이면 잠그지 않음)
+	MaxFailures int `yaml:"max_failures" mapstructure:"max_failures" json:"max_failures" validate:"min=0"`
+	
+	// Duration 잠금 유지 시간
+	Duration time.Duration `yaml:"duration" mapstructure:"duration" json:"duration"`
+	
+	// AutoBlockIP 무차별 대입으로 판단되면 요청 IP 차단 (redis_addr 필요)
+	AutoBlockIP bool `yaml:"auto_block_ip" mapstructure:"auto_block_ip" json:"auto_block_ip"`
+	
+	// RedisAddr 공격 탐지기가 보안 이벤트와 IP별 실패 횟수를 저장할 Redis 주소 (비어 있으면 로그만 남김)
+	RedisAddr string `yaml:"redis_addr" mapstructure:"redis_addr" json:"redis_addr"`
+}
+
+// EmailConfig는 초대, 비밀번호 재설정, 예산 알림, 보안 알림 메일 발송을 정의합니다
+// 발송 기록을 남기고 실패한 메일은 재시도 대기열에서 다시 보냅니다.
+type EmailConfig struct {
+	// Driver 발송 방식 (smtp, ses, log; 비어 있으면 메일을 보내지 않음)
+	Driver string `yaml:"driver" mapstructure:"driver" json:"driver" validate:"omitempty,oneof=smtp ses log"`
+	
+	// FromEmail 보내는 사람 주소
+	FromEmail string `yaml:"from_email" mapstructure:"from_email" json:"from_email" validate:"omitempty,email"`
+	
+	// FromName 보내는 사람 이름
+	FromName string `yaml:"from_name" mapstructure:"from_name" json:"from_name"`
+	
+	// BaseURL 메일 본문 링크에 쓸 웹 UI 주소
+	BaseURL string `yaml:"base_url" mapstructure:"base_url" json:"base_url"`
+	
+	// TemplateDir 기본 템플릿을 덮어쓸 템플릿 디렉토리 (<이름>.tmpl)
+	TemplateDir string `yaml:"template_dir" mapstructure:"template_dir" json:"template_dir"`
+	
+	// MaxAttempts 발송을 포기하기 전까지 시도 횟수
+	MaxAttempts int `yaml:"max_attempts" mapstructure:"max_attempts" json:"max_attempts" validate:"min=1"`
+	
+	// RetryBackoff 첫 재시도 대기 시간 (실패할 때마다 두 배)
+	RetryBackoff time.Duration `yaml:"retry_backoff" mapstructure:"retry_backoff" json:"retry_backoff"`
+	
+	// PollInterval 재시도 대기열 확인 주기
+	PollInterval time.Duration `yaml:"poll_interval" mapstructure:"poll_interval" json:"poll_interval"`
+	
+	// SecurityRecipients 계정 잠금 같은 보안 알림을 함께 받을 관리자 주소
+	SecurityRecipients []string `yaml:"security_recipients" mapstructure:"security_recipients" json:"security_recipients" validate:"omitempty,dive,email"`
+	
+	// SMTP 발송 서버 설정 (driver가 smtp일 때)
+	SMTP SMTPConfig `yaml:"smtp" mapstructure:"smtp" json:"smtp"`
+	
+	// SES Amazon SES 설정 (driver가 ses일 때)
+	SES SESConfig `yaml:"ses" mapstructure:"ses" json:"ses"`
+}
+
+// SMTPConfig는 메일 발송 서버 설정을 정의합니다
+type SMTPConfig struct {
+	Host     string `yaml:"host" mapstructure:"host" json:"host"`
+	Port     int    `yaml:"port" mapstructure:"port" json:"port"`
+	Username string `yaml:"username" mapstructure:"username" json:"username"`
+	Password string `yaml:"password" mapstructure:"password" json:"-"`
+}
+
+// SESConfig는 Amazon SES API 발송 설정을 정의합니다
+type SESConfig struct {
+	// Region 리전
+	Region string `yaml:"region" mapstructure:"region" json:"region"`
+	
+	// Endpoint SES 호환 엔드포인트 (비어 있으면 AWS 기본 엔드포인트)
+	Endpoint string `yaml:"endpoint" mapstructure:"endpoint" json:"endpoint"`
+	
+	// AccessKeyID 액세스 키 ID
+	AccessKeyID string `yaml:"access_key_id" mapstructure:"access_key_id" json:"access_key_id"`
+	
+	// SecretAccessKey 시크릿 액세스 키
+	SecretAccessKey string `yaml:"secret_access_key" mapstructure:"secret_access_key" json:"-"`
+	
+	// ConfigurationSet 발송 이벤트를 추적할 SES 구성 세트
+	ConfigurationSet string `yaml:"configuration_set" mapstructure:"configuration_set" json:"configuration_set"`
+}
+
+// RecoveryConfig는 세션 에러 자동 복구 동작을 정의합니다
+type RecoveryConfig struct {
+	// Enabled 자동 복구 활성화 여부
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Timeout 복구 요청 하나의 최대 시간
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout" json:"timeout"`
+	
+	// TokenFile 재인증 시 OAuth 토큰을 다시 읽을 파일 (설정하면 TokenEnv보다 우선)
+	TokenFile string `yaml:"token_file" mapstructure:"token_file" json:"token_file"`
+	
+	// TokenEnv 재인증 시 OAuth 토큰을 다시 읽을 환경 변수 (비어 있고 TokenFile도 없으면 재인증하지 않음)
+	TokenEnv string `yaml:"token_env" mapstructure:"token_env" json:"token_env"`
+	
+	// TokenTTL 다시 읽은 토큰의 유효 기간
+	TokenTTL time.Duration `yaml:"token_ttl" mapstructure:"token_ttl" json:"token_ttl"`
+}
+
+// RestartPolicyConfig는 자동 재시작 정책 하나를 정의합니다
+type RestartPolicyConfig struct {
+	// Enabled 자동 재시작 활성화 여부 (워크스페이스 정책에서 생략하면 기본 정책을 따름)
+	Enabled *bool `yaml:"enabled" mapstructure:"enabled" json:"enabled,omitempty"`
+	
+	// MaxRestarts 안정적으로 실행되기 전까지 허용할 연속 재시작 횟수 (0이면 무제한)
+	MaxRestarts int `yaml:"max_restarts" mapstructure:"max_restarts" json:"max_restarts" validate:"min=0"`
+	
+	// InitialBackoff 첫 재시작 전 대기 시간
+	InitialBackoff time.Duration `yaml:"initial_backoff" mapstructure:"initial_backoff" json:"initial_backoff"`
+	
+	// MaxBackoff 재시작 대기 시간 상한
+	MaxBackoff time.Duration `yaml:"max_backoff" mapstructure:"max_backoff" json:"max_backoff"`
+	
+	// BackoffMultiplier 재시작할 때마다 대기 시간에 곱할 배수
+	BackoffMultiplier float64 `yaml:"backoff_multiplier" mapstructure:"backoff_multiplier" json:"backoff_multiplier"`
+	
+	// CircuitBreakerThreshold 회로 차단기가 열리는 연속 실패 횟수 (0이면 사용하지 않음)
+	CircuitBreakerThreshold int `yaml:"circuit_breaker_threshold" mapstructure:"circuit_breaker_threshold" json:"circuit_breaker_threshold" validate:"min=0"`
+	
+	// CircuitBreakerCooldown 회로가 열린 뒤 다음 재시작까지 대기 시간 (0이면 재시작 중단)
+	CircuitBreakerCooldown time.Duration `yaml:"circuit_breaker_cooldown" mapstructure:"circuit_breaker_cooldown" json:"circuit_breaker_cooldown"`
+	
+	// StableAfter 이 시간 이상 실행된 뒤 종료되면 재시작 횟수와 백오프를 초기화
+	StableAfter time.Duration `yaml:"stable_after" mapstructure:"stable_after" json:"stable_after"`
+}
+
+// SearchConfig는 통합 검색(/search) 인덱스 설정을 정의합니다
+// 인덱스는 스토리지에서 주기적으로 다시 만들며, 다중 레플리카에서 모든 인스턴스가 같은 결과를 보려면 elasticsearch를 사용합니다.
+type SearchConfig struct {
+	// Backend 인덱스 종류 (memory: 프로세스 안의 역색인, elasticsearch)
+	Backend string `yaml:"backend" mapstructure:"backend" json:"backend" validate:"omitempty,oneof=memory elasticsearch"`
+	
+	// ReindexInterval 인덱스를 다시 만드는 주기 (0이면 시작할 때와 수동 실행할 때만)
+	ReindexInterval time.Duration `yaml:"reindex_interval" mapstructure:"reindex_interval" json:"reindex_interval" validate:"min=0"`
+	
+	// Elasticsearch backend가 elasticsearch일 때의 연결 설정
+	Elasticsearch SearchElasticsearchConfig `yaml:"elasticsearch" mapstructure:"elasticsearch" json:"elasticsearch"`
+}
+
+// SearchElasticsearchConfig는 Elasticsearch 연결 설정을 정의합니다
+type SearchElasticsearchConfig struct {
+	// URL 클러스터 주소 (예: http://localhost:9200)
+	URL string `yaml:"url" mapstructure:"url" json:"url"`
+	
+	// Index 인덱스 이름
+	Index string `yaml:"index" mapstructure:"index" json:"index"`
+	
+	// Username 기본 인증 사용자 (비어 있으면 인증하지 않음)
+	Username string `yaml:"username" mapstructure:"username" json:"username"`
+	
+	// Password 기본 인증 비밀번호
+	Password string `yaml:"password" mapstructure:"password" json:"-"`
+}
+
+// NotificationsConfig는 알림 센터(/notifications) 설정을 정의합니다
+// 메일을 요약으로 받도록 설정한 알림은 DigestInterval마다 사용자별로 한 통의 메일로 보냅니다.
+type NotificationsConfig struct {
+	// DigestInterval 요약 메일을 보내는 주기 (0이면 요약 메일을 보내지 않고 알림 센터에만 남김)
+	DigestInterval time.Duration `yaml:"digest_interval" mapstructure:"digest_interval" json:"digest_interval" validate:"min=0"`
+}
+
+// AnalyticsConfig는 사용량 분석(/admin/analytics) 설정을 정의합니다
+// 대시보드는 원본 태스크 대신 RollupInterval마다 갱신하는 일별 집계만 조회합니다.
+type AnalyticsConfig struct {
+	// RollupInterval 새로 끝난 태스크를 일별 집계에 반영하는 주기 (0이면 수동 실행할 때만 반영)
+	RollupInterval time.Duration `yaml:"rollup_interval" mapstructure:"rollup_interval" json:"rollup_interval" validate:"min=0"`
+}
+
+// ExportsConfig는 대화 기록 내보내기(/exports/transcripts) 설정을 정의합니다
+// 내보낸 문서는 아티팩트 저장소에 저장하므로 artifacts 설정이 필요합니다.
+type ExportsConfig struct {
+	// PDFCommand HTML을 PDF로 인쇄할 헤드리스 브라우저 실행 파일 (Chromium 호환, 예: chromium; 비어 있으면 PDF 형식을 사용할 수 없음)
+	PDFCommand string `yaml:"pdf_command" mapstructure:"pdf_command" json:"pdf_command"`
+	
+	// PDFTimeout PDF 인쇄 한 번의 최대 실행 시간
+	PDFTimeout time.Duration `yaml:"pdf_timeout" mapstructure:"pdf_timeout" json:"pdf_timeout" validate:"min=0"`
+	
+	// Retention 끝난 내보내기 작업 기록을 보관하는 기간 (다운로드 URL은 artifacts.presign_expiry 동안 유효)
+	Retention time.Duration `yaml:"retention" mapstructure:"retention" json:"retention" validate:"min=0"`
+}
+
+// SecretsConfig는 저장소에 넣는 비밀 값(예: 워크스페이스 비밀 환경 변수) 암호화 설정을 정의합니다
+type SecretsConfig struct {
+	// Key 암호화 키를 만드는 문자열 (비어 있으면 api.jwt_secret에서 만들며, 바꾸면 이전에 저장한 비밀 값을 읽을 수 없음)
+	Key string `yaml:"key" mapstructure:"key" json:"-" validate:"omitempty,min=32"`
+}
+
+// AttackDetectionConfig는 공격 탐지기(로그인 무차별 대입, 요청 검사)의 임계값을 정의합니다
+// IP 자동 차단 여부와 Redis 주소는 accounts.lockout 설정을 따릅니다.
+type AttackDetectionConfig struct {
+	// Enabled 모든 요청의 경로, 쿼리, 헤더를 공격 패턴으로 검사하고 공격이면 403으로 거부 (로그인 실패 탐지는 항상 동작)
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// DryRun 탐지한 공격을 로그와 보안 이벤트로만 남기고 차단, 거부, 알림은 하지 않음 (오탐 조정용)
+	DryRun bool `yaml:"dry_run" mapstructure:"dry_run" json:"dry_run"`
+	
+	// MinConfidence 공격으로 판단할 최소 신뢰도 (0~1)
+	MinConfidence float64 `yaml:"min_confidence" mapstructure:"min_confidence" json:"min_confidence" validate:"gt=0,max=1"`
+	
+	// BruteForceThreshold 무차별 대입으로 판단할 IP별 로그인 실패 횟수
+	BruteForceThreshold int `yaml:"brute_force_threshold" mapstructure:"brute_force_threshold" json:"brute_force_threshold" validate:"min=1"`
+	
+	// BruteForceWindow 로그인 실패 횟수를 세는 시간 윈도우
+	BruteForceWindow time.Duration `yaml:"brute_force_window" mapstructure:"brute_force_window" json:"brute_force_window" validate:"gt=0"`
+	
+	// BlockDuration 자동 차단한 IP의 차단 유지 시간
+	BlockDuration time.Duration `yaml:"block_duration" mapstructure:"block_duration" json:"block_duration" validate:"gt=0"`
+	
+	// RouteGroups 경로 접두사별로 기본값 대신 적용할 임계값 (접두사가 가장 긴 그룹 적용)
+	RouteGroups []AttackRouteGroupConfig `yaml:"route_groups" mapstructure:"route_groups" json:"route_groups" validate:"dive"`	
+	// Scan 패턴 검사 바이트 예산과 패턴별 검사 대상
+	Scan AttackScanConfig `yaml:"scan" mapstructure:"scan" json:"scan"`
+}
+
+// AttackScanConfig는 공격 패턴 검사 비용과 검사 대상을 제한합니다
+// 예산을 넘는 부분은 검사하지 않으므로 큰 요청도 예산만큼만 검사합니다.
+type AttackScanConfig struct {
+	// MaxURLBytes URL과 쿼리 문자열을 각각 검사할 최대 바이트 (0이면 검사하지 않음)
+	MaxURLBytes int `yaml:"max_url_bytes" mapstructure:"max_url_bytes" json:"max_url_bytes" validate:"min=0"`
+	
+	// MaxHeaderBytes 헤더 값 하나를 검사할 최대 바이트 (0이면 검사하지 않음)
+	MaxHeaderBytes int `yaml:"max_header_bytes" mapstructure:"max_header_bytes" json:"max_header_bytes" validate:"min=0"`
+	
+	// MaxBodyBytes 본문 앞부분을 검사할 최대 바이트 (0이면 본문을 검사하지 않음)
+	MaxBodyBytes int `yaml:"max_body_bytes" mapstructure:"max_body_bytes" json:"max_body_bytes" validate:"min=0"`
+	
+	// SkipContentTypes 본문을 검사하지 않을 Content-Type 접두사 (diff, 소스 코드, 파일 업로드 등)
+	SkipContentTypes []string `yaml:"skip_content_types" mapstructure:"skip_content_types" json:"skip_content_types"`
+	
+	// PatternTargets 패턴 ID별 검사 대상 (url, query, headers, body; 지정하지 않은 패턴은 기본 대상 검사)
+	PatternTargets map[string][]string `yaml:"pattern_targets" mapstructure:"pattern_targets" json:"pattern_targets" validate:"dive,min=1,dive,oneof=url query headers body"`
+}
+
+// AttackRouteGroupConfig는 경로 접두사별 공격 탐지 임계값을 정의합니다 (0인 값은 기본값을 따름)
+type AttackRouteGroupConfig struct {
+	// Name 그룹 이름 (로그와 보안 이벤트에 기록)
+	Name string `yaml:"name" mapstructure:"name" json:"name" validate:"required"`
+	
+	// PathPrefix 그룹에 속하는 요청 경로 접두사 (예: /api/v1/auth, /ws)
+	PathPrefix string `yaml:"path_prefix" mapstructure:"path_prefix" json:"path_prefix" validate:"required,startswith=/"`
+	
+	// MinConfidence 공격으로 판단할 최소 신뢰도
+	MinConfidence float64 `yaml:"min_confidence" mapstructure:"min_confidence" json:"min_confidence" validate:"min=0,max=1"`
+	
+	// BruteForceThreshold 무차별 대입으로 판단할 IP별 로그인 실패 횟수
+	BruteForceThreshold int `yaml:"brute_force_threshold" mapstructure:"brute_force_threshold" json:"brute_force_threshold" validate:"min=0"`
+	
+	// BruteForceWindow 로그인 실패 횟수를 세는 시간 윈도우
+	BruteForceWindow time.Duration `yaml:"brute_force_window" mapstructure:"brute_force_window" json:"brute_force_window" validate:"min=0"`
+	
+	// BlockDuration 자동 차단한 IP의 차단 유지 시간
+	BlockDuration time.Duration `yaml:"block_duration" mapstructure:"block_duration" json:"block_duration" validate:"min=0"`
+	
+	// DisableAutoBlock 이 그룹에서 탐지한 공격은 IP를 차단하지 않음
+	DisableAutoBlock bool `yaml:"disable_auto_block" mapstructure:"disable_auto_block" json:"disable_auto_block"`
+	
+	// DryRun 이 그룹만 탐지 기록 모드로 동작
+	DryRun bool `yaml:"dry_run" mapstructure:"dry_run" json:"dry_run"`
+}
+
+// CSPConfig는 Content-Security-Policy 헤더와 위반 보고 수집을 정의합니다
+// 사용하면 기본 보안 헤더 대신 지시문별 CSP 헤더를 보내고, 위반 보고는 보고 수가 최대에 이르면 오래된 것부터 버립니다.
+type CSPConfig struct {
+	// Enabled 지시문별 CSP 헤더 사용 (false면 환경별 고정 정책을 보냄)
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Nonce 요청마다 nonce를 만들어 script-src, style-src에 추가 (X-CSP-Nonce 응답 헤더로 전달)
+	Nonce bool `yaml:"nonce" mapstructure:"nonce" json:"nonce"`
+	
+	// ReportOnly 정책을 강제하지 않고 위반만 보고 (Content-Security-Policy-Report-Only 헤더)
+	ReportOnly bool `yaml:"report_only" mapstructure:"report_only" json:"report_only"`
+	
+	// ReportURI 브라우저가 위반 보고를 보낼 주소 (비우면 보고하지 않음)
+	ReportURI string `yaml:"report_uri" mapstructure:"report_uri" json:"report_uri"`
+	
+	// MaxReports 보안 대시보드용으로 보관할 최대 위반 보고 수
+	MaxReports int `yaml:"max_reports" mapstructure:"max_reports" json:"max_reports" validate:"min=1,max=100000"`
+}
+
+// ACMEConfig는 ACME(Let's Encrypt) 인증서 자동 발급과 갱신을 정의합니다
+// 사용하면 api.tls_cert_path, api.tls_key_path 대신 발급한 인증서로 HTTPS를 제공하고, HTTP-01 챌린지는 http_port에서 받습니다.
+type ACMEConfig struct {
+	// Enabled ACME로 인증서 발급 (켜면 api.tls_enabled와 관계없이 HTTPS 사용)
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Domains 인증서를 발급할 도메인 (이 도메인으로 들어온 요청만 발급)
+	Domains []string `yaml:"domains" mapstructure:"domains" json:"domains" validate:"omitempty,dive,fqdn"`
+	
+	// Email 만료와 정책 변경 알림을 받을 ACME 계정 이메일
+	Email string `yaml:"email" mapstructure:"email" json:"email" validate:"omitempty,email"`
+	
+	// DirectoryURL ACME 디렉토리 주소 (비우면 Let's Encrypt 운영 서버)
+	DirectoryURL string `yaml:"directory_url" mapstructure:"directory_url" json:"directory_url" validate:"omitempty,url"`
+	
+	// CacheDir 계정 키와 발급한 인증서를 보관할 디렉토리
+	CacheDir string `yaml:"cache_dir" mapstructure:"cache_dir" json:"cache_dir"`
+	
+	// HTTPPort HTTP-01 챌린지를 받을 포트 (그 밖의 요청은 HTTPS로 리다이렉트)
+	HTTPPort int `yaml:"http_port" mapstructure:"http_port" json:"http_port" validate:"min=1,max=65535"`
+	
+	// RenewBefore 만료 이 시간 전에 인증서 갱신
+	RenewBefore time.Duration `yaml:"renew_before" mapstructure:"renew_before" json:"renew_before" validate:"min=0"`
+}
+
+// WebUIConfig는 API 서버가 빌드된 웹 UI를 직접 제공하는 설정을 정의합니다
+// API, WebSocket 경로가 아닌 GET 요청은 웹 UI 파일로, 없는 경로는 index.html로 응답합니다 (SPA 라우팅).
+type WebUIConfig struct {
+	// Enabled 웹 UI 제공
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" json:"enabled"`
+	
+	// Dir 웹 UI 빌드 디렉토리 (비우면 바이너리에 포함한 웹 UI)
+	Dir string `yaml:"dir" mapstructure:"dir" json:"dir"`
+	
+	// AssetMaxAge 파일 이름에 해시가 붙은 /assets/ 파일의 브라우저 캐시 시간
+	AssetMaxAge time.Duration `yaml:"asset_max_age" mapstructure:"asset_max_age" json:"asset_max_age" validate:"min=0"`
+}
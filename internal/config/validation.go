@@ -63,7 +63,7 @@ func (cv *ConfigValidator) validateBusinessRules(cfg *Config) error {
 	}
 	
 	// TLS 설정 검증
-	if cfg.API.TLSEnabled {
+	if cfg.API.TLSEnabled && !cfg.API.ACME.Enabled {
 		if cfg.API.TLSCertPath == "" || cfg.API.TLSKeyPath == "" {
 			return fmt.Errorf("TLS가 활성화되었지만 인증서 경로가 설정되지 않았습니다")
 		}
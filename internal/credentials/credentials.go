@@ -0,0 +1,158 @@
+// Package credentials는 CLI가 원격 API 서버에 로그인해 받은 토큰을 저장합니다.
+// 운영체제 키체인(macOS Keychain, Linux Secret Service)을 우선 사용하고,
+// 키체인 도구가 없으면 사용자만 읽을 수 있는 파일에 저장합니다.
+package credentials
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound 서버에 대해 저장된 로그인 정보가 없음
+var ErrNotFound = errors.New("저장된 로그인 정보가 없습니다")
+
+// Credentials 원격 서버 로그인 정보
+type Credentials struct {
+	Server       string    `json:"server"`
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	TokenType    string    `json:"token_type"`
+	Scope        string    `json:"scope,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// Store 로그인 정보 저장소 (서버 주소별로 하나씩 저장)
+type Store interface {
+	// Name 저장 위치 설명 (사용자 안내용)
+	Name() string
+	Get(server string) (*Credentials, error)
+	Set(creds *Credentials) error
+	Delete(server string) error
+}
+
+// NewStore 사용할 수 있는 운영체제 키체인 저장소를 반환하고, 없으면 ~/.aicli/credentials.json 파일 저장소를 반환합니다
+func NewStore() (Store, error) {
+	if store := NewKeychainStore(); store != nil {
+		return store, nil
+	}
+	return NewDefaultFileStore()
+}
+
+// NormalizeServer 저장 키로 쓰는 서버 주소 (끝의 /를 제거)
+func NormalizeServer(server string) string {
+	return strings.TrimRight(strings.TrimSpace(server), "/")
+}
+
+// FileStore 로그인 정보를 JSON 파일 하나에 저장 (파일 권한 0600)
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileStore 새로운 파일 저장소 생성
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// NewDefaultFileStore ~/.aicli/credentials.json 파일 저장소 생성
+func NewDefaultFileStore() (*FileStore, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("홈 디렉토리를 찾을 수 없습니다: %w", err)
+	}
+	return NewFileStore(filepath.Join(home, ".aicli", "credentials.json")), nil
+}
+
+// Name 저장 위치 설명
+func (s *FileStore) Name() string {
+	return s.path
+}
+
+// Get 서버의 로그인 정보 조회
+func (s *FileStore) Get(server string) (*Credentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	creds, ok := all[NormalizeServer(server)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return creds, nil
+}
+
+// Set 로그인 정보 저장 (같은 서버의 기존 정보는 덮어씀)
+func (s *FileStore) Set(creds *Credentials) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all, err := s.load()
+	if err != nil {
+		return err
+	}
+	creds.Server = NormalizeServer(creds.Server)
+	all[creds.Server] = creds
+	return s.save(all)
+}
+
+// Delete 서버의 로그인 정보 삭제
+func (s *FileStore) Delete(server string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all, err := s.load()
+	if err != nil {
+		return err
+	}
+	server = NormalizeServer(server)
+	if _, ok := all[server]; !ok {
+		return ErrNotFound
+	}
+	delete(all, server)
+	return s.save(all)
+}
+
+// load 파일 전체를 읽음 (파일이 없으면 빈 목록)
+func (s *FileStore) load() (map[string]*Credentials, error) {
+	all := make(map[string]*Credentials)
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("로그인 정보 파일을 읽을 수 없습니다: %w", err)
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("로그인 정보 파일 형식이 잘못되었습니다: %w", err)
+	}
+	return all, nil
+}
+
+// save 임시 파일에 쓴 뒤 교체해 중간에 실패해도 기존 파일을 보존
+func (s *FileStore) save(all map[string]*Credentials) error {
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("로그인 정보 디렉토리를 만들 수 없습니다: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("로그인 정보를 저장할 수 없습니다: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("로그인 정보를 저장할 수 없습니다: %w", err)
+	}
+	return nil
+}
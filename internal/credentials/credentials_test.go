@@ -0,0 +1,107 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCredentials(server string) *Credentials {
+	return &Credentials{
+		Server:       server,
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		TokenType:    "Bearer",
+		Scope:        "read write",
+		ExpiresAt:    time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "aicli", "credentials.json")
+	store := NewFileStore(path)
+
+	_, err := store.Get("https://aicli.example.com")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set(testCredentials("https://aicli.example.com/")))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	creds, err := store.Get("https://aicli.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "https://aicli.example.com", creds.Server)
+	assert.Equal(t, "access", creds.AccessToken)
+
+	require.NoError(t, store.Delete("https://aicli.example.com/"))
+	assert.ErrorIs(t, store.Delete("https://aicli.example.com"), ErrNotFound)
+}
+
+// fakeSecretTool secret-tool처럼 동작하는 메모리 키링
+type fakeSecretTool struct {
+	items map[string]string
+	calls [][]string
+}
+
+func (f *fakeSecretTool) run(stdin string, name string, args ...string) (string, error) {
+	f.calls = append(f.calls, append([]string{name}, args...))
+	server := args[len(args)-1]
+	switch args[0] {
+	case "store":
+		f.items[server] = stdin
+	case "lookup":
+		return f.items[server], nil
+	case "clear":
+		delete(f.items, server)
+	}
+	return "", nil
+}
+
+func TestKeychainStore(t *testing.T) {
+	backend, ok := keychainBackendFor("linux")
+	require.True(t, ok)
+	fake := &fakeSecretTool{items: make(map[string]string)}
+	store := &KeychainStore{backend: backend, run: fake.run}
+
+	_, err := store.Get("https://aicli.example.com")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	expected := testCredentials("https://aicli.example.com/")
+	require.NoError(t, store.Set(expected))
+	for _, arg := range fake.calls[len(fake.calls)-1] {
+		assert.NotContains(t, arg, "access", "비밀 값은 명령 인자로 전달하지 않음")
+	}
+
+	creds, err := store.Get("https://aicli.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, expected.AccessToken, creds.AccessToken)
+	assert.Equal(t, expected.Scope, creds.Scope)
+	assert.True(t, expected.ExpiresAt.Equal(creds.ExpiresAt))
+
+	require.NoError(t, store.Delete("https://aicli.example.com"))
+	_, err = store.Get("https://aicli.example.com")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, ok = keychainBackendFor("windows")
+	assert.False(t, ok)
+}
+
+func TestKeychainBackend_DarwinSecretOnStdin(t *testing.T) {
+	backend, ok := keychainBackendFor("darwin")
+	require.True(t, ok)
+
+	secret := `{"refresh_token":"r\\t\"x"}`
+	name, args, stdin := backend.set("https://aicli.example.com", secret)
+
+	assert.Equal(t, "security", name)
+	assert.Equal(t, []string{"-i"}, args, "비밀 값은 명령 인자로 전달하지 않음")
+	assert.True(t, strings.HasPrefix(stdin, "add-generic-password -U "))
+	assert.True(t, strings.HasSuffix(stdin, "\n"))
+	assert.Contains(t, stdin, `-w "{\"refresh_token\":\"r\\\\t\\\"x\"}"`)
+}
@@ -0,0 +1,183 @@
+package credentials
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// keychainService 키체인 항목의 서비스 이름
+const keychainService = "aicli-web"
+
+// commandRunner 외부 명령을 실행하고 표준 출력을 반환 (테스트에서 교체)
+type commandRunner func(stdin string, name string, args ...string) (string, error)
+
+// keychainBackend 운영체제별 키체인 명령
+type keychainBackend struct {
+	name   string
+	get    func(server string) (string, []string)
+	set    func(server, secret string) (string, []string, string)
+	delete func(server string) (string, []string)
+	// notFound 항목이 없어서 실패한 경우인지 판단
+	notFound func(err error, stdout string) bool
+}
+
+// KeychainStore 운영체제 키체인에 로그인 정보를 저장
+// 별도 라이브러리 없이 macOS는 security, Linux는 secret-tool(libsecret) 명령을 사용합니다.
+type KeychainStore struct {
+	backend keychainBackend
+	run     commandRunner
+}
+
+// NewKeychainStore 현재 운영체제의 키체인 저장소를 반환 (키체인 명령이 없으면 nil)
+// Windows 자격 증명 관리자는 저장한 비밀 값을 읽는 기본 명령이 없어 지원하지 않습니다.
+func NewKeychainStore() *KeychainStore {
+	backend, ok := keychainBackendFor(runtime.GOOS)
+	if !ok {
+		return nil
+	}
+	if _, err := exec.LookPath(backend.name); err != nil {
+		return nil
+	}
+	return &KeychainStore{backend: backend, run: runCommand}
+}
+
+// Name 저장 위치 설명
+func (s *KeychainStore) Name() string {
+	switch s.backend.name {
+	case "security":
+		return "macOS Keychain"
+	default:
+		return "Secret Service keyring"
+	}
+}
+
+// Get 서버의 로그인 정보 조회
+func (s *KeychainStore) Get(server string) (*Credentials, error) {
+	name, args := s.backend.get(NormalizeServer(server))
+	out, err := s.run("", name, args...)
+	if err != nil || strings.TrimSpace(out) == "" {
+		if s.backend.notFound(err, out) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("키체인에서 로그인 정보를 읽을 수 없습니다: %w", err)
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal([]byte(strings.TrimSpace(out)), &creds); err != nil {
+		return nil, fmt.Errorf("키체인의 로그인 정보 형식이 잘못되었습니다: %w", err)
+	}
+	return &creds, nil
+}
+
+// Set 로그인 정보 저장 (같은 서버의 기존 항목은 덮어씀)
+func (s *KeychainStore) Set(creds *Credentials) error {
+	creds.Server = NormalizeServer(creds.Server)
+	secret, err := json.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	name, args, stdin := s.backend.set(creds.Server, string(secret))
+	if _, err := s.run(stdin, name, args...); err != nil {
+		return fmt.Errorf("키체인에 로그인 정보를 저장할 수 없습니다: %w", err)
+	}
+	return nil
+}
+
+// Delete 서버의 로그인 정보 삭제
+func (s *KeychainStore) Delete(server string) error {
+	name, args := s.backend.delete(NormalizeServer(server))
+	out, err := s.run("", name, args...)
+	if err != nil {
+		if s.backend.notFound(err, out) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("키체인에서 로그인 정보를 삭제할 수 없습니다: %w", err)
+	}
+	return nil
+}
+
+// keychainBackendFor 운영체제별 키체인 명령
+func keychainBackendFor(goos string) (keychainBackend, bool) {
+	switch goos {
+	case "darwin":
+		return keychainBackend{
+			name: "security",
+			get: func(server string) (string, []string) {
+				return "security", []string{"find-generic-password", "-s", keychainService, "-a", server, "-w"}
+			},
+			set: func(server, secret string) (string, []string, string) {
+				// -U: 같은 항목이 있으면 갱신
+				// add-generic-password는 비밀 값을 인자로만 받으므로 -i(대화형 모드)로 명령 전체를 표준 입력에 전달해
+				// 프로세스 목록에 비밀 값이 드러나지 않도록 합니다.
+				command := strings.Join([]string{"add-generic-password", "-U",
+					"-s", securityQuote(keychainService), "-a", securityQuote(server),
+					"-l", securityQuote("aicli (" + server + ")"), "-w", securityQuote(secret)}, " ")
+				return "security", []string{"-i"}, command + "\n"
+			},
+			delete: func(server string) (string, []string) {
+				return "security", []string{"delete-generic-password", "-s", keychainService, "-a", server}
+			},
+			// security는 항목이 없으면 종료 코드 44
+			notFound: func(err error, _ string) bool {
+				var exitErr *exec.ExitError
+				return errors.As(err, &exitErr) && exitErr.ExitCode() == 44
+			},
+		}, true
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return keychainBackend{
+			name: "secret-tool",
+			get: func(server string) (string, []string) {
+				return "secret-tool", []string{"lookup", "service", keychainService, "server", server}
+			},
+			set: func(server, secret string) (string, []string, string) {
+				// 비밀 값은 명령 인자에 드러나지 않도록 표준 입력으로 전달
+				return "secret-tool", []string{"store", "--label=aicli (" + server + ")", "service", keychainService, "server", server}, secret
+			},
+			delete: func(server string) (string, []string) {
+				return "secret-tool", []string{"clear", "service", keychainService, "server", server}
+			},
+			// secret-tool은 항목이 없으면 아무것도 출력하지 않고 종료 코드 1 (clear는 성공)
+			notFound: func(err error, stdout string) bool {
+				if err == nil {
+					return strings.TrimSpace(stdout) == ""
+				}
+				var exitErr *exec.ExitError
+				return errors.As(err, &exitErr) && exitErr.ExitCode() == 1 && len(exitErr.Stderr) == 0
+			},
+		}, true
+	default:
+		return keychainBackend{}, false
+	}
+}
+
+// runCommand 명령을 실행하고 표준 출력을 반환 (실패하면 표준 오류를 오류 메시지에 포함)
+func runCommand(stdin string, name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			exitErr.Stderr = stderr.Bytes()
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return stdout.String(), fmt.Errorf("%w: %s", err, msg)
+			}
+		}
+		return stdout.String(), err
+	}
+	return stdout.String(), nil
+}
+
+// securityQuote security -i 명령 줄에서 하나의 인자로 읽히도록 큰따옴표로 감쌈
+func securityQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
@@ -0,0 +1,560 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+)
+
+// 의존성 캐시 관련 에러들
+var (
+	ErrCacheNotFound = errors.New("dependency cache not found")
+	ErrCacheInUse    = errors.New("dependency cache is in use")
+)
+
+// CacheBackend 의존성 캐시 저장 방식
+type CacheBackend string
+
+const (
+	// CacheBackendVolume Docker named volume에 저장
+	CacheBackendVolume CacheBackend = "volume"
+	// CacheBackendHost 호스트 캐시 디렉토리를 바인드 마운트
+	CacheBackendHost CacheBackend = "host"
+)
+
+// DependencyCache 컨테이너를 다시 만들어도 유지할 의존성 디렉토리
+type DependencyCache struct {
+	Name string            `yaml:"name" json:"name"`
+	Path string            `yaml:"path" json:"path"`                   // 컨테이너 안 경로
+	Env  map[string]string `yaml:"env,omitempty" json:"env,omitempty"` // 캐시 경로를 알려줄 환경 변수
+}
+
+// DefaultDependencyCaches 기본 의존성 캐시 (node_modules, .venv, Go 모듈 캐시)
+func DefaultDependencyCaches() []DependencyCache {
+	return []DependencyCache{
+		{Name: "node_modules", Path: "/workspace/node_modules"},
+		{Name: "venv", Path: "/workspace/.venv"},
+		{Name: "gomod", Path: "/go/pkg/mod", Env: map[string]string{"GOMODCACHE": "/go/pkg/mod"}},
+	}
+}
+
+// CacheConfig 의존성 캐시 설정
+type CacheConfig struct {
+	Caches           []DependencyCache `yaml:"caches" json:"caches"`
+	MaxTotalSize     int64             `yaml:"max_total_size" json:"max_total_size"`         // 전체 캐시 상한 (bytes, 0이면 무제한)
+	MaxWorkspaceSize int64             `yaml:"max_workspace_size" json:"max_workspace_size"` // 워크스페이스별 상한 (bytes, 0이면 무제한)
+}
+
+// CacheEntry 워크스페이스 의존성 캐시 정보
+type CacheEntry struct {
+	WorkspaceID string       `json:"workspace_id"`
+	Name        string       `json:"name"`
+	Path        string       `json:"path,omitempty"`
+	Backend     CacheBackend `json:"backend"`
+	Source      string       `json:"source"` // 볼륨 이름 또는 호스트 디렉토리
+	SizeBytes   int64        `json:"size_bytes"`
+	CreatedAt   time.Time    `json:"created_at"`
+	LastUsed    time.Time    `json:"last_used"`
+	InUse       bool         `json:"in_use"`
+}
+
+// CacheUsage 의존성 캐시 사용량
+type CacheUsage struct {
+	Entries          []CacheEntry `json:"entries"`
+	TotalSize        int64        `json:"total_size"`
+	MaxTotalSize     int64        `json:"max_total_size,omitempty"`
+	MaxWorkspaceSize int64        `json:"max_workspace_size,omitempty"`
+}
+
+// CacheStore 의존성 캐시 저장소
+type CacheStore interface {
+	Backend() CacheBackend
+	// Ensure 캐시 저장소를 만들고 마운트 원본(볼륨 이름 또는 디렉토리)을 반환합니다
+	Ensure(ctx context.Context, workspaceID, name string) (string, error)
+	// Mount 원본을 컨테이너 경로에 연결하는 마운트를 만듭니다
+	Mount(source, target string) mount.Mount
+	// List 저장된 캐시와 크기를 조회합니다
+	List(ctx context.Context) ([]CacheEntry, error)
+	Remove(ctx context.Context, entry CacheEntry) error
+}
+
+// CacheManager 워크스페이스별 의존성 캐시를 관리합니다.
+// 크기 상한을 넘으면 사용 중이지 않은 캐시부터 가장 오래 쓰이지 않은 순서로 제거합니다.
+type CacheManager struct {
+	store  CacheStore
+	config CacheConfig
+
+	mu       sync.Mutex
+	lastUsed map[string]time.Time // 캐시 키별 마지막 사용 시각
+	inUse    map[string]bool      // 컨테이너에 마운트된 워크스페이스
+}
+
+// NewCacheManager 새로운 의존성 캐시 관리자를 생성합니다.
+func NewCacheManager(store CacheStore, config CacheConfig) *CacheManager {
+	if config.Caches == nil {
+		config.Caches = DefaultDependencyCaches()
+	}
+	return &CacheManager{
+		store:    store,
+		config:   config,
+		lastUsed: make(map[string]time.Time),
+		inUse:    make(map[string]bool),
+	}
+}
+
+func cacheKey(workspaceID, name string) string {
+	return workspaceID + "/" + name
+}
+
+// Prepare 워크스페이스 컨테이너에 연결할 캐시 마운트와 환경 변수를 준비합니다.
+// 워크스페이스 상한을 넘은 캐시는 마운트 전에 비우고, 전체 상한은 다른 유휴 캐시를 제거해 맞춥니다.
+func (cm *CacheManager) Prepare(ctx context.Context, workspaceID string) ([]mount.Mount, map[string]string, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	entries, err := cm.refreshLocked(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := cm.enforceWorkspaceQuotaLocked(ctx, workspaceID, entries); err != nil {
+		return nil, nil, err
+	}
+
+	mounts := make([]mount.Mount, 0, len(cm.config.Caches))
+	env := make(map[string]string)
+	now := time.Now()
+	for _, cache := range cm.config.Caches {
+		source, err := cm.store.Ensure(ctx, workspaceID, cache.Name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("ensure cache %s: %w", cache.Name, err)
+		}
+		mounts = append(mounts, cm.store.Mount(source, cache.Path))
+		for key, value := range cache.Env {
+			env[key] = value
+		}
+		cm.lastUsed[cacheKey(workspaceID, cache.Name)] = now
+	}
+	cm.inUse[workspaceID] = true
+
+	if cm.config.MaxTotalSize > 0 {
+		entries, err := cm.refreshLocked(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := cm.enforceTotalQuotaLocked(ctx, entries); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return mounts, env, nil
+}
+
+// Release 워크스페이스 컨테이너가 삭제되어 캐시를 더 이상 사용하지 않음을 기록합니다.
+func (cm *CacheManager) Release(workspaceID string) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	delete(cm.inUse, workspaceID)
+	now := time.Now()
+	for _, cache := range cm.config.Caches {
+		cm.lastUsed[cacheKey(workspaceID, cache.Name)] = now
+	}
+}
+
+// List 캐시 목록을 조회합니다 (workspaceID가 비어 있으면 전체)
+func (cm *CacheManager) List(ctx context.Context, workspaceID string) ([]CacheEntry, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	entries, err := cm.refreshLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if workspaceID == "" {
+		return entries, nil
+	}
+
+	var result []CacheEntry
+	for _, entry := range entries {
+		if entry.WorkspaceID == workspaceID {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// Usage 전체 캐시 사용량을 조회합니다.
+func (cm *CacheManager) Usage(ctx context.Context) (*CacheUsage, error) {
+	entries, err := cm.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &CacheUsage{
+		Entries:          entries,
+		MaxTotalSize:     cm.config.MaxTotalSize,
+		MaxWorkspaceSize: cm.config.MaxWorkspaceSize,
+	}
+	for _, entry := range entries {
+		usage.TotalSize += entry.SizeBytes
+	}
+	return usage, nil
+}
+
+// Purge 워크스페이스 캐시를 삭제합니다 (name이 비어 있으면 워크스페이스의 모든 캐시)
+// 컨테이너에 마운트된 캐시는 force가 아니면 삭제하지 않습니다.
+func (cm *CacheManager) Purge(ctx context.Context, workspaceID, name string, force bool) ([]CacheEntry, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if cm.inUse[workspaceID] && !force {
+		return nil, ErrCacheInUse
+	}
+
+	entries, err := cm.refreshLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []CacheEntry
+	for _, entry := range entries {
+		if entry.WorkspaceID != workspaceID || (name != "" && entry.Name != name) {
+			continue
+		}
+		if err := cm.removeLocked(ctx, entry); err != nil {
+			return removed, err
+		}
+		removed = append(removed, entry)
+	}
+
+	if name != "" && len(removed) == 0 {
+		return nil, ErrCacheNotFound
+	}
+	return removed, nil
+}
+
+// EnforceQuota 크기 상한을 넘은 유휴 캐시를 제거하고 제거된 캐시를 반환합니다.
+func (cm *CacheManager) EnforceQuota(ctx context.Context) ([]CacheEntry, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	entries, err := cm.refreshLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var evicted []CacheEntry
+	if cm.config.MaxWorkspaceSize > 0 {
+		workspaces := make(map[string]bool)
+		for _, entry := range entries {
+			workspaces[entry.WorkspaceID] = true
+		}
+		for workspaceID := range workspaces {
+			if cm.inUse[workspaceID] {
+				continue
+			}
+			removed, err := cm.enforceWorkspaceQuotaLocked(ctx, workspaceID, entries)
+			evicted = append(evicted, removed...)
+			if err != nil {
+				return evicted, err
+			}
+		}
+		if len(evicted) > 0 {
+			if entries, err = cm.refreshLocked(ctx); err != nil {
+				return evicted, err
+			}
+		}
+	}
+
+	removed, err := cm.enforceTotalQuotaLocked(ctx, entries)
+	return append(evicted, removed...), err
+}
+
+// enforceWorkspaceQuotaLocked 워크스페이스 캐시가 상한을 넘으면 오래된 캐시부터 비웁니다.
+func (cm *CacheManager) enforceWorkspaceQuotaLocked(ctx context.Context, workspaceID string, entries []CacheEntry) ([]CacheEntry, error) {
+	if cm.config.MaxWorkspaceSize <= 0 {
+		return nil, nil
+	}
+
+	var owned []CacheEntry
+	var total int64
+	for _, entry := range entries {
+		if entry.WorkspaceID == workspaceID {
+			owned = append(owned, entry)
+			total += entry.SizeBytes
+		}
+	}
+	return cm.evictLocked(ctx, owned, total, cm.config.MaxWorkspaceSize)
+}
+
+// enforceTotalQuotaLocked 전체 캐시가 상한을 넘으면 유휴 캐시를 오래된 순서로 제거합니다.
+func (cm *CacheManager) enforceTotalQuotaLocked(ctx context.Context, entries []CacheEntry) ([]CacheEntry, error) {
+	if cm.config.MaxTotalSize <= 0 {
+		return nil, nil
+	}
+
+	var idle []CacheEntry
+	var total int64
+	for _, entry := range entries {
+		total += entry.SizeBytes
+		if !entry.InUse {
+			idle = append(idle, entry)
+		}
+	}
+	return cm.evictLocked(ctx, idle, total, cm.config.MaxTotalSize)
+}
+
+// evictLocked 후보 캐시를 LRU 순서로 제거해 total을 limit 이하로 줄입니다.
+func (cm *CacheManager) evictLocked(ctx context.Context, candidates []CacheEntry, total, limit int64) ([]CacheEntry, error) {
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].LastUsed.Before(candidates[j].LastUsed)
+	})
+
+	var evicted []CacheEntry
+	for _, entry := range candidates {
+		if total <= limit {
+			break
+		}
+		if err := cm.removeLocked(ctx, entry); err != nil {
+			return evicted, err
+		}
+		total -= entry.SizeBytes
+		evicted = append(evicted, entry)
+	}
+	return evicted, nil
+}
+
+func (cm *CacheManager) removeLocked(ctx context.Context, entry CacheEntry) error {
+	if err := cm.store.Remove(ctx, entry); err != nil {
+		return fmt.Errorf("remove cache %s/%s: %w", entry.WorkspaceID, entry.Name, err)
+	}
+	delete(cm.lastUsed, cacheKey(entry.WorkspaceID, entry.Name))
+	return nil
+}
+
+// refreshLocked 저장소의 캐시 목록에 사용 상태와 마지막 사용 시각을 합칩니다.
+func (cm *CacheManager) refreshLocked(ctx context.Context) ([]CacheEntry, error) {
+	entries, err := cm.store.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list caches: %w", err)
+	}
+
+	paths := make(map[string]string, len(cm.config.Caches))
+	for _, cache := range cm.config.Caches {
+		paths[cache.Name] = cache.Path
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		entry.Path = paths[entry.Name]
+		entry.InUse = cm.inUse[entry.WorkspaceID]
+		if used, ok := cm.lastUsed[cacheKey(entry.WorkspaceID, entry.Name)]; ok && used.After(entry.LastUsed) {
+			entry.LastUsed = used
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].WorkspaceID != entries[j].WorkspaceID {
+			return entries[i].WorkspaceID < entries[j].WorkspaceID
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+// HostCacheStore 호스트 디렉토리(<root>/<워크스페이스 ID>/<캐시 이름>)에 캐시를 저장합니다.
+type HostCacheStore struct {
+	root string
+}
+
+// NewHostCacheStore 새로운 호스트 캐시 저장소를 생성합니다.
+func NewHostCacheStore(root string) *HostCacheStore {
+	return &HostCacheStore{root: root}
+}
+
+// Backend 저장 방식을 반환합니다.
+func (s *HostCacheStore) Backend() CacheBackend {
+	return CacheBackendHost
+}
+
+// Ensure 캐시 디렉토리를 만듭니다.
+func (s *HostCacheStore) Ensure(ctx context.Context, workspaceID, name string) (string, error) {
+	dir := filepath.Join(s.root, workspaceID, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// Mount 캐시 디렉토리를 바인드 마운트합니다.
+func (s *HostCacheStore) Mount(source, target string) mount.Mount {
+	return mount.Mount{
+		Type:   mount.TypeBind,
+		Source: source,
+		Target: target,
+		BindOptions: &mount.BindOptions{
+			Propagation: mount.PropagationRPrivate,
+		},
+	}
+}
+
+// List 캐시 디렉토리와 크기를 조회합니다.
+func (s *HostCacheStore) List(ctx context.Context) ([]CacheEntry, error) {
+	workspaces, err := os.ReadDir(s.root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []CacheEntry
+	for _, workspace := range workspaces {
+		if !workspace.IsDir() {
+			continue
+		}
+		caches, err := os.ReadDir(filepath.Join(s.root, workspace.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, cache := range caches {
+			if !cache.IsDir() {
+				continue
+			}
+			dir := filepath.Join(s.root, workspace.Name(), cache.Name())
+			info, err := cache.Info()
+			if err != nil {
+				return nil, err
+			}
+			size, err := dirSize(dir)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, CacheEntry{
+				WorkspaceID: workspace.Name(),
+				Name:        cache.Name(),
+				Backend:     CacheBackendHost,
+				Source:      dir,
+				SizeBytes:   size,
+				CreatedAt:   info.ModTime(),
+				LastUsed:    info.ModTime(),
+			})
+		}
+	}
+	return entries, nil
+}
+
+// Remove 캐시 디렉토리를 삭제합니다 (워크스페이스 디렉토리가 비면 함께 삭제)
+func (s *HostCacheStore) Remove(ctx context.Context, entry CacheEntry) error {
+	dir := filepath.Join(s.root, entry.WorkspaceID, entry.Name)
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	os.Remove(filepath.Join(s.root, entry.WorkspaceID))
+	return nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// VolumeCacheStore Docker named volume에 캐시를 저장합니다.
+type VolumeCacheStore struct {
+	client *Client
+}
+
+// NewVolumeCacheStore 새로운 볼륨 캐시 저장소를 생성합니다.
+func NewVolumeCacheStore(client *Client) *VolumeCacheStore {
+	return &VolumeCacheStore{client: client}
+}
+
+// Backend 저장 방식을 반환합니다.
+func (s *VolumeCacheStore) Backend() CacheBackend {
+	return CacheBackendVolume
+}
+
+func (s *VolumeCacheStore) volumeName(workspaceID, name string) string {
+	return fmt.Sprintf("%s-cache-%s-%s", s.client.labelPrefix, workspaceID, name)
+}
+
+// Ensure 캐시 볼륨을 만듭니다 (이미 있으면 그대로 사용)
+func (s *VolumeCacheStore) Ensure(ctx context.Context, workspaceID, name string) (string, error) {
+	vol, err := s.client.cli.VolumeCreate(ctx, volume.CreateOptions{
+		Name: s.volumeName(workspaceID, name),
+		Labels: map[string]string{
+			s.client.labelKey("managed"):      "true",
+			s.client.labelKey("type"):         "cache",
+			s.client.labelKey("workspace.id"): workspaceID,
+			s.client.labelKey("cache.name"):   name,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return vol.Name, nil
+}
+
+// Mount 캐시 볼륨을 마운트합니다.
+func (s *VolumeCacheStore) Mount(source, target string) mount.Mount {
+	return mount.Mount{
+		Type:   mount.TypeVolume,
+		Source: source,
+		Target: target,
+	}
+}
+
+// List 캐시 볼륨과 디스크 사용량을 조회합니다.
+func (s *VolumeCacheStore) List(ctx context.Context) ([]CacheEntry, error) {
+	usage, err := s.client.cli.DiskUsage(ctx, types.DiskUsageOptions{
+		Types: []types.DiskUsageObject{types.VolumeObject},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	typeKey := s.client.labelKey("type")
+	var entries []CacheEntry
+	for _, vol := range usage.Volumes {
+		if vol == nil || vol.Labels[typeKey] != "cache" {
+			continue
+		}
+		created, _ := time.Parse(time.RFC3339, vol.CreatedAt)
+		entry := CacheEntry{
+			WorkspaceID: vol.Labels[s.client.labelKey("workspace.id")],
+			Name:        vol.Labels[s.client.labelKey("cache.name")],
+			Backend:     CacheBackendVolume,
+			Source:      vol.Name,
+			CreatedAt:   created,
+			LastUsed:    created,
+		}
+		if vol.UsageData != nil && vol.UsageData.Size > 0 {
+			entry.SizeBytes = vol.UsageData.Size
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Remove 캐시 볼륨을 삭제합니다.
+func (s *VolumeCacheStore) Remove(ctx context.Context, entry CacheEntry) error {
+	return s.client.cli.VolumeRemove(ctx, entry.Source, false)
+}
@@ -0,0 +1,117 @@
+package docker
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fillCache 캐시 디렉토리에 지정한 크기의 파일을 씁니다
+func fillCache(t *testing.T, root, workspaceID, name string, size int) {
+	t.Helper()
+	dir := filepath.Join(root, workspaceID, name)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data"), make([]byte, size), 0644))
+}
+
+func cacheNames(entries []CacheEntry) []string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.WorkspaceID+"/"+entry.Name)
+	}
+	return names
+}
+
+func TestCacheManager_Prepare(t *testing.T) {
+	root := t.TempDir()
+	cm := NewCacheManager(NewHostCacheStore(root), CacheConfig{})
+	ctx := context.Background()
+
+	mounts, env, err := cm.Prepare(ctx, "ws-1")
+	require.NoError(t, err)
+	require.Len(t, mounts, 3)
+	assert.Equal(t, mount.TypeBind, mounts[0].Type)
+	assert.Equal(t, filepath.Join(root, "ws-1", "node_modules"), mounts[0].Source)
+	assert.Equal(t, "/workspace/node_modules", mounts[0].Target)
+	assert.Equal(t, "/go/pkg/mod", env["GOMODCACHE"])
+
+	entries, err := cm.List(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ws-1/gomod", "ws-1/node_modules", "ws-1/venv"}, cacheNames(entries))
+	for _, entry := range entries {
+		assert.True(t, entry.InUse)
+		assert.Equal(t, CacheBackendHost, entry.Backend)
+	}
+
+	cm.Release("ws-1")
+	entries, err = cm.List(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.False(t, entries[0].InUse)
+}
+
+func TestCacheManager_EnforceQuota(t *testing.T) {
+	root := t.TempDir()
+	config := CacheConfig{
+		Caches:           []DependencyCache{{Name: "node_modules", Path: "/workspace/node_modules"}, {Name: "venv", Path: "/workspace/.venv"}},
+		MaxTotalSize:     250,
+		MaxWorkspaceSize: 150,
+	}
+	cm := NewCacheManager(NewHostCacheStore(root), config)
+	ctx := context.Background()
+
+	fillCache(t, root, "ws-old", "node_modules", 100)
+	fillCache(t, root, "ws-mid", "node_modules", 100)
+	fillCache(t, root, "ws-mid", "venv", 100)
+	fillCache(t, root, "ws-new", "node_modules", 100)
+
+	base := time.Now().Add(time.Hour)
+	cm.lastUsed[cacheKey("ws-old", "node_modules")] = base
+	cm.lastUsed[cacheKey("ws-mid", "venv")] = base.Add(time.Minute)
+	cm.lastUsed[cacheKey("ws-mid", "node_modules")] = base.Add(2 * time.Minute)
+	cm.lastUsed[cacheKey("ws-new", "node_modules")] = base.Add(3 * time.Minute)
+	// 사용 중인 워크스페이스는 가장 오래되었어도 전체 상한 정리에서 제외
+	cm.inUse["ws-old"] = true
+
+	evicted, err := cm.EnforceQuota(ctx)
+	require.NoError(t, err)
+	// ws-mid는 워크스페이스 상한을 넘어 오래된 venv가 제거되고,
+	// 남은 300바이트 중 유휴 캐시 중 가장 오래된 ws-mid/node_modules가 제거됨
+	assert.Equal(t, []string{"ws-mid/venv", "ws-mid/node_modules"}, cacheNames(evicted))
+
+	usage, err := cm.Usage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), usage.TotalSize)
+	assert.Equal(t, []string{"ws-new/node_modules", "ws-old/node_modules"}, cacheNames(usage.Entries))
+}
+
+func TestCacheManager_Purge(t *testing.T) {
+	root := t.TempDir()
+	cm := NewCacheManager(NewHostCacheStore(root), CacheConfig{})
+	ctx := context.Background()
+
+	_, _, err := cm.Prepare(ctx, "ws-1")
+	require.NoError(t, err)
+
+	_, err = cm.Purge(ctx, "ws-1", "", false)
+	assert.ErrorIs(t, err, ErrCacheInUse)
+
+	removed, err := cm.Purge(ctx, "ws-1", "venv", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ws-1/venv"}, cacheNames(removed))
+
+	_, err = cm.Purge(ctx, "ws-1", "venv", true)
+	assert.ErrorIs(t, err, ErrCacheNotFound)
+
+	cm.Release("ws-1")
+	removed, err = cm.Purge(ctx, "ws-1", "", false)
+	require.NoError(t, err)
+	assert.Len(t, removed, 2)
+	_, err = os.Stat(filepath.Join(root, "ws-1"))
+	assert.True(t, os.IsNotExist(err))
+}
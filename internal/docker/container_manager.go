@@ -9,13 +9,14 @@ import (
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
-	"github.com/docker/docker/api/types/network"
 	"github.com/docker/go-connections/nat"
+	"github.com/aicli/aicli-web/internal/models"
 )
 
 // ContainerManager 컨테이너 생명주기를 관리합니다.
 type ContainerManager struct {
-	client *Client
+	client           *Client
+	egressProxyImage string // allowlist 모드 이그레스 프록시 이미지
 }
 
 // NewContainerManager 새로운 컨테이너 매니저를 생성합니다.
@@ -107,6 +108,12 @@ type CreateContainerRequest struct {
 	// 보안 설정
 	Privileged    bool              `json:"privileged,omitempty"`
 	ReadOnly      bool              `json:"read_only,omitempty"`
+	
+	// 추가 마운트 (의존성 캐시 등)
+	ExtraMounts   []mount.Mount     `json:"-"`
+	
+	// 네트워크 이그레스 정책 (nil이면 전체 허용)
+	NetworkPolicy *models.NetworkPolicy `json:"network_policy,omitempty"`
 }
 
 // CreateWorkspaceContainerRequest Docker 통합 서비스용 컨테이너 생성 요청
@@ -195,13 +202,13 @@ func (cm *ContainerManager) CreateWorkspaceContainer(ctx context.Context, req *C
 		PortBindings: cm.buildPortBindings(req.Ports),
 	}
 	
-	// 네트워크 설정
-	networkConfig := &network.NetworkingConfig{
-		EndpointsConfig: map[string]*network.EndpointSettings{
-			cm.client.config.NetworkName: {
-				NetworkID: cm.client.GetNetworkID(),
-			},
-		},
+	// 의존성 캐시 등 추가 마운트
+	hostConfig.Mounts = append(hostConfig.Mounts, req.ExtraMounts...)
+	
+	// 네트워크 설정 (이그레스 정책에 따라 결정)
+	networkConfig, err := cm.applyNetworkPolicy(ctx, req, config, hostConfig)
+	if err != nil {
+		return nil, err
 	}
 	
 	// 컨테이너 생성
@@ -394,16 +401,29 @@ func (cm *ContainerManager) inspectResultToWorkspaceContainer(inspect *types.Con
 
 // ListWorkspaceContainers 워크스페이스별 컨테이너 목록을 조회합니다.
 func (cm *ContainerManager) ListWorkspaceContainers(ctx context.Context, workspaceID string) ([]*WorkspaceContainer, error) {
-	filters := filters.NewArgs()
-	filters.Add("label", fmt.Sprintf("%s.workspace.id=%s", cm.client.labelPrefix, workspaceID))
+	args := filters.NewArgs()
+	args.Add("label", fmt.Sprintf("%s.workspace.id=%s", cm.client.labelPrefix, workspaceID))
 	
 	containers, err := cm.client.cli.ContainerList(ctx, types.ContainerListOptions{
 		All:     true,
-		Filters: filters,
+		Filters: args,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+	
+	// 웜 풀에서 할당된 컨테이너는 레이블 대신 이름으로 찾음
+	claimed, err := cm.client.cli.ContainerList(ctx, types.ContainerListOptions{
+		All: true,
+		Filters: filters.NewArgs(
+			filters.Arg("name", "^/"+cm.client.GenerateContainerName(workspaceID)+"$"),
+			filters.Arg("label", cm.client.labelKey("type")+"=warm"),
+		),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("list containers: %w", err)
 	}
+	containers = append(containers, claimed...)
 	
 	result := make([]*WorkspaceContainer, 0, len(containers))
 	for _, container := range containers {
@@ -445,6 +465,11 @@ func (cm *ContainerManager) CleanupWorkspace(ctx context.Context, workspaceID st
 		}
 	}
 	
+	// 이그레스 프록시와 워크스페이스 내부 네트워크 정리
+	if err := cm.RemoveEgressProxy(ctx, workspaceID); err != nil && !force {
+		return err
+	}
+	
 	return nil
 }
 
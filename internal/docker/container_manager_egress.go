@@ -0,0 +1,248 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/errdefs"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// DefaultEgressProxyImage allowlist 모드에서 사이드카로 실행할 이그레스 프록시 이미지 (cmd/egress-proxy)
+const DefaultEgressProxyImage = "aicli/egress-proxy:latest"
+
+// EgressProxyPort 이그레스 프록시 수신 포트
+const EgressProxyPort = 3128
+
+// egressProxyAlias 워크스페이스 내부 네트워크에서 이그레스 프록시의 호스트 이름
+const egressProxyAlias = "egress-proxy"
+
+// 프록시 컨테이너는 workspace.id 대신 egress.workspace.id 레이블을 사용해
+// ListWorkspaceContainers와 생명주기 이벤트에서 워크스페이스 컨테이너로 취급되지 않도록 합니다.
+
+// SetEgressProxyImage allowlist 모드에서 사용할 이그레스 프록시 이미지를 설정합니다 (서버 시작 시 호출)
+func (cm *ContainerManager) SetEgressProxyImage(image string) {
+	cm.egressProxyImage = image
+}
+
+// egressNetworkName 워크스페이스 전용 내부 네트워크 이름
+func (cm *ContainerManager) egressNetworkName(workspaceID string) string {
+	return fmt.Sprintf("%s-egress-%s", cm.client.labelPrefix, workspaceID)
+}
+
+// egressProxyName 워크스페이스 이그레스 프록시 컨테이너 이름
+func (cm *ContainerManager) egressProxyName(workspaceID string) string {
+	return fmt.Sprintf("%s-egress-proxy-%s", cm.client.labelPrefix, workspaceID)
+}
+
+// applyNetworkPolicy 이그레스 정책에 맞게 컨테이너 네트워크를 구성합니다.
+//   - full: 공용 네트워크에 연결 (외부 접근 허용)
+//   - offline: 네트워크 없음
+//   - allowlist: 외부 경로가 없는 워크스페이스 전용 내부 네트워크에 연결하고,
+//     허용 도메인만 전달하는 이그레스 프록시 사이드카를 통해서만 외부에 접근
+func (cm *ContainerManager) applyNetworkPolicy(ctx context.Context, req *CreateContainerRequest, config *container.Config, hostConfig *container.HostConfig) (*network.NetworkingConfig, error) {
+	mode := models.NetworkEgressFull
+	if req.NetworkPolicy != nil {
+		mode = req.NetworkPolicy.Mode
+	}
+
+	if mode != models.NetworkEgressAllowlist {
+		// 이전 정책에서 만든 프록시가 남아 있으면 정리
+		if err := cm.RemoveEgressProxy(ctx, req.WorkspaceID); err != nil {
+			return nil, err
+		}
+	}
+
+	switch mode {
+	case models.NetworkEgressOffline:
+		hostConfig.NetworkMode = "none"
+		hostConfig.PortBindings = nil
+		return &network.NetworkingConfig{}, nil
+
+	case models.NetworkEgressAllowlist:
+		networkID, err := cm.ensureEgressProxy(ctx, req.WorkspaceID, req.NetworkPolicy.AllowedDomains)
+		if err != nil {
+			return nil, fmt.Errorf("setup egress proxy: %w", err)
+		}
+
+		proxyURL := fmt.Sprintf("http://%s:%d", egressProxyAlias, EgressProxyPort)
+		config.Env = append(config.Env,
+			"HTTP_PROXY="+proxyURL,
+			"HTTPS_PROXY="+proxyURL,
+			"http_proxy="+proxyURL,
+			"https_proxy="+proxyURL,
+			"NO_PROXY=localhost,127.0.0.1",
+			"no_proxy=localhost,127.0.0.1",
+		)
+		return &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				cm.egressNetworkName(req.WorkspaceID): {NetworkID: networkID},
+			},
+		}, nil
+
+	default:
+		return &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				cm.client.config.NetworkName: {
+					NetworkID: cm.client.GetNetworkID(),
+				},
+			},
+		}, nil
+	}
+}
+
+// ensureEgressProxy 워크스페이스 내부 네트워크와 이그레스 프록시 사이드카를 준비합니다.
+// 허용 도메인이 바뀌었을 수 있으므로 프록시 컨테이너는 매번 새로 만듭니다.
+func (cm *ContainerManager) ensureEgressProxy(ctx context.Context, workspaceID string, domains []string) (string, error) {
+	networkID, err := cm.ensureEgressNetwork(ctx, workspaceID)
+	if err != nil {
+		return "", err
+	}
+
+	proxyName := cm.egressProxyName(workspaceID)
+	if err := cm.removeContainerByName(ctx, proxyName); err != nil {
+		return "", err
+	}
+
+	image := cm.egressProxyImage
+	if image == "" {
+		image = DefaultEgressProxyImage
+	}
+	if err := cm.EnsureImage(ctx, image); err != nil {
+		return "", err
+	}
+
+	config := &container.Config{
+		Image: image,
+		Env: []string{
+			"AICLI_EGRESS_ALLOWED_DOMAINS=" + strings.Join(domains, ","),
+			fmt.Sprintf("AICLI_EGRESS_LISTEN=:%d", EgressProxyPort),
+		},
+		Labels: map[string]string{
+			cm.client.labelKey("managed"):             "true",
+			cm.client.labelKey("type"):                "egress-proxy",
+			cm.client.labelKey("egress.workspace.id"): workspaceID,
+			cm.client.labelKey("created"):             time.Now().Format(time.RFC3339),
+		},
+	}
+	hostConfig := &container.HostConfig{
+		ReadonlyRootfs: true,
+		SecurityOpt:    []string{"no-new-privileges:true"},
+		CapDrop:        []string{"ALL"},
+		RestartPolicy: container.RestartPolicy{
+			Name: "unless-stopped",
+		},
+		Resources: container.Resources{
+			Memory:   64 * 1024 * 1024,
+			NanoCPUs: 500_000_000,
+		},
+	}
+	// 외부로 나가는 공용 네트워크에 먼저 연결하고 워크스페이스 내부 네트워크를 추가로 연결
+	networkConfig := &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{
+			cm.client.config.NetworkName: {
+				NetworkID: cm.client.GetNetworkID(),
+			},
+		},
+	}
+
+	resp, err := cm.client.cli.ContainerCreate(ctx, config, hostConfig, networkConfig, nil, proxyName)
+	if err != nil {
+		return "", fmt.Errorf("create egress proxy: %w", err)
+	}
+	if err := cm.client.cli.NetworkConnect(ctx, networkID, resp.ID, &network.EndpointSettings{
+		Aliases: []string{egressProxyAlias},
+	}); err != nil {
+		cm.RemoveContainer(ctx, resp.ID, true)
+		return "", fmt.Errorf("connect egress proxy: %w", err)
+	}
+	if err := cm.StartContainer(ctx, resp.ID); err != nil {
+		cm.RemoveContainer(ctx, resp.ID, true)
+		return "", err
+	}
+
+	return networkID, nil
+}
+
+// ensureEgressNetwork 외부 경로가 없는 워크스페이스 전용 내부 네트워크를 찾거나 만듭니다.
+func (cm *ContainerManager) ensureEgressNetwork(ctx context.Context, workspaceID string) (string, error) {
+	name := cm.egressNetworkName(workspaceID)
+
+	networks, err := cm.client.cli.NetworkList(ctx, types.NetworkListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", name)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("list networks: %w", err)
+	}
+	for _, n := range networks {
+		if n.Name == name {
+			return n.ID, nil
+		}
+	}
+
+	resp, err := cm.client.cli.NetworkCreate(ctx, name, types.NetworkCreate{
+		Driver:   "bridge",
+		Internal: true, // 외부 경로 없음 (프록시를 통해서만 접근)
+		Options: map[string]string{
+			"com.docker.network.bridge.enable_ip_masquerade": "false",
+		},
+		Labels: map[string]string{
+			cm.client.labelKey("managed"):             "true",
+			cm.client.labelKey("type"):                "egress",
+			cm.client.labelKey("egress.workspace.id"): workspaceID,
+			cm.client.labelKey("created"):             time.Now().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create egress network: %w", err)
+	}
+	return resp.ID, nil
+}
+
+// RemoveEgressProxy 워크스페이스의 이그레스 프록시와 내부 네트워크를 삭제합니다 (없으면 무시)
+func (cm *ContainerManager) RemoveEgressProxy(ctx context.Context, workspaceID string) error {
+	if err := cm.removeContainerByName(ctx, cm.egressProxyName(workspaceID)); err != nil {
+		return err
+	}
+
+	name := cm.egressNetworkName(workspaceID)
+	networks, err := cm.client.cli.NetworkList(ctx, types.NetworkListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", name)),
+	})
+	if err != nil {
+		return fmt.Errorf("list networks: %w", err)
+	}
+	for _, n := range networks {
+		if n.Name != name {
+			continue
+		}
+		if err := cm.client.cli.NetworkRemove(ctx, n.ID); err != nil && !errdefs.IsNotFound(err) {
+			return fmt.Errorf("remove egress network: %w", err)
+		}
+	}
+	return nil
+}
+
+// removeContainerByName 이름이 정확히 일치하는 컨테이너를 삭제합니다.
+func (cm *ContainerManager) removeContainerByName(ctx context.Context, name string) error {
+	containers, err := cm.client.cli.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("name", "^/"+name+"$")),
+	})
+	if err != nil {
+		return fmt.Errorf("list containers: %w", err)
+	}
+	for _, c := range containers {
+		if err := cm.RemoveContainer(ctx, c.ID, true); err != nil {
+			return fmt.Errorf("remove container %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+)
+
+// InteractiveExec TTY가 연결된 컨테이너 exec 세션
+// TTY 모드에서는 stdout/stderr가 하나의 스트림으로 합쳐져 전달됩니다.
+type InteractiveExec struct {
+	cli    *Client
+	execID string
+	conn   types.HijackedResponse
+}
+
+// ExecInteractive 컨테이너 안에서 TTY를 할당해 대화형 명령을 시작합니다.
+func (cm *ContainerManager) ExecInteractive(ctx context.Context, containerID string, cmd []string, workDir string, env []string, cols, rows uint) (*InteractiveExec, error) {
+	config := types.ExecConfig{
+		Tty:          true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		WorkingDir:   workDir,
+		Env:          env,
+		Cmd:          cmd,
+	}
+	if cols > 0 && rows > 0 {
+		config.ConsoleSize = &[2]uint{rows, cols}
+	}
+
+	exec, err := cm.client.cli.ContainerExecCreate(ctx, containerID, config)
+	if err != nil {
+		return nil, fmt.Errorf("create exec: %w", err)
+	}
+	conn, err := cm.client.cli.ContainerExecAttach(ctx, exec.ID, types.ExecStartCheck{Tty: true, ConsoleSize: config.ConsoleSize})
+	if err != nil {
+		return nil, fmt.Errorf("attach exec: %w", err)
+	}
+	return &InteractiveExec{cli: cm.client, execID: exec.ID, conn: conn}, nil
+}
+
+// Read 터미널 출력 읽기
+func (e *InteractiveExec) Read(p []byte) (int, error) {
+	return e.conn.Reader.Read(p)
+}
+
+// Write 터미널 입력 쓰기
+func (e *InteractiveExec) Write(p []byte) (int, error) {
+	return e.conn.Conn.Write(p)
+}
+
+// Resize 터미널 크기 변경
+func (e *InteractiveExec) Resize(cols, rows uint) error {
+	return e.cli.cli.ContainerExecResize(context.Background(), e.execID, container.ResizeOptions{Width: cols, Height: rows})
+}
+
+// Wait 명령이 끝날 때까지 기다린 뒤 종료 코드를 반환합니다.
+// 출력 스트림이 닫힌 뒤 호출해야 하며, exec 상태가 갱신될 때까지 잠시 폴링합니다.
+func (e *InteractiveExec) Wait() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		inspect, err := e.cli.cli.ContainerExecInspect(ctx, e.execID)
+		if err != nil {
+			return -1, err
+		}
+		if !inspect.Running {
+			return inspect.ExitCode, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return -1, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close 연결 종료 (셸에는 EOF/HUP이 전달됩니다)
+func (e *InteractiveExec) Close() error {
+	e.conn.Close()
+	return nil
+}
+
+var _ io.ReadWriteCloser = (*InteractiveExec)(nil)
@@ -0,0 +1,220 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/errdefs"
+)
+
+// WarmWorkspaceMountPoint 웜 컨테이너에 워크스페이스 루트가 마운트되는 경로
+const WarmWorkspaceMountPoint = "/aicli/workspaces"
+
+// WarmWorkspaceEnvFile 할당된 웜 컨테이너의 워크스페이스 환경 변수 파일 (KEY=VALUE 형식)
+const WarmWorkspaceEnvFile = "/aicli/workspace.env"
+
+// EnsureImage 이미지가 로컬에 없으면 받아옵니다.
+func (cm *ContainerManager) EnsureImage(ctx context.Context, image string) error {
+	if _, _, err := cm.client.cli.ImageInspectWithRaw(ctx, image); err == nil {
+		return nil
+	} else if !errdefs.IsNotFound(err) {
+		return fmt.Errorf("inspect image: %w", err)
+	}
+
+	reader, err := cm.client.cli.ImagePull(ctx, image, types.ImagePullOptions{})
+	if err != nil {
+		return fmt.Errorf("pull image: %w", err)
+	}
+	defer reader.Close()
+
+	// 진행 상황 스트림을 끝까지 읽어야 받기가 완료됨
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return fmt.Errorf("pull image: %w", err)
+	}
+	return nil
+}
+
+// CreateWarmContainer 워크스페이스가 정해지지 않은 웜 컨테이너를 생성하고 시작합니다.
+// 프로젝트 디렉토리 대신 워크스페이스 루트 전체를 마운트하고, 할당 시 /workspace를 프로젝트 경로로 연결합니다.
+func (cm *ContainerManager) CreateWarmContainer(ctx context.Context, image, workspaceRoot string) (*WorkspaceContainer, error) {
+	if image == "" {
+		image = cm.client.config.DefaultImage
+	}
+
+	config := &container.Config{
+		Image:        image,
+		WorkingDir:   "/",
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		OpenStdin:    true,
+		StdinOnce:    false,
+		Tty:          true,
+		Env:          []string{"AICLI_MANAGED=true", "AICLI_WARM=true"},
+		Labels: map[string]string{
+			cm.client.labelKey("managed"): "true",
+			cm.client.labelKey("type"):    "warm",
+			cm.client.labelKey("created"): time.Now().Format(time.RFC3339),
+		},
+	}
+
+	hostConfig := &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:   mount.TypeBind,
+				Source: workspaceRoot,
+				Target: WarmWorkspaceMountPoint,
+				BindOptions: &mount.BindOptions{
+					Propagation: mount.PropagationRPrivate,
+				},
+			},
+		},
+
+		// 리소스 제한
+		Resources: cm.buildResourceLimits(&CreateContainerRequest{}),
+
+		// 보안 설정
+		SecurityOpt: cm.client.config.SecurityOpts,
+		CapDrop:     []string{"ALL"},
+		CapAdd:      []string{"CHOWN", "SETUID", "SETGID", "DAC_OVERRIDE"},
+
+		RestartPolicy: container.RestartPolicy{
+			Name: "unless-stopped",
+		},
+	}
+
+	networkConfig := &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{
+			cm.client.config.NetworkName: {
+				NetworkID: cm.client.GetNetworkID(),
+			},
+		},
+	}
+
+	resp, err := cm.client.cli.ContainerCreate(ctx, config, hostConfig, networkConfig, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("create warm container: %w", err)
+	}
+
+	if err := cm.StartContainer(ctx, resp.ID); err != nil {
+		cm.RemoveContainer(ctx, resp.ID, true)
+		return nil, err
+	}
+
+	return &WorkspaceContainer{
+		ID:      resp.ID,
+		State:   ContainerStateRunning,
+		Created: time.Now(),
+	}, nil
+}
+
+// ClaimWarmContainer 웜 컨테이너를 워크스페이스에 할당합니다.
+// 워크스페이스 컨테이너 이름으로 바꾸고 /workspace를 워크스페이스 루트 아래 프로젝트 경로로 연결합니다.
+// 생성 후에는 환경 변수를 바꿀 수 없으므로 워크스페이스 환경 변수는 WarmWorkspaceEnvFile에 기록합니다.
+func (cm *ContainerManager) ClaimWarmContainer(ctx context.Context, containerID, workspaceID, relPath string, env map[string]string) (*WorkspaceContainer, error) {
+	containerName := cm.client.GenerateContainerName(workspaceID)
+
+	if err := cm.cleanupExistingContainer(ctx, containerName); err != nil {
+		return nil, fmt.Errorf("cleanup existing container: %w", err)
+	}
+
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	cmd := []string{
+		"sh", "-c", `target=$1; shift; rmdir /workspace 2>/dev/null; ln -sfn "$target" /workspace && printf '%s\n' "$@" > ` + WarmWorkspaceEnvFile,
+		"sh", path.Join(WarmWorkspaceMountPoint, relPath),
+		"WORKSPACE_ID=" + workspaceID,
+	}
+	for _, key := range keys {
+		cmd = append(cmd, key+"="+env[key])
+	}
+	if err := cm.execCommand(ctx, containerID, cmd); err != nil {
+		return nil, fmt.Errorf("link workspace: %w", err)
+	}
+
+	if err := cm.client.cli.ContainerRename(ctx, containerID, containerName); err != nil {
+		return nil, fmt.Errorf("rename container: %w", err)
+	}
+
+	return &WorkspaceContainer{
+		ID:          containerID,
+		Name:        containerName,
+		WorkspaceID: workspaceID,
+		State:       ContainerStateRunning,
+		Created:     time.Now(),
+	}, nil
+}
+
+// ListWarmContainers 할당되지 않은 웜 컨테이너 목록을 조회합니다.
+func (cm *ContainerManager) ListWarmContainers(ctx context.Context) ([]*WorkspaceContainer, error) {
+	containers, err := cm.client.cli.ContainerList(ctx, types.ContainerListOptions{
+		All: true,
+		Filters: filters.NewArgs(
+			filters.Arg("label", cm.client.labelKey("type")+"=warm"),
+		),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list warm containers: %w", err)
+	}
+
+	prefix := "/" + cm.client.labelPrefix + "-workspace-"
+	result := make([]*WorkspaceContainer, 0, len(containers))
+	for _, c := range containers {
+		// 이미 워크스페이스에 할당된 컨테이너는 제외
+		if len(c.Names) > 0 && strings.HasPrefix(c.Names[0], prefix) {
+			continue
+		}
+		result = append(result, &WorkspaceContainer{
+			ID:      c.ID,
+			State:   ContainerState(c.State),
+			Created: time.Unix(c.Created, 0),
+		})
+	}
+	return result, nil
+}
+
+// execCommand 컨테이너 안에서 명령을 실행하고 종료 코드를 확인합니다.
+func (cm *ContainerManager) execCommand(ctx context.Context, containerID string, cmd []string) error {
+	exec, err := cm.client.cli.ContainerExecCreate(ctx, containerID, types.ExecConfig{Cmd: cmd})
+	if err != nil {
+		return err
+	}
+	if err := cm.client.cli.ContainerExecStart(ctx, exec.ID, types.ExecStartCheck{}); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		inspect, err := cm.client.cli.ContainerExecInspect(ctx, exec.ID)
+		if err != nil {
+			return err
+		}
+		if !inspect.Running {
+			if inspect.ExitCode != 0 {
+				return fmt.Errorf("command exited with code %d", inspect.ExitCode)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Package egress 워크스페이스 컨테이너의 외부 접근을 허용된 도메인으로 제한하는 HTTP 프록시
+//
+// allowlist 모드의 워크스페이스 컨테이너는 외부 경로가 없는 내부 네트워크에만 연결되고,
+// 내부 네트워크와 외부 네트워크에 함께 연결된 사이드카 컨테이너가 이 프록시를 실행합니다.
+// 컨테이너에는 HTTP_PROXY/HTTPS_PROXY가 설정되며, 프록시를 거치지 않는 연결은 네트워크 수준에서 막힙니다.
+package egress
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Allowlist 허용 도메인 목록
+type Allowlist struct {
+	exact    map[string]bool
+	suffixes []string // "*.example.com" → ".example.com"
+}
+
+// NewAllowlist 허용 도메인 목록을 만듭니다 ("*.example.com"은 하위 도메인만 허용)
+func NewAllowlist(domains []string) *Allowlist {
+	a := &Allowlist{exact: make(map[string]bool)}
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+		if domain == "" {
+			continue
+		}
+		if strings.HasPrefix(domain, "*.") {
+			a.suffixes = append(a.suffixes, domain[1:])
+			continue
+		}
+		a.exact[domain] = true
+	}
+	return a
+}
+
+// Allows 호스트(포트 포함 가능)가 허용 목록에 있는지 확인합니다.
+func (a *Allowlist) Allows(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "" {
+		return false
+	}
+	if a.exact[host] {
+		return true
+	}
+	for _, suffix := range a.suffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDomain 허용 도메인 형식을 확인합니다.
+func ValidateDomain(domain string) error {
+	name := strings.TrimPrefix(domain, "*.")
+	if name == "" || len(name) > 253 {
+		return fmt.Errorf("invalid domain: %q", domain)
+	}
+	if strings.Contains(name, "*") {
+		return fmt.Errorf("wildcard is only allowed as a leading \"*.\": %q", domain)
+	}
+	if net.ParseIP(name) != nil {
+		return nil
+	}
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("invalid domain: %q", domain)
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("invalid domain: %q", domain)
+			}
+		}
+	}
+	return nil
+}
+
+// Proxy 허용된 도메인으로만 요청을 전달하는 HTTP/CONNECT 프록시
+type Proxy struct {
+	allowlist *Allowlist
+	dialer    *net.Dialer
+	forward   *httputil.ReverseProxy
+	logger    *log.Logger
+}
+
+// NewProxy 새 이그레스 프록시를 생성합니다 (logger가 nil이면 차단 기록을 남기지 않음)
+func NewProxy(allowlist *Allowlist, logger *log.Logger) *Proxy {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	return &Proxy{
+		allowlist: allowlist,
+		dialer:    dialer,
+		forward: &httputil.ReverseProxy{
+			// 요청 URL이 이미 절대 경로이므로 그대로 전달
+			Director: func(r *http.Request) {},
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 10 * time.Second,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+		logger: logger,
+	}
+}
+
+// ServeHTTP 프록시 요청을 처리합니다.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	host := r.Host
+	if r.Method != http.MethodConnect {
+		if !r.URL.IsAbs() {
+			http.Error(w, "proxy requests must use an absolute URL", http.StatusBadRequest)
+			return
+		}
+		host = r.URL.Host
+	}
+
+	if !p.allowlist.Allows(host) {
+		p.logf("denied %s %s", r.Method, host)
+		http.Error(w, fmt.Sprintf("egress to %s is not allowed by the workspace network policy", host), http.StatusForbidden)
+		return
+	}
+
+	if r.Method == http.MethodConnect {
+		p.tunnel(w, r)
+		return
+	}
+	p.forward.ServeHTTP(w, r)
+}
+
+// tunnel CONNECT 요청을 대상 호스트와 양방향으로 연결합니다.
+func (p *Proxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	upstream, err := p.dialer.DialContext(r.Context(), "tcp", r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		upstream.Close()
+		http.Error(w, "hijacking not supported", http.StatusInternalServerError)
+		return
+	}
+	client, buffered, err := hijacker.Hijack()
+	if err != nil {
+		upstream.Close()
+		return
+	}
+
+	if _, err := client.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n")); err != nil {
+		client.Close()
+		upstream.Close()
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		// 헤더와 함께 읽힌 데이터가 있으면 먼저 전달
+		if n := buffered.Reader.Buffered(); n > 0 {
+			data, _ := buffered.Reader.Peek(n)
+			upstream.Write(data)
+		}
+		io.Copy(upstream, client)
+		closeWrite(upstream)
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(client, upstream)
+		closeWrite(client)
+	}()
+	wg.Wait()
+	client.Close()
+	upstream.Close()
+}
+
+func (p *Proxy) logf(format string, args ...interface{}) {
+	if p.logger != nil {
+		p.logger.Printf(format, args...)
+	}
+}
+
+// closeWrite 반대 방향 복사가 끝나도록 쓰기 방향만 닫습니다.
+func closeWrite(conn net.Conn) {
+	if c, ok := conn.(interface{ CloseWrite() error }); ok {
+		c.CloseWrite()
+	}
+}
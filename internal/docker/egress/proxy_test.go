@@ -0,0 +1,115 @@
+package egress
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowlist_Allows(t *testing.T) {
+	allowlist := NewAllowlist([]string{"github.com", "*.npmjs.org", "Registry.Example.com."})
+
+	tests := []struct {
+		host    string
+		allowed bool
+	}{
+		{"github.com", true},
+		{"github.com:443", true},
+		{"GITHUB.COM", true},
+		{"api.github.com", false},
+		{"registry.npmjs.org", true},
+		{"npmjs.org", false},
+		{"evilnpmjs.org", false},
+		{"registry.example.com", true},
+		{"github.com.evil.io", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, allowlist.Allows(tt.host))
+		})
+	}
+}
+
+func TestValidateDomain(t *testing.T) {
+	for _, domain := range []string{"github.com", "*.npmjs.org", "localhost", "10.0.0.1"} {
+		assert.NoError(t, ValidateDomain(domain), domain)
+	}
+	for _, domain := range []string{"", "*", "*.", "git*hub.com", "-bad.com", "a..b", "exa mple.com", "https://github.com"} {
+		assert.Error(t, ValidateDomain(domain), domain)
+	}
+}
+
+func TestProxy_HTTP(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "hello")
+	}))
+	defer upstream.Close()
+	upstreamURL, _ := url.Parse(upstream.URL)
+
+	proxy := httptest.NewServer(NewProxy(NewAllowlist([]string{upstreamURL.Hostname()}), nil))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+	client := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}}
+
+	resp, err := client.Get(upstream.URL)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "hello", string(body))
+
+	resp, err = client.Get("http://blocked.example.com/")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestProxy_Connect(t *testing.T) {
+	// 에코 서버
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		io.Copy(conn, conn)
+	}()
+
+	proxy := httptest.NewServer(NewProxy(NewAllowlist([]string{"127.0.0.1"}), nil))
+	defer proxy.Close()
+
+	connect := func(target string) (net.Conn, *http.Response) {
+		conn, err := net.Dial("tcp", proxy.Listener.Addr().String())
+		require.NoError(t, err)
+		fmt.Fprintf(conn, "CONNECT %s HTTP/1.1\r\nHost: %s\r\n\r\n", target, target)
+		reader := bufio.NewReader(conn)
+		resp, err := http.ReadResponse(reader, &http.Request{Method: http.MethodConnect})
+		require.NoError(t, err)
+		return conn, resp
+	}
+
+	conn, resp := connect(listener.Addr().String())
+	defer conn.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	fmt.Fprint(conn, "ping")
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+
+	denied, resp := connect("example.com:443")
+	defer denied.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
@@ -0,0 +1,179 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/errdefs"
+)
+
+// ImageBuildRequest 워크스페이스 Dockerfile 빌드 요청
+type ImageBuildRequest struct {
+	WorkspaceID string
+	Dockerfile  string
+	BuildArgs   map[string]string
+	NoCache     bool // true면 같은 내용의 이미지가 있어도 다시 빌드
+}
+
+// ImageBuildResult 빌드 결과
+type ImageBuildResult struct {
+	Tag    string `json:"tag"`
+	Cached bool   `json:"cached"` // 같은 내용으로 이미 빌드된 이미지를 재사용
+}
+
+// ImageBuilder 워크스페이스 Dockerfile로 이미지를 빌드합니다.
+// 이미지 태그는 Dockerfile과 빌드 인자의 해시로 정해지므로 내용이 같으면 빌드를 건너뛰고,
+// 내용이 바뀌어도 Docker 레이어 캐시를 사용합니다.
+type ImageBuilder struct {
+	client *Client
+}
+
+// NewImageBuilder 새로운 이미지 빌더를 생성합니다.
+func NewImageBuilder(client *Client) *ImageBuilder {
+	return &ImageBuilder{client: client}
+}
+
+// ImageTag 빌드 요청 내용으로 이미지 태그를 만듭니다.
+func (b *ImageBuilder) ImageTag(req ImageBuildRequest) string {
+	return fmt.Sprintf("%s-workspace-%s:%s", b.client.labelPrefix, strings.ToLower(req.WorkspaceID), buildHash(req))
+}
+
+// Build 이미지를 빌드하고 빌드 출력을 한 줄씩 logFn으로 전달합니다.
+func (b *ImageBuilder) Build(ctx context.Context, req ImageBuildRequest, logFn func(line string)) (*ImageBuildResult, error) {
+	tag := b.ImageTag(req)
+
+	if !req.NoCache {
+		if _, _, err := b.client.cli.ImageInspectWithRaw(ctx, tag); err == nil {
+			logFn(fmt.Sprintf("이미 빌드된 이미지를 사용합니다: %s", tag))
+			return &ImageBuildResult{Tag: tag, Cached: true}, nil
+		} else if !errdefs.IsNotFound(err) {
+			return nil, fmt.Errorf("inspect image: %w", err)
+		}
+	}
+
+	buildContext, err := dockerfileContext(req.Dockerfile)
+	if err != nil {
+		return nil, err
+	}
+
+	buildArgs := make(map[string]*string, len(req.BuildArgs))
+	for key, value := range req.BuildArgs {
+		value := value
+		buildArgs[key] = &value
+	}
+
+	resp, err := b.client.cli.ImageBuild(ctx, buildContext, types.ImageBuildOptions{
+		Tags:        []string{tag},
+		Dockerfile:  "Dockerfile",
+		BuildArgs:   buildArgs,
+		NoCache:     req.NoCache,
+		Remove:      true,
+		ForceRemove: true,
+		PullParent:  true,
+		Labels: map[string]string{
+			b.client.labelKey("managed"):      "true",
+			b.client.labelKey("type"):         "workspace-image",
+			b.client.labelKey("workspace.id"): req.WorkspaceID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("build image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := streamBuildOutput(resp.Body, logFn); err != nil {
+		return nil, err
+	}
+	return &ImageBuildResult{Tag: tag}, nil
+}
+
+// buildHash Dockerfile과 빌드 인자의 해시 (태그로 사용)
+func buildHash(req ImageBuildRequest) string {
+	h := sha256.New()
+	h.Write([]byte(req.Dockerfile))
+
+	keys := make([]string, 0, len(req.BuildArgs))
+	for key := range req.BuildArgs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(h, "\x00%s=%s", key, req.BuildArgs[key])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// dockerfileContext Dockerfile 하나만 담은 빌드 컨텍스트 tar를 만듭니다.
+func dockerfileContext(dockerfile string) (io.Reader, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    "Dockerfile",
+		Mode:    0644,
+		Size:    int64(len(dockerfile)),
+		ModTime: time.Unix(0, 0),
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write([]byte(dockerfile)); err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// buildMessage Docker 빌드 출력 스트림의 JSON 메시지
+type buildMessage struct {
+	Stream      string `json:"stream"`
+	Status      string `json:"status"`
+	Error       string `json:"error"`
+	ErrorDetail struct {
+		Message string `json:"message"`
+	} `json:"errorDetail"`
+}
+
+// streamBuildOutput 빌드 출력을 줄 단위로 전달하고 빌드 에러를 반환합니다.
+func streamBuildOutput(body io.Reader, logFn func(line string)) error {
+	decoder := json.NewDecoder(body)
+	for {
+		var msg buildMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("read build output: %w", err)
+		}
+
+		if msg.Error != "" {
+			detail := msg.ErrorDetail.Message
+			if detail == "" {
+				detail = msg.Error
+			}
+			logFn(detail)
+			return fmt.Errorf("build failed: %s", detail)
+		}
+
+		text := msg.Stream
+		if text == "" {
+			text = msg.Status
+		}
+		for _, line := range strings.Split(text, "\n") {
+			if line = strings.TrimRight(line, "\r "); line != "" {
+				logFn(line)
+			}
+		}
+	}
+}
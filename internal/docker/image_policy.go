@@ -0,0 +1,281 @@
+package docker
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types/mount"
+)
+
+// WorkspaceImageSpec 워크스페이스 컨테이너 이미지 지정 (허용된 이미지 또는 Dockerfile 중 하나)
+type WorkspaceImageSpec struct {
+	Image      string            `json:"image,omitempty"`
+	Dockerfile string            `json:"dockerfile,omitempty"` // Dockerfile 내용
+	BuildArgs  map[string]string `json:"build_args,omitempty"`
+	Privileged bool              `json:"privileged,omitempty"`
+	Mounts     []ContainerMount  `json:"mounts,omitempty"` // 추가 호스트 마운트 (워크스페이스 안 경로만 허용)
+}
+
+// BindMounts 추가 호스트 마운트를 컨테이너 바인드 마운트로 변환합니다.
+func (s WorkspaceImageSpec) BindMounts() []mount.Mount {
+	mounts := make([]mount.Mount, 0, len(s.Mounts))
+	for _, m := range s.Mounts {
+		mounts = append(mounts, mount.Mount{
+			Type:     mount.TypeBind,
+			Source:   m.Source,
+			Target:   m.Destination,
+			ReadOnly: m.ReadOnly,
+			BindOptions: &mount.BindOptions{
+				Propagation: mount.PropagationRPrivate,
+			},
+		})
+	}
+	return mounts
+}
+
+// ImagePolicy 워크스페이스가 사용할 수 있는 이미지와 컨테이너 설정 정책
+type ImagePolicy struct {
+	// AllowedImages 허용된 이미지 패턴 (path.Match 형식, 예: "node:20*", "aicli/*")
+	// 태그를 생략한 이미지는 latest로 취급합니다.
+	AllowedImages   []string `json:"allowed_images"`
+	AllowDockerfile bool     `json:"allow_dockerfile"`
+	AllowPrivileged bool     `json:"allow_privileged"`
+}
+
+// PolicyViolationError 정책 위반 목록
+type PolicyViolationError struct {
+	Violations []string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return "image policy violation: " + strings.Join(e.Violations, "; ")
+}
+
+// blockedMountTargets 워크스페이스 마운트로 덮어쓸 수 없는 컨테이너 경로
+var blockedMountTargets = []string{"/", "/proc", "/sys", "/dev", "/etc", "/var/run/docker.sock"}
+
+// normalizeImageRef 태그와 다이제스트가 없는 이미지에 latest 태그를 붙입니다.
+func normalizeImageRef(image string) string {
+	if strings.Contains(image, "@") {
+		return image
+	}
+	name := image
+	if i := strings.LastIndex(image, "/"); i >= 0 {
+		name = image[i+1:]
+	}
+	if !strings.Contains(name, ":") {
+		return image + ":latest"
+	}
+	return image
+}
+
+// IsImageAllowed 이미지가 허용 목록에 있는지 확인합니다.
+func (p ImagePolicy) IsImageAllowed(image string) bool {
+	ref := normalizeImageRef(image)
+	for _, pattern := range p.AllowedImages {
+		if pattern == image || pattern == ref {
+			return true
+		}
+		if matched, err := path.Match(normalizeImageRef(pattern), ref); err == nil && matched {
+			return true
+		}
+		if matched, err := path.Match(pattern, ref); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate 이미지 지정이 정책을 지키는지 확인합니다 (위반 시 *PolicyViolationError)
+func (p ImagePolicy) Validate(spec WorkspaceImageSpec, projectPath string) error {
+	var violations []string
+
+	switch {
+	case spec.Image == "" && spec.Dockerfile == "":
+		violations = append(violations, "image 또는 dockerfile 중 하나를 지정해야 합니다")
+	case spec.Image != "" && spec.Dockerfile != "":
+		violations = append(violations, "image와 dockerfile은 함께 지정할 수 없습니다")
+	case spec.Image != "":
+		if !p.IsImageAllowed(spec.Image) {
+			violations = append(violations, fmt.Sprintf("허용되지 않은 이미지입니다: %s", spec.Image))
+		}
+	default:
+		if !p.AllowDockerfile {
+			violations = append(violations, "Dockerfile 빌드가 허용되지 않습니다")
+		} else {
+			violations = append(violations, p.validateDockerfile(spec.Dockerfile)...)
+		}
+	}
+
+	if spec.Privileged && !p.AllowPrivileged {
+		violations = append(violations, "특권 모드는 허용되지 않습니다")
+	}
+	violations = append(violations, validateWorkspaceMounts(spec.Mounts, projectPath)...)
+
+	if len(violations) > 0 {
+		return &PolicyViolationError{Violations: violations}
+	}
+	return nil
+}
+
+// validateDockerfile 기본 이미지가 허용 목록에 있는지와 빌드에 쓸 수 없는 명령을 확인합니다.
+// 빌드 컨텍스트에는 Dockerfile만 포함되므로 로컬 파일을 복사하는 COPY/ADD는 사용할 수 없습니다.
+func (p ImagePolicy) validateDockerfile(dockerfile string) []string {
+	var violations []string
+	stages := make(map[string]bool)
+	hasFrom := false
+
+	for _, inst := range parseDockerfile(dockerfile) {
+		switch inst.command {
+		case "FROM":
+			hasFrom = true
+			image, stage := parseFromArgs(inst.args)
+			switch {
+			case image == "":
+				violations = append(violations, fmt.Sprintf("%d행: FROM에 이미지가 없습니다", inst.line))
+			case strings.Contains(image, "$"):
+				violations = append(violations, fmt.Sprintf("%d행: 변수를 사용한 기본 이미지는 허용되지 않습니다", inst.line))
+			case stages[strings.ToLower(image)] || image == "scratch":
+			case !p.IsImageAllowed(image):
+				violations = append(violations, fmt.Sprintf("%d행: 허용되지 않은 기본 이미지입니다: %s", inst.line, image))
+			}
+			if stage != "" {
+				stages[strings.ToLower(stage)] = true
+			}
+		case "RUN":
+			if strings.Contains(inst.args, "--security=insecure") {
+				violations = append(violations, fmt.Sprintf("%d행: RUN --security=insecure는 허용되지 않습니다", inst.line))
+			}
+		case "COPY":
+			if !strings.Contains(inst.args, "--from=") {
+				violations = append(violations, fmt.Sprintf("%d행: 로컬 파일 COPY는 지원하지 않습니다 (COPY --from만 허용)", inst.line))
+			}
+		case "ADD":
+			if !addsRemoteSource(inst.args) {
+				violations = append(violations, fmt.Sprintf("%d행: 로컬 파일 ADD는 지원하지 않습니다 (URL만 허용)", inst.line))
+			}
+		}
+	}
+
+	if !hasFrom {
+		violations = append(violations, "Dockerfile에 FROM이 없습니다")
+	}
+	return violations
+}
+
+// validateWorkspaceMounts 호스트 마운트가 워크스페이스 디렉토리 안에 있는지 확인합니다.
+func validateWorkspaceMounts(mounts []ContainerMount, projectPath string) []string {
+	var violations []string
+	root := filepath.Clean(projectPath)
+
+	for _, m := range mounts {
+		if !filepath.IsAbs(m.Source) {
+			violations = append(violations, fmt.Sprintf("마운트 원본은 절대 경로여야 합니다: %s", m.Source))
+			continue
+		}
+		if projectPath == "" || !isWithinDir(root, filepath.Clean(m.Source)) {
+			violations = append(violations, fmt.Sprintf("워크스페이스 밖의 호스트 경로는 마운트할 수 없습니다: %s", m.Source))
+		}
+		if !path.IsAbs(m.Destination) {
+			violations = append(violations, fmt.Sprintf("마운트 대상은 절대 경로여야 합니다: %s", m.Destination))
+			continue
+		}
+		target := path.Clean(m.Destination)
+		for _, blocked := range blockedMountTargets {
+			if target == blocked {
+				violations = append(violations, fmt.Sprintf("마운트할 수 없는 컨테이너 경로입니다: %s", m.Destination))
+				break
+			}
+		}
+	}
+	return violations
+}
+
+func isWithinDir(root, target string) bool {
+	// 심볼릭 링크로 워크스페이스 밖을 가리키는 경우도 차단
+	if resolved, err := filepath.EvalSymlinks(target); err == nil {
+		target = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		root = resolved
+	}
+	rel, err := filepath.Rel(root, target)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// dockerfileInstruction Dockerfile 명령 한 줄 (줄 이어쓰기 처리 후)
+type dockerfileInstruction struct {
+	line    int
+	command string
+	args    string
+}
+
+// parseDockerfile 주석과 줄 이어쓰기를 처리해 명령 목록으로 나눕니다.
+func parseDockerfile(content string) []dockerfileInstruction {
+	var instructions []dockerfileInstruction
+	var current strings.Builder
+	start := 0
+
+	for i, raw := range strings.Split(content, "\n") {
+		line := strings.TrimSpace(raw)
+		if current.Len() == 0 {
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			start = i + 1
+		}
+		if strings.HasSuffix(line, "\\") {
+			current.WriteString(strings.TrimSuffix(line, "\\"))
+			current.WriteString(" ")
+			continue
+		}
+		current.WriteString(line)
+
+		fields := strings.SplitN(current.String(), " ", 2)
+		inst := dockerfileInstruction{line: start, command: strings.ToUpper(fields[0])}
+		if len(fields) > 1 {
+			inst.args = strings.TrimSpace(fields[1])
+		}
+		instructions = append(instructions, inst)
+		current.Reset()
+	}
+	return instructions
+}
+
+// parseFromArgs "FROM [--platform=...] image [AS name]"에서 이미지와 스테이지 이름을 꺼냅니다.
+func parseFromArgs(args string) (image, stage string) {
+	fields := strings.Fields(args)
+	for len(fields) > 0 && strings.HasPrefix(fields[0], "--") {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return "", ""
+	}
+	image = fields[0]
+	if len(fields) >= 3 && strings.EqualFold(fields[1], "AS") {
+		stage = fields[2]
+	}
+	return image, stage
+}
+
+// addsRemoteSource ADD의 원본이 모두 URL인지 확인합니다.
+func addsRemoteSource(args string) bool {
+	fields := strings.Fields(args)
+	var sources []string
+	for _, field := range fields {
+		if !strings.HasPrefix(field, "--") {
+			sources = append(sources, field)
+		}
+	}
+	if len(sources) < 2 {
+		return false
+	}
+	for _, src := range sources[:len(sources)-1] {
+		if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+			return false
+		}
+	}
+	return true
+}
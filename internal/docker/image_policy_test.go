@@ -0,0 +1,114 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testImagePolicy() ImagePolicy {
+	return ImagePolicy{
+		AllowedImages:   []string{"aicli/workspace:*", "node:20*", "golang"},
+		AllowDockerfile: true,
+	}
+}
+
+func TestImagePolicy_IsImageAllowed(t *testing.T) {
+	policy := testImagePolicy()
+
+	tests := []struct {
+		image   string
+		allowed bool
+	}{
+		{"aicli/workspace", true}, // 태그 생략은 latest
+		{"aicli/workspace:1.2", true},
+		{"node:20-alpine", true},
+		{"node:18", false},
+		{"golang", true},
+		{"golang:latest", true},
+		{"golang:1.23", false},
+		{"evil/aicli/workspace:latest", false},
+		{"ubuntu", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, policy.IsImageAllowed(tt.image))
+		})
+	}
+}
+
+func TestImagePolicy_Validate(t *testing.T) {
+	projectPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(projectPath, "data"), 0755))
+	outside := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(projectPath, "escape")))
+
+	tests := []struct {
+		name      string
+		spec      WorkspaceImageSpec
+		violation string // 빈 문자열이면 통과
+	}{
+		{"allowed image", WorkspaceImageSpec{Image: "node:20"}, ""},
+		{"empty", WorkspaceImageSpec{}, "하나를 지정"},
+		{"both", WorkspaceImageSpec{Image: "node:20", Dockerfile: "FROM node:20"}, "함께 지정"},
+		{"denied image", WorkspaceImageSpec{Image: "ubuntu:22.04"}, "허용되지 않은 이미지"},
+		{"privileged", WorkspaceImageSpec{Image: "node:20", Privileged: true}, "특권 모드"},
+		{"dockerfile", WorkspaceImageSpec{Dockerfile: "FROM node:20 AS build\nRUN npm i -g pnpm\n\nFROM aicli/workspace:latest\nCOPY --from=build /usr/local /usr/local\nADD https://example.com/tool.tar.gz /opt/"}, ""},
+		{"dockerfile base", WorkspaceImageSpec{Dockerfile: "# base\nFROM ubuntu:22.04\nRUN apt-get update"}, "2행: 허용되지 않은 기본 이미지"},
+		{"dockerfile arg base", WorkspaceImageSpec{Dockerfile: "ARG BASE=node:20\nFROM ${BASE}"}, "변수를 사용한"},
+		{"dockerfile local copy", WorkspaceImageSpec{Dockerfile: "FROM node:20\nCOPY . /app"}, "로컬 파일 COPY"},
+		{"dockerfile local add", WorkspaceImageSpec{Dockerfile: "FROM node:20\nADD secrets.txt /tmp/"}, "로컬 파일 ADD"},
+		{"dockerfile insecure", WorkspaceImageSpec{Dockerfile: "FROM node:20\nRUN \\\n  --security=insecure id"}, "--security=insecure"},
+		{"mount inside", WorkspaceImageSpec{Image: "node:20", Mounts: []ContainerMount{{Source: filepath.Join(projectPath, "data"), Destination: "/data"}}}, ""},
+		{"mount outside", WorkspaceImageSpec{Image: "node:20", Mounts: []ContainerMount{{Source: outside, Destination: "/data"}}}, "워크스페이스 밖"},
+		{"mount traversal", WorkspaceImageSpec{Image: "node:20", Mounts: []ContainerMount{{Source: projectPath + "/../", Destination: "/data"}}}, "워크스페이스 밖"},
+		{"mount symlink", WorkspaceImageSpec{Image: "node:20", Mounts: []ContainerMount{{Source: filepath.Join(projectPath, "escape"), Destination: "/data"}}}, "워크스페이스 밖"},
+		{"mount docker socket", WorkspaceImageSpec{Image: "node:20", Mounts: []ContainerMount{{Source: projectPath, Destination: "/var/run/docker.sock"}}}, "마운트할 수 없는 컨테이너 경로"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testImagePolicy().Validate(tt.spec, projectPath)
+			if tt.violation == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var violation *PolicyViolationError
+			require.ErrorAs(t, err, &violation)
+			assert.Contains(t, violation.Error(), tt.violation)
+		})
+	}
+}
+
+func TestImagePolicy_DockerfileDisabled(t *testing.T) {
+	policy := testImagePolicy()
+	policy.AllowDockerfile = false
+
+	err := policy.Validate(WorkspaceImageSpec{Dockerfile: "FROM node:20"}, "/srv/app")
+	assert.ErrorContains(t, err, "Dockerfile 빌드가 허용되지 않습니다")
+}
+
+func TestImageBuilder_ImageTag(t *testing.T) {
+	builder := NewImageBuilder(&Client{labelPrefix: "aicli"})
+
+	req := ImageBuildRequest{WorkspaceID: "WS-1", Dockerfile: "FROM node:20", BuildArgs: map[string]string{"A": "1", "B": "2"}}
+	tag := builder.ImageTag(req)
+	assert.True(t, strings.HasPrefix(tag, "aicli-workspace-ws-1:"), tag)
+	assert.Equal(t, tag, builder.ImageTag(ImageBuildRequest{WorkspaceID: "WS-1", Dockerfile: "FROM node:20", BuildArgs: map[string]string{"B": "2", "A": "1"}}))
+	assert.NotEqual(t, tag, builder.ImageTag(ImageBuildRequest{WorkspaceID: "WS-1", Dockerfile: "FROM node:22"}))
+}
+
+func TestStreamBuildOutput(t *testing.T) {
+	var lines []string
+	body := `{"stream":"Step 1/2 : FROM node:20\n"}
+{"stream":" ---> abc\n"}
+{"error":"failed","errorDetail":{"message":"RUN exited with 1"}}`
+
+	err := streamBuildOutput(strings.NewReader(body), func(line string) { lines = append(lines, line) })
+	assert.EqualError(t, err, "build failed: RUN exited with 1")
+	assert.Equal(t, []string{"Step 1/2 : FROM node:20", " ---> abc", "RUN exited with 1"}, lines)
+}
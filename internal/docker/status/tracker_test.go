@@ -35,6 +35,10 @@ func (m *MockWorkspaceService) GetWorkspace(ctx context.Context, workspaceID, ow
 	return nil, errors.NewWorkspaceError(errors.ErrCodeNotFound, "workspace not found", nil)
 }
 
+func (m *MockWorkspaceService) AuthorizeWorkspace(ctx context.Context, workspaceID, userID string, required models.WorkspacePermission) (*models.Workspace, error) {
+	return m.GetWorkspace(ctx, workspaceID, userID)
+}
+
 func (m *MockWorkspaceService) ListWorkspaces(ctx context.Context, ownerID string, req *models.PaginationRequest) (*models.WorkspaceListResponse, error) {
 	var result []*models.Workspace
 	for _, workspace := range m.workspaces {
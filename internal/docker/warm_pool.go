@@ -0,0 +1,395 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// 웜 컨테이너 풀 관련 에러들
+var (
+	ErrWarmPoolEmpty      = errors.New("no warm container available")
+	ErrWarmPoolIneligible = errors.New("workspace cannot use warm container")
+)
+
+// 콜드 스타트 사유
+const (
+	ColdStartReasonEmpty      = "empty"
+	ColdStartReasonIneligible = "ineligible"
+	ColdStartReasonClaimError = "claim_error"
+)
+
+// WarmContainerRuntime 웜 컨테이너를 만들고 할당하는 런타임 (ContainerManager가 구현)
+type WarmContainerRuntime interface {
+	EnsureImage(ctx context.Context, image string) error
+	CreateWarmContainer(ctx context.Context, image, workspaceRoot string) (*WorkspaceContainer, error)
+	ClaimWarmContainer(ctx context.Context, containerID, workspaceID, relPath string, env map[string]string) (*WorkspaceContainer, error)
+	InspectContainer(ctx context.Context, containerID string) (*WorkspaceContainer, error)
+	RemoveContainer(ctx context.Context, containerID string, force bool) error
+	ListWarmContainers(ctx context.Context) ([]*WorkspaceContainer, error)
+}
+
+// WarmPoolConfig 웜 컨테이너 풀 설정
+type WarmPoolConfig struct {
+	Image         string        // 미리 만들어 둘 컨테이너 이미지
+	Size          int           // 유지할 웜 컨테이너 수
+	WorkspaceRoot string        // 웜 컨테이너에 마운트할 루트 (이 아래 프로젝트만 웜 스타트 가능)
+	HealthCheck   time.Duration // 상태 점검 주기
+	MaxIdle       time.Duration // 이 시간 이상 대기한 컨테이너는 새로 만듦 (0이면 무제한)
+
+	// Registerer Prometheus 메트릭 등록 대상 (nil이면 등록하지 않음)
+	Registerer prometheus.Registerer
+}
+
+// WarmClaimRequest 웜 컨테이너 할당 요청
+type WarmClaimRequest struct {
+	WorkspaceID string
+	Image       string
+	ProjectPath string
+	Environment map[string]string
+}
+
+// WarmPoolStats 웜 풀 현황과 콜드/웜 스타트 통계
+type WarmPoolStats struct {
+	Image            string           `json:"image"`
+	Size             int              `json:"size"`
+	Ready            int              `json:"ready"`
+	Creating         int              `json:"creating"`
+	WarmStarts       int64            `json:"warm_starts"`
+	ColdStarts       int64            `json:"cold_starts"`
+	ColdStartReasons map[string]int64 `json:"cold_start_reasons,omitempty"`
+	AvgWarmStart     time.Duration    `json:"avg_warm_start"`
+	AvgColdStart     time.Duration    `json:"avg_cold_start"`
+	CreateFailures   int64            `json:"create_failures"`
+	Unhealthy        int64            `json:"unhealthy"` // 상태 점검에서 교체된 컨테이너 수
+	Recycled         int64            `json:"recycled"`  // 대기 시간 초과로 교체된 컨테이너 수
+	LastHealthCheck  time.Time        `json:"last_health_check,omitempty"`
+	LastError        string           `json:"last_error,omitempty"`
+}
+
+// warmPoolMetrics Prometheus 웜 풀 메트릭
+type warmPoolMetrics struct {
+	starts   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	ready    prometheus.Gauge
+}
+
+func newWarmPoolMetrics(reg prometheus.Registerer) *warmPoolMetrics {
+	factory := promauto.With(reg)
+	return &warmPoolMetrics{
+		starts: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "aicli_workspace_container_starts_total",
+			Help: "워크스페이스 컨테이너 시작 수 (warm/cold)",
+		}, []string{"mode"}),
+		duration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "aicli_workspace_container_start_duration_seconds",
+			Help:    "워크스페이스 컨테이너 시작 소요 시간 (warm/cold)",
+			Buckets: []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"mode"}),
+		ready: factory.NewGauge(prometheus.GaugeOpts{
+			Name: "aicli_warm_pool_ready_containers",
+			Help: "할당 대기 중인 웜 컨테이너 수",
+		}),
+	}
+}
+
+// WarmPool 미리 만들어 둔 컨테이너를 새 워크스페이스에 할당해 시작 시간을 줄입니다.
+// 할당된 만큼 백그라운드에서 다시 채우고, 주기적으로 상태를 점검해 죽은 컨테이너를 교체합니다.
+type WarmPool struct {
+	runtime WarmContainerRuntime
+	config  WarmPoolConfig
+	metrics *warmPoolMetrics
+
+	mu       sync.Mutex
+	ready    []*WorkspaceContainer
+	creating int
+	stats    WarmPoolStats
+	warmTime time.Duration
+	coldTime time.Duration
+
+	refill chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWarmPool 새로운 웜 컨테이너 풀을 생성합니다.
+func NewWarmPool(runtime WarmContainerRuntime, config WarmPoolConfig) *WarmPool {
+	if config.HealthCheck <= 0 {
+		config.HealthCheck = 30 * time.Second
+	}
+	return &WarmPool{
+		runtime: runtime,
+		config:  config,
+		metrics: newWarmPoolMetrics(config.Registerer),
+		stats: WarmPoolStats{
+			Image:            config.Image,
+			Size:             config.Size,
+			ColdStartReasons: make(map[string]int64),
+		},
+		refill: make(chan struct{}, 1),
+	}
+}
+
+// Start 이미지를 미리 받고, 이전 실행에서 남은 웜 컨테이너를 정리한 뒤 풀을 채웁니다.
+func (wp *WarmPool) Start(ctx context.Context) error {
+	if err := wp.runtime.EnsureImage(ctx, wp.config.Image); err != nil {
+		return fmt.Errorf("pre-pull image %s: %w", wp.config.Image, err)
+	}
+
+	leftovers, err := wp.runtime.ListWarmContainers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range leftovers {
+		wp.runtime.RemoveContainer(ctx, c.ID, true)
+	}
+
+	loopCtx, cancel := context.WithCancel(context.Background())
+	wp.cancel = cancel
+	wp.wg.Add(1)
+	go wp.loop(loopCtx)
+	wp.triggerRefill()
+	return nil
+}
+
+// Stop 백그라운드 작업을 멈추고 할당되지 않은 웜 컨테이너를 삭제합니다.
+func (wp *WarmPool) Stop(ctx context.Context) {
+	if wp.cancel != nil {
+		wp.cancel()
+	}
+	wp.wg.Wait()
+
+	wp.mu.Lock()
+	ready := wp.ready
+	wp.ready = nil
+	wp.mu.Unlock()
+	wp.metrics.ready.Set(0)
+
+	for _, c := range ready {
+		wp.runtime.RemoveContainer(ctx, c.ID, true)
+	}
+}
+
+// Acquire 웜 컨테이너를 워크스페이스에 할당합니다.
+// 이미지가 다르거나 프로젝트가 워크스페이스 루트 밖에 있으면 ErrWarmPoolIneligible,
+// 대기 중인 컨테이너가 없으면 ErrWarmPoolEmpty를 반환하며 호출자는 콜드 스타트로 진행합니다.
+func (wp *WarmPool) Acquire(ctx context.Context, req WarmClaimRequest) (*WorkspaceContainer, error) {
+	relPath, ok := wp.eligible(req)
+	if !ok {
+		return nil, ErrWarmPoolIneligible
+	}
+
+	wp.mu.Lock()
+	if len(wp.ready) == 0 {
+		wp.mu.Unlock()
+		return nil, ErrWarmPoolEmpty
+	}
+	// 가장 오래 기다린 컨테이너부터 할당
+	candidate := wp.ready[0]
+	wp.ready = wp.ready[1:]
+	wp.metrics.ready.Set(float64(len(wp.ready)))
+	wp.mu.Unlock()
+	wp.triggerRefill()
+
+	start := time.Now()
+	claimed, err := wp.runtime.ClaimWarmContainer(ctx, candidate.ID, req.WorkspaceID, relPath, req.Environment)
+	if err != nil {
+		wp.runtime.RemoveContainer(context.Background(), candidate.ID, true)
+		wp.recordError(err)
+		return nil, err
+	}
+
+	wp.recordStart("warm", time.Since(start))
+	return claimed, nil
+}
+
+// RecordColdStart 웜 컨테이너 없이 새로 만든 컨테이너의 시작 시간을 기록합니다.
+func (wp *WarmPool) RecordColdStart(reason string, duration time.Duration) {
+	wp.mu.Lock()
+	wp.stats.ColdStartReasons[reason]++
+	wp.mu.Unlock()
+	wp.recordStart("cold", duration)
+}
+
+// ColdStartReason Acquire 에러를 콜드 스타트 사유로 변환합니다.
+func ColdStartReason(err error) string {
+	switch {
+	case errors.Is(err, ErrWarmPoolEmpty):
+		return ColdStartReasonEmpty
+	case errors.Is(err, ErrWarmPoolIneligible):
+		return ColdStartReasonIneligible
+	default:
+		return ColdStartReasonClaimError
+	}
+}
+
+// Stats 웜 풀 현황을 반환합니다.
+func (wp *WarmPool) Stats() WarmPoolStats {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+
+	stats := wp.stats
+	stats.Ready = len(wp.ready)
+	stats.Creating = wp.creating
+	stats.ColdStartReasons = make(map[string]int64, len(wp.stats.ColdStartReasons))
+	for reason, count := range wp.stats.ColdStartReasons {
+		stats.ColdStartReasons[reason] = count
+	}
+	if stats.WarmStarts > 0 {
+		stats.AvgWarmStart = wp.warmTime / time.Duration(stats.WarmStarts)
+	}
+	if stats.ColdStarts > 0 {
+		stats.AvgColdStart = wp.coldTime / time.Duration(stats.ColdStarts)
+	}
+	return stats
+}
+
+// eligible 요청이 웜 컨테이너를 쓸 수 있는지 확인하고 워크스페이스 루트 기준 상대 경로를 반환합니다.
+func (wp *WarmPool) eligible(req WarmClaimRequest) (string, bool) {
+	if req.Image != "" && req.Image != wp.config.Image {
+		return "", false
+	}
+	if wp.config.WorkspaceRoot == "" || req.ProjectPath == "" {
+		return "", false
+	}
+
+	relPath, err := filepath.Rel(wp.config.WorkspaceRoot, req.ProjectPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(relPath), true
+}
+
+func (wp *WarmPool) recordStart(mode string, duration time.Duration) {
+	wp.mu.Lock()
+	if mode == "warm" {
+		wp.stats.WarmStarts++
+		wp.warmTime += duration
+	} else {
+		wp.stats.ColdStarts++
+		wp.coldTime += duration
+	}
+	wp.mu.Unlock()
+
+	wp.metrics.starts.WithLabelValues(mode).Inc()
+	wp.metrics.duration.WithLabelValues(mode).Observe(duration.Seconds())
+}
+
+func (wp *WarmPool) recordError(err error) {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+	wp.stats.LastError = err.Error()
+}
+
+func (wp *WarmPool) triggerRefill() {
+	select {
+	case wp.refill <- struct{}{}:
+	default:
+	}
+}
+
+// loop 풀 채우기와 상태 점검을 수행합니다.
+func (wp *WarmPool) loop(ctx context.Context) {
+	defer wp.wg.Done()
+
+	ticker := time.NewTicker(wp.config.HealthCheck)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-wp.refill:
+			wp.fill(ctx)
+		case <-ticker.C:
+			wp.checkHealth(ctx)
+			wp.fill(ctx)
+		}
+	}
+}
+
+// fill 부족한 만큼 웜 컨테이너를 만듭니다.
+func (wp *WarmPool) fill(ctx context.Context) {
+	for {
+		wp.mu.Lock()
+		if len(wp.ready)+wp.creating >= wp.config.Size {
+			wp.mu.Unlock()
+			return
+		}
+		wp.creating++
+		wp.mu.Unlock()
+
+		c, err := wp.runtime.CreateWarmContainer(ctx, wp.config.Image, wp.config.WorkspaceRoot)
+
+		wp.mu.Lock()
+		wp.creating--
+		if err != nil {
+			wp.stats.CreateFailures++
+			wp.stats.LastError = err.Error()
+			wp.mu.Unlock()
+			// 다음 상태 점검 주기에 다시 시도
+			return
+		}
+		if ctx.Err() != nil {
+			wp.mu.Unlock()
+			wp.runtime.RemoveContainer(context.Background(), c.ID, true)
+			return
+		}
+		wp.ready = append(wp.ready, c)
+		wp.metrics.ready.Set(float64(len(wp.ready)))
+		wp.mu.Unlock()
+	}
+}
+
+// checkHealth 대기 중인 컨테이너가 실행 중인지 확인하고, 죽었거나 너무 오래된 컨테이너를 교체합니다.
+func (wp *WarmPool) checkHealth(ctx context.Context) {
+	wp.mu.Lock()
+	snapshot := append([]*WorkspaceContainer(nil), wp.ready...)
+	wp.mu.Unlock()
+
+	now := time.Now()
+	recycle := make(map[string]bool)
+	unhealthy := make(map[string]bool)
+	for _, c := range snapshot {
+		if wp.config.MaxIdle > 0 && now.Sub(c.Created) > wp.config.MaxIdle {
+			recycle[c.ID] = true
+			continue
+		}
+		info, err := wp.runtime.InspectContainer(ctx, c.ID)
+		if err != nil || info.State != ContainerStateRunning {
+			unhealthy[c.ID] = true
+		}
+	}
+
+	// 점검 중에 할당된 컨테이너는 그대로 둠
+	wp.mu.Lock()
+	kept := wp.ready[:0]
+	var removed []string
+	for _, c := range wp.ready {
+		switch {
+		case recycle[c.ID]:
+			wp.stats.Recycled++
+		case unhealthy[c.ID]:
+			wp.stats.Unhealthy++
+		default:
+			kept = append(kept, c)
+			continue
+		}
+		removed = append(removed, c.ID)
+	}
+	wp.ready = kept
+	wp.stats.LastHealthCheck = now
+	wp.metrics.ready.Set(float64(len(wp.ready)))
+	wp.mu.Unlock()
+
+	for _, id := range removed {
+		wp.runtime.RemoveContainer(ctx, id, true)
+	}
+}
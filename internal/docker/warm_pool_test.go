@@ -0,0 +1,183 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWarmRuntime 메모리에서 웜 컨테이너를 흉내내는 런타임
+type fakeWarmRuntime struct {
+	mu         sync.Mutex
+	nextID     int
+	containers map[string]*WorkspaceContainer
+	pulled     []string
+	claimed    map[string]string // 컨테이너 ID -> 상대 경로
+	claimErr   error
+}
+
+func newFakeWarmRuntime() *fakeWarmRuntime {
+	return &fakeWarmRuntime{
+		containers: make(map[string]*WorkspaceContainer),
+		claimed:    make(map[string]string),
+	}
+}
+
+func (f *fakeWarmRuntime) EnsureImage(ctx context.Context, image string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pulled = append(f.pulled, image)
+	return nil
+}
+
+func (f *fakeWarmRuntime) CreateWarmContainer(ctx context.Context, image, workspaceRoot string) (*WorkspaceContainer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	c := &WorkspaceContainer{ID: fmt.Sprintf("warm-%d", f.nextID), State: ContainerStateRunning, Created: time.Now()}
+	f.containers[c.ID] = c
+	return c, nil
+}
+
+func (f *fakeWarmRuntime) ClaimWarmContainer(ctx context.Context, containerID, workspaceID, relPath string, env map[string]string) (*WorkspaceContainer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.claimErr != nil {
+		return nil, f.claimErr
+	}
+	f.claimed[containerID] = relPath
+	return &WorkspaceContainer{ID: containerID, WorkspaceID: workspaceID, State: ContainerStateRunning}, nil
+}
+
+func (f *fakeWarmRuntime) InspectContainer(ctx context.Context, containerID string) (*WorkspaceContainer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c, ok := f.containers[containerID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	copied := *c
+	return &copied, nil
+}
+
+func (f *fakeWarmRuntime) RemoveContainer(ctx context.Context, containerID string, force bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.containers, containerID)
+	return nil
+}
+
+func (f *fakeWarmRuntime) ListWarmContainers(ctx context.Context) ([]*WorkspaceContainer, error) {
+	return nil, nil
+}
+
+func (f *fakeWarmRuntime) setState(containerID string, state ContainerState) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.containers[containerID].State = state
+}
+
+func newTestWarmPool(t *testing.T, runtime *fakeWarmRuntime, size int) *WarmPool {
+	t.Helper()
+	pool := NewWarmPool(runtime, WarmPoolConfig{
+		Image:         "aicli/workspace:latest",
+		Size:          size,
+		WorkspaceRoot: "/srv/projects",
+		HealthCheck:   time.Hour,
+	})
+	require.NoError(t, pool.Start(context.Background()))
+	t.Cleanup(func() { pool.Stop(context.Background()) })
+	require.Eventually(t, func() bool { return pool.Stats().Ready == size }, time.Second, 5*time.Millisecond)
+	return pool
+}
+
+func TestWarmPool_AcquireAndRefill(t *testing.T) {
+	runtime := newFakeWarmRuntime()
+	pool := newTestWarmPool(t, runtime, 2)
+	assert.Equal(t, []string{"aicli/workspace:latest"}, runtime.pulled)
+
+	c, err := pool.Acquire(context.Background(), WarmClaimRequest{
+		WorkspaceID: "ws-1",
+		ProjectPath: "/srv/projects/alice/app",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "warm-1", c.ID)
+	assert.Equal(t, "alice/app", runtime.claimed["warm-1"])
+
+	// 할당된 만큼 다시 채움
+	require.Eventually(t, func() bool { return pool.Stats().Ready == 2 }, time.Second, 5*time.Millisecond)
+
+	pool.RecordColdStart(ColdStartReasonIneligible, 2*time.Second)
+	stats := pool.Stats()
+	assert.Equal(t, int64(1), stats.WarmStarts)
+	assert.Equal(t, int64(1), stats.ColdStarts)
+	assert.Equal(t, 2*time.Second, stats.AvgColdStart)
+	assert.Equal(t, map[string]int64{ColdStartReasonIneligible: 1}, stats.ColdStartReasons)
+}
+
+func TestWarmPool_Ineligible(t *testing.T) {
+	runtime := newFakeWarmRuntime()
+	pool := newTestWarmPool(t, runtime, 1)
+
+	tests := []struct {
+		name string
+		req  WarmClaimRequest
+	}{
+		{"outside root", WarmClaimRequest{WorkspaceID: "ws", ProjectPath: "/home/alice/app"}},
+		{"root sibling", WarmClaimRequest{WorkspaceID: "ws", ProjectPath: "/srv/projects-old/app"}},
+		{"other image", WarmClaimRequest{WorkspaceID: "ws", Image: "node:20", ProjectPath: "/srv/projects/app"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pool.Acquire(context.Background(), tt.req)
+			assert.ErrorIs(t, err, ErrWarmPoolIneligible)
+			assert.Equal(t, ColdStartReasonIneligible, ColdStartReason(err))
+		})
+	}
+	assert.Equal(t, 1, pool.Stats().Ready)
+}
+
+func TestWarmPool_ClaimErrorRemovesContainer(t *testing.T) {
+	runtime := newFakeWarmRuntime()
+	pool := newTestWarmPool(t, runtime, 1)
+	runtime.claimErr = errors.New("exec failed")
+
+	_, err := pool.Acquire(context.Background(), WarmClaimRequest{WorkspaceID: "ws", ProjectPath: "/srv/projects/app"})
+	require.Error(t, err)
+	assert.Equal(t, ColdStartReasonClaimError, ColdStartReason(err))
+
+	_, err = runtime.InspectContainer(context.Background(), "warm-1")
+	assert.Error(t, err)
+	assert.Equal(t, "exec failed", pool.Stats().LastError)
+}
+
+func TestWarmPool_HealthCheckReplacesDeadContainers(t *testing.T) {
+	runtime := newFakeWarmRuntime()
+	pool := newTestWarmPool(t, runtime, 2)
+
+	runtime.setState("warm-1", ContainerStateExited)
+	pool.checkHealth(context.Background())
+	pool.fill(context.Background())
+
+	stats := pool.Stats()
+	assert.Equal(t, int64(1), stats.Unhealthy)
+	assert.Equal(t, 2, stats.Ready)
+	_, err := runtime.InspectContainer(context.Background(), "warm-1")
+	assert.Error(t, err)
+	_, err = runtime.InspectContainer(context.Background(), "warm-3")
+	assert.NoError(t, err)
+}
+
+func TestWarmPool_Empty(t *testing.T) {
+	pool := NewWarmPool(newFakeWarmRuntime(), WarmPoolConfig{Image: "img", Size: 1, WorkspaceRoot: "/srv"})
+
+	_, err := pool.Acquire(context.Background(), WarmClaimRequest{WorkspaceID: "ws", ProjectPath: "/srv/app"})
+	assert.ErrorIs(t, err, ErrWarmPoolEmpty)
+	assert.Equal(t, ColdStartReasonEmpty, ColdStartReason(err))
+}
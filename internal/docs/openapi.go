@@ -0,0 +1,633 @@
+package docs
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/swaggo/swag"
+)
+
+// OpenAPIVersion 생성되는 스펙의 OpenAPI 버전
+const OpenAPIVersion = "3.1.0"
+
+// Schema OpenAPI 스키마 객체
+type Schema map[string]interface{}
+
+// OpenAPIGenerator swaggo 어노테이션과 모델 검증 태그를 병합하여 OpenAPI 3.1 스펙을 생성합니다.
+type OpenAPIGenerator struct {
+	mu     sync.RWMutex
+	info   SwaggerInfo
+	models map[string]reflect.Type
+	routes []gin.RouteInfo
+
+	// WebSocket 메시지 타입별 데이터 스키마 이름
+	wsMessages map[string]string
+
+	// 생성된 스펙 캐시
+	cached []byte
+}
+
+// NewOpenAPIGenerator 새 OpenAPI 생성기를 만듭니다
+func NewOpenAPIGenerator(info SwaggerInfo) *OpenAPIGenerator {
+	return &OpenAPIGenerator{
+		info:       info,
+		models:     make(map[string]reflect.Type),
+		wsMessages: make(map[string]string),
+	}
+}
+
+// RegisterModel 스키마를 생성할 모델을 등록합니다.
+// 이름은 swaggo 정의 이름(예: models.Workspace)과 같아야 기존 정의에 병합됩니다.
+func (g *OpenAPIGenerator) RegisterModel(name string, model interface{}) {
+	t := reflect.TypeOf(model)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.models[name] = t
+	g.cached = nil
+}
+
+// RegisterWebSocketMessage WebSocket 메시지 타입의 데이터 모델을 등록합니다.
+// 모델은 일반 스키마로 추가되고, 타입과 스키마의 대응은 x-websocket-messages 확장에 기록됩니다.
+func (g *OpenAPIGenerator) RegisterWebSocketMessage(msgType, name string, model interface{}) {
+	g.RegisterModel(name, model)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.wsMessages[msgType] = name
+}
+
+// SetRoutes 어노테이션이 없는 라우트도 스펙에 포함되도록 라우터 정보를 설정합니다
+func (g *OpenAPIGenerator) SetRoutes(routes gin.RoutesInfo) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.routes = routes
+	g.cached = nil
+}
+
+// Generate OpenAPI 3.1 스펙 JSON을 생성합니다
+func (g *OpenAPIGenerator) Generate() ([]byte, error) {
+	g.mu.RLock()
+	if g.cached != nil {
+		defer g.mu.RUnlock()
+		return g.cached, nil
+	}
+	g.mu.RUnlock()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	spec := g.build()
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	g.cached = data
+	return data, nil
+}
+
+// build 스펙 객체를 조립합니다
+func (g *OpenAPIGenerator) build() map[string]interface{} {
+	paths := make(map[string]interface{})
+	schemas := make(map[string]interface{})
+
+	// swaggo가 등록한 Swagger 2.0 문서가 있으면 기반으로 사용
+	if doc, err := swag.ReadDoc(); err == nil {
+		var swagger map[string]interface{}
+		if json.Unmarshal([]byte(doc), &swagger) == nil {
+			convertSwaggerPaths(swagger, paths)
+			if defs, ok := swagger["definitions"].(map[string]interface{}); ok {
+				for name, def := range defs {
+					schemas[name] = rewriteRefs(def)
+				}
+			}
+		}
+	}
+
+	// 등록된 모델의 검증 태그를 스키마에 반영
+	for name, t := range g.models {
+		generated := SchemaFromType(t)
+		if existing, ok := schemas[name].(map[string]interface{}); ok {
+			schemas[name] = mergeSchema(existing, generated)
+		} else {
+			schemas[name] = generated
+		}
+	}
+
+	// 어노테이션이 없는 라우트 보완
+	for _, route := range g.routes {
+		if !strings.HasPrefix(route.Path, g.info.BasePath) {
+			continue
+		}
+		path := toOpenAPIPath(strings.TrimPrefix(route.Path, g.info.BasePath))
+		method := strings.ToLower(route.Method)
+
+		item, _ := paths[path].(map[string]interface{})
+		if item == nil {
+			item = make(map[string]interface{})
+			paths[path] = item
+		}
+		if _, exists := item[method]; exists {
+			continue
+		}
+		item[method] = map[string]interface{}{
+			"parameters": pathParameters(path),
+			"responses": map[string]interface{}{
+				"default": map[string]interface{}{"description": "응답"},
+			},
+		}
+	}
+
+	spec := map[string]interface{}{
+		"openapi": OpenAPIVersion,
+		"info": map[string]interface{}{
+			"title":       g.info.Title,
+			"description": g.info.Description,
+			"version":     g.info.Version,
+		},
+		"servers": []interface{}{
+			map[string]interface{}{"url": g.info.BasePath},
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"BearerAuth": map[string]interface{}{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+				},
+			},
+		},
+	}
+
+	if len(g.wsMessages) > 0 {
+		messages := make(map[string]interface{}, len(g.wsMessages))
+		for msgType, name := range g.wsMessages {
+			messages[msgType] = map[string]interface{}{"$ref": "#/components/schemas/" + name}
+		}
+		spec["x-websocket-messages"] = messages
+	}
+	return spec
+}
+
+// OpenAPIHandler 생성된 스펙을 제공하는 핸들러를 반환합니다
+func OpenAPIHandler(g *OpenAPIGenerator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		data, err := g.Generate()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "OpenAPI 스펙 생성 실패",
+				"message": err.Error(),
+			})
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", data)
+	}
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// SchemaFromType 구조체 타입으로부터 JSON 스키마를 생성합니다.
+// binding/validate 태그의 required, min, max, len, oneof, email, uuid, url 등을 제약 조건으로 변환합니다.
+func SchemaFromType(t reflect.Type) Schema {
+	return schemaFromType(t, make(map[reflect.Type]bool))
+}
+
+func schemaFromType(t reflect.Type, visiting map[reflect.Type]bool) Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return Schema{"type": "string", "format": "date-time"}
+	}
+	// 원시 JSON은 어떤 값이든 올 수 있음
+	if t == rawMessageType {
+		return Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": schemaFromType(t.Elem(), visiting)}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": schemaFromType(t.Elem(), visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			return Schema{"type": "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		properties := make(map[string]interface{})
+		var required []string
+		collectFields(t, properties, &required, visiting)
+
+		schema := Schema{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			sort.Strings(required)
+			schema["required"] = required
+		}
+		return schema
+	default:
+		return Schema{}
+	}
+}
+
+// collectFields 구조체 필드를 순회하며 프로퍼티를 수집합니다 (임베디드 구조체 포함)
+func collectFields(t reflect.Type, properties map[string]interface{}, required *[]string, visiting map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		// 임베디드 구조체의 필드는 상위로 승격
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				collectFields(ft, properties, required, visiting)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := schemaFromType(field.Type, visiting)
+		bindingRequired := applyConstraints(prop, field.Type, field.Tag.Get("binding"))
+		validateRequired := applyConstraints(prop, field.Type, field.Tag.Get("validate"))
+		if bindingRequired || validateRequired {
+			*required = appendUnique(*required, name)
+		}
+		if example := field.Tag.Get("example"); example != "" {
+			prop["examples"] = []interface{}{example}
+		}
+		properties[name] = prop
+	}
+}
+
+// jsonFieldName json 태그에서 필드 이름을 추출합니다
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	name := strings.Split(tag, ",")[0]
+	return name, false
+}
+
+// applyConstraints 검증 태그를 스키마 제약 조건으로 변환합니다. 필수 필드 여부를 반환합니다.
+func applyConstraints(prop Schema, t reflect.Type, tag string) bool {
+	if tag == "" || tag == "-" {
+		return false
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	required := false
+	for _, rule := range strings.Split(tag, ",") {
+		// dive 이후 규칙은 요소에 적용되므로 건너뜀
+		if rule == "dive" {
+			break
+		}
+
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			required = true
+		case "min", "gte":
+			setBound(prop, t, value, "minLength", "minimum", "minItems")
+		case "max", "lte":
+			setBound(prop, t, value, "maxLength", "maximum", "maxItems")
+		case "gt":
+			setBound(prop, t, value, "", "exclusiveMinimum", "")
+		case "lt":
+			setBound(prop, t, value, "", "exclusiveMaximum", "")
+		case "len":
+			setBound(prop, t, value, "minLength", "minimum", "minItems")
+			setBound(prop, t, value, "maxLength", "maximum", "maxItems")
+		case "oneof":
+			prop["enum"] = enumValues(t, strings.Fields(value))
+		case "email":
+			prop["format"] = "email"
+		case "uuid", "uuid4":
+			prop["format"] = "uuid"
+		case "url", "uri":
+			prop["format"] = "uri"
+		case "ip":
+			prop["format"] = "ipv4"
+		case "alphanum":
+			prop["pattern"] = "^[a-zA-Z0-9]*$"
+		case "alpha":
+			prop["pattern"] = "^[a-zA-Z]*$"
+		case "numeric":
+			prop["pattern"] = "^[0-9]*$"
+		default:
+			pattern, values := customRule(key)
+			if pattern != "" {
+				prop["pattern"] = pattern
+			}
+			if values != nil {
+				prop["enum"] = enumValues(t, values)
+			}
+		}
+	}
+	return required
+}
+
+// customMu customPatterns, customEnums 보호 (등록과 스펙 생성이 동시에 일어날 수 있음)
+var customMu sync.RWMutex
+
+// customPatterns 커스텀 검증자를 표현하는 정규식
+var customPatterns = map[string]string{
+	"claude_api_key":   `^sk-ant-[a-zA-Z0-9\-_]+$`,
+	"no_special_chars": `^[^<>:"/\\|?*]*$`,
+}
+
+// customEnums 커스텀 상태 검증자를 표현하는 열거값
+var customEnums = map[string][]string{
+	"workspace_status": {"active", "inactive", "archived"},
+	"task_status":      {"pending", "running", "completed", "failed", "cancelled"},
+}
+
+// RegisterPattern 커스텀 검증 태그에 대응하는 정규식을 등록합니다
+func RegisterPattern(tag, pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return err
+	}
+	customMu.Lock()
+	defer customMu.Unlock()
+	customPatterns[tag] = pattern
+	return nil
+}
+
+// RegisterEnum 커스텀 검증 태그에 대응하는 열거값을 등록합니다
+func RegisterEnum(tag string, values ...string) {
+	customMu.Lock()
+	defer customMu.Unlock()
+	customEnums[tag] = append([]string(nil), values...)
+}
+
+// customRule 커스텀 검증 태그에 등록된 정규식과 열거값 조회
+func customRule(tag string) (string, []string) {
+	customMu.RLock()
+	defer customMu.RUnlock()
+	return customPatterns[tag], customEnums[tag]
+}
+
+// setBound 타입에 맞는 경계 키워드를 설정합니다
+func setBound(prop Schema, t reflect.Type, value, strKey, numKey, arrKey string) {
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if strKey != "" {
+			prop[strKey] = int(n)
+		}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if arrKey != "" {
+			prop[arrKey] = int(n)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		prop[numKey] = int64(n)
+	case reflect.Float32, reflect.Float64:
+		prop[numKey] = n
+	}
+}
+
+// enumValues 필드 타입에 맞게 열거값을 변환합니다
+func enumValues(t reflect.Type, values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				result = append(result, n)
+				continue
+			}
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// mergeSchema swaggo 정의에 반영되지 않은 제약 조건을 덮어씁니다
+func mergeSchema(base map[string]interface{}, generated Schema) map[string]interface{} {
+	baseProps, _ := base["properties"].(map[string]interface{})
+	genProps, _ := generated["properties"].(map[string]interface{})
+	if baseProps == nil {
+		baseProps = make(map[string]interface{})
+		base["properties"] = baseProps
+	}
+
+	for name, gp := range genProps {
+		bp, ok := baseProps[name].(map[string]interface{})
+		if !ok {
+			baseProps[name] = gp
+			continue
+		}
+		for k, v := range gp.(Schema) {
+			// 참조 스키마는 유지하고 제약 조건만 추가
+			if _, isRef := bp["$ref"]; isRef && (k == "type" || k == "properties") {
+				continue
+			}
+			if _, exists := bp[k]; exists && k == "description" {
+				continue
+			}
+			bp[k] = v
+		}
+	}
+
+	if req, ok := generated["required"]; ok {
+		base["required"] = req
+	}
+	return base
+}
+
+// convertSwaggerPaths Swagger 2.0 경로를 OpenAPI 3 형식으로 변환합니다
+func convertSwaggerPaths(swagger map[string]interface{}, paths map[string]interface{}) {
+	swPaths, _ := swagger["paths"].(map[string]interface{})
+	for path, rawItem := range swPaths {
+		item, ok := rawItem.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		converted := make(map[string]interface{})
+		for method, rawOp := range item {
+			op, ok := rawOp.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			converted[method] = convertOperation(op)
+		}
+		paths[path] = converted
+	}
+}
+
+// convertOperation body 파라미터를 requestBody로, 응답 스키마를 content로 옮깁니다
+func convertOperation(op map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range op {
+		switch k {
+		case "consumes", "produces":
+			continue
+		case "parameters":
+			var params []interface{}
+			for _, rawParam := range v.([]interface{}) {
+				param, ok := rawParam.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if param["in"] == "body" {
+					result["requestBody"] = map[string]interface{}{
+						"required": param["required"],
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{
+								"schema": rewriteRefs(param["schema"]),
+							},
+						},
+					}
+					continue
+				}
+				params = append(params, convertParameter(param))
+			}
+			if len(params) > 0 {
+				result["parameters"] = params
+			}
+		case "responses":
+			responses := make(map[string]interface{})
+			for code, rawResp := range v.(map[string]interface{}) {
+				resp, ok := rawResp.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				converted := map[string]interface{}{"description": resp["description"]}
+				if schema, ok := resp["schema"]; ok {
+					converted["content"] = map[string]interface{}{
+						"application/json": map[string]interface{}{
+							"schema": rewriteRefs(schema),
+						},
+					}
+				}
+				responses[code] = converted
+			}
+			result["responses"] = responses
+		default:
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// convertParameter 비-body 파라미터의 타입 정보를 schema로 옮깁니다
+func convertParameter(param map[string]interface{}) map[string]interface{} {
+	schema := make(map[string]interface{})
+	result := make(map[string]interface{})
+	for k, v := range param {
+		switch k {
+		case "type", "format", "enum", "default", "minimum", "maximum",
+			"minLength", "maxLength", "pattern", "items":
+			schema[k] = v
+		default:
+			result[k] = v
+		}
+	}
+	result["schema"] = schema
+	return result
+}
+
+// rewriteRefs #/definitions/ 참조를 #/components/schemas/ 로 변경합니다
+func rewriteRefs(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, child := range val {
+			if k == "$ref" {
+				if ref, ok := child.(string); ok {
+					out[k] = strings.Replace(ref, "#/definitions/", "#/components/schemas/", 1)
+					continue
+				}
+			}
+			out[k] = rewriteRefs(child)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, child := range val {
+			out[i] = rewriteRefs(child)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+var ginParamPattern = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// toOpenAPIPath gin 경로 파라미터(:id)를 OpenAPI 형식({id})으로 변환합니다
+func toOpenAPIPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return ginParamPattern.ReplaceAllString(path, "{$1}")
+}
+
+// pathParameters 경로에서 파라미터 정의를 추출합니다
+func pathParameters(path string) []interface{} {
+	params := []interface{}{}
+	for _, m := range regexp.MustCompile(`\{([^}]+)\}`).FindAllStringSubmatch(path, -1) {
+		params = append(params, map[string]interface{}{
+			"name":     m[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+	return params
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
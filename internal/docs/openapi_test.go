@@ -0,0 +1,117 @@
+package docs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEmbedded struct {
+	ID string `json:"id" validate:"omitempty,uuid"`
+}
+
+type testRequest struct {
+	testEmbedded
+	Name     string   `json:"name" binding:"required,min=1,max=100"`
+	Email    string   `json:"email" validate:"omitempty,email"`
+	Order    string   `json:"order" binding:"oneof=asc desc"`
+	Limit    int      `json:"limit" binding:"min=1,max=100"`
+	Tags     []string `json:"tags" validate:"max=5,dive,min=1"`
+	Key      string   `json:"key" validate:"omitempty,claude_api_key"`
+	Internal string   `json:"-"`
+}
+
+func TestSchemaFromType_Constraints(t *testing.T) {
+	schema := SchemaFromType(reflect.TypeOf(testRequest{}))
+
+	props := schema["properties"].(map[string]interface{})
+	require.Contains(t, props, "id")
+	assert.NotContains(t, props, "Internal")
+
+	name := props["name"].(Schema)
+	assert.Equal(t, 1, name["minLength"])
+	assert.Equal(t, 100, name["maxLength"])
+
+	assert.Equal(t, "email", props["email"].(Schema)["format"])
+	assert.Equal(t, "uuid", props["id"].(Schema)["format"])
+	assert.Equal(t, []interface{}{"asc", "desc"}, props["order"].(Schema)["enum"])
+
+	limit := props["limit"].(Schema)
+	assert.Equal(t, int64(1), limit["minimum"])
+	assert.Equal(t, int64(100), limit["maximum"])
+
+	tags := props["tags"].(Schema)
+	assert.Equal(t, 5, tags["maxItems"])
+	assert.NotContains(t, tags, "minItems")
+
+	assert.NotEmpty(t, props["key"].(Schema)["pattern"])
+	assert.Equal(t, []string{"name"}, schema["required"])
+}
+
+func TestRegisterCustomRules_Concurrent(t *testing.T) {
+	type ruleRequest struct {
+		Mode string `json:"mode" binding:"test_mode"`
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterEnum("test_mode", "fast", "slow")
+			require.NoError(t, RegisterPattern("test_pattern", `^[a-z]+$`))
+		}()
+		go func() {
+			defer wg.Done()
+			SchemaFromType(reflect.TypeOf(ruleRequest{}))
+		}()
+	}
+	wg.Wait()
+
+	props := SchemaFromType(reflect.TypeOf(ruleRequest{}))["properties"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"fast", "slow"}, props["mode"].(Schema)["enum"])
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	gen := NewOpenAPIGenerator(GetSwaggerInfo())
+	gen.RegisterModel("docs.testRequest", &testRequest{})
+
+	router.GET("/api/v1/openapi.json", OpenAPIHandler(gen))
+	router.GET("/api/v1/workspaces/:id", func(c *gin.Context) {})
+	gen.SetRoutes(router.Routes())
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/openapi.json", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var spec map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+	assert.Equal(t, OpenAPIVersion, spec["openapi"])
+
+	paths := spec["paths"].(map[string]interface{})
+	require.Contains(t, paths, "/workspaces/{id}")
+	op := paths["/workspaces/{id}"].(map[string]interface{})["get"].(map[string]interface{})
+	assert.Len(t, op["parameters"], 1)
+
+	schemas := spec["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	assert.Contains(t, schemas, "docs.testRequest")
+}
+
+func TestRewriteRefs(t *testing.T) {
+	in := map[string]interface{}{
+		"items": map[string]interface{}{"$ref": "#/definitions/models.Workspace"},
+	}
+	out := rewriteRefs(in).(map[string]interface{})
+	assert.Equal(t, "#/components/schemas/models.Workspace", out["items"].(map[string]interface{})["$ref"])
+}
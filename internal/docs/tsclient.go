@@ -0,0 +1,600 @@
+package docs
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultWebSocketEnvelope WebSocket 메시지 봉투의 스키마 이름
+const DefaultWebSocketEnvelope = "websocket.Envelope"
+
+// TSClientOptions TypeScript 클라이언트 생성 옵션
+type TSClientOptions struct {
+	// ClientVersion 생성된 클라이언트에 기록할 빌드 버전 (비어 있으면 API 버전 사용)
+	ClientVersion string
+	// WebSocketEnvelope x-websocket-messages의 데이터를 감싸는 메시지 스키마 이름
+	WebSocketEnvelope string
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// httpMethods 클라이언트 메서드 생성 순서
+var httpMethods = []string{"get", "post", "put", "patch", "delete"}
+
+// GenerateTypeScriptClient OpenAPI 스펙(JSON)으로부터 TypeScript 클라이언트를 생성합니다.
+// components.schemas는 인터페이스로, paths는 fetch 기반 ApiClient 메서드로,
+// x-websocket-messages 확장은 메시지 타입별 판별 유니온으로 변환됩니다.
+func GenerateTypeScriptClient(spec []byte, opts TSClientOptions) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("OpenAPI 스펙 파싱 실패: %w", err)
+	}
+	if opts.WebSocketEnvelope == "" {
+		opts.WebSocketEnvelope = DefaultWebSocketEnvelope
+	}
+
+	components, _ := doc["components"].(map[string]interface{})
+	schemas, _ := components["schemas"].(map[string]interface{})
+	g := &tsGenerator{names: tsTypeNames(schemas)}
+
+	info, _ := doc["info"].(map[string]interface{})
+	apiVersion, _ := info["version"].(string)
+	clientVersion := opts.ClientVersion
+	if clientVersion == "" {
+		clientVersion = apiVersion
+	}
+	baseURL := "/"
+	if servers, ok := doc["servers"].([]interface{}); ok && len(servers) > 0 {
+		if server, ok := servers[0].(map[string]interface{}); ok {
+			if url, ok := server["url"].(string); ok && url != "" {
+				baseURL = url
+			}
+		}
+	}
+
+	g.line("// Code generated by tsclient-gen from the OpenAPI spec. DO NOT EDIT.")
+	if title, ok := info["title"].(string); ok && title != "" {
+		g.line("// %s", title)
+	}
+	g.line("/* eslint-disable */")
+	g.line("")
+	g.line("/** 스펙의 API 버전 */")
+	g.line("export const API_VERSION = %s", tsString(apiVersion))
+	g.line("/** 클라이언트를 생성한 서버 빌드 버전 */")
+	g.line("export const CLIENT_VERSION = %s", tsString(clientVersion))
+
+	g.writeSchemas(schemas)
+	if messages, ok := doc["x-websocket-messages"].(map[string]interface{}); ok && len(messages) > 0 {
+		envelope, dataField := "", "data"
+		if schema, ok := schemas[opts.WebSocketEnvelope].(map[string]interface{}); ok {
+			envelope = g.names[opts.WebSocketEnvelope]
+			// v2 봉투는 data 대신 payload에 메시지 데이터를 담음
+			if props, ok := schema["properties"].(map[string]interface{}); ok {
+				if _, ok := props["payload"]; ok {
+					dataField = "payload"
+				}
+			}
+		}
+		g.writeWebSocketMessages(messages, envelope, dataField)
+	}
+	paths, _ := doc["paths"].(map[string]interface{})
+	g.writeClient(paths, baseURL)
+
+	return []byte(g.buf.String()), nil
+}
+
+type tsGenerator struct {
+	buf   strings.Builder
+	names map[string]string
+}
+
+func (g *tsGenerator) line(format string, args ...interface{}) {
+	if len(args) > 0 {
+		format = fmt.Sprintf(format, args...)
+	}
+	g.buf.WriteString(format)
+	g.buf.WriteByte('\n')
+}
+
+func (g *tsGenerator) comment(indent, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	text = strings.ReplaceAll(text, "*/", "*\\/")
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		g.line("%s/** %s */", indent, lines[0])
+		return
+	}
+	g.line("%s/**", indent)
+	for _, l := range lines {
+		g.line("%s * %s", indent, strings.TrimSpace(l))
+	}
+	g.line("%s */", indent)
+}
+
+// writeSchemas 스키마를 인터페이스(객체) 또는 타입 별칭으로 출력합니다
+func (g *tsGenerator) writeSchemas(schemas map[string]interface{}) {
+	for _, name := range sortedKeys(schemas) {
+		schema, _ := schemas[name].(map[string]interface{})
+		g.line("")
+		g.comment("", stringValue(schema["description"]))
+
+		props, hasProps := schema["properties"].(map[string]interface{})
+		if !hasProps || schema["type"] != "object" {
+			g.line("export type %s = %s", g.names[name], g.tsType(schema, ""))
+			continue
+		}
+		g.line("export interface %s {", g.names[name])
+		g.writeProperties(props, requiredSet(schema), "  ")
+		g.line("}")
+	}
+}
+
+func (g *tsGenerator) writeProperties(props map[string]interface{}, required map[string]bool, indent string) {
+	for _, prop := range sortedKeys(props) {
+		propSchema, _ := props[prop].(map[string]interface{})
+		g.comment(indent, stringValue(propSchema["description"]))
+		optional := "?"
+		if required[prop] {
+			optional = ""
+		}
+		g.line("%s%s%s: %s", indent, tsPropertyName(prop), optional, g.tsType(propSchema, indent))
+	}
+}
+
+// writeWebSocketMessages 메시지 타입별 데이터 대응과 판별 유니온을 출력합니다
+func (g *tsGenerator) writeWebSocketMessages(messages map[string]interface{}, envelope, dataField string) {
+	types := sortedKeys(messages)
+	literals := make([]string, len(types))
+	for i, t := range types {
+		literals[i] = tsString(t)
+	}
+
+	g.line("")
+	g.line("/** WebSocket 메시지 타입 */")
+	g.line("export type WebSocketMessageType = %s", strings.Join(literals, " | "))
+	g.line("")
+	g.line("/** WebSocket 메시지 타입별 %s 필드 */", dataField)
+	g.line("export interface WebSocketMessageDataMap {")
+	for _, t := range types {
+		schema, _ := messages[t].(map[string]interface{})
+		g.line("  %s: %s", tsPropertyName(t), g.tsType(schema, "  "))
+	}
+	g.line("}")
+	g.line("")
+	g.line("/** 특정 타입의 WebSocket 메시지 */")
+	if envelope != "" {
+		g.line("export type WebSocketMessageOf<K extends WebSocketMessageType> = Omit<%s, 'type' | '%s'> & {", envelope, dataField)
+	} else {
+		g.line("export type WebSocketMessageOf<K extends WebSocketMessageType> = {")
+	}
+	g.line("  type: K")
+	g.line("  %s: WebSocketMessageDataMap[K]", dataField)
+	g.line("}")
+	g.line("")
+	g.line("/** type으로 data 타입이 결정되는 WebSocket 메시지 */")
+	g.line("export type WebSocketMessage = {")
+	g.line("  [K in WebSocketMessageType]: WebSocketMessageOf<K>")
+	g.line("}[WebSocketMessageType]")
+}
+
+// tsOperation 클라이언트 메서드로 변환할 연산
+type tsOperation struct {
+	name     string
+	method   string
+	path     string
+	summary  string
+	params   []string // 경로 파라미터 (경로 순서)
+	query    map[string]interface{}
+	required map[string]bool
+	body     string
+	bodyReq  bool
+	response string
+}
+
+// writeClient fetch 기반 API 클라이언트를 출력합니다
+func (g *tsGenerator) writeClient(paths map[string]interface{}, baseURL string) {
+	g.line("")
+	g.line("export interface ApiClientOptions {")
+	g.line("  /** API 기본 경로 (기본값 %s) */", tsString(baseURL))
+	g.line("  baseUrl?: string")
+	g.line("  /** 요청마다 Authorization 헤더에 넣을 토큰 */")
+	g.line("  getToken?: () => string | null | undefined")
+	g.line("  fetch?: typeof fetch")
+	g.line("}")
+	g.line("")
+	g.line("/** 2xx가 아닌 응답 */")
+	g.line("export class ApiRequestError extends Error {")
+	g.line("  constructor(")
+	g.line("    public readonly status: number,")
+	g.line("    public readonly body: unknown")
+	g.line("  ) {")
+	g.line("    super(`API 요청 실패 (${status})`)")
+	g.line("    this.name = 'ApiRequestError'")
+	g.line("  }")
+	g.line("}")
+	g.line("")
+	g.line("export type QueryParams = Record<string, string | number | boolean | undefined | null>")
+	g.line("")
+	g.line("export class ApiClient {")
+	g.line("  private readonly baseUrl: string")
+	g.line("")
+	g.line("  constructor(private readonly options: ApiClientOptions = {}) {")
+	g.line("    this.baseUrl = (options.baseUrl ?? %s).replace(/\\/$/, '')", tsString(baseURL))
+	g.line("  }")
+	g.line("")
+	g.line("  async request<T>(method: string, path: string, query?: QueryParams, body?: unknown): Promise<T> {")
+	g.line("    const params = new URLSearchParams()")
+	g.line("    for (const [key, value] of Object.entries(query ?? {})) {")
+	g.line("      if (value !== undefined && value !== null) params.append(key, String(value))")
+	g.line("    }")
+	g.line("    const search = params.toString()")
+	g.line("    const headers: Record<string, string> = { Accept: 'application/json' }")
+	g.line("    const token = this.options.getToken?.()")
+	g.line("    if (token) headers.Authorization = `Bearer ${token}`")
+	g.line("    if (body !== undefined) headers['Content-Type'] = 'application/json'")
+	g.line("")
+	g.line("    const doFetch = this.options.fetch ?? fetch")
+	g.line("    const response = await doFetch(`${this.baseUrl}${path}${search ? `?${search}` : ''}`, {")
+	g.line("      method,")
+	g.line("      headers,")
+	g.line("      body: body === undefined ? undefined : JSON.stringify(body),")
+	g.line("    })")
+	g.line("    const text = await response.text()")
+	g.line("    const data = text ? JSON.parse(text) : undefined")
+	g.line("    if (!response.ok) throw new ApiRequestError(response.status, data)")
+	g.line("    return data as T")
+	g.line("  }")
+
+	for _, op := range g.operations(paths) {
+		g.line("")
+		g.comment("  ", strings.TrimSpace(op.summary+"\n"+strings.ToUpper(op.method)+" "+op.path))
+
+		var args []string
+		for _, p := range op.params {
+			args = append(args, tsIdentifier(p)+": string")
+		}
+		if op.body != "" {
+			if op.bodyReq {
+				args = append(args, "body: "+op.body)
+			} else {
+				args = append(args, "body?: "+op.body)
+			}
+		}
+		queryArg := "undefined"
+		if len(op.query) > 0 {
+			var fields []string
+			for _, name := range sortedKeys(op.query) {
+				schema, _ := op.query[name].(map[string]interface{})
+				optional := "?"
+				if op.required[name] {
+					optional = ""
+				}
+				fields = append(fields, fmt.Sprintf("%s%s: %s", tsPropertyName(name), optional, g.tsType(schema, "")))
+			}
+			args = append(args, "query: { "+strings.Join(fields, "; ")+" } = {}")
+			queryArg = "query"
+		}
+
+		path := op.path
+		for _, p := range op.params {
+			path = strings.Replace(path, "{"+p+"}", "${encodeURIComponent("+tsIdentifier(p)+")}", 1)
+		}
+		call := fmt.Sprintf("this.request<%s>('%s', `%s`", op.response, strings.ToUpper(op.method), path)
+		switch {
+		case op.body != "":
+			call += ", " + queryArg + ", body"
+		case queryArg != "undefined":
+			call += ", " + queryArg
+		}
+
+		g.line("  %s(%s): Promise<%s> {", op.name, strings.Join(args, ", "), op.response)
+		g.line("    return %s)", call)
+		g.line("  }")
+	}
+	g.line("}")
+}
+
+// operations 경로와 메서드 순서대로 연산을 수집합니다
+func (g *tsGenerator) operations(paths map[string]interface{}) []tsOperation {
+	var ops []tsOperation
+	used := make(map[string]int)
+
+	for _, path := range sortedKeys(paths) {
+		item, _ := paths[path].(map[string]interface{})
+		for _, method := range httpMethods {
+			raw, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			op := tsOperation{
+				method:   method,
+				path:     path,
+				summary:  stringValue(raw["summary"]),
+				query:    make(map[string]interface{}),
+				required: make(map[string]bool),
+				response: g.responseType(raw),
+			}
+			for _, m := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+				op.params = append(op.params, m[1])
+			}
+			if params, ok := raw["parameters"].([]interface{}); ok {
+				for _, rawParam := range params {
+					param, _ := rawParam.(map[string]interface{})
+					if param["in"] != "query" {
+						continue
+					}
+					name := stringValue(param["name"])
+					op.query[name] = param["schema"]
+					op.required[name], _ = param["required"].(bool)
+				}
+			}
+			if body, ok := raw["requestBody"].(map[string]interface{}); ok {
+				op.body = g.tsType(jsonContentSchema(body), "")
+				op.bodyReq, _ = body["required"].(bool)
+			} else if method == "post" || method == "put" || method == "patch" {
+				op.body = "unknown"
+			}
+
+			name := stringValue(raw["operationId"])
+			if name == "" {
+				name = operationName(method, path)
+			}
+			name = tsIdentifier(name)
+			used[name]++
+			if n := used[name]; n > 1 {
+				name = fmt.Sprintf("%s%d", name, n)
+			}
+			op.name = name
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// responseType 성공 응답(200, 201, 202, 204)의 본문 타입을 구합니다
+func (g *tsGenerator) responseType(op map[string]interface{}) string {
+	responses, _ := op["responses"].(map[string]interface{})
+	for _, code := range []string{"200", "201", "202"} {
+		if resp, ok := responses[code].(map[string]interface{}); ok {
+			if schema := jsonContentSchema(resp); schema != nil {
+				return g.tsType(schema, "")
+			}
+			return "unknown"
+		}
+	}
+	if _, ok := responses["204"]; ok {
+		return "void"
+	}
+	return "unknown"
+}
+
+// tsType JSON 스키마를 TypeScript 타입 표현식으로 변환합니다
+func (g *tsGenerator) tsType(schema map[string]interface{}, indent string) string {
+	if schema == nil {
+		return "unknown"
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		if name, ok := g.names[strings.TrimPrefix(ref, "#/components/schemas/")]; ok {
+			return name
+		}
+		return "unknown"
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok && len(enum) > 0 {
+		literals := make([]string, 0, len(enum))
+		for _, v := range enum {
+			data, _ := json.Marshal(v)
+			if s, ok := v.(string); ok {
+				literals = append(literals, tsString(s))
+			} else {
+				literals = append(literals, string(data))
+			}
+		}
+		return strings.Join(literals, " | ")
+	}
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if variants, ok := schema[key].([]interface{}); ok && len(variants) > 0 {
+			types := make([]string, 0, len(variants))
+			for _, v := range variants {
+				vs, _ := v.(map[string]interface{})
+				types = append(types, g.tsType(vs, indent))
+			}
+			return strings.Join(types, " | ")
+		}
+	}
+	if variants, ok := schema["allOf"].([]interface{}); ok && len(variants) > 0 {
+		types := make([]string, 0, len(variants))
+		for _, v := range variants {
+			vs, _ := v.(map[string]interface{})
+			types = append(types, g.tsType(vs, indent))
+		}
+		return strings.Join(types, " & ")
+	}
+
+	// OpenAPI 3.1은 type에 배열을 허용 (예: ["string", "null"])
+	if list, ok := schema["type"].([]interface{}); ok {
+		types := make([]string, 0, len(list))
+		for _, t := range list {
+			copied := make(map[string]interface{}, len(schema))
+			for k, v := range schema {
+				copied[k] = v
+			}
+			copied["type"] = t
+			types = append(types, g.tsType(copied, indent))
+		}
+		return strings.Join(types, " | ")
+	}
+
+	switch schema["type"] {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "null":
+		return "null"
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		item := g.tsType(items, indent)
+		if strings.ContainsAny(item, "|&{ ") {
+			return "Array<" + item + ">"
+		}
+		return item + "[]"
+	case "object":
+		if props, ok := schema["properties"].(map[string]interface{}); ok && len(props) > 0 {
+			var inner tsGenerator
+			inner.names = g.names
+			inner.writeProperties(props, requiredSet(schema), indent+"  ")
+			return "{\n" + inner.buf.String() + indent + "}"
+		}
+		if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			return "Record<string, " + g.tsType(additional, indent) + ">"
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+// tsTypeNames 스키마 이름(models.Workspace)을 TypeScript 타입 이름(Workspace)으로 바꿉니다.
+// 마지막 구성 요소가 겹치면 패키지 이름까지 포함합니다.
+func tsTypeNames(schemas map[string]interface{}) map[string]string {
+	counts := make(map[string]int)
+	for name := range schemas {
+		counts[pascalCase(lastSegment(name))]++
+	}
+
+	names := make(map[string]string, len(schemas))
+	for name := range schemas {
+		short := pascalCase(lastSegment(name))
+		if counts[short] > 1 {
+			short = pascalCase(name)
+		}
+		names[name] = short
+	}
+	return names
+}
+
+// operationName 메서드와 경로로 메서드 이름을 만듭니다 (GET /workspaces/{id} → getWorkspacesById)
+func operationName(method, path string) string {
+	var b strings.Builder
+	b.WriteString(method)
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			b.WriteString("By")
+			segment = strings.Trim(segment, "{}")
+		}
+		b.WriteString(pascalCase(segment))
+	}
+	return b.String()
+}
+
+func lastSegment(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// pascalCase 영숫자가 아닌 문자를 경계로 단어를 나누어 이어 붙입니다
+func pascalCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// tsIdentifier 식별자로 쓸 수 없는 문자를 제거합니다
+func tsIdentifier(s string) string {
+	p := pascalCase(s)
+	if p == "" {
+		return "_"
+	}
+	runes := []rune(p)
+	if unicode.IsDigit(runes[0]) {
+		return "_" + p
+	}
+	if s != "" && unicode.IsLower([]rune(s)[0]) {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes)
+}
+
+// tsPropertyName 식별자가 아닌 프로퍼티 이름은 따옴표로 감쌉니다
+func tsPropertyName(name string) string {
+	for i, r := range name {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return tsString(name)
+	}
+	if name == "" {
+		return "''"
+	}
+	return name
+}
+
+func tsString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "'", `\'`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return "'" + s + "'"
+}
+
+func jsonContentSchema(obj map[string]interface{}) map[string]interface{} {
+	content, _ := obj["content"].(map[string]interface{})
+	media, _ := content["application/json"].(map[string]interface{})
+	schema, _ := media["schema"].(map[string]interface{})
+	return schema
+}
+
+func requiredSet(schema map[string]interface{}) map[string]bool {
+	set := make(map[string]bool)
+	switch required := schema["required"].(type) {
+	case []interface{}:
+		for _, r := range required {
+			if s, ok := r.(string); ok {
+				set[s] = true
+			}
+		}
+	case []string:
+		for _, s := range required {
+			set[s] = true
+		}
+	}
+	return set
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+package docs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnvelope struct {
+	Type    string          `json:"type"`
+	Version int             `json:"version"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+type testLogData struct {
+	Level   string `json:"level" binding:"required,oneof=info error"`
+	Message string `json:"message"`
+}
+
+func TestGenerateTypeScriptClient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	gen := NewOpenAPIGenerator(GetSwaggerInfo())
+	gen.RegisterModel("docs.testRequest", testRequest{})
+	gen.RegisterModel("websocket.Envelope", testEnvelope{})
+	gen.RegisterWebSocketMessage("log", "websocket.LogMessage", testLogData{})
+
+	noop := func(c *gin.Context) {}
+	router.GET("/api/v1/workspaces/:id/sessions", noop)
+	router.POST("/api/v1/workspaces", noop)
+	gen.SetRoutes(router.Routes())
+
+	spec, err := gen.Generate()
+	require.NoError(t, err)
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(spec, &doc))
+	require.Contains(t, doc, "x-websocket-messages")
+
+	out, err := GenerateTypeScriptClient(spec, TSClientOptions{ClientVersion: "1.2.3"})
+	require.NoError(t, err)
+	ts := string(out)
+
+	assert.Contains(t, ts, "export const API_VERSION = '1.0'")
+	assert.Contains(t, ts, "export const CLIENT_VERSION = '1.2.3'")
+
+	// 스키마 → 인터페이스
+	assert.Contains(t, ts, "export interface TestRequest {")
+	assert.Contains(t, ts, "  name: string\n")
+	assert.Contains(t, ts, "  order?: 'asc' | 'desc'\n")
+	assert.Contains(t, ts, "  tags?: string[]\n")
+	assert.Contains(t, ts, "  payload?: unknown\n")
+
+	// WebSocket 메시지 판별 유니온
+	assert.Contains(t, ts, "export type WebSocketMessageType = 'log'")
+	assert.Contains(t, ts, "  log: LogMessage\n")
+	assert.Contains(t, ts, "  level: 'info' | 'error'\n")
+	assert.Contains(t, ts, "Omit<Envelope, 'type' | 'payload'>")
+	assert.Contains(t, ts, "  payload: WebSocketMessageDataMap[K]\n")
+
+	// 경로 → 클라이언트 메서드
+	assert.Contains(t, ts, "getWorkspacesByIdSessions(id: string): Promise<unknown> {")
+	assert.Contains(t, ts, "this.request<unknown>('GET', `/workspaces/${encodeURIComponent(id)}/sessions`)")
+	assert.Contains(t, ts, "postWorkspaces(body?: unknown): Promise<unknown> {")
+	assert.Contains(t, ts, "this.request<unknown>('POST', `/workspaces`, undefined, body)")
+}
+
+func TestGenerateTypeScriptClient_Operations(t *testing.T) {
+	spec := map[string]interface{}{
+		"openapi": OpenAPIVersion,
+		"info":    map[string]interface{}{"title": "test", "version": "2"},
+		"servers": []interface{}{map[string]interface{}{"url": "/api/v2"}},
+		"paths": map[string]interface{}{
+			"/tasks/{task_id}": map[string]interface{}{
+				"put": map[string]interface{}{
+					"operationId": "updateTask",
+					"summary":     "태스크 수정",
+					"parameters": []interface{}{
+						map[string]interface{}{"name": "task_id", "in": "path", "required": true},
+						map[string]interface{}{"name": "dry-run", "in": "query", "schema": map[string]interface{}{"type": "boolean"}},
+					},
+					"requestBody": map[string]interface{}{
+						"required": true,
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{
+								"schema": map[string]interface{}{"$ref": "#/components/schemas/models.Task"},
+							},
+						},
+					},
+					"responses": map[string]interface{}{
+						"200": map[string]interface{}{
+							"content": map[string]interface{}{
+								"application/json": map[string]interface{}{
+									"schema": map[string]interface{}{"type": "array", "items": map[string]interface{}{"$ref": "#/components/schemas/models.Task"}},
+								},
+							},
+						},
+					},
+				},
+				"delete": map[string]interface{}{
+					"responses": map[string]interface{}{"204": map[string]interface{}{"description": "삭제됨"}},
+				},
+			},
+		},
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"models.Task":    map[string]interface{}{"type": "object", "properties": map[string]interface{}{"id": map[string]interface{}{"type": "string"}}},
+				"other.Task":     map[string]interface{}{"type": "string"},
+				"models.Status":  map[string]interface{}{"type": []interface{}{"string", "null"}},
+				"models.Details": map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": "integer"}},
+			},
+		},
+	}
+	data, err := json.Marshal(spec)
+	require.NoError(t, err)
+
+	out, err := GenerateTypeScriptClient(data, TSClientOptions{})
+	require.NoError(t, err)
+	ts := string(out)
+
+	assert.Contains(t, ts, "export const CLIENT_VERSION = '2'")
+	assert.Contains(t, ts, "options.baseUrl ?? '/api/v2'")
+	assert.Contains(t, ts, "export interface ModelsTask {")
+	assert.Contains(t, ts, "export type OtherTask = string")
+	assert.Contains(t, ts, "export type Status = string | null")
+	assert.Contains(t, ts, "export type Details = Record<string, number>")
+	assert.NotContains(t, ts, "WebSocketMessage")
+
+	assert.Contains(t, ts, "/**\n   * 태스크 수정\n   * PUT /tasks/{task_id}\n   */")
+	assert.Contains(t, ts, "updateTask(taskId: string, body: ModelsTask, query: { 'dry-run'?: boolean } = {}): Promise<ModelsTask[]> {")
+	assert.Contains(t, ts, "this.request<ModelsTask[]>('PUT', `/tasks/${encodeURIComponent(taskId)}`, query, body)")
+	assert.Contains(t, ts, "deleteTasksByTaskId(taskId: string): Promise<void> {")
+}
+
+func TestGenerateTypeScriptClient_InvalidSpec(t *testing.T) {
+	_, err := GenerateTypeScriptClient([]byte("{"), TSClientOptions{})
+	assert.Error(t, err)
+}
+
+func TestOperationName(t *testing.T) {
+	assert.Equal(t, "getWorkspacesByIdSessions", tsIdentifier(operationName("get", "/workspaces/{id}/sessions")))
+	assert.Equal(t, "postWorkspacesBatchDelete", tsIdentifier(operationName("post", "/workspaces:batchDelete")))
+}
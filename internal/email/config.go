@@ -0,0 +1,47 @@
+package email
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewSenderFromConfig 설정에 맞는 발송기를 생성합니다 (driver가 비어 있으면 nil)
+func NewSenderFromConfig(cfg config.EmailConfig, logger *logrus.Logger) (Sender, error) {
+	switch cfg.Driver {
+	case "":
+		return nil, nil
+	case "log":
+		return NewLogSender(logger), nil
+	case "smtp":
+		return NewSMTPSender(SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+		})
+	case "ses":
+		return NewSESSender(SESConfig{
+			Region:           cfg.SES.Region,
+			Endpoint:         cfg.SES.Endpoint,
+			AccessKeyID:      cfg.SES.AccessKeyID,
+			SecretAccessKey:  cfg.SES.SecretAccessKey,
+			ConfigurationSet: cfg.SES.ConfigurationSet,
+		})
+	default:
+		return nil, fmt.Errorf("지원하지 않는 메일 드라이버: %s", cfg.Driver)
+	}
+}
+
+// MailerConfigFrom 애플리케이션 메일 설정을 발송기 설정으로 변환합니다
+func MailerConfigFrom(cfg config.EmailConfig) MailerConfig {
+	return MailerConfig{
+		From:         cfg.FromEmail,
+		FromName:     cfg.FromName,
+		MaxAttempts:  cfg.MaxAttempts,
+		RetryBackoff: cfg.RetryBackoff,
+		PollInterval: cfg.PollInterval,
+	}
+}
@@ -0,0 +1,109 @@
+// Package email 메일 발송 추상화, 템플릿 렌더링, 발송 기록과 재시도 대기열을 제공합니다.
+// SMTP와 Amazon SES API, 개발용 로그 발송을 지원하며 초대, 비밀번호 재설정,
+// 예산 알림, 보안 알림 메일을 같은 경로로 보냅니다.
+package email
+
+import (
+	"context"
+	"errors"
+)
+
+// 기본 제공 템플릿 이름
+const (
+	TemplateWorkspaceInvitation = "workspace_invitation"
+	TemplatePasswordReset       = "password_reset"
+	TemplateEmailVerification   = "email_verification"
+	TemplateBudgetAlert         = "budget_alert"
+	TemplateSecurityAlert       = "security_alert"
+
+	// TemplateRaw 템플릿 없이 제목과 본문을 그대로 보낸 메일의 기록 이름
+	TemplateRaw = "raw"
+)
+
+// Message 발송할 메일 한 통
+type Message struct {
+	From     string
+	FromName string
+	To       string
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+// Sender 메일 발송 방식 (SMTP, SES 등)
+type Sender interface {
+	// Send 메일 한 통 발송
+	// 다시 보내도 실패할 에러는 PermanentError로 감싸 반환합니다.
+	Send(ctx context.Context, msg *Message) error
+
+	// Name 발송 방식 이름
+	Name() string
+}
+
+// PermanentError 재시도하지 않을 발송 에러 (잘못된 주소, 인증 거부 등)
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent 재시도하지 않을 에러로 표시
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+// IsPermanent 재시도하지 않을 에러인지 확인
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
+
+// InvitationData 워크스페이스 초대 메일 데이터
+type InvitationData struct {
+	InviterName   string
+	WorkspaceName string
+	Role          string
+	AcceptURL     string
+	ExpiresIn     string
+}
+
+// PasswordResetData 비밀번호 재설정 메일 데이터
+type PasswordResetData struct {
+	ResetURL  string
+	ExpiresIn string
+}
+
+// EmailVerificationData 이메일 인증 메일 데이터
+type EmailVerificationData struct {
+	VerifyURL string
+	ExpiresIn string
+}
+
+// BudgetAlertData 예산 알림 메일 데이터
+type BudgetAlertData struct {
+	Scope     string // 예산 대상 (워크스페이스 이름 등)
+	Period    string // 예산 기간 (예: 2026-10)
+	Spent     string
+	Limit     string
+	Percent   int
+	DetailURL string
+}
+
+// SecurityAlertData 보안 알림 메일 데이터
+type SecurityAlertData struct {
+	Title      string
+	Message    string
+	Username   string
+	IPAddress  string
+	OccurredAt string
+	ActionURL  string
+}
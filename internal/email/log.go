@@ -0,0 +1,40 @@
+package email
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LogSender 메일을 보내지 않고 로그로만 남기는 개발용 발송기
+// 비밀번호 재설정 링크 등 본문이 로그에 남으므로 운영 환경에서는 쓰지 않습니다.
+type LogSender struct {
+	logger *logrus.Logger
+}
+
+// NewLogSender 새 로그 발송기 생성
+func NewLogSender(logger *logrus.Logger) *LogSender {
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return &LogSender{logger: logger}
+}
+
+// Name 발송 방식 이름
+func (s *LogSender) Name() string {
+	return "log"
+}
+
+// Send 메일 내용을 로그로 출력
+func (s *LogSender) Send(ctx context.Context, msg *Message) error {
+	body := msg.TextBody
+	if body == "" {
+		body = msg.HTMLBody
+	}
+	s.logger.WithFields(logrus.Fields{
+		"from":    msg.From,
+		"to":      msg.To,
+		"subject": msg.Subject,
+	}).Infof("메일 발송 (log 드라이버):\n%s", body)
+	return nil
+}
@@ -0,0 +1,264 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// maxRetryBackoff 재시도 대기 시간 상한
+	maxRetryBackoff = time.Hour
+
+	// dueBatchSize 한 번에 처리할 대기 기록 수
+	dueBatchSize = 50
+)
+
+// ErrAlreadySent 이미 발송한 메일을 다시 보내려 할 때
+var ErrAlreadySent = errors.New("이미 발송한 메일입니다")
+
+// MailerConfig 메일 발송기 설정
+type MailerConfig struct {
+	From     string
+	FromName string
+
+	// MaxAttempts 발송을 포기하기 전까지 시도 횟수
+	MaxAttempts int
+
+	// RetryBackoff 첫 재시도 대기 시간 (실패할 때마다 두 배, 최대 1시간)
+	RetryBackoff time.Duration
+
+	// PollInterval 재시도 대기열 확인 주기
+	PollInterval time.Duration
+}
+
+// Mailer 템플릿으로 메일을 만들어 발송 기록에 남기고 대기열에서 보내는 발송기
+// Send는 기록만 남기고 바로 반환하며, Run이 대기열을 돌며 보내고 실패하면
+// 지수 백오프로 MaxAttempts까지 다시 보냅니다.
+type Mailer struct {
+	sender   Sender
+	renderer *Renderer
+	store    storage.EmailDeliveryStorage
+	config   MailerConfig
+	logger   *logrus.Logger
+	now      func() time.Time
+
+	// wake 새 메일이 들어오면 대기열 처리를 깨움
+	wake chan struct{}
+
+	// processMu 같은 기록을 동시에 두 번 보내지 않도록 대기열 처리를 직렬화
+	processMu sync.Mutex
+}
+
+// NewMailer 새 메일 발송기 생성
+func NewMailer(sender Sender, renderer *Renderer, store storage.EmailDeliveryStorage, config MailerConfig, logger *logrus.Logger) *Mailer {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 5
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = time.Minute
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = 15 * time.Second
+	}
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return &Mailer{
+		sender:   sender,
+		renderer: renderer,
+		store:    store,
+		config:   config,
+		logger:   logger,
+		now:      time.Now,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Templates 사용할 수 있는 템플릿 이름
+func (m *Mailer) Templates() []string {
+	return m.renderer.Names()
+}
+
+// Send 템플릿으로 메일을 만들어 발송 대기열에 넣습니다
+func (m *Mailer) Send(ctx context.Context, to, template string, data interface{}) (*models.EmailDelivery, error) {
+	content, err := m.renderer.Render(template, data)
+	if err != nil {
+		return nil, err
+	}
+	return m.enqueue(ctx, to, template, content)
+}
+
+// SendContent 이미 만든 제목과 본문을 발송 대기열에 넣습니다
+func (m *Mailer) SendContent(ctx context.Context, to string, content *Content) (*models.EmailDelivery, error) {
+	return m.enqueue(ctx, to, TemplateRaw, content)
+}
+
+// enqueue 발송 기록을 만들고 대기열 처리를 깨웁니다
+func (m *Mailer) enqueue(ctx context.Context, to, template string, content *Content) (*models.EmailDelivery, error) {
+	if to == "" {
+		return nil, fmt.Errorf("받는 사람 주소가 없습니다")
+	}
+
+	delivery := &models.EmailDelivery{
+		Template: template,
+		To:       to,
+		Subject:  content.Subject,
+		HTMLBody: content.HTMLBody,
+		TextBody: content.TextBody,
+		Status:   models.EmailDeliveryPending,
+	}
+	if err := m.store.Create(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("메일 발송 기록 저장 실패: %w", err)
+	}
+
+	m.signal()
+	return delivery, nil
+}
+
+// signal 대기열 처리를 깨움 (이미 깨운 상태면 무시)
+func (m *Mailer) signal() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Retry 발송에 실패한 메일을 다시 대기열에 넣습니다 (시도 횟수는 새로 셈)
+func (m *Mailer) Retry(ctx context.Context, id string) (*models.EmailDelivery, error) {
+	delivery, err := m.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status == models.EmailDeliverySent {
+		return nil, ErrAlreadySent
+	}
+
+	delivery.Status = models.EmailDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = nil
+	if err := m.store.Update(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	m.signal()
+	return delivery, nil
+}
+
+// Get 발송 기록 조회
+func (m *Mailer) Get(ctx context.Context, id string) (*models.EmailDelivery, error) {
+	return m.store.GetByID(ctx, id)
+}
+
+// List 발송 기록 조회 (최신순)
+func (m *Mailer) List(ctx context.Context, filter *models.EmailDeliveryFilter) ([]*models.EmailDelivery, error) {
+	return m.store.List(ctx, filter)
+}
+
+// Run ctx가 끝날 때까지 발송 대기열을 처리합니다
+func (m *Mailer) Run(ctx context.Context) {
+	m.logger.WithField("driver", m.sender.Name()).Info("메일 발송 대기열 시작")
+
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		m.ProcessDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.wake:
+		}
+	}
+}
+
+// ProcessDue 발송할 때가 된 메일을 한 묶음 보내고 보낸 수를 반환합니다
+// 묶음이 가득 찼으면 남은 메일을 이어서 보내도록 대기열 처리를 다시 깨웁니다.
+func (m *Mailer) ProcessDue(ctx context.Context) int {
+	m.processMu.Lock()
+	defer m.processMu.Unlock()
+
+	due, err := m.store.ListDue(ctx, m.now(), dueBatchSize)
+	if err != nil {
+		m.logger.WithError(err).Warn("메일 발송 대기열 조회 실패")
+		return 0
+	}
+
+	sent := 0
+	for _, delivery := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		if m.attempt(ctx, delivery) {
+			sent++
+		}
+	}
+	if len(due) == dueBatchSize {
+		m.signal()
+	}
+	return sent
+}
+
+// attempt 메일 한 통을 보내고 결과를 기록합니다
+func (m *Mailer) attempt(ctx context.Context, delivery *models.EmailDelivery) bool {
+	delivery.Attempts++
+	err := m.sender.Send(ctx, &Message{
+		From:     m.config.From,
+		FromName: m.config.FromName,
+		To:       delivery.To,
+		Subject:  delivery.Subject,
+		HTMLBody: delivery.HTMLBody,
+		TextBody: delivery.TextBody,
+	})
+
+	now := m.now()
+	entry := m.logger.WithFields(logrus.Fields{
+		"delivery_id": delivery.ID,
+		"template":    delivery.Template,
+		"attempts":    delivery.Attempts,
+	})
+
+	if err == nil {
+		delivery.Status = models.EmailDeliverySent
+		delivery.SentAt = &now
+		delivery.NextAttemptAt = nil
+		delivery.LastError = ""
+	} else {
+		delivery.LastError = err.Error()
+		if IsPermanent(err) || delivery.Attempts >= m.config.MaxAttempts {
+			delivery.Status = models.EmailDeliveryFailed
+			delivery.NextAttemptAt = nil
+			entry.WithError(err).Error("메일 발송 실패, 재시도하지 않습니다")
+		} else {
+			next := now.Add(m.backoff(delivery.Attempts))
+			delivery.NextAttemptAt = &next
+			entry.WithError(err).Warnf("메일 발송 실패, %s에 재시도합니다", next.Format(time.RFC3339))
+		}
+	}
+
+	if updateErr := m.store.Update(ctx, delivery); updateErr != nil {
+		entry.WithError(updateErr).Error("메일 발송 결과 저장 실패")
+	}
+	return err == nil
+}
+
+// backoff 시도 횟수에 따른 재시도 대기 시간
+func (m *Mailer) backoff(attempts int) time.Duration {
+	wait := m.config.RetryBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= maxRetryBackoff {
+			return maxRetryBackoff
+		}
+	}
+	return wait
+}
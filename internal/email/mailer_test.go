@@ -0,0 +1,163 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeSender 지정한 에러를 차례로 반환하는 발송기
+type fakeSender struct {
+	mu     sync.Mutex
+	errs   []error
+	sent   []*Message
+	called int
+}
+
+func (s *fakeSender) Name() string {
+	return "fake"
+}
+
+func (s *fakeSender) Send(ctx context.Context, msg *Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.called++
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		if err != nil {
+			return err
+		}
+	}
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func newTestMailer(t *testing.T, sender Sender) (*Mailer, *time.Time) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	mailer := NewMailer(sender, renderer, memory.New().EmailDelivery(), MailerConfig{
+		From:         "noreply@example.com",
+		FromName:     "AICode Manager",
+		MaxAttempts:  3,
+		RetryBackoff: time.Minute,
+	}, nil)
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	mailer.now = func() time.Time { return now }
+	return mailer, &now
+}
+
+func TestMailer_RetryWithBackoff(t *testing.T) {
+	sender := &fakeSender{errs: []error{errors.New("연결 거부"), errors.New("연결 거부")}}
+	mailer, now := newTestMailer(t, sender)
+	ctx := context.Background()
+
+	delivery, err := mailer.Send(ctx, "alice@example.com", TemplatePasswordReset, PasswordResetData{
+		ResetURL:  "https://aicli.example.com/auth/reset-password?token=rst_1",
+		ExpiresIn: "24시간",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.EmailDeliveryPending, delivery.Status)
+	assert.Equal(t, "비밀번호 재설정 요청", delivery.Subject)
+
+	// 첫 시도 실패: 1분 뒤 재시도
+	assert.Equal(t, 0, mailer.ProcessDue(ctx))
+	stored, err := mailer.Get(ctx, delivery.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.EmailDeliveryPending, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, "연결 거부", stored.LastError)
+	assert.Equal(t, now.Add(time.Minute), *stored.NextAttemptAt)
+
+	// 재시도 시각 전에는 보내지 않음
+	assert.Equal(t, 0, mailer.ProcessDue(ctx))
+	assert.Equal(t, 1, sender.called)
+
+	// 두 번째 실패: 2분 뒤 재시도
+	*now = now.Add(time.Minute)
+	mailer.ProcessDue(ctx)
+	stored, _ = mailer.Get(ctx, delivery.ID)
+	assert.Equal(t, now.Add(2*time.Minute), *stored.NextAttemptAt)
+
+	*now = now.Add(2 * time.Minute)
+	assert.Equal(t, 1, mailer.ProcessDue(ctx))
+	stored, _ = mailer.Get(ctx, delivery.ID)
+	assert.Equal(t, models.EmailDeliverySent, stored.Status)
+	assert.Equal(t, 3, stored.Attempts)
+	assert.Empty(t, stored.LastError)
+	assert.NotNil(t, stored.SentAt)
+
+	require.Len(t, sender.sent, 1)
+	msg := sender.sent[0]
+	assert.Equal(t, "noreply@example.com", msg.From)
+	assert.Equal(t, "alice@example.com", msg.To)
+	assert.Contains(t, msg.TextBody, "token=rst_1")
+
+	_, err = mailer.Retry(ctx, delivery.ID)
+	assert.ErrorIs(t, err, ErrAlreadySent)
+}
+
+func TestMailer_GivesUp(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("재시도 한도 초과", func(t *testing.T) {
+		failure := errors.New("일시적 오류")
+		sender := &fakeSender{errs: []error{failure, failure, failure}}
+		mailer, now := newTestMailer(t, sender)
+
+		delivery, err := mailer.SendContent(ctx, "bob@example.com", &Content{Subject: "제목", HTMLBody: "<p>본문</p>"})
+		require.NoError(t, err)
+		assert.Equal(t, TemplateRaw, delivery.Template)
+
+		for i := 0; i < 3; i++ {
+			mailer.ProcessDue(ctx)
+			*now = now.Add(time.Hour)
+		}
+		stored, _ := mailer.Get(ctx, delivery.ID)
+		assert.Equal(t, models.EmailDeliveryFailed, stored.Status)
+		assert.Equal(t, 3, stored.Attempts)
+		assert.Nil(t, stored.NextAttemptAt)
+
+		// 관리자가 다시 보내면 시도 횟수를 새로 셈
+		retried, err := mailer.Retry(ctx, delivery.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EmailDeliveryPending, retried.Status)
+		assert.Equal(t, 1, mailer.ProcessDue(ctx))
+	})
+
+	t.Run("재시도하지 않을 에러", func(t *testing.T) {
+		sender := &fakeSender{errs: []error{Permanent(errors.New("550 없는 주소"))}}
+		mailer, _ := newTestMailer(t, sender)
+
+		delivery, err := mailer.Send(ctx, "nobody@example.com", TemplateSecurityAlert, SecurityAlertData{Title: "계정 잠김", Message: "로그인 실패"})
+		require.NoError(t, err)
+		mailer.ProcessDue(ctx)
+
+		stored, _ := mailer.Get(ctx, delivery.ID)
+		assert.Equal(t, models.EmailDeliveryFailed, stored.Status)
+		assert.Equal(t, 1, stored.Attempts)
+
+		failed, err := mailer.List(ctx, &models.EmailDeliveryFilter{Status: models.EmailDeliveryFailed})
+		require.NoError(t, err)
+		require.Len(t, failed, 1)
+		assert.Equal(t, delivery.ID, failed[0].ID)
+	})
+}
+
+func TestMailer_Backoff(t *testing.T) {
+	mailer, _ := newTestMailer(t, &fakeSender{})
+
+	assert.Equal(t, time.Minute, mailer.backoff(1))
+	assert.Equal(t, 4*time.Minute, mailer.backoff(3))
+	assert.Equal(t, maxRetryBackoff, mailer.backoff(10))
+}
@@ -0,0 +1,180 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// sesSendPath SES v2 SendEmail API 경로
+const sesSendPath = "/v2/email/outbound-emails"
+
+// SESConfig Amazon SES 발송 설정
+type SESConfig struct {
+	Region           string
+	Endpoint         string
+	AccessKeyID      string
+	SecretAccessKey  string
+	ConfigurationSet string
+}
+
+// SESSender Amazon SES v2 API로 메일을 보내는 발송기
+// 외부 SDK 없이 AWS Signature V4 서명을 사용합니다.
+type SESSender struct {
+	config SESConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSESSender 새 SES 발송기 생성
+func NewSESSender(config SESConfig) (*SESSender, error) {
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("SES 액세스 키가 설정되지 않았습니다")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://email.%s.amazonaws.com", config.Region)
+	}
+	config.Endpoint = strings.TrimSuffix(config.Endpoint, "/")
+
+	return &SESSender{
+		config: config,
+		client: &http.Client{Timeout: 30 * time.Second},
+		now:    time.Now,
+	}, nil
+}
+
+// Name 발송 방식 이름
+func (s *SESSender) Name() string {
+	return "ses"
+}
+
+// sesContent SES 메일 본문 항목
+type sesContent struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+// sesSendRequest SES v2 SendEmail 요청 본문
+type sesSendRequest struct {
+	FromEmailAddress string `json:"FromEmailAddress"`
+	Destination      struct {
+		ToAddresses []string `json:"ToAddresses"`
+	} `json:"Destination"`
+	Content struct {
+		Simple struct {
+			Subject sesContent `json:"Subject"`
+			Body    struct {
+				Html *sesContent `json:"Html,omitempty"`
+				Text *sesContent `json:"Text,omitempty"`
+			} `json:"Body"`
+		} `json:"Simple"`
+	} `json:"Content"`
+	ConfigurationSetName string `json:"ConfigurationSetName,omitempty"`
+}
+
+// Send 메일 한 통 발송
+// 요청 제한(429)과 서버 에러(5xx)만 재시도 대상이고 나머지 4xx는 PermanentError를 반환합니다.
+func (s *SESSender) Send(ctx context.Context, msg *Message) error {
+	var body sesSendRequest
+	body.FromEmailAddress = (&mail.Address{Name: msg.FromName, Address: msg.From}).String()
+	body.Destination.ToAddresses = []string{msg.To}
+	body.Content.Simple.Subject = sesContent{Data: msg.Subject, Charset: "UTF-8"}
+	if msg.HTMLBody != "" {
+		body.Content.Simple.Body.Html = &sesContent{Data: msg.HTMLBody, Charset: "UTF-8"}
+	}
+	if msg.TextBody != "" {
+		body.Content.Simple.Body.Text = &sesContent{Data: msg.TextBody, Charset: "UTF-8"}
+	}
+	body.ConfigurationSetName = s.config.ConfigurationSet
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return Permanent(fmt.Errorf("SES 요청 생성 실패: %w", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.Endpoint+sesSendPath, bytes.NewReader(payload))
+	if err != nil {
+		return Permanent(fmt.Errorf("SES 요청 생성 실패: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.sign(req, payload, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("SES 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err := fmt.Errorf("SES 발송 실패: %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return err
+		}
+		return Permanent(err)
+	}
+	return nil
+}
+
+// sign Authorization 헤더 방식의 AWS Signature V4 서명
+func (s *SESSender) sign(req *http.Request, payload []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := hexSHA256(payload)
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	signedHeaders := "content-type;host;x-amz-date"
+	canonicalHeaders := "content-type:" + req.Header.Get("Content-Type") + "\n" +
+		"host:" + req.URL.Host + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/ses/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "ses")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
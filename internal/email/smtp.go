@@ -0,0 +1,69 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/textproto"
+
+	"gopkg.in/mail.v2"
+)
+
+// SMTPConfig SMTP 발송 설정
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+// SMTPSender SMTP 서버로 메일을 보내는 발송기
+type SMTPSender struct {
+	dialer *mail.Dialer
+}
+
+// NewSMTPSender 새 SMTP 발송기 생성
+func NewSMTPSender(config SMTPConfig) (*SMTPSender, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("SMTP 호스트가 설정되지 않았습니다")
+	}
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	return &SMTPSender{
+		dialer: mail.NewDialer(config.Host, config.Port, config.Username, config.Password),
+	}, nil
+}
+
+// Name 발송 방식 이름
+func (s *SMTPSender) Name() string {
+	return "smtp"
+}
+
+// Send 메일 한 통 발송
+// 서버가 5xx로 거절하면 다시 보내도 실패하므로 PermanentError를 반환합니다.
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	m := mail.NewMessage()
+	if msg.FromName != "" {
+		m.SetAddressHeader("From", msg.From, msg.FromName)
+	} else {
+		m.SetHeader("From", msg.From)
+	}
+	m.SetHeader("To", msg.To)
+	m.SetHeader("Subject", msg.Subject)
+	if msg.TextBody != "" {
+		m.SetBody("text/plain", msg.TextBody)
+		m.AddAlternative("text/html", msg.HTMLBody)
+	} else {
+		m.SetBody("text/html", msg.HTMLBody)
+	}
+
+	if err := s.dialer.DialAndSend(m); err != nil {
+		var protoErr *textproto.Error
+		if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+			return Permanent(fmt.Errorf("SMTP 발송 거절: %w", err))
+		}
+		return fmt.Errorf("SMTP 발송 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+)
+
+// layoutFile 모든 HTML 메일이 공유하는 레이아웃 템플릿 파일
+const layoutFile = "layout.tmpl"
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// Content 렌더링한 메일 제목과 본문
+type Content struct {
+	Subject  string
+	HTMLBody string
+	TextBody string
+}
+
+// Renderer 메일 템플릿 렌더러
+// 템플릿 파일 하나가 subject, content(HTML 레이아웃 안에 들어갈 본문), text(선택)를 정의합니다.
+// 제목과 텍스트 본문은 text/template으로, HTML 본문은 html/template으로 렌더링합니다.
+type Renderer struct {
+	html map[string]*htmltemplate.Template
+	text map[string]*texttemplate.Template
+}
+
+// NewRenderer 기본 템플릿에 overrideDir의 같은 이름 템플릿을 덮어써 렌더러를 생성합니다.
+// overrideDir에 기본 템플릿에 없는 이름의 템플릿을 두면 새 템플릿으로 추가됩니다.
+func NewRenderer(overrideDir string) (*Renderer, error) {
+	sources, err := readTemplates(builtinTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+	if overrideDir != "" {
+		overrides, err := readTemplates(os.DirFS(overrideDir), ".")
+		if err != nil {
+			return nil, err
+		}
+		for file, src := range overrides {
+			sources[file] = src
+		}
+	}
+
+	layout := sources[layoutFile]
+	r := &Renderer{
+		html: make(map[string]*htmltemplate.Template),
+		text: make(map[string]*texttemplate.Template),
+	}
+	for file, src := range sources {
+		if file == layoutFile {
+			continue
+		}
+		name := strings.TrimSuffix(file, ".tmpl")
+
+		textTmpl, err := texttemplate.New(name).Parse(layout + src)
+		if err != nil {
+			return nil, fmt.Errorf("메일 템플릿 %s 파싱 실패: %w", name, err)
+		}
+		if textTmpl.Lookup("subject") == nil || textTmpl.Lookup("content") == nil {
+			return nil, fmt.Errorf("메일 템플릿 %s에 subject나 content 정의가 없습니다", name)
+		}
+		htmlTmpl, err := htmltemplate.New(name).Parse(layout + src)
+		if err != nil {
+			return nil, fmt.Errorf("메일 템플릿 %s 파싱 실패: %w", name, err)
+		}
+
+		r.text[name] = textTmpl
+		r.html[name] = htmlTmpl
+	}
+	return r, nil
+}
+
+// readTemplates 디렉토리의 *.tmpl 파일을 파일 이름별로 읽습니다
+func readTemplates(fsys fs.FS, dir string) (map[string]string, error) {
+	files, err := fs.Glob(fsys, filepath.ToSlash(filepath.Join(dir, "*.tmpl")))
+	if err != nil {
+		return nil, err
+	}
+	sources := make(map[string]string, len(files))
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("메일 템플릿 읽기 실패: %w", err)
+		}
+		sources[filepath.Base(file)] = string(data)
+	}
+	return sources, nil
+}
+
+// Names 사용할 수 있는 템플릿 이름 (정렬됨)
+func (r *Renderer) Names() []string {
+	names := make([]string, 0, len(r.text))
+	for name := range r.text {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Has 템플릿이 있는지 확인
+func (r *Renderer) Has(name string) bool {
+	_, ok := r.text[name]
+	return ok
+}
+
+// Render 템플릿으로 메일 제목과 본문을 만듭니다
+func (r *Renderer) Render(name string, data interface{}) (*Content, error) {
+	textTmpl, ok := r.text[name]
+	if !ok {
+		return nil, fmt.Errorf("메일 템플릿을 찾을 수 없습니다: %s", name)
+	}
+
+	var subject, html, text bytes.Buffer
+	if err := textTmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("메일 제목 렌더링 실패 (%s): %w", name, err)
+	}
+	if err := r.html[name].ExecuteTemplate(&html, "html", data); err != nil {
+		return nil, fmt.Errorf("메일 본문 렌더링 실패 (%s): %w", name, err)
+	}
+	if textTmpl.Lookup("text") != nil {
+		if err := textTmpl.ExecuteTemplate(&text, "text", data); err != nil {
+			return nil, fmt.Errorf("메일 텍스트 본문 렌더링 실패 (%s): %w", name, err)
+		}
+	}
+
+	return &Content{
+		// 제목은 한 줄이어야 하므로 줄바꿈과 앞뒤 공백 정리
+		Subject:  strings.Join(strings.Fields(subject.String()), " "),
+		HTMLBody: html.String(),
+		TextBody: strings.TrimSpace(text.String()),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package email
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer_BuiltinTemplates(t *testing.T) {
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		TemplateBudgetAlert,
+		TemplateEmailVerification,
+		TemplatePasswordReset,
+		TemplateSecurityAlert,
+		TemplateWorkspaceInvitation,
+	}, renderer.Names())
+
+	content, err := renderer.Render(TemplateWorkspaceInvitation, InvitationData{
+		InviterName:   "alice",
+		WorkspaceName: "<b>demo</b>",
+		Role:          "editor",
+		AcceptURL:     "https://aicli.example.com/invitations/accept?token=abc&x=1",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice님이 <b>demo</b> 워크스페이스에 초대했습니다", content.Subject)
+	// HTML 본문은 데이터를 이스케이프하고 텍스트 본문은 그대로 둠
+	assert.Contains(t, content.HTMLBody, "&lt;b&gt;demo&lt;/b&gt;")
+	assert.Contains(t, content.HTMLBody, `href="https://aicli.example.com/invitations/accept?token=abc&amp;x=1"`)
+	assert.Contains(t, content.HTMLBody, "<h1>AICode Manager</h1>")
+	assert.Contains(t, content.TextBody, "초대 수락: https://aicli.example.com/invitations/accept?token=abc&x=1")
+
+	_, err = renderer.Render("unknown", nil)
+	assert.Error(t, err)
+}
+
+func TestRenderer_OverrideDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "password_reset.tmpl"), []byte(
+		`{{define "subject"}}[사내] 비밀번호 재설정{{end}}{{define "content"}}<a href="{{.ResetURL}}">재설정</a>{{end}}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "weekly_report.tmpl"), []byte(
+		`{{define "subject"}}주간 보고서{{end}}{{define "content"}}{{.}}{{end}}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.txt"), []byte(`무시되는 파일`), 0o644))
+
+	renderer, err := NewRenderer(dir)
+	require.NoError(t, err)
+	assert.True(t, renderer.Has("weekly_report"))
+	assert.True(t, renderer.Has(TemplateSecurityAlert))
+
+	content, err := renderer.Render(TemplatePasswordReset, PasswordResetData{ResetURL: "https://aicli.example.com/reset"})
+	require.NoError(t, err)
+	assert.Equal(t, "[사내] 비밀번호 재설정", content.Subject)
+	assert.Contains(t, content.HTMLBody, `<a href="https://aicli.example.com/reset">재설정</a>`)
+	assert.Empty(t, content.TextBody, "text를 정의하지 않으면 HTML 본문만 보냄")
+
+	// subject가 없는 템플릿은 시작할 때 거부
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.tmpl"), []byte(`{{define "content"}}본문{{end}}`), 0o644))
+	_, err = NewRenderer(dir)
+	assert.Error(t, err)
+}
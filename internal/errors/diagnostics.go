@@ -17,6 +17,7 @@ type DiagnosticInfo struct {
 	Process     ProcessInfo           `json:"process"`
 	Timestamp   time.Time             `json:"timestamp"`
 	Custom      map[string]interface{} `json:"custom,omitempty"`
+	Checks      []DiagnosticCheck     `json:"checks,omitempty"`
 }
 
 // SystemInfo는 시스템 정보를 담습니다.
@@ -65,6 +66,48 @@ type ProcessInfo struct {
 	Version    string   `json:"version"`
 }
 
+// DiagnosticCheckStatus는 의존성 점검 결과입니다.
+type DiagnosticCheckStatus string
+
+const (
+	// CheckOK는 문제가 없음을 나타냅니다.
+	CheckOK DiagnosticCheckStatus = "ok"
+	
+	// CheckWarn은 동작은 하지만 확인이 필요함을 나타냅니다.
+	CheckWarn DiagnosticCheckStatus = "warn"
+	
+	// CheckFail은 서버나 태스크 실행이 실패할 문제를 나타냅니다.
+	CheckFail DiagnosticCheckStatus = "fail"
+	
+	// CheckSkipped는 설정되지 않아 점검하지 않았음을 나타냅니다.
+	CheckSkipped DiagnosticCheckStatus = "skipped"
+)
+
+// DiagnosticCheck는 의존성(스토리지, Redis, Docker 등) 점검 한 항목의 결과입니다.
+type DiagnosticCheck struct {
+	Name        string                `json:"name"`
+	Status      DiagnosticCheckStatus `json:"status"`
+	Message     string                `json:"message"`
+	ErrorType   string                `json:"error_type,omitempty"`
+	Suggestions []string              `json:"suggestions,omitempty"`
+	DurationMs  int64                 `json:"duration_ms"`
+}
+
+// NewDiagnosticCheck는 CLIError의 메시지, 종류, 해결 방법으로 점검 결과를 생성합니다.
+func NewDiagnosticCheck(name string, status DiagnosticCheckStatus, err *CLIError) DiagnosticCheck {
+	message := err.Message
+	if err.Cause != nil {
+		message += ": " + err.Cause.Error()
+	}
+	return DiagnosticCheck{
+		Name:        name,
+		Status:      status,
+		Message:     message,
+		ErrorType:   err.Type.String(),
+		Suggestions: err.Suggestions,
+	}
+}
+
 // DiagnosticCollector는 진단 정보를 수집하는 인터페이스입니다.
 type DiagnosticCollector interface {
 	Collect() *DiagnosticInfo
@@ -274,8 +317,11 @@ func EnrichErrorWithDiagnostics(err *CLIError, collector DiagnosticCollector) *C
 
 // GenerateDiagnosticReport는 진단 보고서를 생성합니다.
 func GenerateDiagnosticReport(collector DiagnosticCollector) string {
-	diagnostics := collector.Collect()
-	
+	return FormatDiagnosticReport(collector.Collect())
+}
+
+// FormatDiagnosticReport는 수집한 진단 정보를 사람이 읽을 수 있는 보고서로 만듭니다.
+func FormatDiagnosticReport(diagnostics *DiagnosticInfo) string {
 	var buf strings.Builder
 	
 	buf.WriteString("=== AICode Manager 진단 보고서 ===\n\n")
@@ -323,5 +369,18 @@ func GenerateDiagnosticReport(collector DiagnosticCollector) string {
 	buf.WriteString(fmt.Sprintf("버전: %s\n", diagnostics.Process.Version))
 	buf.WriteString(fmt.Sprintf("명령행 인수: %v\n", diagnostics.Process.Args))
 	
+	// 의존성 점검 결과
+	if len(diagnostics.Checks) > 0 {
+		buf.WriteString("\n## 의존성 점검\n")
+		for _, check := range diagnostics.Checks {
+			buf.WriteString(fmt.Sprintf("[%s] %s: %s\n", strings.ToUpper(string(check.Status)), check.Name, check.Message))
+			if check.Status == CheckWarn || check.Status == CheckFail {
+				for _, suggestion := range check.Suggestions {
+					buf.WriteString(fmt.Sprintf("  → %s\n", suggestion))
+				}
+			}
+		}
+	}
+	
 	return buf.String()
 }
\ No newline at end of file
@@ -0,0 +1,60 @@
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewDiagnosticCheck(t *testing.T) {
+	check := NewDiagnosticCheck("redis", CheckFail, NewNetworkError("Redis", errors.New("connection refused")))
+
+	if check.Name != "redis" || check.Status != CheckFail {
+		t.Fatalf("unexpected check: %+v", check)
+	}
+	if check.ErrorType != "NetworkError" {
+		t.Errorf("expected NetworkError, got %s", check.ErrorType)
+	}
+	if !strings.Contains(check.Message, "connection refused") {
+		t.Errorf("expected cause in message, got %q", check.Message)
+	}
+	if len(check.Suggestions) == 0 {
+		t.Error("expected suggestions from CLIError")
+	}
+}
+
+func TestFormatDiagnosticReport_Checks(t *testing.T) {
+	info := NewDiagnosticCollector("", "1.0.0").Collect()
+	info.Checks = []DiagnosticCheck{
+		{Name: "docker", Status: CheckOK, Message: "Docker 데몬에 연결했습니다", Suggestions: []string{"보이지 않아야 함"}},
+		NewDiagnosticCheck("redis", CheckFail, NewNetworkError("Redis", errors.New("connection refused"))),
+	}
+
+	report := FormatDiagnosticReport(info)
+	if !strings.Contains(report, "## 의존성 점검") {
+		t.Fatal("expected dependency check section")
+	}
+	if !strings.Contains(report, "[OK] docker: Docker 데몬에 연결했습니다") {
+		t.Error("expected ok check line")
+	}
+	if !strings.Contains(report, "[FAIL] redis:") || !strings.Contains(report, "→ 네트워크 연결 상태를 확인하세요") {
+		t.Error("expected failed check with suggestions")
+	}
+	if strings.Contains(report, "보이지 않아야 함") {
+		t.Error("suggestions of passing checks should not be printed")
+	}
+
+	// 번들은 점검 결과를 포함해 JSON으로 직렬화됨
+	data, err := json.Marshal(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded DiagnosticInfo
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Checks) != 2 || decoded.Checks[1].ErrorType != "NetworkError" {
+		t.Errorf("unexpected decoded checks: %+v", decoded.Checks)
+	}
+}
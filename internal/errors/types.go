@@ -3,9 +3,11 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // ErrorType은 에러의 종류를 나타냅니다.
@@ -474,6 +476,9 @@ const (
 	ErrCodeMaxWorkspaces    = "MAX_WORKSPACES_REACHED"
 	ErrCodeResourceBusy     = "RESOURCE_BUSY"
 	ErrCodeDependencyExists = "DEPENDENCY_EXISTS"
+	ErrCodeVersionConflict  = "VERSION_CONFLICT"
+	ErrCodeDeadlineExceeded = "DEADLINE_EXCEEDED"
+	ErrCodeDependencyUnavailable = "DEPENDENCY_UNAVAILABLE"
 	ErrCodeInternal         = "INTERNAL_ERROR"
 )
 
@@ -509,4 +514,30 @@ func NewWorkspaceErrorWithContext(code, message, workspaceID, operation string,
 		WorkspaceID: workspaceID,
 		Operation:   operation,
 	}
-}
\ No newline at end of file
+}
+
+// DeadlineExceededDetails 요청 처리 시간 초과 에러의 상세 정보
+type DeadlineExceededDetails struct {
+	// Timeout 요청에 적용된 처리 시간 예산 (예: "30s")
+	Timeout string `json:"timeout"`
+}
+
+// NewDeadlineExceededError 요청 처리 시간 예산을 넘겼을 때의 에러 (HTTP 504)
+func NewDeadlineExceededError(budget time.Duration) *WorkspaceError {
+	message := "요청 처리 시간을 초과했습니다"
+	var details interface{}
+	if budget > 0 {
+		message = fmt.Sprintf("요청 처리 시간(%s)을 초과했습니다", budget)
+		details = DeadlineExceededDetails{Timeout: budget.String()}
+	}
+	return NewWorkspaceError(ErrCodeDeadlineExceeded, message, details)
+}
+
+// IsDeadlineExceeded context 기한 초과나 DEADLINE_EXCEEDED 에러인지 확인
+func IsDeadlineExceeded(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var workspaceErr *WorkspaceError
+	return errors.As(err, &workspaceErr) && workspaceErr.Code == ErrCodeDeadlineExceeded
+}
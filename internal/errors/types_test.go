@@ -1,8 +1,11 @@
 package errors
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 )
 
 func TestErrorType_String(t *testing.T) {
@@ -293,4 +296,38 @@ func TestGetExitCode(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestNewDeadlineExceededError(t *testing.T) {
+	err := NewDeadlineExceededError(30 * time.Second)
+	if err.Code != ErrCodeDeadlineExceeded {
+		t.Errorf("Code = %v, want %v", err.Code, ErrCodeDeadlineExceeded)
+	}
+	if details, ok := err.Details.(DeadlineExceededDetails); !ok || details.Timeout != "30s" {
+		t.Errorf("Details = %#v, want timeout 30s", err.Details)
+	}
+
+	if err := NewDeadlineExceededError(0); err.Details != nil {
+		t.Errorf("Details = %#v, want nil without budget", err.Details)
+	}
+}
+
+func TestIsDeadlineExceeded(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{context.DeadlineExceeded, true},
+		{fmt.Errorf("query workspace: %w", context.DeadlineExceeded), true},
+		{NewDeadlineExceededError(time.Second), true},
+		{context.Canceled, false},
+		{NewWorkspaceError(ErrCodeInternal, "internal", nil), false},
+		{nil, false},
+	}
+
+	for _, test := range tests {
+		if result := IsDeadlineExceeded(test.err); result != test.expected {
+			t.Errorf("IsDeadlineExceeded(%v) = %v, want %v", test.err, result, test.expected)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Package graphql 대시보드용 GraphQL 엔드포인트에 필요한 최소한의 GraphQL 구현
+//
+// 쿼리와 구독 연산, 변수, 별칭, 프래그먼트(이름/인라인), @skip/@include 디렉티브를 지원합니다.
+// 스키마는 Go 코드로 정의하며(SDL 미지원), 인트로스펙션은 __typename만 제공합니다.
+package graphql
+
+// Operation 연산 종류
+const (
+	OperationQuery        = "query"
+	OperationMutation     = "mutation"
+	OperationSubscription = "subscription"
+)
+
+// Document 파싱된 GraphQL 문서
+type Document struct {
+	Operations []*OperationDefinition
+	Fragments  map[string]*FragmentDefinition
+}
+
+// OperationDefinition 연산 정의
+type OperationDefinition struct {
+	Operation    string
+	Name         string
+	Variables    []*VariableDefinition
+	SelectionSet []Selection
+}
+
+// VariableDefinition 연산 변수 정의
+type VariableDefinition struct {
+	Name    string
+	Type    TypeRef
+	Default Value
+}
+
+// TypeRef 변수 선언의 타입 참조 (예: [ID!]!)
+type TypeRef struct {
+	Name    string
+	List    *TypeRef
+	NonNull bool
+}
+
+// FragmentDefinition 이름 있는 프래그먼트
+type FragmentDefinition struct {
+	Name          string
+	TypeCondition string
+	SelectionSet  []Selection
+}
+
+// Selection 선택 집합의 항목 (*FieldNode, *FragmentSpread, *InlineFragment)
+type Selection interface {
+	directives() []*Directive
+}
+
+// FieldNode 필드 선택
+type FieldNode struct {
+	Alias        string
+	Name         string
+	Arguments    map[string]Value
+	Directives   []*Directive
+	SelectionSet []Selection
+}
+
+// ResponseKey 응답에서 쓰는 키 (별칭이 있으면 별칭)
+func (f *FieldNode) ResponseKey() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// FragmentSpread 이름 있는 프래그먼트 펼치기 (...Name)
+type FragmentSpread struct {
+	Name       string
+	Directives []*Directive
+}
+
+// InlineFragment 인라인 프래그먼트 (... on Type { })
+type InlineFragment struct {
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []Selection
+}
+
+// Directive 디렉티브 (@skip(if: $x))
+type Directive struct {
+	Name      string
+	Arguments map[string]Value
+}
+
+func (f *FieldNode) directives() []*Directive      { return f.Directives }
+func (f *FragmentSpread) directives() []*Directive { return f.Directives }
+func (f *InlineFragment) directives() []*Directive { return f.Directives }
+
+// Value 리터럴 값
+// 정수는 int64, 실수는 float64, 문자열/불리언/null은 Go 기본 값으로 표현하고,
+// 변수, 열거형, 리스트, 객체는 아래 타입으로 표현합니다.
+type Value = interface{}
+
+// Variable 변수 참조 ($name)
+type Variable struct {
+	Name string
+}
+
+// EnumValue 열거형 리터럴
+type EnumValue string
+
+// ListValue 리스트 리터럴
+type ListValue []Value
+
+// ObjectValue 입력 객체 리터럴
+type ObjectValue map[string]Value
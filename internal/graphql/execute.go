@@ -0,0 +1,906 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Request GraphQL 요청 본문
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Result GraphQL 응답
+// 요청 자체가 잘못되면 Data 없이 Errors만, 실행 중 필드 에러는 Data와 함께 Errors에 담깁니다.
+type Result struct {
+	Data   interface{} `json:"data,omitempty"`
+	Errors []*Error    `json:"errors,omitempty"`
+}
+
+// Location 쿼리 안의 위치
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Error GraphQL 에러
+type Error struct {
+	Message    string                 `json:"message"`
+	Locations  []Location             `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// OrderedMap 선택 순서를 유지하는 응답 객체
+type OrderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedMap() *OrderedMap {
+	return &OrderedMap{values: make(map[string]interface{})}
+}
+
+// Set 값 설정 (처음 설정하는 키는 끝에 추가)
+func (m *OrderedMap) Set(key string, value interface{}) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Get 값 조회
+func (m *OrderedMap) Get(key string) (interface{}, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Keys 키 목록 (선택 순서)
+func (m *OrderedMap) Keys() []string {
+	return m.keys
+}
+
+// MarshalJSON 선택 순서대로 직렬화
+func (m *OrderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Execute 쿼리 연산 실행
+func Execute(ctx context.Context, schema *Schema, req *Request) *Result {
+	ec, err := prepare(ctx, schema, req)
+	if err != nil {
+		return errorResult(err)
+	}
+	switch ec.op.Operation {
+	case OperationQuery:
+	case OperationSubscription:
+		return errorResult(&Error{Message: "구독 연산은 스트리밍 요청으로 실행해야 합니다"})
+	default:
+		return errorResult(&Error{Message: fmt.Sprintf("%s 연산은 지원하지 않습니다", ec.op.Operation)})
+	}
+
+	data, propagate := ec.executeSelectionSet(schema.Query, nil, ec.op.SelectionSet, nil)
+	result := &Result{Errors: ec.errors}
+	if !propagate {
+		result.Data = data
+	}
+	return result
+}
+
+// Subscribe 구독 연산을 시작하고 이벤트마다 실행한 결과를 채널로 전달
+// 요청이 잘못되었거나 스트림을 열지 못하면 에러 결과를 반환합니다.
+// 결과 채널은 이벤트 스트림이 끝나거나 ctx가 끝나면 닫힙니다.
+func Subscribe(ctx context.Context, schema *Schema, req *Request) (<-chan *Result, *Result) {
+	ec, err := prepare(ctx, schema, req)
+	if err != nil {
+		return nil, errorResult(err)
+	}
+	if ec.op.Operation != OperationSubscription {
+		return nil, errorResult(&Error{Message: "구독 연산이 아닙니다"})
+	}
+
+	fields := ec.collectFields(schema.Subscription, ec.op.SelectionSet, nil)
+	if len(fields) != 1 {
+		return nil, errorResult(&Error{Message: "구독 연산은 루트 필드를 하나만 선택해야 합니다"})
+	}
+	key, selected := fields[0].key, fields[0].fields
+	def := schema.Subscription.Fields[selected[0].Name]
+	if def == nil || def.Subscribe == nil {
+		return nil, errorResult(&Error{Message: fmt.Sprintf("%q 필드는 구독할 수 없습니다", selected[0].Name)})
+	}
+
+	args, err := ec.coerceArguments(def, selected[0])
+	if err != nil {
+		return nil, errorResult(withPath(err, []interface{}{key}))
+	}
+	params := ResolveParams{Context: ctx, Args: args}
+	if def.Authorize != nil {
+		if err := def.Authorize(params); err != nil {
+			return nil, errorResult(withPath(err, []interface{}{key}))
+		}
+	}
+	events, err := def.Subscribe(params)
+	if err != nil {
+		return nil, errorResult(withPath(err, []interface{}{key}))
+	}
+
+	results := make(chan *Result)
+	go func() {
+		defer close(results)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				// 이벤트마다 독립된 실행 컨텍스트 (에러 목록 분리)
+				run := &executor{ctx: ctx, schema: schema, doc: ec.doc, op: ec.op, variables: ec.variables}
+				data := newOrderedMap()
+				value, failed := run.executeField(schema.Subscription, event, selected, []interface{}{key}, true)
+				result := &Result{Errors: run.errors}
+				if !(failed && isNonNull(def.Type)) {
+					data.Set(key, value)
+					result.Data = data
+				}
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return results, nil
+}
+
+// executor 연산 하나의 실행 상태
+type executor struct {
+	ctx       context.Context
+	schema    *Schema
+	doc       *Document
+	op        *OperationDefinition
+	variables map[string]interface{}
+	errors    []*Error
+}
+
+// prepare 파싱, 연산 선택, 변수 강제 변환, 검증
+func prepare(ctx context.Context, schema *Schema, req *Request) (*executor, error) {
+	if req.Query == "" {
+		return nil, &Error{Message: "query가 필요합니다"}
+	}
+	doc, err := Parse(req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	var op *OperationDefinition
+	for _, candidate := range doc.Operations {
+		if req.OperationName == "" || candidate.Name == req.OperationName {
+			if op != nil {
+				return nil, &Error{Message: "연산이 여러 개이면 operationName이 필요합니다"}
+			}
+			op = candidate
+		}
+	}
+	if op == nil {
+		return nil, &Error{Message: fmt.Sprintf("연산 %q를 찾을 수 없습니다", req.OperationName)}
+	}
+
+	var root *Object
+	switch op.Operation {
+	case OperationQuery:
+		root = schema.Query
+	case OperationSubscription:
+		root = schema.Subscription
+	}
+	if root == nil {
+		return nil, &Error{Message: fmt.Sprintf("스키마가 %s 연산을 지원하지 않습니다", op.Operation)}
+	}
+
+	ec := &executor{ctx: ctx, schema: schema, doc: doc, op: op}
+	if ec.variables, err = ec.coerceVariables(req.Variables); err != nil {
+		return nil, err
+	}
+	v := &validator{
+		schema:        schema,
+		doc:           doc,
+		op:            op,
+		maxDepth:      schema.MaxDepth,
+		maxComplexity: schema.MaxComplexity,
+		maxAliases:    schema.MaxAliases,
+	}
+	if v.maxDepth <= 0 {
+		v.maxDepth = DefaultMaxDepth
+	}
+	if v.maxComplexity <= 0 {
+		v.maxComplexity = DefaultMaxComplexity
+	}
+	if v.maxAliases <= 0 {
+		v.maxAliases = DefaultMaxAliases
+	}
+	v.selectionSet(root, op.SelectionSet, 1, map[string]bool{})
+	if len(v.errors) > 0 {
+		return nil, v.errors[0]
+	}
+	return ec, nil
+}
+
+// coerceVariables 요청 변수를 선언된 타입으로 변환 (선언하지 않은 변수는 무시)
+func (ec *executor) coerceVariables(input map[string]interface{}) (map[string]interface{}, error) {
+	variables := make(map[string]interface{})
+	for _, def := range ec.op.Variables {
+		t, err := ec.schema.inputType(def.Type)
+		if err != nil {
+			return nil, err
+		}
+		raw, provided := input[def.Name]
+		if !provided {
+			if def.Default == nil {
+				if _, required := t.(*NonNull); required {
+					return nil, &Error{Message: fmt.Sprintf("변수 $%s가 필요합니다", def.Name)}
+				}
+				continue
+			}
+			raw = def.Default
+		}
+		value, err := coerceInput(t, raw)
+		if err != nil {
+			return nil, &Error{Message: fmt.Sprintf("변수 $%s: %v", def.Name, err)}
+		}
+		variables[def.Name] = value
+	}
+	return variables, nil
+}
+
+// coerceArguments 필드 인자를 정의된 타입으로 변환하고 기본값 적용
+func (ec *executor) coerceArguments(def *Field, field *FieldNode) (map[string]interface{}, error) {
+	args := make(map[string]interface{}, len(def.Args))
+	for name, arg := range def.Args {
+		raw, provided := field.Arguments[name]
+		if variable, ok := raw.(*Variable); ok {
+			raw, provided = ec.variables[variable.Name]
+			if !provided {
+				// 값이 없는 변수는 인자를 생략한 것으로 처리
+				raw = nil
+			} else {
+				// 변수는 이미 변환된 값이므로 null 검사만 수행
+				if raw == nil {
+					if _, required := arg.Type.(*NonNull); required {
+						return nil, &Error{Message: fmt.Sprintf("인자 %q는 null일 수 없습니다", name)}
+					}
+				}
+				args[name] = raw
+				continue
+			}
+		}
+		if !provided {
+			if arg.DefaultValue != nil {
+				args[name] = arg.DefaultValue
+				continue
+			}
+			if _, required := arg.Type.(*NonNull); required {
+				return nil, &Error{Message: fmt.Sprintf("인자 %q가 필요합니다", name)}
+			}
+			continue
+		}
+		value, err := coerceInput(arg.Type, ec.resolveVariables(raw))
+		if err != nil {
+			return nil, &Error{Message: fmt.Sprintf("인자 %q: %v", name, err)}
+		}
+		args[name] = value
+	}
+	return args, nil
+}
+
+// resolveVariables 리터럴 안의 변수 참조를 변수 값으로 치환
+func (ec *executor) resolveVariables(value Value) Value {
+	switch v := value.(type) {
+	case *Variable:
+		return ec.variables[v.Name]
+	case ListValue:
+		list := make(ListValue, len(v))
+		for i, item := range v {
+			list[i] = ec.resolveVariables(item)
+		}
+		return list
+	case ObjectValue:
+		object := make(ObjectValue, len(v))
+		for key, item := range v {
+			object[key] = ec.resolveVariables(item)
+		}
+		return object
+	}
+	return value
+}
+
+// coerceInput 입력 값을 타입에 맞게 변환 (입력 객체 타입은 지원하지 않음)
+func coerceInput(t Type, value interface{}) (interface{}, error) {
+	if n, ok := value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			value = i
+		} else if f, err := n.Float64(); err == nil {
+			value = f
+		}
+	}
+
+	if nonNull, ok := t.(*NonNull); ok {
+		if value == nil {
+			return nil, fmt.Errorf("%s 값은 null일 수 없습니다", t)
+		}
+		return coerceInput(nonNull.OfType, value)
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	switch typed := t.(type) {
+	case *List:
+		var items []interface{}
+		switch v := value.(type) {
+		case ListValue:
+			items = []interface{}(v)
+		case []interface{}:
+			items = v
+		default:
+			// 리스트 자리에 단일 값을 주면 길이 1인 리스트로 취급
+			items = []interface{}{value}
+		}
+		list := make([]interface{}, len(items))
+		for i, item := range items {
+			coerced, err := coerceInput(typed.OfType, item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = coerced
+		}
+		return list, nil
+	case *Scalar:
+		return typed.ParseValue(value)
+	case *Enum:
+		var name string
+		switch v := value.(type) {
+		case EnumValue:
+			name = string(v)
+		case string:
+			name = v
+		default:
+			return nil, fmt.Errorf("%s 값이 아닙니다: %v", typed.Name, value)
+		}
+		if !typed.has(name) {
+			return nil, fmt.Errorf("%s에 %q 값이 없습니다", typed.Name, name)
+		}
+		return name, nil
+	}
+	return nil, fmt.Errorf("%s는 입력 타입이 아닙니다", t)
+}
+
+// collectedField 같은 응답 키로 모인 필드 선택
+type collectedField struct {
+	key    string
+	fields []*FieldNode
+}
+
+// collectFields 프래그먼트를 펼치고 디렉티브를 적용해 응답 키별로 필드를 모읍니다
+func (ec *executor) collectFields(object *Object, selections []Selection, visited map[string]bool) []*collectedField {
+	var collected []*collectedField
+	index := make(map[string]*collectedField)
+	var walk func(selections []Selection)
+	walk = func(selections []Selection) {
+		for _, selection := range selections {
+			if !ec.shouldInclude(selection.directives()) {
+				continue
+			}
+			switch s := selection.(type) {
+			case *FieldNode:
+				key := s.ResponseKey()
+				if entry, ok := index[key]; ok {
+					entry.fields = append(entry.fields, s)
+					continue
+				}
+				entry := &collectedField{key: key, fields: []*FieldNode{s}}
+				index[key] = entry
+				collected = append(collected, entry)
+			case *InlineFragment:
+				if s.TypeCondition == "" || s.TypeCondition == object.Name {
+					walk(s.SelectionSet)
+				}
+			case *FragmentSpread:
+				fragment := ec.doc.Fragments[s.Name]
+				if fragment == nil || fragment.TypeCondition != object.Name || visited[s.Name] {
+					continue
+				}
+				if visited == nil {
+					visited = make(map[string]bool)
+				}
+				visited[s.Name] = true
+				walk(fragment.SelectionSet)
+			}
+		}
+	}
+	walk(selections)
+	return collected
+}
+
+// shouldInclude @skip/@include 디렉티브 적용
+func (ec *executor) shouldInclude(directives []*Directive) bool {
+	for _, directive := range directives {
+		condition, _ := ec.resolveVariables(directive.Arguments["if"]).(bool)
+		switch directive.Name {
+		case "skip":
+			if condition {
+				return false
+			}
+		case "include":
+			if !condition {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// executeSelectionSet 객체의 선택 집합 실행
+// 반환값 propagate가 true이면 null일 수 없는 필드가 실패해 이 객체도 null이 되어야 합니다.
+func (ec *executor) executeSelectionSet(object *Object, source interface{}, selections []Selection, path []interface{}) (*OrderedMap, bool) {
+	result := newOrderedMap()
+	for _, entry := range ec.collectFields(object, selections, nil) {
+		fieldPath := appendPath(path, entry.key)
+		value, failed := ec.executeField(object, source, entry.fields, fieldPath, false)
+		if failed && entry.fields[0].Name != "__typename" && isNonNull(ec.schema.fieldDef(object, entry.fields[0].Name).Type) {
+			return nil, true
+		}
+		result.Set(entry.key, value)
+	}
+	return result, false
+}
+
+// executeField 필드 하나를 리졸브하고 값을 완성
+// 반환값 failed가 true이면 에러가 이미 기록되었고 값은 null입니다.
+// eventSource가 true이면 리졸버가 없는 필드는 source(구독 이벤트)를 그대로 값으로 사용합니다.
+func (ec *executor) executeField(object *Object, source interface{}, fields []*FieldNode, path []interface{}, eventSource bool) (interface{}, bool) {
+	field := fields[0]
+	if field.Name == "__typename" {
+		return object.Name, false
+	}
+	def := ec.schema.fieldDef(object, field.Name)
+
+	args, err := ec.coerceArguments(def, field)
+	if err != nil {
+		ec.addError(err, path)
+		return nil, true
+	}
+	params := ResolveParams{Context: ec.ctx, Source: source, Args: args}
+	if def.Authorize != nil {
+		if err := def.Authorize(params); err != nil {
+			ec.addError(err, path)
+			return nil, true
+		}
+	}
+
+	var value interface{}
+	switch {
+	case def.Resolve != nil:
+		value, err = ec.resolve(def.Resolve, params)
+	case eventSource:
+		value = source
+	default:
+		value = defaultResolve(source, field.Name)
+	}
+	if err != nil {
+		ec.addError(err, path)
+		return nil, true
+	}
+	return ec.completeValue(def.Type, fields, value, path)
+}
+
+// resolve 리졸버 호출 (패닉은 필드 에러로 변환)
+func (ec *executor) resolve(resolve ResolveFunc, params ResolveParams) (value interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("필드 처리 중 내부 오류가 발생했습니다")
+		}
+	}()
+	return resolve(params)
+}
+
+// completeValue 리졸버 값을 타입에 맞게 응답 값으로 완성
+func (ec *executor) completeValue(t Type, fields []*FieldNode, value interface{}, path []interface{}) (interface{}, bool) {
+	if nonNull, ok := t.(*NonNull); ok {
+		completed, failed := ec.completeValue(nonNull.OfType, fields, value, path)
+		if completed == nil {
+			if !failed {
+				ec.addError(&Error{Message: fmt.Sprintf("null일 수 없는 필드 %q가 null을 반환했습니다", fields[0].Name)}, path)
+			}
+			return nil, true
+		}
+		return completed, false
+	}
+	if isNil(value) {
+		return nil, false
+	}
+
+	switch typed := t.(type) {
+	case *List:
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			ec.addError(&Error{Message: fmt.Sprintf("필드 %q의 값이 리스트가 아닙니다", fields[0].Name)}, path)
+			return nil, true
+		}
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			item, failed := ec.completeValue(typed.OfType, fields, rv.Index(i).Interface(), appendPath(path, i))
+			if failed && isNonNull(typed.OfType) {
+				return nil, true
+			}
+			items[i] = item
+		}
+		return items, false
+	case *Scalar:
+		serialized, err := typed.Serialize(value)
+		if err != nil {
+			ec.addError(err, path)
+			return nil, true
+		}
+		return serialized, false
+	case *Enum:
+		name := fmt.Sprint(value)
+		if !typed.has(name) {
+			ec.addError(&Error{Message: fmt.Sprintf("%s에 %q 값이 없습니다", typed.Name, name)}, path)
+			return nil, true
+		}
+		return name, false
+	case *Object:
+		var selections []Selection
+		for _, field := range fields {
+			selections = append(selections, field.SelectionSet...)
+		}
+		result, propagate := ec.executeSelectionSet(typed, value, selections, path)
+		if propagate {
+			return nil, true
+		}
+		return result, false
+	}
+	ec.addError(&Error{Message: fmt.Sprintf("알 수 없는 타입 %s", t)}, path)
+	return nil, true
+}
+
+func (ec *executor) addError(err error, path []interface{}) {
+	ec.errors = append(ec.errors, withPath(err, path))
+}
+
+// withPath 에러를 경로가 붙은 GraphQL 에러로 변환
+func withPath(err error, path []interface{}) *Error {
+	gqlErr, ok := err.(*Error)
+	if !ok {
+		return &Error{Message: err.Error(), Path: path}
+	}
+	copied := *gqlErr
+	if copied.Path == nil {
+		copied.Path = path
+	}
+	return &copied
+}
+
+func errorResult(err error) *Result {
+	return &Result{Errors: []*Error{withPath(err, nil)}}
+}
+
+// defaultResolve 리졸버가 없는 필드는 source가 맵이면 같은 키의 값을 사용
+func defaultResolve(source interface{}, name string) interface{} {
+	switch s := source.(type) {
+	case map[string]interface{}:
+		return s[name]
+	case *OrderedMap:
+		value, _ := s.Get(name)
+		return value
+	}
+	return nil
+}
+
+func appendPath(path []interface{}, key interface{}) []interface{} {
+	next := make([]interface{}, len(path), len(path)+1)
+	copy(next, path)
+	return append(next, key)
+}
+
+func isNonNull(t Type) bool {
+	_, ok := t.(*NonNull)
+	return ok
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// inputType 변수 선언의 타입 참조를 스키마 타입으로 변환
+func (s *Schema) inputType(ref TypeRef) (Type, error) {
+	var t Type
+	if ref.List != nil {
+		inner, err := s.inputType(*ref.List)
+		if err != nil {
+			return nil, err
+		}
+		t = NewList(inner)
+	} else {
+		named := s.lookupType(ref.Name)
+		switch named.(type) {
+		case *Scalar, *Enum:
+			t = named
+		case nil:
+			return nil, &Error{Message: fmt.Sprintf("알 수 없는 타입 %q", ref.Name)}
+		default:
+			return nil, &Error{Message: fmt.Sprintf("%s는 입력 타입이 아닙니다", ref.Name)}
+		}
+	}
+	if ref.NonNull {
+		t = NewNonNull(t)
+	}
+	return t, nil
+}
+
+// lookupType 스키마에서 이름으로 타입 찾기 (기본 스칼라 포함)
+func (s *Schema) lookupType(name string) Type {
+	for _, scalar := range []*Scalar{String, ID, Int, Float, Boolean} {
+		if scalar.Name == name {
+			return scalar
+		}
+	}
+	visited := make(map[*Object]bool)
+	var find func(t Type) Type
+	find = func(t Type) Type {
+		switch typed := namedType(t).(type) {
+		case *Object:
+			if typed == nil || visited[typed] {
+				return nil
+			}
+			visited[typed] = true
+			if typed.Name == name {
+				return typed
+			}
+			for _, field := range typed.Fields {
+				if found := find(field.Type); found != nil {
+					return found
+				}
+				for _, arg := range field.Args {
+					if found := find(arg.Type); found != nil {
+						return found
+					}
+				}
+			}
+		case *Scalar:
+			if typed.Name == name {
+				return typed
+			}
+		case *Enum:
+			if typed.Name == name {
+				return typed
+			}
+		}
+		return nil
+	}
+	if s.Query != nil {
+		if found := find(s.Query); found != nil {
+			return found
+		}
+	}
+	if s.Subscription != nil {
+		return find(s.Subscription)
+	}
+	return nil
+}
+
+// validator 실행 전에 선택 집합이 스키마에 맞는지 확인
+type validator struct {
+	schema        *Schema
+	doc           *Document
+	op            *OperationDefinition
+	maxDepth      int
+	maxComplexity int
+	maxAliases    int
+	complexity    int
+	aliases       int
+	errors        []*Error
+}
+
+func (v *validator) errorf(format string, args ...interface{}) {
+	v.errors = append(v.errors, &Error{Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) selectionSet(object *Object, selections []Selection, depth int, fragments map[string]bool) {
+	// 인트로스펙션 타입은 ofType 중첩이 깊어 깊이 대신 복잡도로만 제한
+	if depth > v.maxDepth && !strings.HasPrefix(object.Name, "__") {
+		v.errorf("쿼리 깊이가 최대 %d를 넘었습니다", v.maxDepth)
+		return
+	}
+	for _, selection := range selections {
+		if len(v.errors) > 0 {
+			return
+		}
+		v.directives(selection.directives())
+		switch s := selection.(type) {
+		case *FieldNode:
+			v.field(object, s, depth, fragments)
+		case *InlineFragment:
+			if s.TypeCondition != "" && s.TypeCondition != object.Name {
+				v.errorf("%s 타입에 %s 프래그먼트를 사용할 수 없습니다", object.Name, s.TypeCondition)
+				return
+			}
+			v.selectionSet(object, s.SelectionSet, depth, fragments)
+		case *FragmentSpread:
+			fragment := v.doc.Fragments[s.Name]
+			if fragment == nil {
+				v.errorf("프래그먼트 %q가 정의되지 않았습니다", s.Name)
+				return
+			}
+			if fragment.TypeCondition != object.Name {
+				v.errorf("%s 타입에 %s 프래그먼트를 사용할 수 없습니다", object.Name, fragment.TypeCondition)
+				return
+			}
+			if fragments[s.Name] {
+				v.errorf("프래그먼트 %q가 자기 자신을 참조합니다", s.Name)
+				return
+			}
+			fragments[s.Name] = true
+			v.selectionSet(object, fragment.SelectionSet, depth, fragments)
+			delete(fragments, s.Name)
+		}
+	}
+}
+
+func (v *validator) field(object *Object, field *FieldNode, depth int, fragments map[string]bool) {
+	// 프래그먼트를 펼칠 때마다 다시 세므로 프래그먼트 반복으로 응답을 부풀릴 수 없음
+	v.complexity++
+	if v.complexity > v.maxComplexity {
+		v.errorf("쿼리 복잡도가 최대 %d를 넘었습니다", v.maxComplexity)
+		return
+	}
+	if field.Alias != "" && field.Alias != field.Name {
+		v.aliases++
+		if v.aliases > v.maxAliases {
+			v.errorf("별칭 수가 최대 %d를 넘었습니다", v.maxAliases)
+			return
+		}
+	}
+	if field.Name == "__typename" {
+		if len(field.SelectionSet) > 0 {
+			v.errorf("__typename 필드에는 하위 선택을 할 수 없습니다")
+		}
+		return
+	}
+	def := v.schema.fieldDef(object, field.Name)
+	if def == nil {
+		v.errorf("%s 타입에 %q 필드가 없습니다", object.Name, field.Name)
+		return
+	}
+	for name, value := range field.Arguments {
+		if def.Args[name] == nil {
+			v.errorf("%s.%s 필드에 %q 인자가 없습니다", object.Name, field.Name, name)
+			return
+		}
+		if hasVariable(value) {
+			v.variables(value)
+			continue
+		}
+		if _, err := coerceInput(def.Args[name].Type, value); err != nil {
+			v.errorf("%s.%s 필드의 %q 인자: %v", object.Name, field.Name, name, err)
+			return
+		}
+	}
+	for name, arg := range def.Args {
+		if _, provided := field.Arguments[name]; !provided && arg.DefaultValue == nil && isNonNull(arg.Type) {
+			v.errorf("%s.%s 필드에 %q 인자가 필요합니다", object.Name, field.Name, name)
+			return
+		}
+	}
+
+	if child, ok := namedType(def.Type).(*Object); ok {
+		if len(field.SelectionSet) == 0 {
+			v.errorf("%s 타입의 %q 필드는 하위 필드를 선택해야 합니다", object.Name, field.Name)
+			return
+		}
+		v.selectionSet(child, field.SelectionSet, depth+1, fragments)
+		return
+	}
+	if len(field.SelectionSet) > 0 {
+		v.errorf("%s 타입의 %q 필드에는 하위 선택을 할 수 없습니다", object.Name, field.Name)
+	}
+}
+
+func (v *validator) directives(directives []*Directive) {
+	for _, directive := range directives {
+		if directive.Name != "skip" && directive.Name != "include" {
+			v.errorf("알 수 없는 디렉티브 @%s", directive.Name)
+			return
+		}
+		value, ok := directive.Arguments["if"]
+		if !ok || len(directive.Arguments) != 1 {
+			v.errorf("@%s 디렉티브에는 if 인자만 필요합니다", directive.Name)
+			return
+		}
+		if _, isBool := value.(bool); !isBool {
+			if _, isVariable := value.(*Variable); !isVariable {
+				v.errorf("@%s 디렉티브의 if 인자는 Boolean이어야 합니다", directive.Name)
+				return
+			}
+		}
+		v.variables(value)
+	}
+}
+
+// hasVariable 값 안에 변수 참조가 있는지 확인
+func hasVariable(value Value) bool {
+	switch val := value.(type) {
+	case *Variable:
+		return true
+	case ListValue:
+		for _, item := range val {
+			if hasVariable(item) {
+				return true
+			}
+		}
+	case ObjectValue:
+		for _, item := range val {
+			if hasVariable(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// variables 값 안의 변수 참조가 연산에 선언되었는지 확인
+func (v *validator) variables(value Value) {
+	switch val := value.(type) {
+	case *Variable:
+		for _, def := range v.op.Variables {
+			if def.Name == val.Name {
+				return
+			}
+		}
+		v.errorf("변수 $%s가 선언되지 않았습니다", val.Name)
+	case ListValue:
+		for _, item := range val {
+			v.variables(item)
+		}
+	case ObjectValue:
+		for _, item := range val {
+			v.variables(item)
+		}
+	}
+}
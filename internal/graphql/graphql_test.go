@@ -0,0 +1,189 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	Name   string
+	Secret string
+}
+
+func newTestSchema() *Schema {
+	item := &Object{Name: "Item", Fields: map[string]*Field{
+		"name": {Type: NewNonNull(String), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(*testItem).Name, nil
+		}},
+		"secret": {
+			Type: String,
+			Authorize: func(p ResolveParams) error {
+				return &Error{Message: "forbidden", Extensions: map[string]interface{}{"code": "FORBIDDEN"}}
+			},
+			Resolve: func(p ResolveParams) (interface{}, error) { return p.Source.(*testItem).Secret, nil },
+		},
+		"broken": {Type: NewNonNull(String), Resolve: func(p ResolveParams) (interface{}, error) {
+			return nil, errors.New("boom")
+		}},
+	}}
+	item.Fields["next"] = &Field{Type: item, Resolve: func(p ResolveParams) (interface{}, error) { return p.Source, nil }}
+	items := []*testItem{{Name: "a", Secret: "x"}, {Name: "b", Secret: "y"}}
+
+	return &Schema{
+		Query: &Object{Name: "Query", Fields: map[string]*Field{
+			"items": {
+				Type: NewList(NewNonNull(item)),
+				Args: map[string]*Argument{"first": {Type: Int, DefaultValue: 10}},
+				Resolve: func(p ResolveParams) (interface{}, error) {
+					first := p.Args["first"].(int)
+					if first < len(items) {
+						return items[:first], nil
+					}
+					return items, nil
+				},
+			},
+			"echo": {
+				Type:    String,
+				Args:    map[string]*Argument{"value": {Type: NewNonNull(String)}},
+				Resolve: func(p ResolveParams) (interface{}, error) { return p.Args["value"], nil },
+			},
+		}},
+		Subscription: &Object{Name: "Subscription", Fields: map[string]*Field{
+			"ticks": {
+				Type: NewNonNull(item),
+				Subscribe: func(p ResolveParams) (<-chan interface{}, error) {
+					ch := make(chan interface{}, len(items))
+					for _, it := range items {
+						ch <- it
+					}
+					close(ch)
+					return ch, nil
+				},
+			},
+		}},
+		MaxDepth: 3,
+	}
+}
+
+func marshal(t *testing.T, v interface{}) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestExecute(t *testing.T) {
+	schema := newTestSchema()
+	ctx := context.Background()
+
+	result := Execute(ctx, schema, &Request{
+		Query: `query Q($n: Int, $skip: Boolean!) {
+			list: items(first: $n) { ...fields __typename secret @skip(if: $skip) }
+			echo(value: "hi\n")
+		}
+		fragment fields on Item { name }`,
+		Variables: map[string]interface{}{"n": float64(1), "skip": true},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, `{"list":[{"name":"a","__typename":"Item"}],"echo":"hi\n"}`, marshal(t, result.Data))
+
+	// 최대 깊이 안의 중첩 선택
+	result = Execute(ctx, schema, &Request{Query: `{ items(first: 1) { next { name } } }`})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, `{"items":[{"next":{"name":"a"}}]}`, marshal(t, result.Data))
+
+	// 권한이 없는 필드만 null이 되고 에러에 경로가 붙음
+	result = Execute(ctx, schema, &Request{Query: `{ items(first: 1) { name secret } }`})
+	assert.Equal(t, `{"items":[{"name":"a","secret":null}]}`, marshal(t, result.Data))
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, []interface{}{"items", 0, "secret"}, result.Errors[0].Path)
+	assert.Equal(t, "FORBIDDEN", result.Errors[0].Extensions["code"])
+
+	// null일 수 없는 필드의 에러는 가장 가까운 nullable 상위까지 null 전파
+	result = Execute(ctx, schema, &Request{Query: `{ items { broken } echo(value: "ok") }`})
+	assert.Equal(t, `{"items":null,"echo":"ok"}`, marshal(t, result.Data))
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "boom", result.Errors[0].Message)
+}
+
+func TestExecute_RequestErrors(t *testing.T) {
+	schema := newTestSchema()
+	for name, query := range map[string]string{
+		"syntax":            `{ items { name }`,
+		"unknown field":     `{ items { nope } }`,
+		"missing arg":       `{ echo }`,
+		"leaf selection":    `{ echo(value: "a") { x } }`,
+		"missing select":    `{ items }`,
+		"undefined var":     `{ echo(value: $v) }`,
+		"fragment cycle":    `{ items { ...a } } fragment a on Item { ...b } fragment b on Item { ...a }`,
+		"too deep":          `{ items { next { next { name } } } }`,
+		"subscription":      `subscription { ticks { name } }`,
+		"two operations":    `query A { echo(value: "a") } query B { echo(value: "b") }`,
+		"wrong arg type":    `{ items(first: "x") { name } }`,
+		"unknown directive": `{ items @cached { name } }`,
+		"too many aliases":  `{ ` + strings.Repeat(`a: echo(value: "a") `, DefaultMaxAliases+1) + `}`,
+		"too complex":       `{ items { ...f } } fragment f on Item { ` + strings.Repeat(`name `, DefaultMaxComplexity) + `}`,
+	} {
+		result := Execute(context.Background(), schema, &Request{Query: query})
+		assert.Nil(t, result.Data, name)
+		assert.NotEmpty(t, result.Errors, name)
+	}
+}
+
+func TestExecute_Introspection(t *testing.T) {
+	schema := newTestSchema()
+	ctx := context.Background()
+
+	result := Execute(ctx, schema, &Request{Query: `{
+		__schema { queryType { name } subscriptionType { name } types { name } directives { name } }
+		__type(name: "Item") {
+			kind
+			fields { name args { name } type { kind name ofType { kind name } } }
+		}
+	}`})
+	require.Empty(t, result.Errors)
+
+	data := result.Data.(*OrderedMap)
+	schemaData, _ := data.Get("__schema")
+	encoded := marshal(t, schemaData)
+	assert.Contains(t, encoded, `"queryType":{"name":"Query"}`)
+	assert.Contains(t, encoded, `"subscriptionType":{"name":"Subscription"}`)
+	assert.Contains(t, encoded, `{"name":"Item"}`)
+	assert.Contains(t, encoded, `{"name":"__Type"}`)
+	assert.Contains(t, encoded, `"directives":[{"name":"skip"},{"name":"include"}]`)
+
+	typeData, _ := data.Get("__type")
+	encoded = marshal(t, typeData)
+	assert.Contains(t, encoded, `"kind":"OBJECT"`)
+	assert.Contains(t, encoded, `{"name":"name","args":[],"type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"SCALAR","name":"String"}}}`)
+
+	// 인트로스펙션 타입 안의 깊은 ofType 중첩은 깊이 제한을 받지 않음
+	result = Execute(ctx, schema, &Request{Query: `{ __type(name: "Query") { fields { type { ofType { ofType { ofType { name } } } } } } }`})
+	assert.Empty(t, result.Errors)
+
+	// 인트로스펙션을 끄면 메타 필드를 거부
+	schema.DisableIntrospection = true
+	result = Execute(ctx, schema, &Request{Query: `{ __schema { queryType { name } } }`})
+	assert.Nil(t, result.Data)
+	assert.NotEmpty(t, result.Errors)
+}
+
+func TestSubscribe(t *testing.T) {
+	results, errResult := Subscribe(context.Background(), newTestSchema(), &Request{Query: `subscription { tick: ticks { name } }`})
+	require.Nil(t, errResult)
+
+	var names []string
+	for result := range results {
+		require.Empty(t, result.Errors)
+		names = append(names, marshal(t, result.Data))
+	}
+	assert.Equal(t, []string{`{"tick":{"name":"a"}}`, `{"tick":{"name":"b"}}`}, names)
+
+	_, errResult = Subscribe(context.Background(), newTestSchema(), &Request{Query: `{ items { name } }`})
+	require.NotNil(t, errResult)
+}
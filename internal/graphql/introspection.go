@@ -0,0 +1,345 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// 인트로스펙션 메타 타입 (__Schema, __Type, ...)
+// 쿼리 루트의 __schema, __type 필드로 조회합니다.
+var (
+	introspectionSchema     *Object
+	introspectionType       *Object
+	introspectionField      *Object
+	introspectionInputValue *Object
+	introspectionEnumValue  *Object
+	introspectionDirective  *Object
+
+	introspectionTypeKind = &Enum{Name: "__TypeKind", Values: []string{
+		"SCALAR", "OBJECT", "INTERFACE", "UNION", "ENUM", "INPUT_OBJECT", "LIST", "NON_NULL",
+	}}
+	introspectionDirectiveLocation = &Enum{Name: "__DirectiveLocation", Values: []string{
+		"QUERY", "SUBSCRIPTION", "FIELD", "FRAGMENT_DEFINITION", "FRAGMENT_SPREAD", "INLINE_FRAGMENT",
+	}}
+)
+
+// namedField 인트로스펙션에서 이름과 함께 다루는 필드 정의
+type namedField struct {
+	name string
+	def  *Field
+}
+
+// namedArgument 인트로스펙션에서 이름과 함께 다루는 인자 정의
+type namedArgument struct {
+	name string
+	def  *Argument
+}
+
+// directiveDefinition 지원하는 디렉티브 정의
+type directiveDefinition struct {
+	name        string
+	description string
+	locations   []string
+	args        []namedArgument
+}
+
+// supportedDirectives 실행기가 처리하는 디렉티브 (@skip, @include)
+var supportedDirectives = []directiveDefinition{
+	{
+		name:        "skip",
+		description: "if가 true이면 선택에서 제외",
+		locations:   []string{"FIELD", "FRAGMENT_SPREAD", "INLINE_FRAGMENT"},
+		args:        []namedArgument{{name: "if", def: &Argument{Type: NewNonNull(Boolean)}}},
+	},
+	{
+		name:        "include",
+		description: "if가 true일 때만 선택에 포함",
+		locations:   []string{"FIELD", "FRAGMENT_SPREAD", "INLINE_FRAGMENT"},
+		args:        []namedArgument{{name: "if", def: &Argument{Type: NewNonNull(Boolean)}}},
+	},
+}
+
+func init() {
+	introspectionSchema = &Object{Name: "__Schema"}
+	introspectionType = &Object{Name: "__Type"}
+	introspectionField = &Object{Name: "__Field"}
+	introspectionInputValue = &Object{Name: "__InputValue"}
+	introspectionEnumValue = &Object{Name: "__EnumValue"}
+	introspectionDirective = &Object{Name: "__Directive"}
+
+	includeDeprecated := map[string]*Argument{"includeDeprecated": {Type: Boolean, DefaultValue: false}}
+	notDeprecated := func(p ResolveParams) (interface{}, error) { return false, nil }
+	noValue := func(p ResolveParams) (interface{}, error) { return nil, nil }
+
+	introspectionSchema.Fields = map[string]*Field{
+		"description": {Type: String, Resolve: noValue},
+		"types": {Type: NewNonNull(NewList(NewNonNull(introspectionType))), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(*Schema).namedTypes(), nil
+		}},
+		"queryType": {Type: NewNonNull(introspectionType), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(*Schema).Query, nil
+		}},
+		"mutationType": {Type: introspectionType, Resolve: noValue},
+		"subscriptionType": {Type: introspectionType, Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(*Schema).Subscription, nil
+		}},
+		"directives": {Type: NewNonNull(NewList(NewNonNull(introspectionDirective))), Resolve: func(p ResolveParams) (interface{}, error) {
+			return supportedDirectives, nil
+		}},
+	}
+
+	introspectionType.Fields = map[string]*Field{
+		"kind": {Type: NewNonNull(introspectionTypeKind), Resolve: func(p ResolveParams) (interface{}, error) {
+			switch p.Source.(type) {
+			case *Scalar:
+				return "SCALAR", nil
+			case *Enum:
+				return "ENUM", nil
+			case *Object:
+				return "OBJECT", nil
+			case *List:
+				return "LIST", nil
+			case *NonNull:
+				return "NON_NULL", nil
+			}
+			return nil, fmt.Errorf("알 수 없는 타입 %v", p.Source)
+		}},
+		"name": {Type: String, Resolve: func(p ResolveParams) (interface{}, error) {
+			switch t := p.Source.(type) {
+			case *List, *NonNull:
+				return nil, nil
+			case Type:
+				return t.String(), nil
+			}
+			return nil, nil
+		}},
+		"description": {Type: String, Resolve: func(p ResolveParams) (interface{}, error) {
+			if object, ok := p.Source.(*Object); ok && object.Description != "" {
+				return object.Description, nil
+			}
+			return nil, nil
+		}},
+		"specifiedByURL": {Type: String, Resolve: noValue},
+		"fields": {Type: NewList(NewNonNull(introspectionField)), Args: includeDeprecated, Resolve: func(p ResolveParams) (interface{}, error) {
+			object, ok := p.Source.(*Object)
+			if !ok {
+				return nil, nil
+			}
+			names := make([]string, 0, len(object.Fields))
+			for name := range object.Fields {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			fields := make([]namedField, len(names))
+			for i, name := range names {
+				fields[i] = namedField{name: name, def: object.Fields[name]}
+			}
+			return fields, nil
+		}},
+		"interfaces": {Type: NewList(NewNonNull(introspectionType)), Resolve: func(p ResolveParams) (interface{}, error) {
+			if _, ok := p.Source.(*Object); ok {
+				return []Type{}, nil
+			}
+			return nil, nil
+		}},
+		"possibleTypes": {Type: NewList(NewNonNull(introspectionType)), Resolve: noValue},
+		"enumValues": {Type: NewList(NewNonNull(introspectionEnumValue)), Args: includeDeprecated, Resolve: func(p ResolveParams) (interface{}, error) {
+			if enum, ok := p.Source.(*Enum); ok {
+				return enum.Values, nil
+			}
+			return nil, nil
+		}},
+		"inputFields": {Type: NewList(NewNonNull(introspectionInputValue)), Resolve: noValue},
+		"ofType": {Type: introspectionType, Resolve: func(p ResolveParams) (interface{}, error) {
+			switch t := p.Source.(type) {
+			case *List:
+				return t.OfType, nil
+			case *NonNull:
+				return t.OfType, nil
+			}
+			return nil, nil
+		}},
+	}
+
+	introspectionField.Fields = map[string]*Field{
+		"name": {Type: NewNonNull(String), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(namedField).name, nil
+		}},
+		"description": {Type: String, Resolve: func(p ResolveParams) (interface{}, error) {
+			if description := p.Source.(namedField).def.Description; description != "" {
+				return description, nil
+			}
+			return nil, nil
+		}},
+		"args": {Type: NewNonNull(NewList(NewNonNull(introspectionInputValue))), Args: includeDeprecated, Resolve: func(p ResolveParams) (interface{}, error) {
+			return sortedArguments(p.Source.(namedField).def.Args), nil
+		}},
+		"type": {Type: NewNonNull(introspectionType), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(namedField).def.Type, nil
+		}},
+		"isDeprecated":      {Type: NewNonNull(Boolean), Resolve: notDeprecated},
+		"deprecationReason": {Type: String, Resolve: noValue},
+	}
+
+	introspectionInputValue.Fields = map[string]*Field{
+		"name": {Type: NewNonNull(String), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(namedArgument).name, nil
+		}},
+		"description": {Type: String, Resolve: func(p ResolveParams) (interface{}, error) {
+			if description := p.Source.(namedArgument).def.Description; description != "" {
+				return description, nil
+			}
+			return nil, nil
+		}},
+		"type": {Type: NewNonNull(introspectionType), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(namedArgument).def.Type, nil
+		}},
+		"defaultValue": {Type: String, Resolve: func(p ResolveParams) (interface{}, error) {
+			arg := p.Source.(namedArgument).def
+			if arg.DefaultValue == nil {
+				return nil, nil
+			}
+			return valueLiteral(arg.Type, arg.DefaultValue), nil
+		}},
+		"isDeprecated":      {Type: NewNonNull(Boolean), Resolve: notDeprecated},
+		"deprecationReason": {Type: String, Resolve: noValue},
+	}
+
+	introspectionEnumValue.Fields = map[string]*Field{
+		"name": {Type: NewNonNull(String), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source, nil
+		}},
+		"description":       {Type: String, Resolve: noValue},
+		"isDeprecated":      {Type: NewNonNull(Boolean), Resolve: notDeprecated},
+		"deprecationReason": {Type: String, Resolve: noValue},
+	}
+
+	introspectionDirective.Fields = map[string]*Field{
+		"name": {Type: NewNonNull(String), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(directiveDefinition).name, nil
+		}},
+		"description": {Type: String, Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(directiveDefinition).description, nil
+		}},
+		"locations": {Type: NewNonNull(NewList(NewNonNull(introspectionDirectiveLocation))), Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(directiveDefinition).locations, nil
+		}},
+		"args": {Type: NewNonNull(NewList(NewNonNull(introspectionInputValue))), Args: includeDeprecated, Resolve: func(p ResolveParams) (interface{}, error) {
+			return p.Source.(directiveDefinition).args, nil
+		}},
+		"isRepeatable": {Type: NewNonNull(Boolean), Resolve: notDeprecated},
+	}
+}
+
+// metaField 쿼리 루트에서만 선택할 수 있는 인트로스펙션 필드 (__schema, __type)
+func (s *Schema) metaField(name string) *Field {
+	switch name {
+	case "__schema":
+		return &Field{Type: NewNonNull(introspectionSchema), Resolve: func(p ResolveParams) (interface{}, error) {
+			return s, nil
+		}}
+	case "__type":
+		return &Field{
+			Type: introspectionType,
+			Args: map[string]*Argument{"name": {Type: NewNonNull(String)}},
+			Resolve: func(p ResolveParams) (interface{}, error) {
+				for _, t := range s.namedTypes() {
+					if t.String() == p.Args["name"] {
+						return t, nil
+					}
+				}
+				return nil, nil
+			},
+		}
+	}
+	return nil
+}
+
+// fieldDef 객체의 필드 정의 (쿼리 루트는 인트로스펙션 필드 포함)
+func (s *Schema) fieldDef(object *Object, name string) *Field {
+	if def := object.Fields[name]; def != nil {
+		return def
+	}
+	if object == s.Query && !s.DisableIntrospection {
+		return s.metaField(name)
+	}
+	return nil
+}
+
+// namedTypes 스키마에서 도달할 수 있는 이름 있는 타입 전체 (이름순, 기본 스칼라와 인트로스펙션 타입 포함)
+func (s *Schema) namedTypes() []Type {
+	seen := make(map[string]Type)
+	var walk func(t Type)
+	walk = func(t Type) {
+		named := namedType(t)
+		if named == nil {
+			return
+		}
+		if object, ok := named.(*Object); ok && object == nil {
+			return
+		}
+		if _, ok := seen[named.String()]; ok {
+			return
+		}
+		seen[named.String()] = named
+		if object, ok := named.(*Object); ok {
+			for _, field := range object.Fields {
+				walk(field.Type)
+				for _, arg := range field.Args {
+					walk(arg.Type)
+				}
+			}
+		}
+	}
+	for _, scalar := range []*Scalar{String, ID, Int, Float, Boolean} {
+		walk(scalar)
+	}
+	walk(s.Query)
+	walk(s.Subscription)
+	walk(introspectionSchema)
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	types := make([]Type, len(names))
+	for i, name := range names {
+		types[i] = seen[name]
+	}
+	return types
+}
+
+// sortedArguments 인자 정의를 이름순으로 정렬
+func sortedArguments(args map[string]*Argument) []namedArgument {
+	sorted := make([]namedArgument, 0, len(args))
+	for name, def := range args {
+		sorted = append(sorted, namedArgument{name: name, def: def})
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	return sorted
+}
+
+// valueLiteral 기본값을 GraphQL 리터럴 문자열로 표현 (열거형 값은 따옴표 없이)
+func valueLiteral(t Type, value interface{}) string {
+	if enum, ok := namedType(t).(*Enum); ok {
+		if name, ok := value.(string); ok && enum.has(name) {
+			return name
+		}
+	}
+	switch v := value.(type) {
+	case []string:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = valueLiteral(t, item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,257 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Type 스키마 타입 (*Scalar, *Enum, *Object, *List, *NonNull)
+type Type interface {
+	String() string
+}
+
+// ResolveParams 리졸버에 전달되는 값
+type ResolveParams struct {
+	Context context.Context
+	// Source 상위 객체 값 (루트 필드는 nil, 구독 이벤트 필드는 이벤트 값)
+	Source interface{}
+	// Args 강제 변환을 마친 인자 (기본값 적용)
+	Args map[string]interface{}
+}
+
+// ResolveFunc 필드 값을 구하는 함수
+type ResolveFunc func(p ResolveParams) (interface{}, error)
+
+// SubscribeFunc 구독 필드의 이벤트 스트림을 여는 함수
+// 채널은 ctx가 끝나거나 스트림이 끝나면 닫아야 합니다.
+type SubscribeFunc func(p ResolveParams) (<-chan interface{}, error)
+
+// AuthorizeFunc 필드 단위 권한 확인 (에러를 반환하면 필드 값은 null이 되고 에러가 기록됨)
+type AuthorizeFunc func(p ResolveParams) error
+
+// Field 객체 필드 정의
+type Field struct {
+	Type        Type
+	Description string
+	Args        map[string]*Argument
+	Resolve     ResolveFunc
+	// Authorize 필드를 리졸브하기 전에 호출 (nil이면 확인하지 않음)
+	Authorize AuthorizeFunc
+	// Subscribe 구독 루트 필드의 이벤트 스트림 (구독 타입에서만 사용)
+	Subscribe SubscribeFunc
+}
+
+// Argument 필드 인자 정의
+type Argument struct {
+	Type         Type
+	DefaultValue interface{}
+	Description  string
+}
+
+// Object 객체 타입
+type Object struct {
+	Name        string
+	Description string
+	Fields      map[string]*Field
+}
+
+func (o *Object) String() string { return o.Name }
+
+// Scalar 스칼라 타입
+type Scalar struct {
+	Name string
+	// Serialize 리졸버 값을 응답 값으로 변환
+	Serialize func(value interface{}) (interface{}, error)
+	// ParseValue 변수/리터럴 값을 Go 값으로 변환 (리터럴 정수는 int64, 실수는 float64로 전달됨)
+	ParseValue func(value interface{}) (interface{}, error)
+}
+
+func (s *Scalar) String() string { return s.Name }
+
+// Enum 열거형 타입 (값은 Go 문자열로 주고받음)
+type Enum struct {
+	Name   string
+	Values []string
+}
+
+func (e *Enum) String() string { return e.Name }
+
+func (e *Enum) has(value string) bool {
+	for _, v := range e.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// List 리스트 타입
+type List struct {
+	OfType Type
+}
+
+func (l *List) String() string { return "[" + l.OfType.String() + "]" }
+
+// NonNull null 불가 타입
+type NonNull struct {
+	OfType Type
+}
+
+func (n *NonNull) String() string { return n.OfType.String() + "!" }
+
+// NewList 리스트 타입 생성
+func NewList(of Type) *List { return &List{OfType: of} }
+
+// NewNonNull null 불가 타입 생성
+func NewNonNull(of Type) *NonNull { return &NonNull{OfType: of} }
+
+// Schema 실행 가능한 스키마
+type Schema struct {
+	Query        *Object
+	Subscription *Object
+	// MaxDepth 선택 집합의 최대 깊이 (0이면 DefaultMaxDepth, 인트로스펙션 타입 안의 선택은 세지 않음)
+	MaxDepth int
+	// MaxComplexity 프래그먼트를 펼친 뒤의 최대 필드 선택 수 (0이면 DefaultMaxComplexity)
+	MaxComplexity int
+	// MaxAliases 연산 하나에서 쓸 수 있는 최대 별칭 수 (0이면 DefaultMaxAliases)
+	MaxAliases int
+	// DisableIntrospection true이면 __schema, __type 필드를 거부
+	DisableIntrospection bool
+}
+
+// 기본 쿼리 제한
+const (
+	DefaultMaxDepth      = 10
+	DefaultMaxComplexity = 1000
+	DefaultMaxAliases    = 20
+)
+
+// namedType NonNull/List를 벗긴 타입
+func namedType(t Type) Type {
+	for {
+		switch wrapped := t.(type) {
+		case *NonNull:
+			t = wrapped.OfType
+		case *List:
+			t = wrapped.OfType
+		default:
+			return t
+		}
+	}
+}
+
+// 기본 스칼라
+var (
+	String = &Scalar{
+		Name: "String",
+		Serialize: func(value interface{}) (interface{}, error) {
+			switch v := value.(type) {
+			case string:
+				return v, nil
+			case fmt.Stringer:
+				return v.String(), nil
+			}
+			return fmt.Sprint(value), nil
+		},
+		ParseValue: func(value interface{}) (interface{}, error) {
+			if s, ok := value.(string); ok {
+				return s, nil
+			}
+			return nil, fmt.Errorf("String 값이 아닙니다: %v", value)
+		},
+	}
+
+	ID = &Scalar{
+		Name:      "ID",
+		Serialize: String.Serialize,
+		ParseValue: func(value interface{}) (interface{}, error) {
+			switch v := value.(type) {
+			case string:
+				return v, nil
+			case int64:
+				return strconv.FormatInt(v, 10), nil
+			case float64:
+				if v == math.Trunc(v) {
+					return strconv.FormatInt(int64(v), 10), nil
+				}
+			}
+			return nil, fmt.Errorf("ID 값이 아닙니다: %v", value)
+		},
+	}
+
+	Int = &Scalar{
+		Name: "Int",
+		// 바이트 수처럼 32비트를 넘는 값도 잘리지 않도록 그대로 내보냄
+		Serialize: func(value interface{}) (interface{}, error) {
+			if n, ok := toInt64(value); ok {
+				return n, nil
+			}
+			return nil, fmt.Errorf("Int 값이 아닙니다: %v", value)
+		},
+		ParseValue: func(value interface{}) (interface{}, error) {
+			switch v := value.(type) {
+			case int64:
+				return int(v), nil
+			case float64:
+				if v == math.Trunc(v) && !math.IsInf(v, 0) {
+					return int(v), nil
+				}
+			}
+			return nil, fmt.Errorf("Int 값이 아닙니다: %v", value)
+		},
+	}
+
+	Float = &Scalar{
+		Name: "Float",
+		Serialize: func(value interface{}) (interface{}, error) {
+			if f, ok := value.(float64); ok {
+				return f, nil
+			}
+			if n, ok := toInt64(value); ok {
+				return float64(n), nil
+			}
+			return nil, fmt.Errorf("Float 값이 아닙니다: %v", value)
+		},
+		ParseValue: func(value interface{}) (interface{}, error) {
+			switch v := value.(type) {
+			case int64:
+				return float64(v), nil
+			case float64:
+				return v, nil
+			}
+			return nil, fmt.Errorf("Float 값이 아닙니다: %v", value)
+		},
+	}
+
+	Boolean = &Scalar{
+		Name: "Boolean",
+		Serialize: func(value interface{}) (interface{}, error) {
+			if b, ok := value.(bool); ok {
+				return b, nil
+			}
+			return nil, fmt.Errorf("Boolean 값이 아닙니다: %v", value)
+		},
+		ParseValue: func(value interface{}) (interface{}, error) {
+			if b, ok := value.(bool); ok {
+				return b, nil
+			}
+			return nil, fmt.Errorf("Boolean 값이 아닙니다: %v", value)
+		},
+	}
+)
+
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint32:
+		return int64(v), true
+	}
+	return 0, false
+}
@@ -12,6 +12,7 @@ type WorkspaceService interface {
 	// CRUD 기본 오퍼레이션
 	CreateWorkspace(ctx context.Context, req *models.CreateWorkspaceRequest, ownerID string) (*models.Workspace, error)
 	GetWorkspace(ctx context.Context, id string, ownerID string) (*models.Workspace, error)
+	AuthorizeWorkspace(ctx context.Context, id string, userID string, required models.WorkspacePermission) (*models.Workspace, error)
 	UpdateWorkspace(ctx context.Context, id string, req *models.UpdateWorkspaceRequest, ownerID string) (*models.Workspace, error)
 	DeleteWorkspace(ctx context.Context, id string, ownerID string) error
 	
@@ -0,0 +1,363 @@
+// Package jobs는 정리, 백업, 퍼지 같은 백그라운드 작업을 등록하고 주기적으로 실행하는 실행기를 제공합니다.
+// 작업마다 실행 중 여부와 마지막 실행 결과를 보관하여 관리자 API에서 확인하고 수동으로 실행할 수 있습니다.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/cluster"
+)
+
+var (
+	// ErrJobNotFound 등록되지 않은 작업
+	ErrJobNotFound = errors.New("job not found")
+
+	// ErrJobRunning 이미 실행 중인 작업
+	ErrJobRunning = errors.New("job is already running")
+)
+
+// Trigger 작업을 실행한 계기
+type Trigger string
+
+const (
+	TriggerSchedule Trigger = "schedule"
+	TriggerManual   Trigger = "manual"
+)
+
+// Result 작업 실행 결과
+type Result string
+
+const (
+	ResultSucceeded Result = "succeeded"
+	ResultFailed    Result = "failed"
+	ResultSkipped   Result = "skipped" // 다른 인스턴스가 같은 작업을 실행 중
+)
+
+// Job 백그라운드 작업 정의
+type Job struct {
+	// Name 작업 이름 (잠금 이름과 API 경로에 사용)
+	Name string
+
+	// Description 작업 설명
+	Description string
+
+	// Schedule 실행 주기 (nil이면 수동으로만 실행)
+	Schedule Schedule
+
+	// Timeout 한 번 실행할 때의 제한 시간 (0이면 제한 없음)
+	Timeout time.Duration
+
+	// Local 인스턴스마다 따로 실행하는 작업 (분산 잠금 없이 인스턴스 안에서만 겹치지 않게 함)
+	Local bool
+
+	// Run 작업 본문
+	Run func(ctx context.Context) error
+}
+
+// Run 작업 한 번의 실행 기록
+type Run struct {
+	Trigger    Trigger   `json:"trigger"`
+	Result     Result    `json:"result"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	DurationMs int64     `json:"duration_ms"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Status 작업 상태
+type Status struct {
+	Name         string     `json:"name"`
+	Description  string     `json:"description,omitempty"`
+	Schedule     string     `json:"schedule,omitempty"`
+	Local        bool       `json:"local,omitempty"`
+	Running      bool       `json:"running"`
+	NextRunAt    *time.Time `json:"next_run_at,omitempty"`
+	LastRun      *Run       `json:"last_run,omitempty"`
+	LastSuccess  *time.Time `json:"last_success_at,omitempty"`
+	RunCount     int64      `json:"run_count"`
+	FailureCount int64      `json:"failure_count"`
+}
+
+type jobState struct {
+	job       Job
+	running   bool
+	nextRunAt time.Time
+	lastRun   *Run
+	lastOK    time.Time
+	runs      int64
+	failures  int64
+}
+
+// Runner 등록된 작업을 주기에 맞춰 실행하고 상태를 보관합니다
+// 같은 작업은 인스턴스 안에서 한 번에 하나만 실행하며, guard가 있으면 인스턴스 사이에서도 잠금을 잡습니다.
+type Runner struct {
+	guard  cluster.Guard
+	logger *logrus.Logger
+	now    func() time.Time
+
+	mu     sync.Mutex
+	jobs   map[string]*jobState
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRunner 새 작업 실행기 생성 (guard가 nil이면 인스턴스 안에서만 잠금)
+func NewRunner(guard cluster.Guard, logger *logrus.Logger) *Runner {
+	if logger == nil {
+		logger = logrus.New()
+	}
+	return &Runner{
+		guard:  guard,
+		logger: logger,
+		now:    time.Now,
+		jobs:   make(map[string]*jobState),
+	}
+}
+
+// Register 작업 등록 (실행 중에 등록한 작업은 다음 Start부터 예약됨)
+func (r *Runner) Register(job Job) error {
+	if job.Name == "" {
+		return fmt.Errorf("작업 이름이 필요합니다")
+	}
+	if job.Run == nil {
+		return fmt.Errorf("작업 본문이 필요합니다: %s", job.Name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.jobs[job.Name]; exists {
+		return fmt.Errorf("이미 등록된 작업입니다: %s", job.Name)
+	}
+	r.jobs[job.Name] = &jobState{job: job}
+	return nil
+}
+
+// Start 주기가 있는 작업의 예약 실행을 시작합니다
+func (r *Runner) Start(ctx context.Context) {
+	r.mu.Lock()
+	if r.cancel != nil {
+		r.mu.Unlock()
+		return
+	}
+	ctx, r.cancel = context.WithCancel(ctx)
+	states := make([]*jobState, 0, len(r.jobs))
+	for _, state := range r.jobs {
+		if state.job.Schedule != nil {
+			states = append(states, state)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, state := range states {
+		r.wg.Add(1)
+		go r.schedule(ctx, state)
+	}
+}
+
+// Stop 예약 실행을 멈추고 실행 중인 예약 작업이 끝날 때까지 기다립니다
+func (r *Runner) Stop() {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.cancel = nil
+	r.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		r.wg.Wait()
+	}
+}
+
+// Scheduling 예약 실행 중인지 여부 (다중 레플리카에서는 리더만 예약 실행)
+func (r *Runner) Scheduling() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cancel != nil
+}
+
+func (r *Runner) schedule(ctx context.Context, state *jobState) {
+	defer r.wg.Done()
+	defer func() {
+		r.mu.Lock()
+		state.nextRunAt = time.Time{}
+		r.mu.Unlock()
+	}()
+
+	for {
+		next := state.job.Schedule.Next(r.now())
+		if next.IsZero() {
+			return
+		}
+		r.mu.Lock()
+		state.nextRunAt = next
+		r.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if !r.acquire(state) {
+			r.logger.WithField("job", state.job.Name).Debug("이전 실행이 끝나지 않아 예약 실행을 건너뜀")
+			continue
+		}
+		r.execute(ctx, state, TriggerSchedule)
+	}
+}
+
+// Trigger 작업을 즉시 백그라운드에서 실행합니다 (요청이 끝나도 작업은 계속됨)
+func (r *Runner) Trigger(ctx context.Context, name string) error {
+	r.mu.Lock()
+	state, exists := r.jobs[name]
+	r.mu.Unlock()
+	if !exists {
+		return ErrJobNotFound
+	}
+	if !r.acquire(state) {
+		return ErrJobRunning
+	}
+
+	go r.execute(context.WithoutCancel(ctx), state, TriggerManual)
+	return nil
+}
+
+// acquire 인스턴스 안에서 작업을 실행 중으로 표시합니다 (이미 실행 중이면 false)
+func (r *Runner) acquire(state *jobState) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if state.running {
+		return false
+	}
+	state.running = true
+	return true
+}
+
+// execute acquire한 작업을 실행하고 결과를 기록합니다
+func (r *Runner) execute(ctx context.Context, state *jobState, trigger Trigger) {
+	job := state.job
+	if job.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, job.Timeout)
+		defer cancel()
+	}
+
+	run := &Run{Trigger: trigger, StartedAt: r.now()}
+	err := r.runLocked(ctx, job)
+	run.FinishedAt = r.now()
+	run.DurationMs = run.FinishedAt.Sub(run.StartedAt).Milliseconds()
+
+	switch {
+	case errors.Is(err, cluster.ErrLocked):
+		run.Result = ResultSkipped
+		run.Error = err.Error()
+	case err != nil:
+		run.Result = ResultFailed
+		run.Error = err.Error()
+	default:
+		run.Result = ResultSucceeded
+	}
+
+	r.mu.Lock()
+	state.running = false
+	state.lastRun = run
+	if run.Result != ResultSkipped {
+		state.runs++
+	}
+	if run.Result == ResultFailed {
+		state.failures++
+	}
+	if run.Result == ResultSucceeded {
+		state.lastOK = run.FinishedAt
+	}
+	r.mu.Unlock()
+
+	entry := r.logger.WithFields(logrus.Fields{
+		"job":         job.Name,
+		"trigger":     trigger,
+		"result":      run.Result,
+		"duration_ms": run.DurationMs,
+	})
+	switch run.Result {
+	case ResultFailed:
+		entry.WithError(err).Warn("백그라운드 작업 실패")
+	case ResultSkipped:
+		entry.Debug("다른 인스턴스가 실행 중이라 백그라운드 작업을 건너뜀")
+	default:
+		entry.Info("백그라운드 작업 완료")
+	}
+}
+
+// runLocked 분산 잠금을 잡고 작업을 실행합니다 (패닉은 실패로 기록)
+func (r *Runner) runLocked(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+
+	if r.guard == nil || job.Local {
+		return job.Run(ctx)
+	}
+	return r.guard.WithLock(ctx, "job:"+job.Name, job.Run)
+}
+
+// List 등록된 작업 상태 (이름순)
+func (r *Runner) List() []Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	statuses := make([]Status, 0, len(r.jobs))
+	for _, state := range r.jobs {
+		statuses = append(statuses, state.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// Get 작업 상태 조회
+func (r *Runner) Get(name string) (Status, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state, exists := r.jobs[name]
+	if !exists {
+		return Status{}, ErrJobNotFound
+	}
+	return state.status(), nil
+}
+
+func (s *jobState) status() Status {
+	status := Status{
+		Name:         s.job.Name,
+		Description:  s.job.Description,
+		Local:        s.job.Local,
+		Running:      s.running,
+		RunCount:     s.runs,
+		FailureCount: s.failures,
+	}
+	if s.job.Schedule != nil {
+		status.Schedule = s.job.Schedule.String()
+	}
+	if !s.nextRunAt.IsZero() {
+		next := s.nextRunAt
+		status.NextRunAt = &next
+	}
+	if s.lastRun != nil {
+		run := *s.lastRun
+		status.LastRun = &run
+	}
+	if !s.lastOK.IsZero() {
+		lastOK := s.lastOK
+		status.LastSuccess = &lastOK
+	}
+	return status
+}
@@ -0,0 +1,174 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/cluster"
+)
+
+func newTestRunner(guard cluster.Guard) *Runner {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewRunner(guard, logger)
+}
+
+func TestParseSchedule(t *testing.T) {
+	base := time.Date(2025, 7, 21, 10, 7, 30, 0, time.UTC) // 월요일
+
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"@every 10m", base.Add(10 * time.Minute)},
+		{"*/15 * * * *", time.Date(2025, 7, 21, 10, 15, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2025, 7, 21, 11, 0, 0, 0, time.UTC)},
+		{"30 3 * * *", time.Date(2025, 7, 22, 3, 30, 0, 0, time.UTC)},
+		{"0 9 * * 0", time.Date(2025, 7, 27, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 8-10 * * 1-5", time.Date(2025, 7, 22, 8, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.next, schedule.Next(base))
+		})
+	}
+
+	for _, spec := range []string{"", "@every 1ms", "@every soon", "* * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *"} {
+		_, err := ParseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestRunner_Register(t *testing.T) {
+	r := newTestRunner(nil)
+	run := func(ctx context.Context) error { return nil }
+
+	require.NoError(t, r.Register(Job{Name: "cleanup", Run: run}))
+	assert.Error(t, r.Register(Job{Name: "cleanup", Run: run}))
+	assert.Error(t, r.Register(Job{Name: "", Run: run}))
+	assert.Error(t, r.Register(Job{Name: "nothing"}))
+}
+
+func TestRunner_Trigger(t *testing.T) {
+	r := newTestRunner(nil)
+	release := make(chan struct{})
+	var calls atomic.Int32
+	require.NoError(t, r.Register(Job{Name: "purge", Description: "오래된 기록 정리", Run: func(ctx context.Context) error {
+		calls.Add(1)
+		<-release
+		return errors.New("boom")
+	}}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, r.Trigger(ctx, "purge"))
+	// 요청 컨텍스트가 끝나도 작업은 계속됨
+	cancel()
+	assert.ErrorIs(t, r.Trigger(context.Background(), "purge"), ErrJobRunning)
+	assert.ErrorIs(t, r.Trigger(context.Background(), "missing"), ErrJobNotFound)
+
+	status, err := r.Get("purge")
+	require.NoError(t, err)
+	assert.True(t, status.Running)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		status, _ := r.Get("purge")
+		return !status.Running
+	}, time.Second, 5*time.Millisecond)
+
+	status, err = r.Get("purge")
+	require.NoError(t, err)
+	require.NotNil(t, status.LastRun)
+	assert.Equal(t, ResultFailed, status.LastRun.Result)
+	assert.Equal(t, TriggerManual, status.LastRun.Trigger)
+	assert.Equal(t, "boom", status.LastRun.Error)
+	assert.Equal(t, int64(1), status.RunCount)
+	assert.Equal(t, int64(1), status.FailureCount)
+	assert.Nil(t, status.LastSuccess)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRunner_Schedule(t *testing.T) {
+	r := newTestRunner(nil)
+	every, err := Every(time.Second)
+	require.NoError(t, err)
+
+	ran := make(chan struct{}, 10)
+	require.NoError(t, r.Register(Job{Name: "tick", Schedule: every, Run: func(ctx context.Context) error {
+		ran <- struct{}{}
+		return nil
+	}}))
+	require.NoError(t, r.Register(Job{Name: "manual", Run: func(ctx context.Context) error { return nil }}))
+
+	r.Start(context.Background())
+	assert.True(t, r.Scheduling())
+
+	statuses := r.List()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "manual", statuses[0].Name)
+	assert.Nil(t, statuses[0].NextRunAt)
+	assert.Equal(t, "@every 1s", statuses[1].Schedule)
+
+	select {
+	case <-ran:
+	case <-time.After(3 * time.Second):
+		t.Fatal("예약 실행이 되지 않음")
+	}
+
+	r.Stop()
+	assert.False(t, r.Scheduling())
+	status, err := r.Get("tick")
+	require.NoError(t, err)
+	assert.Nil(t, status.NextRunAt)
+	require.NotNil(t, status.LastRun)
+	assert.Equal(t, TriggerSchedule, status.LastRun.Trigger)
+	assert.NotNil(t, status.LastSuccess)
+}
+
+func TestRunner_ClusterLock(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	locker := cluster.NewMemoryLocker()
+	nodeA, err := cluster.New(locker, cluster.Config{InstanceID: "a", LeaseTTL: time.Second}, logger)
+	require.NoError(t, err)
+	nodeB, err := cluster.New(locker, cluster.Config{InstanceID: "b", LeaseTTL: time.Second}, logger)
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	busy := newTestRunner(nodeA)
+	require.NoError(t, busy.Register(Job{Name: "backup", Run: func(ctx context.Context) error {
+		<-release
+		return nil
+	}}))
+	require.NoError(t, busy.Trigger(context.Background(), "backup"))
+
+	// 다른 인스턴스의 실행기는 건너뜀
+	other := newTestRunner(nodeB)
+	require.NoError(t, other.Register(Job{Name: "backup", Run: func(ctx context.Context) error { return nil }}))
+	require.Eventually(t, func() bool {
+		status, _ := busy.Get("backup")
+		return status.Running
+	}, time.Second, 5*time.Millisecond)
+	time.Sleep(20 * time.Millisecond) // 잠금을 잡을 때까지 대기
+	require.NoError(t, other.Trigger(context.Background(), "backup"))
+	require.Eventually(t, func() bool {
+		status, _ := other.Get("backup")
+		return status.LastRun != nil
+	}, time.Second, 5*time.Millisecond)
+
+	status, _ := other.Get("backup")
+	assert.Equal(t, ResultSkipped, status.LastRun.Result)
+	assert.Zero(t, status.RunCount)
+	close(release)
+}
@@ -0,0 +1,201 @@
+package jobs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 작업 실행 시각 계산
+type Schedule interface {
+	// Next after 이후 다음 실행 시각
+	Next(after time.Time) time.Time
+
+	// String 설정에 쓰는 표기
+	String() string
+}
+
+// ParseSchedule 실행 주기 표기를 해석합니다
+// "@every 10m" 같은 간격, "@hourly", "@daily", "@weekly", 또는 5필드 cron 식(분 시 일 월 요일)을 지원합니다.
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	switch spec {
+	case "":
+		return nil, fmt.Errorf("빈 실행 주기")
+	case "@hourly":
+		return ParseSchedule("0 * * * *")
+	case "@daily", "@midnight":
+		return ParseSchedule("0 0 * * *")
+	case "@weekly":
+		return ParseSchedule("0 0 * * 0")
+	}
+
+	if strings.HasPrefix(spec, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("잘못된 실행 간격: %s: %w", spec, err)
+		}
+		return Every(interval)
+	}
+
+	return parseCron(spec)
+}
+
+// Every 일정한 간격으로 실행하는 주기
+func Every(interval time.Duration) (Schedule, error) {
+	if interval < time.Second {
+		return nil, fmt.Errorf("실행 간격은 1초 이상이어야 합니다: %s", interval)
+	}
+	return intervalSchedule(interval), nil
+}
+
+type intervalSchedule time.Duration
+
+func (s intervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(s))
+}
+
+func (s intervalSchedule) String() string {
+	return "@every " + time.Duration(s).String()
+}
+
+// cronSchedule 5필드 cron 식 (각 필드는 허용 값의 비트 집합)
+type cronSchedule struct {
+	spec                          string
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+type cronField struct {
+	min, max int
+}
+
+var cronFields = []cronField{
+	{0, 59}, // 분
+	{0, 23}, // 시
+	{1, 31}, // 일
+	{1, 12}, // 월
+	{0, 6},  // 요일 (0=일요일, 7도 일요일로 취급)
+}
+
+func parseCron(spec string) (Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("cron 식은 5개 필드(분 시 일 월 요일)여야 합니다: %s", spec)
+	}
+
+	bits := make([]uint64, len(parts))
+	for i, part := range parts {
+		field := cronFields[i]
+		if i == 4 {
+			// 요일 7은 일요일
+			field.max = 7
+		}
+		value, err := parseCronField(part, field)
+		if err != nil {
+			return nil, fmt.Errorf("잘못된 cron 식: %s: %w", spec, err)
+		}
+		bits[i] = value
+	}
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &cronSchedule{
+		spec:   spec,
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		domAny: parts[2] == "*",
+		dowAny: parts[4] == "*",
+	}, nil
+}
+
+// parseCronField "*", "5", "1-5", "*/15", "0-30/10", "1,15" 형태의 필드를 해석합니다
+func parseCronField(part string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(part, ",") {
+		rangePart, step := item, 1
+		if idx := strings.Index(item, "/"); idx >= 0 {
+			n, err := strconv.Atoi(item[idx+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("잘못된 간격: %s", item)
+			}
+			rangePart, step = item[:idx], n
+		}
+
+		start, end := field.min, field.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err1, err2 error
+			start, err1 = strconv.Atoi(bounds[0])
+			end, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("잘못된 범위: %s", item)
+			}
+		default:
+			n, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("잘못된 값: %s", item)
+			}
+			start, end = n, n
+			if step > 1 {
+				end = field.max
+			}
+		}
+
+		if start < field.min || end > field.max || start > end {
+			return 0, fmt.Errorf("%d-%d 범위를 벗어남: %s", field.min, field.max, item)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Next after 이후 식에 맞는 첫 분 (5년 안에 없으면 zero time)
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches 일과 요일이 모두 지정되면 둘 중 하나만 맞아도 실행 (cron 관례)
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domAny || s.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func (s *cronSchedule) String() string {
+	return s.spec
+}
@@ -0,0 +1,376 @@
+// Package kubernetes Claude 태스크를 Kubernetes Job으로 실행하는 러너
+//
+// client-go 의존성 없이 Kubernetes REST API를 직접 호출합니다.
+// 클러스터 안에서는 서비스 어카운트 토큰과 CA 인증서를 사용하고,
+// 클러스터 밖에서는 API 서버 주소와 토큰을 설정으로 지정합니다.
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// 클러스터 안에서 실행될 때 서비스 어카운트 자격 증명 경로
+const (
+	inClusterTokenFile     = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	inClusterCAFile        = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	inClusterNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// ClientConfig API 서버 연결 설정
+type ClientConfig struct {
+	// APIServer API 서버 주소 (비어 있으면 클러스터 내부 설정 사용)
+	APIServer string
+	// Token 베어러 토큰
+	Token string
+	// TokenFile 베어러 토큰 파일 (요청마다 다시 읽으므로 토큰 교체를 따라감)
+	TokenFile string
+	// CAFile API 서버 CA 인증서
+	CAFile string
+	// Insecure TLS 인증서 검증 생략 (개발용)
+	Insecure bool
+	// Timeout 로그 스트리밍을 제외한 요청 하나의 최대 시간
+	Timeout time.Duration
+}
+
+// InClusterConfig 파드 안에서 실행될 때의 연결 설정
+func InClusterConfig() (ClientConfig, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return ClientConfig{}, errors.New("kubernetes: not running in a cluster (KUBERNETES_SERVICE_HOST is not set)")
+	}
+	return ClientConfig{
+		APIServer: "https://" + net.JoinHostPort(host, port),
+		TokenFile: inClusterTokenFile,
+		CAFile:    inClusterCAFile,
+	}, nil
+}
+
+// InClusterNamespace 파드가 실행 중인 네임스페이스 (클러스터 밖이면 빈 문자열)
+func InClusterNamespace() string {
+	data, err := os.ReadFile(inClusterNamespaceFile)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// APIError API 서버가 돌려준 오류
+type APIError struct {
+	StatusCode int
+	Reason     string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("kubernetes: %s (%d): %s", e.Reason, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("kubernetes: unexpected status %d", e.StatusCode)
+}
+
+// IsNotFound 오브젝트가 없어서 실패했는지 확인합니다.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsAlreadyExists 같은 이름의 오브젝트가 이미 있어서 실패했는지 확인합니다.
+func IsAlreadyExists(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict && apiErr.Reason == "AlreadyExists"
+}
+
+// Client 러너에 필요한 API만 구현한 최소 Kubernetes REST 클라이언트
+type Client struct {
+	baseURL   *url.URL
+	token     string
+	tokenFile string
+	timeout   time.Duration
+	http      *http.Client
+}
+
+// NewClient 새 클라이언트 생성
+func NewClient(cfg ClientConfig) (*Client, error) {
+	if cfg.APIServer == "" {
+		inCluster, err := InClusterConfig()
+		if err != nil {
+			return nil, err
+		}
+		if cfg.Token == "" && cfg.TokenFile == "" {
+			cfg.TokenFile = inCluster.TokenFile
+		}
+		if cfg.CAFile == "" {
+			cfg.CAFile = inCluster.CAFile
+		}
+		cfg.APIServer = inCluster.APIServer
+	}
+
+	baseURL, err := url.Parse(strings.TrimSuffix(cfg.APIServer, "/"))
+	if err != nil || baseURL.Host == "" {
+		return nil, fmt.Errorf("kubernetes: invalid api server address %q", cfg.APIServer)
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.Insecure}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("kubernetes: read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("kubernetes: no certificates in %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+
+	return &Client{
+		baseURL:   baseURL,
+		token:     cfg.Token,
+		tokenFile: cfg.TokenFile,
+		timeout:   cfg.Timeout,
+		http: &http.Client{
+			// 로그 스트리밍은 태스크가 끝날 때까지 이어지므로 클라이언트 전체 타임아웃은 두지 않음
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				TLSClientConfig:     tlsConfig,
+				TLSHandshakeTimeout: 10 * time.Second,
+				MaxIdleConnsPerHost: 10,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+	}, nil
+}
+
+// CreateJob Job 생성
+func (c *Client) CreateJob(ctx context.Context, namespace string, job *Job) (*Job, error) {
+	job.APIVersion, job.Kind = "batch/v1", "Job"
+	var created Job
+	if err := c.do(ctx, http.MethodPost, jobsPath(namespace, ""), nil, job, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// GetJob Job 조회
+func (c *Client) GetJob(ctx context.Context, namespace, name string) (*Job, error) {
+	var job Job
+	if err := c.do(ctx, http.MethodGet, jobsPath(namespace, name), nil, nil, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// DeleteJob Job과 그 파드를 삭제합니다 (없으면 무시)
+func (c *Client) DeleteJob(ctx context.Context, namespace, name string) error {
+	body := map[string]interface{}{
+		"apiVersion":        "v1",
+		"kind":              "DeleteOptions",
+		"propagationPolicy": "Background",
+	}
+	err := c.do(ctx, http.MethodDelete, jobsPath(namespace, name), nil, body, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// ListPods 레이블 셀렉터에 맞는 파드 목록
+func (c *Client) ListPods(ctx context.Context, namespace, labelSelector string) ([]Pod, error) {
+	query := url.Values{}
+	if labelSelector != "" {
+		query.Set("labelSelector", labelSelector)
+	}
+	var list PodList
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/api/v1/namespaces/%s/pods", url.PathEscape(namespace)), query, nil, &list); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// StreamPodLogs 컨테이너 로그를 스트리밍합니다 (follow가 true면 컨테이너가 끝날 때까지 이어짐)
+func (c *Client) StreamPodLogs(ctx context.Context, namespace, pod, container string, follow bool) (io.ReadCloser, error) {
+	query := url.Values{}
+	if container != "" {
+		query.Set("container", container)
+	}
+	if follow {
+		query.Set("follow", "true")
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log", url.PathEscape(namespace), url.PathEscape(pod)), query, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("kubernetes: stream logs: %w", err)
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, decodeError(resp)
+	}
+	return resp.Body, nil
+}
+
+// CreateSecret 시크릿 생성
+func (c *Client) CreateSecret(ctx context.Context, namespace string, secret *Secret) (*Secret, error) {
+	secret.APIVersion, secret.Kind = "v1", "Secret"
+	var created Secret
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/api/v1/namespaces/%s/secrets", url.PathEscape(namespace)), nil, secret, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// DeleteSecret 시크릿 삭제 (없으면 무시)
+func (c *Client) DeleteSecret(ctx context.Context, namespace, name string) error {
+	err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/namespaces/%s/secrets/%s", url.PathEscape(namespace), url.PathEscape(name)), nil, nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// GetResourceQuota 리소스 쿼터 조회
+func (c *Client) GetResourceQuota(ctx context.Context, namespace, name string) (*ResourceQuota, error) {
+	var quota ResourceQuota
+	if err := c.do(ctx, http.MethodGet, quotasPath(namespace, name), nil, nil, &quota); err != nil {
+		return nil, err
+	}
+	return &quota, nil
+}
+
+// ApplyResourceQuota 리소스 쿼터를 만들거나 상한을 갱신합니다.
+func (c *Client) ApplyResourceQuota(ctx context.Context, namespace string, quota *ResourceQuota) (*ResourceQuota, error) {
+	quota.APIVersion, quota.Kind = "v1", "ResourceQuota"
+
+	existing, err := c.GetResourceQuota(ctx, namespace, quota.Metadata.Name)
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	var applied ResourceQuota
+	if existing == nil {
+		err = c.do(ctx, http.MethodPost, quotasPath(namespace, ""), nil, quota, &applied)
+	} else {
+		quota.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+		err = c.do(ctx, http.MethodPut, quotasPath(namespace, quota.Metadata.Name), nil, quota, &applied)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &applied, nil
+}
+
+// do JSON 요청을 보내고 응답을 out에 디코딩합니다.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := c.newRequest(ctx, method, path, query, in)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("kubernetes: %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return decodeError(resp)
+	}
+	if out == nil {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("kubernetes: decode %s response: %w", path, err)
+	}
+	return nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, in interface{}) (*http.Request, error) {
+	u := *c.baseURL
+	u.Path = c.baseURL.Path + path
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	token := c.token
+	if c.tokenFile != "" {
+		data, err := os.ReadFile(c.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("kubernetes: read token file: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req, nil
+}
+
+// decodeError API 서버 오류 응답을 APIError로 변환합니다.
+func decodeError(resp *http.Response) error {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	var status Status
+	if json.Unmarshal(data, &status) == nil && (status.Message != "" || status.Reason != "") {
+		apiErr.Reason = status.Reason
+		apiErr.Message = status.Message
+	} else {
+		apiErr.Reason = http.StatusText(resp.StatusCode)
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+	return apiErr
+}
+
+func jobsPath(namespace, name string) string {
+	path := fmt.Sprintf("/apis/batch/v1/namespaces/%s/jobs", url.PathEscape(namespace))
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return path
+}
+
+func quotasPath(namespace, name string) string {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/resourcequotas", url.PathEscape(namespace))
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return path
+}
@@ -0,0 +1,585 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+// 레이블 키
+const (
+	LabelManagedBy   = "app.kubernetes.io/managed-by"
+	LabelTaskID      = "aicli.io/task-id"
+	LabelWorkspaceID = "aicli.io/workspace-id"
+	LabelJob         = "aicli.io/job"
+
+	managedByValue = "aicli"
+)
+
+// 러너 기본값
+const (
+	DefaultNamespace        = "default"
+	DefaultClaimNameFormat  = "aicli-workspace-%s"
+	DefaultMountPath        = "/workspace"
+	DefaultQuotaName        = "aicli-tasks"
+	DefaultStartTimeout     = 5 * time.Minute
+	DefaultPollInterval     = 2 * time.Second
+	DefaultTTLAfterFinished = 10 * time.Minute
+
+	taskContainerName = "task"
+	secretMountPath   = "/etc/aicli"
+	mcpConfigKey      = "mcp.json"
+	oauthTokenKey     = "oauth-token"
+	apiKeyKey         = "api-key"
+)
+
+// 파드가 시작되지 못하고 계속 대기할 컨테이너 대기 사유
+var fatalWaitingReasons = map[string]bool{
+	"ErrImagePull":         true,
+	"ImagePullBackOff":     true,
+	"InvalidImageName":     true,
+	"CreateContainerError": true,
+}
+
+// QuotaConfig 태스크 네임스페이스에 적용할 클러스터 수준 리소스 쿼터
+type QuotaConfig struct {
+	// Name 리소스 쿼터 이름
+	Name string
+	// Hard 리소스별 상한 ("limits.cpu": "16", "limits.memory": "64Gi", "pods": "20")
+	Hard map[string]string
+}
+
+// RunnerConfig 러너 설정
+type RunnerConfig struct {
+	// Namespace 태스크 Job을 만들 네임스페이스
+	Namespace string
+	// Image 태스크 컨테이너 이미지 (Claude CLI가 설치된 워크스페이스 이미지)
+	Image string
+	// ImagePullPolicy 이미지 풀 정책 (Always, IfNotPresent, Never)
+	ImagePullPolicy string
+	// ServiceAccount 태스크 파드 서비스 어카운트 (비어 있으면 토큰을 마운트하지 않음)
+	ServiceAccount string
+	// ClaimNameFormat 워크스페이스 PVC 이름 형식 (%s는 워크스페이스 ID)
+	ClaimNameFormat string
+	// MountPath 워크스페이스 볼륨 마운트 경로 (태스크 작업 디렉토리)
+	MountPath string
+	// DefaultLimits 프로세스 설정에 리소스 제한이 없을 때 적용할 제한 ("cpu": "1", "memory": "2Gi")
+	DefaultLimits map[string]string
+	// NodeSelector 태스크 파드 노드 셀렉터
+	NodeSelector map[string]string
+	// StartTimeout 파드가 실행되기까지 기다릴 최대 시간
+	StartTimeout time.Duration
+	// PollInterval 파드와 Job 상태 확인 주기
+	PollInterval time.Duration
+	// TTLAfterFinished 러너가 정리하지 못한 Job을 클러스터가 삭제하기까지의 시간
+	TTLAfterFinished time.Duration
+	// Quota 네임스페이스 리소스 쿼터 (nil이면 관리하지 않음)
+	Quota *QuotaConfig
+}
+
+// RunRequest 태스크 하나의 실행 요청
+type RunRequest struct {
+	// TaskID 태스크 ID (Job 이름에 사용)
+	TaskID string
+	// WorkspaceID 워크스페이스 ID (비어 있으면 워크스페이스 볼륨을 마운트하지 않음)
+	WorkspaceID string
+	// Process 로컬 실행과 같은 형식의 프로세스 설정
+	Process *claude.ProcessConfig
+	// Stream 파드 로그를 파싱할 스트림 핸들러 (nil이면 파싱하지 않음)
+	Stream claude.StreamHandler
+	// OnMessage 파싱된 메시지 콜백
+	OnMessage claude.MessageCallback
+	// Output 원본 로그를 받을 Writer (nil이면 버림)
+	Output io.Writer
+}
+
+// RunResult 태스크 실행 결과
+type RunResult struct {
+	JobName  string
+	PodName  string
+	ExitCode int
+	Reason   string
+}
+
+// Runner 태스크를 Kubernetes Job으로 실행합니다.
+// 각 태스크는 재시도 없는 Job 하나가 되며, 파드는 워크스페이스 PVC를 마운트하고
+// 컨테이너 로그를 기존 스트림 핸들러로 흘려보냅니다.
+type Runner struct {
+	client *Client
+	config RunnerConfig
+	logger *logrus.Logger
+
+	quotaMu      sync.Mutex
+	quotaApplied bool
+}
+
+// NewRunner 새 러너 생성
+func NewRunner(client *Client, config RunnerConfig, logger *logrus.Logger) (*Runner, error) {
+	if client == nil {
+		return nil, errors.New("kubernetes: client is required")
+	}
+	if config.Image == "" {
+		return nil, errors.New("kubernetes: task image is required")
+	}
+	if config.Namespace == "" {
+		config.Namespace = DefaultNamespace
+	}
+	if config.ClaimNameFormat == "" {
+		config.ClaimNameFormat = DefaultClaimNameFormat
+	}
+	if config.MountPath == "" {
+		config.MountPath = DefaultMountPath
+	}
+	if config.StartTimeout <= 0 {
+		config.StartTimeout = DefaultStartTimeout
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+	if config.TTLAfterFinished <= 0 {
+		config.TTLAfterFinished = DefaultTTLAfterFinished
+	}
+	if config.Quota != nil && config.Quota.Name == "" {
+		config.Quota.Name = DefaultQuotaName
+	}
+	if logger == nil {
+		logger = logrus.New()
+	}
+	return &Runner{client: client, config: config, logger: logger}, nil
+}
+
+// Namespace 태스크 Job 네임스페이스
+func (r *Runner) Namespace() string {
+	return r.config.Namespace
+}
+
+// EnsureQuota 설정된 리소스 쿼터를 네임스페이스에 적용합니다.
+// 한 번 성공하면 다시 적용하지 않으며, 실패하면 다음 실행 때 다시 시도합니다.
+func (r *Runner) EnsureQuota(ctx context.Context) error {
+	if r.config.Quota == nil || len(r.config.Quota.Hard) == 0 {
+		return nil
+	}
+
+	r.quotaMu.Lock()
+	defer r.quotaMu.Unlock()
+	if r.quotaApplied {
+		return nil
+	}
+
+	quota := &ResourceQuota{
+		Metadata: ObjectMeta{
+			Name:   r.config.Quota.Name,
+			Labels: map[string]string{LabelManagedBy: managedByValue},
+		},
+		Spec: ResourceQuotaSpec{Hard: r.config.Quota.Hard},
+	}
+	if _, err := r.client.ApplyResourceQuota(ctx, r.config.Namespace, quota); err != nil {
+		return fmt.Errorf("리소스 쿼터 적용 실패: %w", err)
+	}
+	r.quotaApplied = true
+	return nil
+}
+
+// QuotaStatus 관리 중인 리소스 쿼터의 상한과 사용량
+func (r *Runner) QuotaStatus(ctx context.Context) (*ResourceQuotaStatus, error) {
+	if r.config.Quota == nil {
+		return nil, nil
+	}
+	quota, err := r.client.GetResourceQuota(ctx, r.config.Namespace, r.config.Quota.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &quota.Status, nil
+}
+
+// Run 태스크 Job을 만들고, 로그를 스트리밍하고, 종료를 기다린 뒤 Job을 삭제합니다.
+// 컨텍스트가 취소되면 Job을 삭제해 파드를 종료합니다.
+func (r *Runner) Run(ctx context.Context, req RunRequest) (*RunResult, error) {
+	if err := r.EnsureQuota(ctx); err != nil {
+		return nil, err
+	}
+
+	job, secret, err := r.BuildJob(req)
+	if err != nil {
+		return nil, err
+	}
+	namespace := r.config.Namespace
+	result := &RunResult{JobName: job.Metadata.Name}
+
+	created, err := r.client.CreateJob(ctx, namespace, job)
+	if err != nil {
+		return nil, fmt.Errorf("태스크 Job 생성 실패: %w", err)
+	}
+	defer r.cleanup(created.Metadata.Name, secret != nil)
+
+	if secret != nil {
+		// Job이 먼저 삭제되더라도 가비지 컬렉터가 시크릿을 정리하도록 소유자로 지정
+		secret.Metadata.OwnerReferences = []OwnerReference{{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+			Name:       created.Metadata.Name,
+			UID:        created.Metadata.UID,
+		}}
+		if _, err := r.client.CreateSecret(ctx, namespace, secret); err != nil {
+			return result, fmt.Errorf("태스크 시크릿 생성 실패: %w", err)
+		}
+	}
+
+	pod, err := r.waitForPodStart(ctx, created.Metadata.Name)
+	if err != nil {
+		return result, err
+	}
+	result.PodName = pod.Metadata.Name
+
+	logs, err := r.client.StreamPodLogs(ctx, namespace, pod.Metadata.Name, taskContainerName, true)
+	if err != nil {
+		return result, fmt.Errorf("파드 로그 스트리밍 실패: %w", err)
+	}
+	r.streamLogs(ctx, logs, req)
+	logs.Close()
+
+	terminated, err := r.waitForCompletion(ctx, created.Metadata.Name)
+	if err != nil {
+		return result, err
+	}
+	result.ExitCode = int(terminated.ExitCode)
+	result.Reason = terminated.Reason
+
+	if terminated.ExitCode != 0 || terminated.Reason == "DeadlineExceeded" {
+		return result, fmt.Errorf("태스크 Job %s 실패 (종료 코드 %d, %s)", result.JobName, terminated.ExitCode, terminated.Reason)
+	}
+	return result, nil
+}
+
+// BuildJob 프로세스 설정을 태스크 Job과 자격 증명 시크릿으로 변환합니다.
+//   - Command/Args → 컨테이너 command/args (MCP 서버는 시크릿 파일과 --mcp-config로 전달)
+//   - Environment → 컨테이너 env, OAuthToken/APIKey → 시크릿 참조 env
+//   - ResourceLimits.MaxCPU/MaxMemory → 컨테이너 limits와 requests
+//   - Timeout, ResourceLimits.Timeout → activeDeadlineSeconds (둘 중 짧은 값)
+//   - WorkingDir → 상대 경로면 워크스페이스 마운트 아래, 아니면 마운트 경로
+//
+// 재시작과 멈춤 감지 정책은 로컬 프로세스 전용이라 적용되지 않습니다 (backoffLimit 0).
+// 자격 증명이 없으면 시크릿은 nil입니다.
+func (r *Runner) BuildJob(req RunRequest) (*Job, *Secret, error) {
+	if req.TaskID == "" {
+		return nil, nil, errors.New("kubernetes: task id is required")
+	}
+	p := req.Process
+	if p == nil || p.Command == "" {
+		return nil, nil, errors.New("kubernetes: process command is required")
+	}
+
+	name := jobName(req.TaskID)
+	labels := map[string]string{
+		LabelManagedBy: managedByValue,
+		LabelTaskID:    labelValue(req.TaskID),
+		LabelJob:       name,
+	}
+	if req.WorkspaceID != "" {
+		labels[LabelWorkspaceID] = labelValue(req.WorkspaceID)
+	}
+
+	container := Container{
+		Name:            taskContainerName,
+		Image:           r.config.Image,
+		ImagePullPolicy: r.config.ImagePullPolicy,
+		Command:         []string{p.Command},
+		Args:            append([]string{}, p.Args...),
+		WorkingDir:      r.workingDir(p.WorkingDir),
+		Resources:       r.resources(p.ResourceLimits),
+		SecurityContext: &SecurityContext{
+			AllowPrivilegeEscalation: boolPtr(false),
+			Capabilities:             &Capabilities{Drop: []string{"ALL"}},
+		},
+	}
+
+	keys := make([]string, 0, len(p.Environment))
+	for key := range p.Environment {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		container.Env = append(container.Env, EnvVar{Name: key, Value: p.Environment[key]})
+	}
+
+	var volumes []Volume
+	if req.WorkspaceID != "" {
+		volumes = append(volumes, Volume{
+			Name: "workspace",
+			PersistentVolumeClaim: &PersistentVolumeClaimVolumeSource{
+				ClaimName: fmt.Sprintf(r.config.ClaimNameFormat, req.WorkspaceID),
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, VolumeMount{Name: "workspace", MountPath: r.config.MountPath})
+	}
+
+	// 토큰과 MCP 설정은 Job 스펙에 그대로 남지 않도록 시크릿으로 전달
+	secretData := make(map[string]string)
+	if p.OAuthToken != "" {
+		secretData[oauthTokenKey] = p.OAuthToken
+		container.Env = append(container.Env, secretEnv("CLAUDE_CODE_OAUTH_TOKEN", name, oauthTokenKey))
+	} else if p.APIKey != "" {
+		secretData[apiKeyKey] = p.APIKey
+		container.Env = append(container.Env, secretEnv("CLAUDE_API_KEY", name, apiKeyKey))
+	}
+	if len(p.MCPServers) > 0 {
+		data, err := claude.MarshalMCPConfig(p.MCPServers)
+		if err != nil {
+			return nil, nil, err
+		}
+		secretData[mcpConfigKey] = string(data)
+		volumes = append(volumes, Volume{
+			Name: "aicli-config",
+			Secret: &SecretVolumeSource{
+				SecretName:  name,
+				Items:       []KeyToPath{{Key: mcpConfigKey, Path: mcpConfigKey}},
+				DefaultMode: int32Ptr(0400),
+			},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, VolumeMount{Name: "aicli-config", MountPath: secretMountPath, ReadOnly: true})
+		container.Args = append(container.Args, "--mcp-config", path.Join(secretMountPath, mcpConfigKey))
+	}
+
+	podSpec := PodSpec{
+		RestartPolicy:                "Never",
+		ServiceAccountName:           r.config.ServiceAccount,
+		AutomountServiceAccountToken: boolPtr(r.config.ServiceAccount != ""),
+		NodeSelector:                 r.config.NodeSelector,
+		Containers:                   []Container{container},
+		Volumes:                      volumes,
+	}
+
+	job := &Job{
+		Metadata: ObjectMeta{
+			Name:      name,
+			Namespace: r.config.Namespace,
+			Labels:    labels,
+		},
+		Spec: JobSpec{
+			BackoffLimit:            int32Ptr(0),
+			TTLSecondsAfterFinished: int32Ptr(int32(r.config.TTLAfterFinished / time.Second)),
+			Template: PodTemplateSpec{
+				Metadata: ObjectMeta{Labels: labels},
+				Spec:     podSpec,
+			},
+		},
+	}
+	if deadline := processDeadline(p); deadline > 0 {
+		seconds := int64(math.Ceil(deadline.Seconds()))
+		job.Spec.ActiveDeadlineSeconds = &seconds
+	}
+
+	var secret *Secret
+	if len(secretData) > 0 {
+		secret = &Secret{
+			Metadata: ObjectMeta{
+				Name:      name,
+				Namespace: r.config.Namespace,
+				Labels:    labels,
+			},
+			Type:       "Opaque",
+			StringData: secretData,
+		}
+	}
+	return job, secret, nil
+}
+
+// waitForPodStart Job의 파드가 실행을 시작(또는 바로 종료)할 때까지 기다립니다.
+func (r *Runner) waitForPodStart(ctx context.Context, name string) (*Pod, error) {
+	timer := time.NewTimer(r.config.StartTimeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		pods, err := r.client.ListPods(ctx, r.config.Namespace, LabelJob+"="+name)
+		if err != nil {
+			return nil, fmt.Errorf("태스크 파드 조회 실패: %w", err)
+		}
+		for i := range pods {
+			pod := &pods[i]
+			switch pod.Status.Phase {
+			case PodRunning, PodSucceeded, PodFailed:
+				return pod, nil
+			}
+			if waiting := taskContainerState(pod).Waiting; waiting != nil && fatalWaitingReasons[waiting.Reason] {
+				return nil, fmt.Errorf("태스크 파드를 시작할 수 없습니다: %s: %s", waiting.Reason, waiting.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, fmt.Errorf("태스크 파드가 %s 안에 시작되지 않았습니다 (리소스 쿼터 초과 또는 스케줄링 대기)", r.config.StartTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// streamLogs 파드 로그를 스트림 핸들러로 파싱하면서 원본을 Output에 기록합니다.
+func (r *Runner) streamLogs(ctx context.Context, logs io.Reader, req RunRequest) {
+	reader := logs
+	if req.Output != nil {
+		reader = io.TeeReader(logs, req.Output)
+	}
+	if req.Stream != nil {
+		if err := req.Stream.StreamWithCallback(ctx, reader, req.OnMessage); err != nil {
+			r.logger.WithError(err).WithField("task_id", req.TaskID).Warn("태스크 로그 파싱 실패")
+		}
+	}
+	// 파서가 형식이 다른 줄에서 멈춰도 남은 로그를 끝까지 기록
+	io.Copy(io.Discard, reader)
+}
+
+// waitForCompletion 태스크 컨테이너가 종료될 때까지 기다리고 종료 상태를 반환합니다.
+func (r *Runner) waitForCompletion(ctx context.Context, name string) (*ContainerStateTerminated, error) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		pods, err := r.client.ListPods(ctx, r.config.Namespace, LabelJob+"="+name)
+		if err != nil {
+			return nil, fmt.Errorf("태스크 파드 조회 실패: %w", err)
+		}
+		for i := range pods {
+			if terminated := taskContainerState(&pods[i]).Terminated; terminated != nil {
+				return terminated, nil
+			}
+		}
+
+		// 시간 제한을 넘기면 파드가 삭제되어 종료 상태를 읽을 수 없으므로 Job 조건을 확인
+		job, err := r.client.GetJob(ctx, r.config.Namespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("태스크 Job 조회 실패: %w", err)
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Type == "Failed" && cond.Status == "True" {
+				return &ContainerStateTerminated{ExitCode: -1, Reason: cond.Reason, Message: cond.Message}, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// cleanup 태스크 Job과 시크릿을 삭제합니다 (요청 컨텍스트가 취소되어도 실행)
+func (r *Runner) cleanup(name string, hasSecret bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := r.client.DeleteJob(ctx, r.config.Namespace, name); err != nil {
+		r.logger.WithError(err).WithField("job", name).Warn("태스크 Job 삭제 실패")
+	}
+	if hasSecret {
+		if err := r.client.DeleteSecret(ctx, r.config.Namespace, name); err != nil {
+			r.logger.WithError(err).WithField("job", name).Warn("태스크 시크릿 삭제 실패")
+		}
+	}
+}
+
+// workingDir 컨테이너 작업 디렉토리 (호스트 절대 경로는 파드 안에서 의미가 없으므로 마운트 경로 사용)
+func (r *Runner) workingDir(dir string) string {
+	if dir == "" || path.IsAbs(dir) {
+		return r.config.MountPath
+	}
+	joined := path.Join(r.config.MountPath, dir)
+	if joined != r.config.MountPath && !strings.HasPrefix(joined, r.config.MountPath+"/") {
+		return r.config.MountPath
+	}
+	return joined
+}
+
+// resources 리소스 제한을 컨테이너 limits/requests로 변환합니다.
+// 쿼터가 requests.*와 limits.*를 모두 제한할 수 있도록 requests는 limits와 같게 둡니다.
+func (r *Runner) resources(limits *claude.ResourceLimits) ResourceRequirements {
+	values := make(map[string]string, len(r.config.DefaultLimits))
+	for name, value := range r.config.DefaultLimits {
+		values[name] = value
+	}
+	if limits != nil {
+		if limits.MaxCPU > 0 {
+			values["cpu"] = fmt.Sprintf("%dm", int64(math.Ceil(limits.MaxCPU*1000)))
+		}
+		if limits.MaxMemory > 0 {
+			values["memory"] = strconv.FormatInt(limits.MaxMemory, 10)
+		}
+	}
+	if len(values) == 0 {
+		return ResourceRequirements{}
+	}
+
+	requests := make(map[string]string, len(values))
+	for name, value := range values {
+		requests[name] = value
+	}
+	return ResourceRequirements{Limits: values, Requests: requests}
+}
+
+// processDeadline 프로세스 타임아웃과 리소스 제한 타임아웃 중 짧은 값
+func processDeadline(p *claude.ProcessConfig) time.Duration {
+	deadline := p.Timeout
+	if p.ResourceLimits != nil && p.ResourceLimits.Timeout > 0 && (deadline <= 0 || p.ResourceLimits.Timeout < deadline) {
+		deadline = p.ResourceLimits.Timeout
+	}
+	return deadline
+}
+
+// taskContainerState 파드에서 태스크 컨테이너의 상태
+func taskContainerState(pod *Pod) ContainerState {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == taskContainerName {
+			return status.State
+		}
+	}
+	return ContainerState{}
+}
+
+// jobName 태스크 ID로 DNS-1123 형식의 Job 이름을 만듭니다.
+func jobName(taskID string) string {
+	name := "aicli-task-" + labelValue(taskID)
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// labelValue 레이블 값으로 쓸 수 있게 문자열을 정리합니다 (소문자 영숫자와 -, 최대 63자)
+func labelValue(value string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	s := b.String()
+	if len(s) > 63 {
+		s = s[:63]
+	}
+	return strings.Trim(s, "-")
+}
+
+func secretEnv(name, secretName, key string) EnvVar {
+	return EnvVar{Name: name, ValueFrom: &EnvVarSource{SecretKeyRef: &SecretKeySelector{Name: secretName, Key: key}}}
+}
+
+func boolPtr(v bool) *bool    { return &v }
+func int32Ptr(v int32) *int32 { return &v }
@@ -0,0 +1,309 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+// fakeAPIServer 러너가 호출하는 API만 흉내 내는 테스트용 API 서버
+type fakeAPIServer struct {
+	mu       sync.Mutex
+	jobs     map[string]*Job
+	secrets  map[string]*Secret
+	quotas   map[string]*ResourceQuota
+	logs     string
+	exitCode int32
+	requests []string
+}
+
+func newFakeAPIServer(t *testing.T) (*fakeAPIServer, *Client) {
+	fake := &fakeAPIServer{
+		jobs:    make(map[string]*Job),
+		secrets: make(map[string]*Secret),
+		quotas:  make(map[string]*ResourceQuota),
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(ClientConfig{APIServer: server.URL, Token: "test-token"})
+	require.NoError(t, err)
+	return fake, client
+}
+
+func (f *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+
+	if r.Header.Get("Authorization") != "Bearer test-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	resource, name := parts[len(parts)-1], ""
+	if len(parts) >= 2 && (parts[len(parts)-2] == "jobs" || parts[len(parts)-2] == "secrets" || parts[len(parts)-2] == "resourcequotas") {
+		resource, name = parts[len(parts)-2], parts[len(parts)-1]
+	}
+
+	switch {
+	case resource == "jobs" && r.Method == http.MethodPost:
+		var job Job
+		json.NewDecoder(r.Body).Decode(&job)
+		job.Metadata.UID = "uid-" + job.Metadata.Name
+		f.jobs[job.Metadata.Name] = &job
+		json.NewEncoder(w).Encode(job)
+	case resource == "jobs" && r.Method == http.MethodGet:
+		job, ok := f.jobs[name]
+		if !ok {
+			notFound(w)
+			return
+		}
+		json.NewEncoder(w).Encode(job)
+	case resource == "jobs" && r.Method == http.MethodDelete:
+		delete(f.jobs, name)
+		w.Write([]byte("{}"))
+	case resource == "secrets" && r.Method == http.MethodPost:
+		var secret Secret
+		json.NewDecoder(r.Body).Decode(&secret)
+		f.secrets[secret.Metadata.Name] = &secret
+		json.NewEncoder(w).Encode(secret)
+	case resource == "secrets" && r.Method == http.MethodDelete:
+		delete(f.secrets, name)
+		w.Write([]byte("{}"))
+	case resource == "resourcequotas" && r.Method == http.MethodGet:
+		quota, ok := f.quotas[name]
+		if !ok {
+			notFound(w)
+			return
+		}
+		json.NewEncoder(w).Encode(quota)
+	case resource == "resourcequotas" && (r.Method == http.MethodPost || r.Method == http.MethodPut):
+		var quota ResourceQuota
+		json.NewDecoder(r.Body).Decode(&quota)
+		quota.Metadata.ResourceVersion = fmt.Sprint(len(f.requests))
+		f.quotas[quota.Metadata.Name] = &quota
+		json.NewEncoder(w).Encode(quota)
+	case resource == "pods" && r.Method == http.MethodGet:
+		selector := r.URL.Query().Get("labelSelector")
+		var list PodList
+		for jobName := range f.jobs {
+			if selector != LabelJob+"="+jobName {
+				continue
+			}
+			list.Items = append(list.Items, Pod{
+				Metadata: ObjectMeta{Name: jobName + "-pod"},
+				Status: PodStatus{
+					Phase: PodSucceeded,
+					ContainerStatuses: []ContainerStatus{{
+						Name:  taskContainerName,
+						State: ContainerState{Terminated: &ContainerStateTerminated{ExitCode: f.exitCode}},
+					}},
+				},
+			})
+		}
+		json.NewEncoder(w).Encode(list)
+	case resource == "log" && r.Method == http.MethodGet:
+		w.Write([]byte(f.logs))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func notFound(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNotFound)
+	json.NewEncoder(w).Encode(Status{Reason: "NotFound", Message: "not found", Code: http.StatusNotFound})
+}
+
+func newTestRunner(t *testing.T, client *Client, quota *QuotaConfig) *Runner {
+	logger := logrus.New()
+	logger.SetOutput(&bytes.Buffer{})
+	runner, err := NewRunner(client, RunnerConfig{
+		Namespace:     "aicli",
+		Image:         "aicli/workspace:latest",
+		DefaultLimits: map[string]string{"cpu": "1", "memory": "1Gi"},
+		PollInterval:  10 * time.Millisecond,
+		StartTimeout:  time.Second,
+		Quota:         quota,
+	}, logger)
+	require.NoError(t, err)
+	return runner
+}
+
+func TestRunner_BuildJob(t *testing.T) {
+	_, client := newFakeAPIServer(t)
+	runner := newTestRunner(t, client, nil)
+
+	job, secret, err := runner.BuildJob(RunRequest{
+		TaskID:      "Task_42",
+		WorkspaceID: "ws-1",
+		Process: &claude.ProcessConfig{
+			Command:     "claude",
+			Args:        []string{"-p", "hello"},
+			WorkingDir:  "src",
+			Environment: map[string]string{"B": "2", "A": "1"},
+			Timeout:     10 * time.Minute,
+			OAuthToken:  "secret-token",
+			ResourceLimits: &claude.ResourceLimits{
+				MaxCPU:    1.5,
+				MaxMemory: 512 << 20,
+				Timeout:   90 * time.Second,
+			},
+			MCPServers: map[string]claude.MCPServerConfig{
+				"files": {Command: "mcp-files"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "aicli-task-task-42", job.Metadata.Name)
+	assert.Equal(t, "ws-1", job.Metadata.Labels[LabelWorkspaceID])
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	assert.Equal(t, int64(90), *job.Spec.ActiveDeadlineSeconds)
+
+	pod := job.Spec.Template.Spec
+	assert.Equal(t, "Never", pod.RestartPolicy)
+	require.Len(t, pod.Containers, 1)
+	container := pod.Containers[0]
+	assert.Equal(t, []string{"claude"}, container.Command)
+	assert.Equal(t, []string{"-p", "hello", "--mcp-config", "/etc/aicli/mcp.json"}, container.Args)
+	assert.Equal(t, "/workspace/src", container.WorkingDir)
+	assert.Equal(t, map[string]string{"cpu": "1500m", "memory": "536870912"}, container.Resources.Limits)
+	assert.Equal(t, container.Resources.Limits, container.Resources.Requests)
+
+	require.Len(t, container.Env, 3)
+	assert.Equal(t, "A", container.Env[0].Name)
+	assert.Equal(t, "B", container.Env[1].Name)
+	assert.Equal(t, "CLAUDE_CODE_OAUTH_TOKEN", container.Env[2].Name)
+	assert.Empty(t, container.Env[2].Value, "토큰은 시크릿 참조로만 전달")
+	assert.Equal(t, job.Metadata.Name, container.Env[2].ValueFrom.SecretKeyRef.Name)
+
+	require.Len(t, pod.Volumes, 2)
+	assert.Equal(t, "aicli-workspace-ws-1", pod.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "/workspace", container.VolumeMounts[0].MountPath)
+
+	require.NotNil(t, secret)
+	assert.Equal(t, "secret-token", secret.StringData[oauthTokenKey])
+	assert.Contains(t, secret.StringData[mcpConfigKey], "mcp-files")
+}
+
+func TestRunner_BuildJobDefaults(t *testing.T) {
+	_, client := newFakeAPIServer(t)
+	runner := newTestRunner(t, client, nil)
+
+	job, secret, err := runner.BuildJob(RunRequest{
+		TaskID:  "t1",
+		Process: &claude.ProcessConfig{Command: "git", Args: []string{"status"}, WorkingDir: "/home/user/project"},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, secret)
+	assert.Nil(t, job.Spec.ActiveDeadlineSeconds)
+	assert.Empty(t, job.Spec.Template.Spec.Volumes)
+
+	container := job.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "/workspace", container.WorkingDir, "호스트 절대 경로는 마운트 경로로 대체")
+	assert.Equal(t, map[string]string{"cpu": "1", "memory": "1Gi"}, container.Resources.Limits)
+
+	_, _, err = runner.BuildJob(RunRequest{TaskID: "t2", Process: &claude.ProcessConfig{}})
+	assert.Error(t, err)
+}
+
+func TestRunner_Run(t *testing.T) {
+	fake, client := newFakeAPIServer(t)
+	fake.logs = "first line\nsecond line\n"
+	runner := newTestRunner(t, client, &QuotaConfig{Hard: map[string]string{"pods": "5"}})
+
+	var output bytes.Buffer
+	var messages []string
+	result, err := runner.Run(context.Background(), RunRequest{
+		TaskID:  "t1",
+		Process: &claude.ProcessConfig{Command: "echo", APIKey: "key"},
+		Stream:  claude.NewStreamHandlerForAgent(logrus.New(), mustTextRunner(t)),
+		OnMessage: func(msg claude.StreamMessage) error {
+			messages = append(messages, msg.Content)
+			return nil
+		},
+		Output: &output,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "aicli-task-t1", result.JobName)
+	assert.Equal(t, "aicli-task-t1-pod", result.PodName)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Equal(t, "first line\nsecond line\n", output.String())
+	assert.Equal(t, []string{"first line", "second line"}, messages)
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	assert.Empty(t, fake.jobs, "완료된 Job은 삭제")
+	assert.Empty(t, fake.secrets, "시크릿도 삭제")
+	require.Contains(t, fake.quotas, DefaultQuotaName)
+	assert.Equal(t, "5", fake.quotas[DefaultQuotaName].Spec.Hard["pods"])
+}
+
+func TestRunner_RunFailure(t *testing.T) {
+	fake, client := newFakeAPIServer(t)
+	fake.exitCode = 2
+	runner := newTestRunner(t, client, nil)
+
+	result, err := runner.Run(context.Background(), RunRequest{
+		TaskID:  "t1",
+		Process: &claude.ProcessConfig{Command: "false"},
+	})
+	require.Error(t, err)
+	assert.Equal(t, 2, result.ExitCode)
+}
+
+func TestClient_ApplyResourceQuotaUpdates(t *testing.T) {
+	fake, client := newFakeAPIServer(t)
+	ctx := context.Background()
+
+	quota := &ResourceQuota{Metadata: ObjectMeta{Name: "q"}, Spec: ResourceQuotaSpec{Hard: map[string]string{"pods": "1"}}}
+	_, err := client.ApplyResourceQuota(ctx, "aicli", quota)
+	require.NoError(t, err)
+
+	quota = &ResourceQuota{Metadata: ObjectMeta{Name: "q"}, Spec: ResourceQuotaSpec{Hard: map[string]string{"pods": "3"}}}
+	_, err = client.ApplyResourceQuota(ctx, "aicli", quota)
+	require.NoError(t, err)
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	assert.Equal(t, "3", fake.quotas["q"].Spec.Hard["pods"])
+	assert.Contains(t, fake.requests, "PUT /api/v1/namespaces/aicli/resourcequotas/q")
+}
+
+func TestClient_Errors(t *testing.T) {
+	_, client := newFakeAPIServer(t)
+
+	_, err := client.GetJob(context.Background(), "aicli", "missing")
+	assert.True(t, IsNotFound(err))
+	assert.NoError(t, client.DeleteSecret(context.Background(), "aicli", "missing"))
+
+	_, err = NewClient(ClientConfig{APIServer: "://bad"})
+	assert.Error(t, err)
+}
+
+func TestJobName(t *testing.T) {
+	assert.Equal(t, "aicli-task-abc-def", jobName("ABC_def"))
+	assert.LessOrEqual(t, len(jobName(strings.Repeat("a", 100))), 63)
+	assert.Equal(t, "ws-1", labelValue("ws/1"))
+}
+
+func mustTextRunner(t *testing.T) claude.AgentRunner {
+	runner, err := claude.NewCLIRunner(claude.CLIRunnerConfig{Provider: "task", Command: "echo"})
+	require.NoError(t, err)
+	return runner
+}
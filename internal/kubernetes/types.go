@@ -0,0 +1,254 @@
+package kubernetes
+
+import "time"
+
+// 이 파일은 러너가 사용하는 Kubernetes API 오브젝트 중 필요한 필드만 정의합니다.
+// client-go 없이 REST API를 직접 호출하므로 JSON 필드 이름은 API 스펙을 그대로 따릅니다.
+
+// ObjectMeta 오브젝트 메타데이터
+type ObjectMeta struct {
+	Name              string            `json:"name,omitempty"`
+	Namespace         string            `json:"namespace,omitempty"`
+	UID               string            `json:"uid,omitempty"`
+	ResourceVersion   string            `json:"resourceVersion,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
+	OwnerReferences   []OwnerReference  `json:"ownerReferences,omitempty"`
+	CreationTimestamp *time.Time        `json:"creationTimestamp,omitempty"`
+}
+
+// OwnerReference 소유 오브젝트 참조 (소유자가 삭제되면 가비지 컬렉터가 함께 삭제)
+type OwnerReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	UID        string `json:"uid"`
+}
+
+// Job batch/v1 Job
+type Job struct {
+	APIVersion string     `json:"apiVersion,omitempty"`
+	Kind       string     `json:"kind,omitempty"`
+	Metadata   ObjectMeta `json:"metadata"`
+	Spec       JobSpec    `json:"spec"`
+	Status     JobStatus  `json:"status,omitempty"`
+}
+
+// JobSpec Job 스펙
+type JobSpec struct {
+	BackoffLimit            *int32          `json:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds   *int64          `json:"activeDeadlineSeconds,omitempty"`
+	TTLSecondsAfterFinished *int32          `json:"ttlSecondsAfterFinished,omitempty"`
+	Template                PodTemplateSpec `json:"template"`
+}
+
+// JobStatus Job 상태
+type JobStatus struct {
+	Active     int32          `json:"active,omitempty"`
+	Succeeded  int32          `json:"succeeded,omitempty"`
+	Failed     int32          `json:"failed,omitempty"`
+	Conditions []JobCondition `json:"conditions,omitempty"`
+}
+
+// JobCondition Job 상태 조건 (Complete, Failed 등)
+type JobCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// PodTemplateSpec 파드 템플릿
+type PodTemplateSpec struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Spec     PodSpec    `json:"spec"`
+}
+
+// PodSpec 파드 스펙
+type PodSpec struct {
+	RestartPolicy                 string            `json:"restartPolicy,omitempty"`
+	ServiceAccountName            string            `json:"serviceAccountName,omitempty"`
+	AutomountServiceAccountToken  *bool             `json:"automountServiceAccountToken,omitempty"`
+	TerminationGracePeriodSeconds *int64            `json:"terminationGracePeriodSeconds,omitempty"`
+	NodeSelector                  map[string]string `json:"nodeSelector,omitempty"`
+	Containers                    []Container       `json:"containers"`
+	Volumes                       []Volume          `json:"volumes,omitempty"`
+}
+
+// Container 컨테이너 스펙
+type Container struct {
+	Name            string               `json:"name"`
+	Image           string               `json:"image"`
+	ImagePullPolicy string               `json:"imagePullPolicy,omitempty"`
+	Command         []string             `json:"command,omitempty"`
+	Args            []string             `json:"args,omitempty"`
+	WorkingDir      string               `json:"workingDir,omitempty"`
+	Env             []EnvVar             `json:"env,omitempty"`
+	Resources       ResourceRequirements `json:"resources,omitempty"`
+	VolumeMounts    []VolumeMount        `json:"volumeMounts,omitempty"`
+	SecurityContext *SecurityContext     `json:"securityContext,omitempty"`
+}
+
+// EnvVar 환경 변수 (값 또는 시크릿 참조)
+type EnvVar struct {
+	Name      string        `json:"name"`
+	Value     string        `json:"value,omitempty"`
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty"`
+}
+
+// EnvVarSource 환경 변수 값 출처
+type EnvVarSource struct {
+	SecretKeyRef *SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// SecretKeySelector 시크릿의 키 하나
+type SecretKeySelector struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// ResourceRequirements 컨테이너 리소스 요청과 제한 ("cpu": "500m", "memory": "2Gi")
+type ResourceRequirements struct {
+	Limits   map[string]string `json:"limits,omitempty"`
+	Requests map[string]string `json:"requests,omitempty"`
+}
+
+// VolumeMount 컨테이너 볼륨 마운트
+type VolumeMount struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	SubPath   string `json:"subPath,omitempty"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// Volume 파드 볼륨
+type Volume struct {
+	Name                  string                             `json:"name"`
+	PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+	Secret                *SecretVolumeSource                `json:"secret,omitempty"`
+}
+
+// PersistentVolumeClaimVolumeSource PVC 볼륨
+type PersistentVolumeClaimVolumeSource struct {
+	ClaimName string `json:"claimName"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// SecretVolumeSource 시크릿 볼륨
+type SecretVolumeSource struct {
+	SecretName  string      `json:"secretName"`
+	Items       []KeyToPath `json:"items,omitempty"`
+	DefaultMode *int32      `json:"defaultMode,omitempty"`
+}
+
+// KeyToPath 시크릿 키를 볼륨 안의 파일 경로로 매핑
+type KeyToPath struct {
+	Key  string `json:"key"`
+	Path string `json:"path"`
+}
+
+// SecurityContext 컨테이너 보안 설정
+type SecurityContext struct {
+	AllowPrivilegeEscalation *bool         `json:"allowPrivilegeEscalation,omitempty"`
+	Capabilities             *Capabilities `json:"capabilities,omitempty"`
+}
+
+// Capabilities 리눅스 capability 추가/제거
+type Capabilities struct {
+	Drop []string `json:"drop,omitempty"`
+}
+
+// Pod v1 Pod
+type Pod struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Spec     PodSpec    `json:"spec"`
+	Status   PodStatus  `json:"status,omitempty"`
+}
+
+// PodList 파드 목록
+type PodList struct {
+	Items []Pod `json:"items"`
+}
+
+// 파드 단계
+const (
+	PodPending   = "Pending"
+	PodRunning   = "Running"
+	PodSucceeded = "Succeeded"
+	PodFailed    = "Failed"
+)
+
+// PodStatus 파드 상태
+type PodStatus struct {
+	Phase             string            `json:"phase,omitempty"`
+	Reason            string            `json:"reason,omitempty"`
+	Message           string            `json:"message,omitempty"`
+	ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty"`
+}
+
+// ContainerStatus 컨테이너 상태
+type ContainerStatus struct {
+	Name  string         `json:"name"`
+	State ContainerState `json:"state,omitempty"`
+}
+
+// ContainerState 컨테이너 상태 (셋 중 하나만 설정됨)
+type ContainerState struct {
+	Waiting    *ContainerStateWaiting    `json:"waiting,omitempty"`
+	Running    *ContainerStateRunning    `json:"running,omitempty"`
+	Terminated *ContainerStateTerminated `json:"terminated,omitempty"`
+}
+
+// ContainerStateWaiting 대기 중인 컨테이너 상태
+type ContainerStateWaiting struct {
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ContainerStateRunning 실행 중인 컨테이너 상태
+type ContainerStateRunning struct {
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+}
+
+// ContainerStateTerminated 종료된 컨테이너 상태
+type ContainerStateTerminated struct {
+	ExitCode int32  `json:"exitCode"`
+	Reason   string `json:"reason,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Secret v1 Secret (StringData는 생성 시에만 사용)
+type Secret struct {
+	APIVersion string            `json:"apiVersion,omitempty"`
+	Kind       string            `json:"kind,omitempty"`
+	Metadata   ObjectMeta        `json:"metadata"`
+	Type       string            `json:"type,omitempty"`
+	StringData map[string]string `json:"stringData,omitempty"`
+}
+
+// ResourceQuota v1 ResourceQuota
+type ResourceQuota struct {
+	APIVersion string              `json:"apiVersion,omitempty"`
+	Kind       string              `json:"kind,omitempty"`
+	Metadata   ObjectMeta          `json:"metadata"`
+	Spec       ResourceQuotaSpec   `json:"spec"`
+	Status     ResourceQuotaStatus `json:"status,omitempty"`
+}
+
+// ResourceQuotaSpec 네임스페이스 리소스 상한 ("limits.cpu": "16", "pods": "20")
+type ResourceQuotaSpec struct {
+	Hard map[string]string `json:"hard,omitempty"`
+}
+
+// ResourceQuotaStatus 네임스페이스 리소스 상한과 현재 사용량
+type ResourceQuotaStatus struct {
+	Hard map[string]string `json:"hard,omitempty"`
+	Used map[string]string `json:"used,omitempty"`
+}
+
+// Status API 오류 응답 본문
+type Status struct {
+	Message string `json:"message,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Code    int    `json:"code,omitempty"`
+}
@@ -0,0 +1,54 @@
+package logging
+
+import "context"
+
+// 서브시스템 사이의 로그를 연결하는 필드 이름
+const (
+	FieldRequestID   = "request_id"
+	FieldSessionID   = "session_id"
+	FieldWorkspaceID = "workspace_id"
+)
+
+type contextKey struct{}
+
+// WithRequestID 요청 ID를 ctx에 담음
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return withField(ctx, FieldRequestID, requestID)
+}
+
+// WithSessionID 세션 ID를 ctx에 담음
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return withField(ctx, FieldSessionID, sessionID)
+}
+
+// WithWorkspaceID 워크스페이스 ID를 ctx에 담음
+func WithWorkspaceID(ctx context.Context, workspaceID string) context.Context {
+	return withField(ctx, FieldWorkspaceID, workspaceID)
+}
+
+// ContextFields ctx에 담긴 요청 ID, 세션 ID, 워크스페이스 ID (없으면 nil)
+// 반환한 맵은 여러 로거가 함께 쓰므로 수정하지 않습니다.
+func ContextFields(ctx context.Context) Fields {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(contextKey{}).(Fields)
+	return fields
+}
+
+// withField 기존 필드를 복사한 뒤 key를 추가한 맵을 ctx에 담음 (빈 값이면 ctx 그대로)
+func withField(ctx context.Context, key, value string) context.Context {
+	if value == "" {
+		return ctx
+	}
+	current := ContextFields(ctx)
+	if current[key] == value {
+		return ctx
+	}
+	fields := make(Fields, len(current)+1)
+	for k, v := range current {
+		fields[k] = v
+	}
+	fields[key] = value
+	return context.WithValue(ctx, contextKey{}, fields)
+}
@@ -0,0 +1,129 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+// Fields 로그에 붙이는 구조화 필드
+type Fields map[string]interface{}
+
+// Logger 서브시스템이 공통으로 쓰는 구조화 로거
+// logrus와 zap 로거는 FromLogrus, FromZap 어댑터로 감싸서 넘기고,
+// WithContext로 요청 ID, 세션 ID, 워크스페이스 ID를 붙여 서로 다른 서브시스템의 로그를 연결합니다.
+type Logger interface {
+	WithField(key string, value interface{}) Logger
+	WithFields(fields Fields) Logger
+	WithError(err error) Logger
+	// WithContext ctx에 담긴 요청 ID, 세션 ID, 워크스페이스 ID를 필드로 추가
+	WithContext(ctx context.Context) Logger
+
+	Debug(args ...interface{})
+	Debugf(format string, args ...interface{})
+	Info(args ...interface{})
+	Infof(format string, args ...interface{})
+	Warn(args ...interface{})
+	Warnf(format string, args ...interface{})
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// FromLogrus logrus 로거를 Logger로 감쌈 (nil이면 logrus 기본 로거)
+func FromLogrus(logger *logrus.Logger) Logger {
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	return logrusLogger{entry: logrus.NewEntry(logger)}
+}
+
+// FromZap zap 로거를 Logger로 감쌈 (nil이면 아무것도 기록하지 않음)
+func FromZap(logger *zap.Logger) Logger {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return zapLogger{logger: logger.Sugar()}
+}
+
+// Nop 아무것도 기록하지 않는 로거
+func Nop() Logger {
+	return FromZap(zap.NewNop())
+}
+
+// For 모듈 로거를 Logger로 감쌈
+func (r *Registry) For(module Module) Logger {
+	return FromLogrus(r.Logger(module))
+}
+
+// logrusLogger logrus 어댑터
+type logrusLogger struct {
+	entry *logrus.Entry
+}
+
+func (l logrusLogger) WithField(key string, value interface{}) Logger {
+	return logrusLogger{entry: l.entry.WithField(key, value)}
+}
+
+func (l logrusLogger) WithFields(fields Fields) Logger {
+	return logrusLogger{entry: l.entry.WithFields(logrus.Fields(fields))}
+}
+
+func (l logrusLogger) WithError(err error) Logger {
+	return logrusLogger{entry: l.entry.WithError(err)}
+}
+
+func (l logrusLogger) WithContext(ctx context.Context) Logger {
+	fields := ContextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return l.WithFields(fields)
+}
+
+func (l logrusLogger) Debug(args ...interface{})                 { l.entry.Debug(args...) }
+func (l logrusLogger) Debugf(format string, args ...interface{}) { l.entry.Debugf(format, args...) }
+func (l logrusLogger) Info(args ...interface{})                  { l.entry.Info(args...) }
+func (l logrusLogger) Infof(format string, args ...interface{})  { l.entry.Infof(format, args...) }
+func (l logrusLogger) Warn(args ...interface{})                  { l.entry.Warn(args...) }
+func (l logrusLogger) Warnf(format string, args ...interface{})  { l.entry.Warnf(format, args...) }
+func (l logrusLogger) Error(args ...interface{})                 { l.entry.Error(args...) }
+func (l logrusLogger) Errorf(format string, args ...interface{}) { l.entry.Errorf(format, args...) }
+
+// zapLogger zap 어댑터
+type zapLogger struct {
+	logger *zap.SugaredLogger
+}
+
+func (l zapLogger) WithField(key string, value interface{}) Logger {
+	return zapLogger{logger: l.logger.With(key, value)}
+}
+
+func (l zapLogger) WithFields(fields Fields) Logger {
+	args := make([]interface{}, 0, len(fields)*2)
+	for key, value := range fields {
+		args = append(args, key, value)
+	}
+	return zapLogger{logger: l.logger.With(args...)}
+}
+
+func (l zapLogger) WithError(err error) Logger {
+	return zapLogger{logger: l.logger.With(zap.Error(err))}
+}
+
+func (l zapLogger) WithContext(ctx context.Context) Logger {
+	fields := ContextFields(ctx)
+	if len(fields) == 0 {
+		return l
+	}
+	return l.WithFields(fields)
+}
+
+func (l zapLogger) Debug(args ...interface{})                 { l.logger.Debug(args...) }
+func (l zapLogger) Debugf(format string, args ...interface{}) { l.logger.Debugf(format, args...) }
+func (l zapLogger) Info(args ...interface{})                  { l.logger.Info(args...) }
+func (l zapLogger) Infof(format string, args ...interface{})  { l.logger.Infof(format, args...) }
+func (l zapLogger) Warn(args ...interface{})                  { l.logger.Warn(args...) }
+func (l zapLogger) Warnf(format string, args ...interface{})  { l.logger.Warnf(format, args...) }
+func (l zapLogger) Error(args ...interface{})                 { l.logger.Error(args...) }
+func (l zapLogger) Errorf(format string, args ...interface{}) { l.logger.Errorf(format, args...) }
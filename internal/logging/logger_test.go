@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestContextFields(t *testing.T) {
+	assert.Nil(t, ContextFields(context.Background()))
+
+	parent := WithRequestID(context.Background(), "req-1")
+	child := WithWorkspaceID(WithSessionID(parent, "sess-1"), "ws-1")
+
+	assert.Equal(t, Fields{FieldRequestID: "req-1"}, ContextFields(parent))
+	assert.Equal(t, Fields{
+		FieldRequestID:   "req-1",
+		FieldSessionID:   "sess-1",
+		FieldWorkspaceID: "ws-1",
+	}, ContextFields(child))
+
+	// 빈 값은 추가하지 않음
+	assert.Equal(t, parent, WithSessionID(parent, ""))
+}
+
+func TestFromLogrus_WithContext(t *testing.T) {
+	reg, buf := newTestRegistry(t)
+	ctx := WithSessionID(WithRequestID(context.Background(), "req-1"), "sess-1")
+
+	reg.For(ModuleClaude).WithContext(ctx).WithField("pid", 42).WithError(errors.New("boom")).Warn("프로세스 종료")
+
+	entries := decodeEntries(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "req-1", entries[0][FieldRequestID])
+	assert.Equal(t, "sess-1", entries[0][FieldSessionID])
+	assert.Equal(t, "claude", entries[0][ModuleField])
+	assert.Equal(t, "boom", entries[0]["error"])
+	assert.EqualValues(t, 42, entries[0]["pid"])
+}
+
+func TestFromZap_WithContext(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := FromZap(zap.New(core))
+	ctx := WithWorkspaceID(WithRequestID(context.Background(), "req-1"), "ws-1")
+
+	logger.WithContext(ctx).WithFields(Fields{"ip": "10.0.0.1"}).WithError(errors.New("boom")).Errorf("차단 실패: %d", 3)
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "차단 실패: 3", entry.Message)
+	assert.Equal(t, zapcore.ErrorLevel, entry.Level)
+	fields := entry.ContextMap()
+	assert.Equal(t, "req-1", fields[FieldRequestID])
+	assert.Equal(t, "ws-1", fields[FieldWorkspaceID])
+	assert.Equal(t, "10.0.0.1", fields["ip"])
+	assert.Equal(t, "boom", fields["error"])
+}
+
+func TestNop(t *testing.T) {
+	assert.NotPanics(t, func() {
+		Nop().WithContext(WithRequestID(context.Background(), "req-1")).Info("무시됨")
+	})
+}
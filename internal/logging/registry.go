@@ -0,0 +1,216 @@
+// Package logging 서브시스템이 공통으로 쓰는 로거와 모듈별 로그 레벨 관리를 제공합니다.
+//
+// 서비스와 Claude 프로세스 관리, 보안 패키지는 Logger 인터페이스로 로그를 기록하고, logrus와 zap 로거는
+// FromLogrus, FromZap 어댑터로 감싸서 넘깁니다. 요청 ID, 세션 ID, 워크스페이스 ID는 context에 담아
+// Logger.WithContext로 붙이므로 HTTP 요청부터 Claude 프로세스까지의 로그를 같은 필드로 연결할 수 있습니다.
+//
+// 서버는 logrus 로거 하나를 기준으로 모듈(claude, api, security, storage)마다 출력 형식과 훅을
+// 공유하는 로거를 만들고, 아직 zap을 쓰는 패키지에는 같은 logrus 로거로 기록하는 zap 로거를 넘겨
+// 출력 형식, 인스턴스 식별자, 마스킹 훅, 레벨 설정을 한곳에서 관리합니다.
+package logging
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// Module 로그 레벨을 따로 지정할 수 있는 모듈
+type Module string
+
+const (
+	// ModuleServer 모듈을 지정하지 않은 서버 로그 (기본 로거, 항상 기본 레벨 사용)
+	ModuleServer Module = "server"
+	// ModuleClaude Claude 프로세스, 세션, 쿼터
+	ModuleClaude Module = "claude"
+	// ModuleAPI HTTP 요청과 컨트롤러
+	ModuleAPI Module = "api"
+	// ModuleSecurity 인증, 로그인 공격 탐지
+	ModuleSecurity Module = "security"
+	// ModuleStorage 스토리지와 백업
+	ModuleStorage Module = "storage"
+)
+
+// ModuleField 모듈 로거가 모든 로그에 붙이는 필드 이름
+const ModuleField = "module"
+
+// Modules 레벨을 따로 지정할 수 있는 모듈 목록
+func Modules() []Module {
+	return []Module{ModuleClaude, ModuleAPI, ModuleSecurity, ModuleStorage}
+}
+
+// IsModule 레벨을 따로 지정할 수 있는 모듈인지 확인
+func IsModule(name string) bool {
+	for _, module := range Modules() {
+		if string(module) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Registry 기본 로거와 모듈별 로거의 레벨 관리
+// 모듈 레벨을 지정하지 않은 모듈은 기본 레벨을 따릅니다.
+type Registry struct {
+	base *logrus.Logger
+
+	mu        sync.Mutex
+	level     logrus.Level
+	overrides map[Module]logrus.Level
+	loggers   map[Module]*logrus.Logger
+}
+
+// NewRegistry 기본 로거의 출력 형식과 훅을 공유하는 모듈 로거 생성
+// 모듈 로거는 생성 시점의 훅을 복사하므로 기본 로거에 훅을 모두 추가한 뒤 호출합니다.
+func NewRegistry(base *logrus.Logger) *Registry {
+	r := &Registry{
+		base:      base,
+		level:     base.GetLevel(),
+		overrides: make(map[Module]logrus.Level),
+		loggers:   make(map[Module]*logrus.Logger),
+	}
+	for _, module := range Modules() {
+		hooks := make(logrus.LevelHooks)
+		for level, levelHooks := range base.Hooks {
+			hooks[level] = append([]logrus.Hook(nil), levelHooks...)
+		}
+		hooks.Add(moduleHook(module))
+
+		r.loggers[module] = &logrus.Logger{
+			Out:          base.Out,
+			Formatter:    base.Formatter,
+			Hooks:        hooks,
+			Level:        base.GetLevel(),
+			ExitFunc:     base.ExitFunc,
+			ReportCaller: base.ReportCaller,
+		}
+	}
+	return r
+}
+
+// Logger 모듈 로거 (ModuleServer나 알 수 없는 모듈이면 기본 로거)
+func (r *Registry) Logger(module Module) *logrus.Logger {
+	if logger, ok := r.loggers[module]; ok {
+		return logger
+	}
+	return r.base
+}
+
+// Zap 모듈 로거로 기록하는 zap 로거 (레벨도 모듈 로거를 따름)
+func (r *Registry) Zap(module Module) *zap.Logger {
+	return zap.New(NewLogrusCore(r.Logger(module)))
+}
+
+// Apply 설정의 기본 레벨과 모듈 레벨로 모두 바꿈 (설정에 없는 모듈은 기본 레벨로 되돌림)
+// 잘못된 항목이 있으면 아무것도 바꾸지 않고 에러를 반환합니다.
+func (r *Registry) Apply(level string, modules map[string]string) error {
+	parsed, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+	overrides := make(map[Module]logrus.Level, len(modules))
+	for name, moduleLevel := range modules {
+		if !IsModule(name) {
+			return fmt.Errorf("알 수 없는 로그 모듈입니다: %s", name)
+		}
+		if moduleLevel == "" {
+			continue
+		}
+		parsedModule, err := parseLevel(moduleLevel)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		overrides[Module(name)] = parsedModule
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.level = parsed
+	r.overrides = overrides
+	r.applyLocked()
+	return nil
+}
+
+// Update 관리자 요청으로 레벨 변경 (비어 있는 기본 레벨은 유지, 모듈 레벨이 빈 값이면 기본 레벨로 되돌림)
+// 잘못된 항목이 있으면 아무것도 바꾸지 않고 에러를 반환합니다.
+func (r *Registry) Update(req *models.LogLevelUpdateRequest) (models.LogLevelStatus, error) {
+	r.mu.Lock()
+	level := r.level.String()
+	modules := make(map[string]string, len(r.overrides))
+	for module, moduleLevel := range r.overrides {
+		modules[string(module)] = moduleLevel.String()
+	}
+	r.mu.Unlock()
+
+	if req.Level != "" {
+		level = req.Level
+	}
+	for name, moduleLevel := range req.Modules {
+		modules[name] = moduleLevel
+	}
+	if err := r.Apply(level, modules); err != nil {
+		return models.LogLevelStatus{}, err
+	}
+	return r.Status(), nil
+}
+
+// Status 기본 레벨과 모듈별 레벨
+func (r *Registry) Status() models.LogLevelStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status := models.LogLevelStatus{Level: r.level.String()}
+	for _, module := range Modules() {
+		moduleLevel, overridden := r.overrides[module]
+		if !overridden {
+			moduleLevel = r.level
+		}
+		status.Modules = append(status.Modules, models.ModuleLogLevel{
+			Module:     string(module),
+			Level:      moduleLevel.String(),
+			Overridden: overridden,
+		})
+	}
+	return status
+}
+
+// applyLocked 현재 레벨을 모든 로거에 적용 (mu를 잡은 상태에서 호출)
+func (r *Registry) applyLocked() {
+	r.base.SetLevel(r.level)
+	logrus.SetLevel(r.level)
+	for module, logger := range r.loggers {
+		if level, ok := r.overrides[module]; ok {
+			logger.SetLevel(level)
+		} else {
+			logger.SetLevel(r.level)
+		}
+	}
+}
+
+// parseLevel logrus 로그 레벨 해석 (panic은 허용하지 않음)
+func parseLevel(level string) (logrus.Level, error) {
+	parsed, err := logrus.ParseLevel(strings.TrimSpace(level))
+	if err != nil || parsed == logrus.PanicLevel {
+		return 0, fmt.Errorf("알 수 없는 로그 레벨입니다: %s", level)
+	}
+	return parsed, nil
+}
+
+// moduleHook 모든 로그에 모듈 이름 추가
+type moduleHook Module
+
+func (h moduleHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h moduleHook) Fire(entry *logrus.Entry) error {
+	if _, ok := entry.Data[ModuleField]; !ok {
+		entry.Data[ModuleField] = string(h)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+func newTestRegistry(t *testing.T) (*Registry, *bytes.Buffer) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	base := logrus.New()
+	base.SetOutput(&buf)
+	base.SetFormatter(&logrus.JSONFormatter{})
+	base.SetLevel(logrus.InfoLevel)
+
+	level := logrus.GetLevel()
+	t.Cleanup(func() { logrus.SetLevel(level) })
+
+	return NewRegistry(base), &buf
+}
+
+func decodeEntries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestRegistry_ApplyAndStatus(t *testing.T) {
+	reg, _ := newTestRegistry(t)
+
+	require.NoError(t, reg.Apply("warn", map[string]string{"claude": "debug"}))
+
+	assert.Equal(t, logrus.WarnLevel, reg.Logger(ModuleServer).GetLevel())
+	assert.Equal(t, logrus.DebugLevel, reg.Logger(ModuleClaude).GetLevel())
+	assert.Equal(t, logrus.WarnLevel, reg.Logger(ModuleAPI).GetLevel())
+
+	status := reg.Status()
+	assert.Equal(t, "warning", status.Level)
+	require.Len(t, status.Modules, len(Modules()))
+	assert.Equal(t, models.ModuleLogLevel{Module: "claude", Level: "debug", Overridden: true}, status.Modules[0])
+	assert.Equal(t, models.ModuleLogLevel{Module: "api", Level: "warning"}, status.Modules[1])
+
+	// 설정에서 빠진 모듈은 기본 레벨로 돌아감
+	require.NoError(t, reg.Apply("info", nil))
+	assert.Equal(t, logrus.InfoLevel, reg.Logger(ModuleClaude).GetLevel())
+	assert.False(t, reg.Status().Modules[0].Overridden)
+}
+
+func TestRegistry_RejectsInvalidWithoutChanges(t *testing.T) {
+	reg, _ := newTestRegistry(t)
+	require.NoError(t, reg.Apply("info", map[string]string{"api": "debug"}))
+
+	assert.Error(t, reg.Apply("loud", nil))
+	assert.Error(t, reg.Apply("panic", nil))
+	assert.Error(t, reg.Apply("error", map[string]string{"unknown": "debug"}))
+	assert.Error(t, reg.Apply("error", map[string]string{"storage": "verbose"}))
+
+	assert.Equal(t, logrus.InfoLevel, reg.Logger(ModuleServer).GetLevel())
+	assert.Equal(t, logrus.DebugLevel, reg.Logger(ModuleAPI).GetLevel())
+	assert.Equal(t, logrus.InfoLevel, reg.Logger(ModuleStorage).GetLevel())
+}
+
+func TestRegistry_UpdateMergesOverrides(t *testing.T) {
+	reg, _ := newTestRegistry(t)
+	require.NoError(t, reg.Apply("info", map[string]string{"claude": "debug"}))
+
+	status, err := reg.Update(&models.LogLevelUpdateRequest{Modules: map[string]string{"security": "trace"}})
+	require.NoError(t, err)
+	assert.Equal(t, "info", status.Level)
+	assert.Equal(t, logrus.DebugLevel, reg.Logger(ModuleClaude).GetLevel())
+	assert.Equal(t, logrus.TraceLevel, reg.Logger(ModuleSecurity).GetLevel())
+
+	// 빈 값은 기본 레벨로 되돌림
+	_, err = reg.Update(&models.LogLevelUpdateRequest{Level: "error", Modules: map[string]string{"claude": ""}})
+	require.NoError(t, err)
+	assert.Equal(t, logrus.ErrorLevel, reg.Logger(ModuleClaude).GetLevel())
+	assert.Equal(t, logrus.TraceLevel, reg.Logger(ModuleSecurity).GetLevel())
+}
+
+func TestRegistry_ModuleField(t *testing.T) {
+	reg, buf := newTestRegistry(t)
+
+	reg.Logger(ModuleStorage).Info("backup")
+	reg.Logger(ModuleServer).Info("startup")
+
+	entries := decodeEntries(t, buf)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "storage", entries[0][ModuleField])
+	assert.NotContains(t, entries[1], ModuleField)
+}
+
+func TestRegistry_ZapFollowsModuleLevel(t *testing.T) {
+	reg, buf := newTestRegistry(t)
+	require.NoError(t, reg.Apply("info", map[string]string{"claude": "debug"}))
+
+	reg.Zap(ModuleClaude).With(zap.String("session_id", "s1")).Debug("spawned", zap.Int("pid", 42))
+	reg.Zap(ModuleAPI).Debug("dropped")
+
+	entries := decodeEntries(t, buf)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "spawned", entries[0]["msg"])
+	assert.Equal(t, "debug", entries[0]["level"])
+	assert.Equal(t, "claude", entries[0][ModuleField])
+	assert.Equal(t, "s1", entries[0]["session_id"])
+	assert.EqualValues(t, 42, entries[0]["pid"])
+
+	// 실행 중 레벨 변경이 이미 만든 zap 로거에도 적용
+	logger := reg.Zap(ModuleAPI)
+	_, err := reg.Update(&models.LogLevelUpdateRequest{Modules: map[string]string{"api": "debug"}})
+	require.NoError(t, err)
+	logger.Debug("visible")
+	assert.Len(t, decodeEntries(t, buf), 2)
+}
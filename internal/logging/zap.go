@@ -0,0 +1,85 @@
+package logging
+
+import (
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap/zapcore"
+)
+
+// logrusCore zap 로그를 logrus 로거로 기록하는 zapcore.Core
+// 레벨 판단도 logrus 로거를 따르므로 실행 중에 바꾼 레벨이 zap 로그에도 바로 적용됩니다.
+type logrusCore struct {
+	logger *logrus.Logger
+	fields logrus.Fields
+}
+
+// NewLogrusCore logrus 로거로 기록하는 zapcore.Core 생성
+func NewLogrusCore(logger *logrus.Logger) zapcore.Core {
+	return &logrusCore{logger: logger}
+}
+
+func (c *logrusCore) Enabled(level zapcore.Level) bool {
+	return c.logger.IsLevelEnabled(logrusLevel(level))
+}
+
+func (c *logrusCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &logrusCore{logger: c.logger, fields: make(logrus.Fields, len(c.fields)+len(fields))}
+	for key, value := range c.fields {
+		clone.fields[key] = value
+	}
+	for key, value := range encodeFields(fields) {
+		clone.fields[key] = value
+	}
+	return clone
+}
+
+func (c *logrusCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *logrusCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	data := make(logrus.Fields, len(c.fields)+len(fields)+1)
+	for key, value := range c.fields {
+		data[key] = value
+	}
+	for key, value := range encodeFields(fields) {
+		data[key] = value
+	}
+	if entry.LoggerName != "" {
+		data["logger"] = entry.LoggerName
+	}
+	// Fatal, Panic 이후의 종료나 패닉은 zap이 처리
+	c.logger.WithFields(data).WithTime(entry.Time).Log(logrusLevel(entry.Level), entry.Message)
+	return nil
+}
+
+func (c *logrusCore) Sync() error {
+	return nil
+}
+
+// encodeFields zap 필드를 logrus 필드로 변환
+func encodeFields(fields []zapcore.Field) logrus.Fields {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+	return logrus.Fields(encoder.Fields)
+}
+
+// logrusLevel zap 레벨에 해당하는 logrus 레벨 (logrus가 직접 패닉하지 않도록 Panic은 Error로 기록)
+func logrusLevel(level zapcore.Level) logrus.Level {
+	switch level {
+	case zapcore.DebugLevel:
+		return logrus.DebugLevel
+	case zapcore.InfoLevel:
+		return logrus.InfoLevel
+	case zapcore.WarnLevel:
+		return logrus.WarnLevel
+	case zapcore.FatalLevel:
+		return logrus.FatalLevel
+	default:
+		return logrus.ErrorLevel
+	}
+}
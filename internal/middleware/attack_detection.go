@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/security"
+)
+
+// AttackDetectedCode는 공격으로 판단해 거부한 요청의 에러 코드입니다.
+const AttackDetectedCode = "ATTACK_DETECTED"
+
+// AttackDetection은 요청 경로, 쿼리, 헤더, 본문을 공격 탐지기로 검사하는 미들웨어입니다.
+// 요청 경로가 속한 라우트 그룹의 임계값으로 판단하며, 공격이면 403으로 거부하고 탐지 기록 모드이면 기록만 하고 통과시킵니다.
+// 본문은 검사 예산만큼만 앞부분을 읽고 핸들러에는 원래 본문을 그대로 넘기며, 검사하지 않는 형식(업로드, diff 등)은 읽지 않습니다.
+func AttackDetection(detector *security.AttackDetector) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := c.Request.URL.RawQuery
+		if unescaped, err := url.QueryUnescape(query); err == nil {
+			query = unescaped
+		}
+
+		headers := make(map[string]string)
+		for _, name := range []string{"Referer", "X-Forwarded-Host"} {
+			if value := c.GetHeader(name); value != "" {
+				headers[name] = value
+			}
+		}
+
+		contentType := c.GetHeader("Content-Type")
+		body, err := peekBody(c.Request, detector.BodyScanLimit(contentType))
+		if err != nil {
+			BadRequestError(c, "요청 본문을 읽을 수 없습니다")
+			return
+		}
+
+		result := detector.DetectAttacks(c.Request.Context(), &security.AttackDetectionRequest{
+			IPAddress:   c.ClientIP(),
+			UserAgent:   c.Request.UserAgent(),
+			Method:      c.Request.Method,
+			URL:         c.Request.URL.Path,
+			Path:        c.Request.URL.Path,
+			Query:       query,
+			Headers:     headers,
+			Body:        body,
+			ContentType: contentType,
+			Timestamp:   time.Now(),
+		})
+		if result.IsAttack && !result.DryRun {
+			AbortWithError(c, http.StatusForbidden, AttackDetectedCode, "공격으로 판단된 요청입니다", gin.H{
+				"attack_type": result.AttackType,
+				"route_group": result.RouteGroup,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// peekBody는 본문 앞부분을 최대 limit 바이트까지 읽고, 읽은 부분을 되돌려 핸들러가 본문 전체를 읽을 수 있게 합니다.
+func peekBody(req *http.Request, limit int) (string, error) {
+	if limit <= 0 || req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+
+	head, err := io.ReadAll(io.LimitReader(req.Body, int64(limit)))
+	if err != nil {
+		return "", err
+	}
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), req.Body), req.Body}
+	return string(head), nil
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/security"
+)
+
+func TestAttackDetection_Body(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	config := security.DefaultAttackDetectorConfig()
+	config.RouteGroups = []security.AttackRouteGroup{{Name: "ws", PathPrefix: "/ws", DryRun: true}}
+	detector := security.NewAttackDetector(config)
+
+	var received string
+	router := gin.New()
+	router.Use(AttackDetection(detector))
+	handler := func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+		received = string(body)
+		c.Status(http.StatusOK)
+	}
+	router.POST("/api/v1/tasks", handler)
+	router.POST("/ws", handler)
+
+	send := func(path, contentType, body string) int {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	attack := `{"q": "1' UNION SELECT password FROM users"}`
+	assert.Equal(t, http.StatusForbidden, send("/api/v1/tasks", "application/json", attack))
+
+	// 탐지 기록 모드 그룹은 통과시키고 핸들러는 본문 전체를 읽음
+	large := attack + strings.Repeat("x", 2*security.DefaultAttackScanPolicy().MaxBodyBytes)
+	assert.Equal(t, http.StatusOK, send("/ws", "application/json", large))
+	assert.Equal(t, large, received)
+
+	// 검사하지 않는 형식의 본문은 그대로 전달
+	assert.Equal(t, http.StatusOK, send("/api/v1/tasks", "text/x-diff", attack))
+	assert.Equal(t, attack, received)
+}
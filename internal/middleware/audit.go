@@ -175,9 +175,9 @@ func (al *AuditLogger) Handler() gin.HandlerFunc {
 			IPAddress: c.ClientIP(),
 			UserAgent: c.GetHeader("User-Agent"),
 			Method:    c.Request.Method,
-			URL:       c.Request.URL.String(),
+			URL:       redactPath(c.Request.URL.RequestURI()),
 			Path:      c.Request.URL.Path,
-			Query:     c.Request.URL.RawQuery,
+			Query:     redactQuery(c.Request.URL.RawQuery),
 			Metadata:  make(map[string]interface{}),
 		}
 
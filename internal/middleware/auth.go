@@ -66,21 +66,88 @@ func JWTAuth(jwtManager *auth.JWTManager, blacklist *auth.Blacklist) gin.Handler
 			return
 		}
 
+		// 비밀번호 변경이나 계정 삭제로 사용자 토큰이 차단되었는지 확인
+		if blacklist.IsRevoked(claims) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "TOKEN_BLACKLISTED",
+					"message": "Token has been revoked",
+				},
+			})
+			return
+		}
+
+		// 읽기 전용 토큰은 조회 요청만 허용
+		if !claims.HasScope(auth.ScopeWrite) && !isReadOnlyMethod(c.Request.Method) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INSUFFICIENT_SCOPE",
+					"message": "Token scope does not allow this request",
+					"details": "required scope: " + auth.ScopeWrite,
+				},
+			})
+			return
+		}
+
 		// 클레임을 컨텍스트에 저장
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.UserName)
 		c.Set("role", claims.Role)
+		c.Set("scope", claims.Scope)
 		c.Set("claims", claims)
 
 		c.Next()
 	}
 }
 
+// isReadOnlyMethod 상태를 바꾸지 않는 HTTP 메서드인지 확인
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
 // RequireAuth 특정 라우트에 인증을 요구하는 헬퍼 함수
 func RequireAuth(jwtManager *auth.JWTManager, blacklist *auth.Blacklist) gin.HandlerFunc {
 	return JWTAuth(jwtManager, blacklist)
 }
 
+// RequireWebSocketAuth WebSocket 연결용 인증 미들웨어
+// 브라우저 WebSocket은 헤더를 지정할 수 없으므로 Authorization 헤더가 없으면 token 쿼리 파라미터를 사용합니다.
+func RequireWebSocketAuth(jwtManager *auth.JWTManager, blacklist *auth.Blacklist) gin.HandlerFunc {
+	jwtAuth := JWTAuth(jwtManager, blacklist)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			if token := c.Query("token"); token != "" {
+				c.Request.Header.Set("Authorization", "Bearer "+token)
+			}
+		}
+		jwtAuth(c)
+	}
+}
+
+// RequireInteractiveWebSocketAuth 터미널처럼 입력을 받는 WebSocket 연결용 인증 미들웨어
+// WebSocket 업그레이드는 항상 GET이므로 메서드 대신 토큰의 write 범위를 확인합니다.
+func RequireInteractiveWebSocketAuth(jwtManager *auth.JWTManager, blacklist *auth.Blacklist) gin.HandlerFunc {
+	wsAuth := RequireWebSocketAuth(jwtManager, blacklist)
+	return func(c *gin.Context) {
+		wsAuth(c)
+		if c.IsAborted() {
+			return
+		}
+		if claims, ok := GetClaims(c); ok && !claims.HasScope(auth.ScopeWrite) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INSUFFICIENT_SCOPE",
+					"message": "Token scope does not allow this request",
+					"details": "required scope: " + auth.ScopeWrite,
+				},
+			})
+		}
+	}
+}
+
 // RequireRole 특정 역할을 요구하는 미들웨어
 func RequireRole(roles ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -166,7 +233,7 @@ func OptionalAuth(jwtManager *auth.JWTManager, blacklist *auth.Blacklist) gin.Ha
 
 		// 토큰 검증
 		claims, err := jwtManager.VerifyToken(token)
-		if err != nil {
+		if err != nil || blacklist.IsRevoked(claims) {
 			// 검증 실패해도 통과 (선택적 인증)
 			c.Next()
 			return
@@ -213,6 +280,16 @@ func GetUserRole(c *gin.Context) (string, bool) {
 	return roleStr, ok
 }
 
+// GetClaims 컨텍스트에서 검증된 토큰 클레임 추출 헬퍼 함수
+func GetClaims(c *gin.Context) (*auth.Claims, bool) {
+	value, exists := c.Get("claims")
+	if !exists {
+		return nil, false
+	}
+	claims, ok := value.(*auth.Claims)
+	return claims, ok
+}
+
 // IsAuthenticated 인증 여부 확인 헬퍼 함수
 func IsAuthenticated(c *gin.Context) bool {
 	authenticated, exists := c.Get("authenticated")
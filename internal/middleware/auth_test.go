@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/aicli/aicli-web/internal/auth"
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -204,4 +206,45 @@ func BenchmarkRequirePermission(b *testing.B) {
 		w := httptest.NewRecorder()
 		router.ServeHTTP(w, req)
 	}
-}
\ No newline at end of file
+}
+
+func TestRedactQuery(t *testing.T) {
+	assert.Equal(t, "", redactQuery(""))
+	assert.Equal(t, "mode=host&cols=80", redactQuery("mode=host&cols=80"))
+	assert.Equal(t, "mode=host&token=REDACTED", redactQuery("mode=host&token=secret.jwt.value"))
+	assert.NotContains(t, redactPath("/ws/workspaces/ws-1/terminal?token=secret"), "secret")
+	assert.Equal(t, "/api/v1/health", redactPath("/api/v1/health"))
+}
+
+func TestRequireInteractiveWebSocketAuth_Scope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtManager := auth.NewJWTManager("test-secret", time.Hour, time.Hour)
+	blacklist := auth.NewBlacklist()
+
+	router := gin.New()
+	router.GET("/ws/workspaces/:id/terminal", RequireInteractiveWebSocketAuth(jwtManager, blacklist), func(c *gin.Context) {
+		c.Status(http.StatusSwitchingProtocols)
+	})
+	router.GET("/ws/watch", RequireWebSocketAuth(jwtManager, blacklist), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	upgrade := func(path, token string) int {
+		req := httptest.NewRequest(http.MethodGet, path+"?token="+token, nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	readToken, err := jwtManager.GenerateScopedToken("user-1", "alice", "alice@example.com", "user", auth.ScopeRead, auth.AccessToken)
+	assert.NoError(t, err)
+	fullToken, err := jwtManager.GenerateToken("user-1", "alice", "alice@example.com", "user", auth.AccessToken)
+	assert.NoError(t, err)
+
+	// 읽기 전용 토큰은 조회용 WebSocket만 열 수 있고 터미널은 거부
+	assert.Equal(t, http.StatusOK, upgrade("/ws/watch", readToken))
+	assert.Equal(t, http.StatusForbidden, upgrade("/ws/workspaces/ws-1/terminal", readToken))
+	assert.Equal(t, http.StatusSwitchingProtocols, upgrade("/ws/workspaces/ws-1/terminal", fullToken))
+}
@@ -3,10 +3,13 @@ package middleware
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/aicli/aicli-web/internal/breaker"
 	apierrors "github.com/aicli/aicli-web/internal/errors"
+	"github.com/aicli/aicli-web/internal/validation"
 )
 
 // ErrorResponse는 표준 에러 응답 구조체입니다.
@@ -45,8 +48,9 @@ func ErrorHandler() gin.HandlerFunc {
 			err := c.Errors.Last()
 			requestID := GetRequestID(c)
 
-			// 에러 타입에 따른 응답 생성
+			// 에러 타입에 따른 응답 생성 (요청 언어로 번역)
 			response := createErrorResponse(err, requestID)
+			response.Error.Message = validation.TranslateAPIMessage(response.Error.Code, response.Error.Message, GetLanguage(c))
 			
 			// 이미 응답이 작성된 경우 처리하지 않음
 			if c.Writer.Written() {
@@ -140,6 +144,7 @@ func NewAPIError(code string, message string, details interface{}) *gin.Error {
 
 
 // AbortWithError는 에러와 함께 요청을 중단합니다.
+// 메시지는 요청 언어(사용자 설정 또는 Accept-Language)로 번역합니다.
 func AbortWithError(c *gin.Context, statusCode int, code string, message string, details interface{}) {
 	requestID := GetRequestID(c)
 	
@@ -147,7 +152,7 @@ func AbortWithError(c *gin.Context, statusCode int, code string, message string,
 		Success: false,
 		Error: Error{
 			Code:      code,
-			Message:   message,
+			Message:   validation.TranslateAPIMessage(code, message, GetLanguage(c)),
 			Details:   details,
 			RequestID: requestID,
 		},
@@ -197,17 +202,53 @@ func InternalError(c *gin.Context, message string, details interface{}) {
 	AbortWithError(c, http.StatusInternalServerError, ErrInternal, message, details)
 }
 
+// DeadlineExceededError는 요청 처리 시간 초과 에러를 처리합니다.
+func DeadlineExceededError(c *gin.Context) {
+	err := apierrors.NewDeadlineExceededError(GetRequestTimeout(c))
+	AbortWithError(c, http.StatusGatewayTimeout, err.Code, err.Message, err.Details)
+}
+
+// DependencyUnavailableError는 회로가 열린 외부 의존성 에러를 처리합니다.
+// 회로가 다시 시험 호출을 허용할 때까지 남은 시간을 Retry-After로 알려줍니다.
+func DependencyUnavailableError(c *gin.Context, openErr *breaker.OpenError) {
+	retryAfter := int(openErr.RetryAfter.Round(time.Second) / time.Second)
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	AbortWithError(c, http.StatusServiceUnavailable, apierrors.ErrCodeDependencyUnavailable,
+		"외부 서비스를 일시적으로 사용할 수 없습니다", gin.H{"dependency": openErr.Name})
+}
+
 // HandleServiceError는 서비스 계층의 에러를 적절한 HTTP 응답으로 변환합니다.
 func HandleServiceError(c *gin.Context, err error) {
 	if err == nil {
 		return
 	}
 	
+	// 요청 context 기한 초과 (스토리지, Claude 호출 등)
+	if apierrors.IsDeadlineExceeded(err) {
+		DeadlineExceededError(c)
+		return
+	}
+	
+	// 회로가 열린 외부 의존성 (Redis, 스토리지, Git 호스트 등)
+	var openErr *breaker.OpenError
+	if errors.As(err, &openErr) {
+		DependencyUnavailableError(c, openErr)
+		return
+	}
+	
 	// WorkspaceError 타입 확인
 	var workspaceErr *apierrors.WorkspaceError
 	if errors.As(err, &workspaceErr) {
 		statusCode := getHTTPStatusFromWorkspaceError(workspaceErr)
-		AbortWithError(c, statusCode, workspaceErr.Code, workspaceErr.Message, nil)
+		var details interface{}
+		if workspaceErr.Code == apierrors.ErrCodeVersionConflict {
+			// 클라이언트가 최신 버전을 다시 읽고 재시도할 수 있도록 현재 버전 전달
+			details = workspaceErr.Details
+		}
+		AbortWithError(c, statusCode, workspaceErr.Code, workspaceErr.Message, details)
 		return
 	}
 	
@@ -262,8 +303,12 @@ func getHTTPStatusFromWorkspaceError(err *apierrors.WorkspaceError) int {
 		return http.StatusForbidden
 	case apierrors.ErrCodeMaxWorkspaces, apierrors.ErrCodeNotActive, apierrors.ErrCodeArchived:
 		return http.StatusBadRequest
-	case apierrors.ErrCodeResourceBusy, apierrors.ErrCodeDependencyExists:
+	case apierrors.ErrCodeResourceBusy, apierrors.ErrCodeDependencyExists, apierrors.ErrCodeVersionConflict:
 		return http.StatusConflict
+	case apierrors.ErrCodeDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case apierrors.ErrCodeDependencyUnavailable:
+		return http.StatusServiceUnavailable
 	default:
 		return http.StatusInternalServerError
 	}
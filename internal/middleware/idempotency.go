@@ -0,0 +1,288 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IdempotencyKeyHeader는 멱등성 키를 전달하는 요청 헤더입니다.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader는 저장된 응답이 재전송되었음을 알리는 응답 헤더입니다.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// IdempotencyRecord는 멱등성 키에 대해 저장된 요청/응답 정보입니다.
+type IdempotencyRecord struct {
+	// RequestHash는 메서드, 경로와 쿼리 문자열, 본문으로 계산한 해시입니다.
+	RequestHash string
+
+	// Completed는 응답이 저장되었는지 여부입니다 (false면 처리 중).
+	Completed bool
+
+	// StatusCode는 저장된 응답 상태 코드입니다.
+	StatusCode int
+
+	// ContentType은 저장된 응답의 Content-Type입니다.
+	ContentType string
+
+	// Body는 저장된 응답 본문입니다.
+	Body []byte
+
+	// ExpiresAt은 레코드 만료 시간입니다.
+	ExpiresAt time.Time
+}
+
+// IdempotencyStore는 멱등성 레코드 저장소 인터페이스입니다.
+type IdempotencyStore interface {
+	// Reserve는 키를 처리 중 상태로 선점합니다. 이미 레코드가 있으면 기존 레코드와 false를 반환합니다.
+	Reserve(key string, record *IdempotencyRecord) (*IdempotencyRecord, bool)
+
+	// Complete는 처리 결과를 저장합니다.
+	Complete(key string, record *IdempotencyRecord)
+
+	// Release는 처리 실패 시 선점을 해제합니다.
+	Release(key string)
+}
+
+// MemoryIdempotencyStore는 메모리 기반 멱등성 저장소입니다.
+// 만료된 레코드는 요청 처리 중이 아니라 백그라운드에서 주기적으로 정리합니다.
+type MemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]*IdempotencyRecord
+
+	cleanupInterval time.Duration
+	stopCleanup     chan struct{}
+	closeOnce       sync.Once
+}
+
+// NewMemoryIdempotencyStore는 새로운 메모리 멱등성 저장소를 생성합니다.
+func NewMemoryIdempotencyStore() *MemoryIdempotencyStore {
+	s := &MemoryIdempotencyStore{
+		records:         make(map[string]*IdempotencyRecord),
+		cleanupInterval: 10 * time.Minute,
+		stopCleanup:     make(chan struct{}),
+	}
+
+	// 백그라운드 정리 작업 시작
+	go s.cleanupLoop()
+
+	return s
+}
+
+// Reserve는 키를 선점합니다.
+func (s *MemoryIdempotencyStore) Reserve(key string, record *IdempotencyRecord) (*IdempotencyRecord, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.records[key]; ok && time.Now().Before(existing.ExpiresAt) {
+		copied := *existing
+		return &copied, false
+	}
+
+	s.records[key] = record
+	return nil, true
+}
+
+// Complete는 처리 결과를 저장합니다.
+func (s *MemoryIdempotencyStore) Complete(key string, record *IdempotencyRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[key] = record
+}
+
+// Release는 선점을 해제합니다.
+func (s *MemoryIdempotencyStore) Release(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+}
+
+// Close는 백그라운드 정리 작업을 중지합니다.
+func (s *MemoryIdempotencyStore) Close() error {
+	s.closeOnce.Do(func() { close(s.stopCleanup) })
+	return nil
+}
+
+// cleanupLoop는 주기적으로 만료된 레코드를 정리합니다.
+func (s *MemoryIdempotencyStore) cleanupLoop() {
+	ticker := time.NewTicker(s.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.cleanup()
+		case <-s.stopCleanup:
+			return
+		}
+	}
+}
+
+// cleanup은 만료된 레코드를 제거합니다.
+func (s *MemoryIdempotencyStore) cleanup() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, r := range s.records {
+		if now.After(r.ExpiresAt) {
+			delete(s.records, k)
+		}
+	}
+}
+
+// IdempotencyConfig는 멱등성 미들웨어 설정입니다.
+type IdempotencyConfig struct {
+	// Store는 레코드 저장소입니다.
+	Store IdempotencyStore
+
+	// TTL은 저장된 응답의 유지 기간입니다.
+	TTL time.Duration
+
+	// Methods는 멱등성을 적용할 HTTP 메서드 목록입니다.
+	Methods []string
+
+	// MaxKeyLength는 허용되는 키의 최대 길이입니다.
+	MaxKeyLength int
+
+	// MaxBodySize는 키를 붙인 요청 본문의 최대 크기입니다 (본문 해시를 위해 메모리에 읽음, 0이면 무제한).
+	MaxBodySize int64
+
+	// ScopeFunc는 키의 범위를 구분하는 값(사용자 등)을 반환합니다.
+	ScopeFunc func(*gin.Context) string
+}
+
+// DefaultIdempotencyConfig는 기본 멱등성 설정을 반환합니다.
+func DefaultIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		Store:        NewMemoryIdempotencyStore(),
+		TTL:          24 * time.Hour,
+		Methods:      []string{http.MethodPost, http.MethodPut, http.MethodPatch},
+		MaxKeyLength: 255,
+		MaxBodySize:  10 << 20,
+		ScopeFunc:    defaultIdempotencyScope,
+	}
+}
+
+// Idempotency는 Idempotency-Key 헤더 기반으로 중복 요청을 방지하는 미들웨어입니다.
+// 같은 키로 재시도된 요청에는 처음 저장된 응답을 그대로 반환합니다.
+func Idempotency(config *IdempotencyConfig) gin.HandlerFunc {
+	if config == nil {
+		config = DefaultIdempotencyConfig()
+	}
+
+	methods := make(map[string]bool, len(config.Methods))
+	for _, m := range config.Methods {
+		methods[m] = true
+	}
+
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || !methods[c.Request.Method] {
+			c.Next()
+			return
+		}
+
+		if config.MaxKeyLength > 0 && len(key) > config.MaxKeyLength {
+			ValidationError(c, "Idempotency-Key가 너무 깁니다", gin.H{"max_length": config.MaxKeyLength})
+			return
+		}
+
+		// 요청 본문 해시 계산 (본문은 다시 읽을 수 있도록 복원)
+		reader := io.Reader(c.Request.Body)
+		if config.MaxBodySize > 0 {
+			reader = io.LimitReader(c.Request.Body, config.MaxBodySize+1)
+		}
+		body, err := io.ReadAll(reader)
+		if err != nil {
+			BadRequestError(c, "요청 본문을 읽을 수 없습니다")
+			return
+		}
+		if config.MaxBodySize > 0 && int64(len(body)) > config.MaxBodySize {
+			AbortWithError(c, http.StatusRequestEntityTooLarge, ErrBadRequest,
+				"Idempotency-Key를 사용할 수 있는 요청 본문 크기를 초과했습니다", gin.H{"max_body_size": config.MaxBodySize})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		hasher := sha256.New()
+		hasher.Write([]byte(c.Request.Method))
+		hasher.Write([]byte(c.Request.URL.RequestURI()))
+		hasher.Write(body)
+		requestHash := hex.EncodeToString(hasher.Sum(nil))
+
+		storeKey := key
+		if config.ScopeFunc != nil {
+			storeKey = config.ScopeFunc(c) + ":" + key
+		}
+
+		existing, reserved := config.Store.Reserve(storeKey, &IdempotencyRecord{
+			RequestHash: requestHash,
+			ExpiresAt:   time.Now().Add(config.TTL),
+		})
+		if !reserved {
+			switch {
+			case existing.RequestHash != requestHash:
+				AbortWithError(c, http.StatusUnprocessableEntity, ErrConflict,
+					"같은 Idempotency-Key가 다른 요청에 사용되었습니다", nil)
+			case !existing.Completed:
+				AbortWithError(c, http.StatusConflict, ErrConflict,
+					"같은 Idempotency-Key의 요청이 처리 중입니다", nil)
+			default:
+				c.Header(IdempotentReplayedHeader, "true")
+				c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+				c.Abort()
+			}
+			return
+		}
+
+		// 핸들러가 패닉으로 끝나도 (Recovery가 복구) 키가 처리 중으로 남지 않도록 선점 해제
+		settled := false
+		defer func() {
+			if !settled {
+				config.Store.Release(storeKey)
+			}
+		}()
+
+		writer := &responseWriter{
+			ResponseWriter: c.Writer,
+			body:           &bytes.Buffer{},
+		}
+		c.Writer = writer
+
+		c.Next()
+
+		// 서버 오류는 저장하지 않아 재시도가 가능하도록 함
+		status := c.Writer.Status()
+		if status >= http.StatusInternalServerError {
+			return
+		}
+
+		settled = true
+		config.Store.Complete(storeKey, &IdempotencyRecord{
+			RequestHash: requestHash,
+			Completed:   true,
+			StatusCode:  status,
+			ContentType: c.Writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+			ExpiresAt:   time.Now().Add(config.TTL),
+		})
+	}
+}
+
+// defaultIdempotencyScope는 인증 헤더로 키 범위를 구분합니다.
+func defaultIdempotencyScope(c *gin.Context) string {
+	auth := c.GetHeader("Authorization")
+	if auth == "" {
+		return c.ClientIP()
+	}
+	sum := sha256.Sum256([]byte(auth))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newIdempotencyRouter(calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Idempotency(DefaultIdempotencyConfig()))
+	router.POST("/tasks", func(c *gin.Context) {
+		*calls++
+		c.JSON(http.StatusCreated, gin.H{"call": *calls})
+	})
+	return router
+}
+
+func doIdempotentRequest(router *gin.Engine, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotency_ReplaysResponse(t *testing.T) {
+	calls := 0
+	router := newIdempotencyRouter(&calls)
+
+	first := doIdempotentRequest(router, "key-1", `{"command":"ls"}`)
+	second := doIdempotentRequest(router, "key-1", `{"command":"ls"}`)
+
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotency_KeyReusedWithDifferentBody(t *testing.T) {
+	calls := 0
+	router := newIdempotencyRouter(&calls)
+
+	doIdempotentRequest(router, "key-1", `{"command":"ls"}`)
+	w := doIdempotentRequest(router, "key-1", `{"command":"pwd"}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotency_KeyReusedWithDifferentQuery(t *testing.T) {
+	calls := 0
+	router := newIdempotencyRouter(&calls)
+
+	doIdempotentRequest(router, "key-1", `{"command":"ls"}`)
+
+	req := httptest.NewRequest(http.MethodPost, "/tasks?force=true", strings.NewReader(`{"command":"ls"}`))
+	req.Header.Set(IdempotencyKeyHeader, "key-1")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotency_WithoutKey(t *testing.T) {
+	calls := 0
+	router := newIdempotencyRouter(&calls)
+
+	doIdempotentRequest(router, "", `{}`)
+	doIdempotentRequest(router, "", `{}`)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotency_ServerErrorNotStored(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	calls := 0
+	router := gin.New()
+	router.Use(Idempotency(DefaultIdempotencyConfig()))
+	router.POST("/tasks", func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusInternalServerError, gin.H{})
+	})
+
+	doIdempotentRequest(router, "key-1", `{}`)
+	doIdempotentRequest(router, "key-1", `{}`)
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotency_PanicReleasesKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	calls := 0
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.Use(Idempotency(DefaultIdempotencyConfig()))
+	router.POST("/tasks", func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			panic("handler failed")
+		}
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+
+	first := doIdempotentRequest(router, "key-1", `{}`)
+	second := doIdempotentRequest(router, "key-1", `{}`)
+
+	assert.Equal(t, http.StatusInternalServerError, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIdempotency_BodyTooLarge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	calls := 0
+
+	config := DefaultIdempotencyConfig()
+	config.MaxBodySize = 8
+	router := gin.New()
+	router.Use(Idempotency(config))
+	router.POST("/tasks", func(c *gin.Context) {
+		calls++
+		c.Status(http.StatusCreated)
+	})
+
+	w := doIdempotentRequest(router, "key-1", `{"command":"ls"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, 0, calls)
+
+	// 키가 없으면 크기 제한을 적용하지 않음
+	w = doIdempotentRequest(router, "", `{"command":"ls"}`)
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestMemoryIdempotencyStore_Cleanup(t *testing.T) {
+	store := NewMemoryIdempotencyStore()
+	defer store.Close()
+
+	_, reserved := store.Reserve("expired", &IdempotencyRecord{ExpiresAt: time.Now().Add(-time.Minute)})
+	assert.True(t, reserved)
+	_, reserved = store.Reserve("live", &IdempotencyRecord{ExpiresAt: time.Now().Add(time.Hour)})
+	assert.True(t, reserved)
+
+	store.cleanup()
+	assert.Len(t, store.records, 1)
+	assert.Contains(t, store.records, "live")
+}
@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// LanguageResolver는 사용자가 설정한 메시지 언어를 제공합니다 (설정하지 않았으면 빈 값).
+type LanguageResolver interface {
+	PreferredLanguage(ctx context.Context, userID string) validation.Language
+}
+
+const (
+	languageResolverKey = "language_resolver"
+	languageKey         = "language"
+)
+
+// Localization은 에러 응답을 사용자 메시지 언어로 번역할 수 있도록 언어 설정 제공자를 연결하는 미들웨어입니다.
+// 인증 미들웨어보다 먼저 실행되므로 언어는 응답을 만들 때 GetLanguage에서 정합니다.
+func Localization(resolver LanguageResolver) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(languageResolverKey, resolver)
+		c.Next()
+	}
+}
+
+// GetLanguage는 요청의 메시지 언어를 반환합니다.
+// 인증된 사용자가 메시지 언어를 설정했으면 그 언어를, 아니면 Accept-Language 헤더로 정한 언어를 씁니다.
+func GetLanguage(c *gin.Context) validation.Language {
+	if lang, exists := c.Get(languageKey); exists {
+		return lang.(validation.Language)
+	}
+	if c.Request == nil {
+		return validation.LanguageKorean
+	}
+
+	userID := c.GetString("user_id")
+	if value, exists := c.Get(languageResolverKey); exists && userID != "" {
+		if resolver, ok := value.(LanguageResolver); ok {
+			if lang := resolver.PreferredLanguage(c.Request.Context(), userID); lang != "" {
+				c.Set(languageKey, lang)
+				return lang
+			}
+		}
+	}
+
+	lang := validation.GetLanguageFromContext(c.GetHeader("Accept-Language"))
+	if userID != "" {
+		// 인증 뒤에는 사용자 설정이 바뀌지 않으므로 다시 조회하지 않음
+		c.Set(languageKey, lang)
+	}
+	return lang
+}
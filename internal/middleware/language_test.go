@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// staticLanguages 사용자 ID별 메시지 언어
+type staticLanguages map[string]validation.Language
+
+func (l staticLanguages) PreferredLanguage(ctx context.Context, userID string) validation.Language {
+	return l[userID]
+}
+
+func newLanguageRouter(userID string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Localization(staticLanguages{"user-en": validation.LanguageEnglish}))
+	router.GET("/sessions/:id", func(c *gin.Context) {
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		NotFoundError(c, "세션을 찾을 수 없습니다")
+	})
+	return router
+}
+
+func doLanguageRequest(t *testing.T, router *gin.Engine, acceptLanguage string) string {
+	req := httptest.NewRequest(http.MethodGet, "/sessions/s1", nil)
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	var response ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, ErrNotFound, response.Error.Code)
+	return response.Error.Message
+}
+
+func TestLocalization_ErrorMessages(t *testing.T) {
+	// 기본은 한국어, Accept-Language로 영어 선택
+	assert.Equal(t, "세션을 찾을 수 없습니다", doLanguageRequest(t, newLanguageRouter(""), ""))
+	assert.Equal(t, "Session not found", doLanguageRequest(t, newLanguageRouter(""), "en-US,en;q=0.9"))
+	assert.Equal(t, "세션을 찾을 수 없습니다", doLanguageRequest(t, newLanguageRouter(""), "ko-KR,ko;q=0.9,en;q=0.8"))
+
+	// 사용자 설정이 Accept-Language보다 우선
+	assert.Equal(t, "Session not found", doLanguageRequest(t, newLanguageRouter("user-en"), "ko-KR"))
+
+	// 설정하지 않은 사용자는 Accept-Language를 따름
+	assert.Equal(t, "세션을 찾을 수 없습니다", doLanguageRequest(t, newLanguageRouter("user-none"), "ko-KR"))
+	assert.Equal(t, "Session not found", doLanguageRequest(t, newLanguageRouter("user-none"), "en"))
+}
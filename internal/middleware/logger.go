@@ -3,9 +3,13 @@ package middleware
 import (
 	"fmt"
 	"log"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // Logger는 요청/응답 로깅 미들웨어를 반환합니다.
@@ -15,7 +19,7 @@ func Logger() gin.HandlerFunc {
 		log.Printf(`{"time":"%s","method":"%s","path":"%s","protocol":"%s","status":%d,"latency":"%s","client_ip":"%s","user_agent":"%s","error":"%s"}`,
 			param.TimeStamp.Format(time.RFC3339),
 			param.Method,
-			param.Path,
+			redactPath(param.Path),
 			param.Request.Proto,
 			param.StatusCode,
 			param.Latency.String(),
@@ -44,7 +48,7 @@ func RequestLogger() gin.HandlerFunc {
 			requestID,
 			c.Request.Method,
 			c.Request.URL.Path,
-			c.Request.URL.RawQuery,
+			redactQuery(c.Request.URL.RawQuery),
 			c.ClientIP(),
 			c.Request.UserAgent(),
 		)
@@ -74,4 +78,80 @@ func RequestLogger() gin.HandlerFunc {
 			}
 		}
 	}
-}
\ No newline at end of file
+}
+
+// AccessLogger는 요청과 응답을 기록하는 미들웨어입니다.
+// 요청 시작은 debug, 처리 결과는 상태 코드에 따라 info(2xx/3xx), warn(4xx), error(5xx) 레벨로 기록하므로
+// 로거 레벨로 기록할 양을 실행 중에 조절할 수 있습니다. Logger와 RequestLogger를 함께 대신합니다.
+// 처리 결과에는 요청 컨텍스트의 요청 ID, 세션 ID, 워크스페이스 ID가 함께 기록됩니다.
+func AccessLogger(logger logging.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		fields := logging.Fields{
+			"method": c.Request.Method,
+			"path":   c.Request.URL.Path,
+		}
+		logger.WithContext(c.Request.Context()).WithFields(fields).WithFields(logging.Fields{
+			"query":      redactQuery(c.Request.URL.RawQuery),
+			"client_ip":  c.ClientIP(),
+			"user_agent": c.Request.UserAgent(),
+		}).Debug("요청 시작")
+
+		c.Next()
+
+		// 핸들러 체인에서 요청 컨텍스트에 추가한 세션, 워크스페이스 ID까지 기록
+		entry := logger.WithContext(c.Request.Context()).WithFields(fields).WithFields(logging.Fields{
+			"status":        c.Writer.Status(),
+			"latency":       time.Since(start).String(),
+			"client_ip":     c.ClientIP(),
+			"response_size": c.Writer.Size(),
+		})
+		if len(c.Errors) > 0 {
+			entry = entry.WithField("errors", c.Errors.String())
+		}
+
+		switch status := c.Writer.Status(); {
+		case status >= 500:
+			entry.Error("요청 처리 실패")
+		case status >= 400:
+			entry.Warn("요청 거부")
+		default:
+			entry.Info("요청 처리 완료")
+		}
+	}
+}
+
+// sensitiveQueryParams 로그에 값을 남기지 않을 쿼리 파라미터 (WebSocket 인증 토큰 등)
+var sensitiveQueryParams = []string{"token", "access_token"}
+
+// redactQuery 쿼리 문자열에서 민감한 파라미터 값을 가립니다
+func redactQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return rawQuery
+	}
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		// 해석할 수 없는 쿼리는 토큰이 섞여 있을 수 있으므로 남기지 않음
+		return "[REDACTED]"
+	}
+	redacted := false
+	for _, name := range sensitiveQueryParams {
+		if _, ok := values[name]; ok {
+			values.Set(name, "REDACTED")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return rawQuery
+	}
+	return values.Encode()
+}
+
+// redactPath 쿼리가 붙은 경로에서 민감한 파라미터 값을 가립니다
+func redactPath(path string) string {
+	p, rawQuery, ok := strings.Cut(path, "?")
+	if !ok {
+		return path
+	}
+	return p + "?" + redactQuery(rawQuery)
+}
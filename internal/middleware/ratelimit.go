@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -35,11 +36,15 @@ type RateLimitConfig struct {
 	
 	// SkipFailedRequests는 실패한 요청을 Rate Limit에서 제외할지 여부입니다.
 	SkipFailedRequests bool
+	
+	// Skip은 Rate Limit을 적용하지 않을 요청인지 판단합니다 (nil이면 모든 요청에 적용).
+	Skip func(*gin.Context) bool
 }
 
 // RateLimitMiddleware는 Rate Limit 미들웨어 구조체입니다.
 type RateLimitMiddleware struct {
 	config      *RateLimitConfig
+	enabled     atomic.Bool
 	defaultLimiter *ratelimit.MemoryLimiter
 	authLimiter    *ratelimit.MemoryLimiter
 	endpointLimiters map[string]*ratelimit.MemoryLimiter
@@ -88,6 +93,7 @@ func NewRateLimitMiddleware(config *RateLimitConfig) *RateLimitMiddleware {
 		authLimiter: ratelimit.NewMemoryLimiter(config.AuthenticatedConfig),
 		endpointLimiters: make(map[string]*ratelimit.MemoryLimiter),
 	}
+	rlm.enabled.Store(config.Enabled)
 	
 	// 엔드포인트별 limiter 생성
 	for endpoint, endpointConfig := range config.EndpointConfigs {
@@ -107,7 +113,13 @@ func RateLimit(config *RateLimitConfig) gin.HandlerFunc {
 func (rlm *RateLimitMiddleware) Handler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Rate Limiting이 비활성화된 경우
-		if !rlm.config.Enabled {
+		if !rlm.enabled.Load() {
+			c.Next()
+			return
+		}
+		
+		// 제외할 요청 확인
+		if rlm.config.Skip != nil && rlm.config.Skip(c) {
 			c.Next()
 			return
 		}
@@ -143,6 +155,22 @@ func (rlm *RateLimitMiddleware) Handler() gin.HandlerFunc {
 	}
 }
 
+// SetEnabled는 실행 중에 Rate Limiting을 켜거나 끕니다.
+func (rlm *RateLimitMiddleware) SetEnabled(enabled bool) {
+	rlm.enabled.Store(enabled)
+}
+
+// SetLimits는 실행 중에 기본 및 인증 사용자 제한을 변경합니다 (nil이면 해당 설정 유지).
+// 엔드포인트별 제한은 코드에 고정된 보안 설정이므로 변경하지 않습니다.
+func (rlm *RateLimitMiddleware) SetLimits(defaultConfig, authenticatedConfig *ratelimit.LimiterConfig) {
+	if defaultConfig != nil {
+		rlm.defaultLimiter.SetConfig(defaultConfig)
+	}
+	if authenticatedConfig != nil {
+		rlm.authLimiter.SetConfig(authenticatedConfig)
+	}
+}
+
 // isWhitelisted는 IP가 화이트리스트에 있는지 확인합니다.
 func (rlm *RateLimitMiddleware) isWhitelisted(c *gin.Context) bool {
 	clientIP := c.ClientIP()
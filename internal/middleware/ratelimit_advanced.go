@@ -14,6 +14,7 @@ import (
 	"golang.org/x/time/rate"
 	"go.uber.org/zap"
 
+	"github.com/aicli/aicli-web/internal/breaker"
 )
 
 // AdvancedRateLimitConfig는 고급 Rate Limiting 설정입니다.
@@ -21,6 +22,9 @@ type AdvancedRateLimitConfig struct {
 	// Redis 클라이언트
 	Redis redis.UniversalClient
 	
+	// Redis 회로 차단기 (Redis 클라이언트에 훅으로 등록하며, 열려 있으면 로컬 제한으로 대체)
+	Breaker *breaker.Breaker
+	
 	// 기본 설정
 	GlobalRateLimit    int           // 전역 초당 요청 제한
 	UserRateLimit      int           // 사용자별 초당 요청 제한
@@ -51,6 +55,7 @@ type AdvancedRateLimitConfig struct {
 type AdvancedRateLimiter struct {
 	config     *AdvancedRateLimitConfig
 	redis      redis.UniversalClient
+	breaker    *breaker.Breaker
 	logger     *zap.Logger
 	
 	// 로컬 rate limiter (fallback)
@@ -85,10 +90,15 @@ func NewAdvancedRateLimiter(config *AdvancedRateLimitConfig) *AdvancedRateLimite
 	limiter := &AdvancedRateLimiter{
 		config:       config,
 		redis:        config.Redis,
+		breaker:      config.Breaker,
 		logger:       config.Logger,
 		localLimiter: rate.NewLimiter(rate.Limit(config.GlobalRateLimit), int(float64(config.GlobalRateLimit)*config.BurstMultiplier)),
 	}
 
+	if limiter.redis != nil && limiter.breaker != nil {
+		limiter.redis.AddHook(breaker.NewRedisHook(limiter.breaker))
+	}
+
 	// 정기적인 정리 작업 시작
 	go limiter.cleanupExpiredEntries()
 
@@ -104,8 +114,8 @@ func AdvancedRateLimit(config *AdvancedRateLimitConfig) gin.HandlerFunc {
 // Handler는 미들웨어 핸들러를 반환합니다.
 func (arl *AdvancedRateLimiter) Handler() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Redis 연결 확인
-		if arl.redis == nil {
+		// Redis 연결 확인 (Redis 회로가 열려 있으면 로컬 제한으로 대체)
+		if arl.redis == nil || (arl.breaker != nil && !arl.breaker.Ready()) {
 			// Fallback to local limiter
 			if !arl.localLimiter.Allow() {
 				arl.handleRateLimitExceeded(c, "global", time.Now().Add(time.Minute))
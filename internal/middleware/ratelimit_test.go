@@ -373,4 +373,53 @@ func TestRateLimitMiddleware_Stats(t *testing.T) {
 	endpointStats, ok := stats["endpoints"].(map[string]interface{})
 	assert.True(t, ok)
 	assert.Contains(t, endpointStats, "/api/v1/auth/login")
-}
\ No newline at end of file
+}
+func TestRateLimitMiddleware_Reconfigure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	
+	config := &RateLimitConfig{
+		Enabled: true,
+		DefaultConfig: &ratelimit.LimiterConfig{
+			Rate:   60,
+			Burst:  1,
+			Window: 60,
+		},
+		AuthenticatedConfig: &ratelimit.LimiterConfig{
+			Rate:   300,
+			Burst:  10,
+			Window: 60,
+		},
+		EndpointConfigs: make(map[string]*ratelimit.LimiterConfig),
+		KeyGenerator:    defaultKeyGenerator,
+	}
+	
+	rlm := NewRateLimitMiddleware(config)
+	defer rlm.Close()
+	
+	router := gin.New()
+	router.Use(rlm.Handler())
+	router.GET("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "ok"})
+	})
+	
+	request := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+		return w
+	}
+	
+	assert.Equal(t, http.StatusOK, request().Code)
+	assert.Equal(t, http.StatusTooManyRequests, request().Code)
+	
+	// 제한을 늘리면 다음 요청부터 새 설정 적용
+	rlm.SetLimits(&ratelimit.LimiterConfig{Rate: 120, Burst: 3, Window: 60}, nil)
+	w := request()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "120", w.Header().Get("X-RateLimit-Limit"))
+	
+	// 비활성화하면 제한 없이 통과
+	rlm.SetEnabled(false)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, request().Code)
+	}
+}
@@ -123,7 +123,7 @@ func GracefulRecovery() gin.HandlerFunc {
 			"request_id":  requestID,
 			"method":      c.Request.Method,
 			"path":        c.Request.URL.Path,
-			"query":       c.Request.URL.RawQuery,
+			"query":       redactQuery(c.Request.URL.RawQuery),
 			"client_ip":   c.ClientIP(),
 			"user_agent":  c.Request.UserAgent(),
 			"headers":     c.Request.Header,
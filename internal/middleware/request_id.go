@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // RequestID는 각 요청에 고유한 ID를 부여하는 미들웨어입니다.
@@ -20,6 +22,7 @@ func RequestID() gin.HandlerFunc {
 		
 		// 컨텍스트에 저장
 		c.Set("request_id", requestID)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), requestID))
 		
 		// 응답 헤더에 추가
 		c.Header("X-Request-ID", requestID)
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"strings"
 
@@ -28,6 +30,7 @@ type SecurityHeadersConfig struct {
 	CSPSandbox       []string // sandbox 지시문
 	CSPReportURI     string   // report-uri 지시문
 	CSPReportOnly    bool     // Report-Only 모드 활성화
+	CSPNonce         bool     // 요청마다 nonce를 만들어 script-src, style-src에 추가
 	
 	// X-Frame-Options 설정
 	FrameOptions string // DENY, SAMEORIGIN, ALLOW-FROM uri
@@ -58,6 +61,12 @@ type SecurityHeadersConfig struct {
 	Logger *zap.Logger
 }
 
+// CSP nonce를 전달하는 컨텍스트 키와 응답 헤더
+const (
+	CSPNonceContextKey = "csp_nonce"
+	CSPNonceHeader     = "X-CSP-Nonce"
+)
+
 // SecurityHeaders는 보안 헤더 미들웨어입니다.
 type SecurityHeaders struct {
 	config *SecurityHeadersConfig
@@ -208,8 +217,28 @@ func (sh *SecurityHeaders) setHSTSHeader(c *gin.Context) {
 }
 
 // setCSPHeader는 Content Security Policy 헤더를 설정합니다.
+// nonce를 사용하면 템플릿은 컨텍스트(GetCSPNonce)에서, 프론트엔드는 X-CSP-Nonce 응답 헤더에서 nonce를 읽습니다.
+// nonce는 script-src, style-src를 지정했을 때만 추가하며, nonce를 지원하는 브라우저는 'unsafe-inline'을 무시합니다.
 func (sh *SecurityHeaders) setCSPHeader(c *gin.Context) {
 	cspDirectives := make([]string, 0)
+	scriptSrc := sh.config.CSPScriptSrc
+	styleSrc := sh.config.CSPStyleSrc
+	
+	if sh.config.CSPNonce {
+		if nonce, err := generateCSPNonce(); err == nil {
+			source := fmt.Sprintf("'nonce-%s'", nonce)
+			if len(scriptSrc) > 0 {
+				scriptSrc = append(append([]string(nil), scriptSrc...), source)
+			}
+			if len(styleSrc) > 0 {
+				styleSrc = append(append([]string(nil), styleSrc...), source)
+			}
+			c.Set(CSPNonceContextKey, nonce)
+			c.Header(CSPNonceHeader, nonce)
+		} else if sh.logger != nil {
+			sh.logger.Warn("CSP nonce 생성 실패", zap.Error(err))
+		}
+	}
 	
 	// 각 CSP 지시문 설정
 	if len(sh.config.CSPDefaultSrc) > 0 {
@@ -217,14 +246,14 @@ func (sh *SecurityHeaders) setCSPHeader(c *gin.Context) {
 			fmt.Sprintf("default-src %s", strings.Join(sh.config.CSPDefaultSrc, " ")))
 	}
 	
-	if len(sh.config.CSPScriptSrc) > 0 {
+	if len(scriptSrc) > 0 {
 		cspDirectives = append(cspDirectives,
-			fmt.Sprintf("script-src %s", strings.Join(sh.config.CSPScriptSrc, " ")))
+			fmt.Sprintf("script-src %s", strings.Join(scriptSrc, " ")))
 	}
 	
-	if len(sh.config.CSPStyleSrc) > 0 {
+	if len(styleSrc) > 0 {
 		cspDirectives = append(cspDirectives,
-			fmt.Sprintf("style-src %s", strings.Join(sh.config.CSPStyleSrc, " ")))
+			fmt.Sprintf("style-src %s", strings.Join(styleSrc, " ")))
 	}
 	
 	if len(sh.config.CSPImgSrc) > 0 {
@@ -279,6 +308,20 @@ func (sh *SecurityHeaders) setCSPHeader(c *gin.Context) {
 	}
 }
 
+// GetCSPNonce는 컨텍스트에서 현재 요청의 CSP nonce를 가져옵니다 (nonce를 사용하지 않으면 빈 문자열).
+func GetCSPNonce(c *gin.Context) string {
+	return c.GetString(CSPNonceContextKey)
+}
+
+// generateCSPNonce는 128비트 난수를 base64로 인코딩한 nonce를 생성합니다.
+func generateCSPNonce() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(bytes), nil
+}
+
 // setFrameOptionsHeader는 X-Frame-Options 헤더를 설정합니다.
 func (sh *SecurityHeaders) setFrameOptionsHeader(c *gin.Context) {
 	if sh.config.FrameOptions != "" {
@@ -378,6 +421,19 @@ func (b *SecurityHeadersBuilder) WithCSP(defaultSrc, scriptSrc, styleSrc []strin
 	return b
 }
 
+// WithCSPNonce는 요청별 CSP nonce 사용 여부를 설정합니다.
+func (b *SecurityHeadersBuilder) WithCSPNonce(enabled bool) *SecurityHeadersBuilder {
+	b.config.CSPNonce = enabled
+	return b
+}
+
+// WithCSPReport는 위반 보고 주소와 Report-Only 모드를 설정합니다.
+func (b *SecurityHeadersBuilder) WithCSPReport(reportURI string, reportOnly bool) *SecurityHeadersBuilder {
+	b.config.CSPReportURI = reportURI
+	b.config.CSPReportOnly = reportOnly
+	return b
+}
+
 // WithFrameOptions는 X-Frame-Options를 설정합니다.
 func (b *SecurityHeadersBuilder) WithFrameOptions(options string) *SecurityHeadersBuilder {
 	b.config.FrameOptions = options
@@ -453,6 +509,8 @@ func (sh *SecurityHeaders) GetSecurityHeadersInfo() map[string]interface{} {
 	
 	info["csp_enabled"] = len(sh.config.CSPDefaultSrc) > 0
 	info["csp_report_only"] = sh.config.CSPReportOnly
+	info["csp_nonce"] = sh.config.CSPNonce
+	info["csp_report_uri"] = sh.config.CSPReportURI
 	
 	info["frame_options"] = sh.config.FrameOptions
 	info["content_type_nosniff"] = sh.config.ContentTypeNosniff
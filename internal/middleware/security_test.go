@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
+	"github.com/aicli/aicli-web/internal/breaker"
+	"github.com/aicli/aicli-web/internal/logging"
 	"github.com/aicli/aicli-web/internal/security"
 )
 
@@ -95,6 +98,56 @@ func TestAdvancedRateLimit(t *testing.T) {
 	}
 }
 
+// TestAdvancedRateLimit_RedisBreakerOpen은 Redis 회로가 열렸을 때 로컬 제한으로 대체하는지 테스트합니다.
+func TestAdvancedRateLimit_RedisBreakerOpen(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	defer rdb.Close()
+
+	redisBreaker := breaker.New("redis:test", breaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute})
+	_ = redisBreaker.Do(context.Background(), func(context.Context) error { return assert.AnError })
+	require.Equal(t, breaker.StateOpen, redisBreaker.State())
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(AdvancedRateLimit(&AdvancedRateLimitConfig{
+		Redis:           rdb,
+		Breaker:         redisBreaker,
+		GlobalRateLimit: 1,
+		BurstMultiplier: 1,
+		Logger:          zap.NewNop(),
+	}))
+	r.GET("/test", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "success"})
+	})
+
+	statuses := make([]int, 0, 2)
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+		statuses = append(statuses, w.Code)
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusTooManyRequests}, statuses)
+}
+
+// TestHandleServiceError_DependencyUnavailable은 회로가 열린 의존성 에러를 503으로 응답하는지 테스트합니다.
+func TestHandleServiceError_DependencyUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/test", func(c *gin.Context) {
+		HandleServiceError(c, fmt.Errorf("조회 실패: %w", &breaker.OpenError{Name: "git_host:api.github.com", RetryAfter: 1500 * time.Millisecond}))
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+
+	var response ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "DEPENDENCY_UNAVAILABLE", response.Error.Code)
+	assert.Equal(t, map[string]interface{}{"dependency": "git_host:api.github.com"}, response.Error.Details)
+}
+
 // TestCSRFProtection는 CSRF 보호 테스트입니다.
 func TestCSRFProtection(t *testing.T) {
 	config := DefaultCSRFConfig()
@@ -205,6 +258,43 @@ func TestSecurityHeaders(t *testing.T) {
 	assert.Contains(t, headers.Get("Permissions-Policy"), "geolocation=('none')")
 }
 
+// TestSecurityHeaders_CSPNonce는 요청별 CSP nonce와 Report-Only 모드 테스트입니다.
+func TestSecurityHeaders_CSPNonce(t *testing.T) {
+	config := DefaultSecurityHeadersConfig()
+	config.CSPNonce = true
+	config.CSPReportURI = "/api/v1/csp-report"
+	config.CSPReportOnly = true
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(SecurityHeadersMiddleware(config))
+	r.GET("/test", func(c *gin.Context) {
+		c.String(http.StatusOK, GetCSPNonce(c))
+	})
+
+	nonces := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		nonce := w.Header().Get(CSPNonceHeader)
+		require.NotEmpty(t, nonce)
+		assert.Equal(t, nonce, w.Body.String())
+		nonces[nonce] = true
+
+		assert.Empty(t, w.Header().Get("Content-Security-Policy"))
+		policy := w.Header().Get("Content-Security-Policy-Report-Only")
+		assert.Contains(t, policy, fmt.Sprintf("script-src 'self' 'unsafe-inline' 'nonce-%s'", nonce))
+		assert.Contains(t, policy, fmt.Sprintf("style-src 'self' 'unsafe-inline' 'nonce-%s'", nonce))
+		assert.Contains(t, policy, "report-uri /api/v1/csp-report")
+	}
+	assert.Len(t, nonces, 2, "요청마다 다른 nonce")
+
+	// 설정의 지시문 목록은 바뀌지 않음
+	assert.Equal(t, []string{"'self'", "'unsafe-inline'"}, config.CSPScriptSrc)
+}
+
 // TestAuditLogging는 감사 로깅 테스트입니다.
 func TestAuditLogging(t *testing.T) {
 	config := DefaultAuditConfig()
@@ -247,7 +337,7 @@ func TestAuditLogging(t *testing.T) {
 // TestAttackDetection은 공격 탐지 테스트입니다.
 func TestAttackDetection(t *testing.T) {
 	config := security.DefaultAttackDetectorConfig()
-	config.Logger = zap.NewNop()
+	config.Logger = logging.Nop()
 
 	detector := security.NewAttackDetector(config)
 
@@ -333,7 +423,7 @@ func TestAttackDetection(t *testing.T) {
 // TestMetricsCollection은 메트릭 수집 테스트입니다.
 func TestMetricsCollection(t *testing.T) {
 	config := &security.MetricsCollectorConfig{
-		Logger:          zap.NewNop(),
+		Logger:          logging.Nop(),
 		CollectInterval: 100 * time.Millisecond,
 		RetentionPeriod: time.Hour,
 		BufferSize:      100,
@@ -432,7 +522,7 @@ func BenchmarkSecurityHeaders(b *testing.B) {
 // BenchmarkAttackDetection은 공격 탐지 성능 테스트입니다.
 func BenchmarkAttackDetection(b *testing.B) {
 	config := security.DefaultAttackDetectorConfig()
-	config.Logger = zap.NewNop()
+	config.Logger = logging.Nop()
 	detector := security.NewAttackDetector(config)
 
 	request := &security.AttackDetectionRequest{
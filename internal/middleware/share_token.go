@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+const (
+	// ShareTokenHeader 공유 토큰 요청 헤더
+	ShareTokenHeader = "X-Share-Token"
+
+	// ShareTokenQuery 공유 토큰 쿼리 파라미터 (WebSocket 연결처럼 헤더를 지정할 수 없는 경우)
+	ShareTokenQuery = "share_token"
+)
+
+// ShareTokenValidator 공유 토큰 검증 인터페이스
+type ShareTokenValidator interface {
+	// ValidateShareToken 토큰이 세션에 대해 유효하면 공유 링크 반환
+	ValidateShareToken(ctx context.Context, token, sessionID string) (*models.SessionShareLink, error)
+}
+
+// RequireShareToken 세션 공유 토큰 검증 미들웨어
+// 경로의 :id 세션에 대해 발급된, 만료되거나 폐기되지 않은 토큰만 허용하며
+// 읽기 전용 링크는 조회 요청(GET, HEAD)만 허용합니다.
+func RequireShareToken(validator ShareTokenValidator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(ShareTokenHeader)
+		if token == "" {
+			token = c.Query(ShareTokenQuery)
+		}
+		if token == "" {
+			UnauthorizedError(c, "공유 토큰이 필요합니다")
+			return
+		}
+
+		link, err := validator.ValidateShareToken(c.Request.Context(), token, c.Param("id"))
+		if err != nil {
+			AbortWithError(c, http.StatusUnauthorized, "INVALID_SHARE_LINK", "공유 링크가 유효하지 않습니다", err.Error())
+			return
+		}
+
+		if link.Scope == models.ShareScopeRead && c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			ForbiddenError(c, "읽기 전용 공유 링크입니다")
+			return
+		}
+
+		c.Set("share_link", link)
+		c.Set("share_scope", link.Scope)
+
+		c.Next()
+	}
+}
+
+// GetShareLink 컨텍스트에서 검증된 공유 링크 추출
+func GetShareLink(c *gin.Context) (*models.SessionShareLink, bool) {
+	value, exists := c.Get("share_link")
+	if !exists {
+		return nil, false
+	}
+	link, ok := value.(*models.SessionShareLink)
+	return link, ok
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+type fakeShareTokenValidator map[string]*models.SessionShareLink
+
+func (v fakeShareTokenValidator) ValidateShareToken(ctx context.Context, token, sessionID string) (*models.SessionShareLink, error) {
+	link, ok := v[token]
+	if !ok || link.SessionID != sessionID {
+		return nil, errors.New("invalid share link")
+	}
+	return link, nil
+}
+
+func TestRequireShareToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validator := fakeShareTokenValidator{
+		"read":        {SessionID: "s1", Scope: models.ShareScopeRead},
+		"interactive": {SessionID: "s1", Scope: models.ShareScopeInteractive},
+	}
+	router := gin.New()
+	shared := router.Group("/shared/:id", RequireShareToken(validator))
+	shared.GET("", func(c *gin.Context) { c.Status(http.StatusOK) })
+	shared.POST("", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		status int
+	}{
+		{"토큰 없음", http.MethodGet, "/shared/s1", "", http.StatusUnauthorized},
+		{"헤더 토큰", http.MethodGet, "/shared/s1", "read", http.StatusOK},
+		{"쿼리 토큰", http.MethodGet, "/shared/s1?share_token=read", "", http.StatusOK},
+		{"다른 세션", http.MethodGet, "/shared/s2", "read", http.StatusUnauthorized},
+		{"읽기 전용 링크로 입력", http.MethodPost, "/shared/s1", "read", http.StatusForbidden},
+		{"상호작용 링크로 입력", http.MethodPost, "/shared/s1", "interactive", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set(ShareTokenHeader, tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/aicli/aicli-web/internal/errors"
+)
+
+// RequestTimeoutHeader는 클라이언트가 요청 처리 시간 예산을 지정하는 요청 헤더입니다 (예: "30s", 초 단위 숫자).
+const RequestTimeoutHeader = "X-Request-Timeout"
+
+// requestTimeoutKey는 요청에 적용한 처리 시간 예산을 저장하는 컨텍스트 키입니다.
+const requestTimeoutKey = "request_timeout"
+
+// RequestTimeoutConfig는 요청 처리 시간 예산 미들웨어 설정입니다.
+type RequestTimeoutConfig struct {
+	// Default는 경로별 예산이 없는 요청의 기본 예산입니다 (0이면 기한 없음).
+	Default time.Duration
+
+	// MaxClient는 X-Request-Timeout 헤더로 요청할 수 있는 최대 예산입니다 (0이면 헤더 무시).
+	MaxClient time.Duration
+
+	// Routes는 경로별 예산입니다.
+	// 키는 "[메서드 ]경로 템플릿" 형식이며 대소문자를 구분하지 않고, 끝의 *는 접두사 일치입니다 (0이면 기한 없음).
+	Routes map[string]time.Duration
+}
+
+// routeBudget는 해석한 경로별 예산입니다.
+type routeBudget struct {
+	method string // 비어 있으면 모든 메서드
+	path   string
+	prefix bool
+	budget time.Duration
+}
+
+// matches는 규칙이 요청 메서드와 경로 템플릿에 해당하는지 확인합니다.
+func (r routeBudget) matches(method, route string) bool {
+	if r.method != "" && r.method != method {
+		return false
+	}
+	if r.prefix {
+		return strings.HasPrefix(route, r.path)
+	}
+	return route == r.path
+}
+
+// moreSpecific는 r이 other보다 구체적인 규칙인지 확인합니다 (정확한 일치, 긴 경로, 메서드 지정 순).
+func (r routeBudget) moreSpecific(other routeBudget) bool {
+	if r.prefix != other.prefix {
+		return !r.prefix
+	}
+	if len(r.path) != len(other.path) {
+		return len(r.path) > len(other.path)
+	}
+	return r.method != "" && other.method == ""
+}
+
+// requestTimeoutState는 설정에서 해석한 예산입니다 (설정을 바꿀 때 통째로 교체).
+type requestTimeoutState struct {
+	defaultBudget time.Duration
+	maxClient     time.Duration
+	routes        []routeBudget
+}
+
+// RequestTimeoutMiddleware는 요청마다 처리 시간 예산으로 context 기한을 정하는 미들웨어입니다.
+// 기한은 c.Request.Context()로 스토리지와 Claude 호출까지 전달되며, 기한을 넘긴 요청은 504 응답으로 끝납니다.
+// 핸들러를 별도 고루틴에서 실행하지 않으므로 핸들러는 요청 context를 따라 작업을 중단해야 합니다.
+type RequestTimeoutMiddleware struct {
+	state atomic.Pointer[requestTimeoutState]
+}
+
+// NewRequestTimeoutMiddleware는 새로운 요청 처리 시간 예산 미들웨어를 생성합니다.
+func NewRequestTimeoutMiddleware(config *RequestTimeoutConfig) *RequestTimeoutMiddleware {
+	m := &RequestTimeoutMiddleware{}
+	m.SetConfig(config)
+	return m
+}
+
+// SetConfig는 예산 설정을 교체합니다 (처리 중인 요청에는 영향 없음).
+func (m *RequestTimeoutMiddleware) SetConfig(config *RequestTimeoutConfig) {
+	state := &requestTimeoutState{}
+	if config != nil {
+		state.defaultBudget = config.Default
+		state.maxClient = config.MaxClient
+		for key, budget := range config.Routes {
+			state.routes = append(state.routes, parseRouteBudget(key, budget))
+		}
+	}
+	m.state.Store(state)
+}
+
+// Budget은 메서드와 경로 템플릿에 적용할 예산을 반환합니다 (0이면 기한 없음).
+func (m *RequestTimeoutMiddleware) Budget(method, route string) time.Duration {
+	return m.state.Load().budget(method, route)
+}
+
+// Handler는 gin 미들웨어 함수를 반환합니다.
+func (m *RequestTimeoutMiddleware) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 웹소켓과 SSE는 연결이 끝날 때까지 응답하므로 기한을 두지 않음
+		if isStreamingRequest(c.Request) {
+			c.Next()
+			return
+		}
+
+		state := m.state.Load()
+		budget := state.budget(c.Request.Method, c.FullPath())
+		if header := c.GetHeader(RequestTimeoutHeader); header != "" && state.maxClient > 0 {
+			requested, err := parseRequestTimeout(header)
+			if err != nil {
+				BadRequestError(c, "X-Request-Timeout 헤더는 양수의 시간(예: 30s) 또는 초 단위 숫자여야 합니다")
+				return
+			}
+			budget = requested
+			if budget > state.maxClient {
+				budget = state.maxClient
+			}
+		}
+		if budget <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), budget)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Set(requestTimeoutKey, budget)
+
+		writer := &deadlineWriter{ResponseWriter: c.Writer, ctx: ctx, requestID: GetRequestID(c), budget: budget}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		// 기한을 넘긴 핸들러가 응답 없이 끝난 경우
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && !c.Writer.Written() {
+			DeadlineExceededError(c)
+		}
+	}
+}
+
+// GetRequestTimeout은 요청에 적용한 처리 시간 예산을 반환합니다 (기한이 없으면 0).
+func GetRequestTimeout(c *gin.Context) time.Duration {
+	value, exists := c.Get(requestTimeoutKey)
+	if !exists {
+		return 0
+	}
+	budget, _ := value.(time.Duration)
+	return budget
+}
+
+// budget은 가장 구체적인 경로 규칙의 예산, 없으면 기본 예산을 반환합니다.
+func (s *requestTimeoutState) budget(method, route string) time.Duration {
+	method, route = strings.ToLower(method), strings.ToLower(route)
+	var matched *routeBudget
+	for i := range s.routes {
+		rule := s.routes[i]
+		if rule.matches(method, route) && (matched == nil || rule.moreSpecific(*matched)) {
+			matched = &s.routes[i]
+		}
+	}
+	if matched != nil {
+		return matched.budget
+	}
+	return s.defaultBudget
+}
+
+// parseRouteBudget는 "[메서드 ]경로 템플릿" 형식의 키를 해석합니다.
+func parseRouteBudget(key string, budget time.Duration) routeBudget {
+	rule := routeBudget{path: strings.ToLower(strings.TrimSpace(key)), budget: budget}
+	if method, path, ok := strings.Cut(rule.path, " "); ok {
+		rule.method, rule.path = method, strings.TrimSpace(path)
+	}
+	if strings.HasSuffix(rule.path, "*") {
+		rule.prefix = true
+		rule.path = strings.TrimSuffix(rule.path, "*")
+	}
+	return rule
+}
+
+// parseRequestTimeout은 X-Request-Timeout 헤더 값을 해석합니다 (Go duration 또는 초 단위 숫자).
+func parseRequestTimeout(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	budget, err := time.ParseDuration(value)
+	if err != nil {
+		seconds, parseErr := strconv.ParseFloat(value, 64)
+		if parseErr != nil {
+			return 0, err
+		}
+		budget = time.Duration(seconds * float64(time.Second))
+	}
+	if budget <= 0 {
+		return 0, errors.New("request timeout must be positive")
+	}
+	return budget, nil
+}
+
+// isStreamingRequest는 웹소켓 업그레이드나 SSE 요청인지 확인합니다.
+func isStreamingRequest(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// deadlineWriter는 기한을 넘긴 뒤 핸들러가 쓰는 5xx 응답을 표준 504 응답으로 바꿉니다.
+// 스토리지나 Claude 호출이 context 기한 초과로 실패해 핸들러가 500을 쓰는 경우를 504로 통일합니다.
+type deadlineWriter struct {
+	gin.ResponseWriter
+	ctx       context.Context
+	requestID string
+	budget    time.Duration
+	replaced  bool
+}
+
+// WriteHeader는 기한을 넘긴 뒤의 5xx 응답이면 504 응답을 대신 씁니다.
+func (w *deadlineWriter) WriteHeader(code int) {
+	if w.replaced {
+		return
+	}
+	if code >= http.StatusInternalServerError && !w.ResponseWriter.Written() &&
+		errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.replaced = true
+		err := apierrors.NewDeadlineExceededError(w.budget)
+		body, _ := json.Marshal(ErrorResponse{
+			Success: false,
+			Error: Error{
+				Code:      err.Code,
+				Message:   err.Message,
+				Details:   err.Details,
+				RequestID: w.requestID,
+			},
+		})
+		w.ResponseWriter.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.ResponseWriter.WriteHeader(http.StatusGatewayTimeout)
+		_, _ = w.ResponseWriter.Write(body)
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write는 504 응답으로 바꾼 뒤에는 핸들러의 본문을 버립니다.
+func (w *deadlineWriter) Write(data []byte) (int, error) {
+	if w.replaced {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString은 504 응답으로 바꾼 뒤에는 핸들러의 본문을 버립니다.
+func (w *deadlineWriter) WriteString(s string) (int, error) {
+	if w.replaced {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apierrors "github.com/aicli/aicli-web/internal/errors"
+)
+
+func newTimeoutRouter(config *RequestTimeoutConfig, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(RequestID())
+	router.Use(NewRequestTimeoutMiddleware(config).Handler())
+	router.GET("/api/v1/tasks/:id", handler)
+	return router
+}
+
+func doTimeoutRequest(router *gin.Engine, header string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/task-1", nil)
+	if header != "" {
+		req.Header.Set(RequestTimeoutHeader, header)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// waitForDeadline 요청 context 기한까지 기다린 뒤 기한 초과 에러를 반환하는 핸들러
+func waitForDeadline(c *gin.Context) error {
+	<-c.Request.Context().Done()
+	return c.Request.Context().Err()
+}
+
+func TestRequestTimeout_Budget(t *testing.T) {
+	m := NewRequestTimeoutMiddleware(&RequestTimeoutConfig{
+		Default: time.Minute,
+		Routes: map[string]time.Duration{
+			"get /api/v1/tasks/:id/events": 90 * time.Second,
+			"/api/v1/tasks/*":              2 * time.Minute,
+			"POST /api/v1/tasks/*":         3 * time.Minute,
+			"/api/v1/artifacts/download/*": 0,
+		},
+	})
+
+	assert.Equal(t, 90*time.Second, m.Budget(http.MethodGet, "/api/v1/tasks/:id/events"))
+	assert.Equal(t, 2*time.Minute, m.Budget(http.MethodGet, "/api/v1/tasks/:id"))
+	assert.Equal(t, 3*time.Minute, m.Budget(http.MethodPost, "/api/v1/tasks/:id/cancel"))
+	assert.Equal(t, time.Duration(0), m.Budget(http.MethodGet, "/api/v1/artifacts/download/*key"))
+	assert.Equal(t, time.Minute, m.Budget(http.MethodGet, "/api/v1/workspaces"))
+}
+
+func TestRequestTimeout_SetsDeadline(t *testing.T) {
+	var budget time.Duration
+	var hasDeadline bool
+	router := newTimeoutRouter(&RequestTimeoutConfig{Default: time.Minute, MaxClient: 5 * time.Minute}, func(c *gin.Context) {
+		budget = GetRequestTimeout(c)
+		_, hasDeadline = c.Request.Context().Deadline()
+		c.Status(http.StatusNoContent)
+	})
+
+	w := doTimeoutRequest(router, "")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.True(t, hasDeadline)
+	assert.Equal(t, time.Minute, budget)
+
+	// 클라이언트 헤더는 서버 최대값으로 제한
+	doTimeoutRequest(router, "10m")
+	assert.Equal(t, 5*time.Minute, budget)
+
+	doTimeoutRequest(router, "2.5")
+	assert.Equal(t, 2500*time.Millisecond, budget)
+
+	w = doTimeoutRequest(router, "soon")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestRequestTimeout_IgnoresHeaderWithoutMaxClient(t *testing.T) {
+	var hasDeadline bool
+	router := newTimeoutRouter(&RequestTimeoutConfig{}, func(c *gin.Context) {
+		_, hasDeadline = c.Request.Context().Deadline()
+		c.Status(http.StatusNoContent)
+	})
+
+	w := doTimeoutRequest(router, "soon")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.False(t, hasDeadline)
+}
+
+func TestRequestTimeout_ConvertsServerErrorAfterDeadline(t *testing.T) {
+	router := newTimeoutRouter(&RequestTimeoutConfig{Default: 10 * time.Millisecond}, func(c *gin.Context) {
+		err := waitForDeadline(c)
+		InternalError(c, "태스크 조회 실패", err.Error())
+	})
+
+	w := doTimeoutRequest(router, "")
+	require.Equal(t, http.StatusGatewayTimeout, w.Code)
+
+	var response ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.False(t, response.Success)
+	assert.Equal(t, apierrors.ErrCodeDeadlineExceeded, response.Error.Code)
+	assert.Equal(t, w.Header().Get("X-Request-ID"), response.Error.RequestID)
+	assert.Equal(t, map[string]interface{}{"timeout": "10ms"}, response.Error.Details)
+}
+
+func TestRequestTimeout_HandleServiceError(t *testing.T) {
+	router := newTimeoutRouter(&RequestTimeoutConfig{Default: 10 * time.Millisecond}, func(c *gin.Context) {
+		HandleServiceError(c, waitForDeadline(c))
+	})
+
+	w := doTimeoutRequest(router, "")
+	require.Equal(t, http.StatusGatewayTimeout, w.Code)
+
+	var response ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, apierrors.ErrCodeDeadlineExceeded, response.Error.Code)
+}
+
+func TestRequestTimeout_NoResponseAfterDeadline(t *testing.T) {
+	router := newTimeoutRouter(&RequestTimeoutConfig{Default: 10 * time.Millisecond}, func(c *gin.Context) {
+		_ = waitForDeadline(c)
+	})
+
+	w := doTimeoutRequest(router, "")
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+}
+
+func TestRequestTimeout_SkipsStreaming(t *testing.T) {
+	var hasDeadline bool
+	router := newTimeoutRouter(&RequestTimeoutConfig{Default: time.Minute}, func(c *gin.Context) {
+		_, hasDeadline = c.Request.Context().Deadline()
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/task-1", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+	assert.False(t, hasDeadline)
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/aicli/aicli-web/internal/errors"
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// WorkspaceAuthorizer 워크스페이스 ACL 권한 확인 인터페이스
+// 각 메서드는 리소스가 속한 워크스페이스에서 사용자에게 required 권한이 없으면 에러를 반환합니다.
+type WorkspaceAuthorizer interface {
+	Authorize(ctx context.Context, workspaceID, userID string, required models.WorkspacePermission) (*models.Workspace, error)
+	AuthorizeProject(ctx context.Context, projectID, userID string, required models.WorkspacePermission) (*models.Project, error)
+	AuthorizeSession(ctx context.Context, sessionID, userID string, required models.WorkspacePermission) (*models.Session, error)
+	AuthorizeTask(ctx context.Context, taskID, userID string, required models.WorkspacePermission) (*models.Task, error)
+}
+
+// RequireWorkspacePermission 경로의 :id 워크스페이스에 대한 권한 확인 미들웨어
+func RequireWorkspacePermission(authorizer WorkspaceAuthorizer, required models.WorkspacePermission) gin.HandlerFunc {
+	return withLogContext(logging.WithWorkspaceID, "id", requireWorkspaceAccess(func(c *gin.Context, userID string) error {
+		_, err := authorizer.Authorize(c.Request.Context(), c.Param("id"), userID, required)
+		return err
+	}))
+}
+
+// RequireProjectPermission 경로의 :id 프로젝트가 속한 워크스페이스에 대한 권한 확인 미들웨어
+func RequireProjectPermission(authorizer WorkspaceAuthorizer, required models.WorkspacePermission) gin.HandlerFunc {
+	return requireWorkspaceAccess(func(c *gin.Context, userID string) error {
+		_, err := authorizer.AuthorizeProject(c.Request.Context(), c.Param("id"), userID, required)
+		return err
+	})
+}
+
+// RequireSessionPermission 경로의 :id 세션이 속한 워크스페이스에 대한 권한 확인 미들웨어
+func RequireSessionPermission(authorizer WorkspaceAuthorizer, required models.WorkspacePermission) gin.HandlerFunc {
+	return withLogContext(logging.WithSessionID, "id", requireWorkspaceAccess(func(c *gin.Context, userID string) error {
+		_, err := authorizer.AuthorizeSession(c.Request.Context(), c.Param("id"), userID, required)
+		return err
+	}))
+}
+
+// RequireTaskPermission 경로의 :id 태스크가 속한 워크스페이스에 대한 권한 확인 미들웨어
+func RequireTaskPermission(authorizer WorkspaceAuthorizer, required models.WorkspacePermission) gin.HandlerFunc {
+	return requireWorkspaceAccess(func(c *gin.Context, userID string) error {
+		_, err := authorizer.AuthorizeTask(c.Request.Context(), c.Param("id"), userID, required)
+		return err
+	})
+}
+
+// RequireProjectQueryPermission 목록 조회의 project_id 쿼리에 대한 권한 확인 미들웨어
+// 여러 워크스페이스에 걸친 목록은 admin만 조회할 수 있으므로 그 외 사용자는 project_id를 지정해야 합니다.
+func RequireProjectQueryPermission(authorizer WorkspaceAuthorizer, required models.WorkspacePermission) gin.HandlerFunc {
+	return requireWorkspaceAccess(func(c *gin.Context, userID string) error {
+		projectID := c.Query("project_id")
+		if projectID == "" {
+			return apierrors.NewWorkspaceError(apierrors.ErrCodeInvalidRequest, "project_id 파라미터가 필요합니다", nil)
+		}
+		_, err := authorizer.AuthorizeProject(c.Request.Context(), projectID, userID, required)
+		return err
+	})
+}
+
+// RequireSessionQueryPermission 목록 조회의 session_id 쿼리에 대한 권한 확인 미들웨어
+// 여러 워크스페이스에 걸친 목록은 admin만 조회할 수 있으므로 그 외 사용자는 session_id를 지정해야 합니다.
+func RequireSessionQueryPermission(authorizer WorkspaceAuthorizer, required models.WorkspacePermission) gin.HandlerFunc {
+	return requireWorkspaceAccess(func(c *gin.Context, userID string) error {
+		sessionID := c.Query("session_id")
+		if sessionID == "" {
+			return apierrors.NewWorkspaceError(apierrors.ErrCodeInvalidRequest, "session_id 파라미터가 필요합니다", nil)
+		}
+		_, err := authorizer.AuthorizeSession(c.Request.Context(), sessionID, userID, required)
+		return err
+	})
+}
+
+// requireWorkspaceAccess 인증된 사용자의 권한을 check로 확인 (시스템 admin은 확인하지 않음)
+func requireWorkspaceAccess(check func(c *gin.Context, userID string) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok || userID == "" {
+			UnauthorizedError(c, "인증 정보를 찾을 수 없습니다")
+			return
+		}
+		if role, _ := GetUserRole(c); role == "admin" {
+			c.Next()
+			return
+		}
+
+		if err := check(c, userID); err != nil {
+			HandleServiceError(c, err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// withLogContext 경로 파라미터 param 값을 로그 연결용으로 요청 컨텍스트에 담은 뒤 next 실행
+func withLogContext(with func(context.Context, string) context.Context, param string, next gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(with(c.Request.Context(), c.Param(param)))
+		next(c)
+	}
+}
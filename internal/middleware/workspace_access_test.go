@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	apierrors "github.com/aicli/aicli-web/internal/errors"
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// fakeWorkspaceAuthorizer 사용자별 권한만 가진 테스트용 권한 확인기
+type fakeWorkspaceAuthorizer map[string]models.WorkspacePermission
+
+func (a fakeWorkspaceAuthorizer) check(userID string, required models.WorkspacePermission) error {
+	permission, ok := a[userID]
+	if !ok || !permission.Includes(required) {
+		return apierrors.NewWorkspaceError(apierrors.ErrCodeInsufficientPerm, "권한 없음", nil)
+	}
+	return nil
+}
+
+func (a fakeWorkspaceAuthorizer) Authorize(ctx context.Context, workspaceID, userID string, required models.WorkspacePermission) (*models.Workspace, error) {
+	return &models.Workspace{}, a.check(userID, required)
+}
+
+func (a fakeWorkspaceAuthorizer) AuthorizeProject(ctx context.Context, projectID, userID string, required models.WorkspacePermission) (*models.Project, error) {
+	return &models.Project{}, a.check(userID, required)
+}
+
+func (a fakeWorkspaceAuthorizer) AuthorizeSession(ctx context.Context, sessionID, userID string, required models.WorkspacePermission) (*models.Session, error) {
+	return &models.Session{}, a.check(userID, required)
+}
+
+func (a fakeWorkspaceAuthorizer) AuthorizeTask(ctx context.Context, taskID, userID string, required models.WorkspacePermission) (*models.Task, error) {
+	return &models.Task{}, a.check(userID, required)
+}
+
+func TestRequireWorkspacePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	authorizer := fakeWorkspaceAuthorizer{
+		"reader":   models.WorkspacePermissionRead,
+		"executor": models.WorkspacePermissionExecute,
+	}
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		path    string
+		userID  string
+		role    string
+		status  int
+	}{
+		{"인증 없음", RequireWorkspacePermission(authorizer, models.WorkspacePermissionRead), "/ws/1", "", "", http.StatusUnauthorized},
+		{"read 권한으로 조회", RequireWorkspacePermission(authorizer, models.WorkspacePermissionRead), "/ws/1", "reader", "user", http.StatusOK},
+		{"read 권한으로 실행", RequireWorkspacePermission(authorizer, models.WorkspacePermissionExecute), "/ws/1", "reader", "user", http.StatusForbidden},
+		{"execute 권한으로 실행", RequireWorkspacePermission(authorizer, models.WorkspacePermissionExecute), "/ws/1", "executor", "user", http.StatusOK},
+		{"시스템 admin은 확인 생략", RequireWorkspacePermission(authorizer, models.WorkspacePermissionOwner), "/ws/1", "root", "admin", http.StatusOK},
+		{"project_id 없음", RequireProjectQueryPermission(authorizer, models.WorkspacePermissionRead), "/ws/1", "reader", "user", http.StatusBadRequest},
+		{"project_id 지정", RequireProjectQueryPermission(authorizer, models.WorkspacePermissionRead), "/ws/1?project_id=p1", "reader", "user", http.StatusOK},
+		{"admin은 project_id 없이 조회", RequireProjectQueryPermission(authorizer, models.WorkspacePermissionRead), "/ws/1", "root", "admin", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/ws/:id", func(c *gin.Context) {
+				if tt.userID != "" {
+					c.Set("user_id", tt.userID)
+					c.Set("role", tt.role)
+				}
+				c.Next()
+			}, tt.handler, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestWorkspaceAccess_LogContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	authorizer := fakeWorkspaceAuthorizer{"reader": models.WorkspacePermissionRead}
+
+	var fields logging.Fields
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/ws/:id", func(c *gin.Context) {
+		c.Set("user_id", "reader")
+		c.Set("role", "user")
+		c.Next()
+	}, RequireWorkspacePermission(authorizer, models.WorkspacePermissionRead), func(c *gin.Context) {
+		fields = logging.ContextFields(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/ws/ws-1", nil)
+	req.Header.Set("X-Request-ID", "req-1")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, logging.Fields{
+		logging.FieldRequestID:   "req-1",
+		logging.FieldWorkspaceID: "ws-1",
+	}, fields)
+}
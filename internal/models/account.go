@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Account 로컬 계정 (비밀번호로 로그인하는 사용자)
+// swagger:model Account
+type Account struct {
+	ID                string     `json:"id"`
+	Username          string     `json:"username"`
+	Email             string     `json:"email"`
+	DisplayName       string     `json:"display_name,omitempty"`
+	Role              string     `json:"role"`
+	PasswordHash      string     `json:"-"`
+	PasswordChangedAt time.Time  `json:"password_changed_at"`
+	FailedLogins      int        `json:"failed_logins"`
+	LockedUntil       *time.Time `json:"locked_until,omitempty"`
+	LastLoginAt       *time.Time `json:"last_login_at,omitempty"`
+	IsActive          bool       `json:"is_active"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// IsLocked 로그인 실패로 잠긴 상태인지 확인
+func (a *Account) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
+}
+
+// AccountResetToken 비밀번호 재설정 토큰
+// 토큰 원문은 이메일로만 보내고 해시만 저장합니다.
+type AccountResetToken struct {
+	TokenHash string     `json:"-"`
+	AccountID string     `json:"account_id"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// AccountProfileUpdateRequest 본인 프로필 수정 요청
+type AccountProfileUpdateRequest struct {
+	// 표시 이름
+	DisplayName *string `json:"display_name" binding:"omitempty,max=100"`
+
+	// 이메일 (비밀번호 재설정 메일을 받을 주소)
+	Email *string `json:"email" binding:"omitempty,email"`
+}
@@ -0,0 +1,99 @@
+package models
+
+import "time"
+
+// ActivityKind 활동 종류
+type ActivityKind string
+
+const (
+	// ActivityKindTask 태스크 종료 (완료, 실패, 취소)
+	ActivityKindTask ActivityKind = "task"
+	// ActivityKindApproval 태스크 결과 검토 요청과 승인/거부
+	ActivityKindApproval ActivityKind = "approval"
+	// ActivityKindMembership 워크스페이스 공유, 역할, 그룹 구성원 변경
+	ActivityKindMembership ActivityKind = "membership"
+	// ActivityKindSecurity 계정 잠금, 비밀번호 변경 같은 보안 알림
+	ActivityKindSecurity ActivityKind = "security"
+)
+
+// ActivityPersonalFeed 개인 피드의 읽음 표시 키 (워크스페이스 피드는 워크스페이스 ID)
+const ActivityPersonalFeed = ""
+
+// Activity 워크스페이스 또는 사용자 활동 피드 항목
+// RecipientID가 비어 있으면 워크스페이스 read 권한이 있는 모든 사용자가 보는 워크스페이스 피드 항목이고,
+// 있으면 그 사용자의 개인 피드 항목입니다.
+// swagger:model Activity
+type Activity struct {
+	ID   string       `json:"id"`
+	Kind ActivityKind `json:"kind"`
+
+	// Action 종류 안의 세부 동작 (예: completed, review_requested, approved, granted, role_assigned, notice)
+	Action string `json:"action"`
+
+	WorkspaceID string `json:"workspace_id,omitempty"`
+	RecipientID string `json:"recipient_id,omitempty"`
+
+	// 활동을 일으킨 사용자 (시스템이 만든 활동이면 비어 있음)
+	ActorID string `json:"actor_id,omitempty"`
+
+	// 관련 리소스 (예: task, task_review, workspace, role, group)
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+
+	Summary  string            `json:"summary"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+
+	// Read 조회한 사용자가 읽음으로 표시한 시각 이전의 항목인지 (저장하지 않음)
+	Read bool `json:"read"`
+}
+
+// ActivityFilter 활동 조회 조건
+type ActivityFilter struct {
+	// 워크스페이스 (비어 있으면 전체)
+	WorkspaceID string
+
+	// 받는 사용자 (비어 있으면 워크스페이스 피드 항목만)
+	RecipientID string
+
+	// 활동 종류 (비어 있으면 전체)
+	Kind ActivityKind
+
+	// 이 시각보다 나중에 생긴 항목만 (안 읽은 항목 조회용)
+	After *time.Time
+}
+
+// ActivityListRequest 활동 피드 조회 요청
+type ActivityListRequest struct {
+	Kind       ActivityKind `form:"kind" binding:"omitempty,oneof=task approval membership security"`
+	UnreadOnly bool         `form:"unread"`
+	Page       int          `form:"page"`
+	Limit      int          `form:"limit"`
+}
+
+// ActivityFeedResponse 활동 피드 한 페이지와 읽음 상태
+// swagger:model ActivityFeedResponse
+type ActivityFeedResponse struct {
+	Data []*Activity    `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+
+	// 종류 조건에 맞는 안 읽은 항목 수
+	Unread int `json:"unread"`
+
+	// 마지막으로 읽음으로 표시한 시각 (표시한 적 없으면 생략)
+	ReadAt *time.Time `json:"read_at,omitempty"`
+}
+
+// ActivityReadRequest 읽음 표시 요청
+type ActivityReadRequest struct {
+	// Until 이 시각까지 생긴 항목을 읽음으로 표시 (비어 있으면 지금까지)
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// ActivityReadState 피드의 읽음 표시 상태
+// swagger:model ActivityReadState
+type ActivityReadState struct {
+	ReadAt time.Time `json:"read_at"`
+	Unread int       `json:"unread"`
+}
@@ -0,0 +1,145 @@
+package models
+
+import "time"
+
+// AnalyticsDay 하루(UTC) 동안의 사용량 집계 (일별 롤업 한 행)
+// swagger:model AnalyticsDay
+type AnalyticsDay struct {
+	Day time.Time `json:"day"`
+
+	// 그날 끝난 태스크 수 (상태별)
+	Tasks     int64 `json:"tasks"`
+	Completed int64 `json:"completed"`
+	Failed    int64 `json:"failed"`
+	Cancelled int64 `json:"cancelled"`
+
+	// 실행 시간 합계와 실행 시간이 기록된 태스크 수 (평균 계산용)
+	DurationMs int64 `json:"duration_ms"`
+	TimedTasks int64 `json:"timed_tasks"`
+
+	// claude 명령 결과에서 읽은 비용과 토큰 수
+	CostUSD float64 `json:"cost_usd"`
+	Tokens  int64   `json:"tokens"`
+
+	// 태스크를 실행한 워크스페이스의 소유자 수
+	ActiveUsers int64 `json:"active_users"`
+
+	// 그날 동시에 실행 중이던 태스크 수의 최댓값
+	PeakConcurrency int64 `json:"peak_concurrency"`
+}
+
+// SuccessRate 끝난 태스크 중 완료된 비율 (0~1, 끝난 태스크가 없으면 0)
+func (d *AnalyticsDay) SuccessRate() float64 {
+	if d.Tasks == 0 {
+		return 0
+	}
+	return float64(d.Completed) / float64(d.Tasks)
+}
+
+// AverageDurationMs 평균 실행 시간 (밀리초, 기록이 없으면 0)
+func (d *AnalyticsDay) AverageDurationMs() int64 {
+	if d.TimedTasks == 0 {
+		return 0
+	}
+	return d.DurationMs / d.TimedTasks
+}
+
+// AnalyticsWorkspaceDay 하루(UTC) 동안의 워크스페이스별 사용량 집계
+type AnalyticsWorkspaceDay struct {
+	Day         time.Time
+	WorkspaceID string
+	OwnerID     string
+	Tasks       int64
+	CostUSD     float64
+	Tokens      int64
+}
+
+// AnalyticsUserDay 하루(UTC) 동안 태스크를 실행한 사용자
+type AnalyticsUserDay struct {
+	Day    time.Time
+	UserID string
+}
+
+// AnalyticsRollup 집계 작업 한 번에 반영할 증분
+// 태스크 수와 비용은 기존 값에 더하고, 동시 실행 최댓값은 큰 값을 남깁니다.
+type AnalyticsRollup struct {
+	Days       []*AnalyticsDay
+	Workspaces []*AnalyticsWorkspaceDay
+	Users      []*AnalyticsUserDay
+
+	// Watermark 이 시각까지 끝난 태스크를 반영함 (다음 집계는 이후에 끝난 태스크부터)
+	Watermark time.Time
+}
+
+// AnalyticsWorkspaceUsage 기간 동안의 워크스페이스별 사용량
+// swagger:model AnalyticsWorkspaceUsage
+type AnalyticsWorkspaceUsage struct {
+	WorkspaceID   string  `json:"workspace_id"`
+	WorkspaceName string  `json:"workspace_name,omitempty"`
+	OwnerID       string  `json:"owner_id"`
+	Tasks         int64   `json:"tasks"`
+	CostUSD       float64 `json:"cost_usd"`
+	Tokens        int64   `json:"tokens"`
+}
+
+// AnalyticsRangeRequest 사용량 분석 조회 기간 (UTC 날짜, to 포함)
+type AnalyticsRangeRequest struct {
+	From  *time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To    *time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"`
+	Limit int        `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// AnalyticsSummary 기간 전체의 사용량 요약
+// swagger:model AnalyticsSummary
+type AnalyticsSummary struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	Tasks           int64   `json:"tasks"`
+	Completed       int64   `json:"completed"`
+	Failed          int64   `json:"failed"`
+	Cancelled       int64   `json:"cancelled"`
+	SuccessRate     float64 `json:"success_rate"`
+	AvgDurationMs   int64   `json:"avg_duration_ms"`
+	CostUSD         float64 `json:"cost_usd"`
+	Tokens          int64   `json:"tokens"`
+	ActiveUsers     int64   `json:"active_users"`
+	PeakConcurrency int64   `json:"peak_concurrency"`
+
+	// UpdatedAt 집계에 반영된 마지막 시각 (아직 집계하지 않았으면 비어 있음)
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// AnalyticsDailyPoint 일별 추이 한 점
+// swagger:model AnalyticsDailyPoint
+type AnalyticsDailyPoint struct {
+	Day             time.Time `json:"day"`
+	Tasks           int64     `json:"tasks"`
+	Completed       int64     `json:"completed"`
+	Failed          int64     `json:"failed"`
+	Cancelled       int64     `json:"cancelled"`
+	SuccessRate     float64   `json:"success_rate"`
+	AvgDurationMs   int64     `json:"avg_duration_ms"`
+	CostUSD         float64   `json:"cost_usd"`
+	Tokens          int64     `json:"tokens"`
+	ActiveUsers     int64     `json:"active_users"`
+	PeakConcurrency int64     `json:"peak_concurrency"`
+}
+
+// AnalyticsDailyResponse 기간의 일별 추이 (태스크가 없는 날도 0으로 채움)
+// swagger:model AnalyticsDailyResponse
+type AnalyticsDailyResponse struct {
+	From      time.Time             `json:"from"`
+	To        time.Time             `json:"to"`
+	Days      []AnalyticsDailyPoint `json:"days"`
+	UpdatedAt *time.Time            `json:"updated_at,omitempty"`
+}
+
+// AnalyticsTopWorkspacesResponse 기간 동안 비용이 많이 든 워크스페이스
+// swagger:model AnalyticsTopWorkspacesResponse
+type AnalyticsTopWorkspacesResponse struct {
+	From       time.Time                  `json:"from"`
+	To         time.Time                  `json:"to"`
+	Workspaces []*AnalyticsWorkspaceUsage `json:"workspaces"`
+	UpdatedAt  *time.Time                 `json:"updated_at,omitempty"`
+}
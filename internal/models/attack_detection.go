@@ -0,0 +1,69 @@
+package models
+
+// AttackDetectionStatus 공격 탐지 임계값과 요청 검사 여부
+// swagger:model AttackDetectionStatus
+type AttackDetectionStatus struct {
+	// 모든 요청을 공격 패턴으로 검사하는지 여부 (attack_detection.enabled, 재시작해야 변경)
+	RequestInspection bool `json:"request_inspection"`
+
+	// 현재 임계값
+	Settings AttackDetectionSettings `json:"settings"`
+}
+
+// AttackDetectionSettings 실행 중에 바꿀 수 있는 공격 탐지 임계값
+// swagger:model AttackDetectionSettings
+type AttackDetectionSettings struct {
+	// 라우트 그룹에 속하지 않는 경로의 임계값
+	Defaults AttackThresholdSettings `json:"defaults"`
+
+	// 경로 접두사별 임계값 (접두사가 가장 긴 그룹 적용)
+	Groups []AttackRouteGroupSettings `json:"groups" binding:"omitempty,dive"`
+}
+
+// AttackThresholdSettings 기본 공격 탐지 임계값
+type AttackThresholdSettings struct {
+	// 공격으로 판단할 최소 신뢰도 (0 초과 1 이하)
+	MinConfidence float64 `json:"min_confidence" binding:"gt=0,max=1"`
+
+	// 무차별 대입으로 판단할 IP별 로그인 실패 횟수
+	BruteForceThreshold int `json:"brute_force_threshold" binding:"min=1"`
+
+	// 로그인 실패 횟수를 세는 시간 윈도우 (밀리초)
+	BruteForceWindowMS int64 `json:"brute_force_window_ms" binding:"min=1000,max=86400000"`
+
+	// 공격 IP 자동 차단 (Redis가 설정되어 있어야 동작)
+	AutoBlock bool `json:"auto_block"`
+
+	// 자동 차단한 IP의 차단 유지 시간 (밀리초)
+	BlockDurationMS int64 `json:"block_duration_ms" binding:"min=1000,max=604800000"`
+
+	// 탐지만 기록하고 차단, 요청 거부, 알림은 하지 않음
+	DryRun bool `json:"dry_run"`
+}
+
+// AttackRouteGroupSettings 경로 접두사별 공격 탐지 임계값 (0인 값은 기본 임계값을 따름)
+type AttackRouteGroupSettings struct {
+	// 그룹 이름
+	Name string `json:"name" binding:"required,max=64"`
+
+	// 그룹에 속하는 요청 경로 접두사 (예: /api/v1/auth, /ws)
+	PathPrefix string `json:"path_prefix" binding:"required,startswith=/"`
+
+	// 공격으로 판단할 최소 신뢰도 (0~1)
+	MinConfidence float64 `json:"min_confidence,omitempty" binding:"min=0,max=1"`
+
+	// 무차별 대입으로 판단할 IP별 로그인 실패 횟수
+	BruteForceThreshold int `json:"brute_force_threshold,omitempty" binding:"min=0"`
+
+	// 로그인 실패 횟수를 세는 시간 윈도우 (밀리초)
+	BruteForceWindowMS int64 `json:"brute_force_window_ms,omitempty" binding:"min=0,max=86400000"`
+
+	// 자동 차단한 IP의 차단 유지 시간 (밀리초)
+	BlockDurationMS int64 `json:"block_duration_ms,omitempty" binding:"min=0,max=604800000"`
+
+	// 이 그룹에서는 IP를 차단하지 않음
+	DisableAutoBlock bool `json:"disable_auto_block,omitempty"`
+
+	// 이 그룹만 탐지 기록 모드로 동작
+	DryRun bool `json:"dry_run,omitempty"`
+}
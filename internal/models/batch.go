@@ -0,0 +1,90 @@
+package models
+
+// BatchDeleteRequest 일괄 삭제 요청
+// swagger:model BatchDeleteRequest
+type BatchDeleteRequest struct {
+	// 삭제할 리소스 ID 목록
+	// example: ["ws_1", "ws_2"]
+	IDs []string `json:"ids" binding:"required,min=1,max=100,dive,required" validate:"required,min=1,max=100"`
+
+	// 전체 성공 또는 전체 실패 (트랜잭션 지원 스토리지에서만 보장)
+	// example: false
+	Atomic bool `json:"atomic"`
+}
+
+// BatchCreateTasksRequest 태스크 일괄 생성 요청
+// swagger:model BatchCreateTasksRequest
+type BatchCreateTasksRequest struct {
+	// 생성할 태스크 목록
+	Tasks []TaskCreateRequest `json:"tasks" binding:"required,min=1,max=100,dive" validate:"required,min=1,max=100"`
+
+	// 전체 성공 또는 전체 실패
+	// example: false
+	Atomic bool `json:"atomic"`
+}
+
+// BatchItemError 항목별 에러 정보
+type BatchItemError struct {
+	// 에러 분류 (errors.ErrorType 문자열)
+	// example: NotFoundError
+	Type string `json:"type"`
+
+	// 에러 코드
+	// example: NOT_FOUND
+	Code string `json:"code"`
+
+	// 에러 메시지
+	Message string `json:"message"`
+}
+
+// BatchItemResult 항목별 처리 결과
+type BatchItemResult struct {
+	// 요청 내 항목 순서
+	Index int `json:"index"`
+
+	// 리소스 ID
+	ID string `json:"id,omitempty"`
+
+	// 성공 여부
+	Success bool `json:"success"`
+
+	// 생성된 리소스 (생성 요청 시)
+	Data interface{} `json:"data,omitempty"`
+
+	// 실패 시 에러 정보
+	Error *BatchItemError `json:"error,omitempty"`
+}
+
+// BatchResult 일괄 처리 결과
+// swagger:model BatchResult
+type BatchResult struct {
+	// 항목별 결과
+	Results []BatchItemResult `json:"results"`
+
+	// 성공 항목 수
+	Succeeded int `json:"succeeded"`
+
+	// 실패 항목 수
+	Failed int `json:"failed"`
+
+	// 원자적으로 처리되었는지 여부
+	Atomic bool `json:"atomic"`
+
+	// 실패로 인해 전체 롤백되었는지 여부
+	RolledBack bool `json:"rolled_back,omitempty"`
+}
+
+// Add 항목 결과를 추가하고 집계를 갱신
+func (r *BatchResult) Add(item BatchItemResult) {
+	r.Results = append(r.Results, item)
+	if item.Success {
+		r.Succeeded++
+	} else {
+		r.Failed++
+	}
+}
+
+// HasFailures 실패 항목 존재 여부
+func (r *BatchResult) HasFailures() bool {
+	return r.Failed > 0
+}
@@ -68,4 +68,8 @@ type PaginationMeta struct {
 	// 이전 페이지 존재 여부
 	// example: false
 	HasPrev bool `json:"has_prev"`
+	
+	// 다음 페이지 커서 (커서 페이지네이션 사용 시)
+	// example: eyJzIjoiY3JlYXRlZF9hdCJ9
+	NextCursor string `json:"next_cursor,omitempty"`
 }
\ No newline at end of file
@@ -0,0 +1,67 @@
+package models
+
+import "time"
+
+// CSPReport 브라우저가 보낸 Content-Security-Policy 위반 보고
+// report-uri 형식과 Reporting API 형식을 같은 필드로 정규화합니다.
+// swagger:model CSPReport
+type CSPReport struct {
+	ID                 string    `json:"id"`
+	DocumentURI        string    `json:"document_uri"`
+	Referrer           string    `json:"referrer,omitempty"`
+	BlockedURI         string    `json:"blocked_uri"`
+	EffectiveDirective string    `json:"effective_directive"`
+	ViolatedDirective  string    `json:"violated_directive,omitempty"`
+	OriginalPolicy     string    `json:"original_policy,omitempty"`
+	Disposition        string    `json:"disposition"`
+	SourceFile         string    `json:"source_file,omitempty"`
+	LineNumber         int       `json:"line_number,omitempty"`
+	ColumnNumber       int       `json:"column_number,omitempty"`
+	StatusCode         int       `json:"status_code,omitempty"`
+	ScriptSample       string    `json:"script_sample,omitempty"`
+	IPAddress          string    `json:"ip_address"`
+	UserAgent          string    `json:"user_agent"`
+	ReceivedAt         time.Time `json:"received_at"`
+}
+
+// CSPViolationSummary 지시문과 차단된 주소가 같은 위반 보고의 집계
+type CSPViolationSummary struct {
+	EffectiveDirective string    `json:"effective_directive"`
+	BlockedURI         string    `json:"blocked_uri"`
+	Count              int       `json:"count"`
+	LastSeen           time.Time `json:"last_seen"`
+}
+
+// CSPReportList 보안 대시보드용 위반 보고 목록과 집계
+// swagger:model CSPReportList
+type CSPReportList struct {
+	// 서버 시작 후 받은 보고 수
+	Received int64 `json:"received"`
+
+	// 최대 보관 수를 넘어 버린 보고 수
+	Dropped int64 `json:"dropped"`
+
+	// 최대 보관 수 (csp.max_reports)
+	MaxReports int `json:"max_reports"`
+
+	// 보관 중인 보고의 지시문, 차단된 주소별 집계 (많은 것부터)
+	Summary []CSPViolationSummary `json:"summary"`
+
+	// 조건에 맞는 보고 (최근 것부터)
+	Reports []CSPReport `json:"reports"`
+}
+
+// CSPReportFilter 위반 보고 조회 조건
+type CSPReportFilter struct {
+	// 위반한 지시문 (예: script-src-elem)
+	Directive string `form:"directive"`
+
+	// 정책 적용 방식
+	Disposition string `form:"disposition" binding:"omitempty,oneof=enforce report"`
+
+	// 이후에 받은 보고만 (RFC3339)
+	Since time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+
+	// 최대 개수 (0이면 기본값)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=1000"`
+}
@@ -0,0 +1,192 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCursor 커서 토큰 디코딩 실패
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrInvalidSortField 허용되지 않은 정렬 필드
+var ErrInvalidSortField = errors.New("invalid sort field")
+
+// Cursor 키셋 페이지네이션 커서
+// 정렬 필드 값과 ID를 함께 저장하여 동일 값이 있어도 순서가 안정적으로 유지됩니다.
+type Cursor struct {
+	// 정렬 필드
+	Sort string `json:"s"`
+
+	// 정렬 순서 (asc, desc)
+	Order string `json:"o"`
+
+	// 마지막 항목의 정렬 필드 값
+	Value string `json:"v"`
+
+	// 마지막 항목의 ID
+	ID string `json:"i"`
+}
+
+// EncodeCursor 커서를 불투명 토큰으로 인코딩
+func EncodeCursor(c Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor 토큰을 커서로 디코딩
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// TimeValue 커서 값을 시간으로 해석 (시간 정렬 필드용)
+func (c *Cursor) TimeValue() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Value)
+	if err != nil {
+		return time.Time{}, ErrInvalidCursor
+	}
+	return t, nil
+}
+
+// IsTimeSortField 시간 기반 정렬 필드 여부
+func IsTimeSortField(field string) bool {
+	return strings.HasSuffix(field, "_at")
+}
+
+// NewCursor 정렬 필드 값으로부터 커서 토큰 생성
+func NewCursor(sort, order, id string, value interface{}) string {
+	var v string
+	switch val := value.(type) {
+	case time.Time:
+		v = val.UTC().Format(time.RFC3339Nano)
+	case *time.Time:
+		if val != nil {
+			v = val.UTC().Format(time.RFC3339Nano)
+		}
+	default:
+		v = fmt.Sprint(val)
+	}
+	return EncodeCursor(Cursor{Sort: sort, Order: order, Value: v, ID: id})
+}
+
+// SortWhitelist 정렬 가능한 필드 목록
+type SortWhitelist map[string]bool
+
+var (
+	// WorkspaceSortFields 워크스페이스 정렬 허용 필드
+	WorkspaceSortFields = SortWhitelist{"created_at": true, "updated_at": true, "name": true}
+
+	// ProjectSortFields 프로젝트 정렬 허용 필드
+	ProjectSortFields = SortWhitelist{"created_at": true, "updated_at": true, "name": true}
+
+	// SessionSortFields 세션 정렬 허용 필드
+	SessionSortFields = SortWhitelist{"created_at": true, "updated_at": true, "last_active": true}
+
+	// TaskSortFields 태스크 정렬 허용 필드
+	TaskSortFields = SortWhitelist{"created_at": true, "updated_at": true, "status": true}
+)
+
+// ApplySortWhitelist 정렬 필드가 허용 목록에 있는지 검사하고 커서를 디코딩합니다
+func (p *PaginationRequest) ApplySortWhitelist(allowed SortWhitelist) (*Cursor, error) {
+	p.Normalize()
+
+	if !allowed[p.Sort] {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSortField, p.Sort)
+	}
+
+	if p.Cursor == "" {
+		return nil, nil
+	}
+
+	cursor, err := DecodeCursor(p.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// 커서는 생성 당시의 정렬 조건을 따름
+	if !allowed[cursor.Sort] {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSortField, cursor.Sort)
+	}
+	p.Sort = cursor.Sort
+	if cursor.Order == "asc" || cursor.Order == "desc" {
+		p.Order = cursor.Order
+	}
+	return cursor, nil
+}
+
+// FieldList ?fields= 파라미터를 필드 목록으로 변환
+func (p *PaginationRequest) FieldList() []string {
+	if p.Fields == "" {
+		return nil
+	}
+
+	var fields []string
+	for _, f := range strings.Split(p.Fields, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// SelectFields 응답 객체에서 요청된 필드만 남깁니다 (sparse fieldset).
+// 필드 목록이 비어 있으면 원본을 그대로 반환하며, id는 항상 포함됩니다.
+func SelectFields(v interface{}, fields []string) interface{} {
+	if len(fields) == 0 {
+		return v
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice {
+		result := make([]map[string]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			result = append(result, selectItemFields(rv.Index(i).Interface(), fields))
+		}
+		return result
+	}
+	return selectItemFields(v, fields)
+}
+
+func selectItemFields(v interface{}, fields []string) map[string]interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var full map[string]interface{}
+	if err := json.Unmarshal(data, &full); err != nil {
+		return nil
+	}
+
+	result := make(map[string]interface{}, len(fields)+1)
+	if id, ok := full["id"]; ok {
+		result["id"] = id
+	}
+	for _, f := range fields {
+		if value, ok := full[f]; ok {
+			result[f] = value
+		}
+	}
+	return result
+}
+
+// NextCursorFor 조회된 항목 수가 페이지 크기와 같으면 마지막 항목으로 다음 커서를 생성합니다
+func (p *PaginationRequest) NextCursorFor(count int, lastID string, lastValue interface{}) string {
+	if count == 0 || count < p.Limit {
+		return ""
+	}
+	return NewCursor(p.Sort, p.Order, lastID, lastValue)
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// EmailDeliveryStatus 메일 발송 상태
+type EmailDeliveryStatus string
+
+const (
+	// EmailDeliveryPending 발송 대기 (첫 시도 전이거나 재시도 예정)
+	EmailDeliveryPending EmailDeliveryStatus = "pending"
+	// EmailDeliverySent 발송 완료
+	EmailDeliverySent EmailDeliveryStatus = "sent"
+	// EmailDeliveryFailed 재시도 한도를 넘었거나 다시 보내도 실패할 에러로 발송 포기
+	EmailDeliveryFailed EmailDeliveryStatus = "failed"
+)
+
+// EmailDelivery 메일 발송 기록
+// 렌더링한 본문을 함께 저장해 재시도할 때 다시 렌더링하지 않습니다.
+// swagger:model EmailDelivery
+type EmailDelivery struct {
+	ID            string              `json:"id"`
+	Template      string              `json:"template"`
+	To            string              `json:"to"`
+	Subject       string              `json:"subject"`
+	HTMLBody      string              `json:"-"`
+	TextBody      string              `json:"-"`
+	Status        EmailDeliveryStatus `json:"status"`
+	Attempts      int                 `json:"attempts"`
+	LastError     string              `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time          `json:"next_attempt_at,omitempty"`
+	SentAt        *time.Time          `json:"sent_at,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+// EmailDeliveryFilter 메일 발송 기록 조회 조건
+type EmailDeliveryFilter struct {
+	// 발송 상태
+	Status EmailDeliveryStatus `form:"status" binding:"omitempty,oneof=pending sent failed"`
+
+	// 템플릿 이름
+	Template string `form:"template"`
+
+	// 받는 사람 (대소문자 무시)
+	To string `form:"to"`
+
+	// 최대 개수 (0이면 기본값)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+// EmailTestRequest 메일 발송 설정 확인용 테스트 메일 요청
+type EmailTestRequest struct {
+	// 받는 사람
+	To string `json:"to" binding:"required,email"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// ErrorStatGranularity 에러 통계 집계 단위
+type ErrorStatGranularity string
+
+const (
+	ErrorStatHourly ErrorStatGranularity = "hour" // 시간별 집계
+	ErrorStatDaily  ErrorStatGranularity = "day"  // 일별 집계
+)
+
+// IsValid 유효한 집계 단위인지 확인
+func (g ErrorStatGranularity) IsValid() bool {
+	return g == ErrorStatHourly || g == ErrorStatDaily
+}
+
+// Truncate 시각을 집계 구간 시작 시각(UTC)으로 내림
+func (g ErrorStatGranularity) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	if g == ErrorStatDaily {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// ErrorStatBucket 집계 구간 하나의 에러 유형별 발생 횟수
+type ErrorStatBucket struct {
+	Granularity ErrorStatGranularity `json:"granularity"`
+	BucketStart time.Time            `json:"bucket_start"`
+	ErrorType   string               `json:"error_type"`
+	Severity    string               `json:"severity"`
+	Category    string               `json:"category"`
+	Count       int64                `json:"count"`
+}
+
+// ErrorStatPoint 시계열 데이터 포인트
+type ErrorStatPoint struct {
+	Time  time.Time `json:"time"`
+	Count int64     `json:"count"`
+}
+
+// ErrorStatSeries 에러 유형별 시계열 (Grafana JSON 데이터 소스에서 바로 사용할 수 있는 형태)
+type ErrorStatSeries struct {
+	ErrorType string           `json:"error_type"`
+	Severity  string           `json:"severity"`
+	Category  string           `json:"category"`
+	Total     int64            `json:"total"`
+	Points    []ErrorStatPoint `json:"points"`
+}
@@ -0,0 +1,127 @@
+package models
+
+import "time"
+
+// FanOutStatus 팬아웃 실행 상태
+type FanOutStatus string
+
+const (
+	FanOutRunning   FanOutStatus = "running"   // 실행 중
+	FanOutCompleted FanOutStatus = "completed" // 모든 워크스페이스 처리 완료 (일부 실패 포함)
+	FanOutCancelled FanOutStatus = "cancelled" // 사용자가 취소
+)
+
+// FanOutTargetStatus 워크스페이스별 실행 상태
+type FanOutTargetStatus string
+
+const (
+	FanOutTargetPending   FanOutTargetStatus = "pending"   // 동시 실행 한도로 대기 중
+	FanOutTargetRunning   FanOutTargetStatus = "running"   // 태스크 실행 중
+	FanOutTargetSucceeded FanOutTargetStatus = "succeeded" // 태스크 성공
+	FanOutTargetFailed    FanOutTargetStatus = "failed"    // 태스크 실패 또는 제출 불가 (활성 세션 없음 등)
+	FanOutTargetCancelled FanOutTargetStatus = "cancelled" // 취소
+)
+
+// FanOutOutputMaxBytes 워크스페이스별로 보관하는 태스크 출력의 최대 크기
+const FanOutOutputMaxBytes = 32 * 1024
+
+// 팬아웃 동시 실행 수
+const (
+	DefaultFanOutConcurrency = 4
+	MaxFanOutConcurrency     = 20
+)
+
+// FanOutTarget 워크스페이스별 실행 결과
+type FanOutTarget struct {
+	WorkspaceID   string             `json:"workspace_id"`
+	WorkspaceName string             `json:"workspace_name"`
+	SessionID     string             `json:"session_id,omitempty"`
+	Status        FanOutTargetStatus `json:"status"`
+	TaskID        string             `json:"task_id,omitempty"`
+	Output        string             `json:"output,omitempty"` // FanOutOutputMaxBytes까지
+	Error         string             `json:"error,omitempty"`
+	StartedAt     *time.Time         `json:"started_at,omitempty"`
+	CompletedAt   *time.Time         `json:"completed_at,omitempty"`
+}
+
+// FanOutProgress 팬아웃 진행 상황 집계
+type FanOutProgress struct {
+	Total     int `json:"total"`
+	Pending   int `json:"pending"`
+	Running   int `json:"running"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Cancelled int `json:"cancelled"`
+	// Percent 끝난 워크스페이스 비율 (0-100)
+	Percent int `json:"percent"`
+}
+
+// FanOut 같은 프롬프트를 여러 워크스페이스에서 병렬로 실행하는 작업
+// swagger:model FanOut
+type FanOut struct {
+	ID          string          `json:"id"`
+	OwnerID     string          `json:"owner_id"`
+	Name        string          `json:"name"`
+	Command     string          `json:"command"` // 변수를 치환한 프롬프트 본문
+	PromptID    string          `json:"prompt_id,omitempty"`
+	Concurrency int             `json:"concurrency"`
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier"`
+	Status      FanOutStatus    `json:"status"`
+	Targets     []FanOutTarget  `json:"targets"`
+	Progress    FanOutProgress  `json:"progress"`
+	// ReportKey 통합 보고서 아티팩트 키 (아티팩트 저장소가 설정된 경우, 완료 후)
+	ReportKey   string     `json:"report_key,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// RefreshProgress 워크스페이스별 상태로 진행 상황을 다시 계산
+func (f *FanOut) RefreshProgress() {
+	progress := FanOutProgress{Total: len(f.Targets)}
+	for _, target := range f.Targets {
+		switch target.Status {
+		case FanOutTargetPending:
+			progress.Pending++
+		case FanOutTargetRunning:
+			progress.Running++
+		case FanOutTargetSucceeded:
+			progress.Succeeded++
+		case FanOutTargetFailed:
+			progress.Failed++
+		case FanOutTargetCancelled:
+			progress.Cancelled++
+		}
+	}
+	if progress.Total > 0 {
+		progress.Percent = (progress.Succeeded + progress.Failed + progress.Cancelled) * 100 / progress.Total
+	}
+	f.Progress = progress
+}
+
+// FanOutRequest 팬아웃 실행 요청
+type FanOutRequest struct {
+	// 이름 (보고서 제목, 비어 있으면 프롬프트 이름 또는 "fan-out")
+	Name string `json:"name" binding:"max=100"`
+
+	// 실행할 워크스페이스 ID 목록
+	WorkspaceIDs []string `json:"workspace_ids" binding:"required,min=1,max=100"`
+
+	// 워크스페이스별 세션 ID (지정하지 않은 워크스페이스는 가장 최근 활성 세션 사용)
+	Sessions map[string]string `json:"sessions,omitempty"`
+
+	// 프롬프트 본문 (prompt_id와 함께 쓸 수 없음)
+	Prompt string `json:"prompt,omitempty" binding:"max=10000"`
+
+	// 프롬프트 라이브러리의 프롬프트 ID
+	PromptID string `json:"prompt_id,omitempty"`
+
+	// 프롬프트 라이브러리 변수 값
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// 동시에 실행할 워크스페이스 수 (기본 4, 최대 20)
+	Concurrency int `json:"concurrency,omitempty" binding:"omitempty,min=1,max=20"`
+
+	// 실행 시간 제한 단계 (quick, standard, long)
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier,omitempty" binding:"omitempty,oneof=quick standard long"`
+}
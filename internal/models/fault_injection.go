@@ -0,0 +1,57 @@
+package models
+
+// FaultInjectionStatus 장애 주입 테스트 모드 상태
+// swagger:model FaultInjectionStatus
+type FaultInjectionStatus struct {
+	// 현재 주입하는 장애
+	Faults FaultInjectionFaults `json:"faults"`
+
+	// 시작 후 실제로 주입한 장애 수
+	Injected FaultInjectionCounts `json:"injected"`
+}
+
+// FaultInjectionFaults 주입할 장애 (확률이 0인 장애는 주입하지 않음)
+type FaultInjectionFaults struct {
+	// 스토리지 호출 지연
+	StorageDelay StorageDelayFault `json:"storage_delay"`
+
+	// Claude 프로세스 강제 종료
+	ProcessKill ProcessKillFault `json:"process_kill"`
+
+	// WebSocket 프레임 누락
+	FrameDrop FrameDropFault `json:"frame_drop"`
+}
+
+// StorageDelayFault 스토리지 호출 지연 주입
+type StorageDelayFault struct {
+	// 호출마다 지연을 주입할 확률 (0~1)
+	Probability float64 `json:"probability" binding:"min=0,max=1"`
+
+	// 최소 지연 시간 (밀리초)
+	MinDelayMS int64 `json:"min_delay_ms" binding:"min=0,max=600000"`
+
+	// 최대 지연 시간 (밀리초, 최소 지연 시간 이상)
+	MaxDelayMS int64 `json:"max_delay_ms" binding:"min=0,max=600000,gtefield=MinDelayMS"`
+}
+
+// ProcessKillFault Claude 프로세스 강제 종료 주입
+type ProcessKillFault struct {
+	// 실행한 프로세스마다 강제 종료할 확률 (0~1)
+	Probability float64 `json:"probability" binding:"min=0,max=1"`
+
+	// 프로세스 시작 후 강제 종료까지 시간 (밀리초)
+	AfterMS int64 `json:"after_ms" binding:"min=0,max=3600000"`
+}
+
+// FrameDropFault WebSocket 프레임 누락 주입
+type FrameDropFault struct {
+	// 보내는 프레임마다 버릴 확률 (0~1)
+	Probability float64 `json:"probability" binding:"min=0,max=1"`
+}
+
+// FaultInjectionCounts 종류별 주입한 장애 수
+type FaultInjectionCounts struct {
+	StorageDelays int64 `json:"storage_delays"`
+	ProcessKills  int64 `json:"process_kills"`
+	FramesDropped int64 `json:"frames_dropped"`
+}
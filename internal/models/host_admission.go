@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// HostResourceUsage 호스트 CPU, 메모리, 디스크 사용률 측정값
+// swagger:model HostResourceUsage
+type HostResourceUsage struct {
+	// CPU 사용률 (%, 직전 측정 이후 평균)
+	CPUPercent float64 `json:"cpu_percent"`
+
+	// 메모리 사용률 (%, 회수 가능한 캐시 제외)
+	MemoryPercent float64 `json:"memory_percent"`
+
+	// 디스크 사용률 (%, DiskPath가 있는 파일 시스템)
+	DiskPercent float64 `json:"disk_percent"`
+
+	// 디스크 사용률을 측정한 경로
+	DiskPath string `json:"disk_path,omitempty"`
+
+	// 측정 시각
+	SampledAt time.Time `json:"sampled_at"`
+}
+
+// HostResourceThresholds 새 태스크 실행을 미루는 사용률 기준 (%, 0이면 검사하지 않음)
+type HostResourceThresholds struct {
+	CPUPercent    float64 `json:"cpu_percent"`
+	MemoryPercent float64 `json:"memory_percent"`
+	DiskPercent   float64 `json:"disk_percent"`
+}
+
+// HostAdmissionStatus 호스트 자원 상태와 실행을 기다리는 태스크 현황
+// swagger:model HostAdmissionStatus
+type HostAdmissionStatus struct {
+	// 최근 측정값 (아직 측정하지 않았거나 지원하지 않는 플랫폼이면 생략)
+	Usage *HostResourceUsage `json:"usage,omitempty"`
+
+	// 실행을 미루는 기준
+	Thresholds HostResourceThresholds `json:"thresholds"`
+
+	// 기준을 넘어 새 claude 태스크 실행을 미루고 있는지 여부
+	Overloaded bool `json:"overloaded"`
+
+	// 기준을 넘은 항목 설명
+	Reasons []string `json:"reasons,omitempty"`
+
+	// 실행을 기다리는 태스크 ID (대기 순서)
+	DeferredTasks []string `json:"deferred_tasks"`
+}
+
+// TaskAdmission 호스트 자원 부족으로 실행이 미뤄진 태스크의 대기 정보
+type TaskAdmission struct {
+	// 실행이 미뤄졌는지 여부
+	Deferred bool `json:"deferred"`
+
+	// 대기 순서 (1부터 시작)
+	QueuePosition int `json:"queue_position"`
+
+	// 실행을 기다리는 전체 태스크 수
+	QueueLength int `json:"queue_length"`
+
+	// 실행을 미룬 이유
+	Reasons []string `json:"reasons,omitempty"`
+}
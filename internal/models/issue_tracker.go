@@ -0,0 +1,122 @@
+package models
+
+import "time"
+
+// IssueTrackerProvider 이슈 트래커 종류
+type IssueTrackerProvider string
+
+const (
+	// IssueTrackerJira Jira (Cloud 또는 Data Center)
+	IssueTrackerJira IssueTrackerProvider = "jira"
+	// IssueTrackerGitHub GitHub Issues
+	IssueTrackerGitHub IssueTrackerProvider = "github"
+)
+
+// IssueTrackerConfig 워크스페이스의 이슈 트래커 연동 설정
+// swagger:model IssueTrackerConfig
+type IssueTrackerConfig struct {
+	WorkspaceID string `json:"workspace_id"`
+
+	// jira 또는 github
+	Provider IssueTrackerProvider `json:"provider"`
+
+	// Jira 사이트 주소 (예: https://acme.atlassian.net) 또는 GitHub API 주소 (비어 있으면 api.github.com)
+	BaseURL string `json:"base_url,omitempty"`
+
+	// 짧은 참조에 쓸 기본 프로젝트 (Jira 프로젝트 키 또는 GitHub owner/name)
+	DefaultProject string `json:"default_project,omitempty"`
+
+	// Jira Cloud 계정 이메일 (비어 있으면 토큰을 Bearer로 전달)
+	Username string `json:"username,omitempty"`
+
+	// API 토큰 (응답에 노출하지 않음)
+	Token string `json:"-"`
+
+	// 토큰 설정 여부
+	HasToken bool `json:"has_token"`
+
+	// 태스크가 끝나면 연결된 이슈에 결과 댓글 작성
+	CommentOnFinish bool `json:"comment_on_finish"`
+
+	// 태스크 결과가 승인되면 적용할 상태 전환 (Jira 전환 이름, GitHub은 closed 또는 open; 비어 있으면 전환하지 않음)
+	ApprovalTransition string `json:"approval_transition,omitempty"`
+
+	UpdatedBy string    `json:"updated_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IssueTrackerConfigRequest 이슈 트래커 연동 설정 저장 요청
+type IssueTrackerConfigRequest struct {
+	Provider       IssueTrackerProvider `json:"provider" binding:"required,oneof=jira github"`
+	BaseURL        string               `json:"base_url" binding:"omitempty,url,max=500"`
+	DefaultProject string               `json:"default_project" binding:"max=255"`
+	Username       string               `json:"username" binding:"max=255"`
+
+	// API 토큰 (비워 두면 기존 토큰 유지)
+	Token string `json:"token" binding:"max=500"`
+
+	CommentOnFinish    bool   `json:"comment_on_finish"`
+	ApprovalTransition string `json:"approval_transition" binding:"max=100"`
+}
+
+// Issue 이슈 트래커에서 가져온 이슈
+type Issue struct {
+	// 정규화한 이슈 키 (PROJ-123 또는 owner/name#123)
+	Key    string `json:"key"`
+	Title  string `json:"title"`
+	Body   string `json:"body,omitempty"`
+	Status string `json:"status,omitempty"`
+	URL    string `json:"url"`
+}
+
+// IssueLink 태스크 또는 세션과 이슈의 연결
+// swagger:model IssueLink
+type IssueLink struct {
+	ID          string `json:"id"`
+	WorkspaceID string `json:"workspace_id"`
+	SessionID   string `json:"session_id"`
+
+	// 비어 있으면 세션 전체에 연결 (세션의 모든 태스크 결과를 댓글로 남김)
+	TaskID string `json:"task_id,omitempty"`
+
+	Provider   IssueTrackerProvider `json:"provider"`
+	IssueKey   string               `json:"issue_key"`
+	IssueURL   string               `json:"issue_url"`
+	IssueTitle string               `json:"issue_title"`
+
+	// 마지막으로 결과 댓글을 남긴 시각
+	CommentedAt *time.Time `json:"commented_at,omitempty"`
+	// 승인 전환을 적용한 시각
+	TransitionedAt *time.Time `json:"transitioned_at,omitempty"`
+	// 마지막 댓글/전환 실패 사유
+	LastError string `json:"last_error,omitempty"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IssueLinkRequest 태스크 또는 세션을 이슈에 연결하는 요청
+type IssueLinkRequest struct {
+	// 이슈 참조 (PROJ-123, owner/name#123, #123 또는 이슈 URL)
+	Issue string `json:"issue" binding:"required,min=1,max=500"`
+}
+
+// IssueTaskCreateRequest 이슈 내용을 컨텍스트로 태스크를 생성하는 요청
+type IssueTaskCreateRequest struct {
+	// 이슈 참조 (PROJ-123, owner/name#123, #123 또는 이슈 URL)
+	Issue string `json:"issue" binding:"required,min=1,max=500"`
+
+	// 이슈 내용 뒤에 덧붙일 지시 (비어 있으면 이슈를 해결하도록 요청)
+	Instructions string `json:"instructions" binding:"max=4000"`
+
+	TimeoutTier   TaskTimeoutTier `json:"timeout_tier,omitempty" binding:"omitempty,oneof=quick standard long"`
+	RequireReview bool            `json:"require_review,omitempty"`
+}
+
+// IssueTaskResponse 이슈로 생성한 태스크와 연결
+type IssueTaskResponse struct {
+	Task *Task      `json:"task"`
+	Link *IssueLink `json:"link"`
+}
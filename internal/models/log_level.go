@@ -0,0 +1,35 @@
+package models
+
+// LogLevelStatus 서버 기본 로그 레벨과 모듈별 로그 레벨
+// swagger:model LogLevelStatus
+type LogLevelStatus struct {
+	// 기본 로그 레벨 (모듈 레벨을 지정하지 않은 모듈과 그 밖의 서버 로그에 적용)
+	// example: info
+	Level string `json:"level"`
+
+	// 모듈별 로그 레벨
+	Modules []ModuleLogLevel `json:"modules"`
+}
+
+// ModuleLogLevel 모듈의 현재 로그 레벨
+type ModuleLogLevel struct {
+	// 모듈 이름 (claude, api, security, storage)
+	Module string `json:"module"`
+
+	// 현재 적용 중인 로그 레벨
+	Level string `json:"level"`
+
+	// 기본 레벨 대신 모듈 레벨을 지정했는지 여부
+	Overridden bool `json:"overridden"`
+}
+
+// LogLevelUpdateRequest 로그 레벨 변경 요청
+// 변경은 설정 파일을 다시 읽으면 설정 값으로 돌아갑니다.
+type LogLevelUpdateRequest struct {
+	// 기본 로그 레벨 (비어 있으면 유지)
+	// example: info
+	Level string `json:"level" binding:"omitempty,oneof=trace debug info warn error fatal"`
+
+	// 모듈별 로그 레벨 (빈 값이면 기본 레벨을 따르도록 되돌림)
+	Modules map[string]string `json:"modules" binding:"omitempty,dive,keys,oneof=claude api security storage,endkeys,omitempty,oneof=trace debug info warn error fatal"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// MaintenanceStatus 유지보수 모드 상태 (프론트엔드 배너 표시에 사용)
+// swagger:model MaintenanceStatus
+type MaintenanceStatus struct {
+	// 유지보수 모드 여부
+	Enabled bool `json:"enabled"`
+
+	// 배너에 표시할 안내 문구
+	Message string `json:"message,omitempty"`
+
+	// 유지보수 시작 시각
+	Since *time.Time `json:"since,omitempty"`
+
+	// 새 태스크를 다시 제출할 수 있을 것으로 예상되는 시간 (초)
+	RetryAfterSeconds int `json:"retry_after_seconds,omitempty"`
+
+	// 새 태스크를 거부하지 않고 보관하는지 여부
+	QueueSubmissions bool `json:"queue_submissions"`
+
+	// 유지보수가 끝나면 실행할 보관 중인 태스크 수
+	Queued int `json:"queued"`
+}
+
+// MaintenanceUpdateRequest 유지보수 모드 변경 요청
+type MaintenanceUpdateRequest struct {
+	// 유지보수 모드 여부
+	Enabled bool `json:"enabled"`
+
+	// 배너 안내 문구 (비어 있으면 기본 문구)
+	Message string `json:"message" binding:"max=500"`
+
+	// Retry-After로 안내할 시간 (초, 0이면 기본값)
+	RetryAfterSeconds int `json:"retry_after_seconds" binding:"min=0,max=86400"`
+
+	// 새 태스크를 보관했다가 유지보수가 끝나면 실행 (생략하면 기본값)
+	QueueSubmissions *bool `json:"queue_submissions"`
+}
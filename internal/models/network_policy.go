@@ -0,0 +1,34 @@
+package models
+
+// NetworkEgressMode 워크스페이스 컨테이너의 외부 네트워크 접근 모드
+type NetworkEgressMode string
+
+const (
+	// NetworkEgressFull 외부 네트워크 전체 허용
+	NetworkEgressFull NetworkEgressMode = "full"
+	// NetworkEgressOffline 네트워크 없음 (loopback만 사용)
+	NetworkEgressOffline NetworkEgressMode = "offline"
+	// NetworkEgressAllowlist 허용된 도메인만 이그레스 프록시를 통해 접근
+	NetworkEgressAllowlist NetworkEgressMode = "allowlist"
+)
+
+// IsValid 알려진 모드인지 확인합니다.
+func (m NetworkEgressMode) IsValid() bool {
+	switch m {
+	case NetworkEgressFull, NetworkEgressOffline, NetworkEgressAllowlist:
+		return true
+	}
+	return false
+}
+
+// NetworkPolicy 워크스페이스 컨테이너 네트워크 이그레스 정책
+// swagger:model NetworkPolicy
+type NetworkPolicy struct {
+	// 이그레스 모드
+	// example: allowlist
+	Mode NetworkEgressMode `json:"mode"`
+
+	// 허용 도메인 (allowlist 모드, "*.example.com"은 하위 도메인 포함)
+	// example: ["github.com", "*.npmjs.org"]
+	AllowedDomains []string `json:"allowed_domains,omitempty"`
+}
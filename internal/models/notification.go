@@ -0,0 +1,131 @@
+package models
+
+import "time"
+
+// NotificationType 알림 종류 (사용자가 종류별로 받을 방법을 정함)
+type NotificationType string
+
+const (
+	// NotificationTypeBudget Claude 자격 증명 일별 예산, 워크스페이스 디스크 한도 경고
+	NotificationTypeBudget NotificationType = "budget"
+	// NotificationTypeApproval 태스크 결과 검토 요청과 승인/거부
+	NotificationTypeApproval NotificationType = "approval"
+	// NotificationTypeInvite 워크스페이스 공유 초대
+	NotificationTypeInvite NotificationType = "invite"
+	// NotificationTypeSecurity 계정 잠금, 비밀번호 변경, 공격 탐지 같은 보안 알림
+	NotificationTypeSecurity NotificationType = "security"
+	// NotificationTypeExport 대화 기록 내보내기 같은 비동기 작업 완료
+	NotificationTypeExport NotificationType = "export"
+)
+
+// NotificationTypes 모든 알림 종류
+var NotificationTypes = []NotificationType{
+	NotificationTypeBudget,
+	NotificationTypeApproval,
+	NotificationTypeInvite,
+	NotificationTypeSecurity,
+	NotificationTypeExport,
+}
+
+// NotificationEmailMode 알림 메일 발송 방법
+type NotificationEmailMode string
+
+const (
+	// NotificationEmailOff 메일을 보내지 않음
+	NotificationEmailOff NotificationEmailMode = "off"
+	// NotificationEmailImmediate 알림마다 바로 메일 발송
+	NotificationEmailImmediate NotificationEmailMode = "immediate"
+	// NotificationEmailDigest 모아서 주기적으로 요약 메일 발송
+	NotificationEmailDigest NotificationEmailMode = "digest"
+)
+
+// Notification 사용자에게 온 알림
+// swagger:model Notification
+type Notification struct {
+	ID      string           `json:"id"`
+	UserID  string           `json:"user_id"`
+	Type    NotificationType `json:"type"`
+	Title   string           `json:"title"`
+	Message string           `json:"message"`
+
+	// Link 웹 UI에서 관련 화면 경로 (예: /workspaces/{id})
+	Link string `json:"link,omitempty"`
+
+	Data map[string]string `json:"data,omitempty"`
+
+	// ReadAt 읽음으로 표시한 시각 (안 읽었으면 생략)
+	ReadAt *time.Time `json:"read_at,omitempty"`
+
+	// DigestPending 요약 메일로 보낼 차례를 기다리는지 (메일로 보냈으면 false)
+	DigestPending bool `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NotificationFilter 알림 조회 조건
+type NotificationFilter struct {
+	UserID string
+
+	// 알림 종류 (비어 있으면 전체)
+	Type NotificationType
+
+	// 안 읽은 알림만
+	UnreadOnly bool
+
+	// 이 시각보다 나중에 생긴 알림만 (폴링용)
+	Since *time.Time
+}
+
+// NotificationListRequest 알림 조회 요청
+type NotificationListRequest struct {
+	Type       NotificationType `form:"type" binding:"omitempty,oneof=budget approval invite security export"`
+	UnreadOnly bool             `form:"unread"`
+
+	// Since 이 시각보다 나중에 생긴 알림만 (마지막으로 받은 알림의 created_at으로 폴링)
+	Since *time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+
+	Page  int `form:"page"`
+	Limit int `form:"limit"`
+}
+
+// NotificationListResponse 알림 목록과 안 읽은 알림 수
+// swagger:model NotificationListResponse
+type NotificationListResponse struct {
+	Data []*Notification `json:"data"`
+	Meta PaginationMeta  `json:"meta"`
+
+	// 종류와 상관없이 안 읽은 알림 수
+	Unread int `json:"unread"`
+}
+
+// NotificationReadState 읽음 표시 결과
+// swagger:model NotificationReadState
+type NotificationReadState struct {
+	// 이번에 읽음으로 표시한 알림 수
+	Marked int `json:"marked"`
+	Unread int `json:"unread"`
+}
+
+// NotificationPreference 알림 종류별 받을 방법
+// swagger:model NotificationPreference
+type NotificationPreference struct {
+	Type NotificationType `json:"type"`
+
+	// InApp 알림 센터에 저장하고 WebSocket으로 알릴지 여부
+	InApp bool `json:"in_app"`
+
+	// Email 메일 발송 방법 (알림 센터에 저장하지 않으면 digest도 바로 발송)
+	Email NotificationEmailMode `json:"email"`
+}
+
+// NotificationPreferencesRequest 알림 설정 변경 요청 (없는 종류는 바꾸지 않음)
+type NotificationPreferencesRequest struct {
+	Preferences []NotificationPreferenceUpdate `json:"preferences" binding:"required,min=1,dive"`
+}
+
+// NotificationPreferenceUpdate 알림 종류 하나의 설정 변경
+type NotificationPreferenceUpdate struct {
+	Type  NotificationType      `json:"type" binding:"required,oneof=budget approval invite security export"`
+	InApp *bool                 `json:"in_app,omitempty"`
+	Email NotificationEmailMode `json:"email,omitempty" binding:"omitempty,oneof=off immediate digest"`
+}
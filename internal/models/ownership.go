@@ -0,0 +1,143 @@
+package models
+
+// OwnershipAction 소유권 변경 작업 종류
+type OwnershipAction string
+
+const (
+	// OwnershipActionTransfer 워크스페이스 소유자 변경
+	OwnershipActionTransfer OwnershipAction = "transfer"
+	// OwnershipActionMove 프로젝트를 다른 워크스페이스로 이동
+	OwnershipActionMove OwnershipAction = "move"
+	// OwnershipActionArchive 워크스페이스 아카이브
+	OwnershipActionArchive OwnershipAction = "archive"
+	// OwnershipActionRevoke 다른 워크스페이스에 부여된 ACL 항목 회수
+	OwnershipActionRevoke OwnershipAction = "revoke"
+)
+
+// WorkspaceTransferRequest 워크스페이스 소유권 이전 요청
+type WorkspaceTransferRequest struct {
+	// 새 소유자 ID
+	ToUserID string `json:"to_user_id" binding:"required"`
+
+	// 새 소유자에게 같은 이름의 워크스페이스가 있으면 이름 뒤에 접미사를 붙임 (false면 실패)
+	RenameOnConflict bool `json:"rename_on_conflict"`
+
+	// 이전 소유자에게 남길 ACL 권한 (비어 있으면 접근 권한 없음)
+	KeepAccess WorkspacePermission `json:"keep_access" binding:"omitempty,oneof=read execute admin"`
+}
+
+// ProjectTransferRequest 프로젝트 이전 요청
+type ProjectTransferRequest struct {
+	// 프로젝트를 옮길 워크스페이스 ID
+	TargetWorkspaceID string `json:"target_workspace_id" binding:"required"`
+}
+
+// BulkTransferRequest 사용자 간 일괄 소유권 이전 요청 (관리자용)
+// swagger:model BulkTransferRequest
+type BulkTransferRequest struct {
+	// 현재 소유자 ID
+	FromUserID string `json:"from_user_id" binding:"required"`
+
+	// 새 소유자 ID
+	ToUserID string `json:"to_user_id" binding:"required"`
+
+	// 이전할 워크스페이스 (workspace_ids와 project_ids가 모두 비어 있으면 현재 소유자의 모든 워크스페이스)
+	WorkspaceIDs []string `json:"workspace_ids" binding:"max=100,dive,required"`
+
+	// target_workspace_id로 옮길 프로젝트 (현재 소유자의 워크스페이스에 속해야 함)
+	ProjectIDs []string `json:"project_ids" binding:"max=100,dive,required"`
+
+	// 프로젝트를 옮길 새 소유자의 워크스페이스 ID (project_ids가 있으면 필수)
+	TargetWorkspaceID string `json:"target_workspace_id"`
+
+	// 워크스페이스 이름 충돌 시 접미사 추가
+	RenameOnConflict bool `json:"rename_on_conflict"`
+
+	// 변경하지 않고 결과만 미리보기
+	DryRun bool `json:"dry_run"`
+}
+
+// OffboardMode 오프보딩 시 소유 워크스페이스 처리 방식
+type OffboardMode string
+
+const (
+	// OffboardReassign 다른 사용자에게 이전
+	OffboardReassign OffboardMode = "reassign"
+	// OffboardArchive 아카이브
+	OffboardArchive OffboardMode = "archive"
+)
+
+// OffboardRequest 사용자 오프보딩 요청 (관리자용)
+// swagger:model OffboardRequest
+type OffboardRequest struct {
+	// 소유 워크스페이스 처리 방식 (reassign, archive)
+	Mode OffboardMode `json:"mode" binding:"required,oneof=reassign archive"`
+
+	// 워크스페이스를 넘겨받을 사용자 ID (reassign일 때 필수)
+	ToUserID string `json:"to_user_id"`
+
+	// 변경하지 않고 결과만 미리보기
+	DryRun bool `json:"dry_run"`
+}
+
+// OwnershipChange 소유권 변경 항목 (미리보기와 실행 결과에 함께 사용)
+type OwnershipChange struct {
+	// 작업 종류 (transfer, move, archive, revoke)
+	Action OwnershipAction `json:"action"`
+
+	// 리소스 종류 (workspace, project)
+	ResourceType string `json:"resource_type"`
+
+	// 리소스 ID
+	ResourceID string `json:"resource_id"`
+
+	// 리소스 이름
+	Name string `json:"name"`
+
+	// 이름 충돌로 바뀌는 이름
+	NewName string `json:"new_name,omitempty"`
+
+	// 이전 소유자
+	FromUserID string `json:"from_user_id,omitempty"`
+
+	// 새 소유자
+	ToUserID string `json:"to_user_id,omitempty"`
+
+	// 프로젝트가 옮겨갈 워크스페이스 (move)
+	TargetWorkspaceID string `json:"target_workspace_id,omitempty"`
+
+	// 실패 시 에러 정보 (미리보기에서는 실행하면 실패할 항목)
+	Error *BatchItemError `json:"error,omitempty"`
+}
+
+// OwnershipResult 일괄 이전과 오프보딩 결과
+// swagger:model OwnershipResult
+type OwnershipResult struct {
+	// 미리보기 여부 (true면 아무것도 변경되지 않음)
+	DryRun bool `json:"dry_run"`
+
+	// 오프보딩 대상 사용자
+	UserID string `json:"user_id,omitempty"`
+
+	// 로컬 계정을 비활성화했는지 (미리보기에서는 비활성화할 예정인지)
+	AccountDeactivated bool `json:"account_deactivated,omitempty"`
+
+	// 항목별 변경 내용
+	Changes []OwnershipChange `json:"changes"`
+
+	// 성공(미리보기에서는 성공할) 항목 수
+	Succeeded int `json:"succeeded"`
+
+	// 실패 항목 수
+	Failed int `json:"failed"`
+}
+
+// Add 변경 항목을 추가하고 집계를 갱신
+func (r *OwnershipResult) Add(change OwnershipChange) {
+	r.Changes = append(r.Changes, change)
+	if change.Error == nil {
+		r.Succeeded++
+	} else {
+		r.Failed++
+	}
+}
@@ -17,6 +17,12 @@ type PaginationRequest struct {
 	
 	// 정렬 순서 (asc, desc)
 	Order string `form:"order,default=desc" binding:"oneof=asc desc"`
+	
+	// 키셋 페이지네이션 커서 (지정 시 page 대신 사용)
+	Cursor string `form:"cursor"`
+	
+	// 응답에 포함할 필드 목록 (쉼표 구분)
+	Fields string `form:"fields"`
 }
 
 // Note: PaginationMeta is defined in common.go to avoid duplication
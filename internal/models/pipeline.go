@@ -0,0 +1,163 @@
+package models
+
+import "time"
+
+// PipelineStepType 파이프라인 단계 종류
+type PipelineStepType string
+
+const (
+	// PipelineStepPrompt Claude 프롬프트 단계 (직접 작성한 본문 또는 프롬프트 라이브러리)
+	PipelineStepPrompt PipelineStepType = "prompt"
+	// PipelineStepHook 셸 명령 단계 (태스크 명령 허용 목록 적용)
+	PipelineStepHook PipelineStepType = "hook"
+)
+
+// PipelineRunStatus 파이프라인 실행 상태
+type PipelineRunStatus string
+
+const (
+	PipelineRunRunning   PipelineRunStatus = "running"   // 실행 중
+	PipelineRunSucceeded PipelineRunStatus = "succeeded" // 모든 단계 완료
+	PipelineRunFailed    PipelineRunStatus = "failed"    // 단계 실패로 중단 (재개 가능)
+	PipelineRunCancelled PipelineRunStatus = "cancelled" // 사용자가 취소 (재개 가능)
+)
+
+// IsTerminal 실행이 끝난 상태인지 확인
+func (s PipelineRunStatus) IsTerminal() bool {
+	return s != PipelineRunRunning
+}
+
+// PipelineStepStatus 파이프라인 단계 실행 상태
+type PipelineStepStatus string
+
+const (
+	PipelineStepPending   PipelineStepStatus = "pending"   // 대기 중
+	PipelineStepRunning   PipelineStepStatus = "running"   // 태스크 실행 중
+	PipelineStepPassed    PipelineStepStatus = "passed"    // 통과
+	PipelineStepFailed    PipelineStepStatus = "failed"    // 실패 (태스크 실패 또는 조건 불충족)
+	PipelineStepCancelled PipelineStepStatus = "cancelled" // 실행 취소
+)
+
+// PipelineArtifactMaxBytes 다음 단계로 넘기기 위해 보관하는 단계 출력의 최대 크기
+const PipelineArtifactMaxBytes = 64 * 1024
+
+// PipelineCondition 단계 통과 조건
+// 태스크가 성공하고 지정한 조건을 모두 만족해야 통과합니다.
+type PipelineCondition struct {
+	// 출력에 포함되어야 하는 문자열
+	OutputContains string `json:"output_contains,omitempty"`
+
+	// 출력에 포함되면 안 되는 문자열
+	OutputNotContains string `json:"output_not_contains,omitempty"`
+
+	// 출력이 일치해야 하는 정규식
+	OutputMatches string `json:"output_matches,omitempty"`
+}
+
+// PipelineStep 파이프라인 단계 정의
+// 본문, 명령, 변수 값에서 {{steps.<이름>.output}}으로 앞 단계의 출력을 사용할 수 있습니다.
+type PipelineStep struct {
+	// 단계 이름 (파이프라인 안에서 고유, 영문자/숫자/_/-)
+	// example: build
+	Name string `json:"name" binding:"required,max=64"`
+
+	// 단계 종류 (prompt, hook)
+	Type PipelineStepType `json:"type" binding:"required,oneof=prompt hook"`
+
+	// 프롬프트 본문 (prompt 단계, prompt_id와 함께 쓸 수 없음)
+	Prompt string `json:"prompt,omitempty" binding:"max=10000"`
+
+	// 프롬프트 라이브러리의 프롬프트 ID (prompt 단계)
+	PromptID string `json:"prompt_id,omitempty"`
+
+	// 프롬프트 라이브러리 변수 값
+	Variables map[string]string `json:"variables,omitempty"`
+
+	// 실행할 명령 (hook 단계)
+	Command string `json:"command,omitempty" binding:"max=10000"`
+
+	// 실행 시간 제한 단계 (quick, standard, long)
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier,omitempty" binding:"omitempty,oneof=quick standard long"`
+
+	// 통과 조건 (없으면 태스크 성공 시 통과)
+	PassIf *PipelineCondition `json:"pass_if,omitempty"`
+
+	// 실패해도 다음 단계를 계속 실행할지 여부
+	ContinueOnFailure bool `json:"continue_on_failure"`
+}
+
+// Pipeline 순서대로 실행하는 다단계 워크플로
+// swagger:model Pipeline
+type Pipeline struct {
+	ID          string         `json:"id"`
+	OwnerID     string         `json:"owner_id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	Steps       []PipelineStep `json:"steps"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// PipelineCreateRequest 파이프라인 생성 요청
+type PipelineCreateRequest struct {
+	// 이름 (작성자별로 고유)
+	Name string `json:"name" binding:"required,min=1,max=100"`
+
+	// 설명
+	Description string `json:"description" binding:"max=500"`
+
+	// 단계 목록 (순서대로 실행)
+	Steps []PipelineStep `json:"steps" binding:"required,min=1,max=50,dive"`
+}
+
+// PipelineUpdateRequest 파이프라인 수정 요청 (지정한 필드만 변경)
+type PipelineUpdateRequest struct {
+	Name        *string         `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	Description *string         `json:"description,omitempty" binding:"omitempty,max=500"`
+	Steps       *[]PipelineStep `json:"steps,omitempty" binding:"omitempty,min=1,max=50,dive"`
+}
+
+// PipelineStepRun 단계 실행 기록
+type PipelineStepRun struct {
+	Name        string             `json:"name"`
+	Status      PipelineStepStatus `json:"status"`
+	TaskID      string             `json:"task_id,omitempty"`
+	Output      string             `json:"output,omitempty"` // 다음 단계로 넘기는 출력 (PipelineArtifactMaxBytes까지)
+	Error       string             `json:"error,omitempty"`
+	Attempts    int                `json:"attempts"`
+	StartedAt   *time.Time         `json:"started_at,omitempty"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+}
+
+// PipelineRun 파이프라인 실행
+// 실행 시작 시점의 단계 정의를 함께 저장하므로 파이프라인을 수정해도 진행 중인 실행과 재개에는 영향이 없습니다.
+type PipelineRun struct {
+	ID           string            `json:"id"`
+	PipelineID   string            `json:"pipeline_id"`
+	PipelineName string            `json:"pipeline_name"`
+	OwnerID      string            `json:"owner_id"`
+	SessionID    string            `json:"session_id"`
+	Status       PipelineRunStatus `json:"status"`
+	CurrentStep  int               `json:"current_step"` // 실행 중이거나 멈춘 단계 번호 (0부터)
+	Steps        []PipelineStep    `json:"steps"`
+	StepRuns     []PipelineStepRun `json:"step_runs"`
+	Error        string            `json:"error,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	CompletedAt  *time.Time        `json:"completed_at,omitempty"`
+}
+
+// PipelineRunRequest 파이프라인 실행 요청
+type PipelineRunRequest struct {
+	// 단계 태스크를 실행할 세션 ID
+	SessionID string `json:"session_id" binding:"required"`
+}
+
+// PipelineRunFilter 파이프라인 실행 조회 조건
+type PipelineRunFilter struct {
+	OwnerID    string            `form:"-"`
+	PipelineID string            `form:"pipeline_id"`
+	Status     PipelineRunStatus `form:"status" binding:"omitempty,oneof=running succeeded failed cancelled"`
+	// Limit 최대 개수 (0이면 제한 없음)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=500"`
+}
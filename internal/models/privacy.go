@@ -0,0 +1,144 @@
+package models
+
+import "time"
+
+// PrivacyRequestType 개인정보 요청 종류
+type PrivacyRequestType string
+
+const (
+	// PrivacyRequestExport 개인정보 내보내기
+	PrivacyRequestExport PrivacyRequestType = "export"
+	// PrivacyRequestErasure 개인정보 삭제 (익명화)
+	PrivacyRequestErasure PrivacyRequestType = "erasure"
+)
+
+// PrivacyRequest 개인정보 내보내기/삭제 처리 감사 기록
+// 처리 후에도 남겨야 하므로 사용자 ID 외의 개인정보는 저장하지 않습니다.
+// swagger:model PrivacyRequest
+type PrivacyRequest struct {
+	ID   string             `json:"id"`
+	Type PrivacyRequestType `json:"type"`
+
+	// 정보 주체 (내보내거나 삭제한 사용자)
+	SubjectID string `json:"subject_id"`
+
+	// 요청을 처리한 사용자 (본인 내보내기면 SubjectID와 같음)
+	ActorID string `json:"actor_id"`
+
+	// 항목별 처리 건수 (예: workspaces, sessions, share_joins)
+	Summary map[string]int `json:"summary,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PrivacyRequestFilter 개인정보 요청 기록 조회 조건
+type PrivacyRequestFilter struct {
+	// 정보 주체 (비어 있으면 전체)
+	SubjectID string `form:"subject_id"`
+
+	// 요청 종류 (비어 있으면 전체)
+	Type PrivacyRequestType `form:"type" binding:"omitempty,oneof=export erasure"`
+
+	// 최대 개수 (0이면 전체)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=1000"`
+}
+
+// UserDataSession 내보내기에 포함하는 세션 (대화 기록은 개수만 포함)
+type UserDataSession struct {
+	*Session
+	MessageCount int `json:"message_count"`
+}
+
+// UserDataExport 사용자 한 명의 개인정보 내보내기 묶음
+// swagger:model UserDataExport
+type UserDataExport struct {
+	UserID     string    `json:"user_id"`
+	ExportedAt time.Time `json:"exported_at"`
+
+	// 로컬 계정 프로필 (OAuth 사용자처럼 로컬 계정이 없으면 비어 있음)
+	Account *Account `json:"account,omitempty"`
+
+	// 소유한 워크스페이스 (API 키는 마스킹)
+	Workspaces []*Workspace `json:"workspaces"`
+
+	// 소유한 워크스페이스의 프로젝트
+	Projects []*Project `json:"projects"`
+
+	// 소유한 워크스페이스의 세션
+	Sessions []UserDataSession `json:"sessions"`
+
+	// 다른 워크스페이스에서 공유받은 권한
+	SharedWorkspaces []*WorkspaceACLEntry `json:"shared_workspaces"`
+
+	// 작성한 프롬프트
+	Prompts []*Prompt `json:"prompts"`
+
+	// 이 사용자에 대한 감사 기록
+	Audit UserDataAudit `json:"audit"`
+}
+
+// UserDataAudit 내보내기에 포함하는 감사 기록
+type UserDataAudit struct {
+	// 공유 링크로 세션에 참여한 기록
+	ShareJoins []*SessionShareJoin `json:"share_joins"`
+
+	// 워크스페이스 터미널 접속 기록 (녹화 본문 제외)
+	TerminalSessions []*TerminalSession `json:"terminal_sessions"`
+
+	// 이전 개인정보 내보내기/삭제 처리 기록
+	PrivacyRequests []*PrivacyRequest `json:"privacy_requests"`
+}
+
+// ErasureRequest 개인정보 삭제 요청 (관리자용)
+// swagger:model ErasureRequest
+type ErasureRequest struct {
+	// 확인을 위해 삭제할 사용자 ID를 다시 입력
+	Confirm string `json:"confirm" binding:"required"`
+
+	// 소유 워크스페이스 처리 방식 (reassign, archive)
+	Mode OffboardMode `json:"mode" binding:"required,oneof=reassign archive"`
+
+	// 워크스페이스를 넘겨받을 사용자 ID (reassign일 때 필수)
+	ToUserID string `json:"to_user_id"`
+
+	// 변경하지 않고 결과만 미리보기
+	DryRun bool `json:"dry_run"`
+}
+
+// ErasureResult 개인정보 삭제 결과
+// 사용자 ID는 다른 기록이 참조하므로 남기고, 사용자를 알아볼 수 있는 값만 지우거나 익명화합니다.
+// swagger:model ErasureResult
+type ErasureResult struct {
+	// 미리보기 여부 (true면 아무것도 변경되지 않음)
+	DryRun bool `json:"dry_run"`
+
+	// 삭제 대상 사용자
+	UserID string `json:"user_id"`
+
+	// 로컬 계정의 사용자명, 이메일, 표시 이름, 비밀번호를 지웠는지
+	AccountAnonymized bool `json:"account_anonymized"`
+
+	// 소유 워크스페이스 이전/아카이브와 공유 권한 회수 결과
+	Ownership *OwnershipResult `json:"ownership"`
+
+	// 삭제한 대화 메시지 수 (아카이브한 워크스페이스의 세션)
+	MessagesDeleted int `json:"messages_deleted"`
+
+	// 명령(프롬프트), 출력, 에러를 지운 태스크 수 (아카이브한 워크스페이스의 세션)
+	TasksErased int `json:"tasks_erased"`
+
+	// 삭제한 프롬프트 라이브러리 항목 수
+	PromptsDeleted int `json:"prompts_deleted"`
+
+	// 이미 발급한 토큰을 차단했는지
+	TokensRevoked bool `json:"tokens_revoked"`
+
+	// 접속 IP, User-Agent, 표시 이름을 지운 공유 링크 참여 기록 수
+	ShareJoinsAnonymized int `json:"share_joins_anonymized"`
+
+	// 접속 IP, User-Agent와 녹화를 지운 터미널 접속 기록 수
+	TerminalSessionsAnonymized int `json:"terminal_sessions_anonymized"`
+
+	// 처리 감사 기록 ID (미리보기면 비어 있음)
+	RequestID string `json:"request_id,omitempty"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// ProcessRecord 서버 인스턴스가 실행한 CLI 프로세스 기록
+// 서버가 비정상 종료된 뒤 남은 고아 프로세스를 찾는 데 사용합니다.
+type ProcessRecord struct {
+	InstanceID  string     `json:"instance_id"`
+	PID         int        `json:"pid"`
+	Fingerprint string     `json:"fingerprint"` // 부팅 ID와 프로세스 시작 시각 (PID 재사용 구분)
+	Command     string     `json:"command"`
+	Args        []string   `json:"args,omitempty"`
+	WorkingDir  string     `json:"working_dir,omitempty"`
+	StartedAt   time.Time  `json:"started_at"`
+	AdoptedAt   *time.Time `json:"adopted_at,omitempty"` // 재시작 후 다시 관리하기 시작한 시각
+}
@@ -0,0 +1,124 @@
+package models
+
+import "time"
+
+// PromptVisibility 프롬프트 공개 범위
+type PromptVisibility string
+
+const (
+	// PromptVisibilityPrivate 작성자만 사용
+	PromptVisibilityPrivate PromptVisibility = "private"
+	// PromptVisibilityOrg 조직 전체에 공유 (수정과 삭제는 작성자만)
+	PromptVisibilityOrg PromptVisibility = "org"
+)
+
+// PromptVariable 프롬프트 템플릿 변수
+// 본문의 {{name}} 자리에 실행 시 전달한 값이 들어갑니다.
+type PromptVariable struct {
+	// 변수 이름 (영문자 또는 _로 시작, 영문자/숫자/_)
+	// example: file_path
+	Name string `json:"name" binding:"required,max=64"`
+
+	// 설명
+	Description string `json:"description,omitempty" binding:"max=500"`
+
+	// 필수 여부 (기본값이 없으면 실행 시 값을 넣어야 함)
+	Required bool `json:"required"`
+
+	// 기본값
+	Default string `json:"default,omitempty"`
+
+	// 허용 값 목록 (비어 있으면 제한 없음)
+	Options []string `json:"options,omitempty" binding:"max=50"`
+
+	// 최대 길이 (0이면 제한 없음)
+	MaxLength int `json:"max_length,omitempty" binding:"min=0,max=10000"`
+}
+
+// Prompt 재사용 가능한 프롬프트 (플레이북)
+// swagger:model Prompt
+type Prompt struct {
+	ID          string           `json:"id"`
+	OwnerID     string           `json:"owner_id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Content     string           `json:"content"`
+	Variables   []PromptVariable `json:"variables"`
+	Tags        []string         `json:"tags"`
+	Visibility  PromptVisibility `json:"visibility"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// PromptCreateRequest 프롬프트 생성 요청
+type PromptCreateRequest struct {
+	// 이름 (작성자별로 고유)
+	Name string `json:"name" binding:"required,min=1,max=100"`
+
+	// 설명
+	Description string `json:"description" binding:"max=500"`
+
+	// 템플릿 본문 ({{변수}} 사용)
+	// example: {{file_path}} 파일의 테스트를 작성해 주세요
+	Content string `json:"content" binding:"required,min=1,max=10000"`
+
+	// 템플릿 변수 (본문에 쓰인 변수는 모두 선언해야 함)
+	Variables []PromptVariable `json:"variables" binding:"max=50,dive"`
+
+	// 태그
+	Tags []string `json:"tags" binding:"max=10,dive,min=1,max=50"`
+
+	// 공개 범위 (private, org; 기본값 private)
+	Visibility PromptVisibility `json:"visibility" binding:"omitempty,oneof=private org"`
+}
+
+// PromptUpdateRequest 프롬프트 수정 요청 (지정한 필드만 변경)
+type PromptUpdateRequest struct {
+	Name        *string           `json:"name" binding:"omitempty,min=1,max=100"`
+	Description *string           `json:"description" binding:"omitempty,max=500"`
+	Content     *string           `json:"content" binding:"omitempty,min=1,max=10000"`
+	Variables   *[]PromptVariable `json:"variables" binding:"omitempty,max=50,dive"`
+	Tags        *[]string         `json:"tags" binding:"omitempty,max=10,dive,min=1,max=50"`
+	Visibility  *PromptVisibility `json:"visibility" binding:"omitempty,oneof=private org"`
+}
+
+// PromptFilter 프롬프트 목록 조회 조건
+type PromptFilter struct {
+	// 조회하는 사용자 (본인 프롬프트와 조직 공유 프롬프트만 반환, 비어 있으면 전체)
+	ViewerID string `form:"-"`
+
+	// mine: 본인 프롬프트만, shared: 다른 사용자의 공유 프롬프트만
+	Scope string `form:"scope" binding:"omitempty,oneof=mine shared"`
+
+	// 태그
+	Tag string `form:"tag"`
+
+	// 이름 또는 설명 검색어 (대소문자 무시)
+	Search string `form:"q"`
+
+	// 최대 개수 (0이면 기본값)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=500"`
+}
+
+// PromptRenderRequest 프롬프트 변수 치환 요청
+type PromptRenderRequest struct {
+	// 변수 값
+	Variables map[string]string `json:"variables"`
+}
+
+// PromptRenderResponse 변수를 치환한 프롬프트 본문
+type PromptRenderResponse struct {
+	Content string `json:"content"`
+}
+
+// PromptLaunchRequest 프롬프트로 태스크 실행 요청
+type PromptLaunchRequest struct {
+	// 태스크를 실행할 세션 ID
+	SessionID string `json:"session_id" binding:"required"`
+
+	// 변수 값
+	Variables map[string]string `json:"variables"`
+
+	// 실행 시간 제한 단계 (quick, standard, long; 비어 있으면 standard)
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier,omitempty" binding:"omitempty,oneof=quick standard long"`
+}
@@ -0,0 +1,130 @@
+package models
+
+import "time"
+
+const (
+	// DefaultGitHubAPIURL GitHub API 기본 주소
+	DefaultGitHubAPIURL = "https://api.github.com"
+	// DefaultGitLabAPIURL GitLab API 기본 주소
+	DefaultGitLabAPIURL = "https://gitlab.com/api/v4"
+	// DefaultPullRequestBranchPrefix PR 브랜치 이름 접두사 기본값
+	DefaultPullRequestBranchPrefix = "aicli/"
+)
+
+// PullRequestConfig 워크스페이스의 PR/MR 생성 설정
+// swagger:model PullRequestConfig
+type PullRequestConfig struct {
+	WorkspaceID string `json:"workspace_id"`
+
+	// github 또는 gitlab
+	Provider WebhookProvider `json:"provider"`
+
+	// GitHub full_name 또는 GitLab path_with_namespace
+	Repository string `json:"repository"`
+
+	// PR 대상 브랜치
+	BaseBranch string `json:"base_branch"`
+
+	// 태스크 브랜치 이름 접두사 (<접두사>task-<태스크 ID 앞 8자>)
+	BranchPrefix string `json:"branch_prefix"`
+
+	// API 주소 (GitHub Enterprise, 자체 호스팅 GitLab; 비어 있으면 공용 서비스)
+	APIURL string `json:"api_url,omitempty"`
+
+	// 브랜치 푸시와 PR 생성에 쓰는 액세스 토큰 (응답에 노출하지 않음)
+	Token string `json:"-"`
+
+	// 토큰 설정 여부
+	HasToken bool `json:"has_token"`
+
+	// 초안 PR로 생성할지 여부
+	Draft bool `json:"draft"`
+
+	// PR에 붙일 라벨
+	Labels []string `json:"labels"`
+
+	UpdatedBy string    `json:"updated_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ResolvedAPIURL 설정한 API 주소 또는 제공자 기본 주소
+func (c *PullRequestConfig) ResolvedAPIURL() string {
+	if c.APIURL != "" {
+		return c.APIURL
+	}
+	if c.Provider == WebhookProviderGitLab {
+		return DefaultGitLabAPIURL
+	}
+	return DefaultGitHubAPIURL
+}
+
+// PullRequestConfigRequest PR 생성 설정 저장 요청
+type PullRequestConfigRequest struct {
+	Provider   WebhookProvider `json:"provider" binding:"required,oneof=github gitlab"`
+	Repository string          `json:"repository" binding:"required,min=1,max=255"`
+
+	// 대상 브랜치 (기본값 main)
+	BaseBranch string `json:"base_branch" binding:"max=255"`
+
+	// 브랜치 접두사 (기본값 aicli/)
+	BranchPrefix string `json:"branch_prefix" binding:"max=100"`
+
+	APIURL string `json:"api_url" binding:"omitempty,url,max=500"`
+
+	// 액세스 토큰 (비워 두면 기존 토큰 유지)
+	Token string `json:"token" binding:"max=500"`
+
+	Draft  bool     `json:"draft"`
+	Labels []string `json:"labels" binding:"max=20,dive,min=1,max=50"`
+}
+
+// PullRequestStatus PR 생성 상태
+type PullRequestStatus string
+
+const (
+	// PullRequestOpened PR/MR을 생성함
+	PullRequestOpened PullRequestStatus = "opened"
+	// PullRequestFailed 브랜치 푸시 또는 PR 생성에 실패함 (다시 시도 가능)
+	PullRequestFailed PullRequestStatus = "failed"
+)
+
+// PullRequest 태스크 결과로 만든 PR/MR
+// swagger:model PullRequest
+type PullRequest struct {
+	ID          string            `json:"id"`
+	TaskID      string            `json:"task_id"`
+	SessionID   string            `json:"session_id"`
+	WorkspaceID string            `json:"workspace_id"`
+	Provider    WebhookProvider   `json:"provider"`
+	Repository  string            `json:"repository"`
+	Branch      string            `json:"branch"`
+	BaseBranch  string            `json:"base_branch"`
+	CommitSHA   string            `json:"commit_sha,omitempty"`
+	Status      PullRequestStatus `json:"status"`
+
+	// GitHub PR 번호 또는 GitLab MR IID
+	Number int    `json:"number,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Title  string `json:"title"`
+	Error  string `json:"error,omitempty"`
+
+	// 세션 기록 링크 (PR 본문과 커밋 상태에 포함)
+	TranscriptURL string `json:"transcript_url,omitempty"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PullRequestCreateRequest 태스크 결과로 PR/MR 생성 요청
+type PullRequestCreateRequest struct {
+	// 제목 (비어 있으면 태스크 명령의 첫 줄)
+	Title string `json:"title" binding:"max=200"`
+
+	// 대상 브랜치 (비어 있으면 워크스페이스 설정)
+	BaseBranch string `json:"base_branch" binding:"max=255"`
+
+	// 초안 여부 (비어 있으면 워크스페이스 설정)
+	Draft *bool `json:"draft"`
+}
@@ -14,6 +14,8 @@ const (
 	ResourceTypeTask      ResourceType = "task"
 	ResourceTypeUser      ResourceType = "user"
 	ResourceTypeSystem    ResourceType = "system"
+	ResourceTypeSecurity  ResourceType = "security" // 보안 이벤트와 대시보드 (보안 관리자)
+	ResourceTypeTerminal  ResourceType = "terminal" // 워크스페이스 대화형 터미널 (terminal:execute)
 )
 
 // ActionType 액션 타입
@@ -204,6 +206,8 @@ type UpdateRoleRequest struct {
 	Description *string `json:"description,omitempty" validate:"omitempty,max=200"`
 	ParentID    *string `json:"parent_id,omitempty" validate:"omitempty,uuid"`
 	IsActive    *bool   `json:"is_active,omitempty"`
+	// Version 수정 기준으로 삼은 역할 버전 (다른 요청이 먼저 수정했으면 409, 생략하면 검사하지 않음)
+	Version int `json:"version,omitempty" validate:"omitempty,min=1"`
 }
 
 // CreatePermissionRequest 권한 생성 요청
@@ -246,7 +250,7 @@ type CheckPermissionResponse struct {
 func (rt ResourceType) IsValid() bool {
 	switch rt {
 	case ResourceTypeWorkspace, ResourceTypeProject, ResourceTypeSession, 
-		 ResourceTypeTask, ResourceTypeUser, ResourceTypeSystem:
+		 ResourceTypeTask, ResourceTypeUser, ResourceTypeSystem, ResourceTypeSecurity, ResourceTypeTerminal:
 		return true
 	default:
 		return false
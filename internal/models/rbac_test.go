@@ -0,0 +1,31 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceType_IsValid(t *testing.T) {
+	valid := []ResourceType{
+		ResourceTypeWorkspace, ResourceTypeProject, ResourceTypeSession, ResourceTypeTask,
+		ResourceTypeUser, ResourceTypeSystem, ResourceTypeSecurity, ResourceTypeTerminal,
+	}
+	for _, rt := range valid {
+		assert.True(t, rt.IsValid(), rt)
+	}
+
+	assert.False(t, ResourceType("").IsValid())
+	assert.False(t, ResourceType("unknown").IsValid())
+}
+
+func TestPermission_IsValid_Terminal(t *testing.T) {
+	permission := &Permission{
+		Name:         "terminal:execute",
+		ResourceType: ResourceTypeTerminal,
+		Action:       ActionExecute,
+		Effect:       PermissionAllow,
+	}
+
+	assert.True(t, permission.IsValid())
+}
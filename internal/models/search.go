@@ -0,0 +1,100 @@
+package models
+
+import "time"
+
+// SearchDocumentType 통합 검색 대상 종류
+type SearchDocumentType string
+
+const (
+	// SearchTypeWorkspace 워크스페이스 (이름, 프로젝트 경로)
+	SearchTypeWorkspace SearchDocumentType = "workspace"
+	// SearchTypeSession 세션 (프로젝트 이름, 메타데이터)
+	SearchTypeSession SearchDocumentType = "session"
+	// SearchTypePrompt 프롬프트 라이브러리 (이름, 설명, 본문, 태그)
+	SearchTypePrompt SearchDocumentType = "prompt"
+	// SearchTypeAudit 세션 셸 명령 실행 감사 기록 (명령, 설명, 출력)
+	SearchTypeAudit SearchDocumentType = "audit"
+)
+
+// SearchDocumentTypes 검색할 수 있는 모든 문서 종류
+var SearchDocumentTypes = []SearchDocumentType{SearchTypeWorkspace, SearchTypeSession, SearchTypePrompt, SearchTypeAudit}
+
+// SearchDocument 검색 인덱스에 저장하는 문서
+// WorkspaceID가 있으면 워크스페이스 read 권한으로, 없으면 작성자이거나 Public일 때만 검색 결과에 포함합니다.
+// swagger:model SearchDocument
+type SearchDocument struct {
+	Type SearchDocumentType `json:"type"`
+	ID   string             `json:"id"`
+
+	// 제목과 본문 (검색 대상 텍스트, 제목 일치에 가중치)
+	Title string `json:"title"`
+	Body  string `json:"body,omitempty"`
+
+	// 문서가 속한 워크스페이스 (프롬프트는 비어 있음)
+	WorkspaceID string `json:"workspace_id,omitempty"`
+
+	// 소유자 (워크스페이스 소유자 또는 프롬프트 작성자)
+	OwnerID string `json:"owner_id"`
+
+	// 워크스페이스 밖 문서를 모든 사용자에게 공개 (조직 공유 프롬프트)
+	Public bool `json:"public"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Key 인덱스 안에서 문서를 구분하는 키 (종류:ID)
+func (d *SearchDocument) Key() string {
+	return string(d.Type) + ":" + d.ID
+}
+
+// SearchHit 검색 결과 한 건
+type SearchHit struct {
+	SearchDocument
+
+	// 관련도 점수 (높을수록 관련 있음)
+	Score float64 `json:"score"`
+
+	// 본문에서 검색어 주변 미리보기
+	Snippet string `json:"snippet,omitempty"`
+}
+
+// SearchFacetBucket 패싯 값 하나와 해당 결과 수
+type SearchFacetBucket struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// SearchFacets 검색 결과의 종류, 소유자, 생성 월별 개수
+// 권한과 모든 조회 조건을 적용한 결과로 집계합니다.
+type SearchFacets struct {
+	Type  []SearchFacetBucket `json:"type"`
+	Owner []SearchFacetBucket `json:"owner"`
+	// 생성 월(YYYY-MM)별 개수, 최신 월부터
+	Date []SearchFacetBucket `json:"date"`
+}
+
+// SearchRequest 통합 검색 조건
+type SearchRequest struct {
+	// 검색어 (공백으로 구분한 모든 단어를 포함하는 문서, 비어 있으면 조건만으로 조회)
+	Query string `form:"q" binding:"max=500"`
+
+	// 문서 종류 (여러 번 지정 가능)
+	Types []string `form:"type"`
+
+	// 소유자 ID
+	OwnerID string `form:"owner_id"`
+
+	// 생성 시각 범위 (RFC3339 또는 YYYY-MM-DD, to의 날짜는 그날 끝까지 포함)
+	From string `form:"from"`
+	To   string `form:"to"`
+
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// SearchResponse 통합 검색 응답
+type SearchResponse struct {
+	Data   []*SearchHit   `json:"data"`
+	Meta   PaginationMeta `json:"meta"`
+	Facets SearchFacets   `json:"facets"`
+}
@@ -0,0 +1,16 @@
+package models
+
+// SecurityDashboardQuery 보안 대시보드 조회 조건
+type SecurityDashboardQuery struct {
+	// 지금부터 거슬러 올라갈 기간 (예: 1h, 24h, 168h)
+	Period string `form:"period"`
+
+	// 추세 구간 간격 (예: 5m, 1h)
+	Interval string `form:"interval"`
+
+	// 이벤트 분류 (비우면 모든 분류)
+	Category string `form:"category" binding:"omitempty,oneof=attack brute_force anomaly"`
+
+	// 최대 개수 (0이면 기본값)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=500"`
+}
@@ -108,4 +108,15 @@ func (s *Session) ToResponse() *SessionResponse {
 		resp.Project = s.Project.ToResponse()
 	}
 	return resp
-}
\ No newline at end of file
+}
+// SortValue 정렬 필드에 해당하는 값 반환 (커서 생성용)
+func (s *Session) SortValue(field string) interface{} {
+	switch field {
+	case "last_active":
+		return s.LastActive
+	case "updated_at":
+		return s.UpdatedAt
+	default:
+		return s.CreatedAt
+	}
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// SessionCommandExportFormat 명령 실행 기록 내보내기 형식
+type SessionCommandExportFormat string
+
+const (
+	// SessionCommandExportCSV CSV (헤더 포함)
+	SessionCommandExportCSV SessionCommandExportFormat = "csv"
+	// SessionCommandExportJSONL 한 줄에 기록 하나인 JSON
+	SessionCommandExportJSONL SessionCommandExportFormat = "jsonl"
+)
+
+// SessionCommand 세션에서 Bash 도구로 실행된 셸 명령 감사 기록
+// 명령과 출력은 저장 전에 출력 마스킹 정책을 거치며, 출력은 일정 크기에서 잘라 저장합니다.
+// swagger:model SessionCommand
+type SessionCommand struct {
+	ID          string `json:"id"`
+	SessionID   string `json:"session_id"`
+	WorkspaceID string `json:"workspace_id"`
+	TaskID      string `json:"task_id,omitempty"`
+
+	// Claude 도구 호출 ID
+	ToolUseID string `json:"tool_use_id,omitempty"`
+
+	Command     string `json:"command"`
+	Description string `json:"description,omitempty"`
+	WorkingDir  string `json:"working_dir,omitempty"`
+
+	// 종료 코드 (도구 결과에서 알 수 없으면 생략)
+	ExitCode *int `json:"exit_code,omitempty"`
+	IsError  bool `json:"is_error"`
+
+	// 잘린 출력과 원래 출력 크기
+	Output          string `json:"output"`
+	OutputBytes     int    `json:"output_bytes"`
+	OutputTruncated bool   `json:"output_truncated"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,51 @@
+package models
+
+// 동시 실행 세션 한도 범위
+const (
+	// SessionLimitScopeUser 사용자(워크스페이스 소유자)별 한도
+	SessionLimitScopeUser = "user"
+	// SessionLimitScopeOrg 서버 전체 한도
+	SessionLimitScopeOrg = "org"
+)
+
+// SessionLimitSettings 동시에 실행할 수 있는 Claude 세션 수 한도 (0이면 무제한)
+// 대기 중이거나 실행 중인 태스크가 하나라도 있는 세션을 실행 중인 세션으로 셉니다.
+// swagger:model SessionLimitSettings
+type SessionLimitSettings struct {
+	// 사용자별 기본 한도
+	PerUser int `json:"per_user" binding:"min=0"`
+
+	// 서버 전체 한도
+	PerOrg int `json:"per_org" binding:"min=0"`
+
+	// 사용자 ID별 한도 (기본 한도 대신 적용, 0이면 무제한)
+	Users map[string]int `json:"users,omitempty" binding:"omitempty,dive,min=0"`
+}
+
+// SessionLimitUsage 한도 범위의 현재 실행 중인 세션 수와 한도
+type SessionLimitUsage struct {
+	// 한도 범위 (user, org)
+	Scope string `json:"scope"`
+
+	// 사용자 ID (scope가 user일 때)
+	UserID string `json:"user_id,omitempty"`
+
+	// 실행 중인 세션 수
+	Running int `json:"running"`
+
+	// 적용되는 한도 (0이면 무제한)
+	Limit int `json:"limit"`
+}
+
+// SessionLimitStatus 동시 실행 세션 한도와 사용 현황
+// swagger:model SessionLimitStatus
+type SessionLimitStatus struct {
+	// 현재 한도 설정
+	Limits SessionLimitSettings `json:"limits"`
+
+	// 서버 전체 사용 현황
+	Org SessionLimitUsage `json:"org"`
+
+	// 실행 중인 세션이 있는 사용자별 사용 현황 (사용자 ID순)
+	Users []SessionLimitUsage `json:"users"`
+}
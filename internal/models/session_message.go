@@ -0,0 +1,149 @@
+package models
+
+import (
+	"time"
+)
+
+// MessageRole 세션 메시지 작성 주체
+type MessageRole string
+
+const (
+	MessageRoleUser      MessageRole = "user"      // 사용자 프롬프트
+	MessageRoleAssistant MessageRole = "assistant" // 에이전트 응답
+	MessageRoleSystem    MessageRole = "system"    // 시스템 메시지 (에러 등)
+)
+
+// 세션 메시지 메타데이터 키
+const (
+	MessageMetaClaudeSessionID = "claude_session_id" // 메시지를 생성한 Claude CLI 세션 ID
+	MessageMetaForkedFrom      = "forked_from"       // 분기 원본 세션 ID
+	MessageMetaForkedSequence  = "forked_sequence"   // 분기 원본 세션에서의 순서
+)
+
+// SessionMessage 세션 대화 기록의 메시지 하나
+type SessionMessage struct {
+	ID          string            `json:"id"`
+	SessionID   string            `json:"session_id" validate:"required"`
+	WorkspaceID string            `json:"workspace_id" validate:"required"`
+	Sequence    int64             `json:"sequence"` // 세션 내 순서 (1부터 시작)
+	Role        MessageRole       `json:"role" validate:"required,oneof=user assistant system"`
+	Type        string            `json:"type,omitempty"` // 스트림 메시지 타입 (text, tool_use, result 등)
+	Content     string            `json:"content"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// MessageSearchQuery 대화 기록 검색 조건
+type MessageSearchQuery struct {
+	// Query 검색어 (공백으로 구분된 모든 단어를 포함하는 메시지)
+	Query string
+
+	// WorkspaceIDs 검색 대상 워크스페이스 (nil이면 전체, 관리자용)
+	WorkspaceIDs []string
+
+	// SessionID 특정 세션으로 한정
+	SessionID string
+
+	// Role 작성 주체로 한정
+	Role MessageRole
+}
+
+// MessageSearchResult 검색 결과 메시지와 일치 구간 미리보기
+type MessageSearchResult struct {
+	SessionMessage
+	Snippet string `json:"snippet"`
+}
+
+// SessionForkRequest 세션 분기 요청
+type SessionForkRequest struct {
+	// MessageIndex 분기 지점 메시지 순서 (이 메시지까지 새 세션으로 복사)
+	MessageIndex int64 `json:"message_index" binding:"required,min=1"`
+
+	// SystemPrompt 새 세션의 시스템 프롬프트 (선택)
+	SystemPrompt string `json:"system_prompt,omitempty"`
+}
+
+// SessionForkResult 세션 분기 결과
+type SessionForkResult struct {
+	SessionID       string `json:"session_id"`
+	SourceSessionID string `json:"source_session_id"`
+	WorkspaceID     string `json:"workspace_id"`
+	MessageIndex    int64  `json:"message_index"`
+	CopiedMessages  int    `json:"copied_messages"`
+	Resumed         bool   `json:"resumed"` // Claude CLI --resume로 이어갔는지 여부 (false면 대화 내용을 프롬프트로 전달)
+}
+
+// SessionReplayEvent 대화 기록 재생 이벤트
+type SessionReplayEvent struct {
+	Message  *SessionMessage `json:"message"`
+	OffsetMS int64           `json:"offset_ms"` // 첫 메시지로부터의 경과 시간
+	DelayMS  int64           `json:"delay_ms"`  // 이전 메시지로부터의 경과 시간
+}
+
+// 가져온 대화 기록으로 만든 세션의 메타데이터 키와 값
+const (
+	SessionMetaSource       = "source"                // 세션을 만든 곳
+	SessionSourceOffline    = "offline"               // CLI 오프라인 모드에서 실행한 기록
+	SessionMetaTranscriptID = "offline_transcript_id" // CLI에 저장된 대화 기록 ID
+	SessionMetaWorkingDir   = "working_dir"           // 실행한 작업 디렉토리 (CLI 기기 기준)
+)
+
+// 검토 의견 수정 요청으로 만든 세션의 메타데이터 키와 값
+const (
+	SessionSourceReviewRevision = "review_revision"    // 검토 의견을 반영하는 수정 세션
+	SessionMetaRevisionOf       = "revision_of_review" // 의견을 남긴 검토 ID
+	SessionMetaParentSession    = "parent_session_id"  // 검토한 태스크를 실행한 세션 ID
+	SessionMetaParentTask       = "parent_task_id"     // 검토한 태스크 ID
+)
+
+// 대화 기록에서 분기한 세션의 출처 (원본 세션 ID는 SessionMetaParentSession)
+const SessionSourceFork = "fork"
+
+// TranscriptMessage 가져올 대화 기록의 메시지 하나
+type TranscriptMessage struct {
+	Role      MessageRole       `json:"role" binding:"required,oneof=user assistant system"`
+	Type      string            `json:"type,omitempty"`
+	Content   string            `json:"content"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// TranscriptUsage 대화 기록을 실행하는 동안 쓴 토큰과 비용 (Claude CLI 결과 메시지 기준)
+type TranscriptUsage struct {
+	CostUSD             float64 `json:"cost_usd" binding:"min=0"`
+	InputTokens         int     `json:"input_tokens" binding:"min=0"`
+	OutputTokens        int     `json:"output_tokens" binding:"min=0"`
+	CacheCreationTokens int     `json:"cache_creation_tokens" binding:"min=0"`
+	CacheReadTokens     int     `json:"cache_read_tokens" binding:"min=0"`
+	Model               string  `json:"model,omitempty"`
+}
+
+// MaxTranscriptChunkMessages 가져오기 요청 하나에 담을 수 있는 메시지 수
+const MaxTranscriptChunkMessages = 500
+
+// TranscriptImportRequest 서버 밖(CLI 오프라인 모드)에서 실행한 대화 기록 가져오기 요청
+// 가져온 기록은 프로젝트에 종료된 세션 하나로 저장됩니다. 세션 ID는 프로젝트와 기록 ID로 정해지므로
+// 같은 기록을 다시 보내도 세션이 늘지 않습니다. 메시지는 Offset부터 나눠 보낼 수 있으며,
+// 이미 받은 메시지는 건너뛰고 마지막 요청(Complete)에서 사용량을 집계합니다.
+type TranscriptImportRequest struct {
+	TranscriptID string               `json:"transcript_id" binding:"required,max=100"`
+	Prompt       string               `json:"prompt" binding:"max=10000"`
+	WorkingDir   string               `json:"working_dir,omitempty"`
+	StartedAt    time.Time            `json:"started_at" binding:"required"`
+	EndedAt      time.Time            `json:"ended_at" binding:"required"`
+	Offset       int                  `json:"offset" binding:"min=0"`
+	Messages     []*TranscriptMessage `json:"messages" binding:"max=500,dive"`
+	// Complete 마지막 요청 여부 (실행 결과와 사용량을 태스크로 기록)
+	Complete bool             `json:"complete"`
+	Error    string           `json:"error,omitempty"`
+	Usage    *TranscriptUsage `json:"usage,omitempty"`
+}
+
+// TranscriptImportResult 가져오기 결과
+type TranscriptImportResult struct {
+	Session *Session `json:"session"`
+	// Received 서버에 저장된 메시지 수 (다음 요청의 offset)
+	Received int `json:"received"`
+	// TaskID 사용량을 기록한 태스크 ID (마지막 요청 이후)
+	TaskID string `json:"task_id,omitempty"`
+}
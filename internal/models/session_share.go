@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+)
+
+// ShareScope 공유 링크로 참여한 사용자의 권한 범위
+type ShareScope string
+
+const (
+	ShareScopeRead        ShareScope = "read"        // 세션 출력 조회만 가능
+	ShareScopeInteractive ShareScope = "interactive" // 세션 입력 가능 (드라이버 역할을 받을 수 있음)
+)
+
+// IsValid 유효한 권한 범위인지 확인
+func (s ShareScope) IsValid() bool {
+	return s == ShareScopeRead || s == ShareScopeInteractive
+}
+
+// SessionShareLink 세션 공유 링크
+// 토큰 원문은 발급 시 한 번만 반환하고 해시만 저장합니다.
+type SessionShareLink struct {
+	ID          string     `json:"id"`
+	SessionID   string     `json:"session_id" validate:"required"`
+	WorkspaceID string     `json:"workspace_id,omitempty"`
+	CreatedBy   string     `json:"created_by"`
+	TokenHash   string     `json:"-"`
+	Scope       ShareScope `json:"scope" validate:"required,oneof=read interactive"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	JoinCount   int64      `json:"join_count"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// IsExpired 만료 여부 확인
+func (l *SessionShareLink) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
+
+// IsRevoked 폐기 여부 확인
+func (l *SessionShareLink) IsRevoked() bool {
+	return l.RevokedAt != nil
+}
+
+// SessionShareJoin 공유 링크를 통한 세션 참여 감사 기록
+type SessionShareJoin struct {
+	ID        string    `json:"id"`
+	LinkID    string    `json:"link_id"`
+	SessionID string    `json:"session_id"`
+	UserID    string    `json:"user_id,omitempty"` // 인증하지 않은 게스트는 빈 값
+	UserName  string    `json:"user_name,omitempty"`
+	Channel   string    `json:"channel"` // 참여 경로 (websocket, rest)
+	ClientIP  string    `json:"client_ip,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+// CreateShareLinkRequest 공유 링크 생성 요청
+type CreateShareLinkRequest struct {
+	// Scope 권한 범위 (기본값 read)
+	Scope ShareScope `json:"scope,omitempty"`
+
+	// ExpiresIn 유효 기간 (초, 기본값 24시간)
+	ExpiresIn int64 `json:"expires_in,omitempty" binding:"omitempty,min=60"`
+}
+
+// CreateShareLinkResponse 공유 링크 생성 응답 (토큰 원문 포함)
+type CreateShareLinkResponse struct {
+	Link  *SessionShareLink `json:"link"`
+	Token string            `json:"token"`
+	URL   string            `json:"url"`
+}
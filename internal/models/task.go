@@ -22,6 +22,33 @@ const (
 	TaskStatusCancelled = TaskCancelled
 )
 
+// TaskTimeoutTier 태스크 실행 시간 제한 단계
+type TaskTimeoutTier string
+
+const (
+	TaskTimeoutQuick    TaskTimeoutTier = "quick"    // 짧은 조회성 명령
+	TaskTimeoutStandard TaskTimeoutTier = "standard" // 기본값
+	TaskTimeoutLong     TaskTimeoutTier = "long"     // 빌드, 테스트 등 오래 걸리는 명령
+)
+
+// IsValid 지원하는 단계인지 확인 (빈 값은 standard로 간주)
+func (t TaskTimeoutTier) IsValid() bool {
+	switch t {
+	case "", TaskTimeoutQuick, TaskTimeoutStandard, TaskTimeoutLong:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault 빈 값이면 standard 반환
+func (t TaskTimeoutTier) OrDefault() TaskTimeoutTier {
+	if t == "" {
+		return TaskTimeoutStandard
+	}
+	return t
+}
+
 // Task 태스크 모델
 type Task struct {
 	BaseModel
@@ -32,6 +59,21 @@ type Task struct {
 	Error       string     `json:"error,omitempty" gorm:"type:text" validate:"omitempty"`
 	StartedAt   *time.Time `json:"started_at,omitempty" validate:"-"`
 	CompletedAt *time.Time `json:"completed_at,omitempty" validate:"-"`
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier" gorm:"default:'standard'" validate:"-"`
+	
+	// 모델 선호 목록과 실제 처리 모델 (비용 집계용)
+	Models        []string           `json:"models,omitempty" gorm:"serializer:json" validate:"-"`
+	ServedModel   string             `json:"served_model,omitempty" validate:"-"`
+	ModelAttempts []TaskModelAttempt `json:"model_attempts,omitempty" gorm:"serializer:json" validate:"-"`
+	
+	// DryRun 파일 변경을 섀도 복사본에만 적용하고 diff를 검토로 제안 (승인 전에는 워크스페이스를 바꾸지 않음)
+	DryRun bool `json:"dry_run,omitempty" gorm:"default:false" validate:"-"`
+	
+	// Snapshot 실행 직전에 워크스페이스 스냅샷을 만들어 결과가 잘못되면 되돌릴 수 있게 함
+	Snapshot bool `json:"snapshot,omitempty" gorm:"default:false" validate:"-"`
+	
+	// Admission 호스트 자원 부족으로 실행이 미뤄졌을 때의 대기 정보 (저장하지 않음)
+	Admission *TaskAdmission `json:"admission,omitempty" gorm:"-" validate:"-"`
 	
 	// 통계 정보
 	BytesIn  int64 `json:"bytes_in" gorm:"default:0" validate:"min=0"`
@@ -44,6 +86,24 @@ type TaskCreateRequest struct {
 	SessionID string            `json:"session_id" binding:"required" validate:"required,uuid"`
 	Command   string            `json:"command" binding:"required,min=1,max=10000" validate:"required,min=1,max=10000"`
 	Metadata  map[string]string `json:"metadata,omitempty" validate:"-"`
+	// TimeoutTier 실행 시간 제한 단계 (quick, standard, long; 비어 있으면 standard)
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier,omitempty" binding:"omitempty,oneof=quick standard long" validate:"-"`
+	// RequireReview 결과를 바로 반영하지 않고 검토 대기 상태로 둠 (Git 리포지토리 프로젝트만, 승인 시 커밋/거부 시 롤백)
+	RequireReview bool `json:"require_review,omitempty" validate:"-"`
+	// DryRun 프로젝트의 임시 클론에서 실행해 변경 사항을 diff로만 제안 (Git 리포지토리 프로젝트만, 검토를 함께 생성하며 승인 시 워크스페이스에 적용/커밋)
+	DryRun bool `json:"dry_run,omitempty" validate:"-"`
+	// Snapshot 실행 직전에 워크스페이스 파일 시스템 스냅샷 생성 (Git과 무관하게 복원 가능, 스냅샷 기능이 켜진 서버만)
+	Snapshot bool `json:"snapshot,omitempty" validate:"-"`
+	// Models 모델 선호 목록 (예: ["opus", "sonnet"]; 앞의 모델이 과부하나 요청 한도로 실패하면 다음 모델로 재시도, claude 명령만)
+	Models []string `json:"models,omitempty" binding:"omitempty,max=5,dive,required,max=100" validate:"-"`
+	// UserID 태스크를 요청한 사용자 (요청 본문이 아닌 인증 정보로 설정, 동시 실행 세션 한도를 이 사용자에게 셈)
+	UserID string `json:"-" validate:"-"`
+}
+
+// TaskModelAttempt 모델 선호 목록으로 실행할 때 실패한 모델 시도
+type TaskModelAttempt struct {
+	Model string `json:"model"`
+	Error string `json:"error"`
 }
 
 // TaskResponse 태스크 응답
@@ -56,6 +116,13 @@ type TaskResponse struct {
 	Error       string     `json:"error,omitempty"`
 	StartedAt   *time.Time `json:"started_at,omitempty"`
 	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier"`
+	Models        []string           `json:"models,omitempty"`
+	ServedModel   string             `json:"served_model,omitempty"` // 실제로 태스크를 처리한 모델
+	ModelAttempts []TaskModelAttempt `json:"model_attempts,omitempty"` // 실패해서 다음 모델로 넘어간 시도
+	DryRun      bool       `json:"dry_run,omitempty"`
+	Snapshot    bool       `json:"snapshot,omitempty"`
+	Admission   *TaskAdmission `json:"admission,omitempty"` // 호스트 자원 부족으로 실행이 미뤄진 경우의 대기 순서
 	BytesIn     int64      `json:"bytes_in"`
 	BytesOut    int64      `json:"bytes_out"`
 	Duration    int64      `json:"duration"`
@@ -153,10 +220,28 @@ func (t *Task) ToResponse() *TaskResponse {
 		Error:       t.Error,
 		StartedAt:   t.StartedAt,
 		CompletedAt: t.CompletedAt,
+		TimeoutTier: t.TimeoutTier,
+		Models:        t.Models,
+		ServedModel:   t.ServedModel,
+		DryRun:        t.DryRun,
+		Snapshot:      t.Snapshot,
+		Admission:     t.Admission,
+		ModelAttempts: t.ModelAttempts,
 		BytesIn:     t.BytesIn,
 		BytesOut:    t.BytesOut,
 		Duration:    t.Duration,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
 	}
-}
\ No newline at end of file
+}
+// SortValue 정렬 필드에 해당하는 값 반환 (커서 생성용)
+func (t *Task) SortValue(field string) interface{} {
+	switch field {
+	case "status":
+		return string(t.Status)
+	case "updated_at":
+		return t.UpdatedAt
+	default:
+		return t.CreatedAt
+	}
+}
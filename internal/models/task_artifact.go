@@ -0,0 +1,83 @@
+package models
+
+import "time"
+
+// TaskArtifactType 태스크 결과물 종류
+type TaskArtifactType string
+
+const (
+	// TaskArtifactCodeBlock 대화 기록의 마크다운 코드 블록
+	TaskArtifactCodeBlock TaskArtifactType = "code_block"
+	// TaskArtifactFile 에이전트가 생성하거나 수정한 파일 경로
+	TaskArtifactFile TaskArtifactType = "file"
+	// TaskArtifactTestReport 테스트 실행 결과 요약
+	TaskArtifactTestReport TaskArtifactType = "test_report"
+)
+
+// IsValid 알려진 결과물 종류인지 확인
+func (t TaskArtifactType) IsValid() bool {
+	switch t {
+	case TaskArtifactCodeBlock, TaskArtifactFile, TaskArtifactTestReport:
+		return true
+	}
+	return false
+}
+
+// TaskArtifactSource 결과물을 찾은 위치
+type TaskArtifactSource string
+
+const (
+	// TaskArtifactSourceOutput 태스크 출력
+	TaskArtifactSourceOutput TaskArtifactSource = "output"
+	// TaskArtifactSourceMessage 태스크 실행 중 기록된 세션 메시지
+	TaskArtifactSourceMessage TaskArtifactSource = "message"
+)
+
+// TaskArtifact 완료된 태스크의 대화 기록에서 추출한 결과물
+// swagger:model TaskArtifact
+type TaskArtifact struct {
+	ID          string           `json:"id"`
+	TaskID      string           `json:"task_id"`
+	SessionID   string           `json:"session_id"`
+	WorkspaceID string           `json:"workspace_id,omitempty"`
+	Type        TaskArtifactType `json:"type"`
+
+	// 코드 블록은 "<언어> #<순번>", 파일은 경로, 테스트 보고서는 프레임워크 이름
+	Name string `json:"name"`
+
+	// 코드 블록 언어 (펜스에 지정된 경우)
+	Language string `json:"language,omitempty"`
+
+	// 코드 블록 본문 또는 테스트 요약 원문 줄
+	Content string `json:"content,omitempty"`
+
+	// 파일 결과물의 변경 종류 (created, modified, deleted)
+	Action string `json:"action,omitempty"`
+
+	// 테스트 보고서 집계
+	TestReport *TaskTestReport `json:"test_report,omitempty"`
+
+	Source TaskArtifactSource `json:"source"`
+
+	// 출처가 세션 메시지이면 메시지 순서
+	MessageSequence int64 `json:"message_sequence,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TaskTestReport 테스트 결과 요약
+type TaskTestReport struct {
+	// go, pytest, jest 등
+	Framework string `json:"framework"`
+	Passed    int    `json:"passed"`
+	Failed    int    `json:"failed"`
+	Skipped   int    `json:"skipped"`
+
+	// 실패한 테스트 또는 패키지 이름
+	Failures []string `json:"failures,omitempty"`
+}
+
+// Success 실패한 테스트가 없는지 여부
+func (r *TaskTestReport) Success() bool {
+	return r.Failed == 0
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// TaskEventType 태스크 이벤트 종류
+type TaskEventType string
+
+const (
+	// TaskEventStatus 태스크 상태 변경 (pending, running, completed, failed, cancelled)
+	TaskEventStatus TaskEventType = "status"
+	// TaskEventOutput 태스크 출력
+	TaskEventOutput TaskEventType = "output"
+)
+
+// TaskEvent 태스크 이벤트 스트림의 이벤트 하나
+// Cursor는 서버 전체에서 단조 증가하며, 다음 요청의 since로 사용합니다.
+type TaskEvent struct {
+	Cursor    int64         `json:"cursor"`
+	TaskID    string        `json:"task_id"`
+	SessionID string        `json:"session_id"`
+	Type      TaskEventType `json:"type"`
+	Status    TaskStatus    `json:"status"`
+	Output    string        `json:"output,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// TaskEventPollResponse 태스크 이벤트 롱 폴링 응답
+// swagger:model TaskEventPollResponse
+type TaskEventPollResponse struct {
+	TaskID string `json:"task_id"`
+
+	// 현재 태스크 상태
+	Status TaskStatus `json:"status"`
+
+	// since 이후의 이벤트 (대기 시간 안에 이벤트가 없으면 빈 배열)
+	Events []*TaskEvent `json:"events"`
+
+	// 다음 요청에 since로 넘길 커서
+	Cursor int64 `json:"cursor"`
+
+	// 태스크가 종료되어 더 이상 이벤트가 없음
+	Done bool `json:"done"`
+
+	// 보관 한도를 넘어 since 이후 이벤트 일부가 버려졌음
+	Truncated bool `json:"truncated,omitempty"`
+}
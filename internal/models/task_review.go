@@ -0,0 +1,157 @@
+package models
+
+import "time"
+
+// TaskReviewStatus 태스크 결과 검토 상태
+//
+//	awaiting_task → pending → approved (변경 사항 커밋)
+//	                        ↘ rejected (기준 커밋으로 롤백)
+//
+// 드라이런 태스크는 섀도 복사본에서 실행되므로 승인하면 diff를 워크스페이스에 적용해 커밋하고,
+// 거부하면 섀도 복사본만 삭제합니다.
+type TaskReviewStatus string
+
+const (
+	TaskReviewAwaitingTask TaskReviewStatus = "awaiting_task" // 태스크 실행 중
+	TaskReviewPending      TaskReviewStatus = "pending"       // 검토 대기
+	TaskReviewApproved     TaskReviewStatus = "approved"      // 승인되어 커밋됨
+	TaskReviewRejected     TaskReviewStatus = "rejected"      // 거부되어 롤백됨
+)
+
+// IsOpen 아직 결정되지 않은 검토인지 확인
+func (s TaskReviewStatus) IsOpen() bool {
+	return s == TaskReviewAwaitingTask || s == TaskReviewPending
+}
+
+// TaskReviewDiffMaxBytes 검토에 보관하는 diff의 최대 크기
+const TaskReviewDiffMaxBytes = 256 * 1024
+
+// TaskReview 검토가 필요한 태스크의 결과와 승인 상태
+// swagger:model TaskReview
+type TaskReview struct {
+	ID          string           `json:"id"`
+	TaskID      string           `json:"task_id"`
+	SessionID   string           `json:"session_id"`
+	ProjectID   string           `json:"project_id"`
+	WorkspaceID string           `json:"workspace_id"`
+	Status      TaskReviewStatus `json:"status"`
+	// BaseCommit 태스크 실행 전 HEAD (거부 시 이 커밋으로 롤백)
+	BaseCommit string `json:"base_commit"`
+	// TaskStatus 종료된 태스크의 상태 (completed, failed, cancelled)
+	TaskStatus TaskStatus `json:"task_status,omitempty"`
+	// DryRun 섀도 복사본에서 실행한 태스크 (승인 시 diff를 워크스페이스에 적용, 거부 시 섀도 복사본만 삭제)
+	DryRun bool `json:"dry_run,omitempty"`
+	// ShadowPath 드라이런 태스크가 실행된 임시 클론 경로 (결정 후 삭제되면 비어 있음)
+	ShadowPath string `json:"-"`
+
+	// 태스크가 만든 변경 사항 (BaseCommit 대비, TaskReviewDiffMaxBytes까지)
+	Diff          string   `json:"diff,omitempty"`
+	DiffTruncated bool     `json:"diff_truncated,omitempty"`
+	FilesChanged  []string `json:"files_changed"`
+
+	// 결정
+	ReviewerID    string `json:"reviewer_id,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	AppliedCommit string `json:"applied_commit,omitempty"` // 승인 시 만든 커밋 (변경 사항이 없으면 비어 있음)
+
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+}
+
+// TaskReviewDecisionRequest 승인 또는 거부 요청
+type TaskReviewDecisionRequest struct {
+	// 검토 의견
+	Comment string `json:"comment,omitempty" binding:"max=2000"`
+
+	// 커밋 메시지 (승인 시, 비어 있으면 태스크 명령으로 생성)
+	CommitMessage string `json:"commit_message,omitempty" binding:"max=500"`
+}
+
+// TaskReviewFilter 검토 목록 조회 조건
+type TaskReviewFilter struct {
+	WorkspaceID string           `form:"workspace_id"`
+	Status      TaskReviewStatus `form:"status" binding:"omitempty,oneof=awaiting_task pending approved rejected"`
+	Limit       int              `form:"limit" binding:"omitempty,min=1,max=200"`
+
+	// 서비스에서 채우는 조건
+	WorkspaceIDs []string `form:"-"` // 조회 가능한 워크스페이스 (nil이면 제한 없음)
+	ProjectID    string   `form:"-"`
+	OpenOnly     bool     `form:"-"` // 결정되지 않은 검토만
+}
+
+// TaskReviewCommentSide 검토 의견이 가리키는 diff 쪽
+type TaskReviewCommentSide string
+
+const (
+	TaskReviewCommentNew TaskReviewCommentSide = "new" // 변경 후 코드 (추가되거나 남은 줄)
+	TaskReviewCommentOld TaskReviewCommentSide = "old" // 변경 전 코드 (삭제되거나 남은 줄)
+)
+
+// TaskReviewComment 검토 diff의 한 줄에 남긴 의견
+// 해결하지 않은 의견은 수정 요청으로 새 세션에 전달할 수 있으며, 전달한 세션과 태스크를 기록합니다.
+// swagger:model TaskReviewComment
+type TaskReviewComment struct {
+	ID          string `json:"id"`
+	ReviewID    string `json:"review_id"`
+	WorkspaceID string `json:"workspace_id"`
+	AuthorID    string `json:"author_id"`
+
+	// 파일 경로 (리포지토리 기준, diff의 파일 경로와 같음)
+	Path string `json:"path"`
+	// 줄 번호 (side 쪽 파일 기준)
+	Line int                   `json:"line"`
+	Side TaskReviewCommentSide `json:"side"`
+	Body string                `json:"body"`
+
+	Resolved   bool       `json:"resolved"`
+	ResolvedBy string     `json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+
+	// 마지막으로 이 의견을 전달한 수정 세션과 태스크
+	RevisionSessionID string `json:"revision_session_id,omitempty"`
+	RevisionTaskID    string `json:"revision_task_id,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TaskReviewCommentRequest 검토 의견 작성 요청
+type TaskReviewCommentRequest struct {
+	Path string                `json:"path" binding:"required,max=1024"`
+	Line int                   `json:"line" binding:"required,min=1"`
+	Side TaskReviewCommentSide `json:"side,omitempty" binding:"omitempty,oneof=new old"`
+	Body string                `json:"body" binding:"required,max=5000"`
+}
+
+// TaskReviewCommentUpdateRequest 검토 의견 수정 요청 (지정한 필드만 변경)
+type TaskReviewCommentUpdateRequest struct {
+	// 의견 내용 (작성자만 수정 가능)
+	Body *string `json:"body,omitempty" binding:"omitempty,min=1,max=5000"`
+
+	// 해결 여부 (작성자 또는 워크스페이스 admin)
+	Resolved *bool `json:"resolved,omitempty"`
+}
+
+// TaskReviewRevisionRequest 해결하지 않은 의견으로 수정 태스크를 실행하는 요청
+type TaskReviewRevisionRequest struct {
+	// 전달할 의견 ID (비어 있으면 해결하지 않은 의견 전체)
+	CommentIDs []string `json:"comment_ids,omitempty" binding:"omitempty,max=100"`
+
+	// 의견에 덧붙일 지시
+	Instructions string `json:"instructions,omitempty" binding:"max=2000"`
+
+	// 수정 태스크 실행 옵션 (TaskCreateRequest와 같음)
+	TimeoutTier   TaskTimeoutTier `json:"timeout_tier,omitempty" binding:"omitempty,oneof=quick standard long"`
+	RequireReview bool            `json:"require_review,omitempty"`
+	DryRun        bool            `json:"dry_run,omitempty"`
+}
+
+// TaskReviewRevision 수정 요청으로 만든 세션과 태스크
+// swagger:model TaskReviewRevision
+type TaskReviewRevision struct {
+	ReviewID  string               `json:"review_id"`
+	SessionID string               `json:"session_id"`
+	TaskID    string               `json:"task_id"`
+	Comments  []*TaskReviewComment `json:"comments"`
+}
@@ -0,0 +1,97 @@
+package models
+
+import "time"
+
+// TerminalMode 터미널 실행 위치
+type TerminalMode string
+
+const (
+	// TerminalModeHost 호스트의 워크스페이스 디렉토리에서 셸 실행
+	TerminalModeHost TerminalMode = "host"
+	// TerminalModeContainer 워크스페이스 컨테이너 안에서 셸 실행
+	TerminalModeContainer TerminalMode = "container"
+)
+
+// TerminalSessionStatus 터미널 세션 상태
+type TerminalSessionStatus string
+
+const (
+	// TerminalSessionActive 접속 중
+	TerminalSessionActive TerminalSessionStatus = "active"
+	// TerminalSessionClosed 종료됨 (녹화 재생 가능)
+	TerminalSessionClosed TerminalSessionStatus = "closed"
+)
+
+// TerminalSession 워크스페이스 터미널 접속 감사 기록
+// 입출력 전체가 asciicast v2 형식으로 녹화되어 종료 후 재생할 수 있습니다.
+// swagger:model TerminalSession
+type TerminalSession struct {
+	ID          string       `json:"id"`
+	WorkspaceID string       `json:"workspace_id"`
+	UserID      string       `json:"user_id"`
+	Mode        TerminalMode `json:"mode"`
+
+	// 컨테이너 모드에서 접속한 컨테이너 ID
+	ContainerID string `json:"container_id,omitempty"`
+
+	Shell  string                `json:"shell"`
+	Cols   int                   `json:"cols"`
+	Rows   int                   `json:"rows"`
+	Status TerminalSessionStatus `json:"status"`
+
+	ClientIP  string `json:"client_ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+
+	// 셸 종료 코드 (연결이 끊겨 서버가 셸을 종료한 경우 -1일 수 있음)
+	ExitCode *int `json:"exit_code,omitempty"`
+
+	InputBytes  int64 `json:"input_bytes"`
+	OutputBytes int64 `json:"output_bytes"`
+
+	// 녹화 크기와 크기 제한으로 잘렸는지 여부
+	RecordingSize      int64 `json:"recording_size"`
+	RecordingTruncated bool  `json:"recording_truncated,omitempty"`
+
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+// TerminalOpenRequest 터미널 접속 옵션 (WebSocket 연결 쿼리 파라미터)
+type TerminalOpenRequest struct {
+	// host 또는 container (비어 있으면 실행 중인 컨테이너가 있을 때 container)
+	Mode TerminalMode `form:"mode" binding:"omitempty,oneof=host container"`
+
+	// 실행할 셸 (비어 있으면 호스트는 $SHELL 또는 /bin/bash, 컨테이너는 /bin/sh)
+	Shell string `form:"shell" binding:"omitempty,max=255"`
+
+	Cols int `form:"cols" binding:"omitempty,min=1,max=1000"`
+	Rows int `form:"rows" binding:"omitempty,min=1,max=1000"`
+
+	ClientIP  string `form:"-"`
+	UserAgent string `form:"-"`
+}
+
+// TerminalMessage 터미널 WebSocket 메시지
+// 클라이언트는 input/resize를 보내고, 서버는 output/exit/error를 보냅니다.
+type TerminalMessage struct {
+	// input, resize, output, exit, error
+	Type string `json:"type"`
+
+	// 입력 또는 출력 데이터
+	Data string `json:"data,omitempty"`
+
+	Cols int `json:"cols,omitempty"`
+	Rows int `json:"rows,omitempty"`
+
+	// exit 메시지의 종료 코드
+	ExitCode *int `json:"exit_code,omitempty"`
+}
+
+// 터미널 메시지 종류
+const (
+	TerminalMessageInput  = "input"
+	TerminalMessageResize = "resize"
+	TerminalMessageOutput = "output"
+	TerminalMessageExit   = "exit"
+	TerminalMessageError  = "error"
+)
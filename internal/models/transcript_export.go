@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+// TranscriptExportFormat 대화 기록 내보내기 형식
+type TranscriptExportFormat string
+
+const (
+	TranscriptExportMarkdown TranscriptExportFormat = "markdown" // Markdown 문서 (.md)
+	TranscriptExportHTML     TranscriptExportFormat = "html"     // 스타일을 포함한 단독 HTML 문서 (.html)
+	TranscriptExportPDF      TranscriptExportFormat = "pdf"      // 헤드리스 브라우저로 HTML을 인쇄한 PDF (.pdf)
+)
+
+// TranscriptExportStatus 내보내기 작업 상태
+type TranscriptExportStatus string
+
+const (
+	TranscriptExportPending   TranscriptExportStatus = "pending"   // 대기 중
+	TranscriptExportRunning   TranscriptExportStatus = "running"   // 문서 생성 중
+	TranscriptExportCompleted TranscriptExportStatus = "completed" // 아티팩트 저장 완료
+	TranscriptExportFailed    TranscriptExportStatus = "failed"    // 실패
+)
+
+// MaxTranscriptExportSessions 내보내기 요청 하나에 담을 수 있는 세션 수
+const MaxTranscriptExportSessions = 50
+
+// TranscriptExportRequest 세션 대화 기록 내보내기 요청
+// 지정한 순서대로 세션마다 한 장(chapter)씩 이어 붙인 문서 하나를 만듭니다.
+type TranscriptExportRequest struct {
+	SessionIDs []string               `json:"session_ids" binding:"required,min=1,max=50,dive,required"`
+	Format     TranscriptExportFormat `json:"format" binding:"required,oneof=markdown html pdf"`
+
+	// Title 문서 제목 (비어 있으면 세션 수로 만든 제목)
+	Title string `json:"title,omitempty" binding:"max=200"`
+
+	// ExcludeToolCalls 도구 호출과 도구 결과 메시지를 빼고 대화만 내보낼지 여부
+	ExcludeToolCalls bool `json:"exclude_tool_calls,omitempty"`
+}
+
+// TranscriptExport 대화 기록 내보내기 작업
+// swagger:model TranscriptExport
+type TranscriptExport struct {
+	ID               string                 `json:"id"`
+	OwnerID          string                 `json:"owner_id"`
+	Format           TranscriptExportFormat `json:"format"`
+	Title            string                 `json:"title"`
+	SessionIDs       []string               `json:"session_ids"`
+	ExcludeToolCalls bool                   `json:"exclude_tool_calls,omitempty"`
+	Status           TranscriptExportStatus `json:"status"`
+	Error            string                 `json:"error,omitempty"`
+
+	// Messages 문서에 담은 메시지 수
+	Messages int `json:"messages"`
+
+	// 저장한 아티팩트 (완료된 작업만)
+	ArtifactKey string     `json:"artifact_key,omitempty"`
+	Size        int64      `json:"size,omitempty"`
+	DownloadURL string     `json:"download_url,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// IsFinished 작업이 끝났는지 (완료 또는 실패)
+func (e *TranscriptExport) IsFinished() bool {
+	return e.Status == TranscriptExportCompleted || e.Status == TranscriptExportFailed
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// ResponseLanguage Claude가 응답할 언어 정보
+type ResponseLanguage struct {
+	// Name 지시문에 쓰는 영어 이름
+	Name string `json:"name"`
+	// Native 해당 언어로 쓴 이름
+	Native string `json:"native"`
+}
+
+// ResponseLanguages 응답 언어로 지정할 수 있는 언어 (ISO 639-1 코드)
+var ResponseLanguages = map[string]ResponseLanguage{
+	"ko": {Name: "Korean", Native: "한국어"},
+	"en": {Name: "English", Native: "English"},
+	"ja": {Name: "Japanese", Native: "日本語"},
+	"zh": {Name: "Chinese (Simplified)", Native: "简体中文"},
+	"es": {Name: "Spanish", Native: "Español"},
+	"fr": {Name: "French", Native: "Français"},
+	"de": {Name: "German", Native: "Deutsch"},
+	"pt": {Name: "Portuguese", Native: "Português"},
+	"vi": {Name: "Vietnamese", Native: "Tiếng Việt"},
+}
+
+// Locales 서버 메시지(에러, 알림)를 번역할 수 있는 언어
+var Locales = []string{"ko", "en"}
+
+// UserPreference 사용자별 언어 설정
+// swagger:model UserPreference
+type UserPreference struct {
+	UserID string `json:"user_id"`
+
+	// ResponseLanguage 세션과 태스크에서 Claude가 응답할 언어 (비어 있으면 지시하지 않음)
+	ResponseLanguage string `json:"response_language"`
+
+	// Locale 에러와 알림 메시지 언어 (비어 있으면 요청의 Accept-Language, 알림은 한국어)
+	Locale string `json:"locale"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UserPreferenceRequest 언어 설정 변경 요청 (없는 항목은 바꾸지 않고, 빈 문자열은 설정 해제)
+type UserPreferenceRequest struct {
+	ResponseLanguage *string `json:"response_language,omitempty" binding:"omitempty,max=8"`
+	Locale           *string `json:"locale,omitempty" binding:"omitempty,max=8"`
+}
@@ -0,0 +1,181 @@
+package models
+
+import "time"
+
+// WebhookProvider 웹훅을 보내는 Git 호스팅 서비스
+type WebhookProvider string
+
+const (
+	// WebhookProviderGitHub GitHub (X-Hub-Signature-256 서명)
+	WebhookProviderGitHub WebhookProvider = "github"
+	// WebhookProviderGitLab GitLab (X-Gitlab-Token 비밀 토큰)
+	WebhookProviderGitLab WebhookProvider = "gitlab"
+)
+
+// WebhookTrigger 태스크를 시작하는 이벤트 종류
+type WebhookTrigger string
+
+const (
+	// WebhookTriggerIssueLabeled 이슈에 지정한 라벨이 붙음
+	WebhookTriggerIssueLabeled WebhookTrigger = "issue_labeled"
+	// WebhookTriggerPRComment PR/MR 댓글이 지정한 명령으로 시작함
+	WebhookTriggerPRComment WebhookTrigger = "pr_comment"
+	// WebhookTriggerPush 지정한 브랜치에 푸시
+	WebhookTriggerPush WebhookTrigger = "push"
+)
+
+// DefaultWebhookCommand 댓글 명령을 지정하지 않았을 때 사용하는 명령
+const DefaultWebhookCommand = "/claude"
+
+// WebhookPromptVariables 웹훅 프롬프트 템플릿에서 사용할 수 있는 변수
+// 이벤트에 해당하지 않는 변수는 빈 문자열로 치환됩니다.
+var WebhookPromptVariables = []string{
+	"event", "repository", "sender", "url",
+	"number", "title", "body", "label",
+	"comment", "args",
+	"branch", "commit", "commits",
+}
+
+// WebhookMapping 리포지토리 이벤트를 워크스페이스 태스크로 연결하는 매핑
+// swagger:model WebhookMapping
+type WebhookMapping struct {
+	ID          string          `json:"id"`
+	OwnerID     string          `json:"owner_id"`
+	Name        string          `json:"name"`
+	Provider    WebhookProvider `json:"provider"`
+	Repository  string          `json:"repository"`
+	WorkspaceID string          `json:"workspace_id"`
+
+	// 태스크를 실행할 세션 (비어 있으면 워크스페이스의 가장 최근 활성 세션)
+	SessionID string `json:"session_id,omitempty"`
+
+	Triggers []WebhookTrigger `json:"triggers"`
+
+	// issue_labeled 트리거의 라벨
+	Label string `json:"label,omitempty"`
+
+	// pr_comment 트리거의 명령 (댓글 첫 줄이 이 명령으로 시작해야 함)
+	Command string `json:"command,omitempty"`
+
+	// push 트리거의 브랜치 (path.Match 패턴 사용 가능)
+	Branches []string `json:"branches"`
+
+	// 태스크를 시작할 수 있는 이벤트 발신자 (GitHub login, GitLab username)
+	// 비어 있으면 제한 없음, 단 pr_comment는 GitHub 리포지토리 소유자, 멤버, 협업자의 댓글만 처리합니다.
+	AllowedSenders []string `json:"allowed_senders"`
+
+	// 태스크 프롬프트 템플릿 (비어 있으면 이벤트별 기본 템플릿)
+	Prompt string `json:"prompt,omitempty"`
+
+	// 태스크 결과를 검토 대기 상태로 남길지 여부
+	RequireReview bool `json:"require_review"`
+
+	Enabled bool `json:"enabled"`
+
+	// 서명 검증용 비밀 값 (생성과 재발급 응답에서만 노출)
+	Secret string `json:"-"`
+
+	LastDelivery *WebhookDeliveryResult `json:"last_delivery,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+// HasTrigger 트리거 사용 여부
+func (m *WebhookMapping) HasTrigger(trigger WebhookTrigger) bool {
+	for _, t := range m.Triggers {
+		if t == trigger {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookMappingSecretResponse 비밀 값이 포함된 매핑 (생성과 재발급 응답)
+type WebhookMappingSecretResponse struct {
+	*WebhookMapping
+	// 제공자의 웹훅 설정에 입력할 비밀 값 (다시 조회할 수 없음)
+	Secret string `json:"secret"`
+	// 제공자의 웹훅 설정에 입력할 경로
+	// example: /api/v1/hooks/github/3f0c...
+	URL string `json:"url"`
+}
+
+// WebhookMappingCreateRequest 웹훅 매핑 생성 요청
+type WebhookMappingCreateRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=100"`
+
+	// github 또는 gitlab
+	Provider WebhookProvider `json:"provider" binding:"required,oneof=github gitlab"`
+
+	// GitHub full_name 또는 GitLab path_with_namespace
+	// example: moonklabs/aicli-web
+	Repository string `json:"repository" binding:"required,min=1,max=255"`
+
+	WorkspaceID string `json:"workspace_id" binding:"required"`
+	SessionID   string `json:"session_id"`
+
+	Triggers       []WebhookTrigger `json:"triggers" binding:"required,min=1,max=3,dive,oneof=issue_labeled pr_comment push"`
+	Label          string           `json:"label" binding:"max=100"`
+	Command        string           `json:"command" binding:"max=50"`
+	Branches       []string         `json:"branches" binding:"max=20,dive,min=1,max=255"`
+	AllowedSenders []string         `json:"allowed_senders" binding:"max=100,dive,min=1,max=100"`
+
+	// 프롬프트 템플릿 ({{title}}, {{body}}, {{args}} 등 WebhookPromptVariables 사용)
+	Prompt        string `json:"prompt" binding:"max=10000"`
+	RequireReview bool   `json:"require_review"`
+
+	// 활성화 여부 (기본값 true)
+	Enabled *bool `json:"enabled"`
+}
+
+// WebhookMappingUpdateRequest 웹훅 매핑 수정 요청 (지정한 필드만 변경, 제공자는 바꿀 수 없음)
+type WebhookMappingUpdateRequest struct {
+	Name           *string           `json:"name" binding:"omitempty,min=1,max=100"`
+	Repository     *string           `json:"repository" binding:"omitempty,min=1,max=255"`
+	WorkspaceID    *string           `json:"workspace_id" binding:"omitempty,min=1"`
+	SessionID      *string           `json:"session_id"`
+	Triggers       *[]WebhookTrigger `json:"triggers" binding:"omitempty,min=1,max=3,dive,oneof=issue_labeled pr_comment push"`
+	Label          *string           `json:"label" binding:"omitempty,max=100"`
+	Command        *string           `json:"command" binding:"omitempty,max=50"`
+	Branches       *[]string         `json:"branches" binding:"omitempty,max=20,dive,min=1,max=255"`
+	AllowedSenders *[]string         `json:"allowed_senders" binding:"omitempty,max=100,dive,min=1,max=100"`
+	Prompt         *string           `json:"prompt" binding:"omitempty,max=10000"`
+	RequireReview  *bool             `json:"require_review"`
+	Enabled        *bool             `json:"enabled"`
+}
+
+// WebhookDelivery 수신한 웹훅 요청 (컨트롤러가 헤더에서 추출)
+type WebhookDelivery struct {
+	// GitHub X-GitHub-Event 또는 GitLab X-Gitlab-Event
+	Event string
+	// GitHub X-GitHub-Delivery 또는 GitLab X-Gitlab-Event-UUID
+	DeliveryID string
+	// GitHub X-Hub-Signature-256
+	Signature string
+	// GitLab X-Gitlab-Token
+	Token   string
+	Payload []byte
+}
+
+// WebhookDeliveryStatus 웹훅 처리 결과
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryTriggered 태스크를 시작함
+	WebhookDeliveryTriggered WebhookDeliveryStatus = "triggered"
+	// WebhookDeliveryIgnored 매핑 조건에 맞지 않아 무시함
+	WebhookDeliveryIgnored WebhookDeliveryStatus = "ignored"
+	// WebhookDeliveryFailed 조건에는 맞았지만 태스크를 시작하지 못함
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDeliveryResult 웹훅 처리 결과 (응답 본문과 매핑의 마지막 수신 기록)
+type WebhookDeliveryResult struct {
+	Status     WebhookDeliveryStatus `json:"status"`
+	Event      string                `json:"event"`
+	DeliveryID string                `json:"delivery_id,omitempty"`
+	Trigger    WebhookTrigger        `json:"trigger,omitempty"`
+	TaskID     string                `json:"task_id,omitempty"`
+	Reason     string                `json:"reason,omitempty"`
+	ReceivedAt time.Time             `json:"received_at"`
+}
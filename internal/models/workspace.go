@@ -55,8 +55,15 @@ type Workspace struct {
 	// example: 2025-07-21T14:31:00Z
 	UpdatedAt time.Time `json:"updated_at" binding:"-" validate:"-"`
 	
+	// 버전 번호 (수정할 때마다 1씩 증가, 낙관적 잠금용)
+	// example: 3
+	Version int `json:"version" binding:"-" validate:"min=0"`
+	
 	// 삭제 시간 (soft delete)
 	DeletedAt *time.Time `json:"deleted_at,omitempty" binding:"-" validate:"-"`
+	
+	// 적용 중인 네트워크 이그레스 정책 (Docker 백엔드에서 조회 시 채워짐, 저장하지 않음)
+	NetworkPolicy *NetworkPolicy `json:"network_policy,omitempty" binding:"-" validate:"-"`
 }
 
 // CreateWorkspaceRequest 워크스페이스 생성 요청
@@ -93,6 +100,10 @@ type UpdateWorkspaceRequest struct {
 	// 워크스페이스 상태
 	// example: inactive
 	Status WorkspaceStatus `json:"status,omitempty" binding:"omitempty,oneof=active inactive archived" validate:"omitempty,workspace_status"`
+	
+	// 수정 기준으로 삼은 버전 (다른 요청이 먼저 수정했으면 409, 생략하면 검사하지 않음)
+	// example: 3
+	Version int `json:"version,omitempty" binding:"omitempty,min=1" validate:"omitempty,min=1"`
 }
 
 // WorkspaceListResponse 워크스페이스 목록 응답
@@ -154,4 +165,15 @@ func (w *Workspace) MaskClaudeKey() {
 			w.ClaudeKey = "***"
 		}
 	}
-}
\ No newline at end of file
+}
+// SortValue 정렬 필드에 해당하는 값 반환 (커서 생성용)
+func (w *Workspace) SortValue(field string) interface{} {
+	switch field {
+	case "name":
+		return w.Name
+	case "updated_at":
+		return w.UpdatedAt
+	default:
+		return w.CreatedAt
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import "time"
+
+// WorkspacePermission 워크스페이스 공유 권한
+// read < execute < admin 순으로 상위 권한이 하위 권한을 포함합니다.
+type WorkspacePermission string
+
+const (
+	// WorkspacePermissionRead 워크스페이스, 프로젝트, 세션, 태스크 조회
+	WorkspacePermissionRead WorkspacePermission = "read"
+	// WorkspacePermissionExecute 조회 + 세션 생성과 태스크 실행
+	WorkspacePermissionExecute WorkspacePermission = "execute"
+	// WorkspacePermissionAdmin 실행 + 워크스페이스 설정 변경과 ACL 관리
+	WorkspacePermissionAdmin WorkspacePermission = "admin"
+	// WorkspacePermissionOwner 소유자 (ACL 항목으로 부여할 수 없음)
+	WorkspacePermissionOwner WorkspacePermission = "owner"
+)
+
+// workspacePermissionLevels 권한 비교용 순위
+var workspacePermissionLevels = map[WorkspacePermission]int{
+	WorkspacePermissionRead:    1,
+	WorkspacePermissionExecute: 2,
+	WorkspacePermissionAdmin:   3,
+	WorkspacePermissionOwner:   4,
+}
+
+// Includes 이 권한이 required 권한을 포함하는지 확인
+func (p WorkspacePermission) Includes(required WorkspacePermission) bool {
+	level, ok := workspacePermissionLevels[p]
+	if !ok {
+		return false
+	}
+	return level >= workspacePermissionLevels[required]
+}
+
+// ACLPrincipalType ACL 항목의 대상 종류
+type ACLPrincipalType string
+
+const (
+	// ACLPrincipalUser 사용자
+	ACLPrincipalUser ACLPrincipalType = "user"
+	// ACLPrincipalGroup RBAC 사용자 그룹
+	ACLPrincipalGroup ACLPrincipalType = "group"
+)
+
+// WorkspaceACLEntry 워크스페이스 공유 ACL 항목
+// 워크스페이스와 대상(사용자 또는 그룹)마다 하나의 권한만 가집니다.
+// swagger:model WorkspaceACLEntry
+type WorkspaceACLEntry struct {
+	ID            string              `json:"id"`
+	WorkspaceID   string              `json:"workspace_id"`
+	PrincipalType ACLPrincipalType    `json:"principal_type"`
+	PrincipalID   string              `json:"principal_id"`
+	Permission    WorkspacePermission `json:"permission"`
+	GrantedBy     string              `json:"granted_by"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+// ACLPrincipal ACL 조회 대상
+type ACLPrincipal struct {
+	Type ACLPrincipalType
+	ID   string
+}
+
+// WorkspaceACLRequest ACL 항목 부여/변경 요청
+type WorkspaceACLRequest struct {
+	// 대상 종류 (user, group)
+	PrincipalType ACLPrincipalType `json:"principal_type" binding:"required,oneof=user group"`
+
+	// 사용자 ID 또는 그룹 ID
+	PrincipalID string `json:"principal_id" binding:"required"`
+
+	// 권한 (read, execute, admin)
+	Permission WorkspacePermission `json:"permission" binding:"required,oneof=read execute admin"`
+}
+
+// WorkspaceAccessEntry "누가 접근할 수 있는가" 응답 항목
+type WorkspaceAccessEntry struct {
+	// 대상 종류 (user, group)
+	PrincipalType ACLPrincipalType `json:"principal_type"`
+
+	// 사용자 ID 또는 그룹 ID
+	PrincipalID string `json:"principal_id"`
+
+	// 그룹 이름 (그룹 항목이고 그룹을 찾은 경우)
+	PrincipalName string `json:"principal_name,omitempty"`
+
+	// 권한
+	Permission WorkspacePermission `json:"permission"`
+
+	// 권한 출처 (owner, acl)
+	Source string `json:"source"`
+
+	// 그룹 구성원 (그룹 항목이고 구성원을 조회할 수 있는 경우)
+	Members []string `json:"members,omitempty"`
+}
+
+// SharedWorkspace 다른 사용자에게서 공유받은 워크스페이스와 내 권한
+type SharedWorkspace struct {
+	Workspace
+
+	// 본인 또는 소속 그룹에 부여된 권한 중 가장 높은 권한
+	Permission WorkspacePermission `json:"permission"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// WorkspaceDiskQuotaLevel 워크스페이스 디스크 사용량 단계
+type WorkspaceDiskQuotaLevel string
+
+const (
+	// WorkspaceDiskQuotaOK 한도 안 (또는 한도 없음)
+	WorkspaceDiskQuotaOK WorkspaceDiskQuotaLevel = "ok"
+	// WorkspaceDiskQuotaWarning 경고 비율 이상 사용
+	WorkspaceDiskQuotaWarning WorkspaceDiskQuotaLevel = "warning"
+	// WorkspaceDiskQuotaExceeded 한도 도달 (새 태스크 거부)
+	WorkspaceDiskQuotaExceeded WorkspaceDiskQuotaLevel = "exceeded"
+)
+
+// Severity 단계 비교용 순서 (높을수록 심각)
+func (l WorkspaceDiskQuotaLevel) Severity() int {
+	switch l {
+	case WorkspaceDiskQuotaWarning:
+		return 1
+	case WorkspaceDiskQuotaExceeded:
+		return 2
+	}
+	return 0
+}
+
+// WorkspaceDiskUsage 워크스페이스 디렉토리 디스크 사용량과 한도
+// swagger:model WorkspaceDiskUsage
+type WorkspaceDiskUsage struct {
+	WorkspaceID string `json:"workspace_id"`
+
+	// 사용량 (바이트, 하드 링크로 공유한 파일은 한 번만 셈)
+	UsedBytes int64 `json:"used_bytes"`
+
+	// 한도 (바이트, 0이면 무제한)
+	LimitBytes int64 `json:"limit_bytes"`
+
+	// 한도 대비 사용률 (%, 한도가 없으면 0)
+	Percent float64 `json:"percent"`
+
+	// 사용량 단계
+	// example: warning
+	Level WorkspaceDiskQuotaLevel `json:"level"`
+
+	// 사용량을 측정한 시각 (주기적으로 다시 측정)
+	MeasuredAt time.Time `json:"measured_at"`
+}
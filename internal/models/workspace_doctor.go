@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// WorkspaceDoctorStatus 워크스페이스 점검 항목 결과
+type WorkspaceDoctorStatus string
+
+const (
+	// WorkspaceDoctorOK 문제 없음
+	WorkspaceDoctorOK WorkspaceDoctorStatus = "ok"
+	// WorkspaceDoctorWarn 동작은 하지만 확인이 필요함
+	WorkspaceDoctorWarn WorkspaceDoctorStatus = "warn"
+	// WorkspaceDoctorFail 태스크 실행이 실패할 문제
+	WorkspaceDoctorFail WorkspaceDoctorStatus = "fail"
+	// WorkspaceDoctorSkipped 기능이 꺼져 있거나 앞선 점검이 실패해 건너뜀
+	WorkspaceDoctorSkipped WorkspaceDoctorStatus = "skipped"
+)
+
+// 워크스페이스 점검 항목
+const (
+	WorkspaceDoctorCheckPath   = "path"
+	WorkspaceDoctorCheckGit    = "git"
+	WorkspaceDoctorCheckClaude = "claude_cli"
+	WorkspaceDoctorCheckImage  = "container_image"
+	WorkspaceDoctorCheckDisk   = "disk_quota"
+	WorkspaceDoctorCheckMCP    = "mcp_servers"
+)
+
+// WorkspaceDoctorFinding 점검 항목 하나의 결과와 해결 방법
+type WorkspaceDoctorFinding struct {
+	// 점검 항목
+	// example: git
+	Check string `json:"check"`
+
+	// 결과
+	// example: fail
+	Status WorkspaceDoctorStatus `json:"status"`
+
+	// 결과 설명
+	Message string `json:"message"`
+
+	// 문제의 에러 종류 (예: FileSystemError, 문제가 없으면 생략)
+	ErrorType string `json:"error_type,omitempty"`
+
+	// 해결 방법
+	Suggestions []string `json:"suggestions,omitempty"`
+
+	// 점검에 걸린 시간 (밀리초)
+	DurationMs int64 `json:"duration_ms"`
+}
+
+// WorkspaceDoctorReport 워크스페이스 사전 점검 결과
+// swagger:model WorkspaceDoctorReport
+type WorkspaceDoctorReport struct {
+	WorkspaceID string `json:"workspace_id,omitempty"`
+	Path        string `json:"path"`
+
+	// 실패한 항목이 없으면 true (경고는 허용)
+	Healthy bool `json:"healthy"`
+
+	Findings  []WorkspaceDoctorFinding `json:"findings"`
+	CheckedAt time.Time                `json:"checked_at"`
+}
@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+// WorkspaceEnvVar 워크스페이스의 Claude 프로세스에 전달하는 환경 변수
+// 비밀 변수는 암호화해 저장하고 API 응답에서는 값을 가립니다.
+// swagger:model WorkspaceEnvVar
+type WorkspaceEnvVar struct {
+	WorkspaceID string `json:"workspace_id"`
+	Key         string `json:"key"`
+	// 값 (비밀 변수는 마스킹, 스토리지에는 암호화한 값)
+	Value     string    `json:"value"`
+	Secret    bool      `json:"secret"`
+	UpdatedBy string    `json:"updated_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WorkspaceEnvVarCreateRequest 환경 변수 추가 요청
+type WorkspaceEnvVarCreateRequest struct {
+	// 변수 이름 (영문 대문자, 숫자, 밑줄이며 숫자로 시작할 수 없음)
+	Key string `json:"key" binding:"required,max=128"`
+
+	// 값
+	Value string `json:"value" binding:"max=32768"`
+
+	// 비밀 변수 여부 (암호화해 저장하고 응답에서 가림)
+	Secret bool `json:"secret"`
+}
+
+// WorkspaceEnvVarUpdateRequest 환경 변수 변경 요청
+type WorkspaceEnvVarUpdateRequest struct {
+	// 새 값 (생략하면 그대로, 비밀 변수를 일반 변수로 바꿀 때는 필수)
+	Value *string `json:"value" binding:"omitempty,max=32768"`
+
+	// 비밀 변수 여부 (생략하면 그대로)
+	Secret *bool `json:"secret"`
+}
+
+// WorkspaceEnvAction 환경 변수 변경 종류
+type WorkspaceEnvAction string
+
+const (
+	// WorkspaceEnvCreated 변수 추가
+	WorkspaceEnvCreated WorkspaceEnvAction = "created"
+	// WorkspaceEnvUpdated 값 또는 비밀 여부 변경
+	WorkspaceEnvUpdated WorkspaceEnvAction = "updated"
+	// WorkspaceEnvDeleted 변수 삭제
+	WorkspaceEnvDeleted WorkspaceEnvAction = "deleted"
+)
+
+// WorkspaceEnvChange 환경 변수 변경 감사 기록 (값은 기록하지 않음)
+// swagger:model WorkspaceEnvChange
+type WorkspaceEnvChange struct {
+	ID          string             `json:"id"`
+	WorkspaceID string             `json:"workspace_id"`
+	Key         string             `json:"key"`
+	Action      WorkspaceEnvAction `json:"action"`
+	// 변경 후 비밀 변수 여부 (삭제면 삭제 전)
+	Secret bool `json:"secret"`
+	// 값이 바뀌었는지 여부
+	ValueChanged bool      `json:"value_changed"`
+	ActorID      string    `json:"actor_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// WorkspaceEnvHistoryRequest 환경 변수 변경 기록 조회 요청
+type WorkspaceEnvHistoryRequest struct {
+	// 변수 이름 (생략하면 모든 변수)
+	Key string `form:"key"`
+
+	// 최대 개수 (기본값: 50)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=500"`
+}
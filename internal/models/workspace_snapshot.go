@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// WorkspaceSnapshot 워크스페이스 디렉토리의 특정 시점 파일 시스템 복사본
+// 바뀌지 않은 파일은 이전 스냅샷과 하드 링크로 공유하고, 지원하는 파일 시스템에서는 reflink로 복사합니다.
+// swagger:model WorkspaceSnapshot
+type WorkspaceSnapshot struct {
+	ID          string `json:"id"`
+	WorkspaceID string `json:"workspace_id"`
+
+	// 스냅샷을 만든 태스크 (태스크 실행 전 자동 스냅샷만)
+	TaskID string `json:"task_id,omitempty"`
+
+	// 스냅샷 설명
+	// example: 리팩터링 전
+	Label string `json:"label,omitempty"`
+
+	// 스냅샷한 파일 수와 전체 크기 (바이트)
+	Files int   `json:"files"`
+	Bytes int64 `json:"bytes"`
+
+	// 이전 스냅샷과 하드 링크로 공유한 파일 수
+	LinkedFiles int `json:"linked_files"`
+	// reflink로 복사한 파일 수
+	ClonedFiles int `json:"cloned_files"`
+	// 내용을 새로 복사한 파일 수와 크기
+	CopiedFiles int   `json:"copied_files"`
+	CopiedBytes int64 `json:"copied_bytes"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkspaceSnapshotCreateRequest 스냅샷 생성 요청
+type WorkspaceSnapshotCreateRequest struct {
+	// 스냅샷 설명
+	Label string `json:"label,omitempty" binding:"max=200"`
+}
+
+// WorkspaceSnapshotRestoreResult 스냅샷 복원 결과
+// swagger:model WorkspaceSnapshotRestoreResult
+type WorkspaceSnapshotRestoreResult struct {
+	// 복원한 스냅샷
+	Snapshot *WorkspaceSnapshot `json:"snapshot"`
+
+	// 복원 직전 상태를 담은 자동 스냅샷 (복원을 되돌릴 때 사용)
+	Backup *WorkspaceSnapshot `json:"backup,omitempty"`
+
+	// 스냅샷 내용으로 다시 쓴 파일 수와 삭제한 항목 수
+	Restored int `json:"restored"`
+	Removed  int `json:"removed"`
+}
@@ -0,0 +1,112 @@
+package models
+
+import "time"
+
+// WorkspaceWatchState 워크스페이스 파일 감시 상태
+type WorkspaceWatchState string
+
+const (
+	// WorkspaceWatchDisabled 감시하지 않음 (설정이 없거나 꺼져 있음)
+	WorkspaceWatchDisabled WorkspaceWatchState = "disabled"
+	// WorkspaceWatchIdle 변경을 기다리는 중
+	WorkspaceWatchIdle WorkspaceWatchState = "idle"
+	// WorkspaceWatchPending 변경을 모으는 중 (디바운스 시간이 지나면 실행)
+	WorkspaceWatchPending WorkspaceWatchState = "pending"
+	// WorkspaceWatchRunning 자동 실행한 태스크나 파이프라인이 끝나기를 기다리는 중 (이 동안의 변경은 무시)
+	WorkspaceWatchRunning WorkspaceWatchState = "running"
+	// WorkspaceWatchCooldown 실행이 끝난 직후 변경을 무시하는 중
+	WorkspaceWatchCooldown WorkspaceWatchState = "cooldown"
+	// WorkspaceWatchPaused 실행 횟수 한도를 넘거나 감시할 수 없어 멈춤 (설정을 다시 저장하면 재개)
+	WorkspaceWatchPaused WorkspaceWatchState = "paused"
+)
+
+// WorkspaceWatchChangedFilesPlaceholder 프롬프트에서 바뀐 파일 목록으로 치환되는 자리 표시자
+const WorkspaceWatchChangedFilesPlaceholder = "{{changed_files}}"
+
+// WorkspaceWatchConfig 워크스페이스 파일 변경 시 자동으로 실행할 태스크 또는 파이프라인 설정
+// 세션 프로젝트 디렉토리에서 패턴과 일치하는 파일이 바뀌면 디바운스 시간 동안 변경을 모은 뒤 한 번 실행합니다.
+// swagger:model WorkspaceWatchConfig
+type WorkspaceWatchConfig struct {
+	WorkspaceID string `json:"workspace_id"`
+
+	// 감시 여부
+	Enabled bool `json:"enabled"`
+
+	// 실행할 세션 (프로젝트 디렉토리를 감시)
+	SessionID string `json:"session_id"`
+
+	// 실행을 일으키는 파일 패턴 (프로젝트 기준 상대 경로, "**"는 여러 디렉토리, "/"가 없으면 파일 이름만 비교)
+	// example: ["src/**/*.go", "*.proto"]
+	Patterns []string `json:"patterns"`
+
+	// 무시할 파일 패턴 (서버 설정의 workspace.exclude_patterns에 더해 적용)
+	Ignore []string `json:"ignore,omitempty"`
+
+	// 실행할 프롬프트 ({{changed_files}}는 바뀐 파일 목록으로 치환, pipeline_id와 함께 쓸 수 없음)
+	Prompt string `json:"prompt,omitempty"`
+
+	// 실행할 파이프라인 ID (설정한 사용자의 파이프라인)
+	PipelineID string `json:"pipeline_id,omitempty"`
+
+	// 프롬프트 태스크 실행 시간 제한 단계 (quick, standard, long)
+	TimeoutTier TaskTimeoutTier `json:"timeout_tier,omitempty"`
+
+	// 마지막 변경 후 실행까지 기다리는 시간 (밀리초)
+	DebounceMS int64 `json:"debounce_ms"`
+
+	// 실행이 끝난 뒤 변경을 무시하는 시간 (밀리초, Claude가 고친 파일의 늦은 이벤트 방지)
+	CooldownMS int64 `json:"cooldown_ms"`
+
+	// 한 시간 동안 자동 실행할 수 있는 최대 횟수 (넘으면 감시를 멈춤)
+	MaxTriggersPerHour int `json:"max_triggers_per_hour"`
+
+	// 태스크를 실행하는 사용자 (설정을 저장한 사용자)
+	OwnerID string `json:"owner_id"`
+
+	// 감시가 멈춘 이유 (설정을 다시 저장하면 지워짐)
+	PausedReason string `json:"paused_reason,omitempty"`
+
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WorkspaceWatchRequest 워크스페이스 파일 감시 설정 저장 요청
+type WorkspaceWatchRequest struct {
+	Enabled            bool            `json:"enabled"`
+	SessionID          string          `json:"session_id" binding:"required"`
+	Patterns           []string        `json:"patterns" binding:"required,min=1,max=50,dive,required,max=256"`
+	Ignore             []string        `json:"ignore,omitempty" binding:"omitempty,max=50,dive,required,max=256"`
+	Prompt             string          `json:"prompt,omitempty" binding:"max=10000"`
+	PipelineID         string          `json:"pipeline_id,omitempty"`
+	TimeoutTier        TaskTimeoutTier `json:"timeout_tier,omitempty" binding:"omitempty,oneof=quick standard long"`
+	DebounceMS         int64           `json:"debounce_ms" binding:"omitempty,min=100,max=600000"`
+	CooldownMS         int64           `json:"cooldown_ms" binding:"omitempty,min=0,max=3600000"`
+	MaxTriggersPerHour int             `json:"max_triggers_per_hour" binding:"omitempty,min=1,max=60"`
+}
+
+// WorkspaceWatchStatus 워크스페이스 파일 감시 설정과 현재 상태
+// swagger:model WorkspaceWatchStatus
+type WorkspaceWatchStatus struct {
+	Config *WorkspaceWatchConfig `json:"config,omitempty"`
+
+	// 감시 상태
+	// example: idle
+	State WorkspaceWatchState `json:"state"`
+
+	// 감시 중인 디렉토리
+	Root string `json:"root,omitempty"`
+
+	// 실행을 기다리는 바뀐 파일 (프로젝트 기준 상대 경로)
+	PendingChanges []string `json:"pending_changes,omitempty"`
+
+	// 마지막으로 자동 실행한 태스크 ID (프롬프트) 또는 파이프라인 실행 ID
+	LastTaskID        string     `json:"last_task_id,omitempty"`
+	LastPipelineRunID string     `json:"last_pipeline_run_id,omitempty"`
+	LastTriggeredAt   *time.Time `json:"last_triggered_at,omitempty"`
+	LastError         string     `json:"last_error,omitempty"`
+
+	// 최근 한 시간 동안 자동 실행한 횟수
+	TriggersLastHour int `json:"triggers_last_hour"`
+
+	// 실행 중이거나 쿨다운이라 무시한 변경 수 (감시를 시작한 뒤부터)
+	SuppressedEvents int64 `json:"suppressed_events"`
+}
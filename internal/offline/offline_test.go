@@ -0,0 +1,120 @@
+package offline
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// fakeClaude 정해진 stream-json 출력을 내는 claude 스크립트 생성
+func fakeClaude(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "claude")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body), 0755))
+	return path
+}
+
+func TestRunner_Run(t *testing.T) {
+	script := fakeClaude(t, `echo "$2" > prompt.txt
+echo '{"type":"system","subtype":"init","session_id":"claude-1"}'
+echo '{"type":"assistant","message":{"content":[{"type":"text","text":"migration을 고치는 중"},{"type":"tool_use","name":"Bash"}]},"session_id":"claude-1"}'
+echo '{"type":"result","subtype":"success","result":"migration을 고쳤습니다","is_error":false,"session_id":"claude-1"}'
+`)
+	workDir := t.TempDir()
+	var out bytes.Buffer
+	runner := &Runner{Store: NewStore(t.TempDir()), Command: script, Output: &out}
+
+	transcript, err := runner.Run(context.Background(), "fix the migration", workDir)
+	require.NoError(t, err)
+	assert.True(t, transcript.Finished())
+	assert.Empty(t, transcript.Error)
+	assert.Equal(t, "migration을 고치는 중\n→ Bash\n", out.String())
+
+	prompt, err := os.ReadFile(filepath.Join(workDir, "prompt.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "fix the migration\n", string(prompt))
+
+	// 저장된 기록은 다시 읽어도 같음
+	saved, err := runner.Store.Get(transcript.ID)
+	require.NoError(t, err)
+	assert.Equal(t, transcript.Prompt, saved.Prompt)
+	list, err := runner.Store.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	output, err := os.Open(runner.Store.OutputPath(transcript.ID))
+	require.NoError(t, err)
+	defer output.Close()
+	req, err := BuildImportRequest(saved, output)
+	require.NoError(t, err)
+	assert.Equal(t, transcript.ID, req.TranscriptID)
+	assert.Equal(t, workDir, req.WorkingDir)
+	require.Len(t, req.Messages, 2)
+	assert.Equal(t, models.MessageRoleUser, req.Messages[0].Role)
+	assert.Equal(t, "fix the migration", req.Messages[0].Content)
+	assert.Equal(t, models.MessageRoleAssistant, req.Messages[1].Role)
+	assert.Equal(t, "migration을 고쳤습니다", req.Messages[1].Content)
+	assert.Equal(t, "claude-1", req.Messages[1].Metadata[models.MessageMetaClaudeSessionID])
+}
+
+func TestRunner_RunFailure(t *testing.T) {
+	script := fakeClaude(t, `echo '{"type":"result","subtype":"error_during_execution","result":"credit balance too low","is_error":true}'
+exit 1
+`)
+	runner := &Runner{Store: NewStore(t.TempDir()), Command: script}
+
+	transcript, err := runner.Run(context.Background(), "fix the migration", t.TempDir())
+	assert.Error(t, err)
+	require.NotNil(t, transcript)
+	assert.NotEmpty(t, transcript.Error)
+
+	// 실패한 실행도 오류 메시지와 함께 동기화
+	req, err := BuildImportRequest(transcript, strings.NewReader(""))
+	require.NoError(t, err)
+	require.Len(t, req.Messages, 2)
+	assert.Equal(t, models.MessageRoleSystem, req.Messages[1].Role)
+	assert.Equal(t, "error", req.Messages[1].Type)
+}
+
+func TestBuildImportRequest(t *testing.T) {
+	startedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(time.Minute)
+	transcript := &Transcript{ID: "t-1", Prompt: "hello", StartedAt: startedAt}
+
+	_, err := BuildImportRequest(transcript, strings.NewReader(""))
+	assert.Error(t, err, "끝나지 않은 실행은 동기화하지 않음")
+
+	// result 줄 없이 끝나면 마지막 assistant 텍스트를 결과로 사용
+	transcript.EndedAt = &endedAt
+	output := `not json
+{"type":"assistant","message":{"content":[{"type":"text","text":"first"}]}}
+{"type":"assistant","message":{"content":[{"type":"text","text":"second"}]}}
+`
+	req, err := BuildImportRequest(transcript, strings.NewReader(output))
+	require.NoError(t, err)
+	require.Len(t, req.Messages, 2)
+	assert.Equal(t, "second", req.Messages[1].Content)
+	assert.True(t, req.Messages[1].CreatedAt.Equal(endedAt))
+	assert.Nil(t, req.Messages[1].Metadata)
+}
+
+func TestStore_GetRejectsPaths(t *testing.T) {
+	store := NewStore(t.TempDir())
+	_, err := store.Get("../meta")
+	assert.ErrorIs(t, err, ErrTranscriptNotFound)
+	_, err = store.Get("missing")
+	assert.ErrorIs(t, err, ErrTranscriptNotFound)
+
+	list, err := NewStore(filepath.Join(t.TempDir(), "none")).List()
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,129 @@
+package offline
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/logging"
+)
+
+// DefaultCommand 로컬 Claude CLI 실행 파일
+const DefaultCommand = "claude"
+
+// Runner API 서버 없이 로컬 Claude CLI로 작업을 실행
+// 서버와 같은 claude.ProcessManager로 프로세스를 관리합니다.
+type Runner struct {
+	// Store 대화 기록 저장소
+	Store *Store
+	// Command Claude CLI 실행 파일 (비어 있으면 claude)
+	Command string
+	// APIKey Claude API 키 (비어 있으면 Claude CLI의 로그인 정보 사용)
+	APIKey string
+	// Model 사용할 모델 (비어 있으면 Claude CLI 기본값)
+	Model string
+	// Timeout 최대 실행 시간 (0이면 제한 없음)
+	Timeout time.Duration
+	// Output 실행 중 응답을 출력할 곳 (nil이면 출력하지 않음)
+	Output io.Writer
+	// Logger 프로세스 관리자 로거 (nil이면 기록하지 않음)
+	Logger logging.Logger
+}
+
+// Run 작업 디렉토리에서 프롬프트를 실행하고 대화 기록을 저장
+// 실행이 실패해도 대화 기록은 오류와 함께 저장되어 나중에 동기화할 수 있습니다.
+func (r *Runner) Run(ctx context.Context, prompt, workingDir string) (*Transcript, error) {
+	transcript, err := r.Store.Create(prompt, workingDir, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	runErr := r.execute(ctx, transcript)
+	endedAt := time.Now()
+	transcript.EndedAt = &endedAt
+	if runErr != nil {
+		transcript.Error = runErr.Error()
+	}
+	if err := r.Store.Save(transcript); err != nil {
+		return transcript, err
+	}
+	return transcript, runErr
+}
+
+// execute Claude CLI 프로세스를 실행하고 종료될 때까지 대기
+func (r *Runner) execute(ctx context.Context, transcript *Transcript) error {
+	output, err := os.OpenFile(r.Store.OutputPath(transcript.ID), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("출력 파일 생성 실패: %w", err)
+	}
+	defer output.Close()
+
+	// 표준 출력과 표준 에러는 각각의 고루틴에서 복사되므로 r.Output에는 잠금을 거쳐 씀
+	stdout := io.Writer(output)
+	var stderr io.Writer
+	if r.Output != nil {
+		console := &lockedWriter{out: r.Output}
+		stdout = io.MultiWriter(output, NewPrinter(console))
+		stderr = console
+	}
+
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	logger := r.Logger
+	if logger == nil {
+		logger = logging.Nop()
+	}
+	pm := claude.NewProcessManager(logger)
+	err = pm.Start(ctx, &claude.ProcessConfig{
+		Command:    r.command(),
+		Args:       r.args(transcript.Prompt),
+		WorkingDir: transcript.WorkingDir,
+		APIKey:     r.APIKey,
+		Stdout:     stdout,
+		Stderr:     stderr,
+	})
+	if err != nil {
+		return err
+	}
+	if err := pm.Wait(); err != nil {
+		return fmt.Errorf("Claude CLI 실행 실패: %w", err)
+	}
+	return nil
+}
+
+// lockedWriter 여러 고루틴이 같은 out에 쓸 때 한 번에 하나씩 쓰도록 잠그는 io.Writer
+type lockedWriter struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.out.Write(p)
+}
+
+// command 실행할 Claude CLI
+func (r *Runner) command() string {
+	if r.Command != "" {
+		return r.Command
+	}
+	return DefaultCommand
+}
+
+// args 한 번 실행하고 끝나는 비대화형 모드 인자
+func (r *Runner) args(prompt string) []string {
+	args := []string{"-p", prompt, "--output-format", "stream-json", "--verbose"}
+	if r.Model != "" {
+		args = append(args, "--model", r.Model)
+	}
+	return args
+}
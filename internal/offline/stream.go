@@ -0,0 +1,218 @@
+package offline
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// cliLine Claude CLI stream-json 출력 한 줄
+type cliLine struct {
+	Type      string `json:"type"`
+	Subtype   string `json:"subtype"`
+	SessionID string `json:"session_id"`
+	Model     string `json:"model"`
+	Result    string `json:"result"`
+	IsError   bool   `json:"is_error"`
+	Message   *struct {
+		Content []cliContentBlock `json:"content"`
+	} `json:"message"`
+}
+
+// cliContentBlock assistant 메시지의 내용 블록
+type cliContentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+	Name string `json:"name"`
+}
+
+// parseLine JSON이 아닌 줄은 false
+func parseLine(raw []byte) (*cliLine, bool) {
+	raw = bytes.TrimSpace(raw)
+	if !bytes.HasPrefix(raw, []byte("{")) {
+		return nil, false
+	}
+	var line cliLine
+	if err := json.Unmarshal(raw, &line); err != nil {
+		return nil, false
+	}
+	return &line, true
+}
+
+// assistantText assistant 메시지의 텍스트 블록을 이어 붙임
+func (l *cliLine) assistantText() string {
+	if l.Type != "assistant" || l.Message == nil {
+		return ""
+	}
+	var parts []string
+	for _, block := range l.Message.Content {
+		if block.Type == "text" && block.Text != "" {
+			parts = append(parts, block.Text)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// BuildImportRequest 대화 기록을 서버 가져오기 요청으로 변환 (메시지 전체를 담으며 나눠 보내는 것은 Syncer가 담당)
+// 서버에서 실행한 세션과 같은 형식(user/prompt, assistant/result, system/error)으로 기록하며,
+// 도구 호출 같은 중간 출력은 로컬 원본(output.jsonl)에만 남습니다. 사용량은 결과 메시지에서 읽습니다.
+func BuildImportRequest(transcript *Transcript, output io.Reader) (*models.TranscriptImportRequest, error) {
+	if !transcript.Finished() {
+		return nil, fmt.Errorf("대화 기록 %s의 실행이 아직 끝나지 않았습니다", transcript.ID)
+	}
+
+	var (
+		claudeSessionID string
+		model           string
+		lastText        string
+		result          *cliLine
+		resultLine      string
+	)
+	scanner := bufio.NewScanner(output)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line, ok := parseLine(scanner.Bytes())
+		if !ok {
+			continue
+		}
+		if line.SessionID != "" {
+			claudeSessionID = line.SessionID
+		}
+		if line.Type == "system" && line.Model != "" {
+			model = line.Model
+		}
+		if text := line.assistantText(); text != "" {
+			lastText = text
+		}
+		if line.Type == "result" {
+			result = line
+			resultLine = scanner.Text()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("출력 읽기 실패: %w", err)
+	}
+
+	var metadata map[string]string
+	if claudeSessionID != "" {
+		metadata = map[string]string{models.MessageMetaClaudeSessionID: claudeSessionID}
+	}
+	endedAt := *transcript.EndedAt
+	message := func(role models.MessageRole, messageType, content string, at time.Time) *models.TranscriptMessage {
+		return &models.TranscriptMessage{Role: role, Type: messageType, Content: content, Metadata: metadata, CreatedAt: at}
+	}
+
+	messages := []*models.TranscriptMessage{
+		message(models.MessageRoleUser, "prompt", transcript.Prompt, transcript.StartedAt),
+	}
+	errText := transcript.Error
+	switch {
+	case result != nil && result.IsError:
+		if errText == "" {
+			errText = result.Result
+		}
+	case result != nil && result.Result != "":
+		messages = append(messages, message(models.MessageRoleAssistant, "result", result.Result, endedAt))
+	case lastText != "":
+		messages = append(messages, message(models.MessageRoleAssistant, "result", lastText, endedAt))
+	}
+	if errText != "" {
+		messages = append(messages, message(models.MessageRoleSystem, "error", errText, endedAt))
+	}
+
+	req := &models.TranscriptImportRequest{
+		TranscriptID: transcript.ID,
+		Prompt:       truncateRunes(transcript.Prompt, maxTaskPromptLength),
+		WorkingDir:   transcript.WorkingDir,
+		StartedAt:    transcript.StartedAt,
+		EndedAt:      endedAt,
+		Messages:     messages,
+		Error:        errText,
+	}
+	if usage, ok := claude.ExtractCLIUsage(resultLine); ok {
+		req.Usage = &models.TranscriptUsage{
+			CostUSD:             usage.CostUSD,
+			InputTokens:         usage.InputTokens,
+			OutputTokens:        usage.OutputTokens,
+			CacheCreationTokens: usage.CacheCreationTokens,
+			CacheReadTokens:     usage.CacheReadTokens,
+			Model:               model,
+		}
+	}
+	return req, nil
+}
+
+// maxTaskPromptLength 서버 태스크에 기록하는 프롬프트 최대 길이 (전체 프롬프트는 첫 메시지에 있음)
+const maxTaskPromptLength = 10000
+
+// truncateRunes 문자 단위로 자름
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
+
+// printer stream-json 출력을 사람이 읽을 수 있게 바꿔 쓰는 io.Writer
+// 줄 단위로 해석하므로 한 줄이 여러 번에 나뉘어 들어와도 됩니다.
+type printer struct {
+	mu      sync.Mutex
+	out     io.Writer
+	pending []byte
+}
+
+// NewPrinter assistant 텍스트와 도구 호출만 out에 출력하는 io.Writer 생성
+func NewPrinter(out io.Writer) io.Writer {
+	return &printer{out: out}
+}
+
+func (p *printer) Write(data []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.pending = append(p.pending, data...)
+	for {
+		index := bytes.IndexByte(p.pending, '\n')
+		if index < 0 {
+			break
+		}
+		p.print(p.pending[:index])
+		p.pending = p.pending[index+1:]
+	}
+	return len(data), nil
+}
+
+// print 한 줄 출력 (출력 실패는 실행에 영향을 주지 않도록 무시)
+func (p *printer) print(raw []byte) {
+	line, ok := parseLine(raw)
+	if !ok {
+		return
+	}
+	switch line.Type {
+	case "assistant":
+		if line.Message == nil {
+			return
+		}
+		for _, block := range line.Message.Content {
+			switch block.Type {
+			case "text":
+				fmt.Fprintln(p.out, block.Text)
+			case "tool_use":
+				fmt.Fprintf(p.out, "→ %s\n", block.Name)
+			}
+		}
+	case "result":
+		if line.IsError {
+			fmt.Fprintf(p.out, "❌ %s\n", line.Result)
+		}
+	}
+}
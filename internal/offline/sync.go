@@ -0,0 +1,219 @@
+package offline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// DefaultSyncBatchSize 요청 하나에 보내는 메시지 수
+const DefaultSyncBatchSize = 100
+
+// maxOffsetRetries 서버가 받은 위치가 달라 다시 보내는 최대 횟수
+const maxOffsetRetries = 3
+
+// APIError 서버 오류 응답
+type APIError struct {
+	Status  int         `json:"-"`
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Details interface{} `json:"details"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s (%s, HTTP %d)", e.Message, e.Code, e.Status)
+}
+
+// IsRetryable 연결이 돌아오면 다시 시도할 만한 오류인지 (네트워크 오류, 서버 과부하, 5xx)
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Status >= http.StatusInternalServerError || apiErr.Status == http.StatusTooManyRequests
+	}
+	var netErr net.Error
+	var opErr *net.OpError
+	return errors.As(err, &netErr) || errors.As(err, &opErr)
+}
+
+// Syncer 끝난 대화 기록을 서버 프로젝트로 업로드
+// 보낸 메시지 수를 기록에 저장하므로 연결이 끊겨도 다음 동기화에서 이어서 보냅니다.
+// 서버는 프로젝트와 기록 ID로 세션을 정하고 이미 받은 메시지를 건너뛰므로 다시 보내도 중복되지 않습니다.
+type Syncer struct {
+	Store     *Store
+	Server    string
+	ProjectID string
+	// Token 액세스 토큰
+	Token string
+	// Refresh 토큰이 만료되었을 때 새 토큰을 받는 함수 (nil이면 갱신하지 않음)
+	Refresh func(ctx context.Context) (string, error)
+	// BatchSize 요청 하나에 보내는 메시지 수 (0이면 DefaultSyncBatchSize)
+	BatchSize int
+	// Client HTTP 클라이언트 (nil이면 http.DefaultClient)
+	Client *http.Client
+}
+
+// Pending 아직 이 프로젝트로 동기화하지 않은 끝난 기록
+func (s *Syncer) Pending() ([]*Transcript, error) {
+	transcripts, err := s.Store.List()
+	if err != nil {
+		return nil, err
+	}
+	var pending []*Transcript
+	for _, transcript := range transcripts {
+		if transcript.Finished() && !s.Synced(transcript) {
+			pending = append(pending, transcript)
+		}
+	}
+	return pending, nil
+}
+
+// Synced 기록을 이 서버의 이 프로젝트로 이미 동기화했는지
+func (s *Syncer) Synced(transcript *Transcript) bool {
+	return transcript.Synced() && transcript.SyncedServer == s.Server && transcript.SyncedProjectID == s.ProjectID
+}
+
+// Sync 기록 하나를 업로드 (이미 동기화했으면 아무것도 하지 않음)
+func (s *Syncer) Sync(ctx context.Context, transcript *Transcript) error {
+	if s.Synced(transcript) {
+		return nil
+	}
+	output, err := os.Open(s.Store.OutputPath(transcript.ID))
+	if err != nil {
+		return fmt.Errorf("출력 파일을 열 수 없습니다: %w", err)
+	}
+	req, err := BuildImportRequest(transcript, output)
+	output.Close()
+	if err != nil {
+		return err
+	}
+
+	// 다른 서버나 프로젝트로 보내던 기록은 처음부터
+	if transcript.SyncedServer != s.Server || transcript.SyncedProjectID != s.ProjectID {
+		transcript.SyncedServer = s.Server
+		transcript.SyncedProjectID = s.ProjectID
+		transcript.UploadedMessages = 0
+		transcript.SyncedAt = nil
+		transcript.RemoteSessionID = ""
+	}
+
+	messages := req.Messages
+	offset := transcript.UploadedMessages
+	if offset > len(messages) {
+		offset = 0
+	}
+	retries := 0
+	for {
+		end := offset + s.batchSize()
+		if end > len(messages) {
+			end = len(messages)
+		}
+		req.Offset = offset
+		req.Messages = messages[offset:end]
+		req.Complete = end == len(messages)
+
+		var result models.TranscriptImportResult
+		err := s.post(ctx, "/api/v1/projects/"+s.ProjectID+"/transcripts", req, &result)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Status == http.StatusConflict && retries < maxOffsetRetries {
+			// 서버가 받은 위치부터 다시 보냄
+			details, _ := apiErr.Details.(map[string]interface{})
+			received, ok := details["received"].(float64)
+			if !ok || int(received) > len(messages) {
+				return err
+			}
+			retries++
+			offset = int(received)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if result.Received <= offset && !req.Complete {
+			return fmt.Errorf("서버가 메시지를 받지 않았습니다 (위치 %d)", offset)
+		}
+
+		offset = result.Received
+		transcript.UploadedMessages = offset
+		if result.Session != nil {
+			transcript.RemoteSessionID = result.Session.ID
+		}
+		if req.Complete {
+			now := time.Now()
+			transcript.SyncedAt = &now
+		}
+		if err := s.Store.Save(transcript); err != nil {
+			return err
+		}
+		if req.Complete {
+			return nil
+		}
+	}
+}
+
+// batchSize 요청 하나에 보내는 메시지 수
+func (s *Syncer) batchSize() int {
+	if s.BatchSize <= 0 || s.BatchSize > models.MaxTranscriptChunkMessages {
+		return DefaultSyncBatchSize
+	}
+	return s.BatchSize
+}
+
+// post 인증된 JSON 요청을 보내고 응답 본문을 out에 디코딩 (토큰이 만료되었으면 한 번 갱신 후 재시도)
+func (s *Syncer) post(ctx context.Context, path string, body interface{}, out interface{}) error {
+	err := s.doPost(ctx, path, body, out)
+	var apiErr *APIError
+	if s.Refresh == nil || !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized {
+		return err
+	}
+	token, refreshErr := s.Refresh(ctx)
+	if refreshErr != nil {
+		return fmt.Errorf("%w (토큰 갱신 실패: %v)", err, refreshErr)
+	}
+	s.Token = token
+	return s.doPost(ctx, path, body, out)
+}
+
+// doPost 요청 한 번 (실패 응답은 *APIError)
+func (s *Syncer) doPost(ctx context.Context, path string, body interface{}, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Server+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.Token)
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var envelope struct {
+			Error *APIError `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&envelope)
+		if envelope.Error == nil {
+			envelope.Error = &APIError{Message: "요청이 실패했습니다"}
+		}
+		envelope.Error.Status = resp.StatusCode
+		return envelope.Error
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
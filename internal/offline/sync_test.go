@@ -0,0 +1,171 @@
+package offline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/api/controllers"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// flakyTransport 정해진 번째 요청에서 연결 오류를 내는 http.RoundTripper
+type flakyTransport struct {
+	failAt int
+	calls  int
+}
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.calls == f.failAt {
+		return nil, errors.New("connection refused")
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// syncTestServer 메모리 스토리지를 쓰는 동기화 API 서버 (토큰 good만 허용)
+func syncTestServer(t *testing.T) (*httptest.Server, *memory.Storage, *models.Project) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	store := memory.New()
+
+	workspace := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp"}
+	require.NoError(t, store.Workspace().Create(ctx, workspace))
+	project := &models.Project{WorkspaceID: workspace.ID, Name: "app", Path: "/tmp/app"}
+	require.NoError(t, store.Project().Create(ctx, project))
+
+	messages := services.NewMessageService(store)
+	controller := controllers.NewTranscriptController(services.NewTranscriptSyncService(store, messages, services.NewAnalyticsService(store)))
+	router := gin.New()
+	router.POST("/api/v1/projects/:id/transcripts", func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "Bearer good" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"success": false, "error": gin.H{"code": "UNAUTHORIZED", "message": "expired"}})
+		}
+	}, controller.Import)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server, store, project
+}
+
+// finishedTranscript 결과 메시지까지 끝난 대화 기록 생성
+func finishedTranscript(t *testing.T, store *Store) *Transcript {
+	t.Helper()
+	startedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	transcript, err := store.Create("fix the migration", "/home/alice/app", startedAt)
+	require.NoError(t, err)
+	output := `{"type":"system","subtype":"init","session_id":"claude-1","model":"claude-sonnet-4"}
+{"type":"result","subtype":"success","result":"migration을 고쳤습니다","is_error":false,"total_cost_usd":0.12,"usage":{"input_tokens":100,"output_tokens":20}}
+`
+	require.NoError(t, os.WriteFile(store.OutputPath(transcript.ID), []byte(output), 0600))
+	endedAt := startedAt.Add(time.Minute)
+	transcript.EndedAt = &endedAt
+	require.NoError(t, store.Save(transcript))
+	return transcript
+}
+
+func TestSyncer_ResumesAfterConnectionLoss(t *testing.T) {
+	server, remote, project := syncTestServer(t)
+	ctx := context.Background()
+	store := NewStore(t.TempDir())
+	transcript := finishedTranscript(t, store)
+
+	transport := &flakyTransport{failAt: 3}
+	refreshed := 0
+	syncer := &Syncer{
+		Store:     store,
+		Server:    server.URL,
+		ProjectID: project.ID,
+		Token:     "expired",
+		Refresh: func(ctx context.Context) (string, error) {
+			refreshed++
+			return "good", nil
+		},
+		BatchSize: 1,
+		Client:    &http.Client{Transport: transport},
+	}
+
+	pending, err := syncer.Pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+
+	// 만료된 토큰을 갱신해 첫 메시지를 보낸 뒤 연결이 끊김
+	err = syncer.Sync(ctx, transcript)
+	require.Error(t, err)
+	assert.True(t, IsRetryable(err))
+	assert.Equal(t, 1, refreshed)
+	saved, err := store.Get(transcript.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, saved.UploadedMessages)
+	assert.False(t, saved.Synced())
+
+	// 연결이 돌아오면 이어서 보냄
+	require.NoError(t, syncer.Sync(ctx, saved))
+	saved, err = store.Get(transcript.ID)
+	require.NoError(t, err)
+	assert.True(t, saved.Synced())
+	assert.Equal(t, 2, saved.UploadedMessages)
+	assert.Equal(t, services.OfflineSessionID(project.ID, transcript.ID), saved.RemoteSessionID)
+
+	pending, err = syncer.Pending()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	_, total, err := remote.Message().ListBySession(ctx, saved.RemoteSessionID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	// 사용량은 태스크로 기록
+	tasks, err := remote.Task().ListExecutedBetween(ctx, time.Time{}, time.Now())
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "claude-sonnet-4", tasks[0].ServedModel)
+	assert.Contains(t, tasks[0].Output, `"total_cost_usd":0.12`)
+}
+
+func TestSyncer_RecoversFromOffsetConflict(t *testing.T) {
+	server, remote, project := syncTestServer(t)
+	ctx := context.Background()
+	store := NewStore(t.TempDir())
+	transcript := finishedTranscript(t, store)
+
+	// 서버에 저장되지 않은 위치를 기억하고 있어도 서버가 알려준 위치부터 다시 보냄
+	transcript.SyncedServer = server.URL
+	transcript.SyncedProjectID = project.ID
+	transcript.UploadedMessages = 1
+	require.NoError(t, store.Save(transcript))
+
+	syncer := &Syncer{Store: store, Server: server.URL, ProjectID: project.ID, Token: "good"}
+	require.NoError(t, syncer.Sync(ctx, transcript))
+	assert.True(t, transcript.Synced())
+
+	_, total, err := remote.Message().ListBySession(ctx, transcript.RemoteSessionID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	// 다른 프로젝트로는 처음부터 다시 보냄
+	other := &models.Project{WorkspaceID: project.WorkspaceID, Name: "other", Path: "/tmp/other"}
+	require.NoError(t, remote.Project().Create(ctx, other))
+	syncer.ProjectID = other.ID
+	require.NoError(t, syncer.Sync(ctx, transcript))
+	assert.Equal(t, services.OfflineSessionID(other.ID, transcript.ID), transcript.RemoteSessionID)
+
+	// 권한 없는 요청은 다시 시도하지 않음
+	syncer.Token = "bad"
+	syncer.ProjectID = project.ID
+	transcript.SyncedAt = nil
+	err = syncer.Sync(ctx, transcript)
+	require.Error(t, err)
+	assert.False(t, IsRetryable(err))
+}
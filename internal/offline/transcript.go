@@ -0,0 +1,174 @@
+// Package offline API 서버 없이 로컬 Claude CLI로 작업을 실행하고
+// 대화 기록을 저장해 두었다가 나중에 서버로 동기화합니다.
+package offline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+const (
+	// metaFileName 대화 기록 정보 파일 이름
+	metaFileName = "meta.json"
+	// outputFileName Claude CLI stream-json 출력 원본 파일 이름
+	outputFileName = "output.jsonl"
+)
+
+// ErrTranscriptNotFound 대화 기록이 없음
+var ErrTranscriptNotFound = errors.New("대화 기록을 찾을 수 없습니다")
+
+// Transcript 오프라인으로 실행한 작업 하나의 기록
+type Transcript struct {
+	ID         string     `json:"id"`
+	Prompt     string     `json:"prompt"`
+	WorkingDir string     `json:"working_dir"`
+	StartedAt  time.Time  `json:"started_at"`
+	EndedAt    *time.Time `json:"ended_at,omitempty"`
+	// Error 실행이 실패했을 때의 오류 메시지
+	Error string `json:"error,omitempty"`
+
+	// 동기화 정보 (동기화 전에는 비어 있음)
+	// SyncedAt은 마지막 요청까지 보낸 뒤에 채우며, 중간에 끊기면 UploadedMessages부터 이어서 보냅니다.
+	SyncedAt         *time.Time `json:"synced_at,omitempty"`
+	SyncedServer     string     `json:"synced_server,omitempty"`
+	SyncedProjectID  string     `json:"synced_project_id,omitempty"`
+	RemoteSessionID  string     `json:"remote_session_id,omitempty"`
+	UploadedMessages int        `json:"uploaded_messages,omitempty"`
+}
+
+// Finished 실행이 끝났는지 여부
+func (t *Transcript) Finished() bool {
+	return t.EndedAt != nil
+}
+
+// Synced 서버로 동기화했는지 여부
+func (t *Transcript) Synced() bool {
+	return t.SyncedAt != nil
+}
+
+// Store 대화 기록 저장소 (기록마다 디렉토리 하나)
+type Store struct {
+	dir string
+}
+
+// NewStore dir 아래에 대화 기록을 저장하는 저장소 생성
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// NewDefaultStore ~/.aicli/offline/transcripts를 사용하는 저장소 생성
+func NewDefaultStore() *Store {
+	return NewStore(filepath.Join(config.GetConfigDir(), "offline", "transcripts"))
+}
+
+// Dir 저장소 디렉토리
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Create 새 대화 기록을 만들고 디렉토리를 준비
+func (s *Store) Create(prompt, workingDir string, startedAt time.Time) (*Transcript, error) {
+	id, err := newTranscriptID()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(s.dir, id), 0700); err != nil {
+		return nil, fmt.Errorf("대화 기록 디렉토리 생성 실패: %w", err)
+	}
+
+	transcript := &Transcript{
+		ID:         id,
+		Prompt:     prompt,
+		WorkingDir: workingDir,
+		StartedAt:  startedAt,
+	}
+	if err := s.Save(transcript); err != nil {
+		return nil, err
+	}
+	return transcript, nil
+}
+
+// Save 대화 기록 정보를 저장 (임시 파일에 쓴 뒤 교체)
+func (s *Store) Save(transcript *Transcript) error {
+	data, err := json.MarshalIndent(transcript, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, transcript.ID, metaFileName)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("대화 기록 저장 실패: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// Get ID로 대화 기록 조회
+func (s *Store) Get(id string) (*Transcript, error) {
+	if id == "" || filepath.Base(id) != id {
+		return nil, ErrTranscriptNotFound
+	}
+	data, err := os.ReadFile(filepath.Join(s.dir, id, metaFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrTranscriptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var transcript Transcript
+	if err := json.Unmarshal(data, &transcript); err != nil {
+		return nil, fmt.Errorf("대화 기록 %s 해석 실패: %w", id, err)
+	}
+	return &transcript, nil
+}
+
+// List 모든 대화 기록을 시작 시각 순서로 조회 (해석할 수 없는 기록은 건너뜀)
+func (s *Store) List() ([]*Transcript, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var transcripts []*Transcript
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		transcript, err := s.Get(entry.Name())
+		if err != nil {
+			continue
+		}
+		transcripts = append(transcripts, transcript)
+	}
+	sort.Slice(transcripts, func(i, j int) bool {
+		return transcripts[i].StartedAt.Before(transcripts[j].StartedAt)
+	})
+	return transcripts, nil
+}
+
+// OutputPath Claude CLI 출력 원본 파일 경로
+func (s *Store) OutputPath(id string) string {
+	return filepath.Join(s.dir, id, outputFileName)
+}
+
+// newTranscriptID 여러 기기에서 만들어도 겹치지 않고 시작 시각순으로 정렬되는 ID 생성 (UUIDv7)
+// 서버는 이 ID로 세션을 정하므로 기기 간에 겹치면 안 됩니다.
+func newTranscriptID() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
@@ -0,0 +1,51 @@
+package plugin
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewManagerFromConfig 설정에 정의된 플러그인을 등록한 매니저를 생성합니다.
+// 등록할 플러그인이 없으면 nil을 반환합니다.
+func NewManagerFromConfig(cfg config.PluginsConfig, logger *logrus.Logger) (*Manager, error) {
+	if len(cfg.Hooks) == 0 {
+		return nil, nil
+	}
+
+	manager, err := NewManager(cfg.StatePath, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pc := range cfg.Hooks {
+		hooks := make([]HookPoint, 0, len(pc.Hooks))
+		for _, h := range pc.Hooks {
+			hooks = append(hooks, HookPoint(h))
+		}
+		timeout := pc.Timeout
+		if timeout <= 0 {
+			timeout = cfg.DefaultTimeout
+		}
+
+		p, err := NewProcessPlugin(ProcessConfig{
+			Name:    pc.Name,
+			Command: pc.Command,
+			Args:    pc.Args,
+			Env:     pc.Env,
+			Hooks:   hooks,
+			Timeout: timeout,
+		})
+		if err != nil {
+			return nil, err
+		}
+		opts := Options{
+			FailurePolicy: FailurePolicy(pc.FailurePolicy),
+			Workspaces:    pc.Workspaces,
+		}
+		if err := manager.Register(p, opts); err != nil {
+			return nil, err
+		}
+	}
+	return manager, nil
+}
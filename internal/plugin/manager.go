@@ -0,0 +1,307 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// AllWorkspaces 모든 워크스페이스에서 기본 활성화
+const AllWorkspaces = "*"
+
+// Options 플러그인 등록 옵션
+type Options struct {
+	FailurePolicy FailurePolicy
+	// Workspaces 기본으로 활성화할 워크스페이스 ID 목록 ("*"이면 전체)
+	Workspaces []string
+}
+
+// Info 등록된 플러그인 정보
+type Info struct {
+	Name          string        `json:"name"`
+	Hooks         []HookPoint   `json:"hooks"`
+	FailurePolicy FailurePolicy `json:"failure_policy"`
+	Workspaces    []string      `json:"workspaces,omitempty"`
+}
+
+// WorkspacePlugin 워크스페이스별 플러그인 활성화 상태
+type WorkspacePlugin struct {
+	Info
+	Enabled bool `json:"enabled"`
+}
+
+type registration struct {
+	plugin  Plugin
+	options Options
+}
+
+// Manager 플러그인 등록과 워크스페이스별 활성화, 훅 실행을 관리합니다
+// 워크스페이스별 활성화 변경 사항은 statePath에 JSON으로 저장됩니다.
+type Manager struct {
+	mu        sync.RWMutex
+	plugins   map[string]*registration
+	order     []string
+	overrides map[string]map[string]bool
+	statePath string
+	logger    *logrus.Logger
+}
+
+// NewManager 새 플러그인 매니저 생성
+// statePath가 비어 있으면 활성화 변경 사항을 저장하지 않습니다.
+func NewManager(statePath string, logger *logrus.Logger) (*Manager, error) {
+	if logger == nil {
+		logger = logrus.StandardLogger()
+	}
+	m := &Manager{
+		plugins:   make(map[string]*registration),
+		overrides: make(map[string]map[string]bool),
+		statePath: statePath,
+		logger:    logger,
+	}
+	if err := m.loadState(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Register 플러그인 등록
+func (m *Manager) Register(p Plugin, opts Options) error {
+	if opts.FailurePolicy == "" {
+		opts.FailurePolicy = FailureIgnore
+	}
+	if opts.FailurePolicy != FailureIgnore && opts.FailurePolicy != FailureFail {
+		return fmt.Errorf("플러그인 %s: 알 수 없는 실패 정책: %s", p.Name(), opts.FailurePolicy)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.plugins[p.Name()]; exists {
+		return fmt.Errorf("플러그인 %s가 이미 등록되어 있습니다", p.Name())
+	}
+	m.plugins[p.Name()] = &registration{plugin: p, options: opts}
+	m.order = append(m.order, p.Name())
+	return nil
+}
+
+// List 등록된 플러그인 목록
+func (m *Manager) List() []Info {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	infos := make([]Info, 0, len(m.order))
+	for _, name := range m.order {
+		infos = append(infos, m.plugins[name].info())
+	}
+	return infos
+}
+
+// WorkspacePlugins 워크스페이스의 플러그인 활성화 상태
+func (m *Manager) WorkspacePlugins(workspaceID string) []WorkspacePlugin {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]WorkspacePlugin, 0, len(m.order))
+	for _, name := range m.order {
+		result = append(result, WorkspacePlugin{
+			Info:    m.plugins[name].info(),
+			Enabled: m.isEnabledLocked(workspaceID, name),
+		})
+	}
+	return result
+}
+
+// IsEnabled 워크스페이스에서 플러그인이 활성화되어 있는지 확인
+func (m *Manager) IsEnabled(workspaceID, name string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.isEnabledLocked(workspaceID, name)
+}
+
+// SetEnabled 워크스페이스의 플러그인 활성화 여부 변경
+func (m *Manager) SetEnabled(workspaceID, name string, enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.plugins[name]; !exists {
+		return ErrPluginNotFound
+	}
+	if m.overrides[workspaceID] == nil {
+		m.overrides[workspaceID] = make(map[string]bool)
+	}
+	m.overrides[workspaceID][name] = enabled
+	return m.saveStateLocked()
+}
+
+// Run 워크스페이스에서 활성화된 플러그인의 훅을 등록 순서대로 실행합니다
+//
+// 플러그인이 중단(abort)을 요청하거나 실패 정책이 fail인 플러그인이 실패하면 에러를 반환합니다.
+// pre_task 훅은 첫 에러에서 멈추고, post_task 훅은 모든 플러그인을 실행한 뒤 첫 에러를 반환합니다.
+func (m *Manager) Run(ctx context.Context, req *HookRequest) ([]HookResult, error) {
+	m.mu.RLock()
+	var targets []*registration
+	for _, name := range m.order {
+		reg := m.plugins[name]
+		if reg.handles(req.Hook) && m.isEnabledLocked(req.WorkspaceID, name) {
+			targets = append(targets, reg)
+		}
+	}
+	m.mu.RUnlock()
+
+	var results []HookResult
+	var firstErr error
+	for _, reg := range targets {
+		result, err := m.call(ctx, reg, req)
+		results = append(results, result)
+		if err == nil {
+			continue
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if req.Hook == HookPreTask {
+			break
+		}
+	}
+	return results, firstErr
+}
+
+// call 플러그인 하나의 훅 실행
+func (m *Manager) call(ctx context.Context, reg *registration, req *HookRequest) (HookResult, error) {
+	name := reg.plugin.Name()
+	start := time.Now()
+	resp, err := reg.plugin.Call(ctx, req)
+	result := HookResult{
+		Plugin:   name,
+		Hook:     req.Hook,
+		Duration: time.Since(start),
+		Response: resp,
+	}
+
+	logger := m.logger.WithFields(logrus.Fields{
+		"plugin":  name,
+		"hook":    req.Hook,
+		"task_id": req.Task.ID,
+	})
+
+	if err != nil {
+		result.Error = err.Error()
+		if reg.options.FailurePolicy == FailureFail {
+			logger.WithError(err).Error("플러그인 훅 실행 실패")
+			return result, fmt.Errorf("플러그인 %s: %w", name, err)
+		}
+		logger.WithError(err).Warn("플러그인 훅 실행 실패 (무시)")
+		return result, nil
+	}
+
+	if resp.Abort {
+		logger.WithField("message", resp.Message).Info("플러그인 훅이 태스크를 중단했습니다")
+		return result, fmt.Errorf("플러그인 %s: %w: %s", name, ErrHookAborted, resp.Message)
+	}
+	return result, nil
+}
+
+// FormatResults 태스크 출력에 덧붙일 훅 결과 요약
+func FormatResults(results []HookResult) string {
+	var b strings.Builder
+	for _, r := range results {
+		fmt.Fprintf(&b, "\n--- plugin %s (%s) ---\n", r.Plugin, r.Hook)
+		switch {
+		case r.Error != "":
+			fmt.Fprintf(&b, "error: %s\n", r.Error)
+		case r.Response != nil:
+			if r.Response.Message != "" {
+				fmt.Fprintf(&b, "%s\n", r.Response.Message)
+			}
+			if r.Response.Output != "" {
+				b.WriteString(strings.TrimRight(r.Response.Output, "\n"))
+				b.WriteString("\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func (r *registration) info() Info {
+	return Info{
+		Name:          r.plugin.Name(),
+		Hooks:         r.plugin.Hooks(),
+		FailurePolicy: r.options.FailurePolicy,
+		Workspaces:    r.options.Workspaces,
+	}
+}
+
+func (r *registration) handles(hook HookPoint) bool {
+	for _, h := range r.plugin.Hooks() {
+		if h == hook {
+			return true
+		}
+	}
+	return false
+}
+
+// isEnabledLocked 워크스페이스 설정이 있으면 우선하고, 없으면 기본 활성화 목록을 따름
+func (m *Manager) isEnabledLocked(workspaceID, name string) bool {
+	reg, exists := m.plugins[name]
+	if !exists {
+		return false
+	}
+	if enabled, ok := m.overrides[workspaceID][name]; ok {
+		return enabled
+	}
+	for _, ws := range reg.options.Workspaces {
+		if ws == AllWorkspaces || ws == workspaceID {
+			return true
+		}
+	}
+	return false
+}
+
+// loadState 저장된 워크스페이스별 활성화 상태 로드
+func (m *Manager) loadState() error {
+	if m.statePath == "" {
+		return nil
+	}
+	data, err := os.ReadFile(m.statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("플러그인 상태 로드 실패: %w", err)
+	}
+	if err := json.Unmarshal(data, &m.overrides); err != nil {
+		return fmt.Errorf("플러그인 상태 파싱 실패: %w", err)
+	}
+	if m.overrides == nil {
+		m.overrides = make(map[string]map[string]bool)
+	}
+	return nil
+}
+
+// saveStateLocked 워크스페이스별 활성화 상태 저장
+func (m *Manager) saveStateLocked() error {
+	if m.statePath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(m.overrides, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(m.statePath), 0755); err != nil {
+		return err
+	}
+	tmp := m.statePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.statePath)
+}
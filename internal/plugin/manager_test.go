@@ -0,0 +1,118 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePlugin 테스트용 플러그인
+type fakePlugin struct {
+	name  string
+	hooks []HookPoint
+	resp  *HookResponse
+	err   error
+	calls int
+}
+
+func (p *fakePlugin) Name() string       { return p.name }
+func (p *fakePlugin) Hooks() []HookPoint { return p.hooks }
+
+func (p *fakePlugin) Call(ctx context.Context, req *HookRequest) (*HookResponse, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.resp == nil {
+		return &HookResponse{}, nil
+	}
+	return p.resp, nil
+}
+
+func bothHooks() []HookPoint {
+	return []HookPoint{HookPreTask, HookPostTask}
+}
+
+func TestManager_WorkspaceEnablement(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "plugins", "state.json")
+	m, err := NewManager(statePath, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Register(&fakePlugin{name: "lint", hooks: bothHooks()}, Options{Workspaces: []string{AllWorkspaces}}))
+	require.NoError(t, m.Register(&fakePlugin{name: "notify", hooks: bothHooks()}, Options{Workspaces: []string{"ws-1"}}))
+	assert.Error(t, m.Register(&fakePlugin{name: "lint"}, Options{}))
+
+	assert.True(t, m.IsEnabled("ws-1", "lint"))
+	assert.True(t, m.IsEnabled("ws-1", "notify"))
+	assert.True(t, m.IsEnabled("ws-2", "lint"))
+	assert.False(t, m.IsEnabled("ws-2", "notify"))
+
+	require.NoError(t, m.SetEnabled("ws-2", "lint", false))
+	require.NoError(t, m.SetEnabled("ws-2", "notify", true))
+	assert.ErrorIs(t, m.SetEnabled("ws-2", "unknown", true), ErrPluginNotFound)
+
+	// 저장된 상태를 새 매니저가 불러옴
+	reloaded, err := NewManager(statePath, nil)
+	require.NoError(t, err)
+	require.NoError(t, reloaded.Register(&fakePlugin{name: "lint", hooks: bothHooks()}, Options{Workspaces: []string{AllWorkspaces}}))
+	require.NoError(t, reloaded.Register(&fakePlugin{name: "notify", hooks: bothHooks()}, Options{}))
+
+	statuses := reloaded.WorkspacePlugins("ws-2")
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "lint", statuses[0].Name)
+	assert.False(t, statuses[0].Enabled)
+	assert.Equal(t, "notify", statuses[1].Name)
+	assert.True(t, statuses[1].Enabled)
+}
+
+func TestManager_RunPreTaskAbort(t *testing.T) {
+	m, err := NewManager("", nil)
+	require.NoError(t, err)
+
+	lint := &fakePlugin{name: "lint", hooks: bothHooks(), resp: &HookResponse{Abort: true, Message: "3 issues"}}
+	tests := &fakePlugin{name: "tests", hooks: bothHooks()}
+	post := &fakePlugin{name: "post", hooks: []HookPoint{HookPostTask}}
+	for _, p := range []*fakePlugin{lint, tests, post} {
+		require.NoError(t, m.Register(p, Options{Workspaces: []string{AllWorkspaces}}))
+	}
+
+	results, err := m.Run(context.Background(), &HookRequest{Hook: HookPreTask, WorkspaceID: "ws-1"})
+	require.ErrorIs(t, err, ErrHookAborted)
+	assert.Contains(t, err.Error(), "3 issues")
+	assert.Len(t, results, 1)
+	assert.Equal(t, 0, tests.calls, "중단 이후 플러그인은 실행되지 않아야 함")
+	assert.Equal(t, 0, post.calls)
+
+	// post_task는 중단 요청이 있어도 모든 플러그인을 실행
+	results, err = m.Run(context.Background(), &HookRequest{Hook: HookPostTask, WorkspaceID: "ws-1"})
+	require.ErrorIs(t, err, ErrHookAborted)
+	assert.Len(t, results, 3)
+	assert.Equal(t, 1, post.calls)
+}
+
+func TestManager_RunFailurePolicy(t *testing.T) {
+	m, err := NewManager("", nil)
+	require.NoError(t, err)
+
+	flaky := &fakePlugin{name: "flaky", hooks: bothHooks(), err: errors.New("timeout")}
+	require.NoError(t, m.Register(flaky, Options{Workspaces: []string{AllWorkspaces}}))
+
+	results, err := m.Run(context.Background(), &HookRequest{Hook: HookPreTask, WorkspaceID: "ws-1"})
+	require.NoError(t, err, "ignore 정책은 실패를 무시해야 함")
+	require.Len(t, results, 1)
+	assert.Equal(t, "timeout", results[0].Error)
+	assert.Contains(t, FormatResults(results), "--- plugin flaky (pre_task) ---\nerror: timeout")
+
+	strict := &fakePlugin{name: "strict", hooks: bothHooks(), err: errors.New("crashed")}
+	require.NoError(t, m.Register(strict, Options{FailurePolicy: FailureFail, Workspaces: []string{AllWorkspaces}}))
+
+	_, err = m.Run(context.Background(), &HookRequest{Hook: HookPreTask, WorkspaceID: "ws-1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "strict")
+
+	assert.Error(t, m.Register(&fakePlugin{name: "bad"}, Options{FailurePolicy: "retry"}))
+}
@@ -0,0 +1,92 @@
+// Package plugin은 Claude 태스크 실행 전후에 운영자가 등록한 훅을 실행하는 플러그인 프레임워크를 제공합니다.
+//
+// 플러그인은 별도의 실행 파일로, 훅이 호출될 때마다 샌드박스된 하위 프로세스로 실행되며
+// 표준 입출력으로 JSON-RPC 2.0 요청 하나를 받고 응답 하나를 돌려줍니다.
+//
+//	→ {"jsonrpc":"2.0","id":1,"method":"pre_task","params":{...HookRequest}}
+//	← {"jsonrpc":"2.0","id":1,"result":{"abort":false,"message":"lint ok"}}
+package plugin
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// HookPoint 훅 실행 시점
+type HookPoint string
+
+const (
+	// HookPreTask 태스크 실행 전
+	HookPreTask HookPoint = "pre_task"
+	// HookPostTask 태스크 실행 후
+	HookPostTask HookPoint = "post_task"
+)
+
+// FailurePolicy 플러그인 실행 실패(타임아웃, 비정상 종료 등) 시 처리 방식
+type FailurePolicy string
+
+const (
+	// FailureIgnore 실패를 기록하고 태스크를 계속 진행
+	FailureIgnore FailurePolicy = "ignore"
+	// FailureFail 실패 시 태스크를 실패 처리
+	FailureFail FailurePolicy = "fail"
+)
+
+var (
+	// ErrPluginNotFound 등록되지 않은 플러그인
+	ErrPluginNotFound = errors.New("플러그인을 찾을 수 없습니다")
+	// ErrHookAborted 훅이 태스크 중단을 요청함
+	ErrHookAborted = errors.New("플러그인 훅이 태스크를 중단했습니다")
+)
+
+// TaskInfo 훅에 전달되는 태스크 정보
+type TaskInfo struct {
+	ID        string `json:"id"`
+	SessionID string `json:"session_id"`
+	Command   string `json:"command"`
+	Output    string `json:"output,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// HookRequest 훅 호출 요청
+type HookRequest struct {
+	Hook        HookPoint `json:"hook"`
+	WorkspaceID string    `json:"workspace_id"`
+	ProjectID   string    `json:"project_id,omitempty"`
+	ProjectPath string    `json:"project_path,omitempty"`
+	Task        TaskInfo  `json:"task"`
+}
+
+// HookResponse 플러그인 응답
+type HookResponse struct {
+	// Abort true이면 태스크를 실패 처리합니다 (pre_task에서는 실행하지 않음)
+	Abort bool `json:"abort"`
+	// Message 사람이 읽을 수 있는 요약
+	Message string `json:"message,omitempty"`
+	// Output 태스크 출력에 덧붙일 내용
+	Output string `json:"output,omitempty"`
+	// Metadata 추가 정보
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// HookResult 플러그인 하나의 훅 실행 결과
+type HookResult struct {
+	Plugin   string        `json:"plugin"`
+	Hook     HookPoint     `json:"hook"`
+	Duration time.Duration `json:"duration"`
+	Response *HookResponse `json:"response,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// Plugin 태스크 훅 플러그인
+type Plugin interface {
+	// Name 플러그인 이름
+	Name() string
+
+	// Hooks 플러그인이 처리하는 훅 목록
+	Hooks() []HookPoint
+
+	// Call 훅 실행
+	Call(ctx context.Context, req *HookRequest) (*HookResponse, error)
+}
@@ -0,0 +1,221 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultTimeout 기본 훅 실행 제한 시간
+	DefaultTimeout = 30 * time.Second
+	// DefaultMaxOutputSize 플러그인 표준 출력 최대 크기
+	DefaultMaxOutputSize = 1 << 20
+	// maxStderrSize 오류 메시지로 보관할 표준 에러 최대 크기
+	maxStderrSize = 64 << 10
+	// defaultSandboxPath 샌드박스 기본 PATH
+	defaultSandboxPath = "/usr/local/bin:/usr/bin:/bin"
+)
+
+// ProcessConfig 하위 프로세스 플러그인 설정
+type ProcessConfig struct {
+	Name    string
+	Command string
+	Args    []string
+	// Env 플러그인에 전달할 환경 변수 (서버 환경 변수는 상속되지 않음)
+	Env   map[string]string
+	Hooks []HookPoint
+
+	Timeout       time.Duration
+	MaxOutputSize int64
+}
+
+// ProcessPlugin 훅 호출마다 하위 프로세스를 실행하고 표준 입출력으로 JSON-RPC 통신하는 플러그인
+//
+// 샌드박스:
+//   - 서버 환경 변수를 상속하지 않고 최소한의 환경만 전달
+//   - 별도 프로세스 그룹으로 실행하여 타임아웃 시 자식 프로세스까지 종료
+//   - 표준 출력/에러 크기 제한
+type ProcessPlugin struct {
+	config ProcessConfig
+	nextID int64
+}
+
+// NewProcessPlugin 하위 프로세스 플러그인 생성
+func NewProcessPlugin(cfg ProcessConfig) (*ProcessPlugin, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("플러그인 이름이 필요합니다")
+	}
+	if cfg.Command == "" {
+		return nil, fmt.Errorf("플러그인 %s: 실행 명령이 필요합니다", cfg.Name)
+	}
+	if _, err := exec.LookPath(cfg.Command); err != nil {
+		return nil, fmt.Errorf("플러그인 %s: 실행 파일을 찾을 수 없습니다: %w", cfg.Name, err)
+	}
+	if len(cfg.Hooks) == 0 {
+		cfg.Hooks = []HookPoint{HookPreTask, HookPostTask}
+	}
+	for _, hook := range cfg.Hooks {
+		if hook != HookPreTask && hook != HookPostTask {
+			return nil, fmt.Errorf("플러그인 %s: 알 수 없는 훅: %s", cfg.Name, hook)
+		}
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.MaxOutputSize <= 0 {
+		cfg.MaxOutputSize = DefaultMaxOutputSize
+	}
+	return &ProcessPlugin{config: cfg}, nil
+}
+
+// Name 플러그인 이름
+func (p *ProcessPlugin) Name() string {
+	return p.config.Name
+}
+
+// Hooks 플러그인이 처리하는 훅 목록
+func (p *ProcessPlugin) Hooks() []HookPoint {
+	return p.config.Hooks
+}
+
+// Timeout 훅 실행 제한 시간
+func (p *ProcessPlugin) Timeout() time.Duration {
+	return p.config.Timeout
+}
+
+// rpcRequest JSON-RPC 2.0 요청
+type rpcRequest struct {
+	JSONRPC string       `json:"jsonrpc"`
+	ID      int64        `json:"id"`
+	Method  string       `json:"method"`
+	Params  *HookRequest `json:"params"`
+}
+
+// rpcResponse JSON-RPC 2.0 응답
+type rpcResponse struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int64         `json:"id"`
+	Result  *HookResponse `json:"result"`
+	Error   *rpcError     `json:"error"`
+}
+
+// rpcError JSON-RPC 2.0 오류
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Call 플러그인 프로세스를 실행하여 훅을 호출합니다
+func (p *ProcessPlugin) Call(ctx context.Context, req *HookRequest) (*HookResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout)
+	defer cancel()
+
+	id := atomic.AddInt64(&p.nextID, 1)
+	payload, err := json.Marshal(rpcRequest{JSONRPC: "2.0", ID: id, Method: string(req.Hook), Params: req})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(p.config.Command, p.config.Args...)
+	cmd.Dir = os.TempDir()
+	if req.ProjectPath != "" {
+		if info, err := os.Stat(req.ProjectPath); err == nil && info.IsDir() {
+			cmd.Dir = req.ProjectPath
+		}
+	}
+	cmd.Env = p.environ(req)
+	cmd.Stdin = bytes.NewReader(append(payload, '\n'))
+	stdout := &limitedBuffer{max: p.config.MaxOutputSize}
+	stderr := &limitedBuffer{max: maxStderrSize}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	// 자식 프로세스가 파이프를 잡고 있어도 Wait가 끝나도록 함
+	cmd.WaitDelay = time.Second
+	configureSandbox(cmd)
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("플러그인 실행 실패: %w", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		killProcessTree(cmd)
+		<-done
+		return nil, fmt.Errorf("플러그인 실행 시간 초과 (%s): %w", p.config.Timeout, ctx.Err())
+	}
+
+	if stdout.exceeded {
+		return nil, fmt.Errorf("플러그인 출력이 %d 바이트를 초과했습니다", p.config.MaxOutputSize)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("플러그인 비정상 종료: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var resp rpcResponse
+	if err := json.NewDecoder(bytes.NewReader(stdout.Bytes())).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("플러그인 응답 파싱 실패: %w", err)
+	}
+	if resp.ID != id {
+		return nil, fmt.Errorf("플러그인 응답 ID 불일치: %d != %d", resp.ID, id)
+	}
+	if resp.Error != nil {
+		return nil, fmt.Errorf("플러그인 오류 (%d): %s", resp.Error.Code, resp.Error.Message)
+	}
+	if resp.Result == nil {
+		return &HookResponse{}, nil
+	}
+	return resp.Result, nil
+}
+
+// environ 샌드박스 환경 변수
+func (p *ProcessPlugin) environ(req *HookRequest) []string {
+	env := map[string]string{
+		"PATH":               defaultSandboxPath,
+		"HOME":               os.TempDir(),
+		"LANG":               "C.UTF-8",
+		"AICLI_PLUGIN_NAME":  p.config.Name,
+		"AICLI_HOOK":         string(req.Hook),
+		"AICLI_WORKSPACE_ID": req.WorkspaceID,
+		"AICLI_TASK_ID":      req.Task.ID,
+	}
+	for k, v := range p.config.Env {
+		env[k] = v
+	}
+
+	result := make([]string, 0, len(env))
+	for k, v := range env {
+		result = append(result, k+"="+v)
+	}
+	return result
+}
+
+// limitedBuffer 최대 크기를 넘는 쓰기는 버리는 버퍼
+type limitedBuffer struct {
+	bytes.Buffer
+	max      int64
+	exceeded bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	remaining := b.max - int64(b.Len())
+	if int64(len(p)) > remaining {
+		b.exceeded = true
+		if remaining > 0 {
+			b.Buffer.Write(p[:remaining])
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,135 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHelperProcess 플러그인 프로세스 역할을 하는 헬퍼 (직접 실행되지 않음)
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	defer os.Exit(0)
+
+	line, _ := bufio.NewReader(os.Stdin).ReadBytes('\n')
+	var req rpcRequest
+	if err := json.Unmarshal(line, &req); err != nil {
+		fmt.Fprintln(os.Stderr, "bad request:", err)
+		os.Exit(2)
+	}
+
+	result := map[string]interface{}{}
+	switch os.Getenv("HELPER_MODE") {
+	case "abort":
+		result["abort"] = true
+		result["message"] = "lint failed"
+	case "sleep":
+		time.Sleep(10 * time.Second)
+	case "crash":
+		fmt.Fprintln(os.Stderr, "boom")
+		os.Exit(3)
+	case "rpc-error":
+		fmt.Printf(`{"jsonrpc":"2.0","id":%d,"error":{"code":-32601,"message":"method not found"}}`+"\n", req.ID)
+		return
+	case "env":
+		result["output"] = strings.Join([]string{
+			"secret=" + os.Getenv("AICLI_TEST_SECRET"),
+			"hook=" + os.Getenv("AICLI_HOOK"),
+			"task=" + os.Getenv("AICLI_TASK_ID"),
+		}, ",")
+	default:
+		result["message"] = "ok " + req.Method + " " + req.Params.Task.Command
+	}
+
+	out, _ := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	fmt.Println(string(out))
+}
+
+func newHelperPlugin(t *testing.T, mode string, timeout time.Duration) *ProcessPlugin {
+	t.Helper()
+	p, err := NewProcessPlugin(ProcessConfig{
+		Name:    "helper-" + mode,
+		Command: os.Args[0],
+		Args:    []string{"-test.run=^TestHelperProcess$"},
+		Env: map[string]string{
+			"GO_WANT_HELPER_PROCESS": "1",
+			"HELPER_MODE":            mode,
+		},
+		Timeout: timeout,
+	})
+	require.NoError(t, err)
+	return p
+}
+
+func testRequest() *HookRequest {
+	return &HookRequest{
+		Hook:        HookPreTask,
+		WorkspaceID: "ws-1",
+		Task:        TaskInfo{ID: "task-1", Command: "go test ./..."},
+	}
+}
+
+func TestProcessPlugin_Call(t *testing.T) {
+	p := newHelperPlugin(t, "ok", 10*time.Second)
+
+	resp, err := p.Call(context.Background(), testRequest())
+	require.NoError(t, err)
+	assert.False(t, resp.Abort)
+	assert.Equal(t, "ok pre_task go test ./...", resp.Message)
+}
+
+func TestProcessPlugin_Abort(t *testing.T) {
+	p := newHelperPlugin(t, "abort", 10*time.Second)
+
+	resp, err := p.Call(context.Background(), testRequest())
+	require.NoError(t, err)
+	assert.True(t, resp.Abort)
+	assert.Equal(t, "lint failed", resp.Message)
+}
+
+func TestProcessPlugin_Timeout(t *testing.T) {
+	p := newHelperPlugin(t, "sleep", 500*time.Millisecond)
+
+	start := time.Now()
+	_, err := p.Call(context.Background(), testRequest())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestProcessPlugin_Failures(t *testing.T) {
+	_, err := newHelperPlugin(t, "crash", 10*time.Second).Call(context.Background(), testRequest())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+
+	_, err = newHelperPlugin(t, "rpc-error", 10*time.Second).Call(context.Background(), testRequest())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "method not found")
+}
+
+func TestProcessPlugin_SandboxEnv(t *testing.T) {
+	t.Setenv("AICLI_TEST_SECRET", "leaked")
+	p := newHelperPlugin(t, "env", 10*time.Second)
+
+	resp, err := p.Call(context.Background(), testRequest())
+	require.NoError(t, err)
+	assert.Equal(t, "secret=,hook=pre_task,task=task-1", resp.Output)
+}
+
+func TestNewProcessPlugin_Validation(t *testing.T) {
+	_, err := NewProcessPlugin(ProcessConfig{Name: "missing", Command: "/nonexistent/plugin"})
+	assert.Error(t, err)
+
+	_, err = NewProcessPlugin(ProcessConfig{Name: "bad-hook", Command: os.Args[0], Hooks: []HookPoint{"on_push"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,18 @@
+//go:build windows
+
+package plugin
+
+import (
+	"os/exec"
+)
+
+// configureSandbox Windows에서는 프로세스 그룹 분리를 지원하지 않음
+func configureSandbox(cmd *exec.Cmd) {}
+
+// killProcessTree 플러그인 프로세스 종료
+func killProcessTree(cmd *exec.Cmd) {
+	if cmd.Process == nil {
+		return
+	}
+	_ = cmd.Process.Kill()
+}
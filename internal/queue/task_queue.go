@@ -2,6 +2,7 @@ package queue
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
@@ -26,15 +27,22 @@ type TaskQueue struct {
 	
 	// 태스크 관리
 	tasks    map[string]*models.Task
+	cancels  map[string]context.CancelFunc // 실행 중인 태스크의 취소 함수
 	tasksMux sync.RWMutex
 	
 	// 워커 관리
-	workerWg sync.WaitGroup
-	running  bool
-	runMux   sync.RWMutex
+	workerWg    sync.WaitGroup
+	workerQuits []chan struct{} // 워커별 종료 채널 (Resize로 줄일 때 사용)
+	nextWorker  int
+	runCtx      context.Context
+	running     bool
+	runMux      sync.RWMutex
 	
 	// 실행기
-	executor TaskExecutor
+	executor   TaskExecutor
+	timeoutFor func(task *models.Task) time.Duration
+	onFinish   func(task *models.Task)
+	onRequeue  func(task *models.Task)
 }
 
 // TaskResult 태스크 실행 결과
@@ -49,8 +57,27 @@ type TaskQueueConfig struct {
 	MaxWorkers   int           // 최대 워커 수
 	MaxQueueSize int           // 최대 큐 크기
 	Executor     TaskExecutor  // 태스크 실행기
+	// TimeoutFor 태스크별 실행 시간 제한 (nil이거나 0 이하를 반환하면 DefaultTaskTimeout)
+	TimeoutFor func(task *models.Task) time.Duration
+	// OnFinish 태스크가 완료, 실패, 취소 상태가 된 뒤 워커에서 호출 (nil이면 호출하지 않음)
+	OnFinish func(task *models.Task)
+	// OnRequeue 실행기가 RequeueError를 반환해 태스크가 대기 상태로 돌아갔을 때 호출 (nil이면 호출하지 않음)
+	OnRequeue func(task *models.Task)
 }
 
+// RequeueError 실행기가 태스크를 지금 실행하지 않고 After 뒤에 다시 큐에 넣도록 요청할 때 반환하는 에러
+type RequeueError struct {
+	After  time.Duration
+	Reason string
+}
+
+func (e *RequeueError) Error() string {
+	return fmt.Sprintf("태스크 재대기 (%s 후): %s", e.After, e.Reason)
+}
+
+// DefaultTaskTimeout 기본 태스크 실행 시간 제한
+const DefaultTaskTimeout = 5 * time.Minute
+
 // NewTaskQueue 새 태스크 큐 생성
 func NewTaskQueue(config *TaskQueueConfig) *TaskQueue {
 	if config == nil {
@@ -75,7 +102,11 @@ func NewTaskQueue(config *TaskQueueConfig) *TaskQueue {
 		resultChan:   make(chan *TaskResult, config.MaxQueueSize),
 		stopChan:     make(chan struct{}),
 		tasks:        make(map[string]*models.Task),
+		cancels:      make(map[string]context.CancelFunc),
 		executor:     config.Executor,
+		timeoutFor:   config.TimeoutFor,
+		onFinish:     config.OnFinish,
+		onRequeue:    config.OnRequeue,
 	}
 	
 	return tq
@@ -91,11 +122,11 @@ func (tq *TaskQueue) Start(ctx context.Context) error {
 	}
 	
 	tq.running = true
+	tq.runCtx = ctx
 	
 	// 워커 고루틴 시작
 	for i := 0; i < tq.maxWorkers; i++ {
-		tq.workerWg.Add(1)
-		go tq.worker(ctx, i)
+		tq.startWorker()
 	}
 	
 	// 결과 처리 고루틴 시작
@@ -115,6 +146,7 @@ func (tq *TaskQueue) Stop() {
 	}
 	
 	tq.running = false
+	tq.workerQuits = nil
 	close(tq.stopChan)
 	
 	// 모든 워커가 종료될 때까지 대기
@@ -123,7 +155,46 @@ func (tq *TaskQueue) Stop() {
 	log.Println("태스크 큐 중지됨")
 }
 
+// Resize 실행 중에 워커 수를 변경합니다
+// 워커를 줄이면 남는 워커는 처리 중인 태스크를 마친 뒤 종료됩니다.
+func (tq *TaskQueue) Resize(workers int) error {
+	if workers <= 0 {
+		return fmt.Errorf("워커 수는 1 이상이어야 합니다: %d", workers)
+	}
+	
+	tq.runMux.Lock()
+	defer tq.runMux.Unlock()
+	
+	previous := tq.maxWorkers
+	tq.maxWorkers = workers
+	if !tq.running || workers == previous {
+		return nil
+	}
+	
+	for len(tq.workerQuits) < workers {
+		tq.startWorker()
+	}
+	for len(tq.workerQuits) > workers {
+		last := len(tq.workerQuits) - 1
+		close(tq.workerQuits[last])
+		tq.workerQuits = tq.workerQuits[:last]
+	}
+	
+	log.Printf("태스크 큐 워커 수 변경됨: %d -> %d", previous, workers)
+	return nil
+}
+
+// startWorker 워커 하나를 시작합니다 (runMux를 잡은 상태에서 호출)
+func (tq *TaskQueue) startWorker() {
+	quit := make(chan struct{})
+	tq.workerQuits = append(tq.workerQuits, quit)
+	tq.workerWg.Add(1)
+	go tq.worker(tq.runCtx, tq.nextWorker, quit)
+	tq.nextWorker++
+}
+
 // Submit 태스크 제출
+// 큐는 제출된 태스크의 복사본을 보관하므로 호출자가 가진 태스크는 워커가 바꾸지 않습니다.
 func (tq *TaskQueue) Submit(task *models.Task) error {
 	tq.runMux.RLock()
 	defer tq.runMux.RUnlock()
@@ -134,6 +205,8 @@ func (tq *TaskQueue) Submit(task *models.Task) error {
 	
 	// 태스크 등록
 	tq.tasksMux.Lock()
+	owned := *task
+	task = &owned
 	tq.tasks[task.ID] = task
 	tq.tasksMux.Unlock()
 	
@@ -151,7 +224,7 @@ func (tq *TaskQueue) Submit(task *models.Task) error {
 	}
 }
 
-// Cancel 태스크 취소
+// Cancel 태스크 취소 (실행 중이면 실행 컨텍스트를 취소하여 프로세스에 종료 신호를 보냄)
 func (tq *TaskQueue) Cancel(taskID string) error {
 	tq.tasksMux.Lock()
 	defer tq.tasksMux.Unlock()
@@ -166,17 +239,33 @@ func (tq *TaskQueue) Cancel(taskID string) error {
 	}
 	
 	task.SetCancelled()
+	if cancel, running := tq.cancels[taskID]; running {
+		cancel()
+	}
 	log.Printf("태스크 취소됨: %s", taskID)
 	return nil
 }
 
-// GetTask 태스크 조회
+// GetTask 태스크 조회 (워커가 상태를 바꾸는 동안에도 안전하도록 잠금 안에서 만든 복사본 반환)
 func (tq *TaskQueue) GetTask(taskID string) (*models.Task, bool) {
 	tq.tasksMux.RLock()
 	defer tq.tasksMux.RUnlock()
 	
 	task, exists := tq.tasks[taskID]
-	return task, exists
+	if !exists {
+		return nil, false
+	}
+	copied := *task
+	return &copied, true
+}
+
+// IsExecuting 워커가 태스크를 실행하고 있는지 확인 (취소 후 프로세스가 끝났는지 확인할 때 사용)
+func (tq *TaskQueue) IsExecuting(taskID string) bool {
+	tq.tasksMux.RLock()
+	defer tq.tasksMux.RUnlock()
+	
+	_, executing := tq.cancels[taskID]
+	return executing
 }
 
 // GetActiveTasks 활성 태스크 목록 조회
@@ -187,7 +276,8 @@ func (tq *TaskQueue) GetActiveTasks() []*models.Task {
 	var activeTasks []*models.Task
 	for _, task := range tq.tasks {
 		if task.IsActive() {
-			activeTasks = append(activeTasks, task)
+			copied := *task
+			activeTasks = append(activeTasks, &copied)
 		}
 	}
 	
@@ -201,15 +291,19 @@ func (tq *TaskQueue) GetQueueSize() int {
 
 // GetStats 큐 통계 조회
 func (tq *TaskQueue) GetStats() map[string]interface{} {
+	tq.runMux.RLock()
+	maxWorkers, running := tq.maxWorkers, tq.running
+	tq.runMux.RUnlock()
+	
 	tq.tasksMux.RLock()
 	defer tq.tasksMux.RUnlock()
 	
 	stats := map[string]interface{}{
 		"total_tasks":    len(tq.tasks),
 		"queue_size":     len(tq.taskChan),
-		"max_workers":    tq.maxWorkers,
+		"max_workers":    maxWorkers,
 		"max_queue_size": tq.maxQueueSize,
-		"running":        tq.running,
+		"running":        running,
 	}
 	
 	// 상태별 카운트
@@ -223,7 +317,7 @@ func (tq *TaskQueue) GetStats() map[string]interface{} {
 }
 
 // worker 워커 고루틴
-func (tq *TaskQueue) worker(ctx context.Context, workerID int) {
+func (tq *TaskQueue) worker(ctx context.Context, workerID int, quit <-chan struct{}) {
 	defer tq.workerWg.Done()
 	
 	log.Printf("워커 %d 시작됨", workerID)
@@ -233,6 +327,8 @@ func (tq *TaskQueue) worker(ctx context.Context, workerID int) {
 		select {
 		case task := <-tq.taskChan:
 			tq.processTask(ctx, task, workerID)
+		case <-quit:
+			return
 		case <-tq.stopChan:
 			return
 		case <-ctx.Done():
@@ -242,37 +338,77 @@ func (tq *TaskQueue) worker(ctx context.Context, workerID int) {
 }
 
 // processTask 태스크 처리
+// 큐가 보관한 태스크는 Cancel과 같은 tasksMux 잠금 안에서만 읽고 바꾸며,
+// 실행기에는 잠금 없이 바꿀 수 있는 복사본을 넘긴 뒤 실행이 끝나면 잠금 안에서 반영합니다.
 func (tq *TaskQueue) processTask(ctx context.Context, task *models.Task, workerID int) {
 	log.Printf("워커 %d: 태스크 %s 처리 시작", workerID, task.ID)
 	
-	// 취소 상태 확인
-	if task.Status == models.TaskCancelled {
+	// 취소 상태 확인 후 실행 시작
+	tq.tasksMux.Lock()
+	cancelled := task.Status == models.TaskCancelled
+	if !cancelled {
+		task.SetRunning()
+	}
+	run := *task
+	tq.tasksMux.Unlock()
+	if cancelled {
 		log.Printf("워커 %d: 태스크 %s 이미 취소됨", workerID, task.ID)
+		tq.finish(task)
 		return
 	}
 	
-	// 태스크 실행 시작
-	task.SetRunning()
-	
 	// 실행기가 없으면 기본 처리
 	if tq.executor == nil {
 		time.Sleep(100 * time.Millisecond) // 기본 대기
-		task.SetCompleted("기본 실행 완료")
+		tq.tasksMux.Lock()
+		if task.Status != models.TaskCancelled {
+			task.SetCompleted("기본 실행 완료")
+		}
+		tq.tasksMux.Unlock()
 		
 		tq.resultChan <- &TaskResult{
 			TaskID: task.ID,
 			Output: "기본 실행 완료",
 			Error:  nil,
 		}
+		tq.finish(task)
 		return
 	}
 	
-	// 컨텍스트 타임아웃 설정
-	taskCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
-	defer cancel()
+	// 컨텍스트 타임아웃 설정 (취소 요청 시 Cancel에서 호출)
+	timeout := tq.timeout(task)
+	taskCtx, cancel := context.WithTimeout(ctx, timeout)
+	tq.tasksMux.Lock()
+	tq.cancels[task.ID] = cancel
+	if task.Status == models.TaskCancelled {
+		cancel()
+	}
+	tq.tasksMux.Unlock()
+	defer func() {
+		tq.tasksMux.Lock()
+		delete(tq.cancels, task.ID)
+		tq.tasksMux.Unlock()
+		cancel()
+	}()
 	
 	// 태스크 실행
-	output, err := tq.executor(taskCtx, task)
+	output, err := tq.executor(taskCtx, &run)
+	if err != nil && taskCtx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("태스크 실행 시간 제한(%s, %s)을 초과했습니다: %w", task.TimeoutTier.OrDefault(), timeout, err)
+	}
+	
+	// 실행기가 재대기를 요청하면 대기 상태로 돌려 나중에 다시 제출
+	var requeue *RequeueError
+	tq.tasksMux.Lock()
+	applyExecuted(task, &run)
+	cancelled = task.Status == models.TaskCancelled
+	if errors.As(err, &requeue) && !cancelled {
+		task.Status = models.TaskPending
+		task.StartedAt = nil
+		tq.tasksMux.Unlock()
+		tq.requeue(task, requeue, workerID)
+		return
+	}
 	
 	// 결과 처리
 	result := &TaskResult{
@@ -281,13 +417,25 @@ func (tq *TaskQueue) processTask(ctx context.Context, task *models.Task, workerI
 		Error:  err,
 	}
 	
-	if err != nil {
+	switch {
+	case cancelled:
+		task.Output = output
+	case err != nil:
 		task.SetFailed(err.Error())
-		log.Printf("워커 %d: 태스크 %s 실행 실패: %v", workerID, task.ID, err)
-	} else {
+	default:
 		task.SetCompleted(output)
+	}
+	tq.tasksMux.Unlock()
+	
+	switch {
+	case cancelled:
+		log.Printf("워커 %d: 태스크 %s 실행 중 취소됨", workerID, task.ID)
+	case err != nil:
+		log.Printf("워커 %d: 태스크 %s 실행 실패: %v", workerID, task.ID, err)
+	default:
 		log.Printf("워커 %d: 태스크 %s 실행 완료", workerID, task.ID)
 	}
+	tq.finish(task)
 	
 	// 결과 전송
 	select {
@@ -297,6 +445,67 @@ func (tq *TaskQueue) processTask(ctx context.Context, task *models.Task, workerI
 	}
 }
 
+// requeue 대기 상태로 돌린 태스크를 지정한 시간 뒤에 다시 제출
+// 그 사이 취소되면 종료 처리하고, 다시 제출하지 못하면 실패로 처리합니다.
+func (tq *TaskQueue) requeue(task *models.Task, requeue *RequeueError, workerID int) {
+	log.Printf("워커 %d: 태스크 %s 재대기 (%s 후 다시 제출): %s", workerID, task.ID, requeue.After, requeue.Reason)
+	if tq.onRequeue != nil {
+		tq.onRequeue(tq.snapshot(task))
+	}
+	
+	time.AfterFunc(requeue.After, func() {
+		tq.tasksMux.RLock()
+		cancelled := task.Status == models.TaskCancelled
+		tq.tasksMux.RUnlock()
+		if cancelled {
+			tq.finish(task)
+			return
+		}
+		if err := tq.Submit(task); err != nil {
+			// 실패 처리는 실행 상태에서만 가능하므로 실행 상태로 바꾼 뒤 실패로 기록
+			tq.tasksMux.Lock()
+			task.SetRunning()
+			task.SetFailed(fmt.Sprintf("재대기 후 큐 제출 실패: %v", err))
+			tq.tasksMux.Unlock()
+			tq.finish(task)
+		}
+	})
+}
+
+// finish 종료된 태스크를 OnFinish로 전달
+func (tq *TaskQueue) finish(task *models.Task) {
+	if tq.onFinish != nil {
+		tq.onFinish(tq.snapshot(task))
+	}
+}
+
+// snapshot 큐가 보관한 태스크를 잠금 안에서 복사 (콜백이 큐 밖에서 바꿔도 영향 없음)
+func (tq *TaskQueue) snapshot(task *models.Task) *models.Task {
+	tq.tasksMux.RLock()
+	defer tq.tasksMux.RUnlock()
+	
+	copied := *task
+	return &copied
+}
+
+// applyExecuted 실행기가 복사본에 기록한 내용(처리 모델, 저장 시각 등)을 큐의 태스크에 반영
+// 실행 중 Cancel이 바꿨을 수 있는 상태와 시각은 큐의 값을 유지합니다. tasksMux 잠금 안에서 호출해야 합니다.
+func applyExecuted(task, run *models.Task) {
+	status, startedAt, completedAt, duration := task.Status, task.StartedAt, task.CompletedAt, task.Duration
+	*task = *run
+	task.Status, task.StartedAt, task.CompletedAt, task.Duration = status, startedAt, completedAt, duration
+}
+
+// timeout 태스크 실행 시간 제한
+func (tq *TaskQueue) timeout(task *models.Task) time.Duration {
+	if tq.timeoutFor != nil {
+		if timeout := tq.timeoutFor(task); timeout > 0 {
+			return timeout
+		}
+	}
+	return DefaultTaskTimeout
+}
+
 // resultProcessor 결과 처리기
 func (tq *TaskQueue) resultProcessor(ctx context.Context) {
 	log.Println("결과 처리기 시작됨")
@@ -133,9 +133,26 @@ func (ml *MemoryLimiter) Remaining(key string) int {
 
 // Limit은 제한된 요청 수를 반환합니다.
 func (ml *MemoryLimiter) Limit(key string) int {
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+	
 	return ml.config.Rate
 }
 
+// SetConfig는 실행 중에 제한 설정을 변경합니다.
+// 이전 설정으로 만든 키별 limiter는 모두 버리고 다음 요청부터 새 설정을 적용합니다.
+func (ml *MemoryLimiter) SetConfig(config *LimiterConfig) {
+	if config == nil {
+		config = DefaultLimiterConfig()
+	}
+	
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+	
+	ml.config = config
+	ml.limiters = make(map[string]*rateLimiterEntry)
+}
+
 // ResetTime은 리셋 시간을 반환합니다.
 func (ml *MemoryLimiter) ResetTime(key string) time.Time {
 	ml.mu.RLock()
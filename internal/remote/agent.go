@@ -0,0 +1,342 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// 에이전트 기본값
+const (
+	DefaultReconnectMin = time.Second
+	DefaultReconnectMax = time.Minute
+	DefaultCancelGrace  = 5 * time.Second
+	outputChunkSize     = 4096
+)
+
+// AgentConfig 원격 에이전트 설정
+type AgentConfig struct {
+	// ServerURL 서버 에이전트 엔드포인트 (예: wss://aicli.example.com/ws/agents)
+	ServerURL string
+	// Token 에이전트 접속 토큰
+	Token string
+	// AgentID 에이전트 ID (비어 있으면 호스트 이름)
+	AgentID string
+	// Name 표시 이름
+	Name string
+	// Labels 능력 레이블
+	Labels map[string]string
+	// Capacity 동시에 실행할 수 있는 태스크 수
+	Capacity int
+	// WorkDir 워크스페이스별 작업 디렉토리의 루트 (<WorkDir>/<workspaceID>)
+	WorkDir string
+	// CancelGrace 취소 신호 후 강제 종료까지 대기 시간
+	CancelGrace time.Duration
+	// ReconnectMin, ReconnectMax 재접속 대기 시간 범위 (지수 백오프)
+	ReconnectMin time.Duration
+	ReconnectMax time.Duration
+	// Logger 로거 (nil이면 표준 로거)
+	Logger *log.Logger
+}
+
+// Agent 서버에 접속해 배정받은 태스크를 로컬에서 실행하는 원격 에이전트
+type Agent struct {
+	config AgentConfig
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc
+}
+
+// NewAgent 새 에이전트 생성
+func NewAgent(config AgentConfig) (*Agent, error) {
+	if config.ServerURL == "" {
+		return nil, errors.New("server url is required")
+	}
+	if config.AgentID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("agent id is required: %w", err)
+		}
+		config.AgentID = hostname
+	}
+	if config.Capacity <= 0 {
+		config.Capacity = 1
+	}
+	if config.CancelGrace <= 0 {
+		config.CancelGrace = DefaultCancelGrace
+	}
+	if config.ReconnectMin <= 0 {
+		config.ReconnectMin = DefaultReconnectMin
+	}
+	if config.ReconnectMax < config.ReconnectMin {
+		config.ReconnectMax = DefaultReconnectMax
+	}
+	if config.Logger == nil {
+		config.Logger = log.New(os.Stderr, "aicli-agent: ", log.LstdFlags)
+	}
+	return &Agent{config: config, running: make(map[string]context.CancelFunc)}, nil
+}
+
+// Run 컨텍스트가 끝날 때까지 서버에 접속해 태스크를 처리하고, 연결이 끊기면 다시 접속합니다.
+func (a *Agent) Run(ctx context.Context) error {
+	backoff := a.config.ReconnectMin
+	for {
+		connected, err := a.session(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if connected {
+			backoff = a.config.ReconnectMin
+		}
+		a.config.Logger.Printf("서버 연결 종료: %v (%s 후 재접속)", err, backoff)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > a.config.ReconnectMax {
+			backoff = a.config.ReconnectMax
+		}
+	}
+}
+
+// agentSession 서버 연결 하나 (쓰기는 직렬화)
+type agentSession struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+}
+
+func (s *agentSession) send(msg Envelope) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return s.conn.WriteJSON(msg)
+}
+
+// session 서버에 접속해 등록하고 연결이 끊어질 때까지 메시지를 처리합니다.
+// 연결이 끊어지면 실행 중인 태스크를 모두 취소합니다 (서버가 이미 실패로 처리함).
+func (a *Agent) session(ctx context.Context) (bool, error) {
+	header := http.Header{}
+	if a.config.Token != "" {
+		header.Set("Authorization", "Bearer "+a.config.Token)
+	}
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, a.config.ServerURL, header)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+	s := &agentSession{conn: conn}
+
+	if err := s.send(Envelope{Type: MessageRegister, Register: &Registration{
+		AgentID:  a.config.AgentID,
+		Name:     a.config.Name,
+		Labels:   a.config.Labels,
+		Capacity: a.config.Capacity,
+		Version:  ProtocolVersion,
+	}}); err != nil {
+		return false, err
+	}
+	conn.SetReadDeadline(time.Now().Add(registerTimeout))
+	var reply Envelope
+	if err := conn.ReadJSON(&reply); err != nil {
+		return false, err
+	}
+	if reply.Type != MessageRegistered || reply.Registered == nil {
+		return false, fmt.Errorf("unexpected reply to register: %s", reply.Type)
+	}
+	conn.SetReadDeadline(time.Time{})
+	a.config.Logger.Printf("에이전트 %s 등록 완료 (동시 실행 %d)", reply.Registered.AgentID, a.config.Capacity)
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	var tasks sync.WaitGroup
+	defer func() {
+		cancel()
+		conn.Close()
+		tasks.Wait()
+	}()
+
+	interval := time.Duration(reply.Registered.HeartbeatSeconds) * time.Second
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	go a.heartbeat(sessionCtx, s, interval)
+
+	// 컨텍스트가 끝나면 읽기를 멈추도록 연결을 닫음
+	go func() {
+		<-sessionCtx.Done()
+		conn.Close()
+	}()
+
+	for {
+		var msg Envelope
+		if err := conn.ReadJSON(&msg); err != nil {
+			return true, err
+		}
+		switch {
+		case msg.Type == MessageAssign && msg.Assign != nil:
+			tasks.Add(1)
+			go func(assignment Assignment) {
+				defer tasks.Done()
+				a.execute(sessionCtx, s, assignment)
+			}(*msg.Assign)
+		case msg.Type == MessageCancel && msg.Cancel != nil:
+			a.cancel(msg.Cancel.TaskID)
+		}
+	}
+}
+
+// heartbeat 주기적으로 실행 중인 태스크 목록을 보냅니다.
+func (a *Agent) heartbeat(ctx context.Context, s *agentSession, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.send(Envelope{Type: MessageHeartbeat, Heartbeat: &Heartbeat{Running: a.runningTasks()}}); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// execute 배정받은 태스크를 실행하고 출력과 결과를 전송합니다.
+func (a *Agent) execute(ctx context.Context, s *agentSession, assignment Assignment) {
+	result := Result{TaskID: assignment.TaskID}
+	defer func() {
+		s.send(Envelope{Type: MessageResult, Result: &result})
+	}()
+
+	if assignment.Command == "" {
+		result.ExitCode, result.Error = -1, "빈 명령어입니다"
+		return
+	}
+
+	var cancel context.CancelFunc
+	if assignment.TimeoutSeconds > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(assignment.TimeoutSeconds)*time.Second)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	if !a.track(assignment.TaskID, cancel) {
+		result.ExitCode, result.Error = -1, "에이전트의 동시 실행 한도를 초과했습니다"
+		return
+	}
+	defer a.untrack(assignment.TaskID)
+
+	cmd := exec.CommandContext(ctx, assignment.Command, assignment.Args...)
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
+	cmd.WaitDelay = a.config.CancelGrace
+	if len(assignment.Env) > 0 {
+		cmd.Env = os.Environ()
+		for key, value := range assignment.Env {
+			cmd.Env = append(cmd.Env, key+"="+value)
+		}
+	}
+
+	if a.config.WorkDir != "" {
+		dir := a.config.WorkDir
+		if assignment.WorkspaceID != "" && !strings.ContainsAny(assignment.WorkspaceID, `/\`) && !strings.HasPrefix(assignment.WorkspaceID, ".") {
+			dir = filepath.Join(dir, assignment.WorkspaceID)
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			result.ExitCode, result.Error = -1, err.Error()
+			return
+		}
+		cmd.Dir = dir
+	}
+
+	reader, writer := io.Pipe()
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+
+	if err := cmd.Start(); err != nil {
+		writer.Close()
+		result.ExitCode, result.Error = -1, err.Error()
+		return
+	}
+
+	streamed := make(chan struct{})
+	go func() {
+		defer close(streamed)
+		buf := make([]byte, outputChunkSize)
+		for {
+			n, err := reader.Read(buf)
+			if n > 0 {
+				s.send(Envelope{Type: MessageOutput, Output: &Output{TaskID: assignment.TaskID, Data: string(buf[:n])}})
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	err := cmd.Wait()
+	writer.Close()
+	<-streamed
+
+	if err != nil {
+		result.ExitCode = -1
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			result.ExitCode = exitErr.ExitCode()
+		}
+		result.Error = err.Error()
+		if ctx.Err() == context.DeadlineExceeded {
+			result.Error = "실행 시간 제한을 초과했습니다"
+		}
+	}
+}
+
+// track 실행 중인 태스크를 기록합니다 (동시 실행 한도를 넘으면 false)
+func (a *Agent) track(taskID string, cancel context.CancelFunc) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.running) >= a.config.Capacity {
+		return false
+	}
+	a.running[taskID] = cancel
+	return true
+}
+
+func (a *Agent) untrack(taskID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.running, taskID)
+}
+
+// cancel 실행 중인 태스크를 취소합니다.
+func (a *Agent) cancel(taskID string) {
+	a.mu.Lock()
+	cancel := a.running[taskID]
+	a.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func (a *Agent) runningTasks() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ids := make([]string, 0, len(a.running))
+	for id := range a.running {
+		ids = append(ids, id)
+	}
+	return ids
+}
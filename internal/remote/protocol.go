@@ -0,0 +1,99 @@
+// Package remote API 서버와 원격 실행 에이전트 사이의 태스크 분산 실행
+//
+// 에이전트(cmd/aicli-agent)는 API 서버의 WebSocket 엔드포인트에 접속해 등록하고,
+// 주기적으로 하트비트를 보내며, 배정받은 태스크를 로컬에서 실행해 출력과 결과를 돌려보냅니다.
+// 서버는 에이전트의 레이블과 워크스페이스별 어피니티 규칙으로 태스크를 배정할 에이전트를 고릅니다.
+package remote
+
+// ProtocolVersion 에이전트 프로토콜 버전
+const ProtocolVersion = 1
+
+// MessageType 메시지 종류
+type MessageType string
+
+const (
+	// MessageRegister 에이전트 → 서버: 접속 직후 등록
+	MessageRegister MessageType = "register"
+	// MessageRegistered 서버 → 에이전트: 등록 완료
+	MessageRegistered MessageType = "registered"
+	// MessageHeartbeat 에이전트 → 서버: 생존 신호
+	MessageHeartbeat MessageType = "heartbeat"
+	// MessageAssign 서버 → 에이전트: 태스크 배정
+	MessageAssign MessageType = "assign"
+	// MessageCancel 서버 → 에이전트: 태스크 취소
+	MessageCancel MessageType = "cancel"
+	// MessageOutput 에이전트 → 서버: 태스크 출력 일부
+	MessageOutput MessageType = "output"
+	// MessageResult 에이전트 → 서버: 태스크 종료
+	MessageResult MessageType = "result"
+)
+
+// Envelope 에이전트 연결에서 주고받는 메시지 (Type에 맞는 필드 하나만 설정)
+type Envelope struct {
+	Type       MessageType   `json:"type"`
+	Register   *Registration `json:"register,omitempty"`
+	Registered *Registered   `json:"registered,omitempty"`
+	Heartbeat  *Heartbeat    `json:"heartbeat,omitempty"`
+	Assign     *Assignment   `json:"assign,omitempty"`
+	Cancel     *Cancel       `json:"cancel,omitempty"`
+	Output     *Output       `json:"output,omitempty"`
+	Result     *Result       `json:"result,omitempty"`
+}
+
+// Registration 에이전트 등록 정보
+type Registration struct {
+	// AgentID 에이전트 ID (같은 ID로 다시 접속하면 이전 연결을 대체)
+	AgentID string `json:"agent_id"`
+	// Name 표시 이름
+	Name string `json:"name,omitempty"`
+	// Labels 능력 레이블 (예: gpu=true, region=ap-northeast-2)
+	Labels map[string]string `json:"labels,omitempty"`
+	// Capacity 동시에 실행할 수 있는 태스크 수
+	Capacity int `json:"capacity"`
+	// Version 에이전트 프로토콜 버전
+	Version int `json:"version"`
+}
+
+// Registered 등록 응답
+type Registered struct {
+	AgentID string `json:"agent_id"`
+	// HeartbeatSeconds 하트비트 주기 (초)
+	HeartbeatSeconds int `json:"heartbeat_seconds"`
+}
+
+// Heartbeat 생존 신호와 실행 중인 태스크 목록
+type Heartbeat struct {
+	Running []string `json:"running,omitempty"`
+}
+
+// Assignment 태스크 배정
+type Assignment struct {
+	TaskID      string   `json:"task_id"`
+	SessionID   string   `json:"session_id,omitempty"`
+	WorkspaceID string   `json:"workspace_id,omitempty"`
+	Command     string   `json:"command"`
+	Args        []string `json:"args,omitempty"`
+	// TimeoutSeconds 실행 시간 제한 (0이면 제한 없음)
+	TimeoutSeconds int `json:"timeout_seconds,omitempty"`
+	// Env 에이전트 환경에 더해 전달할 워크스페이스 환경 변수
+	Env map[string]string `json:"env,omitempty"`
+}
+
+// Cancel 태스크 취소 요청
+type Cancel struct {
+	TaskID string `json:"task_id"`
+}
+
+// Output 태스크 출력 일부 (표준 출력과 표준 에러를 합친 순서)
+type Output struct {
+	TaskID string `json:"task_id"`
+	Data   string `json:"data"`
+}
+
+// Result 태스크 종료 결과
+type Result struct {
+	TaskID   string `json:"task_id"`
+	ExitCode int    `json:"exit_code"`
+	// Error 실행하지 못했거나 비정상 종료된 경우의 사유
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,432 @@
+package remote
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// 레지스트리 기본값
+const (
+	DefaultHeartbeatInterval = 10 * time.Second
+	DefaultHeartbeatTimeout  = 30 * time.Second
+	DefaultAssignTimeout     = 30 * time.Second
+
+	registerTimeout = 10 * time.Second
+	writeTimeout    = 10 * time.Second
+	cancelGrace     = 10 * time.Second
+)
+
+// ErrNoAgentAvailable 배정 대기 시간 안에 조건에 맞는 에이전트가 없음
+var ErrNoAgentAvailable = errors.New("조건에 맞는 사용 가능한 원격 에이전트가 없습니다")
+
+// ErrAgentDisconnected 태스크 실행 중 에이전트 연결이 끊어짐
+var ErrAgentDisconnected = errors.New("원격 에이전트 연결이 끊어졌습니다")
+
+// Affinity 태스크를 배정할 에이전트 조건
+type Affinity struct {
+	// Required 에이전트 레이블이 모두 일치해야 배정
+	Required map[string]string `json:"required,omitempty"`
+	// Preferred 일치하는 레이블이 많은 에이전트를 우선
+	Preferred map[string]string `json:"preferred,omitempty"`
+}
+
+// AffinityRule 워크스페이스별 어피니티 규칙 (Workspace가 "*"면 규칙이 없는 워크스페이스에 적용)
+type AffinityRule struct {
+	Workspace string `json:"workspace"`
+	Affinity
+}
+
+// RegistryConfig 레지스트리 설정
+type RegistryConfig struct {
+	// Token 에이전트 접속 토큰 (Authorization: Bearer)
+	Token string
+	// HeartbeatInterval 에이전트 하트비트 주기
+	HeartbeatInterval time.Duration
+	// HeartbeatTimeout 이 시간 동안 메시지가 없으면 연결을 끊음
+	HeartbeatTimeout time.Duration
+	// AssignTimeout 사용 가능한 에이전트를 기다릴 최대 시간
+	AssignTimeout time.Duration
+	// Rules 워크스페이스별 어피니티 규칙
+	Rules []AffinityRule
+}
+
+// AgentInfo 접속 중인 에이전트 정보
+type AgentInfo struct {
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	Capacity      int               `json:"capacity"`
+	Running       []string          `json:"running"`
+	RemoteAddr    string            `json:"remote_addr"`
+	ConnectedAt   time.Time         `json:"connected_at"`
+	LastHeartbeat time.Time         `json:"last_heartbeat"`
+}
+
+// agentConn 접속 중인 에이전트 하나
+type agentConn struct {
+	info AgentInfo
+	conn *websocket.Conn
+
+	writeMu sync.Mutex
+	tasks   map[string]*pendingTask // mu(Registry)로 보호
+	closed  chan struct{}
+}
+
+// pendingTask 에이전트에 배정되어 결과를 기다리는 태스크
+type pendingTask struct {
+	onOutput func(string)
+	done     chan Result
+}
+
+// Registry 원격 에이전트 접속을 받고 태스크를 배정합니다.
+type Registry struct {
+	config   RegistryConfig
+	logger   *logrus.Logger
+	upgrader websocket.Upgrader
+
+	mu      sync.Mutex
+	agents  map[string]*agentConn
+	changed chan struct{} // 에이전트나 여유 슬롯이 바뀌면 닫고 새로 만듦
+}
+
+// NewRegistry 새 레지스트리 생성
+func NewRegistry(config RegistryConfig, logger *logrus.Logger) *Registry {
+	if config.HeartbeatInterval <= 0 {
+		config.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+	if config.HeartbeatTimeout <= config.HeartbeatInterval {
+		config.HeartbeatTimeout = 3 * config.HeartbeatInterval
+	}
+	if config.AssignTimeout <= 0 {
+		config.AssignTimeout = DefaultAssignTimeout
+	}
+	if logger == nil {
+		logger = logrus.New()
+	}
+	return &Registry{
+		config: config,
+		logger: logger,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  4096,
+			WriteBufferSize: 4096,
+			// 에이전트는 브라우저가 아니므로 Origin 대신 토큰으로 인증
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		agents:  make(map[string]*agentConn),
+		changed: make(chan struct{}),
+	}
+}
+
+// List 접속 중인 에이전트 목록 (ID 순)
+func (r *Registry) List() []AgentInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := make([]AgentInfo, 0, len(r.agents))
+	for _, agent := range r.agents {
+		list = append(list, agent.snapshot())
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// ServeHTTP 에이전트 WebSocket 접속을 처리합니다.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if r.config.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(r.config.Token)) != 1 {
+		http.Error(w, "invalid agent token", http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := r.upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+
+	agent, err := r.register(conn, req.RemoteAddr)
+	if err != nil {
+		r.logger.WithError(err).WithField("remote_addr", req.RemoteAddr).Warn("원격 에이전트 등록 실패")
+		conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error()), time.Now().Add(writeTimeout))
+		conn.Close()
+		return
+	}
+	r.serve(agent)
+}
+
+// Dispatch 어피니티에 맞는 에이전트에 태스크를 배정하고 결과를 기다립니다.
+// 출력은 도착하는 대로 onOutput으로 전달되며, 컨텍스트가 취소되면 에이전트에 취소를 요청합니다.
+func (r *Registry) Dispatch(ctx context.Context, assignment Assignment, onOutput func(string)) (*Result, string, error) {
+	affinity := r.AffinityFor(assignment.WorkspaceID)
+	agent, pending, err := r.reserve(ctx, assignment.TaskID, affinity, onOutput)
+	if err != nil {
+		return nil, "", err
+	}
+	defer r.release(agent, assignment.TaskID)
+
+	if err := agent.send(Envelope{Type: MessageAssign, Assign: &assignment}); err != nil {
+		return nil, agent.info.ID, fmt.Errorf("태스크 배정 전송 실패: %w", err)
+	}
+
+	select {
+	case result := <-pending.done:
+		return &result, agent.info.ID, nil
+	case <-agent.closed:
+		return nil, agent.info.ID, ErrAgentDisconnected
+	case <-ctx.Done():
+	}
+
+	// 취소를 요청하고 에이전트가 프로세스를 정리할 시간을 줌
+	agent.send(Envelope{Type: MessageCancel, Cancel: &Cancel{TaskID: assignment.TaskID}})
+	select {
+	case result := <-pending.done:
+		return &result, agent.info.ID, ctx.Err()
+	case <-agent.closed:
+	case <-time.After(cancelGrace):
+	}
+	return nil, agent.info.ID, ctx.Err()
+}
+
+// AffinityFor 워크스페이스에 적용되는 어피니티 (워크스페이스 규칙 → "*" 규칙 → 조건 없음)
+func (r *Registry) AffinityFor(workspaceID string) Affinity {
+	var fallback Affinity
+	for _, rule := range r.config.Rules {
+		if workspaceID != "" && rule.Workspace == workspaceID {
+			return rule.Affinity
+		}
+		if rule.Workspace == "*" {
+			fallback = rule.Affinity
+		}
+	}
+	return fallback
+}
+
+// reserve 조건에 맞는 에이전트의 슬롯 하나를 예약합니다 (없으면 AssignTimeout까지 대기)
+func (r *Registry) reserve(ctx context.Context, taskID string, affinity Affinity, onOutput func(string)) (*agentConn, *pendingTask, error) {
+	timer := time.NewTimer(r.config.AssignTimeout)
+	defer timer.Stop()
+
+	for {
+		r.mu.Lock()
+		agent := r.selectLocked(affinity)
+		if agent != nil {
+			pending := &pendingTask{onOutput: onOutput, done: make(chan Result, 1)}
+			agent.tasks[taskID] = pending
+			agent.info.Running = append(agent.info.Running, taskID)
+			r.mu.Unlock()
+			return agent, pending, nil
+		}
+		changed := r.changed
+		r.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-timer.C:
+			return nil, nil, ErrNoAgentAvailable
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+// release 태스크가 끝난 슬롯을 반환합니다.
+func (r *Registry) release(agent *agentConn, taskID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(agent.tasks, taskID)
+	for i, id := range agent.info.Running {
+		if id == taskID {
+			agent.info.Running = append(agent.info.Running[:i], agent.info.Running[i+1:]...)
+			break
+		}
+	}
+	r.notifyLocked()
+}
+
+// selectLocked 여유 슬롯이 있고 Required 레이블이 일치하는 에이전트 중
+// Preferred 레이블이 가장 많이 일치하고, 같으면 부하가 가장 낮은 에이전트를 고릅니다.
+func (r *Registry) selectLocked(affinity Affinity) *agentConn {
+	var best *agentConn
+	bestScore, bestLoad := -1, 0.0
+	for _, agent := range r.agents {
+		if len(agent.info.Running) >= agent.info.Capacity || !matchLabels(agent.info.Labels, affinity.Required) {
+			continue
+		}
+		score := 0
+		for key, value := range affinity.Preferred {
+			if agent.info.Labels[key] == value {
+				score++
+			}
+		}
+		load := float64(len(agent.info.Running)) / float64(agent.info.Capacity)
+		if best == nil || score > bestScore ||
+			score == bestScore && (load < bestLoad || load == bestLoad && agent.info.ID < best.info.ID) {
+			best, bestScore, bestLoad = agent, score, load
+		}
+	}
+	return best
+}
+
+// register 첫 메시지로 등록 정보를 받아 에이전트를 추가합니다 (같은 ID의 이전 연결은 끊음)
+func (r *Registry) register(conn *websocket.Conn, remoteAddr string) (*agentConn, error) {
+	conn.SetReadDeadline(time.Now().Add(registerTimeout))
+	var msg Envelope
+	if err := conn.ReadJSON(&msg); err != nil {
+		return nil, fmt.Errorf("등록 메시지 읽기 실패: %w", err)
+	}
+	if msg.Type != MessageRegister || msg.Register == nil {
+		return nil, errors.New("첫 메시지는 register여야 합니다")
+	}
+	reg := msg.Register
+	if reg.Version != ProtocolVersion {
+		return nil, fmt.Errorf("지원하지 않는 프로토콜 버전입니다: %d", reg.Version)
+	}
+	if reg.AgentID == "" {
+		reg.AgentID = uuid.NewString()
+	}
+	if reg.Name == "" {
+		reg.Name = reg.AgentID
+	}
+	if reg.Capacity <= 0 {
+		reg.Capacity = 1
+	}
+
+	now := time.Now()
+	agent := &agentConn{
+		info: AgentInfo{
+			ID:            reg.AgentID,
+			Name:          reg.Name,
+			Labels:        reg.Labels,
+			Capacity:      reg.Capacity,
+			Running:       []string{},
+			RemoteAddr:    remoteAddr,
+			ConnectedAt:   now,
+			LastHeartbeat: now,
+		},
+		conn:   conn,
+		tasks:  make(map[string]*pendingTask),
+		closed: make(chan struct{}),
+	}
+
+	if err := agent.send(Envelope{Type: MessageRegistered, Registered: &Registered{
+		AgentID:          agent.info.ID,
+		HeartbeatSeconds: int(r.config.HeartbeatInterval / time.Second),
+	}}); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	previous := r.agents[agent.info.ID]
+	r.agents[agent.info.ID] = agent
+	r.notifyLocked()
+	r.mu.Unlock()
+	if previous != nil {
+		previous.conn.Close()
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"agent_id": agent.info.ID,
+		"labels":   agent.info.Labels,
+		"capacity": agent.info.Capacity,
+	}).Info("원격 에이전트 등록")
+	return agent, nil
+}
+
+// serve 연결이 끊어질 때까지 에이전트 메시지를 처리합니다.
+func (r *Registry) serve(agent *agentConn) {
+	defer r.disconnect(agent)
+
+	for {
+		agent.conn.SetReadDeadline(time.Now().Add(r.config.HeartbeatTimeout))
+		var msg Envelope
+		if err := agent.conn.ReadJSON(&msg); err != nil {
+			return
+		}
+
+		r.mu.Lock()
+		agent.info.LastHeartbeat = time.Now()
+		var pending *pendingTask
+		switch {
+		case msg.Type == MessageOutput && msg.Output != nil:
+			pending = agent.tasks[msg.Output.TaskID]
+		case msg.Type == MessageResult && msg.Result != nil:
+			pending = agent.tasks[msg.Result.TaskID]
+		}
+		r.mu.Unlock()
+
+		if pending == nil {
+			continue
+		}
+		if msg.Type == MessageOutput {
+			if pending.onOutput != nil {
+				pending.onOutput(msg.Output.Data)
+			}
+		} else {
+			select {
+			case pending.done <- *msg.Result:
+			default:
+			}
+		}
+	}
+}
+
+// disconnect 에이전트를 목록에서 제거하고 실행 중이던 태스크를 실패 처리합니다.
+func (r *Registry) disconnect(agent *agentConn) {
+	agent.conn.Close()
+	close(agent.closed)
+
+	r.mu.Lock()
+	if r.agents[agent.info.ID] == agent {
+		delete(r.agents, agent.info.ID)
+		r.notifyLocked()
+	}
+	running := len(agent.tasks)
+	r.mu.Unlock()
+
+	r.logger.WithFields(logrus.Fields{
+		"agent_id": agent.info.ID,
+		"running":  running,
+	}).Info("원격 에이전트 연결 종료")
+}
+
+// notifyLocked 배정을 기다리는 태스크를 깨웁니다 (mu를 잡은 상태에서 호출)
+func (r *Registry) notifyLocked() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+// snapshot 에이전트 정보 복사본 (Registry.mu를 잡은 상태에서 호출)
+func (a *agentConn) snapshot() AgentInfo {
+	info := a.info
+	info.Running = append([]string{}, a.info.Running...)
+	return info
+}
+
+// send 메시지 하나를 전송합니다.
+func (a *agentConn) send(msg Envelope) error {
+	a.writeMu.Lock()
+	defer a.writeMu.Unlock()
+	a.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return a.conn.WriteJSON(msg)
+}
+
+// matchLabels labels가 selector의 키/값을 모두 포함하는지 확인합니다.
+func matchLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
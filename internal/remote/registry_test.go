@@ -0,0 +1,159 @@
+package remote
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegistry(t *testing.T, rules ...AffinityRule) (*Registry, string) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	registry := NewRegistry(RegistryConfig{
+		Token:             "agent-token",
+		HeartbeatInterval: time.Second,
+		AssignTimeout:     200 * time.Millisecond,
+		Rules:             rules,
+	}, logger)
+
+	server := httptest.NewServer(registry)
+	t.Cleanup(server.Close)
+	return registry, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func startTestAgent(t *testing.T, registry *Registry, url, id string, labels map[string]string) {
+	agent, err := NewAgent(AgentConfig{
+		ServerURL: url,
+		Token:     "agent-token",
+		AgentID:   id,
+		Labels:    labels,
+		Capacity:  1,
+		WorkDir:   t.TempDir(),
+		Logger:    log.New(io.Discard, "", 0),
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		agent.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	require.Eventually(t, func() bool {
+		for _, info := range registry.List() {
+			if info.ID == id {
+				return true
+			}
+		}
+		return false
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestRegistry_DispatchToAgent(t *testing.T) {
+	registry, url := newTestRegistry(t)
+	startTestAgent(t, registry, url, "agent-1", map[string]string{"os": "linux"})
+
+	var mu sync.Mutex
+	var output strings.Builder
+	result, agentID, err := registry.Dispatch(context.Background(), Assignment{
+		TaskID:      "task-1",
+		WorkspaceID: "ws-1",
+		Command:     "echo",
+		Args:        []string{"hello", "agent"},
+	}, func(data string) {
+		mu.Lock()
+		output.WriteString(data)
+		mu.Unlock()
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "agent-1", agentID)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Empty(t, result.Error)
+
+	mu.Lock()
+	assert.Equal(t, "hello agent\n", output.String())
+	mu.Unlock()
+
+	// 슬롯이 반환됨
+	agents := registry.List()
+	require.Len(t, agents, 1)
+	assert.Empty(t, agents[0].Running)
+	assert.Equal(t, map[string]string{"os": "linux"}, agents[0].Labels)
+}
+
+func TestRegistry_DispatchFailureAndCancel(t *testing.T) {
+	registry, url := newTestRegistry(t)
+	startTestAgent(t, registry, url, "agent-1", nil)
+
+	result, _, err := registry.Dispatch(context.Background(), Assignment{TaskID: "fail", Command: "false"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.NotEmpty(t, result.Error)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, _, err = registry.Dispatch(ctx, Assignment{TaskID: "slow", Command: "sleep", Args: []string{"30"}}, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 10*time.Second, "취소 요청으로 에이전트가 프로세스를 종료")
+}
+
+func TestRegistry_Affinity(t *testing.T) {
+	registry, url := newTestRegistry(t,
+		AffinityRule{Workspace: "gpu-ws", Affinity: Affinity{Required: map[string]string{"gpu": "true"}}},
+		AffinityRule{Workspace: "*", Affinity: Affinity{Preferred: map[string]string{"region": "eu"}}},
+	)
+	startTestAgent(t, registry, url, "agent-us", map[string]string{"region": "us"})
+	startTestAgent(t, registry, url, "agent-eu", map[string]string{"region": "eu"})
+
+	_, agentID, err := registry.Dispatch(context.Background(), Assignment{TaskID: "t1", WorkspaceID: "any", Command: "true"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "agent-eu", agentID, "선호 레이블이 일치하는 에이전트 우선")
+
+	_, _, err = registry.Dispatch(context.Background(), Assignment{TaskID: "t2", WorkspaceID: "gpu-ws", Command: "true"}, nil)
+	assert.ErrorIs(t, err, ErrNoAgentAvailable, "필수 레이블이 일치하는 에이전트가 없음")
+
+	startTestAgent(t, registry, url, "agent-gpu", map[string]string{"gpu": "true"})
+	_, agentID, err = registry.Dispatch(context.Background(), Assignment{TaskID: "t3", WorkspaceID: "gpu-ws", Command: "true"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "agent-gpu", agentID)
+}
+
+func TestRegistry_RejectsInvalidToken(t *testing.T) {
+	_, url := newTestRegistry(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "http"+strings.TrimPrefix(url, "ws"), nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestRegistry_SelectLeastLoaded(t *testing.T) {
+	registry := NewRegistry(RegistryConfig{}, nil)
+	registry.agents["a"] = &agentConn{info: AgentInfo{ID: "a", Capacity: 2, Running: []string{"x"}}, tasks: map[string]*pendingTask{}}
+	registry.agents["b"] = &agentConn{info: AgentInfo{ID: "b", Capacity: 2}, tasks: map[string]*pendingTask{}}
+	registry.agents["c"] = &agentConn{info: AgentInfo{ID: "c", Capacity: 1, Running: []string{"y"}}, tasks: map[string]*pendingTask{}}
+
+	assert.Equal(t, "b", registry.selectLocked(Affinity{}).info.ID)
+	registry.agents["b"].info.Running = []string{"p", "q"}
+	assert.Equal(t, "a", registry.selectLocked(Affinity{}).info.ID)
+	registry.agents["a"].info.Running = []string{"x", "z"}
+	assert.Nil(t, registry.selectLocked(Affinity{}))
+}
@@ -0,0 +1,349 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// ElasticsearchConfig Elasticsearch 인덱스 설정
+type ElasticsearchConfig struct {
+	// URL 클러스터 주소 (예: http://localhost:9200)
+	URL string
+
+	// Index 인덱스 이름
+	Index string
+
+	// Username, Password 기본 인증 (비어 있으면 인증하지 않음)
+	Username string
+	Password string
+
+	// Client HTTP 클라이언트 (nil이면 30초 제한 기본 클라이언트)
+	Client *http.Client
+}
+
+// ElasticsearchIndex Elasticsearch(7.x 이상) REST API를 사용하는 검색 인덱스
+// 여러 인스턴스가 같은 인덱스를 공유하므로 다시 만들기는 한 인스턴스에서만 실행하면 됩니다.
+type ElasticsearchIndex struct {
+	baseURL  string
+	index    string
+	username string
+	password string
+	client   *http.Client
+	now      func() time.Time
+}
+
+// 인터페이스 구현 확인
+var _ Index = (*ElasticsearchIndex)(nil)
+
+// facetSize 소유자 패싯에서 반환할 최대 구간 수
+const facetSize = 20
+
+// indexMapping 인덱스가 없을 때 만드는 매핑 (generation은 다시 만들기 전 문서를 지우는 데 사용)
+const indexMapping = `{
+  "mappings": {
+    "properties": {
+      "type": {"type": "keyword"},
+      "id": {"type": "keyword"},
+      "title": {"type": "text"},
+      "body": {"type": "text"},
+      "workspace_id": {"type": "keyword"},
+      "owner_id": {"type": "keyword"},
+      "public": {"type": "boolean"},
+      "created_at": {"type": "date"},
+      "generation": {"type": "long"}
+    }
+  }
+}`
+
+// NewElasticsearchIndex 새 Elasticsearch 인덱스 클라이언트 생성
+func NewElasticsearchIndex(config ElasticsearchConfig) (*ElasticsearchIndex, error) {
+	if config.URL == "" {
+		return nil, errors.New("elasticsearch 주소가 필요합니다")
+	}
+	if config.Index == "" {
+		return nil, errors.New("elasticsearch 인덱스 이름이 필요합니다")
+	}
+	client := config.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &ElasticsearchIndex{
+		baseURL:  strings.TrimRight(config.URL, "/"),
+		index:    config.Index,
+		username: config.Username,
+		password: config.Password,
+		client:   client,
+		now:      time.Now,
+	}, nil
+}
+
+// esDocument 인덱스에 저장하는 문서 (다시 만든 세대 포함)
+type esDocument struct {
+	*models.SearchDocument
+	Generation int64 `json:"generation"`
+}
+
+// Replace 새 세대로 모든 문서를 색인한 뒤 이전 세대 문서를 지웁니다
+func (e *ElasticsearchIndex) Replace(ctx context.Context, docs []*models.SearchDocument) error {
+	if err := e.ensureIndex(ctx); err != nil {
+		return err
+	}
+
+	generation := e.now().UnixNano()
+	if len(docs) > 0 {
+		var bulk bytes.Buffer
+		encoder := json.NewEncoder(&bulk)
+		for _, doc := range docs {
+			action := map[string]interface{}{"index": map[string]string{"_index": e.index, "_id": doc.Key()}}
+			if err := encoder.Encode(action); err != nil {
+				return err
+			}
+			if err := encoder.Encode(esDocument{SearchDocument: doc, Generation: generation}); err != nil {
+				return err
+			}
+		}
+
+		var result struct {
+			Errors bool `json:"errors"`
+			Items  []map[string]struct {
+				Error *struct {
+					Reason string `json:"reason"`
+				} `json:"error"`
+			} `json:"items"`
+		}
+		if err := e.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", &bulk, &result); err != nil {
+			return err
+		}
+		if result.Errors {
+			for _, item := range result.Items {
+				for _, op := range item {
+					if op.Error != nil {
+						return fmt.Errorf("elasticsearch 색인 실패: %s", op.Error.Reason)
+					}
+				}
+			}
+			return errors.New("elasticsearch 색인 실패")
+		}
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"range": map[string]interface{}{"generation": map[string]int64{"lt": generation}},
+		},
+	}
+	return e.doJSON(ctx, http.MethodPost, "/"+e.index+"/_delete_by_query?refresh=true&conflicts=proceed", query, nil)
+}
+
+// ensureIndex 인덱스가 없으면 매핑과 함께 만듭니다
+func (e *ElasticsearchIndex) ensureIndex(ctx context.Context) error {
+	err := e.do(ctx, http.MethodPut, "/"+e.index, "application/json", strings.NewReader(indexMapping), nil)
+	var apiErr *esError
+	if errors.As(err, &apiErr) && apiErr.Type == "resource_already_exists_exception" {
+		return nil
+	}
+	return err
+}
+
+// Search 검색어, 조건, 권한을 쿼리로 바꿔 조회하고 패싯은 집계로 계산합니다
+func (e *ElasticsearchIndex) Search(ctx context.Context, query *Query) (*Result, error) {
+	var must interface{} = map[string]interface{}{"match_all": map[string]interface{}{}}
+	if strings.TrimSpace(query.Text) != "" {
+		must = map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":    query.Text,
+				"fields":   []string{fmt.Sprintf("title^%d", titleWeight), "body"},
+				"operator": "and",
+				"type":     "bool_prefix",
+			},
+		}
+	}
+
+	size := query.Limit
+	if size <= 0 {
+		size = 20
+	}
+	body := map[string]interface{}{
+		"from":             query.Offset,
+		"size":             size,
+		"track_total_hits": true,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{"must": must, "filter": esFilters(query)},
+		},
+		"sort": []interface{}{"_score", map[string]string{"created_at": "desc"}},
+		"aggs": map[string]interface{}{
+			"type":  map[string]interface{}{"terms": map[string]interface{}{"field": "type"}},
+			"owner": map[string]interface{}{"terms": map[string]interface{}{"field": "owner_id", "size": facetSize}},
+			"date": map[string]interface{}{"date_histogram": map[string]interface{}{
+				"field": "created_at", "calendar_interval": "month", "format": "yyyy-MM", "min_doc_count": 1,
+			}},
+		},
+		"highlight": map[string]interface{}{
+			"fields": map[string]interface{}{"body": map[string]interface{}{"number_of_fragments": 1}},
+		},
+	}
+
+	var resp struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Score     float64               `json:"_score"`
+				Source    models.SearchDocument `json:"_source"`
+				Highlight map[string][]string   `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+		Aggregations map[string]struct {
+			Buckets []struct {
+				Key         interface{} `json:"key"`
+				KeyAsString string      `json:"key_as_string"`
+				DocCount    int         `json:"doc_count"`
+			} `json:"buckets"`
+		} `json:"aggregations"`
+	}
+	if err := e.doJSON(ctx, http.MethodPost, "/"+e.index+"/_search", body, &resp); err != nil {
+		return nil, err
+	}
+
+	result := &Result{Total: resp.Hits.Total.Value, Hits: make([]*models.SearchHit, 0, len(resp.Hits.Hits))}
+	for _, hit := range resp.Hits.Hits {
+		searchHit := &models.SearchHit{SearchDocument: hit.Source, Score: hit.Score}
+		if fragments := hit.Highlight["body"]; len(fragments) > 0 {
+			searchHit.Snippet = fragments[0]
+		}
+		result.Hits = append(result.Hits, searchHit)
+	}
+
+	facets := make(map[string]facetCounter, len(resp.Aggregations))
+	for name, agg := range resp.Aggregations {
+		counter := facetCounter{}
+		for _, bucket := range agg.Buckets {
+			value := bucket.KeyAsString
+			if value == "" {
+				value = fmt.Sprint(bucket.Key)
+			}
+			counter[value] = bucket.DocCount
+		}
+		facets[name] = counter
+	}
+	result.Facets = models.SearchFacets{
+		Type:  facets["type"].buckets(),
+		Owner: facets["owner"].buckets(),
+		Date:  facets["date"].dateBuckets(),
+	}
+	return result, nil
+}
+
+// esFilters 검색어 외의 조건과 권한을 bool filter 절로 변환
+func esFilters(query *Query) []interface{} {
+	filters := []interface{}{}
+	if len(query.Types) > 0 {
+		filters = append(filters, map[string]interface{}{"terms": map[string]interface{}{"type": query.Types}})
+	}
+	if query.OwnerID != "" {
+		filters = append(filters, map[string]interface{}{"term": map[string]interface{}{"owner_id": query.OwnerID}})
+	}
+	if query.From != nil || query.To != nil {
+		dateRange := map[string]interface{}{}
+		if query.From != nil {
+			dateRange["gte"] = query.From.UTC().Format(time.RFC3339Nano)
+		}
+		if query.To != nil {
+			dateRange["lt"] = query.To.UTC().Format(time.RFC3339Nano)
+		}
+		filters = append(filters, map[string]interface{}{"range": map[string]interface{}{"created_at": dateRange}})
+	}
+	if query.Access != nil {
+		// 권한 있는 워크스페이스의 문서, 또는 워크스페이스 밖의 공개 문서와 본인 문서
+		outside := map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must_not": map[string]interface{}{"exists": map[string]string{"field": "workspace_id"}},
+				"should": []interface{}{
+					map[string]interface{}{"term": map[string]interface{}{"public": true}},
+					map[string]interface{}{"term": map[string]interface{}{"owner_id": query.Access.UserID}},
+				},
+				"minimum_should_match": 1,
+			},
+		}
+		should := []interface{}{outside}
+		if len(query.Access.WorkspaceIDs) > 0 {
+			should = append(should, map[string]interface{}{"terms": map[string]interface{}{"workspace_id": query.Access.WorkspaceIDs}})
+		}
+		filters = append(filters, map[string]interface{}{
+			"bool": map[string]interface{}{"should": should, "minimum_should_match": 1},
+		})
+	}
+	return filters
+}
+
+// esError Elasticsearch 오류 응답
+type esError struct {
+	Status int
+	Type   string
+	Reason string
+}
+
+func (e *esError) Error() string {
+	return fmt.Sprintf("elasticsearch 오류 (%d): %s: %s", e.Status, e.Type, e.Reason)
+}
+
+// doJSON body를 JSON으로 보내고 응답을 out에 해석합니다
+func (e *ElasticsearchIndex) doJSON(ctx context.Context, method, path string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return e.do(ctx, method, path, "application/json", bytes.NewReader(payload), out)
+}
+
+// do 요청을 보내고 2xx가 아니면 esError를 반환합니다
+func (e *ElasticsearchIndex) do(ctx context.Context, method, path, contentType string, body io.Reader, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, e.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	if e.username != "" {
+		req.SetBasicAuth(e.username, e.password)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("elasticsearch 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 16<<20))
+	if err != nil {
+		return fmt.Errorf("elasticsearch 응답 읽기 실패: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := &esError{Status: resp.StatusCode, Reason: strings.TrimSpace(string(data))}
+		var parsed struct {
+			Error struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(data, &parsed) == nil && parsed.Error.Type != "" {
+			apiErr.Type, apiErr.Reason = parsed.Error.Type, parsed.Error.Reason
+		}
+		return apiErr
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("elasticsearch 응답 해석 실패: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+func TestElasticsearchIndex_Replace(t *testing.T) {
+	var requests []string
+	var bulk string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/aicli-search":
+			// 이미 있는 인덱스는 그대로 사용
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"type":"resource_already_exists_exception","reason":"exists"}}`))
+		case "/_bulk":
+			bulk = string(body)
+			w.Write([]byte(`{"errors":false,"items":[]}`))
+		default:
+			w.Write([]byte(`{"deleted":0}`))
+		}
+	}))
+	defer server.Close()
+
+	index, err := NewElasticsearchIndex(ElasticsearchConfig{URL: server.URL + "/", Index: "aicli-search"})
+	require.NoError(t, err)
+	require.NoError(t, index.Replace(context.Background(), testDocuments()[:2]))
+
+	assert.Equal(t, []string{"PUT /aicli-search", "POST /_bulk", "POST /aicli-search/_delete_by_query"}, requests)
+	lines := strings.Split(strings.TrimSpace(bulk), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[0], `"_id":"workspace:ws1"`)
+	assert.Contains(t, lines[1], `"generation":`)
+}
+
+func TestElasticsearchIndex_Search(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/aicli-search/_search", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{
+			"hits": {"total": {"value": 1}, "hits": [
+				{"_score": 2.5, "_source": {"type": "audit", "id": "c1", "title": "go test", "workspace_id": "ws1", "owner_id": "alice"},
+				 "highlight": {"body": ["<em>payments</em> tests passed"]}}
+			]},
+			"aggregations": {
+				"type": {"buckets": [{"key": "audit", "doc_count": 1}]},
+				"owner": {"buckets": [{"key": "alice", "doc_count": 1}]},
+				"date": {"buckets": [{"key": 1767225600000, "key_as_string": "2026-01", "doc_count": 1}]}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	index, err := NewElasticsearchIndex(ElasticsearchConfig{URL: server.URL, Index: "aicli-search"})
+	require.NoError(t, err)
+	result, err := index.Search(context.Background(), &Query{
+		Text:   "payments",
+		Types:  []models.SearchDocumentType{models.SearchTypeAudit},
+		Access: &Access{UserID: "alice", WorkspaceIDs: []string{"ws1"}},
+		Limit:  10,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Total)
+	require.Len(t, result.Hits, 1)
+	assert.Equal(t, "c1", result.Hits[0].ID)
+	assert.Equal(t, 2.5, result.Hits[0].Score)
+	assert.Equal(t, "<em>payments</em> tests passed", result.Hits[0].Snippet)
+	assert.Equal(t, []models.SearchFacetBucket{{Value: "2026-01", Count: 1}}, result.Facets.Date)
+
+	// 종류 조건과 권한 조건이 filter로 전달됨
+	filters := body["query"].(map[string]interface{})["bool"].(map[string]interface{})["filter"].([]interface{})
+	assert.Len(t, filters, 2)
+	assert.Equal(t, float64(10), body["size"])
+}
+
+func TestNewElasticsearchIndex_InvalidConfig(t *testing.T) {
+	_, err := NewElasticsearchIndex(ElasticsearchConfig{Index: "aicli-search"})
+	assert.Error(t, err)
+	_, err = NewElasticsearchIndex(ElasticsearchConfig{URL: "http://localhost:9200"})
+	assert.Error(t, err)
+}
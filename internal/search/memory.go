@@ -0,0 +1,161 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// titleWeight 제목에 나온 단어의 가중치 (본문은 1)
+const titleWeight = 3
+
+// MemoryIndex 프로세스 안의 역색인
+// 검색어의 각 단어는 색인된 단어의 접두어로 일치하며, 모든 단어가 일치한 문서만 결과에 포함합니다.
+type MemoryIndex struct {
+	mu       sync.RWMutex
+	docs     map[string]*models.SearchDocument
+	postings map[string]map[string]int // 단어 -> 문서 키 -> 가중치 합
+	terms    []string                  // 접두어 검색용으로 정렬한 단어 목록
+}
+
+// 인터페이스 구현 확인
+var _ Index = (*MemoryIndex)(nil)
+
+// NewMemoryIndex 빈 메모리 인덱스 생성
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{
+		docs:     make(map[string]*models.SearchDocument),
+		postings: make(map[string]map[string]int),
+	}
+}
+
+// Replace 새 색인을 만든 뒤 한 번에 바꿉니다 (만드는 동안에도 이전 색인으로 검색 가능)
+func (m *MemoryIndex) Replace(ctx context.Context, docs []*models.SearchDocument) error {
+	docMap := make(map[string]*models.SearchDocument, len(docs))
+	postings := make(map[string]map[string]int)
+	add := func(key, text string, weight int) {
+		for _, term := range Tokenize(text) {
+			if postings[term] == nil {
+				postings[term] = make(map[string]int)
+			}
+			postings[term][key] += weight
+		}
+	}
+
+	for _, doc := range docs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		copied := *doc
+		key := copied.Key()
+		docMap[key] = &copied
+		add(key, copied.Title, titleWeight)
+		add(key, copied.Body, 1)
+	}
+
+	terms := make([]string, 0, len(postings))
+	for term := range postings {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	m.mu.Lock()
+	m.docs, m.postings, m.terms = docMap, postings, terms
+	m.mu.Unlock()
+	return nil
+}
+
+// Search 관련도(같으면 최신순)로 정렬한 결과와 패싯 조회
+func (m *MemoryIndex) Search(ctx context.Context, query *Query) (*Result, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	queryTerms := Tokenize(query.Text)
+	scores := m.score(queryTerms)
+
+	types, owners, dates := facetCounter{}, facetCounter{}, facetCounter{}
+	hits := []*models.SearchHit{}
+	for key, doc := range m.docs {
+		score, matched := scores[key]
+		if len(queryTerms) > 0 && !matched {
+			continue
+		}
+		if !query.matchesFilters(doc) {
+			continue
+		}
+		types[string(doc.Type)]++
+		owners[doc.OwnerID]++
+		dates[doc.CreatedAt.UTC().Format(dateFacetLayout)]++
+		hits = append(hits, &models.SearchHit{SearchDocument: *doc, Score: float64(score)})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if !hits[i].CreatedAt.Equal(hits[j].CreatedAt) {
+			return hits[i].CreatedAt.After(hits[j].CreatedAt)
+		}
+		return hits[i].Key() < hits[j].Key()
+	})
+
+	result := &Result{
+		Total: len(hits),
+		Facets: models.SearchFacets{
+			Type:  types.buckets(),
+			Owner: owners.buckets(),
+			Date:  dates.dateBuckets(),
+		},
+	}
+	page := paginateHits(hits, query.Offset, query.Limit)
+	for _, hit := range page {
+		if hit.Body != "" && len(queryTerms) > 0 {
+			hit.Snippet = storage.MessageSnippet(hit.Body, queryTerms)
+		}
+	}
+	result.Hits = page
+	return result, nil
+}
+
+// score 모든 검색어 단어와 일치한 문서의 점수 (단어마다 접두어가 같은 색인 단어의 가중치 합)
+func (m *MemoryIndex) score(queryTerms []string) map[string]int {
+	var scores map[string]int
+	for _, term := range queryTerms {
+		termScores := make(map[string]int)
+		start := sort.SearchStrings(m.terms, term)
+		for i := start; i < len(m.terms) && strings.HasPrefix(m.terms[i], term); i++ {
+			for key, weight := range m.postings[m.terms[i]] {
+				termScores[key] += weight
+			}
+		}
+
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for key, score := range scores {
+			if termScore, ok := termScores[key]; ok {
+				scores[key] = score + termScore
+			} else {
+				delete(scores, key)
+			}
+		}
+	}
+	return scores
+}
+
+// paginateHits offset부터 limit개 (limit이 0 이하이면 끝까지)
+func paginateHits(hits []*models.SearchHit, offset, limit int) []*models.SearchHit {
+	if offset >= len(hits) {
+		return []*models.SearchHit{}
+	}
+	hits = hits[offset:]
+	if limit > 0 && limit < len(hits) {
+		hits = hits[:limit]
+	}
+	return hits
+}
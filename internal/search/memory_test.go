@@ -0,0 +1,98 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+func testDocuments() []*models.SearchDocument {
+	jan := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC)
+	return []*models.SearchDocument{
+		{Type: models.SearchTypeWorkspace, ID: "ws1", Title: "payments-api", Body: "/srv/payments", WorkspaceID: "ws1", OwnerID: "alice", CreatedAt: jan},
+		{Type: models.SearchTypeAudit, ID: "c1", Title: "go test ./...", Body: "payments tests passed", WorkspaceID: "ws1", OwnerID: "alice", CreatedAt: feb},
+		{Type: models.SearchTypeWorkspace, ID: "ws2", Title: "billing", Body: "/srv/payment-gateway", WorkspaceID: "ws2", OwnerID: "bob", CreatedAt: feb},
+		{Type: models.SearchTypePrompt, ID: "p1", Title: "review payments", OwnerID: "bob", Public: true, CreatedAt: feb},
+		{Type: models.SearchTypePrompt, ID: "p2", Title: "private payments", OwnerID: "bob", CreatedAt: feb},
+	}
+}
+
+func TestMemoryIndex_Search(t *testing.T) {
+	ctx := context.Background()
+	index := NewMemoryIndex()
+	require.NoError(t, index.Replace(ctx, testDocuments()))
+
+	// 단어 앞부분 일치, 제목 일치가 본문 일치보다 앞
+	result, err := index.Search(ctx, &Query{Text: "paym"})
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Total)
+	assert.Equal(t, models.SearchTypeWorkspace, result.Hits[0].Type)
+	assert.Equal(t, "ws1", result.Hits[0].ID)
+
+	// 모든 단어가 일치해야 함
+	result, err = index.Search(ctx, &Query{Text: "payments passed"})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Total)
+	assert.Equal(t, "c1", result.Hits[0].ID)
+	assert.Contains(t, result.Hits[0].Snippet, "passed")
+
+	// 패싯은 조건을 적용한 결과로 집계
+	result, err = index.Search(ctx, &Query{Text: "payments", Types: []models.SearchDocumentType{models.SearchTypePrompt}})
+	require.NoError(t, err)
+	assert.Equal(t, []models.SearchFacetBucket{{Value: "prompt", Count: 2}}, result.Facets.Type)
+	assert.Equal(t, []models.SearchFacetBucket{{Value: "bob", Count: 2}}, result.Facets.Owner)
+
+	// 검색어 없이 기간만으로 조회, 날짜 패싯은 최신 월부터
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	result, err = index.Search(ctx, &Query{From: &from})
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.Total)
+	assert.Equal(t, []models.SearchFacetBucket{{Value: "2026-02", Count: 4}}, result.Facets.Date)
+
+	result, err = index.Search(ctx, &Query{})
+	require.NoError(t, err)
+	assert.Equal(t, []models.SearchFacetBucket{{Value: "2026-02", Count: 4}, {Value: "2026-01", Count: 1}}, result.Facets.Date)
+
+	// 페이지
+	result, err = index.Search(ctx, &Query{Offset: 4, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Total)
+	assert.Len(t, result.Hits, 1)
+}
+
+func TestMemoryIndex_Access(t *testing.T) {
+	ctx := context.Background()
+	index := NewMemoryIndex()
+	require.NoError(t, index.Replace(ctx, testDocuments()))
+
+	// alice는 ws1 문서와 공개 프롬프트만 (bob의 비공개 프롬프트와 ws2 제외)
+	result, err := index.Search(ctx, &Query{Text: "payment", Access: &Access{UserID: "alice", WorkspaceIDs: []string{"ws1"}}})
+	require.NoError(t, err)
+	ids := []string{}
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.ID)
+	}
+	assert.ElementsMatch(t, []string{"ws1", "c1", "p1"}, ids)
+	assert.Equal(t, []models.SearchFacetBucket{{Value: "alice", Count: 2}, {Value: "bob", Count: 1}}, result.Facets.Owner)
+
+	// 작성자는 비공개 프롬프트도 검색
+	result, err = index.Search(ctx, &Query{Text: "private", Access: &Access{UserID: "bob"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+
+	// 다시 만들면 빠진 문서는 사라짐
+	require.NoError(t, index.Replace(ctx, testDocuments()[:1]))
+	result, err = index.Search(ctx, &Query{Text: "private"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Total)
+}
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"go", "test", "payments", "결제", "v2"}, Tokenize("go test ./payments/... 결제-v2"))
+}
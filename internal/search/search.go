@@ -0,0 +1,137 @@
+// Package search 워크스페이스, 세션, 프롬프트, 감사 기록을 가로지르는 통합 검색 인덱스
+//
+// 기본값은 프로세스 안의 역색인(MemoryIndex)이며, 여러 인스턴스가 같은 인덱스를 봐야 하면
+// Elasticsearch(ElasticsearchIndex)를 사용합니다. 인덱스는 스토리지에서 주기적으로 다시 만들어지므로
+// 권한 확인에 필요한 값(워크스페이스, 소유자, 공개 여부)을 문서에 함께 저장합니다.
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// Index 검색 인덱스
+type Index interface {
+	// Replace 인덱스의 문서를 docs로 모두 바꿉니다 (docs에 없는 문서는 삭제)
+	Replace(ctx context.Context, docs []*models.SearchDocument) error
+
+	// Search 조건에 맞는 문서를 관련도순으로 조회합니다
+	Search(ctx context.Context, query *Query) (*Result, error)
+}
+
+// Access 검색하는 사용자가 볼 수 있는 범위
+type Access struct {
+	// UserID 검색하는 사용자 (작성한 프롬프트는 공개 여부와 관계없이 포함)
+	UserID string
+
+	// WorkspaceIDs read 권한이 있는 워크스페이스
+	WorkspaceIDs []string
+}
+
+// Allows 문서를 볼 수 있는지 확인
+func (a *Access) Allows(doc *models.SearchDocument) bool {
+	if doc.WorkspaceID != "" {
+		for _, id := range a.WorkspaceIDs {
+			if id == doc.WorkspaceID {
+				return true
+			}
+		}
+		return false
+	}
+	return doc.Public || doc.OwnerID == a.UserID
+}
+
+// Query 검색 조건
+type Query struct {
+	// Text 검색어 (비어 있으면 조건에 맞는 모든 문서를 최신순으로)
+	Text string
+
+	// Types 문서 종류 (비어 있으면 전체)
+	Types []models.SearchDocumentType
+
+	// OwnerID 소유자
+	OwnerID string
+
+	// From, To 생성 시각 범위 (From 이상, To 미만)
+	From *time.Time
+	To   *time.Time
+
+	// Access 볼 수 있는 범위 (nil이면 제한 없음, 관리자용)
+	Access *Access
+
+	Offset int
+	Limit  int
+}
+
+// Result 검색 결과 한 페이지와 전체 결과 수, 패싯
+type Result struct {
+	Hits   []*models.SearchHit
+	Total  int
+	Facets models.SearchFacets
+}
+
+// dateFacetLayout 날짜 패싯의 구간 (생성 월)
+const dateFacetLayout = "2006-01"
+
+// Tokenize 텍스트를 소문자 단어 목록으로 나눕니다 (글자와 숫자가 아닌 문자로 구분)
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// matchesFilters 검색어 외의 조건(종류, 소유자, 기간, 권한) 확인
+func (q *Query) matchesFilters(doc *models.SearchDocument) bool {
+	if len(q.Types) > 0 {
+		found := false
+		for _, t := range q.Types {
+			if t == doc.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if q.OwnerID != "" && doc.OwnerID != q.OwnerID {
+		return false
+	}
+	if q.From != nil && doc.CreatedAt.Before(*q.From) {
+		return false
+	}
+	if q.To != nil && !doc.CreatedAt.Before(*q.To) {
+		return false
+	}
+	return q.Access == nil || q.Access.Allows(doc)
+}
+
+// facetCounter 패싯 값별 개수 집계
+type facetCounter map[string]int
+
+// buckets 개수가 많은 순(같으면 값 순)으로 정렬한 구간
+func (f facetCounter) buckets() []models.SearchFacetBucket {
+	buckets := make([]models.SearchFacetBucket, 0, len(f))
+	for value, count := range f {
+		buckets = append(buckets, models.SearchFacetBucket{Value: value, Count: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		return buckets[i].Value < buckets[j].Value
+	})
+	return buckets
+}
+
+// dateBuckets 최신 월부터 정렬한 구간
+func (f facetCounter) dateBuckets() []models.SearchFacetBucket {
+	buckets := f.buckets()
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Value > buckets[j].Value })
+	return buckets
+}
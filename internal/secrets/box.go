@@ -0,0 +1,80 @@
+// Package secrets 저장소에 넣는 비밀 값을 암호화합니다.
+//
+// 값은 AES-256-GCM으로 암호화해 "v1:" 접두사와 base64(논스+암호문) 형식의 문자열로 저장하며,
+// 키는 설정한 문자열의 SHA-256 해시로 만듭니다.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// sealedPrefix 암호화한 값의 형식 버전 접두사
+const sealedPrefix = "v1:"
+
+// keyContext 다른 용도로 쓰는 같은 문자열과 다른 키가 되도록 해시 앞에 붙이는 값
+const keyContext = "aicli-web/secrets/v1\x00"
+
+// ErrInvalidSealed 암호화한 값의 형식이 잘못되었거나 다른 키로 암호화됨
+var ErrInvalidSealed = errors.New("secrets: invalid sealed value")
+
+// Box 비밀 값을 암호화하고 복호화합니다 (여러 고루틴에서 함께 사용 가능)
+type Box struct {
+	aead cipher.AEAD
+}
+
+// NewBox 키 문자열로 새 Box 생성 (빈 문자열은 에러)
+func NewBox(key string) (*Box, error) {
+	if key == "" {
+		return nil, errors.New("secrets: empty key")
+	}
+	sum := sha256.Sum256([]byte(keyContext + key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead: aead}, nil
+}
+
+// IsSealed 값이 Seal로 암호화한 형식인지 확인합니다 (암호화하기 전에 저장된 평문과 구분할 때 사용)
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+// Seal 값을 암호화합니다 (같은 값도 매번 다른 결과)
+func (b *Box) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("secrets: nonce 생성 실패: %w", err)
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return sealedPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Open Seal로 암호화한 값을 복호화합니다
+func (b *Box) Open(sealed string) (string, error) {
+	encoded, ok := strings.CutPrefix(sealed, sealedPrefix)
+	if !ok {
+		return "", ErrInvalidSealed
+	}
+	data, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(data) < b.aead.NonceSize() {
+		return "", ErrInvalidSealed
+	}
+	nonce, ciphertext := data[:b.aead.NonceSize()], data[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrInvalidSealed
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,41 @@
+package secrets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBox_SealOpen(t *testing.T) {
+	box, err := NewBox("first-key-with-at-least-32-characters")
+	require.NoError(t, err)
+
+	sealed, err := box.Seal("sk-live-value")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sealed, sealedPrefix))
+	assert.NotContains(t, sealed, "sk-live-value")
+
+	// 같은 값도 매번 다르게 암호화
+	again, err := box.Seal("sk-live-value")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again)
+
+	plaintext, err := box.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "sk-live-value", plaintext)
+
+	// 다른 키나 손상된 값은 복호화하지 않음
+	other, err := NewBox("second-key-with-at-least-32-characters")
+	require.NoError(t, err)
+	_, err = other.Open(sealed)
+	assert.ErrorIs(t, err, ErrInvalidSealed)
+	_, err = box.Open(sealed[:len(sealed)-2])
+	assert.ErrorIs(t, err, ErrInvalidSealed)
+	_, err = box.Open("sk-live-value")
+	assert.ErrorIs(t, err, ErrInvalidSealed)
+
+	_, err = NewBox("")
+	assert.Error(t, err)
+}
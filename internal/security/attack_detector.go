@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
-	"go.uber.org/zap"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // AttackPattern은 공격 패턴을 정의합니다.
@@ -22,6 +25,7 @@ type AttackPattern struct {
 	Severity    Severity  `json:"severity"`
 	Confidence  float64   `json:"confidence"` // 0.0 ~ 1.0
 	Description string    `json:"description"`
+	Targets     []string  `json:"targets,omitempty"` // 검사 대상 (url, query, headers, body; 비어 있으면 모두)
 	IsActive    bool      `json:"is_active"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
@@ -36,6 +40,8 @@ type AttackDetectionResult struct {
 	Risk          Severity           `json:"risk"`
 	Evidence      []string           `json:"evidence,omitempty"`
 	Recommendations []string         `json:"recommendations,omitempty"`
+	RouteGroup    string             `json:"route_group,omitempty"` // 적용한 임계값의 라우트 그룹
+	DryRun        bool               `json:"dry_run,omitempty"`     // 탐지만 기록하고 차단과 알림은 하지 않음
 }
 
 // AttackDetectorConfig는 공격 탐지기 설정입니다.
@@ -56,17 +62,32 @@ type AttackDetectorConfig struct {
 	// 알림 설정
 	AlertEnabled        bool          // 알림 활성화
 	AlertThreshold      Severity      // 알림 최소 심각도
+	AlertHandler        AttackAlertHandler // 알림을 받을 함수 (nil이면 로그만 남김)
+	
+	// 탐지 기록 모드와 라우트 그룹별 임계값 (SetSettings로 실행 중 변경 가능)
+	DryRun              bool               // 탐지만 기록하고 차단과 알림은 하지 않음
+	RouteGroups         []AttackRouteGroup // 경로 접두사별 임계값
 	
-	Logger *zap.Logger
+	// 검사 바이트 예산과 패턴별 검사 대상 (SetScanPolicy로 실행 중 변경 가능)
+	ScanPolicy          AttackScanPolicy
+	
+	Logger logging.Logger
 }
 
+// AttackAlertHandler는 알림 최소 심각도 이상의 공격이 탐지되었을 때 호출되는 함수입니다.
+type AttackAlertHandler func(ctx context.Context, request *AttackDetectionRequest, result *AttackDetectionResult)
+
 // AttackDetector는 공격 패턴 탐지기입니다.
 type AttackDetector struct {
 	config       *AttackDetectorConfig
 	redis        redis.UniversalClient
-	logger       *zap.Logger
+	logger       logging.Logger
 	patterns     []*AttackPattern
 	eventTracker *EventTracker
+
+	mu         sync.RWMutex
+	settings   AttackDetectionSettings
+	scanPolicy AttackScanPolicy
 }
 
 // DefaultAttackDetectorConfig는 기본 공격 탐지기 설정을 반환합니다.
@@ -80,6 +101,7 @@ func DefaultAttackDetectorConfig() *AttackDetectorConfig {
 		BlockDuration:       30 * time.Minute,
 		AlertEnabled:        true,
 		AlertThreshold:      SeverityHigh,
+		ScanPolicy:          DefaultAttackScanPolicy(),
 	}
 }
 
@@ -89,12 +111,28 @@ func NewAttackDetector(config *AttackDetectorConfig) *AttackDetector {
 		config = DefaultAttackDetectorConfig()
 	}
 
+	if config.Logger == nil {
+		config.Logger = logging.Nop()
+	}
+
 	detector := &AttackDetector{
 		config:       config,
 		redis:        config.Redis,
 		logger:       config.Logger,
 		eventTracker: config.EventTracker,
 		patterns:     make([]*AttackPattern, 0),
+		settings: AttackDetectionSettings{
+			Defaults: AttackThresholds{
+				MinConfidence:       config.MinConfidence,
+				BruteForceThreshold: config.BruteForceThreshold,
+				BruteForceWindow:    config.BruteForceWindow,
+				AutoBlockEnabled:    config.AutoBlockEnabled,
+				BlockDuration:       config.BlockDuration,
+				DryRun:              config.DryRun,
+			},
+			Groups: append([]AttackRouteGroup(nil), config.RouteGroups...),
+		},
+		scanPolicy: config.ScanPolicy.clone(),
 	}
 
 	// 기본 공격 패턴 로드
@@ -115,6 +153,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.9,
 			Description: "UNION SELECT를 이용한 SQL Injection 공격",
+			Targets:     []string{AttackTargetQuery, AttackTargetBody},
 			IsActive:    true,
 		},
 		{
@@ -125,6 +164,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.8,
 			Description: "OR 1=1 조건을 이용한 SQL Injection",
+			Targets:     []string{AttackTargetQuery, AttackTargetBody},
 			IsActive:    true,
 		},
 		{
@@ -135,6 +175,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityMedium,
 			Confidence:  0.6,
 			Description: "주석을 이용한 SQL Injection",
+			Targets:     []string{AttackTargetQuery},
 			IsActive:    true,
 		},
 		
@@ -147,6 +188,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.9,
 			Description: "Script 태그를 이용한 XSS 공격",
+			Targets:     []string{AttackTargetQuery, AttackTargetHeaders, AttackTargetBody},
 			IsActive:    true,
 		},
 		{
@@ -157,6 +199,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.8,
 			Description: "이벤트 핸들러를 이용한 XSS 공격",
+			Targets:     []string{AttackTargetQuery, AttackTargetHeaders, AttackTargetBody},
 			IsActive:    true,
 		},
 		{
@@ -167,6 +210,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityMedium,
 			Confidence:  0.7,
 			Description: "JavaScript URI를 이용한 XSS 공격",
+			Targets:     []string{AttackTargetQuery, AttackTargetHeaders, AttackTargetBody},
 			IsActive:    true,
 		},
 		
@@ -179,6 +223,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.6,
 			Description: "시스템 명령어 실행을 위한 특수문자",
+			Targets:     []string{AttackTargetURL},
 			IsActive:    true,
 		},
 		{
@@ -189,6 +234,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.8,
 			Description: "일반적인 시스템 명령어",
+			Targets:     []string{AttackTargetQuery},
 			IsActive:    true,
 		},
 		
@@ -201,6 +247,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityMedium,
 			Confidence:  0.8,
 			Description: "디렉토리 순회 공격",
+			Targets:     []string{AttackTargetURL, AttackTargetQuery},
 			IsActive:    true,
 		},
 		
@@ -225,6 +272,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityMedium,
 			Confidence:  0.6,
 			Description: "LDAP Injection 공격",
+			Targets:     []string{AttackTargetURL},
 			IsActive:    true,
 		},
 		
@@ -237,6 +285,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.8,
 			Description: "XXE (XML External Entity) 공격",
+			Targets:     []string{AttackTargetBody},
 			IsActive:    true,
 		},
 		
@@ -249,6 +298,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 			Severity:    SeverityHigh,
 			Confidence:  0.7,
 			Description: "서버사이드 템플릿 인젝션",
+			Targets:     []string{AttackTargetQuery, AttackTargetBody},
 			IsActive:    true,
 		},
 	}
@@ -257,9 +307,7 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 	for _, pattern := range defaultPatterns {
 		compiled, err := regexp.Compile(pattern.Pattern)
 		if err != nil {
-			ad.logger.Error("공격 패턴 정규표현식 컴파일 실패",
-				zap.String("pattern_id", pattern.ID),
-				zap.Error(err))
+			ad.logger.WithField("pattern_id", pattern.ID).WithError(err).Error("공격 패턴 정규표현식 컴파일 실패")
 			continue
 		}
 		pattern.Regex = compiled
@@ -270,8 +318,60 @@ func (ad *AttackDetector) loadDefaultPatterns() {
 	ad.patterns = defaultPatterns
 }
 
+// Settings는 현재 적용 중인 임계값과 라우트 그룹을 반환합니다.
+func (ad *AttackDetector) Settings() AttackDetectionSettings {
+	ad.mu.RLock()
+	defer ad.mu.RUnlock()
+	return ad.settings.clone()
+}
+
+// SetSettings는 임계값과 라우트 그룹을 바꿉니다 (다음 요청부터 적용).
+func (ad *AttackDetector) SetSettings(settings AttackDetectionSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	ad.mu.Lock()
+	defer ad.mu.Unlock()
+	ad.settings = settings.clone()
+	return nil
+}
+
+// ScanPolicy는 현재 적용 중인 검사 바이트 예산과 패턴별 검사 대상을 반환합니다.
+func (ad *AttackDetector) ScanPolicy() AttackScanPolicy {
+	ad.mu.RLock()
+	defer ad.mu.RUnlock()
+	return ad.scanPolicy.clone()
+}
+
+// BodyScanLimit는 Content-Type의 본문에서 읽어 검사할 최대 바이트를 반환합니다 (0이면 본문을 읽지 않음).
+func (ad *AttackDetector) BodyScanLimit(contentType string) int {
+	ad.mu.RLock()
+	defer ad.mu.RUnlock()
+	return ad.scanPolicy.BodyScanLimit(contentType)
+}
+
+// SetScanPolicy는 검사 바이트 예산과 패턴별 검사 대상을 바꿉니다 (다음 요청부터 적용).
+func (ad *AttackDetector) SetScanPolicy(policy AttackScanPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	ad.mu.Lock()
+	defer ad.mu.Unlock()
+	ad.scanPolicy = policy.clone()
+	return nil
+}
+
+// thresholdsFor는 경로에 적용할 라우트 그룹과 임계값을 반환합니다.
+func (ad *AttackDetector) thresholdsFor(path string) (string, AttackThresholds) {
+	ad.mu.RLock()
+	defer ad.mu.RUnlock()
+	return ad.settings.Resolve(path)
+}
+
 // DetectAttacks는 요청에서 공격 패턴을 탐지합니다.
+// 요청 경로가 속한 라우트 그룹의 임계값으로 판단하며, 탐지 기록 모드이면 차단과 알림 없이 기록만 남깁니다.
 func (ad *AttackDetector) DetectAttacks(ctx context.Context, request *AttackDetectionRequest) *AttackDetectionResult {
+	group, thresholds := ad.thresholdsFor(request.Path)
 	result := &AttackDetectionResult{
 		IsAttack:        false,
 		Patterns:        make([]*AttackPattern, 0),
@@ -279,26 +379,31 @@ func (ad *AttackDetector) DetectAttacks(ctx context.Context, request *AttackDete
 		Risk:           SeverityLow,
 		Evidence:        make([]string, 0),
 		Recommendations: make([]string, 0),
+		RouteGroup:      group,
+		DryRun:          thresholds.DryRun,
 	}
 
 	// 패턴 기반 탐지
-	ad.detectPatternBasedAttacks(request, result)
+	ad.mu.RLock()
+	policy := ad.scanPolicy
+	ad.mu.RUnlock()
+	ad.detectPatternBasedAttacks(request, result, policy)
 
 	// 행동 기반 탐지
 	ad.detectBehaviorBasedAttacks(ctx, request, result)
 
 	// 빈도 기반 탐지
-	ad.detectFrequencyBasedAttacks(ctx, request, result)
+	ad.detectFrequencyBasedAttacks(ctx, request, result, thresholds)
 
 	// 이상 행동 탐지
 	ad.detectAnomalyBasedAttacks(ctx, request, result)
 
 	// 최종 결과 계산
-	ad.calculateFinalResult(result)
+	ad.calculateFinalResult(result, thresholds)
 
 	// 공격 탐지 시 이벤트 기록 및 조치
 	if result.IsAttack {
-		ad.handleDetectedAttack(ctx, request, result)
+		ad.handleDetectedAttack(ctx, request, result, thresholds)
 	}
 
 	return result
@@ -316,34 +421,28 @@ type AttackDetectionRequest struct {
 	Query       string            `json:"query,omitempty"`
 	Headers     map[string]string `json:"headers,omitempty"`
 	Body        string            `json:"body,omitempty"`
+	ContentType string            `json:"content_type,omitempty"` // 본문 Content-Type (검사하지 않을 형식 판단)
 	Timestamp   time.Time         `json:"timestamp"`
 }
 
 // detectPatternBasedAttacks는 패턴 기반 공격을 탐지합니다.
-func (ad *AttackDetector) detectPatternBasedAttacks(request *AttackDetectionRequest, result *AttackDetectionResult) {
-	// 검사할 대상 문자열 구성
-	targets := []string{
-		request.URL,
-		request.Query,
-		request.Body,
-	}
-	
-	// 헤더도 검사 대상에 추가
-	for _, value := range request.Headers {
-		targets = append(targets, value)
-	}
+// 검사 예산을 넘는 부분과 검사하지 않는 형식의 본문은 건너뛰고, 패턴마다 지정한 대상만 검사합니다.
+func (ad *AttackDetector) detectPatternBasedAttacks(request *AttackDetectionRequest, result *AttackDetectionResult, policy AttackScanPolicy) {
+	// 예산 안에서 검사할 대상 문자열 구성
+	values := policy.targets(request)
 
 	// 각 패턴에 대해 검사
 	for _, pattern := range ad.patterns {
-		if !pattern.IsActive {
+		if !pattern.IsActive || pattern.Regex == nil {
 			continue
 		}
 
-		for _, target := range targets {
-			if target == "" {
-				continue
-			}
+		var targets []string
+		for _, kind := range policy.patternTargets(pattern) {
+			targets = append(targets, values[kind]...)
+		}
 
+		for _, target := range targets {
 			if pattern.Regex.MatchString(target) {
 				result.Patterns = append(result.Patterns, pattern)
 				result.Evidence = append(result.Evidence, 
@@ -352,10 +451,11 @@ func (ad *AttackDetector) detectPatternBasedAttacks(request *AttackDetectionRequ
 				// 신뢰도 누적
 				result.Confidence = ad.combineConfidence(result.Confidence, pattern.Confidence)
 				
-				ad.logger.Debug("공격 패턴 탐지됨",
-					zap.String("pattern_id", pattern.ID),
-					zap.String("pattern_name", pattern.Name),
-					zap.String("ip", request.IPAddress))
+				ad.logger.WithFields(logging.Fields{
+					"pattern_id":   pattern.ID,
+					"pattern_name": pattern.Name,
+					"ip":           request.IPAddress,
+				}).Debug("공격 패턴 탐지됨")
 			}
 		}
 	}
@@ -389,12 +489,13 @@ func (ad *AttackDetector) detectBehaviorBasedAttacks(ctx context.Context, reques
 }
 
 // detectFrequencyBasedAttacks는 빈도 기반 공격을 탐지합니다.
-func (ad *AttackDetector) detectFrequencyBasedAttacks(ctx context.Context, request *AttackDetectionRequest, result *AttackDetectionResult) {
+// 무차별 대입은 로그인 실패 기록(RecordLoginFailure)이 센 IP별 실패 횟수로만 판단합니다.
+func (ad *AttackDetector) detectFrequencyBasedAttacks(ctx context.Context, request *AttackDetectionRequest, result *AttackDetectionResult, thresholds AttackThresholds) {
 	now := time.Now()
-	windowStart := now.Add(-ad.config.BruteForceWindow)
+	windowStart := now.Add(-thresholds.BruteForceWindow)
 
 	// 브루트포스 공격 탐지
-	if ad.isBruteForceAttack(ctx, request, windowStart, now) {
+	if ad.bruteForceFailures(ctx, request) > int64(thresholds.BruteForceThreshold) {
 		result.Evidence = append(result.Evidence, "Brute force attack pattern detected")
 		result.Confidence = ad.combineConfidence(result.Confidence, 0.9)
 		result.AttackType = "brute_force"
@@ -430,9 +531,9 @@ func (ad *AttackDetector) detectAnomalyBasedAttacks(ctx context.Context, request
 }
 
 // calculateFinalResult는 최종 결과를 계산합니다.
-func (ad *AttackDetector) calculateFinalResult(result *AttackDetectionResult) {
+func (ad *AttackDetector) calculateFinalResult(result *AttackDetectionResult, thresholds AttackThresholds) {
 	// 최종 공격 여부 판단
-	result.IsAttack = result.Confidence >= ad.config.MinConfidence
+	result.IsAttack = result.Confidence >= thresholds.MinConfidence
 
 	// 위험도 계산
 	if result.Confidence >= 0.9 {
@@ -462,7 +563,8 @@ func (ad *AttackDetector) calculateFinalResult(result *AttackDetectionResult) {
 }
 
 // handleDetectedAttack은 탐지된 공격을 처리합니다.
-func (ad *AttackDetector) handleDetectedAttack(ctx context.Context, request *AttackDetectionRequest, result *AttackDetectionResult) {
+// 탐지 기록 모드이면 이벤트와 로그만 남기고 IP를 차단하거나 알리지 않습니다.
+func (ad *AttackDetector) handleDetectedAttack(ctx context.Context, request *AttackDetectionRequest, result *AttackDetectionResult, thresholds AttackThresholds) {
 	// 보안 이벤트 기록
 	if ad.eventTracker != nil {
 		event := &SecurityEvent{
@@ -481,30 +583,154 @@ func (ad *AttackDetector) handleDetectedAttack(ctx context.Context, request *Att
 				"confidence":    result.Confidence,
 				"patterns":      len(result.Patterns),
 				"evidence":      result.Evidence,
+				"route_group":   result.RouteGroup,
+				"dry_run":       result.DryRun,
 			},
 		}
 
 		if err := ad.eventTracker.RecordEvent(ctx, event); err != nil {
-			ad.logger.Error("공격 이벤트 기록 실패", zap.Error(err))
+			ad.logger.WithContext(ctx).WithError(err).Error("공격 이벤트 기록 실패")
 		}
 	}
 
+	fields := logging.Fields{
+		"ip":          request.IPAddress,
+		"user_id":     request.UserID,
+		"path":        request.Path,
+		"route_group": result.RouteGroup,
+		"attack_type": result.AttackType,
+		"confidence":  result.Confidence,
+		"risk":        string(result.Risk),
+		"evidence":    result.Evidence,
+	}
+	if result.DryRun {
+		ad.logger.WithContext(ctx).WithFields(fields).Warn("공격 패턴 탐지됨 (탐지 기록 모드, 차단하지 않음)")
+		return
+	}
+
 	// 자동 차단
-	if ad.config.AutoBlockEnabled && result.Risk >= SeverityHigh {
-		ad.blockIP(ctx, request.IPAddress, ad.config.BlockDuration)
+	if thresholds.AutoBlockEnabled && result.Risk.AtLeast(SeverityHigh) {
+		ad.blockIP(ctx, request.IPAddress, thresholds.BlockDuration)
 	}
 
 	// 알림 발송
-	if ad.config.AlertEnabled && result.Risk >= ad.config.AlertThreshold {
+	if ad.config.AlertEnabled && result.Risk.AtLeast(ad.config.AlertThreshold) {
 		ad.sendAlert(ctx, request, result)
 	}
 
-	ad.logger.Warn("공격 패턴 탐지됨",
-		zap.String("ip", request.IPAddress),
-		zap.String("user_id", request.UserID),
-		zap.String("attack_type", result.AttackType),
-		zap.Float64("confidence", result.Confidence),
-		zap.String("risk", string(result.Risk)))
+	ad.logger.WithContext(ctx).WithFields(fields).Warn("공격 패턴 탐지됨")
+}
+
+// LoginFailure는 로그인 실패 정보입니다.
+type LoginFailure struct {
+	Username    string
+	UserID      string
+	IPAddress   string
+	UserAgent   string
+	RequestPath string
+	Failures    int       // 계정의 연속 실패 횟수
+	Locked      bool      // 이번 실패로 계정이 잠겼는지 여부
+	LockedUntil time.Time // 잠금 해제 시각
+}
+
+// RecordLoginFailure는 로그인 실패를 보안 이벤트로 기록하고 무차별 대입 공격 여부를 반환합니다.
+// 계정이 잠겼거나 같은 IP의 실패가 BruteForceWindow 안에 BruteForceThreshold를 넘으면
+// 무차별 대입 이벤트를 남기고, 자동 차단이 켜져 있으면 IP를 차단합니다.
+// 임계값은 요청 경로의 라우트 그룹을 따르며, 탐지 기록 모드이면 차단과 알림 없이 기록만 남깁니다.
+func (ad *AttackDetector) RecordLoginFailure(ctx context.Context, failure *LoginFailure) bool {
+	group, thresholds := ad.thresholdsFor(failure.RequestPath)
+
+	ad.recordEvent(ctx, &SecurityEvent{
+		Type:        EventTypeAuthFailure,
+		Severity:    SeverityLow,
+		Source:      failure.IPAddress,
+		Target:      failure.Username,
+		UserID:      failure.UserID,
+		IPAddress:   failure.IPAddress,
+		UserAgent:   failure.UserAgent,
+		RequestPath: failure.RequestPath,
+		Method:      "POST",
+		Details: map[string]interface{}{
+			"failures": failure.Failures,
+		},
+	})
+
+	bruteForce := failure.Locked || ad.isBruteForceAttack(ctx, &AttackDetectionRequest{
+		IPAddress: failure.IPAddress,
+		Path:      failure.RequestPath,
+	}, thresholds)
+	if !bruteForce {
+		return false
+	}
+
+	details := map[string]interface{}{
+		"failures":    failure.Failures,
+		"locked":      failure.Locked,
+		"route_group": group,
+		"dry_run":     thresholds.DryRun,
+	}
+	if failure.Locked {
+		details["locked_until"] = failure.LockedUntil
+	}
+	ad.recordEvent(ctx, &SecurityEvent{
+		Type:        EventTypeBruteForce,
+		Severity:    SeverityHigh,
+		Source:      failure.IPAddress,
+		Target:      failure.Username,
+		UserID:      failure.UserID,
+		IPAddress:   failure.IPAddress,
+		UserAgent:   failure.UserAgent,
+		RequestPath: failure.RequestPath,
+		Method:      "POST",
+		Details:     details,
+	})
+
+	fields := logging.Fields{
+		"ip":          failure.IPAddress,
+		"username":    failure.Username,
+		"failures":    failure.Failures,
+		"locked":      failure.Locked,
+		"route_group": group,
+	}
+	if thresholds.DryRun {
+		ad.logger.WithContext(ctx).WithFields(fields).Warn("무차별 대입 로그인 탐지됨 (탐지 기록 모드, 차단하지 않음)")
+		return true
+	}
+
+	if thresholds.AutoBlockEnabled && failure.IPAddress != "" {
+		ad.blockIP(ctx, failure.IPAddress, thresholds.BlockDuration)
+	}
+
+	ad.logger.WithContext(ctx).WithFields(fields).Warn("무차별 대입 로그인 탐지됨")
+
+	if ad.config.AlertEnabled && SeverityHigh.AtLeast(ad.config.AlertThreshold) {
+		ad.sendAlert(ctx, &AttackDetectionRequest{
+			UserID:    failure.UserID,
+			IPAddress: failure.IPAddress,
+			UserAgent: failure.UserAgent,
+			Method:    "POST",
+			Path:      failure.RequestPath,
+			Timestamp: time.Now(),
+		}, &AttackDetectionResult{
+			IsAttack:   true,
+			AttackType: "brute_force",
+			Confidence: 1,
+			Risk:       SeverityHigh,
+			Evidence:   []string{fmt.Sprintf("%s 계정 로그인 %d회 실패", failure.Username, failure.Failures)},
+			RouteGroup: group,
+		})
+	}
+	return true
+}
+
+// recordEvent는 이벤트 추적기가 있으면 보안 이벤트를 기록합니다.
+func (ad *AttackDetector) recordEvent(ctx context.Context, event *SecurityEvent) {
+	if ad.eventTracker == nil {
+		return
+	}
+	if err := ad.eventTracker.RecordEvent(ctx, event); err != nil {
+		ad.logger.WithContext(ctx).WithField("type", string(event.Type)).WithError(err).Error("보안 이벤트 기록 실패")
+	}
 }
 
 // 헬퍼 메서드들
@@ -593,7 +819,8 @@ func (ad *AttackDetector) isSuspiciousPath(path string) bool {
 	return false
 }
 
-func (ad *AttackDetector) isBruteForceAttack(ctx context.Context, request *AttackDetectionRequest, start, end time.Time) bool {
+// isBruteForceAttack는 로그인 실패 하나를 IP별로 세고 임계값을 넘었는지 확인합니다.
+func (ad *AttackDetector) isBruteForceAttack(ctx context.Context, request *AttackDetectionRequest, thresholds AttackThresholds) bool {
 	if ad.redis == nil {
 		return false
 	}
@@ -610,10 +837,26 @@ func (ad *AttackDetector) isBruteForceAttack(ctx context.Context, request *Attac
 	}
 
 	if count == 1 {
-		ad.redis.Expire(ctx, key, ad.config.BruteForceWindow)
+		ad.redis.Expire(ctx, key, thresholds.BruteForceWindow)
+	}
+
+	return count > int64(thresholds.BruteForceThreshold)
+}
+
+// bruteForceFailures는 세지 않고 IP별 로그인 실패 횟수만 읽습니다 (요청 검사에서 실패를 두 번 세지 않도록).
+func (ad *AttackDetector) bruteForceFailures(ctx context.Context, request *AttackDetectionRequest) int64 {
+	if ad.redis == nil {
+		return 0
+	}
+	if !strings.Contains(request.Path, "login") && !strings.Contains(request.Path, "auth") {
+		return 0
 	}
 
-	return count > int64(ad.config.BruteForceThreshold)
+	count, err := ad.redis.Get(ctx, fmt.Sprintf("attack:bruteforce:%s", request.IPAddress)).Int64()
+	if err != nil {
+		return 0
+	}
+	return count
 }
 
 func (ad *AttackDetector) isScanningAttack(ctx context.Context, request *AttackDetectionRequest, start, end time.Time) bool {
@@ -669,12 +912,12 @@ func (ad *AttackDetector) isSessionAnomaly(ctx context.Context, request *AttackD
 func (ad *AttackDetector) generateRecommendations(result *AttackDetectionResult) []string {
 	recommendations := make([]string, 0)
 
-	if result.Risk >= SeverityHigh {
+	if result.Risk.AtLeast(SeverityHigh) {
 		recommendations = append(recommendations, "즉시 IP 차단 고려")
 		recommendations = append(recommendations, "보안 팀에 즉시 알림")
 	}
 
-	if result.Risk >= SeverityMedium {
+	if result.Risk.AtLeast(SeverityMedium) {
 		recommendations = append(recommendations, "추가 모니터링 적용")
 		recommendations = append(recommendations, "세션 무효화 고려")
 	}
@@ -695,22 +938,27 @@ func (ad *AttackDetector) blockIP(ctx context.Context, ipAddress string, duratio
 	blockKey := fmt.Sprintf("blocked:ip:%s", ipAddress)
 	err := ad.redis.Set(ctx, blockKey, time.Now().Unix(), duration).Err()
 	if err != nil {
-		ad.logger.Error("IP 차단 실패", zap.String("ip", ipAddress), zap.Error(err))
+		ad.logger.WithContext(ctx).WithField("ip", ipAddress).WithError(err).Error("IP 차단 실패")
 		return
 	}
 
-	ad.logger.Warn("IP 자동 차단됨", 
-		zap.String("ip", ipAddress),
-		zap.Duration("duration", duration))
+	ad.logger.WithContext(ctx).WithFields(logging.Fields{
+		"ip":       ipAddress,
+		"duration": duration.String(),
+	}).Warn("IP 자동 차단됨")
 }
 
 func (ad *AttackDetector) sendAlert(ctx context.Context, request *AttackDetectionRequest, result *AttackDetectionResult) {
-	// 여기에 실제 알림 발송 로직 구현 (이메일, 슬랙, 웹훅 등)
-	ad.logger.Error("보안 공격 알림",
-		zap.String("attack_type", result.AttackType),
-		zap.String("ip", request.IPAddress),
-		zap.Float64("confidence", result.Confidence),
-		zap.Strings("evidence", result.Evidence))
+	ad.logger.WithContext(ctx).WithFields(logging.Fields{
+		"attack_type": result.AttackType,
+		"ip":          request.IPAddress,
+		"confidence":  result.Confidence,
+		"evidence":    result.Evidence,
+	}).Error("보안 공격 알림")
+
+	if ad.config.AlertHandler != nil {
+		ad.config.AlertHandler(ctx, request, result)
+	}
 }
 
 // GetPatterns는 현재 로드된 공격 패턴 목록을 반환합니다.
@@ -720,6 +968,10 @@ func (ad *AttackDetector) GetPatterns() []*AttackPattern {
 
 // AddPattern은 새로운 공격 패턴을 추가합니다.
 func (ad *AttackDetector) AddPattern(pattern *AttackPattern) error {
+	if err := validateAttackTargets(pattern.Targets); err != nil {
+		return err
+	}
+
 	// 정규표현식 컴파일
 	compiled, err := regexp.Compile(pattern.Pattern)
 	if err != nil {
@@ -736,6 +988,10 @@ func (ad *AttackDetector) AddPattern(pattern *AttackPattern) error {
 
 // UpdatePattern은 기존 공격 패턴을 업데이트합니다.
 func (ad *AttackDetector) UpdatePattern(patternID string, updates *AttackPattern) error {
+	if err := validateAttackTargets(updates.Targets); err != nil {
+		return err
+	}
+
 	for i, pattern := range ad.patterns {
 		if pattern.ID == patternID {
 			// 정규표현식 재컴파일 (패턴이 변경된 경우)
@@ -764,6 +1020,9 @@ func (ad *AttackDetector) UpdatePattern(patternID string, updates *AttackPattern
 			if updates.Description != "" {
 				ad.patterns[i].Description = updates.Description
 			}
+			if len(updates.Targets) > 0 {
+				ad.patterns[i].Targets = updates.Targets
+			}
 
 			ad.patterns[i].UpdatedAt = time.Now()
 			return nil
@@ -803,17 +1062,89 @@ func (ad *AttackDetector) GetStatistics(ctx context.Context) (map[string]interfa
 	// 최근 24시간 공격 탐지 수
 	// (이 부분은 실제 이벤트 추적기와 연동하여 구현)
 
+	settings := ad.Settings()
 	stats["active_patterns"] = len(ad.patterns)
 	stats["detector_config"] = map[string]interface{}{
-		"min_confidence":        ad.config.MinConfidence,
-		"brute_force_threshold": ad.config.BruteForceThreshold,
-		"auto_block_enabled":    ad.config.AutoBlockEnabled,
+		"min_confidence":        settings.Defaults.MinConfidence,
+		"brute_force_threshold": settings.Defaults.BruteForceThreshold,
+		"auto_block_enabled":    settings.Defaults.AutoBlockEnabled,
 		"alert_enabled":         ad.config.AlertEnabled,
+		"dry_run":               settings.Defaults.DryRun,
+		"route_groups":          len(settings.Groups),
 	}
 
 	return stats, nil
 }
 
+// BlockedIP는 자동 차단 중인 IP입니다.
+type BlockedIP struct {
+	IPAddress string    `json:"ip_address"`
+	BlockedAt time.Time `json:"blocked_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// EventTracker는 보안 이벤트를 기록하는 추적기를 반환합니다 (Redis가 없으면 nil).
+func (ad *AttackDetector) EventTracker() *EventTracker {
+	return ad.eventTracker
+}
+
+// BlockedIPs는 자동 차단 중인 IP를 차단 해제가 늦은 것부터 반환합니다 (Redis가 없으면 빈 목록).
+func (ad *AttackDetector) BlockedIPs(ctx context.Context) ([]BlockedIP, error) {
+	blocked := make([]BlockedIP, 0)
+	if ad.redis == nil {
+		return blocked, nil
+	}
+
+	var cursor uint64
+	var keys []string
+	for {
+		found, nextCursor, err := ad.redis.Scan(ctx, cursor, "blocked:ip:*", 100).Result()
+		if err != nil {
+			return nil, fmt.Errorf("차단 IP 조회 실패: %w", err)
+		}
+		keys = append(keys, found...)
+		cursor = nextCursor
+		if cursor == 0 {
+			break
+		}
+	}
+	if len(keys) == 0 {
+		return blocked, nil
+	}
+
+	pipe := ad.redis.Pipeline()
+	values := make([]*redis.StringCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		values[i] = pipe.Get(ctx, key)
+		ttls[i] = pipe.TTL(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("차단 IP 조회 실패: %w", err)
+	}
+
+	now := time.Now()
+	for i, key := range keys {
+		blockedAt, err := values[i].Int64()
+		if err != nil {
+			continue // 조회 사이에 차단이 풀림
+		}
+		ip := BlockedIP{
+			IPAddress: strings.TrimPrefix(key, "blocked:ip:"),
+			BlockedAt: time.Unix(blockedAt, 0),
+		}
+		if ttl := ttls[i].Val(); ttl > 0 {
+			ip.ExpiresAt = now.Add(ttl)
+		}
+		blocked = append(blocked, ip)
+	}
+
+	sort.Slice(blocked, func(i, j int) bool {
+		return blocked[i].ExpiresAt.After(blocked[j].ExpiresAt)
+	})
+	return blocked, nil
+}
+
 func (ad *AttackDetector) countKeysByPattern(ctx context.Context, pattern string) (int, error) {
 	var cursor uint64
 	var count int
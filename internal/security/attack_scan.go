@@ -0,0 +1,158 @@
+package security
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// 공격 패턴 검사 대상
+const (
+	AttackTargetURL     = "url"     // 요청 URL
+	AttackTargetQuery   = "query"   // 쿼리 문자열
+	AttackTargetHeaders = "headers" // 헤더 값
+	AttackTargetBody    = "body"    // 요청 본문
+)
+
+// attackTargets는 검사 대상을 지정하지 않은 패턴이 검사하는 대상입니다.
+var attackTargets = []string{AttackTargetURL, AttackTargetQuery, AttackTargetHeaders, AttackTargetBody}
+
+// AttackScanPolicy는 패턴 검사에 쓰는 바이트 예산과 패턴별 검사 대상입니다.
+// 예산을 넘는 부분은 검사하지 않으므로 요청 크기와 상관없이 검사 비용이 예산에 비례합니다.
+type AttackScanPolicy struct {
+	MaxURLBytes      int                 `json:"max_url_bytes"`      // URL과 쿼리 문자열을 각각 검사할 최대 바이트 (0이면 검사하지 않음)
+	MaxHeaderBytes   int                 `json:"max_header_bytes"`   // 헤더 값 하나를 검사할 최대 바이트 (0이면 검사하지 않음)
+	MaxBodyBytes     int                 `json:"max_body_bytes"`     // 본문을 검사할 최대 바이트 (0이면 검사하지 않음)
+	SkipContentTypes []string            `json:"skip_content_types"` // 본문을 검사하지 않을 Content-Type 접두사 (예: text/x-, multipart/form-data)
+	PatternTargets   map[string][]string `json:"pattern_targets"`    // 패턴 ID별 검사 대상 (패턴의 기본 대상 대신 적용)
+}
+
+// DefaultAttackScanPolicy는 기본 검사 예산을 반환합니다.
+// diff와 소스 코드, 파일 업로드, CSP 위반 보고 본문은 정상 요청도 패턴에 걸리기 쉬워 검사하지 않습니다.
+func DefaultAttackScanPolicy() AttackScanPolicy {
+	return AttackScanPolicy{
+		MaxURLBytes:    8 * 1024,
+		MaxHeaderBytes: 4 * 1024,
+		MaxBodyBytes:   16 * 1024,
+		SkipContentTypes: []string{
+			"multipart/form-data",
+			"application/octet-stream",
+			"application/zip",
+			"application/gzip",
+			"application/x-tar",
+			"text/x-",
+			"application/csp-report",
+			"application/reports+json",
+		},
+	}
+}
+
+// Validate는 예산 범위와 검사 대상 이름을 확인합니다.
+func (p AttackScanPolicy) Validate() error {
+	if p.MaxURLBytes < 0 || p.MaxHeaderBytes < 0 || p.MaxBodyBytes < 0 {
+		return fmt.Errorf("검사 바이트 예산은 0 이상이어야 합니다")
+	}
+	for id, targets := range p.PatternTargets {
+		if len(targets) == 0 {
+			return fmt.Errorf("%s 패턴의 검사 대상이 비어 있습니다", id)
+		}
+		if err := validateAttackTargets(targets); err != nil {
+			return fmt.Errorf("%s 패턴: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// ScansBody는 Content-Type의 본문을 검사하는지 확인합니다.
+func (p AttackScanPolicy) ScansBody(contentType string) bool {
+	if p.MaxBodyBytes <= 0 {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+	for _, skip := range p.SkipContentTypes {
+		if skip != "" && strings.HasPrefix(mediaType, strings.ToLower(skip)) {
+			return false
+		}
+	}
+	return true
+}
+
+// BodyScanLimit는 Content-Type의 본문에서 읽어 검사할 최대 바이트를 반환합니다 (0이면 본문을 읽지 않음).
+func (p AttackScanPolicy) BodyScanLimit(contentType string) int {
+	if !p.ScansBody(contentType) {
+		return 0
+	}
+	return p.MaxBodyBytes
+}
+
+// targets는 예산 안에서 검사할 요청 값을 대상별로 반환합니다.
+func (p AttackScanPolicy) targets(request *AttackDetectionRequest) map[string][]string {
+	targets := make(map[string][]string, len(attackTargets))
+	if value := limitBytes(request.URL, p.MaxURLBytes); value != "" {
+		targets[AttackTargetURL] = []string{value}
+	}
+	if value := limitBytes(request.Query, p.MaxURLBytes); value != "" {
+		targets[AttackTargetQuery] = []string{value}
+	}
+	for _, value := range request.Headers {
+		if value = limitBytes(value, p.MaxHeaderBytes); value != "" {
+			targets[AttackTargetHeaders] = append(targets[AttackTargetHeaders], value)
+		}
+	}
+	if p.ScansBody(request.ContentType) {
+		if value := limitBytes(request.Body, p.MaxBodyBytes); value != "" {
+			targets[AttackTargetBody] = []string{value}
+		}
+	}
+	return targets
+}
+
+// patternTargets는 패턴이 검사할 대상을 반환합니다 (정책, 패턴 기본값, 모든 대상 순).
+func (p AttackScanPolicy) patternTargets(pattern *AttackPattern) []string {
+	if targets, ok := p.PatternTargets[pattern.ID]; ok {
+		return targets
+	}
+	if len(pattern.Targets) > 0 {
+		return pattern.Targets
+	}
+	return attackTargets
+}
+
+// clone은 목록과 맵을 복사한 정책을 반환합니다.
+func (p AttackScanPolicy) clone() AttackScanPolicy {
+	p.SkipContentTypes = append([]string(nil), p.SkipContentTypes...)
+	if p.PatternTargets != nil {
+		targets := make(map[string][]string, len(p.PatternTargets))
+		for id, values := range p.PatternTargets {
+			targets[id] = append([]string(nil), values...)
+		}
+		p.PatternTargets = targets
+	}
+	return p
+}
+
+// validateAttackTargets는 검사 대상 이름을 확인합니다.
+func validateAttackTargets(targets []string) error {
+	for _, target := range targets {
+		switch target {
+		case AttackTargetURL, AttackTargetQuery, AttackTargetHeaders, AttackTargetBody:
+		default:
+			return fmt.Errorf("알 수 없는 검사 대상입니다: %q", target)
+		}
+	}
+	return nil
+}
+
+// limitBytes는 문자열을 최대 바이트까지 자릅니다 (0 이하이면 빈 문자열).
+func limitBytes(value string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	if len(value) > max {
+		return value[:max]
+	}
+	return value
+}
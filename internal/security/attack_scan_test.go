@@ -0,0 +1,109 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scanRequest 사설 IP에서 낮 시간에 보낸 요청 (패턴 외 탐지 요소 제외)
+func scanRequest(body, contentType string) *AttackDetectionRequest {
+	return &AttackDetectionRequest{
+		IPAddress:   "10.0.0.1",
+		UserAgent:   "Mozilla/5.0",
+		Method:      "POST",
+		URL:         "/api/v1/tasks",
+		Path:        "/api/v1/tasks",
+		Body:        body,
+		ContentType: contentType,
+		Timestamp:   time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestAttackDetector_ScanBudget(t *testing.T) {
+	detector := NewAttackDetector(nil)
+	ctx := context.Background()
+	payload := `{"q": "1' UNION SELECT password FROM users"}`
+
+	result := detector.DetectAttacks(ctx, scanRequest(payload, "application/json"))
+	assert.True(t, result.IsAttack)
+
+	// 예산을 넘는 부분은 검사하지 않음
+	padded := strings.Repeat(" ", DefaultAttackScanPolicy().MaxBodyBytes) + payload
+	result = detector.DetectAttacks(ctx, scanRequest(padded, "application/json"))
+	assert.False(t, result.IsAttack)
+
+	// diff와 소스 코드 본문은 검사하지 않음
+	for _, contentType := range []string{"text/x-diff", "text/x-python; charset=utf-8", "multipart/form-data; boundary=x"} {
+		result = detector.DetectAttacks(ctx, scanRequest(payload, contentType))
+		assert.False(t, result.IsAttack, contentType)
+	}
+	assert.Zero(t, detector.BodyScanLimit("text/x-diff"))
+	assert.Equal(t, DefaultAttackScanPolicy().MaxBodyBytes, detector.BodyScanLimit("application/json"))
+}
+
+func TestAttackDetector_PatternTargets(t *testing.T) {
+	detector := NewAttackDetector(nil)
+	ctx := context.Background()
+
+	// 명령어 특수문자 패턴은 기본적으로 URL만 검사하므로 코드가 담긴 본문은 통과
+	code := `{"prompt": "run: if (x > 0) { echo $HOME | wc -l; }"}`
+	result := detector.DetectAttacks(ctx, scanRequest(code, "application/json"))
+	assert.Empty(t, result.Patterns)
+
+	policy := detector.ScanPolicy()
+	policy.PatternTargets = map[string][]string{"cmdi_001": {AttackTargetBody}}
+	require.NoError(t, detector.SetScanPolicy(policy))
+	result = detector.DetectAttacks(ctx, scanRequest(code, "application/json"))
+	require.NotEmpty(t, result.Patterns)
+	assert.Equal(t, "cmdi_001", result.Patterns[0].ID)
+
+	policy.PatternTargets = map[string][]string{"cmdi_001": {"cookie"}}
+	assert.Error(t, detector.SetScanPolicy(policy))
+	assert.Error(t, detector.AddPattern(&AttackPattern{ID: "custom", Pattern: "x", Targets: []string{"cookie"}}))
+}
+
+// BenchmarkAttackDetector_Body 본문 크기가 커져도 검사 비용이 예산에서 멈추는지 확인합니다.
+func BenchmarkAttackDetector_Body(b *testing.B) {
+	detector := NewAttackDetector(nil)
+	ctx := context.Background()
+	line := "func main() { fmt.Println(\"hello\") } // 정상 코드\n"
+
+	for _, size := range []int{1 << 10, 64 << 10, 1 << 20, 8 << 20} {
+		body := strings.Repeat(line, size/len(line)+1)[:size]
+		for _, contentType := range []string{"application/json", "text/x-go"} {
+			request := scanRequest(body, contentType)
+			b.Run(fmt.Sprintf("%dKB/%s", size>>10, contentType), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					detector.DetectAttacks(ctx, request)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkAttackDetector_Headers 헤더 값이 길어도 값마다 예산만큼만 검사하는지 확인합니다.
+func BenchmarkAttackDetector_Headers(b *testing.B) {
+	detector := NewAttackDetector(nil)
+	ctx := context.Background()
+
+	for _, size := range []int{256, 4 << 10, 256 << 10} {
+		request := scanRequest("", "")
+		request.Headers = map[string]string{
+			"Referer": "https://example.com/" + strings.Repeat("a", size),
+			"Cookie":  strings.Repeat("k=v; ", size/5),
+		}
+		b.Run(fmt.Sprintf("%dB", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				detector.DetectAttacks(ctx, request)
+			}
+		})
+	}
+}
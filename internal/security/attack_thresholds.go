@@ -0,0 +1,121 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultAttackRouteGroup는 어느 라우트 그룹에도 속하지 않는 경로의 그룹 이름입니다.
+const DefaultAttackRouteGroup = "default"
+
+// AttackThresholds는 요청 경로에 적용할 공격 탐지 임계값입니다.
+type AttackThresholds struct {
+	MinConfidence       float64       `json:"min_confidence"`        // 공격으로 판단할 최소 신뢰도
+	BruteForceThreshold int           `json:"brute_force_threshold"` // 무차별 대입으로 판단할 IP별 실패 횟수
+	BruteForceWindow    time.Duration `json:"brute_force_window"`    // 실패 횟수를 세는 시간 윈도우
+	AutoBlockEnabled    bool          `json:"auto_block_enabled"`    // 공격 IP 자동 차단
+	BlockDuration       time.Duration `json:"block_duration"`        // 차단 지속 시간
+	DryRun              bool          `json:"dry_run"`               // 탐지만 기록하고 차단과 알림은 하지 않음
+}
+
+// AttackRouteGroup은 경로 접두사가 같은 요청에 기본 임계값 대신 적용할 임계값입니다.
+// 0인 값은 기본 임계값을 따르며, 여러 그룹에 속하면 접두사가 가장 긴 그룹을 적용합니다.
+type AttackRouteGroup struct {
+	Name                string        `json:"name"`
+	PathPrefix          string        `json:"path_prefix"`
+	MinConfidence       float64       `json:"min_confidence,omitempty"`
+	BruteForceThreshold int           `json:"brute_force_threshold,omitempty"`
+	BruteForceWindow    time.Duration `json:"brute_force_window,omitempty"`
+	BlockDuration       time.Duration `json:"block_duration,omitempty"`
+	DisableAutoBlock    bool          `json:"disable_auto_block,omitempty"` // 이 그룹에서는 자동 차단하지 않음
+	DryRun              bool          `json:"dry_run,omitempty"`            // 이 그룹만 탐지 기록 모드로 동작
+}
+
+// AttackDetectionSettings는 실행 중에 바꿀 수 있는 공격 탐지 임계값입니다.
+type AttackDetectionSettings struct {
+	Defaults AttackThresholds   `json:"defaults"`
+	Groups   []AttackRouteGroup `json:"groups"`
+}
+
+// Validate는 임계값 범위와 라우트 그룹 이름, 접두사 중복을 확인합니다.
+func (s AttackDetectionSettings) Validate() error {
+	if s.Defaults.MinConfidence <= 0 || s.Defaults.MinConfidence > 1 {
+		return fmt.Errorf("최소 신뢰도는 0보다 크고 1 이하여야 합니다: %v", s.Defaults.MinConfidence)
+	}
+	if s.Defaults.BruteForceThreshold < 1 {
+		return fmt.Errorf("무차별 대입 임계값은 1 이상이어야 합니다: %d", s.Defaults.BruteForceThreshold)
+	}
+	if s.Defaults.BruteForceWindow <= 0 || s.Defaults.BlockDuration <= 0 {
+		return fmt.Errorf("무차별 대입 윈도우와 차단 시간은 0보다 커야 합니다")
+	}
+
+	names := make(map[string]bool, len(s.Groups))
+	prefixes := make(map[string]bool, len(s.Groups))
+	for _, group := range s.Groups {
+		if group.Name == "" || group.Name == DefaultAttackRouteGroup {
+			return fmt.Errorf("라우트 그룹 이름이 올바르지 않습니다: %q", group.Name)
+		}
+		if !strings.HasPrefix(group.PathPrefix, "/") {
+			return fmt.Errorf("%s 그룹의 경로 접두사는 /로 시작해야 합니다: %q", group.Name, group.PathPrefix)
+		}
+		if names[group.Name] {
+			return fmt.Errorf("라우트 그룹 이름이 중복되었습니다: %s", group.Name)
+		}
+		if prefixes[group.PathPrefix] {
+			return fmt.Errorf("라우트 그룹 경로 접두사가 중복되었습니다: %s", group.PathPrefix)
+		}
+		names[group.Name] = true
+		prefixes[group.PathPrefix] = true
+
+		if group.MinConfidence < 0 || group.MinConfidence > 1 {
+			return fmt.Errorf("%s 그룹의 최소 신뢰도는 0과 1 사이여야 합니다: %v", group.Name, group.MinConfidence)
+		}
+		if group.BruteForceThreshold < 0 || group.BruteForceWindow < 0 || group.BlockDuration < 0 {
+			return fmt.Errorf("%s 그룹의 임계값은 0 이상이어야 합니다", group.Name)
+		}
+	}
+	return nil
+}
+
+// Resolve는 경로에 적용할 라우트 그룹 이름과 임계값을 반환합니다.
+func (s AttackDetectionSettings) Resolve(path string) (string, AttackThresholds) {
+	var matched *AttackRouteGroup
+	for i := range s.Groups {
+		group := &s.Groups[i]
+		if !strings.HasPrefix(path, group.PathPrefix) {
+			continue
+		}
+		if matched == nil || len(group.PathPrefix) > len(matched.PathPrefix) {
+			matched = group
+		}
+	}
+
+	thresholds := s.Defaults
+	if matched == nil {
+		return DefaultAttackRouteGroup, thresholds
+	}
+	if matched.MinConfidence > 0 {
+		thresholds.MinConfidence = matched.MinConfidence
+	}
+	if matched.BruteForceThreshold > 0 {
+		thresholds.BruteForceThreshold = matched.BruteForceThreshold
+	}
+	if matched.BruteForceWindow > 0 {
+		thresholds.BruteForceWindow = matched.BruteForceWindow
+	}
+	if matched.BlockDuration > 0 {
+		thresholds.BlockDuration = matched.BlockDuration
+	}
+	if matched.DisableAutoBlock {
+		thresholds.AutoBlockEnabled = false
+	}
+	thresholds.DryRun = thresholds.DryRun || matched.DryRun
+	return matched.Name, thresholds
+}
+
+// clone은 라우트 그룹 목록을 복사한 설정을 반환합니다.
+func (s AttackDetectionSettings) clone() AttackDetectionSettings {
+	s.Groups = append([]AttackRouteGroup(nil), s.Groups...)
+	return s
+}
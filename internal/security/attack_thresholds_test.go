@@ -0,0 +1,95 @@
+package security
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttackDetectionSettings_Resolve(t *testing.T) {
+	settings := AttackDetectionSettings{
+		Defaults: AttackThresholds{
+			MinConfidence:       0.7,
+			BruteForceThreshold: 10,
+			BruteForceWindow:    5 * time.Minute,
+			AutoBlockEnabled:    true,
+			BlockDuration:       30 * time.Minute,
+		},
+		Groups: []AttackRouteGroup{
+			{Name: "api", PathPrefix: "/api/v1", MinConfidence: 0.8},
+			{Name: "auth", PathPrefix: "/api/v1/auth", BruteForceThreshold: 3},
+			{Name: "ws", PathPrefix: "/ws", MinConfidence: 0.95, DisableAutoBlock: true, DryRun: true},
+		},
+	}
+	require.NoError(t, settings.Validate())
+
+	// 접두사가 가장 긴 그룹을 적용하고 0인 값은 기본값을 따름
+	group, thresholds := settings.Resolve("/api/v1/auth/login")
+	assert.Equal(t, "auth", group)
+	assert.Equal(t, 3, thresholds.BruteForceThreshold)
+	assert.Equal(t, 0.7, thresholds.MinConfidence)
+	assert.True(t, thresholds.AutoBlockEnabled)
+
+	group, thresholds = settings.Resolve("/ws")
+	assert.Equal(t, "ws", group)
+	assert.False(t, thresholds.AutoBlockEnabled)
+	assert.True(t, thresholds.DryRun)
+
+	group, thresholds = settings.Resolve("/health")
+	assert.Equal(t, DefaultAttackRouteGroup, group)
+	assert.Equal(t, settings.Defaults, thresholds)
+
+	invalid := settings.clone()
+	invalid.Groups = append(invalid.Groups, AttackRouteGroup{Name: "login", PathPrefix: "/api/v1/auth"})
+	assert.Error(t, invalid.Validate())
+	invalid.Groups = []AttackRouteGroup{{Name: DefaultAttackRouteGroup, PathPrefix: "/"}}
+	assert.Error(t, invalid.Validate())
+}
+
+func TestAttackDetector_DryRun(t *testing.T) {
+	var alerts int
+	config := DefaultAttackDetectorConfig()
+	config.AlertThreshold = SeverityMedium
+	config.AlertHandler = func(ctx context.Context, request *AttackDetectionRequest, result *AttackDetectionResult) {
+		alerts++
+	}
+	config.RouteGroups = []AttackRouteGroup{{Name: "ws", PathPrefix: "/ws", DryRun: true}}
+	detector := NewAttackDetector(config)
+
+	request := func(path string) *AttackDetectionRequest {
+		return &AttackDetectionRequest{
+			IPAddress: "10.0.0.1",
+			Method:    "GET",
+			URL:       path,
+			Path:      path,
+			Query:     "q=1' UNION SELECT password FROM users--",
+			Timestamp: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		}
+	}
+
+	// 탐지 기록 모드 그룹은 공격으로 판단해도 알리지 않음
+	result := detector.DetectAttacks(context.Background(), request("/ws"))
+	assert.True(t, result.IsAttack)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, "ws", result.RouteGroup)
+	assert.Zero(t, alerts)
+
+	result = detector.DetectAttacks(context.Background(), request("/api/v1/tasks"))
+	assert.True(t, result.IsAttack)
+	assert.False(t, result.DryRun)
+	assert.Equal(t, 1, alerts)
+
+	// 실행 중에 임계값을 높이면 같은 요청도 공격으로 판단하지 않음
+	settings := detector.Settings()
+	settings.Groups = append(settings.Groups, AttackRouteGroup{Name: "tasks", PathPrefix: "/api/v1/tasks", MinConfidence: 1})
+	require.NoError(t, detector.SetSettings(settings))
+	result = detector.DetectAttacks(context.Background(), request("/api/v1/tasks"))
+	assert.False(t, result.IsAttack)
+	assert.Equal(t, "tasks", result.RouteGroup)
+
+	settings.Defaults.MinConfidence = 0
+	assert.Error(t, detector.SetSettings(settings))
+}
@@ -0,0 +1,292 @@
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CSP 위반 보고 형식
+const (
+	CSPReportContentType  = "application/csp-report"   // report-uri 지시문으로 보내는 보고
+	CSPReportsContentType = "application/reports+json" // Reporting API(report-to)로 보내는 보고
+)
+
+// maxCSPReportFieldBytes는 보고 필드 하나에 보관할 최대 바이트입니다 (original-policy처럼 긴 값을 자름).
+const maxCSPReportFieldBytes = 2048
+
+// CSPReport는 브라우저가 보낸 Content-Security-Policy 위반 보고입니다.
+// report-uri 형식과 Reporting API 형식을 같은 필드로 정규화합니다.
+type CSPReport struct {
+	ID                 string    `json:"id"`
+	DocumentURI        string    `json:"document_uri"`
+	Referrer           string    `json:"referrer,omitempty"`
+	BlockedURI         string    `json:"blocked_uri"`
+	EffectiveDirective string    `json:"effective_directive"`
+	ViolatedDirective  string    `json:"violated_directive,omitempty"`
+	OriginalPolicy     string    `json:"original_policy,omitempty"`
+	Disposition        string    `json:"disposition"` // enforce 또는 report
+	SourceFile         string    `json:"source_file,omitempty"`
+	LineNumber         int       `json:"line_number,omitempty"`
+	ColumnNumber       int       `json:"column_number,omitempty"`
+	StatusCode         int       `json:"status_code,omitempty"`
+	ScriptSample       string    `json:"script_sample,omitempty"`
+	IPAddress          string    `json:"ip_address"`
+	UserAgent          string    `json:"user_agent"`
+	ReceivedAt         time.Time `json:"received_at"`
+}
+
+// cspLegacyReport는 report-uri 지시문으로 받는 보고 본문입니다.
+type cspLegacyReport struct {
+	Report struct {
+		DocumentURI        string `json:"document-uri"`
+		Referrer           string `json:"referrer"`
+		BlockedURI         string `json:"blocked-uri"`
+		EffectiveDirective string `json:"effective-directive"`
+		ViolatedDirective  string `json:"violated-directive"`
+		OriginalPolicy     string `json:"original-policy"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"source-file"`
+		LineNumber         int    `json:"line-number"`
+		ColumnNumber       int    `json:"column-number"`
+		StatusCode         int    `json:"status-code"`
+		ScriptSample       string `json:"script-sample"`
+	} `json:"csp-report"`
+}
+
+// cspReportingAPIReport는 Reporting API로 받는 보고 하나입니다.
+type cspReportingAPIReport struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	UserAgent string `json:"user_agent"`
+	Body      struct {
+		DocumentURL        string `json:"documentURL"`
+		Referrer           string `json:"referrer"`
+		BlockedURL         string `json:"blockedURL"`
+		EffectiveDirective string `json:"effectiveDirective"`
+		OriginalPolicy     string `json:"originalPolicy"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"sourceFile"`
+		LineNumber         int    `json:"lineNumber"`
+		ColumnNumber       int    `json:"columnNumber"`
+		StatusCode         int    `json:"statusCode"`
+		Sample             string `json:"sample"`
+	} `json:"body"`
+}
+
+// ParseCSPReports는 Content-Type에 따라 위반 보고 본문을 해석합니다.
+// Reporting API 본문에서는 csp-violation 유형만 꺼내며, 위반 지시문이 없는 보고는 잘못된 보고로 봅니다.
+func ParseCSPReports(contentType string, body []byte) ([]CSPReport, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+
+	var reports []CSPReport
+	switch mediaType {
+	case CSPReportsContentType:
+		var entries []cspReportingAPIReport
+		if err := json.Unmarshal(body, &entries); err != nil {
+			return nil, fmt.Errorf("위반 보고를 해석할 수 없습니다: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.Type != "csp-violation" {
+				continue
+			}
+			documentURI := entry.Body.DocumentURL
+			if documentURI == "" {
+				documentURI = entry.URL
+			}
+			reports = append(reports, CSPReport{
+				DocumentURI:        documentURI,
+				Referrer:           entry.Body.Referrer,
+				BlockedURI:         entry.Body.BlockedURL,
+				EffectiveDirective: entry.Body.EffectiveDirective,
+				OriginalPolicy:     entry.Body.OriginalPolicy,
+				Disposition:        entry.Body.Disposition,
+				SourceFile:         entry.Body.SourceFile,
+				LineNumber:         entry.Body.LineNumber,
+				ColumnNumber:       entry.Body.ColumnNumber,
+				StatusCode:         entry.Body.StatusCode,
+				ScriptSample:       entry.Body.Sample,
+				UserAgent:          entry.UserAgent,
+			})
+		}
+	case CSPReportContentType, "application/json":
+		var legacy cspLegacyReport
+		if err := json.Unmarshal(body, &legacy); err != nil {
+			return nil, fmt.Errorf("위반 보고를 해석할 수 없습니다: %w", err)
+		}
+		report := legacy.Report
+		directive := report.EffectiveDirective
+		if fields := strings.Fields(report.ViolatedDirective); directive == "" && len(fields) > 0 {
+			// 오래된 브라우저는 violated-directive만 보냄 (예: "script-src 'self'")
+			directive = fields[0]
+		}
+		reports = append(reports, CSPReport{
+			DocumentURI:        report.DocumentURI,
+			Referrer:           report.Referrer,
+			BlockedURI:         report.BlockedURI,
+			EffectiveDirective: directive,
+			ViolatedDirective:  report.ViolatedDirective,
+			OriginalPolicy:     report.OriginalPolicy,
+			Disposition:        report.Disposition,
+			SourceFile:         report.SourceFile,
+			LineNumber:         report.LineNumber,
+			ColumnNumber:       report.ColumnNumber,
+			StatusCode:         report.StatusCode,
+			ScriptSample:       report.ScriptSample,
+		})
+	default:
+		return nil, fmt.Errorf("지원하지 않는 위반 보고 형식입니다: %s", mediaType)
+	}
+
+	for _, report := range reports {
+		if report.EffectiveDirective == "" {
+			return nil, fmt.Errorf("위반 지시문이 없는 보고입니다")
+		}
+	}
+	return reports, nil
+}
+
+// CSPReportFilter는 위반 보고 조회 조건입니다.
+type CSPReportFilter struct {
+	Directive   string    // effective-directive가 같은 보고만
+	Disposition string    // enforce 또는 report
+	Since       time.Time // 이후에 받은 보고만
+	Limit       int       // 최대 개수 (0이면 모두)
+}
+
+// CSPViolationSummary는 지시문과 차단된 주소가 같은 위반 보고의 집계입니다.
+type CSPViolationSummary struct {
+	EffectiveDirective string    `json:"effective_directive"`
+	BlockedURI         string    `json:"blocked_uri"`
+	Count              int       `json:"count"`
+	LastSeen           time.Time `json:"last_seen"`
+}
+
+// CSPReportStore는 보안 대시보드용으로 최근 위반 보고를 보관합니다.
+// 보관 수가 최대에 이르면 가장 오래된 보고부터 버립니다.
+type CSPReportStore struct {
+	mu         sync.RWMutex
+	reports    []CSPReport // 받은 순서 (오래된 것부터)
+	maxReports int
+	sequence   int64
+	received   int64
+	dropped    int64
+}
+
+// NewCSPReportStore는 최대 maxReports개를 보관하는 위반 보고 저장소를 생성합니다.
+func NewCSPReportStore(maxReports int) *CSPReportStore {
+	if maxReports <= 0 {
+		maxReports = 1000
+	}
+	return &CSPReportStore{
+		reports:    make([]CSPReport, 0, maxReports),
+		maxReports: maxReports,
+	}
+}
+
+// Add는 위반 보고를 보관하고 ID와 받은 시각을 채운 보고를 반환합니다.
+func (s *CSPReportStore) Add(report CSPReport) CSPReport {
+	report = truncateCSPReport(report)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sequence++
+	s.received++
+	report.ID = fmt.Sprintf("csp_%d", s.sequence)
+	if report.ReceivedAt.IsZero() {
+		report.ReceivedAt = time.Now()
+	}
+	if len(s.reports) >= s.maxReports {
+		s.reports = append(s.reports[:0], s.reports[1:]...)
+		s.dropped++
+	}
+	s.reports = append(s.reports, report)
+	return report
+}
+
+// List는 조건에 맞는 보고를 최근 것부터 반환합니다.
+func (s *CSPReportStore) List(filter CSPReportFilter) []CSPReport {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	reports := make([]CSPReport, 0)
+	for i := len(s.reports) - 1; i >= 0; i-- {
+		report := s.reports[i]
+		if filter.Directive != "" && report.EffectiveDirective != filter.Directive {
+			continue
+		}
+		if filter.Disposition != "" && report.Disposition != filter.Disposition {
+			continue
+		}
+		if !filter.Since.IsZero() && report.ReceivedAt.Before(filter.Since) {
+			continue
+		}
+		reports = append(reports, report)
+		if filter.Limit > 0 && len(reports) >= filter.Limit {
+			break
+		}
+	}
+	return reports
+}
+
+// Summary는 보관 중인 보고를 지시문과 차단된 주소별로 집계해 많은 것부터 반환합니다.
+func (s *CSPReportStore) Summary() []CSPViolationSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	index := make(map[string]int)
+	summaries := make([]CSPViolationSummary, 0)
+	for _, report := range s.reports {
+		key := report.EffectiveDirective + " " + report.BlockedURI
+		i, ok := index[key]
+		if !ok {
+			i = len(summaries)
+			index[key] = i
+			summaries = append(summaries, CSPViolationSummary{
+				EffectiveDirective: report.EffectiveDirective,
+				BlockedURI:         report.BlockedURI,
+			})
+		}
+		summaries[i].Count++
+		if report.ReceivedAt.After(summaries[i].LastSeen) {
+			summaries[i].LastSeen = report.ReceivedAt
+		}
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].Count > summaries[j].Count
+	})
+	return summaries
+}
+
+// Stats는 받은 보고 수, 최대 보관 수를 넘어 버린 보고 수, 보관 중인 보고 수를 반환합니다.
+func (s *CSPReportStore) Stats() (received, dropped int64, stored int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.received, s.dropped, len(s.reports)
+}
+
+// MaxReports는 최대 보관 수를 반환합니다.
+func (s *CSPReportStore) MaxReports() int {
+	return s.maxReports
+}
+
+// truncateCSPReport는 문자열 필드를 보관 한도까지 자릅니다.
+func truncateCSPReport(report CSPReport) CSPReport {
+	for _, field := range []*string{
+		&report.DocumentURI, &report.Referrer, &report.BlockedURI, &report.EffectiveDirective,
+		&report.ViolatedDirective, &report.OriginalPolicy, &report.Disposition, &report.SourceFile,
+		&report.ScriptSample, &report.UserAgent,
+	} {
+		*field = limitBytes(*field, maxCSPReportFieldBytes)
+	}
+	return report
+}
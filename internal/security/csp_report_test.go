@@ -0,0 +1,87 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSPReports(t *testing.T) {
+	t.Run("report-uri 형식", func(t *testing.T) {
+		body := `{"csp-report": {"document-uri": "https://aicli.local/workspaces", "blocked-uri": "inline", "violated-directive": "script-src-elem 'self'", "disposition": "report", "line-number": 12, "script-sample": "alert(1)"}}`
+		reports, err := ParseCSPReports(CSPReportContentType, []byte(body))
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, "script-src-elem", reports[0].EffectiveDirective) // violated-directive에서 지시문만
+		assert.Equal(t, "inline", reports[0].BlockedURI)
+		assert.Equal(t, 12, reports[0].LineNumber)
+		assert.Equal(t, "alert(1)", reports[0].ScriptSample)
+	})
+
+	t.Run("Reporting API 형식", func(t *testing.T) {
+		body := `[
+			{"type": "csp-violation", "url": "https://aicli.local/", "user_agent": "Mozilla/5.0", "body": {"blockedURL": "https://cdn.example.com/x.js", "effectiveDirective": "script-src-elem", "disposition": "enforce"}},
+			{"type": "deprecation", "url": "https://aicli.local/", "body": {}}
+		]`
+		reports, err := ParseCSPReports(CSPReportsContentType+"; charset=utf-8", []byte(body))
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, "https://aicli.local/", reports[0].DocumentURI)
+		assert.Equal(t, "https://cdn.example.com/x.js", reports[0].BlockedURI)
+		assert.Equal(t, "enforce", reports[0].Disposition)
+		assert.Equal(t, "Mozilla/5.0", reports[0].UserAgent)
+	})
+
+	t.Run("잘못된 보고", func(t *testing.T) {
+		_, err := ParseCSPReports("text/plain", []byte(`{}`))
+		assert.Error(t, err)
+		_, err = ParseCSPReports(CSPReportContentType, []byte(`{"csp-report": {}}`))
+		assert.Error(t, err, "위반 지시문 없음")
+		_, err = ParseCSPReports(CSPReportContentType, []byte(`not json`))
+		assert.Error(t, err)
+	})
+}
+
+func TestCSPReportStore(t *testing.T) {
+	store := NewCSPReportStore(3)
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i, directive := range []string{"script-src-elem", "img-src", "script-src-elem", "script-src-elem"} {
+		store.Add(CSPReport{
+			EffectiveDirective: directive,
+			BlockedURI:         "inline",
+			Disposition:        "enforce",
+			ReceivedAt:         base.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	// 최대 보관 수를 넘으면 가장 오래된 보고를 버림
+	received, dropped, stored := store.Stats()
+	assert.Equal(t, int64(4), received)
+	assert.Equal(t, int64(1), dropped)
+	assert.Equal(t, 3, stored)
+
+	reports := store.List(CSPReportFilter{})
+	require.Len(t, reports, 3)
+	assert.Equal(t, "csp_4", reports[0].ID, "최근 것부터")
+	assert.Equal(t, "csp_2", reports[2].ID)
+
+	assert.Len(t, store.List(CSPReportFilter{Directive: "img-src"}), 1)
+	assert.Len(t, store.List(CSPReportFilter{Limit: 2}), 2)
+	assert.Len(t, store.List(CSPReportFilter{Since: base.Add(3 * time.Minute)}), 1)
+	assert.Empty(t, store.List(CSPReportFilter{Disposition: "report"}))
+
+	summary := store.Summary()
+	require.Len(t, summary, 2)
+	assert.Equal(t, "script-src-elem", summary[0].EffectiveDirective)
+	assert.Equal(t, 2, summary[0].Count)
+	assert.Equal(t, base.Add(3*time.Minute), summary[0].LastSeen)
+
+	// 긴 필드는 보관 한도까지 자름
+	report := store.Add(CSPReport{EffectiveDirective: "script-src", OriginalPolicy: strings.Repeat("a", 10000)})
+	assert.Len(t, report.OriginalPolicy, maxCSPReportFieldBytes)
+	assert.Equal(t, fmt.Sprintf("csp_%d", 5), report.ID)
+}
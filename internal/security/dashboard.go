@@ -0,0 +1,293 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// 보안 대시보드 이벤트 분류
+const (
+	DashboardCategoryAttack     = "attack"      // 요청 공격 패턴
+	DashboardCategoryBruteForce = "brute_force" // 로그인 실패와 무차별 대입
+	DashboardCategoryAnomaly    = "anomaly"     // 세션, 기기, 위치 이상과 의심 활동
+)
+
+// dashboardCategoryTypes는 분류별 보안 이벤트 타입입니다.
+var dashboardCategoryTypes = map[string][]EventType{
+	DashboardCategoryAttack:     {EventTypeAttackPattern, EventTypeMaliciousRequest},
+	DashboardCategoryBruteForce: {EventTypeAuthFailure, EventTypeBruteForce},
+	DashboardCategoryAnomaly: {
+		EventTypeSessionAnomaly, EventTypeDeviceChange, EventTypeLocationChange,
+		EventTypeSuspiciousActivity, EventTypePrivilegeEscalation,
+	},
+}
+
+// dashboardEventTypes는 대시보드가 집계하는 모든 이벤트 타입입니다.
+var dashboardEventTypes = []EventType{
+	EventTypeAttackPattern, EventTypeMaliciousRequest,
+	EventTypeAuthFailure, EventTypeBruteForce,
+	EventTypeSessionAnomaly, EventTypeDeviceChange, EventTypeLocationChange,
+	EventTypeSuspiciousActivity, EventTypePrivilegeEscalation,
+	EventTypeRateLimitExceeded, EventTypeCSRFViolation, EventTypeIPBlocked,
+}
+
+// maxDashboardSample은 공격 유형, 출처처럼 이벤트 본문이 필요한 집계에 읽는 최대 이벤트 수입니다.
+const maxDashboardSample = 1000
+
+// dashboardTopN은 상위 출처, 대상 목록의 길이입니다.
+const dashboardTopN = 10
+
+// DashboardEventTypes는 분류의 이벤트 타입을 반환합니다 (빈 분류는 모든 타입).
+func DashboardEventTypes(category string) ([]EventType, error) {
+	if category == "" {
+		return dashboardEventTypes, nil
+	}
+	types, ok := dashboardCategoryTypes[category]
+	if !ok {
+		return nil, fmt.Errorf("알 수 없는 이벤트 분류입니다: %s", category)
+	}
+	return types, nil
+}
+
+// DashboardOverview는 기간 동안의 보안 이벤트 요약입니다.
+type DashboardOverview struct {
+	Since        time.Time         `json:"since"`
+	Until        time.Time         `json:"until"`
+	EventsByType map[EventType]int `json:"events_by_type"`
+	Attacks      AttackSummary     `json:"attacks"`
+	BruteForce   BruteForceSummary `json:"brute_force"`
+	Anomalies    AnomalySummary    `json:"anomalies"`
+	BlockedIPs   int               `json:"blocked_ips"`
+}
+
+// AttackSummary는 요청 공격 탐지 요약입니다.
+// 공격 유형과 출처는 최근 이벤트 maxDashboardSample개로 집계하며, 넘으면 Sampled가 참입니다.
+type AttackSummary struct {
+	Total      int            `json:"total"`
+	ByType     map[string]int `json:"by_type"`
+	DryRun     int            `json:"dry_run"` // 탐지 기록 모드로 거부하지 않은 공격
+	TopSources []SourceStat   `json:"top_sources"`
+	Sampled    bool           `json:"sampled"`
+}
+
+// BruteForceSummary는 로그인 실패와 무차별 대입 탐지 요약입니다.
+type BruteForceSummary struct {
+	FailedLogins int          `json:"failed_logins"`
+	Detections   int          `json:"detections"`
+	TopSources   []SourceStat `json:"top_sources"` // 로그인 실패가 많은 IP
+	TopTargets   []TargetStat `json:"top_targets"` // 로그인 실패가 많은 계정
+	Sampled      bool         `json:"sampled"`
+}
+
+// AnomalySummary는 이상 이벤트 요약입니다.
+type AnomalySummary struct {
+	Total      int               `json:"total"`
+	ByType     map[EventType]int `json:"by_type"`
+	Unresolved int               `json:"unresolved"`
+	Sampled    bool              `json:"sampled"`
+}
+
+// DashboardTrend는 분류의 구간별 이벤트 수입니다.
+type DashboardTrend struct {
+	Category   string            `json:"category,omitempty"`
+	Since      time.Time         `json:"since"`
+	Until      time.Time         `json:"until"`
+	IntervalMS int64             `json:"interval_ms"`
+	Points     []EventTrendPoint `json:"points"`
+}
+
+// SecurityDashboard는 이벤트 추적기와 공격 탐지기의 기록을 보안 대시보드용으로 집계합니다.
+type SecurityDashboard struct {
+	tracker  *EventTracker
+	detector *AttackDetector
+}
+
+// NewSecurityDashboard는 새로운 보안 대시보드 집계기를 생성합니다.
+// detector가 nil이면 차단 IP는 집계하지 않습니다.
+func NewSecurityDashboard(tracker *EventTracker, detector *AttackDetector) *SecurityDashboard {
+	return &SecurityDashboard{
+		tracker:  tracker,
+		detector: detector,
+	}
+}
+
+// Overview는 기간 동안의 공격, 무차별 대입, 이상 이벤트와 차단 IP 수를 집계합니다.
+func (d *SecurityDashboard) Overview(ctx context.Context, since, until time.Time) (*DashboardOverview, error) {
+	counts, err := d.tracker.CountEvents(ctx, dashboardEventTypes, since, until)
+	if err != nil {
+		return nil, err
+	}
+
+	attacks, err := d.tracker.RecentEvents(ctx, dashboardCategoryTypes[DashboardCategoryAttack], since, maxDashboardSample)
+	if err != nil {
+		return nil, err
+	}
+	failures, err := d.tracker.RecentEvents(ctx, []EventType{EventTypeAuthFailure}, since, maxDashboardSample)
+	if err != nil {
+		return nil, err
+	}
+	anomalies, err := d.tracker.RecentEvents(ctx, dashboardCategoryTypes[DashboardCategoryAnomaly], since, maxDashboardSample)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &DashboardOverview{
+		Since:        since,
+		Until:        until,
+		EventsByType: counts,
+		Attacks:      summarizeAttacks(attacks, counts),
+		BruteForce:   summarizeBruteForce(failures, counts),
+		Anomalies:    summarizeAnomalies(anomalies, counts),
+	}
+	if d.detector != nil {
+		blocked, err := d.detector.BlockedIPs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		overview.BlockedIPs = len(blocked)
+	}
+	return overview, nil
+}
+
+// RecentEvents는 분류의 이벤트를 최근 것부터 최대 limit개 반환합니다 (빈 분류는 모든 타입).
+func (d *SecurityDashboard) RecentEvents(ctx context.Context, category string, since time.Time, limit int) ([]*SecurityEvent, error) {
+	types, err := DashboardEventTypes(category)
+	if err != nil {
+		return nil, err
+	}
+	return d.tracker.RecentEvents(ctx, types, since, limit)
+}
+
+// BlockedIPs는 자동 차단 중인 IP를 반환합니다.
+func (d *SecurityDashboard) BlockedIPs(ctx context.Context) ([]BlockedIP, error) {
+	if d.detector == nil {
+		return []BlockedIP{}, nil
+	}
+	return d.detector.BlockedIPs(ctx)
+}
+
+// Trend는 분류의 이벤트 수를 interval 간격으로 나눠 반환합니다 (빈 분류는 모든 타입).
+func (d *SecurityDashboard) Trend(ctx context.Context, category string, since, until time.Time, interval time.Duration) (*DashboardTrend, error) {
+	types, err := DashboardEventTypes(category)
+	if err != nil {
+		return nil, err
+	}
+	points, err := d.tracker.EventTrend(ctx, types, since, until, interval)
+	if err != nil {
+		return nil, err
+	}
+	return &DashboardTrend{
+		Category:   category,
+		Since:      since,
+		Until:      until,
+		IntervalMS: interval.Milliseconds(),
+		Points:     points,
+	}, nil
+}
+
+// summarizeAttacks는 공격 이벤트를 공격 유형과 출처 IP별로 집계합니다.
+func summarizeAttacks(events []*SecurityEvent, counts map[EventType]int) AttackSummary {
+	summary := AttackSummary{
+		ByType: make(map[string]int),
+	}
+	for _, eventType := range dashboardCategoryTypes[DashboardCategoryAttack] {
+		summary.Total += counts[eventType]
+	}
+
+	sources := make(map[string]int)
+	for _, event := range events {
+		attackType, _ := event.Details["attack_type"].(string)
+		if attackType == "" {
+			attackType = string(event.Type)
+		}
+		summary.ByType[attackType]++
+		if dryRun, _ := event.Details["dry_run"].(bool); dryRun {
+			summary.DryRun++
+		}
+		if event.IPAddress != "" {
+			sources[event.IPAddress]++
+		}
+	}
+	summary.TopSources = topSourceStats(sources, dashboardTopN)
+	summary.Sampled = summary.Total > len(events)
+	return summary
+}
+
+// summarizeBruteForce는 로그인 실패 이벤트를 IP와 계정별로 집계합니다.
+func summarizeBruteForce(failures []*SecurityEvent, counts map[EventType]int) BruteForceSummary {
+	summary := BruteForceSummary{
+		FailedLogins: counts[EventTypeAuthFailure],
+		Detections:   counts[EventTypeBruteForce],
+	}
+
+	sources := make(map[string]int)
+	targets := make(map[string]int)
+	for _, event := range failures {
+		if event.IPAddress != "" {
+			sources[event.IPAddress]++
+		}
+		if event.Target != "" {
+			targets[event.Target]++
+		}
+	}
+	summary.TopSources = topSourceStats(sources, dashboardTopN)
+	summary.TopTargets = topTargetStats(targets, dashboardTopN)
+	summary.Sampled = summary.FailedLogins > len(failures)
+	return summary
+}
+
+// summarizeAnomalies는 이상 이벤트를 타입별로 집계하고 해결하지 않은 이벤트를 셉니다.
+func summarizeAnomalies(events []*SecurityEvent, counts map[EventType]int) AnomalySummary {
+	summary := AnomalySummary{
+		ByType: make(map[EventType]int),
+	}
+	for _, eventType := range dashboardCategoryTypes[DashboardCategoryAnomaly] {
+		summary.ByType[eventType] = counts[eventType]
+		summary.Total += counts[eventType]
+	}
+	for _, event := range events {
+		if !event.Resolved {
+			summary.Unresolved++
+		}
+	}
+	summary.Sampled = summary.Total > len(events)
+	return summary
+}
+
+// topSourceStats는 횟수가 많은 출처부터 최대 n개를 반환합니다 (횟수가 같으면 이름순).
+func topSourceStats(counts map[string]int, n int) []SourceStat {
+	stats := make([]SourceStat, 0, len(counts))
+	for source, count := range counts {
+		stats = append(stats, SourceStat{Source: source, Count: count})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Source < stats[j].Source
+	})
+	if len(stats) > n {
+		stats = stats[:n]
+	}
+	return stats
+}
+
+// topTargetStats는 횟수가 많은 대상부터 최대 n개를 반환합니다 (횟수가 같으면 이름순).
+func topTargetStats(counts map[string]int, n int) []TargetStat {
+	stats := make([]TargetStat, 0, len(counts))
+	for target, count := range counts {
+		stats = append(stats, TargetStat{Target: target, Count: count})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Target < stats[j].Target
+	})
+	if len(stats) > n {
+		stats = stats[:n]
+	}
+	return stats
+}
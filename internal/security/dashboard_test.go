@@ -0,0 +1,118 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardEventTypes(t *testing.T) {
+	types, err := DashboardEventTypes(DashboardCategoryBruteForce)
+	require.NoError(t, err)
+	assert.Equal(t, []EventType{EventTypeAuthFailure, EventTypeBruteForce}, types)
+
+	types, err = DashboardEventTypes("")
+	require.NoError(t, err)
+	assert.Contains(t, types, EventTypeCSRFViolation)
+
+	_, err = DashboardEventTypes("unknown")
+	assert.Error(t, err)
+}
+
+func TestSummarizeDashboardEvents(t *testing.T) {
+	attack := func(ip, attackType string, dryRun bool) *SecurityEvent {
+		return &SecurityEvent{
+			Type:      EventTypeAttackPattern,
+			IPAddress: ip,
+			Details:   map[string]interface{}{"attack_type": attackType, "dry_run": dryRun},
+		}
+	}
+	counts := map[EventType]int{
+		EventTypeAttackPattern:    3,
+		EventTypeMaliciousRequest: 2, // 본문을 읽지 않은 이벤트 포함
+		EventTypeAuthFailure:      3,
+		EventTypeBruteForce:       1,
+		EventTypeSessionAnomaly:   1,
+		EventTypeLocationChange:   1,
+	}
+
+	attacks := summarizeAttacks([]*SecurityEvent{
+		attack("10.0.0.1", "sql_injection", false),
+		attack("10.0.0.1", "xss", true),
+		attack("10.0.0.2", "sql_injection", false),
+	}, counts)
+	assert.Equal(t, 5, attacks.Total)
+	assert.Equal(t, map[string]int{"sql_injection": 2, "xss": 1}, attacks.ByType)
+	assert.Equal(t, 1, attacks.DryRun)
+	assert.Equal(t, []SourceStat{{Source: "10.0.0.1", Count: 2}, {Source: "10.0.0.2", Count: 1}}, attacks.TopSources)
+	assert.True(t, attacks.Sampled)
+
+	bruteForce := summarizeBruteForce([]*SecurityEvent{
+		{Type: EventTypeAuthFailure, IPAddress: "10.0.0.3", Target: "admin"},
+		{Type: EventTypeAuthFailure, IPAddress: "10.0.0.3", Target: "admin"},
+		{Type: EventTypeAuthFailure, IPAddress: "10.0.0.4", Target: "alice"},
+	}, counts)
+	assert.Equal(t, 3, bruteForce.FailedLogins)
+	assert.Equal(t, 1, bruteForce.Detections)
+	assert.Equal(t, "10.0.0.3", bruteForce.TopSources[0].Source)
+	assert.Equal(t, []TargetStat{{Target: "admin", Count: 2}, {Target: "alice", Count: 1}}, bruteForce.TopTargets)
+	assert.False(t, bruteForce.Sampled)
+
+	anomalies := summarizeAnomalies([]*SecurityEvent{
+		{Type: EventTypeSessionAnomaly},
+		{Type: EventTypeLocationChange, Resolved: true},
+	}, counts)
+	assert.Equal(t, 2, anomalies.Total)
+	assert.Equal(t, 1, anomalies.ByType[EventTypeLocationChange])
+	assert.Equal(t, 1, anomalies.Unresolved)
+}
+
+func TestSecurityDashboard_Redis(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 1})
+	ctx := context.Background()
+	if _, err := rdb.Ping(ctx).Result(); err != nil {
+		t.Skip("Redis not available, skipping test")
+	}
+	defer rdb.Close()
+	defer rdb.FlushDB(ctx)
+	require.NoError(t, rdb.FlushDB(ctx).Err())
+
+	tracker := NewEventTracker(&EventTrackerConfig{Redis: rdb})
+	detector := NewAttackDetector(&AttackDetectorConfig{Redis: rdb, EventTracker: tracker})
+	dashboard := NewSecurityDashboard(tracker, detector)
+
+	now := time.Now().Truncate(time.Hour)
+	for i, eventType := range []EventType{EventTypeAttackPattern, EventTypeAuthFailure, EventTypeAuthFailure} {
+		require.NoError(t, tracker.RecordEvent(ctx, &SecurityEvent{
+			ID:        fmt.Sprintf("evt_test_%d", i),
+			Type:      eventType,
+			Severity:  SeverityMedium,
+			IPAddress: "10.0.0.1",
+			Timestamp: now.Add(-time.Duration(i) * time.Hour),
+			Details:   map[string]interface{}{"attack_type": "xss"},
+		}))
+	}
+	detector.blockIP(ctx, "10.0.0.1", time.Hour)
+
+	overview, err := dashboard.Overview(ctx, now.Add(-3*time.Hour), now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, overview.Attacks.ByType["xss"])
+	assert.Equal(t, 2, overview.BruteForce.FailedLogins)
+	assert.Equal(t, 1, overview.BlockedIPs)
+
+	trend, err := dashboard.Trend(ctx, DashboardCategoryBruteForce, now.Add(-3*time.Hour), now.Add(time.Hour), time.Hour)
+	require.NoError(t, err)
+	require.Len(t, trend.Points, 4)
+	assert.Equal(t, 1, trend.Points[1].ByType[EventTypeAuthFailure]) // now-2h
+	assert.Equal(t, 1, trend.Points[2].ByType[EventTypeAuthFailure]) // now-1h
+
+	events, err := dashboard.RecentEvents(ctx, "", now.Add(-3*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, EventTypeAttackPattern, events[0].Type, "최근 것부터")
+}
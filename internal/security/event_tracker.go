@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/go-redis/redis/v8"
-	"go.uber.org/zap"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // EventType은 보안 이벤트 타입을 정의합니다.
@@ -38,6 +40,19 @@ const (
 	SeverityCritical Severity = "critical"
 )
 
+// severityRanks는 심각도 비교에 쓰는 순위입니다 (문자열 순서와 다름).
+var severityRanks = map[Severity]int{
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// AtLeast는 심각도가 other 이상인지 확인합니다.
+func (s Severity) AtLeast(other Severity) bool {
+	return severityRanks[s] >= severityRanks[other]
+}
+
 // SecurityEvent는 보안 이벤트를 나타냅니다.
 type SecurityEvent struct {
 	ID          string                 `json:"id" redis:"id"`
@@ -65,14 +80,14 @@ type EventTrackerConfig struct {
 	MaxEvents       int                   // 최대 이벤트 수
 	AlertThresholds map[EventType]int     // 알림 임계값
 	EnableAlerts    bool                  // 알림 활성화
-	Logger          *zap.Logger
+	Logger          logging.Logger
 }
 
 // EventTracker는 보안 이벤트 추적기입니다.
 type EventTracker struct {
 	config *EventTrackerConfig
 	redis  redis.UniversalClient
-	logger *zap.Logger
+	logger logging.Logger
 }
 
 // EventFilter는 이벤트 필터링을 위한 구조체입니다.
@@ -120,6 +135,16 @@ type TrendData struct {
 	Count  int    `json:"count"`
 }
 
+// EventTrendPoint는 추세 구간 하나의 이벤트 수입니다.
+type EventTrendPoint struct {
+	Start  time.Time         `json:"start"`
+	Total  int               `json:"total"`
+	ByType map[EventType]int `json:"by_type"`
+}
+
+// MaxEventTrendBuckets는 추세 조회 한 번에 나눌 수 있는 최대 구간 수입니다.
+const MaxEventTrendBuckets = 500
+
 // NewEventTracker는 새로운 이벤트 추적기를 생성합니다.
 func NewEventTracker(config *EventTrackerConfig) *EventTracker {
 	if config.RetentionPeriod == 0 {
@@ -137,6 +162,10 @@ func NewEventTracker(config *EventTrackerConfig) *EventTracker {
 		}
 	}
 
+	if config.Logger == nil {
+		config.Logger = logging.Nop()
+	}
+
 	tracker := &EventTracker{
 		config: config,
 		redis:  config.Redis,
@@ -222,11 +251,12 @@ func (et *EventTracker) RecordEvent(ctx context.Context, event *SecurityEvent) e
 		et.checkAlerts(ctx, event)
 	}
 
-	et.logger.Debug("보안 이벤트 기록됨",
-		zap.String("event_id", event.ID),
-		zap.String("type", string(event.Type)),
-		zap.String("severity", string(event.Severity)),
-		zap.String("source", event.Source))
+	et.logger.WithContext(ctx).WithFields(logging.Fields{
+		"event_id": event.ID,
+		"type":     string(event.Type),
+		"severity": string(event.Severity),
+		"source":   event.Source,
+	}).Debug("보안 이벤트 기록됨")
 
 	return nil
 }
@@ -288,7 +318,7 @@ func (et *EventTracker) QueryEvents(ctx context.Context, filter *EventFilter) ([
 	for _, eventID := range eventIDs {
 		event, err := et.GetEvent(ctx, eventID)
 		if err != nil {
-			et.logger.Warn("이벤트 조회 실패", zap.String("event_id", eventID), zap.Error(err))
+			et.logger.WithContext(ctx).WithField("event_id", eventID).WithError(err).Warn("이벤트 조회 실패")
 			continue
 		}
 
@@ -370,13 +400,97 @@ func (et *EventTracker) ResolveEvent(ctx context.Context, eventID string) error
 		return fmt.Errorf("이벤트 업데이트 실패: %w", err)
 	}
 
-	et.logger.Info("보안 이벤트 해결됨",
-		zap.String("event_id", eventID),
-		zap.String("type", string(event.Type)))
+	et.logger.WithContext(ctx).WithFields(logging.Fields{
+		"event_id": eventID,
+		"type":     string(event.Type),
+	}).Info("보안 이벤트 해결됨")
 
 	return nil
 }
 
+// CountEvents는 [start, end] 기간에 기록된 타입별 이벤트 수를 반환합니다.
+func (et *EventTracker) CountEvents(ctx context.Context, types []EventType, start, end time.Time) (map[EventType]int, error) {
+	pipe := et.redis.Pipeline()
+	cmds := make(map[EventType]*redis.IntCmd, len(types))
+	for _, eventType := range types {
+		cmds[eventType] = pipe.ZCount(ctx, et.getTimeSeriesKey(eventType), fmt.Sprintf("%d", start.Unix()), fmt.Sprintf("%d", end.Unix()))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("이벤트 수 조회 실패: %w", err)
+	}
+
+	counts := make(map[EventType]int, len(types))
+	for eventType, cmd := range cmds {
+		counts[eventType] = int(cmd.Val())
+	}
+	return counts, nil
+}
+
+// RecentEvents는 여러 타입의 이벤트 중 start 이후에 기록된 것을 최근 것부터 최대 limit개 반환합니다.
+func (et *EventTracker) RecentEvents(ctx context.Context, types []EventType, start time.Time, limit int) ([]*SecurityEvent, error) {
+	events := make([]*SecurityEvent, 0)
+	for _, eventType := range types {
+		found, err := et.QueryEvents(ctx, &EventFilter{
+			Types:     []EventType{eventType},
+			StartTime: &start,
+			Limit:     limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s 이벤트 조회 실패: %w", eventType, err)
+		}
+		events = append(events, found...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.After(events[j].Timestamp)
+	})
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// EventTrend는 [start, end)를 interval 간격으로 나눠 구간별 이벤트 수를 타입별로 셉니다.
+// 이벤트 시각은 초 단위로 저장되므로 interval은 1초 이상이어야 합니다.
+func (et *EventTracker) EventTrend(ctx context.Context, types []EventType, start, end time.Time, interval time.Duration) ([]EventTrendPoint, error) {
+	if interval < time.Second || !end.After(start) {
+		return nil, fmt.Errorf("추세 기간이나 간격이 올바르지 않습니다")
+	}
+	buckets := int((end.Sub(start) + interval - 1) / interval)
+	if buckets > MaxEventTrendBuckets {
+		return nil, fmt.Errorf("추세 구간이 너무 많습니다: %d (최대 %d)", buckets, MaxEventTrendBuckets)
+	}
+
+	pipe := et.redis.Pipeline()
+	cmds := make([][]*redis.IntCmd, buckets)
+	for i := range cmds {
+		bucketStart := start.Add(time.Duration(i) * interval)
+		bucketEnd := bucketStart.Add(interval)
+		cmds[i] = make([]*redis.IntCmd, len(types))
+		for j, eventType := range types {
+			cmds[i][j] = pipe.ZCount(ctx, et.getTimeSeriesKey(eventType),
+				fmt.Sprintf("%d", bucketStart.Unix()), fmt.Sprintf("(%d", bucketEnd.Unix()))
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("이벤트 추세 조회 실패: %w", err)
+	}
+
+	points := make([]EventTrendPoint, buckets)
+	for i := range points {
+		points[i] = EventTrendPoint{
+			Start:  start.Add(time.Duration(i) * interval),
+			ByType: make(map[EventType]int, len(types)),
+		}
+		for j, eventType := range types {
+			count := int(cmds[i][j].Val())
+			points[i].ByType[eventType] = count
+			points[i].Total += count
+		}
+	}
+	return points, nil
+}
+
 // 내부 헬퍼 메서드들
 
 func (et *EventTracker) generateEventID() string {
@@ -523,15 +637,16 @@ func (et *EventTracker) checkAlerts(ctx context.Context, event *SecurityEvent) {
 	
 	count, err := et.getEventCountByType(ctx, event.Type, oneHourAgo, now)
 	if err != nil {
-		et.logger.Error("알림 확인 중 오류 발생", zap.Error(err))
+		et.logger.WithContext(ctx).WithError(err).Error("알림 확인 중 오류 발생")
 		return
 	}
 
 	if count >= threshold {
-		et.logger.Warn("보안 이벤트 임계값 초과",
-			zap.String("event_type", string(event.Type)),
-			zap.Int("count", count),
-			zap.Int("threshold", threshold))
+		et.logger.WithContext(ctx).WithFields(logging.Fields{
+			"event_type": string(event.Type),
+			"count":      count,
+			"threshold":  threshold,
+		}).Warn("보안 이벤트 임계값 초과")
 		
 		// 여기에 실제 알림 로직 구현 (이메일, 슬랙 등)
 		// TODO: 알림 발송 구현
@@ -588,8 +703,7 @@ func (et *EventTracker) cleanupExpiredEvents(ctx context.Context) {
 		et.cleanupByPattern(ctx, pattern, cutoffScore)
 	}
 
-	et.logger.Debug("만료된 보안 이벤트 정리 완료",
-		zap.Time("cutoff_time", cutoffTime))
+	et.logger.WithContext(ctx).WithField("cutoff_time", cutoffTime).Debug("만료된 보안 이벤트 정리 완료")
 }
 
 func (et *EventTracker) cleanupByPattern(ctx context.Context, pattern, cutoffScore string) {
@@ -597,7 +711,7 @@ func (et *EventTracker) cleanupByPattern(ctx context.Context, pattern, cutoffSco
 	for {
 		keys, nextCursor, err := et.redis.Scan(ctx, cursor, pattern, 100).Result()
 		if err != nil {
-			et.logger.Error("키 스캔 실패", zap.Error(err))
+			et.logger.WithContext(ctx).WithError(err).Error("키 스캔 실패")
 			break
 		}
 
@@ -8,13 +8,14 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
-	"go.uber.org/zap"
+
+	"github.com/aicli/aicli-web/internal/logging"
 )
 
 // MetricsCollector는 보안 메트릭 수집기입니다.
 type MetricsCollector struct {
 	redis      redis.UniversalClient
-	logger     *zap.Logger
+	logger     logging.Logger
 	metrics    map[string]*Metric
 	mu         sync.RWMutex
 	
@@ -110,7 +111,7 @@ type ResponseTimeMetrics struct {
 // MetricsCollectorConfig는 메트릭 수집기 설정입니다.
 type MetricsCollectorConfig struct {
 	Redis           redis.UniversalClient
-	Logger          *zap.Logger
+	Logger          logging.Logger
 	CollectInterval time.Duration
 	RetentionPeriod time.Duration
 	BufferSize      int
@@ -127,6 +128,9 @@ func NewMetricsCollector(config *MetricsCollectorConfig) *MetricsCollector {
 	if config.BufferSize == 0 {
 		config.BufferSize = 1000
 	}
+	if config.Logger == nil {
+		config.Logger = logging.Nop()
+	}
 
 	mc := &MetricsCollector{
 		redis:           config.Redis,
@@ -159,7 +163,7 @@ func (mc *MetricsCollector) RecordMetric(name string, metricType MetricType, val
 	case mc.metricChan <- event:
 		// 성공
 	default:
-		mc.logger.Warn("메트릭 버퍼 가득참", zap.String("metric", name))
+		mc.logger.WithField("metric", name).Warn("메트릭 버퍼 가득참")
 	}
 }
 
@@ -196,27 +200,27 @@ func (mc *MetricsCollector) GetSecurityMetrics(ctx context.Context, period time.
 
 	// 공격 관련 메트릭 수집
 	if err := mc.collectAttackMetrics(ctx, metrics, startTime, now); err != nil {
-		mc.logger.Error("공격 메트릭 수집 실패", zap.Error(err))
+		mc.logger.WithContext(ctx).WithError(err).Error("공격 메트릭 수집 실패")
 	}
 
 	// Rate Limiting 메트릭 수집
 	if err := mc.collectRateLimitMetrics(ctx, metrics, startTime, now); err != nil {
-		mc.logger.Error("Rate Limit 메트릭 수집 실패", zap.Error(err))
+		mc.logger.WithContext(ctx).WithError(err).Error("Rate Limit 메트릭 수집 실패")
 	}
 
 	// 인증 메트릭 수집
 	if err := mc.collectAuthMetrics(ctx, metrics, startTime, now); err != nil {
-		mc.logger.Error("인증 메트릭 수집 실패", zap.Error(err))
+		mc.logger.WithContext(ctx).WithError(err).Error("인증 메트릭 수집 실패")
 	}
 
 	// 세션 메트릭 수집
 	if err := mc.collectSessionMetrics(ctx, metrics, startTime, now); err != nil {
-		mc.logger.Error("세션 메트릭 수집 실패", zap.Error(err))
+		mc.logger.WithContext(ctx).WithError(err).Error("세션 메트릭 수집 실패")
 	}
 
 	// 성능 메트릭 수집
 	if err := mc.collectPerformanceMetrics(ctx, metrics, startTime, now); err != nil {
-		mc.logger.Error("성능 메트릭 수집 실패", zap.Error(err))
+		mc.logger.WithContext(ctx).WithError(err).Error("성능 메트릭 수집 실패")
 	}
 
 	return metrics, nil
@@ -425,7 +429,7 @@ func (mc *MetricsCollector) aggregateMetrics() {
 		mc.storeAggregatedMetric(ctx, aggregateKey, metric)
 	}
 
-	mc.logger.Debug("메트릭 집계 완료", zap.Int("metrics_count", len(mc.metrics)))
+	mc.logger.WithField("metrics_count", len(mc.metrics)).Debug("메트릭 집계 완료")
 }
 
 // storeMetricToRedis는 메트릭을 Redis에 저장합니다.
@@ -439,7 +443,7 @@ func (mc *MetricsCollector) storeMetricToRedis(key string, metric *Metric) {
 	// 메트릭 직렬화
 	data, err := json.Marshal(metric)
 	if err != nil {
-		mc.logger.Error("메트릭 직렬화 실패", zap.String("key", key), zap.Error(err))
+		mc.logger.WithField("key", key).WithError(err).Error("메트릭 직렬화 실패")
 		return
 	}
 
@@ -453,7 +457,7 @@ func (mc *MetricsCollector) storeMetricToRedis(key string, metric *Metric) {
 	}).Err()
 	
 	if err != nil {
-		mc.logger.Error("메트릭 Redis 저장 실패", zap.String("key", key), zap.Error(err))
+		mc.logger.WithField("key", key).WithError(err).Error("메트릭 Redis 저장 실패")
 		return
 	}
 
@@ -469,13 +473,13 @@ func (mc *MetricsCollector) storeAggregatedMetric(ctx context.Context, key strin
 
 	data, err := json.Marshal(metric)
 	if err != nil {
-		mc.logger.Error("집계 메트릭 직렬화 실패", zap.String("key", key), zap.Error(err))
+		mc.logger.WithContext(ctx).WithField("key", key).WithError(err).Error("집계 메트릭 직렬화 실패")
 		return
 	}
 
 	err = mc.redis.Set(ctx, key, data, mc.retentionPeriod).Err()
 	if err != nil {
-		mc.logger.Error("집계 메트릭 저장 실패", zap.String("key", key), zap.Error(err))
+		mc.logger.WithContext(ctx).WithField("key", key).WithError(err).Error("집계 메트릭 저장 실패")
 	}
 }
 
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/breaker"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/security"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// NewAccountServiceFromConfig 설정으로 로컬 계정 서비스를 구성합니다 (비활성이면 nil)
+// notifier가 nil이면 비밀번호 재설정 메일과 보안 알림을 보내지 않습니다.
+// notifications가 있으면 보안 알림을 알림 센터에도 남기고, 로그인 실패는 detector로 전달해 무차별 대입을 탐지합니다.
+// 계정이 하나도 없고 최초 관리자 비밀번호가 있으면 관리자 계정을 만들며,
+// 최초 관리자를 만들지 못해도 서비스는 반환하므로 개발용 고정 계정으로 로그인되지 않습니다.
+func NewAccountServiceFromConfig(cfg config.AccountsConfig, accountStorage storage.AccountStorage, notifier services.NotificationService, notifications *services.NotificationCenter, detector *security.AttackDetector, logger logging.Logger) (*services.AccountService, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	hasher := auth.NewPasswordHasher(auth.Argon2Params{
+		Memory:      cfg.Argon2.Memory,
+		Iterations:  cfg.Argon2.Iterations,
+		Parallelism: cfg.Argon2.Parallelism,
+	})
+	accounts := services.NewAccountService(accountStorage, hasher, services.AccountConfig{
+		Policy: validation.PasswordPolicy{
+			MinLength:        cfg.PasswordPolicy.MinLength,
+			MaxLength:        cfg.PasswordPolicy.MaxLength,
+			RequireUpper:     cfg.PasswordPolicy.RequireUpper,
+			RequireLower:     cfg.PasswordPolicy.RequireLower,
+			RequireDigit:     cfg.PasswordPolicy.RequireDigit,
+			RequireSymbol:    cfg.PasswordPolicy.RequireSymbol,
+			DisallowUsername: cfg.PasswordPolicy.DisallowUsername,
+		},
+		MaxFailures:      cfg.Lockout.MaxFailures,
+		LockoutDuration:  cfg.Lockout.Duration,
+		ResetTokenExpiry: cfg.ResetTokenExpiry,
+	})
+
+	if notifier != nil {
+		accounts.SetNotifier(notifier)
+	} else {
+		logger.Warn("email.driver가 비어 있어 비밀번호 재설정 메일과 보안 알림을 보내지 않습니다")
+	}
+
+	if notifications != nil {
+		accounts.AddSecurityNoticeRecorder(notifications)
+	}
+	if detector != nil {
+		accounts.SetLoginFailureRecorder(detector)
+	}
+
+	created, err := accounts.EnsureBootstrapAdmin(context.Background(), cfg.BootstrapAdmin, cfg.BootstrapPassword)
+	if err != nil {
+		return accounts, err
+	}
+	if created {
+		logger.WithField("username", cfg.BootstrapAdmin).Info("최초 관리자 계정을 만들었습니다")
+	}
+	return accounts, nil
+}
+
+// NewAttackDetectorFromConfig 로그인 실패와 요청 검사에 쓸 공격 탐지기 구성
+// 임계값과 라우트 그룹은 attack_detection, IP 자동 차단과 Redis 주소는 accounts.lockout 설정을 따릅니다.
+// Redis가 없으면 보안 이벤트 저장과 IP별 집계, IP 차단 없이 계정 잠금만 동작합니다.
+// Redis 회로가 열린 동안에도 같으며, 계정 잠금은 스토리지에 기록하므로 계속 동작합니다.
+// 공격이 탐지되면 notifications(nil이면 로그만)로 관리자에게 알립니다.
+func NewAttackDetectorFromConfig(cfg config.AttackDetectionConfig, lockout config.AccountLockoutConfig, notifications *services.NotificationCenter, breakers *breaker.Registry, logger logging.Logger) *security.AttackDetector {
+	settings := attackDetectionSettingsFromConfig(cfg, lockout.AutoBlockIP)
+	detectorConfig := security.DefaultAttackDetectorConfig()
+	detectorConfig.MinConfidence = settings.Defaults.MinConfidence
+	detectorConfig.BruteForceThreshold = settings.Defaults.BruteForceThreshold
+	detectorConfig.BruteForceWindow = settings.Defaults.BruteForceWindow
+	detectorConfig.AutoBlockEnabled = settings.Defaults.AutoBlockEnabled
+	detectorConfig.BlockDuration = settings.Defaults.BlockDuration
+	detectorConfig.DryRun = settings.Defaults.DryRun
+	detectorConfig.RouteGroups = settings.Groups
+	detectorConfig.ScanPolicy = attackScanPolicyFromConfig(cfg.Scan)
+	detectorConfig.Logger = logger
+	if notifications != nil {
+		detectorConfig.AlertHandler = newAttackAlertNotifier(notifications)
+	}
+
+	if lockout.RedisAddr != "" {
+		client := newRedisClient(&redis.Options{Addr: lockout.RedisAddr}, breakers, "redis:security")
+		detectorConfig.Redis = client
+		detectorConfig.EventTracker = security.NewEventTracker(&security.EventTrackerConfig{
+			Redis:  client,
+			Logger: logger,
+		})
+	}
+	return security.NewAttackDetector(detectorConfig)
+}
+
+// newSecurityDashboard 공격 탐지기의 이벤트 추적기로 보안 대시보드 집계기를 구성합니다
+// 이벤트 추적기는 accounts.lockout.redis_addr가 있을 때만 만들어지므로 없으면 nil입니다.
+func newSecurityDashboard(detector *security.AttackDetector) *security.SecurityDashboard {
+	if detector == nil || detector.EventTracker() == nil {
+		return nil
+	}
+	return security.NewSecurityDashboard(detector.EventTracker(), detector)
+}
+
+// attackScanPolicyFromConfig 설정을 공격 패턴 검사 바이트 예산과 패턴별 검사 대상으로 변환
+func attackScanPolicyFromConfig(cfg config.AttackScanConfig) security.AttackScanPolicy {
+	return security.AttackScanPolicy{
+		MaxURLBytes:      cfg.MaxURLBytes,
+		MaxHeaderBytes:   cfg.MaxHeaderBytes,
+		MaxBodyBytes:     cfg.MaxBodyBytes,
+		SkipContentTypes: cfg.SkipContentTypes,
+		PatternTargets:   cfg.PatternTargets,
+	}
+}
+
+// attackDetectionSettingsFromConfig 설정을 공격 탐지기의 임계값과 라우트 그룹으로 변환
+func attackDetectionSettingsFromConfig(cfg config.AttackDetectionConfig, autoBlock bool) security.AttackDetectionSettings {
+	groups := make([]security.AttackRouteGroup, 0, len(cfg.RouteGroups))
+	for _, group := range cfg.RouteGroups {
+		groups = append(groups, security.AttackRouteGroup{
+			Name:                group.Name,
+			PathPrefix:          group.PathPrefix,
+			MinConfidence:       group.MinConfidence,
+			BruteForceThreshold: group.BruteForceThreshold,
+			BruteForceWindow:    group.BruteForceWindow,
+			BlockDuration:       group.BlockDuration,
+			DisableAutoBlock:    group.DisableAutoBlock,
+			DryRun:              group.DryRun,
+		})
+	}
+	return security.AttackDetectionSettings{
+		Defaults: security.AttackThresholds{
+			MinConfidence:       cfg.MinConfidence,
+			BruteForceThreshold: cfg.BruteForceThreshold,
+			BruteForceWindow:    cfg.BruteForceWindow,
+			AutoBlockEnabled:    autoBlock,
+			BlockDuration:       cfg.BlockDuration,
+			DryRun:              cfg.DryRun,
+		},
+		Groups: groups,
+	}
+}
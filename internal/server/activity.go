@@ -0,0 +1,23 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// NewActivityService 활동 피드 서비스를 구성하고 태스크, 검토, 공유, RBAC, 계정 보안 알림 훅을 연결합니다
+// accounts가 nil이면(로컬 계정 비활성) 보안 알림은 기록하지 않습니다.
+func NewActivityService(store storage.Storage, rbacBus *auth.RBACEventBus, taskService *services.TaskService, reviews *services.TaskReviewService, access *services.WorkspaceAccessService, accounts *services.AccountService) *services.ActivityService {
+	activity := services.NewActivityService(store)
+	taskService.AddFinishListener(activity)
+	reviews.AddListener(activity)
+	access.AddListener(activity)
+	for _, eventType := range services.ActivityRBACEvents {
+		rbacBus.RegisterHandler(eventType, activity)
+	}
+	if accounts != nil {
+		accounts.AddSecurityNoticeRecorder(activity)
+	}
+	return activity
+}
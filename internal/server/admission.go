@@ -0,0 +1,33 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// NewHostAdmissionServiceFromConfig 설정으로 호스트 자원 기반 태스크 실행 허가를 구성하고 태스크 서비스에 연결합니다 (비활성이면 nil)
+func NewHostAdmissionServiceFromConfig(cfg *config.Config, taskService *services.TaskService) *services.HostAdmissionService {
+	admissionCfg := cfg.Tasks.Admission
+	if !admissionCfg.Enabled {
+		return nil
+	}
+
+	diskPath := admissionCfg.DiskPath
+	if diskPath == "" {
+		diskPath = cfg.Workspace.DefaultPath
+	}
+	admission := services.NewHostAdmissionService(services.HostAdmissionConfig{
+		Interval: admissionCfg.SampleInterval,
+		Thresholds: models.HostResourceThresholds{
+			CPUPercent:    admissionCfg.CPUPercent,
+			MemoryPercent: admissionCfg.MemoryPercent,
+			DiskPercent:   admissionCfg.DiskPercent,
+		},
+		DiskPath:     diskPath,
+		MaxDeferred:  admissionCfg.MaxDeferred,
+		ReleaseBatch: admissionCfg.ReleaseBatch,
+	})
+	taskService.SetAdmission(admission)
+	return admission
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewAgentRegistryFromConfig 설정에 정의된 CLI 에이전트 프로바이더로 레지스트리를 구성합니다
+// Claude 러너는 항상 등록되며, 기본 프로바이더와 워크스페이스별 지정은 등록된 프로바이더만 허용합니다.
+// RecordDir가 있으면 모든 러너의 출력을 녹화해 재생 테스트에 쓸 수 있게 합니다.
+func NewAgentRegistryFromConfig(cfg config.AgentsConfig) (*claude.AgentRegistry, error) {
+	registry := claude.NewAgentRegistry()
+
+	register := func(runner claude.AgentRunner) error {
+		if cfg.RecordDir != "" {
+			recording, err := claude.NewRecordingRunner(runner, cfg.RecordDir)
+			if err != nil {
+				return err
+			}
+			runner = recording
+		}
+		return registry.Register(runner)
+	}
+	if cfg.RecordDir != "" {
+		if err := register(claude.NewClaudeRunner()); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, pc := range cfg.Providers {
+		runner, err := claude.NewCLIRunner(claude.CLIRunnerConfig{
+			Provider:     pc.Name,
+			Command:      pc.Command,
+			Args:         pc.Args,
+			Env:          pc.Env,
+			OutputFormat: pc.OutputFormat,
+			Pricing: claude.AgentPricing{
+				InputPerMTok:  pc.InputPricePerMTok,
+				OutputPerMTok: pc.OutputPricePerMTok,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := register(runner); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.Default != "" {
+		if err := registry.SetDefault(cfg.Default); err != nil {
+			return nil, err
+		}
+	}
+
+	for workspaceID, provider := range cfg.Workspaces {
+		if err := registry.SetWorkspaceProvider(workspaceID, provider); err != nil {
+			return nil, fmt.Errorf("workspace %s: %w", workspaceID, err)
+		}
+	}
+
+	return registry, nil
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+func TestNewAgentRegistryFromConfig_RecordDir(t *testing.T) {
+	registry, err := NewAgentRegistryFromConfig(config.AgentsConfig{
+		Providers: []config.AgentProviderConfig{{Name: "codex", Command: "codex"}},
+		RecordDir: t.TempDir(),
+	})
+	require.NoError(t, err)
+
+	// 녹화 디렉토리를 지정하면 모든 러너가 녹화 러너로 감싸짐
+	for _, provider := range []string{"claude", "codex"} {
+		runner, err := registry.Get(provider)
+		require.NoError(t, err)
+		assert.IsType(t, &claude.RecordingRunner{}, runner, provider)
+		assert.Equal(t, provider, runner.Provider())
+	}
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aicli/aicli-web/internal/breaker"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewBreakerRegistryFromConfig 설정으로 외부 의존성 회로 차단기 레지스트리를 구성합니다 (비활성이면 nil)
+// 같은 이름의 의존성은 차단기 하나를 공유하며, 상태와 호출 결과는 의존성별 메트릭으로 기록합니다.
+func NewBreakerRegistryFromConfig(cfg config.CircuitBreakerConfig, reg prometheus.Registerer) *breaker.Registry {
+	if !cfg.Enabled {
+		return nil
+	}
+	return breaker.NewRegistry(breaker.Config{
+		FailureThreshold: cfg.FailureThreshold,
+		OpenTimeout:      cfg.OpenTimeout,
+		HalfOpenProbes:   cfg.HalfOpenProbes,
+	}, reg)
+}
+
+// newRedisClient Redis 클라이언트 생성 (회로 차단기를 사용하면 name 차단기를 훅으로 등록)
+func newRedisClient(options *redis.Options, breakers *breaker.Registry, name string) *redis.Client {
+	client := redis.NewClient(options)
+	if breakers != nil {
+		client.AddHook(breaker.NewRedisHook(breakers.GetWith(name, breaker.IsRedisFailure)))
+	}
+	return client
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/aicli/aicli-web/internal/chaos"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// NewFaultInjectorFromConfig 설정으로 장애 주입기를 구성합니다 (비활성이면 nil)
+// production 환경에서는 설정과 관계없이 장애를 주입하지 않습니다.
+func NewFaultInjectorFromConfig(cfg config.ChaosConfig, env string) (*chaos.Injector, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if env == "production" {
+		return nil, errors.New("production 환경에서는 장애 주입을 사용할 수 없습니다")
+	}
+	return chaos.New(chaos.Faults{
+		StorageDelay: chaos.StorageDelay{
+			Probability: cfg.StorageDelay.Probability,
+			Min:         cfg.StorageDelay.MinDelay,
+			Max:         cfg.StorageDelay.MaxDelay,
+		},
+		ProcessKill: chaos.ProcessKill{
+			Probability: cfg.ProcessKill.Probability,
+			After:       cfg.ProcessKill.After,
+		},
+		FrameDrop: chaos.FrameDrop{Probability: cfg.FrameDrop.Probability},
+	}, cfg.Seed)
+}
+
+// withFaultInjection 장애 주입 중이면 스토리지 호출에 지연을 주입합니다
+func withFaultInjection(inner storage.Storage, injector *chaos.Injector) storage.Storage {
+	if injector == nil {
+		return inner
+	}
+	return chaos.WrapStorage(inner, injector)
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestNewFaultInjectorFromConfig(t *testing.T) {
+	injector, err := NewFaultInjectorFromConfig(config.ChaosConfig{}, "development")
+	require.NoError(t, err)
+	assert.Nil(t, injector)
+
+	inner := memory.New()
+	assert.Same(t, inner, withFaultInjection(inner, nil))
+
+	cfg := config.ChaosConfig{Enabled: true, Seed: 1, FrameDrop: config.ChaosFrameDropConfig{Probability: 1}}
+	injector, err = NewFaultInjectorFromConfig(cfg, "test")
+	require.NoError(t, err)
+	require.NotNil(t, injector)
+	assert.True(t, injector.DropFrame())
+	assert.NotSame(t, inner, withFaultInjection(inner, injector))
+
+	// production에서는 켜지 않음
+	injector, err = NewFaultInjectorFromConfig(cfg, "production")
+	assert.Error(t, err)
+	assert.Nil(t, injector)
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/breaker"
+	"github.com/aicli/aicli-web/internal/cluster"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewClusterFromConfig 설정으로 다중 레플리카 조정(잠금, 리더 선출, 멤버십)을 구성합니다 (비활성이면 nil)
+// Redis 회로가 열리면 잠금 획득이 바로 실패하므로 단일 실행 작업은 회로가 닫힐 때까지 실행되지 않습니다.
+func NewClusterFromConfig(cfg config.ClusterConfig, instanceID string, breakers *breaker.Registry, logger *logrus.Logger) (*cluster.Cluster, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var locker cluster.Locker
+	switch cfg.Backend {
+	case "", "redis":
+		if cfg.Redis.Addr == "" {
+			return nil, fmt.Errorf("cluster.redis.addr is required")
+		}
+		locker = cluster.NewRedisLocker(newRedisClient(&redis.Options{
+			Addr:     cfg.Redis.Addr,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		}, breakers, "redis:cluster"))
+	case "memory":
+		logger.Warn("cluster.backend가 memory이면 레플리카 간에 잠금을 공유하지 않습니다")
+		locker = cluster.NewMemoryLocker()
+	default:
+		return nil, fmt.Errorf("unsupported cluster backend: %s", cfg.Backend)
+	}
+
+	return cluster.New(locker, cluster.Config{
+		InstanceID:    instanceID,
+		KeyPrefix:     cfg.KeyPrefix,
+		LeaseTTL:      cfg.LeaseTTL,
+		RetryInterval: cfg.RetryInterval,
+	}, logger)
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/ratelimit"
+	"github.com/aicli/aicli-web/internal/webui"
+)
+
+// rateLimitWindow 요청 제한 설정의 분 단위 윈도우 (초)
+const rateLimitWindow = 60
+
+// NewRateLimitMiddlewareFromConfig 설정으로 요청 제한 미들웨어를 구성합니다
+// api.rate_limit이 0이면 제한을 비활성화하며, 엔드포인트별 제한(로그인 등)은 기본값을 유지합니다.
+// 웹 UI를 제공하면 웹 UI 파일 요청은 제한하지 않습니다.
+func NewRateLimitMiddlewareFromConfig(cfg *config.Config) *middleware.RateLimitMiddleware {
+	rateLimitConfig := middleware.DefaultRateLimitConfig()
+	rateLimitConfig.Enabled = cfg.API.RateLimit > 0
+	if rateLimitConfig.Enabled {
+		rateLimitConfig.DefaultConfig, rateLimitConfig.AuthenticatedConfig = rateLimitsFromConfig(cfg)
+	}
+	if cfg.WebUI.Enabled {
+		// 웹 UI는 페이지 하나에 파일 요청이 많아 미인증 요청 순간 허용량을 바로 넘김
+		rateLimitConfig.Skip = func(c *gin.Context) bool { return webui.IsUIRequest(c.Request) }
+	}
+	return middleware.NewRateLimitMiddleware(rateLimitConfig)
+}
+
+// NewRequestTimeoutMiddlewareFromConfig 설정의 처리 시간 예산으로 /api/v1 요청 기한 미들웨어 생성
+func NewRequestTimeoutMiddlewareFromConfig(cfg config.RequestTimeoutConfig) *middleware.RequestTimeoutMiddleware {
+	return middleware.NewRequestTimeoutMiddleware(requestTimeoutFromConfig(cfg))
+}
+
+// requestTimeoutFromConfig 설정을 미들웨어 설정으로 변환
+func requestTimeoutFromConfig(cfg config.RequestTimeoutConfig) *middleware.RequestTimeoutConfig {
+	return &middleware.RequestTimeoutConfig{
+		Default:   cfg.Default,
+		MaxClient: cfg.MaxClient,
+		Routes:    cfg.Routes,
+	}
+}
+
+// rateLimitsFromConfig 미인증, 인증 요청의 제한 설정
+func rateLimitsFromConfig(cfg *config.Config) (anonymous, authenticated *ratelimit.LimiterConfig) {
+	anonymous = &ratelimit.LimiterConfig{
+		Rate:   cfg.API.RateLimit,
+		Burst:  cfg.Limits.RateLimitBurst,
+		Window: rateLimitWindow,
+	}
+	authenticated = &ratelimit.LimiterConfig{
+		Rate:   cfg.Limits.AuthenticatedRateLimit,
+		Burst:  cfg.Limits.AuthenticatedBurst,
+		Window: rateLimitWindow,
+	}
+	return anonymous, authenticated
+}
+
+// applyLogLevel 서버 로거와 logrus 기본 로거의 레벨을 변경합니다 (알 수 없는 레벨은 무시)
+func applyLogLevel(logger *logrus.Logger, level string) {
+	parsed, err := logrus.ParseLevel(level)
+	if err != nil {
+		logger.WithError(err).Warn("알 수 없는 로그 레벨입니다")
+		return
+	}
+	logger.SetLevel(parsed)
+	logrus.SetLevel(parsed)
+}
+
+// ApplyConfig 다시 읽은 설정 중 실행 중에 바꿀 수 있는 항목을 적용합니다
+// 로그 레벨(모듈별 포함), 요청 제한, 요청 처리 시간 예산, 태스크 워커 수, 동시 실행 세션 한도,
+// 공격 탐지 임계값은 즉시 반영하고, 그 밖의 변경은 재시작해야 적용됨을 경고합니다.
+func (s *Server) ApplyConfig(old, new *config.Config) {
+	if old.Logging.Level != new.Logging.Level || !reflect.DeepEqual(old.Logging.Modules, new.Logging.Modules) {
+		if s.logs != nil {
+			// 관리자 API로 바꾼 레벨도 설정 파일 값으로 되돌림
+			if err := s.logs.Apply(new.Logging.Level, new.Logging.Modules); err != nil {
+				s.logger.WithError(err).Warn("로그 레벨 변경 실패")
+			}
+		} else {
+			applyLogLevel(s.logger, new.Logging.Level)
+		}
+		s.logger.WithFields(logrus.Fields{
+			"level":   new.Logging.Level,
+			"modules": new.Logging.Modules,
+		}).Info("로그 레벨 변경")
+	}
+
+	if s.rateLimiter != nil && (old.API.RateLimit != new.API.RateLimit || old.Limits != new.Limits) {
+		if new.API.RateLimit > 0 {
+			s.rateLimiter.SetLimits(rateLimitsFromConfig(new))
+		}
+		s.rateLimiter.SetEnabled(new.API.RateLimit > 0)
+		s.logger.WithFields(logrus.Fields{
+			"rate_limit":               new.API.RateLimit,
+			"authenticated_rate_limit": new.Limits.AuthenticatedRateLimit,
+		}).Info("요청 제한 변경")
+	}
+
+	if s.requestTimeout != nil && !reflect.DeepEqual(old.API.RequestTimeout, new.API.RequestTimeout) {
+		s.requestTimeout.SetConfig(requestTimeoutFromConfig(new.API.RequestTimeout))
+		s.logger.WithFields(logrus.Fields{
+			"default":    new.API.RequestTimeout.Default,
+			"max_client": new.API.RequestTimeout.MaxClient,
+		}).Info("요청 처리 시간 예산 변경")
+	}
+
+	if s.taskService != nil && old.Limits.TaskWorkers != new.Limits.TaskWorkers {
+		if err := s.taskService.SetMaxWorkers(new.Limits.TaskWorkers); err != nil {
+			s.logger.WithError(err).Warn("태스크 워커 수 변경 실패")
+		}
+	}
+
+	if s.sessionLimits != nil && (old.Limits.SessionsPerUser != new.Limits.SessionsPerUser || old.Limits.SessionsPerOrg != new.Limits.SessionsPerOrg) {
+		// 관리자 API로 지정한 사용자별 한도는 유지
+		s.sessionLimits.SetDefaults(new.Limits.SessionsPerUser, new.Limits.SessionsPerOrg)
+		s.logger.WithFields(logrus.Fields{
+			"sessions_per_user": new.Limits.SessionsPerUser,
+			"sessions_per_org":  new.Limits.SessionsPerOrg,
+		}).Info("동시 실행 세션 한도 변경")
+	}
+
+	if s.attackDetector != nil && (!reflect.DeepEqual(old.AttackDetection, new.AttackDetection) || old.Accounts.Lockout.AutoBlockIP != new.Accounts.Lockout.AutoBlockIP) {
+		// 관리자 API로 바꾼 임계값도 설정 파일 값으로 되돌림 (요청 검사 여부는 재시작해야 적용)
+		if err := s.attackDetector.SetScanPolicy(attackScanPolicyFromConfig(new.AttackDetection.Scan)); err != nil {
+			s.logger.WithError(err).Warn("공격 탐지 검사 예산 변경 실패")
+		}
+		if err := s.attackDetector.SetSettings(attackDetectionSettingsFromConfig(new.AttackDetection, new.Accounts.Lockout.AutoBlockIP)); err != nil {
+			s.logger.WithError(err).Warn("공격 탐지 임계값 변경 실패")
+		} else {
+			s.logger.WithFields(logrus.Fields{
+				"dry_run":      new.AttackDetection.DryRun,
+				"route_groups": len(new.AttackDetection.RouteGroups),
+			}).Info("공격 탐지 임계값 변경")
+		}
+	}
+
+	if sections := restartRequiredChanges(old, new); len(sections) > 0 {
+		s.logger.WithField("sections", strings.Join(sections, ", ")).Warn("변경된 설정 중 일부는 재시작 후 적용됩니다")
+	}
+}
+
+// restartRequiredChanges 실행 중에 적용할 수 없는 설정 중 바뀐 섹션 이름 목록
+func restartRequiredChanges(old, new *config.Config) []string {
+	before, after := *old, *new
+	for _, cfg := range []*config.Config{&before, &after} {
+		cfg.Logging.Level = ""
+		cfg.Logging.Modules = nil
+		cfg.API.RateLimit = 0
+		cfg.API.RequestTimeout = config.RequestTimeoutConfig{}
+		cfg.Limits.RateLimitBurst = 0
+		cfg.Limits.AuthenticatedRateLimit = 0
+		cfg.Limits.AuthenticatedBurst = 0
+		cfg.Limits.TaskWorkers = 0
+		cfg.Limits.SessionsPerUser = 0
+		cfg.Limits.SessionsPerOrg = 0
+		cfg.AttackDetection = config.AttackDetectionConfig{Enabled: cfg.AttackDetection.Enabled}
+		cfg.Accounts.Lockout.AutoBlockIP = false
+	}
+
+	var sections []string
+	beforeValue, afterValue := reflect.ValueOf(before), reflect.ValueOf(after)
+	for i := 0; i < beforeValue.NumField(); i++ {
+		if reflect.DeepEqual(beforeValue.Field(i).Interface(), afterValue.Field(i).Interface()) {
+			continue
+		}
+		name := strings.Split(beforeValue.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if name == "" {
+			name = beforeValue.Type().Field(i).Name
+		}
+		sections = append(sections, name)
+	}
+	return sections
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/security"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestServer_ApplyConfig(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	defer logrus.SetLevel(logrus.GetLevel())
+
+	old := config.GetDefaultConfig()
+	taskService := services.NewTaskService(memory.New(), nil, services.DefaultTaskServiceConfig())
+	require.NoError(t, taskService.Start(context.Background()))
+	defer taskService.Stop()
+
+	s := &Server{
+		logger:         logger,
+		rateLimiter:    NewRateLimitMiddlewareFromConfig(old),
+		taskService:    taskService,
+		attackDetector: NewAttackDetectorFromConfig(old.AttackDetection, old.Accounts.Lockout, nil, nil, nil),
+	}
+	defer s.rateLimiter.Close()
+
+	updated := config.GetDefaultConfig()
+	updated.Logging.Level = "debug"
+	updated.API.RateLimit = 30
+	updated.Limits.TaskWorkers = 3
+	updated.AttackDetection.DryRun = true
+	updated.AttackDetection.RouteGroups = []config.AttackRouteGroupConfig{{Name: "auth", PathPrefix: "/api/v1/auth", BruteForceThreshold: 3}}
+	s.ApplyConfig(old, updated)
+
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+	stats, _ := taskService.GetStats(context.Background())
+	assert.Equal(t, 3, stats["max_workers"])
+	limits := s.rateLimiter.GetStats()["default"].(map[string]interface{})["config"].(map[string]interface{})
+	assert.Equal(t, 30, limits["rate"])
+
+	group, thresholds := s.attackDetector.Settings().Resolve("/api/v1/auth/login")
+	assert.Equal(t, "auth", group)
+	assert.Equal(t, 3, thresholds.BruteForceThreshold)
+	assert.True(t, thresholds.DryRun)
+	group, _ = s.attackDetector.Settings().Resolve("/ws")
+	assert.Equal(t, security.DefaultAttackRouteGroup, group)
+}
+
+func TestRestartRequiredChanges(t *testing.T) {
+	old := config.GetDefaultConfig()
+	updated := config.GetDefaultConfig()
+
+	// 실행 중 변경 가능한 항목만 바뀐 경우
+	updated.Logging.Level = "debug"
+	updated.API.RateLimit = 0
+	updated.Limits.TaskWorkers = 10
+	updated.AttackDetection.DryRun = true
+	assert.Empty(t, restartRequiredChanges(old, updated))
+
+	updated.Server.Port = 9090
+	updated.Storage.Type = "sqlite"
+	assert.Equal(t, []string{"server", "storage"}, restartRequiredChanges(old, updated))
+}
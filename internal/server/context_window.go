@@ -0,0 +1,89 @@
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// 컨텍스트 이벤트를 세션 채널로 보낼 때의 이벤트 타입
+const (
+	wsEventContextWarning   = "context_warning"
+	wsEventContextCompacted = "context_compacted"
+)
+
+// NewContextTrackerFromConfig 설정으로 세션 컨텍스트 추적기를 구성합니다 (비활성이면 nil)
+// 자동 압축은 저장된 대화 기록을 요약해 시스템 프롬프트로 넘기고 세션 프로세스를 재시작합니다.
+func NewContextTrackerFromConfig(cfg config.ContextWindowConfig, sessions claude.SessionManager, store storage.Storage, logger *logrus.Logger) (*claude.ContextTracker, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	trackerConfig := claude.ContextTrackerConfig{
+		Policy: claude.ContextWindowPolicy{
+			Limit:        cfg.Limit,
+			ModelLimits:  cfg.ModelLimits,
+			WarnRatio:    cfg.WarnRatio,
+			CompactRatio: cfg.CompactRatio,
+			AutoCompact:  cfg.AutoCompact,
+		},
+		Timeout: cfg.CompactTimeout,
+	}
+	if source, ok := sessions.(claude.SessionEventSource); ok {
+		trackerConfig.Events = source.Events()
+	}
+	if cfg.AutoCompact {
+		summarize := claude.NewTranscriptSummarizer(store, cfg.SummaryMaxChars, cfg.SummaryMessageChars)
+		trackerConfig.Compactor = claude.NewSessionCompactor(sessions, summarize)
+	}
+
+	return claude.NewContextTracker(trackerConfig, logger)
+}
+
+// ContextEventBroadcaster 컨텍스트 경고와 압축 세션 이벤트를 세션 채널의 WebSocket 이벤트로 전달합니다
+type ContextEventBroadcaster struct {
+	hub *websocket.Hub
+}
+
+// NewContextEventBroadcaster 새 컨텍스트 이벤트 전달기 생성
+func NewContextEventBroadcaster(hub *websocket.Hub) *ContextEventBroadcaster {
+	return &ContextEventBroadcaster{hub: hub}
+}
+
+// OnSessionEvent 컨텍스트 관련 세션 이벤트만 세션 채널로 전송합니다
+func (b *ContextEventBroadcaster) OnSessionEvent(event claude.SessionEvent) {
+	var (
+		eventType string
+		data      map[string]interface{}
+	)
+	switch payload := event.Data.(type) {
+	case claude.ContextUsage:
+		if event.Type != claude.SessionEventContextWarning {
+			return
+		}
+		eventType = wsEventContextWarning
+		data = map[string]interface{}{"usage": payload}
+	case claude.ContextCompaction:
+		if event.Type != claude.SessionEventCompacted {
+			return
+		}
+		eventType = wsEventContextCompacted
+		data = map[string]interface{}{"compaction": payload}
+	default:
+		return
+	}
+	if event.Error != nil {
+		data["error"] = event.Error.Error()
+	}
+
+	message := websocket.NewMessage(websocket.MessageTypeEvent, websocket.EventMessage{
+		Type:      eventType,
+		Source:    "claude",
+		SessionID: event.SessionID,
+		Data:      data,
+	})
+	b.hub.Broadcast(message, websocket.GetSessionChannel(event.SessionID))
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// customMethodHandler는 `/resources:verb` 형식의 커스텀 메서드 경로를
+// gin 라우터가 처리할 수 있는 `/resources/verb` 형식으로 변환합니다.
+// gin은 경로 세그먼트 중간의 콜론을 파라미터로 해석하므로 라우팅 전에 변환이 필요합니다.
+func customMethodHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rewritten, ok := rewriteCustomMethod(r.URL.Path); ok {
+			r.URL.Path = rewritten
+			r.URL.RawPath = ""
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rewriteCustomMethod는 마지막 세그먼트의 `name:verb`를 `name/verb`로 변환합니다.
+func rewriteCustomMethod(path string) (string, bool) {
+	if !strings.HasPrefix(path, "/api/") {
+		return path, false
+	}
+
+	slash := strings.LastIndex(path, "/")
+	last := path[slash+1:]
+	colon := strings.Index(last, ":")
+	if colon <= 0 || colon == len(last)-1 {
+		return path, false
+	}
+
+	return path[:slash+1] + last[:colon] + "/" + last[colon+1:], true
+}
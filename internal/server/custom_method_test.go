@@ -0,0 +1,26 @@
+package server
+
+import (
+	"testing"
+)
+
+func TestRewriteCustomMethod(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+		rewrote  bool
+	}{
+		{"/api/v1/workspaces:batchDelete", "/api/v1/workspaces/batchDelete", true},
+		{"/api/v1/tasks:batchCreate", "/api/v1/tasks/batchCreate", true},
+		{"/api/v1/workspaces/ws_1", "/api/v1/workspaces/ws_1", false},
+		{"/api/v1/workspaces:", "/api/v1/workspaces:", false},
+		{"/ws:upgrade", "/ws:upgrade", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := rewriteCustomMethod(tt.path)
+		if got != tt.expected || ok != tt.rewrote {
+			t.Errorf("rewriteCustomMethod(%q) = (%q, %v), 기대값 (%q, %v)", tt.path, got, ok, tt.expected, tt.rewrote)
+		}
+	}
+}
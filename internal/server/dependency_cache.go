@@ -0,0 +1,32 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/docker"
+)
+
+// NewCacheManagerFromConfig 설정으로 워크스페이스 의존성 캐시 관리자를 구성합니다 (비활성이면 nil)
+func NewCacheManagerFromConfig(cfg config.DependencyCacheConfig, dockerManager *docker.Manager) *docker.CacheManager {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var store docker.CacheStore
+	switch docker.CacheBackend(cfg.Backend) {
+	case docker.CacheBackendHost:
+		if cfg.HostDir == "" {
+			return nil
+		}
+		store = docker.NewHostCacheStore(cfg.HostDir)
+	default:
+		if dockerManager == nil {
+			return nil
+		}
+		store = docker.NewVolumeCacheStore(dockerManager.Client())
+	}
+
+	return docker.NewCacheManager(store, docker.CacheConfig{
+		MaxTotalSize:     cfg.MaxTotalSizeMB * 1024 * 1024,
+		MaxWorkspaceSize: cfg.MaxWorkspaceSizeMB * 1024 * 1024,
+	})
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/email"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// NewMailerFromConfig 설정으로 메일 발송기를 구성합니다 (email.driver가 비어 있으면 nil)
+func NewMailerFromConfig(cfg config.EmailConfig, deliveries storage.EmailDeliveryStorage, logger *logrus.Logger) (*email.Mailer, error) {
+	sender, err := email.NewSenderFromConfig(cfg, logger)
+	if err != nil || sender == nil {
+		return nil, err
+	}
+	renderer, err := email.NewRenderer(cfg.TemplateDir)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Driver == "log" {
+		logger.Warn("email.driver가 log이므로 메일을 보내지 않고 본문을 로그에 남깁니다 (개발용)")
+	}
+	return email.NewMailer(sender, renderer, deliveries, email.MailerConfigFrom(cfg), logger), nil
+}
+
+// newNotificationServiceFromConfig 메일 발송기로 초대, 비밀번호 재설정, 예산 알림, 보안 알림을 보낼 알림 서비스 구성
+// 발송기가 없으면 nil을 반환합니다.
+func newNotificationServiceFromConfig(cfg *config.Config, mailer *email.Mailer) services.NotificationService {
+	if mailer == nil {
+		return nil
+	}
+	return services.NewNotificationService(mailer, &services.NotificationConfig{
+		BaseURL:            cfg.Email.BaseURL,
+		ResetTokenExpiry:   cfg.Accounts.ResetTokenExpiry,
+		SecurityRecipients: cfg.Email.SecurityRecipients,
+	})
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/broker"
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// ErrorTrendAlertManager는 에러 추세 알림을 로그로 남기고 외부 브로커가 있으면 이벤트로 발행합니다
+type ErrorTrendAlertManager struct {
+	connector *broker.Connector
+	logger    *logrus.Logger
+}
+
+// NewErrorTrendAlertManager 새로운 알림 관리자를 생성합니다 (connector는 nil 가능)
+func NewErrorTrendAlertManager(connector *broker.Connector, logger *logrus.Logger) *ErrorTrendAlertManager {
+	return &ErrorTrendAlertManager{
+		connector: connector,
+		logger:    logger,
+	}
+}
+
+// SendAlert 알림을 기록하고 errors.trend_alert 이벤트로 발행합니다
+func (m *ErrorTrendAlertManager) SendAlert(level claude.AlertLevel, message string, context map[string]interface{}) error {
+	entry := m.logger.WithFields(logrus.Fields(context))
+	if level >= claude.AlertLevelError {
+		entry.Error(message)
+	} else {
+		entry.Warn(message)
+	}
+
+	if m.connector == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"level":   int(level),
+		"message": message,
+	}
+	for key, value := range context {
+		data[key] = value
+	}
+	return m.connector.Publish(&broker.Event{
+		ID:        uuid.New().String(),
+		Type:      "errors.trend_alert",
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
+// NewErrorTrendMonitorFromConfig 설정으로 에러 추세 모니터를 구성합니다 (비활성이면 nil)
+func NewErrorTrendMonitorFromConfig(cfg config.ErrorTrendConfig, collector *claude.ErrorStatisticsCollector, alerts claude.AlertManager, logger *logrus.Logger) (*claude.ErrorTrendMonitor, error) {
+	if !cfg.Enabled || collector == nil {
+		return nil, nil
+	}
+
+	return claude.NewErrorTrendMonitor(collector, claude.ErrorTrendConfig{
+		Analyzer:     cfg.Analyzer,
+		Window:       cfg.Window,
+		Interval:     cfg.Interval,
+		Threshold:    cfg.Threshold,
+		Sensitivity:  cfg.Sensitivity,
+		Alpha:        cfg.Alpha,
+		Beta:         cfg.Beta,
+		Gamma:        cfg.Gamma,
+		SeasonLength: cfg.SeasonLength,
+	}, alerts, logger)
+}
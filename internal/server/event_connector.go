@@ -0,0 +1,47 @@
+package server
+
+import (
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/broker"
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+// SessionEventForwarder는 세션 이벤트 버스의 이벤트를 외부 브로커 커넥터로 전달합니다
+type SessionEventForwarder struct {
+	connector *broker.Connector
+	logger    *logrus.Logger
+}
+
+// NewSessionEventForwarder 새로운 포워더를 생성합니다
+func NewSessionEventForwarder(connector *broker.Connector, logger *logrus.Logger) *SessionEventForwarder {
+	return &SessionEventForwarder{
+		connector: connector,
+		logger:    logger,
+	}
+}
+
+// OnSessionEvent 세션 이벤트를 브로커 이벤트로 변환하여 발행 큐에 추가합니다
+func (f *SessionEventForwarder) OnSessionEvent(event claude.SessionEvent) {
+	data := map[string]interface{}{
+		"session_id": event.SessionID,
+	}
+	if event.Data != nil {
+		data["data"] = event.Data
+	}
+	if event.Error != nil {
+		data["error"] = event.Error.Error()
+	}
+
+	err := f.connector.Publish(&broker.Event{
+		ID:        uuid.New().String(),
+		Type:      "session." + event.Type.String(),
+		Subject:   event.SessionID,
+		Timestamp: event.Timestamp,
+		Data:      data,
+	})
+	if err != nil && f.logger != nil {
+		f.logger.WithError(err).WithField("session_id", event.SessionID).Debug("세션 이벤트 전달 실패")
+	}
+}
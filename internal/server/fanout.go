@@ -0,0 +1,44 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewFanOutService 팬아웃 서비스를 구성하고 태스크 종료 알림과 WebSocket 진행 상황 전달을 연결합니다
+// artifactService가 nil이면 통합 보고서는 API로만 조회할 수 있습니다.
+func NewFanOutService(store storage.Storage, prompts *services.PromptService, taskService *services.TaskService, artifactService *services.ArtifactService, hub *websocket.Hub) *services.FanOutService {
+	var reports services.FanOutReportStore
+	if artifactService != nil {
+		reports = artifactService
+	}
+
+	fanOutService := services.NewFanOutService(store, prompts, taskService, reports)
+	taskService.AddFinishListener(fanOutService)
+	if hub != nil {
+		fanOutService.SetListener(&fanOutBroadcaster{hub: hub})
+	}
+	return fanOutService
+}
+
+// fanOutBroadcaster 팬아웃 진행 상황을 시작한 사용자의 사용자 채널로 전달합니다
+type fanOutBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *fanOutBroadcaster) OnFanOutUpdate(fanOut *models.FanOut) {
+	data := map[string]interface{}{
+		"name":     fanOut.Name,
+		"progress": fanOut.Progress,
+		"targets":  fanOut.Targets,
+	}
+	if fanOut.ReportKey != "" {
+		data["report_key"] = fanOut.ReportKey
+	}
+
+	msg := websocket.NewStatusMessage("fanout", fanOut.ID, string(fanOut.Status), data)
+	msg.Channel = websocket.GetUserChannel(fanOut.OwnerID)
+	b.hub.BroadcastToUsers(msg, fanOut.OwnerID)
+}
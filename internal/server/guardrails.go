@@ -0,0 +1,23 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewPromptGuardrailsFromConfig 설정으로 시스템 프롬프트 가드레일을 구성합니다 (비활성이면 nil)
+func NewPromptGuardrailsFromConfig(cfg config.GuardrailsConfig) (*claude.PromptGuardrails, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	fragments := make([]claude.GuardrailFragment, 0, len(cfg.Fragments))
+	for _, fragment := range cfg.Fragments {
+		fragments = append(fragments, claude.GuardrailFragment{
+			Name:  fragment.Name,
+			Text:  fragment.Text,
+			Order: fragment.Order,
+		})
+	}
+	return claude.NewPromptGuardrails(fragments)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"strconv"
 	"time"
@@ -22,6 +23,31 @@ type ClaudeHandler struct {
 	claudeWrapper claude.Wrapper
 	sessionStore  storage.SessionStorage
 	wsHub         *websocket.Hub
+	mcpProvider   claude.MCPServerProvider
+	envProvider   claude.EnvironmentProvider
+	agents        *claude.AgentRegistry
+	restarts      claude.RestartPolicyProvider
+	hangPolicy    *claude.HangPolicy
+	recorder      MessageRecorder
+	redactor      *claude.OutputRedactor
+	secrets       SecretMasker
+	access        WorkspaceAuthorizer
+	languages     claude.ResponseLanguageProvider
+}
+
+// WorkspaceAuthorizer는 요청한 사용자의 워크스페이스 권한을 확인합니다.
+type WorkspaceAuthorizer interface {
+	Authorize(ctx context.Context, workspaceID, userID string, required models.WorkspacePermission) (*models.Workspace, error)
+}
+
+// SecretMasker는 출력에 나타난 워크스페이스 비밀 변수 값을 가립니다.
+type SecretMasker interface {
+	MaskSecrets(ctx context.Context, workspaceID, text string) string
+}
+
+// MessageRecorder는 세션 대화 기록을 저장합니다.
+type MessageRecorder interface {
+	Record(ctx context.Context, message *models.SessionMessage) error
 }
 
 // NewClaudeHandler는 새로운 Claude 핸들러를 생성합니다.
@@ -33,6 +59,57 @@ func NewClaudeHandler(wrapper claude.Wrapper, store storage.SessionStorage, hub
 	}
 }
 
+// SetMCPServerProvider는 새 세션에 연결할 워크스페이스 MCP 서버 제공자를 설정합니다.
+func (h *ClaudeHandler) SetMCPServerProvider(provider claude.MCPServerProvider) {
+	h.mcpProvider = provider
+}
+
+// SetEnvironmentProvider는 새 세션 프로세스에 전달할 워크스페이스 환경 변수 제공자를 설정합니다.
+// 비밀 값이 포함되므로 SetWorkspaceAuthorizer로 권한 확인이 설정된 경우에만 전달합니다.
+func (h *ClaudeHandler) SetEnvironmentProvider(provider claude.EnvironmentProvider) {
+	h.envProvider = provider
+}
+
+// SetWorkspaceAuthorizer는 실행 요청의 워크스페이스 권한을 확인할 인가 서비스를 설정합니다.
+func (h *ClaudeHandler) SetWorkspaceAuthorizer(access WorkspaceAuthorizer) {
+	h.access = access
+}
+
+// SetSecretMasker는 기록하거나 WebSocket으로 보내는 실행 결과에서 비밀 변수 값을 가릴 서비스를 설정합니다.
+func (h *ClaudeHandler) SetSecretMasker(secrets SecretMasker) {
+	h.secrets = secrets
+}
+
+// SetAgentRegistry는 워크스페이스별 에이전트 프로바이더를 결정할 레지스트리를 설정합니다.
+func (h *ClaudeHandler) SetAgentRegistry(registry *claude.AgentRegistry) {
+	h.agents = registry
+}
+
+// SetRestartPolicyProvider는 새 세션 프로세스에 적용할 워크스페이스별 자동 재시작 정책 제공자를 설정합니다.
+func (h *ClaudeHandler) SetRestartPolicyProvider(provider claude.RestartPolicyProvider) {
+	h.restarts = provider
+}
+
+// SetHangPolicy는 새 세션 프로세스에 적용할 멈춤 감지 정책을 설정합니다.
+func (h *ClaudeHandler) SetHangPolicy(policy *claude.HangPolicy) {
+	h.hangPolicy = policy
+}
+
+// SetMessageRecorder는 실행한 프롬프트와 응답을 저장할 대화 기록 저장소를 설정합니다.
+func (h *ClaudeHandler) SetMessageRecorder(recorder MessageRecorder) {
+	h.recorder = recorder
+}
+
+// SetOutputRedactor는 기록하거나 WebSocket으로 보내는 실행 결과에 적용할 마스킹 필터를 설정합니다.
+func (h *ClaudeHandler) SetOutputRedactor(redactor *claude.OutputRedactor) {
+	h.redactor = redactor
+}
+
+// SetResponseLanguageProvider는 새 세션에 적용할 사용자 응답 언어 제공자를 설정합니다.
+func (h *ClaudeHandler) SetResponseLanguageProvider(provider claude.ResponseLanguageProvider) {
+	h.languages = provider
+}
+
 // ExecuteRequest는 Claude 실행 요청 구조체입니다.
 type ExecuteRequest struct {
 	WorkspaceID  string                 `json:"workspace_id" binding:"required"`
@@ -63,6 +140,17 @@ func (h *ClaudeHandler) Execute(c *gin.Context) {
 		return
 	}
 
+	// 워크스페이스 실행 권한 확인 (시스템 admin은 확인하지 않음)
+	if h.access != nil && c.GetString("role") != "admin" {
+		if _, err := h.access.Authorize(c.Request.Context(), req.WorkspaceID, c.GetString("user_id"), models.WorkspacePermissionExecute); err != nil {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Workspace access denied",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
 	// 세션 생성 또는 재사용
 	session, err := h.getOrCreateSession(c, req)
 	if err != nil {
@@ -146,6 +234,37 @@ func (h *ClaudeHandler) getOrCreateSession(c *gin.Context, req ExecuteRequest) (
 		config.MaxTurns = 10 // 기본값
 	}
 
+	// 워크스페이스에 지정된 에이전트 프로바이더 사용
+	if h.agents != nil {
+		config.Provider = h.agents.ProviderFor(req.WorkspaceID)
+	}
+
+	// 워크스페이스에 선언된 MCP 서버 연결
+	if h.mcpProvider != nil {
+		config.MCPServers = h.mcpProvider.MCPServers(req.WorkspaceID)
+	}
+
+	// 워크스페이스에 등록된 환경 변수 전달 (권한을 확인한 요청에만)
+	if h.envProvider != nil && h.access != nil {
+		config.Environment = h.envProvider.WorkspaceEnvironment(req.WorkspaceID)
+	}
+
+	// 요청한 사용자가 설정한 응답 언어
+	if h.languages != nil {
+		config.ResponseLanguage = h.languages.ResponseLanguage(c.Request.Context(), c.GetString("user_id"))
+	}
+
+	// 워크스페이스에 적용할 자동 재시작 정책
+	if h.restarts != nil {
+		config.RestartPolicy = h.restarts.RestartPolicy(req.WorkspaceID)
+	}
+
+	// 멈춘 프로세스 감지 정책 (세션마다 사본 사용)
+	if h.hangPolicy != nil {
+		policy := *h.hangPolicy
+		config.HangPolicy = &policy
+	}
+
 	session, err := h.claudeWrapper.CreateSession(config)
 	if err != nil {
 		return nil, err
@@ -162,7 +281,7 @@ func (h *ClaudeHandler) executeAsync(ctx context.Context, session *claude.Sessio
 			if h.wsHub != nil {
 				data := map[string]interface{}{
 					"execution_id": executionID,
-					"error":        fmt.Sprintf("Execution panic: %v", r),
+					"error":        h.mask(req.WorkspaceID, fmt.Sprintf("Execution panic: %v", r)),
 					"timestamp":    time.Now(),
 				}
 				dataBytes, _ := json.Marshal(data)
@@ -175,15 +294,21 @@ func (h *ClaudeHandler) executeAsync(ctx context.Context, session *claude.Sessio
 		}
 	}()
 
+	// 프롬프트 기록
+	h.recordMessage(session.ID, req.WorkspaceID, models.MessageRoleUser, "prompt", req.Prompt)
+
 	// Claude 실행
 	result, err := h.claudeWrapper.Execute(session.ID, req.Prompt)
 	if err != nil {
+		errText := h.mask(req.WorkspaceID, err.Error())
+		h.recordMessage(session.ID, req.WorkspaceID, models.MessageRoleSystem, "error", errText)
+
 		// 에러 메시지를 WebSocket으로 전송
 		if h.wsHub != nil && req.Stream {
 			data := map[string]interface{}{
 				"execution_id": executionID,
 				"session_id":   session.ID,
-				"error":        err.Error(),
+				"error":        errText,
 				"timestamp":    time.Now(),
 			}
 			dataBytes, _ := json.Marshal(data)
@@ -196,6 +321,16 @@ func (h *ClaudeHandler) executeAsync(ctx context.Context, session *claude.Sessio
 		return
 	}
 
+	// 마스킹 필터가 있으면 원본 결과 대신 마스킹한 텍스트를 기록하고 전송
+	content := resultContent(result)
+	if h.redactor != nil || h.secrets != nil {
+		content = h.mask(req.WorkspaceID, content)
+		result = content
+	}
+
+	// 응답 기록
+	h.recordMessage(session.ID, req.WorkspaceID, models.MessageRoleAssistant, "result", content)
+
 	// 성공 결과를 WebSocket으로 전송
 	if h.wsHub != nil && req.Stream {
 		data := map[string]interface{}{
@@ -213,6 +348,54 @@ func (h *ClaudeHandler) executeAsync(ctx context.Context, session *claude.Sessio
 	}
 }
 
+// mask는 실행 결과에 마스킹 필터를 적용하고 워크스페이스 비밀 변수 값을 가립니다.
+func (h *ClaudeHandler) mask(workspaceID, text string) string {
+	text = h.redactor.Redact(workspaceID, text)
+	if h.secrets != nil {
+		text = h.secrets.MaskSecrets(context.Background(), workspaceID, text)
+	}
+	return text
+}
+
+// recordMessage는 대화 기록을 저장합니다. 실패해도 실행에는 영향을 주지 않습니다.
+func (h *ClaudeHandler) recordMessage(sessionID, workspaceID string, role models.MessageRole, messageType, content string) {
+	if h.recorder == nil || content == "" {
+		return
+	}
+	// 요청 컨텍스트는 응답 후 취소되므로 별도 컨텍스트 사용
+	err := h.recorder.Record(context.Background(), &models.SessionMessage{
+		SessionID:   sessionID,
+		WorkspaceID: workspaceID,
+		Role:        role,
+		Type:        messageType,
+		Content:     content,
+	})
+	if err != nil {
+		log.Printf("대화 기록 저장 실패 (세션: %s): %v", sessionID, err)
+	}
+}
+
+// resultContent는 실행 결과에서 기록할 텍스트를 추출합니다.
+func resultContent(result interface{}) string {
+	switch v := result.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}:
+		for _, key := range []string{"content", "output", "result"} {
+			if text, ok := v[key].(string); ok && text != "" {
+				return text
+			}
+		}
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Sprintf("%v", result)
+	}
+	return string(data)
+}
+
 // ListSessions는 세션 목록을 조회합니다.
 func (h *ClaudeHandler) ListSessions(c *gin.Context) {
 	workspaceID := c.Query("workspace_id")
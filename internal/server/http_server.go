@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewHTTPServer 설정으로 API 서버의 http.Server를 구성합니다
+// tlsConfig가 있으면 ALPN으로 HTTP/2를 협상하고, 없으면 server.http2.h2c일 때만 평문 HTTP/2를 받습니다.
+// 반환한 ConnTracker로 종료할 때 남은 연결을 확인할 수 있습니다.
+func NewHTTPServer(cfg config.ServerConfig, handler http.Handler, tlsConfig *tls.Config) (*http.Server, *ConnTracker, error) {
+	tracker := NewConnTracker()
+	srv := &http.Server{
+		Handler:           handler,
+		TLSConfig:         tlsConfig,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+		ConnState:         tracker.track,
+	}
+	srv.SetKeepAlivesEnabled(cfg.KeepAlive)
+
+	if !cfg.HTTP2.Enabled {
+		// TLSNextProto가 nil이면 net/http가 HTTP/2를 자동으로 켜므로 빈 맵으로 끔
+		srv.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
+		if tlsConfig != nil {
+			srv.TLSConfig = tlsConfig.Clone()
+			srv.TLSConfig.NextProtos = withoutProto(tlsConfig.NextProtos, http2.NextProtoTLS)
+		}
+		return srv, tracker, nil
+	}
+
+	h2s := &http2.Server{
+		MaxConcurrentStreams: cfg.HTTP2.MaxConcurrentStreams,
+		MaxReadFrameSize:     cfg.HTTP2.MaxReadFrameSize,
+		ReadIdleTimeout:      cfg.HTTP2.ReadIdleTimeout,
+		PingTimeout:          cfg.HTTP2.PingTimeout,
+	}
+	if err := http2.ConfigureServer(srv, h2s); err != nil {
+		return nil, nil, err
+	}
+	if tlsConfig == nil && cfg.HTTP2.H2C {
+		srv.Handler = h2c.NewHandler(handler, h2s)
+	}
+	return srv, tracker, nil
+}
+
+// ShutdownHTTPServer는 연결을 정리하며 서버를 종료합니다.
+// 새 연결을 받지 않고 유휴 keep-alive 연결은 바로 닫으며, 처리 중인 요청은 응답 후 연결을 닫고
+// HTTP/2 연결에는 GOAWAY를 보냅니다. ctx 기한까지 끝나지 않은 연결은 강제로 닫고 기한 초과 오류를 반환합니다.
+func ShutdownHTTPServer(ctx context.Context, srv *http.Server) error {
+	err := srv.Shutdown(ctx)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		if closeErr := srv.Close(); closeErr != nil {
+			return errors.Join(err, closeErr)
+		}
+	}
+	return err
+}
+
+// ConnTracker는 HTTP 서버 연결을 상태별로 셉니다.
+// WebSocket처럼 HTTP 서버가 넘겨준 연결은 더 추적하지 않습니다.
+type ConnTracker struct {
+	mu    sync.Mutex
+	conns map[net.Conn]http.ConnState
+}
+
+// ConnStats는 상태별 연결 수입니다.
+type ConnStats struct {
+	Active int `json:"active"` // 요청을 처리 중인 연결
+	Idle   int `json:"idle"`   // 다음 요청을 기다리는 keep-alive 연결
+}
+
+// NewConnTracker는 새로운 연결 추적기를 생성합니다.
+func NewConnTracker() *ConnTracker {
+	return &ConnTracker{conns: make(map[net.Conn]http.ConnState)}
+}
+
+// Stats는 현재 상태별 연결 수를 반환합니다.
+func (t *ConnTracker) Stats() ConnStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var stats ConnStats
+	for _, state := range t.conns {
+		switch state {
+		case http.StateNew, http.StateActive:
+			stats.Active++
+		case http.StateIdle:
+			stats.Idle++
+		}
+	}
+	return stats
+}
+
+// track은 http.Server.ConnState 콜백입니다.
+func (t *ConnTracker) track(conn net.Conn, state http.ConnState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// 넘겨준 연결은 닫혀도 StateClosed가 오지 않음
+	if state == http.StateClosed || state == http.StateHijacked {
+		delete(t.conns, conn)
+		return
+	}
+	t.conns[conn] = state
+}
+
+// withoutProto는 protos에서 proto를 뺀 새 목록을 반환합니다.
+func withoutProto(protos []string, proto string) []string {
+	result := make([]string, 0, len(protos))
+	for _, p := range protos {
+		if p != proto {
+			result = append(result, p)
+		}
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// serveHTTP는 임의 포트에서 srv를 시작하고 주소를 반환합니다.
+func serveHTTP(t *testing.T, srv *http.Server) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go srv.Serve(listener)
+	t.Cleanup(func() { srv.Close() })
+	return listener.Addr().String()
+}
+
+func TestNewHTTPServer(t *testing.T) {
+	cfg := config.GetDefaultConfig().Server
+	cfg.WriteTimeout = time.Minute
+	cfg.MaxHeaderBytes = 64 * 1024
+
+	srv, _, err := NewHTTPServer(cfg, http.NotFoundHandler(), &tls.Config{NextProtos: []string{"h2", "http/1.1"}})
+	require.NoError(t, err)
+	assert.Equal(t, config.DefaultServerReadHeaderTimeout, srv.ReadHeaderTimeout)
+	assert.Equal(t, config.DefaultServerIdleTimeout, srv.IdleTimeout)
+	assert.Equal(t, time.Minute, srv.WriteTimeout)
+	assert.Equal(t, 64*1024, srv.MaxHeaderBytes)
+	assert.Contains(t, srv.TLSNextProto, http2.NextProtoTLS)
+
+	// HTTP/2를 끄면 ALPN에서도 빼고 자동 설정도 막음
+	cfg.HTTP2.Enabled = false
+	srv, _, err = NewHTTPServer(cfg, http.NotFoundHandler(), &tls.Config{NextProtos: []string{"h2", "http/1.1"}})
+	require.NoError(t, err)
+	assert.NotNil(t, srv.TLSNextProto)
+	assert.Empty(t, srv.TLSNextProto)
+	assert.Equal(t, []string{"http/1.1"}, srv.TLSConfig.NextProtos)
+}
+
+func TestNewHTTPServer_H2C(t *testing.T) {
+	cfg := config.GetDefaultConfig().Server
+	cfg.HTTP2.H2C = true
+
+	srv, _, err := NewHTTPServer(cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.Proto)
+	}), nil)
+	require.NoError(t, err)
+	addr := serveHTTP(t, srv)
+
+	client := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}}
+	resp, err := client.Get("http://" + addr + "/")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "HTTP/2.0", string(body))
+}
+
+func TestShutdownHTTPServer(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	slowErr := make(chan error, 1)
+
+	srv, conns, err := NewHTTPServer(config.GetDefaultConfig().Server, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			close(started)
+			<-release
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}), nil)
+	require.NoError(t, err)
+	addr := serveHTTP(t, srv)
+
+	// keep-alive 연결 하나는 유휴 상태로 남김
+	idleClient := &http.Client{Transport: &http.Transport{}}
+	resp, err := idleClient.Get("http://" + addr + "/")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	go func() {
+		resp, err := http.Get("http://" + addr + "/slow")
+		if err == nil {
+			resp.Body.Close()
+		}
+		slowErr <- err
+	}()
+	<-started
+	require.Eventually(t, func() bool {
+		stats := conns.Stats()
+		return stats.Active == 1 && stats.Idle == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// 처리 중인 요청이 기한까지 끝나지 않으면 강제로 닫음
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	err = ShutdownHTTPServer(ctx, srv)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Error(t, <-slowErr)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		stats := conns.Stats()
+		return stats.Active == 0 && stats.Idle == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/backup"
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/cluster"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/jobs"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// 백그라운드 작업 이름 (jobs.schedules 설정 키와 /admin/jobs/{name} 경로에 사용)
+const (
+	JobBackup             = "backup"
+	JobProcessRecordPurge = "process-record-purge"
+	JobOrphanProcessReap  = "orphan-process-reap"
+	JobSearchReindex      = "search-reindex"
+	JobNotificationDigest = "notification-digest"
+	JobAnalyticsRollup    = "analytics-rollup"
+	jobScheduleOff        = "off"
+)
+
+// NewJobRunnerFromConfig 백그라운드 작업 실행기를 만들고 사용할 수 있는 작업을 등록합니다
+// 다중 레플리카에서는 작업마다 클러스터 잠금을 잡아 여러 인스턴스에서 동시에 실행되지 않게 합니다.
+// 클러스터가 없으면 storageGuard(PostgreSQL advisory lock 등, nil이면 잠금 없음)를 대신 사용합니다.
+func NewJobRunnerFromConfig(cfg *config.Config, clusterNode *cluster.Cluster, storageGuard cluster.Guard, backupManager *backup.Manager, processReaper *claude.ProcessReaper, searchService *services.SearchService, notifications *services.NotificationCenter, analytics *services.AnalyticsService, logger *logrus.Logger) *jobs.Runner {
+	guard := storageGuard
+	if clusterNode != nil {
+		guard = clusterNode
+	}
+	runner := jobs.NewRunner(guard, logger)
+
+	register := func(job jobs.Job, defaultSchedule string) {
+		job.Schedule = jobSchedule(cfg.Jobs, job.Name, defaultSchedule, logger)
+		job.Timeout = cfg.Jobs.Timeout
+		if err := runner.Register(job); err != nil {
+			logger.WithError(err).Warn("백그라운드 작업 등록 실패")
+		}
+	}
+
+	if backupManager != nil {
+		schedule := ""
+		if cfg.Backup.Enabled && cfg.Backup.Interval > 0 {
+			schedule = everySpec(cfg.Backup.Interval)
+		}
+		register(jobs.Job{
+			Name:        JobBackup,
+			Description: "스토리지, 시크릿, 워크스페이스 메타데이터 백업 생성과 보관 개수 정리",
+			Run: func(ctx context.Context) error {
+				snapshot, err := backupManager.Create(ctx)
+				if err != nil {
+					return err
+				}
+				logger.WithField("snapshot", snapshot.ID).WithField("size", snapshot.Size).Info("예약 백업 완료")
+				return nil
+			},
+		}, schedule)
+	}
+
+	if processReaper != nil {
+		register(jobs.Job{
+			Name:        JobOrphanProcessReap,
+			Description: "이전 실행에서 남은 고아 CLI 프로세스 점검과 정리 (인스턴스별)",
+			Local:       true,
+			Run: func(ctx context.Context) error {
+				report, err := processReaper.Reap(ctx, "", cfg.Reaper.DryRun)
+				if err != nil {
+					return err
+				}
+				for _, entry := range report.Entries {
+					logger.WithFields(logrus.Fields{
+						"pid":     entry.PID,
+						"command": entry.Command,
+						"result":  entry.Result,
+						"error":   entry.Error,
+					}).Info("고아 프로세스 점검 결과")
+				}
+				return nil
+			},
+		}, "")
+
+		if clusterNode != nil {
+			schedule := ""
+			if cfg.Cluster.ReapInterval > 0 {
+				schedule = everySpec(cfg.Cluster.ReapInterval)
+			}
+			register(jobs.Job{
+				Name:        JobProcessRecordPurge,
+				Description: "멤버십이 끊긴 인스턴스가 남긴 프로세스 기록 정리",
+				Run: func(ctx context.Context) error {
+					purged, err := processReaper.PurgeInstances(ctx, clusterNode.Alive)
+					if purged > 0 {
+						logger.WithField("purged", purged).Info("종료된 인스턴스의 프로세스 기록 정리")
+					}
+					return err
+				},
+			}, schedule)
+		}
+	}
+
+	if searchService != nil {
+		schedule := ""
+		if cfg.Search.ReindexInterval > 0 {
+			schedule = everySpec(cfg.Search.ReindexInterval)
+		}
+		register(jobs.Job{
+			Name:        JobSearchReindex,
+			Description: "워크스페이스, 세션, 프롬프트, 셸 명령 기록으로 통합 검색 인덱스 다시 만들기",
+			// 메모리 인덱스는 인스턴스마다 있으므로 인스턴스별로 실행
+			Local: cfg.Search.Backend != "elasticsearch",
+			Run: func(ctx context.Context) error {
+				count, err := searchService.Reindex(ctx)
+				if err != nil {
+					return err
+				}
+				logger.WithField("documents", count).Debug("통합 검색 인덱스 갱신")
+				return nil
+			},
+		}, schedule)
+	}
+
+	if notifications != nil {
+		schedule := ""
+		if cfg.Notifications.DigestInterval > 0 {
+			schedule = everySpec(cfg.Notifications.DigestInterval)
+		}
+		register(jobs.Job{
+			Name:        JobNotificationDigest,
+			Description: "요약 메일로 받도록 설정한 알림을 사용자별 요약 메일로 발송",
+			Run: func(ctx context.Context) error {
+				sent, err := notifications.SendDigests(ctx)
+				if sent > 0 {
+					logger.WithField("notifications", sent).Info("알림 요약 메일 발송")
+				}
+				return err
+			},
+		}, schedule)
+	}
+
+	if analytics != nil {
+		schedule := ""
+		if cfg.Analytics.RollupInterval > 0 {
+			schedule = everySpec(cfg.Analytics.RollupInterval)
+		}
+		register(jobs.Job{
+			Name:        JobAnalyticsRollup,
+			Description: "마지막 집계 이후에 끝난 태스크를 사용량 분석 일별 집계에 반영",
+			Run: func(ctx context.Context) error {
+				count, err := analytics.Rollup(ctx)
+				if err != nil {
+					return err
+				}
+				logger.WithField("tasks", count).Debug("사용량 분석 집계 갱신")
+				return nil
+			},
+		}, schedule)
+	}
+
+	return runner
+}
+
+// jobSchedule 설정의 재정의를 반영한 작업 실행 주기 (nil이면 수동으로만 실행)
+// 재정의가 잘못되었으면 경고하고 기본 주기를 사용합니다.
+func jobSchedule(cfg config.JobsConfig, name, defaultSpec string, logger *logrus.Logger) jobs.Schedule {
+	spec := defaultSpec
+	if override, ok := cfg.Schedules[name]; ok && override != "" {
+		if override == jobScheduleOff {
+			return nil
+		}
+		if _, err := jobs.ParseSchedule(override); err != nil {
+			logger.WithError(err).WithField("job", name).Warn("잘못된 작업 실행 주기, 기본값 사용")
+		} else {
+			spec = override
+		}
+	}
+	if spec == "" {
+		return nil
+	}
+
+	schedule, err := jobs.ParseSchedule(spec)
+	if err != nil {
+		logger.WithError(err).WithField("job", name).Warn("작업 실행 주기 해석 실패, 수동 실행만 가능")
+		return nil
+	}
+	return schedule
+}
+
+func everySpec(interval time.Duration) string {
+	return fmt.Sprintf("@every %s", interval)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+func TestJobSchedule(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	cfg := config.JobsConfig{Schedules: map[string]string{
+		"nightly": "0 3 * * *",
+		"manual":  "off",
+		"broken":  "every day",
+	}}
+
+	schedule := jobSchedule(cfg, "nightly", "@every 1h", logger)
+	require.NotNil(t, schedule)
+	assert.Equal(t, "0 3 * * *", schedule.String())
+
+	assert.Nil(t, jobSchedule(cfg, "manual", "@every 1h", logger))
+
+	// 잘못된 재정의는 기본값 사용
+	schedule = jobSchedule(cfg, "broken", "@every 1h", logger)
+	require.NotNil(t, schedule)
+	assert.Equal(t, "@every 1h0m0s", schedule.String())
+
+	assert.Nil(t, jobSchedule(cfg, "other", "", logger))
+}
+
+func TestNewJobRunnerFromConfig(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	// 의존성이 없으면 등록할 작업도 없음
+	runner := NewJobRunnerFromConfig(config.GetDefaultConfig(), nil, nil, nil, nil, nil, nil, nil, logger)
+	assert.Empty(t, runner.List())
+
+	runner.Start(context.Background())
+	assert.True(t, runner.Scheduling())
+	runner.Stop()
+
+	_, err := runner.Get(JobBackup)
+	assert.Error(t, err)
+}
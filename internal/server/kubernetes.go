@@ -0,0 +1,129 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/kubernetes"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewKubernetesTaskRunnerFromConfig 설정으로 Kubernetes 태스크 실행기를 구성합니다
+// 비활성화되어 있으면 nil을 반환하며, 태스크는 로컬 프로세스로 실행됩니다.
+// contexts가 있으면 Claude CLI 출력의 토큰 사용량을 태스크 세션의 컨텍스트 사용량으로 기록하고,
+// redactor가 있으면 WebSocket으로 보내는 로그를 워크스페이스 정책으로 마스킹합니다.
+func NewKubernetesTaskRunnerFromConfig(cfg config.KubernetesConfig, hub *websocket.Hub, contexts *claude.ContextTracker, redactor *claude.OutputRedactor, logger *logrus.Logger) (services.TaskRunner, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	client, err := kubernetes.NewClient(kubernetes.ClientConfig{
+		APIServer: cfg.APIServer,
+		TokenFile: cfg.TokenFile,
+		CAFile:    cfg.CAFile,
+		Insecure:  cfg.Insecure,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = kubernetes.InClusterNamespace()
+	}
+	runnerConfig := kubernetes.RunnerConfig{
+		Namespace:        namespace,
+		Image:            cfg.Image,
+		ImagePullPolicy:  cfg.ImagePullPolicy,
+		ServiceAccount:   cfg.ServiceAccount,
+		ClaimNameFormat:  cfg.ClaimNameFormat,
+		MountPath:        cfg.MountPath,
+		DefaultLimits:    cfg.DefaultLimits,
+		NodeSelector:     cfg.NodeSelector,
+		StartTimeout:     cfg.StartTimeout,
+		TTLAfterFinished: cfg.TTLAfterFinished,
+	}
+	if len(cfg.Quota.Hard) > 0 {
+		runnerConfig.Quota = &kubernetes.QuotaConfig{Name: cfg.Quota.Name, Hard: cfg.Quota.Hard}
+	}
+
+	runner, err := kubernetes.NewRunner(client, runnerConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// 쿼터는 첫 태스크 실행 때도 적용되므로 여기서 실패해도 계속 진행
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := runner.EnsureQuota(ctx); err != nil {
+			logger.WithError(err).Warn("Kubernetes 리소스 쿼터 적용 실패")
+		}
+	}()
+
+	return &kubernetesTaskRunner{runner: runner, hub: hub, contexts: contexts, redactor: redactor, logger: logger}, nil
+}
+
+// kubernetesTaskRunner 태스크를 Kubernetes Job으로 실행하고, 파드 로그를 스트림 핸들러로 파싱해
+// 태스크 채널에 WebSocket 로그 메시지로 전달합니다.
+type kubernetesTaskRunner struct {
+	runner   *kubernetes.Runner
+	hub      *websocket.Hub
+	contexts *claude.ContextTracker
+	redactor *claude.OutputRedactor
+	logger   *logrus.Logger
+}
+
+func (r *kubernetesTaskRunner) RunTask(ctx context.Context, run *services.TaskRun) (string, error) {
+	stream := r.streamHandler(run.Process.Command)
+	if stream != nil && r.contexts != nil && path.Base(run.Process.Command) == "claude" {
+		if _, err := r.contexts.Observe(run.SessionID, stream); err != nil {
+			r.logger.WithError(err).WithField("task_id", run.TaskID).Debug("컨텍스트 사용량 추적 등록 실패")
+		}
+	}
+
+	var output bytes.Buffer
+	result, err := r.runner.Run(ctx, kubernetes.RunRequest{
+		TaskID:      run.TaskID,
+		WorkspaceID: run.WorkspaceID,
+		Process:     run.Process,
+		Stream:      stream,
+		OnMessage: func(msg claude.StreamMessage) error {
+			if r.hub != nil && msg.Content != "" {
+				content := r.redactor.Redact(run.WorkspaceID, msg.Content)
+				logMsg := websocket.NewLogMessage("info", content, "kubernetes", run.SessionID, run.TaskID)
+				r.hub.Broadcast(logMsg, websocket.GetTaskChannel(run.TaskID))
+			}
+			return nil
+		},
+		Output: &output,
+	})
+	if result != nil {
+		r.logger.WithFields(logrus.Fields{
+			"task_id":   run.TaskID,
+			"job":       result.JobName,
+			"pod":       result.PodName,
+			"exit_code": result.ExitCode,
+		}).Info("Kubernetes 태스크 종료")
+	}
+	return output.String(), err
+}
+
+// streamHandler Claude CLI는 stream-json 출력, 그 외 명령은 한 줄씩 텍스트로 파싱
+func (r *kubernetesTaskRunner) streamHandler(command string) claude.StreamHandler {
+	if path.Base(command) == "claude" {
+		return claude.NewStreamHandler(r.logger)
+	}
+	textRunner, err := claude.NewCLIRunner(claude.CLIRunnerConfig{Provider: "task", Command: command})
+	if err != nil {
+		return nil
+	}
+	return claude.NewStreamHandlerForAgent(r.logger, textRunner)
+}
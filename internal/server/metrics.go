@@ -0,0 +1,24 @@
+package server
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// metricsTokenAuth 수집 토큰(api.metrics_token)이 맞으면 메트릭을 바로 응답하고, 아니면 다음 인증 단계(관리자 JWT)로 넘깁니다
+// Prometheus처럼 JWT를 발급받을 수 없는 수집기를 위한 경로입니다.
+func metricsTokenAuth(token string, metrics gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			return
+		}
+		provided, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return
+		}
+		metrics(c)
+		c.Abort()
+	}
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsTokenAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	metrics := func(c *gin.Context) { c.String(http.StatusOK, "metrics") }
+	denied := func(c *gin.Context) { c.AbortWithStatus(http.StatusUnauthorized) }
+
+	newRouter := func(token string) *gin.Engine {
+		router := gin.New()
+		router.GET("/metrics", metricsTokenAuth(token, metrics), denied, metrics)
+		return router
+	}
+	get := func(router *gin.Engine, authorization string) int {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	router := newRouter("scrape-token")
+	assert.Equal(t, http.StatusOK, get(router, "Bearer scrape-token"))
+	// 토큰이 없거나 틀리면 다음 인증 단계(관리자 JWT)로 넘어감
+	assert.Equal(t, http.StatusUnauthorized, get(router, ""))
+	assert.Equal(t, http.StatusUnauthorized, get(router, "Bearer wrong"))
+
+	// 수집 토큰을 설정하지 않으면 빈 Bearer 값도 통과하지 않음
+	assert.Equal(t, http.StatusUnauthorized, get(newRouter(""), "Bearer "))
+}
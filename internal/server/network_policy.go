@@ -0,0 +1,22 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// NewNetworkPolicyServiceFromConfig 설정으로 워크스페이스 네트워크 정책 서비스를 구성하고
+// allowlist 모드에서 사용할 이그레스 프록시 이미지를 컨테이너 매니저에 설정합니다.
+func NewNetworkPolicyServiceFromConfig(cfg config.NetworkPolicyConfig, dockerManager *docker.Manager) *services.NetworkPolicyService {
+	if dockerManager != nil && cfg.ProxyImage != "" {
+		dockerManager.Container().SetEgressProxyImage(cfg.ProxyImage)
+	}
+
+	defaultPolicy := models.NetworkPolicy{Mode: models.NetworkEgressMode(cfg.DefaultMode)}
+	if defaultPolicy.Mode == models.NetworkEgressAllowlist {
+		defaultPolicy.AllowedDomains = cfg.DefaultAllowedDomains
+	}
+	return services.NewNetworkPolicyService(cfg.Dir, defaultPolicy)
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/security"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewNotificationCenter 알림 센터를 구성하고 WebSocket 전달을 연결합니다
+// notifier가 nil이면 메일 없이 알림 센터와 WebSocket으로만 알립니다.
+func NewNotificationCenter(cfg *config.Config, store storage.Storage, hub *websocket.Hub, notifier services.NotificationService) *services.NotificationCenter {
+	center := services.NewNotificationCenter(store, notifier, cfg.Email.BaseURL)
+	if hub != nil {
+		center.AddPusher(&notificationBroadcaster{hub: hub})
+	}
+	return center
+}
+
+// notificationBroadcaster 새 알림과 안 읽은 알림 수를 받는 사용자의 채널로 전달합니다
+type notificationBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *notificationBroadcaster) PushNotification(notification *models.Notification, unread int) {
+	msg := websocket.NewStatusMessage("notification", notification.ID, string(notification.Type), map[string]interface{}{
+		"title":      notification.Title,
+		"message":    notification.Message,
+		"link":       notification.Link,
+		"created_at": notification.CreatedAt,
+		"unread":     unread,
+	})
+	msg.Channel = websocket.GetUserChannel(notification.UserID)
+	b.hub.BroadcastToUsers(msg, notification.UserID)
+}
+
+// newQuotaBudgetNotifier Claude 자격 증명 일별 예산 알림을 관리자에게 전달하는 함수
+func newQuotaBudgetNotifier(center *services.NotificationCenter) claude.QuotaBudgetAlertHandler {
+	return func(alert claude.QuotaBudgetAlert) {
+		var usage []string
+		if alert.DailyTokenBudget > 0 {
+			usage = append(usage, fmt.Sprintf("토큰 %d / %d", alert.TokensToday, alert.DailyTokenBudget))
+		}
+		if alert.DailyBudgetUSD > 0 {
+			usage = append(usage, fmt.Sprintf("비용 $%.2f / $%.2f", alert.CostTodayUSD, alert.DailyBudgetUSD))
+		}
+		message := fmt.Sprintf("Claude 자격 증명 %s의 %s 사용량: %s.", alert.Credential, alert.Day.Format("2006-01-02"), strings.Join(usage, ", "))
+		if alert.Percent >= 100 {
+			message += " 예산을 모두 사용해 UTC 자정까지 이 자격 증명을 사용하지 않습니다."
+		}
+
+		center.NotifyAdmins(&models.Notification{
+			Type:    models.NotificationTypeBudget,
+			Title:   fmt.Sprintf("Claude 자격 증명 %s 일별 예산 %d%% 도달", alert.Credential, alert.Percent),
+			Message: message,
+			Link:    "/admin/quota",
+			Data: map[string]string{
+				"credential": alert.Credential,
+				"percent":    fmt.Sprintf("%d", alert.Percent),
+				"day":        alert.Day.Format("2006-01-02"),
+			},
+		})
+	}
+}
+
+// newAttackAlertNotifier 공격 탐지 알림을 관리자에게 전달하는 함수
+func newAttackAlertNotifier(center *services.NotificationCenter) security.AttackAlertHandler {
+	return func(ctx context.Context, request *security.AttackDetectionRequest, result *security.AttackDetectionResult) {
+		message := fmt.Sprintf("%s에서 %s 공격이 탐지되었습니다 (신뢰도 %.0f%%).", request.IPAddress, result.AttackType, result.Confidence*100)
+		if len(result.Evidence) > 0 {
+			message += " " + strings.Join(result.Evidence, "; ")
+		}
+
+		center.NotifyAdmins(&models.Notification{
+			Type:    models.NotificationTypeSecurity,
+			Title:   fmt.Sprintf("보안 공격 탐지: %s", result.AttackType),
+			Message: message,
+			Link:    "/admin/security",
+			Data: map[string]string{
+				"attack_type": result.AttackType,
+				"ip_address":  request.IPAddress,
+				"path":        request.Path,
+			},
+		})
+	}
+}
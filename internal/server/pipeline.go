@@ -0,0 +1,39 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewPipelineService 파이프라인 서비스를 구성하고 태스크 종료 알림과 WebSocket 상태 전달을 연결합니다
+func NewPipelineService(store storage.Storage, prompts *services.PromptService, taskService *services.TaskService, hub *websocket.Hub) *services.PipelineService {
+	pipelineService := services.NewPipelineService(store, prompts, taskService)
+	taskService.AddFinishListener(pipelineService)
+	if hub != nil {
+		pipelineService.SetListener(&pipelineRunBroadcaster{hub: hub})
+	}
+	return pipelineService
+}
+
+// pipelineRunBroadcaster 파이프라인 실행 상태를 실행한 사용자에게 세션 채널로 전달합니다
+type pipelineRunBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *pipelineRunBroadcaster) OnPipelineRunUpdate(run *models.PipelineRun) {
+	data := map[string]interface{}{
+		"pipeline_id":  run.PipelineID,
+		"session_id":   run.SessionID,
+		"current_step": run.CurrentStep,
+		"step_runs":    run.StepRuns,
+	}
+	if run.Error != "" {
+		data["error"] = run.Error
+	}
+
+	msg := websocket.NewStatusMessage("pipeline_run", run.ID, string(run.Status), data)
+	msg.Channel = websocket.GetSessionChannel(run.SessionID)
+	b.hub.BroadcastToUsers(msg, run.OwnerID)
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewWorkerPoolConfigFromConfig 선점 설정을 반영한 워커 풀 설정을 구성합니다
+// 비어 있는 항목은 claude.DefaultPreemptionConfig 값을 그대로 사용합니다.
+func NewWorkerPoolConfigFromConfig(cfg config.PreemptionConfig) (claude.WorkerPoolConfig, error) {
+	poolConfig := claude.DefaultWorkerPoolConfig()
+	preemption := &poolConfig.Preemption
+
+	if cfg.Policy != "" {
+		preemption.Policy = claude.PreemptionPolicy(cfg.Policy)
+	}
+	if cfg.MinPreemptorPriority != "" {
+		priority, err := claude.ParseTaskPriority(cfg.MinPreemptorPriority)
+		if err != nil {
+			return poolConfig, fmt.Errorf("preemption.min_preemptor_priority: %w", err)
+		}
+		preemption.MinPreemptorPriority = priority
+	}
+	if cfg.MaxPreemptiblePriority != "" {
+		priority, err := claude.ParseTaskPriority(cfg.MaxPreemptiblePriority)
+		if err != nil {
+			return poolConfig, fmt.Errorf("preemption.max_preemptible_priority: %w", err)
+		}
+		preemption.MaxPreemptiblePriority = priority
+	}
+	preemption.MaxPreemptionsPerTask = cfg.MaxPreemptionsPerTask
+
+	return poolConfig, nil
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+func TestNewWorkerPoolConfigFromConfig(t *testing.T) {
+	poolConfig, err := NewWorkerPoolConfigFromConfig(config.PreemptionConfig{
+		Policy:                 "pause",
+		MinPreemptorPriority:   "high",
+		MaxPreemptiblePriority: "low",
+		MaxPreemptionsPerTask:  1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, claude.PreemptionConfig{
+		Policy:                 claude.PreemptionPolicyPause,
+		MinPreemptorPriority:   claude.TaskPriorityHigh,
+		MaxPreemptiblePriority: claude.TaskPriorityLow,
+		MaxPreemptionsPerTask:  1,
+	}, poolConfig.Preemption)
+
+	// 기본 설정은 claude 패키지의 기본 선점 정책과 같음
+	poolConfig, err = NewWorkerPoolConfigFromConfig(config.GetDefaultConfig().Preemption)
+	require.NoError(t, err)
+	assert.Equal(t, claude.DefaultPreemptionConfig(), poolConfig.Preemption)
+
+	_, err = NewWorkerPoolConfigFromConfig(config.PreemptionConfig{MinPreemptorPriority: "urgent"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/cached"
+)
+
+// NewQueryCacheFromConfig 설정에 따라 스토리지에 읽기 캐시를 씌웁니다
+// 캐시를 적용한 스토리지와 무효화에 쓸 캐시를 반환합니다 (비활성화면 원래 스토리지와 nil).
+func NewQueryCacheFromConfig(cfg config.StorageCacheConfig, inner storage.Storage) (storage.Storage, *cached.Storage) {
+	if !cfg.Enabled {
+		return inner, nil
+	}
+
+	cacheConfig := cached.Config{
+		AccountTTL:   cfg.AccountTTL,
+		WorkspaceTTL: cfg.WorkspaceTTL,
+		RBACTTL:      cfg.RBACTTL,
+		MaxEntries:   cfg.MaxEntries,
+		Registerer:   prometheus.DefaultRegisterer,
+	}
+	if tx, ok := inner.(storage.TransactionalStorage); ok {
+		wrapped := cached.NewTransactional(tx, cacheConfig)
+		return wrapped, wrapped.Storage
+	}
+	wrapped := cached.New(inner, cacheConfig)
+	return wrapped, wrapped
+}
+
+// queryCacheEvents 읽기 캐시 무효화가 필요한 RBAC 이벤트
+var queryCacheEvents = []auth.RBACEventType{
+	auth.EventRoleCreated,
+	auth.EventRoleUpdated,
+	auth.EventRoleDeleted,
+	auth.EventRoleAssigned,
+	auth.EventRoleRevoked,
+	auth.EventPermissionCreated,
+	auth.EventPermissionUpdated,
+	auth.EventPermissionDeleted,
+	auth.EventCacheInvalidated,
+}
+
+// NewRBACEventBus RBAC 이벤트 버스를 만들고 읽기 캐시 무효화 핸들러를 등록합니다
+func NewRBACEventBus(queryCache *cached.Storage) *auth.RBACEventBus {
+	bus := auth.NewRBACEventBus(nil)
+	if queryCache != nil {
+		handler := &queryCacheInvalidator{cache: queryCache}
+		for _, eventType := range queryCacheEvents {
+			bus.RegisterHandler(eventType, handler)
+		}
+	}
+	return bus
+}
+
+// queryCacheInvalidator RBAC 이벤트를 받아 읽기 캐시에서 바뀐 역할/권한을 지웁니다
+// 캐시를 거치지 않은 변경(다른 경로의 쓰기, 관리자의 캐시 무효화 요청)도 반영하기 위해 사용합니다.
+type queryCacheInvalidator struct {
+	cache *cached.Storage
+}
+
+// HandleEvent 이벤트 처리
+func (h *queryCacheInvalidator) HandleEvent(ctx context.Context, event *auth.RBACEvent) error {
+	switch event.Type {
+	case auth.EventRoleCreated, auth.EventRoleUpdated, auth.EventRoleDeleted:
+		h.cache.Invalidate(cached.CollectionRoles, event.TargetID)
+		h.cache.Invalidate(cached.CollectionRolePermissions, event.TargetID)
+		h.cache.Invalidate(cached.CollectionUserRoles)
+
+	case auth.EventRoleAssigned, auth.EventRoleRevoked:
+		if userID, ok := event.Metadata["user_id"].(string); ok && userID != "" {
+			h.cache.Invalidate(cached.CollectionUserRoles, userID)
+		} else {
+			h.cache.Invalidate(cached.CollectionUserRoles)
+		}
+
+	case auth.EventPermissionCreated, auth.EventPermissionUpdated, auth.EventPermissionDeleted:
+		h.cache.Invalidate(cached.CollectionRolePermissions)
+
+	case auth.EventCacheInvalidated:
+		switch event.TargetType {
+		case "user":
+			h.cache.Invalidate(cached.CollectionAccounts, event.TargetID)
+			h.cache.Invalidate(cached.CollectionUserRoles, event.TargetID)
+		case "role":
+			h.cache.Invalidate(cached.CollectionRoles, event.TargetID)
+			h.cache.Invalidate(cached.CollectionRolePermissions, event.TargetID)
+			h.cache.Invalidate(cached.CollectionUserRoles)
+		case "group":
+			h.cache.Invalidate(cached.CollectionUserRoles)
+		default:
+			h.cache.InvalidateAll()
+		}
+	}
+	return nil
+}
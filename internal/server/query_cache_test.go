@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/cached"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestNewQueryCacheFromConfig(t *testing.T) {
+	inner := memory.New()
+
+	store, cache := NewQueryCacheFromConfig(config.StorageCacheConfig{}, inner)
+	assert.Same(t, inner, store)
+	assert.Nil(t, cache)
+
+	store, cache = NewQueryCacheFromConfig(config.StorageCacheConfig{Enabled: true, RBACTTL: 0}, inner)
+	require.NotNil(t, cache)
+	assert.Same(t, cache, store)
+}
+
+func TestRBACEventBus_InvalidatesQueryCache(t *testing.T) {
+	ctx := context.Background()
+	inner := memory.New()
+	cache := cached.New(inner, cached.DefaultConfig())
+	events := auth.NewRBACEventManager(NewRBACEventBus(cache))
+
+	require.NoError(t, cache.RBAC().CreateRole(ctx, &models.Role{BaseModel: models.BaseModel{ID: "r1"}, Name: "editor"}))
+	_, err := cache.RBAC().GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+
+	// 캐시를 거치지 않은 변경은 이벤트로 반영
+	role, err := inner.RBAC().GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+	role.Description = "changed"
+	require.NoError(t, inner.RBAC().UpdateRole(ctx, role))
+
+	cachedRole, err := cache.RBAC().GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+	assert.Empty(t, cachedRole.Description)
+
+	require.NoError(t, events.EmitRoleEvent(auth.EventRoleUpdated, "r1", "admin", nil))
+	cachedRole, err = cache.RBAC().GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+	assert.Equal(t, "changed", cachedRole.Description)
+
+	// 관리자 전체 무효화
+	require.NoError(t, events.EmitCacheEvent("all", "", "admin"))
+	for _, stats := range cache.Stats() {
+		assert.Zero(t, stats.Entries, stats.Collection)
+	}
+}
@@ -0,0 +1,38 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewQuotaGovernorFromConfig 설정으로 Claude 자격 증명 쿼터 거버너를 구성합니다 (비활성이면 nil)
+func NewQuotaGovernorFromConfig(cfg config.QuotaConfig, reg prometheus.Registerer, logger *logrus.Logger) (*claude.QuotaGovernor, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	credentials := make([]claude.QuotaCredential, 0, len(cfg.Credentials))
+	for _, credential := range cfg.Credentials {
+		credentials = append(credentials, claude.QuotaCredential{
+			Name:              credential.Name,
+			OAuthToken:        credential.OAuthToken,
+			APIKey:            credential.APIKey,
+			RequestsPerMinute: credential.RequestsPerMinute,
+			MaxConcurrent:     credential.MaxConcurrent,
+			DailyTokenBudget:  credential.DailyTokenBudget,
+			DailyBudgetUSD:    credential.DailyBudgetUSD,
+		})
+	}
+
+	return claude.NewQuotaGovernor(claude.QuotaGovernorConfig{
+		Credentials:    credentials,
+		NearRatio:      cfg.NearRatio,
+		Cooldown:       cfg.Cooldown,
+		AuthQuarantine: cfg.AuthQuarantine,
+		MaxDelay:       cfg.MaxDelay,
+		Registerer:     reg,
+	}, logger)
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// NewProcessReaperFromConfig 설정으로 고아 프로세스 정리기를 구성합니다 (비활성이면 nil)
+// 프로세스 기록에는 서버 인스턴스 식별자(instanceID)를 사용합니다.
+func NewProcessReaperFromConfig(cfg config.ReaperConfig, instanceID string, store storage.Storage, logger *logrus.Logger) (*claude.ProcessReaper, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	return claude.NewProcessReaper(store.Process(), claude.ProcessReaperConfig{
+		InstanceID:  instanceID,
+		Action:      claude.OrphanAction(cfg.Action),
+		KillTimeout: cfg.KillTimeout,
+	}, logger)
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// NewRecoveryManagerFromConfig 설정으로 에러 자동 복구 관리자를 구성합니다 (비활성이면 nil)
+// Docker 워크스페이스 서비스가 없으면 컨테이너 재생성을, 토큰 출처가 없으면 재인증을 등록하지 않습니다.
+func NewRecoveryManagerFromConfig(cfg config.RecoveryConfig, sessions claude.SessionManager, processes claude.ProcessManager, workspaces *services.DockerWorkspaceService, stats *claude.ErrorStatisticsCollector, logger *logrus.Logger) *claude.RecoveryManager {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	manager := claude.NewRecoveryManager(claude.RecoveryManagerConfig{
+		Sessions:  sessions,
+		Processes: processes,
+		Stats:     stats,
+		Timeout:   cfg.Timeout,
+	}, logger)
+
+	var recreator claude.WorkspaceRecreator
+	if workspaces != nil {
+		recreator = workspaces
+	}
+
+	var refresh claude.TokenRefreshFunc
+	switch {
+	case cfg.TokenFile != "":
+		refresh = claude.NewFileTokenRefresher(cfg.TokenFile, cfg.TokenTTL)
+	case cfg.TokenEnv != "":
+		refresh = claude.NewEnvTokenRefresher(cfg.TokenEnv, cfg.TokenTTL)
+	}
+
+	manager.RegisterDefaults(recreator, refresh)
+	return manager
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewOutputRedactorFromConfig 설정으로 Claude 출력 마스킹 필터를 구성합니다 (비활성이면 nil)
+func NewOutputRedactorFromConfig(cfg config.RedactionConfig, reg prometheus.Registerer) (*claude.OutputRedactor, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	workspaces := make(map[string]claude.RedactionPolicy, len(cfg.Workspaces))
+	for workspaceID, policy := range cfg.Workspaces {
+		workspaces[workspaceID] = redactionPolicy(policy)
+	}
+	return claude.NewOutputRedactor(claude.OutputRedactorConfig{
+		Default:    redactionPolicy(cfg.Default),
+		Workspaces: workspaces,
+		Registerer: reg,
+	})
+}
+
+// redactionPolicy 정책 설정 변환 (엔트로피 기준이 비어 있으면 기본값)
+func redactionPolicy(cfg config.RedactionPolicyConfig) claude.RedactionPolicy {
+	policy := claude.RedactionPolicy{
+		Secrets:          cfg.Secrets,
+		PII:              cfg.PII,
+		Entropy:          cfg.Entropy,
+		EntropyThreshold: cfg.EntropyThreshold,
+		EntropyMinLength: cfg.EntropyMinLength,
+		DisabledRules:    cfg.DisabledRules,
+		Allowlist:        cfg.Allowlist,
+	}
+	if policy.EntropyThreshold == 0 {
+		policy.EntropyThreshold = claude.DefaultRedactionEntropyThreshold
+	}
+	if policy.EntropyMinLength == 0 {
+		policy.EntropyMinLength = claude.DefaultRedactionEntropyMinLength
+	}
+	return policy
+}
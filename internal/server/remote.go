@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/remote"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewRemoteRegistryFromConfig 설정으로 원격 에이전트 레지스트리를 구성합니다
+// 비활성화되어 있거나 접속 토큰이 없으면 nil을 반환합니다.
+func NewRemoteRegistryFromConfig(cfg config.RemoteConfig, logger *logrus.Logger) *remote.Registry {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.Token == "" {
+		logger.Warn("remote.token이 설정되지 않아 원격 에이전트 실행을 사용하지 않습니다")
+		return nil
+	}
+
+	rules := make([]remote.AffinityRule, 0, len(cfg.Affinity))
+	for _, rule := range cfg.Affinity {
+		rules = append(rules, remote.AffinityRule{
+			Workspace: rule.Workspace,
+			Affinity:  remote.Affinity{Required: rule.Required, Preferred: rule.Preferred},
+		})
+	}
+
+	return remote.NewRegistry(remote.RegistryConfig{
+		Token:             cfg.Token,
+		HeartbeatInterval: cfg.HeartbeatInterval,
+		HeartbeatTimeout:  cfg.HeartbeatTimeout,
+		AssignTimeout:     cfg.AssignTimeout,
+		Rules:             rules,
+	}, logger)
+}
+
+// remoteTaskRunner 태스크를 원격 에이전트에 배정하고, 에이전트가 보내는 출력을
+// 태스크 채널에 WebSocket 로그 메시지로 전달합니다.
+type remoteTaskRunner struct {
+	registry *remote.Registry
+	hub      *websocket.Hub
+	redactor *claude.OutputRedactor
+	logger   *logrus.Logger
+}
+
+// NewRemoteTaskRunner 원격 에이전트 레지스트리를 태스크 실행기로 사용합니다
+// redactor가 있으면 WebSocket으로 보내는 출력을 줄 단위로 마스킹합니다.
+func NewRemoteTaskRunner(registry *remote.Registry, hub *websocket.Hub, redactor *claude.OutputRedactor, logger *logrus.Logger) services.TaskRunner {
+	return &remoteTaskRunner{registry: registry, hub: hub, redactor: redactor, logger: logger}
+}
+
+func (r *remoteTaskRunner) RunTask(ctx context.Context, run *services.TaskRun) (string, error) {
+	assignment := remote.Assignment{
+		TaskID:      run.TaskID,
+		SessionID:   run.SessionID,
+		WorkspaceID: run.WorkspaceID,
+		Command:     run.Process.Command,
+		Args:        run.Process.Args,
+		Env:         run.Process.Environment,
+	}
+	if run.Process.Timeout > 0 {
+		assignment.TimeoutSeconds = int((run.Process.Timeout + time.Second - 1) / time.Second)
+	}
+
+	var mu sync.Mutex
+	var output strings.Builder
+	var logs io.WriteCloser
+	if r.hub != nil {
+		// 청크 경계에 걸친 값도 가리도록 줄 단위로 모아 마스킹한 뒤 전송
+		logs = claude.NewRedactingWriter(&taskLogWriter{hub: r.hub, source: "remote", sessionID: run.SessionID, taskID: run.TaskID}, r.redactor, run.WorkspaceID)
+	}
+	result, agentID, err := r.registry.Dispatch(ctx, assignment, func(data string) {
+		mu.Lock()
+		output.WriteString(data)
+		mu.Unlock()
+		if logs != nil {
+			_, _ = io.WriteString(logs, data)
+		}
+	})
+	if logs != nil {
+		_ = logs.Close()
+	}
+
+	mu.Lock()
+	out := output.String()
+	mu.Unlock()
+
+	r.logger.WithFields(logrus.Fields{
+		"task_id":  run.TaskID,
+		"agent_id": agentID,
+	}).Info("원격 태스크 종료")
+
+	if err != nil {
+		return out, err
+	}
+	if result.Error != "" || result.ExitCode != 0 {
+		return out, fmt.Errorf("원격 에이전트 %s: %s (종료 코드 %d)", agentID, result.Error, result.ExitCode)
+	}
+	return out, nil
+}
+
+// taskLogWriter 쓰기마다 태스크 채널에 WebSocket 로그 메시지를 보내는 io.Writer
+type taskLogWriter struct {
+	hub       *websocket.Hub
+	source    string
+	sessionID string
+	taskID    string
+}
+
+func (w *taskLogWriter) Write(p []byte) (int, error) {
+	msg := websocket.NewLogMessage("info", string(p), w.source, w.sessionID, w.taskID)
+	w.hub.Broadcast(msg, websocket.GetTaskChannel(w.taskID))
+	return len(p), nil
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// NewRestartPolicyRegistryFromConfig 설정의 기본 정책과 워크스페이스별 정책으로 재시작 정책 레지스트리를 구성합니다
+// 워크스페이스 정책에서 지정하지 않은 값(0 또는 생략)은 기본 정책 값을 사용합니다.
+func NewRestartPolicyRegistryFromConfig(cfg config.RestartConfig) (*claude.RestartPolicyRegistry, error) {
+	defaultPolicy := restartPolicyFromConfig(cfg.RestartPolicyConfig, nil)
+	if err := defaultPolicy.Validate(); err != nil {
+		return nil, err
+	}
+
+	registry := claude.NewRestartPolicyRegistry(defaultPolicy)
+	for workspaceID, pc := range cfg.Workspaces {
+		if err := registry.SetWorkspacePolicy(workspaceID, restartPolicyFromConfig(pc, defaultPolicy)); err != nil {
+			return nil, fmt.Errorf("workspace %s: %w", workspaceID, err)
+		}
+	}
+
+	return registry, nil
+}
+
+// restartPolicyFromConfig 설정 값을 재시작 정책으로 변환 (base가 있으면 빈 값을 base로 채움)
+func restartPolicyFromConfig(pc config.RestartPolicyConfig, base *claude.RestartPolicy) *claude.RestartPolicy {
+	policy := &claude.RestartPolicy{}
+	if base != nil {
+		*policy = *base
+	}
+
+	if pc.Enabled != nil {
+		policy.Enabled = *pc.Enabled
+	}
+	if pc.MaxRestarts != 0 {
+		policy.MaxRestarts = pc.MaxRestarts
+	}
+	if pc.InitialBackoff != 0 {
+		policy.InitialBackoff = pc.InitialBackoff
+	}
+	if pc.MaxBackoff != 0 {
+		policy.MaxBackoff = pc.MaxBackoff
+	}
+	if pc.BackoffMultiplier != 0 {
+		policy.BackoffMultiplier = pc.BackoffMultiplier
+	}
+	if pc.CircuitBreakerThreshold != 0 {
+		policy.CircuitBreakerThreshold = pc.CircuitBreakerThreshold
+	}
+	if pc.CircuitBreakerCooldown != 0 {
+		policy.CircuitBreakerCooldown = pc.CircuitBreakerCooldown
+	}
+	if pc.StableAfter != 0 {
+		policy.StableAfter = pc.StableAfter
+	}
+	return policy
+}
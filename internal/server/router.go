@@ -2,13 +2,19 @@ package server
 
 import (
 	"net/http"
+	"reflect"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/aicli/aicli-web/internal/server/handlers"
 	apiHandlers "github.com/aicli/aicli-web/internal/api/handlers"
 	"github.com/aicli/aicli-web/internal/api/controllers"
+	"github.com/aicli/aicli-web/internal/logging"
 	"github.com/aicli/aicli-web/internal/middleware"
 	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/webui"
+	"github.com/aicli/aicli-web/internal/websocket"
 	"github.com/aicli/aicli-web/pkg/version"
 	"github.com/aicli/aicli-web/internal/docs"
 )
@@ -17,15 +23,38 @@ import (
 func (s *Server) setupRoutes() {
 	// Swagger UI 설정
 	docs.SetupSwagger(s.router)
+
+	// OpenAPI 3.1 스펙 생성기 (검증 태그 기반 제약 조건 포함)
+	openAPI := docs.NewOpenAPIGenerator(docs.GetSwaggerInfo())
+	openAPI.RegisterModel("models.Workspace", models.Workspace{})
+	openAPI.RegisterModel("models.CreateWorkspaceRequest", models.CreateWorkspaceRequest{})
+	openAPI.RegisterModel("models.UpdateWorkspaceRequest", models.UpdateWorkspaceRequest{})
+	openAPI.RegisterModel("models.Project", models.Project{})
+	openAPI.RegisterModel("models.Session", models.Session{})
+	openAPI.RegisterModel("models.SessionCreateRequest", models.SessionCreateRequest{})
+	openAPI.RegisterModel("models.Task", models.Task{})
+	openAPI.RegisterModel("models.TaskCreateRequest", models.TaskCreateRequest{})
+	openAPI.RegisterModel("models.PaginationRequest", models.PaginationRequest{})
+
+	// WebSocket 메시지 (TypeScript 클라이언트의 메시지 타입 정의에 사용)
+	openAPI.RegisterModel("websocket.Message", websocket.Message{})
+	openAPI.RegisterModel("websocket.Envelope", websocket.Envelope{})
+	for msgType, data := range websocket.MessageDataTypes() {
+		openAPI.RegisterWebSocketMessage(string(msgType), "websocket."+reflect.TypeOf(data).Name(), data)
+	}
 	
-	// 루트 경로 - 기본 정보
-	s.router.GET("/", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{
-			"service": "AICode Manager API",
-			"version": version.Version,
-			"status":  "running",
+	// 루트 경로 - 웹 UI를 제공하면 index.html, 아니면 기본 정보
+	if s.webUI != nil {
+		s.router.GET("/", s.webUI.Serve)
+	} else {
+		s.router.GET("/", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{
+				"service": "AICode Manager API",
+				"version": version.Version,
+				"status":  "running",
+			})
 		})
-	})
+	}
 
 	// 헬스체크 엔드포인트
 	s.router.GET("/health", handlers.HealthCheck)
@@ -35,11 +64,37 @@ func (s *Server) setupRoutes() {
 		c.JSON(http.StatusOK, version.Get())
 	})
 
+	// Prometheus 메트릭 엔드포인트 (모든 메트릭에 instance_id 레이블 추가)
+	// 수집 토큰(api.metrics_token)이나 관리자 JWT가 있어야 조회할 수 있습니다.
+	gatherer := s.metricsGatherer
+	if gatherer == nil {
+		gatherer = prometheus.DefaultGatherer
+	}
+	metricsHandler := gin.WrapH(promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}),
+	))
+	s.router.GET("/metrics",
+		metricsTokenAuth(s.metricsToken, metricsHandler),
+		middleware.RequireAuth(s.jwtManager, s.blacklist),
+		middleware.RequireRole("admin"),
+		metricsHandler,
+	)
+
 	// API v1 그룹
 	v1 := s.router.Group("/api/v1")
+	v1.Use(middleware.Idempotency(middleware.DefaultIdempotencyConfig())) // 재시도 요청 중복 방지
+	if s.requestTimeout != nil {
+		v1.Use(s.requestTimeout.Handler()) // 요청 처리 시간 예산 (기한 초과 시 504)
+	}
 	{
 		// 인증 핸들러 생성
 		authHandler := apiHandlers.NewAuthHandler(s.jwtManager, s.blacklist)
+		if s.accounts != nil {
+			authHandler.SetAccountService(s.accounts)
+		}
+		if s.deviceAuth != nil {
+			authHandler.SetDeviceAuthorizer(s.deviceAuth)
+		}
 		
 		// 인증 엔드포인트 (인증 불필요)
 		auth := v1.Group("/auth")
@@ -48,6 +103,22 @@ func (s *Server) setupRoutes() {
 			auth.POST("/refresh", authHandler.Refresh)
 			auth.POST("/logout", authHandler.Logout)
 			
+			// 로컬 계정 비밀번호 재설정
+			if s.accounts != nil {
+				accountController := controllers.NewAccountController(s.accounts)
+				auth.POST("/password/forgot", accountController.RequestPasswordReset)
+				auth.POST("/password/reset", accountController.ResetPassword)
+			}
+			
+			// CLI 디바이스 코드 로그인 (발급과 토큰 요청은 인증 불필요, 승인은 로그인한 사용자만)
+			if s.deviceAuth != nil {
+				device := auth.Group("/device")
+				device.POST("/code", authHandler.DeviceCode)
+				device.POST("/token", authHandler.DeviceToken)
+				device.GET("", middleware.RequireAuth(s.jwtManager, s.blacklist), authHandler.GetDeviceAuthorization)
+				device.POST("/approve", middleware.RequireAuth(s.jwtManager, s.blacklist), authHandler.ApproveDevice)
+			}
+			
 			// OAuth 엔드포인트
 			oauth := auth.Group("/oauth")
 			{
@@ -60,11 +131,25 @@ func (s *Server) setupRoutes() {
 			}
 		}
 		
+		// OpenAPI 스펙 엔드포인트
+		v1.GET("/openapi.json", docs.OpenAPIHandler(openAPI))
+
+		// CSP 위반 보고 수집 (브라우저가 보내므로 인증 불필요)
+		var cspReportController *controllers.CSPReportController
+		if s.cspReports != nil {
+			cspReportController = controllers.NewCSPReportController(s.cspReports)
+			v1.POST("/csp-report", cspReportController.CollectReport)
+		}
+
 		// 시스템 정보 엔드포인트
 		system := v1.Group("/system")
 		{
 			system.GET("/info", apiHandlers.GetSystemInfo)
-			system.GET("/status", apiHandlers.GetSystemStatus)
+			if s.maintenance != nil {
+				system.GET("/status", apiHandlers.SystemStatusWithMaintenance(s.maintenance.Status))
+			} else {
+				system.GET("/status", apiHandlers.GetSystemStatus)
+			}
 		}
 
 		// 워크스페이스 컨트롤러 인스턴스 생성
@@ -75,16 +160,99 @@ func (s *Server) setupRoutes() {
 		
 		// 세션 컨트롤러 인스턴스 생성
 		sessionController := controllers.NewSessionController(s.sessionService)
+		if s.logs != nil {
+			sessionController.SetLogger(s.logs.For(logging.ModuleAPI))
+		}
+		
+		// 대화 기록 컨트롤러 인스턴스 생성
+		messageController := controllers.NewMessageController(s.messageService)
+		
+		// 오프라인 대화 기록 동기화 컨트롤러 인스턴스 생성
+		transcriptController := controllers.NewTranscriptController(s.transcriptSync)
+		
+		// 셸 명령 실행 기록 컨트롤러 인스턴스 생성
+		sessionCommandController := controllers.NewSessionCommandController(s.sessionCommands)
+		
+		// 세션 분기/재생 컨트롤러 인스턴스 생성
+		branchController := controllers.NewSessionBranchController(s.branchService)
+		
+		// 세션 공유 링크 컨트롤러 인스턴스 생성
+		shareController := controllers.NewSessionShareController(s.shareService, s.sharedSessionHandler)
 		
 		// 태스크 컨트롤러 인스턴스 생성
 		taskController := controllers.NewTaskController(s.taskService)
+		taskReviewController := controllers.NewTaskReviewController(s.taskReviews)
+		pullRequestController := controllers.NewPullRequestController(s.pullRequests)
+		issueTrackerController := controllers.NewIssueTrackerController(s.issueTracker)
+		terminalController := controllers.NewTerminalController(s.terminals)
+		taskArtifactController := controllers.NewTaskArtifactController(s.taskArtifacts)
+		taskEventController := controllers.NewTaskEventController(s.taskEvents)
+		
+		// 일괄 처리 컨트롤러 인스턴스 생성
+		batchController := controllers.NewBatchController(s.batchService, s.workspaceAccess)
+		
+		// 워크스페이스 공유 ACL 컨트롤러 인스턴스 생성
+		aclController := controllers.NewWorkspaceACLController(s.workspaceAccess)
+		
+		// 활동 피드 컨트롤러 인스턴스 생성
+		activityController := controllers.NewActivityController(s.activity)
+		
+		// 알림 센터 컨트롤러 인스턴스 생성
+		notificationController := controllers.NewNotificationController(s.notifications)
+		
+		// 소유권 이전 컨트롤러 인스턴스 생성
+		ownershipController := controllers.NewOwnershipController(s.ownership)
+		
+		// 워크스페이스 ACL 권한 확인 미들웨어 (경로의 :id가 가리키는 리소스가 속한 워크스페이스 기준)
+		wsRead := middleware.RequireWorkspacePermission(s.workspaceAccess, models.WorkspacePermissionRead)
+		wsExecute := middleware.RequireWorkspacePermission(s.workspaceAccess, models.WorkspacePermissionExecute)
+		wsAdmin := middleware.RequireWorkspacePermission(s.workspaceAccess, models.WorkspacePermissionAdmin)
+		wsOwner := middleware.RequireWorkspacePermission(s.workspaceAccess, models.WorkspacePermissionOwner)
+		projectRead := middleware.RequireProjectPermission(s.workspaceAccess, models.WorkspacePermissionRead)
+		projectExecute := middleware.RequireProjectPermission(s.workspaceAccess, models.WorkspacePermissionExecute)
+		projectAdmin := middleware.RequireProjectPermission(s.workspaceAccess, models.WorkspacePermissionAdmin)
+		sessionRead := middleware.RequireSessionPermission(s.workspaceAccess, models.WorkspacePermissionRead)
+		sessionExecute := middleware.RequireSessionPermission(s.workspaceAccess, models.WorkspacePermissionExecute)
+		sessionAdmin := middleware.RequireSessionPermission(s.workspaceAccess, models.WorkspacePermissionAdmin)
+		taskRead := middleware.RequireTaskPermission(s.workspaceAccess, models.WorkspacePermissionRead)
+		taskExecute := middleware.RequireTaskPermission(s.workspaceAccess, models.WorkspacePermissionExecute)
+		
+		// 워크스페이스 아카이브 컨트롤러 인스턴스 생성
+		archiveController := controllers.NewWorkspaceArchiveController(s.archiveService, s.artifactService)
 		
 		// RBAC 컨트롤러 인스턴스 생성
 		rbacController := controllers.NewRBACController(s.rbacManager, s.storage)
+		rbacController.SetEventManager(s.rbacEvents)
 
 		// Claude 핸들러 인스턴스 생성 (Claude wrapper가 있다고 가정)
 		// TODO: s.claudeWrapper가 Server 구조체에 추가되어야 함
 		claudeHandler := handlers.NewClaudeHandler(s.claudeWrapper, s.storage.Session(), s.wsHub)
+		if s.mcpService != nil {
+			claudeHandler.SetMCPServerProvider(s.mcpService)
+		}
+		if s.workspaceAccess != nil {
+			claudeHandler.SetWorkspaceAuthorizer(s.workspaceAccess)
+		}
+		if s.workspaceEnv != nil {
+			claudeHandler.SetEnvironmentProvider(s.workspaceEnv)
+			claudeHandler.SetSecretMasker(s.workspaceEnv)
+		}
+		if s.agentRegistry != nil {
+			claudeHandler.SetAgentRegistry(s.agentRegistry)
+		}
+		if s.restartPolicies != nil {
+			claudeHandler.SetRestartPolicyProvider(s.restartPolicies)
+		}
+		if s.hangPolicy != nil {
+			claudeHandler.SetHangPolicy(s.hangPolicy)
+		}
+		if s.outputRedactor != nil {
+			claudeHandler.SetOutputRedactor(s.outputRedactor)
+		}
+		if s.preferences != nil {
+			claudeHandler.SetResponseLanguageProvider(s.preferences)
+		}
+		claudeHandler.SetMessageRecorder(s.messageService)
 
 		// Claude 관련 엔드포인트 (인증 필요)
 		claude := v1.Group("/claude")
@@ -105,61 +273,407 @@ func (s *Server) setupRoutes() {
 		{
 			workspaces.GET("", workspaceController.ListWorkspaces)
 			workspaces.POST("", workspaceController.CreateWorkspace)
-			workspaces.GET("/:id", workspaceController.GetWorkspace)
-			workspaces.PUT("/:id", workspaceController.UpdateWorkspace)
-			workspaces.DELETE("/:id", workspaceController.DeleteWorkspace)
+			workspaces.GET("/shared", aclController.ListShared)
+			workspaces.GET("/:id", wsRead, workspaceController.GetWorkspace)
+			workspaces.PUT("/:id", wsAdmin, workspaceController.UpdateWorkspace)
+			workspaces.DELETE("/:id", wsOwner, workspaceController.DeleteWorkspace)
+			
+			// 공유 ACL과 접근 권한 보유자
+			workspaces.GET("/:id/acl", wsAdmin, aclController.ListACL)
+			workspaces.PUT("/:id/acl", wsAdmin, aclController.PutACL)
+			workspaces.DELETE("/:id/acl/:type/:principal", wsAdmin, aclController.DeleteACL)
+			workspaces.GET("/:id/access", wsRead, aclController.WhoHasAccess)
+			
+			// 환경 변수와 변경 기록 (비밀 변수 값은 응답에서 가림)
+			if s.workspaceEnv != nil {
+				envController := controllers.NewWorkspaceEnvController(s.workspaceEnv)
+				workspaces.GET("/:id/env", wsRead, envController.ListEnv)
+				workspaces.POST("/:id/env", wsAdmin, envController.CreateEnv)
+				workspaces.GET("/:id/env/history", wsAdmin, envController.ListEnvHistory)
+				workspaces.PUT("/:id/env/:key", wsAdmin, envController.UpdateEnv)
+				workspaces.DELETE("/:id/env/:key", wsAdmin, envController.DeleteEnv)
+			}
+			
+			// 사전 점검 (경로, git, Claude CLI, 이미지, 디스크 한도, MCP 서버)
+			if s.workspaceDoctor != nil {
+				doctorController := controllers.NewWorkspaceDoctorController(s.workspaceDoctor)
+				workspaces.POST("/:id/doctor", wsExecute, doctorController.RunDoctor)
+			}
+			
+			// 활동 피드와 읽음 표시
+			workspaces.GET("/:id/activity", wsRead, activityController.ListWorkspaceActivity)
+			workspaces.POST("/:id/activity/read", wsRead, activityController.MarkWorkspaceActivityRead)
+			
+			// 소유권 이전
+			workspaces.POST("/:id/transfer", wsOwner, ownershipController.TransferWorkspace)
+			
+			// 일괄 처리 (POST /workspaces:batchDelete)
+			workspaces.POST("/batchDelete", batchController.BatchDeleteWorkspaces)
+			
+			// 내보내기/가져오기
+			workspaces.GET("/:id/export", wsAdmin, archiveController.ExportWorkspace)
+			workspaces.POST("/import", archiveController.ImportWorkspace)
 			
 			// 워크스페이스 내 프로젝트 엔드포인트
-			workspaces.POST("/:id/projects", projectController.CreateProject)
-			workspaces.GET("/:id/projects", projectController.ListProjects)
+			workspaces.POST("/:id/projects", wsAdmin, projectController.CreateProject)
+			workspaces.GET("/:id/projects", wsRead, projectController.ListProjects)
+			
+			// 태스크 결과 PR/MR 설정과 목록
+			workspaces.GET("/:id/pull-request-config", wsRead, pullRequestController.GetConfig)
+			workspaces.PUT("/:id/pull-request-config", wsAdmin, pullRequestController.PutConfig)
+			workspaces.DELETE("/:id/pull-request-config", wsAdmin, pullRequestController.DeleteConfig)
+			workspaces.GET("/:id/pull-requests", wsRead, pullRequestController.ListPullRequests)
+			
+			// 이슈 트래커 연동 설정
+			workspaces.GET("/:id/issue-tracker", wsRead, issueTrackerController.GetConfig)
+			workspaces.PUT("/:id/issue-tracker", wsAdmin, issueTrackerController.PutConfig)
+			workspaces.DELETE("/:id/issue-tracker", wsAdmin, issueTrackerController.DeleteConfig)
+			
+			// 터미널 접속 감사 기록과 녹화 재생 (접속은 /ws/workspaces/:id/terminal)
+			workspaces.GET("/:id/terminal-sessions", wsAdmin, terminalController.ListSessions)
+			workspaces.GET("/:id/terminal-sessions/:terminalId", wsAdmin, terminalController.GetSession)
+			workspaces.GET("/:id/terminal-sessions/:terminalId/recording", wsAdmin, terminalController.GetRecording)
+			
+			// 워크스페이스별 MCP 서버
+			if s.mcpService != nil {
+				mcpController := controllers.NewMCPController(s.mcpService)
+				workspaces.GET("/:id/mcp/servers", wsRead, mcpController.ListServers)
+				workspaces.PUT("/:id/mcp/servers/:name", wsAdmin, mcpController.PutServer)
+				workspaces.DELETE("/:id/mcp/servers/:name", wsAdmin, mcpController.DeleteServer)
+				workspaces.GET("/:id/mcp/status", wsRead, mcpController.GetStatus)
+			}
+			
+			// 워크스페이스별 의존성 캐시
+			if s.cacheManager != nil {
+				cacheController := controllers.NewCacheController(s.cacheManager)
+				workspaces.GET("/:id/caches", wsRead, cacheController.ListWorkspaceCaches)
+				workspaces.DELETE("/:id/caches", wsExecute, cacheController.PurgeWorkspaceCaches)
+				workspaces.DELETE("/:id/caches/:name", wsExecute, cacheController.PurgeWorkspaceCache)
+			}
+			
+			// 워크스페이스별 컨테이너 이미지
+			if s.imageService != nil {
+				imageController := controllers.NewWorkspaceImageController(s.imageService, s.storage)
+				workspaces.GET("/:id/image", wsRead, imageController.GetImage)
+				workspaces.PUT("/:id/image", wsAdmin, imageController.PutImage)
+				workspaces.GET("/:id/image/build", wsRead, imageController.GetBuild)
+				workspaces.POST("/:id/image/build", wsExecute, imageController.RebuildImage)
+			}
+			
+			// 워크스페이스별 네트워크 이그레스 정책
+			if s.networkPolicy != nil {
+				networkPolicyController := controllers.NewNetworkPolicyController(s.networkPolicy)
+				workspaces.GET("/:id/network-policy", wsRead, networkPolicyController.GetPolicy)
+				workspaces.PUT("/:id/network-policy", wsAdmin, networkPolicyController.PutPolicy)
+				workspaces.DELETE("/:id/network-policy", wsAdmin, networkPolicyController.DeletePolicy)
+			}
+			
+			// 워크스페이스 디스크 사용량
+			if s.diskQuota != nil {
+				diskQuotaController := controllers.NewWorkspaceDiskQuotaController(s.diskQuota)
+				workspaces.GET("/:id/disk-usage", wsRead, diskQuotaController.GetDiskUsage)
+			}
+			
+			// 워크스페이스 파일 변경 시 자동 태스크 실행
+			if s.workspaceWatch != nil {
+				watchController := controllers.NewWorkspaceWatchController(s.workspaceWatch)
+				workspaces.GET("/:id/watch", wsRead, watchController.GetWatch)
+				workspaces.PUT("/:id/watch", wsAdmin, watchController.PutWatch)
+				workspaces.DELETE("/:id/watch", wsAdmin, watchController.DeleteWatch)
+			}
+			
+			// 워크스페이스 파일 시스템 스냅샷
+			if s.snapshots != nil {
+				snapshotController := controllers.NewWorkspaceSnapshotController(s.snapshots)
+				workspaces.GET("/:id/snapshots", wsRead, snapshotController.ListSnapshots)
+				workspaces.POST("/:id/snapshots", wsExecute, snapshotController.CreateSnapshot)
+				workspaces.POST("/:id/snapshots/:snapshotId/restore", wsExecute, snapshotController.RestoreSnapshot)
+				workspaces.DELETE("/:id/snapshots/:snapshotId", wsAdmin, snapshotController.DeleteSnapshot)
+			}
+			
+			// 워크스페이스별 태스크 훅 플러그인
+			if s.pluginManager != nil {
+				pluginController := controllers.NewPluginController(s.pluginManager)
+				workspaces.GET("/:id/plugins", wsRead, pluginController.ListWorkspacePlugins)
+				workspaces.PUT("/:id/plugins/:name", wsAdmin, pluginController.UpdateWorkspacePlugin)
+			}
 		}
 		
 		// 프로젝트 관련 엔드포인트 (인증 필요)
 		projects := v1.Group("/projects")
 		projects.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
 		{
-			projects.GET("/:id", projectController.GetProject)
-			projects.PUT("/:id", projectController.UpdateProject)
-			projects.DELETE("/:id", projectController.DeleteProject)
+			projects.GET("/:id", projectRead, projectController.GetProject)
+			projects.PUT("/:id", projectAdmin, projectController.UpdateProject)
+			projects.DELETE("/:id", projectAdmin, projectController.DeleteProject)
+			
+			// 다른 워크스페이스로 이전 (대상 워크스페이스 권한은 서비스에서 확인)
+			projects.POST("/:id/transfer", projectAdmin, ownershipController.TransferProject)
 			
 			// 프로젝트별 세션 생성
-			projects.POST("/:id/sessions", sessionController.Create)
+			projects.POST("/:id/sessions", projectExecute, sessionController.Create)
+			
+			// 오프라인 모드 대화 기록과 사용량 동기화 (나눠 보내기, 재전송 가능)
+			projects.POST("/:id/transcripts", projectExecute, transcriptController.Import)
 		}
 		
 		// 세션 관련 엔드포인트 (인증 필요)
 		sessions := v1.Group("/sessions")
 		sessions.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
 		{
-			sessions.GET("", sessionController.List)
-			sessions.GET("/active", sessionController.GetActiveSessions)
-			sessions.GET("/:id", sessionController.GetByID)
-			sessions.DELETE("/:id", sessionController.Terminate)
-			sessions.PUT("/:id/activity", sessionController.UpdateActivity)
+			// 여러 워크스페이스에 걸친 목록은 admin만 조회 (그 외 사용자는 project_id 지정)
+			sessions.GET("", middleware.RequireProjectQueryPermission(s.workspaceAccess, models.WorkspacePermissionRead), sessionController.List)
+			sessions.GET("/active", middleware.RequireRole("admin"), sessionController.GetActiveSessions)
+			sessions.GET("/:id", sessionRead, sessionController.GetByID)
+			sessions.DELETE("/:id", sessionExecute, sessionController.Terminate)
+			sessions.PUT("/:id/activity", sessionExecute, sessionController.UpdateActivity)
+			sessions.GET("/:id/messages", sessionRead, messageController.ListSessionMessages)
+			sessions.GET("/:id/commands", sessionRead, sessionCommandController.ListSessionCommands)
+			sessions.GET("/:id/commands/export", sessionRead, sessionCommandController.ExportSessionCommands)
+			sessions.POST("/:id/fork", sessionExecute, branchController.Fork)
+			sessions.GET("/:id/replay", sessionRead, branchController.Replay)
+			sessions.POST("/:id/share-links", sessionAdmin, shareController.Create)
+			sessions.GET("/:id/share-links", sessionAdmin, shareController.List)
+			sessions.DELETE("/:id/share-links/:linkId", sessionAdmin, shareController.Revoke)
+			sessions.GET("/:id/share-joins", sessionAdmin, shareController.ListJoins)
 			
 			// 세션별 태스크 생성
-			sessions.POST("/:sessionId/tasks", taskController.Create)
+			sessions.POST("/:id/tasks", sessionExecute, taskController.Create)
+			sessions.POST("/:id/issue-tasks", sessionExecute, issueTrackerController.CreateTaskFromIssue)
+			
+			// 세션 이슈 연결
+			sessions.GET("/:id/issue-links", sessionRead, issueTrackerController.ListSessionLinks)
+			sessions.POST("/:id/issue-links", sessionExecute, issueTrackerController.LinkSession)
+			sessions.DELETE("/:id/issue-links/:linkId", sessionExecute, issueTrackerController.UnlinkSession)
+		}
+
+		// 공유 링크로 접근하는 세션 엔드포인트 (공유 토큰 필요, 로그인은 선택)
+		shared := v1.Group("/shared/sessions/:id")
+		shared.Use(middleware.OptionalAuth(s.jwtManager, s.blacklist))
+		shared.Use(middleware.RequireShareToken(s.shareService))
+		{
+			shared.GET("", shareController.GetShared)
+			shared.GET("/messages", shareController.SharedMessages)
+		}
+
+		// 대화 기록 검색 (인증 필요, 소유하거나 공유받은 워크스페이스로 제한)
+		messages := v1.Group("/messages")
+		messages.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			messages.GET("/search", messageController.SearchMessages)
+		}
+
+		// 대화 기록 내보내기 (인증 필요, 읽을 수 있는 세션만, 결과는 아티팩트로 저장)
+		transcriptExportController := controllers.NewTranscriptExportController(s.transcriptExport)
+		transcriptExports := v1.Group("/exports/transcripts")
+		transcriptExports.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			transcriptExports.GET("", transcriptExportController.ListExports)
+			transcriptExports.POST("", transcriptExportController.StartExport)
+			transcriptExports.GET("/:id", transcriptExportController.GetExport)
+		}
+
+		// 통합 검색 (인증 필요, 권한 있는 워크스페이스의 문서와 공유 프롬프트로 제한)
+		searchGroup := v1.Group("/search")
+		searchGroup.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			searchGroup.GET("", controllers.NewSearchController(s.searchService).Search)
+		}
+
+		// 개인 활동 피드 (인증 필요, 워크스페이스 피드는 /workspaces/:id/activity)
+		activityGroup := v1.Group("/activity")
+		activityGroup.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			activityGroup.GET("", activityController.ListMyActivity)
+			activityGroup.POST("/read", activityController.MarkMyActivityRead)
+		}
+
+		// 알림 센터 (인증 필요, 본인 알림만)
+		notificationGroup := v1.Group("/notifications")
+		notificationGroup.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			notificationGroup.GET("", notificationController.ListNotifications)
+			notificationGroup.POST("/read-all", notificationController.MarkAllNotificationsRead)
+			notificationGroup.POST("/:id/read", notificationController.MarkNotificationRead)
+			notificationGroup.GET("/preferences", notificationController.GetPreferences)
+			notificationGroup.PUT("/preferences", notificationController.UpdatePreferences)
+		}
+
+		// 사용자 언어 설정 (인증 필요, 본인 설정만)
+		if s.preferences != nil {
+			preferenceController := controllers.NewUserPreferenceController(s.preferences)
+			preferenceGroup := v1.Group("/preferences")
+			preferenceGroup.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+			{
+				preferenceGroup.GET("", preferenceController.GetPreferences)
+				preferenceGroup.PUT("", preferenceController.UpdatePreferences)
+			}
 		}
 
 		// 태스크 관련 엔드포인트 (인증 필요)
 		tasks := v1.Group("/tasks")
 		tasks.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
 		{
-			tasks.GET("", taskController.List)
-			tasks.GET("/active", taskController.GetActiveTasks)
+			// 여러 워크스페이스에 걸친 목록은 admin만 조회 (그 외 사용자는 session_id 지정)
+			tasks.GET("", middleware.RequireSessionQueryPermission(s.workspaceAccess, models.WorkspacePermissionRead), taskController.List)
+			tasks.GET("/active", middleware.RequireRole("admin"), taskController.GetActiveTasks)
 			tasks.GET("/stats", taskController.GetStats)
-			tasks.GET("/:id", taskController.GetByID)
-			tasks.DELETE("/:id", taskController.Cancel)
+			tasks.GET("/:id", taskRead, taskController.GetByID)
+			tasks.GET("/:id/review", taskRead, taskReviewController.GetTaskReview)
+			tasks.GET("/:id/artifacts", taskRead, taskArtifactController.List)
+			tasks.GET("/:id/events", taskRead, taskEventController.Poll)
+			tasks.GET("/:id/pull-request", taskRead, pullRequestController.GetTaskPullRequest)
+			tasks.POST("/:id/pull-request", taskExecute, pullRequestController.CreatePullRequest)
+			tasks.GET("/:id/issue-links", taskRead, issueTrackerController.ListTaskLinks)
+			tasks.POST("/:id/issue-links", taskExecute, issueTrackerController.LinkTask)
+			tasks.DELETE("/:id/issue-links/:linkId", taskExecute, issueTrackerController.UnlinkTask)
+			tasks.DELETE("/:id", taskExecute, taskController.Cancel)
+			
+			// 일괄 처리 (POST /tasks:batchCreate)
+			tasks.POST("/batchCreate", batchController.BatchCreateTasks)
+		}
+
+		// GraphQL 게이트웨이 (GET은 EventSource 구독용)
+		graphqlController := controllers.NewGraphQLController(s.graphql)
+		graphqlGroup := v1.Group("/graphql")
+		graphqlGroup.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			graphqlGroup.POST("", graphqlController.Handle)
+			graphqlGroup.GET("", graphqlController.Handle)
+		}
+
+		// 아티팩트 관련 엔드포인트
+		if s.artifactService != nil {
+			artifactController := controllers.NewArtifactController(s.artifactService, s.maxUploadSize)
+			
+			// 다운로드는 미리 서명된 URL로 접근 제어 (인증 불필요)
+			v1.GET("/artifacts/download/*key", artifactController.Download)
+			
+			artifacts := v1.Group("/artifacts")
+			artifacts.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+			{
+				artifacts.POST("", artifactController.Upload)
+				artifacts.GET("/url", artifactController.GetDownloadURL)
+			}
+			
+			tasks.POST("/:id/archive", taskExecute, artifactController.ArchiveTaskOutput)
+		}
+
+		// 프롬프트 라이브러리 엔드포인트 (인증 필요)
+		promptController := controllers.NewPromptController(s.prompts)
+		prompts := v1.Group("/prompts")
+		prompts.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			prompts.GET("", promptController.ListPrompts)
+			prompts.POST("", promptController.CreatePrompt)
+			prompts.GET("/:id", promptController.GetPrompt)
+			prompts.PUT("/:id", promptController.UpdatePrompt)
+			prompts.DELETE("/:id", promptController.DeletePrompt)
+			prompts.POST("/:id/render", promptController.RenderPrompt)
+			
+			// 세션 권한은 서비스에서 확인 (session_id가 요청 본문에 있음)
+			prompts.POST("/:id/launch", promptController.LaunchPrompt)
+		}
+
+		// 파이프라인 (다단계 워크플로, 단계 실행 시 세션 워크스페이스 execute 권한은 서비스에서 확인)
+		pipelineController := controllers.NewPipelineController(s.pipelines)
+		pipelines := v1.Group("/pipelines")
+		pipelines.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			pipelines.GET("", pipelineController.ListPipelines)
+			pipelines.POST("", pipelineController.CreatePipeline)
+			pipelines.GET("/:id", pipelineController.GetPipeline)
+			pipelines.PUT("/:id", pipelineController.UpdatePipeline)
+			pipelines.DELETE("/:id", pipelineController.DeletePipeline)
+			pipelines.POST("/:id/runs", pipelineController.RunPipeline)
+		}
+		
+		pipelineRuns := v1.Group("/pipeline-runs")
+		pipelineRuns.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			pipelineRuns.GET("", pipelineController.ListRuns)
+			pipelineRuns.GET("/:id", pipelineController.GetRun)
+			pipelineRuns.POST("/:id/cancel", pipelineController.CancelRun)
+			pipelineRuns.POST("/:id/resume", pipelineController.ResumeRun)
+		}
+
+		// 워크스페이스 팬아웃 (같은 프롬프트를 여러 워크스페이스에서 병렬 실행)
+		fanOutController := controllers.NewFanOutController(s.fanOuts)
+		fanOuts := v1.Group("/fanouts")
+		fanOuts.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			fanOuts.GET("", fanOutController.ListFanOuts)
+			fanOuts.POST("", fanOutController.StartFanOut)
+			fanOuts.GET("/:id", fanOutController.GetFanOut)
+			fanOuts.POST("/:id/cancel", fanOutController.CancelFanOut)
+			fanOuts.GET("/:id/report", fanOutController.GetReport)
+		}
+
+		// 태스크 결과 검토 (승인 시 커밋, 거부 시 롤백, 줄 단위 의견과 수정 요청)
+		reviews := v1.Group("/reviews")
+		reviews.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			reviews.GET("", taskReviewController.ListReviews)
+			reviews.GET("/:id", taskReviewController.GetReview)
+			reviews.GET("/:id/diff", taskReviewController.GetReviewDiff)
+			reviews.POST("/:id/approve", taskReviewController.ApproveReview)
+			reviews.POST("/:id/reject", taskReviewController.RejectReview)
+			reviews.GET("/:id/comments", taskReviewController.ListReviewComments)
+			reviews.POST("/:id/comments", taskReviewController.AddReviewComment)
+			reviews.PUT("/:id/comments/:commentId", taskReviewController.UpdateReviewComment)
+			reviews.DELETE("/:id/comments/:commentId", taskReviewController.DeleteReviewComment)
+			reviews.POST("/:id/revise", taskReviewController.ReviseReview)
+		}
+
+		// GitHub/GitLab 웹훅 매핑 설정
+		webhookController := controllers.NewWebhookController(s.webhooks)
+		webhooks := v1.Group("/webhooks")
+		webhooks.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			webhooks.GET("", webhookController.ListMappings)
+			webhooks.POST("", webhookController.CreateMapping)
+			webhooks.GET("/:id", webhookController.GetMapping)
+			webhooks.PUT("/:id", webhookController.UpdateMapping)
+			webhooks.DELETE("/:id", webhookController.DeleteMapping)
+			webhooks.POST("/:id/rotate-secret", webhookController.RotateSecret)
+		}
+
+		// 웹훅 수신 (인증 대신 매핑의 비밀 값으로 서명 검증)
+		hooks := v1.Group("/hooks")
+		{
+			hooks.POST("/github/:id", webhookController.ReceiveGitHub)
+			hooks.POST("/gitlab/:id", webhookController.ReceiveGitLab)
 		}
 
 		// 로그 관련 엔드포인트 (인증 필요)
 		logs := v1.Group("/logs")
 		logs.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
 		{
-			logs.GET("/workspaces/:id", handlers.GetWorkspaceLogs)
-			logs.GET("/tasks/:id", handlers.GetTaskLogs)
+			logs.GET("/workspaces/:id", wsRead, handlers.GetWorkspaceLogs)
+			logs.GET("/tasks/:id", taskRead, handlers.GetTaskLogs)
 			// TODO: WebSocket 엔드포인트는 나중에 추가
 		}
 
+		// 로컬 계정 본인 관리 엔드포인트 (인증 필요)
+		if s.accounts != nil {
+			accountController := controllers.NewAccountController(s.accounts)
+			account := v1.Group("/account")
+			account.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+			{
+				account.GET("/me", accountController.GetProfile)
+				account.PUT("/me", accountController.UpdateProfile)
+				account.PUT("/me/password", accountController.ChangePassword)
+			}
+		}
+
+		// 본인 개인정보 내보내기 (인증 필요)
+		privacyController := controllers.NewPrivacyController(s.privacy)
+		privacy := v1.Group("/privacy")
+		privacy.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		{
+			privacy.GET("/export", privacyController.ExportMyData)
+		}
+		
 		// RBAC 관련 엔드포인트 (인증 필요)
 		rbac := v1.Group("/rbac")
 		rbac.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
@@ -201,6 +715,181 @@ func (s *Server) setupRoutes() {
 			}
 		}
 
+		// 보안 대시보드 (인증 필요 + security 리소스 read 권한을 가진 보안 관리자)
+		if s.securityDashboard != nil {
+			securityDashboardController := controllers.NewSecurityDashboardController(s.securityDashboard)
+			securityGroup := v1.Group("/security")
+			securityGroup.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+			securityGroup.Use(middleware.RequirePermission(s.rbacManager, models.ResourceTypeSecurity, models.ActionRead))
+			{
+				securityGroup.GET("/dashboard", securityDashboardController.GetOverview)
+				securityGroup.GET("/dashboard/events", securityDashboardController.ListEvents)
+				securityGroup.GET("/dashboard/blocked-ips", securityDashboardController.ListBlockedIPs)
+				securityGroup.GET("/dashboard/trend", securityDashboardController.GetTrend)
+			}
+		}
+
+		// 관리자 엔드포인트 (인증 필요 + 관리자 권한)
+		admin := v1.Group("/admin")
+		admin.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
+		admin.Use(middleware.RequireRole("admin"))
+		if s.backupManager != nil {
+			backupController := controllers.NewBackupController(s.backupManager)
+			
+			admin.GET("/backups", backupController.ListBackups)
+			admin.POST("/backups", backupController.CreateBackup)
+			admin.POST("/backups/:id/verify", backupController.VerifyBackup)
+		}
+		
+		if s.pluginManager != nil {
+			admin.GET("/plugins", controllers.NewPluginController(s.pluginManager).ListPlugins)
+		}
+		
+		// 백그라운드 작업 조회와 수동 실행
+		if s.jobRunner != nil {
+			jobController := controllers.NewJobController(s.jobRunner)
+			
+			admin.GET("/jobs", jobController.ListJobs)
+			admin.GET("/jobs/:name", jobController.GetJob)
+			admin.POST("/jobs/:name/run", jobController.RunJob)
+		}
+		
+		// 고아 프로세스 점검 및 정리
+		if s.processReaper != nil {
+			processReaperController := controllers.NewProcessReaperController(s.processReaper)
+			
+			admin.GET("/processes/orphans", processReaperController.ListOrphans)
+			admin.POST("/processes/orphans/reap", processReaperController.ReapOrphans)
+		}
+		
+		// 의존성 캐시 사용량 및 용량 정리
+		if s.cacheManager != nil {
+			cacheController := controllers.NewCacheController(s.cacheManager)
+			
+			admin.GET("/caches", cacheController.GetCacheUsage)
+			admin.POST("/caches/evict", cacheController.EnforceCacheQuota)
+		}
+		
+		// 웜 컨테이너 풀 현황
+		if s.warmPool != nil {
+			admin.GET("/warm-pool", controllers.NewWarmPoolController(s.warmPool).GetStats)
+		}
+		
+		// Claude 자격 증명 풀 현황과 격리 해제
+		if s.quotaGovernor != nil {
+			quotaController := controllers.NewQuotaController(s.quotaGovernor)
+			admin.GET("/quota", quotaController.GetQuota)
+			admin.POST("/quota/:name/reinstate", quotaController.ReinstateCredential)
+		}
+		
+		// 다중 레플리카 리더 현황
+		if s.clusterNode != nil {
+			admin.GET("/cluster", controllers.NewClusterController(s.clusterNode).GetStatus)
+		}
+		
+		// 호스트 자원 상태와 실행을 기다리는 태스크
+		if s.admission != nil {
+			admin.GET("/admission", controllers.NewAdmissionController(s.admission).GetAdmission)
+		}
+		
+		// 모듈별 로그 레벨 조회와 변경
+		if s.logs != nil {
+			logLevelController := controllers.NewLogLevelController(s.logs)
+			admin.GET("/log-levels", logLevelController.GetLogLevels)
+			admin.PUT("/log-levels", logLevelController.UpdateLogLevels)
+		}
+		
+		// 장애 주입 테스트 모드 (production이 아닌 환경에서 chaos.enabled일 때만)
+		if s.faults != nil {
+			faultInjectionController := controllers.NewFaultInjectionController(s.faults)
+			admin.GET("/fault-injection", faultInjectionController.GetFaultInjection)
+			admin.PUT("/fault-injection", faultInjectionController.UpdateFaultInjection)
+		}
+		
+		// 동시 실행 세션 한도
+		if s.sessionLimits != nil {
+			sessionLimitController := controllers.NewSessionLimitController(s.sessionLimits)
+			admin.GET("/session-limits", sessionLimitController.GetSessionLimits)
+			admin.PUT("/session-limits", sessionLimitController.UpdateSessionLimits)
+		}
+		
+		// 공격 탐지 임계값과 탐지 기록 모드
+		if s.attackDetector != nil {
+			attackDetectionController := controllers.NewAttackDetectionController(s.attackDetector, s.attackInspection)
+			admin.GET("/attack-detection", attackDetectionController.GetAttackDetection)
+			admin.PUT("/attack-detection", attackDetectionController.UpdateAttackDetection)
+		}
+		
+		// 보안 대시보드용 CSP 위반 보고
+		if cspReportController != nil {
+			admin.GET("/csp-reports", cspReportController.ListReports)
+		}
+		
+		// 유지보수 모드
+		if s.maintenance != nil {
+			maintenanceController := controllers.NewMaintenanceController(s.maintenance)
+			admin.GET("/maintenance", maintenanceController.GetMaintenance)
+			admin.PUT("/maintenance", maintenanceController.UpdateMaintenance)
+		}
+		
+		// 로그인 실패로 잠긴 로컬 계정 잠금 해제
+		if s.accounts != nil {
+			admin.POST("/accounts/:id/unlock", controllers.NewAccountController(s.accounts).UnlockAccount)
+		}
+		
+		// 소유권 일괄 이전과 사용자 오프보딩
+		admin.POST("/ownership/transfer", ownershipController.BulkTransfer)
+		admin.POST("/users/:id/offboard", ownershipController.OffboardUser)
+		
+		// 개인정보 내보내기와 삭제 (처리 기록 포함)
+		admin.GET("/users/:id/export", privacyController.ExportUserData)
+		admin.POST("/users/:id/erase", privacyController.EraseUserData)
+		admin.GET("/privacy/requests", privacyController.ListPrivacyRequests)
+		
+		// 메일 발송 기록과 재시도
+		if s.mailer != nil {
+			emailController := controllers.NewEmailController(s.mailer)
+			admin.GET("/email/deliveries", emailController.ListDeliveries)
+			admin.POST("/email/deliveries/:id/retry", emailController.RetryDelivery)
+			admin.POST("/email/test", emailController.SendTestEmail)
+		}
+		
+		// 원격 에이전트 현황
+		if s.remoteRegistry != nil {
+			admin.GET("/remote-agents", controllers.NewRemoteAgentController(s.remoteRegistry).ListAgents)
+		}
+		
+		// 워크스페이스 이미지 정책
+		if s.imageService != nil {
+			admin.GET("/image-policy", controllers.NewWorkspaceImageController(s.imageService, s.storage).GetPolicy)
+		}
+		
+		// 에러 통계 시계열
+		if s.errorStats != nil {
+			errorStatsController := controllers.NewErrorStatsController(s.errorStats)
+			if s.errorTrend != nil {
+				errorStatsController.SetTrendMonitor(s.errorTrend)
+			}
+			
+			admin.GET("/errors/stats", errorStatsController.GetErrorStats)
+			admin.GET("/errors/trend", errorStatsController.GetErrorTrend)
+		}
+		
+		// 사용량 분석 대시보드 (일별 집계)
+		if s.analytics != nil {
+			analyticsController := controllers.NewAnalyticsController(s.analytics)
+			admin.GET("/analytics/summary", analyticsController.GetSummary)
+			admin.GET("/analytics/daily", analyticsController.GetDaily)
+			admin.GET("/analytics/workspaces", analyticsController.GetTopWorkspaces)
+		}
+		
+		// 외부 이벤트 브로커 전달 통계
+		if s.eventConnector != nil {
+			admin.GET("/events/broker", func(c *gin.Context) {
+				c.JSON(http.StatusOK, s.eventConnector.GetMetrics())
+			})
+		}
+
 		// 설정 관련 엔드포인트 (인증 필요 + 관리자 권한)
 		config := v1.Group("/config")
 		config.Use(middleware.RequireAuth(s.jwtManager, s.blacklist))
@@ -216,6 +905,28 @@ func (s *Server) setupRoutes() {
 	
 	// Claude WebSocket 스트림 엔드포인트
 	s.router.GET("/ws/executions/:executionID", s.claudeStreamHandler.HandleConnection)
+	
+	// 원격 에이전트 접속 엔드포인트 (에이전트 토큰으로 인증)
+	if s.remoteRegistry != nil {
+		s.router.GET("/ws/agents", gin.WrapH(s.remoteRegistry))
+	}
+	
+	// 공유 세션 WebSocket 엔드포인트 (공유 토큰 필요, 로그인은 선택)
+	s.router.GET("/ws/session/:id",
+		middleware.OptionalAuth(s.jwtManager, s.blacklist),
+		middleware.RequireShareToken(s.shareService),
+		s.handleSharedSession,
+	)
+	
+	// 워크스페이스 터미널 WebSocket 엔드포인트 (write 범위 토큰, 워크스페이스 실행 권한과 RBAC terminal:execute 권한 필요, 입출력 녹화)
+	terminalController := controllers.NewTerminalController(s.terminals)
+	terminalController.SetAllowedOrigins(s.terminalOrigins)
+	s.router.GET("/ws/workspaces/:id/terminal",
+		middleware.RequireInteractiveWebSocketAuth(s.jwtManager, s.blacklist),
+		middleware.RequireWorkspacePermission(s.workspaceAccess, models.WorkspacePermissionExecute),
+		middleware.RequirePermission(s.rbacManager, models.ResourceTypeTerminal, models.ActionExecute),
+		terminalController.Connect,
+	)
 
 	// 개발 환경용 디버그 라우트
 	if gin.Mode() == gin.DebugMode {
@@ -238,8 +949,15 @@ func (s *Server) setupRoutes() {
 		}
 	}
 
-	// 404 핸들러
+	// 모든 라우트 등록 후 스펙에 반영
+	openAPI.SetRoutes(s.router.Routes())
+
+	// 404 핸들러 (웹 UI를 제공하면 API가 아닌 GET 요청은 웹 UI 파일이나 index.html로 응답)
 	s.router.NoRoute(func(c *gin.Context) {
+		if s.webUI != nil && webui.IsUIRequest(c.Request) {
+			s.webUI.Serve(c)
+			return
+		}
 		c.JSON(http.StatusNotFound, gin.H{
 			"error":   "Not Found",
 			"message": "요청한 엔드포인트를 찾을 수 없습니다",
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/search"
+)
+
+// NewSearchIndexFromConfig 설정의 백엔드로 통합 검색 인덱스를 만듭니다
+// memory 인덱스는 인스턴스마다 따로 만들어지므로, 다중 레플리카에서는 elasticsearch로 인덱스를 공유합니다.
+func NewSearchIndexFromConfig(cfg config.SearchConfig) (search.Index, error) {
+	switch cfg.Backend {
+	case "", "memory":
+		return search.NewMemoryIndex(), nil
+	case "elasticsearch":
+		return search.NewElasticsearchIndex(search.ElasticsearchConfig{
+			URL:      cfg.Elasticsearch.URL,
+			Index:    cfg.Elasticsearch.Index,
+			Username: cfg.Elasticsearch.Username,
+			Password: cfg.Elasticsearch.Password,
+		})
+	default:
+		return nil, fmt.Errorf("지원하지 않는 검색 백엔드: %s", cfg.Backend)
+	}
+}
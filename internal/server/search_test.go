@@ -0,0 +1,27 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/search"
+)
+
+func TestNewSearchIndexFromConfig(t *testing.T) {
+	index, err := NewSearchIndexFromConfig(config.SearchConfig{})
+	require.NoError(t, err)
+	assert.IsType(t, &search.MemoryIndex{}, index)
+
+	index, err = NewSearchIndexFromConfig(config.SearchConfig{
+		Backend:       "elasticsearch",
+		Elasticsearch: config.SearchElasticsearchConfig{URL: "http://localhost:9200", Index: "aicli-search"},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &search.ElasticsearchIndex{}, index)
+
+	_, err = NewSearchIndexFromConfig(config.SearchConfig{Backend: "solr"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+// SecurityHeadersConfigFromConfig 설정으로 지시문별 CSP 보안 헤더 설정을 구성합니다 (비활성이면 nil)
+// nil이면 환경별 고정 정책을 보내는 기본 보안 헤더를 사용합니다.
+// 프레임 차단과 script-src는 기본 보안 헤더의 production 정책과 같게 두고,
+// 외부 이미지와 아바타가 막히지 않도록 Cross-Origin-Embedder-Policy는 보내지 않습니다.
+func SecurityHeadersConfigFromConfig(cfg config.CSPConfig, env string) *middleware.SecurityHeadersConfig {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	headers := middleware.DefaultSecurityHeadersConfig()
+	headers.CSPScriptSrc = []string{"'self'"}
+	if env == "development" {
+		headers = middleware.DevelopmentSecurityHeadersConfig()
+	}
+	headers.FrameOptions = "DENY"
+	headers.CrossOriginEmbedderPolicy = ""
+	headers.CSPNonce = cfg.Nonce
+	headers.CSPReportOnly = cfg.ReportOnly
+	headers.CSPReportURI = cfg.ReportURI
+	return headers
+}
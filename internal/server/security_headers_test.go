@@ -0,0 +1,84 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/api/controllers"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/security"
+)
+
+func TestSecurityHeadersConfigFromConfig(t *testing.T) {
+	assert.Nil(t, SecurityHeadersConfigFromConfig(config.CSPConfig{}, "production"))
+
+	cfg := config.CSPConfig{Enabled: true, Nonce: true, ReportOnly: true, ReportURI: config.DefaultCSPReportURI}
+	headers := SecurityHeadersConfigFromConfig(cfg, "production")
+	require.NotNil(t, headers)
+	assert.True(t, headers.CSPNonce)
+	assert.True(t, headers.CSPReportOnly)
+	assert.Equal(t, config.DefaultCSPReportURI, headers.CSPReportURI)
+	assert.Equal(t, []string{"'self'"}, headers.CSPScriptSrc)
+	assert.Equal(t, "DENY", headers.FrameOptions)
+	assert.Empty(t, headers.CrossOriginEmbedderPolicy)
+
+	headers = SecurityHeadersConfigFromConfig(cfg, "development")
+	assert.Contains(t, headers.CSPConnectSrc, "ws:")
+}
+
+func TestCSPReportCollection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := security.NewCSPReportStore(10)
+	controller := controllers.NewCSPReportController(store)
+
+	r := gin.New()
+	r.Use(middleware.SecurityHeadersMiddleware(SecurityHeadersConfigFromConfig(config.CSPConfig{
+		Enabled: true, Nonce: true, ReportURI: config.DefaultCSPReportURI,
+	}, "production")))
+	r.POST("/api/v1/csp-report", controller.CollectReport)
+	r.GET("/api/v1/admin/csp-reports", controller.ListReports)
+
+	post := func(contentType, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/csp-report", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := post(security.CSPReportContentType, `{"csp-report": {"document-uri": "https://aicli.local/", "blocked-uri": "inline", "effective-directive": "script-src-elem", "disposition": "enforce"}}`)
+	require.Equal(t, http.StatusNoContent, w.Code)
+	assert.NotEmpty(t, w.Header().Get(middleware.CSPNonceHeader))
+
+	w = post(security.CSPReportsContentType, `[{"type": "csp-violation", "url": "https://aicli.local/", "body": {"blockedURL": "eval", "effectiveDirective": "script-src", "disposition": "report"}}]`)
+	require.Equal(t, http.StatusNoContent, w.Code)
+
+	assert.Equal(t, http.StatusBadRequest, post(security.CSPReportContentType, `{"csp-report": {}}`).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post(security.CSPReportContentType, strings.Repeat(" ", 65*1024)).Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/csp-reports?disposition=enforce", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var list models.CSPReportList
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	assert.Equal(t, int64(2), list.Received)
+	assert.Equal(t, 10, list.MaxReports)
+	assert.Len(t, list.Summary, 2)
+	require.Len(t, list.Reports, 1)
+	assert.Equal(t, "script-src-elem", list.Reports[0].EffectiveDirective)
+	assert.NotEmpty(t, list.Reports[0].IPAddress)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/admin/csp-reports?disposition=block", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -2,21 +2,41 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 	
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/viper"
 	"github.com/sirupsen/logrus"
+	"github.com/aicli/aicli-web/internal/artifact"
 	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/backup"
+	"github.com/aicli/aicli-web/internal/broker"
+	"github.com/aicli/aicli-web/internal/chaos"
+	"github.com/aicli/aicli-web/internal/cluster"
 	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/email"
+	"github.com/aicli/aicli-web/internal/jobs"
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/plugin"
+	"github.com/aicli/aicli-web/internal/remote"
+	"github.com/aicli/aicli-web/internal/security"
 	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/secrets"
+	"github.com/aicli/aicli-web/internal/search"
 	"github.com/aicli/aicli-web/internal/storage"
-	"github.com/aicli/aicli-web/internal/storage/memory"
+	"github.com/aicli/aicli-web/internal/storage/cached"
 	"github.com/aicli/aicli-web/internal/utils"
 	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/webui"
 	"github.com/aicli/aicli-web/internal/websocket"
 	"github.com/aicli/aicli-web/internal/claude"
 	"github.com/aicli/aicli-web/internal/docker"
 	"github.com/aicli/aicli-web/internal/api/handlers"
+	sessionws "github.com/aicli/aicli-web/internal/api/websocket"
 )
 
 // Server는 API 서버의 핵심 구조체입니다.
@@ -26,13 +46,89 @@ type Server struct {
 	router         *gin.Engine
 	jwtManager     *auth.JWTManager
 	blacklist      *auth.Blacklist
+	deviceAuth     *auth.DeviceAuthorizer // CLI 디바이스 코드 로그인
 	oauthManager   auth.OAuthManager
 	rbacManager    *auth.RBACManager
+	rbacEvents     *auth.RBACEventManager
 	storage          storage.Storage
+	queryCache       *cached.Storage // 스토리지 읽기 캐시 (비활성이면 nil)
 	workspaceService services.WorkspaceService
+	workspaceAccess  *services.WorkspaceAccessService // 워크스페이스 공유 ACL과 권한 확인
+	ownership        *services.OwnershipService       // 소유권 이전과 사용자 오프보딩
+	privacy          *services.PrivacyService         // 개인정보 내보내기와 삭제
 	dockerWorkspaceService *services.DockerWorkspaceService // Docker 통합 워크스페이스 서비스 추가
+	cacheManager     *docker.CacheManager // 워크스페이스 의존성 캐시
+	warmPool         *docker.WarmPool     // 웜 컨테이너 풀
+	quotaGovernor    *claude.QuotaGovernor // Claude 자격 증명 쿼터 거버너
+	outputRedactor   *claude.OutputRedactor // Claude 출력 마스킹 필터
+	imageService     *services.WorkspaceImageService // 워크스페이스별 이미지 정책과 빌드
+	networkPolicy    *services.NetworkPolicyService  // 워크스페이스별 네트워크 이그레스 정책
 	sessionService   *services.SessionService
 	taskService      *services.TaskService
+	prompts          *services.PromptService // 프롬프트 라이브러리
+	pipelines        *services.PipelineService // 다단계 태스크 파이프라인
+	fanOuts          *services.FanOutService   // 워크스페이스 팬아웃
+	taskReviews      *services.TaskReviewService // 태스크 결과 검토와 승인
+	webhooks         *services.WebhookService    // GitHub/GitLab 웹훅 트리거
+	pullRequests     *services.PullRequestService // 태스크 결과 PR/MR 생성
+	issueTracker     *services.IssueTrackerService // Jira/GitHub Issues 연동
+	workspaceEnv     *services.WorkspaceEnvService // 워크스페이스 환경 변수와 비밀 값
+	workspaceDoctor  *services.WorkspaceDoctorService // 워크스페이스 사전 점검
+	terminals        *services.TerminalService     // 워크스페이스 터미널 접속과 녹화
+	terminalOrigins  []string                      // 터미널 WebSocket을 허용할 브라우저 오리진 (CORS 허용 목록)
+	taskArtifacts    *services.TaskArtifactService // 태스크 대화 기록의 결과물 추출
+	sessionCommands  *services.SessionCommandService // 세션 셸 명령 실행 감사 기록
+	snapshots        *services.WorkspaceSnapshotService // 워크스페이스 파일 시스템 스냅샷 (비활성이면 nil)
+	diskQuota        *services.WorkspaceDiskQuotaService // 워크스페이스 디스크 한도 (비활성이면 nil)
+	workspaceWatch   *services.WorkspaceWatchService // 워크스페이스 파일 변경 시 자동 태스크 실행 (비활성이면 nil)
+	admission        *services.HostAdmissionService // 호스트 자원 기반 태스크 실행 허가 (비활성이면 nil)
+	taskEvents       *services.TaskEventService    // 태스크 이벤트 스트림 (롱 폴링)
+	graphql          *services.GraphQLService      // 대시보드용 GraphQL 게이트웨이
+	maintenance      *services.MaintenanceService // 유지보수 모드
+	sessionLimits    *services.SessionLimitService // 사용자별, 서버 전체 동시 실행 세션 한도
+	accounts         *services.AccountService     // 로컬 계정 (비활성이면 nil)
+	attackDetector   *security.AttackDetector     // 로그인 무차별 대입과 요청 공격 탐지 (임계값은 실행 중 변경 가능)
+	attackInspection bool                         // 모든 요청을 공격 탐지기로 검사
+	securityHeaders  *middleware.SecurityHeadersConfig // 지시문별 CSP 보안 헤더 (nil이면 기본 보안 헤더)
+	cspReports       *security.CSPReportStore     // 보안 대시보드용 CSP 위반 보고
+	securityDashboard *security.SecurityDashboard // 보안 이벤트 집계 (이벤트 추적기가 없으면 nil)
+	webUI            *webui.Handler               // 빌드된 웹 UI (비활성이면 nil)
+	mailer           *email.Mailer                // 메일 발송 기록과 재시도 대기열 (비활성이면 nil)
+	notifier         services.NotificationService // 초대, 비밀번호 재설정, 예산, 보안 알림 메일 (비활성이면 nil)
+	remoteRegistry   *remote.Registry // 원격 에이전트 접속과 태스크 배정
+	batchService     *services.BatchService
+	archiveService   *services.WorkspaceArchiveService
+	backupManager    *backup.Manager
+	jobRunner        *jobs.Runner // 백그라운드 작업 예약과 수동 실행
+	artifactService  *services.ArtifactService
+	maxUploadSize    int64
+	eventConnector   *broker.Connector
+	pluginManager    *plugin.Manager
+	mcpService       *services.MCPService
+	agentRegistry    *claude.AgentRegistry
+	restartPolicies  *claude.RestartPolicyRegistry
+	processReaper    *claude.ProcessReaper
+	clusterNode      *cluster.Cluster    // 다중 레플리카 잠금과 리더 선출
+	metricsGatherer  prometheus.Gatherer // 인스턴스 식별자를 붙인 메트릭 수집기
+	metricsToken     string              // /metrics 수집용 Bearer 토큰 (비어 있으면 관리자 JWT 필요)
+	logger           *logrus.Logger
+	logs             *logging.Registry // 모듈별 로그 레벨
+	faults           *chaos.Injector   // 장애 주입 테스트 모드 (비활성이면 nil)
+	rateLimiter      *middleware.RateLimitMiddleware // 설정 다시 읽기 시 제한 변경
+	requestTimeout   *middleware.RequestTimeoutMiddleware // 요청 처리 시간 예산 (설정 다시 읽기 시 변경)
+	hangPolicy       *claude.HangPolicy
+	errorStats       *claude.ErrorStatisticsCollector
+	errorTrend       *claude.ErrorTrendMonitor
+	messageService   *services.MessageService
+	branchService    *services.SessionBranchService
+	shareService     *services.SessionShareService
+	searchService    *services.SearchService // 통합 검색 (워크스페이스, 세션, 프롬프트, 감사 기록)
+	activity         *services.ActivityService // 워크스페이스/사용자 활동 피드와 읽음 표시
+	notifications    *services.NotificationCenter // 사용자별 알림 센터 (예산, 검토, 초대, 보안 알림)
+	analytics        *services.AnalyticsService // 사용량 분석 대시보드 (일별 집계)
+	transcriptSync   *services.TranscriptSyncService // CLI 오프라인 모드 대화 기록과 사용량 동기화
+	transcriptExport *services.TranscriptExportService // 세션 대화 기록 Markdown/HTML/PDF 내보내기
+	preferences      *services.UserPreferenceService // 사용자 언어 설정 (Claude 응답 언어, 에러와 알림 메시지 언어)
 	
 	// Claude 관련
 	claudeWrapper        claude.Wrapper
@@ -42,16 +138,25 @@ type Server struct {
 	// WebSocket 관련
 	wsHub     *websocket.Hub
 	wsHandler *websocket.WebSocketHandler
+	sharedSessionHandler *sessionws.ClaudeStreamHandler // 공유 링크로 참여하는 공동 작업 세션
 }
 
-// New는 새로운 서버 인스턴스를 생성합니다.
+// New는 기본 설정으로 새로운 서버 인스턴스를 생성합니다.
+// 기본 설정은 메모리 스토리지를 사용하므로 생성에 실패하지 않습니다.
 func New() *Server {
+	s, err := NewWithConfig(config.GetDefaultConfig())
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewWithConfig는 주어진 설정으로 새로운 서버 인스턴스를 생성합니다.
+// 스토리지를 만들 수 없으면 다른 스토리지로 대신하지 않고 에러를 반환합니다.
+func NewWithConfig(cfg *config.Config) (*Server, error) {
 	// 커스텀 validator 등록
 	utils.RegisterCustomValidators()
 	
-	// 설정 로드
-	cfg := config.GetDefaultConfig()
-	
 	// JWT 매니저 초기화
 	jwtManager := auth.NewJWTManager(
 		cfg.API.JWTSecret,
@@ -62,6 +167,12 @@ func New() *Server {
 	// 블랙리스트 초기화
 	blacklist := auth.NewBlacklist()
 	
+	// CLI 디바이스 코드 로그인 승인 요청 저장소
+	deviceAuth := auth.NewDeviceAuthorizer(auth.DefaultDeviceCodeTTL, auth.DefaultDevicePollInterval)
+	
+	// 외부 의존성(Redis, Git 호스트, OAuth 제공자) 회로 차단기 (비활성이면 nil)
+	breakers := NewBreakerRegistryFromConfig(cfg.CircuitBreaker, prometheus.DefaultRegisterer)
+	
 	// OAuth 매니저 초기화
 	oauthConfigs := make(map[auth.OAuthProvider]*auth.OAuthConfig)
 	
@@ -90,9 +201,18 @@ func New() *Server {
 	}
 	
 	oauthManager := auth.NewOAuthManager(oauthConfigs, jwtManager)
+	oauthManager.SetBreakers(breakers)
+	
+	// 장애 주입 테스트 모드 (chaos.enabled이고 production이 아닐 때만, 아니면 nil)
+	faults, faultsErr := NewFaultInjectorFromConfig(cfg.Chaos, cfg.Server.Env)
 	
-	// 스토리지 초기화 (개발 환경에서는 메모리 스토리지 사용)
-	storage := memory.New()
+	// 스토리지 초기화 (storage.type에 따라, 실패하면 데이터를 잃지 않도록 서버를 시작하지 않음)
+	// 자주 읽는 계정, 워크스페이스, 역할/권한 조회는 읽기 캐시를 거치고, 장애 주입 중이면 캐시 아래에서 지연
+	backend, err := NewStorageFromConfig(cfg.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("스토리지 초기화 실패 (type=%s): %w", cfg.Storage.Type, err)
+	}
+	storage, queryCache := NewQueryCacheFromConfig(cfg.Storage.Cache, withFaultInjection(backend, faults))
 	
 	// 워크스페이스 서비스 초기화
 	workspaceService := services.NewWorkspaceService(storage)
@@ -109,6 +229,31 @@ func New() *Server {
 		dockerWorkspaceService = services.NewDockerWorkspaceService(workspaceService, storage, dockerManager)
 	}
 	
+	// 컨테이너 재생성 시에도 유지할 의존성 캐시 (node_modules, .venv, Go 모듈 캐시)
+	var cacheManager *docker.CacheManager
+	if dockerWorkspaceService != nil {
+		cacheManager = NewCacheManagerFromConfig(cfg.Docker.Cache, dockerManager)
+		if cacheManager != nil {
+			dockerWorkspaceService.SetCacheManager(cacheManager)
+		}
+	}
+	
+	// 워크스페이스별 네트워크 이그레스 정책
+	var networkPolicy *services.NetworkPolicyService
+	if dockerWorkspaceService != nil {
+		networkPolicy = NewNetworkPolicyServiceFromConfig(cfg.Docker.NetworkPolicy, dockerManager)
+		dockerWorkspaceService.SetNetworkPolicyService(networkPolicy)
+	}
+	
+	// 새 워크스페이스 시작을 빠르게 하는 웜 컨테이너 풀
+	var warmPool *docker.WarmPool
+	if dockerWorkspaceService != nil {
+		warmPool = NewWarmPoolFromConfig(cfg.Docker.WarmPool, dockerManager, prometheus.DefaultRegisterer)
+		if warmPool != nil {
+			dockerWorkspaceService.SetWarmPool(warmPool)
+		}
+	}
+	
 	// 프로젝트 서비스 초기화
 	projectService := services.NewProjectService(storage)
 	
@@ -116,7 +261,36 @@ func New() *Server {
 	sessionService := services.NewSessionService(storage, projectService, nil)
 	
 	// 태스크 서비스 초기화
-	taskService := services.NewTaskService(storage, sessionService, nil)
+	taskConfig := services.DefaultTaskServiceConfig()
+	taskConfig.TaskTimeout = cfg.Tasks.StandardTimeout
+	taskConfig.TimeoutTiers = map[models.TaskTimeoutTier]time.Duration{
+		models.TaskTimeoutQuick:    cfg.Tasks.QuickTimeout,
+		models.TaskTimeoutStandard: cfg.Tasks.StandardTimeout,
+		models.TaskTimeoutLong:     cfg.Tasks.LongTimeout,
+	}
+	taskConfig.CancelGracePeriod = cfg.Tasks.CancelGracePeriod
+	taskConfig.MaxWorkers = cfg.Limits.TaskWorkers
+	taskConfig.MaxQueueSize = cfg.Limits.TaskQueueSize
+	taskService := services.NewTaskService(storage, sessionService, taskConfig)
+	
+	// 유지보수 모드 (새 태스크 거부 또는 보관)
+	maintenance := services.NewMaintenanceService(services.MaintenanceConfig{
+		Message:          cfg.Maintenance.Message,
+		RetryAfter:       cfg.Maintenance.RetryAfter,
+		QueueSubmissions: cfg.Maintenance.QueueSubmissions,
+		MaxQueued:        cfg.Maintenance.MaxQueued,
+	})
+	taskService.SetMaintenance(maintenance)
+	if cfg.Maintenance.Enabled {
+		maintenance.Enable("", 0, cfg.Maintenance.QueueSubmissions)
+	}
+	
+	// 사용자별, 서버 전체 동시 실행 세션 한도 (관리자 API로 변경 가능)
+	sessionLimits := services.NewSessionLimitService(cfg.Limits.SessionsPerUser, cfg.Limits.SessionsPerOrg)
+	taskService.SetSessionLimits(sessionLimits)
+	
+	// 호스트 자원 부족 시 새 claude 태스크 실행 지연
+	admission := NewHostAdmissionServiceFromConfig(cfg, taskService)
 	
 	// WebSocket 허브 초기화
 	wsHub := websocket.NewHub(nil)
@@ -124,14 +298,162 @@ func New() *Server {
 	// WebSocket 핸들러 초기화
 	wsHandler := websocket.NewWebSocketHandler(wsHub, jwtManager, blacklist, nil)
 	
+	// 워크스페이스별 이미지 정책 (빌드 로그는 WebSocket으로 전달)
+	var imageService *services.WorkspaceImageService
+	if dockerWorkspaceService != nil {
+		imageService = NewWorkspaceImageServiceFromConfig(cfg.Docker.ImagePolicy, dockerManager, wsHub)
+		dockerWorkspaceService.SetImageService(imageService)
+	}
+	
 	// 로거 초기화
 	logger := logrus.New()
+	applyLogLevel(logger, cfg.Logging.Level)
+	if faultsErr != nil {
+		logger.WithError(faultsErr).Warn("장애 주입 초기화 실패")
+	} else if faults != nil {
+		logger.Warn("장애 주입 테스트 모드: 스토리지 지연, Claude 프로세스 강제 종료, WebSocket 프레임 누락을 주입합니다")
+	}
+	
+	// 인스턴스 식별자 (모든 로그와 메트릭에 표시)
+	instanceID, err := cluster.ResolveInstanceID(cfg.Cluster.InstanceID, cfg.Reaper.InstanceID)
+	if err != nil {
+		logger.WithError(err).Warn("인스턴스 식별자를 확인할 수 없습니다")
+		instanceID = "unknown"
+	}
+	logger.AddHook(cluster.NewInstanceHook(instanceID))
+	
+	// Claude 출력 마스킹 (설정 오류 시 마스킹하지 않음, 로그에도 기본 정책 적용)
+	outputRedactor, err := NewOutputRedactorFromConfig(cfg.Redaction, prometheus.DefaultRegisterer)
+	if err != nil {
+		logger.WithError(err).Warn("출력 마스킹 초기화 실패")
+		outputRedactor = nil
+	}
+	if outputRedactor != nil {
+		logger.AddHook(claude.NewRedactionLogHook(outputRedactor))
+		taskService.SetOutputRedactor(outputRedactor)
+	}
+	
+	// 모듈별 로그 레벨 (모듈 로거는 위 훅을 공유하며, 세션과 태스크 로그는 요청, 세션, 워크스페이스 ID로 연결)
+	logs := logging.NewRegistry(logger)
+	if err := logs.Apply(cfg.Logging.Level, cfg.Logging.Modules); err != nil {
+		logger.WithError(err).Warn("모듈별 로그 레벨 설정 실패")
+	}
+	claudeLogger := logs.Logger(logging.ModuleClaude)
+	sessionService.SetLogger(logs.For(logging.ModuleClaude))
+	taskService.SetLogger(logs.For(logging.ModuleClaude))
+	
+	// 워크스페이스 환경 변수 (비밀 값은 secrets.key, 없으면 api.jwt_secret으로 만든 키로 암호화)
+	secretsKey := cfg.Secrets.Key
+	if secretsKey == "" {
+		secretsKey = cfg.API.JWTSecret
+	}
+	secretsBox, err := secrets.NewBox(secretsKey)
+	if err != nil {
+		logger.WithError(err).Warn("비밀 값 암호화 키가 없어 비밀 환경 변수를 저장할 수 없습니다")
+	}
+	workspaceEnv := services.NewWorkspaceEnvService(storage, secretsBox)
+	workspaceEnv.SetLogger(logs.For(logging.ModuleSecurity))
+	taskService.SetEnvironment(workspaceEnv)
+	
+	// 사용자 언어 설정 (태스크 claude 명령에 응답 언어 지시문 추가)
+	userPreferences := services.NewUserPreferenceService(storage)
+	taskService.SetResponseLanguageProvider(userPreferences)
+	
+	// 다중 레플리카 조정 (설정 오류 시 모든 단일 실행 작업을 이 인스턴스에서 실행)
+	clusterNode, err := NewClusterFromConfig(cfg.Cluster, instanceID, breakers, logger)
+	if err != nil {
+		logger.WithError(err).Warn("클러스터 초기화 실패")
+		clusterNode = nil
+	}
+	
+	// 메일 발송 (설정 오류 시 메일을 보내지 않음)
+	mailer, err := NewMailerFromConfig(cfg.Email, storage.EmailDelivery(), logger)
+	if err != nil {
+		logger.WithError(err).Warn("메일 발송 초기화 실패")
+		mailer = nil
+	}
+	notifier := newNotificationServiceFromConfig(cfg, mailer)
+	
+	// 사용자별 알림 센터 (예산, 검토, 초대, 보안 알림을 WebSocket과 메일로 전달)
+	notificationCenter := NewNotificationCenter(cfg, storage, wsHub, notifier)
+	notificationCenter.SetPreferences(userPreferences)
 	
-	// Claude 프로세스 매니저 초기화
-	processManager := claude.NewProcessManager(logger)
+	// WebSocket 채널 이벤트 로그 (설정 오류 시 재개 없이 실시간 전송만 함)
+	if eventLog, err := NewEventLogFromConfig(cfg.WebSocket.EventLog); err != nil {
+		logger.WithError(err).Warn("WebSocket 이벤트 로그 초기화 실패")
+	} else if eventLog != nil {
+		wsHub.SetEventLog(eventLog)
+	}
+	if faults != nil {
+		wsHub.SetFrameDropper(faults)
+	}
+	
+	// 로컬 계정 (비활성이면 개발용 고정 계정으로 로그인)
+	attackDetector := NewAttackDetectorFromConfig(cfg.AttackDetection, cfg.Accounts.Lockout, notificationCenter, breakers, logs.For(logging.ModuleSecurity))
+	accounts, err := NewAccountServiceFromConfig(cfg.Accounts, storage.Account(), notifier, notificationCenter, attackDetector, logs.For(logging.ModuleSecurity))
+	if err != nil {
+		logger.WithError(err).Error("로컬 계정 초기화 실패")
+	}
+	if accounts != nil {
+		accounts.SetTokenRevoker(blacklist, cfg.API.RefreshTokenExpiry)
+	}
+	
+	// 고아 프로세스 정리기 초기화 (설정 오류 시 프로세스를 기록하지 않음)
+	processReaper, err := NewProcessReaperFromConfig(cfg.Reaper, instanceID, storage, logger)
+	if err != nil {
+		logger.WithError(err).Warn("고아 프로세스 정리기 초기화 실패")
+		processReaper = nil
+	}
+	
+	// Claude 프로세스 매니저 초기화 (실행한 프로세스는 정리기에 기록, 장애 주입 중이면 강제 종료 예약)
+	var processTracker claude.ProcessTracker
+	if processReaper != nil {
+		processTracker = processReaper
+	}
+	if faults != nil {
+		processTracker = faults.ProcessTracker(processTracker)
+	}
+	processManager := claude.NewProcessManagerWithTracker(logs.For(logging.ModuleClaude), processTracker)
+	
+	// 에이전트 프로바이더 초기화 (설정 오류 시 Claude만 사용)
+	agentRegistry, err := NewAgentRegistryFromConfig(cfg.Agents)
+	if err != nil {
+		logger.WithError(err).Warn("에이전트 프로바이더 초기화 실패")
+		agentRegistry = claude.NewAgentRegistry()
+	}
+	
+	// 프로세스 자동 재시작 정책 초기화 (설정 오류 시 자동 재시작하지 않음)
+	restartPolicies, err := NewRestartPolicyRegistryFromConfig(cfg.Restart)
+	if err != nil {
+		logger.WithError(err).Warn("재시작 정책 초기화 실패")
+		restartPolicies = claude.NewRestartPolicyRegistry(nil)
+	}
+	
+	// 멈춘 프로세스 감지 정책 (설정 오류 시 감지하지 않음)
+	hangPolicy := &claude.HangPolicy{
+		Timeout:       cfg.Hang.Timeout,
+		CheckInterval: cfg.Hang.CheckInterval,
+		AutoKill:      cfg.Hang.AutoKill,
+	}
+	if err := hangPolicy.Validate(); err != nil {
+		logger.WithError(err).Warn("멈춤 감지 정책 초기화 실패")
+		hangPolicy = nil
+	}
 	
 	// Claude 세션 매니저 초기화
-	sessionManager := claude.NewSessionManager(processManager, storage)
+	sessionManager := claude.NewSessionManagerWithAgents(processManager, storage, agentRegistry)
+	
+	// 시스템 프롬프트 가드레일 (설정 오류 시 적용하지 않음)
+	promptGuardrails, err := NewPromptGuardrailsFromConfig(cfg.Guardrails)
+	if err != nil {
+		logger.WithError(err).Warn("시스템 프롬프트 가드레일 초기화 실패")
+		promptGuardrails = nil
+	}
+	if promptGuardrails != nil {
+		if setter, ok := sessionManager.(claude.GuardrailSetter); ok {
+			setter.SetGuardrails(promptGuardrails)
+		}
+	}
 	
 	// Claude 래퍼 초기화
 	claudeWrapper := claude.NewWrapper(sessionManager, processManager)
@@ -150,25 +472,433 @@ func New() *Server {
 	rbacCache := auth.NewInMemoryPermissionCache()
 	rbacManager := auth.NewRBACManager(storage.RBAC(), rbacCache)
 	
+	// RBAC 변경 이벤트 버스 (읽기 캐시 무효화, 활동 피드)
+	rbacBus := NewRBACEventBus(queryCache)
+	rbacEvents := auth.NewRBACEventManager(rbacBus)
+	
+	// 백업 관리자 초기화 (설정 오류 시 백업 기능 비활성화)
+	backupManager, err := backup.NewManagerFromConfig(cfg, storage)
+	if err != nil {
+		logs.Logger(logging.ModuleStorage).WithError(err).Warn("백업 관리자 초기화 실패")
+		backupManager = nil
+	}
+	if backupManager != nil && clusterNode != nil {
+		backupManager.SetGuard(clusterNode)
+	}
+	
+	// 아티팩트 저장소 초기화 (설정 오류 시 아티팩트 기능 비활성화)
+	var artifactService *services.ArtifactService
+	artifactStore, err := artifact.NewStoreFromConfig(cfg.Artifacts, []byte(cfg.API.JWTSecret))
+	if err != nil {
+		logger.WithError(err).Warn("아티팩트 저장소 초기화 실패")
+	} else {
+		artifactService = services.NewArtifactService(artifactStore, taskService, cfg.Artifacts.PresignExpiry)
+	}
+	
+	// 워크스페이스별 MCP 서버 관리
+	mcpService := services.NewMCPService(cfg.MCP.Dir, claude.NewMCPHealthChecker(cfg.MCP.HealthCheckTimeout), cfg.MCP.HealthCheckInterval)
+	
+	// 태스크 훅 플러그인 초기화 (설정 오류 시 플러그인 비활성화)
+	pluginManager, err := plugin.NewManagerFromConfig(cfg.Plugins, logger)
+	if err != nil {
+		logger.WithError(err).Warn("플러그인 초기화 실패")
+		pluginManager = nil
+	}
+	if pluginManager != nil {
+		taskService.SetPluginManager(pluginManager)
+	}
+	
+	// 세션 컨텍스트 사용량 추적 (한도에 가까우면 WebSocket 경고 또는 자동 압축)
+	contextTracker, err := NewContextTrackerFromConfig(cfg.ContextWindow, sessionManager, storage, claudeLogger)
+	if err != nil {
+		logger.WithError(err).Warn("컨텍스트 추적기 초기화 실패")
+		contextTracker = nil
+	}
+	if contextTracker != nil {
+		if source, ok := sessionManager.(claude.SessionEventSource); ok {
+			// 세션 ID별 구독 대신 이벤트 타입으로 구독해 모든 세션의 이벤트를 받음
+			broadcaster := NewContextEventBroadcaster(wsHub)
+			source.Events().SubscribeToType(claude.SessionEventClosed, contextTracker.OnSessionEvent)
+			source.Events().SubscribeToType(claude.SessionEventContextWarning, broadcaster.OnSessionEvent)
+			source.Events().SubscribeToType(claude.SessionEventCompacted, broadcaster.OnSessionEvent)
+		}
+	}
+	
+	// Kubernetes 태스크 실행기 (설정 오류 시 로컬 프로세스로 실행)
+	taskRunner, err := NewKubernetesTaskRunnerFromConfig(cfg.Kubernetes, wsHub, contextTracker, outputRedactor, logger)
+	if err != nil {
+		logger.WithError(err).Warn("Kubernetes 태스크 실행기 초기화 실패")
+	} else if taskRunner != nil {
+		taskService.SetTaskRunner(taskRunner)
+	}
+	
+	// Claude 자격 증명별 요청 한도 관리 (한도에 가까우면 태스크 지연 또는 재대기)
+	quotaGovernor, err := NewQuotaGovernorFromConfig(cfg.Quota, prometheus.DefaultRegisterer, claudeLogger)
+	if err != nil {
+		logger.WithError(err).Warn("쿼터 거버너 초기화 실패")
+		quotaGovernor = nil
+	}
+	if quotaGovernor != nil {
+		quotaGovernor.SetBudgetAlertHandler(newQuotaBudgetNotifier(notificationCenter))
+		taskService.SetQuotaGovernor(quotaGovernor)
+	}
+	
+	// 원격 에이전트 분산 실행 (활성화되면 Kubernetes 실행기보다 우선)
+	remoteRegistry := NewRemoteRegistryFromConfig(cfg.Remote, logger)
+	if remoteRegistry != nil {
+		if taskRunner != nil {
+			logger.Warn("kubernetes와 remote가 모두 활성화되어 원격 에이전트로 태스크를 실행합니다")
+		}
+		taskService.SetTaskRunner(NewRemoteTaskRunner(remoteRegistry, wsHub, outputRedactor, logger))
+	}
+	
+	// 외부 이벤트 브로커 연결 (설정 오류 시 이벤트 내보내기 비활성화)
+	eventConnector, err := broker.NewConnectorFromConfig(context.Background(), cfg.Events, prometheus.DefaultRegisterer, logger)
+	if err != nil {
+		logger.WithError(err).Warn("이벤트 브로커 연결 실패")
+		eventConnector = nil
+	}
+	if eventConnector != nil {
+		if source, ok := sessionManager.(claude.SessionEventSource); ok {
+			source.Events().Subscribe("", NewSessionEventForwarder(eventConnector, logger))
+		}
+	}
+	
+	// 에러 통계 집계 (세션 에러와 프로세스 비정상 종료를 시간별/일별로 저장)
+	var errorStats *claude.ErrorStatisticsCollector
+	if cfg.ErrorStats.Enabled {
+		statsConfig := claude.ErrorStatisticsCollectorConfig{
+			FlushInterval:   cfg.ErrorStats.FlushInterval,
+			CompactInterval: cfg.ErrorStats.CompactInterval,
+			HourlyRetention: cfg.ErrorStats.HourlyRetention,
+			DailyRetention:  cfg.ErrorStats.DailyRetention,
+			Registerer:      prometheus.DefaultRegisterer,
+		}
+		if clusterNode != nil {
+			statsConfig.Guard = clusterNode
+		}
+		errorStats = claude.NewErrorStatisticsCollector(storage.ErrorStats(), nil, statsConfig, claudeLogger)
+		if source, ok := sessionManager.(claude.SessionEventSource); ok {
+			source.Events().Subscribe("", errorStats)
+		}
+	}
+	
+	// 세션 에러 자동 복구 (프로세스 재시작, 컨테이너 재생성, 재인증)
+	recoveryManager := NewRecoveryManagerFromConfig(cfg.Recovery, sessionManager, processManager, dockerWorkspaceService, errorStats, logger)
+	if recoveryManager != nil {
+		if source, ok := sessionManager.(claude.SessionEventSource); ok {
+			source.Events().Subscribe("", recoveryManager)
+		}
+	}
+	
+	// 에러 추세 분석 (예측 에러율이 임계값을 넘거나 이상이 감지되면 알림)
+	errorTrend, err := NewErrorTrendMonitorFromConfig(cfg.ErrorStats.Trend, errorStats, NewErrorTrendAlertManager(eventConnector, logger), logger)
+	if err != nil {
+		logger.WithError(err).Warn("에러 추세 분석 초기화 실패")
+		errorTrend = nil
+	}
+	
+	// 워크스페이스 아카이브 (파일 복원은 가져오기 루트 안으로 제한)
+	archiveService := services.NewWorkspaceArchiveService(storage, workspaceService)
+	archiveService.SetImportRoot(cfg.Workspace.ImportRoot)
+	
+	messageService := services.NewMessageService(storage)
+	
+	// 세션 분기 (분기 세션에도 사용자 응답 언어 적용)
+	branchService := services.NewSessionBranchService(storage, messageService, claudeWrapper)
+	branchService.SetResponseLanguageProvider(userPreferences)
+	
+	// 통합 검색 인덱스 (초기화 실패 시 메모리 인덱스 사용, 인덱스는 백그라운드 작업으로 갱신)
+	searchIndex, err := NewSearchIndexFromConfig(cfg.Search)
+	if err != nil {
+		logger.WithError(err).WithField("backend", cfg.Search.Backend).Warn("검색 인덱스 초기화 실패, 메모리 인덱스를 사용합니다")
+		searchIndex = search.NewMemoryIndex()
+	}
+	searchService := services.NewSearchService(storage, searchIndex)
+	
+	// 사용량 분석 (대시보드는 analytics-rollup 작업이 갱신하는 일별 집계만 조회)
+	analyticsService := services.NewAnalyticsService(storage)
+	
+	// 프롬프트 라이브러리와 파이프라인 (단계 태스크가 끝나면 다음 단계 진행)
+	promptService := services.NewPromptService(storage, taskService)
+	pipelineService := NewPipelineService(storage, promptService, taskService, wsHub)
+	fanOutService := NewFanOutService(storage, promptService, taskService, artifactService, wsHub)
+	taskReviewService := NewTaskReviewService(storage, taskService, wsHub, notificationCenter)
+	webhookService := services.NewWebhookService(storage, taskService)
+	gitHostClient := services.NewGitHostClient(nil)
+	gitHostClient.SetBreakers(breakers)
+	pullRequestService := services.NewPullRequestService(storage, services.NewGitService(), gitHostClient, cfg.Email.BaseURL)
+	pullRequestService.SetSecretsBox(secretsBox)
+	
+	// 이슈 트래커 연동 (태스크 종료 시 댓글, 검토 승인 시 상태 전환)
+	issueTrackerClient := services.NewIssueTrackerClient(nil)
+	issueTrackerClient.SetBreakers(breakers)
+	issueTrackerService := services.NewIssueTrackerService(storage, issueTrackerClient, taskService, cfg.Email.BaseURL)
+	issueTrackerService.SetSecretsBox(secretsBox)
+	taskService.AddFinishListener(issueTrackerService)
+	taskReviewService.AddListener(issueTrackerService)
+	
+	// 암호화를 켜기 전에 평문으로 저장된 PR, 이슈 트래커 토큰을 암호화
+	if secretsBox != nil {
+		if sealed, err := pullRequestService.SealTokens(context.Background()); err != nil {
+			logger.WithError(err).Warn("PR 토큰 암호화 실패")
+		} else if sealed > 0 {
+			logger.WithField("count", sealed).Info("평문으로 저장된 PR 토큰을 암호화했습니다")
+		}
+		if sealed, err := issueTrackerService.SealTokens(context.Background()); err != nil {
+			logger.WithError(err).Warn("이슈 트래커 토큰 암호화 실패")
+		} else if sealed > 0 {
+			logger.WithField("count", sealed).Info("평문으로 저장된 이슈 트래커 토큰을 암호화했습니다")
+		}
+	}
+	
+	// 완료된 태스크의 코드 블록, 파일 경로, 테스트 보고서 추출
+	taskArtifactService := services.NewTaskArtifactService(storage)
+	taskService.AddFinishListener(taskArtifactService)
+	
+	// 태스크에서 Bash 도구로 실행된 명령을 세션 감사 기록으로 저장
+	sessionCommandService := services.NewSessionCommandService(storage)
+	taskService.AddFinishListener(sessionCommandService)
+	
+	// 워크스페이스/사용자 활동 피드 (태스크 종료, 검토, 공유/역할 변경, 보안 알림)
+	workspaceAccess := services.NewWorkspaceAccessService(storage)
+	activityService := NewActivityService(storage, rbacBus, taskService, taskReviewService, workspaceAccess, accounts)
+	workspaceAccess.AddListener(notificationCenter)
+	
+	// 워크스페이스 파일 시스템 스냅샷 (태스크 실행 전 스냅샷 포함)
+	snapshotService := NewWorkspaceSnapshotServiceFromConfig(cfg.Workspace.Snapshots, storage)
+	if snapshotService != nil {
+		taskService.SetSnapshotter(snapshotService)
+	}
+	
+	// 워크스페이스 디스크 한도 (경고 알림, 한도 도달 시 태스크 거부)
+	diskQuotaService := NewWorkspaceDiskQuotaServiceFromConfig(cfg.Workspace.DiskQuota, storage, taskService, wsHub, notificationCenter)
+	
+	// 워크스페이스 파일 변경 시 자동 태스크/파이프라인 실행 (파이프라인 서비스 다음에 종료 알림 등록)
+	workspaceWatch := NewWorkspaceWatchServiceFromConfig(cfg.Workspace, storage, taskService, pipelineService)
+	
+	// 태스크 상태/출력 이벤트 스트림 (WebSocket 태스크 채널과 롱 폴링이 공유)
+	taskEventService := NewTaskEventService(storage, taskService, wsHub)
+	
+	// 워크스페이스 터미널 (Docker를 사용할 수 있으면 컨테이너 셸도 제공)
+	var terminalContainers services.TerminalContainers
+	if dockerWorkspaceService != nil {
+		terminalContainers = services.NewDockerTerminalContainers(dockerManager)
+	}
+	terminalService := services.NewTerminalService(storage, terminalContainers)
+	terminalService.SetHostMode(cfg.Terminal.AllowHost)
+	
+	// 워크스페이스 사전 점검 (꺼져 있는 기능은 건너뜀)
+	workspaceDoctor := services.NewWorkspaceDoctorService(storage)
+	if dockerWorkspaceService != nil {
+		workspaceDoctor.SetImages(services.NewDockerDoctorImages(dockerManager), imageService)
+	}
+	workspaceDoctor.SetDiskQuota(diskQuotaService)
+	workspaceDoctor.SetMCP(mcpService)
+	
+	// 공유 링크 기반 공동 작업 세션 핸들러
+	shareService := services.NewSessionShareService(storage)
+	sharedSessionHandler := sessionws.NewClaudeStreamHandler(sessionManager, nil, sessionws.DefaultClaudeStreamConfig())
+	sharedSessionHandler.SetShareLinkService(shareService)
+	
+	// 소유권 이전과 오프보딩 (개인정보 삭제도 오프보딩을 거침)
+	ownership := services.NewOwnershipService(storage)
+	privacyService := services.NewPrivacyService(storage, ownership)
+	privacyService.SetTokenRevoker(blacklist, cfg.API.RefreshTokenExpiry)
+	
+	// 빌드된 웹 UI 제공 (SPA)
+	webUI, err := NewWebUIFromConfig(cfg.WebUI)
+	if err != nil {
+		logger.WithError(err).Error("웹 UI 초기화 실패, 웹 UI를 제공하지 않습니다")
+	}
+	
 	s := &Server{
 		jwtManager:           jwtManager,
 		blacklist:            blacklist,
+		deviceAuth:           deviceAuth,
 		oauthManager:         oauthManager,
 		rbacManager:          rbacManager,
+		rbacEvents:           rbacEvents,
 		storage:              storage,
+		queryCache:           queryCache,
 		workspaceService:     workspaceService,
+		workspaceAccess:      workspaceAccess,
+		ownership:            ownership,
+		privacy:              privacyService,
 		dockerWorkspaceService: dockerWorkspaceService,
+		cacheManager:         cacheManager,
+		warmPool:             warmPool,
+		quotaGovernor:        quotaGovernor,
+		outputRedactor:       outputRedactor,
+		imageService:         imageService,
+		networkPolicy:        networkPolicy,
 		sessionService:       sessionService,
 		taskService:          taskService,
+		prompts:              promptService,
+		pipelines:            pipelineService,
+		fanOuts:              fanOutService,
+		taskReviews:          taskReviewService,
+		webhooks:             webhookService,
+		pullRequests:         pullRequestService,
+		issueTracker:         issueTrackerService,
+		workspaceEnv:         workspaceEnv,
+		workspaceDoctor:      workspaceDoctor,
+		terminals:            terminalService,
+		terminalOrigins:      cfg.API.CORSOrigins,
+		taskArtifacts:        taskArtifactService,
+		sessionCommands:      sessionCommandService,
+		snapshots:            snapshotService,
+		diskQuota:            diskQuotaService,
+		workspaceWatch:       workspaceWatch,
+		admission:            admission,
+		taskEvents:           taskEventService,
+		maintenance:          maintenance,
+		sessionLimits:        sessionLimits,
+		accounts:             accounts,
+		attackDetector:       attackDetector,
+		attackInspection:     cfg.AttackDetection.Enabled,
+		securityHeaders:      SecurityHeadersConfigFromConfig(cfg.CSP, cfg.Server.Env),
+		cspReports:           security.NewCSPReportStore(cfg.CSP.MaxReports),
+		securityDashboard:    newSecurityDashboard(attackDetector),
+		webUI:                webUI,
+		mailer:               mailer,
+		notifier:             notifier,
+		remoteRegistry:       remoteRegistry,
+		batchService:         services.NewBatchService(storage, workspaceService, taskService),
+		archiveService:       archiveService,
+		backupManager:        backupManager,
+		artifactService:      artifactService,
+		maxUploadSize:        cfg.Artifacts.MaxUploadSize,
+		eventConnector:       eventConnector,
+		pluginManager:        pluginManager,
+		mcpService:           mcpService,
+		agentRegistry:        agentRegistry,
+		restartPolicies:      restartPolicies,
+		processReaper:        processReaper,
+		clusterNode:          clusterNode,
+		metricsGatherer:      cluster.InstanceGatherer(prometheus.DefaultGatherer, instanceID),
+		metricsToken:         cfg.API.MetricsToken,
+		logger:               logger,
+		logs:                 logs,
+		faults:               faults,
+		rateLimiter:          NewRateLimitMiddlewareFromConfig(cfg),
+		requestTimeout:       NewRequestTimeoutMiddlewareFromConfig(cfg.API.RequestTimeout),
+		hangPolicy:           hangPolicy,
+		errorStats:           errorStats,
+		errorTrend:           errorTrend,
+		messageService:       messageService,
+		branchService:        branchService,
+		shareService:         shareService,
+		searchService:        searchService,
+		activity:             activityService,
+		notifications:        notificationCenter,
+		analytics:            analyticsService,
+		transcriptSync:       services.NewTranscriptSyncService(storage, messageService, analyticsService),
+		transcriptExport:     NewTranscriptExportServiceFromConfig(cfg.Exports, storage, artifactService, wsHub, notificationCenter),
+		preferences:          userPreferences,
 		claudeWrapper:        claudeWrapper,
 		claudeStreamHandler:  claudeStreamHandler,
 		executionTracker:     executionTracker,
 		wsHub:                wsHub,
 		wsHandler:            wsHandler,
+		sharedSessionHandler: sharedSessionHandler,
 		authHandler:          handlers.NewAuthHandler(jwtManager, blacklist),
 		shutdown:             make(chan struct{}),
 	}
 	
+	// 백그라운드 작업 등록 (예약 백업, 고아 프로세스 점검, 종료된 인스턴스 정리)
+	// 클러스터가 없으면 PostgreSQL advisory lock으로 같은 데이터베이스를 쓰는 인스턴스 사이에서 작업을 한 번만 실행
+	jobRunner := NewJobRunnerFromConfig(cfg, clusterNode, storageGuard(backend), backupManager, processReaper, searchService, notificationCenter, analyticsService, logger)
+	s.jobRunner = jobRunner
+	
+	// GraphQL 게이트웨이 (필드 권한은 REST와 같은 워크스페이스 ACL로 확인)
+	s.graphql = services.NewGraphQLService(storage, s.workspaceAccess, taskEventService)
+	
+	// 이전 실행에서 남은 고아 프로세스 점검 (현재 인스턴스가 실행한 프로세스는 제외)
+	if processReaper != nil {
+		if err := jobRunner.Trigger(context.Background(), JobOrphanProcessReap); err != nil {
+			logger.WithError(err).Warn("고아 프로세스 점검 실패")
+		}
+	}
+	
+	// 시작할 때 검색 인덱스 만들기 (이후에는 search.reindex_interval마다 갱신)
+	if err := jobRunner.Trigger(context.Background(), JobSearchReindex); err != nil {
+		logger.WithError(err).Warn("검색 인덱스 생성 실패")
+	}
+	
+	// 클러스터 멤버십 유지
+	if clusterNode != nil {
+		go clusterNode.Run(context.Background())
+	}
+	
+	// 백그라운드 작업 예약 실행 (백업, 종료된 인스턴스의 프로세스 기록 정리 등, 다중 레플리카에서는 리더만 실행)
+	if clusterNode != nil {
+		go clusterNode.Elector("jobs").Run(context.Background(), func(ctx context.Context) {
+			jobRunner.Start(ctx)
+			<-ctx.Done()
+			jobRunner.Stop()
+		})
+	} else {
+		jobRunner.Start(context.Background())
+	}
+	
+	// 웜 풀 이미지 미리 받기 및 컨테이너 준비 (이미지 받기가 오래 걸릴 수 있어 백그라운드에서 진행)
+	if warmPool != nil {
+		go func() {
+			if err := warmPool.Start(context.Background()); err != nil {
+				logger.WithError(err).Warn("웜 컨테이너 풀 시작 실패")
+			}
+		}()
+	}
+	
+	// 에러 통계 저장 및 보존 기간 정리 시작
+	if errorStats != nil {
+		errorStats.Start(context.Background())
+	}
+	if errorTrend != nil {
+		if clusterNode != nil {
+			// 레플리카마다 같은 알림을 보내지 않도록 리더만 추세를 분석
+			go clusterNode.Elector("error-trend").Run(context.Background(), func(ctx context.Context) {
+				errorTrend.Start(ctx)
+				<-ctx.Done()
+				errorTrend.Stop()
+			})
+		} else {
+			errorTrend.Start(context.Background())
+		}
+	}
+	
+	// 워크스페이스 디스크 사용량 주기 측정 (다중 레플리카에서는 같은 경고를 보내지 않도록 리더만 측정)
+	if diskQuotaService != nil {
+		if clusterNode != nil {
+			go clusterNode.Elector("disk-quota").Run(context.Background(), func(ctx context.Context) {
+				diskQuotaService.Start(ctx)
+				<-ctx.Done()
+				diskQuotaService.Stop()
+			})
+		} else {
+			diskQuotaService.Start(context.Background())
+		}
+	}
+	
+	// 워크스페이스 파일 감시 (레플리카마다 자기 설정 디렉토리의 감시 설정으로 자기 파일 시스템을 감시)
+	if workspaceWatch != nil {
+		workspaceWatch.Start(context.Background())
+	}
+	
+	// 호스트 자원 측정 (레플리카마다 자기 호스트를 측정)
+	if admission != nil {
+		admission.Start(context.Background())
+	}
+	
+	// 메일 발송 대기열 시작
+	if mailer != nil {
+		go mailer.Run(context.Background())
+	}
+	
+	// MCP 서버 상태 모니터링 시작
+	mcpService.Start(context.Background())
+	
 	// 태스크 서비스 시작
 	if err := taskService.Start(context.Background()); err != nil {
 		// 에러 로깅하지만 서버는 계속 시작
@@ -182,7 +912,7 @@ func New() *Server {
 	}
 	
 	s.setupRouter()
-	return s
+	return s, nil
 }
 
 // Router는 Gin 라우터를 반환합니다.
@@ -190,6 +920,23 @@ func (s *Server) Router() *gin.Engine {
 	return s.router
 }
 
+// Logger는 서버 로거를 반환합니다.
+func (s *Server) Logger() *logrus.Logger {
+	return s.logger
+}
+
+// CloseWebSockets는 WebSocket 연결을 Going Away로 닫아 클라이언트가 다시 연결하게 합니다.
+// HTTP 서버는 넘겨준 WebSocket 연결을 종료할 때 기다리지 않으므로 http.Server.RegisterOnShutdown으로 등록합니다.
+func (s *Server) CloseWebSockets() {
+	s.wsHub.Stop()
+}
+
+// Handler는 HTTP 서버에 연결할 최상위 핸들러를 반환합니다.
+// 커스텀 메서드 경로(`/workspaces:batchDelete`)를 라우팅 전에 변환합니다.
+func (s *Server) Handler() http.Handler {
+	return customMethodHandler(s.router)
+}
+
 // setupRouter는 라우터를 설정합니다.
 func (s *Server) setupRouter() {
 	// 환경에 따른 Gin 모드 설정
@@ -205,11 +952,29 @@ func (s *Server) setupRouter() {
 
 	// 미들웨어 설정 (순서 중요!)
 	s.router.Use(middleware.RequestID())    // 요청 ID 생성 (가장 먼저)
-	s.router.Use(middleware.Security())     // 보안 헤더
+	if s.preferences != nil {
+		s.router.Use(middleware.Localization(s.preferences)) // 에러 메시지 언어 (사용자 설정, 없으면 Accept-Language)
+	}
+	if s.securityHeaders != nil {
+		s.router.Use(middleware.SecurityHeadersMiddleware(s.securityHeaders)) // 보안 헤더 (요청별 CSP nonce, 위반 보고)
+	} else {
+		s.router.Use(middleware.Security()) // 보안 헤더
+	}
 	s.router.Use(middleware.CORS())         // CORS 설정
-	s.router.Use(middleware.RateLimit(middleware.DefaultRateLimitConfig())) // Rate Limiting
-	s.router.Use(middleware.Logger())       // 기본 로깅
-	s.router.Use(middleware.RequestLogger()) // 상세 요청 로깅
+	if s.rateLimiter != nil {
+		s.router.Use(s.rateLimiter.Handler()) // Rate Limiting (설정 다시 읽기로 변경 가능)
+	} else {
+		s.router.Use(middleware.RateLimit(middleware.DefaultRateLimitConfig())) // Rate Limiting
+	}
+	if s.logs != nil {
+		s.router.Use(middleware.AccessLogger(s.logs.For(logging.ModuleAPI))) // 요청 로깅 (api 모듈 레벨 적용)
+	} else {
+		s.router.Use(middleware.Logger())       // 기본 로깅
+		s.router.Use(middleware.RequestLogger()) // 상세 요청 로깅
+	}
+	if s.attackInspection && s.attackDetector != nil {
+		s.router.Use(middleware.AttackDetection(s.attackDetector)) // 요청 공격 탐지 (탐지 기록 모드면 거부하지 않음)
+	}
 	s.router.Use(middleware.GracefulRecovery()) // 패닉 복구
 	s.router.Use(middleware.ErrorHandler())  // 에러 처리 (마지막)
 
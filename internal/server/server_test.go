@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/aicli/aicli-web/internal/config"
 	"github.com/aicli/aicli-web/internal/testutil"
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/viper"
@@ -18,6 +19,21 @@ func TestNew(t *testing.T) {
 	testutil.AssertNotNil(t, s.router)
 }
 
+func TestNewWithConfig_StorageError(t *testing.T) {
+	// 스토리지를 만들 수 없으면 메모리 스토리지로 대신하지 않고 에러 반환
+	cfg := config.GetDefaultConfig()
+	cfg.Storage.Type = "postgres"
+	cfg.Storage.DataSource = "postgres://localhost/aicli"
+	
+	s, err := NewWithConfig(cfg)
+	if err == nil {
+		t.Fatal("postgres 스토리지 설정에서 에러가 반환되어야 함")
+	}
+	if s != nil {
+		t.Error("에러가 있으면 서버를 반환하면 안됨")
+	}
+}
+
 func TestServer_Router(t *testing.T) {
 	s := New()
 	router := s.Router()
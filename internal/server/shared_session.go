@@ -0,0 +1,36 @@
+package server
+
+import (
+	"github.com/gin-gonic/gin"
+
+	sessionws "github.com/aicli/aicli-web/internal/api/websocket"
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/middleware"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// handleSharedSession은 공유 링크로 공동 작업 세션에 WebSocket 참여를 처리합니다.
+// 링크는 middleware.RequireShareToken에서 검증되며, 로그인한 사용자는 본인 계정으로 참여 기록이 남습니다.
+func (s *Server) handleSharedSession(c *gin.Context) {
+	link, ok := middleware.GetShareLink(c)
+	if !ok {
+		middleware.UnauthorizedError(c, "공유 링크 정보를 찾을 수 없습니다")
+		return
+	}
+
+	// 읽기 전용 토큰으로 로그인한 사용자는 링크 범위와 관계없이 조회만 가능
+	if claims, ok := middleware.GetClaims(c); ok && !claims.HasScope(auth.ScopeWrite) && link.Scope != models.ShareScopeRead {
+		readOnly := *link
+		readOnly.Scope = models.ShareScopeRead
+		link = &readOnly
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	userName, _ := middleware.GetUsername(c)
+	s.sharedSessionHandler.HandleSharedConnection(c.Writer, c.Request, link, sessionws.SharedParticipant{
+		UserID:    userID,
+		UserName:  userName,
+		ClientIP:  c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	})
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/aicli/aicli-web/internal/cluster"
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// NewStorageFromConfig 설정의 스토리지 타입으로 백엔드를 만듭니다
+// API 서버는 memory만 지원합니다. postgres 패키지는 워크스페이스, 프로젝트, 세션, 태스크만 테이블에 저장하고
+// 계정, ACL, RBAC, 공유 링크, 웹훅, 환경 변수 등은 인스턴스 메모리에 두므로 서버 스토리지로 선택할 수 없습니다.
+func NewStorageFromConfig(cfg config.StorageConfig) (storage.Storage, error) {
+	switch storage.StorageType(cfg.Type) {
+	case "", storage.StorageTypeMemory:
+		return memory.New(), nil
+	case storage.StorageTypePostgres:
+		return nil, fmt.Errorf("API 서버는 postgres 스토리지를 지원하지 않습니다: 워크스페이스/프로젝트/세션/태스크 외의 데이터(계정, ACL, RBAC, 웹훅 등)를 저장하지 못합니다")
+	default:
+		return nil, fmt.Errorf("API 서버에서 지원하지 않는 스토리지 타입: %s", cfg.Type)
+	}
+}
+
+// storageGuard 스토리지가 인스턴스 간 잠금을 제공하면 단일 실행 작업의 잠금으로 사용합니다 (아니면 nil)
+// PostgreSQL은 같은 데이터베이스를 쓰는 모든 인스턴스가 advisory lock을 공유합니다.
+func storageGuard(s storage.Storage) cluster.Guard {
+	if guard, ok := s.(cluster.Guard); ok {
+		return guard
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestNewStorageFromConfig(t *testing.T) {
+	s, err := NewStorageFromConfig(config.StorageConfig{})
+	require.NoError(t, err)
+	assert.IsType(t, &memory.Storage{}, s)
+
+	s, err = NewStorageFromConfig(config.StorageConfig{Type: "memory"})
+	require.NoError(t, err)
+	assert.IsType(t, &memory.Storage{}, s)
+
+	_, err = NewStorageFromConfig(config.StorageConfig{Type: "boltdb", DataSource: "aicli.db"})
+	assert.Error(t, err)
+
+	// postgres는 일부 스토리지만 구현되어 있어 선택할 수 없음
+	_, err = NewStorageFromConfig(config.StorageConfig{
+		Type:       "postgres",
+		DataSource: "postgres://localhost/aicli",
+	})
+	assert.Error(t, err)
+}
+
+func TestStorageGuard(t *testing.T) {
+	// 메모리 스토리지는 인스턴스 간 잠금이 없음
+	assert.Nil(t, storageGuard(memory.New()))
+}
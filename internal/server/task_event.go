@@ -0,0 +1,34 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewTaskEventService 태스크 이벤트 스트림을 구성하고 태스크 서비스의 상태 변경과 WebSocket 태스크 채널을 연결합니다
+func NewTaskEventService(store storage.Storage, taskService *services.TaskService, hub *websocket.Hub) *services.TaskEventService {
+	eventService := services.NewTaskEventService(store)
+	taskService.SetEventPublisher(eventService)
+	taskService.AddFinishListener(eventService)
+	if hub != nil {
+		eventService.SetListener(&taskEventBroadcaster{hub: hub})
+	}
+	return eventService
+}
+
+// taskEventBroadcaster 태스크 이벤트를 태스크 채널 구독자에게 전달합니다
+type taskEventBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *taskEventBroadcaster) OnTaskEvent(event *models.TaskEvent) {
+	data := map[string]interface{}{
+		"cursor": event.Cursor,
+		"event":  string(event.Type),
+	}
+
+	msg := websocket.NewTaskMessage(event.TaskID, event.SessionID, string(event.Status), event.Output, event.Error, data)
+	b.hub.Broadcast(msg, websocket.GetTaskChannel(event.TaskID))
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewTaskReviewService 태스크 검토 서비스를 구성하고 태스크 제출/종료와 알림 훅을 연결합니다
+// notifications가 nil이면 알림 센터와 메일 없이 WebSocket으로만 알립니다.
+func NewTaskReviewService(store storage.Storage, taskService *services.TaskService, hub *websocket.Hub, notifications *services.NotificationCenter) *services.TaskReviewService {
+	reviewService := services.NewTaskReviewService(store, services.NewGitService())
+	taskService.SetReviewGate(reviewService)
+	taskService.AddFinishListener(reviewService)
+	reviewService.SetTaskRunner(taskService)
+	if hub != nil {
+		reviewService.AddListener(&taskReviewBroadcaster{hub: hub})
+	}
+	if notifications != nil {
+		reviewService.AddListener(notifications)
+	}
+	return reviewService
+}
+
+// taskReviewBroadcaster 검토 요청과 결정을 검토자의 사용자 채널로 전달합니다
+type taskReviewBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *taskReviewBroadcaster) OnReviewRequested(review *models.TaskReview, reviewers []string) {
+	b.broadcast(review, reviewers)
+}
+
+func (b *taskReviewBroadcaster) OnReviewDecided(review *models.TaskReview, reviewers []string) {
+	b.broadcast(review, reviewers)
+}
+
+func (b *taskReviewBroadcaster) broadcast(review *models.TaskReview, reviewers []string) {
+	data := map[string]interface{}{
+		"task_id":       review.TaskID,
+		"workspace_id":  review.WorkspaceID,
+		"files_changed": review.FilesChanged,
+	}
+	if review.ReviewerID != "" {
+		data["reviewer_id"] = review.ReviewerID
+	}
+
+	for _, userID := range reviewers {
+		msg := websocket.NewStatusMessage("task_review", review.ID, string(review.Status), data)
+		msg.Channel = websocket.GetUserChannel(userID)
+		b.hub.BroadcastToUsers(msg, userID)
+	}
+}
@@ -0,0 +1,373 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// TLS 인증서 관리 주기
+const (
+	tlsCheckInterval     = time.Minute      // 인증서 파일 변경과 OCSP 갱신 확인 주기
+	ocspRetryInterval    = 5 * time.Minute  // OCSP 조회 실패 후 다시 시도할 때까지 시간
+	ocspDefaultValidity  = time.Hour        // 다음 갱신 시각이 없는 OCSP 응답의 재조회 주기
+	ocspRequestTimeout   = 10 * time.Second // OCSP 응답자 요청 제한 시간
+	maxOCSPResponseBytes = 1024 * 1024
+)
+
+// TLSManager는 API 서버의 HTTPS 인증서를 제공합니다.
+// 정적 인증서는 파일이 바뀌면 다시 읽고, ACME 인증서는 autocert가 발급하고 만료 전에 갱신하며,
+// 두 방식 모두 인증서의 OCSP 응답자에게 받은 응답을 핸드셰이크에 붙입니다 (OCSP 스테이플링).
+type TLSManager struct {
+	certFile string
+	keyFile  string
+	acme     *autocert.Manager
+	httpPort int
+	stapling bool
+	client   *http.Client
+	logger   *logrus.Logger
+
+	mu       sync.RWMutex
+	static   *tls.Certificate
+	modTime  time.Time
+	staples  map[string]*ocspStaple // 인증서 일련번호별 OCSP 응답
+	fetching map[string]bool
+}
+
+// ocspStaple은 인증서 하나의 OCSP 응답과 다음 조회 시각입니다.
+type ocspStaple struct {
+	leaf      *x509.Certificate
+	issuer    *x509.Certificate
+	raw       []byte    // 비어 있으면 아직 받은 응답이 없음
+	expiresAt time.Time // 응답의 NextUpdate
+	refreshAt time.Time
+}
+
+// NewTLSManagerFromConfig 설정으로 HTTPS 인증서 관리자를 구성합니다 (TLS와 ACME가 모두 비활성이면 nil)
+// ACME를 사용하면 api.acme.domains의 인증서를 처음 요청받을 때 발급하고 cache_dir에 보관합니다.
+// 정적 인증서는 여기서 한 번 읽어 보므로 경로가 잘못되면 오류를 반환합니다.
+func NewTLSManagerFromConfig(cfg config.APIConfig, logger *logrus.Logger) (*TLSManager, error) {
+	if !cfg.TLSEnabled && !cfg.ACME.Enabled {
+		return nil, nil
+	}
+
+	m := &TLSManager{
+		certFile: cfg.TLSCertPath,
+		keyFile:  cfg.TLSKeyPath,
+		stapling: cfg.OCSPStapling,
+		client:   &http.Client{Timeout: ocspRequestTimeout},
+		logger:   logger,
+		staples:  make(map[string]*ocspStaple),
+		fetching: make(map[string]bool),
+	}
+
+	if cfg.ACME.Enabled {
+		if err := os.MkdirAll(cfg.ACME.CacheDir, 0700); err != nil {
+			return nil, fmt.Errorf("ACME 캐시 디렉토리를 만들 수 없습니다: %w", err)
+		}
+		m.acme = &autocert.Manager{
+			Prompt:      autocert.AcceptTOS,
+			Cache:       autocert.DirCache(cfg.ACME.CacheDir),
+			HostPolicy:  autocert.HostWhitelist(cfg.ACME.Domains...),
+			Email:       cfg.ACME.Email,
+			RenewBefore: cfg.ACME.RenewBefore,
+		}
+		if cfg.ACME.DirectoryURL != "" {
+			m.acme.Client = &acme.Client{DirectoryURL: cfg.ACME.DirectoryURL}
+		}
+		m.httpPort = cfg.ACME.HTTPPort
+		return m, nil
+	}
+
+	if err := m.reloadStatic(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TLSConfig는 http.Server에 연결할 TLS 설정을 반환합니다.
+func (m *TLSManager) TLSConfig() *tls.Config {
+	cfg := &tls.Config{NextProtos: []string{"h2", "http/1.1"}}
+	if m.acme != nil {
+		// TLS-ALPN-01 챌린지를 받을 수 있도록 acme-tls/1 프로토콜 포함
+		cfg = m.acme.TLSConfig()
+	}
+	cfg.MinVersion = tls.VersionTLS12
+	cfg.GetCertificate = m.getCertificate
+	return cfg
+}
+
+// ChallengePort는 HTTP-01 챌린지를 받을 포트를 반환합니다 (ACME가 아니면 0).
+func (m *TLSManager) ChallengePort() int {
+	if m.acme == nil {
+		return 0
+	}
+	return m.httpPort
+}
+
+// ChallengeHandler는 HTTP-01 챌린지에 응답하고 그 밖의 요청은 tlsPort의 HTTPS로 리다이렉트하는 핸들러를 반환합니다.
+func (m *TLSManager) ChallengeHandler(tlsPort int) http.Handler {
+	return m.acme.HTTPHandler(redirectToHTTPS(tlsPort))
+}
+
+// Run은 ctx가 끝날 때까지 정적 인증서 파일 변경을 반영하고 곧 만료되는 OCSP 응답을 미리 갱신합니다.
+func (m *TLSManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(tlsCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if m.acme == nil {
+				if err := m.reloadStatic(); err != nil {
+					m.logger.WithError(err).Warn("TLS 인증서를 다시 읽지 못해 기존 인증서를 계속 사용합니다")
+				}
+			}
+			m.refreshStaples(ctx)
+		}
+	}
+}
+
+// getCertificate는 핸드셰이크마다 인증서를 고르고 OCSP 응답이 있으면 붙입니다.
+func (m *TLSManager) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	var cert *tls.Certificate
+	if m.acme != nil {
+		var err error
+		cert, err = m.acme.GetCertificate(hello)
+		if err != nil {
+			return nil, err
+		}
+		// TLS-ALPN-01 챌린지 인증서는 ACME 서버만 확인하므로 OCSP 응답이 필요 없음
+		if len(hello.SupportedProtos) == 1 && hello.SupportedProtos[0] == acme.ALPNProto {
+			return cert, nil
+		}
+	} else {
+		m.mu.RLock()
+		cert = m.static
+		m.mu.RUnlock()
+	}
+
+	if !m.stapling {
+		return cert, nil
+	}
+	return m.withStaple(cert), nil
+}
+
+// reloadStatic은 정적 인증서 파일이 바뀌었으면 다시 읽습니다.
+// 인증서를 갱신하는 외부 도구(certbot 등)가 파일을 교체하면 재시작 없이 반영됩니다.
+func (m *TLSManager) reloadStatic() error {
+	certInfo, err := os.Stat(m.certFile)
+	if err != nil {
+		return fmt.Errorf("TLS 인증서 파일을 찾을 수 없습니다: %w", err)
+	}
+	keyInfo, err := os.Stat(m.keyFile)
+	if err != nil {
+		return fmt.Errorf("TLS 키 파일을 찾을 수 없습니다: %w", err)
+	}
+	modTime := certInfo.ModTime()
+	if keyInfo.ModTime().After(modTime) {
+		modTime = keyInfo.ModTime()
+	}
+
+	m.mu.RLock()
+	unchanged := m.static != nil && modTime.Equal(m.modTime)
+	m.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(m.certFile, m.keyFile)
+	if err != nil {
+		return fmt.Errorf("TLS 인증서를 읽을 수 없습니다: %w", err)
+	}
+
+	m.mu.Lock()
+	reloaded := m.static != nil
+	m.static = &cert
+	m.modTime = modTime
+	m.mu.Unlock()
+
+	if reloaded {
+		m.logger.WithField("cert_file", m.certFile).Info("바뀐 TLS 인증서를 다시 읽었습니다")
+	}
+	return nil
+}
+
+// withStaple은 인증서에 유효한 OCSP 응답을 붙인 복사본을 반환합니다.
+// 응답이 없거나 갱신할 때가 되었으면 핸드셰이크를 기다리게 하지 않도록 백그라운드에서 조회합니다.
+func (m *TLSManager) withStaple(cert *tls.Certificate) *tls.Certificate {
+	if cert == nil || cert.Leaf == nil || len(cert.Leaf.OCSPServer) == 0 || len(cert.Certificate) < 2 {
+		return cert
+	}
+	key := hex.EncodeToString(cert.Leaf.SerialNumber.Bytes())
+	now := time.Now()
+
+	m.mu.Lock()
+	staple, ok := m.staples[key]
+	if !ok {
+		issuer, err := x509.ParseCertificate(cert.Certificate[1])
+		if err != nil {
+			m.mu.Unlock()
+			return cert
+		}
+		staple = &ocspStaple{leaf: cert.Leaf, issuer: issuer}
+		m.staples[key] = staple
+	}
+	raw, expiresAt := staple.raw, staple.expiresAt
+	due := !now.Before(staple.refreshAt) && !m.fetching[key]
+	if due {
+		m.fetching[key] = true
+	}
+	m.mu.Unlock()
+
+	if due {
+		go m.fetchStaple(context.Background(), key)
+	}
+	if len(raw) == 0 || !now.Before(expiresAt) {
+		return cert
+	}
+
+	stapled := *cert
+	stapled.OCSPStaple = raw
+	return &stapled
+}
+
+// refreshStaples는 갱신할 때가 된 OCSP 응답을 다시 조회하고 더는 제공하지 않는 인증서의 응답은 버립니다.
+func (m *TLSManager) refreshStaples(ctx context.Context) {
+	now := time.Now()
+
+	m.mu.Lock()
+	var due []string
+	for key, staple := range m.staples {
+		if now.After(staple.leaf.NotAfter) {
+			delete(m.staples, key)
+			continue
+		}
+		if !now.Before(staple.refreshAt) && !m.fetching[key] {
+			m.fetching[key] = true
+			due = append(due, key)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, key := range due {
+		m.fetchStaple(ctx, key)
+	}
+}
+
+// fetchStaple은 OCSP 응답자에게 인증서 상태를 조회해 저장합니다.
+// 실패하면 기존 응답을 만료될 때까지 계속 사용하고 ocspRetryInterval 뒤에 다시 시도합니다.
+func (m *TLSManager) fetchStaple(ctx context.Context, key string) {
+	m.mu.RLock()
+	staple := m.staples[key]
+	m.mu.RUnlock()
+	if staple == nil {
+		return
+	}
+
+	raw, resp, err := m.requestOCSP(ctx, staple.leaf, staple.issuer)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.fetching, key)
+
+	if err != nil {
+		staple.refreshAt = time.Now().Add(ocspRetryInterval)
+		m.logger.WithError(err).WithField("serial", key).Warn("OCSP 응답을 받지 못했습니다")
+		return
+	}
+	if resp.Status != ocsp.Good {
+		// 폐기된 인증서의 응답을 붙이면 클라이언트가 연결을 거부하므로 기존 응답도 버림
+		staple.raw = nil
+		staple.refreshAt = time.Now().Add(ocspRetryInterval)
+		m.logger.WithField("serial", key).WithField("status", resp.Status).Error("OCSP 응답자가 인증서를 유효하지 않다고 응답했습니다")
+		return
+	}
+
+	staple.raw = raw
+	staple.expiresAt = resp.NextUpdate
+	if resp.NextUpdate.IsZero() {
+		staple.expiresAt = time.Now().Add(ocspDefaultValidity)
+		staple.refreshAt = staple.expiresAt
+		return
+	}
+	// 유효 기간의 절반이 지나면 미리 갱신
+	staple.refreshAt = resp.ThisUpdate.Add(resp.NextUpdate.Sub(resp.ThisUpdate) / 2)
+}
+
+// requestOCSP는 인증서의 첫 번째 OCSP 응답자에게 상태를 조회합니다.
+func (m *TLSManager) requestOCSP(ctx context.Context, leaf, issuer *x509.Certificate) ([]byte, *ocsp.Response, error) {
+	body, err := ocsp.CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("OCSP 요청 생성 실패: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ocspRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, leaf.OCSPServer[0], bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("OCSP 요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+
+	httpResp, err := m.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("OCSP 응답자 요청 실패: %w", err)
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("OCSP 응답자 상태 코드: %d", httpResp.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(httpResp.Body, maxOCSPResponseBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("OCSP 응답 읽기 실패: %w", err)
+	}
+	resp, err := ocsp.ParseResponseForCert(raw, leaf, issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("OCSP 응답 해석 실패: %w", err)
+	}
+	if !resp.NextUpdate.IsZero() && time.Now().After(resp.NextUpdate) {
+		return nil, nil, errors.New("만료된 OCSP 응답입니다")
+	}
+	return raw, resp, nil
+}
+
+// redirectToHTTPS는 GET, HEAD 요청을 같은 호스트의 HTTPS 주소로 리다이렉트합니다 (그 밖의 메서드는 400).
+func redirectToHTTPS(tlsPort int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "HTTPS를 사용하세요", http.StatusBadRequest)
+			return
+		}
+
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if tlsPort != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(tlsPort))
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusFound)
+	})
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+// testCA는 테스트용 인증 기관입니다.
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "aicli test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// writeLeaf는 CA가 서명한 localhost 인증서와 키를 dir에 쓰고 경로를 반환합니다.
+func (ca *testCA) writeLeaf(t *testing.T, dir string, serial int64, ocspURL string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		OCSPServer:   []string{ocspURL},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})...)
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return certFile, keyFile
+}
+
+// ocspResponder는 요청받은 인증서를 status로 응답하는 OCSP 응답자입니다.
+func (ca *testCA) ocspResponder(t *testing.T, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req, err := ocsp.ParseRequest(body)
+		require.NoError(t, err)
+
+		resp, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: req.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, ca.key)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/ocsp-response")
+		w.Write(resp)
+	}))
+}
+
+func TestNewTLSManagerFromConfig(t *testing.T) {
+	logger := logrus.New()
+
+	manager, err := NewTLSManagerFromConfig(config.APIConfig{}, logger)
+	require.NoError(t, err)
+	assert.Nil(t, manager)
+
+	_, err = NewTLSManagerFromConfig(config.APIConfig{
+		TLSEnabled:  true,
+		TLSCertPath: filepath.Join(t.TempDir(), "missing.pem"),
+		TLSKeyPath:  filepath.Join(t.TempDir(), "missing-key.pem"),
+	}, logger)
+	assert.Error(t, err)
+
+	manager, err = NewTLSManagerFromConfig(config.APIConfig{
+		ACME: config.ACMEConfig{
+			Enabled:  true,
+			Domains:  []string{"aicli.example.com"},
+			CacheDir: filepath.Join(t.TempDir(), "acme"),
+			HTTPPort: 80,
+		},
+	}, logger)
+	require.NoError(t, err)
+	assert.Equal(t, 80, manager.ChallengePort())
+	assert.Contains(t, manager.TLSConfig().NextProtos, "acme-tls/1")
+
+	// 허용하지 않은 도메인은 발급하지 않음
+	_, err = manager.TLSConfig().GetCertificate(&tls.ClientHelloInfo{ServerName: "other.example.com"})
+	assert.Error(t, err)
+
+	// 챌린지 경로가 아닌 요청은 HTTPS로 리다이렉트
+	w := httptest.NewRecorder()
+	manager.ChallengeHandler(8443).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://aicli.example.com/api/v1/health?x=1", nil))
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "https://aicli.example.com:8443/api/v1/health?x=1", w.Header().Get("Location"))
+}
+
+func TestTLSManager_StaticCertificateWithOCSPStapling(t *testing.T) {
+	ca := newTestCA(t)
+	responder := ca.ocspResponder(t, ocsp.Good)
+	defer responder.Close()
+
+	dir := t.TempDir()
+	certFile, keyFile := ca.writeLeaf(t, dir, 100, responder.URL)
+	manager, err := NewTLSManagerFromConfig(config.APIConfig{
+		TLSEnabled:   true,
+		TLSCertPath:  certFile,
+		TLSKeyPath:   keyFile,
+		OCSPStapling: true,
+	}, logrus.New())
+	require.NoError(t, err)
+	assert.Zero(t, manager.ChallengePort())
+
+	// 첫 핸드셰이크는 응답 없이 진행하고 백그라운드에서 조회
+	hello := &tls.ClientHelloInfo{ServerName: "localhost"}
+	cert, err := manager.TLSConfig().GetCertificate(hello)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		cert, err = manager.TLSConfig().GetCertificate(hello)
+		return err == nil && len(cert.OCSPStaple) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resp, err := ocsp.ParseResponse(cert.OCSPStaple, ca.cert)
+	require.NoError(t, err)
+	assert.Equal(t, ocsp.Good, resp.Status)
+	assert.Equal(t, int64(100), resp.SerialNumber.Int64())
+
+	// 파일을 교체하면 새 인증서를 제공
+	_, _ = ca.writeLeaf(t, dir, 200, responder.URL)
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+	require.NoError(t, manager.reloadStatic())
+	cert, err = manager.TLSConfig().GetCertificate(hello)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), cert.Leaf.SerialNumber.Int64())
+}
+
+func TestTLSManager_RevokedCertificateNotStapled(t *testing.T) {
+	ca := newTestCA(t)
+	responder := ca.ocspResponder(t, ocsp.Revoked)
+	defer responder.Close()
+
+	certFile, keyFile := ca.writeLeaf(t, t.TempDir(), 100, responder.URL)
+	manager, err := NewTLSManagerFromConfig(config.APIConfig{
+		TLSEnabled:   true,
+		TLSCertPath:  certFile,
+		TLSKeyPath:   keyFile,
+		OCSPStapling: true,
+	}, logrus.New())
+	require.NoError(t, err)
+
+	hello := &tls.ClientHelloInfo{ServerName: "localhost"}
+	cert, err := manager.TLSConfig().GetCertificate(hello)
+	require.NoError(t, err)
+	assert.Empty(t, cert.OCSPStaple)
+
+	// 조회가 끝나면 다음 시도는 재시도 주기 뒤로 미룸
+	manager.refreshStaples(context.Background())
+	require.Eventually(t, func() bool {
+		manager.mu.RLock()
+		defer manager.mu.RUnlock()
+		return len(manager.fetching) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	manager.mu.RLock()
+	for _, staple := range manager.staples {
+		assert.Empty(t, staple.raw)
+		assert.True(t, staple.refreshAt.After(time.Now()))
+	}
+	manager.mu.RUnlock()
+
+	cert, err = manager.TLSConfig().GetCertificate(hello)
+	require.NoError(t, err)
+	assert.Empty(t, cert.OCSPStaple)
+}
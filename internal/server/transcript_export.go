@@ -0,0 +1,54 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewTranscriptExportServiceFromConfig 대화 기록 내보내기 서비스를 구성하고 완료 알림을 연결합니다
+// artifactService가 nil이면 내보내기 요청을 거부하고, exports.pdf_command가 비어 있으면 PDF 형식만 거부합니다.
+func NewTranscriptExportServiceFromConfig(cfg config.ExportsConfig, store storage.Storage, artifactService *services.ArtifactService, hub *websocket.Hub, notifications *services.NotificationCenter) *services.TranscriptExportService {
+	var artifacts services.TranscriptExportStore
+	if artifactService != nil {
+		artifacts = artifactService
+	}
+	var pdf services.TranscriptPDFRenderer
+	if cfg.PDFCommand != "" {
+		pdf = &services.HeadlessPDFRenderer{Command: cfg.PDFCommand, Timeout: cfg.PDFTimeout}
+	}
+
+	exportService := services.NewTranscriptExportService(store, artifacts, pdf, cfg.Retention)
+	if hub != nil {
+		exportService.AddListener(&transcriptExportBroadcaster{hub: hub})
+	}
+	if notifications != nil {
+		exportService.AddListener(notifications)
+	}
+	return exportService
+}
+
+// transcriptExportBroadcaster 내보내기 결과를 시작한 사용자의 사용자 채널로 전달합니다
+type transcriptExportBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *transcriptExportBroadcaster) OnTranscriptExportFinished(export *models.TranscriptExport) {
+	data := map[string]interface{}{
+		"format":   export.Format,
+		"messages": export.Messages,
+	}
+	if export.ArtifactKey != "" {
+		data["artifact_key"] = export.ArtifactKey
+		data["download_url"] = export.DownloadURL
+	}
+	if export.Error != "" {
+		data["error"] = export.Error
+	}
+
+	msg := websocket.NewStatusMessage("transcript_export", export.ID, string(export.Status), data)
+	msg.Channel = websocket.GetUserChannel(export.OwnerID)
+	b.hub.BroadcastToUsers(msg, export.OwnerID)
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/services"
+)
+
+// NewWarmPoolFromConfig 설정으로 웜 컨테이너 풀을 구성합니다 (비활성이거나 워크스페이스 루트가 없으면 nil)
+func NewWarmPoolFromConfig(cfg config.WarmPoolConfig, dockerManager *docker.Manager, reg prometheus.Registerer) *docker.WarmPool {
+	if !cfg.Enabled || cfg.Size <= 0 || cfg.WorkspaceRoot == "" || dockerManager == nil {
+		return nil
+	}
+
+	image := cfg.Image
+	if image == "" {
+		image = services.DefaultWorkspaceImage
+	}
+
+	return docker.NewWarmPool(dockerManager.Container(), docker.WarmPoolConfig{
+		Image:         image,
+		Size:          cfg.Size,
+		WorkspaceRoot: cfg.WorkspaceRoot,
+		HealthCheck:   cfg.HealthCheckInterval,
+		MaxIdle:       cfg.MaxIdle,
+		Registerer:    reg,
+	})
+}
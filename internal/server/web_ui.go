@@ -0,0 +1,31 @@
+package server
+
+import (
+	"errors"
+	"os"
+
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/webui"
+	"github.com/aicli/aicli-web/web"
+)
+
+// NewWebUIFromConfig 설정으로 웹 UI 핸들러를 구성합니다 (비활성이면 nil)
+// web_ui.dir이 비어 있으면 바이너리에 포함한 웹 UI를 사용하므로 embedui 태그로 빌드해야 합니다.
+func NewWebUIFromConfig(cfg config.WebUIConfig) (*webui.Handler, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	if cfg.Dir != "" {
+		if _, err := os.Stat(cfg.Dir); err != nil {
+			return nil, err
+		}
+		return webui.New(os.DirFS(cfg.Dir), cfg.AssetMaxAge)
+	}
+
+	dist := web.Dist()
+	if dist == nil {
+		return nil, errors.New("바이너리에 웹 UI가 포함되지 않았습니다 (embedui 태그로 빌드하거나 web_ui.dir을 지정하세요)")
+	}
+	return webui.New(dist, cfg.AssetMaxAge)
+}
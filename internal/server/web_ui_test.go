@@ -0,0 +1,31 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/config"
+)
+
+func TestNewWebUIFromConfig(t *testing.T) {
+	handler, err := NewWebUIFromConfig(config.WebUIConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, handler)
+
+	// embedui 태그 없이 빌드한 테스트 바이너리에는 웹 UI가 없음
+	_, err = NewWebUIFromConfig(config.WebUIConfig{Enabled: true})
+	assert.Error(t, err)
+
+	_, err = NewWebUIFromConfig(config.WebUIConfig{Enabled: true, Dir: filepath.Join(t.TempDir(), "missing")})
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html></html>"), 0644))
+	handler, err = NewWebUIFromConfig(config.WebUIConfig{Enabled: true, Dir: dir, AssetMaxAge: config.DefaultWebUIAssetMaxAge})
+	require.NoError(t, err)
+	assert.NotNil(t, handler)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewWorkspaceDiskQuotaServiceFromConfig 설정으로 워크스페이스 디스크 한도 서비스를 구성하고 태스크 생성과 알림 훅을 연결합니다 (비활성이면 nil)
+// notifications가 nil이면 알림 센터와 메일 없이 WebSocket으로만 알립니다.
+func NewWorkspaceDiskQuotaServiceFromConfig(cfg config.WorkspaceDiskQuotaConfig, store storage.Storage, taskService *services.TaskService, hub *websocket.Hub, notifications *services.NotificationCenter) *services.WorkspaceDiskQuotaService {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	limits := make(map[string]int64, len(cfg.Workspaces))
+	for workspaceID, limitMB := range cfg.Workspaces {
+		limits[workspaceID] = limitMB * 1024 * 1024
+	}
+	quotaService := services.NewWorkspaceDiskQuotaService(store, services.WorkspaceDiskQuotaConfig{
+		DefaultLimit: cfg.DefaultLimitMB * 1024 * 1024,
+		Limits:       limits,
+		WarnRatio:    cfg.WarnRatio,
+		CacheTTL:     cfg.CacheTTL,
+		ScanInterval: cfg.ScanInterval,
+	})
+	taskService.SetDiskQuota(quotaService)
+	if hub != nil {
+		quotaService.AddListener(&diskQuotaBroadcaster{hub: hub})
+	}
+	if notifications != nil {
+		quotaService.AddListener(notifications)
+	}
+	return quotaService
+}
+
+// diskQuotaBroadcaster 디스크 사용량 경고를 워크스페이스 소유자의 사용자 채널로 전달합니다
+type diskQuotaBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *diskQuotaBroadcaster) OnDiskQuotaLevelChanged(workspace *models.Workspace, usage *models.WorkspaceDiskUsage) {
+	msg := websocket.NewStatusMessage("workspace_disk_quota", workspace.ID, string(usage.Level), map[string]interface{}{
+		"used_bytes":  usage.UsedBytes,
+		"limit_bytes": usage.LimitBytes,
+		"percent":     usage.Percent,
+	})
+	msg.Channel = websocket.GetUserChannel(workspace.OwnerID)
+	b.hub.BroadcastToUsers(msg, workspace.OwnerID)
+}
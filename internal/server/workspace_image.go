@@ -0,0 +1,57 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewWorkspaceImageServiceFromConfig 설정으로 워크스페이스 이미지 서비스를 구성합니다
+// Docker를 사용할 수 없으면 이미지 지정만 가능하고 Dockerfile 빌드는 거부됩니다.
+func NewWorkspaceImageServiceFromConfig(cfg config.ImagePolicyConfig, dockerManager *docker.Manager, hub *websocket.Hub) *services.WorkspaceImageService {
+	policy := docker.ImagePolicy{
+		AllowedImages:   cfg.AllowedImages,
+		AllowDockerfile: cfg.AllowDockerfile,
+		AllowPrivileged: cfg.AllowPrivileged,
+	}
+
+	var builder services.WorkspaceImageBuilder
+	if dockerManager != nil {
+		builder = docker.NewImageBuilder(dockerManager.Client())
+	}
+
+	imageService := services.NewWorkspaceImageService(cfg.Dir, policy, builder, cfg.BuildTimeout)
+	if hub != nil {
+		imageService.SetListener(&imageBuildBroadcaster{hub: hub})
+	}
+	return imageService
+}
+
+// imageBuildBroadcaster 이미지 빌드 로그와 상태를 워크스페이스 소유자에게 WebSocket으로 전달합니다
+type imageBuildBroadcaster struct {
+	hub *websocket.Hub
+}
+
+func (b *imageBuildBroadcaster) OnImageBuildLog(ownerID string, build services.ImageBuild, line string) {
+	msg := websocket.NewLogMessage("info", line, "image_build", "", build.ID)
+	msg.Channel = websocket.GetWorkspaceChannel(build.WorkspaceID)
+	b.hub.BroadcastToUsers(msg, ownerID)
+}
+
+func (b *imageBuildBroadcaster) OnImageBuildStatus(ownerID string, build services.ImageBuild) {
+	data := map[string]interface{}{
+		"workspace_id": build.WorkspaceID,
+		"cached":       build.Cached,
+	}
+	if build.Tag != "" {
+		data["tag"] = build.Tag
+	}
+	if build.Error != "" {
+		data["error"] = build.Error
+	}
+
+	msg := websocket.NewStatusMessage("image_build", build.ID, string(build.Status), data)
+	msg.Channel = websocket.GetWorkspaceChannel(build.WorkspaceID)
+	b.hub.BroadcastToUsers(msg, ownerID)
+}
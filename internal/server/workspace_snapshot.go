@@ -0,0 +1,20 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// NewWorkspaceSnapshotServiceFromConfig 설정으로 워크스페이스 스냅샷 서비스를 구성합니다 (비활성이면 nil)
+func NewWorkspaceSnapshotServiceFromConfig(cfg config.WorkspaceSnapshotConfig, store storage.Storage) *services.WorkspaceSnapshotService {
+	if !cfg.Enabled || cfg.Dir == "" {
+		return nil
+	}
+	return services.NewWorkspaceSnapshotService(store, services.WorkspaceSnapshotConfig{
+		Dir:             cfg.Dir,
+		MaxTotalBytes:   cfg.MaxTotalSizeMB * 1024 * 1024,
+		MaxPerWorkspace: cfg.MaxPerWorkspace,
+		MaxAge:          cfg.MaxAge,
+	})
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/services"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// NewWorkspaceWatchServiceFromConfig 설정으로 워크스페이스 파일 감시 서비스를 구성하고 태스크 종료 알림을 연결합니다 (비활성이면 nil)
+// 파이프라인 실행이 끝났는지 확인하려면 파이프라인 서비스가 먼저 태스크 종료를 처리해야 하므로 파이프라인 서비스를 만든 뒤에 호출합니다.
+func NewWorkspaceWatchServiceFromConfig(cfg config.WorkspaceConfig, store storage.Storage, taskService *services.TaskService, pipelines *services.PipelineService) *services.WorkspaceWatchService {
+	if !cfg.WatchFiles || cfg.Watch.Dir == "" {
+		return nil
+	}
+
+	watchService := services.NewWorkspaceWatchService(store, taskService, pipelines, services.WorkspaceWatchServiceConfig{
+		Dir:             cfg.Watch.Dir,
+		ExcludePatterns: cfg.ExcludePatterns,
+		MaxDirectories:  cfg.Watch.MaxDirectories,
+	})
+	taskService.AddFinishListener(watchService)
+	return watchService
+}
@@ -0,0 +1,19 @@
+package server
+
+import (
+	"github.com/aicli/aicli-web/internal/config"
+	"github.com/aicli/aicli-web/internal/websocket"
+)
+
+// NewEventLogFromConfig 설정으로 WebSocket 채널 이벤트 로그를 구성합니다 (비활성화면 nil)
+func NewEventLogFromConfig(cfg config.WebSocketEventLogConfig) (*websocket.EventLog, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	eventConfig := websocket.DefaultEventLogConfig()
+	eventConfig.Dir = cfg.Dir
+	eventConfig.RingSize = cfg.RingSize
+	eventConfig.MaxEvents = cfg.MaxEvents
+	eventConfig.Retention = cfg.Retention
+	return websocket.NewEventLog(eventConfig)
+}
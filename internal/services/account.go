@@ -0,0 +1,493 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/email"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/security"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// resetTokenPrefix 비밀번호 재설정 토큰 접두어
+const resetTokenPrefix = "rst_"
+
+// loginLockStripes 사용자명별 로그인 직렬화에 쓰는 잠금 수
+const loginLockStripes = 64
+
+// 로컬 계정 에러
+var (
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrAccountLocked      = errors.New("account locked")
+	ErrPasswordMismatch   = errors.New("password confirmation does not match")
+	ErrPasswordReused     = errors.New("new password must differ from current password")
+	ErrInvalidResetToken  = errors.New("invalid or expired reset token")
+)
+
+// AccountLockedError 로그인 실패가 쌓여 잠긴 계정
+type AccountLockedError struct {
+	Until time.Time
+}
+
+func (e *AccountLockedError) Error() string {
+	return fmt.Sprintf("로그인 실패가 반복되어 %s까지 계정이 잠겼습니다", e.Until.Format(time.RFC3339))
+}
+
+// Is errors.Is(err, ErrAccountLocked)로 확인할 수 있도록 합니다
+func (e *AccountLockedError) Is(target error) bool {
+	return target == ErrAccountLocked
+}
+
+// SecurityNoticeRecorder 계정 보안 알림을 메일 외의 곳(활동 피드, 알림 센터)에도 남기는 훅 (*ActivityService, *NotificationCenter)
+type SecurityNoticeRecorder interface {
+	OnSecurityNotice(userID, title, message, ipAddress string)
+}
+
+// LoginFailureRecorder 로그인 실패를 공격 탐지기에 전달 (*security.AttackDetector)
+type LoginFailureRecorder interface {
+	RecordLoginFailure(ctx context.Context, failure *security.LoginFailure) bool
+}
+
+// TokenRevoker 사용자에게 이미 발급한 토큰을 차단 (*auth.Blacklist)
+type TokenRevoker interface {
+	RevokeUser(userID string, before, expiresAt time.Time)
+}
+
+// AccountConfig 로컬 계정 정책
+type AccountConfig struct {
+	Policy           validation.PasswordPolicy
+	MaxFailures      int           // 잠그기 전까지 허용하는 연속 실패 횟수 (0이면 잠그지 않음)
+	LockoutDuration  time.Duration // 잠금 유지 시간
+	ResetTokenExpiry time.Duration // 비밀번호 재설정 토큰 유효 시간
+}
+
+// LoginAttempt 로그인 시도 정보
+type LoginAttempt struct {
+	Username  string
+	Password  string
+	IPAddress string
+	UserAgent string
+	Path      string
+}
+
+// AccountService 로컬 계정 로그인, 프로필, 비밀번호 변경과 재설정을 담당하는 서비스
+// 비밀번호는 argon2id로 해시하고, 연속 로그인 실패가 MaxFailures에 이르면
+// LockoutDuration 동안 계정을 잠그며 실패는 공격 탐지기에도 전달합니다.
+type AccountService struct {
+	storage  storage.AccountStorage
+	hasher   *auth.PasswordHasher
+	config   AccountConfig
+	notifier NotificationService
+	detector LoginFailureRecorder
+	notices  []SecurityNoticeRecorder
+
+	// revoker 비밀번호를 바꾸면 이전에 발급한 토큰을 차단 (tokenLifetime은 리프레시 토큰 유효 기간)
+	revoker       TokenRevoker
+	tokenLifetime time.Duration
+
+	// dummyHash 없는 계정으로 로그인할 때도 해시 검증 시간을 들이기 위한 해시
+	dummyHash string
+
+	// loginLocks 같은 사용자명의 로그인 시도를 직렬화해 동시 요청으로 실패 횟수가 덜 세어지지 않도록 함
+	loginLocks [loginLockStripes]sync.Mutex
+}
+
+// NewAccountService 새 계정 서비스 생성
+func NewAccountService(storage storage.AccountStorage, hasher *auth.PasswordHasher, config AccountConfig) *AccountService {
+	dummyHash, _ := hasher.Hash(resetTokenPrefix)
+	return &AccountService{
+		storage:   storage,
+		hasher:    hasher,
+		config:    config,
+		dummyHash: dummyHash,
+	}
+}
+
+// SetNotifier 비밀번호 재설정 메일과 보안 알림(계정 잠금, 비밀번호 변경) 발송에 사용할 알림 서비스 설정
+func (s *AccountService) SetNotifier(notifier NotificationService) {
+	s.notifier = notifier
+}
+
+// SetLoginFailureRecorder 로그인 실패를 전달할 공격 탐지기 설정
+func (s *AccountService) SetLoginFailureRecorder(detector LoginFailureRecorder) {
+	s.detector = detector
+}
+
+// SetTokenRevoker 비밀번호 변경과 재설정 시 이전에 발급한 토큰을 차단하도록 설정
+// tokenLifetime은 발급한 토큰의 최대 유효 기간(리프레시 토큰 만료)으로, 그동안 차단을 유지합니다.
+func (s *AccountService) SetTokenRevoker(revoker TokenRevoker, tokenLifetime time.Duration) {
+	s.revoker = revoker
+	s.tokenLifetime = tokenLifetime
+}
+
+// AddSecurityNoticeRecorder 보안 알림을 함께 전달할 훅 추가 (서비스 시작 전에 등록)
+func (s *AccountService) AddSecurityNoticeRecorder(recorder SecurityNoticeRecorder) {
+	s.notices = append(s.notices, recorder)
+}
+
+// PasswordPolicy 비밀번호 복잡도 규칙
+func (s *AccountService) PasswordPolicy() validation.PasswordPolicy {
+	return s.config.Policy
+}
+
+// Create 새 로컬 계정 생성 (비밀번호 정책 검증)
+func (s *AccountService) Create(ctx context.Context, username, email, password, role string) (*models.Account, error) {
+	if err := s.config.Policy.ValidatePassword(password, username); err != nil {
+		return nil, err
+	}
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	account := &models.Account{
+		Username:          username,
+		Email:             email,
+		Role:              role,
+		PasswordHash:      hash,
+		PasswordChangedAt: now,
+		IsActive:          true,
+		CreatedAt:         now,
+	}
+	if err := s.storage.Create(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// EnsureBootstrapAdmin 계정이 하나도 없으면 최초 관리자 계정 생성 (생성했으면 true)
+func (s *AccountService) EnsureBootstrapAdmin(ctx context.Context, username, password string) (bool, error) {
+	if username == "" || password == "" {
+		return false, nil
+	}
+	count, err := s.storage.Count(ctx)
+	if err != nil || count > 0 {
+		return false, err
+	}
+	if _, err := s.Create(ctx, username, "", password, "admin"); err != nil {
+		return false, fmt.Errorf("최초 관리자 계정 생성 실패: %w", err)
+	}
+	return true, nil
+}
+
+// Authenticate 사용자명과 비밀번호로 로그인
+// 잠긴 계정이면 *AccountLockedError, 자격 증명이 틀리면 ErrInvalidCredentials를 반환합니다.
+func (s *AccountService) Authenticate(ctx context.Context, attempt *LoginAttempt) (*models.Account, error) {
+	// 조회 → 검증 → 실패 횟수 저장을 사용자명별로 직렬화하므로 잠금 전까지 최대 MaxFailures번만 검증합니다
+	lock := s.loginLock(attempt.Username)
+	lock.Lock()
+	defer lock.Unlock()
+
+	now := time.Now()
+
+	account, err := s.storage.GetByUsername(ctx, attempt.Username)
+	if err != nil {
+		if !storage.IsNotFoundError(err) {
+			return nil, err
+		}
+		// 계정 존재 여부가 응답 시간으로 드러나지 않도록 같은 비용의 검증 수행
+		s.hasher.Verify(attempt.Password, s.dummyHash)
+		s.recordFailure(ctx, attempt, nil)
+		return nil, ErrInvalidCredentials
+	}
+
+	if account.IsLocked(now) {
+		return nil, &AccountLockedError{Until: *account.LockedUntil}
+	}
+
+	match, needsRehash, err := s.hasher.Verify(attempt.Password, account.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+	if !match || !account.IsActive {
+		return nil, s.registerFailure(ctx, account, attempt, now)
+	}
+
+	account.FailedLogins = 0
+	account.LockedUntil = nil
+	account.LastLoginAt = &now
+	if needsRehash {
+		if hash, err := s.hasher.Hash(attempt.Password); err == nil {
+			account.PasswordHash = hash
+		}
+	}
+	if err := s.storage.Update(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// loginLock 사용자명(대소문자 무시)에 해당하는 로그인 잠금
+func (s *AccountService) loginLock(username string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(username)))
+	return &s.loginLocks[h.Sum32()%loginLockStripes]
+}
+
+// registerFailure 로그인 실패 횟수를 늘리고 한도에 이르면 계정을 잠급니다
+func (s *AccountService) registerFailure(ctx context.Context, account *models.Account, attempt *LoginAttempt, now time.Time) error {
+	// 잠금이 풀린 뒤에는 실패 횟수를 새로 셈
+	if account.LockedUntil != nil {
+		account.LockedUntil = nil
+		account.FailedLogins = 0
+	}
+	account.FailedLogins++
+
+	var lockErr error
+	if s.config.MaxFailures > 0 && account.FailedLogins >= s.config.MaxFailures {
+		until := now.Add(s.config.LockoutDuration)
+		account.LockedUntil = &until
+		lockErr = &AccountLockedError{Until: until}
+		log.Printf("로그인 실패 %d회로 계정 잠금: %s (IP %s, %s까지)", account.FailedLogins, account.Username, attempt.IPAddress, until.Format(time.RFC3339))
+		s.notifySecurity(ctx, account, "로그인 실패로 계정이 잠겼습니다",
+			fmt.Sprintf("로그인에 %d번 연속 실패해 %s까지 계정을 잠갔습니다.", account.FailedLogins, until.Format("2006-01-02 15:04 MST")),
+			attempt.IPAddress)
+	}
+
+	if err := s.storage.Update(ctx, account); err != nil {
+		log.Printf("로그인 실패 횟수 저장 실패: %s: %v", account.Username, err)
+	}
+	s.recordFailure(ctx, attempt, account)
+
+	if lockErr != nil {
+		return lockErr
+	}
+	return ErrInvalidCredentials
+}
+
+// recordFailure 로그인 실패를 공격 탐지기에 전달
+func (s *AccountService) recordFailure(ctx context.Context, attempt *LoginAttempt, account *models.Account) {
+	if s.detector == nil {
+		return
+	}
+	failure := &security.LoginFailure{
+		Username:    attempt.Username,
+		IPAddress:   attempt.IPAddress,
+		UserAgent:   attempt.UserAgent,
+		RequestPath: attempt.Path,
+	}
+	if account != nil {
+		failure.UserID = account.ID
+		failure.Failures = account.FailedLogins
+		if account.LockedUntil != nil {
+			failure.Locked = true
+			failure.LockedUntil = *account.LockedUntil
+		}
+	}
+	s.detector.RecordLoginFailure(ctx, failure)
+}
+
+// Unlock 잠긴 계정 잠금 해제 (관리자용)
+func (s *AccountService) Unlock(ctx context.Context, id string) (*models.Account, error) {
+	account, err := s.storage.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	account.FailedLogins = 0
+	account.LockedUntil = nil
+	if err := s.storage.Update(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// Get 계정 조회
+func (s *AccountService) Get(ctx context.Context, id string) (*models.Account, error) {
+	return s.storage.GetByID(ctx, id)
+}
+
+// UpdateProfile 본인 프로필 수정
+func (s *AccountService) UpdateProfile(ctx context.Context, id string, req *models.AccountProfileUpdateRequest) (*models.Account, error) {
+	account, err := s.storage.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.DisplayName != nil {
+		account.DisplayName = strings.TrimSpace(*req.DisplayName)
+	}
+	if req.Email != nil {
+		account.Email = strings.TrimSpace(*req.Email)
+	}
+	if err := s.storage.Update(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// ChangePassword 현재 비밀번호를 확인한 뒤 새 비밀번호로 변경
+func (s *AccountService) ChangePassword(ctx context.Context, id string, req *models.ChangePasswordRequest) error {
+	if req.NewPassword != req.ConfirmPassword {
+		return ErrPasswordMismatch
+	}
+
+	account, err := s.storage.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	match, _, err := s.hasher.Verify(req.CurrentPassword, account.PasswordHash)
+	if err != nil {
+		return err
+	}
+	if !match {
+		return ErrInvalidCredentials
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return ErrPasswordReused
+	}
+
+	if err := s.setPassword(ctx, account, req.NewPassword); err != nil {
+		return err
+	}
+	s.revokeTokens(account)
+	s.notifySecurity(ctx, account, "비밀번호가 변경되었습니다", "계정 비밀번호가 변경되었습니다.", "")
+	return nil
+}
+
+// RequestPasswordReset 이메일로 비밀번호 재설정 토큰 발송
+// 계정 존재 여부가 드러나지 않도록 등록되지 않은 이메일이나 발송 실패도 에러로 반환하지 않습니다.
+func (s *AccountService) RequestPasswordReset(ctx context.Context, address string) error {
+	account, err := s.storage.GetByEmail(ctx, address)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if !account.IsActive {
+		return nil
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Errorf("재설정 토큰 생성 실패: %w", err)
+	}
+	token := resetTokenPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	err = s.storage.CreateResetToken(ctx, &models.AccountResetToken{
+		TokenHash: hashResetToken(token),
+		AccountID: account.ID,
+		ExpiresAt: time.Now().Add(s.config.ResetTokenExpiry),
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.notifier == nil {
+		log.Printf("메일 발송이 설정되지 않아 비밀번호 재설정 메일을 보내지 않았습니다: %s", account.Username)
+		return nil
+	}
+	if err := s.notifier.SendPasswordResetEmail(ctx, account.Email, token); err != nil {
+		log.Printf("비밀번호 재설정 메일 발송 실패: %s: %v", account.Username, err)
+	}
+	return nil
+}
+
+// ResetPassword 재설정 토큰으로 비밀번호를 변경하고 계정 잠금을 해제합니다
+func (s *AccountService) ResetPassword(ctx context.Context, req *models.ConfirmResetPasswordRequest) error {
+	if req.NewPassword != req.ConfirmPassword {
+		return ErrPasswordMismatch
+	}
+
+	tokenHash := hashResetToken(req.Token)
+	token, err := s.storage.GetResetToken(ctx, tokenHash)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+	now := time.Now()
+	if token.UsedAt != nil || !now.Before(token.ExpiresAt) {
+		return ErrInvalidResetToken
+	}
+
+	account, err := s.storage.GetByID(ctx, token.AccountID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+	if err := s.config.Policy.ValidatePassword(req.NewPassword, account.Username); err != nil {
+		return err
+	}
+
+	// 같은 토큰이 동시에 두 번 쓰이지 않도록 먼저 사용 처리
+	if err := s.storage.UseResetToken(ctx, tokenHash, now); err != nil {
+		if storage.IsNotFoundError(err) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+
+	account.FailedLogins = 0
+	account.LockedUntil = nil
+	if err := s.setPassword(ctx, account, req.NewPassword); err != nil {
+		return err
+	}
+	s.revokeTokens(account)
+	s.notifySecurity(ctx, account, "비밀번호가 재설정되었습니다", "재설정 링크로 계정 비밀번호를 변경했습니다.", "")
+	return nil
+}
+
+// notifySecurity 계정 소유자(와 보안 담당 관리자)에게 보안 알림 메일 발송 (훅이 있으면 활동 피드에도 기록)
+// 알림 발송 실패는 로그인이나 비밀번호 변경을 막지 않도록 로그로만 남깁니다.
+func (s *AccountService) notifySecurity(ctx context.Context, account *models.Account, title, message, ipAddress string) {
+	for _, recorder := range s.notices {
+		recorder.OnSecurityNotice(account.ID, title, message, ipAddress)
+	}
+	if s.notifier == nil {
+		return
+	}
+	err := s.notifier.SendSecurityAlertEmail(ctx, account.Email, &email.SecurityAlertData{
+		Title:     title,
+		Message:   message,
+		Username:  account.Username,
+		IPAddress: ipAddress,
+	})
+	if err != nil {
+		log.Printf("보안 알림 메일 발송 실패: %s: %v", account.Username, err)
+	}
+}
+
+// setPassword 비밀번호 정책을 검증하고 새 해시를 저장
+func (s *AccountService) setPassword(ctx context.Context, account *models.Account, password string) error {
+	if err := s.config.Policy.ValidatePassword(password, account.Username); err != nil {
+		return err
+	}
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	account.PasswordHash = hash
+	account.PasswordChangedAt = time.Now()
+	return s.storage.Update(ctx, account)
+}
+
+// revokeTokens 비밀번호를 바꾸기 전에 발급한 토큰 차단 (탈취한 토큰으로 계속 접근하지 못하도록)
+func (s *AccountService) revokeTokens(account *models.Account) {
+	if s.revoker == nil {
+		return
+	}
+	s.revoker.RevokeUser(account.ID, account.PasswordChangedAt, account.PasswordChangedAt.Add(s.tokenLifetime))
+}
+
+// hashResetToken 저장용 재설정 토큰 해시
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/security"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// recordingDetector 로그인 실패 전달 기록
+type recordingDetector struct {
+	failures []*security.LoginFailure
+}
+
+func (d *recordingDetector) RecordLoginFailure(ctx context.Context, failure *security.LoginFailure) bool {
+	d.failures = append(d.failures, failure)
+	return failure.Locked
+}
+
+func setupAccountTest(t *testing.T) (*AccountService, *models.Account, *recordingDetector, *MockNotificationService) {
+	accounts := NewAccountService(memory.New().Account(), auth.NewPasswordHasher(auth.Argon2Params{Memory: 1024, Iterations: 1}), AccountConfig{
+		Policy:           validation.DefaultPasswordPolicy(),
+		MaxFailures:      3,
+		LockoutDuration:  time.Minute,
+		ResetTokenExpiry: time.Hour,
+	})
+	detector := &recordingDetector{}
+	notifier := NewMockNotificationService()
+	accounts.SetLoginFailureRecorder(detector)
+	accounts.SetNotifier(notifier)
+
+	account, err := accounts.Create(context.Background(), "alice", "alice@example.com", "Initial-Pass-1", "user")
+	require.NoError(t, err)
+	return accounts, account, detector, notifier
+}
+
+func TestAccountService_CreateEnforcesPolicy(t *testing.T) {
+	accounts, _, _, _ := setupAccountTest(t)
+
+	_, err := accounts.Create(context.Background(), "bob", "", "short", "user")
+	var policyErr validation.ValidationErrors
+	require.ErrorAs(t, err, &policyErr)
+	assert.True(t, policyErr.HasField("password"))
+
+	_, err = accounts.Create(context.Background(), "ALICE", "", "Another-Pass-2", "user")
+	assert.Error(t, err, "사용자명은 대소문자 구분 없이 중복 불가")
+}
+
+func TestAccountService_LockoutAfterFailures(t *testing.T) {
+	accounts, account, detector, notifier := setupAccountTest(t)
+	ctx := context.Background()
+	attempt := func(password string) error {
+		_, err := accounts.Authenticate(ctx, &LoginAttempt{Username: "alice", Password: password, IPAddress: "10.0.0.1"})
+		return err
+	}
+
+	assert.ErrorIs(t, attempt("wrong-1"), ErrInvalidCredentials)
+	assert.ErrorIs(t, attempt("wrong-2"), ErrInvalidCredentials)
+
+	err := attempt("wrong-3")
+	var lockedErr *AccountLockedError
+	require.ErrorAs(t, err, &lockedErr)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), lockedErr.Until, 5*time.Second)
+
+	// 잠긴 동안에는 올바른 비밀번호도 거부
+	assert.ErrorIs(t, attempt("Initial-Pass-1"), ErrAccountLocked)
+
+	require.Len(t, detector.failures, 3)
+	assert.Equal(t, account.ID, detector.failures[2].UserID)
+	assert.Equal(t, 3, detector.failures[2].Failures)
+	assert.True(t, detector.failures[2].Locked)
+	assert.Equal(t, "10.0.0.1", detector.failures[2].IPAddress)
+
+	// 잠기면 계정 소유자에게 보안 알림
+	require.Len(t, notifier.SentEmails, 1)
+	assert.Equal(t, "alice@example.com", notifier.SentEmails[0].To)
+	assert.Equal(t, "[보안 알림] 로그인 실패로 계정이 잠겼습니다", notifier.SentEmails[0].Subject)
+
+	// 관리자가 잠금을 해제하면 로그인 가능
+	_, err = accounts.Unlock(ctx, account.ID)
+	require.NoError(t, err)
+	logged, err := accounts.Authenticate(ctx, &LoginAttempt{Username: "Alice", Password: "Initial-Pass-1"})
+	require.NoError(t, err)
+	assert.Zero(t, logged.FailedLogins)
+	assert.NotNil(t, logged.LastLoginAt)
+}
+
+// slowAccountStorage 계정 조회를 늦춰 동시 로그인 시도가 겹치도록 하는 스토리지
+type slowAccountStorage struct {
+	storage.AccountStorage
+}
+
+func (s *slowAccountStorage) GetByUsername(ctx context.Context, username string) (*models.Account, error) {
+	time.Sleep(5 * time.Millisecond)
+	return s.AccountStorage.GetByUsername(ctx, username)
+}
+
+func TestAccountService_ConcurrentFailuresLockout(t *testing.T) {
+	accounts, account, _, _ := setupAccountTest(t)
+	accounts.storage = &slowAccountStorage{AccountStorage: accounts.storage}
+	ctx := context.Background()
+
+	// 동시에 들어온 잘못된 비밀번호도 하나씩 세어 MaxFailures번만 검증
+	const attempts = 12
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		invalid int
+		locked  int
+	)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := accounts.Authenticate(ctx, &LoginAttempt{Username: "ALICE", Password: fmt.Sprintf("wrong-%d", i)})
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, ErrAccountLocked):
+				locked++
+			case errors.Is(err, ErrInvalidCredentials):
+				invalid++
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, invalid)
+	assert.Equal(t, attempts-2, locked)
+
+	stored, err := accounts.storage.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stored.FailedLogins)
+	assert.True(t, stored.IsLocked(time.Now()))
+}
+
+func TestAccountService_UnknownUserReportedToDetector(t *testing.T) {
+	accounts, _, detector, _ := setupAccountTest(t)
+
+	_, err := accounts.Authenticate(context.Background(), &LoginAttempt{Username: "mallory", Password: "guess", IPAddress: "10.0.0.2"})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	require.Len(t, detector.failures, 1)
+	assert.Equal(t, "mallory", detector.failures[0].Username)
+	assert.Empty(t, detector.failures[0].UserID)
+}
+
+func TestAccountService_ChangePassword(t *testing.T) {
+	accounts, account, _, notifier := setupAccountTest(t)
+	ctx := context.Background()
+
+	err := accounts.ChangePassword(ctx, account.ID, &models.ChangePasswordRequest{
+		CurrentPassword: "wrong", NewPassword: "Changed-Pass-2", ConfirmPassword: "Changed-Pass-2",
+	})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	err = accounts.ChangePassword(ctx, account.ID, &models.ChangePasswordRequest{
+		CurrentPassword: "Initial-Pass-1", NewPassword: "Changed-Pass-2", ConfirmPassword: "Changed-Pass-3",
+	})
+	assert.ErrorIs(t, err, ErrPasswordMismatch)
+
+	err = accounts.ChangePassword(ctx, account.ID, &models.ChangePasswordRequest{
+		CurrentPassword: "Initial-Pass-1", NewPassword: "weak", ConfirmPassword: "weak",
+	})
+	var policyErr validation.ValidationErrors
+	assert.ErrorAs(t, err, &policyErr)
+
+	err = accounts.ChangePassword(ctx, account.ID, &models.ChangePasswordRequest{
+		CurrentPassword: "Initial-Pass-1", NewPassword: "Changed-Pass-2", ConfirmPassword: "Changed-Pass-2",
+	})
+	require.NoError(t, err)
+	require.Len(t, notifier.SentEmails, 1, "실패한 변경 시도는 알리지 않음")
+	assert.Equal(t, "[보안 알림] 비밀번호가 변경되었습니다", notifier.SentEmails[0].Subject)
+
+	_, err = accounts.Authenticate(ctx, &LoginAttempt{Username: "alice", Password: "Changed-Pass-2"})
+	assert.NoError(t, err)
+}
+
+func TestAccountService_PasswordReset(t *testing.T) {
+	accounts, account, _, notifier := setupAccountTest(t)
+	ctx := context.Background()
+
+	// 등록되지 않은 이메일도 에러 없이 처리하고 메일은 보내지 않음
+	require.NoError(t, accounts.RequestPasswordReset(ctx, "nobody@example.com"))
+	assert.Empty(t, notifier.SentEmails)
+
+	require.NoError(t, accounts.RequestPasswordReset(ctx, "ALICE@example.com"))
+	require.Len(t, notifier.SentEmails, 1)
+	assert.Equal(t, "alice@example.com", notifier.SentEmails[0].To)
+	token := strings.TrimPrefix(notifier.SentEmails[0].Body, "Reset token: ")
+	require.True(t, strings.HasPrefix(token, resetTokenPrefix))
+
+	// 잠긴 계정도 재설정하면 잠금 해제
+	for i := 0; i < 3; i++ {
+		accounts.Authenticate(ctx, &LoginAttempt{Username: "alice", Password: "wrong"})
+	}
+
+	err := accounts.ResetPassword(ctx, &models.ConfirmResetPasswordRequest{Token: "rst_invalid", NewPassword: "Reset-Pass-3", ConfirmPassword: "Reset-Pass-3"})
+	assert.ErrorIs(t, err, ErrInvalidResetToken)
+
+	require.NoError(t, accounts.ResetPassword(ctx, &models.ConfirmResetPasswordRequest{Token: token, NewPassword: "Reset-Pass-3", ConfirmPassword: "Reset-Pass-3"}))
+
+	stored, err := accounts.Get(ctx, account.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.IsLocked(time.Now()))
+	_, err = accounts.Authenticate(ctx, &LoginAttempt{Username: "alice", Password: "Reset-Pass-3"})
+	assert.NoError(t, err)
+
+	// 같은 토큰은 다시 쓸 수 없음
+	err = accounts.ResetPassword(ctx, &models.ConfirmResetPasswordRequest{Token: token, NewPassword: "Reset-Pass-4", ConfirmPassword: "Reset-Pass-4"})
+	assert.ErrorIs(t, err, ErrInvalidResetToken)
+}
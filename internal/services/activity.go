@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// activityRecordTimeout 리스너에서 활동 하나를 저장하는 데 쓰는 시간
+	activityRecordTimeout = 10 * time.Second
+	// activitySummaryMax 요약에 넣는 태스크 명령 최대 크기
+	activitySummaryMax = 200
+)
+
+// ActivityRBACEvents 활동 피드에 개인 알림으로 남기는 RBAC 이벤트
+var ActivityRBACEvents = []auth.RBACEventType{
+	auth.EventRoleAssigned,
+	auth.EventRoleRevoked,
+	auth.EventGroupMemberAdded,
+	auth.EventGroupMemberRemoved,
+}
+
+// ActivityService 워크스페이스/사용자 활동 피드
+// 태스크 종료, 검토 요청과 결정, ACL 변경, RBAC 이벤트, 계정 보안 알림을 리스너로 받아 활동으로 저장합니다.
+// 워크스페이스 피드는 read 권한이 있는 모든 사용자가 같은 항목을 보고, 개인 피드는 받는 사용자만 봅니다.
+// 읽음 상태는 사용자와 피드마다 마지막으로 읽음 표시한 시각으로 관리합니다.
+type ActivityService struct {
+	storage storage.Storage
+	now     func() time.Time
+}
+
+// 리스너 구현 확인
+var (
+	_ TaskFinishListener     = (*ActivityService)(nil)
+	_ TaskReviewListener     = (*ActivityService)(nil)
+	_ WorkspaceACLListener   = (*ActivityService)(nil)
+	_ SecurityNoticeRecorder = (*ActivityService)(nil)
+	_ auth.RBACEventHandler  = (*ActivityService)(nil)
+)
+
+// NewActivityService 새 활동 피드 서비스 생성
+func NewActivityService(storage storage.Storage) *ActivityService {
+	return &ActivityService{
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// WorkspaceFeed 워크스페이스 피드 조회 (read 권한은 호출하는 쪽에서 확인)
+func (s *ActivityService) WorkspaceFeed(ctx context.Context, workspaceID, userID string, req *models.ActivityListRequest) (*models.ActivityFeedResponse, error) {
+	return s.feed(ctx, userID, workspaceID, &models.ActivityFilter{WorkspaceID: workspaceID, Kind: req.Kind}, req)
+}
+
+// UserFeed 사용자의 개인 피드 조회 (모든 워크스페이스의 개인 알림과 보안 알림)
+func (s *ActivityService) UserFeed(ctx context.Context, userID string, req *models.ActivityListRequest) (*models.ActivityFeedResponse, error) {
+	return s.feed(ctx, userID, models.ActivityPersonalFeed, &models.ActivityFilter{RecipientID: userID, Kind: req.Kind}, req)
+}
+
+// feed 피드 한 페이지와 안 읽은 항목 수 조회
+func (s *ActivityService) feed(ctx context.Context, userID, feed string, filter *models.ActivityFilter, req *models.ActivityListRequest) (*models.ActivityFeedResponse, error) {
+	readAt, err := s.storage.Activity().GetReadMarker(ctx, userID, feed)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "읽음 상태 조회 실패", err)
+	}
+
+	unread, err := s.countAfter(ctx, filter, readAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UnreadOnly {
+		filter.After = readAt
+	}
+	paging := &models.PaginationRequest{Page: req.Page, Limit: req.Limit}
+	paging.Normalize()
+	activities, total, err := s.storage.Activity().List(ctx, filter, paging)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "활동 조회 실패", err)
+	}
+	for _, activity := range activities {
+		activity.Read = readAt != nil && !activity.CreatedAt.After(*readAt)
+	}
+
+	return &models.ActivityFeedResponse{
+		Data:   activities,
+		Meta:   models.NewPaginationMeta(paging.Page, paging.Limit, total),
+		Unread: unread,
+		ReadAt: readAt,
+	}, nil
+}
+
+// MarkRead 피드를 until까지(nil이면 지금까지) 읽음으로 표시
+// 읽음 표시 시각은 앞으로만 움직이며, 이미 더 나중까지 읽었으면 그대로 둡니다.
+func (s *ActivityService) MarkRead(ctx context.Context, userID, feed string, until *time.Time) (*models.ActivityReadState, error) {
+	now := s.now()
+	readAt := now
+	if until != nil {
+		if until.After(now) {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "읽음 표시 시각은 현재보다 늦을 수 없습니다", ErrInvalidRequest)
+		}
+		readAt = *until
+	}
+
+	current, err := s.storage.Activity().GetReadMarker(ctx, userID, feed)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "읽음 상태 조회 실패", err)
+	}
+	if current != nil && !readAt.After(*current) {
+		readAt = *current
+	} else if err := s.storage.Activity().SetReadMarker(ctx, userID, feed, readAt); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "읽음 상태 저장 실패", err)
+	}
+
+	filter := &models.ActivityFilter{WorkspaceID: feed}
+	if feed == models.ActivityPersonalFeed {
+		filter = &models.ActivityFilter{RecipientID: userID}
+	}
+	unread, err := s.countAfter(ctx, filter, &readAt)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ActivityReadState{ReadAt: readAt, Unread: unread}, nil
+}
+
+// countAfter 읽음 표시 시각 이후에 생긴 항목 수
+func (s *ActivityService) countAfter(ctx context.Context, filter *models.ActivityFilter, readAt *time.Time) (int, error) {
+	countFilter := *filter
+	countFilter.After = readAt
+	_, total, err := s.storage.Activity().List(ctx, &countFilter, &models.PaginationRequest{Page: 1, Limit: 1})
+	if err != nil {
+		return 0, NewWorkspaceError(ErrCodeInternal, "안 읽은 활동 수 조회 실패", err)
+	}
+	return total, nil
+}
+
+// Record 활동 저장
+func (s *ActivityService) Record(ctx context.Context, activity *models.Activity) error {
+	if activity.CreatedAt.IsZero() {
+		activity.CreatedAt = s.now()
+	}
+	return s.storage.Activity().Create(ctx, activity)
+}
+
+// recordAsync 리스너에서 받은 활동을 백그라운드에서 저장 (실패는 로그로만 남김)
+func (s *ActivityService) recordAsync(activities ...*models.Activity) {
+	now := s.now()
+	for _, activity := range activities {
+		if activity.CreatedAt.IsZero() {
+			activity.CreatedAt = now
+		}
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), activityRecordTimeout)
+		defer cancel()
+		for _, activity := range activities {
+			if err := s.Record(ctx, activity); err != nil {
+				log.Printf("활동 저장 실패: %s/%s: %v", activity.Kind, activity.Action, err)
+			}
+		}
+	}()
+}
+
+// OnTaskFinished 종료된 태스크를 워크스페이스 피드에 기록 (TaskFinishListener)
+func (s *ActivityService) OnTaskFinished(task *models.Task) {
+	activity := &models.Activity{
+		Kind:         models.ActivityKindTask,
+		Action:       string(task.Status),
+		ResourceType: "task",
+		ResourceID:   task.ID,
+		Summary:      activityCommandSummary(task.Command),
+		Metadata:     map[string]string{"session_id": task.SessionID},
+		CreatedAt:    s.now(),
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), activityRecordTimeout)
+		defer cancel()
+
+		// 태스크에는 워크스페이스가 없으므로 세션과 프로젝트로 찾음
+		session, err := s.storage.Session().GetByID(ctx, task.SessionID)
+		if err != nil {
+			log.Printf("태스크 활동 세션 조회 실패: %s: %v", task.ID, err)
+			return
+		}
+		project, err := s.storage.Project().GetByID(ctx, session.ProjectID)
+		if err != nil {
+			log.Printf("태스크 활동 프로젝트 조회 실패: %s: %v", task.ID, err)
+			return
+		}
+		activity.WorkspaceID = project.WorkspaceID
+		activity.Metadata["project_id"] = project.ID
+		if err := s.Record(ctx, activity); err != nil {
+			log.Printf("활동 저장 실패: %s/%s: %v", activity.Kind, activity.Action, err)
+		}
+	}()
+}
+
+// OnReviewRequested 검토 요청을 워크스페이스 피드와 검토자 개인 피드에 기록 (TaskReviewListener)
+func (s *ActivityService) OnReviewRequested(review *models.TaskReview, reviewers []string) {
+	s.recordAsync(reviewActivities(review, "review_requested", "태스크 결과 검토가 요청되었습니다", "", reviewers)...)
+}
+
+// OnReviewDecided 검토 결정을 워크스페이스 피드와 검토자 개인 피드에 기록 (TaskReviewListener)
+func (s *ActivityService) OnReviewDecided(review *models.TaskReview, reviewers []string) {
+	summary := "태스크 결과가 승인되었습니다"
+	if review.Status == models.TaskReviewRejected {
+		summary = "태스크 결과가 거부되었습니다"
+	}
+	// 결정한 검토자에게는 개인 알림을 남기지 않음
+	recipients := make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer != review.ReviewerID {
+			recipients = append(recipients, reviewer)
+		}
+	}
+	s.recordAsync(reviewActivities(review, string(review.Status), summary, review.ReviewerID, recipients)...)
+}
+
+// reviewActivities 검토 활동 (워크스페이스 피드 항목과 받는 사용자별 개인 피드 항목)
+func reviewActivities(review *models.TaskReview, action, summary, actorID string, recipients []string) []*models.Activity {
+	metadata := map[string]string{"task_id": review.TaskID, "project_id": review.ProjectID}
+	activities := make([]*models.Activity, 0, len(recipients)+1)
+	for _, recipientID := range append([]string{""}, recipients...) {
+		activities = append(activities, &models.Activity{
+			Kind:         models.ActivityKindApproval,
+			Action:       action,
+			WorkspaceID:  review.WorkspaceID,
+			RecipientID:  recipientID,
+			ActorID:      actorID,
+			ResourceType: "task_review",
+			ResourceID:   review.ID,
+			Summary:      summary,
+			Metadata:     metadata,
+		})
+	}
+	return activities
+}
+
+// OnACLChanged 워크스페이스 공유 변경을 워크스페이스 피드에, 사용자 대상이면 그 사용자의 개인 피드에도 기록 (WorkspaceACLListener)
+func (s *ActivityService) OnACLChanged(entry *models.WorkspaceACLEntry, revoked bool) {
+	action, summary := "granted", fmt.Sprintf("%s %s에 %s 권한이 부여되었습니다", entry.PrincipalType, entry.PrincipalID, entry.Permission)
+	if revoked {
+		action, summary = "revoked", fmt.Sprintf("%s %s의 권한이 삭제되었습니다", entry.PrincipalType, entry.PrincipalID)
+	}
+	metadata := map[string]string{"principal_type": string(entry.PrincipalType), "principal_id": entry.PrincipalID}
+	if entry.Permission != "" {
+		metadata["permission"] = string(entry.Permission)
+	}
+
+	activities := []*models.Activity{{
+		Kind:         models.ActivityKindMembership,
+		Action:       action,
+		WorkspaceID:  entry.WorkspaceID,
+		ActorID:      entry.GrantedBy,
+		ResourceType: "workspace",
+		ResourceID:   entry.WorkspaceID,
+		Summary:      summary,
+		Metadata:     metadata,
+	}}
+	if entry.PrincipalType == models.ACLPrincipalUser && entry.PrincipalID != entry.GrantedBy {
+		personal := *activities[0]
+		personal.RecipientID = entry.PrincipalID
+		activities = append(activities, &personal)
+	}
+	s.recordAsync(activities...)
+}
+
+// HandleEvent 역할 할당/해제, 그룹 구성원 변경을 대상 사용자의 개인 피드에 기록 (RBAC 이벤트 버스 핸들러)
+func (s *ActivityService) HandleEvent(ctx context.Context, event *auth.RBACEvent) error {
+	userID, _ := event.Metadata["user_id"].(string)
+	if userID == "" {
+		return nil
+	}
+
+	var action, summary string
+	switch event.Type {
+	case auth.EventRoleAssigned:
+		action, summary = "role_assigned", fmt.Sprintf("역할 %s가 할당되었습니다", event.TargetID)
+	case auth.EventRoleRevoked:
+		action, summary = "role_revoked", fmt.Sprintf("역할 %s가 해제되었습니다", event.TargetID)
+	case auth.EventGroupMemberAdded:
+		action, summary = "group_member_added", fmt.Sprintf("그룹 %s에 추가되었습니다", event.TargetID)
+	case auth.EventGroupMemberRemoved:
+		action, summary = "group_member_removed", fmt.Sprintf("그룹 %s에서 제외되었습니다", event.TargetID)
+	default:
+		return nil
+	}
+
+	return s.Record(ctx, &models.Activity{
+		Kind:         models.ActivityKindMembership,
+		Action:       action,
+		RecipientID:  userID,
+		ActorID:      event.UserID,
+		ResourceType: event.TargetType,
+		ResourceID:   event.TargetID,
+		Summary:      summary,
+		CreatedAt:    event.Timestamp,
+	})
+}
+
+// OnSecurityNotice 계정 보안 알림을 사용자의 개인 피드에 기록 (SecurityNoticeRecorder)
+func (s *ActivityService) OnSecurityNotice(userID, title, message, ipAddress string) {
+	activity := &models.Activity{
+		Kind:         models.ActivityKindSecurity,
+		Action:       "notice",
+		RecipientID:  userID,
+		ResourceType: "account",
+		ResourceID:   userID,
+		Summary:      title,
+		Metadata:     map[string]string{"message": message},
+	}
+	if ipAddress != "" {
+		activity.Metadata["ip_address"] = ipAddress
+	}
+	s.recordAsync(activity)
+}
+
+// activityCommandSummary 태스크 명령의 첫 줄 (길면 자름)
+func activityCommandSummary(command string) string {
+	line := strings.TrimSpace(command)
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = strings.TrimSpace(line[:i]) + " …"
+	}
+	if len(line) > activitySummaryMax {
+		line = truncateOutput(line, activitySummaryMax) + "…"
+	}
+	return line
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/auth"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// waitForActivities 백그라운드 저장이 끝날 때까지 피드 항목 수를 기다림
+func waitForActivities(t *testing.T, fetch func() (*models.ActivityFeedResponse, error), want int) *models.ActivityFeedResponse {
+	t.Helper()
+	var resp *models.ActivityFeedResponse
+	require.Eventually(t, func() bool {
+		var err error
+		resp, err = fetch()
+		return err == nil && resp.Meta.Total == want
+	}, 2*time.Second, 10*time.Millisecond)
+	return resp
+}
+
+func TestActivityService_WorkspaceAndPersonalFeeds(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewActivityService(store)
+	access := NewWorkspaceAccessService(store)
+	access.AddListener(svc)
+
+	workspace := &models.Workspace{Name: "payments", OwnerID: "alice", ProjectPath: "/srv/payments"}
+	require.NoError(t, store.Workspace().Create(ctx, workspace))
+	project := &models.Project{WorkspaceID: workspace.ID, Name: "billing", Path: "/srv/payments/billing"}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, session))
+
+	// 공유 변경: 워크스페이스 피드와 대상 사용자의 개인 피드
+	_, err := access.Grant(ctx, workspace.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+	waitForActivities(t, func() (*models.ActivityFeedResponse, error) {
+		return svc.WorkspaceFeed(ctx, workspace.ID, "alice", &models.ActivityListRequest{})
+	}, 1)
+
+	// 태스크 종료는 세션의 워크스페이스 피드에 기록
+	svc.OnTaskFinished(&models.Task{BaseModel: models.BaseModel{ID: "task-1"}, SessionID: session.ID, Command: "run tests\nand more", Status: models.TaskCompleted})
+	resp := waitForActivities(t, func() (*models.ActivityFeedResponse, error) {
+		return svc.WorkspaceFeed(ctx, workspace.ID, "alice", &models.ActivityListRequest{})
+	}, 2)
+	assert.Equal(t, models.ActivityKindTask, resp.Data[0].Kind)
+	assert.Equal(t, "completed", resp.Data[0].Action)
+	assert.Equal(t, "run tests …", resp.Data[0].Summary)
+	assert.Equal(t, project.ID, resp.Data[0].Metadata["project_id"])
+	assert.Equal(t, 2, resp.Unread)
+
+	personal, err := svc.UserFeed(ctx, "bob", &models.ActivityListRequest{})
+	require.NoError(t, err)
+	require.Len(t, personal.Data, 1)
+	assert.Equal(t, "granted", personal.Data[0].Action)
+	assert.Equal(t, workspace.ID, personal.Data[0].WorkspaceID)
+
+	// 다른 사용자의 개인 피드에는 없음
+	other, err := svc.UserFeed(ctx, "alice", &models.ActivityListRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, other.Data)
+
+	// 종류 조건
+	resp, err = svc.WorkspaceFeed(ctx, workspace.ID, "alice", &models.ActivityListRequest{Kind: models.ActivityKindMembership})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "alice", resp.Data[0].ActorID)
+}
+
+func TestActivityService_MarkRead(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewActivityService(store)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	for i, action := range []string{"completed", "failed", "cancelled"} {
+		require.NoError(t, svc.Record(ctx, &models.Activity{
+			Kind: models.ActivityKindTask, Action: action, WorkspaceID: "ws-1",
+			CreatedAt: now.Add(time.Duration(i-3) * time.Minute),
+		}))
+	}
+
+	readAt, earlier, later := now.Add(-150*time.Second), now.Add(-time.Hour), now.Add(time.Hour)
+	state, err := svc.MarkRead(ctx, "alice", "ws-1", &readAt)
+	require.NoError(t, err)
+	assert.Equal(t, 2, state.Unread)
+
+	resp, err := svc.WorkspaceFeed(ctx, "ws-1", "alice", &models.ActivityListRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 3)
+	assert.False(t, resp.Data[0].Read)
+	assert.False(t, resp.Data[1].Read)
+	assert.True(t, resp.Data[2].Read)
+	assert.Equal(t, 2, resp.Unread)
+
+	resp, err = svc.WorkspaceFeed(ctx, "ws-1", "alice", &models.ActivityListRequest{UnreadOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+
+	// 읽음 표시는 앞으로만 움직임
+	state, err = svc.MarkRead(ctx, "alice", "ws-1", &earlier)
+	require.NoError(t, err)
+	assert.Equal(t, readAt, state.ReadAt)
+
+	state, err = svc.MarkRead(ctx, "alice", "ws-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, state.Unread)
+
+	// 다른 사용자의 읽음 상태와는 별개
+	resp, err = svc.WorkspaceFeed(ctx, "ws-1", "bob", &models.ActivityListRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Unread)
+	assert.Nil(t, resp.ReadAt)
+
+	_, err = svc.MarkRead(ctx, "alice", "ws-1", &later)
+	assert.Error(t, err)
+}
+
+func TestActivityService_PersonalNotices(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewActivityService(store)
+
+	require.NoError(t, svc.HandleEvent(ctx, &auth.RBACEvent{
+		Type: auth.EventRoleAssigned, UserID: "admin", TargetID: "role-ops", TargetType: "role",
+		Metadata: map[string]interface{}{"user_id": "bob"}, Timestamp: time.Now(),
+	}))
+	// 대상 사용자가 없는 이벤트는 무시
+	require.NoError(t, svc.HandleEvent(ctx, &auth.RBACEvent{Type: auth.EventRoleAssigned, TargetID: "role-ops"}))
+
+	svc.OnSecurityNotice("bob", "비밀번호가 변경되었습니다", "계정 비밀번호가 변경되었습니다.", "10.0.0.1")
+	svc.OnReviewRequested(&models.TaskReview{ID: "review-1", TaskID: "task-1", WorkspaceID: "ws-1"}, []string{"bob", "carol"})
+
+	resp := waitForActivities(t, func() (*models.ActivityFeedResponse, error) {
+		return svc.UserFeed(ctx, "bob", &models.ActivityListRequest{})
+	}, 3)
+	kinds := []models.ActivityKind{}
+	for _, activity := range resp.Data {
+		kinds = append(kinds, activity.Kind)
+	}
+	assert.ElementsMatch(t, []models.ActivityKind{models.ActivityKindMembership, models.ActivityKindSecurity, models.ActivityKindApproval}, kinds)
+
+	security, err := svc.UserFeed(ctx, "bob", &models.ActivityListRequest{Kind: models.ActivityKindSecurity})
+	require.NoError(t, err)
+	require.Len(t, security.Data, 1)
+	assert.Equal(t, "10.0.0.1", security.Data[0].Metadata["ip_address"])
+
+	// 검토 요청은 워크스페이스 피드에도 한 번 기록
+	waitForActivities(t, func() (*models.ActivityFeedResponse, error) {
+		return svc.WorkspaceFeed(ctx, "ws-1", "dave", &models.ActivityListRequest{})
+	}, 1)
+}
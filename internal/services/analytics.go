@@ -0,0 +1,450 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// analyticsSettleDelay 집계에서 제외하는 최근 구간
+	// 태스크의 종료 시각은 저장하기 전에 정해지므로 방금 끝난 태스크는 다음 집계에서 반영합니다.
+	analyticsSettleDelay = time.Minute
+	// analyticsDefaultDays 기간을 지정하지 않았을 때 조회하는 날 수 (오늘 포함)
+	analyticsDefaultDays = 30
+	// analyticsMaxDays 한 번에 조회할 수 있는 최대 날 수
+	analyticsMaxDays = 366
+	// analyticsDefaultTopLimit 비용 상위 워크스페이스 기본 개수
+	analyticsDefaultTopLimit = 10
+)
+
+// AnalyticsService 사용량 분석 대시보드 서비스
+// 백그라운드 작업(Rollup)이 지난 집계 이후에 끝난 태스크만 읽어 일별 롤업에 더하고,
+// 대시보드 조회는 원본 태스크 대신 롤업만 읽습니다. 태스크에는 실행한 사용자가 없으므로
+// 활성 사용자는 태스크를 실행한 워크스페이스의 소유자로 셉니다.
+type AnalyticsService struct {
+	storage storage.Storage
+	now     func() time.Time
+
+	// 같은 인스턴스에서 예약 실행과 수동 실행이 겹치지 않게 함
+	mu sync.Mutex
+}
+
+// NewAnalyticsService 새 사용량 분석 서비스 생성
+func NewAnalyticsService(storage storage.Storage) *AnalyticsService {
+	return &AnalyticsService{
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// Rollup 마지막 집계 이후에 끝난 태스크를 일별 롤업에 반영하고 반영한 태스크 수를 반환
+// 동시 실행 최댓값은 집계 구간 동안 실행 중이던 태스크까지 포함해 계산합니다.
+func (s *AnalyticsService) Rollup(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	until := s.now().UTC().Add(-analyticsSettleDelay)
+	var from time.Time
+	watermark, err := s.storage.Analytics().Watermark(ctx)
+	if err != nil {
+		return 0, NewWorkspaceError(ErrCodeInternal, "사용량 집계 위치 조회 실패", err)
+	}
+	if watermark != nil {
+		if !until.After(*watermark) {
+			return 0, nil
+		}
+		from = *watermark
+	}
+
+	tasks, err := s.storage.Task().ListExecutedBetween(ctx, from, until)
+	if err != nil {
+		return 0, NewWorkspaceError(ErrCodeInternal, "집계할 태스크 조회 실패", err)
+	}
+
+	batch := newAnalyticsBatch()
+	workspaces := make(map[string]*models.Workspace)
+	finished := 0
+	for _, task := range tasks {
+		if task.CompletedAt == nil || !task.CompletedAt.After(from) || task.CompletedAt.After(until) {
+			continue
+		}
+		finished++
+		batch.addTask(task, s.taskWorkspace(ctx, task, workspaces))
+	}
+	for day, peak := range analyticsConcurrencyPeaks(tasks, from, until) {
+		batch.day(day).PeakConcurrency = peak
+	}
+
+	if err := s.storage.Analytics().Apply(ctx, batch.rollup(until)); err != nil {
+		return 0, NewWorkspaceError(ErrCodeInternal, "사용량 집계 저장 실패", err)
+	}
+	return finished, nil
+}
+
+// ApplyLateTask 이미 집계가 지난 시각에 끝난 태스크를 일별 롤업에 바로 더함
+// 오프라인으로 실행하고 나중에 동기화한 태스크처럼 종료 시각이 워터마크보다 이른 태스크는
+// Rollup이 다시 읽지 않으므로 여기서 반영합니다. 워터마크 이후에 끝난 태스크는 다음 Rollup에 맡깁니다.
+func (s *AnalyticsService) ApplyLateTask(ctx context.Context, task *models.Task) error {
+	if task.CompletedAt == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watermark, err := s.storage.Analytics().Watermark(ctx)
+	if err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "사용량 집계 위치 조회 실패", err)
+	}
+	if watermark == nil || task.CompletedAt.After(*watermark) {
+		return nil
+	}
+
+	batch := newAnalyticsBatch()
+	batch.addTask(task, s.taskWorkspace(ctx, task, make(map[string]*models.Workspace)))
+	if err := s.storage.Analytics().Apply(ctx, batch.rollup(*watermark)); err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "사용량 집계 저장 실패", err)
+	}
+	return nil
+}
+
+// taskWorkspace 태스크를 실행한 워크스페이스 (세션, 프로젝트가 지워졌으면 nil)
+// 같은 세션의 태스크가 많으므로 세션별로 조회 결과를 기억합니다.
+func (s *AnalyticsService) taskWorkspace(ctx context.Context, task *models.Task, cache map[string]*models.Workspace) *models.Workspace {
+	if workspace, ok := cache[task.SessionID]; ok {
+		return workspace
+	}
+
+	var workspace *models.Workspace
+	session, err := s.storage.Session().GetByID(ctx, task.SessionID)
+	if err == nil {
+		var project *models.Project
+		if project, err = s.storage.Project().GetByID(ctx, session.ProjectID); err == nil {
+			workspace, err = s.storage.Workspace().GetByID(ctx, project.WorkspaceID)
+		}
+	}
+	if err != nil {
+		log.Printf("사용량 집계 워크스페이스 조회 실패: 태스크 %s: %v", task.ID, err)
+		workspace = nil
+	}
+	cache[task.SessionID] = workspace
+	return workspace
+}
+
+// Summary 기간 전체의 사용량 요약
+func (s *AnalyticsService) Summary(ctx context.Context, req *models.AnalyticsRangeRequest) (*models.AnalyticsSummary, error) {
+	from, to, err := s.dateRange(req)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := s.storage.Analytics().ListDays(ctx, from, to)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "사용량 집계 조회 실패", err)
+	}
+	activeUsers, err := s.storage.Analytics().CountActiveUsers(ctx, from, to)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "활성 사용자 수 조회 실패", err)
+	}
+	updatedAt, err := s.storage.Analytics().Watermark(ctx)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "사용량 집계 위치 조회 실패", err)
+	}
+
+	total := &models.AnalyticsDay{}
+	for _, day := range days {
+		total.Tasks += day.Tasks
+		total.Completed += day.Completed
+		total.Failed += day.Failed
+		total.Cancelled += day.Cancelled
+		total.DurationMs += day.DurationMs
+		total.TimedTasks += day.TimedTasks
+		total.CostUSD += day.CostUSD
+		total.Tokens += day.Tokens
+		if day.PeakConcurrency > total.PeakConcurrency {
+			total.PeakConcurrency = day.PeakConcurrency
+		}
+	}
+
+	return &models.AnalyticsSummary{
+		From:            from,
+		To:              to.AddDate(0, 0, -1),
+		Tasks:           total.Tasks,
+		Completed:       total.Completed,
+		Failed:          total.Failed,
+		Cancelled:       total.Cancelled,
+		SuccessRate:     total.SuccessRate(),
+		AvgDurationMs:   total.AverageDurationMs(),
+		CostUSD:         total.CostUSD,
+		Tokens:          total.Tokens,
+		ActiveUsers:     activeUsers,
+		PeakConcurrency: total.PeakConcurrency,
+		UpdatedAt:       updatedAt,
+	}, nil
+}
+
+// Daily 기간의 일별 추이 (태스크가 없는 날은 0으로 채움)
+func (s *AnalyticsService) Daily(ctx context.Context, req *models.AnalyticsRangeRequest) (*models.AnalyticsDailyResponse, error) {
+	from, to, err := s.dateRange(req)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := s.storage.Analytics().ListDays(ctx, from, to)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "사용량 집계 조회 실패", err)
+	}
+	updatedAt, err := s.storage.Analytics().Watermark(ctx)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "사용량 집계 위치 조회 실패", err)
+	}
+
+	byDay := make(map[time.Time]*models.AnalyticsDay, len(days))
+	for _, day := range days {
+		byDay[day.Day] = day
+	}
+	points := []models.AnalyticsDailyPoint{}
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		rollup, ok := byDay[day]
+		if !ok {
+			rollup = &models.AnalyticsDay{Day: day}
+		}
+		points = append(points, models.AnalyticsDailyPoint{
+			Day:             day,
+			Tasks:           rollup.Tasks,
+			Completed:       rollup.Completed,
+			Failed:          rollup.Failed,
+			Cancelled:       rollup.Cancelled,
+			SuccessRate:     rollup.SuccessRate(),
+			AvgDurationMs:   rollup.AverageDurationMs(),
+			CostUSD:         rollup.CostUSD,
+			Tokens:          rollup.Tokens,
+			ActiveUsers:     rollup.ActiveUsers,
+			PeakConcurrency: rollup.PeakConcurrency,
+		})
+	}
+
+	return &models.AnalyticsDailyResponse{
+		From:      from,
+		To:        to.AddDate(0, 0, -1),
+		Days:      points,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// TopWorkspaces 기간 동안 비용이 많이 든 워크스페이스 (비용이 같으면 태스크 수순)
+func (s *AnalyticsService) TopWorkspaces(ctx context.Context, req *models.AnalyticsRangeRequest) (*models.AnalyticsTopWorkspacesResponse, error) {
+	from, to, err := s.dateRange(req)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = analyticsDefaultTopLimit
+	}
+
+	usages, err := s.storage.Analytics().TopWorkspaces(ctx, from, to, limit)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스별 사용량 조회 실패", err)
+	}
+	updatedAt, err := s.storage.Analytics().Watermark(ctx)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "사용량 집계 위치 조회 실패", err)
+	}
+
+	// 이름은 롤업에 두지 않고 조회할 때 채움 (지워진 워크스페이스는 비워 둠)
+	for _, usage := range usages {
+		if workspace, err := s.storage.Workspace().GetByID(ctx, usage.WorkspaceID); err == nil {
+			usage.WorkspaceName = workspace.Name
+		}
+	}
+
+	return &models.AnalyticsTopWorkspacesResponse{
+		From:       from,
+		To:         to.AddDate(0, 0, -1),
+		Workspaces: usages,
+		UpdatedAt:  updatedAt,
+	}, nil
+}
+
+// dateRange 조회 기간을 [from, to) 날짜 구간(UTC)으로 변환
+// to를 지정하지 않으면 오늘, from을 지정하지 않으면 to를 포함한 30일 전부터입니다.
+func (s *AnalyticsService) dateRange(req *models.AnalyticsRangeRequest) (time.Time, time.Time, error) {
+	last := analyticsDay(s.now())
+	if req.To != nil {
+		last = analyticsDay(*req.To)
+	}
+	first := last.AddDate(0, 0, -(analyticsDefaultDays - 1))
+	if req.From != nil {
+		first = analyticsDay(*req.From)
+	}
+
+	if first.After(last) {
+		return time.Time{}, time.Time{}, NewWorkspaceError(ErrCodeInvalidRequest, "from은 to보다 늦을 수 없습니다", nil)
+	}
+	if last.Sub(first) >= analyticsMaxDays*24*time.Hour {
+		return time.Time{}, time.Time{}, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("조회 기간은 최대 %d일입니다", analyticsMaxDays), nil)
+	}
+	return first, last.AddDate(0, 0, 1), nil
+}
+
+// analyticsDay 시각이 속한 날(UTC 자정)
+func analyticsDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// analyticsBatch 집계 한 번에 반영할 증분 모음
+type analyticsBatch struct {
+	days       map[time.Time]*models.AnalyticsDay
+	workspaces map[string]*models.AnalyticsWorkspaceDay // 날짜/워크스페이스 ID
+	users      map[models.AnalyticsUserDay]bool
+}
+
+func newAnalyticsBatch() *analyticsBatch {
+	return &analyticsBatch{
+		days:       make(map[time.Time]*models.AnalyticsDay),
+		workspaces: make(map[string]*models.AnalyticsWorkspaceDay),
+		users:      make(map[models.AnalyticsUserDay]bool),
+	}
+}
+
+// day 날짜의 일별 증분 (없으면 만듦)
+func (b *analyticsBatch) day(day time.Time) *models.AnalyticsDay {
+	rollup, ok := b.days[day]
+	if !ok {
+		rollup = &models.AnalyticsDay{Day: day}
+		b.days[day] = rollup
+	}
+	return rollup
+}
+
+// addTask 끝난 태스크 하나를 종료한 날의 증분에 더함
+func (b *analyticsBatch) addTask(task *models.Task, workspace *models.Workspace) {
+	day := analyticsDay(*task.CompletedAt)
+	rollup := b.day(day)
+	rollup.Tasks++
+	switch task.Status {
+	case models.TaskCompleted:
+		rollup.Completed++
+	case models.TaskFailed:
+		rollup.Failed++
+	case models.TaskCancelled:
+		rollup.Cancelled++
+	}
+	if task.Duration > 0 {
+		rollup.DurationMs += task.Duration
+		rollup.TimedTasks++
+	}
+
+	// 비용과 토큰은 claude 명령의 JSON 결과 메시지에만 있음
+	usage, hasUsage := claude.ExtractCLIUsage(task.Output)
+	if hasUsage {
+		rollup.CostUSD += usage.CostUSD
+		rollup.Tokens += usage.Tokens()
+	}
+
+	if workspace == nil {
+		return
+	}
+	key := day.Format("2006-01-02") + "/" + workspace.ID
+	workspaceDay, ok := b.workspaces[key]
+	if !ok {
+		workspaceDay = &models.AnalyticsWorkspaceDay{Day: day, WorkspaceID: workspace.ID, OwnerID: workspace.OwnerID}
+		b.workspaces[key] = workspaceDay
+	}
+	workspaceDay.Tasks++
+	if hasUsage {
+		workspaceDay.CostUSD += usage.CostUSD
+		workspaceDay.Tokens += usage.Tokens()
+	}
+	if workspace.OwnerID != "" {
+		b.users[models.AnalyticsUserDay{Day: day, UserID: workspace.OwnerID}] = true
+	}
+}
+
+// rollup 스토리지에 반영할 형태로 변환
+func (b *analyticsBatch) rollup(watermark time.Time) *models.AnalyticsRollup {
+	rollup := &models.AnalyticsRollup{Watermark: watermark}
+	for _, day := range b.days {
+		rollup.Days = append(rollup.Days, day)
+	}
+	for _, workspaceDay := range b.workspaces {
+		rollup.Workspaces = append(rollup.Workspaces, workspaceDay)
+	}
+	for user := range b.users {
+		rollup.Users = append(rollup.Users, &user)
+	}
+	return rollup
+}
+
+// analyticsConcurrencyEvent 태스크 실행 시작(+1) 또는 종료(-1)
+type analyticsConcurrencyEvent struct {
+	at    time.Time
+	delta int64
+}
+
+// analyticsConcurrencyPeaks 구간 [from, until] 동안 날짜별 동시 실행 태스크 수의 최댓값
+// tasks에는 구간 안에 끝났거나 구간 끝에 실행 중이던 태스크가 모두 있어야 합니다.
+// 자정을 넘겨 실행 중인 태스크는 다음 날의 최댓값에도 반영합니다.
+func analyticsConcurrencyPeaks(tasks []*models.Task, from, until time.Time) map[time.Time]int64 {
+	events := make([]analyticsConcurrencyEvent, 0, len(tasks)*2)
+	for _, task := range tasks {
+		if task.StartedAt == nil {
+			continue
+		}
+		end := until
+		if task.CompletedAt != nil && task.CompletedAt.Before(until) {
+			end = *task.CompletedAt
+		}
+		if end.Before(*task.StartedAt) {
+			continue
+		}
+		events = append(events,
+			analyticsConcurrencyEvent{at: *task.StartedAt, delta: 1},
+			analyticsConcurrencyEvent{at: end, delta: -1})
+	}
+	// 같은 시각이면 종료를 먼저 처리해 이어서 실행된 태스크를 동시 실행으로 세지 않음
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].at.Equal(events[j].at) {
+			return events[i].at.Before(events[j].at)
+		}
+		return events[i].delta < events[j].delta
+	})
+
+	peaks := make(map[time.Time]int64)
+	record := func(at time.Time, running int64) {
+		day := analyticsDay(at)
+		if running > peaks[day] {
+			peaks[day] = running
+		}
+	}
+
+	var running int64
+	last := from
+	for _, event := range events {
+		if event.at.After(last) {
+			// 구간 시작 시각이나 자정에 이미 실행 중이던 태스크 수
+			if last.Equal(from) {
+				record(from, running)
+			}
+			for midnight := analyticsDay(last).AddDate(0, 0, 1); running > 0 && !midnight.After(event.at); midnight = midnight.AddDate(0, 0, 1) {
+				record(midnight, running)
+			}
+			last = event.at
+		}
+		running += event.delta
+		if event.delta > 0 && !event.at.Before(from) {
+			record(event.at, running)
+		}
+	}
+	return peaks
+}
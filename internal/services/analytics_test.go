@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// seedAnalyticsSession 소유자가 다른 워크스페이스마다 세션 하나를 만듦
+func seedAnalyticsSession(t *testing.T, store storage.Storage, name, ownerID string) *models.Session {
+	t.Helper()
+	ctx := context.Background()
+	workspace := &models.Workspace{Name: name, OwnerID: ownerID, ProjectPath: "/srv/" + name}
+	require.NoError(t, store.Workspace().Create(ctx, workspace))
+	project := &models.Project{WorkspaceID: workspace.ID, Name: name, Path: "/srv/" + name + "/app"}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{BaseModel: models.BaseModel{ID: "session-" + name}, ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, session))
+	return session
+}
+
+// analyticsTask 시작/종료 시각과 claude 결과 메시지(비용이 0보다 크면)가 있는 태스크 저장
+func analyticsTask(t *testing.T, store storage.Storage, session *models.Session, status models.TaskStatus, started time.Time, completed *time.Time, cost float64) {
+	t.Helper()
+	task := &models.Task{SessionID: session.ID, Command: "claude -p work", Status: status, StartedAt: &started, CompletedAt: completed}
+	if completed != nil {
+		task.Duration = completed.Sub(started).Milliseconds()
+	}
+	if cost > 0 {
+		task.Output = fmt.Sprintf(`{"type":"result","total_cost_usd":%g,"usage":{"input_tokens":100,"output_tokens":20}}`, cost)
+	}
+	require.NoError(t, store.Task().Create(context.Background(), task))
+}
+
+func TestAnalyticsService_IncrementalRollup(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewAnalyticsService(store)
+	day1 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	at := func(day time.Time, hour, minute int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	payments := seedAnalyticsSession(t, store, "payments", "alice")
+	search := seedAnalyticsSession(t, store, "search", "bob")
+
+	analyticsTask(t, store, payments, models.TaskCompleted, at(day1, 10, 0), ptr(at(day1, 10, 30)), 1.5)
+	analyticsTask(t, store, payments, models.TaskFailed, at(day1, 10, 10), ptr(at(day1, 10, 20)), 0)
+	// 자정을 넘겨 끝난 태스크는 끝난 날에 집계
+	analyticsTask(t, store, search, models.TaskCompleted, at(day1, 23, 50), ptr(at(day2, 0, 10)), 0.25)
+	running := &models.Task{SessionID: search.ID, Command: "claude -p long", Status: models.TaskRunning, StartedAt: ptr(at(day2, 11, 0))}
+	require.NoError(t, store.Task().Create(ctx, running))
+
+	svc.now = func() time.Time { return at(day2, 12, 0) }
+	finished, err := svc.Rollup(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, finished)
+
+	// 새로 끝난 태스크가 없으면 다시 세지 않음
+	finished, err = svc.Rollup(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, finished)
+
+	rangeReq := &models.AnalyticsRangeRequest{From: &day1, To: &day2}
+	summary, err := svc.Summary(ctx, rangeReq)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), summary.Tasks)
+	assert.InDelta(t, 2.0/3.0, summary.SuccessRate, 0.001)
+	assert.Equal(t, (20 * time.Minute).Milliseconds(), summary.AvgDurationMs)
+	assert.InDelta(t, 1.75, summary.CostUSD, 0.0001)
+	assert.Equal(t, int64(240), summary.Tokens)
+	assert.Equal(t, int64(2), summary.ActiveUsers)
+	assert.Equal(t, int64(2), summary.PeakConcurrency)
+	require.NotNil(t, summary.UpdatedAt)
+	assert.Equal(t, at(day2, 11, 59), *summary.UpdatedAt)
+
+	// 실행 중이던 태스크가 끝나고 같은 워크스페이스에서 하나 더 실행
+	running.Status = models.TaskCancelled
+	running.CompletedAt = ptr(at(day2, 12, 30))
+	require.NoError(t, store.Task().Update(ctx, running))
+	analyticsTask(t, store, payments, models.TaskCompleted, at(day2, 12, 5), ptr(at(day2, 12, 20)), 0.5)
+
+	svc.now = func() time.Time { return at(day2, 13, 0) }
+	finished, err = svc.Rollup(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, finished)
+
+	daily, err := svc.Daily(ctx, &models.AnalyticsRangeRequest{From: &day1, To: ptr(day2.AddDate(0, 0, 1))})
+	require.NoError(t, err)
+	require.Len(t, daily.Days, 3)
+	assert.Equal(t, models.AnalyticsDailyPoint{
+		Day: day1, Tasks: 2, Completed: 1, Failed: 1, SuccessRate: 0.5, AvgDurationMs: (20 * time.Minute).Milliseconds(),
+		CostUSD: 1.5, Tokens: 120, ActiveUsers: 1, PeakConcurrency: 2,
+	}, daily.Days[0])
+	assert.Equal(t, int64(3), daily.Days[1].Tasks)
+	assert.Equal(t, int64(1), daily.Days[1].Cancelled)
+	assert.Equal(t, int64(2), daily.Days[1].ActiveUsers)
+	assert.Equal(t, int64(2), daily.Days[1].PeakConcurrency)
+	assert.Equal(t, models.AnalyticsDailyPoint{Day: day2.AddDate(0, 0, 1)}, daily.Days[2])
+
+	top, err := svc.TopWorkspaces(ctx, &models.AnalyticsRangeRequest{From: &day1, To: &day2, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, top.Workspaces, 1)
+	assert.Equal(t, "payments", top.Workspaces[0].WorkspaceName)
+	assert.Equal(t, "alice", top.Workspaces[0].OwnerID)
+	assert.Equal(t, int64(3), top.Workspaces[0].Tasks)
+	assert.InDelta(t, 2.0, top.Workspaces[0].CostUSD, 0.0001)
+}
+
+func TestAnalyticsService_DateRange(t *testing.T) {
+	svc := NewAnalyticsService(memory.New())
+	svc.now = func() time.Time { return time.Date(2026, 3, 15, 18, 30, 0, 0, time.UTC) }
+
+	// 기본값: 오늘을 포함한 30일
+	summary, err := svc.Summary(context.Background(), &models.AnalyticsRangeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 2, 14, 0, 0, 0, 0, time.UTC), summary.From)
+	assert.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), summary.To)
+	assert.Nil(t, summary.UpdatedAt)
+
+	from := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	_, err = svc.Daily(context.Background(), &models.AnalyticsRangeRequest{From: &from, To: &to})
+	assert.Error(t, err)
+
+	from = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = svc.TopWorkspaces(context.Background(), &models.AnalyticsRangeRequest{From: &from})
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/artifact"
+)
+
+// ArtifactInfo 저장된 아티팩트와 다운로드 URL
+type ArtifactInfo struct {
+	Key         string    `json:"key"`
+	Size        int64     `json:"size"`
+	DownloadURL string    `json:"download_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// ArtifactService 사용자 아티팩트(업로드 파일, 태스크 출력, 워크스페이스 내보내기) 관리 서비스
+// 모든 사용자 아티팩트는 "users/<userID>/" 아래에 저장되어 소유자 확인에 사용됩니다.
+type ArtifactService struct {
+	store         artifact.Store
+	taskService   *TaskService
+	presignExpiry time.Duration
+}
+
+// NewArtifactService 새 아티팩트 서비스 생성
+func NewArtifactService(store artifact.Store, taskService *TaskService, presignExpiry time.Duration) *ArtifactService {
+	if presignExpiry <= 0 {
+		presignExpiry = 15 * time.Minute
+	}
+	return &ArtifactService{
+		store:         store,
+		taskService:   taskService,
+		presignExpiry: presignExpiry,
+	}
+}
+
+// Store 하위 아티팩트 저장소
+func (s *ArtifactService) Store() artifact.Store {
+	return s.store
+}
+
+// Upload 사용자 업로드 파일 저장
+func (s *ArtifactService) Upload(ctx context.Context, userID, filename string, r io.Reader, size int64, contentType string) (*ArtifactInfo, error) {
+	name := sanitizeArtifactFilename(filename)
+	key := path.Join(userArtifactPrefix(userID), "uploads", uuid.New().String(), name)
+	return s.put(ctx, key, r, size, contentType)
+}
+
+// ArchiveTaskOutput 태스크 출력을 tar.gz로 묶어 저장
+func (s *ArtifactService) ArchiveTaskOutput(ctx context.Context, userID, taskID string) (*ArtifactInfo, error) {
+	task, err := s.taskService.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	meta, err := json.MarshalIndent(task.ToResponse(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{"task.json", meta},
+		{"output.txt", []byte(task.Output)},
+		{"error.txt", []byte(task.Error)},
+	}
+	for _, f := range files {
+		header := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.data)), ModTime: time.Now()}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	key := path.Join(userArtifactPrefix(userID), "tasks", task.ID, "output.tar.gz")
+	return s.put(ctx, key, &buf, int64(buf.Len()), "application/gzip")
+}
+
+// StoreWorkspaceExport 내보낸 워크스페이스 아카이브 저장
+func (s *ArtifactService) StoreWorkspaceExport(ctx context.Context, userID, workspaceID string, r io.Reader, size int64) (*ArtifactInfo, error) {
+	name := fmt.Sprintf("%s-%s.tar.gz", workspaceID, time.Now().UTC().Format("20060102-150405"))
+	key := path.Join(userArtifactPrefix(userID), "exports", name)
+	return s.put(ctx, key, r, size, "application/gzip")
+}
+
+// StoreFanOutReport 팬아웃 통합 보고서 저장 (FanOutReportStore)
+func (s *ArtifactService) StoreFanOutReport(ctx context.Context, userID, fanOutID string, data []byte) (*ArtifactInfo, error) {
+	key := path.Join(userArtifactPrefix(userID), "fanouts", sanitizeArtifactFilename(fanOutID), "report.md")
+	return s.put(ctx, key, bytes.NewReader(data), int64(len(data)), "text/markdown; charset=utf-8")
+}
+
+// StoreTranscriptExport 내보낸 대화 기록 문서 저장 (TranscriptExportStore)
+func (s *ArtifactService) StoreTranscriptExport(ctx context.Context, userID, exportID, filename, contentType string, data []byte) (*ArtifactInfo, error) {
+	key := path.Join(userArtifactPrefix(userID), "exports", sanitizeArtifactFilename(exportID), sanitizeArtifactFilename(filename))
+	return s.put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType)
+}
+
+// PresignURL 사용자 소유 아티팩트의 다운로드 URL 생성
+// admin이 true이면 소유자 확인을 건너뜁니다.
+func (s *ArtifactService) PresignURL(ctx context.Context, userID, key string, admin bool) (*ArtifactInfo, error) {
+	if err := artifact.ValidateKey(key); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 아티팩트 키입니다", err)
+	}
+	if !admin && !strings.HasPrefix(key, userArtifactPrefix(userID)+"/") {
+		return nil, NewWorkspaceError(ErrCodeInsufficientPerm, "아티팩트에 접근할 권한이 없습니다", ErrUnauthorized)
+	}
+
+	expiresAt := time.Now().Add(s.presignExpiry)
+	url, err := s.store.PresignGet(ctx, key, s.presignExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return &ArtifactInfo{Key: key, DownloadURL: url, ExpiresAt: expiresAt}, nil
+}
+
+// put 저장 후 다운로드 URL 발급
+func (s *ArtifactService) put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*ArtifactInfo, error) {
+	if err := s.store.Put(ctx, key, r, size, contentType); err != nil {
+		return nil, fmt.Errorf("아티팩트 저장 실패: %w", err)
+	}
+
+	expiresAt := time.Now().Add(s.presignExpiry)
+	url, err := s.store.PresignGet(ctx, key, s.presignExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return &ArtifactInfo{Key: key, Size: size, DownloadURL: url, ExpiresAt: expiresAt}, nil
+}
+
+// userArtifactPrefix 사용자 아티팩트 키 접두사
+func userArtifactPrefix(userID string) string {
+	return "users/" + sanitizeArtifactFilename(userID)
+}
+
+// sanitizeArtifactFilename 파일 이름에서 경로 구분자와 특수 이름을 제거
+func sanitizeArtifactFilename(name string) string {
+	name = strings.TrimLeft(path.Base(strings.ReplaceAll(name, "\\", "/")), ".")
+	if name == "" || name == "/" {
+		return "file"
+	}
+	return name
+}
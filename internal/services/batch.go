@@ -0,0 +1,372 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	apierrors "github.com/aicli/aicli-web/internal/errors"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// BatchService 워크스페이스/태스크 일괄 처리 서비스
+// 기본적으로 항목별로 처리하여 부분 실패를 허용하며, atomic 요청 시
+// 트랜잭션을 지원하는 스토리지에서는 전체를 하나의 트랜잭션으로 처리합니다.
+type BatchService struct {
+	storage          storage.Storage
+	workspaceService WorkspaceService
+	taskService      *TaskService
+	validator        *WorkspaceValidator
+}
+
+// NewBatchService 새 일괄 처리 서비스 생성
+func NewBatchService(storage storage.Storage, workspaceService WorkspaceService, taskService *TaskService) *BatchService {
+	return &BatchService{
+		storage:          storage,
+		workspaceService: workspaceService,
+		taskService:      taskService,
+		validator:        NewWorkspaceValidator(),
+	}
+}
+
+// BatchDeleteWorkspaces 워크스페이스 일괄 삭제
+func (bs *BatchService) BatchDeleteWorkspaces(ctx context.Context, req *models.BatchDeleteRequest, ownerID string) (*models.BatchResult, error) {
+	if req == nil || len(req.IDs) == 0 {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "삭제할 워크스페이스 ID가 필요합니다", ErrInvalidRequest)
+	}
+
+	txStorage, transactional := bs.storage.(storage.TransactionalStorage)
+	if !req.Atomic {
+		result := &models.BatchResult{}
+		for i, id := range req.IDs {
+			item := models.BatchItemResult{Index: i, ID: id}
+			if err := bs.workspaceService.DeleteWorkspace(ctx, id, ownerID); err != nil {
+				item.Error = NewBatchItemError(err)
+			} else {
+				item.Success = true
+			}
+			result.Add(item)
+		}
+		return result, nil
+	}
+
+	// 1단계: 모든 항목 사전 검증 (권한, 삭제 가능 여부)
+	result := &models.BatchResult{Atomic: transactional}
+	validated := make([]models.BatchItemResult, len(req.IDs))
+	for i, id := range req.IDs {
+		validated[i] = models.BatchItemResult{Index: i, ID: id, Success: true}
+		workspace, err := bs.workspaceService.GetWorkspace(ctx, id, ownerID)
+		if err == nil {
+			err = requireWorkspaceOwner(workspace, ownerID)
+		}
+		if err == nil {
+			err = bs.validator.CanDeleteWorkspace(ctx, workspace)
+		}
+		if err != nil {
+			validated[i].Success = false
+			validated[i].Error = NewBatchItemError(err)
+		}
+	}
+	if hasBatchFailure(validated) {
+		return rollbackResult(result, validated), nil
+	}
+
+	// 2단계: 삭제 실행
+	deleteAll := func(ws storage.WorkspaceStorage) error {
+		for i, id := range req.IDs {
+			if err := ws.Delete(ctx, id); err != nil {
+				validated[i].Success = false
+				validated[i].Error = NewBatchItemError(err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	var err error
+	if transactional {
+		err = txStorage.WithTx(ctx, func(tx storage.Transaction) error {
+			return deleteAll(tx.Workspace())
+		})
+	} else {
+		// 트랜잭션 미지원 스토리지는 사전 검증 후 순차 처리 (best-effort)
+		err = deleteAll(bs.storage.Workspace())
+	}
+
+	if err != nil && transactional {
+		return rollbackResult(result, validated), nil
+	}
+	if err != nil {
+		// 트랜잭션 없이 중단된 경우 실패 이후 항목은 삭제를 시도하지 않았음을 표시
+		markNotAttempted(validated)
+	}
+	for _, item := range validated {
+		if item.Success {
+			// 삭제한 워크스페이스의 공유 ACL과 환경 변수 정리 (실패해도 삭제 결과는 유지)
+			_ = bs.storage.WorkspaceACL().DeleteByWorkspace(ctx, item.ID)
+			_ = bs.storage.WorkspaceEnv().DeleteByWorkspace(ctx, item.ID)
+		}
+		result.Add(item)
+	}
+	return result, nil
+}
+
+// BatchCreateTasks 태스크 일괄 생성
+func (bs *BatchService) BatchCreateTasks(ctx context.Context, req *models.BatchCreateTasksRequest) (*models.BatchResult, error) {
+	if req == nil || len(req.Tasks) == 0 {
+		return nil, apierrors.NewValidationError("생성할 태스크가 필요합니다")
+	}
+	if err := bs.taskService.checkMaintenance(); err != nil {
+		return nil, err
+	}
+
+	if !req.Atomic {
+		result := &models.BatchResult{}
+		for i := range req.Tasks {
+			item := models.BatchItemResult{Index: i}
+			task, err := bs.taskService.Create(ctx, &req.Tasks[i])
+			if err != nil {
+				item.Error = NewBatchItemError(err)
+			} else {
+				item.ID = task.ID
+				item.Success = true
+				item.Data = task.ToResponse()
+			}
+			result.Add(item)
+		}
+		return result, nil
+	}
+
+	// 1단계: 모든 항목 사전 검증
+	result := &models.BatchResult{Atomic: true}
+	items := make([]models.BatchItemResult, len(req.Tasks))
+	for i := range req.Tasks {
+		items[i] = models.BatchItemResult{Index: i, Success: true}
+		if err := bs.taskService.validateCreate(ctx, &req.Tasks[i]); err != nil {
+			items[i].Success = false
+			items[i].Error = NewBatchItemError(err)
+		}
+	}
+	if hasBatchFailure(items) {
+		return rollbackResult(result, items), nil
+	}
+
+	// 2단계: 저장 (트랜잭션 지원 시 트랜잭션 사용)
+	tasks := make([]*models.Task, len(req.Tasks))
+	createAll := func(ts storage.TaskStorage) error {
+		for i := range req.Tasks {
+			tasks[i] = newTask(&req.Tasks[i])
+			if err := ts.Create(ctx, tasks[i]); err != nil {
+				items[i].Success = false
+				items[i].Error = NewBatchItemError(err)
+				return err
+			}
+		}
+		return nil
+	}
+
+	var err error
+	if txStorage, ok := bs.storage.(storage.TransactionalStorage); ok {
+		err = txStorage.WithTx(ctx, func(tx storage.Transaction) error {
+			return createAll(tx.Task())
+		})
+	} else if err = createAll(bs.storage.Task()); err != nil {
+		// 보상 처리: 이미 생성된 태스크 삭제
+		bs.deleteTasks(ctx, tasks)
+	}
+	if err != nil {
+		return rollbackResult(result, items), nil
+	}
+
+	// 동시 실행 세션 한도 확인 (하나라도 넘으면 전체 취소)
+	for i, task := range tasks {
+		if err := bs.taskService.admitSession(ctx, task, req.Tasks[i].UserID); err != nil {
+			items[i].Success = false
+			items[i].Error = NewBatchItemError(err)
+			bs.deleteTasks(ctx, tasks)
+			return rollbackResult(result, items), nil
+		}
+	}
+
+	// 검토가 필요한 태스크는 실행 전 작업 트리 상태를 기록 (하나라도 실패하면 전체 취소)
+	for i, task := range tasks {
+		if !requiresReview(&req.Tasks[i]) {
+			continue
+		}
+		if err := bs.taskService.prepareReview(ctx, task); err != nil {
+			items[i].Success = false
+			items[i].Error = NewBatchItemError(err)
+			bs.discardReviews(ctx, req, tasks[:i])
+			bs.deleteTasks(ctx, tasks)
+			return rollbackResult(result, items), nil
+		}
+	}
+
+	// 3단계: 큐 제출 (하나라도 실패하면 전체 취소)
+	for i, task := range tasks {
+		// 워커가 상태를 바꾸기 전에 대기 상태 발행
+		bs.taskService.publishStatus(task)
+		if err := bs.taskService.submit(task); err != nil {
+			items[i].Success = false
+			items[i].Error = NewBatchItemError(fmt.Errorf("태스크 큐 제출 실패: %w", err))
+			for _, submitted := range tasks[:i] {
+				_ = bs.taskService.taskQueue.Cancel(submitted.ID)
+			}
+			bs.discardReviews(ctx, req, tasks)
+			bs.deleteTasks(ctx, tasks)
+			return rollbackResult(result, items), nil
+		}
+	}
+
+	for i, task := range tasks {
+		items[i].ID = task.ID
+		items[i].Data = task.ToResponse()
+		result.Add(items[i])
+	}
+	log.Printf("태스크 일괄 생성됨: %d개", len(tasks))
+	return result, nil
+}
+
+// deleteTasks 생성된 태스크를 삭제합니다 (보상 처리)
+func (bs *BatchService) deleteTasks(ctx context.Context, tasks []*models.Task) {
+	for _, task := range tasks {
+		if task != nil && task.ID != "" {
+			_ = bs.storage.Task().Delete(ctx, task.ID)
+			bs.taskService.releaseSession(task.ID)
+		}
+	}
+}
+
+// discardReviews 검토 워크플로에 등록한 태스크를 취소합니다 (보상 처리)
+func (bs *BatchService) discardReviews(ctx context.Context, req *models.BatchCreateTasksRequest, tasks []*models.Task) {
+	for i, task := range tasks {
+		if requiresReview(&req.Tasks[i]) {
+			bs.taskService.reviews.Discard(ctx, task.ID)
+		}
+	}
+}
+
+// hasBatchFailure 실패 항목 존재 여부
+func hasBatchFailure(items []models.BatchItemResult) bool {
+	for _, item := range items {
+		if !item.Success {
+			return true
+		}
+	}
+	return false
+}
+
+// markNotAttempted 첫 실패 항목 이후의 항목을 처리하지 않은 실패로 표시합니다
+func markNotAttempted(items []models.BatchItemResult) {
+	failed := false
+	for i := range items {
+		if failed {
+			items[i].Success = false
+			items[i].Error = &models.BatchItemError{
+				Type:    apierrors.ErrorTypeConflict.String(),
+				Code:    "BATCH_NOT_ATTEMPTED",
+				Message: "앞선 항목의 실패로 처리하지 않았습니다",
+			}
+			continue
+		}
+		failed = !items[i].Success
+	}
+}
+
+// rollbackResult 원자적 처리 실패 시 모든 항목을 실패로 표시한 결과를 만듭니다
+func rollbackResult(result *models.BatchResult, items []models.BatchItemResult) *models.BatchResult {
+	result.RolledBack = true
+	for _, item := range items {
+		if item.Success {
+			item.Success = false
+			item.Data = nil
+			item.Error = &models.BatchItemError{
+				Type:    apierrors.ErrorTypeConflict.String(),
+				Code:    "BATCH_ROLLED_BACK",
+				Message: "다른 항목의 실패로 처리가 취소되었습니다",
+			}
+		}
+		result.Add(item)
+	}
+	return result
+}
+
+// NewBatchItemError 에러를 errors 패키지의 분류 체계에 맞춰 항목 에러로 변환합니다
+func NewBatchItemError(err error) *models.BatchItemError {
+	var cliErr *apierrors.CLIError
+	if errors.As(err, &cliErr) {
+		return &models.BatchItemError{
+			Type:    cliErr.Type.String(),
+			Code:    strings.ToUpper(strings.TrimSuffix(cliErr.Type.String(), "Error")),
+			Message: cliErr.Message,
+		}
+	}
+
+	var quotaErr *DiskQuotaError
+	if errors.As(err, &quotaErr) {
+		return &models.BatchItemError{
+			Type:    apierrors.ErrorTypeFileSystem.String(),
+			Code:    DiskQuotaErrorCode,
+			Message: quotaErr.Error(),
+		}
+	}
+
+	var limitErr *SessionLimitError
+	if errors.As(err, &limitErr) {
+		return &models.BatchItemError{
+			Type:    apierrors.ErrorTypeConflict.String(),
+			Code:    SessionLimitErrorCode,
+			Message: limitErr.Error(),
+		}
+	}
+
+	var wsErr *apierrors.WorkspaceError
+	if errors.As(err, &wsErr) {
+		return &models.BatchItemError{
+			Type:    workspaceErrorType(wsErr.Code).String(),
+			Code:    wsErr.Code,
+			Message: wsErr.Message,
+		}
+	}
+
+	errorType := apierrors.ErrorTypeInternal
+	code := ErrCodeInternal
+	switch {
+	case storage.IsNotFoundError(err) || errors.Is(err, ErrWorkspaceNotFound):
+		errorType, code = apierrors.ErrorTypeNotFound, ErrCodeNotFound
+	case storage.IsAlreadyExistsError(err):
+		errorType, code = apierrors.ErrorTypeConflict, ErrCodeAlreadyExists
+	case storage.IsConflictError(err):
+		errorType, code = apierrors.ErrorTypeConflict, ErrCodeVersionConflict
+	case errors.Is(err, storage.ErrInvalidInput):
+		errorType, code = apierrors.ErrorTypeValidation, ErrCodeInvalidRequest
+	}
+
+	return &models.BatchItemError{
+		Type:    errorType.String(),
+		Code:    code,
+		Message: err.Error(),
+	}
+}
+
+// workspaceErrorType 워크스페이스 에러 코드를 ErrorType으로 매핑
+func workspaceErrorType(code string) apierrors.ErrorType {
+	switch code {
+	case ErrCodeNotFound:
+		return apierrors.ErrorTypeNotFound
+	case ErrCodeAlreadyExists, ErrCodeResourceBusy, ErrCodeDependencyExists, ErrCodeVersionConflict:
+		return apierrors.ErrorTypeConflict
+	case ErrCodeUnauthorized:
+		return apierrors.ErrorTypeAuthentication
+	case ErrCodeInsufficientPerm, ErrCodeOwnershipRequired:
+		return apierrors.ErrorTypePermission
+	case ErrCodeInvalidName, ErrCodeInvalidPath, ErrCodeInvalidRequest, ErrCodeInvalidStatus,
+		ErrCodeNotActive, ErrCodeArchived, ErrCodeMaxWorkspaces:
+		return apierrors.ErrorTypeValidation
+	default:
+		return apierrors.ErrorTypeInternal
+	}
+}
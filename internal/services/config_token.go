@@ -0,0 +1,37 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/aicli/aicli-web/internal/secrets"
+)
+
+// configTokens PR, 이슈 트래커 설정의 액세스 토큰을 secrets.Box로 암호화해 저장하고 꺼낼 때 복호화합니다
+// box가 없으면 평문으로 저장하며, 암호화를 켜기 전에 저장된 평문 토큰도 그대로 읽습니다.
+type configTokens struct {
+	box *secrets.Box
+}
+
+// seal 저장할 토큰 암호화
+func (t configTokens) seal(token string) (string, error) {
+	if t.box == nil || token == "" {
+		return token, nil
+	}
+	return t.box.Seal(token)
+}
+
+// open 저장된 토큰 복호화 (평문으로 저장된 토큰은 그대로 반환)
+func (t configTokens) open(stored string) (string, error) {
+	if !secrets.IsSealed(stored) {
+		return stored, nil
+	}
+	if t.box == nil {
+		return "", errors.New("암호화된 토큰을 복호화할 키가 설정되지 않았습니다")
+	}
+	return t.box.Open(stored)
+}
+
+// needsSeal 평문으로 저장되어 암호화해야 하는 토큰인지 확인
+func (t configTokens) needsSeal(stored string) bool {
+	return t.box != nil && stored != "" && !secrets.IsSealed(stored)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aicli/aicli-web/internal/docker"
 	"github.com/aicli/aicli-web/internal/docker/status"
 	"github.com/aicli/aicli-web/internal/docker/security"
+	"github.com/docker/docker/api/types/mount"
 )
 
 // TaskType 워크스페이스 작업 타입
@@ -25,6 +26,9 @@ const (
 	TaskTypeSync      TaskType = "sync"
 )
 
+// DefaultWorkspaceImage 기본 워크스페이스 이미지
+const DefaultWorkspaceImage = "aicli/workspace:latest"
+
 // WorkspaceTask 비동기 워크스페이스 작업 정의
 type WorkspaceTask struct {
 	Type        TaskType             `json:"type"`
@@ -47,6 +51,10 @@ type DockerWorkspaceService struct {
 	dockerManager *docker.Manager
 	statusTracker *status.Tracker
 	isolationMgr  *security.IsolationManager
+	cacheManager  *docker.CacheManager // 의존성 캐시 (선택적)
+	warmPool      *docker.WarmPool     // 웜 컨테이너 풀 (선택적)
+	imageService  *WorkspaceImageService // 워크스페이스별 이미지 (선택적)
+	networkPolicy *NetworkPolicyService  // 워크스페이스별 네트워크 이그레스 정책 (선택적)
 	
 	// 비동기 작업 처리
 	taskQueue     chan *WorkspaceTask
@@ -136,6 +144,62 @@ func NewDockerWorkspaceService(
 	return service
 }
 
+// SetCacheManager 컨테이너를 다시 만들어도 유지할 의존성 캐시 관리자를 설정합니다
+func (dws *DockerWorkspaceService) SetCacheManager(cacheManager *docker.CacheManager) {
+	dws.mu.Lock()
+	defer dws.mu.Unlock()
+	dws.cacheManager = cacheManager
+}
+
+// CacheManager 의존성 캐시 관리자를 반환합니다 (설정되지 않았으면 nil)
+func (dws *DockerWorkspaceService) CacheManager() *docker.CacheManager {
+	dws.mu.RLock()
+	defer dws.mu.RUnlock()
+	return dws.cacheManager
+}
+
+// SetWarmPool 새 워크스페이스에 미리 만들어 둔 컨테이너를 할당할 웜 풀을 설정합니다
+func (dws *DockerWorkspaceService) SetWarmPool(warmPool *docker.WarmPool) {
+	dws.mu.Lock()
+	defer dws.mu.Unlock()
+	dws.warmPool = warmPool
+}
+
+// WarmPool 웜 컨테이너 풀을 반환합니다 (설정되지 않았으면 nil)
+func (dws *DockerWorkspaceService) WarmPool() *docker.WarmPool {
+	dws.mu.RLock()
+	defer dws.mu.RUnlock()
+	return dws.warmPool
+}
+
+// SetImageService 워크스페이스별 컨테이너 이미지 서비스를 설정합니다
+func (dws *DockerWorkspaceService) SetImageService(imageService *WorkspaceImageService) {
+	dws.mu.Lock()
+	defer dws.mu.Unlock()
+	dws.imageService = imageService
+}
+
+// ImageService 워크스페이스 이미지 서비스를 반환합니다 (설정되지 않았으면 nil)
+func (dws *DockerWorkspaceService) ImageService() *WorkspaceImageService {
+	dws.mu.RLock()
+	defer dws.mu.RUnlock()
+	return dws.imageService
+}
+
+// SetNetworkPolicyService 워크스페이스별 네트워크 이그레스 정책 서비스를 설정합니다
+func (dws *DockerWorkspaceService) SetNetworkPolicyService(networkPolicy *NetworkPolicyService) {
+	dws.mu.Lock()
+	defer dws.mu.Unlock()
+	dws.networkPolicy = networkPolicy
+}
+
+// NetworkPolicyService 네트워크 정책 서비스를 반환합니다 (설정되지 않았으면 nil)
+func (dws *DockerWorkspaceService) NetworkPolicyService() *NetworkPolicyService {
+	dws.mu.RLock()
+	defer dws.mu.RUnlock()
+	return dws.networkPolicy
+}
+
 // startWorkers 비동기 작업 워커들을 시작합니다
 func (dws *DockerWorkspaceService) startWorkers() {
 	for i := 0; i < dws.workers; i++ {
@@ -181,7 +245,7 @@ func (dws *DockerWorkspaceService) CreateWorkspace(ctx context.Context, req *mod
 			WorkspaceID: workspace.ID,
 			Name:        fmt.Sprintf("workspace-%s", workspace.ID),
 			ProjectPath: workspace.ProjectPath,
-			Image:       "aicli/workspace:latest", // 기본 워크스페이스 이미지
+			Image:       DefaultWorkspaceImage,
 			Environment: map[string]string{
 				"WORKSPACE_ID":   workspace.ID,
 				"WORKSPACE_NAME": workspace.Name,
@@ -240,6 +304,11 @@ func (dws *DockerWorkspaceService) GetWorkspace(ctx context.Context, id string,
 	return dws.baseService.GetWorkspace(ctx, id, ownerID)
 }
 
+// AuthorizeWorkspace 워크스페이스 권한을 확인합니다 (기본 서비스 위임)
+func (dws *DockerWorkspaceService) AuthorizeWorkspace(ctx context.Context, id string, userID string, required models.WorkspacePermission) (*models.Workspace, error) {
+	return dws.baseService.AuthorizeWorkspace(ctx, id, userID, required)
+}
+
 // UpdateWorkspace 워크스페이스를 수정합니다 (기본 서비스 위임)
 func (dws *DockerWorkspaceService) UpdateWorkspace(ctx context.Context, id string, req *models.UpdateWorkspaceRequest, ownerID string) (*models.Workspace, error) {
 	return dws.baseService.UpdateWorkspace(ctx, id, req, ownerID)
@@ -287,7 +356,28 @@ func (dws *DockerWorkspaceService) DeleteWorkspace(ctx context.Context, id strin
 	}
 	
 	// Phase 2: DB에서 워크스페이스 삭제
-	return dws.baseService.DeleteWorkspace(ctx, id, ownerID)
+	if err := dws.baseService.DeleteWorkspace(ctx, id, ownerID); err != nil {
+		return err
+	}
+	
+	// Phase 3: 더 이상 쓰이지 않는 의존성 캐시 삭제
+	if cacheManager := dws.CacheManager(); cacheManager != nil {
+		if _, err := cacheManager.Purge(ctx, id, "", true); err != nil {
+			fmt.Printf("failed to purge dependency caches for workspace %s: %v\n", id, err)
+		}
+	}
+	if imageService := dws.ImageService(); imageService != nil {
+		if err := imageService.Delete(ctx, id); err != nil {
+			fmt.Printf("failed to delete image settings for workspace %s: %v\n", id, err)
+		}
+	}
+	if networkPolicy := dws.NetworkPolicyService(); networkPolicy != nil {
+		if err := networkPolicy.Delete(ctx, id); err != nil {
+			fmt.Printf("failed to delete network policy for workspace %s: %v\n", id, err)
+		}
+	}
+	
+	return nil
 }
 
 // ListWorkspaces 워크스페이스 목록을 조회합니다 (기본 서비스 위임)
@@ -406,38 +496,118 @@ func (dws *DockerWorkspaceService) executeCreateTask(ctx context.Context, task *
 		return fmt.Errorf("create isolation config: %w", err)
 	}
 	
-	// Step 3: 컨테이너 생성
+	// Step 3: 워크스페이스 이미지 확인 (정책을 다시 확인)
+	image := req.Image
+	var spec docker.WorkspaceImageSpec
+	if imageService := dws.ImageService(); imageService != nil {
+		resolved, err := imageService.Resolve(workspace)
+		if err != nil {
+			return fmt.Errorf("resolve workspace image: %w", err)
+		}
+		if resolved != nil {
+			image = resolved.Image
+			spec = resolved.Spec
+		}
+	}
+	
+	// Step 4: 네트워크 이그레스 정책 확인
+	var networkPolicy *models.NetworkPolicy
+	if policyService := dws.NetworkPolicyService(); policyService != nil {
+		networkPolicy, err = policyService.Effective(ctx, workspace.ID)
+		if err != nil {
+			return fmt.Errorf("resolve network policy: %w", err)
+		}
+	}
+	restrictedNetwork := networkPolicy != nil && networkPolicy.Mode != models.NetworkEgressFull
+	
+	// Step 5: 웜 컨테이너 할당 (의존성 캐시와 추가 마운트, 특권 모드, 네트워크 제한은 콜드 스타트에서만 적용)
+	warmPool := dws.WarmPool()
+	var coldReason string
+	if warmPool != nil && (spec.Privileged || len(spec.Mounts) > 0 || restrictedNetwork) {
+		coldReason = docker.ColdStartReasonIneligible
+	} else if warmPool != nil {
+		_, err := warmPool.Acquire(ctx, docker.WarmClaimRequest{
+			WorkspaceID: req.WorkspaceID,
+			Image:       image,
+			ProjectPath: req.ProjectPath,
+			Environment: req.Environment,
+		})
+		if err == nil {
+			return dws.markContainerStarted(ctx, workspace)
+		}
+		coldReason = docker.ColdStartReason(err)
+	}
+	coldStart := time.Now()
+	
+	// Step 6: 의존성 캐시 마운트 준비
+	environment := req.Environment
+	var cacheMounts []mount.Mount
+	cacheManager := dws.CacheManager()
+	if cacheManager != nil {
+		var cacheEnv map[string]string
+		cacheMounts, cacheEnv, err = cacheManager.Prepare(ctx, task.WorkspaceID)
+		if err != nil {
+			return fmt.Errorf("prepare dependency caches: %w", err)
+		}
+		environment = make(map[string]string, len(req.Environment)+len(cacheEnv))
+		for key, value := range cacheEnv {
+			environment[key] = value
+		}
+		for key, value := range req.Environment {
+			environment[key] = value
+		}
+	}
+	
+	// Step 7: 컨테이너 생성
 	container, err := dws.dockerManager.Container().CreateWorkspaceContainer(ctx, &docker.CreateContainerRequest{
-		WorkspaceID: req.WorkspaceID,
-		Name:        req.Name,
-		Image:       req.Image,
-		ProjectPath: req.ProjectPath,
-		Environment: req.Environment,
-		WorkingDir:  "/workspace",
-		CPULimit:    1.0,
-		MemoryLimit: 1024 * 1024 * 1024, // 1GB
+		WorkspaceID:   req.WorkspaceID,
+		Name:          req.Name,
+		Image:         image,
+		ProjectPath:   req.ProjectPath,
+		Environment:   environment,
+		WorkingDir:    "/workspace",
+		CPULimit:      1.0,
+		MemoryLimit:   1024 * 1024 * 1024, // 1GB
+		Privileged:    spec.Privileged,
+		ExtraMounts:   append(cacheMounts, spec.BindMounts()...),
+		NetworkPolicy: networkPolicy,
 	})
 	if err != nil {
+		if cacheManager != nil {
+			cacheManager.Release(task.WorkspaceID)
+		}
 		return fmt.Errorf("create container: %w", err)
 	}
 	
-	// Step 4: 컨테이너 시작
+	// Step 8: 컨테이너 시작
 	if err := dws.dockerManager.Container().StartContainer(ctx, container.ID); err != nil {
+		if cacheManager != nil {
+			cacheManager.Release(task.WorkspaceID)
+		}
+		
 		// 실패 시 컨테이너 삭제
 		if removeErr := dws.dockerManager.Container().RemoveContainer(ctx, container.ID, true); removeErr != nil {
 			return fmt.Errorf("start container failed and cleanup failed: %v (cleanup error: %v)", err, removeErr)
 		}
 		return fmt.Errorf("start container: %w", err)
 	}
+	if warmPool != nil {
+		warmPool.RecordColdStart(coldReason, time.Since(coldStart))
+	}
 	
-	// Step 5: 데이터베이스 상태 업데이트
+	// Step 9: 데이터베이스 상태 업데이트
+	return dws.markContainerStarted(ctx, workspace)
+}
+
+// markContainerStarted 컨테이너가 시작된 워크스페이스를 활성 상태로 갱신합니다
+func (dws *DockerWorkspaceService) markContainerStarted(ctx context.Context, workspace *models.Workspace) error {
 	updates := map[string]interface{}{
 		"status":       models.WorkspaceStatusActive,
 		"active_tasks": workspace.ActiveTasks + 1,
 		"updated_at":   time.Now(),
 	}
 	
-	if err := dws.storage.Workspace().Update(ctx, task.WorkspaceID, updates); err != nil {
+	if err := dws.storage.Workspace().Update(ctx, workspace.ID, updates); err != nil {
 		return fmt.Errorf("update workspace status: %w", err)
 	}
 	
@@ -511,6 +681,16 @@ func (dws *DockerWorkspaceService) executeDeleteTask(ctx context.Context, task *
 		}
 	}
 	
+	// allowlist 모드의 이그레스 프록시와 내부 네트워크 정리
+	if err := dws.dockerManager.Container().RemoveEgressProxy(ctx, task.WorkspaceID); err != nil {
+		fmt.Printf("failed to remove egress proxy for workspace %s: %v\n", task.WorkspaceID, err)
+	}
+	
+	// 컨테이너가 사라졌으므로 캐시는 유휴 상태 (재생성 시 다시 마운트됨)
+	if cacheManager := dws.CacheManager(); cacheManager != nil {
+		cacheManager.Release(task.WorkspaceID)
+	}
+	
 	return nil
 }
 
@@ -160,6 +160,20 @@ func (dws *DockerWorkspaceService) recreateWorkspace(workspaceID string, strateg
 	return dws.retryTask(createTask, strategy)
 }
 
+// RecreateWorkspace 워크스페이스 컨테이너를 지우고 다시 생성합니다 (에러 복구용)
+func (dws *DockerWorkspaceService) RecreateWorkspace(ctx context.Context, workspaceID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	
+	strategy, err := dws.GetErrorRecoveryStrategy(workspaceID)
+	if err != nil {
+		return err
+	}
+	
+	return dws.recreateWorkspace(workspaceID, strategy)
+}
+
 // recreateWorkspaceWithMoreResources 더 많은 리소스로 워크스페이스를 재생성합니다
 func (dws *DockerWorkspaceService) recreateWorkspaceWithMoreResources(workspaceID string, strategy *ErrorRecoveryStrategy) error {
 	// TODO: 리소스 제한 증가 로직 구현
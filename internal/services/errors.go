@@ -52,5 +52,6 @@ const (
 	ErrCodeMaxWorkspaces     = errors.ErrCodeMaxWorkspaces
 	ErrCodeResourceBusy      = errors.ErrCodeResourceBusy
 	ErrCodeDependencyExists  = errors.ErrCodeDependencyExists
+	ErrCodeVersionConflict   = errors.ErrCodeVersionConflict
 	ErrCodeInternal          = errors.ErrCodeInternal
 )
\ No newline at end of file
@@ -0,0 +1,489 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// defaultFanOutListLimit 팬아웃 목록 조회 개수
+const defaultFanOutListLimit = 50
+
+// FanOutTaskRunner 워크스페이스별 태스크를 실행하는 인터페이스 (*TaskService)
+type FanOutTaskRunner interface {
+	Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error)
+	Cancel(ctx context.Context, id string) error
+}
+
+// FanOutReportStore 통합 보고서를 아티팩트로 저장하는 인터페이스 (*ArtifactService)
+type FanOutReportStore interface {
+	StoreFanOutReport(ctx context.Context, userID, fanOutID string, data []byte) (*ArtifactInfo, error)
+}
+
+// FanOutListener 팬아웃 진행 상황을 전달받는 리스너 (WebSocket 스트리밍용)
+type FanOutListener interface {
+	OnFanOutUpdate(fanOut *models.FanOut)
+}
+
+// FanOutService 같은 프롬프트를 여러 워크스페이스에서 병렬로 실행
+// 워크스페이스마다 활성 세션에 태스크를 하나씩 제출하되, 동시에 실행되는 태스크는 Concurrency개로 제한합니다.
+// 태스크가 끝나면(OnTaskFinished) 대기 중인 워크스페이스를 이어서 제출하고, 모두 끝나면 통합 보고서를 남깁니다.
+type FanOutService struct {
+	storage  storage.Storage
+	access   *WorkspaceAccessService
+	prompts  *PromptService
+	tasks    FanOutTaskRunner
+	reports  FanOutReportStore
+	listener FanOutListener
+
+	// mu 팬아웃 상태 변경을 직렬화 (태스크 완료와 취소가 겹치지 않도록)
+	mu        sync.Mutex
+	runByTask map[string]string // 실행 중인 태스크 ID -> 팬아웃 ID
+}
+
+// NewFanOutService 새 팬아웃 서비스 생성
+// reports가 nil이면 보고서는 API로만 조회할 수 있습니다.
+func NewFanOutService(storage storage.Storage, prompts *PromptService, tasks FanOutTaskRunner, reports FanOutReportStore) *FanOutService {
+	return &FanOutService{
+		storage:   storage,
+		access:    NewWorkspaceAccessService(storage),
+		prompts:   prompts,
+		tasks:     tasks,
+		reports:   reports,
+		runByTask: make(map[string]string),
+	}
+}
+
+// SetListener 진행 상황 리스너 설정
+func (s *FanOutService) SetListener(listener FanOutListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listener = listener
+}
+
+// Start 팬아웃 시작
+// 모든 워크스페이스에 execute 권한이 있어야 합니다 (admin 제외). 세션을 지정하지 않은 워크스페이스는
+// 가장 최근 활성 세션을 사용하며, 활성 세션이 없으면 해당 워크스페이스만 실패로 기록합니다.
+func (s *FanOutService) Start(ctx context.Context, userID string, admin bool, req *models.FanOutRequest) (*models.FanOut, error) {
+	if (req.Prompt == "") == (req.PromptID == "") {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "prompt와 prompt_id 중 하나만 지정해야 합니다", ErrInvalidRequest)
+	}
+
+	name := strings.TrimSpace(req.Name)
+	command := req.Prompt
+	if req.PromptID != "" {
+		prompt, err := s.prompts.Get(ctx, req.PromptID, userID, admin)
+		if err != nil {
+			return nil, err
+		}
+		if command, err = RenderPrompt(prompt, req.Variables); err != nil {
+			return nil, err
+		}
+		if name == "" {
+			name = prompt.Name
+		}
+	}
+	if name == "" {
+		name = "fan-out"
+	}
+
+	workspaceIDs := make([]string, 0, len(req.WorkspaceIDs))
+	seen := make(map[string]bool, len(req.WorkspaceIDs))
+	for _, id := range req.WorkspaceIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			workspaceIDs = append(workspaceIDs, id)
+		}
+	}
+	for id := range req.Sessions {
+		if !seen[id] {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("세션을 지정한 워크스페이스가 workspace_ids에 없습니다: %s", id), ErrInvalidRequest)
+		}
+	}
+
+	fanOut := &models.FanOut{
+		OwnerID:     userID,
+		Name:        name,
+		Command:     command,
+		PromptID:    req.PromptID,
+		Concurrency: req.Concurrency,
+		TimeoutTier: req.TimeoutTier.OrDefault(),
+		Status:      models.FanOutRunning,
+		Targets:     make([]models.FanOutTarget, 0, len(workspaceIDs)),
+	}
+	if fanOut.Concurrency <= 0 {
+		fanOut.Concurrency = models.DefaultFanOutConcurrency
+	}
+	if fanOut.Concurrency > models.MaxFanOutConcurrency {
+		fanOut.Concurrency = models.MaxFanOutConcurrency
+	}
+
+	for _, id := range workspaceIDs {
+		workspace, err := s.workspace(ctx, id, userID, admin)
+		if err != nil {
+			return nil, err
+		}
+		target := models.FanOutTarget{
+			WorkspaceID:   workspace.ID,
+			WorkspaceName: workspace.Name,
+			Status:        models.FanOutTargetPending,
+		}
+
+		if sessionID := req.Sessions[id]; sessionID != "" {
+			if err := checkWorkspaceSession(ctx, s.storage, workspace.ID, sessionID); err != nil {
+				return nil, err
+			}
+			target.SessionID = sessionID
+		} else {
+			session, err := latestActiveSession(ctx, s.storage, workspace.ID)
+			if err != nil {
+				return nil, NewWorkspaceError(ErrCodeInternal, "세션 조회 실패", err)
+			}
+			if session != nil {
+				target.SessionID = session.ID
+			} else {
+				now := time.Now()
+				target.Status = models.FanOutTargetFailed
+				target.Error = "활성 세션이 없습니다"
+				target.CompletedAt = &now
+			}
+		}
+		fanOut.Targets = append(fanOut.Targets, target)
+	}
+
+	fanOut.RefreshProgress()
+	if err := s.storage.FanOut().Create(ctx, fanOut); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "팬아웃 저장 실패", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.schedule(ctx, fanOut)
+	return fanOut, nil
+}
+
+// Get 팬아웃 조회 (시작한 사용자 또는 admin)
+func (s *FanOutService) Get(ctx context.Context, id, userID string, admin bool) (*models.FanOut, error) {
+	fanOut, err := s.storage.FanOut().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "팬아웃을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "팬아웃 조회 실패", err)
+	}
+	if !admin && fanOut.OwnerID != userID {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "팬아웃을 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	return fanOut, nil
+}
+
+// List 사용자의 팬아웃 조회 (최신순)
+func (s *FanOutService) List(ctx context.Context, userID string) ([]*models.FanOut, error) {
+	fanOuts, err := s.storage.FanOut().ListByOwner(ctx, userID, defaultFanOutListLimit)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "팬아웃 목록 조회 실패", err)
+	}
+	return fanOuts, nil
+}
+
+// Cancel 실행 중인 팬아웃 취소
+// 대기 중인 워크스페이스는 제출하지 않고, 실행 중인 태스크는 취소합니다. 이미 끝난 결과는 보고서에 남습니다.
+func (s *FanOutService) Cancel(ctx context.Context, id, userID string, admin bool) (*models.FanOut, error) {
+	s.mu.Lock()
+	fanOut, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	if fanOut.Status != models.FanOutRunning {
+		s.mu.Unlock()
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, fmt.Sprintf("실행 중인 팬아웃만 취소할 수 있습니다 (상태: %s)", fanOut.Status), ErrInvalidRequest)
+	}
+
+	var taskIDs []string
+	now := time.Now()
+	for i := range fanOut.Targets {
+		target := &fanOut.Targets[i]
+		switch target.Status {
+		case models.FanOutTargetRunning:
+			taskIDs = append(taskIDs, target.TaskID)
+			delete(s.runByTask, target.TaskID)
+		case models.FanOutTargetPending:
+		default:
+			continue
+		}
+		target.Status = models.FanOutTargetCancelled
+		target.CompletedAt = &now
+	}
+	s.finish(ctx, fanOut, models.FanOutCancelled)
+	s.mu.Unlock()
+
+	// 태스크 취소는 종료 알림을 바로 호출할 수 있으므로 잠금 밖에서 수행
+	for _, taskID := range taskIDs {
+		if err := s.tasks.Cancel(ctx, taskID); err != nil {
+			log.Printf("팬아웃 태스크 취소 실패: %s: %v", taskID, err)
+		}
+	}
+	return fanOut, nil
+}
+
+// Report 워크스페이스별 결과를 모은 마크다운 보고서 (실행 중이면 현재까지의 결과)
+func (s *FanOutService) Report(ctx context.Context, id, userID string, admin bool) (string, error) {
+	fanOut, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return "", err
+	}
+	return buildFanOutReport(fanOut), nil
+}
+
+// OnTaskFinished 워크스페이스 태스크 종료 처리 (TaskFinishListener)
+// 결과를 기록하고 대기 중인 워크스페이스를 이어서 제출합니다.
+func (s *FanOutService) OnTaskFinished(task *models.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fanOutID, ok := s.runByTask[task.ID]
+	if !ok {
+		return
+	}
+	delete(s.runByTask, task.ID)
+
+	ctx := context.Background()
+	fanOut, err := s.storage.FanOut().GetByID(ctx, fanOutID)
+	if err != nil {
+		log.Printf("팬아웃 조회 실패: %s: %v", fanOutID, err)
+		return
+	}
+	if fanOut.Status != models.FanOutRunning {
+		return
+	}
+
+	for i := range fanOut.Targets {
+		target := &fanOut.Targets[i]
+		if target.TaskID != task.ID || target.Status != models.FanOutTargetRunning {
+			continue
+		}
+		now := time.Now()
+		target.CompletedAt = &now
+		target.Output = truncateOutput(task.Output, models.FanOutOutputMaxBytes)
+		switch task.Status {
+		case models.TaskCompleted:
+			target.Status = models.FanOutTargetSucceeded
+		case models.TaskCancelled:
+			target.Status = models.FanOutTargetCancelled
+		default:
+			target.Status = models.FanOutTargetFailed
+			target.Error = task.Error
+			if target.Error == "" {
+				target.Error = fmt.Sprintf("태스크 상태: %s", task.Status)
+			}
+		}
+		break
+	}
+	s.schedule(ctx, fanOut)
+}
+
+// schedule 동시 실행 한도까지 대기 중인 워크스페이스를 제출 (모두 끝났으면 완료 처리)
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *FanOutService) schedule(ctx context.Context, fanOut *models.FanOut) {
+	running := 0
+	for _, target := range fanOut.Targets {
+		if target.Status == models.FanOutTargetRunning {
+			running++
+		}
+	}
+
+	for i := range fanOut.Targets {
+		if running >= fanOut.Concurrency {
+			break
+		}
+		target := &fanOut.Targets[i]
+		if target.Status != models.FanOutTargetPending {
+			continue
+		}
+
+		now := time.Now()
+		target.StartedAt = &now
+		task, err := s.tasks.Create(ctx, &models.TaskCreateRequest{
+			SessionID: target.SessionID,
+			Command:   fanOut.Command,
+			Metadata: map[string]string{
+				"fan_out_id":   fanOut.ID,
+				"workspace_id": target.WorkspaceID,
+			},
+			TimeoutTier: fanOut.TimeoutTier,
+			UserID:      fanOut.OwnerID,
+		})
+		if err != nil {
+			target.Status = models.FanOutTargetFailed
+			target.Error = err.Error()
+			target.CompletedAt = &now
+			continue
+		}
+		target.Status = models.FanOutTargetRunning
+		target.TaskID = task.ID
+		s.runByTask[task.ID] = fanOut.ID
+		running++
+	}
+
+	if running == 0 {
+		s.finish(ctx, fanOut, models.FanOutCompleted)
+		return
+	}
+	s.save(ctx, fanOut)
+}
+
+// finish 팬아웃을 종료 상태로 저장하고 통합 보고서를 아티팩트로 남김
+func (s *FanOutService) finish(ctx context.Context, fanOut *models.FanOut, status models.FanOutStatus) {
+	now := time.Now()
+	fanOut.Status = status
+	fanOut.CompletedAt = &now
+	fanOut.RefreshProgress()
+
+	if s.reports != nil {
+		info, err := s.reports.StoreFanOutReport(ctx, fanOut.OwnerID, fanOut.ID, []byte(buildFanOutReport(fanOut)))
+		if err != nil {
+			log.Printf("팬아웃 보고서 저장 실패: %s: %v", fanOut.ID, err)
+		} else {
+			fanOut.ReportKey = info.Key
+		}
+	}
+	s.save(ctx, fanOut)
+}
+
+// save 진행 상황을 다시 계산해 저장한 뒤 리스너에 알림
+func (s *FanOutService) save(ctx context.Context, fanOut *models.FanOut) {
+	fanOut.RefreshProgress()
+	if err := s.storage.FanOut().Update(ctx, fanOut); err != nil {
+		log.Printf("팬아웃 저장 실패: %s: %v", fanOut.ID, err)
+	}
+	if s.listener != nil {
+		s.listener.OnFanOutUpdate(fanOut)
+	}
+}
+
+// workspace 팬아웃 대상 워크스페이스 조회 (admin이 아니면 execute 권한 확인)
+func (s *FanOutService) workspace(ctx context.Context, id, userID string, admin bool) (*models.Workspace, error) {
+	if !admin {
+		return s.access.Authorize(ctx, id, userID, models.WorkspacePermissionExecute)
+	}
+	workspace, err := s.storage.Workspace().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+	}
+	return workspace, nil
+}
+
+// checkWorkspaceSession 지정한 세션이 워크스페이스에 속한 활성 세션인지 확인
+func checkWorkspaceSession(ctx context.Context, store storage.Storage, workspaceID, sessionID string) error {
+	session, err := store.Session().GetByID(ctx, sessionID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return NewWorkspaceError(ErrCodeNotFound, fmt.Sprintf("세션을 찾을 수 없습니다: %s", sessionID), err)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "세션 조회 실패", err)
+	}
+	project, err := store.Project().GetByID(ctx, session.ProjectID)
+	if err != nil || project.WorkspaceID != workspaceID {
+		return NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("세션 %s는 워크스페이스 %s에 속하지 않습니다", sessionID, workspaceID), ErrInvalidRequest)
+	}
+	if !session.IsActive() {
+		return NewWorkspaceError(ErrCodeInvalidStatus, fmt.Sprintf("활성 세션이 아닙니다: %s (상태: %s)", sessionID, session.Status), ErrInvalidRequest)
+	}
+	return nil
+}
+
+// latestActiveSession 워크스페이스에서 가장 최근에 사용한 활성 세션 (없으면 nil)
+func latestActiveSession(ctx context.Context, store storage.Storage, workspaceID string) (*models.Session, error) {
+	active := true
+	var latest *models.Session
+
+	for page := 1; ; page++ {
+		projects, total, err := store.Project().GetByWorkspaceID(ctx, workspaceID,
+			&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, fmt.Errorf("프로젝트 조회 실패: %w", err)
+		}
+		for _, project := range projects {
+			for sessionPage := 1; ; sessionPage++ {
+				resp, err := store.Session().List(ctx, &models.SessionFilter{ProjectID: project.ID, Active: &active},
+					&models.PaginationRequest{Page: sessionPage, Limit: 100, Sort: "created_at", Order: "asc"})
+				if err != nil {
+					return nil, fmt.Errorf("세션 조회 실패: %w", err)
+				}
+				sessions, _ := resp.Data.([]*models.Session)
+				for _, session := range sessions {
+					if latest == nil || session.LastActive.After(latest.LastActive) {
+						latest = session
+					}
+				}
+				if len(sessions) == 0 || !resp.Meta.HasNext {
+					break
+				}
+			}
+		}
+		if len(projects) == 0 || page*100 >= total {
+			break
+		}
+	}
+	return latest, nil
+}
+
+// buildFanOutReport 워크스페이스별 상태와 출력을 모은 마크다운 보고서
+func buildFanOutReport(fanOut *models.FanOut) string {
+	fanOut.RefreshProgress()
+	progress := fanOut.Progress
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", fanOut.Name)
+	fmt.Fprintf(&b, "- 상태: %s\n", fanOut.Status)
+	fmt.Fprintf(&b, "- 시작: %s\n", fanOut.CreatedAt.UTC().Format(time.RFC3339))
+	if fanOut.CompletedAt != nil {
+		fmt.Fprintf(&b, "- 종료: %s\n", fanOut.CompletedAt.UTC().Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, "- 워크스페이스: %d개 (성공 %d, 실패 %d, 취소 %d, 실행 중 %d, 대기 %d)\n\n",
+		progress.Total, progress.Succeeded, progress.Failed, progress.Cancelled, progress.Running, progress.Pending)
+
+	b.WriteString("## 프롬프트\n\n")
+	writeFencedBlock(&b, fanOut.Command)
+
+	b.WriteString("## 결과\n\n")
+	b.WriteString("| 워크스페이스 | 상태 | 태스크 | 오류 |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, target := range fanOut.Targets {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n",
+			escapeReportCell(target.WorkspaceName), target.Status, target.TaskID, escapeReportCell(target.Error))
+	}
+
+	for _, target := range fanOut.Targets {
+		if target.Output == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "\n### %s (%s)\n\n", target.WorkspaceName, target.WorkspaceID)
+		writeFencedBlock(&b, target.Output)
+	}
+	return b.String()
+}
+
+// writeFencedBlock 본문에 포함된 백틱보다 긴 펜스로 코드 블록 작성
+func writeFencedBlock(b *strings.Builder, text string) {
+	fence := "```"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	fmt.Fprintf(b, "%s\n%s\n%s\n\n", fence, strings.TrimRight(text, "\n"), fence)
+}
+
+// escapeReportCell 표 셀에 들어갈 수 있도록 줄바꿈과 구분자를 치환
+func escapeReportCell(text string) string {
+	return strings.NewReplacer("|", "\\|", "\r", " ", "\n", " ").Replace(text)
+}
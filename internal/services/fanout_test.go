@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// recordingReportStore 저장된 보고서를 기록하는 테스트용 보고서 저장소
+type recordingReportStore struct {
+	reports map[string]string
+}
+
+func (r *recordingReportStore) StoreFanOutReport(ctx context.Context, userID, fanOutID string, data []byte) (*ArtifactInfo, error) {
+	key := "users/" + userID + "/fanouts/" + fanOutID + "/report.md"
+	r.reports[key] = string(data)
+	return &ArtifactInfo{Key: key}, nil
+}
+
+// createWorkspaceWithSession 활성 세션이 하나 있는 워크스페이스 생성
+func createWorkspaceWithSession(t *testing.T, store *memory.Storage, owner, name string) (*models.Workspace, *models.Session) {
+	ws := createOwnedWorkspace(t, store, owner, name)
+	project := &models.Project{WorkspaceID: ws.ID, Name: name, Path: "/tmp/" + name}
+	require.NoError(t, store.Project().Create(context.Background(), project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	session.ID = "session-" + name
+	require.NoError(t, store.Session().Create(context.Background(), session))
+	return ws, session
+}
+
+func newFanOutFixture(t *testing.T) (*FanOutService, *fakePipelineTasks, *recordingReportStore, *memory.Storage) {
+	store := memory.New()
+	tasks := &fakePipelineTasks{}
+	reports := &recordingReportStore{reports: map[string]string{}}
+	service := NewFanOutService(store, NewPromptService(store, tasks), tasks, reports)
+	return service, tasks, reports, store
+}
+
+func TestFanOutService_ConcurrencyAndReport(t *testing.T) {
+	service, tasks, reports, store := newFanOutFixture(t)
+	ctx := context.Background()
+
+	var workspaceIDs []string
+	for _, name := range []string{"api", "web", "worker"} {
+		ws, _ := createWorkspaceWithSession(t, store, "alice", name)
+		workspaceIDs = append(workspaceIDs, ws.ID)
+	}
+	// 활성 세션이 없는 워크스페이스는 실패로 기록되고 나머지는 계속 실행
+	empty := createOwnedWorkspace(t, store, "alice", "docs")
+	workspaceIDs = append(workspaceIDs, empty.ID, workspaceIDs[0])
+
+	fanOut, err := service.Start(ctx, "alice", false, &models.FanOutRequest{
+		Name:         "로깅 라이브러리 교체",
+		WorkspaceIDs: workspaceIDs,
+		Prompt:       "로깅 라이브러리를 zap으로 교체하세요",
+		Concurrency:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, fanOut.Targets, 4)
+	assert.Equal(t, models.FanOutTargetFailed, fanOut.Targets[3].Status)
+	assert.Equal(t, 2, fanOut.Progress.Running)
+	assert.Equal(t, 1, fanOut.Progress.Pending)
+	require.Len(t, tasks.created, 2)
+	assert.Equal(t, "로깅 라이브러리를 zap으로 교체하세요", tasks.created[0].Command)
+
+	// 하나가 끝나면 대기 중인 워크스페이스가 이어서 실행됨
+	tasks.created[0].Status = models.TaskCompleted
+	tasks.created[0].Output = "교체 완료"
+	service.OnTaskFinished(tasks.created[0])
+	require.Len(t, tasks.created, 3)
+
+	tasks.created[1].Status = models.TaskFailed
+	tasks.created[1].Error = "exit status 1"
+	service.OnTaskFinished(tasks.created[1])
+	tasks.created[2].Status = models.TaskCompleted
+	service.OnTaskFinished(tasks.created[2])
+
+	fanOut, err = service.Get(ctx, fanOut.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.FanOutCompleted, fanOut.Status)
+	assert.Equal(t, models.FanOutProgress{Total: 4, Succeeded: 2, Failed: 2, Percent: 100}, fanOut.Progress)
+	assert.Equal(t, "exit status 1", fanOut.Targets[1].Error)
+
+	require.NotEmpty(t, fanOut.ReportKey)
+	report := reports.reports[fanOut.ReportKey]
+	assert.Contains(t, report, "# 로깅 라이브러리 교체")
+	assert.Contains(t, report, "교체 완료")
+	assert.Contains(t, report, "활성 세션이 없습니다")
+
+	_, err = service.Get(ctx, fanOut.ID, "bob", false)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
+
+func TestFanOutService_StartValidation(t *testing.T) {
+	service, tasks, _, store := newFanOutFixture(t)
+	ctx := context.Background()
+
+	ws, _ := createWorkspaceWithSession(t, store, "alice", "api")
+	other, otherSession := createWorkspaceWithSession(t, store, "alice", "web")
+
+	_, err := service.Start(ctx, "alice", false, &models.FanOutRequest{WorkspaceIDs: []string{ws.ID}})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	// 다른 워크스페이스의 세션은 지정할 수 없음
+	_, err = service.Start(ctx, "alice", false, &models.FanOutRequest{
+		WorkspaceIDs: []string{ws.ID, other.ID},
+		Sessions:     map[string]string{ws.ID: otherSession.ID},
+		Prompt:       "ls",
+	})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	// 권한이 없는 워크스페이스가 하나라도 있으면 시작하지 않음
+	_, err = service.Start(ctx, "bob", false, &models.FanOutRequest{WorkspaceIDs: []string{ws.ID}, Prompt: "ls"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+	assert.Empty(t, tasks.created)
+}
+
+func TestFanOutService_Cancel(t *testing.T) {
+	service, tasks, _, store := newFanOutFixture(t)
+	ctx := context.Background()
+
+	var workspaceIDs []string
+	for _, name := range []string{"api", "web", "worker"} {
+		ws, _ := createWorkspaceWithSession(t, store, "alice", name)
+		workspaceIDs = append(workspaceIDs, ws.ID)
+	}
+
+	fanOut, err := service.Start(ctx, "alice", false, &models.FanOutRequest{WorkspaceIDs: workspaceIDs, Prompt: "go test ./...", Concurrency: 1})
+	require.NoError(t, err)
+	taskID := tasks.last().ID
+
+	fanOut, err = service.Cancel(ctx, fanOut.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.FanOutCancelled, fanOut.Status)
+	assert.Equal(t, 3, fanOut.Progress.Cancelled)
+	assert.Equal(t, []string{taskID}, tasks.cancelled)
+
+	// 취소 후 도착한 종료 알림은 무시하고 대기 중이던 워크스페이스도 실행하지 않음
+	tasks.last().Status = models.TaskCancelled
+	service.OnTaskFinished(tasks.last())
+	assert.Len(t, tasks.created, 1)
+
+	_, err = service.Cancel(ctx, fanOut.ID, "alice", false)
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+}
@@ -1,7 +1,9 @@
 package services
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -181,4 +183,192 @@ func (s *GitService) InitRepository(path string) error {
 func (s *GitService) CloneRepository(url, path string) error {
 	cmd := exec.Command("git", "clone", url, path)
 	return cmd.Run()
-}
\ No newline at end of file
+}
+
+// HeadCommit 현재 HEAD 커밋 해시
+func (s *GitService) HeadCommit(path string) (string, error) {
+	return s.run(path, "rev-parse", "HEAD")
+}
+
+// HasChanges 커밋되지 않은 변경(추적하지 않는 파일 포함)이 있는지 확인
+func (s *GitService) HasChanges(path string) (bool, error) {
+	status, err := s.getStatus(path)
+	if err != nil {
+		return false, err
+	}
+	return status.HasChanges, nil
+}
+
+// StageDiff 작업 트리의 모든 변경을 스테이징하고 base 커밋 대비 diff와 변경 파일 목록 반환
+func (s *GitService) StageDiff(path, base string) (string, []string, error) {
+	if _, err := s.run(path, "add", "-A"); err != nil {
+		return "", nil, err
+	}
+	diff, err := s.run(path, "diff", "--cached", "--no-color", base)
+	if err != nil {
+		return "", nil, err
+	}
+	names, err := s.run(path, "diff", "--cached", "--name-only", base)
+	if err != nil {
+		return "", nil, err
+	}
+
+	files := []string{}
+	for _, name := range strings.Split(names, "\n") {
+		if name != "" {
+			files = append(files, name)
+		}
+	}
+	return diff, files, nil
+}
+
+// CommitAll 모든 변경을 커밋하고 커밋 해시 반환
+// 리포지토리에 커밋 작성자가 설정되어 있지 않으면 authorName을 사용합니다.
+func (s *GitService) CommitAll(path, message, authorName string) (string, error) {
+	if _, err := s.run(path, "add", "-A"); err != nil {
+		return "", err
+	}
+
+	args := []string{"commit", "-m", message}
+	if email, _ := s.run(path, "config", "user.email"); email == "" {
+		args = append([]string{"-c", "user.name=" + authorName, "-c", "user.email=" + authorName + "@aicli.local"}, args...)
+	}
+	if _, err := s.run(path, args...); err != nil {
+		return "", err
+	}
+	return s.HeadCommit(path)
+}
+
+// ResetTo 작업 트리를 base 커밋 상태로 되돌림 (추적하지 않는 파일 삭제 포함)
+func (s *GitService) ResetTo(path, base string) error {
+	if _, err := s.run(path, "reset", "--hard", base); err != nil {
+		return err
+	}
+	_, err := s.run(path, "clean", "-fd")
+	return err
+}
+
+// CloneShadow path 리포지토리를 dest에 복제하고 base 커밋을 체크아웃 (드라이런 태스크의 섀도 복사본)
+// 커밋된 내용만 복제하므로 추적하지 않거나 무시된 파일은 없으며, 섀도 복사본에서 원본으로 push하지 못하도록
+// origin 원격을 제거합니다.
+func (s *GitService) CloneShadow(path, base, dest string) error {
+	if _, err := s.run(path, "clone", "--quiet", "--local", "--no-checkout", ".", dest); err != nil {
+		return err
+	}
+	if _, err := s.run(dest, "remote", "remove", "origin"); err != nil {
+		return err
+	}
+	_, err := s.run(dest, "checkout", "--quiet", "--detach", base)
+	return err
+}
+
+// ApplyShadow shadow 작업 트리의 base 커밋 대비 변경(바이너리 포함)을 path 작업 트리에 적용
+// 패치가 깨끗하게 적용되지 않으면 path를 바꾸지 않고 에러를 반환합니다.
+func (s *GitService) ApplyShadow(shadow, path, base string) error {
+	if _, err := s.run(shadow, "add", "-A"); err != nil {
+		return err
+	}
+
+	patch, err := os.CreateTemp("", "aicli-shadow-*.patch")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(patch.Name())
+	defer patch.Close()
+
+	cmd := exec.Command("git", "diff", "--cached", "--binary", base)
+	cmd.Dir = shadow
+	cmd.Stdout = patch
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git diff: %w", err)
+	}
+	if info, err := patch.Stat(); err != nil || info.Size() == 0 {
+		return err
+	}
+
+	_, err = s.run(path, "apply", "--whitespace=nowarn", patch.Name())
+	return err
+}
+
+// BranchCommit 로컬 브랜치가 가리키는 커밋 해시 (브랜치가 없으면 빈 문자열)
+func (s *GitService) BranchCommit(path, branch string) (string, error) {
+	if _, err := s.run(path, "rev-parse", "--git-dir"); err != nil {
+		return "", err
+	}
+	commit, err := s.run(path, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	if err != nil {
+		return "", nil
+	}
+	return commit, nil
+}
+
+// CreateBranch commit을 가리키는 새 브랜치 생성 (현재 브랜치는 바꾸지 않음)
+func (s *GitService) CreateBranch(path, branch, commit string) error {
+	_, err := s.run(path, "branch", branch, commit)
+	return err
+}
+
+// CommitToBranch 작업 트리의 모든 변경을 새 브랜치에 커밋하고 원래 브랜치로 돌아옴
+// 변경 사항은 새 브랜치에만 남고 원래 브랜치의 작업 트리는 깨끗해집니다.
+func (s *GitService) CommitToBranch(path, branch, message, authorName string) (string, error) {
+	original, err := s.getCurrentBranch(path)
+	if err != nil || original == "" {
+		// 분리된 HEAD면 커밋 해시로 돌아옴
+		if original, err = s.HeadCommit(path); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := s.run(path, "checkout", "-b", branch); err != nil {
+		return "", err
+	}
+	commit, commitErr := s.CommitAll(path, message, authorName)
+	if _, err := s.run(path, "checkout", original); err != nil && commitErr == nil {
+		return "", err
+	}
+	return commit, commitErr
+}
+
+// CommitFiles 커밋에서 변경된 파일 목록
+func (s *GitService) CommitFiles(path, commit string) ([]string, error) {
+	names, err := s.run(path, "diff-tree", "--no-commit-id", "--name-only", "-r", "--root", commit)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, name := range strings.Split(names, "\n") {
+		if name != "" {
+			files = append(files, name)
+		}
+	}
+	return files, nil
+}
+
+// Push 브랜치를 원격 URL로 푸시
+// remoteURL에 토큰이 들어 있을 수 있으므로 에러 메시지에서는 토큰을 가립니다.
+func (s *GitService) Push(path, remoteURL, branch string) error {
+	ref := "refs/heads/" + branch
+	_, err := s.run(path, "push", remoteURL, ref+":"+ref)
+	if err != nil {
+		if u, parseErr := url.Parse(remoteURL); parseErr == nil && u.User != nil {
+			if password, ok := u.User.Password(); ok && password != "" {
+				return errors.New(strings.ReplaceAll(err.Error(), password, "***"))
+			}
+		}
+	}
+	return err
+}
+
+// run git 명령 실행 (실패하면 stderr를 에러 메시지에 포함)
+func (s *GitService) run(path string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = path
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("git %s: %s", args[0], strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimRight(string(output), "\n"), nil
+}
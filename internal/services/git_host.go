@@ -0,0 +1,217 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/breaker"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// gitHostStatusContext 커밋 상태 이름 (GitHub context, GitLab name)
+const gitHostStatusContext = "aicli/session"
+
+// GitHostPullRequest 생성할 PR/MR 내용
+type GitHostPullRequest struct {
+	Title  string
+	Body   string
+	Head   string
+	Base   string
+	Draft  bool
+	Labels []string
+}
+
+// GitHostPullRequestResult 생성된 PR/MR
+type GitHostPullRequestResult struct {
+	Number int
+	URL    string
+}
+
+// GitHostClient GitHub/GitLab REST API 클라이언트
+type GitHostClient struct {
+	client   *http.Client
+	breakers *breaker.Registry
+}
+
+// NewGitHostClient 새 GitHub/GitLab API 클라이언트 생성 (client가 nil이면 30초 제한 기본 클라이언트)
+func NewGitHostClient(client *http.Client) *GitHostClient {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &GitHostClient{client: client}
+}
+
+// SetBreakers API 호스트별 회로 차단기 설정 (열린 호스트는 요청하지 않고 바로 실패)
+func (c *GitHostClient) SetBreakers(breakers *breaker.Registry) {
+	c.breakers = breakers
+}
+
+// OpenPullRequest GitHub PR 또는 GitLab MR 생성
+// GitHub 라벨은 PR 생성 후 이슈 API로 붙이며, 라벨을 붙이지 못해도 PR은 생성된 것으로 봅니다.
+func (c *GitHostClient) OpenPullRequest(ctx context.Context, config *models.PullRequestConfig, pr *GitHostPullRequest) (*GitHostPullRequestResult, error) {
+	if config.Provider == models.WebhookProviderGitLab {
+		title := pr.Title
+		if pr.Draft {
+			title = "Draft: " + title
+		}
+		var created struct {
+			IID    int    `json:"iid"`
+			WebURL string `json:"web_url"`
+		}
+		err := c.do(ctx, config, http.MethodPost, "/projects/"+url.PathEscape(config.Repository)+"/merge_requests", map[string]interface{}{
+			"source_branch":        pr.Head,
+			"target_branch":        pr.Base,
+			"title":                title,
+			"description":          pr.Body,
+			"labels":               strings.Join(pr.Labels, ","),
+			"remove_source_branch": true,
+		}, &created)
+		if err != nil {
+			return nil, err
+		}
+		return &GitHostPullRequestResult{Number: created.IID, URL: created.WebURL}, nil
+	}
+
+	var created struct {
+		Number  int    `json:"number"`
+		HTMLURL string `json:"html_url"`
+	}
+	err := c.do(ctx, config, http.MethodPost, "/repos/"+config.Repository+"/pulls", map[string]interface{}{
+		"title": pr.Title,
+		"head":  pr.Head,
+		"base":  pr.Base,
+		"body":  pr.Body,
+		"draft": pr.Draft,
+	}, &created)
+	if err != nil {
+		return nil, err
+	}
+	result := &GitHostPullRequestResult{Number: created.Number, URL: created.HTMLURL}
+	if len(pr.Labels) > 0 {
+		path := fmt.Sprintf("/repos/%s/issues/%d/labels", config.Repository, created.Number)
+		if err := c.do(ctx, config, http.MethodPost, path, map[string]interface{}{"labels": pr.Labels}, nil); err != nil {
+			return result, fmt.Errorf("PR은 생성했지만 라벨을 붙이지 못했습니다: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// SetCommitStatus 커밋에 세션 기록 링크를 상태로 남김
+func (c *GitHostClient) SetCommitStatus(ctx context.Context, config *models.PullRequestConfig, commit, targetURL, description string) error {
+	if config.Provider == models.WebhookProviderGitLab {
+		return c.do(ctx, config, http.MethodPost, "/projects/"+url.PathEscape(config.Repository)+"/statuses/"+commit, map[string]interface{}{
+			"state":       "success",
+			"name":        gitHostStatusContext,
+			"target_url":  targetURL,
+			"description": description,
+		}, nil)
+	}
+	return c.do(ctx, config, http.MethodPost, "/repos/"+config.Repository+"/statuses/"+commit, map[string]interface{}{
+		"state":       "success",
+		"context":     gitHostStatusContext,
+		"target_url":  targetURL,
+		"description": description,
+	}, nil)
+}
+
+// do API 요청 (2xx가 아니면 응답의 message를 에러에 포함)
+func (c *GitHostClient) do(ctx context.Context, config *models.PullRequestConfig, method, path string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(config.ResolvedAPIURL(), "/")+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if config.Provider == models.WebhookProviderGitLab {
+		req.Header.Set("PRIVATE-TOKEN", config.Token)
+	} else {
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("Authorization", "Bearer "+config.Token)
+	}
+
+	done, err := c.breakers.Get(hostBreakerName("git_host", config.ResolvedAPIURL())).Allow(ctx)
+	if err != nil {
+		return fmt.Errorf("%s API 요청 실패: %w", config.Provider, err)
+	}
+	resp, err := c.client.Do(req)
+	done(hostFailure(resp, err))
+	if err != nil {
+		return fmt.Errorf("%s API 요청 실패: %w", config.Provider, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("%s API 응답 읽기 실패: %w", config.Provider, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message json.RawMessage `json:"message"`
+		}
+		message := strings.TrimSpace(string(data))
+		if json.Unmarshal(data, &apiErr) == nil && len(apiErr.Message) > 0 {
+			message = strings.Trim(string(apiErr.Message), `"`)
+		}
+		return fmt.Errorf("%s API 오류 (%d): %s", config.Provider, resp.StatusCode, truncateOutput(message, 500))
+	}
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("%s API 응답 해석 실패: %w", config.Provider, err)
+		}
+	}
+	return nil
+}
+
+// hostBreakerName 외부 API 호스트의 회로 차단기 이름 (예: git_host:api.github.com)
+func hostBreakerName(kind, apiURL string) string {
+	host := apiURL
+	if parsed, err := url.Parse(apiURL); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+	return kind + ":" + host
+}
+
+// hostFailure 회로 차단기에 실패로 기록할 결과 (연결 실패와 5xx 응답, 4xx는 요청 문제로 보고 제외)
+func hostFailure(resp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// gitHostPushURL 토큰으로 인증하는 HTTPS 푸시 주소
+// API 주소의 호스트를 사용하며, 공용 GitHub(api.github.com)는 github.com으로 바꿉니다.
+func gitHostPushURL(config *models.PullRequestConfig) (string, error) {
+	api, err := url.Parse(config.ResolvedAPIURL())
+	if err != nil || api.Host == "" {
+		return "", fmt.Errorf("API 주소가 올바르지 않습니다: %s", config.APIURL)
+	}
+	host := api.Host
+	if host == "api.github.com" {
+		host = "github.com"
+	}
+	user := "x-access-token"
+	if config.Provider == models.WebhookProviderGitLab {
+		user = "oauth2"
+	}
+	push := url.URL{
+		Scheme: api.Scheme,
+		User:   url.UserPassword(user, config.Token),
+		Host:   host,
+		Path:   "/" + config.Repository + ".git",
+	}
+	return push.String(), nil
+}
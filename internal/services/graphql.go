@@ -0,0 +1,466 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aicli/aicli-web/internal/graphql"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// graphqlDefaultLimit 목록 필드의 기본 항목 수
+	graphqlDefaultLimit = 20
+	// graphqlMaxLimit 목록 필드의 최대 항목 수
+	graphqlMaxLimit = 100
+)
+
+// GraphQLViewer GraphQL 요청을 보낸 사용자
+type GraphQLViewer struct {
+	UserID string
+	// Admin 시스템 관리자 (REST 권한 미들웨어와 같이 워크스페이스 권한 확인을 건너뜀)
+	Admin bool
+}
+
+// GraphQLService 대시보드용 GraphQL 게이트웨이
+// 워크스페이스, 프로젝트, 세션, 태스크, 사용량을 한 번의 쿼리로 중첩 조회하고 태스크 이벤트를 구독합니다.
+// 모든 필드는 해당 리소스가 속한 워크스페이스의 ACL 권한으로 확인하며(필드 단위),
+// 권한이 없는 필드는 null과 FORBIDDEN 에러로 응답하고 나머지 필드는 그대로 반환합니다.
+type GraphQLService struct {
+	storage storage.Storage
+	access  *WorkspaceAccessService
+	events  *TaskEventService
+	schema  *graphql.Schema
+}
+
+// NewGraphQLService 새 GraphQL 서비스 생성 (events가 nil이면 구독을 사용할 수 없음)
+func NewGraphQLService(storage storage.Storage, access *WorkspaceAccessService, events *TaskEventService) *GraphQLService {
+	s := &GraphQLService{
+		storage: storage,
+		access:  access,
+		events:  events,
+	}
+	s.schema = s.buildSchema()
+	return s
+}
+
+// Execute 쿼리 실행
+func (s *GraphQLService) Execute(ctx context.Context, viewer GraphQLViewer, req *graphql.Request) *graphql.Result {
+	return graphql.Execute(s.withViewer(ctx, viewer), s.schema, req)
+}
+
+// Subscribe 구독 실행 (이벤트마다 결과를 채널로 전달, 요청이 잘못되면 에러 결과 반환)
+func (s *GraphQLService) Subscribe(ctx context.Context, viewer GraphQLViewer, req *graphql.Request) (<-chan *graphql.Result, *graphql.Result) {
+	return graphql.Subscribe(s.withViewer(ctx, viewer), s.schema, req)
+}
+
+type graphqlRequestKey struct{}
+
+// graphqlRequest 요청 하나의 사용자와 워크스페이스 권한 캐시
+type graphqlRequest struct {
+	viewer      GraphQLViewer
+	mu          sync.Mutex
+	permissions map[string]models.WorkspacePermission
+	projects    map[string]string // 프로젝트 ID → 워크스페이스 ID
+}
+
+func (s *GraphQLService) withViewer(ctx context.Context, viewer GraphQLViewer) context.Context {
+	return context.WithValue(ctx, graphqlRequestKey{}, &graphqlRequest{
+		viewer:      viewer,
+		permissions: make(map[string]models.WorkspacePermission),
+		projects:    make(map[string]string),
+	})
+}
+
+func graphqlRequestFrom(ctx context.Context) *graphqlRequest {
+	req, _ := ctx.Value(graphqlRequestKey{}).(*graphqlRequest)
+	if req == nil {
+		req = &graphqlRequest{permissions: map[string]models.WorkspacePermission{}, projects: map[string]string{}}
+	}
+	return req
+}
+
+// authorize 사용자에게 워크스페이스의 required 권한이 있는지 확인 (요청 안에서 캐시)
+func (s *GraphQLService) authorize(ctx context.Context, workspaceID string, required models.WorkspacePermission) error {
+	req := graphqlRequestFrom(ctx)
+	if req.viewer.Admin {
+		return nil
+	}
+
+	req.mu.Lock()
+	permission, cached := req.permissions[workspaceID]
+	req.mu.Unlock()
+	if !cached {
+		workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+		if err != nil {
+			if storage.IsNotFoundError(err) {
+				return NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+			}
+			return NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+		}
+		if permission, err = s.access.Permission(ctx, workspace, req.viewer.UserID); err != nil {
+			return NewWorkspaceError(ErrCodeInternal, "워크스페이스 권한 확인 실패", err)
+		}
+		req.mu.Lock()
+		req.permissions[workspaceID] = permission
+		req.mu.Unlock()
+	}
+
+	if permission == "" {
+		return NewWorkspaceError(ErrCodeInsufficientPerm, "워크스페이스에 접근할 권한이 없습니다", ErrUnauthorized)
+	}
+	if !permission.Includes(required) {
+		return NewWorkspaceError(ErrCodeInsufficientPerm, "워크스페이스에 대한 "+string(required)+" 권한이 필요합니다", ErrInsufficientPermissions)
+	}
+	return nil
+}
+
+// require 필드 단위 권한 확인 (상위 객체가 속한 워크스페이스 기준)
+func (s *GraphQLService) require(required models.WorkspacePermission) graphql.AuthorizeFunc {
+	return func(p graphql.ResolveParams) error {
+		workspaceID := graphqlScope(p.Source)
+		if workspaceID == "" {
+			return nil
+		}
+		return graphqlError(s.authorize(p.Context, workspaceID, required))
+	}
+}
+
+// gqlSession 워크스페이스 정보를 붙인 세션
+type gqlSession struct {
+	*models.Session
+	workspaceID string
+}
+
+// gqlTask 워크스페이스 정보를 붙인 태스크
+type gqlTask struct {
+	*models.Task
+	workspaceID string
+}
+
+// gqlTaskEvent 워크스페이스 정보를 붙인 태스크 이벤트
+type gqlTaskEvent struct {
+	*models.TaskEvent
+	workspaceID string
+}
+
+// graphqlScope 필드 권한 확인에 쓸 워크스페이스 ID (워크스페이스에 속하지 않는 값이면 빈 문자열)
+func graphqlScope(source interface{}) string {
+	switch v := source.(type) {
+	case *models.Workspace:
+		return v.ID
+	case *models.Project:
+		return v.WorkspaceID
+	case *gqlSession:
+		return v.workspaceID
+	case *gqlTask:
+		return v.workspaceID
+	case *gqlTaskEvent:
+		return v.workspaceID
+	case *models.WorkspaceACLEntry:
+		return v.WorkspaceID
+	}
+	return ""
+}
+
+// graphqlError 서비스 에러를 GraphQL 에러로 변환 (extensions.code에 에러 코드)
+func graphqlError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if wsErr, ok := err.(*WorkspaceError); ok {
+		code := wsErr.Code
+		switch code {
+		case ErrCodeInsufficientPerm, ErrCodeUnauthorized, ErrCodeOwnershipRequired:
+			code = "FORBIDDEN"
+		case ErrCodeInvalidRequest:
+			code = "BAD_REQUEST"
+		}
+		return &graphql.Error{Message: wsErr.Message, Extensions: map[string]interface{}{"code": code}}
+	}
+	return &graphql.Error{Message: "요청 처리 중 오류가 발생했습니다", Extensions: map[string]interface{}{"code": ErrCodeInternal}}
+}
+
+// projectWorkspaceID 프로젝트가 속한 워크스페이스 ID (요청 안에서 캐시)
+func (s *GraphQLService) projectWorkspaceID(ctx context.Context, projectID string) (string, error) {
+	req := graphqlRequestFrom(ctx)
+	req.mu.Lock()
+	workspaceID, cached := req.projects[projectID]
+	req.mu.Unlock()
+	if cached {
+		return workspaceID, nil
+	}
+
+	project, err := s.storage.Project().GetByID(ctx, projectID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return "", NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+		}
+		return "", NewWorkspaceError(ErrCodeInternal, "프로젝트 조회 실패", err)
+	}
+	req.mu.Lock()
+	req.projects[projectID] = project.WorkspaceID
+	req.mu.Unlock()
+	return project.WorkspaceID, nil
+}
+
+// loadSession 세션을 조회해 워크스페이스 정보를 붙이고 read 권한 확인
+func (s *GraphQLService) loadSession(ctx context.Context, sessionID string) (*gqlSession, error) {
+	session, err := s.storage.Session().GetByID(ctx, sessionID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "세션 조회 실패", err)
+	}
+	workspaceID, err := s.projectWorkspaceID(ctx, session.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, workspaceID, models.WorkspacePermissionRead); err != nil {
+		return nil, err
+	}
+	return &gqlSession{Session: session, workspaceID: workspaceID}, nil
+}
+
+// loadTask 태스크를 조회해 워크스페이스 정보를 붙이고 read 권한 확인
+func (s *GraphQLService) loadTask(ctx context.Context, taskID string) (*gqlTask, error) {
+	task, err := s.storage.Task().GetByID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+	}
+	session, err := s.loadSession(ctx, task.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlTask{Task: task, workspaceID: session.workspaceID}, nil
+}
+
+// workspaces 사용자가 소유하거나 공유받은 워크스페이스 (관리자도 본인 기준)
+func (s *GraphQLService) workspaces(ctx context.Context, limit int) ([]*models.Workspace, error) {
+	req := graphqlRequestFrom(ctx)
+	ids, err := s.access.AccessibleWorkspaceIDs(ctx, req.viewer.UserID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 목록 조회 실패", err)
+	}
+
+	workspaces := []*models.Workspace{}
+	for _, id := range ids {
+		if len(workspaces) >= limit {
+			break
+		}
+		workspace, err := s.storage.Workspace().GetByID(ctx, id)
+		if err != nil {
+			if storage.IsNotFoundError(err) {
+				continue
+			}
+			return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+		}
+		workspace.MaskClaudeKey()
+		workspaces = append(workspaces, workspace)
+	}
+	return workspaces, nil
+}
+
+// projects 워크스페이스의 프로젝트 (생성 순)
+func (s *GraphQLService) projects(ctx context.Context, workspaceID string, limit int) ([]*models.Project, error) {
+	projects, _, err := s.storage.Project().GetByWorkspaceID(ctx, workspaceID,
+		&models.PaginationRequest{Page: 1, Limit: limit, Sort: "created_at", Order: "asc"})
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "프로젝트 조회 실패", err)
+	}
+	for _, project := range projects {
+		project.Config.ClaudeAPIKey = ""
+		project.Config.EncryptedAPIKey = ""
+	}
+	return projects, nil
+}
+
+// sessions 프로젝트들의 세션을 최신순으로 limit개까지 조회
+func (s *GraphQLService) sessions(ctx context.Context, workspaceID string, projectIDs []string, status models.SessionStatus, limit int) ([]*gqlSession, error) {
+	var sessions []*gqlSession
+	for _, projectID := range projectIDs {
+		resp, err := s.storage.Session().List(ctx, &models.SessionFilter{ProjectID: projectID, Status: status},
+			&models.PaginationRequest{Page: 1, Limit: limit, Sort: "created_at", Order: "desc"})
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "세션 조회 실패", err)
+		}
+		items, _ := resp.Data.([]*models.Session)
+		for _, session := range items {
+			sessions = append(sessions, &gqlSession{Session: session, workspaceID: workspaceID})
+		}
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+	if len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+	return sessions, nil
+}
+
+// tasks 세션의 태스크를 최신순으로 limit개까지 조회
+func (s *GraphQLService) tasks(ctx context.Context, session *gqlSession, status models.TaskStatus, limit int) ([]*gqlTask, error) {
+	filter := &models.TaskFilter{SessionID: &session.ID}
+	if status != "" {
+		filter.Status = &status
+	}
+	tasks, _, err := s.storage.Task().List(ctx, filter, &models.PaginationRequest{Page: 1, Limit: limit, Sort: "created_at", Order: "desc"})
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+	}
+	result := make([]*gqlTask, len(tasks))
+	for i, task := range tasks {
+		result[i] = &gqlTask{Task: task, workspaceID: session.workspaceID}
+	}
+	return result, nil
+}
+
+// workspaceProjectIDs 워크스페이스의 모든 프로젝트 ID
+func (s *GraphQLService) workspaceProjectIDs(ctx context.Context, workspaceID string) ([]string, error) {
+	var ids []string
+	for page := 1; ; page++ {
+		projects, total, err := s.storage.Project().GetByWorkspaceID(ctx, workspaceID,
+			&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "프로젝트 조회 실패", err)
+		}
+		for _, project := range projects {
+			ids = append(ids, project.ID)
+		}
+		if len(projects) == 0 || len(ids) >= total {
+			return ids, nil
+		}
+	}
+}
+
+// GraphQLUsage 워크스페이스 또는 세션의 사용량 집계
+type GraphQLUsage struct {
+	Sessions       int
+	ActiveSessions int
+	Tasks          int
+	RunningTasks   int
+	CompletedTasks int
+	FailedTasks    int
+	Commands       int64
+	BytesIn        int64
+	BytesOut       int64
+	Errors         int64
+	TaskDurationMs int64
+}
+
+// addSession 세션 하나의 통계와 태스크를 사용량에 더함
+func (s *GraphQLService) addSession(ctx context.Context, usage *GraphQLUsage, session *models.Session) error {
+	usage.Sessions++
+	if session.IsActive() {
+		usage.ActiveSessions++
+	}
+	usage.Commands += session.CommandCount
+	usage.BytesIn += session.BytesIn
+	usage.BytesOut += session.BytesOut
+	usage.Errors += session.ErrorCount
+
+	counted := 0
+	for page := 1; ; page++ {
+		tasks, total, err := s.storage.Task().GetBySessionID(ctx, session.ID,
+			&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+		}
+		for _, task := range tasks {
+			usage.Tasks++
+			usage.TaskDurationMs += task.Duration
+			switch task.Status {
+			case models.TaskRunning:
+				usage.RunningTasks++
+			case models.TaskCompleted:
+				usage.CompletedTasks++
+			case models.TaskFailed:
+				usage.FailedTasks++
+			}
+		}
+		counted += len(tasks)
+		if len(tasks) == 0 || counted >= total {
+			return nil
+		}
+	}
+}
+
+// workspaceUsage 워크스페이스의 모든 세션과 태스크 사용량
+func (s *GraphQLService) workspaceUsage(ctx context.Context, usage *GraphQLUsage, workspaceID string) error {
+	projectIDs, err := s.workspaceProjectIDs(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+	for _, projectID := range projectIDs {
+		for page := 1; ; page++ {
+			resp, err := s.storage.Session().List(ctx, &models.SessionFilter{ProjectID: projectID},
+				&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+			if err != nil {
+				return NewWorkspaceError(ErrCodeInternal, "세션 조회 실패", err)
+			}
+			sessions, _ := resp.Data.([]*models.Session)
+			for _, session := range sessions {
+				if err := s.addSession(ctx, usage, session); err != nil {
+					return err
+				}
+			}
+			if len(sessions) == 0 || !resp.Meta.HasNext {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// subscribeTaskEvents 태스크 이벤트 스트림을 열어 since 이후 이벤트를 전달 (태스크가 끝나면 닫힘)
+func (s *GraphQLService) subscribeTaskEvents(ctx context.Context, taskID string, since int64) (<-chan interface{}, error) {
+	if s.events == nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 이벤트 스트림을 사용할 수 없습니다", nil)
+	}
+	if since < 0 {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "since는 0 이상이어야 합니다", ErrInvalidRequest)
+	}
+	task, err := s.loadTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		cursor := since
+		for {
+			resp, err := s.events.Poll(ctx, task.ID, cursor, MaxTaskEventWait)
+			if err != nil {
+				return
+			}
+			for _, event := range resp.Events {
+				select {
+				case out <- &gqlTaskEvent{TaskEvent: event, workspaceID: task.workspaceID}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			cursor = resp.Cursor
+			if resp.Done || ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// graphqlLimit limit 인자 확인
+func graphqlLimit(args map[string]interface{}) (int, error) {
+	limit, _ := args["limit"].(int)
+	if limit < 1 || limit > graphqlMaxLimit {
+		return 0, NewWorkspaceError(ErrCodeInvalidRequest, "limit는 1에서 100 사이여야 합니다", ErrInvalidRequest)
+	}
+	return limit, nil
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/graphql"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// graphqlDateTime RFC 3339 시간 스칼라
+var graphqlDateTime = &graphql.Scalar{
+	Name: "DateTime",
+	Serialize: func(value interface{}) (interface{}, error) {
+		switch v := value.(type) {
+		case time.Time:
+			return v.UTC().Format(time.RFC3339Nano), nil
+		case *time.Time:
+			return v.UTC().Format(time.RFC3339Nano), nil
+		}
+		return nil, fmt.Errorf("DateTime 값이 아닙니다: %v", value)
+	},
+	ParseValue: func(value interface{}) (interface{}, error) {
+		if s, ok := value.(string); ok {
+			return time.Parse(time.RFC3339, s)
+		}
+		return nil, fmt.Errorf("DateTime 값이 아닙니다: %v", value)
+	},
+}
+
+var (
+	graphqlWorkspaceStatus = &graphql.Enum{Name: "WorkspaceStatus", Values: []string{
+		string(models.WorkspaceStatusActive), string(models.WorkspaceStatusInactive), string(models.WorkspaceStatusArchived),
+	}}
+	graphqlSessionStatus = &graphql.Enum{Name: "SessionStatus", Values: []string{
+		string(models.SessionPending), string(models.SessionActive), string(models.SessionIdle),
+		string(models.SessionEnding), string(models.SessionEnded), string(models.SessionError),
+	}}
+	graphqlTaskStatus = &graphql.Enum{Name: "TaskStatus", Values: []string{
+		string(models.TaskPending), string(models.TaskRunning), string(models.TaskCompleted),
+		string(models.TaskFailed), string(models.TaskCancelled),
+	}}
+	graphqlPermission = &graphql.Enum{Name: "WorkspacePermission", Values: []string{
+		string(models.WorkspacePermissionRead), string(models.WorkspacePermissionExecute),
+		string(models.WorkspacePermissionAdmin), string(models.WorkspacePermissionOwner),
+	}}
+	graphqlTaskEventType = &graphql.Enum{Name: "TaskEventType", Values: []string{
+		string(models.TaskEventStatus), string(models.TaskEventOutput),
+	}}
+)
+
+// gqlField 상위 객체 값에서 읽는 필드 (상위 객체가 속한 워크스페이스의 read 권한 필요)
+func gqlField[T any](s *GraphQLService, t graphql.Type, get func(T) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type:      t,
+		Authorize: s.require(models.WorkspacePermissionRead),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			source, ok := p.Source.(T)
+			if !ok {
+				return nil, nil
+			}
+			return get(source), nil
+		},
+	}
+}
+
+// gqlResolver 조회가 필요한 필드 (required 권한 확인 후 resolve 호출, 서비스 에러는 GraphQL 에러로 변환)
+func gqlResolver[T any](s *GraphQLService, t graphql.Type, required models.WorkspacePermission, args map[string]*graphql.Argument, resolve func(p graphql.ResolveParams, source T) (interface{}, error)) *graphql.Field {
+	return &graphql.Field{
+		Type:      t,
+		Args:      args,
+		Authorize: s.require(required),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			source, ok := p.Source.(T)
+			if !ok {
+				return nil, nil
+			}
+			value, err := resolve(p, source)
+			return value, graphqlError(err)
+		},
+	}
+}
+
+// graphqlUsageFields 사용량 객체 필드 (상위 객체에서 권한을 확인하므로 필드 권한 확인 없음)
+func graphqlUsageFields() map[string]*graphql.Field {
+	usage := func(t graphql.Type, get func(u *GraphQLUsage) interface{}) *graphql.Field {
+		return &graphql.Field{Type: t, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return get(p.Source.(*GraphQLUsage)), nil
+		}}
+	}
+	nonNullInt := graphql.NewNonNull(graphql.Int)
+	return map[string]*graphql.Field{
+		"sessions":       usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.Sessions }),
+		"activeSessions": usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.ActiveSessions }),
+		"tasks":          usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.Tasks }),
+		"runningTasks":   usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.RunningTasks }),
+		"completedTasks": usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.CompletedTasks }),
+		"failedTasks":    usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.FailedTasks }),
+		"commands":       usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.Commands }),
+		"bytesIn":        usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.BytesIn }),
+		"bytesOut":       usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.BytesOut }),
+		"errors":         usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.Errors }),
+		"taskDurationMs": usage(nonNullInt, func(u *GraphQLUsage) interface{} { return u.TaskDurationMs }),
+	}
+}
+
+// buildSchema GraphQL 스키마 구성
+//
+//	type Query {
+//	  workspaces(limit: Int = 20): [Workspace!]!
+//	  workspace(id: ID!): Workspace
+//	  session(id: ID!): Session
+//	  task(id: ID!): Task
+//	  usage: Usage!                      # 접근 가능한 모든 워크스페이스 합계
+//	}
+//	type Subscription {
+//	  taskEvents(taskId: ID!, since: Int = 0): TaskEvent!
+//	}
+//
+// Workspace.acl은 admin 권한, 그 외 필드는 read 권한이 필요합니다.
+func (s *GraphQLService) buildSchema() *graphql.Schema {
+	nonNull := graphql.NewNonNull
+	list := func(t graphql.Type) graphql.Type { return nonNull(graphql.NewList(nonNull(t))) }
+	read := models.WorkspacePermissionRead
+	limitArg := func() *graphql.Argument {
+		return &graphql.Argument{Type: graphql.Int, DefaultValue: graphqlDefaultLimit, Description: "최대 항목 수 (1~100)"}
+	}
+
+	usageType := &graphql.Object{Name: "Usage", Description: "세션/태스크 사용량 집계", Fields: graphqlUsageFields()}
+	workspaceType := &graphql.Object{Name: "Workspace"}
+	projectType := &graphql.Object{Name: "Project"}
+	sessionType := &graphql.Object{Name: "Session"}
+	taskType := &graphql.Object{Name: "Task"}
+	aclEntryType := &graphql.Object{Name: "WorkspaceACLEntry"}
+	taskEventType := &graphql.Object{Name: "TaskEvent"}
+
+	workspaceType.Fields = map[string]*graphql.Field{
+		"id":          gqlField(s, nonNull(graphql.ID), func(w *models.Workspace) interface{} { return w.ID }),
+		"name":        gqlField(s, nonNull(graphql.String), func(w *models.Workspace) interface{} { return w.Name }),
+		"projectPath": gqlField(s, nonNull(graphql.String), func(w *models.Workspace) interface{} { return w.ProjectPath }),
+		"status":      gqlField(s, nonNull(graphqlWorkspaceStatus), func(w *models.Workspace) interface{} { return w.Status }),
+		"ownerId":     gqlField(s, nonNull(graphql.ID), func(w *models.Workspace) interface{} { return w.OwnerID }),
+		"activeTasks": gqlField(s, nonNull(graphql.Int), func(w *models.Workspace) interface{} { return w.ActiveTasks }),
+		"createdAt":   gqlField(s, nonNull(graphqlDateTime), func(w *models.Workspace) interface{} { return w.CreatedAt }),
+		"updatedAt":   gqlField(s, nonNull(graphqlDateTime), func(w *models.Workspace) interface{} { return w.UpdatedAt }),
+		"permission": gqlResolver(s, nonNull(graphqlPermission), read, nil, func(p graphql.ResolveParams, w *models.Workspace) (interface{}, error) {
+			req := graphqlRequestFrom(p.Context)
+			if req.viewer.Admin && w.OwnerID != req.viewer.UserID {
+				return models.WorkspacePermissionAdmin, nil
+			}
+			return s.access.Permission(p.Context, w, req.viewer.UserID)
+		}),
+		"projects": gqlResolver(s, list(projectType), read, map[string]*graphql.Argument{"limit": limitArg()},
+			func(p graphql.ResolveParams, w *models.Workspace) (interface{}, error) {
+				limit, err := graphqlLimit(p.Args)
+				if err != nil {
+					return nil, err
+				}
+				return s.projects(p.Context, w.ID, limit)
+			}),
+		"sessions": gqlResolver(s, list(sessionType), read, map[string]*graphql.Argument{
+			"status": {Type: graphqlSessionStatus},
+			"limit":  limitArg(),
+		}, func(p graphql.ResolveParams, w *models.Workspace) (interface{}, error) {
+			limit, err := graphqlLimit(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			projectIDs, err := s.workspaceProjectIDs(p.Context, w.ID)
+			if err != nil {
+				return nil, err
+			}
+			status, _ := p.Args["status"].(string)
+			return s.sessions(p.Context, w.ID, projectIDs, models.SessionStatus(status), limit)
+		}),
+		"usage": gqlResolver(s, nonNull(usageType), read, nil, func(p graphql.ResolveParams, w *models.Workspace) (interface{}, error) {
+			usage := &GraphQLUsage{}
+			return usage, s.workspaceUsage(p.Context, usage, w.ID)
+		}),
+		// 권한이 없으면 워크스페이스 전체가 아니라 이 필드만 null이 되도록 nullable
+		"acl": gqlResolver(s, graphql.NewList(nonNull(aclEntryType)), models.WorkspacePermissionAdmin, nil, func(p graphql.ResolveParams, w *models.Workspace) (interface{}, error) {
+			return s.storage.WorkspaceACL().ListByWorkspace(p.Context, w.ID)
+		}),
+	}
+
+	projectType.Fields = map[string]*graphql.Field{
+		"id":          gqlField(s, nonNull(graphql.ID), func(pr *models.Project) interface{} { return pr.ID }),
+		"workspaceId": gqlField(s, nonNull(graphql.ID), func(pr *models.Project) interface{} { return pr.WorkspaceID }),
+		"name":        gqlField(s, nonNull(graphql.String), func(pr *models.Project) interface{} { return pr.Name }),
+		"path":        gqlField(s, nonNull(graphql.String), func(pr *models.Project) interface{} { return pr.Path }),
+		"description": gqlField(s, graphql.String, func(pr *models.Project) interface{} { return pr.Description }),
+		"gitUrl":      gqlField(s, graphql.String, func(pr *models.Project) interface{} { return pr.GitURL }),
+		"gitBranch":   gqlField(s, graphql.String, func(pr *models.Project) interface{} { return pr.GitBranch }),
+		"language":    gqlField(s, graphql.String, func(pr *models.Project) interface{} { return pr.Language }),
+		"status":      gqlField(s, nonNull(graphql.String), func(pr *models.Project) interface{} { return string(pr.Status) }),
+		"createdAt":   gqlField(s, nonNull(graphqlDateTime), func(pr *models.Project) interface{} { return pr.CreatedAt }),
+		"sessions": gqlResolver(s, list(sessionType), read, map[string]*graphql.Argument{
+			"status": {Type: graphqlSessionStatus},
+			"limit":  limitArg(),
+		}, func(p graphql.ResolveParams, pr *models.Project) (interface{}, error) {
+			limit, err := graphqlLimit(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			status, _ := p.Args["status"].(string)
+			return s.sessions(p.Context, pr.WorkspaceID, []string{pr.ID}, models.SessionStatus(status), limit)
+		}),
+	}
+
+	sessionType.Fields = map[string]*graphql.Field{
+		"id":           gqlField(s, nonNull(graphql.ID), func(ss *gqlSession) interface{} { return ss.ID }),
+		"projectId":    gqlField(s, nonNull(graphql.ID), func(ss *gqlSession) interface{} { return ss.ProjectID }),
+		"workspaceId":  gqlField(s, nonNull(graphql.ID), func(ss *gqlSession) interface{} { return ss.workspaceID }),
+		"status":       gqlField(s, nonNull(graphqlSessionStatus), func(ss *gqlSession) interface{} { return ss.Status }),
+		"startedAt":    gqlField(s, graphqlDateTime, func(ss *gqlSession) interface{} { return ss.StartedAt }),
+		"endedAt":      gqlField(s, graphqlDateTime, func(ss *gqlSession) interface{} { return ss.EndedAt }),
+		"lastActive":   gqlField(s, nonNull(graphqlDateTime), func(ss *gqlSession) interface{} { return ss.LastActive }),
+		"commandCount": gqlField(s, nonNull(graphql.Int), func(ss *gqlSession) interface{} { return ss.CommandCount }),
+		"bytesIn":      gqlField(s, nonNull(graphql.Int), func(ss *gqlSession) interface{} { return ss.BytesIn }),
+		"bytesOut":     gqlField(s, nonNull(graphql.Int), func(ss *gqlSession) interface{} { return ss.BytesOut }),
+		"errorCount":   gqlField(s, nonNull(graphql.Int), func(ss *gqlSession) interface{} { return ss.ErrorCount }),
+		"createdAt":    gqlField(s, nonNull(graphqlDateTime), func(ss *gqlSession) interface{} { return ss.CreatedAt }),
+		"project": gqlResolver(s, projectType, read, nil, func(p graphql.ResolveParams, ss *gqlSession) (interface{}, error) {
+			project, err := s.storage.Project().GetByID(p.Context, ss.ProjectID)
+			if err != nil {
+				return nil, NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+			}
+			project.Config.ClaudeAPIKey = ""
+			project.Config.EncryptedAPIKey = ""
+			return project, nil
+		}),
+		"tasks": gqlResolver(s, list(taskType), read, map[string]*graphql.Argument{
+			"status": {Type: graphqlTaskStatus},
+			"limit":  limitArg(),
+		}, func(p graphql.ResolveParams, ss *gqlSession) (interface{}, error) {
+			limit, err := graphqlLimit(p.Args)
+			if err != nil {
+				return nil, err
+			}
+			status, _ := p.Args["status"].(string)
+			return s.tasks(p.Context, ss, models.TaskStatus(status), limit)
+		}),
+		"usage": gqlResolver(s, nonNull(usageType), read, nil, func(p graphql.ResolveParams, ss *gqlSession) (interface{}, error) {
+			usage := &GraphQLUsage{}
+			return usage, s.addSession(p.Context, usage, ss.Session)
+		}),
+	}
+
+	taskType.Fields = map[string]*graphql.Field{
+		"id":          gqlField(s, nonNull(graphql.ID), func(t *gqlTask) interface{} { return t.ID }),
+		"sessionId":   gqlField(s, nonNull(graphql.ID), func(t *gqlTask) interface{} { return t.SessionID }),
+		"command":     gqlField(s, nonNull(graphql.String), func(t *gqlTask) interface{} { return t.Command }),
+		"status":      gqlField(s, nonNull(graphqlTaskStatus), func(t *gqlTask) interface{} { return t.Status }),
+		"output":      gqlField(s, graphql.String, func(t *gqlTask) interface{} { return t.Output }),
+		"error":       gqlField(s, graphql.String, func(t *gqlTask) interface{} { return t.Error }),
+		"timeoutTier": gqlField(s, nonNull(graphql.String), func(t *gqlTask) interface{} { return string(t.TimeoutTier.OrDefault()) }),
+		"startedAt":   gqlField(s, graphqlDateTime, func(t *gqlTask) interface{} { return t.StartedAt }),
+		"completedAt": gqlField(s, graphqlDateTime, func(t *gqlTask) interface{} { return t.CompletedAt }),
+		"bytesIn":     gqlField(s, nonNull(graphql.Int), func(t *gqlTask) interface{} { return t.BytesIn }),
+		"bytesOut":    gqlField(s, nonNull(graphql.Int), func(t *gqlTask) interface{} { return t.BytesOut }),
+		"durationMs":  gqlField(s, nonNull(graphql.Int), func(t *gqlTask) interface{} { return t.Duration }),
+		"createdAt":   gqlField(s, nonNull(graphqlDateTime), func(t *gqlTask) interface{} { return t.CreatedAt }),
+		"session": gqlResolver(s, sessionType, read, nil, func(p graphql.ResolveParams, t *gqlTask) (interface{}, error) {
+			return s.loadSession(p.Context, t.SessionID)
+		}),
+	}
+
+	aclEntryType.Fields = map[string]*graphql.Field{
+		"principalType": gqlField(s, nonNull(graphql.String), func(e *models.WorkspaceACLEntry) interface{} { return string(e.PrincipalType) }),
+		"principalId":   gqlField(s, nonNull(graphql.ID), func(e *models.WorkspaceACLEntry) interface{} { return e.PrincipalID }),
+		"permission":    gqlField(s, nonNull(graphqlPermission), func(e *models.WorkspaceACLEntry) interface{} { return e.Permission }),
+		"grantedBy":     gqlField(s, graphql.ID, func(e *models.WorkspaceACLEntry) interface{} { return e.GrantedBy }),
+		"createdAt":     gqlField(s, nonNull(graphqlDateTime), func(e *models.WorkspaceACLEntry) interface{} { return e.CreatedAt }),
+	}
+
+	taskEventType.Fields = map[string]*graphql.Field{
+		"cursor":    gqlField(s, nonNull(graphql.Int), func(e *gqlTaskEvent) interface{} { return e.Cursor }),
+		"taskId":    gqlField(s, nonNull(graphql.ID), func(e *gqlTaskEvent) interface{} { return e.TaskID }),
+		"sessionId": gqlField(s, nonNull(graphql.ID), func(e *gqlTaskEvent) interface{} { return e.SessionID }),
+		"type":      gqlField(s, nonNull(graphqlTaskEventType), func(e *gqlTaskEvent) interface{} { return e.Type }),
+		"status":    gqlField(s, nonNull(graphqlTaskStatus), func(e *gqlTaskEvent) interface{} { return e.Status }),
+		"output":    gqlField(s, graphql.String, func(e *gqlTaskEvent) interface{} { return e.Output }),
+		"error":     gqlField(s, graphql.String, func(e *gqlTaskEvent) interface{} { return e.Error }),
+		"createdAt": gqlField(s, nonNull(graphqlDateTime), func(e *gqlTaskEvent) interface{} { return e.CreatedAt }),
+	}
+
+	idArg := map[string]*graphql.Argument{"id": {Type: nonNull(graphql.ID)}}
+	query := &graphql.Object{Name: "Query", Fields: map[string]*graphql.Field{
+		"workspaces": {
+			Type: list(workspaceType),
+			Args: map[string]*graphql.Argument{"limit": limitArg()},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				limit, err := graphqlLimit(p.Args)
+				if err != nil {
+					return nil, graphqlError(err)
+				}
+				workspaces, err := s.workspaces(p.Context, limit)
+				return workspaces, graphqlError(err)
+			},
+		},
+		"workspace": {
+			Type: workspaceType,
+			Args: idArg,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				workspace, err := s.storage.Workspace().GetByID(p.Context, p.Args["id"].(string))
+				if err != nil {
+					return nil, graphqlError(NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", err))
+				}
+				if err := s.authorize(p.Context, workspace.ID, read); err != nil {
+					return nil, graphqlError(err)
+				}
+				workspace.MaskClaudeKey()
+				return workspace, nil
+			},
+		},
+		"session": {
+			Type: sessionType,
+			Args: idArg,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				session, err := s.loadSession(p.Context, p.Args["id"].(string))
+				if err != nil {
+					return nil, graphqlError(err)
+				}
+				return session, nil
+			},
+		},
+		"task": {
+			Type: taskType,
+			Args: idArg,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				task, err := s.loadTask(p.Context, p.Args["id"].(string))
+				if err != nil {
+					return nil, graphqlError(err)
+				}
+				return task, nil
+			},
+		},
+		"usage": {
+			Type:        nonNull(usageType),
+			Description: "접근 가능한 모든 워크스페이스의 사용량 합계",
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				ids, err := s.access.AccessibleWorkspaceIDs(p.Context, graphqlRequestFrom(p.Context).viewer.UserID)
+				if err != nil {
+					return nil, graphqlError(NewWorkspaceError(ErrCodeInternal, "워크스페이스 목록 조회 실패", err))
+				}
+				usage := &GraphQLUsage{}
+				for _, id := range ids {
+					if err := s.workspaceUsage(p.Context, usage, id); err != nil {
+						return nil, graphqlError(err)
+					}
+				}
+				return usage, nil
+			},
+		},
+	}}
+
+	subscription := &graphql.Object{Name: "Subscription", Fields: map[string]*graphql.Field{
+		"taskEvents": {
+			Type: nonNull(taskEventType),
+			Args: map[string]*graphql.Argument{
+				"taskId": {Type: nonNull(graphql.ID)},
+				"since":  {Type: graphql.Int, DefaultValue: 0, Description: "마지막으로 받은 이벤트 커서"},
+			},
+			Subscribe: func(p graphql.ResolveParams) (<-chan interface{}, error) {
+				since, _ := p.Args["since"].(int)
+				events, err := s.subscribeTaskEvents(p.Context, p.Args["taskId"].(string), int64(since))
+				return events, graphqlError(err)
+			},
+		},
+	}}
+
+	return &graphql.Schema{Query: query, Subscription: subscription}
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/graphql"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func graphqlJSON(t *testing.T, v interface{}) map[string]interface{} {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	return decoded
+}
+
+func TestGraphQLService_Query(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	access := NewWorkspaceAccessService(store)
+	service := NewGraphQLService(store, access, NewTaskEventService(store))
+
+	ws, session := createWorkspaceWithSession(t, store, "alice", "api")
+	session.CommandCount, session.BytesIn = 3, 120
+	require.NoError(t, store.Session().Update(ctx, session))
+	for _, status := range []models.TaskStatus{models.TaskCompleted, models.TaskFailed} {
+		require.NoError(t, store.Task().Create(ctx, &models.Task{SessionID: session.ID, Command: "claude", Status: status, Duration: 500}))
+	}
+	_, err := access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+
+	query := `query($id: ID!) {
+		workspace(id: $id) {
+			name
+			permission
+			sessions { id tasks(status: failed) { status durationMs } }
+			usage { sessions tasks failedTasks commands bytesIn taskDurationMs }
+			acl { principalId permission }
+		}
+	}`
+	req := &graphql.Request{Query: query, Variables: map[string]interface{}{"id": ws.ID}}
+
+	// 소유자는 모든 필드 조회
+	result := graphqlJSON(t, service.Execute(ctx, GraphQLViewer{UserID: "alice"}, req))
+	assert.Nil(t, result["errors"])
+	workspace := result["data"].(map[string]interface{})["workspace"].(map[string]interface{})
+	assert.Equal(t, "api", workspace["name"])
+	assert.Equal(t, "owner", workspace["permission"])
+	sessions := workspace["sessions"].([]interface{})
+	require.Len(t, sessions, 1)
+	assert.Equal(t, []interface{}{map[string]interface{}{"status": "failed", "durationMs": float64(500)}}, sessions[0].(map[string]interface{})["tasks"])
+	assert.Equal(t, map[string]interface{}{
+		"sessions": float64(1), "tasks": float64(2), "failedTasks": float64(1),
+		"commands": float64(3), "bytesIn": float64(120), "taskDurationMs": float64(1000),
+	}, workspace["usage"])
+	assert.Len(t, workspace["acl"], 1)
+
+	// read 권한 사용자는 admin 권한이 필요한 acl 필드만 null
+	result = graphqlJSON(t, service.Execute(ctx, GraphQLViewer{UserID: "bob"}, req))
+	workspace = result["data"].(map[string]interface{})["workspace"].(map[string]interface{})
+	assert.Equal(t, "read", workspace["permission"])
+	assert.Nil(t, workspace["acl"])
+	assert.NotNil(t, workspace["usage"])
+	errs := result["errors"].([]interface{})
+	require.Len(t, errs, 1)
+	gqlErr := errs[0].(map[string]interface{})
+	assert.Equal(t, []interface{}{"workspace", "acl"}, gqlErr["path"])
+	assert.Equal(t, "FORBIDDEN", gqlErr["extensions"].(map[string]interface{})["code"])
+
+	// 권한이 없는 사용자
+	result = graphqlJSON(t, service.Execute(ctx, GraphQLViewer{UserID: "mallory"}, req))
+	assert.Nil(t, result["data"].(map[string]interface{})["workspace"])
+	assert.Len(t, result["errors"], 1)
+
+	// 시스템 관리자는 권한 확인 없이 조회
+	result = graphqlJSON(t, service.Execute(ctx, GraphQLViewer{UserID: "root", Admin: true}, req))
+	assert.Nil(t, result["errors"])
+
+	// 공유받은 워크스페이스 목록과 태스크에서 세션으로 거슬러 조회
+	result = graphqlJSON(t, service.Execute(ctx, GraphQLViewer{UserID: "bob"}, &graphql.Request{Query: `{ workspaces { id } usage { tasks } }`}))
+	assert.Nil(t, result["errors"])
+	assert.Len(t, result["data"].(map[string]interface{})["workspaces"], 1)
+
+	result = graphqlJSON(t, service.Execute(ctx, GraphQLViewer{UserID: "bob"}, &graphql.Request{Query: `{ workspaces(limit: 500) { id } }`}))
+	assert.Equal(t, "BAD_REQUEST", result["errors"].([]interface{})[0].(map[string]interface{})["extensions"].(map[string]interface{})["code"])
+}
+
+func TestGraphQLService_SubscribeTaskEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	store := memory.New()
+	events := NewTaskEventService(store)
+	service := NewGraphQLService(store, NewWorkspaceAccessService(store), events)
+	_, session := createWorkspaceWithSession(t, store, "alice", "api")
+	task := &models.Task{SessionID: session.ID, Command: "claude", Status: models.TaskRunning}
+	require.NoError(t, store.Task().Create(ctx, task))
+
+	req := &graphql.Request{
+		Query:     `subscription($id: ID!) { taskEvents(taskId: $id) { type status output } }`,
+		Variables: map[string]interface{}{"id": task.ID},
+	}
+	_, errResult := service.Subscribe(ctx, GraphQLViewer{UserID: "mallory"}, req)
+	require.NotNil(t, errResult)
+
+	results, errResult := service.Subscribe(ctx, GraphQLViewer{UserID: "alice"}, req)
+	require.Nil(t, errResult)
+	events.PublishStatus(task)
+	task.Status, task.Output = models.TaskCompleted, "done"
+	events.OnTaskFinished(task)
+
+	var received []interface{}
+	for result := range results {
+		require.Empty(t, result.Errors)
+		received = append(received, graphqlJSON(t, result)["data"].(map[string]interface{})["taskEvents"])
+	}
+	require.Len(t, received, 3)
+	assert.Equal(t, map[string]interface{}{"type": "status", "status": "running", "output": ""}, received[0])
+	assert.Equal(t, map[string]interface{}{"type": "output", "status": "completed", "output": "done"}, received[1])
+	assert.Equal(t, "completed", received[2].(map[string]interface{})["status"])
+}
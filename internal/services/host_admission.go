@@ -0,0 +1,329 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+const (
+	// defaultHostSampleInterval 기본 호스트 자원 측정 주기
+	defaultHostSampleInterval = 5 * time.Second
+	// defaultHostReleaseBatch 자원이 회복되었을 때 측정 한 번에 실행하는 기본 태스크 수
+	defaultHostReleaseBatch = 5
+)
+
+// ErrHostOverloaded 호스트 자원이 부족하고 대기열도 가득 차 새 태스크를 받을 수 없음
+var ErrHostOverloaded = errors.New("host overloaded")
+
+// HostOverloadedError 호스트 자원 부족으로 거부된 요청의 안내 정보
+type HostOverloadedError struct {
+	Reasons    []string
+	RetryAfter time.Duration
+}
+
+func (e *HostOverloadedError) Error() string {
+	return fmt.Sprintf("서버 자원이 부족하고 실행 대기열이 가득 차 새 태스크를 받을 수 없습니다: %s", strings.Join(e.Reasons, ", "))
+}
+
+// Is errors.Is(err, ErrHostOverloaded)로 확인할 수 있도록 합니다
+func (e *HostOverloadedError) Is(target error) bool {
+	return target == ErrHostOverloaded
+}
+
+// HostSampler 호스트 자원 사용률 측정기
+type HostSampler interface {
+	Sample() (*models.HostResourceUsage, error)
+}
+
+// HostAdmissionConfig 호스트 자원 기반 실행 허가 설정
+type HostAdmissionConfig struct {
+	// Interval 측정 주기 (0이면 5초)
+	Interval time.Duration
+	// Thresholds 실행을 미루는 사용률 기준 (0인 항목은 검사하지 않음)
+	Thresholds models.HostResourceThresholds
+	// DiskPath 디스크 사용률을 측정할 경로 (비어 있으면 측정하지 않음)
+	DiskPath string
+	// MaxDeferred 실행을 기다릴 수 있는 최대 태스크 수 (0이면 무제한, 넘으면 거부)
+	MaxDeferred int
+	// ReleaseBatch 자원이 회복되었을 때 측정 한 번에 실행하는 태스크 수 (0이면 5)
+	ReleaseBatch int
+	// Sampler 측정기 (nil이면 플랫폼 기본 측정기)
+	Sampler HostSampler
+}
+
+// HostAdmissionService 호스트 CPU, 메모리, 디스크 사용률을 주기적으로 측정해 새 claude 태스크의 실행을 허가하는 서비스
+// 사용률이 기준을 넘으면 새 태스크를 큐에 넣지 않고 대기열에 보관했다가, 기준 아래로 내려오면 측정마다
+// ReleaseBatch개씩 순서대로 실행합니다. 한꺼번에 실행해 다시 과부하가 되는 것을 막기 위해서입니다.
+// 대기열은 인스턴스 메모리에만 있으므로 보관 중인 태스크는 재시작하면 대기 상태로 남습니다.
+type HostAdmissionService struct {
+	config  HostAdmissionConfig
+	sampler HostSampler
+
+	mu       sync.Mutex
+	usage    *models.HostResourceUsage
+	reasons  []string
+	deferred []*models.Task
+	release  func(tasks []*models.Task)
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewHostAdmissionService 새 호스트 자원 기반 실행 허가 서비스 생성
+func NewHostAdmissionService(config HostAdmissionConfig) *HostAdmissionService {
+	if config.Interval <= 0 {
+		config.Interval = defaultHostSampleInterval
+	}
+	if config.ReleaseBatch <= 0 {
+		config.ReleaseBatch = defaultHostReleaseBatch
+	}
+	sampler := config.Sampler
+	if sampler == nil {
+		sampler = newHostSampler(config.DiskPath)
+	}
+	return &HostAdmissionService{
+		config:  config,
+		sampler: sampler,
+	}
+}
+
+// Status 최근 측정값과 실행을 기다리는 태스크 현황
+func (s *HostAdmissionService) Status() models.HostAdmissionStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := models.HostAdmissionStatus{
+		Thresholds:    s.config.Thresholds,
+		Overloaded:    len(s.reasons) > 0,
+		Reasons:       append([]string(nil), s.reasons...),
+		DeferredTasks: make([]string, 0, len(s.deferred)),
+	}
+	if s.usage != nil {
+		usage := *s.usage
+		status.Usage = &usage
+	}
+	for _, task := range s.deferred {
+		status.DeferredTasks = append(status.DeferredTasks, task.ID)
+	}
+	return status
+}
+
+// Start 주기적인 호스트 자원 측정 시작
+func (s *HostAdmissionService) Start(ctx context.Context) {
+	if s.stopCh != nil {
+		return
+	}
+	s.stopCh = make(chan struct{})
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.config.Interval)
+		defer ticker.Stop()
+
+		if !s.sample() {
+			return
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+				if !s.sample() {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// Stop 주기적인 호스트 자원 측정 중지
+func (s *HostAdmissionService) Stop() {
+	if s.stopCh == nil {
+		return
+	}
+	close(s.stopCh)
+	s.wg.Wait()
+	s.stopCh = nil
+}
+
+// sample 사용률을 측정해 과부하 여부를 갱신하고, 기준 아래면 대기 중인 태스크를 실행합니다
+// 지원하지 않는 플랫폼이면 false를 반환해 측정을 멈춥니다 (실행을 미루지 않음).
+func (s *HostAdmissionService) sample() bool {
+	usage, err := s.sampler.Sample()
+	if err != nil {
+		if errors.Is(err, errors.ErrUnsupported) {
+			log.Printf("이 플랫폼에서는 호스트 자원 측정을 지원하지 않아 태스크 실행을 미루지 않습니다")
+		} else {
+			log.Printf("호스트 자원 측정 실패: %v", err)
+		}
+		// 측정하지 못하면 태스크가 대기열에 묶이지 않도록 과부하가 아닌 것으로 간주
+		usage = nil
+	}
+
+	reasons := s.overloadReasons(usage)
+
+	s.mu.Lock()
+	wasOverloaded := len(s.reasons) > 0
+	s.usage, s.reasons = usage, reasons
+	var released []*models.Task
+	if len(reasons) == 0 && len(s.deferred) > 0 {
+		n := min(s.config.ReleaseBatch, len(s.deferred))
+		released = append(released, s.deferred[:n]...)
+		s.deferred = s.deferred[n:]
+	}
+	release := s.release
+	s.mu.Unlock()
+
+	if len(reasons) > 0 && !wasOverloaded {
+		log.Printf("호스트 자원 부족으로 새 claude 태스크 실행을 미룹니다: %s", strings.Join(reasons, ", "))
+	} else if len(reasons) == 0 && wasOverloaded {
+		log.Printf("호스트 자원이 회복되어 대기 중인 태스크를 실행합니다")
+	}
+	if release != nil && len(released) > 0 {
+		release(released)
+	}
+	return !errors.Is(err, errors.ErrUnsupported)
+}
+
+// overloadReasons 기준을 넘은 항목 설명 (기준 아래면 nil)
+func (s *HostAdmissionService) overloadReasons(usage *models.HostResourceUsage) []string {
+	if usage == nil {
+		return nil
+	}
+	var reasons []string
+	check := func(name string, value, threshold float64) {
+		if threshold > 0 && value >= threshold {
+			reasons = append(reasons, fmt.Sprintf("%s 사용률 %.0f%% (기준 %.0f%%)", name, value, threshold))
+		}
+	}
+	check("CPU", usage.CPUPercent, s.config.Thresholds.CPUPercent)
+	check("메모리", usage.MemoryPercent, s.config.Thresholds.MemoryPercent)
+	if usage.DiskPath != "" {
+		check("디스크", usage.DiskPercent, s.config.Thresholds.DiskPercent)
+	}
+	return reasons
+}
+
+// admit 과부하 상태이거나 먼저 기다리는 태스크가 있으면 대기열에 보관하고 대기 정보 반환 (바로 실행하면 nil)
+// 대기열이 가득 차면 *HostOverloadedError를 반환합니다.
+func (s *HostAdmissionService) admit(task *models.Task) (*models.TaskAdmission, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// 자원이 회복되어도 먼저 기다리던 태스크보다 앞서 실행하지 않음
+	if len(s.reasons) == 0 && len(s.deferred) == 0 {
+		return nil, nil
+	}
+	if s.config.MaxDeferred > 0 && len(s.deferred) >= s.config.MaxDeferred {
+		return nil, &HostOverloadedError{
+			Reasons:    append([]string(nil), s.reasons...),
+			RetryAfter: s.config.Interval * time.Duration(len(s.deferred)/s.config.ReleaseBatch+1),
+		}
+	}
+	s.deferred = append(s.deferred, task)
+	return s.admissionLocked(len(s.deferred)), nil
+}
+
+// position 대기열에 있는 태스크의 대기 정보 (대기열에 없으면 nil)
+func (s *HostAdmissionService) position(taskID string) *models.TaskAdmission {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, task := range s.deferred {
+		if task.ID == taskID {
+			return s.admissionLocked(i + 1)
+		}
+	}
+	return nil
+}
+
+// forget 취소된 태스크를 대기열에서 제거
+func (s *HostAdmissionService) forget(taskID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, task := range s.deferred {
+		if task.ID == taskID {
+			s.deferred = append(s.deferred[:i], s.deferred[i+1:]...)
+			return
+		}
+	}
+}
+
+// admissionLocked 대기 순서에 해당하는 대기 정보 (mu를 잡은 상태에서 호출)
+func (s *HostAdmissionService) admissionLocked(position int) *models.TaskAdmission {
+	reasons := append([]string(nil), s.reasons...)
+	if len(reasons) == 0 {
+		reasons = []string{"먼저 대기 중인 태스크 실행 중"}
+	}
+	return &models.TaskAdmission{
+		Deferred:      true,
+		QueuePosition: position,
+		QueueLength:   len(s.deferred),
+		Reasons:       reasons,
+	}
+}
+
+// setRelease 자원이 회복되었을 때 대기 중인 태스크를 제출할 함수 설정
+func (s *HostAdmissionService) setRelease(release func(tasks []*models.Task)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.release = release
+}
+
+// SetAdmission 새 claude 태스크에 적용할 호스트 자원 기반 실행 허가 설정
+func (ts *TaskService) SetAdmission(admission *HostAdmissionService) {
+	ts.admission = admission
+	admission.setRelease(ts.releaseDeferred)
+}
+
+// admit 호스트 자원이 부족하면 claude 태스크를 대기열에 보관합니다 (보관했으면 true, 거부해야 하면 에러)
+func (ts *TaskService) admit(task *models.Task) (bool, error) {
+	if ts.admission == nil || !isClaudeCommand(task.Command) {
+		return false, nil
+	}
+	admission, err := ts.admission.admit(task)
+	if err != nil || admission == nil {
+		return false, err
+	}
+	task.Admission = admission
+	log.Printf("호스트 자원 부족으로 태스크 실행 대기: %s (%d/%d)", task.ID, admission.QueuePosition, admission.QueueLength)
+	return true, nil
+}
+
+// releaseDeferred 호스트 자원 부족으로 보관한 태스크를 큐에 제출합니다
+// 보관 중에 취소되었거나 삭제된 태스크는 건너뜁니다.
+func (ts *TaskService) releaseDeferred(tasks []*models.Task) {
+	ctx := context.Background()
+	for _, deferred := range tasks {
+		task, err := ts.storage.Task().GetByID(ctx, deferred.ID)
+		if err != nil || task.Status != models.TaskPending {
+			continue
+		}
+		if err := ts.taskQueue.Submit(task); err != nil {
+			task.SetRunning()
+			task.SetFailed(fmt.Sprintf("자원 회복 후 큐 제출 실패: %v", err))
+			if updateErr := ts.storage.Task().Update(ctx, task); updateErr != nil {
+				log.Printf("태스크 상태 저장 실패: %s: %v", task.ID, updateErr)
+			}
+			ts.notifyFinished(task)
+		}
+	}
+}
+
+// isClaudeCommand claude CLI를 실행하는 명령인지 확인
+func isClaudeCommand(command string) bool {
+	parts := strings.Fields(command)
+	return len(parts) > 0 && path.Base(parts[0]) == "claude"
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+type fakeHostSampler struct {
+	mu    sync.Mutex
+	usage models.HostResourceUsage
+}
+
+func (f *fakeHostSampler) Sample() (*models.HostResourceUsage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	usage := f.usage
+	return &usage, nil
+}
+
+func (f *fakeHostSampler) set(cpu, memory float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.usage.CPUPercent, f.usage.MemoryPercent = cpu, memory
+}
+
+func TestHostAdmission_DefersClaudeTasksUnderPressure(t *testing.T) {
+	taskService, _, session := setupTaskTest()
+	defer taskService.Stop()
+
+	sampler := &fakeHostSampler{}
+	admission := NewHostAdmissionService(HostAdmissionConfig{
+		Thresholds:   models.HostResourceThresholds{CPUPercent: 90, MemoryPercent: 90},
+		MaxDeferred:  2,
+		ReleaseBatch: 1,
+		Sampler:      sampler,
+	})
+	taskService.SetAdmission(admission)
+
+	create := func(command string) (*models.Task, error) {
+		return taskService.Create(context.Background(), &models.TaskCreateRequest{
+			SessionID: session.ID,
+			Command:   command,
+		})
+	}
+
+	sampler.set(95, 40)
+	admission.sample()
+	status := admission.Status()
+	assert.True(t, status.Overloaded)
+	require.Len(t, status.Reasons, 1)
+	assert.Contains(t, status.Reasons[0], "CPU")
+
+	// claude가 아닌 명령은 미루지 않음
+	shell, err := create("echo not-deferred")
+	require.NoError(t, err)
+	assert.Nil(t, shell.Admission)
+
+	first, err := create("claude --version")
+	require.NoError(t, err)
+	require.NotNil(t, first.Admission)
+	assert.Equal(t, 1, first.Admission.QueuePosition)
+	second, err := create("claude --help")
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Admission.QueuePosition)
+	assert.Equal(t, 2, second.Admission.QueueLength)
+
+	// 대기열이 가득 차면 거부
+	_, err = create("claude --overflow")
+	require.ErrorIs(t, err, ErrHostOverloaded)
+	var overloadedErr *HostOverloadedError
+	require.ErrorAs(t, err, &overloadedErr)
+	assert.NotEmpty(t, overloadedErr.Reasons)
+
+	// 취소하면 뒤의 태스크가 앞으로 당겨짐
+	require.NoError(t, taskService.Cancel(context.Background(), first.ID))
+	stored, err := taskService.GetByID(context.Background(), second.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.Admission)
+	assert.Equal(t, 1, stored.Admission.QueuePosition)
+	_, queued := taskService.taskQueue.GetTask(second.ID)
+	assert.False(t, queued)
+
+	// 메모리 부족이 이어지면 계속 대기
+	sampler.set(10, 95)
+	admission.sample()
+	_, queued = taskService.taskQueue.GetTask(second.ID)
+	assert.False(t, queued)
+
+	// 자원이 회복되면 순서대로 실행
+	sampler.set(10, 40)
+	admission.sample()
+	_, queued = taskService.taskQueue.GetTask(second.ID)
+	assert.True(t, queued)
+	assert.Empty(t, admission.Status().DeferredTasks)
+
+	third, err := create("claude --version")
+	require.NoError(t, err)
+	assert.Nil(t, third.Admission)
+}
+
+func TestHostAdmission_WaitsBehindDeferredTasks(t *testing.T) {
+	sampler := &fakeHostSampler{}
+	admission := NewHostAdmissionService(HostAdmissionConfig{
+		Thresholds:   models.HostResourceThresholds{CPUPercent: 90},
+		ReleaseBatch: 1,
+		Sampler:      sampler,
+	})
+	var released []string
+	admission.setRelease(func(tasks []*models.Task) {
+		for _, task := range tasks {
+			released = append(released, task.ID)
+		}
+	})
+
+	sampler.set(99, 0)
+	admission.sample()
+	for _, id := range []string{"a", "b"} {
+		deferred, err := admission.admit(&models.Task{BaseModel: models.BaseModel{ID: id}})
+		require.NoError(t, err)
+		require.NotNil(t, deferred)
+	}
+
+	// 자원이 회복되어도 먼저 기다리던 태스크보다 앞서지 않음
+	sampler.set(10, 0)
+	admission.sample()
+	assert.Equal(t, []string{"a"}, released)
+	deferred, err := admission.admit(&models.Task{BaseModel: models.BaseModel{ID: "c"}})
+	require.NoError(t, err)
+	require.NotNil(t, deferred)
+	assert.Equal(t, 2, deferred.QueuePosition)
+
+	admission.sample()
+	admission.sample()
+	assert.Equal(t, []string{"a", "b", "c"}, released)
+	deferred, err = admission.admit(&models.Task{BaseModel: models.BaseModel{ID: "d"}})
+	require.NoError(t, err)
+	assert.Nil(t, deferred)
+}
+
+func TestHostSampler_Platform(t *testing.T) {
+	usage, err := newHostSampler(t.TempDir()).Sample()
+	if err != nil {
+		t.Skipf("호스트 자원 측정을 지원하지 않는 환경: %v", err)
+	}
+	assert.GreaterOrEqual(t, usage.CPUPercent, 0.0)
+	assert.LessOrEqual(t, usage.CPUPercent, 100.0)
+	assert.Greater(t, usage.MemoryPercent, 0.0)
+	assert.LessOrEqual(t, usage.DiskPercent, 100.0)
+}
@@ -0,0 +1,124 @@
+//go:build linux
+
+package services
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// procHostSampler /proc과 statfs로 호스트 자원 사용률을 측정
+type procHostSampler struct {
+	diskPath string
+
+	// 직전 측정의 누적 CPU 시간 (처음에는 부팅 이후 평균)
+	prevTotal uint64
+	prevIdle  uint64
+}
+
+// newHostSampler 플랫폼 기본 호스트 자원 측정기
+func newHostSampler(diskPath string) HostSampler {
+	return &procHostSampler{diskPath: diskPath}
+}
+
+// Sample 호스트 자원 사용률 측정
+func (p *procHostSampler) Sample() (*models.HostResourceUsage, error) {
+	usage := &models.HostResourceUsage{DiskPath: p.diskPath, SampledAt: time.Now().UTC()}
+
+	total, idle, err := readCPUTimes()
+	if err != nil {
+		return nil, err
+	}
+	if deltaTotal := total - p.prevTotal; deltaTotal > 0 {
+		usage.CPUPercent = float64(deltaTotal-(idle-p.prevIdle)) * 100 / float64(deltaTotal)
+	}
+	p.prevTotal, p.prevIdle = total, idle
+
+	if usage.MemoryPercent, err = readMemoryPercent(); err != nil {
+		return nil, err
+	}
+
+	if p.diskPath != "" {
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(p.diskPath, &stat); err != nil {
+			return nil, fmt.Errorf("디스크 사용률 측정 실패: %w", err)
+		}
+		// df와 같이 루트 예약 블록은 제외하고 계산
+		used := stat.Blocks - stat.Bfree
+		if available := used + stat.Bavail; available > 0 {
+			usage.DiskPercent = float64(used) * 100 / float64(available)
+		}
+	}
+	return usage, nil
+}
+
+// readCPUTimes /proc/stat의 전체 CPU 누적 시간과 유휴 시간 (iowait 포함)
+func readCPUTimes() (total, idle uint64, err error) {
+	file, err := os.Open("/proc/stat")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() {
+		return 0, 0, fmt.Errorf("/proc/stat을 읽을 수 없습니다")
+	}
+	fields := strings.Fields(scanner.Text())
+	if len(fields) < 5 || fields[0] != "cpu" {
+		return 0, 0, fmt.Errorf("/proc/stat 형식이 올바르지 않습니다")
+	}
+	// user nice system idle iowait irq softirq steal (guest는 user에 이미 포함)
+	for i, field := range fields[1:] {
+		if i >= 8 {
+			break
+		}
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("/proc/stat 형식이 올바르지 않습니다: %w", err)
+		}
+		total += value
+		if i == 3 || i == 4 {
+			idle += value
+		}
+	}
+	return total, idle, nil
+}
+
+// readMemoryPercent /proc/meminfo의 MemAvailable 기준 메모리 사용률
+func readMemoryPercent() (float64, error) {
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	var memTotal, memAvailable uint64
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "MemTotal:":
+			memTotal, _ = strconv.ParseUint(fields[1], 10, 64)
+		case "MemAvailable:":
+			memAvailable, _ = strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if memTotal == 0 {
+		return 0, fmt.Errorf("/proc/meminfo에서 MemTotal을 찾을 수 없습니다")
+	}
+	return float64(memTotal-memAvailable) * 100 / float64(memTotal), nil
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package services
+
+import (
+	"errors"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// unsupportedHostSampler 호스트 자원 측정을 지원하지 않는 플랫폼 (실행을 미루지 않음)
+type unsupportedHostSampler struct{}
+
+// newHostSampler 플랫폼 기본 호스트 자원 측정기
+func newHostSampler(diskPath string) HostSampler {
+	return unsupportedHostSampler{}
+}
+
+// Sample 지원하지 않는 플랫폼이라 errors.ErrUnsupported 반환
+func (unsupportedHostSampler) Sample() (*models.HostResourceUsage, error) {
+	return nil, errors.ErrUnsupported
+}
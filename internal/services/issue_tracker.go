@@ -0,0 +1,508 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/secrets"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// issueBodyMaxBytes 태스크 명령에 넣을 이슈 본문 최대 크기
+	issueBodyMaxBytes = 6000
+	// issueCommentOutputMaxBytes 결과 댓글에 넣을 태스크 출력 최대 크기
+	issueCommentOutputMaxBytes = 3000
+	// issueTrackerTimeout 태스크 종료/승인 후 이슈 트래커 호출 제한 시간
+	issueTrackerTimeout = time.Minute
+)
+
+// IssueTrackerAPI 이슈 조회, 댓글, 상태 전환 API (*IssueTrackerClient)
+type IssueTrackerAPI interface {
+	GetIssue(ctx context.Context, config *models.IssueTrackerConfig, key string) (*models.Issue, error)
+	AddComment(ctx context.Context, config *models.IssueTrackerConfig, key, body string) error
+	Transition(ctx context.Context, config *models.IssueTrackerConfig, key, transition string) error
+}
+
+// IssueTaskCreator 이슈로 태스크를 생성하는 인터페이스 (*TaskService)
+type IssueTaskCreator interface {
+	Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error)
+}
+
+// IssueTrackerService 태스크/세션과 Jira, GitHub Issues 이슈 연결
+// 태스크가 끝나면 연결된 이슈에 결과 댓글을 남기고(TaskFinishListener), 검토에서 승인되면 이슈 상태를
+// 전환합니다(TaskReviewListener). 권한 확인은 라우터의 워크스페이스/세션/태스크 권한 미들웨어가 맡습니다.
+type IssueTrackerService struct {
+	storage    storage.Storage
+	api        IssueTrackerAPI
+	tasks      IssueTaskCreator
+	webBaseURL string
+	tokens     configTokens
+}
+
+// NewIssueTrackerService 새 이슈 트래커 서비스 생성
+// webBaseURL은 댓글에 넣을 세션 기록 링크의 웹 UI 주소입니다.
+func NewIssueTrackerService(storage storage.Storage, api IssueTrackerAPI, tasks IssueTaskCreator, webBaseURL string) *IssueTrackerService {
+	return &IssueTrackerService{
+		storage:    storage,
+		api:        api,
+		tasks:      tasks,
+		webBaseURL: strings.TrimRight(webBaseURL, "/"),
+	}
+}
+
+// SetSecretsBox API 토큰을 암호화할 Box 설정 (없으면 토큰을 평문으로 저장)
+func (s *IssueTrackerService) SetSecretsBox(box *secrets.Box) {
+	s.tokens = configTokens{box: box}
+}
+
+// SealTokens 평문으로 저장된 이슈 트래커 토큰을 암호화해 다시 저장하고 암호화한 설정 수를 반환합니다
+func (s *IssueTrackerService) SealTokens(ctx context.Context) (int, error) {
+	workspaceIDs, err := s.storage.IssueTracker().ListConfigWorkspaces(ctx)
+	if err != nil {
+		return 0, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 목록 조회 실패", err)
+	}
+	sealed := 0
+	for _, workspaceID := range workspaceIDs {
+		config, err := s.storage.IssueTracker().GetConfig(ctx, workspaceID)
+		if err != nil {
+			if storage.IsNotFoundError(err) {
+				continue
+			}
+			return sealed, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 조회 실패", err)
+		}
+		if !s.tokens.needsSeal(config.Token) {
+			continue
+		}
+		if config.Token, err = s.tokens.seal(config.Token); err != nil {
+			return sealed, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 토큰 암호화 실패", err)
+		}
+		if err := s.storage.IssueTracker().SaveConfig(ctx, config); err != nil {
+			return sealed, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 저장 실패", err)
+		}
+		sealed++
+	}
+	return sealed, nil
+}
+
+// GetConfig 워크스페이스의 이슈 트래커 설정 조회 (토큰은 설정 여부만 반환)
+func (s *IssueTrackerService) GetConfig(ctx context.Context, workspaceID string) (*models.IssueTrackerConfig, error) {
+	config, err := s.storage.IssueTracker().GetConfig(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스에 이슈 트래커 설정이 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 조회 실패", err)
+	}
+	config.HasToken = config.Token != ""
+	return config, nil
+}
+
+// SaveConfig 워크스페이스의 이슈 트래커 설정 저장 (토큰을 비워 두면 기존 토큰 유지)
+func (s *IssueTrackerService) SaveConfig(ctx context.Context, workspaceID, userID string, req *models.IssueTrackerConfigRequest) (*models.IssueTrackerConfig, error) {
+	config := &models.IssueTrackerConfig{
+		WorkspaceID:        workspaceID,
+		Provider:           req.Provider,
+		BaseURL:            strings.TrimRight(strings.TrimSpace(req.BaseURL), "/"),
+		DefaultProject:     strings.TrimSpace(req.DefaultProject),
+		Username:           strings.TrimSpace(req.Username),
+		Token:              req.Token,
+		CommentOnFinish:    req.CommentOnFinish,
+		ApprovalTransition: strings.TrimSpace(req.ApprovalTransition),
+		UpdatedBy:          userID,
+	}
+	switch config.Provider {
+	case models.IssueTrackerJira:
+		if config.BaseURL == "" {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "Jira 사이트 주소가 필요합니다", ErrInvalidRequest)
+		}
+		config.DefaultProject = strings.ToUpper(config.DefaultProject)
+	case models.IssueTrackerGitHub:
+		config.DefaultProject = normalizeWebhookRepository(config.DefaultProject)
+		if config.DefaultProject != "" && strings.Count(config.DefaultProject, "/") != 1 {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "기본 리포지토리는 owner/name 형식이어야 합니다", ErrInvalidRequest)
+		}
+		config.ApprovalTransition = strings.ToLower(config.ApprovalTransition)
+		if config.ApprovalTransition != "" && config.ApprovalTransition != "closed" && config.ApprovalTransition != "open" {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "GitHub 승인 전환은 closed 또는 open이어야 합니다", ErrInvalidRequest)
+		}
+	}
+
+	existing, err := s.loadConfig(ctx, workspaceID)
+	if err != nil && !storage.IsNotFoundError(err) {
+		return nil, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 조회 실패", err)
+	}
+	if existing != nil {
+		config.CreatedAt = existing.CreatedAt
+		if config.Token == "" {
+			config.Token = existing.Token
+		}
+	}
+	if config.Token == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "API 토큰이 필요합니다", ErrInvalidRequest)
+	}
+
+	token := config.Token
+	if config.Token, err = s.tokens.seal(token); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 토큰 암호화 실패", err)
+	}
+	if err := s.storage.IssueTracker().SaveConfig(ctx, config); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 저장 실패", err)
+	}
+	config.Token = token
+	config.HasToken = true
+	return config, nil
+}
+
+// DeleteConfig 워크스페이스의 이슈 트래커 설정 삭제 (이슈 연결은 유지하지만 댓글과 전환은 멈춤)
+func (s *IssueTrackerService) DeleteConfig(ctx context.Context, workspaceID string) error {
+	if err := s.storage.IssueTracker().DeleteConfig(ctx, workspaceID); err != nil {
+		if storage.IsNotFoundError(err) {
+			return NewWorkspaceError(ErrCodeNotFound, "워크스페이스에 이슈 트래커 설정이 없습니다", err)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 삭제 실패", err)
+	}
+	return nil
+}
+
+// LinkTask 태스크를 이슈에 연결
+func (s *IssueTrackerService) LinkTask(ctx context.Context, taskID, userID string, req *models.IssueLinkRequest) (*models.IssueLink, error) {
+	task, err := s.storage.Task().GetByID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+	}
+	return s.link(ctx, task.SessionID, task.ID, userID, req.Issue)
+}
+
+// LinkSession 세션을 이슈에 연결 (세션에서 끝나는 모든 태스크 결과를 댓글로 남김)
+func (s *IssueTrackerService) LinkSession(ctx context.Context, sessionID, userID string, req *models.IssueLinkRequest) (*models.IssueLink, error) {
+	return s.link(ctx, sessionID, "", userID, req.Issue)
+}
+
+// ListTaskLinks 태스크에 적용되는 이슈 연결 조회 (태스크 연결과 세션 연결)
+func (s *IssueTrackerService) ListTaskLinks(ctx context.Context, taskID string) ([]*models.IssueLink, error) {
+	task, err := s.storage.Task().GetByID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+	}
+	links, err := s.taskLinks(ctx, task)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "이슈 연결 조회 실패", err)
+	}
+	return links, nil
+}
+
+// ListSessionLinks 세션의 이슈 연결 조회 (세션 연결과 세션 태스크의 연결 모두)
+func (s *IssueTrackerService) ListSessionLinks(ctx context.Context, sessionID string) ([]*models.IssueLink, error) {
+	links, err := s.storage.IssueTracker().ListLinksBySession(ctx, sessionID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "이슈 연결 조회 실패", err)
+	}
+	return links, nil
+}
+
+// UnlinkTask 태스크의 이슈 연결 해제
+func (s *IssueTrackerService) UnlinkTask(ctx context.Context, taskID, linkID string) error {
+	return s.unlink(ctx, linkID, func(link *models.IssueLink) bool { return link.TaskID == taskID })
+}
+
+// UnlinkSession 세션의 이슈 연결 해제 (세션 태스크의 연결 포함)
+func (s *IssueTrackerService) UnlinkSession(ctx context.Context, sessionID, linkID string) error {
+	return s.unlink(ctx, linkID, func(link *models.IssueLink) bool { return link.SessionID == sessionID })
+}
+
+// CreateTask 이슈 내용을 컨텍스트로 세션에 태스크를 생성하고 이슈에 연결
+func (s *IssueTrackerService) CreateTask(ctx context.Context, sessionID, userID string, req *models.IssueTaskCreateRequest) (*models.IssueTaskResponse, error) {
+	workspaceID, err := s.sessionWorkspace(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	config, issue, err := s.resolveIssue(ctx, workspaceID, req.Issue)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.tasks.Create(ctx, &models.TaskCreateRequest{
+		SessionID:     sessionID,
+		Command:       buildIssueCommand(config, issue, req.Instructions),
+		TimeoutTier:   req.TimeoutTier,
+		RequireReview: req.RequireReview,
+		UserID:        userID,
+		Metadata: map[string]string{
+			"issue_provider": string(config.Provider),
+			"issue_key":      issue.Key,
+			"issue_url":      issue.URL,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link := newIssueLink(config, issue, sessionID, task.ID, userID)
+	if err := s.storage.IssueTracker().CreateLink(ctx, link); err != nil {
+		// 태스크는 이미 시작했으므로 연결 실패는 기록만 남김
+		log.Printf("이슈 연결 저장 실패: %s -> %s: %v", task.ID, issue.Key, err)
+		link = nil
+	}
+	return &models.IssueTaskResponse{Task: task, Link: link}, nil
+}
+
+// OnTaskFinished 연결된 이슈에 태스크 결과 댓글 작성 (TaskFinishListener, 취소된 태스크는 제외)
+func (s *IssueTrackerService) OnTaskFinished(task *models.Task) {
+	if task.Status == models.TaskCancelled {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), issueTrackerTimeout)
+		defer cancel()
+		s.commentFinished(ctx, task)
+	}()
+}
+
+// OnReviewRequested 검토 요청은 이슈에 반영하지 않음 (TaskReviewListener)
+func (s *IssueTrackerService) OnReviewRequested(review *models.TaskReview, reviewers []string) {}
+
+// OnReviewDecided 승인된 태스크에 직접 연결된 이슈의 상태 전환 (TaskReviewListener)
+func (s *IssueTrackerService) OnReviewDecided(review *models.TaskReview, reviewers []string) {
+	if review.Status != models.TaskReviewApproved {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), issueTrackerTimeout)
+		defer cancel()
+		s.transitionApproved(ctx, review)
+	}()
+}
+
+// commentFinished 태스크에 적용되는 이슈 연결마다 결과 댓글 작성
+func (s *IssueTrackerService) commentFinished(ctx context.Context, task *models.Task) {
+	links, err := s.taskLinks(ctx, task)
+	if err != nil {
+		log.Printf("이슈 연결 조회 실패: %s: %v", task.ID, err)
+		return
+	}
+	if len(links) == 0 {
+		return
+	}
+	config, err := s.loadConfig(ctx, links[0].WorkspaceID)
+	if err != nil || !config.CommentOnFinish {
+		return
+	}
+
+	body := s.buildComment(config, task)
+	for _, link := range links {
+		err := s.api.AddComment(ctx, config, link.IssueKey, body)
+		if err == nil {
+			now := time.Now()
+			link.CommentedAt = &now
+		}
+		s.recordResult(ctx, link, err)
+	}
+}
+
+// transitionApproved 승인된 태스크의 이슈 상태 전환 (세션 전체 연결은 전환하지 않음)
+func (s *IssueTrackerService) transitionApproved(ctx context.Context, review *models.TaskReview) {
+	links, err := s.storage.IssueTracker().ListLinksBySession(ctx, review.SessionID)
+	if err != nil {
+		log.Printf("이슈 연결 조회 실패: %s: %v", review.TaskID, err)
+		return
+	}
+	var config *models.IssueTrackerConfig
+	for _, link := range links {
+		if link.TaskID != review.TaskID || link.TransitionedAt != nil {
+			continue
+		}
+		if config == nil {
+			if config, err = s.loadConfig(ctx, link.WorkspaceID); err != nil || config.ApprovalTransition == "" {
+				return
+			}
+		}
+		err := s.api.Transition(ctx, config, link.IssueKey, config.ApprovalTransition)
+		if err == nil {
+			now := time.Now()
+			link.TransitionedAt = &now
+		}
+		s.recordResult(ctx, link, err)
+	}
+}
+
+// recordResult 댓글/전환 결과를 연결에 기록
+func (s *IssueTrackerService) recordResult(ctx context.Context, link *models.IssueLink, err error) {
+	link.LastError = ""
+	if err != nil {
+		log.Printf("이슈 트래커 호출 실패: %s: %v", link.IssueKey, err)
+		link.LastError = err.Error()
+	}
+	if err := s.storage.IssueTracker().UpdateLink(ctx, link); err != nil {
+		log.Printf("이슈 연결 저장 실패: %s: %v", link.ID, err)
+	}
+}
+
+// link 이슈를 조회해 연결 생성
+func (s *IssueTrackerService) link(ctx context.Context, sessionID, taskID, userID, ref string) (*models.IssueLink, error) {
+	workspaceID, err := s.sessionWorkspace(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	config, issue, err := s.resolveIssue(ctx, workspaceID, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	link := newIssueLink(config, issue, sessionID, taskID, userID)
+	if err := s.storage.IssueTracker().CreateLink(ctx, link); err != nil {
+		if storage.IsAlreadyExistsError(err) {
+			return nil, NewWorkspaceError(ErrCodeResourceBusy, fmt.Sprintf("이미 %s에 연결되어 있습니다", issue.Key), err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "이슈 연결 저장 실패", err)
+	}
+	return link, nil
+}
+
+// unlink 조건에 맞는 이슈 연결 삭제 (다른 세션/태스크의 연결은 찾을 수 없음으로 처리)
+func (s *IssueTrackerService) unlink(ctx context.Context, linkID string, owns func(*models.IssueLink) bool) error {
+	link, err := s.storage.IssueTracker().GetLink(ctx, linkID)
+	if err != nil && !storage.IsNotFoundError(err) {
+		return NewWorkspaceError(ErrCodeInternal, "이슈 연결 조회 실패", err)
+	}
+	if link == nil || !owns(link) {
+		return NewWorkspaceError(ErrCodeNotFound, "이슈 연결을 찾을 수 없습니다", err)
+	}
+	if err := s.storage.IssueTracker().DeleteLink(ctx, linkID); err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "이슈 연결 삭제 실패", err)
+	}
+	return nil
+}
+
+// loadConfig 이슈 트래커 설정을 토큰을 복호화해 조회
+func (s *IssueTrackerService) loadConfig(ctx context.Context, workspaceID string) (*models.IssueTrackerConfig, error) {
+	config, err := s.storage.IssueTracker().GetConfig(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if config.Token, err = s.tokens.open(config.Token); err != nil {
+		return nil, fmt.Errorf("이슈 트래커 토큰 복호화 실패: %w", err)
+	}
+	return config, nil
+}
+
+// resolveIssue 워크스페이스 설정으로 이슈 참조를 해석하고 이슈 조회
+func (s *IssueTrackerService) resolveIssue(ctx context.Context, workspaceID, ref string) (*models.IssueTrackerConfig, *models.Issue, error) {
+	config, err := s.loadConfig(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스에 이슈 트래커 설정이 없습니다", err)
+		}
+		return nil, nil, NewWorkspaceError(ErrCodeInternal, "이슈 트래커 설정 조회 실패", err)
+	}
+	key, err := parseIssueRef(config, ref)
+	if err != nil {
+		return nil, nil, NewWorkspaceError(ErrCodeInvalidRequest, err.Error(), ErrInvalidRequest)
+	}
+	issue, err := s.api.GetIssue(ctx, config, key)
+	if err != nil {
+		return nil, nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("이슈를 가져올 수 없습니다: %v", err), err)
+	}
+	if issue.Key == "" {
+		issue.Key = key
+	}
+	return config, issue, nil
+}
+
+// sessionWorkspace 세션이 속한 워크스페이스 ID
+func (s *IssueTrackerService) sessionWorkspace(ctx context.Context, sessionID string) (string, error) {
+	session, err := s.storage.Session().GetByID(ctx, sessionID)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+	}
+	project, err := s.storage.Project().GetByID(ctx, session.ProjectID)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+	}
+	return project.WorkspaceID, nil
+}
+
+// taskLinks 태스크에 직접 연결되었거나 태스크 세션 전체에 연결된 이슈
+func (s *IssueTrackerService) taskLinks(ctx context.Context, task *models.Task) ([]*models.IssueLink, error) {
+	links, err := s.storage.IssueTracker().ListLinksBySession(ctx, task.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	applicable := links[:0]
+	for _, link := range links {
+		if link.TaskID == "" || link.TaskID == task.ID {
+			applicable = append(applicable, link)
+		}
+	}
+	return applicable, nil
+}
+
+// buildComment 태스크 결과 댓글 (Jira는 {noformat}, GitHub은 코드 블록)
+func (s *IssueTrackerService) buildComment(config *models.IssueTrackerConfig, task *models.Task) string {
+	var b strings.Builder
+	if task.Status == models.TaskCompleted {
+		b.WriteString("AICLI 태스크가 완료되었습니다.\n\n")
+	} else {
+		fmt.Fprintf(&b, "AICLI 태스크가 %s 상태로 끝났습니다.\n\n", task.Status)
+	}
+	fmt.Fprintf(&b, "요청: %s\n", taskSummary(task.Command))
+
+	result := task.Output
+	if task.Status != models.TaskCompleted && task.Error != "" {
+		result = task.Error
+	}
+	if result = strings.TrimSpace(result); result != "" {
+		if len(result) > issueCommentOutputMaxBytes {
+			result = truncateOutput(result, issueCommentOutputMaxBytes) + "\n..."
+		}
+		if config.Provider == models.IssueTrackerJira {
+			fmt.Fprintf(&b, "\n{noformat}\n%s\n{noformat}\n", result)
+		} else {
+			fmt.Fprintf(&b, "\n```\n%s\n```\n", result)
+		}
+	}
+
+	if transcript := sessionTranscriptURL(s.webBaseURL, task.SessionID); transcript != "" {
+		fmt.Fprintf(&b, "\n세션 기록: %s\n", transcript)
+	}
+	return b.String()
+}
+
+// buildIssueCommand 이슈 내용을 컨텍스트로 넣은 태스크 명령
+func buildIssueCommand(config *models.IssueTrackerConfig, issue *models.Issue, instructions string) string {
+	var b strings.Builder
+	if instructions = strings.TrimSpace(instructions); instructions != "" {
+		b.WriteString(instructions)
+	} else {
+		b.WriteString("다음 이슈를 해결하세요.")
+	}
+	fmt.Fprintf(&b, "\n\n## 이슈 %s: %s\n%s\n", issue.Key, issue.Title, issue.URL)
+	if body := strings.TrimSpace(issue.Body); body != "" {
+		fmt.Fprintf(&b, "\n%s\n", truncateOutput(body, issueBodyMaxBytes))
+	}
+	return truncateOutput(b.String(), webhookCommandMaxBytes)
+}
+
+// newIssueLink 조회한 이슈로 연결 생성
+func newIssueLink(config *models.IssueTrackerConfig, issue *models.Issue, sessionID, taskID, userID string) *models.IssueLink {
+	return &models.IssueLink{
+		WorkspaceID: config.WorkspaceID,
+		SessionID:   sessionID,
+		TaskID:      taskID,
+		Provider:    config.Provider,
+		IssueKey:    issue.Key,
+		IssueURL:    issue.URL,
+		IssueTitle:  issue.Title,
+		CreatedBy:   userID,
+	}
+}
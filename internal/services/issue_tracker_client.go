@@ -0,0 +1,270 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/breaker"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+var (
+	// jiraIssueKeyPattern Jira 이슈 키 (PROJ-123)
+	jiraIssueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-[0-9]+$`)
+	// githubIssueRefPattern GitHub 이슈 참조 (owner/name#123)
+	githubIssueRefPattern = regexp.MustCompile(`^([A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+)#([0-9]+)$`)
+)
+
+// IssueTrackerClient Jira REST API(v2)와 GitHub Issues API 클라이언트
+type IssueTrackerClient struct {
+	client   *http.Client
+	breakers *breaker.Registry
+}
+
+// NewIssueTrackerClient 새 이슈 트래커 API 클라이언트 생성 (client가 nil이면 30초 제한 기본 클라이언트)
+func NewIssueTrackerClient(client *http.Client) *IssueTrackerClient {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &IssueTrackerClient{client: client}
+}
+
+// SetBreakers API 호스트별 회로 차단기 설정 (열린 호스트는 요청하지 않고 바로 실패)
+func (c *IssueTrackerClient) SetBreakers(breakers *breaker.Registry) {
+	c.breakers = breakers
+}
+
+// GetIssue 이슈 제목, 본문, 상태 조회
+func (c *IssueTrackerClient) GetIssue(ctx context.Context, config *models.IssueTrackerConfig, key string) (*models.Issue, error) {
+	if config.Provider == models.IssueTrackerJira {
+		var issue struct {
+			Key    string `json:"key"`
+			Fields struct {
+				Summary     string `json:"summary"`
+				Description string `json:"description"`
+				Status      struct {
+					Name string `json:"name"`
+				} `json:"status"`
+			} `json:"fields"`
+		}
+		if err := c.do(ctx, config, http.MethodGet, "/rest/api/2/issue/"+url.PathEscape(key)+"?fields=summary,description,status", nil, &issue); err != nil {
+			return nil, err
+		}
+		return &models.Issue{
+			Key:    issue.Key,
+			Title:  issue.Fields.Summary,
+			Body:   issue.Fields.Description,
+			Status: issue.Fields.Status.Name,
+			URL:    jiraBrowseURL(config, issue.Key),
+		}, nil
+	}
+
+	repo, number, err := splitGitHubIssueKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var issue struct {
+		Title   string `json:"title"`
+		Body    string `json:"body"`
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+	}
+	if err := c.do(ctx, config, http.MethodGet, fmt.Sprintf("/repos/%s/issues/%d", repo, number), nil, &issue); err != nil {
+		return nil, err
+	}
+	return &models.Issue{Key: key, Title: issue.Title, Body: issue.Body, Status: issue.State, URL: issue.HTMLURL}, nil
+}
+
+// AddComment 이슈에 댓글 작성
+func (c *IssueTrackerClient) AddComment(ctx context.Context, config *models.IssueTrackerConfig, key, body string) error {
+	if config.Provider == models.IssueTrackerJira {
+		return c.do(ctx, config, http.MethodPost, "/rest/api/2/issue/"+url.PathEscape(key)+"/comment", map[string]interface{}{"body": body}, nil)
+	}
+
+	repo, number, err := splitGitHubIssueKey(key)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, config, http.MethodPost, fmt.Sprintf("/repos/%s/issues/%d/comments", repo, number), map[string]interface{}{"body": body}, nil)
+}
+
+// Transition 이슈 상태 전환
+// Jira는 전환 이름 또는 도착 상태 이름으로 전환을 찾고, GitHub은 이슈 state(open, closed)를 바꿉니다.
+func (c *IssueTrackerClient) Transition(ctx context.Context, config *models.IssueTrackerConfig, key, transition string) error {
+	if config.Provider != models.IssueTrackerJira {
+		repo, number, err := splitGitHubIssueKey(key)
+		if err != nil {
+			return err
+		}
+		return c.do(ctx, config, http.MethodPatch, fmt.Sprintf("/repos/%s/issues/%d", repo, number), map[string]interface{}{"state": transition}, nil)
+	}
+
+	path := "/rest/api/2/issue/" + url.PathEscape(key) + "/transitions"
+	var available struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			To   struct {
+				Name string `json:"name"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	if err := c.do(ctx, config, http.MethodGet, path, nil, &available); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(available.Transitions))
+	for _, t := range available.Transitions {
+		if strings.EqualFold(t.Name, transition) || strings.EqualFold(t.To.Name, transition) {
+			return c.do(ctx, config, http.MethodPost, path, map[string]interface{}{"transition": map[string]string{"id": t.ID}}, nil)
+		}
+		names = append(names, t.Name)
+	}
+	return fmt.Errorf("%s에서 사용할 수 없는 전환입니다: %s (가능한 전환: %s)", key, transition, strings.Join(names, ", "))
+}
+
+// do API 요청 (2xx가 아니면 응답의 오류 메시지를 에러에 포함)
+func (c *IssueTrackerClient) do(ctx context.Context, config *models.IssueTrackerConfig, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, issueTrackerAPIURL(config)+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch {
+	case config.Provider == models.IssueTrackerJira && config.Username != "":
+		req.SetBasicAuth(config.Username, config.Token)
+	case config.Provider == models.IssueTrackerJira:
+		req.Header.Set("Authorization", "Bearer "+config.Token)
+	default:
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("Authorization", "Bearer "+config.Token)
+	}
+
+	done, err := c.breakers.Get(hostBreakerName("issue_tracker", issueTrackerAPIURL(config))).Allow(ctx)
+	if err != nil {
+		return fmt.Errorf("%s API 요청 실패: %w", config.Provider, err)
+	}
+	resp, err := c.client.Do(req)
+	done(hostFailure(resp, err))
+	if err != nil {
+		return fmt.Errorf("%s API 요청 실패: %w", config.Provider, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("%s API 응답 읽기 실패: %w", config.Provider, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message       string   `json:"message"`
+			ErrorMessages []string `json:"errorMessages"`
+		}
+		message := strings.TrimSpace(string(data))
+		if json.Unmarshal(data, &apiErr) == nil {
+			if apiErr.Message != "" {
+				message = apiErr.Message
+			} else if len(apiErr.ErrorMessages) > 0 {
+				message = strings.Join(apiErr.ErrorMessages, "; ")
+			}
+		}
+		return fmt.Errorf("%s API 오류 (%d): %s", config.Provider, resp.StatusCode, truncateOutput(message, 500))
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return fmt.Errorf("%s API 응답 해석 실패: %w", config.Provider, err)
+		}
+	}
+	return nil
+}
+
+// issueTrackerAPIURL API 기본 주소 (GitHub은 비어 있으면 공용 API)
+func issueTrackerAPIURL(config *models.IssueTrackerConfig) string {
+	if config.BaseURL == "" && config.Provider == models.IssueTrackerGitHub {
+		return models.DefaultGitHubAPIURL
+	}
+	return strings.TrimRight(config.BaseURL, "/")
+}
+
+// jiraBrowseURL Jira 이슈 화면 주소
+func jiraBrowseURL(config *models.IssueTrackerConfig, key string) string {
+	return strings.TrimRight(config.BaseURL, "/") + "/browse/" + key
+}
+
+// parseIssueRef 이슈 참조를 정규화한 이슈 키로 변환
+// Jira는 PROJ-123, 123(기본 프로젝트), .../browse/PROJ-123 URL을,
+// GitHub은 owner/name#123, #123 또는 123(기본 리포지토리), .../owner/name/issues/123 URL을 받습니다.
+func parseIssueRef(config *models.IssueTrackerConfig, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", fmt.Errorf("이슈 참조가 비어 있습니다")
+	}
+
+	if config.Provider == models.IssueTrackerJira {
+		if i := strings.Index(ref, "/browse/"); i >= 0 {
+			ref = ref[i+len("/browse/"):]
+			if end := strings.IndexAny(ref, "/?#"); end >= 0 {
+				ref = ref[:end]
+			}
+		}
+		if _, err := strconv.Atoi(ref); err == nil && config.DefaultProject != "" {
+			ref = config.DefaultProject + "-" + ref
+		}
+		key := strings.ToUpper(ref)
+		if !jiraIssueKeyPattern.MatchString(key) {
+			return "", fmt.Errorf("Jira 이슈 키가 올바르지 않습니다: %s", ref)
+		}
+		return key, nil
+	}
+
+	if strings.Contains(ref, "://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return "", fmt.Errorf("이슈 URL이 올바르지 않습니다: %s", ref)
+		}
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) < 4 || (parts[len(parts)-2] != "issues" && parts[len(parts)-2] != "pull") {
+			return "", fmt.Errorf("GitHub 이슈 URL이 아닙니다: %s", ref)
+		}
+		ref = parts[len(parts)-4] + "/" + parts[len(parts)-3] + "#" + parts[len(parts)-1]
+	}
+	if number := strings.TrimPrefix(ref, "#"); !strings.Contains(number, "/") {
+		if config.DefaultProject == "" {
+			return "", fmt.Errorf("기본 리포지토리가 없으므로 owner/name#번호 형식으로 지정해야 합니다: %s", ref)
+		}
+		ref = config.DefaultProject + "#" + number
+	}
+	if !githubIssueRefPattern.MatchString(ref) {
+		return "", fmt.Errorf("GitHub 이슈 참조가 올바르지 않습니다: %s", ref)
+	}
+	return ref, nil
+}
+
+// splitGitHubIssueKey owner/name#123 형식의 키를 리포지토리와 번호로 분리
+func splitGitHubIssueKey(key string) (string, int, error) {
+	match := githubIssueRefPattern.FindStringSubmatch(key)
+	if match == nil {
+		return "", 0, fmt.Errorf("GitHub 이슈 키가 올바르지 않습니다: %s", key)
+	}
+	number, _ := strconv.Atoi(match[2])
+	return match[1], number, nil
+}
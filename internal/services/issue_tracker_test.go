@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/breaker"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeIssueTrackerAPI 호출을 기록하는 테스트용 이슈 트래커
+type fakeIssueTrackerAPI struct {
+	comments    map[string][]string
+	transitions map[string]string
+}
+
+func (f *fakeIssueTrackerAPI) GetIssue(ctx context.Context, config *models.IssueTrackerConfig, key string) (*models.Issue, error) {
+	return &models.Issue{Key: key, Title: "로그인 실패", Body: "비밀번호에 특수문자가 있으면 로그인할 수 없습니다", URL: "https://acme.atlassian.net/browse/" + key}, nil
+}
+
+func (f *fakeIssueTrackerAPI) AddComment(ctx context.Context, config *models.IssueTrackerConfig, key, body string) error {
+	f.comments[key] = append(f.comments[key], body)
+	return nil
+}
+
+func (f *fakeIssueTrackerAPI) Transition(ctx context.Context, config *models.IssueTrackerConfig, key, transition string) error {
+	f.transitions[key] = transition
+	return nil
+}
+
+func TestIssueTrackerService_TaskLifecycle(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	api := &fakeIssueTrackerAPI{comments: map[string][]string{}, transitions: map[string]string{}}
+	tasks := &fakePipelineTasks{}
+	service := NewIssueTrackerService(store, api, tasks, "https://aicli.example.com")
+	ws, session := createWorkspaceWithSession(t, store, "alice", "api")
+
+	_, err := service.CreateTask(ctx, session.ID, "alice", &models.IssueTaskCreateRequest{Issue: "PROJ-1"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	_, err = service.SaveConfig(ctx, ws.ID, "alice", &models.IssueTrackerConfigRequest{Provider: models.IssueTrackerJira, Token: "t"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	config, err := service.SaveConfig(ctx, ws.ID, "alice", &models.IssueTrackerConfigRequest{
+		Provider:           models.IssueTrackerJira,
+		BaseURL:            "https://acme.atlassian.net/",
+		DefaultProject:     "proj",
+		Username:           "bot@acme.io",
+		Token:              "jira-token",
+		CommentOnFinish:    true,
+		ApprovalTransition: "Done",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "PROJ", config.DefaultProject)
+	assert.True(t, config.HasToken)
+
+	result, err := service.CreateTask(ctx, session.ID, "alice", &models.IssueTaskCreateRequest{Issue: "42", Instructions: "원인을 찾아 고치세요"})
+	require.NoError(t, err)
+	require.NotNil(t, result.Link)
+	assert.Equal(t, "PROJ-42", result.Link.IssueKey)
+	assert.Equal(t, result.Task.ID, result.Link.TaskID)
+	command := tasks.last().Command
+	assert.Contains(t, command, "원인을 찾아 고치세요")
+	assert.Contains(t, command, "## 이슈 PROJ-42: 로그인 실패")
+	assert.Contains(t, command, "특수문자")
+
+	// 세션 전체 연결과 중복 연결
+	_, err = service.LinkSession(ctx, session.ID, "alice", &models.IssueLinkRequest{Issue: "https://acme.atlassian.net/browse/PROJ-7?focused=1"})
+	require.NoError(t, err)
+	_, err = service.LinkSession(ctx, session.ID, "alice", &models.IssueLinkRequest{Issue: "proj-7"})
+	assertWorkspaceErrorCode(t, err, ErrCodeResourceBusy)
+
+	task := tasks.last()
+	task.Status = models.TaskCompleted
+	task.Output = "특수문자 이스케이프 수정"
+	require.NoError(t, store.Task().Create(ctx, task))
+	links, err := service.ListTaskLinks(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Len(t, links, 2)
+
+	service.commentFinished(ctx, task)
+	require.Len(t, api.comments["PROJ-42"], 1)
+	require.Len(t, api.comments["PROJ-7"], 1)
+	assert.Contains(t, api.comments["PROJ-42"][0], "{noformat}\n특수문자 이스케이프 수정\n{noformat}")
+	assert.Contains(t, api.comments["PROJ-42"][0], "https://aicli.example.com/sessions/"+session.ID)
+
+	// 승인 전환은 태스크에 직접 연결된 이슈에만 적용
+	service.transitionApproved(ctx, &models.TaskReview{TaskID: task.ID, SessionID: session.ID, Status: models.TaskReviewApproved})
+	assert.Equal(t, map[string]string{"PROJ-42": "Done"}, api.transitions)
+	links, err = service.ListSessionLinks(ctx, session.ID)
+	require.NoError(t, err)
+	for _, link := range links {
+		assert.NotNil(t, link.CommentedAt)
+		assert.Equal(t, link.TaskID != "", link.TransitionedAt != nil)
+	}
+
+	assertWorkspaceErrorCode(t, service.UnlinkTask(ctx, "other-task", result.Link.ID), ErrCodeNotFound)
+	require.NoError(t, service.UnlinkTask(ctx, task.ID, result.Link.ID))
+	links, err = service.ListTaskLinks(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Len(t, links, 1)
+}
+
+func TestParseIssueRef(t *testing.T) {
+	jira := &models.IssueTrackerConfig{Provider: models.IssueTrackerJira, DefaultProject: "PROJ"}
+	github := &models.IssueTrackerConfig{Provider: models.IssueTrackerGitHub, DefaultProject: "acme/api"}
+
+	tests := []struct {
+		config *models.IssueTrackerConfig
+		ref    string
+		want   string
+	}{
+		{jira, "abc-12", "ABC-12"},
+		{jira, "12", "PROJ-12"},
+		{jira, "https://acme.atlassian.net/browse/OPS-3", "OPS-3"},
+		{jira, "not a key", ""},
+		{github, "#12", "acme/api#12"},
+		{github, "octo/web#5", "octo/web#5"},
+		{github, "https://github.com/octo/web/issues/9", "octo/web#9"},
+		{github, "https://github.com/octo/web", ""},
+		{&models.IssueTrackerConfig{Provider: models.IssueTrackerGitHub}, "#12", ""},
+	}
+	for _, tt := range tests {
+		got, err := parseIssueRef(tt.config, tt.ref)
+		if tt.want == "" {
+			assert.Error(t, err, tt.ref)
+			continue
+		}
+		require.NoError(t, err, tt.ref)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestIssueTrackerClient_JiraTransition(t *testing.T) {
+	var applied map[string]map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "bot@acme.io:token", user+":"+pass)
+		require.Equal(t, "/rest/api/2/issue/PROJ-1/transitions", r.URL.Path)
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"transitions": [{"id": "11", "name": "Start", "to": {"name": "In Progress"}}, {"id": "31", "name": "Resolve", "to": {"name": "Done"}}]}`))
+			return
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&applied))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewIssueTrackerClient(server.Client())
+	config := &models.IssueTrackerConfig{Provider: models.IssueTrackerJira, BaseURL: server.URL, Username: "bot@acme.io", Token: "token"}
+	require.NoError(t, client.Transition(context.Background(), config, "PROJ-1", "done"))
+	assert.Equal(t, "31", applied["transition"]["id"])
+
+	err := client.Transition(context.Background(), config, "PROJ-1", "Closed")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Start, Resolve")
+}
+
+func TestIssueTrackerClient_Breaker(t *testing.T) {
+	status := http.StatusNotFound
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	breakers := breaker.NewRegistry(breaker.Config{FailureThreshold: 2, OpenTimeout: time.Minute}, nil)
+	client := NewIssueTrackerClient(server.Client())
+	client.SetBreakers(breakers)
+	config := &models.IssueTrackerConfig{Provider: models.IssueTrackerJira, BaseURL: server.URL, Token: "token"}
+
+	// 4xx는 요청 문제이므로 회로를 열지 않음
+	for i := 0; i < 2; i++ {
+		require.Error(t, client.AddComment(context.Background(), config, "PROJ-1", "완료"))
+	}
+	require.Len(t, breakers.Snapshots(), 1)
+	assert.Equal(t, "closed", breakers.Snapshots()[0].State)
+
+	status = http.StatusBadGateway
+	for i := 0; i < 2; i++ {
+		require.Error(t, client.AddComment(context.Background(), config, "PROJ-1", "완료"))
+	}
+	assert.Equal(t, "open", breakers.Snapshots()[0].State)
+
+	err := client.AddComment(context.Background(), config, "PROJ-1", "완료")
+	assert.ErrorIs(t, err, breaker.ErrOpen)
+	assert.Equal(t, 4, calls)
+}
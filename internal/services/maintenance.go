@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// ErrMaintenanceMode 유지보수 모드라 새 태스크를 받을 수 없음
+var ErrMaintenanceMode = errors.New("maintenance mode")
+
+// MaintenanceError 유지보수 모드로 거부된 요청의 안내 정보
+type MaintenanceError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *MaintenanceError) Error() string {
+	return fmt.Sprintf("유지보수 중이라 새 태스크를 받을 수 없습니다: %s", e.Message)
+}
+
+// Is errors.Is(err, ErrMaintenanceMode)로 확인할 수 있도록 합니다
+func (e *MaintenanceError) Is(target error) bool {
+	return target == ErrMaintenanceMode
+}
+
+// MaintenanceConfig 유지보수 모드 기본값
+type MaintenanceConfig struct {
+	Message          string
+	RetryAfter       time.Duration
+	QueueSubmissions bool
+	MaxQueued        int
+}
+
+// MaintenanceService 유지보수 모드 상태를 관리하는 서비스
+// 유지보수 중에는 새 태스크를 거부하거나(503) 보관했다가 유지보수가 끝나면 큐에 제출하며,
+// 이미 실행 중이거나 큐에 들어간 태스크는 그대로 완료됩니다.
+// 상태는 인스턴스 메모리에만 있으므로 보관 중인 태스크는 재시작하면 대기 상태로 남습니다.
+type MaintenanceService struct {
+	defaults MaintenanceConfig
+
+	mu      sync.Mutex
+	status  models.MaintenanceStatus
+	held    []*models.Task
+	release func(tasks []*models.Task)
+}
+
+// NewMaintenanceService 새 유지보수 모드 서비스 생성
+func NewMaintenanceService(config MaintenanceConfig) *MaintenanceService {
+	return &MaintenanceService{defaults: config}
+}
+
+// Status 현재 유지보수 모드 상태
+func (s *MaintenanceService) Status() models.MaintenanceStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.status
+	status.Queued = len(s.held)
+	return status
+}
+
+// Update 유지보수 모드를 켜거나 끕니다
+// 끄면 보관 중인 태스크를 큐에 제출합니다.
+func (s *MaintenanceService) Update(req *models.MaintenanceUpdateRequest) models.MaintenanceStatus {
+	if req.Enabled {
+		retryAfter := time.Duration(req.RetryAfterSeconds) * time.Second
+		queue := s.defaults.QueueSubmissions
+		if req.QueueSubmissions != nil {
+			queue = *req.QueueSubmissions
+		}
+		s.Enable(req.Message, retryAfter, queue)
+	} else {
+		s.Disable()
+	}
+	return s.Status()
+}
+
+// Enable 유지보수 모드 시작 (비어 있는 값은 기본값 사용, 이미 켜져 있으면 안내 정보만 변경)
+func (s *MaintenanceService) Enable(message string, retryAfter time.Duration, queueSubmissions bool) {
+	if message == "" {
+		message = s.defaults.Message
+	}
+	if retryAfter <= 0 {
+		retryAfter = s.defaults.RetryAfter
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.status.Enabled {
+		now := time.Now()
+		s.status.Since = &now
+		log.Printf("유지보수 모드 시작 (태스크 보관: %t)", queueSubmissions)
+	}
+	s.status.Enabled = true
+	s.status.Message = message
+	s.status.RetryAfterSeconds = int(retryAfter / time.Second)
+	s.status.QueueSubmissions = queueSubmissions
+}
+
+// Disable 유지보수 모드 종료 후 보관 중인 태스크를 큐에 제출합니다
+func (s *MaintenanceService) Disable() {
+	s.mu.Lock()
+	if !s.status.Enabled {
+		s.mu.Unlock()
+		return
+	}
+	s.status = models.MaintenanceStatus{}
+	held, release := s.held, s.release
+	s.held = nil
+	s.mu.Unlock()
+
+	log.Printf("유지보수 모드 종료 (보관된 태스크 %d개 제출)", len(held))
+	if release != nil && len(held) > 0 {
+		release(held)
+	}
+}
+
+// Check 새 태스크를 받을 수 있는지 확인 (거부해야 하면 *MaintenanceError)
+func (s *MaintenanceService) Check() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rejection()
+}
+
+// hold 유지보수 중이면 태스크를 보관합니다 (보관했으면 true, 거부해야 하면 에러)
+func (s *MaintenanceService) hold(task *models.Task) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.status.Enabled {
+		return false, nil
+	}
+	if err := s.rejection(); err != nil {
+		return false, err
+	}
+	s.held = append(s.held, task)
+	return true, nil
+}
+
+// rejection 유지보수 중 새 태스크를 거부해야 하는 경우의 에러 (mu를 잡은 상태에서 호출)
+func (s *MaintenanceService) rejection() error {
+	if !s.status.Enabled {
+		return nil
+	}
+	if s.status.QueueSubmissions && (s.defaults.MaxQueued <= 0 || len(s.held) < s.defaults.MaxQueued) {
+		return nil
+	}
+	return &MaintenanceError{
+		Message:    s.status.Message,
+		RetryAfter: time.Duration(s.status.RetryAfterSeconds) * time.Second,
+	}
+}
+
+// setRelease 유지보수가 끝났을 때 보관한 태스크를 제출할 함수 설정
+func (s *MaintenanceService) setRelease(release func(tasks []*models.Task)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.release = release
+}
+
+// SetMaintenance 새 태스크 제출에 적용할 유지보수 모드 설정
+func (ts *TaskService) SetMaintenance(maintenance *MaintenanceService) {
+	ts.maintenance = maintenance
+	maintenance.setRelease(ts.releaseHeld)
+}
+
+// submit 태스크를 큐에 제출합니다 (유지보수 중이거나 호스트 자원이 부족하면 보관)
+func (ts *TaskService) submit(task *models.Task) error {
+	if ts.maintenance != nil {
+		held, err := ts.maintenance.hold(task)
+		if err != nil || held {
+			return err
+		}
+	}
+	if deferred, err := ts.admit(task); err != nil || deferred {
+		return err
+	}
+	return ts.taskQueue.Submit(task)
+}
+
+// releaseHeld 유지보수 중 보관한 태스크를 큐에 제출합니다
+// 보관 중에 취소되었거나 삭제된 태스크는 건너뜁니다.
+func (ts *TaskService) releaseHeld(tasks []*models.Task) {
+	ctx := context.Background()
+	for _, held := range tasks {
+		task, err := ts.storage.Task().GetByID(ctx, held.ID)
+		if err != nil || task.Status != models.TaskPending {
+			continue
+		}
+		if err := ts.taskQueue.Submit(task); err != nil {
+			task.SetFailed(fmt.Sprintf("유지보수 종료 후 큐 제출 실패: %v", err))
+			if updateErr := ts.storage.Task().Update(ctx, task); updateErr != nil {
+				log.Printf("태스크 상태 저장 실패: %s: %v", task.ID, updateErr)
+			}
+			ts.notifyFinished(task)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+func TestMaintenance_RejectsNewTasks(t *testing.T) {
+	taskService, _, session := setupTaskTest()
+	defer taskService.Stop()
+
+	maintenance := NewMaintenanceService(MaintenanceConfig{Message: "점검 중", RetryAfter: 2 * time.Minute})
+	taskService.SetMaintenance(maintenance)
+
+	maintenance.Enable("", 0, false)
+	status := maintenance.Status()
+	assert.True(t, status.Enabled)
+	assert.Equal(t, "점검 중", status.Message)
+	assert.Equal(t, 120, status.RetryAfterSeconds)
+	require.NotNil(t, status.Since)
+
+	_, err := taskService.Create(context.Background(), &models.TaskCreateRequest{
+		SessionID: session.ID,
+		Command:   "echo blocked",
+	})
+	require.ErrorIs(t, err, ErrMaintenanceMode)
+	var maintenanceErr *MaintenanceError
+	require.ErrorAs(t, err, &maintenanceErr)
+	assert.Equal(t, 2*time.Minute, maintenanceErr.RetryAfter)
+
+	maintenance.Disable()
+	assert.False(t, maintenance.Status().Enabled)
+	_, err = taskService.Create(context.Background(), &models.TaskCreateRequest{
+		SessionID: session.ID,
+		Command:   "echo allowed",
+	})
+	assert.NoError(t, err)
+}
+
+func TestMaintenance_QueuesSubmissions(t *testing.T) {
+	taskService, _, session := setupTaskTest()
+	defer taskService.Stop()
+
+	maintenance := NewMaintenanceService(MaintenanceConfig{MaxQueued: 2})
+	taskService.SetMaintenance(maintenance)
+	queue := true
+	maintenance.Update(&models.MaintenanceUpdateRequest{Enabled: true, QueueSubmissions: &queue})
+
+	create := func(command string) (*models.Task, error) {
+		return taskService.Create(context.Background(), &models.TaskCreateRequest{
+			SessionID: session.ID,
+			Command:   command,
+		})
+	}
+
+	held, err := create("echo held")
+	require.NoError(t, err)
+	cancelled, err := create("echo cancelled")
+	require.NoError(t, err)
+	assert.Equal(t, 2, maintenance.Status().Queued)
+
+	// 보관 한도를 넘으면 거부
+	_, err = create("echo overflow")
+	assert.ErrorIs(t, err, ErrMaintenanceMode)
+
+	// 보관 중에는 실행되지 않음
+	time.Sleep(200 * time.Millisecond)
+	stored, err := taskService.storage.Task().GetByID(context.Background(), held.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskPending, stored.Status)
+
+	require.NoError(t, taskService.Cancel(context.Background(), cancelled.ID))
+
+	// 유지보수가 끝나면 보관한 태스크 실행 (취소된 태스크 제외)
+	status := maintenance.Update(&models.MaintenanceUpdateRequest{Enabled: false})
+	assert.False(t, status.Enabled)
+	assert.Zero(t, status.Queued)
+
+	assert.Eventually(t, func() bool {
+		task, ok := taskService.taskQueue.GetTask(held.ID)
+		return ok && task.IsTerminal()
+	}, 5*time.Second, 20*time.Millisecond)
+	_, submitted := taskService.taskQueue.GetTask(cancelled.ID)
+	assert.False(t, submitted)
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+// maskedValue 응답에서 시크릿 값을 대신할 문자열
+const maskedValue = "********"
+
+// MCPServer 워크스페이스에 선언된 MCP 서버 (응답용, 시크릿 값 마스킹)
+type MCPServer struct {
+	Name string `json:"name"`
+	claude.MCPServerConfig
+	Status *claude.MCPServerStatus `json:"status,omitempty"`
+}
+
+// MCPService 워크스페이스별 MCP 서버 선언과 상태를 관리하는 서비스
+// 선언은 "<dir>/<workspaceID>.json" 파일에 저장되며, 세션 시작 시 Claude CLI의 MCP 설정 파일로 전달됩니다.
+type MCPService struct {
+	dir      string
+	checker  *claude.MCPHealthChecker
+	interval time.Duration
+
+	mu       sync.RWMutex
+	statuses map[string]map[string]claude.MCPServerStatus
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewMCPService 새 MCP 서비스 생성
+func NewMCPService(dir string, checker *claude.MCPHealthChecker, interval time.Duration) *MCPService {
+	if checker == nil {
+		checker = claude.NewMCPHealthChecker(0)
+	}
+	return &MCPService{
+		dir:      dir,
+		checker:  checker,
+		interval: interval,
+		statuses: make(map[string]map[string]claude.MCPServerStatus),
+	}
+}
+
+// List 워크스페이스의 MCP 서버 목록 (마지막 상태 포함)
+func (s *MCPService) List(ctx context.Context, workspaceID string) ([]MCPServer, error) {
+	servers, err := s.load(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]MCPServer, 0, len(servers))
+	for _, name := range sortedMCPNames(servers) {
+		server := MCPServer{Name: name, MCPServerConfig: maskMCPServer(servers[name])}
+		if status, ok := s.statuses[workspaceID][name]; ok {
+			server.Status = &status
+		}
+		result = append(result, server)
+	}
+	return result, nil
+}
+
+// Put MCP 서버 선언 추가 또는 갱신
+func (s *MCPService) Put(ctx context.Context, workspaceID, name string, config claude.MCPServerConfig) (*MCPServer, error) {
+	if err := claude.ValidateMCPServerName(name); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, err.Error(), nil)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, err.Error(), nil)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	servers, err := s.load(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	servers[name] = config
+	if err := s.save(workspaceID, servers); err != nil {
+		return nil, err
+	}
+	// 설정이 바뀌었으므로 이전 상태는 버림
+	delete(s.statuses[workspaceID], name)
+
+	return &MCPServer{Name: name, MCPServerConfig: maskMCPServer(config)}, nil
+}
+
+// Delete MCP 서버 선언 삭제
+func (s *MCPService) Delete(ctx context.Context, workspaceID, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	servers, err := s.load(workspaceID)
+	if err != nil {
+		return err
+	}
+	if _, exists := servers[name]; !exists {
+		return NewWorkspaceError(ErrCodeNotFound, "MCP 서버를 찾을 수 없습니다", nil)
+	}
+	delete(servers, name)
+	delete(s.statuses[workspaceID], name)
+	return s.save(workspaceID, servers)
+}
+
+// MCPServers 세션 시작 시 사용할 워크스페이스의 MCP 서버 설정 (claude.MCPServerProvider 구현)
+func (s *MCPService) MCPServers(workspaceID string) map[string]claude.MCPServerConfig {
+	servers, err := s.load(workspaceID)
+	if err != nil {
+		log.Printf("MCP 서버 설정 로드 실패 (워크스페이스: %s): %v", workspaceID, err)
+		return nil
+	}
+	if len(servers) == 0 {
+		return nil
+	}
+	return servers
+}
+
+// Check 워크스페이스의 모든 MCP 서버 상태를 즉시 확인
+func (s *MCPService) Check(ctx context.Context, workspaceID string) ([]claude.MCPServerStatus, error) {
+	servers, err := s.load(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]claude.MCPServerStatus, len(servers))
+	var wg sync.WaitGroup
+	for i, name := range sortedMCPNames(servers) {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			results[i] = s.checker.Check(ctx, name, servers[name])
+		}(i, name)
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	statuses := make(map[string]claude.MCPServerStatus, len(results))
+	for _, status := range results {
+		statuses[status.Name] = status
+	}
+	s.statuses[workspaceID] = statuses
+	s.mu.Unlock()
+
+	return results, nil
+}
+
+// Start 주기적인 MCP 서버 상태 확인 시작
+func (s *MCPService) Start(ctx context.Context) {
+	if s.interval <= 0 || s.stopCh != nil {
+		return
+	}
+	s.stopCh = make(chan struct{})
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+				s.checkAll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop 주기적인 상태 확인 중지
+func (s *MCPService) Stop() {
+	if s.stopCh == nil {
+		return
+	}
+	close(s.stopCh)
+	s.wg.Wait()
+	s.stopCh = nil
+}
+
+// checkAll MCP 서버가 선언된 모든 워크스페이스 상태 확인
+func (s *MCPService) checkAll(ctx context.Context) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		workspaceID := strings.TrimSuffix(entry.Name(), ".json")
+		results, err := s.Check(ctx, workspaceID)
+		if err != nil {
+			log.Printf("MCP 서버 상태 확인 실패 (워크스페이스: %s): %v", workspaceID, err)
+			continue
+		}
+		for _, status := range results {
+			if !status.Healthy {
+				log.Printf("MCP 서버 비정상 (워크스페이스: %s, 서버: %s): %s", workspaceID, status.Name, status.Error)
+			}
+		}
+	}
+}
+
+// path 워크스페이스 선언 파일 경로
+func (s *MCPService) path(workspaceID string) (string, error) {
+	if workspaceID == "" || strings.ContainsAny(workspaceID, `/\`) || strings.HasPrefix(workspaceID, ".") {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 워크스페이스 ID입니다", nil)
+	}
+	return filepath.Join(s.dir, workspaceID+".json"), nil
+}
+
+// load 워크스페이스의 MCP 서버 선언 로드
+func (s *MCPService) load(workspaceID string) (map[string]claude.MCPServerConfig, error) {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make(map[string]claude.MCPServerConfig)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return servers, nil
+		}
+		return nil, fmt.Errorf("MCP 서버 설정 읽기 실패: %w", err)
+	}
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return nil, fmt.Errorf("MCP 서버 설정 파싱 실패: %w", err)
+	}
+	return servers, nil
+}
+
+// save 워크스페이스의 MCP 서버 선언 저장 (시크릿이 포함될 수 있어 0600)
+func (s *MCPService) save(workspaceID string, servers map[string]claude.MCPServerConfig) error {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return err
+	}
+	if len(servers) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(servers, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("MCP 설정 디렉토리 생성 실패: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// maskMCPServer 환경 변수와 헤더 값 마스킹
+func maskMCPServer(config claude.MCPServerConfig) claude.MCPServerConfig {
+	mask := func(values map[string]string) map[string]string {
+		if len(values) == 0 {
+			return nil
+		}
+		masked := make(map[string]string, len(values))
+		for k := range values {
+			masked[k] = maskedValue
+		}
+		return masked
+	}
+	config.Env = mask(config.Env)
+	config.Headers = mask(config.Headers)
+	return config
+}
+
+func sortedMCPNames(servers map[string]claude.MCPServerConfig) []string {
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
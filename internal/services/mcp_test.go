@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+)
+
+func TestMCPService_CRUD(t *testing.T) {
+	dir := t.TempDir()
+	svc := NewMCPService(dir, nil, 0)
+	ctx := context.Background()
+
+	servers, err := svc.List(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Empty(t, servers)
+	assert.Nil(t, svc.MCPServers("ws-1"))
+
+	github := claude.MCPServerConfig{
+		Command: "npx",
+		Args:    []string{"-y", "@modelcontextprotocol/server-github"},
+		Env:     map[string]string{"GITHUB_TOKEN": "ghp_secret"},
+	}
+	saved, err := svc.Put(ctx, "ws-1", "github", github)
+	require.NoError(t, err)
+	assert.Equal(t, maskedValue, saved.Env["GITHUB_TOKEN"])
+
+	_, err = svc.Put(ctx, "ws-1", "docs", claude.MCPServerConfig{Type: claude.MCPTransportHTTP, URL: "https://docs.example.com/mcp"})
+	require.NoError(t, err)
+
+	// 응답에서는 마스킹, 세션 시작용 설정에서는 원래 값
+	servers, err = svc.List(ctx, "ws-1")
+	require.NoError(t, err)
+	require.Len(t, servers, 2)
+	assert.Equal(t, "docs", servers[0].Name)
+	assert.Equal(t, "github", servers[1].Name)
+	assert.Equal(t, maskedValue, servers[1].Env["GITHUB_TOKEN"])
+	assert.Equal(t, "ghp_secret", svc.MCPServers("ws-1")["github"].Env["GITHUB_TOKEN"])
+
+	info, err := os.Stat(filepath.Join(dir, "ws-1.json"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// 다른 워크스페이스와 분리
+	assert.Nil(t, svc.MCPServers("ws-2"))
+
+	require.NoError(t, svc.Delete(ctx, "ws-1", "github"))
+	require.NoError(t, svc.Delete(ctx, "ws-1", "docs"))
+	assert.Error(t, svc.Delete(ctx, "ws-1", "docs"))
+	_, err = os.Stat(filepath.Join(dir, "ws-1.json"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestMCPService_Validation(t *testing.T) {
+	svc := NewMCPService(t.TempDir(), nil, 0)
+	ctx := context.Background()
+
+	_, err := svc.Put(ctx, "ws-1", "bad name", claude.MCPServerConfig{Command: "npx"})
+	assert.Error(t, err)
+
+	_, err = svc.Put(ctx, "ws-1", "empty", claude.MCPServerConfig{})
+	assert.Error(t, err)
+
+	_, err = svc.Put(ctx, "../escape", "github", claude.MCPServerConfig{Command: "npx"})
+	assert.Error(t, err)
+}
+
+func TestMCPService_CheckRecordsStatus(t *testing.T) {
+	svc := NewMCPService(t.TempDir(), nil, 0)
+	ctx := context.Background()
+
+	_, err := svc.Put(ctx, "ws-1", "missing", claude.MCPServerConfig{Command: "/nonexistent/mcp-server"})
+	require.NoError(t, err)
+
+	statuses, err := svc.Check(ctx, "ws-1")
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.False(t, statuses[0].Healthy)
+	assert.NotEmpty(t, statuses[0].Error)
+
+	servers, err := svc.List(ctx, "ws-1")
+	require.NoError(t, err)
+	require.NotNil(t, servers[0].Status)
+	assert.False(t, servers[0].Status.Healthy)
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// maxSearchQueryLength 검색어 최대 길이
+const maxSearchQueryLength = 500
+
+// MessageService 세션 대화 기록 저장과 검색을 담당하는 서비스
+// 조회와 검색은 메시지가 속한 워크스페이스의 소유자, ACL로 공유받은 사용자(또는 admin)로 제한됩니다.
+type MessageService struct {
+	storage storage.Storage
+}
+
+// NewMessageService 새 대화 기록 서비스 생성
+func NewMessageService(storage storage.Storage) *MessageService {
+	return &MessageService{storage: storage}
+}
+
+// Record 세션 메시지 저장
+func (s *MessageService) Record(ctx context.Context, message *models.SessionMessage) error {
+	if message.SessionID == "" || message.WorkspaceID == "" {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "세션 ID와 워크스페이스 ID가 필요합니다", nil)
+	}
+	switch message.Role {
+	case models.MessageRoleUser, models.MessageRoleAssistant, models.MessageRoleSystem:
+	default:
+		return NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 메시지 역할입니다", nil)
+	}
+	return s.storage.Message().Append(ctx, message)
+}
+
+// ListBySession 세션의 메시지를 순서대로 조회
+// admin이 true이면 소유자 확인을 건너뜁니다.
+func (s *MessageService) ListBySession(ctx context.Context, sessionID, userID string, admin bool, paging *models.PaginationRequest) (*models.PaginationResponse, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	if !admin {
+		workspaceID, err := sessionWorkspaceID(ctx, s.storage, sessionID)
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInsufficientPerm, "세션에 접근할 권한이 없습니다", ErrUnauthorized)
+		}
+		if err := s.checkOwner(ctx, workspaceID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	messages, total, err := s.storage.Message().ListBySession(ctx, sessionID, paging)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PaginationResponse{
+		Data: messages,
+		Meta: models.NewPaginationMeta(paging.Page, paging.Limit, total),
+	}, nil
+}
+
+// Search 사용자가 소유하거나 공유받은 워크스페이스의 대화 기록 검색
+// query.WorkspaceIDs를 지정하면 그중 접근할 수 있는 워크스페이스로 한정하며, admin이 true이면 소유자 확인을 건너뜁니다.
+func (s *MessageService) Search(ctx context.Context, userID string, admin bool, query *models.MessageSearchQuery, paging *models.PaginationRequest) (*models.PaginationResponse, error) {
+	query.Query = strings.TrimSpace(query.Query)
+	if query.Query == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "검색어가 필요합니다", nil)
+	}
+	if len(query.Query) > maxSearchQueryLength {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "검색어가 너무 깁니다", nil)
+	}
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	if !admin {
+		owned, err := s.ownedWorkspaceIDs(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		query.WorkspaceIDs = intersectIDs(owned, query.WorkspaceIDs)
+	}
+
+	results, total, err := s.storage.Message().Search(ctx, query, paging)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PaginationResponse{
+		Data: results,
+		Meta: models.NewPaginationMeta(paging.Page, paging.Limit, total),
+	}, nil
+}
+
+// checkOwner 워크스페이스 read 권한 확인 (소유자 또는 ACL로 공유받은 사용자)
+func (s *MessageService) checkOwner(ctx context.Context, workspaceID, userID string) error {
+	return checkSessionWorkspaceAccess(ctx, s.storage, workspaceID, userID, models.WorkspacePermissionRead)
+}
+
+// sessionWorkspaceID 세션이 속한 워크스페이스 ID 조회
+// 대화 기록의 워크스페이스 ID는 실행 요청에서 온 값이라 권한 판단에 쓰지 않고 세션 → 프로젝트 순으로 찾습니다.
+func sessionWorkspaceID(ctx context.Context, store storage.Storage, sessionID string) (string, error) {
+	session, err := store.Session().GetByID(ctx, sessionID)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+	}
+	project, err := store.Project().GetByID(ctx, session.ProjectID)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeNotFound, "세션의 프로젝트를 찾을 수 없습니다", err)
+	}
+	return project.WorkspaceID, nil
+}
+
+// checkSessionWorkspaceAccess 세션이 속한 워크스페이스에서 required 권한 확인
+func checkSessionWorkspaceAccess(ctx context.Context, store storage.Storage, workspaceID, userID string, required models.WorkspacePermission) error {
+	// 삭제된 워크스페이스의 기록도 권한이 없으면 존재 여부를 알 수 없도록 같은 에러 반환
+	if _, err := NewWorkspaceAccessService(store).Authorize(ctx, workspaceID, userID, required); err != nil {
+		return NewWorkspaceError(ErrCodeInsufficientPerm, "세션에 접근할 권한이 없습니다", ErrUnauthorized)
+	}
+	return nil
+}
+
+// ownedWorkspaceIDs 사용자가 소유하거나 공유받은 모든 워크스페이스 ID
+func (s *MessageService) ownedWorkspaceIDs(ctx context.Context, userID string) ([]string, error) {
+	return NewWorkspaceAccessService(s.storage).AccessibleWorkspaceIDs(ctx, userID)
+}
+
+// intersectIDs requested가 nil이면 owned 전체, 아니면 두 목록에 모두 있는 ID
+func intersectIDs(owned, requested []string) []string {
+	if requested == nil {
+		return owned
+	}
+	allowed := make(map[string]bool, len(owned))
+	for _, id := range owned {
+		allowed[id] = true
+	}
+	ids := []string{}
+	for _, id := range requested {
+		if allowed[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
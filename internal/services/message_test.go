@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestMessageService_AccessScope(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewMessageService(store)
+
+	alice := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp"}
+	bob := &models.Workspace{Name: "bob-ws", OwnerID: "bob", ProjectPath: "/tmp"}
+	require.NoError(t, store.Workspace().Create(ctx, alice))
+	require.NoError(t, store.Workspace().Create(ctx, bob))
+	createWorkspaceSession(t, store, alice.ID, "s-alice")
+	createWorkspaceSession(t, store, bob.ID, "s-bob")
+
+	require.NoError(t, svc.Record(ctx, &models.SessionMessage{SessionID: "s-alice", WorkspaceID: alice.ID, Role: models.MessageRoleUser, Content: "fix the migration"}))
+	require.NoError(t, svc.Record(ctx, &models.SessionMessage{SessionID: "s-alice", WorkspaceID: alice.ID, Role: models.MessageRoleAssistant, Content: "migration fixed"}))
+	require.NoError(t, svc.Record(ctx, &models.SessionMessage{SessionID: "s-bob", WorkspaceID: bob.ID, Role: models.MessageRoleAssistant, Content: "bob migration notes"}))
+	assert.Error(t, svc.Record(ctx, &models.SessionMessage{SessionID: "s-bob", WorkspaceID: bob.ID, Role: "tool", Content: "x"}))
+
+	// 세션 기록은 워크스페이스 소유자만 조회
+	resp, err := svc.ListBySession(ctx, "s-alice", "alice", false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+
+	_, err = svc.ListBySession(ctx, "s-alice", "bob", false, nil)
+	assert.Error(t, err)
+
+	// 메시지에 자신의 워크스페이스 ID를 기록해도 다른 세션의 기록은 조회할 수 없음
+	require.NoError(t, svc.Record(ctx, &models.SessionMessage{SessionID: "s-alice", WorkspaceID: bob.ID, Role: models.MessageRoleUser, Content: "forged"}))
+	_, err = svc.ListBySession(ctx, "s-alice", "bob", false, nil)
+	assert.Error(t, err)
+	resp, err = svc.ListBySession(ctx, "s-alice", "alice", false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Meta.Total)
+
+	resp, err = svc.ListBySession(ctx, "s-alice", "admin-user", true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Meta.Total)
+
+	// 검색은 소유한 워크스페이스로 제한
+	resp, err = svc.Search(ctx, "alice", false, &models.MessageSearchQuery{Query: "migration"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+
+	resp, err = svc.Search(ctx, "alice", false, &models.MessageSearchQuery{Query: "migration", WorkspaceIDs: []string{bob.ID}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.Meta.Total)
+
+	resp, err = svc.Search(ctx, "admin-user", true, &models.MessageSearchQuery{Query: "migration"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Meta.Total)
+
+	_, err = svc.Search(ctx, "alice", false, &models.MessageSearchQuery{Query: "   "}, nil)
+	assert.Error(t, err)
+}
@@ -2,10 +2,13 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 
-	"gopkg.in/mail.v2"
+	"github.com/aicli/aicli-web/internal/email"
 )
 
 // NotificationService는 알림 발송을 위한 서비스 인터페이스입니다
@@ -15,218 +18,133 @@ type NotificationService interface {
 	SendPasswordResetEmail(ctx context.Context, to, resetToken string) error
 	SendEmailVerificationEmail(ctx context.Context, to, verificationToken string) error
 	Send2FAEnabledEmail(ctx context.Context, to string) error
+	SendWorkspaceInvitationEmail(ctx context.Context, to string, invitation *WorkspaceInvitationNotice) error
+	SendBudgetAlertEmail(ctx context.Context, to string, alert *email.BudgetAlertData) error
+	SendSecurityAlertEmail(ctx context.Context, to string, alert *email.SecurityAlertData) error
 	
 	// 푸시 알림 (향후 확장)
 	SendPushNotification(ctx context.Context, userID, title, message string) error
 }
 
-// notificationService는 NotificationService 인터페이스의 구현체입니다
+// WorkspaceInvitationNotice는 워크스페이스 초대 메일 내용입니다
+type WorkspaceInvitationNotice struct {
+	InviterName   string
+	WorkspaceName string
+	Role          string
+	Token         string
+}
+
+// notificationService는 메일 발송기(email.Mailer)로 알림을 보내는 NotificationService 구현체입니다
+// 메일은 발송 기록에 남고 실패하면 발송기의 재시도 대기열에서 다시 보냅니다.
 type notificationService struct {
-	smtpHost     string
-	smtpPort     int
-	smtpUsername string
-	smtpPassword string
-	fromEmail   string
-	fromName    string
+	mailer *email.Mailer
+	config NotificationConfig
 }
 
 // NotificationConfig는 알림 서비스 설정입니다
 type NotificationConfig struct {
-	SMTPHost     string
-	SMTPPort     int
-	SMTPUsername string
-	SMTPPassword string
-	FromEmail   string
-	FromName    string
+	// BaseURL 메일 본문 링크에 쓸 웹 UI 주소
+	BaseURL string
+	
+	// ResetTokenExpiry 비밀번호 재설정 링크 유효 시간
+	ResetTokenExpiry time.Duration
+	
+	// VerificationExpiry 이메일 인증 링크 유효 시간
+	VerificationExpiry time.Duration
+	
+	// InvitationExpiry 워크스페이스 초대 유효 시간
+	InvitationExpiry time.Duration
+	
+	// SecurityRecipients 보안 알림을 함께 받을 관리자 주소
+	SecurityRecipients []string
 }
 
 // NewNotificationService는 새로운 알림 서비스를 생성합니다
-func NewNotificationService(config *NotificationConfig) NotificationService {
+func NewNotificationService(mailer *email.Mailer, config *NotificationConfig) NotificationService {
+	cfg := *config
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	if cfg.ResetTokenExpiry == 0 {
+		cfg.ResetTokenExpiry = 24 * time.Hour
+	}
+	if cfg.VerificationExpiry == 0 {
+		cfg.VerificationExpiry = 24 * time.Hour
+	}
+	if cfg.InvitationExpiry == 0 {
+		cfg.InvitationExpiry = 7 * 24 * time.Hour
+	}
 	return &notificationService{
-		smtpHost:     config.SMTPHost,
-		smtpPort:     config.SMTPPort,
-		smtpUsername: config.SMTPUsername,
-		smtpPassword: config.SMTPPassword,
-		fromEmail:   config.FromEmail,
-		fromName:    config.FromName,
+		mailer: mailer,
+		config: cfg,
 	}
 }
 
-// SendEmail은 이메일을 발송합니다
+// SendEmail은 템플릿 없이 HTML 본문으로 이메일을 발송합니다
 func (s *notificationService) SendEmail(ctx context.Context, to, subject, body string) error {
-	m := mail.NewMessage()
-	m.SetHeader("From", s.fromEmail)
-	m.SetHeader("To", to)
-	m.SetHeader("Subject", subject)
-	m.SetBody("text/html", body)
-
-	d := mail.NewDialer(s.smtpHost, s.smtpPort, s.smtpUsername, s.smtpPassword)
-	
-	if err := d.DialAndSend(m); err != nil {
-		return fmt.Errorf("failed to send email: %w", err)
-	}
-	
-	return nil
+	_, err := s.mailer.SendContent(ctx, to, &email.Content{Subject: subject, HTMLBody: body})
+	return err
 }
 
 // SendPasswordResetEmail은 비밀번호 재설정 이메일을 발송합니다
 func (s *notificationService) SendPasswordResetEmail(ctx context.Context, to, resetToken string) error {
-	subject := "비밀번호 재설정 요청"
-	
-	// HTML 템플릿 생성
-	body := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <title>비밀번호 재설정</title>
-    <style>
-        .container { max-width: 600px; margin: 0 auto; font-family: Arial, sans-serif; }
-        .header { background-color: #4CAF50; color: white; padding: 20px; text-align: center; }
-        .content { padding: 20px; background-color: #f9f9f9; }
-        .button { 
-            display: inline-block; 
-            background-color: #4CAF50; 
-            color: white; 
-            padding: 12px 24px; 
-            text-decoration: none; 
-            border-radius: 4px; 
-            margin: 20px 0;
-        }
-        .footer { padding: 20px; font-size: 12px; color: #666; text-align: center; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <div class="header">
-            <h1>AICode Manager</h1>
-        </div>
-        <div class="content">
-            <h2>비밀번호 재설정 요청</h2>
-            <p>안녕하세요,</p>
-            <p>귀하의 계정에 대한 비밀번호 재설정 요청이 접수되었습니다.</p>
-            <p>아래 버튼을 클릭하여 비밀번호를 재설정하세요:</p>
-            
-            <a href="http://localhost:3000/auth/reset-password?token=%s" class="button">비밀번호 재설정</a>
-            
-            <p>이 링크는 24시간 후에 만료됩니다.</p>
-            <p>만약 비밀번호 재설정을 요청하지 않으셨다면, 이 이메일을 무시하세요.</p>
-        </div>
-        <div class="footer">
-            <p>이 이메일은 자동으로 생성되었습니다. 답장하지 마세요.</p>
-        </div>
-    </div>
-</body>
-</html>
-	`, resetToken)
-
-	return s.SendEmail(ctx, to, subject, body)
+	_, err := s.mailer.Send(ctx, to, email.TemplatePasswordReset, &email.PasswordResetData{
+		ResetURL:  s.link("/auth/reset-password", resetToken),
+		ExpiresIn: formatKoreanDuration(s.config.ResetTokenExpiry),
+	})
+	return err
 }
 
 // SendEmailVerificationEmail은 이메일 인증 메일을 발송합니다
 func (s *notificationService) SendEmailVerificationEmail(ctx context.Context, to, verificationToken string) error {
-	subject := "이메일 주소 인증"
-	
-	body := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <title>이메일 인증</title>
-    <style>
-        .container { max-width: 600px; margin: 0 auto; font-family: Arial, sans-serif; }
-        .header { background-color: #2196F3; color: white; padding: 20px; text-align: center; }
-        .content { padding: 20px; background-color: #f9f9f9; }
-        .button { 
-            display: inline-block; 
-            background-color: #2196F3; 
-            color: white; 
-            padding: 12px 24px; 
-            text-decoration: none; 
-            border-radius: 4px; 
-            margin: 20px 0;
-        }
-        .footer { padding: 20px; font-size: 12px; color: #666; text-align: center; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <div class="header">
-            <h1>AICode Manager</h1>
-        </div>
-        <div class="content">
-            <h2>이메일 주소 인증</h2>
-            <p>안녕하세요,</p>
-            <p>AICode Manager에 가입해 주셔서 감사합니다!</p>
-            <p>아래 버튼을 클릭하여 이메일 주소를 인증해 주세요:</p>
-            
-            <a href="http://localhost:3000/auth/verify-email?token=%s" class="button">이메일 인증</a>
-            
-            <p>이 링크는 24시간 후에 만료됩니다.</p>
-        </div>
-        <div class="footer">
-            <p>이 이메일은 자동으로 생성되었습니다. 답장하지 마세요.</p>
-        </div>
-    </div>
-</body>
-</html>
-	`, verificationToken)
-
-	return s.SendEmail(ctx, to, subject, body)
+	_, err := s.mailer.Send(ctx, to, email.TemplateEmailVerification, &email.EmailVerificationData{
+		VerifyURL: s.link("/auth/verify-email", verificationToken),
+		ExpiresIn: formatKoreanDuration(s.config.VerificationExpiry),
+	})
+	return err
 }
 
 // Send2FAEnabledEmail은 2FA 활성화 알림 이메일을 발송합니다
 func (s *notificationService) Send2FAEnabledEmail(ctx context.Context, to string) error {
-	subject := "2단계 인증이 활성화되었습니다"
-	
-	body := `
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <title>2FA 활성화</title>
-    <style>
-        .container { max-width: 600px; margin: 0 auto; font-family: Arial, sans-serif; }
-        .header { background-color: #FF9800; color: white; padding: 20px; text-align: center; }
-        .content { padding: 20px; background-color: #f9f9f9; }
-        .alert { 
-            background-color: #fff3cd; 
-            border: 1px solid #ffeaa7; 
-            color: #856404; 
-            padding: 15px; 
-            border-radius: 4px; 
-            margin: 20px 0;
-        }
-        .footer { padding: 20px; font-size: 12px; color: #666; text-align: center; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <div class="header">
-            <h1>AICode Manager</h1>
-        </div>
-        <div class="content">
-            <h2>2단계 인증 활성화</h2>
-            <p>안녕하세요,</p>
-            <p>귀하의 계정에 2단계 인증(2FA)이 성공적으로 활성화되었습니다.</p>
-            
-            <div class="alert">
-                <strong>보안 알림:</strong> 이제 로그인할 때 인증 앱에서 생성된 6자리 코드가 필요합니다.
-            </div>
-            
-            <p>2단계 인증을 활성화하지 않으셨다면, 즉시 계정의 보안을 확인하고 비밀번호를 변경하세요.</p>
-            
-            <p><strong>백업 코드를 안전한 곳에 보관하는 것을 잊지 마세요!</strong></p>
-        </div>
-        <div class="footer">
-            <p>이 이메일은 자동으로 생성되었습니다. 답장하지 마세요.</p>
-        </div>
-    </div>
-</body>
-</html>
-	`
-
-	return s.SendEmail(ctx, to, subject, body)
+	return s.SendSecurityAlertEmail(ctx, to, &email.SecurityAlertData{
+		Title:   "2단계 인증이 활성화되었습니다",
+		Message: "이제 로그인할 때 인증 앱에서 생성된 6자리 코드가 필요합니다. 백업 코드를 안전한 곳에 보관하세요.",
+	})
+}
+
+// SendWorkspaceInvitationEmail은 워크스페이스 초대 이메일을 발송합니다
+func (s *notificationService) SendWorkspaceInvitationEmail(ctx context.Context, to string, invitation *WorkspaceInvitationNotice) error {
+	_, err := s.mailer.Send(ctx, to, email.TemplateWorkspaceInvitation, &email.InvitationData{
+		InviterName:   invitation.InviterName,
+		WorkspaceName: invitation.WorkspaceName,
+		Role:          invitation.Role,
+		AcceptURL:     s.link("/invitations/accept", invitation.Token),
+		ExpiresIn:     formatKoreanDuration(s.config.InvitationExpiry),
+	})
+	return err
+}
+
+// SendBudgetAlertEmail은 예산 알림 이메일을 발송합니다
+func (s *notificationService) SendBudgetAlertEmail(ctx context.Context, to string, alert *email.BudgetAlertData) error {
+	_, err := s.mailer.Send(ctx, to, email.TemplateBudgetAlert, alert)
+	return err
+}
+
+// SendSecurityAlertEmail은 보안 알림 이메일을 받는 사람과 보안 담당 관리자에게 발송합니다
+func (s *notificationService) SendSecurityAlertEmail(ctx context.Context, to string, alert *email.SecurityAlertData) error {
+	data := *alert
+	if data.OccurredAt == "" {
+		data.OccurredAt = time.Now().Format("2006-01-02 15:04:05 MST")
+	}
+	if data.ActionURL == "" && s.config.BaseURL != "" {
+		data.ActionURL = s.config.BaseURL + "/account"
+	}
+
+	var errs []error
+	for _, recipient := range uniqueRecipients(to, s.config.SecurityRecipients) {
+		if _, err := s.mailer.Send(ctx, recipient, email.TemplateSecurityAlert, &data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // SendPushNotification은 푸시 알림을 발송합니다 (향후 구현)
@@ -235,8 +153,40 @@ func (s *notificationService) SendPushNotification(ctx context.Context, userID,
 	return fmt.Errorf("push notification not implemented yet")
 }
 
+// link 웹 UI 경로에 토큰을 붙인 링크
+func (s *notificationService) link(path, token string) string {
+	return s.config.BaseURL + path + "?token=" + url.QueryEscape(token)
+}
+
 // ===== 헬퍼 함수들 =====
 
+// uniqueRecipients는 받는 사람 목록에서 빈 주소와 중복 주소(대소문자 무시)를 뺍니다
+func uniqueRecipients(to string, extra []string) []string {
+	seen := make(map[string]bool)
+	var recipients []string
+	for _, recipient := range append([]string{to}, extra...) {
+		key := strings.ToLower(strings.TrimSpace(recipient))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		recipients = append(recipients, strings.TrimSpace(recipient))
+	}
+	return recipients
+}
+
+// formatKoreanDuration은 메일 본문에 쓸 유효 시간 문구를 만듭니다 (예: 24시간, 7일, 30분)
+func formatKoreanDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour && d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%d일", d/(24*time.Hour))
+	case d >= time.Hour && d%time.Hour == 0:
+		return fmt.Sprintf("%d시간", d/time.Hour)
+	default:
+		return fmt.Sprintf("%d분", d/time.Minute)
+	}
+}
+
 // validateEmailFormat은 이메일 형식을 검증합니다
 func validateEmailFormat(email string) bool {
 	return strings.Contains(email, "@") && strings.Contains(email, ".")
@@ -280,6 +230,18 @@ func (m *MockNotificationService) Send2FAEnabledEmail(ctx context.Context, to st
 	return m.SendEmail(ctx, to, "2단계 인증이 활성화되었습니다", "2FA enabled")
 }
 
+func (m *MockNotificationService) SendWorkspaceInvitationEmail(ctx context.Context, to string, invitation *WorkspaceInvitationNotice) error {
+	return m.SendEmail(ctx, to, "워크스페이스 초대", "Invitation token: "+invitation.Token)
+}
+
+func (m *MockNotificationService) SendBudgetAlertEmail(ctx context.Context, to string, alert *email.BudgetAlertData) error {
+	return m.SendEmail(ctx, to, "[예산 알림] "+alert.Scope, fmt.Sprintf("Budget %d%%", alert.Percent))
+}
+
+func (m *MockNotificationService) SendSecurityAlertEmail(ctx context.Context, to string, alert *email.SecurityAlertData) error {
+	return m.SendEmail(ctx, to, "[보안 알림] "+alert.Title, alert.Message)
+}
+
 func (m *MockNotificationService) SendPushNotification(ctx context.Context, userID, title, message string) error {
 	return nil // 테스트에서는 무시
 }
\ No newline at end of file
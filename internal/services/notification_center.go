@@ -0,0 +1,539 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+const (
+	// notificationDeliverTimeout 리스너에서 알림 하나를 저장하고 보내는 데 쓰는 시간
+	notificationDeliverTimeout = 10 * time.Second
+	// notificationDigestBatch 요약 메일 작업 한 번에 처리하는 알림 수
+	notificationDigestBatch = 1000
+	// notificationAdminRole 관리자 대상 알림(예산, 공격 탐지)을 받는 로컬 계정 역할
+	notificationAdminRole = "admin"
+)
+
+// notificationText 받는 사람의 메시지 언어로 알림 제목과 내용을 만듦
+type notificationText func(lang validation.Language) (title, message string)
+
+// NotificationPusher 알림 센터에 저장한 알림을 사용자에게 바로 전달 (WebSocket 사용자 채널)
+type NotificationPusher interface {
+	PushNotification(notification *models.Notification, unread int)
+}
+
+// NotificationCenter 사용자별 알림 센터
+// 예산 경고, 검토 요청과 결정, 워크스페이스 공유 초대, 보안 알림을 사용자의 알림 종류별 설정에 따라
+// 알림 센터에 저장해 WebSocket으로 알리고, 메일은 바로 보내거나 모아서 요약 메일로 보냅니다.
+// 메일 발송기(NotificationService)가 없으면 알림 센터에만 저장합니다.
+// 알림 센터가 만드는 알림은 받는 사람이 설정한 메시지 언어로 쓰고, 설정이 없으면 한국어로 씁니다.
+type NotificationCenter struct {
+	storage     storage.Storage
+	mailer      NotificationService
+	baseURL     string
+	pushers     []NotificationPusher
+	preferences *UserPreferenceService
+	now         func() time.Time
+}
+
+// 리스너 구현 확인
+var (
+	_ TaskReviewListener     = (*NotificationCenter)(nil)
+	_ WorkspaceACLListener   = (*NotificationCenter)(nil)
+	_ SecurityNoticeRecorder = (*NotificationCenter)(nil)
+	_ DiskQuotaListener      = (*NotificationCenter)(nil)
+)
+
+// NewNotificationCenter 새 알림 센터 생성 (mailer가 nil이면 메일을 보내지 않음)
+func NewNotificationCenter(storage storage.Storage, mailer NotificationService, baseURL string) *NotificationCenter {
+	return &NotificationCenter{
+		storage: storage,
+		mailer:  mailer,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		now:     time.Now,
+	}
+}
+
+// SetPreferences 받는 사람의 메시지 언어를 조회할 사용자 설정 서비스 설정 (서비스 시작 전에 설정)
+func (c *NotificationCenter) SetPreferences(preferences *UserPreferenceService) {
+	c.preferences = preferences
+}
+
+// language 사용자의 메시지 언어 (설정하지 않았으면 한국어)
+func (c *NotificationCenter) language(ctx context.Context, userID string) validation.Language {
+	if c.preferences != nil {
+		if lang := c.preferences.PreferredLanguage(ctx, userID); lang != "" {
+			return lang
+		}
+	}
+	return validation.LanguageKorean
+}
+
+// AddPusher 알림을 바로 전달할 대상 추가 (서비스 시작 전에 등록)
+func (c *NotificationCenter) AddPusher(pusher NotificationPusher) {
+	c.pushers = append(c.pushers, pusher)
+}
+
+// List 사용자의 알림 한 페이지와 안 읽은 알림 수 조회
+func (c *NotificationCenter) List(ctx context.Context, userID string, req *models.NotificationListRequest) (*models.NotificationListResponse, error) {
+	paging := &models.PaginationRequest{Page: req.Page, Limit: req.Limit}
+	paging.Normalize()
+	notifications, total, err := c.storage.Notification().List(ctx, &models.NotificationFilter{
+		UserID:     userID,
+		Type:       req.Type,
+		UnreadOnly: req.UnreadOnly,
+		Since:      req.Since,
+	}, paging)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "알림 조회 실패", err)
+	}
+
+	unread, err := c.unread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.NotificationListResponse{
+		Data:   notifications,
+		Meta:   models.NewPaginationMeta(paging.Page, paging.Limit, total),
+		Unread: unread,
+	}, nil
+}
+
+// MarkRead 사용자의 알림을 읽음으로 표시 (ids가 비어 있으면 안 읽은 알림 전체)
+func (c *NotificationCenter) MarkRead(ctx context.Context, userID string, ids []string) (*models.NotificationReadState, error) {
+	marked, err := c.storage.Notification().MarkRead(ctx, userID, ids, c.now())
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "알림 읽음 표시 실패", err)
+	}
+
+	unread, err := c.unread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.NotificationReadState{Marked: marked, Unread: unread}, nil
+}
+
+// unread 사용자의 안 읽은 알림 수
+func (c *NotificationCenter) unread(ctx context.Context, userID string) (int, error) {
+	_, total, err := c.storage.Notification().List(ctx, &models.NotificationFilter{UserID: userID, UnreadOnly: true}, &models.PaginationRequest{Limit: 1})
+	if err != nil {
+		return 0, NewWorkspaceError(ErrCodeInternal, "안 읽은 알림 수 조회 실패", err)
+	}
+	return total, nil
+}
+
+// Preferences 사용자의 알림 종류별 설정 (저장하지 않은 종류는 기본값)
+func (c *NotificationCenter) Preferences(ctx context.Context, userID string) ([]*models.NotificationPreference, error) {
+	stored, err := c.storage.Notification().GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "알림 설정 조회 실패", err)
+	}
+
+	byType := make(map[models.NotificationType]*models.NotificationPreference, len(stored))
+	for _, preference := range stored {
+		byType[preference.Type] = preference
+	}
+
+	preferences := make([]*models.NotificationPreference, 0, len(models.NotificationTypes))
+	for _, notificationType := range models.NotificationTypes {
+		if preference, ok := byType[notificationType]; ok {
+			preferences = append(preferences, preference)
+			continue
+		}
+		preferences = append(preferences, defaultNotificationPreference(notificationType))
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences 알림 종류별 설정 변경 (요청에 없는 종류와 값은 유지)
+func (c *NotificationCenter) UpdatePreferences(ctx context.Context, userID string, req *models.NotificationPreferencesRequest) ([]*models.NotificationPreference, error) {
+	current, err := c.Preferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	byType := make(map[models.NotificationType]*models.NotificationPreference, len(current))
+	for _, preference := range current {
+		byType[preference.Type] = preference
+	}
+
+	for _, update := range req.Preferences {
+		preference, ok := byType[update.Type]
+		if !ok {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("알 수 없는 알림 종류입니다: %s", update.Type), nil)
+		}
+		if update.InApp != nil {
+			preference.InApp = *update.InApp
+		}
+		if update.Email != "" {
+			preference.Email = update.Email
+		}
+		if err := c.storage.Notification().SetPreference(ctx, userID, preference); err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "알림 설정 저장 실패", err)
+		}
+	}
+	return current, nil
+}
+
+// defaultNotificationPreference 설정을 저장하지 않은 종류의 기본값 (알림 센터와 즉시 메일)
+func defaultNotificationPreference(notificationType models.NotificationType) *models.NotificationPreference {
+	return &models.NotificationPreference{
+		Type:  notificationType,
+		InApp: true,
+		Email: models.NotificationEmailImmediate,
+	}
+}
+
+// Notify 사용자의 알림 설정에 따라 알림을 저장하고 WebSocket과 메일로 전달
+func (c *NotificationCenter) Notify(ctx context.Context, notification *models.Notification) error {
+	return c.deliver(ctx, notification, true)
+}
+
+// deliver 알림 저장과 전달 (allowEmail이 false면 메일 설정과 상관없이 메일을 보내지 않음)
+func (c *NotificationCenter) deliver(ctx context.Context, notification *models.Notification, allowEmail bool) error {
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = c.now()
+	}
+
+	preference := defaultNotificationPreference(notification.Type)
+	stored, err := c.storage.Notification().GetPreferences(ctx, notification.UserID)
+	if err != nil {
+		return err
+	}
+	for _, candidate := range stored {
+		if candidate.Type == notification.Type {
+			preference = candidate
+		}
+	}
+
+	emailMode := preference.Email
+	if !allowEmail || c.mailer == nil {
+		emailMode = models.NotificationEmailOff
+	}
+
+	// 알림 센터에 저장하지 않으면 요약 메일에 넣을 수 없으므로 바로 보냄
+	if !preference.InApp {
+		if emailMode != models.NotificationEmailOff {
+			return c.email(ctx, notification)
+		}
+		return nil
+	}
+
+	notification.DigestPending = emailMode == models.NotificationEmailDigest
+	if err := c.storage.Notification().Create(ctx, notification); err != nil {
+		return err
+	}
+
+	if len(c.pushers) > 0 {
+		unread, err := c.unread(ctx, notification.UserID)
+		if err != nil {
+			return err
+		}
+		for _, pusher := range c.pushers {
+			pusher.PushNotification(notification, unread)
+		}
+	}
+
+	if emailMode == models.NotificationEmailImmediate {
+		return c.email(ctx, notification)
+	}
+	return nil
+}
+
+// notifyAsync 리스너에서 받은 알림을 백그라운드에서 전달 (리스너는 요청 처리 경로에서 호출됨)
+// text가 있으면 받는 사람마다 메시지 언어로 제목과 내용을 채웁니다.
+func (c *NotificationCenter) notifyAsync(allowEmail bool, text notificationText, notifications ...*models.Notification) {
+	if len(notifications) == 0 {
+		return
+	}
+	now := c.now()
+	for _, notification := range notifications {
+		if notification.CreatedAt.IsZero() {
+			notification.CreatedAt = now
+		}
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationDeliverTimeout)
+		defer cancel()
+		for _, notification := range notifications {
+			if text != nil {
+				notification.Title, notification.Message = text(c.language(ctx, notification.UserID))
+			}
+			if err := c.deliver(ctx, notification, allowEmail); err != nil {
+				log.Printf("알림 전달 실패: %s/%s: %v", notification.Type, notification.UserID, err)
+			}
+		}
+	}()
+}
+
+// NotifyAdmins 관리자 역할의 모든 로컬 계정에 같은 알림을 백그라운드에서 전달 (예산, 공격 탐지)
+func (c *NotificationCenter) NotifyAdmins(template *models.Notification) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notificationDeliverTimeout)
+		defer cancel()
+
+		admins, err := c.storage.Account().ListByRole(ctx, notificationAdminRole)
+		if err != nil {
+			log.Printf("관리자 알림 대상 조회 실패: %s: %v", template.Type, err)
+			return
+		}
+		if len(admins) == 0 {
+			log.Printf("관리자 계정이 없어 알림을 보내지 않습니다: %s: %s", template.Type, template.Title)
+			return
+		}
+
+		createdAt := c.now()
+		for _, admin := range admins {
+			notification := *template
+			notification.UserID = admin.ID
+			notification.CreatedAt = createdAt
+			if err := c.deliver(ctx, &notification, true); err != nil {
+				log.Printf("관리자 알림 전달 실패: %s/%s: %v", template.Type, admin.ID, err)
+			}
+		}
+	}()
+}
+
+// email 알림 하나를 바로 메일로 보냄 (로컬 계정 이메일이 있는 사용자만)
+func (c *NotificationCenter) email(ctx context.Context, notification *models.Notification) error {
+	address := c.emailAddress(ctx, notification.UserID)
+	if address == "" {
+		return nil
+	}
+
+	var body strings.Builder
+	c.writeNotificationHTML(&body, notification)
+	return c.mailer.SendEmail(ctx, address, "[AICLI] "+notification.Title, body.String())
+}
+
+// emailAddress 사용자의 로컬 계정 이메일 (없으면 빈 문자열)
+func (c *NotificationCenter) emailAddress(ctx context.Context, userID string) string {
+	account, err := c.storage.Account().GetByID(ctx, userID)
+	if err != nil || !account.IsActive {
+		return ""
+	}
+	return account.Email
+}
+
+// writeNotificationHTML 메일 본문에 알림 하나를 씀
+func (c *NotificationCenter) writeNotificationHTML(body *strings.Builder, notification *models.Notification) {
+	fmt.Fprintf(body, "<p><strong>%s</strong></p>", html.EscapeString(notification.Title))
+	if notification.Message != "" {
+		fmt.Fprintf(body, "<p>%s</p>", html.EscapeString(notification.Message))
+	}
+	if notification.Link != "" && c.baseURL != "" {
+		link := html.EscapeString(c.baseURL + notification.Link)
+		fmt.Fprintf(body, `<p><a href="%s">%s</a></p>`, link, link)
+	}
+}
+
+// SendDigests 요약 메일을 기다리는 알림을 사용자마다 한 통의 메일로 보내고 보낸 알림 수 반환 (백그라운드 작업)
+// 이메일이 없는 사용자의 알림은 보내지 않고 대기에서만 뺍니다. 발송에 실패한 사용자의 알림은 다음 주기에 다시 보냅니다.
+func (c *NotificationCenter) SendDigests(ctx context.Context) (int, error) {
+	pending, err := c.storage.Notification().ListDigestPending(ctx, notificationDigestBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []string
+	for start := 0; start < len(pending); {
+		end := start
+		for end < len(pending) && pending[end].UserID == pending[start].UserID {
+			end++
+		}
+		group := pending[start:end]
+		start = end
+
+		if c.mailer != nil {
+			if address := c.emailAddress(ctx, group[0].UserID); address != "" {
+				if err := c.sendDigest(ctx, address, c.language(ctx, group[0].UserID), group); err != nil {
+					errs = append(errs, fmt.Sprintf("%s: %v", group[0].UserID, err))
+					continue
+				}
+				sent += len(group)
+			}
+		}
+
+		ids := make([]string, 0, len(group))
+		for _, notification := range group {
+			ids = append(ids, notification.ID)
+		}
+		if err := c.storage.Notification().ClearDigestPending(ctx, ids); err != nil {
+			return sent, err
+		}
+	}
+
+	if len(errs) > 0 {
+		return sent, fmt.Errorf("요약 메일 발송 실패: %s", strings.Join(errs, "; "))
+	}
+	return sent, nil
+}
+
+// sendDigest 한 사용자의 알림을 요약 메일 한 통으로 보냄
+func (c *NotificationCenter) sendDigest(ctx context.Context, address string, lang validation.Language, notifications []*models.Notification) error {
+	var body strings.Builder
+	fmt.Fprintf(&body, "<p>%s</p><hr>", html.EscapeString(validation.TL(validation.MsgNotifyDigestIntro, lang, len(notifications))))
+	for _, notification := range notifications {
+		c.writeNotificationHTML(&body, notification)
+		fmt.Fprintf(&body, "<p><small>%s</small></p><hr>", notification.CreatedAt.Format("2006-01-02 15:04 MST"))
+	}
+	return c.mailer.SendEmail(ctx, address, validation.TL(validation.MsgNotifyDigestSubject, lang, len(notifications)), body.String())
+}
+
+// OnReviewRequested 검토 요청을 검토자에게 알림 (TaskReviewListener)
+func (c *NotificationCenter) OnReviewRequested(review *models.TaskReview, reviewers []string) {
+	c.notifyAsync(true, reviewText(review, validation.MsgNotifyReviewRequestedTitle, len(review.FilesChanged)), reviewNotifications(review, reviewers)...)
+}
+
+// OnReviewDecided 검토 결정을 관련 사용자에게 알림 (TaskReviewListener)
+func (c *NotificationCenter) OnReviewDecided(review *models.TaskReview, reviewers []string) {
+	title := validation.MsgNotifyReviewApprovedTitle
+	if review.Status == models.TaskReviewRejected {
+		title = validation.MsgNotifyReviewRejectedTitle
+	}
+	recipients := make([]string, 0, len(reviewers))
+	for _, userID := range reviewers {
+		// 결정한 사람에게는 알리지 않음
+		if userID != review.ReviewerID {
+			recipients = append(recipients, userID)
+		}
+	}
+	c.notifyAsync(true, reviewText(review, title), reviewNotifications(review, recipients)...)
+}
+
+// reviewText 검토 알림 제목과 내용
+func reviewText(review *models.TaskReview, title validation.MessageKey, titleParams ...interface{}) notificationText {
+	return func(lang validation.Language) (string, string) {
+		message := validation.TL(validation.MsgNotifyReviewStatus, lang, review.TaskID, review.Status)
+		if len(review.FilesChanged) > 0 {
+			message += "\n" + validation.TL(validation.MsgNotifyReviewFiles, lang, strings.Join(review.FilesChanged, ", "))
+		}
+		if review.Comment != "" {
+			message += "\n" + validation.TL(validation.MsgNotifyReviewComment, lang, review.Comment)
+		}
+		return validation.TL(title, lang, titleParams...), message
+	}
+}
+
+// reviewNotifications 검토 알림 (받는 사람마다 하나)
+func reviewNotifications(review *models.TaskReview, recipients []string) []*models.Notification {
+	notifications := make([]*models.Notification, 0, len(recipients))
+	for _, userID := range recipients {
+		notifications = append(notifications, &models.Notification{
+			UserID: userID,
+			Type:   models.NotificationTypeApproval,
+			Link:   "/workspaces/" + review.WorkspaceID + "/reviews/" + review.ID,
+			Data: map[string]string{
+				"review_id":    review.ID,
+				"task_id":      review.TaskID,
+				"workspace_id": review.WorkspaceID,
+				"status":       string(review.Status),
+			},
+		})
+	}
+	return notifications
+}
+
+// OnACLChanged 워크스페이스를 공유받은 사용자에게 초대 알림 (WorkspaceACLListener)
+// 권한 삭제, 그룹/역할 대상 공유, 스스로 부여한 권한은 알리지 않습니다.
+func (c *NotificationCenter) OnACLChanged(entry *models.WorkspaceACLEntry, revoked bool) {
+	if revoked || entry.PrincipalType != models.ACLPrincipalUser || entry.PrincipalID == entry.GrantedBy {
+		return
+	}
+
+	workspaceName := entry.WorkspaceID
+	ctx, cancel := context.WithTimeout(context.Background(), notificationDeliverTimeout)
+	defer cancel()
+	if workspace, err := c.storage.Workspace().GetByID(ctx, entry.WorkspaceID); err == nil {
+		workspaceName = workspace.Name
+	}
+
+	text := func(lang validation.Language) (string, string) {
+		message := validation.TL(validation.MsgNotifyInviteMessage, lang, workspaceName, entry.Permission)
+		if entry.GrantedBy != "" {
+			message = validation.TL(validation.MsgNotifyInviteMessageBy, lang, entry.GrantedBy, workspaceName, entry.Permission)
+		}
+		return validation.TL(validation.MsgNotifyInviteTitle, lang, workspaceName), message
+	}
+	c.notifyAsync(true, text, &models.Notification{
+		UserID: entry.PrincipalID,
+		Type:   models.NotificationTypeInvite,
+		Link:   "/workspaces/" + entry.WorkspaceID,
+		Data: map[string]string{
+			"workspace_id": entry.WorkspaceID,
+			"permission":   string(entry.Permission),
+			"granted_by":   entry.GrantedBy,
+		},
+	})
+}
+
+// OnSecurityNotice 계정 보안 알림을 알림 센터에 저장 (SecurityNoticeRecorder)
+// 계정 서비스가 보안 알림 메일을 직접 보내므로 메일은 보내지 않습니다.
+func (c *NotificationCenter) OnSecurityNotice(userID, title, message, ipAddress string) {
+	notification := &models.Notification{
+		UserID:  userID,
+		Type:    models.NotificationTypeSecurity,
+		Title:   title,
+		Message: message,
+		Link:    "/account",
+	}
+	if ipAddress != "" {
+		notification.Data = map[string]string{"ip_address": ipAddress}
+	}
+	c.notifyAsync(false, nil, notification)
+}
+
+// OnDiskQuotaLevelChanged 워크스페이스 디스크 사용량 경고를 소유자에게 알림 (DiskQuotaListener)
+func (c *NotificationCenter) OnDiskQuotaLevelChanged(workspace *models.Workspace, usage *models.WorkspaceDiskUsage) {
+	text := func(lang validation.Language) (string, string) {
+		message := validation.TL(validation.MsgNotifyDiskMessage, lang,
+			workspace.Name, float64(usage.UsedBytes)/(1024*1024), float64(usage.LimitBytes)/(1024*1024), usage.Percent)
+		if usage.Level == models.WorkspaceDiskQuotaExceeded {
+			message += validation.TL(validation.MsgNotifyDiskExceeded, lang)
+		}
+		return validation.TL(validation.MsgNotifyDiskTitle, lang, workspace.Name, usage.Percent), message
+	}
+	c.notifyAsync(true, text, &models.Notification{
+		UserID: workspace.OwnerID,
+		Type:   models.NotificationTypeBudget,
+		Link:   "/workspaces/" + workspace.ID,
+		Data: map[string]string{
+			"workspace_id": workspace.ID,
+			"level":        string(usage.Level),
+		},
+	})
+}
+
+// OnTranscriptExportFinished 대화 기록 내보내기 결과를 시작한 사용자에게 알림 (TranscriptExportListener)
+func (c *NotificationCenter) OnTranscriptExportFinished(export *models.TranscriptExport) {
+	data := map[string]string{
+		"export_id": export.ID,
+		"format":    string(export.Format),
+		"status":    string(export.Status),
+	}
+	if export.Status != models.TranscriptExportFailed {
+		data["artifact_key"] = export.ArtifactKey
+	}
+	text := func(lang validation.Language) (string, string) {
+		if export.Status == models.TranscriptExportFailed {
+			return validation.TL(validation.MsgNotifyExportFailedTitle, lang, export.Title), export.Error
+		}
+		return validation.TL(validation.MsgNotifyExportCompletedTitle, lang, export.Title),
+			validation.TL(validation.MsgNotifyExportCompleted, lang, len(export.SessionIDs), export.Messages, export.Format)
+	}
+	c.notifyAsync(true, text, &models.Notification{
+		UserID: export.OwnerID,
+		Type:   models.NotificationTypeExport,
+		Link:   "/exports/transcripts/" + export.ID,
+		Data:   data,
+	})
+}
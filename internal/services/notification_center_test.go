@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// recordingPusher WebSocket 대신 전달된 알림을 기록
+type recordingPusher struct {
+	mu     sync.Mutex
+	pushed []string
+	unread []int
+}
+
+func (p *recordingPusher) PushNotification(notification *models.Notification, unread int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pushed = append(p.pushed, notification.Title)
+	p.unread = append(p.unread, unread)
+}
+
+// waitForNotifications 백그라운드 전달이 끝날 때까지 사용자의 알림 수를 기다림
+func waitForNotifications(t *testing.T, center *NotificationCenter, userID string, want int) *models.NotificationListResponse {
+	t.Helper()
+	var resp *models.NotificationListResponse
+	require.Eventually(t, func() bool {
+		var err error
+		resp, err = center.List(context.Background(), userID, &models.NotificationListRequest{})
+		return err == nil && resp.Meta.Total == want
+	}, 2*time.Second, 10*time.Millisecond)
+	return resp
+}
+
+func TestNotificationCenter_DeliveryPreferences(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	require.NoError(t, store.Account().Create(ctx, &models.Account{ID: "bob", Username: "bob", Email: "bob@example.com", IsActive: true}))
+
+	mailer := NewMockNotificationService()
+	pusher := &recordingPusher{}
+	center := NewNotificationCenter(store, mailer, "https://aicli.example.com/")
+	center.AddPusher(pusher)
+
+	// 기본값: 알림 센터에 저장하고 바로 메일 발송
+	require.NoError(t, center.Notify(ctx, &models.Notification{UserID: "bob", Type: models.NotificationTypeApproval, Title: "검토 요청", Link: "/workspaces/ws-1"}))
+	require.Len(t, mailer.SentEmails, 1)
+	assert.Equal(t, "[AICLI] 검토 요청", mailer.SentEmails[0].Subject)
+	assert.Contains(t, mailer.SentEmails[0].Body, "https://aicli.example.com/workspaces/ws-1")
+	assert.Equal(t, []int{1}, pusher.unread)
+
+	inApp := false
+	prefs, err := center.UpdatePreferences(ctx, "bob", &models.NotificationPreferencesRequest{Preferences: []models.NotificationPreferenceUpdate{
+		{Type: models.NotificationTypeBudget, Email: models.NotificationEmailDigest},
+		{Type: models.NotificationTypeInvite, InApp: &inApp},
+		{Type: models.NotificationTypeSecurity, Email: models.NotificationEmailOff},
+	}})
+	require.NoError(t, err)
+	require.Len(t, prefs, len(models.NotificationTypes))
+	assert.Equal(t, models.NotificationEmailDigest, prefs[0].Email)
+	assert.True(t, prefs[0].InApp)
+
+	// 요약 메일: 저장만 하고 메일은 나중에
+	require.NoError(t, center.Notify(ctx, &models.Notification{UserID: "bob", Type: models.NotificationTypeBudget, Title: "예산 80%"}))
+	require.NoError(t, center.Notify(ctx, &models.Notification{UserID: "bob", Type: models.NotificationTypeBudget, Title: "예산 100%"}))
+	// 알림 센터에 저장하지 않으면 메일만 바로 발송
+	require.NoError(t, center.Notify(ctx, &models.Notification{UserID: "bob", Type: models.NotificationTypeInvite, Title: "초대"}))
+	// 메일 끔
+	require.NoError(t, center.Notify(ctx, &models.Notification{UserID: "bob", Type: models.NotificationTypeSecurity, Title: "비밀번호 변경"}))
+
+	require.Len(t, mailer.SentEmails, 2)
+	assert.Equal(t, "[AICLI] 초대", mailer.SentEmails[1].Subject)
+
+	resp, err := center.List(ctx, "bob", &models.NotificationListRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 4, resp.Meta.Total)
+	assert.Equal(t, 4, resp.Unread)
+	assert.Equal(t, []string{"검토 요청", "예산 80%", "예산 100%", "비밀번호 변경"}, pusher.pushed)
+
+	sent, err := center.SendDigests(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	require.Len(t, mailer.SentEmails, 3)
+	assert.Equal(t, "[AICLI] 알림 요약 (2건)", mailer.SentEmails[2].Subject)
+	assert.Contains(t, mailer.SentEmails[2].Body, "예산 80%")
+	assert.Contains(t, mailer.SentEmails[2].Body, "예산 100%")
+
+	// 한 번 보낸 알림은 다시 보내지 않음
+	sent, err = center.SendDigests(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+}
+
+func TestNotificationCenter_ListAndMarkRead(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	center := NewNotificationCenter(store, nil, "")
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	center.now = func() time.Time { return now }
+
+	ids := []string{}
+	for i, notificationType := range []models.NotificationType{models.NotificationTypeApproval, models.NotificationTypeBudget, models.NotificationTypeApproval} {
+		notification := &models.Notification{UserID: "alice", Type: notificationType, Title: string(notificationType), CreatedAt: now.Add(time.Duration(i) * time.Minute)}
+		require.NoError(t, center.Notify(ctx, notification))
+		ids = append(ids, notification.ID)
+	}
+	require.NoError(t, center.Notify(ctx, &models.Notification{UserID: "bob", Type: models.NotificationTypeApproval, Title: "other"}))
+
+	// 폴링: 마지막으로 받은 알림 이후
+	since := now
+	resp, err := center.List(ctx, "alice", &models.NotificationListRequest{Since: &since})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+	assert.Equal(t, ids[2], resp.Data[0].ID)
+
+	resp, err = center.List(ctx, "alice", &models.NotificationListRequest{Type: models.NotificationTypeApproval})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+	assert.Equal(t, 3, resp.Unread)
+
+	state, err := center.MarkRead(ctx, "alice", []string{ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, models.NotificationReadState{Marked: 1, Unread: 2}, *state)
+
+	// 다른 사용자의 알림은 표시하지 않음
+	state, err = center.MarkRead(ctx, "bob", []string{ids[1]})
+	require.NoError(t, err)
+	assert.Zero(t, state.Marked)
+
+	resp, err = center.List(ctx, "alice", &models.NotificationListRequest{UnreadOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+
+	state, err = center.MarkRead(ctx, "alice", nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.NotificationReadState{Marked: 2, Unread: 0}, *state)
+
+	resp, err = center.List(ctx, "alice", &models.NotificationListRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Data[0].ReadAt)
+	assert.Equal(t, now, *resp.Data[0].ReadAt)
+}
+
+func TestNotificationCenter_Producers(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	center := NewNotificationCenter(store, nil, "")
+	for _, account := range []*models.Account{
+		{ID: "root", Username: "root", Role: "admin", IsActive: true},
+		{ID: "ops", Username: "ops", Role: "admin", IsActive: true},
+		{ID: "former", Username: "former", Role: "admin"},
+		{ID: "bob", Username: "bob", Role: "user", IsActive: true},
+	} {
+		require.NoError(t, store.Account().Create(ctx, account))
+	}
+
+	workspace := &models.Workspace{Name: "payments", OwnerID: "alice", ProjectPath: "/srv/payments"}
+	require.NoError(t, store.Workspace().Create(ctx, workspace))
+
+	access := NewWorkspaceAccessService(store)
+	access.AddListener(center)
+	_, err := access.Grant(ctx, workspace.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionExecute})
+	require.NoError(t, err)
+	resp := waitForNotifications(t, center, "bob", 1)
+	assert.Equal(t, models.NotificationTypeInvite, resp.Data[0].Type)
+	assert.Equal(t, "워크스페이스 payments에 초대되었습니다", resp.Data[0].Title)
+	assert.Equal(t, "/workspaces/"+workspace.ID, resp.Data[0].Link)
+
+	// 검토 결정은 결정한 사람에게 알리지 않음
+	center.OnReviewDecided(&models.TaskReview{ID: "review-1", TaskID: "task-1", WorkspaceID: workspace.ID, Status: models.TaskReviewApproved, ReviewerID: "bob"}, []string{"alice", "bob"})
+	resp = waitForNotifications(t, center, "alice", 1)
+	assert.Equal(t, "태스크 결과가 승인되었습니다", resp.Data[0].Title)
+	assert.Equal(t, "review-1", resp.Data[0].Data["review_id"])
+
+	center.OnSecurityNotice("bob", "계정이 잠겼습니다", "로그인에 여러 번 실패했습니다.", "10.0.0.1")
+	resp = waitForNotifications(t, center, "bob", 2)
+	assert.Equal(t, models.NotificationTypeSecurity, resp.Data[0].Type)
+	assert.Equal(t, "10.0.0.1", resp.Data[0].Data["ip_address"])
+
+	// 관리자 알림은 활성 admin 계정에만
+	center.NotifyAdmins(&models.Notification{Type: models.NotificationTypeBudget, Title: "예산 80%"})
+	waitForNotifications(t, center, "root", 1)
+	waitForNotifications(t, center, "ops", 1)
+	former, err := center.List(ctx, "former", &models.NotificationListRequest{})
+	require.NoError(t, err)
+	assert.Zero(t, former.Meta.Total)
+}
+
+func TestNotificationCenter_RecipientLanguage(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	preferences := NewUserPreferenceService(store)
+	center := NewNotificationCenter(store, nil, "")
+	center.SetPreferences(preferences)
+
+	english := "en"
+	_, err := preferences.Update(ctx, "alice", &models.UserPreferenceRequest{Locale: &english})
+	require.NoError(t, err)
+
+	// 받는 사람마다 자신의 메시지 언어로 받음 (설정이 없으면 한국어)
+	review := &models.TaskReview{ID: "review-1", TaskID: "task-1", WorkspaceID: "ws-1", Status: models.TaskReviewRejected, ReviewerID: "carol", Comment: "테스트 누락"}
+	center.OnReviewDecided(review, []string{"alice", "bob"})
+	resp := waitForNotifications(t, center, "alice", 1)
+	assert.Equal(t, "Task result rejected", resp.Data[0].Title)
+	assert.Equal(t, "Review status of task task-1: rejected\nReview comment: 테스트 누락", resp.Data[0].Message)
+	resp = waitForNotifications(t, center, "bob", 1)
+	assert.Equal(t, "태스크 결과가 거부되었습니다", resp.Data[0].Title)
+
+	center.OnTranscriptExportFinished(&models.TranscriptExport{
+		ID: "export-1", OwnerID: "alice", Title: "Sprint", Format: models.TranscriptExportMarkdown,
+		SessionIDs: []string{"s1", "s2"}, Messages: 12, Status: models.TranscriptExportCompleted,
+	})
+	resp = waitForNotifications(t, center, "alice", 2)
+	assert.Equal(t, "Transcript export completed: Sprint", resp.Data[0].Title)
+	assert.Equal(t, "Exported 2 sessions and 12 messages as a markdown document.", resp.Data[0].Message)
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/email"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func setupNotificationTest(t *testing.T) (NotificationService, *email.Mailer) {
+	renderer, err := email.NewRenderer("")
+	require.NoError(t, err)
+	mailer := email.NewMailer(email.NewLogSender(nil), renderer, memory.New().EmailDelivery(), email.MailerConfig{}, nil)
+
+	notifier := NewNotificationService(mailer, &NotificationConfig{
+		BaseURL:            "https://aicli.example.com/",
+		ResetTokenExpiry:   2 * time.Hour,
+		SecurityRecipients: []string{"security@example.com", "ALICE@example.com"},
+	})
+	return notifier, mailer
+}
+
+func TestNotificationService_PasswordReset(t *testing.T) {
+	notifier, mailer := setupNotificationTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, notifier.SendPasswordResetEmail(ctx, "alice@example.com", "rst_a+b"))
+
+	deliveries, err := mailer.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	delivery := deliveries[0]
+	assert.Equal(t, email.TemplatePasswordReset, delivery.Template)
+	assert.Equal(t, models.EmailDeliveryPending, delivery.Status)
+	assert.Contains(t, delivery.TextBody, "https://aicli.example.com/auth/reset-password?token=rst_a%2Bb")
+	assert.Contains(t, delivery.TextBody, "2시간 후에 만료")
+
+	// 대기열에서 발송
+	assert.Equal(t, 1, mailer.ProcessDue(ctx))
+}
+
+func TestNotificationService_SecurityAlertRecipients(t *testing.T) {
+	notifier, mailer := setupNotificationTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, notifier.SendSecurityAlertEmail(ctx, "alice@example.com", &email.SecurityAlertData{
+		Title:   "로그인 실패로 계정이 잠겼습니다",
+		Message: "로그인에 5번 연속 실패했습니다.",
+	}))
+
+	// 계정 소유자와 보안 담당 관리자에게 한 번씩
+	deliveries, err := mailer.List(ctx, &models.EmailDeliveryFilter{Template: email.TemplateSecurityAlert})
+	require.NoError(t, err)
+	var recipients []string
+	for _, delivery := range deliveries {
+		recipients = append(recipients, delivery.To)
+		assert.Equal(t, "[보안 알림] 로그인 실패로 계정이 잠겼습니다", delivery.Subject)
+	}
+	assert.ElementsMatch(t, []string{"alice@example.com", "security@example.com"}, recipients)
+}
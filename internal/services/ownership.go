@@ -0,0 +1,508 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// OwnershipService 워크스페이스/프로젝트 소유권 이전과 사용자 오프보딩
+// 워크스페이스는 소유자를 바꾸고, 프로젝트는 다른 사용자의 워크스페이스로 옮깁니다.
+// 일괄 이전과 오프보딩은 먼저 전체 계획을 세운 뒤 실행하므로 dry-run 미리보기와
+// 실제 실행 결과가 같은 형태(models.OwnershipResult)로 반환됩니다.
+type OwnershipService struct {
+	storage   storage.Storage
+	access    *WorkspaceAccessService
+	validator *WorkspaceValidator
+}
+
+// NewOwnershipService 새 소유권 서비스 생성
+func NewOwnershipService(storage storage.Storage) *OwnershipService {
+	return &OwnershipService{
+		storage:   storage,
+		access:    NewWorkspaceAccessService(storage),
+		validator: NewWorkspaceValidator(),
+	}
+}
+
+// transferPlan 한 사용자에게 워크스페이스를 넘기는 계획
+// 같은 요청 안에서 이전될 워크스페이스도 이름 충돌과 개수 제한 계산에 포함합니다.
+type transferPlan struct {
+	toUserID string
+	rename   bool
+	owned    int
+	reserved map[string]bool
+}
+
+// projectPlan 한 워크스페이스로 프로젝트를 옮기는 계획
+type projectPlan struct {
+	target   *models.Workspace
+	reserved map[string]bool
+}
+
+// TransferWorkspace 워크스페이스 소유권을 다른 사용자에게 이전
+// 소유자만 이전할 수 있으며, admin이 true(시스템 관리자)면 소유자 확인을 건너뜁니다.
+func (s *OwnershipService) TransferWorkspace(ctx context.Context, workspaceID, actorID string, admin bool, req *models.WorkspaceTransferRequest) (*models.Workspace, error) {
+	workspace, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		if err := requireWorkspaceOwner(workspace, actorID); err != nil {
+			return nil, err
+		}
+	}
+
+	plan, err := s.newTransferPlan(ctx, req.ToUserID, req.RenameOnConflict)
+	if err != nil {
+		return nil, err
+	}
+	newName, err := s.planWorkspace(ctx, plan, workspace)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyWorkspace(ctx, workspace, req.ToUserID, newName, actorID, req.KeepAccess); err != nil {
+		return nil, err
+	}
+
+	transferred, err := s.getWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	transferred.MaskClaudeKey()
+	return transferred, nil
+}
+
+// TransferProject 프로젝트를 다른 워크스페이스로 이동
+// 일반 사용자는 원래 워크스페이스와 대상 워크스페이스 모두에 admin 권한이 있어야 합니다.
+func (s *OwnershipService) TransferProject(ctx context.Context, projectID, actorID string, admin bool, req *models.ProjectTransferRequest) (*models.Project, error) {
+	project, err := s.getProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *models.Workspace
+	if admin {
+		target, err = s.getWorkspace(ctx, req.TargetWorkspaceID)
+	} else {
+		if _, err = s.access.Authorize(ctx, project.WorkspaceID, actorID, models.WorkspacePermissionAdmin); err != nil {
+			return nil, err
+		}
+		target, err = s.access.Authorize(ctx, req.TargetWorkspaceID, actorID, models.WorkspacePermissionAdmin)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &projectPlan{target: target, reserved: make(map[string]bool)}
+	if err := s.planProject(ctx, plan, project); err != nil {
+		return nil, err
+	}
+	if err := s.applyProject(ctx, project, target.ID); err != nil {
+		return nil, err
+	}
+	return s.getProject(ctx, projectID)
+}
+
+// BulkTransfer 한 사용자의 워크스페이스와 프로젝트를 다른 사용자에게 일괄 이전 (관리자용)
+// 항목별로 성공/실패를 기록하며, DryRun이면 아무것도 변경하지 않고 결과만 계산합니다.
+func (s *OwnershipService) BulkTransfer(ctx context.Context, actorID string, req *models.BulkTransferRequest) (*models.OwnershipResult, error) {
+	if req.FromUserID == req.ToUserID {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "현재 소유자와 새 소유자가 같습니다", ErrInvalidRequest)
+	}
+
+	// 프로젝트 대상 워크스페이스는 변경을 시작하기 전에 확인
+	var projects *projectPlan
+	if len(req.ProjectIDs) > 0 {
+		if req.TargetWorkspaceID == "" {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "project_ids를 옮길 target_workspace_id가 필요합니다", ErrInvalidRequest)
+		}
+		target, err := s.getWorkspace(ctx, req.TargetWorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		if target.OwnerID != req.ToUserID {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "target_workspace_id는 새 소유자의 워크스페이스여야 합니다", ErrInvalidRequest)
+		}
+		projects = &projectPlan{target: target, reserved: make(map[string]bool)}
+	}
+
+	workspaces := []*models.Workspace{}
+	result := &models.OwnershipResult{DryRun: req.DryRun, Changes: []models.OwnershipChange{}}
+	if len(req.WorkspaceIDs) == 0 && len(req.ProjectIDs) == 0 {
+		owned, err := s.ownedWorkspaces(ctx, req.FromUserID)
+		if err != nil {
+			return nil, err
+		}
+		workspaces = owned
+	}
+	for _, id := range req.WorkspaceIDs {
+		workspace, err := s.getWorkspace(ctx, id)
+		if err == nil && workspace.OwnerID != req.FromUserID {
+			err = NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("워크스페이스 %s는 %s의 소유가 아닙니다", id, req.FromUserID), ErrInvalidRequest)
+		}
+		if err != nil {
+			result.Add(models.OwnershipChange{
+				Action:       models.OwnershipActionTransfer,
+				ResourceType: "workspace",
+				ResourceID:   id,
+				FromUserID:   req.FromUserID,
+				ToUserID:     req.ToUserID,
+				Error:        NewBatchItemError(err),
+			})
+			continue
+		}
+		workspaces = append(workspaces, workspace)
+	}
+
+	// 워크스페이스 이전
+	plan, err := s.newTransferPlan(ctx, req.ToUserID, req.RenameOnConflict)
+	if err != nil {
+		return nil, err
+	}
+	for _, workspace := range workspaces {
+		result.Add(s.transferWorkspace(ctx, plan, workspace, actorID, req.DryRun))
+	}
+
+	// 프로젝트 이동
+	for _, id := range req.ProjectIDs {
+		result.Add(s.moveProject(ctx, projects, id, req.FromUserID, req.DryRun))
+	}
+
+	return result, nil
+}
+
+// Offboard 사용자가 소유한 모든 워크스페이스를 다른 사용자에게 넘기거나 아카이브
+// 로컬 계정이 아직 활성 상태면 먼저 비활성화하고, 다른 워크스페이스에서 이 사용자에게
+// 부여된 ACL 항목도 회수합니다. DryRun이면 아무것도 변경하지 않고 결과만 계산합니다.
+func (s *OwnershipService) Offboard(ctx context.Context, userID, actorID string, req *models.OffboardRequest) (*models.OwnershipResult, error) {
+	if userID == actorID {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "자기 자신은 오프보딩할 수 없습니다", ErrInvalidRequest)
+	}
+
+	var plan *transferPlan
+	switch req.Mode {
+	case models.OffboardReassign:
+		if req.ToUserID == "" {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "reassign에는 to_user_id가 필요합니다", ErrInvalidRequest)
+		}
+		if req.ToUserID == userID {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "오프보딩 대상에게 다시 넘길 수 없습니다", ErrInvalidRequest)
+		}
+		recipient, err := s.storage.Account().GetByID(ctx, req.ToUserID)
+		if err != nil && !storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeInternal, "계정 조회 실패", err)
+		}
+		if err == nil && !recipient.IsActive {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "비활성화된 사용자에게는 넘길 수 없습니다", ErrInvalidRequest)
+		}
+		// 오프보딩은 이름이 겹쳐도 멈추지 않도록 항상 접미사를 붙입니다
+		if plan, err = s.newTransferPlan(ctx, req.ToUserID, true); err != nil {
+			return nil, err
+		}
+	case models.OffboardArchive:
+	default:
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "mode는 reassign, archive 중 하나여야 합니다", ErrInvalidRequest)
+	}
+
+	result := &models.OwnershipResult{DryRun: req.DryRun, UserID: userID, Changes: []models.OwnershipChange{}}
+
+	// 로컬 계정 비활성화 (OAuth 사용자처럼 로컬 계정이 없으면 건너뜀)
+	account, err := s.storage.Account().GetByID(ctx, userID)
+	if err != nil && !storage.IsNotFoundError(err) {
+		return nil, NewWorkspaceError(ErrCodeInternal, "계정 조회 실패", err)
+	}
+	if err == nil && account.IsActive {
+		if !req.DryRun {
+			account.IsActive = false
+			if err := s.storage.Account().Update(ctx, account); err != nil {
+				return nil, NewWorkspaceError(ErrCodeInternal, "계정 비활성화 실패", err)
+			}
+		}
+		result.AccountDeactivated = true
+	}
+
+	workspaces, err := s.ownedWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, workspace := range workspaces {
+		if plan != nil {
+			result.Add(s.transferWorkspace(ctx, plan, workspace, actorID, req.DryRun))
+		} else if workspace.Status != models.WorkspaceStatusArchived {
+			result.Add(s.archiveWorkspace(ctx, workspace, req.DryRun))
+		}
+	}
+
+	// 공유받은 접근 권한 회수
+	entries, err := s.storage.WorkspaceACL().ListByPrincipals(ctx, []models.ACLPrincipal{{Type: models.ACLPrincipalUser, ID: userID}})
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "ACL 항목 조회 실패", err)
+	}
+	for _, entry := range entries {
+		change := models.OwnershipChange{
+			Action:       models.OwnershipActionRevoke,
+			ResourceType: "workspace",
+			ResourceID:   entry.WorkspaceID,
+			FromUserID:   userID,
+		}
+		if workspace, err := s.storage.Workspace().GetByID(ctx, entry.WorkspaceID); err == nil {
+			change.Name = workspace.Name
+		}
+		if !req.DryRun {
+			if err := s.storage.WorkspaceACL().Delete(ctx, entry.WorkspaceID, entry.PrincipalType, entry.PrincipalID); err != nil && !storage.IsNotFoundError(err) {
+				change.Error = NewBatchItemError(err)
+			}
+		}
+		result.Add(change)
+	}
+
+	return result, nil
+}
+
+// transferWorkspace 계획에 워크스페이스를 추가하고 DryRun이 아니면 바로 이전
+func (s *OwnershipService) transferWorkspace(ctx context.Context, plan *transferPlan, workspace *models.Workspace, actorID string, dryRun bool) models.OwnershipChange {
+	change := models.OwnershipChange{
+		Action:       models.OwnershipActionTransfer,
+		ResourceType: "workspace",
+		ResourceID:   workspace.ID,
+		Name:         workspace.Name,
+		FromUserID:   workspace.OwnerID,
+		ToUserID:     plan.toUserID,
+	}
+
+	newName, err := s.planWorkspace(ctx, plan, workspace)
+	if err == nil && !dryRun {
+		err = s.applyWorkspace(ctx, workspace, plan.toUserID, newName, actorID, "")
+	}
+	if err != nil {
+		change.Error = NewBatchItemError(err)
+		return change
+	}
+	if newName != workspace.Name {
+		change.NewName = newName
+	}
+	return change
+}
+
+// archiveWorkspace 워크스페이스를 아카이브 (DryRun이면 가능 여부만 확인)
+func (s *OwnershipService) archiveWorkspace(ctx context.Context, workspace *models.Workspace, dryRun bool) models.OwnershipChange {
+	change := models.OwnershipChange{
+		Action:       models.OwnershipActionArchive,
+		ResourceType: "workspace",
+		ResourceID:   workspace.ID,
+		Name:         workspace.Name,
+		FromUserID:   workspace.OwnerID,
+	}
+
+	err := s.validator.CanDeleteWorkspace(ctx, workspace)
+	if err == nil && !dryRun {
+		if err = s.storage.Workspace().Update(ctx, workspace.ID, map[string]interface{}{"status": models.WorkspaceStatusArchived}); err != nil {
+			err = NewWorkspaceError(ErrCodeInternal, "워크스페이스 아카이브 실패", err)
+		}
+	}
+	if err != nil {
+		change.Error = NewBatchItemError(err)
+	}
+	return change
+}
+
+// moveProject 계획에 프로젝트를 추가하고 DryRun이 아니면 바로 이동
+func (s *OwnershipService) moveProject(ctx context.Context, plan *projectPlan, projectID, fromUserID string, dryRun bool) models.OwnershipChange {
+	change := models.OwnershipChange{
+		Action:            models.OwnershipActionMove,
+		ResourceType:      "project",
+		ResourceID:        projectID,
+		FromUserID:        fromUserID,
+		ToUserID:          plan.target.OwnerID,
+		TargetWorkspaceID: plan.target.ID,
+	}
+
+	project, err := s.getProject(ctx, projectID)
+	if err == nil {
+		change.Name = project.Name
+		var source *models.Workspace
+		if source, err = s.getWorkspace(ctx, project.WorkspaceID); err == nil && source.OwnerID != fromUserID {
+			err = NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("프로젝트 %s는 %s의 워크스페이스에 속하지 않습니다", projectID, fromUserID), ErrInvalidRequest)
+		}
+	}
+	if err == nil {
+		err = s.planProject(ctx, plan, project)
+	}
+	if err == nil && !dryRun {
+		err = s.applyProject(ctx, project, plan.target.ID)
+	}
+	if err != nil {
+		change.Error = NewBatchItemError(err)
+	}
+	return change
+}
+
+// newTransferPlan 새 소유자의 현재 워크스페이스 수로 계획 시작
+func (s *OwnershipService) newTransferPlan(ctx context.Context, toUserID string, rename bool) (*transferPlan, error) {
+	if toUserID == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "새 소유자 ID가 필요합니다", ErrInvalidRequest)
+	}
+	count, err := s.storage.Workspace().CountByOwner(ctx, toUserID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 수 조회 실패", err)
+	}
+	return &transferPlan{toUserID: toUserID, rename: rename, owned: count, reserved: make(map[string]bool)}, nil
+}
+
+// planWorkspace 워크스페이스를 새 소유자에게 넘길 수 있는지 확인하고 새 소유자 아래에서 쓸 이름을 결정
+func (s *OwnershipService) planWorkspace(ctx context.Context, plan *transferPlan, workspace *models.Workspace) (string, error) {
+	if workspace.OwnerID == plan.toUserID {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "이미 해당 사용자가 소유한 워크스페이스입니다", ErrInvalidRequest)
+	}
+	if err := s.validator.CanCreateWorkspace(ctx, plan.toUserID, plan.owned); err != nil {
+		return "", err
+	}
+
+	name := workspace.Name
+	for i := 2; ; i++ {
+		exists := plan.reserved[name]
+		if !exists {
+			var err error
+			if exists, err = s.storage.Workspace().ExistsByName(ctx, plan.toUserID, name); err != nil {
+				return "", NewWorkspaceError(ErrCodeInternal, "워크스페이스 이름 확인 실패", err)
+			}
+		}
+		if !exists {
+			break
+		}
+		if !plan.rename {
+			return "", NewWorkspaceError(ErrCodeAlreadyExists, "새 소유자에게 같은 이름의 워크스페이스가 이미 존재합니다", ErrWorkspaceExists)
+		}
+		if i >= 100 {
+			return "", NewWorkspaceError(ErrCodeAlreadyExists, "사용 가능한 워크스페이스 이름을 찾을 수 없습니다", ErrWorkspaceExists)
+		}
+		name = fmt.Sprintf("%s (%d)", workspace.Name, i)
+	}
+
+	plan.owned++
+	plan.reserved[name] = true
+	return name, nil
+}
+
+// applyWorkspace 소유자와 이름을 바꾸고 ACL을 정리
+// 새 소유자에게 있던 ACL 항목은 소유자 권한과 겹치므로 지우고, keepAccess가 있으면
+// 이전 소유자에게 그 권한을 부여합니다.
+func (s *OwnershipService) applyWorkspace(ctx context.Context, workspace *models.Workspace, toUserID, newName, actorID string, keepAccess models.WorkspacePermission) error {
+	updates := map[string]interface{}{"owner_id": toUserID}
+	if newName != workspace.Name {
+		updates["name"] = newName
+	}
+	if err := s.storage.Workspace().Update(ctx, workspace.ID, updates); err != nil {
+		switch {
+		case storage.IsNotFoundError(err):
+			return NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		case storage.IsAlreadyExistsError(err):
+			return NewWorkspaceError(ErrCodeAlreadyExists, "새 소유자에게 같은 이름의 워크스페이스가 이미 존재합니다", ErrWorkspaceExists)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "워크스페이스 소유권 이전 실패", err)
+	}
+
+	if err := s.storage.WorkspaceACL().Delete(ctx, workspace.ID, models.ACLPrincipalUser, toUserID); err != nil && !storage.IsNotFoundError(err) {
+		return NewWorkspaceError(ErrCodeInternal, "워크스페이스 ACL 정리 실패", err)
+	}
+	if keepAccess != "" {
+		entry := &models.WorkspaceACLEntry{
+			WorkspaceID:   workspace.ID,
+			PrincipalType: models.ACLPrincipalUser,
+			PrincipalID:   workspace.OwnerID,
+			Permission:    keepAccess,
+			GrantedBy:     actorID,
+		}
+		if err := s.storage.WorkspaceACL().Upsert(ctx, entry); err != nil {
+			return NewWorkspaceError(ErrCodeInternal, "이전 소유자 권한 부여 실패", err)
+		}
+	}
+	return nil
+}
+
+// planProject 프로젝트를 대상 워크스페이스로 옮길 수 있는지 확인
+func (s *OwnershipService) planProject(ctx context.Context, plan *projectPlan, project *models.Project) error {
+	if project.WorkspaceID == plan.target.ID {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "이미 대상 워크스페이스에 속한 프로젝트입니다", ErrInvalidRequest)
+	}
+	if plan.target.Status == models.WorkspaceStatusArchived {
+		return NewWorkspaceError(ErrCodeArchived, "아카이브된 워크스페이스로는 옮길 수 없습니다", ErrWorkspaceArchived)
+	}
+
+	active, err := s.storage.Session().GetActiveCount(ctx, project.ID)
+	if err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "활성 세션 수 조회 실패", err)
+	}
+	if active > 0 {
+		return NewWorkspaceError(ErrCodeResourceBusy, "활성 세션이 있는 프로젝트는 옮길 수 없습니다", ErrResourceBusy)
+	}
+
+	exists := plan.reserved[project.Name]
+	if !exists {
+		if exists, err = s.storage.Project().ExistsByName(ctx, plan.target.ID, project.Name); err != nil {
+			return NewWorkspaceError(ErrCodeInternal, "프로젝트 이름 확인 실패", err)
+		}
+	}
+	if exists {
+		return NewWorkspaceError(ErrCodeAlreadyExists, "대상 워크스페이스에 같은 이름의 프로젝트가 이미 존재합니다", nil)
+	}
+
+	plan.reserved[project.Name] = true
+	return nil
+}
+
+// applyProject 프로젝트의 워크스페이스 변경
+func (s *OwnershipService) applyProject(ctx context.Context, project *models.Project, targetWorkspaceID string) error {
+	if err := s.storage.Project().Update(ctx, project.ID, map[string]interface{}{"workspace_id": targetWorkspaceID}); err != nil {
+		switch {
+		case storage.IsNotFoundError(err):
+			return NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+		case storage.IsAlreadyExistsError(err):
+			return NewWorkspaceError(ErrCodeAlreadyExists, "대상 워크스페이스에 같은 이름의 프로젝트가 이미 존재합니다", err)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "프로젝트 이동 실패", err)
+	}
+	return nil
+}
+
+// ownedWorkspaces 사용자가 소유한 모든 워크스페이스 (이전 도중 목록이 바뀌지 않도록 먼저 모두 조회)
+func (s *OwnershipService) ownedWorkspaces(ctx context.Context, userID string) ([]*models.Workspace, error) {
+	owned := []*models.Workspace{}
+	for page := 1; ; page++ {
+		workspaces, total, err := s.storage.Workspace().GetByOwnerID(ctx, userID, &models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 목록 조회 실패", err)
+		}
+		owned = append(owned, workspaces...)
+		if len(workspaces) == 0 || len(owned) >= total {
+			return owned, nil
+		}
+	}
+}
+
+// getWorkspace 워크스페이스 조회 (없으면 NotFound 에러)
+func (s *OwnershipService) getWorkspace(ctx context.Context, id string) (*models.Workspace, error) {
+	workspace, err := s.storage.Workspace().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+	}
+	return workspace, nil
+}
+
+// getProject 프로젝트 조회 (없으면 NotFound 에러)
+func (s *OwnershipService) getProject(ctx context.Context, id string) (*models.Project, error) {
+	project, err := s.storage.Project().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "프로젝트 조회 실패", err)
+	}
+	return project, nil
+}
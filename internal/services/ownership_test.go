@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func createOwnedWorkspace(t *testing.T, store *memory.Storage, ownerID, name string) *models.Workspace {
+	ws := &models.Workspace{Name: name, OwnerID: ownerID, ProjectPath: "/tmp/" + name, Status: models.WorkspaceStatusActive}
+	require.NoError(t, store.Workspace().Create(context.Background(), ws))
+	return ws
+}
+
+func TestOwnershipService_TransferWorkspace(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	ownership := NewOwnershipService(store)
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+	createOwnedWorkspace(t, store, "bob", "api")
+
+	// 소유자가 아니면 이전할 수 없음
+	_, err := ownership.TransferWorkspace(ctx, ws.ID, "bob", false, &models.WorkspaceTransferRequest{ToUserID: "bob"})
+	assertWorkspaceErrorCode(t, err, ErrCodeOwnershipRequired)
+
+	// 이름 충돌
+	_, err = ownership.TransferWorkspace(ctx, ws.ID, "alice", false, &models.WorkspaceTransferRequest{ToUserID: "bob"})
+	assertWorkspaceErrorCode(t, err, ErrCodeAlreadyExists)
+
+	// bob에게 있던 ACL 항목은 지우고 alice에게 read 권한을 남김
+	require.NoError(t, store.WorkspaceACL().Upsert(ctx, &models.WorkspaceACLEntry{WorkspaceID: ws.ID, PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionExecute}))
+	transferred, err := ownership.TransferWorkspace(ctx, ws.ID, "alice", false, &models.WorkspaceTransferRequest{
+		ToUserID:         "bob",
+		RenameOnConflict: true,
+		KeepAccess:       models.WorkspacePermissionRead,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bob", transferred.OwnerID)
+	assert.Equal(t, "api (2)", transferred.Name)
+
+	entries, err := store.WorkspaceACL().ListByWorkspace(ctx, ws.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "alice", entries[0].PrincipalID)
+	assert.Equal(t, models.WorkspacePermissionRead, entries[0].Permission)
+
+	owned, err := store.Workspace().ExistsByName(ctx, "bob", "api (2)")
+	require.NoError(t, err)
+	assert.True(t, owned)
+}
+
+func TestOwnershipService_TransferProject(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	ownership := NewOwnershipService(store)
+	source := createOwnedWorkspace(t, store, "alice", "source")
+	target := createOwnedWorkspace(t, store, "bob", "target")
+	project := &models.Project{WorkspaceID: source.ID, Name: "web", Path: "/tmp/web"}
+	require.NoError(t, store.Project().Create(ctx, project))
+
+	// 대상 워크스페이스 권한이 없으면 실패, 관리자는 가능
+	_, err := ownership.TransferProject(ctx, project.ID, "alice", false, &models.ProjectTransferRequest{TargetWorkspaceID: target.ID})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, session))
+	_, err = ownership.TransferProject(ctx, project.ID, "root", true, &models.ProjectTransferRequest{TargetWorkspaceID: target.ID})
+	assertWorkspaceErrorCode(t, err, ErrCodeResourceBusy)
+
+	session.Status = models.SessionEnded
+	require.NoError(t, store.Session().Update(ctx, session))
+	moved, err := ownership.TransferProject(ctx, project.ID, "root", true, &models.ProjectTransferRequest{TargetWorkspaceID: target.ID})
+	require.NoError(t, err)
+	assert.Equal(t, target.ID, moved.WorkspaceID)
+}
+
+func TestOwnershipService_BulkTransfer(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	ownership := NewOwnershipService(store)
+	first := createOwnedWorkspace(t, store, "alice", "one")
+	createOwnedWorkspace(t, store, "alice", "two")
+	createOwnedWorkspace(t, store, "bob", "one")
+	other := createOwnedWorkspace(t, store, "carol", "three")
+
+	// 미리보기는 아무것도 바꾸지 않음
+	preview, err := ownership.BulkTransfer(ctx, "root", &models.BulkTransferRequest{FromUserID: "alice", ToUserID: "bob", RenameOnConflict: true, DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, preview.DryRun)
+	assert.Equal(t, 2, preview.Succeeded)
+	unchanged, err := store.Workspace().GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", unchanged.OwnerID)
+
+	// 실행 결과는 미리보기와 같고, 다른 사용자의 워크스페이스는 항목 에러
+	result, err := ownership.BulkTransfer(ctx, "root", &models.BulkTransferRequest{FromUserID: "alice", ToUserID: "bob", RenameOnConflict: true})
+	require.NoError(t, err)
+	assert.Equal(t, preview.Changes, result.Changes)
+
+	count, err := store.Workspace().CountByOwner(ctx, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	result, err = ownership.BulkTransfer(ctx, "root", &models.BulkTransferRequest{FromUserID: "alice", ToUserID: "bob", WorkspaceIDs: []string{other.ID}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Failed)
+	require.NotNil(t, result.Changes[0].Error)
+	assert.Equal(t, ErrCodeInvalidRequest, result.Changes[0].Error.Code)
+}
+
+func TestOwnershipService_Offboard(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	ownership := NewOwnershipService(store)
+	require.NoError(t, store.Account().Create(ctx, &models.Account{ID: "alice", Username: "alice", Email: "alice@example.com", IsActive: true}))
+	owned := createOwnedWorkspace(t, store, "alice", "api")
+	shared := createOwnedWorkspace(t, store, "carol", "shared")
+	require.NoError(t, store.WorkspaceACL().Upsert(ctx, &models.WorkspaceACLEntry{WorkspaceID: shared.ID, PrincipalType: models.ACLPrincipalUser, PrincipalID: "alice", Permission: models.WorkspacePermissionRead}))
+
+	_, err := ownership.Offboard(ctx, "alice", "root", &models.OffboardRequest{Mode: models.OffboardReassign})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	// 미리보기
+	preview, err := ownership.Offboard(ctx, "alice", "root", &models.OffboardRequest{Mode: models.OffboardArchive, DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, preview.AccountDeactivated)
+	require.Len(t, preview.Changes, 2)
+	assert.Equal(t, models.OwnershipActionArchive, preview.Changes[0].Action)
+	assert.Equal(t, models.OwnershipActionRevoke, preview.Changes[1].Action)
+	account, err := store.Account().GetByID(ctx, "alice")
+	require.NoError(t, err)
+	assert.True(t, account.IsActive)
+
+	// 실행
+	result, err := ownership.Offboard(ctx, "alice", "root", &models.OffboardRequest{Mode: models.OffboardReassign, ToUserID: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Succeeded)
+	assert.Equal(t, models.OwnershipActionTransfer, result.Changes[0].Action)
+
+	account, err = store.Account().GetByID(ctx, "alice")
+	require.NoError(t, err)
+	assert.False(t, account.IsActive)
+	ws, err := store.Workspace().GetByID(ctx, owned.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", ws.OwnerID)
+	entries, err := store.WorkspaceACL().ListByWorkspace(ctx, shared.ID)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
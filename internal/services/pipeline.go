@@ -0,0 +1,559 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+var (
+	// pipelineStepNamePattern 단계 이름 규칙
+	pipelineStepNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+	// pipelineArtifactPattern 앞 단계 출력 참조 ({{steps.<이름>.output}})
+	pipelineArtifactPattern = regexp.MustCompile(`\{\{\s*steps\.([A-Za-z0-9_-]+)\.output\s*\}\}`)
+)
+
+// maxPipelineSteps 파이프라인 최대 단계 수
+const maxPipelineSteps = 50
+
+// defaultPipelineRunLimit 조회 개수를 지정하지 않았을 때 반환할 실행 수
+const defaultPipelineRunLimit = 50
+
+// PipelineTaskRunner 파이프라인 단계를 태스크로 실행하는 인터페이스 (*TaskService)
+type PipelineTaskRunner interface {
+	Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error)
+	Cancel(ctx context.Context, id string) error
+}
+
+// PipelineListener 파이프라인 실행 상태 변경을 전달받는 리스너 (WebSocket 스트리밍용)
+type PipelineListener interface {
+	OnPipelineRunUpdate(run *models.PipelineRun)
+}
+
+// PipelineService 다단계 파이프라인 관리와 실행
+// 각 단계는 태스크 큐에서 태스크로 실행되고, 태스크가 끝나면(OnTaskFinished) 통과 조건을 확인해
+// 다음 단계를 제출합니다. 실패하거나 취소된 실행은 멈춘 단계부터 재개할 수 있습니다.
+type PipelineService struct {
+	storage  storage.Storage
+	access   *WorkspaceAccessService
+	prompts  *PromptService
+	tasks    PipelineTaskRunner
+	listener PipelineListener
+
+	// mu 실행 상태 변경을 직렬화 (단계 태스크 완료와 취소, 재개가 겹치지 않도록)
+	mu        sync.Mutex
+	runByTask map[string]string // 실행 중인 단계 태스크 ID -> 파이프라인 실행 ID
+}
+
+// NewPipelineService 새 파이프라인 서비스 생성
+func NewPipelineService(storage storage.Storage, prompts *PromptService, tasks PipelineTaskRunner) *PipelineService {
+	return &PipelineService{
+		storage:   storage,
+		access:    NewWorkspaceAccessService(storage),
+		prompts:   prompts,
+		tasks:     tasks,
+		runByTask: make(map[string]string),
+	}
+}
+
+// SetListener 실행 상태 리스너 설정
+func (s *PipelineService) SetListener(listener PipelineListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listener = listener
+}
+
+// Create 파이프라인 생성
+func (s *PipelineService) Create(ctx context.Context, ownerID string, req *models.PipelineCreateRequest) (*models.Pipeline, error) {
+	pipeline := &models.Pipeline{
+		OwnerID:     ownerID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Steps:       req.Steps,
+	}
+	if err := s.validatePipeline(ctx, pipeline); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Pipeline().Create(ctx, pipeline); err != nil {
+		if storage.IsAlreadyExistsError(err) {
+			return nil, NewWorkspaceError(ErrCodeAlreadyExists, "같은 이름의 파이프라인이 이미 존재합니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "파이프라인 저장 실패", err)
+	}
+	return pipeline, nil
+}
+
+// Get 파이프라인 조회 (작성자 또는 admin)
+func (s *PipelineService) Get(ctx context.Context, id, userID string, admin bool) (*models.Pipeline, error) {
+	pipeline, err := s.storage.Pipeline().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "파이프라인을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "파이프라인 조회 실패", err)
+	}
+	if !admin && pipeline.OwnerID != userID {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "파이프라인을 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	return pipeline, nil
+}
+
+// List 사용자의 파이프라인 조회
+func (s *PipelineService) List(ctx context.Context, userID string) ([]*models.Pipeline, error) {
+	pipelines, err := s.storage.Pipeline().ListByOwner(ctx, userID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "파이프라인 목록 조회 실패", err)
+	}
+	return pipelines, nil
+}
+
+// Update 파이프라인 수정 (진행 중인 실행은 시작 시점의 단계 정의를 계속 사용)
+func (s *PipelineService) Update(ctx context.Context, id, userID string, admin bool, req *models.PipelineUpdateRequest) (*models.Pipeline, error) {
+	pipeline, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		pipeline.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		pipeline.Description = *req.Description
+	}
+	if req.Steps != nil {
+		pipeline.Steps = *req.Steps
+	}
+	if err := s.validatePipeline(ctx, pipeline); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Pipeline().Update(ctx, pipeline); err != nil {
+		if storage.IsAlreadyExistsError(err) {
+			return nil, NewWorkspaceError(ErrCodeAlreadyExists, "같은 이름의 파이프라인이 이미 존재합니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "파이프라인 저장 실패", err)
+	}
+	return pipeline, nil
+}
+
+// Delete 파이프라인 삭제 (실행 기록은 유지)
+func (s *PipelineService) Delete(ctx context.Context, id, userID string, admin bool) error {
+	if _, err := s.Get(ctx, id, userID, admin); err != nil {
+		return err
+	}
+	if err := s.storage.Pipeline().Delete(ctx, id); err != nil {
+		if storage.IsNotFoundError(err) {
+			return NewWorkspaceError(ErrCodeNotFound, "파이프라인을 찾을 수 없습니다", err)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "파이프라인 삭제 실패", err)
+	}
+	return nil
+}
+
+// Run 파이프라인 실행 시작
+// 세션이 속한 워크스페이스에 execute 권한이 있어야 합니다 (admin 제외).
+// 첫 단계를 제출하지 못하면 실패 상태의 실행을 반환하며, 원인을 해결한 뒤 재개할 수 있습니다.
+func (s *PipelineService) Run(ctx context.Context, id, userID string, admin bool, req *models.PipelineRunRequest) (*models.PipelineRun, error) {
+	pipeline, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		if _, err := s.access.AuthorizeSession(ctx, req.SessionID, userID, models.WorkspacePermissionExecute); err != nil {
+			return nil, err
+		}
+	}
+
+	run := &models.PipelineRun{
+		PipelineID:   pipeline.ID,
+		PipelineName: pipeline.Name,
+		OwnerID:      userID,
+		SessionID:    req.SessionID,
+		Status:       models.PipelineRunRunning,
+		Steps:        pipeline.Steps,
+		StepRuns:     make([]models.PipelineStepRun, len(pipeline.Steps)),
+	}
+	for i, step := range pipeline.Steps {
+		run.StepRuns[i] = models.PipelineStepRun{Name: step.Name, Status: models.PipelineStepPending}
+	}
+	if err := s.storage.Pipeline().CreateRun(ctx, run); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "파이프라인 실행 저장 실패", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance(ctx, run, 0)
+	return run, nil
+}
+
+// GetRun 파이프라인 실행 조회 (실행한 사용자 또는 admin)
+func (s *PipelineService) GetRun(ctx context.Context, id, userID string, admin bool) (*models.PipelineRun, error) {
+	run, err := s.storage.Pipeline().GetRun(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "파이프라인 실행을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "파이프라인 실행 조회 실패", err)
+	}
+	if !admin && run.OwnerID != userID {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "파이프라인 실행을 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	return run, nil
+}
+
+// ListRuns 사용자의 파이프라인 실행 조회 (최신순)
+func (s *PipelineService) ListRuns(ctx context.Context, userID string, filter *models.PipelineRunFilter) ([]*models.PipelineRun, error) {
+	filter.OwnerID = userID
+	if filter.Limit <= 0 {
+		filter.Limit = defaultPipelineRunLimit
+	}
+	runs, err := s.storage.Pipeline().ListRuns(ctx, filter)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "파이프라인 실행 목록 조회 실패", err)
+	}
+	return runs, nil
+}
+
+// CancelRun 실행 중인 파이프라인 취소 (현재 단계 태스크도 취소)
+func (s *PipelineService) CancelRun(ctx context.Context, id, userID string, admin bool) (*models.PipelineRun, error) {
+	s.mu.Lock()
+	run, err := s.GetRun(ctx, id, userID, admin)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	if run.Status != models.PipelineRunRunning {
+		s.mu.Unlock()
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, fmt.Sprintf("실행 중인 파이프라인만 취소할 수 있습니다 (상태: %s)", run.Status), ErrInvalidRequest)
+	}
+
+	stepRun := &run.StepRuns[run.CurrentStep]
+	taskID := stepRun.TaskID
+	delete(s.runByTask, taskID)
+	now := time.Now()
+	stepRun.Status = models.PipelineStepCancelled
+	stepRun.CompletedAt = &now
+	run.Status = models.PipelineRunCancelled
+	run.CompletedAt = &now
+	s.save(ctx, run)
+	s.mu.Unlock()
+
+	// 태스크 취소는 종료 알림을 바로 호출할 수 있으므로 잠금 밖에서 수행
+	if taskID != "" {
+		if err := s.tasks.Cancel(ctx, taskID); err != nil {
+			log.Printf("파이프라인 단계 태스크 취소 실패: %s: %v", taskID, err)
+		}
+	}
+	return run, nil
+}
+
+// ResumeRun 실패하거나 취소된 실행을 멈춘 단계부터 다시 실행
+// 앞서 통과한 단계의 출력은 그대로 다음 단계에 전달됩니다.
+func (s *PipelineService) ResumeRun(ctx context.Context, id, userID string, admin bool) (*models.PipelineRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, err := s.GetRun(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != models.PipelineRunFailed && run.Status != models.PipelineRunCancelled {
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, fmt.Sprintf("실패하거나 취소된 파이프라인만 재개할 수 있습니다 (상태: %s)", run.Status), ErrInvalidRequest)
+	}
+	if !admin {
+		if _, err := s.access.AuthorizeSession(ctx, run.SessionID, userID, models.WorkspacePermissionExecute); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := run.CurrentStep; i < len(run.StepRuns); i++ {
+		run.StepRuns[i] = models.PipelineStepRun{
+			Name:     run.StepRuns[i].Name,
+			Status:   models.PipelineStepPending,
+			Attempts: run.StepRuns[i].Attempts,
+		}
+	}
+	run.Status = models.PipelineRunRunning
+	run.Error = ""
+	run.CompletedAt = nil
+	s.advance(ctx, run, run.CurrentStep)
+	return run, nil
+}
+
+// OnTaskFinished 단계 태스크 종료 처리 (TaskFinishListener)
+// 통과 조건을 확인하고 다음 단계를 제출하거나 실행을 끝냅니다.
+func (s *PipelineService) OnTaskFinished(task *models.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	runID, ok := s.runByTask[task.ID]
+	if !ok {
+		return
+	}
+	delete(s.runByTask, task.ID)
+
+	ctx := context.Background()
+	run, err := s.storage.Pipeline().GetRun(ctx, runID)
+	if err != nil {
+		log.Printf("파이프라인 실행 조회 실패: %s: %v", runID, err)
+		return
+	}
+	index := run.CurrentStep
+	if run.Status != models.PipelineRunRunning || run.StepRuns[index].TaskID != task.ID {
+		return
+	}
+
+	step := run.Steps[index]
+	stepRun := &run.StepRuns[index]
+	now := time.Now()
+	stepRun.CompletedAt = &now
+	stepRun.Output = truncateOutput(task.Output, models.PipelineArtifactMaxBytes)
+
+	var reason string
+	switch task.Status {
+	case models.TaskCompleted:
+		reason = checkPipelineCondition(step.PassIf, task.Output)
+	case models.TaskCancelled:
+		reason = "태스크가 취소되었습니다"
+	default:
+		reason = task.Error
+		if reason == "" {
+			reason = fmt.Sprintf("태스크 상태: %s", task.Status)
+		}
+	}
+
+	if reason == "" {
+		stepRun.Status = models.PipelineStepPassed
+		s.advance(ctx, run, index+1)
+		return
+	}
+
+	stepRun.Status = models.PipelineStepFailed
+	stepRun.Error = reason
+	if step.ContinueOnFailure {
+		s.advance(ctx, run, index+1)
+		return
+	}
+	s.fail(ctx, run, index, reason)
+}
+
+// advance from 단계부터 실행 (제출하지 못한 단계는 실패 처리, 모두 끝나면 성공)
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *PipelineService) advance(ctx context.Context, run *models.PipelineRun, from int) {
+	for index := from; index < len(run.Steps); index++ {
+		step := run.Steps[index]
+		stepRun := &run.StepRuns[index]
+		now := time.Now()
+		run.CurrentStep = index
+		stepRun.Attempts++
+		stepRun.StartedAt = &now
+
+		task, err := s.submitStep(ctx, run, index)
+		if err == nil {
+			stepRun.Status = models.PipelineStepRunning
+			stepRun.TaskID = task.ID
+			s.runByTask[task.ID] = run.ID
+			s.save(ctx, run)
+			return
+		}
+
+		stepRun.Status = models.PipelineStepFailed
+		stepRun.Error = err.Error()
+		stepRun.CompletedAt = &now
+		if !step.ContinueOnFailure {
+			s.fail(ctx, run, index, err.Error())
+			return
+		}
+	}
+
+	now := time.Now()
+	run.Status = models.PipelineRunSucceeded
+	run.CompletedAt = &now
+	s.save(ctx, run)
+}
+
+// fail 실행을 실패 상태로 종료 (index 단계부터 재개 가능)
+func (s *PipelineService) fail(ctx context.Context, run *models.PipelineRun, index int, reason string) {
+	now := time.Now()
+	run.Status = models.PipelineRunFailed
+	run.CurrentStep = index
+	run.Error = fmt.Sprintf("%s 단계 실패: %s", run.Steps[index].Name, reason)
+	run.CompletedAt = &now
+	s.save(ctx, run)
+}
+
+// save 실행 상태 저장 후 리스너에 알림
+func (s *PipelineService) save(ctx context.Context, run *models.PipelineRun) {
+	if err := s.storage.Pipeline().UpdateRun(ctx, run); err != nil {
+		log.Printf("파이프라인 실행 저장 실패: %s: %v", run.ID, err)
+	}
+	if s.listener != nil {
+		s.listener.OnPipelineRunUpdate(run)
+	}
+}
+
+// submitStep 앞 단계 출력을 치환한 단계를 태스크로 제출
+func (s *PipelineService) submitStep(ctx context.Context, run *models.PipelineRun, index int) (*models.Task, error) {
+	step := run.Steps[index]
+
+	artifacts := make(map[string]string, index)
+	for _, previous := range run.StepRuns[:index] {
+		artifacts[previous.Name] = previous.Output
+	}
+	substitute := func(text string) string {
+		return pipelineArtifactPattern.ReplaceAllStringFunc(text, func(match string) string {
+			return artifacts[pipelineArtifactPattern.FindStringSubmatch(match)[1]]
+		})
+	}
+
+	var command string
+	switch {
+	case step.Type == models.PipelineStepHook:
+		command = substitute(step.Command)
+	case step.PromptID != "":
+		prompt, err := s.prompts.Get(ctx, step.PromptID, run.OwnerID, false)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]string, len(step.Variables))
+		for name, value := range step.Variables {
+			values[name] = substitute(value)
+		}
+		if command, err = RenderPrompt(prompt, values); err != nil {
+			return nil, err
+		}
+	default:
+		command = substitute(step.Prompt)
+	}
+
+	return s.tasks.Create(ctx, &models.TaskCreateRequest{
+		SessionID: run.SessionID,
+		Command:   command,
+		Metadata: map[string]string{
+			"pipeline_run_id": run.ID,
+			"pipeline_step":   step.Name,
+		},
+		TimeoutTier: step.TimeoutTier,
+		UserID:      run.OwnerID,
+	})
+}
+
+// validatePipeline 단계 정의 검증
+// 단계 이름 중복, 종류별 필수 값, 통과 조건 정규식, 뒤 단계 출력 참조, 프롬프트 접근 권한을 확인합니다.
+func (s *PipelineService) validatePipeline(ctx context.Context, pipeline *models.Pipeline) error {
+	if pipeline.Name == "" {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "파이프라인 이름이 필요합니다", ErrInvalidRequest)
+	}
+	if len(pipeline.Steps) == 0 || len(pipeline.Steps) > maxPipelineSteps {
+		return NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("파이프라인 단계는 1개 이상 %d개 이하여야 합니다", maxPipelineSteps), ErrInvalidRequest)
+	}
+
+	var problems []string
+	earlier := make(map[string]bool, len(pipeline.Steps))
+	for i, step := range pipeline.Steps {
+		label := fmt.Sprintf("%d번째 단계", i+1)
+		if !pipelineStepNamePattern.MatchString(step.Name) {
+			problems = append(problems, fmt.Sprintf("%s 이름이 올바르지 않습니다: %q", label, step.Name))
+		} else if earlier[step.Name] {
+			problems = append(problems, fmt.Sprintf("단계 이름이 중복되었습니다: %s", step.Name))
+		} else {
+			label = step.Name + " 단계"
+		}
+
+		references := []string{}
+		switch step.Type {
+		case models.PipelineStepPrompt:
+			if step.Command != "" {
+				problems = append(problems, label+"에는 command를 지정할 수 없습니다")
+			}
+			if (strings.TrimSpace(step.Prompt) == "") == (step.PromptID == "") {
+				problems = append(problems, label+"에는 prompt와 prompt_id 중 하나만 지정해야 합니다")
+			}
+			if step.PromptID == "" && len(step.Variables) > 0 {
+				problems = append(problems, label+"의 variables는 prompt_id와 함께 사용해야 합니다")
+			}
+			if step.PromptID != "" {
+				if _, err := s.prompts.Get(ctx, step.PromptID, pipeline.OwnerID, false); err != nil {
+					problems = append(problems, fmt.Sprintf("%s의 프롬프트를 찾을 수 없습니다: %s", label, step.PromptID))
+				}
+			}
+			references = append(references, step.Prompt)
+			for _, value := range step.Variables {
+				references = append(references, value)
+			}
+		case models.PipelineStepHook:
+			if strings.TrimSpace(step.Command) == "" {
+				problems = append(problems, label+"에는 command가 필요합니다")
+			}
+			if step.Prompt != "" || step.PromptID != "" || len(step.Variables) > 0 {
+				problems = append(problems, label+"에는 prompt, prompt_id, variables를 지정할 수 없습니다")
+			}
+			references = append(references, step.Command)
+		default:
+			problems = append(problems, fmt.Sprintf("%s의 종류가 올바르지 않습니다: %q", label, step.Type))
+		}
+
+		if !step.TimeoutTier.IsValid() {
+			problems = append(problems, fmt.Sprintf("%s의 타임아웃 단계가 올바르지 않습니다: %s", label, step.TimeoutTier))
+		}
+		if step.PassIf != nil && step.PassIf.OutputMatches != "" {
+			if _, err := regexp.Compile(step.PassIf.OutputMatches); err != nil {
+				problems = append(problems, fmt.Sprintf("%s의 output_matches 정규식이 올바르지 않습니다: %v", label, err))
+			}
+		}
+		for _, text := range references {
+			for _, match := range pipelineArtifactPattern.FindAllStringSubmatch(text, -1) {
+				if !earlier[match[1]] {
+					problems = append(problems, fmt.Sprintf("%s에서 앞 단계가 아닌 %s의 출력을 참조합니다", label, match[1]))
+				}
+			}
+		}
+
+		earlier[step.Name] = true
+	}
+
+	if len(problems) > 0 {
+		return NewWorkspaceError(ErrCodeInvalidRequest, strings.Join(problems, "; "), ErrInvalidRequest)
+	}
+	return nil
+}
+
+// checkPipelineCondition 단계 출력이 통과 조건을 만족하는지 확인 (만족하지 않으면 이유 반환)
+func checkPipelineCondition(condition *models.PipelineCondition, output string) string {
+	if condition == nil {
+		return ""
+	}
+	if condition.OutputContains != "" && !strings.Contains(output, condition.OutputContains) {
+		return fmt.Sprintf("출력에 %q가 없습니다", condition.OutputContains)
+	}
+	if condition.OutputNotContains != "" && strings.Contains(output, condition.OutputNotContains) {
+		return fmt.Sprintf("출력에 %q가 있습니다", condition.OutputNotContains)
+	}
+	if condition.OutputMatches != "" {
+		pattern, err := regexp.Compile(condition.OutputMatches)
+		if err != nil {
+			return fmt.Sprintf("output_matches 정규식이 올바르지 않습니다: %v", err)
+		}
+		if !pattern.MatchString(output) {
+			return fmt.Sprintf("출력이 %q와 일치하지 않습니다", condition.OutputMatches)
+		}
+	}
+	return ""
+}
+
+// truncateOutput 태스크 출력을 최대 크기로 자름 (UTF-8 경계 유지)
+func truncateOutput(output string, maxBytes int) string {
+	if len(output) <= maxBytes {
+		return output
+	}
+	return strings.ToValidUTF8(output[:maxBytes], "")
+}
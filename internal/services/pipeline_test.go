@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakePipelineTasks 태스크를 실제로 실행하지 않고 생성과 취소만 기록하는 테스트용 실행기
+type fakePipelineTasks struct {
+	created   []*models.Task
+	cancelled []string
+	createErr error
+}
+
+func (f *fakePipelineTasks) Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	task := &models.Task{SessionID: req.SessionID, Command: req.Command, Status: models.TaskPending}
+	task.ID = fmt.Sprintf("task-%d", len(f.created)+1)
+	f.created = append(f.created, task)
+	return task, nil
+}
+
+func (f *fakePipelineTasks) Cancel(ctx context.Context, id string) error {
+	f.cancelled = append(f.cancelled, id)
+	return nil
+}
+
+// last 마지막으로 생성된 태스크
+func (f *fakePipelineTasks) last() *models.Task {
+	return f.created[len(f.created)-1]
+}
+
+// recordingPipelineListener 실행 상태 변경을 기록하는 리스너
+type recordingPipelineListener struct {
+	statuses []models.PipelineRunStatus
+}
+
+func (r *recordingPipelineListener) OnPipelineRunUpdate(run *models.PipelineRun) {
+	r.statuses = append(r.statuses, run.Status)
+}
+
+// finishTask 태스크를 종료 상태로 바꿔 파이프라인에 알림
+func finishTask(service *PipelineService, task *models.Task, status models.TaskStatus, output string) {
+	task.Status = status
+	task.Output = output
+	if status == models.TaskFailed {
+		task.Error = "exit status 1"
+	}
+	service.OnTaskFinished(task)
+}
+
+func newPipelineFixture(t *testing.T) (*PipelineService, *fakePipelineTasks, string) {
+	store := memory.New()
+	tasks := &fakePipelineTasks{}
+	service := NewPipelineService(store, NewPromptService(store, tasks), tasks)
+
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+	project := &models.Project{WorkspaceID: ws.ID, Name: "api", Path: "/tmp/api"}
+	require.NoError(t, store.Project().Create(context.Background(), project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(context.Background(), session))
+	return service, tasks, session.ID
+}
+
+func TestPipelineService_Validate(t *testing.T) {
+	service, _, _ := newPipelineFixture(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		steps []models.PipelineStep
+	}{
+		{"뒤 단계 출력 참조", []models.PipelineStep{
+			{Name: "review", Type: models.PipelineStepPrompt, Prompt: "{{steps.build.output}} 검토"},
+			{Name: "build", Type: models.PipelineStepHook, Command: "go build ./..."},
+		}},
+		{"중복 단계 이름", []models.PipelineStep{
+			{Name: "build", Type: models.PipelineStepHook, Command: "go build ./..."},
+			{Name: "build", Type: models.PipelineStepHook, Command: "go test ./..."},
+		}},
+		{"prompt 단계에 본문 없음", []models.PipelineStep{{Name: "review", Type: models.PipelineStepPrompt}}},
+		{"hook 단계에 프롬프트 지정", []models.PipelineStep{{Name: "build", Type: models.PipelineStepHook, Command: "ls", Prompt: "x"}}},
+		{"없는 프롬프트", []models.PipelineStep{{Name: "review", Type: models.PipelineStepPrompt, PromptID: "missing"}}},
+		{"잘못된 정규식", []models.PipelineStep{{Name: "build", Type: models.PipelineStepHook, Command: "ls", PassIf: &models.PipelineCondition{OutputMatches: "("}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Create(ctx, "alice", &models.PipelineCreateRequest{Name: "ci", Steps: tt.steps})
+			assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+		})
+	}
+
+	pipeline, err := service.Create(ctx, "alice", &models.PipelineCreateRequest{Name: "ci", Steps: []models.PipelineStep{
+		{Name: "build", Type: models.PipelineStepHook, Command: "go build ./..."},
+	}})
+	require.NoError(t, err)
+	_, err = service.Get(ctx, pipeline.ID, "bob", false)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
+
+func TestPipelineService_RunAndResume(t *testing.T) {
+	service, tasks, sessionID := newPipelineFixture(t)
+	listener := &recordingPipelineListener{}
+	service.SetListener(listener)
+	ctx := context.Background()
+
+	pipeline, err := service.Create(ctx, "alice", &models.PipelineCreateRequest{Name: "ci", Steps: []models.PipelineStep{
+		{Name: "build", Type: models.PipelineStepHook, Command: "go build ./..."},
+		{Name: "review", Type: models.PipelineStepPrompt, Prompt: "{{steps.build.output}} 결과를 검토하세요", PassIf: &models.PipelineCondition{OutputContains: "LGTM"}},
+		{Name: "lint", Type: models.PipelineStepHook, Command: "go vet ./...", ContinueOnFailure: true},
+		{Name: "publish", Type: models.PipelineStepHook, Command: "echo {{steps.review.output}}"},
+	}})
+	require.NoError(t, err)
+
+	// 다른 사용자의 파이프라인은 실행할 수 없음
+	_, err = service.Run(ctx, pipeline.ID, "bob", false, &models.PipelineRunRequest{SessionID: sessionID})
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+
+	// 세션 워크스페이스에 권한이 없으면 자신의 파이프라인도 실행할 수 없음
+	own, err := service.Create(ctx, "bob", &models.PipelineCreateRequest{Name: "ci", Steps: []models.PipelineStep{
+		{Name: "build", Type: models.PipelineStepHook, Command: "go build ./..."},
+	}})
+	require.NoError(t, err)
+	_, err = service.Run(ctx, own.ID, "bob", false, &models.PipelineRunRequest{SessionID: sessionID})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	run, err := service.Run(ctx, pipeline.ID, "alice", false, &models.PipelineRunRequest{SessionID: sessionID})
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineRunRunning, run.Status)
+	require.Len(t, tasks.created, 1)
+	assert.Equal(t, "go build ./...", tasks.last().Command)
+
+	// 앞 단계 출력이 다음 단계 본문으로 전달됨
+	finishTask(service, tasks.last(), models.TaskCompleted, "build ok")
+	require.Len(t, tasks.created, 2)
+	assert.Equal(t, "build ok 결과를 검토하세요", tasks.last().Command)
+
+	// 통과 조건을 만족하지 못하면 실패
+	finishTask(service, tasks.last(), models.TaskCompleted, "needs work")
+	run, err = service.GetRun(ctx, run.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineRunFailed, run.Status)
+	assert.Equal(t, 1, run.CurrentStep)
+	assert.Equal(t, models.PipelineStepPassed, run.StepRuns[0].Status)
+	assert.Equal(t, models.PipelineStepFailed, run.StepRuns[1].Status)
+	assert.Contains(t, run.Error, "review")
+
+	_, err = service.CancelRun(ctx, run.ID, "alice", false)
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+
+	// 실패한 단계부터 재개 (앞 단계 출력 유지)
+	run, err = service.ResumeRun(ctx, run.ID, "alice", false)
+	require.NoError(t, err)
+	require.Len(t, tasks.created, 3)
+	assert.Equal(t, "build ok 결과를 검토하세요", tasks.last().Command)
+	assert.Equal(t, 2, run.StepRuns[1].Attempts)
+
+	finishTask(service, tasks.last(), models.TaskCompleted, "LGTM")
+	// continue_on_failure 단계는 실패해도 다음 단계 진행
+	finishTask(service, tasks.last(), models.TaskFailed, "")
+	require.Len(t, tasks.created, 5)
+	assert.Equal(t, "echo LGTM", tasks.last().Command)
+	finishTask(service, tasks.last(), models.TaskCompleted, "LGTM")
+
+	run, err = service.GetRun(ctx, run.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineRunSucceeded, run.Status)
+	assert.Equal(t, models.PipelineStepFailed, run.StepRuns[2].Status)
+	assert.NotNil(t, run.CompletedAt)
+	assert.Equal(t, models.PipelineRunSucceeded, listener.statuses[len(listener.statuses)-1])
+}
+
+func TestPipelineService_CancelAndSubmitFailure(t *testing.T) {
+	service, tasks, sessionID := newPipelineFixture(t)
+	ctx := context.Background()
+
+	pipeline, err := service.Create(ctx, "alice", &models.PipelineCreateRequest{Name: "ci", Steps: []models.PipelineStep{
+		{Name: "build", Type: models.PipelineStepHook, Command: "go build ./..."},
+	}})
+	require.NoError(t, err)
+
+	run, err := service.Run(ctx, pipeline.ID, "alice", false, &models.PipelineRunRequest{SessionID: sessionID})
+	require.NoError(t, err)
+	taskID := tasks.last().ID
+
+	run, err = service.CancelRun(ctx, run.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineRunCancelled, run.Status)
+	assert.Equal(t, []string{taskID}, tasks.cancelled)
+
+	// 취소 후 늦게 도착한 종료 알림은 무시
+	finishTask(service, tasks.last(), models.TaskCancelled, "")
+	run, err = service.GetRun(ctx, run.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineRunCancelled, run.Status)
+
+	// 태스크를 제출하지 못하면 실패 상태로 남고, 원인을 해결한 뒤 재개
+	tasks.createErr = errors.New("태스크 큐가 가득 찼습니다")
+	run, err = service.ResumeRun(ctx, run.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineRunFailed, run.Status)
+	assert.Contains(t, run.StepRuns[0].Error, "가득 찼습니다")
+
+	tasks.createErr = nil
+	run, err = service.ResumeRun(ctx, run.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineRunRunning, run.Status)
+	assert.Equal(t, 3, run.StepRuns[0].Attempts)
+}
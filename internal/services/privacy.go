@@ -0,0 +1,379 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// PrivacyService 사용자 개인정보 내보내기와 삭제(익명화)
+// 삭제는 사용자 ID를 참조하는 기록이 깨지지 않도록 ID는 남기고, 계정의 사용자명/이메일/표시 이름과
+// 감사 기록의 접속 IP, User-Agent처럼 사용자를 알아볼 수 있는 값, 대화와 태스크 내용, 프롬프트를 지우며
+// 이미 발급한 토큰을 차단합니다.
+// 내보내기와 삭제는 모두 처리 기록(models.PrivacyRequest)으로 남습니다.
+type PrivacyService struct {
+	storage   storage.Storage
+	ownership *OwnershipService
+	now       func() time.Time
+
+	// revoker 삭제한 사용자의 토큰 차단 (tokenLifetime은 리프레시 토큰 유효 기간)
+	revoker       TokenRevoker
+	tokenLifetime time.Duration
+}
+
+// NewPrivacyService 새 개인정보 서비스 생성
+func NewPrivacyService(storage storage.Storage, ownership *OwnershipService) *PrivacyService {
+	return &PrivacyService{
+		storage:   storage,
+		ownership: ownership,
+		now:       time.Now,
+	}
+}
+
+// SetTokenRevoker 삭제한 사용자에게 이미 발급한 토큰을 차단하도록 설정
+func (s *PrivacyService) SetTokenRevoker(revoker TokenRevoker, tokenLifetime time.Duration) {
+	s.revoker = revoker
+	s.tokenLifetime = tokenLifetime
+}
+
+// Export 사용자의 프로필, 소유 워크스페이스/프로젝트/세션, 공유받은 권한, 프롬프트와 감사 기록을 묶어 반환
+// actorID는 처리 기록에 남길 요청자입니다 (본인 내보내기면 userID와 같음).
+func (s *PrivacyService) Export(ctx context.Context, userID, actorID string) (*models.UserDataExport, error) {
+	export := &models.UserDataExport{
+		UserID:           userID,
+		ExportedAt:       s.now(),
+		Workspaces:       []*models.Workspace{},
+		Projects:         []*models.Project{},
+		Sessions:         []models.UserDataSession{},
+		SharedWorkspaces: []*models.WorkspaceACLEntry{},
+		Prompts:          []*models.Prompt{},
+	}
+
+	account, err := s.storage.Account().GetByID(ctx, userID)
+	if err != nil && !storage.IsNotFoundError(err) {
+		return nil, NewWorkspaceError(ErrCodeInternal, "계정 조회 실패", err)
+	}
+	if err == nil {
+		export.Account = account
+	}
+
+	workspaces, err := s.ownership.ownedWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, workspace := range workspaces {
+		workspace.MaskClaudeKey()
+		export.Workspaces = append(export.Workspaces, workspace)
+
+		projects, sessions, err := s.workspaceSessions(ctx, workspace.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, project := range projects {
+			project.Config.ClaudeAPIKey = ""
+			project.Config.EncryptedAPIKey = ""
+			export.Projects = append(export.Projects, project)
+		}
+		for _, session := range sessions {
+			_, count, err := s.storage.Message().ListBySession(ctx, session.ID, &models.PaginationRequest{Page: 1, Limit: 1})
+			if err != nil {
+				return nil, NewWorkspaceError(ErrCodeInternal, "대화 기록 조회 실패", err)
+			}
+			export.Sessions = append(export.Sessions, models.UserDataSession{Session: session, MessageCount: count})
+		}
+	}
+
+	entries, err := s.storage.WorkspaceACL().ListByPrincipals(ctx, []models.ACLPrincipal{{Type: models.ACLPrincipalUser, ID: userID}})
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "ACL 항목 조회 실패", err)
+	}
+	export.SharedWorkspaces = append(export.SharedWorkspaces, entries...)
+
+	prompts, err := s.storage.Prompt().List(ctx, &models.PromptFilter{ViewerID: userID, Scope: "mine"})
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "프롬프트 조회 실패", err)
+	}
+	export.Prompts = append(export.Prompts, prompts...)
+
+	if export.Audit.ShareJoins, err = s.storage.ShareLink().ListJoinsByUser(ctx, userID); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "공유 링크 참여 기록 조회 실패", err)
+	}
+	if export.Audit.TerminalSessions, err = s.storage.Terminal().ListByUser(ctx, userID, 0); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "터미널 접속 기록 조회 실패", err)
+	}
+	if export.Audit.PrivacyRequests, err = s.storage.PrivacyRequest().List(ctx, &models.PrivacyRequestFilter{SubjectID: userID}); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "개인정보 처리 기록 조회 실패", err)
+	}
+
+	err = s.record(ctx, &models.PrivacyRequest{
+		Type:      models.PrivacyRequestExport,
+		SubjectID: userID,
+		ActorID:   actorID,
+		Summary: map[string]int{
+			"workspaces":        len(export.Workspaces),
+			"projects":          len(export.Projects),
+			"sessions":          len(export.Sessions),
+			"shared_workspaces": len(export.SharedWorkspaces),
+			"prompts":           len(export.Prompts),
+			"share_joins":       len(export.Audit.ShareJoins),
+			"terminal_sessions": len(export.Audit.TerminalSessions),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// Erase 사용자의 개인정보를 삭제 (관리자용)
+// 오프보딩과 같이 계정을 비활성화하고 소유 워크스페이스를 넘기거나 아카이브한 뒤, 계정 정보를 익명화하고
+// 사용자에게 남는 워크스페이스의 대화 기록과 태스크 명령/출력, 사용자의 프롬프트, 감사 기록의 접속 정보를 지우고
+// 이미 발급한 토큰을 차단합니다.
+// 실수를 막기 위해 req.Confirm에 삭제할 사용자 ID를 다시 입력해야 하며, DryRun이면 아무것도 변경하지 않습니다.
+func (s *PrivacyService) Erase(ctx context.Context, userID, actorID string, req *models.ErasureRequest) (*models.ErasureResult, error) {
+	if req.Confirm != userID {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "confirm에 삭제할 사용자 ID를 정확히 입력해야 합니다", ErrInvalidRequest)
+	}
+
+	// 소유권 변경 전에 워크스페이스 목록을 확보해야 이전되지 않고 남는 워크스페이스를 알 수 있음
+	owned, err := s.ownership.ownedWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownership, err := s.ownership.Offboard(ctx, userID, actorID, &models.OffboardRequest{
+		Mode:     req.Mode,
+		ToUserID: req.ToUserID,
+		DryRun:   req.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &models.ErasureResult{DryRun: req.DryRun, UserID: userID, Ownership: ownership}
+
+	// 계정 익명화 (ID는 다른 기록이 참조하므로 유지)
+	account, err := s.storage.Account().GetByID(ctx, userID)
+	if err != nil && !storage.IsNotFoundError(err) {
+		return nil, NewWorkspaceError(ErrCodeInternal, "계정 조회 실패", err)
+	}
+	if err == nil {
+		if !req.DryRun {
+			anonymizeAccount(account)
+			if err := s.storage.Account().Update(ctx, account); err != nil {
+				return nil, NewWorkspaceError(ErrCodeInternal, "계정 익명화 실패", err)
+			}
+		}
+		result.AccountAnonymized = true
+	}
+
+	// 다른 사용자에게 넘어가지 않은 워크스페이스의 대화 기록 삭제와 태스크 명령/출력 삭제
+	transferred := make(map[string]bool)
+	for _, change := range ownership.Changes {
+		if change.Action == models.OwnershipActionTransfer && change.Error == nil {
+			transferred[change.ResourceID] = true
+		}
+	}
+	for _, workspace := range owned {
+		if transferred[workspace.ID] {
+			continue
+		}
+		_, sessions, err := s.workspaceSessions(ctx, workspace.ID)
+		if err != nil {
+			return nil, err
+		}
+		deleted, err := s.deleteMessages(ctx, sessions, req.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		result.MessagesDeleted += deleted
+		erased, err := s.eraseTasks(ctx, sessions, req.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		result.TasksErased += erased
+	}
+
+	// 사용자가 작성한 프롬프트 삭제
+	prompts, err := s.storage.Prompt().List(ctx, &models.PromptFilter{ViewerID: userID, Scope: "mine"})
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "프롬프트 조회 실패", err)
+	}
+	result.PromptsDeleted = len(prompts)
+
+	// 감사 기록의 접속 정보 삭제 (기록 자체와 사용자 ID는 유지)
+	if req.DryRun {
+		joins, err := s.storage.ShareLink().ListJoinsByUser(ctx, userID)
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "공유 링크 참여 기록 조회 실패", err)
+		}
+		terminals, err := s.storage.Terminal().ListByUser(ctx, userID, 0)
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "터미널 접속 기록 조회 실패", err)
+		}
+		result.ShareJoinsAnonymized = len(joins)
+		result.TerminalSessionsAnonymized = len(terminals)
+		return result, nil
+	}
+
+	for _, prompt := range prompts {
+		if err := s.storage.Prompt().Delete(ctx, prompt.ID); err != nil && !storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeInternal, "프롬프트 삭제 실패", err)
+		}
+	}
+
+	// 이미 발급한 액세스/리프레시 토큰 차단
+	if s.revoker != nil {
+		now := s.now()
+		s.revoker.RevokeUser(userID, now, now.Add(s.tokenLifetime))
+		result.TokensRevoked = true
+	}
+
+	if result.ShareJoinsAnonymized, err = s.storage.ShareLink().AnonymizeJoins(ctx, userID); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "공유 링크 참여 기록 익명화 실패", err)
+	}
+	if result.TerminalSessionsAnonymized, err = s.storage.Terminal().AnonymizeByUser(ctx, userID); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "터미널 접속 기록 익명화 실패", err)
+	}
+
+	request := &models.PrivacyRequest{
+		Type:      models.PrivacyRequestErasure,
+		SubjectID: userID,
+		ActorID:   actorID,
+		Summary: map[string]int{
+			"ownership_succeeded": ownership.Succeeded,
+			"ownership_failed":    ownership.Failed,
+			"messages":            result.MessagesDeleted,
+			"tasks":               result.TasksErased,
+			"prompts":             result.PromptsDeleted,
+			"share_joins":         result.ShareJoinsAnonymized,
+			"terminal_sessions":   result.TerminalSessionsAnonymized,
+		},
+	}
+	if result.AccountAnonymized {
+		request.Summary["account"] = 1
+	}
+	if err := s.record(ctx, request); err != nil {
+		return nil, err
+	}
+	result.RequestID = request.ID
+	return result, nil
+}
+
+// ListRequests 개인정보 처리 기록 조회 (최신순)
+func (s *PrivacyService) ListRequests(ctx context.Context, filter *models.PrivacyRequestFilter) ([]*models.PrivacyRequest, error) {
+	requests, err := s.storage.PrivacyRequest().List(ctx, filter)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "개인정보 처리 기록 조회 실패", err)
+	}
+	return requests, nil
+}
+
+// record 처리 기록 저장
+func (s *PrivacyService) record(ctx context.Context, request *models.PrivacyRequest) error {
+	request.CreatedAt = s.now()
+	if err := s.storage.PrivacyRequest().Create(ctx, request); err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "개인정보 처리 기록 저장 실패", err)
+	}
+	return nil
+}
+
+// workspaceSessions 워크스페이스의 모든 프로젝트와 세션 조회
+func (s *PrivacyService) workspaceSessions(ctx context.Context, workspaceID string) ([]*models.Project, []*models.Session, error) {
+	projects := []*models.Project{}
+	for page := 1; ; page++ {
+		batch, total, err := s.storage.Project().GetByWorkspaceID(ctx, workspaceID,
+			&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, nil, NewWorkspaceError(ErrCodeInternal, "프로젝트 조회 실패", err)
+		}
+		projects = append(projects, batch...)
+		if len(batch) == 0 || len(projects) >= total {
+			break
+		}
+	}
+
+	sessions := []*models.Session{}
+	for _, project := range projects {
+		for page := 1; ; page++ {
+			resp, err := s.storage.Session().List(ctx, &models.SessionFilter{ProjectID: project.ID},
+				&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+			if err != nil {
+				return nil, nil, NewWorkspaceError(ErrCodeInternal, "세션 조회 실패", err)
+			}
+			batch, _ := resp.Data.([]*models.Session)
+			sessions = append(sessions, batch...)
+			if len(batch) == 0 || !resp.Meta.HasNext {
+				break
+			}
+		}
+	}
+	return projects, sessions, nil
+}
+
+// deleteMessages 세션의 대화 기록을 지우고 지운 메시지 수 반환 (DryRun이면 세기만 함)
+func (s *PrivacyService) deleteMessages(ctx context.Context, sessions []*models.Session, dryRun bool) (int, error) {
+	deleted := 0
+	for _, session := range sessions {
+		_, count, err := s.storage.Message().ListBySession(ctx, session.ID, &models.PaginationRequest{Page: 1, Limit: 1})
+		if err != nil {
+			return 0, NewWorkspaceError(ErrCodeInternal, "대화 기록 조회 실패", err)
+		}
+		if count == 0 {
+			continue
+		}
+		if !dryRun {
+			if err := s.storage.Message().DeleteBySession(ctx, session.ID); err != nil {
+				return 0, NewWorkspaceError(ErrCodeInternal, "대화 기록 삭제 실패", err)
+			}
+		}
+		deleted += count
+	}
+	return deleted, nil
+}
+
+// eraseTasks 세션 태스크의 명령(프롬프트), 출력, 에러를 지우고 지운 태스크 수 반환 (DryRun이면 세기만 함)
+// 태스크 기록 자체는 사용량 집계가 참조하므로 남깁니다.
+func (s *PrivacyService) eraseTasks(ctx context.Context, sessions []*models.Session, dryRun bool) (int, error) {
+	erased := 0
+	for _, session := range sessions {
+		for page := 1; ; page++ {
+			tasks, total, err := s.storage.Task().GetBySessionID(ctx, session.ID, &models.PaginationRequest{Page: page, Limit: 100})
+			if err != nil {
+				return 0, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+			}
+			for _, task := range tasks {
+				if task.Command == "" && task.Output == "" && task.Error == "" {
+					continue
+				}
+				if !dryRun {
+					task.Command = ""
+					task.Output = ""
+					task.Error = ""
+					if err := s.storage.Task().Update(ctx, task); err != nil {
+						return 0, NewWorkspaceError(ErrCodeInternal, "태스크 내용 삭제 실패", err)
+					}
+				}
+				erased++
+			}
+			if len(tasks) == 0 || page*100 >= total {
+				break
+			}
+		}
+	}
+	return erased, nil
+}
+
+// anonymizeAccount 계정에서 사용자를 알아볼 수 있는 값을 지우고 로그인할 수 없게 함
+// 사용자명은 고유해야 하므로 계정 ID로 만든 값을 사용합니다.
+func anonymizeAccount(account *models.Account) {
+	account.Username = "erased-" + account.ID
+	account.Email = ""
+	account.DisplayName = ""
+	account.PasswordHash = ""
+	account.FailedLogins = 0
+	account.LockedUntil = nil
+	account.LastLoginAt = nil
+	account.IsActive = false
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeTokenRevoker 차단한 사용자 기록
+type fakeTokenRevoker struct {
+	revoked []string
+}
+
+func (r *fakeTokenRevoker) RevokeUser(userID string, before, expiresAt time.Time) {
+	r.revoked = append(r.revoked, userID)
+}
+
+// seedPrivacyData alice의 계정, 워크스페이스, 세션 대화 기록과 감사 기록을 만듦
+func seedPrivacyData(t *testing.T, store *memory.Storage) *models.Session {
+	ctx := context.Background()
+	require.NoError(t, store.Account().Create(ctx, &models.Account{ID: "alice", Username: "alice", Email: "alice@example.com", DisplayName: "Alice", PasswordHash: "hash", IsActive: true}))
+
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+	project := &models.Project{WorkspaceID: ws.ID, Name: "web", Path: "/tmp/web"}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionEnded}
+	require.NoError(t, store.Session().Create(ctx, session))
+	for _, content := range []string{"hello", "world"} {
+		require.NoError(t, store.Message().Append(ctx, &models.SessionMessage{SessionID: session.ID, WorkspaceID: ws.ID, Role: models.MessageRoleUser, Content: content}))
+	}
+
+	link := &models.SessionShareLink{SessionID: session.ID, TokenHash: "hash", Scope: models.ShareScopeRead}
+	require.NoError(t, store.ShareLink().Create(ctx, link))
+	require.NoError(t, store.ShareLink().RecordJoin(ctx, &models.SessionShareJoin{LinkID: link.ID, SessionID: session.ID, UserID: "alice", UserName: "Alice", ClientIP: "10.0.0.1", UserAgent: "curl"}))
+	require.NoError(t, store.ShareLink().RecordJoin(ctx, &models.SessionShareJoin{LinkID: link.ID, SessionID: session.ID, UserID: "bob", UserName: "Bob", ClientIP: "10.0.0.2"}))
+
+	require.NoError(t, store.Task().Create(ctx, &models.Task{SessionID: session.ID, Command: "alice의 비밀 프롬프트", Output: "결과", Status: models.TaskCompleted}))
+	require.NoError(t, store.Prompt().Create(ctx, &models.Prompt{OwnerID: "alice", Name: "review", Content: "리뷰해 주세요"}))
+
+	terminal := &models.TerminalSession{WorkspaceID: ws.ID, UserID: "alice", ClientIP: "10.0.0.1", UserAgent: "curl", RecordingSize: 3}
+	require.NoError(t, store.Terminal().Create(ctx, terminal))
+	require.NoError(t, store.Terminal().SaveRecording(ctx, terminal.ID, []byte("ls\n")))
+	return session
+}
+
+func TestPrivacyService_Export(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	privacy := NewPrivacyService(store, NewOwnershipService(store))
+	seedPrivacyData(t, store)
+
+	export, err := privacy.Export(ctx, "alice", "root")
+	require.NoError(t, err)
+	require.NotNil(t, export.Account)
+	assert.Equal(t, "alice@example.com", export.Account.Email)
+	assert.Len(t, export.Workspaces, 1)
+	assert.Len(t, export.Projects, 1)
+	require.Len(t, export.Sessions, 1)
+	assert.Equal(t, 2, export.Sessions[0].MessageCount)
+	require.Len(t, export.Audit.ShareJoins, 1)
+	assert.Equal(t, "alice", export.Audit.ShareJoins[0].UserID)
+	assert.Len(t, export.Audit.TerminalSessions, 1)
+
+	// 내보내기도 처리 기록으로 남음
+	requests, err := privacy.ListRequests(ctx, &models.PrivacyRequestFilter{SubjectID: "alice"})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, models.PrivacyRequestExport, requests[0].Type)
+	assert.Equal(t, "root", requests[0].ActorID)
+	assert.Equal(t, 1, requests[0].Summary["sessions"])
+}
+
+func TestPrivacyService_Erase(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	privacy := NewPrivacyService(store, NewOwnershipService(store))
+	revoker := &fakeTokenRevoker{}
+	privacy.SetTokenRevoker(revoker, time.Hour)
+	session := seedPrivacyData(t, store)
+
+	// 확인 값이 다르면 거부
+	_, err := privacy.Erase(ctx, "alice", "root", &models.ErasureRequest{Confirm: "bob", Mode: models.OffboardArchive})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	// 미리보기는 아무것도 바꾸지 않음
+	preview, err := privacy.Erase(ctx, "alice", "root", &models.ErasureRequest{Confirm: "alice", Mode: models.OffboardArchive, DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, preview.AccountAnonymized)
+	assert.Equal(t, 2, preview.MessagesDeleted)
+	assert.Equal(t, 1, preview.ShareJoinsAnonymized)
+	assert.Equal(t, 1, preview.TerminalSessionsAnonymized)
+	assert.Equal(t, 1, preview.TasksErased)
+	assert.Equal(t, 1, preview.PromptsDeleted)
+	assert.False(t, preview.TokensRevoked)
+	assert.Empty(t, revoker.revoked)
+	assert.Empty(t, preview.RequestID)
+	account, err := store.Account().GetByID(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", account.Email)
+
+	result, err := privacy.Erase(ctx, "alice", "root", &models.ErasureRequest{Confirm: "alice", Mode: models.OffboardArchive})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.RequestID)
+	assert.Equal(t, 2, result.MessagesDeleted)
+
+	// 계정은 ID를 유지한 채 익명화
+	account, err = store.Account().GetByID(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "erased-alice", account.Username)
+	assert.Empty(t, account.Email)
+	assert.Empty(t, account.DisplayName)
+	assert.Empty(t, account.PasswordHash)
+	assert.False(t, account.IsActive)
+
+	_, total, err := store.Message().ListBySession(ctx, session.ID, nil)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	// 태스크 기록은 남기고 명령과 출력만 지움
+	tasks, _, err := store.Task().GetBySessionID(ctx, session.ID, &models.PaginationRequest{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Empty(t, tasks[0].Command)
+	assert.Empty(t, tasks[0].Output)
+	assert.Equal(t, 1, result.TasksErased)
+
+	prompts, err := store.Prompt().List(ctx, &models.PromptFilter{ViewerID: "alice", Scope: "mine"})
+	require.NoError(t, err)
+	assert.Empty(t, prompts)
+
+	// 이미 발급한 토큰 차단
+	assert.True(t, result.TokensRevoked)
+	assert.Equal(t, []string{"alice"}, revoker.revoked)
+
+	// 감사 기록은 남기고 접속 정보만 지움 (다른 사용자의 기록은 그대로)
+	joins, _, err := store.ShareLink().ListJoins(ctx, session.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, joins, 2)
+	for _, join := range joins {
+		if join.UserID == "alice" {
+			assert.Empty(t, join.UserName)
+			assert.Empty(t, join.ClientIP)
+			assert.Empty(t, join.UserAgent)
+		} else {
+			assert.Equal(t, "10.0.0.2", join.ClientIP)
+		}
+	}
+	terminals, err := store.Terminal().ListByUser(ctx, "alice", 0)
+	require.NoError(t, err)
+	require.Len(t, terminals, 1)
+	assert.Empty(t, terminals[0].ClientIP)
+	_, err = store.Terminal().GetRecording(ctx, terminals[0].ID)
+	assert.Error(t, err)
+
+	requests, err := privacy.ListRequests(ctx, &models.PrivacyRequestFilter{Type: models.PrivacyRequestErasure})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, "alice", requests[0].SubjectID)
+	assert.Equal(t, 2, requests[0].Summary["messages"])
+}
+
+func TestPrivacyService_EraseReassignKeepsMessages(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	privacy := NewPrivacyService(store, NewOwnershipService(store))
+	session := seedPrivacyData(t, store)
+
+	result, err := privacy.Erase(ctx, "alice", "root", &models.ErasureRequest{Confirm: "alice", Mode: models.OffboardReassign, ToUserID: "bob"})
+	require.NoError(t, err)
+	assert.Zero(t, result.MessagesDeleted)
+
+	// 넘겨받은 사용자의 워크스페이스 대화 기록은 유지
+	_, total, err := store.Message().ListBySession(ctx, session.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+}
@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+var (
+	// promptPlaceholderPattern 본문의 {{변수}} 자리 (중괄호 안 공백 허용)
+	promptPlaceholderPattern = regexp.MustCompile(`\{\{\s*([^{}\s]*)\s*\}\}`)
+
+	// promptVariableNamePattern 변수 이름 규칙
+	promptVariableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// PromptTaskCreator 프롬프트로 태스크를 생성하는 인터페이스 (*TaskService)
+type PromptTaskCreator interface {
+	Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error)
+}
+
+// PromptService 프롬프트 라이브러리 관리와 프롬프트 실행
+// 프롬프트는 작성자만 수정하고 삭제할 수 있으며, org로 공유하면 모든 사용자가
+// 조회하고 실행할 수 있습니다. 변수 치환과 검증은 항상 서버에서 수행합니다.
+type PromptService struct {
+	storage storage.Storage
+	access  *WorkspaceAccessService
+	tasks   PromptTaskCreator
+}
+
+// NewPromptService 새 프롬프트 서비스 생성
+func NewPromptService(storage storage.Storage, tasks PromptTaskCreator) *PromptService {
+	return &PromptService{
+		storage: storage,
+		access:  NewWorkspaceAccessService(storage),
+		tasks:   tasks,
+	}
+}
+
+// Create 프롬프트 생성
+func (s *PromptService) Create(ctx context.Context, ownerID string, req *models.PromptCreateRequest) (*models.Prompt, error) {
+	prompt := &models.Prompt{
+		OwnerID:     ownerID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Content:     req.Content,
+		Variables:   req.Variables,
+		Tags:        req.Tags,
+		Visibility:  req.Visibility,
+	}
+	if prompt.Visibility == "" {
+		prompt.Visibility = models.PromptVisibilityPrivate
+	}
+	if err := validatePrompt(prompt); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Prompt().Create(ctx, prompt); err != nil {
+		if storage.IsAlreadyExistsError(err) {
+			return nil, NewWorkspaceError(ErrCodeAlreadyExists, "같은 이름의 프롬프트가 이미 존재합니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "프롬프트 저장 실패", err)
+	}
+	return prompt, nil
+}
+
+// Get 프롬프트 조회 (본인 프롬프트 또는 조직 공유 프롬프트, admin은 전체)
+func (s *PromptService) Get(ctx context.Context, id, userID string, admin bool) (*models.Prompt, error) {
+	prompt, err := s.storage.Prompt().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "프롬프트를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "프롬프트 조회 실패", err)
+	}
+	// 공유되지 않은 다른 사용자의 프롬프트는 존재 여부도 드러내지 않음
+	if !admin && prompt.OwnerID != userID && prompt.Visibility != models.PromptVisibilityOrg {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "프롬프트를 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	return prompt, nil
+}
+
+// List 사용자가 볼 수 있는 프롬프트 조회
+func (s *PromptService) List(ctx context.Context, userID string, filter *models.PromptFilter) ([]*models.Prompt, error) {
+	filter.ViewerID = userID
+	prompts, err := s.storage.Prompt().List(ctx, filter)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "프롬프트 목록 조회 실패", err)
+	}
+	return prompts, nil
+}
+
+// Update 프롬프트 수정 (작성자 또는 admin)
+func (s *PromptService) Update(ctx context.Context, id, userID string, admin bool, req *models.PromptUpdateRequest) (*models.Prompt, error) {
+	prompt, err := s.owned(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		prompt.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		prompt.Description = *req.Description
+	}
+	if req.Content != nil {
+		prompt.Content = *req.Content
+	}
+	if req.Variables != nil {
+		prompt.Variables = *req.Variables
+	}
+	if req.Tags != nil {
+		prompt.Tags = *req.Tags
+	}
+	if req.Visibility != nil {
+		prompt.Visibility = *req.Visibility
+	}
+	if err := validatePrompt(prompt); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Prompt().Update(ctx, prompt); err != nil {
+		if storage.IsAlreadyExistsError(err) {
+			return nil, NewWorkspaceError(ErrCodeAlreadyExists, "같은 이름의 프롬프트가 이미 존재합니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "프롬프트 저장 실패", err)
+	}
+	return prompt, nil
+}
+
+// Delete 프롬프트 삭제 (작성자 또는 admin)
+func (s *PromptService) Delete(ctx context.Context, id, userID string, admin bool) error {
+	if _, err := s.owned(ctx, id, userID, admin); err != nil {
+		return err
+	}
+	if err := s.storage.Prompt().Delete(ctx, id); err != nil {
+		if storage.IsNotFoundError(err) {
+			return NewWorkspaceError(ErrCodeNotFound, "프롬프트를 찾을 수 없습니다", err)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "프롬프트 삭제 실패", err)
+	}
+	return nil
+}
+
+// Render 변수를 치환한 프롬프트 본문 (실행 전 미리보기)
+func (s *PromptService) Render(ctx context.Context, id, userID string, admin bool, values map[string]string) (string, error) {
+	prompt, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return "", err
+	}
+	return RenderPrompt(prompt, values)
+}
+
+// Launch 변수를 치환한 프롬프트로 세션에서 태스크 실행
+// 세션이 속한 워크스페이스에 execute 권한이 있어야 합니다 (admin 제외).
+func (s *PromptService) Launch(ctx context.Context, id, userID string, admin bool, req *models.PromptLaunchRequest) (*models.Task, error) {
+	prompt, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		if _, err := s.access.AuthorizeSession(ctx, req.SessionID, userID, models.WorkspacePermissionExecute); err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := RenderPrompt(prompt, req.Variables)
+	if err != nil {
+		return nil, err
+	}
+	return s.tasks.Create(ctx, &models.TaskCreateRequest{
+		SessionID: req.SessionID,
+		Command:   content,
+		Metadata: map[string]string{
+			"prompt_id":   prompt.ID,
+			"prompt_name": prompt.Name,
+		},
+		TimeoutTier: req.TimeoutTier,
+		UserID:      userID,
+	})
+}
+
+// owned 수정/삭제할 프롬프트 조회 (작성자가 아니면 에러)
+func (s *PromptService) owned(ctx context.Context, id, userID string, admin bool) (*models.Prompt, error) {
+	prompt, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if !admin && prompt.OwnerID != userID {
+		return nil, NewWorkspaceError(ErrCodeOwnershipRequired, "프롬프트 작성자만 수정하거나 삭제할 수 있습니다", ErrOwnershipRequired)
+	}
+	return prompt, nil
+}
+
+// RenderPrompt 프롬프트 본문의 {{변수}}를 값으로 치환
+// 선언되지 않은 변수, 빠진 필수 변수, 허용 값과 최대 길이를 벗어난 값은 모두 모아 하나의 에러로 반환합니다.
+// 치환은 한 번만 수행하므로 값 안의 {{...}}는 다시 치환되지 않습니다.
+func RenderPrompt(prompt *models.Prompt, values map[string]string) (string, error) {
+	declared := make(map[string]models.PromptVariable, len(prompt.Variables))
+	for _, variable := range prompt.Variables {
+		declared[variable.Name] = variable
+	}
+
+	var problems []string
+	for name := range values {
+		if _, ok := declared[name]; !ok {
+			problems = append(problems, fmt.Sprintf("알 수 없는 변수: %s", name))
+		}
+	}
+
+	resolved := make(map[string]string, len(prompt.Variables))
+	for _, variable := range prompt.Variables {
+		value, provided := values[variable.Name]
+		if !provided || value == "" {
+			value = variable.Default
+		}
+		if value == "" {
+			if variable.Required {
+				problems = append(problems, fmt.Sprintf("필수 변수가 없습니다: %s", variable.Name))
+			}
+			resolved[variable.Name] = ""
+			continue
+		}
+		if problem := checkPromptValue(variable, value); problem != "" {
+			problems = append(problems, problem)
+		}
+		resolved[variable.Name] = value
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, strings.Join(problems, "; "), ErrInvalidRequest)
+	}
+
+	content := promptPlaceholderPattern.ReplaceAllStringFunc(prompt.Content, func(match string) string {
+		name := promptPlaceholderPattern.FindStringSubmatch(match)[1]
+		return resolved[name]
+	})
+	if strings.TrimSpace(content) == "" {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "변수를 치환한 프롬프트가 비어 있습니다", ErrInvalidRequest)
+	}
+	return content, nil
+}
+
+// validatePrompt 변수 선언과 본문 검증
+func validatePrompt(prompt *models.Prompt) error {
+	if prompt.Name == "" {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "프롬프트 이름이 필요합니다", ErrInvalidRequest)
+	}
+	if strings.TrimSpace(prompt.Content) == "" {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "프롬프트 본문이 필요합니다", ErrInvalidRequest)
+	}
+
+	var problems []string
+	declared := make(map[string]bool, len(prompt.Variables))
+	for _, variable := range prompt.Variables {
+		if !promptVariableNamePattern.MatchString(variable.Name) {
+			problems = append(problems, fmt.Sprintf("변수 이름이 올바르지 않습니다: %q", variable.Name))
+			continue
+		}
+		if declared[variable.Name] {
+			problems = append(problems, fmt.Sprintf("변수가 중복 선언되었습니다: %s", variable.Name))
+			continue
+		}
+		declared[variable.Name] = true
+		if variable.Default != "" {
+			if problem := checkPromptValue(variable, variable.Default); problem != "" {
+				problems = append(problems, "기본값 - "+problem)
+			}
+		}
+	}
+
+	for _, match := range promptPlaceholderPattern.FindAllStringSubmatch(prompt.Content, -1) {
+		if name := match[1]; !declared[name] {
+			problems = append(problems, fmt.Sprintf("본문에 선언되지 않은 변수가 있습니다: {{%s}}", name))
+			declared[name] = true
+		}
+	}
+
+	if len(problems) > 0 {
+		return NewWorkspaceError(ErrCodeInvalidRequest, strings.Join(problems, "; "), ErrInvalidRequest)
+	}
+	return nil
+}
+
+// checkPromptValue 허용 값과 최대 길이 확인 (문제가 없으면 빈 문자열)
+func checkPromptValue(variable models.PromptVariable, value string) string {
+	if variable.MaxLength > 0 && len([]rune(value)) > variable.MaxLength {
+		return fmt.Sprintf("%s 값은 %d자를 넘을 수 없습니다", variable.Name, variable.MaxLength)
+	}
+	if len(variable.Options) > 0 {
+		for _, option := range variable.Options {
+			if option == value {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%s 값은 %s 중 하나여야 합니다", variable.Name, strings.Join(variable.Options, ", "))
+	}
+	return ""
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// recordingTaskCreator 생성 요청만 기록하는 테스트용 태스크 생성기
+type recordingTaskCreator struct {
+	requests []*models.TaskCreateRequest
+}
+
+func (r *recordingTaskCreator) Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error) {
+	r.requests = append(r.requests, req)
+	return &models.Task{SessionID: req.SessionID, Command: req.Command}, nil
+}
+
+func TestRenderPrompt(t *testing.T) {
+	prompt := &models.Prompt{
+		Content: "{{ lang }}로 {{file}} 테스트를 작성하세요. {{note}}",
+		Variables: []models.PromptVariable{
+			{Name: "file", Required: true, MaxLength: 20},
+			{Name: "lang", Default: "go", Options: []string{"go", "python"}},
+			{Name: "note"},
+		},
+	}
+
+	content, err := RenderPrompt(prompt, map[string]string{"file": "main.go"})
+	require.NoError(t, err)
+	assert.Equal(t, "go로 main.go 테스트를 작성하세요. ", content)
+
+	// 값 안의 {{...}}는 다시 치환하지 않음
+	content, err = RenderPrompt(prompt, map[string]string{"file": "{{lang}}", "lang": "python"})
+	require.NoError(t, err)
+	assert.Equal(t, "python로 {{lang}} 테스트를 작성하세요. ", content)
+
+	tests := []struct {
+		name   string
+		values map[string]string
+	}{
+		{"필수 변수 없음", map[string]string{}},
+		{"허용되지 않은 값", map[string]string{"file": "a.go", "lang": "rust"}},
+		{"최대 길이 초과", map[string]string{"file": "internal/services/prompt_test.go"}},
+		{"알 수 없는 변수", map[string]string{"file": "a.go", "extra": "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RenderPrompt(prompt, tt.values)
+			assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+		})
+	}
+}
+
+func TestPromptService_CreateAndShare(t *testing.T) {
+	ctx := context.Background()
+	service := NewPromptService(memory.New(), &recordingTaskCreator{})
+
+	// 본문에 선언되지 않은 변수
+	_, err := service.Create(ctx, "alice", &models.PromptCreateRequest{Name: "review", Content: "{{target}} 리뷰"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	private, err := service.Create(ctx, "alice", &models.PromptCreateRequest{
+		Name:      "review",
+		Content:   "{{target}} 리뷰",
+		Variables: []models.PromptVariable{{Name: "target", Required: true}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.PromptVisibilityPrivate, private.Visibility)
+
+	_, err = service.Create(ctx, "alice", &models.PromptCreateRequest{Name: "review", Content: "중복"})
+	assertWorkspaceErrorCode(t, err, ErrCodeAlreadyExists)
+
+	shared, err := service.Create(ctx, "alice", &models.PromptCreateRequest{Name: "lint", Content: "린트 수정", Tags: []string{"go"}, Visibility: models.PromptVisibilityOrg})
+	require.NoError(t, err)
+
+	// 다른 사용자는 공유 프롬프트만 보고, 수정할 수 없음
+	_, err = service.Get(ctx, private.ID, "bob", false)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+	prompts, err := service.List(ctx, "bob", &models.PromptFilter{})
+	require.NoError(t, err)
+	require.Len(t, prompts, 1)
+	assert.Equal(t, shared.ID, prompts[0].ID)
+
+	name := "lint-fix"
+	_, err = service.Update(ctx, shared.ID, "bob", false, &models.PromptUpdateRequest{Name: &name})
+	assertWorkspaceErrorCode(t, err, ErrCodeOwnershipRequired)
+	updated, err := service.Update(ctx, shared.ID, "alice", false, &models.PromptUpdateRequest{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, "lint-fix", updated.Name)
+
+	prompts, err = service.List(ctx, "alice", &models.PromptFilter{Scope: "mine", Tag: "go"})
+	require.NoError(t, err)
+	require.Len(t, prompts, 1)
+
+	assertWorkspaceErrorCode(t, service.Delete(ctx, shared.ID, "bob", false), ErrCodeOwnershipRequired)
+	require.NoError(t, service.Delete(ctx, shared.ID, "alice", false))
+}
+
+func TestPromptService_Launch(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	tasks := &recordingTaskCreator{}
+	service := NewPromptService(store, tasks)
+
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+	project := &models.Project{WorkspaceID: ws.ID, Name: "api", Path: "/tmp/api"}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, session))
+
+	prompt, err := service.Create(ctx, "alice", &models.PromptCreateRequest{
+		Name:       "fix",
+		Content:    "{{issue}} 이슈를 수정하세요",
+		Variables:  []models.PromptVariable{{Name: "issue", Required: true}},
+		Visibility: models.PromptVisibilityOrg,
+	})
+	require.NoError(t, err)
+
+	// 세션 워크스페이스에 권한이 없으면 실행 불가
+	_, err = service.Launch(ctx, prompt.ID, "bob", false, &models.PromptLaunchRequest{SessionID: session.ID, Variables: map[string]string{"issue": "#1"}})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	_, err = service.Launch(ctx, prompt.ID, "alice", false, &models.PromptLaunchRequest{SessionID: session.ID})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	assert.Empty(t, tasks.requests)
+
+	task, err := service.Launch(ctx, prompt.ID, "alice", false, &models.PromptLaunchRequest{SessionID: session.ID, Variables: map[string]string{"issue": "#1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "#1 이슈를 수정하세요", task.Command)
+	require.Len(t, tasks.requests, 1)
+	assert.Equal(t, prompt.ID, tasks.requests[0].Metadata["prompt_id"])
+}
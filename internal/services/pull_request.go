@@ -0,0 +1,471 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/secrets"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// defaultPullRequestBaseBranch 대상 브랜치를 지정하지 않았을 때 사용하는 브랜치
+	defaultPullRequestBaseBranch = "main"
+	// defaultPullRequestListLimit 워크스페이스 PR 목록 조회 개수
+	defaultPullRequestListLimit = 50
+	// pullRequestSessionTaskLimit PR 본문에 나열할 세션 태스크 수
+	pullRequestSessionTaskLimit = 20
+	// pullRequestOutputMaxBytes PR 본문에 넣을 태스크 출력 최대 크기
+	pullRequestOutputMaxBytes = 3000
+	// pullRequestFileListLimit PR 본문에 나열할 변경 파일 수
+	pullRequestFileListLimit = 50
+)
+
+// PullRequestGit PR 생성에 필요한 Git 작업 (*GitService)
+type PullRequestGit interface {
+	HasChanges(path string) (bool, error)
+	BranchCommit(path, branch string) (string, error)
+	CreateBranch(path, branch, commit string) error
+	CommitToBranch(path, branch, message, authorName string) (string, error)
+	CommitFiles(path, commit string) ([]string, error)
+	Push(path, remoteURL, branch string) error
+}
+
+// PullRequestHost PR/MR을 만드는 Git 호스팅 API (*GitHostClient)
+type PullRequestHost interface {
+	OpenPullRequest(ctx context.Context, config *models.PullRequestConfig, pr *GitHostPullRequest) (*GitHostPullRequestResult, error)
+	SetCommitStatus(ctx context.Context, config *models.PullRequestConfig, commit, targetURL, description string) error
+}
+
+// PullRequestService 완료된 태스크의 변경 사항으로 브랜치를 푸시하고 PR/MR 생성
+// 워크스페이스마다 제공자, 리포지토리, 토큰을 설정하며, 권한 확인은 라우터의 워크스페이스/태스크 권한 미들웨어가 맡습니다.
+// PR 본문에는 세션 요약과 세션 기록 링크를 넣고, 커밋 상태로도 같은 링크를 남깁니다.
+type PullRequestService struct {
+	storage    storage.Storage
+	git        PullRequestGit
+	host       PullRequestHost
+	webBaseURL string
+	tokens     configTokens
+
+	// mu Git 작업 직렬화 (같은 작업 트리에서 브랜치 전환이 겹치지 않도록)
+	mu sync.Mutex
+}
+
+// NewPullRequestService 새 PR 서비스 생성
+// webBaseURL은 세션 기록 링크에 쓸 웹 UI 주소이며, 비어 있으면 링크와 커밋 상태를 남기지 않습니다.
+func NewPullRequestService(storage storage.Storage, git PullRequestGit, host PullRequestHost, webBaseURL string) *PullRequestService {
+	return &PullRequestService{
+		storage:    storage,
+		git:        git,
+		host:       host,
+		webBaseURL: strings.TrimRight(webBaseURL, "/"),
+	}
+}
+
+// SetSecretsBox 액세스 토큰을 암호화할 Box 설정 (없으면 토큰을 평문으로 저장)
+func (s *PullRequestService) SetSecretsBox(box *secrets.Box) {
+	s.tokens = configTokens{box: box}
+}
+
+// SealTokens 평문으로 저장된 PR 토큰을 암호화해 다시 저장하고 암호화한 설정 수를 반환합니다
+// 토큰 암호화를 켜기 전에 저장된 설정을 옮길 때 서버 시작 시 한 번 호출합니다.
+func (s *PullRequestService) SealTokens(ctx context.Context) (int, error) {
+	workspaceIDs, err := s.storage.PullRequest().ListConfigWorkspaces(ctx)
+	if err != nil {
+		return 0, NewWorkspaceError(ErrCodeInternal, "PR 설정 목록 조회 실패", err)
+	}
+	sealed := 0
+	for _, workspaceID := range workspaceIDs {
+		config, err := s.storage.PullRequest().GetConfig(ctx, workspaceID)
+		if err != nil {
+			if storage.IsNotFoundError(err) {
+				continue
+			}
+			return sealed, NewWorkspaceError(ErrCodeInternal, "PR 설정 조회 실패", err)
+		}
+		if !s.tokens.needsSeal(config.Token) {
+			continue
+		}
+		if config.Token, err = s.tokens.seal(config.Token); err != nil {
+			return sealed, NewWorkspaceError(ErrCodeInternal, "PR 토큰 암호화 실패", err)
+		}
+		if err := s.storage.PullRequest().SaveConfig(ctx, config); err != nil {
+			return sealed, NewWorkspaceError(ErrCodeInternal, "PR 설정 저장 실패", err)
+		}
+		sealed++
+	}
+	return sealed, nil
+}
+
+// GetConfig 워크스페이스의 PR 설정 조회 (토큰은 설정 여부만 반환)
+func (s *PullRequestService) GetConfig(ctx context.Context, workspaceID string) (*models.PullRequestConfig, error) {
+	config, err := s.storage.PullRequest().GetConfig(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스에 PR 설정이 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 설정 조회 실패", err)
+	}
+	config.HasToken = config.Token != ""
+	return config, nil
+}
+
+// SaveConfig 워크스페이스의 PR 설정 저장
+// 토큰을 비워 두면 기존 토큰을 유지하므로 토큰을 다시 보내지 않고도 다른 항목을 바꿀 수 있습니다.
+func (s *PullRequestService) SaveConfig(ctx context.Context, workspaceID, userID string, req *models.PullRequestConfigRequest) (*models.PullRequestConfig, error) {
+	config := &models.PullRequestConfig{
+		WorkspaceID:  workspaceID,
+		Provider:     req.Provider,
+		Repository:   normalizeWebhookRepository(req.Repository),
+		BaseBranch:   strings.TrimSpace(req.BaseBranch),
+		BranchPrefix: strings.TrimSpace(req.BranchPrefix),
+		APIURL:       strings.TrimRight(strings.TrimSpace(req.APIURL), "/"),
+		Token:        req.Token,
+		Draft:        req.Draft,
+		Labels:       req.Labels,
+		UpdatedBy:    userID,
+	}
+	if !strings.Contains(config.Repository, "/") {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "리포지토리는 owner/name 형식이어야 합니다", ErrInvalidRequest)
+	}
+	if config.BaseBranch == "" {
+		config.BaseBranch = defaultPullRequestBaseBranch
+	}
+	if config.BranchPrefix == "" {
+		config.BranchPrefix = models.DefaultPullRequestBranchPrefix
+	}
+
+	existing, err := s.loadConfig(ctx, workspaceID)
+	if err != nil && !storage.IsNotFoundError(err) {
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 설정 조회 실패", err)
+	}
+	if existing != nil {
+		config.CreatedAt = existing.CreatedAt
+		if config.Token == "" {
+			config.Token = existing.Token
+		}
+	}
+	if config.Token == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "액세스 토큰이 필요합니다", ErrInvalidRequest)
+	}
+	if _, err := gitHostPushURL(config); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, err.Error(), ErrInvalidRequest)
+	}
+
+	token := config.Token
+	if config.Token, err = s.tokens.seal(token); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 토큰 암호화 실패", err)
+	}
+	if err := s.storage.PullRequest().SaveConfig(ctx, config); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 설정 저장 실패", err)
+	}
+	config.Token = token
+	config.HasToken = true
+	return config, nil
+}
+
+// DeleteConfig 워크스페이스의 PR 설정 삭제 (이미 만든 PR 기록은 유지)
+func (s *PullRequestService) DeleteConfig(ctx context.Context, workspaceID string) error {
+	if err := s.storage.PullRequest().DeleteConfig(ctx, workspaceID); err != nil {
+		if storage.IsNotFoundError(err) {
+			return NewWorkspaceError(ErrCodeNotFound, "워크스페이스에 PR 설정이 없습니다", err)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "PR 설정 삭제 실패", err)
+	}
+	return nil
+}
+
+// loadConfig PR 설정을 토큰을 복호화해 조회
+func (s *PullRequestService) loadConfig(ctx context.Context, workspaceID string) (*models.PullRequestConfig, error) {
+	config, err := s.storage.PullRequest().GetConfig(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if config.Token, err = s.tokens.open(config.Token); err != nil {
+		return nil, fmt.Errorf("PR 토큰 복호화 실패: %w", err)
+	}
+	return config, nil
+}
+
+// GetByTask 태스크로 만든 PR 조회
+func (s *PullRequestService) GetByTask(ctx context.Context, taskID string) (*models.PullRequest, error) {
+	pr, err := s.storage.PullRequest().GetByTaskID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "태스크로 만든 PR이 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 조회 실패", err)
+	}
+	return pr, nil
+}
+
+// List 워크스페이스의 PR 조회 (최신순)
+func (s *PullRequestService) List(ctx context.Context, workspaceID string) ([]*models.PullRequest, error) {
+	prs, err := s.storage.PullRequest().ListByWorkspace(ctx, workspaceID, defaultPullRequestListLimit)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 목록 조회 실패", err)
+	}
+	return prs, nil
+}
+
+// Create 완료된 태스크의 변경 사항으로 브랜치를 푸시하고 PR/MR 생성
+// 작업 트리의 변경은 태스크 브랜치에 커밋하고 원래 브랜치로 돌아오며, 검토(require_review)를 거친 태스크는
+// 승인 커밋으로 브랜치를 만듭니다. 푸시나 API 호출에 실패하면 failed 기록을 남기고, 다시 요청하면 이미 만든
+// 브랜치로 이어서 시도합니다.
+func (s *PullRequestService) Create(ctx context.Context, taskID, userID string, req *models.PullRequestCreateRequest) (*models.PullRequest, error) {
+	task, err := s.storage.Task().GetByID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+	}
+	if task.Status != models.TaskCompleted {
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, fmt.Sprintf("완료된 태스크만 PR을 만들 수 있습니다 (상태: %s)", task.Status), ErrInvalidRequest)
+	}
+	session, err := s.storage.Session().GetByID(ctx, task.SessionID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+	}
+	project, err := s.storage.Project().GetByID(ctx, session.ProjectID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+	}
+	config, err := s.loadConfig(ctx, project.WorkspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스에 PR 설정이 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 설정 조회 실패", err)
+	}
+
+	approvedCommit, err := s.reviewedCommit(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pr, err := s.storage.PullRequest().GetByTaskID(ctx, task.ID)
+	switch {
+	case err == nil && pr.Status == models.PullRequestOpened:
+		return nil, NewWorkspaceError(ErrCodeResourceBusy, fmt.Sprintf("이미 PR이 있습니다: %s", pr.URL), nil)
+	case err != nil && !storage.IsNotFoundError(err):
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 조회 실패", err)
+	case err != nil:
+		pr = &models.PullRequest{TaskID: task.ID, SessionID: session.ID, WorkspaceID: project.WorkspaceID}
+	}
+	pr.Provider = config.Provider
+	pr.Repository = config.Repository
+	pr.Branch = config.BranchPrefix + "task-" + shortTaskID(task.ID)
+	pr.BaseBranch = config.BaseBranch
+	if req.BaseBranch != "" {
+		pr.BaseBranch = req.BaseBranch
+	}
+	pr.Title = strings.TrimSpace(req.Title)
+	if pr.Title == "" {
+		pr.Title = taskSummary(task.Command)
+	}
+	pr.TranscriptURL = sessionTranscriptURL(s.webBaseURL, session.ID)
+	pr.CreatedBy = userID
+
+	commit, err := s.prepareBranch(project.Path, pr.Branch, approvedCommit, pr.Title, task.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	pr.CommitSHA = commit
+
+	opened, openErr := s.pushAndOpen(ctx, config, project.Path, task, pr, req)
+	if opened != nil {
+		pr.Status = models.PullRequestOpened
+		pr.Number = opened.Number
+		pr.URL = opened.URL
+		pr.Error = ""
+	} else {
+		pr.Status = models.PullRequestFailed
+		pr.Error = openErr.Error()
+	}
+
+	if pr.ID == "" {
+		err = s.storage.PullRequest().Create(ctx, pr)
+	} else {
+		err = s.storage.PullRequest().Update(ctx, pr)
+	}
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "PR 기록 저장 실패", err)
+	}
+	if opened == nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, openErr.Error(), openErr)
+	}
+	if openErr != nil {
+		log.Printf("PR 후처리 실패: %s: %v", pr.URL, openErr)
+	}
+	return pr, nil
+}
+
+// reviewedCommit 검토를 거친 태스크의 승인 커밋 (검토가 없으면 빈 문자열)
+func (s *PullRequestService) reviewedCommit(ctx context.Context, taskID string) (string, error) {
+	review, err := s.storage.TaskReview().GetByTaskID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", NewWorkspaceError(ErrCodeInternal, "검토 조회 실패", err)
+	}
+	switch review.Status {
+	case models.TaskReviewApproved:
+		return review.AppliedCommit, nil
+	case models.TaskReviewRejected:
+		return "", NewWorkspaceError(ErrCodeInvalidStatus, "검토에서 거부된 태스크입니다", ErrInvalidRequest)
+	default:
+		return "", NewWorkspaceError(ErrCodeInvalidStatus, "태스크 결과 검토가 끝나지 않았습니다", ErrInvalidRequest)
+	}
+}
+
+// prepareBranch 태스크 브랜치를 만들고 브랜치 커밋 반환 (이미 있으면 그대로 사용)
+func (s *PullRequestService) prepareBranch(path, branch, approvedCommit, title, taskID, userID string) (string, error) {
+	commit, err := s.git.BranchCommit(path, branch)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "PR은 Git 리포지토리 프로젝트에서만 만들 수 있습니다", err)
+	}
+	if commit != "" {
+		return commit, nil
+	}
+
+	if approvedCommit != "" {
+		if err := s.git.CreateBranch(path, branch, approvedCommit); err != nil {
+			return "", NewWorkspaceError(ErrCodeInternal, "브랜치 생성 실패", err)
+		}
+		return approvedCommit, nil
+	}
+
+	dirty, err := s.git.HasChanges(path)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "작업 트리 상태 확인 실패", err)
+	}
+	if !dirty {
+		return "", NewWorkspaceError(ErrCodeInvalidStatus, "PR로 만들 변경 사항이 없습니다", ErrInvalidRequest)
+	}
+	commit, err = s.git.CommitToBranch(path, branch, fmt.Sprintf("%s\n\nTask %s", title, taskID), userID)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "변경 사항 커밋 실패", err)
+	}
+	return commit, nil
+}
+
+// pushAndOpen 브랜치 푸시 후 PR/MR 생성과 커밋 상태 설정
+// PR을 만들었으면 후처리(라벨, 커밋 상태) 실패는 결과와 함께 에러로 반환합니다.
+func (s *PullRequestService) pushAndOpen(ctx context.Context, config *models.PullRequestConfig, path string, task *models.Task, pr *models.PullRequest, req *models.PullRequestCreateRequest) (*GitHostPullRequestResult, error) {
+	pushURL, err := gitHostPushURL(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.git.Push(path, pushURL, pr.Branch); err != nil {
+		return nil, fmt.Errorf("브랜치 푸시 실패: %w", err)
+	}
+
+	files, err := s.git.CommitFiles(path, pr.CommitSHA)
+	if err != nil {
+		log.Printf("PR 변경 파일 조회 실패: %s: %v", pr.CommitSHA, err)
+	}
+	draft := config.Draft
+	if req.Draft != nil {
+		draft = *req.Draft
+	}
+	opened, err := s.host.OpenPullRequest(ctx, config, &GitHostPullRequest{
+		Title:  pr.Title,
+		Body:   s.buildBody(ctx, task, pr, files),
+		Head:   pr.Branch,
+		Base:   pr.BaseBranch,
+		Draft:  draft,
+		Labels: config.Labels,
+	})
+	if opened == nil {
+		return nil, fmt.Errorf("PR 생성 실패: %w", err)
+	}
+
+	if pr.TranscriptURL != "" {
+		if statusErr := s.host.SetCommitStatus(ctx, config, pr.CommitSHA, pr.TranscriptURL, "AICLI 세션 기록"); statusErr != nil && err == nil {
+			err = fmt.Errorf("커밋 상태 설정 실패: %w", statusErr)
+		}
+	}
+	return opened, err
+}
+
+// buildBody 세션 요약이 담긴 PR 본문
+func (s *PullRequestService) buildBody(ctx context.Context, task *models.Task, pr *models.PullRequest, files []string) string {
+	var b strings.Builder
+	b.WriteString("## 요청\n\n")
+	fmt.Fprintf(&b, "```\n%s\n```\n\n", truncateOutput(strings.TrimSpace(task.Command), pullRequestOutputMaxBytes))
+
+	if output := strings.TrimSpace(task.Output); output != "" {
+		b.WriteString("## 결과\n\n")
+		if len(output) > pullRequestOutputMaxBytes {
+			output = truncateOutput(output, pullRequestOutputMaxBytes) + "\n..."
+		}
+		fmt.Fprintf(&b, "```\n%s\n```\n\n", output)
+	}
+
+	if len(files) > 0 {
+		fmt.Fprintf(&b, "## 변경 파일 (%d)\n\n", len(files))
+		for i, file := range files {
+			if i == pullRequestFileListLimit {
+				fmt.Fprintf(&b, "- ... 외 %d개\n", len(files)-i)
+				break
+			}
+			fmt.Fprintf(&b, "- `%s`\n", file)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## 세션\n\n")
+	if pr.TranscriptURL != "" {
+		fmt.Fprintf(&b, "- 세션 기록: [%s](%s)\n", pr.SessionID, pr.TranscriptURL)
+	} else {
+		fmt.Fprintf(&b, "- 세션: `%s`\n", pr.SessionID)
+	}
+	fmt.Fprintf(&b, "- 태스크: `%s`\n", task.ID)
+
+	sessionID := pr.SessionID
+	tasks, _, err := s.storage.Task().List(ctx, &models.TaskFilter{SessionID: &sessionID},
+		&models.PaginationRequest{Page: 1, Limit: pullRequestSessionTaskLimit, Sort: "created_at", Order: "asc"})
+	if err != nil {
+		log.Printf("PR 세션 태스크 조회 실패: %s: %v", sessionID, err)
+	} else if len(tasks) > 1 {
+		b.WriteString("\n세션에서 실행한 태스크:\n\n")
+		for _, t := range tasks {
+			fmt.Fprintf(&b, "1. [%s] %s\n", t.Status, taskSummary(t.Command))
+		}
+	}
+
+	b.WriteString("\n---\n_AICLI 태스크 결과로 생성된 PR입니다._\n")
+	return b.String()
+}
+
+// sessionTranscriptURL 웹 UI의 세션 기록 주소 (webBaseURL이 비어 있으면 빈 문자열)
+func sessionTranscriptURL(webBaseURL, sessionID string) string {
+	if webBaseURL == "" {
+		return ""
+	}
+	return webBaseURL + "/sessions/" + sessionID
+}
+
+// taskSummary 태스크 명령의 첫 줄 (최대 72바이트)
+func taskSummary(command string) string {
+	summary := strings.TrimSpace(strings.SplitN(strings.TrimSpace(command), "\n", 2)[0])
+	return truncateOutput(summary, 72)
+}
+
+// shortTaskID 브랜치 이름에 쓸 태스크 ID 앞부분
+func shortTaskID(id string) string {
+	id = strings.ReplaceAll(id, "-", "")
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
@@ -0,0 +1,307 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/search"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// searchIndexPage 다시 색인할 때 스토리지에서 읽는 페이지 크기
+	searchIndexPage = 100
+	// searchMaxPrompts 색인하는 프롬프트 최대 개수
+	searchMaxPrompts = 10000
+	// searchMaxAuditBody 감사 기록 문서에 넣는 명령 출력 최대 크기
+	searchMaxAuditBody = 4 << 10
+	// searchDateLayout from/to에 날짜만 지정할 때의 형식
+	searchDateLayout = "2006-01-02"
+)
+
+// SearchService 워크스페이스, 세션, 프롬프트, 셸 명령 감사 기록 통합 검색 서비스
+// 검색 결과와 패싯은 사용자가 read 권한을 가진 워크스페이스(소유 또는 ACL 공유)의 문서와
+// 본인 또는 조직에 공유된 프롬프트로 제한되며, admin은 모든 문서를 검색합니다.
+type SearchService struct {
+	storage storage.Storage
+	index   search.Index
+	access  *WorkspaceAccessService
+}
+
+// NewSearchService 새 통합 검색 서비스 생성
+func NewSearchService(storage storage.Storage, index search.Index) *SearchService {
+	return &SearchService{
+		storage: storage,
+		index:   index,
+		access:  NewWorkspaceAccessService(storage),
+	}
+}
+
+// Search 검색어와 조건(종류, 소유자, 기간)으로 권한 있는 문서를 검색
+func (s *SearchService) Search(ctx context.Context, userID string, admin bool, req *models.SearchRequest) (*models.SearchResponse, error) {
+	query, err := buildSearchQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		workspaceIDs, err := s.access.AccessibleWorkspaceIDs(ctx, userID)
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 권한 조회 실패", err)
+		}
+		query.Access = &search.Access{UserID: userID, WorkspaceIDs: workspaceIDs}
+	}
+
+	paging := &models.PaginationRequest{Page: req.Page, Limit: req.Limit}
+	paging.Normalize()
+	query.Offset = (paging.Page - 1) * paging.Limit
+	query.Limit = paging.Limit
+
+	result, err := s.index.Search(ctx, query)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "검색 실패", err)
+	}
+	return &models.SearchResponse{
+		Data:   result.Hits,
+		Meta:   models.NewPaginationMeta(paging.Page, paging.Limit, result.Total),
+		Facets: result.Facets,
+	}, nil
+}
+
+// buildSearchQuery 요청의 종류와 기간을 확인해 인덱스 조회 조건으로 변환
+func buildSearchQuery(req *models.SearchRequest) (*search.Query, error) {
+	query := &search.Query{
+		Text:    strings.TrimSpace(req.Query),
+		OwnerID: req.OwnerID,
+	}
+	if len(query.Text) > maxSearchQueryLength {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "검색어가 너무 깁니다", nil)
+	}
+
+	for _, value := range req.Types {
+		// type=workspace,session 형식도 허용
+		for _, name := range strings.Split(value, ",") {
+			docType := models.SearchDocumentType(strings.TrimSpace(name))
+			if docType == "" {
+				continue
+			}
+			if !isSearchDocumentType(docType) {
+				return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("지원하지 않는 검색 대상입니다: %s", docType), nil)
+			}
+			query.Types = append(query.Types, docType)
+		}
+	}
+
+	var err error
+	if query.From, err = parseSearchTime(req.From, false); err != nil {
+		return nil, err
+	}
+	if query.To, err = parseSearchTime(req.To, true); err != nil {
+		return nil, err
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "검색 기간의 시작이 끝보다 늦습니다", nil)
+	}
+	return query, nil
+}
+
+// parseSearchTime RFC3339 또는 YYYY-MM-DD (endOfDay이면 날짜만 지정한 경우 다음 날 0시)
+func parseSearchTime(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(searchDateLayout, value)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("검색 기간 형식이 올바르지 않습니다: %s", value), nil)
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+func isSearchDocumentType(docType models.SearchDocumentType) bool {
+	for _, t := range models.SearchDocumentTypes {
+		if t == docType {
+			return true
+		}
+	}
+	return false
+}
+
+// Reindex 스토리지의 워크스페이스, 세션, 셸 명령 기록, 프롬프트로 인덱스를 다시 만들고 색인한 문서 수 반환
+func (s *SearchService) Reindex(ctx context.Context) (int, error) {
+	docs, err := s.collectWorkspaceDocuments(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	prompts, err := s.storage.Prompt().List(ctx, &models.PromptFilter{Limit: searchMaxPrompts})
+	if err != nil {
+		return 0, fmt.Errorf("프롬프트 조회 실패: %w", err)
+	}
+	for _, prompt := range prompts {
+		docs = append(docs, promptDocument(prompt))
+	}
+
+	if err := s.index.Replace(ctx, docs); err != nil {
+		return 0, fmt.Errorf("검색 인덱스 갱신 실패: %w", err)
+	}
+	return len(docs), nil
+}
+
+// collectWorkspaceDocuments 모든 워크스페이스와 그 안의 세션, 셸 명령 기록 문서
+func (s *SearchService) collectWorkspaceDocuments(ctx context.Context) ([]*models.SearchDocument, error) {
+	docs := []*models.SearchDocument{}
+	for page, seen := 1, 0; ; page++ {
+		workspaces, total, err := s.storage.Workspace().List(ctx, &models.PaginationRequest{Page: page, Limit: searchIndexPage, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, fmt.Errorf("워크스페이스 조회 실패: %w", err)
+		}
+		for _, workspace := range workspaces {
+			docs = append(docs, workspaceDocument(workspace))
+			sessionDocs, err := s.collectSessionDocuments(ctx, workspace)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, sessionDocs...)
+		}
+		seen += len(workspaces)
+		if len(workspaces) == 0 || seen >= total {
+			break
+		}
+	}
+	return docs, nil
+}
+
+// collectSessionDocuments 워크스페이스의 세션과 셸 명령 기록 문서
+func (s *SearchService) collectSessionDocuments(ctx context.Context, workspace *models.Workspace) ([]*models.SearchDocument, error) {
+	docs := []*models.SearchDocument{}
+	for page, seen := 1, 0; ; page++ {
+		projects, total, err := s.storage.Project().GetByWorkspaceID(ctx, workspace.ID,
+			&models.PaginationRequest{Page: page, Limit: searchIndexPage, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, fmt.Errorf("프로젝트 조회 실패: %w", err)
+		}
+		for _, project := range projects {
+			projectDocs, err := s.collectProjectDocuments(ctx, workspace, project)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, projectDocs...)
+		}
+		seen += len(projects)
+		if len(projects) == 0 || seen >= total {
+			break
+		}
+	}
+	return docs, nil
+}
+
+// collectProjectDocuments 프로젝트의 세션과 셸 명령 기록 문서
+func (s *SearchService) collectProjectDocuments(ctx context.Context, workspace *models.Workspace, project *models.Project) ([]*models.SearchDocument, error) {
+	docs := []*models.SearchDocument{}
+	for page := 1; ; page++ {
+		resp, err := s.storage.Session().List(ctx, &models.SessionFilter{ProjectID: project.ID},
+			&models.PaginationRequest{Page: page, Limit: searchIndexPage, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, fmt.Errorf("세션 조회 실패: %w", err)
+		}
+		sessions, _ := resp.Data.([]*models.Session)
+		for _, session := range sessions {
+			docs = append(docs, sessionDocument(workspace, project, session))
+
+			for commandPage, seen := 1, 0; ; commandPage++ {
+				commands, total, err := s.storage.SessionCommand().ListBySession(ctx, session.ID,
+					&models.PaginationRequest{Page: commandPage, Limit: searchIndexPage})
+				if err != nil {
+					return nil, fmt.Errorf("셸 명령 기록 조회 실패: %w", err)
+				}
+				for _, command := range commands {
+					docs = append(docs, auditDocument(workspace, command))
+				}
+				seen += len(commands)
+				if len(commands) == 0 || seen >= total {
+					break
+				}
+			}
+		}
+		if len(sessions) == 0 || !resp.Meta.HasNext {
+			break
+		}
+	}
+	return docs, nil
+}
+
+func workspaceDocument(workspace *models.Workspace) *models.SearchDocument {
+	return &models.SearchDocument{
+		Type:        models.SearchTypeWorkspace,
+		ID:          workspace.ID,
+		Title:       workspace.Name,
+		Body:        workspace.ProjectPath,
+		WorkspaceID: workspace.ID,
+		OwnerID:     workspace.OwnerID,
+		CreatedAt:   workspace.CreatedAt,
+	}
+}
+
+// sessionDocument 세션 문서 (제목은 프로젝트 이름, 본문은 메타데이터 키와 값)
+func sessionDocument(workspace *models.Workspace, project *models.Project, session *models.Session) *models.SearchDocument {
+	keys := make([]string, 0, len(session.Metadata))
+	for key := range session.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	lines := make([]string, 0, len(keys)+1)
+	lines = append(lines, string(session.Status))
+	for _, key := range keys {
+		lines = append(lines, key+": "+session.Metadata[key])
+	}
+
+	return &models.SearchDocument{
+		Type:        models.SearchTypeSession,
+		ID:          session.ID,
+		Title:       project.Name,
+		Body:        strings.Join(lines, "\n"),
+		WorkspaceID: workspace.ID,
+		OwnerID:     workspace.OwnerID,
+		CreatedAt:   session.CreatedAt,
+	}
+}
+
+// promptDocument 프롬프트 문서 (워크스페이스 밖 문서, 조직 공유이면 공개)
+func promptDocument(prompt *models.Prompt) *models.SearchDocument {
+	body := strings.TrimSpace(strings.Join([]string{prompt.Description, prompt.Content, strings.Join(prompt.Tags, " ")}, "\n"))
+	return &models.SearchDocument{
+		Type:      models.SearchTypePrompt,
+		ID:        prompt.ID,
+		Title:     prompt.Name,
+		Body:      body,
+		OwnerID:   prompt.OwnerID,
+		Public:    prompt.Visibility == models.PromptVisibilityOrg,
+		CreatedAt: prompt.CreatedAt,
+	}
+}
+
+// auditDocument 셸 명령 감사 기록 문서 (출력은 앞부분만 색인)
+func auditDocument(workspace *models.Workspace, command *models.SessionCommand) *models.SearchDocument {
+	output := command.Output
+	if len(output) > searchMaxAuditBody {
+		output = strings.ToValidUTF8(output[:searchMaxAuditBody], "")
+	}
+	return &models.SearchDocument{
+		Type:        models.SearchTypeAudit,
+		ID:          command.ID,
+		Title:       command.Command,
+		Body:        strings.TrimSpace(command.Description + "\n" + output),
+		WorkspaceID: workspace.ID,
+		OwnerID:     workspace.OwnerID,
+		CreatedAt:   command.CreatedAt,
+	}
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/search"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestSearchService_AccessScope(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewSearchService(store, search.NewMemoryIndex())
+
+	alice := &models.Workspace{Name: "alice-payments", OwnerID: "alice", ProjectPath: "/srv/payments"}
+	bob := &models.Workspace{Name: "bob-payments", OwnerID: "bob", ProjectPath: "/srv/bob"}
+	require.NoError(t, store.Workspace().Create(ctx, alice))
+	require.NoError(t, store.Workspace().Create(ctx, bob))
+
+	project := &models.Project{WorkspaceID: alice.ID, Name: "billing", Path: "/srv/payments/billing"}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive, Metadata: map[string]string{"ticket": "PAY-42"}}
+	require.NoError(t, store.Session().Create(ctx, session))
+	require.NoError(t, store.SessionCommand().CreateBatch(ctx, []*models.SessionCommand{
+		{SessionID: session.ID, WorkspaceID: alice.ID, Command: "go test ./payments/...", Output: "ok"},
+	}))
+
+	require.NoError(t, store.Prompt().Create(ctx, &models.Prompt{ID: "p1", OwnerID: "alice", Name: "payments review", Content: "review", Visibility: models.PromptVisibilityPrivate}))
+	require.NoError(t, store.Prompt().Create(ctx, &models.Prompt{ID: "p2", OwnerID: "bob", Name: "payments shared", Content: "shared", Visibility: models.PromptVisibilityOrg}))
+	require.NoError(t, store.Prompt().Create(ctx, &models.Prompt{ID: "p3", OwnerID: "bob", Name: "payments private", Content: "secret", Visibility: models.PromptVisibilityPrivate}))
+
+	count, err := svc.Reindex(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 7, count)
+
+	// alice: 본인 워크스페이스와 세션, 감사 기록, 본인 프롬프트, 공유 프롬프트
+	resp, err := svc.Search(ctx, "alice", false, &models.SearchRequest{Query: "payments"})
+	require.NoError(t, err)
+	assert.Equal(t, 4, resp.Meta.Total)
+	assert.ElementsMatch(t, []models.SearchFacetBucket{
+		{Value: "workspace", Count: 1}, {Value: "prompt", Count: 2}, {Value: "audit", Count: 1},
+	}, resp.Facets.Type)
+
+	// ACL로 공유받으면 bob의 워크스페이스도 검색됨
+	access := NewWorkspaceAccessService(store)
+	_, err = access.Grant(ctx, bob.ID, "bob", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "alice", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+	resp, err = svc.Search(ctx, "alice", false, &models.SearchRequest{Query: "payments", Types: []string{"workspace"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+
+	// 세션은 프로젝트 이름과 메타데이터로 검색
+	resp, err = svc.Search(ctx, "alice", false, &models.SearchRequest{Query: "pay-42", Types: []string{"session"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, session.ID, resp.Data[0].ID)
+
+	// admin은 비공개 프롬프트까지 모두 검색
+	resp, err = svc.Search(ctx, "admin-user", true, &models.SearchRequest{Query: "payments", OwnerID: "bob"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Meta.Total)
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	query, err := buildSearchQuery(&models.SearchRequest{Types: []string{"workspace,prompt", "audit"}, From: "2026-01-01", To: "2026-01-31"})
+	require.NoError(t, err)
+	assert.Equal(t, []models.SearchDocumentType{models.SearchTypeWorkspace, models.SearchTypePrompt, models.SearchTypeAudit}, query.Types)
+	// 날짜만 지정한 끝은 그날 끝까지 포함
+	assert.Equal(t, "2026-02-01T00:00:00Z", query.To.Format("2006-01-02T15:04:05Z07:00"))
+
+	_, err = buildSearchQuery(&models.SearchRequest{Types: []string{"task"}})
+	assert.Error(t, err)
+
+	_, err = buildSearchQuery(&models.SearchRequest{From: "yesterday"})
+	assert.Error(t, err)
+
+	_, err = buildSearchQuery(&models.SearchRequest{From: "2026-02-01", To: "2026-01-01"})
+	assert.Error(t, err)
+}
@@ -6,21 +6,22 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aicli/aicli-web/internal/logging"
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/storage"
 	"github.com/google/uuid"
-	"go.uber.org/zap"
 )
 
 // SessionService 세션 관리 서비스
 type SessionService struct {
 	storage        storage.Storage
 	projectService *ProjectService
-	logger         *zap.Logger
+	logger         logging.Logger
 	
-	// 동시성 제어
+	// 동시성 제어 (활성 세션 캐시는 mu 안에서만 읽고 바꾸며, 밖으로는 복사본을 반환)
 	mu             sync.RWMutex
 	activeSessions map[string]*models.Session
+	updateMu       sync.Mutex // 같은 세션을 동시에 갱신할 때 값이 유실되지 않도록 조회-수정-저장을 직렬화
 	
 	// 설정
 	maxConcurrent  int
@@ -51,7 +52,7 @@ func NewSessionService(storage storage.Storage, projectService *ProjectService,
 	s := &SessionService{
 		storage:        storage,
 		projectService: projectService,
-		logger:         zap.NewNop(),
+		logger:         logging.Nop(),
 		activeSessions: make(map[string]*models.Session),
 		maxConcurrent:  config.MaxConcurrent,
 		cleanupTicker:  time.NewTicker(config.CleanupInterval),
@@ -64,6 +65,11 @@ func NewSessionService(storage storage.Storage, projectService *ProjectService,
 	return s
 }
 
+// SetLogger 세션 서비스 로거 설정 (서비스 시작 전에 설정)
+func (s *SessionService) SetLogger(logger logging.Logger) {
+	s.logger = logger
+}
+
 // Create 새로운 세션 생성
 func (s *SessionService) Create(ctx context.Context, req *models.SessionCreateRequest) (*models.Session, error) {
 	// 프로젝트 확인
@@ -103,13 +109,15 @@ func (s *SessionService) Create(ctx context.Context, req *models.SessionCreateRe
 	
 	// 활성 세션 추가
 	s.mu.Lock()
-	s.activeSessions[session.ID] = session
+	cached := *session
+	s.activeSessions[session.ID] = &cached
 	s.mu.Unlock()
 	
-	s.logger.Info("세션 생성됨",
-		zap.String("session_id", session.ID),
-		zap.String("project_id", project.ID),
-	)
+	s.logger.WithContext(ctx).WithFields(logging.Fields{
+		"session_id":   session.ID,
+		"project_id":   project.ID,
+		"workspace_id": project.WorkspaceID,
+	}).Info("세션 생성됨")
 	
 	return session, nil
 }
@@ -119,8 +127,9 @@ func (s *SessionService) GetByID(ctx context.Context, id string) (*models.Sessio
 	// 먼저 활성 세션에서 확인
 	s.mu.RLock()
 	if session, ok := s.activeSessions[id]; ok {
+		copied := *session
 		s.mu.RUnlock()
-		return session, nil
+		return &copied, nil
 	}
 	s.mu.RUnlock()
 	
@@ -140,6 +149,9 @@ func (s *SessionService) List(ctx context.Context, filter *models.SessionFilter,
 
 // UpdateStatus 세션 상태 업데이트
 func (s *SessionService) UpdateStatus(ctx context.Context, id string, status models.SessionStatus) error {
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+	
 	session, err := s.GetByID(ctx, id)
 	if err != nil {
 		return err
@@ -173,30 +185,26 @@ func (s *SessionService) UpdateStatus(ctx context.Context, id string, status mod
 	if err := s.storage.Session().Update(ctx, session); err != nil {
 		return fmt.Errorf("세션 상태 업데이트 실패: %w", err)
 	}
+	s.refreshActive(session)
 	
-	s.logger.Info("세션 상태 업데이트",
-		zap.String("session_id", id),
-		zap.String("status", string(status)),
-	)
+	s.logger.WithContext(ctx).WithFields(logging.Fields{
+		"session_id": id,
+		"status":     string(status),
+	}).Info("세션 상태 업데이트")
 	
 	return nil
 }
 
 // UpdateActivity 세션 활동 업데이트
 func (s *SessionService) UpdateActivity(ctx context.Context, id string) error {
-	session, err := s.GetByID(ctx, id)
-	if err != nil {
-		return err
-	}
-	
-	session.UpdateActivity()
-	
-	// Idle 상태인 경우 Active로 변경
-	if session.Status == models.SessionIdle {
-		session.Status = models.SessionActive
-	}
-	
-	return s.storage.Session().Update(ctx, session)
+	return s.update(ctx, id, func(session *models.Session) {
+		session.UpdateActivity()
+		
+		// Idle 상태인 경우 Active로 변경
+		if session.Status == models.SessionIdle {
+			session.Status = models.SessionActive
+		}
+	})
 }
 
 // Terminate 세션 종료
@@ -206,18 +214,43 @@ func (s *SessionService) Terminate(ctx context.Context, id string) error {
 
 // UpdateStats 세션 통계 업데이트
 func (s *SessionService) UpdateStats(ctx context.Context, id string, commandDelta, bytesInDelta, bytesOutDelta, errorDelta int64) error {
+	return s.update(ctx, id, func(session *models.Session) {
+		session.CommandCount += commandDelta
+		session.BytesIn += bytesInDelta
+		session.BytesOut += bytesOutDelta
+		session.ErrorCount += errorDelta
+		session.UpdateActivity()
+	})
+}
+
+// update 세션 복사본을 modify로 바꿔 저장하고 활성 세션 캐시에 반영
+func (s *SessionService) update(ctx context.Context, id string, modify func(session *models.Session)) error {
+	s.updateMu.Lock()
+	defer s.updateMu.Unlock()
+	
 	session, err := s.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	
-	session.CommandCount += commandDelta
-	session.BytesIn += bytesInDelta
-	session.BytesOut += bytesOutDelta
-	session.ErrorCount += errorDelta
-	session.UpdateActivity()
+	modify(session)
 	
-	return s.storage.Session().Update(ctx, session)
+	if err := s.storage.Session().Update(ctx, session); err != nil {
+		return err
+	}
+	s.refreshActive(session)
+	return nil
+}
+
+// refreshActive 활성 세션 캐시에 있는 세션이면 저장한 값으로 교체
+func (s *SessionService) refreshActive(session *models.Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	
+	if _, ok := s.activeSessions[session.ID]; ok {
+		cached := *session
+		s.activeSessions[session.ID] = &cached
+	}
 }
 
 // GetActiveSessions 활성 세션 목록 조회
@@ -227,7 +260,8 @@ func (s *SessionService) GetActiveSessions() []*models.Session {
 	
 	sessions := make([]*models.Session, 0, len(s.activeSessions))
 	for _, session := range s.activeSessions {
-		sessions = append(sessions, session)
+		copied := *session
+		sessions = append(sessions, &copied)
 	}
 	return sessions
 }
@@ -289,39 +323,29 @@ func (s *SessionService) cleanupSessions() {
 	s.mu.RLock()
 	sessions := make([]*models.Session, 0, len(s.activeSessions))
 	for _, session := range s.activeSessions {
-		sessions = append(sessions, session)
+		copied := *session
+		sessions = append(sessions, &copied)
 	}
 	s.mu.RUnlock()
 	
 	for _, session := range sessions {
+		logger := s.logger.WithField("session_id", session.ID)
+
 		// 타임아웃 확인
 		if session.IsIdleTimeout() {
-			s.logger.Info("유휴 타임아웃으로 세션 종료",
-				zap.String("session_id", session.ID),
-			)
+			logger.Info("유휴 타임아웃으로 세션 종료")
 			if err := s.Terminate(ctx, session.ID); err != nil {
-				s.logger.Error("세션 종료 실패",
-					zap.String("session_id", session.ID),
-					zap.Error(err),
-				)
+				logger.WithError(err).Error("세션 종료 실패")
 			}
 		} else if session.IsLifetimeTimeout() {
-			s.logger.Info("생명주기 타임아웃으로 세션 종료",
-				zap.String("session_id", session.ID),
-			)
+			logger.Info("생명주기 타임아웃으로 세션 종료")
 			if err := s.Terminate(ctx, session.ID); err != nil {
-				s.logger.Error("세션 종료 실패",
-					zap.String("session_id", session.ID),
-					zap.Error(err),
-				)
+				logger.WithError(err).Error("세션 종료 실패")
 			}
 		} else if session.Status == models.SessionActive && time.Since(session.LastActive) > 5*time.Minute {
 			// 5분 이상 활동이 없으면 Idle 상태로 변경
 			if err := s.UpdateStatus(ctx, session.ID, models.SessionIdle); err != nil {
-				s.logger.Error("세션 상태 업데이트 실패",
-					zap.String("session_id", session.ID),
-					zap.Error(err),
-				)
+				logger.WithError(err).Error("세션 상태 업데이트 실패")
 			}
 		}
 	}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// maxBranchTranscriptChars 분기 세션 시스템 프롬프트에 포함할 대화 내용 최대 길이
+	maxBranchTranscriptChars = 100000
+
+	// defaultBranchMaxTurns 분기 세션 기본 최대 턴 수
+	defaultBranchMaxTurns = 10
+)
+
+// SessionBranchService 저장된 대화 기록으로 세션을 분기하고 재생하는 서비스
+// 접근 권한은 MessageService와 같이 워크스페이스 소유자(또는 admin)로 제한됩니다.
+type SessionBranchService struct {
+	storage  storage.Storage
+	messages *MessageService
+	wrapper  claude.Wrapper
+	// 분기 세션에 적용할 사용자 응답 언어 (nil이면 지시하지 않음)
+	languages claude.ResponseLanguageProvider
+}
+
+// NewSessionBranchService 새 세션 분기 서비스 생성
+func NewSessionBranchService(storage storage.Storage, messages *MessageService, wrapper claude.Wrapper) *SessionBranchService {
+	return &SessionBranchService{
+		storage:  storage,
+		messages: messages,
+		wrapper:  wrapper,
+	}
+}
+
+// SetResponseLanguageProvider 분기 세션에 적용할 사용자 응답 언어 제공자 설정
+func (s *SessionBranchService) SetResponseLanguageProvider(languages claude.ResponseLanguageProvider) {
+	s.languages = languages
+}
+
+// Fork 세션을 지정한 메시지까지 복사한 새 Claude 세션으로 분기
+// 분기 지점이 마지막 메시지이고 Claude CLI 세션 ID가 기록되어 있으면 --resume --fork-session으로 이어가고,
+// 그렇지 않으면 분기 지점까지의 대화 내용을 시스템 프롬프트로 전달합니다.
+func (s *SessionBranchService) Fork(ctx context.Context, sessionID, userID string, admin bool, req *models.SessionForkRequest) (*models.SessionForkResult, error) {
+	if req.MessageIndex < 1 {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "분기 지점은 1 이상이어야 합니다", nil)
+	}
+
+	transcript, err := s.transcript(ctx, sessionID, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if len(transcript) == 0 {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "세션 대화 기록을 찾을 수 없습니다", nil)
+	}
+
+	cut := 0
+	for cut < len(transcript) && transcript[cut].Sequence <= req.MessageIndex {
+		cut++
+	}
+	if cut == 0 || transcript[cut-1].Sequence != req.MessageIndex {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("메시지 %d을(를) 찾을 수 없습니다", req.MessageIndex), nil)
+	}
+	branch := transcript[:cut]
+	last := branch[len(branch)-1]
+
+	// 작업 디렉토리는 대화 기록이 아닌 세션의 프로젝트가 속한 워크스페이스에서 결정
+	source, err := s.storage.Session().GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+	}
+	workspaceID, err := sessionWorkspaceID(ctx, s.storage, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "세션의 워크스페이스를 찾을 수 없습니다", err)
+	}
+
+	config := &claude.SessionConfig{
+		WorkingDir:   workspace.ProjectPath,
+		SystemPrompt: req.SystemPrompt,
+		MaxTurns:     defaultBranchMaxTurns,
+		Temperature:  0.7,
+	}
+	if s.languages != nil {
+		config.ResponseLanguage = s.languages.ResponseLanguage(ctx, userID)
+	}
+	resumeID := last.Metadata[models.MessageMetaClaudeSessionID]
+	resumed := resumeID != "" && cut == len(transcript)
+	if resumed {
+		config.ResumeSessionID = resumeID
+		config.ForkSession = true
+	} else {
+		config.SystemPrompt = branchSystemPrompt(req.SystemPrompt, sessionID, branch)
+	}
+
+	session, err := s.wrapper.CreateSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("분기 세션 생성 실패: %w", err)
+	}
+	if err := s.recordForkSession(ctx, source, session.ID); err != nil {
+		return nil, err
+	}
+
+	// 분기 지점까지의 기록을 새 세션으로 복사 (원본 시각 유지)
+	for _, message := range branch {
+		metadata := make(map[string]string, len(message.Metadata)+2)
+		for k, v := range message.Metadata {
+			metadata[k] = v
+		}
+		metadata[models.MessageMetaForkedFrom] = sessionID
+		metadata[models.MessageMetaForkedSequence] = strconv.FormatInt(message.Sequence, 10)
+
+		err := s.messages.Record(ctx, &models.SessionMessage{
+			SessionID:   session.ID,
+			WorkspaceID: workspaceID,
+			Role:        message.Role,
+			Type:        message.Type,
+			Content:     message.Content,
+			Metadata:    metadata,
+			CreatedAt:   message.CreatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.SessionForkResult{
+		SessionID:       session.ID,
+		SourceSessionID: sessionID,
+		WorkspaceID:     workspaceID,
+		MessageIndex:    req.MessageIndex,
+		CopiedMessages:  len(branch),
+		Resumed:         resumed,
+	}, nil
+}
+
+// recordForkSession 분기 세션을 원본 세션과 같은 프로젝트에 저장 (래퍼가 이미 저장했으면 그대로 둠)
+func (s *SessionBranchService) recordForkSession(ctx context.Context, source *models.Session, sessionID string) error {
+	if _, err := s.storage.Session().GetByID(ctx, sessionID); err == nil {
+		return nil
+	}
+
+	now := time.Now()
+	session := &models.Session{
+		BaseModel:  models.BaseModel{ID: sessionID},
+		ProjectID:  source.ProjectID,
+		Status:     models.SessionActive,
+		StartedAt:  &now,
+		LastActive: now,
+		Metadata: map[string]string{
+			models.SessionMetaSource:        models.SessionSourceFork,
+			models.SessionMetaParentSession: source.ID,
+		},
+		MaxIdleTime: source.MaxIdleTime,
+		MaxLifetime: source.MaxLifetime,
+	}
+	if err := s.storage.Session().Create(ctx, session); err != nil && !storage.IsAlreadyExistsError(err) {
+		return NewWorkspaceError(ErrCodeInternal, "분기 세션 저장 실패", err)
+	}
+	return nil
+}
+
+// Replay 세션 대화 기록을 원래 시간 간격 정보와 함께 재생 이벤트로 변환
+func (s *SessionBranchService) Replay(ctx context.Context, sessionID, userID string, admin bool) ([]*models.SessionReplayEvent, error) {
+	transcript, err := s.transcript(ctx, sessionID, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if len(transcript) == 0 {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "세션 대화 기록을 찾을 수 없습니다", nil)
+	}
+
+	start := transcript[0].CreatedAt
+	events := make([]*models.SessionReplayEvent, len(transcript))
+	for i, message := range transcript {
+		event := &models.SessionReplayEvent{
+			Message:  message,
+			OffsetMS: nonNegativeMillis(message.CreatedAt.Sub(start).Milliseconds()),
+		}
+		if i > 0 {
+			event.DelayMS = nonNegativeMillis(message.CreatedAt.Sub(transcript[i-1].CreatedAt).Milliseconds())
+		}
+		events[i] = event
+	}
+	return events, nil
+}
+
+// transcript 접근 권한을 확인하고 세션의 전체 대화 기록을 순서대로 조회
+func (s *SessionBranchService) transcript(ctx context.Context, sessionID, userID string, admin bool) ([]*models.SessionMessage, error) {
+	messages := []*models.SessionMessage{}
+	for page := 1; ; page++ {
+		resp, err := s.messages.ListBySession(ctx, sessionID, userID, admin, &models.PaginationRequest{Page: page, Limit: 100})
+		if err != nil {
+			return nil, err
+		}
+		batch := resp.Data.([]*models.SessionMessage)
+		messages = append(messages, batch...)
+		if len(batch) == 0 || len(messages) >= resp.Meta.Total {
+			return messages, nil
+		}
+	}
+}
+
+// branchSystemPrompt 분기 지점까지의 대화 내용을 포함한 시스템 프롬프트 생성
+// 길이 제한을 넘으면 오래된 메시지부터 생략합니다.
+func branchSystemPrompt(systemPrompt, sourceID string, messages []*models.SessionMessage) string {
+	entries := make([]string, 0, len(messages))
+	size := 0
+	for i := len(messages) - 1; i >= 0; i-- {
+		entry := fmt.Sprintf("[%s]\n%s", messages[i].Role, messages[i].Content)
+		if size+len(entry) > maxBranchTranscriptChars && len(entries) > 0 {
+			break
+		}
+		entries = append(entries, entry)
+		size += len(entry)
+	}
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+
+	var b strings.Builder
+	if systemPrompt != "" {
+		b.WriteString(systemPrompt)
+		b.WriteString("\n\n")
+	}
+	fmt.Fprintf(&b, "다음은 세션 %s에서 이어지는 이전 대화입니다. 이 대화에 이어서 응답하세요.\n\n", sourceID)
+	if len(entries) < len(messages) {
+		fmt.Fprintf(&b, "(이전 메시지 %d개 생략)\n\n", len(messages)-len(entries))
+	}
+	b.WriteString(strings.Join(entries, "\n\n"))
+	return b.String()
+}
+
+// nonNegativeMillis 시각이 역순인 기록을 0으로 보정
+func nonNegativeMillis(ms int64) int64 {
+	if ms < 0 {
+		return 0
+	}
+	return ms
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeBranchWrapper 생성 요청만 기록하는 Claude 래퍼
+type fakeBranchWrapper struct {
+	configs []*claude.SessionConfig
+}
+
+func (w *fakeBranchWrapper) CreateSession(config *claude.SessionConfig) (*claude.Session, error) {
+	w.configs = append(w.configs, config)
+	return &claude.Session{ID: "forked-session", Config: *config}, nil
+}
+
+func (w *fakeBranchWrapper) GetSession(sessionID string) (*claude.Session, error) { return nil, nil }
+func (w *fakeBranchWrapper) CloseSession(sessionID string) error                  { return nil }
+func (w *fakeBranchWrapper) ListSessions(filter claude.SessionFilter) ([]*claude.Session, error) {
+	return nil, nil
+}
+func (w *fakeBranchWrapper) Execute(sessionID, prompt string) (interface{}, error) { return nil, nil }
+
+func setupBranchTest(t *testing.T) (*SessionBranchService, *MessageService, *fakeBranchWrapper, *models.Workspace) {
+	ctx := context.Background()
+	store := memory.New()
+	messages := NewMessageService(store)
+	wrapper := &fakeBranchWrapper{}
+
+	ws := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp/alice"}
+	require.NoError(t, store.Workspace().Create(ctx, ws))
+	createWorkspaceSession(t, store, ws.ID, "source")
+
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	contents := []struct {
+		role    models.MessageRole
+		content string
+		offset  time.Duration
+	}{
+		{models.MessageRoleUser, "add a health endpoint", 0},
+		{models.MessageRoleAssistant, "added /health", 3 * time.Second},
+		{models.MessageRoleUser, "now add metrics", 10 * time.Second},
+		{models.MessageRoleAssistant, "added /metrics", 12 * time.Second},
+	}
+	for _, c := range contents {
+		require.NoError(t, messages.Record(ctx, &models.SessionMessage{
+			SessionID:   "source",
+			WorkspaceID: ws.ID,
+			Role:        c.role,
+			Content:     c.content,
+			CreatedAt:   base.Add(c.offset),
+		}))
+	}
+
+	return NewSessionBranchService(store, messages, wrapper), messages, wrapper, ws
+}
+
+func TestSessionBranchService_Fork(t *testing.T) {
+	ctx := context.Background()
+	svc, messages, wrapper, ws := setupBranchTest(t)
+
+	result, err := svc.Fork(ctx, "source", "alice", false, &models.SessionForkRequest{MessageIndex: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "forked-session", result.SessionID)
+	assert.Equal(t, 2, result.CopiedMessages)
+	assert.False(t, result.Resumed)
+
+	// 분기 지점까지의 대화가 프롬프트로 전달됨
+	require.Len(t, wrapper.configs, 1)
+	config := wrapper.configs[0]
+	assert.Equal(t, ws.ProjectPath, config.WorkingDir)
+	assert.Contains(t, config.SystemPrompt, "added /health")
+	assert.NotContains(t, config.SystemPrompt, "now add metrics")
+	assert.Empty(t, config.ResumeSessionID)
+
+	resp, err := messages.ListBySession(ctx, "forked-session", "alice", false, nil)
+	require.NoError(t, err)
+	copied := resp.Data.([]*models.SessionMessage)
+	require.Len(t, copied, 2)
+	assert.Equal(t, "source", copied[1].Metadata[models.MessageMetaForkedFrom])
+	assert.Equal(t, "2", copied[1].Metadata[models.MessageMetaForkedSequence])
+	assert.Equal(t, ws.ID, copied[1].WorkspaceID)
+
+	// 권한 없는 사용자와 존재하지 않는 분기 지점
+	_, err = svc.Fork(ctx, "source", "bob", false, &models.SessionForkRequest{MessageIndex: 1})
+	assert.Error(t, err)
+	_, err = svc.Fork(ctx, "source", "alice", false, &models.SessionForkRequest{MessageIndex: 9})
+	assert.Error(t, err)
+}
+
+func TestSessionBranchService_ForkIgnoresMessageWorkspace(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	messages := NewMessageService(store)
+	wrapper := &fakeBranchWrapper{}
+	svc := NewSessionBranchService(store, messages, wrapper)
+
+	alice := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp/alice"}
+	require.NoError(t, store.Workspace().Create(ctx, alice))
+	mallory := &models.Workspace{Name: "mallory-ws", OwnerID: "mallory", ProjectPath: "/tmp/mallory"}
+	require.NoError(t, store.Workspace().Create(ctx, mallory))
+	createWorkspaceSession(t, store, mallory.ID, "source")
+
+	// 다른 사용자의 워크스페이스 ID로 기록된 메시지가 있어도 세션의 워크스페이스에서 실행
+	require.NoError(t, messages.Record(ctx, &models.SessionMessage{SessionID: "source", WorkspaceID: alice.ID, Role: models.MessageRoleUser, Content: "cat secrets"}))
+
+	result, err := svc.Fork(ctx, "source", "mallory", false, &models.SessionForkRequest{MessageIndex: 1})
+	require.NoError(t, err)
+	assert.Equal(t, mallory.ID, result.WorkspaceID)
+	assert.Equal(t, mallory.ProjectPath, wrapper.configs[0].WorkingDir)
+
+	forked, err := store.Session().GetByID(ctx, result.SessionID)
+	require.NoError(t, err)
+	assert.Equal(t, models.SessionSourceFork, forked.Metadata[models.SessionMetaSource])
+	assert.Equal(t, "source", forked.Metadata[models.SessionMetaParentSession])
+
+	// 메시지의 워크스페이스 ID로는 기록을 조회할 수 없음
+	_, err = messages.ListBySession(ctx, "source", "alice", false, nil)
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+}
+
+func TestSessionBranchService_ForkResume(t *testing.T) {
+	ctx := context.Background()
+	svc, messages, wrapper, ws := setupBranchTest(t)
+
+	require.NoError(t, messages.Record(ctx, &models.SessionMessage{
+		SessionID:   "source",
+		WorkspaceID: ws.ID,
+		Role:        models.MessageRoleAssistant,
+		Content:     "done",
+		Metadata:    map[string]string{models.MessageMetaClaudeSessionID: "cli-123"},
+	}))
+
+	result, err := svc.Fork(ctx, "source", "alice", false, &models.SessionForkRequest{MessageIndex: 5})
+	require.NoError(t, err)
+	assert.True(t, result.Resumed)
+	assert.Equal(t, "cli-123", wrapper.configs[0].ResumeSessionID)
+	assert.True(t, wrapper.configs[0].ForkSession)
+}
+
+func TestSessionBranchService_Replay(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, _ := setupBranchTest(t)
+
+	events, err := svc.Replay(ctx, "source", "alice", false)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	assert.Equal(t, int64(0), events[0].DelayMS)
+	assert.Equal(t, int64(7000), events[2].DelayMS)
+	assert.Equal(t, int64(12000), events[3].OffsetMS)
+
+	_, err = svc.Replay(ctx, "source", "bob", false)
+	assert.Error(t, err)
+	_, err = svc.Replay(ctx, "missing", "alice", false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// sessionCommandTimeout 태스크 종료 후 명령 실행 기록 저장 제한 시간
+	sessionCommandTimeout = 30 * time.Second
+	// sessionCommandMaxOutput 명령 하나의 출력 최대 저장 크기 (넘으면 잘라서 저장)
+	sessionCommandMaxOutput = 16 << 10
+	// sessionCommandExportPage 내보내기에서 기록을 읽는 페이지 크기
+	sessionCommandExportPage = 100
+)
+
+// sessionCommandCSVHeader CSV 내보내기 헤더
+var sessionCommandCSVHeader = []string{
+	"created_at", "session_id", "workspace_id", "task_id", "tool_use_id", "working_dir", "command",
+	"description", "exit_code", "is_error", "output_bytes", "output_truncated", "output",
+}
+
+// SessionCommandService 태스크 출력에서 Bash 도구로 실행된 셸 명령을 찾아 세션별 감사 기록으로 남기는 서비스
+// 태스크 출력은 TaskService에서 이미 마스킹을 거치므로 기록에도 마스킹된 명령과 출력만 남습니다.
+type SessionCommandService struct {
+	storage storage.Storage
+}
+
+// NewSessionCommandService 새 명령 실행 기록 서비스 생성
+func NewSessionCommandService(storage storage.Storage) *SessionCommandService {
+	return &SessionCommandService{storage: storage}
+}
+
+// OnTaskFinished 종료된 태스크의 명령 실행 기록 저장 (TaskFinishListener)
+// 취소된 태스크도 그 전에 실행된 명령은 기록합니다.
+func (s *SessionCommandService) OnTaskFinished(task *models.Task) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), sessionCommandTimeout)
+		defer cancel()
+		if _, err := s.Record(ctx, task); err != nil {
+			log.Printf("명령 실행 기록 저장 실패: %s: %v", task.ID, err)
+		}
+	}()
+}
+
+// Record 태스크 출력에서 실행된 명령을 추출해 저장
+// 작업 디렉토리를 알 수 없는 명령은 세션 프로젝트 경로로 기록합니다.
+func (s *SessionCommandService) Record(ctx context.Context, task *models.Task) ([]*models.SessionCommand, error) {
+	executed := claude.ExtractExecutedCommands(task.Output)
+	if len(executed) == 0 {
+		return nil, nil
+	}
+
+	var workspaceID, projectPath string
+	if session, err := s.storage.Session().GetByID(ctx, task.SessionID); err == nil {
+		if project, err := s.storage.Project().GetByID(ctx, session.ProjectID); err == nil {
+			workspaceID = project.WorkspaceID
+			projectPath = project.Path
+		}
+	}
+
+	commands := make([]*models.SessionCommand, 0, len(executed))
+	for _, command := range executed {
+		if command.WorkingDir == "" {
+			command.WorkingDir = projectPath
+		}
+		output := truncateOutput(command.Output, sessionCommandMaxOutput)
+		commands = append(commands, &models.SessionCommand{
+			SessionID:       task.SessionID,
+			WorkspaceID:     workspaceID,
+			TaskID:          task.ID,
+			ToolUseID:       command.ToolUseID,
+			Command:         command.Command,
+			Description:     command.Description,
+			WorkingDir:      command.WorkingDir,
+			ExitCode:        command.ExitCode,
+			IsError:         command.IsError,
+			Output:          output,
+			OutputBytes:     len(command.Output),
+			OutputTruncated: len(output) < len(command.Output),
+		})
+	}
+	if err := s.storage.SessionCommand().CreateBatch(ctx, commands); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "명령 실행 기록 저장 실패", err)
+	}
+	return commands, nil
+}
+
+// ListBySession 세션의 명령 실행 기록을 실행 순서대로 조회
+// 세션 접근 권한은 라우터의 권한 미들웨어에서 확인합니다.
+func (s *SessionCommandService) ListBySession(ctx context.Context, sessionID string, paging *models.PaginationRequest) (*models.PaginationResponse, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	commands, total, err := s.storage.SessionCommand().ListBySession(ctx, sessionID, paging)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "명령 실행 기록 조회 실패", err)
+	}
+	return &models.PaginationResponse{
+		Data: commands,
+		Meta: models.NewPaginationMeta(paging.Page, paging.Limit, total),
+	}, nil
+}
+
+// Export 세션의 명령 실행 기록 전체를 실행 순서대로 w에 기록 (csv 또는 jsonl)
+func (s *SessionCommandService) Export(ctx context.Context, w io.Writer, sessionID string, format models.SessionCommandExportFormat) error {
+	var (
+		write  func(command *models.SessionCommand) error
+		finish = func() error { return nil }
+	)
+	switch format {
+	case models.SessionCommandExportCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(sessionCommandCSVHeader); err != nil {
+			return err
+		}
+		write = func(command *models.SessionCommand) error {
+			return writer.Write(sessionCommandCSVRecord(command))
+		}
+		finish = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	case models.SessionCommandExportJSONL:
+		encoder := json.NewEncoder(w)
+		write = func(command *models.SessionCommand) error {
+			return encoder.Encode(command)
+		}
+	default:
+		return NewWorkspaceError(ErrCodeInvalidRequest, "지원하지 않는 내보내기 형식입니다: "+string(format), nil)
+	}
+
+	for page := 1; ; page++ {
+		commands, total, err := s.storage.SessionCommand().ListBySession(ctx, sessionID, &models.PaginationRequest{Page: page, Limit: sessionCommandExportPage})
+		if err != nil {
+			return NewWorkspaceError(ErrCodeInternal, "명령 실행 기록 조회 실패", err)
+		}
+		for _, command := range commands {
+			if err := write(command); err != nil {
+				return err
+			}
+		}
+		if len(commands) == 0 || page*sessionCommandExportPage >= total {
+			return finish()
+		}
+	}
+}
+
+// sessionCommandCSVRecord CSV 한 줄 (종료 코드를 모르면 빈 칸)
+func sessionCommandCSVRecord(command *models.SessionCommand) []string {
+	exitCode := ""
+	if command.ExitCode != nil {
+		exitCode = strconv.Itoa(*command.ExitCode)
+	}
+	return []string{
+		command.CreatedAt.UTC().Format(time.RFC3339),
+		command.SessionID,
+		command.WorkspaceID,
+		command.TaskID,
+		command.ToolUseID,
+		command.WorkingDir,
+		command.Command,
+		command.Description,
+		exitCode,
+		strconv.FormatBool(command.IsError),
+		strconv.Itoa(command.OutputBytes),
+		strconv.FormatBool(command.OutputTruncated),
+		command.Output,
+	}
+}
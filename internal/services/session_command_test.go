@@ -0,0 +1,75 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestSessionCommandService_RecordAndExport(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	service := NewSessionCommandService(store)
+	ws, session := createWorkspaceWithSession(t, store, "alice", "api")
+
+	longOutput := strings.Repeat("x", sessionCommandMaxOutput+10)
+	task := &models.Task{SessionID: session.ID, Command: "claude", Status: models.TaskCompleted}
+	task.ID = "task-1"
+	task.Output = `{"type":"assistant","message":{"content":[{"type":"tool_use","id":"toolu_1","name":"Bash","input":{"command":"go build ./...","description":"Build"}}]}}
+{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"toolu_1","content":"` + longOutput + `"}]}}
+{"type":"assistant","message":{"content":[{"type":"tool_use","id":"toolu_2","name":"Bash","input":{"command":"go vet ./..."}}]}}
+{"type":"user","message":{"content":[{"type":"tool_result","tool_use_id":"toolu_2","content":"Exit code 1\nvet: bad","is_error":true}]}}`
+
+	commands, err := service.Record(ctx, task)
+	require.NoError(t, err)
+	require.Len(t, commands, 2)
+
+	resp, err := service.ListBySession(ctx, session.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+	listed := resp.Data.([]*models.SessionCommand)
+
+	// 작업 디렉토리는 프로젝트 경로, 출력은 잘라서 저장
+	first := listed[0]
+	assert.Equal(t, "go build ./...", first.Command)
+	assert.Equal(t, ws.ID, first.WorkspaceID)
+	assert.Equal(t, task.ID, first.TaskID)
+	assert.Equal(t, "/tmp/api", first.WorkingDir)
+	assert.Equal(t, 0, *first.ExitCode)
+	assert.True(t, first.OutputTruncated)
+	assert.Len(t, first.Output, sessionCommandMaxOutput)
+	assert.Equal(t, len(longOutput), first.OutputBytes)
+
+	assert.Equal(t, 1, *listed[1].ExitCode)
+	assert.True(t, listed[1].IsError)
+
+	// CSV 내보내기
+	var buf bytes.Buffer
+	require.NoError(t, service.Export(ctx, &buf, session.ID, models.SessionCommandExportCSV))
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, sessionCommandCSVHeader, records[0])
+	assert.Equal(t, "go vet ./...", records[2][6])
+	assert.Equal(t, "1", records[2][8])
+
+	// JSON Lines 내보내기
+	buf.Reset()
+	require.NoError(t, service.Export(ctx, &buf, session.ID, models.SessionCommandExportJSONL))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var exported models.SessionCommand
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &exported))
+	assert.Equal(t, "vet: bad", exported.Output)
+
+	assert.Error(t, service.Export(ctx, &buf, session.ID, "xml"))
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// SessionLimitErrorCode 동시 실행 세션 한도 초과 응답의 에러 코드
+const SessionLimitErrorCode = "SESSION_LIMIT_EXCEEDED"
+
+// ErrSessionLimitExceeded 동시 실행 세션 한도에 도달해 새 세션의 태스크를 받을 수 없음
+var ErrSessionLimitExceeded = errors.New("session limit exceeded")
+
+// SessionLimitError 동시 실행 세션 한도로 거부된 태스크 요청의 사용량 정보
+type SessionLimitError struct {
+	Usage models.SessionLimitUsage
+}
+
+func (e *SessionLimitError) Error() string {
+	if e.Usage.Scope == models.SessionLimitScopeOrg {
+		return fmt.Sprintf("서버 전체 동시 실행 세션 한도에 도달했습니다 (%d/%d)", e.Usage.Running, e.Usage.Limit)
+	}
+	return fmt.Sprintf("사용자 %s의 동시 실행 세션 한도에 도달했습니다 (%d/%d)", e.Usage.UserID, e.Usage.Running, e.Usage.Limit)
+}
+
+// Is errors.Is(err, ErrSessionLimitExceeded)로 확인할 수 있도록 합니다
+func (e *SessionLimitError) Is(target error) bool {
+	return target == ErrSessionLimitExceeded
+}
+
+// runningSession 대기 중이거나 실행 중인 태스크가 있는 세션
+type runningSession struct {
+	userID string
+	tasks  map[string]bool
+}
+
+// SessionLimitService 사용자별, 서버 전체 동시 실행 Claude 세션 수를 제한하는 서비스
+// 대기 중이거나 실행 중인 태스크가 있는 세션을 실행 중인 세션으로 세며,
+// 이미 실행 중인 세션의 태스크는 한도와 관계없이 받고 새로 실행되는 세션의 태스크만 거부합니다.
+// 세션은 태스크를 요청한 사용자에게 세고, 요청한 사용자가 없는 태스크(시스템이 만든 태스크)는 워크스페이스 소유자에게 셉니다.
+// 사용 현황은 인스턴스 메모리에만 있으므로 레플리카마다 따로 제한합니다.
+type SessionLimitService struct {
+	mu       sync.Mutex
+	limits   models.SessionLimitSettings
+	sessions map[string]*runningSession // 세션 ID별
+	tasks    map[string]string          // 태스크 ID → 세션 ID
+}
+
+// NewSessionLimitService 새 동시 실행 세션 한도 서비스 생성 (0이면 무제한)
+func NewSessionLimitService(perUser, perOrg int) *SessionLimitService {
+	return &SessionLimitService{
+		limits:   models.SessionLimitSettings{PerUser: perUser, PerOrg: perOrg},
+		sessions: make(map[string]*runningSession),
+		tasks:    make(map[string]string),
+	}
+}
+
+// Settings 현재 한도 설정
+func (s *SessionLimitService) Settings() models.SessionLimitSettings {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.settings()
+}
+
+// Update 한도 설정을 바꿉니다 (사용자별 한도는 요청 값으로 교체)
+// 한도를 낮춰도 이미 실행 중인 세션은 그대로 진행됩니다.
+func (s *SessionLimitService) Update(settings models.SessionLimitSettings) models.SessionLimitStatus {
+	users := make(map[string]int, len(settings.Users))
+	for userID, limit := range settings.Users {
+		users[userID] = limit
+	}
+
+	s.mu.Lock()
+	s.limits = models.SessionLimitSettings{PerUser: settings.PerUser, PerOrg: settings.PerOrg, Users: users}
+	s.mu.Unlock()
+
+	log.Printf("동시 실행 세션 한도 변경 (사용자별: %d, 서버 전체: %d, 사용자 지정: %d명)", settings.PerUser, settings.PerOrg, len(users))
+	return s.Status()
+}
+
+// SetDefaults 사용자별 기본 한도와 서버 전체 한도 변경 (설정 다시 읽기 시 사용, 사용자별 한도는 유지)
+func (s *SessionLimitService) SetDefaults(perUser, perOrg int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limits.PerUser = perUser
+	s.limits.PerOrg = perOrg
+}
+
+// Status 한도 설정과 사용 현황
+func (s *SessionLimitService) Status() models.SessionLimitStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	running := make(map[string]int)
+	for _, session := range s.sessions {
+		if session.userID != "" {
+			running[session.userID]++
+		}
+	}
+
+	status := models.SessionLimitStatus{
+		Limits: s.settings(),
+		Org:    s.usage(models.SessionLimitScopeOrg, ""),
+		Users:  make([]models.SessionLimitUsage, 0, len(running)),
+	}
+	for userID := range running {
+		status.Users = append(status.Users, s.usage(models.SessionLimitScopeUser, userID))
+	}
+	sort.Slice(status.Users, func(i, j int) bool { return status.Users[i].UserID < status.Users[j].UserID })
+	return status
+}
+
+// OnTaskFinished 종료된 태스크를 세션 사용 현황에서 뺍니다 (TaskFinishListener)
+func (s *SessionLimitService) OnTaskFinished(task *models.Task) {
+	s.release(task.ID)
+}
+
+// admit 태스크의 세션을 실행 중인 세션으로 셉니다
+// 세션이 이미 실행 중이면 한도와 관계없이 받고, 새 세션이 한도를 넘기면 *SessionLimitError를 반환합니다.
+func (s *SessionLimitService) admit(task *models.Task, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[task.SessionID]
+	if !ok {
+		if usage := s.usage(models.SessionLimitScopeOrg, ""); usage.Limit > 0 && usage.Running >= usage.Limit {
+			return &SessionLimitError{Usage: usage}
+		}
+		if userID != "" {
+			if usage := s.usage(models.SessionLimitScopeUser, userID); usage.Limit > 0 && usage.Running >= usage.Limit {
+				return &SessionLimitError{Usage: usage}
+			}
+		}
+		session = &runningSession{userID: userID, tasks: make(map[string]bool)}
+		s.sessions[task.SessionID] = session
+	}
+	session.tasks[task.ID] = true
+	s.tasks[task.ID] = task.SessionID
+	return nil
+}
+
+// release 태스크를 사용 현황에서 빼고, 세션에 남은 태스크가 없으면 세션도 뺍니다 (여러 번 호출해도 됨)
+func (s *SessionLimitService) release(taskID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessionID, ok := s.tasks[taskID]
+	if !ok {
+		return
+	}
+	delete(s.tasks, taskID)
+	if session, ok := s.sessions[sessionID]; ok {
+		delete(session.tasks, taskID)
+		if len(session.tasks) == 0 {
+			delete(s.sessions, sessionID)
+		}
+	}
+}
+
+// usage 한도 범위의 실행 중인 세션 수와 한도 (mu를 잡은 상태에서 호출)
+func (s *SessionLimitService) usage(scope, userID string) models.SessionLimitUsage {
+	if scope == models.SessionLimitScopeOrg {
+		return models.SessionLimitUsage{Scope: scope, Running: len(s.sessions), Limit: s.limits.PerOrg}
+	}
+
+	usage := models.SessionLimitUsage{Scope: scope, UserID: userID, Limit: s.limits.PerUser}
+	if limit, ok := s.limits.Users[userID]; ok {
+		usage.Limit = limit
+	}
+	for _, session := range s.sessions {
+		if session.userID == userID {
+			usage.Running++
+		}
+	}
+	return usage
+}
+
+// settings 한도 설정 복사본 (mu를 잡은 상태에서 호출)
+func (s *SessionLimitService) settings() models.SessionLimitSettings {
+	settings := s.limits
+	if len(s.limits.Users) > 0 {
+		settings.Users = make(map[string]int, len(s.limits.Users))
+		for userID, limit := range s.limits.Users {
+			settings.Users[userID] = limit
+		}
+	}
+	return settings
+}
+
+// SetSessionLimits 동시 실행 세션 한도 설정 (서비스 시작 전에 설정)
+func (ts *TaskService) SetSessionLimits(limits *SessionLimitService) {
+	ts.sessionLimits = limits
+	ts.AddFinishListener(limits)
+}
+
+// admitSession 저장한 태스크의 세션이 동시 실행 세션 한도 안인지 확인하고 사용 현황에 더합니다
+// userID가 비어 있으면 세션이 속한 워크스페이스의 소유자에게 셉니다.
+func (ts *TaskService) admitSession(ctx context.Context, task *models.Task, userID string) error {
+	if ts.sessionLimits == nil {
+		return nil
+	}
+	if userID == "" {
+		userID = ts.sessionOwner(ctx, task.SessionID)
+	}
+	return ts.sessionLimits.admit(task, userID)
+}
+
+// releaseSession 제출하지 못한 태스크를 동시 실행 세션 사용 현황에서 뺍니다
+func (ts *TaskService) releaseSession(taskID string) {
+	if ts.sessionLimits != nil {
+		ts.sessionLimits.release(taskID)
+	}
+}
+
+// sessionOwner 세션이 속한 워크스페이스의 소유자 ID (찾을 수 없으면 빈 문자열)
+func (ts *TaskService) sessionOwner(ctx context.Context, sessionID string) string {
+	session, err := ts.storage.Session().GetByID(ctx, sessionID)
+	if err != nil {
+		return ""
+	}
+	workspaceID := ts.workspaceID(ctx, session)
+	if workspaceID == "" {
+		return ""
+	}
+	workspace, err := ts.storage.Workspace().GetByID(ctx, workspaceID)
+	if err != nil {
+		return ""
+	}
+	return workspace.OwnerID
+}
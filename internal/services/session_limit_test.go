@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestSessionLimit_AdmitsTasksPerSession(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	taskService := NewTaskService(store, NewSessionService(store, NewProjectService(store), nil), nil)
+	require.NoError(t, taskService.Start(ctx))
+	defer taskService.Stop()
+
+	// 유지보수 중 보관해 태스크가 끝나지 않도록 함
+	maintenance := NewMaintenanceService(MaintenanceConfig{QueueSubmissions: true})
+	taskService.SetMaintenance(maintenance)
+	maintenance.Enable("", 0, true)
+
+	limits := NewSessionLimitService(1, 2)
+	taskService.SetSessionLimits(limits)
+
+	alice := seedAnalyticsSession(t, store, "alice-app", "alice")
+	aliceOther := seedAnalyticsSession(t, store, "alice-tools", "alice")
+	bob := seedAnalyticsSession(t, store, "bob-app", "bob")
+	carol := seedAnalyticsSession(t, store, "carol-app", "carol")
+	create := func(session *models.Session) (*models.Task, error) {
+		return taskService.Create(ctx, &models.TaskCreateRequest{SessionID: session.ID, Command: "claude -p work"})
+	}
+
+	first, err := create(alice)
+	require.NoError(t, err)
+	// 이미 실행 중인 세션의 태스크는 한도와 관계없이 받음
+	_, err = create(alice)
+	require.NoError(t, err)
+
+	_, err = create(aliceOther)
+	require.ErrorIs(t, err, ErrSessionLimitExceeded)
+	var limitErr *SessionLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, models.SessionLimitUsage{Scope: models.SessionLimitScopeUser, UserID: "alice", Running: 1, Limit: 1}, limitErr.Usage)
+
+	_, err = create(bob)
+	require.NoError(t, err)
+	_, err = create(carol)
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, models.SessionLimitUsage{Scope: models.SessionLimitScopeOrg, Running: 2, Limit: 2}, limitErr.Usage)
+
+	// 거부된 태스크는 저장하지 않음
+	active := true
+	tasks, _, err := store.Task().List(ctx, &models.TaskFilter{Active: &active}, &models.PaginationRequest{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 3)
+
+	status := limits.Status()
+	assert.Equal(t, 2, status.Org.Running)
+	require.Len(t, status.Users, 2)
+	assert.Equal(t, "alice", status.Users[0].UserID)
+	assert.Equal(t, 1, status.Users[0].Running)
+
+	// 사용자별 한도를 늘려도 서버 전체 한도는 그대로 적용
+	limits.Update(models.SessionLimitSettings{PerUser: 1, PerOrg: 2, Users: map[string]int{"alice": 0}})
+	_, err = create(aliceOther)
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, models.SessionLimitScopeOrg, limitErr.Usage.Scope)
+
+	// 세션의 태스크가 모두 끝나야 세션 자리가 빔
+	require.NoError(t, taskService.Cancel(ctx, first.ID))
+	_, err = create(aliceOther)
+	require.ErrorIs(t, err, ErrSessionLimitExceeded)
+
+	tasks, _, err = store.Task().List(ctx, &models.TaskFilter{SessionID: &alice.ID, Active: &active}, &models.PaginationRequest{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.NoError(t, taskService.Cancel(ctx, tasks[0].ID))
+	_, err = create(aliceOther)
+	require.NoError(t, err)
+	assert.Equal(t, 2, limits.Status().Org.Running)
+}
+
+func TestSessionLimit_ChargesRequestingUser(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	taskService := NewTaskService(store, NewSessionService(store, NewProjectService(store), nil), nil)
+	require.NoError(t, taskService.Start(ctx))
+	defer taskService.Stop()
+
+	maintenance := NewMaintenanceService(MaintenanceConfig{QueueSubmissions: true})
+	taskService.SetMaintenance(maintenance)
+	maintenance.Enable("", 0, true)
+
+	limits := NewSessionLimitService(1, 0)
+	taskService.SetSessionLimits(limits)
+
+	// 협업자 bob이 alice 소유 워크스페이스의 세션에서 실행
+	app := seedAnalyticsSession(t, store, "alice-app", "alice")
+	tools := seedAnalyticsSession(t, store, "alice-tools", "alice")
+	docs := seedAnalyticsSession(t, store, "alice-docs", "alice")
+	create := func(session *models.Session, userID string) (*models.Task, error) {
+		return taskService.Create(ctx, &models.TaskCreateRequest{SessionID: session.ID, Command: "claude -p work", UserID: userID})
+	}
+
+	_, err := create(app, "bob")
+	require.NoError(t, err)
+
+	_, err = create(tools, "bob")
+	var limitErr *SessionLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, models.SessionLimitUsage{Scope: models.SessionLimitScopeUser, UserID: "bob", Running: 1, Limit: 1}, limitErr.Usage)
+
+	// 소유자는 협업자의 세션으로 한도가 차지 않음
+	_, err = create(docs, "alice")
+	require.NoError(t, err)
+
+	status := limits.Status()
+	require.Len(t, status.Users, 2)
+	for _, usage := range status.Users {
+		assert.Equal(t, 1, usage.Running, usage.UserID)
+	}
+
+	// 협업자별 한도를 늘리면 그 협업자만 더 실행
+	limits.Update(models.SessionLimitSettings{PerUser: 1, Users: map[string]int{"bob": 2}})
+	_, err = create(tools, "bob")
+	require.NoError(t, err)
+}
+
+func TestSessionLimit_Unlimited(t *testing.T) {
+	limits := NewSessionLimitService(0, 0)
+	for i, sessionID := range []string{"s1", "s2", "s3"} {
+		task := &models.Task{BaseModel: models.BaseModel{ID: sessionID + "-task"}, SessionID: sessionID}
+		require.NoError(t, limits.admit(task, "alice"), i)
+	}
+	assert.Equal(t, 3, limits.Status().Org.Running)
+
+	limits.OnTaskFinished(&models.Task{BaseModel: models.BaseModel{ID: "s1-task"}})
+	limits.OnTaskFinished(&models.Task{BaseModel: models.BaseModel{ID: "s1-task"}})
+	assert.Equal(t, 2, limits.Status().Org.Running)
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// shareTokenPrefix 공유 토큰 접두어 (로그와 설정에서 구분하기 위함)
+	shareTokenPrefix = "shr_"
+
+	// defaultShareLinkTTL 공유 링크 기본 유효 기간
+	defaultShareLinkTTL = 24 * time.Hour
+
+	// maxShareLinkTTL 공유 링크 최대 유효 기간
+	maxShareLinkTTL = 30 * 24 * time.Hour
+)
+
+// 공유 링크 검증 에러
+var (
+	ErrShareLinkInvalid = errors.New("invalid share link")
+	ErrShareLinkExpired = errors.New("share link expired")
+	ErrShareLinkRevoked = errors.New("share link revoked")
+)
+
+// SessionShareService 세션 공유 링크 발급, 검증, 폐기와 참여 기록을 담당하는 서비스
+// 토큰 원문은 발급 시 한 번만 반환하며 스토리지에는 SHA-256 해시만 저장합니다.
+type SessionShareService struct {
+	storage storage.Storage
+}
+
+// NewSessionShareService 새 세션 공유 서비스 생성
+func NewSessionShareService(storage storage.Storage) *SessionShareService {
+	return &SessionShareService{storage: storage}
+}
+
+// Create 세션 공유 링크 생성 (워크스페이스 소유자 또는 admin)
+func (s *SessionShareService) Create(ctx context.Context, sessionID, userID string, admin bool, req *models.CreateShareLinkRequest) (*models.CreateShareLinkResponse, error) {
+	workspaceID, err := s.authorize(ctx, sessionID, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := req.Scope
+	if scope == "" {
+		scope = models.ShareScopeRead
+	}
+	if !scope.IsValid() {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 공유 권한 범위입니다", nil)
+	}
+
+	ttl := defaultShareLinkTTL
+	if req.ExpiresIn > 0 {
+		ttl = time.Duration(req.ExpiresIn) * time.Second
+	}
+	if ttl > maxShareLinkTTL {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "공유 링크 유효 기간은 30일을 넘을 수 없습니다", nil)
+	}
+
+	link, token, err := s.IssueShareLink(ctx, sessionID, workspaceID, userID, scope, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CreateShareLinkResponse{
+		Link:  link,
+		Token: token,
+		URL:   fmt.Sprintf("/ws/session/%s?share_token=%s", sessionID, token),
+	}, nil
+}
+
+// IssueShareLink 권한 확인 없이 공유 링크 발급 (호출자가 권한을 확인한 경우)
+func (s *SessionShareService) IssueShareLink(ctx context.Context, sessionID, workspaceID, createdBy string, scope models.ShareScope, ttl time.Duration) (*models.SessionShareLink, string, error) {
+	token, err := newShareToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	link := &models.SessionShareLink{
+		SessionID:   sessionID,
+		WorkspaceID: workspaceID,
+		CreatedBy:   createdBy,
+		TokenHash:   hashShareToken(token),
+		Scope:       scope,
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+	}
+	if err := s.storage.ShareLink().Create(ctx, link); err != nil {
+		return nil, "", err
+	}
+	return link, token, nil
+}
+
+// List 세션의 공유 링크 목록 조회
+func (s *SessionShareService) List(ctx context.Context, sessionID, userID string, admin bool) ([]*models.SessionShareLink, error) {
+	if _, err := s.authorize(ctx, sessionID, userID, admin); err != nil {
+		return nil, err
+	}
+	return s.storage.ShareLink().ListBySession(ctx, sessionID)
+}
+
+// Revoke 공유 링크 폐기 (이후 토큰 검증이 실패하며, 이미 연결된 참여자는 호출자가 종료)
+func (s *SessionShareService) Revoke(ctx context.Context, sessionID, linkID, userID string, admin bool) error {
+	if _, err := s.authorize(ctx, sessionID, userID, admin); err != nil {
+		return err
+	}
+
+	link, err := s.storage.ShareLink().GetByID(ctx, linkID)
+	if err != nil || link.SessionID != sessionID {
+		return NewWorkspaceError(ErrCodeNotFound, "공유 링크를 찾을 수 없습니다", nil)
+	}
+	return s.storage.ShareLink().Revoke(ctx, linkID, time.Now())
+}
+
+// ListJoins 세션의 공유 링크 참여 기록 조회
+func (s *SessionShareService) ListJoins(ctx context.Context, sessionID, userID string, admin bool, paging *models.PaginationRequest) (*models.PaginationResponse, error) {
+	if _, err := s.authorize(ctx, sessionID, userID, admin); err != nil {
+		return nil, err
+	}
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	joins, total, err := s.storage.ShareLink().ListJoins(ctx, sessionID, paging)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PaginationResponse{
+		Data: joins,
+		Meta: models.NewPaginationMeta(paging.Page, paging.Limit, total),
+	}, nil
+}
+
+// ValidateShareToken 공유 토큰이 세션에 대해 유효한지 확인
+func (s *SessionShareService) ValidateShareToken(ctx context.Context, token, sessionID string) (*models.SessionShareLink, error) {
+	if !strings.HasPrefix(token, shareTokenPrefix) {
+		return nil, ErrShareLinkInvalid
+	}
+
+	hash := hashShareToken(token)
+	link, err := s.storage.ShareLink().GetByTokenHash(ctx, hash)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, ErrShareLinkInvalid
+		}
+		return nil, err
+	}
+	if link.SessionID != sessionID {
+		return nil, ErrShareLinkInvalid
+	}
+	if link.IsRevoked() {
+		return nil, ErrShareLinkRevoked
+	}
+	if link.IsExpired(time.Now()) {
+		return nil, ErrShareLinkExpired
+	}
+	return link, nil
+}
+
+// RecordShareJoin 공유 링크를 통한 참여 감사 기록
+func (s *SessionShareService) RecordShareJoin(ctx context.Context, join *models.SessionShareJoin) error {
+	return s.storage.ShareLink().RecordJoin(ctx, join)
+}
+
+// SharedTranscript 공유 링크로 접근한 세션의 대화 기록 조회
+// 링크는 middleware.RequireShareToken에서 검증된 것이어야 합니다.
+func (s *SessionShareService) SharedTranscript(ctx context.Context, link *models.SessionShareLink, paging *models.PaginationRequest) (*models.PaginationResponse, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	messages, total, err := s.storage.Message().ListBySession(ctx, link.SessionID, paging)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PaginationResponse{
+		Data: messages,
+		Meta: models.NewPaginationMeta(paging.Page, paging.Limit, total),
+	}, nil
+}
+
+// authorize 세션의 워크스페이스를 찾고 공유 링크 관리 권한(admin) 확인
+func (s *SessionShareService) authorize(ctx context.Context, sessionID, userID string, admin bool) (string, error) {
+	workspaceID, err := sessionWorkspaceID(ctx, s.storage, sessionID)
+	if err != nil {
+		return "", err
+	}
+	if !admin {
+		if err := checkSessionWorkspaceAccess(ctx, s.storage, workspaceID, userID, models.WorkspacePermissionAdmin); err != nil {
+			return "", err
+		}
+	}
+	return workspaceID, nil
+}
+
+// newShareToken 추측할 수 없는 공유 토큰 생성
+func newShareToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("공유 토큰 생성 실패: %w", err)
+	}
+	return shareTokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashShareToken 저장용 토큰 해시
+func hashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
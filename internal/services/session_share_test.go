@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestSessionShareService_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewSessionShareService(store)
+
+	ws := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp"}
+	require.NoError(t, store.Workspace().Create(ctx, ws))
+	createWorkspaceSession(t, store, ws.ID, "s1")
+	require.NoError(t, NewMessageService(store).Record(ctx, &models.SessionMessage{SessionID: "s1", WorkspaceID: ws.ID, Role: models.MessageRoleUser, Content: "hello"}))
+
+	// 워크스페이스 소유자만 링크 발급
+	_, err := svc.Create(ctx, "s1", "bob", false, &models.CreateShareLinkRequest{})
+	assert.Error(t, err)
+	_, err = svc.Create(ctx, "s1", "alice", false, &models.CreateShareLinkRequest{Scope: "owner"})
+	assert.Error(t, err)
+	_, err = svc.Create(ctx, "s1", "alice", false, &models.CreateShareLinkRequest{ExpiresIn: int64((31 * 24 * time.Hour).Seconds())})
+	assert.Error(t, err)
+
+	resp, err := svc.Create(ctx, "s1", "alice", false, &models.CreateShareLinkRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, models.ShareScopeRead, resp.Link.Scope)
+	assert.NotContains(t, resp.Link.TokenHash, resp.Token)
+	assert.Contains(t, resp.URL, resp.Token)
+
+	link, err := svc.ValidateShareToken(ctx, resp.Token, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, resp.Link.ID, link.ID)
+
+	// 다른 세션이나 위조된 토큰은 거부
+	_, err = svc.ValidateShareToken(ctx, resp.Token, "s2")
+	assert.ErrorIs(t, err, ErrShareLinkInvalid)
+	_, err = svc.ValidateShareToken(ctx, resp.Token+"x", "s1")
+	assert.ErrorIs(t, err, ErrShareLinkInvalid)
+
+	// 참여 기록
+	require.NoError(t, svc.RecordShareJoin(ctx, &models.SessionShareJoin{LinkID: link.ID, SessionID: "s1", Channel: "rest"}))
+	joins, err := svc.ListJoins(ctx, "s1", "alice", false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, joins.Meta.Total)
+
+	links, err := svc.List(ctx, "s1", "alice", false)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, int64(1), links[0].JoinCount)
+
+	transcript, err := svc.SharedTranscript(ctx, link, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, transcript.Meta.Total)
+
+	// 폐기 후 검증 실패
+	assert.Error(t, svc.Revoke(ctx, "s1", link.ID, "bob", false))
+	assert.Error(t, svc.Revoke(ctx, "s1", "missing", "alice", false))
+	require.NoError(t, svc.Revoke(ctx, "s1", link.ID, "alice", false))
+	_, err = svc.ValidateShareToken(ctx, resp.Token, "s1")
+	assert.ErrorIs(t, err, ErrShareLinkRevoked)
+}
+
+func TestSessionShareService_Expired(t *testing.T) {
+	ctx := context.Background()
+	svc := NewSessionShareService(memory.New())
+
+	_, token, err := svc.IssueShareLink(ctx, "s1", "ws1", "alice", models.ShareScopeInteractive, -time.Minute)
+	require.NoError(t, err)
+
+	_, err = svc.ValidateShareToken(ctx, token, "s1")
+	assert.ErrorIs(t, err, ErrShareLinkExpired)
+}
+
+func TestSessionShareService_IgnoresMessageWorkspace(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	svc := NewSessionShareService(store)
+
+	alice := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp"}
+	require.NoError(t, store.Workspace().Create(ctx, alice))
+	mallory := &models.Workspace{Name: "mallory-ws", OwnerID: "mallory", ProjectPath: "/tmp"}
+	require.NoError(t, store.Workspace().Create(ctx, mallory))
+	createWorkspaceSession(t, store, alice.ID, "s1")
+
+	// 다른 워크스페이스 ID로 기록된 메시지가 있어도 세션의 프로젝트 기준으로 권한 판단
+	require.NoError(t, NewMessageService(store).Record(ctx, &models.SessionMessage{SessionID: "s1", WorkspaceID: mallory.ID, Role: models.MessageRoleUser, Content: "hi"}))
+	_, err := svc.Create(ctx, "s1", "mallory", false, &models.CreateShareLinkRequest{})
+	assert.Error(t, err)
+
+	resp, err := svc.Create(ctx, "s1", "alice", false, &models.CreateShareLinkRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, resp.Link.WorkspaceID)
+
+	// 저장소에 없는 세션은 메시지만으로 링크를 발급할 수 없음
+	require.NoError(t, NewMessageService(store).Record(ctx, &models.SessionMessage{SessionID: "ghost", WorkspaceID: mallory.ID, Role: models.MessageRoleUser, Content: "hi"}))
+	_, err = svc.Create(ctx, "ghost", "mallory", false, &models.CreateShareLinkRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
+
+// createWorkspaceSession 워크스페이스에 프로젝트와 세션을 만들어 세션 → 프로젝트 → 워크스페이스 조회가 되도록 함
+func createWorkspaceSession(t *testing.T, store *memory.Storage, workspaceID, sessionID string) {
+	t.Helper()
+	ctx := context.Background()
+	project := &models.Project{WorkspaceID: workspaceID, Name: "app-" + sessionID, Path: "/tmp/app-" + sessionID}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	session.ID = sessionID
+	require.NoError(t, store.Session().Create(ctx, session))
+}
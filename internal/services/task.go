@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"os/exec"
 	"strings"
 	"time"
 
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/logging"
 	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/plugin"
 	"github.com/aicli/aicli-web/internal/queue"
 	"github.com/aicli/aicli-web/internal/storage"
 )
@@ -19,15 +23,90 @@ type TaskService struct {
 	sessionService *SessionService
 	taskQueue      *queue.TaskQueue
 	config         *TaskServiceConfig
+	plugins        *plugin.Manager
+	runner         TaskRunner
+	maintenance    *MaintenanceService
+	finishListeners []TaskFinishListener
+	reviews        TaskReviewGate
+	events         TaskEventPublisher
+	quota          *claude.QuotaGovernor
+	redactor       *claude.OutputRedactor
+	environment    TaskEnvironment
+	snapshots      TaskSnapshotter
+	diskQuota      TaskDiskQuota
+	admission      *HostAdmissionService
+	sessionLimits  *SessionLimitService
+	languages      claude.ResponseLanguageProvider
+	logger         logging.Logger
+}
+
+// TaskFinishListener 태스크가 완료, 실패, 취소 상태가 되었을 때 알림을 받는 리스너 (파이프라인 진행용)
+type TaskFinishListener interface {
+	OnTaskFinished(task *models.Task)
+}
+
+// TaskReviewGate 검토가 필요한 태스크를 제출하기 전후의 처리 (*TaskReviewService)
+type TaskReviewGate interface {
+	// Prepare 태스크 실행 전 작업 트리를 확인하고 검토를 기록
+	Prepare(ctx context.Context, task *models.Task) error
+	// Discard 제출하지 못한 태스크의 검토 삭제
+	Discard(ctx context.Context, taskID string)
+	// ShadowPath 드라이런 태스크가 실행될 섀도 복사본 경로
+	ShadowPath(ctx context.Context, taskID string) (string, error)
+}
+
+// TaskSnapshotter 태스크 실행 직전 워크스페이스 스냅샷 생성 (*WorkspaceSnapshotService)
+type TaskSnapshotter interface {
+	SnapshotBeforeTask(ctx context.Context, workspaceID string, task *models.Task) (*models.WorkspaceSnapshot, error)
+}
+
+// TaskDiskQuota 워크스페이스 디스크 한도 확인 (*WorkspaceDiskQuotaService)
+type TaskDiskQuota interface {
+	// CheckTask 한도에 도달했으면 *DiskQuotaError 반환
+	CheckTask(ctx context.Context, workspaceID string) error
+}
+
+// TaskEventPublisher 태스크 상태 변경을 이벤트 스트림에 발행 (*TaskEventService)
+// 종료 상태는 TaskFinishListener로 전달되므로 생성과 실행 시작만 발행합니다.
+type TaskEventPublisher interface {
+	PublishStatus(task *models.Task)
+}
+
+// TaskEnvironment 태스크 프로세스에 전달할 워크스페이스 환경 변수 (*WorkspaceEnvService)
+type TaskEnvironment interface {
+	// Environment 워크스페이스의 변수 (비밀 변수는 복호화한 값)
+	Environment(ctx context.Context, workspaceID string) (map[string]string, error)
+	// MaskSecrets 출력에 나타난 워크스페이스 비밀 변수 값을 가림
+	MaskSecrets(ctx context.Context, workspaceID, text string) string
+}
+
+// TaskRunner 태스크 명령을 로컬 프로세스 대신 외부 실행 환경(예: Kubernetes Job)에서 실행하는 인터페이스
+type TaskRunner interface {
+	// RunTask 명령을 실행하고 출력을 반환합니다 (컨텍스트에 태스크 시간 제한이 걸려 있음)
+	RunTask(ctx context.Context, run *TaskRun) (string, error)
+}
+
+// TaskRun 외부 실행기에 전달하는 태스크 실행 정보
+type TaskRun struct {
+	TaskID      string
+	SessionID   string
+	WorkspaceID string
+	// Process 로컬 실행과 같은 형식의 프로세스 설정
+	Process *claude.ProcessConfig
 }
 
 // TaskServiceConfig 태스크 서비스 설정
 type TaskServiceConfig struct {
 	MaxWorkers      int           // 최대 워커 수
 	MaxQueueSize    int           // 최대 큐 크기
-	TaskTimeout     time.Duration // 태스크 타임아웃
+	TaskTimeout     time.Duration // 태스크 타임아웃 (단계별 제한이 없을 때 사용)
 	CleanupInterval time.Duration // 정리 주기
 	CleanupMaxAge   time.Duration // 정리할 태스크 최대 나이
+	
+	// TimeoutTiers 단계별 실행 시간 제한 (quick, standard, long)
+	TimeoutTiers map[models.TaskTimeoutTier]time.Duration
+	// CancelGracePeriod 취소 신호(SIGINT) 후 강제 종료까지 대기 시간
+	CancelGracePeriod time.Duration
 }
 
 // DefaultTaskServiceConfig 기본 태스크 서비스 설정
@@ -38,6 +117,12 @@ func DefaultTaskServiceConfig() *TaskServiceConfig {
 		TaskTimeout:     5 * time.Minute,
 		CleanupInterval: 10 * time.Minute,
 		CleanupMaxAge:   1 * time.Hour,
+		TimeoutTiers: map[models.TaskTimeoutTier]time.Duration{
+			models.TaskTimeoutQuick:    30 * time.Second,
+			models.TaskTimeoutStandard: 5 * time.Minute,
+			models.TaskTimeoutLong:     30 * time.Minute,
+		},
+		CancelGracePeriod: 5 * time.Second,
 	}
 }
 
@@ -46,11 +131,15 @@ func NewTaskService(storage storage.Storage, sessionService *SessionService, con
 	if config == nil {
 		config = DefaultTaskServiceConfig()
 	}
+	if config.CancelGracePeriod <= 0 {
+		config.CancelGracePeriod = DefaultTaskServiceConfig().CancelGracePeriod
+	}
 	
 	ts := &TaskService{
 		storage:        storage,
 		sessionService: sessionService,
 		config:         config,
+		logger:         logging.FromLogrus(nil),
 	}
 	
 	// 태스크 큐 초기화
@@ -58,12 +147,73 @@ func NewTaskService(storage storage.Storage, sessionService *SessionService, con
 		MaxWorkers:   config.MaxWorkers,
 		MaxQueueSize: config.MaxQueueSize,
 		Executor:     ts.executeTask,
+		TimeoutFor:   ts.TimeoutFor,
+		OnFinish:     ts.onTaskFinished,
+		OnRequeue:    ts.onTaskRequeued,
 	}
 	ts.taskQueue = queue.NewTaskQueue(queueConfig)
 	
 	return ts
 }
 
+// SetLogger 태스크 서비스 로거 설정 (기본값은 logrus 기본 로거)
+func (ts *TaskService) SetLogger(logger logging.Logger) {
+	ts.logger = logger
+}
+
+// SetPluginManager 태스크 전후 훅을 실행할 플러그인 매니저 설정
+func (ts *TaskService) SetPluginManager(plugins *plugin.Manager) {
+	ts.plugins = plugins
+}
+
+// SetTaskRunner 태스크 명령을 실행할 외부 실행기 설정 (nil이면 로컬 프로세스로 실행)
+func (ts *TaskService) SetTaskRunner(runner TaskRunner) {
+	ts.runner = runner
+}
+
+// AddFinishListener 태스크 종료 알림을 받을 리스너 추가 (서비스 시작 전에 등록)
+func (ts *TaskService) AddFinishListener(listener TaskFinishListener) {
+	ts.finishListeners = append(ts.finishListeners, listener)
+}
+
+// SetReviewGate 검토 워크플로 설정 (설정하지 않으면 require_review 요청을 거부)
+func (ts *TaskService) SetReviewGate(reviews TaskReviewGate) {
+	ts.reviews = reviews
+}
+
+// SetSnapshotter 실행 전 워크스페이스 스냅샷 설정 (설정하지 않으면 snapshot 요청을 거부)
+func (ts *TaskService) SetSnapshotter(snapshots TaskSnapshotter) {
+	ts.snapshots = snapshots
+}
+
+// SetDiskQuota 워크스페이스 디스크 한도 설정 (설정하면 한도에 도달한 워크스페이스의 새 태스크를 거부)
+func (ts *TaskService) SetDiskQuota(quota TaskDiskQuota) {
+	ts.diskQuota = quota
+}
+
+// SetEventPublisher 태스크 상태 이벤트 발행기 설정 (서비스 시작 전에 설정)
+func (ts *TaskService) SetEventPublisher(events TaskEventPublisher) {
+	ts.events = events
+}
+
+// SetOutputRedactor 태스크 출력 마스킹 필터 설정 (저장, 훅, 이벤트 발행 전에 적용)
+func (ts *TaskService) SetOutputRedactor(redactor *claude.OutputRedactor) {
+	ts.redactor = redactor
+}
+
+// SetEnvironment 태스크 프로세스에 전달할 워크스페이스 환경 변수 설정
+func (ts *TaskService) SetEnvironment(environment TaskEnvironment) {
+	ts.environment = environment
+}
+
+// TimeoutFor 태스크의 타임아웃 단계에 해당하는 실행 시간 제한
+func (ts *TaskService) TimeoutFor(task *models.Task) time.Duration {
+	if timeout, ok := ts.config.TimeoutTiers[task.TimeoutTier.OrDefault()]; ok && timeout > 0 {
+		return timeout
+	}
+	return ts.config.TaskTimeout
+}
+
 // Start 태스크 서비스 시작
 func (ts *TaskService) Start(ctx context.Context) error {
 	// 태스크 큐 시작
@@ -78,6 +228,15 @@ func (ts *TaskService) Start(ctx context.Context) error {
 	return nil
 }
 
+// SetMaxWorkers 실행 중에 태스크 워커 수를 변경합니다 (설정 다시 읽기 시 사용)
+func (ts *TaskService) SetMaxWorkers(workers int) error {
+	if err := ts.taskQueue.Resize(workers); err != nil {
+		return err
+	}
+	ts.config.MaxWorkers = workers
+	return nil
+}
+
 // Stop 태스크 서비스 중지
 func (ts *TaskService) Stop() {
 	ts.taskQueue.Stop()
@@ -86,45 +245,147 @@ func (ts *TaskService) Stop() {
 
 // Create 새 태스크 생성
 func (ts *TaskService) Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error) {
-	// 빈 명령어 검증
-	if strings.TrimSpace(req.Command) == "" {
-		return nil, fmt.Errorf("명령어가 비어있습니다")
+	if err := ts.checkMaintenance(); err != nil {
+		return nil, err
 	}
-	
-	// 세션 존재 확인
-	session, err := ts.sessionService.GetByID(ctx, req.SessionID)
-	if err != nil {
-		return nil, fmt.Errorf("세션을 찾을 수 없습니다: %w", err)
-	}
-	
-	// 세션이 활성 상태인지 확인
-	if !session.IsActive() {
-		return nil, fmt.Errorf("세션이 활성 상태가 아닙니다: %s", session.Status)
+	if err := ts.validateCreate(ctx, req); err != nil {
+		return nil, err
 	}
 	
 	// 태스크 생성
-	task := &models.Task{
-		SessionID: req.SessionID,
-		Command:   req.Command,
-		Status:    models.TaskPending,
-	}
+	task := newTask(req)
 	
 	// 데이터베이스에 저장
 	if err := ts.storage.Task().Create(ctx, task); err != nil {
 		return nil, fmt.Errorf("태스크 생성 실패: %w", err)
 	}
 	
-	// 태스크 큐에 제출
-	if err := ts.taskQueue.Submit(task); err != nil {
+	// 동시 실행 세션 한도 확인 (저장한 태스크 ID로 세므로 동시에 들어온 요청도 한도를 넘지 않음)
+	if err := ts.admitSession(ctx, task, req.UserID); err != nil {
+		_ = ts.storage.Task().Delete(ctx, task.ID)
+		return nil, err
+	}
+	
+	// 검토가 필요하면 실행 전 작업 트리 상태를 기록 (드라이런은 항상 검토를 거침)
+	reviewed := requiresReview(req)
+	if reviewed {
+		if err := ts.prepareReview(ctx, task); err != nil {
+			_ = ts.storage.Task().Delete(ctx, task.ID)
+			ts.releaseSession(task.ID)
+			return nil, err
+		}
+	}
+	
+	// 워커가 상태를 바꾸기 전에 대기 상태 발행
+	ts.publishStatus(task)
+	
+	// 태스크 큐에 제출 (유지보수 중이면 보관)
+	if err := ts.submit(task); err != nil {
 		// 큐 제출 실패 시 태스크 삭제
 		_ = ts.storage.Task().Delete(ctx, task.ID)
+		ts.releaseSession(task.ID)
+		if reviewed {
+			ts.reviews.Discard(ctx, task.ID)
+		}
 		return nil, fmt.Errorf("태스크 큐 제출 실패: %w", err)
 	}
 	
-	log.Printf("태스크 생성됨: %s (세션: %s)", task.ID, task.SessionID)
+	ts.logger.WithContext(ctx).WithFields(logging.Fields{
+		"task_id":    task.ID,
+		"session_id": task.SessionID,
+	}).Info("태스크 생성됨")
 	return task, nil
 }
 
+// newTask 생성 요청으로 대기 상태의 태스크를 만듭니다 (단건/일괄 생성 공통)
+func newTask(req *models.TaskCreateRequest) *models.Task {
+	return &models.Task{
+		SessionID:   req.SessionID,
+		Command:     req.Command,
+		Status:      models.TaskPending,
+		TimeoutTier: req.TimeoutTier.OrDefault(),
+		Models:      append([]string(nil), req.Models...),
+		DryRun:      req.DryRun,
+		Snapshot:    req.Snapshot,
+	}
+}
+
+// requiresReview 실행 결과를 검토 워크플로로 보내야 하는지 (드라이런은 항상 검토를 거침)
+func requiresReview(req *models.TaskCreateRequest) bool {
+	return req.RequireReview || req.DryRun
+}
+
+// prepareReview 검토 워크플로에 태스크 등록
+func (ts *TaskService) prepareReview(ctx context.Context, task *models.Task) error {
+	if ts.reviews == nil {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "검토 워크플로가 설정되지 않았습니다", ErrInvalidRequest)
+	}
+	return ts.reviews.Prepare(ctx, task)
+}
+
+// checkMaintenance 유지보수 모드로 새 태스크를 거부해야 하는지 확인
+func (ts *TaskService) checkMaintenance() error {
+	if ts.maintenance == nil {
+		return nil
+	}
+	return ts.maintenance.Check()
+}
+
+// validateCreate 태스크 생성 요청 검증 (명령어, 세션 상태)
+func (ts *TaskService) validateCreate(ctx context.Context, req *models.TaskCreateRequest) error {
+	// 빈 명령어 검증
+	if strings.TrimSpace(req.Command) == "" {
+		return fmt.Errorf("명령어가 비어있습니다")
+	}
+	
+	// 타임아웃 단계 검증
+	if !req.TimeoutTier.IsValid() {
+		return fmt.Errorf("지원하지 않는 타임아웃 단계입니다: %s", req.TimeoutTier)
+	}
+	
+	// 모델 선호 목록 검증
+	if err := validateTaskModels(req.Command, req.Models); err != nil {
+		return err
+	}
+	
+	// 외부 실행기는 섀도 복사본에 접근할 수 없으므로 드라이런은 로컬 실행만 지원
+	if req.DryRun && ts.runner != nil {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "외부 태스크 실행기를 사용하는 서버에서는 드라이런을 지원하지 않습니다", ErrInvalidRequest)
+	}
+	
+	// 스냅샷은 서버의 워크스페이스 디렉토리를 복사하므로 로컬 실행만 지원
+	if req.Snapshot {
+		if ts.snapshots == nil {
+			return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 스냅샷이 설정되지 않았습니다", ErrInvalidRequest)
+		}
+		if ts.runner != nil {
+			return NewWorkspaceError(ErrCodeInvalidRequest, "외부 태스크 실행기를 사용하는 서버에서는 실행 전 스냅샷을 지원하지 않습니다", ErrInvalidRequest)
+		}
+	}
+	
+	// 세션 존재 확인
+	session, err := ts.sessionService.GetByID(ctx, req.SessionID)
+	if err != nil {
+		return fmt.Errorf("세션을 찾을 수 없습니다: %w", err)
+	}
+	
+	// 세션이 활성 상태인지 확인
+	if !session.IsActive() {
+		return fmt.Errorf("세션이 활성 상태가 아닙니다: %s", session.Status)
+	}
+	
+	// 워크스페이스 디스크 한도 확인
+	if ts.diskQuota != nil {
+		if workspaceID := ts.workspaceID(ctx, session); workspaceID != "" {
+			if err := ts.diskQuota.CheckTask(ctx, workspaceID); err != nil {
+				return err
+			}
+		}
+	}
+	
+	return nil
+}
+
 // GetByID ID로 태스크 조회
 func (ts *TaskService) GetByID(ctx context.Context, id string) (*models.Task, error) {
 	if id == "" {
@@ -142,6 +403,11 @@ func (ts *TaskService) GetByID(ctx context.Context, id string) (*models.Task, er
 		return nil, fmt.Errorf("태스크 조회 실패: %w", err)
 	}
 	
+	// 호스트 자원 부족으로 실행을 기다리는 중이면 현재 대기 순서 표시
+	if ts.admission != nil && task.Status == models.TaskPending {
+		task.Admission = ts.admission.position(id)
+	}
+	
 	return task, nil
 }
 
@@ -152,20 +418,32 @@ func (ts *TaskService) List(ctx context.Context, filter *models.TaskFilter, pagi
 		return nil, fmt.Errorf("태스크 목록 조회 실패: %w", err)
 	}
 	
+	meta := models.NewPaginationMeta(paging.Page, paging.Limit, total)
+	if n := len(tasks); n > 0 {
+		meta.NextCursor = paging.NextCursorFor(n, tasks[n-1].ID, tasks[n-1].SortValue(paging.Sort))
+	}
+	
 	return &models.PagingResponse{
 		Data: tasks,
-		Meta: models.NewPaginationMeta(paging.Page, paging.Limit, total),
+		Meta: meta,
 	}, nil
 }
 
 // Cancel 태스크 취소
+// 실행 중인 태스크는 프로세스에 종료 신호를 보내고, 취소 상태를 바로 저장합니다.
 func (ts *TaskService) Cancel(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("태스크 ID가 필요합니다")
 	}
 	
 	// 큐에서 취소 시도
-	if err := ts.taskQueue.Cancel(id); err != nil {
+	if err := ts.taskQueue.Cancel(id); err == nil {
+		if task, exists := ts.taskQueue.GetTask(id); exists {
+			if updateErr := ts.storage.Task().Update(ctx, task); updateErr != nil {
+				ts.logger.WithContext(ctx).WithField("task_id", id).WithError(updateErr).Error("태스크 취소 상태 저장 실패")
+			}
+		}
+	} else {
 		// 큐에 없으면 데이터베이스에서 조회하여 취소
 		task, dbErr := ts.storage.Task().GetByID(ctx, id)
 		if dbErr != nil {
@@ -180,9 +458,14 @@ func (ts *TaskService) Cancel(ctx context.Context, id string) error {
 		if updateErr := ts.storage.Task().Update(ctx, task); updateErr != nil {
 			return fmt.Errorf("태스크 취소 업데이트 실패: %w", updateErr)
 		}
+		if ts.admission != nil {
+			ts.admission.forget(id)
+		}
+		// 큐를 거치지 않았으므로 종료 알림을 직접 전달
+		ts.notifyFinished(task)
 	}
 	
-	log.Printf("태스크 취소됨: %s", id)
+	ts.logger.WithContext(ctx).WithField("task_id", id).Info("태스크 취소됨")
 	return nil
 }
 
@@ -226,19 +509,62 @@ func (ts *TaskService) UpdateStatus(ctx context.Context, id string, status model
 
 // executeTask 태스크 실행 (큐에서 호출됨)
 func (ts *TaskService) executeTask(ctx context.Context, task *models.Task) (string, error) {
-	log.Printf("태스크 실행 시작: %s", task.ID)
+	// 실행 중 기록하는 로그와 하위 서비스 로그에 세션, 워크스페이스 ID를 남김
+	ctx = logging.WithSessionID(ctx, task.SessionID)
+	ts.logger.WithContext(ctx).WithField("task_id", task.ID).Info("태스크 실행 시작")
+	ts.publishStatus(task)
 	
 	// 세션 정보 조회
 	session, err := ts.sessionService.GetByID(ctx, task.SessionID)
 	if err != nil {
 		return "", fmt.Errorf("세션 조회 실패: %w", err)
 	}
+	ctx = logging.WithWorkspaceID(ctx, ts.workspaceID(ctx, session))
 	
 	// 세션 활동 업데이트
 	_ = ts.sessionService.UpdateActivity(ctx, session.ID)
 	
-	// 실제 명령 실행
-	output, err := ts.executeCommand(ctx, task.Command, session)
+	// 실행 전 워크스페이스 스냅샷 (만들지 못하면 명령을 실행하지 않음)
+	if task.Snapshot {
+		if err := ts.snapshotBeforeTask(ctx, task, session); err != nil {
+			_ = ts.sessionService.UpdateStats(ctx, session.ID, 1, int64(len(task.Command)), 0, 1)
+			return "", err
+		}
+	}
+	
+	// 실행 전 훅 (중단 요청 시 명령을 실행하지 않음)
+	hookReq := ts.hookRequest(ctx, task, session)
+	if hookReq != nil {
+		hookReq.Hook = plugin.HookPreTask
+		results, hookErr := ts.plugins.Run(ctx, hookReq)
+		if hookErr != nil {
+			_ = ts.sessionService.UpdateStats(ctx, session.ID, 1, int64(len(task.Command)), 0, 1)
+			return plugin.FormatResults(results), hookErr
+		}
+	}
+	
+	// 실제 명령 실행 (모델 전환과 자격 증명 판단이 끝난 출력을 워크스페이스 정책으로 마스킹)
+	output, err := ts.runWithModels(ctx, task, session)
+	if ts.redactor != nil {
+		output = ts.redactor.Redact(ts.workspaceID(ctx, session), output)
+	}
+	if ts.environment != nil {
+		output = ts.environment.MaskSecrets(ctx, ts.workspaceID(ctx, session), output)
+	}
+	
+	// 실행 후 훅 (결과는 출력에 덧붙임)
+	if hookReq != nil {
+		hookReq.Hook = plugin.HookPostTask
+		hookReq.Task.Output = output
+		if err != nil {
+			hookReq.Task.Error = err.Error()
+		}
+		results, hookErr := ts.plugins.Run(ctx, hookReq)
+		output += plugin.FormatResults(results)
+		if err == nil {
+			err = hookErr
+		}
+	}
 	
 	// 통계 업데이트
 	if err == nil {
@@ -249,18 +575,77 @@ func (ts *TaskService) executeTask(ctx context.Context, task *models.Task) (stri
 	
 	// 데이터베이스 업데이트
 	if err := ts.storage.Task().Update(ctx, task); err != nil {
-		log.Printf("태스크 업데이트 실패: %v", err)
+		ts.logger.WithContext(ctx).WithField("task_id", task.ID).WithError(err).Error("태스크 업데이트 실패")
 	}
 	
 	return output, err
 }
 
-// executeCommand 명령 실행
-func (ts *TaskService) executeCommand(ctx context.Context, command string, session *models.Session) (string, error) {
-	// 타임아웃 컨텍스트 생성
-	cmdCtx, cancel := context.WithTimeout(ctx, ts.config.TaskTimeout)
-	defer cancel()
+// snapshotBeforeTask 세션 프로젝트가 속한 워크스페이스의 스냅샷 생성
+func (ts *TaskService) snapshotBeforeTask(ctx context.Context, task *models.Task, session *models.Session) error {
+	if ts.snapshots == nil {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 스냅샷이 설정되지 않았습니다", ErrInvalidRequest)
+	}
+	workspaceID := ts.workspaceID(ctx, session)
+	if workspaceID == "" {
+		return fmt.Errorf("스냅샷할 워크스페이스를 찾을 수 없습니다: 세션 %s", session.ID)
+	}
+	if _, err := ts.snapshots.SnapshotBeforeTask(ctx, workspaceID, task); err != nil {
+		return fmt.Errorf("실행 전 워크스페이스 스냅샷 실패: %w", err)
+	}
+	return nil
+}
+
+// onTaskFinished 큐에서 종료된 태스크의 최종 상태를 저장하고 리스너에 알림
+func (ts *TaskService) onTaskFinished(task *models.Task) {
+	if err := ts.storage.Task().Update(context.Background(), task); err != nil {
+		ts.logger.WithFields(logging.Fields{
+			"task_id":    task.ID,
+			"session_id": task.SessionID,
+		}).WithError(err).Error("태스크 최종 상태 저장 실패")
+	}
+	ts.notifyFinished(task)
+}
+
+// notifyFinished 종료된 태스크를 리스너들에 전달
+func (ts *TaskService) notifyFinished(task *models.Task) {
+	for _, listener := range ts.finishListeners {
+		listener.OnTaskFinished(task)
+	}
+}
+
+// publishStatus 태스크 상태 이벤트 발행 (발행기가 없으면 무시)
+func (ts *TaskService) publishStatus(task *models.Task) {
+	if ts.events != nil {
+		ts.events.PublishStatus(task)
+	}
+}
+
+// hookRequest 플러그인 훅 요청 생성 (플러그인 매니저가 없으면 nil)
+func (ts *TaskService) hookRequest(ctx context.Context, task *models.Task, session *models.Session) *plugin.HookRequest {
+	if ts.plugins == nil {
+		return nil
+	}
 	
+	req := &plugin.HookRequest{
+		ProjectID: session.ProjectID,
+		Task: plugin.TaskInfo{
+			ID:        task.ID,
+			SessionID: task.SessionID,
+			Command:   task.Command,
+		},
+	}
+	if session.ProjectID != "" {
+		if project, err := ts.storage.Project().GetByID(ctx, session.ProjectID); err == nil {
+			req.WorkspaceID = project.WorkspaceID
+			req.ProjectPath = project.Path
+		}
+	}
+	return req
+}
+
+// executeCommand 명령 실행 (credential이 있으면 해당 자격 증명으로 인증)
+func (ts *TaskService) executeCommand(ctx context.Context, task *models.Task, command string, credential *claude.QuotaCredential, session *models.Session) (string, error) {
 	// 명령어 파싱
 	parts := strings.Fields(command)
 	if len(parts) == 0 {
@@ -272,16 +657,51 @@ func (ts *TaskService) executeCommand(ctx context.Context, command string, sessi
 		return "", err
 	}
 	
+	// 사용자 응답 언어 지시문 (claude 명령만)
+	parts = ts.argsWithResponseLanguage(ctx, parts, session)
+	
 	// 명령 실행
 	var cmd *exec.Cmd
 	if len(parts) == 1 {
-		cmd = exec.CommandContext(cmdCtx, parts[0])
+		cmd = exec.CommandContext(ctx, parts[0])
 	} else {
-		cmd = exec.CommandContext(cmdCtx, parts[0], parts[1:]...)
+		cmd = exec.CommandContext(ctx, parts[0], parts[1:]...)
 	}
 	
-	// 작업 디렉토리 설정 (프로젝트 경로)
-	if session.ProjectID != "" {
+	// 취소나 시간 초과 시 먼저 SIGINT로 정리할 기회를 주고, 유예 시간이 지나면 강제 종료
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
+	cmd.WaitDelay = ts.config.CancelGracePeriod
+	
+	// 워크스페이스 환경 변수와 쿼터 거버너가 고른 자격 증명 전달 (자격 증명이 우선)
+	env, err := ts.taskEnvironment(ctx, session)
+	if err != nil {
+		return "", err
+	}
+	if credential != nil || len(env) > 0 {
+		cmd.Env = os.Environ()
+		for key, value := range env {
+			cmd.Env = append(cmd.Env, key+"="+value)
+		}
+	}
+	if credential != nil {
+		for key, value := range credential.Environment() {
+			cmd.Env = append(cmd.Env, key+"="+value)
+		}
+	}
+	
+	// 작업 디렉토리 설정 (드라이런이면 섀도 복사본, 아니면 프로젝트 경로)
+	if task.DryRun {
+		if ts.reviews == nil {
+			return "", NewWorkspaceError(ErrCodeInvalidRequest, "검토 워크플로가 설정되지 않았습니다", ErrInvalidRequest)
+		}
+		shadow, err := ts.reviews.ShadowPath(ctx, task.ID)
+		if err != nil {
+			return "", err
+		}
+		cmd.Dir = shadow
+	} else if session.ProjectID != "" {
 		project, err := ts.storage.Project().GetByID(ctx, session.ProjectID)
 		if err == nil && project.Path != "" {
 			cmd.Dir = project.Path
@@ -297,6 +717,70 @@ func (ts *TaskService) executeCommand(ctx context.Context, command string, sessi
 	return string(output), nil
 }
 
+// runTask 외부 실행기로 명령 실행 (credential이 있으면 해당 자격 증명으로 인증)
+func (ts *TaskService) runTask(ctx context.Context, task *models.Task, command string, credential *claude.QuotaCredential, session *models.Session) (string, error) {
+	parts := strings.Fields(command)
+	if len(parts) == 0 {
+		return "", fmt.Errorf("빈 명령어입니다")
+	}
+	if err := ts.validateCommand(parts[0]); err != nil {
+		return "", err
+	}
+	parts = ts.argsWithResponseLanguage(ctx, parts, session)
+	
+	run := &TaskRun{
+		TaskID:    task.ID,
+		SessionID: session.ID,
+		Process: &claude.ProcessConfig{
+			Command: parts[0],
+			Args:    parts[1:],
+		},
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		run.Process.Timeout = time.Until(deadline)
+	}
+	if credential != nil {
+		run.Process.OAuthToken = credential.OAuthToken
+		run.Process.APIKey = credential.APIKey
+	}
+	run.WorkspaceID = ts.workspaceID(ctx, session)
+	env, err := ts.taskEnvironment(ctx, session)
+	if err != nil {
+		return "", err
+	}
+	run.Process.Environment = env
+	
+	output, err := ts.runner.RunTask(ctx, run)
+	if err != nil {
+		return output, fmt.Errorf("명령 실행 실패: %w", err)
+	}
+	return output, nil
+}
+
+// taskEnvironment 태스크 프로세스에 전달할 워크스페이스 환경 변수 (설정하지 않았으면 nil)
+func (ts *TaskService) taskEnvironment(ctx context.Context, session *models.Session) (map[string]string, error) {
+	if ts.environment == nil {
+		return nil, nil
+	}
+	env, err := ts.environment.Environment(ctx, ts.workspaceID(ctx, session))
+	if err != nil {
+		return nil, fmt.Errorf("워크스페이스 환경 변수 조회 실패: %w", err)
+	}
+	return env, nil
+}
+
+// workspaceID 세션 프로젝트가 속한 워크스페이스 ID (찾지 못하면 빈 문자열)
+func (ts *TaskService) workspaceID(ctx context.Context, session *models.Session) string {
+	if session.ProjectID == "" {
+		return ""
+	}
+	project, err := ts.storage.Project().GetByID(ctx, session.ProjectID)
+	if err != nil {
+		return ""
+	}
+	return project.WorkspaceID
+}
+
 // validateCommand 명령어 검증
 func (ts *TaskService) validateCommand(command string) error {
 	// 위험한 명령어 차단
@@ -315,7 +799,7 @@ func (ts *TaskService) validateCommand(command string) error {
 	// 허용된 명령어 목록 (화이트리스트)
 	allowedCommands := []string{
 		"echo", "cat", "ls", "pwd", "date", "whoami",
-		"git", "node", "npm", "go", "python", "python3",
+		"claude", "git", "node", "npm", "go", "python", "python3",
 		"docker", "kubectl", "curl", "wget",
 		"grep", "find", "head", "tail", "wc",
 	}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// taskArtifactTimeout 태스크 종료 후 결과물 추출 제한 시간
+	taskArtifactTimeout = 30 * time.Second
+	// taskArtifactMessagePage 대화 기록을 읽는 페이지 크기
+	taskArtifactMessagePage = 100
+)
+
+// TaskArtifactService 완료된 태스크의 출력과 대화 기록에서 결과물을 추출해 저장하는 서비스
+// 코드 블록, 생성/수정한 파일 경로, 테스트 결과 요약을 태스크에 연결합니다.
+type TaskArtifactService struct {
+	storage storage.Storage
+}
+
+// NewTaskArtifactService 새 태스크 결과물 서비스 생성
+func NewTaskArtifactService(storage storage.Storage) *TaskArtifactService {
+	return &TaskArtifactService{storage: storage}
+}
+
+// OnTaskFinished 완료되거나 실패한 태스크의 결과물 추출 (TaskFinishListener)
+func (s *TaskArtifactService) OnTaskFinished(task *models.Task) {
+	if task.Status == models.TaskCancelled {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), taskArtifactTimeout)
+		defer cancel()
+		if _, err := s.Extract(ctx, task); err != nil {
+			log.Printf("태스크 결과물 추출 실패: %s: %v", task.ID, err)
+		}
+	}()
+}
+
+// Extract 태스크 출력과 실행 중 기록된 세션 메시지를 훑어 결과물을 저장 (기존 결과물은 교체)
+func (s *TaskArtifactService) Extract(ctx context.Context, task *models.Task) ([]*models.TaskArtifact, error) {
+	workspaceID := s.taskWorkspaceID(ctx, task)
+
+	sources := []artifactSource{{kind: models.TaskArtifactSourceOutput, text: task.Output}}
+	messages, err := s.taskMessages(ctx, task)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "대화 기록 조회 실패", err)
+	}
+	for _, message := range messages {
+		if message.Role == models.MessageRoleUser {
+			continue
+		}
+		sources = append(sources, artifactSource{
+			kind:        models.TaskArtifactSourceMessage,
+			sequence:    message.Sequence,
+			messageType: message.Type,
+			text:        message.Content,
+		})
+	}
+
+	artifacts := extractTaskArtifacts(sources)
+	for _, artifact := range artifacts {
+		artifact.SessionID = task.SessionID
+		artifact.WorkspaceID = workspaceID
+	}
+	if err := s.storage.TaskArtifact().ReplaceByTask(ctx, task.ID, artifacts); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 결과물 저장 실패", err)
+	}
+	return artifacts, nil
+}
+
+// List 태스크의 결과물 조회 (artifactType이 비어 있으면 전체)
+// 태스크 접근 권한은 라우터의 권한 미들웨어에서 확인합니다.
+func (s *TaskArtifactService) List(ctx context.Context, taskID string, artifactType models.TaskArtifactType) ([]*models.TaskArtifact, error) {
+	if artifactType != "" && !artifactType.IsValid() {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "알 수 없는 결과물 종류입니다: "+string(artifactType), nil)
+	}
+	if _, err := s.storage.Task().GetByID(ctx, taskID); err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+	}
+
+	artifacts, err := s.storage.TaskArtifact().ListByTask(ctx, taskID, artifactType)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "태스크 결과물 조회 실패", err)
+	}
+	return artifacts, nil
+}
+
+// taskMessages 태스크 실행 시간 동안 세션에 기록된 메시지
+func (s *TaskArtifactService) taskMessages(ctx context.Context, task *models.Task) ([]*models.SessionMessage, error) {
+	if task.StartedAt == nil {
+		return nil, nil
+	}
+	end := time.Now()
+	if task.CompletedAt != nil {
+		end = *task.CompletedAt
+	}
+
+	var messages []*models.SessionMessage
+	for page := 1; ; page++ {
+		batch, total, err := s.storage.Message().ListBySession(ctx, task.SessionID, &models.PaginationRequest{Page: page, Limit: taskArtifactMessagePage})
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range batch {
+			if !message.CreatedAt.Before(*task.StartedAt) && !message.CreatedAt.After(end) {
+				messages = append(messages, message)
+			}
+		}
+		if len(batch) == 0 || page*taskArtifactMessagePage >= total {
+			return messages, nil
+		}
+	}
+}
+
+// taskWorkspaceID 태스크 세션의 프로젝트가 속한 워크스페이스 (찾지 못하면 빈 문자열)
+func (s *TaskArtifactService) taskWorkspaceID(ctx context.Context, task *models.Task) string {
+	session, err := s.storage.Session().GetByID(ctx, task.SessionID)
+	if err != nil {
+		return ""
+	}
+	project, err := s.storage.Project().GetByID(ctx, session.ProjectID)
+	if err != nil {
+		return ""
+	}
+	return project.WorkspaceID
+}
@@ -0,0 +1,356 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+const (
+	// taskArtifactMaxCount 태스크 하나에서 저장할 최대 결과물 수
+	taskArtifactMaxCount = 200
+	// taskArtifactMaxContent 코드 블록 본문 최대 크기 (넘으면 잘라서 저장)
+	taskArtifactMaxContent = 64 << 10
+)
+
+// artifactSource 결과물을 찾을 텍스트 하나 (태스크 출력 또는 세션 메시지)
+type artifactSource struct {
+	kind        models.TaskArtifactSource
+	sequence    int64
+	messageType string
+	text        string
+}
+
+var (
+	// 텍스트에서 파일 변경을 알리는 문장 ("Created file `a/b.go`", "Updated src/x.ts")
+	fileActionPattern = regexp.MustCompile("(?i)\\b(created|creating|wrote|writing|updated|updating|modified|edited|editing|deleted|removed)\\s+(?:the\\s+)?(?:new\\s+)?(?:file\\s+)?`?([\\w./@+-]+)`?")
+	// git status/commit 출력의 파일 변경 줄
+	gitStatusPattern = regexp.MustCompile(`(?m)^\s*(new file|modified|deleted):\s+(\S+)\s*$`)
+	gitCommitPattern = regexp.MustCompile(`(?m)^\s*(create|delete) mode \d+ (\S+)\s*$`)
+
+	// go test 출력
+	goPackageOKPattern   = regexp.MustCompile(`(?m)^ok\s+(\S+)\t`)
+	goPackageFailPattern = regexp.MustCompile(`(?m)^FAIL\t(\S+)\t`)
+	goTestResultPattern  = regexp.MustCompile(`(?m)^\s*--- (PASS|FAIL|SKIP): (\S+)`)
+
+	// pytest 요약 줄 ("==== 3 passed, 1 failed in 0.12s ====")
+	pytestSummaryPattern = regexp.MustCompile(`(?m)^=+ (.*\b(?:passed|failed|error|errors)\b.*) in [\d.]+s(?: \([^)]*\))? =+\s*$`)
+	pytestFailedPattern  = regexp.MustCompile(`(?m)^(?:FAILED|ERROR) (\S+)`)
+
+	// jest 요약 줄 ("Tests:       1 failed, 5 passed, 6 total")
+	jestSummaryPattern = regexp.MustCompile(`(?m)^Tests:\s+(.*\btotal)\s*$`)
+	jestFailedPattern  = regexp.MustCompile(`(?m)^\s*FAIL\s+(\S+)\s*$`)
+
+	// "3 passed" 같은 집계 항목
+	testCountPattern = regexp.MustCompile(`(\d+) (passed|failed|skipped|errors?|xfailed|xpassed)`)
+)
+
+// 파일 변경 동사를 결과물 변경 종류로 변환
+var fileActions = map[string]string{
+	"created": "created", "creating": "created", "wrote": "created", "writing": "created",
+	"create": "created", "new file": "created",
+	"updated": "modified", "updating": "modified", "modified": "modified", "edited": "modified", "editing": "modified",
+	"deleted": "deleted", "removed": "deleted", "delete": "deleted",
+}
+
+// 도구 이름별 파일 변경 종류 (Claude 도구 호출 기록)
+var toolFileActions = map[string]string{
+	"Write":        "created",
+	"Edit":         "modified",
+	"MultiEdit":    "modified",
+	"NotebookEdit": "modified",
+}
+
+// extractTaskArtifacts 텍스트 목록에서 코드 블록, 파일 경로, 테스트 보고서를 찾아 결과물로 변환
+// 같은 내용의 코드 블록과 같은 경로의 파일은 처음 찾은 것 하나만 남깁니다.
+func extractTaskArtifacts(sources []artifactSource) []*models.TaskArtifact {
+	var (
+		artifacts []*models.TaskArtifact
+		blocks    = make(map[[sha256.Size]byte]bool)
+		files     = make(map[string]*models.TaskArtifact)
+		blockNum  int
+	)
+
+	add := func(source artifactSource, artifact *models.TaskArtifact) bool {
+		if len(artifacts) >= taskArtifactMaxCount {
+			return false
+		}
+		artifact.Source = source.kind
+		artifact.MessageSequence = source.sequence
+		artifacts = append(artifacts, artifact)
+		return true
+	}
+
+	for _, source := range sources {
+		text := claude.StripANSI(source.text)
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		for _, block := range extractCodeBlocks(text) {
+			key := sha256.Sum256([]byte(block.content))
+			if blocks[key] {
+				continue
+			}
+			blocks[key] = true
+			blockNum++
+			name := fmt.Sprintf("#%d", blockNum)
+			if block.language != "" {
+				name = block.language + " " + name
+			}
+			add(source, &models.TaskArtifact{
+				Type:     models.TaskArtifactCodeBlock,
+				Name:     name,
+				Language: block.language,
+				Content:  truncateOutput(block.content, taskArtifactMaxContent),
+			})
+		}
+
+		for _, file := range extractFileChanges(source.messageType, text) {
+			if existing, ok := files[file.path]; ok {
+				// 같은 파일의 이후 변경이 최종 상태
+				if file.action != "" {
+					existing.Action = file.action
+				}
+				continue
+			}
+			artifact := &models.TaskArtifact{Type: models.TaskArtifactFile, Name: file.path, Action: file.action}
+			if add(source, artifact) {
+				files[file.path] = artifact
+			}
+		}
+
+		for _, report := range extractTestReports(text) {
+			add(source, &models.TaskArtifact{
+				Type:       models.TaskArtifactTestReport,
+				Name:       report.report.Framework,
+				Content:    report.summary,
+				TestReport: report.report,
+			})
+		}
+	}
+	return artifacts
+}
+
+// codeBlock 마크다운 펜스 코드 블록
+type codeBlock struct {
+	language string
+	content  string
+}
+
+// extractCodeBlocks ``` 또는 ~~~ 펜스로 감싼 코드 블록 추출 (닫히지 않은 블록은 무시)
+func extractCodeBlocks(text string) []codeBlock {
+	var (
+		blocks []codeBlock
+		fence  string
+		lang   string
+		body   []string
+	)
+
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimRight(strings.TrimLeft(line, " "), "\r")
+		if fence == "" {
+			if len(line)-len(strings.TrimLeft(line, " ")) > 3 {
+				continue
+			}
+			if marker := fenceMarker(trimmed); marker != "" {
+				fence, lang, body = marker, "", nil
+				if info := strings.Fields(trimmed[len(marker):]); len(info) > 0 {
+					lang = strings.ToLower(info[0])
+				}
+			}
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(strings.TrimSpace(trimmed), fence[:1]) == "" {
+			if content := strings.Join(body, "\n"); strings.TrimSpace(content) != "" {
+				blocks = append(blocks, codeBlock{language: lang, content: content})
+			}
+			fence = ""
+			continue
+		}
+		body = append(body, strings.TrimRight(line, "\r"))
+	}
+	return blocks
+}
+
+// fenceMarker 줄이 펜스로 시작하면 펜스 문자열 반환
+func fenceMarker(line string) string {
+	for _, c := range []byte{'`', '~'} {
+		n := 0
+		for n < len(line) && line[n] == c {
+			n++
+		}
+		if n >= 3 {
+			// 백틱 펜스의 정보 문자열에는 백틱이 올 수 없음
+			if c == '`' && strings.Contains(line[n:], "`") {
+				return ""
+			}
+			return line[:n]
+		}
+	}
+	return ""
+}
+
+// fileChange 텍스트에서 찾은 파일 변경
+type fileChange struct {
+	path   string
+	action string
+}
+
+// extractFileChanges 도구 호출 기록, git 출력, 변경 안내 문장에서 파일 경로 추출
+func extractFileChanges(messageType, text string) []fileChange {
+	var changes []fileChange
+
+	if messageType == string(claude.MessageTypeToolUse) {
+		if change, ok := toolFileChange(text); ok {
+			changes = append(changes, change)
+		}
+	}
+
+	for _, pattern := range []*regexp.Regexp{gitStatusPattern, gitCommitPattern, fileActionPattern} {
+		for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+			path := strings.TrimRight(match[2], ".,:;")
+			if !looksLikeFilePath(path) {
+				continue
+			}
+			changes = append(changes, fileChange{path: path, action: fileActions[strings.ToLower(match[1])]})
+		}
+	}
+	return changes
+}
+
+// toolFileChange Claude 도구 호출 JSON({"name":"Write","input":{"file_path":...}})에서 파일 경로 추출
+func toolFileChange(text string) (fileChange, bool) {
+	var call struct {
+		Name  string                 `json:"name"`
+		Input map[string]interface{} `json:"input"`
+	}
+	if err := json.Unmarshal([]byte(text), &call); err != nil {
+		return fileChange{}, false
+	}
+	action, ok := toolFileActions[call.Name]
+	if !ok {
+		return fileChange{}, false
+	}
+	for _, key := range []string{"file_path", "notebook_path", "path"} {
+		if path, ok := call.Input[key].(string); ok && path != "" {
+			return fileChange{path: path, action: action}, true
+		}
+	}
+	return fileChange{}, false
+}
+
+// looksLikeFilePath 디렉토리 구분자나 확장자가 있는 경로처럼 보이는지 확인
+func looksLikeFilePath(path string) bool {
+	if path == "" || len(path) > 512 || strings.Contains(path, "://") {
+		return false
+	}
+	if strings.Contains(path, "/") {
+		return strings.Trim(path, "./") != ""
+	}
+	// 확장자는 글자로 시작해야 함 (버전 번호 등 제외)
+	dot := strings.LastIndex(path, ".")
+	if dot <= 0 || dot == len(path)-1 {
+		return false
+	}
+	ext := path[dot+1]
+	return ext >= 'a' && ext <= 'z' || ext >= 'A' && ext <= 'Z'
+}
+
+// testReport 테스트 보고서와 근거가 된 요약 줄
+type testReport struct {
+	report  *models.TaskTestReport
+	summary string
+}
+
+// extractTestReports go test, pytest, jest 출력에서 테스트 결과 요약 추출
+func extractTestReports(text string) []testReport {
+	var reports []testReport
+	if report, ok := goTestReport(text); ok {
+		reports = append(reports, report)
+	}
+	if report, ok := summaryTestReport(text, "pytest", pytestSummaryPattern, pytestFailedPattern); ok {
+		reports = append(reports, report)
+	}
+	if report, ok := summaryTestReport(text, "jest", jestSummaryPattern, jestFailedPattern); ok {
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// goTestReport go test 출력 집계
+// -v 출력이 있으면 테스트 단위로, 없으면 패키지 단위로 셉니다.
+func goTestReport(text string) (testReport, bool) {
+	okPackages := goPackageOKPattern.FindAllStringSubmatch(text, -1)
+	failPackages := goPackageFailPattern.FindAllStringSubmatch(text, -1)
+	results := goTestResultPattern.FindAllStringSubmatch(text, -1)
+	if len(okPackages) == 0 && len(failPackages) == 0 && len(results) == 0 {
+		return testReport{}, false
+	}
+
+	report := &models.TaskTestReport{Framework: "go"}
+	var summary []string
+	if len(results) > 0 {
+		for _, result := range results {
+			// 서브테스트는 상위 테스트 결과에 포함되므로 제외
+			if strings.Contains(result[2], "/") {
+				continue
+			}
+			switch result[1] {
+			case "PASS":
+				report.Passed++
+			case "FAIL":
+				report.Failed++
+				report.Failures = append(report.Failures, result[2])
+			case "SKIP":
+				report.Skipped++
+			}
+		}
+	} else {
+		report.Passed = len(okPackages)
+		report.Failed = len(failPackages)
+		for _, pkg := range failPackages {
+			report.Failures = append(report.Failures, pkg[1])
+		}
+	}
+	for _, match := range append(okPackages, failPackages...) {
+		summary = append(summary, strings.TrimSpace(match[0]))
+	}
+	if len(summary) == 0 {
+		summary = append(summary, fmt.Sprintf("%d passed, %d failed, %d skipped", report.Passed, report.Failed, report.Skipped))
+	}
+	return testReport{report: report, summary: strings.Join(summary, "\n")}, true
+}
+
+// summaryTestReport "N passed, M failed" 형식 요약 줄이 있는 테스트 출력 집계 (마지막 요약 기준)
+func summaryTestReport(text, framework string, summaryPattern, failedPattern *regexp.Regexp) (testReport, bool) {
+	matches := summaryPattern.FindAllStringSubmatch(text, -1)
+	if len(matches) == 0 {
+		return testReport{}, false
+	}
+	last := matches[len(matches)-1]
+
+	report := &models.TaskTestReport{Framework: framework}
+	for _, count := range testCountPattern.FindAllStringSubmatch(last[1], -1) {
+		n, _ := strconv.Atoi(count[1])
+		switch count[2] {
+		case "passed", "xpassed":
+			report.Passed += n
+		case "failed", "error", "errors":
+			report.Failed += n
+		case "skipped", "xfailed":
+			report.Skipped += n
+		}
+	}
+	for _, failed := range failedPattern.FindAllStringSubmatch(text, -1) {
+		report.Failures = append(report.Failures, failed[1])
+	}
+	return testReport{report: report, summary: strings.TrimSpace(last[0])}, true
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestTaskArtifactService_Extract(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	service := NewTaskArtifactService(store)
+	ws, session := createWorkspaceWithSession(t, store, "alice", "api")
+
+	started := time.Now().Add(-time.Minute)
+	task := &models.Task{SessionID: session.ID, Command: "claude", Status: models.TaskCompleted, StartedAt: &started}
+	task.Output = "\x1b[32mok  \tgithub.com/acme/api/parser\t0.12s\x1b[0m\n" +
+		"FAIL\tgithub.com/acme/api/server\t0.40s\n" +
+		"create mode 100644 internal/parser/lexer.go\n"
+	require.NoError(t, store.Task().Create(ctx, task))
+
+	record := func(role models.MessageRole, messageType, content string) {
+		require.NoError(t, store.Message().Append(ctx, &models.SessionMessage{
+			SessionID: session.ID, WorkspaceID: ws.ID, Role: role, Type: messageType, Content: content,
+		}))
+	}
+	record(models.MessageRoleUser, "text", "```go\nfunc ignored() {}\n```")
+	record(models.MessageRoleAssistant, "text", "Here is the fix:\n\n```Go\nfunc Lex() {}\n```\n\nI updated `internal/parser/parser.go` and removed docs/old.md.")
+	record(models.MessageRoleAssistant, "tool_use", `{"name":"Write","input":{"file_path":"/repo/internal/parser/lexer_test.go","content":"..."}}`)
+	record(models.MessageRoleAssistant, "tool_result", "==== 1 failed, 4 passed, 2 skipped in 0.31s ====\nFAILED tests/test_api.py::test_login")
+	// 같은 코드 블록은 한 번만 저장
+	record(models.MessageRoleAssistant, "text", "```go\nfunc Lex() {}\n```")
+	completed := time.Now().Add(time.Second)
+	task.CompletedAt = &completed
+
+	artifacts, err := service.Extract(ctx, task)
+	require.NoError(t, err)
+
+	var blocks, files, reports []*models.TaskArtifact
+	for _, artifact := range artifacts {
+		assert.Equal(t, task.ID, artifact.TaskID)
+		assert.Equal(t, session.ID, artifact.SessionID)
+		assert.Equal(t, ws.ID, artifact.WorkspaceID)
+		switch artifact.Type {
+		case models.TaskArtifactCodeBlock:
+			blocks = append(blocks, artifact)
+		case models.TaskArtifactFile:
+			files = append(files, artifact)
+		case models.TaskArtifactTestReport:
+			reports = append(reports, artifact)
+		}
+	}
+
+	require.Len(t, blocks, 1)
+	assert.Equal(t, "go", blocks[0].Language)
+	assert.Equal(t, "go #1", blocks[0].Name)
+	assert.Equal(t, "func Lex() {}", blocks[0].Content)
+	assert.Equal(t, models.TaskArtifactSourceMessage, blocks[0].Source)
+	assert.Equal(t, int64(2), blocks[0].MessageSequence)
+
+	fileActions := map[string]string{}
+	for _, file := range files {
+		fileActions[file.Name] = file.Action
+	}
+	assert.Equal(t, map[string]string{
+		"internal/parser/lexer.go":            "created",
+		"internal/parser/parser.go":           "modified",
+		"docs/old.md":                         "deleted",
+		"/repo/internal/parser/lexer_test.go": "created",
+	}, fileActions)
+
+	require.Len(t, reports, 2)
+	assert.Equal(t, "go", reports[0].Name)
+	assert.Equal(t, models.TaskArtifactSourceOutput, reports[0].Source)
+	assert.Equal(t, &models.TaskTestReport{Framework: "go", Passed: 1, Failed: 1, Failures: []string{"github.com/acme/api/server"}}, reports[0].TestReport)
+	assert.Equal(t, &models.TaskTestReport{Framework: "pytest", Passed: 4, Failed: 1, Skipped: 2, Failures: []string{"tests/test_api.py::test_login"}}, reports[1].TestReport)
+	assert.False(t, reports[1].TestReport.Success())
+
+	// 저장된 결과물 조회와 종류 필터
+	listed, err := service.List(ctx, task.ID, "")
+	require.NoError(t, err)
+	assert.Len(t, listed, len(artifacts))
+	listed, err = service.List(ctx, task.ID, models.TaskArtifactTestReport)
+	require.NoError(t, err)
+	assert.Len(t, listed, 2)
+
+	// 다시 추출하면 기존 결과물을 교체
+	task.Output = ""
+	_, err = service.Extract(ctx, task)
+	require.NoError(t, err)
+	listed, err = service.List(ctx, task.ID, models.TaskArtifactTestReport)
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+
+	_, err = service.List(ctx, task.ID, "binary")
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	_, err = service.List(ctx, "missing", "")
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
+
+func TestExtractTestReports(t *testing.T) {
+	verbose := "=== RUN   TestA\n--- PASS: TestA (0.00s)\n--- FAIL: TestB (0.01s)\n    --- FAIL: TestB/case (0.00s)\n--- SKIP: TestC (0.00s)\nFAIL\nFAIL\tgithub.com/acme/pkg\t0.02s\n"
+	reports := extractTestReports(verbose)
+	require.Len(t, reports, 1)
+	assert.Equal(t, &models.TaskTestReport{Framework: "go", Passed: 1, Failed: 1, Skipped: 1, Failures: []string{"TestB"}}, reports[0].report)
+
+	jest := " PASS  src/a.test.js\n FAIL  src/b.test.js\nTests:       1 failed, 5 passed, 6 total\nTime: 1.2s\n"
+	reports = extractTestReports(jest)
+	require.Len(t, reports, 1)
+	assert.Equal(t, &models.TaskTestReport{Framework: "jest", Passed: 5, Failed: 1, Failures: []string{"src/b.test.js"}}, reports[0].report)
+	assert.Equal(t, "Tests:       1 failed, 5 passed, 6 total", reports[0].summary)
+
+	assert.Empty(t, extractTestReports("nothing to see here\nok then"))
+}
+
+func TestExtractCodeBlocks(t *testing.T) {
+	text := "intro\n~~~~ python extra\nprint(1)\n```\nstill inside\n~~~~\n```\n\n```\n```bash\nunterminated"
+	blocks := extractCodeBlocks(text)
+	require.Len(t, blocks, 1)
+	assert.Equal(t, codeBlock{language: "python", content: "print(1)\n```\nstill inside"}, blocks[0])
+
+	assert.True(t, looksLikeFilePath("cmd/main.go"))
+	assert.True(t, looksLikeFilePath("README.md"))
+	assert.False(t, looksLikeFilePath("v1.2"))
+	assert.False(t, looksLikeFilePath("tests"))
+	assert.False(t, looksLikeFilePath("https://example.com/a.go"))
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// taskEventLogMax 태스크 하나에 보관하는 최대 이벤트 수 (넘으면 오래된 것부터 버림)
+	taskEventLogMax = 256
+	// taskEventRetention 종료되었거나 이벤트가 없는 태스크의 이벤트 보관 시간
+	taskEventRetention = 15 * time.Minute
+	// taskEventOutputMax 출력 이벤트에 싣는 최대 출력 크기
+	taskEventOutputMax = 64 << 10
+
+	// DefaultTaskEventWait 롱 폴링 기본 대기 시간
+	DefaultTaskEventWait = 30 * time.Second
+	// MaxTaskEventWait 롱 폴링 최대 대기 시간
+	MaxTaskEventWait = 60 * time.Second
+)
+
+// TaskEventListener 태스크 이벤트를 다른 채널(WebSocket 등)로 전달하는 리스너
+type TaskEventListener interface {
+	OnTaskEvent(event *models.TaskEvent)
+}
+
+// taskEventLog 태스크 하나의 이벤트 기록과 대기 중인 폴링 요청 깨우기 채널
+type taskEventLog struct {
+	events    []*models.TaskEvent
+	dropped   int64 // 보관 한도로 버린 마지막 이벤트 커서
+	finished  bool
+	notify    chan struct{}
+	updatedAt time.Time
+}
+
+// TaskEventService 태스크 상태/출력 이벤트 스트림
+// 태스크 서비스가 상태 변경을 발행하면 커서를 붙여 보관하고, 리스너(WebSocket 태스크 채널)와
+// 롱 폴링 요청에 같은 이벤트를 전달합니다. 이벤트는 메모리에만 보관합니다.
+type TaskEventService struct {
+	storage  storage.Storage
+	mu       sync.Mutex
+	cursor   int64
+	logs     map[string]*taskEventLog
+	listener TaskEventListener
+}
+
+// NewTaskEventService 새 태스크 이벤트 서비스 생성
+func NewTaskEventService(storage storage.Storage) *TaskEventService {
+	return &TaskEventService{
+		storage: storage,
+		logs:    make(map[string]*taskEventLog),
+	}
+}
+
+// SetListener 이벤트 리스너 설정 (서비스 시작 전에 설정)
+func (s *TaskEventService) SetListener(listener TaskEventListener) {
+	s.listener = listener
+}
+
+// PublishStatus 태스크의 현재 상태를 이벤트로 발행 (TaskEventPublisher)
+func (s *TaskEventService) PublishStatus(task *models.Task) {
+	s.publish(&models.TaskEvent{
+		TaskID:    task.ID,
+		SessionID: task.SessionID,
+		Type:      models.TaskEventStatus,
+		Status:    task.Status,
+		Error:     task.Error,
+	})
+}
+
+// OnTaskFinished 종료된 태스크의 출력과 최종 상태 발행 (TaskFinishListener)
+func (s *TaskEventService) OnTaskFinished(task *models.Task) {
+	s.mu.Lock()
+	record, exists := s.logs[task.ID]
+	finished := exists && record.finished
+	s.mu.Unlock()
+	if finished {
+		return
+	}
+
+	if task.Output != "" {
+		s.publish(&models.TaskEvent{
+			TaskID:    task.ID,
+			SessionID: task.SessionID,
+			Type:      models.TaskEventOutput,
+			Status:    task.Status,
+			Output:    truncateOutput(task.Output, taskEventOutputMax),
+		})
+	}
+	s.PublishStatus(task)
+}
+
+// Poll since 커서 이후의 태스크 이벤트를 반환 (롱 폴링)
+// 이벤트가 없으면 새 이벤트가 생기거나 wait가 지날 때까지 기다리고, 시간이 지나면 빈 목록을 반환합니다.
+// since가 0이고 보관된 이벤트가 없으면 저장된 태스크 상태로 만든 이벤트 하나를 반환합니다.
+func (s *TaskEventService) Poll(ctx context.Context, taskID string, since int64, wait time.Duration) (*models.TaskEventPollResponse, error) {
+	if since < 0 {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "since는 0 이상이어야 합니다", ErrInvalidRequest)
+	}
+	if wait < 0 || wait > MaxTaskEventWait {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "wait는 0에서 "+MaxTaskEventWait.String()+" 사이여야 합니다", ErrInvalidRequest)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	for {
+		task, err := s.storage.Task().GetByID(ctx, taskID)
+		if err != nil {
+			if storage.IsNotFoundError(err) {
+				return nil, NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+			}
+			return nil, NewWorkspaceError(ErrCodeInternal, "태스크 조회 실패", err)
+		}
+
+		resp, notify := s.collect(task, since)
+		if len(resp.Events) > 0 || resp.Done || wait == 0 {
+			return resp, nil
+		}
+
+		select {
+		case <-notify:
+		case <-timer.C:
+			return resp, nil
+		case <-ctx.Done():
+			return resp, nil
+		}
+	}
+}
+
+// collect since 이후 이벤트로 응답을 만들고, 새 이벤트를 기다릴 채널을 반환
+func (s *TaskEventService) collect(task *models.Task, since int64) (*models.TaskEventPollResponse, <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := &models.TaskEventPollResponse{TaskID: task.ID, Status: task.Status, Events: []*models.TaskEvent{}, Cursor: since}
+	record := s.logLocked(task.ID)
+
+	if len(record.events) == 0 && record.dropped == 0 {
+		// 서버 재시작 등으로 이벤트 기록이 없으면 저장된 상태를 기준으로 응답
+		if since == 0 {
+			// 커서 0은 "처음부터"를 뜻하므로 스냅샷에는 0보다 큰 커서를 붙임
+			if s.cursor == 0 {
+				s.cursor++
+			}
+			resp.Events = append(resp.Events, &models.TaskEvent{
+				Cursor:    s.cursor,
+				TaskID:    task.ID,
+				SessionID: task.SessionID,
+				Type:      models.TaskEventStatus,
+				Status:    task.Status,
+				Error:     task.Error,
+				CreatedAt: time.Now(),
+			})
+			resp.Cursor = s.cursor
+		}
+		resp.Done = task.IsTerminal()
+		return resp, record.notify
+	}
+
+	resp.Truncated = since < record.dropped
+	for _, event := range record.events {
+		if event.Cursor > since {
+			resp.Events = append(resp.Events, event)
+			resp.Cursor = event.Cursor
+			resp.Status = event.Status
+		}
+	}
+	// 종료 상태 이벤트까지 전달했으면 더 기다릴 것이 없음
+	resp.Done = record.finished && resp.Cursor >= record.events[len(record.events)-1].Cursor
+	return resp, record.notify
+}
+
+// publish 이벤트에 커서를 붙여 보관하고 대기 중인 요청과 리스너에 전달
+func (s *TaskEventService) publish(event *models.TaskEvent) {
+	s.mu.Lock()
+	now := time.Now()
+	s.sweepLocked(now)
+
+	s.cursor++
+	event.Cursor = s.cursor
+	event.CreatedAt = now
+
+	record := s.logLocked(event.TaskID)
+	record.events = append(record.events, event)
+	if len(record.events) > taskEventLogMax {
+		record.dropped = record.events[0].Cursor
+		record.events = record.events[1:]
+	}
+	if event.Type == models.TaskEventStatus && isTerminalTaskStatus(event.Status) {
+		record.finished = true
+	}
+	record.updatedAt = now
+	close(record.notify)
+	record.notify = make(chan struct{})
+	s.mu.Unlock()
+
+	if s.listener != nil {
+		s.listener.OnTaskEvent(event)
+	}
+}
+
+// logLocked 태스크 이벤트 기록 조회 (없으면 생성, 호출자가 잠금 보유)
+func (s *TaskEventService) logLocked(taskID string) *taskEventLog {
+	record, exists := s.logs[taskID]
+	if !exists {
+		record = &taskEventLog{notify: make(chan struct{}), updatedAt: time.Now()}
+		s.logs[taskID] = record
+	}
+	return record
+}
+
+// sweepLocked 보관 시간이 지난 종료/빈 기록 삭제 (호출자가 잠금 보유)
+func (s *TaskEventService) sweepLocked(now time.Time) {
+	for taskID, record := range s.logs {
+		if (record.finished || len(record.events) == 0) && now.Sub(record.updatedAt) > taskEventRetention {
+			close(record.notify)
+			delete(s.logs, taskID)
+		}
+	}
+}
+
+// isTerminalTaskStatus 종료 상태인지 확인
+func isTerminalTaskStatus(status models.TaskStatus) bool {
+	task := models.Task{Status: status}
+	return task.IsTerminal()
+}
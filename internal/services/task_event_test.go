@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// recordingTaskEventListener 발행된 태스크 이벤트를 기록 (발행 고루틴과 테스트가 동시에 접근)
+type recordingTaskEventListener struct {
+	mu     sync.Mutex
+	events []*models.TaskEvent
+}
+
+func (l *recordingTaskEventListener) OnTaskEvent(event *models.TaskEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, event)
+}
+
+func (l *recordingTaskEventListener) count() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.events)
+}
+
+func TestTaskEventService_Poll(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	service := NewTaskEventService(store)
+	listener := &recordingTaskEventListener{}
+	service.SetListener(listener)
+	_, session := createWorkspaceWithSession(t, store, "alice", "api")
+
+	task := &models.Task{SessionID: session.ID, Command: "claude", Status: models.TaskPending}
+	require.NoError(t, store.Task().Create(ctx, task))
+
+	// 이벤트 기록이 없으면 저장된 상태로 만든 스냅샷 반환
+	resp, err := service.Poll(ctx, task.ID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, models.TaskPending, resp.Events[0].Status)
+	assert.False(t, resp.Done)
+
+	// 새 이벤트가 없으면 대기 시간이 지난 뒤 빈 목록 반환
+	resp, err = service.Poll(ctx, task.ID, resp.Cursor, 20*time.Millisecond)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Events)
+
+	// 대기 중에 발행된 이벤트로 깨어남
+	task.Status = models.TaskRunning
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		service.PublishStatus(task)
+	}()
+	started := time.Now()
+	resp, err = service.Poll(ctx, task.ID, resp.Cursor, 5*time.Second)
+	require.NoError(t, err)
+	assert.Less(t, time.Since(started), 5*time.Second)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, models.TaskRunning, resp.Status)
+	cursor := resp.Cursor
+
+	// 종료되면 출력과 최종 상태를 한 번에 받고 done 표시
+	task.Status = models.TaskCompleted
+	task.Output = "done"
+	service.OnTaskFinished(task)
+	service.OnTaskFinished(task)
+	resp, err = service.Poll(ctx, task.ID, cursor, time.Second)
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, models.TaskEventOutput, resp.Events[0].Type)
+	assert.Equal(t, "done", resp.Events[0].Output)
+	assert.Equal(t, models.TaskCompleted, resp.Status)
+	assert.True(t, resp.Done)
+	assert.Equal(t, 3, listener.count())
+
+	// 종료 후 마지막 커서로 요청하면 기다리지 않고 바로 반환
+	resp, err = service.Poll(ctx, task.ID, resp.Cursor, 5*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Events)
+	assert.True(t, resp.Done)
+
+	_, err = service.Poll(ctx, task.ID, -1, 0)
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	_, err = service.Poll(ctx, task.ID, 0, 2*MaxTaskEventWait)
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	_, err = service.Poll(ctx, "missing", 0, 0)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// SetResponseLanguageProvider 태스크 claude 명령에 적용할 사용자 응답 언어 제공자 설정 (서비스 시작 전에 설정)
+func (ts *TaskService) SetResponseLanguageProvider(languages claude.ResponseLanguageProvider) {
+	ts.languages = languages
+}
+
+// argsWithResponseLanguage claude 명령이면 실행 파일 바로 뒤에 응답 언어 지시문(--append-system-prompt)을 넣은 인자 반환
+// 태스크에는 만든 사용자가 기록되지 않으므로 세션 워크스페이스 소유자의 설정을 따르고,
+// 명령에 시스템 프롬프트가 이미 지정되어 있으면 그대로 둡니다.
+func (ts *TaskService) argsWithResponseLanguage(ctx context.Context, parts []string, session *models.Session) []string {
+	if ts.languages == nil || len(parts) == 0 || path.Base(parts[0]) != "claude" {
+		return parts
+	}
+	for _, arg := range parts[1:] {
+		if arg == "--append-system-prompt" || arg == "--system-prompt" ||
+			strings.HasPrefix(arg, "--append-system-prompt=") || strings.HasPrefix(arg, "--system-prompt=") {
+			return parts
+		}
+	}
+
+	instruction := claude.ResponseLanguageInstruction(ts.languages.ResponseLanguage(ctx, ts.sessionOwner(ctx, session.ID)))
+	if instruction == "" {
+		return parts
+	}
+	return append([]string{parts[0], "--append-system-prompt", instruction}, parts[1:]...)
+}
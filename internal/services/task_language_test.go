@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// argsTaskRunner 실행한 프로세스 인자를 기록하는 테스트용 실행기
+type argsTaskRunner struct {
+	args []string
+}
+
+func (r *argsTaskRunner) RunTask(ctx context.Context, run *TaskRun) (string, error) {
+	r.args = append([]string{run.Process.Command}, run.Process.Args...)
+	return "", nil
+}
+
+func TestTaskService_ResponseLanguage(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	taskService := NewTaskService(store, NewSessionService(store, NewProjectService(store), nil), nil)
+	preferences := NewUserPreferenceService(store)
+	taskService.SetResponseLanguageProvider(preferences)
+	runner := &argsTaskRunner{}
+	taskService.SetTaskRunner(runner)
+
+	japanese := "ja"
+	_, err := preferences.Update(ctx, "alice", &models.UserPreferenceRequest{ResponseLanguage: &japanese})
+	require.NoError(t, err)
+	alice := seedAnalyticsSession(t, store, "alice-app", "alice")
+	bob := seedAnalyticsSession(t, store, "bob-app", "bob")
+
+	run := func(session *models.Session, command string) []string {
+		_, err := taskService.runTask(ctx, &models.Task{SessionID: session.ID, Command: command}, command, nil, session)
+		require.NoError(t, err)
+		return runner.args
+	}
+
+	// 워크스페이스 소유자의 응답 언어 지시문을 실행 파일 바로 뒤에 넣음 (공백이 있어도 인자 하나)
+	assert.Equal(t, []string{"claude", "--append-system-prompt", claude.ResponseLanguageInstruction("ja"), "-p", "hello"}, run(alice, "claude -p hello"))
+
+	// 설정이 없거나, claude 명령이 아니거나, 시스템 프롬프트를 직접 지정하면 그대로 실행
+	assert.Equal(t, []string{"claude", "-p", "hello"}, run(bob, "claude -p hello"))
+	assert.Equal(t, []string{"git", "status"}, run(alice, "git status"))
+	assert.Equal(t, []string{"claude", "--append-system-prompt=terse", "-p", "hello"}, run(alice, "claude --append-system-prompt=terse -p hello"))
+}
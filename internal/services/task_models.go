@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/queue"
+)
+
+// taskModelPattern 모델 이름으로 허용하는 형식 (별칭 또는 전체 모델 ID)
+var taskModelPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:@-]*$`)
+
+// validateTaskModels 모델 선호 목록 검증 (claude 명령에만, 명령에 모델이 지정되지 않은 경우에만 허용)
+func validateTaskModels(command string, taskModels []string) error {
+	if len(taskModels) == 0 {
+		return nil
+	}
+
+	parts := strings.Fields(command)
+	if len(parts) == 0 || path.Base(parts[0]) != "claude" {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "모델 선호 목록은 claude 명령에만 지정할 수 있습니다", ErrInvalidRequest)
+	}
+	for _, arg := range parts[1:] {
+		if arg == "--model" || strings.HasPrefix(arg, "--model=") {
+			return NewWorkspaceError(ErrCodeInvalidRequest, "명령에 --model이 있으면 모델 선호 목록을 지정할 수 없습니다", ErrInvalidRequest)
+		}
+	}
+
+	seen := make(map[string]bool, len(taskModels))
+	for _, model := range taskModels {
+		if !taskModelPattern.MatchString(model) {
+			return NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("잘못된 모델 이름입니다: %q", model), ErrInvalidRequest)
+		}
+		if seen[model] {
+			return NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("모델이 중복되었습니다: %s", model), ErrInvalidRequest)
+		}
+		seen[model] = true
+	}
+	return nil
+}
+
+// commandWithModel 실행 파일 바로 뒤에 --model 인자를 넣은 명령 반환
+func commandWithModel(command, model string) string {
+	parts := strings.Fields(command)
+	if len(parts) == 0 {
+		return command
+	}
+	args := append([]string{parts[0], "--model", model}, parts[1:]...)
+	return strings.Join(args, " ")
+}
+
+// runWithModels 모델 선호 목록 순서대로 명령 실행
+// 모델 과부하나 요청 한도 초과로 실패하면 다음 모델로 다시 실행하고, 성공한 모델을 ServedModel에 기록합니다.
+func (ts *TaskService) runWithModels(ctx context.Context, task *models.Task, session *models.Session) (string, error) {
+	if len(task.Models) == 0 {
+		return ts.runCommand(ctx, task, task.Command, session)
+	}
+
+	var (
+		output string
+		err    error
+	)
+	for i, model := range task.Models {
+		output, err = ts.runCommand(ctx, task, commandWithModel(task.Command, model), session)
+		if err == nil {
+			task.ServedModel = model
+			return output, nil
+		}
+		var requeue *queue.RequeueError
+		if errors.As(err, &requeue) {
+			return output, err
+		}
+
+		task.ModelAttempts = append(task.ModelAttempts, models.TaskModelAttempt{Model: model, Error: err.Error()})
+		if i == len(task.Models)-1 || ctx.Err() != nil || !claude.IsModelUnavailable(output+"\n"+err.Error()) {
+			break
+		}
+		ts.logger.WithContext(ctx).WithFields(logging.Fields{
+			"task_id":    task.ID,
+			"model":      model,
+			"next_model": task.Models[i+1],
+		}).WithError(err).Warn("태스크 모델 전환")
+	}
+	return output, err
+}
+
+// runCommand 외부 실행기가 있으면 실행기로, 없으면 로컬 프로세스로 명령 실행
+// 쿼터 거버너가 있으면 claude 명령은 거버너가 고른 자격 증명으로 실행하고, 인증 실패나
+// 한도 초과로 실패하면 (해당 자격 증명은 격리되므로) 다른 자격 증명으로 다시 실행합니다.
+func (ts *TaskService) runCommand(ctx context.Context, task *models.Task, command string, session *models.Session) (string, error) {
+	for attempt := 1; ; attempt++ {
+		lease, err := ts.acquireQuota(ctx, command)
+		if err != nil {
+			return "", err
+		}
+		var credential *claude.QuotaCredential
+		if lease != nil {
+			c := lease.Credential()
+			credential = &c
+		}
+
+		var output string
+		if ts.runner != nil {
+			output, err = ts.runTask(ctx, task, command, credential, session)
+		} else {
+			output, err = ts.executeCommand(ctx, task, command, credential, session)
+		}
+		if lease == nil {
+			return output, err
+		}
+		lease.Release(output, err)
+
+		if err == nil || attempt >= ts.quota.Size() || ctx.Err() != nil || !claude.IsCredentialFailure(output+"\n"+err.Error()) {
+			return output, err
+		}
+		ts.logger.WithContext(ctx).WithFields(logging.Fields{
+			"task_id":    task.ID,
+			"credential": credential.Name,
+		}).WithError(err).Warn("태스크 자격 증명 전환")
+	}
+}
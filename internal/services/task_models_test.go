@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// modelTaskRunner 모델별로 정해 둔 출력과 에러를 돌려주는 테스트용 실행기
+type modelTaskRunner struct {
+	failures map[string]string
+	models   []string
+}
+
+func (r *modelTaskRunner) RunTask(ctx context.Context, run *TaskRun) (string, error) {
+	model := ""
+	for i, arg := range run.Process.Args {
+		if arg == "--model" && i+1 < len(run.Process.Args) {
+			model = run.Process.Args[i+1]
+		}
+	}
+	r.models = append(r.models, model)
+	if output, ok := r.failures[model]; ok {
+		return output, errors.New("exit status 1")
+	}
+	return "served by " + model, nil
+}
+
+func TestValidateTaskModels(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		models  []string
+		wantErr bool
+	}{
+		{"목록 없음", "git status", nil, false},
+		{"claude 명령", "claude -p hello", []string{"opus", "sonnet"}, false},
+		{"전체 모델 ID", "claude -p hello", []string{"claude-opus-4-1-20250805"}, false},
+		{"claude가 아닌 명령", "git status", []string{"opus"}, true},
+		{"명령에 모델 지정", "claude --model opus -p hello", []string{"sonnet"}, true},
+		{"명령에 모델 지정 (= 형식)", "claude --model=opus -p hello", []string{"sonnet"}, true},
+		{"잘못된 모델 이름", "claude -p hello", []string{"opus; rm -rf"}, true},
+		{"중복 모델", "claude -p hello", []string{"opus", "opus"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTaskModels(tt.command, tt.models)
+			if tt.wantErr {
+				var wsErr *WorkspaceError
+				require.ErrorAs(t, err, &wsErr)
+				assert.Equal(t, ErrCodeInvalidRequest, wsErr.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCommandWithModel(t *testing.T) {
+	assert.Equal(t, "claude --model sonnet -p hello", commandWithModel("claude -p hello", "sonnet"))
+	assert.Equal(t, "claude --model opus", commandWithModel("claude", "opus"))
+}
+
+func TestTaskService_RunWithModels(t *testing.T) {
+	taskService, _, session := setupTaskTest()
+	defer taskService.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	t.Run("과부하 시 다음 모델로 전환", func(t *testing.T) {
+		runner := &modelTaskRunner{failures: map[string]string{
+			"opus": `API Error: 529 {"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`,
+		}}
+		taskService.SetTaskRunner(runner)
+
+		task := &models.Task{SessionID: session.ID, Command: "claude -p hello", Models: []string{"opus", "sonnet"}}
+		output, err := taskService.runWithModels(ctx, task, session)
+		require.NoError(t, err)
+		assert.Equal(t, "served by sonnet", output)
+		assert.Equal(t, []string{"opus", "sonnet"}, runner.models)
+		assert.Equal(t, "sonnet", task.ServedModel)
+		require.Len(t, task.ModelAttempts, 1)
+		assert.Equal(t, "opus", task.ModelAttempts[0].Model)
+	})
+
+	t.Run("다른 에러는 전환하지 않음", func(t *testing.T) {
+		runner := &modelTaskRunner{failures: map[string]string{"opus": "Error: invalid prompt"}}
+		taskService.SetTaskRunner(runner)
+
+		task := &models.Task{SessionID: session.ID, Command: "claude -p hello", Models: []string{"opus", "sonnet"}}
+		_, err := taskService.runWithModels(ctx, task, session)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"opus"}, runner.models)
+		assert.Empty(t, task.ServedModel)
+		assert.Len(t, task.ModelAttempts, 1)
+	})
+
+	t.Run("모든 모델이 한도 초과", func(t *testing.T) {
+		runner := &modelTaskRunner{failures: map[string]string{
+			"opus":   "API Error: 429 rate_limit_error",
+			"sonnet": "API Error: 429 rate_limit_error",
+		}}
+		taskService.SetTaskRunner(runner)
+
+		task := &models.Task{SessionID: session.ID, Command: "claude -p hello", Models: []string{"opus", "sonnet"}}
+		output, err := taskService.runWithModels(ctx, task, session)
+		assert.Error(t, err)
+		assert.Contains(t, output, "rate_limit_error")
+		assert.Equal(t, []string{"opus", "sonnet"}, runner.models)
+		assert.Empty(t, task.ServedModel)
+		assert.Len(t, task.ModelAttempts, 2)
+	})
+
+	t.Run("목록이 없으면 명령 그대로 실행", func(t *testing.T) {
+		runner := &modelTaskRunner{}
+		taskService.SetTaskRunner(runner)
+
+		task := &models.Task{SessionID: session.ID, Command: "claude -p hello"}
+		output, err := taskService.runWithModels(ctx, task, session)
+		require.NoError(t, err)
+		assert.Equal(t, "served by ", output)
+		assert.Empty(t, task.ServedModel)
+	})
+}
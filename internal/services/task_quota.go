@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/queue"
+)
+
+// SetQuotaGovernor claude 명령에 자격 증명을 나눠 주고 한도에 가까우면 태스크를 지연시킬 쿼터 거버너 설정
+func (ts *TaskService) SetQuotaGovernor(quota *claude.QuotaGovernor) {
+	ts.quota = quota
+}
+
+// QuotaStatus 자격 증명별 쿼터 상태 (거버너가 없으면 nil)
+func (ts *TaskService) QuotaStatus() []claude.QuotaCredentialStatus {
+	if ts.quota == nil {
+		return nil
+	}
+	return ts.quota.Status()
+}
+
+// acquireQuota claude 명령이면 쿼터 거버너에서 자격 증명 예약 (거버너가 없거나 다른 명령이면 nil)
+// 모든 자격 증명이 한도에 가까워 오래 기다려야 하면 태스크를 다시 큐에 넣도록 queue.RequeueError를 반환합니다.
+func (ts *TaskService) acquireQuota(ctx context.Context, command string) (*claude.QuotaLease, error) {
+	if ts.quota == nil {
+		return nil, nil
+	}
+	if !isClaudeCommand(command) {
+		return nil, nil
+	}
+
+	lease, err := ts.quota.Acquire(ctx)
+	var wait *claude.QuotaWaitError
+	if errors.As(err, &wait) {
+		return nil, &queue.RequeueError{After: wait.Wait, Reason: wait.Error()}
+	}
+	return lease, err
+}
+
+// onTaskRequeued 쿼터 한도로 대기 상태로 돌아간 태스크 저장과 상태 발행
+func (ts *TaskService) onTaskRequeued(task *models.Task) {
+	if err := ts.storage.Task().Update(context.Background(), task); err != nil {
+		ts.logger.WithFields(logging.Fields{
+			"task_id":    task.ID,
+			"session_id": task.SessionID,
+		}).WithError(err).Error("재대기 태스크 상태 저장 실패")
+	}
+	ts.publishStatus(task)
+}
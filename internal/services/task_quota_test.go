@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// quotaTaskRunner 첫 실행만 요청 한도 초과로 실패하는 테스트용 실행기
+type quotaTaskRunner struct {
+	mu      sync.Mutex
+	apiKeys []string
+}
+
+func (r *quotaTaskRunner) RunTask(ctx context.Context, run *TaskRun) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.apiKeys = append(r.apiKeys, run.Process.APIKey)
+	if len(r.apiKeys) == 1 {
+		return "API Error: 429 rate_limit_error. Please try again in 300ms", errors.New("exit status 1")
+	}
+	return "ok", nil
+}
+
+func (r *quotaTaskRunner) keys() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.apiKeys...)
+}
+
+func TestTaskService_QuotaRequeue(t *testing.T) {
+	taskService, _, session := setupTaskTest()
+	defer taskService.Stop()
+
+	runner := &quotaTaskRunner{}
+	taskService.SetTaskRunner(runner)
+	quota, err := claude.NewQuotaGovernor(claude.QuotaGovernorConfig{
+		Credentials: []claude.QuotaCredential{{Name: "primary", APIKey: "key-primary"}},
+		MaxDelay:    0,
+	}, nil)
+	require.NoError(t, err)
+	taskService.SetQuotaGovernor(quota)
+
+	// 첫 태스크는 한도 초과로 실패하고 자격 증명이 잠시 쉬게 됨
+	first, err := taskService.Create(context.Background(), &models.TaskCreateRequest{SessionID: session.ID, Command: "claude -p first"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		current, err := taskService.GetByID(context.Background(), first.ID)
+		return err == nil && current.Status == models.TaskFailed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status := taskService.QuotaStatus()
+	require.Len(t, status, 1)
+	require.NotNil(t, status[0].BlockedUntil)
+	assert.Equal(t, int64(1), status[0].RateLimited)
+
+	// 쉬는 동안 들어온 태스크는 다시 큐에 들어갔다가 재시도 시간이 지나면 실행됨
+	second, err := taskService.Create(context.Background(), &models.TaskCreateRequest{SessionID: session.ID, Command: "claude -p second"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		current, err := taskService.GetByID(context.Background(), second.ID)
+		return err == nil && current.Status == models.TaskCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"key-primary", "key-primary"}, runner.keys())
+}
+
+func TestTaskService_AcquireQuotaSkipsOtherCommands(t *testing.T) {
+	taskService, _, _ := setupTaskTest()
+	defer taskService.Stop()
+
+	lease, err := taskService.acquireQuota(context.Background(), "claude -p hi")
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+
+	quota, err := claude.NewQuotaGovernor(claude.QuotaGovernorConfig{
+		Credentials: []claude.QuotaCredential{{Name: "primary", APIKey: "key-primary"}},
+	}, nil)
+	require.NoError(t, err)
+	taskService.SetQuotaGovernor(quota)
+
+	lease, err = taskService.acquireQuota(context.Background(), "git status")
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+
+	lease, err = taskService.acquireQuota(context.Background(), "claude -p hi")
+	require.NoError(t, err)
+	require.NotNil(t, lease)
+	assert.Equal(t, "primary", lease.Credential().Name)
+	lease.Release("", nil)
+}
+
+// authFailingRunner 지정한 API 키로 실행하면 인증 실패를 돌려주는 테스트용 실행기
+type authFailingRunner struct {
+	badKey  string
+	apiKeys []string
+}
+
+func (r *authFailingRunner) RunTask(ctx context.Context, run *TaskRun) (string, error) {
+	r.apiKeys = append(r.apiKeys, run.Process.APIKey)
+	if run.Process.APIKey == r.badKey {
+		return `API Error: 401 {"type":"authentication_error","message":"invalid x-api-key"}`, errors.New("exit status 1")
+	}
+	return "ok", nil
+}
+
+func TestTaskService_RotatesCredentialOnAuthFailure(t *testing.T) {
+	taskService, _, session := setupTaskTest()
+	defer taskService.Stop()
+
+	runner := &authFailingRunner{badKey: "key-revoked"}
+	taskService.SetTaskRunner(runner)
+	quota, err := claude.NewQuotaGovernor(claude.QuotaGovernorConfig{
+		Credentials: []claude.QuotaCredential{
+			{Name: "revoked", APIKey: "key-revoked"},
+			{Name: "valid", APIKey: "key-valid"},
+		},
+	}, nil)
+	require.NoError(t, err)
+	taskService.SetQuotaGovernor(quota)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		task := &models.Task{SessionID: session.ID, Command: "claude -p hello"}
+		output, err := taskService.runCommand(ctx, task, task.Command, session)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", output)
+	}
+
+	// 처음 고른 키가 인증에 실패하면 격리되어 다시 시도되지 않음
+	assert.Equal(t, []string{"key-revoked", "key-valid", "key-valid", "key-valid"}, runner.apiKeys)
+	status := taskService.QuotaStatus()
+	require.Len(t, status, 2)
+	assert.Equal(t, "revoked", status[0].Name)
+	assert.Equal(t, claude.CredentialQuarantined, status[0].Health)
+	assert.Equal(t, int64(1), status[0].AuthFailures)
+	assert.Equal(t, claude.CredentialHealthy, status[1].Health)
+}
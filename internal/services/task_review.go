@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// defaultTaskReviewLimit 조회 개수를 지정하지 않았을 때 반환할 검토 수
+const defaultTaskReviewLimit = 50
+
+// ReviewGit 검토 워크플로가 사용하는 Git 작업 (*GitService)
+type ReviewGit interface {
+	HeadCommit(path string) (string, error)
+	HasChanges(path string) (bool, error)
+	StageDiff(path, base string) (string, []string, error)
+	CommitAll(path, message, authorName string) (string, error)
+	ResetTo(path, base string) error
+	CloneShadow(path, base, dest string) error
+	ApplyShadow(shadow, path, base string) error
+}
+
+// TaskReviewListener 검토 요청과 결정을 전달받는 알림 훅
+// reviewers는 승인할 수 있는 사용자(워크스페이스 admin 이상, 그룹 구성원 포함)입니다.
+type TaskReviewListener interface {
+	OnReviewRequested(review *models.TaskReview, reviewers []string)
+	OnReviewDecided(review *models.TaskReview, reviewers []string)
+}
+
+// TaskReviewService 태스크 결과 검토와 승인 워크플로
+// require_review로 제출한 태스크는 실행 전 HEAD를 기준 커밋으로 기록하고, 끝나면 변경 사항을 diff로 남겨
+// 검토 대기 상태가 됩니다. 워크스페이스 admin 권한이 있는 검토자가 승인하면 변경 사항을 커밋하고,
+// 거부하면 작업 트리를 기준 커밋으로 되돌립니다.
+// dry_run 태스크는 기준 커밋을 복제한 섀도 복사본에서 실행되어 승인 전까지 작업 트리를 바꾸지 않으며,
+// 승인하면 섀도 복사본의 변경을 작업 트리에 적용해 커밋합니다.
+type TaskReviewService struct {
+	storage   storage.Storage
+	access    *WorkspaceAccessService
+	git       ReviewGit
+	tasks     PipelineTaskRunner // 검토 의견 수정 태스크 실행 (SetTaskRunner로 설정)
+	listeners []TaskReviewListener
+
+	// mu 검토 상태 변경을 직렬화 (같은 프로젝트에서 검토가 겹치거나 한 검토를 두 번 결정하지 않도록)
+	mu sync.Mutex
+}
+
+// NewTaskReviewService 새 태스크 검토 서비스 생성
+func NewTaskReviewService(storage storage.Storage, git ReviewGit) *TaskReviewService {
+	return &TaskReviewService{
+		storage: storage,
+		access:  NewWorkspaceAccessService(storage),
+		git:     git,
+	}
+}
+
+// SetTaskRunner 검토 의견을 반영하는 수정 태스크를 실행할 태스크 서비스 설정
+// 태스크 서비스가 검토 서비스를 게이트로 사용하므로 생성 후에 연결합니다.
+func (s *TaskReviewService) SetTaskRunner(tasks PipelineTaskRunner) {
+	s.tasks = tasks
+}
+
+// AddListener 알림 훅 추가
+func (s *TaskReviewService) AddListener(listener TaskReviewListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, listener)
+}
+
+// Prepare 태스크 실행 전 기준 커밋을 기록하고 검토 생성 (TaskReviewGate)
+// 롤백할 때 다른 변경을 지우지 않도록 작업 트리가 깨끗해야 하며, 프로젝트마다 결정되지 않은 검토는 하나만 둡니다.
+// 드라이런 태스크는 기준 커밋을 섀도 복사본으로 복제합니다.
+func (s *TaskReviewService) Prepare(ctx context.Context, task *models.Task) error {
+	session, err := s.storage.Session().GetByID(ctx, task.SessionID)
+	if err != nil {
+		return NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+	}
+	project, err := s.storage.Project().GetByID(ctx, session.ProjectID)
+	if err != nil {
+		return NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	open, err := s.storage.TaskReview().List(ctx, &models.TaskReviewFilter{ProjectID: project.ID, OpenOnly: true, Limit: 1})
+	if err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "검토 조회 실패", err)
+	}
+	if len(open) > 0 {
+		return NewWorkspaceError(ErrCodeResourceBusy, fmt.Sprintf("프로젝트에 아직 결정되지 않은 검토가 있습니다 (태스크 %s)", open[0].TaskID), nil)
+	}
+
+	base, err := s.git.HeadCommit(project.Path)
+	if err != nil {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "검토가 필요한 태스크는 커밋이 있는 Git 리포지토리에서만 실행할 수 있습니다", err)
+	}
+	// 드라이런은 작업 트리를 바꾸지 않으므로 승인할 때 확인
+	if !task.DryRun {
+		dirty, err := s.git.HasChanges(project.Path)
+		if err != nil {
+			return NewWorkspaceError(ErrCodeInternal, "작업 트리 상태 확인 실패", err)
+		}
+		if dirty {
+			return NewWorkspaceError(ErrCodeInvalidStatus, "커밋되지 않은 변경 사항이 있어 검토가 필요한 태스크를 실행할 수 없습니다", nil)
+		}
+	}
+
+	review := &models.TaskReview{
+		TaskID:       task.ID,
+		SessionID:    session.ID,
+		ProjectID:    project.ID,
+		WorkspaceID:  project.WorkspaceID,
+		Status:       models.TaskReviewAwaitingTask,
+		BaseCommit:   base,
+		DryRun:       task.DryRun,
+		FilesChanged: []string{},
+	}
+	if task.DryRun {
+		shadow, err := s.createShadow(project.Path, base)
+		if err != nil {
+			return err
+		}
+		review.ShadowPath = shadow
+	}
+	if err := s.storage.TaskReview().Create(ctx, review); err != nil {
+		s.removeShadow(review)
+		return NewWorkspaceError(ErrCodeInternal, "검토 저장 실패", err)
+	}
+	return nil
+}
+
+// ShadowPath 드라이런 태스크가 실행될 섀도 복사본 경로 (TaskReviewGate)
+func (s *TaskReviewService) ShadowPath(ctx context.Context, taskID string) (string, error) {
+	review, err := s.storage.TaskReview().GetByTaskID(ctx, taskID)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeNotFound, "드라이런 검토를 찾을 수 없습니다", err)
+	}
+	if !review.DryRun || review.ShadowPath == "" {
+		return "", NewWorkspaceError(ErrCodeInvalidStatus, "드라이런 섀도 복사본이 없습니다", ErrInvalidRequest)
+	}
+	return review.ShadowPath, nil
+}
+
+// createShadow 기준 커밋을 임시 디렉토리에 복제
+func (s *TaskReviewService) createShadow(path, base string) (string, error) {
+	shadow, err := os.MkdirTemp("", "aicli-dryrun-")
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "드라이런 섀도 복사본 생성 실패", err)
+	}
+	if err := s.git.CloneShadow(path, base, shadow); err != nil {
+		_ = os.RemoveAll(shadow)
+		return "", NewWorkspaceError(ErrCodeInternal, "드라이런 섀도 복사본 생성 실패", err)
+	}
+	return shadow, nil
+}
+
+// removeShadow 드라이런 섀도 복사본 삭제 (경로를 비움)
+func (s *TaskReviewService) removeShadow(review *models.TaskReview) {
+	if review.ShadowPath == "" {
+		return
+	}
+	if err := os.RemoveAll(review.ShadowPath); err != nil {
+		log.Printf("드라이런 섀도 복사본 삭제 실패: %s: %v", review.ShadowPath, err)
+	}
+	review.ShadowPath = ""
+}
+
+// Discard 제출하지 못한 태스크의 검토 삭제 (TaskReviewGate)
+func (s *TaskReviewService) Discard(ctx context.Context, taskID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	review, err := s.storage.TaskReview().GetByTaskID(ctx, taskID)
+	if err != nil {
+		return
+	}
+	s.removeShadow(review)
+	if err := s.storage.TaskReview().Delete(ctx, review.ID); err != nil {
+		log.Printf("검토 삭제 실패: %s: %v", review.ID, err)
+	}
+}
+
+// OnTaskFinished 태스크가 만든 변경 사항을 기록하고 검토 대기 상태로 전환 (TaskFinishListener)
+// 실패하거나 취소된 태스크도 일부 변경이 남아 있을 수 있으므로 같은 절차로 검토합니다.
+func (s *TaskReviewService) OnTaskFinished(task *models.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := context.Background()
+	review, err := s.storage.TaskReview().GetByTaskID(ctx, task.ID)
+	if err != nil || review.Status != models.TaskReviewAwaitingTask {
+		return
+	}
+
+	review.Status = models.TaskReviewPending
+	review.TaskStatus = task.Status
+	if path, err := s.changedTree(ctx, review); err != nil {
+		log.Printf("검토 프로젝트 조회 실패: %s: %v", review.ID, err)
+	} else if diff, files, err := s.git.StageDiff(path, review.BaseCommit); err != nil {
+		log.Printf("검토 diff 생성 실패: %s: %v", review.ID, err)
+	} else {
+		review.FilesChanged = files
+		review.Diff = truncateOutput(diff, models.TaskReviewDiffMaxBytes)
+		review.DiffTruncated = len(diff) > models.TaskReviewDiffMaxBytes
+	}
+
+	if err := s.storage.TaskReview().Update(ctx, review); err != nil {
+		log.Printf("검토 저장 실패: %s: %v", review.ID, err)
+		return
+	}
+	s.notify(ctx, review, false)
+}
+
+// Get 검토 조회 (워크스페이스 read 권한 필요)
+func (s *TaskReviewService) Get(ctx context.Context, id, userID string, admin bool) (*models.TaskReview, error) {
+	review, err := s.storage.TaskReview().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "검토를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 조회 실패", err)
+	}
+	if err := s.authorize(ctx, review, userID, admin, models.WorkspacePermissionRead); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// GetByTask 태스크의 검토 조회 (워크스페이스 read 권한 필요)
+func (s *TaskReviewService) GetByTask(ctx context.Context, taskID, userID string, admin bool) (*models.TaskReview, error) {
+	review, err := s.storage.TaskReview().GetByTaskID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "검토가 필요한 태스크가 아닙니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 조회 실패", err)
+	}
+	if err := s.authorize(ctx, review, userID, admin, models.WorkspacePermissionRead); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// Diff 검토의 제안 diff 전체 (워크스페이스 read 권한 필요)
+// 섀도 복사본이 남아 있는 드라이런 검토는 잘리지 않은 diff를 다시 만들고, 그 외에는 저장된 diff를 반환합니다.
+func (s *TaskReviewService) Diff(ctx context.Context, id, userID string, admin bool) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	review, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return "", err
+	}
+	if !review.DryRun || review.ShadowPath == "" || review.Status != models.TaskReviewPending {
+		return review.Diff, nil
+	}
+	diff, _, err := s.git.StageDiff(review.ShadowPath, review.BaseCommit)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "드라이런 diff 생성 실패", err)
+	}
+	return diff, nil
+}
+
+// List 사용자가 접근할 수 있는 워크스페이스의 검토 조회 (최신순, admin은 전체)
+func (s *TaskReviewService) List(ctx context.Context, userID string, admin bool, filter *models.TaskReviewFilter) ([]*models.TaskReview, error) {
+	if !admin {
+		ids, err := s.access.AccessibleWorkspaceIDs(ctx, userID)
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+		}
+		filter.WorkspaceIDs = ids
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultTaskReviewLimit
+	}
+
+	reviews, err := s.storage.TaskReview().List(ctx, filter)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 목록 조회 실패", err)
+	}
+	return reviews, nil
+}
+
+// Approve 검토 승인 후 변경 사항 커밋 (워크스페이스 admin 권한 필요)
+func (s *TaskReviewService) Approve(ctx context.Context, id, userID string, admin bool, req *models.TaskReviewDecisionRequest) (*models.TaskReview, error) {
+	return s.decide(ctx, id, userID, admin, req, true)
+}
+
+// Reject 검토 거부 후 작업 트리를 기준 커밋으로 롤백 (워크스페이스 admin 권한 필요)
+func (s *TaskReviewService) Reject(ctx context.Context, id, userID string, admin bool, req *models.TaskReviewDecisionRequest) (*models.TaskReview, error) {
+	return s.decide(ctx, id, userID, admin, req, false)
+}
+
+// decide 승인 또는 거부 처리
+// 커밋이나 롤백에 실패하면 검토 대기 상태를 유지하므로 원인을 해결한 뒤 다시 결정할 수 있습니다.
+func (s *TaskReviewService) decide(ctx context.Context, id, userID string, admin bool, req *models.TaskReviewDecisionRequest, approve bool) (*models.TaskReview, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	review, err := s.Get(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, review, userID, admin, models.WorkspacePermissionAdmin); err != nil {
+		return nil, err
+	}
+	switch review.Status {
+	case models.TaskReviewPending:
+	case models.TaskReviewAwaitingTask:
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, "태스크가 아직 실행 중입니다", ErrInvalidRequest)
+	default:
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, fmt.Sprintf("이미 결정된 검토입니다 (상태: %s)", review.Status), ErrInvalidRequest)
+	}
+
+	project, err := s.storage.Project().GetByID(ctx, review.ProjectID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+	}
+
+	if approve {
+		if len(review.FilesChanged) > 0 {
+			message, err := s.commitMessage(ctx, review, req.CommitMessage)
+			if err != nil {
+				return nil, err
+			}
+			var head string
+			if review.DryRun {
+				if head, err = s.applyShadow(review, project.Path); err != nil {
+					return nil, err
+				}
+			}
+			commit, err := s.git.CommitAll(project.Path, message, userID)
+			if err != nil {
+				// 드라이런 변경은 다시 승인할 수 있도록 적용 전으로 되돌림
+				if review.DryRun {
+					if resetErr := s.git.ResetTo(project.Path, head); resetErr != nil {
+						log.Printf("드라이런 적용 취소 실패: %s: %v", review.ID, resetErr)
+					}
+				}
+				return nil, NewWorkspaceError(ErrCodeInternal, "변경 사항 커밋 실패", err)
+			}
+			review.AppliedCommit = commit
+		}
+		review.Status = models.TaskReviewApproved
+	} else {
+		// 드라이런은 작업 트리를 바꾸지 않았으므로 섀도 복사본만 삭제
+		if !review.DryRun {
+			if err := s.git.ResetTo(project.Path, review.BaseCommit); err != nil {
+				return nil, NewWorkspaceError(ErrCodeInternal, "변경 사항 롤백 실패", err)
+			}
+		}
+		review.Status = models.TaskReviewRejected
+	}
+	s.removeShadow(review)
+
+	now := time.Now()
+	review.ReviewerID = userID
+	review.Comment = req.Comment
+	review.DecidedAt = &now
+	if err := s.storage.TaskReview().Update(ctx, review); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 저장 실패", err)
+	}
+	s.notify(ctx, review, true)
+	return review, nil
+}
+
+// applyShadow 드라이런 섀도 복사본의 변경을 작업 트리에 적용하고 적용 전 HEAD 반환
+// 커밋에 실패했을 때 되돌리면서 다른 변경을 지우지 않도록 작업 트리가 깨끗해야 합니다.
+func (s *TaskReviewService) applyShadow(review *models.TaskReview, path string) (string, error) {
+	dirty, err := s.git.HasChanges(path)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "작업 트리 상태 확인 실패", err)
+	}
+	if dirty {
+		return "", NewWorkspaceError(ErrCodeInvalidStatus, "커밋되지 않은 변경 사항이 있어 제안된 변경을 적용할 수 없습니다", ErrInvalidRequest)
+	}
+	head, err := s.git.HeadCommit(path)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "HEAD 커밋 조회 실패", err)
+	}
+	if err := s.git.ApplyShadow(review.ShadowPath, path, review.BaseCommit); err != nil {
+		return "", NewWorkspaceError(ErrCodeInvalidStatus, "제안된 변경 사항을 작업 트리에 적용할 수 없습니다", err)
+	}
+	return head, nil
+}
+
+// changedTree 태스크가 변경한 작업 트리 (드라이런이면 섀도 복사본, 아니면 프로젝트 경로)
+func (s *TaskReviewService) changedTree(ctx context.Context, review *models.TaskReview) (string, error) {
+	if review.DryRun {
+		return review.ShadowPath, nil
+	}
+	project, err := s.storage.Project().GetByID(ctx, review.ProjectID)
+	if err != nil {
+		return "", err
+	}
+	return project.Path, nil
+}
+
+// commitMessage 승인 커밋 메시지 (지정하지 않으면 태스크 명령의 첫 줄)
+func (s *TaskReviewService) commitMessage(ctx context.Context, review *models.TaskReview, message string) (string, error) {
+	if message = strings.TrimSpace(message); message != "" {
+		return message, nil
+	}
+	task, err := s.storage.Task().GetByID(ctx, review.TaskID)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+	}
+
+	summary := strings.TrimSpace(strings.SplitN(strings.TrimSpace(task.Command), "\n", 2)[0])
+	if len(summary) > 72 {
+		summary = strings.ToValidUTF8(summary[:72], "")
+	}
+	return fmt.Sprintf("%s\n\nReviewed task %s", summary, task.ID), nil
+}
+
+// authorize 검토가 속한 워크스페이스에서 required 권한 확인 (admin 제외)
+func (s *TaskReviewService) authorize(ctx context.Context, review *models.TaskReview, userID string, admin bool, required models.WorkspacePermission) error {
+	if admin {
+		return nil
+	}
+	if _, err := s.access.Authorize(ctx, review.WorkspaceID, userID, required); err != nil {
+		if required == models.WorkspacePermissionRead {
+			return NewWorkspaceError(ErrCodeNotFound, "검토를 찾을 수 없습니다", storage.ErrNotFound)
+		}
+		return err
+	}
+	return nil
+}
+
+// notify 검토자를 찾아 알림 훅 호출
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *TaskReviewService) notify(ctx context.Context, review *models.TaskReview, decided bool) {
+	if len(s.listeners) == 0 {
+		return
+	}
+	workspace, err := s.storage.Workspace().GetByID(ctx, review.WorkspaceID)
+	if err != nil {
+		log.Printf("검토 워크스페이스 조회 실패: %s: %v", review.ID, err)
+		return
+	}
+	reviewers, err := s.access.UsersWithPermission(ctx, workspace, models.WorkspacePermissionAdmin)
+	if err != nil {
+		log.Printf("검토자 조회 실패: %s: %v", review.ID, err)
+		return
+	}
+
+	for _, listener := range s.listeners {
+		if decided {
+			listener.OnReviewDecided(review, reviewers)
+		} else {
+			listener.OnReviewRequested(review, reviewers)
+		}
+	}
+}
@@ -0,0 +1,501 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// revisionContextLines 수정 요청에 함께 보내는 의견 줄 앞뒤의 diff 줄 수
+	revisionContextLines = 3
+
+	// maxRevisionPromptBytes 수정 태스크 명령 최대 길이 (TaskCreateRequest의 command 제한과 같음)
+	maxRevisionPromptBytes = 10000
+)
+
+// ListComments 검토의 의견 조회 (워크스페이스 read 권한 필요)
+func (s *TaskReviewService) ListComments(ctx context.Context, reviewID, userID string, admin bool) ([]*models.TaskReviewComment, error) {
+	if _, err := s.Get(ctx, reviewID, userID, admin); err != nil {
+		return nil, err
+	}
+	comments, err := s.storage.TaskReview().ListComments(ctx, reviewID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 의견 조회 실패", err)
+	}
+	return comments, nil
+}
+
+// AddComment 검토 diff의 한 줄에 의견 추가 (워크스페이스 execute 권한 필요)
+// 의견은 diff에 있는 줄에만 남길 수 있으며, diff가 잘린 검토는 변경 파일이면 줄을 확인하지 않습니다.
+func (s *TaskReviewService) AddComment(ctx context.Context, reviewID, userID string, admin bool, req *models.TaskReviewCommentRequest) (*models.TaskReviewComment, error) {
+	review, err := s.Get(ctx, reviewID, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, review, userID, admin, models.WorkspacePermissionExecute); err != nil {
+		return nil, err
+	}
+	if review.Status == models.TaskReviewAwaitingTask {
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, "태스크가 아직 실행 중이라 diff가 없습니다", ErrInvalidRequest)
+	}
+
+	side := req.Side
+	if side == "" {
+		side = models.TaskReviewCommentNew
+	}
+	if err := validateReviewCommentLine(review, req.Path, req.Line, side); err != nil {
+		return nil, err
+	}
+
+	comment := &models.TaskReviewComment{
+		ReviewID:    review.ID,
+		WorkspaceID: review.WorkspaceID,
+		AuthorID:    userID,
+		Path:        req.Path,
+		Line:        req.Line,
+		Side:        side,
+		Body:        strings.TrimSpace(req.Body),
+	}
+	if comment.Body == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "의견 내용이 비어 있습니다", nil)
+	}
+	if err := s.storage.TaskReview().CreateComment(ctx, comment); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 의견 저장 실패", err)
+	}
+	return comment, nil
+}
+
+// UpdateComment 검토 의견 수정 또는 해결 표시
+// 내용은 작성자만 고칠 수 있고, 해결 여부는 작성자나 워크스페이스 admin이 바꿀 수 있습니다.
+func (s *TaskReviewService) UpdateComment(ctx context.Context, reviewID, commentID, userID string, admin bool, req *models.TaskReviewCommentUpdateRequest) (*models.TaskReviewComment, error) {
+	review, comment, err := s.getComment(ctx, reviewID, commentID, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Body != nil {
+		if comment.AuthorID != userID {
+			return nil, NewWorkspaceError(ErrCodeInsufficientPerm, "의견은 작성자만 수정할 수 있습니다", ErrUnauthorized)
+		}
+		body := strings.TrimSpace(*req.Body)
+		if body == "" {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "의견 내용이 비어 있습니다", nil)
+		}
+		comment.Body = body
+	}
+	if req.Resolved != nil && *req.Resolved != comment.Resolved {
+		if comment.AuthorID != userID {
+			if err := s.authorize(ctx, review, userID, admin, models.WorkspacePermissionAdmin); err != nil {
+				return nil, err
+			}
+		}
+		comment.Resolved = *req.Resolved
+		comment.ResolvedBy = ""
+		comment.ResolvedAt = nil
+		if comment.Resolved {
+			now := time.Now()
+			comment.ResolvedBy = userID
+			comment.ResolvedAt = &now
+		}
+	}
+
+	if err := s.storage.TaskReview().UpdateComment(ctx, comment); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 의견 저장 실패", err)
+	}
+	return comment, nil
+}
+
+// DeleteComment 검토 의견 삭제 (작성자 또는 워크스페이스 admin)
+func (s *TaskReviewService) DeleteComment(ctx context.Context, reviewID, commentID, userID string, admin bool) error {
+	review, comment, err := s.getComment(ctx, reviewID, commentID, userID, admin)
+	if err != nil {
+		return err
+	}
+	if comment.AuthorID != userID {
+		if err := s.authorize(ctx, review, userID, admin, models.WorkspacePermissionAdmin); err != nil {
+			return err
+		}
+	}
+	if err := s.storage.TaskReview().DeleteComment(ctx, comment.ID); err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "검토 의견 삭제 실패", err)
+	}
+	return nil
+}
+
+// getComment 검토에 속한 의견 조회 (워크스페이스 read 권한 필요)
+func (s *TaskReviewService) getComment(ctx context.Context, reviewID, commentID, userID string, admin bool) (*models.TaskReview, *models.TaskReviewComment, error) {
+	review, err := s.Get(ctx, reviewID, userID, admin)
+	if err != nil {
+		return nil, nil, err
+	}
+	comment, err := s.storage.TaskReview().GetComment(ctx, commentID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, nil, NewWorkspaceError(ErrCodeNotFound, "검토 의견을 찾을 수 없습니다", err)
+		}
+		return nil, nil, NewWorkspaceError(ErrCodeInternal, "검토 의견 조회 실패", err)
+	}
+	if comment.ReviewID != review.ID {
+		return nil, nil, NewWorkspaceError(ErrCodeNotFound, "검토 의견을 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	return review, comment, nil
+}
+
+// Revise 해결하지 않은 의견을 수정 지시로 정리해 새 세션에서 태스크 실행 (워크스페이스 execute 권한 필요)
+// 새 세션은 검토한 태스크의 프로젝트에 만들고 메타데이터로 검토와 원래 세션을 가리키며,
+// 전달한 의견에는 수정 세션과 태스크를 기록합니다. 의견은 검토자가 결과를 확인한 뒤 직접 해결합니다.
+// 결정되지 않은 검토는 작업 트리가 확정되지 않았으므로 승인하거나 거부한 뒤 요청할 수 있습니다.
+func (s *TaskReviewService) Revise(ctx context.Context, reviewID, userID string, admin bool, req *models.TaskReviewRevisionRequest) (*models.TaskReviewRevision, error) {
+	if s.tasks == nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "수정 태스크를 실행할 수 없는 서버입니다", ErrInvalidRequest)
+	}
+
+	review, err := s.Get(ctx, reviewID, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, review, userID, admin, models.WorkspacePermissionExecute); err != nil {
+		return nil, err
+	}
+	if review.Status.IsOpen() {
+		return nil, NewWorkspaceError(ErrCodeInvalidStatus, "검토를 승인하거나 거부한 뒤 수정을 요청할 수 있습니다", ErrInvalidRequest)
+	}
+
+	comments, err := s.revisionComments(ctx, review, req.CommentIDs)
+	if err != nil {
+		return nil, err
+	}
+	task, err := s.storage.Task().GetByID(ctx, review.TaskID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "검토한 태스크를 찾을 수 없습니다", err)
+	}
+	prompt := revisionPrompt(review, task, comments, req.Instructions)
+	if len(prompt) > maxRevisionPromptBytes {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("수정 지시가 너무 깁니다 (%d바이트, 최대 %d). comment_ids로 의견을 나눠 요청하세요", len(prompt), maxRevisionPromptBytes), nil)
+	}
+
+	session, err := s.createRevisionSession(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	revisionTask, err := s.tasks.Create(ctx, &models.TaskCreateRequest{
+		SessionID:     session.ID,
+		Command:       prompt,
+		TimeoutTier:   req.TimeoutTier,
+		RequireReview: req.RequireReview,
+		DryRun:        req.DryRun,
+		UserID:        userID,
+	})
+	if err != nil {
+		if deleteErr := s.storage.Session().Delete(ctx, session.ID); deleteErr != nil {
+			log.Printf("수정 세션 삭제 실패: %s: %v", session.ID, deleteErr)
+		}
+		return nil, err
+	}
+
+	for _, comment := range comments {
+		comment.RevisionSessionID = session.ID
+		comment.RevisionTaskID = revisionTask.ID
+		if err := s.storage.TaskReview().UpdateComment(ctx, comment); err != nil {
+			log.Printf("검토 의견에 수정 태스크 기록 실패: %s: %v", comment.ID, err)
+		}
+	}
+	return &models.TaskReviewRevision{
+		ReviewID:  review.ID,
+		SessionID: session.ID,
+		TaskID:    revisionTask.ID,
+		Comments:  comments,
+	}, nil
+}
+
+// revisionComments 수정 요청에 담을 의견 (ids가 비어 있으면 해결하지 않은 의견 전체)
+func (s *TaskReviewService) revisionComments(ctx context.Context, review *models.TaskReview, ids []string) ([]*models.TaskReviewComment, error) {
+	all, err := s.storage.TaskReview().ListComments(ctx, review.ID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "검토 의견 조회 실패", err)
+	}
+
+	var selected map[string]bool
+	if len(ids) > 0 {
+		selected = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			selected[id] = true
+		}
+	}
+	comments := []*models.TaskReviewComment{}
+	for _, comment := range all {
+		if selected != nil {
+			if !selected[comment.ID] {
+				continue
+			}
+			delete(selected, comment.ID)
+			if comment.Resolved {
+				return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("이미 해결한 의견입니다: %s", comment.ID), nil)
+			}
+		} else if comment.Resolved {
+			continue
+		}
+		comments = append(comments, comment)
+	}
+	for id := range selected {
+		return nil, NewWorkspaceError(ErrCodeNotFound, fmt.Sprintf("검토 의견을 찾을 수 없습니다: %s", id), storage.ErrNotFound)
+	}
+	if len(comments) == 0 {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "수정을 요청할 해결되지 않은 의견이 없습니다", nil)
+	}
+	return comments, nil
+}
+
+// createRevisionSession 검토한 태스크의 프로젝트에 수정 세션 생성 (원래 세션의 자원 제한을 이어받음)
+func (s *TaskReviewService) createRevisionSession(ctx context.Context, review *models.TaskReview) (*models.Session, error) {
+	if _, err := s.storage.Project().GetByID(ctx, review.ProjectID); err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+	}
+
+	now := time.Now()
+	session := &models.Session{
+		BaseModel:  models.BaseModel{ID: uuid.New().String()},
+		ProjectID:  review.ProjectID,
+		Status:     models.SessionActive,
+		StartedAt:  &now,
+		LastActive: now,
+		Metadata: map[string]string{
+			models.SessionMetaSource:        models.SessionSourceReviewRevision,
+			models.SessionMetaRevisionOf:    review.ID,
+			models.SessionMetaParentSession: review.SessionID,
+			models.SessionMetaParentTask:    review.TaskID,
+		},
+	}
+	if parent, err := s.storage.Session().GetByID(ctx, review.SessionID); err == nil {
+		session.MaxIdleTime = parent.MaxIdleTime
+		session.MaxLifetime = parent.MaxLifetime
+	}
+	if err := s.storage.Session().Create(ctx, session); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "수정 세션 생성 실패", err)
+	}
+	return session, nil
+}
+
+// revisionPrompt 검토 의견을 파일과 줄별 수정 지시로 정리한 태스크 명령
+func revisionPrompt(review *models.TaskReview, task *models.Task, comments []*models.TaskReviewComment, instructions string) string {
+	files := parseReviewDiff(review.Diff)
+
+	var b strings.Builder
+	b.WriteString("코드 검토에서 남긴 의견에 따라 이전 태스크의 변경 사항을 수정해 주세요.\n\n")
+	b.WriteString("## 원래 작업\n")
+	b.WriteString(strings.TrimSpace(task.Command))
+	b.WriteString("\n\n## 검토 결과\n")
+	if review.Status == models.TaskReviewApproved {
+		if review.AppliedCommit != "" {
+			fmt.Fprintf(&b, "변경 사항은 커밋 %s로 반영되었습니다. 현재 코드에서 아래 의견을 반영하세요.\n", review.AppliedCommit)
+		} else {
+			b.WriteString("변경 사항이 승인되었습니다. 현재 코드에서 아래 의견을 반영하세요.\n")
+		}
+	} else {
+		b.WriteString("변경 사항은 거부되어 되돌렸습니다. 원래 작업을 다시 수행하면서 아래 의견을 반영하세요.\n")
+	}
+	if comment := strings.TrimSpace(review.Comment); comment != "" {
+		fmt.Fprintf(&b, "검토 의견: %s\n", comment)
+	}
+
+	fmt.Fprintf(&b, "\n## 수정 요청 (%d건)\n", len(comments))
+	for i, comment := range comments {
+		side := "변경 후"
+		if comment.Side == models.TaskReviewCommentOld {
+			side = "변경 전"
+		}
+		fmt.Fprintf(&b, "\n### %d. %s:%d (%s)\n%s\n", i+1, comment.Path, comment.Line, side, comment.Body)
+		if snippet := files.snippet(comment.Path, comment.Line, comment.Side, revisionContextLines); snippet != "" {
+			fmt.Fprintf(&b, "```diff\n%s\n```\n", snippet)
+		}
+	}
+
+	if instructions = strings.TrimSpace(instructions); instructions != "" {
+		b.WriteString("\n## 추가 지시\n")
+		b.WriteString(instructions)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// validateReviewCommentLine 의견을 남길 파일과 줄이 검토 diff에 있는지 확인
+func validateReviewCommentLine(review *models.TaskReview, path string, line int, side models.TaskReviewCommentSide) error {
+	files := parseReviewDiff(review.Diff)
+	if _, index := files.locate(path, line, side); index >= 0 {
+		return nil
+	}
+
+	changed := false
+	for _, file := range review.FilesChanged {
+		if file == path {
+			changed = true
+			break
+		}
+	}
+	switch {
+	case !changed && files[path] == nil:
+		return NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("검토에서 변경하지 않은 파일입니다: %s", path), nil)
+	case review.DiffTruncated && files[path] == nil:
+		// 잘린 diff에 없는 파일은 줄을 확인할 수 없음
+		return nil
+	default:
+		return NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("diff에 없는 줄입니다: %s:%d (%s)", path, line, side), nil)
+	}
+}
+
+// reviewDiffLine diff hunk의 한 줄 (해당 쪽에 없는 줄은 번호가 0)
+type reviewDiffLine struct {
+	oldLine int
+	newLine int
+	text    string // 앞의 " ", "+", "-"를 포함한 원래 줄
+}
+
+// reviewDiffFiles 파일 경로별 hunk 목록
+type reviewDiffFiles map[string][][]reviewDiffLine
+
+// parseReviewDiff git diff에서 파일별 hunk 줄과 줄 번호 추출
+// 이름이 바뀐 파일은 새 경로, 삭제된 파일은 이전 경로로 기록합니다.
+func parseReviewDiff(diff string) reviewDiffFiles {
+	files := reviewDiffFiles{}
+
+	var (
+		oldPath, path    string
+		oldLine, newLine int
+		oldLeft, newLeft int
+	)
+	for _, line := range strings.Split(diff, "\n") {
+		if oldLeft > 0 || newLeft > 0 {
+			hunks := files[path]
+			current := &hunks[len(hunks)-1]
+			switch {
+			case strings.HasPrefix(line, "+"):
+				*current = append(*current, reviewDiffLine{newLine: newLine, text: line})
+				newLine++
+				newLeft--
+			case strings.HasPrefix(line, "-"):
+				*current = append(*current, reviewDiffLine{oldLine: oldLine, text: line})
+				oldLine++
+				oldLeft--
+			case strings.HasPrefix(line, `\`):
+				// "\ No newline at end of file"
+			default:
+				*current = append(*current, reviewDiffLine{oldLine: oldLine, newLine: newLine, text: " " + strings.TrimPrefix(line, " ")})
+				oldLine++
+				newLine++
+				oldLeft--
+				newLeft--
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			oldPath, path = "", ""
+		case strings.HasPrefix(line, "--- "):
+			oldPath = diffFilePath(line[4:])
+		case strings.HasPrefix(line, "+++ "):
+			if path = diffFilePath(line[4:]); path == "" {
+				path = oldPath
+			}
+		case strings.HasPrefix(line, "@@ ") && path != "":
+			oldStart, oldCount, newStart, newCount, ok := parseHunkHeader(line)
+			if !ok {
+				continue
+			}
+			oldLine, oldLeft, newLine, newLeft = oldStart, oldCount, newStart, newCount
+			files[path] = append(files[path], []reviewDiffLine{})
+		}
+	}
+	return files
+}
+
+// snippet 의견 줄과 앞뒤 around줄의 diff (같은 hunk 안에서, 줄이 없으면 빈 문자열)
+func (f reviewDiffFiles) snippet(path string, line int, side models.TaskReviewCommentSide, around int) string {
+	hunk, index := f.locate(path, line, side)
+	if index < 0 {
+		return ""
+	}
+	start, end := index-around, index+around+1
+	if start < 0 {
+		start = 0
+	}
+	if end > len(hunk) {
+		end = len(hunk)
+	}
+	lines := make([]string, 0, end-start)
+	for _, l := range hunk[start:end] {
+		lines = append(lines, l.text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// locate 줄이 속한 hunk와 hunk 안의 위치 (없으면 -1)
+func (f reviewDiffFiles) locate(path string, line int, side models.TaskReviewCommentSide) ([]reviewDiffLine, int) {
+	for _, hunk := range f[path] {
+		for i, l := range hunk {
+			if (side == models.TaskReviewCommentOld && l.oldLine == line) || (side != models.TaskReviewCommentOld && l.newLine == line) {
+				return hunk, i
+			}
+		}
+	}
+	return nil, -1
+}
+
+// diffFilePath "--- a/path", "+++ b/path" 줄의 경로 ("/dev/null"이면 빈 문자열)
+func diffFilePath(name string) string {
+	name = strings.TrimRight(name, "\r")
+	if i := strings.IndexByte(name, '\t'); i >= 0 {
+		name = name[:i]
+	}
+	if strings.HasPrefix(name, `"`) {
+		if unquoted, err := strconv.Unquote(name); err == nil {
+			name = unquoted
+		}
+	}
+	if name == "/dev/null" {
+		return ""
+	}
+	if strings.HasPrefix(name, "a/") || strings.HasPrefix(name, "b/") {
+		name = name[2:]
+	}
+	return name
+}
+
+// parseHunkHeader "@@ -oldStart,oldCount +newStart,newCount @@" 해석 (개수가 없으면 1)
+func parseHunkHeader(line string) (oldStart, oldCount, newStart, newCount int, ok bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 3 || !strings.HasPrefix(fields[1], "-") || !strings.HasPrefix(fields[2], "+") {
+		return 0, 0, 0, 0, false
+	}
+	parse := func(r string) (int, int, bool) {
+		start, count, found := strings.Cut(r, ",")
+		s, err := strconv.Atoi(start)
+		if err != nil {
+			return 0, 0, false
+		}
+		if !found {
+			return s, 1, true
+		}
+		c, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, 0, false
+		}
+		return s, c, true
+	}
+	if oldStart, oldCount, ok = parse(fields[1][1:]); !ok {
+		return 0, 0, 0, 0, false
+	}
+	if newStart, newCount, ok = parse(fields[2][1:]); !ok {
+		return 0, 0, 0, 0, false
+	}
+	return oldStart, oldCount, newStart, newCount, true
+}
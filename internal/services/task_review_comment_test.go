@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// reviewCommentDiff 수정, 추가, 삭제된 파일이 있는 검토 diff
+const reviewCommentDiff = `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1,5 +1,7 @@
+ package main
+
+-import "fmt"
++import (
++	"fmt"
++)
+
+ func main() {
+diff --git a/util.go b/util.go
+new file mode 100644
+index 0000000..3333333
+--- /dev/null
++++ b/util.go
+@@ -0,0 +1,2 @@
++package main
++func helper() {}
+diff --git a/old.go b/old.go
+deleted file mode 100644
+index 4444444..0000000
+--- a/old.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package main
+`
+
+func TestParseReviewDiff(t *testing.T) {
+	files := parseReviewDiff(reviewCommentDiff)
+	require.Len(t, files, 3)
+
+	tests := []struct {
+		path  string
+		line  int
+		side  models.TaskReviewCommentSide
+		found bool
+	}{
+		{"main.go", 4, models.TaskReviewCommentNew, true},
+		{"main.go", 3, models.TaskReviewCommentOld, true},
+		{"main.go", 4, models.TaskReviewCommentOld, true}, // 변경하지 않은 줄은 양쪽 번호가 있음
+		{"main.go", 8, models.TaskReviewCommentNew, false},
+		{"util.go", 2, models.TaskReviewCommentNew, true},
+		{"util.go", 1, models.TaskReviewCommentOld, false},
+		{"old.go", 1, models.TaskReviewCommentOld, true},
+		{"README.md", 1, models.TaskReviewCommentNew, false},
+	}
+	for _, tt := range tests {
+		_, index := files.locate(tt.path, tt.line, tt.side)
+		assert.Equal(t, tt.found, index >= 0, "%s:%d (%s)", tt.path, tt.line, tt.side)
+	}
+
+	assert.Equal(t, "+import (\n+\t\"fmt\"\n+)", files.snippet("main.go", 4, models.TaskReviewCommentNew, 1))
+	assert.Equal(t, " package main\n \n-import \"fmt\"\n+import (", files.snippet("main.go", 1, models.TaskReviewCommentNew, 3))
+	assert.Empty(t, files.snippet("main.go", 8, models.TaskReviewCommentNew, 1))
+}
+
+// startCommentedReview 변경 사항이 있는 검토 대기 상태의 검토 생성
+func startCommentedReview(t *testing.T, store *memory.Storage, service *TaskReviewService, sessionID string) *models.TaskReview {
+	task := startReviewedTask(t, store, service, sessionID, "import 정리")
+	task.Status = models.TaskCompleted
+	service.OnTaskFinished(task)
+	review, err := store.TaskReview().GetByTaskID(context.Background(), task.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.TaskReviewPending, review.Status)
+	return review
+}
+
+func TestTaskReviewService_Comments(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	git := &fakeReviewGit{head: "base1", diff: reviewCommentDiff, files: []string{"main.go", "util.go", "old.go"}}
+	service := NewTaskReviewService(store, git)
+	ws, session := createWorkspaceWithSession(t, store, "alice", "api")
+	require.NoError(t, store.WorkspaceACL().Upsert(ctx, &models.WorkspaceACLEntry{WorkspaceID: ws.ID, PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionExecute}))
+	require.NoError(t, store.WorkspaceACL().Upsert(ctx, &models.WorkspaceACLEntry{WorkspaceID: ws.ID, PrincipalType: models.ACLPrincipalUser, PrincipalID: "carol", Permission: models.WorkspacePermissionRead}))
+	review := startCommentedReview(t, store, service, session.ID)
+
+	comment, err := service.AddComment(ctx, review.ID, "bob", false, &models.TaskReviewCommentRequest{Path: "main.go", Line: 4, Body: "  괄호 import는 필요 없습니다  "})
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskReviewCommentNew, comment.Side)
+	assert.Equal(t, "괄호 import는 필요 없습니다", comment.Body)
+	assert.Equal(t, ws.ID, comment.WorkspaceID)
+
+	invalid := []struct {
+		name string
+		req  models.TaskReviewCommentRequest
+		code string
+	}{
+		{"diff에 없는 줄", models.TaskReviewCommentRequest{Path: "main.go", Line: 40, Body: "?"}, ErrCodeInvalidRequest},
+		{"삭제된 파일의 새 줄", models.TaskReviewCommentRequest{Path: "old.go", Line: 1, Body: "?"}, ErrCodeInvalidRequest},
+		{"변경하지 않은 파일", models.TaskReviewCommentRequest{Path: "README.md", Line: 1, Body: "?"}, ErrCodeInvalidRequest},
+		{"빈 의견", models.TaskReviewCommentRequest{Path: "main.go", Line: 4, Body: "   "}, ErrCodeInvalidRequest},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.AddComment(ctx, review.ID, "bob", false, &tt.req)
+			assertWorkspaceErrorCode(t, err, tt.code)
+		})
+	}
+
+	// read 권한으로는 조회만 가능
+	_, err = service.AddComment(ctx, review.ID, "carol", false, &models.TaskReviewCommentRequest{Path: "old.go", Line: 1, Side: models.TaskReviewCommentOld, Body: "삭제해도 되나요?"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+	_, err = service.ListComments(ctx, review.ID, "mallory", false)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+
+	deleted, err := service.AddComment(ctx, review.ID, "alice", false, &models.TaskReviewCommentRequest{Path: "old.go", Line: 1, Side: models.TaskReviewCommentOld, Body: "삭제해도 되나요?"})
+	require.NoError(t, err)
+	comments, err := service.ListComments(ctx, review.ID, "carol", false)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.Equal(t, "main.go", comments[0].Path)
+
+	// 내용은 작성자만, 해결은 작성자나 워크스페이스 admin
+	body := "fmt 하나면 한 줄 import로 충분합니다"
+	_, err = service.UpdateComment(ctx, review.ID, comment.ID, "alice", false, &models.TaskReviewCommentUpdateRequest{Body: &body})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+	updated, err := service.UpdateComment(ctx, review.ID, comment.ID, "bob", false, &models.TaskReviewCommentUpdateRequest{Body: &body})
+	require.NoError(t, err)
+	assert.Equal(t, body, updated.Body)
+
+	resolved := true
+	_, err = service.UpdateComment(ctx, review.ID, deleted.ID, "bob", false, &models.TaskReviewCommentUpdateRequest{Resolved: &resolved})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+	updated, err = service.UpdateComment(ctx, review.ID, comment.ID, "alice", false, &models.TaskReviewCommentUpdateRequest{Resolved: &resolved})
+	require.NoError(t, err)
+	assert.True(t, updated.Resolved)
+	assert.Equal(t, "alice", updated.ResolvedBy)
+	assert.NotNil(t, updated.ResolvedAt)
+
+	// 다른 검토의 의견 ID로는 찾을 수 없음
+	assertWorkspaceErrorCode(t, service.DeleteComment(ctx, "other-review", deleted.ID, "alice", false), ErrCodeNotFound)
+	assertWorkspaceErrorCode(t, service.DeleteComment(ctx, review.ID, deleted.ID, "bob", false), ErrCodeInsufficientPerm)
+	require.NoError(t, service.DeleteComment(ctx, review.ID, deleted.ID, "alice", false))
+	comments, err = service.ListComments(ctx, review.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Len(t, comments, 1)
+}
+
+func TestTaskReviewService_Revise(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	git := &fakeReviewGit{head: "base1", diff: reviewCommentDiff, files: []string{"main.go", "util.go", "old.go"}}
+	service := NewTaskReviewService(store, git)
+	tasks := &fakePipelineTasks{}
+	service.SetTaskRunner(tasks)
+	_, session := createWorkspaceWithSession(t, store, "alice", "api")
+	review := startCommentedReview(t, store, service, session.ID)
+
+	first, err := service.AddComment(ctx, review.ID, "alice", false, &models.TaskReviewCommentRequest{Path: "main.go", Line: 4, Body: "한 줄 import로 되돌려 주세요"})
+	require.NoError(t, err)
+	second, err := service.AddComment(ctx, review.ID, "alice", false, &models.TaskReviewCommentRequest{Path: "util.go", Line: 2, Body: "helper에 주석을 달아 주세요"})
+	require.NoError(t, err)
+	done, err := service.AddComment(ctx, review.ID, "alice", false, &models.TaskReviewCommentRequest{Path: "old.go", Line: 1, Side: models.TaskReviewCommentOld, Body: "삭제 확인"})
+	require.NoError(t, err)
+	resolved := true
+	_, err = service.UpdateComment(ctx, review.ID, done.ID, "alice", false, &models.TaskReviewCommentUpdateRequest{Resolved: &resolved})
+	require.NoError(t, err)
+
+	// 결정하기 전에는 요청할 수 없음
+	_, err = service.Revise(ctx, review.ID, "alice", false, &models.TaskReviewRevisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+
+	_, err = service.Reject(ctx, review.ID, "alice", false, &models.TaskReviewDecisionRequest{Comment: "import 스타일 확인 필요"})
+	require.NoError(t, err)
+
+	// 해결한 의견은 지정해서 보낼 수 없음
+	_, err = service.Revise(ctx, review.ID, "alice", false, &models.TaskReviewRevisionRequest{CommentIDs: []string{done.ID}})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	_, err = service.Revise(ctx, review.ID, "alice", false, &models.TaskReviewRevisionRequest{CommentIDs: []string{"missing"}})
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+	_, err = service.Revise(ctx, review.ID, "mallory", false, &models.TaskReviewRevisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+
+	revision, err := service.Revise(ctx, review.ID, "alice", false, &models.TaskReviewRevisionRequest{Instructions: "테스트도 실행해 주세요"})
+	require.NoError(t, err)
+	require.Len(t, revision.Comments, 2)
+	assert.Equal(t, first.ID, revision.Comments[0].ID)
+	assert.Equal(t, second.ID, revision.Comments[1].ID)
+
+	// 새 세션은 같은 프로젝트에서 검토와 원래 세션을 가리킴
+	revisionSession, err := store.Session().GetByID(ctx, revision.SessionID)
+	require.NoError(t, err)
+	assert.NotEqual(t, session.ID, revisionSession.ID)
+	assert.Equal(t, session.ProjectID, revisionSession.ProjectID)
+	assert.Equal(t, models.SessionActive, revisionSession.Status)
+	assert.Equal(t, models.SessionSourceReviewRevision, revisionSession.Metadata[models.SessionMetaSource])
+	assert.Equal(t, review.ID, revisionSession.Metadata[models.SessionMetaRevisionOf])
+	assert.Equal(t, session.ID, revisionSession.Metadata[models.SessionMetaParentSession])
+	assert.Equal(t, review.TaskID, revisionSession.Metadata[models.SessionMetaParentTask])
+
+	// 수정 지시는 원래 작업, 결정, 파일과 줄별 의견, diff 문맥, 추가 지시를 담음
+	require.Len(t, tasks.created, 1)
+	command := tasks.last().Command
+	assert.Equal(t, revision.TaskID, tasks.last().ID)
+	assert.Equal(t, revision.SessionID, tasks.last().SessionID)
+	assert.Contains(t, command, "## 원래 작업\nimport 정리")
+	assert.Contains(t, command, "거부되어 되돌렸습니다")
+	assert.Contains(t, command, "검토 의견: import 스타일 확인 필요")
+	assert.Contains(t, command, "### 1. main.go:4 (변경 후)\n한 줄 import로 되돌려 주세요\n```diff\n")
+	assert.Contains(t, command, "+\t\"fmt\"")
+	assert.Contains(t, command, "### 2. util.go:2 (변경 후)")
+	assert.NotContains(t, command, "삭제 확인")
+	assert.Contains(t, command, "## 추가 지시\n테스트도 실행해 주세요")
+
+	// 전달한 의견에 수정 세션과 태스크를 기록하고, 해결 여부는 그대로 둠
+	comments, err := service.ListComments(ctx, review.ID, "alice", false)
+	require.NoError(t, err)
+	for _, comment := range comments {
+		if comment.ID == done.ID {
+			assert.Empty(t, comment.RevisionSessionID)
+			continue
+		}
+		assert.Equal(t, revision.SessionID, comment.RevisionSessionID)
+		assert.Equal(t, revision.TaskID, comment.RevisionTaskID)
+		assert.False(t, comment.Resolved)
+	}
+
+	// 모두 해결하면 보낼 의견이 없음
+	for _, comment := range revision.Comments {
+		_, err = service.UpdateComment(ctx, review.ID, comment.ID, "alice", false, &models.TaskReviewCommentUpdateRequest{Resolved: &resolved})
+		require.NoError(t, err)
+	}
+	_, err = service.Revise(ctx, review.ID, "alice", false, &models.TaskReviewRevisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+}
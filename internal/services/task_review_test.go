@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeReviewGit 호출을 기록하는 테스트용 Git
+type fakeReviewGit struct {
+	head      string
+	headErr   error
+	dirty     bool
+	diff      string
+	files     []string
+	commits   []string
+	resets    []string
+	commitErr error
+
+	// 드라이런
+	shadows  []string
+	applied  []string
+	applyErr error
+}
+
+func (g *fakeReviewGit) HeadCommit(path string) (string, error) { return g.head, g.headErr }
+func (g *fakeReviewGit) HasChanges(path string) (bool, error)   { return g.dirty, nil }
+
+func (g *fakeReviewGit) StageDiff(path, base string) (string, []string, error) {
+	return g.diff, g.files, nil
+}
+
+func (g *fakeReviewGit) CommitAll(path, message, authorName string) (string, error) {
+	if g.commitErr != nil {
+		return "", g.commitErr
+	}
+	g.commits = append(g.commits, message)
+	return "c0ffee", nil
+}
+
+func (g *fakeReviewGit) ResetTo(path, base string) error {
+	g.resets = append(g.resets, base)
+	return nil
+}
+
+func (g *fakeReviewGit) CloneShadow(path, base, dest string) error {
+	g.shadows = append(g.shadows, dest)
+	return nil
+}
+
+func (g *fakeReviewGit) ApplyShadow(shadow, path, base string) error {
+	if g.applyErr != nil {
+		return g.applyErr
+	}
+	g.applied = append(g.applied, shadow+"→"+path)
+	return nil
+}
+
+// recordingReviewListener 알림 훅 호출 기록
+type recordingReviewListener struct {
+	requested []string
+	decided   []string
+	reviewers []string
+}
+
+func (l *recordingReviewListener) OnReviewRequested(review *models.TaskReview, reviewers []string) {
+	l.requested = append(l.requested, review.ID)
+	l.reviewers = reviewers
+}
+
+func (l *recordingReviewListener) OnReviewDecided(review *models.TaskReview, reviewers []string) {
+	l.decided = append(l.decided, string(review.Status))
+	l.reviewers = reviewers
+}
+
+// startReviewedTask 검토가 필요한 태스크를 저장하고 검토를 준비
+func startReviewedTask(t *testing.T, store *memory.Storage, service *TaskReviewService, sessionID, command string) *models.Task {
+	task := &models.Task{SessionID: sessionID, Command: command, Status: models.TaskPending}
+	require.NoError(t, store.Task().Create(context.Background(), task))
+	require.NoError(t, service.Prepare(context.Background(), task))
+	return task
+}
+
+func TestTaskReviewService_Prepare(t *testing.T) {
+	store := memory.New()
+	git := &fakeReviewGit{head: "base1"}
+	service := NewTaskReviewService(store, git)
+	_, session := createWorkspaceWithSession(t, store, "alice", "api")
+
+	task := startReviewedTask(t, store, service, session.ID, "리팩터링")
+	review, err := store.TaskReview().GetByTaskID(context.Background(), task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskReviewAwaitingTask, review.Status)
+	assert.Equal(t, "base1", review.BaseCommit)
+
+	// 결정되지 않은 검토가 있으면 같은 프로젝트에서 새 검토를 시작할 수 없음
+	second := &models.Task{SessionID: session.ID, Command: "다른 작업"}
+	require.NoError(t, store.Task().Create(context.Background(), second))
+	assertWorkspaceErrorCode(t, service.Prepare(context.Background(), second), ErrCodeResourceBusy)
+
+	// 제출하지 못한 태스크의 검토를 지우면 다시 시작할 수 있지만, 작업 트리가 깨끗해야 함
+	service.Discard(context.Background(), task.ID)
+	git.dirty = true
+	assertWorkspaceErrorCode(t, service.Prepare(context.Background(), second), ErrCodeInvalidStatus)
+
+	git.dirty = false
+	git.headErr = errors.New("not a git repository")
+	assertWorkspaceErrorCode(t, service.Prepare(context.Background(), second), ErrCodeInvalidRequest)
+}
+
+func TestTaskReviewService_ApproveAndReject(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	git := &fakeReviewGit{head: "base1", diff: "diff --git a/main.go b/main.go", files: []string{"main.go"}}
+	service := NewTaskReviewService(store, git)
+	listener := &recordingReviewListener{}
+	service.AddListener(listener)
+	ws, session := createWorkspaceWithSession(t, store, "alice", "api")
+	require.NoError(t, store.WorkspaceACL().Upsert(ctx, &models.WorkspaceACLEntry{WorkspaceID: ws.ID, PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionAdmin}))
+	require.NoError(t, store.WorkspaceACL().Upsert(ctx, &models.WorkspaceACLEntry{WorkspaceID: ws.ID, PrincipalType: models.ACLPrincipalUser, PrincipalID: "carol", Permission: models.WorkspacePermissionRead}))
+
+	task := startReviewedTask(t, store, service, session.ID, "로그 포맷 정리\n세부 지시")
+	review, err := service.GetByTask(ctx, task.ID, "carol", false)
+	require.NoError(t, err)
+
+	// 태스크가 끝나기 전에는 결정할 수 없음
+	_, err = service.Approve(ctx, review.ID, "alice", false, &models.TaskReviewDecisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+
+	task.Status = models.TaskCompleted
+	service.OnTaskFinished(task)
+	review, err = service.Get(ctx, review.ID, "carol", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskReviewPending, review.Status)
+	assert.Equal(t, []string{"main.go"}, review.FilesChanged)
+	assert.Equal(t, models.TaskCompleted, review.TaskStatus)
+	assert.Equal(t, []string{review.ID}, listener.requested)
+	assert.Equal(t, []string{"alice", "bob"}, listener.reviewers)
+
+	// read 권한만으로는 승인할 수 없음
+	_, err = service.Approve(ctx, review.ID, "carol", false, &models.TaskReviewDecisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	// 커밋에 실패하면 검토 대기 상태 유지
+	git.commitErr = errors.New("index.lock exists")
+	_, err = service.Approve(ctx, review.ID, "bob", false, &models.TaskReviewDecisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInternal)
+
+	git.commitErr = nil
+	approved, err := service.Approve(ctx, review.ID, "bob", false, &models.TaskReviewDecisionRequest{Comment: "좋습니다"})
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskReviewApproved, approved.Status)
+	assert.Equal(t, "c0ffee", approved.AppliedCommit)
+	assert.Equal(t, "bob", approved.ReviewerID)
+	assert.Equal(t, []string{"로그 포맷 정리\n\nReviewed task " + task.ID}, git.commits)
+	assert.Equal(t, []string{"approved"}, listener.decided)
+
+	_, err = service.Reject(ctx, review.ID, "bob", false, &models.TaskReviewDecisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+
+	// 다음 태스크는 거부하면 기준 커밋으로 롤백
+	git.head = "base2"
+	next := startReviewedTask(t, store, service, session.ID, "실험적 변경")
+	next.Status = models.TaskFailed
+	service.OnTaskFinished(next)
+	nextReview, err := store.TaskReview().GetByTaskID(ctx, next.ID)
+	require.NoError(t, err)
+
+	rejected, err := service.Reject(ctx, nextReview.ID, "alice", false, &models.TaskReviewDecisionRequest{Comment: "범위 초과"})
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskReviewRejected, rejected.Status)
+	assert.Equal(t, []string{"base2"}, git.resets)
+	assert.Len(t, git.commits, 1)
+
+	// 권한 없는 사용자에게는 목록에 나타나지 않음
+	reviews, err := service.List(ctx, "mallory", false, &models.TaskReviewFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, reviews)
+	reviews, err = service.List(ctx, "carol", false, &models.TaskReviewFilter{Status: models.TaskReviewRejected})
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, next.ID, reviews[0].TaskID)
+}
+
+func TestTaskReviewService_DryRun(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	git := &fakeReviewGit{head: "base1", dirty: true, diff: "diff --git a/main.go b/main.go", files: []string{"main.go"}}
+	service := NewTaskReviewService(store, git)
+	_, session := createWorkspaceWithSession(t, store, "alice", "api")
+
+	// 드라이런은 작업 트리를 바꾸지 않으므로 변경 사항이 있어도 시작할 수 있음
+	task := &models.Task{SessionID: session.ID, Command: "리팩터링 제안", Status: models.TaskPending, DryRun: true}
+	require.NoError(t, store.Task().Create(ctx, task))
+	require.NoError(t, service.Prepare(ctx, task))
+
+	shadow, err := service.ShadowPath(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{shadow}, git.shadows)
+	assert.DirExists(t, shadow)
+
+	task.Status = models.TaskCompleted
+	service.OnTaskFinished(task)
+	review, err := service.GetByTask(ctx, task.ID, "alice", false)
+	require.NoError(t, err)
+	assert.True(t, review.DryRun)
+	assert.Equal(t, models.TaskReviewPending, review.Status)
+	assert.Equal(t, []string{"main.go"}, review.FilesChanged)
+
+	diff, err := service.Diff(ctx, review.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, git.diff, diff)
+
+	// 승인할 때는 작업 트리가 깨끗해야 함
+	_, err = service.Approve(ctx, review.ID, "alice", false, &models.TaskReviewDecisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+	assert.Empty(t, git.applied)
+
+	// 적용한 뒤 커밋에 실패하면 적용 전으로 되돌리고 대기 상태 유지
+	git.dirty = false
+	git.commitErr = errors.New("index.lock exists")
+	_, err = service.Approve(ctx, review.ID, "alice", false, &models.TaskReviewDecisionRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInternal)
+	assert.Equal(t, []string{"base1"}, git.resets)
+	assert.DirExists(t, shadow)
+
+	git.commitErr = nil
+	approved, err := service.Approve(ctx, review.ID, "alice", false, &models.TaskReviewDecisionRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskReviewApproved, approved.Status)
+	assert.Equal(t, "c0ffee", approved.AppliedCommit)
+	assert.Len(t, git.applied, 2)
+	assert.NoDirExists(t, shadow)
+
+	// 거부하면 작업 트리는 그대로 두고 섀도 복사본만 삭제
+	next := &models.Task{SessionID: session.ID, Command: "실험", Status: models.TaskPending, DryRun: true}
+	require.NoError(t, store.Task().Create(ctx, next))
+	require.NoError(t, service.Prepare(ctx, next))
+	nextShadow, err := service.ShadowPath(ctx, next.ID)
+	require.NoError(t, err)
+	next.Status = models.TaskCompleted
+	service.OnTaskFinished(next)
+	nextReview, err := store.TaskReview().GetByTaskID(ctx, next.ID)
+	require.NoError(t, err)
+
+	_, err = service.Reject(ctx, nextReview.ID, "alice", false, &models.TaskReviewDecisionRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"base1"}, git.resets)
+	assert.NoDirExists(t, nextShadow)
+
+	// 드라이런이 아닌 검토는 섀도 복사본이 없음
+	_, err = service.ShadowPath(ctx, "unknown")
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
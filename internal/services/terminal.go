@@ -0,0 +1,510 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// defaultTerminalCols 크기를 지정하지 않았을 때 터미널 열 수
+	defaultTerminalCols = 120
+	// defaultTerminalRows 크기를 지정하지 않았을 때 터미널 행 수
+	defaultTerminalRows = 32
+	// defaultContainerShell 컨테이너 모드 기본 셸 (슬림 이미지에도 있는 셸)
+	defaultContainerShell = "/bin/sh"
+	// terminalMaxActivePerWorkspace 워크스페이스별 동시 터미널 수
+	terminalMaxActivePerWorkspace = 5
+	// terminalReadBufferSize 한 번에 전달하는 출력 크기
+	terminalReadBufferSize = 32 * 1024
+	// defaultTerminalSessionListLimit 터미널 기록 목록 조회 개수
+	defaultTerminalSessionListLimit = 100
+)
+
+// ErrTerminalPTYUnsupported 호스트 PTY를 지원하지 않는 플랫폼
+var ErrTerminalPTYUnsupported = errors.New("host terminal is not supported on this platform")
+
+// TerminalProcess PTY가 연결된 셸 프로세스
+type TerminalProcess interface {
+	io.ReadWriteCloser
+
+	// Resize 터미널 크기 변경
+	Resize(cols, rows int) error
+
+	// Wait 출력이 끝난 뒤 종료 코드 반환
+	Wait() (int, error)
+}
+
+// TerminalContainers 워크스페이스 컨테이너에서 셸을 여는 기능 (NewDockerTerminalContainers)
+type TerminalContainers interface {
+	// RunningContainer 워크스페이스의 실행 중인 컨테이너 ID (없으면 빈 문자열)
+	RunningContainer(ctx context.Context, workspaceID string) (string, error)
+
+	// Exec 컨테이너 안에서 TTY로 셸 시작
+	Exec(ctx context.Context, containerID, shell string, cols, rows int) (TerminalProcess, error)
+}
+
+// TerminalConn 터미널 클라이언트 연결 (WebSocket)
+type TerminalConn interface {
+	ReadMessage() (*models.TerminalMessage, error)
+	WriteMessage(msg *models.TerminalMessage) error
+	Close() error
+}
+
+// TerminalHandle 시작된 터미널 (Serve로 연결과 이어 줍니다)
+type TerminalHandle struct {
+	Session *models.TerminalSession
+
+	process  TerminalProcess
+	recorder *AsciicastRecorder
+}
+
+// TerminalService 워크스페이스 디렉토리나 컨테이너에서 대화형 셸을 열고 입출력을 녹화
+// 접속 권한은 라우터의 워크스페이스 권한과 RBAC terminal:execute 권한 미들웨어가 확인하며, 모든 접속은
+// 감사 기록(TerminalSession)과 asciicast v2 녹화로 남아 종료 후 재생할 수 있습니다.
+// 호스트 모드는 API 서버 호스트의 셸을 여는 것이므로 SetHostMode로 켜야만 사용할 수 있습니다.
+type TerminalService struct {
+	storage    storage.Storage
+	containers TerminalContainers
+	allowHost  bool
+
+	mu     sync.Mutex
+	active map[string]int // 워크스페이스 ID -> 접속 중인 터미널 수
+}
+
+// NewTerminalService 새 터미널 서비스 생성 (containers가 nil이면 호스트 모드만 사용 가능)
+func NewTerminalService(storage storage.Storage, containers TerminalContainers) *TerminalService {
+	return &TerminalService{
+		storage:    storage,
+		containers: containers,
+		active:     make(map[string]int),
+	}
+}
+
+// SetHostMode 컨테이너 없이 API 서버 호스트의 워크스페이스 디렉토리에서 셸을 여는 것을 허용 (기본 꺼짐)
+func (s *TerminalService) SetHostMode(enabled bool) {
+	s.allowHost = enabled
+}
+
+// Open 워크스페이스에서 셸을 시작하고 접속 기록을 생성합니다.
+// 모드를 지정하지 않으면 실행 중인 컨테이너가 있을 때 컨테이너, 없으면 (호스트 모드가 켜져 있을 때만) 호스트 디렉토리에서 엽니다.
+func (s *TerminalService) Open(ctx context.Context, workspaceID, userID string, req *models.TerminalOpenRequest) (*TerminalHandle, error) {
+	workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+	}
+
+	session := &models.TerminalSession{
+		WorkspaceID: workspace.ID,
+		UserID:      userID,
+		Mode:        req.Mode,
+		Shell:       strings.TrimSpace(req.Shell),
+		Cols:        req.Cols,
+		Rows:        req.Rows,
+		Status:      models.TerminalSessionActive,
+		ClientIP:    req.ClientIP,
+		UserAgent:   req.UserAgent,
+	}
+	if session.Cols <= 0 {
+		session.Cols = defaultTerminalCols
+	}
+	if session.Rows <= 0 {
+		session.Rows = defaultTerminalRows
+	}
+	if session.Shell != "" && !filepath.IsAbs(session.Shell) {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "셸은 절대 경로로 지정해야 합니다", ErrInvalidRequest)
+	}
+
+	if session.Mode == models.TerminalModeHost && !s.allowHost {
+		return nil, NewWorkspaceError(ErrCodeInsufficientPerm, "호스트 터미널이 비활성화되어 있습니다", ErrInsufficientPermissions)
+	}
+
+	if session.Mode == "" || session.Mode == models.TerminalModeContainer {
+		containerID := ""
+		if s.containers != nil {
+			if containerID, err = s.containers.RunningContainer(ctx, workspace.ID); err != nil {
+				return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 컨테이너 조회 실패", err)
+			}
+		}
+		switch {
+		case containerID != "":
+			session.Mode = models.TerminalModeContainer
+			session.ContainerID = containerID
+		case session.Mode == models.TerminalModeContainer || !s.allowHost:
+			return nil, NewWorkspaceError(ErrCodeInvalidStatus, "실행 중인 워크스페이스 컨테이너가 없습니다", ErrWorkspaceNotActive)
+		default:
+			session.Mode = models.TerminalModeHost
+		}
+	}
+
+	if err := s.acquire(workspace.ID); err != nil {
+		return nil, err
+	}
+
+	process, err := s.start(ctx, workspace, session)
+	if err != nil {
+		s.release(workspace.ID)
+		return nil, err
+	}
+
+	if err := s.storage.Terminal().Create(ctx, session); err != nil {
+		process.Close()
+		s.release(workspace.ID)
+		return nil, NewWorkspaceError(ErrCodeInternal, "터미널 접속 기록 생성 실패", err)
+	}
+
+	title := fmt.Sprintf("%s (%s)", workspace.Name, session.Mode)
+	return &TerminalHandle{
+		Session:  session,
+		process:  process,
+		recorder: NewAsciicastRecorder(session.Cols, session.Rows, session.Shell, title, session.StartedAt),
+	}, nil
+}
+
+// Serve 터미널과 클라이언트 연결 사이에서 입출력을 중계하고, 끝나면 녹화와 접속 기록을 저장합니다.
+// 셸이 끝나면 exit 메시지를 보내고 연결을 닫으며, 연결이 먼저 끊기거나 ctx가 취소되면 셸을 종료합니다.
+func (s *TerminalService) Serve(ctx context.Context, handle *TerminalHandle, conn TerminalConn) {
+	session := handle.Session
+	defer s.release(session.WorkspaceID)
+
+	var (
+		exitCode   *int
+		outputDone = make(chan struct{})
+	)
+	go func() {
+		defer close(outputDone)
+		defer conn.Close()
+
+		buf := make([]byte, terminalReadBufferSize)
+		var pending []byte
+		for {
+			n, err := handle.process.Read(buf)
+			if n > 0 {
+				data, rest := splitUTF8(append(pending, buf[:n]...))
+				pending = append([]byte(nil), rest...)
+				if len(data) > 0 {
+					handle.recorder.Output(data)
+					if conn.WriteMessage(&models.TerminalMessage{Type: models.TerminalMessageOutput, Data: string(data)}) != nil {
+						// 클라이언트에 더 보낼 수 없으면 셸을 종료하고 회수
+						handle.process.Close()
+					}
+				}
+			}
+			if err != nil {
+				break
+			}
+		}
+
+		code, err := handle.process.Wait()
+		if err != nil {
+			return
+		}
+		exitCode = &code
+		conn.WriteMessage(&models.TerminalMessage{Type: models.TerminalMessageExit, ExitCode: &code})
+	}()
+
+	stop := context.AfterFunc(ctx, func() { handle.process.Close() })
+	defer stop()
+
+relay:
+	for {
+		msg, err := conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		switch msg.Type {
+		case models.TerminalMessageInput:
+			handle.recorder.Input([]byte(msg.Data))
+			if _, err := handle.process.Write([]byte(msg.Data)); err != nil {
+				break relay
+			}
+		case models.TerminalMessageResize:
+			if msg.Cols <= 0 || msg.Rows <= 0 || msg.Cols > 1000 || msg.Rows > 1000 {
+				continue
+			}
+			if err := handle.process.Resize(msg.Cols, msg.Rows); err == nil {
+				handle.recorder.Resize(msg.Cols, msg.Rows)
+				session.Cols, session.Rows = msg.Cols, msg.Rows
+			}
+		}
+	}
+	handle.process.Close()
+	<-outputDone
+
+	session.ExitCode = exitCode
+	s.finish(session, handle.recorder)
+}
+
+// Abort 클라이언트와 연결하지 못한 터미널을 종료하고 접속 기록을 닫습니다.
+func (s *TerminalService) Abort(handle *TerminalHandle) {
+	defer s.release(handle.Session.WorkspaceID)
+
+	handle.process.Close()
+	if code, err := handle.process.Wait(); err == nil {
+		handle.Session.ExitCode = &code
+	}
+	s.finish(handle.Session, handle.recorder)
+}
+
+// ListSessions 워크스페이스의 터미널 접속 기록 조회 (최신순)
+func (s *TerminalService) ListSessions(ctx context.Context, workspaceID string) ([]*models.TerminalSession, error) {
+	sessions, err := s.storage.Terminal().ListByWorkspace(ctx, workspaceID, defaultTerminalSessionListLimit)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "터미널 접속 기록 조회 실패", err)
+	}
+	return sessions, nil
+}
+
+// GetSession 워크스페이스의 터미널 접속 기록 조회
+func (s *TerminalService) GetSession(ctx context.Context, workspaceID, sessionID string) (*models.TerminalSession, error) {
+	session, err := s.storage.Terminal().GetByID(ctx, sessionID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "터미널 접속 기록을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "터미널 접속 기록 조회 실패", err)
+	}
+	if session.WorkspaceID != workspaceID {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "터미널 접속 기록을 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	return session, nil
+}
+
+// GetRecording 터미널 녹화 조회 (asciicast v2, 접속 중인 세션은 종료 후 조회 가능)
+func (s *TerminalService) GetRecording(ctx context.Context, workspaceID, sessionID string) ([]byte, error) {
+	session, err := s.GetSession(ctx, workspaceID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status == models.TerminalSessionActive {
+		return nil, NewWorkspaceError(ErrCodeResourceBusy, "접속 중인 터미널은 종료 후 재생할 수 있습니다", ErrResourceBusy)
+	}
+
+	data, err := s.storage.Terminal().GetRecording(ctx, sessionID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "터미널 녹화가 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "터미널 녹화 조회 실패", err)
+	}
+	return data, nil
+}
+
+// start 모드에 맞게 셸 프로세스 시작
+func (s *TerminalService) start(ctx context.Context, workspace *models.Workspace, session *models.TerminalSession) (TerminalProcess, error) {
+	if session.Mode == models.TerminalModeContainer {
+		if session.Shell == "" {
+			session.Shell = defaultContainerShell
+		}
+		process, err := s.containers.Exec(ctx, session.ContainerID, session.Shell, session.Cols, session.Rows)
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "컨테이너 셸 시작 실패", err)
+		}
+		return process, nil
+	}
+
+	if info, err := os.Stat(workspace.ProjectPath); err != nil || !info.IsDir() {
+		return nil, NewWorkspaceError(ErrCodeInvalidPath, "워크스페이스 디렉토리를 찾을 수 없습니다", ErrInvalidProjectPath)
+	}
+	if session.Shell == "" {
+		session.Shell = defaultHostShell()
+	}
+	process, err := startHostTerminal(workspace, session.Shell, session.Cols, session.Rows)
+	if err != nil {
+		if errors.Is(err, ErrTerminalPTYUnsupported) {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "이 서버에서는 호스트 터미널을 사용할 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "셸 시작 실패", err)
+	}
+	return process, nil
+}
+
+// finish 녹화와 종료 상태 저장 (연결 요청의 ctx가 끝났을 수 있으므로 새 컨텍스트 사용)
+func (s *TerminalService) finish(session *models.TerminalSession, recorder *AsciicastRecorder) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	data := recorder.Bytes()
+	now := time.Now()
+	session.Status = models.TerminalSessionClosed
+	session.EndedAt = &now
+	session.InputBytes, session.OutputBytes = recorder.Counts()
+	session.RecordingSize = int64(len(data))
+	session.RecordingTruncated = recorder.Truncated()
+
+	if err := s.storage.Terminal().SaveRecording(ctx, session.ID, data); err != nil {
+		log.Printf("터미널 녹화 저장 실패 (session %s): %v", session.ID, err)
+	}
+	if err := s.storage.Terminal().Update(ctx, session); err != nil {
+		log.Printf("터미널 접속 기록 저장 실패 (session %s): %v", session.ID, err)
+	}
+}
+
+// acquire 워크스페이스 동시 터미널 수 확인 후 점유
+func (s *TerminalService) acquire(workspaceID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.active[workspaceID] >= terminalMaxActivePerWorkspace {
+		return NewWorkspaceError(ErrCodeResourceBusy, fmt.Sprintf("워크스페이스의 동시 터미널은 %d개까지 열 수 있습니다", terminalMaxActivePerWorkspace), ErrResourceBusy)
+	}
+	s.active[workspaceID]++
+	return nil
+}
+
+// release 워크스페이스 터미널 점유 해제
+func (s *TerminalService) release(workspaceID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.active[workspaceID] <= 1 {
+		delete(s.active, workspaceID)
+		return
+	}
+	s.active[workspaceID]--
+}
+
+// hostTerminal 호스트에서 PTY로 실행한 셸
+type hostTerminal struct {
+	cmd  *exec.Cmd
+	pty  *os.File
+	once sync.Once
+}
+
+// startHostTerminal 워크스페이스 디렉토리에서 셸 시작
+// 서버의 환경 변수(시크릿 포함)를 물려주지 않도록 최소한의 환경만 전달합니다.
+func startHostTerminal(workspace *models.Workspace, shell string, cols, rows int) (*hostTerminal, error) {
+	cmd := exec.Command(shell)
+	cmd.Dir = workspace.ProjectPath
+	cmd.Env = []string{
+		"TERM=xterm-256color",
+		"SHELL=" + shell,
+		"AICLI_WORKSPACE_ID=" + workspace.ID,
+	}
+	for _, key := range []string{"PATH", "HOME", "USER", "LANG", "LC_ALL"} {
+		if value, ok := os.LookupEnv(key); ok {
+			cmd.Env = append(cmd.Env, key+"="+value)
+		}
+	}
+
+	pty, err := startPTY(cmd, cols, rows)
+	if err != nil {
+		return nil, err
+	}
+	return &hostTerminal{cmd: cmd, pty: pty}, nil
+}
+
+// Read 셸 출력 읽기 (셸이 끝나 PTY가 닫히면 EIO 대신 EOF 반환)
+func (t *hostTerminal) Read(p []byte) (int, error) {
+	n, err := t.pty.Read(p)
+	if err != nil && (errors.Is(err, syscall.EIO) || errors.Is(err, os.ErrClosed)) {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Write 셸 입력 쓰기
+func (t *hostTerminal) Write(p []byte) (int, error) {
+	return t.pty.Write(p)
+}
+
+// Resize 터미널 크기 변경
+func (t *hostTerminal) Resize(cols, rows int) error {
+	return setPTYSize(t.pty, cols, rows)
+}
+
+// Wait 셸 종료 코드 반환 (시그널로 끝나면 -1)
+func (t *hostTerminal) Wait() (int, error) {
+	err := t.cmd.Wait()
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return -1, err
+	}
+	return t.cmd.ProcessState.ExitCode(), nil
+}
+
+// Close 셸 프로세스 그룹에 SIGHUP을 보내고 PTY를 닫습니다.
+func (t *hostTerminal) Close() error {
+	t.once.Do(func() {
+		if t.cmd.Process != nil {
+			hangupProcessGroup(t.cmd.Process.Pid)
+		}
+		t.pty.Close()
+	})
+	return nil
+}
+
+// defaultHostShell 호스트 모드 기본 셸 ($SHELL 또는 /bin/bash, 없으면 /bin/sh)
+func defaultHostShell() string {
+	if shell := os.Getenv("SHELL"); shell != "" {
+		return shell
+	}
+	if _, err := os.Stat("/bin/bash"); err == nil {
+		return "/bin/bash"
+	}
+	return "/bin/sh"
+}
+
+// dockerTerminalContainers Docker 매니저 기반 TerminalContainers
+type dockerTerminalContainers struct {
+	manager *docker.Manager
+}
+
+// NewDockerTerminalContainers Docker 매니저로 워크스페이스 컨테이너 터미널 제공
+func NewDockerTerminalContainers(manager *docker.Manager) TerminalContainers {
+	return &dockerTerminalContainers{manager: manager}
+}
+
+// RunningContainer 워크스페이스의 실행 중인 컨테이너 ID
+func (d *dockerTerminalContainers) RunningContainer(ctx context.Context, workspaceID string) (string, error) {
+	containers, err := d.manager.Container().ListWorkspaceContainers(ctx, workspaceID)
+	if err != nil {
+		return "", err
+	}
+	for _, container := range containers {
+		if container.State == docker.ContainerStateRunning {
+			return container.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// Exec 컨테이너 안에서 TTY로 셸 시작
+func (d *dockerTerminalContainers) Exec(ctx context.Context, containerID, shell string, cols, rows int) (TerminalProcess, error) {
+	exec, err := d.manager.Container().ExecInteractive(ctx, containerID, []string{shell}, "", []string{"TERM=xterm-256color"}, uint(cols), uint(rows))
+	if err != nil {
+		return nil, err
+	}
+	return &containerTerminal{exec: exec}, nil
+}
+
+// containerTerminal 컨테이너 exec 세션 기반 TerminalProcess
+type containerTerminal struct {
+	exec *docker.InteractiveExec
+}
+
+func (t *containerTerminal) Read(p []byte) (int, error)  { return t.exec.Read(p) }
+func (t *containerTerminal) Write(p []byte) (int, error) { return t.exec.Write(p) }
+func (t *containerTerminal) Close() error                { return t.exec.Close() }
+func (t *containerTerminal) Wait() (int, error)          { return t.exec.Wait() }
+
+// Resize 터미널 크기 변경
+func (t *containerTerminal) Resize(cols, rows int) error {
+	return t.exec.Resize(uint(cols), uint(rows))
+}
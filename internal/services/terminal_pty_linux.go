@@ -0,0 +1,79 @@
+//go:build linux
+
+package services
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"unsafe"
+)
+
+// openPTY /dev/ptmx에서 의사 터미널 쌍(master, slave)을 엽니다.
+func openPTY() (*os.File, *os.File, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var unlock int32
+	if err := ptyIoctl(master, syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("unlockpt: %w", err)
+	}
+	var number uint32
+	if err := ptyIoctl(master, syscall.TIOCGPTN, uintptr(unsafe.Pointer(&number))); err != nil {
+		master.Close()
+		return nil, nil, fmt.Errorf("ptsname: %w", err)
+	}
+
+	slave, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", number), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		return nil, nil, err
+	}
+	return master, slave, nil
+}
+
+// setPTYSize 터미널 크기 설정 (셸에는 SIGWINCH가 전달됩니다)
+func setPTYSize(master *os.File, cols, rows int) error {
+	size := struct {
+		Rows, Cols, X, Y uint16
+	}{Rows: uint16(rows), Cols: uint16(cols)}
+	return ptyIoctl(master, syscall.TIOCSWINSZ, uintptr(unsafe.Pointer(&size)))
+}
+
+// startPTY 새 세션의 제어 터미널로 PTY를 연결해 명령을 시작하고 master를 반환합니다.
+func startPTY(cmd *exec.Cmd, cols, rows int) (*os.File, error) {
+	master, slave, err := openPTY()
+	if err != nil {
+		return nil, err
+	}
+	defer slave.Close()
+
+	if err := setPTYSize(master, cols, rows); err != nil {
+		master.Close()
+		return nil, err
+	}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = slave, slave, slave
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true, Ctty: 0}
+	if err := cmd.Start(); err != nil {
+		master.Close()
+		return nil, err
+	}
+	return master, nil
+}
+
+// hangupProcessGroup 셸의 프로세스 그룹(setsid로 만든 세션)에 SIGHUP 전송
+func hangupProcessGroup(pid int) {
+	syscall.Kill(-pid, syscall.SIGHUP)
+}
+
+// ptyIoctl PTY 제어 ioctl 호출
+func ptyIoctl(f *os.File, request, arg uintptr) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), request, arg); errno != 0 {
+		return errno
+	}
+	return nil
+}
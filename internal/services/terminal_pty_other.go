@@ -0,0 +1,21 @@
+//go:build !linux
+
+package services
+
+import (
+	"os"
+	"os/exec"
+)
+
+// startPTY 이 플랫폼에서는 호스트 PTY를 지원하지 않음 (컨테이너 터미널만 사용 가능)
+func startPTY(cmd *exec.Cmd, cols, rows int) (*os.File, error) {
+	return nil, ErrTerminalPTYUnsupported
+}
+
+// setPTYSize 이 플랫폼에서는 지원하지 않음
+func setPTYSize(master *os.File, cols, rows int) error {
+	return ErrTerminalPTYUnsupported
+}
+
+// hangupProcessGroup 이 플랫폼에서는 호스트 셸을 시작하지 않으므로 할 일 없음
+func hangupProcessGroup(pid int) {}
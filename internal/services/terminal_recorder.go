@@ -0,0 +1,117 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// terminalRecordingMaxBytes 터미널 녹화 최대 크기 (넘으면 이후 이벤트는 기록하지 않음)
+const terminalRecordingMaxBytes = 16 << 20
+
+// AsciicastRecorder 터미널 입출력을 asciicast v2 형식으로 녹화
+// 첫 줄은 헤더 JSON이고, 이후 줄마다 [경과 초, "o"|"i"|"r", 데이터] 이벤트를 기록하므로
+// asciinema 플레이어로 그대로 재생할 수 있습니다.
+type AsciicastRecorder struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	started   time.Time
+	input     int64
+	output    int64
+	truncated bool
+}
+
+// NewAsciicastRecorder 새 녹화기 생성 (헤더 기록)
+func NewAsciicastRecorder(cols, rows int, shell, title string, started time.Time) *AsciicastRecorder {
+	r := &AsciicastRecorder{started: started}
+	header, _ := json.Marshal(map[string]interface{}{
+		"version":   2,
+		"width":     cols,
+		"height":    rows,
+		"timestamp": started.Unix(),
+		"title":     title,
+		"env":       map[string]string{"SHELL": shell, "TERM": "xterm-256color"},
+	})
+	r.buf.Write(header)
+	r.buf.WriteByte('\n')
+	return r
+}
+
+// Output 셸 출력 기록
+func (r *AsciicastRecorder) Output(data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.output += int64(len(data))
+	r.event("o", string(data))
+}
+
+// Input 사용자 입력 기록
+func (r *AsciicastRecorder) Input(data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.input += int64(len(data))
+	r.event("i", string(data))
+}
+
+// Resize 터미널 크기 변경 기록
+func (r *AsciicastRecorder) Resize(cols, rows int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.event("r", fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Bytes 지금까지의 녹화 데이터
+func (r *AsciicastRecorder) Bytes() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]byte(nil), r.buf.Bytes()...)
+}
+
+// Counts 입력/출력 바이트 수 (녹화가 잘려도 전체 크기를 셉니다)
+func (r *AsciicastRecorder) Counts() (int64, int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.input, r.output
+}
+
+// Truncated 크기 제한으로 녹화가 잘렸는지 여부
+func (r *AsciicastRecorder) Truncated() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.truncated
+}
+
+// event 이벤트 한 줄 추가 (호출자가 잠금 보유)
+func (r *AsciicastRecorder) event(kind, data string) {
+	if r.truncated {
+		return
+	}
+	line, err := json.Marshal([]interface{}{time.Since(r.started).Seconds(), kind, data})
+	if err != nil {
+		return
+	}
+	if r.buf.Len()+len(line)+1 > terminalRecordingMaxBytes {
+		r.truncated = true
+		return
+	}
+	r.buf.Write(line)
+	r.buf.WriteByte('\n')
+}
+
+// splitUTF8 끝에 잘린 멀티바이트 문자가 있으면 다음 읽기와 합치도록 분리
+func splitUTF8(data []byte) ([]byte, []byte) {
+	for i := 1; i <= utf8.UTFMax-1 && i <= len(data); i++ {
+		start := len(data) - i
+		if !utf8.RuneStart(data[start]) {
+			continue
+		}
+		if !utf8.FullRune(data[start:]) {
+			return data[:start], data[start:]
+		}
+		break
+	}
+	return data, nil
+}
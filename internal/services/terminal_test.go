@@ -0,0 +1,260 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeTerminalProcess 입력을 그대로 출력하고 "exit" 입력에 종료하는 테스트용 셸
+type fakeTerminalProcess struct {
+	out  *io.PipeReader
+	outW *io.PipeWriter
+}
+
+func newFakeTerminalProcess() *fakeTerminalProcess {
+	r, w := io.Pipe()
+	return &fakeTerminalProcess{out: r, outW: w}
+}
+
+func (p *fakeTerminalProcess) Read(b []byte) (int, error) { return p.out.Read(b) }
+
+func (p *fakeTerminalProcess) Write(b []byte) (int, error) {
+	if strings.TrimSpace(string(b)) == "exit" {
+		p.outW.Close()
+		return len(b), nil
+	}
+	return p.outW.Write(b)
+}
+
+func (p *fakeTerminalProcess) Resize(cols, rows int) error { return nil }
+
+func (p *fakeTerminalProcess) Wait() (int, error) { return 7, nil }
+
+func (p *fakeTerminalProcess) Close() error { return p.outW.Close() }
+
+// fakeTerminalContainers 실행 중인 컨테이너 하나를 돌려주는 테스트용 Docker
+type fakeTerminalContainers struct {
+	containerID string
+	process     *fakeTerminalProcess
+	shell       string
+}
+
+func (f *fakeTerminalContainers) RunningContainer(ctx context.Context, workspaceID string) (string, error) {
+	return f.containerID, nil
+}
+
+func (f *fakeTerminalContainers) Exec(ctx context.Context, containerID, shell string, cols, rows int) (TerminalProcess, error) {
+	f.shell = shell
+	f.process = newFakeTerminalProcess()
+	return f.process, nil
+}
+
+// fakeTerminalConn 미리 정한 메시지를 보내고 받은 메시지를 모으는 테스트용 연결
+type fakeTerminalConn struct {
+	in       chan *models.TerminalMessage
+	done     chan struct{}
+	once     sync.Once
+	mu       sync.Mutex
+	received []*models.TerminalMessage
+}
+
+func newFakeTerminalConn(messages ...*models.TerminalMessage) *fakeTerminalConn {
+	conn := &fakeTerminalConn{in: make(chan *models.TerminalMessage, len(messages)), done: make(chan struct{})}
+	for _, msg := range messages {
+		conn.in <- msg
+	}
+	return conn
+}
+
+func (c *fakeTerminalConn) ReadMessage() (*models.TerminalMessage, error) {
+	select {
+	case msg := <-c.in:
+		return msg, nil
+	case <-c.done:
+		return nil, errors.New("closed")
+	}
+}
+
+func (c *fakeTerminalConn) WriteMessage(msg *models.TerminalMessage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.received = append(c.received, msg)
+	return nil
+}
+
+func (c *fakeTerminalConn) Close() error {
+	c.once.Do(func() { close(c.done) })
+	return nil
+}
+
+func (c *fakeTerminalConn) output() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var out strings.Builder
+	for _, msg := range c.received {
+		if msg.Type == models.TerminalMessageOutput {
+			out.WriteString(msg.Data)
+		}
+	}
+	return out.String()
+}
+
+func TestTerminalService_ContainerSessionRecorded(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	containers := &fakeTerminalContainers{containerID: "c0ffee"}
+	service := NewTerminalService(store, containers)
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+
+	_, err := service.Open(ctx, ws.ID, "alice", &models.TerminalOpenRequest{Shell: "bash"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	handle, err := service.Open(ctx, ws.ID, "alice", &models.TerminalOpenRequest{ClientIP: "10.0.0.1"})
+	require.NoError(t, err)
+	assert.Equal(t, models.TerminalModeContainer, handle.Session.Mode)
+	assert.Equal(t, "c0ffee", handle.Session.ContainerID)
+	assert.Equal(t, defaultContainerShell, containers.shell)
+	assert.Equal(t, defaultTerminalCols, handle.Session.Cols)
+
+	conn := newFakeTerminalConn(
+		&models.TerminalMessage{Type: models.TerminalMessageInput, Data: "ls\n"},
+		&models.TerminalMessage{Type: models.TerminalMessageResize, Cols: 80, Rows: 24},
+		&models.TerminalMessage{Type: models.TerminalMessageInput, Data: "exit\n"},
+	)
+	service.Serve(ctx, handle, conn)
+
+	assert.Equal(t, "ls\n", conn.output())
+	last := conn.received[len(conn.received)-1]
+	assert.Equal(t, models.TerminalMessageExit, last.Type)
+	assert.Equal(t, 7, *last.ExitCode)
+
+	session, err := service.GetSession(ctx, ws.ID, handle.Session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TerminalSessionClosed, session.Status)
+	assert.Equal(t, 7, *session.ExitCode)
+	assert.Equal(t, 80, session.Cols)
+	assert.Equal(t, int64(len("ls\nexit\n")), session.InputBytes)
+	assert.Equal(t, int64(len("ls\n")), session.OutputBytes)
+	assert.Equal(t, "10.0.0.1", session.ClientIP)
+	assert.NotNil(t, session.EndedAt)
+
+	recording, err := service.GetRecording(ctx, ws.ID, handle.Session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, session.RecordingSize, int64(len(recording)))
+	kinds := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(recording))
+	require.True(t, scanner.Scan())
+	var header map[string]interface{}
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &header))
+	assert.Equal(t, float64(2), header["version"])
+	assert.Equal(t, float64(defaultTerminalCols), header["width"])
+	for scanner.Scan() {
+		var event []interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		kinds = append(kinds, event[1].(string)+":"+event[2].(string))
+	}
+	assert.Contains(t, kinds, "i:ls\n")
+	assert.Contains(t, kinds, "o:ls\n")
+	assert.Contains(t, kinds, "r:80x24")
+	assert.Contains(t, kinds, "i:exit\n")
+
+	// 다른 워크스페이스에서는 조회할 수 없음
+	other := createOwnedWorkspace(t, store, "alice", "web")
+	_, err = service.GetRecording(ctx, other.ID, handle.Session.ID)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+
+	sessions, err := service.ListSessions(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+}
+
+func TestTerminalService_ModeAndLimits(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	service := NewTerminalService(store, &fakeTerminalContainers{})
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+
+	// 실행 중인 컨테이너가 없으면 컨테이너 모드를 쓸 수 없음
+	_, err := service.Open(ctx, ws.ID, "alice", &models.TerminalOpenRequest{Mode: models.TerminalModeContainer})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+
+	// 호스트 모드는 켜지 않으면 쓸 수 없고, 자동으로 호스트 모드로 바뀌지도 않음
+	_, err = service.Open(ctx, ws.ID, "alice", &models.TerminalOpenRequest{Mode: models.TerminalModeHost})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+	_, err = service.Open(ctx, ws.ID, "alice", &models.TerminalOpenRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidStatus)
+	assert.Empty(t, service.active)
+	service.SetHostMode(true)
+
+	// 호스트 모드는 워크스페이스 디렉토리가 있어야 함
+	missingDir := &models.Workspace{Name: "gone", OwnerID: "alice", ProjectPath: "/nonexistent/aicli-terminal-test", Status: models.WorkspaceStatusActive}
+	require.NoError(t, store.Workspace().Create(ctx, missingDir))
+	_, err = service.Open(ctx, missingDir.ID, "alice", &models.TerminalOpenRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidPath)
+	assert.Empty(t, service.active)
+
+	_, err = service.Open(ctx, "missing", "alice", &models.TerminalOpenRequest{})
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+
+	for i := 0; i < terminalMaxActivePerWorkspace; i++ {
+		require.NoError(t, service.acquire(ws.ID))
+	}
+	assertWorkspaceErrorCode(t, service.acquire(ws.ID), ErrCodeResourceBusy)
+	service.release(ws.ID)
+	require.NoError(t, service.acquire(ws.ID))
+}
+
+func TestTerminalService_HostShell(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("호스트 PTY는 리눅스에서만 지원")
+	}
+	ctx := context.Background()
+	store := memory.New()
+	service := NewTerminalService(store, nil)
+	service.SetHostMode(true)
+	ws := &models.Workspace{Name: "host", OwnerID: "alice", ProjectPath: t.TempDir(), Status: models.WorkspaceStatusActive}
+	require.NoError(t, store.Workspace().Create(ctx, ws))
+
+	handle, err := service.Open(ctx, ws.ID, "alice", &models.TerminalOpenRequest{Shell: "/bin/sh", Cols: 100, Rows: 30})
+	require.NoError(t, err)
+	assert.Equal(t, models.TerminalModeHost, handle.Session.Mode)
+
+	conn := newFakeTerminalConn(&models.TerminalMessage{Type: models.TerminalMessageInput, Data: "echo pwd=$PWD; exit 3\n"})
+	service.Serve(ctx, handle, conn)
+
+	assert.Contains(t, conn.output(), "pwd="+ws.ProjectPath)
+	session, err := store.Terminal().GetByID(ctx, handle.Session.ID)
+	require.NoError(t, err)
+	require.NotNil(t, session.ExitCode)
+	assert.Equal(t, 3, *session.ExitCode)
+	assert.Empty(t, service.active)
+}
+
+func TestSplitUTF8(t *testing.T) {
+	text := []byte("터미널")
+	data, rest := splitUTF8(text[:4])
+	assert.Equal(t, "터", string(data))
+	assert.Equal(t, text[3:4], rest)
+
+	data, rest = splitUTF8(append(rest, text[4:]...))
+	assert.Equal(t, "미널", string(data))
+	assert.Empty(t, rest)
+
+	data, rest = splitUTF8([]byte("plain"))
+	assert.Equal(t, "plain", string(data))
+	assert.Empty(t, rest)
+}
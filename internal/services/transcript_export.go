@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// transcriptExportTimeout 내보내기 작업 하나의 최대 실행 시간 (조회, 렌더링, 저장 포함)
+	transcriptExportTimeout = 10 * time.Minute
+
+	// defaultTranscriptExportRetention 끝난 작업 기록 기본 보관 기간
+	defaultTranscriptExportRetention = 24 * time.Hour
+
+	// maxTranscriptExportMessages 문서 하나에 담을 수 있는 메시지 수 (메모리 사용량 제한)
+	maxTranscriptExportMessages = 50000
+)
+
+// transcriptExportFiles 형식별 파일 이름과 Content-Type
+var transcriptExportFiles = map[models.TranscriptExportFormat]struct {
+	filename    string
+	contentType string
+}{
+	models.TranscriptExportMarkdown: {"transcript.md", "text/markdown; charset=utf-8"},
+	models.TranscriptExportHTML:     {"transcript.html", "text/html; charset=utf-8"},
+	models.TranscriptExportPDF:      {"transcript.pdf", "application/pdf"},
+}
+
+// TranscriptExportStore 내보낸 문서를 아티팩트로 저장하는 인터페이스 (*ArtifactService)
+type TranscriptExportStore interface {
+	StoreTranscriptExport(ctx context.Context, userID, exportID, filename, contentType string, data []byte) (*ArtifactInfo, error)
+}
+
+// TranscriptExportListener 내보내기 작업이 끝나면 호출되는 훅 (알림 센터, WebSocket)
+type TranscriptExportListener interface {
+	OnTranscriptExportFinished(export *models.TranscriptExport)
+}
+
+// TranscriptExportService 세션 대화 기록을 Markdown, HTML, PDF 문서로 내보내는 서비스
+// 요청은 바로 작업 ID를 반환하고 문서는 백그라운드에서 만들어 아티팩트 저장소에 저장합니다.
+// 작업 기록은 메모리에만 두며, 끝난 작업은 보관 기간이 지나면 다음 요청 때 정리합니다.
+type TranscriptExportService struct {
+	storage   storage.Storage
+	access    *WorkspaceAccessService
+	artifacts TranscriptExportStore
+	pdf       TranscriptPDFRenderer
+	retention time.Duration
+	listeners []TranscriptExportListener
+
+	mu      sync.Mutex
+	exports map[string]*models.TranscriptExport
+}
+
+// NewTranscriptExportService 새 대화 기록 내보내기 서비스 생성
+// artifacts가 nil이면 내보내기를 사용할 수 없고, pdf가 nil이면 PDF 형식만 사용할 수 없습니다.
+func NewTranscriptExportService(storage storage.Storage, artifacts TranscriptExportStore, pdf TranscriptPDFRenderer, retention time.Duration) *TranscriptExportService {
+	if retention <= 0 {
+		retention = defaultTranscriptExportRetention
+	}
+	return &TranscriptExportService{
+		storage:   storage,
+		access:    NewWorkspaceAccessService(storage),
+		artifacts: artifacts,
+		pdf:       pdf,
+		retention: retention,
+		exports:   make(map[string]*models.TranscriptExport),
+	}
+}
+
+// AddListener 완료 훅 추가
+func (s *TranscriptExportService) AddListener(listener TranscriptExportListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, listener)
+}
+
+// Start 내보내기 작업 시작
+// 모든 세션에 read 권한이 있어야 합니다 (admin 제외). 같은 세션을 여러 번 지정하면 한 번만 담습니다.
+func (s *TranscriptExportService) Start(ctx context.Context, userID string, admin bool, req *models.TranscriptExportRequest) (*models.TranscriptExport, error) {
+	if s.artifacts == nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "아티팩트 저장소가 설정되지 않았습니다", ErrInvalidRequest)
+	}
+	if _, ok := transcriptExportFiles[req.Format]; !ok {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "지원하지 않는 내보내기 형식입니다", ErrInvalidRequest)
+	}
+	if req.Format == models.TranscriptExportPDF && s.pdf == nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "PDF 렌더러가 설정되지 않았습니다 (exports.pdf_command)", ErrInvalidRequest)
+	}
+
+	sessionIDs := make([]string, 0, len(req.SessionIDs))
+	seen := make(map[string]bool, len(req.SessionIDs))
+	for _, id := range req.SessionIDs {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		sessionIDs = append(sessionIDs, id)
+	}
+	if len(sessionIDs) == 0 {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "내보낼 세션을 지정해야 합니다", ErrInvalidRequest)
+	}
+	if len(sessionIDs) > models.MaxTranscriptExportSessions {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest,
+			fmt.Sprintf("한 번에 내보낼 수 있는 세션은 %d개까지입니다", models.MaxTranscriptExportSessions), ErrInvalidRequest)
+	}
+	for _, id := range sessionIDs {
+		if _, err := s.session(ctx, id, userID, admin); err != nil {
+			return nil, err
+		}
+	}
+
+	export := &models.TranscriptExport{
+		ID:               uuid.New().String(),
+		OwnerID:          userID,
+		Format:           req.Format,
+		Title:            transcriptExportTitle(req.Title, len(sessionIDs)),
+		SessionIDs:       sessionIDs,
+		ExcludeToolCalls: req.ExcludeToolCalls,
+		Status:           models.TranscriptExportPending,
+		CreatedAt:        time.Now(),
+	}
+
+	s.mu.Lock()
+	s.pruneLocked(export.CreatedAt)
+	s.exports[export.ID] = export
+	result := *export
+	s.mu.Unlock()
+
+	go s.run(export.ID, admin)
+	return &result, nil
+}
+
+// Get 내보내기 작업 조회 (시작한 사용자 또는 admin)
+func (s *TranscriptExportService) Get(ctx context.Context, id, userID string, admin bool) (*models.TranscriptExport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	export, ok := s.exports[id]
+	if !ok || (!admin && export.OwnerID != userID) {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "내보내기 작업을 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	result := *export
+	return &result, nil
+}
+
+// List 사용자가 시작한 내보내기 작업 (최신순)
+func (s *TranscriptExportService) List(ctx context.Context, userID string) ([]*models.TranscriptExport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exports := []*models.TranscriptExport{}
+	for _, export := range s.exports {
+		if export.OwnerID == userID {
+			result := *export
+			exports = append(exports, &result)
+		}
+	}
+	sort.Slice(exports, func(i, j int) bool {
+		return exports[i].CreatedAt.After(exports[j].CreatedAt)
+	})
+	return exports, nil
+}
+
+// run 문서를 만들어 저장하고 완료 훅 호출
+func (s *TranscriptExportService) run(id string, admin bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), transcriptExportTimeout)
+	defer cancel()
+
+	s.mu.Lock()
+	export := s.exports[id]
+	export.Status = models.TranscriptExportRunning
+	snapshot := *export
+	s.mu.Unlock()
+
+	info, messages, err := s.render(ctx, &snapshot, admin)
+
+	s.mu.Lock()
+	now := time.Now()
+	export.CompletedAt = &now
+	export.Messages = messages
+	if err != nil {
+		log.Printf("대화 기록 내보내기 실패: %s: %v", export.ID, err)
+		export.Status = models.TranscriptExportFailed
+		export.Error = err.Error()
+	} else {
+		export.Status = models.TranscriptExportCompleted
+		export.ArtifactKey = info.Key
+		export.Size = info.Size
+		export.DownloadURL = info.DownloadURL
+		expiresAt := info.ExpiresAt
+		export.ExpiresAt = &expiresAt
+	}
+	result := *export
+	listeners := append([]TranscriptExportListener(nil), s.listeners...)
+	s.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener.OnTranscriptExportFinished(&result)
+	}
+}
+
+// render 세션 대화 기록을 모아 요청한 형식의 문서로 만들어 저장
+// 작업을 시작한 뒤 권한이 바뀌었을 수 있으므로 세션 권한을 다시 확인합니다.
+func (s *TranscriptExportService) render(ctx context.Context, export *models.TranscriptExport, admin bool) (*ArtifactInfo, int, error) {
+	transcripts := make([]exportTranscript, 0, len(export.SessionIDs))
+	total := 0
+	for _, id := range export.SessionIDs {
+		session, err := s.session(ctx, id, export.OwnerID, admin)
+		if err != nil {
+			return nil, total, err
+		}
+		messages, err := s.transcript(ctx, id, maxTranscriptExportMessages-total)
+		if err != nil {
+			return nil, total, err
+		}
+		total += len(messages)
+		transcripts = append(transcripts, exportTranscript{session: session, messages: messages})
+	}
+
+	var document []byte
+	switch export.Format {
+	case models.TranscriptExportMarkdown:
+		document = []byte(renderTranscriptMarkdown(export.Title, transcripts, export.ExcludeToolCalls, export.CreatedAt))
+	case models.TranscriptExportHTML:
+		document = []byte(renderTranscriptHTML(export.Title, transcripts, export.ExcludeToolCalls, export.CreatedAt))
+	case models.TranscriptExportPDF:
+		pdf, err := s.pdf.RenderPDF(ctx, []byte(renderTranscriptHTML(export.Title, transcripts, export.ExcludeToolCalls, export.CreatedAt)))
+		if err != nil {
+			return nil, total, err
+		}
+		document = pdf
+	}
+
+	file := transcriptExportFiles[export.Format]
+	info, err := s.artifacts.StoreTranscriptExport(ctx, export.OwnerID, export.ID, file.filename, file.contentType, document)
+	if err != nil {
+		return nil, total, err
+	}
+	return info, total, nil
+}
+
+// transcript 세션의 모든 메시지를 순서대로 조회 (limit개를 넘으면 에러)
+func (s *TranscriptExportService) transcript(ctx context.Context, sessionID string, limit int) ([]*models.SessionMessage, error) {
+	messages := []*models.SessionMessage{}
+	for page := 1; ; page++ {
+		batch, total, err := s.storage.Message().ListBySession(ctx, sessionID, &models.PaginationRequest{Page: page, Limit: 100})
+		if err != nil {
+			return nil, err
+		}
+		if total > limit {
+			return nil, fmt.Errorf("문서 하나에 담을 수 있는 메시지는 %d개까지입니다", maxTranscriptExportMessages)
+		}
+		messages = append(messages, batch...)
+		if len(batch) == 0 || len(messages) >= total {
+			return messages, nil
+		}
+	}
+}
+
+// session 세션 조회와 read 권한 확인 (admin이면 권한 확인 생략)
+func (s *TranscriptExportService) session(ctx context.Context, id, userID string, admin bool) (*models.Session, error) {
+	if !admin {
+		return s.access.AuthorizeSession(ctx, id, userID, models.WorkspacePermissionRead)
+	}
+	session, err := s.storage.Session().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// pruneLocked 보관 기간이 지난 끝난 작업 정리
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *TranscriptExportService) pruneLocked(now time.Time) {
+	for id, export := range s.exports {
+		if export.IsFinished() && export.CompletedAt != nil && now.Sub(*export.CompletedAt) > s.retention {
+			delete(s.exports, id)
+		}
+	}
+}
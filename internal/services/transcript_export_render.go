@@ -0,0 +1,534 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// exportTranscript 문서에 담을 세션 하나와 메시지
+type exportTranscript struct {
+	session  *models.Session
+	messages []*models.SessionMessage
+}
+
+// exportEntry 문서에 넣을 메시지 하나 (도구 호출은 이름과 정리한 입력)
+type exportEntry struct {
+	kind claude.LineKind
+	role models.MessageRole
+	tool string
+	lang string // 코드 블록 언어 (도구 호출 입력과 출력)
+	body string
+	at   time.Time
+}
+
+// exportRoleLabels 작성 주체 표시 이름
+var exportRoleLabels = map[models.MessageRole]string{
+	models.MessageRoleUser:      "사용자",
+	models.MessageRoleAssistant: "Claude",
+	models.MessageRoleSystem:    "시스템",
+}
+
+// exportEntries 메시지를 문서 항목으로 변환 (ANSI 시퀀스 제거, 빈 메시지 생략)
+func exportEntries(messages []*models.SessionMessage, excludeToolCalls bool) []exportEntry {
+	entries := make([]exportEntry, 0, len(messages))
+	for _, message := range messages {
+		body := strings.TrimSpace(claude.StripANSI(message.Content))
+		if body == "" {
+			continue
+		}
+		entry := exportEntry{kind: claude.MessageKind(message.Type), role: message.Role, body: body, at: message.CreatedAt}
+		switch message.Role {
+		case models.MessageRoleUser:
+			entry.kind = claude.LineKindAssistant
+		case models.MessageRoleSystem:
+			if entry.kind == claude.LineKindAssistant {
+				entry.kind = claude.LineKindSystem
+			}
+		}
+		if excludeToolCalls && (entry.kind == claude.LineKindToolUse || entry.kind == claude.LineKindToolOutput) {
+			continue
+		}
+		if entry.kind == claude.LineKindToolUse {
+			entry.tool, entry.lang, entry.body = formatToolCall(body)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// formatToolCall Claude 도구 호출 JSON({"name":"Bash","input":{...}})을 도구 이름과 코드 블록으로 정리
+// 셸 명령은 명령만, 나머지는 들여쓴 JSON 입력을 반환합니다. JSON이 아니면 원문 그대로 둡니다.
+func formatToolCall(body string) (tool, lang, formatted string) {
+	var call struct {
+		Name  string          `json:"name"`
+		Input json.RawMessage `json:"input"`
+	}
+	if err := json.Unmarshal([]byte(body), &call); err != nil || call.Name == "" {
+		return "", "", body
+	}
+
+	var input map[string]interface{}
+	if err := json.Unmarshal(call.Input, &input); err == nil {
+		if command, ok := input["command"].(string); ok && call.Name == "Bash" {
+			return call.Name, "bash", command
+		}
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, call.Input, "", "  "); err != nil {
+		return call.Name, "", string(call.Input)
+	}
+	return call.Name, "json", indented.String()
+}
+
+// transcriptExportTitle 제목이 없으면 세션 수로 만든 제목
+func transcriptExportTitle(title string, sessions int) string {
+	if title = strings.Join(strings.Fields(title), " "); title != "" {
+		return title
+	}
+	if sessions == 1 {
+		return "세션 대화 기록"
+	}
+	return fmt.Sprintf("세션 대화 기록 (%d개)", sessions)
+}
+
+// exportSessionHeading 세션 장 제목
+func exportSessionHeading(index int, session *models.Session) string {
+	return fmt.Sprintf("%d. 세션 %s", index+1, session.ID)
+}
+
+// exportSessionFacts 세션 장 머리의 요약 항목
+func exportSessionFacts(session *models.Session, messages int) []string {
+	facts := []string{
+		"프로젝트: " + session.ProjectID,
+		"상태: " + string(session.Status),
+	}
+	if session.StartedAt != nil {
+		facts = append(facts, "시작: "+session.StartedAt.Format(time.RFC3339))
+	}
+	if session.EndedAt != nil {
+		facts = append(facts, "종료: "+session.EndedAt.Format(time.RFC3339))
+	}
+	if source := session.Metadata[models.SessionMetaSource]; source != "" {
+		facts = append(facts, "출처: "+source)
+	}
+	return append(facts, fmt.Sprintf("메시지: %d개", messages))
+}
+
+// exportEntryHeading 메시지 머리 (작성 주체와 시각)
+func exportEntryHeading(entry exportEntry) string {
+	label := exportRoleLabels[entry.role]
+	if label == "" {
+		label = string(entry.role)
+	}
+	switch entry.kind {
+	case claude.LineKindToolUse:
+		label = "도구 호출"
+		if entry.tool != "" {
+			label += ": " + entry.tool
+		}
+	case claude.LineKindToolOutput:
+		label = "도구 결과"
+	case claude.LineKindError:
+		label = "오류"
+	}
+	if entry.at.IsZero() {
+		return label
+	}
+	return label + " · " + entry.at.Format("2006-01-02 15:04:05")
+}
+
+// renderTranscriptMarkdown 세션마다 한 장씩 이어 붙인 Markdown 문서
+func renderTranscriptMarkdown(title string, transcripts []exportTranscript, excludeToolCalls bool, generatedAt time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	fmt.Fprintf(&b, "- 내보낸 시각: %s\n- 세션: %d개\n\n", generatedAt.Format(time.RFC3339), len(transcripts))
+
+	if len(transcripts) > 1 {
+		b.WriteString("## 목차\n\n")
+		for i, transcript := range transcripts {
+			fmt.Fprintf(&b, "- [%s](#session-%d)\n", exportSessionHeading(i, transcript.session), i+1)
+		}
+		b.WriteString("\n")
+	}
+
+	for i, transcript := range transcripts {
+		entries := exportEntries(transcript.messages, excludeToolCalls)
+		fmt.Fprintf(&b, "<a id=\"session-%d\"></a>\n\n## %s\n\n", i+1, exportSessionHeading(i, transcript.session))
+		for _, fact := range exportSessionFacts(transcript.session, len(entries)) {
+			fmt.Fprintf(&b, "- %s\n", fact)
+		}
+		b.WriteString("\n")
+
+		for _, entry := range entries {
+			fmt.Fprintf(&b, "### %s\n\n", exportEntryHeading(entry))
+			switch entry.kind {
+			case claude.LineKindToolUse, claude.LineKindToolOutput, claude.LineKindError:
+				writeLangFencedBlock(&b, entry.lang, entry.body)
+			case claude.LineKindSystem:
+				for _, line := range strings.Split(entry.body, "\n") {
+					fmt.Fprintf(&b, "> %s\n", line)
+				}
+				b.WriteString("\n")
+			default:
+				b.WriteString(entry.body)
+				b.WriteString("\n\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// writeLangFencedBlock 언어를 표시한 코드 블록 작성 (본문의 백틱보다 긴 펜스 사용)
+func writeLangFencedBlock(b *strings.Builder, lang, text string) {
+	fence := "```"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	fmt.Fprintf(b, "%s%s\n%s\n%s\n\n", fence, lang, strings.TrimRight(text, "\n"), fence)
+}
+
+// transcriptExportCSS 단독 HTML 문서 스타일 (인쇄해도 코드 블록이 잘리지 않도록 줄바꿈)
+const transcriptExportCSS = `body{font-family:-apple-system,"Segoe UI","Noto Sans KR",sans-serif;max-width:960px;margin:2rem auto;padding:0 1rem;color:#1f2328;line-height:1.6}
+h1{border-bottom:1px solid #d0d7de;padding-bottom:.3rem}
+h2{margin-top:2.5rem;border-bottom:1px solid #d0d7de;padding-bottom:.3rem;page-break-before:auto}
+.facts{color:#59636e;font-size:.9rem}
+.msg{margin:1rem 0;padding:.75rem 1rem;border-left:4px solid #d0d7de;border-radius:4px;background:#f6f8fa;page-break-inside:avoid}
+.msg.user{border-color:#0969da;background:#ddf4ff}
+.msg.assistant{border-color:#8250df;background:#fbefff}
+.msg.tool_use{border-color:#bf8700;background:#fff8c5}
+.msg.tool_output{border-color:#59636e}
+.msg.error{border-color:#cf222e;background:#ffebe9}
+.msg.system{border-color:#59636e;color:#59636e}
+.who{font-weight:600;font-size:.85rem;margin-bottom:.4rem}
+pre{background:#fff;border:1px solid #d0d7de;border-radius:6px;padding:.75rem;overflow-x:auto;white-space:pre-wrap;word-break:break-word;font-size:.85rem}
+code{font-family:ui-monospace,SFMono-Regular,Menlo,monospace}
+p code{background:rgba(175,184,193,.2);padding:.1rem .3rem;border-radius:4px}
+.tok-kw{color:#cf222e}.tok-str{color:#0a3069}.tok-num{color:#0550ae}.tok-com{color:#6e7781;font-style:italic}`
+
+// renderTranscriptHTML 스타일과 코드 강조를 포함한 단독 HTML 문서 (외부 리소스 없음)
+func renderTranscriptHTML(title string, transcripts []exportTranscript, excludeToolCalls bool, generatedAt time.Time) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html lang=\"ko\">\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&b, "<title>%s</title>\n<style>\n%s\n</style>\n</head>\n<body>\n", html.EscapeString(title), transcriptExportCSS)
+	fmt.Fprintf(&b, "<h1>%s</h1>\n<p class=\"facts\">내보낸 시각: %s · 세션: %d개</p>\n",
+		html.EscapeString(title), generatedAt.Format(time.RFC3339), len(transcripts))
+
+	if len(transcripts) > 1 {
+		b.WriteString("<nav>\n<h2>목차</h2>\n<ol>\n")
+		for i, transcript := range transcripts {
+			fmt.Fprintf(&b, "<li><a href=\"#session-%d\">%s</a></li>\n", i+1, html.EscapeString(exportSessionHeading(i, transcript.session)))
+		}
+		b.WriteString("</ol>\n</nav>\n")
+	}
+
+	for i, transcript := range transcripts {
+		entries := exportEntries(transcript.messages, excludeToolCalls)
+		fmt.Fprintf(&b, "<section>\n<h2 id=\"session-%d\">%s</h2>\n<p class=\"facts\">", i+1, html.EscapeString(exportSessionHeading(i, transcript.session)))
+		for j, fact := range exportSessionFacts(transcript.session, len(entries)) {
+			if j > 0 {
+				b.WriteString(" · ")
+			}
+			b.WriteString(html.EscapeString(fact))
+		}
+		b.WriteString("</p>\n")
+
+		for _, entry := range entries {
+			class := string(entry.kind)
+			if entry.kind == claude.LineKindAssistant {
+				class = string(entry.role)
+			}
+			fmt.Fprintf(&b, "<div class=\"msg %s\">\n<div class=\"who\">%s</div>\n", html.EscapeString(class), html.EscapeString(exportEntryHeading(entry)))
+			switch entry.kind {
+			case claude.LineKindToolUse, claude.LineKindToolOutput, claude.LineKindError:
+				writeHTMLCodeBlock(&b, entry.lang, entry.body)
+			default:
+				writeMarkdownHTML(&b, entry.body)
+			}
+			b.WriteString("</div>\n")
+		}
+		b.WriteString("</section>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+// writeMarkdownHTML 메시지 본문을 HTML로 변환
+// 대화에 자주 나오는 요소(코드 펜스, 제목, 목록, 인라인 코드)만 해석하고 나머지는 문단으로 둡니다.
+func writeMarkdownHTML(b *strings.Builder, text string) {
+	lines := strings.Split(text, "\n")
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		escaped := make([]string, len(paragraph))
+		for i, line := range paragraph {
+			escaped[i] = inlineMarkdownHTML(line)
+		}
+		fmt.Fprintf(b, "<p>%s</p>\n", strings.Join(escaped, "<br>\n"))
+		paragraph = nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+			fence := trimmed[:len(trimmed)-len(strings.TrimLeft(trimmed, "`"))]
+			lang := strings.TrimSpace(strings.TrimPrefix(trimmed, fence))
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence); i++ {
+				code = append(code, lines[i])
+			}
+			writeHTMLCodeBlock(b, lang, strings.Join(code, "\n"))
+		case trimmed == "":
+			flush()
+		case strings.HasPrefix(trimmed, "#"):
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			if level > 6 || !strings.HasPrefix(trimmed[level:], " ") {
+				paragraph = append(paragraph, line)
+				continue
+			}
+			flush()
+			// 문서 구조(h1 제목, h2 세션)보다 아래 단계로 낮춤
+			tag := min(level+2, 6)
+			fmt.Fprintf(b, "<h%d>%s</h%d>\n", tag, inlineMarkdownHTML(strings.TrimSpace(trimmed[level:])), tag)
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			flush()
+			b.WriteString("<ul>\n")
+			for ; i < len(lines); i++ {
+				item := strings.TrimSpace(lines[i])
+				if !strings.HasPrefix(item, "- ") && !strings.HasPrefix(item, "* ") {
+					break
+				}
+				fmt.Fprintf(b, "<li>%s</li>\n", inlineMarkdownHTML(item[2:]))
+			}
+			i--
+			b.WriteString("</ul>\n")
+		default:
+			paragraph = append(paragraph, line)
+		}
+	}
+	flush()
+}
+
+// inlineMarkdownHTML 한 줄을 이스케이프하고 인라인 코드(`code`)와 굵게(**text**)만 변환
+func inlineMarkdownHTML(line string) string {
+	parts := strings.Split(line, "`")
+	var b strings.Builder
+	for i, part := range parts {
+		switch {
+		case i%2 == 1 && i < len(parts)-1:
+			fmt.Fprintf(&b, "<code>%s</code>", html.EscapeString(part))
+		case i%2 == 1:
+			// 닫히지 않은 백틱은 그대로 표시
+			b.WriteString("`" + boldMarkdownHTML(part))
+		default:
+			b.WriteString(boldMarkdownHTML(part))
+		}
+	}
+	return b.String()
+}
+
+// boldMarkdownHTML 이스케이프 후 짝이 맞는 **text**를 <strong>으로 변환
+func boldMarkdownHTML(text string) string {
+	parts := strings.Split(html.EscapeString(text), "**")
+	if len(parts) < 3 {
+		return strings.Join(parts, "**")
+	}
+	var b strings.Builder
+	for i, part := range parts {
+		switch {
+		case i%2 == 1 && i < len(parts)-1:
+			fmt.Fprintf(&b, "<strong>%s</strong>", part)
+		case i%2 == 1:
+			b.WriteString("**" + part)
+		default:
+			b.WriteString(part)
+		}
+	}
+	return b.String()
+}
+
+// writeHTMLCodeBlock 언어별 강조를 적용한 코드 블록 작성
+func writeHTMLCodeBlock(b *strings.Builder, lang, code string) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	class := ""
+	if lang != "" {
+		class = fmt.Sprintf(" class=\"language-%s\"", html.EscapeString(lang))
+	}
+	fmt.Fprintf(b, "<pre><code%s>%s</code></pre>\n", class, highlightCode(lang, code))
+}
+
+// codeLanguage 코드 강조 규칙 (주석 문법과 키워드)
+type codeLanguage struct {
+	lineComment  string
+	blockComment bool // /* */ 주석 지원
+	backtick     bool // `...` 문자열 지원
+	keywords     map[string]bool
+}
+
+func keywordSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(words) {
+		set[word] = true
+	}
+	return set
+}
+
+var (
+	goLanguage = &codeLanguage{lineComment: "//", blockComment: true, backtick: true, keywords: keywordSet(
+		"break case chan const continue default defer else fallthrough for func go goto if import interface map package range return select struct switch type var nil true false")}
+	jsLanguage = &codeLanguage{lineComment: "//", blockComment: true, backtick: true, keywords: keywordSet(
+		"async await break case catch class const continue default delete do else export extends false finally for from function if import in instanceof interface let new null of return switch this throw true try type typeof undefined var void while yield")}
+	pythonLanguage = &codeLanguage{lineComment: "#", keywords: keywordSet(
+		"and as assert async await break class continue def del elif else except False finally for from global if import in is lambda None nonlocal not or pass raise return True try while with yield")}
+	shellLanguage = &codeLanguage{lineComment: "#", keywords: keywordSet(
+		"case cd do done echo elif else esac export fi for function if in local return then until while")}
+	jsonLanguage = &codeLanguage{keywords: keywordSet("true false null")}
+)
+
+// codeLanguages 코드 블록 언어 이름 -> 강조 규칙
+var codeLanguages = map[string]*codeLanguage{
+	"go": goLanguage, "golang": goLanguage,
+	"js": jsLanguage, "javascript": jsLanguage, "jsx": jsLanguage, "ts": jsLanguage, "typescript": jsLanguage, "tsx": jsLanguage,
+	"py": pythonLanguage, "python": pythonLanguage,
+	"sh": shellLanguage, "bash": shellLanguage, "shell": shellLanguage, "zsh": shellLanguage, "console": shellLanguage,
+	"json": jsonLanguage,
+}
+
+// highlightCode 주석, 문자열, 숫자, 키워드를 span으로 감싼 HTML (모르는 언어는 이스케이프만)
+func highlightCode(lang, code string) string {
+	rules, ok := codeLanguages[lang]
+	if !ok {
+		return html.EscapeString(code)
+	}
+
+	var b strings.Builder
+	span := func(class, text string) {
+		fmt.Fprintf(&b, "<span class=\"tok-%s\">%s</span>", class, html.EscapeString(text))
+	}
+	runes := []rune(code)
+	for i := 0; i < len(runes); {
+		rest := string(runes[i:])
+		c := runes[i]
+		switch {
+		case rules.lineComment != "" && strings.HasPrefix(rest, rules.lineComment):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			span("com", rest[:end])
+			i += len([]rune(rest[:end]))
+		case rules.blockComment && strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				end = len(rest)
+			} else {
+				end += 4
+			}
+			span("com", rest[:end])
+			i += len([]rune(rest[:end]))
+		case c == '"' || c == '\'' || (c == '`' && rules.backtick):
+			j := i + 1
+			for j < len(runes) && runes[j] != c {
+				if runes[j] == '\n' && c != '`' {
+					break
+				}
+				if runes[j] == '\\' && c != '`' && j+1 < len(runes) {
+					j++
+				}
+				j++
+			}
+			if j < len(runes) && runes[j] == c {
+				j++
+			}
+			span("str", string(runes[i:j]))
+			i = j
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || unicode.IsLetter(runes[j]) || runes[j] == '.' || runes[j] == '_') {
+				j++
+			}
+			span("num", string(runes[i:j]))
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			word := string(runes[i:j])
+			if rules.keywords[word] {
+				span("kw", word)
+			} else {
+				b.WriteString(html.EscapeString(word))
+			}
+			i = j
+		default:
+			b.WriteString(html.EscapeString(string(c)))
+			i++
+		}
+	}
+	return b.String()
+}
+
+// TranscriptPDFRenderer HTML 문서를 PDF로 인쇄하는 인터페이스
+type TranscriptPDFRenderer interface {
+	RenderPDF(ctx context.Context, document []byte) ([]byte, error)
+}
+
+// HeadlessPDFRenderer Chromium 호환 헤드리스 브라우저(--print-to-pdf)로 HTML을 PDF로 인쇄
+type HeadlessPDFRenderer struct {
+	// Command 브라우저 실행 파일 (예: chromium, google-chrome)
+	Command string
+
+	// Timeout 인쇄 한 번의 최대 실행 시간 (0이면 제한 없음)
+	Timeout time.Duration
+}
+
+// RenderPDF 임시 디렉토리에 HTML을 쓰고 브라우저로 인쇄한 PDF를 반환
+func (r *HeadlessPDFRenderer) RenderPDF(ctx context.Context, document []byte) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "aicli-export-")
+	if err != nil {
+		return nil, fmt.Errorf("임시 디렉토리 생성 실패: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "transcript.html")
+	output := filepath.Join(dir, "transcript.pdf")
+	if err := os.WriteFile(input, document, 0o600); err != nil {
+		return nil, fmt.Errorf("HTML 문서 저장 실패: %w", err)
+	}
+
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+	cmd := exec.CommandContext(ctx, r.Command,
+		"--headless", "--disable-gpu", "--no-sandbox", "--no-pdf-header-footer",
+		"--user-data-dir="+filepath.Join(dir, "profile"),
+		"--print-to-pdf="+output, "file://"+input)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("PDF 인쇄 실패: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	pdf, err := os.ReadFile(output)
+	if err != nil {
+		return nil, fmt.Errorf("PDF 파일을 읽을 수 없습니다: %w", err)
+	}
+	return pdf, nil
+}
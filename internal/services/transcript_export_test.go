@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// exportTestMessages 텍스트, 도구 호출, 도구 결과, 시스템 메시지가 섞인 대화
+func exportTestMessages(sessionID, workspaceID string) []*models.SessionMessage {
+	at := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	return []*models.SessionMessage{
+		{SessionID: sessionID, WorkspaceID: workspaceID, Role: models.MessageRoleUser, Content: "테스트를 실행해 주세요", CreatedAt: at},
+		{SessionID: sessionID, WorkspaceID: workspaceID, Role: models.MessageRoleAssistant, Type: "text", Content: "수정한 코드입니다:\n\n```go\nfunc main() {}\n```", CreatedAt: at.Add(time.Second)},
+		{SessionID: sessionID, WorkspaceID: workspaceID, Role: models.MessageRoleAssistant, Type: "tool_use", Content: `{"name":"Bash","input":{"command":"go test ./..."}}`, CreatedAt: at.Add(2 * time.Second)},
+		{SessionID: sessionID, WorkspaceID: workspaceID, Role: models.MessageRoleAssistant, Type: "tool_use", Content: `{"name":"Read","input":{"file_path":"main.go"}}`, CreatedAt: at.Add(3 * time.Second)},
+		{SessionID: sessionID, WorkspaceID: workspaceID, Role: models.MessageRoleAssistant, Type: "tool_result", Content: "\x1b[32mok\x1b[0m  example.com/app", CreatedAt: at.Add(4 * time.Second)},
+		{SessionID: sessionID, WorkspaceID: workspaceID, Role: models.MessageRoleSystem, Content: "세션이 종료되었습니다", CreatedAt: at.Add(5 * time.Second)},
+		{SessionID: sessionID, WorkspaceID: workspaceID, Role: models.MessageRoleAssistant, Type: "text", Content: "   ", CreatedAt: at.Add(6 * time.Second)},
+	}
+}
+
+func TestRenderTranscriptMarkdown(t *testing.T) {
+	first := &models.Session{ProjectID: "project-1", Status: models.SessionEnded}
+	first.ID = "session-a"
+	second := &models.Session{ProjectID: "project-1", Status: models.SessionActive}
+	second.ID = "session-b"
+	transcripts := []exportTranscript{
+		{session: first, messages: exportTestMessages(first.ID, "ws-1")},
+		{session: second, messages: exportTestMessages(second.ID, "ws-1")[:1]},
+	}
+	generatedAt := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	doc := renderTranscriptMarkdown("배포 작업", transcripts, false, generatedAt)
+	assert.True(t, strings.HasPrefix(doc, "# 배포 작업\n"))
+	assert.Contains(t, doc, "- [1. 세션 session-a](#session-1)\n- [2. 세션 session-b](#session-2)")
+	assert.Contains(t, doc, "## 1. 세션 session-a")
+	assert.Contains(t, doc, "- 메시지: 6개")
+	assert.Contains(t, doc, "### 사용자 · 2026-10-01 09:00:00\n\n테스트를 실행해 주세요")
+	assert.Contains(t, doc, "### 도구 호출: Bash · 2026-10-01 09:00:02\n\n```bash\ngo test ./...\n```")
+	assert.Contains(t, doc, "```json\n{\n  \"file_path\": \"main.go\"\n}\n```")
+	assert.Contains(t, doc, "### 도구 결과 · 2026-10-01 09:00:04\n\n```\nok  example.com/app\n```")
+	assert.Contains(t, doc, "> 세션이 종료되었습니다")
+	assert.NotContains(t, doc, "\x1b[")
+
+	// 도구 호출을 빼면 대화만 남음
+	doc = renderTranscriptMarkdown("배포 작업", transcripts[:1], true, generatedAt)
+	assert.NotContains(t, doc, "목차")
+	assert.NotContains(t, doc, "도구 호출")
+	assert.NotContains(t, doc, "도구 결과")
+	assert.Contains(t, doc, "- 메시지: 3개")
+}
+
+func TestRenderTranscriptHTML(t *testing.T) {
+	session := &models.Session{ProjectID: "project-1", Status: models.SessionEnded}
+	session.ID = "session-a"
+	messages := append(exportTestMessages(session.ID, "ws-1"), &models.SessionMessage{
+		SessionID: session.ID, WorkspaceID: "ws-1", Role: models.MessageRoleUser, Content: "<script>alert(1)</script> **중요** `x < y`",
+	})
+
+	doc := renderTranscriptHTML("<보고서>", []exportTranscript{{session: session, messages: messages}}, false, time.Now())
+	assert.True(t, strings.HasPrefix(doc, "<!DOCTYPE html>"))
+	assert.Contains(t, doc, "<title>&lt;보고서&gt;</title>")
+	assert.Contains(t, doc, "<style>")
+	assert.NotContains(t, doc, "<script>")
+	assert.Contains(t, doc, "&lt;script&gt;alert(1)&lt;/script&gt; <strong>중요</strong> <code>x &lt; y</code>")
+	assert.Contains(t, doc, `<div class="msg user">`)
+	assert.Contains(t, doc, `<div class="msg tool_use">`)
+	assert.Contains(t, doc, `<pre><code class="language-go"><span class="tok-kw">func</span> main() {}</code></pre>`)
+	assert.Contains(t, doc, `<pre><code class="language-bash">go test ./...</code></pre>`)
+}
+
+func TestHighlightCode(t *testing.T) {
+	tests := []struct {
+		lang string
+		code string
+		want string
+	}{
+		{"go", `return "a<b" // 끝`, `<span class="tok-kw">return</span> <span class="tok-str">&#34;a&lt;b&#34;</span> <span class="tok-com">// 끝</span>`},
+		{"python", "x = 10 # None", `x = <span class="tok-num">10</span> <span class="tok-com"># None</span>`},
+		{"json", `{"ok": true}`, `{<span class="tok-str">&#34;ok&#34;</span>: <span class="tok-kw">true</span>}`},
+		{"ts", "/* a */ let s = 'x\\'y'", `<span class="tok-com">/* a */</span> <span class="tok-kw">let</span> s = <span class="tok-str">&#39;x\&#39;y&#39;</span>`},
+		{"", "<b>if</b>", "&lt;b&gt;if&lt;/b&gt;"},
+		{"go", `"unterminated`, `<span class="tok-str">&#34;unterminated</span>`},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, highlightCode(tt.lang, tt.code), "%s: %s", tt.lang, tt.code)
+	}
+}
+
+// recordingExportStore 저장한 문서를 기록하는 아티팩트 저장소
+type recordingExportStore struct {
+	documents map[string][]byte
+	types     map[string]string
+}
+
+func (r *recordingExportStore) StoreTranscriptExport(ctx context.Context, userID, exportID, filename, contentType string, data []byte) (*ArtifactInfo, error) {
+	key := "users/" + userID + "/exports/" + exportID + "/" + filename
+	r.documents[key] = data
+	r.types[key] = contentType
+	return &ArtifactInfo{Key: key, Size: int64(len(data)), DownloadURL: "https://example.com/" + key, ExpiresAt: time.Now().Add(time.Hour)}, nil
+}
+
+// fakePDFRenderer 받은 HTML을 기록하고 고정된 PDF를 반환
+type fakePDFRenderer struct {
+	html string
+}
+
+func (f *fakePDFRenderer) RenderPDF(ctx context.Context, document []byte) ([]byte, error) {
+	f.html = string(document)
+	return []byte("%PDF-1.7"), nil
+}
+
+// exportListener 끝난 작업을 채널로 전달
+type exportListener chan *models.TranscriptExport
+
+func (l exportListener) OnTranscriptExportFinished(export *models.TranscriptExport) {
+	l <- export
+}
+
+func waitExport(t *testing.T, finished exportListener) *models.TranscriptExport {
+	select {
+	case export := <-finished:
+		return export
+	case <-time.After(5 * time.Second):
+		t.Fatal("내보내기 작업이 끝나지 않았습니다")
+		return nil
+	}
+}
+
+func TestTranscriptExportService(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	artifacts := &recordingExportStore{documents: map[string][]byte{}, types: map[string]string{}}
+	pdf := &fakePDFRenderer{}
+	service := NewTranscriptExportService(store, artifacts, pdf, 0)
+	finished := make(exportListener, 4)
+	service.AddListener(finished)
+
+	ws, first := createWorkspaceWithSession(t, store, "alice", "api")
+	_, second := createWorkspaceWithSession(t, store, "alice", "web")
+	_, private := createWorkspaceWithSession(t, store, "carol", "secret")
+	for _, session := range []*models.Session{first, second} {
+		for _, message := range exportTestMessages(session.ID, ws.ID) {
+			require.NoError(t, store.Message().Append(ctx, message))
+		}
+	}
+
+	export, err := service.Start(ctx, "alice", false, &models.TranscriptExportRequest{
+		SessionIDs: []string{first.ID, second.ID, first.ID},
+		Format:     models.TranscriptExportMarkdown,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{first.ID, second.ID}, export.SessionIDs)
+	assert.Equal(t, "세션 대화 기록 (2개)", export.Title)
+
+	done := waitExport(t, finished)
+	assert.Equal(t, export.ID, done.ID)
+	require.Equal(t, models.TranscriptExportCompleted, done.Status, done.Error)
+	assert.Equal(t, 14, done.Messages)
+	assert.Equal(t, "users/alice/exports/"+export.ID+"/transcript.md", done.ArtifactKey)
+	assert.Equal(t, "text/markdown; charset=utf-8", artifacts.types[done.ArtifactKey])
+	document := string(artifacts.documents[done.ArtifactKey])
+	assert.Contains(t, document, "## 1. 세션 "+first.ID)
+	assert.Contains(t, document, "## 2. 세션 "+second.ID)
+
+	stored, err := service.Get(ctx, export.ID, "alice", false)
+	require.NoError(t, err)
+	assert.Equal(t, models.TranscriptExportCompleted, stored.Status)
+	_, err = service.Get(ctx, export.ID, "bob", false)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+	_, err = service.Get(ctx, export.ID, "bob", true)
+	assert.NoError(t, err)
+
+	list, err := service.List(ctx, "alice")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	// 읽을 수 없는 세션이 있으면 작업을 시작하지 않음 (admin은 가능)
+	_, err = service.Start(ctx, "alice", false, &models.TranscriptExportRequest{SessionIDs: []string{first.ID, private.ID}, Format: models.TranscriptExportHTML})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+	_, err = service.Start(ctx, "alice", false, &models.TranscriptExportRequest{SessionIDs: []string{"missing"}, Format: models.TranscriptExportHTML})
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+
+	export, err = service.Start(ctx, "admin", true, &models.TranscriptExportRequest{
+		SessionIDs:       []string{private.ID, first.ID},
+		Format:           models.TranscriptExportPDF,
+		Title:            "감사\n보고서",
+		ExcludeToolCalls: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "감사 보고서", export.Title)
+	done = waitExport(t, finished)
+	require.Equal(t, models.TranscriptExportCompleted, done.Status, done.Error)
+	assert.Equal(t, "application/pdf", artifacts.types[done.ArtifactKey])
+	assert.Equal(t, "%PDF-1.7", string(artifacts.documents[done.ArtifactKey]))
+	assert.Contains(t, pdf.html, "<title>감사 보고서</title>")
+	assert.NotContains(t, pdf.html, "도구 호출")
+}
+
+func TestTranscriptExportService_NotConfigured(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	_, session := createWorkspaceWithSession(t, store, "alice", "api")
+
+	// 아티팩트 저장소가 없으면 내보낼 수 없음
+	service := NewTranscriptExportService(store, nil, nil, 0)
+	_, err := service.Start(ctx, "alice", false, &models.TranscriptExportRequest{SessionIDs: []string{session.ID}, Format: models.TranscriptExportMarkdown})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	// PDF 렌더러가 없으면 PDF만 거부
+	service = NewTranscriptExportService(store, &recordingExportStore{documents: map[string][]byte{}, types: map[string]string{}}, nil, 0)
+	finished := make(exportListener, 1)
+	service.AddListener(finished)
+	_, err = service.Start(ctx, "alice", false, &models.TranscriptExportRequest{SessionIDs: []string{session.ID}, Format: models.TranscriptExportPDF})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	_, err = service.Start(ctx, "alice", false, &models.TranscriptExportRequest{SessionIDs: []string{session.ID}, Format: models.TranscriptExportHTML})
+	require.NoError(t, err)
+	done := waitExport(t, finished)
+	assert.Equal(t, models.TranscriptExportCompleted, done.Status)
+	assert.Equal(t, 0, done.Messages)
+}
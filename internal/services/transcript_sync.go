@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// offlineSessionNamespace 오프라인 대화 기록의 세션 ID를 만드는 UUID 네임스페이스
+var offlineSessionNamespace = uuid.MustParse("6f1f3c8e-2d4b-5a7e-9c61-0b8d4e2f7a13")
+
+// TranscriptSyncService 서버 밖(CLI 오프라인 모드)에서 실행한 대화 기록 동기화 서비스
+// 세션과 태스크 ID를 프로젝트와 기록 ID로 정하므로 같은 기록을 여러 번 보내도 한 번만 저장되고,
+// 메시지는 offset 단위로 이어 받을 수 있습니다. 권한 확인은 라우트의 ACL 미들웨어가 담당합니다.
+type TranscriptSyncService struct {
+	storage   storage.Storage
+	messages  *MessageService
+	analytics *AnalyticsService
+
+	// 같은 기록의 요청이 동시에 들어와 메시지가 두 번 저장되지 않게 함
+	mu sync.Mutex
+}
+
+// NewTranscriptSyncService 새 대화 기록 동기화 서비스 생성
+// analytics가 nil이면 이미 집계가 지난 사용량은 대시보드에 반영하지 않습니다.
+func NewTranscriptSyncService(storage storage.Storage, messages *MessageService, analytics *AnalyticsService) *TranscriptSyncService {
+	return &TranscriptSyncService{
+		storage:   storage,
+		messages:  messages,
+		analytics: analytics,
+	}
+}
+
+// OfflineSessionID 프로젝트의 오프라인 대화 기록이 저장되는 세션 ID
+func OfflineSessionID(projectID, transcriptID string) string {
+	return uuid.NewSHA1(offlineSessionNamespace, []byte(projectID+"/"+transcriptID)).String()
+}
+
+// offlineTaskID 오프라인 대화 기록의 실행 결과와 사용량을 기록하는 태스크 ID
+func offlineTaskID(sessionID string) string {
+	return uuid.NewSHA1(uuid.MustParse(sessionID), []byte("task")).String()
+}
+
+// Import 대화 기록의 메시지 일부(또는 전체)를 저장
+// 서버가 받은 메시지 수보다 offset이 크면 빠진 구간이 생기므로 받은 수를 담아 충돌 에러를 반환합니다.
+func (s *TranscriptSyncService) Import(ctx context.Context, projectID string, req *models.TranscriptImportRequest) (*models.TranscriptImportResult, error) {
+	if req.EndedAt.Before(req.StartedAt) {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "종료 시각이 시작 시각보다 앞설 수 없습니다", nil)
+	}
+	project, err := s.storage.Project().GetByID(ctx, projectID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, err := s.session(ctx, project, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, received, err := s.storage.Message().ListBySession(ctx, session.ID, &models.PaginationRequest{Page: 1, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("저장된 메시지 조회 실패: %w", err)
+	}
+	if req.Offset > received {
+		return nil, NewWorkspaceError(ErrCodeVersionConflict, "앞선 메시지를 먼저 보내야 합니다", map[string]interface{}{
+			"received": received,
+		})
+	}
+
+	// 이미 받은 메시지는 건너뜀 (응답을 받지 못해 다시 보낸 요청)
+	for i := received - req.Offset; i < len(req.Messages); i++ {
+		message := req.Messages[i]
+		err := s.messages.Record(ctx, &models.SessionMessage{
+			SessionID:   session.ID,
+			WorkspaceID: project.WorkspaceID,
+			Role:        message.Role,
+			Type:        message.Type,
+			Content:     message.Content,
+			Metadata:    message.Metadata,
+			CreatedAt:   message.CreatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		received++
+	}
+
+	result := &models.TranscriptImportResult{Session: session, Received: received}
+	if req.Complete {
+		task, err := s.recordTask(ctx, session, req)
+		if err != nil {
+			return nil, err
+		}
+		result.TaskID = task.ID
+	}
+	return result, nil
+}
+
+// session 기록의 세션 조회 (처음 받은 기록이면 종료된 세션으로 생성)
+func (s *TranscriptSyncService) session(ctx context.Context, project *models.Project, req *models.TranscriptImportRequest) (*models.Session, error) {
+	sessionID := OfflineSessionID(project.ID, req.TranscriptID)
+	session, err := s.storage.Session().GetByID(ctx, sessionID)
+	if err == nil {
+		return session, nil
+	}
+	if !storage.IsNotFoundError(err) {
+		return nil, fmt.Errorf("세션 조회 실패: %w", err)
+	}
+
+	startedAt, endedAt := req.StartedAt, req.EndedAt
+	metadata := map[string]string{
+		models.SessionMetaSource:       models.SessionSourceOffline,
+		models.SessionMetaTranscriptID: req.TranscriptID,
+	}
+	if req.WorkingDir != "" {
+		metadata[models.SessionMetaWorkingDir] = req.WorkingDir
+	}
+	session = &models.Session{
+		BaseModel:  models.BaseModel{ID: sessionID},
+		ProjectID:  project.ID,
+		Status:     models.SessionEnded,
+		StartedAt:  &startedAt,
+		EndedAt:    &endedAt,
+		LastActive: endedAt,
+		Metadata:   metadata,
+	}
+	if err := s.storage.Session().Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("세션 생성 실패: %w", err)
+	}
+	return session, nil
+}
+
+// offlineResultMessage 사용량 집계가 읽는 Claude CLI 결과 메시지 형식 (claude.ExtractCLIUsage)
+type offlineResultMessage struct {
+	Type         string  `json:"type"`
+	IsError      bool    `json:"is_error"`
+	TotalCostUSD float64 `json:"total_cost_usd"`
+	Usage        struct {
+		InputTokens              int `json:"input_tokens"`
+		OutputTokens             int `json:"output_tokens"`
+		CacheCreationInputTokens int `json:"cache_creation_input_tokens"`
+		CacheReadInputTokens     int `json:"cache_read_input_tokens"`
+	} `json:"usage"`
+}
+
+// recordTask 실행 결과와 사용량을 태스크로 기록 (이미 기록했으면 그대로 반환)
+// 사용량 집계는 태스크 출력의 결과 메시지를 읽으므로 서버에서 실행한 태스크와 같은 형식으로 남깁니다.
+func (s *TranscriptSyncService) recordTask(ctx context.Context, session *models.Session, req *models.TranscriptImportRequest) (*models.Task, error) {
+	taskID := offlineTaskID(session.ID)
+	if task, err := s.storage.Task().GetByID(ctx, taskID); err == nil {
+		return task, nil
+	} else if !storage.IsNotFoundError(err) {
+		return nil, fmt.Errorf("태스크 조회 실패: %w", err)
+	}
+
+	startedAt, endedAt := req.StartedAt, req.EndedAt
+	task := &models.Task{
+		BaseModel:   models.BaseModel{ID: taskID},
+		SessionID:   session.ID,
+		Command:     req.Prompt,
+		Status:      models.TaskCompleted,
+		Error:       req.Error,
+		StartedAt:   &startedAt,
+		CompletedAt: &endedAt,
+		Duration:    endedAt.Sub(startedAt).Milliseconds(),
+	}
+	if task.Command == "" {
+		task.Command = req.TranscriptID
+	}
+	if req.Error != "" {
+		task.Status = models.TaskFailed
+	}
+	if req.Usage != nil {
+		result := offlineResultMessage{Type: "result", IsError: req.Error != "", TotalCostUSD: req.Usage.CostUSD}
+		result.Usage.InputTokens = req.Usage.InputTokens
+		result.Usage.OutputTokens = req.Usage.OutputTokens
+		result.Usage.CacheCreationInputTokens = req.Usage.CacheCreationTokens
+		result.Usage.CacheReadInputTokens = req.Usage.CacheReadTokens
+		output, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		task.Output = string(output)
+		task.ServedModel = req.Usage.Model
+	}
+
+	if err := s.storage.Task().Create(ctx, task); err != nil {
+		return nil, fmt.Errorf("태스크 생성 실패: %w", err)
+	}
+
+	// 집계가 이미 지난 시각에 끝난 실행은 바로 반영 (실패해도 동기화는 성공으로 처리)
+	if s.analytics != nil {
+		if err := s.analytics.ApplyLateTask(ctx, task); err != nil {
+			log.Printf("오프라인 태스크 사용량 반영 실패: 태스크 %s: %v", task.ID, err)
+		}
+	}
+	return task, nil
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestTranscriptSyncService_Import(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	messages := NewMessageService(store)
+	analytics := NewAnalyticsService(store)
+	svc := NewTranscriptSyncService(store, messages, analytics)
+
+	workspace := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp"}
+	require.NoError(t, store.Workspace().Create(ctx, workspace))
+	project := &models.Project{WorkspaceID: workspace.ID, Name: "app", Path: "/tmp/app"}
+	require.NoError(t, store.Project().Create(ctx, project))
+
+	// 동기화 전에 이미 집계가 끝난 시각
+	startedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, store.Analytics().Apply(ctx, &models.AnalyticsRollup{Watermark: startedAt.Add(24 * time.Hour)}))
+
+	base := models.TranscriptImportRequest{
+		TranscriptID: "0192f3a4-5b6c-7d8e-9f01-23456789abcd",
+		Prompt:       "fix the migration",
+		WorkingDir:   "/home/alice/app",
+		StartedAt:    startedAt,
+		EndedAt:      startedAt.Add(time.Minute),
+	}
+	chunk := func(offset int, contents ...string) *models.TranscriptImportRequest {
+		req := base
+		req.Offset = offset
+		for i, content := range contents {
+			req.Messages = append(req.Messages, &models.TranscriptMessage{
+				Role:      models.MessageRoleAssistant,
+				Content:   content,
+				CreatedAt: startedAt.Add(time.Duration(offset+i) * time.Second),
+			})
+		}
+		return &req
+	}
+
+	result, err := svc.Import(ctx, project.ID, chunk(0, "m0", "m1"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Received)
+	assert.Equal(t, OfflineSessionID(project.ID, base.TranscriptID), result.Session.ID)
+	assert.Equal(t, models.SessionEnded, result.Session.Status)
+	assert.Equal(t, models.SessionSourceOffline, result.Session.Metadata[models.SessionMetaSource])
+
+	// 빠진 구간이 있으면 받은 수와 함께 거부
+	_, err = svc.Import(ctx, project.ID, chunk(3, "m3"))
+	var conflict *WorkspaceError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, ErrCodeVersionConflict, conflict.Code)
+	assert.Equal(t, 2, conflict.Details.(map[string]interface{})["received"])
+
+	// 응답을 받지 못해 다시 보낸 구간은 새 메시지만 저장
+	result, err = svc.Import(ctx, project.ID, chunk(1, "m1", "m2"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Received)
+
+	// 마지막 요청에서 사용량을 기록하고, 집계가 지난 날이므로 바로 반영
+	final := chunk(3)
+	final.Complete = true
+	final.Usage = &models.TranscriptUsage{CostUSD: 0.25, InputTokens: 100, OutputTokens: 50, Model: "claude-sonnet-4"}
+	result, err = svc.Import(ctx, project.ID, final)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.TaskID)
+	_, err = svc.Import(ctx, project.ID, final)
+	require.NoError(t, err)
+
+	task, err := store.Task().GetByID(ctx, result.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskCompleted, task.Status)
+	assert.Equal(t, "fix the migration", task.Command)
+	assert.Equal(t, int64(time.Minute/time.Millisecond), task.Duration)
+
+	days, err := store.Analytics().ListDays(ctx, startedAt.Truncate(24*time.Hour), startedAt.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, int64(1), days[0].Tasks, "다시 보내도 한 번만 집계")
+	assert.InDelta(t, 0.25, days[0].CostUSD, 1e-9)
+	assert.Equal(t, int64(150), days[0].Tokens)
+
+	resp, err := messages.ListBySession(ctx, result.Session.ID, "alice", false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Meta.Total)
+
+	// 다른 프로젝트로 보낸 같은 기록은 별도 세션
+	other := &models.Project{WorkspaceID: workspace.ID, Name: "other", Path: "/tmp/other"}
+	require.NoError(t, store.Project().Create(ctx, other))
+	otherResult, err := svc.Import(ctx, other.ID, chunk(0, "m0"))
+	require.NoError(t, err)
+	assert.NotEqual(t, result.Session.ID, otherResult.Session.ID)
+
+	_, err = svc.Import(ctx, "missing", chunk(0, "m0"))
+	assert.Error(t, err)
+	invalid := chunk(0, "m0")
+	invalid.EndedAt = startedAt.Add(-time.Minute)
+	_, err = svc.Import(ctx, project.ID, invalid)
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/claude"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// UserPreferenceService 사용자별 언어 설정
+// 응답 언어는 사용자가 시작한 세션과 태스크의 Claude 시스템 프롬프트에 지시문으로 들어가고,
+// 메시지 언어는 API 에러와 알림 센터 메시지를 번역하는 데 씁니다.
+type UserPreferenceService struct {
+	storage storage.Storage
+}
+
+// 제공자 구현 확인
+var _ claude.ResponseLanguageProvider = (*UserPreferenceService)(nil)
+
+// NewUserPreferenceService 새 사용자 설정 서비스 생성
+func NewUserPreferenceService(storage storage.Storage) *UserPreferenceService {
+	return &UserPreferenceService{storage: storage}
+}
+
+// Get 사용자 설정 조회 (저장한 적이 없으면 빈 설정)
+func (s *UserPreferenceService) Get(ctx context.Context, userID string) (*models.UserPreference, error) {
+	preference, err := s.storage.UserPreference().Get(ctx, userID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return &models.UserPreference{UserID: userID}, nil
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "사용자 설정 조회 실패", err)
+	}
+	return preference, nil
+}
+
+// Update 사용자 설정 변경 (요청에 없는 항목은 유지)
+func (s *UserPreferenceService) Update(ctx context.Context, userID string, req *models.UserPreferenceRequest) (*models.UserPreference, error) {
+	preference, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ResponseLanguage != nil {
+		code := strings.ToLower(strings.TrimSpace(*req.ResponseLanguage))
+		if _, ok := models.ResponseLanguages[code]; code != "" && !ok {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "지원하지 않는 응답 언어입니다", ErrInvalidRequest)
+		}
+		preference.ResponseLanguage = code
+	}
+	if req.Locale != nil {
+		locale := ""
+		if strings.TrimSpace(*req.Locale) != "" {
+			lang, ok := validation.ParseLanguage(*req.Locale)
+			if !ok {
+				return nil, NewWorkspaceError(ErrCodeInvalidRequest, "지원하지 않는 메시지 언어입니다", ErrInvalidRequest)
+			}
+			locale = string(lang)
+		}
+		preference.Locale = locale
+	}
+
+	if err := s.storage.UserPreference().Save(ctx, preference); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "사용자 설정 저장 실패", err)
+	}
+	return preference, nil
+}
+
+// ResponseLanguage 사용자의 Claude 응답 언어 코드 (설정하지 않았거나 조회에 실패하면 빈 문자열)
+func (s *UserPreferenceService) ResponseLanguage(ctx context.Context, userID string) string {
+	if userID == "" {
+		return ""
+	}
+	preference, err := s.storage.UserPreference().Get(ctx, userID)
+	if err != nil {
+		return ""
+	}
+	return preference.ResponseLanguage
+}
+
+// PreferredLanguage 사용자의 메시지 언어 (설정하지 않았거나 조회에 실패하면 빈 문자열)
+func (s *UserPreferenceService) PreferredLanguage(ctx context.Context, userID string) validation.Language {
+	if userID == "" {
+		return ""
+	}
+	preference, err := s.storage.UserPreference().Get(ctx, userID)
+	if err != nil {
+		return ""
+	}
+	lang, _ := validation.ParseLanguage(preference.Locale)
+	return lang
+}
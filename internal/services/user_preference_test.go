@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+	"github.com/aicli/aicli-web/internal/validation"
+)
+
+// preferenceValue 설정 변경 요청 값
+func preferenceValue(value string) *string {
+	return &value
+}
+
+func TestUserPreferenceService_Update(t *testing.T) {
+	ctx := context.Background()
+	service := NewUserPreferenceService(memory.New())
+
+	// 저장한 적이 없으면 빈 설정
+	preference, err := service.Get(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", preference.UserID)
+	assert.Empty(t, preference.ResponseLanguage)
+	assert.Empty(t, service.ResponseLanguage(ctx, "alice"))
+	assert.Empty(t, service.PreferredLanguage(ctx, "alice"))
+
+	preference, err = service.Update(ctx, "alice", &models.UserPreferenceRequest{ResponseLanguage: preferenceValue(" JA "), Locale: preferenceValue("en")})
+	require.NoError(t, err)
+	assert.Equal(t, "ja", preference.ResponseLanguage)
+	assert.Equal(t, "en", preference.Locale)
+	assert.Equal(t, "ja", service.ResponseLanguage(ctx, "alice"))
+	assert.Equal(t, validation.LanguageEnglish, service.PreferredLanguage(ctx, "alice"))
+
+	// 요청에 없는 항목은 유지하고 빈 문자열은 설정 해제
+	preference, err = service.Update(ctx, "alice", &models.UserPreferenceRequest{ResponseLanguage: preferenceValue("")})
+	require.NoError(t, err)
+	assert.Empty(t, preference.ResponseLanguage)
+	assert.Equal(t, "en", preference.Locale)
+
+	_, err = service.Update(ctx, "alice", &models.UserPreferenceRequest{ResponseLanguage: preferenceValue("xx")})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	_, err = service.Update(ctx, "alice", &models.UserPreferenceRequest{Locale: preferenceValue("ja")})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+}
@@ -0,0 +1,521 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// webhookValueMaxBytes 이슈 본문이나 댓글처럼 외부에서 온 긴 변수 값의 최대 크기
+const webhookValueMaxBytes = 4000
+
+// webhookCommandMaxBytes 태스크 명령 최대 크기 (TaskCreateRequest.Command 제한)
+const webhookCommandMaxBytes = 10000
+
+// webhookDeliveryRetention 같은 전송을 다시 받으면 무시하도록 전송 식별자를 기억하는 기간
+const webhookDeliveryRetention = 30 * 24 * time.Hour
+
+// defaultWebhookPrompts 프롬프트를 지정하지 않은 매핑의 트리거별 기본 템플릿
+var defaultWebhookPrompts = map[models.WebhookTrigger]string{
+	models.WebhookTriggerIssueLabeled: "{{repository}} 이슈 #{{number}} \"{{title}}\"를 해결하세요.\n\n{{body}}\n\n이슈: {{url}}",
+	models.WebhookTriggerPRComment:    "{{repository}} PR #{{number}} \"{{title}}\"에 남긴 {{sender}}의 요청을 처리하세요.\n\n{{args}}\n\n댓글: {{url}}",
+	models.WebhookTriggerPush:         "{{repository}}의 {{branch}} 브랜치에 푸시된 변경 사항({{commit}})을 검토하고 문제가 있으면 보고하세요.\n\n{{commits}}",
+}
+
+// WebhookTaskCreator 웹훅 이벤트로 태스크를 생성하는 인터페이스 (*TaskService)
+type WebhookTaskCreator interface {
+	Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error)
+}
+
+// WebhookService GitHub/GitLab 웹훅을 워크스페이스 태스크로 변환
+// 매핑마다 비밀 값을 발급하고, 수신한 이벤트는 서명을 검증한 뒤 리포지토리, 트리거 조건, 발신자를 확인해
+// 매핑 소유자 권한으로 태스크를 시작합니다.
+type WebhookService struct {
+	storage storage.Storage
+	access  *WorkspaceAccessService
+	tasks   WebhookTaskCreator
+}
+
+// NewWebhookService 새 웹훅 서비스 생성
+func NewWebhookService(storage storage.Storage, tasks WebhookTaskCreator) *WebhookService {
+	return &WebhookService{
+		storage: storage,
+		access:  NewWorkspaceAccessService(storage),
+		tasks:   tasks,
+	}
+}
+
+// CreateMapping 웹훅 매핑 생성
+// 매핑한 워크스페이스에 execute 권한이 있어야 하며, 비밀 값은 이 응답에서만 확인할 수 있습니다.
+func (s *WebhookService) CreateMapping(ctx context.Context, userID string, req *models.WebhookMappingCreateRequest) (*models.WebhookMappingSecretResponse, error) {
+	mapping := &models.WebhookMapping{
+		OwnerID:        userID,
+		Name:           strings.TrimSpace(req.Name),
+		Provider:       req.Provider,
+		Repository:     req.Repository,
+		WorkspaceID:    req.WorkspaceID,
+		SessionID:      req.SessionID,
+		Triggers:       req.Triggers,
+		Label:          req.Label,
+		Command:        req.Command,
+		Branches:       req.Branches,
+		AllowedSenders: req.AllowedSenders,
+		Prompt:         req.Prompt,
+		RequireReview:  req.RequireReview,
+		Enabled:        true,
+	}
+	if req.Enabled != nil {
+		mapping.Enabled = *req.Enabled
+	}
+	if err := s.validateMapping(ctx, mapping); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "비밀 값 생성 실패", err)
+	}
+	mapping.Secret = secret
+	if err := s.storage.WebhookMapping().Create(ctx, mapping); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "웹훅 매핑 저장 실패", err)
+	}
+	return webhookSecretResponse(mapping), nil
+}
+
+// GetMapping 웹훅 매핑 조회 (소유자 또는 admin)
+func (s *WebhookService) GetMapping(ctx context.Context, id, userID string, admin bool) (*models.WebhookMapping, error) {
+	mapping, err := s.storage.WebhookMapping().GetByID(ctx, id)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "웹훅 매핑을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "웹훅 매핑 조회 실패", err)
+	}
+	if !admin && mapping.OwnerID != userID {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "웹훅 매핑을 찾을 수 없습니다", storage.ErrNotFound)
+	}
+	return mapping, nil
+}
+
+// ListMappings 사용자의 웹훅 매핑 조회 (이름순)
+func (s *WebhookService) ListMappings(ctx context.Context, userID string) ([]*models.WebhookMapping, error) {
+	mappings, err := s.storage.WebhookMapping().ListByOwner(ctx, userID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "웹훅 매핑 목록 조회 실패", err)
+	}
+	return mappings, nil
+}
+
+// UpdateMapping 웹훅 매핑 수정
+// 워크스페이스나 세션을 바꾸면 매핑 소유자의 권한을 다시 확인합니다.
+func (s *WebhookService) UpdateMapping(ctx context.Context, id, userID string, admin bool, req *models.WebhookMappingUpdateRequest) (*models.WebhookMapping, error) {
+	mapping, err := s.GetMapping(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		mapping.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Repository != nil {
+		mapping.Repository = *req.Repository
+	}
+	if req.WorkspaceID != nil {
+		mapping.WorkspaceID = *req.WorkspaceID
+	}
+	if req.SessionID != nil {
+		mapping.SessionID = *req.SessionID
+	}
+	if req.Triggers != nil {
+		mapping.Triggers = *req.Triggers
+	}
+	if req.Label != nil {
+		mapping.Label = *req.Label
+	}
+	if req.Command != nil {
+		mapping.Command = *req.Command
+	}
+	if req.Branches != nil {
+		mapping.Branches = *req.Branches
+	}
+	if req.AllowedSenders != nil {
+		mapping.AllowedSenders = *req.AllowedSenders
+	}
+	if req.Prompt != nil {
+		mapping.Prompt = *req.Prompt
+	}
+	if req.RequireReview != nil {
+		mapping.RequireReview = *req.RequireReview
+	}
+	if req.Enabled != nil {
+		mapping.Enabled = *req.Enabled
+	}
+	if err := s.validateMapping(ctx, mapping); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.WebhookMapping().Update(ctx, mapping); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "웹훅 매핑 저장 실패", err)
+	}
+	return mapping, nil
+}
+
+// DeleteMapping 웹훅 매핑 삭제
+func (s *WebhookService) DeleteMapping(ctx context.Context, id, userID string, admin bool) error {
+	mapping, err := s.GetMapping(ctx, id, userID, admin)
+	if err != nil {
+		return err
+	}
+	if err := s.storage.WebhookMapping().Delete(ctx, mapping.ID); err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "웹훅 매핑 삭제 실패", err)
+	}
+	return nil
+}
+
+// RotateSecret 비밀 값 재발급 (이전 값으로 서명한 요청은 바로 거부됨)
+func (s *WebhookService) RotateSecret(ctx context.Context, id, userID string, admin bool) (*models.WebhookMappingSecretResponse, error) {
+	mapping, err := s.GetMapping(ctx, id, userID, admin)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "비밀 값 생성 실패", err)
+	}
+	mapping.Secret = secret
+	if err := s.storage.WebhookMapping().Update(ctx, mapping); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "웹훅 매핑 저장 실패", err)
+	}
+	return webhookSecretResponse(mapping), nil
+}
+
+// Handle 수신한 웹훅 처리
+// 매핑이 없거나 제공자가 다르면 NotFound, 서명이 맞지 않으면 Unauthorized를 반환합니다. 서명이 맞으면 태스크를
+// 시작하지 못한 경우에도 결과(ignored/failed)를 반환하고 매핑의 마지막 수신 기록에 남깁니다.
+func (s *WebhookService) Handle(ctx context.Context, provider models.WebhookProvider, id string, delivery *models.WebhookDelivery) (*models.WebhookDeliveryResult, error) {
+	mapping, err := s.storage.WebhookMapping().GetByID(ctx, id)
+	if err != nil || mapping.Provider != provider {
+		if err == nil || storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "웹훅 매핑을 찾을 수 없습니다", storage.ErrNotFound)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "웹훅 매핑 조회 실패", err)
+	}
+	if !verifyWebhookDelivery(mapping, delivery) {
+		return nil, NewWorkspaceError(ErrCodeUnauthorized, "웹훅 서명이 올바르지 않습니다", ErrUnauthorized)
+	}
+
+	result := &models.WebhookDeliveryResult{
+		Event:      delivery.Event,
+		DeliveryID: delivery.DeliveryID,
+		ReceivedAt: time.Now(),
+	}
+	event, reason, err := parseWebhookEvent(provider, delivery)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, err.Error(), ErrInvalidRequest)
+	}
+	if event != nil {
+		result.Trigger = event.trigger
+		reason = matchWebhookEvent(mapping, event)
+	}
+
+	switch {
+	case reason != "":
+		result.Status = models.WebhookDeliveryIgnored
+		result.Reason = reason
+	case !mapping.Enabled:
+		result.Status = models.WebhookDeliveryIgnored
+		result.Reason = "비활성화된 매핑입니다"
+	default:
+		// 제공자 재전송이나 가로챈 요청을 다시 보내도 태스크를 한 번만 시작 (전송 ID는 서명 대상이 아니므로 본문 해시도 기록)
+		fresh, err := s.storage.WebhookMapping().RecordDelivery(ctx, mapping.ID, webhookDeliveryKeys(delivery), time.Now().Add(-webhookDeliveryRetention))
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "웹훅 전송 기록 실패", err)
+		}
+		if !fresh {
+			result.Status = models.WebhookDeliveryIgnored
+			result.Reason = "이미 처리한 전송입니다"
+			break
+		}
+		task, err := s.startTask(ctx, mapping, event, delivery)
+		if err != nil {
+			result.Status = models.WebhookDeliveryFailed
+			result.Reason = err.Error()
+		} else {
+			result.Status = models.WebhookDeliveryTriggered
+			result.TaskID = task.ID
+		}
+	}
+
+	mapping.LastDelivery = result
+	if err := s.storage.WebhookMapping().Update(ctx, mapping); err != nil {
+		log.Printf("웹훅 수신 기록 저장 실패: %s: %v", mapping.ID, err)
+	}
+	return result, nil
+}
+
+// startTask 매핑 소유자 권한으로 이벤트 태스크 시작
+func (s *WebhookService) startTask(ctx context.Context, mapping *models.WebhookMapping, event *webhookEvent, delivery *models.WebhookDelivery) (*models.Task, error) {
+	if _, err := s.access.Authorize(ctx, mapping.WorkspaceID, mapping.OwnerID, models.WorkspacePermissionExecute); err != nil {
+		return nil, fmt.Errorf("매핑 소유자에게 워크스페이스 실행 권한이 없습니다")
+	}
+
+	sessionID := mapping.SessionID
+	if sessionID != "" {
+		if err := checkWorkspaceSession(ctx, s.storage, mapping.WorkspaceID, sessionID); err != nil {
+			return nil, err
+		}
+	} else {
+		session, err := latestActiveSession(ctx, s.storage, mapping.WorkspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("세션 조회 실패: %w", err)
+		}
+		if session == nil {
+			return nil, fmt.Errorf("워크스페이스에 활성 세션이 없습니다")
+		}
+		sessionID = session.ID
+	}
+
+	command, err := RenderPrompt(webhookPrompt(mapping, event.trigger), event.vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tasks.Create(ctx, &models.TaskCreateRequest{
+		SessionID:     sessionID,
+		Command:       truncateOutput(command, webhookCommandMaxBytes),
+		RequireReview: mapping.RequireReview,
+		UserID:        mapping.OwnerID,
+		Metadata: map[string]string{
+			"webhook_mapping_id":  mapping.ID,
+			"webhook_provider":    string(mapping.Provider),
+			"webhook_event":       delivery.Event,
+			"webhook_delivery_id": delivery.DeliveryID,
+			"webhook_url":         event.vars["url"],
+		},
+	})
+}
+
+// validateMapping 매핑 설정 검증과 정규화
+func (s *WebhookService) validateMapping(ctx context.Context, mapping *models.WebhookMapping) error {
+	if mapping.Name == "" {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "매핑 이름이 필요합니다", ErrInvalidRequest)
+	}
+	mapping.Repository = normalizeWebhookRepository(mapping.Repository)
+	if mapping.Repository == "" || !strings.Contains(mapping.Repository, "/") {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "리포지토리는 owner/name 형식이어야 합니다", ErrInvalidRequest)
+	}
+	if _, err := s.access.Authorize(ctx, mapping.WorkspaceID, mapping.OwnerID, models.WorkspacePermissionExecute); err != nil {
+		return err
+	}
+	if mapping.SessionID != "" {
+		if err := checkWorkspaceSession(ctx, s.storage, mapping.WorkspaceID, mapping.SessionID); err != nil {
+			return err
+		}
+	}
+
+	var problems []string
+	if mapping.HasTrigger(models.WebhookTriggerIssueLabeled) && strings.TrimSpace(mapping.Label) == "" {
+		problems = append(problems, "issue_labeled 트리거에는 label이 필요합니다")
+	}
+	if mapping.HasTrigger(models.WebhookTriggerPRComment) {
+		mapping.Command = strings.TrimSpace(mapping.Command)
+		if mapping.Command == "" {
+			mapping.Command = models.DefaultWebhookCommand
+		}
+		if strings.IndexFunc(mapping.Command, unicode.IsSpace) >= 0 {
+			problems = append(problems, "command에는 공백을 넣을 수 없습니다")
+		}
+	}
+	if mapping.HasTrigger(models.WebhookTriggerPush) {
+		if len(mapping.Branches) == 0 {
+			problems = append(problems, "push 트리거에는 branches가 필요합니다")
+		}
+		for _, branch := range mapping.Branches {
+			if _, err := path.Match(branch, ""); err != nil {
+				problems = append(problems, fmt.Sprintf("브랜치 패턴이 올바르지 않습니다: %s", branch))
+			}
+		}
+	}
+	if mapping.Prompt != "" {
+		if err := validatePrompt(webhookPrompt(mapping, "")); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	if len(problems) > 0 {
+		return NewWorkspaceError(ErrCodeInvalidRequest, strings.Join(problems, "; "), ErrInvalidRequest)
+	}
+	return nil
+}
+
+// parseWebhookEvent 제공자별 이벤트 해석
+func parseWebhookEvent(provider models.WebhookProvider, delivery *models.WebhookDelivery) (*webhookEvent, string, error) {
+	if provider == models.WebhookProviderGitLab {
+		return parseGitLabEvent(delivery.Event, delivery.Payload)
+	}
+	return parseGitHubEvent(delivery.Event, delivery.Payload)
+}
+
+// matchWebhookEvent 이벤트가 매핑 조건에 맞는지 확인하고 프롬프트 변수를 채움 (맞지 않으면 무시 사유)
+func matchWebhookEvent(mapping *models.WebhookMapping, event *webhookEvent) string {
+	if !strings.EqualFold(normalizeWebhookRepository(event.repository), mapping.Repository) {
+		return fmt.Sprintf("매핑한 리포지토리가 아닙니다: %s", event.repository)
+	}
+	if !mapping.HasTrigger(event.trigger) {
+		return fmt.Sprintf("사용하지 않는 트리거입니다: %s", event.trigger)
+	}
+	if len(mapping.AllowedSenders) > 0 && !containsFold(mapping.AllowedSenders, event.sender) {
+		return fmt.Sprintf("허용되지 않은 발신자입니다: %s", event.sender)
+	}
+	// 공개 리포지토리에서는 누구나 댓글을 달 수 있으므로 허용 목록이 없으면 멤버와 협업자의 댓글만 처리
+	if event.trigger == models.WebhookTriggerPRComment && len(mapping.AllowedSenders) == 0 && !event.trusted {
+		return fmt.Sprintf("리포지토리 멤버나 협업자가 아닌 발신자입니다 (allowed_senders 필요): %s", event.sender)
+	}
+
+	switch event.trigger {
+	case models.WebhookTriggerIssueLabeled:
+		if !containsFold(event.labels, mapping.Label) {
+			return fmt.Sprintf("매핑한 라벨이 아닙니다: %s", strings.Join(event.labels, ", "))
+		}
+		event.vars["label"] = mapping.Label
+	case models.WebhookTriggerPRComment:
+		args, ok := parseWebhookCommand(event.comment, mapping.Command)
+		if !ok {
+			return "댓글이 명령으로 시작하지 않습니다"
+		}
+		event.vars["comment"] = truncateOutput(event.comment, webhookValueMaxBytes)
+		event.vars["args"] = truncateOutput(args, webhookValueMaxBytes)
+	case models.WebhookTriggerPush:
+		if !matchWebhookBranch(mapping.Branches, event.branch) {
+			return fmt.Sprintf("매핑한 브랜치가 아닙니다: %s", event.branch)
+		}
+	}
+
+	event.vars["event"] = string(event.trigger)
+	event.vars["repository"] = event.repository
+	event.vars["sender"] = event.sender
+	event.vars["body"] = truncateOutput(event.vars["body"], webhookValueMaxBytes)
+	return ""
+}
+
+// parseWebhookCommand 댓글이 명령으로 시작하면 명령 뒤의 내용 반환
+// 명령 바로 뒤에는 공백이나 줄바꿈이 와야 합니다 (/claude는 /claudex와 구분).
+func parseWebhookCommand(comment, command string) (string, bool) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(comment), command)
+	if !ok {
+		return "", false
+	}
+	if rest != "" && !unicode.IsSpace([]rune(rest)[0]) {
+		return "", false
+	}
+	return strings.TrimSpace(rest), true
+}
+
+// matchWebhookBranch 브랜치가 패턴 중 하나와 일치하는지 확인
+func matchWebhookBranch(patterns []string, branch string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, branch); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookPrompt 매핑 템플릿을 웹훅 변수를 선언한 프롬프트로 변환 (템플릿이 없으면 트리거별 기본 템플릿)
+func webhookPrompt(mapping *models.WebhookMapping, trigger models.WebhookTrigger) *models.Prompt {
+	content := mapping.Prompt
+	if content == "" {
+		content = defaultWebhookPrompts[trigger]
+	}
+	variables := make([]models.PromptVariable, 0, len(models.WebhookPromptVariables))
+	for _, name := range models.WebhookPromptVariables {
+		variables = append(variables, models.PromptVariable{Name: name})
+	}
+	return &models.Prompt{Name: mapping.Name, Content: content, Variables: variables}
+}
+
+// verifyWebhookDelivery 서명 검증
+// GitHub는 본문의 HMAC-SHA256(X-Hub-Signature-256), GitLab은 비밀 토큰(X-Gitlab-Token)을 비교합니다.
+func verifyWebhookDelivery(mapping *models.WebhookMapping, delivery *models.WebhookDelivery) bool {
+	if mapping.Secret == "" {
+		return false
+	}
+	if mapping.Provider == models.WebhookProviderGitLab {
+		return subtle.ConstantTimeCompare([]byte(delivery.Token), []byte(mapping.Secret)) == 1
+	}
+
+	signature, ok := strings.CutPrefix(delivery.Signature, "sha256=")
+	if !ok {
+		return false
+	}
+	received, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(mapping.Secret))
+	mac.Write(delivery.Payload)
+	return hmac.Equal(received, mac.Sum(nil))
+}
+
+// webhookDeliveryKeys 중복 전송을 판별할 식별자 (제공자 전송 ID와 본문 해시)
+func webhookDeliveryKeys(delivery *models.WebhookDelivery) []string {
+	digest := sha256.Sum256(delivery.Payload)
+	keys := []string{"sha256:" + hex.EncodeToString(digest[:])}
+	if delivery.DeliveryID != "" {
+		keys = append(keys, "id:"+delivery.DeliveryID)
+	}
+	return keys
+}
+
+// normalizeWebhookRepository 리포지토리 경로 정규화 (URL, 앞뒤 슬래시, .git 접미사 제거)
+func normalizeWebhookRepository(repository string) string {
+	repository = strings.TrimSpace(repository)
+	if i := strings.Index(repository, "://"); i >= 0 {
+		repository = repository[i+3:]
+		if j := strings.Index(repository, "/"); j >= 0 {
+			repository = repository[j+1:]
+		}
+	}
+	repository = strings.Trim(repository, "/")
+	return strings.TrimSuffix(repository, ".git")
+}
+
+// containsFold 대소문자를 무시하고 목록에 값이 있는지 확인
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookSecretResponse 비밀 값과 수신 경로를 포함한 응답
+func webhookSecretResponse(mapping *models.WebhookMapping) *models.WebhookMappingSecretResponse {
+	return &models.WebhookMappingSecretResponse{
+		WebhookMapping: mapping,
+		Secret:         mapping.Secret,
+		URL:            fmt.Sprintf("/api/v1/hooks/%s/%s", mapping.Provider, mapping.ID),
+	}
+}
+
+// newWebhookSecret 추측할 수 없는 비밀 값 생성
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("비밀 값 생성 실패: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
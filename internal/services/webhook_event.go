@@ -0,0 +1,277 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// webhookCommitListLimit 푸시 이벤트 프롬프트에 나열할 최대 커밋 수
+const webhookCommitListLimit = 20
+
+// webhookEvent 제공자 페이로드에서 추출한 이벤트
+type webhookEvent struct {
+	trigger    models.WebhookTrigger
+	repository string
+	sender     string
+	// 발신자가 리포지토리 소유자, 멤버, 협업자인지 (GitHub author_association, GitLab은 알 수 없어 항상 false)
+	trusted bool
+
+	// issue_labeled: 새로 붙은 라벨
+	labels []string
+	// pr_comment: 댓글 본문
+	comment string
+	// push: 브랜치
+	branch string
+
+	// 프롬프트 템플릿 변수 (event, repository, sender는 매칭 후 채움)
+	vars map[string]string
+}
+
+// githubTrustedAssociations 허용 발신자 목록이 없을 때 PR 댓글 명령을 받아들이는 author_association
+var githubTrustedAssociations = map[string]bool{
+	"OWNER":        true,
+	"MEMBER":       true,
+	"COLLABORATOR": true,
+}
+
+// githubUser GitHub 사용자
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+// githubRepository GitHub 리포지토리
+type githubRepository struct {
+	FullName string `json:"full_name"`
+}
+
+// githubIssue GitHub 이슈 (pull_request가 있으면 PR)
+type githubIssue struct {
+	Number      int              `json:"number"`
+	Title       string           `json:"title"`
+	Body        string           `json:"body"`
+	HTMLURL     string           `json:"html_url"`
+	PullRequest *json.RawMessage `json:"pull_request"`
+}
+
+// githubPayload GitHub issues, issue_comment, push 이벤트 페이로드에서 사용하는 필드
+type githubPayload struct {
+	Action string      `json:"action"`
+	Issue  githubIssue `json:"issue"`
+	Label  *struct {
+		Name string `json:"name"`
+	} `json:"label"`
+	Comment *struct {
+		Body              string `json:"body"`
+		HTMLURL           string `json:"html_url"`
+		AuthorAssociation string `json:"author_association"`
+	} `json:"comment"`
+	Ref        string           `json:"ref"`
+	After      string           `json:"after"`
+	Deleted    bool             `json:"deleted"`
+	Commits    []webhookCommit  `json:"commits"`
+	Repository githubRepository `json:"repository"`
+	Sender     githubUser       `json:"sender"`
+}
+
+// webhookCommit 푸시된 커밋 (GitHub와 GitLab 공통 필드)
+type webhookCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+// parseGitHubEvent GitHub 이벤트 해석
+// 태스크를 시작하지 않는 이벤트는 무시 사유와 함께 nil을 반환합니다.
+func parseGitHubEvent(eventType string, payload []byte) (*webhookEvent, string, error) {
+	switch eventType {
+	case "ping":
+		return nil, "ping 이벤트", nil
+	case "issues", "issue_comment", "push":
+	default:
+		return nil, fmt.Sprintf("지원하지 않는 이벤트입니다: %s", eventType), nil
+	}
+
+	var body githubPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, "", fmt.Errorf("페이로드 해석 실패: %w", err)
+	}
+	event := &webhookEvent{
+		repository: body.Repository.FullName,
+		sender:     body.Sender.Login,
+		vars:       map[string]string{},
+	}
+
+	switch eventType {
+	case "issues":
+		if body.Action != "labeled" || body.Label == nil {
+			return nil, fmt.Sprintf("라벨 이벤트가 아닙니다 (action: %s)", body.Action), nil
+		}
+		event.trigger = models.WebhookTriggerIssueLabeled
+		event.labels = []string{body.Label.Name}
+		setIssueVars(event.vars, body.Issue.Number, body.Issue.Title, body.Issue.Body, body.Issue.HTMLURL)
+		event.vars["label"] = body.Label.Name
+
+	case "issue_comment":
+		if body.Action != "created" || body.Comment == nil {
+			return nil, fmt.Sprintf("새 댓글이 아닙니다 (action: %s)", body.Action), nil
+		}
+		if body.Issue.PullRequest == nil {
+			return nil, "PR 댓글이 아닙니다", nil
+		}
+		event.trigger = models.WebhookTriggerPRComment
+		event.comment = body.Comment.Body
+		event.trusted = githubTrustedAssociations[body.Comment.AuthorAssociation]
+		setIssueVars(event.vars, body.Issue.Number, body.Issue.Title, body.Issue.Body, body.Comment.HTMLURL)
+
+	case "push":
+		branch, ok := strings.CutPrefix(body.Ref, "refs/heads/")
+		if !ok {
+			return nil, fmt.Sprintf("브랜치 푸시가 아닙니다 (ref: %s)", body.Ref), nil
+		}
+		if body.Deleted {
+			return nil, "브랜치 삭제 이벤트입니다", nil
+		}
+		event.trigger = models.WebhookTriggerPush
+		event.branch = branch
+		setPushVars(event.vars, branch, body.After, body.Commits)
+	}
+	return event, "", nil
+}
+
+// gitlabLabel GitLab 라벨
+type gitlabLabel struct {
+	Title string `json:"title"`
+}
+
+// gitlabPayload GitLab Issue Hook, Note Hook, Push Hook 페이로드에서 사용하는 필드
+type gitlabPayload struct {
+	User *struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	UserUsername string `json:"user_username"`
+	Project      struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		Description  string `json:"description"`
+		URL          string `json:"url"`
+		Action       string `json:"action"`
+		Note         string `json:"note"`
+		NoteableType string `json:"noteable_type"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Labels *struct {
+			Previous []gitlabLabel `json:"previous"`
+			Current  []gitlabLabel `json:"current"`
+		} `json:"labels"`
+	} `json:"changes"`
+	MergeRequest *struct {
+		IID         int    `json:"iid"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"merge_request"`
+	Ref     string          `json:"ref"`
+	After   string          `json:"after"`
+	Commits []webhookCommit `json:"commits"`
+}
+
+// parseGitLabEvent GitLab 이벤트 해석
+// 태스크를 시작하지 않는 이벤트는 무시 사유와 함께 nil을 반환합니다.
+func parseGitLabEvent(eventType string, payload []byte) (*webhookEvent, string, error) {
+	switch eventType {
+	case "Issue Hook", "Note Hook", "Push Hook":
+	default:
+		return nil, fmt.Sprintf("지원하지 않는 이벤트입니다: %s", eventType), nil
+	}
+
+	var body gitlabPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, "", fmt.Errorf("페이로드 해석 실패: %w", err)
+	}
+	event := &webhookEvent{
+		repository: body.Project.PathWithNamespace,
+		sender:     body.UserUsername,
+		vars:       map[string]string{},
+	}
+	if body.User != nil {
+		event.sender = body.User.Username
+	}
+	attrs := body.ObjectAttributes
+
+	switch eventType {
+	case "Issue Hook":
+		if body.Changes.Labels == nil {
+			return nil, "라벨 변경이 없습니다", nil
+		}
+		previous := make(map[string]bool, len(body.Changes.Labels.Previous))
+		for _, label := range body.Changes.Labels.Previous {
+			previous[label.Title] = true
+		}
+		for _, label := range body.Changes.Labels.Current {
+			if !previous[label.Title] {
+				event.labels = append(event.labels, label.Title)
+			}
+		}
+		if len(event.labels) == 0 {
+			return nil, "새로 붙은 라벨이 없습니다", nil
+		}
+		event.trigger = models.WebhookTriggerIssueLabeled
+		setIssueVars(event.vars, attrs.IID, attrs.Title, attrs.Description, attrs.URL)
+		event.vars["label"] = strings.Join(event.labels, ", ")
+
+	case "Note Hook":
+		if attrs.NoteableType != "MergeRequest" || body.MergeRequest == nil {
+			return nil, "MR 댓글이 아닙니다", nil
+		}
+		event.trigger = models.WebhookTriggerPRComment
+		event.comment = attrs.Note
+		setIssueVars(event.vars, body.MergeRequest.IID, body.MergeRequest.Title, body.MergeRequest.Description, attrs.URL)
+
+	case "Push Hook":
+		branch, ok := strings.CutPrefix(body.Ref, "refs/heads/")
+		if !ok {
+			return nil, fmt.Sprintf("브랜치 푸시가 아닙니다 (ref: %s)", body.Ref), nil
+		}
+		if strings.Trim(body.After, "0") == "" {
+			return nil, "브랜치 삭제 이벤트입니다", nil
+		}
+		event.trigger = models.WebhookTriggerPush
+		event.branch = branch
+		setPushVars(event.vars, branch, body.After, body.Commits)
+	}
+	return event, "", nil
+}
+
+// setIssueVars 이슈/PR 프롬프트 변수 설정
+func setIssueVars(vars map[string]string, number int, title, body, url string) {
+	vars["number"] = strconv.Itoa(number)
+	vars["title"] = title
+	vars["body"] = body
+	vars["url"] = url
+}
+
+// setPushVars 푸시 프롬프트 변수 설정 (커밋 목록은 "- <짧은 해시> <제목>" 형식)
+func setPushVars(vars map[string]string, branch, after string, commits []webhookCommit) {
+	vars["branch"] = branch
+	vars["commit"] = after
+
+	var lines []string
+	for i, commit := range commits {
+		if i == webhookCommitListLimit {
+			lines = append(lines, fmt.Sprintf("- ... 외 %d개", len(commits)-i))
+			break
+		}
+		id := commit.ID
+		if len(id) > 7 {
+			id = id[:7]
+		}
+		subject := strings.TrimSpace(strings.SplitN(commit.Message, "\n", 2)[0])
+		lines = append(lines, fmt.Sprintf("- %s %s", id, subject))
+	}
+	vars["commits"] = strings.Join(lines, "\n")
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// signGitHubPayload GitHub 방식의 X-Hub-Signature-256 값
+func signGitHubPayload(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookFixture(t *testing.T) (*WebhookService, *fakePipelineTasks, *memory.Storage, *models.Workspace) {
+	store := memory.New()
+	tasks := &fakePipelineTasks{}
+	ws, _ := createWorkspaceWithSession(t, store, "alice", "api")
+	return NewWebhookService(store, tasks), tasks, store, ws
+}
+
+func TestWebhookService_CreateMappingValidation(t *testing.T) {
+	service, _, store, ws := newWebhookFixture(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  models.WebhookMappingCreateRequest
+		code string
+	}{
+		{"라벨 없는 issue_labeled", models.WebhookMappingCreateRequest{Triggers: []models.WebhookTrigger{models.WebhookTriggerIssueLabeled}}, ErrCodeInvalidRequest},
+		{"브랜치 없는 push", models.WebhookMappingCreateRequest{Triggers: []models.WebhookTrigger{models.WebhookTriggerPush}}, ErrCodeInvalidRequest},
+		{"공백이 있는 명령", models.WebhookMappingCreateRequest{Triggers: []models.WebhookTrigger{models.WebhookTriggerPRComment}, Command: "/do it"}, ErrCodeInvalidRequest},
+		{"알 수 없는 템플릿 변수", models.WebhookMappingCreateRequest{Triggers: []models.WebhookTrigger{models.WebhookTriggerPRComment}, Prompt: "{{ticket}} 처리"}, ErrCodeInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Name = "api"
+			tt.req.Provider = models.WebhookProviderGitHub
+			tt.req.Repository = "moonklabs/api"
+			tt.req.WorkspaceID = ws.ID
+			_, err := service.CreateMapping(ctx, "alice", &tt.req)
+			assertWorkspaceErrorCode(t, err, tt.code)
+		})
+	}
+
+	// 워크스페이스 실행 권한이 없는 사용자는 매핑을 만들 수 없음
+	require.NoError(t, store.WorkspaceACL().Upsert(ctx, &models.WorkspaceACLEntry{WorkspaceID: ws.ID, PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionRead}))
+	_, err := service.CreateMapping(ctx, "bob", &models.WebhookMappingCreateRequest{
+		Name: "api", Provider: models.WebhookProviderGitHub, Repository: "moonklabs/api", WorkspaceID: ws.ID,
+		Triggers: []models.WebhookTrigger{models.WebhookTriggerPRComment},
+	})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	created, err := service.CreateMapping(ctx, "alice", &models.WebhookMappingCreateRequest{
+		Name: "api", Provider: models.WebhookProviderGitHub, Repository: "https://github.com/moonklabs/api.git", WorkspaceID: ws.ID,
+		Triggers: []models.WebhookTrigger{models.WebhookTriggerPRComment},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "moonklabs/api", created.Repository)
+	assert.Equal(t, models.DefaultWebhookCommand, created.Command)
+	assert.Len(t, created.Secret, 64)
+	assert.Equal(t, "/api/v1/hooks/github/"+created.ID, created.URL)
+}
+
+func TestWebhookService_HandleGitHub(t *testing.T) {
+	service, tasks, _, ws := newWebhookFixture(t)
+	ctx := context.Background()
+
+	mapping, err := service.CreateMapping(ctx, "alice", &models.WebhookMappingCreateRequest{
+		Name:           "api",
+		Provider:       models.WebhookProviderGitHub,
+		Repository:     "moonklabs/api",
+		WorkspaceID:    ws.ID,
+		Triggers:       []models.WebhookTrigger{models.WebhookTriggerIssueLabeled, models.WebhookTriggerPRComment},
+		Label:          "claude",
+		AllowedSenders: []string{"Alice-GH"},
+	})
+	require.NoError(t, err)
+
+	deliveries := 0
+	deliver := func(event, payload, signature string) (*models.WebhookDeliveryResult, error) {
+		deliveries++
+		return service.Handle(ctx, models.WebhookProviderGitHub, mapping.ID, &models.WebhookDelivery{
+			Event: event, DeliveryID: fmt.Sprintf("d-%d", deliveries), Signature: signature, Payload: []byte(payload),
+		})
+	}
+
+	labeled := `{"action":"labeled","label":{"name":"claude"},"issue":{"number":42,"title":"로그인 실패","body":"세션이 만료됩니다","html_url":"https://github.com/moonklabs/api/issues/42"},"repository":{"full_name":"moonklabs/api"},"sender":{"login":"alice-gh"}}`
+
+	// 서명이 없거나 다른 비밀 값으로 서명하면 거부
+	_, err = deliver("issues", labeled, "")
+	assertWorkspaceErrorCode(t, err, ErrCodeUnauthorized)
+	_, err = deliver("issues", labeled, signGitHubPayload("other", labeled))
+	assertWorkspaceErrorCode(t, err, ErrCodeUnauthorized)
+	// 다른 제공자 경로로는 찾을 수 없음
+	_, err = service.Handle(ctx, models.WebhookProviderGitLab, mapping.ID, &models.WebhookDelivery{Token: mapping.Secret})
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+
+	result, err := deliver("issues", labeled, signGitHubPayload(mapping.Secret, labeled))
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryTriggered, result.Status)
+	require.Len(t, tasks.created, 1)
+	assert.Equal(t, "session-api", tasks.created[0].SessionID)
+	assert.Contains(t, tasks.created[0].Command, "moonklabs/api 이슈 #42 \"로그인 실패\"")
+	assert.Contains(t, tasks.created[0].Command, "세션이 만료됩니다")
+
+	tests := []struct {
+		name    string
+		event   string
+		payload string
+		reason  string
+	}{
+		{"다른 라벨", "issues", `{"action":"labeled","label":{"name":"bug"},"repository":{"full_name":"moonklabs/api"},"sender":{"login":"alice-gh"}}`, "매핑한 라벨이 아닙니다: bug"},
+		{"다른 리포지토리", "issues", `{"action":"labeled","label":{"name":"claude"},"repository":{"full_name":"moonklabs/web"},"sender":{"login":"alice-gh"}}`, "매핑한 리포지토리가 아닙니다: moonklabs/web"},
+		{"허용되지 않은 발신자", "issue_comment", `{"action":"created","issue":{"number":7,"pull_request":{}},"comment":{"body":"/claude 테스트 추가"},"repository":{"full_name":"moonklabs/api"},"sender":{"login":"mallory"}}`, "허용되지 않은 발신자입니다: mallory"},
+		{"이슈 댓글", "issue_comment", `{"action":"created","issue":{"number":7},"comment":{"body":"/claude 테스트 추가"},"repository":{"full_name":"moonklabs/api"},"sender":{"login":"alice-gh"}}`, "PR 댓글이 아닙니다"},
+		{"명령과 비슷한 댓글", "issue_comment", `{"action":"created","issue":{"number":7,"pull_request":{}},"comment":{"body":"/claudex 테스트 추가"},"repository":{"full_name":"moonklabs/api"},"sender":{"login":"alice-gh"}}`, "댓글이 명령으로 시작하지 않습니다"},
+		{"사용하지 않는 트리거", "push", `{"ref":"refs/heads/main","after":"abc","repository":{"full_name":"moonklabs/api"},"sender":{"login":"alice-gh"}}`, "사용하지 않는 트리거입니다: push"},
+		{"ping", "ping", `{"zen":"Keep it logically awesome."}`, "ping 이벤트"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := deliver(tt.event, tt.payload, signGitHubPayload(mapping.Secret, tt.payload))
+			require.NoError(t, err)
+			assert.Equal(t, models.WebhookDeliveryIgnored, result.Status)
+			assert.Equal(t, tt.reason, result.Reason)
+		})
+	}
+	require.Len(t, tasks.created, 1)
+
+	comment := `{"action":"created","issue":{"number":7,"title":"결제 리팩터링","pull_request":{}},"comment":{"body":"/claude 실패하는 테스트를 고쳐 주세요\n특히 환불 케이스","html_url":"https://github.com/moonklabs/api/pull/7#issuecomment-1"},"repository":{"full_name":"moonklabs/api"},"sender":{"login":"alice-gh"}}`
+	result, err = deliver("issue_comment", comment, signGitHubPayload(mapping.Secret, comment))
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryTriggered, result.Status)
+	assert.Equal(t, models.WebhookTriggerPRComment, result.Trigger)
+	assert.Contains(t, tasks.last().Command, "실패하는 테스트를 고쳐 주세요\n특히 환불 케이스")
+	assert.NotContains(t, tasks.last().Command, "/claude")
+
+	// 마지막 수신 결과가 매핑에 남음
+	stored, err := service.GetMapping(ctx, mapping.ID, "alice", false)
+	require.NoError(t, err)
+	require.NotNil(t, stored.LastDelivery)
+	assert.Equal(t, tasks.last().ID, stored.LastDelivery.TaskID)
+
+	// 같은 본문을 다른 전송 ID로 다시 보내거나 같은 전송 ID를 재전송해도 태스크를 다시 시작하지 않음
+	created := len(tasks.created)
+	result, err = deliver("issue_comment", comment, signGitHubPayload(mapping.Secret, comment))
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryIgnored, result.Status)
+	assert.Equal(t, "이미 처리한 전송입니다", result.Reason)
+	result, err = service.Handle(ctx, models.WebhookProviderGitHub, mapping.ID, &models.WebhookDelivery{
+		Event: "issues", DeliveryID: "d-1", Signature: signGitHubPayload(mapping.Secret, labeled), Payload: []byte(labeled),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryIgnored, result.Status)
+	assert.Len(t, tasks.created, created)
+}
+
+func TestWebhookService_HandleGitHubCommentWithoutAllowedSenders(t *testing.T) {
+	service, tasks, _, ws := newWebhookFixture(t)
+	ctx := context.Background()
+
+	mapping, err := service.CreateMapping(ctx, "alice", &models.WebhookMappingCreateRequest{
+		Name:        "api",
+		Provider:    models.WebhookProviderGitHub,
+		Repository:  "moonklabs/api",
+		WorkspaceID: ws.ID,
+		Triggers:    []models.WebhookTrigger{models.WebhookTriggerPRComment},
+	})
+	require.NoError(t, err)
+
+	deliver := func(id, association string) *models.WebhookDeliveryResult {
+		payload := fmt.Sprintf(`{"action":"created","issue":{"number":7,"pull_request":{}},"comment":{"body":"/claude 테스트 추가","author_association":%q},"repository":{"full_name":"moonklabs/api"},"sender":{"login":"user-%s"}}`, association, id)
+		result, err := service.Handle(ctx, models.WebhookProviderGitHub, mapping.ID, &models.WebhookDelivery{
+			Event: "issue_comment", DeliveryID: id, Signature: signGitHubPayload(mapping.Secret, payload), Payload: []byte(payload),
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	// 허용 목록이 없으면 외부 기여자의 댓글은 무시
+	result := deliver("d-1", "NONE")
+	assert.Equal(t, models.WebhookDeliveryIgnored, result.Status)
+	assert.Contains(t, result.Reason, "allowed_senders")
+	assert.Equal(t, models.WebhookDeliveryIgnored, deliver("d-2", "CONTRIBUTOR").Status)
+	assert.Empty(t, tasks.created)
+
+	assert.Equal(t, models.WebhookDeliveryTriggered, deliver("d-3", "COLLABORATOR").Status)
+	assert.Len(t, tasks.created, 1)
+}
+
+func TestWebhookService_HandleGitLabPush(t *testing.T) {
+	service, tasks, _, ws := newWebhookFixture(t)
+	ctx := context.Background()
+
+	mapping, err := service.CreateMapping(ctx, "alice", &models.WebhookMappingCreateRequest{
+		Name:        "api",
+		Provider:    models.WebhookProviderGitLab,
+		Repository:  "group/api",
+		WorkspaceID: ws.ID,
+		Triggers:    []models.WebhookTrigger{models.WebhookTriggerPush},
+		Branches:    []string{"main", "release/*"},
+		Prompt:      "{{branch}}에 푸시된 커밋을 검토하세요:\n{{commits}}",
+	})
+	require.NoError(t, err)
+
+	deliver := func(token, payload string) (*models.WebhookDeliveryResult, error) {
+		return service.Handle(ctx, models.WebhookProviderGitLab, mapping.ID, &models.WebhookDelivery{
+			Event: "Push Hook", Token: token, Payload: []byte(payload),
+		})
+	}
+
+	push := `{"ref":"refs/heads/release/1.2","after":"0123456789abcdef","user_username":"alice","project":{"path_with_namespace":"group/api"},"commits":[{"id":"0123456789abcdef","message":"버전 올리기\n\n세부 내용"}]}`
+	_, err = deliver("wrong", push)
+	assertWorkspaceErrorCode(t, err, ErrCodeUnauthorized)
+
+	result, err := deliver(mapping.Secret, push)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryTriggered, result.Status)
+	require.Len(t, tasks.created, 1)
+	assert.Equal(t, "release/1.2에 푸시된 커밋을 검토하세요:\n- 0123456 버전 올리기", tasks.created[0].Command)
+
+	// 매핑하지 않은 브랜치와 브랜치 삭제는 무시
+	result, err = deliver(mapping.Secret, `{"ref":"refs/heads/feature/x","after":"abc","project":{"path_with_namespace":"group/api"}}`)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryIgnored, result.Status)
+	result, err = deliver(mapping.Secret, `{"ref":"refs/heads/main","after":"0000000000000000000000000000000000000000","project":{"path_with_namespace":"group/api"}}`)
+	require.NoError(t, err)
+	assert.Equal(t, "브랜치 삭제 이벤트입니다", result.Reason)
+
+	// 비활성화된 매핑은 태스크를 시작하지 않음
+	disabled := false
+	_, err = service.UpdateMapping(ctx, mapping.ID, "alice", false, &models.WebhookMappingUpdateRequest{Enabled: &disabled})
+	require.NoError(t, err)
+	result, err = deliver(mapping.Secret, push)
+	require.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryIgnored, result.Status)
+	assert.Len(t, tasks.created, 1)
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"time"
 	
 	"github.com/aicli/aicli-web/internal/models"
@@ -17,6 +18,7 @@ type WorkspaceStats = wsinterfaces.WorkspaceStats
 type workspaceService struct {
 	storage   storage.Storage
 	validator *WorkspaceValidator
+	access    *WorkspaceAccessService
 }
 
 // NewWorkspaceService는 새로운 워크스페이스 서비스를 생성합니다
@@ -24,6 +26,7 @@ func NewWorkspaceService(storage storage.Storage) WorkspaceService {
 	return &workspaceService{
 		storage:   storage,
 		validator: NewWorkspaceValidator(),
+		access:    NewWorkspaceAccessService(storage),
 	}
 }
 
@@ -94,27 +97,30 @@ func (s *workspaceService) CreateWorkspace(ctx context.Context, req *models.Crea
 }
 
 // GetWorkspace는 특정 워크스페이스를 조회합니다
+// 소유자 외에 ACL로 read 이상의 권한을 공유받은 사용자도 조회할 수 있습니다.
 func (s *workspaceService) GetWorkspace(ctx context.Context, id string, ownerID string) (*models.Workspace, error) {
+	return s.getAuthorized(ctx, id, ownerID, models.WorkspacePermissionRead)
+}
+
+// AuthorizeWorkspace는 사용자에게 required 이상의 권한이 있을 때 워크스페이스를 반환합니다
+func (s *workspaceService) AuthorizeWorkspace(ctx context.Context, id string, userID string, required models.WorkspacePermission) (*models.Workspace, error) {
+	return s.getAuthorized(ctx, id, userID, required)
+}
+
+// getAuthorized는 워크스페이스를 조회하고 사용자에게 required 권한이 있는지 확인합니다
+func (s *workspaceService) getAuthorized(ctx context.Context, id string, userID string, required models.WorkspacePermission) (*models.Workspace, error) {
 	if id == "" {
 		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 ID가 필요합니다", ErrInvalidRequest)
 	}
 	
-	if ownerID == "" {
+	if userID == "" {
 		return nil, NewWorkspaceError(ErrCodeUnauthorized, "소유자 ID가 필요합니다", ErrUnauthorized)
 	}
 	
-	// 워크스페이스 조회
-	workspace, err := s.storage.Workspace().GetByID(ctx, id)
+	// 워크스페이스 조회 및 권한 확인
+	workspace, err := s.access.Authorize(ctx, id, userID, required)
 	if err != nil {
-		if err == storage.ErrNotFound {
-			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
-		}
-		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 조회 실패", err)
-	}
-	
-	// 권한 확인
-	if workspace.OwnerID != ownerID {
-		return nil, NewWorkspaceError(ErrCodeUnauthorized, "워크스페이스에 접근할 권한이 없습니다", ErrUnauthorized)
+		return nil, err
 	}
 	
 	// API 키 마스킹
@@ -142,15 +148,15 @@ func (s *workspaceService) UpdateWorkspace(ctx context.Context, id string, req *
 		return nil, err
 	}
 	
-	// 기존 워크스페이스 조회 및 권한 확인
-	workspace, err := s.GetWorkspace(ctx, id, ownerID)
+	// 기존 워크스페이스 조회 및 권한 확인 (설정 변경은 admin 권한 필요)
+	workspace, err := s.getAuthorized(ctx, id, ownerID, models.WorkspacePermissionAdmin)
 	if err != nil {
 		return nil, err
 	}
 	
-	// 이름 변경 시 중복 확인
+	// 이름 변경 시 중복 확인 (이름은 소유자 단위로 고유)
 	if req.Name != "" && req.Name != workspace.Name {
-		exists, err := s.storage.Workspace().ExistsByName(ctx, ownerID, req.Name)
+		exists, err := s.storage.Workspace().ExistsByName(ctx, workspace.OwnerID, req.Name)
 		if err != nil {
 			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "이름 중복 확인 실패", err)
 		}
@@ -194,11 +200,19 @@ func (s *workspaceService) UpdateWorkspace(ctx context.Context, id string, req *
 	}
 	updates["updated_at"] = time.Now()
 	
+	// 요청이 기준으로 삼은 버전 이후에 다른 수정이 있었으면 덮어쓰지 않음
+	if req.Version > 0 {
+		updates[storage.VersionKey] = req.Version
+	}
+	
 	// 데이터베이스 업데이트
 	if err := s.storage.Workspace().Update(ctx, id, updates); err != nil {
 		if err == storage.ErrNotFound {
 			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
 		}
+		if storage.IsConflictError(err) {
+			return nil, newVersionConflictError("워크스페이스가 다른 요청으로 먼저 수정되었습니다", err)
+		}
 		if err == storage.ErrAlreadyExists {
 			return nil, NewWorkspaceError(ErrCodeAlreadyExists, "이미 존재하는 워크스페이스 이름입니다", ErrWorkspaceExists)
 		}
@@ -224,11 +238,14 @@ func (s *workspaceService) DeleteWorkspace(ctx context.Context, id string, owner
 		return NewWorkspaceError(ErrCodeUnauthorized, "소유자 ID가 필요합니다", ErrUnauthorized)
 	}
 	
-	// 워크스페이스 존재 및 권한 확인
+	// 워크스페이스 존재 및 권한 확인 (삭제는 소유자만 가능)
 	workspace, err := s.GetWorkspace(ctx, id, ownerID)
 	if err != nil {
 		return err
 	}
+	if err := requireWorkspaceOwner(workspace, ownerID); err != nil {
+		return err
+	}
 	
 	// 삭제 가능 여부 확인
 	if err := s.validator.CanDeleteWorkspace(ctx, workspace); err != nil {
@@ -243,6 +260,16 @@ func (s *workspaceService) DeleteWorkspace(ctx context.Context, id string, owner
 		return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 삭제 실패", err)
 	}
 	
+	// 공유 ACL 정리
+	if err := s.storage.WorkspaceACL().DeleteByWorkspace(ctx, id); err != nil {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 ACL 삭제 실패", err)
+	}
+	
+	// 환경 변수 정리 (비밀 값이 남지 않도록)
+	if err := s.storage.WorkspaceEnv().DeleteByWorkspace(ctx, id); err != nil {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 환경 변수 삭제 실패", err)
+	}
+	
 	return nil
 }
 
@@ -297,6 +324,9 @@ func (s *workspaceService) ListWorkspaces(ctx context.Context, ownerID string, r
 			TotalPages:  (total + req.Limit - 1) / req.Limit,
 		},
 	}
+	if n := len(workspaces); n > 0 {
+		response.Meta.NextCursor = req.NextCursorFor(n, workspaces[n-1].ID, workspaces[n-1].SortValue(req.Sort))
+	}
 	
 	// 포인터를 값으로 변환
 	for i, workspace := range workspaces {
@@ -347,34 +377,60 @@ func (s *workspaceService) UpdateActiveTaskCount(ctx context.Context, id string,
 		return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 ID가 필요합니다", ErrInvalidRequest)
 	}
 	
-	// 현재 워크스페이스 조회
-	workspace, err := s.storage.Workspace().GetByID(ctx, id)
-	if err != nil {
-		if err == storage.ErrNotFound {
-			return NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+	// 동시에 시작/종료된 태스크의 증감이 사라지지 않도록 버전이 바뀌면 다시 읽어 재시도
+	var err error
+	for attempt := 0; attempt < maxVersionRetries; attempt++ {
+		// 현재 워크스페이스 조회
+		workspace, getErr := s.storage.Workspace().GetByID(ctx, id)
+		if getErr != nil {
+			if getErr == storage.ErrNotFound {
+				return NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+			}
+			return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 조회 실패", getErr)
+		}
+		
+		// 새로운 활성 태스크 수 계산
+		newCount := workspace.ActiveTasks + delta
+		if newCount < 0 {
+			newCount = 0
+		}
+		
+		// 업데이트
+		updates := map[string]interface{}{
+			"active_tasks":     newCount,
+			"updated_at":       time.Now(),
+			storage.VersionKey: workspace.Version,
+		}
+		
+		if err = s.storage.Workspace().Update(ctx, id, updates); !storage.IsConflictError(err) {
+			break
 		}
-		return NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 조회 실패", err)
-	}
-	
-	// 새로운 활성 태스크 수 계산
-	newCount := workspace.ActiveTasks + delta
-	if newCount < 0 {
-		newCount = 0
-	}
-	
-	// 업데이트
-	updates := map[string]interface{}{
-		"active_tasks": newCount,
-		"updated_at":   time.Now(),
 	}
 	
-	if err := s.storage.Workspace().Update(ctx, id, updates); err != nil {
+	if err != nil {
+		if storage.IsConflictError(err) {
+			return newVersionConflictError("활성 태스크 수를 갱신하는 동안 워크스페이스가 계속 수정되었습니다", err)
+		}
 		return NewWorkspaceError(ErrCodeInvalidRequest, "활성 태스크 수 업데이트 실패", err)
 	}
 	
 	return nil
 }
 
+// maxVersionRetries 서버 내부의 읽기-수정-쓰기가 버전 충돌로 실패했을 때 다시 시도하는 횟수
+const maxVersionRetries = 3
+
+// newVersionConflictError 버전 충돌을 409 응답용 워크스페이스 에러로 변환 (현재 버전을 함께 전달)
+func newVersionConflictError(message string, err error) *WorkspaceError {
+	details := map[string]interface{}{}
+	var conflict *storage.ConflictError
+	if errors.As(err, &conflict) {
+		details["expected_version"] = conflict.ExpectedVersion
+		details["current_version"] = conflict.CurrentVersion
+	}
+	return NewWorkspaceError(ErrCodeVersionConflict, message, details)
+}
+
 // GetWorkspaceStats는 워크스페이스 통계를 조회합니다
 func (s *workspaceService) GetWorkspaceStats(ctx context.Context, ownerID string) (*WorkspaceStats, error) {
 	if ownerID == "" {
@@ -0,0 +1,406 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// WorkspaceAccessService 워크스페이스 공유 ACL 관리와 접근 권한 판단
+// 소유자는 항상 모든 권한을 가지며, 그 외 사용자는 본인 또는 소속 그룹에 부여된
+// ACL 항목 중 가장 높은 권한을 가집니다. 워크스페이스, 프로젝트, 세션, 태스크
+// 핸들러는 모두 이 서비스로 권한을 확인합니다.
+type WorkspaceAccessService struct {
+	storage   storage.Storage
+	listeners []WorkspaceACLListener
+}
+
+// WorkspaceACLListener 워크스페이스 ACL 항목이 부여, 변경, 삭제되었을 때 알림을 받는 리스너
+// 삭제된 경우 entry에는 대상과 삭제한 사용자(GrantedBy)만 채워집니다.
+type WorkspaceACLListener interface {
+	OnACLChanged(entry *models.WorkspaceACLEntry, revoked bool)
+}
+
+// NewWorkspaceAccessService 새 워크스페이스 접근 권한 서비스 생성
+func NewWorkspaceAccessService(storage storage.Storage) *WorkspaceAccessService {
+	return &WorkspaceAccessService{storage: storage}
+}
+
+// AddListener ACL 변경 리스너 추가
+func (s *WorkspaceAccessService) AddListener(listener WorkspaceACLListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// Permission 사용자가 워크스페이스에 가진 권한 (권한이 없으면 빈 문자열)
+func (s *WorkspaceAccessService) Permission(ctx context.Context, workspace *models.Workspace, userID string) (models.WorkspacePermission, error) {
+	if userID == "" {
+		return "", nil
+	}
+	if workspace.OwnerID == userID {
+		return models.WorkspacePermissionOwner, nil
+	}
+
+	principals, err := s.principals(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	entries, err := s.storage.WorkspaceACL().ListByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return "", err
+	}
+
+	var permission models.WorkspacePermission
+	for _, entry := range entries {
+		if !principals[models.ACLPrincipal{Type: entry.PrincipalType, ID: entry.PrincipalID}] {
+			continue
+		}
+		if permission == "" || !permission.Includes(entry.Permission) {
+			permission = entry.Permission
+		}
+	}
+	return permission, nil
+}
+
+// Authorize 워크스페이스를 조회하고 사용자에게 required 권한이 있는지 확인
+func (s *WorkspaceAccessService) Authorize(ctx context.Context, workspaceID, userID string, required models.WorkspacePermission) (*models.Workspace, error) {
+	workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		}
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 조회 실패", err)
+	}
+	if err := s.check(ctx, workspace, userID, required); err != nil {
+		return nil, err
+	}
+	return workspace, nil
+}
+
+// AuthorizeProject 프로젝트가 속한 워크스페이스에서 required 권한 확인
+func (s *WorkspaceAccessService) AuthorizeProject(ctx context.Context, projectID, userID string, required models.WorkspacePermission) (*models.Project, error) {
+	project, err := s.storage.Project().GetByID(ctx, projectID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "프로젝트를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "프로젝트 조회 실패", err)
+	}
+	if _, err := s.Authorize(ctx, project.WorkspaceID, userID, required); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// AuthorizeSession 세션이 속한 워크스페이스에서 required 권한 확인
+func (s *WorkspaceAccessService) AuthorizeSession(ctx context.Context, sessionID, userID string, required models.WorkspacePermission) (*models.Session, error) {
+	session, err := s.storage.Session().GetByID(ctx, sessionID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "세션을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "세션 조회 실패", err)
+	}
+	if _, err := s.AuthorizeProject(ctx, session.ProjectID, userID, required); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// AuthorizeTask 태스크가 속한 워크스페이스에서 required 권한 확인
+func (s *WorkspaceAccessService) AuthorizeTask(ctx context.Context, taskID, userID string, required models.WorkspacePermission) (*models.Task, error) {
+	task, err := s.storage.Task().GetByID(ctx, taskID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "태스크를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "태스크 조회 실패", err)
+	}
+	if _, err := s.AuthorizeSession(ctx, task.SessionID, userID, required); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// AccessibleWorkspaceIDs 사용자가 소유하거나 ACL로 공유받은 모든 워크스페이스 ID
+func (s *WorkspaceAccessService) AccessibleWorkspaceIDs(ctx context.Context, userID string) ([]string, error) {
+	ids := []string{}
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		workspaces, total, err := s.storage.Workspace().GetByOwnerID(ctx, userID, &models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, err
+		}
+		for _, ws := range workspaces {
+			seen[ws.ID] = true
+			ids = append(ids, ws.ID)
+		}
+		if len(workspaces) == 0 || len(ids) >= total {
+			break
+		}
+	}
+
+	entries, err := s.sharedEntries(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !seen[entry.WorkspaceID] {
+			seen[entry.WorkspaceID] = true
+			ids = append(ids, entry.WorkspaceID)
+		}
+	}
+	return ids, nil
+}
+
+// ListShared 사용자에게 ACL로 공유된 워크스페이스와 권한 조회 (소유한 워크스페이스 제외)
+func (s *WorkspaceAccessService) ListShared(ctx context.Context, userID string) ([]*models.SharedWorkspace, error) {
+	entries, err := s.sharedEntries(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 워크스페이스마다 가장 높은 권한
+	permissions := make(map[string]models.WorkspacePermission)
+	for _, entry := range entries {
+		if current, ok := permissions[entry.WorkspaceID]; !ok || !current.Includes(entry.Permission) {
+			permissions[entry.WorkspaceID] = entry.Permission
+		}
+	}
+
+	shared := []*models.SharedWorkspace{}
+	for workspaceID, permission := range permissions {
+		workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+		if err != nil {
+			if storage.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		if workspace.OwnerID == userID {
+			continue
+		}
+		workspace.MaskClaudeKey()
+		shared = append(shared, &models.SharedWorkspace{Workspace: *workspace, Permission: permission})
+	}
+	sort.Slice(shared, func(i, j int) bool {
+		return shared[i].Name < shared[j].Name
+	})
+	return shared, nil
+}
+
+// ListEntries 워크스페이스의 ACL 항목 조회 (admin 권한 필요)
+func (s *WorkspaceAccessService) ListEntries(ctx context.Context, workspaceID, actorID string) ([]*models.WorkspaceACLEntry, error) {
+	if _, err := s.Authorize(ctx, workspaceID, actorID, models.WorkspacePermissionAdmin); err != nil {
+		return nil, err
+	}
+	return s.storage.WorkspaceACL().ListByWorkspace(ctx, workspaceID)
+}
+
+// Grant 사용자 또는 그룹에 권한 부여 (이미 있으면 권한 변경, admin 권한 필요)
+func (s *WorkspaceAccessService) Grant(ctx context.Context, workspaceID, actorID string, req *models.WorkspaceACLRequest) (*models.WorkspaceACLEntry, error) {
+	workspace, err := s.Authorize(ctx, workspaceID, actorID, models.WorkspacePermissionAdmin)
+	if err != nil {
+		return nil, err
+	}
+	switch req.Permission {
+	case models.WorkspacePermissionRead, models.WorkspacePermissionExecute, models.WorkspacePermissionAdmin:
+	default:
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "권한은 read, execute, admin 중 하나여야 합니다", ErrInvalidRequest)
+	}
+	switch req.PrincipalType {
+	case models.ACLPrincipalUser:
+		if req.PrincipalID == workspace.OwnerID {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 소유자에게는 ACL 항목을 부여할 수 없습니다", ErrInvalidRequest)
+		}
+	case models.ACLPrincipalGroup:
+	default:
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "대상 종류는 user, group 중 하나여야 합니다", ErrInvalidRequest)
+	}
+	if req.PrincipalID == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "대상 ID가 필요합니다", ErrInvalidRequest)
+	}
+
+	entry := &models.WorkspaceACLEntry{
+		WorkspaceID:   workspaceID,
+		PrincipalType: req.PrincipalType,
+		PrincipalID:   req.PrincipalID,
+		Permission:    req.Permission,
+		GrantedBy:     actorID,
+	}
+	if err := s.storage.WorkspaceACL().Upsert(ctx, entry); err != nil {
+		return nil, err
+	}
+	s.notify(entry, false)
+	return entry, nil
+}
+
+// Revoke 사용자 또는 그룹의 ACL 항목 삭제 (admin 권한 필요)
+func (s *WorkspaceAccessService) Revoke(ctx context.Context, workspaceID, actorID string, principalType models.ACLPrincipalType, principalID string) error {
+	if _, err := s.Authorize(ctx, workspaceID, actorID, models.WorkspacePermissionAdmin); err != nil {
+		return err
+	}
+	if err := s.storage.WorkspaceACL().Delete(ctx, workspaceID, principalType, principalID); err != nil {
+		if storage.IsNotFoundError(err) {
+			return NewWorkspaceError(ErrCodeNotFound, "ACL 항목을 찾을 수 없습니다", err)
+		}
+		return err
+	}
+	s.notify(&models.WorkspaceACLEntry{
+		WorkspaceID:   workspaceID,
+		PrincipalType: principalType,
+		PrincipalID:   principalID,
+		GrantedBy:     actorID,
+	}, true)
+	return nil
+}
+
+// notify ACL 변경 리스너 호출
+func (s *WorkspaceAccessService) notify(entry *models.WorkspaceACLEntry, revoked bool) {
+	for _, listener := range s.listeners {
+		listener.OnACLChanged(entry, revoked)
+	}
+}
+
+// WhoHasAccess 워크스페이스에 접근할 수 있는 사용자와 그룹 조회 (read 권한 필요)
+// 소유자가 맨 앞에 오고, 그룹 항목에는 그룹 이름과 구성원을 함께 담습니다.
+func (s *WorkspaceAccessService) WhoHasAccess(ctx context.Context, workspaceID, actorID string) ([]*models.WorkspaceAccessEntry, error) {
+	workspace, err := s.Authorize(ctx, workspaceID, actorID, models.WorkspacePermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.storage.WorkspaceACL().ListByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	access := []*models.WorkspaceAccessEntry{{
+		PrincipalType: models.ACLPrincipalUser,
+		PrincipalID:   workspace.OwnerID,
+		Permission:    models.WorkspacePermissionOwner,
+		Source:        "owner",
+	}}
+	for _, entry := range entries {
+		item := &models.WorkspaceAccessEntry{
+			PrincipalType: entry.PrincipalType,
+			PrincipalID:   entry.PrincipalID,
+			Permission:    entry.Permission,
+			Source:        "acl",
+		}
+		if entry.PrincipalType == models.ACLPrincipalGroup {
+			s.describeGroup(ctx, item)
+		}
+		access = append(access, item)
+	}
+	return access, nil
+}
+
+// UsersWithPermission 워크스페이스에 required 이상의 권한이 있는 사용자 ID (소유자 먼저, 그룹 구성원 포함)
+func (s *WorkspaceAccessService) UsersWithPermission(ctx context.Context, workspace *models.Workspace, required models.WorkspacePermission) ([]string, error) {
+	entries, err := s.storage.WorkspaceACL().ListByWorkspace(ctx, workspace.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []string{workspace.OwnerID}
+	seen := map[string]bool{workspace.OwnerID: true}
+	add := func(userID string) {
+		if !seen[userID] {
+			seen[userID] = true
+			users = append(users, userID)
+		}
+	}
+	for _, entry := range entries {
+		if !entry.Permission.Includes(required) {
+			continue
+		}
+		if entry.PrincipalType == models.ACLPrincipalUser {
+			add(entry.PrincipalID)
+			continue
+		}
+		// 비활성 그룹은 권한 확인(principals)에서도 제외됨
+		if group, err := s.storage.RBAC().GetUserGroupByID(ctx, entry.PrincipalID); err != nil || !group.IsActive {
+			continue
+		}
+		members, err := s.storage.RBAC().GetGroupMembers(ctx, entry.PrincipalID)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.IsActive {
+				add(member.UserID)
+			}
+		}
+	}
+	return users, nil
+}
+
+// check 사용자에게 required 권한이 있는지 확인
+func (s *WorkspaceAccessService) check(ctx context.Context, workspace *models.Workspace, userID string, required models.WorkspacePermission) error {
+	permission, err := s.Permission(ctx, workspace, userID)
+	if err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "워크스페이스 권한 확인 실패", err)
+	}
+	if permission == "" {
+		return NewWorkspaceError(ErrCodeInsufficientPerm, "워크스페이스에 접근할 권한이 없습니다", ErrUnauthorized)
+	}
+	if !permission.Includes(required) {
+		return NewWorkspaceError(ErrCodeInsufficientPerm, "워크스페이스에 대한 "+string(required)+" 권한이 필요합니다", ErrInsufficientPermissions)
+	}
+	return nil
+}
+
+// principals 사용자 본인과 소속 그룹
+func (s *WorkspaceAccessService) principals(ctx context.Context, userID string) (map[models.ACLPrincipal]bool, error) {
+	principals := map[models.ACLPrincipal]bool{
+		{Type: models.ACLPrincipalUser, ID: userID}: true,
+	}
+	groups, err := s.storage.RBAC().GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.IsActive {
+			principals[models.ACLPrincipal{Type: models.ACLPrincipalGroup, ID: group.ID}] = true
+		}
+	}
+	return principals, nil
+}
+
+// sharedEntries 사용자 본인과 소속 그룹에 부여된 모든 ACL 항목
+func (s *WorkspaceAccessService) sharedEntries(ctx context.Context, userID string) ([]*models.WorkspaceACLEntry, error) {
+	principals, err := s.principals(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]models.ACLPrincipal, 0, len(principals))
+	for principal := range principals {
+		list = append(list, principal)
+	}
+	return s.storage.WorkspaceACL().ListByPrincipals(ctx, list)
+}
+
+// describeGroup 그룹 이름과 활성 구성원 채우기 (그룹을 찾지 못해도 항목은 유지)
+func (s *WorkspaceAccessService) describeGroup(ctx context.Context, item *models.WorkspaceAccessEntry) {
+	if group, err := s.storage.RBAC().GetUserGroupByID(ctx, item.PrincipalID); err == nil {
+		item.PrincipalName = group.Name
+	}
+	members, err := s.storage.RBAC().GetGroupMembers(ctx, item.PrincipalID)
+	if err != nil {
+		return
+	}
+	for _, member := range members {
+		if member.IsActive {
+			item.Members = append(item.Members, member.UserID)
+		}
+	}
+}
+
+// requireWorkspaceOwner 소유자만 할 수 있는 작업 (삭제 등) 확인
+func requireWorkspaceOwner(workspace *models.Workspace, userID string) error {
+	if workspace.OwnerID != userID {
+		return NewWorkspaceError(ErrCodeOwnershipRequired, "워크스페이스 소유자만 할 수 있는 작업입니다", ErrOwnershipRequired)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// groupRBACStorage 사용자 그룹 소속만 돌려주는 테스트용 RBAC 스토리지
+type groupRBACStorage struct {
+	*memory.RBACStorage
+	groups map[string][]models.UserGroup
+}
+
+func (r *groupRBACStorage) GetUserGroups(ctx context.Context, userID string) ([]models.UserGroup, error) {
+	return r.groups[userID], nil
+}
+
+// aclTestStorage RBAC만 바꾼 메모리 스토리지
+type aclTestStorage struct {
+	*memory.Storage
+	rbac storage.RBACStorage
+}
+
+func (s *aclTestStorage) RBAC() storage.RBACStorage {
+	return s.rbac
+}
+
+func setupWorkspaceAccessTest(t *testing.T) (*aclTestStorage, *models.Workspace) {
+	store := &aclTestStorage{
+		Storage: memory.New(),
+		rbac: &groupRBACStorage{
+			RBACStorage: memory.NewRBACStorage(),
+			groups: map[string][]models.UserGroup{
+				"carol": {{BaseModel: models.BaseModel{ID: "team-a"}, Name: "team-a", IsActive: true}},
+			},
+		},
+	}
+	ws := &models.Workspace{Name: "alice-ws", OwnerID: "alice", ProjectPath: "/tmp"}
+	require.NoError(t, store.Workspace().Create(context.Background(), ws))
+	return store, ws
+}
+
+func TestWorkspaceAccessService_Permission(t *testing.T) {
+	ctx := context.Background()
+	store, ws := setupWorkspaceAccessTest(t)
+	access := NewWorkspaceAccessService(store)
+
+	permission, err := access.Permission(ctx, ws, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspacePermissionOwner, permission)
+
+	_, err = access.Authorize(ctx, ws.ID, "bob", models.WorkspacePermissionRead)
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	// 사용자 항목과 그룹 항목
+	_, err = access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+	_, err = access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalGroup, PrincipalID: "team-a", Permission: models.WorkspacePermissionExecute})
+	require.NoError(t, err)
+
+	_, err = access.Authorize(ctx, ws.ID, "bob", models.WorkspacePermissionRead)
+	assert.NoError(t, err)
+	_, err = access.Authorize(ctx, ws.ID, "bob", models.WorkspacePermissionExecute)
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	permission, err = access.Permission(ctx, ws, "carol")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspacePermissionExecute, permission)
+
+	// 본인 항목과 그룹 항목 중 높은 권한
+	_, err = access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "carol", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+	permission, err = access.Permission(ctx, ws, "carol")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspacePermissionExecute, permission)
+}
+
+func TestWorkspaceAccessService_SessionAndTask(t *testing.T) {
+	ctx := context.Background()
+	store, ws := setupWorkspaceAccessTest(t)
+	access := NewWorkspaceAccessService(store)
+
+	project := &models.Project{WorkspaceID: ws.ID, Name: "api", Path: "/tmp/api"}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, session))
+	task := &models.Task{SessionID: session.ID, Command: "ls", Status: models.TaskPending}
+	require.NoError(t, store.Task().Create(ctx, task))
+
+	_, err := access.AuthorizeTask(ctx, task.ID, "carol", models.WorkspacePermissionRead)
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	_, err = access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalGroup, PrincipalID: "team-a", Permission: models.WorkspacePermissionExecute})
+	require.NoError(t, err)
+
+	_, err = access.AuthorizeSession(ctx, session.ID, "carol", models.WorkspacePermissionExecute)
+	assert.NoError(t, err)
+	_, err = access.AuthorizeTask(ctx, task.ID, "carol", models.WorkspacePermissionExecute)
+	assert.NoError(t, err)
+	_, err = access.AuthorizeProject(ctx, project.ID, "carol", models.WorkspacePermissionAdmin)
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	_, err = access.AuthorizeTask(ctx, "missing", "carol", models.WorkspacePermissionRead)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
+
+func TestWorkspaceAccessService_ManageEntries(t *testing.T) {
+	ctx := context.Background()
+	store, ws := setupWorkspaceAccessTest(t)
+	access := NewWorkspaceAccessService(store)
+
+	// 소유자에게는 부여할 수 없고, admin 권한 없이는 관리할 수 없음
+	_, err := access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "alice", Permission: models.WorkspacePermissionRead})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+	_, err = access.Grant(ctx, ws.ID, "bob", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionAdmin})
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	// 같은 대상에 다시 부여하면 권한 변경
+	first, err := access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+	second, err := access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionAdmin})
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+
+	// admin 권한을 받은 사용자도 ACL 관리 가능
+	_, err = access.Grant(ctx, ws.ID, "bob", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalGroup, PrincipalID: "team-a", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+
+	entries, err := access.ListEntries(ctx, ws.ID, "alice")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.ACLPrincipalGroup, entries[0].PrincipalType)
+	assert.Equal(t, "bob", entries[0].GrantedBy)
+
+	who, err := access.WhoHasAccess(ctx, ws.ID, "carol")
+	require.NoError(t, err)
+	require.Len(t, who, 3)
+	assert.Equal(t, "alice", who[0].PrincipalID)
+	assert.Equal(t, models.WorkspacePermissionOwner, who[0].Permission)
+	assert.Equal(t, "owner", who[0].Source)
+
+	shared, err := access.ListShared(ctx, "bob")
+	require.NoError(t, err)
+	require.Len(t, shared, 1)
+	assert.Equal(t, ws.ID, shared[0].ID)
+	assert.Equal(t, models.WorkspacePermissionAdmin, shared[0].Permission)
+
+	require.NoError(t, access.Revoke(ctx, ws.ID, "alice", models.ACLPrincipalUser, "bob"))
+	assertWorkspaceErrorCode(t, access.Revoke(ctx, ws.ID, "alice", models.ACLPrincipalUser, "bob"), ErrCodeNotFound)
+	_, err = access.Authorize(ctx, ws.ID, "bob", models.WorkspacePermissionRead)
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+}
+
+func TestWorkspaceService_SharedAccess(t *testing.T) {
+	ctx := context.Background()
+	store, ws := setupWorkspaceAccessTest(t)
+	service := NewWorkspaceService(store)
+	access := NewWorkspaceAccessService(store)
+
+	_, err := access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionRead})
+	require.NoError(t, err)
+
+	// read 권한으로 조회는 가능하지만 수정은 불가
+	got, err := service.GetWorkspace(ctx, ws.ID, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", got.OwnerID)
+	_, err = service.UpdateWorkspace(ctx, ws.ID, &models.UpdateWorkspaceRequest{Name: "renamed"}, "bob")
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+	_, err = service.AuthorizeWorkspace(ctx, ws.ID, "bob", models.WorkspacePermissionAdmin)
+	assertWorkspaceErrorCode(t, err, ErrCodeInsufficientPerm)
+
+	// admin 권한이면 수정 가능, 삭제는 소유자만 가능
+	_, err = access.Grant(ctx, ws.ID, "alice", &models.WorkspaceACLRequest{PrincipalType: models.ACLPrincipalUser, PrincipalID: "bob", Permission: models.WorkspacePermissionAdmin})
+	require.NoError(t, err)
+	_, err = service.AuthorizeWorkspace(ctx, ws.ID, "bob", models.WorkspacePermissionAdmin)
+	require.NoError(t, err)
+	updated, err := service.UpdateWorkspace(ctx, ws.ID, &models.UpdateWorkspaceRequest{Name: "renamed"}, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", updated.Name)
+	assertWorkspaceErrorCode(t, service.DeleteWorkspace(ctx, ws.ID, "bob"), ErrCodeOwnershipRequired)
+
+	// 삭제하면 ACL 항목도 정리
+	require.NoError(t, service.DeleteWorkspace(ctx, ws.ID, "alice"))
+	entries, err := store.WorkspaceACL().ListByWorkspace(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,727 @@
+package services
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// WorkspaceArchiveFormatVersion 아카이브 포맷 버전
+const WorkspaceArchiveFormatVersion = 1
+
+// 아카이브 내부 경로
+const (
+	archiveManifestFile  = "manifest.json"
+	archiveWorkspaceFile = "workspace.json"
+	archiveProjectsFile  = "projects.json"
+	archiveSessionsFile  = "sessions.json"
+	archiveTasksFile     = "tasks.json"
+	archiveFilesDir      = "files/"
+)
+
+// 가져오기 파일 크기 기본 한도 (압축 해제 후 기준)
+const (
+	defaultImportMaxFilesSize int64 = 1 << 30
+	defaultImportMaxFileSize  int64 = 100 << 20
+)
+
+// ConflictStrategy 가져오기 시 이름 충돌 처리 방식
+type ConflictStrategy string
+
+const (
+	// ConflictFail 충돌 시 가져오기 실패
+	ConflictFail ConflictStrategy = "fail"
+	// ConflictRename 충돌 시 이름 뒤에 접미사 추가
+	ConflictRename ConflictStrategy = "rename"
+)
+
+// WorkspaceArchiveManifest 아카이브 매니페스트
+type WorkspaceArchiveManifest struct {
+	FormatVersion int       `json:"format_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+	WorkspaceID   string    `json:"workspace_id"`
+	IncludesFiles bool      `json:"includes_files"`
+	ProjectCount  int       `json:"project_count"`
+	SessionCount  int       `json:"session_count"`
+	TaskCount     int       `json:"task_count"`
+}
+
+// ExportOptions 내보내기 옵션
+type ExportOptions struct {
+	// IncludeFiles 프로젝트 디렉토리 파일 포함 여부
+	IncludeFiles bool
+
+	// MaxFilesSize 포함할 파일의 최대 총 크기 (바이트)
+	MaxFilesSize int64
+}
+
+// ImportOptions 가져오기 옵션
+type ImportOptions struct {
+	// OnConflict 워크스페이스/프로젝트 이름 충돌 처리 방식
+	OnConflict ConflictStrategy
+
+	// TargetPath 파일을 풀 디렉토리 (비어 있으면 원래 경로 유지, 파일은 무시)
+	// 상대 경로는 가져오기 루트 기준이며, 가져오기 루트 밖의 경로는 거부됩니다.
+	TargetPath string
+
+	// MaxFilesSize 복원할 파일의 최대 총 크기 (바이트, 0이면 기본값)
+	MaxFilesSize int64
+
+	// MaxFileSize 복원할 파일 하나의 최대 크기 (바이트, 0이면 기본값)
+	MaxFileSize int64
+}
+
+// ImportResult 가져오기 결과
+type ImportResult struct {
+	Workspace *models.Workspace `json:"workspace"`
+
+	// IDMapping 원본 ID -> 새 ID
+	IDMapping map[string]string `json:"id_mapping"`
+
+	Projects int      `json:"projects"`
+	Sessions int      `json:"sessions"`
+	Tasks    int      `json:"tasks"`
+	Files    int      `json:"files"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// WorkspaceArchiveService 워크스페이스 내보내기/가져오기 서비스
+type WorkspaceArchiveService struct {
+	storage          storage.Storage
+	workspaceService WorkspaceService
+	validator        *WorkspaceValidator
+
+	// importRoot 가져오기 파일을 풀 수 있는 루트 디렉토리 (비어 있으면 파일 복원 불가)
+	importRoot string
+}
+
+// NewWorkspaceArchiveService 새 아카이브 서비스 생성
+func NewWorkspaceArchiveService(storage storage.Storage, workspaceService WorkspaceService) *WorkspaceArchiveService {
+	return &WorkspaceArchiveService{
+		storage:          storage,
+		workspaceService: workspaceService,
+		validator:        NewWorkspaceValidator(),
+	}
+}
+
+// SetImportRoot 가져오기 파일을 풀 수 있는 루트 디렉토리 설정
+func (s *WorkspaceArchiveService) SetImportRoot(root string) {
+	s.importRoot = root
+}
+
+// workspaceBundle 아카이브에 저장되는 데이터 묶음
+type workspaceBundle struct {
+	workspace *models.Workspace
+	projects  []*models.Project
+	sessions  []*models.Session
+	tasks     []*models.Task
+}
+
+// Export 워크스페이스를 tar.gz 아카이브로 내보냅니다
+func (s *WorkspaceArchiveService) Export(ctx context.Context, w io.Writer, workspaceID, ownerID string, opts ExportOptions) error {
+	workspace, err := s.workspaceService.GetWorkspace(ctx, workspaceID, ownerID)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := s.collect(ctx, workspace)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	manifest := WorkspaceArchiveManifest{
+		FormatVersion: WorkspaceArchiveFormatVersion,
+		ExportedAt:    time.Now(),
+		WorkspaceID:   workspace.ID,
+		IncludesFiles: opts.IncludeFiles,
+		ProjectCount:  len(bundle.projects),
+		SessionCount:  len(bundle.sessions),
+		TaskCount:     len(bundle.tasks),
+	}
+
+	// API 키는 내보내지 않음
+	exported := *workspace
+	exported.ClaudeKey = ""
+
+	entries := []struct {
+		name string
+		data interface{}
+	}{
+		{archiveManifestFile, manifest},
+		{archiveWorkspaceFile, exported},
+		{archiveProjectsFile, bundle.projects},
+		{archiveSessionsFile, bundle.sessions},
+		{archiveTasksFile, bundle.tasks},
+	}
+	for _, entry := range entries {
+		if err := writeJSONEntry(tw, entry.name, entry.data); err != nil {
+			return fmt.Errorf("아카이브 작성 실패 (%s): %w", entry.name, err)
+		}
+	}
+
+	if opts.IncludeFiles {
+		var written int64
+		for _, project := range bundle.projects {
+			if err := archiveProjectFiles(tw, project, opts.MaxFilesSize, &written); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// collect 워크스페이스에 속한 프로젝트, 세션, 태스크를 모읍니다
+func (s *WorkspaceArchiveService) collect(ctx context.Context, workspace *models.Workspace) (*workspaceBundle, error) {
+	bundle := &workspaceBundle{workspace: workspace}
+
+	for page := 1; ; page++ {
+		projects, total, err := s.storage.Project().GetByWorkspaceID(ctx, workspace.ID,
+			&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+		if err != nil {
+			return nil, fmt.Errorf("프로젝트 조회 실패: %w", err)
+		}
+		for _, p := range projects {
+			p.Config.ClaudeAPIKey = ""
+			p.Config.EncryptedAPIKey = ""
+			bundle.projects = append(bundle.projects, p)
+		}
+		if len(projects) == 0 || len(bundle.projects) >= total {
+			break
+		}
+	}
+
+	for _, project := range bundle.projects {
+		for page := 1; ; page++ {
+			resp, err := s.storage.Session().List(ctx, &models.SessionFilter{ProjectID: project.ID},
+				&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+			if err != nil {
+				return nil, fmt.Errorf("세션 조회 실패: %w", err)
+			}
+			sessions, _ := resp.Data.([]*models.Session)
+			bundle.sessions = append(bundle.sessions, sessions...)
+			if len(sessions) == 0 || !resp.Meta.HasNext {
+				break
+			}
+		}
+	}
+
+	for _, session := range bundle.sessions {
+		for page := 1; ; page++ {
+			tasks, total, err := s.storage.Task().GetBySessionID(ctx, session.ID,
+				&models.PaginationRequest{Page: page, Limit: 100, Sort: "created_at", Order: "asc"})
+			if err != nil {
+				return nil, fmt.Errorf("태스크 조회 실패: %w", err)
+			}
+			bundle.tasks = append(bundle.tasks, tasks...)
+			if len(tasks) == 0 || page*100 >= total {
+				break
+			}
+		}
+	}
+
+	return bundle, nil
+}
+
+// Import tar.gz 아카이브에서 워크스페이스를 가져옵니다. 모든 ID는 새로 발급됩니다.
+func (s *WorkspaceArchiveService) Import(ctx context.Context, r io.Reader, ownerID string, opts ImportOptions) (*ImportResult, error) {
+	if opts.OnConflict == "" {
+		opts.OnConflict = ConflictFail
+	}
+	if opts.MaxFilesSize <= 0 {
+		opts.MaxFilesSize = defaultImportMaxFilesSize
+	}
+	if opts.MaxFileSize <= 0 {
+		opts.MaxFileSize = defaultImportMaxFileSize
+	}
+	if opts.TargetPath != "" {
+		target, err := s.resolveImportTarget(opts.TargetPath)
+		if err != nil {
+			return nil, err
+		}
+		opts.TargetPath = target
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "아카이브 형식이 올바르지 않습니다", err)
+	}
+	defer gz.Close()
+
+	var (
+		manifest WorkspaceArchiveManifest
+		bundle   = &workspaceBundle{workspace: &models.Workspace{}}
+		staging  *importStaging
+	)
+
+	// 파일은 메모리에 두지 않고 대상 디렉토리 안의 임시 디렉토리에 먼저 풉니다
+	if opts.TargetPath != "" {
+		staging, err = newImportStaging(opts.TargetPath, opts.MaxFilesSize, opts.MaxFileSize)
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInternal, "가져오기 임시 디렉토리 생성 실패", err)
+		}
+		defer staging.cleanup()
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "아카이브를 읽을 수 없습니다", err)
+		}
+
+		var target interface{}
+		switch header.Name {
+		case archiveManifestFile:
+			target = &manifest
+		case archiveWorkspaceFile:
+			target = bundle.workspace
+		case archiveProjectsFile:
+			target = &bundle.projects
+		case archiveSessionsFile:
+			target = &bundle.sessions
+		case archiveTasksFile:
+			target = &bundle.tasks
+		default:
+			if strings.HasPrefix(header.Name, archiveFilesDir) && header.Typeflag == tar.TypeReg && staging != nil {
+				if err := staging.add(header.Name, tr); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+
+		if err := json.NewDecoder(tr).Decode(target); err != nil {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "아카이브 데이터가 손상되었습니다: "+header.Name, err)
+		}
+	}
+
+	if manifest.FormatVersion == 0 || manifest.FormatVersion > WorkspaceArchiveFormatVersion {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest,
+			fmt.Sprintf("지원하지 않는 아카이브 버전입니다: %d", manifest.FormatVersion), ErrInvalidRequest)
+	}
+
+	return s.restore(ctx, bundle, staging, ownerID, opts)
+}
+
+// resolveImportTarget 파일을 풀 대상 경로를 가져오기 루트 안으로 제한하고 워크스페이스 경로 규칙으로 검증합니다
+func (s *WorkspaceArchiveService) resolveImportTarget(target string) (string, error) {
+	if s.importRoot == "" {
+		return "", NewWorkspaceError(ErrCodeInvalidPath, "파일 가져오기가 설정되지 않았습니다 (workspace.import_root)", ErrInvalidProjectPath)
+	}
+	root, err := filepath.Abs(s.importRoot)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "가져오기 루트 경로가 올바르지 않습니다", err)
+	}
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(root, target)
+	}
+	target = filepath.Clean(target)
+	if !isWithinDir(root, target) || target == root {
+		return "", NewWorkspaceError(ErrCodeInvalidPath, "가져오기 경로는 가져오기 루트 아래에 있어야 합니다", ErrInvalidProjectPath)
+	}
+
+	// 워크스페이스 생성과 같은 경로 검증 (디렉토리가 없으면 생성하고 쓰기 권한 확인)
+	if err := s.validator.validateProjectPath(target); err != nil {
+		return "", err
+	}
+
+	// 심볼릭 링크를 따라간 실제 경로도 루트 안에 있어야 함
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "가져오기 루트 경로를 확인할 수 없습니다", err)
+	}
+	realTarget, err := filepath.EvalSymlinks(target)
+	if err != nil || !isWithinDir(realRoot, realTarget) || realTarget == realRoot {
+		return "", NewWorkspaceError(ErrCodeInvalidPath, "가져오기 경로는 가져오기 루트 아래에 있어야 합니다", ErrInvalidProjectPath)
+	}
+	return realTarget, nil
+}
+
+// importProjectPath 아카이브에 기록된 프로젝트 경로를 워크스페이스 경로 아래로 확인
+// 상대 경로는 워크스페이스 경로 기준이며, 벗어나는 경로가 있으면 가져오기를 거부합니다.
+func importProjectPath(workspacePath, path string) (string, error) {
+	if path == "" {
+		return "", NewWorkspaceError(ErrCodeInvalidPath, "프로젝트 경로가 필요합니다", ErrInvalidProjectPath)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workspacePath, path)
+	}
+	path = filepath.Clean(path)
+	if !isWithinDir(filepath.Clean(workspacePath), path) {
+		return "", NewWorkspaceError(ErrCodeInvalidPath, fmt.Sprintf("프로젝트 경로 %s는 워크스페이스 경로 아래에 있어야 합니다", path), ErrInvalidProjectPath)
+	}
+	return path, nil
+}
+
+// isWithinDir path가 root 자신이거나 그 아래에 있는지 확인합니다
+func isWithinDir(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
+
+// restore ID를 재매핑하여 데이터를 저장합니다
+func (s *WorkspaceArchiveService) restore(ctx context.Context, bundle *workspaceBundle, staging *importStaging, ownerID string, opts ImportOptions) (*ImportResult, error) {
+	result := &ImportResult{IDMapping: make(map[string]string)}
+
+	// 워크스페이스 이름 충돌 처리
+	name, err := s.resolveWorkspaceName(ctx, ownerID, bundle.workspace.Name, opts.OnConflict)
+	if err != nil {
+		return nil, err
+	}
+
+	projectPath := bundle.workspace.ProjectPath
+	if opts.TargetPath != "" {
+		projectPath = opts.TargetPath
+	}
+	if err := s.validator.validateProjectPath(projectPath); err != nil {
+		return nil, err
+	}
+
+	// 프로젝트 경로는 아카이브 값을 그대로 쓰지 않고 워크스페이스 경로 아래로 제한
+	projectPaths := make(map[string]string, len(bundle.projects))
+	for _, project := range bundle.projects {
+		path := filepath.Join(projectPath, sanitizeArchiveName(project.ID))
+		if opts.TargetPath == "" {
+			var err error
+			if path, err = importProjectPath(projectPath, project.Path); err != nil {
+				return nil, err
+			}
+		}
+		projectPaths[project.ID] = path
+	}
+
+	// 워크스페이스 생성과 같은 사용자별 워크스페이스 수 제한
+	_, count, err := s.storage.Workspace().GetByOwnerID(ctx, ownerID, &models.PaginationRequest{Page: 1, Limit: 1})
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 수 확인 실패", err)
+	}
+	if err := s.validator.CanCreateWorkspace(ctx, ownerID, count); err != nil {
+		return nil, err
+	}
+
+	oldWorkspaceID := bundle.workspace.ID
+	workspace := &models.Workspace{
+		Name:        name,
+		ProjectPath: projectPath,
+		OwnerID:     ownerID,
+		Status:      models.WorkspaceStatusActive,
+	}
+	if err := s.storage.Workspace().Create(ctx, workspace); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 생성 실패", err)
+	}
+	result.Workspace = workspace
+	result.IDMapping[oldWorkspaceID] = workspace.ID
+
+	for _, project := range bundle.projects {
+		oldID := project.ID
+		project.ID = ""
+		project.WorkspaceID = workspace.ID
+		project.Path = projectPaths[oldID]
+		if err := s.validator.validateProjectPath(project.Path); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("프로젝트 %s(%s) 건너뜀: %v", project.Name, oldID, err))
+			continue
+		}
+		if err := s.storage.Project().Create(ctx, project); err != nil {
+			if storage.IsAlreadyExistsError(err) && opts.OnConflict == ConflictRename {
+				project.Name = fmt.Sprintf("%s (imported %s)", project.Name, workspace.ID[len(workspace.ID)-6:])
+				err = s.storage.Project().Create(ctx, project)
+			}
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("프로젝트 %s(%s) 건너뜀: %v", project.Name, oldID, err))
+				continue
+			}
+		}
+		result.IDMapping[oldID] = project.ID
+		result.Projects++
+
+		// 파일 복원
+		if staging != nil {
+			n, err := staging.restoreProject(oldID, project.Path)
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("프로젝트 %s 파일 복원 실패: %v", project.Name, err))
+			}
+			result.Files += n
+		}
+	}
+
+	for _, session := range bundle.sessions {
+		newProjectID, ok := result.IDMapping[session.ProjectID]
+		if !ok {
+			continue
+		}
+		oldID := session.ID
+		session.ID = uuid.New().String()
+		session.ProjectID = newProjectID
+		session.ProcessID = 0
+		session.Project = nil
+		// 가져온 세션은 실행 중인 프로세스가 없으므로 종료 상태로 저장
+		if session.IsActive() || session.Status == models.SessionPending {
+			session.Status = models.SessionEnded
+		}
+		if err := s.storage.Session().Create(ctx, session); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("세션 %s 건너뜀: %v", oldID, err))
+			continue
+		}
+		result.IDMapping[oldID] = session.ID
+		result.Sessions++
+	}
+
+	for _, task := range bundle.tasks {
+		newSessionID, ok := result.IDMapping[task.SessionID]
+		if !ok {
+			continue
+		}
+		oldID := task.ID
+		task.ID = uuid.New().String()
+		task.SessionID = newSessionID
+		if task.IsActive() {
+			task.Status = models.TaskCancelled
+		}
+		if err := s.storage.Task().Create(ctx, task); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("태스크 %s 건너뜀: %v", oldID, err))
+			continue
+		}
+		result.IDMapping[oldID] = task.ID
+		result.Tasks++
+	}
+
+	return result, nil
+}
+
+// resolveWorkspaceName 이름 충돌 시 전략에 따라 새 이름을 결정합니다
+func (s *WorkspaceArchiveService) resolveWorkspaceName(ctx context.Context, ownerID, name string, strategy ConflictStrategy) (string, error) {
+	exists, err := s.storage.Workspace().ExistsByName(ctx, ownerID, name)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "워크스페이스 이름 확인 실패", err)
+	}
+	if !exists {
+		return name, nil
+	}
+	if strategy != ConflictRename {
+		return "", NewWorkspaceError(ErrCodeAlreadyExists, "같은 이름의 워크스페이스가 이미 존재합니다", ErrWorkspaceExists)
+	}
+
+	for i := 2; i < 100; i++ {
+		candidate := fmt.Sprintf("%s (%d)", name, i)
+		exists, err := s.storage.Workspace().ExistsByName(ctx, ownerID, candidate)
+		if err != nil {
+			return "", NewWorkspaceError(ErrCodeInternal, "워크스페이스 이름 확인 실패", err)
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+	return "", NewWorkspaceError(ErrCodeAlreadyExists, "사용 가능한 워크스페이스 이름을 찾을 수 없습니다", ErrWorkspaceExists)
+}
+
+// writeJSONEntry JSON 데이터를 tar 항목으로 기록합니다
+func writeJSONEntry(tw *tar.Writer, name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+// archiveProjectFiles 프로젝트 디렉토리의 일반 파일을 아카이브에 추가합니다 (.git 제외)
+func archiveProjectFiles(tw *tar.Writer, project *models.Project, maxSize int64, written *int64) error {
+	root := project.Path
+	if root == "" {
+		return nil
+	}
+	if _, err := os.Stat(root); err != nil {
+		return nil
+	}
+
+	prefix := archiveFilesDir + sanitizeArchiveName(project.ID) + "/"
+	return filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if maxSize > 0 && *written+info.Size() > maxSize {
+			return fmt.Errorf("파일 아카이브 크기 제한(%d 바이트)을 초과했습니다", maxSize)
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return nil
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = prefix + filepath.ToSlash(rel)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		n, err := io.Copy(tw, f)
+		*written += n
+		return err
+	})
+}
+
+// importStaging 가져오기 중 아카이브의 프로젝트 파일을 임시로 풀어 두는 디렉토리
+// 압축 해제 후 크기를 파일별, 전체 한도로 제한합니다.
+type importStaging struct {
+	dir          string
+	maxTotalSize int64
+	maxFileSize  int64
+	written      int64
+}
+
+// newImportStaging 대상 디렉토리 안에 임시 디렉토리 생성 (복원 시 같은 파일 시스템 안에서 이동)
+func newImportStaging(targetPath string, maxTotalSize, maxFileSize int64) (*importStaging, error) {
+	dir, err := os.MkdirTemp(targetPath, ".aicli-import-")
+	if err != nil {
+		return nil, err
+	}
+	return &importStaging{dir: dir, maxTotalSize: maxTotalSize, maxFileSize: maxFileSize}, nil
+}
+
+// add 아카이브의 files/ 항목 하나를 한도 안에서 임시 디렉토리에 기록합니다
+// 경로가 안전하지 않은 항목은 건너뛰고, 한도를 넘으면 가져오기를 중단합니다.
+func (st *importStaging) add(name string, r io.Reader) error {
+	rel, ok := archiveEntryPath(strings.TrimPrefix(name, archiveFilesDir))
+	if !ok {
+		return nil
+	}
+
+	target := filepath.Join(st.dir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "가져오기 파일 기록 실패", err)
+	}
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "가져오기 파일 기록 실패", err)
+	}
+	defer f.Close()
+
+	limit := st.maxFileSize
+	if remaining := st.maxTotalSize - st.written; remaining < limit {
+		limit = remaining
+	}
+	n, err := io.Copy(f, io.LimitReader(r, limit+1))
+	st.written += n
+	if err != nil {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "아카이브를 읽을 수 없습니다", err)
+	}
+	if n > limit {
+		return NewWorkspaceError(ErrCodeInvalidRequest,
+			fmt.Sprintf("아카이브 파일 크기 제한(파일당 %d 바이트, 전체 %d 바이트)을 초과했습니다", st.maxFileSize, st.maxTotalSize),
+			ErrInvalidRequest)
+	}
+	return nil
+}
+
+// restoreProject 임시 디렉토리에 풀어 둔 프로젝트 파일을 대상 디렉토리로 옮깁니다
+func (st *importStaging) restoreProject(oldProjectID, dest string) (int, error) {
+	src := filepath.Join(st.dir, sanitizeArchiveName(oldProjectID))
+	if _, err := os.Stat(src); err != nil {
+		return 0, nil
+	}
+
+	count := 0
+	err := filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		if err := os.Rename(p, target); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// cleanup 임시 디렉토리 삭제
+func (st *importStaging) cleanup() {
+	os.RemoveAll(st.dir)
+}
+
+// archiveEntryPath files/ 아래 항목 이름을 안전한 상대 경로로 정리합니다 (탈출 경로는 false)
+func archiveEntryPath(name string) (string, bool) {
+	rel := path.Clean(name)
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, "../") || path.IsAbs(rel) {
+		return "", false
+	}
+	// 첫 요소는 프로젝트 디렉토리, 그 아래에 파일이 있어야 함
+	parts := strings.SplitN(rel, "/", 2)
+	if len(parts) != 2 || parts[0] != sanitizeArchiveName(parts[0]) {
+		return "", false
+	}
+	return rel, true
+}
+
+// sanitizeArchiveName 아카이브 경로에 사용할 수 있도록 이름을 정리합니다
+func sanitizeArchiveName(name string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(name)
+}
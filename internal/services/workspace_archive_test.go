@@ -0,0 +1,242 @@
+package services
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func TestWorkspaceArchiveService_ExportImportRoundTrip(t *testing.T) {
+	storage := memory.New()
+	workspaceService := NewWorkspaceService(storage)
+	archiveService := NewWorkspaceArchiveService(storage, workspaceService)
+
+	ctx := context.Background()
+	ownerID := "user-123"
+	root := t.TempDir()
+	claudeKey := "sk-ant-" + strings.Repeat("x", 50)
+
+	workspace, err := workspaceService.CreateWorkspace(ctx, &models.CreateWorkspaceRequest{
+		Name:        "archive-ws",
+		ProjectPath: root,
+		ClaudeKey:   claudeKey,
+	}, ownerID)
+	require.NoError(t, err)
+
+	projectDir := filepath.Join(root, "proj")
+	require.NoError(t, os.MkdirAll(projectDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main"), 0644))
+
+	project := &models.Project{WorkspaceID: workspace.ID, Name: "proj", Path: projectDir, Status: models.ProjectStatusActive}
+	require.NoError(t, storage.Project().Create(ctx, project))
+
+	session := &models.Session{ProjectID: project.ID, Status: models.SessionActive}
+	session.ID = uuid.New().String()
+	require.NoError(t, storage.Session().Create(ctx, session))
+
+	task := &models.Task{SessionID: session.ID, Command: "echo hi", Output: "hi", Status: models.TaskCompleted}
+	require.NoError(t, storage.Task().Create(ctx, task))
+
+	var buf bytes.Buffer
+	require.NoError(t, archiveService.Export(ctx, &buf, workspace.ID, ownerID, ExportOptions{IncludeFiles: true}))
+	assert.NotContains(t, readArchive(t, buf.Bytes()), claudeKey)
+
+	// 같은 이름이 존재하므로 기본 전략은 실패
+	_, err = archiveService.Import(ctx, bytes.NewReader(buf.Bytes()), ownerID, ImportOptions{})
+	assert.Error(t, err)
+
+	importRoot := t.TempDir()
+	archiveService.SetImportRoot(importRoot)
+	result, err := archiveService.Import(ctx, bytes.NewReader(buf.Bytes()), ownerID, ImportOptions{
+		OnConflict: ConflictRename,
+		TargetPath: "restored",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "archive-ws (2)", result.Workspace.Name)
+	assert.NotEqual(t, workspace.ID, result.IDMapping[workspace.ID])
+	assert.Equal(t, 1, result.Projects)
+	assert.Equal(t, 1, result.Sessions)
+	assert.Equal(t, 1, result.Tasks)
+	assert.Equal(t, 1, result.Files)
+	assert.Equal(t, filepath.Join(importRoot, "restored"), result.Workspace.ProjectPath)
+	restored, err := os.ReadFile(filepath.Join(importRoot, "restored", project.ID, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main", string(restored))
+
+	// 가져온 태스크는 새 세션을 참조해야 함
+	newTaskID := result.IDMapping[task.ID]
+	imported, err := storage.Task().GetByID(ctx, newTaskID)
+	require.NoError(t, err)
+	assert.Equal(t, result.IDMapping[session.ID], imported.SessionID)
+	assert.Equal(t, "hi", imported.Output)
+
+	// 가져온 세션은 종료 상태
+	importedSession, err := storage.Session().GetByID(ctx, result.IDMapping[session.ID])
+	require.NoError(t, err)
+	assert.Equal(t, models.SessionEnded, importedSession.Status)
+}
+
+func TestWorkspaceArchiveService_ImportInvalidArchive(t *testing.T) {
+	storage := memory.New()
+	archiveService := NewWorkspaceArchiveService(storage, NewWorkspaceService(storage))
+
+	_, err := archiveService.Import(context.Background(), bytes.NewReader([]byte("not an archive")), "user-123", ImportOptions{})
+	assert.Error(t, err)
+}
+
+func TestWorkspaceArchiveService_ImportTargetPath(t *testing.T) {
+	storage := memory.New()
+	archiveService := NewWorkspaceArchiveService(storage, NewWorkspaceService(storage))
+	archive := buildArchive(t, map[string]string{"files/p1/a.txt": "a"})
+
+	// 가져오기 루트가 없으면 파일 복원 불가
+	_, err := archiveService.Import(context.Background(), bytes.NewReader(archive), "user-123", ImportOptions{TargetPath: t.TempDir()})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidPath)
+
+	root := t.TempDir()
+	archiveService.SetImportRoot(root)
+	for _, target := range []string{"..", "../escape", "/etc", filepath.Dir(root), root} {
+		_, err := archiveService.Import(context.Background(), bytes.NewReader(archive), "user-123", ImportOptions{TargetPath: target})
+		assertWorkspaceErrorCode(t, err, ErrCodeInvalidPath)
+	}
+	_, err = os.Stat(filepath.Join(filepath.Dir(root), "escape"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWorkspaceArchiveService_ImportFileLimits(t *testing.T) {
+	storage := memory.New()
+	archiveService := NewWorkspaceArchiveService(storage, NewWorkspaceService(storage))
+	root := t.TempDir()
+	archiveService.SetImportRoot(root)
+
+	archive := buildArchive(t, map[string]string{
+		"files/p1/big.txt": strings.Repeat("x", 64),
+	})
+	_, err := archiveService.Import(context.Background(), bytes.NewReader(archive), "user-123", ImportOptions{
+		TargetPath:  "limited",
+		MaxFileSize: 16,
+	})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	archive = buildArchive(t, map[string]string{
+		"files/p1/a.txt": strings.Repeat("x", 10),
+		"files/p1/b.txt": strings.Repeat("x", 10),
+	})
+	_, err = archiveService.Import(context.Background(), bytes.NewReader(archive), "user-123", ImportOptions{
+		TargetPath:   "limited",
+		MaxFilesSize: 15,
+	})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	// 실패한 가져오기의 임시 파일은 남지 않아야 함
+	entries, err := os.ReadDir(filepath.Join(root, "limited"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestWorkspaceArchiveService_ImportProjectPaths(t *testing.T) {
+	ctx := context.Background()
+	storage := memory.New()
+	archiveService := NewWorkspaceArchiveService(storage, NewWorkspaceService(storage))
+	workspacePath := t.TempDir()
+	workspace := &models.Workspace{ID: "old-ws", Name: "imported", ProjectPath: workspacePath}
+
+	// 워크스페이스 경로를 벗어난 프로젝트가 있으면 아무것도 만들지 않고 거부
+	for _, path := range []string{"/etc", "../other-user", filepath.Join(workspacePath, "..", "x")} {
+		archive := buildWorkspaceArchive(t, workspace, []*models.Project{{ID: "p1", Name: "p1", Path: path}}, nil)
+		_, err := archiveService.Import(ctx, bytes.NewReader(archive), "user-123", ImportOptions{})
+		assertWorkspaceErrorCode(t, err, ErrCodeInvalidPath)
+	}
+	_, total, err := storage.Workspace().GetByOwnerID(ctx, "user-123", &models.PaginationRequest{Page: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+
+	archive := buildWorkspaceArchive(t, workspace, []*models.Project{{ID: "p1", Name: "p1", Path: "app"}}, nil)
+	result, err := archiveService.Import(ctx, bytes.NewReader(archive), "user-123", ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Projects)
+	project, err := storage.Project().GetByID(ctx, result.IDMapping["p1"])
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(workspacePath, "app"), project.Path)
+}
+
+func TestWorkspaceArchiveService_ImportWorkspaceLimit(t *testing.T) {
+	ctx := context.Background()
+	storage := memory.New()
+	archiveService := NewWorkspaceArchiveService(storage, NewWorkspaceService(storage))
+	archiveService.validator.maxWorkspaceCount = 1
+	require.NoError(t, storage.Workspace().Create(ctx, &models.Workspace{Name: "existing", OwnerID: "user-123", ProjectPath: t.TempDir()}))
+
+	archive := buildWorkspaceArchive(t, &models.Workspace{ID: "old-ws", Name: "imported", ProjectPath: t.TempDir()}, nil, nil)
+	_, err := archiveService.Import(ctx, bytes.NewReader(archive), "user-123", ImportOptions{})
+	assertWorkspaceErrorCode(t, err, ErrCodeMaxWorkspaces)
+}
+
+func TestArchiveEntryPath(t *testing.T) {
+	for _, name := range []string{"..", "../x", "p1/../../x", "/etc/passwd", ".", "p1"} {
+		_, ok := archiveEntryPath(name)
+		assert.False(t, ok, name)
+	}
+	rel, ok := archiveEntryPath("p1/src/./main.go")
+	assert.True(t, ok)
+	assert.Equal(t, "p1/src/main.go", rel)
+}
+
+// buildArchive 매니페스트와 주어진 항목으로 가져오기용 아카이브 생성
+func buildArchive(t *testing.T, entries map[string]string) []byte {
+	return buildWorkspaceArchive(t, &models.Workspace{ID: "old-ws", Name: "imported"}, nil, entries)
+}
+
+// buildWorkspaceArchive 워크스페이스와 프로젝트 메타데이터를 지정해 가져오기용 아카이브 생성
+func buildWorkspaceArchive(t *testing.T, workspace *models.Workspace, projects []*models.Project, entries map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, writeJSONEntry(tw, archiveManifestFile, WorkspaceArchiveManifest{FormatVersion: WorkspaceArchiveFormatVersion}))
+	require.NoError(t, writeJSONEntry(tw, archiveWorkspaceFile, workspace))
+	if projects != nil {
+		require.NoError(t, writeJSONEntry(tw, archiveProjectsFile, projects))
+	}
+	for name, content := range entries {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// readArchive 아카이브의 모든 항목 내용을 이어 붙여 반환합니다
+func readArchive(t *testing.T, data []byte) string {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var sb strings.Builder
+	for {
+		_, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		sb.Write(content)
+	}
+	return sb.String()
+}
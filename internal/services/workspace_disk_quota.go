@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// defaultDiskQuotaWarnRatio 경고를 보낼 기본 사용률
+	defaultDiskQuotaWarnRatio = 0.8
+	// diskQuotaScanPage 주기적 측정에서 워크스페이스를 읽는 페이지 크기
+	diskQuotaScanPage = 100
+
+	// DiskQuotaErrorCode 디스크 한도 초과 응답의 에러 코드
+	DiskQuotaErrorCode = "DISK_QUOTA_EXCEEDED"
+)
+
+// ErrDiskQuotaExceeded 워크스페이스 디스크 한도에 도달해 새 태스크를 받을 수 없음
+var ErrDiskQuotaExceeded = errors.New("disk quota exceeded")
+
+// DiskQuotaError 디스크 한도 초과로 거부된 태스크 요청의 사용량 정보
+type DiskQuotaError struct {
+	Usage *models.WorkspaceDiskUsage
+}
+
+func (e *DiskQuotaError) Error() string {
+	return fmt.Sprintf("워크스페이스 디스크 한도를 초과해 새 태스크를 받을 수 없습니다: %d/%d 바이트", e.Usage.UsedBytes, e.Usage.LimitBytes)
+}
+
+// Is errors.Is(err, ErrDiskQuotaExceeded)로 확인할 수 있도록 합니다
+func (e *DiskQuotaError) Is(target error) bool {
+	return target == ErrDiskQuotaExceeded
+}
+
+// DiskQuotaListener 워크스페이스 디스크 사용량 단계가 올라갔을 때 알림을 받는 리스너
+type DiskQuotaListener interface {
+	OnDiskQuotaLevelChanged(workspace *models.Workspace, usage *models.WorkspaceDiskUsage)
+}
+
+// WorkspaceDiskQuotaConfig 워크스페이스 디스크 한도 서비스 설정
+type WorkspaceDiskQuotaConfig struct {
+	// DefaultLimit 워크스페이스별 한도가 없을 때 적용할 한도 (바이트, 0이면 무제한)
+	DefaultLimit int64
+	// Limits 워크스페이스 ID별 한도 (바이트, 0이면 무제한)
+	Limits map[string]int64
+	// WarnRatio 경고를 보낼 사용률 (0이면 0.8)
+	WarnRatio float64
+	// CacheTTL 측정한 사용량을 다시 쓰는 시간 (0이면 매번 측정)
+	CacheTTL time.Duration
+	// ScanInterval 모든 워크스페이스 사용량을 다시 측정하는 주기 (0이면 주기적으로 측정하지 않음)
+	ScanInterval time.Duration
+}
+
+// WorkspaceDiskQuotaService 워크스페이스 디렉토리 디스크 사용량을 측정하고 한도를 적용하는 서비스
+// 사용량은 du처럼 디렉토리를 훑어 실제 블록 사용량을 합산하며, 측정 결과는 CacheTTL 동안 재사용합니다.
+// 사용률이 경고 비율을 넘거나 한도에 도달하면 리스너에 알리고, 한도에 도달한 워크스페이스의 새 태스크는 거부합니다.
+type WorkspaceDiskQuotaService struct {
+	storage   storage.Storage
+	config    WorkspaceDiskQuotaConfig
+	listeners []DiskQuotaListener
+
+	mu     sync.Mutex
+	usages map[string]*models.WorkspaceDiskUsage
+	levels map[string]models.WorkspaceDiskQuotaLevel
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewWorkspaceDiskQuotaService 새 워크스페이스 디스크 한도 서비스 생성
+func NewWorkspaceDiskQuotaService(storage storage.Storage, config WorkspaceDiskQuotaConfig) *WorkspaceDiskQuotaService {
+	if config.WarnRatio <= 0 || config.WarnRatio >= 1 {
+		config.WarnRatio = defaultDiskQuotaWarnRatio
+	}
+	return &WorkspaceDiskQuotaService{
+		storage: storage,
+		config:  config,
+		usages:  make(map[string]*models.WorkspaceDiskUsage),
+		levels:  make(map[string]models.WorkspaceDiskQuotaLevel),
+	}
+}
+
+// AddListener 사용량 단계 알림을 받을 리스너 추가 (서비스 시작 전에 등록)
+func (s *WorkspaceDiskQuotaService) AddListener(listener DiskQuotaListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// Limit 워크스페이스에 적용되는 한도 (바이트, 0이면 무제한)
+func (s *WorkspaceDiskQuotaService) Limit(workspaceID string) int64 {
+	if limit, ok := s.config.Limits[workspaceID]; ok {
+		return limit
+	}
+	return s.config.DefaultLimit
+}
+
+// Usage 워크스페이스 디스크 사용량 (캐시가 오래되었거나 refresh면 다시 측정)
+func (s *WorkspaceDiskQuotaService) Usage(ctx context.Context, workspaceID string, refresh bool) (*models.WorkspaceDiskUsage, error) {
+	if !refresh {
+		s.mu.Lock()
+		cached, ok := s.usages[workspaceID]
+		s.mu.Unlock()
+		if ok && s.config.CacheTTL > 0 && time.Since(cached.MeasuredAt) < s.config.CacheTTL {
+			usage := *cached
+			return &usage, nil
+		}
+	}
+
+	workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+	}
+	return s.measure(ctx, workspace)
+}
+
+// CheckTask 워크스페이스가 디스크 한도에 도달했으면 *DiskQuotaError 반환 (TaskDiskQuota)
+func (s *WorkspaceDiskQuotaService) CheckTask(ctx context.Context, workspaceID string) error {
+	if s.Limit(workspaceID) <= 0 {
+		return nil
+	}
+	usage, err := s.Usage(ctx, workspaceID, false)
+	if err != nil {
+		// 사용량을 측정하지 못해도 태스크는 막지 않음
+		log.Printf("워크스페이스 디스크 사용량 측정 실패: %s: %v", workspaceID, err)
+		return nil
+	}
+	if usage.Level == models.WorkspaceDiskQuotaExceeded {
+		return &DiskQuotaError{Usage: usage}
+	}
+	return nil
+}
+
+// Start 모든 워크스페이스의 주기적인 사용량 측정 시작
+func (s *WorkspaceDiskQuotaService) Start(ctx context.Context) {
+	if s.config.ScanInterval <= 0 || s.stopCh != nil {
+		return
+	}
+	s.stopCh = make(chan struct{})
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.config.ScanInterval)
+		defer ticker.Stop()
+
+		s.scanAll(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+				s.scanAll(ctx)
+			}
+		}
+	}()
+}
+
+// Stop 주기적인 사용량 측정 중지
+func (s *WorkspaceDiskQuotaService) Stop() {
+	if s.stopCh == nil {
+		return
+	}
+	close(s.stopCh)
+	s.wg.Wait()
+	s.stopCh = nil
+}
+
+// scanAll 모든 워크스페이스 사용량 측정
+func (s *WorkspaceDiskQuotaService) scanAll(ctx context.Context) {
+	for page := 1; ; page++ {
+		workspaces, total, err := s.storage.Workspace().List(ctx, &models.PaginationRequest{Page: page, Limit: diskQuotaScanPage})
+		if err != nil {
+			log.Printf("워크스페이스 목록 조회 실패: %v", err)
+			return
+		}
+		for _, workspace := range workspaces {
+			if ctx.Err() != nil {
+				return
+			}
+			if _, err := s.measure(ctx, workspace); err != nil {
+				log.Printf("워크스페이스 디스크 사용량 측정 실패: %s: %v", workspace.ID, err)
+			}
+		}
+		if len(workspaces) == 0 || page*diskQuotaScanPage >= total {
+			return
+		}
+	}
+}
+
+// measure 워크스페이스 디렉토리를 훑어 사용량을 측정하고 단계가 올라갔으면 리스너에 알림
+func (s *WorkspaceDiskQuotaService) measure(ctx context.Context, workspace *models.Workspace) (*models.WorkspaceDiskUsage, error) {
+	used, err := directoryDiskUsage(ctx, workspace.ProjectPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, NewWorkspaceError(ErrCodeInvalidPath, "워크스페이스 디렉토리를 찾을 수 없습니다", ErrInvalidProjectPath)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "디스크 사용량 측정 실패", err)
+	}
+
+	usage := &models.WorkspaceDiskUsage{
+		WorkspaceID: workspace.ID,
+		UsedBytes:   used,
+		LimitBytes:  s.Limit(workspace.ID),
+		Level:       models.WorkspaceDiskQuotaOK,
+		MeasuredAt:  time.Now().UTC(),
+	}
+	if usage.LimitBytes > 0 {
+		usage.Percent = float64(used) * 100 / float64(usage.LimitBytes)
+		switch {
+		case used >= usage.LimitBytes:
+			usage.Level = models.WorkspaceDiskQuotaExceeded
+		case float64(used) >= float64(usage.LimitBytes)*s.config.WarnRatio:
+			usage.Level = models.WorkspaceDiskQuotaWarning
+		}
+	}
+
+	s.mu.Lock()
+	previous := s.levels[workspace.ID]
+	s.levels[workspace.ID] = usage.Level
+	cached := *usage
+	s.usages[workspace.ID] = &cached
+	s.mu.Unlock()
+
+	// 단계가 올라갈 때만 알림 (내려갔다가 다시 올라가면 다시 알림)
+	if usage.Level.Severity() > previous.Severity() {
+		log.Printf("워크스페이스 디스크 사용량 %s: %s (%d/%d 바이트)", usage.Level, workspace.ID, usage.UsedBytes, usage.LimitBytes)
+		for _, listener := range s.listeners {
+			notice := *usage
+			listener.OnDiskQuotaLevelChanged(workspace, &notice)
+		}
+	}
+	return usage, nil
+}
+
+// directoryDiskUsage 디렉토리 아래 파일의 실제 디스크 사용량 합계 (하드 링크는 한 번만 셈, 읽을 수 없는 항목은 건너뜀)
+func directoryDiskUsage(ctx context.Context, root string) (int64, error) {
+	if _, err := os.Stat(root); err != nil {
+		return 0, err
+	}
+
+	var total int64
+	seen := make(map[string]bool)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if d != nil && d.IsDir() && path != root {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		key, size := diskUsageKey(path, info)
+		if !seen[key] {
+			seen[key] = true
+			total += size
+		}
+		return nil
+	})
+	return total, err
+}
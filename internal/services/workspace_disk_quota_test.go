@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+type recordingDiskQuotaListener struct {
+	levels []models.WorkspaceDiskQuotaLevel
+}
+
+func (l *recordingDiskQuotaListener) OnDiskQuotaLevelChanged(workspace *models.Workspace, usage *models.WorkspaceDiskUsage) {
+	l.levels = append(l.levels, usage.Level)
+}
+
+func TestWorkspaceDiskQuotaService_Levels(t *testing.T) {
+	store := memory.New()
+	root := t.TempDir()
+	ws := &models.Workspace{Name: "quota", OwnerID: "alice", ProjectPath: root, Status: models.WorkspaceStatusActive}
+	require.NoError(t, store.Workspace().Create(context.Background(), ws))
+	ctx := context.Background()
+
+	// 파일 시스템 블록 단위와 무관하도록 빈 디렉토리 사용량을 기준으로 한도 설정
+	base, err := directoryDiskUsage(ctx, root)
+	require.NoError(t, err)
+	data := make([]byte, 64<<10)
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.bin"), data, 0644))
+	written, err := directoryDiskUsage(ctx, root)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(root, "a.bin")))
+	fileSize := written - base
+	require.Greater(t, fileSize, int64(0))
+
+	listener := &recordingDiskQuotaListener{}
+	service := NewWorkspaceDiskQuotaService(store, WorkspaceDiskQuotaConfig{
+		Limits:   map[string]int64{ws.ID: base + fileSize*10/9},
+		CacheTTL: time.Hour,
+	})
+	service.AddListener(listener)
+
+	usage, err := service.Usage(ctx, ws.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspaceDiskQuotaOK, usage.Level)
+	require.NoError(t, service.CheckTask(ctx, ws.ID))
+
+	// 90% 사용: 경고 알림
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.bin"), data, 0644))
+	usage, err = service.Usage(ctx, ws.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspaceDiskQuotaWarning, usage.Level)
+	require.NoError(t, service.CheckTask(ctx, ws.ID))
+
+	// 같은 단계에서는 다시 알리지 않음
+	_, err = service.Usage(ctx, ws.ID, true)
+	require.NoError(t, err)
+
+	// 한도 도달: 캐시된 사용량으로도 태스크 거부
+	require.NoError(t, os.WriteFile(filepath.Join(root, "b.bin"), data, 0644))
+	usage, err = service.Usage(ctx, ws.ID, true)
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspaceDiskQuotaExceeded, usage.Level)
+	assert.Greater(t, usage.Percent, 100.0)
+
+	err = service.CheckTask(ctx, ws.ID)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrDiskQuotaExceeded))
+	var quotaErr *DiskQuotaError
+	require.True(t, errors.As(err, &quotaErr))
+	assert.Equal(t, ws.ID, quotaErr.Usage.WorkspaceID)
+
+	assert.Equal(t, []models.WorkspaceDiskQuotaLevel{models.WorkspaceDiskQuotaWarning, models.WorkspaceDiskQuotaExceeded}, listener.levels)
+
+	// 한도가 없는 워크스페이스는 측정하지 않고 통과
+	other := &models.Workspace{Name: "free", OwnerID: "alice", ProjectPath: "/nonexistent", Status: models.WorkspaceStatusActive}
+	require.NoError(t, store.Workspace().Create(ctx, other))
+	assert.NoError(t, service.CheckTask(ctx, other.ID))
+	_, err = service.Usage(ctx, other.ID, false)
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidPath)
+}
+
+type rejectingDiskQuota struct{}
+
+func (rejectingDiskQuota) CheckTask(ctx context.Context, workspaceID string) error {
+	return &DiskQuotaError{Usage: &models.WorkspaceDiskUsage{WorkspaceID: workspaceID, UsedBytes: 2, LimitBytes: 1, Level: models.WorkspaceDiskQuotaExceeded}}
+}
+
+func TestTaskService_RejectsWhenDiskQuotaExceeded(t *testing.T) {
+	taskService, _, session := setupTaskTest()
+	taskService.SetDiskQuota(rejectingDiskQuota{})
+
+	_, err := taskService.Create(context.Background(), &models.TaskCreateRequest{SessionID: session.ID, Command: "claude --help"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrDiskQuotaExceeded))
+}
@@ -0,0 +1,389 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/docker"
+	apierrors "github.com/aicli/aicli-web/internal/errors"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// defaultDoctorClaudeCommand PATH에서 찾을 Claude CLI 실행 파일
+	defaultDoctorClaudeCommand = "claude"
+	// doctorCommandTimeout git, claude 명령 하나에 허용하는 시간
+	doctorCommandTimeout = 15 * time.Second
+	// doctorImageTimeout 컨테이너 이미지를 받는 데 허용하는 시간
+	doctorImageTimeout = 3 * time.Minute
+)
+
+// DoctorImages 컨테이너 이미지를 받을 수 있는지 확인하는 Docker 어댑터
+type DoctorImages interface {
+	// DefaultImage 워크스페이스 이미지를 지정하지 않았을 때 쓰는 이미지
+	DefaultImage() string
+	// EnsureImage 이미지가 로컬에 없으면 받아옴
+	EnsureImage(ctx context.Context, image string) error
+}
+
+// dockerDoctorImages Docker 매니저 기반 DoctorImages
+type dockerDoctorImages struct {
+	manager *docker.Manager
+}
+
+// NewDockerDoctorImages Docker 매니저로 이미지 점검 제공
+func NewDockerDoctorImages(manager *docker.Manager) DoctorImages {
+	return &dockerDoctorImages{manager: manager}
+}
+
+func (d *dockerDoctorImages) DefaultImage() string {
+	return d.manager.Config().DefaultImage
+}
+
+func (d *dockerDoctorImages) EnsureImage(ctx context.Context, image string) error {
+	return d.manager.Container().EnsureImage(ctx, image)
+}
+
+// WorkspaceDoctorService 태스크를 실행하기 전에 워크스페이스 환경을 점검하는 서비스
+// 경로 쓰기 권한, git 저장소 상태, Claude CLI, 컨테이너 이미지, 디스크 한도, MCP 서버를 차례로 확인하고
+// 문제마다 errors 패키지의 에러 종류와 해결 방법을 함께 돌려줍니다.
+// 꺼져 있는 기능(Docker, 디스크 한도, MCP)은 skipped로 표시합니다.
+type WorkspaceDoctorService struct {
+	storage       storage.Storage
+	git           *GitService
+	claudeCommand string
+	images        DoctorImages
+	imageService  *WorkspaceImageService
+	diskQuota     *WorkspaceDiskQuotaService
+	mcp           *MCPService
+}
+
+// NewWorkspaceDoctorService 새 워크스페이스 점검 서비스 생성 (storage는 Run에서만 사용)
+func NewWorkspaceDoctorService(storage storage.Storage) *WorkspaceDoctorService {
+	return &WorkspaceDoctorService{
+		storage:       storage,
+		git:           NewGitService(),
+		claudeCommand: defaultDoctorClaudeCommand,
+	}
+}
+
+// SetClaudeCommand 점검할 Claude CLI 실행 파일 (이름이면 PATH에서 찾음)
+func (s *WorkspaceDoctorService) SetClaudeCommand(command string) {
+	if command != "" {
+		s.claudeCommand = command
+	}
+}
+
+// SetImages 컨테이너 이미지 점검 설정 (imageService가 있으면 워크스페이스 이미지를 우선 사용)
+func (s *WorkspaceDoctorService) SetImages(images DoctorImages, imageService *WorkspaceImageService) {
+	s.images = images
+	s.imageService = imageService
+}
+
+// SetDiskQuota 디스크 한도 점검에 사용할 서비스
+func (s *WorkspaceDoctorService) SetDiskQuota(diskQuota *WorkspaceDiskQuotaService) {
+	s.diskQuota = diskQuota
+}
+
+// SetMCP MCP 서버 점검에 사용할 서비스
+func (s *WorkspaceDoctorService) SetMCP(mcp *MCPService) {
+	s.mcp = mcp
+}
+
+// Run 저장된 워크스페이스를 점검
+func (s *WorkspaceDoctorService) Run(ctx context.Context, workspaceID string) (*models.WorkspaceDoctorReport, error) {
+	workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+	}
+	return s.Diagnose(ctx, workspace), nil
+}
+
+// Diagnose 워크스페이스를 점검해 항목별 결과를 반환
+// ID가 비어 있으면(CLI에서 경로만 점검) 워크스페이스별 설정이 필요한 항목은 기본값으로 확인합니다.
+func (s *WorkspaceDoctorService) Diagnose(ctx context.Context, workspace *models.Workspace) *models.WorkspaceDoctorReport {
+	report := &models.WorkspaceDoctorReport{
+		WorkspaceID: workspace.ID,
+		Path:        workspace.ProjectPath,
+		Healthy:     true,
+		CheckedAt:   time.Now().UTC(),
+	}
+
+	pathOK := s.runCheck(report, models.WorkspaceDoctorCheckPath, func() models.WorkspaceDoctorFinding {
+		return s.checkPath(workspace.ProjectPath)
+	})
+	s.runCheck(report, models.WorkspaceDoctorCheckGit, func() models.WorkspaceDoctorFinding {
+		if !pathOK {
+			return doctorSkipped("워크스페이스 경로를 사용할 수 없어 건너뜁니다")
+		}
+		return s.checkGit(ctx, workspace.ProjectPath)
+	})
+	s.runCheck(report, models.WorkspaceDoctorCheckClaude, func() models.WorkspaceDoctorFinding {
+		return s.checkClaude(ctx)
+	})
+	s.runCheck(report, models.WorkspaceDoctorCheckImage, func() models.WorkspaceDoctorFinding {
+		return s.checkImage(ctx, workspace)
+	})
+	s.runCheck(report, models.WorkspaceDoctorCheckDisk, func() models.WorkspaceDoctorFinding {
+		if !pathOK {
+			return doctorSkipped("워크스페이스 경로를 사용할 수 없어 건너뜁니다")
+		}
+		return s.checkDisk(ctx, workspace)
+	})
+	s.runCheck(report, models.WorkspaceDoctorCheckMCP, func() models.WorkspaceDoctorFinding {
+		return s.checkMCP(ctx, workspace.ID)
+	})
+
+	return report
+}
+
+// runCheck 점검 하나를 실행해 보고서에 추가하고, 실패가 아니면 true 반환
+func (s *WorkspaceDoctorService) runCheck(report *models.WorkspaceDoctorReport, check string, fn func() models.WorkspaceDoctorFinding) bool {
+	start := time.Now()
+	finding := fn()
+	finding.Check = check
+	finding.DurationMs = time.Since(start).Milliseconds()
+	report.Findings = append(report.Findings, finding)
+
+	if finding.Status == models.WorkspaceDoctorFail {
+		report.Healthy = false
+		return false
+	}
+	return finding.Status != models.WorkspaceDoctorSkipped
+}
+
+// checkPath 경로가 디렉토리이고 쓸 수 있는지 확인
+func (s *WorkspaceDoctorService) checkPath(path string) models.WorkspaceDoctorFinding {
+	if path == "" {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewValidationError("워크스페이스 경로가 설정되지 않았습니다",
+			"워크스페이스의 프로젝트 경로를 지정하세요"))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		cliErr := apierrors.NewFileSystemError("stat", path, err)
+		if errors.Is(err, os.ErrNotExist) {
+			cliErr.AddSuggestion(fmt.Sprintf("'mkdir -p %s'로 디렉토리를 만들거나 워크스페이스 경로를 수정하세요", path))
+		}
+		return doctorFinding(models.WorkspaceDoctorFail, cliErr)
+	}
+	if !info.IsDir() {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewFileSystemError("stat", path, errors.New("디렉토리가 아닙니다")).
+			AddSuggestion("워크스페이스 경로가 디렉토리를 가리키도록 수정하세요"))
+	}
+
+	// 실제로 파일을 만들어 봐야 읽기 전용 마운트와 ACL까지 확인할 수 있음
+	probe, err := os.CreateTemp(path, ".aicli-doctor-*")
+	if err != nil {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewPermissionError("쓰기", path).
+			WithCause(err).
+			AddSuggestion("서버 프로세스 사용자가 디렉토리 소유자인지, 읽기 전용으로 마운트되지 않았는지 확인하세요"))
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	return doctorOK("경로가 존재하고 쓸 수 있습니다")
+}
+
+// checkGit git 저장소이면 HEAD와 객체 연결 상태를 확인
+func (s *WorkspaceDoctorService) checkGit(ctx context.Context, path string) models.WorkspaceDoctorFinding {
+	if _, err := exec.LookPath("git"); err != nil {
+		return doctorFinding(models.WorkspaceDoctorWarn, apierrors.NewCLIError(apierrors.ErrorTypeNotFound, "git을 찾을 수 없습니다").
+			WithCause(err).
+			AddSuggestion("git을 설치하면 태스크 스냅샷, 리뷰, PR 기능을 사용할 수 있습니다"))
+	}
+	if !s.git.isGitRepository(path) {
+		return doctorFinding(models.WorkspaceDoctorWarn, apierrors.NewValidationError("git 저장소가 아닙니다",
+			fmt.Sprintf("'git -C %s init'으로 저장소를 만들면 태스크 결과 리뷰와 PR 기능을 사용할 수 있습니다", path)))
+	}
+
+	if _, err := s.runCommand(ctx, path, "git", "rev-parse", "--git-dir"); err != nil {
+		cliErr := doctorProcessError("git rev-parse", err)
+		if strings.Contains(err.Error(), "dubious ownership") {
+			cliErr.AddSuggestion(fmt.Sprintf("'git config --global --add safe.directory %s'로 저장소를 신뢰하도록 설정하세요", path))
+		}
+		return doctorFinding(models.WorkspaceDoctorFail, cliErr)
+	}
+	if _, err := s.runCommand(ctx, path, "git", "rev-parse", "--verify", "HEAD"); err != nil {
+		return doctorFinding(models.WorkspaceDoctorWarn, apierrors.NewValidationError("커밋이 없는 저장소입니다",
+			"첫 커밋을 만들어야 태스크 스냅샷과 변경 비교를 사용할 수 있습니다"))
+	}
+	if _, err := s.runCommand(ctx, path, "git", "fsck", "--no-progress", "--connectivity-only"); err != nil {
+		return doctorFinding(models.WorkspaceDoctorFail, doctorProcessError("git fsck", err).
+			AddSuggestion("'git fsck --full'로 손상된 객체를 확인하고 원격 저장소에서 다시 클론하세요"))
+	}
+
+	return doctorOK("git 저장소가 정상입니다")
+}
+
+// checkClaude Claude CLI를 찾아 버전을 확인
+func (s *WorkspaceDoctorService) checkClaude(ctx context.Context) models.WorkspaceDoctorFinding {
+	binary, err := exec.LookPath(s.claudeCommand)
+	if err != nil {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewCLIError(apierrors.ErrorTypeNotFound, "Claude CLI를 찾을 수 없습니다").
+			WithCause(err).
+			AddSuggestion("'npm install -g @anthropic-ai/claude-code'로 Claude CLI를 설치하세요").
+			AddSuggestion("서버 프로세스의 PATH에 Claude CLI 설치 경로가 포함되어 있는지 확인하세요"))
+	}
+
+	version, err := s.runCommand(ctx, "", binary, "--version")
+	if err != nil {
+		return doctorFinding(models.WorkspaceDoctorFail, doctorProcessError(s.claudeCommand+" --version", err).
+			AddSuggestion("Claude CLI를 다시 설치하거나 최신 버전으로 업데이트하세요"))
+	}
+	return doctorOK(fmt.Sprintf("Claude CLI %s (%s)", version, binary))
+}
+
+// checkImage 워크스페이스 이미지(없으면 기본 이미지)를 받을 수 있는지 확인
+func (s *WorkspaceDoctorService) checkImage(ctx context.Context, workspace *models.Workspace) models.WorkspaceDoctorFinding {
+	if s.images == nil {
+		return doctorSkipped("Docker를 사용할 수 없어 건너뜁니다")
+	}
+
+	image := s.images.DefaultImage()
+	if s.imageService != nil && workspace.ID != "" {
+		resolved, err := s.imageService.Resolve(workspace)
+		if err != nil {
+			return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewValidationError(err.Error(),
+				"워크스페이스 이미지 설정을 확인하고 Dockerfile이면 다시 빌드하세요"))
+		}
+		if resolved != nil {
+			image = resolved.Image
+		}
+	}
+
+	pullCtx, cancel := context.WithTimeout(ctx, doctorImageTimeout)
+	defer cancel()
+	if err := s.images.EnsureImage(pullCtx, image); err != nil {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewNetworkError("컨테이너 레지스트리", err).
+			AddContext("image", image).
+			AddSuggestion(fmt.Sprintf("'docker pull %s'로 직접 받아 보고 레지스트리 로그인이 필요한지 확인하세요", image)))
+	}
+	return doctorOK(fmt.Sprintf("컨테이너 이미지 %s를 사용할 수 있습니다", image))
+}
+
+// checkDisk 디스크 한도까지 남은 여유를 확인
+func (s *WorkspaceDoctorService) checkDisk(ctx context.Context, workspace *models.Workspace) models.WorkspaceDoctorFinding {
+	if s.diskQuota == nil {
+		return doctorSkipped("디스크 한도가 꺼져 있어 건너뜁니다")
+	}
+
+	usage, err := s.diskQuota.measure(ctx, workspace)
+	if err != nil {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewFileSystemError("disk usage", workspace.ProjectPath, err))
+	}
+	if usage.LimitBytes <= 0 {
+		return doctorOK(fmt.Sprintf("사용량 %d바이트 (한도 없음)", usage.UsedBytes))
+	}
+
+	message := fmt.Sprintf("한도의 %.1f%% 사용 중 (%d/%d 바이트)", usage.Percent, usage.UsedBytes, usage.LimitBytes)
+	switch usage.Level {
+	case models.WorkspaceDiskQuotaExceeded:
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewValidationError("디스크 한도에 도달해 새 태스크를 받을 수 없습니다: "+message,
+			"빌드 산출물, 의존성 캐시 등 필요 없는 파일을 정리하세요",
+			"관리자에게 워크스페이스 디스크 한도 상향을 요청하세요"))
+	case models.WorkspaceDiskQuotaWarning:
+		return doctorFinding(models.WorkspaceDoctorWarn, apierrors.NewValidationError("디스크 한도에 가깝습니다: "+message,
+			"빌드 산출물, 의존성 캐시 등 필요 없는 파일을 정리하세요"))
+	}
+	return doctorOK(message)
+}
+
+// checkMCP 워크스페이스의 MCP 서버가 모두 시작되는지 확인
+func (s *WorkspaceDoctorService) checkMCP(ctx context.Context, workspaceID string) models.WorkspaceDoctorFinding {
+	if s.mcp == nil || workspaceID == "" {
+		return doctorSkipped("MCP 서버 설정이 없어 건너뜁니다")
+	}
+
+	statuses, err := s.mcp.Check(ctx, workspaceID)
+	if err != nil {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewCLIError(apierrors.ErrorTypeConfig, "MCP 서버 설정을 읽을 수 없습니다").
+			WithCause(err).
+			AddSuggestion("워크스페이스 MCP 서버 설정을 다시 저장하세요"))
+	}
+	if len(statuses) == 0 {
+		return doctorOK("설정된 MCP 서버가 없습니다")
+	}
+
+	var failed []string
+	for _, status := range statuses {
+		if !status.Healthy {
+			failed = append(failed, fmt.Sprintf("%s (%s)", status.Name, status.Error))
+		}
+	}
+	if len(failed) > 0 {
+		return doctorFinding(models.WorkspaceDoctorFail, apierrors.NewProcessError("MCP 서버", -1, errors.New(strings.Join(failed, ", "))).
+			AddSuggestion("MCP 서버 명령과 인자, 환경 변수가 올바른지 확인하세요").
+			AddSuggestion("GET /workspaces/{id}/mcp/status로 서버별 오류를 확인하세요"))
+	}
+	return doctorOK(fmt.Sprintf("MCP 서버 %d개가 모두 응답합니다", len(statuses)))
+}
+
+// runCommand 점검용 명령을 시간 제한을 두고 실행 (실패하면 stderr를 에러 메시지에 포함)
+func (s *WorkspaceDoctorService) runCommand(ctx context.Context, dir, name string, args ...string) (string, error) {
+	cmdCtx, cancel := context.WithTimeout(ctx, doctorCommandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(cmdCtx, name, args...)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", &doctorCommandError{exitCode: exitErr.ExitCode(), message: strings.TrimSpace(string(exitErr.Stderr))}
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// doctorCommandError 종료 코드와 stderr를 담은 점검 명령 실패
+type doctorCommandError struct {
+	exitCode int
+	message  string
+}
+
+func (e *doctorCommandError) Error() string {
+	return e.message
+}
+
+// doctorProcessError 점검 명령 실패를 프로세스 에러로 변환
+func doctorProcessError(command string, err error) *apierrors.CLIError {
+	exitCode := -1
+	var cmdErr *doctorCommandError
+	if errors.As(err, &cmdErr) {
+		exitCode = cmdErr.exitCode
+	}
+	return apierrors.NewProcessError(command, exitCode, err)
+}
+
+// doctorFinding 에러의 종류, 메시지, 해결 방법으로 점검 결과 생성
+func doctorFinding(status models.WorkspaceDoctorStatus, err *apierrors.CLIError) models.WorkspaceDoctorFinding {
+	message := err.Message
+	if err.Cause != nil {
+		message += ": " + err.Cause.Error()
+	}
+	return models.WorkspaceDoctorFinding{
+		Status:      status,
+		Message:     message,
+		ErrorType:   err.Type.String(),
+		Suggestions: err.Suggestions,
+	}
+}
+
+func doctorOK(message string) models.WorkspaceDoctorFinding {
+	return models.WorkspaceDoctorFinding{Status: models.WorkspaceDoctorOK, Message: message}
+}
+
+func doctorSkipped(message string) models.WorkspaceDoctorFinding {
+	return models.WorkspaceDoctorFinding{Status: models.WorkspaceDoctorSkipped, Message: message}
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeDoctorImages 이미지 받기 결과를 정할 수 있는 테스트용 DoctorImages
+type fakeDoctorImages struct {
+	err    error
+	pulled []string
+}
+
+func (f *fakeDoctorImages) DefaultImage() string {
+	return "alpine:latest"
+}
+
+func (f *fakeDoctorImages) EnsureImage(ctx context.Context, image string) error {
+	f.pulled = append(f.pulled, image)
+	return f.err
+}
+
+func doctorFindings(report *models.WorkspaceDoctorReport) map[string]models.WorkspaceDoctorFinding {
+	findings := make(map[string]models.WorkspaceDoctorFinding, len(report.Findings))
+	for _, finding := range report.Findings {
+		findings[finding.Check] = finding
+	}
+	return findings
+}
+
+func TestWorkspaceDoctorService_Diagnose(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git이 설치되어 있지 않습니다")
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "big.bin"), make([]byte, 64*1024), 0o644))
+
+	images := &fakeDoctorImages{err: errors.New("pull access denied")}
+	doctor := NewWorkspaceDoctorService(memory.New())
+	doctor.SetClaudeCommand("aicli-doctor-missing-claude")
+	doctor.SetImages(images, nil)
+	doctor.SetDiskQuota(NewWorkspaceDiskQuotaService(nil, WorkspaceDiskQuotaConfig{DefaultLimit: 1024}))
+
+	report := doctor.Diagnose(ctx, &models.Workspace{ID: "ws-1", ProjectPath: dir})
+	assert.False(t, report.Healthy)
+	require.Len(t, report.Findings, 6)
+
+	findings := doctorFindings(report)
+	assert.Equal(t, models.WorkspaceDoctorOK, findings[models.WorkspaceDoctorCheckPath].Status)
+	assert.Equal(t, models.WorkspaceDoctorOK, findings[models.WorkspaceDoctorCheckGit].Status)
+
+	claudeFinding := findings[models.WorkspaceDoctorCheckClaude]
+	assert.Equal(t, models.WorkspaceDoctorFail, claudeFinding.Status)
+	assert.Equal(t, "NotFoundError", claudeFinding.ErrorType)
+	assert.NotEmpty(t, claudeFinding.Suggestions)
+
+	imageFinding := findings[models.WorkspaceDoctorCheckImage]
+	assert.Equal(t, models.WorkspaceDoctorFail, imageFinding.Status)
+	assert.Equal(t, "NetworkError", imageFinding.ErrorType)
+	assert.Contains(t, imageFinding.Message, "pull access denied")
+	assert.Equal(t, []string{"alpine:latest"}, images.pulled)
+
+	assert.Equal(t, models.WorkspaceDoctorFail, findings[models.WorkspaceDoctorCheckDisk].Status)
+	assert.Equal(t, models.WorkspaceDoctorSkipped, findings[models.WorkspaceDoctorCheckMCP].Status)
+
+	// 점검용 임시 파일은 남기지 않음
+	matches, err := filepath.Glob(filepath.Join(dir, ".aicli-doctor-*"))
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
+
+func TestWorkspaceDoctorService_MissingPath(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	doctor := NewWorkspaceDoctorService(store)
+
+	_, err := doctor.Run(ctx, "missing")
+	require.Error(t, err)
+
+	workspace := &models.Workspace{ID: "ws-1", Name: "doctor", ProjectPath: filepath.Join(t.TempDir(), "gone"), OwnerID: "alice"}
+	require.NoError(t, store.Workspace().Create(ctx, workspace))
+
+	report, err := doctor.Run(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.False(t, report.Healthy)
+
+	findings := doctorFindings(report)
+	path := findings[models.WorkspaceDoctorCheckPath]
+	assert.Equal(t, models.WorkspaceDoctorFail, path.Status)
+	assert.Equal(t, "FileSystemError", path.ErrorType)
+	assert.Contains(t, path.Suggestions[len(path.Suggestions)-1], "mkdir -p")
+
+	// 경로를 쓸 수 없으면 경로에 의존하는 점검은 건너뜀
+	assert.Equal(t, models.WorkspaceDoctorSkipped, findings[models.WorkspaceDoctorCheckGit].Status)
+	assert.Equal(t, models.WorkspaceDoctorSkipped, findings[models.WorkspaceDoctorCheckDisk].Status)
+	assert.Equal(t, models.WorkspaceDoctorSkipped, findings[models.WorkspaceDoctorCheckImage].Status)
+}
@@ -0,0 +1,307 @@
+package services
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/logging"
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/secrets"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// workspaceEnvKeyPattern 환경 변수 이름 형식
+var workspaceEnvKeyPattern = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+
+// reservedWorkspaceEnvKeys 쿼터 거버너와 자격 증명 설정이 관리하므로 워크스페이스에서 덮어쓸 수 없는 변수
+var reservedWorkspaceEnvKeys = map[string]bool{
+	"CLAUDE_CODE_OAUTH_TOKEN": true,
+	"CLAUDE_API_KEY":          true,
+	"ANTHROPIC_API_KEY":       true,
+}
+
+// defaultWorkspaceEnvHistoryLimit 변경 기록 조회 기본 개수
+const defaultWorkspaceEnvHistoryLimit = 50
+
+// WorkspaceEnvService 워크스페이스별 환경 변수 관리
+// 변수는 태스크와 Claude 세션 프로세스에 전달됩니다. 비밀 변수 값은 secrets.Box로 암호화해 저장하고
+// API 응답에서는 가리며, 변경 기록과 로그에는 변수 이름만 남깁니다.
+type WorkspaceEnvService struct {
+	storage storage.Storage
+	box     *secrets.Box
+	logger  logging.Logger
+}
+
+// NewWorkspaceEnvService 새 환경 변수 서비스 생성 (box가 nil이면 비밀 변수를 만들 수 없음)
+func NewWorkspaceEnvService(storage storage.Storage, box *secrets.Box) *WorkspaceEnvService {
+	return &WorkspaceEnvService{
+		storage: storage,
+		box:     box,
+		logger:  logging.FromLogrus(nil),
+	}
+}
+
+// SetLogger 변경 로그를 남길 로거 설정
+func (s *WorkspaceEnvService) SetLogger(logger logging.Logger) {
+	s.logger = logger
+}
+
+// List 워크스페이스의 환경 변수 조회 (비밀 변수 값은 마스킹)
+func (s *WorkspaceEnvService) List(ctx context.Context, workspaceID string) ([]*models.WorkspaceEnvVar, error) {
+	variables, err := s.storage.WorkspaceEnv().List(ctx, workspaceID)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "환경 변수 조회 실패", err)
+	}
+	for _, variable := range variables {
+		maskWorkspaceEnvVar(variable)
+	}
+	return variables, nil
+}
+
+// Create 환경 변수 추가
+func (s *WorkspaceEnvService) Create(ctx context.Context, workspaceID, userID string, req *models.WorkspaceEnvVarCreateRequest) (*models.WorkspaceEnvVar, error) {
+	if err := validateWorkspaceEnvKey(req.Key); err != nil {
+		return nil, err
+	}
+
+	value, err := s.storedValue(req.Value, req.Secret)
+	if err != nil {
+		return nil, err
+	}
+	variable := &models.WorkspaceEnvVar{
+		WorkspaceID: workspaceID,
+		Key:         req.Key,
+		Value:       value,
+		Secret:      req.Secret,
+		UpdatedBy:   userID,
+	}
+	if err := s.storage.WorkspaceEnv().Create(ctx, variable); err != nil {
+		if storage.IsAlreadyExistsError(err) {
+			return nil, NewWorkspaceError(ErrCodeAlreadyExists, "같은 이름의 환경 변수가 이미 있습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "환경 변수 저장 실패", err)
+	}
+
+	s.recordChange(ctx, variable, models.WorkspaceEnvCreated, true, userID)
+	maskWorkspaceEnvVar(variable)
+	return variable, nil
+}
+
+// Update 환경 변수의 값 또는 비밀 여부 변경
+// 비밀 변수를 일반 변수로 바꿀 때는 저장된 값이 응답에 드러나지 않도록 새 값을 함께 받아야 합니다.
+func (s *WorkspaceEnvService) Update(ctx context.Context, workspaceID, key, userID string, req *models.WorkspaceEnvVarUpdateRequest) (*models.WorkspaceEnvVar, error) {
+	variable, err := s.get(ctx, workspaceID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.plaintext(variable)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "비밀 변수 복호화 실패", err)
+	}
+	secret := variable.Secret
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+	if variable.Secret && !secret && req.Value == nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "비밀 변수를 일반 변수로 바꿀 때는 새 값이 필요합니다", ErrInvalidRequest)
+	}
+
+	next := current
+	if req.Value != nil {
+		next = *req.Value
+	}
+	valueChanged := next != current
+	if !valueChanged && secret == variable.Secret {
+		maskWorkspaceEnvVar(variable)
+		return variable, nil
+	}
+
+	if variable.Value, err = s.storedValue(next, secret); err != nil {
+		return nil, err
+	}
+	variable.Secret = secret
+	variable.UpdatedBy = userID
+	if err := s.storage.WorkspaceEnv().Update(ctx, variable); err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "환경 변수를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "환경 변수 저장 실패", err)
+	}
+
+	s.recordChange(ctx, variable, models.WorkspaceEnvUpdated, valueChanged, userID)
+	maskWorkspaceEnvVar(variable)
+	return variable, nil
+}
+
+// Delete 환경 변수 삭제
+func (s *WorkspaceEnvService) Delete(ctx context.Context, workspaceID, key, userID string) error {
+	variable, err := s.get(ctx, workspaceID, key)
+	if err != nil {
+		return err
+	}
+	if err := s.storage.WorkspaceEnv().Delete(ctx, workspaceID, key); err != nil {
+		if storage.IsNotFoundError(err) {
+			return NewWorkspaceError(ErrCodeNotFound, "환경 변수를 찾을 수 없습니다", err)
+		}
+		return NewWorkspaceError(ErrCodeInternal, "환경 변수 삭제 실패", err)
+	}
+
+	s.recordChange(ctx, variable, models.WorkspaceEnvDeleted, true, userID)
+	return nil
+}
+
+// History 환경 변수 변경 기록 조회 (최신순)
+func (s *WorkspaceEnvService) History(ctx context.Context, workspaceID string, req *models.WorkspaceEnvHistoryRequest) ([]*models.WorkspaceEnvChange, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultWorkspaceEnvHistoryLimit
+	}
+	changes, err := s.storage.WorkspaceEnv().ListChanges(ctx, workspaceID, req.Key, limit)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "환경 변수 변경 기록 조회 실패", err)
+	}
+	return changes, nil
+}
+
+// Environment 프로세스에 전달할 워크스페이스 환경 변수 (비밀 변수는 복호화한 값)
+// 복호화하지 못한 변수는 건너뛰고 이름만 로그에 남깁니다.
+func (s *WorkspaceEnvService) Environment(ctx context.Context, workspaceID string) (map[string]string, error) {
+	if workspaceID == "" {
+		return nil, nil
+	}
+	variables, err := s.storage.WorkspaceEnv().List(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		value, err := s.plaintext(variable)
+		if err != nil {
+			s.logger.WithContext(ctx).WithFields(logging.Fields{
+				"workspace_id": workspaceID,
+				"key":          variable.Key,
+			}).Warn("비밀 환경 변수 복호화 실패, 전달하지 않음")
+			continue
+		}
+		env[variable.Key] = value
+	}
+	return env, nil
+}
+
+// WorkspaceEnvironment 세션 시작 시 사용할 워크스페이스 환경 변수 (claude.EnvironmentProvider 구현)
+func (s *WorkspaceEnvService) WorkspaceEnvironment(workspaceID string) map[string]string {
+	env, err := s.Environment(context.Background(), workspaceID)
+	if err != nil {
+		s.logger.WithError(err).WithField("workspace_id", workspaceID).Warn("워크스페이스 환경 변수 조회 실패")
+		return nil
+	}
+	if len(env) == 0 {
+		return nil
+	}
+	return env
+}
+
+// MaskSecrets 출력에 그대로 나타난 워크스페이스 비밀 변수 값을 가림
+func (s *WorkspaceEnvService) MaskSecrets(ctx context.Context, workspaceID, text string) string {
+	if workspaceID == "" || text == "" {
+		return text
+	}
+	variables, err := s.storage.WorkspaceEnv().List(ctx, workspaceID)
+	if err != nil {
+		return text
+	}
+	for _, variable := range variables {
+		if !variable.Secret {
+			continue
+		}
+		value, err := s.plaintext(variable)
+		if err != nil || value == "" {
+			continue
+		}
+		text = strings.ReplaceAll(text, value, maskedValue)
+	}
+	return text
+}
+
+// get 환경 변수 조회 (없으면 ErrCodeNotFound)
+func (s *WorkspaceEnvService) get(ctx context.Context, workspaceID, key string) (*models.WorkspaceEnvVar, error) {
+	variable, err := s.storage.WorkspaceEnv().Get(ctx, workspaceID, key)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "환경 변수를 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "환경 변수 조회 실패", err)
+	}
+	return variable, nil
+}
+
+// storedValue 저장할 값 (비밀 변수는 암호화)
+func (s *WorkspaceEnvService) storedValue(value string, secret bool) (string, error) {
+	if !secret {
+		return value, nil
+	}
+	if s.box == nil {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "비밀 값 암호화 키가 설정되지 않아 비밀 변수를 저장할 수 없습니다", ErrInvalidRequest)
+	}
+	sealed, err := s.box.Seal(value)
+	if err != nil {
+		return "", NewWorkspaceError(ErrCodeInternal, "비밀 변수 암호화 실패", err)
+	}
+	return sealed, nil
+}
+
+// plaintext 저장된 변수의 원래 값 (비밀 변수는 복호화)
+func (s *WorkspaceEnvService) plaintext(variable *models.WorkspaceEnvVar) (string, error) {
+	if !variable.Secret {
+		return variable.Value, nil
+	}
+	if s.box == nil {
+		return "", secrets.ErrInvalidSealed
+	}
+	return s.box.Open(variable.Value)
+}
+
+// recordChange 변경 기록과 로그 남기기 (값은 남기지 않음, 기록 실패는 변경을 되돌리지 않음)
+func (s *WorkspaceEnvService) recordChange(ctx context.Context, variable *models.WorkspaceEnvVar, action models.WorkspaceEnvAction, valueChanged bool, userID string) {
+	change := &models.WorkspaceEnvChange{
+		WorkspaceID:  variable.WorkspaceID,
+		Key:          variable.Key,
+		Action:       action,
+		Secret:       variable.Secret,
+		ValueChanged: valueChanged,
+		ActorID:      userID,
+	}
+	logger := s.logger.WithContext(ctx).WithFields(logging.Fields{
+		"workspace_id": variable.WorkspaceID,
+		"key":          variable.Key,
+		"action":       action,
+		"secret":       variable.Secret,
+		"actor_id":     userID,
+	})
+	if err := s.storage.WorkspaceEnv().AddChange(ctx, change); err != nil {
+		logger.WithError(err).Warn("환경 변수 변경 기록 실패")
+		return
+	}
+	logger.Info("워크스페이스 환경 변수 변경")
+}
+
+// validateWorkspaceEnvKey 환경 변수 이름 검증
+func validateWorkspaceEnvKey(key string) error {
+	if !workspaceEnvKeyPattern.MatchString(key) {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "환경 변수 이름은 영문 대문자, 숫자, 밑줄로 이루어져야 하며 숫자로 시작할 수 없습니다", ErrInvalidRequest)
+	}
+	if reservedWorkspaceEnvKeys[key] {
+		return NewWorkspaceError(ErrCodeInvalidRequest, "자격 증명 설정이 관리하는 환경 변수는 지정할 수 없습니다: "+key, ErrInvalidRequest)
+	}
+	return nil
+}
+
+// maskWorkspaceEnvVar 응답용으로 비밀 변수 값을 가림
+func maskWorkspaceEnvVar(variable *models.WorkspaceEnvVar) {
+	if variable.Secret {
+		variable.Value = maskedValue
+	}
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/secrets"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// envTaskRunner 전달받은 환경 변수를 기록하고 비밀 값을 출력하는 테스트용 실행기
+type envTaskRunner struct {
+	mu  sync.Mutex
+	env map[string]string
+}
+
+func (r *envTaskRunner) RunTask(ctx context.Context, run *TaskRun) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.env = run.Process.Environment
+	return "token=" + run.Process.Environment["DEPLOY_TOKEN"] + " region=" + run.Process.Environment["REGION"], nil
+}
+
+func (r *envTaskRunner) environment() map[string]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.env
+}
+
+func newTestWorkspaceEnvService(t *testing.T) (*WorkspaceEnvService, *memory.Storage) {
+	t.Helper()
+	box, err := secrets.NewBox("workspace-env-test-key-with-32-chars")
+	require.NoError(t, err)
+	store := memory.New()
+	return NewWorkspaceEnvService(store, box), store
+}
+
+func TestWorkspaceEnvService_MasksSecrets(t *testing.T) {
+	ctx := context.Background()
+	svc, store := newTestWorkspaceEnvService(t)
+
+	created, err := svc.Create(ctx, "ws-1", "alice", &models.WorkspaceEnvVarCreateRequest{Key: "DEPLOY_TOKEN", Value: "tok-123456", Secret: true})
+	require.NoError(t, err)
+	assert.Equal(t, maskedValue, created.Value)
+	_, err = svc.Create(ctx, "ws-1", "alice", &models.WorkspaceEnvVarCreateRequest{Key: "REGION", Value: "eu-west-1"})
+	require.NoError(t, err)
+
+	// 저장소에는 암호화한 값만 남음
+	stored, err := store.WorkspaceEnv().Get(ctx, "ws-1", "DEPLOY_TOKEN")
+	require.NoError(t, err)
+	assert.NotContains(t, stored.Value, "tok-123456")
+
+	variables, err := svc.List(ctx, "ws-1")
+	require.NoError(t, err)
+	require.Len(t, variables, 2)
+	assert.Equal(t, "DEPLOY_TOKEN", variables[0].Key)
+	assert.Equal(t, maskedValue, variables[0].Value)
+	assert.Equal(t, "eu-west-1", variables[1].Value)
+
+	env, err := svc.Environment(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"DEPLOY_TOKEN": "tok-123456", "REGION": "eu-west-1"}, env)
+	assert.Equal(t, "token="+maskedValue+" region=eu-west-1", svc.MaskSecrets(ctx, "ws-1", "token=tok-123456 region=eu-west-1"))
+
+	// 비밀 변수를 일반 변수로 바꿀 때는 새 값이 필요함
+	public := false
+	_, err = svc.Update(ctx, "ws-1", "DEPLOY_TOKEN", "bob", &models.WorkspaceEnvVarUpdateRequest{Secret: &public})
+	require.Error(t, err)
+
+	// 일반 변수를 비밀 변수로 바꾸면 기존 값을 암호화
+	secret := true
+	updated, err := svc.Update(ctx, "ws-1", "REGION", "bob", &models.WorkspaceEnvVarUpdateRequest{Secret: &secret})
+	require.NoError(t, err)
+	assert.Equal(t, maskedValue, updated.Value)
+	env, err = svc.Environment(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", env["REGION"])
+
+	value := "tok-654321"
+	_, err = svc.Update(ctx, "ws-1", "DEPLOY_TOKEN", "bob", &models.WorkspaceEnvVarUpdateRequest{Value: &value})
+	require.NoError(t, err)
+	require.NoError(t, svc.Delete(ctx, "ws-1", "REGION", "carol"))
+
+	changes, err := svc.History(ctx, "ws-1", &models.WorkspaceEnvHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, changes, 5)
+	assert.Equal(t, models.WorkspaceEnvDeleted, changes[0].Action)
+	assert.Equal(t, "carol", changes[0].ActorID)
+	assert.True(t, changes[1].ValueChanged)
+	assert.Equal(t, "REGION", changes[2].Key)
+	assert.False(t, changes[2].ValueChanged)
+	assert.True(t, changes[2].Secret)
+
+	changes, err = svc.History(ctx, "ws-1", &models.WorkspaceEnvHistoryRequest{Key: "DEPLOY_TOKEN", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, models.WorkspaceEnvUpdated, changes[0].Action)
+}
+
+func TestWorkspaceEnvService_ValidatesKeys(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestWorkspaceEnvService(t)
+
+	for _, key := range []string{"lower", "1START", "HAS-DASH", "CLAUDE_CODE_OAUTH_TOKEN"} {
+		_, err := svc.Create(ctx, "ws-1", "alice", &models.WorkspaceEnvVarCreateRequest{Key: key, Value: "v"})
+		assert.Error(t, err, key)
+	}
+
+	_, err := svc.Create(ctx, "ws-1", "alice", &models.WorkspaceEnvVarCreateRequest{Key: "REGION", Value: "v"})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, "ws-1", "alice", &models.WorkspaceEnvVarCreateRequest{Key: "REGION", Value: "w"})
+	assert.Error(t, err)
+
+	// 암호화 키가 없으면 비밀 변수를 만들 수 없음
+	plain := NewWorkspaceEnvService(memory.New(), nil)
+	_, err = plain.Create(ctx, "ws-1", "alice", &models.WorkspaceEnvVarCreateRequest{Key: "TOKEN", Value: "v", Secret: true})
+	assert.Error(t, err)
+}
+
+func TestTaskService_InjectsWorkspaceEnv(t *testing.T) {
+	ctx := context.Background()
+	svc, store := newTestWorkspaceEnvService(t)
+	taskService := NewTaskService(store, NewSessionService(store, NewProjectService(store), nil), nil)
+	require.NoError(t, taskService.Start(ctx))
+	defer taskService.Stop()
+
+	runner := &envTaskRunner{}
+	taskService.SetTaskRunner(runner)
+	taskService.SetEnvironment(svc)
+
+	session := seedAnalyticsSession(t, store, "env-app", "alice")
+	project, err := store.Project().GetByID(ctx, session.ProjectID)
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, project.WorkspaceID, "alice", &models.WorkspaceEnvVarCreateRequest{Key: "DEPLOY_TOKEN", Value: "tok-123456", Secret: true})
+	require.NoError(t, err)
+	_, err = svc.Create(ctx, project.WorkspaceID, "alice", &models.WorkspaceEnvVarCreateRequest{Key: "REGION", Value: "eu-west-1"})
+	require.NoError(t, err)
+
+	task, err := taskService.Create(ctx, &models.TaskCreateRequest{SessionID: session.ID, Command: "claude -p deploy"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		current, err := taskService.GetByID(ctx, task.ID)
+		return err == nil && current.Status == models.TaskCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, map[string]string{"DEPLOY_TOKEN": "tok-123456", "REGION": "eu-west-1"}, runner.environment())
+
+	// 출력에 나타난 비밀 값은 저장 전에 가림
+	current, err := taskService.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "token="+maskedValue+" region=eu-west-1", current.Output)
+}
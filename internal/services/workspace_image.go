@@ -0,0 +1,394 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// ImageBuildStatus 워크스페이스 이미지 빌드 상태
+type ImageBuildStatus string
+
+const (
+	ImageBuildPending   ImageBuildStatus = "pending"
+	ImageBuildRunning   ImageBuildStatus = "running"
+	ImageBuildSucceeded ImageBuildStatus = "succeeded"
+	ImageBuildFailed    ImageBuildStatus = "failed"
+)
+
+// maxImageBuildLogLines 빌드마다 보관할 최근 로그 줄 수
+const maxImageBuildLogLines = 1000
+
+// ImageBuild 워크스페이스 Dockerfile 빌드 기록
+type ImageBuild struct {
+	ID          string           `json:"id"`
+	WorkspaceID string           `json:"workspace_id"`
+	Status      ImageBuildStatus `json:"status"`
+	Tag         string           `json:"tag,omitempty"`
+	Cached      bool             `json:"cached"`
+	Error       string           `json:"error,omitempty"`
+	StartedAt   time.Time        `json:"started_at"`
+	FinishedAt  *time.Time       `json:"finished_at,omitempty"`
+	Logs        []string         `json:"logs,omitempty"`
+}
+
+// WorkspaceImage 워크스페이스 이미지 설정과 현재 빌드 상태
+type WorkspaceImage struct {
+	WorkspaceID string                    `json:"workspace_id"`
+	Spec        docker.WorkspaceImageSpec `json:"spec"`
+	Image       string                    `json:"image,omitempty"` // 컨테이너에 사용할 이미지 (Dockerfile은 빌드 성공 후 채워짐)
+	Build       *ImageBuild               `json:"build,omitempty"`
+}
+
+// WorkspaceImageBuilder Dockerfile 이미지 빌더 (docker.ImageBuilder가 구현)
+type WorkspaceImageBuilder interface {
+	Build(ctx context.Context, req docker.ImageBuildRequest, logFn func(line string)) (*docker.ImageBuildResult, error)
+}
+
+// ImageBuildListener 빌드 로그와 상태 변경을 전달받는 리스너 (WebSocket 스트리밍용)
+type ImageBuildListener interface {
+	OnImageBuildLog(ownerID string, build ImageBuild, line string)
+	OnImageBuildStatus(ownerID string, build ImageBuild)
+}
+
+// storedWorkspaceImage 파일에 저장되는 워크스페이스 이미지 설정
+type storedWorkspaceImage struct {
+	Spec  docker.WorkspaceImageSpec `json:"spec"`
+	Image string                    `json:"image,omitempty"`
+}
+
+// WorkspaceImageService 워크스페이스별 컨테이너 이미지 지정과 Dockerfile 빌드를 관리하는 서비스
+// 설정은 "<dir>/<workspaceID>.json" 파일에 저장되며, 컨테이너를 만들 때 정책을 다시 확인합니다.
+type WorkspaceImageService struct {
+	dir          string
+	policy       docker.ImagePolicy
+	builder      WorkspaceImageBuilder
+	listener     ImageBuildListener
+	buildTimeout time.Duration
+
+	mu     sync.RWMutex
+	builds map[string]*ImageBuild // 워크스페이스별 마지막 빌드
+	wg     sync.WaitGroup
+}
+
+// NewWorkspaceImageService 새 워크스페이스 이미지 서비스 생성 (builder가 nil이면 Dockerfile 빌드 불가)
+func NewWorkspaceImageService(dir string, policy docker.ImagePolicy, builder WorkspaceImageBuilder, buildTimeout time.Duration) *WorkspaceImageService {
+	if buildTimeout <= 0 {
+		buildTimeout = 30 * time.Minute
+	}
+	return &WorkspaceImageService{
+		dir:          dir,
+		policy:       policy,
+		builder:      builder,
+		buildTimeout: buildTimeout,
+		builds:       make(map[string]*ImageBuild),
+	}
+}
+
+// SetListener 빌드 로그 리스너 설정
+func (s *WorkspaceImageService) SetListener(listener ImageBuildListener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listener = listener
+}
+
+// Policy 이미지 정책 반환
+func (s *WorkspaceImageService) Policy() docker.ImagePolicy {
+	return s.policy
+}
+
+// Get 워크스페이스 이미지 설정과 마지막 빌드 조회
+func (s *WorkspaceImageService) Get(ctx context.Context, workspaceID string) (*WorkspaceImage, error) {
+	stored, err := s.load(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "워크스페이스 이미지 설정이 없습니다", nil)
+	}
+
+	result := &WorkspaceImage{WorkspaceID: workspaceID, Spec: stored.Spec, Image: stored.Image}
+	if build := s.LatestBuild(workspaceID); build != nil {
+		result.Build = build
+	}
+	return result, nil
+}
+
+// Put 워크스페이스 이미지를 지정합니다. Dockerfile이면 백그라운드에서 빌드를 시작합니다.
+func (s *WorkspaceImageService) Put(ctx context.Context, workspace *models.Workspace, spec docker.WorkspaceImageSpec) (*WorkspaceImage, error) {
+	if err := s.policy.Validate(spec, workspace.ProjectPath); err != nil {
+		return nil, policyError(err)
+	}
+	if spec.Dockerfile != "" && s.builder == nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "Docker를 사용할 수 없어 Dockerfile을 빌드할 수 없습니다", nil)
+	}
+
+	s.mu.Lock()
+	if build := s.builds[workspace.ID]; build != nil && isBuildActive(build) {
+		s.mu.Unlock()
+		return nil, NewWorkspaceError(ErrCodeResourceBusy, "이미지 빌드가 진행 중입니다", nil)
+	}
+	stored := &storedWorkspaceImage{Spec: spec}
+	if spec.Dockerfile == "" {
+		stored.Image = spec.Image
+		delete(s.builds, workspace.ID)
+	}
+	if err := s.save(workspace.ID, stored); err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+
+	result := &WorkspaceImage{WorkspaceID: workspace.ID, Spec: spec, Image: stored.Image}
+	if spec.Dockerfile != "" {
+		build := s.startBuildLocked(workspace, spec, false)
+		result.Build = &build
+	}
+	s.mu.Unlock()
+
+	return result, nil
+}
+
+// Rebuild 워크스페이스 Dockerfile을 다시 빌드합니다 (noCache면 이전 빌드 결과와 레이어 캐시를 쓰지 않음)
+func (s *WorkspaceImageService) Rebuild(ctx context.Context, workspace *models.Workspace, noCache bool) (*ImageBuild, error) {
+	stored, err := s.load(workspace.ID)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil || stored.Spec.Dockerfile == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "Dockerfile이 지정되지 않은 워크스페이스입니다", nil)
+	}
+	if s.builder == nil {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "Docker를 사용할 수 없어 Dockerfile을 빌드할 수 없습니다", nil)
+	}
+	// 정책이 바뀌었을 수 있으므로 다시 확인
+	if err := s.policy.Validate(stored.Spec, workspace.ProjectPath); err != nil {
+		return nil, policyError(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if build := s.builds[workspace.ID]; build != nil && isBuildActive(build) {
+		return nil, NewWorkspaceError(ErrCodeResourceBusy, "이미지 빌드가 진행 중입니다", nil)
+	}
+	build := s.startBuildLocked(workspace, stored.Spec, noCache)
+	return &build, nil
+}
+
+// LatestBuild 워크스페이스의 마지막 빌드 기록 (없으면 nil)
+func (s *WorkspaceImageService) LatestBuild(workspaceID string) *ImageBuild {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	build, ok := s.builds[workspaceID]
+	if !ok {
+		return nil
+	}
+	copied := *build
+	copied.Logs = append([]string(nil), build.Logs...)
+	return &copied
+}
+
+// Delete 워크스페이스 이미지 설정 삭제
+func (s *WorkspaceImageService) Delete(ctx context.Context, workspaceID string) error {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.builds, workspaceID)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Resolve 컨테이너를 만들 때 사용할 워크스페이스 이미지 설정 (지정하지 않았으면 nil)
+// 저장 이후 정책이 바뀌었을 수 있으므로 다시 확인하고, Dockerfile은 빌드가 끝나야 사용할 수 있습니다.
+func (s *WorkspaceImageService) Resolve(workspace *models.Workspace) (*WorkspaceImage, error) {
+	stored, err := s.load(workspace.ID)
+	if err != nil || stored == nil {
+		return nil, err
+	}
+	if err := s.policy.Validate(stored.Spec, workspace.ProjectPath); err != nil {
+		return nil, policyError(err)
+	}
+	if stored.Image == "" {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "워크스페이스 이미지가 아직 빌드되지 않았습니다", nil)
+	}
+	return &WorkspaceImage{WorkspaceID: workspace.ID, Spec: stored.Spec, Image: stored.Image}, nil
+}
+
+// Wait 진행 중인 빌드가 끝날 때까지 대기
+func (s *WorkspaceImageService) Wait() {
+	s.wg.Wait()
+}
+
+// startBuildLocked 빌드 기록을 만들고 백그라운드 빌드를 시작합니다 (s.mu를 잡은 상태로 호출)
+func (s *WorkspaceImageService) startBuildLocked(workspace *models.Workspace, spec docker.WorkspaceImageSpec, noCache bool) ImageBuild {
+	build := &ImageBuild{
+		ID:          uuid.New().String(),
+		WorkspaceID: workspace.ID,
+		Status:      ImageBuildPending,
+		StartedAt:   time.Now(),
+	}
+	s.builds[workspace.ID] = build
+	snapshot := *build
+
+	s.wg.Add(1)
+	go s.runBuild(workspace.ID, workspace.OwnerID, build, docker.ImageBuildRequest{
+		WorkspaceID: workspace.ID,
+		Dockerfile:  spec.Dockerfile,
+		BuildArgs:   spec.BuildArgs,
+		NoCache:     noCache,
+	})
+	return snapshot
+}
+
+// runBuild 이미지를 빌드하고 결과를 저장합니다.
+func (s *WorkspaceImageService) runBuild(workspaceID, ownerID string, build *ImageBuild, req docker.ImageBuildRequest) {
+	defer s.wg.Done()
+
+	s.updateBuild(ownerID, build, func(b *ImageBuild) { b.Status = ImageBuildRunning })
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.buildTimeout)
+	defer cancel()
+
+	result, err := s.builder.Build(ctx, req, func(line string) {
+		s.mu.Lock()
+		build.Logs = append(build.Logs, line)
+		if len(build.Logs) > maxImageBuildLogLines {
+			build.Logs = build.Logs[len(build.Logs)-maxImageBuildLogLines:]
+		}
+		snapshot := *build
+		snapshot.Logs = nil
+		listener := s.listener
+		s.mu.Unlock()
+
+		if listener != nil {
+			listener.OnImageBuildLog(ownerID, snapshot, line)
+		}
+	})
+
+	if err == nil {
+		// 빌드하는 동안 설정이 바뀌었거나 삭제되었으면 결과를 저장하지 않음
+		s.mu.Lock()
+		current := s.builds[workspaceID] == build
+		var stored *storedWorkspaceImage
+		if current {
+			stored, err = s.load(workspaceID)
+			if err == nil && stored != nil && stored.Spec.Dockerfile == req.Dockerfile {
+				stored.Image = result.Tag
+				err = s.save(workspaceID, stored)
+			}
+		}
+		s.mu.Unlock()
+	}
+
+	now := time.Now()
+	s.updateBuild(ownerID, build, func(b *ImageBuild) {
+		b.FinishedAt = &now
+		if err != nil {
+			b.Status = ImageBuildFailed
+			b.Error = err.Error()
+			return
+		}
+		b.Status = ImageBuildSucceeded
+		b.Tag = result.Tag
+		b.Cached = result.Cached
+	})
+	if err != nil {
+		log.Printf("워크스페이스 이미지 빌드 실패 (워크스페이스: %s): %v", workspaceID, err)
+	}
+}
+
+// updateBuild 빌드 기록을 갱신하고 리스너에 상태를 알립니다.
+func (s *WorkspaceImageService) updateBuild(ownerID string, build *ImageBuild, update func(*ImageBuild)) {
+	s.mu.Lock()
+	update(build)
+	snapshot := *build
+	snapshot.Logs = nil
+	listener := s.listener
+	s.mu.Unlock()
+
+	if listener != nil {
+		listener.OnImageBuildStatus(ownerID, snapshot)
+	}
+}
+
+func isBuildActive(build *ImageBuild) bool {
+	return build.Status == ImageBuildPending || build.Status == ImageBuildRunning
+}
+
+// policyError 정책 위반을 요청 오류로 변환
+func policyError(err error) error {
+	var violation *docker.PolicyViolationError
+	if errors.As(err, &violation) {
+		return NewWorkspaceError(ErrCodeInvalidRequest, strings.Join(violation.Violations, "; "), err)
+	}
+	return err
+}
+
+// path 워크스페이스 이미지 설정 파일 경로
+func (s *WorkspaceImageService) path(workspaceID string) (string, error) {
+	if workspaceID == "" || strings.ContainsAny(workspaceID, `/\`) || strings.HasPrefix(workspaceID, ".") {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 워크스페이스 ID입니다", nil)
+	}
+	return filepath.Join(s.dir, workspaceID+".json"), nil
+}
+
+// load 워크스페이스 이미지 설정 로드 (없으면 nil)
+func (s *WorkspaceImageService) load(workspaceID string) (*storedWorkspaceImage, error) {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("워크스페이스 이미지 설정 읽기 실패: %w", err)
+	}
+
+	var stored storedWorkspaceImage
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("워크스페이스 이미지 설정 파싱 실패: %w", err)
+	}
+	return &stored, nil
+}
+
+// save 워크스페이스 이미지 설정 저장 (빌드 인자에 시크릿이 포함될 수 있어 0600)
+func (s *WorkspaceImageService) save(workspaceID string, stored *storedWorkspaceImage) error {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return fmt.Errorf("워크스페이스 이미지 설정 디렉토리 생성 실패: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/docker"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// fakeImageBuilder 빌드 요청을 기록하고 고정된 로그를 내보내는 빌더
+type fakeImageBuilder struct {
+	mu       sync.Mutex
+	requests []docker.ImageBuildRequest
+	err      error
+}
+
+func (f *fakeImageBuilder) Build(ctx context.Context, req docker.ImageBuildRequest, logFn func(line string)) (*docker.ImageBuildResult, error) {
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	f.mu.Unlock()
+
+	logFn("Step 1/1 : FROM node:20")
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &docker.ImageBuildResult{Tag: "aicli-workspace-" + req.WorkspaceID + ":abc"}, nil
+}
+
+// recordingBuildListener 전달된 빌드 로그와 상태를 기록하는 리스너
+type recordingBuildListener struct {
+	mu       sync.Mutex
+	lines    []string
+	statuses []ImageBuildStatus
+}
+
+func (l *recordingBuildListener) OnImageBuildLog(ownerID string, build ImageBuild, line string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, ownerID+": "+line)
+}
+
+func (l *recordingBuildListener) OnImageBuildStatus(ownerID string, build ImageBuild) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.statuses = append(l.statuses, build.Status)
+}
+
+func newTestImageService(t *testing.T, builder WorkspaceImageBuilder) *WorkspaceImageService {
+	t.Helper()
+	policy := docker.ImagePolicy{AllowedImages: []string{"node:20*"}, AllowDockerfile: true}
+	return NewWorkspaceImageService(t.TempDir(), policy, builder, 0)
+}
+
+func TestWorkspaceImageService_Image(t *testing.T) {
+	svc := newTestImageService(t, nil)
+	ctx := context.Background()
+	workspace := &models.Workspace{ID: "ws-1", OwnerID: "user-1", ProjectPath: t.TempDir()}
+
+	resolved, err := svc.Resolve(workspace)
+	require.NoError(t, err)
+	assert.Nil(t, resolved)
+
+	_, err = svc.Put(ctx, workspace, docker.WorkspaceImageSpec{Image: "ubuntu"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	// Docker가 없으면 Dockerfile 빌드 불가
+	_, err = svc.Put(ctx, workspace, docker.WorkspaceImageSpec{Dockerfile: "FROM node:20"})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	saved, err := svc.Put(ctx, workspace, docker.WorkspaceImageSpec{Image: "node:20-alpine"})
+	require.NoError(t, err)
+	assert.Equal(t, "node:20-alpine", saved.Image)
+
+	resolved, err = svc.Resolve(workspace)
+	require.NoError(t, err)
+	assert.Equal(t, "node:20-alpine", resolved.Image)
+
+	// 정책이 바뀌면 저장된 설정도 거부
+	svc.policy.AllowedImages = []string{"node:22"}
+	_, err = svc.Resolve(workspace)
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+
+	require.NoError(t, svc.Delete(ctx, workspace.ID))
+	_, err = svc.Get(ctx, workspace.ID)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
+
+func TestWorkspaceImageService_DockerfileBuild(t *testing.T) {
+	builder := &fakeImageBuilder{}
+	listener := &recordingBuildListener{}
+	svc := newTestImageService(t, builder)
+	svc.SetListener(listener)
+	ctx := context.Background()
+	workspace := &models.Workspace{ID: "ws-1", OwnerID: "user-1", ProjectPath: t.TempDir()}
+	spec := docker.WorkspaceImageSpec{Dockerfile: "FROM node:20\nRUN npm i -g pnpm", BuildArgs: map[string]string{"NODE_ENV": "dev"}}
+
+	saved, err := svc.Put(ctx, workspace, spec)
+	require.NoError(t, err)
+	require.NotNil(t, saved.Build)
+	assert.Equal(t, ImageBuildPending, saved.Build.Status)
+
+	// 빌드 완료 대기
+	svc.Wait()
+
+	build := svc.LatestBuild(workspace.ID)
+	require.NotNil(t, build)
+	assert.Equal(t, ImageBuildSucceeded, build.Status)
+	assert.Equal(t, "aicli-workspace-ws-1:abc", build.Tag)
+	assert.Equal(t, []string{"Step 1/1 : FROM node:20"}, build.Logs)
+	assert.Equal(t, []string{"user-1: Step 1/1 : FROM node:20"}, listener.lines)
+	assert.Equal(t, []ImageBuildStatus{ImageBuildRunning, ImageBuildSucceeded}, listener.statuses)
+
+	resolved, err := svc.Resolve(workspace)
+	require.NoError(t, err)
+	assert.Equal(t, "aicli-workspace-ws-1:abc", resolved.Image)
+
+	_, err = svc.Rebuild(ctx, workspace, true)
+	require.NoError(t, err)
+	svc.Wait()
+	require.Len(t, builder.requests, 2)
+	assert.True(t, builder.requests[1].NoCache)
+	assert.Equal(t, "dev", builder.requests[1].BuildArgs["NODE_ENV"])
+}
+
+func TestWorkspaceImageService_BuildFailure(t *testing.T) {
+	svc := newTestImageService(t, &fakeImageBuilder{err: errors.New("build failed: exit 1")})
+	ctx := context.Background()
+	workspace := &models.Workspace{ID: "ws-1", OwnerID: "user-1", ProjectPath: t.TempDir()}
+
+	_, err := svc.Put(ctx, workspace, docker.WorkspaceImageSpec{Dockerfile: "FROM node:20"})
+	require.NoError(t, err)
+	svc.Wait()
+
+	build := svc.LatestBuild(workspace.ID)
+	require.NotNil(t, build)
+	assert.Equal(t, ImageBuildFailed, build.Status)
+	assert.Equal(t, "build failed: exit 1", build.Error)
+
+	_, err = svc.Resolve(workspace)
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+}
+
+func assertWorkspaceErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var workspaceErr *WorkspaceError
+	require.ErrorAs(t, err, &workspaceErr)
+	assert.Equal(t, code, workspaceErr.Code)
+}
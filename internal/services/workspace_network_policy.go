@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/aicli/aicli-web/internal/docker/egress"
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// maxAllowedDomains 워크스페이스별 허용 도메인 최대 수
+const maxAllowedDomains = 100
+
+// NetworkPolicyService 워크스페이스별 네트워크 이그레스 정책을 관리하는 서비스
+// 정책은 "<dir>/<workspaceID>.json" 파일에 저장되며, 지정하지 않은 워크스페이스는 기본 정책을 따릅니다.
+// 바뀐 정책은 컨테이너를 다시 만들 때 적용됩니다.
+type NetworkPolicyService struct {
+	dir           string
+	defaultPolicy models.NetworkPolicy
+
+	mu sync.RWMutex
+}
+
+// NewNetworkPolicyService 새 네트워크 정책 서비스 생성
+func NewNetworkPolicyService(dir string, defaultPolicy models.NetworkPolicy) *NetworkPolicyService {
+	if !defaultPolicy.Mode.IsValid() {
+		defaultPolicy.Mode = models.NetworkEgressFull
+	}
+	return &NetworkPolicyService{
+		dir:           dir,
+		defaultPolicy: defaultPolicy,
+	}
+}
+
+// DefaultPolicy 정책을 지정하지 않은 워크스페이스에 적용되는 정책
+func (s *NetworkPolicyService) DefaultPolicy() models.NetworkPolicy {
+	return copyNetworkPolicy(s.defaultPolicy)
+}
+
+// Effective 워크스페이스에 적용되는 정책 (지정하지 않았으면 기본 정책)
+func (s *NetworkPolicyService) Effective(ctx context.Context, workspaceID string) (*models.NetworkPolicy, error) {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			policy := s.DefaultPolicy()
+			return &policy, nil
+		}
+		return nil, fmt.Errorf("네트워크 정책 읽기 실패: %w", err)
+	}
+
+	var policy models.NetworkPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("네트워크 정책 파싱 실패: %w", err)
+	}
+	return &policy, nil
+}
+
+// Put 워크스페이스 네트워크 정책 저장
+func (s *NetworkPolicyService) Put(ctx context.Context, workspaceID string, policy models.NetworkPolicy) (*models.NetworkPolicy, error) {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeNetworkPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(normalized, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return nil, fmt.Errorf("네트워크 정책 디렉토리 생성 실패: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return nil, err
+	}
+	return &normalized, nil
+}
+
+// Delete 워크스페이스 네트워크 정책 삭제 (이후 기본 정책 적용)
+func (s *NetworkPolicyService) Delete(ctx context.Context, workspaceID string) error {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path 워크스페이스 네트워크 정책 파일 경로
+func (s *NetworkPolicyService) path(workspaceID string) (string, error) {
+	if workspaceID == "" || strings.ContainsAny(workspaceID, `/\`) || strings.HasPrefix(workspaceID, ".") {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 워크스페이스 ID입니다", nil)
+	}
+	return filepath.Join(s.dir, workspaceID+".json"), nil
+}
+
+// normalizeNetworkPolicy 정책을 검증하고 허용 도메인을 정리합니다.
+func normalizeNetworkPolicy(policy models.NetworkPolicy) (models.NetworkPolicy, error) {
+	if !policy.Mode.IsValid() {
+		return policy, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("지원하지 않는 네트워크 모드입니다: %q (full, offline, allowlist)", policy.Mode), nil)
+	}
+	if policy.Mode != models.NetworkEgressAllowlist {
+		if len(policy.AllowedDomains) > 0 {
+			return policy, NewWorkspaceError(ErrCodeInvalidRequest, "allowed_domains는 allowlist 모드에서만 사용할 수 있습니다", nil)
+		}
+		return models.NetworkPolicy{Mode: policy.Mode}, nil
+	}
+
+	if len(policy.AllowedDomains) == 0 {
+		return policy, NewWorkspaceError(ErrCodeInvalidRequest, "allowlist 모드에는 허용 도메인이 하나 이상 필요합니다", nil)
+	}
+	if len(policy.AllowedDomains) > maxAllowedDomains {
+		return policy, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("허용 도메인은 최대 %d개입니다", maxAllowedDomains), nil)
+	}
+
+	seen := make(map[string]bool, len(policy.AllowedDomains))
+	domains := make([]string, 0, len(policy.AllowedDomains))
+	for _, domain := range policy.AllowedDomains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if err := egress.ValidateDomain(domain); err != nil {
+			return policy, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("올바르지 않은 도메인입니다: %q", domain), err)
+		}
+		if !seen[domain] {
+			seen[domain] = true
+			domains = append(domains, domain)
+		}
+	}
+	return models.NetworkPolicy{Mode: policy.Mode, AllowedDomains: domains}, nil
+}
+
+func copyNetworkPolicy(policy models.NetworkPolicy) models.NetworkPolicy {
+	policy.AllowedDomains = append([]string(nil), policy.AllowedDomains...)
+	return policy
+}
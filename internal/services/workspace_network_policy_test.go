@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+func TestNetworkPolicyService_Effective(t *testing.T) {
+	svc := NewNetworkPolicyService(t.TempDir(), models.NetworkPolicy{Mode: models.NetworkEgressOffline})
+	ctx := context.Background()
+
+	// 지정하지 않으면 기본 정책
+	policy, err := svc.Effective(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.NetworkEgressOffline, policy.Mode)
+
+	saved, err := svc.Put(ctx, "ws-1", models.NetworkPolicy{
+		Mode:           models.NetworkEgressAllowlist,
+		AllowedDomains: []string{" GitHub.com", "*.npmjs.org", "github.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"github.com", "*.npmjs.org"}, saved.AllowedDomains)
+
+	policy, err = svc.Effective(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, saved, policy)
+
+	// 다른 워크스페이스와 분리
+	policy, err = svc.Effective(ctx, "ws-2")
+	require.NoError(t, err)
+	assert.Equal(t, models.NetworkEgressOffline, policy.Mode)
+
+	require.NoError(t, svc.Delete(ctx, "ws-1"))
+	policy, err = svc.Effective(ctx, "ws-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.NetworkEgressOffline, policy.Mode)
+}
+
+func TestNetworkPolicyService_Validation(t *testing.T) {
+	svc := NewNetworkPolicyService(t.TempDir(), models.NetworkPolicy{})
+	ctx := context.Background()
+	assert.Equal(t, models.NetworkEgressFull, svc.DefaultPolicy().Mode)
+
+	tests := []struct {
+		name   string
+		policy models.NetworkPolicy
+	}{
+		{"unknown mode", models.NetworkPolicy{Mode: "vpn"}},
+		{"allowlist without domains", models.NetworkPolicy{Mode: models.NetworkEgressAllowlist}},
+		{"domains with full", models.NetworkPolicy{Mode: models.NetworkEgressFull, AllowedDomains: []string{"github.com"}}},
+		{"invalid domain", models.NetworkPolicy{Mode: models.NetworkEgressAllowlist, AllowedDomains: []string{"https://github.com"}}},
+		{"middle wildcard", models.NetworkPolicy{Mode: models.NetworkEgressAllowlist, AllowedDomains: []string{"git*.com"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Put(ctx, "ws-1", tt.policy)
+			assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+		})
+	}
+
+	_, err := svc.Put(ctx, "../escape", models.NetworkPolicy{Mode: models.NetworkEgressOffline})
+	assertWorkspaceErrorCode(t, err, ErrCodeInvalidRequest)
+}
@@ -0,0 +1,642 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// workspaceSnapshotManifest 스냅샷 정보 파일 이름
+	workspaceSnapshotManifest = "snapshot.json"
+	// workspaceSnapshotTree 스냅샷한 워크스페이스 파일을 담는 디렉토리 이름
+	workspaceSnapshotTree = "tree"
+	// workspaceSnapshotTempPrefix 만드는 중인 스냅샷 디렉토리 접두사 (목록에서 제외)
+	workspaceSnapshotTempPrefix = ".tmp-"
+	// workspaceSnapshotLabelCommand 태스크 자동 스냅샷 설명에 넣을 명령 최대 길이
+	workspaceSnapshotLabelCommand = 80
+)
+
+// WorkspaceSnapshotConfig 워크스페이스 스냅샷 서비스 설정
+type WorkspaceSnapshotConfig struct {
+	// Dir 스냅샷 저장 디렉토리 ("<Dir>/<workspaceID>/<snapshotID>")
+	Dir string
+	// MaxTotalBytes 전체 스냅샷 디스크 사용량 상한 (0이면 무제한, 하드 링크로 공유한 파일은 한 번만 셈)
+	MaxTotalBytes int64
+	// MaxPerWorkspace 워크스페이스별 최대 스냅샷 수 (0이면 무제한)
+	MaxPerWorkspace int
+	// MaxAge 스냅샷 보관 기간 (0이면 무제한)
+	MaxAge time.Duration
+}
+
+// WorkspaceSnapshotService Git과 무관하게 워크스페이스 디렉토리의 특정 시점 복사본을 만들고 복원하는 서비스
+// 바뀌지 않은 파일(크기, 수정 시각, 모드가 같은 파일)은 직전 스냅샷과 하드 링크로 공유하고,
+// 나머지는 reflink를 지원하는 파일 시스템이면 reflink로, 아니면 내용을 복사합니다.
+// 스냅샷 파일은 여러 스냅샷이 공유하므로 워크스페이스로 링크하지 않고 복원할 때도 복사합니다.
+// 스냅샷을 만들 때마다 보관 개수, 기간, 디스크 사용량 상한을 넘는 오래된 스냅샷을 정리합니다.
+type WorkspaceSnapshotService struct {
+	storage storage.Storage
+	config  WorkspaceSnapshotConfig
+
+	// mu 스냅샷 생성, 복원, 삭제, 정리를 직렬화 (정리 중 링크 원본이 사라지지 않도록)
+	mu sync.Mutex
+}
+
+// NewWorkspaceSnapshotService 새 워크스페이스 스냅샷 서비스 생성
+func NewWorkspaceSnapshotService(storage storage.Storage, config WorkspaceSnapshotConfig) *WorkspaceSnapshotService {
+	return &WorkspaceSnapshotService{
+		storage: storage,
+		config:  config,
+	}
+}
+
+// Create 워크스페이스 디렉토리의 현재 상태로 스냅샷 생성
+func (s *WorkspaceSnapshotService) Create(ctx context.Context, workspaceID, label string) (*models.WorkspaceSnapshot, error) {
+	return s.create(ctx, workspaceID, "", strings.TrimSpace(label))
+}
+
+// SnapshotBeforeTask 태스크 실행 직전에 워크스페이스 스냅샷 생성 (TaskSnapshotter)
+func (s *WorkspaceSnapshotService) SnapshotBeforeTask(ctx context.Context, workspaceID string, task *models.Task) (*models.WorkspaceSnapshot, error) {
+	command := task.Command
+	if runes := []rune(command); len(runes) > workspaceSnapshotLabelCommand {
+		command = string(runes[:workspaceSnapshotLabelCommand]) + "…"
+	}
+	return s.create(ctx, workspaceID, task.ID, "태스크 실행 전: "+command)
+}
+
+// create 스냅샷을 만들고 보관 정책에 따라 오래된 스냅샷 정리
+func (s *WorkspaceSnapshotService) create(ctx context.Context, workspaceID, taskID, label string) (*models.WorkspaceSnapshot, error) {
+	workspace, root, err := s.workspaceRoot(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot, err := s.capture(ctx, workspace.ID, root, taskID, label)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.collectLocked(map[string]bool{snapshot.ID: true}); err != nil {
+		log.Printf("워크스페이스 스냅샷 정리 실패: %v", err)
+	}
+	return snapshot, nil
+}
+
+// List 워크스페이스 스냅샷 목록 (최신순)
+func (s *WorkspaceSnapshotService) List(ctx context.Context, workspaceID string) ([]*models.WorkspaceSnapshot, error) {
+	dir, err := s.workspaceDir(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := readWorkspaceSnapshots(dir)
+	if err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "스냅샷 목록 조회 실패", err)
+	}
+	return snapshots, nil
+}
+
+// Get 워크스페이스 스냅샷 조회
+func (s *WorkspaceSnapshotService) Get(ctx context.Context, workspaceID, snapshotID string) (*models.WorkspaceSnapshot, error) {
+	path, err := s.snapshotPath(workspaceID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := readWorkspaceSnapshot(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, NewWorkspaceError(ErrCodeNotFound, "스냅샷을 찾을 수 없습니다", err)
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "스냅샷 조회 실패", err)
+	}
+	if snapshot.WorkspaceID != workspaceID {
+		return nil, NewWorkspaceError(ErrCodeNotFound, "스냅샷을 찾을 수 없습니다", nil)
+	}
+	return snapshot, nil
+}
+
+// Restore 워크스페이스 디렉토리를 스냅샷 상태로 되돌림
+// 복원 전 상태를 자동 스냅샷으로 남기므로 복원도 되돌릴 수 있습니다.
+// 스냅샷에 없는 파일은 삭제하고, 내용이 다른 파일은 스냅샷에서 다시 복사합니다.
+func (s *WorkspaceSnapshotService) Restore(ctx context.Context, workspaceID, snapshotID string) (*models.WorkspaceSnapshotRestoreResult, error) {
+	workspace, root, err := s.workspaceRoot(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot, err := s.Get(ctx, workspace.ID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	path, _ := s.snapshotPath(workspace.ID, snapshot.ID)
+	tree := filepath.Join(path, workspaceSnapshotTree)
+
+	backup, err := s.capture(ctx, workspace.ID, root, "", "복원 전 자동 스냅샷 ("+snapshot.ID+")")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.WorkspaceSnapshotRestoreResult{Snapshot: snapshot, Backup: backup}
+	if result.Removed, err = pruneSnapshotExtras(ctx, root, tree); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "스냅샷에 없는 파일 삭제 실패", err)
+	}
+	if result.Restored, err = restoreSnapshotTree(ctx, tree, root); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "스냅샷 파일 복원 실패", err)
+	}
+
+	if _, err := s.collectLocked(map[string]bool{snapshot.ID: true, backup.ID: true}); err != nil {
+		log.Printf("워크스페이스 스냅샷 정리 실패: %v", err)
+	}
+	log.Printf("워크스페이스 스냅샷 복원: %s <- %s (파일 %d개 복원, %d개 삭제)", workspace.ID, snapshot.ID, result.Restored, result.Removed)
+	return result, nil
+}
+
+// Delete 워크스페이스 스냅샷 삭제
+func (s *WorkspaceSnapshotService) Delete(ctx context.Context, workspaceID, snapshotID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.Get(ctx, workspaceID, snapshotID); err != nil {
+		return err
+	}
+	path, _ := s.snapshotPath(workspaceID, snapshotID)
+	if err := os.RemoveAll(path); err != nil {
+		return NewWorkspaceError(ErrCodeInternal, "스냅샷 삭제 실패", err)
+	}
+	return nil
+}
+
+// Collect 보관 개수, 기간, 디스크 사용량 상한을 넘는 오래된 스냅샷 정리 (삭제한 스냅샷 수 반환)
+func (s *WorkspaceSnapshotService) Collect(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.collectLocked(nil)
+}
+
+// collectLocked 오래된 스냅샷 정리 (keep의 스냅샷은 남김, mu를 잡은 상태에서 호출)
+func (s *WorkspaceSnapshotService) collectLocked(keep map[string]bool) (int, error) {
+	entries, err := os.ReadDir(s.config.Dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var (
+		remaining []*models.WorkspaceSnapshot
+		removed   int
+	)
+	remove := func(snapshot *models.WorkspaceSnapshot) error {
+		if err := os.RemoveAll(filepath.Join(s.config.Dir, snapshot.WorkspaceID, snapshot.ID)); err != nil {
+			return err
+		}
+		removed++
+		return nil
+	}
+
+	// 워크스페이스별 개수와 기간 제한
+	cutoff := time.Time{}
+	if s.config.MaxAge > 0 {
+		cutoff = time.Now().Add(-s.config.MaxAge)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		snapshots, err := readWorkspaceSnapshots(filepath.Join(s.config.Dir, entry.Name()))
+		if err != nil {
+			return removed, err
+		}
+		for i, snapshot := range snapshots {
+			expired := (s.config.MaxPerWorkspace > 0 && i >= s.config.MaxPerWorkspace) ||
+				(!cutoff.IsZero() && snapshot.CreatedAt.Before(cutoff))
+			if expired && !keep[snapshot.ID] {
+				if err := remove(snapshot); err != nil {
+					return removed, err
+				}
+				continue
+			}
+			remaining = append(remaining, snapshot)
+		}
+	}
+
+	if s.config.MaxTotalBytes <= 0 {
+		return removed, nil
+	}
+
+	// 디스크 사용량 상한: 전체에서 가장 오래된 스냅샷부터 삭제
+	usage, err := newSnapshotUsage(s.config.Dir, remaining)
+	if err != nil {
+		return removed, err
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return remaining[i].CreatedAt.Before(remaining[j].CreatedAt)
+	})
+	for _, snapshot := range remaining {
+		if usage.total <= s.config.MaxTotalBytes {
+			break
+		}
+		if keep[snapshot.ID] {
+			continue
+		}
+		if err := remove(snapshot); err != nil {
+			return removed, err
+		}
+		usage.release(snapshot.ID)
+	}
+	if usage.total > s.config.MaxTotalBytes {
+		log.Printf("워크스페이스 스냅샷 디스크 사용량이 상한을 넘었습니다: %d > %d 바이트", usage.total, s.config.MaxTotalBytes)
+	}
+	return removed, nil
+}
+
+// capture 워크스페이스 디렉토리를 새 스냅샷으로 복사 (mu를 잡은 상태에서 호출)
+func (s *WorkspaceSnapshotService) capture(ctx context.Context, workspaceID, root, taskID, label string) (*models.WorkspaceSnapshot, error) {
+	dir, err := s.workspaceDir(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 직전 스냅샷의 파일과 하드 링크로 공유
+	var previous string
+	if snapshots, err := readWorkspaceSnapshots(dir); err == nil && len(snapshots) > 0 {
+		previous = filepath.Join(dir, snapshots[0].ID, workspaceSnapshotTree)
+	}
+
+	snapshot := &models.WorkspaceSnapshot{
+		ID:          uuid.New().String(),
+		WorkspaceID: workspaceID,
+		TaskID:      taskID,
+		Label:       label,
+		CreatedAt:   time.Now().UTC(),
+	}
+	tmp := filepath.Join(dir, workspaceSnapshotTempPrefix+snapshot.ID)
+	tree := filepath.Join(tmp, workspaceSnapshotTree)
+	if err := os.MkdirAll(tree, 0700); err != nil {
+		return nil, NewWorkspaceError(ErrCodeInternal, "스냅샷 디렉토리 생성 실패", err)
+	}
+
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(tree, rel)
+
+		switch {
+		case d.IsDir():
+			return os.Mkdir(target, info.Mode().Perm()|0700)
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case d.Type().IsRegular():
+			snapshot.Files++
+			snapshot.Bytes += info.Size()
+			if previous != "" && linkUnchangedFile(filepath.Join(previous, rel), target, info) {
+				snapshot.LinkedFiles++
+				return nil
+			}
+			cloned, err := copySnapshotFile(path, target, info)
+			if err != nil {
+				return err
+			}
+			if cloned {
+				snapshot.ClonedFiles++
+			} else {
+				snapshot.CopiedFiles++
+				snapshot.CopiedBytes += info.Size()
+			}
+		}
+		// 소켓, 장치 파일 등은 스냅샷하지 않음
+		return nil
+	})
+	if err == nil {
+		err = writeWorkspaceSnapshot(tmp, snapshot)
+	}
+	if err == nil {
+		err = os.Rename(tmp, filepath.Join(dir, snapshot.ID))
+	}
+	if err != nil {
+		_ = os.RemoveAll(tmp)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, NewWorkspaceError(ErrCodeInternal, "워크스페이스 스냅샷 생성 실패", err)
+	}
+
+	log.Printf("워크스페이스 스냅샷 생성: %s/%s (파일 %d개, 링크 %d개, reflink %d개, 복사 %d바이트)",
+		workspaceID, snapshot.ID, snapshot.Files, snapshot.LinkedFiles, snapshot.ClonedFiles, snapshot.CopiedBytes)
+	return snapshot, nil
+}
+
+// workspaceRoot 워크스페이스와 스냅샷할 디렉토리 조회
+func (s *WorkspaceSnapshotService) workspaceRoot(ctx context.Context, workspaceID string) (*models.Workspace, string, error) {
+	workspace, err := s.storage.Workspace().GetByID(ctx, workspaceID)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, "", NewWorkspaceError(ErrCodeNotFound, "워크스페이스를 찾을 수 없습니다", ErrWorkspaceNotFound)
+		}
+		return nil, "", NewWorkspaceError(ErrCodeInternal, "워크스페이스 조회 실패", err)
+	}
+	if info, err := os.Stat(workspace.ProjectPath); err != nil || !info.IsDir() {
+		return nil, "", NewWorkspaceError(ErrCodeInvalidPath, "워크스페이스 디렉토리를 찾을 수 없습니다", ErrInvalidProjectPath)
+	}
+	return workspace, workspace.ProjectPath, nil
+}
+
+// workspaceDir 워크스페이스 스냅샷 디렉토리 경로
+func (s *WorkspaceSnapshotService) workspaceDir(workspaceID string) (string, error) {
+	if !validSnapshotName(workspaceID) {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 워크스페이스 ID입니다", nil)
+	}
+	return filepath.Join(s.config.Dir, workspaceID), nil
+}
+
+// snapshotPath 스냅샷 디렉토리 경로
+func (s *WorkspaceSnapshotService) snapshotPath(workspaceID, snapshotID string) (string, error) {
+	dir, err := s.workspaceDir(workspaceID)
+	if err != nil {
+		return "", err
+	}
+	if !validSnapshotName(snapshotID) {
+		return "", NewWorkspaceError(ErrCodeNotFound, "스냅샷을 찾을 수 없습니다", nil)
+	}
+	return filepath.Join(dir, snapshotID), nil
+}
+
+// validSnapshotName 경로 한 단계로 쓸 수 있는 이름인지 확인
+func validSnapshotName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, `/\`) && !strings.HasPrefix(name, ".")
+}
+
+// readWorkspaceSnapshots 워크스페이스 스냅샷 디렉토리의 스냅샷 목록 (최신순, 디렉토리가 없으면 빈 목록)
+func readWorkspaceSnapshots(dir string) ([]*models.WorkspaceSnapshot, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []*models.WorkspaceSnapshot{}, nil
+		}
+		return nil, err
+	}
+
+	snapshots := make([]*models.WorkspaceSnapshot, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		snapshot, err := readWorkspaceSnapshot(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			log.Printf("워크스페이스 스냅샷 정보 읽기 실패: %s: %v", entry.Name(), err)
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+	})
+	return snapshots, nil
+}
+
+// readWorkspaceSnapshot 스냅샷 정보 파일 읽기
+func readWorkspaceSnapshot(path string) (*models.WorkspaceSnapshot, error) {
+	data, err := os.ReadFile(filepath.Join(path, workspaceSnapshotManifest))
+	if err != nil {
+		return nil, err
+	}
+	var snapshot models.WorkspaceSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("스냅샷 정보 파싱 실패: %w", err)
+	}
+	return &snapshot, nil
+}
+
+// writeWorkspaceSnapshot 스냅샷 정보 파일 쓰기
+func writeWorkspaceSnapshot(path string, snapshot *models.WorkspaceSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(path, workspaceSnapshotManifest), data, 0600)
+}
+
+// sameSnapshotFile 크기, 수정 시각, 모드가 같으면 내용이 같은 파일로 봄
+func sameSnapshotFile(a, b fs.FileInfo) bool {
+	return a.Mode() == b.Mode() && a.Size() == b.Size() && a.ModTime().Equal(b.ModTime())
+}
+
+// linkUnchangedFile 직전 스냅샷의 파일이 바뀌지 않았으면 하드 링크로 공유 (공유했으면 true)
+func linkUnchangedFile(previous, target string, info fs.FileInfo) bool {
+	prev, err := os.Lstat(previous)
+	if err != nil || !prev.Mode().IsRegular() || !sameSnapshotFile(info, prev) {
+		return false
+	}
+	return os.Link(previous, target) == nil
+}
+
+// copySnapshotFile 파일을 reflink 또는 내용 복사로 복제하고 모드와 수정 시각을 맞춤 (reflink면 true)
+func copySnapshotFile(src, dst string, info fs.FileInfo) (bool, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return false, err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return false, err
+	}
+	cloned := reflinkFile(out, in) == nil
+	if !cloned {
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return false, err
+		}
+	}
+	if err := out.Close(); err != nil {
+		return false, err
+	}
+	if err := os.Chmod(dst, info.Mode().Perm()); err != nil {
+		return false, err
+	}
+	return cloned, os.Chtimes(dst, info.ModTime(), info.ModTime())
+}
+
+// pruneSnapshotExtras 워크스페이스에서 스냅샷에 없거나 종류(파일, 디렉토리, 심볼릭 링크)가 다른 항목 삭제
+func pruneSnapshotExtras(ctx context.Context, root, tree string) (int, error) {
+	removed := 0
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		if kept, err := os.Lstat(filepath.Join(tree, rel)); err == nil && kept.Mode().Type() == d.Type() {
+			return nil
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+		removed++
+		if d.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return removed, err
+}
+
+// restoreSnapshotTree 스냅샷 파일을 워크스페이스로 복사 (내용이 같은 파일은 건너뜀, 링크하지 않음)
+func restoreSnapshotTree(ctx context.Context, tree, root string) (int, error) {
+	restored := 0
+	err := filepath.WalkDir(tree, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(tree, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(root, rel)
+		current, statErr := os.Lstat(target)
+
+		switch {
+		case d.IsDir():
+			if statErr == nil {
+				return os.Chmod(target, info.Mode().Perm())
+			}
+			return os.Mkdir(target, info.Mode().Perm())
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			if statErr == nil {
+				if existing, err := os.Readlink(target); err == nil && existing == link {
+					return nil
+				}
+				if err := os.Remove(target); err != nil {
+					return err
+				}
+			}
+			restored++
+			return os.Symlink(link, target)
+		case d.Type().IsRegular():
+			if statErr == nil {
+				if sameSnapshotFile(info, current) {
+					return nil
+				}
+				if err := os.Remove(target); err != nil {
+					return err
+				}
+			}
+			restored++
+			_, err := copySnapshotFile(path, target, info)
+			return err
+		}
+		return nil
+	})
+	return restored, err
+}
+
+// snapshotUsage 스냅샷 디스크 사용량 (하드 링크로 공유한 파일은 한 번만 셈)
+type snapshotUsage struct {
+	total int64
+	sizes map[string]int64
+	refs  map[string]int
+	files map[string][]string
+}
+
+// newSnapshotUsage 스냅샷들의 파일을 훑어 디스크 사용량 계산
+func newSnapshotUsage(dir string, snapshots []*models.WorkspaceSnapshot) (*snapshotUsage, error) {
+	usage := &snapshotUsage{
+		sizes: make(map[string]int64),
+		refs:  make(map[string]int),
+		files: make(map[string][]string, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		tree := filepath.Join(dir, snapshot.WorkspaceID, snapshot.ID, workspaceSnapshotTree)
+		err := filepath.WalkDir(tree, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			key, size := diskUsageKey(path, info)
+			if usage.refs[key] == 0 {
+				usage.sizes[key] = size
+				usage.total += size
+			}
+			usage.refs[key]++
+			usage.files[snapshot.ID] = append(usage.files[snapshot.ID], key)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return usage, nil
+}
+
+// release 삭제한 스냅샷의 파일 참조를 빼고 더 이상 공유되지 않는 파일 크기를 사용량에서 뺌
+func (u *snapshotUsage) release(snapshotID string) {
+	for _, key := range u.files[snapshotID] {
+		u.refs[key]--
+		if u.refs[key] == 0 {
+			u.total -= u.sizes[key]
+		}
+	}
+	delete(u.files, snapshotID)
+}
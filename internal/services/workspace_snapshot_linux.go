@@ -0,0 +1,28 @@
+//go:build linux
+
+package services
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// ficloneRequest FICLONE ioctl 요청 번호 (linux/fs.h)
+const ficloneRequest = 0x40049409
+
+// reflinkFile dst가 src와 데이터 블록을 공유하도록 복제합니다 (btrfs, XFS 등 reflink를 지원하는 파일 시스템만)
+func reflinkFile(dst, src *os.File) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, dst.Fd(), ficloneRequest, src.Fd()); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// diskUsageKey 하드 링크로 공유한 파일을 한 번만 세기 위한 식별자(장치:아이노드)와 실제 디스크 사용량
+func diskUsageKey(path string, info os.FileInfo) (string, int64) {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return fmt.Sprintf("%d:%d", stat.Dev, stat.Ino), stat.Blocks * 512
+	}
+	return path, info.Size()
+}
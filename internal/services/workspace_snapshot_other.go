@@ -0,0 +1,18 @@
+//go:build !linux
+
+package services
+
+import (
+	"errors"
+	"os"
+)
+
+// reflinkFile 이 플랫폼에서는 지원하지 않음 (일반 복사로 대체)
+func reflinkFile(dst, src *os.File) error {
+	return errors.ErrUnsupported
+}
+
+// diskUsageKey 아이노드를 알 수 없으므로 파일마다 따로 셈 (하드 링크 공유분도 중복 계산)
+func diskUsageKey(path string, info os.FileInfo) (string, int64) {
+	return path, info.Size()
+}
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func newSnapshotFixture(t *testing.T, config WorkspaceSnapshotConfig) (*WorkspaceSnapshotService, *models.Workspace, string) {
+	store := memory.New()
+	root := t.TempDir()
+	ws := &models.Workspace{Name: "snap", OwnerID: "alice", ProjectPath: root, Status: models.WorkspaceStatusActive}
+	require.NoError(t, store.Workspace().Create(context.Background(), ws))
+
+	config.Dir = t.TempDir()
+	return NewWorkspaceSnapshotService(store, config), ws, root
+}
+
+func writeSnapshotFile(t *testing.T, root, rel, content string) {
+	path := filepath.Join(root, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestWorkspaceSnapshotService_CreateAndRestore(t *testing.T) {
+	service, ws, root := newSnapshotFixture(t, WorkspaceSnapshotConfig{})
+	ctx := context.Background()
+
+	writeSnapshotFile(t, root, "main.go", "package main\n")
+	writeSnapshotFile(t, root, "docs/readme.md", "v1")
+	require.NoError(t, os.Symlink("main.go", filepath.Join(root, "link.go")))
+
+	first, err := service.Create(ctx, ws.ID, " 리팩터링 전 ")
+	require.NoError(t, err)
+	assert.Equal(t, "리팩터링 전", first.Label)
+	assert.Equal(t, 2, first.Files)
+	assert.Equal(t, 0, first.LinkedFiles)
+
+	// 바뀌지 않은 파일은 직전 스냅샷과 하드 링크로 공유
+	writeSnapshotFile(t, root, "docs/readme.md", "v2 changed")
+	second, err := service.Create(ctx, ws.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, second.LinkedFiles)
+	assert.Equal(t, 1, second.ClonedFiles+second.CopiedFiles)
+
+	snapshots, err := service.List(ctx, ws.ID)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assert.Equal(t, second.ID, snapshots[0].ID)
+
+	// 워크스페이스를 망가뜨린 뒤 첫 스냅샷으로 복원
+	writeSnapshotFile(t, root, "main.go", "broken")
+	writeSnapshotFile(t, root, "generated/out.txt", "junk")
+	require.NoError(t, os.Remove(filepath.Join(root, "link.go")))
+
+	result, err := service.Restore(ctx, ws.ID, first.ID)
+	require.NoError(t, err)
+	require.NotNil(t, result.Backup)
+	assert.Equal(t, 1, result.Removed)
+
+	data, err := os.ReadFile(filepath.Join(root, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n", string(data))
+	data, err = os.ReadFile(filepath.Join(root, "docs/readme.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(data))
+	link, err := os.Readlink(filepath.Join(root, "link.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "main.go", link)
+	assert.NoDirExists(t, filepath.Join(root, "generated"))
+
+	// 복원한 파일을 고쳐도 스냅샷은 바뀌지 않음 (링크가 아닌 복사)
+	writeSnapshotFile(t, root, "main.go", "edited after restore")
+	again, err := service.Restore(ctx, ws.ID, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, again.Restored)
+	data, err = os.ReadFile(filepath.Join(root, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n", string(data))
+
+	// 복원 전 자동 스냅샷으로 복원을 되돌림
+	_, err = service.Restore(ctx, ws.ID, result.Backup.ID)
+	require.NoError(t, err)
+	data, err = os.ReadFile(filepath.Join(root, "generated/out.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "junk", string(data))
+
+	require.NoError(t, service.Delete(ctx, ws.ID, second.ID))
+	_, err = service.Get(ctx, ws.ID, second.ID)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+	_, err = service.Get(ctx, ws.ID, "../"+first.ID)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
+
+func TestWorkspaceSnapshotService_Collect(t *testing.T) {
+	t.Run("워크스페이스별 개수 제한", func(t *testing.T) {
+		service, ws, root := newSnapshotFixture(t, WorkspaceSnapshotConfig{MaxPerWorkspace: 2})
+		ctx := context.Background()
+		writeSnapshotFile(t, root, "a.txt", "a")
+
+		var ids []string
+		for i := 0; i < 3; i++ {
+			snapshot, err := service.Create(ctx, ws.ID, "")
+			require.NoError(t, err)
+			ids = append(ids, snapshot.ID)
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		snapshots, err := service.List(ctx, ws.ID)
+		require.NoError(t, err)
+		require.Len(t, snapshots, 2)
+		assert.Equal(t, ids[2], snapshots[0].ID)
+		assert.Equal(t, ids[1], snapshots[1].ID)
+	})
+
+	t.Run("디스크 사용량 상한", func(t *testing.T) {
+		service, ws, root := newSnapshotFixture(t, WorkspaceSnapshotConfig{MaxTotalBytes: 1})
+		ctx := context.Background()
+		writeSnapshotFile(t, root, "a.txt", "a")
+
+		_, err := service.Create(ctx, ws.ID, "")
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+		latest, err := service.Create(ctx, ws.ID, "")
+		require.NoError(t, err)
+
+		// 상한을 넘어도 방금 만든 스냅샷은 남김
+		snapshots, err := service.List(ctx, ws.ID)
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		assert.Equal(t, latest.ID, snapshots[0].ID)
+	})
+}
+
+func TestWorkspaceSnapshotService_SnapshotBeforeTask(t *testing.T) {
+	service, ws, root := newSnapshotFixture(t, WorkspaceSnapshotConfig{})
+	writeSnapshotFile(t, root, "a.txt", "a")
+
+	task := &models.Task{Command: "claude fix the flaky test"}
+	task.ID = "task-1"
+	snapshot, err := service.SnapshotBeforeTask(context.Background(), ws.ID, task)
+	require.NoError(t, err)
+	assert.Equal(t, "task-1", snapshot.TaskID)
+	assert.Equal(t, "태스크 실행 전: claude fix the flaky test", snapshot.Label)
+
+	_, err = service.SnapshotBeforeTask(context.Background(), "missing", task)
+	assertWorkspaceErrorCode(t, err, ErrCodeNotFound)
+}
@@ -0,0 +1,785 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const (
+	// defaultWatchDebounce 마지막 변경 후 실행까지 기다리는 기본 시간
+	defaultWatchDebounce = 2 * time.Second
+	// defaultWatchCooldown 실행이 끝난 뒤 변경을 무시하는 기본 시간
+	defaultWatchCooldown = 10 * time.Second
+	// defaultWatchMaxTriggersPerHour 한 시간 동안 자동 실행할 수 있는 기본 최대 횟수
+	defaultWatchMaxTriggersPerHour = 6
+	// defaultWatchMaxDirectories 워크스페이스 하나에서 감시하는 기본 최대 디렉토리 수 (inotify 한도 보호)
+	defaultWatchMaxDirectories = 4096
+	// maxWatchPendingChanges 실행 하나에 모으는 최대 변경 파일 수 (넘는 파일은 개수만 알림)
+	maxWatchPendingChanges = 200
+
+	// watchTaskMetadataKey 자동 실행한 태스크의 메타데이터 키 (값은 워크스페이스 ID)
+	watchTaskMetadataKey = "watch_workspace_id"
+)
+
+// defaultWatchIgnore 설정과 관계없이 항상 무시하는 패턴
+var defaultWatchIgnore = []string{".git/**"}
+
+// WorkspaceWatchPipelineRunner 감시 설정의 파이프라인 조회와 실행 (*PipelineService)
+type WorkspaceWatchPipelineRunner interface {
+	Get(ctx context.Context, id, userID string, admin bool) (*models.Pipeline, error)
+	Run(ctx context.Context, id, userID string, admin bool, req *models.PipelineRunRequest) (*models.PipelineRun, error)
+}
+
+// WorkspaceWatchServiceConfig 워크스페이스 파일 감시 서비스 설정
+type WorkspaceWatchServiceConfig struct {
+	// Dir 워크스페이스별 감시 설정 저장 디렉토리 ("<Dir>/<workspaceID>.json")
+	Dir string
+	// ExcludePatterns 모든 워크스페이스에서 무시할 패턴 (workspace.exclude_patterns)
+	ExcludePatterns []string
+	// MaxDirectories 워크스페이스 하나에서 감시할 최대 디렉토리 수 (0이면 4096)
+	MaxDirectories int
+}
+
+// WorkspaceWatchService 워크스페이스 파일 변경 시 설정한 태스크나 파이프라인을 자동으로 실행하는 서비스
+// 세션 프로젝트 디렉토리를 fsnotify로 감시하고, 패턴과 일치하는 변경을 디바운스 시간 동안 모아 한 번 실행합니다.
+// Claude가 고친 파일로 다시 실행되지 않도록 자동 실행한 태스크(파이프라인)가 끝날 때까지와
+// 쿨다운 시간 동안의 변경은 무시하며, 한 시간 실행 횟수 한도를 넘으면 감시를 멈춥니다.
+type WorkspaceWatchService struct {
+	storage   storage.Storage
+	access    *WorkspaceAccessService
+	tasks     PipelineTaskRunner
+	pipelines WorkspaceWatchPipelineRunner
+	config    WorkspaceWatchServiceConfig
+
+	// mu 감시기 상태와 설정 파일 변경을 직렬화
+	mu       sync.Mutex
+	ctx      context.Context // Start에서 받은 컨텍스트 (nil이면 감시하지 않음)
+	watchers map[string]*workspaceWatcher
+	byTask   map[string]string // 자동 실행한 태스크 ID -> 워크스페이스 ID
+	byRun    map[string]string // 자동 실행한 파이프라인 실행 ID -> 워크스페이스 ID
+}
+
+// workspaceWatcher 워크스페이스 하나의 감시기
+// fs와 dirs는 감시 고루틴만 사용하고, 나머지는 서비스 mu로 보호합니다.
+type workspaceWatcher struct {
+	config  *models.WorkspaceWatchConfig
+	root    string
+	ignore  []string
+	fs      *fsnotify.Watcher
+	dirs    int
+	maxDirs int
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	stopped       bool
+	pending       map[string]bool
+	overflow      int
+	timer         *time.Timer
+	taskID        string
+	runID         string
+	cooldownUntil time.Time
+	triggers      []time.Time
+
+	lastTaskID      string
+	lastRunID       string
+	lastTriggeredAt *time.Time
+	lastError       string
+	suppressed      int64
+}
+
+// NewWorkspaceWatchService 새 워크스페이스 파일 감시 서비스 생성
+// pipelines가 nil이면 프롬프트 태스크만 설정할 수 있습니다.
+func NewWorkspaceWatchService(storage storage.Storage, tasks PipelineTaskRunner, pipelines WorkspaceWatchPipelineRunner, config WorkspaceWatchServiceConfig) *WorkspaceWatchService {
+	if config.MaxDirectories <= 0 {
+		config.MaxDirectories = defaultWatchMaxDirectories
+	}
+	return &WorkspaceWatchService{
+		storage:   storage,
+		access:    NewWorkspaceAccessService(storage),
+		tasks:     tasks,
+		pipelines: pipelines,
+		config:    config,
+		watchers:  make(map[string]*workspaceWatcher),
+		byTask:    make(map[string]string),
+		byRun:     make(map[string]string),
+	}
+}
+
+// Start 저장된 설정 중 켜진 워크스페이스 감시 시작 (ctx가 끝나거나 Stop을 호출할 때까지)
+func (s *WorkspaceWatchService) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx != nil {
+		return
+	}
+	s.ctx = ctx
+
+	entries, err := os.ReadDir(s.config.Dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("워크스페이스 감시 설정 목록 조회 실패: %v", err)
+		}
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		config, err := s.load(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			log.Printf("워크스페이스 감시 설정 읽기 실패: %s: %v", entry.Name(), err)
+			continue
+		}
+		if config != nil && config.Enabled {
+			s.startWatcher(config, nil)
+		}
+	}
+}
+
+// Stop 모든 워크스페이스 감시 중지
+func (s *WorkspaceWatchService) Stop() {
+	s.mu.Lock()
+	watchers := make([]*workspaceWatcher, 0, len(s.watchers))
+	for _, w := range s.watchers {
+		w.stop()
+		watchers = append(watchers, w)
+	}
+	s.watchers = make(map[string]*workspaceWatcher)
+	s.ctx = nil
+	s.mu.Unlock()
+
+	for _, w := range watchers {
+		w.wg.Wait()
+	}
+}
+
+// Get 워크스페이스 감시 설정과 현재 상태 (설정이 없으면 disabled)
+func (s *WorkspaceWatchService) Get(ctx context.Context, workspaceID string) (*models.WorkspaceWatchStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	config, err := s.load(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.status(config, s.watchers[workspaceID]), nil
+}
+
+// Put 워크스페이스 감시 설정 저장 (켜져 있으면 감시를 다시 시작)
+// 설정한 사용자가 세션 워크스페이스에 execute 권한이 있어야 하며, 자동 실행은 이 사용자로 제출됩니다.
+// 멈춘 감시도 다시 저장하면 실행 횟수를 초기화하고 재개합니다.
+func (s *WorkspaceWatchService) Put(ctx context.Context, workspaceID, userID string, req *models.WorkspaceWatchRequest) (*models.WorkspaceWatchStatus, error) {
+	if _, err := s.path(workspaceID); err != nil {
+		return nil, err
+	}
+	config, err := s.normalize(ctx, workspaceID, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.save(config); err != nil {
+		return nil, err
+	}
+
+	// 자동 실행한 태스크가 아직 실행 중이면 새 감시기도 끝날 때까지 변경을 무시
+	previous := s.watchers[workspaceID]
+	if previous != nil {
+		previous.stop()
+		delete(s.watchers, workspaceID)
+	}
+	if config.Enabled && s.ctx != nil {
+		s.startWatcher(config, previous)
+	}
+	return s.status(config, s.watchers[workspaceID]), nil
+}
+
+// Delete 워크스페이스 감시 설정 삭제와 감시 중지
+func (s *WorkspaceWatchService) Delete(ctx context.Context, workspaceID string) error {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w := s.watchers[workspaceID]; w != nil {
+		w.stop()
+		delete(s.watchers, workspaceID)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// OnTaskFinished 자동 실행한 태스크나 파이프라인이 끝나면 쿨다운을 시작 (TaskFinishListener)
+// 파이프라인 실행은 마지막 단계까지 끝나야 하므로 파이프라인 서비스보다 나중에 등록해야 합니다.
+func (s *WorkspaceWatchService) OnTaskFinished(task *models.Task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if workspaceID, ok := s.byTask[task.ID]; ok {
+		delete(s.byTask, task.ID)
+		if w := s.watchers[workspaceID]; w != nil && w.taskID == task.ID {
+			s.finishRun(w)
+		}
+		return
+	}
+
+	// 파이프라인 단계 태스크는 실행 ID를 남기지 않으므로 같은 세션의 자동 실행 상태를 확인
+	for runID, workspaceID := range s.byRun {
+		w := s.watchers[workspaceID]
+		if w != nil && w.config.SessionID != task.SessionID {
+			continue
+		}
+		run, err := s.storage.Pipeline().GetRun(context.Background(), runID)
+		if err == nil && !run.Status.IsTerminal() {
+			continue
+		}
+		delete(s.byRun, runID)
+		if w != nil && w.runID == runID {
+			if err == nil && run.Error != "" {
+				w.lastError = run.Error
+			}
+			s.finishRun(w)
+		}
+	}
+}
+
+// normalize 요청을 검증하고 기본값을 채운 설정 생성
+func (s *WorkspaceWatchService) normalize(ctx context.Context, workspaceID, userID string, req *models.WorkspaceWatchRequest) (*models.WorkspaceWatchConfig, error) {
+	prompt := strings.TrimSpace(req.Prompt)
+	if (prompt == "") == (req.PipelineID == "") {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "prompt와 pipeline_id 중 하나만 지정해야 합니다", nil)
+	}
+	for _, pattern := range append(append([]string(nil), req.Patterns...), req.Ignore...) {
+		if err := validateWatchPattern(pattern); err != nil {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("올바르지 않은 파일 패턴입니다: %q", pattern), err)
+		}
+	}
+
+	session, err := s.access.AuthorizeSession(ctx, req.SessionID, userID, models.WorkspacePermissionExecute)
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.storage.Project().GetByID(ctx, session.ProjectID)
+	if err != nil || project.WorkspaceID != workspaceID {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, "세션이 이 워크스페이스의 프로젝트에 속하지 않습니다", err)
+	}
+	if req.Enabled && !session.IsActive() {
+		return nil, NewWorkspaceError(ErrCodeInvalidRequest, fmt.Sprintf("세션이 활성 상태가 아닙니다: %s", session.Status), nil)
+	}
+	if req.PipelineID != "" {
+		if s.pipelines == nil {
+			return nil, NewWorkspaceError(ErrCodeInvalidRequest, "파이프라인을 사용할 수 없는 서버입니다", nil)
+		}
+		if _, err := s.pipelines.Get(ctx, req.PipelineID, userID, false); err != nil {
+			return nil, err
+		}
+	}
+
+	config := &models.WorkspaceWatchConfig{
+		WorkspaceID:        workspaceID,
+		Enabled:            req.Enabled,
+		SessionID:          req.SessionID,
+		Patterns:           req.Patterns,
+		Ignore:             req.Ignore,
+		Prompt:             prompt,
+		PipelineID:         req.PipelineID,
+		TimeoutTier:        req.TimeoutTier,
+		DebounceMS:         req.DebounceMS,
+		CooldownMS:         req.CooldownMS,
+		MaxTriggersPerHour: req.MaxTriggersPerHour,
+		OwnerID:            userID,
+		UpdatedAt:          time.Now(),
+	}
+	if config.DebounceMS == 0 {
+		config.DebounceMS = defaultWatchDebounce.Milliseconds()
+	}
+	if config.CooldownMS == 0 {
+		config.CooldownMS = defaultWatchCooldown.Milliseconds()
+	}
+	if config.MaxTriggersPerHour == 0 {
+		config.MaxTriggersPerHour = defaultWatchMaxTriggersPerHour
+	}
+	return config, nil
+}
+
+// startWatcher 세션 프로젝트 디렉토리 감시 시작 (시작하지 못하면 감시를 멈추고 이유를 저장)
+// previous가 있으면 실행 중인 태스크와 쿨다운, 멈추지 않은 감시의 실행 기록을 이어받습니다.
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *WorkspaceWatchService) startWatcher(config *models.WorkspaceWatchConfig, previous *workspaceWatcher) {
+	w, err := s.newWatcher(config)
+	if err != nil {
+		log.Printf("워크스페이스 감시 시작 실패: %s: %v", config.WorkspaceID, err)
+		s.pauseConfig(config, fmt.Sprintf("감시를 시작할 수 없습니다: %v", err))
+		return
+	}
+	if previous != nil {
+		w.taskID, w.runID, w.cooldownUntil = previous.taskID, previous.runID, previous.cooldownUntil
+		w.lastTaskID, w.lastRunID, w.lastTriggeredAt = previous.lastTaskID, previous.lastRunID, previous.lastTriggeredAt
+		if previous.config.PausedReason == "" {
+			w.triggers = previous.triggers
+		}
+	}
+	s.watchers[config.WorkspaceID] = w
+
+	w.wg.Add(1)
+	go s.watch(w)
+}
+
+// newWatcher 감시기를 만들고 프로젝트 디렉토리를 하위 디렉토리까지 등록
+func (s *WorkspaceWatchService) newWatcher(config *models.WorkspaceWatchConfig) (*workspaceWatcher, error) {
+	session, err := s.storage.Session().GetByID(s.ctx, config.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("세션 조회 실패: %w", err)
+	}
+	project, err := s.storage.Project().GetByID(s.ctx, session.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("프로젝트 조회 실패: %w", err)
+	}
+	if info, err := os.Stat(project.Path); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("프로젝트 디렉토리를 찾을 수 없습니다: %s", project.Path)
+	}
+
+	fsWatcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	w := &workspaceWatcher{
+		config:  config,
+		root:    project.Path,
+		ignore:  append(append(append([]string(nil), defaultWatchIgnore...), s.config.ExcludePatterns...), config.Ignore...),
+		fs:      fsWatcher,
+		maxDirs: s.config.MaxDirectories,
+		done:    make(chan struct{}),
+		pending: make(map[string]bool),
+	}
+	if _, err := w.addTree(project.Path); err != nil {
+		fsWatcher.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// watch 파일 시스템 이벤트 처리 (감시기가 멈추면 fsnotify 감시기를 닫음)
+func (s *WorkspaceWatchService) watch(w *workspaceWatcher) {
+	defer w.wg.Done()
+	defer w.fs.Close()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.fs.Events:
+			if !ok {
+				return
+			}
+			s.handleEvent(w, event)
+		case err, ok := <-w.fs.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("워크스페이스 파일 감시 오류: %s: %v", w.config.WorkspaceID, err)
+		}
+	}
+}
+
+// handleEvent 패턴과 일치하는 변경을 모으고 디바운스 타이머를 다시 시작
+func (s *WorkspaceWatchService) handleEvent(w *workspaceWatcher, event fsnotify.Event) {
+	if event.Op == fsnotify.Chmod {
+		return
+	}
+	rel, err := filepath.Rel(w.root, event.Name)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return
+	}
+	rel = filepath.ToSlash(rel)
+	if w.ignored(rel) {
+		return
+	}
+
+	// 새로 만든 디렉토리도 감시하고, 감시를 등록하기 전에 만들어진 파일은 변경으로 봄
+	changes := []string{rel}
+	if event.Has(fsnotify.Create) {
+		if info, err := os.Lstat(event.Name); err == nil && info.IsDir() {
+			files, err := w.addTree(event.Name)
+			if err != nil {
+				log.Printf("워크스페이스 디렉토리 감시 추가 실패: %s: %v", w.config.WorkspaceID, err)
+			}
+			changes = files
+		}
+	}
+	matched := changes[:0]
+	for _, change := range changes {
+		if matchAnyWatchPattern(w.config.Patterns, change) {
+			matched = append(matched, change)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w.stopped {
+		return
+	}
+	// 자동 실행 중이거나 막 끝난 뒤의 변경은 Claude가 고친 파일일 수 있으므로 무시
+	if w.busy() || time.Now().Before(w.cooldownUntil) {
+		w.suppressed += int64(len(matched))
+		return
+	}
+	for _, change := range matched {
+		if len(w.pending) < maxWatchPendingChanges {
+			w.pending[change] = true
+		} else if !w.pending[change] {
+			w.overflow++
+		}
+	}
+
+	debounce := time.Duration(w.config.DebounceMS) * time.Millisecond
+	if w.timer == nil {
+		w.timer = time.AfterFunc(debounce, func() { s.fire(w) })
+	} else {
+		w.timer.Reset(debounce)
+	}
+}
+
+// fire 디바운스 시간이 지나면 모은 변경으로 태스크나 파이프라인 실행
+func (s *WorkspaceWatchService) fire(w *workspaceWatcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.timer = nil
+	if w.stopped || w.busy() || len(w.pending) == 0 {
+		return
+	}
+	changes := make([]string, 0, len(w.pending))
+	for rel := range w.pending {
+		changes = append(changes, rel)
+	}
+	sort.Strings(changes)
+	overflow := w.overflow
+	w.pending = make(map[string]bool)
+	w.overflow = 0
+
+	// 한 시간 실행 횟수 한도를 넘으면 실행이 실행을 부르는 상황으로 보고 감시를 멈춤
+	now := time.Now()
+	recent := w.triggers[:0]
+	for _, at := range w.triggers {
+		if now.Sub(at) < time.Hour {
+			recent = append(recent, at)
+		}
+	}
+	w.triggers = recent
+	if len(w.triggers) >= w.config.MaxTriggersPerHour {
+		s.pause(w, fmt.Sprintf("한 시간 동안 자동 실행이 %d번을 넘어 감시를 멈췄습니다", w.config.MaxTriggersPerHour))
+		return
+	}
+	w.triggers = append(w.triggers, now)
+	w.lastTriggeredAt = &now
+	w.lastError = ""
+
+	if w.config.PipelineID != "" {
+		run, err := s.pipelines.Run(s.ctx, w.config.PipelineID, w.config.OwnerID, false, &models.PipelineRunRequest{SessionID: w.config.SessionID})
+		if err != nil {
+			w.lastError = err.Error()
+			log.Printf("워크스페이스 감시 파이프라인 실행 실패: %s: %v", w.config.WorkspaceID, err)
+			return
+		}
+		w.lastRunID = run.ID
+		if run.Status.IsTerminal() {
+			w.lastError = run.Error
+			return
+		}
+		w.runID = run.ID
+		s.byRun[run.ID] = w.config.WorkspaceID
+		return
+	}
+
+	task, err := s.tasks.Create(s.ctx, &models.TaskCreateRequest{
+		SessionID:   w.config.SessionID,
+		Command:     renderWatchPrompt(w.config.Prompt, changes, overflow),
+		Metadata:    map[string]string{watchTaskMetadataKey: w.config.WorkspaceID},
+		TimeoutTier: w.config.TimeoutTier,
+		UserID:      w.config.OwnerID,
+	})
+	if err != nil {
+		w.lastError = err.Error()
+		log.Printf("워크스페이스 감시 태스크 생성 실패: %s: %v", w.config.WorkspaceID, err)
+		return
+	}
+	w.taskID = task.ID
+	w.lastTaskID = task.ID
+	s.byTask[task.ID] = w.config.WorkspaceID
+}
+
+// finishRun 자동 실행이 끝난 감시기의 쿨다운 시작
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *WorkspaceWatchService) finishRun(w *workspaceWatcher) {
+	w.taskID = ""
+	w.runID = ""
+	w.cooldownUntil = time.Now().Add(time.Duration(w.config.CooldownMS) * time.Millisecond)
+}
+
+// pause 감시를 멈추고 이유를 설정에 저장 (설정을 다시 저장할 때까지 재시작해도 멈춘 상태)
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *WorkspaceWatchService) pause(w *workspaceWatcher, reason string) {
+	w.stop()
+	w.lastError = reason
+	log.Printf("워크스페이스 감시 멈춤: %s: %s", w.config.WorkspaceID, reason)
+	s.pauseConfig(w.config, reason)
+}
+
+// pauseConfig 설정을 끄고 멈춘 이유를 저장
+func (s *WorkspaceWatchService) pauseConfig(config *models.WorkspaceWatchConfig, reason string) {
+	config.Enabled = false
+	config.PausedReason = reason
+	if err := s.save(config); err != nil {
+		log.Printf("워크스페이스 감시 설정 저장 실패: %s: %v", config.WorkspaceID, err)
+	}
+}
+
+// status 설정과 감시기 상태로 응답 생성
+// 호출하는 쪽에서 s.mu를 잡고 있어야 합니다.
+func (s *WorkspaceWatchService) status(config *models.WorkspaceWatchConfig, w *workspaceWatcher) *models.WorkspaceWatchStatus {
+	status := &models.WorkspaceWatchStatus{Config: config, State: models.WorkspaceWatchDisabled}
+	if config != nil && config.PausedReason != "" {
+		status.State = models.WorkspaceWatchPaused
+	}
+	if w == nil {
+		return status
+	}
+
+	status.Root = w.root
+	status.LastTaskID = w.lastTaskID
+	status.LastPipelineRunID = w.lastRunID
+	status.LastTriggeredAt = w.lastTriggeredAt
+	status.LastError = w.lastError
+	status.SuppressedEvents = w.suppressed
+	for _, at := range w.triggers {
+		if time.Since(at) < time.Hour {
+			status.TriggersLastHour++
+		}
+	}
+	for rel := range w.pending {
+		status.PendingChanges = append(status.PendingChanges, rel)
+	}
+	sort.Strings(status.PendingChanges)
+
+	switch {
+	case w.stopped:
+	case w.busy():
+		status.State = models.WorkspaceWatchRunning
+	case time.Now().Before(w.cooldownUntil):
+		status.State = models.WorkspaceWatchCooldown
+	case len(w.pending) > 0:
+		status.State = models.WorkspaceWatchPending
+	default:
+		status.State = models.WorkspaceWatchIdle
+	}
+	return status
+}
+
+// load 워크스페이스 감시 설정 읽기 (없으면 nil)
+func (s *WorkspaceWatchService) load(workspaceID string) (*models.WorkspaceWatchConfig, error) {
+	path, err := s.path(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("워크스페이스 감시 설정 읽기 실패: %w", err)
+	}
+
+	var config models.WorkspaceWatchConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("워크스페이스 감시 설정 파싱 실패: %w", err)
+	}
+	return &config, nil
+}
+
+// save 워크스페이스 감시 설정 저장 (임시 파일에 쓴 뒤 교체)
+func (s *WorkspaceWatchService) save(config *models.WorkspaceWatchConfig) error {
+	path, err := s.path(config.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.config.Dir, 0700); err != nil {
+		return fmt.Errorf("워크스페이스 감시 설정 디렉토리 생성 실패: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// path 워크스페이스 감시 설정 파일 경로
+func (s *WorkspaceWatchService) path(workspaceID string) (string, error) {
+	if workspaceID == "" || strings.ContainsAny(workspaceID, `/\`) || strings.HasPrefix(workspaceID, ".") {
+		return "", NewWorkspaceError(ErrCodeInvalidRequest, "올바르지 않은 워크스페이스 ID입니다", nil)
+	}
+	return filepath.Join(s.config.Dir, workspaceID+".json"), nil
+}
+
+// busy 자동 실행한 태스크나 파이프라인이 실행 중인지
+func (w *workspaceWatcher) busy() bool {
+	return w.taskID != "" || w.runID != ""
+}
+
+// stop 감시 중지 (fsnotify 감시기는 감시 고루틴이 닫음)
+// 서비스 mu를 잡은 채 호출하므로 고루틴이 끝나기를 기다리지 않습니다.
+func (w *workspaceWatcher) stop() {
+	if w.stopped {
+		return
+	}
+	w.stopped = true
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	w.pending = make(map[string]bool)
+	close(w.done)
+}
+
+// ignored 무시할 경로인지 (기본 패턴, 서버 제외 패턴, 워크스페이스 ignore 패턴)
+func (w *workspaceWatcher) ignored(rel string) bool {
+	return matchAnyWatchPattern(w.ignore, rel)
+}
+
+// addTree dir와 하위 디렉토리를 감시 목록에 추가하고 그 안의 파일 경로를 반환 (무시할 경로는 건너뜀)
+func (w *workspaceWatcher) addTree(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// 감시 도중 지워진 디렉토리
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(w.root, name)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel != "." && w.ignored(rel) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.IsDir() {
+			files = append(files, rel)
+			return nil
+		}
+		if w.dirs >= w.maxDirs {
+			return fmt.Errorf("감시할 디렉토리가 %d개를 넘습니다 (ignore 패턴으로 줄여 주세요)", w.maxDirs)
+		}
+		if err := w.fs.Add(name); err != nil {
+			return fmt.Errorf("디렉토리 감시 등록 실패: %s: %w", name, err)
+		}
+		w.dirs++
+		return nil
+	})
+	return files, err
+}
+
+// renderWatchPrompt 프롬프트의 {{changed_files}}를 바뀐 파일 목록으로 치환
+func renderWatchPrompt(prompt string, changes []string, overflow int) string {
+	if !strings.Contains(prompt, models.WorkspaceWatchChangedFilesPlaceholder) {
+		return prompt
+	}
+	list := "- " + strings.Join(changes, "\n- ")
+	if overflow > 0 {
+		list += fmt.Sprintf("\n(외 %d개)", overflow)
+	}
+	return strings.ReplaceAll(prompt, models.WorkspaceWatchChangedFilesPlaceholder, list)
+}
+
+// validateWatchPattern 상대 경로 glob 패턴인지 확인
+func validateWatchPattern(pattern string) error {
+	if strings.HasPrefix(pattern, "/") || strings.Contains(pattern, `\`) {
+		return errors.New("프로젝트 기준 상대 경로여야 합니다")
+	}
+	for _, segment := range strings.Split(pattern, "/") {
+		if segment == "" || segment == ".." {
+			return errors.New("빈 경로나 ..를 사용할 수 없습니다")
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchAnyWatchPattern 경로가 패턴 중 하나와 일치하는지
+func matchAnyWatchPattern(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if matchWatchPattern(pattern, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchWatchPattern 프로젝트 기준 상대 경로(/ 구분)가 패턴과 일치하는지
+// "/"가 없는 패턴은 파일 이름만 비교하고, "**"는 0개 이상의 디렉토리와 일치합니다 ("vendor/**"는 vendor 자체도 포함).
+func matchWatchPattern(pattern, rel string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(rel))
+		return ok
+	}
+	return matchWatchSegments(strings.Split(pattern, "/"), strings.Split(rel, "/"))
+}
+
+func matchWatchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchWatchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+// fakeWatchTasks 감시기가 제출한 태스크를 기록하는 테스트용 실행기 (타이머 고루틴에서 호출됨)
+type fakeWatchTasks struct {
+	mu      sync.Mutex
+	created []*models.Task
+}
+
+func (f *fakeWatchTasks) Create(ctx context.Context, req *models.TaskCreateRequest) (*models.Task, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	task := &models.Task{SessionID: req.SessionID, Command: req.Command, Status: models.TaskRunning}
+	task.ID = fmt.Sprintf("task-%d", len(f.created)+1)
+	f.created = append(f.created, task)
+	return task, nil
+}
+
+func (f *fakeWatchTasks) Cancel(ctx context.Context, id string) error {
+	return nil
+}
+
+func (f *fakeWatchTasks) list() []*models.Task {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*models.Task(nil), f.created...)
+}
+
+func TestMatchWatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		rel     string
+		want    bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "internal/api/server.go", true},
+		{"*.go", "main.go.orig", false},
+		{"src/**/*.ts", "src/app.ts", true},
+		{"src/**/*.ts", "src/a/b/app.ts", true},
+		{"src/**/*.ts", "lib/app.ts", false},
+		{"node_modules/**", "node_modules", true},
+		{"node_modules/**", "node_modules/react/index.js", true},
+		{"docs/*.md", "docs/a/b.md", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, matchWatchPattern(tt.pattern, tt.rel), "%s ~ %s", tt.pattern, tt.rel)
+	}
+
+	assert.NoError(t, validateWatchPattern("src/**/*.go"))
+	assert.Error(t, validateWatchPattern("/etc/passwd"))
+	assert.Error(t, validateWatchPattern("../other/*.go"))
+	assert.Error(t, validateWatchPattern("src/[a"))
+}
+
+func TestWorkspaceWatchService_Put(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	service := NewWorkspaceWatchService(store, &fakeWatchTasks{}, nil, WorkspaceWatchServiceConfig{Dir: t.TempDir()})
+
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+	project := &models.Project{WorkspaceID: ws.ID, Name: "api", Path: t.TempDir()}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{BaseModel: models.BaseModel{ID: "session-api"}, ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, session))
+
+	other := createOwnedWorkspace(t, store, "alice", "web")
+	otherProject := &models.Project{WorkspaceID: other.ID, Name: "web", Path: t.TempDir()}
+	require.NoError(t, store.Project().Create(ctx, otherProject))
+	otherSession := &models.Session{BaseModel: models.BaseModel{ID: "session-web"}, ProjectID: otherProject.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, otherSession))
+
+	status, err := service.Get(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspaceWatchDisabled, status.State)
+	assert.Nil(t, status.Config)
+
+	tests := []struct {
+		name string
+		req  models.WorkspaceWatchRequest
+	}{
+		{"프롬프트와 파이프라인 둘 다", models.WorkspaceWatchRequest{SessionID: session.ID, Patterns: []string{"*.go"}, Prompt: "test", PipelineID: "p1"}},
+		{"실행할 작업 없음", models.WorkspaceWatchRequest{SessionID: session.ID, Patterns: []string{"*.go"}}},
+		{"잘못된 패턴", models.WorkspaceWatchRequest{SessionID: session.ID, Patterns: []string{"../*.go"}, Prompt: "test"}},
+		{"다른 워크스페이스 세션", models.WorkspaceWatchRequest{SessionID: otherSession.ID, Patterns: []string{"*.go"}, Prompt: "test"}},
+		{"파이프라인을 쓸 수 없는 서버", models.WorkspaceWatchRequest{SessionID: session.ID, Patterns: []string{"*.go"}, PipelineID: "p1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Put(ctx, ws.ID, "alice", &tt.req)
+			assert.Error(t, err)
+		})
+	}
+
+	// 권한 없는 사용자
+	_, err = service.Put(ctx, ws.ID, "mallory", &models.WorkspaceWatchRequest{SessionID: session.ID, Patterns: []string{"*.go"}, Prompt: "test"})
+	assert.Error(t, err)
+
+	// 기본값 채움 (서비스를 시작하기 전에는 저장만)
+	status, err = service.Put(ctx, ws.ID, "alice", &models.WorkspaceWatchRequest{Enabled: true, SessionID: session.ID, Patterns: []string{"*.go"}, Prompt: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspaceWatchDisabled, status.State)
+	assert.Equal(t, defaultWatchDebounce.Milliseconds(), status.Config.DebounceMS)
+	assert.Equal(t, defaultWatchMaxTriggersPerHour, status.Config.MaxTriggersPerHour)
+	assert.Equal(t, "alice", status.Config.OwnerID)
+
+	require.NoError(t, service.Delete(ctx, ws.ID))
+	status, err = service.Get(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Nil(t, status.Config)
+}
+
+func TestWorkspaceWatchService_Trigger(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := memory.New()
+	tasks := &fakeWatchTasks{}
+	configDir := t.TempDir()
+	service := NewWorkspaceWatchService(store, tasks, nil, WorkspaceWatchServiceConfig{
+		Dir:             configDir,
+		ExcludePatterns: []string{"*.tmp"},
+	})
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "gen"), 0755))
+	ws := createOwnedWorkspace(t, store, "alice", "api")
+	project := &models.Project{WorkspaceID: ws.ID, Name: "api", Path: root}
+	require.NoError(t, store.Project().Create(ctx, project))
+	session := &models.Session{BaseModel: models.BaseModel{ID: "session-api"}, ProjectID: project.ID, Status: models.SessionActive}
+	require.NoError(t, store.Session().Create(ctx, session))
+
+	_, err := service.Put(ctx, ws.ID, "alice", &models.WorkspaceWatchRequest{
+		Enabled:            true,
+		SessionID:          session.ID,
+		Patterns:           []string{"**/*.go"},
+		Ignore:             []string{"gen/**"},
+		Prompt:             "테스트를 고쳐줘\n{{changed_files}}",
+		DebounceMS:         100,
+		CooldownMS:         300,
+		MaxTriggersPerHour: 2,
+	})
+	require.NoError(t, err)
+	service.Start(ctx)
+	defer service.Stop()
+
+	status, err := service.Get(ctx, ws.ID)
+	require.NoError(t, err)
+	require.Equal(t, models.WorkspaceWatchIdle, status.State)
+	assert.Equal(t, root, status.Root)
+
+	write := func(rel string) {
+		t.Helper()
+		path := filepath.Join(root, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(time.Now().String()), 0644))
+	}
+	waitTasks := func(n int) []*models.Task {
+		t.Helper()
+		require.Eventually(t, func() bool { return len(tasks.list()) == n }, 5*time.Second, 20*time.Millisecond)
+		return tasks.list()
+	}
+	waitState := func(state models.WorkspaceWatchState) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			status, err := service.Get(ctx, ws.ID)
+			return err == nil && status.State == state
+		}, 5*time.Second, 20*time.Millisecond)
+	}
+
+	// 무시할 파일과 패턴에 맞지 않는 파일은 실행하지 않고, 맞는 변경은 모아서 한 번 실행
+	write("gen/model.go")
+	write("README.md")
+	write("notes.tmp")
+	write("main.go")
+	write("pkg/util/strings.go")
+	created := waitTasks(1)
+	assert.Contains(t, created[0].Command, "- main.go\n- pkg/util/strings.go")
+	assert.NotContains(t, created[0].Command, "gen/model.go")
+	waitState(models.WorkspaceWatchRunning)
+
+	// 실행 중 Claude가 고친 파일은 무시
+	write("main.go")
+	time.Sleep(300 * time.Millisecond)
+	assert.Len(t, tasks.list(), 1)
+
+	// 끝난 직후(쿨다운)의 변경도 무시
+	created[0].Status = models.TaskCompleted
+	service.OnTaskFinished(created[0])
+	write("main.go")
+	time.Sleep(150 * time.Millisecond)
+	assert.Len(t, tasks.list(), 1)
+	status, err = service.Get(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspaceWatchCooldown, status.State)
+	assert.Positive(t, status.SuppressedEvents)
+
+	// 쿨다운이 지나면 다시 실행
+	waitState(models.WorkspaceWatchIdle)
+	write("main.go")
+	created = waitTasks(2)
+	service.OnTaskFinished(created[1])
+	waitState(models.WorkspaceWatchIdle)
+
+	// 한 시간 실행 횟수 한도를 넘으면 감시를 멈추고 설정에 남김
+	write("main.go")
+	waitState(models.WorkspaceWatchPaused)
+	assert.Len(t, tasks.list(), 2)
+	status, err = service.Get(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.False(t, status.Config.Enabled)
+	assert.NotEmpty(t, status.Config.PausedReason)
+
+	// 재시작해도 멈춘 상태 유지
+	restarted := NewWorkspaceWatchService(store, tasks, nil, WorkspaceWatchServiceConfig{Dir: configDir})
+	restarted.Start(ctx)
+	defer restarted.Stop()
+	status, err = restarted.Get(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkspaceWatchPaused, status.State)
+}
@@ -0,0 +1,26 @@
+package cached
+
+import (
+	"context"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// accountStorage ID 조회(토큰 subject로 사용자 찾기)를 캐시하는 계정 스토리지
+type accountStorage struct {
+	storage.AccountStorage
+	cache *Storage
+}
+
+func (s *accountStorage) GetByID(ctx context.Context, id string) (*models.Account, error) {
+	return s.cache.accounts.get(id, func() (*models.Account, error) {
+		return s.AccountStorage.GetByID(ctx, id)
+	})
+}
+
+func (s *accountStorage) Update(ctx context.Context, account *models.Account) error {
+	err := s.AccountStorage.Update(ctx, account)
+	s.cache.accounts.invalidate(account.ID)
+	return err
+}
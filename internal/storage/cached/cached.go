@@ -0,0 +1,202 @@
+// Package cached는 자주 읽는 스토리지 조회 결과를 TTL 동안 메모리에 보관하는 읽기 캐시를 제공합니다.
+// 캐시를 거친 쓰기는 관련 항목을 즉시 지우고, 캐시를 거치지 않은 변경은 이벤트 버스에서
+// Invalidate를 호출해 반영합니다.
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// Collection 캐시 컬렉션 이름 (메트릭 레이블로도 사용)
+type Collection string
+
+const (
+	CollectionAccounts        Collection = "accounts"         // 토큰 subject(계정 ID)로 조회한 계정
+	CollectionWorkspaces      Collection = "workspaces"       // ID로 조회한 워크스페이스
+	CollectionRoles           Collection = "roles"            // ID로 조회한 역할
+	CollectionUserRoles       Collection = "user_roles"       // 사용자에게 할당된 역할 목록
+	CollectionRolePermissions Collection = "role_permissions" // 역할에 부여된 권한 목록
+)
+
+// 기본 캐시 설정 값
+const (
+	DefaultAccountTTL   = time.Minute
+	DefaultWorkspaceTTL = time.Minute
+	DefaultRBACTTL      = 5 * time.Minute
+	DefaultMaxEntries   = 10000
+)
+
+// Config 캐시 설정
+// TTL이 0 이하인 컬렉션은 캐시하지 않습니다.
+type Config struct {
+	AccountTTL   time.Duration
+	WorkspaceTTL time.Duration
+	RBACTTL      time.Duration
+	MaxEntries   int // 컬렉션별 최대 항목 수 (0이면 제한 없음)
+	Registerer   prometheus.Registerer
+}
+
+// DefaultConfig 기본 캐시 설정
+func DefaultConfig() Config {
+	return Config{
+		AccountTTL:   DefaultAccountTTL,
+		WorkspaceTTL: DefaultWorkspaceTTL,
+		RBACTTL:      DefaultRBACTTL,
+		MaxEntries:   DefaultMaxEntries,
+	}
+}
+
+// Stats 컬렉션별 캐시 통계
+type Stats struct {
+	Collection Collection `json:"collection"`
+	Hits       int64      `json:"hits"`
+	Misses     int64      `json:"misses"`
+	HitRatio   float64    `json:"hit_ratio"`
+	Entries    int        `json:"entries"`
+}
+
+// Storage 읽기 캐시를 적용한 스토리지
+// 계정, 워크스페이스, RBAC 외의 스토리지는 그대로 전달합니다.
+type Storage struct {
+	storage.Storage
+
+	accounts        *collection[*models.Account]
+	workspaces      *collection[*models.Workspace]
+	roles           *collection[*models.Role]
+	userRoles       *collection[[]models.Role]
+	rolePermissions *collection[[]models.Permission]
+}
+
+// New 스토리지에 읽기 캐시를 씌웁니다
+func New(inner storage.Storage, config Config) *Storage {
+	metrics := newCacheMetrics(config.Registerer)
+	return &Storage{
+		Storage:         inner,
+		accounts:        newCollection(CollectionAccounts, config.AccountTTL, config.MaxEntries, clonePtr[models.Account], metrics),
+		workspaces:      newCollection(CollectionWorkspaces, config.WorkspaceTTL, config.MaxEntries, clonePtr[models.Workspace], metrics),
+		roles:           newCollection(CollectionRoles, config.RBACTTL, config.MaxEntries, clonePtr[models.Role], metrics),
+		userRoles:       newCollection(CollectionUserRoles, config.RBACTTL, config.MaxEntries, cloneSlice[models.Role], metrics),
+		rolePermissions: newCollection(CollectionRolePermissions, config.RBACTTL, config.MaxEntries, cloneSlice[models.Permission], metrics),
+	}
+}
+
+// Account 캐시를 적용한 계정 스토리지 반환
+func (s *Storage) Account() storage.AccountStorage {
+	return &accountStorage{AccountStorage: s.Storage.Account(), cache: s}
+}
+
+// Workspace 캐시를 적용한 워크스페이스 스토리지 반환
+func (s *Storage) Workspace() storage.WorkspaceStorage {
+	return &workspaceStorage{WorkspaceStorage: s.Storage.Workspace(), cache: s}
+}
+
+// RBAC 캐시를 적용한 RBAC 스토리지 반환
+func (s *Storage) RBAC() storage.RBACStorage {
+	return &rbacStorage{RBACStorage: s.Storage.RBAC(), cache: s}
+}
+
+// Invalidate 컬렉션에서 주어진 키의 항목을 지웁니다 (키가 없으면 컬렉션 전체)
+func (s *Storage) Invalidate(name Collection, keys ...string) {
+	switch name {
+	case CollectionAccounts:
+		s.accounts.invalidate(keys...)
+	case CollectionWorkspaces:
+		s.workspaces.invalidate(keys...)
+	case CollectionRoles:
+		s.roles.invalidate(keys...)
+	case CollectionUserRoles:
+		s.userRoles.invalidate(keys...)
+	case CollectionRolePermissions:
+		s.rolePermissions.invalidate(keys...)
+	}
+}
+
+// InvalidateAll 모든 컬렉션을 비웁니다
+func (s *Storage) InvalidateAll() {
+	s.accounts.invalidate()
+	s.workspaces.invalidate()
+	s.invalidateRBAC()
+}
+
+// invalidateRBAC 역할과 권한 컬렉션을 모두 비웁니다
+func (s *Storage) invalidateRBAC() {
+	s.roles.invalidate()
+	s.userRoles.invalidate()
+	s.rolePermissions.invalidate()
+}
+
+// Stats 컬렉션별 캐시 통계
+func (s *Storage) Stats() []Stats {
+	return []Stats{
+		s.accounts.stats(),
+		s.workspaces.stats(),
+		s.roles.stats(),
+		s.userRoles.stats(),
+		s.rolePermissions.stats(),
+	}
+}
+
+// clonePtr 캐시에 보관한 값을 호출자가 수정하지 못하도록 얕은 복사본을 만듭니다
+func clonePtr[T any](value *T) *T {
+	if value == nil {
+		return nil
+	}
+	copied := *value
+	return &copied
+}
+
+func cloneSlice[T any](values []T) []T {
+	if values == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(values)), values...)
+}
+
+// TransactionalStorage 트랜잭션을 지원하는 스토리지에 읽기 캐시를 씌운 스토리지
+// 트랜잭션 안의 쓰기는 캐시를 거치지 않으므로 커밋하면 워크스페이스 캐시를 비웁니다.
+type TransactionalStorage struct {
+	*Storage
+	tx storage.TransactionalStorage
+}
+
+// NewTransactional 트랜잭션을 지원하는 스토리지에 읽기 캐시를 씌웁니다
+func NewTransactional(inner storage.TransactionalStorage, config Config) *TransactionalStorage {
+	return &TransactionalStorage{Storage: New(inner, config), tx: inner}
+}
+
+// BeginTx 새 트랜잭션 시작
+func (s *TransactionalStorage) BeginTx(ctx context.Context) (storage.Transaction, error) {
+	tx, err := s.tx.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{Transaction: tx, cache: s.Storage}, nil
+}
+
+// WithTx 트랜잭션 내에서 작업 실행
+func (s *TransactionalStorage) WithTx(ctx context.Context, fn func(tx storage.Transaction) error) error {
+	err := s.tx.WithTx(ctx, fn)
+	if err == nil {
+		s.workspaces.invalidate()
+	}
+	return err
+}
+
+type transaction struct {
+	storage.Transaction
+	cache *Storage
+}
+
+func (t *transaction) Commit() error {
+	if err := t.Transaction.Commit(); err != nil {
+		return err
+	}
+	t.cache.workspaces.invalidate()
+	return nil
+}
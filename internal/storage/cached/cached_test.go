@@ -0,0 +1,152 @@
+package cached
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+)
+
+func newTestStorage(t *testing.T, config Config) (*Storage, *prometheus.Registry) {
+	t.Helper()
+	reg := prometheus.NewRegistry()
+	config.Registerer = reg
+	return New(memory.New(), config), reg
+}
+
+func statsOf(s *Storage, name Collection) Stats {
+	for _, stats := range s.Stats() {
+		if stats.Collection == name {
+			return stats
+		}
+	}
+	return Stats{}
+}
+
+func TestStorage_AccountCache(t *testing.T) {
+	ctx := context.Background()
+	s, reg := newTestStorage(t, DefaultConfig())
+
+	require.NoError(t, s.Account().Create(ctx, &models.Account{ID: "u1", Username: "alice", Email: "alice@example.com"}))
+
+	first, err := s.Account().GetByID(ctx, "u1")
+	require.NoError(t, err)
+	// 캐시에서 받은 값을 고쳐도 캐시된 값은 바뀌지 않음
+	first.DisplayName = "changed"
+
+	second, err := s.Account().GetByID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Empty(t, second.DisplayName)
+
+	stats := statsOf(s, CollectionAccounts)
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(1), stats.Misses)
+	assert.Equal(t, 0.5, stats.HitRatio)
+	assert.Equal(t, float64(1), testutil.ToFloat64(s.accounts.metrics.requests.WithLabelValues("accounts", "hit")))
+
+	// 캐시를 거친 쓰기는 즉시 반영
+	second.DisplayName = "Alice"
+	require.NoError(t, s.Account().Update(ctx, second))
+	updated, err := s.Account().GetByID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", updated.DisplayName)
+
+	count, err := testutil.GatherAndCount(reg, "aicli_storage_cache_requests_total")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestStorage_WorkspaceCache(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStorage(t, DefaultConfig())
+
+	ws := &models.Workspace{Name: "ws", ProjectPath: "/tmp/ws", OwnerID: "u1"}
+	require.NoError(t, s.Workspace().Create(ctx, ws))
+
+	_, err := s.Workspace().GetByID(ctx, ws.ID)
+	require.NoError(t, err)
+	require.NoError(t, s.Workspace().Update(ctx, ws.ID, map[string]interface{}{"name": "renamed"}))
+
+	got, err := s.Workspace().GetByID(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", got.Name)
+	assert.Equal(t, int64(2), statsOf(s, CollectionWorkspaces).Misses)
+
+	// 없는 항목은 캐시하지 않음
+	_, err = s.Workspace().GetByID(ctx, "missing")
+	assert.Error(t, err)
+	assert.Equal(t, 1, statsOf(s, CollectionWorkspaces).Entries)
+}
+
+func TestStorage_TTLAndInvalidate(t *testing.T) {
+	ctx := context.Background()
+	config := DefaultConfig()
+	config.AccountTTL = 20 * time.Millisecond
+	s, _ := newTestStorage(t, config)
+
+	require.NoError(t, s.Account().Create(ctx, &models.Account{ID: "u1", Username: "alice"}))
+	_, err := s.Account().GetByID(ctx, "u1")
+	require.NoError(t, err)
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = s.Account().GetByID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), statsOf(s, CollectionAccounts).Misses)
+
+	s.Invalidate(CollectionAccounts, "u1")
+	assert.Equal(t, 0, statsOf(s, CollectionAccounts).Entries)
+
+	// TTL이 0이면 캐시하지 않음
+	config.AccountTTL = 0
+	uncached, _ := newTestStorage(t, config)
+	require.NoError(t, uncached.Account().Create(ctx, &models.Account{ID: "u1", Username: "alice"}))
+	_, err = uncached.Account().GetByID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, Stats{Collection: CollectionAccounts}, statsOf(uncached, CollectionAccounts))
+}
+
+func TestStorage_RBACCache(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStorage(t, DefaultConfig())
+
+	require.NoError(t, s.RBAC().CreateRole(ctx, &models.Role{BaseModel: models.BaseModel{ID: "r1"}, Name: "editor"}))
+	require.NoError(t, s.RBAC().AssignRoleToUser(ctx, &models.UserRole{UserID: "u1", RoleID: "r1"}))
+
+	roles, err := s.RBAC().GetRolesByUserID(ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+
+	// 역할이 바뀌면 사용자 역할 목록도 다시 읽음
+	role, err := s.RBAC().GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+	role.Description = "can edit"
+	require.NoError(t, s.RBAC().UpdateRole(ctx, role))
+
+	roles, err = s.RBAC().GetRolesByUserID(ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Equal(t, "can edit", roles[0].Description)
+
+	// 역할을 회수하면 사용자 역할 목록을 지움
+	require.NoError(t, s.RBAC().RevokeRoleFromUser(ctx, "u1", "r1", nil))
+	roles, err = s.RBAC().GetRolesByUserID(ctx, "u1")
+	require.NoError(t, err)
+	assert.Empty(t, roles)
+}
+
+func TestCollection_MaxEntries(t *testing.T) {
+	c := newCollection(CollectionAccounts, time.Minute, 2, func(v int) int { return v }, newCacheMetrics(nil))
+	for i, key := range []string{"a", "b", "c"} {
+		value := i
+		_, err := c.get(key, func() (int, error) { return value, nil })
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, c.stats().Entries)
+}
@@ -0,0 +1,170 @@
+package cached
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// cacheMetrics 컬렉션별 캐시 메트릭
+type cacheMetrics struct {
+	requests      *prometheus.CounterVec
+	invalidations *prometheus.CounterVec
+	entries       *prometheus.GaugeVec
+}
+
+func newCacheMetrics(reg prometheus.Registerer) *cacheMetrics {
+	return &cacheMetrics{
+		requests: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "aicli_storage_cache_requests_total",
+			Help: "스토리지 조회 캐시 요청 수 (컬렉션, 결과별)",
+		}, []string{"collection", "result"})),
+		invalidations: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "aicli_storage_cache_invalidations_total",
+			Help: "스토리지 조회 캐시 무효화 수 (컬렉션별)",
+		}, []string{"collection"})),
+		entries: register(reg, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "aicli_storage_cache_entries",
+			Help: "스토리지 조회 캐시에 보관 중인 항목 수",
+		}, []string{"collection"})),
+	}
+}
+
+// register 메트릭 등록 (이미 등록되어 있으면 기존 메트릭 사용)
+func register[C prometheus.Collector](reg prometheus.Registerer, collector C) C {
+	if reg == nil {
+		return collector
+	}
+	if err := reg.Register(collector); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if errors.As(err, &registered) {
+			if existing, ok := registered.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+	}
+	return collector
+}
+
+type cacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// collection TTL이 있는 컬렉션 하나의 캐시
+// 무효화할 때마다 세대를 올려, 무효화 전에 시작한 조회 결과가 뒤늦게 저장되지 않도록 합니다.
+type collection[T any] struct {
+	name    Collection
+	ttl     time.Duration
+	max     int
+	clone   func(T) T
+	metrics *cacheMetrics
+
+	mu         sync.Mutex
+	entries    map[string]cacheEntry[T]
+	generation uint64
+	hits       int64
+	misses     int64
+}
+
+func newCollection[T any](name Collection, ttl time.Duration, max int, clone func(T) T, metrics *cacheMetrics) *collection[T] {
+	return &collection[T]{
+		name:    name,
+		ttl:     ttl,
+		max:     max,
+		clone:   clone,
+		metrics: metrics,
+		entries: make(map[string]cacheEntry[T]),
+	}
+}
+
+// get 캐시된 값을 반환하고, 없거나 만료되었으면 load로 읽어 저장합니다 (에러는 캐시하지 않음)
+func (c *collection[T]) get(key string, load func() (T, error)) (T, error) {
+	if c.ttl <= 0 {
+		return load()
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && time.Now().Before(entry.expiresAt) {
+		c.hits++
+		c.mu.Unlock()
+		c.metrics.requests.WithLabelValues(string(c.name), "hit").Inc()
+		return c.clone(entry.value), nil
+	}
+	if ok {
+		delete(c.entries, key)
+	}
+	c.misses++
+	generation := c.generation
+	c.mu.Unlock()
+	c.metrics.requests.WithLabelValues(string(c.name), "miss").Inc()
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		if c.max > 0 && len(c.entries) >= c.max {
+			c.evictLocked()
+		}
+		c.entries[key] = cacheEntry[T]{value: c.clone(value), expiresAt: time.Now().Add(c.ttl)}
+	}
+	size := len(c.entries)
+	c.mu.Unlock()
+	c.metrics.entries.WithLabelValues(string(c.name)).Set(float64(size))
+
+	return value, nil
+}
+
+// evictLocked 만료된 항목을 지우고, 그래도 가득 차 있으면 가장 먼저 만료될 항목을 지웁니다
+func (c *collection[T]) evictLocked() {
+	now := time.Now()
+	oldestKey := ""
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.expiresAt.Before(oldest) {
+			oldestKey, oldest = key, entry.expiresAt
+		}
+	}
+	if len(c.entries) >= c.max && oldestKey != "" {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// invalidate 주어진 키를 지웁니다 (키가 없으면 컬렉션 전체)
+func (c *collection[T]) invalidate(keys ...string) {
+	c.mu.Lock()
+	c.generation++
+	if len(keys) == 0 {
+		c.entries = make(map[string]cacheEntry[T])
+	} else {
+		for _, key := range keys {
+			delete(c.entries, key)
+		}
+	}
+	size := len(c.entries)
+	c.mu.Unlock()
+
+	c.metrics.invalidations.WithLabelValues(string(c.name)).Inc()
+	c.metrics.entries.WithLabelValues(string(c.name)).Set(float64(size))
+}
+
+func (c *collection[T]) stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := Stats{Collection: c.name, Hits: c.hits, Misses: c.misses, Entries: len(c.entries)}
+	if total := c.hits + c.misses; total > 0 {
+		stats.HitRatio = float64(c.hits) / float64(total)
+	}
+	return stats
+}
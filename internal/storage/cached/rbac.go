@@ -0,0 +1,101 @@
+package cached
+
+import (
+	"context"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// rbacStorage 권한 검사에서 반복되는 역할/권한 조회를 캐시하는 RBAC 스토리지
+// 역할 하나가 여러 사용자의 역할 목록에 들어 있으므로 역할이 바뀌면 사용자 역할 목록을 모두 비웁니다.
+type rbacStorage struct {
+	storage.RBACStorage
+	cache *Storage
+}
+
+func (s *rbacStorage) GetRoleByID(ctx context.Context, roleID string) (*models.Role, error) {
+	return s.cache.roles.get(roleID, func() (*models.Role, error) {
+		return s.RBACStorage.GetRoleByID(ctx, roleID)
+	})
+}
+
+func (s *rbacStorage) GetRolesByUserID(ctx context.Context, userID string) ([]models.Role, error) {
+	return s.cache.userRoles.get(userID, func() ([]models.Role, error) {
+		return s.RBACStorage.GetRolesByUserID(ctx, userID)
+	})
+}
+
+func (s *rbacStorage) GetPermissionsByRoleID(ctx context.Context, roleID string) ([]models.Permission, error) {
+	return s.cache.rolePermissions.get(roleID, func() ([]models.Permission, error) {
+		return s.RBACStorage.GetPermissionsByRoleID(ctx, roleID)
+	})
+}
+
+func (s *rbacStorage) UpdateRole(ctx context.Context, role *models.Role) error {
+	err := s.RBACStorage.UpdateRole(ctx, role)
+	s.invalidateRole(role.ID)
+	return err
+}
+
+func (s *rbacStorage) DeleteRole(ctx context.Context, roleID string) error {
+	err := s.RBACStorage.DeleteRole(ctx, roleID)
+	s.invalidateRole(roleID)
+	return err
+}
+
+func (s *rbacStorage) UpdatePermission(ctx context.Context, permission *models.Permission) error {
+	err := s.RBACStorage.UpdatePermission(ctx, permission)
+	s.cache.rolePermissions.invalidate()
+	return err
+}
+
+func (s *rbacStorage) DeletePermission(ctx context.Context, permissionID string) error {
+	err := s.RBACStorage.DeletePermission(ctx, permissionID)
+	s.cache.rolePermissions.invalidate()
+	return err
+}
+
+func (s *rbacStorage) AssignPermissionToRole(ctx context.Context, roleID, permissionID string, effect models.PermissionEffect, conditions string) error {
+	err := s.RBACStorage.AssignPermissionToRole(ctx, roleID, permissionID, effect, conditions)
+	s.cache.rolePermissions.invalidate(roleID)
+	return err
+}
+
+func (s *rbacStorage) RevokePermissionFromRole(ctx context.Context, roleID, permissionID string) error {
+	err := s.RBACStorage.RevokePermissionFromRole(ctx, roleID, permissionID)
+	s.cache.rolePermissions.invalidate(roleID)
+	return err
+}
+
+func (s *rbacStorage) UpdateRolePermission(ctx context.Context, roleID, permissionID string, effect models.PermissionEffect, conditions string) error {
+	err := s.RBACStorage.UpdateRolePermission(ctx, roleID, permissionID, effect, conditions)
+	s.cache.rolePermissions.invalidate(roleID)
+	return err
+}
+
+func (s *rbacStorage) AssignRoleToUser(ctx context.Context, userRole *models.UserRole) error {
+	err := s.RBACStorage.AssignRoleToUser(ctx, userRole)
+	s.cache.userRoles.invalidate(userRole.UserID)
+	return err
+}
+
+func (s *rbacStorage) RevokeRoleFromUser(ctx context.Context, userID, roleID string, resourceID *string) error {
+	err := s.RBACStorage.RevokeRoleFromUser(ctx, userID, roleID, resourceID)
+	s.cache.userRoles.invalidate(userID)
+	return err
+}
+
+func (s *rbacStorage) UpdateUserRole(ctx context.Context, userID, roleID string, resourceID *string, expiresAt *time.Time, isActive bool) error {
+	err := s.RBACStorage.UpdateUserRole(ctx, userID, roleID, resourceID, expiresAt, isActive)
+	s.cache.userRoles.invalidate(userID)
+	return err
+}
+
+// invalidateRole 역할과 그 역할의 권한, 역할을 포함할 수 있는 사용자 역할 목록을 지웁니다
+func (s *rbacStorage) invalidateRole(roleID string) {
+	s.cache.roles.invalidate(roleID)
+	s.cache.rolePermissions.invalidate(roleID)
+	s.cache.userRoles.invalidate()
+}
@@ -0,0 +1,32 @@
+package cached
+
+import (
+	"context"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// workspaceStorage ID 조회를 캐시하는 워크스페이스 스토리지
+type workspaceStorage struct {
+	storage.WorkspaceStorage
+	cache *Storage
+}
+
+func (s *workspaceStorage) GetByID(ctx context.Context, id string) (*models.Workspace, error) {
+	return s.cache.workspaces.get(id, func() (*models.Workspace, error) {
+		return s.WorkspaceStorage.GetByID(ctx, id)
+	})
+}
+
+func (s *workspaceStorage) Update(ctx context.Context, id string, updates map[string]interface{}) error {
+	err := s.WorkspaceStorage.Update(ctx, id, updates)
+	s.cache.workspaces.invalidate(id)
+	return err
+}
+
+func (s *workspaceStorage) Delete(ctx context.Context, id string) error {
+	err := s.WorkspaceStorage.Delete(ctx, id)
+	s.cache.workspaces.invalidate(id)
+	return err
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -36,8 +37,57 @@ var (
 	
 	// ErrCorruptedData 손상된 데이터
 	ErrCorruptedData = fmt.Errorf("데이터가 손상되었습니다")
+	
+	// ErrVersionConflict 다른 요청이 먼저 수정해 버전이 맞지 않음
+	ErrVersionConflict = fmt.Errorf("version conflict")
 )
 
+// VersionKey Update의 updates 맵에 이 키로 읽어 온 버전을 넣으면
+// 저장된 버전이 같을 때만 수정하고, 다르면 ConflictError를 반환합니다 (compare-and-swap).
+const VersionKey = "version"
+
+// ConflictError 오래된 버전을 기준으로 수정하려 할 때 반환되는 에러
+type ConflictError struct {
+	Resource        string // workspace, role, permission 등
+	ID              string
+	ExpectedVersion int // 요청이 기준으로 삼은 버전
+	CurrentVersion  int // 저장된 최신 버전
+}
+
+// Error 에러 메시지 반환
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s %s 버전 충돌: 요청 버전 %d, 현재 버전 %d", e.Resource, e.ID, e.ExpectedVersion, e.CurrentVersion)
+}
+
+// Is ErrVersionConflict와 비교
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrVersionConflict
+}
+
+// ExpectedVersion updates 맵에서 기대 버전을 꺼냅니다 (없거나 0 이하면 검사하지 않음)
+func ExpectedVersion(updates map[string]interface{}) (int, bool) {
+	var version int
+	switch v := updates[VersionKey].(type) {
+	case int:
+		version = v
+	case int64:
+		version = int(v)
+	case float64:
+		version = int(v)
+	default:
+		return 0, false
+	}
+	return version, version > 0
+}
+
+// CheckVersion 기대 버전이 있으면 현재 버전과 비교합니다
+func CheckVersion(resource, id string, expected, current int) error {
+	if expected > 0 && expected != current {
+		return &ConflictError{Resource: resource, ID: id, ExpectedVersion: expected, CurrentVersion: current}
+	}
+	return nil
+}
+
 // StorageError 스토리지 에러 래퍼
 type StorageError struct {
 	Operation string
@@ -124,6 +174,11 @@ func ConvertError(err error, operation, storageType string) error {
 		return convertBoltDBError(err, operation, errMsg)
 	}
 	
+	// PostgreSQL 에러 변환
+	if storageType == "postgres" {
+		return convertPostgresError(err, operation, errMsg)
+	}
+	
 	// 기본 에러 처리
 	return NewStorageError(operation, storageType, err)
 }
@@ -150,6 +205,28 @@ func convertSQLiteError(err error, operation, errMsg string) error {
 	}
 }
 
+// convertPostgresError PostgreSQL 에러 변환 (드라이버와 무관하게 메시지의 SQLSTATE 코드와 문구로 판단)
+func convertPostgresError(err error, operation, errMsg string) error {
+	storageErr := NewStorageError(operation, "postgres", err)
+	
+	switch {
+	case strings.Contains(errMsg, "23505") || strings.Contains(errMsg, "duplicate key"):
+		return storageErr.WithDetail("type", "unique_constraint")
+	case strings.Contains(errMsg, "23503") || strings.Contains(errMsg, "foreign key constraint"):
+		return storageErr.WithDetail("type", "foreign_key_constraint")
+	case strings.Contains(errMsg, "42p01"):
+		return storageErr.WithDetail("type", "table_not_found")
+	case strings.Contains(errMsg, "42703"):
+		return storageErr.WithDetail("type", "column_not_found")
+	case strings.Contains(errMsg, "42601"):
+		return storageErr.WithDetail("type", "syntax_error")
+	case strings.Contains(errMsg, "40001") || strings.Contains(errMsg, "40p01"):
+		return storageErr.WithDetail("type", "rollback")
+	default:
+		return storageErr
+	}
+}
+
 // convertBoltDBError BoltDB 에러 변환
 func convertBoltDBError(err error, operation, errMsg string) error {
 	storageErr := NewStorageError(operation, "boltdb", err)
@@ -222,6 +299,11 @@ func IsAlreadyExistsError(err error) bool {
 		   strings.Contains(errMsg, "unique constraint")
 }
 
+// IsConflictError 버전 충돌 에러인지 확인
+func IsConflictError(err error) bool {
+	return errors.Is(err, ErrVersionConflict)
+}
+
 // IsConnectionError 연결 에러인지 확인
 func IsConnectionError(err error) bool {
 	if err == nil {
@@ -20,6 +20,9 @@ const (
 	
 	// StorageTypeBoltDB BoltDB 기반 스토리지
 	StorageTypeBoltDB StorageType = "boltdb"
+	
+	// StorageTypePostgres PostgreSQL 기반 스토리지 (여러 인스턴스가 공유하는 팀 배포용)
+	StorageTypePostgres StorageType = "postgres"
 )
 
 // StorageConfig 스토리지 설정 구조체
@@ -89,6 +92,9 @@ func (f *DefaultStorageFactory) Create(config StorageConfig) (Storage, error) {
 		return f.createSQLiteStorage(config)
 	case StorageTypeBoltDB:
 		return f.createBoltDBStorage(config)
+	case StorageTypePostgres:
+		// PostgreSQL 스토리지는 순환 의존성 회피를 위해 여기서 직접 생성하지 않습니다
+		return nil, fmt.Errorf("PostgreSQL 스토리지는 postgres.New()를 사용하세요")
 	default:
 		return nil, fmt.Errorf("지원하지 않는 스토리지 타입: %s", config.Type)
 	}
@@ -138,7 +144,7 @@ func ValidateStorageConfig(config StorageConfig) error {
 		return fmt.Errorf("스토리지 타입이 지정되지 않았습니다")
 	}
 	
-	validTypes := []StorageType{StorageTypeMemory, StorageTypeSQLite, StorageTypeBoltDB}
+	validTypes := []StorageType{StorageTypeMemory, StorageTypeSQLite, StorageTypeBoltDB, StorageTypePostgres}
 	isValidType := false
 	for _, validType := range validTypes {
 		if config.Type == validType {
@@ -151,8 +157,8 @@ func ValidateStorageConfig(config StorageConfig) error {
 		return fmt.Errorf("지원하지 않는 스토리지 타입: %s", config.Type)
 	}
 	
-	// 파일 기반 스토리지는 데이터 소스가 필요
-	if (config.Type == StorageTypeSQLite || config.Type == StorageTypeBoltDB) && config.DataSource == "" {
+	// 파일 기반 스토리지와 PostgreSQL은 데이터 소스가 필요
+	if (config.Type == StorageTypeSQLite || config.Type == StorageTypeBoltDB || config.Type == StorageTypePostgres) && config.DataSource == "" {
 		return fmt.Errorf("%s 스토리지는 데이터 소스가 필요합니다", config.Type)
 	}
 	
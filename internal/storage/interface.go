@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 	
 	"github.com/aicli/aicli-web/internal/models"
 )
@@ -23,7 +24,8 @@ type WorkspaceStorage interface {
 	// CountByOwner 소유자별 워크스페이스 개수 조회
 	CountByOwner(ctx context.Context, ownerID string) (int, error)
 	
-	// Update 워크스페이스 업데이트
+	// Update 워크스페이스 업데이트 (수정할 때마다 버전 증가)
+	// updates에 VersionKey로 기대 버전을 넣으면 저장된 버전이 다를 때 ConflictError를 반환합니다.
 	Update(ctx context.Context, id string, updates map[string]interface{}) error
 	
 	// Delete 워크스페이스 삭제 (soft delete)
@@ -103,6 +105,475 @@ type TaskStorage interface {
 	
 	// GetActiveCount 활성 태스크 수 조회
 	GetActiveCount(ctx context.Context, sessionID string) (int64, error)
+	
+	// ListExecutedBetween from 초과 to 이하에 끝났거나 to 시점에 실행 중이던 태스크 조회 (사용량 집계용, 종료 시각순)
+	ListExecutedBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error)
+}
+
+// MessageStorage 세션 대화 기록 스토리지 인터페이스
+type MessageStorage interface {
+	// Append 세션에 메시지 추가 (ID, 순서, 생성 시각 자동 설정)
+	Append(ctx context.Context, message *models.SessionMessage) error
+	
+	// ListBySession 세션의 메시지를 순서대로 조회
+	ListBySession(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionMessage, int, error)
+	
+	// Search 메시지 내용 전문 검색 (최신순)
+	Search(ctx context.Context, query *models.MessageSearchQuery, paging *models.PaginationRequest) ([]*models.MessageSearchResult, int, error)
+	
+	// DeleteBySession 세션의 메시지 전체 삭제
+	DeleteBySession(ctx context.Context, sessionID string) error
+}
+
+// ShareLinkStorage 세션 공유 링크 스토리지 인터페이스
+type ShareLinkStorage interface {
+	// Create 새 공유 링크 생성
+	Create(ctx context.Context, link *models.SessionShareLink) error
+	
+	// GetByID ID로 공유 링크 조회
+	GetByID(ctx context.Context, id string) (*models.SessionShareLink, error)
+	
+	// GetByTokenHash 토큰 해시로 공유 링크 조회
+	GetByTokenHash(ctx context.Context, tokenHash string) (*models.SessionShareLink, error)
+	
+	// ListBySession 세션의 공유 링크 목록 조회 (최신순)
+	ListBySession(ctx context.Context, sessionID string) ([]*models.SessionShareLink, error)
+	
+	// Revoke 공유 링크 폐기
+	Revoke(ctx context.Context, id string, revokedAt time.Time) error
+	
+	// RecordJoin 공유 링크 참여 기록 추가 (링크 참여 횟수 증가)
+	RecordJoin(ctx context.Context, join *models.SessionShareJoin) error
+	
+	// ListJoins 세션의 공유 링크 참여 기록 조회 (최신순)
+	ListJoins(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionShareJoin, int, error)
+	
+	// ListJoinsByUser 사용자의 공유 링크 참여 기록 조회 (최신순)
+	ListJoinsByUser(ctx context.Context, userID string) ([]*models.SessionShareJoin, error)
+	
+	// AnonymizeJoins 사용자의 참여 기록에서 표시 이름, 접속 IP, User-Agent를 지우고 수정한 수 반환
+	AnonymizeJoins(ctx context.Context, userID string) (int, error)
+}
+
+// ProcessRecordStorage 실행 중인 CLI 프로세스 기록 스토리지 인터페이스
+type ProcessRecordStorage interface {
+	// Save 프로세스 기록 저장 (같은 인스턴스와 PID의 기록은 덮어씀)
+	Save(ctx context.Context, record *models.ProcessRecord) error
+	
+	// Delete 프로세스 기록 삭제
+	Delete(ctx context.Context, instanceID string, pid int) error
+	
+	// ListByInstance 인스턴스의 프로세스 기록 조회 (시작 시각순)
+	ListByInstance(ctx context.Context, instanceID string) ([]*models.ProcessRecord, error)
+	
+	// ListInstances 프로세스 기록이 남아 있는 인스턴스 ID 목록 (정렬됨)
+	ListInstances(ctx context.Context) ([]string, error)
+}
+
+// ErrorStatsStorage 에러 통계 집계 스토리지 인터페이스
+type ErrorStatsStorage interface {
+	// Increment 집계 구간별 발생 횟수 누적 (같은 구간과 유형의 기록은 더함)
+	Increment(ctx context.Context, buckets []*models.ErrorStatBucket) error
+	
+	// List 기간 안의 집계 조회 (from 이상 to 미만, 구간 시작 시각순)
+	List(ctx context.Context, granularity models.ErrorStatGranularity, from, to time.Time) ([]*models.ErrorStatBucket, error)
+	
+	// DeleteBefore 기준 시각 이전 구간 삭제 후 삭제한 수 반환
+	DeleteBefore(ctx context.Context, granularity models.ErrorStatGranularity, before time.Time) (int64, error)
+}
+
+// AccountStorage 로컬 계정 스토리지 인터페이스
+type AccountStorage interface {
+	// Create 새 계정 생성 (사용자명이나 이메일이 중복되면 에러)
+	Create(ctx context.Context, account *models.Account) error
+	
+	// GetByID ID로 계정 조회
+	GetByID(ctx context.Context, id string) (*models.Account, error)
+	
+	// GetByUsername 사용자명으로 계정 조회 (대소문자 무시)
+	GetByUsername(ctx context.Context, username string) (*models.Account, error)
+	
+	// GetByEmail 이메일로 계정 조회 (대소문자 무시)
+	GetByEmail(ctx context.Context, email string) (*models.Account, error)
+	
+	// Update 계정 정보 저장 (사용자명이나 이메일이 다른 계정과 중복되면 에러)
+	Update(ctx context.Context, account *models.Account) error
+	
+	// Count 전체 계정 수
+	Count(ctx context.Context) (int, error)
+	
+	// ListByRole 역할이 같은 활성 계정 조회 (사용자명순)
+	ListByRole(ctx context.Context, role string) ([]*models.Account, error)
+	
+	// CreateResetToken 비밀번호 재설정 토큰 저장
+	CreateResetToken(ctx context.Context, token *models.AccountResetToken) error
+	
+	// GetResetToken 토큰 해시로 재설정 토큰 조회
+	GetResetToken(ctx context.Context, tokenHash string) (*models.AccountResetToken, error)
+	
+	// UseResetToken 재설정 토큰 사용 처리 (없거나 이미 사용된 토큰이면 ErrNotFound)
+	UseResetToken(ctx context.Context, tokenHash string, usedAt time.Time) error
+}
+
+// EmailDeliveryStorage 메일 발송 기록 스토리지 인터페이스
+type EmailDeliveryStorage interface {
+	// Create 발송 기록 저장
+	Create(ctx context.Context, delivery *models.EmailDelivery) error
+	
+	// GetByID ID로 발송 기록 조회
+	GetByID(ctx context.Context, id string) (*models.EmailDelivery, error)
+	
+	// Update 발송 상태 저장
+	Update(ctx context.Context, delivery *models.EmailDelivery) error
+	
+	// List 조건에 맞는 발송 기록 조회 (최신순)
+	List(ctx context.Context, filter *models.EmailDeliveryFilter) ([]*models.EmailDelivery, error)
+	
+	// ListDue 발송할 때가 된 대기 기록 조회 (다음 시도 시각순)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*models.EmailDelivery, error)
+}
+
+// WorkspaceACLStorage 워크스페이스 공유 ACL 스토리지 인터페이스
+type WorkspaceACLStorage interface {
+	// Upsert 워크스페이스와 대상이 같은 항목이 있으면 권한을 바꾸고 없으면 추가
+	Upsert(ctx context.Context, entry *models.WorkspaceACLEntry) error
+	
+	// Delete 항목 삭제 (없으면 ErrNotFound)
+	Delete(ctx context.Context, workspaceID string, principalType models.ACLPrincipalType, principalID string) error
+	
+	// DeleteByWorkspace 워크스페이스의 모든 항목 삭제
+	DeleteByWorkspace(ctx context.Context, workspaceID string) error
+	
+	// ListByWorkspace 워크스페이스의 항목 조회 (대상 종류, 대상 ID순)
+	ListByWorkspace(ctx context.Context, workspaceID string) ([]*models.WorkspaceACLEntry, error)
+	
+	// ListByPrincipals 대상 중 하나에게 부여된 모든 항목 조회
+	ListByPrincipals(ctx context.Context, principals []models.ACLPrincipal) ([]*models.WorkspaceACLEntry, error)
+}
+
+// PromptStorage 프롬프트 라이브러리 스토리지 인터페이스
+type PromptStorage interface {
+	// Create 새 프롬프트 생성 (같은 작성자에게 같은 이름이 있으면 에러)
+	Create(ctx context.Context, prompt *models.Prompt) error
+	
+	// GetByID ID로 프롬프트 조회
+	GetByID(ctx context.Context, id string) (*models.Prompt, error)
+	
+	// Update 프롬프트 저장 (같은 작성자에게 같은 이름이 있으면 에러)
+	Update(ctx context.Context, prompt *models.Prompt) error
+	
+	// Delete 프롬프트 삭제 (없으면 ErrNotFound)
+	Delete(ctx context.Context, id string) error
+	
+	// List 조건에 맞는 프롬프트 조회 (이름순)
+	List(ctx context.Context, filter *models.PromptFilter) ([]*models.Prompt, error)
+}
+
+// PipelineStorage 파이프라인과 파이프라인 실행 스토리지 인터페이스
+type PipelineStorage interface {
+	// Create 새 파이프라인 생성 (같은 작성자에게 같은 이름이 있으면 에러)
+	Create(ctx context.Context, pipeline *models.Pipeline) error
+	
+	// GetByID ID로 파이프라인 조회
+	GetByID(ctx context.Context, id string) (*models.Pipeline, error)
+	
+	// Update 파이프라인 저장 (같은 작성자에게 같은 이름이 있으면 에러)
+	Update(ctx context.Context, pipeline *models.Pipeline) error
+	
+	// Delete 파이프라인 삭제 (없으면 ErrNotFound, 실행 기록은 유지)
+	Delete(ctx context.Context, id string) error
+	
+	// ListByOwner 작성자의 파이프라인 조회 (이름순)
+	ListByOwner(ctx context.Context, ownerID string) ([]*models.Pipeline, error)
+	
+	// CreateRun 새 파이프라인 실행 생성
+	CreateRun(ctx context.Context, run *models.PipelineRun) error
+	
+	// GetRun ID로 파이프라인 실행 조회
+	GetRun(ctx context.Context, id string) (*models.PipelineRun, error)
+	
+	// UpdateRun 파이프라인 실행 저장
+	UpdateRun(ctx context.Context, run *models.PipelineRun) error
+	
+	// ListRuns 조건에 맞는 파이프라인 실행 조회 (최신순)
+	ListRuns(ctx context.Context, filter *models.PipelineRunFilter) ([]*models.PipelineRun, error)
+}
+
+// FanOutStorage 워크스페이스 팬아웃 실행 스토리지 인터페이스
+type FanOutStorage interface {
+	// Create 새 팬아웃 생성
+	Create(ctx context.Context, fanOut *models.FanOut) error
+	
+	// GetByID ID로 팬아웃 조회
+	GetByID(ctx context.Context, id string) (*models.FanOut, error)
+	
+	// Update 팬아웃 저장 (없으면 ErrNotFound)
+	Update(ctx context.Context, fanOut *models.FanOut) error
+	
+	// ListByOwner 사용자의 팬아웃 조회 (최신순, limit이 0이면 전체)
+	ListByOwner(ctx context.Context, ownerID string, limit int) ([]*models.FanOut, error)
+}
+
+// TaskReviewStorage 태스크 결과 검토 스토리지 인터페이스
+type TaskReviewStorage interface {
+	// Create 새 검토 생성 (태스크당 하나)
+	Create(ctx context.Context, review *models.TaskReview) error
+	
+	// GetByID ID로 검토 조회
+	GetByID(ctx context.Context, id string) (*models.TaskReview, error)
+	
+	// GetByTaskID 태스크의 검토 조회
+	GetByTaskID(ctx context.Context, taskID string) (*models.TaskReview, error)
+	
+	// Update 검토 저장 (없으면 ErrNotFound)
+	Update(ctx context.Context, review *models.TaskReview) error
+	
+	// Delete 검토 삭제 (태스크 제출 실패 시)
+	Delete(ctx context.Context, id string) error
+	
+	// List 조건에 맞는 검토 조회 (최신순, Limit이 0이면 전체)
+	List(ctx context.Context, filter *models.TaskReviewFilter) ([]*models.TaskReview, error)
+	
+	// CreateComment 검토 의견 추가
+	CreateComment(ctx context.Context, comment *models.TaskReviewComment) error
+	
+	// GetComment 검토 의견 조회
+	GetComment(ctx context.Context, id string) (*models.TaskReviewComment, error)
+	
+	// UpdateComment 검토 의견 저장 (없으면 ErrNotFound)
+	UpdateComment(ctx context.Context, comment *models.TaskReviewComment) error
+	
+	// DeleteComment 검토 의견 삭제 (없으면 ErrNotFound)
+	DeleteComment(ctx context.Context, id string) error
+	
+	// ListComments 검토의 의견 조회 (파일, 줄, 작성 순)
+	ListComments(ctx context.Context, reviewID string) ([]*models.TaskReviewComment, error)
+}
+
+// WebhookMappingStorage 웹훅 매핑 스토리지 인터페이스
+type WebhookMappingStorage interface {
+	// Create 새 매핑 생성
+	Create(ctx context.Context, mapping *models.WebhookMapping) error
+	
+	// GetByID ID로 매핑 조회 (비밀 값 포함)
+	GetByID(ctx context.Context, id string) (*models.WebhookMapping, error)
+	
+	// Update 매핑 저장 (없으면 ErrNotFound)
+	Update(ctx context.Context, mapping *models.WebhookMapping) error
+	
+	// Delete 매핑 삭제 (없으면 ErrNotFound)
+	Delete(ctx context.Context, id string) error
+	
+	// ListByOwner 사용자의 매핑 조회 (이름순, ownerID가 비어 있으면 전체)
+	ListByOwner(ctx context.Context, ownerID string) ([]*models.WebhookMapping, error)
+	
+	// RecordDelivery 매핑이 처리한 전송 식별자 기록
+	// 식별자 중 하나라도 이미 기록되어 있으면 기록하지 않고 false를 반환합니다. since 이전 기록은 잊습니다.
+	RecordDelivery(ctx context.Context, mappingID string, keys []string, since time.Time) (bool, error)
+}
+
+// PullRequestStorage 워크스페이스 PR 설정과 태스크 PR 스토리지 인터페이스
+type PullRequestStorage interface {
+	// GetConfig 워크스페이스의 PR 설정 조회 (토큰 포함, 없으면 ErrNotFound)
+	GetConfig(ctx context.Context, workspaceID string) (*models.PullRequestConfig, error)
+	
+	// SaveConfig PR 설정 저장 (없으면 생성)
+	SaveConfig(ctx context.Context, config *models.PullRequestConfig) error
+	
+	// DeleteConfig PR 설정 삭제 (없으면 ErrNotFound)
+	DeleteConfig(ctx context.Context, workspaceID string) error
+	
+	// ListConfigWorkspaces PR 설정이 있는 워크스페이스 ID 목록
+	ListConfigWorkspaces(ctx context.Context) ([]string, error)
+	
+	// Create 새 PR 기록 생성 (태스크당 하나)
+	Create(ctx context.Context, pr *models.PullRequest) error
+	
+	// Update PR 기록 저장 (없으면 ErrNotFound)
+	Update(ctx context.Context, pr *models.PullRequest) error
+	
+	// GetByTaskID 태스크의 PR 조회
+	GetByTaskID(ctx context.Context, taskID string) (*models.PullRequest, error)
+	
+	// ListByWorkspace 워크스페이스의 PR 조회 (최신순)
+	ListByWorkspace(ctx context.Context, workspaceID string, limit int) ([]*models.PullRequest, error)
+}
+
+// IssueTrackerStorage 워크스페이스 이슈 트래커 설정과 이슈 연결 스토리지 인터페이스
+type IssueTrackerStorage interface {
+	// GetConfig 워크스페이스의 이슈 트래커 설정 조회 (토큰 포함, 없으면 ErrNotFound)
+	GetConfig(ctx context.Context, workspaceID string) (*models.IssueTrackerConfig, error)
+	
+	// SaveConfig 이슈 트래커 설정 저장 (없으면 생성)
+	SaveConfig(ctx context.Context, config *models.IssueTrackerConfig) error
+	
+	// DeleteConfig 이슈 트래커 설정 삭제 (없으면 ErrNotFound)
+	DeleteConfig(ctx context.Context, workspaceID string) error
+	
+	// ListConfigWorkspaces 이슈 트래커 설정이 있는 워크스페이스 ID 목록
+	ListConfigWorkspaces(ctx context.Context) ([]string, error)
+	
+	// CreateLink 새 이슈 연결 생성 (같은 세션/태스크에 같은 이슈는 한 번만)
+	CreateLink(ctx context.Context, link *models.IssueLink) error
+	
+	// UpdateLink 이슈 연결 저장 (없으면 ErrNotFound)
+	UpdateLink(ctx context.Context, link *models.IssueLink) error
+	
+	// DeleteLink 이슈 연결 삭제 (없으면 ErrNotFound)
+	DeleteLink(ctx context.Context, id string) error
+	
+	// GetLink ID로 이슈 연결 조회
+	GetLink(ctx context.Context, id string) (*models.IssueLink, error)
+	
+	// ListLinksBySession 세션의 이슈 연결 조회 (세션 연결과 태스크 연결 모두, 생성순)
+	ListLinksBySession(ctx context.Context, sessionID string) ([]*models.IssueLink, error)
+}
+
+// TerminalStorage 워크스페이스 터미널 접속 기록과 녹화 스토리지 인터페이스
+type TerminalStorage interface {
+	// Create 새 터미널 세션 기록 생성
+	Create(ctx context.Context, session *models.TerminalSession) error
+	
+	// Update 터미널 세션 기록 저장 (없으면 ErrNotFound)
+	Update(ctx context.Context, session *models.TerminalSession) error
+	
+	// GetByID ID로 터미널 세션 기록 조회
+	GetByID(ctx context.Context, id string) (*models.TerminalSession, error)
+	
+	// ListByWorkspace 워크스페이스의 터미널 세션 기록 조회 (최신순)
+	ListByWorkspace(ctx context.Context, workspaceID string, limit int) ([]*models.TerminalSession, error)
+	
+	// SaveRecording 녹화 데이터(asciicast v2) 저장 (기존 데이터를 덮어씀)
+	SaveRecording(ctx context.Context, id string, data []byte) error
+	
+	// GetRecording 녹화 데이터 조회 (녹화가 없으면 ErrNotFound)
+	GetRecording(ctx context.Context, id string) ([]byte, error)
+	
+	// ListByUser 사용자의 터미널 세션 기록 조회 (최신순)
+	ListByUser(ctx context.Context, userID string, limit int) ([]*models.TerminalSession, error)
+	
+	// AnonymizeByUser 사용자의 기록에서 접속 IP, User-Agent와 녹화를 지우고 수정한 수 반환
+	AnonymizeByUser(ctx context.Context, userID string) (int, error)
+}
+
+// TaskArtifactStorage 태스크 결과물(코드 블록, 파일, 테스트 보고서) 스토리지 인터페이스
+type TaskArtifactStorage interface {
+	// ReplaceByTask 태스크의 결과물을 모두 교체 (ID, 생성 시각 자동 설정, 목록 순서 유지)
+	ReplaceByTask(ctx context.Context, taskID string, artifacts []*models.TaskArtifact) error
+	
+	// ListByTask 태스크의 결과물을 추출 순서대로 조회 (artifactType이 비어 있으면 전체)
+	ListByTask(ctx context.Context, taskID string, artifactType models.TaskArtifactType) ([]*models.TaskArtifact, error)
+}
+
+// PrivacyRequestStorage 개인정보 내보내기/삭제 처리 기록 스토리지 인터페이스
+type PrivacyRequestStorage interface {
+	// Create 처리 기록 추가 (ID, 생성 시각 자동 설정)
+	Create(ctx context.Context, request *models.PrivacyRequest) error
+	
+	// List 조건에 맞는 처리 기록 조회 (최신순)
+	List(ctx context.Context, filter *models.PrivacyRequestFilter) ([]*models.PrivacyRequest, error)
+}
+
+// SessionCommandStorage 세션 셸 명령 실행 기록 스토리지 인터페이스
+type SessionCommandStorage interface {
+	// CreateBatch 실행 순서대로 기록 추가 (ID, 생성 시각 자동 설정)
+	CreateBatch(ctx context.Context, commands []*models.SessionCommand) error
+	
+	// ListBySession 세션의 실행 기록을 실행 순서대로 조회
+	ListBySession(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionCommand, int, error)
+}
+
+// ActivityStorage 워크스페이스/사용자 활동 피드와 읽음 표시 스토리지 인터페이스
+type ActivityStorage interface {
+	// Create 활동 추가 (ID, 생성 시각 자동 설정)
+	Create(ctx context.Context, activity *models.Activity) error
+	
+	// List 조건에 맞는 활동 조회 (최신순)
+	List(ctx context.Context, filter *models.ActivityFilter, paging *models.PaginationRequest) ([]*models.Activity, int, error)
+	
+	// GetReadMarker 사용자가 피드를 읽음으로 표시한 시각 (표시한 적 없으면 nil)
+	GetReadMarker(ctx context.Context, userID, feed string) (*time.Time, error)
+	
+	// SetReadMarker 사용자가 피드를 읽음으로 표시한 시각 저장 (기존 값을 덮어씀)
+	SetReadMarker(ctx context.Context, userID, feed string, readAt time.Time) error
+}
+
+// NotificationStorage 알림 센터 알림과 사용자별 알림 설정 스토리지 인터페이스
+type NotificationStorage interface {
+	// Create 알림 추가 (ID, 생성 시각 자동 설정)
+	Create(ctx context.Context, notification *models.Notification) error
+	
+	// List 조건에 맞는 알림 조회 (최신순)
+	List(ctx context.Context, filter *models.NotificationFilter, paging *models.PaginationRequest) ([]*models.Notification, int, error)
+	
+	// MarkRead 사용자의 안 읽은 알림을 읽음으로 표시하고 표시한 수 반환 (ids가 비어 있으면 전체)
+	MarkRead(ctx context.Context, userID string, ids []string, readAt time.Time) (int, error)
+	
+	// ListDigestPending 요약 메일을 기다리는 알림 조회 (사용자, 생성 시각순)
+	ListDigestPending(ctx context.Context, limit int) ([]*models.Notification, error)
+	
+	// ClearDigestPending 요약 메일로 보낸 알림 표시
+	ClearDigestPending(ctx context.Context, ids []string) error
+	
+	// GetPreferences 사용자가 저장한 알림 설정 (저장하지 않은 종류는 없음)
+	GetPreferences(ctx context.Context, userID string) ([]*models.NotificationPreference, error)
+	
+	// SetPreference 알림 종류 하나의 설정 저장 (기존 값을 덮어씀)
+	SetPreference(ctx context.Context, userID string, preference *models.NotificationPreference) error
+}
+
+// AnalyticsStorage 사용량 분석 일별 롤업 스토리지 인터페이스
+type AnalyticsStorage interface {
+	// Apply 집계 증분과 워터마크를 함께 반영
+	Apply(ctx context.Context, rollup *models.AnalyticsRollup) error
+	
+	// Watermark 마지막 집계에 반영된 시각 (집계한 적 없으면 nil)
+	Watermark(ctx context.Context) (*time.Time, error)
+	
+	// ListDays 기간 안의 일별 집계 조회 (from 이상 to 미만, 날짜순)
+	ListDays(ctx context.Context, from, to time.Time) ([]*models.AnalyticsDay, error)
+	
+	// TopWorkspaces 기간 동안 비용이 많이 든 워크스페이스 (비용, 태스크 수 내림차순)
+	TopWorkspaces(ctx context.Context, from, to time.Time, limit int) ([]*models.AnalyticsWorkspaceUsage, error)
+	
+	// CountActiveUsers 기간 동안 태스크를 실행한 사용자 수 (중복 제외)
+	CountActiveUsers(ctx context.Context, from, to time.Time) (int64, error)
+}
+
+// WorkspaceEnvStorage 워크스페이스 환경 변수와 변경 기록 스토리지 인터페이스
+type WorkspaceEnvStorage interface {
+	// Create 변수 추가 (같은 이름이 있으면 ErrAlreadyExists)
+	Create(ctx context.Context, variable *models.WorkspaceEnvVar) error
+	
+	// Get 변수 조회 (없으면 ErrNotFound)
+	Get(ctx context.Context, workspaceID, key string) (*models.WorkspaceEnvVar, error)
+	
+	// Update 변수의 값, 비밀 여부, 변경한 사용자 저장 (없으면 ErrNotFound)
+	Update(ctx context.Context, variable *models.WorkspaceEnvVar) error
+	
+	// Delete 변수 삭제 (없으면 ErrNotFound)
+	Delete(ctx context.Context, workspaceID, key string) error
+	
+	// DeleteByWorkspace 워크스페이스의 모든 변수 삭제 (변경 기록은 남김)
+	DeleteByWorkspace(ctx context.Context, workspaceID string) error
+	
+	// List 워크스페이스의 변수 조회 (이름순)
+	List(ctx context.Context, workspaceID string) ([]*models.WorkspaceEnvVar, error)
+	
+	// AddChange 변경 기록 추가
+	AddChange(ctx context.Context, change *models.WorkspaceEnvChange) error
+	
+	// ListChanges 워크스페이스의 변경 기록 조회 (key가 비어 있지 않으면 해당 변수만, 최신순)
+	ListChanges(ctx context.Context, workspaceID, key string, limit int) ([]*models.WorkspaceEnvChange, error)
+}
+
+// UserPreferenceStorage 사용자별 언어 설정 스토리지 인터페이스
+type UserPreferenceStorage interface {
+	// Get 사용자 설정 조회 (저장한 적이 없으면 ErrNotFound)
+	Get(ctx context.Context, userID string) (*models.UserPreference, error)
+	
+	// Save 사용자 설정 저장 (없으면 추가, 있으면 덮어씀)
+	Save(ctx context.Context, preference *models.UserPreference) error
 }
 
 // RBACStorage는 rbac.go에서 정의됨
@@ -124,6 +595,75 @@ type Storage interface {
 	// RBAC RBAC 스토리지 반환
 	RBAC() RBACStorage
 	
+	// Message 세션 대화 기록 스토리지 반환
+	Message() MessageStorage
+	
+	// ShareLink 세션 공유 링크 스토리지 반환
+	ShareLink() ShareLinkStorage
+	
+	// Process 실행 중인 CLI 프로세스 기록 스토리지 반환
+	Process() ProcessRecordStorage
+	
+	// ErrorStats 에러 통계 집계 스토리지 반환
+	ErrorStats() ErrorStatsStorage
+	
+	// Account 로컬 계정 스토리지 반환
+	Account() AccountStorage
+	
+	// EmailDelivery 메일 발송 기록 스토리지 반환
+	EmailDelivery() EmailDeliveryStorage
+	
+	// WorkspaceACL 워크스페이스 공유 ACL 스토리지 반환
+	WorkspaceACL() WorkspaceACLStorage
+	
+	// Prompt 프롬프트 라이브러리 스토리지 반환
+	Prompt() PromptStorage
+	
+	// Pipeline 파이프라인 스토리지 반환
+	Pipeline() PipelineStorage
+	
+	// FanOut 워크스페이스 팬아웃 스토리지 반환
+	FanOut() FanOutStorage
+	
+	// TaskReview 태스크 결과 검토 스토리지 반환
+	TaskReview() TaskReviewStorage
+	
+	// WebhookMapping 웹훅 매핑 스토리지 반환
+	WebhookMapping() WebhookMappingStorage
+	
+	// PullRequest 워크스페이스 PR 설정과 태스크 PR 스토리지 반환
+	PullRequest() PullRequestStorage
+	
+	// IssueTracker 워크스페이스 이슈 트래커 설정과 이슈 연결 스토리지 반환
+	IssueTracker() IssueTrackerStorage
+	
+	// Terminal 워크스페이스 터미널 접속 기록과 녹화 스토리지 반환
+	Terminal() TerminalStorage
+	
+	// TaskArtifact 태스크 결과물 스토리지 반환
+	TaskArtifact() TaskArtifactStorage
+	
+	// PrivacyRequest 개인정보 내보내기/삭제 처리 기록 스토리지 반환
+	PrivacyRequest() PrivacyRequestStorage
+	
+	// SessionCommand 세션 셸 명령 실행 기록 스토리지 반환
+	SessionCommand() SessionCommandStorage
+	
+	// Activity 활동 피드 스토리지 반환
+	Activity() ActivityStorage
+	
+	// Notification 알림 센터 스토리지 반환
+	Notification() NotificationStorage
+	
+	// Analytics 사용량 분석 롤업 스토리지 반환
+	Analytics() AnalyticsStorage
+	
+	// WorkspaceEnv 워크스페이스 환경 변수 스토리지 반환
+	WorkspaceEnv() WorkspaceEnvStorage
+	
+	// UserPreference 사용자 언어 설정 스토리지 반환
+	UserPreference() UserPreferenceStorage
+	
 	// Close 스토리지 연결 종료
 	Close() error
 }
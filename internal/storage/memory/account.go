@@ -0,0 +1,199 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// accountStorage 메모리 기반 로컬 계정 스토리지
+type accountStorage struct {
+	accounts    map[string]*models.Account           // 계정 ID별 계정
+	resetTokens map[string]*models.AccountResetToken // 토큰 해시별 재설정 토큰
+	mutex       sync.RWMutex
+}
+
+// storage.AccountStorage 인터페이스 구현 확인
+var _ storage.AccountStorage = (*accountStorage)(nil)
+
+// newAccountStorage 새 계정 스토리지 생성
+func newAccountStorage() *accountStorage {
+	return &accountStorage{
+		accounts:    make(map[string]*models.Account),
+		resetTokens: make(map[string]*models.AccountResetToken),
+	}
+}
+
+// Create 새 계정 생성
+func (as *accountStorage) Create(ctx context.Context, account *models.Account) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	if account.ID == "" {
+		account.ID = uuid.New().String()
+	}
+	now := time.Now()
+	if account.CreatedAt.IsZero() {
+		account.CreatedAt = now
+	}
+	account.UpdatedAt = account.CreatedAt
+
+	if _, exists := as.accounts[account.ID]; exists {
+		return ErrAlreadyExists
+	}
+	for _, existing := range as.accounts {
+		if strings.EqualFold(existing.Username, account.Username) ||
+			(account.Email != "" && strings.EqualFold(existing.Email, account.Email)) {
+			return ErrAlreadyExists
+		}
+	}
+
+	accountCopy := *account
+	as.accounts[account.ID] = &accountCopy
+	return nil
+}
+
+// GetByID ID로 계정 조회
+func (as *accountStorage) GetByID(ctx context.Context, id string) (*models.Account, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	account, exists := as.accounts[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	accountCopy := *account
+	return &accountCopy, nil
+}
+
+// GetByUsername 사용자명으로 계정 조회
+func (as *accountStorage) GetByUsername(ctx context.Context, username string) (*models.Account, error) {
+	return as.find(func(account *models.Account) bool {
+		return strings.EqualFold(account.Username, username)
+	})
+}
+
+// GetByEmail 이메일로 계정 조회
+func (as *accountStorage) GetByEmail(ctx context.Context, email string) (*models.Account, error) {
+	if email == "" {
+		return nil, storage.ErrNotFound
+	}
+	return as.find(func(account *models.Account) bool {
+		return strings.EqualFold(account.Email, email)
+	})
+}
+
+// Update 계정 정보 저장
+func (as *accountStorage) Update(ctx context.Context, account *models.Account) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	if _, exists := as.accounts[account.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	for id, existing := range as.accounts {
+		if id == account.ID {
+			continue
+		}
+		if strings.EqualFold(existing.Username, account.Username) {
+			return ErrAlreadyExists
+		}
+		if account.Email != "" && strings.EqualFold(existing.Email, account.Email) {
+			return ErrAlreadyExists
+		}
+	}
+
+	account.UpdatedAt = time.Now()
+	accountCopy := *account
+	as.accounts[account.ID] = &accountCopy
+	return nil
+}
+
+// Count 전체 계정 수
+func (as *accountStorage) Count(ctx context.Context) (int, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	return len(as.accounts), nil
+}
+
+// ListByRole 역할이 같은 활성 계정 조회 (사용자명순)
+func (as *accountStorage) ListByRole(ctx context.Context, role string) ([]*models.Account, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	accounts := []*models.Account{}
+	for _, account := range as.accounts {
+		if account.Role != role || !account.IsActive {
+			continue
+		}
+		accountCopy := *account
+		accounts = append(accounts, &accountCopy)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Username < accounts[j].Username })
+	return accounts, nil
+}
+
+// CreateResetToken 비밀번호 재설정 토큰 저장
+func (as *accountStorage) CreateResetToken(ctx context.Context, token *models.AccountResetToken) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+	if _, exists := as.resetTokens[token.TokenHash]; exists {
+		return ErrAlreadyExists
+	}
+
+	tokenCopy := *token
+	as.resetTokens[token.TokenHash] = &tokenCopy
+	return nil
+}
+
+// GetResetToken 토큰 해시로 재설정 토큰 조회
+func (as *accountStorage) GetResetToken(ctx context.Context, tokenHash string) (*models.AccountResetToken, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	token, exists := as.resetTokens[tokenHash]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	tokenCopy := *token
+	return &tokenCopy, nil
+}
+
+// UseResetToken 재설정 토큰 사용 처리
+func (as *accountStorage) UseResetToken(ctx context.Context, tokenHash string, usedAt time.Time) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	token, exists := as.resetTokens[tokenHash]
+	if !exists || token.UsedAt != nil {
+		return storage.ErrNotFound
+	}
+	token.UsedAt = &usedAt
+	return nil
+}
+
+// find 조건에 맞는 계정 하나 조회
+func (as *accountStorage) find(match func(account *models.Account) bool) (*models.Account, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	for _, account := range as.accounts {
+		if match(account) {
+			accountCopy := *account
+			return &accountCopy, nil
+		}
+	}
+	return nil, storage.ErrNotFound
+}
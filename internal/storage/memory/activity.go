@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// activityStorage 메모리 기반 활동 피드 스토리지
+type activityStorage struct {
+	activities []*models.Activity              // 추가 순서
+	markers    map[string]map[string]time.Time // 사용자 ID -> 피드 -> 읽음 표시 시각
+	mutex      sync.RWMutex
+}
+
+// storage.ActivityStorage 인터페이스 구현 확인
+var _ storage.ActivityStorage = (*activityStorage)(nil)
+
+// newActivityStorage 새 활동 피드 스토리지 생성
+func newActivityStorage() *activityStorage {
+	return &activityStorage{
+		markers: make(map[string]map[string]time.Time),
+	}
+}
+
+// Create 활동 추가
+func (as *activityStorage) Create(ctx context.Context, activity *models.Activity) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	if activity.ID == "" {
+		activity.ID = uuid.New().String()
+	}
+	if activity.CreatedAt.IsZero() {
+		activity.CreatedAt = time.Now()
+	}
+	as.activities = append(as.activities, copyActivity(activity))
+	return nil
+}
+
+// List 조건에 맞는 활동 조회 (최신순)
+func (as *activityStorage) List(ctx context.Context, filter *models.ActivityFilter, paging *models.PaginationRequest) ([]*models.Activity, int, error) {
+	if filter == nil {
+		filter = &models.ActivityFilter{}
+	}
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	matched := []*models.Activity{}
+	for i := len(as.activities) - 1; i >= 0; i-- {
+		activity := as.activities[i]
+		if activity.RecipientID != filter.RecipientID {
+			continue
+		}
+		if filter.WorkspaceID != "" && activity.WorkspaceID != filter.WorkspaceID {
+			continue
+		}
+		if filter.Kind != "" && activity.Kind != filter.Kind {
+			continue
+		}
+		if filter.After != nil && !activity.CreatedAt.After(*filter.After) {
+			continue
+		}
+		matched = append(matched, activity)
+	}
+
+	start, end := pageBounds(len(matched), paging)
+	result := make([]*models.Activity, 0, end-start)
+	for _, activity := range matched[start:end] {
+		result = append(result, copyActivity(activity))
+	}
+	return result, len(matched), nil
+}
+
+// GetReadMarker 사용자가 피드를 읽음으로 표시한 시각
+func (as *activityStorage) GetReadMarker(ctx context.Context, userID, feed string) (*time.Time, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	readAt, ok := as.markers[userID][feed]
+	if !ok {
+		return nil, nil
+	}
+	return &readAt, nil
+}
+
+// SetReadMarker 사용자가 피드를 읽음으로 표시한 시각 저장
+func (as *activityStorage) SetReadMarker(ctx context.Context, userID, feed string, readAt time.Time) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	if as.markers[userID] == nil {
+		as.markers[userID] = make(map[string]time.Time)
+	}
+	as.markers[userID][feed] = readAt
+	return nil
+}
+
+// copyActivity 메타데이터까지 복사한 활동
+func copyActivity(activity *models.Activity) *models.Activity {
+	activityCopy := *activity
+	if activity.Metadata != nil {
+		activityCopy.Metadata = make(map[string]string, len(activity.Metadata))
+		for key, value := range activity.Metadata {
+			activityCopy.Metadata[key] = value
+		}
+	}
+	return &activityCopy
+}
@@ -0,0 +1,188 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// analyticsWorkspaceKey 일별 워크스페이스 집계 키
+type analyticsWorkspaceKey struct {
+	day         int64
+	workspaceID string
+}
+
+// analyticsUserKey 일별 활성 사용자 키
+type analyticsUserKey struct {
+	day    int64
+	userID string
+}
+
+// analyticsStorage 메모리 기반 사용량 분석 롤업 스토리지
+type analyticsStorage struct {
+	days       map[int64]*models.AnalyticsDay
+	workspaces map[analyticsWorkspaceKey]*models.AnalyticsWorkspaceDay
+	users      map[analyticsUserKey]bool
+	watermark  *time.Time
+	mutex      sync.RWMutex
+}
+
+// storage.AnalyticsStorage 인터페이스 구현 확인
+var _ storage.AnalyticsStorage = (*analyticsStorage)(nil)
+
+// newAnalyticsStorage 새 사용량 분석 스토리지 생성
+func newAnalyticsStorage() *analyticsStorage {
+	return &analyticsStorage{
+		days:       make(map[int64]*models.AnalyticsDay),
+		workspaces: make(map[analyticsWorkspaceKey]*models.AnalyticsWorkspaceDay),
+		users:      make(map[analyticsUserKey]bool),
+	}
+}
+
+// Apply 집계 증분과 워터마크를 함께 반영
+func (as *analyticsStorage) Apply(ctx context.Context, rollup *models.AnalyticsRollup) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	for _, delta := range rollup.Days {
+		day := as.day(delta.Day)
+		day.Tasks += delta.Tasks
+		day.Completed += delta.Completed
+		day.Failed += delta.Failed
+		day.Cancelled += delta.Cancelled
+		day.DurationMs += delta.DurationMs
+		day.TimedTasks += delta.TimedTasks
+		day.CostUSD += delta.CostUSD
+		day.Tokens += delta.Tokens
+		if delta.PeakConcurrency > day.PeakConcurrency {
+			day.PeakConcurrency = delta.PeakConcurrency
+		}
+	}
+
+	for _, delta := range rollup.Workspaces {
+		key := analyticsWorkspaceKey{delta.Day.UTC().Unix(), delta.WorkspaceID}
+		existing, ok := as.workspaces[key]
+		if !ok {
+			existing = &models.AnalyticsWorkspaceDay{Day: delta.Day.UTC(), WorkspaceID: delta.WorkspaceID}
+			as.workspaces[key] = existing
+		}
+		existing.OwnerID = delta.OwnerID
+		existing.Tasks += delta.Tasks
+		existing.CostUSD += delta.CostUSD
+		existing.Tokens += delta.Tokens
+	}
+
+	// 그날 처음 보는 사용자만 활성 사용자 수에 더함
+	for _, user := range rollup.Users {
+		key := analyticsUserKey{user.Day.UTC().Unix(), user.UserID}
+		if as.users[key] {
+			continue
+		}
+		as.users[key] = true
+		as.day(user.Day).ActiveUsers++
+	}
+
+	watermark := rollup.Watermark.UTC()
+	as.watermark = &watermark
+	return nil
+}
+
+// day 일별 집계 행 (없으면 만듦, mutex를 잡은 상태에서 호출)
+func (as *analyticsStorage) day(t time.Time) *models.AnalyticsDay {
+	key := t.UTC().Unix()
+	day, ok := as.days[key]
+	if !ok {
+		day = &models.AnalyticsDay{Day: t.UTC()}
+		as.days[key] = day
+	}
+	return day
+}
+
+// Watermark 마지막 집계에 반영된 시각
+func (as *analyticsStorage) Watermark(ctx context.Context) (*time.Time, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	if as.watermark == nil {
+		return nil, nil
+	}
+	watermark := *as.watermark
+	return &watermark, nil
+}
+
+// ListDays 기간 안의 일별 집계 조회 (날짜순)
+func (as *analyticsStorage) ListDays(ctx context.Context, from, to time.Time) ([]*models.AnalyticsDay, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	days := []*models.AnalyticsDay{}
+	for _, day := range as.days {
+		if day.Day.Before(from) || !day.Day.Before(to) {
+			continue
+		}
+		dayCopy := *day
+		days = append(days, &dayCopy)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Day.Before(days[j].Day) })
+	return days, nil
+}
+
+// TopWorkspaces 기간 동안 비용이 많이 든 워크스페이스
+func (as *analyticsStorage) TopWorkspaces(ctx context.Context, from, to time.Time, limit int) ([]*models.AnalyticsWorkspaceUsage, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	byWorkspace := make(map[string]*models.AnalyticsWorkspaceUsage)
+	for _, day := range as.workspaces {
+		if day.Day.Before(from) || !day.Day.Before(to) {
+			continue
+		}
+		usage, ok := byWorkspace[day.WorkspaceID]
+		if !ok {
+			usage = &models.AnalyticsWorkspaceUsage{WorkspaceID: day.WorkspaceID}
+			byWorkspace[day.WorkspaceID] = usage
+		}
+		usage.OwnerID = day.OwnerID
+		usage.Tasks += day.Tasks
+		usage.CostUSD += day.CostUSD
+		usage.Tokens += day.Tokens
+	}
+
+	usages := make([]*models.AnalyticsWorkspaceUsage, 0, len(byWorkspace))
+	for _, usage := range byWorkspace {
+		usages = append(usages, usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		a, b := usages[i], usages[j]
+		if a.CostUSD != b.CostUSD {
+			return a.CostUSD > b.CostUSD
+		}
+		if a.Tasks != b.Tasks {
+			return a.Tasks > b.Tasks
+		}
+		return a.WorkspaceID < b.WorkspaceID
+	})
+	if limit > 0 && len(usages) > limit {
+		usages = usages[:limit]
+	}
+	return usages, nil
+}
+
+// CountActiveUsers 기간 동안 태스크를 실행한 사용자 수
+func (as *analyticsStorage) CountActiveUsers(ctx context.Context, from, to time.Time) (int64, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	fromUnix, toUnix := from.UTC().Unix(), to.UTC().Unix()
+	users := make(map[string]bool)
+	for key := range as.users {
+		if key.day >= fromUnix && key.day < toUnix {
+			users[key.userID] = true
+		}
+	}
+	return int64(len(users)), nil
+}
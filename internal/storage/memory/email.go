@@ -0,0 +1,164 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// defaultEmailDeliveryLimit 조회 개수를 지정하지 않았을 때 반환할 발송 기록 수
+const defaultEmailDeliveryLimit = 100
+
+// emailDeliveryStorage 메모리 기반 메일 발송 기록 스토리지
+type emailDeliveryStorage struct {
+	deliveries map[string]*models.EmailDelivery
+	mutex      sync.RWMutex
+}
+
+// storage.EmailDeliveryStorage 인터페이스 구현 확인
+var _ storage.EmailDeliveryStorage = (*emailDeliveryStorage)(nil)
+
+// newEmailDeliveryStorage 새 발송 기록 스토리지 생성
+func newEmailDeliveryStorage() *emailDeliveryStorage {
+	return &emailDeliveryStorage{
+		deliveries: make(map[string]*models.EmailDelivery),
+	}
+}
+
+// Create 발송 기록 저장
+func (es *emailDeliveryStorage) Create(ctx context.Context, delivery *models.EmailDelivery) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now()
+	}
+	delivery.UpdatedAt = delivery.CreatedAt
+
+	if _, exists := es.deliveries[delivery.ID]; exists {
+		return ErrAlreadyExists
+	}
+	es.deliveries[delivery.ID] = copyEmailDelivery(delivery)
+	return nil
+}
+
+// GetByID ID로 발송 기록 조회
+func (es *emailDeliveryStorage) GetByID(ctx context.Context, id string) (*models.EmailDelivery, error) {
+	es.mutex.RLock()
+	defer es.mutex.RUnlock()
+
+	delivery, exists := es.deliveries[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyEmailDelivery(delivery), nil
+}
+
+// Update 발송 상태 저장
+func (es *emailDeliveryStorage) Update(ctx context.Context, delivery *models.EmailDelivery) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	if _, exists := es.deliveries[delivery.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	delivery.UpdatedAt = time.Now()
+	es.deliveries[delivery.ID] = copyEmailDelivery(delivery)
+	return nil
+}
+
+// List 조건에 맞는 발송 기록 조회 (최신순)
+func (es *emailDeliveryStorage) List(ctx context.Context, filter *models.EmailDeliveryFilter) ([]*models.EmailDelivery, error) {
+	es.mutex.RLock()
+	defer es.mutex.RUnlock()
+
+	if filter == nil {
+		filter = &models.EmailDeliveryFilter{}
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultEmailDeliveryLimit
+	}
+
+	result := make([]*models.EmailDelivery, 0)
+	for _, delivery := range es.deliveries {
+		if filter.Status != "" && delivery.Status != filter.Status {
+			continue
+		}
+		if filter.Template != "" && delivery.Template != filter.Template {
+			continue
+		}
+		if filter.To != "" && !strings.EqualFold(delivery.To, filter.To) {
+			continue
+		}
+		result = append(result, copyEmailDelivery(delivery))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].ID > result[j].ID
+		}
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// ListDue 발송할 때가 된 대기 기록 조회 (다음 시도 시각순)
+func (es *emailDeliveryStorage) ListDue(ctx context.Context, now time.Time, limit int) ([]*models.EmailDelivery, error) {
+	es.mutex.RLock()
+	defer es.mutex.RUnlock()
+
+	result := make([]*models.EmailDelivery, 0)
+	for _, delivery := range es.deliveries {
+		if delivery.Status != models.EmailDeliveryPending {
+			continue
+		}
+		if delivery.NextAttemptAt != nil && delivery.NextAttemptAt.After(now) {
+			continue
+		}
+		result = append(result, copyEmailDelivery(delivery))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return dueTime(result[i]).Before(dueTime(result[j]))
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// dueTime 대기 기록의 발송 예정 시각 (다음 시도 시각이 없으면 생성 시각)
+func dueTime(delivery *models.EmailDelivery) time.Time {
+	if delivery.NextAttemptAt != nil {
+		return *delivery.NextAttemptAt
+	}
+	return delivery.CreatedAt
+}
+
+// copyEmailDelivery 포인터 필드까지 복사
+func copyEmailDelivery(delivery *models.EmailDelivery) *models.EmailDelivery {
+	deliveryCopy := *delivery
+	if delivery.NextAttemptAt != nil {
+		next := *delivery.NextAttemptAt
+		deliveryCopy.NextAttemptAt = &next
+	}
+	if delivery.SentAt != nil {
+		sent := *delivery.SentAt
+		deliveryCopy.SentAt = &sent
+	}
+	return &deliveryCopy
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// errorStatKey 집계 구간과 에러 유형 키
+type errorStatKey struct {
+	granularity models.ErrorStatGranularity
+	bucketStart int64
+	errorType   string
+	severity    string
+	category    string
+}
+
+// errorStatsStorage 메모리 기반 에러 통계 스토리지
+type errorStatsStorage struct {
+	buckets map[errorStatKey]*models.ErrorStatBucket
+	mutex   sync.RWMutex
+}
+
+// storage.ErrorStatsStorage 인터페이스 구현 확인
+var _ storage.ErrorStatsStorage = (*errorStatsStorage)(nil)
+
+// newErrorStatsStorage 새 에러 통계 스토리지 생성
+func newErrorStatsStorage() *errorStatsStorage {
+	return &errorStatsStorage{
+		buckets: make(map[errorStatKey]*models.ErrorStatBucket),
+	}
+}
+
+// Increment 집계 구간별 발생 횟수 누적
+func (es *errorStatsStorage) Increment(ctx context.Context, buckets []*models.ErrorStatBucket) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	for _, bucket := range buckets {
+		key := errorStatKey{bucket.Granularity, bucket.BucketStart.UTC().Unix(), bucket.ErrorType, bucket.Severity, bucket.Category}
+		if existing, ok := es.buckets[key]; ok {
+			existing.Count += bucket.Count
+			continue
+		}
+		bucketCopy := *bucket
+		bucketCopy.BucketStart = bucket.BucketStart.UTC()
+		es.buckets[key] = &bucketCopy
+	}
+	return nil
+}
+
+// List 기간 안의 집계 조회
+func (es *errorStatsStorage) List(ctx context.Context, granularity models.ErrorStatGranularity, from, to time.Time) ([]*models.ErrorStatBucket, error) {
+	es.mutex.RLock()
+	defer es.mutex.RUnlock()
+
+	buckets := []*models.ErrorStatBucket{}
+	for key, bucket := range es.buckets {
+		if key.granularity != granularity || bucket.BucketStart.Before(from) || !bucket.BucketStart.Before(to) {
+			continue
+		}
+		bucketCopy := *bucket
+		buckets = append(buckets, &bucketCopy)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if !buckets[i].BucketStart.Equal(buckets[j].BucketStart) {
+			return buckets[i].BucketStart.Before(buckets[j].BucketStart)
+		}
+		if buckets[i].ErrorType != buckets[j].ErrorType {
+			return buckets[i].ErrorType < buckets[j].ErrorType
+		}
+		if buckets[i].Severity != buckets[j].Severity {
+			return buckets[i].Severity < buckets[j].Severity
+		}
+		return buckets[i].Category < buckets[j].Category
+	})
+	return buckets, nil
+}
+
+// DeleteBefore 기준 시각 이전 구간 삭제
+func (es *errorStatsStorage) DeleteBefore(ctx context.Context, granularity models.ErrorStatGranularity, before time.Time) (int64, error) {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	var deleted int64
+	for key, bucket := range es.buckets {
+		if key.granularity == granularity && bucket.BucketStart.Before(before) {
+			delete(es.buckets, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// fanOutStorage 메모리 기반 팬아웃 스토리지
+type fanOutStorage struct {
+	fanOuts map[string]*models.FanOut
+	mutex   sync.RWMutex
+}
+
+// storage.FanOutStorage 인터페이스 구현 확인
+var _ storage.FanOutStorage = (*fanOutStorage)(nil)
+
+// newFanOutStorage 새 팬아웃 스토리지 생성
+func newFanOutStorage() *fanOutStorage {
+	return &fanOutStorage{
+		fanOuts: make(map[string]*models.FanOut),
+	}
+}
+
+// Create 새 팬아웃 생성
+func (fs *fanOutStorage) Create(ctx context.Context, fanOut *models.FanOut) error {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	if fanOut.ID == "" {
+		fanOut.ID = uuid.New().String()
+	}
+	if _, exists := fs.fanOuts[fanOut.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if fanOut.CreatedAt.IsZero() {
+		fanOut.CreatedAt = time.Now()
+	}
+	fanOut.UpdatedAt = fanOut.CreatedAt
+
+	fs.fanOuts[fanOut.ID] = copyFanOut(fanOut)
+	return nil
+}
+
+// GetByID ID로 팬아웃 조회
+func (fs *fanOutStorage) GetByID(ctx context.Context, id string) (*models.FanOut, error) {
+	fs.mutex.RLock()
+	defer fs.mutex.RUnlock()
+
+	fanOut, exists := fs.fanOuts[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyFanOut(fanOut), nil
+}
+
+// Update 팬아웃 저장
+func (fs *fanOutStorage) Update(ctx context.Context, fanOut *models.FanOut) error {
+	fs.mutex.Lock()
+	defer fs.mutex.Unlock()
+
+	if _, exists := fs.fanOuts[fanOut.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	fanOut.UpdatedAt = time.Now()
+	fs.fanOuts[fanOut.ID] = copyFanOut(fanOut)
+	return nil
+}
+
+// ListByOwner 사용자의 팬아웃 조회 (최신순)
+func (fs *fanOutStorage) ListByOwner(ctx context.Context, ownerID string, limit int) ([]*models.FanOut, error) {
+	fs.mutex.RLock()
+	defer fs.mutex.RUnlock()
+
+	fanOuts := []*models.FanOut{}
+	for _, fanOut := range fs.fanOuts {
+		if fanOut.OwnerID == ownerID {
+			fanOuts = append(fanOuts, copyFanOut(fanOut))
+		}
+	}
+	sort.Slice(fanOuts, func(i, j int) bool {
+		if !fanOuts[i].CreatedAt.Equal(fanOuts[j].CreatedAt) {
+			return fanOuts[i].CreatedAt.After(fanOuts[j].CreatedAt)
+		}
+		return fanOuts[i].ID > fanOuts[j].ID
+	})
+
+	if limit > 0 && len(fanOuts) > limit {
+		fanOuts = fanOuts[:limit]
+	}
+	return fanOuts, nil
+}
+
+// copyFanOut 워크스페이스별 결과까지 복사한 팬아웃
+func copyFanOut(fanOut *models.FanOut) *models.FanOut {
+	fanOutCopy := *fanOut
+	fanOutCopy.Targets = append([]models.FanOutTarget{}, fanOut.Targets...)
+	return &fanOutCopy
+}
@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// issueTrackerStorage 메모리 기반 이슈 트래커 설정과 이슈 연결 스토리지
+type issueTrackerStorage struct {
+	configs map[string]*models.IssueTrackerConfig // 워크스페이스 ID -> 설정
+	links   map[string]*models.IssueLink
+	mutex   sync.RWMutex
+}
+
+// storage.IssueTrackerStorage 인터페이스 구현 확인
+var _ storage.IssueTrackerStorage = (*issueTrackerStorage)(nil)
+
+// newIssueTrackerStorage 새 이슈 트래커 스토리지 생성
+func newIssueTrackerStorage() *issueTrackerStorage {
+	return &issueTrackerStorage{
+		configs: make(map[string]*models.IssueTrackerConfig),
+		links:   make(map[string]*models.IssueLink),
+	}
+}
+
+// GetConfig 워크스페이스의 이슈 트래커 설정 조회
+func (is *issueTrackerStorage) GetConfig(ctx context.Context, workspaceID string) (*models.IssueTrackerConfig, error) {
+	is.mutex.RLock()
+	defer is.mutex.RUnlock()
+
+	config, exists := is.configs[workspaceID]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	configCopy := *config
+	return &configCopy, nil
+}
+
+// SaveConfig 이슈 트래커 설정 저장
+func (is *issueTrackerStorage) SaveConfig(ctx context.Context, config *models.IssueTrackerConfig) error {
+	is.mutex.Lock()
+	defer is.mutex.Unlock()
+
+	now := time.Now()
+	if existing, exists := is.configs[config.WorkspaceID]; exists {
+		config.CreatedAt = existing.CreatedAt
+	} else {
+		config.CreatedAt = now
+	}
+	config.UpdatedAt = now
+	configCopy := *config
+	is.configs[config.WorkspaceID] = &configCopy
+	return nil
+}
+
+// DeleteConfig 이슈 트래커 설정 삭제
+func (is *issueTrackerStorage) DeleteConfig(ctx context.Context, workspaceID string) error {
+	is.mutex.Lock()
+	defer is.mutex.Unlock()
+
+	if _, exists := is.configs[workspaceID]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(is.configs, workspaceID)
+	return nil
+}
+
+// ListConfigWorkspaces 이슈 트래커 설정이 있는 워크스페이스 ID 목록
+func (is *issueTrackerStorage) ListConfigWorkspaces(ctx context.Context) ([]string, error) {
+	is.mutex.RLock()
+	defer is.mutex.RUnlock()
+
+	workspaceIDs := make([]string, 0, len(is.configs))
+	for workspaceID := range is.configs {
+		workspaceIDs = append(workspaceIDs, workspaceID)
+	}
+	sort.Strings(workspaceIDs)
+	return workspaceIDs, nil
+}
+
+// CreateLink 새 이슈 연결 생성
+func (is *issueTrackerStorage) CreateLink(ctx context.Context, link *models.IssueLink) error {
+	is.mutex.Lock()
+	defer is.mutex.Unlock()
+
+	if link.ID == "" {
+		link.ID = uuid.New().String()
+	}
+	if _, exists := is.links[link.ID]; exists {
+		return ErrAlreadyExists
+	}
+	for _, existing := range is.links {
+		if existing.SessionID == link.SessionID && existing.TaskID == link.TaskID && existing.IssueKey == link.IssueKey {
+			return ErrAlreadyExists
+		}
+	}
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = time.Now()
+	}
+	link.UpdatedAt = link.CreatedAt
+
+	linkCopy := *link
+	is.links[link.ID] = &linkCopy
+	return nil
+}
+
+// UpdateLink 이슈 연결 저장
+func (is *issueTrackerStorage) UpdateLink(ctx context.Context, link *models.IssueLink) error {
+	is.mutex.Lock()
+	defer is.mutex.Unlock()
+
+	if _, exists := is.links[link.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	link.UpdatedAt = time.Now()
+	linkCopy := *link
+	is.links[link.ID] = &linkCopy
+	return nil
+}
+
+// DeleteLink 이슈 연결 삭제
+func (is *issueTrackerStorage) DeleteLink(ctx context.Context, id string) error {
+	is.mutex.Lock()
+	defer is.mutex.Unlock()
+
+	if _, exists := is.links[id]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(is.links, id)
+	return nil
+}
+
+// GetLink ID로 이슈 연결 조회
+func (is *issueTrackerStorage) GetLink(ctx context.Context, id string) (*models.IssueLink, error) {
+	is.mutex.RLock()
+	defer is.mutex.RUnlock()
+
+	link, exists := is.links[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	linkCopy := *link
+	return &linkCopy, nil
+}
+
+// ListLinksBySession 세션의 이슈 연결 조회 (생성순)
+func (is *issueTrackerStorage) ListLinksBySession(ctx context.Context, sessionID string) ([]*models.IssueLink, error) {
+	is.mutex.RLock()
+	defer is.mutex.RUnlock()
+
+	links := []*models.IssueLink{}
+	for _, link := range is.links {
+		if link.SessionID == sessionID {
+			linkCopy := *link
+			links = append(links, &linkCopy)
+		}
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if !links[i].CreatedAt.Equal(links[j].CreatedAt) {
+			return links[i].CreatedAt.Before(links[j].CreatedAt)
+		}
+		return links[i].ID < links[j].ID
+	})
+	return links, nil
+}
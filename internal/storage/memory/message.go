@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// messageStorage 메모리 기반 세션 대화 기록 스토리지
+type messageStorage struct {
+	messages map[string][]*models.SessionMessage // 세션 ID별 메시지 (순서대로)
+	mutex    sync.RWMutex
+}
+
+// storage.MessageStorage 인터페이스 구현 확인
+var _ storage.MessageStorage = (*messageStorage)(nil)
+
+// newMessageStorage 새 대화 기록 스토리지 생성
+func newMessageStorage() *messageStorage {
+	return &messageStorage{
+		messages: make(map[string][]*models.SessionMessage),
+	}
+}
+
+// Append 세션에 메시지 추가
+func (ms *messageStorage) Append(ctx context.Context, message *models.SessionMessage) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	if message.ID == "" {
+		message.ID = uuid.New().String()
+	}
+	if message.CreatedAt.IsZero() {
+		message.CreatedAt = time.Now()
+	}
+	message.Sequence = int64(len(ms.messages[message.SessionID]) + 1)
+
+	// 복사본 저장
+	messageCopy := *message
+	ms.messages[message.SessionID] = append(ms.messages[message.SessionID], &messageCopy)
+
+	return nil
+}
+
+// ListBySession 세션의 메시지를 순서대로 조회
+func (ms *messageStorage) ListBySession(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionMessage, int, error) {
+	ms.mutex.RLock()
+	defer ms.mutex.RUnlock()
+
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	messages := ms.messages[sessionID]
+	total := len(messages)
+	start, end := pageBounds(total, paging)
+
+	result := make([]*models.SessionMessage, 0, end-start)
+	for _, m := range messages[start:end] {
+		messageCopy := *m
+		result = append(result, &messageCopy)
+	}
+	return result, total, nil
+}
+
+// Search 모든 검색 단어를 포함하는 메시지를 최신순으로 조회
+func (ms *messageStorage) Search(ctx context.Context, query *models.MessageSearchQuery, paging *models.PaginationRequest) ([]*models.MessageSearchResult, int, error) {
+	ms.mutex.RLock()
+	defer ms.mutex.RUnlock()
+
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	terms := storage.SearchTerms(query.Query)
+	var workspaces map[string]bool
+	if query.WorkspaceIDs != nil {
+		workspaces = make(map[string]bool, len(query.WorkspaceIDs))
+		for _, id := range query.WorkspaceIDs {
+			workspaces[id] = true
+		}
+	}
+
+	var matched []*models.SessionMessage
+	for sessionID, messages := range ms.messages {
+		if query.SessionID != "" && sessionID != query.SessionID {
+			continue
+		}
+		for _, m := range messages {
+			if workspaces != nil && !workspaces[m.WorkspaceID] {
+				continue
+			}
+			if query.Role != "" && m.Role != query.Role {
+				continue
+			}
+			if storage.MatchesAllTerms(m.Content, terms) {
+				matched = append(matched, m)
+			}
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.After(matched[j].CreatedAt)
+		}
+		return matched[i].ID > matched[j].ID
+	})
+
+	total := len(matched)
+	start, end := pageBounds(total, paging)
+
+	results := make([]*models.MessageSearchResult, 0, end-start)
+	for _, m := range matched[start:end] {
+		results = append(results, &models.MessageSearchResult{
+			SessionMessage: *m,
+			Snippet:        storage.MessageSnippet(m.Content, terms),
+		})
+	}
+	return results, total, nil
+}
+
+// DeleteBySession 세션의 메시지 전체 삭제
+func (ms *messageStorage) DeleteBySession(ctx context.Context, sessionID string) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	delete(ms.messages, sessionID)
+	return nil
+}
+
+// pageBounds 오프셋 페이지네이션 범위 계산
+func pageBounds(total int, paging *models.PaginationRequest) (int, int) {
+	start := paging.GetOffset()
+	if start > total {
+		start = total
+	}
+	end := start + paging.Limit
+	if end > total {
+		end = total
+	}
+	return start, end
+}
@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+func TestMessageStorage_ListBySession(t *testing.T) {
+	ctx := context.Background()
+	s := newMessageStorage()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.Append(ctx, &models.SessionMessage{
+			SessionID:   "s1",
+			WorkspaceID: "w1",
+			Role:        models.MessageRoleUser,
+			Content:     fmt.Sprintf("message %d", i),
+		}))
+	}
+	require.NoError(t, s.Append(ctx, &models.SessionMessage{SessionID: "s2", WorkspaceID: "w1", Role: models.MessageRoleUser, Content: "other"}))
+
+	page, total, err := s.ListBySession(ctx, "s1", &models.PaginationRequest{Page: 2, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+	require.Len(t, page, 2)
+	assert.Equal(t, int64(3), page[0].Sequence)
+	assert.Equal(t, "message 3", page[1].Content)
+
+	page, total, err = s.ListBySession(ctx, "s1", &models.PaginationRequest{Page: 4, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+	assert.Empty(t, page)
+
+	require.NoError(t, s.DeleteBySession(ctx, "s1"))
+	_, total, err = s.ListBySession(ctx, "s1", nil)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func TestMessageStorage_Search(t *testing.T) {
+	ctx := context.Background()
+	s := newMessageStorage()
+
+	base := time.Now()
+	messages := []*models.SessionMessage{
+		{SessionID: "s1", WorkspaceID: "w1", Role: models.MessageRoleUser, Content: "Please fix the users migration"},
+		{SessionID: "s1", WorkspaceID: "w1", Role: models.MessageRoleAssistant, Content: strings.Repeat("context ", 30) + "I fixed the Migration by adding a default value"},
+		{SessionID: "s2", WorkspaceID: "w2", Role: models.MessageRoleAssistant, Content: "migration fixed in another workspace"},
+		{SessionID: "s3", WorkspaceID: "w1", Role: models.MessageRoleAssistant, Content: "unrelated answer"},
+	}
+	for i, m := range messages {
+		m.CreatedAt = base.Add(time.Duration(i) * time.Second)
+		require.NoError(t, s.Append(ctx, m))
+	}
+
+	// 모든 단어를 포함, 최신순
+	results, total, err := s.Search(ctx, &models.MessageSearchQuery{Query: "MIGRATION fix"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, "s2", results[0].SessionID)
+
+	// 워크스페이스 제한과 역할 필터
+	results, total, err = s.Search(ctx, &models.MessageSearchQuery{Query: "migration", WorkspaceIDs: []string{"w1"}, Role: models.MessageRoleAssistant}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	assert.True(t, strings.HasPrefix(results[0].Snippet, "…"))
+	assert.Contains(t, results[0].Snippet, "Migration by adding")
+
+	// 빈 워크스페이스 목록은 결과 없음
+	_, total, err = s.Search(ctx, &models.MessageSearchQuery{Query: "migration", WorkspaceIDs: []string{}}, nil)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
@@ -0,0 +1,191 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// notificationStorage 메모리 기반 알림 센터 스토리지
+type notificationStorage struct {
+	notifications []*models.Notification                                               // 추가 순서
+	preferences   map[string]map[models.NotificationType]models.NotificationPreference // 사용자 ID -> 종류 -> 설정
+	mutex         sync.RWMutex
+}
+
+// storage.NotificationStorage 인터페이스 구현 확인
+var _ storage.NotificationStorage = (*notificationStorage)(nil)
+
+// newNotificationStorage 새 알림 센터 스토리지 생성
+func newNotificationStorage() *notificationStorage {
+	return &notificationStorage{
+		preferences: make(map[string]map[models.NotificationType]models.NotificationPreference),
+	}
+}
+
+// Create 알림 추가
+func (ns *notificationStorage) Create(ctx context.Context, notification *models.Notification) error {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+
+	if notification.ID == "" {
+		notification.ID = uuid.New().String()
+	}
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now()
+	}
+	ns.notifications = append(ns.notifications, copyNotification(notification))
+	return nil
+}
+
+// List 조건에 맞는 알림 조회 (최신순)
+func (ns *notificationStorage) List(ctx context.Context, filter *models.NotificationFilter, paging *models.PaginationRequest) ([]*models.Notification, int, error) {
+	if filter == nil {
+		filter = &models.NotificationFilter{}
+	}
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	ns.mutex.RLock()
+	defer ns.mutex.RUnlock()
+
+	matched := []*models.Notification{}
+	for i := len(ns.notifications) - 1; i >= 0; i-- {
+		notification := ns.notifications[i]
+		if notification.UserID != filter.UserID {
+			continue
+		}
+		if filter.Type != "" && notification.Type != filter.Type {
+			continue
+		}
+		if filter.UnreadOnly && notification.ReadAt != nil {
+			continue
+		}
+		if filter.Since != nil && !notification.CreatedAt.After(*filter.Since) {
+			continue
+		}
+		matched = append(matched, notification)
+	}
+
+	start, end := pageBounds(len(matched), paging)
+	result := make([]*models.Notification, 0, end-start)
+	for _, notification := range matched[start:end] {
+		result = append(result, copyNotification(notification))
+	}
+	return result, len(matched), nil
+}
+
+// MarkRead 사용자의 안 읽은 알림을 읽음으로 표시
+func (ns *notificationStorage) MarkRead(ctx context.Context, userID string, ids []string, readAt time.Time) (int, error) {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	marked := 0
+	for _, notification := range ns.notifications {
+		if notification.UserID != userID || notification.ReadAt != nil {
+			continue
+		}
+		if len(ids) > 0 && !wanted[notification.ID] {
+			continue
+		}
+		at := readAt
+		notification.ReadAt = &at
+		marked++
+	}
+	return marked, nil
+}
+
+// ListDigestPending 요약 메일을 기다리는 알림 조회 (사용자, 생성 시각순)
+func (ns *notificationStorage) ListDigestPending(ctx context.Context, limit int) ([]*models.Notification, error) {
+	ns.mutex.RLock()
+	defer ns.mutex.RUnlock()
+
+	pending := []*models.Notification{}
+	for _, notification := range ns.notifications {
+		if notification.DigestPending {
+			pending = append(pending, copyNotification(notification))
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].UserID != pending[j].UserID {
+			return pending[i].UserID < pending[j].UserID
+		}
+		return pending[i].CreatedAt.Before(pending[j].CreatedAt)
+	})
+	if limit > 0 && len(pending) > limit {
+		pending = pending[:limit]
+	}
+	return pending, nil
+}
+
+// ClearDigestPending 요약 메일로 보낸 알림 표시
+func (ns *notificationStorage) ClearDigestPending(ctx context.Context, ids []string) error {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+
+	sent := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		sent[id] = true
+	}
+	for _, notification := range ns.notifications {
+		if sent[notification.ID] {
+			notification.DigestPending = false
+		}
+	}
+	return nil
+}
+
+// GetPreferences 사용자가 저장한 알림 설정 (종류 이름순)
+func (ns *notificationStorage) GetPreferences(ctx context.Context, userID string) ([]*models.NotificationPreference, error) {
+	ns.mutex.RLock()
+	defer ns.mutex.RUnlock()
+
+	preferences := []*models.NotificationPreference{}
+	for _, preference := range ns.preferences[userID] {
+		preferenceCopy := preference
+		preferences = append(preferences, &preferenceCopy)
+	}
+	sort.Slice(preferences, func(i, j int) bool { return preferences[i].Type < preferences[j].Type })
+	return preferences, nil
+}
+
+// SetPreference 알림 종류 하나의 설정 저장
+func (ns *notificationStorage) SetPreference(ctx context.Context, userID string, preference *models.NotificationPreference) error {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+
+	if ns.preferences[userID] == nil {
+		ns.preferences[userID] = make(map[models.NotificationType]models.NotificationPreference)
+	}
+	ns.preferences[userID][preference.Type] = *preference
+	return nil
+}
+
+// copyNotification 데이터와 읽음 시각까지 복사한 알림
+func copyNotification(notification *models.Notification) *models.Notification {
+	notificationCopy := *notification
+	if notification.Data != nil {
+		notificationCopy.Data = make(map[string]string, len(notification.Data))
+		for key, value := range notification.Data {
+			notificationCopy.Data[key] = value
+		}
+	}
+	if notification.ReadAt != nil {
+		readAt := *notification.ReadAt
+		notificationCopy.ReadAt = &readAt
+	}
+	return &notificationCopy
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// sortable 정렬 값과 ID를 제공하는 모델
+type sortable interface {
+	SortValue(field string) interface{}
+}
+
+// paginate 허용된 정렬 필드로 정렬한 뒤 커서 또는 오프셋 기준으로 한 페이지를 잘라냅니다.
+// 전체 항목 수(커서 적용 전)를 함께 반환합니다.
+func paginate[T sortable](items []T, idOf func(T) string, paging *models.PaginationRequest, allowed models.SortWhitelist) ([]T, int, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+
+	cursor, err := paging.ApplySortWhitelist(allowed)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	desc := paging.Order != "asc"
+	sort.SliceStable(items, func(i, j int) bool {
+		c := compareSortValues(items[i].SortValue(paging.Sort), items[j].SortValue(paging.Sort))
+		if c == 0 {
+			c = strings.Compare(idOf(items[i]), idOf(items[j]))
+		}
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	total := len(items)
+
+	start := 0
+	if cursor != nil {
+		var cursorValue interface{} = cursor.Value
+		if models.IsTimeSortField(paging.Sort) {
+			t, err := cursor.TimeValue()
+			if err != nil {
+				return nil, 0, err
+			}
+			cursorValue = t
+		}
+
+		// 커서 위치 다음 항목부터 시작
+		start = sort.Search(len(items), func(i int) bool {
+			c := compareSortValues(items[i].SortValue(paging.Sort), cursorValue)
+			if c == 0 {
+				c = strings.Compare(idOf(items[i]), cursor.ID)
+			}
+			if desc {
+				return c < 0
+			}
+			return c > 0
+		})
+	} else {
+		start = paging.GetOffset()
+	}
+
+	if start >= len(items) {
+		return []T{}, total, nil
+	}
+
+	end := start + paging.Limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end], total, nil
+}
+
+// compareSortValues 정렬 값 비교 (시간, 문자열, 정수 지원)
+func compareSortValues(a, b interface{}) int {
+	switch av := a.(type) {
+	case time.Time:
+		bv, _ := b.(time.Time)
+		return av.Compare(bv)
+	case string:
+		bv, _ := b.(string)
+		return strings.Compare(av, bv)
+	case int:
+		bv, _ := b.(int)
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+func TestWorkspaceStorage_CursorPagination(t *testing.T) {
+	ctx := context.Background()
+	s := NewWorkspaceStorage()
+
+	base := time.Now()
+	for i := 0; i < 5; i++ {
+		ws := &models.Workspace{Name: fmt.Sprintf("ws-%d", i), OwnerID: "owner", ProjectPath: "/tmp"}
+		require.NoError(t, s.Create(ctx, ws))
+		s.workspaces[ws.ID].CreatedAt = base.Add(time.Duration(i) * time.Second)
+	}
+
+	paging := &models.PaginationRequest{Limit: 2, Sort: "created_at", Order: "desc"}
+	var names []string
+	for {
+		page, total, err := s.GetByOwnerID(ctx, "owner", paging)
+		require.NoError(t, err)
+		assert.Equal(t, 5, total)
+
+		for _, ws := range page {
+			names = append(names, ws.Name)
+		}
+
+		next := paging.NextCursorFor(len(page), "", nil)
+		if next == "" {
+			break
+		}
+		last := page[len(page)-1]
+		paging = &models.PaginationRequest{
+			Limit:  2,
+			Cursor: paging.NextCursorFor(len(page), last.ID, last.SortValue(paging.Sort)),
+		}
+	}
+
+	assert.Equal(t, []string{"ws-4", "ws-3", "ws-2", "ws-1", "ws-0"}, names)
+}
+
+func TestWorkspaceStorage_SortWhitelist(t *testing.T) {
+	s := NewWorkspaceStorage()
+
+	_, _, err := s.List(context.Background(), &models.PaginationRequest{Sort: "owner_id; DROP TABLE"})
+	assert.ErrorIs(t, err, models.ErrInvalidSortField)
+
+	_, _, err = s.List(context.Background(), &models.PaginationRequest{Cursor: "not-a-cursor"})
+	assert.ErrorIs(t, err, models.ErrInvalidCursor)
+}
+
+func TestSelectFields(t *testing.T) {
+	items := []models.Workspace{{ID: "ws-1", Name: "one", OwnerID: "owner"}}
+
+	result := models.SelectFields(items, []string{"name"}).([]map[string]interface{})
+	require.Len(t, result, 1)
+	assert.Equal(t, map[string]interface{}{"id": "ws-1", "name": "one"}, result[0])
+}
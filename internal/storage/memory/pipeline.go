@@ -0,0 +1,240 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// pipelineStorage 메모리 기반 파이프라인 스토리지
+type pipelineStorage struct {
+	pipelines map[string]*models.Pipeline
+	runs      map[string]*models.PipelineRun
+	mutex     sync.RWMutex
+}
+
+// storage.PipelineStorage 인터페이스 구현 확인
+var _ storage.PipelineStorage = (*pipelineStorage)(nil)
+
+// newPipelineStorage 새 파이프라인 스토리지 생성
+func newPipelineStorage() *pipelineStorage {
+	return &pipelineStorage{
+		pipelines: make(map[string]*models.Pipeline),
+		runs:      make(map[string]*models.PipelineRun),
+	}
+}
+
+// Create 새 파이프라인 생성
+func (ps *pipelineStorage) Create(ctx context.Context, pipeline *models.Pipeline) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if pipeline.ID == "" {
+		pipeline.ID = uuid.New().String()
+	}
+	if _, exists := ps.pipelines[pipeline.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if ps.nameTaken(pipeline) {
+		return ErrAlreadyExists
+	}
+	if pipeline.CreatedAt.IsZero() {
+		pipeline.CreatedAt = time.Now()
+	}
+	pipeline.UpdatedAt = pipeline.CreatedAt
+
+	ps.pipelines[pipeline.ID] = copyPipeline(pipeline)
+	return nil
+}
+
+// GetByID ID로 파이프라인 조회
+func (ps *pipelineStorage) GetByID(ctx context.Context, id string) (*models.Pipeline, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	pipeline, exists := ps.pipelines[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyPipeline(pipeline), nil
+}
+
+// Update 파이프라인 저장
+func (ps *pipelineStorage) Update(ctx context.Context, pipeline *models.Pipeline) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if _, exists := ps.pipelines[pipeline.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	if ps.nameTaken(pipeline) {
+		return ErrAlreadyExists
+	}
+	pipeline.UpdatedAt = time.Now()
+	ps.pipelines[pipeline.ID] = copyPipeline(pipeline)
+	return nil
+}
+
+// Delete 파이프라인 삭제
+func (ps *pipelineStorage) Delete(ctx context.Context, id string) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if _, exists := ps.pipelines[id]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(ps.pipelines, id)
+	return nil
+}
+
+// ListByOwner 작성자의 파이프라인 조회 (이름순)
+func (ps *pipelineStorage) ListByOwner(ctx context.Context, ownerID string) ([]*models.Pipeline, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	pipelines := []*models.Pipeline{}
+	for _, pipeline := range ps.pipelines {
+		if pipeline.OwnerID == ownerID {
+			pipelines = append(pipelines, copyPipeline(pipeline))
+		}
+	}
+	sort.Slice(pipelines, func(i, j int) bool {
+		if pipelines[i].Name != pipelines[j].Name {
+			return pipelines[i].Name < pipelines[j].Name
+		}
+		return pipelines[i].ID < pipelines[j].ID
+	})
+	return pipelines, nil
+}
+
+// CreateRun 새 파이프라인 실행 생성
+func (ps *pipelineStorage) CreateRun(ctx context.Context, run *models.PipelineRun) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if run.ID == "" {
+		run.ID = uuid.New().String()
+	}
+	if _, exists := ps.runs[run.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Now()
+	}
+	run.UpdatedAt = run.CreatedAt
+
+	ps.runs[run.ID] = copyPipelineRun(run)
+	return nil
+}
+
+// GetRun ID로 파이프라인 실행 조회
+func (ps *pipelineStorage) GetRun(ctx context.Context, id string) (*models.PipelineRun, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	run, exists := ps.runs[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyPipelineRun(run), nil
+}
+
+// UpdateRun 파이프라인 실행 저장
+func (ps *pipelineStorage) UpdateRun(ctx context.Context, run *models.PipelineRun) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if _, exists := ps.runs[run.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	run.UpdatedAt = time.Now()
+	ps.runs[run.ID] = copyPipelineRun(run)
+	return nil
+}
+
+// ListRuns 조건에 맞는 파이프라인 실행 조회 (최신순)
+func (ps *pipelineStorage) ListRuns(ctx context.Context, filter *models.PipelineRunFilter) ([]*models.PipelineRun, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	if filter == nil {
+		filter = &models.PipelineRunFilter{}
+	}
+
+	runs := []*models.PipelineRun{}
+	for _, run := range ps.runs {
+		if filter.OwnerID != "" && run.OwnerID != filter.OwnerID {
+			continue
+		}
+		if filter.PipelineID != "" && run.PipelineID != filter.PipelineID {
+			continue
+		}
+		if filter.Status != "" && run.Status != filter.Status {
+			continue
+		}
+		runs = append(runs, copyPipelineRun(run))
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		if !runs[i].CreatedAt.Equal(runs[j].CreatedAt) {
+			return runs[i].CreatedAt.After(runs[j].CreatedAt)
+		}
+		return runs[i].ID > runs[j].ID
+	})
+
+	if filter.Limit > 0 && len(runs) > filter.Limit {
+		runs = runs[:filter.Limit]
+	}
+	return runs, nil
+}
+
+// nameTaken 같은 작성자의 다른 파이프라인이 같은 이름을 쓰는지 확인
+func (ps *pipelineStorage) nameTaken(pipeline *models.Pipeline) bool {
+	for _, existing := range ps.pipelines {
+		if existing.ID != pipeline.ID && existing.OwnerID == pipeline.OwnerID && existing.Name == pipeline.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// copyPipeline 단계 정의까지 복사한 파이프라인
+func copyPipeline(pipeline *models.Pipeline) *models.Pipeline {
+	pipelineCopy := *pipeline
+	pipelineCopy.Steps = copyPipelineSteps(pipeline.Steps)
+	return &pipelineCopy
+}
+
+// copyPipelineRun 단계 정의와 실행 기록까지 복사한 파이프라인 실행
+func copyPipelineRun(run *models.PipelineRun) *models.PipelineRun {
+	runCopy := *run
+	runCopy.Steps = copyPipelineSteps(run.Steps)
+	runCopy.StepRuns = append([]models.PipelineStepRun{}, run.StepRuns...)
+	return &runCopy
+}
+
+// copyPipelineSteps 변수와 조건까지 복사한 단계 목록
+func copyPipelineSteps(steps []models.PipelineStep) []models.PipelineStep {
+	stepsCopy := make([]models.PipelineStep, len(steps))
+	for i, step := range steps {
+		if step.Variables != nil {
+			variables := make(map[string]string, len(step.Variables))
+			for name, value := range step.Variables {
+				variables[name] = value
+			}
+			step.Variables = variables
+		}
+		if step.PassIf != nil {
+			condition := *step.PassIf
+			step.PassIf = &condition
+		}
+		stepsCopy[i] = step
+	}
+	return stepsCopy
+}
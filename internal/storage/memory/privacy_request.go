@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// privacyRequestStorage 메모리 기반 개인정보 처리 기록 스토리지
+type privacyRequestStorage struct {
+	requests []*models.PrivacyRequest // 추가 순서
+	mutex    sync.RWMutex
+}
+
+// storage.PrivacyRequestStorage 인터페이스 구현 확인
+var _ storage.PrivacyRequestStorage = (*privacyRequestStorage)(nil)
+
+// newPrivacyRequestStorage 새 개인정보 처리 기록 스토리지 생성
+func newPrivacyRequestStorage() *privacyRequestStorage {
+	return &privacyRequestStorage{}
+}
+
+// Create 처리 기록 추가
+func (ps *privacyRequestStorage) Create(ctx context.Context, request *models.PrivacyRequest) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if request.ID == "" {
+		request.ID = uuid.New().String()
+	}
+	if request.CreatedAt.IsZero() {
+		request.CreatedAt = time.Now()
+	}
+	ps.requests = append(ps.requests, copyPrivacyRequest(request))
+	return nil
+}
+
+// List 조건에 맞는 처리 기록 조회 (최신순)
+func (ps *privacyRequestStorage) List(ctx context.Context, filter *models.PrivacyRequestFilter) ([]*models.PrivacyRequest, error) {
+	if filter == nil {
+		filter = &models.PrivacyRequestFilter{}
+	}
+
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	requests := []*models.PrivacyRequest{}
+	for i := len(ps.requests) - 1; i >= 0; i-- {
+		request := ps.requests[i]
+		if filter.SubjectID != "" && request.SubjectID != filter.SubjectID {
+			continue
+		}
+		if filter.Type != "" && request.Type != filter.Type {
+			continue
+		}
+		requests = append(requests, copyPrivacyRequest(request))
+		if filter.Limit > 0 && len(requests) >= filter.Limit {
+			break
+		}
+	}
+	return requests, nil
+}
+
+// copyPrivacyRequest 처리 건수까지 복사한 기록
+func copyPrivacyRequest(request *models.PrivacyRequest) *models.PrivacyRequest {
+	requestCopy := *request
+	if request.Summary != nil {
+		requestCopy.Summary = make(map[string]int, len(request.Summary))
+		for key, count := range request.Summary {
+			requestCopy.Summary[key] = count
+		}
+	}
+	return &requestCopy
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// processRecordKey 인스턴스별 PID 키
+type processRecordKey struct {
+	instanceID string
+	pid        int
+}
+
+// processRecordStorage 메모리 기반 프로세스 기록 스토리지
+type processRecordStorage struct {
+	records map[processRecordKey]*models.ProcessRecord
+	mutex   sync.RWMutex
+}
+
+// storage.ProcessRecordStorage 인터페이스 구현 확인
+var _ storage.ProcessRecordStorage = (*processRecordStorage)(nil)
+
+// newProcessRecordStorage 새 프로세스 기록 스토리지 생성
+func newProcessRecordStorage() *processRecordStorage {
+	return &processRecordStorage{
+		records: make(map[processRecordKey]*models.ProcessRecord),
+	}
+}
+
+// Save 프로세스 기록 저장
+func (ps *processRecordStorage) Save(ctx context.Context, record *models.ProcessRecord) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	recordCopy := *record
+	recordCopy.Args = append([]string(nil), record.Args...)
+	ps.records[processRecordKey{record.InstanceID, record.PID}] = &recordCopy
+	return nil
+}
+
+// Delete 프로세스 기록 삭제
+func (ps *processRecordStorage) Delete(ctx context.Context, instanceID string, pid int) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	key := processRecordKey{instanceID, pid}
+	if _, exists := ps.records[key]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(ps.records, key)
+	return nil
+}
+
+// ListByInstance 인스턴스의 프로세스 기록 조회
+func (ps *processRecordStorage) ListByInstance(ctx context.Context, instanceID string) ([]*models.ProcessRecord, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	records := []*models.ProcessRecord{}
+	for key, record := range ps.records {
+		if key.instanceID == instanceID {
+			recordCopy := *record
+			records = append(records, &recordCopy)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].StartedAt.Before(records[j].StartedAt)
+	})
+	return records, nil
+}
+
+// ListInstances 프로세스 기록이 남아 있는 인스턴스 ID 목록
+func (ps *processRecordStorage) ListInstances(ctx context.Context) ([]string, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	seen := make(map[string]bool)
+	instances := []string{}
+	for key := range ps.records {
+		if !seen[key.instanceID] {
+			seen[key.instanceID] = true
+			instances = append(instances, key.instanceID)
+		}
+	}
+	sort.Strings(instances)
+	return instances, nil
+}
@@ -152,20 +152,29 @@ func (s *ProjectStorage) Update(ctx context.Context, id string, updates map[stri
 		return ErrNotFound
 	}
 
-	// 이름 변경 시 중복 확인
-	if name, ok := updates["name"].(string); ok && name != project.Name {
-		nameKey := fmt.Sprintf("%s:%s", project.WorkspaceID, name)
+	// 이름 또는 워크스페이스 변경 시 중복 확인
+	name, nameChanged := updates["name"].(string)
+	if !nameChanged {
+		name = project.Name
+	}
+	workspaceID, workspaceChanged := updates["workspace_id"].(string)
+	if !workspaceChanged {
+		workspaceID = project.WorkspaceID
+	}
+	if name != project.Name || workspaceID != project.WorkspaceID {
+		nameKey := fmt.Sprintf("%s:%s", workspaceID, name)
 		if existingID, exists := s.nameIndex[nameKey]; exists && existingID != id {
 			return ErrAlreadyExists
 		}
-		
+
 		// 기존 인덱스 삭제
 		oldNameKey := fmt.Sprintf("%s:%s", project.WorkspaceID, project.Name)
 		delete(s.nameIndex, oldNameKey)
-		
+
 		// 새 인덱스 추가
 		s.nameIndex[nameKey] = id
 		project.Name = name
+		project.WorkspaceID = workspaceID
 	}
 
 	// 경로 변경 시 중복 확인
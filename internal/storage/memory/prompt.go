@@ -0,0 +1,176 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// defaultPromptLimit 조회 개수를 지정하지 않았을 때 반환할 프롬프트 수
+const defaultPromptLimit = 100
+
+// promptStorage 메모리 기반 프롬프트 라이브러리 스토리지
+type promptStorage struct {
+	prompts map[string]*models.Prompt
+	mutex   sync.RWMutex
+}
+
+// storage.PromptStorage 인터페이스 구현 확인
+var _ storage.PromptStorage = (*promptStorage)(nil)
+
+// newPromptStorage 새 프롬프트 스토리지 생성
+func newPromptStorage() *promptStorage {
+	return &promptStorage{
+		prompts: make(map[string]*models.Prompt),
+	}
+}
+
+// Create 새 프롬프트 생성
+func (ps *promptStorage) Create(ctx context.Context, prompt *models.Prompt) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if prompt.ID == "" {
+		prompt.ID = uuid.New().String()
+	}
+	if _, exists := ps.prompts[prompt.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if ps.nameTaken(prompt) {
+		return ErrAlreadyExists
+	}
+	if prompt.CreatedAt.IsZero() {
+		prompt.CreatedAt = time.Now()
+	}
+	prompt.UpdatedAt = prompt.CreatedAt
+
+	ps.prompts[prompt.ID] = copyPrompt(prompt)
+	return nil
+}
+
+// GetByID ID로 프롬프트 조회
+func (ps *promptStorage) GetByID(ctx context.Context, id string) (*models.Prompt, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	prompt, exists := ps.prompts[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyPrompt(prompt), nil
+}
+
+// Update 프롬프트 저장
+func (ps *promptStorage) Update(ctx context.Context, prompt *models.Prompt) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if _, exists := ps.prompts[prompt.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	if ps.nameTaken(prompt) {
+		return ErrAlreadyExists
+	}
+	prompt.UpdatedAt = time.Now()
+	ps.prompts[prompt.ID] = copyPrompt(prompt)
+	return nil
+}
+
+// Delete 프롬프트 삭제
+func (ps *promptStorage) Delete(ctx context.Context, id string) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if _, exists := ps.prompts[id]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(ps.prompts, id)
+	return nil
+}
+
+// List 조건에 맞는 프롬프트 조회 (이름순)
+func (ps *promptStorage) List(ctx context.Context, filter *models.PromptFilter) ([]*models.Prompt, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	if filter == nil {
+		filter = &models.PromptFilter{}
+	}
+	search := strings.ToLower(filter.Search)
+
+	prompts := []*models.Prompt{}
+	for _, prompt := range ps.prompts {
+		if filter.ViewerID != "" {
+			own := prompt.OwnerID == filter.ViewerID
+			if !own && prompt.Visibility != models.PromptVisibilityOrg {
+				continue
+			}
+			if (filter.Scope == "mine" && !own) || (filter.Scope == "shared" && own) {
+				continue
+			}
+		}
+		if filter.Tag != "" && !containsString(prompt.Tags, filter.Tag) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(prompt.Name), search) &&
+			!strings.Contains(strings.ToLower(prompt.Description), search) {
+			continue
+		}
+		prompts = append(prompts, copyPrompt(prompt))
+	}
+
+	sort.Slice(prompts, func(i, j int) bool {
+		if prompts[i].Name != prompts[j].Name {
+			return prompts[i].Name < prompts[j].Name
+		}
+		return prompts[i].ID < prompts[j].ID
+	})
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultPromptLimit
+	}
+	if len(prompts) > limit {
+		prompts = prompts[:limit]
+	}
+	return prompts, nil
+}
+
+// nameTaken 같은 작성자의 다른 프롬프트가 같은 이름을 쓰는지 확인
+func (ps *promptStorage) nameTaken(prompt *models.Prompt) bool {
+	for _, existing := range ps.prompts {
+		if existing.ID != prompt.ID && existing.OwnerID == prompt.OwnerID && existing.Name == prompt.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// copyPrompt 슬라이스까지 복사한 프롬프트
+func copyPrompt(prompt *models.Prompt) *models.Prompt {
+	promptCopy := *prompt
+	promptCopy.Variables = make([]models.PromptVariable, len(prompt.Variables))
+	for i, variable := range prompt.Variables {
+		variable.Options = append([]string(nil), variable.Options...)
+		promptCopy.Variables[i] = variable
+	}
+	promptCopy.Tags = append([]string{}, prompt.Tags...)
+	return &promptCopy
+}
+
+// containsString 슬라이스에 값이 있는지 확인
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
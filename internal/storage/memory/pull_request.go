@@ -0,0 +1,170 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// pullRequestStorage 메모리 기반 PR 설정과 PR 기록 스토리지
+type pullRequestStorage struct {
+	configs map[string]*models.PullRequestConfig // 워크스페이스 ID -> 설정
+	prs     map[string]*models.PullRequest
+	byTask  map[string]string // 태스크 ID -> PR ID
+	mutex   sync.RWMutex
+}
+
+// storage.PullRequestStorage 인터페이스 구현 확인
+var _ storage.PullRequestStorage = (*pullRequestStorage)(nil)
+
+// newPullRequestStorage 새 PR 스토리지 생성
+func newPullRequestStorage() *pullRequestStorage {
+	return &pullRequestStorage{
+		configs: make(map[string]*models.PullRequestConfig),
+		prs:     make(map[string]*models.PullRequest),
+		byTask:  make(map[string]string),
+	}
+}
+
+// GetConfig 워크스페이스의 PR 설정 조회
+func (ps *pullRequestStorage) GetConfig(ctx context.Context, workspaceID string) (*models.PullRequestConfig, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	config, exists := ps.configs[workspaceID]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyPullRequestConfig(config), nil
+}
+
+// SaveConfig PR 설정 저장
+func (ps *pullRequestStorage) SaveConfig(ctx context.Context, config *models.PullRequestConfig) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	now := time.Now()
+	if existing, exists := ps.configs[config.WorkspaceID]; exists {
+		config.CreatedAt = existing.CreatedAt
+	} else {
+		config.CreatedAt = now
+	}
+	config.UpdatedAt = now
+	ps.configs[config.WorkspaceID] = copyPullRequestConfig(config)
+	return nil
+}
+
+// DeleteConfig PR 설정 삭제
+func (ps *pullRequestStorage) DeleteConfig(ctx context.Context, workspaceID string) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if _, exists := ps.configs[workspaceID]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(ps.configs, workspaceID)
+	return nil
+}
+
+// ListConfigWorkspaces PR 설정이 있는 워크스페이스 ID 목록
+func (ps *pullRequestStorage) ListConfigWorkspaces(ctx context.Context) ([]string, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	workspaceIDs := make([]string, 0, len(ps.configs))
+	for workspaceID := range ps.configs {
+		workspaceIDs = append(workspaceIDs, workspaceID)
+	}
+	sort.Strings(workspaceIDs)
+	return workspaceIDs, nil
+}
+
+// Create 새 PR 기록 생성
+func (ps *pullRequestStorage) Create(ctx context.Context, pr *models.PullRequest) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if pr.ID == "" {
+		pr.ID = uuid.New().String()
+	}
+	if _, exists := ps.prs[pr.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if _, exists := ps.byTask[pr.TaskID]; exists {
+		return ErrAlreadyExists
+	}
+	if pr.CreatedAt.IsZero() {
+		pr.CreatedAt = time.Now()
+	}
+	pr.UpdatedAt = pr.CreatedAt
+
+	prCopy := *pr
+	ps.prs[pr.ID] = &prCopy
+	ps.byTask[pr.TaskID] = pr.ID
+	return nil
+}
+
+// Update PR 기록 저장
+func (ps *pullRequestStorage) Update(ctx context.Context, pr *models.PullRequest) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	if _, exists := ps.prs[pr.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	pr.UpdatedAt = time.Now()
+	prCopy := *pr
+	ps.prs[pr.ID] = &prCopy
+	return nil
+}
+
+// GetByTaskID 태스크의 PR 조회
+func (ps *pullRequestStorage) GetByTaskID(ctx context.Context, taskID string) (*models.PullRequest, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	id, exists := ps.byTask[taskID]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	prCopy := *ps.prs[id]
+	return &prCopy, nil
+}
+
+// ListByWorkspace 워크스페이스의 PR 조회 (최신순)
+func (ps *pullRequestStorage) ListByWorkspace(ctx context.Context, workspaceID string, limit int) ([]*models.PullRequest, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	prs := []*models.PullRequest{}
+	for _, pr := range ps.prs {
+		if pr.WorkspaceID == workspaceID {
+			prCopy := *pr
+			prs = append(prs, &prCopy)
+		}
+	}
+	sort.Slice(prs, func(i, j int) bool {
+		if !prs[i].CreatedAt.Equal(prs[j].CreatedAt) {
+			return prs[i].CreatedAt.After(prs[j].CreatedAt)
+		}
+		return prs[i].ID > prs[j].ID
+	})
+
+	if limit > 0 && len(prs) > limit {
+		prs = prs[:limit]
+	}
+	return prs, nil
+}
+
+// copyPullRequestConfig 라벨 목록까지 복사한 설정
+func copyPullRequestConfig(config *models.PullRequestConfig) *models.PullRequestConfig {
+	configCopy := *config
+	configCopy.Labels = append([]string{}, config.Labels...)
+	return &configCopy
+}
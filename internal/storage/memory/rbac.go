@@ -93,7 +93,11 @@ func (r *RBACStorage) CreateRole(ctx context.Context, role *models.Role) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	
-	r.roles[role.ID] = role
+	if role.Version == 0 {
+		role.Version = 1
+	}
+	stored := *role
+	r.roles[role.ID] = &stored
 	return nil
 }
 
@@ -106,7 +110,10 @@ func (r *RBACStorage) GetRoleByID(ctx context.Context, id string) (*models.Role,
 	if !exists {
 		return nil, ErrNotFound
 	}
-	return role, nil
+	
+	// 복사본 반환 (호출자가 수정해도 UpdateRole 전에는 저장된 역할이 바뀌지 않음)
+	result := *role
+	return &result, nil
 }
 
 // GetRoleByName 이름으로 역할 조회
@@ -116,7 +123,8 @@ func (r *RBACStorage) GetRoleByName(ctx context.Context, name string) (*models.R
 	
 	for _, role := range r.roles {
 		if role.Name == name {
-			return role, nil
+			result := *role
+			return &result, nil
 		}
 	}
 	return nil, ErrNotFound
@@ -134,19 +142,6 @@ func (r *RBACStorage) listAllRoles(ctx context.Context) ([]*models.Role, error)
 	return roles, nil
 }
 
-// updateRoleByID 역할 업데이트 (내부 헬퍼 메서드)
-func (r *RBACStorage) updateRoleByID(ctx context.Context, id string, updates map[string]interface{}) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	
-	if _, exists := r.roles[id]; !exists {
-		return ErrNotFound
-	}
-	
-	// 간단한 구현
-	return nil
-}
-
 // DeleteRole 역할 삭제
 func (r *RBACStorage) DeleteRole(ctx context.Context, id string) error {
 	r.mu.Lock()
@@ -161,7 +156,11 @@ func (r *RBACStorage) CreatePermission(ctx context.Context, permission *models.P
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	
-	r.permissions[permission.ID] = permission
+	if permission.Version == 0 {
+		permission.Version = 1
+	}
+	stored := *permission
+	r.permissions[permission.ID] = &stored
 	return nil
 }
 
@@ -174,7 +173,9 @@ func (r *RBACStorage) GetPermissionByID(ctx context.Context, id string) (*models
 	if !exists {
 		return nil, ErrNotFound
 	}
-	return permission, nil
+	
+	result := *permission
+	return &result, nil
 }
 
 // listAllPermissions 모든 권한 조회 (내부 헬퍼 메서드)
@@ -374,8 +375,25 @@ func (r *RBACStorage) ListRoles(ctx context.Context, req models.ListRolesRequest
 	return result, int64(len(result)), nil
 }
 
+// UpdateRole 역할 저장
+// role.Version이 0보다 크면 저장된 버전과 같을 때만 저장하고, 저장하면 버전을 1 올립니다.
 func (r *RBACStorage) UpdateRole(ctx context.Context, role *models.Role) error {
-	return r.updateRoleByID(ctx, role.ID, nil)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	
+	current, exists := r.roles[role.ID]
+	if !exists {
+		return ErrNotFound
+	}
+	if err := storage.CheckVersion("role", role.ID, role.Version, current.Version); err != nil {
+		return err
+	}
+	
+	role.Version = current.Version + 1
+	role.UpdatedAt = time.Now()
+	stored := *role
+	r.roles[role.ID] = &stored
+	return nil
 }
 
 func (r *RBACStorage) GetUsersByRoleID(ctx context.Context, roleID string) ([]string, error) {
@@ -434,7 +452,23 @@ func (r *RBACStorage) ListPermissions(ctx context.Context, req models.ListPermis
 	return result, int64(len(result)), nil
 }
 
+// UpdatePermission 권한 저장 (UpdateRole과 같은 버전 검사)
 func (r *RBACStorage) UpdatePermission(ctx context.Context, permission *models.Permission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	
+	current, exists := r.permissions[permission.ID]
+	if !exists {
+		return ErrNotFound
+	}
+	if err := storage.CheckVersion("permission", permission.ID, permission.Version, current.Version); err != nil {
+		return err
+	}
+	
+	permission.Version = current.Version + 1
+	permission.UpdatedAt = time.Now()
+	stored := *permission
+	r.permissions[permission.ID] = &stored
 	return nil
 }
 
@@ -97,23 +97,23 @@ func (s *SessionStorage) List(ctx context.Context, filter *models.SessionFilter,
 		filtered = append(filtered, &sessionCopy)
 	}
 
-	// 페이징 적용
-	total := len(filtered)
-	start := (paging.Page - 1) * paging.Limit
-	end := start + paging.Limit
-	
-	if start > total {
-		start = total
+	// 정렬 및 페이징 적용 (커서 지원)
+	if paging == nil {
+		paging = &models.PaginationRequest{}
 	}
-	if end > total {
-		end = total
+	items, total, err := paginate(filtered, func(s *models.Session) string { return s.ID }, paging, models.SessionSortFields)
+	if err != nil {
+		return nil, err
 	}
-	
-	items := filtered[start:end]
-	
+
+	meta := models.NewPaginationMeta(paging.Page, paging.Limit, total)
+	if n := len(items); n > 0 {
+		meta.NextCursor = paging.NextCursorFor(n, items[n-1].ID, items[n-1].SortValue(paging.Sort))
+	}
+
 	return &models.PaginationResponse{
 		Data: items,
-		Meta: models.NewPaginationMeta(paging.Page, paging.Limit, total),
+		Meta: meta,
 	}, nil
 }
 
@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// sessionCommandStorage 메모리 기반 세션 셸 명령 실행 기록 스토리지
+type sessionCommandStorage struct {
+	commands map[string][]*models.SessionCommand // 세션 ID별 기록 (실행 순서대로)
+	mutex    sync.RWMutex
+}
+
+// storage.SessionCommandStorage 인터페이스 구현 확인
+var _ storage.SessionCommandStorage = (*sessionCommandStorage)(nil)
+
+// newSessionCommandStorage 새 명령 실행 기록 스토리지 생성
+func newSessionCommandStorage() *sessionCommandStorage {
+	return &sessionCommandStorage{
+		commands: make(map[string][]*models.SessionCommand),
+	}
+}
+
+// CreateBatch 실행 순서대로 기록 추가
+func (cs *sessionCommandStorage) CreateBatch(ctx context.Context, commands []*models.SessionCommand) error {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	now := time.Now()
+	for _, command := range commands {
+		if command.ID == "" {
+			command.ID = uuid.New().String()
+		}
+		if command.CreatedAt.IsZero() {
+			command.CreatedAt = now
+		}
+		cs.commands[command.SessionID] = append(cs.commands[command.SessionID], copySessionCommand(command))
+	}
+	return nil
+}
+
+// ListBySession 세션의 실행 기록을 실행 순서대로 조회
+func (cs *sessionCommandStorage) ListBySession(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionCommand, int, error) {
+	cs.mutex.RLock()
+	defer cs.mutex.RUnlock()
+
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	commands := cs.commands[sessionID]
+	total := len(commands)
+	start, end := pageBounds(total, paging)
+
+	result := make([]*models.SessionCommand, 0, end-start)
+	for _, command := range commands[start:end] {
+		result = append(result, copySessionCommand(command))
+	}
+	return result, total, nil
+}
+
+// copySessionCommand 종료 코드까지 복사한 기록
+func copySessionCommand(command *models.SessionCommand) *models.SessionCommand {
+	commandCopy := *command
+	if command.ExitCode != nil {
+		code := *command.ExitCode
+		commandCopy.ExitCode = &code
+	}
+	return &commandCopy
+}
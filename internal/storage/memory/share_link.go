@@ -0,0 +1,192 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// shareLinkStorage 메모리 기반 세션 공유 링크 스토리지
+type shareLinkStorage struct {
+	links  map[string]*models.SessionShareLink // 링크 ID별 공유 링크
+	byHash map[string]string                   // 토큰 해시 -> 링크 ID
+	joins  []*models.SessionShareJoin          // 참여 기록 (추가 순)
+	mutex  sync.RWMutex
+}
+
+// storage.ShareLinkStorage 인터페이스 구현 확인
+var _ storage.ShareLinkStorage = (*shareLinkStorage)(nil)
+
+// newShareLinkStorage 새 공유 링크 스토리지 생성
+func newShareLinkStorage() *shareLinkStorage {
+	return &shareLinkStorage{
+		links:  make(map[string]*models.SessionShareLink),
+		byHash: make(map[string]string),
+	}
+}
+
+// Create 새 공유 링크 생성
+func (ss *shareLinkStorage) Create(ctx context.Context, link *models.SessionShareLink) error {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	if link.ID == "" {
+		link.ID = uuid.New().String()
+	}
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = time.Now()
+	}
+	if _, exists := ss.links[link.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if _, exists := ss.byHash[link.TokenHash]; exists {
+		return ErrAlreadyExists
+	}
+
+	linkCopy := *link
+	ss.links[link.ID] = &linkCopy
+	ss.byHash[link.TokenHash] = link.ID
+	return nil
+}
+
+// GetByID ID로 공유 링크 조회
+func (ss *shareLinkStorage) GetByID(ctx context.Context, id string) (*models.SessionShareLink, error) {
+	ss.mutex.RLock()
+	defer ss.mutex.RUnlock()
+
+	link, exists := ss.links[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	linkCopy := *link
+	return &linkCopy, nil
+}
+
+// GetByTokenHash 토큰 해시로 공유 링크 조회
+func (ss *shareLinkStorage) GetByTokenHash(ctx context.Context, tokenHash string) (*models.SessionShareLink, error) {
+	ss.mutex.RLock()
+	defer ss.mutex.RUnlock()
+
+	id, exists := ss.byHash[tokenHash]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	linkCopy := *ss.links[id]
+	return &linkCopy, nil
+}
+
+// ListBySession 세션의 공유 링크 목록 조회 (최신순)
+func (ss *shareLinkStorage) ListBySession(ctx context.Context, sessionID string) ([]*models.SessionShareLink, error) {
+	ss.mutex.RLock()
+	defer ss.mutex.RUnlock()
+
+	links := []*models.SessionShareLink{}
+	for _, link := range ss.links {
+		if link.SessionID == sessionID {
+			linkCopy := *link
+			links = append(links, &linkCopy)
+		}
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].CreatedAt.After(links[j].CreatedAt)
+	})
+	return links, nil
+}
+
+// Revoke 공유 링크 폐기
+func (ss *shareLinkStorage) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	link, exists := ss.links[id]
+	if !exists {
+		return storage.ErrNotFound
+	}
+	if link.RevokedAt == nil {
+		link.RevokedAt = &revokedAt
+	}
+	return nil
+}
+
+// RecordJoin 공유 링크 참여 기록 추가
+func (ss *shareLinkStorage) RecordJoin(ctx context.Context, join *models.SessionShareJoin) error {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	link, exists := ss.links[join.LinkID]
+	if !exists {
+		return storage.ErrNotFound
+	}
+	if join.ID == "" {
+		join.ID = uuid.New().String()
+	}
+	if join.JoinedAt.IsZero() {
+		join.JoinedAt = time.Now()
+	}
+
+	link.JoinCount++
+	joinCopy := *join
+	ss.joins = append(ss.joins, &joinCopy)
+	return nil
+}
+
+// ListJoins 세션의 공유 링크 참여 기록 조회 (최신순)
+func (ss *shareLinkStorage) ListJoins(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionShareJoin, int, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	ss.mutex.RLock()
+	defer ss.mutex.RUnlock()
+
+	matched := []*models.SessionShareJoin{}
+	for i := len(ss.joins) - 1; i >= 0; i-- {
+		if ss.joins[i].SessionID == sessionID {
+			joinCopy := *ss.joins[i]
+			matched = append(matched, &joinCopy)
+		}
+	}
+
+	total := len(matched)
+	start, end := pageBounds(total, paging)
+	return matched[start:end], total, nil
+}
+
+// ListJoinsByUser 사용자의 공유 링크 참여 기록 조회 (최신순)
+func (ss *shareLinkStorage) ListJoinsByUser(ctx context.Context, userID string) ([]*models.SessionShareJoin, error) {
+	ss.mutex.RLock()
+	defer ss.mutex.RUnlock()
+
+	matched := []*models.SessionShareJoin{}
+	for i := len(ss.joins) - 1; i >= 0; i-- {
+		if userID != "" && ss.joins[i].UserID == userID {
+			joinCopy := *ss.joins[i]
+			matched = append(matched, &joinCopy)
+		}
+	}
+	return matched, nil
+}
+
+// AnonymizeJoins 사용자의 참여 기록에서 표시 이름, 접속 IP, User-Agent 삭제
+func (ss *shareLinkStorage) AnonymizeJoins(ctx context.Context, userID string) (int, error) {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	count := 0
+	for _, join := range ss.joins {
+		if userID != "" && join.UserID == userID {
+			join.UserName = ""
+			join.ClientIP = ""
+			join.UserAgent = ""
+			count++
+		}
+	}
+	return count, nil
+}
@@ -6,11 +6,34 @@ import (
 
 // Storage 메모리 기반 스토리지 구현
 type Storage struct {
-	workspace *WorkspaceStorage
-	project   *ProjectStorage
-	session   *SessionStorage
-	task      *taskStorage
-	rbac      *RBACStorage
+	workspace  *WorkspaceStorage
+	project    *ProjectStorage
+	session    *SessionStorage
+	task       *taskStorage
+	rbac       *RBACStorage
+	message    *messageStorage
+	shareLink  *shareLinkStorage
+	process    *processRecordStorage
+	errorStats *errorStatsStorage
+	account    *accountStorage
+	email      *emailDeliveryStorage
+	acl        *workspaceACLStorage
+	prompt     *promptStorage
+	pipeline   *pipelineStorage
+	fanOut     *fanOutStorage
+	taskReview *taskReviewStorage
+	webhook    *webhookMappingStorage
+	pullReq    *pullRequestStorage
+	issues     *issueTrackerStorage
+	terminal   *terminalStorage
+	artifacts  *taskArtifactStorage
+	privacy    *privacyRequestStorage
+	commands   *sessionCommandStorage
+	activity   *activityStorage
+	notices    *notificationStorage
+	analytics  *analyticsStorage
+	env        *workspaceEnvStorage
+	prefs      *userPreferenceStorage
 }
 
 // storage.Storage 인터페이스 구현 확인
@@ -19,11 +42,34 @@ var _ storage.Storage = (*Storage)(nil)
 // New 새 메모리 스토리지 생성
 func New() *Storage {
 	return &Storage{
-		workspace: NewWorkspaceStorage(),
-		project:   NewProjectStorage(),
-		session:   NewSessionStorage(),
-		task:      newTaskStorage(),
-		rbac:      NewRBACStorage(),
+		workspace:  NewWorkspaceStorage(),
+		project:    NewProjectStorage(),
+		session:    NewSessionStorage(),
+		task:       newTaskStorage(),
+		rbac:       NewRBACStorage(),
+		message:    newMessageStorage(),
+		shareLink:  newShareLinkStorage(),
+		process:    newProcessRecordStorage(),
+		errorStats: newErrorStatsStorage(),
+		account:    newAccountStorage(),
+		email:      newEmailDeliveryStorage(),
+		acl:        newWorkspaceACLStorage(),
+		prompt:     newPromptStorage(),
+		pipeline:   newPipelineStorage(),
+		fanOut:     newFanOutStorage(),
+		taskReview: newTaskReviewStorage(),
+		webhook:    newWebhookMappingStorage(),
+		pullReq:    newPullRequestStorage(),
+		issues:     newIssueTrackerStorage(),
+		terminal:   newTerminalStorage(),
+		artifacts:  newTaskArtifactStorage(),
+		privacy:    newPrivacyRequestStorage(),
+		commands:   newSessionCommandStorage(),
+		activity:   newActivityStorage(),
+		notices:    newNotificationStorage(),
+		analytics:  newAnalyticsStorage(),
+		env:        newWorkspaceEnvStorage(),
+		prefs:      newUserPreferenceStorage(),
 	}
 }
 
@@ -52,6 +98,121 @@ func (s *Storage) RBAC() storage.RBACStorage {
 	return s.rbac
 }
 
+// Message 세션 대화 기록 스토리지 반환
+func (s *Storage) Message() storage.MessageStorage {
+	return s.message
+}
+
+// ShareLink 세션 공유 링크 스토리지 반환
+func (s *Storage) ShareLink() storage.ShareLinkStorage {
+	return s.shareLink
+}
+
+// Process 실행 중인 CLI 프로세스 기록 스토리지 반환
+func (s *Storage) Process() storage.ProcessRecordStorage {
+	return s.process
+}
+
+// ErrorStats 에러 통계 집계 스토리지 반환
+func (s *Storage) ErrorStats() storage.ErrorStatsStorage {
+	return s.errorStats
+}
+
+// Account 로컬 계정 스토리지 반환
+func (s *Storage) Account() storage.AccountStorage {
+	return s.account
+}
+
+// EmailDelivery 메일 발송 기록 스토리지 반환
+func (s *Storage) EmailDelivery() storage.EmailDeliveryStorage {
+	return s.email
+}
+
+// WorkspaceACL 워크스페이스 공유 ACL 스토리지 반환
+func (s *Storage) WorkspaceACL() storage.WorkspaceACLStorage {
+	return s.acl
+}
+
+// Prompt 프롬프트 라이브러리 스토리지 반환
+func (s *Storage) Prompt() storage.PromptStorage {
+	return s.prompt
+}
+
+// Pipeline 파이프라인 스토리지 반환
+func (s *Storage) Pipeline() storage.PipelineStorage {
+	return s.pipeline
+}
+
+// FanOut 워크스페이스 팬아웃 스토리지 반환
+func (s *Storage) FanOut() storage.FanOutStorage {
+	return s.fanOut
+}
+
+// TaskReview 태스크 결과 검토 스토리지 반환
+func (s *Storage) TaskReview() storage.TaskReviewStorage {
+	return s.taskReview
+}
+
+// WebhookMapping 웹훅 매핑 스토리지 반환
+func (s *Storage) WebhookMapping() storage.WebhookMappingStorage {
+	return s.webhook
+}
+
+// PullRequest 워크스페이스 PR 설정과 태스크 PR 스토리지 반환
+func (s *Storage) PullRequest() storage.PullRequestStorage {
+	return s.pullReq
+}
+
+// IssueTracker 워크스페이스 이슈 트래커 설정과 이슈 연결 스토리지 반환
+func (s *Storage) IssueTracker() storage.IssueTrackerStorage {
+	return s.issues
+}
+
+// Terminal 워크스페이스 터미널 접속 기록과 녹화 스토리지 반환
+func (s *Storage) Terminal() storage.TerminalStorage {
+	return s.terminal
+}
+
+// TaskArtifact 태스크 결과물 스토리지 반환
+func (s *Storage) TaskArtifact() storage.TaskArtifactStorage {
+	return s.artifacts
+}
+
+// PrivacyRequest 개인정보 내보내기/삭제 처리 기록 스토리지 반환
+func (s *Storage) PrivacyRequest() storage.PrivacyRequestStorage {
+	return s.privacy
+}
+
+// SessionCommand 세션 셸 명령 실행 기록 스토리지 반환
+func (s *Storage) SessionCommand() storage.SessionCommandStorage {
+	return s.commands
+}
+
+// Activity 활동 피드 스토리지 반환
+func (s *Storage) Activity() storage.ActivityStorage {
+	return s.activity
+}
+
+// Notification 알림 센터 스토리지 반환
+func (s *Storage) Notification() storage.NotificationStorage {
+	return s.notices
+}
+
+// Analytics 사용량 분석 롤업 스토리지 반환
+func (s *Storage) Analytics() storage.AnalyticsStorage {
+	return s.analytics
+}
+
+// WorkspaceEnv 워크스페이스 환경 변수 스토리지 반환
+func (s *Storage) WorkspaceEnv() storage.WorkspaceEnvStorage {
+	return s.env
+}
+
+// UserPreference 사용자 언어 설정 스토리지 반환
+func (s *Storage) UserPreference() storage.UserPreferenceStorage {
+	return s.prefs
+}
+
 // Close 스토리지 연결 종료 (메모리 스토리지는 아무 작업 없음)
 func (s *Storage) Close() error {
 	return nil
@@ -49,6 +49,7 @@ func (ts *taskStorage) Create(ctx context.Context, task *models.Task) error {
 	if task.Status == "" {
 		task.Status = models.TaskPending
 	}
+	task.TimeoutTier = task.TimeoutTier.OrDefault()
 	
 	// 중복 확인
 	if _, exists := ts.tasks[task.ID]; exists {
@@ -93,27 +94,10 @@ func (ts *taskStorage) List(ctx context.Context, filter *models.TaskFilter, pagi
 		allTasks = ts.applyFilter(allTasks, filter)
 	}
 	
-	// 정렬 (최신순)
-	sort.Slice(allTasks, func(i, j int) bool {
-		return allTasks[i].CreatedAt.After(allTasks[j].CreatedAt)
-	})
-	
-	total := len(allTasks)
-	
-	// 페이징 적용
-	if paging != nil {
-		start := (paging.Page - 1) * paging.Limit
-		end := start + paging.Limit
-		
-		if start >= total {
-			return []*models.Task{}, total, nil
-		}
-		
-		if end > total {
-			end = total
-		}
-		
-		allTasks = allTasks[start:end]
+	// 정렬 및 페이지네이션 (커서 지원)
+	allTasks, total, err := paginate(allTasks, func(t *models.Task) string { return t.ID }, paging, models.TaskSortFields)
+	if err != nil {
+		return nil, 0, err
 	}
 	
 	// 복사본 반환
@@ -186,6 +170,38 @@ func (ts *taskStorage) GetActiveCount(ctx context.Context, sessionID string) (in
 	return count, nil
 }
 
+// ListExecutedBetween from 초과 to 이하에 끝났거나 to 시점에 실행 중이던 태스크 조회 (종료 시각순)
+func (ts *taskStorage) ListExecutedBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+	
+	var result []*models.Task
+	for _, task := range ts.tasks {
+		finished := task.CompletedAt != nil && task.CompletedAt.After(from) && !task.CompletedAt.After(to)
+		running := task.StartedAt != nil && !task.StartedAt.After(to) && (task.CompletedAt == nil || task.CompletedAt.After(to))
+		if !finished && !running {
+			continue
+		}
+		taskCopy := *task
+		result = append(result, &taskCopy)
+	}
+	
+	// 실행 중인 태스크(종료 시각 없음)는 마지막에
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].CompletedAt, result[j].CompletedAt
+		switch {
+		case a == nil || b == nil:
+			return b == nil && a != nil
+		case !a.Equal(*b):
+			return a.Before(*b)
+		default:
+			return result[i].ID < result[j].ID
+		}
+	})
+	
+	return result, nil
+}
+
 // applyFilter 필터 적용
 func (ts *taskStorage) applyFilter(tasks []*models.Task, filter *models.TaskFilter) []*models.Task {
 	var filtered []*models.Task
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// taskArtifactStorage 메모리 기반 태스크 결과물 스토리지
+type taskArtifactStorage struct {
+	byTask map[string][]*models.TaskArtifact // 태스크 ID -> 추출 순서의 결과물
+	mutex  sync.RWMutex
+}
+
+// storage.TaskArtifactStorage 인터페이스 구현 확인
+var _ storage.TaskArtifactStorage = (*taskArtifactStorage)(nil)
+
+// newTaskArtifactStorage 새 태스크 결과물 스토리지 생성
+func newTaskArtifactStorage() *taskArtifactStorage {
+	return &taskArtifactStorage{
+		byTask: make(map[string][]*models.TaskArtifact),
+	}
+}
+
+// ReplaceByTask 태스크의 결과물을 모두 교체
+func (as *taskArtifactStorage) ReplaceByTask(ctx context.Context, taskID string, artifacts []*models.TaskArtifact) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	now := time.Now()
+	stored := make([]*models.TaskArtifact, len(artifacts))
+	for i, artifact := range artifacts {
+		if artifact.ID == "" {
+			artifact.ID = uuid.New().String()
+		}
+		if artifact.CreatedAt.IsZero() {
+			artifact.CreatedAt = now
+		}
+		artifact.TaskID = taskID
+		stored[i] = copyTaskArtifact(artifact)
+	}
+
+	if len(stored) == 0 {
+		delete(as.byTask, taskID)
+		return nil
+	}
+	as.byTask[taskID] = stored
+	return nil
+}
+
+// ListByTask 태스크의 결과물을 추출 순서대로 조회
+func (as *taskArtifactStorage) ListByTask(ctx context.Context, taskID string, artifactType models.TaskArtifactType) ([]*models.TaskArtifact, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	artifacts := []*models.TaskArtifact{}
+	for _, artifact := range as.byTask[taskID] {
+		if artifactType != "" && artifact.Type != artifactType {
+			continue
+		}
+		artifacts = append(artifacts, copyTaskArtifact(artifact))
+	}
+	return artifacts, nil
+}
+
+// copyTaskArtifact 테스트 보고서까지 복사한 결과물
+func copyTaskArtifact(artifact *models.TaskArtifact) *models.TaskArtifact {
+	artifactCopy := *artifact
+	if artifact.TestReport != nil {
+		report := *artifact.TestReport
+		report.Failures = append([]string(nil), artifact.TestReport.Failures...)
+		artifactCopy.TestReport = &report
+	}
+	return &artifactCopy
+}
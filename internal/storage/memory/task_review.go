@@ -0,0 +1,256 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// taskReviewStorage 메모리 기반 태스크 검토 스토리지
+type taskReviewStorage struct {
+	reviews  map[string]*models.TaskReview
+	byTask   map[string]string // 태스크 ID -> 검토 ID
+	comments map[string]*models.TaskReviewComment
+	mutex    sync.RWMutex
+}
+
+// storage.TaskReviewStorage 인터페이스 구현 확인
+var _ storage.TaskReviewStorage = (*taskReviewStorage)(nil)
+
+// newTaskReviewStorage 새 태스크 검토 스토리지 생성
+func newTaskReviewStorage() *taskReviewStorage {
+	return &taskReviewStorage{
+		reviews:  make(map[string]*models.TaskReview),
+		byTask:   make(map[string]string),
+		comments: make(map[string]*models.TaskReviewComment),
+	}
+}
+
+// Create 새 검토 생성
+func (rs *taskReviewStorage) Create(ctx context.Context, review *models.TaskReview) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if review.ID == "" {
+		review.ID = uuid.New().String()
+	}
+	if _, exists := rs.reviews[review.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if _, exists := rs.byTask[review.TaskID]; exists {
+		return ErrAlreadyExists
+	}
+	if review.CreatedAt.IsZero() {
+		review.CreatedAt = time.Now()
+	}
+	review.UpdatedAt = review.CreatedAt
+
+	rs.reviews[review.ID] = copyTaskReview(review)
+	rs.byTask[review.TaskID] = review.ID
+	return nil
+}
+
+// GetByID ID로 검토 조회
+func (rs *taskReviewStorage) GetByID(ctx context.Context, id string) (*models.TaskReview, error) {
+	rs.mutex.RLock()
+	defer rs.mutex.RUnlock()
+
+	review, exists := rs.reviews[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyTaskReview(review), nil
+}
+
+// GetByTaskID 태스크의 검토 조회
+func (rs *taskReviewStorage) GetByTaskID(ctx context.Context, taskID string) (*models.TaskReview, error) {
+	rs.mutex.RLock()
+	defer rs.mutex.RUnlock()
+
+	id, exists := rs.byTask[taskID]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyTaskReview(rs.reviews[id]), nil
+}
+
+// Update 검토 저장
+func (rs *taskReviewStorage) Update(ctx context.Context, review *models.TaskReview) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if _, exists := rs.reviews[review.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	review.UpdatedAt = time.Now()
+	rs.reviews[review.ID] = copyTaskReview(review)
+	return nil
+}
+
+// Delete 검토와 의견 삭제
+func (rs *taskReviewStorage) Delete(ctx context.Context, id string) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	review, exists := rs.reviews[id]
+	if !exists {
+		return storage.ErrNotFound
+	}
+	for commentID, comment := range rs.comments {
+		if comment.ReviewID == id {
+			delete(rs.comments, commentID)
+		}
+	}
+	delete(rs.byTask, review.TaskID)
+	delete(rs.reviews, id)
+	return nil
+}
+
+// List 조건에 맞는 검토 조회 (최신순)
+func (rs *taskReviewStorage) List(ctx context.Context, filter *models.TaskReviewFilter) ([]*models.TaskReview, error) {
+	rs.mutex.RLock()
+	defer rs.mutex.RUnlock()
+
+	var workspaces map[string]bool
+	if filter.WorkspaceIDs != nil {
+		workspaces = make(map[string]bool, len(filter.WorkspaceIDs))
+		for _, id := range filter.WorkspaceIDs {
+			workspaces[id] = true
+		}
+	}
+
+	reviews := []*models.TaskReview{}
+	for _, review := range rs.reviews {
+		if workspaces != nil && !workspaces[review.WorkspaceID] {
+			continue
+		}
+		if filter.WorkspaceID != "" && review.WorkspaceID != filter.WorkspaceID {
+			continue
+		}
+		if filter.ProjectID != "" && review.ProjectID != filter.ProjectID {
+			continue
+		}
+		if filter.Status != "" && review.Status != filter.Status {
+			continue
+		}
+		if filter.OpenOnly && !review.Status.IsOpen() {
+			continue
+		}
+		reviews = append(reviews, copyTaskReview(review))
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		if !reviews[i].CreatedAt.Equal(reviews[j].CreatedAt) {
+			return reviews[i].CreatedAt.After(reviews[j].CreatedAt)
+		}
+		return reviews[i].ID > reviews[j].ID
+	})
+
+	if filter.Limit > 0 && len(reviews) > filter.Limit {
+		reviews = reviews[:filter.Limit]
+	}
+	return reviews, nil
+}
+
+// CreateComment 검토 의견 추가
+func (rs *taskReviewStorage) CreateComment(ctx context.Context, comment *models.TaskReviewComment) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if _, exists := rs.reviews[comment.ReviewID]; !exists {
+		return storage.ErrNotFound
+	}
+	if comment.ID == "" {
+		comment.ID = uuid.New().String()
+	}
+	if _, exists := rs.comments[comment.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if comment.CreatedAt.IsZero() {
+		comment.CreatedAt = time.Now()
+	}
+	comment.UpdatedAt = comment.CreatedAt
+
+	commentCopy := *comment
+	rs.comments[comment.ID] = &commentCopy
+	return nil
+}
+
+// GetComment 검토 의견 조회
+func (rs *taskReviewStorage) GetComment(ctx context.Context, id string) (*models.TaskReviewComment, error) {
+	rs.mutex.RLock()
+	defer rs.mutex.RUnlock()
+
+	comment, exists := rs.comments[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	commentCopy := *comment
+	return &commentCopy, nil
+}
+
+// UpdateComment 검토 의견 저장
+func (rs *taskReviewStorage) UpdateComment(ctx context.Context, comment *models.TaskReviewComment) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if _, exists := rs.comments[comment.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	comment.UpdatedAt = time.Now()
+	commentCopy := *comment
+	rs.comments[comment.ID] = &commentCopy
+	return nil
+}
+
+// DeleteComment 검토 의견 삭제
+func (rs *taskReviewStorage) DeleteComment(ctx context.Context, id string) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	if _, exists := rs.comments[id]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(rs.comments, id)
+	return nil
+}
+
+// ListComments 검토의 의견 조회 (파일, 줄, 작성 순)
+func (rs *taskReviewStorage) ListComments(ctx context.Context, reviewID string) ([]*models.TaskReviewComment, error) {
+	rs.mutex.RLock()
+	defer rs.mutex.RUnlock()
+
+	comments := []*models.TaskReviewComment{}
+	for _, comment := range rs.comments {
+		if comment.ReviewID == reviewID {
+			commentCopy := *comment
+			comments = append(comments, &commentCopy)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		a, b := comments[i], comments[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+	return comments, nil
+}
+
+// copyTaskReview 변경 파일 목록까지 복사한 검토
+func copyTaskReview(review *models.TaskReview) *models.TaskReview {
+	reviewCopy := *review
+	reviewCopy.FilesChanged = append([]string{}, review.FilesChanged...)
+	return &reviewCopy
+}
@@ -0,0 +1,166 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// terminalStorage 메모리 기반 터미널 접속 기록과 녹화 스토리지
+type terminalStorage struct {
+	sessions   map[string]*models.TerminalSession
+	recordings map[string][]byte // 세션 ID -> asciicast 녹화
+	mutex      sync.RWMutex
+}
+
+// storage.TerminalStorage 인터페이스 구현 확인
+var _ storage.TerminalStorage = (*terminalStorage)(nil)
+
+// newTerminalStorage 새 터미널 스토리지 생성
+func newTerminalStorage() *terminalStorage {
+	return &terminalStorage{
+		sessions:   make(map[string]*models.TerminalSession),
+		recordings: make(map[string][]byte),
+	}
+}
+
+// Create 새 터미널 세션 기록 생성
+func (ts *terminalStorage) Create(ctx context.Context, session *models.TerminalSession) error {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if session.ID == "" {
+		session.ID = uuid.New().String()
+	}
+	if _, exists := ts.sessions[session.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if session.StartedAt.IsZero() {
+		session.StartedAt = time.Now()
+	}
+
+	ts.sessions[session.ID] = copyTerminalSession(session)
+	return nil
+}
+
+// Update 터미널 세션 기록 저장
+func (ts *terminalStorage) Update(ctx context.Context, session *models.TerminalSession) error {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if _, exists := ts.sessions[session.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	ts.sessions[session.ID] = copyTerminalSession(session)
+	return nil
+}
+
+// GetByID ID로 터미널 세션 기록 조회
+func (ts *terminalStorage) GetByID(ctx context.Context, id string) (*models.TerminalSession, error) {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	session, exists := ts.sessions[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyTerminalSession(session), nil
+}
+
+// ListByWorkspace 워크스페이스의 터미널 세션 기록 조회 (최신순)
+func (ts *terminalStorage) ListByWorkspace(ctx context.Context, workspaceID string, limit int) ([]*models.TerminalSession, error) {
+	return ts.list(func(session *models.TerminalSession) bool { return session.WorkspaceID == workspaceID }, limit), nil
+}
+
+// list 조건에 맞는 기록을 최신순으로 조회
+func (ts *terminalStorage) list(match func(*models.TerminalSession) bool, limit int) []*models.TerminalSession {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	sessions := []*models.TerminalSession{}
+	for _, session := range ts.sessions {
+		if match(session) {
+			sessions = append(sessions, copyTerminalSession(session))
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].StartedAt.Equal(sessions[j].StartedAt) {
+			return sessions[i].StartedAt.After(sessions[j].StartedAt)
+		}
+		return sessions[i].ID > sessions[j].ID
+	})
+
+	if limit > 0 && len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+	return sessions
+}
+
+// SaveRecording 녹화 데이터 저장
+func (ts *terminalStorage) SaveRecording(ctx context.Context, id string, data []byte) error {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if _, exists := ts.sessions[id]; !exists {
+		return storage.ErrNotFound
+	}
+	ts.recordings[id] = append([]byte(nil), data...)
+	return nil
+}
+
+// GetRecording 녹화 데이터 조회
+func (ts *terminalStorage) GetRecording(ctx context.Context, id string) ([]byte, error) {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	data, exists := ts.recordings[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// ListByUser 사용자의 터미널 세션 기록 조회 (최신순)
+func (ts *terminalStorage) ListByUser(ctx context.Context, userID string, limit int) ([]*models.TerminalSession, error) {
+	return ts.list(func(session *models.TerminalSession) bool { return session.UserID == userID }, limit), nil
+}
+
+// AnonymizeByUser 사용자의 기록에서 접속 IP, User-Agent와 녹화 삭제
+func (ts *terminalStorage) AnonymizeByUser(ctx context.Context, userID string) (int, error) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	count := 0
+	for id, session := range ts.sessions {
+		if session.UserID != userID {
+			continue
+		}
+		session.ClientIP = ""
+		session.UserAgent = ""
+		session.RecordingSize = 0
+		session.RecordingTruncated = false
+		delete(ts.recordings, id)
+		count++
+	}
+	return count, nil
+}
+
+// copyTerminalSession 종료 코드와 종료 시각까지 복사한 세션 기록
+func copyTerminalSession(session *models.TerminalSession) *models.TerminalSession {
+	sessionCopy := *session
+	if session.ExitCode != nil {
+		code := *session.ExitCode
+		sessionCopy.ExitCode = &code
+	}
+	if session.EndedAt != nil {
+		endedAt := *session.EndedAt
+		sessionCopy.EndedAt = &endedAt
+	}
+	return &sessionCopy
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// userPreferenceStorage 메모리 기반 사용자 언어 설정 스토리지
+type userPreferenceStorage struct {
+	preferences map[string]models.UserPreference // key: 사용자 ID
+	mutex       sync.RWMutex
+}
+
+// storage.UserPreferenceStorage 인터페이스 구현 확인
+var _ storage.UserPreferenceStorage = (*userPreferenceStorage)(nil)
+
+// newUserPreferenceStorage 새 사용자 설정 스토리지 생성
+func newUserPreferenceStorage() *userPreferenceStorage {
+	return &userPreferenceStorage{
+		preferences: make(map[string]models.UserPreference),
+	}
+}
+
+// Get 사용자 설정 조회
+func (ps *userPreferenceStorage) Get(ctx context.Context, userID string) (*models.UserPreference, error) {
+	ps.mutex.RLock()
+	defer ps.mutex.RUnlock()
+
+	preference, exists := ps.preferences[userID]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return &preference, nil
+}
+
+// Save 사용자 설정 저장
+func (ps *userPreferenceStorage) Save(ctx context.Context, preference *models.UserPreference) error {
+	ps.mutex.Lock()
+	defer ps.mutex.Unlock()
+
+	preference.UpdatedAt = time.Now()
+	ps.preferences[preference.UserID] = *preference
+	return nil
+}
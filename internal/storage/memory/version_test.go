@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+func TestWorkspaceStorage_UpdateVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	s := NewWorkspaceStorage()
+
+	ws := &models.Workspace{Name: "ws", ProjectPath: "/tmp/ws", OwnerID: "u1"}
+	require.NoError(t, s.Create(ctx, ws))
+	assert.Equal(t, 1, ws.Version)
+
+	// 버전 없이 수정하면 검사 없이 버전만 증가
+	require.NoError(t, s.Update(ctx, ws.ID, map[string]interface{}{"claude_key": "k1"}))
+	// 최신 버전으로 수정
+	require.NoError(t, s.Update(ctx, ws.ID, map[string]interface{}{"claude_key": "k2", storage.VersionKey: 2}))
+
+	// 오래된 버전은 거부
+	err := s.Update(ctx, ws.ID, map[string]interface{}{"claude_key": "k3", storage.VersionKey: 2})
+	require.Error(t, err)
+	assert.True(t, storage.IsConflictError(err))
+
+	var conflict *storage.ConflictError
+	require.True(t, errors.As(err, &conflict))
+	assert.Equal(t, 2, conflict.ExpectedVersion)
+	assert.Equal(t, 3, conflict.CurrentVersion)
+
+	got, err := s.GetByID(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "k2", got.ClaudeKey)
+	assert.Equal(t, 3, got.Version)
+}
+
+func TestRBACStorage_UpdateRoleVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	s := NewRBACStorage()
+
+	require.NoError(t, s.CreateRole(ctx, &models.Role{BaseModel: models.BaseModel{ID: "r1"}, Name: "editor"}))
+
+	// 같은 버전을 읽은 두 요청 중 먼저 저장한 쪽만 성공
+	first, err := s.GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+	second, err := s.GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+
+	first.Description = "first"
+	require.NoError(t, s.UpdateRole(ctx, first))
+	assert.Equal(t, 2, first.Version)
+
+	second.Description = "second"
+	assert.True(t, storage.IsConflictError(s.UpdateRole(ctx, second)))
+
+	got, err := s.GetRoleByID(ctx, "r1")
+	require.NoError(t, err)
+	assert.Equal(t, "first", got.Description)
+}
+
+func TestExpectedVersion(t *testing.T) {
+	v, ok := storage.ExpectedVersion(map[string]interface{}{storage.VersionKey: float64(3)})
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	_, ok = storage.ExpectedVersion(map[string]interface{}{storage.VersionKey: 0})
+	assert.False(t, ok)
+	_, ok = storage.ExpectedVersion(map[string]interface{}{})
+	assert.False(t, ok)
+}
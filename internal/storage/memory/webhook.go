@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// webhookMappingStorage 메모리 기반 웹훅 매핑 스토리지
+type webhookMappingStorage struct {
+	mappings   map[string]*models.WebhookMapping
+	deliveries map[string]map[string]time.Time // 매핑 ID -> 전송 식별자 -> 기록 시각
+	mutex      sync.RWMutex
+}
+
+// storage.WebhookMappingStorage 인터페이스 구현 확인
+var _ storage.WebhookMappingStorage = (*webhookMappingStorage)(nil)
+
+// newWebhookMappingStorage 새 웹훅 매핑 스토리지 생성
+func newWebhookMappingStorage() *webhookMappingStorage {
+	return &webhookMappingStorage{
+		mappings:   make(map[string]*models.WebhookMapping),
+		deliveries: make(map[string]map[string]time.Time),
+	}
+}
+
+// Create 새 매핑 생성
+func (ws *webhookMappingStorage) Create(ctx context.Context, mapping *models.WebhookMapping) error {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	if mapping.ID == "" {
+		mapping.ID = uuid.New().String()
+	}
+	if _, exists := ws.mappings[mapping.ID]; exists {
+		return ErrAlreadyExists
+	}
+	if mapping.CreatedAt.IsZero() {
+		mapping.CreatedAt = time.Now()
+	}
+	mapping.UpdatedAt = mapping.CreatedAt
+
+	ws.mappings[mapping.ID] = copyWebhookMapping(mapping)
+	return nil
+}
+
+// GetByID ID로 매핑 조회
+func (ws *webhookMappingStorage) GetByID(ctx context.Context, id string) (*models.WebhookMapping, error) {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	mapping, exists := ws.mappings[id]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	return copyWebhookMapping(mapping), nil
+}
+
+// Update 매핑 저장
+func (ws *webhookMappingStorage) Update(ctx context.Context, mapping *models.WebhookMapping) error {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	if _, exists := ws.mappings[mapping.ID]; !exists {
+		return storage.ErrNotFound
+	}
+	mapping.UpdatedAt = time.Now()
+	ws.mappings[mapping.ID] = copyWebhookMapping(mapping)
+	return nil
+}
+
+// Delete 매핑 삭제
+func (ws *webhookMappingStorage) Delete(ctx context.Context, id string) error {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	if _, exists := ws.mappings[id]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(ws.mappings, id)
+	delete(ws.deliveries, id)
+	return nil
+}
+
+// ListByOwner 사용자의 매핑 조회 (이름순)
+func (ws *webhookMappingStorage) ListByOwner(ctx context.Context, ownerID string) ([]*models.WebhookMapping, error) {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	mappings := []*models.WebhookMapping{}
+	for _, mapping := range ws.mappings {
+		if ownerID == "" || mapping.OwnerID == ownerID {
+			mappings = append(mappings, copyWebhookMapping(mapping))
+		}
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		if mappings[i].Name != mappings[j].Name {
+			return mappings[i].Name < mappings[j].Name
+		}
+		return mappings[i].ID < mappings[j].ID
+	})
+	return mappings, nil
+}
+
+// RecordDelivery 매핑이 처리한 전송 식별자 기록
+func (ws *webhookMappingStorage) RecordDelivery(ctx context.Context, mappingID string, keys []string, since time.Time) (bool, error) {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	seen := ws.deliveries[mappingID]
+	if seen == nil {
+		seen = make(map[string]time.Time)
+		ws.deliveries[mappingID] = seen
+	}
+	for key, recordedAt := range seen {
+		if recordedAt.Before(since) {
+			delete(seen, key)
+		}
+	}
+	for _, key := range keys {
+		if _, exists := seen[key]; exists {
+			return false, nil
+		}
+	}
+	now := time.Now()
+	for _, key := range keys {
+		seen[key] = now
+	}
+	return true, nil
+}
+
+// copyWebhookMapping 목록 필드와 마지막 수신 기록까지 복사한 매핑
+func copyWebhookMapping(mapping *models.WebhookMapping) *models.WebhookMapping {
+	mappingCopy := *mapping
+	mappingCopy.Triggers = append([]models.WebhookTrigger{}, mapping.Triggers...)
+	mappingCopy.Branches = append([]string{}, mapping.Branches...)
+	mappingCopy.AllowedSenders = append([]string{}, mapping.AllowedSenders...)
+	if mapping.LastDelivery != nil {
+		delivery := *mapping.LastDelivery
+		mappingCopy.LastDelivery = &delivery
+	}
+	return &mappingCopy
+}
@@ -3,7 +3,6 @@ package memory
 import (
 	"context"
 	"fmt"
-	"sort"
 	"sync"
 	"time"
 
@@ -50,6 +49,7 @@ func (s *WorkspaceStorage) Create(ctx context.Context, workspace *models.Workspa
 	now := time.Now()
 	workspace.CreatedAt = now
 	workspace.UpdatedAt = now
+	workspace.Version = 1
 	if workspace.Status == "" {
 		workspace.Status = models.WorkspaceStatusActive
 	}
@@ -89,45 +89,16 @@ func (s *WorkspaceStorage) GetByOwnerID(ctx context.Context, ownerID string, pag
 		}
 	}
 
-	// 정렬
-	sort.Slice(filtered, func(i, j int) bool {
-		switch pagination.Sort {
-		case "name":
-			if pagination.Order == "asc" {
-				return filtered[i].Name < filtered[j].Name
-			}
-			return filtered[i].Name > filtered[j].Name
-		case "updated_at":
-			if pagination.Order == "asc" {
-				return filtered[i].UpdatedAt.Before(filtered[j].UpdatedAt)
-			}
-			return filtered[i].UpdatedAt.After(filtered[j].UpdatedAt)
-		default: // created_at
-			if pagination.Order == "asc" {
-				return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
-			}
-			return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
-		}
-	})
-
-	// 페이지네이션
-	total := len(filtered)
-	offset := pagination.GetOffset()
-	limit := pagination.Limit
-
-	if offset >= total {
-		return []*models.Workspace{}, total, nil
-	}
-
-	end := offset + limit
-	if end > total {
-		end = total
+	// 정렬 및 페이지네이션 (커서 지원)
+	page, total, err := paginate(filtered, func(w *models.Workspace) string { return w.ID }, pagination, models.WorkspaceSortFields)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// 복사본 반환
-	result := make([]*models.Workspace, 0, end-offset)
-	for i := offset; i < end; i++ {
-		ws := *filtered[i]
+	result := make([]*models.Workspace, 0, len(page))
+	for _, w := range page {
+		ws := *w
 		result = append(result, &ws)
 	}
 
@@ -144,20 +115,36 @@ func (s *WorkspaceStorage) Update(ctx context.Context, id string, updates map[st
 		return ErrNotFound
 	}
 
-	// 이름 변경 시 중복 확인
-	if name, ok := updates["name"].(string); ok && name != workspace.Name {
-		nameKey := fmt.Sprintf("%s:%s", workspace.OwnerID, name)
+	// 읽은 뒤 다른 요청이 먼저 수정했으면 거부
+	if expected, ok := storage.ExpectedVersion(updates); ok {
+		if err := storage.CheckVersion("workspace", id, expected, workspace.Version); err != nil {
+			return err
+		}
+	}
+
+	// 이름 또는 소유자 변경 시 중복 확인
+	name, nameChanged := updates["name"].(string)
+	if !nameChanged {
+		name = workspace.Name
+	}
+	ownerID, ownerChanged := updates["owner_id"].(string)
+	if !ownerChanged {
+		ownerID = workspace.OwnerID
+	}
+	if name != workspace.Name || ownerID != workspace.OwnerID {
+		nameKey := fmt.Sprintf("%s:%s", ownerID, name)
 		if existingID, exists := s.nameIndex[nameKey]; exists && existingID != id {
 			return ErrAlreadyExists
 		}
-		
+
 		// 기존 인덱스 삭제
 		oldNameKey := fmt.Sprintf("%s:%s", workspace.OwnerID, workspace.Name)
 		delete(s.nameIndex, oldNameKey)
-		
+
 		// 새 인덱스 추가
 		s.nameIndex[nameKey] = id
 		workspace.Name = name
+		workspace.OwnerID = ownerID
 	}
 
 	// 다른 필드 업데이트
@@ -172,6 +159,7 @@ func (s *WorkspaceStorage) Update(ctx context.Context, id string, updates map[st
 	}
 
 	workspace.UpdatedAt = time.Now()
+	workspace.Version++
 
 	return nil
 }
@@ -211,45 +199,16 @@ func (s *WorkspaceStorage) List(ctx context.Context, pagination *models.Paginati
 		}
 	}
 
-	// 정렬
-	sort.Slice(filtered, func(i, j int) bool {
-		switch pagination.Sort {
-		case "name":
-			if pagination.Order == "asc" {
-				return filtered[i].Name < filtered[j].Name
-			}
-			return filtered[i].Name > filtered[j].Name
-		case "updated_at":
-			if pagination.Order == "asc" {
-				return filtered[i].UpdatedAt.Before(filtered[j].UpdatedAt)
-			}
-			return filtered[i].UpdatedAt.After(filtered[j].UpdatedAt)
-		default: // created_at
-			if pagination.Order == "asc" {
-				return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
-			}
-			return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
-		}
-	})
-
-	// 페이지네이션
-	total := len(filtered)
-	offset := pagination.GetOffset()
-	limit := pagination.Limit
-
-	if offset >= total {
-		return []*models.Workspace{}, total, nil
-	}
-
-	end := offset + limit
-	if end > total {
-		end = total
+	// 정렬 및 페이지네이션 (커서 지원)
+	page, total, err := paginate(filtered, func(w *models.Workspace) string { return w.ID }, pagination, models.WorkspaceSortFields)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// 복사본 반환
-	result := make([]*models.Workspace, 0, end-offset)
-	for i := offset; i < end; i++ {
-		ws := *filtered[i]
+	result := make([]*models.Workspace, 0, len(page))
+	for _, w := range page {
+		ws := *w
 		result = append(result, &ws)
 	}
 
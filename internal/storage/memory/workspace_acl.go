@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// workspaceACLStorage 메모리 기반 워크스페이스 공유 ACL 스토리지
+type workspaceACLStorage struct {
+	entries map[string]*models.WorkspaceACLEntry // key: 워크스페이스 ID + 대상
+	mutex   sync.RWMutex
+}
+
+// storage.WorkspaceACLStorage 인터페이스 구현 확인
+var _ storage.WorkspaceACLStorage = (*workspaceACLStorage)(nil)
+
+// newWorkspaceACLStorage 새 ACL 스토리지 생성
+func newWorkspaceACLStorage() *workspaceACLStorage {
+	return &workspaceACLStorage{
+		entries: make(map[string]*models.WorkspaceACLEntry),
+	}
+}
+
+// aclKey 워크스페이스와 대상으로 항목 키 생성
+func aclKey(workspaceID string, principalType models.ACLPrincipalType, principalID string) string {
+	return workspaceID + "/" + string(principalType) + "/" + principalID
+}
+
+// Upsert 워크스페이스와 대상이 같은 항목이 있으면 권한을 바꾸고 없으면 추가
+func (as *workspaceACLStorage) Upsert(ctx context.Context, entry *models.WorkspaceACLEntry) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	now := time.Now()
+	key := aclKey(entry.WorkspaceID, entry.PrincipalType, entry.PrincipalID)
+	if existing, exists := as.entries[key]; exists {
+		entry.ID = existing.ID
+		entry.CreatedAt = existing.CreatedAt
+	} else {
+		if entry.ID == "" {
+			entry.ID = uuid.New().String()
+		}
+		entry.CreatedAt = now
+	}
+	entry.UpdatedAt = now
+
+	entryCopy := *entry
+	as.entries[key] = &entryCopy
+	return nil
+}
+
+// Delete 항목 삭제
+func (as *workspaceACLStorage) Delete(ctx context.Context, workspaceID string, principalType models.ACLPrincipalType, principalID string) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	key := aclKey(workspaceID, principalType, principalID)
+	if _, exists := as.entries[key]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(as.entries, key)
+	return nil
+}
+
+// DeleteByWorkspace 워크스페이스의 모든 항목 삭제
+func (as *workspaceACLStorage) DeleteByWorkspace(ctx context.Context, workspaceID string) error {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	for key, entry := range as.entries {
+		if entry.WorkspaceID == workspaceID {
+			delete(as.entries, key)
+		}
+	}
+	return nil
+}
+
+// ListByWorkspace 워크스페이스의 항목 조회 (대상 종류, 대상 ID순)
+func (as *workspaceACLStorage) ListByWorkspace(ctx context.Context, workspaceID string) ([]*models.WorkspaceACLEntry, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	entries := []*models.WorkspaceACLEntry{}
+	for _, entry := range as.entries {
+		if entry.WorkspaceID == workspaceID {
+			entryCopy := *entry
+			entries = append(entries, &entryCopy)
+		}
+	}
+	sortACLEntries(entries)
+	return entries, nil
+}
+
+// ListByPrincipals 대상 중 하나에게 부여된 모든 항목 조회
+func (as *workspaceACLStorage) ListByPrincipals(ctx context.Context, principals []models.ACLPrincipal) ([]*models.WorkspaceACLEntry, error) {
+	as.mutex.RLock()
+	defer as.mutex.RUnlock()
+
+	wanted := make(map[models.ACLPrincipal]bool, len(principals))
+	for _, principal := range principals {
+		wanted[principal] = true
+	}
+
+	entries := []*models.WorkspaceACLEntry{}
+	for _, entry := range as.entries {
+		if wanted[models.ACLPrincipal{Type: entry.PrincipalType, ID: entry.PrincipalID}] {
+			entryCopy := *entry
+			entries = append(entries, &entryCopy)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].WorkspaceID != entries[j].WorkspaceID {
+			return entries[i].WorkspaceID < entries[j].WorkspaceID
+		}
+		return lessACLEntry(entries[i], entries[j])
+	})
+	return entries, nil
+}
+
+// sortACLEntries 대상 종류, 대상 ID순 정렬
+func sortACLEntries(entries []*models.WorkspaceACLEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return lessACLEntry(entries[i], entries[j])
+	})
+}
+
+// lessACLEntry 대상 종류, 대상 ID 비교
+func lessACLEntry(a, b *models.WorkspaceACLEntry) bool {
+	if a.PrincipalType != b.PrincipalType {
+		return a.PrincipalType < b.PrincipalType
+	}
+	return a.PrincipalID < b.PrincipalID
+}
@@ -0,0 +1,160 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// workspaceEnvStorage 메모리 기반 워크스페이스 환경 변수 스토리지
+type workspaceEnvStorage struct {
+	variables map[string]*models.WorkspaceEnvVar // key: 워크스페이스 ID + 변수 이름
+	changes   []*models.WorkspaceEnvChange
+	mutex     sync.RWMutex
+}
+
+// storage.WorkspaceEnvStorage 인터페이스 구현 확인
+var _ storage.WorkspaceEnvStorage = (*workspaceEnvStorage)(nil)
+
+// newWorkspaceEnvStorage 새 환경 변수 스토리지 생성
+func newWorkspaceEnvStorage() *workspaceEnvStorage {
+	return &workspaceEnvStorage{
+		variables: make(map[string]*models.WorkspaceEnvVar),
+	}
+}
+
+// envKey 워크스페이스와 변수 이름으로 항목 키 생성
+func envKey(workspaceID, key string) string {
+	return workspaceID + "/" + key
+}
+
+// Create 변수 추가
+func (es *workspaceEnvStorage) Create(ctx context.Context, variable *models.WorkspaceEnvVar) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	key := envKey(variable.WorkspaceID, variable.Key)
+	if _, exists := es.variables[key]; exists {
+		return storage.ErrAlreadyExists
+	}
+	now := time.Now()
+	variable.CreatedAt = now
+	variable.UpdatedAt = now
+
+	variableCopy := *variable
+	es.variables[key] = &variableCopy
+	return nil
+}
+
+// Get 변수 조회
+func (es *workspaceEnvStorage) Get(ctx context.Context, workspaceID, key string) (*models.WorkspaceEnvVar, error) {
+	es.mutex.RLock()
+	defer es.mutex.RUnlock()
+
+	variable, exists := es.variables[envKey(workspaceID, key)]
+	if !exists {
+		return nil, storage.ErrNotFound
+	}
+	variableCopy := *variable
+	return &variableCopy, nil
+}
+
+// Update 변수의 값, 비밀 여부, 변경한 사용자 저장
+func (es *workspaceEnvStorage) Update(ctx context.Context, variable *models.WorkspaceEnvVar) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	existing, exists := es.variables[envKey(variable.WorkspaceID, variable.Key)]
+	if !exists {
+		return storage.ErrNotFound
+	}
+	variable.CreatedAt = existing.CreatedAt
+	variable.UpdatedAt = time.Now()
+
+	variableCopy := *variable
+	es.variables[envKey(variable.WorkspaceID, variable.Key)] = &variableCopy
+	return nil
+}
+
+// Delete 변수 삭제
+func (es *workspaceEnvStorage) Delete(ctx context.Context, workspaceID, key string) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	if _, exists := es.variables[envKey(workspaceID, key)]; !exists {
+		return storage.ErrNotFound
+	}
+	delete(es.variables, envKey(workspaceID, key))
+	return nil
+}
+
+// DeleteByWorkspace 워크스페이스의 모든 변수 삭제
+func (es *workspaceEnvStorage) DeleteByWorkspace(ctx context.Context, workspaceID string) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	for key, variable := range es.variables {
+		if variable.WorkspaceID == workspaceID {
+			delete(es.variables, key)
+		}
+	}
+	return nil
+}
+
+// List 워크스페이스의 변수 조회 (이름순)
+func (es *workspaceEnvStorage) List(ctx context.Context, workspaceID string) ([]*models.WorkspaceEnvVar, error) {
+	es.mutex.RLock()
+	defer es.mutex.RUnlock()
+
+	variables := []*models.WorkspaceEnvVar{}
+	for _, variable := range es.variables {
+		if variable.WorkspaceID == workspaceID {
+			variableCopy := *variable
+			variables = append(variables, &variableCopy)
+		}
+	}
+	sort.Slice(variables, func(i, j int) bool { return variables[i].Key < variables[j].Key })
+	return variables, nil
+}
+
+// AddChange 변경 기록 추가
+func (es *workspaceEnvStorage) AddChange(ctx context.Context, change *models.WorkspaceEnvChange) error {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	if change.ID == "" {
+		change.ID = uuid.New().String()
+	}
+	if change.CreatedAt.IsZero() {
+		change.CreatedAt = time.Now()
+	}
+	changeCopy := *change
+	es.changes = append(es.changes, &changeCopy)
+	return nil
+}
+
+// ListChanges 워크스페이스의 변경 기록 조회 (최신순)
+func (es *workspaceEnvStorage) ListChanges(ctx context.Context, workspaceID, key string, limit int) ([]*models.WorkspaceEnvChange, error) {
+	es.mutex.RLock()
+	defer es.mutex.RUnlock()
+
+	changes := []*models.WorkspaceEnvChange{}
+	for i := len(es.changes) - 1; i >= 0; i-- {
+		change := es.changes[i]
+		if change.WorkspaceID != workspaceID || (key != "" && change.Key != key) {
+			continue
+		}
+		changeCopy := *change
+		changes = append(changes, &changeCopy)
+		if limit > 0 && len(changes) >= limit {
+			break
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// snippetRadius 미리보기에서 일치 위치 앞뒤로 보여줄 문자 수
+const snippetRadius = 60
+
+// SearchTerms 검색어를 소문자 단어 목록으로 분리
+func SearchTerms(query string) []string {
+	fields := strings.Fields(strings.ToLower(query))
+	terms := fields[:0]
+	for _, f := range fields {
+		f = strings.Trim(f, `"'`)
+		if f != "" {
+			terms = append(terms, f)
+		}
+	}
+	return terms
+}
+
+// MatchesAllTerms 내용에 모든 검색 단어가 포함되어 있는지 확인 (대소문자 무시)
+func MatchesAllTerms(content string, terms []string) bool {
+	lower := strings.ToLower(content)
+	for _, term := range terms {
+		if !strings.Contains(lower, term) {
+			return false
+		}
+	}
+	return len(terms) > 0
+}
+
+// MessageSnippet 첫 번째로 일치하는 단어 주변의 미리보기 문자열 생성
+func MessageSnippet(content string, terms []string) string {
+	lower := strings.ToLower(content)
+	pos := -1
+	for _, term := range terms {
+		if i := strings.Index(lower, term); i >= 0 && (pos < 0 || i < pos) {
+			pos = i
+		}
+	}
+	// 소문자 변환으로 바이트 길이가 달라진 경우 앞부분부터 보여줌
+	if pos < 0 || len(lower) != len(content) {
+		pos = 0
+	}
+
+	start := pos
+	for n := 0; start > 0 && n < snippetRadius; n++ {
+		_, size := utf8.DecodeLastRuneInString(content[:start])
+		start -= size
+	}
+	end := pos
+	for n := 0; end < len(content) && n < snippetRadius*2; n++ {
+		_, size := utf8.DecodeRuneInString(content[end:])
+		end += size
+	}
+
+	snippet := strings.Join(strings.Fields(content[start:end]), " ")
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(content) {
+		snippet += "…"
+	}
+	return snippet
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"hash/fnv"
+
+	"go.uber.org/zap"
+
+	"github.com/aicli/aicli-web/internal/cluster"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// cluster.Guard 인터페이스 구현 확인
+var _ cluster.Guard = (*Storage)(nil)
+
+// lockNamespace 다른 애플리케이션의 advisory lock 키와 겹치지 않도록 이름 앞에 붙이는 접두사
+const lockNamespace = "aicli:"
+
+// WithLock 이름의 PostgreSQL advisory lock을 잡고 fn을 실행합니다
+// 같은 데이터베이스를 쓰는 다른 인스턴스가 잠금을 잡고 있으면 기다리지 않고 cluster.ErrLocked를 반환합니다.
+// 세션 단위 잠금이므로 잠금을 잡은 연결을 fn이 끝날 때까지 풀에서 빼 두고, 끝나면 해제합니다.
+// 연결이 끊기면 데이터베이스가 잠금을 자동으로 풀어 주므로 인스턴스가 죽어도 잠금이 남지 않습니다.
+func (s *Storage) WithLock(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return storage.ConvertError(err, "acquire lock connection", storageType)
+	}
+	defer conn.Close()
+
+	key := lockKey(name)
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		return storage.ConvertError(err, "try advisory lock", storageType)
+	}
+	if !acquired {
+		return cluster.ErrLocked
+	}
+	defer func() {
+		// fn의 컨텍스트가 취소되어도 잠금은 해제
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			s.logger.Warn("advisory lock 해제 실패", zap.String("name", name), zap.Error(err))
+		}
+	}()
+
+	return fn(ctx)
+}
+
+// lockKey 잠금 이름을 advisory lock의 64비트 키로 바꿉니다 (FNV-1a)
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(lockNamespace + name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,302 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
+)
+
+// projectStorage 프로젝트 스토리지 PostgreSQL 구현
+type projectStorage struct {
+	db executor
+}
+
+const (
+	// 프로젝트 조회 쿼리
+	selectProjectQuery = `
+		SELECT id, workspace_id, name, path, description, git_url, git_branch,
+		       language, status, config, git_info, created_at, updated_at, deleted_at
+		FROM projects
+	`
+
+	// 프로젝트 삽입 쿼리
+	insertProjectQuery = `
+		INSERT INTO projects (id, workspace_id, name, path, description, git_url, git_branch,
+		                      language, status, config, git_info, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 프로젝트 삭제 쿼리 (soft delete)
+	deleteProjectQuery = `
+		UPDATE projects
+		SET deleted_at = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	// 프로젝트 존재 여부 확인 쿼리
+	existsProjectByNameQuery = `
+		SELECT EXISTS (SELECT 1 FROM projects WHERE workspace_id = ? AND name = ? AND deleted_at IS NULL)
+	`
+
+	// 카운트 쿼리
+	countProjectsQuery = `SELECT COUNT(*) FROM projects`
+)
+
+// projectUpdateFields Update에서 수정할 수 있는 컬럼
+var projectUpdateFields = map[string]bool{
+	"workspace_id": true,
+	"name":         true,
+	"path":         true,
+	"description":  true,
+	"git_url":      true,
+	"git_branch":   true,
+	"language":     true,
+	"status":       true,
+	"config":       true,
+	"git_info":     true,
+}
+
+// Create 새 프로젝트 생성
+func (p *projectStorage) Create(ctx context.Context, project *models.Project) error {
+	now := time.Now()
+
+	if project.Status == "" {
+		project.Status = models.ProjectStatusActive
+	}
+	project.CreatedAt = now
+	project.UpdatedAt = now
+
+	configJSON, err := json.Marshal(project.Config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	gitInfoJSON, err := marshalGitInfo(project.GitInfo)
+	if err != nil {
+		return fmt.Errorf("failed to marshal git info: %w", err)
+	}
+
+	_, err = p.db.execContext(ctx, insertProjectQuery,
+		project.ID,
+		project.WorkspaceID,
+		project.Name,
+		project.Path,
+		project.Description,
+		project.GitURL,
+		project.GitBranch,
+		project.Language,
+		project.Status,
+		string(configJSON),
+		gitInfoJSON,
+		project.CreatedAt,
+		project.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create project", storageType)
+	}
+
+	return nil
+}
+
+// GetByID ID로 프로젝트 조회
+func (p *projectStorage) GetByID(ctx context.Context, id string) (*models.Project, error) {
+	return p.get(ctx, "get project by id", ` WHERE id = ? AND deleted_at IS NULL`, id)
+}
+
+// GetByPath 경로로 프로젝트 조회
+func (p *projectStorage) GetByPath(ctx context.Context, path string) (*models.Project, error) {
+	return p.get(ctx, "get project by path", ` WHERE path = ? AND deleted_at IS NULL`, path)
+}
+
+// get 조건에 맞는 프로젝트 하나를 조회
+func (p *projectStorage) get(ctx context.Context, operation, whereClause string, args ...interface{}) (*models.Project, error) {
+	project, err := scanProject(p.db.queryRowContext(ctx, selectProjectQuery+whereClause, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, operation, storageType)
+	}
+
+	return project, nil
+}
+
+// GetByWorkspaceID 워크스페이스 ID로 프로젝트 목록 조회
+func (p *projectStorage) GetByWorkspaceID(ctx context.Context, workspaceID string, pagination *models.PaginationRequest) ([]*models.Project, int, error) {
+	if pagination == nil {
+		pagination = &models.PaginationRequest{Page: 1, Limit: 20, Sort: "created_at", Order: "desc"}
+	}
+	pagination.Normalize()
+
+	whereClause := ` WHERE workspace_id = ? AND deleted_at IS NULL`
+
+	var total int
+	if err := p.db.queryRowContext(ctx, countProjectsQuery+whereClause, workspaceID).Scan(&total); err != nil {
+		return nil, 0, storage.ConvertError(err, "count projects by workspace", storageType)
+	}
+
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(pagination, models.ProjectSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectProjectQuery + page.AppendWhere(whereClause) + page.Tail
+	args := append(append([]interface{}{workspaceID}, page.WhereArgs...), page.TailArgs...)
+
+	rows, err := p.db.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "get projects by workspace", storageType)
+	}
+	defer rows.Close()
+
+	var projects []*models.Project
+	for rows.Next() {
+		project, err := scanProject(rows)
+		if err != nil {
+			return nil, 0, storage.ConvertError(err, "scan project row", storageType)
+		}
+		projects = append(projects, project)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, storage.ConvertError(err, "scan project rows", storageType)
+	}
+
+	return projects, total, nil
+}
+
+// Update 프로젝트 업데이트
+func (p *projectStorage) Update(ctx context.Context, id string, updates map[string]interface{}) error {
+	if len(updates) == 0 {
+		return storage.ErrInvalidInput
+	}
+
+	// updated_at은 항상 업데이트
+	setParts := []string{"updated_at = ?"}
+	args := []interface{}{time.Now()}
+
+	for field, value := range updates {
+		if !projectUpdateFields[field] {
+			return fmt.Errorf("field '%s' is not allowed for update", field)
+		}
+
+		// JSONB 컬럼은 직렬화해서 저장
+		switch v := value.(type) {
+		case models.ProjectConfig:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("failed to marshal config: %w", err)
+			}
+			value = string(data)
+		case *models.GitInfo:
+			data, err := marshalGitInfo(v)
+			if err != nil {
+				return fmt.Errorf("failed to marshal git info: %w", err)
+			}
+			value = data
+		}
+
+		setParts = append(setParts, field+" = ?")
+		args = append(args, value)
+	}
+
+	query := `UPDATE projects SET ` + strings.Join(setParts, ", ") + ` WHERE id = ? AND deleted_at IS NULL`
+	args = append(args, id)
+
+	result, err := p.db.execContext(ctx, query, args...)
+	if err != nil {
+		return storage.ConvertError(err, "update project", storageType)
+	}
+
+	return requireAffected(result, "update project")
+}
+
+// Delete 프로젝트 삭제 (soft delete)
+func (p *projectStorage) Delete(ctx context.Context, id string) error {
+	now := time.Now()
+
+	result, err := p.db.execContext(ctx, deleteProjectQuery, now, now, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete project", storageType)
+	}
+
+	return requireAffected(result, "delete project")
+}
+
+// ExistsByName 워크스페이스 내 이름으로 존재 여부 확인
+func (p *projectStorage) ExistsByName(ctx context.Context, workspaceID, name string) (bool, error) {
+	var exists bool
+	if err := p.db.queryRowContext(ctx, existsProjectByNameQuery, workspaceID, name).Scan(&exists); err != nil {
+		return false, storage.ConvertError(err, "check project exists by name", storageType)
+	}
+
+	return exists, nil
+}
+
+// marshalGitInfo GitInfo를 JSONB 값으로 직렬화 (없으면 NULL)
+func marshalGitInfo(gitInfo *models.GitInfo) (interface{}, error) {
+	if gitInfo == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(gitInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// scanProject 프로젝트 한 행 스캔
+func scanProject(row scanner) (*models.Project, error) {
+	project := &models.Project{}
+	var deletedAt sql.NullTime
+	var description, gitURL, gitBranch, language sql.NullString
+	var configJSON, gitInfoJSON []byte
+
+	err := row.Scan(
+		&project.ID,
+		&project.WorkspaceID,
+		&project.Name,
+		&project.Path,
+		&description,
+		&gitURL,
+		&gitBranch,
+		&language,
+		&project.Status,
+		&configJSON,
+		&gitInfoJSON,
+		&project.CreatedAt,
+		&project.UpdatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Description = description.String
+	project.GitURL = gitURL.String
+	project.GitBranch = gitBranch.String
+	project.Language = language.String
+	if deletedAt.Valid {
+		project.DeletedAt = &deletedAt.Time
+	}
+
+	if len(configJSON) > 0 {
+		if err := json.Unmarshal(configJSON, &project.Config); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+	if len(gitInfoJSON) > 0 {
+		if err := json.Unmarshal(gitInfoJSON, &project.GitInfo); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal git info: %w", err)
+		}
+	}
+
+	return project, nil
+}
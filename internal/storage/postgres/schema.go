@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+)
+
+// schemaSQL 시작할 때 적용하는 테이블과 인덱스 정의
+//
+//go:embed schema.sql
+var schemaSQL string
+
+// schemaLockName 여러 인스턴스가 동시에 시작할 때 스키마 적용을 직렬화하는 advisory lock 이름
+const schemaLockName = "schema"
+
+// applySchema 테이블과 인덱스를 만듭니다 (이미 있으면 그대로 둠)
+func applySchema(ctx context.Context, db *sql.DB) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("스키마 적용 연결 실패: %w", err)
+	}
+	defer conn.Close()
+
+	// 동시에 시작한 인스턴스끼리 CREATE가 겹치지 않도록 잠금을 잡고 적용
+	key := lockKey(schemaLockName)
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+		return fmt.Errorf("스키마 잠금 실패: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key)
+
+	if _, err := conn.ExecContext(ctx, schemaSQL); err != nil {
+		return fmt.Errorf("PostgreSQL 스키마 적용 실패: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+-- AICode Manager PostgreSQL Schema
+-- SQLite 스키마(001_initial ~ 026)와 같은 컬럼 구성, JSON 데이터는 JSONB 컬럼에 저장
+-- 모든 문장은 여러 번 실행해도 안전하도록 IF NOT EXISTS를 사용합니다.
+
+-- Workspace 테이블
+CREATE TABLE IF NOT EXISTS workspaces (
+    id VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    project_path VARCHAR(500) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'inactive', 'archived')),
+    owner_id VARCHAR(50) NOT NULL,
+    claude_key TEXT, -- 암호화된 API 키
+    active_tasks INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+-- 삭제되지 않은 워크스페이스는 소유자 안에서 이름이 유일
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspaces_owner_name_unique ON workspaces(owner_id, name) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_workspaces_owner_created ON workspaces(owner_id, created_at DESC) WHERE deleted_at IS NULL;
+
+-- Project 테이블
+CREATE TABLE IF NOT EXISTS projects (
+    id VARCHAR(36) PRIMARY KEY,
+    workspace_id VARCHAR(36) NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    path VARCHAR(500) NOT NULL,
+    description TEXT,
+    git_url VARCHAR(500),
+    git_branch VARCHAR(100),
+    language VARCHAR(50),
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'inactive', 'archived')),
+    config JSONB NOT NULL DEFAULT '{}',
+    git_info JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted_at TIMESTAMPTZ,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_projects_workspace ON projects(workspace_id, created_at DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_projects_path ON projects(path) WHERE deleted_at IS NULL;
+
+-- Session 테이블
+CREATE TABLE IF NOT EXISTS sessions (
+    id VARCHAR(36) PRIMARY KEY,
+    project_id VARCHAR(36) NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    process_id INTEGER,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'active', 'idle', 'ending', 'ended', 'error')),
+    started_at TIMESTAMPTZ,
+    ended_at TIMESTAMPTZ,
+    last_active TIMESTAMPTZ NOT NULL DEFAULT now(),
+    metadata JSONB NOT NULL DEFAULT '{}',
+    command_count BIGINT NOT NULL DEFAULT 0,
+    bytes_in BIGINT NOT NULL DEFAULT 0,
+    bytes_out BIGINT NOT NULL DEFAULT 0,
+    error_count BIGINT NOT NULL DEFAULT 0,
+    max_idle_time BIGINT NOT NULL DEFAULT 1800000000000, -- 30분 (나노초)
+    max_lifetime BIGINT NOT NULL DEFAULT 14400000000000, -- 4시간 (나노초)
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_project_status ON sessions(project_id, status);
+-- 메타데이터 키/값 포함 검색 (metadata @> '{"key": "value"}')
+CREATE INDEX IF NOT EXISTS idx_sessions_metadata ON sessions USING GIN (metadata jsonb_path_ops);
+
+-- Task 테이블
+CREATE TABLE IF NOT EXISTS tasks (
+    id VARCHAR(36) PRIMARY KEY,
+    session_id VARCHAR(36) NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    command TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed', 'cancelled')),
+    output TEXT,
+    error TEXT,
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    timeout_tier VARCHAR(20) NOT NULL DEFAULT 'standard' CHECK (timeout_tier IN ('quick', 'standard', 'long')),
+    models JSONB NOT NULL DEFAULT '[]',
+    served_model VARCHAR(100) NOT NULL DEFAULT '',
+    model_attempts JSONB NOT NULL DEFAULT '[]',
+    dry_run BOOLEAN NOT NULL DEFAULT FALSE,
+    snapshot BOOLEAN NOT NULL DEFAULT FALSE,
+    bytes_in BIGINT NOT NULL DEFAULT 0,
+    bytes_out BIGINT NOT NULL DEFAULT 0,
+    duration BIGINT NOT NULL DEFAULT 0, -- 밀리초
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_session_status ON tasks(session_id, status);
+CREATE INDEX IF NOT EXISTS idx_tasks_session_created ON tasks(session_id, created_at DESC);
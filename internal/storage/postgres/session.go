@@ -0,0 +1,332 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
+)
+
+// sessionStorage 세션 스토리지 PostgreSQL 구현
+type sessionStorage struct {
+	db executor
+}
+
+const (
+	// 세션 조회 쿼리
+	selectSessionQuery = `
+		SELECT id, project_id, process_id, status, started_at, ended_at, last_active,
+		       metadata, command_count, bytes_in, bytes_out, error_count,
+		       max_idle_time, max_lifetime, created_at, updated_at, version
+		FROM sessions
+	`
+
+	// 세션 삽입 쿼리
+	insertSessionQuery = `
+		INSERT INTO sessions (id, project_id, process_id, status, started_at, ended_at,
+		                      last_active, metadata, command_count, bytes_in, bytes_out,
+		                      error_count, max_idle_time, max_lifetime, created_at, updated_at, version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 세션 업데이트 쿼리
+	updateSessionQuery = `
+		UPDATE sessions
+		SET status = ?, started_at = ?, ended_at = ?, last_active = ?, metadata = ?,
+		    command_count = ?, bytes_in = ?, bytes_out = ?, error_count = ?,
+		    max_idle_time = ?, max_lifetime = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ?
+	`
+
+	// 세션 삭제 쿼리
+	deleteSessionQuery = `DELETE FROM sessions WHERE id = ?`
+
+	// 활성 세션 수 조회 쿼리
+	countActiveSessionsQuery = `
+		SELECT COUNT(*) FROM sessions
+		WHERE project_id = ? AND status IN ('active', 'idle')
+	`
+
+	// 카운트 쿼리
+	countSessionsQuery = `SELECT COUNT(*) FROM sessions`
+)
+
+// Create 새 세션 생성
+func (s *sessionStorage) Create(ctx context.Context, session *models.Session) error {
+	now := time.Now()
+
+	if session.Status == "" {
+		session.Status = models.SessionPending
+	}
+	session.CreatedAt = now
+	session.UpdatedAt = now
+	session.LastActive = now
+	if session.Version == 0 {
+		session.Version = 1
+	}
+
+	// 기본 타임아웃 설정
+	if session.MaxIdleTime == 0 {
+		session.MaxIdleTime = 30 * time.Minute
+	}
+	if session.MaxLifetime == 0 {
+		session.MaxLifetime = 4 * time.Hour
+	}
+
+	metadataJSON, err := marshalMetadata(session.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	_, err = s.db.execContext(ctx, insertSessionQuery,
+		session.ID,
+		session.ProjectID,
+		session.ProcessID,
+		session.Status,
+		session.StartedAt,
+		session.EndedAt,
+		session.LastActive,
+		metadataJSON,
+		session.CommandCount,
+		session.BytesIn,
+		session.BytesOut,
+		session.ErrorCount,
+		int64(session.MaxIdleTime),
+		int64(session.MaxLifetime),
+		session.CreatedAt,
+		session.UpdatedAt,
+		session.Version,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create session", storageType)
+	}
+
+	return nil
+}
+
+// GetByID ID로 세션 조회
+func (s *sessionStorage) GetByID(ctx context.Context, id string) (*models.Session, error) {
+	session, err := scanSession(s.db.queryRowContext(ctx, selectSessionQuery+` WHERE id = ?`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, "get session by id", storageType)
+	}
+
+	return session, nil
+}
+
+// List 세션 목록 조회
+func (s *sessionStorage) List(ctx context.Context, filter *models.SessionFilter, paging *models.PaginationRequest) (*models.PaginationResponse, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{Page: 1, Limit: 20, Sort: "created_at", Order: "desc"}
+	}
+	paging.Normalize()
+
+	var whereConditions []string
+	var args []interface{}
+
+	if filter != nil {
+		if filter.ProjectID != "" {
+			whereConditions = append(whereConditions, "project_id = ?")
+			args = append(args, filter.ProjectID)
+		}
+		if filter.Status != "" {
+			whereConditions = append(whereConditions, "status = ?")
+			args = append(args, filter.Status)
+		}
+		if filter.Active != nil {
+			if *filter.Active {
+				whereConditions = append(whereConditions, "status IN ('active', 'idle')")
+			} else {
+				whereConditions = append(whereConditions, "status IN ('ended', 'error')")
+			}
+		}
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = " WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	var total int
+	if err := s.db.queryRowContext(ctx, countSessionsQuery+whereClause, args...).Scan(&total); err != nil {
+		return nil, storage.ConvertError(err, "count sessions", storageType)
+	}
+
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(paging, models.SessionSortFields)
+	if err != nil {
+		return nil, err
+	}
+	query := selectSessionQuery + page.AppendWhere(whereClause) + page.Tail
+	queryArgs := append(append(args, page.WhereArgs...), page.TailArgs...)
+
+	rows, err := s.db.queryContext(ctx, query, queryArgs...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list sessions", storageType)
+	}
+	defer rows.Close()
+
+	var sessions []*models.Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan session row", storageType)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "scan session rows", storageType)
+	}
+
+	totalPages := total / paging.Limit
+	if total%paging.Limit > 0 {
+		totalPages++
+	}
+
+	meta := models.PaginationMeta{
+		CurrentPage: paging.Page,
+		PerPage:     paging.Limit,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     paging.Page < totalPages,
+		HasPrev:     paging.Page > 1,
+	}
+	if n := len(sessions); n > 0 {
+		meta.NextCursor = paging.NextCursorFor(n, sessions[n-1].ID, sessions[n-1].SortValue(paging.Sort))
+	}
+
+	return &models.PaginationResponse{
+		Data: sessions,
+		Meta: meta,
+	}, nil
+}
+
+// Update 세션 업데이트 (저장된 버전이 session.Version과 같을 때만 수정)
+func (s *sessionStorage) Update(ctx context.Context, session *models.Session) error {
+	metadataJSON, err := marshalMetadata(session.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	session.UpdatedAt = time.Now()
+
+	result, err := s.db.execContext(ctx, updateSessionQuery,
+		session.Status,
+		session.StartedAt,
+		session.EndedAt,
+		session.LastActive,
+		metadataJSON,
+		session.CommandCount,
+		session.BytesIn,
+		session.BytesOut,
+		session.ErrorCount,
+		int64(session.MaxIdleTime),
+		int64(session.MaxLifetime),
+		session.UpdatedAt,
+		session.ID,
+		session.Version,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update session", storageType)
+	}
+	if err := requireAffected(result, "update session"); err != nil {
+		return err
+	}
+
+	session.Version++
+	return nil
+}
+
+// Delete 세션 삭제
+func (s *sessionStorage) Delete(ctx context.Context, id string) error {
+	result, err := s.db.execContext(ctx, deleteSessionQuery, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete session", storageType)
+	}
+
+	return requireAffected(result, "delete session")
+}
+
+// GetActiveCount 활성 세션 수 조회
+func (s *sessionStorage) GetActiveCount(ctx context.Context, projectID string) (int64, error) {
+	var count int64
+	if err := s.db.queryRowContext(ctx, countActiveSessionsQuery, projectID).Scan(&count); err != nil {
+		return 0, storage.ConvertError(err, "get active session count", storageType)
+	}
+
+	return count, nil
+}
+
+// marshalMetadata 메타데이터를 JSONB 값으로 직렬화
+func marshalMetadata(metadata map[string]string) (string, error) {
+	if len(metadata) == 0 {
+		return "{}", nil
+	}
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// scanSession 세션 한 행 스캔
+func scanSession(row scanner) (*models.Session, error) {
+	session := &models.Session{}
+	var processID sql.NullInt64
+	var startedAt, endedAt sql.NullTime
+	var metadataJSON []byte
+	var maxIdleTimeNS, maxLifetimeNS int64
+
+	err := row.Scan(
+		&session.ID,
+		&session.ProjectID,
+		&processID,
+		&session.Status,
+		&startedAt,
+		&endedAt,
+		&session.LastActive,
+		&metadataJSON,
+		&session.CommandCount,
+		&session.BytesIn,
+		&session.BytesOut,
+		&session.ErrorCount,
+		&maxIdleTimeNS,
+		&maxLifetimeNS,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+		&session.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session.ProcessID = int(processID.Int64)
+	if startedAt.Valid {
+		session.StartedAt = &startedAt.Time
+	}
+	if endedAt.Valid {
+		session.EndedAt = &endedAt.Time
+	}
+	session.MaxIdleTime = time.Duration(maxIdleTimeNS)
+	session.MaxLifetime = time.Duration(maxLifetimeNS)
+
+	session.Metadata = make(map[string]string)
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &session.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+		}
+	}
+
+	return session, nil
+}
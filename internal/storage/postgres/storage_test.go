@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"자리표시자 없음", "SELECT 1", "SELECT 1"},
+		{"순서대로 번호", "SELECT * FROM tasks WHERE id = ? AND version = ?", "SELECT * FROM tasks WHERE id = $1 AND version = $2"},
+		{"문자열 안의 물음표는 그대로", "SELECT '?' FROM tasks WHERE status IN ('a?', 'b') AND id = ?", "SELECT '?' FROM tasks WHERE status IN ('a?', 'b') AND id = $1"},
+		{"10개 이상", "VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", "VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rebind(tt.query))
+		})
+	}
+}
+
+func TestLockKey(t *testing.T) {
+	// 인스턴스마다 같은 이름은 같은 키가 되어야 잠금을 공유
+	assert.Equal(t, lockKey("job:backup"), lockKey("job:backup"))
+	assert.NotEqual(t, lockKey("job:backup"), lockKey("job:process-record-purge"))
+	assert.NotEqual(t, lockKey(schemaLockName), lockKey("job:"+schemaLockName))
+}
+
+func TestNew_InvalidConfig(t *testing.T) {
+	_, err := New(Config{})
+	assert.Error(t, err)
+
+	config := DefaultConfig()
+	config.Driver = "not-registered"
+	config.DataSource = "postgres://localhost/aicli"
+	_, err = New(config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not-registered")
+}
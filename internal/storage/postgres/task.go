@@ -0,0 +1,348 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
+)
+
+// taskStorage 태스크 스토리지 PostgreSQL 구현
+type taskStorage struct {
+	db executor
+}
+
+const (
+	// 태스크 조회 쿼리
+	selectTaskQuery = `
+		SELECT id, session_id, command, status, output, error, started_at, completed_at,
+		       timeout_tier, models, served_model, model_attempts, dry_run, snapshot, bytes_in, bytes_out, duration,
+		       created_at, updated_at, version
+		FROM tasks
+	`
+
+	// 태스크 삽입 쿼리
+	insertTaskQuery = `
+		INSERT INTO tasks (id, session_id, command, status, output, error, started_at,
+		                   completed_at, timeout_tier, models, served_model, model_attempts, dry_run, snapshot, bytes_in, bytes_out,
+		                   duration, created_at, updated_at, version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 태스크 업데이트 쿼리
+	updateTaskQuery = `
+		UPDATE tasks
+		SET command = ?, status = ?, output = ?, error = ?, started_at = ?, completed_at = ?,
+		    served_model = ?, model_attempts = ?, bytes_in = ?, bytes_out = ?, duration = ?, updated_at = ?,
+		    version = version + 1
+		WHERE id = ? AND version = ?
+	`
+
+	// 태스크 삭제 쿼리
+	deleteTaskQuery = `DELETE FROM tasks WHERE id = ?`
+
+	// 활성 태스크 수 조회 쿼리
+	countActiveTasksQuery = `
+		SELECT COUNT(*) FROM tasks
+		WHERE session_id = ? AND status IN ('pending', 'running')
+	`
+
+	// 카운트 쿼리
+	countTasksQuery = `SELECT COUNT(*) FROM tasks`
+
+	// 기간 안에 끝났거나 기간 끝에 실행 중이던 태스크 조건 (사용량 집계용)
+	executedTasksClause = `
+		WHERE (completed_at > ? AND completed_at <= ?)
+		   OR (started_at IS NOT NULL AND started_at <= ? AND (completed_at IS NULL OR completed_at > ?))
+		ORDER BY completed_at NULLS LAST, id
+	`
+)
+
+// Create 새 태스크 생성
+func (t *taskStorage) Create(ctx context.Context, task *models.Task) error {
+	now := time.Now()
+
+	if task.Status == "" {
+		task.Status = models.TaskPending
+	}
+	task.TimeoutTier = task.TimeoutTier.OrDefault()
+	task.CreatedAt = now
+	task.UpdatedAt = now
+	if task.Version == 0 {
+		task.Version = 1
+	}
+
+	taskModels, err := marshalJSONArray(task.Models)
+	if err != nil {
+		return storage.ConvertError(err, "marshal task models", storageType)
+	}
+	attempts, err := marshalJSONArray(task.ModelAttempts)
+	if err != nil {
+		return storage.ConvertError(err, "marshal task model attempts", storageType)
+	}
+
+	_, err = t.db.execContext(ctx, insertTaskQuery,
+		task.ID,
+		task.SessionID,
+		task.Command,
+		task.Status,
+		task.Output,
+		task.Error,
+		task.StartedAt,
+		task.CompletedAt,
+		task.TimeoutTier,
+		taskModels,
+		task.ServedModel,
+		attempts,
+		task.DryRun,
+		task.Snapshot,
+		task.BytesIn,
+		task.BytesOut,
+		task.Duration,
+		task.CreatedAt,
+		task.UpdatedAt,
+		task.Version,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create task", storageType)
+	}
+
+	return nil
+}
+
+// GetByID ID로 태스크 조회
+func (t *taskStorage) GetByID(ctx context.Context, id string) (*models.Task, error) {
+	task, err := scanTask(t.db.queryRowContext(ctx, selectTaskQuery+` WHERE id = ?`, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, "get task by id", storageType)
+	}
+
+	return task, nil
+}
+
+// List 태스크 목록 조회
+func (t *taskStorage) List(ctx context.Context, filter *models.TaskFilter, paging *models.PaginationRequest) ([]*models.Task, int, error) {
+	var whereConditions []string
+	var args []interface{}
+
+	if filter != nil {
+		if filter.SessionID != nil && *filter.SessionID != "" {
+			whereConditions = append(whereConditions, "session_id = ?")
+			args = append(args, *filter.SessionID)
+		}
+		if filter.Status != nil {
+			whereConditions = append(whereConditions, "status = ?")
+			args = append(args, *filter.Status)
+		}
+		if filter.Active != nil {
+			if *filter.Active {
+				whereConditions = append(whereConditions, "status IN ('pending', 'running')")
+			} else {
+				whereConditions = append(whereConditions, "status IN ('completed', 'failed', 'cancelled')")
+			}
+		}
+	}
+
+	whereClause := ""
+	if len(whereConditions) > 0 {
+		whereClause = " WHERE " + strings.Join(whereConditions, " AND ")
+	}
+
+	return t.list(ctx, whereClause, args, paging)
+}
+
+// GetBySessionID 세션 ID로 태스크 목록 조회
+func (t *taskStorage) GetBySessionID(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.Task, int, error) {
+	return t.list(ctx, ` WHERE session_id = ?`, []interface{}{sessionID}, paging)
+}
+
+// list 조건에 맞는 태스크 수와 한 페이지를 조회
+func (t *taskStorage) list(ctx context.Context, whereClause string, args []interface{}, paging *models.PaginationRequest) ([]*models.Task, int, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{Page: 1, Limit: 20, Sort: "created_at", Order: "desc"}
+	}
+	paging.Normalize()
+
+	var total int
+	if err := t.db.queryRowContext(ctx, countTasksQuery+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, storage.ConvertError(err, "count tasks", storageType)
+	}
+
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(paging, models.TaskSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectTaskQuery + page.AppendWhere(whereClause) + page.Tail
+	queryArgs := append(append(append([]interface{}{}, args...), page.WhereArgs...), page.TailArgs...)
+
+	rows, err := t.db.queryContext(ctx, query, queryArgs...)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "list tasks", storageType)
+	}
+	defer rows.Close()
+
+	var tasks []*models.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, 0, storage.ConvertError(err, "scan task row", storageType)
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, storage.ConvertError(err, "scan task rows", storageType)
+	}
+
+	return tasks, total, nil
+}
+
+// Update 태스크 업데이트 (저장된 버전이 task.Version과 같을 때만 수정)
+func (t *taskStorage) Update(ctx context.Context, task *models.Task) error {
+	task.UpdatedAt = time.Now()
+
+	attempts, err := marshalJSONArray(task.ModelAttempts)
+	if err != nil {
+		return storage.ConvertError(err, "marshal task model attempts", storageType)
+	}
+
+	result, err := t.db.execContext(ctx, updateTaskQuery,
+		task.Command,
+		task.Status,
+		task.Output,
+		task.Error,
+		task.StartedAt,
+		task.CompletedAt,
+		task.ServedModel,
+		attempts,
+		task.BytesIn,
+		task.BytesOut,
+		task.Duration,
+		task.UpdatedAt,
+		task.ID,
+		task.Version,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update task", storageType)
+	}
+	if err := requireAffected(result, "update task"); err != nil {
+		return err
+	}
+
+	task.Version++
+	return nil
+}
+
+// Delete 태스크 삭제
+func (t *taskStorage) Delete(ctx context.Context, id string) error {
+	result, err := t.db.execContext(ctx, deleteTaskQuery, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete task", storageType)
+	}
+
+	return requireAffected(result, "delete task")
+}
+
+// GetActiveCount 활성 태스크 수 조회
+func (t *taskStorage) GetActiveCount(ctx context.Context, sessionID string) (int64, error) {
+	var count int64
+	if err := t.db.queryRowContext(ctx, countActiveTasksQuery, sessionID).Scan(&count); err != nil {
+		return 0, storage.ConvertError(err, "get active task count", storageType)
+	}
+
+	return count, nil
+}
+
+// ListExecutedBetween from 초과 to 이하에 끝났거나 to 시점에 실행 중이던 태스크 조회 (종료 시각순)
+func (t *taskStorage) ListExecutedBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	rows, err := t.db.queryContext(ctx, selectTaskQuery+executedTasksClause, from, to, to, to)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list executed tasks", storageType)
+	}
+	defer rows.Close()
+
+	var tasks []*models.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan task row", storageType)
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "scan task rows", storageType)
+	}
+
+	return tasks, nil
+}
+
+// marshalJSONArray 슬라이스를 JSONB 배열 값으로 직렬화 (nil이면 빈 배열)
+func marshalJSONArray[T any](values []T) (string, error) {
+	if values == nil {
+		values = []T{}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// scanTask 태스크 한 행 스캔
+func scanTask(row scanner) (*models.Task, error) {
+	task := &models.Task{}
+	var output, taskError sql.NullString
+	var startedAt, completedAt sql.NullTime
+	var taskModels, attempts []byte
+
+	err := row.Scan(
+		&task.ID,
+		&task.SessionID,
+		&task.Command,
+		&task.Status,
+		&output,
+		&taskError,
+		&startedAt,
+		&completedAt,
+		&task.TimeoutTier,
+		&taskModels,
+		&task.ServedModel,
+		&attempts,
+		&task.DryRun,
+		&task.Snapshot,
+		&task.BytesIn,
+		&task.BytesOut,
+		&task.Duration,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+		&task.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Output = output.String
+	task.Error = taskError.String
+	if startedAt.Valid {
+		task.StartedAt = &startedAt.Time
+	}
+	if completedAt.Valid {
+		task.CompletedAt = &completedAt.Time
+	}
+	if err := json.Unmarshal(taskModels, &task.Models); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(attempts, &task.ModelAttempts); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// executor 쿼리를 실행하는 대상 (연결 풀의 Storage 또는 트랜잭션)
+// 스토리지 구현체는 같은 코드로 트랜잭션 밖과 안에서 동작합니다.
+type executor interface {
+	execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// transaction PostgreSQL 트랜잭션 래퍼
+type transaction struct {
+	ctx     context.Context
+	tx      *sql.Tx
+	storage *Storage
+	closed  bool
+
+	// 트랜잭션 스토리지들
+	workspace *workspaceStorage
+	project   *projectStorage
+	session   *sessionStorage
+	task      *taskStorage
+}
+
+// newTransaction 새 트랜잭션 래퍼 생성
+func newTransaction(ctx context.Context, tx *sql.Tx, s *Storage) *transaction {
+	t := &transaction{ctx: ctx, tx: tx, storage: s}
+
+	t.workspace = &workspaceStorage{db: t}
+	t.project = &projectStorage{db: t}
+	t.session = &sessionStorage{db: t}
+	t.task = &taskStorage{db: t}
+
+	return t
+}
+
+// Commit 트랜잭션 커밋
+func (t *transaction) Commit() error {
+	if t.closed {
+		return fmt.Errorf("transaction is already closed")
+	}
+
+	t.closed = true
+	if err := t.tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Rollback 트랜잭션 롤백
+func (t *transaction) Rollback() error {
+	if t.closed {
+		return fmt.Errorf("transaction is already closed")
+	}
+
+	t.closed = true
+	if err := t.tx.Rollback(); err != nil {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Context 트랜잭션을 시작한 컨텍스트 반환
+func (t *transaction) Context() context.Context {
+	return t.ctx
+}
+
+// IsClosed 트랜잭션 종료 여부 확인
+func (t *transaction) IsClosed() bool {
+	return t.closed
+}
+
+// Workspace 워크스페이스 스토리지 반환
+func (t *transaction) Workspace() storage.WorkspaceStorage {
+	return t.workspace
+}
+
+// Project 프로젝트 스토리지 반환
+func (t *transaction) Project() storage.ProjectStorage {
+	return t.project
+}
+
+// Session 세션 스토리지 반환
+func (t *transaction) Session() storage.SessionStorage {
+	return t.session
+}
+
+// Task 태스크 스토리지 반환
+func (t *transaction) Task() storage.TaskStorage {
+	return t.task
+}
+
+// stmt 연결 풀에 캐시된 Prepared Statement를 트랜잭션에 묶어 반환
+// 트랜잭션용 statement는 커밋이나 롤백 때 함께 닫힙니다.
+func (t *transaction) stmt(ctx context.Context, query string) (*sql.Stmt, error) {
+	stmt, err := t.storage.prepareStmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return t.tx.StmtContext(ctx, stmt), nil
+}
+
+// execContext 트랜잭션 내에서 실행
+func (t *transaction) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	stmt, err := t.stmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "exec in transaction", storageType)
+	}
+
+	return result, nil
+}
+
+// queryContext 트랜잭션 내에서 쿼리
+func (t *transaction) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	stmt, err := t.stmt(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "query in transaction", storageType)
+	}
+
+	return rows, nil
+}
+
+// queryRowContext 트랜잭션 내에서 단일 행 쿼리
+func (t *transaction) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	stmt, err := t.stmt(ctx, query)
+	if err != nil {
+		return t.tx.QueryRowContext(ctx, rebind(query), args...)
+	}
+
+	return stmt.QueryRowContext(ctx, args...)
+}
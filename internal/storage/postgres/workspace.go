@@ -0,0 +1,304 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
+)
+
+// workspaceStorage 워크스페이스 스토리지 PostgreSQL 구현
+type workspaceStorage struct {
+	db executor
+}
+
+const (
+	// 워크스페이스 조회 쿼리
+	selectWorkspaceQuery = `
+		SELECT id, name, project_path, status, owner_id, claude_key,
+		       active_tasks, created_at, updated_at, deleted_at, version
+		FROM workspaces
+	`
+
+	// 워크스페이스 삽입 쿼리
+	insertWorkspaceQuery = `
+		INSERT INTO workspaces (id, name, project_path, status, owner_id, claude_key,
+		                        active_tasks, created_at, updated_at, version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 버전 충돌 확인용 현재 버전 조회 쿼리
+	selectWorkspaceVersionQuery = `SELECT version FROM workspaces WHERE id = ? AND deleted_at IS NULL`
+
+	// 워크스페이스 삭제 쿼리 (soft delete)
+	deleteWorkspaceQuery = `
+		UPDATE workspaces
+		SET deleted_at = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	// 워크스페이스 존재 여부 확인 쿼리
+	existsWorkspaceByNameQuery = `
+		SELECT EXISTS (SELECT 1 FROM workspaces WHERE owner_id = ? AND name = ? AND deleted_at IS NULL)
+	`
+
+	// 카운트 쿼리
+	countWorkspacesQuery = `SELECT COUNT(*) FROM workspaces`
+)
+
+// workspaceUpdateFields Update에서 수정할 수 있는 컬럼
+var workspaceUpdateFields = map[string]bool{
+	"name":         true,
+	"owner_id":     true,
+	"project_path": true,
+	"status":       true,
+	"claude_key":   true,
+	"active_tasks": true,
+}
+
+// Create 새 워크스페이스 생성
+func (w *workspaceStorage) Create(ctx context.Context, workspace *models.Workspace) error {
+	now := time.Now()
+
+	if workspace.Status == "" {
+		workspace.Status = models.WorkspaceStatusActive
+	}
+	workspace.CreatedAt = now
+	workspace.UpdatedAt = now
+	workspace.Version = 1
+
+	_, err := w.db.execContext(ctx, insertWorkspaceQuery,
+		workspace.ID,
+		workspace.Name,
+		workspace.ProjectPath,
+		workspace.Status,
+		workspace.OwnerID,
+		workspace.ClaudeKey,
+		workspace.ActiveTasks,
+		workspace.CreatedAt,
+		workspace.UpdatedAt,
+		workspace.Version,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create workspace", storageType)
+	}
+
+	return nil
+}
+
+// GetByID ID로 워크스페이스 조회
+func (w *workspaceStorage) GetByID(ctx context.Context, id string) (*models.Workspace, error) {
+	row := w.db.queryRowContext(ctx, selectWorkspaceQuery+` WHERE id = ? AND deleted_at IS NULL`, id)
+
+	workspace, err := scanWorkspace(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, "get workspace by id", storageType)
+	}
+
+	return workspace, nil
+}
+
+// GetByName 이름으로 워크스페이스 조회
+func (w *workspaceStorage) GetByName(ctx context.Context, ownerID, name string) (*models.Workspace, error) {
+	row := w.db.queryRowContext(ctx, selectWorkspaceQuery+` WHERE owner_id = ? AND name = ? AND deleted_at IS NULL`, ownerID, name)
+
+	workspace, err := scanWorkspace(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, "get workspace by name", storageType)
+	}
+
+	return workspace, nil
+}
+
+// GetByOwnerID 소유자 ID로 워크스페이스 목록 조회
+func (w *workspaceStorage) GetByOwnerID(ctx context.Context, ownerID string, pagination *models.PaginationRequest) ([]*models.Workspace, int, error) {
+	return w.list(ctx, ` WHERE owner_id = ? AND deleted_at IS NULL`, []interface{}{ownerID}, pagination)
+}
+
+// List 전체 워크스페이스 목록 조회 (관리자용)
+func (w *workspaceStorage) List(ctx context.Context, pagination *models.PaginationRequest) ([]*models.Workspace, int, error) {
+	return w.list(ctx, ` WHERE deleted_at IS NULL`, nil, pagination)
+}
+
+// list 조건에 맞는 워크스페이스 수와 한 페이지를 조회
+func (w *workspaceStorage) list(ctx context.Context, whereClause string, args []interface{}, pagination *models.PaginationRequest) ([]*models.Workspace, int, error) {
+	if pagination == nil {
+		pagination = &models.PaginationRequest{Page: 1, Limit: 20, Sort: "created_at", Order: "desc"}
+	}
+	pagination.Normalize()
+
+	var total int
+	if err := w.db.queryRowContext(ctx, countWorkspacesQuery+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, storage.ConvertError(err, "count workspaces", storageType)
+	}
+
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(pagination, models.WorkspaceSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectWorkspaceQuery + page.AppendWhere(whereClause) + page.Tail
+	queryArgs := append(append(append([]interface{}{}, args...), page.WhereArgs...), page.TailArgs...)
+
+	rows, err := w.db.queryContext(ctx, query, queryArgs...)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "list workspaces", storageType)
+	}
+	defer rows.Close()
+
+	var workspaces []*models.Workspace
+	for rows.Next() {
+		workspace, err := scanWorkspace(rows)
+		if err != nil {
+			return nil, 0, storage.ConvertError(err, "scan workspace row", storageType)
+		}
+		workspaces = append(workspaces, workspace)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, storage.ConvertError(err, "scan workspace rows", storageType)
+	}
+
+	return workspaces, total, nil
+}
+
+// Update 워크스페이스 업데이트
+func (w *workspaceStorage) Update(ctx context.Context, id string, updates map[string]interface{}) error {
+	if len(updates) == 0 {
+		return storage.ErrInvalidInput
+	}
+
+	// updated_at과 버전은 항상 업데이트
+	setParts := []string{"updated_at = ?", "version = version + 1"}
+	args := []interface{}{time.Now()}
+
+	for field, value := range updates {
+		if field == "updated_at" || field == storage.VersionKey {
+			continue
+		}
+		if !workspaceUpdateFields[field] {
+			return fmt.Errorf("field '%s' is not allowed for update", field)
+		}
+
+		setParts = append(setParts, field+" = ?")
+		args = append(args, value)
+	}
+
+	query := `UPDATE workspaces SET ` + strings.Join(setParts, ", ") + ` WHERE id = ? AND deleted_at IS NULL`
+	args = append(args, id)
+
+	// 기대 버전이 있으면 저장된 버전이 같을 때만 수정 (compare-and-swap)
+	expected, checkVersion := storage.ExpectedVersion(updates)
+	if checkVersion {
+		query += ` AND version = ?`
+		args = append(args, expected)
+	}
+
+	result, err := w.db.execContext(ctx, query, args...)
+	if err != nil {
+		return storage.ConvertError(err, "update workspace", storageType)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "check rows affected", storageType)
+	}
+	if rowsAffected == 0 {
+		if checkVersion {
+			return w.versionConflict(ctx, id, expected)
+		}
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+// versionConflict 수정된 행이 없을 때 버전 충돌인지 삭제된 것인지 구분합니다
+func (w *workspaceStorage) versionConflict(ctx context.Context, id string, expected int) error {
+	var current int
+	err := w.db.queryRowContext(ctx, selectWorkspaceVersionQuery, id).Scan(&current)
+	if err == sql.ErrNoRows {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return storage.ConvertError(err, "get workspace version", storageType)
+	}
+	if err := storage.CheckVersion("workspace", id, expected, current); err != nil {
+		return err
+	}
+	return storage.ErrNotFound
+}
+
+// Delete 워크스페이스 삭제 (soft delete)
+func (w *workspaceStorage) Delete(ctx context.Context, id string) error {
+	now := time.Now()
+
+	result, err := w.db.execContext(ctx, deleteWorkspaceQuery, now, now, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete workspace", storageType)
+	}
+
+	return requireAffected(result, "delete workspace")
+}
+
+// ExistsByName 이름으로 존재 여부 확인
+func (w *workspaceStorage) ExistsByName(ctx context.Context, ownerID, name string) (bool, error) {
+	var exists bool
+	if err := w.db.queryRowContext(ctx, existsWorkspaceByNameQuery, ownerID, name).Scan(&exists); err != nil {
+		return false, storage.ConvertError(err, "check workspace exists by name", storageType)
+	}
+
+	return exists, nil
+}
+
+// CountByOwner 소유자별 워크스페이스 개수 조회
+func (w *workspaceStorage) CountByOwner(ctx context.Context, ownerID string) (int, error) {
+	var count int
+	err := w.db.queryRowContext(ctx, countWorkspacesQuery+` WHERE owner_id = ? AND deleted_at IS NULL`, ownerID).Scan(&count)
+	if err != nil {
+		return 0, storage.ConvertError(err, "count workspaces by owner", storageType)
+	}
+
+	return count, nil
+}
+
+// scanWorkspace 워크스페이스 한 행 스캔
+func scanWorkspace(row scanner) (*models.Workspace, error) {
+	workspace := &models.Workspace{}
+	var deletedAt sql.NullTime
+	var claudeKey sql.NullString
+
+	err := row.Scan(
+		&workspace.ID,
+		&workspace.Name,
+		&workspace.ProjectPath,
+		&workspace.Status,
+		&workspace.OwnerID,
+		&claudeKey,
+		&workspace.ActiveTasks,
+		&workspace.CreatedAt,
+		&workspace.UpdatedAt,
+		&deletedAt,
+		&workspace.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace.ClaudeKey = claudeKey.String
+	if deletedAt.Valid {
+		workspace.DeletedAt = &deletedAt.Time
+	}
+
+	return workspace, nil
+}
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/aicli/aicli-web/internal/models"
+)
+
+// PageClause 정렬/페이지네이션 SQL 조각 (? 자리표시자 사용)
+type PageClause struct {
+	// Where 커서 조건 (없으면 빈 문자열)
+	Where string
+
+	// WhereArgs 커서 조건 파라미터
+	WhereArgs []interface{}
+
+	// Tail ORDER BY / LIMIT / OFFSET 절
+	Tail string
+
+	// TailArgs LIMIT / OFFSET 파라미터
+	TailArgs []interface{}
+}
+
+// BuildPageClause 허용된 정렬 필드만 사용하여 키셋 또는 오프셋 페이지네이션 절을 생성합니다.
+// 커서가 있으면 (정렬값, id) 튜플 비교로 다음 페이지를 조회하므로 OFFSET 스캔이 필요 없습니다.
+func BuildPageClause(paging *models.PaginationRequest, allowed models.SortWhitelist) (*PageClause, error) {
+	cursor, err := paging.ApplySortWhitelist(allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	order := "DESC"
+	op := "<"
+	if paging.Order == "asc" {
+		order = "ASC"
+		op = ">"
+	}
+
+	clause := &PageClause{
+		Tail: " ORDER BY " + paging.Sort + " " + order + ", id " + order + " LIMIT ?",
+	}
+	clause.TailArgs = append(clause.TailArgs, paging.Limit)
+
+	if cursor == nil {
+		clause.Tail += " OFFSET ?"
+		clause.TailArgs = append(clause.TailArgs, paging.GetOffset())
+		return clause, nil
+	}
+
+	var value interface{} = cursor.Value
+	if models.IsTimeSortField(paging.Sort) {
+		t, err := cursor.TimeValue()
+		if err != nil {
+			return nil, err
+		}
+		value = t.Local()
+	}
+
+	clause.Where = "(" + paging.Sort + ", id) " + op + " (?, ?)"
+	clause.WhereArgs = []interface{}{value, cursor.ID}
+	return clause, nil
+}
+
+// AppendWhere 기존 WHERE 절에 커서 조건을 추가합니다
+func (pc *PageClause) AppendWhere(whereClause string) string {
+	if pc.Where == "" {
+		return whereClause
+	}
+	if strings.TrimSpace(whereClause) == "" {
+		return " WHERE " + pc.Where
+	}
+	return whereClause + " AND " + pc.Where
+}
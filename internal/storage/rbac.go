@@ -20,6 +20,7 @@ type RBACStorage interface {
 	GetAllRoles(ctx context.Context) ([]models.Role, error)
 	GetChildRoles(ctx context.Context, parentID string) ([]models.Role, error)
 	ListRoles(ctx context.Context, req models.ListRolesRequest) ([]models.Role, int64, error)
+	// UpdateRole role.Version이 0보다 크면 저장된 버전과 비교하여 다르면 ConflictError를 반환합니다
 	UpdateRole(ctx context.Context, role *models.Role) error
 	DeleteRole(ctx context.Context, roleID string) error
 	GetUsersByRoleID(ctx context.Context, roleID string) ([]string, error)
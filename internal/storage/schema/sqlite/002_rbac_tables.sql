@@ -86,7 +86,6 @@ CREATE TABLE IF NOT EXISTS user_roles (
     is_active BOOLEAN DEFAULT TRUE,
     created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-    PRIMARY KEY (user_id, role_id, COALESCE(resource_id, '')),
     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
     FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
     FOREIGN KEY (assigned_by) REFERENCES users(id) ON DELETE RESTRICT,
@@ -117,13 +116,16 @@ CREATE TABLE IF NOT EXISTS group_roles (
     is_active BOOLEAN DEFAULT TRUE,
     created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
     updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-    PRIMARY KEY (group_id, role_id, COALESCE(resource_id, '')),
     FOREIGN KEY (group_id) REFERENCES user_groups(id) ON DELETE CASCADE,
     FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
     FOREIGN KEY (assigned_by) REFERENCES users(id) ON DELETE RESTRICT,
     FOREIGN KEY (resource_id) REFERENCES resources(id) ON DELETE CASCADE
 );
 
+-- 리소스 없는 역할 지정도 중복되지 않도록 표현식 유니크 인덱스 사용 (SQLite는 PRIMARY KEY에 표현식을 허용하지 않음)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_roles_unique ON user_roles(user_id, role_id, COALESCE(resource_id, ''));
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_roles_unique ON group_roles(group_id, role_id, COALESCE(resource_id, ''));
+
 -- 인덱스 생성
 CREATE INDEX IF NOT EXISTS idx_roles_parent_id ON roles(parent_id);
 CREATE INDEX IF NOT EXISTS idx_roles_level ON roles(level);
@@ -0,0 +1,19 @@
+-- 세션 대화 기록 테이블
+-- 마이그레이션 버전: 003
+-- 설명: Claude 세션의 모든 메시지를 저장하여 세션별 조회와 검색을 지원
+
+CREATE TABLE IF NOT EXISTS session_messages (
+    id CHAR(36) PRIMARY KEY,
+    session_id CHAR(36) NOT NULL,
+    workspace_id CHAR(36) NOT NULL,
+    sequence INTEGER NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('user', 'assistant', 'system')),
+    type VARCHAR(50),
+    content TEXT NOT NULL,
+    metadata TEXT, -- JSON 데이터
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (session_id, sequence)
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_messages_workspace_created
+    ON session_messages (workspace_id, created_at DESC);
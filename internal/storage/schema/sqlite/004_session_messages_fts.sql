@@ -0,0 +1,28 @@
+-- 세션 대화 기록 전문 검색 인덱스
+-- 마이그레이션 버전: 004
+-- 설명: FTS5 외부 콘텐츠 테이블과 동기화 트리거 생성
+-- 참고: go-sqlite3를 sqlite_fts5 빌드 태그로 빌드해야 합니다.
+--       FTS5를 사용할 수 없으면 이 마이그레이션을 건너뛰어도 되며, 검색은 LIKE 조회로 동작합니다.
+
+CREATE VIRTUAL TABLE IF NOT EXISTS session_messages_fts USING fts5(
+    content,
+    content = 'session_messages',
+    content_rowid = 'rowid',
+    tokenize = 'unicode61'
+);
+
+-- 기존 메시지 색인
+INSERT INTO session_messages_fts (session_messages_fts) VALUES ('rebuild');
+
+CREATE TRIGGER IF NOT EXISTS session_messages_fts_insert AFTER INSERT ON session_messages BEGIN
+    INSERT INTO session_messages_fts (rowid, content) VALUES (new.rowid, new.content);
+END;
+
+CREATE TRIGGER IF NOT EXISTS session_messages_fts_delete AFTER DELETE ON session_messages BEGIN
+    INSERT INTO session_messages_fts (session_messages_fts, rowid, content) VALUES ('delete', old.rowid, old.content);
+END;
+
+CREATE TRIGGER IF NOT EXISTS session_messages_fts_update AFTER UPDATE OF content ON session_messages BEGIN
+    INSERT INTO session_messages_fts (session_messages_fts, rowid, content) VALUES ('delete', old.rowid, old.content);
+    INSERT INTO session_messages_fts (rowid, content) VALUES (new.rowid, new.content);
+END;
@@ -0,0 +1,34 @@
+-- 세션 공유 링크 테이블
+-- 마이그레이션 버전: 005
+-- 설명: 권한 범위와 만료 시각이 있는 세션 공유 링크와 링크를 통한 참여 감사 기록
+
+CREATE TABLE IF NOT EXISTS session_share_links (
+    id CHAR(36) PRIMARY KEY,
+    session_id CHAR(36) NOT NULL,
+    workspace_id CHAR(36),
+    created_by CHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE, -- SHA-256 hex (토큰 원문은 저장하지 않음)
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('read', 'interactive')),
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME,
+    join_count INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_share_links_session
+    ON session_share_links (session_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS session_share_joins (
+    id CHAR(36) PRIMARY KEY,
+    link_id CHAR(36) NOT NULL REFERENCES session_share_links(id) ON DELETE CASCADE,
+    session_id CHAR(36) NOT NULL,
+    user_id CHAR(36),
+    user_name VARCHAR(255),
+    channel VARCHAR(20) NOT NULL,
+    client_ip VARCHAR(45),
+    user_agent TEXT,
+    joined_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_share_joins_session
+    ON session_share_joins (session_id, joined_at DESC);
@@ -0,0 +1,15 @@
+-- CLI 프로세스 기록 테이블
+-- 마이그레이션 버전: 006
+-- 설명: 서버 인스턴스가 실행한 CLI 프로세스의 PID와 시작 시각 지문 (재시작 시 고아 프로세스 정리)
+
+CREATE TABLE IF NOT EXISTS process_records (
+    instance_id VARCHAR(255) NOT NULL,
+    pid INTEGER NOT NULL,
+    fingerprint VARCHAR(255) NOT NULL, -- 부팅 ID와 프로세스 시작 시각 (PID 재사용 구분)
+    command TEXT NOT NULL,
+    args TEXT, -- JSON 배열
+    working_dir TEXT,
+    started_at DATETIME NOT NULL,
+    adopted_at DATETIME,
+    PRIMARY KEY (instance_id, pid)
+);
@@ -0,0 +1,15 @@
+-- 에러 통계 집계 테이블
+-- 마이그레이션 버전: 007
+-- 설명: 시간별/일별 에러 유형 발생 횟수 (서버 재시작 후에도 유지, 보존 기간이 지나면 삭제)
+
+CREATE TABLE IF NOT EXISTS error_stats (
+    granularity VARCHAR(10) NOT NULL CHECK (granularity IN ('hour', 'day')),
+    bucket_start DATETIME NOT NULL,
+    error_type VARCHAR(50) NOT NULL,
+    severity VARCHAR(20) NOT NULL,
+    category VARCHAR(100) NOT NULL,
+    count INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (granularity, bucket_start, error_type, severity, category)
+);
+
+CREATE INDEX IF NOT EXISTS idx_error_stats_bucket ON error_stats(granularity, bucket_start);
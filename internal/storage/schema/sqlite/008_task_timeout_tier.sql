@@ -0,0 +1,5 @@
+-- 태스크 실행 시간 제한 단계
+-- 마이그레이션 버전: 008
+-- 설명: 태스크 생성 시 선택한 타임아웃 단계 (quick, standard, long)
+
+ALTER TABLE tasks ADD COLUMN timeout_tier VARCHAR(20) NOT NULL DEFAULT 'standard' CHECK (timeout_tier IN ('quick', 'standard', 'long'));
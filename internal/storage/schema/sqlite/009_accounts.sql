@@ -0,0 +1,33 @@
+-- 로컬 계정 테이블
+-- 마이그레이션 버전: 009
+-- 설명: 비밀번호로 로그인하는 로컬 계정 (argon2id 해시, 로그인 실패 잠금)과 비밀번호 재설정 토큰
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id CHAR(36) PRIMARY KEY,
+    username VARCHAR(100) NOT NULL UNIQUE COLLATE NOCASE,
+    email VARCHAR(255) COLLATE NOCASE,
+    display_name VARCHAR(100),
+    role VARCHAR(20) NOT NULL DEFAULT 'user',
+    password_hash TEXT NOT NULL, -- PHC 형식 argon2id (이전 bcrypt 해시는 로그인 시 다시 해시)
+    password_changed_at DATETIME NOT NULL,
+    failed_logins INTEGER NOT NULL DEFAULT 0,
+    locked_until DATETIME,
+    last_login_at DATETIME,
+    is_active BOOLEAN NOT NULL DEFAULT 1,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_email
+    ON accounts (email) WHERE email IS NOT NULL AND email != '';
+
+CREATE TABLE IF NOT EXISTS account_reset_tokens (
+    token_hash CHAR(64) PRIMARY KEY, -- SHA-256 hex (토큰 원문은 저장하지 않음)
+    account_id CHAR(36) NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_reset_tokens_account
+    ON account_reset_tokens (account_id);
@@ -0,0 +1,25 @@
+-- 메일 발송 기록 테이블
+-- 마이그레이션 버전: 010
+-- 설명: 초대, 비밀번호 재설정, 예산 알림, 보안 알림 메일의 발송 기록과 재시도 대기열
+
+CREATE TABLE IF NOT EXISTS email_deliveries (
+    id CHAR(36) PRIMARY KEY,
+    template VARCHAR(100) NOT NULL,
+    recipient VARCHAR(255) NOT NULL COLLATE NOCASE,
+    subject TEXT NOT NULL,
+    html_body TEXT NOT NULL, -- 렌더링한 본문 (재시도할 때 다시 렌더링하지 않음)
+    text_body TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, sent, failed
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at DATETIME,
+    sent_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_deliveries_due
+    ON email_deliveries (status, next_attempt_at);
+
+CREATE INDEX IF NOT EXISTS idx_email_deliveries_created
+    ON email_deliveries (created_at DESC);
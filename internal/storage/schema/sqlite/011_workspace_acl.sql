@@ -0,0 +1,20 @@
+-- 워크스페이스 공유 ACL 테이블
+-- 마이그레이션 버전: 011
+-- 설명: RBAC 역할과 별개로 워크스페이스마다 사용자 또는 그룹에 부여하는 read/execute/admin 권한
+
+CREATE TABLE IF NOT EXISTS workspace_acl (
+    id CHAR(36) PRIMARY KEY,
+    workspace_id CHAR(36) NOT NULL,
+    principal_type VARCHAR(10) NOT NULL, -- user, group
+    principal_id VARCHAR(255) NOT NULL,
+    permission VARCHAR(10) NOT NULL, -- read, execute, admin
+    granted_by VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (workspace_id, principal_type, principal_id),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_acl_principal
+    ON workspace_acl (principal_type, principal_id);
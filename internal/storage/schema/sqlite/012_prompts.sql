@@ -0,0 +1,21 @@
+-- 프롬프트 라이브러리 테이블
+-- 마이그레이션 버전: 012
+-- 설명: 템플릿 변수가 있는 재사용 프롬프트 (작성자 전용 또는 조직 공유)
+
+CREATE TABLE IF NOT EXISTS prompts (
+    id CHAR(36) PRIMARY KEY,
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    content TEXT NOT NULL,
+    variables TEXT NOT NULL DEFAULT '[]', -- JSON 배열 (models.PromptVariable)
+    tags TEXT NOT NULL DEFAULT '[]', -- JSON 배열
+    visibility VARCHAR(10) NOT NULL DEFAULT 'private' CHECK (visibility IN ('private', 'org')),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (owner_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_prompts_visibility
+    ON prompts (visibility, name);
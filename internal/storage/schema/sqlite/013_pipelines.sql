@@ -0,0 +1,37 @@
+-- 파이프라인 테이블
+-- 마이그레이션 버전: 013
+-- 설명: 순서대로 실행하는 다단계 워크플로와 실행 기록 (단계 재개 지원)
+
+CREATE TABLE IF NOT EXISTS pipelines (
+    id CHAR(36) PRIMARY KEY,
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    steps TEXT NOT NULL DEFAULT '[]', -- JSON 배열 (models.PipelineStep)
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (owner_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS pipeline_runs (
+    id CHAR(36) PRIMARY KEY,
+    pipeline_id CHAR(36) NOT NULL, -- 파이프라인을 삭제해도 실행 기록은 유지
+    pipeline_name VARCHAR(100) NOT NULL,
+    owner_id VARCHAR(255) NOT NULL,
+    session_id CHAR(36) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('running', 'succeeded', 'failed', 'cancelled')),
+    current_step INTEGER NOT NULL DEFAULT 0,
+    steps TEXT NOT NULL DEFAULT '[]', -- 실행 시작 시점의 단계 정의 (JSON)
+    step_runs TEXT NOT NULL DEFAULT '[]', -- 단계 실행 기록 (JSON, models.PipelineStepRun)
+    error TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_pipeline_runs_owner
+    ON pipeline_runs (owner_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_pipeline_runs_status
+    ON pipeline_runs (status);
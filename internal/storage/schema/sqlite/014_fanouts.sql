@@ -0,0 +1,22 @@
+-- 워크스페이스 팬아웃 테이블
+-- 마이그레이션 버전: 014
+-- 설명: 같은 프롬프트를 여러 워크스페이스에서 병렬 실행한 작업과 워크스페이스별 결과
+
+CREATE TABLE IF NOT EXISTS fan_outs (
+    id CHAR(36) PRIMARY KEY,
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    command TEXT NOT NULL,
+    prompt_id CHAR(36),
+    concurrency INTEGER NOT NULL DEFAULT 4,
+    timeout_tier VARCHAR(20) NOT NULL DEFAULT 'standard',
+    status VARCHAR(20) NOT NULL CHECK (status IN ('running', 'completed', 'cancelled')),
+    targets TEXT NOT NULL DEFAULT '[]', -- 워크스페이스별 결과 (JSON, models.FanOutTarget)
+    report_key TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_fan_outs_owner
+    ON fan_outs (owner_id, created_at DESC);
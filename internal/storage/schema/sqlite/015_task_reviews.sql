@@ -0,0 +1,29 @@
+-- 태스크 결과 검토 테이블
+-- 마이그레이션 버전: 015
+-- 설명: 검토가 필요한 태스크의 diff와 승인/거부 상태
+
+CREATE TABLE IF NOT EXISTS task_reviews (
+    id CHAR(36) PRIMARY KEY,
+    task_id CHAR(36) NOT NULL UNIQUE,
+    session_id CHAR(36) NOT NULL,
+    project_id CHAR(36) NOT NULL,
+    workspace_id CHAR(36) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('awaiting_task', 'pending', 'approved', 'rejected')),
+    base_commit VARCHAR(64) NOT NULL,
+    task_status VARCHAR(20),
+    diff TEXT,
+    diff_truncated BOOLEAN NOT NULL DEFAULT 0,
+    files_changed TEXT NOT NULL DEFAULT '[]', -- 변경 파일 목록 (JSON)
+    reviewer_id VARCHAR(255),
+    comment TEXT,
+    applied_commit VARCHAR(64),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_reviews_workspace
+    ON task_reviews (workspace_id, status, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_task_reviews_project
+    ON task_reviews (project_id, status);
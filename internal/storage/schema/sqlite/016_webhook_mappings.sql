@@ -0,0 +1,31 @@
+-- 웹훅 매핑 테이블
+-- 마이그레이션 버전: 016
+-- 설명: GitHub/GitLab 리포지토리 이벤트를 워크스페이스 태스크로 연결하는 매핑
+
+CREATE TABLE IF NOT EXISTS webhook_mappings (
+    id CHAR(36) PRIMARY KEY,
+    owner_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    provider VARCHAR(10) NOT NULL CHECK (provider IN ('github', 'gitlab')),
+    repository VARCHAR(255) NOT NULL,
+    workspace_id CHAR(36) NOT NULL,
+    session_id CHAR(36),
+    triggers TEXT NOT NULL DEFAULT '[]', -- JSON 배열 (models.WebhookTrigger)
+    label VARCHAR(100),
+    command VARCHAR(50),
+    branches TEXT NOT NULL DEFAULT '[]', -- JSON 배열
+    allowed_senders TEXT NOT NULL DEFAULT '[]', -- JSON 배열
+    prompt TEXT,
+    require_review BOOLEAN NOT NULL DEFAULT 0,
+    enabled BOOLEAN NOT NULL DEFAULT 1,
+    secret VARCHAR(128) NOT NULL,
+    last_delivery TEXT, -- JSON (models.WebhookDeliveryResult)
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_mappings_owner
+    ON webhook_mappings (owner_id, name);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_mappings_workspace
+    ON webhook_mappings (workspace_id);
@@ -0,0 +1,42 @@
+-- PR/MR 생성 테이블
+-- 마이그레이션 버전: 017
+-- 설명: 워크스페이스별 GitHub/GitLab PR 설정과 태스크 결과로 만든 PR 기록
+
+CREATE TABLE IF NOT EXISTS workspace_pr_configs (
+    workspace_id CHAR(36) PRIMARY KEY,
+    provider VARCHAR(10) NOT NULL CHECK (provider IN ('github', 'gitlab')),
+    repository VARCHAR(255) NOT NULL,
+    base_branch VARCHAR(255) NOT NULL,
+    branch_prefix VARCHAR(100) NOT NULL DEFAULT '',
+    api_url VARCHAR(500),
+    token TEXT,
+    draft BOOLEAN NOT NULL DEFAULT 0,
+    labels TEXT NOT NULL DEFAULT '[]', -- JSON 배열
+    updated_by VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS pull_requests (
+    id CHAR(36) PRIMARY KEY,
+    task_id CHAR(36) NOT NULL UNIQUE,
+    session_id CHAR(36) NOT NULL,
+    workspace_id CHAR(36) NOT NULL,
+    provider VARCHAR(10) NOT NULL CHECK (provider IN ('github', 'gitlab')),
+    repository VARCHAR(255) NOT NULL,
+    branch VARCHAR(255) NOT NULL,
+    base_branch VARCHAR(255) NOT NULL,
+    commit_sha VARCHAR(64),
+    status VARCHAR(10) NOT NULL CHECK (status IN ('opened', 'failed')),
+    number INTEGER NOT NULL DEFAULT 0,
+    url TEXT,
+    title VARCHAR(255) NOT NULL,
+    error TEXT,
+    transcript_url TEXT,
+    created_by VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_workspace
+    ON pull_requests (workspace_id, created_at);
@@ -0,0 +1,38 @@
+-- 이슈 트래커 연동 테이블
+-- 마이그레이션 버전: 018
+-- 설명: 워크스페이스별 Jira/GitHub Issues 설정과 태스크/세션의 이슈 연결
+
+CREATE TABLE IF NOT EXISTS workspace_issue_trackers (
+    workspace_id CHAR(36) PRIMARY KEY,
+    provider VARCHAR(10) NOT NULL CHECK (provider IN ('jira', 'github')),
+    base_url VARCHAR(500),
+    default_project VARCHAR(255),
+    username VARCHAR(255),
+    token TEXT,
+    comment_on_finish BOOLEAN NOT NULL DEFAULT 0,
+    approval_transition VARCHAR(100),
+    updated_by VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS issue_links (
+    id CHAR(36) PRIMARY KEY,
+    workspace_id CHAR(36) NOT NULL,
+    session_id CHAR(36) NOT NULL,
+    task_id CHAR(36) NOT NULL DEFAULT '', -- 비어 있으면 세션 전체 연결
+    provider VARCHAR(10) NOT NULL CHECK (provider IN ('jira', 'github')),
+    issue_key VARCHAR(255) NOT NULL,
+    issue_url TEXT NOT NULL,
+    issue_title TEXT NOT NULL,
+    commented_at DATETIME,
+    transitioned_at DATETIME,
+    last_error TEXT,
+    created_by VARCHAR(255) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (session_id, task_id, issue_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_issue_links_session
+    ON issue_links (session_id, created_at);
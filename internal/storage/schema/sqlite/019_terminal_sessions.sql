@@ -0,0 +1,28 @@
+-- 워크스페이스 터미널 감사 테이블
+-- 마이그레이션 버전: 019
+-- 설명: 워크스페이스 터미널 접속 기록과 asciicast v2 형식의 입출력 녹화
+
+CREATE TABLE IF NOT EXISTS terminal_sessions (
+    id CHAR(36) PRIMARY KEY,
+    workspace_id CHAR(36) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    mode VARCHAR(10) NOT NULL CHECK (mode IN ('host', 'container')),
+    container_id VARCHAR(128),
+    shell VARCHAR(255) NOT NULL,
+    cols INTEGER NOT NULL,
+    rows INTEGER NOT NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('active', 'closed')),
+    client_ip VARCHAR(64),
+    user_agent TEXT,
+    exit_code INTEGER,
+    input_bytes INTEGER NOT NULL DEFAULT 0,
+    output_bytes INTEGER NOT NULL DEFAULT 0,
+    recording_size INTEGER NOT NULL DEFAULT 0,
+    recording_truncated BOOLEAN NOT NULL DEFAULT 0,
+    recording BLOB,
+    started_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ended_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_terminal_sessions_workspace
+    ON terminal_sessions (workspace_id, started_at);
@@ -0,0 +1,23 @@
+-- 태스크 결과물 테이블
+-- 마이그레이션 버전: 020
+-- 설명: 완료된 태스크의 대화 기록에서 추출한 코드 블록, 파일 경로, 테스트 보고서
+
+CREATE TABLE IF NOT EXISTS task_artifacts (
+    id CHAR(36) PRIMARY KEY,
+    task_id CHAR(36) NOT NULL,
+    session_id CHAR(36) NOT NULL,
+    workspace_id CHAR(36),
+    position INTEGER NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('code_block', 'file', 'test_report')),
+    name TEXT NOT NULL,
+    language VARCHAR(50),
+    content TEXT,
+    action VARCHAR(20),
+    test_report TEXT, -- JSON (models.TaskTestReport)
+    source VARCHAR(10) NOT NULL CHECK (source IN ('output', 'message')),
+    message_sequence INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_artifacts_task
+    ON task_artifacts (task_id, position);
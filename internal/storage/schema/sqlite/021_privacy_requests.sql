@@ -0,0 +1,21 @@
+-- 개인정보 처리 기록 테이블
+-- 마이그레이션 버전: 021
+-- 설명: 관리자의 개인정보 내보내기/삭제 처리 감사 기록 (사용자 ID 외의 개인정보는 저장하지 않음)
+
+CREATE TABLE IF NOT EXISTS privacy_requests (
+    id CHAR(36) PRIMARY KEY,
+    type VARCHAR(10) NOT NULL CHECK (type IN ('export', 'erasure')),
+    subject_id VARCHAR(255) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    summary TEXT, -- JSON (항목별 처리 건수)
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_privacy_requests_subject
+    ON privacy_requests (subject_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_session_share_joins_user
+    ON session_share_joins (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_terminal_sessions_user
+    ON terminal_sessions (user_id, started_at);
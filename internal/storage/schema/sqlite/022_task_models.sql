@@ -0,0 +1,7 @@
+-- 태스크 모델 선호 목록과 실제 처리 모델
+-- 마이그레이션 버전: 022
+-- 설명: 과부하나 요청 한도 초과 시 다음 모델로 넘어가는 선호 목록(JSON 배열), 실제로 처리한 모델(비용 집계용), 실패한 모델 시도(JSON 배열)
+
+ALTER TABLE tasks ADD COLUMN models TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE tasks ADD COLUMN served_model VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE tasks ADD COLUMN model_attempts TEXT NOT NULL DEFAULT '[]';
@@ -0,0 +1,23 @@
+-- 세션 셸 명령 실행 기록 테이블
+-- 마이그레이션 버전: 023
+-- 설명: Bash 도구로 실행된 명령, 작업 디렉토리, 종료 코드, 잘린 출력을 세션별 감사 기록으로 저장
+
+CREATE TABLE IF NOT EXISTS session_commands (
+    id CHAR(36) PRIMARY KEY,
+    session_id CHAR(36) NOT NULL,
+    workspace_id CHAR(36) NOT NULL DEFAULT '',
+    task_id CHAR(36) NOT NULL DEFAULT '',
+    tool_use_id VARCHAR(100) NOT NULL DEFAULT '',
+    command TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    working_dir TEXT NOT NULL DEFAULT '',
+    exit_code INTEGER, -- 알 수 없으면 NULL
+    is_error BOOLEAN NOT NULL DEFAULT 0,
+    output TEXT NOT NULL DEFAULT '',
+    output_bytes INTEGER NOT NULL DEFAULT 0,
+    output_truncated BOOLEAN NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_session_commands_session_created
+    ON session_commands (session_id, created_at);
@@ -0,0 +1,7 @@
+-- 태스크 드라이런
+-- 마이그레이션 버전: 024
+-- 설명: 파일 변경을 실제 워크스페이스 대신 섀도 복사본에 적용하는 태스크 표시와, 검토가 가리키는 섀도 복사본 경로
+
+ALTER TABLE tasks ADD COLUMN dry_run BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE task_reviews ADD COLUMN dry_run BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE task_reviews ADD COLUMN shadow_path TEXT NOT NULL DEFAULT '';
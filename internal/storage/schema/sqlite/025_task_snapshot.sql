@@ -0,0 +1,5 @@
+-- 태스크 실행 전 워크스페이스 스냅샷
+-- 마이그레이션 버전: 025
+-- 설명: 실행 직전에 워크스페이스 파일 시스템 스냅샷을 만들 태스크 표시 (스냅샷 자체는 스냅샷 디렉토리에 저장)
+
+ALTER TABLE tasks ADD COLUMN snapshot BOOLEAN NOT NULL DEFAULT 0;
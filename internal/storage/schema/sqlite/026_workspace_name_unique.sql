@@ -0,0 +1,5 @@
+-- 소유자별 워크스페이스 이름 중복 방지
+-- 마이그레이션 버전: 026
+-- 설명: 삭제되지 않은 워크스페이스는 소유자 안에서 이름이 유일해야 함 (메모리 스토리지와 같은 동작, 동시 생성도 하나만 성공)
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workspaces_owner_name_unique ON workspaces(owner_id, name) WHERE deleted_at IS NULL;
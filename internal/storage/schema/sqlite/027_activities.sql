@@ -0,0 +1,27 @@
+-- 활동 피드 테이블
+-- 마이그레이션 버전: 027
+-- 설명: 워크스페이스/사용자 활동 피드(태스크, 검토, 구성원 변경, 보안 알림)와 사용자별 피드 읽음 표시
+
+CREATE TABLE IF NOT EXISTS activities (
+    id CHAR(36) PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('task', 'approval', 'membership', 'security')),
+    action VARCHAR(50) NOT NULL,
+    workspace_id VARCHAR(255) NOT NULL DEFAULT '',
+    recipient_id VARCHAR(255) NOT NULL DEFAULT '', -- 비어 있으면 워크스페이스 피드 항목
+    actor_id VARCHAR(255) NOT NULL DEFAULT '',
+    resource_type VARCHAR(50) NOT NULL DEFAULT '',
+    resource_id VARCHAR(255) NOT NULL DEFAULT '',
+    summary TEXT NOT NULL DEFAULT '',
+    metadata TEXT, -- JSON
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_activities_recipient_workspace
+    ON activities (recipient_id, workspace_id, created_at);
+
+CREATE TABLE IF NOT EXISTS activity_read_markers (
+    user_id VARCHAR(255) NOT NULL,
+    feed VARCHAR(255) NOT NULL, -- 워크스페이스 ID, 개인 피드는 빈 문자열
+    read_at DATETIME NOT NULL,
+    PRIMARY KEY (user_id, feed)
+);
@@ -0,0 +1,31 @@
+-- 알림 센터 테이블
+-- 마이그레이션 버전: 028
+-- 설명: 사용자별 알림(예산, 검토, 초대, 보안)과 읽음 상태, 요약 메일 대기, 알림 종류별 설정
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id CHAR(36) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('budget', 'approval', 'invite', 'security')),
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    link VARCHAR(500) NOT NULL DEFAULT '',
+    data TEXT, -- JSON
+    read_at DATETIME,
+    digest_pending BOOLEAN NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created
+    ON notifications (user_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_digest_pending
+    ON notifications (digest_pending, user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('budget', 'approval', 'invite', 'security')),
+    in_app BOOLEAN NOT NULL DEFAULT 1,
+    email VARCHAR(20) NOT NULL DEFAULT 'immediate' CHECK (email IN ('off', 'immediate', 'digest')),
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, type)
+);
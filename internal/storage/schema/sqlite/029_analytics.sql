@@ -0,0 +1,39 @@
+-- 사용량 분석 롤업 테이블
+-- 마이그레이션 버전: 029
+-- 설명: 백그라운드 작업이 새로 끝난 태스크만 더해 가는 일별 집계 (대시보드는 원본 태스크 대신 이 테이블만 조회)
+
+CREATE TABLE IF NOT EXISTS analytics_daily (
+    day DATETIME PRIMARY KEY,
+    tasks INTEGER NOT NULL DEFAULT 0,
+    completed INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    cancelled INTEGER NOT NULL DEFAULT 0,
+    duration_ms INTEGER NOT NULL DEFAULT 0,
+    timed_tasks INTEGER NOT NULL DEFAULT 0,
+    cost_usd REAL NOT NULL DEFAULT 0,
+    tokens INTEGER NOT NULL DEFAULT 0,
+    active_users INTEGER NOT NULL DEFAULT 0,
+    peak_concurrency INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS analytics_workspace_daily (
+    day DATETIME NOT NULL,
+    workspace_id CHAR(36) NOT NULL,
+    owner_id VARCHAR(255) NOT NULL DEFAULT '',
+    tasks INTEGER NOT NULL DEFAULT 0,
+    cost_usd REAL NOT NULL DEFAULT 0,
+    tokens INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, workspace_id)
+);
+
+CREATE TABLE IF NOT EXISTS analytics_user_daily (
+    day DATETIME NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (day, user_id)
+);
+
+-- 마지막으로 반영한 태스크 종료 시각 (행 하나)
+CREATE TABLE IF NOT EXISTS analytics_state (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    watermark DATETIME NOT NULL
+);
@@ -0,0 +1,30 @@
+-- 워크스페이스 환경 변수 테이블
+-- 마이그레이션 버전: 030
+-- 설명: Claude 프로세스에 전달하는 워크스페이스별 환경 변수와 변경 기록 (비밀 변수 값은 암호화해 저장하고 변경 기록에는 값을 남기지 않음)
+
+CREATE TABLE IF NOT EXISTS workspace_env_vars (
+    workspace_id CHAR(36) NOT NULL,
+    key VARCHAR(128) NOT NULL,
+    value TEXT NOT NULL DEFAULT '',
+    secret BOOLEAN NOT NULL DEFAULT 0,
+    updated_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (workspace_id, key),
+    FOREIGN KEY (workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS workspace_env_changes (
+    id CHAR(36) PRIMARY KEY,
+    workspace_id CHAR(36) NOT NULL,
+    key VARCHAR(128) NOT NULL,
+    action VARCHAR(10) NOT NULL, -- created, updated, deleted
+    secret BOOLEAN NOT NULL DEFAULT 0,
+    value_changed BOOLEAN NOT NULL DEFAULT 0,
+    actor_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_workspace_env_changes_workspace
+    ON workspace_env_changes (workspace_id, created_at);
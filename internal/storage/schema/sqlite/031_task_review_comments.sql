@@ -0,0 +1,26 @@
+-- 태스크 검토 의견 테이블
+-- 마이그레이션 버전: 031
+-- 설명: 검토 diff의 줄 단위 의견과 해결 여부, 의견을 전달한 수정 세션
+
+CREATE TABLE IF NOT EXISTS task_review_comments (
+    id CHAR(36) PRIMARY KEY,
+    review_id CHAR(36) NOT NULL,
+    workspace_id CHAR(36) NOT NULL,
+    author_id VARCHAR(255) NOT NULL,
+    path TEXT NOT NULL,
+    line INTEGER NOT NULL CHECK (line > 0),
+    side VARCHAR(3) NOT NULL CHECK (side IN ('new', 'old')),
+    body TEXT NOT NULL,
+    resolved BOOLEAN NOT NULL DEFAULT 0,
+    resolved_by VARCHAR(255),
+    resolved_at DATETIME,
+    revision_session_id CHAR(36),
+    revision_task_id CHAR(36),
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    FOREIGN KEY (review_id) REFERENCES task_reviews(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_review_comments_review
+    ON task_review_comments (review_id, path, line, created_at);
@@ -0,0 +1,44 @@
+-- 알림 종류에 내보내기 완료 추가
+-- 마이그레이션 버전: 032
+-- 설명: 대화 기록 내보내기 같은 비동기 작업 완료 알림(export)을 저장할 수 있도록 종류 CHECK 제약을 넓힘
+-- SQLite는 CHECK 제약을 바꿀 수 없으므로 테이블을 새로 만들어 기존 행을 옮깁니다.
+
+CREATE TABLE notifications_new (
+    id CHAR(36) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('budget', 'approval', 'invite', 'security', 'export')),
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    link VARCHAR(500) NOT NULL DEFAULT '',
+    data TEXT, -- JSON
+    read_at DATETIME,
+    digest_pending BOOLEAN NOT NULL DEFAULT 0,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO notifications_new (id, user_id, type, title, message, link, data, read_at, digest_pending, created_at)
+    SELECT id, user_id, type, title, message, link, data, read_at, digest_pending, created_at FROM notifications;
+
+DROP TABLE notifications;
+ALTER TABLE notifications_new RENAME TO notifications;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created
+    ON notifications (user_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_digest_pending
+    ON notifications (digest_pending, user_id, created_at);
+
+CREATE TABLE notification_preferences_new (
+    user_id VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('budget', 'approval', 'invite', 'security', 'export')),
+    in_app BOOLEAN NOT NULL DEFAULT 1,
+    email VARCHAR(20) NOT NULL DEFAULT 'immediate' CHECK (email IN ('off', 'immediate', 'digest')),
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, type)
+);
+
+INSERT INTO notification_preferences_new (user_id, type, in_app, email, updated_at)
+    SELECT user_id, type, in_app, email, updated_at FROM notification_preferences;
+
+DROP TABLE notification_preferences;
+ALTER TABLE notification_preferences_new RENAME TO notification_preferences;
@@ -0,0 +1,10 @@
+-- 사용자 언어 설정 테이블
+-- 마이그레이션 버전: 033
+-- 설명: 세션과 태스크에서 Claude가 응답할 언어, 에러와 알림 메시지 언어 (빈 문자열은 설정하지 않음)
+
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id VARCHAR(255) PRIMARY KEY,
+    response_language VARCHAR(8) NOT NULL DEFAULT '',
+    locale VARCHAR(8) NOT NULL DEFAULT '',
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,14 @@
+-- 웹훅 전송 기록 테이블
+-- 마이그레이션 버전: 034
+-- 설명: 재전송이나 가로챈 요청으로 같은 이벤트가 태스크를 다시 시작하지 않도록 처리한 전송 식별자를 기록
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    mapping_id CHAR(36) NOT NULL,
+    delivery_key VARCHAR(100) NOT NULL, -- 제공자 전송 ID 또는 sha256:<본문 해시>
+    received_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (mapping_id, delivery_key),
+    FOREIGN KEY (mapping_id) REFERENCES webhook_mappings(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_received
+    ON webhook_deliveries (mapping_id, received_at);
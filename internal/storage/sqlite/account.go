@@ -0,0 +1,259 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// accountStorage 로컬 계정 SQLite 구현 (009_accounts.sql)
+type accountStorage struct {
+	storage *Storage
+}
+
+// newAccountStorage 새 계정 스토리지 생성
+func newAccountStorage(s *Storage) *accountStorage {
+	return &accountStorage{storage: s}
+}
+
+const (
+	// 계정 조회 쿼리
+	selectAccountQuery = `
+		SELECT id, username, email, display_name, role, password_hash, password_changed_at,
+		       failed_logins, locked_until, last_login_at, is_active, created_at, updated_at
+		FROM accounts
+	`
+
+	// 계정 삽입 쿼리
+	insertAccountQuery = `
+		INSERT INTO accounts (id, username, email, display_name, role, password_hash, password_changed_at,
+		                      failed_logins, locked_until, last_login_at, is_active, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 계정 수정 쿼리
+	updateAccountQuery = `
+		UPDATE accounts SET username = ?, email = ?, display_name = ?, role = ?, password_hash = ?, password_changed_at = ?,
+		                    failed_logins = ?, locked_until = ?, last_login_at = ?, is_active = ?, updated_at = ?
+		WHERE id = ?
+	`
+)
+
+// Create 새 계정 생성
+func (as *accountStorage) Create(ctx context.Context, account *models.Account) error {
+	if account.ID == "" {
+		account.ID = uuid.New().String()
+	}
+	if account.CreatedAt.IsZero() {
+		account.CreatedAt = time.Now()
+	}
+	account.UpdatedAt = account.CreatedAt
+
+	_, err := as.storage.execContext(ctx, insertAccountQuery,
+		account.ID,
+		account.Username,
+		account.Email,
+		account.DisplayName,
+		account.Role,
+		account.PasswordHash,
+		account.PasswordChangedAt,
+		account.FailedLogins,
+		account.LockedUntil,
+		account.LastLoginAt,
+		account.IsActive,
+		account.CreatedAt,
+		account.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create account", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 계정 조회
+func (as *accountStorage) GetByID(ctx context.Context, id string) (*models.Account, error) {
+	return as.getOne(ctx, `WHERE id = ?`, id)
+}
+
+// GetByUsername 사용자명으로 계정 조회 (username 컬럼은 COLLATE NOCASE)
+func (as *accountStorage) GetByUsername(ctx context.Context, username string) (*models.Account, error) {
+	return as.getOne(ctx, `WHERE username = ?`, username)
+}
+
+// GetByEmail 이메일로 계정 조회 (email 컬럼은 COLLATE NOCASE)
+func (as *accountStorage) GetByEmail(ctx context.Context, email string) (*models.Account, error) {
+	if email == "" {
+		return nil, storage.ErrNotFound
+	}
+	return as.getOne(ctx, `WHERE email = ?`, email)
+}
+
+// Update 계정 정보 저장
+func (as *accountStorage) Update(ctx context.Context, account *models.Account) error {
+	account.UpdatedAt = time.Now()
+
+	result, err := as.storage.execContext(ctx, updateAccountQuery,
+		account.Username,
+		account.Email,
+		account.DisplayName,
+		account.Role,
+		account.PasswordHash,
+		account.PasswordChangedAt,
+		account.FailedLogins,
+		account.LockedUntil,
+		account.LastLoginAt,
+		account.IsActive,
+		account.UpdatedAt,
+		account.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update account", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "update account", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// Count 전체 계정 수
+func (as *accountStorage) Count(ctx context.Context) (int, error) {
+	var count int
+	if err := as.storage.queryRowContext(ctx, `SELECT COUNT(*) FROM accounts`).Scan(&count); err != nil {
+		return 0, storage.ConvertError(err, "count accounts", "sqlite")
+	}
+	return count, nil
+}
+
+// CreateResetToken 비밀번호 재설정 토큰 저장
+func (as *accountStorage) CreateResetToken(ctx context.Context, token *models.AccountResetToken) error {
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+
+	_, err := as.storage.execContext(ctx, `
+		INSERT INTO account_reset_tokens (token_hash, account_id, expires_at, created_at)
+		VALUES (?, ?, ?, ?)`,
+		token.TokenHash, token.AccountID, token.ExpiresAt, token.CreatedAt)
+	if err != nil {
+		return storage.ConvertError(err, "create reset token", "sqlite")
+	}
+	return nil
+}
+
+// GetResetToken 토큰 해시로 재설정 토큰 조회
+func (as *accountStorage) GetResetToken(ctx context.Context, tokenHash string) (*models.AccountResetToken, error) {
+	var (
+		token  models.AccountResetToken
+		usedAt sql.NullTime
+	)
+	err := as.storage.queryRowContext(ctx, `
+		SELECT token_hash, account_id, expires_at, used_at, created_at
+		FROM account_reset_tokens WHERE token_hash = ?`, tokenHash).
+		Scan(&token.TokenHash, &token.AccountID, &token.ExpiresAt, &usedAt, &token.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, storage.ConvertError(err, "get reset token", "sqlite")
+	}
+	if usedAt.Valid {
+		token.UsedAt = &usedAt.Time
+	}
+	return &token, nil
+}
+
+// UseResetToken 재설정 토큰 사용 처리 (동시에 두 번 사용되지 않도록 used_at이 비어 있을 때만 변경)
+func (as *accountStorage) UseResetToken(ctx context.Context, tokenHash string, usedAt time.Time) error {
+	result, err := as.storage.execContext(ctx,
+		`UPDATE account_reset_tokens SET used_at = ? WHERE token_hash = ? AND used_at IS NULL`, usedAt, tokenHash)
+	if err != nil {
+		return storage.ConvertError(err, "use reset token", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "use reset token", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// ListByRole 역할이 같은 활성 계정 조회 (사용자명순)
+func (as *accountStorage) ListByRole(ctx context.Context, role string) ([]*models.Account, error) {
+	rows, err := as.storage.queryContext(ctx, selectAccountQuery+` WHERE role = ? AND is_active = 1 ORDER BY username`, role)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list accounts", "sqlite")
+	}
+	defer rows.Close()
+
+	accounts := []*models.Account{}
+	for rows.Next() {
+		account, err := scanAccount(rows.Scan)
+		if err != nil {
+			return nil, storage.ConvertError(err, "list accounts", "sqlite")
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list accounts", "sqlite")
+	}
+	return accounts, nil
+}
+
+// getOne 조건에 맞는 계정 하나 조회
+func (as *accountStorage) getOne(ctx context.Context, where string, arg interface{}) (*models.Account, error) {
+	account, err := scanAccount(as.storage.queryRowContext(ctx, selectAccountQuery+where, arg).Scan)
+	if err == sql.ErrNoRows {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, storage.ConvertError(err, "get account", "sqlite")
+	}
+	return account, nil
+}
+
+// scanAccount selectAccountQuery 결과 한 행을 계정으로 변환
+func scanAccount(scan func(dest ...interface{}) error) (*models.Account, error) {
+	var (
+		account                  models.Account
+		email, displayName       sql.NullString
+		lockedUntil, lastLoginAt sql.NullTime
+	)
+	err := scan(
+		&account.ID,
+		&account.Username,
+		&email,
+		&displayName,
+		&account.Role,
+		&account.PasswordHash,
+		&account.PasswordChangedAt,
+		&account.FailedLogins,
+		&lockedUntil,
+		&lastLoginAt,
+		&account.IsActive,
+		&account.CreatedAt,
+		&account.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	account.Email = email.String
+	account.DisplayName = displayName.String
+	if lockedUntil.Valid {
+		account.LockedUntil = &lockedUntil.Time
+	}
+	if lastLoginAt.Valid {
+		account.LastLoginAt = &lastLoginAt.Time
+	}
+	return &account, nil
+}
@@ -0,0 +1,166 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// activityStorage 활동 피드 SQLite 구현 (027_activities.sql)
+type activityStorage struct {
+	storage *Storage
+}
+
+// newActivityStorage 새 활동 피드 스토리지 생성
+func newActivityStorage(s *Storage) *activityStorage {
+	return &activityStorage{storage: s}
+}
+
+// Create 활동 추가
+func (as *activityStorage) Create(ctx context.Context, activity *models.Activity) error {
+	if activity.ID == "" {
+		activity.ID = uuid.New().String()
+	}
+	if activity.CreatedAt.IsZero() {
+		activity.CreatedAt = time.Now()
+	}
+
+	var metadata sql.NullString
+	if len(activity.Metadata) > 0 {
+		data, err := json.Marshal(activity.Metadata)
+		if err != nil {
+			return storage.ConvertError(err, "create activity", "sqlite")
+		}
+		metadata = sql.NullString{String: string(data), Valid: true}
+	}
+
+	_, err := as.storage.execContext(ctx, `
+		INSERT INTO activities (id, kind, action, workspace_id, recipient_id, actor_id,
+		                        resource_type, resource_id, summary, metadata, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		activity.ID,
+		activity.Kind,
+		activity.Action,
+		activity.WorkspaceID,
+		activity.RecipientID,
+		activity.ActorID,
+		activity.ResourceType,
+		activity.ResourceID,
+		activity.Summary,
+		metadata,
+		activity.CreatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create activity", "sqlite")
+	}
+	return nil
+}
+
+// List 조건에 맞는 활동 조회 (최신순)
+func (as *activityStorage) List(ctx context.Context, filter *models.ActivityFilter, paging *models.PaginationRequest) ([]*models.Activity, int, error) {
+	if filter == nil {
+		filter = &models.ActivityFilter{}
+	}
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	conditions := []string{"recipient_id = ?"}
+	args := []interface{}{filter.RecipientID}
+	if filter.WorkspaceID != "" {
+		conditions = append(conditions, "workspace_id = ?")
+		args = append(args, filter.WorkspaceID)
+	}
+	if filter.Kind != "" {
+		conditions = append(conditions, "kind = ?")
+		args = append(args, filter.Kind)
+	}
+	if filter.After != nil {
+		conditions = append(conditions, "created_at > ?")
+		args = append(args, *filter.After)
+	}
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := as.storage.queryRowContext(ctx, `SELECT COUNT(*) FROM activities`+where, args...).Scan(&total); err != nil {
+		return nil, 0, storage.ConvertError(err, "count activities", "sqlite")
+	}
+
+	query := `SELECT id, kind, action, workspace_id, recipient_id, actor_id, resource_type, resource_id,
+	                 summary, metadata, created_at
+	          FROM activities` + where + ` ORDER BY created_at DESC, rowid DESC LIMIT ? OFFSET ?`
+	rows, err := as.storage.queryContext(ctx, query, append(args, paging.Limit, paging.GetOffset())...)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "list activities", "sqlite")
+	}
+	defer rows.Close()
+
+	activities := make([]*models.Activity, 0, paging.Limit)
+	for rows.Next() {
+		var (
+			activity models.Activity
+			metadata sql.NullString
+		)
+		err := rows.Scan(
+			&activity.ID,
+			&activity.Kind,
+			&activity.Action,
+			&activity.WorkspaceID,
+			&activity.RecipientID,
+			&activity.ActorID,
+			&activity.ResourceType,
+			&activity.ResourceID,
+			&activity.Summary,
+			&metadata,
+			&activity.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, storage.ConvertError(err, "scan activity", "sqlite")
+		}
+		if metadata.Valid && metadata.String != "" {
+			if err := json.Unmarshal([]byte(metadata.String), &activity.Metadata); err != nil {
+				return nil, 0, storage.ConvertError(err, "decode activity metadata", "sqlite")
+			}
+		}
+		activities = append(activities, &activity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, storage.ConvertError(err, "list activities", "sqlite")
+	}
+	return activities, total, nil
+}
+
+// GetReadMarker 사용자가 피드를 읽음으로 표시한 시각
+func (as *activityStorage) GetReadMarker(ctx context.Context, userID, feed string) (*time.Time, error) {
+	var readAt time.Time
+	err := as.storage.queryRowContext(ctx,
+		`SELECT read_at FROM activity_read_markers WHERE user_id = ? AND feed = ?`, userID, feed).Scan(&readAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, storage.ConvertError(err, "get activity read marker", "sqlite")
+	}
+	return &readAt, nil
+}
+
+// SetReadMarker 사용자가 피드를 읽음으로 표시한 시각 저장
+func (as *activityStorage) SetReadMarker(ctx context.Context, userID, feed string, readAt time.Time) error {
+	_, err := as.storage.execContext(ctx, `
+		INSERT INTO activity_read_markers (user_id, feed, read_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT (user_id, feed) DO UPDATE SET read_at = excluded.read_at`,
+		userID, feed, readAt)
+	if err != nil {
+		return storage.ConvertError(err, "set activity read marker", "sqlite")
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// analyticsStorage 사용량 분석 롤업 SQLite 구현 (029_analytics.sql)
+type analyticsStorage struct {
+	storage *Storage
+}
+
+// newAnalyticsStorage 새 사용량 분석 스토리지 생성
+func newAnalyticsStorage(s *Storage) *analyticsStorage {
+	return &analyticsStorage{storage: s}
+}
+
+// Apply 집계 증분과 워터마크를 하나의 트랜잭션으로 반영
+func (as *analyticsStorage) Apply(ctx context.Context, rollup *models.AnalyticsRollup) error {
+	tx, err := as.storage.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storage.ConvertError(err, "apply analytics rollup", "sqlite")
+	}
+	defer tx.Rollback()
+
+	// 그날 처음 보는 사용자만 활성 사용자 수에 더함
+	newUsers := make(map[time.Time]int64)
+	for _, user := range rollup.Users {
+		result, err := tx.ExecContext(ctx,
+			`INSERT INTO analytics_user_daily (day, user_id) VALUES (?, ?) ON CONFLICT (day, user_id) DO NOTHING`,
+			user.Day.UTC(), user.UserID)
+		if err != nil {
+			return storage.ConvertError(err, "apply analytics users", "sqlite")
+		}
+		if affected, err := result.RowsAffected(); err == nil && affected > 0 {
+			newUsers[user.Day.UTC()]++
+		}
+	}
+
+	days := rollup.Days
+	for day := range newUsers {
+		found := false
+		for _, delta := range rollup.Days {
+			if delta.Day.UTC().Equal(day) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			days = append(days, &models.AnalyticsDay{Day: day})
+		}
+	}
+
+	for _, delta := range days {
+		day := delta.Day.UTC()
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO analytics_daily (day, tasks, completed, failed, cancelled, duration_ms, timed_tasks,
+			                             cost_usd, tokens, active_users, peak_concurrency)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (day) DO UPDATE SET
+				tasks = tasks + excluded.tasks,
+				completed = completed + excluded.completed,
+				failed = failed + excluded.failed,
+				cancelled = cancelled + excluded.cancelled,
+				duration_ms = duration_ms + excluded.duration_ms,
+				timed_tasks = timed_tasks + excluded.timed_tasks,
+				cost_usd = cost_usd + excluded.cost_usd,
+				tokens = tokens + excluded.tokens,
+				active_users = active_users + excluded.active_users,
+				peak_concurrency = MAX(peak_concurrency, excluded.peak_concurrency)`,
+			day,
+			delta.Tasks,
+			delta.Completed,
+			delta.Failed,
+			delta.Cancelled,
+			delta.DurationMs,
+			delta.TimedTasks,
+			delta.CostUSD,
+			delta.Tokens,
+			newUsers[day],
+			delta.PeakConcurrency,
+		)
+		if err != nil {
+			return storage.ConvertError(err, "apply analytics days", "sqlite")
+		}
+	}
+
+	for _, delta := range rollup.Workspaces {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO analytics_workspace_daily (day, workspace_id, owner_id, tasks, cost_usd, tokens)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (day, workspace_id) DO UPDATE SET
+				owner_id = excluded.owner_id,
+				tasks = tasks + excluded.tasks,
+				cost_usd = cost_usd + excluded.cost_usd,
+				tokens = tokens + excluded.tokens`,
+			delta.Day.UTC(), delta.WorkspaceID, delta.OwnerID, delta.Tasks, delta.CostUSD, delta.Tokens)
+		if err != nil {
+			return storage.ConvertError(err, "apply analytics workspaces", "sqlite")
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO analytics_state (id, watermark) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET watermark = excluded.watermark`,
+		rollup.Watermark.UTC())
+	if err != nil {
+		return storage.ConvertError(err, "save analytics watermark", "sqlite")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return storage.ConvertError(err, "apply analytics rollup", "sqlite")
+	}
+	return nil
+}
+
+// Watermark 마지막 집계에 반영된 시각
+func (as *analyticsStorage) Watermark(ctx context.Context) (*time.Time, error) {
+	var watermark time.Time
+	err := as.storage.queryRowContext(ctx, `SELECT watermark FROM analytics_state WHERE id = 1`).Scan(&watermark)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, storage.ConvertError(err, "get analytics watermark", "sqlite")
+	}
+	watermark = watermark.UTC()
+	return &watermark, nil
+}
+
+// ListDays 기간 안의 일별 집계 조회 (날짜순)
+func (as *analyticsStorage) ListDays(ctx context.Context, from, to time.Time) ([]*models.AnalyticsDay, error) {
+	rows, err := as.storage.queryContext(ctx, `
+		SELECT day, tasks, completed, failed, cancelled, duration_ms, timed_tasks,
+		       cost_usd, tokens, active_users, peak_concurrency
+		FROM analytics_daily
+		WHERE day >= ? AND day < ?
+		ORDER BY day`,
+		from.UTC(), to.UTC())
+	if err != nil {
+		return nil, storage.ConvertError(err, "list analytics days", "sqlite")
+	}
+	defer rows.Close()
+
+	days := []*models.AnalyticsDay{}
+	for rows.Next() {
+		var day models.AnalyticsDay
+		err := rows.Scan(
+			&day.Day,
+			&day.Tasks,
+			&day.Completed,
+			&day.Failed,
+			&day.Cancelled,
+			&day.DurationMs,
+			&day.TimedTasks,
+			&day.CostUSD,
+			&day.Tokens,
+			&day.ActiveUsers,
+			&day.PeakConcurrency,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan analytics day", "sqlite")
+		}
+		day.Day = day.Day.UTC()
+		days = append(days, &day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list analytics days", "sqlite")
+	}
+	return days, nil
+}
+
+// TopWorkspaces 기간 동안 비용이 많이 든 워크스페이스
+func (as *analyticsStorage) TopWorkspaces(ctx context.Context, from, to time.Time, limit int) ([]*models.AnalyticsWorkspaceUsage, error) {
+	query := `
+		SELECT workspace_id, MAX(owner_id), SUM(tasks), SUM(cost_usd), SUM(tokens)
+		FROM analytics_workspace_daily
+		WHERE day >= ? AND day < ?
+		GROUP BY workspace_id
+		ORDER BY SUM(cost_usd) DESC, SUM(tasks) DESC, workspace_id`
+	args := []interface{}{from.UTC(), to.UTC()}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := as.storage.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list analytics workspaces", "sqlite")
+	}
+	defer rows.Close()
+
+	usages := []*models.AnalyticsWorkspaceUsage{}
+	for rows.Next() {
+		var usage models.AnalyticsWorkspaceUsage
+		if err := rows.Scan(&usage.WorkspaceID, &usage.OwnerID, &usage.Tasks, &usage.CostUSD, &usage.Tokens); err != nil {
+			return nil, storage.ConvertError(err, "scan analytics workspace", "sqlite")
+		}
+		usages = append(usages, &usage)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list analytics workspaces", "sqlite")
+	}
+	return usages, nil
+}
+
+// CountActiveUsers 기간 동안 태스크를 실행한 사용자 수
+func (as *analyticsStorage) CountActiveUsers(ctx context.Context, from, to time.Time) (int64, error) {
+	var count int64
+	err := as.storage.queryRowContext(ctx,
+		`SELECT COUNT(DISTINCT user_id) FROM analytics_user_daily WHERE day >= ? AND day < ?`,
+		from.UTC(), to.UTC()).Scan(&count)
+	if err != nil {
+		return 0, storage.ConvertError(err, "count analytics users", "sqlite")
+	}
+	return count, nil
+}
@@ -0,0 +1,221 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// defaultEmailDeliveryLimit 조회 개수를 지정하지 않았을 때 반환할 발송 기록 수
+const defaultEmailDeliveryLimit = 100
+
+// emailDeliveryStorage 메일 발송 기록 SQLite 구현 (010_email_deliveries.sql)
+type emailDeliveryStorage struct {
+	storage *Storage
+}
+
+// newEmailDeliveryStorage 새 발송 기록 스토리지 생성
+func newEmailDeliveryStorage(s *Storage) *emailDeliveryStorage {
+	return &emailDeliveryStorage{storage: s}
+}
+
+const (
+	// 발송 기록 조회 쿼리
+	selectEmailDeliveryQuery = `
+		SELECT id, template, recipient, subject, html_body, text_body, status, attempts,
+		       last_error, next_attempt_at, sent_at, created_at, updated_at
+		FROM email_deliveries
+	`
+
+	// 발송 기록 삽입 쿼리
+	insertEmailDeliveryQuery = `
+		INSERT INTO email_deliveries (id, template, recipient, subject, html_body, text_body, status, attempts,
+		                              last_error, next_attempt_at, sent_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 발송 상태 수정 쿼리
+	updateEmailDeliveryQuery = `
+		UPDATE email_deliveries SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, sent_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+)
+
+// Create 발송 기록 저장
+func (es *emailDeliveryStorage) Create(ctx context.Context, delivery *models.EmailDelivery) error {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now()
+	}
+	delivery.UpdatedAt = delivery.CreatedAt
+
+	_, err := es.storage.execContext(ctx, insertEmailDeliveryQuery,
+		delivery.ID,
+		delivery.Template,
+		delivery.To,
+		delivery.Subject,
+		delivery.HTMLBody,
+		delivery.TextBody,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.LastError,
+		delivery.NextAttemptAt,
+		delivery.SentAt,
+		delivery.CreatedAt,
+		delivery.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create email delivery", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 발송 기록 조회
+func (es *emailDeliveryStorage) GetByID(ctx context.Context, id string) (*models.EmailDelivery, error) {
+	deliveries, err := es.query(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return deliveries[0], nil
+}
+
+// Update 발송 상태 저장 (렌더링한 본문은 바꾸지 않음)
+func (es *emailDeliveryStorage) Update(ctx context.Context, delivery *models.EmailDelivery) error {
+	delivery.UpdatedAt = time.Now()
+
+	result, err := es.storage.execContext(ctx, updateEmailDeliveryQuery,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.LastError,
+		delivery.NextAttemptAt,
+		delivery.SentAt,
+		delivery.UpdatedAt,
+		delivery.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update email delivery", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "update email delivery", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// List 조건에 맞는 발송 기록 조회 (최신순)
+func (es *emailDeliveryStorage) List(ctx context.Context, filter *models.EmailDeliveryFilter) ([]*models.EmailDelivery, error) {
+	if filter == nil {
+		filter = &models.EmailDeliveryFilter{}
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.Template != "" {
+		conditions = append(conditions, "template = ?")
+		args = append(args, filter.Template)
+	}
+	if filter.To != "" {
+		conditions = append(conditions, "recipient = ?")
+		args = append(args, filter.To)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultEmailDeliveryLimit
+	}
+	args = append(args, limit)
+
+	return es.query(ctx, where+` ORDER BY created_at DESC, id DESC LIMIT ?`, args...)
+}
+
+// ListDue 발송할 때가 된 대기 기록 조회 (다음 시도 시각순)
+func (es *emailDeliveryStorage) ListDue(ctx context.Context, now time.Time, limit int) ([]*models.EmailDelivery, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+	return es.query(ctx, `
+		WHERE status = ? AND (next_attempt_at IS NULL OR next_attempt_at <= ?)
+		ORDER BY COALESCE(next_attempt_at, created_at) LIMIT ?`,
+		models.EmailDeliveryPending, now, limit)
+}
+
+// query 조건에 맞는 발송 기록 조회
+func (es *emailDeliveryStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.EmailDelivery, error) {
+	rows, err := es.storage.queryContext(ctx, selectEmailDeliveryQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list email deliveries", "sqlite")
+	}
+	defer rows.Close()
+
+	deliveries := []*models.EmailDelivery{}
+	for rows.Next() {
+		delivery, err := scanEmailDelivery(rows)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan email delivery", "sqlite")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list email deliveries", "sqlite")
+	}
+	return deliveries, nil
+}
+
+// scanEmailDelivery 조회 결과 행을 발송 기록으로 변환
+func scanEmailDelivery(rows *sql.Rows) (*models.EmailDelivery, error) {
+	var (
+		delivery            models.EmailDelivery
+		textBody, lastError sql.NullString
+		nextAttemptAt       sql.NullTime
+		sentAt              sql.NullTime
+	)
+	err := rows.Scan(
+		&delivery.ID,
+		&delivery.Template,
+		&delivery.To,
+		&delivery.Subject,
+		&delivery.HTMLBody,
+		&textBody,
+		&delivery.Status,
+		&delivery.Attempts,
+		&lastError,
+		&nextAttemptAt,
+		&sentAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	delivery.TextBody = textBody.String
+	delivery.LastError = lastError.String
+	if nextAttemptAt.Valid {
+		delivery.NextAttemptAt = &nextAttemptAt.Time
+	}
+	if sentAt.Valid {
+		delivery.SentAt = &sentAt.Time
+	}
+	return &delivery, nil
+}
@@ -0,0 +1,96 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// errorStatsStorage 에러 통계 SQLite 구현 (007_error_stats.sql)
+type errorStatsStorage struct {
+	storage *Storage
+}
+
+// newErrorStatsStorage 새 에러 통계 스토리지 생성
+func newErrorStatsStorage(s *Storage) *errorStatsStorage {
+	return &errorStatsStorage{storage: s}
+}
+
+// Increment 집계 구간별 발생 횟수 누적 (하나의 트랜잭션으로 처리)
+func (es *errorStatsStorage) Increment(ctx context.Context, buckets []*models.ErrorStatBucket) error {
+	if len(buckets) == 0 {
+		return nil
+	}
+
+	tx, err := es.storage.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storage.ConvertError(err, "increment error stats", "sqlite")
+	}
+	defer tx.Rollback()
+
+	for _, bucket := range buckets {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO error_stats (granularity, bucket_start, error_type, severity, category, count)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (granularity, bucket_start, error_type, severity, category)
+			DO UPDATE SET count = count + excluded.count`,
+			bucket.Granularity,
+			bucket.BucketStart.UTC(),
+			bucket.ErrorType,
+			bucket.Severity,
+			bucket.Category,
+			bucket.Count,
+		)
+		if err != nil {
+			return storage.ConvertError(err, "increment error stats", "sqlite")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return storage.ConvertError(err, "increment error stats", "sqlite")
+	}
+	return nil
+}
+
+// List 기간 안의 집계 조회
+func (es *errorStatsStorage) List(ctx context.Context, granularity models.ErrorStatGranularity, from, to time.Time) ([]*models.ErrorStatBucket, error) {
+	rows, err := es.storage.queryContext(ctx, `
+		SELECT granularity, bucket_start, error_type, severity, category, count
+		FROM error_stats
+		WHERE granularity = ? AND bucket_start >= ? AND bucket_start < ?
+		ORDER BY bucket_start, error_type, severity, category`,
+		granularity, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, storage.ConvertError(err, "list error stats", "sqlite")
+	}
+	defer rows.Close()
+
+	buckets := []*models.ErrorStatBucket{}
+	for rows.Next() {
+		var bucket models.ErrorStatBucket
+		if err := rows.Scan(&bucket.Granularity, &bucket.BucketStart, &bucket.ErrorType, &bucket.Severity, &bucket.Category, &bucket.Count); err != nil {
+			return nil, storage.ConvertError(err, "scan error stats", "sqlite")
+		}
+		bucket.BucketStart = bucket.BucketStart.UTC()
+		buckets = append(buckets, &bucket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list error stats", "sqlite")
+	}
+	return buckets, nil
+}
+
+// DeleteBefore 기준 시각 이전 구간 삭제
+func (es *errorStatsStorage) DeleteBefore(ctx context.Context, granularity models.ErrorStatGranularity, before time.Time) (int64, error) {
+	result, err := es.storage.execContext(ctx, `DELETE FROM error_stats WHERE granularity = ? AND bucket_start < ?`, granularity, before.UTC())
+	if err != nil {
+		return 0, storage.ConvertError(err, "delete error stats", "sqlite")
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, storage.ConvertError(err, "delete error stats", "sqlite")
+	}
+	return deleted, nil
+}
@@ -0,0 +1,166 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// fanOutStorage 워크스페이스 팬아웃 SQLite 구현 (014_fanouts.sql)
+type fanOutStorage struct {
+	storage *Storage
+}
+
+// newFanOutStorage 새 팬아웃 스토리지 생성
+func newFanOutStorage(s *Storage) *fanOutStorage {
+	return &fanOutStorage{storage: s}
+}
+
+// 팬아웃 조회 쿼리
+const selectFanOutQuery = `
+	SELECT id, owner_id, name, command, prompt_id, concurrency, timeout_tier, status, targets,
+	       report_key, created_at, updated_at, completed_at
+	FROM fan_outs
+`
+
+// Create 새 팬아웃 생성
+func (fs *fanOutStorage) Create(ctx context.Context, fanOut *models.FanOut) error {
+	if fanOut.ID == "" {
+		fanOut.ID = uuid.New().String()
+	}
+	if fanOut.CreatedAt.IsZero() {
+		fanOut.CreatedAt = time.Now()
+	}
+	fanOut.UpdatedAt = fanOut.CreatedAt
+
+	targets, err := marshalPipelineJSON(fanOut.Targets)
+	if err != nil {
+		return storage.ConvertError(err, "create fan-out", "sqlite")
+	}
+	_, err = fs.storage.execContext(ctx, `
+		INSERT INTO fan_outs (id, owner_id, name, command, prompt_id, concurrency, timeout_tier, status, targets,
+		                      report_key, created_at, updated_at, completed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		fanOut.ID,
+		fanOut.OwnerID,
+		fanOut.Name,
+		fanOut.Command,
+		fanOut.PromptID,
+		fanOut.Concurrency,
+		fanOut.TimeoutTier,
+		fanOut.Status,
+		targets,
+		fanOut.ReportKey,
+		fanOut.CreatedAt,
+		fanOut.UpdatedAt,
+		fanOut.CompletedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create fan-out", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 팬아웃 조회
+func (fs *fanOutStorage) GetByID(ctx context.Context, id string) (*models.FanOut, error) {
+	fanOuts, err := fs.query(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(fanOuts) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return fanOuts[0], nil
+}
+
+// Update 팬아웃 저장
+func (fs *fanOutStorage) Update(ctx context.Context, fanOut *models.FanOut) error {
+	fanOut.UpdatedAt = time.Now()
+
+	targets, err := marshalPipelineJSON(fanOut.Targets)
+	if err != nil {
+		return storage.ConvertError(err, "update fan-out", "sqlite")
+	}
+	result, err := fs.storage.execContext(ctx, `
+		UPDATE fan_outs SET status = ?, targets = ?, report_key = ?, updated_at = ?, completed_at = ?
+		WHERE id = ?`,
+		fanOut.Status,
+		targets,
+		fanOut.ReportKey,
+		fanOut.UpdatedAt,
+		fanOut.CompletedAt,
+		fanOut.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update fan-out", "sqlite")
+	}
+	return requireAffected(result, "update fan-out")
+}
+
+// ListByOwner 사용자의 팬아웃 조회 (최신순)
+func (fs *fanOutStorage) ListByOwner(ctx context.Context, ownerID string, limit int) ([]*models.FanOut, error) {
+	clause := `WHERE owner_id = ? ORDER BY created_at DESC, id DESC`
+	args := []interface{}{ownerID}
+	if limit > 0 {
+		clause += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	return fs.query(ctx, clause, args...)
+}
+
+// query 조건에 맞는 팬아웃 조회 (진행 상황은 워크스페이스별 상태로 계산)
+func (fs *fanOutStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.FanOut, error) {
+	rows, err := fs.storage.queryContext(ctx, selectFanOutQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list fan-outs", "sqlite")
+	}
+	defer rows.Close()
+
+	fanOuts := []*models.FanOut{}
+	for rows.Next() {
+		var (
+			fanOut              models.FanOut
+			promptID, reportKey sql.NullString
+			targets             string
+			completedAt         sql.NullTime
+		)
+		err := rows.Scan(
+			&fanOut.ID,
+			&fanOut.OwnerID,
+			&fanOut.Name,
+			&fanOut.Command,
+			&promptID,
+			&fanOut.Concurrency,
+			&fanOut.TimeoutTier,
+			&fanOut.Status,
+			&targets,
+			&reportKey,
+			&fanOut.CreatedAt,
+			&fanOut.UpdatedAt,
+			&completedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan fan-out", "sqlite")
+		}
+		fanOut.PromptID = promptID.String
+		fanOut.ReportKey = reportKey.String
+		if completedAt.Valid {
+			fanOut.CompletedAt = &completedAt.Time
+		}
+		if err := json.Unmarshal([]byte(targets), &fanOut.Targets); err != nil {
+			return nil, storage.ConvertError(err, "scan fan-out", "sqlite")
+		}
+		fanOut.RefreshProgress()
+		fanOuts = append(fanOuts, &fanOut)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list fan-outs", "sqlite")
+	}
+	return fanOuts, nil
+}
@@ -0,0 +1,279 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// issueTrackerStorage 이슈 트래커 설정과 이슈 연결 SQLite 구현 (018_issue_tracker.sql)
+type issueTrackerStorage struct {
+	storage *Storage
+}
+
+// newIssueTrackerStorage 새 이슈 트래커 스토리지 생성
+func newIssueTrackerStorage(s *Storage) *issueTrackerStorage {
+	return &issueTrackerStorage{storage: s}
+}
+
+const (
+	// 이슈 트래커 설정 조회 쿼리
+	selectIssueTrackerConfigQuery = `
+		SELECT workspace_id, provider, base_url, default_project, username, token, comment_on_finish,
+		       approval_transition, updated_by, created_at, updated_at
+		FROM workspace_issue_trackers
+		WHERE workspace_id = ?
+	`
+
+	// 이슈 트래커 설정 저장 쿼리 (생성 시각은 유지)
+	upsertIssueTrackerConfigQuery = `
+		INSERT INTO workspace_issue_trackers (workspace_id, provider, base_url, default_project, username, token,
+		                                      comment_on_finish, approval_transition, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (workspace_id) DO UPDATE SET
+			provider = excluded.provider,
+			base_url = excluded.base_url,
+			default_project = excluded.default_project,
+			username = excluded.username,
+			token = excluded.token,
+			comment_on_finish = excluded.comment_on_finish,
+			approval_transition = excluded.approval_transition,
+			updated_by = excluded.updated_by,
+			updated_at = excluded.updated_at
+	`
+
+	// 이슈 연결 조회 쿼리
+	selectIssueLinkQuery = `
+		SELECT id, workspace_id, session_id, task_id, provider, issue_key, issue_url, issue_title, commented_at,
+		       transitioned_at, last_error, created_by, created_at, updated_at
+		FROM issue_links
+	`
+)
+
+// GetConfig 워크스페이스의 이슈 트래커 설정 조회
+func (is *issueTrackerStorage) GetConfig(ctx context.Context, workspaceID string) (*models.IssueTrackerConfig, error) {
+	var (
+		config                                               models.IssueTrackerConfig
+		baseURL, defaultProject, username, token, transition sql.NullString
+	)
+	err := is.storage.queryRowContext(ctx, selectIssueTrackerConfigQuery, workspaceID).Scan(
+		&config.WorkspaceID,
+		&config.Provider,
+		&baseURL,
+		&defaultProject,
+		&username,
+		&token,
+		&config.CommentOnFinish,
+		&transition,
+		&config.UpdatedBy,
+		&config.CreatedAt,
+		&config.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, "get issue tracker config", "sqlite")
+	}
+	config.BaseURL = baseURL.String
+	config.DefaultProject = defaultProject.String
+	config.Username = username.String
+	config.Token = token.String
+	config.ApprovalTransition = transition.String
+	return &config, nil
+}
+
+// SaveConfig 이슈 트래커 설정 저장
+func (is *issueTrackerStorage) SaveConfig(ctx context.Context, config *models.IssueTrackerConfig) error {
+	now := time.Now()
+	if config.CreatedAt.IsZero() {
+		config.CreatedAt = now
+	}
+	config.UpdatedAt = now
+
+	_, err := is.storage.execContext(ctx, upsertIssueTrackerConfigQuery,
+		config.WorkspaceID,
+		config.Provider,
+		config.BaseURL,
+		config.DefaultProject,
+		config.Username,
+		config.Token,
+		config.CommentOnFinish,
+		config.ApprovalTransition,
+		config.UpdatedBy,
+		config.CreatedAt,
+		config.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "save issue tracker config", "sqlite")
+	}
+	return nil
+}
+
+// DeleteConfig 이슈 트래커 설정 삭제
+func (is *issueTrackerStorage) DeleteConfig(ctx context.Context, workspaceID string) error {
+	result, err := is.storage.execContext(ctx, `DELETE FROM workspace_issue_trackers WHERE workspace_id = ?`, workspaceID)
+	if err != nil {
+		return storage.ConvertError(err, "delete issue tracker config", "sqlite")
+	}
+	return requireAffected(result, "delete issue tracker config")
+}
+
+// ListConfigWorkspaces 이슈 트래커 설정이 있는 워크스페이스 ID 목록
+func (is *issueTrackerStorage) ListConfigWorkspaces(ctx context.Context) ([]string, error) {
+	rows, err := is.storage.queryContext(ctx, `SELECT workspace_id FROM workspace_issue_trackers ORDER BY workspace_id`)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list issue tracker configs", "sqlite")
+	}
+	defer rows.Close()
+
+	workspaceIDs := []string{}
+	for rows.Next() {
+		var workspaceID string
+		if err := rows.Scan(&workspaceID); err != nil {
+			return nil, storage.ConvertError(err, "scan issue tracker config", "sqlite")
+		}
+		workspaceIDs = append(workspaceIDs, workspaceID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list issue tracker configs", "sqlite")
+	}
+	return workspaceIDs, nil
+}
+
+// CreateLink 새 이슈 연결 생성
+func (is *issueTrackerStorage) CreateLink(ctx context.Context, link *models.IssueLink) error {
+	if link.ID == "" {
+		link.ID = uuid.New().String()
+	}
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = time.Now()
+	}
+	link.UpdatedAt = link.CreatedAt
+
+	_, err := is.storage.execContext(ctx, `
+		INSERT INTO issue_links (id, workspace_id, session_id, task_id, provider, issue_key, issue_url, issue_title,
+		                         commented_at, transitioned_at, last_error, created_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		link.ID,
+		link.WorkspaceID,
+		link.SessionID,
+		link.TaskID,
+		link.Provider,
+		link.IssueKey,
+		link.IssueURL,
+		link.IssueTitle,
+		link.CommentedAt,
+		link.TransitionedAt,
+		link.LastError,
+		link.CreatedBy,
+		link.CreatedAt,
+		link.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create issue link", "sqlite")
+	}
+	return nil
+}
+
+// UpdateLink 이슈 연결 저장
+func (is *issueTrackerStorage) UpdateLink(ctx context.Context, link *models.IssueLink) error {
+	link.UpdatedAt = time.Now()
+
+	result, err := is.storage.execContext(ctx, `
+		UPDATE issue_links SET issue_url = ?, issue_title = ?, commented_at = ?, transitioned_at = ?, last_error = ?,
+		                       updated_at = ?
+		WHERE id = ?`,
+		link.IssueURL,
+		link.IssueTitle,
+		link.CommentedAt,
+		link.TransitionedAt,
+		link.LastError,
+		link.UpdatedAt,
+		link.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update issue link", "sqlite")
+	}
+	return requireAffected(result, "update issue link")
+}
+
+// DeleteLink 이슈 연결 삭제
+func (is *issueTrackerStorage) DeleteLink(ctx context.Context, id string) error {
+	result, err := is.storage.execContext(ctx, `DELETE FROM issue_links WHERE id = ?`, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete issue link", "sqlite")
+	}
+	return requireAffected(result, "delete issue link")
+}
+
+// GetLink ID로 이슈 연결 조회
+func (is *issueTrackerStorage) GetLink(ctx context.Context, id string) (*models.IssueLink, error) {
+	links, err := is.query(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(links) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return links[0], nil
+}
+
+// ListLinksBySession 세션의 이슈 연결 조회 (생성순)
+func (is *issueTrackerStorage) ListLinksBySession(ctx context.Context, sessionID string) ([]*models.IssueLink, error) {
+	return is.query(ctx, `WHERE session_id = ? ORDER BY created_at, id`, sessionID)
+}
+
+// query 조건에 맞는 이슈 연결 조회
+func (is *issueTrackerStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.IssueLink, error) {
+	rows, err := is.storage.queryContext(ctx, selectIssueLinkQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list issue links", "sqlite")
+	}
+	defer rows.Close()
+
+	links := []*models.IssueLink{}
+	for rows.Next() {
+		var (
+			link                      models.IssueLink
+			commentedAt, transitioned sql.NullTime
+			lastError                 sql.NullString
+		)
+		err := rows.Scan(
+			&link.ID,
+			&link.WorkspaceID,
+			&link.SessionID,
+			&link.TaskID,
+			&link.Provider,
+			&link.IssueKey,
+			&link.IssueURL,
+			&link.IssueTitle,
+			&commentedAt,
+			&transitioned,
+			&lastError,
+			&link.CreatedBy,
+			&link.CreatedAt,
+			&link.UpdatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan issue link", "sqlite")
+		}
+		if commentedAt.Valid {
+			link.CommentedAt = &commentedAt.Time
+		}
+		if transitioned.Valid {
+			link.TransitionedAt = &transitioned.Time
+		}
+		link.LastError = lastError.String
+		links = append(links, &link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list issue links", "sqlite")
+	}
+	return links, nil
+}
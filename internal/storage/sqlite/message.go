@@ -0,0 +1,253 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// messageStorage 세션 대화 기록 SQLite 구현
+// 검색은 FTS5 인덱스(004_session_messages_fts.sql)가 있으면 사용하고, 없으면 LIKE 조회로 동작합니다.
+type messageStorage struct {
+	storage *Storage
+}
+
+// newMessageStorage 새 대화 기록 스토리지 생성
+func newMessageStorage(s *Storage) *messageStorage {
+	return &messageStorage{storage: s}
+}
+
+const (
+	// 메시지 조회 컬럼
+	messageColumns = `m.id, m.session_id, m.workspace_id, m.sequence, m.role, m.type, m.content, m.metadata, m.created_at`
+
+	// 메시지 삽입 쿼리 (세션 내 다음 순서 번호 할당)
+	insertMessageQuery = `
+		INSERT INTO session_messages (id, session_id, workspace_id, sequence, role, type, content, metadata, created_at)
+		SELECT ?, ?, ?, COALESCE(MAX(sequence), 0) + 1, ?, ?, ?, ?, ?
+		FROM session_messages WHERE session_id = ?
+		RETURNING sequence
+	`
+
+	// FTS5 인덱스 존재 여부 확인 쿼리
+	messageFTSExistsQuery = `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'session_messages_fts'`
+)
+
+// Append 세션에 메시지 추가
+func (ms *messageStorage) Append(ctx context.Context, message *models.SessionMessage) error {
+	if message.ID == "" {
+		message.ID = uuid.New().String()
+	}
+	if message.CreatedAt.IsZero() {
+		message.CreatedAt = time.Now()
+	}
+
+	metadataJSON := "{}"
+	if len(message.Metadata) > 0 {
+		data, err := json.Marshal(message.Metadata)
+		if err != nil {
+			return err
+		}
+		metadataJSON = string(data)
+	}
+
+	err := ms.storage.queryRowContext(ctx, insertMessageQuery,
+		message.ID,
+		message.SessionID,
+		message.WorkspaceID,
+		message.Role,
+		message.Type,
+		message.Content,
+		metadataJSON,
+		message.CreatedAt,
+		message.SessionID,
+	).Scan(&message.Sequence)
+	if err != nil {
+		return storage.ConvertError(err, "append session message", "sqlite")
+	}
+
+	return nil
+}
+
+// ListBySession 세션의 메시지를 순서대로 조회
+func (ms *messageStorage) ListBySession(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionMessage, int, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	var total int
+	err := ms.storage.queryRowContext(ctx, `SELECT COUNT(*) FROM session_messages WHERE session_id = ?`, sessionID).Scan(&total)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "count session messages", "sqlite")
+	}
+
+	query := `SELECT ` + messageColumns + ` FROM session_messages m WHERE m.session_id = ? ORDER BY m.sequence ASC LIMIT ? OFFSET ?`
+	rows, err := ms.storage.queryContext(ctx, query, sessionID, paging.Limit, paging.GetOffset())
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "list session messages", "sqlite")
+	}
+	defer rows.Close()
+
+	messages := make([]*models.SessionMessage, 0, paging.Limit)
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, storage.ConvertError(err, "list session messages", "sqlite")
+	}
+
+	return messages, total, nil
+}
+
+// Search 모든 검색 단어를 포함하는 메시지를 최신순으로 조회
+func (ms *messageStorage) Search(ctx context.Context, query *models.MessageSearchQuery, paging *models.PaginationRequest) ([]*models.MessageSearchResult, int, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	terms := storage.SearchTerms(query.Query)
+	if len(terms) == 0 || (query.WorkspaceIDs != nil && len(query.WorkspaceIDs) == 0) {
+		return []*models.MessageSearchResult{}, 0, nil
+	}
+
+	useFTS, err := ms.hasFTS(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 검색 조건 생성
+	from := ` FROM session_messages m`
+	conditions := []string{}
+	args := []interface{}{}
+	if useFTS {
+		from += ` JOIN session_messages_fts f ON f.rowid = m.rowid`
+		conditions = append(conditions, `session_messages_fts MATCH ?`)
+		args = append(args, ftsMatchExpression(terms))
+	} else {
+		for _, term := range terms {
+			conditions = append(conditions, `LOWER(m.content) LIKE ? ESCAPE '\'`)
+			args = append(args, "%"+escapeLike(term)+"%")
+		}
+	}
+	if query.WorkspaceIDs != nil {
+		conditions = append(conditions, `m.workspace_id IN (?`+strings.Repeat(`, ?`, len(query.WorkspaceIDs)-1)+`)`)
+		for _, id := range query.WorkspaceIDs {
+			args = append(args, id)
+		}
+	}
+	if query.SessionID != "" {
+		conditions = append(conditions, `m.session_id = ?`)
+		args = append(args, query.SessionID)
+	}
+	if query.Role != "" {
+		conditions = append(conditions, `m.role = ?`)
+		args = append(args, query.Role)
+	}
+	where := ` WHERE ` + strings.Join(conditions, ` AND `)
+
+	// 검색 쿼리는 단어 수와 워크스페이스 수에 따라 달라지므로 Prepared Statement 캐시를 사용하지 않음
+	var total int
+	if err := ms.storage.db.QueryRowContext(ctx, `SELECT COUNT(*)`+from+where, args...).Scan(&total); err != nil {
+		return nil, 0, storage.ConvertError(err, "count message search", "sqlite")
+	}
+
+	selectQuery := `SELECT ` + messageColumns + from + where + ` ORDER BY m.created_at DESC, m.id DESC LIMIT ? OFFSET ?`
+	rows, err := ms.storage.db.QueryContext(ctx, selectQuery, append(args, paging.Limit, paging.GetOffset())...)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "search session messages", "sqlite")
+	}
+	defer rows.Close()
+
+	results := make([]*models.MessageSearchResult, 0, paging.Limit)
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		results = append(results, &models.MessageSearchResult{
+			SessionMessage: *message,
+			Snippet:        storage.MessageSnippet(message.Content, terms),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, storage.ConvertError(err, "search session messages", "sqlite")
+	}
+
+	return results, total, nil
+}
+
+// DeleteBySession 세션의 메시지 전체 삭제
+func (ms *messageStorage) DeleteBySession(ctx context.Context, sessionID string) error {
+	if _, err := ms.storage.execContext(ctx, `DELETE FROM session_messages WHERE session_id = ?`, sessionID); err != nil {
+		return storage.ConvertError(err, "delete session messages", "sqlite")
+	}
+	return nil
+}
+
+// hasFTS FTS5 인덱스 테이블이 생성되어 있는지 확인
+func (ms *messageStorage) hasFTS(ctx context.Context) (bool, error) {
+	var count int
+	if err := ms.storage.queryRowContext(ctx, messageFTSExistsQuery).Scan(&count); err != nil {
+		return false, storage.ConvertError(err, "check message search index", "sqlite")
+	}
+	return count > 0, nil
+}
+
+// scanMessage 조회 결과 행을 메시지로 변환
+func scanMessage(rows *sql.Rows) (*models.SessionMessage, error) {
+	var (
+		message      models.SessionMessage
+		messageType  sql.NullString
+		metadataJSON sql.NullString
+	)
+	err := rows.Scan(
+		&message.ID,
+		&message.SessionID,
+		&message.WorkspaceID,
+		&message.Sequence,
+		&message.Role,
+		&messageType,
+		&message.Content,
+		&metadataJSON,
+		&message.CreatedAt,
+	)
+	if err != nil {
+		return nil, storage.ConvertError(err, "scan session message", "sqlite")
+	}
+
+	message.Type = messageType.String
+	if metadataJSON.Valid && metadataJSON.String != "" && metadataJSON.String != "{}" {
+		if err := json.Unmarshal([]byte(metadataJSON.String), &message.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	return &message, nil
+}
+
+// ftsMatchExpression 검색 단어를 FTS5 MATCH 식으로 변환 (모든 단어 접두어 일치)
+func ftsMatchExpression(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"*`
+	}
+	return strings.Join(quoted, " ")
+}
+
+// escapeLike LIKE 패턴의 특수 문자 이스케이프
+func escapeLike(term string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(term)
+}
@@ -0,0 +1,239 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// notificationStorage 알림 센터 SQLite 구현 (028_notifications.sql)
+type notificationStorage struct {
+	storage *Storage
+}
+
+// newNotificationStorage 새 알림 센터 스토리지 생성
+func newNotificationStorage(s *Storage) *notificationStorage {
+	return &notificationStorage{storage: s}
+}
+
+// 알림 조회 쿼리
+const selectNotificationQuery = `
+	SELECT id, user_id, type, title, message, link, data, read_at, digest_pending, created_at
+	FROM notifications
+`
+
+// Create 알림 추가
+func (ns *notificationStorage) Create(ctx context.Context, notification *models.Notification) error {
+	if notification.ID == "" {
+		notification.ID = uuid.New().String()
+	}
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now()
+	}
+
+	var data sql.NullString
+	if len(notification.Data) > 0 {
+		encoded, err := json.Marshal(notification.Data)
+		if err != nil {
+			return storage.ConvertError(err, "create notification", "sqlite")
+		}
+		data = sql.NullString{String: string(encoded), Valid: true}
+	}
+
+	_, err := ns.storage.execContext(ctx, `
+		INSERT INTO notifications (id, user_id, type, title, message, link, data, read_at, digest_pending, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		notification.ID,
+		notification.UserID,
+		notification.Type,
+		notification.Title,
+		notification.Message,
+		notification.Link,
+		data,
+		notification.ReadAt,
+		notification.DigestPending,
+		notification.CreatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create notification", "sqlite")
+	}
+	return nil
+}
+
+// List 조건에 맞는 알림 조회 (최신순)
+func (ns *notificationStorage) List(ctx context.Context, filter *models.NotificationFilter, paging *models.PaginationRequest) ([]*models.Notification, int, error) {
+	if filter == nil {
+		filter = &models.NotificationFilter{}
+	}
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	conditions := []string{"user_id = ?"}
+	args := []interface{}{filter.UserID}
+	if filter.Type != "" {
+		conditions = append(conditions, "type = ?")
+		args = append(args, filter.Type)
+	}
+	if filter.UnreadOnly {
+		conditions = append(conditions, "read_at IS NULL")
+	}
+	if filter.Since != nil {
+		conditions = append(conditions, "created_at > ?")
+		args = append(args, *filter.Since)
+	}
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := ns.storage.queryRowContext(ctx, `SELECT COUNT(*) FROM notifications`+where, args...).Scan(&total); err != nil {
+		return nil, 0, storage.ConvertError(err, "count notifications", "sqlite")
+	}
+
+	query := selectNotificationQuery + where + ` ORDER BY created_at DESC, rowid DESC LIMIT ? OFFSET ?`
+	notifications, err := ns.query(ctx, query, append(args, paging.Limit, paging.GetOffset())...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, total, nil
+}
+
+// MarkRead 사용자의 안 읽은 알림을 읽음으로 표시
+func (ns *notificationStorage) MarkRead(ctx context.Context, userID string, ids []string, readAt time.Time) (int, error) {
+	query := `UPDATE notifications SET read_at = ? WHERE user_id = ? AND read_at IS NULL`
+	args := []interface{}{readAt, userID}
+	if len(ids) > 0 {
+		query += ` AND id IN (?` + strings.Repeat(", ?", len(ids)-1) + `)`
+		for _, id := range ids {
+			args = append(args, id)
+		}
+	}
+
+	result, err := ns.storage.execContext(ctx, query, args...)
+	if err != nil {
+		return 0, storage.ConvertError(err, "mark notifications read", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, storage.ConvertError(err, "mark notifications read", "sqlite")
+	}
+	return int(affected), nil
+}
+
+// ListDigestPending 요약 메일을 기다리는 알림 조회 (사용자, 생성 시각순)
+func (ns *notificationStorage) ListDigestPending(ctx context.Context, limit int) ([]*models.Notification, error) {
+	query := selectNotificationQuery + ` WHERE digest_pending = 1 ORDER BY user_id, created_at, rowid`
+	args := []interface{}{}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+	return ns.query(ctx, query, args...)
+}
+
+// ClearDigestPending 요약 메일로 보낸 알림 표시
+func (ns *notificationStorage) ClearDigestPending(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	_, err := ns.storage.execContext(ctx,
+		`UPDATE notifications SET digest_pending = 0 WHERE id IN (?`+strings.Repeat(", ?", len(ids)-1)+`)`, args...)
+	if err != nil {
+		return storage.ConvertError(err, "clear notification digest", "sqlite")
+	}
+	return nil
+}
+
+// GetPreferences 사용자가 저장한 알림 설정 (종류 이름순)
+func (ns *notificationStorage) GetPreferences(ctx context.Context, userID string) ([]*models.NotificationPreference, error) {
+	rows, err := ns.storage.queryContext(ctx,
+		`SELECT type, in_app, email FROM notification_preferences WHERE user_id = ? ORDER BY type`, userID)
+	if err != nil {
+		return nil, storage.ConvertError(err, "get notification preferences", "sqlite")
+	}
+	defer rows.Close()
+
+	preferences := []*models.NotificationPreference{}
+	for rows.Next() {
+		var preference models.NotificationPreference
+		if err := rows.Scan(&preference.Type, &preference.InApp, &preference.Email); err != nil {
+			return nil, storage.ConvertError(err, "scan notification preference", "sqlite")
+		}
+		preferences = append(preferences, &preference)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "get notification preferences", "sqlite")
+	}
+	return preferences, nil
+}
+
+// SetPreference 알림 종류 하나의 설정 저장
+func (ns *notificationStorage) SetPreference(ctx context.Context, userID string, preference *models.NotificationPreference) error {
+	_, err := ns.storage.execContext(ctx, `
+		INSERT INTO notification_preferences (user_id, type, in_app, email, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (user_id, type) DO UPDATE SET
+			in_app = excluded.in_app, email = excluded.email, updated_at = excluded.updated_at`,
+		userID, preference.Type, preference.InApp, preference.Email, time.Now())
+	if err != nil {
+		return storage.ConvertError(err, "set notification preference", "sqlite")
+	}
+	return nil
+}
+
+// query 알림 조회 쿼리 실행
+func (ns *notificationStorage) query(ctx context.Context, query string, args ...interface{}) ([]*models.Notification, error) {
+	rows, err := ns.storage.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list notifications", "sqlite")
+	}
+	defer rows.Close()
+
+	notifications := []*models.Notification{}
+	for rows.Next() {
+		var (
+			notification models.Notification
+			data         sql.NullString
+			readAt       sql.NullTime
+		)
+		err := rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Type,
+			&notification.Title,
+			&notification.Message,
+			&notification.Link,
+			&data,
+			&readAt,
+			&notification.DigestPending,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan notification", "sqlite")
+		}
+		if data.Valid && data.String != "" {
+			if err := json.Unmarshal([]byte(data.String), &notification.Data); err != nil {
+				return nil, storage.ConvertError(err, "decode notification data", "sqlite")
+			}
+		}
+		if readAt.Valid {
+			notification.ReadAt = &readAt.Time
+		}
+		notifications = append(notifications, &notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list notifications", "sqlite")
+	}
+	return notifications, nil
+}
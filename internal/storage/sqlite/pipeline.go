@@ -0,0 +1,348 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// pipelineStorage 파이프라인 SQLite 구현 (013_pipelines.sql)
+type pipelineStorage struct {
+	storage *Storage
+}
+
+// newPipelineStorage 새 파이프라인 스토리지 생성
+func newPipelineStorage(s *Storage) *pipelineStorage {
+	return &pipelineStorage{storage: s}
+}
+
+const (
+	// 파이프라인 조회 쿼리
+	selectPipelineQuery = `
+		SELECT id, owner_id, name, description, steps, created_at, updated_at
+		FROM pipelines
+	`
+
+	// 파이프라인 실행 조회 쿼리
+	selectPipelineRunQuery = `
+		SELECT id, pipeline_id, pipeline_name, owner_id, session_id, status, current_step,
+		       steps, step_runs, error, created_at, updated_at, completed_at
+		FROM pipeline_runs
+	`
+)
+
+// Create 새 파이프라인 생성
+func (ps *pipelineStorage) Create(ctx context.Context, pipeline *models.Pipeline) error {
+	if pipeline.ID == "" {
+		pipeline.ID = uuid.New().String()
+	}
+	if pipeline.CreatedAt.IsZero() {
+		pipeline.CreatedAt = time.Now()
+	}
+	pipeline.UpdatedAt = pipeline.CreatedAt
+
+	steps, err := marshalPipelineJSON(pipeline.Steps)
+	if err != nil {
+		return storage.ConvertError(err, "create pipeline", "sqlite")
+	}
+	_, err = ps.storage.execContext(ctx, `
+		INSERT INTO pipelines (id, owner_id, name, description, steps, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		pipeline.ID,
+		pipeline.OwnerID,
+		pipeline.Name,
+		pipeline.Description,
+		steps,
+		pipeline.CreatedAt,
+		pipeline.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create pipeline", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 파이프라인 조회
+func (ps *pipelineStorage) GetByID(ctx context.Context, id string) (*models.Pipeline, error) {
+	pipelines, err := ps.queryPipelines(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(pipelines) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return pipelines[0], nil
+}
+
+// Update 파이프라인 저장
+func (ps *pipelineStorage) Update(ctx context.Context, pipeline *models.Pipeline) error {
+	pipeline.UpdatedAt = time.Now()
+
+	steps, err := marshalPipelineJSON(pipeline.Steps)
+	if err != nil {
+		return storage.ConvertError(err, "update pipeline", "sqlite")
+	}
+	result, err := ps.storage.execContext(ctx, `
+		UPDATE pipelines SET name = ?, description = ?, steps = ?, updated_at = ?
+		WHERE id = ?`,
+		pipeline.Name,
+		pipeline.Description,
+		steps,
+		pipeline.UpdatedAt,
+		pipeline.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update pipeline", "sqlite")
+	}
+	return requireAffected(result, "update pipeline")
+}
+
+// Delete 파이프라인 삭제
+func (ps *pipelineStorage) Delete(ctx context.Context, id string) error {
+	result, err := ps.storage.execContext(ctx, `DELETE FROM pipelines WHERE id = ?`, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete pipeline", "sqlite")
+	}
+	return requireAffected(result, "delete pipeline")
+}
+
+// ListByOwner 작성자의 파이프라인 조회 (이름순)
+func (ps *pipelineStorage) ListByOwner(ctx context.Context, ownerID string) ([]*models.Pipeline, error) {
+	return ps.queryPipelines(ctx, `WHERE owner_id = ? ORDER BY name, id`, ownerID)
+}
+
+// CreateRun 새 파이프라인 실행 생성
+func (ps *pipelineStorage) CreateRun(ctx context.Context, run *models.PipelineRun) error {
+	if run.ID == "" {
+		run.ID = uuid.New().String()
+	}
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Now()
+	}
+	run.UpdatedAt = run.CreatedAt
+
+	steps, stepRuns, err := marshalPipelineRun(run)
+	if err != nil {
+		return storage.ConvertError(err, "create pipeline run", "sqlite")
+	}
+	_, err = ps.storage.execContext(ctx, `
+		INSERT INTO pipeline_runs (id, pipeline_id, pipeline_name, owner_id, session_id, status, current_step,
+		                           steps, step_runs, error, created_at, updated_at, completed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		run.ID,
+		run.PipelineID,
+		run.PipelineName,
+		run.OwnerID,
+		run.SessionID,
+		run.Status,
+		run.CurrentStep,
+		steps,
+		stepRuns,
+		run.Error,
+		run.CreatedAt,
+		run.UpdatedAt,
+		run.CompletedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create pipeline run", "sqlite")
+	}
+	return nil
+}
+
+// GetRun ID로 파이프라인 실행 조회
+func (ps *pipelineStorage) GetRun(ctx context.Context, id string) (*models.PipelineRun, error) {
+	runs, err := ps.queryRuns(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return runs[0], nil
+}
+
+// UpdateRun 파이프라인 실행 저장
+func (ps *pipelineStorage) UpdateRun(ctx context.Context, run *models.PipelineRun) error {
+	run.UpdatedAt = time.Now()
+
+	steps, stepRuns, err := marshalPipelineRun(run)
+	if err != nil {
+		return storage.ConvertError(err, "update pipeline run", "sqlite")
+	}
+	result, err := ps.storage.execContext(ctx, `
+		UPDATE pipeline_runs SET status = ?, current_step = ?, steps = ?, step_runs = ?, error = ?,
+		                         updated_at = ?, completed_at = ?
+		WHERE id = ?`,
+		run.Status,
+		run.CurrentStep,
+		steps,
+		stepRuns,
+		run.Error,
+		run.UpdatedAt,
+		run.CompletedAt,
+		run.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update pipeline run", "sqlite")
+	}
+	return requireAffected(result, "update pipeline run")
+}
+
+// ListRuns 조건에 맞는 파이프라인 실행 조회 (최신순)
+func (ps *pipelineStorage) ListRuns(ctx context.Context, filter *models.PipelineRunFilter) ([]*models.PipelineRun, error) {
+	if filter == nil {
+		filter = &models.PipelineRunFilter{}
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	if filter.OwnerID != "" {
+		conditions = append(conditions, "owner_id = ?")
+		args = append(args, filter.OwnerID)
+	}
+	if filter.PipelineID != "" {
+		conditions = append(conditions, "pipeline_id = ?")
+		args = append(args, filter.PipelineID)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+
+	clause := ""
+	if len(conditions) > 0 {
+		clause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	clause += ` ORDER BY created_at DESC, id DESC`
+	if filter.Limit > 0 {
+		clause += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+	return ps.queryRuns(ctx, clause, args...)
+}
+
+// queryPipelines 조건에 맞는 파이프라인 조회
+func (ps *pipelineStorage) queryPipelines(ctx context.Context, clause string, args ...interface{}) ([]*models.Pipeline, error) {
+	rows, err := ps.storage.queryContext(ctx, selectPipelineQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list pipelines", "sqlite")
+	}
+	defer rows.Close()
+
+	pipelines := []*models.Pipeline{}
+	for rows.Next() {
+		var (
+			pipeline    models.Pipeline
+			description sql.NullString
+			steps       string
+		)
+		if err := rows.Scan(&pipeline.ID, &pipeline.OwnerID, &pipeline.Name, &description, &steps, &pipeline.CreatedAt, &pipeline.UpdatedAt); err != nil {
+			return nil, storage.ConvertError(err, "scan pipeline", "sqlite")
+		}
+		pipeline.Description = description.String
+		if err := json.Unmarshal([]byte(steps), &pipeline.Steps); err != nil {
+			return nil, storage.ConvertError(err, "scan pipeline", "sqlite")
+		}
+		pipelines = append(pipelines, &pipeline)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list pipelines", "sqlite")
+	}
+	return pipelines, nil
+}
+
+// queryRuns 조건에 맞는 파이프라인 실행 조회
+func (ps *pipelineStorage) queryRuns(ctx context.Context, clause string, args ...interface{}) ([]*models.PipelineRun, error) {
+	rows, err := ps.storage.queryContext(ctx, selectPipelineRunQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list pipeline runs", "sqlite")
+	}
+	defer rows.Close()
+
+	runs := []*models.PipelineRun{}
+	for rows.Next() {
+		var (
+			run             models.PipelineRun
+			steps, stepRuns string
+			runErr          sql.NullString
+			completedAt     sql.NullTime
+		)
+		err := rows.Scan(
+			&run.ID,
+			&run.PipelineID,
+			&run.PipelineName,
+			&run.OwnerID,
+			&run.SessionID,
+			&run.Status,
+			&run.CurrentStep,
+			&steps,
+			&stepRuns,
+			&runErr,
+			&run.CreatedAt,
+			&run.UpdatedAt,
+			&completedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan pipeline run", "sqlite")
+		}
+		run.Error = runErr.String
+		if completedAt.Valid {
+			run.CompletedAt = &completedAt.Time
+		}
+		if err := json.Unmarshal([]byte(steps), &run.Steps); err != nil {
+			return nil, storage.ConvertError(err, "scan pipeline run", "sqlite")
+		}
+		if err := json.Unmarshal([]byte(stepRuns), &run.StepRuns); err != nil {
+			return nil, storage.ConvertError(err, "scan pipeline run", "sqlite")
+		}
+		runs = append(runs, &run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list pipeline runs", "sqlite")
+	}
+	return runs, nil
+}
+
+// marshalPipelineRun 단계 정의와 실행 기록을 JSON 문자열로 변환
+func marshalPipelineRun(run *models.PipelineRun) (string, string, error) {
+	steps, err := marshalPipelineJSON(run.Steps)
+	if err != nil {
+		return "", "", err
+	}
+	stepRuns, err := marshalPipelineJSON(run.StepRuns)
+	if err != nil {
+		return "", "", err
+	}
+	return steps, stepRuns, nil
+}
+
+// marshalPipelineJSON 슬라이스를 JSON 문자열로 변환 (nil이면 빈 배열)
+func marshalPipelineJSON[T any](values []T) (string, error) {
+	if values == nil {
+		values = []T{}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// requireAffected 변경된 행이 없으면 ErrNotFound
+func requireAffected(result sql.Result, operation string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, operation, "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// privacyRequestStorage 개인정보 처리 기록 SQLite 구현 (021_privacy_requests.sql)
+type privacyRequestStorage struct {
+	storage *Storage
+}
+
+// newPrivacyRequestStorage 새 개인정보 처리 기록 스토리지 생성
+func newPrivacyRequestStorage(s *Storage) *privacyRequestStorage {
+	return &privacyRequestStorage{storage: s}
+}
+
+// Create 처리 기록 추가
+func (ps *privacyRequestStorage) Create(ctx context.Context, request *models.PrivacyRequest) error {
+	if request.ID == "" {
+		request.ID = uuid.New().String()
+	}
+	if request.CreatedAt.IsZero() {
+		request.CreatedAt = time.Now()
+	}
+
+	var summary sql.NullString
+	if len(request.Summary) > 0 {
+		data, err := json.Marshal(request.Summary)
+		if err != nil {
+			return storage.ConvertError(err, "create privacy request", "sqlite")
+		}
+		summary = sql.NullString{String: string(data), Valid: true}
+	}
+
+	_, err := ps.storage.execContext(ctx, `
+		INSERT INTO privacy_requests (id, type, subject_id, actor_id, summary, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
+		request.ID,
+		request.Type,
+		request.SubjectID,
+		request.ActorID,
+		summary,
+		request.CreatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create privacy request", "sqlite")
+	}
+	return nil
+}
+
+// List 조건에 맞는 처리 기록 조회 (최신순)
+func (ps *privacyRequestStorage) List(ctx context.Context, filter *models.PrivacyRequestFilter) ([]*models.PrivacyRequest, error) {
+	if filter == nil {
+		filter = &models.PrivacyRequestFilter{}
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	if filter.SubjectID != "" {
+		conditions = append(conditions, "subject_id = ?")
+		args = append(args, filter.SubjectID)
+	}
+	if filter.Type != "" {
+		conditions = append(conditions, "type = ?")
+		args = append(args, filter.Type)
+	}
+
+	query := `SELECT id, type, subject_id, actor_id, summary, created_at FROM privacy_requests`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at DESC, id DESC"
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	rows, err := ps.storage.queryContext(ctx, query, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list privacy requests", "sqlite")
+	}
+	defer rows.Close()
+
+	requests := []*models.PrivacyRequest{}
+	for rows.Next() {
+		var (
+			request models.PrivacyRequest
+			summary sql.NullString
+		)
+		if err := rows.Scan(&request.ID, &request.Type, &request.SubjectID, &request.ActorID, &summary, &request.CreatedAt); err != nil {
+			return nil, storage.ConvertError(err, "scan privacy request", "sqlite")
+		}
+		if summary.Valid && summary.String != "" {
+			if err := json.Unmarshal([]byte(summary.String), &request.Summary); err != nil {
+				return nil, storage.ConvertError(err, "decode privacy request summary", "sqlite")
+			}
+		}
+		requests = append(requests, &request)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list privacy requests", "sqlite")
+	}
+	return requests, nil
+}
@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// processRecordStorage 프로세스 기록 SQLite 구현 (006_process_records.sql)
+type processRecordStorage struct {
+	storage *Storage
+}
+
+// newProcessRecordStorage 새 프로세스 기록 스토리지 생성
+func newProcessRecordStorage(s *Storage) *processRecordStorage {
+	return &processRecordStorage{storage: s}
+}
+
+// Save 프로세스 기록 저장 (같은 인스턴스와 PID의 기록은 덮어씀)
+func (ps *processRecordStorage) Save(ctx context.Context, record *models.ProcessRecord) error {
+	args, err := json.Marshal(record.Args)
+	if err != nil {
+		return err
+	}
+
+	_, err = ps.storage.execContext(ctx, `
+		INSERT OR REPLACE INTO process_records (instance_id, pid, fingerprint, command, args, working_dir, started_at, adopted_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.InstanceID,
+		record.PID,
+		record.Fingerprint,
+		record.Command,
+		string(args),
+		record.WorkingDir,
+		record.StartedAt,
+		record.AdoptedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "save process record", "sqlite")
+	}
+	return nil
+}
+
+// Delete 프로세스 기록 삭제
+func (ps *processRecordStorage) Delete(ctx context.Context, instanceID string, pid int) error {
+	result, err := ps.storage.execContext(ctx, `DELETE FROM process_records WHERE instance_id = ? AND pid = ?`, instanceID, pid)
+	if err != nil {
+		return storage.ConvertError(err, "delete process record", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "delete process record", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// ListByInstance 인스턴스의 프로세스 기록 조회 (시작 시각순)
+func (ps *processRecordStorage) ListByInstance(ctx context.Context, instanceID string) ([]*models.ProcessRecord, error) {
+	rows, err := ps.storage.queryContext(ctx, `
+		SELECT instance_id, pid, fingerprint, command, args, working_dir, started_at, adopted_at
+		FROM process_records WHERE instance_id = ?
+		ORDER BY started_at, pid`, instanceID)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list process records", "sqlite")
+	}
+	defer rows.Close()
+
+	records := []*models.ProcessRecord{}
+	for rows.Next() {
+		var (
+			record     models.ProcessRecord
+			args       sql.NullString
+			workingDir sql.NullString
+			adoptedAt  sql.NullTime
+		)
+		err := rows.Scan(&record.InstanceID, &record.PID, &record.Fingerprint, &record.Command, &args, &workingDir, &record.StartedAt, &adoptedAt)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan process record", "sqlite")
+		}
+		if args.Valid && args.String != "" {
+			if err := json.Unmarshal([]byte(args.String), &record.Args); err != nil {
+				return nil, err
+			}
+		}
+		record.WorkingDir = workingDir.String
+		if adoptedAt.Valid {
+			record.AdoptedAt = &adoptedAt.Time
+		}
+		records = append(records, &record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list process records", "sqlite")
+	}
+	return records, nil
+}
+
+// ListInstances 프로세스 기록이 남아 있는 인스턴스 ID 목록
+func (ps *processRecordStorage) ListInstances(ctx context.Context) ([]string, error) {
+	rows, err := ps.storage.queryContext(ctx, `SELECT DISTINCT instance_id FROM process_records ORDER BY instance_id`)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list process record instances", "sqlite")
+	}
+	defer rows.Close()
+
+	instances := []string{}
+	for rows.Next() {
+		var instanceID string
+		if err := rows.Scan(&instanceID); err != nil {
+			return nil, storage.ConvertError(err, "scan process record instance", "sqlite")
+		}
+		instances = append(instances, instanceID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list process record instances", "sqlite")
+	}
+	return instances, nil
+}
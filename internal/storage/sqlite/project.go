@@ -10,6 +10,7 @@ import (
 
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
 )
 
 // projectStorage 프로젝트 스토리지 SQLite 구현
@@ -38,7 +39,7 @@ const (
 	`
 	
 	// 프로젝트 업데이트 쿼리 베이스
-	updateProjectQueryBase = `UPDATE projects SET updated_at = ? `
+	updateProjectQueryBase = `UPDATE projects SET `
 	
 	// 프로젝트 삭제 쿼리 (soft delete)
 	deleteProjectQuery = `
@@ -138,15 +139,15 @@ func (p *projectStorage) GetByWorkspaceID(ctx context.Context, workspaceID strin
 		return nil, 0, storage.ConvertError(err, "count projects by workspace", "sqlite")
 	}
 	
-	// 데이터 조회
-	query := selectProjectQuery + `
-		WHERE workspace_id = ? AND deleted_at IS NULL 
-		ORDER BY ` + pagination.Sort + ` ` + pagination.Order + `
-		LIMIT ? OFFSET ?
-	`
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(pagination, models.ProjectSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectProjectQuery + page.AppendWhere(` WHERE workspace_id = ? AND deleted_at IS NULL`) + page.Tail
+	args := append(append([]interface{}{workspaceID}, page.WhereArgs...), page.TailArgs...)
 	
-	rows, err := p.storage.queryContext(ctx, query, 
-		workspaceID, pagination.Limit, pagination.GetOffset())
+	rows, err := p.storage.queryContext(ctx, query, args...)
 	if err != nil {
 		return nil, 0, storage.ConvertError(err, "get projects by workspace", "sqlite")
 	}
@@ -168,6 +169,7 @@ func (p *projectStorage) Update(ctx context.Context, id string, updates map[stri
 	
 	// 허용된 업데이트 필드들
 	allowedFields := map[string]bool{
+		"workspace_id": true,
 		"name":         true,
 		"path":         true,
 		"description":  true,
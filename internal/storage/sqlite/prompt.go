@@ -0,0 +1,257 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// defaultPromptLimit 조회 개수를 지정하지 않았을 때 반환할 프롬프트 수
+const defaultPromptLimit = 100
+
+// promptStorage 프롬프트 라이브러리 SQLite 구현 (012_prompts.sql)
+type promptStorage struct {
+	storage *Storage
+}
+
+// newPromptStorage 새 프롬프트 스토리지 생성
+func newPromptStorage(s *Storage) *promptStorage {
+	return &promptStorage{storage: s}
+}
+
+const (
+	// 프롬프트 조회 쿼리
+	selectPromptQuery = `
+		SELECT id, owner_id, name, description, content, variables, tags, visibility, created_at, updated_at
+		FROM prompts
+	`
+
+	// 프롬프트 삽입 쿼리
+	insertPromptQuery = `
+		INSERT INTO prompts (id, owner_id, name, description, content, variables, tags, visibility, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 프롬프트 수정 쿼리
+	updatePromptQuery = `
+		UPDATE prompts SET name = ?, description = ?, content = ?, variables = ?, tags = ?, visibility = ?, updated_at = ?
+		WHERE id = ?
+	`
+)
+
+// Create 새 프롬프트 생성
+func (ps *promptStorage) Create(ctx context.Context, prompt *models.Prompt) error {
+	if prompt.ID == "" {
+		prompt.ID = uuid.New().String()
+	}
+	if prompt.CreatedAt.IsZero() {
+		prompt.CreatedAt = time.Now()
+	}
+	prompt.UpdatedAt = prompt.CreatedAt
+
+	variables, tags, err := marshalPromptLists(prompt)
+	if err != nil {
+		return storage.ConvertError(err, "create prompt", "sqlite")
+	}
+	_, err = ps.storage.execContext(ctx, insertPromptQuery,
+		prompt.ID,
+		prompt.OwnerID,
+		prompt.Name,
+		prompt.Description,
+		prompt.Content,
+		variables,
+		tags,
+		prompt.Visibility,
+		prompt.CreatedAt,
+		prompt.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create prompt", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 프롬프트 조회
+func (ps *promptStorage) GetByID(ctx context.Context, id string) (*models.Prompt, error) {
+	prompts, err := ps.query(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(prompts) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return prompts[0], nil
+}
+
+// Update 프롬프트 저장
+func (ps *promptStorage) Update(ctx context.Context, prompt *models.Prompt) error {
+	prompt.UpdatedAt = time.Now()
+
+	variables, tags, err := marshalPromptLists(prompt)
+	if err != nil {
+		return storage.ConvertError(err, "update prompt", "sqlite")
+	}
+	result, err := ps.storage.execContext(ctx, updatePromptQuery,
+		prompt.Name,
+		prompt.Description,
+		prompt.Content,
+		variables,
+		tags,
+		prompt.Visibility,
+		prompt.UpdatedAt,
+		prompt.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update prompt", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "update prompt", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// Delete 프롬프트 삭제
+func (ps *promptStorage) Delete(ctx context.Context, id string) error {
+	result, err := ps.storage.execContext(ctx, `DELETE FROM prompts WHERE id = ?`, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete prompt", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "delete prompt", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// List 조건에 맞는 프롬프트 조회 (이름순)
+func (ps *promptStorage) List(ctx context.Context, filter *models.PromptFilter) ([]*models.Prompt, error) {
+	if filter == nil {
+		filter = &models.PromptFilter{}
+	}
+
+	conditions := []string{}
+	args := []interface{}{}
+	if filter.ViewerID != "" {
+		switch filter.Scope {
+		case "mine":
+			conditions = append(conditions, "owner_id = ?")
+			args = append(args, filter.ViewerID)
+		case "shared":
+			conditions = append(conditions, "owner_id != ? AND visibility = ?")
+			args = append(args, filter.ViewerID, models.PromptVisibilityOrg)
+		default:
+			conditions = append(conditions, "(owner_id = ? OR visibility = ?)")
+			args = append(args, filter.ViewerID, models.PromptVisibilityOrg)
+		}
+	}
+	if filter.Tag != "" {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM json_each(prompts.tags) WHERE json_each.value = ?)")
+		args = append(args, filter.Tag)
+	}
+	if filter.Search != "" {
+		conditions = append(conditions, `(LOWER(name) LIKE ? ESCAPE '\' OR LOWER(description) LIKE ? ESCAPE '\')`)
+		pattern := "%" + escapeLike(strings.ToLower(filter.Search)) + "%"
+		args = append(args, pattern, pattern)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultPromptLimit
+	}
+	args = append(args, limit)
+
+	return ps.query(ctx, where+` ORDER BY name, id LIMIT ?`, args...)
+}
+
+// query 조건에 맞는 프롬프트 조회
+func (ps *promptStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.Prompt, error) {
+	rows, err := ps.storage.queryContext(ctx, selectPromptQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list prompts", "sqlite")
+	}
+	defer rows.Close()
+
+	prompts := []*models.Prompt{}
+	for rows.Next() {
+		prompt, err := scanPrompt(rows)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan prompt", "sqlite")
+		}
+		prompts = append(prompts, prompt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list prompts", "sqlite")
+	}
+	return prompts, nil
+}
+
+// marshalPromptLists 변수와 태그를 JSON 문자열로 변환
+func marshalPromptLists(prompt *models.Prompt) (string, string, error) {
+	variables := prompt.Variables
+	if variables == nil {
+		variables = []models.PromptVariable{}
+	}
+	tags := prompt.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	variablesJSON, err := json.Marshal(variables)
+	if err != nil {
+		return "", "", err
+	}
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		return "", "", err
+	}
+	return string(variablesJSON), string(tagsJSON), nil
+}
+
+// scanPrompt 조회 결과 행을 프롬프트로 변환
+func scanPrompt(rows *sql.Rows) (*models.Prompt, error) {
+	var (
+		prompt          models.Prompt
+		description     sql.NullString
+		variables, tags string
+	)
+	err := rows.Scan(
+		&prompt.ID,
+		&prompt.OwnerID,
+		&prompt.Name,
+		&description,
+		&prompt.Content,
+		&variables,
+		&tags,
+		&prompt.Visibility,
+		&prompt.CreatedAt,
+		&prompt.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	prompt.Description = description.String
+	if err := json.Unmarshal([]byte(variables), &prompt.Variables); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(tags), &prompt.Tags); err != nil {
+		return nil, err
+	}
+	return &prompt, nil
+}
@@ -0,0 +1,293 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// pullRequestStorage PR 설정과 PR 기록 SQLite 구현 (017_pull_requests.sql)
+type pullRequestStorage struct {
+	storage *Storage
+}
+
+// newPullRequestStorage 새 PR 스토리지 생성
+func newPullRequestStorage(s *Storage) *pullRequestStorage {
+	return &pullRequestStorage{storage: s}
+}
+
+const (
+	// PR 설정 조회 쿼리
+	selectPullRequestConfigQuery = `
+		SELECT workspace_id, provider, repository, base_branch, branch_prefix, api_url, token, draft, labels,
+		       updated_by, created_at, updated_at
+		FROM workspace_pr_configs
+		WHERE workspace_id = ?
+	`
+
+	// PR 설정 저장 쿼리 (생성 시각은 유지)
+	upsertPullRequestConfigQuery = `
+		INSERT INTO workspace_pr_configs (workspace_id, provider, repository, base_branch, branch_prefix, api_url, token,
+		                                  draft, labels, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (workspace_id) DO UPDATE SET
+			provider = excluded.provider,
+			repository = excluded.repository,
+			base_branch = excluded.base_branch,
+			branch_prefix = excluded.branch_prefix,
+			api_url = excluded.api_url,
+			token = excluded.token,
+			draft = excluded.draft,
+			labels = excluded.labels,
+			updated_by = excluded.updated_by,
+			updated_at = excluded.updated_at
+	`
+
+	// PR 기록 조회 쿼리
+	selectPullRequestQuery = `
+		SELECT id, task_id, session_id, workspace_id, provider, repository, branch, base_branch, commit_sha, status,
+		       number, url, title, error, transcript_url, created_by, created_at, updated_at
+		FROM pull_requests
+	`
+)
+
+// GetConfig 워크스페이스의 PR 설정 조회
+func (ps *pullRequestStorage) GetConfig(ctx context.Context, workspaceID string) (*models.PullRequestConfig, error) {
+	var (
+		config        models.PullRequestConfig
+		apiURL, token sql.NullString
+		labels        string
+	)
+	err := ps.storage.queryRowContext(ctx, selectPullRequestConfigQuery, workspaceID).Scan(
+		&config.WorkspaceID,
+		&config.Provider,
+		&config.Repository,
+		&config.BaseBranch,
+		&config.BranchPrefix,
+		&apiURL,
+		&token,
+		&config.Draft,
+		&labels,
+		&config.UpdatedBy,
+		&config.CreatedAt,
+		&config.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, "get pull request config", "sqlite")
+	}
+	config.APIURL = apiURL.String
+	config.Token = token.String
+	if err := json.Unmarshal([]byte(labels), &config.Labels); err != nil {
+		return nil, storage.ConvertError(err, "get pull request config", "sqlite")
+	}
+	return &config, nil
+}
+
+// SaveConfig PR 설정 저장
+func (ps *pullRequestStorage) SaveConfig(ctx context.Context, config *models.PullRequestConfig) error {
+	now := time.Now()
+	if config.CreatedAt.IsZero() {
+		config.CreatedAt = now
+	}
+	config.UpdatedAt = now
+
+	labels, err := marshalPipelineJSON(config.Labels)
+	if err != nil {
+		return storage.ConvertError(err, "save pull request config", "sqlite")
+	}
+	_, err = ps.storage.execContext(ctx, upsertPullRequestConfigQuery,
+		config.WorkspaceID,
+		config.Provider,
+		config.Repository,
+		config.BaseBranch,
+		config.BranchPrefix,
+		config.APIURL,
+		config.Token,
+		config.Draft,
+		labels,
+		config.UpdatedBy,
+		config.CreatedAt,
+		config.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "save pull request config", "sqlite")
+	}
+	return nil
+}
+
+// DeleteConfig PR 설정 삭제
+func (ps *pullRequestStorage) DeleteConfig(ctx context.Context, workspaceID string) error {
+	result, err := ps.storage.execContext(ctx, `DELETE FROM workspace_pr_configs WHERE workspace_id = ?`, workspaceID)
+	if err != nil {
+		return storage.ConvertError(err, "delete pull request config", "sqlite")
+	}
+	return requireAffected(result, "delete pull request config")
+}
+
+// ListConfigWorkspaces PR 설정이 있는 워크스페이스 ID 목록
+func (ps *pullRequestStorage) ListConfigWorkspaces(ctx context.Context) ([]string, error) {
+	rows, err := ps.storage.queryContext(ctx, `SELECT workspace_id FROM workspace_pr_configs ORDER BY workspace_id`)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list pull request configs", "sqlite")
+	}
+	defer rows.Close()
+
+	workspaceIDs := []string{}
+	for rows.Next() {
+		var workspaceID string
+		if err := rows.Scan(&workspaceID); err != nil {
+			return nil, storage.ConvertError(err, "scan pull request config", "sqlite")
+		}
+		workspaceIDs = append(workspaceIDs, workspaceID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list pull request configs", "sqlite")
+	}
+	return workspaceIDs, nil
+}
+
+// Create 새 PR 기록 생성
+func (ps *pullRequestStorage) Create(ctx context.Context, pr *models.PullRequest) error {
+	if pr.ID == "" {
+		pr.ID = uuid.New().String()
+	}
+	if pr.CreatedAt.IsZero() {
+		pr.CreatedAt = time.Now()
+	}
+	pr.UpdatedAt = pr.CreatedAt
+
+	_, err := ps.storage.execContext(ctx, `
+		INSERT INTO pull_requests (id, task_id, session_id, workspace_id, provider, repository, branch, base_branch,
+		                           commit_sha, status, number, url, title, error, transcript_url, created_by,
+		                           created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		pr.ID,
+		pr.TaskID,
+		pr.SessionID,
+		pr.WorkspaceID,
+		pr.Provider,
+		pr.Repository,
+		pr.Branch,
+		pr.BaseBranch,
+		pr.CommitSHA,
+		pr.Status,
+		pr.Number,
+		pr.URL,
+		pr.Title,
+		pr.Error,
+		pr.TranscriptURL,
+		pr.CreatedBy,
+		pr.CreatedAt,
+		pr.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create pull request", "sqlite")
+	}
+	return nil
+}
+
+// Update PR 기록 저장
+func (ps *pullRequestStorage) Update(ctx context.Context, pr *models.PullRequest) error {
+	pr.UpdatedAt = time.Now()
+
+	result, err := ps.storage.execContext(ctx, `
+		UPDATE pull_requests SET repository = ?, branch = ?, base_branch = ?, commit_sha = ?, status = ?, number = ?,
+		                         url = ?, title = ?, error = ?, transcript_url = ?, created_by = ?, updated_at = ?
+		WHERE id = ?`,
+		pr.Repository,
+		pr.Branch,
+		pr.BaseBranch,
+		pr.CommitSHA,
+		pr.Status,
+		pr.Number,
+		pr.URL,
+		pr.Title,
+		pr.Error,
+		pr.TranscriptURL,
+		pr.CreatedBy,
+		pr.UpdatedAt,
+		pr.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update pull request", "sqlite")
+	}
+	return requireAffected(result, "update pull request")
+}
+
+// GetByTaskID 태스크의 PR 조회
+func (ps *pullRequestStorage) GetByTaskID(ctx context.Context, taskID string) (*models.PullRequest, error) {
+	prs, err := ps.query(ctx, `WHERE task_id = ?`, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if len(prs) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return prs[0], nil
+}
+
+// ListByWorkspace 워크스페이스의 PR 조회 (최신순)
+func (ps *pullRequestStorage) ListByWorkspace(ctx context.Context, workspaceID string, limit int) ([]*models.PullRequest, error) {
+	if limit <= 0 {
+		return ps.query(ctx, `WHERE workspace_id = ? ORDER BY created_at DESC, id DESC`, workspaceID)
+	}
+	return ps.query(ctx, `WHERE workspace_id = ? ORDER BY created_at DESC, id DESC LIMIT ?`, workspaceID, limit)
+}
+
+// query 조건에 맞는 PR 기록 조회
+func (ps *pullRequestStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.PullRequest, error) {
+	rows, err := ps.storage.queryContext(ctx, selectPullRequestQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list pull requests", "sqlite")
+	}
+	defer rows.Close()
+
+	prs := []*models.PullRequest{}
+	for rows.Next() {
+		var (
+			pr                                    models.PullRequest
+			commitSHA, url, errMsg, transcriptURL sql.NullString
+		)
+		err := rows.Scan(
+			&pr.ID,
+			&pr.TaskID,
+			&pr.SessionID,
+			&pr.WorkspaceID,
+			&pr.Provider,
+			&pr.Repository,
+			&pr.Branch,
+			&pr.BaseBranch,
+			&commitSHA,
+			&pr.Status,
+			&pr.Number,
+			&url,
+			&pr.Title,
+			&errMsg,
+			&transcriptURL,
+			&pr.CreatedBy,
+			&pr.CreatedAt,
+			&pr.UpdatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan pull request", "sqlite")
+		}
+		pr.CommitSHA = commitSHA.String
+		pr.URL = url.String
+		pr.Error = errMsg.String
+		pr.TranscriptURL = transcriptURL.String
+		prs = append(prs, &pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list pull requests", "sqlite")
+	}
+	return prs, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
 )
 
 // sessionStorage 세션 스토리지 SQLite 구현
@@ -237,12 +238,13 @@ func (s *sessionStorage) List(ctx context.Context, filter *models.SessionFilter,
 		return nil, storage.ConvertError(err, "count sessions", "sqlite")
 	}
 	
-	// 데이터 조회
-	query := selectSessionQuery + whereClause + `
-		ORDER BY ` + paging.Sort + ` ` + paging.Order + `
-		LIMIT ? OFFSET ?
-	`
-	queryArgs := append(args, paging.Limit, paging.GetOffset())
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(paging, models.SessionSortFields)
+	if err != nil {
+		return nil, err
+	}
+	query := selectSessionQuery + page.AppendWhere(whereClause) + page.Tail
+	queryArgs := append(append(args, page.WhereArgs...), page.TailArgs...)
 	
 	rows, err := s.storage.queryContext(ctx, query, queryArgs...)
 	if err != nil {
@@ -261,16 +263,21 @@ func (s *sessionStorage) List(ctx context.Context, filter *models.SessionFilter,
 		totalPages++
 	}
 	
+	meta := models.PaginationMeta{
+		CurrentPage: paging.Page,
+		PerPage:     paging.Limit,
+		Total:       total,
+		TotalPages:  totalPages,
+		HasNext:     paging.Page < totalPages,
+		HasPrev:     paging.Page > 1,
+	}
+	if n := len(sessions); n > 0 {
+		meta.NextCursor = paging.NextCursorFor(n, sessions[n-1].ID, sessions[n-1].SortValue(paging.Sort))
+	}
+	
 	return &models.PaginationResponse{
 		Data: sessions,
-		Meta: models.PaginationMeta{
-			CurrentPage: paging.Page,
-			PerPage:     paging.Limit,
-			Total:       total,
-			TotalPages:  totalPages,
-			HasNext:     paging.Page < totalPages,
-			HasPrev:     paging.Page > 1,
-		},
+		Meta: meta,
 	}, nil
 }
 
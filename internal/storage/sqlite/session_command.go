@@ -0,0 +1,146 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// sessionCommandStorage 세션 셸 명령 실행 기록 SQLite 구현 (023_session_commands.sql)
+type sessionCommandStorage struct {
+	storage *Storage
+}
+
+// newSessionCommandStorage 새 명령 실행 기록 스토리지 생성
+func newSessionCommandStorage(s *Storage) *sessionCommandStorage {
+	return &sessionCommandStorage{storage: s}
+}
+
+const (
+	// 실행 기록 추가 쿼리
+	insertSessionCommandQuery = `
+		INSERT INTO session_commands (id, session_id, workspace_id, task_id, tool_use_id, command, description,
+		                              working_dir, exit_code, is_error, output, output_bytes, output_truncated, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 실행 기록 조회 쿼리 (같은 시각이면 추가 순서)
+	selectSessionCommandQuery = `
+		SELECT id, session_id, workspace_id, task_id, tool_use_id, command, description, working_dir,
+		       exit_code, is_error, output, output_bytes, output_truncated, created_at
+		FROM session_commands
+		WHERE session_id = ?
+		ORDER BY created_at ASC, rowid ASC
+		LIMIT ? OFFSET ?
+	`
+)
+
+// CreateBatch 실행 순서대로 기록 추가
+func (cs *sessionCommandStorage) CreateBatch(ctx context.Context, commands []*models.SessionCommand) error {
+	tx, err := cs.storage.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storage.ConvertError(err, "begin create session commands", "sqlite")
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	for _, command := range commands {
+		if command.ID == "" {
+			command.ID = uuid.New().String()
+		}
+		if command.CreatedAt.IsZero() {
+			command.CreatedAt = now
+		}
+
+		var exitCode sql.NullInt64
+		if command.ExitCode != nil {
+			exitCode = sql.NullInt64{Int64: int64(*command.ExitCode), Valid: true}
+		}
+
+		_, err := tx.ExecContext(ctx, insertSessionCommandQuery,
+			command.ID,
+			command.SessionID,
+			command.WorkspaceID,
+			command.TaskID,
+			command.ToolUseID,
+			command.Command,
+			command.Description,
+			command.WorkingDir,
+			exitCode,
+			command.IsError,
+			command.Output,
+			command.OutputBytes,
+			command.OutputTruncated,
+			command.CreatedAt,
+		)
+		if err != nil {
+			return storage.ConvertError(err, "insert session command", "sqlite")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return storage.ConvertError(err, "commit session commands", "sqlite")
+	}
+	return nil
+}
+
+// ListBySession 세션의 실행 기록을 실행 순서대로 조회
+func (cs *sessionCommandStorage) ListBySession(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionCommand, int, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	var total int
+	err := cs.storage.queryRowContext(ctx, `SELECT COUNT(*) FROM session_commands WHERE session_id = ?`, sessionID).Scan(&total)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "count session commands", "sqlite")
+	}
+
+	rows, err := cs.storage.queryContext(ctx, selectSessionCommandQuery, sessionID, paging.Limit, paging.GetOffset())
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "list session commands", "sqlite")
+	}
+	defer rows.Close()
+
+	commands := make([]*models.SessionCommand, 0, paging.Limit)
+	for rows.Next() {
+		var (
+			command  models.SessionCommand
+			exitCode sql.NullInt64
+		)
+		err := rows.Scan(
+			&command.ID,
+			&command.SessionID,
+			&command.WorkspaceID,
+			&command.TaskID,
+			&command.ToolUseID,
+			&command.Command,
+			&command.Description,
+			&command.WorkingDir,
+			&exitCode,
+			&command.IsError,
+			&command.Output,
+			&command.OutputBytes,
+			&command.OutputTruncated,
+			&command.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, storage.ConvertError(err, "scan session command", "sqlite")
+		}
+		if exitCode.Valid {
+			code := int(exitCode.Int64)
+			command.ExitCode = &code
+		}
+		commands = append(commands, &command)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, storage.ConvertError(err, "list session commands", "sqlite")
+	}
+	return commands, total, nil
+}
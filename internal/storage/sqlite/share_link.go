@@ -0,0 +1,293 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// shareLinkStorage 세션 공유 링크 SQLite 구현 (005_session_share_links.sql)
+type shareLinkStorage struct {
+	storage *Storage
+}
+
+// newShareLinkStorage 새 공유 링크 스토리지 생성
+func newShareLinkStorage(s *Storage) *shareLinkStorage {
+	return &shareLinkStorage{storage: s}
+}
+
+const (
+	// 공유 링크 조회 쿼리
+	selectShareLinkQuery = `
+		SELECT id, session_id, workspace_id, created_by, token_hash, scope, expires_at, revoked_at, join_count, created_at
+		FROM session_share_links
+	`
+
+	// 공유 링크 삽입 쿼리
+	insertShareLinkQuery = `
+		INSERT INTO session_share_links (id, session_id, workspace_id, created_by, token_hash, scope, expires_at, join_count, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, 0, ?)
+	`
+
+	// 참여 기록 삽입 쿼리
+	insertShareJoinQuery = `
+		INSERT INTO session_share_joins (id, link_id, session_id, user_id, user_name, channel, client_ip, user_agent, joined_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+)
+
+// Create 새 공유 링크 생성
+func (ss *shareLinkStorage) Create(ctx context.Context, link *models.SessionShareLink) error {
+	if link.ID == "" {
+		link.ID = uuid.New().String()
+	}
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = time.Now()
+	}
+
+	_, err := ss.storage.execContext(ctx, insertShareLinkQuery,
+		link.ID,
+		link.SessionID,
+		link.WorkspaceID,
+		link.CreatedBy,
+		link.TokenHash,
+		link.Scope,
+		link.ExpiresAt,
+		link.CreatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create share link", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 공유 링크 조회
+func (ss *shareLinkStorage) GetByID(ctx context.Context, id string) (*models.SessionShareLink, error) {
+	return ss.getOne(ctx, `WHERE id = ?`, id)
+}
+
+// GetByTokenHash 토큰 해시로 공유 링크 조회
+func (ss *shareLinkStorage) GetByTokenHash(ctx context.Context, tokenHash string) (*models.SessionShareLink, error) {
+	return ss.getOne(ctx, `WHERE token_hash = ?`, tokenHash)
+}
+
+// ListBySession 세션의 공유 링크 목록 조회 (최신순)
+func (ss *shareLinkStorage) ListBySession(ctx context.Context, sessionID string) ([]*models.SessionShareLink, error) {
+	rows, err := ss.storage.queryContext(ctx, selectShareLinkQuery+` WHERE session_id = ? ORDER BY created_at DESC`, sessionID)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list share links", "sqlite")
+	}
+	defer rows.Close()
+
+	links := []*models.SessionShareLink{}
+	for rows.Next() {
+		link, err := scanShareLink(rows)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan share link", "sqlite")
+		}
+		links = append(links, link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list share links", "sqlite")
+	}
+	return links, nil
+}
+
+// Revoke 공유 링크 폐기 (이미 폐기된 링크는 최초 폐기 시각 유지)
+func (ss *shareLinkStorage) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	result, err := ss.storage.execContext(ctx,
+		`UPDATE session_share_links SET revoked_at = COALESCE(revoked_at, ?) WHERE id = ?`, revokedAt, id)
+	if err != nil {
+		return storage.ConvertError(err, "revoke share link", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "revoke share link", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// RecordJoin 공유 링크 참여 기록 추가 (링크 참여 횟수 증가)
+func (ss *shareLinkStorage) RecordJoin(ctx context.Context, join *models.SessionShareJoin) error {
+	if join.ID == "" {
+		join.ID = uuid.New().String()
+	}
+	if join.JoinedAt.IsZero() {
+		join.JoinedAt = time.Now()
+	}
+
+	tx, err := ss.storage.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storage.ConvertError(err, "begin share join", "sqlite")
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE session_share_links SET join_count = join_count + 1 WHERE id = ?`, join.LinkID)
+	if err != nil {
+		return storage.ConvertError(err, "update share link join count", "sqlite")
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, insertShareJoinQuery,
+		join.ID,
+		join.LinkID,
+		join.SessionID,
+		join.UserID,
+		join.UserName,
+		join.Channel,
+		join.ClientIP,
+		join.UserAgent,
+		join.JoinedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "record share join", "sqlite")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return storage.ConvertError(err, "commit share join", "sqlite")
+	}
+	return nil
+}
+
+// ListJoins 세션의 공유 링크 참여 기록 조회 (최신순)
+func (ss *shareLinkStorage) ListJoins(ctx context.Context, sessionID string, paging *models.PaginationRequest) ([]*models.SessionShareJoin, int, error) {
+	if paging == nil {
+		paging = &models.PaginationRequest{}
+	}
+	paging.Normalize()
+
+	var total int
+	err := ss.storage.queryRowContext(ctx, `SELECT COUNT(*) FROM session_share_joins WHERE session_id = ?`, sessionID).Scan(&total)
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "count share joins", "sqlite")
+	}
+
+	rows, err := ss.storage.queryContext(ctx, `
+		SELECT id, link_id, session_id, user_id, user_name, channel, client_ip, user_agent, joined_at
+		FROM session_share_joins WHERE session_id = ?
+		ORDER BY joined_at DESC, id DESC LIMIT ? OFFSET ?`,
+		sessionID, paging.Limit, paging.GetOffset())
+	if err != nil {
+		return nil, 0, storage.ConvertError(err, "list share joins", "sqlite")
+	}
+	defer rows.Close()
+
+	joins, err := scanShareJoins(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return joins, total, nil
+}
+
+// ListJoinsByUser 사용자의 공유 링크 참여 기록 조회 (최신순)
+func (ss *shareLinkStorage) ListJoinsByUser(ctx context.Context, userID string) ([]*models.SessionShareJoin, error) {
+	rows, err := ss.storage.queryContext(ctx, `
+		SELECT id, link_id, session_id, user_id, user_name, channel, client_ip, user_agent, joined_at
+		FROM session_share_joins WHERE user_id = ? AND user_id != ''
+		ORDER BY joined_at DESC, id DESC`, userID)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list share joins", "sqlite")
+	}
+	defer rows.Close()
+
+	return scanShareJoins(rows)
+}
+
+// AnonymizeJoins 사용자의 참여 기록에서 표시 이름, 접속 IP, User-Agent 삭제
+func (ss *shareLinkStorage) AnonymizeJoins(ctx context.Context, userID string) (int, error) {
+	result, err := ss.storage.execContext(ctx, `
+		UPDATE session_share_joins SET user_name = NULL, client_ip = NULL, user_agent = NULL
+		WHERE user_id = ? AND user_id != ''`, userID)
+	if err != nil {
+		return 0, storage.ConvertError(err, "anonymize share joins", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, storage.ConvertError(err, "anonymize share joins", "sqlite")
+	}
+	return int(affected), nil
+}
+
+// scanShareJoins 조회 결과 행을 참여 기록으로 변환
+func scanShareJoins(rows *sql.Rows) ([]*models.SessionShareJoin, error) {
+	joins := []*models.SessionShareJoin{}
+	for rows.Next() {
+		var (
+			join                                  models.SessionShareJoin
+			userID, userName, clientIP, userAgent sql.NullString
+		)
+		err := rows.Scan(&join.ID, &join.LinkID, &join.SessionID, &userID, &userName, &join.Channel, &clientIP, &userAgent, &join.JoinedAt)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan share join", "sqlite")
+		}
+		join.UserID = userID.String
+		join.UserName = userName.String
+		join.ClientIP = clientIP.String
+		join.UserAgent = userAgent.String
+		joins = append(joins, &join)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list share joins", "sqlite")
+	}
+	return joins, nil
+}
+
+// getOne 조건에 맞는 공유 링크 하나 조회
+func (ss *shareLinkStorage) getOne(ctx context.Context, where string, arg interface{}) (*models.SessionShareLink, error) {
+	rows, err := ss.storage.queryContext(ctx, selectShareLinkQuery+where, arg)
+	if err != nil {
+		return nil, storage.ConvertError(err, "get share link", "sqlite")
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, storage.ConvertError(err, "get share link", "sqlite")
+		}
+		return nil, storage.ErrNotFound
+	}
+	link, err := scanShareLink(rows)
+	if err != nil {
+		return nil, storage.ConvertError(err, "scan share link", "sqlite")
+	}
+	return link, nil
+}
+
+// scanShareLink 조회 결과 행을 공유 링크로 변환
+func scanShareLink(rows *sql.Rows) (*models.SessionShareLink, error) {
+	var (
+		link        models.SessionShareLink
+		workspaceID sql.NullString
+		revokedAt   sql.NullTime
+	)
+	err := rows.Scan(
+		&link.ID,
+		&link.SessionID,
+		&workspaceID,
+		&link.CreatedBy,
+		&link.TokenHash,
+		&link.Scope,
+		&link.ExpiresAt,
+		&revokedAt,
+		&link.JoinCount,
+		&link.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	link.WorkspaceID = workspaceID.String
+	if revokedAt.Valid {
+		link.RevokedAt = &revokedAt.Time
+	}
+	return &link, nil
+}
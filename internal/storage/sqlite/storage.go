@@ -10,6 +10,7 @@ import (
 	_ "github.com/mattn/go-sqlite3" // SQLite 드라이버
 	"go.uber.org/zap"
 	
+	"github.com/aicli/aicli-web/internal/breaker"
 	"github.com/aicli/aicli-web/internal/storage"
 	"github.com/aicli/aicli-web/internal/storage/memory"
 )
@@ -20,13 +21,37 @@ type Storage struct {
 	stmtCache map[string]*sql.Stmt
 	mu        sync.RWMutex
 	logger    *zap.Logger
+	breaker   *breaker.Breaker
 	
 	// 스토리지 구현체들
-	workspace *workspaceStorage
-	project   *projectStorage
-	session   *sessionStorage
-	task      *taskStorage
-	rbac      *memory.RBACStorage // 임시로 메모리 RBAC 사용
+	workspace  *workspaceStorage
+	project    *projectStorage
+	session    *sessionStorage
+	task       *taskStorage
+	rbac       *memory.RBACStorage // 임시로 메모리 RBAC 사용
+	message    *messageStorage
+	shareLink  *shareLinkStorage
+	process    *processRecordStorage
+	errorStats *errorStatsStorage
+	account    *accountStorage
+	email      *emailDeliveryStorage
+	acl        *workspaceACLStorage
+	prompt     *promptStorage
+	pipeline   *pipelineStorage
+	fanOut     *fanOutStorage
+	taskReview *taskReviewStorage
+	webhook    *webhookMappingStorage
+	pullReq    *pullRequestStorage
+	issues     *issueTrackerStorage
+	terminal   *terminalStorage
+	artifacts  *taskArtifactStorage
+	privacy    *privacyRequestStorage
+	commands   *sessionCommandStorage
+	activity   *activityStorage
+	notices    *notificationStorage
+	analytics  *analyticsStorage
+	env        *workspaceEnvStorage
+	prefs      *userPreferenceStorage
 	
 	// 최적화 도구들
 	indexManager *IndexManager
@@ -41,6 +66,10 @@ type Config struct {
 	ConnMaxIdleTime time.Duration
 	PragmaOptions   map[string]string
 	Logger          *zap.Logger
+	
+	// Breaker 쿼리에 적용할 회로 차단기 (열려 있으면 데이터베이스에 접근하지 않고 바로 실패)
+	// 실패 판단은 IsBreakerFailure를 사용합니다.
+	Breaker *breaker.Breaker
 }
 
 // DefaultConfig 기본 설정 반환
@@ -90,6 +119,7 @@ func New(config Config) (*Storage, error) {
 		db:        db,
 		stmtCache: make(map[string]*sql.Stmt),
 		logger:    config.Logger,
+		breaker:   config.Breaker,
 	}
 	
 	if storage.logger == nil {
@@ -107,6 +137,29 @@ func New(config Config) (*Storage, error) {
 	storage.session = newSessionStorage(storage)
 	storage.task = newTaskStorage(storage)
 	storage.rbac = memory.NewRBACStorage() // 임시로 메모리 RBAC 사용
+	storage.message = newMessageStorage(storage)
+	storage.shareLink = newShareLinkStorage(storage)
+	storage.process = newProcessRecordStorage(storage)
+	storage.errorStats = newErrorStatsStorage(storage)
+	storage.account = newAccountStorage(storage)
+	storage.email = newEmailDeliveryStorage(storage)
+	storage.acl = newWorkspaceACLStorage(storage)
+	storage.prompt = newPromptStorage(storage)
+	storage.pipeline = newPipelineStorage(storage)
+	storage.fanOut = newFanOutStorage(storage)
+	storage.taskReview = newTaskReviewStorage(storage)
+	storage.webhook = newWebhookMappingStorage(storage)
+	storage.pullReq = newPullRequestStorage(storage)
+	storage.issues = newIssueTrackerStorage(storage)
+	storage.terminal = newTerminalStorage(storage)
+	storage.artifacts = newTaskArtifactStorage(storage)
+	storage.privacy = newPrivacyRequestStorage(storage)
+	storage.commands = newSessionCommandStorage(storage)
+	storage.activity = newActivityStorage(storage)
+	storage.notices = newNotificationStorage(storage)
+	storage.analytics = newAnalyticsStorage(storage)
+	storage.env = newWorkspaceEnvStorage(storage)
+	storage.prefs = newUserPreferenceStorage(storage)
 	
 	// 최적화 도구들 초기화
 	storage.indexManager = newIndexManager(storage)
@@ -146,6 +199,121 @@ func (s *Storage) RBAC() storage.RBACStorage {
 	return s.rbac
 }
 
+// Message 세션 대화 기록 스토리지 반환
+func (s *Storage) Message() storage.MessageStorage {
+	return s.message
+}
+
+// ShareLink 세션 공유 링크 스토리지 반환
+func (s *Storage) ShareLink() storage.ShareLinkStorage {
+	return s.shareLink
+}
+
+// Process 실행 중인 CLI 프로세스 기록 스토리지 반환
+func (s *Storage) Process() storage.ProcessRecordStorage {
+	return s.process
+}
+
+// ErrorStats 에러 통계 집계 스토리지 반환
+func (s *Storage) ErrorStats() storage.ErrorStatsStorage {
+	return s.errorStats
+}
+
+// Account 로컬 계정 스토리지 반환
+func (s *Storage) Account() storage.AccountStorage {
+	return s.account
+}
+
+// EmailDelivery 메일 발송 기록 스토리지 반환
+func (s *Storage) EmailDelivery() storage.EmailDeliveryStorage {
+	return s.email
+}
+
+// WorkspaceACL 워크스페이스 공유 ACL 스토리지 반환
+func (s *Storage) WorkspaceACL() storage.WorkspaceACLStorage {
+	return s.acl
+}
+
+// Prompt 프롬프트 라이브러리 스토리지 반환
+func (s *Storage) Prompt() storage.PromptStorage {
+	return s.prompt
+}
+
+// Pipeline 파이프라인 스토리지 반환
+func (s *Storage) Pipeline() storage.PipelineStorage {
+	return s.pipeline
+}
+
+// FanOut 워크스페이스 팬아웃 스토리지 반환
+func (s *Storage) FanOut() storage.FanOutStorage {
+	return s.fanOut
+}
+
+// TaskReview 태스크 결과 검토 스토리지 반환
+func (s *Storage) TaskReview() storage.TaskReviewStorage {
+	return s.taskReview
+}
+
+// WebhookMapping 웹훅 매핑 스토리지 반환
+func (s *Storage) WebhookMapping() storage.WebhookMappingStorage {
+	return s.webhook
+}
+
+// PullRequest 워크스페이스 PR 설정과 태스크 PR 스토리지 반환
+func (s *Storage) PullRequest() storage.PullRequestStorage {
+	return s.pullReq
+}
+
+// IssueTracker 워크스페이스 이슈 트래커 설정과 이슈 연결 스토리지 반환
+func (s *Storage) IssueTracker() storage.IssueTrackerStorage {
+	return s.issues
+}
+
+// Terminal 워크스페이스 터미널 접속 기록과 녹화 스토리지 반환
+func (s *Storage) Terminal() storage.TerminalStorage {
+	return s.terminal
+}
+
+// TaskArtifact 태스크 결과물 스토리지 반환
+func (s *Storage) TaskArtifact() storage.TaskArtifactStorage {
+	return s.artifacts
+}
+
+// PrivacyRequest 개인정보 내보내기/삭제 처리 기록 스토리지 반환
+func (s *Storage) PrivacyRequest() storage.PrivacyRequestStorage {
+	return s.privacy
+}
+
+// SessionCommand 세션 셸 명령 실행 기록 스토리지 반환
+func (s *Storage) SessionCommand() storage.SessionCommandStorage {
+	return s.commands
+}
+
+// Activity 활동 피드 스토리지 반환
+func (s *Storage) Activity() storage.ActivityStorage {
+	return s.activity
+}
+
+// Notification 알림 센터 스토리지 반환
+func (s *Storage) Notification() storage.NotificationStorage {
+	return s.notices
+}
+
+// Analytics 사용량 분석 롤업 스토리지 반환
+func (s *Storage) Analytics() storage.AnalyticsStorage {
+	return s.analytics
+}
+
+// WorkspaceEnv 워크스페이스 환경 변수 스토리지 반환
+func (s *Storage) WorkspaceEnv() storage.WorkspaceEnvStorage {
+	return s.env
+}
+
+// UserPreference 사용자 언어 설정 스토리지 반환
+func (s *Storage) UserPreference() storage.UserPreferenceStorage {
+	return s.prefs
+}
+
 // BeginTx 트랜잭션 시작
 func (s *Storage) BeginTx(ctx context.Context) (storage.Transaction, error) {
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -216,14 +384,27 @@ func (s *Storage) prepareStmt(ctx context.Context, query string) (*sql.Stmt, err
 	return stmt, nil
 }
 
+// IsBreakerFailure 회로 차단기가 데이터베이스 장애로 집계할 에러인지 판단 (연결 실패, 잠김, 타임아웃)
+// 제약 조건 위반이나 행 없음처럼 요청 자체의 문제는 집계하지 않습니다.
+func IsBreakerFailure(err error) bool {
+	return storage.IsConnectionError(err)
+}
+
 // execContext Context를 지원하는 실행
 func (s *Storage) execContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	done, err := s.breaker.Allow(ctx)
+	if err != nil {
+		return nil, storage.NewStorageError("exec", "sqlite", err)
+	}
+	
 	stmt, err := s.prepareStmt(ctx, query)
 	if err != nil {
+		done(err)
 		return nil, err
 	}
 	
 	result, err := stmt.ExecContext(ctx, args...)
+	done(err)
 	if err != nil {
 		return nil, storage.ConvertError(err, "exec", "sqlite")
 	}
@@ -233,12 +414,19 @@ func (s *Storage) execContext(ctx context.Context, query string, args ...interfa
 
 // queryContext Context를 지원하는 쿼리
 func (s *Storage) queryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	done, err := s.breaker.Allow(ctx)
+	if err != nil {
+		return nil, storage.NewStorageError("query", "sqlite", err)
+	}
+	
 	stmt, err := s.prepareStmt(ctx, query)
 	if err != nil {
+		done(err)
 		return nil, err
 	}
 	
 	rows, err := stmt.QueryContext(ctx, args...)
+	done(err)
 	if err != nil {
 		return nil, storage.ConvertError(err, "query", "sqlite")
 	}
@@ -247,6 +435,7 @@ func (s *Storage) queryContext(ctx context.Context, query string, args ...interf
 }
 
 // queryRowContext Context를 지원하는 단일 행 쿼리
+// 에러가 Scan에서야 드러나므로 회로 차단기 집계에는 포함하지 않습니다.
 func (s *Storage) queryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
 	stmt, err := s.prepareStmt(ctx, query)
 	if err != nil {
@@ -3,11 +3,13 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"strings"
 	"time"
 
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
 )
 
 // taskStorage 태스크 스토리지 SQLite 구현
@@ -24,22 +26,25 @@ const (
 	// 태스크 조회 쿼리
 	selectTaskQuery = `
 		SELECT id, session_id, command, status, output, error, started_at, completed_at,
-		       bytes_in, bytes_out, duration, created_at, updated_at, version
+		       timeout_tier, models, served_model, model_attempts, dry_run, snapshot, bytes_in, bytes_out, duration,
+		       created_at, updated_at, version
 		FROM tasks
 	`
 	
 	// 태스크 삽입 쿼리
 	insertTaskQuery = `
 		INSERT INTO tasks (id, session_id, command, status, output, error, started_at, 
-		                  completed_at, bytes_in, bytes_out, duration, created_at, updated_at, version)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		                  completed_at, timeout_tier, models, served_model, model_attempts, dry_run, snapshot, bytes_in, bytes_out,
+		                  duration, created_at, updated_at, version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	
 	// 태스크 업데이트 쿼리
 	updateTaskQuery = `
 		UPDATE tasks 
 		SET command = ?, status = ?, output = ?, error = ?, started_at = ?, completed_at = ?,
-		    bytes_in = ?, bytes_out = ?, duration = ?, updated_at = ?, version = version + 1
+		    served_model = ?, model_attempts = ?, bytes_in = ?, bytes_out = ?, duration = ?, updated_at = ?,
+		    version = version + 1
 		WHERE id = ? AND version = ?
 	`
 	
@@ -64,14 +69,24 @@ func (t *taskStorage) Create(ctx context.Context, task *models.Task) error {
 	if task.Status == "" {
 		task.Status = models.TaskPending
 	}
+	task.TimeoutTier = task.TimeoutTier.OrDefault()
 	task.CreatedAt = now
 	task.UpdatedAt = now
 	if task.Version == 0 {
 		task.Version = 1
 	}
 	
+	taskModels, err := marshalPipelineJSON(task.Models)
+	if err != nil {
+		return storage.ConvertError(err, "marshal task models", "sqlite")
+	}
+	attempts, err := marshalPipelineJSON(task.ModelAttempts)
+	if err != nil {
+		return storage.ConvertError(err, "marshal task model attempts", "sqlite")
+	}
+	
 	// 태스크 삽입
-	_, err := t.storage.execContext(ctx, insertTaskQuery,
+	_, err = t.storage.execContext(ctx, insertTaskQuery,
 		task.ID,
 		task.SessionID,
 		task.Command,
@@ -80,6 +95,12 @@ func (t *taskStorage) Create(ctx context.Context, task *models.Task) error {
 		task.Error,
 		task.StartedAt,
 		task.CompletedAt,
+		task.TimeoutTier,
+		taskModels,
+		task.ServedModel,
+		attempts,
+		task.DryRun,
+		task.Snapshot,
 		task.BytesIn,
 		task.BytesOut,
 		task.Duration,
@@ -154,12 +175,13 @@ func (t *taskStorage) List(ctx context.Context, filter *models.TaskFilter, pagin
 		return nil, 0, storage.ConvertError(err, "count tasks", "sqlite")
 	}
 	
-	// 데이터 조회
-	query := selectTaskQuery + whereClause + `
-		ORDER BY ` + paging.Sort + ` ` + paging.Order + `
-		LIMIT ? OFFSET ?
-	`
-	queryArgs := append(args, paging.Limit, paging.GetOffset())
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(paging, models.TaskSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectTaskQuery + page.AppendWhere(whereClause) + page.Tail
+	queryArgs := append(append(args, page.WhereArgs...), page.TailArgs...)
 	
 	rows, err := t.storage.queryContext(ctx, query, queryArgs...)
 	if err != nil {
@@ -179,6 +201,11 @@ func (t *taskStorage) List(ctx context.Context, filter *models.TaskFilter, pagin
 func (t *taskStorage) Update(ctx context.Context, task *models.Task) error {
 	task.UpdatedAt = time.Now()
 	
+	attempts, err := marshalPipelineJSON(task.ModelAttempts)
+	if err != nil {
+		return storage.ConvertError(err, "marshal task model attempts", "sqlite")
+	}
+	
 	result, err := t.storage.execContext(ctx, updateTaskQuery,
 		task.Command,
 		task.Status,
@@ -186,6 +213,8 @@ func (t *taskStorage) Update(ctx context.Context, task *models.Task) error {
 		task.Error,
 		task.StartedAt,
 		task.CompletedAt,
+		task.ServedModel,
+		attempts,
 		task.BytesIn,
 		task.BytesOut,
 		task.Duration,
@@ -249,14 +278,15 @@ func (t *taskStorage) GetBySessionID(ctx context.Context, sessionID string, pagi
 		return nil, 0, storage.ConvertError(err, "count tasks by session", "sqlite")
 	}
 	
-	// 데이터 조회
-	query := selectTaskQuery + `
-		WHERE session_id = ?
-		ORDER BY ` + paging.Sort + ` ` + paging.Order + `
-		LIMIT ? OFFSET ?
-	`
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(paging, models.TaskSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectTaskQuery + page.AppendWhere(` WHERE session_id = ?`) + page.Tail
+	args := append(append([]interface{}{sessionID}, page.WhereArgs...), page.TailArgs...)
 	
-	rows, err := t.storage.queryContext(ctx, query, sessionID, paging.Limit, paging.GetOffset())
+	rows, err := t.storage.queryContext(ctx, query, args...)
 	if err != nil {
 		return nil, 0, storage.ConvertError(err, "get tasks by session", "sqlite")
 	}
@@ -282,11 +312,29 @@ func (t *taskStorage) GetActiveCount(ctx context.Context, sessionID string) (int
 	return count, nil
 }
 
+// ListExecutedBetween from 초과 to 이하에 끝났거나 to 시점에 실행 중이던 태스크 조회 (종료 시각순)
+func (t *taskStorage) ListExecutedBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	query := selectTaskQuery + `
+		WHERE (completed_at > ? AND completed_at <= ?)
+		   OR (started_at IS NOT NULL AND started_at <= ? AND (completed_at IS NULL OR completed_at > ?))
+		ORDER BY completed_at IS NULL, completed_at, id
+	`
+	
+	rows, err := t.storage.queryContext(ctx, query, from, to, to, to)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list executed tasks", "sqlite")
+	}
+	defer rows.Close()
+	
+	return t.scanTasks(rows)
+}
+
 // scanTask 단일 태스크 스캔
 func (t *taskStorage) scanTask(row *sql.Row) (*models.Task, error) {
 	task := &models.Task{}
 	var output, error sql.NullString
 	var startedAt, completedAt sql.NullTime
+	var taskModels, attempts string
 	
 	err := row.Scan(
 		&task.ID,
@@ -297,6 +345,12 @@ func (t *taskStorage) scanTask(row *sql.Row) (*models.Task, error) {
 		&error,
 		&startedAt,
 		&completedAt,
+		&task.TimeoutTier,
+		&taskModels,
+		&task.ServedModel,
+		&attempts,
+		&task.DryRun,
+		&task.Snapshot,
 		&task.BytesIn,
 		&task.BytesOut,
 		&task.Duration,
@@ -322,6 +376,9 @@ func (t *taskStorage) scanTask(row *sql.Row) (*models.Task, error) {
 	if completedAt.Valid {
 		task.CompletedAt = &completedAt.Time
 	}
+	if err := decodeTaskModels(task, taskModels, attempts); err != nil {
+		return nil, err
+	}
 	
 	return task, nil
 }
@@ -335,6 +392,7 @@ func (t *taskStorage) scanTasks(rows *sql.Rows) ([]*models.Task, error) {
 		task := &models.Task{}
 		var output, error sql.NullString
 		var startedAt, completedAt sql.NullTime
+		var taskModels, attempts string
 		
 		err := rows.Scan(
 			&task.ID,
@@ -345,6 +403,12 @@ func (t *taskStorage) scanTasks(rows *sql.Rows) ([]*models.Task, error) {
 			&error,
 			&startedAt,
 			&completedAt,
+			&task.TimeoutTier,
+			&taskModels,
+			&task.ServedModel,
+			&attempts,
+			&task.DryRun,
+			&task.Snapshot,
 			&task.BytesIn,
 			&task.BytesOut,
 			&task.Duration,
@@ -370,6 +434,9 @@ func (t *taskStorage) scanTasks(rows *sql.Rows) ([]*models.Task, error) {
 		if completedAt.Valid {
 			task.CompletedAt = &completedAt.Time
 		}
+		if err := decodeTaskModels(task, taskModels, attempts); err != nil {
+			return nil, storage.ConvertError(err, "decode task models", "sqlite")
+		}
 		
 		tasks = append(tasks, task)
 	}
@@ -380,4 +447,12 @@ func (t *taskStorage) scanTasks(rows *sql.Rows) ([]*models.Task, error) {
 	}
 	
 	return tasks, nil
-}
\ No newline at end of file
+}
+
+// decodeTaskModels JSON 컬럼의 모델 선호 목록과 실패한 모델 시도 복원
+func decodeTaskModels(task *models.Task, taskModels, attempts string) error {
+	if err := json.Unmarshal([]byte(taskModels), &task.Models); err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(attempts), &task.ModelAttempts)
+}
@@ -0,0 +1,155 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// taskArtifactStorage 태스크 결과물 SQLite 구현 (020_task_artifacts.sql)
+type taskArtifactStorage struct {
+	storage *Storage
+}
+
+// newTaskArtifactStorage 새 태스크 결과물 스토리지 생성
+func newTaskArtifactStorage(s *Storage) *taskArtifactStorage {
+	return &taskArtifactStorage{storage: s}
+}
+
+const (
+	// 결과물 추가 쿼리
+	insertTaskArtifactQuery = `
+		INSERT INTO task_artifacts (id, task_id, session_id, workspace_id, position, type, name, language, content,
+		                            action, test_report, source, message_sequence, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 결과물 조회 쿼리
+	selectTaskArtifactQuery = `
+		SELECT id, task_id, session_id, workspace_id, type, name, language, content, action, test_report,
+		       source, message_sequence, created_at
+		FROM task_artifacts
+		WHERE task_id = ?
+	`
+)
+
+// ReplaceByTask 태스크의 결과물을 모두 교체
+func (as *taskArtifactStorage) ReplaceByTask(ctx context.Context, taskID string, artifacts []*models.TaskArtifact) error {
+	tx, err := as.storage.db.BeginTx(ctx, nil)
+	if err != nil {
+		return storage.ConvertError(err, "begin replace task artifacts", "sqlite")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM task_artifacts WHERE task_id = ?`, taskID); err != nil {
+		return storage.ConvertError(err, "delete task artifacts", "sqlite")
+	}
+
+	now := time.Now()
+	for i, artifact := range artifacts {
+		if artifact.ID == "" {
+			artifact.ID = uuid.New().String()
+		}
+		if artifact.CreatedAt.IsZero() {
+			artifact.CreatedAt = now
+		}
+		artifact.TaskID = taskID
+
+		var report sql.NullString
+		if artifact.TestReport != nil {
+			data, err := json.Marshal(artifact.TestReport)
+			if err != nil {
+				return storage.ConvertError(err, "insert task artifact", "sqlite")
+			}
+			report = sql.NullString{String: string(data), Valid: true}
+		}
+
+		_, err := tx.ExecContext(ctx, insertTaskArtifactQuery,
+			artifact.ID,
+			artifact.TaskID,
+			artifact.SessionID,
+			artifact.WorkspaceID,
+			i,
+			artifact.Type,
+			artifact.Name,
+			artifact.Language,
+			artifact.Content,
+			artifact.Action,
+			report,
+			artifact.Source,
+			artifact.MessageSequence,
+			artifact.CreatedAt,
+		)
+		if err != nil {
+			return storage.ConvertError(err, "insert task artifact", "sqlite")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return storage.ConvertError(err, "commit task artifacts", "sqlite")
+	}
+	return nil
+}
+
+// ListByTask 태스크의 결과물을 추출 순서대로 조회
+func (as *taskArtifactStorage) ListByTask(ctx context.Context, taskID string, artifactType models.TaskArtifactType) ([]*models.TaskArtifact, error) {
+	query := selectTaskArtifactQuery
+	args := []interface{}{taskID}
+	if artifactType != "" {
+		query += ` AND type = ?`
+		args = append(args, artifactType)
+	}
+
+	rows, err := as.storage.queryContext(ctx, query+` ORDER BY position`, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list task artifacts", "sqlite")
+	}
+	defer rows.Close()
+
+	artifacts := []*models.TaskArtifact{}
+	for rows.Next() {
+		var (
+			artifact                                       models.TaskArtifact
+			workspaceID, language, content, action, report sql.NullString
+		)
+		err := rows.Scan(
+			&artifact.ID,
+			&artifact.TaskID,
+			&artifact.SessionID,
+			&workspaceID,
+			&artifact.Type,
+			&artifact.Name,
+			&language,
+			&content,
+			&action,
+			&report,
+			&artifact.Source,
+			&artifact.MessageSequence,
+			&artifact.CreatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan task artifact", "sqlite")
+		}
+		artifact.WorkspaceID = workspaceID.String
+		artifact.Language = language.String
+		artifact.Content = content.String
+		artifact.Action = action.String
+		if report.Valid {
+			artifact.TestReport = &models.TaskTestReport{}
+			if err := json.Unmarshal([]byte(report.String), artifact.TestReport); err != nil {
+				return nil, storage.ConvertError(err, "scan task artifact", "sqlite")
+			}
+		}
+		artifacts = append(artifacts, &artifact)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list task artifacts", "sqlite")
+	}
+	return artifacts, nil
+}
@@ -0,0 +1,384 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// taskReviewStorage 태스크 결과 검토 SQLite 구현 (015_task_reviews.sql)
+type taskReviewStorage struct {
+	storage *Storage
+}
+
+// newTaskReviewStorage 새 태스크 검토 스토리지 생성
+func newTaskReviewStorage(s *Storage) *taskReviewStorage {
+	return &taskReviewStorage{storage: s}
+}
+
+// 검토 조회 쿼리
+const selectTaskReviewQuery = `
+	SELECT id, task_id, session_id, project_id, workspace_id, status, base_commit, task_status, dry_run, shadow_path,
+	       diff, diff_truncated, files_changed, reviewer_id, comment, applied_commit,
+	       created_at, updated_at, decided_at
+	FROM task_reviews
+`
+
+// Create 새 검토 생성
+func (rs *taskReviewStorage) Create(ctx context.Context, review *models.TaskReview) error {
+	if review.ID == "" {
+		review.ID = uuid.New().String()
+	}
+	if review.CreatedAt.IsZero() {
+		review.CreatedAt = time.Now()
+	}
+	review.UpdatedAt = review.CreatedAt
+
+	files, err := marshalPipelineJSON(review.FilesChanged)
+	if err != nil {
+		return storage.ConvertError(err, "create task review", "sqlite")
+	}
+	_, err = rs.storage.execContext(ctx, `
+		INSERT INTO task_reviews (id, task_id, session_id, project_id, workspace_id, status, base_commit, task_status,
+		                          dry_run, shadow_path, diff, diff_truncated, files_changed, reviewer_id, comment,
+		                          applied_commit, created_at, updated_at, decided_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		review.ID,
+		review.TaskID,
+		review.SessionID,
+		review.ProjectID,
+		review.WorkspaceID,
+		review.Status,
+		review.BaseCommit,
+		review.TaskStatus,
+		review.DryRun,
+		review.ShadowPath,
+		review.Diff,
+		review.DiffTruncated,
+		files,
+		review.ReviewerID,
+		review.Comment,
+		review.AppliedCommit,
+		review.CreatedAt,
+		review.UpdatedAt,
+		review.DecidedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create task review", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 검토 조회
+func (rs *taskReviewStorage) GetByID(ctx context.Context, id string) (*models.TaskReview, error) {
+	return rs.get(ctx, `WHERE id = ?`, id)
+}
+
+// GetByTaskID 태스크의 검토 조회
+func (rs *taskReviewStorage) GetByTaskID(ctx context.Context, taskID string) (*models.TaskReview, error) {
+	return rs.get(ctx, `WHERE task_id = ?`, taskID)
+}
+
+// Update 검토 저장
+func (rs *taskReviewStorage) Update(ctx context.Context, review *models.TaskReview) error {
+	review.UpdatedAt = time.Now()
+
+	files, err := marshalPipelineJSON(review.FilesChanged)
+	if err != nil {
+		return storage.ConvertError(err, "update task review", "sqlite")
+	}
+	result, err := rs.storage.execContext(ctx, `
+		UPDATE task_reviews
+		SET status = ?, task_status = ?, shadow_path = ?, diff = ?, diff_truncated = ?, files_changed = ?,
+		    reviewer_id = ?, comment = ?, applied_commit = ?, updated_at = ?, decided_at = ?
+		WHERE id = ?`,
+		review.Status,
+		review.TaskStatus,
+		review.ShadowPath,
+		review.Diff,
+		review.DiffTruncated,
+		files,
+		review.ReviewerID,
+		review.Comment,
+		review.AppliedCommit,
+		review.UpdatedAt,
+		review.DecidedAt,
+		review.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update task review", "sqlite")
+	}
+	return requireAffected(result, "update task review")
+}
+
+// Delete 검토 삭제
+func (rs *taskReviewStorage) Delete(ctx context.Context, id string) error {
+	result, err := rs.storage.execContext(ctx, `DELETE FROM task_reviews WHERE id = ?`, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete task review", "sqlite")
+	}
+	return requireAffected(result, "delete task review")
+}
+
+// List 조건에 맞는 검토 조회 (최신순)
+func (rs *taskReviewStorage) List(ctx context.Context, filter *models.TaskReviewFilter) ([]*models.TaskReview, error) {
+	conditions := []string{}
+	args := []interface{}{}
+	if filter.WorkspaceIDs != nil {
+		if len(filter.WorkspaceIDs) == 0 {
+			return []*models.TaskReview{}, nil
+		}
+		conditions = append(conditions, `workspace_id IN (?`+strings.Repeat(`, ?`, len(filter.WorkspaceIDs)-1)+`)`)
+		for _, id := range filter.WorkspaceIDs {
+			args = append(args, id)
+		}
+	}
+	if filter.WorkspaceID != "" {
+		conditions = append(conditions, `workspace_id = ?`)
+		args = append(args, filter.WorkspaceID)
+	}
+	if filter.ProjectID != "" {
+		conditions = append(conditions, `project_id = ?`)
+		args = append(args, filter.ProjectID)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, `status = ?`)
+		args = append(args, filter.Status)
+	}
+	if filter.OpenOnly {
+		conditions = append(conditions, `status IN ('awaiting_task', 'pending')`)
+	}
+
+	clause := ``
+	if len(conditions) > 0 {
+		clause = `WHERE ` + strings.Join(conditions, ` AND `)
+	}
+	clause += ` ORDER BY created_at DESC, id DESC`
+	if filter.Limit > 0 {
+		clause += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+	return rs.query(ctx, clause, args...)
+}
+
+// get 조건에 맞는 검토 하나 조회
+func (rs *taskReviewStorage) get(ctx context.Context, clause string, args ...interface{}) (*models.TaskReview, error) {
+	reviews, err := rs.query(ctx, clause, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(reviews) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return reviews[0], nil
+}
+
+// query 조건에 맞는 검토 조회
+func (rs *taskReviewStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.TaskReview, error) {
+	// 워크스페이스 수에 따라 쿼리가 달라지므로 Prepared Statement 캐시를 사용하지 않음
+	rows, err := rs.storage.db.QueryContext(ctx, selectTaskReviewQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list task reviews", "sqlite")
+	}
+	defer rows.Close()
+
+	reviews := []*models.TaskReview{}
+	for rows.Next() {
+		var (
+			review                                       models.TaskReview
+			taskStatus, diff, reviewer, comment, applied sql.NullString
+			files                                        string
+			decidedAt                                    sql.NullTime
+		)
+		err := rows.Scan(
+			&review.ID,
+			&review.TaskID,
+			&review.SessionID,
+			&review.ProjectID,
+			&review.WorkspaceID,
+			&review.Status,
+			&review.BaseCommit,
+			&taskStatus,
+			&review.DryRun,
+			&review.ShadowPath,
+			&diff,
+			&review.DiffTruncated,
+			&files,
+			&reviewer,
+			&comment,
+			&applied,
+			&review.CreatedAt,
+			&review.UpdatedAt,
+			&decidedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan task review", "sqlite")
+		}
+		review.TaskStatus = models.TaskStatus(taskStatus.String)
+		review.Diff = diff.String
+		review.ReviewerID = reviewer.String
+		review.Comment = comment.String
+		review.AppliedCommit = applied.String
+		if decidedAt.Valid {
+			review.DecidedAt = &decidedAt.Time
+		}
+		if err := json.Unmarshal([]byte(files), &review.FilesChanged); err != nil {
+			return nil, storage.ConvertError(err, "scan task review", "sqlite")
+		}
+		reviews = append(reviews, &review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list task reviews", "sqlite")
+	}
+	return reviews, nil
+}
+
+// 검토 의견 조회 쿼리 (031_task_review_comments.sql)
+const selectTaskReviewCommentQuery = `
+	SELECT id, review_id, workspace_id, author_id, path, line, side, body, resolved, resolved_by, resolved_at,
+	       revision_session_id, revision_task_id, created_at, updated_at
+	FROM task_review_comments
+`
+
+// CreateComment 검토 의견 추가
+func (rs *taskReviewStorage) CreateComment(ctx context.Context, comment *models.TaskReviewComment) error {
+	if comment.ID == "" {
+		comment.ID = uuid.New().String()
+	}
+	if comment.CreatedAt.IsZero() {
+		comment.CreatedAt = time.Now()
+	}
+	comment.UpdatedAt = comment.CreatedAt
+
+	_, err := rs.storage.execContext(ctx, `
+		INSERT INTO task_review_comments (id, review_id, workspace_id, author_id, path, line, side, body, resolved,
+		                                  resolved_by, resolved_at, revision_session_id, revision_task_id,
+		                                  created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		comment.ID,
+		comment.ReviewID,
+		comment.WorkspaceID,
+		comment.AuthorID,
+		comment.Path,
+		comment.Line,
+		comment.Side,
+		comment.Body,
+		comment.Resolved,
+		comment.ResolvedBy,
+		comment.ResolvedAt,
+		comment.RevisionSessionID,
+		comment.RevisionTaskID,
+		comment.CreatedAt,
+		comment.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create task review comment", "sqlite")
+	}
+	return nil
+}
+
+// GetComment 검토 의견 조회
+func (rs *taskReviewStorage) GetComment(ctx context.Context, id string) (*models.TaskReviewComment, error) {
+	comments, err := rs.queryComments(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(comments) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return comments[0], nil
+}
+
+// UpdateComment 검토 의견 저장
+func (rs *taskReviewStorage) UpdateComment(ctx context.Context, comment *models.TaskReviewComment) error {
+	comment.UpdatedAt = time.Now()
+
+	result, err := rs.storage.execContext(ctx, `
+		UPDATE task_review_comments
+		SET body = ?, resolved = ?, resolved_by = ?, resolved_at = ?, revision_session_id = ?, revision_task_id = ?,
+		    updated_at = ?
+		WHERE id = ?`,
+		comment.Body,
+		comment.Resolved,
+		comment.ResolvedBy,
+		comment.ResolvedAt,
+		comment.RevisionSessionID,
+		comment.RevisionTaskID,
+		comment.UpdatedAt,
+		comment.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update task review comment", "sqlite")
+	}
+	return requireAffected(result, "update task review comment")
+}
+
+// DeleteComment 검토 의견 삭제
+func (rs *taskReviewStorage) DeleteComment(ctx context.Context, id string) error {
+	result, err := rs.storage.execContext(ctx, `DELETE FROM task_review_comments WHERE id = ?`, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete task review comment", "sqlite")
+	}
+	return requireAffected(result, "delete task review comment")
+}
+
+// ListComments 검토의 의견 조회 (파일, 줄, 작성 순)
+func (rs *taskReviewStorage) ListComments(ctx context.Context, reviewID string) ([]*models.TaskReviewComment, error) {
+	return rs.queryComments(ctx, `WHERE review_id = ? ORDER BY path, line, created_at, id`, reviewID)
+}
+
+// queryComments 조건에 맞는 검토 의견 조회
+func (rs *taskReviewStorage) queryComments(ctx context.Context, clause string, args ...interface{}) ([]*models.TaskReviewComment, error) {
+	rows, err := rs.storage.queryContext(ctx, selectTaskReviewCommentQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list task review comments", "sqlite")
+	}
+	defer rows.Close()
+
+	comments := []*models.TaskReviewComment{}
+	for rows.Next() {
+		var (
+			comment                                   models.TaskReviewComment
+			resolvedBy, revisionSession, revisionTask sql.NullString
+			resolvedAt                                sql.NullTime
+		)
+		err := rows.Scan(
+			&comment.ID,
+			&comment.ReviewID,
+			&comment.WorkspaceID,
+			&comment.AuthorID,
+			&comment.Path,
+			&comment.Line,
+			&comment.Side,
+			&comment.Body,
+			&comment.Resolved,
+			&resolvedBy,
+			&resolvedAt,
+			&revisionSession,
+			&revisionTask,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan task review comment", "sqlite")
+		}
+		comment.ResolvedBy = resolvedBy.String
+		comment.RevisionSessionID = revisionSession.String
+		comment.RevisionTaskID = revisionTask.String
+		if resolvedAt.Valid {
+			comment.ResolvedAt = &resolvedAt.Time
+		}
+		comments = append(comments, &comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list task review comments", "sqlite")
+	}
+	return comments, nil
+}
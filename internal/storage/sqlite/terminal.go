@@ -0,0 +1,217 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// terminalStorage 터미널 접속 기록과 녹화 SQLite 구현 (019_terminal_sessions.sql)
+type terminalStorage struct {
+	storage *Storage
+}
+
+// newTerminalStorage 새 터미널 스토리지 생성
+func newTerminalStorage(s *Storage) *terminalStorage {
+	return &terminalStorage{storage: s}
+}
+
+// 터미널 세션 조회 쿼리 (녹화 본문 제외)
+const selectTerminalSessionQuery = `
+	SELECT id, workspace_id, user_id, mode, container_id, shell, cols, rows, status, client_ip, user_agent,
+	       exit_code, input_bytes, output_bytes, recording_size, recording_truncated, started_at, ended_at
+	FROM terminal_sessions
+`
+
+// Create 새 터미널 세션 기록 생성
+func (ts *terminalStorage) Create(ctx context.Context, session *models.TerminalSession) error {
+	if session.ID == "" {
+		session.ID = uuid.New().String()
+	}
+	if session.StartedAt.IsZero() {
+		session.StartedAt = time.Now()
+	}
+
+	_, err := ts.storage.execContext(ctx, `
+		INSERT INTO terminal_sessions (id, workspace_id, user_id, mode, container_id, shell, cols, rows, status,
+		                               client_ip, user_agent, exit_code, input_bytes, output_bytes, recording_size,
+		                               recording_truncated, started_at, ended_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		session.ID,
+		session.WorkspaceID,
+		session.UserID,
+		session.Mode,
+		session.ContainerID,
+		session.Shell,
+		session.Cols,
+		session.Rows,
+		session.Status,
+		session.ClientIP,
+		session.UserAgent,
+		session.ExitCode,
+		session.InputBytes,
+		session.OutputBytes,
+		session.RecordingSize,
+		session.RecordingTruncated,
+		session.StartedAt,
+		session.EndedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create terminal session", "sqlite")
+	}
+	return nil
+}
+
+// Update 터미널 세션 기록 저장
+func (ts *terminalStorage) Update(ctx context.Context, session *models.TerminalSession) error {
+	result, err := ts.storage.execContext(ctx, `
+		UPDATE terminal_sessions SET cols = ?, rows = ?, status = ?, exit_code = ?, input_bytes = ?, output_bytes = ?,
+		                             recording_size = ?, recording_truncated = ?, ended_at = ?
+		WHERE id = ?`,
+		session.Cols,
+		session.Rows,
+		session.Status,
+		session.ExitCode,
+		session.InputBytes,
+		session.OutputBytes,
+		session.RecordingSize,
+		session.RecordingTruncated,
+		session.EndedAt,
+		session.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update terminal session", "sqlite")
+	}
+	return requireAffected(result, "update terminal session")
+}
+
+// GetByID ID로 터미널 세션 기록 조회
+func (ts *terminalStorage) GetByID(ctx context.Context, id string) (*models.TerminalSession, error) {
+	sessions, err := ts.query(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return sessions[0], nil
+}
+
+// ListByWorkspace 워크스페이스의 터미널 세션 기록 조회 (최신순)
+func (ts *terminalStorage) ListByWorkspace(ctx context.Context, workspaceID string, limit int) ([]*models.TerminalSession, error) {
+	if limit <= 0 {
+		return ts.query(ctx, `WHERE workspace_id = ? ORDER BY started_at DESC, id DESC`, workspaceID)
+	}
+	return ts.query(ctx, `WHERE workspace_id = ? ORDER BY started_at DESC, id DESC LIMIT ?`, workspaceID, limit)
+}
+
+// ListByUser 사용자의 터미널 세션 기록 조회 (최신순)
+func (ts *terminalStorage) ListByUser(ctx context.Context, userID string, limit int) ([]*models.TerminalSession, error) {
+	if limit <= 0 {
+		return ts.query(ctx, `WHERE user_id = ? ORDER BY started_at DESC, id DESC`, userID)
+	}
+	return ts.query(ctx, `WHERE user_id = ? ORDER BY started_at DESC, id DESC LIMIT ?`, userID, limit)
+}
+
+// AnonymizeByUser 사용자의 기록에서 접속 IP, User-Agent와 녹화 삭제
+func (ts *terminalStorage) AnonymizeByUser(ctx context.Context, userID string) (int, error) {
+	result, err := ts.storage.execContext(ctx, `
+		UPDATE terminal_sessions SET client_ip = NULL, user_agent = NULL, recording = NULL, recording_size = 0,
+		                             recording_truncated = 0
+		WHERE user_id = ?`, userID)
+	if err != nil {
+		return 0, storage.ConvertError(err, "anonymize terminal sessions", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, storage.ConvertError(err, "anonymize terminal sessions", "sqlite")
+	}
+	return int(affected), nil
+}
+
+// SaveRecording 녹화 데이터 저장
+func (ts *terminalStorage) SaveRecording(ctx context.Context, id string, data []byte) error {
+	result, err := ts.storage.execContext(ctx, `UPDATE terminal_sessions SET recording = ? WHERE id = ?`, data, id)
+	if err != nil {
+		return storage.ConvertError(err, "save terminal recording", "sqlite")
+	}
+	return requireAffected(result, "save terminal recording")
+}
+
+// GetRecording 녹화 데이터 조회
+func (ts *terminalStorage) GetRecording(ctx context.Context, id string) ([]byte, error) {
+	var data []byte
+	err := ts.storage.queryRowContext(ctx, `SELECT recording FROM terminal_sessions WHERE id = ?`, id).Scan(&data)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, storage.ConvertError(err, "get terminal recording", "sqlite")
+	}
+	if data == nil {
+		return nil, storage.ErrNotFound
+	}
+	return data, nil
+}
+
+// query 조건에 맞는 터미널 세션 기록 조회
+func (ts *terminalStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.TerminalSession, error) {
+	rows, err := ts.storage.queryContext(ctx, selectTerminalSessionQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list terminal sessions", "sqlite")
+	}
+	defer rows.Close()
+
+	sessions := []*models.TerminalSession{}
+	for rows.Next() {
+		var (
+			session                          models.TerminalSession
+			containerID, clientIP, userAgent sql.NullString
+			exitCode                         sql.NullInt64
+			endedAt                          sql.NullTime
+		)
+		err := rows.Scan(
+			&session.ID,
+			&session.WorkspaceID,
+			&session.UserID,
+			&session.Mode,
+			&containerID,
+			&session.Shell,
+			&session.Cols,
+			&session.Rows,
+			&session.Status,
+			&clientIP,
+			&userAgent,
+			&exitCode,
+			&session.InputBytes,
+			&session.OutputBytes,
+			&session.RecordingSize,
+			&session.RecordingTruncated,
+			&session.StartedAt,
+			&endedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan terminal session", "sqlite")
+		}
+		session.ContainerID = containerID.String
+		session.ClientIP = clientIP.String
+		session.UserAgent = userAgent.String
+		if exitCode.Valid {
+			code := int(exitCode.Int64)
+			session.ExitCode = &code
+		}
+		if endedAt.Valid {
+			session.EndedAt = &endedAt.Time
+		}
+		sessions = append(sessions, &session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list terminal sessions", "sqlite")
+	}
+	return sessions, nil
+}
@@ -167,6 +167,7 @@ func (t *transactionWorkspaceStorage) createInTx(ctx context.Context, workspace
 	}
 	workspace.CreatedAt = now
 	workspace.UpdatedAt = now
+	workspace.Version = 1
 	
 	_, err := t.execContext(ctx, insertWorkspaceQuery,
 		workspace.ID,
@@ -178,6 +179,7 @@ func (t *transactionWorkspaceStorage) createInTx(ctx context.Context, workspace
 		workspace.ActiveTasks,
 		workspace.CreatedAt,
 		workspace.UpdatedAt,
+		workspace.Version,
 	)
 	
 	return err
@@ -217,6 +219,7 @@ func (t *transactionWorkspaceStorage) scanWorkspace(row *sql.Row) (*models.Works
 		&workspace.CreatedAt,
 		&workspace.UpdatedAt,
 		&deletedAt,
+		&workspace.Version,
 	)
 	
 	if err != nil {
@@ -406,4 +409,8 @@ func (t *transactionTaskStorage) GetBySessionID(ctx context.Context, sessionID s
 
 func (t *transactionTaskStorage) GetActiveCount(ctx context.Context, sessionID string) (int64, error) {
 	return 0, fmt.Errorf("task transaction methods not implemented yet")
+}
+
+func (t *transactionTaskStorage) ListExecutedBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	return nil, fmt.Errorf("task transaction methods not implemented yet")
 }
\ No newline at end of file
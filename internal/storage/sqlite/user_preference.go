@@ -0,0 +1,56 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// userPreferenceStorage 사용자 언어 설정 SQLite 구현 (033_user_preferences.sql)
+type userPreferenceStorage struct {
+	storage *Storage
+}
+
+// newUserPreferenceStorage 새 사용자 설정 스토리지 생성
+func newUserPreferenceStorage(s *Storage) *userPreferenceStorage {
+	return &userPreferenceStorage{storage: s}
+}
+
+// Get 사용자 설정 조회
+func (ps *userPreferenceStorage) Get(ctx context.Context, userID string) (*models.UserPreference, error) {
+	var preference models.UserPreference
+	err := ps.storage.queryRowContext(ctx, `
+		SELECT user_id, response_language, locale, updated_at
+		FROM user_preferences WHERE user_id = ?`, userID).Scan(
+		&preference.UserID,
+		&preference.ResponseLanguage,
+		&preference.Locale,
+		&preference.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, storage.ErrNotFound
+	}
+	if err != nil {
+		return nil, storage.ConvertError(err, "get user preference", "sqlite")
+	}
+	return &preference, nil
+}
+
+// Save 사용자 설정 저장
+func (ps *userPreferenceStorage) Save(ctx context.Context, preference *models.UserPreference) error {
+	preference.UpdatedAt = time.Now()
+
+	_, err := ps.storage.execContext(ctx, `
+		INSERT INTO user_preferences (user_id, response_language, locale, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET
+			response_language = excluded.response_language, locale = excluded.locale, updated_at = excluded.updated_at`,
+		preference.UserID, preference.ResponseLanguage, preference.Locale, preference.UpdatedAt)
+	if err != nil {
+		return storage.ConvertError(err, "save user preference", "sqlite")
+	}
+	return nil
+}
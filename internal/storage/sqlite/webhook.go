@@ -0,0 +1,290 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// webhookMappingStorage 웹훅 매핑 SQLite 구현 (016_webhook_mappings.sql, 034_webhook_deliveries.sql)
+type webhookMappingStorage struct {
+	storage *Storage
+}
+
+// newWebhookMappingStorage 새 웹훅 매핑 스토리지 생성
+func newWebhookMappingStorage(s *Storage) *webhookMappingStorage {
+	return &webhookMappingStorage{storage: s}
+}
+
+const (
+	// 웹훅 매핑 조회 쿼리
+	selectWebhookMappingQuery = `
+		SELECT id, owner_id, name, provider, repository, workspace_id, session_id, triggers, label, command,
+			branches, allowed_senders, prompt, require_review, enabled, secret, last_delivery, created_at, updated_at
+		FROM webhook_mappings
+	`
+
+	// 웹훅 매핑 삽입 쿼리
+	insertWebhookMappingQuery = `
+		INSERT INTO webhook_mappings (id, owner_id, name, provider, repository, workspace_id, session_id, triggers, label, command,
+			branches, allowed_senders, prompt, require_review, enabled, secret, last_delivery, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	// 웹훅 매핑 수정 쿼리
+	updateWebhookMappingQuery = `
+		UPDATE webhook_mappings SET owner_id = ?, name = ?, repository = ?, workspace_id = ?, session_id = ?, triggers = ?,
+			label = ?, command = ?, branches = ?, allowed_senders = ?, prompt = ?, require_review = ?, enabled = ?,
+			secret = ?, last_delivery = ?, updated_at = ?
+		WHERE id = ?
+	`
+)
+
+// webhookMappingJSON JSON으로 저장하는 매핑 컬럼
+type webhookMappingJSON struct {
+	triggers, branches, senders string
+	lastDelivery                interface{}
+}
+
+// Create 새 매핑 생성
+func (ws *webhookMappingStorage) Create(ctx context.Context, mapping *models.WebhookMapping) error {
+	if mapping.ID == "" {
+		mapping.ID = uuid.New().String()
+	}
+	if mapping.CreatedAt.IsZero() {
+		mapping.CreatedAt = time.Now()
+	}
+	mapping.UpdatedAt = mapping.CreatedAt
+
+	columns, err := marshalWebhookMapping(mapping)
+	if err != nil {
+		return storage.ConvertError(err, "create webhook mapping", "sqlite")
+	}
+	_, err = ws.storage.execContext(ctx, insertWebhookMappingQuery,
+		mapping.ID,
+		mapping.OwnerID,
+		mapping.Name,
+		mapping.Provider,
+		mapping.Repository,
+		mapping.WorkspaceID,
+		mapping.SessionID,
+		columns.triggers,
+		mapping.Label,
+		mapping.Command,
+		columns.branches,
+		columns.senders,
+		mapping.Prompt,
+		mapping.RequireReview,
+		mapping.Enabled,
+		mapping.Secret,
+		columns.lastDelivery,
+		mapping.CreatedAt,
+		mapping.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "create webhook mapping", "sqlite")
+	}
+	return nil
+}
+
+// GetByID ID로 매핑 조회
+func (ws *webhookMappingStorage) GetByID(ctx context.Context, id string) (*models.WebhookMapping, error) {
+	mappings, err := ws.query(ctx, `WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(mappings) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return mappings[0], nil
+}
+
+// Update 매핑 저장
+func (ws *webhookMappingStorage) Update(ctx context.Context, mapping *models.WebhookMapping) error {
+	mapping.UpdatedAt = time.Now()
+
+	columns, err := marshalWebhookMapping(mapping)
+	if err != nil {
+		return storage.ConvertError(err, "update webhook mapping", "sqlite")
+	}
+	result, err := ws.storage.execContext(ctx, updateWebhookMappingQuery,
+		mapping.OwnerID,
+		mapping.Name,
+		mapping.Repository,
+		mapping.WorkspaceID,
+		mapping.SessionID,
+		columns.triggers,
+		mapping.Label,
+		mapping.Command,
+		columns.branches,
+		columns.senders,
+		mapping.Prompt,
+		mapping.RequireReview,
+		mapping.Enabled,
+		mapping.Secret,
+		columns.lastDelivery,
+		mapping.UpdatedAt,
+		mapping.ID,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "update webhook mapping", "sqlite")
+	}
+	return requireAffected(result, "update webhook mapping")
+}
+
+// Delete 매핑 삭제
+func (ws *webhookMappingStorage) Delete(ctx context.Context, id string) error {
+	result, err := ws.storage.execContext(ctx, `DELETE FROM webhook_mappings WHERE id = ?`, id)
+	if err != nil {
+		return storage.ConvertError(err, "delete webhook mapping", "sqlite")
+	}
+	return requireAffected(result, "delete webhook mapping")
+}
+
+// ListByOwner 사용자의 매핑 조회 (이름순)
+func (ws *webhookMappingStorage) ListByOwner(ctx context.Context, ownerID string) ([]*models.WebhookMapping, error) {
+	if ownerID == "" {
+		return ws.query(ctx, `ORDER BY name, id`)
+	}
+	return ws.query(ctx, `WHERE owner_id = ? ORDER BY name, id`, ownerID)
+}
+
+// RecordDelivery 매핑이 처리한 전송 식별자 기록
+func (ws *webhookMappingStorage) RecordDelivery(ctx context.Context, mappingID string, keys []string, since time.Time) (bool, error) {
+	tx, err := ws.storage.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, storage.ConvertError(err, "begin record webhook delivery", "sqlite")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM webhook_deliveries WHERE mapping_id = ? AND received_at < ?`, mappingID, since); err != nil {
+		return false, storage.ConvertError(err, "prune webhook deliveries", "sqlite")
+	}
+	for _, key := range keys {
+		var exists bool
+		err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM webhook_deliveries WHERE mapping_id = ? AND delivery_key = ?)`, mappingID, key).Scan(&exists)
+		if err != nil {
+			return false, storage.ConvertError(err, "check webhook delivery", "sqlite")
+		}
+		if exists {
+			return false, nil
+		}
+	}
+	now := time.Now()
+	for _, key := range keys {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO webhook_deliveries (mapping_id, delivery_key, received_at) VALUES (?, ?, ?)`, mappingID, key, now); err != nil {
+			return false, storage.ConvertError(err, "record webhook delivery", "sqlite")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return false, storage.ConvertError(err, "commit record webhook delivery", "sqlite")
+	}
+	return true, nil
+}
+
+// query 조건에 맞는 매핑 조회
+func (ws *webhookMappingStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.WebhookMapping, error) {
+	rows, err := ws.storage.queryContext(ctx, selectWebhookMappingQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list webhook mappings", "sqlite")
+	}
+	defer rows.Close()
+
+	mappings := []*models.WebhookMapping{}
+	for rows.Next() {
+		mapping, err := scanWebhookMapping(rows)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan webhook mapping", "sqlite")
+		}
+		mappings = append(mappings, mapping)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list webhook mappings", "sqlite")
+	}
+	return mappings, nil
+}
+
+// marshalWebhookMapping 목록 필드와 마지막 수신 기록을 JSON 문자열로 변환
+func marshalWebhookMapping(mapping *models.WebhookMapping) (*webhookMappingJSON, error) {
+	var (
+		columns webhookMappingJSON
+		err     error
+	)
+	if columns.triggers, err = marshalPipelineJSON(mapping.Triggers); err != nil {
+		return nil, err
+	}
+	if columns.branches, err = marshalPipelineJSON(mapping.Branches); err != nil {
+		return nil, err
+	}
+	if columns.senders, err = marshalPipelineJSON(mapping.AllowedSenders); err != nil {
+		return nil, err
+	}
+	if mapping.LastDelivery != nil {
+		data, err := json.Marshal(mapping.LastDelivery)
+		if err != nil {
+			return nil, err
+		}
+		columns.lastDelivery = string(data)
+	}
+	return &columns, nil
+}
+
+// scanWebhookMapping 조회 결과 행을 매핑으로 변환
+func scanWebhookMapping(rows *sql.Rows) (*models.WebhookMapping, error) {
+	var (
+		mapping                           models.WebhookMapping
+		sessionID, label, command, prompt sql.NullString
+		lastDelivery                      sql.NullString
+		triggers, branches, senders       string
+	)
+	err := rows.Scan(
+		&mapping.ID,
+		&mapping.OwnerID,
+		&mapping.Name,
+		&mapping.Provider,
+		&mapping.Repository,
+		&mapping.WorkspaceID,
+		&sessionID,
+		&triggers,
+		&label,
+		&command,
+		&branches,
+		&senders,
+		&prompt,
+		&mapping.RequireReview,
+		&mapping.Enabled,
+		&mapping.Secret,
+		&lastDelivery,
+		&mapping.CreatedAt,
+		&mapping.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	mapping.SessionID = sessionID.String
+	mapping.Label = label.String
+	mapping.Command = command.String
+	mapping.Prompt = prompt.String
+	if err := json.Unmarshal([]byte(triggers), &mapping.Triggers); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(branches), &mapping.Branches); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(senders), &mapping.AllowedSenders); err != nil {
+		return nil, err
+	}
+	if lastDelivery.Valid {
+		mapping.LastDelivery = &models.WebhookDeliveryResult{}
+		if err := json.Unmarshal([]byte(lastDelivery.String), mapping.LastDelivery); err != nil {
+			return nil, err
+		}
+	}
+	return &mapping, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aicli/aicli-web/internal/models"
 	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/query"
 )
 
 // workspaceStorage 워크스페이스 스토리지 SQLite 구현
@@ -25,19 +26,22 @@ const (
 	// 워크스페이스 조회 쿼리
 	selectWorkspaceQuery = `
 		SELECT id, name, project_path, status, owner_id, claude_key, 
-		       active_tasks, created_at, updated_at, deleted_at
+		       active_tasks, created_at, updated_at, deleted_at, version
 		FROM workspaces
 	`
 	
 	// 워크스페이스 삽입 쿼리
 	insertWorkspaceQuery = `
 		INSERT INTO workspaces (id, name, project_path, status, owner_id, claude_key, 
-		                       active_tasks, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		                       active_tasks, created_at, updated_at, version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	
 	// 워크스페이스 업데이트 쿼리 베이스
-	updateWorkspaceQueryBase = `UPDATE workspaces SET updated_at = ? `
+	updateWorkspaceQueryBase = `UPDATE workspaces SET `
+	
+	// 버전 충돌 확인용 현재 버전 조회 쿼리
+	selectWorkspaceVersionQuery = `SELECT version FROM workspaces WHERE id = ? AND deleted_at IS NULL`
 	
 	// 워크스페이스 삭제 쿼리 (soft delete)
 	deleteWorkspaceQuery = `
@@ -66,6 +70,7 @@ func (w *workspaceStorage) Create(ctx context.Context, workspace *models.Workspa
 	}
 	workspace.CreatedAt = now
 	workspace.UpdatedAt = now
+	workspace.Version = 1
 	
 	// 워크스페이스 삽입
 	_, err := w.storage.execContext(ctx, insertWorkspaceQuery,
@@ -78,6 +83,7 @@ func (w *workspaceStorage) Create(ctx context.Context, workspace *models.Workspa
 		workspace.ActiveTasks,
 		workspace.CreatedAt,
 		workspace.UpdatedAt,
+		workspace.Version,
 	)
 	
 	if err != nil {
@@ -136,15 +142,15 @@ func (w *workspaceStorage) GetByOwnerID(ctx context.Context, ownerID string, pag
 		return nil, 0, storage.ConvertError(err, "count workspaces by owner", "sqlite")
 	}
 	
-	// 데이터 조회
-	query := selectWorkspaceQuery + `
-		WHERE owner_id = ? AND deleted_at IS NULL 
-		ORDER BY ` + pagination.Sort + ` ` + pagination.Order + `
-		LIMIT ? OFFSET ?
-	`
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(pagination, models.WorkspaceSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectWorkspaceQuery + page.AppendWhere(` WHERE owner_id = ? AND deleted_at IS NULL`) + page.Tail
+	args := append([]interface{}{ownerID}, page.WhereArgs...)
 	
-	rows, err := w.storage.queryContext(ctx, query, 
-		ownerID, pagination.Limit, pagination.GetOffset())
+	rows, err := w.storage.queryContext(ctx, query, append(args, page.TailArgs...)...)
 	if err != nil {
 		return nil, 0, storage.ConvertError(err, "get workspaces by owner", "sqlite")
 	}
@@ -167,6 +173,7 @@ func (w *workspaceStorage) Update(ctx context.Context, id string, updates map[st
 	// 허용된 업데이트 필드들
 	allowedFields := map[string]bool{
 		"name":         true,
+		"owner_id":     true,
 		"project_path": true,
 		"status":       true,
 		"claude_key":   true,
@@ -177,12 +184,15 @@ func (w *workspaceStorage) Update(ctx context.Context, id string, updates map[st
 	var setParts []string
 	var args []interface{}
 	
-	// updated_at은 항상 업데이트
-	setParts = append(setParts, "updated_at = ?")
+	// updated_at과 버전은 항상 업데이트
+	setParts = append(setParts, "updated_at = ?", "version = version + 1")
 	args = append(args, time.Now())
 	
 	// 업데이트할 필드들 처리
 	for field, value := range updates {
+		if field == "updated_at" || field == storage.VersionKey {
+			continue
+		}
 		if !allowedFields[field] {
 			return fmt.Errorf("field '%s' is not allowed for update", field)
 		}
@@ -196,6 +206,13 @@ func (w *workspaceStorage) Update(ctx context.Context, id string, updates map[st
 		" WHERE id = ? AND deleted_at IS NULL"
 	args = append(args, id)
 	
+	// 기대 버전이 있으면 저장된 버전이 같을 때만 수정 (compare-and-swap)
+	expected, checkVersion := storage.ExpectedVersion(updates)
+	if checkVersion {
+		query += " AND version = ?"
+		args = append(args, expected)
+	}
+	
 	result, err := w.storage.execContext(ctx, query, args...)
 	if err != nil {
 		return storage.ConvertError(err, "update workspace", "sqlite")
@@ -208,12 +225,31 @@ func (w *workspaceStorage) Update(ctx context.Context, id string, updates map[st
 	}
 	
 	if rowsAffected == 0 {
+		if checkVersion {
+			return w.versionConflict(ctx, id, expected)
+		}
 		return storage.ErrNotFound
 	}
 	
 	return nil
 }
 
+// versionConflict 수정된 행이 없을 때 버전 충돌인지 삭제된 것인지 구분합니다
+func (w *workspaceStorage) versionConflict(ctx context.Context, id string, expected int) error {
+	var current int
+	err := w.storage.queryRowContext(ctx, selectWorkspaceVersionQuery, id).Scan(&current)
+	if err == sql.ErrNoRows {
+		return storage.ErrNotFound
+	}
+	if err != nil {
+		return storage.ConvertError(err, "get workspace version", "sqlite")
+	}
+	if err := storage.CheckVersion("workspace", id, expected, current); err != nil {
+		return err
+	}
+	return storage.ErrNotFound
+}
+
 // Delete 워크스페이스 삭제 (soft delete)
 func (w *workspaceStorage) Delete(ctx context.Context, id string) error {
 	now := time.Now()
@@ -251,14 +287,14 @@ func (w *workspaceStorage) List(ctx context.Context, pagination *models.Paginati
 		return nil, 0, storage.ConvertError(err, "count all workspaces", "sqlite")
 	}
 	
-	// 데이터 조회
-	query := selectWorkspaceQuery + `
-		WHERE deleted_at IS NULL 
-		ORDER BY ` + pagination.Sort + ` ` + pagination.Order + `
-		LIMIT ? OFFSET ?
-	`
+	// 데이터 조회 (허용된 정렬 필드 + 커서 페이지네이션)
+	page, err := query.BuildPageClause(pagination, models.WorkspaceSortFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := selectWorkspaceQuery + page.AppendWhere(` WHERE deleted_at IS NULL`) + page.Tail
 	
-	rows, err := w.storage.queryContext(ctx, query, pagination.Limit, pagination.GetOffset())
+	rows, err := w.storage.queryContext(ctx, query, append(page.WhereArgs, page.TailArgs...)...)
 	if err != nil {
 		return nil, 0, storage.ConvertError(err, "list all workspaces", "sqlite")
 	}
@@ -317,6 +353,7 @@ func (w *workspaceStorage) scanWorkspace(row *sql.Row) (*models.Workspace, error
 		&workspace.CreatedAt,
 		&workspace.UpdatedAt,
 		&deletedAt,
+		&workspace.Version,
 	)
 	
 	if err != nil {
@@ -354,6 +391,7 @@ func (w *workspaceStorage) scanWorkspaces(rows *sql.Rows) ([]*models.Workspace,
 			&workspace.CreatedAt,
 			&workspace.UpdatedAt,
 			&deletedAt,
+			&workspace.Version,
 		)
 		
 		if err != nil {
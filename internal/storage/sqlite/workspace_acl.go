@@ -0,0 +1,151 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// workspaceACLStorage 워크스페이스 공유 ACL SQLite 구현 (011_workspace_acl.sql)
+type workspaceACLStorage struct {
+	storage *Storage
+}
+
+// newWorkspaceACLStorage 새 ACL 스토리지 생성
+func newWorkspaceACLStorage(s *Storage) *workspaceACLStorage {
+	return &workspaceACLStorage{storage: s}
+}
+
+const (
+	// ACL 항목 조회 쿼리
+	selectWorkspaceACLQuery = `
+		SELECT id, workspace_id, principal_type, principal_id, permission, granted_by, created_at, updated_at
+		FROM workspace_acl
+	`
+
+	// ACL 항목 추가/권한 변경 쿼리
+	upsertWorkspaceACLQuery = `
+		INSERT INTO workspace_acl (id, workspace_id, principal_type, principal_id, permission, granted_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (workspace_id, principal_type, principal_id)
+		DO UPDATE SET permission = excluded.permission, granted_by = excluded.granted_by, updated_at = excluded.updated_at
+	`
+)
+
+// Upsert 워크스페이스와 대상이 같은 항목이 있으면 권한을 바꾸고 없으면 추가
+func (as *workspaceACLStorage) Upsert(ctx context.Context, entry *models.WorkspaceACLEntry) error {
+	now := time.Now()
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+	entry.CreatedAt = now
+	entry.UpdatedAt = now
+
+	_, err := as.storage.execContext(ctx, upsertWorkspaceACLQuery,
+		entry.ID,
+		entry.WorkspaceID,
+		entry.PrincipalType,
+		entry.PrincipalID,
+		entry.Permission,
+		entry.GrantedBy,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "upsert workspace acl", "sqlite")
+	}
+
+	// 기존 항목을 갱신했으면 저장된 ID와 생성 시각을 돌려줌
+	row := as.storage.queryRowContext(ctx,
+		`SELECT id, created_at FROM workspace_acl WHERE workspace_id = ? AND principal_type = ? AND principal_id = ?`,
+		entry.WorkspaceID, entry.PrincipalType, entry.PrincipalID)
+	if err := row.Scan(&entry.ID, &entry.CreatedAt); err != nil {
+		return storage.ConvertError(err, "upsert workspace acl", "sqlite")
+	}
+	return nil
+}
+
+// Delete 항목 삭제
+func (as *workspaceACLStorage) Delete(ctx context.Context, workspaceID string, principalType models.ACLPrincipalType, principalID string) error {
+	result, err := as.storage.execContext(ctx,
+		`DELETE FROM workspace_acl WHERE workspace_id = ? AND principal_type = ? AND principal_id = ?`,
+		workspaceID, principalType, principalID)
+	if err != nil {
+		return storage.ConvertError(err, "delete workspace acl", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "delete workspace acl", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByWorkspace 워크스페이스의 모든 항목 삭제
+func (as *workspaceACLStorage) DeleteByWorkspace(ctx context.Context, workspaceID string) error {
+	if _, err := as.storage.execContext(ctx, `DELETE FROM workspace_acl WHERE workspace_id = ?`, workspaceID); err != nil {
+		return storage.ConvertError(err, "delete workspace acl", "sqlite")
+	}
+	return nil
+}
+
+// ListByWorkspace 워크스페이스의 항목 조회 (대상 종류, 대상 ID순)
+func (as *workspaceACLStorage) ListByWorkspace(ctx context.Context, workspaceID string) ([]*models.WorkspaceACLEntry, error) {
+	return as.query(ctx, `WHERE workspace_id = ? ORDER BY principal_type, principal_id`, workspaceID)
+}
+
+// ListByPrincipals 대상 중 하나에게 부여된 모든 항목 조회
+func (as *workspaceACLStorage) ListByPrincipals(ctx context.Context, principals []models.ACLPrincipal) ([]*models.WorkspaceACLEntry, error) {
+	if len(principals) == 0 {
+		return []*models.WorkspaceACLEntry{}, nil
+	}
+
+	conditions := make([]string, 0, len(principals))
+	args := make([]interface{}, 0, len(principals)*2)
+	for _, principal := range principals {
+		conditions = append(conditions, "(principal_type = ? AND principal_id = ?)")
+		args = append(args, principal.Type, principal.ID)
+	}
+	return as.query(ctx,
+		`WHERE `+strings.Join(conditions, " OR ")+` ORDER BY workspace_id, principal_type, principal_id`,
+		args...)
+}
+
+// query 조건에 맞는 ACL 항목 조회
+func (as *workspaceACLStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.WorkspaceACLEntry, error) {
+	rows, err := as.storage.queryContext(ctx, selectWorkspaceACLQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list workspace acl", "sqlite")
+	}
+	defer rows.Close()
+
+	entries := []*models.WorkspaceACLEntry{}
+	for rows.Next() {
+		var entry models.WorkspaceACLEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.WorkspaceID,
+			&entry.PrincipalType,
+			&entry.PrincipalID,
+			&entry.Permission,
+			&entry.GrantedBy,
+			&entry.CreatedAt,
+			&entry.UpdatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan workspace acl", "sqlite")
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list workspace acl", "sqlite")
+	}
+	return entries, nil
+}
@@ -0,0 +1,228 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// workspaceEnvStorage 워크스페이스 환경 변수 SQLite 구현 (030_workspace_env.sql)
+type workspaceEnvStorage struct {
+	storage *Storage
+}
+
+// newWorkspaceEnvStorage 새 환경 변수 스토리지 생성
+func newWorkspaceEnvStorage(s *Storage) *workspaceEnvStorage {
+	return &workspaceEnvStorage{storage: s}
+}
+
+const (
+	// 환경 변수 조회 쿼리
+	selectWorkspaceEnvQuery = `
+		SELECT workspace_id, key, value, secret, updated_by, created_at, updated_at
+		FROM workspace_env_vars
+	`
+
+	// 변경 기록 조회 쿼리
+	selectWorkspaceEnvChangeQuery = `
+		SELECT id, workspace_id, key, action, secret, value_changed, actor_id, created_at
+		FROM workspace_env_changes
+	`
+)
+
+// Create 변수 추가
+func (es *workspaceEnvStorage) Create(ctx context.Context, variable *models.WorkspaceEnvVar) error {
+	now := time.Now()
+	variable.CreatedAt = now
+	variable.UpdatedAt = now
+
+	_, err := es.storage.execContext(ctx, `
+		INSERT INTO workspace_env_vars (workspace_id, key, value, secret, updated_by, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		variable.WorkspaceID,
+		variable.Key,
+		variable.Value,
+		variable.Secret,
+		variable.UpdatedBy,
+		variable.CreatedAt,
+		variable.UpdatedAt,
+	)
+	if err != nil {
+		err = storage.ConvertError(err, "create workspace env", "sqlite")
+		if storage.IsAlreadyExistsError(err) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// Get 변수 조회
+func (es *workspaceEnvStorage) Get(ctx context.Context, workspaceID, key string) (*models.WorkspaceEnvVar, error) {
+	variables, err := es.query(ctx, `WHERE workspace_id = ? AND key = ?`, workspaceID, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(variables) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return variables[0], nil
+}
+
+// Update 변수의 값, 비밀 여부, 변경한 사용자 저장
+func (es *workspaceEnvStorage) Update(ctx context.Context, variable *models.WorkspaceEnvVar) error {
+	variable.UpdatedAt = time.Now()
+
+	result, err := es.storage.execContext(ctx, `
+		UPDATE workspace_env_vars SET value = ?, secret = ?, updated_by = ?, updated_at = ?
+		WHERE workspace_id = ? AND key = ?`,
+		variable.Value, variable.Secret, variable.UpdatedBy, variable.UpdatedAt,
+		variable.WorkspaceID, variable.Key)
+	if err != nil {
+		return storage.ConvertError(err, "update workspace env", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "update workspace env", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// Delete 변수 삭제
+func (es *workspaceEnvStorage) Delete(ctx context.Context, workspaceID, key string) error {
+	result, err := es.storage.execContext(ctx,
+		`DELETE FROM workspace_env_vars WHERE workspace_id = ? AND key = ?`, workspaceID, key)
+	if err != nil {
+		return storage.ConvertError(err, "delete workspace env", "sqlite")
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return storage.ConvertError(err, "delete workspace env", "sqlite")
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByWorkspace 워크스페이스의 모든 변수 삭제
+func (es *workspaceEnvStorage) DeleteByWorkspace(ctx context.Context, workspaceID string) error {
+	if _, err := es.storage.execContext(ctx, `DELETE FROM workspace_env_vars WHERE workspace_id = ?`, workspaceID); err != nil {
+		return storage.ConvertError(err, "delete workspace env", "sqlite")
+	}
+	return nil
+}
+
+// List 워크스페이스의 변수 조회 (이름순)
+func (es *workspaceEnvStorage) List(ctx context.Context, workspaceID string) ([]*models.WorkspaceEnvVar, error) {
+	return es.query(ctx, `WHERE workspace_id = ? ORDER BY key`, workspaceID)
+}
+
+// AddChange 변경 기록 추가
+func (es *workspaceEnvStorage) AddChange(ctx context.Context, change *models.WorkspaceEnvChange) error {
+	if change.ID == "" {
+		change.ID = uuid.New().String()
+	}
+	if change.CreatedAt.IsZero() {
+		change.CreatedAt = time.Now()
+	}
+
+	_, err := es.storage.execContext(ctx, `
+		INSERT INTO workspace_env_changes (id, workspace_id, key, action, secret, value_changed, actor_id, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		change.ID,
+		change.WorkspaceID,
+		change.Key,
+		change.Action,
+		change.Secret,
+		change.ValueChanged,
+		change.ActorID,
+		change.CreatedAt,
+	)
+	if err != nil {
+		return storage.ConvertError(err, "add workspace env change", "sqlite")
+	}
+	return nil
+}
+
+// ListChanges 워크스페이스의 변경 기록 조회 (최신순)
+func (es *workspaceEnvStorage) ListChanges(ctx context.Context, workspaceID, key string, limit int) ([]*models.WorkspaceEnvChange, error) {
+	clause := `WHERE workspace_id = ?`
+	args := []interface{}{workspaceID}
+	if key != "" {
+		clause += ` AND key = ?`
+		args = append(args, key)
+	}
+	clause += ` ORDER BY created_at DESC, rowid DESC`
+	if limit > 0 {
+		clause += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := es.storage.queryContext(ctx, selectWorkspaceEnvChangeQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list workspace env changes", "sqlite")
+	}
+	defer rows.Close()
+
+	changes := []*models.WorkspaceEnvChange{}
+	for rows.Next() {
+		var change models.WorkspaceEnvChange
+		err := rows.Scan(
+			&change.ID,
+			&change.WorkspaceID,
+			&change.Key,
+			&change.Action,
+			&change.Secret,
+			&change.ValueChanged,
+			&change.ActorID,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan workspace env change", "sqlite")
+		}
+		changes = append(changes, &change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list workspace env changes", "sqlite")
+	}
+	return changes, nil
+}
+
+// query 조건에 맞는 변수 조회
+func (es *workspaceEnvStorage) query(ctx context.Context, clause string, args ...interface{}) ([]*models.WorkspaceEnvVar, error) {
+	rows, err := es.storage.queryContext(ctx, selectWorkspaceEnvQuery+clause, args...)
+	if err != nil {
+		return nil, storage.ConvertError(err, "list workspace env", "sqlite")
+	}
+	defer rows.Close()
+
+	variables := []*models.WorkspaceEnvVar{}
+	for rows.Next() {
+		var variable models.WorkspaceEnvVar
+		err := rows.Scan(
+			&variable.WorkspaceID,
+			&variable.Key,
+			&variable.Value,
+			&variable.Secret,
+			&variable.UpdatedBy,
+			&variable.CreatedAt,
+			&variable.UpdatedAt,
+		)
+		if err != nil {
+			return nil, storage.ConvertError(err, "scan workspace env", "sqlite")
+		}
+		variables = append(variables, &variable)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storage.ConvertError(err, "list workspace env", "sqlite")
+	}
+	return variables, nil
+}
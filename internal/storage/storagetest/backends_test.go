@@ -0,0 +1,103 @@
+package storagetest
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/storage"
+	"github.com/aicli/aicli-web/internal/storage/memory"
+	"github.com/aicli/aicli-web/internal/storage/postgres"
+	"github.com/aicli/aicli-web/internal/storage/sqlite"
+)
+
+func TestMemoryConformance(t *testing.T) {
+	Run(t, func(t *testing.T) storage.Storage {
+		return memory.New()
+	})
+}
+
+func TestSQLiteConformance(t *testing.T) {
+	Run(t, func(t *testing.T) storage.Storage {
+		dataSource := filepath.Join(t.TempDir(), "conformance.db")
+		applySQLiteSchema(t, dataSource)
+
+		config := sqlite.DefaultConfig()
+		config.DataSource = dataSource
+		s, err := sqlite.New(config)
+		require.NoError(t, err)
+		t.Cleanup(func() { s.Close() })
+		return s
+	})
+}
+
+// TestPostgresConformance AICLI_STORAGE_TEST_POSTGRES_DSN이 가리키는 데이터베이스에서 실행합니다
+// 테스트 바이너리에 등록된 드라이버 이름은 AICLI_STORAGE_TEST_POSTGRES_DRIVER로 바꿀 수 있습니다 (기본값: pgx).
+// 테스트마다 테이블을 비우므로 전용 데이터베이스를 사용하세요.
+func TestPostgresConformance(t *testing.T) {
+	dsn := ExternalDSN(t, "postgres")
+	driver := os.Getenv("AICLI_STORAGE_TEST_POSTGRES_DRIVER")
+	if driver == "" {
+		driver = postgres.DefaultConfig().Driver
+	}
+	if !isDriverRegistered(driver) {
+		t.Skipf("database/sql driver %q is not registered in this test binary", driver)
+	}
+
+	Run(t, func(t *testing.T) storage.Storage {
+		config := postgres.DefaultConfig()
+		config.Driver = driver
+		config.DataSource = dsn
+		s, err := postgres.New(config)
+		require.NoError(t, err)
+		t.Cleanup(func() { s.Close() })
+
+		db, err := sql.Open(driver, dsn)
+		require.NoError(t, err)
+		defer db.Close()
+		_, err = db.Exec("TRUNCATE workspaces, projects, sessions, tasks CASCADE")
+		require.NoError(t, err)
+		return s
+	})
+}
+
+func isDriverRegistered(name string) bool {
+	for _, driver := range sql.Drivers() {
+		if driver == name {
+			return true
+		}
+	}
+	return false
+}
+
+// applySQLiteSchema 스키마 파일을 버전 순서대로 적용합니다
+// 드라이버가 FTS5 없이 빌드되었으면 전문 검색 인덱스는 건너뜁니다 (메시지 검색은 LIKE 조회로 동작).
+func applySQLiteSchema(t *testing.T, dataSource string) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join("..", "schema", "sqlite", "[0-9]*.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	sort.Strings(files)
+
+	db, err := sql.Open("sqlite3", dataSource)
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		if _, err := db.Exec(string(content)); err != nil {
+			if strings.Contains(err.Error(), "no such module: fts5") {
+				t.Logf("skipping %s: %v", filepath.Base(file), err)
+				continue
+			}
+			require.NoError(t, err, file)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+const workers = 10
+
+// parallel n개의 작업을 동시에 실행하고 각 결과 에러를 반환합니다
+func parallel(n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			errs[i] = fn(i)
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	return errs
+}
+
+func testConcurrency(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := uuid.New().String()
+
+	t.Run("DistinctCreates", func(t *testing.T) {
+		errs := parallel(workers, func(i int) error {
+			return s.Workspace().Create(ctx, newWorkspace(ownerID, fmt.Sprintf("ws-%d", i)))
+		})
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		count, err := s.Workspace().CountByOwner(ctx, ownerID)
+		require.NoError(t, err)
+		assert.Equal(t, workers, count)
+	})
+
+	t.Run("SameNameCreates", func(t *testing.T) {
+		errs := parallel(workers, func(int) error {
+			return s.Workspace().Create(ctx, newWorkspace(ownerID, "contended"))
+		})
+		created := 0
+		for _, err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+			assert.True(t, storage.IsAlreadyExistsError(err), "duplicate name: %v", err)
+		}
+		assert.Equal(t, 1, created)
+	})
+
+	t.Run("CompareAndSwap", func(t *testing.T) {
+		ws := newWorkspace(ownerID, "cas")
+		require.NoError(t, s.Workspace().Create(ctx, ws))
+
+		// 같은 버전을 기준으로 한 동시 수정은 하나만 성공
+		errs := parallel(workers, func(i int) error {
+			return s.Workspace().Update(ctx, ws.ID, map[string]interface{}{
+				"project_path":     fmt.Sprintf("/tmp/cas-%d", i),
+				storage.VersionKey: 1,
+			})
+		})
+		updated := 0
+		for _, err := range errs {
+			if err == nil {
+				updated++
+				continue
+			}
+			var conflict *storage.ConflictError
+			if assert.True(t, errors.As(err, &conflict), "stale update: %v", err) {
+				assert.Equal(t, 1, conflict.ExpectedVersion)
+			}
+		}
+		assert.Equal(t, 1, updated)
+
+		got, err := s.Workspace().GetByID(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, got.Version)
+	})
+}
+
+func testTransaction(t *testing.T, s storage.TransactionalStorage) {
+	ctx := context.Background()
+	ownerID := uuid.New().String()
+
+	t.Run("Commit", func(t *testing.T) {
+		ws := newWorkspace(ownerID, "committed")
+		require.NoError(t, s.WithTx(ctx, func(tx storage.Transaction) error {
+			return tx.Workspace().Create(tx.Context(), ws)
+		}))
+
+		got, err := s.Workspace().GetByID(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "committed", got.Name)
+	})
+
+	t.Run("RollbackOnError", func(t *testing.T) {
+		ws := newWorkspace(ownerID, "rolled-back")
+		errAbort := errors.New("abort")
+		err := s.WithTx(ctx, func(tx storage.Transaction) error {
+			if err := tx.Workspace().Create(tx.Context(), ws); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		assert.ErrorIs(t, err, errAbort)
+
+		_, err = s.Workspace().GetByID(ctx, ws.ID)
+		assert.True(t, storage.IsNotFoundError(err), "rolled back workspace: %v", err)
+	})
+
+	t.Run("ExplicitRollback", func(t *testing.T) {
+		tx, err := s.BeginTx(ctx)
+		require.NoError(t, err)
+
+		ws := newWorkspace(ownerID, "explicit")
+		require.NoError(t, tx.Workspace().Create(tx.Context(), ws))
+		require.NoError(t, tx.Rollback())
+		assert.True(t, tx.IsClosed())
+		assert.Error(t, tx.Commit())
+
+		_, err = s.Workspace().GetByID(ctx, ws.ID)
+		assert.True(t, storage.IsNotFoundError(err), "rolled back workspace: %v", err)
+	})
+}
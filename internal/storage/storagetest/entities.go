@@ -0,0 +1,469 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/models"
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+func page(n, limit int) *models.PaginationRequest {
+	return &models.PaginationRequest{Page: n, Limit: limit, Sort: "created_at", Order: "desc"}
+}
+
+func newWorkspace(ownerID, name string) *models.Workspace {
+	return &models.Workspace{
+		ID:          uuid.New().String(),
+		Name:        name,
+		ProjectPath: "/tmp/" + name,
+		OwnerID:     ownerID,
+	}
+}
+
+// seedSession 워크스페이스, 프로젝트, 세션을 차례로 만듭니다
+func seedSession(t *testing.T, s storage.Storage) (*models.Workspace, *models.Project, *models.Session) {
+	t.Helper()
+	ctx := context.Background()
+
+	ws := newWorkspace(uuid.New().String(), "seed")
+	require.NoError(t, s.Workspace().Create(ctx, ws))
+
+	project := &models.Project{
+		ID:          uuid.New().String(),
+		WorkspaceID: ws.ID,
+		Name:        "seed-project",
+		Path:        "/tmp/seed/" + uuid.New().String(),
+	}
+	require.NoError(t, s.Project().Create(ctx, project))
+
+	session := &models.Session{
+		BaseModel: models.BaseModel{ID: uuid.New().String()},
+		ProjectID: project.ID,
+		Status:    models.SessionPending,
+	}
+	require.NoError(t, s.Session().Create(ctx, session))
+	return ws, project, session
+}
+
+func testWorkspace(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := uuid.New().String()
+
+	ws := newWorkspace(ownerID, "alpha")
+	require.NoError(t, s.Workspace().Create(ctx, ws))
+	assert.Equal(t, 1, ws.Version)
+	assert.Equal(t, models.WorkspaceStatusActive, ws.Status)
+	assert.False(t, ws.CreatedAt.IsZero())
+
+	got, err := s.Workspace().GetByID(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "alpha", got.Name)
+	assert.Equal(t, ownerID, got.OwnerID)
+
+	got, err = s.Workspace().GetByName(ctx, ownerID, "alpha")
+	require.NoError(t, err)
+	assert.Equal(t, ws.ID, got.ID)
+
+	_, err = s.Workspace().GetByID(ctx, uuid.New().String())
+	assert.True(t, storage.IsNotFoundError(err), "missing workspace: %v", err)
+
+	// 같은 소유자 안에서 이름은 유일
+	err = s.Workspace().Create(ctx, newWorkspace(ownerID, "alpha"))
+	assert.True(t, storage.IsAlreadyExistsError(err), "duplicate name: %v", err)
+	require.NoError(t, s.Workspace().Create(ctx, newWorkspace(uuid.New().String(), "alpha")))
+
+	exists, err := s.Workspace().ExistsByName(ctx, ownerID, "alpha")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// 수정할 때마다 버전이 오르고, 오래된 버전으로 수정하면 충돌
+	require.NoError(t, s.Workspace().Update(ctx, ws.ID, map[string]interface{}{
+		"name":             "beta",
+		storage.VersionKey: 1,
+	}))
+	got, err = s.Workspace().GetByID(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "beta", got.Name)
+	assert.Equal(t, 2, got.Version)
+
+	err = s.Workspace().Update(ctx, ws.ID, map[string]interface{}{"name": "gamma", storage.VersionKey: 1})
+	assert.True(t, storage.IsConflictError(err), "stale version: %v", err)
+
+	err = s.Workspace().Update(ctx, uuid.New().String(), map[string]interface{}{"name": "gamma"})
+	assert.True(t, storage.IsNotFoundError(err), "update missing workspace: %v", err)
+
+	// 소유자별 목록과 페이지네이션
+	for _, name := range []string{"one", "two", "three"} {
+		require.NoError(t, s.Workspace().Create(ctx, newWorkspace(ownerID, name)))
+	}
+	count, err := s.Workspace().CountByOwner(ctx, ownerID)
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	items, total, err := s.Workspace().GetByOwnerID(ctx, ownerID, page(1, 3))
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+	assert.Len(t, items, 3)
+	items, _, err = s.Workspace().GetByOwnerID(ctx, ownerID, page(2, 3))
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	// 삭제한 워크스페이스는 조회되지 않고 이름을 다시 쓸 수 있음
+	require.NoError(t, s.Workspace().Delete(ctx, ws.ID))
+	_, err = s.Workspace().GetByID(ctx, ws.ID)
+	assert.True(t, storage.IsNotFoundError(err), "deleted workspace: %v", err)
+	err = s.Workspace().Delete(ctx, ws.ID)
+	assert.True(t, storage.IsNotFoundError(err), "delete twice: %v", err)
+
+	_, total, err = s.Workspace().GetByOwnerID(ctx, ownerID, page(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.NoError(t, s.Workspace().Create(ctx, newWorkspace(ownerID, "beta")))
+}
+
+func testProject(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ws := newWorkspace(uuid.New().String(), "projects")
+	require.NoError(t, s.Workspace().Create(ctx, ws))
+
+	project := &models.Project{
+		ID:          uuid.New().String(),
+		WorkspaceID: ws.ID,
+		Name:        "api",
+		Path:        "/tmp/projects/api",
+		Description: "API server",
+	}
+	require.NoError(t, s.Project().Create(ctx, project))
+
+	got, err := s.Project().GetByID(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "api", got.Name)
+	assert.Equal(t, "API server", got.Description)
+
+	got, err = s.Project().GetByPath(ctx, "/tmp/projects/api")
+	require.NoError(t, err)
+	assert.Equal(t, project.ID, got.ID)
+
+	_, err = s.Project().GetByID(ctx, uuid.New().String())
+	assert.True(t, storage.IsNotFoundError(err), "missing project: %v", err)
+
+	exists, err := s.Project().ExistsByName(ctx, ws.ID, "api")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = s.Project().ExistsByName(ctx, ws.ID, "web")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, s.Project().Update(ctx, project.ID, map[string]interface{}{"description": "updated"}))
+	got, err = s.Project().GetByID(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", got.Description)
+
+	require.NoError(t, s.Project().Create(ctx, &models.Project{
+		ID:          uuid.New().String(),
+		WorkspaceID: ws.ID,
+		Name:        "web",
+		Path:        "/tmp/projects/web",
+	}))
+	projects, total, err := s.Project().GetByWorkspaceID(ctx, ws.ID, page(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, projects, 2)
+
+	require.NoError(t, s.Project().Delete(ctx, project.ID))
+	_, err = s.Project().GetByID(ctx, project.ID)
+	assert.True(t, storage.IsNotFoundError(err), "deleted project: %v", err)
+	_, total, err = s.Project().GetByWorkspaceID(ctx, ws.ID, page(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+}
+
+func testSession(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	_, project, session := seedSession(t, s)
+
+	got, err := s.Session().GetByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, project.ID, got.ProjectID)
+	assert.Equal(t, models.SessionPending, got.Status)
+
+	_, err = s.Session().GetByID(ctx, uuid.New().String())
+	assert.True(t, storage.IsNotFoundError(err), "missing session: %v", err)
+
+	active, err := s.Session().GetActiveCount(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), active)
+
+	got.Status = models.SessionActive
+	got.CommandCount = 3
+	require.NoError(t, s.Session().Update(ctx, got))
+	got, err = s.Session().GetByID(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.SessionActive, got.Status)
+	assert.Equal(t, int64(3), got.CommandCount)
+
+	active, err = s.Session().GetActiveCount(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), active)
+
+	missing := *got
+	missing.ID = uuid.New().String()
+	err = s.Session().Update(ctx, &missing)
+	assert.True(t, storage.IsNotFoundError(err), "update missing session: %v", err)
+
+	ended := &models.Session{
+		BaseModel: models.BaseModel{ID: uuid.New().String()},
+		ProjectID: project.ID,
+		Status:    models.SessionEnded,
+	}
+	require.NoError(t, s.Session().Create(ctx, ended))
+
+	isActive := true
+	resp, err := s.Session().List(ctx, &models.SessionFilter{ProjectID: project.ID, Active: &isActive}, page(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Meta.Total)
+
+	resp, err = s.Session().List(ctx, &models.SessionFilter{ProjectID: project.ID}, page(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Meta.Total)
+
+	require.NoError(t, s.Session().Delete(ctx, ended.ID))
+	_, err = s.Session().GetByID(ctx, ended.ID)
+	assert.True(t, storage.IsNotFoundError(err), "deleted session: %v", err)
+}
+
+func testTask(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	_, _, session := seedSession(t, s)
+
+	task := &models.Task{
+		BaseModel: models.BaseModel{ID: uuid.New().String()},
+		SessionID: session.ID,
+		Command:   "claude -p hello",
+		Status:    models.TaskPending,
+	}
+	require.NoError(t, s.Task().Create(ctx, task))
+
+	got, err := s.Task().GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "claude -p hello", got.Command)
+	assert.Equal(t, models.TaskPending, got.Status)
+
+	_, err = s.Task().GetByID(ctx, uuid.New().String())
+	assert.True(t, storage.IsNotFoundError(err), "missing task: %v", err)
+
+	active, err := s.Task().GetActiveCount(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), active)
+
+	got.Status = models.TaskCompleted
+	got.Output = "done"
+	require.NoError(t, s.Task().Update(ctx, got))
+	got, err = s.Task().GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.TaskCompleted, got.Status)
+	assert.Equal(t, "done", got.Output)
+
+	active, err = s.Task().GetActiveCount(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), active)
+
+	require.NoError(t, s.Task().Create(ctx, &models.Task{
+		BaseModel: models.BaseModel{ID: uuid.New().String()},
+		SessionID: session.ID,
+		Command:   "claude -p again",
+		Status:    models.TaskPending,
+	}))
+
+	tasks, total, err := s.Task().GetBySessionID(ctx, session.ID, page(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, tasks, 2)
+
+	status := models.TaskCompleted
+	tasks, total, err = s.Task().List(ctx, &models.TaskFilter{SessionID: &session.ID, Status: &status}, page(1, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, task.ID, tasks[0].ID)
+
+	// 사용량 집계: 구간 안에 끝났거나 구간 끝에 실행 중이던 태스크만
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	executed := func(status models.TaskStatus, started, completed *time.Time) *models.Task {
+		task := &models.Task{
+			BaseModel:   models.BaseModel{ID: uuid.New().String()},
+			SessionID:   session.ID,
+			Command:     "claude -p usage",
+			Status:      status,
+			StartedAt:   started,
+			CompletedAt: completed,
+		}
+		require.NoError(t, s.Task().Create(ctx, task))
+		return task
+	}
+	at := func(offset time.Duration) *time.Time {
+		value := base.Add(offset)
+		return &value
+	}
+	finished := executed(models.TaskCompleted, at(0), at(30*time.Minute))
+	running := executed(models.TaskRunning, at(20*time.Minute), nil)
+	executed(models.TaskFailed, at(-2*time.Hour), at(-time.Hour))
+
+	tasks, err = s.Task().ListExecutedBetween(ctx, base, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, finished.ID, tasks[0].ID)
+	assert.Equal(t, running.ID, tasks[1].ID)
+
+	tasks, err = s.Task().ListExecutedBetween(ctx, base.Add(40*time.Minute), base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, running.ID, tasks[0].ID)
+
+	require.NoError(t, s.Task().Delete(ctx, task.ID))
+	_, err = s.Task().GetByID(ctx, task.ID)
+	assert.True(t, storage.IsNotFoundError(err), "deleted task: %v", err)
+}
+
+func testWorkspaceEnv(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ws := newWorkspace(uuid.New().String(), "env")
+	require.NoError(t, s.Workspace().Create(ctx, ws))
+
+	region := &models.WorkspaceEnvVar{WorkspaceID: ws.ID, Key: "REGION", Value: "eu-west-1", UpdatedBy: "alice"}
+	require.NoError(t, s.WorkspaceEnv().Create(ctx, region))
+	require.NoError(t, s.WorkspaceEnv().Create(ctx, &models.WorkspaceEnvVar{WorkspaceID: ws.ID, Key: "API_TOKEN", Value: "v1:sealed", Secret: true}))
+	err := s.WorkspaceEnv().Create(ctx, &models.WorkspaceEnvVar{WorkspaceID: ws.ID, Key: "REGION"})
+	assert.True(t, storage.IsAlreadyExistsError(err), "duplicate env var: %v", err)
+
+	variables, err := s.WorkspaceEnv().List(ctx, ws.ID)
+	require.NoError(t, err)
+	require.Len(t, variables, 2)
+	assert.Equal(t, "API_TOKEN", variables[0].Key)
+	assert.True(t, variables[0].Secret)
+
+	region.Value = "us-east-1"
+	region.UpdatedBy = "bob"
+	require.NoError(t, s.WorkspaceEnv().Update(ctx, region))
+	got, err := s.WorkspaceEnv().Get(ctx, ws.ID, "REGION")
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", got.Value)
+	assert.Equal(t, "bob", got.UpdatedBy)
+
+	require.NoError(t, s.WorkspaceEnv().Delete(ctx, ws.ID, "REGION"))
+	_, err = s.WorkspaceEnv().Get(ctx, ws.ID, "REGION")
+	assert.True(t, storage.IsNotFoundError(err), "deleted env var: %v", err)
+	assert.True(t, storage.IsNotFoundError(s.WorkspaceEnv().Delete(ctx, ws.ID, "REGION")))
+
+	require.NoError(t, s.WorkspaceEnv().DeleteByWorkspace(ctx, ws.ID))
+	variables, err = s.WorkspaceEnv().List(ctx, ws.ID)
+	require.NoError(t, err)
+	assert.Empty(t, variables)
+
+	// 변경 기록은 최신순, 변수 이름으로 거를 수 있음
+	for _, change := range []*models.WorkspaceEnvChange{
+		{WorkspaceID: ws.ID, Key: "REGION", Action: models.WorkspaceEnvCreated, ActorID: "alice"},
+		{WorkspaceID: ws.ID, Key: "API_TOKEN", Action: models.WorkspaceEnvCreated, Secret: true, ActorID: "alice"},
+		{WorkspaceID: ws.ID, Key: "REGION", Action: models.WorkspaceEnvUpdated, ValueChanged: true, ActorID: "bob"},
+	} {
+		require.NoError(t, s.WorkspaceEnv().AddChange(ctx, change))
+		assert.NotEmpty(t, change.ID)
+	}
+	changes, err := s.WorkspaceEnv().ListChanges(ctx, ws.ID, "", 0)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	assert.Equal(t, models.WorkspaceEnvUpdated, changes[0].Action)
+	assert.True(t, changes[0].ValueChanged)
+
+	changes, err = s.WorkspaceEnv().ListChanges(ctx, ws.ID, "REGION", 1)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "bob", changes[0].ActorID)
+}
+
+func testTaskReviewComments(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ws, project, session := seedSession(t, s)
+	review := &models.TaskReview{
+		TaskID:       uuid.New().String(),
+		SessionID:    session.ID,
+		ProjectID:    project.ID,
+		WorkspaceID:  ws.ID,
+		Status:       models.TaskReviewPending,
+		BaseCommit:   "base1",
+		FilesChanged: []string{"main.go"},
+	}
+	require.NoError(t, s.TaskReview().Create(ctx, review))
+
+	// 파일, 줄, 작성 순으로 조회
+	later := &models.TaskReviewComment{ReviewID: review.ID, WorkspaceID: ws.ID, AuthorID: "alice", Path: "main.go", Line: 12, Side: models.TaskReviewCommentNew, Body: "에러를 감싸 주세요"}
+	require.NoError(t, s.TaskReview().CreateComment(ctx, later))
+	assert.NotEmpty(t, later.ID)
+	require.NoError(t, s.TaskReview().CreateComment(ctx, &models.TaskReviewComment{ReviewID: review.ID, WorkspaceID: ws.ID, AuthorID: "bob", Path: "main.go", Line: 3, Side: models.TaskReviewCommentOld, Body: "이 줄은 남겨 두세요"}))
+	require.NoError(t, s.TaskReview().CreateComment(ctx, &models.TaskReviewComment{ReviewID: review.ID, WorkspaceID: ws.ID, AuthorID: "bob", Path: "api/server.go", Line: 40, Side: models.TaskReviewCommentNew, Body: "타임아웃을 설정하세요"}))
+	assert.Error(t, s.TaskReview().CreateComment(ctx, &models.TaskReviewComment{ReviewID: uuid.New().String(), WorkspaceID: ws.ID, AuthorID: "bob", Path: "main.go", Line: 1, Side: models.TaskReviewCommentNew, Body: "검토 없음"}))
+
+	comments, err := s.TaskReview().ListComments(ctx, review.ID)
+	require.NoError(t, err)
+	require.Len(t, comments, 3)
+	assert.Equal(t, "api/server.go", comments[0].Path)
+	assert.Equal(t, 3, comments[1].Line)
+	assert.Equal(t, models.TaskReviewCommentOld, comments[1].Side)
+
+	now := time.Now()
+	later.Resolved = true
+	later.ResolvedBy = "bob"
+	later.ResolvedAt = &now
+	later.RevisionSessionID = uuid.New().String()
+	later.RevisionTaskID = uuid.New().String()
+	require.NoError(t, s.TaskReview().UpdateComment(ctx, later))
+	got, err := s.TaskReview().GetComment(ctx, later.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Resolved)
+	assert.Equal(t, "bob", got.ResolvedBy)
+	require.NotNil(t, got.ResolvedAt)
+	assert.Equal(t, later.RevisionSessionID, got.RevisionSessionID)
+	assert.Equal(t, later.RevisionTaskID, got.RevisionTaskID)
+
+	require.NoError(t, s.TaskReview().DeleteComment(ctx, later.ID))
+	_, err = s.TaskReview().GetComment(ctx, later.ID)
+	assert.True(t, storage.IsNotFoundError(err), "deleted comment: %v", err)
+	assert.True(t, storage.IsNotFoundError(s.TaskReview().DeleteComment(ctx, later.ID)))
+	assert.True(t, storage.IsNotFoundError(s.TaskReview().UpdateComment(ctx, later)))
+
+	// 검토를 삭제하면 의견도 삭제
+	require.NoError(t, s.TaskReview().Delete(ctx, review.ID))
+	comments, err = s.TaskReview().ListComments(ctx, review.ID)
+	require.NoError(t, err)
+	assert.Empty(t, comments)
+}
+
+func testUserPreference(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := uuid.New().String()
+
+	_, err := s.UserPreference().Get(ctx, userID)
+	assert.True(t, storage.IsNotFoundError(err), "missing preference: %v", err)
+
+	preference := &models.UserPreference{UserID: userID, ResponseLanguage: "ja", Locale: "en"}
+	require.NoError(t, s.UserPreference().Save(ctx, preference))
+	assert.False(t, preference.UpdatedAt.IsZero())
+
+	got, err := s.UserPreference().Get(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, "ja", got.ResponseLanguage)
+	assert.Equal(t, "en", got.Locale)
+
+	// 다시 저장하면 덮어씀
+	require.NoError(t, s.UserPreference().Save(ctx, &models.UserPreference{UserID: userID, Locale: "ko"}))
+	got, err = s.UserPreference().Get(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, got.ResponseLanguage)
+	assert.Equal(t, "ko", got.Locale)
+}
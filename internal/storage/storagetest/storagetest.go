@@ -0,0 +1,51 @@
+// Package storagetest는 모든 스토리지 백엔드가 통과해야 하는 공통 적합성 테스트를 제공합니다.
+//
+// 백엔드 패키지의 테스트에서 Run에 빈 스토리지를 만드는 함수를 넘기면
+// 워크스페이스, 프로젝트, 세션, 태스크의 CRUD, 에러 분류, 버전 충돌, 동시성,
+// 트랜잭션(TransactionalStorage를 구현한 경우) 동작을 같은 기준으로 확인합니다.
+// 외부 서비스가 필요한 백엔드는 ExternalDSN으로 컨테이너 접속 정보를 받아 실행합니다.
+package storagetest
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aicli/aicli-web/internal/storage"
+)
+
+// Factory 테스트마다 비어 있는 새 스토리지를 만드는 함수
+// 정리 작업은 t.Cleanup으로 등록합니다.
+type Factory func(t *testing.T) storage.Storage
+
+// Run 백엔드가 공통 스토리지 계약을 지키는지 확인합니다
+func Run(t *testing.T, newStorage Factory) {
+	t.Run("Workspace", func(t *testing.T) { testWorkspace(t, newStorage(t)) })
+	t.Run("Project", func(t *testing.T) { testProject(t, newStorage(t)) })
+	t.Run("Session", func(t *testing.T) { testSession(t, newStorage(t)) })
+	t.Run("Task", func(t *testing.T) { testTask(t, newStorage(t)) })
+	t.Run("WorkspaceEnv", func(t *testing.T) { testWorkspaceEnv(t, newStorage(t)) })
+	t.Run("TaskReviewComments", func(t *testing.T) { testTaskReviewComments(t, newStorage(t)) })
+	t.Run("UserPreference", func(t *testing.T) { testUserPreference(t, newStorage(t)) })
+	t.Run("Concurrency", func(t *testing.T) { testConcurrency(t, newStorage(t)) })
+	t.Run("Transaction", func(t *testing.T) {
+		txStorage, ok := newStorage(t).(storage.TransactionalStorage)
+		if !ok {
+			t.Skip("backend does not support transactions")
+		}
+		testTransaction(t, txStorage)
+	})
+}
+
+// ExternalDSN 외부 서비스 백엔드의 접속 정보를 AICLI_STORAGE_TEST_<BACKEND>_DSN 환경 변수에서 읽습니다
+// 값이 없으면 테스트를 건너뜁니다. CI에서는 컨테이너(예: docker run, testcontainers)를 띄운 뒤
+// 접속 정보를 이 환경 변수로 넘겨 같은 적합성 테스트를 실제 서비스에 대해 실행합니다.
+func ExternalDSN(t *testing.T, backend string) string {
+	t.Helper()
+	name := "AICLI_STORAGE_TEST_" + strings.ToUpper(backend) + "_DSN"
+	dsn := os.Getenv(name)
+	if dsn == "" {
+		t.Skipf("%s not set; skipping %s conformance tests", name, backend)
+	}
+	return dsn
+}
@@ -0,0 +1,243 @@
+package validation
+
+import (
+	"strings"
+	"sync"
+)
+
+// API 에러 메시지 키 상수
+// 컨트롤러와 서비스가 한국어로 만든 공통 에러 메시지를 요청 언어로 바꿀 때 씁니다.
+const (
+	MsgAPIInvalidRequestData     MessageKey = "api.error.invalid_request_data"
+	MsgAPIInvalidRequestFormat   MessageKey = "api.error.invalid_request_format"
+	MsgAPIInvalidRequest         MessageKey = "api.error.invalid_request"
+	MsgAPIInvalidQuery           MessageKey = "api.error.invalid_query"
+	MsgAPIInternal               MessageKey = "api.error.internal"
+	MsgAPIClaimsNotFound         MessageKey = "api.error.claims_not_found"
+	MsgAPIAuthRequired           MessageKey = "api.error.auth_required"
+	MsgAPIUnauthorized           MessageKey = "api.error.unauthorized"
+	MsgAPIInsufficientPerm       MessageKey = "api.error.insufficient_permissions"
+	MsgAPIOwnershipRequired      MessageKey = "api.error.ownership_required"
+	MsgAPIWorkspaceNotFound      MessageKey = "api.error.workspace_not_found"
+	MsgAPIProjectNotFound        MessageKey = "api.error.project_not_found"
+	MsgAPISessionNotFound        MessageKey = "api.error.session_not_found"
+	MsgAPITaskNotFound           MessageKey = "api.error.task_not_found"
+	MsgAPIWorkspaceIDRequired    MessageKey = "api.error.workspace_id_required"
+	MsgAPIInvalidWorkspaceName   MessageKey = "api.error.invalid_workspace_name"
+	MsgAPIInvalidProjectPath     MessageKey = "api.error.invalid_project_path"
+	MsgAPIWorkspaceExists        MessageKey = "api.error.workspace_exists"
+	MsgAPIWorkspaceNameExists    MessageKey = "api.error.workspace_name_exists"
+	MsgAPIInvalidWorkspaceStatus MessageKey = "api.error.invalid_workspace_status"
+	MsgAPIWorkspaceNotActive     MessageKey = "api.error.workspace_not_active"
+	MsgAPIWorkspaceArchived      MessageKey = "api.error.workspace_archived"
+	MsgAPIMaxWorkspaces          MessageKey = "api.error.max_workspaces"
+	MsgAPIResourceBusy           MessageKey = "api.error.resource_busy"
+	MsgAPIDependencyExists       MessageKey = "api.error.dependency_exists"
+	MsgAPIDependencyUnavailable  MessageKey = "api.error.dependency_unavailable"
+	MsgAPIDeadlineExceeded       MessageKey = "api.error.deadline_exceeded"
+	MsgAPIInvalidResponseLang    MessageKey = "api.error.invalid_response_language"
+	MsgAPIInvalidLocale          MessageKey = "api.error.invalid_locale"
+)
+
+// 알림 메시지 키 상수
+const (
+	MsgNotifyReviewRequestedTitle MessageKey = "notification.review.requested_title"
+	MsgNotifyReviewApprovedTitle  MessageKey = "notification.review.approved_title"
+	MsgNotifyReviewRejectedTitle  MessageKey = "notification.review.rejected_title"
+	MsgNotifyReviewStatus         MessageKey = "notification.review.status"
+	MsgNotifyReviewFiles          MessageKey = "notification.review.files"
+	MsgNotifyReviewComment        MessageKey = "notification.review.comment"
+	MsgNotifyInviteTitle          MessageKey = "notification.invite.title"
+	MsgNotifyInviteMessage        MessageKey = "notification.invite.message"
+	MsgNotifyInviteMessageBy      MessageKey = "notification.invite.message_by"
+	MsgNotifyDiskTitle            MessageKey = "notification.disk.title"
+	MsgNotifyDiskMessage          MessageKey = "notification.disk.message"
+	MsgNotifyDiskExceeded         MessageKey = "notification.disk.exceeded"
+	MsgNotifyExportCompletedTitle MessageKey = "notification.export.completed_title"
+	MsgNotifyExportCompleted      MessageKey = "notification.export.completed"
+	MsgNotifyExportFailedTitle    MessageKey = "notification.export.failed_title"
+	MsgNotifyDigestSubject        MessageKey = "notification.digest.subject"
+	MsgNotifyDigestIntro          MessageKey = "notification.digest.intro"
+)
+
+// apiMessages API 에러와 알림 메시지 (loadMessages에서 검증 메시지와 합침)
+var apiMessages = map[Language]map[MessageKey]string{
+	LanguageKorean: {
+		// API 에러 메시지
+		MsgAPIInvalidRequestData:     "요청 데이터가 올바르지 않습니다",
+		MsgAPIInvalidRequestFormat:   "잘못된 요청 형식",
+		MsgAPIInvalidRequest:         "잘못된 요청입니다",
+		MsgAPIInvalidQuery:           "조회 조건이 올바르지 않습니다",
+		MsgAPIInternal:               "서버 내부 오류가 발생했습니다",
+		MsgAPIClaimsNotFound:         "인증 정보를 찾을 수 없습니다",
+		MsgAPIAuthRequired:           "인증이 필요합니다",
+		MsgAPIUnauthorized:           "접근 권한이 없습니다",
+		MsgAPIInsufficientPerm:       "권한이 부족합니다",
+		MsgAPIOwnershipRequired:      "소유자 권한이 필요합니다",
+		MsgAPIWorkspaceNotFound:      "워크스페이스를 찾을 수 없습니다",
+		MsgAPIProjectNotFound:        "프로젝트를 찾을 수 없습니다",
+		MsgAPISessionNotFound:        "세션을 찾을 수 없습니다",
+		MsgAPITaskNotFound:           "태스크를 찾을 수 없습니다",
+		MsgAPIWorkspaceIDRequired:    "워크스페이스 ID가 필요합니다",
+		MsgAPIInvalidWorkspaceName:   "워크스페이스 이름이 유효하지 않습니다",
+		MsgAPIInvalidProjectPath:     "프로젝트 경로가 유효하지 않습니다",
+		MsgAPIWorkspaceExists:        "이미 존재하는 워크스페이스입니다",
+		MsgAPIWorkspaceNameExists:    "이미 존재하는 워크스페이스 이름입니다",
+		MsgAPIInvalidWorkspaceStatus: "워크스페이스 상태가 유효하지 않습니다",
+		MsgAPIWorkspaceNotActive:     "워크스페이스가 활성 상태가 아닙니다",
+		MsgAPIWorkspaceArchived:      "아카이브된 워크스페이스입니다",
+		MsgAPIMaxWorkspaces:          "최대 워크스페이스 수에 도달했습니다",
+		MsgAPIResourceBusy:           "리소스가 사용 중입니다",
+		MsgAPIDependencyExists:       "의존성이 존재합니다",
+		MsgAPIDependencyUnavailable:  "외부 서비스를 일시적으로 사용할 수 없습니다",
+		MsgAPIDeadlineExceeded:       "요청 처리 시간을 초과했습니다",
+		MsgAPIInvalidResponseLang:    "지원하지 않는 응답 언어입니다",
+		MsgAPIInvalidLocale:          "지원하지 않는 메시지 언어입니다",
+
+		// 알림 메시지
+		MsgNotifyReviewRequestedTitle: "태스크 결과 검토 요청 (%d개 파일 변경)",
+		MsgNotifyReviewApprovedTitle:  "태스크 결과가 승인되었습니다",
+		MsgNotifyReviewRejectedTitle:  "태스크 결과가 거부되었습니다",
+		MsgNotifyReviewStatus:         "태스크 %s의 검토 상태: %s",
+		MsgNotifyReviewFiles:          "변경된 파일: %s",
+		MsgNotifyReviewComment:        "검토 의견: %s",
+		MsgNotifyInviteTitle:          "워크스페이스 %s에 초대되었습니다",
+		MsgNotifyInviteMessage:        "워크스페이스 %s에 %s 권한이 부여되었습니다.",
+		MsgNotifyInviteMessageBy:      "%s 사용자가 워크스페이스 %s에 %s 권한을 부여했습니다.",
+		MsgNotifyDiskTitle:            "워크스페이스 %s 디스크 사용량 %.0f%%",
+		MsgNotifyDiskMessage:          "워크스페이스 %s의 디스크 사용량이 %.1f MB / %.1f MB (%.0f%%)입니다.",
+		MsgNotifyDiskExceeded:         " 한도에 도달해 새 태스크를 실행할 수 없습니다. 불필요한 파일을 정리해 주세요.",
+		MsgNotifyExportCompletedTitle: "대화 기록 내보내기가 완료되었습니다: %s",
+		MsgNotifyExportCompleted:      "세션 %d개, 메시지 %d개를 %s 문서로 내보냈습니다.",
+		MsgNotifyExportFailedTitle:    "대화 기록 내보내기에 실패했습니다: %s",
+		MsgNotifyDigestSubject:        "[AICLI] 알림 요약 (%d건)",
+		MsgNotifyDigestIntro:          "확인하지 않은 알림 %d건이 있습니다.",
+	},
+	LanguageEnglish: {
+		// API 에러 메시지
+		MsgAPIInvalidRequestData:     "Invalid request data",
+		MsgAPIInvalidRequestFormat:   "Invalid request format",
+		MsgAPIInvalidRequest:         "Invalid request",
+		MsgAPIInvalidQuery:           "Invalid query parameters",
+		MsgAPIInternal:               "An internal server error occurred",
+		MsgAPIClaimsNotFound:         "Authentication information not found",
+		MsgAPIAuthRequired:           "Authentication required",
+		MsgAPIUnauthorized:           "Access denied",
+		MsgAPIInsufficientPerm:       "Insufficient permissions",
+		MsgAPIOwnershipRequired:      "Owner permission required",
+		MsgAPIWorkspaceNotFound:      "Workspace not found",
+		MsgAPIProjectNotFound:        "Project not found",
+		MsgAPISessionNotFound:        "Session not found",
+		MsgAPITaskNotFound:           "Task not found",
+		MsgAPIWorkspaceIDRequired:    "Workspace ID is required",
+		MsgAPIInvalidWorkspaceName:   "Invalid workspace name",
+		MsgAPIInvalidProjectPath:     "Invalid project path",
+		MsgAPIWorkspaceExists:        "Workspace already exists",
+		MsgAPIWorkspaceNameExists:    "Workspace name already exists",
+		MsgAPIInvalidWorkspaceStatus: "Invalid workspace status",
+		MsgAPIWorkspaceNotActive:     "Workspace is not active",
+		MsgAPIWorkspaceArchived:      "Workspace is archived",
+		MsgAPIMaxWorkspaces:          "Maximum number of workspaces reached",
+		MsgAPIResourceBusy:           "Resource is busy",
+		MsgAPIDependencyExists:       "Dependencies exist",
+		MsgAPIDependencyUnavailable:  "An external service is temporarily unavailable",
+		MsgAPIDeadlineExceeded:       "Request processing time exceeded",
+		MsgAPIInvalidResponseLang:    "Unsupported response language",
+		MsgAPIInvalidLocale:          "Unsupported message language",
+
+		// 알림 메시지
+		MsgNotifyReviewRequestedTitle: "Task result review requested (%d files changed)",
+		MsgNotifyReviewApprovedTitle:  "Task result approved",
+		MsgNotifyReviewRejectedTitle:  "Task result rejected",
+		MsgNotifyReviewStatus:         "Review status of task %s: %s",
+		MsgNotifyReviewFiles:          "Changed files: %s",
+		MsgNotifyReviewComment:        "Review comment: %s",
+		MsgNotifyInviteTitle:          "You were invited to workspace %s",
+		MsgNotifyInviteMessage:        "You were granted %[2]s permission on workspace %[1]s.",
+		MsgNotifyInviteMessageBy:      "%[1]s granted you %[3]s permission on workspace %[2]s.",
+		MsgNotifyDiskTitle:            "Workspace %s disk usage at %.0f%%",
+		MsgNotifyDiskMessage:          "Workspace %s is using %.1f MB of %.1f MB (%.0f%%).",
+		MsgNotifyDiskExceeded:         " The limit has been reached and new tasks cannot run. Please remove unneeded files.",
+		MsgNotifyExportCompletedTitle: "Transcript export completed: %s",
+		MsgNotifyExportCompleted:      "Exported %d sessions and %d messages as a %s document.",
+		MsgNotifyExportFailedTitle:    "Transcript export failed: %s",
+		MsgNotifyDigestSubject:        "[AICLI] Notification digest (%d)",
+		MsgNotifyDigestIntro:          "You have %d unread notifications.",
+	},
+}
+
+// APIErrorCodeTranslation 메시지 카탈로그에 없는 API 에러를 코드별 일반 메시지로 번역
+// 코드는 middleware(ERR_*)와 errors 패키지(WorkspaceError)의 에러 코드입니다.
+var APIErrorCodeTranslation = map[Language]map[string]string{
+	LanguageEnglish: {
+		"ERR_VALIDATION":           "Invalid request data",
+		"ERR_NOT_FOUND":            "Resource not found",
+		"ERR_UNAUTHORIZED":         "Authentication required",
+		"ERR_FORBIDDEN":            "Permission denied",
+		"ERR_CONFLICT":             "Resource conflict",
+		"CONFLICT":                 "Resource conflict",
+		"ERR_INTERNAL":             "An internal server error occurred",
+		"ERR_BAD_REQUEST":          "Invalid request",
+		"ERR_TIMEOUT":              "Request timed out",
+		"NOT_FOUND":                "Resource not found",
+		"ALREADY_EXISTS":           "Resource already exists",
+		"INVALID_NAME":             "Invalid name",
+		"INVALID_PATH":             "Invalid path",
+		"INVALID_REQUEST":          "Invalid request",
+		"INVALID_STATUS":           "Invalid status",
+		"UNAUTHORIZED":             "Authentication required",
+		"INSUFFICIENT_PERMISSIONS": "Insufficient permissions",
+		"OWNERSHIP_REQUIRED":       "Owner permission required",
+		"NOT_ACTIVE":               "Resource is not active",
+		"ARCHIVED":                 "Resource is archived",
+		"MAX_WORKSPACES_REACHED":   "Maximum number of workspaces reached",
+		"RESOURCE_BUSY":            "Resource is busy",
+		"DEPENDENCY_EXISTS":        "Dependencies exist",
+		"VERSION_CONFLICT":         "The resource was modified by another request",
+		"DEADLINE_EXCEEDED":        "Request processing time exceeded",
+		"DEPENDENCY_UNAVAILABLE":   "An external service is temporarily unavailable",
+		"INTERNAL_ERROR":           "An internal server error occurred",
+	},
+}
+
+var (
+	apiMessageIndexOnce sync.Once
+	// apiMessageIndex 한국어 API 에러 메시지 → 메시지 키
+	apiMessageIndex map[string]MessageKey
+)
+
+// ParseLanguage 언어 코드를 서버 메시지 언어로 변환 (지원하지 않으면 false)
+func ParseLanguage(code string) (Language, bool) {
+	switch Language(strings.ToLower(strings.TrimSpace(code))) {
+	case LanguageKorean:
+		return LanguageKorean, true
+	case LanguageEnglish:
+		return LanguageEnglish, true
+	}
+	return "", false
+}
+
+// TranslateAPIMessage 한국어로 만든 API 에러 메시지를 lang으로 번역
+// 메시지 카탈로그에 있는 공통 메시지는 그대로 옮기고, 서비스가 만든 개별 메시지는 에러 코드의
+// 일반 메시지로 바꿉니다. 코드도 모르면 원래 메시지를 반환합니다.
+func TranslateAPIMessage(code, message string, lang Language) string {
+	if lang == LanguageKorean || lang == "" {
+		return message
+	}
+
+	apiMessageIndexOnce.Do(func() {
+		apiMessageIndex = make(map[string]MessageKey)
+		for key, text := range apiMessages[LanguageKorean] {
+			if strings.HasPrefix(string(key), "api.") {
+				apiMessageIndex[text] = key
+			}
+		}
+	})
+	if key, ok := apiMessageIndex[message]; ok {
+		return TL(key, lang)
+	}
+	if translation, ok := APIErrorCodeTranslation[lang][code]; ok {
+		return translation
+	}
+	return message
+}
@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIMessages_AllLanguages(t *testing.T) {
+	// 한국어와 영어 카탈로그의 키가 같아야 함
+	assert.Equal(t, len(apiMessages[LanguageKorean]), len(apiMessages[LanguageEnglish]))
+	for key := range apiMessages[LanguageKorean] {
+		assert.Contains(t, apiMessages[LanguageEnglish], key)
+	}
+
+	assert.Equal(t, "You were granted write permission on workspace demo.", TL(MsgNotifyInviteMessage, LanguageEnglish, "demo", "write"))
+	assert.Equal(t, "alice granted you read permission on workspace demo.", TL(MsgNotifyInviteMessageBy, LanguageEnglish, "alice", "demo", "read"))
+	assert.Equal(t, "워크스페이스 demo에 write 권한이 부여되었습니다.", TL(MsgNotifyInviteMessage, LanguageKorean, "demo", "write"))
+}
+
+func TestTranslateAPIMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		message  string
+		lang     Language
+		expected string
+	}{
+		{"한국어는 그대로", "ERR_VALIDATION", "요청 데이터가 올바르지 않습니다", LanguageKorean, "요청 데이터가 올바르지 않습니다"},
+		{"카탈로그 메시지", "ERR_VALIDATION", "요청 데이터가 올바르지 않습니다", LanguageEnglish, "Invalid request data"},
+		{"서비스 메시지", "NOT_FOUND", "세션을 찾을 수 없습니다", LanguageEnglish, "Session not found"},
+		{"코드 일반 메시지", "NOT_FOUND", "파이프라인 단계 3을 찾을 수 없습니다", LanguageEnglish, "Resource not found"},
+		{"알 수 없는 코드", "CUSTOM", "사용자 정의 에러", LanguageEnglish, "사용자 정의 에러"},
+		{"알림 키는 역번역하지 않음", "CUSTOM", "태스크 결과가 승인되었습니다", LanguageEnglish, "태스크 결과가 승인되었습니다"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, TranslateAPIMessage(tt.code, tt.message, tt.lang))
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	lang, ok := ParseLanguage(" EN ")
+	assert.True(t, ok)
+	assert.Equal(t, LanguageEnglish, lang)
+
+	_, ok = ParseLanguage("ja")
+	assert.False(t, ok)
+}
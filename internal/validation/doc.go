@@ -25,6 +25,7 @@
 //    - 다국어 에러 메시지 (한국어, 영어)
 //    - Accept-Language 헤더 기반 자동 언어 감지
 //    - 필드명 번역
+//    - API 에러와 알림 메시지 번역 (TranslateAPIMessage, 사용자 언어 설정)
 //
 // 사용 예제:
 //
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -176,6 +177,13 @@ func (t *DefaultMessageTranslator) loadMessages() {
 		MsgDangerousCommand: "Dangerous command detected: %s",
 		MsgCommandTooLong:   "Command too long (maximum %s characters)",
 	}
+
+	// API 에러와 알림 메시지 (api_messages.go)
+	for lang, messages := range apiMessages {
+		for key, message := range messages {
+			t.messages[lang][key] = message
+		}
+	}
 }
 
 // Translate 메시지 번역
@@ -344,19 +352,32 @@ func TranslateValidationErrors(errors ValidationErrors, lang Language) Validatio
 }
 
 // GetLanguageFromContext 컨텍스트에서 언어 정보 추출
+// Accept-Language 항목 중 지원하는 언어(한국어, 영어)에서 가중치(q)가 가장 높은 언어를 고릅니다.
 func GetLanguageFromContext(acceptLanguage string) Language {
-	// Accept-Language 헤더 파싱
-	if acceptLanguage == "" {
-		return LanguageKorean
-	}
-	
-	// 간단한 언어 감지 (더 정교한 구현 가능)
-	acceptLanguage = strings.ToLower(acceptLanguage)
-	if strings.Contains(acceptLanguage, "en") {
-		return LanguageEnglish
+	best := LanguageKorean
+	bestWeight := 0.0
+	for _, entry := range strings.Split(acceptLanguage, ",") {
+		tag, weight := entry, 1.0
+		if i := strings.Index(entry, ";"); i >= 0 {
+			tag = entry[:i]
+			if q := strings.TrimSpace(entry[i+1:]); strings.HasPrefix(q, "q=") {
+				parsed, err := strconv.ParseFloat(strings.TrimPrefix(q, "q="), 64)
+				if err != nil {
+					continue
+				}
+				weight = parsed
+			}
+		}
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if i := strings.Index(tag, "-"); i >= 0 {
+			tag = tag[:i]
+		}
+		lang, ok := ParseLanguage(tag)
+		if ok && weight > bestWeight {
+			best, bestWeight = lang, weight
+		}
 	}
-	
-	return LanguageKorean
+	return best
 }
 
 // ErrorCodeTranslation 에러 코드별 번역 메시지
@@ -267,6 +267,16 @@ func TestGetLanguageFromContext(t *testing.T) {
 			acceptLanguage: "fr-FR,fr;q=0.9,en;q=0.8",
 			expected:       LanguageEnglish,
 		},
+		{
+			name:           "한국어 우선, 영어 차선",
+			acceptLanguage: "ko-KR,ko;q=0.9,en-US;q=0.8,en;q=0.7",
+			expected:       LanguageKorean,
+		},
+		{
+			name:           "가중치가 높은 영어",
+			acceptLanguage: "ko;q=0.5,en;q=0.9",
+			expected:       LanguageEnglish,
+		},
 		{
 			name:           "한국어만",
 			acceptLanguage: "ko-KR",
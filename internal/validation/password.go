@@ -0,0 +1,82 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PasswordPolicy 로컬 계정 비밀번호 복잡도 규칙
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUpper     bool
+	RequireLower     bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	DisallowUsername bool // 사용자명(대소문자 무시)을 포함한 비밀번호 거부
+}
+
+// DefaultPasswordPolicy 기본 비밀번호 정책
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:        10,
+		MaxLength:        128,
+		RequireUpper:     true,
+		RequireLower:     true,
+		RequireDigit:     true,
+		DisallowUsername: true,
+	}
+}
+
+// ValidatePassword 비밀번호가 정책을 만족하는지 확인
+// 위반한 규칙마다 password 필드의 ValidationError를 담은 ValidationErrors를 반환합니다.
+func (p PasswordPolicy) ValidatePassword(password, username string) error {
+	var errs []ValidationError
+	add := func(tag, param, message string) {
+		errs = append(errs, ValidationError{Field: "password", Tag: tag, Param: param, Message: message})
+	}
+
+	length := len([]rune(password))
+	if p.MinLength > 0 && length < p.MinLength {
+		add("min", strconv.Itoa(p.MinLength), fmt.Sprintf("비밀번호는 %d자 이상이어야 합니다", p.MinLength))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		add("max", strconv.Itoa(p.MaxLength), fmt.Sprintf("비밀번호는 %d자 이하여야 합니다", p.MaxLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		add("password_upper", "", "비밀번호에 대문자가 하나 이상 있어야 합니다")
+	}
+	if p.RequireLower && !hasLower {
+		add("password_lower", "", "비밀번호에 소문자가 하나 이상 있어야 합니다")
+	}
+	if p.RequireDigit && !hasDigit {
+		add("password_digit", "", "비밀번호에 숫자가 하나 이상 있어야 합니다")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		add("password_symbol", "", "비밀번호에 특수문자가 하나 이상 있어야 합니다")
+	}
+	if p.DisallowUsername && username != "" && strings.Contains(strings.ToLower(password), strings.ToLower(username)) {
+		add("password_username", "", "비밀번호에 사용자명을 포함할 수 없습니다")
+	}
+
+	if len(errs) > 0 {
+		return ValidationErrors{Errors: errs, Model: "Password"}
+	}
+	return nil
+}
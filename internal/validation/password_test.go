@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicy_ValidatePassword(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	policy.RequireSymbol = true
+
+	assert.NoError(t, policy.ValidatePassword("Str0ng-Passw0rd", "alice"))
+
+	tests := []struct {
+		name     string
+		password string
+		tags     []string
+	}{
+		{"짧은 비밀번호", "Ab1!", []string{"min"}},
+		{"대문자 없음", "lowercase-only-1", []string{"password_upper"}},
+		{"소문자 없음", "UPPERCASE-ONLY-1", []string{"password_lower"}},
+		{"숫자와 특수문자 없음", "NoDigitsOrSymbols", []string{"password_digit", "password_symbol"}},
+		{"사용자명 포함", "Alice-Secret-99", []string{"password_username"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.ValidatePassword(tt.password, "alice")
+			require.Error(t, err)
+
+			var validationErrs ValidationErrors
+			require.True(t, errors.As(err, &validationErrs))
+			var tags []string
+			for _, e := range validationErrs.Errors {
+				assert.Equal(t, "password", e.Field)
+				tags = append(tags, e.Tag)
+			}
+			assert.Equal(t, tt.tags, tags)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 연결별 출력 옵션 쿼리 파라미터
+const (
+	BatchIntervalQueryParam = "batch_ms"    // 스트림 출력을 모으는 시간 (0이면 배칭 안 함)
+	BatchBytesQueryParam    = "batch_bytes" // 모인 크기가 이 값에 도달하면 즉시 전송
+	CompressionQueryParam   = "compress"    // false면 permessage-deflate를 협상해도 압축하지 않음
+)
+
+// MaxBatchInterval 연결이 요청할 수 있는 최대 배치 간격
+const MaxBatchInterval = time.Second
+
+// OutputOptions 연결별 출력 전송 옵션
+type OutputOptions struct {
+	BatchInterval time.Duration // 0이면 배칭 비활성
+	BatchMaxBytes int           // 0이면 크기 제한 없이 간격마다 전송
+	Compression   bool          // 협상된 압축 사용 여부
+}
+
+// ParseOutputOptions 업그레이드 요청의 쿼리 파라미터로 기본 옵션을 덮어씁니다
+func ParseOutputOptions(r *http.Request, defaults OutputOptions) (OutputOptions, error) {
+	opts := defaults
+	query := r.URL.Query()
+
+	if raw := query.Get(BatchIntervalQueryParam); raw != "" {
+		ms, err := strconv.Atoi(raw)
+		if err != nil || ms < 0 || time.Duration(ms)*time.Millisecond > MaxBatchInterval {
+			return defaults, fmt.Errorf("%s는 0에서 %d 사이의 밀리초여야 합니다: %s", BatchIntervalQueryParam, MaxBatchInterval.Milliseconds(), raw)
+		}
+		opts.BatchInterval = time.Duration(ms) * time.Millisecond
+	}
+
+	if raw := query.Get(BatchBytesQueryParam); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			return defaults, fmt.Errorf("%s는 0 이상의 정수여야 합니다: %s", BatchBytesQueryParam, raw)
+		}
+		opts.BatchMaxBytes = n
+	}
+
+	if raw := query.Get(CompressionQueryParam); raw != "" {
+		enabled, err := strconv.ParseBool(raw)
+		if err != nil {
+			return defaults, fmt.Errorf("%s는 true 또는 false여야 합니다: %s", CompressionQueryParam, raw)
+		}
+		opts.Compression = enabled
+	}
+
+	return opts, nil
+}
+
+// offersCompression 클라이언트가 permessage-deflate를 제시했는지 확인
+func offersCompression(r *http.Request) bool {
+	for _, header := range r.Header.Values("Sec-WebSocket-Extensions") {
+		for _, ext := range strings.Split(header, ",") {
+			name := strings.TrimSpace(strings.SplitN(ext, ";", 2)[0])
+			if strings.EqualFold(name, "permessage-deflate") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isStreamOutput 배칭 대상인 스트림 출력 메시지인지 확인 (Claude와 명령 출력은 log로 전달됨)
+func isStreamOutput(msgType MessageType) bool {
+	return msgType == MessageTypeLog
+}
+
+// outboundFrame 전송 대기 중인 프레임
+type outboundFrame struct {
+	data      []byte
+	batchable bool
+}
+
+// outputBatcher 쓰기 펌프에서 스트림 출력을 모읍니다 (쓰기 고루틴 전용)
+type outputBatcher struct {
+	interval time.Duration
+	maxBytes int
+	pending  []json.RawMessage
+	size     int
+	timer    *time.Timer
+	timerC   <-chan time.Time
+}
+
+func newOutputBatcher(opts OutputOptions) *outputBatcher {
+	return &outputBatcher{interval: opts.BatchInterval, maxBytes: opts.BatchMaxBytes}
+}
+
+func (b *outputBatcher) enabled() bool {
+	return b.interval > 0
+}
+
+// add 출력을 모으고, 최대 크기에 도달했으면 true를 반환합니다
+func (b *outputBatcher) add(data []byte) bool {
+	if len(b.pending) == 0 {
+		if b.timer == nil {
+			b.timer = time.NewTimer(b.interval)
+		} else {
+			b.timer.Reset(b.interval)
+		}
+		b.timerC = b.timer.C
+	}
+	b.pending = append(b.pending, data)
+	b.size += len(data)
+	return b.maxBytes > 0 && b.size >= b.maxBytes
+}
+
+// C 모인 출력을 보낼 시각 (모인 출력이 없으면 nil)
+func (b *outputBatcher) C() <-chan time.Time {
+	return b.timerC
+}
+
+// take 모인 출력을 꺼내고 타이머를 멈춥니다
+func (b *outputBatcher) take() []json.RawMessage {
+	pending := b.pending
+	b.pending = nil
+	b.size = 0
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+	b.timerC = nil
+	return pending
+}
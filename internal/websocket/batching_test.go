@@ -0,0 +1,199 @@
+package websocket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/auth"
+)
+
+func TestParseOutputOptions(t *testing.T) {
+	defaults := OutputOptions{BatchMaxBytes: 1024, Compression: true}
+
+	tests := []struct {
+		name     string
+		url      string
+		expected OutputOptions
+		wantErr  bool
+	}{
+		{"기본값", "/ws", defaults, false},
+		{"배칭 요청", "/ws?batch_ms=50&batch_bytes=4096", OutputOptions{BatchInterval: 50 * time.Millisecond, BatchMaxBytes: 4096, Compression: true}, false},
+		{"압축 끄기", "/ws?compress=false", OutputOptions{BatchMaxBytes: 1024}, false},
+		{"최대 간격 초과", "/ws?batch_ms=5000", OutputOptions{}, true},
+		{"음수 크기", "/ws?batch_bytes=-1", OutputOptions{}, true},
+		{"잘못된 압축 값", "/ws?compress=maybe", OutputOptions{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := ParseOutputOptions(httptest.NewRequest(http.MethodGet, tt.url, nil), defaults)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, opts)
+		})
+	}
+}
+
+func TestOutputBatcher(t *testing.T) {
+	batcher := newOutputBatcher(OutputOptions{BatchInterval: time.Hour, BatchMaxBytes: 10})
+	assert.True(t, batcher.enabled())
+	assert.Nil(t, batcher.C())
+
+	assert.False(t, batcher.add([]byte("12345")))
+	assert.NotNil(t, batcher.C())
+	assert.True(t, batcher.add([]byte("67890")))
+
+	assert.Len(t, batcher.take(), 2)
+	assert.Nil(t, batcher.C())
+	assert.Empty(t, batcher.take())
+
+	assert.False(t, newOutputBatcher(OutputOptions{}).enabled())
+}
+
+// startBatchingServer 허브에 접근할 수 있는 테스트 서버
+func startBatchingServer(t *testing.T) (*Hub, string) {
+	t.Helper()
+
+	hub := NewHub(nil)
+	require.NoError(t, hub.Start())
+	t.Cleanup(hub.Stop)
+
+	handler := NewWebSocketHandler(hub, auth.NewJWTManager("test-secret", time.Hour, time.Hour), auth.NewBlacklist(), nil)
+	server := httptest.NewServer(http.HandlerFunc(handler.HandleConnectionHTTP))
+	t.Cleanup(server.Close)
+	return hub, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func readHello(t *testing.T, conn *websocket.Conn) HelloMessage {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var env Envelope
+	require.NoError(t, conn.ReadJSON(&env))
+	require.Equal(t, MessageTypeHello, env.Type)
+
+	var hello HelloMessage
+	require.NoError(t, json.Unmarshal(env.Payload, &hello))
+	return hello
+}
+
+func TestClient_OutputBatching(t *testing.T) {
+	hub, url := startBatchingServer(t)
+
+	dialer := websocket.Dialer{Subprotocols: []string{"aicli-ws-v2"}}
+	conn, _, err := dialer.Dial(url+"?batch_ms=1000", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	hello := readHello(t, conn)
+	assert.Equal(t, int64(1000), hello.BatchIntervalMs)
+	assert.False(t, hello.Compression)
+
+	require.Eventually(t, func() bool { return len(hub.GetClientsByUser("anonymous")) == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		hub.BroadcastToUsers(NewLogMessage("info", "line", "claude", "s1", "t1"), "anonymous")
+	}
+	// 배칭 대상이 아닌 메시지는 모인 출력을 먼저 내보냄
+	hub.BroadcastToUsers(NewMessage(MessageTypeStatus, StatusMessage{Resource: "task", ID: "t1", Status: "completed"}), "anonymous")
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var batch Envelope
+	require.NoError(t, conn.ReadJSON(&batch))
+	require.Equal(t, MessageTypeBatch, batch.Type)
+
+	var data BatchMessage
+	require.NoError(t, json.Unmarshal(batch.Payload, &data))
+	require.Len(t, data.Messages, 3)
+	for _, raw := range data.Messages {
+		msg, err := DecodeMessage(raw)
+		require.NoError(t, err)
+		assert.Equal(t, MessageTypeLog, msg.Type)
+	}
+
+	var status Envelope
+	require.NoError(t, conn.ReadJSON(&status))
+	assert.Equal(t, MessageTypeStatus, status.Type)
+}
+
+func TestClient_CompressionNegotiation(t *testing.T) {
+	_, url := startBatchingServer(t)
+
+	dialer := websocket.Dialer{Subprotocols: []string{"aicli-ws-v2"}, EnableCompression: true}
+	conn, _, err := dialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	hello := readHello(t, conn)
+	assert.True(t, hello.Compression)
+	assert.Zero(t, hello.BatchIntervalMs)
+
+	// 연결별로 압축을 끌 수 있음
+	off, _, err := dialer.Dial(url+"?compress=false", nil)
+	require.NoError(t, err)
+	defer off.Close()
+	assert.False(t, readHello(t, off).Compression)
+
+	// 잘못된 옵션은 업그레이드 전에 거부
+	_, resp, err := dialer.Dial(url+"?batch_ms=abc", nil)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+// toggleDropper 켜져 있는 동안 모든 프레임을 버림
+type toggleDropper struct {
+	on      atomic.Bool
+	dropped atomic.Int64
+}
+
+func (d *toggleDropper) DropFrame() bool {
+	if !d.on.Load() {
+		return false
+	}
+	d.dropped.Add(1)
+	return true
+}
+
+func TestClient_FrameDropper(t *testing.T) {
+	dropper := &toggleDropper{}
+	hub := NewHub(nil)
+	hub.SetFrameDropper(dropper)
+	require.NoError(t, hub.Start())
+	t.Cleanup(hub.Stop)
+
+	handler := NewWebSocketHandler(hub, auth.NewJWTManager("test-secret", time.Hour, time.Hour), auth.NewBlacklist(), nil)
+	server := httptest.NewServer(http.HandlerFunc(handler.HandleConnectionHTTP))
+	t.Cleanup(server.Close)
+
+	dialer := websocket.Dialer{Subprotocols: []string{"aicli-ws-v2"}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	readHello(t, conn)
+	require.Eventually(t, func() bool { return len(hub.GetClientsByUser("anonymous")) == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	// 버린 프레임은 전달되지 않고 연결은 유지됨
+	dropper.on.Store(true)
+	hub.BroadcastToUsers(NewLogMessage("info", "dropped", "claude", "s1", "t1"), "anonymous")
+	require.Eventually(t, func() bool { return dropper.dropped.Load() == 1 }, 2*time.Second, 10*time.Millisecond)
+	dropper.on.Store(false)
+
+	hub.BroadcastToUsers(NewMessage(MessageTypeStatus, StatusMessage{Resource: "task", ID: "t1", Status: "completed"}), "anonymous")
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var env Envelope
+	require.NoError(t, conn.ReadJSON(&env))
+	assert.Equal(t, MessageTypeStatus, env.Type)
+}
@@ -276,6 +276,7 @@ func (s *StreamSession) streamClaude() {
 					"message_type": msg.Type,
 					"content":      msg.Content,
 					"metadata":     nil, // msg.Metadata 필드 없음
+					"rendered":     claude.RenderedLines(msg.Type, msg.Content, msg.Meta),
 				},
 			}
 
@@ -1,9 +1,14 @@
 package websocket
 
 import (
+	"compress/flate"
 	"context"
+	"errors"
 	"log"
+	"net"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -21,24 +26,36 @@ type Client struct {
 	channelsMu sync.RWMutex    `json:"-"`
 	
 	// 메시지 전송
-	send chan []byte
+	send   chan outboundFrame
+	output OutputOptions // 배칭/압축 옵션 (연결별)
 	
 	// 상태 관리
 	isAuthenticated bool
 	lastPing        time.Time
 	lastPong        time.Time
+	lastActivity    atomic.Int64 // 마지막 애플리케이션 메시지 수신 시각 (UnixNano)
+	
+	// 협상된 프로토콜 버전
+	protocol ProtocolVersion
+	config   *ClientConfig
 	
 	// 제어
 	ctx    context.Context
 	cancel context.CancelFunc
 	done   chan struct{}
 	
+	// 종료 코드 (처음 요청된 값 유지)
+	closeMu     sync.Mutex
+	closeCode   int
+	closeReason string
+	
 	// 허브 참조
 	hub *Hub
 	
 	// 통계
 	messagesReceived int64
 	messagesSent     int64
+	batchesSent      int64
 	connectedAt      time.Time
 }
 
@@ -49,26 +66,62 @@ type ClientConfig struct {
 	
 	// 타임아웃 설정
 	WriteTimeout time.Duration
-	ReadTimeout  time.Duration
-	PingInterval time.Duration
-	PongTimeout  time.Duration
+	ReadTimeout  time.Duration // 핑 간격이 없을 때 읽기 대기 한도
+	PingInterval time.Duration // 서버 → 클라이언트 핑 간격
+	PongTimeout  time.Duration // 핑 간격 이후 퐁을 기다리는 시간
+	
+	// IdleTimeout 애플리케이션 메시지가 없을 때 연결을 닫는 시간 (0이면 비활성)
+	IdleTimeout time.Duration
+	// CloseGracePeriod 종료 프레임을 보낸 뒤 상대의 종료 프레임을 기다리는 시간
+	CloseGracePeriod time.Duration
 	
 	// 메시지 크기 제한
 	MaxMessageSize int64
+	
+	// 스트림 출력 배칭 기본값 (연결별로 쿼리 파라미터로 조정, BatchInterval이 0이면 비활성)
+	BatchInterval time.Duration
+	BatchMaxBytes int
+	
+	// 압축 (permessage-deflate가 협상된 연결에만 적용)
+	CompressionLevel     int
+	CompressionThreshold int // 이보다 작은 프레임은 압축하지 않음
 }
 
 // DefaultClientConfig 기본 클라이언트 설정
 func DefaultClientConfig() *ClientConfig {
 	return &ClientConfig{
-		SendBufferSize: 256,
-		WriteTimeout:   10 * time.Second,
-		ReadTimeout:    60 * time.Second,
-		PingInterval:   30 * time.Second,
-		PongTimeout:    10 * time.Second,
-		MaxMessageSize: 1024 * 1024, // 1MB
+		SendBufferSize:       256,
+		WriteTimeout:         10 * time.Second,
+		ReadTimeout:          60 * time.Second,
+		PingInterval:         30 * time.Second,
+		PongTimeout:          10 * time.Second,
+		IdleTimeout:          0,
+		CloseGracePeriod:     time.Second,
+		MaxMessageSize:       1024 * 1024, // 1MB
+		BatchInterval:        0,
+		BatchMaxBytes:        32 * 1024,
+		CompressionLevel:     flate.BestSpeed,
+		CompressionThreshold: 512,
+	}
+}
+
+// outputOptions 연결별 출력 옵션의 기본값
+func (cfg *ClientConfig) outputOptions() OutputOptions {
+	return OutputOptions{
+		BatchInterval: cfg.BatchInterval,
+		BatchMaxBytes: cfg.BatchMaxBytes,
+		Compression:   true,
 	}
 }
 
+// livenessTimeout 퐁이나 메시지 없이 기다릴 수 있는 최대 시간
+func (cfg *ClientConfig) livenessTimeout() time.Duration {
+	if cfg.PingInterval > 0 && cfg.PongTimeout > 0 {
+		return cfg.PingInterval + cfg.PongTimeout
+	}
+	return cfg.ReadTimeout
+}
+
 // NewClient 새 클라이언트 생성
 func NewClient(id, userID string, conn *websocket.Conn, hub *Hub, config *ClientConfig) *Client {
 	if config == nil {
@@ -82,10 +135,13 @@ func NewClient(id, userID string, conn *websocket.Conn, hub *Hub, config *Client
 		UserID:          userID,
 		Conn:            conn,
 		channels:        make(map[string]bool),
-		send:            make(chan []byte, config.SendBufferSize),
+		send:            make(chan outboundFrame, config.SendBufferSize),
+		output:          config.outputOptions(),
 		isAuthenticated: false,
 		lastPing:        time.Now(),
 		lastPong:        time.Now(),
+		protocol:        ProtocolV1,
+		config:          config,
 		ctx:             ctx,
 		cancel:          cancel,
 		done:            make(chan struct{}),
@@ -93,13 +149,15 @@ func NewClient(id, userID string, conn *websocket.Conn, hub *Hub, config *Client
 		connectedAt:     time.Now(),
 	}
 	
+	client.lastActivity.Store(time.Now().UnixNano())
+	
 	// WebSocket 설정
 	if conn != nil {
 		conn.SetReadLimit(config.MaxMessageSize)
-		conn.SetReadDeadline(time.Now().Add(config.ReadTimeout))
+		client.extendReadDeadline()
 		conn.SetPongHandler(func(string) error {
 			client.lastPong = time.Now()
-			conn.SetReadDeadline(time.Now().Add(config.ReadTimeout))
+			client.extendReadDeadline()
 			return nil
 		})
 	}
@@ -111,16 +169,82 @@ func NewClient(id, userID string, conn *websocket.Conn, hub *Hub, config *Client
 func (c *Client) Start() {
 	go c.readPump()
 	go c.writePump()
-	go c.pingPump()
+	go c.idlePump()
 }
 
-// Stop 클라이언트 중지
+// Stop 클라이언트 중지 (정상 종료 코드로 닫음)
 func (c *Client) Stop() {
 	if c.cancel != nil {
 		c.cancel()
 	}
 }
 
+// Close 종료 코드와 사유를 보내고 연결을 닫습니다.
+// 여러 번 호출되면 처음 요청된 코드가 사용됩니다.
+func (c *Client) Close(code int, reason string) {
+	c.closeMu.Lock()
+	if c.closeCode == 0 {
+		c.closeCode = code
+		c.closeReason = reason
+	}
+	c.closeMu.Unlock()
+	c.Stop()
+}
+
+// closeStatus 보낼 종료 코드와 사유
+func (c *Client) closeStatus() (int, string) {
+	c.closeMu.Lock()
+	defer c.closeMu.Unlock()
+	if c.closeCode == 0 {
+		return websocket.CloseNormalClosure, ""
+	}
+	return c.closeCode, c.closeReason
+}
+
+// Protocol 협상된 프로토콜 버전
+func (c *Client) Protocol() ProtocolVersion {
+	return c.protocol
+}
+
+// SetProtocol 프로토콜 버전 설정 (Start 이전에 호출)
+func (c *Client) SetProtocol(version ProtocolVersion) {
+	c.protocol = version
+}
+
+// OutputOptions 연결의 배칭/압축 옵션
+func (c *Client) OutputOptions() OutputOptions {
+	return c.output
+}
+
+// SetOutputOptions 배칭/압축 옵션 설정 (Start 이전에 호출)
+func (c *Client) SetOutputOptions(opts OutputOptions) {
+	c.output = opts
+}
+
+// SendHello 협상 결과와 하트비트 설정을 알립니다 (v2 이상에서만 전송)
+func (c *Client) SendHello() bool {
+	if c.protocol < ProtocolV2 {
+		return false
+	}
+	return c.SendMessage(NewMessage(MessageTypeHello, HelloMessage{
+		ClientID:          c.ID,
+		ProtocolVersion:   c.protocol,
+		SupportedVersions: SupportedProtocolVersions(),
+		PingIntervalMs:    c.config.PingInterval.Milliseconds(),
+		IdleTimeoutMs:     c.config.IdleTimeout.Milliseconds(),
+		BatchIntervalMs:   c.output.BatchInterval.Milliseconds(),
+		BatchMaxBytes:     c.output.BatchMaxBytes,
+		Compression:       c.output.Compression,
+	}))
+}
+
+// extendReadDeadline 퐁이나 메시지를 받을 때마다 읽기 한도를 연장합니다
+func (c *Client) extendReadDeadline() {
+	if timeout := c.config.livenessTimeout(); timeout > 0 {
+		c.Conn.SetReadDeadline(time.Now().Add(timeout))
+	}
+}
+
 // IsConnected 연결 상태 확인
 func (c *Client) IsConnected() bool {
 	select {
@@ -183,29 +307,34 @@ func (c *Client) GetChannels() []string {
 
 // Send 메시지 전송
 func (c *Client) Send(message []byte) bool {
+	return c.enqueue(outboundFrame{data: message})
+}
+
+// enqueue 프레임을 전송 버퍼에 넣습니다
+func (c *Client) enqueue(frame outboundFrame) bool {
 	if !c.IsConnected() {
 		return false
 	}
 	
 	select {
-	case c.send <- message:
+	case c.send <- frame:
 		return true
 	default:
 		// 버퍼가 가득 찬 경우 연결 해제
 		log.Printf("클라이언트 %s 전송 버퍼 가득참, 연결 해제", c.ID)
-		c.Stop()
+		c.Close(CloseSlowConsumer, "send buffer full")
 		return false
 	}
 }
 
 // SendMessage 메시지 객체 전송
 func (c *Client) SendMessage(msg *Message) bool {
-	data, err := msg.ToJSON()
+	data, err := EncodeMessage(msg, c.protocol)
 	if err != nil {
 		log.Printf("메시지 JSON 변환 실패: %v", err)
 		return false
 	}
-	return c.Send(data)
+	return c.enqueue(outboundFrame{data: data, batchable: isStreamOutput(msg.Type)})
 }
 
 // SendError 에러 메시지 전송
@@ -224,83 +353,175 @@ func (c *Client) SendSuccess(message string, data interface{}) {
 func (c *Client) readPump() {
 	defer func() {
 		c.hub.Unregister(c)
-		c.Conn.Close()
+		c.Stop()
 		close(c.done)
 	}()
 	
 	for {
-		select {
-		case <-c.ctx.Done():
-			return
-		default:
-			_, message, err := c.Conn.ReadMessage()
-			if err != nil {
-				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-					log.Printf("WebSocket 읽기 에러: %v", err)
-				}
-				return
+		_, message, err := c.Conn.ReadMessage()
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				log.Printf("클라이언트 %s 퐁 응답 없음, 연결 해제", c.ID)
+				c.Close(CloseHeartbeatTimeout, "heartbeat timeout")
+			} else if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				log.Printf("WebSocket 읽기 에러: %v", err)
 			}
-			
-			c.messagesReceived++
-			
-			// 메시지 처리
-			if err := c.handleMessage(message); err != nil {
-				log.Printf("메시지 처리 에러 (클라이언트 %s): %v", c.ID, err)
-				c.SendError("MESSAGE_ERROR", "메시지 처리 실패", err.Error())
+			return
+		}
+		
+		c.messagesReceived++
+		c.lastActivity.Store(time.Now().UnixNano())
+		c.extendReadDeadline()
+		
+		// 메시지 처리
+		if err := c.handleMessage(message); err != nil {
+			var protoErr *ProtocolError
+			if errors.As(err, &protoErr) {
+				c.SendError("UNSUPPORTED_PROTOCOL", "지원하지 않는 프로토콜 버전", err.Error())
+				c.Close(CloseUnsupportedProtocol, "unsupported protocol version")
+				continue
 			}
+			log.Printf("메시지 처리 에러 (클라이언트 %s): %v", c.ID, err)
+			c.SendError("MESSAGE_ERROR", "메시지 처리 실패", err.Error())
 		}
 	}
 }
 
 // writePump 메시지 쓰기 펌프
 func (c *Client) writePump() {
-	ticker := time.NewTicker(54 * time.Second)
+	pingInterval := c.config.PingInterval
+	if pingInterval <= 0 {
+		pingInterval = DefaultClientConfig().PingInterval
+	}
+	ticker := time.NewTicker(pingInterval)
+	batcher := newOutputBatcher(c.output)
 	defer func() {
 		ticker.Stop()
+		batcher.take()
 		c.Conn.Close()
 	}()
 	
+	if c.output.Compression {
+		if err := c.Conn.SetCompressionLevel(c.config.CompressionLevel); err != nil {
+			log.Printf("압축 수준 설정 실패 (클라이언트 %s): %v", c.ID, err)
+		}
+	}
+	
 	for {
 		select {
-		case message, ok := <-c.send:
-			c.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+		case frame, ok := <-c.send:
 			if !ok {
-				c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+				c.flushBatch(batcher)
+				c.writeClose()
 				return
 			}
 			
-			if err := c.Conn.WriteMessage(websocket.TextMessage, message); err != nil {
-				log.Printf("WebSocket 쓰기 에러: %v", err)
+			if frame.batchable && batcher.enabled() {
+				if batcher.add(frame.data) && !c.flushBatch(batcher) {
+					return
+				}
+				continue
+			}
+			
+			// 순서를 지키기 위해 모인 출력을 먼저 보냄
+			if !c.flushBatch(batcher) || !c.writeFrame(frame.data) {
 				return
 			}
 			
-			c.messagesSent++
+		case <-batcher.C():
+			if !c.flushBatch(batcher) {
+				return
+			}
 			
 		case <-ticker.C:
-			c.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			c.Conn.SetWriteDeadline(time.Now().Add(c.config.WriteTimeout))
 			if err := c.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
 			c.lastPing = time.Now()
 			
 		case <-c.ctx.Done():
+			c.flushBatch(batcher)
+			c.writeClose()
 			return
 		}
 	}
 }
 
-// pingPump 핑 펌프 (연결 상태 모니터링)
-func (c *Client) pingPump() {
-	ticker := time.NewTicker(30 * time.Second)
+// writeFrame 텍스트 프레임 하나를 씁니다 (작은 프레임은 압축하지 않음)
+func (c *Client) writeFrame(data []byte) bool {
+	if c.hub != nil && c.hub.frameDropper != nil && c.hub.frameDropper.DropFrame() {
+		return true
+	}
+	
+	c.Conn.SetWriteDeadline(time.Now().Add(c.config.WriteTimeout))
+	c.Conn.EnableWriteCompression(c.output.Compression && len(data) >= c.config.CompressionThreshold)
+	if err := c.Conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		log.Printf("WebSocket 쓰기 에러: %v", err)
+		return false
+	}
+	
+	c.messagesSent++
+	return true
+}
+
+// flushBatch 모인 스트림 출력을 보냅니다. 하나뿐이면 그대로, 여럿이면 batch 메시지로 묶습니다.
+func (c *Client) flushBatch(batcher *outputBatcher) bool {
+	pending := batcher.take()
+	switch len(pending) {
+	case 0:
+		return true
+	case 1:
+		return c.writeFrame(pending[0])
+	}
+	
+	data, err := EncodeMessage(NewMessage(MessageTypeBatch, BatchMessage{Messages: pending}), c.protocol)
+	if err != nil {
+		log.Printf("배치 메시지 JSON 변환 실패: %v", err)
+		return true
+	}
+	if !c.writeFrame(data) {
+		return false
+	}
+	c.batchesSent++
+	return true
+}
+
+// writeClose 종료 프레임을 보내고 상대의 종료 프레임(읽기 펌프 종료)을 잠시 기다립니다
+func (c *Client) writeClose() {
+	code, reason := c.closeStatus()
+	deadline := time.Now().Add(c.config.WriteTimeout)
+	if err := c.Conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), deadline); err != nil {
+		return
+	}
+	
+	select {
+	case <-c.done:
+	case <-time.After(c.config.CloseGracePeriod):
+	}
+}
+
+// idlePump 유휴 연결 감시 (IdleTimeout 동안 애플리케이션 메시지가 없으면 종료)
+func (c *Client) idlePump() {
+	if c.config.IdleTimeout <= 0 {
+		return
+	}
+	
+	interval := c.config.IdleTimeout / 4
+	if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 	
 	for {
 		select {
 		case <-ticker.C:
-			// 마지막 퐁으로부터 너무 오래 지났으면 연결 해제
-			if time.Since(c.lastPong) > 60*time.Second {
-				log.Printf("클라이언트 %s 응답 없음, 연결 해제", c.ID)
-				c.Stop()
+			idle := time.Since(time.Unix(0, c.lastActivity.Load()))
+			if idle > c.config.IdleTimeout {
+				log.Printf("클라이언트 %s 유휴 시간 초과 (%s), 연결 해제", c.ID, idle.Round(time.Second))
+				c.Close(CloseIdleTimeout, "idle timeout")
 				return
 			}
 			
@@ -312,7 +533,7 @@ func (c *Client) pingPump() {
 
 // handleMessage 수신된 메시지 처리
 func (c *Client) handleMessage(data []byte) error {
-	msg, err := ParseMessage(data)
+	msg, err := DecodeMessage(data)
 	if err != nil {
 		return err
 	}
@@ -327,6 +548,10 @@ func (c *Client) handleMessage(data []byte) error {
 		return c.handleSubscribeMessage(msg)
 	case MessageTypeUnsubscribe:
 		return c.handleUnsubscribeMessage(msg)
+	case MessageTypeResume:
+		return c.handleResumeMessage(msg)
+	case MessageTypeAck:
+		return c.handleAckMessage(msg)
 	case MessageTypeCommand:
 		return c.handleCommandMessage(msg)
 	default:
@@ -358,7 +583,7 @@ func (c *Client) handleAuthMessage(msg *Message) error {
 		})
 		
 		// 사용자별 채널 자동 구독
-		c.Subscribe(GetUserChannel(c.UserID))
+		c.subscribe(GetUserChannel(c.UserID))
 	} else {
 		c.SendError("INVALID_TOKEN", "유효하지 않은 토큰입니다", "")
 	}
@@ -388,7 +613,7 @@ func (c *Client) handleSubscribeMessage(msg *Message) error {
 	}
 	
 	// TODO: 채널별 권한 확인 로직 추가
-	c.Subscribe(sub.Channels...)
+	c.subscribe(sub.Channels...)
 	c.SendSuccess("채널 구독 완료", map[string]interface{}{
 		"channels": sub.Channels,
 	})
@@ -404,6 +629,11 @@ func (c *Client) handleUnsubscribeMessage(msg *Message) error {
 	}
 	
 	c.Unsubscribe(unsub.Channels...)
+	if c.hub != nil {
+		for _, channel := range unsub.Channels {
+			c.hub.unsubscribeClientFromChannel(c, channel)
+		}
+	}
 	c.SendSuccess("채널 구독 취소 완료", map[string]interface{}{
 		"channels": unsub.Channels,
 	})
@@ -411,6 +641,70 @@ func (c *Client) handleUnsubscribeMessage(msg *Message) error {
 	return nil
 }
 
+// subscribe 채널을 구독하고 허브의 채널 브로드캐스트 대상에 등록
+func (c *Client) subscribe(channels ...string) {
+	c.Subscribe(channels...)
+	if c.hub != nil {
+		for _, channel := range channels {
+			c.hub.subscribeClientToChannel(c, channel)
+		}
+	}
+}
+
+// handleResumeMessage 재개 메시지 처리
+// 마지막으로 받은 이벤트 이후를 재전송한 뒤 채널을 구독합니다 (결과는 resumed 메시지로 전달).
+func (c *Client) handleResumeMessage(msg *Message) error {
+	resume, err := msg.ParseResumeMessage()
+	if err != nil {
+		return err
+	}
+	
+	if !c.isAuthenticated {
+		c.SendError("NOT_AUTHENTICATED", "인증이 필요합니다", "")
+		return nil
+	}
+	
+	var afterID uint64
+	if resume.LastEventID != "" {
+		afterID, err = strconv.ParseUint(resume.LastEventID, 10, 64)
+		if err != nil {
+			c.SendError("INVALID_EVENT_ID", "유효하지 않은 이벤트 ID입니다", resume.LastEventID)
+			return nil
+		}
+	} else if events := c.hub.EventLog(); events != nil {
+		// 이벤트 ID를 잃어버린 클라이언트는 마지막으로 확인한 위치부터 재개
+		afterID = events.LastAck(c.UserID, resume.Channel)
+	}
+	
+	if err := c.hub.Resume(c, resume.Channel, afterID); err != nil {
+		c.SendError("RESUME_UNAVAILABLE", "이벤트를 재개할 수 없습니다", err.Error())
+	}
+	return nil
+}
+
+// handleAckMessage 수신 확인 메시지 처리 (응답 없음)
+func (c *Client) handleAckMessage(msg *Message) error {
+	ack, err := msg.ParseAckMessage()
+	if err != nil {
+		return err
+	}
+	
+	if !c.isAuthenticated {
+		c.SendError("NOT_AUTHENTICATED", "인증이 필요합니다", "")
+		return nil
+	}
+	
+	eventID, err := strconv.ParseUint(ack.EventID, 10, 64)
+	if err != nil {
+		c.SendError("INVALID_EVENT_ID", "유효하지 않은 이벤트 ID입니다", ack.EventID)
+		return nil
+	}
+	if events := c.hub.EventLog(); events != nil {
+		events.Ack(c.UserID, ack.Channel, eventID)
+	}
+	return nil
+}
+
 // handleCommandMessage 명령 메시지 처리
 func (c *Client) handleCommandMessage(msg *Message) error {
 	if !c.isAuthenticated {
@@ -445,8 +739,12 @@ func (c *Client) GetStats() map[string]interface{} {
 		"channels":           c.GetChannels(),
 		"messages_received":  c.messagesReceived,
 		"messages_sent":      c.messagesSent,
+		"batches_sent":       c.batchesSent,
+		"batch_interval_ms":  c.output.BatchInterval.Milliseconds(),
+		"compression":        c.output.Compression,
 		"last_ping":          c.lastPing,
 		"last_pong":          c.lastPong,
+		"protocol_version":   c.protocol,
 		"uptime":             time.Since(c.connectedAt).String(),
 	}
 }
\ No newline at end of file
@@ -0,0 +1,437 @@
+package websocket
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventLogConfig 채널 이벤트 로그 설정
+type EventLogConfig struct {
+	// Dir 메모리에서 밀려난 이벤트를 저장할 디렉토리 (비어 있으면 링 버퍼만 사용)
+	Dir string
+	// RingSize 채널별로 메모리에 보관하는 최근 이벤트 수
+	RingSize int
+	// MaxEvents 채널별 최대 보관 이벤트 수 (메모리 + 디스크, 넘으면 오래된 것부터 버림)
+	MaxEvents int
+	// Retention 마지막 이벤트 이후 채널 로그를 보관하는 시간
+	Retention time.Duration
+	// ChannelTypes 기록할 채널 종류 (session, task 등)
+	ChannelTypes []string
+}
+
+// DefaultEventLogConfig 기본 이벤트 로그 설정
+func DefaultEventLogConfig() EventLogConfig {
+	return EventLogConfig{
+		RingSize:     512,
+		MaxEvents:    10000,
+		Retention:    time.Hour,
+		ChannelTypes: []string{ChannelSession, ChannelTask},
+	}
+}
+
+// ReplayResult 재개 요청에 대한 재전송 결과
+type ReplayResult struct {
+	Events    []*Message
+	OldestID  uint64 // 보관 중인 가장 오래된 이벤트 ID (없으면 0)
+	LastID    uint64 // 마지막으로 발급한 이벤트 ID
+	Truncated bool   // 요청한 ID 이후 이벤트 일부가 보관 한도로 버려졌음
+	HasMore   bool   // limit 때문에 돌려주지 못한 이벤트가 남아 있음
+}
+
+// loggedEvent 디스크에 한 줄로 저장되는 이벤트
+type loggedEvent struct {
+	ID      uint64   `json:"id"`
+	Message *Message `json:"message"`
+}
+
+// eventStream 채널 하나의 이벤트 기록
+// ID firstID부터 ring[0] 직전까지는 디스크에, 그 이후는 ring에 있습니다.
+type eventStream struct {
+	channel   string
+	lastID    uint64
+	firstID   uint64 // 보관 중인 가장 오래된 ID (0이면 보관 중인 이벤트 없음)
+	ring      []loggedEvent
+	file      *os.File
+	path      string
+	diskLines int // 파일의 전체 줄 수 (firstID 이전의 버려진 줄 포함)
+	updatedAt time.Time
+}
+
+// EventLog 채널별 이벤트 로그
+// 세션/태스크 채널로 나간 메시지에 채널별로 단조 증가하는 ID를 붙여 보관하고,
+// 재연결한 클라이언트가 마지막으로 확인한 ID 이후 이벤트를 다시 받을 수 있게 합니다.
+type EventLog struct {
+	config  EventLogConfig
+	mu      sync.Mutex
+	streams map[string]*eventStream
+	acks    map[string]uint64 // 사용자 + 채널 → 마지막 확인 ID
+}
+
+// NewEventLog 새 이벤트 로그 생성
+func NewEventLog(config EventLogConfig) (*EventLog, error) {
+	defaults := DefaultEventLogConfig()
+	if config.RingSize <= 0 {
+		config.RingSize = defaults.RingSize
+	}
+	if config.MaxEvents <= 0 {
+		config.MaxEvents = defaults.MaxEvents
+	}
+	if config.MaxEvents < config.RingSize {
+		config.RingSize = config.MaxEvents
+	}
+	if config.Retention <= 0 {
+		config.Retention = defaults.Retention
+	}
+	if len(config.ChannelTypes) == 0 {
+		config.ChannelTypes = defaults.ChannelTypes
+	}
+	if config.Dir != "" {
+		if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+			return nil, fmt.Errorf("이벤트 로그 디렉토리 생성 실패: %w", err)
+		}
+		removeExpiredEventLogs(config.Dir, time.Now().Add(-config.Retention))
+	}
+
+	return &EventLog{
+		config:  config,
+		streams: make(map[string]*eventStream),
+		acks:    make(map[string]uint64),
+	}, nil
+}
+
+// Records 이벤트를 기록하는 채널인지 확인
+func (l *EventLog) Records(channel string) bool {
+	channelType, _, ok := strings.Cut(channel, ":")
+	if !ok {
+		return false
+	}
+	for _, t := range l.config.ChannelTypes {
+		if t == channelType {
+			return true
+		}
+	}
+	return false
+}
+
+// Append 메시지에 채널 이벤트 ID를 붙여 기록하고, ID와 채널이 채워진 사본을 반환합니다
+func (l *EventLog) Append(channel string, msg *Message) *Message {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stream := l.streamLocked(channel)
+	stream.lastID++
+	if stream.firstID == 0 {
+		stream.firstID = stream.lastID
+	}
+	stream.updatedAt = time.Now()
+
+	stamped := *msg
+	stamped.ID = strconv.FormatUint(stream.lastID, 10)
+	stamped.Channel = channel
+	stream.ring = append(stream.ring, loggedEvent{ID: stream.lastID, Message: &stamped})
+
+	// 링 버퍼를 넘으면 가장 오래된 이벤트를 디스크로 내보냄 (디스크가 없으면 버림)
+	if len(stream.ring) > l.config.RingSize {
+		evicted := stream.ring[0]
+		stream.ring = stream.ring[1:]
+		if !l.spillLocked(stream, evicted) {
+			stream.firstID = stream.ring[0].ID
+		}
+	}
+
+	// 보관 한도를 넘은 오래된 이벤트 버림
+	if stream.lastID-stream.firstID+1 > uint64(l.config.MaxEvents) {
+		stream.firstID = stream.lastID - uint64(l.config.MaxEvents) + 1
+		l.compactLocked(stream)
+	}
+
+	return &stamped
+}
+
+// Replay afterID 이후의 이벤트를 최대 limit개 반환합니다 (limit이 0 이하면 전부).
+// afterID가 마지막 ID보다 크면(서버 로그가 초기화됨) 보관 중인 이벤트 전체를 잘린 것으로 표시해 반환합니다.
+func (l *EventLog) Replay(channel string, afterID uint64, limit int) (*ReplayResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stream := l.streamLocked(channel)
+	result := &ReplayResult{Events: []*Message{}, OldestID: stream.firstID, LastID: stream.lastID}
+
+	if afterID > stream.lastID {
+		result.Truncated = true
+		afterID = 0
+	}
+	if afterID >= stream.lastID {
+		return result, nil
+	}
+	if afterID+1 < stream.firstID {
+		result.Truncated = true
+	}
+
+	// 링 버퍼보다 오래된 이벤트는 디스크에서 읽음
+	ringStart := stream.lastID + 1
+	if len(stream.ring) > 0 {
+		ringStart = stream.ring[0].ID
+	}
+	if afterID+1 < ringStart && stream.diskLines > 0 {
+		events, err := l.readDiskLocked(stream, afterID, ringStart)
+		if err != nil {
+			return nil, err
+		}
+		result.Events = append(result.Events, events...)
+	}
+
+	for _, event := range stream.ring {
+		if event.ID > afterID {
+			result.Events = append(result.Events, event.Message)
+		}
+	}
+
+	if limit > 0 && len(result.Events) > limit {
+		result.Events = result.Events[:limit]
+		result.HasMore = true
+	}
+	return result, nil
+}
+
+// Ack 사용자가 채널의 이벤트를 어디까지 받았는지 기록
+func (l *EventLog) Ack(userID, channel string, eventID uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := userID + "\x00" + channel
+	if eventID > l.acks[key] {
+		l.acks[key] = eventID
+	}
+}
+
+// LastAck 사용자가 마지막으로 확인한 채널 이벤트 ID (없으면 0)
+func (l *EventLog) LastAck(userID, channel string) uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.acks[userID+"\x00"+channel]
+}
+
+// Sweep 보관 시간이 지난 채널 로그를 삭제합니다
+func (l *EventLog) Sweep(now time.Time) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	removed := 0
+	for channel, stream := range l.streams {
+		if now.Sub(stream.updatedAt) <= l.config.Retention {
+			continue
+		}
+		l.closeStreamLocked(stream)
+		if stream.path != "" {
+			os.Remove(stream.path)
+		}
+		delete(l.streams, channel)
+		for key := range l.acks {
+			if strings.HasSuffix(key, "\x00"+channel) {
+				delete(l.acks, key)
+			}
+		}
+		removed++
+	}
+	return removed
+}
+
+// Close 링 버퍼의 이벤트를 디스크로 내보내고 파일을 닫습니다 (재시작 후에도 재개할 수 있음)
+func (l *EventLog) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, stream := range l.streams {
+		if stream.path != "" {
+			for len(stream.ring) > 0 && l.spillLocked(stream, stream.ring[0]) {
+				stream.ring = stream.ring[1:]
+			}
+		}
+		l.closeStreamLocked(stream)
+	}
+}
+
+// streamLocked 채널 기록 조회 (없으면 디스크에서 복원하거나 생성, 호출자가 잠금 보유)
+func (l *EventLog) streamLocked(channel string) *eventStream {
+	if stream, exists := l.streams[channel]; exists {
+		return stream
+	}
+
+	stream := &eventStream{channel: channel, updatedAt: time.Now()}
+	if l.config.Dir != "" {
+		stream.path = filepath.Join(l.config.Dir, eventLogFileName(channel))
+		if err := l.restoreLocked(stream); err != nil {
+			log.Printf("이벤트 로그 복원 실패 (%s): %v", channel, err)
+		}
+	}
+	l.streams[channel] = stream
+	return stream
+}
+
+// restoreLocked 서버 재시작 전에 디스크로 내보낸 이벤트의 범위를 복원합니다
+func (l *EventLog) restoreLocked(stream *eventStream) error {
+	file, err := os.Open(stream.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var event loggedEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		if stream.firstID == 0 {
+			stream.firstID = event.ID
+		}
+		stream.lastID = event.ID
+		stream.diskLines++
+	}
+	return scanner.Err()
+}
+
+// spillLocked 링 버퍼에서 밀려난 이벤트를 디스크에 추가합니다
+func (l *EventLog) spillLocked(stream *eventStream, event loggedEvent) bool {
+	if stream.path == "" {
+		return false
+	}
+	if stream.file == nil {
+		file, err := os.OpenFile(stream.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			log.Printf("이벤트 로그 파일 열기 실패 (%s): %v", stream.channel, err)
+			return false
+		}
+		stream.file = file
+	}
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		return false
+	}
+	if _, err := stream.file.Write(append(line, '\n')); err != nil {
+		log.Printf("이벤트 로그 쓰기 실패 (%s): %v", stream.channel, err)
+		return false
+	}
+	stream.diskLines++
+	return true
+}
+
+// readDiskLocked 디스크에서 afterID 초과 before 미만인 보관 중 이벤트를 읽습니다
+func (l *EventLog) readDiskLocked(stream *eventStream, afterID, before uint64) ([]*Message, error) {
+	file, err := os.Open(stream.path)
+	if err != nil {
+		return nil, fmt.Errorf("이벤트 로그 읽기 실패: %w", err)
+	}
+	defer file.Close()
+
+	var events []*Message
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var event loggedEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		if event.ID > afterID && event.ID >= stream.firstID && event.ID < before {
+			events = append(events, event.Message)
+		}
+	}
+	return events, scanner.Err()
+}
+
+// compactLocked 버려진 줄이 보관 중인 줄보다 많아지면 파일을 다시 씁니다
+func (l *EventLog) compactLocked(stream *eventStream) {
+	if stream.file == nil {
+		return
+	}
+	live := 0
+	if len(stream.ring) > 0 && stream.ring[0].ID > stream.firstID {
+		live = int(stream.ring[0].ID - stream.firstID)
+	}
+	if stream.diskLines-live <= live || stream.diskLines < l.config.RingSize {
+		return
+	}
+
+	events, err := l.readDiskLocked(stream, stream.firstID-1, stream.lastID+1)
+	if err != nil {
+		log.Printf("이벤트 로그 정리 실패 (%s): %v", stream.channel, err)
+		return
+	}
+
+	tmpPath := stream.path + ".tmp"
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		log.Printf("이벤트 로그 정리 실패 (%s): %v", stream.channel, err)
+		return
+	}
+	writer := bufio.NewWriter(tmp)
+	for _, msg := range events {
+		id, _ := strconv.ParseUint(msg.ID, 10, 64)
+		line, _ := json.Marshal(loggedEvent{ID: id, Message: msg})
+		writer.Write(append(line, '\n'))
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return
+	}
+	tmp.Close()
+
+	stream.file.Close()
+	stream.file = nil
+	if err := os.Rename(tmpPath, stream.path); err != nil {
+		log.Printf("이벤트 로그 정리 실패 (%s): %v", stream.channel, err)
+		return
+	}
+	stream.diskLines = len(events)
+}
+
+func (l *EventLog) closeStreamLocked(stream *eventStream) {
+	if stream.file != nil {
+		stream.file.Close()
+		stream.file = nil
+	}
+}
+
+// removeExpiredEventLogs 재시작 전에 남은 로그 중 보관 시간이 지난 파일을 삭제합니다
+func removeExpiredEventLogs(dir string, before time.Time) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".jsonl" {
+			continue
+		}
+		if info, err := entry.Info(); err == nil && info.ModTime().Before(before) {
+			os.Remove(filepath.Join(dir, entry.Name()))
+		}
+	}
+}
+
+// eventLogFileName 채널 이름을 파일 이름으로 바꿉니다 (session:abc → session_abc.jsonl)
+func eventLogFileName(channel string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, channel)
+	return name + ".jsonl"
+}
@@ -0,0 +1,252 @@
+package websocket
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func appendEvents(l *EventLog, channel string, n int) {
+	for i := 0; i < n; i++ {
+		l.Append(channel, NewLogMessage("info", "line "+strconv.Itoa(i), "claude", "s1", ""))
+	}
+}
+
+func eventIDs(events []*Message) []string {
+	ids := make([]string, len(events))
+	for i, msg := range events {
+		ids[i] = msg.ID
+	}
+	return ids
+}
+
+func TestEventLog_AppendAndReplay(t *testing.T) {
+	events, err := NewEventLog(EventLogConfig{RingSize: 4})
+	require.NoError(t, err)
+
+	assert.True(t, events.Records("session:s1"))
+	assert.False(t, events.Records("workspace:w1"))
+	assert.False(t, events.Records("broadcast"))
+
+	stamped := events.Append("session:s1", NewLogMessage("info", "hello", "claude", "s1", ""))
+	assert.Equal(t, "1", stamped.ID)
+	assert.Equal(t, "session:s1", stamped.Channel)
+	appendEvents(events, "session:s1", 2)
+
+	result, err := events.Replay("session:s1", 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, eventIDs(result.Events))
+	assert.Equal(t, uint64(3), result.LastID)
+	assert.False(t, result.Truncated)
+
+	result, err = events.Replay("session:s1", 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, eventIDs(result.Events))
+	assert.True(t, result.HasMore)
+
+	// 채널마다 ID가 따로 증가
+	assert.Equal(t, "1", events.Append("task:t1", NewLogMessage("info", "x", "claude", "", "t1")).ID)
+}
+
+func TestEventLog_TruncatedWithoutDisk(t *testing.T) {
+	events, err := NewEventLog(EventLogConfig{RingSize: 3})
+	require.NoError(t, err)
+	appendEvents(events, "session:s1", 5)
+
+	result, err := events.Replay("session:s1", 1, 0)
+	require.NoError(t, err)
+	assert.True(t, result.Truncated)
+	assert.Equal(t, uint64(3), result.OldestID)
+	assert.Equal(t, []string{"3", "4", "5"}, eventIDs(result.Events))
+
+	// 서버 로그보다 앞선 ID는 초기화된 것으로 보고 처음부터 재전송
+	result, err = events.Replay("session:s1", 99, 0)
+	require.NoError(t, err)
+	assert.True(t, result.Truncated)
+	assert.Len(t, result.Events, 3)
+}
+
+func TestEventLog_DiskSpillAndRestore(t *testing.T) {
+	dir := t.TempDir()
+	events, err := NewEventLog(EventLogConfig{Dir: dir, RingSize: 2, MaxEvents: 100})
+	require.NoError(t, err)
+	appendEvents(events, "session:s1", 6)
+
+	_, err = os.Stat(filepath.Join(dir, "session_s1.jsonl"))
+	require.NoError(t, err)
+
+	result, err := events.Replay("session:s1", 1, 0)
+	require.NoError(t, err)
+	assert.False(t, result.Truncated)
+	assert.Equal(t, []string{"2", "3", "4", "5", "6"}, eventIDs(result.Events))
+
+	// 재시작 후에도 같은 ID 이후부터 이어서 재개
+	events.Close()
+	restored, err := NewEventLog(EventLogConfig{Dir: dir, RingSize: 2, MaxEvents: 100})
+	require.NoError(t, err)
+
+	result, err = restored.Replay("session:s1", 4, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"5", "6"}, eventIDs(result.Events))
+	assert.Equal(t, "7", restored.Append("session:s1", NewLogMessage("info", "after", "claude", "s1", "")).ID)
+}
+
+func TestEventLog_MaxEvents(t *testing.T) {
+	events, err := NewEventLog(EventLogConfig{Dir: t.TempDir(), RingSize: 2, MaxEvents: 4})
+	require.NoError(t, err)
+	appendEvents(events, "session:s1", 10)
+
+	result, err := events.Replay("session:s1", 0, 0)
+	require.NoError(t, err)
+	assert.True(t, result.Truncated)
+	assert.Equal(t, uint64(7), result.OldestID)
+	assert.Equal(t, []string{"7", "8", "9", "10"}, eventIDs(result.Events))
+}
+
+func TestEventLog_AckAndSweep(t *testing.T) {
+	dir := t.TempDir()
+	events, err := NewEventLog(EventLogConfig{Dir: dir, RingSize: 1, Retention: time.Minute})
+	require.NoError(t, err)
+	appendEvents(events, "session:s1", 3)
+
+	events.Ack("u1", "session:s1", 2)
+	events.Ack("u1", "session:s1", 1)
+	assert.Equal(t, uint64(2), events.LastAck("u1", "session:s1"))
+	assert.Zero(t, events.LastAck("u2", "session:s1"))
+
+	assert.Zero(t, events.Sweep(time.Now()))
+	assert.Equal(t, 1, events.Sweep(time.Now().Add(2*time.Minute)))
+	assert.Zero(t, events.LastAck("u1", "session:s1"))
+	_, err = os.Stat(filepath.Join(dir, "session_s1.jsonl"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestEventLogFileName(t *testing.T) {
+	assert.Equal(t, "session_abc-1.jsonl", eventLogFileName("session:abc-1"))
+	assert.Equal(t, "task_.._x.jsonl", eventLogFileName("task:../x"))
+}
+
+// readMessage 다음 메시지를 읽습니다 (v1 형식)
+func readMessage(t *testing.T, conn *websocket.Conn) *Message {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	msg, err := DecodeMessage(data)
+	require.NoError(t, err)
+	return msg
+}
+
+func sendMessage(t *testing.T, conn *websocket.Conn, msgType MessageType, data interface{}) {
+	t.Helper()
+	raw, err := NewMessage(msgType, data).ToJSON()
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, raw))
+}
+
+func TestHub_ResumeAfterReconnect(t *testing.T) {
+	hub, url := startBatchingServer(t)
+	events, err := NewEventLog(EventLogConfig{Dir: t.TempDir(), RingSize: 2})
+	require.NoError(t, err)
+	hub.SetEventLog(events)
+
+	channel := GetSessionChannel("s1")
+	for i := 0; i < 5; i++ {
+		hub.Broadcast(NewLogMessage("info", "line", "claude", "s1", ""), channel)
+	}
+	require.Eventually(t, func() bool {
+		result, _ := events.Replay(channel, 0, 0)
+		return result.LastID == 5
+	}, 2*time.Second, 10*time.Millisecond)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// 인증 전에는 재개할 수 없음
+	sendMessage(t, conn, MessageTypeResume, ResumeMessage{Channel: channel, LastEventID: "2"})
+	assert.Equal(t, MessageTypeError, readMessage(t, conn).Type)
+
+	sendMessage(t, conn, MessageTypeAuth, AuthMessage{Token: "token"})
+	require.Equal(t, MessageTypeSuccess, readMessage(t, conn).Type)
+
+	sendMessage(t, conn, MessageTypeResume, ResumeMessage{Channel: channel, LastEventID: "2"})
+	for _, id := range []string{"3", "4", "5"} {
+		msg := readMessage(t, conn)
+		assert.Equal(t, MessageTypeLog, msg.Type)
+		assert.Equal(t, id, msg.ID)
+		assert.Equal(t, channel, msg.Channel)
+	}
+
+	resumedMsg := readMessage(t, conn)
+	require.Equal(t, MessageTypeResumed, resumedMsg.Type)
+	var resumed ResumedMessage
+	require.NoError(t, json.Unmarshal(resumedMsg.Data, &resumed))
+	assert.Equal(t, ResumedMessage{
+		Channel: channel, LastEventID: "5", HeadEventID: "5", OldestEventID: "1", Replayed: 3,
+	}, resumed)
+
+	// 따라잡은 뒤에는 실시간 이벤트를 이어서 받음
+	hub.Broadcast(NewLogMessage("info", "live", "claude", "s1", ""), channel)
+	live := readMessage(t, conn)
+	assert.Equal(t, "6", live.ID)
+
+	// 확인한 위치는 이벤트 ID 없이 재개할 때 사용
+	sendMessage(t, conn, MessageTypeAck, AckMessage{Channel: channel, EventID: "6"})
+	require.Eventually(t, func() bool { return events.LastAck("anonymous", channel) == 6 }, 2*time.Second, 10*time.Millisecond)
+
+	// 기록하지 않는 채널은 재개 불가
+	sendMessage(t, conn, MessageTypeResume, ResumeMessage{Channel: GetWorkspaceChannel("w1")})
+	assert.Equal(t, MessageTypeError, readMessage(t, conn).Type)
+}
+
+func TestHub_ResumePaging(t *testing.T) {
+	hub := NewHub(&HubConfig{
+		MaxClients: 10, CleanupInterval: time.Minute, StatsInterval: time.Minute,
+		BroadcastBuffer: 10, HeartbeatInterval: time.Minute, ReplayBatchSize: 2,
+	})
+	events, err := NewEventLog(EventLogConfig{})
+	require.NoError(t, err)
+	hub.SetEventLog(events)
+
+	channel := GetTaskChannel("t1")
+	appendEvents(events, channel, 3)
+
+	client := NewClient("c1", "u1", nil, hub, nil)
+
+	hub.handleResume(&resumeRequest{client: client, channel: channel, afterID: 0})
+	assert.False(t, client.IsSubscribed(channel))
+
+	var resumed ResumedMessage
+	frames := drainFrames(client)
+	require.Len(t, frames, 3)
+	msg, err := DecodeMessage(frames[2])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(msg.Data, &resumed))
+	assert.True(t, resumed.HasMore)
+	assert.Equal(t, "2", resumed.LastEventID)
+
+	hub.handleResume(&resumeRequest{client: client, channel: channel, afterID: 2})
+	assert.True(t, client.IsSubscribed(channel))
+	assert.Len(t, drainFrames(client), 2)
+}
+
+func drainFrames(client *Client) [][]byte {
+	var frames [][]byte
+	for {
+		select {
+		case frame := <-client.send:
+			frames = append(frames, frame.data)
+		default:
+			return frames
+		}
+	}
+}
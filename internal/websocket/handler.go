@@ -31,11 +31,14 @@ type HandlerConfig struct {
 	// 타임아웃 설정
 	HandshakeTimeout time.Duration
 	
-	// 압축 설정
+	// 압축 설정 (permessage-deflate, 연결별로 compress 쿼리 파라미터로 끌 수 있음)
 	EnableCompression bool
 	
-	// 서브프로토콜
+	// 서브프로토콜 (서버 선호 순, 프로토콜 버전 협상에 사용)
 	Subprotocols []string
+	
+	// 클라이언트 하트비트/유휴 설정 (nil이면 기본값)
+	Client *ClientConfig
 }
 
 // DefaultHandlerConfig 기본 핸들러 설정
@@ -49,8 +52,9 @@ func DefaultHandlerConfig() *HandlerConfig {
 		ReadBufferSize:    4096,
 		WriteBufferSize:   4096,
 		HandshakeTimeout:  10 * time.Second,
-		EnableCompression: false,
-		Subprotocols:      []string{"aicli-ws-v1"},
+		EnableCompression: true,
+		Subprotocols:      SupportedSubprotocols(),
+		Client:            DefaultClientConfig(),
 	}
 }
 
@@ -83,6 +87,26 @@ func NewWebSocketHandler(hub *Hub, jwtManager *auth.JWTManager, blacklist *auth.
 
 // HandleConnection WebSocket 연결 처리 (Gin 핸들러)
 func (wsh *WebSocketHandler) HandleConnection(c *gin.Context) {
+	// 지원하지 않는 프로토콜 버전은 업그레이드 전에 거부
+	if err := CheckProtocolRequest(c.Request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":              "Unsupported protocol version",
+			"message":            err.Error(),
+			"supported_versions": SupportedProtocolVersions(),
+			"subprotocols":       SupportedSubprotocols(),
+		})
+		return
+	}
+	
+	// 배칭/압축 옵션도 업그레이드 전에 검증
+	if _, err := ParseOutputOptions(c.Request, wsh.clientConfig().outputOptions()); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid output options",
+			"message": err.Error(),
+		})
+		return
+	}
+	
 	// WebSocket으로 업그레이드
 	conn, err := wsh.upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
@@ -100,6 +124,16 @@ func (wsh *WebSocketHandler) HandleConnection(c *gin.Context) {
 
 // HandleConnectionHTTP WebSocket 연결 처리 (표준 HTTP 핸들러)
 func (wsh *WebSocketHandler) HandleConnectionHTTP(w http.ResponseWriter, r *http.Request) {
+	// 지원하지 않는 프로토콜 버전은 업그레이드 전에 거부
+	if err := CheckProtocolRequest(r); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if _, err := ParseOutputOptions(r, wsh.clientConfig().outputOptions()); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	
 	// WebSocket으로 업그레이드
 	conn, err := wsh.upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -126,8 +160,26 @@ func (wsh *WebSocketHandler) handleNewConnection(r *http.Request, conn *websocke
 		log.Printf("WebSocket 연결 (인증 대기): %s", clientID)
 	}
 	
+	// 프로토콜 버전 협상 (서브프로토콜 → 쿼리 파라미터 → v1)
+	protocol, err := NegotiateProtocol(r, conn.Subprotocol())
+	if err != nil {
+		conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(CloseUnsupportedProtocol, "unsupported protocol version"),
+			time.Now().Add(time.Second))
+		conn.Close()
+		return
+	}
+	
+	// 연결별 배칭/압축 옵션 (쿼리 파라미터는 업그레이드 전에 검증됨)
+	config := wsh.clientConfig()
+	output, _ := ParseOutputOptions(r, config.outputOptions())
+	output.Compression = output.Compression && wsh.upgrader.EnableCompression && offersCompression(r)
+	
 	// 클라이언트 생성
-	client := NewClient(clientID, userID, conn, wsh.hub, nil)
+	client := NewClient(clientID, userID, conn, wsh.hub, config)
+	client.SetProtocol(protocol)
+	client.SetOutputOptions(output)
+	client.SendHello()
 	
 	// 허브에 등록
 	if err := wsh.hub.Register(client); err != nil {
@@ -137,8 +189,16 @@ func (wsh *WebSocketHandler) handleNewConnection(r *http.Request, conn *websocke
 		return
 	}
 	
-	log.Printf("새 WebSocket 연결: %s (사용자: %s, IP: %s)", 
-		clientID, userID, getClientIP(r))
+	log.Printf("새 WebSocket 연결: %s (사용자: %s, IP: %s, 프로토콜: v%d)", 
+		clientID, userID, getClientIP(r), protocol)
+}
+
+// clientConfig 연결에 적용할 클라이언트 설정
+func (wsh *WebSocketHandler) clientConfig() *ClientConfig {
+	if wsh.config.Client == nil {
+		return DefaultClientConfig()
+	}
+	return wsh.config.Client
 }
 
 // BroadcastManager 브로드캐스트 관리자
@@ -3,10 +3,12 @@ package websocket
 import (
 	"context"
 	"log"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
 )
 
 // Hub WebSocket 연결 허브
@@ -23,6 +25,13 @@ type Hub struct {
 	register   chan *Client
 	unregister chan *Client
 	broadcast  chan *BroadcastMessage
+	resume     chan *resumeRequest
+	
+	// 채널 이벤트 로그 (nil이면 재개 비활성)
+	events *EventLog
+	
+	// 보내는 프레임을 버릴지 결정 (장애 주입 테스트용, nil이면 모두 보냄)
+	frameDropper FrameDropper
 	
 	// 설정
 	config *HubConfig
@@ -44,6 +53,7 @@ type HubConfig struct {
 	StatsInterval     time.Duration // 통계 업데이트 주기
 	BroadcastBuffer   int           // 브로드캐스트 버퍼 크기
 	HeartbeatInterval time.Duration // 하트비트 간격
+	ReplayBatchSize   int           // 재개 요청 한 번에 재전송하는 최대 이벤트 수
 }
 
 // DefaultHubConfig 기본 허브 설정
@@ -54,6 +64,7 @@ func DefaultHubConfig() *HubConfig {
 		StatsInterval:     10 * time.Second,
 		BroadcastBuffer:   1000,
 		HeartbeatInterval: 30 * time.Second,
+		ReplayBatchSize:   256,
 	}
 }
 
@@ -80,6 +91,14 @@ type BroadcastMessage struct {
 	Exclude  []string // 제외할 클라이언트 ID
 }
 
+// resumeRequest 채널 재개 요청
+// 브로드캐스트와 같은 루프에서 처리해 재전송과 실시간 이벤트 사이에 빠지거나 겹치는 이벤트가 없게 합니다.
+type resumeRequest struct {
+	client  *Client
+	channel string
+	afterID uint64
+}
+
 // NewHub 새 허브 생성
 func NewHub(config *HubConfig) *Hub {
 	if config == nil {
@@ -94,6 +113,7 @@ func NewHub(config *HubConfig) *Hub {
 		register:   make(chan *Client, config.BroadcastBuffer),
 		unregister: make(chan *Client, config.BroadcastBuffer),
 		broadcast:  make(chan *BroadcastMessage, config.BroadcastBuffer),
+		resume:     make(chan *resumeRequest, config.BroadcastBuffer),
 		config:     config,
 		running:    false,
 		ctx:        ctx,
@@ -144,19 +164,77 @@ func (h *Hub) Stop() {
 	h.running = false
 	h.cancel()
 	
-	// 모든 클라이언트 연결 해제
+	// 모든 클라이언트 연결 해제 (재연결하도록 Going Away로 종료)
 	h.clientsMu.RLock()
 	for _, client := range h.clients {
-		client.Stop()
+		client.Close(websocket.CloseGoingAway, "server shutting down")
 	}
 	h.clientsMu.RUnlock()
 	
 	// 고루틴 종료 대기
 	h.wg.Wait()
 	
+	// 남은 이벤트를 디스크에 기록해 재시작 후에도 재개할 수 있게 함
+	if h.events != nil {
+		h.events.Close()
+	}
+	
 	log.Println("WebSocket 허브 중지됨")
 }
 
+// SetEventLog 채널 이벤트 로그 설정 (Start 전에 호출)
+func (h *Hub) SetEventLog(events *EventLog) {
+	h.events = events
+}
+
+// FrameDropper 클라이언트에 보내는 프레임을 버릴지 결정합니다 (장애 주입 테스트용)
+type FrameDropper interface {
+	DropFrame() bool
+}
+
+// SetFrameDropper 프레임 누락 주입 설정 (Start 전에 호출)
+// 버린 프레임은 보낸 것으로 처리하므로 클라이언트는 이벤트 로그 재개로 복구해야 합니다.
+func (h *Hub) SetFrameDropper(dropper FrameDropper) {
+	h.frameDropper = dropper
+}
+
+// EventLog 채널 이벤트 로그 반환 (설정하지 않았으면 nil)
+func (h *Hub) EventLog() *EventLog {
+	return h.events
+}
+
+// Resume 클라이언트가 afterID 이후 채널 이벤트를 재전송받고 채널을 다시 구독하도록 요청합니다
+func (h *Hub) Resume(client *Client, channel string, afterID uint64) error {
+	if h.events == nil {
+		return &HubError{
+			Code:    "EVENT_LOG_DISABLED",
+			Message: "이벤트 로그가 비활성화됨",
+		}
+	}
+	if !h.events.Records(channel) {
+		return &HubError{
+			Code:    "CHANNEL_NOT_RECORDED",
+			Message: "이벤트를 기록하지 않는 채널: " + channel,
+		}
+	}
+	if !h.running {
+		return &HubError{
+			Code:    "HUB_STOPPED",
+			Message: "허브가 중지됨",
+		}
+	}
+	
+	select {
+	case h.resume <- &resumeRequest{client: client, channel: channel, afterID: afterID}:
+		return nil
+	case <-h.ctx.Done():
+		return &HubError{
+			Code:    "HUB_STOPPED",
+			Message: "허브가 중지됨",
+		}
+	}
+}
+
 // Register 클라이언트 등록
 func (h *Hub) Register(client *Client) error {
 	if !h.running {
@@ -301,6 +379,9 @@ func (h *Hub) run() {
 		case broadcastMsg := <-h.broadcast:
 			h.handleBroadcast(broadcastMsg)
 			
+		case req := <-h.resume:
+			h.handleResume(req)
+			
 		case <-h.ctx.Done():
 			return
 		}
@@ -353,17 +434,28 @@ func (h *Hub) handleUnregister(client *Client) {
 
 // handleBroadcast 브로드캐스트 처리
 func (h *Hub) handleBroadcast(broadcastMsg *BroadcastMessage) {
-	messageData, err := broadcastMsg.Message.ToJSON()
-	if err != nil {
-		log.Printf("브로드캐스트 메시지 JSON 변환 실패: %v", err)
-		return
-	}
+	// 클라이언트마다 협상한 프로토콜 버전으로 직렬화 (버전별로 한 번만)
+	messageData := newEncodedMessage(broadcastMsg.Message)
 	
 	sentCount := 0
 	
+	// 이벤트 로그에 기록하는 채널은 채널별 이벤트 ID를 붙인 사본을 따로 전송
+	channels := broadcastMsg.Channels
+	if h.events != nil {
+		channels = nil
+		for _, channel := range broadcastMsg.Channels {
+			if !h.events.Records(channel) {
+				channels = append(channels, channel)
+				continue
+			}
+			stamped := h.events.Append(channel, broadcastMsg.Message)
+			sentCount += h.broadcastToChannels(newEncodedMessage(stamped), []string{channel}, broadcastMsg.Exclude)
+		}
+	}
+	
 	// 채널별 브로드캐스트
-	if len(broadcastMsg.Channels) > 0 {
-		sentCount += h.broadcastToChannels(messageData, broadcastMsg.Channels, broadcastMsg.Exclude)
+	if len(channels) > 0 {
+		sentCount += h.broadcastToChannels(messageData, channels, broadcastMsg.Exclude)
 	}
 	
 	// 사용자별 브로드캐스트
@@ -378,7 +470,7 @@ func (h *Hub) handleBroadcast(broadcastMsg *BroadcastMessage) {
 }
 
 // broadcastToChannels 채널별 브로드캐스트
-func (h *Hub) broadcastToChannels(messageData []byte, channels []string, exclude []string) int {
+func (h *Hub) broadcastToChannels(messageData *encodedMessage, channels []string, exclude []string) int {
 	h.channelsMu.RLock()
 	defer h.channelsMu.RUnlock()
 	
@@ -393,7 +485,7 @@ func (h *Hub) broadcastToChannels(messageData []byte, channels []string, exclude
 	for _, channel := range channels {
 		if clients, exists := h.channels[channel]; exists {
 			for clientID, client := range clients {
-				if !excludeMap[clientID] && !sent[clientID] && client.Send(messageData) {
+				if !excludeMap[clientID] && !sent[clientID] && h.sendEncoded(client, messageData) {
 					sent[clientID] = true
 					sentCount++
 				}
@@ -405,7 +497,7 @@ func (h *Hub) broadcastToChannels(messageData []byte, channels []string, exclude
 }
 
 // broadcastToUsers 사용자별 브로드캐스트
-func (h *Hub) broadcastToUsers(messageData []byte, userIDs []string, exclude []string) int {
+func (h *Hub) broadcastToUsers(messageData *encodedMessage, userIDs []string, exclude []string) int {
 	h.clientsMu.RLock()
 	defer h.clientsMu.RUnlock()
 	
@@ -418,7 +510,7 @@ func (h *Hub) broadcastToUsers(messageData []byte, userIDs []string, exclude []s
 	
 	for _, userID := range userIDs {
 		for _, client := range h.clients {
-			if client.UserID == userID && !excludeMap[client.ID] && client.Send(messageData) {
+			if client.UserID == userID && !excludeMap[client.ID] && h.sendEncoded(client, messageData) {
 				sentCount++
 			}
 		}
@@ -427,6 +519,16 @@ func (h *Hub) broadcastToUsers(messageData []byte, userIDs []string, exclude []s
 	return sentCount
 }
 
+// sendEncoded 클라이언트의 프로토콜 버전에 맞는 직렬화 결과를 전송
+func (h *Hub) sendEncoded(client *Client, messageData *encodedMessage) bool {
+	data, err := messageData.bytes(client.Protocol())
+	if err != nil {
+		log.Printf("브로드캐스트 메시지 JSON 변환 실패: %v", err)
+		return false
+	}
+	return client.enqueue(outboundFrame{data: data, batchable: isStreamOutput(messageData.msg.Type)})
+}
+
 // removeClientFromChannels 채널에서 클라이언트 제거
 func (h *Hub) removeClientFromChannels(client *Client) {
 	h.channelsMu.Lock()
@@ -453,6 +555,81 @@ func (h *Hub) subscribeClientToChannel(client *Client, channel string) {
 	h.channels[channel][client.ID] = client
 }
 
+// unsubscribeClientFromChannel 클라이언트의 채널 구독 해제
+func (h *Hub) unsubscribeClientFromChannel(client *Client, channel string) {
+	h.channelsMu.Lock()
+	defer h.channelsMu.Unlock()
+	
+	if clients, exists := h.channels[channel]; exists {
+		delete(clients, client.ID)
+		if len(clients) == 0 {
+			delete(h.channels, channel)
+		}
+	}
+}
+
+// handleResume 재개 요청 처리
+// 재전송할 이벤트가 남아 있으면(has_more) 구독하지 않고 기다리며,
+// 따라잡은 뒤에야 채널을 구독해 이후 이벤트를 실시간으로 받게 합니다.
+func (h *Hub) handleResume(req *resumeRequest) {
+	client := req.client
+	h.unsubscribeClientFromChannel(client, req.channel)
+	
+	// 전송 버퍼에 들어갈 만큼만 재전송
+	limit := h.config.ReplayBatchSize
+	if limit <= 0 {
+		limit = DefaultHubConfig().ReplayBatchSize
+	}
+	if free := cap(client.send) - len(client.send) - 1; free < limit {
+		limit = free
+	}
+	if limit < 1 {
+		limit = 1
+	}
+	
+	result, err := h.events.Replay(req.channel, req.afterID, limit)
+	if err != nil {
+		log.Printf("이벤트 재전송 실패 (클라이언트 %s, 채널 %s): %v", client.ID, req.channel, err)
+		client.SendError("RESUME_FAILED", "이벤트 재전송 실패", err.Error())
+		return
+	}
+	
+	lastID := req.afterID
+	if lastID > result.LastID {
+		lastID = result.LastID
+	}
+	for _, msg := range result.Events {
+		if !h.sendEncoded(client, newEncodedMessage(msg)) {
+			return
+		}
+	}
+	if n := len(result.Events); n > 0 {
+		lastID, _ = strconv.ParseUint(result.Events[n-1].ID, 10, 64)
+	}
+	
+	if !result.HasMore {
+		client.Subscribe(req.channel)
+		h.subscribeClientToChannel(client, req.channel)
+	}
+	
+	resumed := ResumedMessage{
+		Channel:     req.channel,
+		LastEventID: strconv.FormatUint(lastID, 10),
+		HeadEventID: strconv.FormatUint(result.LastID, 10),
+		Replayed:    len(result.Events),
+		Truncated:   result.Truncated,
+		HasMore:     result.HasMore,
+	}
+	if result.OldestID > 0 {
+		resumed.OldestEventID = strconv.FormatUint(result.OldestID, 10)
+	}
+	client.SendMessage(NewMessage(MessageTypeResumed, resumed).WithChannel(req.channel))
+	
+	h.stats.mu.Lock()
+	h.stats.MessagesSent += int64(len(result.Events))
+	h.stats.mu.Unlock()
+}
+
 // cleanupRoutine 정리 루틴
 func (h *Hub) cleanupRoutine() {
 	defer h.wg.Done()
@@ -490,6 +667,13 @@ func (h *Hub) cleanup() {
 	if len(disconnectedClients) > 0 {
 		log.Printf("정리됨: %d개 클라이언트", len(disconnectedClients))
 	}
+	
+	// 보관 시간이 지난 채널 이벤트 로그 정리
+	if h.events != nil {
+		if removed := h.events.Sweep(time.Now()); removed > 0 {
+			log.Printf("정리됨: %d개 채널 이벤트 로그", removed)
+		}
+	}
 }
 
 // statsRoutine 통계 업데이트 루틴
@@ -17,6 +17,11 @@ const (
 	MessageTypeSuccess    MessageType = "success"     // 성공
 	MessageTypeSubscribe  MessageType = "subscribe"   // 채널 구독
 	MessageTypeUnsubscribe MessageType = "unsubscribe" // 구독 취소
+	MessageTypeHello      MessageType = "hello"       // 연결 직후 협상 결과 (v2 이상)
+	MessageTypeBatch      MessageType = "batch"       // 묶어서 보낸 스트림 출력 (배칭을 요청한 연결만)
+	MessageTypeResume     MessageType = "resume"      // 마지막으로 받은 이벤트 이후부터 채널 재구독
+	MessageTypeResumed    MessageType = "resumed"     // 재개 결과 (재전송한 이벤트 뒤에 전송)
+	MessageTypeAck        MessageType = "ack"         // 채널 이벤트 수신 확인
 	
 	// 비즈니스 메시지
 	MessageTypeLog        MessageType = "log"         // 로그 스트림
@@ -49,6 +54,23 @@ type ErrorMessage struct {
 	Details string `json:"details,omitempty"`
 }
 
+// HelloMessage 연결 직후 보내는 협상 결과 데이터
+type HelloMessage struct {
+	ClientID          string            `json:"client_id"`
+	ProtocolVersion   ProtocolVersion   `json:"protocol_version"`
+	SupportedVersions []ProtocolVersion `json:"supported_versions"`
+	PingIntervalMs    int64             `json:"ping_interval_ms"`
+	IdleTimeoutMs     int64             `json:"idle_timeout_ms,omitempty"`
+	BatchIntervalMs   int64             `json:"batch_interval_ms,omitempty"`
+	BatchMaxBytes     int               `json:"batch_max_bytes,omitempty"`
+	Compression       bool              `json:"compression"`
+}
+
+// BatchMessage 한 프레임에 묶인 메시지 데이터 (각 항목은 연결의 프로토콜 형식으로 직렬화된 메시지)
+type BatchMessage struct {
+	Messages []json.RawMessage `json:"messages"`
+}
+
 // SuccessMessage 성공 메시지 데이터
 type SuccessMessage struct {
 	Message string      `json:"message"`
@@ -65,6 +87,30 @@ type UnsubscribeMessage struct {
 	Channels []string `json:"channels"`
 }
 
+// ResumeMessage 재개 요청 데이터
+// LastEventID가 비어 있으면 같은 사용자가 마지막으로 확인(ack)한 이벤트 이후부터 재전송합니다.
+type ResumeMessage struct {
+	Channel     string `json:"channel"`
+	LastEventID string `json:"last_event_id,omitempty"`
+}
+
+// ResumedMessage 재개 결과 데이터
+type ResumedMessage struct {
+	Channel       string `json:"channel"`
+	LastEventID   string `json:"last_event_id"`             // 이번에 재전송한 마지막 이벤트 ID (없으면 요청한 ID)
+	HeadEventID   string `json:"head_event_id"`             // 채널에서 마지막으로 발급된 이벤트 ID
+	OldestEventID string `json:"oldest_event_id,omitempty"` // 보관 중인 가장 오래된 이벤트 ID
+	Replayed      int    `json:"replayed"`
+	Truncated     bool   `json:"truncated"` // 요청한 ID 이후 이벤트 일부가 보관 한도를 넘어 유실됨
+	HasMore       bool   `json:"has_more"`  // true면 LastEventID로 다시 resume을 보내야 나머지를 받음
+}
+
+// AckMessage 이벤트 수신 확인 데이터
+type AckMessage struct {
+	Channel string `json:"channel"`
+	EventID string `json:"event_id"`
+}
+
 // LogMessage 로그 메시지 데이터
 type LogMessage struct {
 	Level     string    `json:"level"`
@@ -116,6 +162,29 @@ type SessionMessage struct {
 	Data      map[string]interface{} `json:"data,omitempty"`
 }
 
+// MessageDataTypes 메시지 타입별 Data 필드 구조체를 반환합니다.
+// OpenAPI 스펙과 TypeScript 클라이언트의 메시지 타입 정의가 이 목록에서 생성됩니다.
+func MessageDataTypes() map[MessageType]interface{} {
+	return map[MessageType]interface{}{
+		MessageTypeAuth:        AuthMessage{},
+		MessageTypeHello:       HelloMessage{},
+		MessageTypeBatch:       BatchMessage{},
+		MessageTypeError:       ErrorMessage{},
+		MessageTypeSuccess:     SuccessMessage{},
+		MessageTypeSubscribe:   SubscribeMessage{},
+		MessageTypeUnsubscribe: UnsubscribeMessage{},
+		MessageTypeResume:      ResumeMessage{},
+		MessageTypeResumed:     ResumedMessage{},
+		MessageTypeAck:         AckMessage{},
+		MessageTypeLog:         LogMessage{},
+		MessageTypeStatus:      StatusMessage{},
+		MessageTypeEvent:       EventMessage{},
+		MessageTypeCommand:     CommandMessage{},
+		MessageTypeTask:        TaskMessage{},
+		MessageTypeSession:     SessionMessage{},
+	}
+}
+
 // NewMessage 새 메시지 생성
 func NewMessage(msgType MessageType, data interface{}) *Message {
 	dataBytes, _ := json.Marshal(data)
@@ -209,7 +278,8 @@ func (m *Message) WithUserID(userID string) *Message {
 func (m *Message) IsSystemMessage() bool {
 	switch m.Type {
 	case MessageTypeAuth, MessageTypePing, MessageTypePong, MessageTypeError, MessageTypeSuccess,
-		 MessageTypeSubscribe, MessageTypeUnsubscribe:
+		 MessageTypeSubscribe, MessageTypeUnsubscribe, MessageTypeHello, MessageTypeBatch,
+		 MessageTypeResume, MessageTypeResumed, MessageTypeAck:
 		return true
 	default:
 		return false
@@ -262,6 +332,24 @@ func (m *Message) ParseUnsubscribeMessage() (*UnsubscribeMessage, error) {
 	return &unsub, nil
 }
 
+// ParseResumeMessage 재개 메시지 데이터 파싱
+func (m *Message) ParseResumeMessage() (*ResumeMessage, error) {
+	var resume ResumeMessage
+	if err := json.Unmarshal(m.Data, &resume); err != nil {
+		return nil, err
+	}
+	return &resume, nil
+}
+
+// ParseAckMessage 수신 확인 메시지 데이터 파싱
+func (m *Message) ParseAckMessage() (*AckMessage, error) {
+	var ack AckMessage
+	if err := json.Unmarshal(m.Data, &ack); err != nil {
+		return nil, err
+	}
+	return &ack, nil
+}
+
 // ParseCommandMessage 명령 메시지 데이터 파싱
 func (m *Message) ParseCommandMessage() (*CommandMessage, error) {
 	var cmd CommandMessage
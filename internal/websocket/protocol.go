@@ -0,0 +1,237 @@
+package websocket
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProtocolVersion WebSocket 메시지 프로토콜 버전
+type ProtocolVersion int
+
+const (
+	// ProtocolV1 기존 메시지 형식 (type, id, channel, data, timestamp)
+	ProtocolV1 ProtocolVersion = 1
+	// ProtocolV2 버전이 있는 봉투 형식 (type, version, id, payload)
+	ProtocolV2 ProtocolVersion = 2
+
+	// CurrentProtocolVersion 서버가 선호하는 최신 버전
+	CurrentProtocolVersion = ProtocolV2
+	// MinProtocolVersion 아직 지원하는 가장 오래된 버전
+	MinProtocolVersion = ProtocolV1
+)
+
+// SubprotocolPrefix Sec-WebSocket-Protocol 서브프로토콜 이름의 접두사 (aicli-ws-v2)
+const SubprotocolPrefix = "aicli-ws-v"
+
+// ProtocolQueryParam 서브프로토콜을 지정할 수 없는 클라이언트를 위한 쿼리 파라미터
+const ProtocolQueryParam = "protocol"
+
+// 애플리케이션 종료 코드 (RFC 6455의 4000-4999 비공개 영역)
+const (
+	CloseHeartbeatTimeout    = 4000 // 핑에 대한 응답(퐁) 없음
+	CloseIdleTimeout         = 4001 // 유휴 시간 초과
+	CloseUnsupportedProtocol = 4002 // 지원하지 않는 프로토콜 버전의 메시지
+	CloseSlowConsumer        = 4003 // 전송 버퍼 가득 참
+)
+
+// Subprotocol 버전에 대응하는 서브프로토콜 이름
+func (v ProtocolVersion) Subprotocol() string {
+	return SubprotocolPrefix + strconv.Itoa(int(v))
+}
+
+// IsSupported 서버가 지원하는 버전인지 확인
+func (v ProtocolVersion) IsSupported() bool {
+	return v >= MinProtocolVersion && v <= CurrentProtocolVersion
+}
+
+// SupportedProtocolVersions 지원하는 버전 목록 (최신순)
+func SupportedProtocolVersions() []ProtocolVersion {
+	versions := make([]ProtocolVersion, 0, CurrentProtocolVersion-MinProtocolVersion+1)
+	for v := CurrentProtocolVersion; v >= MinProtocolVersion; v-- {
+		versions = append(versions, v)
+	}
+	return versions
+}
+
+// SupportedSubprotocols 업그레이더에 설정할 서브프로토콜 목록 (서버 선호 순)
+func SupportedSubprotocols() []string {
+	versions := SupportedProtocolVersions()
+	names := make([]string, len(versions))
+	for i, v := range versions {
+		names[i] = v.Subprotocol()
+	}
+	return names
+}
+
+// ParseSubprotocol 서브프로토콜 이름에서 버전을 읽습니다
+func ParseSubprotocol(name string) (ProtocolVersion, bool) {
+	if !strings.HasPrefix(name, SubprotocolPrefix) {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(name, SubprotocolPrefix))
+	if err != nil {
+		return 0, false
+	}
+	return ProtocolVersion(n), true
+}
+
+// ProtocolError 버전 협상 실패
+type ProtocolError struct {
+	Requested string
+	Supported []ProtocolVersion
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("지원하지 않는 프로토콜 버전: %s (지원 버전 %v)", e.Requested, e.Supported)
+}
+
+// CheckProtocolRequest 업그레이드 전에 요청한 버전을 지원하는지 확인합니다.
+// 서브프로토콜을 제시했지만 모두 모르는 버전이거나, 쿼리 파라미터의 버전이 범위를 벗어나면 에러입니다.
+// 아무 버전도 제시하지 않은 요청(기존 프론트엔드)은 통과합니다.
+func CheckProtocolRequest(r *http.Request) error {
+	offered := false
+	for _, name := range requestedSubprotocols(r) {
+		v, ok := ParseSubprotocol(name)
+		if !ok {
+			continue
+		}
+		if v.IsSupported() {
+			return nil
+		}
+		offered = true
+	}
+	if offered {
+		return &ProtocolError{Requested: r.Header.Get("Sec-WebSocket-Protocol"), Supported: SupportedProtocolVersions()}
+	}
+
+	if raw := r.URL.Query().Get(ProtocolQueryParam); raw != "" {
+		if _, err := parseQueryVersion(raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NegotiateProtocol 업그레이드 후 연결에 사용할 버전을 결정합니다.
+// 핸드셰이크에서 선택된 서브프로토콜이 우선이고, 없으면 protocol 쿼리 파라미터를 따르며,
+// 둘 다 없으면 버전 협상을 모르는 기존 프론트엔드로 보고 v1을 사용합니다.
+func NegotiateProtocol(r *http.Request, selected string) (ProtocolVersion, error) {
+	if v, ok := ParseSubprotocol(selected); ok && v.IsSupported() {
+		return v, nil
+	}
+	if raw := r.URL.Query().Get(ProtocolQueryParam); raw != "" {
+		return parseQueryVersion(raw)
+	}
+	return ProtocolV1, nil
+}
+
+func parseQueryVersion(raw string) (ProtocolVersion, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(raw, "v"))
+	if err != nil || !ProtocolVersion(n).IsSupported() {
+		return 0, &ProtocolError{Requested: raw, Supported: SupportedProtocolVersions()}
+	}
+	return ProtocolVersion(n), nil
+}
+
+func requestedSubprotocols(r *http.Request) []string {
+	var names []string
+	for _, header := range r.Header.Values("Sec-WebSocket-Protocol") {
+		for _, name := range strings.Split(header, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// Envelope v2 메시지 봉투
+type Envelope struct {
+	Type      MessageType     `json:"type"`
+	Version   ProtocolVersion `json:"version"`
+	ID        string          `json:"id,omitempty"`
+	Channel   string          `json:"channel,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+// EncodeMessage 메시지를 연결의 프로토콜 버전 형식으로 직렬화합니다
+func EncodeMessage(msg *Message, version ProtocolVersion) ([]byte, error) {
+	if version < ProtocolV2 {
+		return msg.ToJSON()
+	}
+
+	payload := msg.Data
+	if len(payload) == 0 {
+		payload = json.RawMessage("null")
+	}
+	return json.Marshal(Envelope{
+		Type:      msg.Type,
+		Version:   version,
+		ID:        msg.ID,
+		Channel:   msg.Channel,
+		Payload:   payload,
+		Timestamp: msg.Timestamp,
+	})
+}
+
+// wireMessage 두 버전의 필드를 모두 받는 수신용 구조체
+type wireMessage struct {
+	Type      MessageType     `json:"type"`
+	Version   ProtocolVersion `json:"version"`
+	ID        string          `json:"id,omitempty"`
+	Channel   string          `json:"channel,omitempty"`
+	Data      json.RawMessage `json:"data"`
+	Payload   json.RawMessage `json:"payload"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+// DecodeMessage 수신한 메시지를 해석합니다.
+// v1(data)과 v2(payload) 형식을 모두 받으며, 봉투의 version이 지원 범위를 벗어나면 ProtocolError를 반환합니다.
+func DecodeMessage(data []byte) (*Message, error) {
+	var wire wireMessage
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return nil, err
+	}
+	if wire.Version != 0 && !wire.Version.IsSupported() {
+		return nil, &ProtocolError{Requested: strconv.Itoa(int(wire.Version)), Supported: SupportedProtocolVersions()}
+	}
+
+	body := wire.Data
+	if len(wire.Payload) > 0 {
+		body = wire.Payload
+	}
+	return &Message{
+		Type:      wire.Type,
+		ID:        wire.ID,
+		Channel:   wire.Channel,
+		Data:      body,
+		Timestamp: wire.Timestamp,
+	}, nil
+}
+
+// encodedMessage 브로드캐스트 시 버전별 직렬화 결과를 한 번만 만들기 위한 캐시
+type encodedMessage struct {
+	msg   *Message
+	cache map[ProtocolVersion][]byte
+}
+
+func newEncodedMessage(msg *Message) *encodedMessage {
+	return &encodedMessage{msg: msg, cache: make(map[ProtocolVersion][]byte)}
+}
+
+func (e *encodedMessage) bytes(version ProtocolVersion) ([]byte, error) {
+	if data, ok := e.cache[version]; ok {
+		return data, nil
+	}
+	data, err := EncodeMessage(e.msg, version)
+	if err != nil {
+		return nil, err
+	}
+	e.cache[version] = data
+	return data, nil
+}
@@ -0,0 +1,192 @@
+package websocket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/auth"
+)
+
+func TestNegotiateProtocol(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		selected string
+		expected ProtocolVersion
+		wantErr  bool
+	}{
+		{"서브프로토콜 v2", "/ws", "aicli-ws-v2", ProtocolV2, false},
+		{"서브프로토콜 v1", "/ws", "aicli-ws-v1", ProtocolV1, false},
+		{"쿼리 파라미터", "/ws?protocol=2", "", ProtocolV2, false},
+		{"v 접두사 쿼리", "/ws?protocol=v1", "", ProtocolV1, false},
+		{"협상 없음은 v1", "/ws", "", ProtocolV1, false},
+		{"지원하지 않는 쿼리", "/ws?protocol=9", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			v, err := NegotiateProtocol(r, tt.selected)
+			if tt.wantErr {
+				var protoErr *ProtocolError
+				assert.ErrorAs(t, err, &protoErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestCheckProtocolRequest(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/ws", nil)
+	assert.NoError(t, CheckProtocolRequest(r))
+
+	r.Header.Set("Sec-WebSocket-Protocol", "aicli-ws-v9, aicli-ws-v2")
+	assert.NoError(t, CheckProtocolRequest(r))
+
+	r.Header.Set("Sec-WebSocket-Protocol", "aicli-ws-v9")
+	assert.Error(t, CheckProtocolRequest(r))
+
+	// 다른 서브프로토콜만 제시하면 버전 협상을 모르는 클라이언트로 간주
+	r.Header.Set("Sec-WebSocket-Protocol", "graphql-ws")
+	assert.NoError(t, CheckProtocolRequest(r))
+
+	assert.Error(t, CheckProtocolRequest(httptest.NewRequest(http.MethodGet, "/ws?protocol=0", nil)))
+	assert.Equal(t, []string{"aicli-ws-v2", "aicli-ws-v1"}, SupportedSubprotocols())
+}
+
+func TestEncodeDecodeMessage(t *testing.T) {
+	msg := NewMessage(MessageTypeTask, TaskMessage{TaskID: "task-1", Status: "running"}).WithID("m-1").WithChannel("task:task-1")
+
+	// v1은 기존 형식 그대로
+	v1, err := EncodeMessage(msg, ProtocolV1)
+	require.NoError(t, err)
+	var legacy map[string]interface{}
+	require.NoError(t, json.Unmarshal(v1, &legacy))
+	assert.Contains(t, legacy, "data")
+	assert.NotContains(t, legacy, "version")
+
+	// v2는 version과 payload를 가진 봉투
+	v2, err := EncodeMessage(msg, ProtocolV2)
+	require.NoError(t, err)
+	var env Envelope
+	require.NoError(t, json.Unmarshal(v2, &env))
+	assert.Equal(t, ProtocolV2, env.Version)
+	assert.Equal(t, "m-1", env.ID)
+	assert.JSONEq(t, string(msg.Data), string(env.Payload))
+
+	// 두 형식 모두 같은 메시지로 해석
+	for _, data := range [][]byte{v1, v2} {
+		decoded, err := DecodeMessage(data)
+		require.NoError(t, err)
+		assert.Equal(t, MessageTypeTask, decoded.Type)
+		assert.Equal(t, "task:task-1", decoded.Channel)
+		assert.JSONEq(t, string(msg.Data), string(decoded.Data))
+	}
+
+	_, err = DecodeMessage([]byte(`{"type":"ping","version":7,"payload":{}}`))
+	var protoErr *ProtocolError
+	assert.ErrorAs(t, err, &protoErr)
+}
+
+// startProtocolServer 핸들러와 허브를 띄운 테스트 서버
+func startProtocolServer(t *testing.T, clientConfig *ClientConfig) string {
+	t.Helper()
+
+	hub := NewHub(nil)
+	require.NoError(t, hub.Start())
+	t.Cleanup(hub.Stop)
+
+	config := DefaultHandlerConfig()
+	config.Client = clientConfig
+	handler := NewWebSocketHandler(hub, auth.NewJWTManager("test-secret", time.Hour, time.Hour), auth.NewBlacklist(), config)
+
+	server := httptest.NewServer(http.HandlerFunc(handler.HandleConnectionHTTP))
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestWebSocketHandler_ProtocolNegotiation(t *testing.T) {
+	url := startProtocolServer(t, nil)
+
+	// v2를 제시하면 서브프로토콜이 선택되고 hello를 받음
+	dialer := websocket.Dialer{Subprotocols: []string{"aicli-ws-v2", "aicli-ws-v1"}}
+	conn, _, err := dialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Equal(t, "aicli-ws-v2", conn.Subprotocol())
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var env Envelope
+	require.NoError(t, conn.ReadJSON(&env))
+	assert.Equal(t, MessageTypeHello, env.Type)
+	assert.Equal(t, ProtocolV2, env.Version)
+
+	var hello HelloMessage
+	require.NoError(t, json.Unmarshal(env.Payload, &hello))
+	assert.Equal(t, ProtocolV2, hello.ProtocolVersion)
+	assert.Equal(t, int64(30000), hello.PingIntervalMs)
+
+	// 기존 프론트엔드(서브프로토콜 없음)는 v1 형식으로 응답받음
+	legacy, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer legacy.Close()
+	require.NoError(t, legacy.WriteJSON(map[string]interface{}{"type": "ping", "payload": map[string]interface{}{}}))
+
+	legacy.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var reply map[string]interface{}
+	require.NoError(t, legacy.ReadJSON(&reply))
+	assert.Equal(t, "pong", reply["type"])
+	assert.Contains(t, reply, "data")
+	assert.NotContains(t, reply, "version")
+
+	// 지원하지 않는 버전만 제시하면 업그레이드 거부
+	badDialer := websocket.Dialer{Subprotocols: []string{"aicli-ws-v9"}}
+	_, resp, err := badDialer.Dial(url, nil)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestClient_IdleTimeoutCloseCode(t *testing.T) {
+	config := DefaultClientConfig()
+	config.IdleTimeout = 500 * time.Millisecond
+	url := startProtocolServer(t, config)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, _, err = conn.ReadMessage()
+	require.Error(t, err)
+	assert.True(t, websocket.IsCloseError(err, CloseIdleTimeout), "unexpected error: %v", err)
+}
+
+func TestClient_HeartbeatTimeoutCloseCode(t *testing.T) {
+	config := DefaultClientConfig()
+	config.PingInterval = 200 * time.Millisecond
+	config.PongTimeout = 200 * time.Millisecond
+	url := startProtocolServer(t, config)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// 핑에 퐁으로 응답하지 않는 클라이언트
+	conn.SetPingHandler(func(string) error { return nil })
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, _, err = conn.ReadMessage()
+	require.Error(t, err)
+	assert.True(t, websocket.IsCloseError(err, CloseHeartbeatTimeout), "unexpected error: %v", err)
+}
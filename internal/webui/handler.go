@@ -0,0 +1,147 @@
+// Package webui는 API 서버가 빌드된 웹 UI(SPA)를 직접 제공하는 핸들러를 구현합니다.
+package webui
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+// CSPNoncePlaceholder는 index.html에서 요청별 CSP nonce로 바꿀 자리 표시자입니다.
+// web/vite.config.ts의 html.cspNonce와 같아야 합니다.
+const CSPNoncePlaceholder = "__AICLI_CSP_NONCE__"
+
+// indexFile은 SPA 진입 문서입니다.
+const indexFile = "index.html"
+
+// assetsDir은 파일 이름에 내용 해시가 붙는 빌드 결과 디렉토리입니다.
+const assetsDir = "assets/"
+
+// reservedPrefixes는 웹 UI로 응답하지 않는 서버 경로입니다.
+var reservedPrefixes = []string{"/api", "/ws", "/debug", "/health", "/metrics", "/version"}
+
+// staticFile은 메모리에 올린 웹 UI 파일입니다.
+type staticFile struct {
+	data        []byte
+	etag        string
+	contentType string
+}
+
+// Handler는 웹 UI 파일을 제공하고, 없는 경로는 index.html로 응답해 클라이언트 라우팅에 맡깁니다.
+// 파일은 생성할 때 모두 메모리에 올리므로 디렉토리의 파일을 바꾸면 재시작해야 반영됩니다.
+type Handler struct {
+	files       map[string]*staticFile
+	index       []byte
+	assetMaxAge time.Duration
+}
+
+// New는 fsys의 빌드 결과로 웹 UI 핸들러를 생성합니다.
+// /assets/ 파일은 assetMaxAge 동안 브라우저가 다시 묻지 않도록 캐시하며, fsys에 index.html이 없으면 오류를 반환합니다.
+func New(fsys fs.FS, assetMaxAge time.Duration) (*Handler, error) {
+	if fsys == nil {
+		return nil, errors.New("웹 UI 빌드 결과가 없습니다")
+	}
+
+	h := &Handler{
+		files:       make(map[string]*staticFile),
+		assetMaxAge: assetMaxAge,
+	}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		if name == indexFile {
+			h.index = data
+			return nil
+		}
+
+		sum := sha256.Sum256(data)
+		contentType := mime.TypeByExtension(path.Ext(name))
+		if contentType == "" {
+			contentType = http.DetectContentType(data)
+		}
+		h.files[name] = &staticFile{
+			data:        data,
+			etag:        `"` + hex.EncodeToString(sum[:8]) + `"`,
+			contentType: contentType,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("웹 UI 파일을 읽을 수 없습니다: %w", err)
+	}
+	if h.index == nil {
+		return nil, errors.New("웹 UI 빌드 결과에 index.html이 없습니다")
+	}
+	return h, nil
+}
+
+// IsUIRequest는 요청을 웹 UI로 응답할지 판단합니다 (GET, HEAD이고 API, WebSocket 같은 서버 경로가 아님).
+func IsUIRequest(r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if r.URL.Path == prefix || strings.HasPrefix(r.URL.Path, prefix+"/") {
+			return false
+		}
+	}
+	return true
+}
+
+// Serve는 요청 경로의 파일로 응답합니다.
+// 파일이 없으면 확장자가 있는 경로(빠진 스크립트, 이미지)는 404로, 그 밖의 경로는 index.html로 응답합니다.
+func (h *Handler) Serve(c *gin.Context) {
+	name := strings.TrimPrefix(path.Clean("/"+c.Request.URL.Path), "/")
+	if name == "" || name == indexFile {
+		h.serveIndex(c)
+		return
+	}
+
+	file, ok := h.files[name]
+	if !ok {
+		if path.Ext(name) != "" {
+			c.String(http.StatusNotFound, "404 page not found")
+			return
+		}
+		h.serveIndex(c)
+		return
+	}
+
+	if strings.HasPrefix(name, assetsDir) && h.assetMaxAge > 0 {
+		c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", int64(h.assetMaxAge.Seconds())))
+	} else {
+		c.Header("Cache-Control", "no-cache")
+	}
+	c.Header("ETag", file.etag)
+	c.Header("Content-Type", file.contentType)
+	http.ServeContent(c.Writer, c.Request, name, time.Time{}, bytes.NewReader(file.data))
+}
+
+// serveIndex는 index.html의 nonce 자리 표시자를 요청의 CSP nonce로 바꿔 응답합니다.
+// 새 빌드를 배포하면 바로 받도록 캐시하지 않으며, nonce가 있으면 재사용되지 않도록 저장도 막습니다.
+func (h *Handler) serveIndex(c *gin.Context) {
+	nonce := middleware.GetCSPNonce(c)
+	if nonce != "" {
+		c.Header("Cache-Control", "no-store")
+	} else {
+		c.Header("Cache-Control", "no-cache")
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", bytes.ReplaceAll(h.index, []byte(CSPNoncePlaceholder), []byte(nonce)))
+}
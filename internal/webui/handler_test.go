@@ -0,0 +1,124 @@
+package webui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aicli/aicli-web/internal/middleware"
+)
+
+const testIndex = `<html><head><meta property="csp-nonce" nonce="__AICLI_CSP_NONCE__">` +
+	`<script type="module" src="/assets/index-abc123.js" nonce="__AICLI_CSP_NONCE__"></script></head></html>`
+
+func newTestRouter(t *testing.T, nonce string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler, err := New(fstest.MapFS{
+		"index.html":             {Data: []byte(testIndex)},
+		"favicon.ico":            {Data: []byte{0, 0, 1, 0}},
+		"assets/index-abc123.js": {Data: []byte("console.log('aicli')")},
+	}, 24*time.Hour)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if nonce != "" {
+			c.Set(middleware.CSPNonceContextKey, nonce)
+		}
+	})
+	router.NoRoute(func(c *gin.Context) {
+		if IsUIRequest(c.Request) {
+			handler.Serve(c)
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not Found"})
+	})
+	return router
+}
+
+func serve(router *gin.Engine, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, time.Hour)
+	assert.Error(t, err)
+
+	_, err = New(fstest.MapFS{"assets/app.js": {Data: []byte("x")}}, time.Hour)
+	assert.Error(t, err)
+}
+
+func TestIsUIRequest(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{http.MethodGet, "/", true},
+		{http.MethodGet, "/workspaces/ws-1", true},
+		{http.MethodHead, "/assets/index.js", true},
+		{http.MethodGet, "/apiary", true},
+		{http.MethodPost, "/workspaces", false},
+		{http.MethodGet, "/api/v1/unknown", false},
+		{http.MethodGet, "/api", false},
+		{http.MethodGet, "/ws/unknown", false},
+		{http.MethodGet, "/health", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, IsUIRequest(httptest.NewRequest(tt.method, tt.path, nil)), "%s %s", tt.method, tt.path)
+	}
+}
+
+func TestHandler_Serve(t *testing.T) {
+	router := newTestRouter(t, "")
+
+	// 해시가 붙은 파일은 오래 캐시
+	w := serve(router, httptest.NewRequest(http.MethodGet, "/assets/index-abc123.js", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "public, max-age=86400, immutable", w.Header().Get("Cache-Control"))
+	assert.Contains(t, w.Header().Get("Content-Type"), "javascript")
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/assets/index-abc123.js", nil)
+	req.Header.Set("If-None-Match", etag)
+	assert.Equal(t, http.StatusNotModified, serve(router, req).Code)
+
+	// 그 밖의 파일은 매번 확인
+	w = serve(router, httptest.NewRequest(http.MethodGet, "/favicon.ico", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+
+	// 클라이언트 라우팅 경로는 index.html
+	w = serve(router, httptest.NewRequest(http.MethodGet, "/workspace/ws-1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.Contains(t, w.Body.String(), `nonce=""`)
+
+	// 빠진 파일과 API 경로는 index.html로 응답하지 않음
+	w = serve(router, httptest.NewRequest(http.MethodGet, "/assets/missing.js", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = serve(router, httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), "Not Found")
+}
+
+func TestHandler_ServeIndexWithCSPNonce(t *testing.T) {
+	router := newTestRouter(t, "bm9uY2U=")
+
+	w := serve(router, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	assert.NotContains(t, w.Body.String(), CSPNoncePlaceholder)
+	assert.Contains(t, w.Body.String(), `<script type="module" src="/assets/index-abc123.js" nonce="bm9uY2U="></script>`)
+	assert.Contains(t, w.Body.String(), `<meta property="csp-nonce" nonce="bm9uY2U=">`)
+}
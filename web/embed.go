@@ -0,0 +1,22 @@
+//go:build embedui
+
+package web
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// dist는 `npm run build-only`로 만든 웹 UI 빌드 결과입니다.
+//
+//go:embed all:dist
+var dist embed.FS
+
+// Dist는 바이너리에 포함한 웹 UI를 반환합니다.
+func Dist() fs.FS {
+	sub, err := fs.Sub(dist, "dist")
+	if err != nil {
+		return nil
+	}
+	return sub
+}
@@ -0,0 +1,12 @@
+//go:build !embedui
+
+// Package web은 API 서버 바이너리에 포함할 웹 UI 빌드 결과를 제공합니다.
+// `make build-api-ui`처럼 embedui 태그로 빌드해야 web/dist가 포함됩니다.
+package web
+
+import "io/fs"
+
+// Dist는 바이너리에 포함한 웹 UI를 반환합니다 (embedui 태그 없이 빌드하면 nil).
+func Dist() fs.FS {
+	return nil
+}
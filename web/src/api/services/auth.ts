@@ -1,6 +1,7 @@
 import { apiGet, apiPost } from '@/api'
 import type {
   AuditLog,
+  DeviceAuthorization,
   LinkOAuthRequest,
   LogExportRequest,
   LogExportResponse,
@@ -95,6 +96,23 @@ export const authApi = {
     await apiPost('/auth/email-verification-confirm', { token })
   },
 
+  // CLI 디바이스 코드 로그인
+
+  /**
+   * 승인 대기 중인 디바이스 요청 조회
+   */
+  getDeviceAuthorization: async (userCode: string): Promise<DeviceAuthorization> => {
+    const response = await apiGet<DeviceAuthorization>('/auth/device', { params: { user_code: userCode } })
+    return response.data.data
+  },
+
+  /**
+   * 디바이스 요청 승인 또는 거절
+   */
+  approveDevice: async (userCode: string, approve: boolean): Promise<void> => {
+    await apiPost('/auth/device/approve', { user_code: userCode, approve })
+  },
+
   // OAuth 관련 API
 
   /**
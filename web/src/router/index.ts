@@ -15,6 +15,7 @@ const ProfileEditView = () => import('@/views/ProfileEditView.vue')
 const TerminalTest = () => import('@/views/TerminalTest.vue')
 const LoginView = () => import('@/views/LoginView.vue')
 const OAuthCallbackView = () => import('@/views/OAuthCallbackView.vue')
+const DeviceApprovalView = () => import('@/views/DeviceApprovalView.vue')
 const NotFoundView = () => import('@/views/NotFoundView.vue')
 const ForbiddenView = () => import('@/views/ForbiddenView.vue')
 
@@ -67,6 +68,15 @@ const router = createRouter({
         title: 'OAuth 로그인 처리',
       },
     },
+    {
+      path: '/device',
+      name: 'device-approval',
+      component: DeviceApprovalView,
+      meta: {
+        requiresAuth: true,
+        title: 'CLI 로그인 승인',
+      },
+    },
     {
       path: '/workspaces',
       name: 'workspaces',
@@ -45,6 +45,15 @@ export interface LoginResponse {
   expiresIn: number
 }
 
+// CLI 디바이스 코드 로그인 승인 요청
+export interface DeviceAuthorization {
+  user_code: string
+  client_name?: string
+  scope: string
+  status: 'pending' | 'approved' | 'denied'
+  expires_at: string
+}
+
 export interface RefreshTokenRequest {
   refreshToken: string
 }
@@ -1,18 +1,35 @@
 // WebSocket 클라이언트 매니저
 
+/** /ws 엔드포인트의 프로토콜 버전 협상용 서브프로토콜 (선호 순) */
+export const AICLI_WS_PROTOCOLS = ['aicli-ws-v2', 'aicli-ws-v1']
+
+/** 서버가 보내는 애플리케이션 종료 코드 */
+export const WS_CLOSE_CODES = {
+  HEARTBEAT_TIMEOUT: 4000,
+  IDLE_TIMEOUT: 4001,
+  UNSUPPORTED_PROTOCOL: 4002,
+  SLOW_CONSUMER: 4003,
+} as const
+
 export interface WebSocketOptions {
   reconnectInterval?: number
   maxReconnectAttempts?: number
   pingInterval?: number
   pongTimeout?: number
   debug?: boolean
+  /** 핸드셰이크에서 제시할 서브프로토콜 (예: AICLI_WS_PROTOCOLS) */
+  protocols?: string[]
+  /** 재개 중인 채널의 수신 확인(ack)을 모아 보내는 간격 */
+  ackInterval?: number
 }
 
 export interface WebSocketMessage {
   type: string
   payload: any
+  version?: number
   timestamp?: string
   id?: string
+  channel?: string
 }
 
 export type WebSocketEventHandler = (data: any) => void
@@ -32,7 +49,14 @@ class WebSocketManager {
   private pingTimer: NodeJS.Timeout | null = null
   private pongTimer: NodeJS.Timeout | null = null
 
+  private ackTimer: NodeJS.Timeout | null = null
+
+  /** 재연결 시 이어 받을 채널과 마지막으로 받은 이벤트 ID */
+  private resumeChannels: Map<string, string | undefined> = new Map()
+  private pendingAcks: Map<string, string> = new Map()
+
   private isManualClose = false
+  private protocolVersion = 1
   private status: 'connecting' | 'connected' | 'disconnected' | 'error' = 'disconnected'
 
   constructor(url: string, options: WebSocketOptions = {}) {
@@ -43,6 +67,8 @@ class WebSocketManager {
       pingInterval: options.pingInterval || 30000,
       pongTimeout: options.pongTimeout || 5000,
       debug: options.debug || false,
+      protocols: options.protocols || [],
+      ackInterval: options.ackInterval || 1000,
     }
   }
 
@@ -55,13 +81,20 @@ class WebSocketManager {
         this.isManualClose = false
         this.setStatus('connecting')
 
-        this.ws = new WebSocket(this.url)
+        this.ws = this.options.protocols.length
+          ? new WebSocket(this.url, this.options.protocols)
+          : new WebSocket(this.url)
 
         this.ws.onopen = () => {
-          this.log('WebSocket connected')
+          // 서버가 선택한 서브프로토콜(aicli-ws-vN)에서 버전을 읽음
+          const match = /^aicli-ws-v(\d+)$/.exec(this.ws?.protocol || '')
+          this.protocolVersion = match ? Number(match[1]) : 1
+          this.log('WebSocket connected', this.ws?.protocol || '(no subprotocol)')
           this.reconnectCount = 0
           this.setStatus('connected')
           this.startPing()
+          // 상태 핸들러(인증 등) 다음에 끊겼던 채널을 마지막 이벤트 이후부터 재개
+          this.resumeChannels.forEach((lastEventId, channel) => this.sendResume(channel, lastEventId))
           resolve()
         }
 
@@ -73,7 +106,9 @@ class WebSocketManager {
           this.log('WebSocket closed', event.code, event.reason)
           this.stopPing()
 
-          if (!this.isManualClose && this.shouldReconnect()) {
+          // 지원하지 않는 프로토콜 버전이면 같은 조건으로 재연결해도 실패함
+          const retryable = event.code !== WS_CLOSE_CODES.UNSUPPORTED_PROTOCOL
+          if (!this.isManualClose && retryable && this.shouldReconnect()) {
             this.setStatus('disconnected')
             this.scheduleReconnect()
           } else {
@@ -126,6 +161,7 @@ class WebSocketManager {
     try {
       const payload = {
         ...message,
+        ...(this.protocolVersion >= 2 && { version: this.protocolVersion }),
         timestamp: message.timestamp || new Date().toISOString(),
         id: message.id || this.generateMessageId(),
       }
@@ -140,6 +176,31 @@ class WebSocketManager {
     }
   }
 
+  /**
+   * 채널을 마지막으로 받은 이벤트 이후부터 이어 받습니다 (session:, task: 채널)
+   * 이벤트 ID를 생략하면 서버에 마지막으로 확인(ack)한 위치부터 재개하며, 이후 재연결 때마다 자동으로 재개합니다.
+   */
+  resume(channel: string, lastEventId?: string): boolean {
+    this.resumeChannels.set(channel, lastEventId ?? this.resumeChannels.get(channel))
+    return this.sendResume(channel, this.resumeChannels.get(channel))
+  }
+
+  /**
+   * 채널 재개 중지 (구독 취소)
+   */
+  stopResume(channel: string): void {
+    this.resumeChannels.delete(channel)
+    this.pendingAcks.delete(channel)
+    this.send({ type: 'unsubscribe', payload: { channels: [channel] } })
+  }
+
+  /**
+   * 채널에서 마지막으로 받은 이벤트 ID
+   */
+  getLastEventId(channel: string): string | undefined {
+    return this.resumeChannels.get(channel)
+  }
+
   /**
    * 이벤트 핸들러 등록
    */
@@ -197,6 +258,13 @@ class WebSocketManager {
     return this.status
   }
 
+  /**
+   * 협상된 프로토콜 버전 (서브프로토콜이 없으면 1)
+   */
+  getProtocolVersion(): number {
+    return this.protocolVersion
+  }
+
   /**
    * 연결 상태 확인
    */
@@ -219,40 +287,98 @@ class WebSocketManager {
       const data = JSON.parse(event.data)
       this.log('Message received', data)
 
-      // pong 응답 처리
-      if (data.type === 'pong') {
-        this.handlePong()
+      // 배칭된 스트림 출력은 개별 메시지로 풀어서 처리
+      if (data.type === 'batch') {
+        const messages = (data.payload ?? data.data)?.messages ?? []
+        messages.forEach((message: any) => this.dispatchMessage(message))
         return
       }
 
-      // 이벤트 핸들러 실행
-      const handlers = this.eventHandlers.get(data.type)
-      if (handlers) {
-        handlers.forEach(handler => {
-          try {
-            handler(data.payload || data)
-          } catch (error) {
-            this.log('Error in event handler', error)
-          }
-        })
-      }
-
-      // 전체 메시지 핸들러 실행
-      const allHandlers = this.eventHandlers.get('*')
-      if (allHandlers) {
-        allHandlers.forEach(handler => {
-          try {
-            handler(data)
-          } catch (error) {
-            this.log('Error in catch-all event handler', error)
-          }
-        })
-      }
+      this.dispatchMessage(data)
     } catch (error) {
       this.log('Failed to parse message', error)
     }
   }
 
+  private dispatchMessage(data: any): void {
+    // pong 응답 처리
+    if (data.type === 'pong') {
+      this.handlePong()
+      return
+    }
+
+    if (data.type === 'resumed') {
+      this.handleResumed(data.payload ?? data.data)
+    } else if (data.channel && data.id && this.resumeChannels.has(data.channel)) {
+      this.trackEvent(data.channel, data.id)
+    }
+
+    // 이벤트 핸들러 실행
+    const handlers = this.eventHandlers.get(data.type)
+    if (handlers) {
+      handlers.forEach(handler => {
+        try {
+          handler(data.payload || data)
+        } catch (error) {
+          this.log('Error in event handler', error)
+        }
+      })
+    }
+
+    // 전체 메시지 핸들러 실행
+    const allHandlers = this.eventHandlers.get('*')
+    if (allHandlers) {
+      allHandlers.forEach(handler => {
+        try {
+          handler(data)
+        } catch (error) {
+          this.log('Error in catch-all event handler', error)
+        }
+      })
+    }
+  }
+
+  private sendResume(channel: string, lastEventId?: string): boolean {
+    return this.send({
+      type: 'resume',
+      payload: { channel, ...(lastEventId && { last_event_id: lastEventId }) },
+    })
+  }
+
+  private handleResumed(result: any): void {
+    if (!result?.channel || !this.resumeChannels.has(result.channel)) {
+      return
+    }
+
+    if (result.truncated) {
+      this.log(`Events lost while disconnected on ${result.channel}; oldest retained ${result.oldest_event_id}`)
+    }
+    if (result.last_event_id && result.last_event_id !== '0') {
+      this.trackEvent(result.channel, result.last_event_id)
+    }
+    // 재전송이 남아 있으면 이어서 요청 (따라잡을 때까지 채널 구독이 보류됨)
+    if (result.has_more) {
+      this.sendResume(result.channel, result.last_event_id)
+    }
+  }
+
+  private trackEvent(channel: string, eventId: string): void {
+    this.resumeChannels.set(channel, eventId)
+    this.pendingAcks.set(channel, eventId)
+
+    if (!this.ackTimer) {
+      this.ackTimer = setTimeout(() => this.flushAcks(), this.options.ackInterval)
+    }
+  }
+
+  private flushAcks(): void {
+    this.ackTimer = null
+    this.pendingAcks.forEach((eventId, channel) => {
+      this.send({ type: 'ack', payload: { channel, event_id: eventId } })
+    })
+    this.pendingAcks.clear()
+  }
+
   private setStatus(status: 'connecting' | 'connected' | 'disconnected' | 'error'): void {
     if (this.status !== status) {
       this.status = status
@@ -341,6 +467,11 @@ class WebSocketManager {
       this.reconnectTimer = null
     }
 
+    if (this.ackTimer) {
+      clearTimeout(this.ackTimer)
+      this.ackTimer = null
+    }
+
     this.stopPing()
   }
 
@@ -69,6 +69,11 @@ export default defineConfig(({ mode }) => {
       strictPort: true,
     },
 
+    // index.html의 script, style 태그에 붙일 CSP nonce 자리 표시자 (API 서버가 요청별 nonce로 바꿈)
+    html: {
+      cspNonce: '__AICLI_CSP_NONCE__',
+    },
+
     // 빌드 설정
     build: {
       target: 'esnext',